@@ -0,0 +1,94 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"github.com/percona/pmm/admin/commands"
+	"github.com/percona/pmm/admin/pkg/flags"
+	"github.com/percona/pmm/api/inventory/v1/json/client"
+	agents "github.com/percona/pmm/api/inventory/v1/json/client/agents_service"
+)
+
+var addAgentQANProxySQLDigestAgentResultT = commands.ParseTemplate(`
+QAN ProxySQL query digest agent added.
+Agent ID              : {{ .Agent.AgentID }}
+PMM-Agent ID          : {{ .Agent.PMMAgentID }}
+Service ID            : {{ .Agent.ServiceID }}
+Username              : {{ .Agent.Username }}
+TLS enabled           : {{ .Agent.TLS }}
+Skip TLS verification : {{ .Agent.TLSSkipVerify }}
+
+Status                : {{ .Agent.Status }}
+Disabled              : {{ .Agent.Disabled }}
+Custom labels         : {{ .Agent.CustomLabels }}
+`)
+
+type addAgentQANProxySQLDigestAgentResult struct {
+	Agent *agents.AddAgentOKBodyQANProxysqlDigestAgent `json:"qan_proxysql_digest_agent"`
+}
+
+func (res *addAgentQANProxySQLDigestAgentResult) Result() {}
+
+func (res *addAgentQANProxySQLDigestAgentResult) String() string {
+	return commands.RenderTemplate(addAgentQANProxySQLDigestAgentResultT, res)
+}
+
+// AddAgentQANProxySQLDigestAgentCommand is used by Kong for CLI flags and commands.
+//
+//nolint:lll
+type AddAgentQANProxySQLDigestAgentCommand struct {
+	flags.LogLevelFatalFlags
+
+	PMMAgentID          string            `arg:"" help:"The pmm-agent identifier which runs this instance"`
+	ServiceID           string            `arg:"" help:"Service identifier"`
+	Username            string            `arg:"" optional:"" help:"ProxySQL username for getting query digest data"`
+	Password            string            `help:"ProxySQL password for getting query digest data"`
+	CustomLabels        map[string]string `mapsep:"," help:"Custom user-assigned labels"`
+	SkipConnectionCheck bool              `help:"Skip connection check"`
+	MaxQueryLength      int32             `placeholder:"NUMBER" help:"Limit query length in QAN (default: server-defined; -1: no limit)"`
+	TLS                 bool              `help:"Use TLS to connect to the database"`
+	TLSSkipVerify       bool              `help:"Skip TLS certificate verification"`
+}
+
+// RunCmd executes the AddAgentQANProxySQLDigestAgentCommand and returns the result.
+func (cmd *AddAgentQANProxySQLDigestAgentCommand) RunCmd() (commands.Result, error) {
+	customLabels := commands.ParseKeyValuePair(&cmd.CustomLabels)
+
+	params := &agents.AddAgentParams{
+		Body: agents.AddAgentBody{
+			QANProxysqlDigestAgent: &agents.AddAgentParamsBodyQANProxysqlDigestAgent{
+				PMMAgentID:          cmd.PMMAgentID,
+				ServiceID:           cmd.ServiceID,
+				Username:            cmd.Username,
+				Password:            cmd.Password,
+				CustomLabels:        *customLabels,
+				SkipConnectionCheck: cmd.SkipConnectionCheck,
+				MaxQueryLength:      cmd.MaxQueryLength,
+				TLS:                 cmd.TLS,
+				TLSSkipVerify:       cmd.TLSSkipVerify,
+				LogLevel:            cmd.LogLevel.EnumValue(),
+			},
+		},
+		Context: commands.Ctx,
+	}
+
+	resp, err := client.Default.AgentsService.AddAgent(params)
+	if err != nil {
+		return nil, err
+	}
+	return &addAgentQANProxySQLDigestAgentResult{
+		Agent: resp.Payload.QANProxysqlDigestAgent,
+	}, nil
+}
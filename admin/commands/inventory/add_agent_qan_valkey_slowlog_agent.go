@@ -0,0 +1,131 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"github.com/percona/pmm/admin/commands"
+	"github.com/percona/pmm/admin/pkg/flags"
+	"github.com/percona/pmm/api/inventory/v1/json/client"
+	agents "github.com/percona/pmm/api/inventory/v1/json/client/agents_service"
+)
+
+var addAgentQANValkeySlowlogAgentResultT = commands.ParseTemplate(`
+QAN Valkey slowlog agent added.
+Agent ID              : {{ .Agent.AgentID }}
+PMM-Agent ID          : {{ .Agent.PMMAgentID }}
+Service ID            : {{ .Agent.ServiceID }}
+Username              : {{ .Agent.Username }}
+Query examples        : {{ .QueryExamples }}
+TLS enabled           : {{ .Agent.TLS }}
+Skip TLS verification : {{ .Agent.TLSSkipVerify }}
+
+Status                : {{ .Agent.Status }}
+Disabled              : {{ .Agent.Disabled }}
+Custom labels         : {{ .Agent.CustomLabels }}
+`)
+
+type addAgentQANValkeySlowlogAgentResult struct {
+	Agent *agents.AddAgentOKBodyQANValkeySlowlogAgent `json:"qan_valkey_slowlog_agent"`
+}
+
+func (res *addAgentQANValkeySlowlogAgentResult) Result() {}
+
+func (res *addAgentQANValkeySlowlogAgentResult) String() string {
+	return commands.RenderTemplate(addAgentQANValkeySlowlogAgentResultT, res)
+}
+
+func (res *addAgentQANValkeySlowlogAgentResult) QueryExamples() string {
+	if res.Agent.QueryExamplesDisabled {
+		return "disabled"
+	}
+	return "enabled"
+}
+
+// AddAgentQANValkeySlowlogAgentCommand is used by Kong for CLI flags and commands.
+//
+//nolint:lll
+type AddAgentQANValkeySlowlogAgentCommand struct {
+	flags.LogLevelFatalFlags
+
+	PMMAgentID           string            `arg:"" help:"The pmm-agent identifier which runs this instance"`
+	ServiceID            string            `arg:"" help:"Service identifier"`
+	Username             string            `arg:"" optional:"" help:"Valkey username for getting slow log"`
+	Password             string            `help:"Valkey password for getting slow log"`
+	CustomLabels         map[string]string `mapsep:"," help:"Custom user-assigned labels"`
+	SkipConnectionCheck  bool              `help:"Skip connection check"`
+	MaxQueryLength       int32             `placeholder:"NUMBER" help:"Limit query length in QAN (default: server-defined; -1: no limit)"`
+	DisableQueryExamples bool              `name:"disable-queryexamples" help:"Disable collection of query examples"`
+	TLS                  bool              `help:"Use TLS to connect to the database"`
+	TLSSkipVerify        bool              `help:"Skip TLS certificate verification"`
+	TLSCAFile            string            `name:"tls-ca" help:"Path to certificate authority certificate file"`
+	TLSCertFile          string            `name:"tls-cert" help:"Path to client certificate file"`
+	TLSKeyFile           string            `name:"tls-key" help:"Path to client key file"`
+}
+
+// RunCmd executes the AddAgentQANValkeySlowlogAgentCommand and returns the result.
+func (cmd *AddAgentQANValkeySlowlogAgentCommand) RunCmd() (commands.Result, error) {
+	customLabels := commands.ParseKeyValuePair(&cmd.CustomLabels)
+
+	var (
+		err                    error
+		tlsCa, tlsCert, tlsKey string
+	)
+	if cmd.TLS {
+		tlsCa, err = commands.ReadFile(cmd.TLSCAFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsCert, err = commands.ReadFile(cmd.TLSCertFile)
+		if err != nil {
+			return nil, err
+		}
+
+		tlsKey, err = commands.ReadFile(cmd.TLSKeyFile)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	params := &agents.AddAgentParams{
+		Body: agents.AddAgentBody{
+			QANValkeySlowlogAgent: &agents.AddAgentParamsBodyQANValkeySlowlogAgent{
+				PMMAgentID:           cmd.PMMAgentID,
+				ServiceID:            cmd.ServiceID,
+				Username:             cmd.Username,
+				Password:             cmd.Password,
+				CustomLabels:         *customLabels,
+				SkipConnectionCheck:  cmd.SkipConnectionCheck,
+				MaxQueryLength:       cmd.MaxQueryLength,
+				DisableQueryExamples: cmd.DisableQueryExamples,
+				TLS:                  cmd.TLS,
+				TLSSkipVerify:        cmd.TLSSkipVerify,
+				TLSCa:                tlsCa,
+				TLSCert:              tlsCert,
+				TLSKey:               tlsKey,
+				LogLevel:             cmd.LogLevel.EnumValue(),
+			},
+		},
+		Context: commands.Ctx,
+	}
+
+	resp, err := client.Default.AgentsService.AddAgent(params)
+	if err != nil {
+		return nil, err
+	}
+	return &addAgentQANValkeySlowlogAgentResult{
+		Agent: resp.Payload.QANValkeySlowlogAgent,
+	}, nil
+}
@@ -0,0 +1,293 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/alecthomas/kong"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm/admin/pkg/flags"
+)
+
+func TestQANPostgreSQLLogAgentChangeAgent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("CoreFunctionality", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("UpdateCredentialsAndSettings", func(t *testing.T) {
+			var capturedRequestBody string
+			cleanup := setupChangeAgentTestServer(t, "test-agent-qan-pglog-update", `{"qan_postgresql_log_agent": {"agent_id": "test-agent-qan-pglog-update"}}`, &capturedRequestBody)
+			defer cleanup()
+
+			cmd := &ChangeAgentQANPostgreSQLLogAgentCommand{
+				AgentID:              "test-agent-qan-pglog-update",
+				Enable:               new(true),
+				Username:             new("postgres_user"),
+				Password:             new("postgres_pass"),
+				TLS:                  new(true),
+				TLSSkipVerify:        new(false),
+				MaxQueryLength:       new(int32(4096)),
+				DisableQueryExamples: new(false),
+				LogLevelFatalChangeFlags: flags.LogLevelFatalChangeFlags{
+					LogLevel: new(flags.LogLevel("debug")),
+				},
+				CommentsParsingChangeFlags: flags.CommentsParsingChangeFlags{
+					CommentsParsing: new("off"),
+				},
+				CustomLabels: &map[string]string{"environment": "production", "service": "postgresql"},
+			}
+
+			result, err := cmd.RunCmd()
+			require.NoError(t, err)
+			assert.NotNil(t, result)
+
+			expectedJSON := `{
+				"qan_postgresql_log_agent": {
+					"enable": true,
+					"username": "postgres_user",
+					"password": "postgres_pass",
+					"tls": true,
+					"tls_skip_verify": false,
+					"max_query_length": 4096,
+					"disable_query_examples": false,
+					"disable_comments_parsing": true,
+					"log_level": "LOG_LEVEL_DEBUG",
+					"custom_labels": {
+						"values": {
+							"environment": "production",
+							"service": "postgresql"
+						}
+					}
+				}
+			}`
+			assert.JSONEq(t, expectedJSON, capturedRequestBody)
+		})
+
+		t.Run("DisableAgent", func(t *testing.T) {
+			var capturedRequestBody string
+			cleanup := setupChangeAgentTestServer(t, "test-agent-qan-pglog-disable", `{"qan_postgresql_log_agent": {"agent_id": "test-agent-qan-pglog-disable"}}`, &capturedRequestBody)
+			defer cleanup()
+
+			cmd := &ChangeAgentQANPostgreSQLLogAgentCommand{
+				AgentID:              "test-agent-qan-pglog-disable",
+				Enable:               new(false),
+				DisableQueryExamples: new(true),
+				CommentsParsingChangeFlags: flags.CommentsParsingChangeFlags{
+					CommentsParsing: new("off"),
+				},
+			}
+
+			result, err := cmd.RunCmd()
+			require.NoError(t, err)
+			assert.NotNil(t, result)
+
+			expectedJSON := `{
+				"qan_postgresql_log_agent": {
+					"enable": false,
+					"disable_query_examples": true,
+					"disable_comments_parsing": true
+				}
+			}`
+			assert.JSONEq(t, expectedJSON, capturedRequestBody)
+		})
+	})
+
+	t.Run("ComprehensiveAllFieldsValidation", func(t *testing.T) {
+		var capturedRequestBody string
+		// Mock a comprehensive API response with all fields populated
+		mockResponse := `{
+			"qan_postgresql_log_agent": {
+				"agent_id": "test-agent-qan-pglog-all-flags",
+				"pmm_agent_id": "pmm-agent-123",
+				"service_id": "postgres-service-456",
+				"username": "testuser",
+				"tls": true,
+				"tls_skip_verify": true,
+				"max_query_length": 1024,
+				"query_examples_disabled": true,
+				"disable_comments_parsing": true,
+				"disabled": false,
+				"custom_labels": {
+					"env": "test",
+					"team": "qan"
+				},
+				"process_exec_path": "/usr/bin/postgres",
+				"log_level": "LOG_LEVEL_INFO"
+			}
+		}`
+
+		cleanup := setupChangeAgentTestServer(t, "test-agent-qan-pglog-all-flags", mockResponse, &capturedRequestBody)
+		defer cleanup()
+
+		cli := []string{
+			"change-agent", "qan-postgresql-log-agent", "test-agent-qan-pglog-all-flags",
+			"--enable",
+			"--username=testuser",
+			"--password=testpass",
+			"--tls",
+			"--tls-skip-verify",
+			"--max-query-length=1024",
+			"--disable-query-examples",
+			"--comments-parsing=off",
+			"--log-level=info",
+			"--custom-labels=env=test,team=qan",
+		}
+
+		var cmd ChangeAgentQANPostgreSQLLogAgentCommand
+		parser, err := kong.New(&cmd)
+		require.NoError(t, err)
+
+		_, err = parser.Parse(cli[2:])
+		require.NoError(t, err)
+
+		result, err := cmd.RunCmd()
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+
+		// Test API request JSON
+		expectedJSON := `{
+			"qan_postgresql_log_agent": {
+				"enable": true,
+				"username": "testuser",
+				"password": "testpass",
+				"tls": true,
+				"tls_skip_verify": true,
+				"max_query_length": 1024,
+				"disable_query_examples": true,
+				"disable_comments_parsing": true,
+				"log_level": "LOG_LEVEL_INFO",
+				"custom_labels": {
+					"values": {
+						"env": "test",
+						"team": "qan"
+					}
+				}
+			}
+		}`
+		assert.JSONEq(t, expectedJSON, capturedRequestBody)
+
+		// Test output format with all fields
+		output := result.String()
+
+		// Expected complete output with all fields and changes
+		expectedOutput := `QAN PostgreSQL log agent configuration updated.
+Agent ID                     : test-agent-qan-pglog-all-flags
+PMM-Agent ID                 : pmm-agent-123
+Service ID                   : postgres-service-456
+Username                     : testuser
+TLS enabled                  : true
+Skip TLS verification        : true
+Max query length             : 1024
+Query examples disabled      : true
+Disable comments parsing     : true
+
+Disabled                     : false
+Custom labels                : env=test, team=qan
+Process exec path            : /usr/bin/postgres
+Log level                    : info
+Configuration changes applied:
+  - enabled agent
+  - updated username
+  - updated password
+  - enabled TLS
+  - enabled TLS skip verification
+  - changed max query length to 1024
+  - disabled query examples
+  - disabled comments parsing
+  - changed log level to info
+  - updated custom labels
+`
+
+		assert.Equal(t, expectedOutput, output)
+	})
+
+	t.Run("ErrorHandling", func(t *testing.T) {
+		t.Parallel()
+
+		cleanup := setupChangeAgentTestServer(t, "invalid-agent-qan-pglog", `{"error": "Agent not found", "code": 404, "message": "Agent not found"}`, nil)
+		defer cleanup()
+
+		cmd := &ChangeAgentQANPostgreSQLLogAgentCommand{
+			AgentID: "invalid-agent-qan-pglog",
+			Enable:  new(true),
+		}
+
+		result, err := cmd.RunCmd()
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+
+	t.Run("KongParsingWithMinimalFlags", func(t *testing.T) {
+		var capturedRequestBody string
+		cleanup := setupChangeAgentTestServer(t, "test-agent-qan-pglog-minimal", `{"qan_postgresql_log_agent": {"agent_id": "test-agent-qan-pglog-minimal"}}`, &capturedRequestBody)
+		defer cleanup()
+
+		cli := []string{"change-agent", "qan-postgresql-log-agent", "test-agent-qan-pglog-minimal"}
+
+		var cmd ChangeAgentQANPostgreSQLLogAgentCommand
+		parser, err := kong.New(&cmd)
+		require.NoError(t, err)
+
+		_, err = parser.Parse(cli[2:])
+		require.NoError(t, err)
+
+		result, err := cmd.RunCmd()
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+
+		// Should have empty qan_postgresql_log_agent object when no flags are set
+		expectedJSON := `{
+			"qan_postgresql_log_agent": {}
+		}`
+		assert.JSONEq(t, expectedJSON, capturedRequestBody)
+	})
+
+	t.Run("KongParsingErrorCases", func(t *testing.T) {
+		t.Parallel()
+
+		t.Run("MissingRequiredArgument", func(t *testing.T) {
+			t.Parallel()
+
+			cli := []string{"change-agent", "qan-postgresql-log-agent"}
+
+			var cmd ChangeAgentQANPostgreSQLLogAgentCommand
+			parser, err := kong.New(&cmd)
+			require.NoError(t, err)
+
+			_, err = parser.Parse(cli[2:])
+			require.Error(t, err)
+			assert.Contains(t, strings.ToLower(err.Error()), "agent-id")
+		})
+
+		t.Run("InvalidLogLevel", func(t *testing.T) {
+			t.Parallel()
+
+			cli := []string{"change-agent", "qan-postgresql-log-agent", "test-agent-id", "--log-level=invalid"}
+
+			var cmd ChangeAgentQANPostgreSQLLogAgentCommand
+			parser, err := kong.New(&cmd)
+			require.NoError(t, err)
+
+			_, err = parser.Parse(cli[2:])
+			require.Error(t, err)
+			assert.Contains(t, strings.ToLower(err.Error()), "log-level")
+		})
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm/admin/pkg/flags"
+)
+
+func TestQANProxySQLDigestAgentChangeAgent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("UpdateCredentialsAndSettings", func(t *testing.T) {
+		var capturedRequestBody string
+		cleanup := setupChangeAgentTestServer(t, "test-agent-qan-proxysql-update", `{"qan_proxysql_digest_agent": {"agent_id": "test-agent-qan-proxysql-update"}}`, &capturedRequestBody)
+		defer cleanup()
+
+		cmd := &ChangeAgentQANProxySQLDigestAgentCommand{
+			AgentID:        "test-agent-qan-proxysql-update",
+			Enable:         new(true),
+			Username:       new("radmin"),
+			Password:       new("radmin_pass"),
+			TLSSkipVerify:  new(true),
+			MaxQueryLength: new(int32(2048)),
+			LogLevelFatalChangeFlags: flags.LogLevelFatalChangeFlags{
+				LogLevel: new(flags.LogLevel("warn")),
+			},
+			CustomLabels: &map[string]string{"tier": "proxy"},
+		}
+
+		result, err := cmd.RunCmd()
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+
+		expectedJSON := `{
+			"qan_proxysql_digest_agent": {
+				"enable": true,
+				"username": "radmin",
+				"password": "radmin_pass",
+				"tls_skip_verify": true,
+				"max_query_length": 2048,
+				"log_level": "LOG_LEVEL_WARN",
+				"custom_labels": {
+					"values": {
+						"tier": "proxy"
+					}
+				}
+			}
+		}`
+		assert.JSONEq(t, expectedJSON, capturedRequestBody)
+	})
+
+	t.Run("ErrorHandling", func(t *testing.T) {
+		cleanup := setupChangeAgentTestServer(t, "invalid-agent-qan-proxysql", `{"error": "Agent not found", "code": 404, "message": "Agent not found"}`, nil)
+		defer cleanup()
+
+		cmd := &ChangeAgentQANProxySQLDigestAgentCommand{
+			AgentID: "invalid-agent-qan-proxysql",
+			Enable:  new(true),
+		}
+
+		result, err := cmd.RunCmd()
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package inventory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm/admin/pkg/flags"
+)
+
+func TestQANValkeySlowlogAgentChangeAgent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("UpdateCredentialsAndSettings", func(t *testing.T) {
+		var capturedRequestBody string
+		cleanup := setupChangeAgentTestServer(t, "test-agent-qan-valkey-update", `{"qan_valkey_slowlog_agent": {"agent_id": "test-agent-qan-valkey-update"}}`, &capturedRequestBody)
+		defer cleanup()
+
+		cmd := &ChangeAgentQANValkeySlowlogAgentCommand{
+			AgentID:              "test-agent-qan-valkey-update",
+			Enable:               new(true),
+			Username:             new("valkey_user"),
+			Password:             new("valkey_pass"),
+			TLS:                  new(true),
+			MaxQueryLength:       new(int32(1024)),
+			DisableQueryExamples: new(true),
+			LogLevelFatalChangeFlags: flags.LogLevelFatalChangeFlags{
+				LogLevel: new(flags.LogLevel("debug")),
+			},
+			CustomLabels: &map[string]string{"role": "primary"},
+		}
+
+		result, err := cmd.RunCmd()
+		require.NoError(t, err)
+		assert.NotNil(t, result)
+
+		expectedJSON := `{
+			"qan_valkey_slowlog_agent": {
+				"enable": true,
+				"username": "valkey_user",
+				"password": "valkey_pass",
+				"tls": true,
+				"max_query_length": 1024,
+				"disable_query_examples": true,
+				"log_level": "LOG_LEVEL_DEBUG",
+				"custom_labels": {
+					"values": {
+						"role": "primary"
+					}
+				}
+			}
+		}`
+		assert.JSONEq(t, expectedJSON, capturedRequestBody)
+	})
+
+	t.Run("ErrorHandling", func(t *testing.T) {
+		cleanup := setupChangeAgentTestServer(t, "invalid-agent-qan-valkey", `{"error": "Agent not found", "code": 404, "message": "Agent not found"}`, nil)
+		defer cleanup()
+
+		cmd := &ChangeAgentQANValkeySlowlogAgentCommand{
+			AgentID: "invalid-agent-qan-valkey",
+			Enable:  new(true),
+		}
+
+		result, err := cmd.RunCmd()
+		require.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
@@ -61,9 +61,14 @@ type AddAgentCommand struct {
 	QANMySQLSlowlogAgent            AddAgentQANMySQLSlowlogAgentCommand            `cmd:"" name:"qan-mysql-slowlog-agent" help:"Add QAN MySQL slowlog agent to inventory"`
 	QANPostgreSQLPgStatementsAgent  AddAgentQANPostgreSQLPgStatementsAgentCommand  `cmd:"" name:"qan-postgresql-pgstatements-agent" help:"Add QAN PostgreSQL Stat Statements Agent to inventory"`
 	QANPostgreSQLPgStatMonitorAgent AddAgentQANPostgreSQLPgStatMonitorAgentCommand `cmd:"" name:"qan-postgresql-pgstatmonitor-agent" help:"Add QAN PostgreSQL Stat Monitor Agent to inventory"`
-
-	RDSExporter     AddAgentRDSExporterCommand     `cmd:"" help:"Add rds_exporter to inventory"`
-	RTAMongoDBAgent AddAgentRTAMongoDBAgentCommand `cmd:"" name:"rta-mongodb-agent" help:"Add Real-Time Analytics MongoDB agent to inventory"`
+	QANPostgreSQLLogAgent           AddAgentQANPostgreSQLLogAgentCommand           `cmd:"" name:"qan-postgresql-log-agent" help:"Add QAN PostgreSQL log agent to inventory"`
+	QANValkeySlowlogAgent           AddAgentQANValkeySlowlogAgentCommand           `cmd:"" name:"qan-valkey-slowlog-agent" help:"Add QAN Valkey slowlog agent to inventory"`
+	QANProxySQLDigestAgent          AddAgentQANProxySQLDigestAgentCommand          `cmd:"" name:"qan-proxysql-digest-agent" help:"Add QAN ProxySQL query digest agent to inventory"`
+
+	RDSExporter        AddAgentRDSExporterCommand        `cmd:"" help:"Add rds_exporter to inventory"`
+	RTAMongoDBAgent    AddAgentRTAMongoDBAgentCommand    `cmd:"" name:"rta-mongodb-agent" help:"Add Real-Time Analytics MongoDB agent to inventory"`
+	RTAMySQLAgent      AddAgentRTAMySQLAgentCommand      `cmd:"" name:"rta-mysql-agent" help:"Add Real-Time Analytics MySQL agent to inventory"`
+	RTAPostgreSQLAgent AddAgentRTAPostgreSQLAgentCommand `cmd:"" name:"rta-postgresql-agent" help:"Add Real-Time Analytics PostgreSQL agent to inventory"`
 }
 
 // AddNodeCommand is used by Kong for CLI flags and commands.
@@ -118,7 +123,12 @@ type ChangeAgentCommand struct {
 	QANMongoDBMongologAgent         ChangeAgentQANMongoDBMongologAgentCommand         `cmd:"" name:"qan-mongodb-mongolog-agent" help:"Change QAN MongoDB mongolog agent configuration (only passed flags will be changed)"`
 	QANPostgreSQLPgStatementsAgent  ChangeAgentQANPostgreSQLPgStatementsAgentCommand  `cmd:"" name:"qan-postgresql-pgstatements-agent" help:"Change QAN PostgreSQL pgstatements agent configuration (only passed flags will be changed)"`
 	QANPostgreSQLPgStatMonitorAgent ChangeAgentQANPostgreSQLPgStatMonitorAgentCommand `cmd:"" name:"qan-postgresql-pgstatmonitor-agent" help:"Change QAN PostgreSQL pgstatmonitor agent configuration (only passed flags will be changed)"`
+	QANPostgreSQLLogAgent           ChangeAgentQANPostgreSQLLogAgentCommand           `cmd:"" name:"qan-postgresql-log-agent" help:"Change QAN PostgreSQL log agent configuration (only passed flags will be changed)"`
+	QANValkeySlowlogAgent           ChangeAgentQANValkeySlowlogAgentCommand           `cmd:"" name:"qan-valkey-slowlog-agent" help:"Change QAN Valkey slowlog agent configuration (only passed flags will be changed)"`
+	QANProxySQLDigestAgent          ChangeAgentQANProxySQLDigestAgentCommand          `cmd:"" name:"qan-proxysql-digest-agent" help:"Change QAN ProxySQL query digest agent configuration (only passed flags will be changed)"`
 	RTAMongoDBAgent                 ChangeAgentRTAMongoDBAgentCommand                 `cmd:"" name:"rta-mongodb-agent" help:"Change Real-Time Analytics MongoDB agent configuration (only passed flags will be changed)"`
+	RTAMySQLAgent                   ChangeAgentRTAMySQLAgentCommand                   `cmd:"" name:"rta-mysql-agent" help:"Change Real-Time Analytics MySQL agent configuration (only passed flags will be changed)"`
+	RTAPostgreSQLAgent              ChangeAgentRTAPostgreSQLAgentCommand              `cmd:"" name:"rta-postgresql-agent" help:"Change Real-Time Analytics PostgreSQL agent configuration (only passed flags will be changed)"`
 }
 
 // formatTypeValue checks acceptable type value and variations contains input and returns type value.
@@ -51,8 +51,13 @@ var acceptableAgentTypes = map[string][]string{
 	types.AgentTypeQANMongoDBMongologAgent:         {types.AgentTypeName(types.AgentTypeQANMongoDBMongologAgent), "qan-mongodb-mongolog-agent"},
 	types.AgentTypeQANPostgreSQLPgStatementsAgent:  {types.AgentTypeName(types.AgentTypeQANPostgreSQLPgStatementsAgent), "qan-postgresql-pgstatements-agent"},
 	types.AgentTypeQANPostgreSQLPgStatMonitorAgent: {types.AgentTypeName(types.AgentTypeQANPostgreSQLPgStatMonitorAgent), "qan-postgresql-pgstatmonitor-agent"},
+	types.AgentTypeQANPostgreSQLLogAgent:           {types.AgentTypeName(types.AgentTypeQANPostgreSQLLogAgent), "qan-postgresql-log-agent"},
+	types.AgentTypeQANValkeySlowlogAgent:           {types.AgentTypeName(types.AgentTypeQANValkeySlowlogAgent), "qan-valkey-slowlog-agent"},
+	types.AgentTypeQANProxySQLDigestAgent:          {types.AgentTypeName(types.AgentTypeQANProxySQLDigestAgent), "qan-proxysql-digest-agent"},
 	types.AgentTypeRDSExporter:                     {types.AgentTypeName(types.AgentTypeRDSExporter), "rds-exporter"},
 	types.AgentTypeRTAMongoDBAgent:                 {types.AgentTypeName(types.AgentTypeRTAMongoDBAgent), "rta-mongodb-agent"},
+	types.AgentTypeRTAMySQLAgent:                   {types.AgentTypeName(types.AgentTypeRTAMySQLAgent), "rta-mysql-agent"},
+	types.AgentTypeRTAPostgreSQLAgent:              {types.AgentTypeName(types.AgentTypeRTAPostgreSQLAgent), "rta-postgresql-agent"},
 }
 
 type listResultAgent struct {
@@ -140,8 +145,13 @@ func (cmd *ListAgentsCommand) RunCmd() (commands.Result, error) {
 			len(agentsRes.Payload.QANMongodbMongologAgent)+
 			len(agentsRes.Payload.QANPostgresqlPgstatementsAgent)+
 			len(agentsRes.Payload.QANPostgresqlPgstatmonitorAgent)+
+			len(agentsRes.Payload.QANPostgresqlLogAgent)+
+			len(agentsRes.Payload.QANValkeySlowlogAgent)+
+			len(agentsRes.Payload.QANProxysqlDigestAgent)+
 			len(agentsRes.Payload.ExternalExporter)+
-			len(agentsRes.Payload.RtaMongodbAgent),
+			len(agentsRes.Payload.RtaMongodbAgent)+
+			len(agentsRes.Payload.RtaMysqlAgent)+
+			len(agentsRes.Payload.RtaPostgresqlAgent),
 	)
 	for _, a := range agentsRes.Payload.PMMAgent {
 		status := "disconnected"
@@ -289,6 +299,36 @@ func (cmd *ListAgentsCommand) RunCmd() (commands.Result, error) {
 			Disabled:   a.Disabled,
 		})
 	}
+	for _, a := range agentsRes.Payload.QANValkeySlowlogAgent {
+		agentsList = append(agentsList, listResultAgent{
+			AgentType:  types.AgentTypeQANValkeySlowlogAgent,
+			AgentID:    a.AgentID,
+			PMMAgentID: a.PMMAgentID,
+			ServiceID:  a.ServiceID,
+			Status:     getAgentStatus(a.Status),
+			Disabled:   a.Disabled,
+		})
+	}
+	for _, a := range agentsRes.Payload.QANPostgresqlLogAgent {
+		agentsList = append(agentsList, listResultAgent{
+			AgentType:  types.AgentTypeQANPostgreSQLLogAgent,
+			AgentID:    a.AgentID,
+			PMMAgentID: a.PMMAgentID,
+			ServiceID:  a.ServiceID,
+			Status:     getAgentStatus(a.Status),
+			Disabled:   a.Disabled,
+		})
+	}
+	for _, a := range agentsRes.Payload.QANProxysqlDigestAgent {
+		agentsList = append(agentsList, listResultAgent{
+			AgentType:  types.AgentTypeQANProxySQLDigestAgent,
+			AgentID:    a.AgentID,
+			PMMAgentID: a.PMMAgentID,
+			ServiceID:  a.ServiceID,
+			Status:     getAgentStatus(a.Status),
+			Disabled:   a.Disabled,
+		})
+	}
 	for _, a := range agentsRes.Payload.ExternalExporter {
 		agentsList = append(agentsList, listResultAgent{
 			AgentType: types.AgentTypeExternalExporter,
@@ -309,6 +349,26 @@ func (cmd *ListAgentsCommand) RunCmd() (commands.Result, error) {
 			Disabled:   a.Disabled,
 		})
 	}
+	for _, a := range agentsRes.Payload.RtaMysqlAgent {
+		agentsList = append(agentsList, listResultAgent{
+			AgentType:  types.AgentTypeRTAMySQLAgent,
+			AgentID:    a.AgentID,
+			PMMAgentID: a.PMMAgentID,
+			ServiceID:  a.ServiceID,
+			Status:     getAgentStatus(a.Status),
+			Disabled:   a.Disabled,
+		})
+	}
+	for _, a := range agentsRes.Payload.RtaPostgresqlAgent {
+		agentsList = append(agentsList, listResultAgent{
+			AgentType:  types.AgentTypeRTAPostgreSQLAgent,
+			AgentID:    a.AgentID,
+			PMMAgentID: a.PMMAgentID,
+			ServiceID:  a.ServiceID,
+			Status:     getAgentStatus(a.Status),
+			Disabled:   a.Disabled,
+		})
+	}
 
 	return &listAgentsResult{
 		Agents: agentsList,
@@ -310,6 +310,8 @@ func agentsList(agentsRes *agents.ListAgentsOK, nodeID string) []listResultAgent
 	agentsList = append(agentsList, vmAgents(agentsRes, pmmAgentIDs)...)
 	agentsList = append(agentsList, nomadAgents(agentsRes, pmmAgentIDs)...)
 	agentsList = append(agentsList, rtaMongodbAgents(agentsRes, pmmAgentIDs)...)
+	agentsList = append(agentsList, rtaMysqlAgents(agentsRes, pmmAgentIDs)...)
+	agentsList = append(agentsList, rtaPostgresqlAgents(agentsRes, pmmAgentIDs)...)
 
 	return agentsList
 }
@@ -516,6 +518,39 @@ func qanPostgresqlPgstatmonitorAgents(agentsRes *agents.ListAgentsOK, pmmAgentID
 			})
 		}
 	}
+	for _, a := range agentsRes.Payload.QANValkeySlowlogAgent {
+		if _, ok := pmmAgentIDs[a.PMMAgentID]; ok {
+			agentsList = append(agentsList, listResultAgent{
+				AgentType: types.AgentTypeQANValkeySlowlogAgent,
+				AgentID:   a.AgentID,
+				ServiceID: a.ServiceID,
+				Status:    getStatus(a.Status),
+				Disabled:  a.Disabled,
+			})
+		}
+	}
+	for _, a := range agentsRes.Payload.QANPostgresqlLogAgent {
+		if _, ok := pmmAgentIDs[a.PMMAgentID]; ok {
+			agentsList = append(agentsList, listResultAgent{
+				AgentType: types.AgentTypeQANPostgreSQLLogAgent,
+				AgentID:   a.AgentID,
+				ServiceID: a.ServiceID,
+				Status:    getStatus(a.Status),
+				Disabled:  a.Disabled,
+			})
+		}
+	}
+	for _, a := range agentsRes.Payload.QANProxysqlDigestAgent {
+		if _, ok := pmmAgentIDs[a.PMMAgentID]; ok {
+			agentsList = append(agentsList, listResultAgent{
+				AgentType: types.AgentTypeQANProxySQLDigestAgent,
+				AgentID:   a.AgentID,
+				ServiceID: a.ServiceID,
+				Status:    getStatus(a.Status),
+				Disabled:  a.Disabled,
+			})
+		}
+	}
 	return agentsList
 }
 
@@ -621,3 +656,39 @@ func rtaMongodbAgents(agentsRes *agents.ListAgentsOK, pmmAgentIDs map[string]str
 
 	return agentsList
 }
+
+func rtaMysqlAgents(agentsRes *agents.ListAgentsOK, pmmAgentIDs map[string]struct{}) []listResultAgent {
+	var agentsList []listResultAgent
+
+	for _, a := range agentsRes.Payload.RtaMysqlAgent {
+		if _, ok := pmmAgentIDs[a.PMMAgentID]; ok {
+			agentsList = append(agentsList, listResultAgent{
+				AgentType: types.AgentTypeRTAMySQLAgent,
+				AgentID:   a.AgentID,
+				ServiceID: a.ServiceID,
+				Status:    getStatus(a.Status),
+				Disabled:  a.Disabled,
+			})
+		}
+	}
+
+	return agentsList
+}
+
+func rtaPostgresqlAgents(agentsRes *agents.ListAgentsOK, pmmAgentIDs map[string]struct{}) []listResultAgent {
+	var agentsList []listResultAgent
+
+	for _, a := range agentsRes.Payload.RtaPostgresqlAgent {
+		if _, ok := pmmAgentIDs[a.PMMAgentID]; ok {
+			agentsList = append(agentsList, listResultAgent{
+				AgentType: types.AgentTypeRTAPostgreSQLAgent,
+				AgentID:   a.AgentID,
+				ServiceID: a.ServiceID,
+				Status:    getStatus(a.Status),
+				Disabled:  a.Disabled,
+			})
+		}
+	}
+
+	return agentsList
+}
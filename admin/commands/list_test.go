@@ -1024,6 +1024,58 @@ func TestRtaMongodbAgents(t *testing.T) {
 	assert.Equal(t, types.AgentTypeRTAMongoDBAgent, result[0].AgentType)
 }
 
+func TestRtaMysqlAgents(t *testing.T) {
+	t.Parallel()
+
+	pmmAgentIDs := map[string]struct{}{
+		"pmm-agent-1": {},
+	}
+
+	agentsRes := &agents_service.ListAgentsOK{
+		Payload: &agents_service.ListAgentsOKBody{
+			RtaMysqlAgent: []*agents_service.ListAgentsOKBodyRtaMysqlAgentItems0{
+				{
+					AgentID:    "rta-mysql-1",
+					PMMAgentID: "pmm-agent-1",
+					ServiceID:  "mysql-service-1",
+					Status:     new("AGENT_STATUS_RUNNING"),
+				},
+			},
+		},
+	}
+
+	result := rtaMysqlAgents(agentsRes, pmmAgentIDs)
+
+	assert.Len(t, result, 1)
+	assert.Equal(t, types.AgentTypeRTAMySQLAgent, result[0].AgentType)
+}
+
+func TestRtaPostgresqlAgents(t *testing.T) {
+	t.Parallel()
+
+	pmmAgentIDs := map[string]struct{}{
+		"pmm-agent-1": {},
+	}
+
+	agentsRes := &agents_service.ListAgentsOK{
+		Payload: &agents_service.ListAgentsOKBody{
+			RtaPostgresqlAgent: []*agents_service.ListAgentsOKBodyRtaPostgresqlAgentItems0{
+				{
+					AgentID:    "rta-postgresql-1",
+					PMMAgentID: "pmm-agent-1",
+					ServiceID:  "postgresql-service-1",
+					Status:     new("AGENT_STATUS_RUNNING"),
+				},
+			},
+		},
+	}
+
+	result := rtaPostgresqlAgents(agentsRes, pmmAgentIDs)
+
+	assert.Len(t, result, 1)
+	assert.Equal(t, types.AgentTypeRTAPostgreSQLAgent, result[0].AgentType)
+}
+
 func TestGetStatus(t *testing.T) {
 	t.Parallel()
 
@@ -65,7 +65,7 @@ type AddPostgreSQLCommand struct {
 	NodeID            string `help:"Node ID (default is autodetected)"`
 	PMMAgentID        string `help:"The pmm-agent identifier which runs this instance (default is autodetected)"`
 	// TODO add "auto"
-	QuerySource            string            `default:"pgstatmonitor" help:"Source of SQL queries, one of: pgstatements, pgstatmonitor, none (default: pgstatmonitor)"`
+	QuerySource            string            `default:"pgstatmonitor" help:"Source of SQL queries, one of: pgstatements, pgstatmonitor, log, none (default: pgstatmonitor)"`
 	Environment            string            `help:"Environment name"`
 	Cluster                string            `help:"Cluster name"`
 	ReplicationSet         string            `help:"Replication set name"`
@@ -145,11 +145,15 @@ func (cmd *AddPostgreSQLCommand) RunCmd() (commands.Result, error) {
 
 	var usePgStatMonitor bool
 
+	var useLog bool
+
 	switch cmd.QuerySource {
 	case "pgstatements":
 		usePgStatements = true
 	case "pgstatmonitor":
 		usePgStatMonitor = true
+	case "log":
+		useLog = true
 	case "none":
 	}
 
@@ -202,6 +206,7 @@ func (cmd *AddPostgreSQLCommand) RunCmd() (commands.Result, error) {
 
 				QANPostgresqlPgstatementsAgent:  usePgStatements,
 				QANPostgresqlPgstatmonitorAgent: usePgStatMonitor,
+				QANPostgresqlLogAgent:           useLog,
 
 				TLS:           cmd.TLS,
 				TLSCa:         tlsCa,
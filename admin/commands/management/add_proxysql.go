@@ -32,7 +32,8 @@ Service name: {{ .Service.ServiceName }}
 `)
 
 type addProxySQLResult struct {
-	Service *mservice.AddServiceOKBodyProxysqlService `json:"service"`
+	Service           *mservice.AddServiceOKBodyProxysqlService           `json:"service"`
+	QANProxysqlDigest *mservice.AddServiceOKBodyProxysqlQANProxysqlDigest `json:"qan_proxysql_digest,omitempty"`
 }
 
 func (res *addProxySQLResult) Result() {}
@@ -66,6 +67,8 @@ type AddProxySQLCommand struct {
 	DisableCollectors   []string          `help:"Comma-separated list of collector names to exclude from exporter"`
 	ExposeExporter      bool              `name:"expose-exporter" help:"Optionally expose the address of the exporter publicly on 0.0.0.0"`
 	ConnectionTimeout   *time.Duration    `placeholder:"DURATION" help:"Connection timeout to use for exporter (e.g. 1s, 1.5s)"`
+	QuerySource         string            `default:"none" enum:"digest,none" help:"Source of queries for QAN, one of: digest, none (default: none)"`
+	MaxQueryLength      int32             `placeholder:"NUMBER" help:"Limit query length in QAN (default: server-defined; -1: no limit)"`
 }
 
 // GetServiceName returns the service name for AddProxySQLCommand.
@@ -156,6 +159,9 @@ func (cmd *AddProxySQLCommand) RunCmd() (commands.Result, error) {
 				DisableCollectors:   commands.ParseDisableCollectors(cmd.DisableCollectors),
 				LogLevel:            cmd.LogLevel.EnumValue(),
 				ConnectionTimeout:   commands.DurationString(cmd.ConnectionTimeout),
+
+				QANProxysqlDigest: cmd.QuerySource == "digest",
+				MaxQueryLength:    cmd.MaxQueryLength,
 			},
 		},
 		Context: commands.Ctx,
@@ -166,6 +172,7 @@ func (cmd *AddProxySQLCommand) RunCmd() (commands.Result, error) {
 	}
 
 	return &addProxySQLResult{
-		Service: resp.Payload.Proxysql.Service,
+		Service:           resp.Payload.Proxysql.Service,
+		QANProxysqlDigest: resp.Payload.Proxysql.QANProxysqlDigest,
 	}, nil
 }
@@ -33,8 +33,9 @@ Service name: {{ .Service.ServiceName }}
 `)
 
 type addValkeyResult struct {
-	Service        *mservice.AddServiceOKBodyValkeyService        `json:"service"`
-	ValkeyExporter *mservice.AddServiceOKBodyValkeyValkeyExporter `json:"valkey_exporter,omitempty"`
+	Service          *mservice.AddServiceOKBodyValkeyService          `json:"service"`
+	ValkeyExporter   *mservice.AddServiceOKBodyValkeyValkeyExporter   `json:"valkey_exporter,omitempty"`
+	QANValkeySlowlog *mservice.AddServiceOKBodyValkeyQANValkeySlowlog `json:"qan_valkey_slowlog,omitempty"`
 }
 
 func (res *addValkeyResult) Result() {}
@@ -49,27 +50,30 @@ type AddValkeyCommand struct {
 	flags.MetricsModeFlags
 	flags.LogLevelNoFatalFlags
 
-	ServiceName         string            `name:"name" arg:"" default:"${hostname}-valkey" help:"Service name (autodetected default: ${hostname}-valkey)"`
-	Address             string            `arg:"" optional:"" help:"Valkey address and port (default: 127.0.0.1:6379)"`
-	Socket              string            `help:"Path to Valkey socket"`
-	NodeID              string            `help:"Node ID (default is autodetected)"`
-	PMMAgentID          string            `help:"The pmm-agent identifier which runs this instance (default is autodetected)"`
-	Username            string            `help:"Valkey username"`
-	Password            string            `help:"Valkey password"`
-	AgentPassword       string            `help:"Custom password for /metrics endpoint"`
-	Environment         string            `help:"Environment name"`
-	Cluster             string            `help:"Cluster name"`
-	ReplicationSet      string            `help:"Replication set name"`
-	CustomLabels        map[string]string `mapsep:"," help:"Custom user-assigned labels"`
-	SkipConnectionCheck bool              `help:"Skip connection check"`
-	TLS                 bool              `help:"Use TLS to connect to the database"`
-	TLSSkipVerify       bool              `help:"Skip TLS certificates validation"`
-	TLSCaFile           string            `name:"tls-ca" help:"Path to certificate authority certificate file"`
-	TLSCertFile         string            `name:"tls-cert" help:"Path to client certificate file"`
-	TLSKeyFile          string            `name:"tls-key" help:"Path to client key file"`
-	DisableCollectors   []string          `help:"Comma-separated list of collector names to exclude from exporter"`
-	ExposeExporter      bool              `name:"expose-exporter" help:"Optionally expose the address of the exporter publicly on 0.0.0.0"`
-	ConnectionTimeout   *time.Duration    `placeholder:"DURATION" help:"Connection timeout to use for exporter (e.g. 1s, 1.5s)"`
+	ServiceName          string            `name:"name" arg:"" default:"${hostname}-valkey" help:"Service name (autodetected default: ${hostname}-valkey)"`
+	Address              string            `arg:"" optional:"" help:"Valkey address and port (default: 127.0.0.1:6379)"`
+	Socket               string            `help:"Path to Valkey socket"`
+	NodeID               string            `help:"Node ID (default is autodetected)"`
+	PMMAgentID           string            `help:"The pmm-agent identifier which runs this instance (default is autodetected)"`
+	Username             string            `help:"Valkey username"`
+	Password             string            `help:"Valkey password"`
+	AgentPassword        string            `help:"Custom password for /metrics endpoint"`
+	Environment          string            `help:"Environment name"`
+	Cluster              string            `help:"Cluster name"`
+	ReplicationSet       string            `help:"Replication set name"`
+	CustomLabels         map[string]string `mapsep:"," help:"Custom user-assigned labels"`
+	SkipConnectionCheck  bool              `help:"Skip connection check"`
+	TLS                  bool              `help:"Use TLS to connect to the database"`
+	TLSSkipVerify        bool              `help:"Skip TLS certificates validation"`
+	TLSCaFile            string            `name:"tls-ca" help:"Path to certificate authority certificate file"`
+	TLSCertFile          string            `name:"tls-cert" help:"Path to client certificate file"`
+	TLSKeyFile           string            `name:"tls-key" help:"Path to client key file"`
+	DisableCollectors    []string          `help:"Comma-separated list of collector names to exclude from exporter"`
+	ExposeExporter       bool              `name:"expose-exporter" help:"Optionally expose the address of the exporter publicly on 0.0.0.0"`
+	ConnectionTimeout    *time.Duration    `placeholder:"DURATION" help:"Connection timeout to use for exporter (e.g. 1s, 1.5s)"`
+	QuerySource          string            `default:"none" enum:"slowlog,none" help:"Source of commands for QAN, one of: slowlog, none (default: none)"`
+	MaxQueryLength       int32             `placeholder:"NUMBER" help:"Limit query length in QAN (default: server-defined; -1: no limit)"`
+	DisableQueryExamples bool              `name:"disable-queryexamples" help:"Disable collection of query examples"`
 }
 
 // GetServiceName returns the service name for AddValkeyCommand.
@@ -163,6 +167,10 @@ func (cmd *AddValkeyCommand) RunCmd() (commands.Result, error) {
 				MetricsMode:       cmd.MetricsMode.EnumValue(),
 				LogLevel:          cmd.LogLevel.EnumValue(),
 				ConnectionTimeout: commands.DurationString(cmd.ConnectionTimeout),
+
+				QANValkeySlowlog:     cmd.QuerySource == "slowlog",
+				MaxQueryLength:       cmd.MaxQueryLength,
+				DisableQueryExamples: cmd.DisableQueryExamples,
 			},
 		},
 		Context: commands.Ctx,
@@ -173,7 +181,8 @@ func (cmd *AddValkeyCommand) RunCmd() (commands.Result, error) {
 	}
 
 	return &addValkeyResult{
-		Service:        resp.Payload.Valkey.Service,
-		ValkeyExporter: resp.Payload.Valkey.ValkeyExporter,
+		Service:          resp.Payload.Valkey.Service,
+		ValkeyExporter:   resp.Payload.Valkey.ValkeyExporter,
+		QANValkeySlowlog: resp.Payload.Valkey.QANValkeySlowlog,
 	}, nil
 }
@@ -165,6 +165,15 @@ func (s *Server) Status(_ context.Context, req *agentlocal.StatusRequest) (*agen
 
 	agentsInfo := s.supervisor.AgentsList()
 
+	var qanSpoolInfo *agentlocal.QANSpoolInfo
+	if stats := s.client.GetQANSpoolStats(); stats != nil {
+		qanSpoolInfo = &agentlocal.QANSpoolInfo{
+			Depth:     uint32(stats.Depth), //nolint:gosec
+			SizeBytes: stats.Size,
+			Dropped:   stats.Dropped,
+		}
+	}
+
 	return &agentlocal.StatusResponse{
 		AgentId:          cfg.ID,
 		RunsOnNodeId:     md.AgentRunsOnNodeID,
@@ -174,6 +183,7 @@ func (s *Server) Status(_ context.Context, req *agentlocal.StatusRequest) (*agen
 		ConfigFilepath:   s.configFilepath,
 		AgentVersion:     version.Version,
 		ConnectionUptime: roundFloat(upTime, 2), //nolint:mnd
+		QanSpool:         qanSpoolInfo,
 	}, nil
 }
 
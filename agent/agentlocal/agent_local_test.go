@@ -29,6 +29,7 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 
 	"github.com/percona/pmm/agent/config"
+	"github.com/percona/pmm/agent/qanspool"
 	"github.com/percona/pmm/agent/tailog"
 	agentv1 "github.com/percona/pmm/api/agent/v1"
 	agentlocal "github.com/percona/pmm/api/agentlocal/v1"
@@ -53,6 +54,7 @@ func TestServerStatus(t *testing.T) {
 			ServerVersion:     "2.0.0-dev",
 		})
 		client.On("GetConnectionUpTime").Return(float32(100.00))
+		client.On("GetQANSpoolStats").Return(&qanspool.Stats{Depth: 2, Size: 1024, Dropped: 1})
 		cfgStorage := config.NewStorage(&config.Config{
 			ID: "00000000-0000-4000-8000-000000000001",
 			Server: config.Server{
@@ -85,6 +87,11 @@ func TestServerStatus(t *testing.T) {
 			AgentsInfo:       agentInfo,
 			ConnectionUptime: 100.00,
 			ConfigFilepath:   "/some/dir/pmm-agent.yaml",
+			QanSpool: &agentlocal.QANSpoolInfo{
+				Depth:     2,
+				SizeBytes: 1024,
+				Dropped:   1,
+			},
 		}
 		assert.Equal(t, expected, actual)
 	})
@@ -115,6 +122,11 @@ func TestServerStatus(t *testing.T) {
 			ConnectionUptime: 100.00,
 			AgentsInfo:       agentInfo,
 			ConfigFilepath:   "/some/dir/pmm-agent.yaml",
+			QanSpool: &agentlocal.QANSpoolInfo{
+				Depth:     2,
+				SizeBytes: 1024,
+				Dropped:   1,
+			},
 		}
 		assert.Equal(t, expected, actual)
 	})
@@ -144,6 +156,7 @@ func TestGetZipFile(t *testing.T) {
 			ServerVersion:     "2.0.0-dev",
 		})
 		client.On("GetConnectionUpTime").Return(float32(100.00))
+		client.On("GetQANSpoolStats").Return((*qanspool.Stats)(nil))
 
 		cfgStorage := config.NewStorage(&config.Config{
 			ID: "00000000-0000-4000-8000-000000000001",
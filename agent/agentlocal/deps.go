@@ -19,6 +19,7 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/percona/pmm/agent/qanspool"
 	agentv1 "github.com/percona/pmm/api/agent/v1"
 	agentlocal "github.com/percona/pmm/api/agentlocal/v1"
 )
@@ -31,6 +32,7 @@ type client interface {
 	// Collector added to use client as Prometheus collector
 	prometheus.Collector
 	GetConnectionUpTime() float32
+	GetQANSpoolStats() *qanspool.Stats
 }
 
 // supervisor is a subset of methods of supervisor.Supervisor used by this package.
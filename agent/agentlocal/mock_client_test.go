@@ -8,6 +8,7 @@ import (
 	prometheus "github.com/prometheus/client_golang/prometheus"
 	mock "github.com/stretchr/testify/mock"
 
+	qanspool "github.com/percona/pmm/agent/qanspool"
 	agentv1 "github.com/percona/pmm/api/agent/v1"
 )
 
@@ -79,6 +80,26 @@ func (_m *mockClient) GetNetworkInformation() (time.Duration, time.Duration, err
 	return r0, r1, r2
 }
 
+// GetQANSpoolStats provides a mock function with no fields
+func (_m *mockClient) GetQANSpoolStats() *qanspool.Stats {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetQANSpoolStats")
+	}
+
+	var r0 *qanspool.Stats
+	if rf, ok := ret.Get(0).(func() *qanspool.Stats); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*qanspool.Stats)
+		}
+	}
+
+	return r0
+}
+
 // GetServerConnectMetadata provides a mock function with no fields
 func (_m *mockClient) GetServerConnectMetadata() *agentv1.ServerConnectMetadata {
 	ret := _m.Called()
@@ -104,8 +125,7 @@ func (_m *mockClient) GetServerConnectMetadata() *agentv1.ServerConnectMetadata
 func newMockClient(t interface {
 	mock.TestingT
 	Cleanup(func())
-},
-) *mockClient {
+}) *mockClient {
 	mock := &mockClient{}
 	mock.Mock.Test(t)
 
@@ -0,0 +1,404 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package realtimeanalytics runs built-in Real-Time Analytics Agent for MySQL.
+package realtimeanalytics
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/reform.v1"
+	mysqlDialects "gopkg.in/reform.v1/dialects/mysql"
+
+	"github.com/percona/pmm/agent/agents"
+	"github.com/percona/pmm/agent/tlshelpers"
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
+	rtav1 "github.com/percona/pmm/api/realtimeanalytics/v1"
+	"github.com/percona/pmm/utils/sqlmetrics"
+)
+
+const (
+	changesBufferSize = 10
+
+	queryTag = "agent='rta-mysql'"
+
+	sourcePerformanceSchema = "performance_schema"
+	sourceProcesslist       = "processlist"
+
+	// minExecutionTime is the minimal execution time of the query to be reported.
+	minExecutionTime = 10 * time.Millisecond
+)
+
+// Performance Schema timers are measured in picoseconds.
+const picosecondsInNanosecond = 1000
+
+// MySQL error codes returned when Performance Schema tables can't be read by the agent user.
+const (
+	erDBAccessDenied       = 1044
+	erTableAccessDenied    = 1142
+	erNoSuchTable          = 1146
+	erSpecificAccessDenied = 1227
+)
+
+// perfschemaQuery fetches currently running statements from Performance Schema.
+// It skips the connection of the RTA agent itself, idle connections and replication threads.
+var perfschemaQuery = fmt.Sprintf(`SELECT /* %s */
+	t.PROCESSLIST_ID,
+	t.THREAD_ID,
+	e.EVENT_ID,
+	IFNULL(t.PROCESSLIST_USER, ''),
+	IFNULL(t.PROCESSLIST_HOST, ''),
+	IFNULL(t.PROCESSLIST_DB, ''),
+	IFNULL(t.PROCESSLIST_COMMAND, ''),
+	IFNULL(t.PROCESSLIST_STATE, ''),
+	IFNULL(e.DIGEST, ''),
+	e.SQL_TEXT,
+	IFNULL(e.TIMER_WAIT, 0),
+	IFNULL(e.LOCK_TIME, 0),
+	IFNULL(e.ROWS_EXAMINED, 0),
+	IFNULL(e.ROWS_SENT, 0)
+FROM performance_schema.events_statements_current e
+JOIN performance_schema.threads t ON t.THREAD_ID = e.THREAD_ID
+WHERE t.PROCESSLIST_ID IS NOT NULL
+	AND t.PROCESSLIST_ID <> CONNECTION_ID()
+	AND t.PROCESSLIST_COMMAND NOT IN ('Sleep', 'Daemon', 'Binlog Dump', 'Binlog Dump GTID')
+	AND e.END_EVENT_ID IS NULL
+	AND e.SQL_TEXT IS NOT NULL
+	AND e.TIMER_WAIT >= ?`, queryTag)
+
+// perfschemaEnabledQuery checks whether Performance Schema is enabled.
+// Performance Schema tables are empty rather than missing when it is disabled.
+var perfschemaEnabledQuery = fmt.Sprintf(`SELECT /* %s */ @@performance_schema`, queryTag)
+
+// processlistQuery fetches currently running statements from the processlist.
+// It is used when Performance Schema is disabled or not accessible.
+var processlistQuery = fmt.Sprintf(`SELECT /* %s */
+	ID,
+	IFNULL(USER, ''),
+	IFNULL(HOST, ''),
+	IFNULL(DB, ''),
+	IFNULL(COMMAND, ''),
+	IFNULL(STATE, ''),
+	INFO,
+	TIME
+FROM information_schema.PROCESSLIST
+WHERE ID <> CONNECTION_ID()
+	AND COMMAND NOT IN ('Sleep', 'Daemon', 'Binlog Dump', 'Binlog Dump GTID')
+	AND INFO IS NOT NULL`, queryTag)
+
+// MySQLRTA extracts Real-Time Analytics data (currently running DB queries) from MySQL.
+type MySQLRTA struct {
+	agentID     string
+	serviceID   string
+	serviceName string
+	l           *logrus.Entry
+
+	// Channel to obtain data from this agent.
+	changes chan agents.Change
+
+	q        *reform.Querier
+	dbCloser io.Closer
+	// collectInterval is how often to collect data from MySQL.
+	collectInterval time.Duration
+	// usePerfschema is false once Performance Schema turned out to be disabled or not accessible,
+	// in that case the processlist is used.
+	usePerfschema bool
+	// perfschemaChecked is true once perfschemaEnabledQuery succeeded.
+	perfschemaChecked bool
+	// processlistStatements contains statements seen during the previous processlist collection by connection ID.
+	processlistStatements map[uint64]processlistStatement
+}
+
+// processlistStatement is a statement seen in the processlist.
+type processlistStatement struct {
+	queryID string
+	info    string
+	seconds int64
+}
+
+// Params represent Agent parameters.
+type Params struct {
+	AgentID         string
+	DSN             string        // DSN to connect to MySQL.
+	ServiceID       string        // ServiceID shall be set in RTA queries to link them to the service.
+	ServiceName     string        // ServiceName shall be set in RTA queries to link them to the service.
+	CollectInterval time.Duration // CollectInterval is how often to collect data from MySQL.
+	TextFiles       *agentv1.TextFiles
+	TLSSkipVerify   bool
+}
+
+// New creates new MySQLRTA service.
+func New(params *Params, l *logrus.Entry) (*MySQLRTA, error) {
+	if params.TextFiles != nil {
+		err := tlshelpers.RegisterMySQLCerts(params.TextFiles.Files, params.TLSSkipVerify)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sqlDB, err := sql.Open("mysql", params.DSN)
+	if err != nil {
+		return nil, err
+	}
+	sqlDB.SetMaxIdleConns(1)
+	sqlDB.SetMaxOpenConns(1)
+	sqlDB.SetConnMaxLifetime(0)
+	reformL := sqlmetrics.NewReform("mysql", params.AgentID, l.Tracef)
+	q := reform.NewDB(sqlDB, mysqlDialects.Dialect, reformL).WithTag(queryTag)
+
+	return newMySQLRTA(params, q, sqlDB, l), nil
+}
+
+func newMySQLRTA(params *Params, q *reform.Querier, dbCloser io.Closer, l *logrus.Entry) *MySQLRTA {
+	return &MySQLRTA{
+		agentID:         params.AgentID,
+		serviceID:       params.ServiceID,
+		serviceName:     params.ServiceName,
+		l:               l,
+		changes:         make(chan agents.Change, changesBufferSize),
+		q:               q,
+		dbCloser:        dbCloser,
+		collectInterval: params.CollectInterval,
+		usePerfschema:   true,
+	}
+}
+
+// Run extracts currently running DB queries from MySQL
+// and sends it to the channel until ctx is canceled.
+func (m *MySQLRTA) Run(ctx context.Context) {
+	m.l.Info("Starting MySQL RTA agent")
+
+	m.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_STARTING}
+
+	defer func() {
+		err := m.dbCloser.Close()
+		if err != nil {
+			m.l.WithError(err).Error("Failed to close database connection")
+		}
+
+		m.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_DONE}
+
+		close(m.changes)
+	}()
+
+	m.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_RUNNING}
+	// fetch RTA data periodically
+	ticker := time.NewTicker(m.collectInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			m.l.Info("Stopping MySQL RTA agent")
+
+			m.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_STOPPING}
+			// m.changes channel will be closed in defer, so we don't need to close it here, just exit the function
+			return
+		case <-ticker.C:
+			// Unlike MongoDB agent we collect data synchronously: there is only one connection to MySQL,
+			// so concurrent collections would be serialized by the connection pool anyway.
+			rtaQueryBucket, err := m.collect(ctx)
+			if err != nil {
+				m.l.Warnf("Current queries collection failed: %v", err)
+				continue
+			}
+
+			if len(rtaQueryBucket) != 0 {
+				m.changes <- agents.Change{RTAQueriesBucket: rtaQueryBucket}
+			}
+		}
+	}
+}
+
+// collect fetches currently running queries from Performance Schema
+// and falls back to the processlist if Performance Schema is disabled or not accessible.
+// Other errors are returned as is, Performance Schema is queried again on the next call.
+func (m *MySQLRTA) collect(ctx context.Context) ([]*rtav1.QueryData, error) {
+	if m.usePerfschema && !m.perfschemaChecked {
+		var enabled bool
+		err := m.q.QueryRowContext(ctx, perfschemaEnabledQuery).Scan(&enabled)
+		if err != nil {
+			return nil, err
+		}
+		m.perfschemaChecked = true
+
+		if !enabled {
+			m.l.Warn("Performance Schema is disabled, falling back to processlist.")
+			m.usePerfschema = false
+		}
+	}
+
+	if m.usePerfschema {
+		res, err := m.collectPerfschema(ctx)
+		if err == nil || !isPerfschemaUnavailable(err) {
+			return res, err
+		}
+
+		m.l.Warnf("Performance Schema is not accessible, falling back to processlist: %v", err)
+		m.usePerfschema = false
+	}
+
+	return m.collectProcesslist(ctx)
+}
+
+// isPerfschemaUnavailable returns true if err means that Performance Schema tables can't be read
+// by the agent user, so there is no point in querying them again.
+func isPerfschemaUnavailable(err error) bool {
+	var mysqlErr *mysql.MySQLError
+	if !errors.As(err, &mysqlErr) {
+		return false
+	}
+
+	switch mysqlErr.Number {
+	case erDBAccessDenied, erTableAccessDenied, erNoSuchTable, erSpecificAccessDenied:
+		return true
+	default:
+		return false
+	}
+}
+
+// collectPerfschema fetches currently running queries from Performance Schema.
+func (m *MySQLRTA) collectPerfschema(ctx context.Context) ([]*rtav1.QueryData, error) {
+	rows, err := m.q.QueryContext(ctx, perfschemaQuery, minExecutionTime.Nanoseconds()*picosecondsInNanosecond)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	currTime := timestamppb.Now()
+
+	var res []*rtav1.QueryData
+	for rows.Next() {
+		var (
+			d         rtav1.QueryMySQLData
+			host      string
+			sqlText   string
+			timerWait uint64
+			lockTime  uint64
+		)
+		err = rows.Scan(&d.ConnectionId, &d.ThreadId, &d.EventId, &d.Username, &host, &d.DatabaseName, &d.Command,
+			&d.State, &d.Digest, &sqlText, &timerWait, &lockTime, &d.RowsExamined, &d.RowsSent)
+		if err != nil {
+			return nil, err
+		}
+
+		d.LockTime = durationpb.New(time.Duration(lockTime / picosecondsInNanosecond))
+		d.Source = sourcePerformanceSchema
+
+		// Thread and event IDs identify the statement, unlike the connection ID which is reused by the next statement.
+		queryID := fmt.Sprintf("%d-%d", d.ThreadId, d.EventId)
+		executionDuration := time.Duration(timerWait / picosecondsInNanosecond)
+		res = append(res, m.newQueryData(&d, queryID, sqlText, host, executionDuration, currTime))
+	}
+
+	return res, rows.Err()
+}
+
+// collectProcesslist fetches currently running queries from the processlist.
+func (m *MySQLRTA) collectProcesslist(ctx context.Context) ([]*rtav1.QueryData, error) {
+	rows, err := m.q.QueryContext(ctx, processlistQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	currTime := timestamppb.Now()
+
+	statements := make(map[uint64]processlistStatement)
+	var res []*rtav1.QueryData
+	for rows.Next() {
+		var (
+			d       rtav1.QueryMySQLData
+			host    string
+			info    string
+			seconds int64
+		)
+		err = rows.Scan(&d.ConnectionId, &d.Username, &host, &d.DatabaseName, &d.Command, &d.State, &info, &seconds)
+		if err != nil {
+			return nil, err
+		}
+
+		d.Source = sourceProcesslist
+
+		// The processlist has no statement identifier, so connection ID and start time are used.
+		// TIME has one second resolution, so the start time of the statement seen during
+		// the previous collection is kept to get the same ID for it.
+		queryID := fmt.Sprintf("%d-%d", d.ConnectionId, currTime.AsTime().Unix()-seconds)
+		if prev, ok := m.processlistStatements[d.ConnectionId]; ok && prev.info == info && prev.seconds <= seconds {
+			queryID = prev.queryID
+		}
+		statements[d.ConnectionId] = processlistStatement{queryID: queryID, info: info, seconds: seconds}
+
+		res = append(res, m.newQueryData(&d, queryID, info, host, time.Duration(seconds)*time.Second, currTime))
+	}
+	if err = rows.Err(); err != nil {
+		return nil, err
+	}
+
+	m.processlistStatements = statements
+
+	return res, nil
+}
+
+// newQueryData wraps MySQL specific data into QueryData.
+func (m *MySQLRTA) newQueryData(
+	d *rtav1.QueryMySQLData,
+	queryID string,
+	queryText string,
+	clientAddress string,
+	executionDuration time.Duration,
+	collectTime *timestamppb.Timestamp,
+) *rtav1.QueryData {
+	return &rtav1.QueryData{
+		ServiceId:              m.serviceID,
+		ServiceName:            m.serviceName,
+		QueryId:                queryID,
+		QueryText:              queryText,
+		QueryExecutionDuration: durationpb.New(executionDuration.Round(10 * time.Millisecond)), //nolint:mnd
+		QueryCollectTime:       collectTime,
+		ClientAddress:          clientAddress,
+		Payload:                &rtav1.QueryData_MysqlPayload{MysqlPayload: d},
+	}
+}
+
+// Changes returns channel that should be read until it is closed.
+func (m *MySQLRTA) Changes() <-chan agents.Change {
+	return m.changes
+}
+
+// Describe implements prometheus.Collector.
+func (m *MySQLRTA) Describe(_ chan<- *prometheus.Desc) {
+	// This method is needed to satisfy interface.
+}
+
+// Collect implement prometheus.Collector.
+func (m *MySQLRTA) Collect(_ chan<- prometheus.Metric) {
+	// This method is needed to satisfy interface.
+}
+
+// check interfaces.
+var (
+	_ prometheus.Collector = (*MySQLRTA)(nil)
+)
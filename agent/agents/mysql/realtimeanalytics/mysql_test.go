@@ -0,0 +1,192 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package realtimeanalytics
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/go-sql-driver/mysql"
+	"gopkg.in/reform.v1"
+	mysqlDialects "gopkg.in/reform.v1/dialects/mysql"
+
+	rtav1 "github.com/percona/pmm/api/realtimeanalytics/v1"
+)
+
+func setup(t *testing.T) (*MySQLRTA, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	q := reform.NewDB(sqlDB, mysqlDialects.Dialect, reform.NewPrintfLogger(t.Logf)).WithTag(queryTag)
+	m := newMySQLRTA(&Params{
+		AgentID:         "agent-id",
+		ServiceID:       "service-id",
+		ServiceName:     "service-name",
+		CollectInterval: time.Second,
+	}, q, sqlDB, logrus.WithField("test", t.Name()))
+
+	return m, mock
+}
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+
+	perfschemaColumns := []string{
+		"PROCESSLIST_ID", "THREAD_ID", "EVENT_ID", "PROCESSLIST_USER", "PROCESSLIST_HOST", "PROCESSLIST_DB",
+		"PROCESSLIST_COMMAND", "PROCESSLIST_STATE", "DIGEST", "SQL_TEXT", "TIMER_WAIT", "LOCK_TIME",
+		"ROWS_EXAMINED", "ROWS_SENT",
+	}
+	processlistColumns := []string{"ID", "USER", "HOST", "DB", "COMMAND", "STATE", "INFO", "TIME"}
+
+	t.Run("PerformanceSchema", func(t *testing.T) {
+		t.Parallel()
+
+		m, mock := setup(t)
+		mock.ExpectQuery(regexp.QuoteMeta("@@performance_schema")).
+			WillReturnRows(sqlmock.NewRows([]string{"@@performance_schema"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM performance_schema.events_statements_current")).
+			WithArgs(int64(10_000_000_000)).
+			WillReturnRows(sqlmock.NewRows(perfschemaColumns).
+				AddRow(42, 81, 1234, "app", "10.0.0.1:5060", "world", "Query", "executing", "abcdef",
+					"SELECT SLEEP(10)", uint64(2_503_000_000_000), uint64(1_000_000), 10, 1))
+
+		res, err := m.collect(t.Context())
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+
+		q := res[0]
+		assert.Equal(t, "service-id", q.ServiceId)
+		assert.Equal(t, "service-name", q.ServiceName)
+		assert.Equal(t, "81-1234", q.QueryId)
+		assert.Equal(t, "SELECT SLEEP(10)", q.QueryText)
+		assert.Equal(t, "10.0.0.1:5060", q.ClientAddress)
+		assert.Equal(t, 2500*time.Millisecond, q.QueryExecutionDuration.AsDuration())
+		assert.NotNil(t, q.QueryCollectTime)
+
+		d := q.GetMysqlPayload()
+		require.NotNil(t, d)
+		assert.Equal(t, uint64(42), d.ConnectionId)
+		assert.Equal(t, uint64(81), d.ThreadId)
+		assert.Equal(t, uint64(1234), d.EventId)
+		assert.Equal(t, "app", d.Username)
+		assert.Equal(t, "world", d.DatabaseName)
+		assert.Equal(t, "Query", d.Command)
+		assert.Equal(t, "executing", d.State)
+		assert.Equal(t, "abcdef", d.Digest)
+		assert.Equal(t, uint64(10), d.RowsExamined)
+		assert.Equal(t, uint64(1), d.RowsSent)
+		assert.Equal(t, time.Microsecond, d.LockTime.AsDuration())
+		assert.Equal(t, sourcePerformanceSchema, d.Source)
+		assert.True(t, m.usePerfschema)
+	})
+
+	t.Run("ProcesslistFallback", func(t *testing.T) {
+		t.Parallel()
+
+		m, mock := setup(t)
+		mock.ExpectQuery(regexp.QuoteMeta("@@performance_schema")).
+			WillReturnRows(sqlmock.NewRows([]string{"@@performance_schema"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM performance_schema.events_statements_current")).
+			WillReturnError(&mysql.MySQLError{Number: 1142, Message: "SELECT command denied"})
+		mock.ExpectQuery(regexp.QuoteMeta("FROM information_schema.PROCESSLIST")).
+			WillReturnRows(sqlmock.NewRows(processlistColumns).
+				AddRow(7, "app", "10.0.0.2:5061", "", "Query", "Sending data", "SELECT * FROM t", 3))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM information_schema.PROCESSLIST")).
+			WillReturnRows(sqlmock.NewRows(processlistColumns).
+				AddRow(7, "app", "10.0.0.2:5061", "", "Query", "Sending data", "SELECT * FROM t", 4))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM information_schema.PROCESSLIST")).
+			WillReturnRows(sqlmock.NewRows(processlistColumns).
+				AddRow(7, "app", "10.0.0.2:5061", "", "Query", "Sending data", "SELECT * FROM t", 0))
+
+		res, err := m.collect(t.Context())
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.False(t, m.usePerfschema)
+
+		expected := &rtav1.QueryMySQLData{
+			ConnectionId: 7,
+			Username:     "app",
+			Command:      "Query",
+			State:        "Sending data",
+			Source:       sourceProcesslist,
+		}
+		queryID := res[0].QueryId
+		assert.Regexp(t, `^7-\d+$`, queryID)
+		assert.Equal(t, "SELECT * FROM t", res[0].QueryText)
+		assert.Equal(t, 3*time.Second, res[0].QueryExecutionDuration.AsDuration())
+		assert.Equal(t, expected.String(), res[0].GetMysqlPayload().String())
+
+		// Performance Schema is not queried again once it turned out to be unavailable,
+		// the same statement keeps its ID.
+		res, err = m.collect(t.Context())
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, queryID, res[0].QueryId)
+
+		// The same statement started again on the same connection gets a new ID.
+		res, err = m.collect(t.Context())
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.NotEqual(t, queryID, res[0].QueryId)
+	})
+
+	t.Run("PerformanceSchemaDisabled", func(t *testing.T) {
+		t.Parallel()
+
+		m, mock := setup(t)
+		mock.ExpectQuery(regexp.QuoteMeta("@@performance_schema")).
+			WillReturnRows(sqlmock.NewRows([]string{"@@performance_schema"}).AddRow(0))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM information_schema.PROCESSLIST")).
+			WillReturnRows(sqlmock.NewRows(processlistColumns))
+
+		res, err := m.collect(t.Context())
+		require.NoError(t, err)
+		assert.Empty(t, res)
+		assert.False(t, m.usePerfschema)
+	})
+
+	t.Run("TransientError", func(t *testing.T) {
+		t.Parallel()
+
+		m, mock := setup(t)
+		mock.ExpectQuery(regexp.QuoteMeta("@@performance_schema")).
+			WillReturnRows(sqlmock.NewRows([]string{"@@performance_schema"}).AddRow(1))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM performance_schema.events_statements_current")).
+			WillReturnError(errors.New("invalid connection"))
+		mock.ExpectQuery(regexp.QuoteMeta("FROM performance_schema.events_statements_current")).
+			WillReturnRows(sqlmock.NewRows(perfschemaColumns))
+
+		_, err := m.collect(t.Context())
+		require.Error(t, err)
+		assert.True(t, m.usePerfschema)
+
+		// Performance Schema is queried again on the next call.
+		res, err := m.collect(t.Context())
+		require.NoError(t, err)
+		assert.Empty(t, res)
+		assert.True(t, m.usePerfschema)
+	})
+}
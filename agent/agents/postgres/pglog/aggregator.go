@@ -0,0 +1,200 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pglog
+
+import (
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/percona/pmm/agent/queryparser"
+)
+
+// classKey identifies a query class, it matches QAN dimensions.
+type classKey struct {
+	queryID    string
+	database   string
+	user       string
+	clientHost string
+}
+
+// class contains aggregated executions of a single query class.
+type class struct {
+	classKey
+	fingerprint     string
+	query           string
+	application     string
+	durations       []float64
+	example         string
+	exampleDuration float64
+	plan            string
+	planDuration    float64
+}
+
+// aggregator groups events by query class.
+type aggregator struct {
+	// countPlans is true when auto_explain output is the only source of executions;
+	// otherwise, it is used for plans only, and executions are counted by log_min_duration_statement output.
+	countPlans bool
+	classes    map[classKey]*class
+}
+
+func newAggregator(countPlans bool) *aggregator {
+	return &aggregator{
+		countPlans: countPlans,
+		classes:    make(map[classKey]*class),
+	}
+}
+
+// add adds event to its query class.
+func (a *aggregator) add(e *event) {
+	fingerprint := fingerprint(e.query)
+	queryID := e.queryID
+	if queryID == "" || queryID == "0" {
+		// query identifier is logged only when compute_query_id is enabled
+		queryID = hash(fingerprint)
+	}
+
+	key := classKey{
+		queryID:    queryID,
+		database:   e.database,
+		user:       e.user,
+		clientHost: e.clientHost,
+	}
+	c := a.classes[key]
+	if c == nil {
+		c = &class{
+			classKey:    key,
+			fingerprint: fingerprint,
+			query:       e.query,
+		}
+		a.classes[key] = c
+	}
+
+	if e.plan != "" && e.duration >= c.planDuration {
+		c.plan, c.planDuration = e.plan, e.duration
+	}
+
+	if e.fromPlan && !a.countPlans {
+		return
+	}
+
+	c.durations = append(c.durations, e.duration)
+	c.application = e.application
+	if e.duration >= c.exampleDuration {
+		c.example, c.exampleDuration = e.example, e.duration
+	}
+}
+
+// fingerprint returns query with constants replaced by placeholders.
+func fingerprint(query string) string {
+	res, err := queryparser.PostgreSQLFingerprint(query)
+	if err != nil {
+		// query may be truncated or use syntax of the newer version, use it as is
+		return strings.Join(strings.Fields(query), " ")
+	}
+	return res
+}
+
+// hash returns upper-case MD5 hash of the string. MD5 is used only for identifiers, so there is no risk.
+func hash(s string) string {
+	h := md5.Sum([]byte(s)) //nolint:gosec
+	return strings.ToUpper(hex.EncodeToString(h[:]))
+}
+
+var (
+	// node costs, row estimates, and timings in text plans.
+	planStatsRE = regexp.MustCompile(`\s*\((?:cost=|actual |never executed)[^)]*\)`)
+
+	// text plan lines with execution statistics only.
+	planStatsLines = []string{
+		"Planning Time:", "Execution Time:", "Planning:", "Buffers:", "I/O Timings:", "WAL:",
+		"Rows Removed by", "Heap Fetches:", "Heap Blocks:", "Sort Method:", "Memory Usage:",
+		"Batches:", "Buckets:", "Worker ", "Workers Launched:", "JIT:", "Functions:", "Options:", "Timing:",
+	}
+
+	// JSON plan properties describing plan shape.
+	planShapeKeys = []string{
+		"Node Type", "Parent Relationship", "Join Type", "Strategy", "Scan Direction",
+		"Relation Name", "Schema", "Alias", "Index Name", "CTE Name", "Function Name",
+		"Filter", "Index Cond", "Recheck Cond", "Hash Cond", "Merge Cond", "Join Filter",
+		"Sort Key", "Group Key", "Output", "Subplan Name",
+	}
+)
+
+// planID returns identifier of the plan shape: plans that differ only by costs and execution statistics have the same ID.
+func planID(plan string) string {
+	if plan == "" {
+		return ""
+	}
+	return hash(normalizePlan(plan))
+}
+
+// normalizePlan removes costs and execution statistics from the plan in text or JSON format.
+func normalizePlan(plan string) string {
+	if strings.HasPrefix(strings.TrimSpace(plan), "{") {
+		var p map[string]any
+		if err := json.Unmarshal([]byte(plan), &p); err == nil {
+			if node, ok := p["Plan"].(map[string]any); ok {
+				b, _ := json.Marshal(planShape(node))
+				return string(b)
+			}
+		}
+	}
+
+	var sb strings.Builder
+	for line := range strings.Lines(plan) {
+		line = strings.TrimRight(line, " \r\n")
+		trimmed := strings.TrimLeft(line, " ->")
+		skip := trimmed == ""
+		for _, p := range planStatsLines {
+			if strings.HasPrefix(trimmed, p) {
+				skip = true
+				break
+			}
+		}
+		if skip {
+			continue
+		}
+
+		sb.WriteString(planStatsRE.ReplaceAllString(line, ""))
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+// planShape returns JSON plan node with shape properties only.
+func planShape(node map[string]any) map[string]any {
+	res := make(map[string]any)
+	for _, k := range planShapeKeys {
+		if v, ok := node[k]; ok {
+			res[k] = v
+		}
+	}
+
+	if plans, ok := node["Plans"].([]any); ok {
+		children := make([]any, 0, len(plans))
+		for _, p := range plans {
+			if child, ok := p.(map[string]any); ok {
+				children = append(children, planShape(child))
+			}
+		}
+		res["Plans"] = children
+	}
+
+	return res
+}
@@ -0,0 +1,514 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pglog
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Supported values of log_destination.
+const (
+	formatStderr  = "stderr"
+	formatCSVLog  = "csvlog"
+	formatJSONLog = "jsonlog"
+)
+
+// logEntry is a single server log message with its session information.
+type logEntry struct {
+	user        string
+	database    string
+	clientHost  string
+	application string
+	queryID     string
+	severity    string
+	message     string
+	detail      string
+}
+
+// entryParser extracts log entries from the lines of the server log.
+type entryParser interface {
+	// feed parses the next line and returns entries completed by it.
+	feed(line string) []*logEntry
+	// flush returns the entry that is still being parsed, if any.
+	flush() []*logEntry
+}
+
+// newEntryParser returns parser for given log format.
+// Line prefix is used for stderr format only.
+func newEntryParser(format, linePrefix string) (entryParser, error) {
+	switch format {
+	case formatStderr:
+		return newStderrParser(linePrefix)
+	case formatCSVLog:
+		return &csvParser{}, nil
+	case formatJSONLog:
+		return &jsonParser{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported log format %q", format)
+	}
+}
+
+// stderrParser parses plain text log with lines starting with log_line_prefix.
+// Message continuation lines start with a tab, auxiliary lines like DETAIL have their own prefix,
+// so the entry is complete only when the next message starts.
+type stderrParser struct {
+	re      *regexp.Regexp
+	current *logEntry
+	cont    *string // field continuation lines are appended to
+}
+
+func newStderrParser(linePrefix string) (*stderrParser, error) {
+	re, err := prefixRegexp(linePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("cannot parse log_line_prefix %q: %w", linePrefix, err)
+	}
+
+	return &stderrParser{re: re}, nil
+}
+
+func (p *stderrParser) feed(line string) []*logEntry {
+	line = strings.TrimRight(line, "\r\n")
+	if line == "" {
+		return nil
+	}
+
+	if strings.HasPrefix(line, "\t") {
+		if p.cont != nil {
+			*p.cont += "\n" + line[1:]
+		}
+		return nil
+	}
+
+	m := p.re.FindStringSubmatch(line)
+	if m == nil {
+		// not a log line, for example, output of archive_command
+		return nil
+	}
+
+	group := func(name string) string {
+		if i := p.re.SubexpIndex(name); i >= 0 {
+			return strings.TrimSpace(m[i])
+		}
+		return ""
+	}
+
+	severity, message := group("severity"), m[p.re.SubexpIndex("message")]
+	if p.current != nil {
+		switch severity {
+		case "DETAIL":
+			p.current.detail = message
+			p.cont = &p.current.detail
+			return nil
+		case "HINT", "CONTEXT", "STATEMENT", "QUERY", "LOCATION":
+			p.cont = nil
+			return nil
+		}
+	}
+
+	res := p.flush()
+	p.current = &logEntry{
+		user:        group("user"),
+		database:    group("database"),
+		clientHost:  group("host"),
+		application: group("application"),
+		queryID:     group("queryid"),
+		severity:    severity,
+		message:     message,
+	}
+	p.cont = &p.current.message
+	return res
+}
+
+func (p *stderrParser) flush() []*logEntry {
+	if p.current == nil {
+		return nil
+	}
+
+	res := []*logEntry{p.current}
+	p.current, p.cont = nil, nil
+	return res
+}
+
+// prefixRegexp converts log_line_prefix to the regular expression matching the whole log line.
+// See https://www.postgresql.org/docs/current/runtime-config-logging.html#GUC-LOG-LINE-PREFIX.
+func prefixRegexp(linePrefix string) (*regexp.Regexp, error) {
+	const timestamp = `\d{4}-\d{2}-\d{2} \d{2}:\d{2}:\d{2}(?:\.\d+)? \S+`
+
+	var sb strings.Builder
+	sb.WriteString("^")
+
+	seen := make(map[string]bool)
+	group := func(name, expr string) string {
+		if seen[name] {
+			return "(?:" + expr + ")"
+		}
+		seen[name] = true
+		return "(?P<" + name + ">" + expr + ")"
+	}
+
+	var optional bool
+	for i := 0; i < len(linePrefix); i++ {
+		c := linePrefix[i]
+		if c != '%' || i == len(linePrefix)-1 {
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+			continue
+		}
+
+		// skip padding, values are trimmed later
+		i++
+		padded := false
+		for i < len(linePrefix)-1 && (linePrefix[i] == '-' || (linePrefix[i] >= '0' && linePrefix[i] <= '9')) {
+			padded = true
+			i++
+		}
+		if padded {
+			sb.WriteString(`\s*`)
+		}
+
+		switch linePrefix[i] {
+		case 'a':
+			sb.WriteString(group("application", `.*?`))
+		case 'u':
+			sb.WriteString(group("user", `.*?`))
+		case 'd':
+			sb.WriteString(group("database", `.*?`))
+		case 'r':
+			sb.WriteString(group("host", `[^\s(]*`) + `(?:\(\d+\))?`)
+		case 'h':
+			sb.WriteString(group("host", `\S*`))
+		case 'Q':
+			sb.WriteString(group("queryid", `-?\d+`))
+		case 'p', 'l', 'x':
+			sb.WriteString(`\d+`)
+		case 'P':
+			sb.WriteString(`\d*`)
+		case 't', 'm', 's':
+			sb.WriteString(timestamp)
+		case 'n':
+			sb.WriteString(`\d+\.\d+`)
+		case 'c':
+			sb.WriteString(`[0-9a-f]+\.[0-9a-f]+`)
+		case 'v':
+			sb.WriteString(`[0-9/-]*`)
+		case 'e':
+			sb.WriteString(`[0-9A-Z]{5}`)
+		case 'q':
+			// the rest is printed only for session processes
+			sb.WriteString("(?:")
+			optional = true
+		case '%':
+			sb.WriteString("%")
+		default:
+			sb.WriteString(`.*?`)
+		}
+
+		if padded {
+			sb.WriteString(`\s*`)
+		}
+	}
+	if optional {
+		sb.WriteString(")?")
+	}
+
+	sb.WriteString(`(?P<severity>[A-Z0-9]+):\s+(?P<message>.*)$`)
+	return regexp.Compile(sb.String())
+}
+
+// Columns of csvlog format.
+// See https://www.postgresql.org/docs/current/runtime-config-logging.html#RUNTIME-CONFIG-LOGGING-CSVLOG.
+const (
+	csvUserName        = 1
+	csvDatabaseName    = 2
+	csvConnectionFrom  = 4
+	csvErrorSeverity   = 11
+	csvMessage         = 13
+	csvDetail          = 14
+	csvApplicationName = 22
+	csvQueryID         = 25 // since PostgreSQL 14
+)
+
+// csvParser parses csvlog format.
+type csvParser struct {
+	buf strings.Builder
+}
+
+func (p *csvParser) feed(line string) []*logEntry {
+	p.buf.WriteString(line)
+
+	// quoted fields may contain line breaks, so the record is complete only when all quotes are closed
+	if !strings.HasSuffix(line, "\n") || strings.Count(p.buf.String(), `"`)%2 != 0 {
+		return nil
+	}
+
+	return p.flush()
+}
+
+func (p *csvParser) flush() []*logEntry {
+	record := p.buf.String()
+	p.buf.Reset()
+	if strings.TrimSpace(record) == "" {
+		return nil
+	}
+
+	r := csv.NewReader(strings.NewReader(record))
+	r.FieldsPerRecord = -1
+	fields, err := r.Read()
+	if err != nil || len(fields) <= csvApplicationName {
+		return nil
+	}
+
+	e := &logEntry{
+		user:        fields[csvUserName],
+		database:    fields[csvDatabaseName],
+		clientHost:  stripPort(fields[csvConnectionFrom]),
+		application: fields[csvApplicationName],
+		severity:    fields[csvErrorSeverity],
+		message:     fields[csvMessage],
+		detail:      fields[csvDetail],
+	}
+	if len(fields) > csvQueryID {
+		e.queryID = fields[csvQueryID]
+	}
+
+	return []*logEntry{e}
+}
+
+// stripPort removes port from host:port string written to csvlog.
+func stripPort(s string) string {
+	i := strings.LastIndexByte(s, ':')
+	if i < 0 {
+		return s
+	}
+	if _, err := strconv.Atoi(s[i+1:]); err != nil {
+		return s
+	}
+	return s[:i]
+}
+
+// jsonRecord contains used fields of jsonlog format.
+// See https://www.postgresql.org/docs/current/runtime-config-logging.html#RUNTIME-CONFIG-LOGGING-JSONLOG.
+type jsonRecord struct {
+	User            string `json:"user"`
+	DBName          string `json:"dbname"`
+	RemoteHost      string `json:"remote_host"`
+	ApplicationName string `json:"application_name"`
+	ErrorSeverity   string `json:"error_severity"`
+	Message         string `json:"message"`
+	Detail          string `json:"detail"`
+	QueryID         int64  `json:"query_id"`
+}
+
+// jsonParser parses jsonlog format with one object per line.
+type jsonParser struct{}
+
+func (p *jsonParser) feed(line string) []*logEntry {
+	line = strings.TrimSpace(line)
+	if line == "" {
+		return nil
+	}
+
+	var r jsonRecord
+	if err := json.Unmarshal([]byte(line), &r); err != nil {
+		return nil
+	}
+
+	e := &logEntry{
+		user:        r.User,
+		database:    r.DBName,
+		clientHost:  r.RemoteHost,
+		application: r.ApplicationName,
+		severity:    r.ErrorSeverity,
+		message:     r.Message,
+		detail:      r.Detail,
+	}
+	if r.QueryID != 0 {
+		e.queryID = strconv.FormatInt(r.QueryID, 10)
+	}
+
+	return []*logEntry{e}
+}
+
+func (p *jsonParser) flush() []*logEntry {
+	return nil
+}
+
+// event is a query execution extracted from log entry.
+type event struct {
+	user        string
+	database    string
+	clientHost  string
+	application string
+	queryID     string
+	query       string  // query text as logged, with placeholders for prepared statements
+	example     string  // query text with parameter values
+	duration    float64 // in seconds
+	plan        string
+	fromPlan    bool // event is logged by auto_explain
+}
+
+var (
+	// log_min_duration_statement and auto_explain messages.
+	durationRE = regexp.MustCompile(`(?s)^duration: (\d+(?:\.\d+)?) ms\s+(statement|execute [^:]*|plan):\s*(.*)$`)
+
+	// plan lines with node costs or timings.
+	planNodeRE = regexp.MustCompile(`\((?:cost=|actual )`)
+
+	// prepared statement parameters.
+	placeholderRE = regexp.MustCompile(`\$\d+`)
+)
+
+// parseEntry returns query execution event for the log entry, or nil if entry is not about query execution.
+func parseEntry(e *logEntry) *event {
+	if e.severity != "LOG" {
+		return nil
+	}
+
+	m := durationRE.FindStringSubmatch(e.message)
+	if m == nil {
+		return nil
+	}
+
+	ms, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return nil
+	}
+
+	ev := &event{
+		user:        e.user,
+		database:    e.database,
+		clientHost:  e.clientHost,
+		application: e.application,
+		queryID:     e.queryID,
+		duration:    ms / 1000, //nolint:mnd
+	}
+
+	switch kind := m[2]; {
+	case kind == "plan":
+		ev.query, ev.plan = splitPlan(m[3])
+		ev.example = ev.query
+		ev.fromPlan = true
+	case strings.HasPrefix(kind, "execute"):
+		ev.query = strings.TrimSpace(m[3])
+		ev.example = bindParameters(ev.query, parseParameters(e.detail))
+	default:
+		ev.query = strings.TrimSpace(m[3])
+		ev.example = ev.query
+	}
+
+	if ev.query == "" {
+		return nil
+	}
+	return ev
+}
+
+// splitPlan splits auto_explain output in text or JSON format to the query text and the plan.
+func splitPlan(s string) (string, string) {
+	s = strings.TrimSpace(s)
+
+	if strings.HasPrefix(s, "{") {
+		var p struct {
+			QueryText string `json:"Query Text"`
+		}
+		if err := json.Unmarshal([]byte(s), &p); err != nil {
+			return "", s
+		}
+		return strings.TrimSpace(p.QueryText), s
+	}
+
+	rest, ok := strings.CutPrefix(s, "Query Text: ")
+	if !ok {
+		return "", s
+	}
+
+	// Query text may span several lines. The plan starts with the first node,
+	// which can be found reliably only if costs or timings are logged.
+	lines := strings.Split(rest, "\n")
+	n := 1
+	for i := 1; i < len(lines); i++ {
+		if planNodeRE.MatchString(lines[i]) {
+			n = i
+			break
+		}
+	}
+
+	return strings.TrimSpace(strings.Join(lines[:n], "\n")), strings.Join(lines[n:], "\n")
+}
+
+// parseParameters parses "parameters: $1 = '1', $2 = NULL" detail of prepared statement execution.
+func parseParameters(detail string) map[string]string {
+	s, ok := strings.CutPrefix(detail, "parameters: ")
+	if !ok {
+		return nil
+	}
+
+	res := make(map[string]string)
+	for strings.HasPrefix(s, "$") {
+		name, rest, ok := strings.Cut(s, " = ")
+		if !ok {
+			break
+		}
+
+		var value string
+		if strings.HasPrefix(rest, "'") {
+			// quotes inside the literal are doubled
+			i := 1
+			for ; i < len(rest); i++ {
+				if rest[i] != '\'' {
+					continue
+				}
+				if i+1 < len(rest) && rest[i+1] == '\'' {
+					i++
+					continue
+				}
+				break
+			}
+			if i >= len(rest) {
+				// truncated by log_parameter_max_length
+				break
+			}
+			value, rest = rest[:i+1], rest[i+1:]
+		} else {
+			end := strings.Index(rest, ", ")
+			if end < 0 {
+				end = len(rest)
+			}
+			value, rest = rest[:end], rest[end:]
+		}
+
+		res[name] = value
+		s = strings.TrimPrefix(rest, ", ")
+	}
+
+	return res
+}
+
+// bindParameters replaces placeholders in the query with parameter values.
+func bindParameters(query string, params map[string]string) string {
+	if len(params) == 0 {
+		return query
+	}
+
+	return placeholderRE.ReplaceAllStringFunc(query, func(p string) string {
+		if v, ok := params[p]; ok {
+			return v
+		}
+		return p
+	})
+}
@@ -0,0 +1,204 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pglog
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func parseLog(t *testing.T, p entryParser, log string) []*logEntry {
+	t.Helper()
+
+	var res []*logEntry
+	for line := range strings.Lines(log) {
+		res = append(res, p.feed(line)...)
+	}
+	return append(res, p.flush()...)
+}
+
+func TestStderrParser(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Default", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := newStderrParser("%m [%p] ")
+		require.NoError(t, err)
+
+		log := "2025-01-02 10:00:00.123 UTC [42] LOG:  duration: 1.500 ms  statement: SELECT 1\n" +
+			"2025-01-02 10:00:01.000 UTC [43] LOG:  checkpoint starting: time\n"
+		expected := []*logEntry{
+			{severity: "LOG", message: "duration: 1.500 ms  statement: SELECT 1"},
+			{severity: "LOG", message: "checkpoint starting: time"},
+		}
+		assert.Equal(t, expected, parseLog(t, p, log))
+	})
+
+	t.Run("SessionInfo", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := newStderrParser("%t [%p]: %quser=%u,db=%d,app=%a,client=%r ")
+		require.NoError(t, err)
+
+		log := "2025-01-02 10:00:00 UTC [42]: user=app,db=shop,app=psql,client=10.0.0.1(51234) " +
+			"LOG:  duration: 12.345 ms  execute S_1: SELECT * FROM orders\n" +
+			"\tWHERE id = $1 AND note = $2\n" +
+			"2025-01-02 10:00:00 UTC [42]: user=app,db=shop,app=psql,client=10.0.0.1(51234) " +
+			"DETAIL:  parameters: $1 = '5', $2 = 'it''s'\n" +
+			"2025-01-02 10:00:02 UTC [7]: LOG:  checkpoint complete\n"
+		expected := []*logEntry{
+			{
+				user:        "app",
+				database:    "shop",
+				clientHost:  "10.0.0.1",
+				application: "psql",
+				severity:    "LOG",
+				message:     "duration: 12.345 ms  execute S_1: SELECT * FROM orders\nWHERE id = $1 AND note = $2",
+				detail:      "parameters: $1 = '5', $2 = 'it''s'",
+			},
+			{severity: "LOG", message: "checkpoint complete"},
+		}
+		assert.Equal(t, expected, parseLog(t, p, log))
+	})
+
+	t.Run("AutoExplain", func(t *testing.T) {
+		t.Parallel()
+
+		p, err := newStderrParser("%m [%p] %q%u@%d ")
+		require.NoError(t, err)
+
+		log := "2025-01-02 10:00:00.000 UTC [42] app@shop LOG:  duration: 100.000 ms  plan:\n" +
+			"\tQuery Text: SELECT * FROM t WHERE a = 1\n" +
+			"\tSeq Scan on t  (cost=0.00..41.88 rows=13 width=8)\n" +
+			"\t  Filter: (a = 1)\n"
+		expected := []*logEntry{{
+			user:     "app",
+			database: "shop",
+			severity: "LOG",
+			message:  "duration: 100.000 ms  plan:\nQuery Text: SELECT * FROM t WHERE a = 1\nSeq Scan on t  (cost=0.00..41.88 rows=13 width=8)\n  Filter: (a = 1)",
+		}}
+		assert.Equal(t, expected, parseLog(t, p, log))
+	})
+}
+
+func TestCSVParser(t *testing.T) {
+	t.Parallel()
+
+	log := `2025-01-02 10:00:00.123 UTC,"app","shop",42,"10.0.0.1:51234",6776e3a0.2a,3,"SELECT",2025-01-02 09:59:00 UTC,3/12,0,LOG,00000,` +
+		`"duration: 2.000 ms  execute <unnamed>: SELECT ""id""` + "\n" + `FROM t WHERE id = $1","parameters: $1 = '7'",,,,,,,,"psql","client backend",,-123` + "\n"
+	expected := []*logEntry{{
+		user:        "app",
+		database:    "shop",
+		clientHost:  "10.0.0.1",
+		application: "psql",
+		queryID:     "-123",
+		severity:    "LOG",
+		message:     "duration: 2.000 ms  execute <unnamed>: SELECT \"id\"\nFROM t WHERE id = $1",
+		detail:      "parameters: $1 = '7'",
+	}}
+	assert.Equal(t, expected, parseLog(t, &csvParser{}, log))
+}
+
+func TestJSONParser(t *testing.T) {
+	t.Parallel()
+
+	log := `{"timestamp":"2025-01-02 10:00:00.123 UTC","user":"app","dbname":"shop","pid":42,"remote_host":"10.0.0.1","remote_port":51234,` +
+		`"error_severity":"LOG","message":"duration: 3.000 ms  statement: SELECT 1","application_name":"psql","query_id":5}` + "\n" +
+		"not a json\n"
+	expected := []*logEntry{{
+		user:        "app",
+		database:    "shop",
+		clientHost:  "10.0.0.1",
+		application: "psql",
+		queryID:     "5",
+		severity:    "LOG",
+		message:     "duration: 3.000 ms  statement: SELECT 1",
+	}}
+	assert.Equal(t, expected, parseLog(t, &jsonParser{}, log))
+}
+
+func TestParseEntry(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		entry    *logEntry
+		expected *event
+	}{{
+		name:  "Statement",
+		entry: &logEntry{user: "app", severity: "LOG", message: "duration: 1500.000 ms  statement: SELECT pg_sleep(1.5)"},
+		expected: &event{
+			user:     "app",
+			query:    "SELECT pg_sleep(1.5)",
+			example:  "SELECT pg_sleep(1.5)",
+			duration: 1.5,
+		},
+	}, {
+		name: "Execute",
+		entry: &logEntry{
+			severity: "LOG",
+			message:  "duration: 10.000 ms  execute S_1: SELECT * FROM t WHERE a = $1 AND b = $2 AND c = $10",
+			detail:   "parameters: $1 = 'x, y', $2 = NULL, $10 = '1'",
+		},
+		expected: &event{
+			query:    "SELECT * FROM t WHERE a = $1 AND b = $2 AND c = $10",
+			example:  "SELECT * FROM t WHERE a = 'x, y' AND b = NULL AND c = '1'",
+			duration: 0.01,
+		},
+	}, {
+		name: "TextPlan",
+		entry: &logEntry{
+			severity: "LOG",
+			message: "duration: 100.000 ms  plan:\nQuery Text: SELECT *\n  FROM t WHERE a = 1\n" +
+				"Seq Scan on t  (cost=0.00..41.88 rows=13 width=8)\n  Filter: (a = 1)",
+		},
+		expected: &event{
+			query:    "SELECT *\n  FROM t WHERE a = 1",
+			example:  "SELECT *\n  FROM t WHERE a = 1",
+			duration: 0.1,
+			plan:     "Seq Scan on t  (cost=0.00..41.88 rows=13 width=8)\n  Filter: (a = 1)",
+			fromPlan: true,
+		},
+	}, {
+		name: "JSONPlan",
+		entry: &logEntry{
+			severity: "LOG",
+			message:  `duration: 100.000 ms  plan: {"Query Text": "SELECT 1", "Plan": {"Node Type": "Result"}}`,
+		},
+		expected: &event{
+			query:    "SELECT 1",
+			example:  "SELECT 1",
+			duration: 0.1,
+			plan:     `{"Query Text": "SELECT 1", "Plan": {"Node Type": "Result"}}`,
+			fromPlan: true,
+		},
+	}, {
+		name:  "Bind",
+		entry: &logEntry{severity: "LOG", message: "duration: 0.100 ms  bind S_1: SELECT 1"},
+	}, {
+		name:  "Error",
+		entry: &logEntry{severity: "ERROR", message: "duration: 0.100 ms  statement: SELECT 1"},
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expected, parseEntry(tc.entry))
+		})
+	}
+}
@@ -0,0 +1,414 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package pglog runs built-in QAN Agent for PostgreSQL server log.
+package pglog
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"math"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	_ "github.com/lib/pq" // register SQL driver
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/percona/pmm/agent/agents"
+	"github.com/percona/pmm/agent/agents/postgres/parser"
+	"github.com/percona/pmm/agent/queryparser"
+	"github.com/percona/pmm/agent/utils/backoff"
+	"github.com/percona/pmm/agent/utils/filereader"
+	"github.com/percona/pmm/agent/utils/truncate"
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
+)
+
+const (
+	backoffMinDelay   = 1 * time.Second
+	backoffMaxDelay   = 5 * time.Second
+	recheckInterval   = 10 * time.Second
+	aggregateInterval = time.Minute
+)
+
+// PGLog extracts performance data from PostgreSQL server log.
+type PGLog struct {
+	params  *Params
+	l       *logrus.Entry
+	changes chan agents.Change
+}
+
+// Params represent Agent parameters.
+type Params struct {
+	DSN                    string
+	AgentID                string
+	MaxQueryLength         int32
+	DisableQueryExamples   bool
+	DisableCommentsParsing bool
+	LogFilePrefix          string // for development and testing
+}
+
+const queryTag = "agent='pglog'"
+
+// logInfo describes the current server log file.
+type logInfo struct {
+	path       string
+	format     string
+	linePrefix string
+	countPlans bool
+}
+
+// New creates new PGLog QAN service.
+func New(params *Params, l *logrus.Entry) (*PGLog, error) {
+	return &PGLog{
+		params:  params,
+		l:       l,
+		changes: make(chan agents.Change, 10), //nolint:mnd
+	}, nil
+}
+
+// Run extracts performance data and sends it to the channel until ctx is canceled.
+func (s *PGLog) Run(ctx context.Context) {
+	defer func() {
+		s.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_DONE}
+		close(s.changes)
+	}()
+
+	// send updates to logInfos channel, close it when ctx is done
+	logInfos := make(chan *logInfo, 1)
+	go func() {
+		recheck := time.NewTicker(recheckInterval)
+		defer recheck.Stop()
+
+		var oldInfo logInfo
+		for {
+			newInfo, err := s.getLogInfo(ctx)
+			switch {
+			case err != nil:
+				s.l.Error(err)
+			case *newInfo != oldInfo:
+				// PostgreSQL switches to the new file on rotation, so this also follows rotated logs.
+				s.l.Debugf("Log information changed: old = %+v, new = %+v.", oldInfo, *newInfo)
+				logInfos <- newInfo
+				oldInfo = *newInfo
+			default:
+				s.l.Tracef("Log information not changed.")
+			}
+
+			select {
+			case <-ctx.Done():
+				close(logInfos)
+				return
+			case <-recheck.C:
+				// nothing, continue loop
+			}
+		}
+	}()
+
+	b := backoff.New(backoffMinDelay, backoffMaxDelay)
+	info := <-logInfos
+	for info != nil {
+		s.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_STARTING}
+
+		// process file until fileCtx is done, or fatal processing error is encountered
+		fileInfo := info
+		fileCtx, fileCancel := context.WithCancel(ctx)
+		fileDone := make(chan error)
+		go func() {
+			s.l.Infof("Processing file %s (%s).", fileInfo.path, fileInfo.format)
+			fileDone <- s.processFile(fileCtx, fileInfo)
+		}()
+
+		// cancel processing when new info is available, but always wait for it to finish
+		var err error
+		select {
+		case info = <-logInfos:
+			fileCancel()
+			err = <-fileDone
+		case err = <-fileDone:
+			fileCancel()
+		}
+
+		s.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_WAITING}
+
+		if err == nil {
+			b.Reset()
+		} else {
+			time.Sleep(b.Delay())
+		}
+	}
+}
+
+// getLogInfo returns information about the current server log file.
+func (s *PGLog) getLogInfo(ctx context.Context) (*logInfo, error) {
+	db, err := sql.Open("postgres", s.params.DSN)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open database connection: %w", err)
+	}
+	defer db.Close() //nolint:errcheck
+
+	var destination, linePrefix, dataDir, minDuration string
+	row := db.QueryRowContext(ctx, fmt.Sprintf("SELECT /* %s */ current_setting('log_destination'), current_setting('log_line_prefix'), "+
+		"current_setting('data_directory'), current_setting('log_min_duration_statement')", queryTag))
+	if err = row.Scan(&destination, &linePrefix, &dataDir, &minDuration); err != nil {
+		return nil, fmt.Errorf("cannot select log settings: %w", err)
+	}
+
+	// structured formats are preferred as they are parsed reliably
+	destinations := strings.Split(destination, ",")
+	for i, d := range destinations {
+		destinations[i] = strings.ToLower(strings.TrimSpace(d))
+	}
+	var format string
+	for _, f := range []string{formatJSONLog, formatCSVLog, formatStderr} {
+		if slices.Contains(destinations, f) {
+			format = f
+			break
+		}
+	}
+	if format == "" {
+		return nil, fmt.Errorf("cannot parse server log: log_destination %q doesn't include stderr, csvlog or jsonlog", destination)
+	}
+
+	var path sql.NullString
+	row = db.QueryRowContext(ctx, fmt.Sprintf("SELECT /* %s */ pg_current_logfile($1)", queryTag), format)
+	if err = row.Scan(&path); err != nil {
+		return nil, fmt.Errorf("cannot select pg_current_logfile(): %w", err)
+	}
+	if !path.Valid || path.String == "" {
+		return nil, fmt.Errorf("cannot parse server log: %s log file is not written, check that logging_collector is on", format)
+	}
+
+	// Log file can be absolute or relative. If it's relative,
+	// then prepend the data directory.
+	info := &logInfo{
+		path:       path.String,
+		format:     format,
+		countPlans: minDuration == "-1",
+	}
+	if !filepath.IsAbs(info.path) {
+		info.path = filepath.Join(dataDir, info.path)
+	}
+	if s.params.LogFilePrefix != "" {
+		info.path = filepath.Join(s.params.LogFilePrefix, info.path)
+	}
+	if format == formatStderr {
+		info.linePrefix = linePrefix
+	}
+
+	return info, nil
+}
+
+// processFile extracts performance data from given file and sends it to the channel until ctx is canceled.
+func (s *PGLog) processFile(ctx context.Context, info *logInfo) error {
+	p, err := newEntryParser(info.format, info.linePrefix)
+	if err != nil {
+		s.l.Error(err)
+		return err
+	}
+
+	rl := s.l.WithField("component", "pglog/reader").WithField("file", info.path)
+	reader, err := filereader.NewContinuousFileReader(info.path, rl)
+	if err != nil {
+		s.l.Errorf("Failed to start reader for file %s: %s.", info.path, err)
+		return err
+	}
+
+	// send entries to the channel, close it when reader is done
+	entries := make(chan *logEntry, 1000) //nolint:mnd
+	go func() {
+		defer close(entries)
+		for {
+			line, err := reader.NextLine()
+			for _, e := range p.feed(line) {
+				entries <- e
+			}
+
+			if err != nil {
+				for _, e := range p.flush() {
+					entries <- e
+				}
+				if !errors.Is(err, io.EOF) {
+					s.l.Warnf("Reader error: %v.", err)
+				}
+				return
+			}
+		}
+	}()
+
+	s.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_RUNNING}
+
+	aggregator := newAggregator(info.countPlans)
+	ctxDone := ctx.Done()
+
+	// aggregate every minute at 00 seconds
+	start := time.Now()
+	wait := start.Truncate(aggregateInterval).Add(aggregateInterval).Sub(start)
+	s.l.Debugf("Scheduling next aggregation in %s at %s.", wait, start.Add(wait).Format("15:04:05"))
+	t := time.NewTimer(wait)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctxDone:
+			err = reader.Close() // that will let reader goroutine to stop
+			s.l.Infof("Context done with %s. Reader closed with %v.", ctx.Err(), err)
+			ctxDone = nil
+
+		case e, ok := <-entries:
+			if !ok {
+				// reader is done
+				return nil
+			}
+
+			if ev := parseEntry(e); ev != nil {
+				s.l.Tracef("Parsed log event: %+v.", ev)
+				aggregator.add(ev)
+			}
+
+		case <-t.C:
+			lengthS := uint32(math.Round(wait.Seconds())) // round 59.9s/60.1s to 60s
+			buckets := makeBuckets(s.params.AgentID, aggregator.classes, start, lengthS,
+				s.params.DisableCommentsParsing, s.params.DisableQueryExamples, s.params.MaxQueryLength, s.l)
+			s.l.Debugf("Made %d buckets out of %d classes in %s+%d interval. Wait time: %s.",
+				len(buckets), len(aggregator.classes), start.Format("15:04:05"), lengthS, time.Since(start))
+
+			aggregator = newAggregator(info.countPlans)
+			start = time.Now()
+			wait = start.Truncate(aggregateInterval).Add(aggregateInterval).Sub(start)
+			s.l.Debugf("Scheduling next aggregation in %s at %s.", wait, start.Add(wait).Format("15:04:05"))
+			t.Reset(wait)
+
+			s.changes <- agents.Change{MetricsBucket: buckets}
+		}
+	}
+}
+
+// makeBuckets is a pure function for easier testing.
+func makeBuckets(
+	agentID string,
+	classes map[classKey]*class,
+	periodStart time.Time,
+	periodLengthSecs uint32,
+	disableCommentsParsing bool,
+	disableQueryExamples bool,
+	maxQueryLength int32,
+	l *logrus.Entry,
+) []*agentv1.MetricsBucket {
+	keys := slices.SortedFunc(maps.Keys(classes), func(a, b classKey) int {
+		return cmp.Or(
+			cmp.Compare(a.queryID, b.queryID),
+			cmp.Compare(a.database, b.database),
+			cmp.Compare(a.user, b.user),
+			cmp.Compare(a.clientHost, b.clientHost),
+		)
+	})
+
+	buckets := make([]*agentv1.MetricsBucket, 0, len(classes))
+	for _, k := range keys {
+		c := classes[k]
+		if len(c.durations) == 0 {
+			// only the plan was logged, the execution will be counted in the next period
+			continue
+		}
+
+		fingerprint, isTruncated := truncate.Query(c.fingerprint, maxQueryLength, truncate.GetDefaultMaxQueryLength())
+		mb := &agentv1.MetricsBucket{
+			Common: &agentv1.MetricsBucket_Common{
+				Queryid:             c.queryID,
+				Fingerprint:         fingerprint,
+				IsTruncated:         isTruncated,
+				Database:            c.database,
+				Username:            c.user,
+				ClientHost:          c.clientHost,
+				AgentId:             agentID,
+				AgentType:           inventoryv1.AgentType_AGENT_TYPE_QAN_POSTGRESQL_LOG_AGENT,
+				PeriodStartUnixSecs: uint32(periodStart.Unix()), //nolint:gosec
+				PeriodLengthSecs:    periodLengthSecs,
+				NumQueries:          float32(len(c.durations)),
+			},
+			Postgresql: &agentv1.MetricsBucket_PostgreSQL{
+				ApplicationName: c.application,
+				Planid:          planID(c.plan),
+				QueryPlan:       c.plan,
+			},
+		}
+
+		tables, err := parser.ExtractTables(c.query)
+		if err != nil {
+			l.Debugf("Can't extract table names from query %s: %s", fingerprint, err)
+		}
+		mb.Common.Tables = tables
+
+		if !disableCommentsParsing {
+			comments, err := queryparser.PostgreSQLComments(c.query)
+			if err != nil {
+				l.Infof("cannot parse comments from query: %s", fingerprint)
+			}
+			mb.Common.Comments = comments
+		}
+
+		if !disableQueryExamples && c.example != "" {
+			example, truncated := truncate.Query(c.example, maxQueryLength, truncate.GetDefaultMaxQueryLength())
+			if truncated {
+				mb.Common.IsTruncated = truncated
+			}
+			mb.Common.Example = example
+			mb.Common.ExampleType = agentv1.ExampleType_EXAMPLE_TYPE_SLOWEST
+		}
+
+		durations := slices.Sorted(slices.Values(c.durations))
+		var sum float64
+		for _, d := range durations {
+			sum += d
+		}
+		mb.Common.MQueryTimeCnt = float32(len(durations))
+		mb.Common.MQueryTimeSum = float32(sum)
+		mb.Common.MQueryTimeMin = float32(durations[0])
+		mb.Common.MQueryTimeMax = float32(durations[len(durations)-1])
+		mb.Common.MQueryTimeP99 = float32(durations[int(math.Ceil(0.99*float64(len(durations))))-1]) //nolint:mnd
+
+		buckets = append(buckets, mb)
+	}
+
+	return buckets
+}
+
+// Changes returns channel that should be read until it is closed.
+func (s *PGLog) Changes() <-chan agents.Change {
+	return s.changes
+}
+
+// Describe implements prometheus.Collector.
+func (s *PGLog) Describe(_ chan<- *prometheus.Desc) {
+	// This method is needed to satisfy interface.
+}
+
+// Collect implement prometheus.Collector.
+func (s *PGLog) Collect(_ chan<- prometheus.Metric) {
+	// This method is needed to satisfy interface.
+}
+
+// check interfaces.
+var (
+	_ prometheus.Collector = (*PGLog)(nil)
+)
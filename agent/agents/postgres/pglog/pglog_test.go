@@ -0,0 +1,150 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pglog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
+)
+
+func TestMakeBuckets(t *testing.T) {
+	t.Parallel()
+
+	periodStart := time.Unix(1735812000, 0)
+	l := logrus.WithField("test", t.Name())
+
+	const plan = "Index Scan using t_pkey on t  (cost=0.29..8.30 rows=1 width=8) (actual time=0.010..0.011 rows=1 loops=1)\n" +
+		"  Index Cond: (id = $1)"
+
+	events := []*event{{
+		user:     "app",
+		database: "shop",
+		query:    "SELECT * FROM t WHERE id = $1",
+		duration: 0.2,
+		plan:     plan,
+		fromPlan: true,
+	}, {
+		user:        "app",
+		database:    "shop",
+		application: "psql",
+		query:       "SELECT * FROM t WHERE id = $1",
+		example:     "SELECT * FROM t WHERE id = '1'",
+		duration:    0.2,
+	}, {
+		user:        "app",
+		database:    "shop",
+		application: "psql",
+		query:       "SELECT * FROM t WHERE id = $1",
+		example:     "SELECT * FROM t WHERE id = '2'",
+		duration:    0.4,
+	}, {
+		user:     "app",
+		database: "shop",
+		query:    "SELECT * FROM u",
+		duration: 1,
+		plan:     "Seq Scan on u  (cost=0.00..35.50 rows=2550 width=4)",
+		fromPlan: true,
+	}}
+
+	t.Run("Normal", func(t *testing.T) {
+		t.Parallel()
+
+		a := newAggregator(false)
+		for _, e := range events {
+			a.add(e)
+		}
+
+		actual := makeBuckets("agent_id", a.classes, periodStart, 60, false, false, 0, l)
+		require.Len(t, actual, 1, "plan-only class should be skipped")
+
+		expected := &agentv1.MetricsBucket{
+			Common: &agentv1.MetricsBucket_Common{
+				Queryid:             hash("SELECT * FROM t WHERE id = $1"),
+				Fingerprint:         "SELECT * FROM t WHERE id = $1",
+				Database:            "shop",
+				Tables:              []string{"t"},
+				Username:            "app",
+				Comments:            map[string]string{},
+				AgentId:             "agent_id",
+				AgentType:           inventoryv1.AgentType_AGENT_TYPE_QAN_POSTGRESQL_LOG_AGENT,
+				PeriodStartUnixSecs: 1735812000,
+				PeriodLengthSecs:    60,
+				Example:             "SELECT * FROM t WHERE id = '2'",
+				ExampleType:         agentv1.ExampleType_EXAMPLE_TYPE_SLOWEST,
+				NumQueries:          2,
+				MQueryTimeCnt:       2,
+				MQueryTimeSum:       0.6,
+				MQueryTimeMin:       0.2,
+				MQueryTimeMax:       0.4,
+				MQueryTimeP99:       0.4,
+			},
+			Postgresql: &agentv1.MetricsBucket_PostgreSQL{
+				ApplicationName: "psql",
+				Planid:          planID(plan),
+				QueryPlan:       plan,
+			},
+		}
+		assert.Equal(t, expected, actual[0])
+	})
+
+	t.Run("CountPlans", func(t *testing.T) {
+		t.Parallel()
+
+		a := newAggregator(true)
+		for _, e := range events {
+			a.add(e)
+		}
+
+		actual := makeBuckets("agent_id", a.classes, periodStart, 60, true, true, 0, l)
+		require.Len(t, actual, 2)
+		for _, mb := range actual {
+			assert.Empty(t, mb.Common.Example)
+			assert.NotEmpty(t, mb.Postgresql.QueryPlan)
+		}
+	})
+}
+
+func TestPlanID(t *testing.T) {
+	t.Parallel()
+
+	// same shape with different costs and timings
+	a := "Seq Scan on t  (cost=0.00..41.88 rows=13 width=8) (actual time=0.010..0.300 rows=10 loops=1)\n" +
+		"  Filter: (a = 1)\n" +
+		"  Rows Removed by Filter: 2540\n" +
+		"Planning Time: 0.050 ms\n" +
+		"Execution Time: 0.400 ms"
+	b := "Seq Scan on t  (cost=0.00..52.10 rows=20 width=8) (actual time=0.020..0.500 rows=12 loops=1)\n" +
+		"  Filter: (a = 1)\n" +
+		"  Rows Removed by Filter: 3000\n" +
+		"Planning Time: 0.070 ms\n" +
+		"Execution Time: 0.600 ms"
+	c := "Index Scan using t_a_idx on t  (cost=0.29..8.30 rows=1 width=8)\n" +
+		"  Index Cond: (a = 1)"
+	assert.Equal(t, planID(a), planID(b))
+	assert.NotEqual(t, planID(a), planID(c))
+
+	// JSON plans
+	j1 := `{"Query Text": "SELECT 1", "Plan": {"Node Type": "Seq Scan", "Relation Name": "t", "Total Cost": 41.88, "Plan Rows": 13}}`
+	j2 := `{"Query Text": "SELECT 1", "Plan": {"Node Type": "Seq Scan", "Relation Name": "t", "Total Cost": 52.10, "Plan Rows": 20}}`
+	assert.Equal(t, planID(j1), planID(j2))
+	assert.Empty(t, planID(""))
+}
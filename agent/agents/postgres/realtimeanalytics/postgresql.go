@@ -0,0 +1,246 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package realtimeanalytics runs built-in Real-Time Analytics Agent for PostgreSQL.
+package realtimeanalytics
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"time"
+
+	_ "github.com/lib/pq" // register SQL driver
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/reform.v1"
+	"gopkg.in/reform.v1/dialects/postgresql"
+
+	"github.com/percona/pmm/agent/agents"
+	"github.com/percona/pmm/agent/queryparser"
+	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
+	rtav1 "github.com/percona/pmm/api/realtimeanalytics/v1"
+	"github.com/percona/pmm/utils/sqlmetrics"
+)
+
+const (
+	changesBufferSize = 10
+
+	queryTag = "agent='rta-postgresql'"
+
+	// minExecutionTime is the minimal execution time of the query to be reported.
+	minExecutionTime = 10 * time.Millisecond
+)
+
+// activityQuery fetches currently running queries from pg_stat_activity.
+// It skips the backend of the RTA agent itself, idle backends and background workers.
+// Execution duration is calculated on the server side to avoid clock skew between pmm-agent and PostgreSQL hosts.
+var activityQuery = fmt.Sprintf(`SELECT /* %s */
+	pid,
+	COALESCE(datname, ''),
+	COALESCE(usename, ''),
+	COALESCE(application_name, ''),
+	COALESCE(host(client_addr) || ':' || client_port, ''),
+	COALESCE(state, ''),
+	COALESCE(wait_event_type, ''),
+	COALESCE(wait_event, ''),
+	COALESCE(backend_xid::text, ''),
+	query_start,
+	COALESCE(query, ''),
+	EXTRACT(EPOCH FROM clock_timestamp() - query_start)
+FROM pg_stat_activity
+WHERE pid <> pg_backend_pid()
+	AND backend_type = 'client backend'
+	AND state IS NOT NULL
+	AND state <> 'idle'
+	AND query_start IS NOT NULL`, queryTag)
+
+// PostgreSQLRTA extracts Real-Time Analytics data (currently running DB queries) from PostgreSQL.
+type PostgreSQLRTA struct {
+	agentID     string
+	serviceID   string
+	serviceName string
+	l           *logrus.Entry
+
+	// Channel to obtain data from this agent.
+	changes chan agents.Change
+
+	q        *reform.Querier
+	dbCloser io.Closer
+	// collectInterval is how often to collect data from PostgreSQL.
+	collectInterval time.Duration
+}
+
+// Params represent Agent parameters.
+type Params struct {
+	AgentID         string
+	DSN             string        // DSN to connect to PostgreSQL.
+	ServiceID       string        // ServiceID shall be set in RTA queries to link them to the service.
+	ServiceName     string        // ServiceName shall be set in RTA queries to link them to the service.
+	CollectInterval time.Duration // CollectInterval is how often to collect data from PostgreSQL.
+}
+
+// New creates new PostgreSQLRTA service.
+func New(params *Params, l *logrus.Entry) (*PostgreSQLRTA, error) {
+	sqlDB, err := sql.Open("postgres", params.DSN)
+	if err != nil {
+		return nil, err
+	}
+	sqlDB.SetMaxIdleConns(1)
+	sqlDB.SetMaxOpenConns(1)
+	sqlDB.SetConnMaxLifetime(0)
+
+	reformL := sqlmetrics.NewReform("postgres", params.AgentID, l.Tracef)
+	q := reform.NewDB(sqlDB, postgresql.Dialect, reformL).WithTag(queryTag)
+
+	return newPostgreSQLRTA(params, q, sqlDB, l), nil
+}
+
+func newPostgreSQLRTA(params *Params, q *reform.Querier, dbCloser io.Closer, l *logrus.Entry) *PostgreSQLRTA {
+	return &PostgreSQLRTA{
+		agentID:         params.AgentID,
+		serviceID:       params.ServiceID,
+		serviceName:     params.ServiceName,
+		l:               l,
+		changes:         make(chan agents.Change, changesBufferSize),
+		q:               q,
+		dbCloser:        dbCloser,
+		collectInterval: params.CollectInterval,
+	}
+}
+
+// Run extracts currently running DB queries from PostgreSQL
+// and sends it to the channel until ctx is canceled.
+func (m *PostgreSQLRTA) Run(ctx context.Context) {
+	m.l.Info("Starting PostgreSQL RTA agent")
+
+	m.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_STARTING}
+
+	defer func() {
+		err := m.dbCloser.Close()
+		if err != nil {
+			m.l.WithError(err).Error("Failed to close database connection")
+		}
+
+		m.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_DONE}
+
+		close(m.changes)
+	}()
+
+	m.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_RUNNING}
+	// fetch RTA data periodically
+	ticker := time.NewTicker(m.collectInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			m.l.Info("Stopping PostgreSQL RTA agent")
+
+			m.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_STOPPING}
+			// m.changes channel will be closed in defer, so we don't need to close it here, just exit the function
+			return
+		case <-ticker.C:
+			// Collection is synchronous: there is only one connection to PostgreSQL,
+			// so concurrent collections would be serialized by the connection pool anyway.
+			rtaQueryBucket, err := m.collect(ctx)
+			if err != nil {
+				m.l.Warnf("pg_stat_activity collection failed: %v", err)
+				continue
+			}
+
+			if len(rtaQueryBucket) != 0 {
+				m.changes <- agents.Change{RTAQueriesBucket: rtaQueryBucket}
+			}
+		}
+	}
+}
+
+// collect fetches currently running queries from pg_stat_activity.
+func (m *PostgreSQLRTA) collect(ctx context.Context) ([]*rtav1.QueryData, error) {
+	rows, err := m.q.QueryContext(ctx, activityQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	currTime := timestamppb.Now()
+
+	var res []*rtav1.QueryData
+	for rows.Next() {
+		var (
+			d             rtav1.QueryPostgreSQLData
+			clientAddress string
+			queryStart    time.Time
+			query         string
+			seconds       float64
+		)
+		err = rows.Scan(&d.Pid, &d.DatabaseName, &d.Username, &d.ApplicationName, &clientAddress, &d.State,
+			&d.WaitEventType, &d.WaitEvent, &d.BackendXid, &queryStart, &query, &seconds)
+		if err != nil {
+			return nil, err
+		}
+
+		executionDuration := time.Duration(seconds * float64(time.Second))
+		if executionDuration < minExecutionTime {
+			continue
+		}
+
+		d.QueryStart = timestamppb.New(queryStart)
+
+		// Query text is not available without pg_read_all_stats role for other users' backends,
+		// and may be truncated by track_activity_query_size, so fingerprinting is best-effort.
+		d.Fingerprint, err = queryparser.PostgreSQLFingerprint(query)
+		if err != nil {
+			m.l.Debugf("Failed to fingerprint query of backend %d: %v", d.Pid, err)
+		}
+
+		// PID identifies the backend only, so query start time is added to tell its queries apart.
+		res = append(res, &rtav1.QueryData{
+			ServiceId:              m.serviceID,
+			ServiceName:            m.serviceName,
+			QueryId:                fmt.Sprintf("%d-%d", d.Pid, queryStart.UnixMicro()),
+			QueryText:              query,
+			QueryExecutionDuration: durationpb.New(executionDuration.Round(10 * time.Millisecond)), //nolint:mnd
+			QueryCollectTime:       currTime,
+			ClientAddress:          clientAddress,
+			Payload:                &rtav1.QueryData_PostgresqlPayload{PostgresqlPayload: &d},
+		})
+	}
+
+	return res, rows.Err()
+}
+
+// Changes returns channel that should be read until it is closed.
+func (m *PostgreSQLRTA) Changes() <-chan agents.Change {
+	return m.changes
+}
+
+// Describe implements prometheus.Collector.
+func (m *PostgreSQLRTA) Describe(_ chan<- *prometheus.Desc) {
+	// This method is needed to satisfy interface.
+}
+
+// Collect implement prometheus.Collector.
+func (m *PostgreSQLRTA) Collect(_ chan<- prometheus.Metric) {
+	// This method is needed to satisfy interface.
+}
+
+// check interfaces.
+var (
+	_ prometheus.Collector = (*PostgreSQLRTA)(nil)
+)
@@ -0,0 +1,84 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package realtimeanalytics
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/reform.v1"
+	"gopkg.in/reform.v1/dialects/postgresql"
+)
+
+func TestCollect(t *testing.T) {
+	t.Parallel()
+
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	q := reform.NewDB(sqlDB, postgresql.Dialect, reform.NewPrintfLogger(t.Logf)).WithTag(queryTag)
+	m := newPostgreSQLRTA(&Params{
+		AgentID:         "agent-id",
+		ServiceID:       "service-id",
+		ServiceName:     "service-name",
+		CollectInterval: time.Second,
+	}, q, sqlDB, logrus.WithField("test", t.Name()))
+
+	queryStart := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	columns := []string{
+		"pid", "datname", "usename", "application_name", "client_addr", "state", "wait_event_type",
+		"wait_event", "backend_xid", "query_start", "query", "duration",
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("FROM pg_stat_activity")).
+		WillReturnRows(sqlmock.NewRows(columns).
+			AddRow(4242, "pagila", "app", "psql", "10.0.0.1:5432", "active", "Lock", "relation",
+				"731", queryStart, "SELECT * FROM film WHERE film_id = 42", 1.503).
+			AddRow(4243, "pagila", "app", "psql", "10.0.0.1:5433", "active", "", "",
+				"", queryStart, "SELECT 1", 0.001))
+
+	res, err := m.collect(t.Context())
+	require.NoError(t, err)
+	require.Len(t, res, 1, "too short queries should be skipped")
+
+	qd := res[0]
+	assert.Equal(t, "service-id", qd.ServiceId)
+	assert.Equal(t, "service-name", qd.ServiceName)
+	assert.Equal(t, "4242-1767323045000000", qd.QueryId)
+	assert.Equal(t, "SELECT * FROM film WHERE film_id = 42", qd.QueryText)
+	assert.Equal(t, "10.0.0.1:5432", qd.ClientAddress)
+	assert.Equal(t, 1500*time.Millisecond, qd.QueryExecutionDuration.AsDuration())
+	assert.NotNil(t, qd.QueryCollectTime)
+
+	d := qd.GetPostgresqlPayload()
+	require.NotNil(t, d)
+	assert.Equal(t, int32(4242), d.Pid)
+	assert.Equal(t, "pagila", d.DatabaseName)
+	assert.Equal(t, "app", d.Username)
+	assert.Equal(t, "psql", d.ApplicationName)
+	assert.Equal(t, "active", d.State)
+	assert.Equal(t, "Lock", d.WaitEventType)
+	assert.Equal(t, "relation", d.WaitEvent)
+	assert.Equal(t, "731", d.BackendXid)
+	assert.Equal(t, queryStart, d.QueryStart.AsTime())
+	assert.Equal(t, "SELECT * FROM film WHERE film_id = $1", d.Fingerprint)
+}
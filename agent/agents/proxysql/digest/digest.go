@@ -0,0 +1,313 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package digest runs built-in QAN Agent for ProxySQL query digest statistics.
+package digest
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "github.com/go-sql-driver/mysql" // register SQL driver
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/percona/pmm/agent/agents"
+	"github.com/percona/pmm/agent/utils/truncate"
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
+)
+
+const (
+	queryDigests = time.Minute
+
+	// hostgroupLabel is a label with the hostgroup the query was routed to.
+	hostgroupLabel = "hostgroup"
+)
+
+const digestsQuery = "SELECT hostgroup, schemaname, username, digest, digest_text, count_star, sum_time, min_time, max_time " +
+	"FROM stats_mysql_query_digest"
+
+// Digest QAN services connects to ProxySQL admin interface and extracts query digest statistics.
+type Digest struct {
+	db             *sql.DB
+	agentID        string
+	maxQueryLength int32
+	l              *logrus.Entry
+	changes        chan agents.Change
+	prev           digestMap
+}
+
+// Params represent Agent parameters.
+type Params struct {
+	DSN            string
+	AgentID        string
+	MaxQueryLength int32
+}
+
+// digestStats represents a single stats_mysql_query_digest row.
+// Times are in microseconds.
+type digestStats struct {
+	hostgroup  int64
+	schema     string
+	username   string
+	digest     string
+	digestText string
+	countStar  uint64
+	sumTime    uint64
+	minTime    uint64
+	maxTime    uint64
+}
+
+type digestMap map[string]*digestStats
+
+// key returns a unique key of the row: ProxySQL keeps separate stats for each hostgroup, schema, and user.
+func (s *digestStats) key() string {
+	return fmt.Sprintf("%d-%s-%s-%s", s.hostgroup, s.schema, s.username, s.digest)
+}
+
+// New creates new Digest QAN service.
+func New(params *Params, l *logrus.Entry) (*Digest, error) {
+	db, err := sql.Open("mysql", params.DSN)
+	if err != nil {
+		return nil, err
+	}
+	db.SetMaxIdleConns(1)
+	db.SetMaxOpenConns(1)
+	db.SetConnMaxLifetime(0)
+
+	return &Digest{
+		db:             db,
+		agentID:        params.AgentID,
+		maxQueryLength: params.MaxQueryLength,
+		l:              l,
+		changes:        make(chan agents.Change, 10), //nolint:mnd
+	}, nil
+}
+
+// Run extracts query digest statistics and sends it to the channel until ctx is canceled.
+func (d *Digest) Run(ctx context.Context) {
+	defer func() {
+		if err := d.db.Close(); err != nil {
+			d.l.WithError(err).Error("Failed to close database connection")
+		}
+		d.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_DONE}
+		close(d.changes)
+	}()
+
+	// remember current digests so they are not sent as new on first iteration with incorrect timestamps
+	var running bool
+	d.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_STARTING}
+	current, err := getDigests(ctx, d.db)
+	if err != nil {
+		d.l.Error(err)
+		d.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_WAITING}
+	} else {
+		d.prev = current
+		d.l.Debugf("Got %d initial digests.", len(current))
+		running = true
+		d.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_RUNNING}
+	}
+
+	// query stats_mysql_query_digest every minute at 00 seconds
+	start := time.Now()
+	wait := start.Truncate(queryDigests).Add(queryDigests).Sub(start)
+	d.l.Debugf("Scheduling next collection in %s at %s.", wait, start.Add(wait).Format("15:04:05"))
+	t := time.NewTimer(wait)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			d.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_STOPPING}
+			d.l.Infof("Context canceled.")
+			return
+
+		case <-t.C:
+			if !running {
+				d.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_STARTING}
+			}
+
+			lengthS := uint32(math.Round(wait.Seconds())) // round 59.9s/60.1s to 60s
+			buckets, err := d.getNewBuckets(ctx, start, lengthS)
+
+			start = time.Now()
+			wait = start.Truncate(queryDigests).Add(queryDigests).Sub(start)
+			d.l.Debugf("Scheduling next collection in %s at %s.", wait, start.Add(wait).Format("15:04:05"))
+			t.Reset(wait)
+
+			if err != nil {
+				d.l.Error(err)
+				running = false
+				d.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_WAITING}
+				continue
+			}
+
+			if !running {
+				running = true
+				d.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_RUNNING}
+			}
+
+			d.changes <- agents.Change{MetricsBucket: buckets}
+		}
+	}
+}
+
+func (d *Digest) getNewBuckets(ctx context.Context, periodStart time.Time, periodLengthSecs uint32) ([]*agentv1.MetricsBucket, error) {
+	current, err := getDigests(ctx, d.db)
+	if err != nil {
+		return nil, err
+	}
+
+	// if the initial query failed, the first successful one only establishes a baseline
+	var buckets []*agentv1.MetricsBucket
+	if d.prev != nil {
+		buckets = makeBuckets(current, d.prev, d.l, d.maxQueryLength)
+	}
+	d.prev = current
+
+	startS := uint32(periodStart.Unix()) //nolint:gosec
+	d.l.Debugf("Made %d buckets out of %d digests in %s+%d interval.",
+		len(buckets), len(current), periodStart.Format("15:04:05"), periodLengthSecs)
+
+	// add agent_id and timestamps
+	for _, b := range buckets {
+		b.Common.AgentId = d.agentID
+		b.Common.PeriodStartUnixSecs = startS
+		b.Common.PeriodLengthSecs = periodLengthSecs
+	}
+
+	return buckets, nil
+}
+
+func getDigests(ctx context.Context, db *sql.DB) (digestMap, error) {
+	rows, err := db.QueryContext(ctx, digestsQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stats_mysql_query_digest: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	res := make(digestMap)
+	for rows.Next() {
+		var s digestStats
+		err = rows.Scan(&s.hostgroup, &s.schema, &s.username, &s.digest, &s.digestText,
+			&s.countStar, &s.sumTime, &s.minTime, &s.maxTime)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan stats_mysql_query_digest: %w", err)
+		}
+		res[s.key()] = &s
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to fetch stats_mysql_query_digest: %w", err)
+	}
+
+	return res, nil
+}
+
+// inc returns increment from prev to current, or 0, if there was a wrap-around.
+func inc(current, prev uint64) float32 {
+	if current <= prev {
+		return 0
+	}
+	return float32(current - prev)
+}
+
+// makeBuckets uses current and previous state of stats_mysql_query_digest table to make metrics buckets;
+// makeBuckets is a pure function for easier testing.
+func makeBuckets(current, prev digestMap, l *logrus.Entry, maxQueryLength int32) []*agentv1.MetricsBucket {
+	res := make([]*agentv1.MetricsBucket, 0, len(current))
+
+	for _, key := range slices.Sorted(maps.Keys(current)) {
+		cur := current[key]
+		p := prev[key]
+		if p == nil {
+			p = &digestStats{}
+		}
+
+		switch {
+		case cur.countStar == p.countStar:
+			l.Tracef("Skipped due to the same number of queries: %s.", key)
+			continue
+		case cur.countStar < p.countStar:
+			// stats_mysql_query_digest_reset was queried
+			l.Debugf("Reset detected. Treating as a new query: %s.", key)
+			p = &digestStats{}
+		case p.countStar == 0:
+			l.Debugf("New query: %s.", key)
+		default:
+			l.Debugf("Normal query: %s.", key)
+		}
+
+		count := inc(cur.countStar, p.countStar)
+		// convert microseconds to seconds
+		sum := inc(cur.sumTime, p.sumTime) / 1e6
+
+		// min_time and max_time are kept since the last reset, so they are exact for the period only
+		// if they were changed during it; otherwise, the average is the best estimation we have.
+		minTime, maxTime := sum/count, sum/count
+		if p.countStar == 0 || cur.minTime < p.minTime {
+			minTime = float32(cur.minTime) / 1e6
+		}
+		if p.countStar == 0 || cur.maxTime > p.maxTime {
+			maxTime = float32(cur.maxTime) / 1e6
+		}
+
+		fingerprint, isTruncated := truncate.Query(cur.digestText, maxQueryLength, truncate.GetDefaultMaxQueryLength())
+		res = append(res, &agentv1.MetricsBucket{
+			Common: &agentv1.MetricsBucket_Common{
+				Queryid:       strings.TrimPrefix(cur.digest, "0x"),
+				Fingerprint:   fingerprint,
+				IsTruncated:   isTruncated,
+				Schema:        cur.schema,
+				Username:      cur.username,
+				Comments:      map[string]string{hostgroupLabel: strconv.FormatInt(cur.hostgroup, 10)},
+				AgentType:     inventoryv1.AgentType_AGENT_TYPE_QAN_PROXYSQL_DIGEST_AGENT,
+				NumQueries:    count,
+				MQueryTimeCnt: count,
+				MQueryTimeSum: sum,
+				MQueryTimeMin: minTime,
+				MQueryTimeMax: maxTime,
+			},
+		})
+	}
+
+	return res
+}
+
+// Changes returns channel that should be read until it is closed.
+func (d *Digest) Changes() <-chan agents.Change {
+	return d.changes
+}
+
+// Describe implements prometheus.Collector.
+func (d *Digest) Describe(_ chan<- *prometheus.Desc) {
+	// This method is needed to satisfy interface.
+}
+
+// Collect implement prometheus.Collector.
+func (d *Digest) Collect(_ chan<- prometheus.Metric) {
+	// This method is needed to satisfy interface.
+}
+
+// check interfaces.
+var (
+	_ prometheus.Collector = (*Digest)(nil)
+)
@@ -0,0 +1,132 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package digest
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
+)
+
+func TestMakeBuckets(t *testing.T) {
+	t.Parallel()
+
+	l := logrus.WithField("test", t.Name())
+
+	digests := func(stats ...*digestStats) digestMap {
+		res := make(digestMap)
+		for _, s := range stats {
+			res[s.key()] = s
+		}
+		return res
+	}
+
+	t.Run("New", func(t *testing.T) {
+		t.Parallel()
+
+		current := digests(&digestStats{
+			hostgroup:  10,
+			schema:     "sbtest",
+			username:   "app",
+			digest:     "0x3765930C7143F468",
+			digestText: "SELECT c FROM sbtest1 WHERE id=?",
+			countStar:  4,
+			sumTime:    10000,
+			minTime:    1000,
+			maxTime:    4000,
+		})
+
+		actual := makeBuckets(current, digestMap{}, l, 0)
+		expected := []*agentv1.MetricsBucket{{
+			Common: &agentv1.MetricsBucket_Common{
+				Queryid:       "3765930C7143F468",
+				Fingerprint:   "SELECT c FROM sbtest1 WHERE id=?",
+				Schema:        "sbtest",
+				Username:      "app",
+				Comments:      map[string]string{"hostgroup": "10"},
+				AgentType:     inventoryv1.AgentType_AGENT_TYPE_QAN_PROXYSQL_DIGEST_AGENT,
+				NumQueries:    4,
+				MQueryTimeCnt: 4,
+				MQueryTimeSum: 0.01,
+				MQueryTimeMin: 0.001,
+				MQueryTimeMax: 0.004,
+			},
+		}}
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("Normal", func(t *testing.T) {
+		t.Parallel()
+
+		prev := digests(
+			&digestStats{hostgroup: 10, digest: "0x1", digestText: "SELECT ?", countStar: 10, sumTime: 20000, minTime: 1000, maxTime: 5000},
+			&digestStats{hostgroup: 20, digest: "0x1", digestText: "SELECT ?", countStar: 10, sumTime: 20000, minTime: 1000, maxTime: 5000},
+			&digestStats{hostgroup: 10, digest: "0x2", digestText: "COMMIT", countStar: 5, sumTime: 500, minTime: 100, maxTime: 100},
+		)
+		current := digests(
+			// max_time changed, min_time is estimated
+			&digestStats{hostgroup: 10, digest: "0x1", digestText: "SELECT ?", countStar: 12, sumTime: 30000, minTime: 1000, maxTime: 8000},
+			// nothing changed, average is used for both
+			&digestStats{hostgroup: 20, digest: "0x1", digestText: "SELECT ?", countStar: 14, sumTime: 28000, minTime: 1000, maxTime: 5000},
+			// no new queries
+			&digestStats{hostgroup: 10, digest: "0x2", digestText: "COMMIT", countStar: 5, sumTime: 500, minTime: 100, maxTime: 100},
+		)
+
+		actual := makeBuckets(current, prev, l, 0)
+		require.Len(t, actual, 2)
+
+		assert.Equal(t, map[string]string{"hostgroup": "10"}, actual[0].Common.Comments)
+		assert.InDelta(t, 2, actual[0].Common.NumQueries, 0.0001)
+		assert.InDelta(t, 0.01, actual[0].Common.MQueryTimeSum, 0.0001)
+		assert.InDelta(t, 0.005, actual[0].Common.MQueryTimeMin, 0.0001)
+		assert.InDelta(t, 0.008, actual[0].Common.MQueryTimeMax, 0.0001)
+
+		assert.Equal(t, map[string]string{"hostgroup": "20"}, actual[1].Common.Comments)
+		assert.InDelta(t, 4, actual[1].Common.NumQueries, 0.0001)
+		assert.InDelta(t, 0.008, actual[1].Common.MQueryTimeSum, 0.0001)
+		assert.InDelta(t, 0.002, actual[1].Common.MQueryTimeMin, 0.0001)
+		assert.InDelta(t, 0.002, actual[1].Common.MQueryTimeMax, 0.0001)
+	})
+
+	t.Run("Reset", func(t *testing.T) {
+		t.Parallel()
+
+		prev := digests(&digestStats{digest: "0x1", digestText: "SELECT ?", countStar: 10, sumTime: 20000, minTime: 1000, maxTime: 5000})
+		current := digests(&digestStats{digest: "0x1", digestText: "SELECT ?", countStar: 3, sumTime: 9000, minTime: 2000, maxTime: 4000})
+
+		actual := makeBuckets(current, prev, l, 0)
+		require.Len(t, actual, 1)
+		assert.InDelta(t, 3, actual[0].Common.NumQueries, 0.0001)
+		assert.InDelta(t, 0.009, actual[0].Common.MQueryTimeSum, 0.0001)
+		assert.InDelta(t, 0.002, actual[0].Common.MQueryTimeMin, 0.0001)
+		assert.InDelta(t, 0.004, actual[0].Common.MQueryTimeMax, 0.0001)
+	})
+
+	t.Run("Truncate", func(t *testing.T) {
+		t.Parallel()
+
+		current := digests(&digestStats{digest: "0x1", digestText: "SELECT * FROM t WHERE id IN (?,?,?)", countStar: 1})
+
+		actual := makeBuckets(current, digestMap{}, l, 20)
+		require.Len(t, actual, 1)
+		assert.Equal(t, "SELECT * FROM t  ...", actual[0].Common.Fingerprint)
+		assert.True(t, actual[0].Common.IsTruncated)
+	})
+}
@@ -19,9 +19,11 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"path/filepath"
 	"runtime/pprof"
+	"slices"
 	"sort"
 	"strings"
 	"sync"
@@ -37,11 +39,16 @@ import (
 	mongoprofiler "github.com/percona/pmm/agent/agents/mongodb/profiler"
 	mongorta "github.com/percona/pmm/agent/agents/mongodb/realtimeanalytics"
 	"github.com/percona/pmm/agent/agents/mysql/perfschema"
+	mysqlrta "github.com/percona/pmm/agent/agents/mysql/realtimeanalytics"
 	"github.com/percona/pmm/agent/agents/mysql/slowlog"
 	"github.com/percona/pmm/agent/agents/noop"
+	"github.com/percona/pmm/agent/agents/postgres/pglog"
 	"github.com/percona/pmm/agent/agents/postgres/pgstatmonitor"
 	"github.com/percona/pmm/agent/agents/postgres/pgstatstatements"
+	pgrta "github.com/percona/pmm/agent/agents/postgres/realtimeanalytics"
 	"github.com/percona/pmm/agent/agents/process"
+	proxysqldigest "github.com/percona/pmm/agent/agents/proxysql/digest"
+	valkeyslowlog "github.com/percona/pmm/agent/agents/valkey/slowlog"
 	"github.com/percona/pmm/agent/config"
 	"github.com/percona/pmm/agent/tailog"
 	"github.com/percona/pmm/agent/utils/templates"
@@ -49,12 +56,16 @@ import (
 	agentlocal "github.com/percona/pmm/api/agentlocal/v1"
 	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
 	rtav1 "github.com/percona/pmm/api/realtimeanalytics/v1"
+	"github.com/percona/pmm/utils/secrets"
 )
 
 const (
 	changesBufferSize     = 100
 	qanRequestsBufferSize = 100
 	rtaRequestsBufferSize = 100
+
+	// secretsRefreshInterval is the interval between resolving secrets of running agents to detect rotation.
+	secretsRefreshInterval = time.Minute
 )
 
 // configGetter allows for getting a config.
@@ -72,6 +83,7 @@ type Supervisor struct {
 	changes        chan *agentv1.StateChangedRequest
 	qanRequests    chan *agentv1.QANCollectRequest
 	rtaRequests    chan *rtav1.CollectRequest
+	secrets        *secrets.Resolver
 	l              *logrus.Entry
 
 	rw             sync.RWMutex
@@ -89,7 +101,8 @@ type agentProcessInfo struct {
 	requestedState  *agentv1.SetStateRequest_AgentProcess
 	listenPort      uint16
 	processExecPath string
-	logStore        *tailog.Store // store logs
+	logStore        *tailog.Store     // store logs
+	secrets         map[string]string // resolved secret values to detect rotation
 }
 
 // builtinAgentInfo describes built-in Agent.
@@ -100,6 +113,7 @@ type builtinAgentInfo struct {
 	describe       func(chan<- *prometheus.Desc)  // agent's func to describe Prometheus metrics
 	collect        func(chan<- prometheus.Metric) // agent's func to provide Prometheus metrics
 	logStore       *tailog.Store                  // store logs
+	secrets        map[string]string              // resolved secret values to detect rotation
 }
 
 // NewSupervisor creates new Supervisor object.
@@ -117,6 +131,7 @@ func NewSupervisor(ctx context.Context, av agentVersioner, cfg configGetter) *Su
 		changes:        make(chan *agentv1.StateChangedRequest, changesBufferSize),
 		qanRequests:    make(chan *agentv1.QANCollectRequest, qanRequestsBufferSize),
 		rtaRequests:    make(chan *rtav1.CollectRequest, rtaRequestsBufferSize),
+		secrets:        secrets.NewResolver(cfg.Get().Secrets.AllowedDirs),
 		l:              logrus.WithField("component", "supervisor"),
 
 		agentProcesses: make(map[string]*agentProcessInfo),
@@ -125,10 +140,20 @@ func NewSupervisor(ctx context.Context, av agentVersioner, cfg configGetter) *Su
 	}
 }
 
-// Run waits for context and stop all agents when it's done.
+// Run periodically refreshes secrets of running agents, and stops all agents when context is done.
 func (s *Supervisor) Run(ctx context.Context) {
-	<-ctx.Done()
-	s.stopAll() //nolint:contextcheck
+	t := time.NewTicker(secretsRefreshInterval)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.stopAll() //nolint:contextcheck
+			return
+		case <-t.C:
+			s.refreshSecrets()
+		}
+	}
 }
 
 // AgentsList returns info for all Agents managed by this supervisor.
@@ -271,6 +296,67 @@ func (s *Supervisor) RestartAgents() {
 	}
 }
 
+// refreshSecrets resolves secrets of running agents again, and restarts agents with rotated secret values.
+// Secrets are resolved without holding s.rw to avoid blocking SetState requests on slow secret providers.
+func (s *Supervisor) refreshSecrets() {
+	refs := make(map[string]map[string]string) // agent ID => secret references
+	s.rw.RLock()
+	for id, agent := range s.agentProcesses {
+		if len(agent.requestedState.Secrets) != 0 {
+			refs[id] = agent.requestedState.Secrets
+		}
+	}
+	for id, agent := range s.builtinAgents {
+		if len(agent.requestedState.Secrets) != 0 {
+			refs[id] = agent.requestedState.Secrets
+		}
+	}
+	s.rw.RUnlock()
+
+	resolved := make(map[string]map[string]string, len(refs)) // agent ID => secret values
+	for id, r := range refs {
+		values, err := s.secrets.ResolveAll(s.ctx, r)
+		if err != nil {
+			s.l.Warnf("Failed to refresh secrets of agent %s: %s.", id, err)
+			continue
+		}
+		resolved[id] = values
+	}
+	if len(resolved) == 0 {
+		return
+	}
+
+	s.rw.Lock()
+	defer s.rw.Unlock()
+
+	if s.ctx.Err() != nil {
+		return
+	}
+
+	for id, values := range resolved {
+		// skip agents that were stopped or changed while secrets were resolved
+		if agent := s.agentProcesses[id]; agent != nil && maps.Equal(agent.requestedState.Secrets, refs[id]) && !maps.Equal(agent.secrets, values) {
+			s.l.Infof("Secrets of agent %s were rotated, restarting it.", id)
+			agent.cancel()
+			<-agent.done
+
+			if err := s.tryStartProcess(id, agent.requestedState, agent.listenPort); err != nil {
+				s.l.Errorf("Failed to restart Agent: %s.", err)
+			}
+		}
+
+		if agent := s.builtinAgents[id]; agent != nil && maps.Equal(agent.requestedState.Secrets, refs[id]) && !maps.Equal(agent.secrets, values) {
+			s.l.Infof("Secrets of agent %s were rotated, restarting it.", id)
+			agent.cancel()
+			<-agent.done
+
+			if err := s.startBuiltin(id, agent.requestedState); err != nil {
+				s.l.Errorf("Failed to restart Agent: %s.", err)
+			}
+		}
+	}
+}
+
 func (s *Supervisor) storeLastStatus(agentID string, status inventoryv1.AgentStatus) {
 	s.arw.Lock()
 	defer s.arw.Unlock()
@@ -474,7 +560,12 @@ func (s *Supervisor) tryStartProcess(agentID string, agentProcess *agentv1.SetSt
 // startProcess starts Agent's process.
 // Must be called with s.rw held for writing.
 func (s *Supervisor) startProcess(agentID string, agentProcess *agentv1.SetStateRequest_AgentProcess, port uint16) error {
-	processParams, err := s.processParams(agentID, agentProcess, port)
+	secretValues, err := s.secrets.ResolveAll(s.ctx, agentProcess.Secrets)
+	if err != nil {
+		return err
+	}
+
+	processParams, err := s.processParams(agentID, agentProcess, port, secretValues)
 	if err != nil {
 		return err
 	}
@@ -489,7 +580,12 @@ func (s *Supervisor) startProcess(agentID string, agentProcess *agentv1.SetState
 	})
 	l.Debugf("Starting: %s.", processParams)
 
-	processWrapper := process.New(processParams, agentProcess.RedactWords, l)
+	redactWords := agentProcess.RedactWords
+	if len(secretValues) != 0 {
+		redactWords = append(slices.Clone(redactWords), slices.Collect(maps.Values(secretValues))...)
+	}
+
+	processWrapper := process.New(processParams, redactWords, l)
 	go pprof.Do(ctx, pprof.Labels("agentID", agentID, "type", agentType), processWrapper.Run)
 
 	version, err := s.version(agentProcess.Type, processParams.Path)
@@ -520,6 +616,7 @@ func (s *Supervisor) startProcess(agentID string, agentProcess *agentv1.SetState
 		listenPort:      port,
 		processExecPath: processParams.Path,
 		logStore:        logStore,
+		secrets:         secretValues,
 	}
 
 	t := time.NewTimer(startProcessWaiting)
@@ -584,16 +681,17 @@ func (s *Supervisor) startBuiltin(agentID string, builtinAgent *agentv1.SetState
 	var agent agents.BuiltinAgent
 	var err error
 
-	var dsn string
-	if builtinAgent.TextFiles != nil {
-		tempDir := filepath.Join(cfg.Paths.TempDir, trimPrefix(builtinAgent.Type.String()), agentID)
-		dsn, err = templates.RenderDSN(builtinAgent.Dsn, builtinAgent.TextFiles, tempDir)
-		if err != nil {
-			cancel()
-			return err
-		}
-	} else {
-		dsn = builtinAgent.Dsn
+	secretValues, err := s.secrets.ResolveAll(s.ctx, builtinAgent.Secrets)
+	if err != nil {
+		cancel()
+		return err
+	}
+
+	tempDir := filepath.Join(cfg.Paths.TempDir, trimPrefix(builtinAgent.Type.String()), agentID)
+	dsn, err := templates.RenderDSNWithSecrets(builtinAgent.Dsn, builtinAgent.TextFiles, secretValues, tempDir)
+	if err != nil {
+		cancel()
+		return err
 	}
 
 	switch builtinAgent.Type {
@@ -672,6 +770,59 @@ func (s *Supervisor) startBuiltin(agentID string, builtinAgent *agentv1.SetState
 		}
 		agent, err = mongorta.New(params, l)
 
+	case inventoryv1.AgentType_AGENT_TYPE_RTA_MYSQL_AGENT:
+		params := &mysqlrta.Params{
+			DSN:             dsn,
+			AgentID:         agentID,
+			ServiceID:       builtinAgent.ServiceId,
+			ServiceName:     builtinAgent.ServiceName,
+			CollectInterval: builtinAgent.RtaOptions.GetCollectInterval().AsDuration(),
+			TextFiles:       builtinAgent.GetTextFiles(),
+			TLSSkipVerify:   builtinAgent.TlsSkipVerify,
+		}
+		agent, err = mysqlrta.New(params, l)
+
+	case inventoryv1.AgentType_AGENT_TYPE_RTA_POSTGRESQL_AGENT:
+		params := &pgrta.Params{
+			DSN:             dsn,
+			AgentID:         agentID,
+			ServiceID:       builtinAgent.ServiceId,
+			ServiceName:     builtinAgent.ServiceName,
+			CollectInterval: builtinAgent.RtaOptions.GetCollectInterval().AsDuration(),
+		}
+		agent, err = pgrta.New(params, l)
+
+	case inventoryv1.AgentType_AGENT_TYPE_QAN_VALKEY_SLOWLOG_AGENT:
+		params := &valkeyslowlog.Params{
+			DSN:                  dsn,
+			AgentID:              agentID,
+			MaxQueryLength:       builtinAgent.MaxQueryLength,
+			DisableQueryExamples: builtinAgent.DisableQueryExamples,
+			TextFiles:            builtinAgent.GetTextFiles(),
+			TLS:                  builtinAgent.Tls,
+			TLSSkipVerify:        builtinAgent.TlsSkipVerify,
+		}
+		agent, err = valkeyslowlog.New(params, l)
+
+	case inventoryv1.AgentType_AGENT_TYPE_QAN_PROXYSQL_DIGEST_AGENT:
+		params := &proxysqldigest.Params{
+			DSN:            dsn,
+			AgentID:        agentID,
+			MaxQueryLength: builtinAgent.MaxQueryLength,
+		}
+		agent, err = proxysqldigest.New(params, l)
+
+	case inventoryv1.AgentType_AGENT_TYPE_QAN_POSTGRESQL_LOG_AGENT:
+		params := &pglog.Params{
+			DSN:                    dsn,
+			AgentID:                agentID,
+			MaxQueryLength:         builtinAgent.MaxQueryLength,
+			DisableQueryExamples:   builtinAgent.DisableQueryExamples,
+			DisableCommentsParsing: builtinAgent.DisableCommentsParsing,
+			LogFilePrefix:          cfg.Paths.SlowLogFilePrefix,
+		}
+		agent, err = pglog.New(params, l)
+
 	case typeTestNoop:
 		agent = noop.New()
 
@@ -736,6 +887,7 @@ func (s *Supervisor) startBuiltin(agentID string, builtinAgent *agentv1.SetState
 		describe:       agent.Describe,
 		collect:        agent.Collect,
 		logStore:       logStore,
+		secrets:        secretValues,
 	}
 	return nil
 }
@@ -752,8 +904,13 @@ func (s *Supervisor) agentLogger(logStore *tailog.Store) *logrus.Logger {
 	}
 }
 
-// processParams makes *process.Params from SetStateRequest parameters and other data.
-func (s *Supervisor) processParams(agentID string, agentProcess *agentv1.SetStateRequest_AgentProcess, port uint16) (*process.Params, error) {
+// processParams makes *process.Params from SetStateRequest parameters, resolved secret values, and other data.
+func (s *Supervisor) processParams(
+	agentID string,
+	agentProcess *agentv1.SetStateRequest_AgentProcess,
+	port uint16,
+	secretValues map[string]string,
+) (*process.Params, error) {
 	var processParams process.Params
 	processParams.Type = agentProcess.Type
 
@@ -807,6 +964,8 @@ func (s *Supervisor) processParams(agentID string, agentProcess *agentv1.SetStat
 		return nil, fmt.Errorf("no path for agent type %[1]s (%[1]d)", agentProcess.Type)
 	}
 
+	templates.AddSecrets(templateParams, secretValues)
+
 	tr := &templates.TemplateRenderer{
 		TextFiles:          agentProcess.TextFiles,
 		TemplateLeftDelim:  agentProcess.TemplateLeftDelim,
@@ -845,6 +1004,14 @@ func (s *Supervisor) processParams(agentID string, agentProcess *agentv1.SetStat
 	}
 	processParams.Env = append(processParams.Env, env...)
 
+	// vmagent in push mode authenticates with the client certificate of pmm-agent
+	if agentProcess.Type == inventoryv1.AgentType_AGENT_TYPE_VM_AGENT && cfg.Server.ClientCertificate != "" && !cfg.Server.WithoutTLS {
+		processParams.Env = append(processParams.Env,
+			"VMAGENT_remoteWrite_tlsCertFile="+cfg.Server.ClientCertificate,
+			"VMAGENT_remoteWrite_tlsKeyFile="+cfg.Server.ClientCertificate,
+		)
+	}
+
 	for _, varName := range agentProcess.EnvVariableNames {
 		value, exists := os.LookupEnv(varName)
 		if !exists {
@@ -0,0 +1,470 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package slowlog runs built-in QAN Agent for Valkey/Redis SLOWLOG.
+package slowlog
+
+import (
+	"context"
+	"crypto/md5" //nolint:gosec
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+
+	"github.com/percona/pmm/agent/agents"
+	"github.com/percona/pmm/agent/tlshelpers"
+	"github.com/percona/pmm/agent/utils/truncate"
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
+)
+
+const (
+	collectInterval = time.Minute
+	dialTimeout     = 3 * time.Second
+)
+
+// containerCommands are commands with subcommands; the subcommand is a part of the fingerprint.
+var containerCommands = map[string]struct{}{
+	"ACL":      {},
+	"CLIENT":   {},
+	"CLUSTER":  {},
+	"COMMAND":  {},
+	"CONFIG":   {},
+	"FUNCTION": {},
+	"LATENCY":  {},
+	"MEMORY":   {},
+	"MODULE":   {},
+	"OBJECT":   {},
+	"PUBSUB":   {},
+	"SCRIPT":   {},
+	"SLOWLOG":  {},
+	"XGROUP":   {},
+	"XINFO":    {},
+}
+
+// moreArgsRe matches the last argument added by the server when the command has too many arguments.
+var moreArgsRe = regexp.MustCompile(`^\.\.\. \((\d+) more arguments\)$`)
+
+// SlowLog extracts stats data from Valkey SLOWLOG and INFO COMMANDSTATS.
+type SlowLog struct {
+	params    *Params
+	dialOpts  []redis.DialOption
+	l         *logrus.Entry
+	changes   chan agents.Change
+	prevStats map[string]commandStats
+}
+
+// Params represent Agent parameters.
+type Params struct {
+	DSN                  string
+	AgentID              string
+	MaxQueryLength       int32
+	DisableQueryExamples bool
+	TextFiles            *agentv1.TextFiles
+	TLS                  bool
+	TLSSkipVerify        bool
+}
+
+// slowlogEntry represents a single SLOWLOG GET entry.
+type slowlogEntry struct {
+	duration time.Duration
+	args     []string
+}
+
+// commandStats represents a single INFO COMMANDSTATS line.
+type commandStats struct {
+	calls         float64
+	usec          float64
+	rejectedCalls float64
+	failedCalls   float64
+}
+
+// New creates new SlowLog QAN service.
+func New(params *Params, l *logrus.Entry) (*SlowLog, error) {
+	opts, err := tlshelpers.GetValkeyTLSConfig(params.TextFiles, params.TLS, params.TLSSkipVerify)
+	if err != nil {
+		return nil, err
+	}
+	opts = append(opts, redis.DialConnectTimeout(dialTimeout))
+
+	return &SlowLog{
+		params:   params,
+		dialOpts: opts,
+		l:        l,
+		changes:  make(chan agents.Change, 10), //nolint:mnd
+	}, nil
+}
+
+// Run extracts stats data and sends it to the channel until ctx is canceled.
+func (s *SlowLog) Run(ctx context.Context) {
+	defer func() {
+		s.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_DONE}
+		close(s.changes)
+	}()
+
+	// reset slow log and remember current command stats, so old entries are not sent with incorrect timestamps
+	var running bool
+	s.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_STARTING}
+	if _, err := s.collect(ctx); err != nil {
+		s.l.Error(err)
+		s.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_WAITING}
+	} else {
+		running = true
+		s.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_RUNNING}
+	}
+
+	// query slow log every minute at 00 seconds
+	start := time.Now()
+	wait := start.Truncate(collectInterval).Add(collectInterval).Sub(start)
+	s.l.Debugf("Scheduling next collection in %s at %s.", wait, start.Add(wait).Format("15:04:05"))
+	t := time.NewTimer(wait)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_STOPPING}
+			s.l.Infof("Context canceled.")
+			return
+
+		case <-t.C:
+			if !running {
+				s.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_STARTING}
+			}
+
+			lengthS := uint32(math.Round(wait.Seconds())) // round 59.9s/60.1s to 60s
+			buckets, err := s.getNewBuckets(ctx, start, lengthS)
+
+			start = time.Now()
+			wait = start.Truncate(collectInterval).Add(collectInterval).Sub(start)
+			s.l.Debugf("Scheduling next collection in %s at %s.", wait, start.Add(wait).Format("15:04:05"))
+			t.Reset(wait)
+
+			if err != nil {
+				s.l.Error(err)
+				running = false
+				s.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_WAITING}
+				continue
+			}
+
+			if !running {
+				running = true
+				s.changes <- agents.Change{Status: inventoryv1.AgentStatus_AGENT_STATUS_RUNNING}
+			}
+
+			s.changes <- agents.Change{MetricsBucket: buckets}
+		}
+	}
+}
+
+// collect reads and resets the slow log, and updates command stats.
+// It returns slow log entries recorded since the previous call.
+func (s *SlowLog) collect(ctx context.Context) ([]slowlogEntry, error) {
+	conn, err := redis.DialURLContext(ctx, s.params.DSN, s.dialOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect: %w", err)
+	}
+	defer conn.Close() //nolint:errcheck
+
+	n, err := redis.Int(redis.DoContext(conn, ctx, "SLOWLOG", "LEN"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get slow log length: %w", err)
+	}
+
+	var entries []slowlogEntry
+	if n > 0 {
+		// Entries logged between GET and RESET are lost; that is acceptable for sampling slow commands.
+		reply, err := redis.Values(redis.DoContext(conn, ctx, "SLOWLOG", "GET", n))
+		if err != nil {
+			return nil, fmt.Errorf("failed to get slow log: %w", err)
+		}
+		if _, err = redis.DoContext(conn, ctx, "SLOWLOG", "RESET"); err != nil {
+			return nil, fmt.Errorf("failed to reset slow log: %w", err)
+		}
+		if entries, err = parseSlowlog(reply); err != nil {
+			return nil, err
+		}
+	}
+
+	info, err := redis.String(redis.DoContext(conn, ctx, "INFO", "COMMANDSTATS"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get command stats: %w", err)
+	}
+	stats := parseCommandStats(info)
+
+	prev := s.prevStats
+	s.prevStats = stats
+	if prev == nil {
+		// first call only establishes a baseline
+		return nil, nil
+	}
+
+	return entries, nil
+}
+
+func (s *SlowLog) getNewBuckets(ctx context.Context, periodStart time.Time, periodLengthSecs uint32) ([]*agentv1.MetricsBucket, error) {
+	prev := s.prevStats
+	entries, err := s.collect(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := makeBuckets(entries, s.prevStats, prev, s.params.MaxQueryLength, s.params.DisableQueryExamples)
+	startS := uint32(periodStart.Unix()) //nolint:gosec
+	s.l.Debugf("Made %d buckets out of %d slow log entries in %s+%d interval.",
+		len(buckets), len(entries), periodStart.Format("15:04:05"), periodLengthSecs)
+
+	// add agent_id and timestamps
+	for _, b := range buckets {
+		b.Common.AgentId = s.params.AgentID
+		b.Common.PeriodStartUnixSecs = startS
+		b.Common.PeriodLengthSecs = periodLengthSecs
+	}
+
+	return buckets, nil
+}
+
+// parseSlowlog parses SLOWLOG GET reply.
+func parseSlowlog(reply []any) ([]slowlogEntry, error) {
+	res := make([]slowlogEntry, 0, len(reply))
+	for _, r := range reply {
+		// id, timestamp, duration in microseconds, arguments, and, since Redis 4.0, client address and name
+		fields, err := redis.Values(r, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse slow log entry: %w", err)
+		}
+		if len(fields) < 4 { //nolint:mnd
+			return nil, fmt.Errorf("unexpected slow log entry with %d fields", len(fields))
+		}
+
+		id, err := redis.Int64(fields[0], nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse slow log entry id: %w", err)
+		}
+		usec, err := redis.Int64(fields[2], nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse slow log entry %d duration: %w", id, err)
+		}
+		args, err := redis.Strings(fields[3], nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse slow log entry %d arguments: %w", id, err)
+		}
+		if len(args) == 0 {
+			continue
+		}
+
+		res = append(res, slowlogEntry{
+			duration: time.Duration(usec) * time.Microsecond,
+			args:     args,
+		})
+	}
+
+	return res, nil
+}
+
+// parseCommandStats parses INFO COMMANDSTATS reply.
+// Stats are keyed by command name in the same format as returned by commandName.
+func parseCommandStats(info string) map[string]commandStats {
+	res := make(map[string]commandStats)
+	for line := range strings.Lines(info) {
+		line, ok := strings.CutPrefix(strings.TrimSpace(line), "cmdstat_")
+		if !ok {
+			continue
+		}
+		name, values, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+
+		var stats commandStats
+		for kv := range strings.SplitSeq(values, ",") {
+			k, v, _ := strings.Cut(kv, "=")
+			f, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				continue
+			}
+			switch k {
+			case "calls":
+				stats.calls = f
+			case "usec":
+				stats.usec = f
+			case "rejected_calls":
+				stats.rejectedCalls = f
+			case "failed_calls":
+				stats.failedCalls = f
+			}
+		}
+
+		res[strings.ToUpper(strings.ReplaceAll(name, "|", " "))] = stats
+	}
+
+	return res
+}
+
+// commandName returns the upper-case command name with the subcommand for container commands,
+// and the number of remaining arguments.
+func commandName(args []string) (string, int) {
+	name := strings.ToUpper(args[0])
+	rest := args[1:]
+	if _, ok := containerCommands[name]; ok && len(rest) > 0 {
+		name += " " + strings.ToUpper(rest[0])
+		rest = rest[1:]
+	}
+
+	n := len(rest)
+	if n > 0 {
+		if m := moreArgsRe.FindStringSubmatch(rest[n-1]); m != nil {
+			more, _ := strconv.Atoi(m[1])
+			n += more - 1
+		}
+	}
+
+	return name, n
+}
+
+// fingerprint returns the command fingerprint with all keys and values stripped.
+func fingerprint(name string, n int) string {
+	if n == 0 {
+		return name
+	}
+	return name + " ?"
+}
+
+// queryID returns the query ID for the given fingerprint.
+func queryID(fingerprint string) string {
+	h := md5.Sum([]byte(fingerprint)) //nolint:gosec
+	return fmt.Sprintf("%X", h[:8])
+}
+
+// makeBuckets uses slow log entries and the difference between current and previous command stats
+// to make metrics buckets. It's a pure function for easier testing.
+func makeBuckets(
+	entries []slowlogEntry,
+	current, prev map[string]commandStats,
+	maxQueryLength int32,
+	disableQueryExamples bool,
+) []*agentv1.MetricsBucket {
+	buckets := make(map[string]*agentv1.MetricsBucket)
+	names := make(map[string]string)
+	slowest := make(map[string]time.Duration)
+
+	for _, e := range entries {
+		name, n := commandName(e.args)
+		fp := fingerprint(name, n)
+		d := float32(e.duration.Seconds())
+		args := float32(n)
+
+		mb := buckets[fp]
+		if mb == nil {
+			mb = &agentv1.MetricsBucket{
+				Common: &agentv1.MetricsBucket_Common{
+					Queryid:       queryID(fp),
+					Fingerprint:   fp,
+					AgentType:     inventoryv1.AgentType_AGENT_TYPE_QAN_VALKEY_SLOWLOG_AGENT,
+					MQueryTimeMin: d,
+				},
+				Valkey: &agentv1.MetricsBucket_Valkey{
+					MArgsMin: args,
+				},
+			}
+			buckets[fp] = mb
+			names[fp] = name
+		}
+
+		mb.Common.NumQueries++
+		mb.Common.MQueryTimeCnt++
+		mb.Common.MQueryTimeSum += d
+		mb.Common.MQueryTimeMin = min(mb.Common.MQueryTimeMin, d)
+		mb.Common.MQueryTimeMax = max(mb.Common.MQueryTimeMax, d)
+
+		mb.Valkey.MArgsCnt++
+		mb.Valkey.MArgsSum += args
+		mb.Valkey.MArgsMin = min(mb.Valkey.MArgsMin, args)
+		mb.Valkey.MArgsMax = max(mb.Valkey.MArgsMax, args)
+
+		if !disableQueryExamples && e.duration >= slowest[fp] {
+			slowest[fp] = e.duration
+			example, truncated := truncate.Query(strings.Join(e.args, " "), maxQueryLength, truncate.GetDefaultMaxQueryLength())
+			mb.Common.Example = example
+			mb.Common.ExampleType = agentv1.ExampleType_EXAMPLE_TYPE_SLOWEST
+			mb.Common.IsTruncated = truncated
+		}
+	}
+
+	res := make([]*agentv1.MetricsBucket, 0, len(buckets))
+	for fp, mb := range buckets {
+		cur, ok := current[names[fp]]
+		if ok && prev != nil {
+			p := prev[names[fp]]
+			if cur.calls < p.calls {
+				// CONFIG RESETSTAT was called
+				p = commandStats{}
+			}
+
+			count := mb.Common.NumQueries
+			for _, v := range []struct {
+				value float32  // result value: cur.XXX-p.XXX
+				sum   *float32 // MetricsBucket.XXXSum field to write value
+				cnt   *float32 // MetricsBucket.XXXCnt field to write count
+			}{
+				{float32(cur.calls - p.calls), &mb.Valkey.MTotalCallsSum, &mb.Valkey.MTotalCallsCnt},
+				// convert microseconds to seconds
+				{float32(cur.usec-p.usec) / 1e6, &mb.Valkey.MTotalTimeSum, &mb.Valkey.MTotalTimeCnt},
+				{float32(cur.rejectedCalls - p.rejectedCalls), &mb.Valkey.MRejectedCallsSum, &mb.Valkey.MRejectedCallsCnt},
+				{float32(cur.failedCalls - p.failedCalls), &mb.Valkey.MFailedCallsSum, &mb.Valkey.MFailedCallsCnt},
+			} {
+				if v.value > 0 {
+					*v.sum = v.value
+					*v.cnt = count
+				}
+			}
+		}
+
+		res = append(res, mb)
+	}
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].Common.Fingerprint < res[j].Common.Fingerprint
+	})
+
+	return res
+}
+
+// Changes returns channel that should be read until it is closed.
+func (s *SlowLog) Changes() <-chan agents.Change {
+	return s.changes
+}
+
+// Describe implements prometheus.Collector.
+func (s *SlowLog) Describe(_ chan<- *prometheus.Desc) {
+	// This method is needed to satisfy interface.
+}
+
+// Collect implement prometheus.Collector.
+func (s *SlowLog) Collect(_ chan<- prometheus.Metric) {
+	// This method is needed to satisfy interface.
+}
+
+// check interfaces.
+var (
+	_ prometheus.Collector = (*SlowLog)(nil)
+)
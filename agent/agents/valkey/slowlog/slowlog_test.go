@@ -0,0 +1,184 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package slowlog
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
+)
+
+func TestParseSlowlog(t *testing.T) {
+	t.Parallel()
+
+	reply := []any{
+		[]any{int64(2), int64(1700000000), int64(15000), []any{[]byte("SET"), []byte("key"), []byte("value")}, []byte("127.0.0.1:50000"), []byte("")},
+		// Redis < 4.0 entries don't have client address and name
+		[]any{int64(1), int64(1700000000), int64(20), []any{[]byte("PING")}},
+	}
+
+	entries, err := parseSlowlog(reply)
+	require.NoError(t, err)
+	expected := []slowlogEntry{
+		{duration: 15 * time.Millisecond, args: []string{"SET", "key", "value"}},
+		{duration: 20 * time.Microsecond, args: []string{"PING"}},
+	}
+	assert.Equal(t, expected, entries)
+
+	_, err = parseSlowlog([]any{[]any{int64(1)}})
+	assert.EqualError(t, err, "unexpected slow log entry with 1 fields")
+}
+
+func TestParseCommandStats(t *testing.T) {
+	t.Parallel()
+
+	info := "# Commandstats\r\n" +
+		"cmdstat_get:calls=10,usec=25,usec_per_call=2.50,rejected_calls=1,failed_calls=2\r\n" +
+		"cmdstat_config|get:calls=3,usec=90,usec_per_call=30.00,rejected_calls=0,failed_calls=0\r\n" +
+		"\r\n"
+
+	expected := map[string]commandStats{
+		"GET":        {calls: 10, usec: 25, rejectedCalls: 1, failedCalls: 2},
+		"CONFIG GET": {calls: 3, usec: 90},
+	}
+	assert.Equal(t, expected, parseCommandStats(info))
+}
+
+func TestFingerprint(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		args        []string
+		fingerprint string
+		name        string
+		n           int
+	}{
+		{[]string{"ping"}, "PING", "PING", 0},
+		{[]string{"get", "user:1"}, "GET ?", "GET", 1},
+		{[]string{"MSET", "a", "1", "b", "2"}, "MSET ?", "MSET", 4},
+		{[]string{"config", "get", "maxmemory"}, "CONFIG GET ?", "CONFIG GET", 1},
+		{[]string{"CLIENT", "LIST"}, "CLIENT LIST", "CLIENT LIST", 0},
+		{[]string{"DEL", "k1", "k2", "... (10 more arguments)"}, "DEL ?", "DEL", 12},
+	} {
+		t.Run(tc.fingerprint, func(t *testing.T) {
+			t.Parallel()
+
+			name, n := commandName(tc.args)
+			assert.Equal(t, tc.name, name)
+			assert.Equal(t, tc.n, n)
+			assert.Equal(t, tc.fingerprint, fingerprint(name, n))
+		})
+	}
+}
+
+func TestMakeBuckets(t *testing.T) {
+	t.Parallel()
+
+	entries := []slowlogEntry{
+		{duration: 10 * time.Millisecond, args: []string{"GET", "a"}},
+		{duration: 30 * time.Millisecond, args: []string{"GET", "b"}},
+		{duration: 20 * time.Millisecond, args: []string{"KEYS", "*"}},
+	}
+	prev := map[string]commandStats{
+		"GET":  {calls: 100, usec: 1000},
+		"KEYS": {calls: 50, usec: 5000000},
+	}
+	current := map[string]commandStats{
+		"GET":  {calls: 200, usec: 3000, failedCalls: 1},
+		"KEYS": {calls: 1, usec: 20000}, // stats were reset
+	}
+
+	t.Run("Normal", func(t *testing.T) {
+		t.Parallel()
+
+		actual := makeBuckets(entries, current, prev, 0, false)
+		expected := []*agentv1.MetricsBucket{
+			{
+				Common: &agentv1.MetricsBucket_Common{
+					Queryid:       queryID("GET ?"),
+					Fingerprint:   "GET ?",
+					AgentType:     inventoryv1.AgentType_AGENT_TYPE_QAN_VALKEY_SLOWLOG_AGENT,
+					Example:       "GET b",
+					ExampleType:   agentv1.ExampleType_EXAMPLE_TYPE_SLOWEST,
+					NumQueries:    2,
+					MQueryTimeCnt: 2,
+					MQueryTimeSum: 0.04,
+					MQueryTimeMin: 0.01,
+					MQueryTimeMax: 0.03,
+				},
+				Valkey: &agentv1.MetricsBucket_Valkey{
+					MArgsCnt:        2,
+					MArgsSum:        2,
+					MArgsMin:        1,
+					MArgsMax:        1,
+					MTotalCallsCnt:  2,
+					MTotalCallsSum:  100,
+					MTotalTimeCnt:   2,
+					MTotalTimeSum:   0.002,
+					MFailedCallsCnt: 2,
+					MFailedCallsSum: 1,
+				},
+			},
+			{
+				Common: &agentv1.MetricsBucket_Common{
+					Queryid:       queryID("KEYS ?"),
+					Fingerprint:   "KEYS ?",
+					AgentType:     inventoryv1.AgentType_AGENT_TYPE_QAN_VALKEY_SLOWLOG_AGENT,
+					Example:       "KEYS *",
+					ExampleType:   agentv1.ExampleType_EXAMPLE_TYPE_SLOWEST,
+					NumQueries:    1,
+					MQueryTimeCnt: 1,
+					MQueryTimeSum: 0.02,
+					MQueryTimeMin: 0.02,
+					MQueryTimeMax: 0.02,
+				},
+				Valkey: &agentv1.MetricsBucket_Valkey{
+					MArgsCnt:       1,
+					MArgsSum:       1,
+					MArgsMin:       1,
+					MArgsMax:       1,
+					MTotalCallsCnt: 1,
+					MTotalCallsSum: 1,
+					MTotalTimeCnt:  1,
+					MTotalTimeSum:  0.02,
+				},
+			},
+		}
+		require.Len(t, actual, len(expected))
+		for i := range expected {
+			assert.InDelta(t, expected[i].Common.MQueryTimeSum, actual[i].Common.MQueryTimeSum, 1e-6)
+			assert.InDelta(t, expected[i].Valkey.MTotalTimeSum, actual[i].Valkey.MTotalTimeSum, 1e-6)
+			expected[i].Common.MQueryTimeSum = actual[i].Common.MQueryTimeSum
+			expected[i].Valkey.MTotalTimeSum = actual[i].Valkey.MTotalTimeSum
+			assert.Equal(t, expected[i].String(), actual[i].String())
+		}
+	})
+
+	t.Run("NoExamplesNoBaseline", func(t *testing.T) {
+		t.Parallel()
+
+		actual := makeBuckets(entries, current, nil, 0, true)
+		require.Len(t, actual, 2)
+		for _, mb := range actual {
+			assert.Empty(t, mb.Common.Example)
+			assert.Zero(t, mb.Valkey.MTotalCallsSum)
+		}
+	})
+}
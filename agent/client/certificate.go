@@ -0,0 +1,108 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/percona/pmm/agent/utils/clientcert"
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+)
+
+// certificateRenewalInterval is how often the client certificate is checked for renewal.
+const certificateRenewalInterval = time.Hour
+
+// loadClientCertificate returns the client certificate issued by PMM Server if it is valid and not expired.
+func loadClientCertificate(path string) (*tls.Certificate, error) {
+	cert, err := clientcert.Load(path)
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().After(cert.Leaf.NotAfter) {
+		return nil, fmt.Errorf("client certificate %s is expired", path)
+	}
+	return cert, nil
+}
+
+// getClientCertificate returns tls.Config.GetClientCertificate callback that reads the client certificate
+// issued by PMM Server on every handshake, so the renewed certificate is used after reconnection.
+// If there is no valid certificate, none is sent, and pmm-agent authenticates with the service token, if any.
+func getClientCertificate(path string, l *logrus.Entry) func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	return func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+		cert, err := loadClientCertificate(path)
+		if err != nil {
+			l.Warnf("Failed to load client certificate: %s.", err)
+			return &tls.Certificate{}, nil
+		}
+		return cert, nil
+	}
+}
+
+// processCertificateRenewal renews the client certificate issued by PMM Server before it expires.
+// It exits when done is closed.
+func (c *Client) processCertificateRenewal(path string, done <-chan struct{}) {
+	t := time.NewTicker(certificateRenewalInterval)
+	defer t.Stop()
+
+	for {
+		if err := c.renewCertificate(path); err != nil {
+			c.l.Warnf("Failed to renew client certificate: %s.", err)
+		}
+
+		select {
+		case <-done:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// renewCertificate requests a new client certificate if the current one is missing, invalid, or expires soon.
+func (c *Client) renewCertificate(path string) error {
+	cert, err := clientcert.Load(path)
+	if err == nil && !clientcert.NeedsRenewal(cert.Leaf, time.Now()) {
+		return nil
+	}
+
+	keyPEM, csrPEM, err := clientcert.NewKey()
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.channel.SendAndWaitResponse(&agentv1.RenewCertificateRequest{
+		CertificateSigningRequest: string(csrPEM),
+	})
+	if err != nil {
+		return err
+	}
+	if resp == nil {
+		return errors.New("failed to send RenewCertificate request")
+	}
+	res, ok := resp.(*agentv1.RenewCertificateResponse)
+	if !ok {
+		return fmt.Errorf("unexpected response %T", resp)
+	}
+
+	if err = clientcert.Save(path, []byte(res.Certificate), keyPEM); err != nil {
+		return err
+	}
+	c.l.Infof("Client certificate %s renewed.", path)
+	return nil
+}
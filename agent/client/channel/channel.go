@@ -306,6 +306,8 @@ func (c *Channel) runReceiver() {
 			c.publish(msg.Id, msg.Status, p.QanCollect)
 		case *agentv1.ServerMessage_ActionResult:
 			c.publish(msg.Id, msg.Status, p.ActionResult)
+		case *agentv1.ServerMessage_RenewCertificate:
+			c.publish(msg.Id, msg.Status, p.RenewCertificate)
 
 		default:
 			c.cancel(msg.Id, fmt.Errorf("unimplemented: failed to handle received message %s", msg))
@@ -42,6 +42,7 @@ import (
 	"github.com/percona/pmm/agent/client/channel"
 	"github.com/percona/pmm/agent/config"
 	"github.com/percona/pmm/agent/connectionuptime"
+	"github.com/percona/pmm/agent/qanspool"
 	"github.com/percona/pmm/agent/runner"
 	"github.com/percona/pmm/agent/runner/actions" // TODO https://jira.percona.com/browse/PMM-7206
 	"github.com/percona/pmm/agent/runner/jobs"
@@ -51,6 +52,7 @@ import (
 	"github.com/percona/pmm/agent/utils/templates"
 	agentv1 "github.com/percona/pmm/api/agent/v1"
 	rtav1 "github.com/percona/pmm/api/realtimeanalytics/v1"
+	"github.com/percona/pmm/utils/secrets"
 	"github.com/percona/pmm/utils/tlsconfig"
 	"github.com/percona/pmm/version"
 )
@@ -97,10 +99,15 @@ type Client struct {
 
 	cus      *connectionuptime.Service
 	logStore *tailog.Store
+	qanSpool *qanspool.Spool
+	secrets  *secrets.Resolver
 }
 
 // New creates new client.
 //
+// If qanSpool is not nil, QAN data is read from it instead of supervisor,
+// so data collected while the server is unreachable is sent after reconnection.
+//
 // Caller should call Run.
 func New(
 	cfg configGetter,
@@ -111,6 +118,7 @@ func New(
 	sib serviceInfoBroker,
 	cus *connectionuptime.Service,
 	logStore *tailog.Store,
+	qanSpool *qanspool.Spool,
 ) *Client {
 	return &Client{
 		cfg:               cfg,
@@ -124,6 +132,8 @@ func New(
 		runner:            r,
 		cus:               cus,
 		logStore:          logStore,
+		qanSpool:          qanSpool,
+		secrets:           secrets.NewResolver(cfg.Get().Secrets.AllowedDirs),
 	}
 }
 
@@ -266,6 +276,8 @@ func (c *Client) Run(ctx context.Context) error {
 	//    When Run is left, caller stops supervisor, and that allows processSupervisorRequests to exit.
 	//
 	// Done() channel is closed when all three goroutines exited.
+	//
+	// processCertificateRenewal renews the client certificate issued by PMM Server until Done() channel is closed.
 
 	// TODO Make 2 and 3 behave more like 1 - that seems to be simpler.
 	// https://jira.percona.com/browse/PMM-4245
@@ -295,6 +307,10 @@ func (c *Client) Run(ctx context.Context) error {
 		oneDone <- struct{}{}
 	}()
 
+	if cfg.Server.ClientCertificate != "" && !cfg.Server.WithoutTLS {
+		go c.processCertificateRenewal(cfg.Server.ClientCertificate, c.done)
+	}
+
 	<-oneDone
 	go func() {
 		<-oneDone
@@ -399,6 +415,11 @@ func (c *Client) processSupervisorRequests(ctx context.Context) { //nolint:gocog
 	})
 
 	wg.Go(func() {
+		if c.qanSpool != nil {
+			c.processQANSpool(ctx)
+			return
+		}
+
 		for {
 			select {
 			case collect := <-c.supervisor.QANRequests():
@@ -438,6 +459,32 @@ func (c *Client) processSupervisorRequests(ctx context.Context) { //nolint:gocog
 	wg.Wait()
 }
 
+// processQANSpool sends QAN requests from the spool in order, removing them once they are sent.
+// A request stays in the spool if the connection is broken while it is being sent.
+func (c *Client) processQANSpool(ctx context.Context) {
+	for {
+		id, collect, err := c.qanSpool.Peek(ctx)
+		if err != nil {
+			c.l.Info("QAN spool processing stopped.")
+			return
+		}
+
+		resp, err := c.channel.SendAndWaitResponse(collect)
+		switch {
+		case err != nil:
+			// the server rejected the request, sending it again would not help
+			c.l.Error(err)
+		case resp == nil:
+			// the channel is closed, the request will be sent again after reconnection
+			c.l.Warn("Failed to send QanCollect request, keeping it in the spool.")
+			<-ctx.Done()
+			return
+		}
+
+		c.qanSpool.Remove(id)
+	}
+}
+
 func (c *Client) processChannelRequests(ctx context.Context) {
 LOOP:
 	for {
@@ -459,7 +506,7 @@ LOOP:
 
 			case *agentv1.StartActionRequest:
 				responsePayload = &agentv1.StartActionResponse{}
-				err := c.handleStartActionRequest(p)
+				err := c.handleStartActionRequest(ctx, p)
 				if err != nil {
 					status = convertAgentErrorToGrpcStatus(err)
 					break
@@ -477,7 +524,7 @@ LOOP:
 
 			case *agentv1.StartJobRequest:
 				var resp agentv1.StartJobResponse
-				err := c.handleStartJobRequest(p)
+				err := c.handleStartJobRequest(ctx, p)
 				if err != nil {
 					resp.Error = err.Error()
 				}
@@ -531,13 +578,17 @@ LOOP:
 	c.l.Debug("Channel closed.")
 }
 
-func (c *Client) handleStartActionRequest(p *agentv1.StartActionRequest) error {
+func (c *Client) handleStartActionRequest(ctx context.Context, p *agentv1.StartActionRequest) error {
 	timeout := p.Timeout.AsDuration()
 	timeoutErr := p.Timeout.CheckValid()
 	if timeoutErr != nil {
 		timeout = 0
 	}
 
+	if err := c.resolveSecrets(ctx, p, "params", p.Secrets); err != nil {
+		return err
+	}
+
 	cfg := c.cfg.Get()
 	var action actions.Action
 	var err error
@@ -630,6 +681,15 @@ func (c *Client) handleStartActionRequest(p *agentv1.StartActionRequest) error {
 			cfg.Paths.TempDir,
 		)
 
+	case *agentv1.StartActionRequest_MongodbKillOpParams:
+		action, err = actions.NewMongoDBKillOpAction(p.ActionId, timeout, params.MongodbKillOpParams, cfg.Paths.TempDir)
+
+	case *agentv1.StartActionRequest_MysqlKillQueryParams:
+		action = actions.NewMySQLKillQueryAction(p.ActionId, timeout, params.MysqlKillQueryParams)
+
+	case *agentv1.StartActionRequest_PostgresqlCancelBackendParams:
+		action, err = actions.NewPostgreSQLCancelBackendAction(p.ActionId, timeout, params.PostgresqlCancelBackendParams, cfg.Paths.TempDir)
+
 	case *agentv1.StartActionRequest_PtSummaryParams:
 		action = actions.NewProcessAction(p.ActionId, timeout, cfg.Paths.PTSummary, []string{})
 
@@ -665,13 +725,17 @@ func (c *Client) handleStartActionRequest(p *agentv1.StartActionRequest) error {
 	return c.runner.StartAction(action)
 }
 
-func (c *Client) handleStartJobRequest(p *agentv1.StartJobRequest) error {
+func (c *Client) handleStartJobRequest(ctx context.Context, p *agentv1.StartJobRequest) error {
 	err := p.Timeout.CheckValid()
 	if err != nil {
 		return err
 	}
 	timeout := p.Timeout.AsDuration()
 
+	if err = c.resolveSecrets(ctx, p, "job", p.Secrets); err != nil {
+		return err
+	}
+
 	var job jobs.Job
 	switch j := p.Job.(type) {
 	case *agentv1.StartJobRequest_MysqlBackup:
@@ -697,7 +761,34 @@ func (c *Client) handleStartJobRequest(p *agentv1.StartJobRequest) error {
 			Port:     int(j.MysqlBackup.Port),
 			Socket:   j.MysqlBackup.Socket,
 		}
-		job = jobs.NewMySQLBackupJob(p.JobId, timeout, j.MysqlBackup.Name, dbConnCfg, locationConfig, j.MysqlBackup.Folder)
+		job = jobs.NewMySQLBackupJob(p.JobId, timeout, j.MysqlBackup.Name, dbConnCfg, locationConfig, j.MysqlBackup.Folder,
+			j.MysqlBackup.EnablePitr)
+
+	case *agentv1.StartJobRequest_MysqlBinlogStream:
+		var locationConfig jobs.BackupLocationConfig
+		switch cfg := j.MysqlBinlogStream.LocationConfig.(type) {
+		case *agentv1.StartJobRequest_MySQLBinlogStream_S3Config:
+			locationConfig.Type = jobs.S3BackupLocationType
+			locationConfig.S3Config = &jobs.S3LocationConfig{
+				Endpoint:     cfg.S3Config.Endpoint,
+				AccessKey:    cfg.S3Config.AccessKey,
+				SecretKey:    cfg.S3Config.SecretKey,
+				BucketName:   cfg.S3Config.BucketName,
+				BucketRegion: cfg.S3Config.BucketRegion,
+			}
+		default:
+			return fmt.Errorf("unknown location config: %T", j.MysqlBinlogStream.LocationConfig)
+		}
+
+		dbConnCfg := jobs.DBConnConfig{
+			User:     j.MysqlBinlogStream.User,
+			Password: j.MysqlBinlogStream.Password,
+			Address:  j.MysqlBinlogStream.Address,
+			Port:     int(j.MysqlBinlogStream.Port),
+			Socket:   j.MysqlBinlogStream.Socket,
+		}
+		job = jobs.NewMySQLBinlogStreamJob(p.JobId, timeout, dbConnCfg, locationConfig, j.MysqlBinlogStream.Folder,
+			c.cfg.Get().Paths.TempDir)
 
 	case *agentv1.StartJobRequest_MysqlRestoreBackup:
 		var locationConfig jobs.BackupLocationConfig
@@ -715,7 +806,20 @@ func (c *Client) handleStartJobRequest(p *agentv1.StartJobRequest) error {
 			return fmt.Errorf("unknown location config: %T", j.MysqlRestoreBackup.LocationConfig)
 		}
 
-		job = jobs.NewMySQLRestoreJob(p.JobId, timeout, j.MysqlRestoreBackup.Name, locationConfig, j.MysqlRestoreBackup.Folder)
+		var pitrTimestamp time.Time
+		if j.MysqlRestoreBackup.PitrTimestamp != nil {
+			pitrTimestamp = j.MysqlRestoreBackup.PitrTimestamp.AsTime()
+		}
+
+		dbConnCfg := jobs.DBConnConfig{
+			User:     j.MysqlRestoreBackup.User,
+			Password: j.MysqlRestoreBackup.Password,
+			Address:  j.MysqlRestoreBackup.Address,
+			Port:     int(j.MysqlRestoreBackup.Port),
+			Socket:   j.MysqlRestoreBackup.Socket,
+		}
+		job = jobs.NewMySQLRestoreJob(p.JobId, timeout, j.MysqlRestoreBackup.Name, locationConfig, j.MysqlRestoreBackup.Folder,
+			pitrTimestamp, j.MysqlRestoreBackup.BinlogFolder, dbConnCfg)
 
 	case *agentv1.StartJobRequest_MongodbBackup:
 		var locationConfig jobs.BackupLocationConfig
@@ -778,6 +882,106 @@ func (c *Client) handleStartJobRequest(p *agentv1.StartJobRequest) error {
 		job = jobs.NewMongoDBRestoreJob(p.JobId, timeout, j.MongodbRestoreBackup.Name,
 			j.MongodbRestoreBackup.PitrTimestamp.AsTime(), dsn, locationConfig,
 			c.supervisor, j.MongodbRestoreBackup.Folder, j.MongodbRestoreBackup.PbmMetadata.Name)
+
+	case *agentv1.StartJobRequest_PostgresqlBackup:
+		var locationConfig jobs.BackupLocationConfig
+		switch cfg := j.PostgresqlBackup.LocationConfig.(type) {
+		case *agentv1.StartJobRequest_PostgreSQLBackup_S3Config:
+			locationConfig.Type = jobs.S3BackupLocationType
+			locationConfig.S3Config = &jobs.S3LocationConfig{
+				Endpoint:     cfg.S3Config.Endpoint,
+				AccessKey:    cfg.S3Config.AccessKey,
+				SecretKey:    cfg.S3Config.SecretKey,
+				BucketName:   cfg.S3Config.BucketName,
+				BucketRegion: cfg.S3Config.BucketRegion,
+			}
+		case *agentv1.StartJobRequest_PostgreSQLBackup_FilesystemConfig:
+			locationConfig.Type = jobs.FilesystemBackupLocationType
+			locationConfig.FilesystemStorageConfig = &jobs.FilesystemBackupLocationConfig{
+				Path: cfg.FilesystemConfig.Path,
+			}
+		default:
+			return fmt.Errorf("unknown location config: %T", j.PostgresqlBackup.LocationConfig)
+		}
+
+		job, err = jobs.NewPostgreSQLBackupJob(p.JobId, timeout, j.PostgresqlBackup.Name, j.PostgresqlBackup.Dsn,
+			j.PostgresqlBackup.TextFiles, j.PostgresqlBackup.DataModel, locationConfig, j.PostgresqlBackup.Folder,
+			c.cfg.Get().Paths.TempDir)
+		if err != nil {
+			return err
+		}
+
+	case *agentv1.StartJobRequest_PostgresqlRestoreBackup:
+		var locationConfig jobs.BackupLocationConfig
+		switch cfg := j.PostgresqlRestoreBackup.LocationConfig.(type) {
+		case *agentv1.StartJobRequest_PostgreSQLRestoreBackup_S3Config:
+			locationConfig.Type = jobs.S3BackupLocationType
+			locationConfig.S3Config = &jobs.S3LocationConfig{
+				Endpoint:     cfg.S3Config.Endpoint,
+				AccessKey:    cfg.S3Config.AccessKey,
+				SecretKey:    cfg.S3Config.SecretKey,
+				BucketName:   cfg.S3Config.BucketName,
+				BucketRegion: cfg.S3Config.BucketRegion,
+			}
+		case *agentv1.StartJobRequest_PostgreSQLRestoreBackup_FilesystemConfig:
+			locationConfig.Type = jobs.FilesystemBackupLocationType
+			locationConfig.FilesystemStorageConfig = &jobs.FilesystemBackupLocationConfig{
+				Path: cfg.FilesystemConfig.Path,
+			}
+		default:
+			return fmt.Errorf("unknown location config: %T", j.PostgresqlRestoreBackup.LocationConfig)
+		}
+
+		job, err = jobs.NewPostgreSQLRestoreJob(p.JobId, timeout, j.PostgresqlRestoreBackup.Name, j.PostgresqlRestoreBackup.Dsn,
+			j.PostgresqlRestoreBackup.TextFiles, j.PostgresqlRestoreBackup.DataModel, locationConfig, j.PostgresqlRestoreBackup.Folder,
+			c.cfg.Get().Paths.TempDir)
+		if err != nil {
+			return err
+		}
+
+	case *agentv1.StartJobRequest_MysqlVerifyBackup:
+		var locationConfig jobs.BackupLocationConfig
+		switch cfg := j.MysqlVerifyBackup.LocationConfig.(type) {
+		case *agentv1.StartJobRequest_MySQLVerifyBackup_S3Config:
+			locationConfig.Type = jobs.S3BackupLocationType
+			locationConfig.S3Config = &jobs.S3LocationConfig{
+				Endpoint:     cfg.S3Config.Endpoint,
+				AccessKey:    cfg.S3Config.AccessKey,
+				SecretKey:    cfg.S3Config.SecretKey,
+				BucketName:   cfg.S3Config.BucketName,
+				BucketRegion: cfg.S3Config.BucketRegion,
+			}
+		default:
+			return fmt.Errorf("unknown location config: %T", j.MysqlVerifyBackup.LocationConfig)
+		}
+
+		job = jobs.NewMySQLVerifyJob(p.JobId, timeout, j.MysqlVerifyBackup.Name, locationConfig, j.MysqlVerifyBackup.Folder,
+			c.cfg.Get().Paths.TempDir)
+
+	case *agentv1.StartJobRequest_MongodbVerifyBackup:
+		var locationConfig jobs.BackupLocationConfig
+		switch cfg := j.MongodbVerifyBackup.LocationConfig.(type) {
+		case *agentv1.StartJobRequest_MongoDBVerifyBackup_S3Config:
+			locationConfig.Type = jobs.S3BackupLocationType
+			locationConfig.S3Config = &jobs.S3LocationConfig{
+				Endpoint:     cfg.S3Config.Endpoint,
+				AccessKey:    cfg.S3Config.AccessKey,
+				SecretKey:    cfg.S3Config.SecretKey,
+				BucketName:   cfg.S3Config.BucketName,
+				BucketRegion: cfg.S3Config.BucketRegion,
+			}
+		case *agentv1.StartJobRequest_MongoDBVerifyBackup_FilesystemConfig:
+			locationConfig.Type = jobs.FilesystemBackupLocationType
+			locationConfig.FilesystemStorageConfig = &jobs.FilesystemBackupLocationConfig{
+				Path: cfg.FilesystemConfig.Path,
+			}
+		default:
+			return fmt.Errorf("unknown location config: %T", j.MongodbVerifyBackup.LocationConfig)
+		}
+
+		job = jobs.NewMongoDBVerifyJob(p.JobId, timeout, j.MongodbVerifyBackup.Name,
+			j.MongodbVerifyBackup.PbmMetadata.GetName(), locationConfig, j.MongodbVerifyBackup.Folder)
+
 	default:
 		return fmt.Errorf("unknown job type: %T", j)
 	}
@@ -840,6 +1044,7 @@ func createConnectionToServer(cfg *config.Config, l *logrus.Entry) (*grpc.Client
 			Timeout: keepaliveTimeout,
 		}),
 	}
+	var useCertificate bool
 	if cfg.Server.WithoutTLS {
 		opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	} else {
@@ -847,10 +1052,17 @@ func createConnectionToServer(cfg *config.Config, l *logrus.Entry) (*grpc.Client
 		tlsConfig := tlsconfig.Get()
 		tlsConfig.ServerName = host
 		tlsConfig.InsecureSkipVerify = cfg.Server.InsecureTLS
+		if cfg.Server.ClientCertificate != "" {
+			tlsConfig.GetClientCertificate = getClientCertificate(cfg.Server.ClientCertificate, l)
+			_, err := loadClientCertificate(cfg.Server.ClientCertificate)
+			useCertificate = err == nil
+		}
 		opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
 	}
 
-	if cfg.Server.Username != "" {
+	// PMM Server doesn't accept the token of pmm-agent with a client certificate,
+	// so it is sent only until the first certificate is obtained.
+	if cfg.Server.Username != "" && !useCertificate {
 		if cfg.Server.Username == "service_token" || cfg.Server.Username == "api_key" {
 			opts = append(opts, grpc.WithPerRPCCredentials(&tokenAuth{
 				token: cfg.Server.Password,
@@ -1053,6 +1265,16 @@ func (c *Client) GetServerConnectMetadata() *agentv1.ServerConnectMetadata {
 	return md
 }
 
+// GetQANSpoolStats returns the state of QAN spool, or nil if it is disabled.
+func (c *Client) GetQANSpoolStats() *qanspool.Stats {
+	if c.qanSpool == nil {
+		return nil
+	}
+
+	stats := c.qanSpool.Stats()
+	return &stats
+}
+
 // GetConnectionUpTime returns connection uptime between agent and server in percentage (from 0 to 100).
 func (c *Client) GetConnectionUpTime() float32 {
 	return c.cus.GetConnectedUpTimeUntil(time.Now())
@@ -1085,6 +1307,10 @@ func (c *Client) Collect(ch chan<- prometheus.Metric) {
 	}
 
 	c.supervisor.Collect(ch)
+
+	if c.qanSpool != nil {
+		c.qanSpool.Collect(ch)
+	}
 }
 
 // argListFromPgParams creates an array of strings from the pointer to the parameters for pt-pg-sumamry.
@@ -43,6 +43,9 @@ type softwareVersioner interface {
 	QpressVersion() (string, error)
 	MongoDBVersion() (string, error)
 	PBMVersion() (string, error)
+	PGBasebackupVersion() (string, error)
+	PGDumpVersion() (string, error)
+	PGRestoreVersion() (string, error)
 }
 
 // supervisor is a subset of methods of supervisor.Supervisor used by this package.
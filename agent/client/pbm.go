@@ -37,7 +37,11 @@ func (c *Client) handlePBMSwitchRequest(ctx context.Context, req *agentv1.PBMSwi
 	}
 
 	tempdir := filepath.Join(c.cfg.Get().Paths.TempDir, "pbm-switch-pitr", strconv.Itoa(int(id)))
-	dsn, err := templates.RenderDSN(req.Dsn, req.TextFiles, tempdir)
+	secretValues, err := c.secrets.ResolveAll(ctx, req.Secrets)
+	if err != nil {
+		return err
+	}
+	dsn, err := templates.RenderDSNWithSecrets(req.Dsn, req.TextFiles, secretValues, tempdir)
 	defer templates.CleanupTempDir(tempdir, c.l)
 	if err != nil {
 		return err
@@ -0,0 +1,75 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/percona/pmm/agent/utils/templates"
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+)
+
+// resolveSecrets resolves secret references of the action or job request and replaces them in parameters
+// set in the given oneof: placeholders in the dsn field, and the password field equal to the reference.
+func (c *Client) resolveSecrets(ctx context.Context, req proto.Message, oneof protoreflect.Name, refs map[string]string) error {
+	if len(refs) == 0 {
+		return nil
+	}
+
+	values, err := c.secrets.ResolveAll(ctx, refs)
+	if err != nil {
+		return err
+	}
+
+	m := req.ProtoReflect()
+	fd := m.WhichOneof(m.Descriptor().Oneofs().ByName(oneof))
+	if fd == nil || fd.Message() == nil {
+		return nil
+	}
+	params := m.Get(fd).Message()
+	fields := params.Descriptor().Fields()
+
+	if dsnField := fields.ByName("dsn"); dsnField != nil {
+		var files *agentv1.TextFiles
+		for i := range fields.Len() {
+			f := fields.Get(i)
+			if f.Kind() == protoreflect.MessageKind && f.Cardinality() != protoreflect.Repeated && params.Has(f) {
+				if tf, ok := params.Get(f).Message().Interface().(*agentv1.TextFiles); ok {
+					files = tf
+				}
+			}
+		}
+
+		dsn, err := templates.RenderSecrets(params.Get(dsnField).String(), files, values)
+		if err != nil {
+			return err
+		}
+		params.Set(dsnField, protoreflect.ValueOfString(dsn))
+	}
+
+	if passwordField := fields.ByName("password"); passwordField != nil {
+		for name, ref := range refs {
+			if params.Get(passwordField).String() == ref {
+				params.Set(passwordField, protoreflect.ValueOfString(values[name]))
+				break
+			}
+		}
+	}
+
+	return nil
+}
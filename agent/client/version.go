@@ -40,6 +40,12 @@ func (c *Client) handleVersionsRequest(r *agentv1.GetVersionsRequest) []*agentv1
 			version, err = c.softwareVersioner.MongoDBVersion()
 		case *agentv1.GetVersionsRequest_Software_Pbm:
 			version, err = c.softwareVersioner.PBMVersion()
+		case *agentv1.GetVersionsRequest_Software_PgBasebackup:
+			version, err = c.softwareVersioner.PGBasebackupVersion()
+		case *agentv1.GetVersionsRequest_Software_PgDump:
+			version, err = c.softwareVersioner.PGDumpVersion()
+		case *agentv1.GetVersionsRequest_Software_PgRestore:
+			version, err = c.softwareVersioner.PGRestoreVersion()
 		default:
 			err = fmt.Errorf("unknown software type %T", s.Software)
 		}
@@ -162,7 +162,7 @@ func ParseKeyValuePair(labels string) (map[string]string, error) {
 // serverRegister registers Node on PMM Server.
 //
 // This method is not thread-safe.
-func serverRegister(cfgSetup *config.Setup) (agentID, token string, _ error) { //nolint:nonamedreturns
+func serverRegister(cfgSetup *config.Setup, csr string) (agentID, token, clientCertificate string, _ error) { //nolint:nonamedreturns
 	nodeTypes := map[string]string{
 		"generic":   mservice.RegisterNodeBodyNodeTypeNODETYPEGENERICNODE,
 		"container": mservice.RegisterNodeBodyNodeTypeNODETYPECONTAINERNODE,
@@ -178,7 +178,7 @@ func serverRegister(cfgSetup *config.Setup) (agentID, token string, _ error) { /
 
 	customLabels, err := ParseKeyValuePair(cfgSetup.CustomLabels)
 	if err != nil {
-		return "", "", err
+		return "", "", "", err
 	}
 
 	res, err := managementClient.Default.ManagementService.RegisterNode(&mservice.RegisterNodeParams{
@@ -200,17 +200,19 @@ func serverRegister(cfgSetup *config.Setup) (agentID, token string, _ error) { /
 			MetricsMode:       new(strings.ToUpper("METRICS_MODE_" + cfgSetup.MetricsMode)),
 			DisableCollectors: disableCollectors,
 			ExposeExporter:    cfgSetup.ExposeExporter,
+
+			CertificateSigningRequest: csr,
 		},
 		Context: context.Background(),
 	})
 	if err != nil {
-		return "", "", err
+		return "", "", "", err
 	}
 	// TODO: Investigate what can lead to PMMAgent being nil in the response
 	if res.Payload == nil || res.Payload.PMMAgent == nil {
-		return "", "", errors.New("unexpected empty response from PMM Server (missing pmm_agent)")
+		return "", "", "", errors.New("unexpected empty response from PMM Server (missing pmm_agent)")
 	}
-	return res.Payload.PMMAgent.AgentID, res.Payload.Token, nil
+	return res.Payload.PMMAgent.AgentID, res.Payload.Token, res.Payload.ClientCertificate, nil
 }
 
 // check interfaces.
@@ -32,6 +32,7 @@ import (
 	"github.com/percona/pmm/agent/config"
 	"github.com/percona/pmm/agent/connectionchecker"
 	"github.com/percona/pmm/agent/connectionuptime"
+	"github.com/percona/pmm/agent/qanspool"
 	"github.com/percona/pmm/agent/runner"
 	"github.com/percona/pmm/agent/serviceinfobroker"
 	"github.com/percona/pmm/agent/tailog"
@@ -72,7 +73,8 @@ func Run() {
 		connectionChecker := connectionchecker.New(configStorage)
 		serviceInfoBroker := serviceinfobroker.New(configStorage)
 		r := runner.New(cfg.RunnerCapacity, cfg.RunnerMaxConnectionsPerService)
-		client := client.New(configStorage, supervisor, r, connectionChecker, v, serviceInfoBroker, prepareConnectionService(ctx, cfg), logStore)
+		qanSpool := prepareQANSpool(cfg, l)
+		client := client.New(configStorage, supervisor, r, connectionChecker, v, serviceInfoBroker, prepareConnectionService(ctx, cfg), logStore, qanSpool)
 		localServer := agentlocal.NewServer(configStorage, supervisor, client, configFilepath, logStore)
 
 		logrus.Infof("Window check connection time is %.2f hour(s)", cfg.WindowConnectedTime.Hours())
@@ -95,6 +97,13 @@ func Run() {
 			localServer.Run(ctx, reloadCh)
 			cancel()
 		}()
+		if qanSpool != nil {
+			// QAN data is stored even while there is no connection to the server;
+			// supervisor closes the channel when it is stopped.
+			wg.Go(func() {
+				qanSpool.Run(supervisor.QANRequests())
+			})
+		}
 
 		processClientUntilCancel(ctx, client, reloadCh)
 
@@ -129,6 +138,18 @@ func processClientUntilCancel(ctx context.Context, client *client.Client, reload
 	}
 }
 
+// prepareQANSpool opens QAN spool. It returns nil if spool can't be opened,
+// in that case QAN data is sent directly and lost while the server is unreachable.
+func prepareQANSpool(cfg *config.Config, l *logrus.Entry) *qanspool.Spool {
+	spool, err := qanspool.New(cfg.Paths.QANSpoolDir, cfg.QANSpool.MaxSize, cfg.QANSpool.MaxAge)
+	if err != nil {
+		l.Errorf("Failed to open QAN spool, QAN data will not be kept while PMM Server is unreachable: %s.", err)
+		return nil
+	}
+
+	return spool
+}
+
 func prepareConfig(l *logrus.Entry) (*config.Storage, string) {
 	configStorage := config.NewStorage(nil)
 	configFilepath, err := configStorage.Reload(l)
@@ -20,12 +20,15 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
+	"syscall"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	"google.golang.org/grpc/codes"
 
 	"github.com/percona/pmm/agent/config"
+	"github.com/percona/pmm/agent/utils/clientcert"
 	agent_local "github.com/percona/pmm/api/agentlocal/v1/json/client/agent_local_service"
 	mservice "github.com/percona/pmm/api/management/v1/json/client/management_service"
 )
@@ -72,7 +75,7 @@ func Setup() {
 	}
 
 	if !cfg.Setup.SkipRegistration {
-		register(cfg, l)
+		register(cfg, configFilepath, l)
 	}
 
 	cfg.ProcMountsPath = cfg.Setup.ProcMountsPath
@@ -132,7 +135,7 @@ func checkStatus(configFilepath string, l *logrus.Entry) (string, bool) {
 	}
 }
 
-func register(cfg *config.Config, l *logrus.Entry) {
+func register(cfg *config.Config, configFilepath string, l *logrus.Entry) {
 	fmt.Printf("Registering pmm-agent on PMM Server...\n")
 
 	u := cfg.Server.URL()
@@ -142,7 +145,19 @@ func register(cfg *config.Config, l *logrus.Entry) {
 	}
 
 	setServerTransport(u, cfg.Server.InsecureTLS, l)
-	agentID, token, err := serverRegister(&cfg.Setup)
+
+	// client certificate can't be sent without TLS, so pmm-agent gets the service token instead
+	var keyPEM, csrPEM []byte
+	if !cfg.Server.WithoutTLS {
+		var err error
+		keyPEM, csrPEM, err = clientcert.NewKey()
+		if err != nil {
+			fmt.Printf("Failed to generate client certificate key: %s.\n", err)
+			os.Exit(1)
+		}
+	}
+
+	agentID, token, certPEM, err := serverRegister(&cfg.Setup, string(csrPEM))
 	l.Debugf("Register error: %#v", err)
 	if err != nil {
 		msg := err.Error()
@@ -164,15 +179,54 @@ func register(cfg *config.Config, l *logrus.Entry) {
 		os.Exit(1)
 	}
 	cfg.ID = agentID
-	if token != "" {
+	switch {
+	case certPEM != "":
+		// pmm-agent authenticates with the client certificate only,
+		// so neither the token nor the credentials used for registration are stored
+		if err = saveClientCertificate(cfg, configFilepath, []byte(certPEM), keyPEM); err != nil {
+			fmt.Printf("Failed to write client certificate: %s.\n", err)
+			os.Exit(1)
+		}
+		cfg.Server.Username = ""
+		cfg.Server.Password = ""
+	case token != "":
 		cfg.Server.Username = "service_token"
 		cfg.Server.Password = token
-	} else {
+	default:
 		l.Info("PMM Server responded with an empty service token. Consider upgrading PMM Server to the latest version.")
 	}
 	fmt.Printf("Registered.\n")
 }
 
+// saveClientCertificate writes client certificate issued by PMM Server next to the configuration file
+// unless another path is configured.
+func saveClientCertificate(cfg *config.Config, configFilepath string, certPEM, keyPEM []byte) error {
+	path := cfg.Server.ClientCertificate
+	if path == "" {
+		path = filepath.Join(filepath.Dir(configFilepath), "pmm-agent.pem")
+	}
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return err
+	}
+	if err = clientcert.Save(path, certPEM, keyPEM); err != nil {
+		return err
+	}
+
+	// setup is often run by root for pmm-agent running as another user
+	if fi, err := os.Stat(configFilepath); err == nil {
+		if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+			if err = os.Chown(path, int(st.Uid), int(st.Gid)); err != nil {
+				fmt.Printf("Failed to change owner of client certificate %s: %s.\n", path, err)
+			}
+		}
+	}
+
+	cfg.Server.ClientCertificate = path
+	fmt.Printf("Client certificate %s written.\n", path)
+	return nil
+}
+
 func reload(l *logrus.Entry) {
 	fmt.Printf("Reloading pmm-agent configuration...\n")
 
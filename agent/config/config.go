@@ -41,14 +41,18 @@ const (
 	agentTmpPath    = "tmp" // temporary directory to keep exporters' config files, relative to pathBase
 	agentDataPath   = "data"
 	agentPrefix     = "/agent_id/"
+
+	qanSpoolMaxSizeDefault = 100 * 1024 * 1024 // 100 MiB
+	qanSpoolMaxAgeDefault  = 24 * time.Hour    // keep in sync with rollupDelay in qan-api2/models/rollups.go
 )
 
 // Server represents PMM Server configuration.
 type Server struct {
-	Address     string `yaml:"address"`
-	Username    string `yaml:"username"`
-	Password    string `yaml:"password"`
-	InsecureTLS bool   `yaml:"insecure-tls"`
+	Address           string `yaml:"address"`
+	Username          string `yaml:"username"`
+	Password          string `yaml:"password"`
+	InsecureTLS       bool   `yaml:"insecure-tls"`
+	ClientCertificate string `yaml:"client-certificate,omitempty"` // path to PEM file with certificate and key issued by PMM Server
 
 	WithoutTLS bool `yaml:"without-tls,omitempty"` // for development and testing
 }
@@ -107,6 +111,7 @@ type Paths struct {
 
 	TempDir      string `yaml:"tempdir"`
 	NomadDataDir string `yaml:"nomad_data_dir"`
+	QANSpoolDir  string `yaml:"qan_spool_dir"`
 
 	PTSummary        string `yaml:"pt_summary"`
 	PTPGSummary      string `yaml:"pt_pg_summary"`
@@ -122,6 +127,39 @@ type Ports struct {
 	Max uint16 `yaml:"max"`
 }
 
+// QANSpool represents limits of on-disk queue for QAN data that was not sent to PMM Server.
+type QANSpool struct {
+	MaxSize uint64        `yaml:"max-size"` // in bytes
+	MaxAge  time.Duration `yaml:"max-age"`
+}
+
+// Secrets represents configuration of secret references resolved by pmm-agent.
+type Secrets struct {
+	// AllowedDirs are directories with files and executables that file:// and exec:// references may point to.
+	// Those references are rejected if there are none.
+	AllowedDirs []string `yaml:"allowed-dirs,omitempty"`
+}
+
+// dirsValue is a kingpin.Value for a comma-separated list of directories.
+type dirsValue []string
+
+// Set implements kingpin.Value.
+func (v *dirsValue) Set(s string) error {
+	var dirs []string
+	for d := range strings.SplitSeq(s, ",") {
+		if d = strings.TrimSpace(d); d != "" {
+			dirs = append(dirs, d)
+		}
+	}
+	*v = dirs
+	return nil
+}
+
+// String implements kingpin.Value.
+func (v *dirsValue) String() string {
+	return strings.Join(*v, ",")
+}
+
 // Setup contains `pmm-agent setup` flag and argument values.
 // It is never stored in configuration file.
 type Setup struct {
@@ -170,6 +208,10 @@ type Config struct {
 
 	WindowConnectedTime time.Duration `yaml:"window-connected-time"`
 
+	QANSpool QANSpool `yaml:"qan-spool"`
+
+	Secrets Secrets `yaml:"secrets,omitempty"`
+
 	Setup      Setup      `yaml:"-"`
 	Encryption Encryption `yaml:"-"`
 }
@@ -225,6 +267,12 @@ func get(args []string, cfg *Config, l *logrus.Entry) (string, error) { //nolint
 		if cfg.PerfschemaRefreshRate == 0 {
 			cfg.PerfschemaRefreshRate = 5
 		}
+		if cfg.QANSpool.MaxSize == 0 {
+			cfg.QANSpool.MaxSize = qanSpoolMaxSizeDefault
+		}
+		if cfg.QANSpool.MaxAge == 0 {
+			cfg.QANSpool.MaxAge = qanSpoolMaxAgeDefault
+		}
 
 		for sp, v := range map[*string]string{
 			&cfg.Paths.NodeExporter:     "node_exporter",
@@ -271,6 +319,11 @@ func get(args []string, cfg *Config, l *logrus.Entry) (string, error) { //nolint
 			l.Infof("Nomad data directory will default to %s", cfg.Paths.NomadDataDir)
 		}
 
+		if cfg.Paths.QANSpoolDir == "" {
+			cfg.Paths.QANSpoolDir = filepath.Join(cfg.Paths.PathsBase, agentDataPath, "qan-spool")
+			l.Infof("QAN spool directory will default to %s", cfg.Paths.QANSpoolDir)
+		}
+
 		if !filepath.IsAbs(cfg.Paths.TempDir) {
 			cfg.Paths.TempDir = filepath.Join(cfg.Paths.PathsBase, cfg.Paths.TempDir)
 			l.Debugf("Temporary directory is configured as %s", cfg.Paths.TempDir)
@@ -306,6 +359,12 @@ func get(args []string, cfg *Config, l *logrus.Entry) (string, error) { //nolint
 			l.Infof("Using %s as a path to %s", *sp, n)
 		}
 
+		for i, dir := range cfg.Secrets.AllowedDirs {
+			if abs, _ := filepath.Abs(dir); abs != "" {
+				cfg.Secrets.AllowedDirs[i] = abs
+			}
+		}
+
 		if cfg.Server.Address != "" {
 			_, _, e := net.SplitHostPort(cfg.Server.Address)
 			if e != nil {
@@ -394,6 +453,8 @@ func Application(cfg *Config) (*kingpin.Application, *string) {
 		Envar("PMM_AGENT_SERVER_PASSWORD").StringVar(&cfg.Server.Password)
 	app.Flag("server-insecure-tls", "Skip PMM Server TLS certificate validation [PMM_AGENT_SERVER_INSECURE_TLS]").
 		Envar("PMM_AGENT_SERVER_INSECURE_TLS").BoolVar(&cfg.Server.InsecureTLS)
+	app.Flag("server-client-certificate", "Path to client certificate and key issued by PMM Server [PMM_AGENT_SERVER_CLIENT_CERTIFICATE]").
+		Envar("PMM_AGENT_SERVER_CLIENT_CERTIFICATE").StringVar(&cfg.Server.ClientCertificate)
 	// no flag for WithoutTLS - it is only for development and testing
 
 	app.Flag("paths-base", "Base path for exporters/collectors/tools to use [PMM_AGENT_PATHS_BASE]").
@@ -428,6 +489,8 @@ func Application(cfg *Config) (*kingpin.Application, *string) {
 		Envar("PMM_AGENT_PATHS_NOMAD_DATA_DIR").StringVar(&cfg.Paths.NomadDataDir)
 	app.Flag("paths-tempdir", "Temporary directory for exporters [PMM_AGENT_PATHS_TEMPDIR]").
 		Envar("PMM_AGENT_PATHS_TEMPDIR").StringVar(&cfg.Paths.TempDir)
+	app.Flag("paths-qan-spool-dir", "Directory for QAN data that was not sent to PMM Server [PMM_AGENT_PATHS_QAN_SPOOL_DIR]").
+		Envar("PMM_AGENT_PATHS_QAN_SPOOL_DIR").StringVar(&cfg.Paths.QANSpoolDir)
 	// no flag for SlowLogFilePrefix - it is only for development and testing
 
 	app.Flag("ports-min", "Minimal allowed port number for listening sockets [PMM_AGENT_PORTS_MIN]").
@@ -436,6 +499,13 @@ func Application(cfg *Config) (*kingpin.Application, *string) {
 		Envar("PMM_AGENT_PORTS_MAX").Uint16Var(&cfg.Ports.Max)
 	app.Flag("window-connected-time", "Window time for which we track the status of connection between agent and server").
 		Envar("PMM_AGENT_WINDOW_CONNECTED_TIME").DurationVar(&cfg.WindowConnectedTime)
+	app.Flag("qan-spool-max-size", "Maximal size in bytes of QAN data kept while PMM Server is unreachable [PMM_AGENT_QAN_SPOOL_MAX_SIZE]").
+		Envar("PMM_AGENT_QAN_SPOOL_MAX_SIZE").Uint64Var(&cfg.QANSpool.MaxSize)
+	app.Flag("qan-spool-max-age", "Maximal age of QAN data kept while PMM Server is unreachable [PMM_AGENT_QAN_SPOOL_MAX_AGE]").
+		Envar("PMM_AGENT_QAN_SPOOL_MAX_AGE").DurationVar(&cfg.QANSpool.MaxAge)
+	app.Flag("secrets-allowed-dirs",
+		"Comma-separated directories with files and executables allowed in file:// and exec:// secret references [PMM_AGENT_SECRETS_ALLOWED_DIRS]").
+		Envar("PMM_AGENT_SECRETS_ALLOWED_DIRS").SetValue((*dirsValue)(&cfg.Secrets.AllowedDirs))
 
 	app.Flag("log-level", "Set logging level [PMM_AGENT_LOG_LEVEL]").
 		Envar("PMM_AGENT_LOG_LEVEL").EnumVar(&cfg.LogLevel, "debug", "info", "warn", "error", "fatal")
@@ -88,6 +88,7 @@ func TestGet(t *testing.T) {
 			"--id=agent-id",
 			"--listen-port=9999",
 			"--server-address=127.0.0.1",
+			"--secrets-allowed-dirs=/etc/pmm/secrets, /usr/local/bin",
 		}, &actual, logrus.WithField("test", t.Name()))
 		require.NoError(t, err)
 
@@ -112,6 +113,7 @@ func TestGet(t *testing.T) {
 				VMAgent:          "/usr/local/percona/pmm/exporters/vmagent",
 				TempDir:          "/usr/local/percona/pmm/tmp",
 				NomadDataDir:     "/usr/local/percona/pmm/data/nomad",
+				QANSpoolDir:      "/usr/local/percona/pmm/data/qan-spool",
 				PTSummary:        "/usr/local/percona/pmm/tools/pt-summary",
 				PTPGSummary:      "/usr/local/percona/pmm/tools/pt-pg-summary",
 				PTMySQLSummary:   "/usr/local/percona/pmm/tools/pt-mysql-summary",
@@ -119,10 +121,17 @@ func TestGet(t *testing.T) {
 				Nomad:            "/usr/local/percona/pmm/tools/nomad",
 			},
 			WindowConnectedTime: defaultWindowPeriod,
+			QANSpool: QANSpool{
+				MaxSize: qanSpoolMaxSizeDefault,
+				MaxAge:  qanSpoolMaxAgeDefault,
+			},
 			Ports: Ports{
 				Min: 42000,
 				Max: 51999,
 			},
+			Secrets: Secrets{
+				AllowedDirs: []string{"/etc/pmm/secrets", "/usr/local/bin"},
+			},
 			LogLinesCount:         1024,
 			PerfschemaRefreshRate: 5,
 		}
@@ -173,6 +182,7 @@ func TestGet(t *testing.T) {
 				VMAgent:          "/usr/local/percona/pmm/exporters/vmagent",
 				TempDir:          "/usr/local/percona/pmm/tmp",
 				NomadDataDir:     "/usr/local/percona/pmm/data/nomad",
+				QANSpoolDir:      "/usr/local/percona/pmm/data/qan-spool",
 				PTSummary:        "/usr/local/percona/pmm/tools/pt-summary",
 				PTPGSummary:      "/usr/local/percona/pmm/tools/pt-pg-summary",
 				PTMongoDBSummary: "/usr/local/percona/pmm/tools/pt-mongodb-summary",
@@ -180,6 +190,10 @@ func TestGet(t *testing.T) {
 				Nomad:            "/usr/local/percona/pmm/tools/nomad",
 			},
 			WindowConnectedTime: defaultWindowPeriod,
+			QANSpool: QANSpool{
+				MaxSize: qanSpoolMaxSizeDefault,
+				MaxAge:  qanSpoolMaxAgeDefault,
+			},
 			Ports: Ports{
 				Min: 42000,
 				Max: 51999,
@@ -235,6 +249,7 @@ func TestGet(t *testing.T) {
 				VMAgent:          "/usr/local/percona/pmm/exporters/vmagent",
 				TempDir:          "/foo/bar/tmp",
 				NomadDataDir:     "/usr/local/percona/pmm/data/nomad",
+				QANSpoolDir:      "/usr/local/percona/pmm/data/qan-spool",
 				PTSummary:        "/usr/local/percona/pmm/tools/pt-summary",
 				PTPGSummary:      "/usr/local/percona/pmm/tools/pt-pg-summary",
 				PTMySQLSummary:   "/usr/local/percona/pmm/tools/pt-mysql-summary",
@@ -242,6 +257,10 @@ func TestGet(t *testing.T) {
 				Nomad:            "/usr/local/percona/pmm/tools/nomad",
 			},
 			WindowConnectedTime: defaultWindowPeriod,
+			QANSpool: QANSpool{
+				MaxSize: qanSpoolMaxSizeDefault,
+				MaxAge:  qanSpoolMaxAgeDefault,
+			},
 			Ports: Ports{
 				Min: 42000,
 				Max: 51999,
@@ -302,6 +321,7 @@ func TestGet(t *testing.T) {
 				VMAgent:          "/base/vmagent",          // default value
 				TempDir:          "/usr/local/percona/pmm/tmp",
 				NomadDataDir:     "/usr/local/percona/pmm/data/nomad",
+				QANSpoolDir:      "/usr/local/percona/pmm/data/qan-spool",
 				PTSummary:        "/usr/local/percona/pmm/tools/pt-summary",
 				PTPGSummary:      "/usr/local/percona/pmm/tools/pt-pg-summary",
 				PTMongoDBSummary: "/usr/local/percona/pmm/tools/pt-mongodb-summary",
@@ -309,6 +329,10 @@ func TestGet(t *testing.T) {
 				Nomad:            "/usr/local/percona/pmm/tools/nomad",
 			},
 			WindowConnectedTime: defaultWindowPeriod,
+			QANSpool: QANSpool{
+				MaxSize: qanSpoolMaxSizeDefault,
+				MaxAge:  qanSpoolMaxAgeDefault,
+			},
 			Ports: Ports{
 				Min: 42000,
 				Max: 51999,
@@ -367,6 +391,7 @@ func TestGet(t *testing.T) {
 				VMAgent:          "/base/exporters/vmagent",            // default value
 				TempDir:          "/base/tmp",
 				NomadDataDir:     "/base/data/nomad",
+				QANSpoolDir:      "/base/data/qan-spool",
 				PTSummary:        "/base/tools/pt-summary",
 				PTPGSummary:      "/base/tools/pt-pg-summary",
 				PTMongoDBSummary: "/base/tools/pt-mongodb-summary",
@@ -374,6 +399,10 @@ func TestGet(t *testing.T) {
 				Nomad:            "/base/tools/nomad",
 			},
 			WindowConnectedTime: defaultWindowPeriod,
+			QANSpool: QANSpool{
+				MaxSize: qanSpoolMaxSizeDefault,
+				MaxAge:  qanSpoolMaxAgeDefault,
+			},
 			Ports: Ports{
 				Min: 42000,
 				Max: 51999,
@@ -430,6 +459,7 @@ func TestGet(t *testing.T) {
 				VMAgent:          "/foo/exporters/vmagent",           // default value
 				TempDir:          "/foo/tmp",
 				NomadDataDir:     "/base/data/nomad",
+				QANSpoolDir:      "/base/data/qan-spool",
 				PTSummary:        "/base/tools/pt-summary",
 				PTPGSummary:      "/base/tools/pt-pg-summary",
 				PTMongoDBSummary: "/base/tools/pt-mongodb-summary",
@@ -437,6 +467,10 @@ func TestGet(t *testing.T) {
 				Nomad:            "/base/tools/nomad",
 			},
 			WindowConnectedTime: defaultWindowPeriod,
+			QANSpool: QANSpool{
+				MaxSize: qanSpoolMaxSizeDefault,
+				MaxAge:  qanSpoolMaxAgeDefault,
+			},
 			Ports: Ports{
 				Min: 42000,
 				Max: 51999,
@@ -482,6 +516,7 @@ func TestGet(t *testing.T) {
 				VMAgent:          "/usr/local/percona/pmm/exporters/vmagent",
 				TempDir:          "/usr/local/percona/pmm/tmp",
 				NomadDataDir:     "/usr/local/percona/pmm/data/nomad",
+				QANSpoolDir:      "/usr/local/percona/pmm/data/qan-spool",
 				PTSummary:        "/usr/local/percona/pmm/tools/pt-summary",
 				PTPGSummary:      "/usr/local/percona/pmm/tools/pt-pg-summary",
 				PTMongoDBSummary: "/usr/local/percona/pmm/tools/pt-mongodb-summary",
@@ -489,6 +524,10 @@ func TestGet(t *testing.T) {
 				Nomad:            "/usr/local/percona/pmm/tools/nomad",
 			},
 			WindowConnectedTime: defaultWindowPeriod,
+			QANSpool: QANSpool{
+				MaxSize: qanSpoolMaxSizeDefault,
+				MaxAge:  qanSpoolMaxAgeDefault,
+			},
 			Ports: Ports{
 				Min: 42000,
 				Max: 51999,
@@ -44,6 +44,7 @@ import (
 	agent_version "github.com/percona/pmm/agent/utils/version"
 	agentv1 "github.com/percona/pmm/api/agent/v1"
 	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
+	"github.com/percona/pmm/utils/secrets"
 	"github.com/percona/pmm/version"
 )
 
@@ -54,15 +55,17 @@ type configGetter interface {
 
 // ConnectionChecker is a struct to check connection to services.
 type ConnectionChecker struct {
-	l   *logrus.Entry
-	cfg configGetter
+	l       *logrus.Entry
+	cfg     configGetter
+	secrets *secrets.Resolver
 }
 
 // New creates new ConnectionChecker.
 func New(cfg configGetter) *ConnectionChecker {
 	return &ConnectionChecker{
-		l:   logrus.WithField("component", "connectionchecker"),
-		cfg: cfg,
+		l:       logrus.WithField("component", "connectionchecker"),
+		cfg:     cfg,
+		secrets: secrets.NewResolver(cfg.Get().Secrets.AllowedDirs),
 	}
 }
 
@@ -75,24 +78,31 @@ func (cc *ConnectionChecker) Check(ctx context.Context, msg *agentv1.CheckConnec
 		defer cancel()
 	}
 
+	secretValues, err := cc.secrets.ResolveAll(ctx, msg.Secrets)
+	if err != nil {
+		cc.l.Debugf("failed to resolve secrets: %s", err)
+		return &agentv1.CheckConnectionResponse{Error: err.Error()}
+	}
+
 	switch msg.Type {
 	case inventoryv1.ServiceType_SERVICE_TYPE_MYSQL_SERVICE:
-		return cc.checkMySQLConnection(ctx, msg.Dsn, msg.TextFiles, msg.TlsSkipVerify, id)
+		return cc.checkMySQLConnection(ctx, msg.Dsn, msg.TextFiles, secretValues, msg.TlsSkipVerify, id)
 	case inventoryv1.ServiceType_SERVICE_TYPE_MONGODB_SERVICE:
-		return cc.checkMongoDBConnection(ctx, msg.Dsn, msg.TextFiles, id)
+		return cc.checkMongoDBConnection(ctx, msg.Dsn, msg.TextFiles, secretValues, id)
 	case inventoryv1.ServiceType_SERVICE_TYPE_POSTGRESQL_SERVICE:
-		return cc.checkPostgreSQLConnection(ctx, msg.Dsn, msg.TextFiles, id)
+		return cc.checkPostgreSQLConnection(ctx, msg.Dsn, msg.TextFiles, secretValues, id)
 	case inventoryv1.ServiceType_SERVICE_TYPE_VALKEY_SERVICE:
 		return cc.checkValkeyConnection(
 			ctx,
 			msg.Dsn,
 			msg.Tls,
 			msg.TextFiles,
+			secretValues,
 			msg.TlsSkipVerify,
 			id,
 		)
 	case inventoryv1.ServiceType_SERVICE_TYPE_PROXYSQL_SERVICE:
-		return cc.checkProxySQLConnection(ctx, msg.Dsn)
+		return cc.checkProxySQLConnection(ctx, msg.Dsn, msg.TextFiles, secretValues, id)
 	case inventoryv1.ServiceType_SERVICE_TYPE_EXTERNAL_SERVICE, inventoryv1.ServiceType_SERVICE_TYPE_HAPROXY_SERVICE:
 		return cc.checkExternalConnection(ctx, msg.Dsn, msg.TlsSkipVerify)
 	default:
@@ -112,6 +122,7 @@ func (cc *ConnectionChecker) checkMySQLConnection(
 	ctx context.Context,
 	dsn string,
 	files *agentv1.TextFiles,
+	secretValues map[string]string,
 	tlsSkipVerify bool,
 	id uint32,
 ) *agentv1.CheckConnectionResponse {
@@ -127,18 +138,18 @@ func (cc *ConnectionChecker) checkMySQLConnection(
 		}
 	}
 
-	cfg, err := mysql.ParseDSN(dsn)
+	tempdir := filepath.Join(cc.cfg.Get().Paths.TempDir, strings.ToLower("check-mysql-connection"), strconv.Itoa(int(id)))
+	dsn, err = templates.RenderDSNWithSecrets(dsn, files, secretValues, tempdir)
+	defer templates.CleanupTempDir(tempdir, cc.l)
 	if err != nil {
-		cc.l.Debugf("checkMySQLConnection: failed to parse DSN: %s", err)
+		cc.l.Debugf("checkMySQLDBConnection: failed to Render DSN: %s", err)
 		res.Error = err.Error()
 		return &res
 	}
 
-	tempdir := filepath.Join(cc.cfg.Get().Paths.TempDir, strings.ToLower("check-mysql-connection"), strconv.Itoa(int(id)))
-	_, err = templates.RenderDSN(dsn, files, tempdir)
-	defer templates.CleanupTempDir(tempdir, cc.l)
+	cfg, err := mysql.ParseDSN(dsn)
 	if err != nil {
-		cc.l.Debugf("checkMySQLDBConnection: failed to Render DSN: %s", err)
+		cc.l.Debugf("checkMySQLConnection: failed to parse DSN: %s", err)
 		res.Error = err.Error()
 		return &res
 	}
@@ -166,14 +177,14 @@ func (cc *ConnectionChecker) checkMySQLConnection(
 	return &res
 }
 
-func (cc *ConnectionChecker) checkMongoDBConnection(ctx context.Context, dsn string, files *agentv1.TextFiles, id uint32) *agentv1.CheckConnectionResponse {
+func (cc *ConnectionChecker) checkMongoDBConnection(ctx context.Context, dsn string, files *agentv1.TextFiles, secretValues map[string]string, id uint32) *agentv1.CheckConnectionResponse {
 	const helloCommandVersion = "4.2.10"
 
 	var res agentv1.CheckConnectionResponse
 	var err error
 
 	tempdir := filepath.Join(cc.cfg.Get().Paths.TempDir, strings.ToLower("check-mongodb-connection"), strconv.Itoa(int(id)))
-	dsn, err = templates.RenderDSN(dsn, files, tempdir)
+	dsn, err = templates.RenderDSNWithSecrets(dsn, files, secretValues, tempdir)
 	defer templates.CleanupTempDir(tempdir, cc.l)
 	if err != nil {
 		cc.l.Debugf("checkMongoDBConnection: failed to Render DSN: %s", err)
@@ -240,12 +251,12 @@ func (cc *ConnectionChecker) checkMongoDBConnection(ctx context.Context, dsn str
 	return &res
 }
 
-func (cc *ConnectionChecker) checkPostgreSQLConnection(ctx context.Context, dsn string, files *agentv1.TextFiles, id uint32) *agentv1.CheckConnectionResponse {
+func (cc *ConnectionChecker) checkPostgreSQLConnection(ctx context.Context, dsn string, files *agentv1.TextFiles, secretValues map[string]string, id uint32) *agentv1.CheckConnectionResponse {
 	var res agentv1.CheckConnectionResponse
 	var err error
 
 	tempdir := filepath.Join(cc.cfg.Get().Paths.TempDir, strings.ToLower("check-postgresql-connection"), strconv.Itoa(int(id)))
-	dsn, err = templates.RenderDSN(dsn, files, tempdir)
+	dsn, err = templates.RenderDSNWithSecrets(dsn, files, secretValues, tempdir)
 	defer templates.CleanupTempDir(tempdir, cc.l)
 	if err != nil {
 		cc.l.Debugf("checkPostgreSQLConnection: failed to Render DSN: %s", err)
@@ -274,6 +285,7 @@ func (cc *ConnectionChecker) checkValkeyConnection(
 	dsn string,
 	tls bool,
 	files *agentv1.TextFiles,
+	secretValues map[string]string,
 	tlsSkipVerify bool,
 	id uint32,
 ) *agentv1.CheckConnectionResponse {
@@ -281,7 +293,7 @@ func (cc *ConnectionChecker) checkValkeyConnection(
 	var err error
 
 	tempdir := filepath.Join(cc.cfg.Get().Paths.TempDir, "check-valkey-connection", strconv.Itoa(int(id)))
-	dsn, err = templates.RenderDSN(dsn, files, tempdir)
+	dsn, err = templates.RenderDSNWithSecrets(dsn, files, secretValues, tempdir)
 	defer templates.CleanupTempDir(tempdir, cc.l)
 	if err != nil {
 		cc.l.Debugf("checkValkeyConnection: failed to Render DSN: %s", err)
@@ -305,9 +317,17 @@ func (cc *ConnectionChecker) checkValkeyConnection(
 	return &res
 }
 
-func (cc *ConnectionChecker) checkProxySQLConnection(ctx context.Context, dsn string) *agentv1.CheckConnectionResponse {
+func (cc *ConnectionChecker) checkProxySQLConnection(ctx context.Context, dsn string, files *agentv1.TextFiles, secretValues map[string]string, id uint32) *agentv1.CheckConnectionResponse {
 	var res agentv1.CheckConnectionResponse
 
+	tempdir := filepath.Join(cc.cfg.Get().Paths.TempDir, "check-proxysql-connection", strconv.Itoa(int(id)))
+	dsn, err := templates.RenderDSNWithSecrets(dsn, files, secretValues, tempdir)
+	defer templates.CleanupTempDir(tempdir, cc.l)
+	if err != nil {
+		res.Error = err.Error()
+		return &res
+	}
+
 	cfg, err := mysql.ParseDSN(dsn)
 	if err != nil {
 		res.Error = err.Error()
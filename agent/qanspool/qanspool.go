@@ -0,0 +1,387 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package qanspool implements bounded on-disk queue for QAN data that was not sent to PMM Server.
+package qanspool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/proto"
+
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+)
+
+const (
+	prometheusNamespace = "pmm_agent"
+	prometheusSubsystem = "qan_spool"
+
+	fileExt    = ".pb"
+	tmpFileExt = ".tmp"
+
+	dropReasonSize      = "size"
+	dropReasonAge       = "age"
+	dropReasonCorrupted = "corrupted"
+	dropReasonError     = "error"
+)
+
+// entry represents a single QAN Collect request stored on disk.
+type entry struct {
+	id   uint64 // unix time of creation in nanoseconds, also defines file name and order
+	size uint64
+}
+
+func (e entry) created() time.Time {
+	return time.Unix(0, int64(e.id)) //nolint:gosec
+}
+
+// Stats represents current state of the spool.
+type Stats struct {
+	Depth   int    // number of stored requests
+	Size    uint64 // total size of stored requests in bytes
+	Dropped uint64 // number of requests dropped since the spool was opened
+}
+
+// Spool stores QAN Collect requests on disk until they are sent to PMM Server.
+//
+// Requests are returned in the order they were pushed, so data is replayed in order after reconnection.
+// Oldest requests are dropped when the total size or age limit is exceeded.
+type Spool struct {
+	dir     string
+	maxSize uint64
+	maxAge  time.Duration
+	l       *logrus.Entry
+
+	m       sync.Mutex
+	entries []entry
+	size    uint64
+	dropped uint64
+	notify  chan struct{}
+
+	mDropped *prometheus.CounterVec
+	mDepth   prometheus.GaugeFunc
+	mSize    prometheus.GaugeFunc
+}
+
+// New opens the spool in the given directory, creating it if needed.
+// Requests stored by the previous pmm-agent run are kept.
+func New(dir string, maxSize uint64, maxAge time.Duration) (*Spool, error) {
+	s := &Spool{
+		dir:     dir,
+		maxSize: maxSize,
+		maxAge:  maxAge,
+		l:       logrus.WithField("component", "qan-spool"),
+		notify:  make(chan struct{}, 1),
+
+		mDropped: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prometheusNamespace,
+			Subsystem: prometheusSubsystem,
+			Name:      "dropped_total",
+			Help:      "A total number of QAN Collect requests dropped from the spool without sending to pmm-managed.",
+		}, []string{"reason"}),
+	}
+
+	s.mDepth = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: prometheusNamespace,
+		Subsystem: prometheusSubsystem,
+		Name:      "depth",
+		Help:      "A number of QAN Collect requests waiting in the spool to be sent to pmm-managed.",
+	}, func() float64 { return float64(s.Stats().Depth) })
+	s.mSize = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: prometheusNamespace,
+		Subsystem: prometheusSubsystem,
+		Name:      "size_bytes",
+		Help:      "A total size of QAN Collect requests waiting in the spool to be sent to pmm-managed.",
+	}, func() float64 { return float64(s.Stats().Size) })
+
+	// initialize metrics with labels
+	for _, reason := range []string{dropReasonSize, dropReasonAge, dropReasonCorrupted, dropReasonError} {
+		s.mDropped.WithLabelValues(reason)
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil { //nolint:mnd
+		return nil, fmt.Errorf("failed to create spool directory: %w", err)
+	}
+
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+
+	s.m.Lock()
+	s.enforceLimits(time.Now())
+	s.m.Unlock()
+
+	if len(s.entries) != 0 {
+		s.l.Infof("Loaded %d QAN requests (%d bytes) from %s.", len(s.entries), s.size, dir)
+	}
+
+	return s, nil
+}
+
+// load reads the list of stored requests from disk.
+func (s *Spool) load() error {
+	// os.ReadDir returns entries sorted by file name, and zero-padded names are sorted by creation time
+	files, err := os.ReadDir(s.dir)
+	if err != nil {
+		return fmt.Errorf("failed to read spool directory: %w", err)
+	}
+
+	for _, f := range files {
+		name := f.Name()
+		path := filepath.Join(s.dir, name)
+
+		if strings.HasSuffix(name, tmpFileExt) {
+			// leftover of interrupted Push
+			_ = os.Remove(path)
+			continue
+		}
+
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, fileExt), 10, 64)
+		if err != nil || !strings.HasSuffix(name, fileExt) {
+			s.l.Warnf("Skipping unexpected file %s.", path)
+			continue
+		}
+
+		info, err := f.Info()
+		if err != nil {
+			return fmt.Errorf("failed to stat %s: %w", path, err)
+		}
+
+		s.entries = append(s.entries, entry{id: id, size: uint64(info.Size())}) //nolint:gosec
+		s.size += uint64(info.Size())                                           //nolint:gosec
+	}
+
+	return nil
+}
+
+func (s *Spool) path(id uint64) string {
+	return filepath.Join(s.dir, fmt.Sprintf("%020d%s", id, fileExt))
+}
+
+// Push stores the request at the end of the spool.
+// The request is counted as dropped if it can't be stored.
+func (s *Spool) Push(req *agentv1.QANCollectRequest) error {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	b, err := proto.Marshal(req)
+	if err != nil {
+		s.drop(dropReasonError, 1)
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	now := time.Now()
+	if uint64(len(b)) > s.maxSize {
+		s.drop(dropReasonSize, 1)
+		return fmt.Errorf("request size %d bytes exceeds spool size limit %d bytes", len(b), s.maxSize)
+	}
+
+	id := uint64(now.UnixNano()) //nolint:gosec
+	if n := len(s.entries); n != 0 && id <= s.entries[n-1].id {
+		id = s.entries[n-1].id + 1
+	}
+
+	// write to temporary file first, so partially written requests are never read
+	path := s.path(id)
+	if err = os.WriteFile(path+tmpFileExt, b, 0o600); err == nil { //nolint:mnd
+		err = os.Rename(path+tmpFileExt, path)
+	}
+	if err != nil {
+		_ = os.Remove(path + tmpFileExt)
+		s.drop(dropReasonError, 1)
+		return fmt.Errorf("failed to write request: %w", err)
+	}
+
+	s.entries = append(s.entries, entry{id: id, size: uint64(len(b))})
+	s.size += uint64(len(b))
+	s.enforceLimits(now)
+
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Peek returns the oldest stored request and its ID without removing it from the spool.
+// It blocks until there is a request or ctx is canceled.
+// Caller should call Remove with returned ID once the request is sent.
+func (s *Spool) Peek(ctx context.Context) (uint64, *agentv1.QANCollectRequest, error) {
+	for {
+		s.m.Lock()
+		s.enforceLimits(time.Now())
+		var e entry
+		ok := len(s.entries) != 0
+		if ok {
+			e = s.entries[0]
+		}
+		s.m.Unlock()
+
+		if !ok {
+			select {
+			case <-ctx.Done():
+				return 0, nil, ctx.Err()
+			case <-s.notify:
+				continue
+			}
+		}
+
+		req, err := s.read(e.id)
+		if err == nil {
+			return e.id, req, nil
+		}
+
+		s.l.Errorf("Dropping unreadable QAN request: %s.", err)
+		s.m.Lock()
+		if s.remove(e.id) {
+			s.drop(dropReasonCorrupted, 1)
+		}
+		s.m.Unlock()
+	}
+}
+
+func (s *Spool) read(id uint64) (*agentv1.QANCollectRequest, error) {
+	b, err := os.ReadFile(s.path(id))
+	if err != nil {
+		return nil, err
+	}
+
+	req := new(agentv1.QANCollectRequest)
+	if err = proto.Unmarshal(b, req); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s: %w", s.path(id), err)
+	}
+
+	return req, nil
+}
+
+// Remove removes the request with the given ID from the spool.
+// It does nothing if the request was already dropped.
+func (s *Spool) Remove(id uint64) {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	s.remove(id)
+}
+
+// Run stores requests from the given channel until it is closed.
+func (s *Spool) Run(requests <-chan *agentv1.QANCollectRequest) {
+	for req := range requests {
+		if req == nil {
+			continue
+		}
+
+		if err := s.Push(req); err != nil {
+			s.l.Errorf("Failed to store QAN request, it is lost: %s.", err)
+		}
+	}
+
+	s.l.Info("Done.")
+}
+
+// Stats returns current state of the spool.
+func (s *Spool) Stats() Stats {
+	s.m.Lock()
+	defer s.m.Unlock()
+
+	return Stats{
+		Depth:   len(s.entries),
+		Size:    s.size,
+		Dropped: s.dropped,
+	}
+}
+
+// enforceLimits drops oldest requests exceeding age and size limits. Caller must hold the lock.
+func (s *Spool) enforceLimits(now time.Time) {
+	var n int
+	for n < len(s.entries) && now.Sub(s.entries[n].created()) > s.maxAge {
+		n++
+	}
+	s.dropOldest(n, dropReasonAge)
+
+	n = 0
+	for size := s.size; size > s.maxSize; n++ {
+		size -= s.entries[n].size
+	}
+	s.dropOldest(n, dropReasonSize)
+}
+
+// dropOldest drops n oldest requests. Caller must hold the lock.
+func (s *Spool) dropOldest(n int, reason string) {
+	if n == 0 {
+		return
+	}
+
+	for _, e := range s.entries[:n] {
+		s.size -= e.size
+		_ = os.Remove(s.path(e.id))
+	}
+	s.entries = s.entries[n:]
+
+	s.l.Warnf("Dropped %d oldest QAN requests exceeding spool %s limit.", n, reason)
+	s.drop(reason, n)
+}
+
+// remove removes the request with the given ID and reports whether it was found. Caller must hold the lock.
+func (s *Spool) remove(id uint64) bool {
+	for i, e := range s.entries {
+		if e.id != id {
+			continue
+		}
+
+		if err := os.Remove(s.path(id)); err != nil && !errors.Is(err, os.ErrNotExist) {
+			s.l.Errorf("Failed to remove sent QAN request: %s.", err)
+		}
+		s.size -= e.size
+		s.entries = append(s.entries[:i], s.entries[i+1:]...)
+		return true
+	}
+
+	return false
+}
+
+// drop counts dropped requests. Caller must hold the lock.
+func (s *Spool) drop(reason string, n int) {
+	s.dropped += uint64(n) //nolint:gosec
+	s.mDropped.WithLabelValues(reason).Add(float64(n))
+}
+
+// Describe implements prometheus.Collector.
+func (s *Spool) Describe(ch chan<- *prometheus.Desc) {
+	s.mDropped.Describe(ch)
+	s.mDepth.Describe(ch)
+	s.mSize.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (s *Spool) Collect(ch chan<- prometheus.Metric) {
+	s.mDropped.Collect(ch)
+	s.mDepth.Collect(ch)
+	s.mSize.Collect(ch)
+}
+
+// check interfaces.
+var (
+	_ prometheus.Collector = (*Spool)(nil)
+)
@@ -0,0 +1,162 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package qanspool
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+)
+
+func newRequest(queryID string) *agentv1.QANCollectRequest {
+	return &agentv1.QANCollectRequest{
+		MetricsBucket: []*agentv1.MetricsBucket{{
+			Common: &agentv1.MetricsBucket_Common{Queryid: queryID},
+		}},
+	}
+}
+
+// pop returns query ID of the oldest request and removes it from the spool.
+func pop(t *testing.T, s *Spool) string {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+	defer cancel()
+
+	id, req, err := s.Peek(ctx)
+	require.NoError(t, err)
+	s.Remove(id)
+
+	return req.MetricsBucket[0].Common.Queryid
+}
+
+func TestSpool(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Order", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := New(t.TempDir(), 1024*1024, time.Hour)
+		require.NoError(t, err)
+
+		for _, q := range []string{"1", "2", "3"} {
+			require.NoError(t, s.Push(newRequest(q)))
+		}
+		assert.Equal(t, 3, s.Stats().Depth)
+
+		assert.Equal(t, "1", pop(t, s))
+		assert.Equal(t, "2", pop(t, s))
+		assert.Equal(t, "3", pop(t, s))
+		assert.Equal(t, Stats{}, s.Stats())
+	})
+
+	t.Run("PeekBlocks", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := New(t.TempDir(), 1024*1024, time.Hour)
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 50*time.Millisecond)
+		defer cancel()
+		_, _, err = s.Peek(ctx)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+
+		go func() {
+			time.Sleep(50 * time.Millisecond)
+			assert.NoError(t, s.Push(newRequest("1")))
+		}()
+		assert.Equal(t, "1", pop(t, s))
+	})
+
+	t.Run("Reopen", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		s, err := New(dir, 1024*1024, time.Hour)
+		require.NoError(t, err)
+		require.NoError(t, s.Push(newRequest("1")))
+		require.NoError(t, s.Push(newRequest("2")))
+
+		// interrupted write
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "00000000000000000001.pb.tmp"), []byte("garbage"), 0o600))
+
+		s, err = New(dir, 1024*1024, time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, 2, s.Stats().Depth)
+		assert.Equal(t, "1", pop(t, s))
+		assert.Equal(t, "2", pop(t, s))
+
+		files, err := os.ReadDir(dir)
+		require.NoError(t, err)
+		assert.Empty(t, files)
+	})
+
+	t.Run("SizeLimit", func(t *testing.T) {
+		t.Parallel()
+
+		size := uint64(proto.Size(newRequest("1")))
+		s, err := New(t.TempDir(), 2*size, time.Hour)
+		require.NoError(t, err)
+
+		for _, q := range []string{"1", "2", "3"} {
+			require.NoError(t, s.Push(newRequest(q)))
+		}
+		assert.Equal(t, Stats{Depth: 2, Size: 2 * size, Dropped: 1}, s.Stats())
+		assert.Equal(t, "2", pop(t, s))
+
+		require.Error(t, s.Push(&agentv1.QANCollectRequest{MetricsBucket: make([]*agentv1.MetricsBucket, 10)}))
+		assert.Equal(t, uint64(2), s.Stats().Dropped)
+		assert.Equal(t, "3", pop(t, s))
+	})
+
+	t.Run("AgeLimit", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		s, err := New(dir, 1024*1024, time.Hour)
+		require.NoError(t, err)
+		require.NoError(t, s.Push(newRequest("1")))
+		require.NoError(t, s.Push(newRequest("2")))
+
+		// make the first request older than the limit
+		s.entries[0].id -= uint64(2 * time.Hour)
+		require.NoError(t, os.Rename(s.path(s.entries[0].id+uint64(2*time.Hour)), s.path(s.entries[0].id)))
+
+		assert.Equal(t, "2", pop(t, s))
+		assert.Equal(t, Stats{Dropped: 1}, s.Stats())
+	})
+
+	t.Run("Corrupted", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		s, err := New(dir, 1024*1024, time.Hour)
+		require.NoError(t, err)
+		require.NoError(t, s.Push(newRequest("1")))
+		require.NoError(t, s.Push(newRequest("2")))
+		require.NoError(t, os.WriteFile(s.path(s.entries[0].id), []byte("garbage"), 0o600))
+
+		assert.Equal(t, "2", pop(t, s))
+		assert.Equal(t, Stats{Dropped: 1}, s.Stats())
+	})
+}
@@ -19,6 +19,8 @@ import (
 	"fmt"
 	"regexp"
 	"strings"
+
+	pgquery "github.com/pganalyze/pg_query_go/v6"
 )
 
 var (
@@ -77,3 +79,13 @@ func MySQLComments(query string) (map[string]string, error) {
 func PostgreSQLComments(query string) (map[string]string, error) {
 	return parsePGComments(query)
 }
+
+// PostgreSQLFingerprint parse query and return its fingerprint with constants replaced by placeholders ($1, $2 etc).
+func PostgreSQLFingerprint(query string) (string, error) {
+	fingerprint, err := pgquery.Normalize(query)
+	if err != nil {
+		return "", fmt.Errorf("failed to normalize the query: %w", err)
+	}
+
+	return fingerprint, nil
+}
@@ -211,3 +211,37 @@ func TestPostgreSQLComments(t *testing.T) {
 		})
 	}
 }
+
+func TestPostgreSQLFingerprint(t *testing.T) {
+	type testCase struct {
+		Name        string
+		Query       string
+		Fingerprint string
+	}
+
+	testCases := []testCase{
+		{
+			Name:        "Select with constants",
+			Query:       `SELECT * FROM people WHERE name = 'John' AND age > 42`,
+			Fingerprint: `SELECT * FROM people WHERE name = $1 AND age > $2`,
+		},
+		{
+			Name:        "Insert",
+			Query:       `INSERT INTO people (name, age) VALUES ('Doe', 7)`,
+			Fingerprint: `INSERT INTO people (name, age) VALUES ($1, $2)`,
+		},
+	}
+
+	for _, c := range testCases {
+		t.Run(c.Name, func(t *testing.T) {
+			fingerprint, err := PostgreSQLFingerprint(c.Query)
+			require.NoError(t, err)
+			require.Equal(t, c.Fingerprint, fingerprint)
+		})
+	}
+
+	t.Run("Invalid query", func(t *testing.T) {
+		_, err := PostgreSQLFingerprint(`SELECT * FROM`)
+		require.Error(t, err)
+	})
+}
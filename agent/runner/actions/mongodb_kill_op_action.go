@@ -0,0 +1,104 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"context"
+	"path/filepath"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+
+	"github.com/percona/pmm/agent/utils/mongofix"
+	"github.com/percona/pmm/agent/utils/templates"
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+)
+
+const mongoDBKillOpActionType = "mongodb-kill-op"
+
+type mongodbKillOpAction struct {
+	id      string
+	timeout time.Duration
+	dsn     string
+	opID    int64
+	tmpDir  string
+}
+
+// NewMongoDBKillOpAction creates MongoDB killOp Action.
+func NewMongoDBKillOpAction(id string, timeout time.Duration, params *agentv1.StartActionRequest_MongoDBKillOpParams, tempDir string) (Action, error) {
+	tmpDir := filepath.Join(tempDir, mongoDBKillOpActionType, id)
+	dsn, err := templates.RenderDSN(params.Dsn, params.TextFiles, tmpDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mongodbKillOpAction{
+		id:      id,
+		timeout: timeout,
+		dsn:     dsn,
+		opID:    params.OpId,
+		tmpDir:  tmpDir,
+	}, nil
+}
+
+// ID returns an Action ID.
+func (a *mongodbKillOpAction) ID() string {
+	return a.id
+}
+
+// Timeout returns Action timeout.
+func (a *mongodbKillOpAction) Timeout() time.Duration {
+	return a.timeout
+}
+
+// Type returns an Action type.
+func (a *mongodbKillOpAction) Type() string {
+	return mongoDBKillOpActionType
+}
+
+// DSN returns a DSN for the Action.
+func (a *mongodbKillOpAction) DSN() string {
+	return a.dsn
+}
+
+// Run runs an Action and returns output and error.
+func (a *mongodbKillOpAction) Run(ctx context.Context) ([]byte, error) {
+	defer templates.CleanupTempDir(a.tmpDir, logrus.WithField("component", mongoDBKillOpActionType))
+	opts, err := mongofix.ClientOptionsForDSN(a.dsn)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := mongo.Connect(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Disconnect(ctx) //nolint:errcheck
+
+	runCommand := bson.D{{Key: "killOp", Value: 1}, {Key: "op", Value: a.opID}}
+	res := client.Database("admin").RunCommand(ctx, runCommand)
+
+	var doc map[string]any
+	err = res.Decode(&doc)
+	if err != nil {
+		return nil, err
+	}
+
+	return agentv1.MarshalActionQueryDocsResult([]map[string]any{doc})
+}
+
+func (a *mongodbKillOpAction) sealed() {}
@@ -0,0 +1,50 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm/agent/utils/tests"
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+)
+
+func TestMongoDBKillOp(t *testing.T) {
+	t.Parallel()
+
+	dsn := tests.GetTestMongoDBDSN(t)
+
+	// killOp succeeds even if there is no such operation, it only attempts to kill it.
+	params := &agentv1.StartActionRequest_MongoDBKillOpParams{
+		Dsn:  dsn,
+		OpId: 1234567,
+	}
+	a, err := NewMongoDBKillOpAction("", 0, params, t.TempDir())
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	b, err := a.Run(ctx)
+	require.NoError(t, err)
+
+	objxM := convertToObjxMap(t, b)
+	assert.InDelta(t, 1.0, objxM.Get("ok").Data(), 0.0001)
+}
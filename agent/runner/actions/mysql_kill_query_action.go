@@ -0,0 +1,130 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/percona/pmm/agent/tlshelpers"
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+)
+
+// processlistStartTimeTolerance is the allowed difference between the statement start time from parameters
+// and the one calculated from the processlist, which reports execution time with one second resolution.
+const processlistStartTimeTolerance = 2 * time.Second
+
+type mysqlKillQueryAction struct {
+	id      string
+	timeout time.Duration
+	params  *agentv1.StartActionRequest_MySQLKillQueryParams
+}
+
+// NewMySQLKillQueryAction creates MySQL KILL QUERY Action.
+// It terminates the statement the connection is currently executing, leaving the connection itself intact.
+func NewMySQLKillQueryAction(id string, timeout time.Duration, params *agentv1.StartActionRequest_MySQLKillQueryParams) Action {
+	return &mysqlKillQueryAction{
+		id:      id,
+		timeout: timeout,
+		params:  params,
+	}
+}
+
+// ID returns an Action ID.
+func (a *mysqlKillQueryAction) ID() string {
+	return a.id
+}
+
+// Timeout returns Action timeout.
+func (a *mysqlKillQueryAction) Timeout() time.Duration {
+	return a.timeout
+}
+
+// Type returns an Action type.
+func (a *mysqlKillQueryAction) Type() string {
+	return "mysql-kill-query"
+}
+
+// DSN returns a DSN for the Action.
+func (a *mysqlKillQueryAction) DSN() string {
+	return a.params.Dsn
+}
+
+// Run runs an Action and returns output and error.
+func (a *mysqlKillQueryAction) Run(ctx context.Context) ([]byte, error) {
+	db, err := mysqlOpen(a.params.Dsn, a.params.TlsFiles, a.params.TlsSkipVerify)
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close() //nolint:errcheck
+	defer tlshelpers.DeregisterMySQLCerts()
+
+	// KILL QUERY terminates whatever the connection is executing, so make sure it is still the same statement.
+	// There is still a tiny window between the check and KILL QUERY, but MySQL provides no way to close it.
+	err = a.checkStatement(ctx, db)
+	if err != nil {
+		return nil, err
+	}
+
+	// KILL does not support placeholders, connection ID is a number so it is safe to format it.
+	_, err = db.ExecContext(ctx, fmt.Sprintf("KILL QUERY /* pmm-agent */ %d", a.params.ConnectionId))
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+// checkStatement returns an error if the connection is not executing the statement from parameters anymore.
+func (a *mysqlKillQueryAction) checkStatement(ctx context.Context, db *sql.DB) error {
+	switch {
+	case a.params.EventId != 0:
+		var connectionID uint64
+		err := db.QueryRowContext(ctx, `SELECT /* pmm-agent */ t.PROCESSLIST_ID
+FROM performance_schema.events_statements_current e
+JOIN performance_schema.threads t ON t.THREAD_ID = e.THREAD_ID
+WHERE e.THREAD_ID = ? AND e.EVENT_ID = ? AND e.END_EVENT_ID IS NULL`, a.params.ThreadId, a.params.EventId).Scan(&connectionID)
+		if errors.Is(err, sql.ErrNoRows) || (err == nil && connectionID != a.params.ConnectionId) {
+			return fmt.Errorf("statement is not running on connection %d anymore", a.params.ConnectionId)
+		}
+		return err
+
+	case a.params.QueryStart != nil:
+		var seconds int64
+		err := db.QueryRowContext(ctx,
+			"SELECT /* pmm-agent */ TIME FROM information_schema.PROCESSLIST WHERE ID = ? AND INFO IS NOT NULL",
+			a.params.ConnectionId).Scan(&seconds)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("statement is not running on connection %d anymore", a.params.ConnectionId)
+		}
+		if err != nil {
+			return err
+		}
+
+		started := time.Now().Add(-time.Duration(seconds) * time.Second)
+		if started.Sub(a.params.QueryStart.AsTime()).Abs() > processlistStartTimeTolerance {
+			return fmt.Errorf("statement is not running on connection %d anymore", a.params.ConnectionId)
+		}
+		return nil
+
+	default:
+		return nil
+	}
+}
+
+func (a *mysqlKillQueryAction) sealed() {}
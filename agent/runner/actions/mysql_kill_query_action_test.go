@@ -0,0 +1,105 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm/agent/utils/tests"
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+)
+
+func TestMySQLKillQuery(t *testing.T) {
+	t.Parallel()
+
+	dsn := tests.GetTestMySQLDSN(t)
+	db := tests.OpenTestMySQL(t)
+	t.Cleanup(func() {
+		assert.NoError(t, db.Close())
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		conn, err := db.Conn(ctx)
+		require.NoError(t, err)
+		defer conn.Close() //nolint:errcheck
+
+		var connectionID uint64
+		require.NoError(t, conn.QueryRowContext(ctx, "SELECT CONNECTION_ID()").Scan(&connectionID))
+
+		// SLEEP returns 1 when interrupted.
+		sleepRes := make(chan int, 1)
+		go func() {
+			var res int
+			assert.NoError(t, conn.QueryRowContext(ctx, "SELECT SLEEP(30)").Scan(&res))
+			sleepRes <- res
+		}()
+
+		params := &agentv1.StartActionRequest_MySQLKillQueryParams{
+			Dsn:          dsn,
+			ConnectionId: connectionID,
+		}
+		a := NewMySQLKillQueryAction("", 0, params)
+
+		// wait for SLEEP to start
+		time.Sleep(500 * time.Millisecond)
+
+		_, err = a.Run(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 1, <-sleepRes)
+	})
+
+	t.Run("UnknownConnection", func(t *testing.T) {
+		t.Parallel()
+
+		params := &agentv1.StartActionRequest_MySQLKillQueryParams{
+			Dsn:          dsn,
+			ConnectionId: 1 << 40,
+		}
+		a := NewMySQLKillQueryAction("", 0, params)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		_, err := a.Run(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Unknown thread id")
+	})
+	t.Run("StatementFinished", func(t *testing.T) {
+		t.Parallel()
+
+		params := &agentv1.StartActionRequest_MySQLKillQueryParams{
+			Dsn:          dsn,
+			ConnectionId: 1 << 40,
+			ThreadId:     1 << 40,
+			EventId:      1,
+		}
+		a := NewMySQLKillQueryAction("", 0, params)
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		_, err := a.Run(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "statement is not running on connection")
+	})
+}
@@ -0,0 +1,120 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+
+	"github.com/percona/pmm/agent/utils/templates"
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+)
+
+const postgreSQLCancelBackendActionType = "postgresql-cancel-backend"
+
+type postgresqlCancelBackendAction struct {
+	id      string
+	timeout time.Duration
+	params  *agentv1.StartActionRequest_PostgreSQLCancelBackendParams
+	dsn     string
+	tmpDir  string
+}
+
+// NewPostgreSQLCancelBackendAction creates PostgreSQL pg_cancel_backend Action.
+// It cancels the current query of the backend, leaving the backend itself intact.
+func NewPostgreSQLCancelBackendAction(
+	id string,
+	timeout time.Duration,
+	params *agentv1.StartActionRequest_PostgreSQLCancelBackendParams,
+	tempDir string,
+) (Action, error) {
+	tmpDir := filepath.Join(tempDir, postgreSQLCancelBackendActionType, id)
+	dsn, err := templates.RenderDSN(params.Dsn, params.TlsFiles, tmpDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &postgresqlCancelBackendAction{
+		id:      id,
+		timeout: timeout,
+		params:  params,
+		dsn:     dsn,
+		tmpDir:  tmpDir,
+	}, nil
+}
+
+// ID returns an Action ID.
+func (a *postgresqlCancelBackendAction) ID() string {
+	return a.id
+}
+
+// Timeout returns Action timeout.
+func (a *postgresqlCancelBackendAction) Timeout() time.Duration {
+	return a.timeout
+}
+
+// Type returns an Action type.
+func (a *postgresqlCancelBackendAction) Type() string {
+	return postgreSQLCancelBackendActionType
+}
+
+// DSN returns the DSN for the Action.
+func (a *postgresqlCancelBackendAction) DSN() string {
+	return a.dsn
+}
+
+// Run runs an Action and returns output and error.
+func (a *postgresqlCancelBackendAction) Run(ctx context.Context) ([]byte, error) {
+	defer templates.CleanupTempDir(a.tmpDir, logrus.WithField("component", postgreSQLCancelBackendActionType))
+
+	connector, err := pq.NewConnector(a.dsn)
+	if err != nil {
+		return nil, err
+	}
+	db := sql.OpenDB(connector)
+	defer db.Close() //nolint:errcheck
+
+	var canceled bool
+	if a.params.QueryStart != nil {
+		// The backend could have started another query since it was observed, so cancel it only if it is the same one.
+		err = db.QueryRowContext(ctx,
+			"SELECT /* pmm-agent */ pg_cancel_backend(pid) FROM pg_stat_activity WHERE pid = $1 AND query_start = $2",
+			a.params.Pid, a.params.QueryStart.AsTime()).Scan(&canceled)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("query of backend %d is not running anymore", a.params.Pid)
+		}
+	} else {
+		err = db.QueryRowContext(ctx, "SELECT /* pmm-agent */ pg_cancel_backend($1)", a.params.Pid).Scan(&canceled)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// pg_cancel_backend returns false (with a warning) if the process is not a PostgreSQL backend.
+	if !canceled {
+		return nil, fmt.Errorf("failed to cancel query of backend %d", a.params.Pid)
+	}
+
+	return nil, nil
+}
+
+func (a *postgresqlCancelBackendAction) sealed() {}
@@ -0,0 +1,116 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package actions
+
+import (
+	"context"
+	"math"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/percona/pmm/agent/utils/tests"
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+)
+
+func TestPostgreSQLCancelBackend(t *testing.T) {
+	t.Parallel()
+
+	dsn := tests.GetTestPostgreSQLDSN(t)
+	db := tests.OpenTestPostgreSQL(t)
+	t.Cleanup(func() {
+		assert.NoError(t, db.Close())
+	})
+
+	t.Run("Default", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		conn, err := db.Conn(ctx)
+		require.NoError(t, err)
+		defer conn.Close() //nolint:errcheck
+
+		var pid int32
+		require.NoError(t, conn.QueryRowContext(ctx, "SELECT pg_backend_pid()").Scan(&pid))
+
+		sleepErr := make(chan error, 1)
+		go func() {
+			_, err := conn.ExecContext(ctx, "SELECT pg_sleep(30)")
+			sleepErr <- err
+		}()
+
+		params := &agentv1.StartActionRequest_PostgreSQLCancelBackendParams{
+			Dsn: dsn,
+			Pid: pid,
+		}
+		a, err := NewPostgreSQLCancelBackendAction("", 0, params, os.TempDir())
+		require.NoError(t, err)
+
+		// wait for pg_sleep to start
+		time.Sleep(500 * time.Millisecond)
+
+		_, err = a.Run(ctx)
+		require.NoError(t, err)
+
+		err = <-sleepErr
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "canceling statement due to user request")
+	})
+
+	t.Run("UnknownBackend", func(t *testing.T) {
+		t.Parallel()
+
+		params := &agentv1.StartActionRequest_PostgreSQLCancelBackendParams{
+			Dsn: dsn,
+			Pid: math.MaxInt32,
+		}
+		a, err := NewPostgreSQLCancelBackendAction("", 0, params, os.TempDir())
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		_, err = a.Run(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to cancel query of backend")
+	})
+	t.Run("QueryFinished", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+
+		var pid int32
+		require.NoError(t, db.QueryRowContext(ctx, "SELECT pg_backend_pid()").Scan(&pid))
+
+		params := &agentv1.StartActionRequest_PostgreSQLCancelBackendParams{
+			Dsn:        dsn,
+			Pid:        pid,
+			QueryStart: timestamppb.New(time.Now().Add(-time.Hour)),
+		}
+		a, err := NewPostgreSQLCancelBackendAction("", 0, params, os.TempDir())
+		require.NoError(t, err)
+
+		_, err = a.Run(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "is not running anymore")
+	})
+}
@@ -0,0 +1,209 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// s3PartSize is the size of a single part of multipart upload.
+// Artifacts are streamed, so their size is unknown beforehand, and this value limits
+// the maximum artifact size to 10000 parts (about 640 GiB).
+const s3PartSize = 64 * 1024 * 1024
+
+// backupStorage is used by jobs that stream artifact files to the backup location themselves
+// instead of relying on the backup tool to do that.
+type backupStorage interface {
+	// Put stores the content of r as the file with given name.
+	Put(ctx context.Context, name string, r io.Reader) error
+	// Get returns the content of the file with given name.
+	Get(ctx context.Context, name string) (io.ReadCloser, error)
+	// List returns sorted names of the files with given prefix.
+	List(ctx context.Context, prefix string) ([]string, error)
+}
+
+// newBackupStorage returns backupStorage for given location config.
+func newBackupStorage(config *BackupLocationConfig) (backupStorage, error) { //nolint:ireturn
+	switch config.Type {
+	case S3BackupLocationType:
+		if config.S3Config == nil {
+			return nil, errors.New("s3 config is not set")
+		}
+		return newS3Storage(config.S3Config)
+	case FilesystemBackupLocationType:
+		if config.FilesystemStorageConfig == nil {
+			return nil, errors.New("filesystem config is not set")
+		}
+		return &filesystemStorage{root: config.FilesystemStorageConfig.Path}, nil
+	default:
+		return nil, fmt.Errorf("unknown location type: %q", config.Type)
+	}
+}
+
+// s3Storage stores files in S3 compatible storage.
+type s3Storage struct {
+	client *minio.Client
+	bucket string
+}
+
+func newS3Storage(config *S3LocationConfig) (*s3Storage, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse s3 endpoint: %w", err)
+	}
+	if endpoint.Host == "" {
+		// Endpoint is specified without scheme.
+		endpoint = &url.URL{Scheme: "https", Host: config.Endpoint}
+	}
+
+	client, err := minio.New(endpoint.Host, &minio.Options{
+		Creds:  credentials.NewStaticV4(config.AccessKey, config.SecretKey, ""),
+		Secure: endpoint.Scheme != "http",
+		Region: config.BucketRegion,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create s3 client: %w", err)
+	}
+
+	return &s3Storage{
+		client: client,
+		bucket: config.BucketName,
+	}, nil
+}
+
+// Put implements backupStorage interface.
+func (s *s3Storage) Put(ctx context.Context, name string, r io.Reader) error {
+	_, err := s.client.PutObject(ctx, s.bucket, name, r, -1, minio.PutObjectOptions{PartSize: s3PartSize})
+	if err != nil {
+		return fmt.Errorf("failed to upload %q: %w", name, err)
+	}
+	return nil
+}
+
+// Get implements backupStorage interface.
+func (s *s3Storage) Get(ctx context.Context, name string) (io.ReadCloser, error) {
+	object, err := s.client.GetObject(ctx, s.bucket, name, minio.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %q: %w", name, err)
+	}
+	return object, nil
+}
+
+// List implements backupStorage interface.
+func (s *s3Storage) List(ctx context.Context, prefix string) ([]string, error) {
+	var res []string
+	for object := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Prefix: prefix, Recursive: true}) {
+		if object.Err != nil {
+			return nil, fmt.Errorf("failed to list objects in bucket %q: %w", s.bucket, object.Err)
+		}
+		res = append(res, object.Key)
+	}
+	sort.Strings(res)
+	return res, nil
+}
+
+// filesystemStorage stores files in the local (or mounted) filesystem of the pmm-agent node.
+type filesystemStorage struct {
+	root string
+}
+
+// Put implements backupStorage interface.
+func (s *filesystemStorage) Put(_ context.Context, name string, r io.Reader) (rerr error) {
+	target := filepath.Join(s.root, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(target), 0o750); err != nil { //nolint:mnd
+		return err
+	}
+
+	// Write to the temporary file first, so incomplete files are never left under the final name.
+	f, err := os.CreateTemp(filepath.Dir(target), "."+filepath.Base(target)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if rerr != nil {
+			_ = os.Remove(f.Name())
+		}
+	}()
+
+	if _, err = io.Copy(f, r); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write %q: %w", target, err)
+	}
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(f.Name(), target)
+}
+
+// Get implements backupStorage interface.
+func (s *filesystemStorage) Get(_ context.Context, name string) (io.ReadCloser, error) {
+	return os.Open(filepath.Join(s.root, filepath.FromSlash(name))) //nolint:gosec
+}
+
+// List implements backupStorage interface.
+func (s *filesystemStorage) List(_ context.Context, prefix string) ([]string, error) {
+	dir := path.Dir(prefix)
+	if strings.HasSuffix(prefix, "/") {
+		dir = strings.TrimSuffix(prefix, "/")
+	}
+
+	var res []string
+	err := filepath.WalkDir(filepath.Join(s.root, filepath.FromSlash(dir)), func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if d.IsDir() || strings.HasSuffix(d.Name(), ".tmp") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(s.root, p)
+		if err != nil {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if strings.HasPrefix(name, prefix) {
+			res = append(res, name)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(res)
+	return res, nil
+}
+
+// check interfaces.
+var (
+	_ backupStorage = (*s3Storage)(nil)
+	_ backupStorage = (*filesystemStorage)(nil)
+)
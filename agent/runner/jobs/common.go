@@ -18,6 +18,8 @@ import (
 	"net"
 	"net/url"
 	"strconv"
+
+	"github.com/go-sql-driver/mysql"
 )
 
 const maxLogsChunkSize = 50
@@ -55,3 +57,46 @@ func (c *DBConnConfig) createDBURL() *url.URL {
 		Host:   host,
 	}
 }
+
+// mysqlConfig returns MySQL driver config for connection to DB.
+func (c *DBConnConfig) mysqlConfig() *mysql.Config {
+	cfg := mysql.NewConfig()
+	cfg.User = c.User
+	cfg.Passwd = c.Password
+	switch {
+	case c.Address != "":
+		cfg.Net = "tcp"
+		cfg.Addr = c.Address
+		if c.Port > 0 {
+			cfg.Addr = net.JoinHostPort(c.Address, strconv.Itoa(c.Port))
+		}
+	case c.Socket != "":
+		cfg.Net = "unix"
+		cfg.Addr = c.Socket
+	}
+	return cfg
+}
+
+// mysqlClientArgs returns connection arguments and environment variables for MySQL command line tools.
+// Password is passed via environment, so it is not visible in the list of processes.
+func (c *DBConnConfig) mysqlClientArgs() ([]string, []string) {
+	var args, env []string
+	if c.User != "" {
+		args = append(args, "--user="+c.User)
+	}
+	if c.Password != "" {
+		env = append(env, "MYSQL_PWD="+c.Password)
+	}
+
+	switch {
+	case c.Address != "":
+		args = append(args, "--host="+c.Address)
+		if c.Port > 0 {
+			args = append(args, "--port="+strconv.Itoa(c.Port))
+		}
+	case c.Socket != "":
+		args = append(args, "--socket="+c.Socket)
+	}
+
+	return args, env
+}
@@ -27,10 +27,15 @@ type JobType string
 
 // Available job types.
 const (
-	MySQLBackup    = JobType("mysql_backup")
-	MongoDBBackup  = JobType("mongodb_backup")
-	MongoDBRestore = JobType("mongodb_restore")
-	MySQLRestore   = JobType("mysql_restore")
+	MySQLBackup       = JobType("mysql_backup")
+	MongoDBBackup     = JobType("mongodb_backup")
+	MongoDBRestore    = JobType("mongodb_restore")
+	MySQLRestore      = JobType("mysql_restore")
+	PostgreSQLBackup  = JobType("postgresql_backup")
+	PostgreSQLRestore = JobType("postgresql_restore")
+	MySQLVerify       = JobType("mysql_verify")
+	MongoDBVerify     = JobType("mongodb_verify")
+	MySQLBinlogStream = JobType("mysql_binlog_stream")
 )
 
 // Send is interface for function that used by jobs to send messages back to pmm-server.
@@ -0,0 +1,263 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/klauspost/compress/zstd"
+	"github.com/pierrec/lz4/v4"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+)
+
+// pbmBackupMeta is a subset of the backup metadata PBM stores next to the backup files.
+type pbmBackupMeta struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Status      string `json:"status"`
+	Error       string `json:"error"`
+	Compression string `json:"compression"`
+	Replsets    []struct {
+		Name   string `json:"name"`
+		Status string `json:"status"`
+		Error  string `json:"error"`
+	} `json:"replsets"`
+}
+
+// MongoDBVerifyJob implements Job for MongoDB backup verification.
+// It checks backup metadata stored by PBM and reads all backup files from the storage,
+// so checksums of the compressed streams are validated.
+type MongoDBVerifyJob struct {
+	id             string
+	timeout        time.Duration
+	l              logrus.FieldLogger
+	name           string
+	pbmBackupName  string
+	locationConfig BackupLocationConfig
+	folder         string
+}
+
+// NewMongoDBVerifyJob creates new Job for MongoDB backup verification.
+func NewMongoDBVerifyJob(
+	id string,
+	timeout time.Duration,
+	name string,
+	pbmBackupName string,
+	locationConfig BackupLocationConfig,
+	folder string,
+) *MongoDBVerifyJob {
+	return &MongoDBVerifyJob{
+		id:             id,
+		timeout:        timeout,
+		l:              logrus.WithFields(logrus.Fields{"id": id, "type": "mongodb_verify", "name": name}),
+		name:           name,
+		pbmBackupName:  pbmBackupName,
+		locationConfig: locationConfig,
+		folder:         folder,
+	}
+}
+
+// ID returns Job id.
+func (j *MongoDBVerifyJob) ID() string {
+	return j.id
+}
+
+// Type returns Job type.
+func (j *MongoDBVerifyJob) Type() JobType {
+	return MongoDBVerify
+}
+
+// Timeout returns Job timeout.
+func (j *MongoDBVerifyJob) Timeout() time.Duration {
+	return j.timeout
+}
+
+// DSN returns DSN for the Job.
+func (j *MongoDBVerifyJob) DSN() string {
+	return "" // artifact is verified on the storage, database is not used
+}
+
+// Run starts Job execution.
+func (j *MongoDBVerifyJob) Run(ctx context.Context, send Send) error {
+	if j.pbmBackupName == "" {
+		return errors.New("pbm backup name is not set")
+	}
+
+	storage, err := newBackupStorage(&j.locationConfig)
+	if err != nil {
+		return err
+	}
+
+	metaName := path.Join(j.folder, j.pbmBackupName+pbmArtifactJSONPostfix)
+	j.l.Infof("Checking PBM metadata %s.", metaName)
+	meta, err := readPBMBackupMeta(ctx, storage, metaName)
+	if err != nil {
+		return err
+	}
+	err = checkPBMBackupMeta(meta, j.pbmBackupName)
+	if err != nil {
+		return err
+	}
+
+	prefix := path.Join(j.folder, j.pbmBackupName) + "/"
+	files, err := storage.List(ctx, prefix)
+	if err != nil {
+		return err
+	}
+	err = checkPBMReplsetFiles(meta, prefix, files)
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		j.l.Debugf("Verifying %s.", file)
+		err = verifyPBMBackupFile(ctx, storage, file)
+		if err != nil {
+			return err
+		}
+	}
+	j.l.Infof("%d backup files verified.", len(files))
+
+	send(&agentv1.JobResult{
+		JobId:     j.id,
+		Timestamp: timestamppb.Now(),
+		Result: &agentv1.JobResult_MongodbVerifyBackup{
+			MongodbVerifyBackup: &agentv1.JobResult_MongoDBVerifyBackup{},
+		},
+	})
+
+	return nil
+}
+
+// readPBMBackupMeta reads and parses PBM backup metadata file.
+func readPBMBackupMeta(ctx context.Context, storage backupStorage, name string) (*pbmBackupMeta, error) {
+	r, err := storage.Get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close() //nolint:errcheck
+
+	var meta pbmBackupMeta
+	err = json.NewDecoder(r).Decode(&meta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read pbm metadata %s: %w", name, err)
+	}
+
+	return &meta, nil
+}
+
+// checkPBMBackupMeta checks that PBM considers the backup complete and consistent.
+func checkPBMBackupMeta(meta *pbmBackupMeta, name string) error {
+	if meta.Name != name {
+		return fmt.Errorf("pbm metadata describes backup %q instead of %q", meta.Name, name)
+	}
+
+	if meta.Status != pbmStatusDone {
+		if meta.Error != "" {
+			return fmt.Errorf("backup has status %q: %s", meta.Status, meta.Error)
+		}
+		return fmt.Errorf("backup has status %q", meta.Status)
+	}
+
+	if len(meta.Replsets) == 0 {
+		return errors.New("pbm metadata contains no replica sets")
+	}
+
+	var errMsgs []string
+	for _, rs := range meta.Replsets {
+		if rs.Status != pbmStatusDone {
+			errMsgs = append(errMsgs, fmt.Sprintf("replset: %s, status: %s, error: %s", rs.Name, rs.Status, rs.Error))
+		}
+	}
+	if len(errMsgs) != 0 {
+		return errors.New(strings.Join(errMsgs, "; "))
+	}
+
+	return nil
+}
+
+// checkPBMReplsetFiles checks that every replica set from the metadata has files on the storage.
+func checkPBMReplsetFiles(meta *pbmBackupMeta, prefix string, files []string) error {
+	for _, rs := range meta.Replsets {
+		rsPrefix := prefix + rs.Name + "/"
+		found := false
+		for _, f := range files {
+			if strings.HasPrefix(f, rsPrefix) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("no backup files found for replset %s", rs.Name)
+		}
+	}
+
+	return nil
+}
+
+// verifyPBMBackupFile reads the whole file from the storage. Compressed files are decompressed,
+// that validates checksums of the compressed streams.
+func verifyPBMBackupFile(ctx context.Context, storage backupStorage, name string) error {
+	f, err := storage.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer f.Close() //nolint:errcheck
+
+	r, err := pbmFileReader(name, f)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", name, err)
+	}
+	defer r.Close() //nolint:errcheck
+
+	_, err = io.Copy(io.Discard, r)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// pbmFileReader returns reader that decompresses PBM backup file based on its extension.
+func pbmFileReader(name string, r io.Reader) (io.ReadCloser, error) {
+	switch path.Ext(name) {
+	case ".gz":
+		return gzip.NewReader(r)
+	case ".snappy", ".s2":
+		return io.NopCloser(s2.NewReader(r)), nil
+	case ".lz4":
+		return io.NopCloser(lz4.NewReader(r)), nil
+	case ".zst":
+		d, err := zstd.NewReader(r)
+		if err != nil {
+			return nil, err
+		}
+		return d.IOReadCloser(), nil
+	default:
+		return io.NopCloser(r), nil
+	}
+}
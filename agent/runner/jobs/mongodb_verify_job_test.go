@@ -0,0 +1,187 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/klauspost/compress/s2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+)
+
+func gzipped(t *testing.T, data string) *bytes.Buffer {
+	t.Helper()
+
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	_, err := w.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return &b
+}
+
+func s2Compressed(t *testing.T, data string) *bytes.Buffer {
+	t.Helper()
+
+	var b bytes.Buffer
+	w := s2.NewWriter(&b)
+	_, err := w.Write([]byte(data))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return &b
+}
+
+func TestCheckPBMBackupMeta(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name   string
+		meta   string
+		errMsg string
+	}{
+		{
+			name: "done",
+			meta: `{"name": "2026-01-01T00:00:00Z", "status": "done", "replsets": [{"name": "rs0", "status": "done"}]}`,
+		},
+		{
+			name:   "other backup",
+			meta:   `{"name": "2026-01-02T00:00:00Z", "status": "done", "replsets": [{"name": "rs0", "status": "done"}]}`,
+			errMsg: `pbm metadata describes backup "2026-01-02T00:00:00Z" instead of "2026-01-01T00:00:00Z"`,
+		},
+		{
+			name:   "error",
+			meta:   `{"name": "2026-01-01T00:00:00Z", "status": "error", "error": "no space left on device"}`,
+			errMsg: `backup has status "error": no space left on device`,
+		},
+		{
+			name:   "no replsets",
+			meta:   `{"name": "2026-01-01T00:00:00Z", "status": "done"}`,
+			errMsg: "pbm metadata contains no replica sets",
+		},
+		{
+			name: "failed replset",
+			meta: `{"name": "2026-01-01T00:00:00Z", "status": "done", "replsets": [` +
+				`{"name": "rs0", "status": "done"}, {"name": "rs1", "status": "error", "error": "node is down"}]}`,
+			errMsg: "replset: rs1, status: error, error: node is down",
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			storage := &filesystemStorage{root: t.TempDir()}
+			require.NoError(t, storage.Put(ctx, "meta.pbm.json", bytes.NewBufferString(tc.meta)))
+
+			meta, err := readPBMBackupMeta(ctx, storage, "meta.pbm.json")
+			require.NoError(t, err)
+
+			err = checkPBMBackupMeta(meta, "2026-01-01T00:00:00Z")
+			if tc.errMsg == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tc.errMsg)
+		})
+	}
+}
+
+func TestMongoDBVerifyJob(t *testing.T) {
+	t.Parallel()
+
+	const (
+		pbmName = "2026-01-01T00:00:00Z"
+		meta    = `{"name": "2026-01-01T00:00:00Z", "status": "done", "compression": "gzip", "replsets": [{"name": "rs0", "status": "done"}]}`
+	)
+
+	newJob := func(t *testing.T) (*MongoDBVerifyJob, *filesystemStorage) {
+		t.Helper()
+
+		root := t.TempDir()
+		locationConfig := BackupLocationConfig{
+			Type:                    FilesystemBackupLocationType,
+			FilesystemStorageConfig: &FilesystemBackupLocationConfig{Path: root},
+		}
+		return NewMongoDBVerifyJob("job-id", 0, "backup", pbmName, locationConfig, "folder"), &filesystemStorage{root: root}
+	}
+
+	run := func(t *testing.T, job *MongoDBVerifyJob) ([]*agentv1.JobResult, error) {
+		t.Helper()
+
+		var results []*agentv1.JobResult
+		err := job.Run(t.Context(), func(payload agentv1.AgentResponsePayload) {
+			results = append(results, payload.(*agentv1.JobResult)) //nolint:forcetypeassert
+		})
+		return results, err
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		job, storage := newJob(t)
+		require.NoError(t, storage.Put(ctx, "folder/"+pbmName+".pbm.json", bytes.NewBufferString(meta)))
+		require.NoError(t, storage.Put(ctx, "folder/"+pbmName+"/rs0/db.coll.gz", gzipped(t, "data")))
+		require.NoError(t, storage.Put(ctx, "folder/"+pbmName+"/rs0/local.oplog.rs.bson.s2", s2Compressed(t, "oplog")))
+
+		results, err := run(t, job)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.NotNil(t, results[0].GetMongodbVerifyBackup())
+	})
+
+	t.Run("missing replset files", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		job, storage := newJob(t)
+		require.NoError(t, storage.Put(ctx, "folder/"+pbmName+".pbm.json", bytes.NewBufferString(meta)))
+		require.NoError(t, storage.Put(ctx, "folder/"+pbmName+"/rs1/db.coll.gz", gzipped(t, "data")))
+
+		results, err := run(t, job)
+		require.EqualError(t, err, "no backup files found for replset rs0")
+		assert.Empty(t, results)
+	})
+
+	t.Run("corrupted file", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		job, storage := newJob(t)
+		require.NoError(t, storage.Put(ctx, "folder/"+pbmName+".pbm.json", bytes.NewBufferString(meta)))
+
+		data := gzipped(t, "some collection data").Bytes()
+		data[len(data)-8] ^= 0xff // damage CRC32 in gzip trailer
+		require.NoError(t, storage.Put(ctx, "folder/"+pbmName+"/rs0/db.coll.gz", bytes.NewBuffer(data)))
+
+		results, err := run(t, job)
+		require.ErrorIs(t, err, gzip.ErrChecksum)
+		assert.Empty(t, results)
+	})
+
+	t.Run("missing metadata", func(t *testing.T) {
+		t.Parallel()
+
+		job, _ := newJob(t)
+
+		results, err := run(t, job)
+		require.Error(t, err)
+		assert.Empty(t, results)
+	})
+}
@@ -22,7 +22,9 @@ import (
 	"os"
 	"os/exec"
 	"path"
+	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -36,6 +38,9 @@ const (
 	xtrabackupBin = "xtrabackup"
 	xbcloudBin    = "xbcloud"
 	qpressBin     = "qpress"
+
+	// mysqlPITRSnapshotTimeFormat is the format of snapshot folder names inside Point-in-Time recovery artifacts.
+	mysqlPITRSnapshotTimeFormat = "20060102150405"
 )
 
 // MySQLBackupJob implements Job for MySQL backup.
@@ -47,10 +52,19 @@ type MySQLBackupJob struct {
 	connConf       DBConnConfig
 	locationConfig BackupLocationConfig
 	folder         string
+	pitr           bool
 }
 
 // NewMySQLBackupJob constructs new Job for MySQL backup.
-func NewMySQLBackupJob(id string, timeout time.Duration, name string, connConf DBConnConfig, locationConfig BackupLocationConfig, folder string) *MySQLBackupJob {
+func NewMySQLBackupJob(
+	id string,
+	timeout time.Duration,
+	name string,
+	connConf DBConnConfig,
+	locationConfig BackupLocationConfig,
+	folder string,
+	pitr bool,
+) *MySQLBackupJob {
 	return &MySQLBackupJob{
 		id:             id,
 		timeout:        timeout,
@@ -59,6 +73,7 @@ func NewMySQLBackupJob(id string, timeout time.Duration, name string, connConf D
 		connConf:       connConf,
 		locationConfig: locationConfig,
 		folder:         folder,
+		pitr:           pitr,
 	}
 }
 
@@ -89,11 +104,35 @@ func (j *MySQLBackupJob) Run(ctx context.Context, send Send) error {
 		return err
 	}
 
-	err = j.backup(ctx)
+	snapshotName := j.name
+	var lsnDir string
+	if j.pitr {
+		// Point-in-Time recovery artifact consists of several snapshots, each one is stored in its own folder.
+		snapshotName = path.Join(j.name, time.Now().UTC().Format(mysqlPITRSnapshotTimeFormat))
+
+		// xtrabackup_info with binary log coordinates of the snapshot is saved there in addition to the stream.
+		lsnDir, err = os.MkdirTemp("", "mysql-backup-info")
+		if err != nil {
+			return fmt.Errorf("failed to create tempdir: %w", err)
+		}
+		defer func() {
+			if err := os.RemoveAll(lsnDir); err != nil {
+				j.l.WithError(err).Warn("failed to remove temporary directory")
+			}
+		}()
+	}
+
+	err = j.backup(ctx, snapshotName, lsnDir)
 	if err != nil {
 		return err
 	}
 
+	if j.pitr {
+		if err = j.saveSnapshotBinlogInfo(ctx, snapshotName, lsnDir); err != nil {
+			return err
+		}
+	}
+
 	// mysqlArtifactFiles returns list of files and folders the backup consists of (hardcoded).
 	mysqlArtifactFiles := func(backupFolder string) []*backuppb.File {
 		res := []*backuppb.File{
@@ -102,14 +141,21 @@ func (j *MySQLBackupJob) Run(ctx context.Context, send Send) error {
 		return res
 	}
 
+	metadata := &backuppb.Metadata{
+		FileList: mysqlArtifactFiles(snapshotName),
+	}
+	if j.pitr {
+		// Binary logs are applied starting from the position stored in the snapshot,
+		// so the snapshot can be used for recovery to any point after it was completed.
+		metadata.RestoreTo = timestamppb.Now()
+	}
+
 	send(&agentv1.JobResult{
 		JobId:     j.id,
 		Timestamp: timestamppb.Now(),
 		Result: &agentv1.JobResult_MysqlBackup{
 			MysqlBackup: &agentv1.JobResult_MySQLBackup{
-				Metadata: &backuppb.Metadata{
-					FileList: mysqlArtifactFiles(j.name),
-				},
+				Metadata: metadata,
 			},
 		},
 	})
@@ -138,7 +184,33 @@ func (j *MySQLBackupJob) binariesInstalled() error {
 	return nil
 }
 
-func (j *MySQLBackupJob) backup(ctx context.Context) (rerr error) {
+// saveSnapshotBinlogInfo stores binary log coordinates of the snapshot next to binary logs of the artifact,
+// so streaming of binary logs started together with the snapshot continues right after it.
+func (j *MySQLBackupJob) saveSnapshotBinlogInfo(ctx context.Context, snapshotName, lsnDir string) error {
+	b, err := os.ReadFile(filepath.Join(lsnDir, xtrabackupInfoFile)) //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("failed to read binary log coordinates of the snapshot: %w", err)
+	}
+
+	binlog, position, err := parseXtrabackupInfoBinlogPos(b)
+	if err != nil {
+		return err
+	}
+
+	storage, err := newBackupStorage(&j.locationConfig)
+	if err != nil {
+		return err
+	}
+
+	name := path.Join(j.folder, j.name, mysqlBinlogFolder, snapshotBinlogInfoName(path.Base(snapshotName)))
+	j.l.Debugf("Snapshot starts at %s:%s, saving to %s.", binlog, position, name)
+
+	return storage.Put(ctx, name, strings.NewReader(binlog+"\t"+position+"\n"))
+}
+
+// backup takes the snapshot and streams it to the storage. If lsnDir is not empty,
+// xtrabackup saves metadata files there too.
+func (j *MySQLBackupJob) backup(ctx context.Context, snapshotName, lsnDir string) (rerr error) {
 	pipeCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
@@ -162,6 +234,10 @@ func (j *MySQLBackupJob) backup(ctx context.Context) (rerr error) {
 		// https://jira.percona.com/browse/PXB-2602
 		"--target-dir="+tmpDir) // #nosec G204
 
+	if lsnDir != "" {
+		xtrabackupCmd.Args = append(xtrabackupCmd.Args, "--extra-lsndir="+lsnDir)
+	}
+
 	if j.connConf.User != "" {
 		xtrabackupCmd.Args = append(xtrabackupCmd.Args, "--user="+j.connConf.User)
 		xtrabackupCmd.Args = append(xtrabackupCmd.Args, "--password="+j.connConf.Password)
@@ -182,7 +258,7 @@ func (j *MySQLBackupJob) backup(ctx context.Context) (rerr error) {
 	case S3BackupLocationType:
 		xtrabackupCmd.Args = append(xtrabackupCmd.Args, "--stream=xbstream")
 
-		artifactFolder := path.Join(j.folder, j.name)
+		artifactFolder := path.Join(j.folder, snapshotName)
 
 		j.l.Debugf("Artifact folder is: %s", artifactFolder)
 
@@ -0,0 +1,264 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// binlogMagic is the header every binary log file starts with.
+	binlogMagic = "\xfebin"
+	// binlogEventHeaderSize is the size of binary log event header (v4 format).
+	binlogEventHeaderSize = 19
+	// binlogChunkTimeFormat is the format of timestamps in the names of binary logs stored on a storage.
+	binlogChunkTimeFormat = "20060102150405"
+	// binlogChunkSuffix is the suffix of binary logs stored on a storage, they are gzipped.
+	binlogChunkSuffix = ".gz"
+	// snapshotBinlogInfoSuffix is the suffix of files with binary log coordinates of snapshots.
+	// They are stored next to binary logs and named after the snapshot folder.
+	snapshotBinlogInfoSuffix = ".binlog_info"
+	// mysqlBinlogFolder is the folder of Point-in-Time recovery artifact where binary logs are stored.
+	// Keep in sync with managed/models/artifact_helpers.go.
+	mysqlBinlogFolder = "binlogs"
+)
+
+var xtrabackupInfoBinlogPosRE = regexp.MustCompile(`(?m)^binlog_pos\s*=\s*filename '([^']+)', position '(\d+)'`)
+
+// binlogChunk describes binary log file stored on a storage.
+type binlogChunk struct {
+	// name is the name of the file on a storage.
+	name string
+	// binlog is the original binary log file name, e.g. binlog.000042.
+	binlog string
+	// start and end are timestamps of the first and the last events in the binary log.
+	start time.Time
+	end   time.Time
+}
+
+// binlogChunkName returns the name of the file on a storage for the given binary log.
+// Keep in sync with managed/services/backup/mysql_pitr_timerange_service.go.
+func binlogChunkName(binlog string, start, end time.Time) string {
+	return fmt.Sprintf("%s.%s.%s%s", binlog, start.UTC().Format(binlogChunkTimeFormat), end.UTC().Format(binlogChunkTimeFormat), binlogChunkSuffix)
+}
+
+// parseBinlogChunkName parses the name of binary log file stored on a storage.
+// It returns false if the name has unexpected format.
+func parseBinlogChunkName(name string) (*binlogChunk, bool) {
+	base := path.Base(name)
+	if !strings.HasSuffix(base, binlogChunkSuffix) {
+		return nil, false
+	}
+
+	// Binary log name contains a dot itself, so there are at least four parts.
+	parts := strings.Split(strings.TrimSuffix(base, binlogChunkSuffix), ".")
+	if len(parts) < 4 { //nolint:mnd
+		return nil, false
+	}
+
+	start, err := time.Parse(binlogChunkTimeFormat, parts[len(parts)-2])
+	if err != nil {
+		return nil, false
+	}
+
+	end, err := time.Parse(binlogChunkTimeFormat, parts[len(parts)-1])
+	if err != nil {
+		return nil, false
+	}
+
+	binlog := strings.Join(parts[:len(parts)-2], ".")
+	if _, _, ok := splitBinlogName(binlog); !ok {
+		return nil, false
+	}
+
+	return &binlogChunk{
+		name:   name,
+		binlog: binlog,
+		start:  start,
+		end:    end,
+	}, true
+}
+
+// splitBinlogName splits binary log file name into base name and sequence number.
+func splitBinlogName(binlog string) (string, int, bool) {
+	i := strings.LastIndex(binlog, ".")
+	if i <= 0 {
+		return "", 0, false
+	}
+
+	seq, err := strconv.Atoi(binlog[i+1:])
+	if err != nil {
+		return "", 0, false
+	}
+
+	return binlog[:i], seq, true
+}
+
+// binlogFollows returns true if next binary log directly follows prev one.
+func binlogFollows(prev, next string) bool {
+	prevBase, prevSeq, ok := splitBinlogName(prev)
+	if !ok {
+		return false
+	}
+
+	nextBase, nextSeq, ok := splitBinlogName(next)
+	if !ok {
+		return false
+	}
+
+	return prevBase == nextBase && nextSeq == prevSeq+1
+}
+
+// binlogBefore returns true if binary log a was written before b. Sequence numbers are compared as numbers,
+// as they are not zero-padded after reaching the padding width (e.g. binlog.999999 is followed by binlog.1000000).
+func binlogBefore(a, b string) bool {
+	aBase, aSeq, aOK := splitBinlogName(a)
+	bBase, bSeq, bOK := splitBinlogName(b)
+	if !aOK || !bOK || aBase != bBase {
+		return a < b
+	}
+
+	return aSeq < bSeq
+}
+
+// snapshotBinlogInfoName returns the name of the file with binary log coordinates of the given snapshot.
+func snapshotBinlogInfoName(snapshot string) string {
+	return snapshot + snapshotBinlogInfoSuffix
+}
+
+// parseXtrabackupBinlogInfo parses binary log name and position in the format of xtrabackup_binlog_info file.
+func parseXtrabackupBinlogInfo(b []byte) (string, string, error) {
+	// The file contains binary log name, position and, optionally, GTID set separated by tabs.
+	fields := strings.Fields(string(b))
+	if len(fields) < 2 { //nolint:mnd
+		return "", "", fmt.Errorf("unexpected content of %s: %q", xtrabackupBinlogInfoFile, string(b))
+	}
+
+	if _, err := strconv.ParseUint(fields[1], 10, 64); err != nil {
+		return "", "", fmt.Errorf("unexpected binary log position in %s: %q", xtrabackupBinlogInfoFile, fields[1])
+	}
+
+	return fields[0], fields[1], nil
+}
+
+// parseXtrabackupInfoBinlogPos returns binary log name and position from the content of xtrabackup_info file.
+func parseXtrabackupInfoBinlogPos(b []byte) (string, string, error) {
+	m := xtrabackupInfoBinlogPosRE.FindSubmatch(b)
+	if m == nil {
+		return "", "", fmt.Errorf("binary log position is not found in %s", xtrabackupInfoFile)
+	}
+
+	return string(m[1]), string(m[2]), nil
+}
+
+// binlogTimeRange returns timestamps of the earliest and the latest events in the binary log.
+func binlogTimeRange(r io.Reader) (time.Time, time.Time, error) {
+	br := bufio.NewReader(r)
+
+	magic := make([]byte, len(binlogMagic))
+	if _, err := io.ReadFull(br, magic); err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to read binary log header: %w", err)
+	}
+	if !bytes.Equal(magic, []byte(binlogMagic)) {
+		return time.Time{}, time.Time{}, errors.New("not a binary log file")
+	}
+
+	var first, last uint32
+	header := make([]byte, binlogEventHeaderSize)
+	for {
+		_, err := io.ReadFull(br, header)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("failed to read binary log event header: %w", err)
+		}
+
+		timestamp := binary.LittleEndian.Uint32(header[0:4])
+		size := binary.LittleEndian.Uint32(header[9:13])
+		if size < binlogEventHeaderSize {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid binary log event size %d", size)
+		}
+
+		if _, err = br.Discard(int(size - binlogEventHeaderSize)); err != nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("failed to read binary log event: %w", err)
+		}
+
+		// Artificial events, e.g. rotate events sent by the server on connection, have zero timestamp.
+		if timestamp == 0 {
+			continue
+		}
+
+		if first == 0 || timestamp < first {
+			first = timestamp
+		}
+		if timestamp > last {
+			last = timestamp
+		}
+	}
+
+	if first == 0 {
+		return time.Time{}, time.Time{}, errors.New("binary log contains no events")
+	}
+
+	return time.Unix(int64(first), 0).UTC(), time.Unix(int64(last), 0).UTC(), nil
+}
+
+// binlogChunksForRestore returns binary logs required to roll forward a snapshot,
+// taken at the position in startBinlog, to the given time.
+func binlogChunksForRestore(chunks []*binlogChunk, startBinlog string, until time.Time) ([]*binlogChunk, error) {
+	sorted := make([]*binlogChunk, len(chunks))
+	copy(sorted, chunks)
+	sort.Slice(sorted, func(i, j int) bool { return binlogBefore(sorted[i].binlog, sorted[j].binlog) })
+
+	var res []*binlogChunk
+	for _, chunk := range sorted {
+		if len(res) == 0 {
+			if chunk.binlog == startBinlog {
+				res = append(res, chunk)
+			}
+			continue
+		}
+
+		prev := res[len(res)-1]
+		if chunk.binlog == prev.binlog {
+			// The same binary log may be uploaded twice if streaming was restarted.
+			continue
+		}
+
+		if !binlogFollows(prev.binlog, chunk.binlog) || chunk.start.After(until) {
+			break
+		}
+
+		res = append(res, chunk)
+	}
+
+	if len(res) == 0 {
+		return nil, fmt.Errorf("binary log %s required for point-in-time recovery is not found on the storage", startBinlog)
+	}
+
+	return res, nil
+}
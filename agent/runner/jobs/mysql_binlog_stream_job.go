@@ -0,0 +1,438 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+)
+
+const (
+	mysqlbinlogBin = "mysqlbinlog"
+
+	// binlogUploadInterval is how often completed binary logs are uploaded to the storage.
+	binlogUploadInterval = 10 * time.Second
+	// binlogFlushInterval is how often binary logs are rotated on the server. Only completed binary logs
+	// are uploaded, so this interval limits how old the latest recovery point can be.
+	binlogFlushInterval = 10 * time.Minute
+	// binlogStopUploadTimeout limits the time spent on uploading binary logs after the job is stopped.
+	binlogStopUploadTimeout = 5 * time.Minute
+)
+
+// MySQLBinlogStreamJob implements Job for continuous streaming of MySQL binary logs to the storage.
+// It runs until stopped. Completed binary logs are uploaded to the storage, while the current one
+// is streamed again after restart.
+type MySQLBinlogStreamJob struct {
+	id             string
+	timeout        time.Duration
+	l              logrus.FieldLogger
+	connConf       DBConnConfig
+	locationConfig BackupLocationConfig
+	folder         string
+	tempDir        string
+}
+
+// NewMySQLBinlogStreamJob constructs new Job for MySQL binary logs streaming.
+func NewMySQLBinlogStreamJob(
+	id string,
+	timeout time.Duration,
+	connConf DBConnConfig,
+	locationConfig BackupLocationConfig,
+	folder, tempDir string,
+) *MySQLBinlogStreamJob {
+	return &MySQLBinlogStreamJob{
+		id:             id,
+		timeout:        timeout,
+		l:              logrus.WithFields(logrus.Fields{"id": id, "type": "mysql_binlog_stream"}),
+		connConf:       connConf,
+		locationConfig: locationConfig,
+		folder:         folder,
+		tempDir:        tempDir,
+	}
+}
+
+// ID returns job id.
+func (j *MySQLBinlogStreamJob) ID() string {
+	return j.id
+}
+
+// Type returns job type.
+func (j *MySQLBinlogStreamJob) Type() JobType {
+	return MySQLBinlogStream
+}
+
+// Timeout returns job timeout.
+func (j *MySQLBinlogStreamJob) Timeout() time.Duration {
+	return j.timeout
+}
+
+// DSN returns DSN for the Job.
+func (j *MySQLBinlogStreamJob) DSN() string {
+	// The job never ends, so it should not take a slot of the per-database concurrency limit.
+	return ""
+}
+
+// Run streams binary logs until the context is canceled.
+func (j *MySQLBinlogStreamJob) Run(ctx context.Context, send Send) error {
+	if j.locationConfig.S3Config == nil {
+		return errors.New("s3 config is not set")
+	}
+
+	if _, err := exec.LookPath(mysqlbinlogBin); err != nil {
+		return fmt.Errorf("lookpath=%s: %w", mysqlbinlogBin, err)
+	}
+
+	storage, err := newBackupStorage(&j.locationConfig)
+	if err != nil {
+		return err
+	}
+
+	connector, err := mysql.NewConnector(j.connConf.mysqlConfig())
+	if err != nil {
+		return err
+	}
+	db := sql.OpenDB(connector)
+	defer db.Close() //nolint:errcheck
+
+	startBinlog, err := j.startBinlog(ctx, db, storage)
+	if err != nil {
+		if ctx.Err() != nil {
+			// Stopped while waiting for the snapshot, nothing was streamed.
+			j.sendResult(send)
+			return nil
+		}
+		return err
+	}
+	j.l.Infof("Streaming binary logs starting from %s.", startBinlog)
+
+	if err = os.MkdirAll(j.tempDir, 0o750); err != nil { //nolint:mnd
+		return fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+
+	dir, err := os.MkdirTemp(j.tempDir, "mysql-binlogs-")
+	if err != nil {
+		return fmt.Errorf("failed to create temporary directory: %w", err)
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			j.l.WithError(err).Warn("failed to remove temporary directory")
+		}
+	}()
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	args, env := j.connConf.mysqlClientArgs()
+	args = append(args,
+		"--read-from-remote-server",
+		"--raw",
+		"--stop-never",
+		"--result-file="+dir+string(filepath.Separator),
+		startBinlog)
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(streamCtx, mysqlbinlogBin, args...) // #nosec G204
+	cmd.Env = append(cmd.Environ(), env...)
+	cmd.Stderr = &stderr
+	if err = cmd.Start(); err != nil {
+		return fmt.Errorf("%s start failed: %w", mysqlbinlogBin, err)
+	}
+
+	waitErr := make(chan error, 1)
+	go func() {
+		waitErr <- cmd.Wait()
+	}()
+
+	uploadTicker := time.NewTicker(binlogUploadInterval)
+	defer uploadTicker.Stop()
+	flushTicker := time.NewTicker(binlogFlushInterval)
+	defer flushTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			cancel()
+			<-waitErr
+			return j.stop(ctx, storage, dir, send)
+
+		case err := <-waitErr:
+			if ctx.Err() != nil {
+				return j.stop(ctx, storage, dir, send)
+			}
+			uploadErr := j.uploadCompleted(ctx, storage, dir)
+			return errors.Join(fmt.Errorf("%s exited: %w, stderr: %s", mysqlbinlogBin, err, stderr.String()), uploadErr)
+
+		case <-uploadTicker.C:
+			// Storage errors are usually transient, so the upload is retried on the next tick.
+			if err := j.uploadCompleted(ctx, storage, dir); err != nil {
+				j.l.WithError(err).Warn("Failed to upload binary logs.")
+			}
+
+		case <-flushTicker.C:
+			if _, err := db.ExecContext(ctx, "FLUSH BINARY LOGS"); err != nil {
+				j.l.WithError(err).Warn("Failed to flush binary logs.")
+			}
+		}
+	}
+}
+
+// stop uploads binary logs completed before the job was stopped and reports the job result.
+func (j *MySQLBinlogStreamJob) stop(ctx context.Context, storage backupStorage, dir string, send Send) error {
+	uploadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), binlogStopUploadTimeout)
+	defer cancel()
+
+	if err := j.uploadCompleted(uploadCtx, storage, dir); err != nil {
+		return err
+	}
+
+	j.sendResult(send)
+	return nil
+}
+
+// sendResult reports successful completion of the job.
+func (j *MySQLBinlogStreamJob) sendResult(send Send) {
+	send(&agentv1.JobResult{
+		JobId:     j.id,
+		Timestamp: timestamppb.Now(),
+		Result: &agentv1.JobResult_MysqlBinlogStream{
+			MysqlBinlogStream: &agentv1.JobResult_MySQLBinlogStream{},
+		},
+	})
+}
+
+// startBinlog returns the binary log to start streaming from. Streaming continues right after the last binary log
+// uploaded to the storage. If nothing was uploaded yet, or the following binary log was purged from the server,
+// streaming starts from the binary log of the next snapshot, so binary logs are applicable on top of it.
+func (j *MySQLBinlogStreamJob) startBinlog(ctx context.Context, db *sql.DB, storage backupStorage) (string, error) {
+	serverBinlogs, err := serverBinlogs(ctx, db)
+	if err != nil {
+		return "", err
+	}
+	if len(serverBinlogs) == 0 {
+		return "", errors.New("binary logging is not enabled on the server")
+	}
+
+	files, err := storage.List(ctx, j.folder+"/")
+	if err != nil {
+		return "", err
+	}
+
+	var lastUploaded string
+	for _, f := range files {
+		if chunk, ok := parseBinlogChunkName(f); ok && (lastUploaded == "" || binlogBefore(lastUploaded, chunk.binlog)) {
+			lastUploaded = chunk.binlog
+		}
+	}
+
+	if lastUploaded != "" {
+		for _, binlog := range serverBinlogs {
+			if binlogFollows(lastUploaded, binlog) {
+				return binlog, nil
+			}
+		}
+
+		j.l.Warnf("Binary log following %s is not available on the server, "+
+			"point-in-time recovery is not possible until the next snapshot is completed.", lastUploaded)
+	}
+
+	return j.waitSnapshotBinlog(ctx, db, storage, lastUploaded)
+}
+
+// waitSnapshotBinlog waits until binary log coordinates of a snapshot starting after the given binary log
+// are stored and returns the binary log of that snapshot. Snapshots are taken concurrently with the start
+// of streaming, so coordinates appear only when the snapshot is completed.
+func (j *MySQLBinlogStreamJob) waitSnapshotBinlog(ctx context.Context, db *sql.DB, storage backupStorage, after string) (string, error) {
+	ticker := time.NewTicker(binlogUploadInterval)
+	defer ticker.Stop()
+
+	for {
+		binlog, err := j.lastSnapshotBinlog(ctx, storage)
+		if err != nil {
+			return "", err
+		}
+
+		if binlog != "" && (after == "" || binlogBefore(after, binlog)) {
+			serverBinlogs, err := serverBinlogs(ctx, db)
+			if err != nil {
+				return "", err
+			}
+			if !slices.Contains(serverBinlogs, binlog) {
+				return "", fmt.Errorf("binary log %s of the snapshot is not available on the server", binlog)
+			}
+			return binlog, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// lastSnapshotBinlog returns the binary log of the latest snapshot of the artifact, or empty string if there are none.
+func (j *MySQLBinlogStreamJob) lastSnapshotBinlog(ctx context.Context, storage backupStorage) (string, error) {
+	files, err := storage.List(ctx, j.folder+"/")
+	if err != nil {
+		return "", err
+	}
+
+	// Files are named after snapshot folders, so the latest snapshot is the last one.
+	var last string
+	for _, f := range files {
+		if strings.HasSuffix(f, snapshotBinlogInfoSuffix) {
+			last = f
+		}
+	}
+	if last == "" {
+		return "", nil
+	}
+
+	r, err := storage.Get(ctx, last)
+	if err != nil {
+		return "", err
+	}
+	defer r.Close() //nolint:errcheck
+
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", last, err)
+	}
+
+	binlog, _, err := parseXtrabackupBinlogInfo(b)
+	return binlog, err
+}
+
+// serverBinlogs returns sorted names of binary logs available on the server.
+func serverBinlogs(ctx context.Context, db *sql.DB) ([]string, error) {
+	rows, err := db.QueryContext(ctx, "SHOW BINARY LOGS")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list binary logs: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, err
+	}
+
+	// The number of columns differs between versions, only the first one (Log_name) is needed.
+	var res []string
+	for rows.Next() {
+		var name string
+		dest := make([]any, len(columns))
+		dest[0] = &name
+		for i := 1; i < len(dest); i++ {
+			dest[i] = new(sql.RawBytes)
+		}
+		if err = rows.Scan(dest...); err != nil {
+			return nil, err
+		}
+		res = append(res, name)
+	}
+
+	return res, rows.Err()
+}
+
+// uploadCompleted uploads all binary logs in the directory except the last one, which is still being written.
+func (j *MySQLBinlogStreamJob) uploadCompleted(ctx context.Context, storage backupStorage, dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+
+	var files []string
+	for _, e := range entries {
+		if e.Type().IsRegular() {
+			files = append(files, filepath.Join(dir, e.Name()))
+		}
+	}
+
+	if len(files) <= 1 {
+		return nil
+	}
+
+	for _, f := range files[:len(files)-1] {
+		if err = j.uploadBinlog(ctx, storage, f); err != nil {
+			return err
+		}
+
+		if err = os.Remove(f); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// uploadBinlog uploads gzipped binary log to the storage.
+func (j *MySQLBinlogStreamJob) uploadBinlog(ctx context.Context, storage backupStorage, fileName string) error {
+	f, err := os.Open(fileName) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	defer f.Close() //nolint:errcheck
+
+	start, end, err := binlogTimeRange(f)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", fileName, err)
+	}
+
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	pr, pw := io.Pipe()
+	gzipErr := make(chan error, 1)
+	go func() {
+		gw := gzip.NewWriter(pw)
+		_, err := io.Copy(gw, f)
+		if err == nil {
+			err = gw.Close()
+		}
+		_ = pw.CloseWithError(err)
+		gzipErr <- err
+	}()
+
+	name := path.Join(j.folder, binlogChunkName(filepath.Base(fileName), start, end))
+	j.l.Debugf("Uploading %s.", name)
+
+	err = storage.Put(ctx, name, pr)
+	// Unblock the writer if upload failed before reading everything.
+	_ = pr.CloseWithError(err)
+	if gzErr := <-gzipErr; err == nil {
+		err = gzErr
+	}
+
+	return err
+}
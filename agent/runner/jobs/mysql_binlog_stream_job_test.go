@@ -0,0 +1,131 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"context"
+	"database/sql"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMySQLBinlogStreamStartBinlog(t *testing.T) {
+	t.Parallel()
+
+	ts := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	const folder = "backups/artifact/binlogs"
+
+	setup := func(t *testing.T, files map[string]string, serverBinlogs ...string) (*MySQLBinlogStreamJob, *sql.DB, backupStorage) {
+		t.Helper()
+
+		storage := &filesystemStorage{root: t.TempDir()}
+		for name, content := range files {
+			require.NoError(t, storage.Put(t.Context(), path.Join(folder, name), strings.NewReader(content)))
+		}
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, db.Close())
+		})
+
+		// Binary logs are listed once in startBinlog and once more when the snapshot is found,
+		// so the second query is not always made.
+		mock.MatchExpectationsInOrder(false)
+		mock.ExpectClose()
+		for range 2 {
+			rows := sqlmock.NewRows([]string{"Log_name", "File_size", "Encrypted"})
+			for _, binlog := range serverBinlogs {
+				rows.AddRow(binlog, 1024, "No")
+			}
+			mock.ExpectQuery("SHOW BINARY LOGS").WillReturnRows(rows)
+		}
+
+		job := NewMySQLBinlogStreamJob("job-id", 0, DBConnConfig{}, BackupLocationConfig{}, folder, t.TempDir())
+		return job, db, storage
+	}
+
+	t.Run("continues after last uploaded", func(t *testing.T) {
+		t.Parallel()
+
+		job, db, storage := setup(t, map[string]string{
+			binlogChunkName("binlog.999998", ts, ts): "",
+			binlogChunkName("binlog.999999", ts, ts): "",
+			snapshotBinlogInfoName("20240301090000"): "binlog.999998\t157\n",
+		}, "binlog.999999", "binlog.1000000", "binlog.1000001")
+
+		binlog, err := job.startBinlog(t.Context(), db, storage)
+		require.NoError(t, err)
+		assert.Equal(t, "binlog.1000000", binlog)
+	})
+
+	t.Run("starts from snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		job, db, storage := setup(t, map[string]string{
+			snapshotBinlogInfoName("20240301090000"): "binlog.000001\t157\n",
+			snapshotBinlogInfoName("20240301100000"): "binlog.000002\t4321\n",
+		}, "binlog.000001", "binlog.000002", "binlog.000003")
+
+		binlog, err := job.startBinlog(t.Context(), db, storage)
+		require.NoError(t, err)
+		assert.Equal(t, "binlog.000002", binlog)
+	})
+
+	t.Run("gap continues from next snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		job, db, storage := setup(t, map[string]string{
+			binlogChunkName("binlog.000001", ts, ts): "",
+			snapshotBinlogInfoName("20240301100000"): "binlog.000004\t157\n",
+		}, "binlog.000003", "binlog.000004", "binlog.000005")
+
+		binlog, err := job.startBinlog(t.Context(), db, storage)
+		require.NoError(t, err)
+		assert.Equal(t, "binlog.000004", binlog)
+	})
+
+	t.Run("snapshot binlog purged", func(t *testing.T) {
+		t.Parallel()
+
+		job, db, storage := setup(t, map[string]string{
+			snapshotBinlogInfoName("20240301100000"): "binlog.000001\t157\n",
+		}, "binlog.000002", "binlog.000003")
+
+		_, err := job.startBinlog(t.Context(), db, storage)
+		require.EqualError(t, err, "binary log binlog.000001 of the snapshot is not available on the server")
+	})
+
+	t.Run("waits for snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		job, db, storage := setup(t, map[string]string{
+			binlogChunkName("binlog.000001", ts, ts): "",
+			snapshotBinlogInfoName("20240301090000"): "binlog.000001\t157\n",
+		}, "binlog.000003", "binlog.000004")
+
+		ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+		defer cancel()
+
+		_, err := job.startBinlog(ctx, db, storage)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -0,0 +1,226 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"bytes"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// binlogEvent returns binary log event with given timestamp and payload size.
+func binlogEvent(timestamp uint32, payloadSize int) []byte {
+	event := make([]byte, binlogEventHeaderSize+payloadSize)
+	binary.LittleEndian.PutUint32(event[0:4], timestamp)
+	binary.LittleEndian.PutUint32(event[9:13], uint32(len(event))) //nolint:gosec
+	return event
+}
+
+func TestBinlogChunkName(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 3, 1, 10, 9, 59, 0, time.UTC)
+
+	name := binlogChunkName("binlog.000042", start, end)
+	assert.Equal(t, "binlog.000042.20240301100000.20240301100959.gz", name)
+
+	chunk, ok := parseBinlogChunkName("backups/artifact/binlogs/" + name)
+	require.True(t, ok)
+	assert.Equal(t, &binlogChunk{
+		name:   "backups/artifact/binlogs/" + name,
+		binlog: "binlog.000042",
+		start:  start,
+		end:    end,
+	}, chunk)
+
+	for _, name := range []string{
+		"binlog.000042.gz",
+		"binlog.000042.20240301100000.20240301100959",
+		"binlog.000042.20240301100000.invalid.gz",
+		"binlog.20240301100000.20240301100959.gz",
+		"metadata.json",
+	} {
+		_, ok := parseBinlogChunkName(name)
+		assert.False(t, ok, name)
+	}
+}
+
+func TestBinlogFollows(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, binlogFollows("binlog.000009", "binlog.000010"))
+	assert.True(t, binlogFollows("mysql-bin.999999", "mysql-bin.1000000"))
+	assert.False(t, binlogFollows("binlog.000009", "binlog.000011"))
+	assert.False(t, binlogFollows("binlog.000009", "binlog.000009"))
+	assert.False(t, binlogFollows("binlog.000009", "mysql-bin.000010"))
+	assert.False(t, binlogFollows("binlog", "binlog.000001"))
+}
+
+func TestBinlogBefore(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, binlogBefore("binlog.000009", "binlog.000010"))
+	assert.True(t, binlogBefore("mysql-bin.999999", "mysql-bin.1000000"))
+	assert.False(t, binlogBefore("mysql-bin.1000000", "mysql-bin.999999"))
+	assert.False(t, binlogBefore("binlog.000009", "binlog.000009"))
+}
+
+func TestParseXtrabackupInfoBinlogPos(t *testing.T) {
+	t.Parallel()
+
+	binlog, position, err := parseXtrabackupInfoBinlogPos([]byte("uuid = 5e6a0b1c\n" +
+		"binlog_pos = filename 'binlog.000042', position '1234', GTID of the last change '3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5'\n" +
+		"innodb_from_lsn = 0\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "binlog.000042", binlog)
+	assert.Equal(t, "1234", position)
+
+	_, _, err = parseXtrabackupInfoBinlogPos([]byte("binlog_pos = \n"))
+	require.EqualError(t, err, "binary log position is not found in xtrabackup_info")
+}
+
+func TestBinlogTimeRange(t *testing.T) {
+	t.Parallel()
+
+	t.Run("normal", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		buf.WriteString(binlogMagic)
+		buf.Write(binlogEvent(0, 10)) // artificial rotate event
+		buf.Write(binlogEvent(1700000100, 100))
+		buf.Write(binlogEvent(1700000000, 0))
+		buf.Write(binlogEvent(1700000500, 20))
+
+		start, end, err := binlogTimeRange(&buf)
+		require.NoError(t, err)
+		assert.Equal(t, time.Unix(1700000000, 0).UTC(), start)
+		assert.Equal(t, time.Unix(1700000500, 0).UTC(), end)
+	})
+
+	t.Run("not a binlog", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := binlogTimeRange(bytes.NewReader([]byte("text file")))
+		require.EqualError(t, err, "not a binary log file")
+	})
+
+	t.Run("no events", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := binlogTimeRange(bytes.NewReader([]byte(binlogMagic)))
+		require.EqualError(t, err, "binary log contains no events")
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		buf.WriteString(binlogMagic)
+		buf.Write(binlogEvent(1700000000, 100)[:50])
+
+		_, _, err := binlogTimeRange(&buf)
+		require.Error(t, err)
+	})
+}
+
+func TestBinlogChunksForRestore(t *testing.T) {
+	t.Parallel()
+
+	ts := func(minute int) time.Time {
+		return time.Date(2024, 3, 1, 10, minute, 0, 0, time.UTC)
+	}
+	chunk := func(binlog string, start, end int) *binlogChunk {
+		return &binlogChunk{name: binlogChunkName(binlog, ts(start), ts(end)), binlog: binlog, start: ts(start), end: ts(end)}
+	}
+
+	c1 := chunk("binlog.000001", 0, 9)
+	c2 := chunk("binlog.000002", 10, 19)
+	c2dup := chunk("binlog.000002", 10, 15)
+	c3 := chunk("binlog.000003", 20, 29)
+	c5 := chunk("binlog.000005", 40, 49)
+
+	chunks := []*binlogChunk{c5, c3, c2dup, c1, c2}
+
+	t.Run("sequence overflow", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := binlogChunksForRestore([]*binlogChunk{
+			chunk("binlog.1000000", 10, 19),
+			chunk("binlog.999999", 0, 9),
+		}, "binlog.999999", ts(15))
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, "binlog.999999", res[0].binlog)
+		assert.Equal(t, "binlog.1000000", res[1].binlog)
+	})
+
+	t.Run("up to time", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := binlogChunksForRestore(chunks, "binlog.000001", ts(15))
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, "binlog.000001", res[0].binlog)
+		assert.Equal(t, "binlog.000002", res[1].binlog)
+	})
+
+	t.Run("stops at gap", func(t *testing.T) {
+		t.Parallel()
+
+		res, err := binlogChunksForRestore(chunks, "binlog.000002", ts(45))
+		require.NoError(t, err)
+		require.Len(t, res, 2)
+		assert.Equal(t, "binlog.000002", res[0].binlog)
+		assert.Equal(t, "binlog.000003", res[1].binlog)
+	})
+
+	t.Run("start binlog not found", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := binlogChunksForRestore(chunks, "binlog.000004", ts(45))
+		require.EqualError(t, err, "binary log binlog.000004 required for point-in-time recovery is not found on the storage")
+	})
+}
+
+func TestReadXtrabackupBinlogInfo(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	_, _, err := readXtrabackupBinlogInfo(dir)
+	require.Error(t, err)
+
+	err = os.WriteFile(filepath.Join(dir, xtrabackupBinlogInfoFile), []byte("binlog.000042\t1234\t3e11fa47-71ca-11e1-9e33-c80aa9429562:1-5\n"), 0o600)
+	require.NoError(t, err)
+
+	binlog, position, err := readXtrabackupBinlogInfo(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "binlog.000042", binlog)
+	assert.Equal(t, "1234", position)
+
+	err = os.WriteFile(filepath.Join(dir, xtrabackupBinlogInfoFile), []byte("binlog.000042\n"), 0o600)
+	require.NoError(t, err)
+
+	_, _, err = readXtrabackupBinlogInfo(dir)
+	require.Error(t, err)
+}
@@ -16,6 +16,7 @@ package jobs
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
 	"errors"
 	"fmt"
@@ -37,11 +38,19 @@ import (
 
 const (
 	xbstreamBin          = "xbstream"
+	mysqlBin             = "mysql"
 	mySQLSystemUserName  = "mysql"
 	mySQLSystemGroupName = "mysql"
 	// TODO make mySQLDirectory autorecognized as done in 'xtrabackup' utility; see 'xtrabackup --help' --datadir parameter.
 	mySQLDirectory   = "/var/lib/mysql"
 	systemctlTimeout = 10 * time.Second
+
+	// xtrabackupBinlogInfoFile contains binary log coordinates of the snapshot.
+	xtrabackupBinlogInfoFile = "xtrabackup_binlog_info"
+	// xtrabackupInfoFile contains backup details, including binary log coordinates of the snapshot.
+	xtrabackupInfoFile = "xtrabackup_info"
+	// mysqlbinlogTimeFormat is the format of --stop-datetime option of mysqlbinlog.
+	mysqlbinlogTimeFormat = "2006-01-02 15:04:05"
 )
 
 var mysqlServiceRegex = regexp.MustCompile(`mysql(d)?\.service`) // this is used to lookup MySQL service in the list of all system services
@@ -54,10 +63,24 @@ type MySQLRestoreJob struct {
 	name           string
 	locationConfig BackupLocationConfig
 	folder         string
+	pitrTimestamp  time.Time
+	binlogFolder   string
+	connConf       DBConnConfig
 }
 
 // NewMySQLRestoreJob constructs new Job for MySQL backup restore.
-func NewMySQLRestoreJob(id string, timeout time.Duration, name string, locationConfig BackupLocationConfig, folder string) *MySQLRestoreJob {
+// If pitrTimestamp is not zero, binary logs stored in binlogFolder are applied on top of the restored snapshot
+// up to that time using connConf to connect to the restored server.
+func NewMySQLRestoreJob(
+	id string,
+	timeout time.Duration,
+	name string,
+	locationConfig BackupLocationConfig,
+	folder string,
+	pitrTimestamp time.Time,
+	binlogFolder string,
+	connConf DBConnConfig,
+) *MySQLRestoreJob {
 	return &MySQLRestoreJob{
 		id:             id,
 		timeout:        timeout,
@@ -65,6 +88,9 @@ func NewMySQLRestoreJob(id string, timeout time.Duration, name string, locationC
 		name:           name,
 		locationConfig: locationConfig,
 		folder:         folder,
+		pitrTimestamp:  pitrTimestamp,
+		binlogFolder:   binlogFolder,
+		connConf:       connConf,
 	}
 }
 
@@ -94,11 +120,18 @@ func (j *MySQLRestoreJob) Run(ctx context.Context, send Send) error {
 		return errors.New("s3 config is not set")
 	}
 
-	err := j.binariesInstalled()
+	err := mySQLRestoreBinariesInstalled()
 	if err != nil {
 		return err
 	}
 
+	if !j.pitrTimestamp.IsZero() {
+		err = mySQLPITRBinariesInstalled()
+		if err != nil {
+			return err
+		}
+	}
+
 	_, _, err = mySQLUserAndGroupIDs()
 	if err != nil {
 		return err
@@ -121,17 +154,17 @@ func (j *MySQLRestoreJob) Run(ctx context.Context, send Send) error {
 	}
 	j.l.Debugf("Using MySQL service name: %s", mySQLServiceName)
 
-	err = j.restoreMySQLFromS3(ctx, tmpDir)
+	err = downloadMySQLBackupFromS3(ctx, j.l, &j.locationConfig, path.Join(j.folder, j.name), tmpDir)
 	if err != nil {
 		return err
 	}
 
-	active, err := mySQLActive(ctx, mySQLServiceName)
+	active, err := systemServiceActive(ctx, mySQLServiceName)
 	if err != nil {
 		return err
 	}
 	if active {
-		err = stopMySQL(ctx, mySQLServiceName)
+		err = stopSystemService(ctx, mySQLServiceName)
 		if err != nil {
 			return err
 		}
@@ -142,11 +175,18 @@ func (j *MySQLRestoreJob) Run(ctx context.Context, send Send) error {
 		return err
 	}
 
-	err = startMySQL(ctx, mySQLServiceName)
+	err = startSystemService(ctx, mySQLServiceName)
 	if err != nil {
 		return err
 	}
 
+	if !j.pitrTimestamp.IsZero() {
+		err = j.applyBinlogs(ctx, tmpDir)
+		if err != nil {
+			return err
+		}
+	}
+
 	send(&agentv1.JobResult{
 		JobId:     j.id,
 		Timestamp: timestamppb.Now(),
@@ -158,7 +198,8 @@ func (j *MySQLRestoreJob) Run(ctx context.Context, send Send) error {
 	return nil
 }
 
-func (j *MySQLRestoreJob) binariesInstalled() error {
+// mySQLRestoreBinariesInstalled checks that all tools required for downloading and preparing MySQL backup are installed.
+func mySQLRestoreBinariesInstalled() error {
 	_, err := exec.LookPath(xtrabackupBin)
 	if err != nil {
 		return fmt.Errorf("lookpath=%s: %w", xtrabackupBin, err)
@@ -182,6 +223,164 @@ func (j *MySQLRestoreJob) binariesInstalled() error {
 	return nil
 }
 
+// mySQLPITRBinariesInstalled checks that all tools required for applying binary logs are installed.
+func mySQLPITRBinariesInstalled() error {
+	_, err := exec.LookPath(mysqlbinlogBin)
+	if err != nil {
+		return fmt.Errorf("lookpath=%s: %w", mysqlbinlogBin, err)
+	}
+
+	_, err = exec.LookPath(mysqlBin)
+	if err != nil {
+		return fmt.Errorf("lookpath=%s: %w", mysqlBin, err)
+	}
+
+	return nil
+}
+
+// applyBinlogs rolls the restored snapshot forward to the requested point in time.
+func (j *MySQLRestoreJob) applyBinlogs(ctx context.Context, backupDirectory string) error {
+	startBinlog, startPosition, err := readXtrabackupBinlogInfo(backupDirectory)
+	if err != nil {
+		return err
+	}
+
+	storage, err := newBackupStorage(&j.locationConfig)
+	if err != nil {
+		return err
+	}
+
+	files, err := storage.List(ctx, j.binlogFolder+"/")
+	if err != nil {
+		return err
+	}
+
+	chunks := make([]*binlogChunk, 0, len(files))
+	for _, f := range files {
+		if chunk, ok := parseBinlogChunkName(f); ok {
+			chunks = append(chunks, chunk)
+		}
+	}
+
+	required, err := binlogChunksForRestore(chunks, startBinlog, j.pitrTimestamp)
+	if err != nil {
+		return err
+	}
+
+	binlogDirectory := filepath.Join(backupDirectory, "binlogs")
+	if err = os.MkdirAll(binlogDirectory, 0o750); err != nil { //nolint:mnd
+		return err
+	}
+
+	binlogFiles := make([]string, 0, len(required))
+	for _, chunk := range required {
+		target := filepath.Join(binlogDirectory, chunk.binlog)
+		j.l.Debugf("Downloading %s.", chunk.name)
+		if err = downloadBinlog(ctx, storage, chunk.name, target); err != nil {
+			return err
+		}
+		binlogFiles = append(binlogFiles, target)
+	}
+
+	j.l.Infof("Applying %d binary logs starting from %s:%s up to %s.", len(binlogFiles), startBinlog, startPosition, j.pitrTimestamp)
+
+	// --stop-datetime is exclusive, while the requested time is inclusive.
+	binlogArgs := append([]string{
+		"--start-position=" + startPosition,
+		"--stop-datetime=" + j.pitrTimestamp.Add(time.Second).UTC().Format(mysqlbinlogTimeFormat),
+		"--disable-log-bin",
+	}, binlogFiles...)
+
+	return applyBinlogFiles(ctx, binlogArgs, &j.connConf)
+}
+
+// applyBinlogFiles pipes the output of mysqlbinlog with given arguments to mysql client.
+func applyBinlogFiles(ctx context.Context, binlogArgs []string, connConf *DBConnConfig) (rerr error) {
+	pipeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var binlogStderr, mysqlOutput bytes.Buffer
+
+	binlogCmd := exec.CommandContext(pipeCtx, mysqlbinlogBin, binlogArgs...) // #nosec G204
+	// mysqlbinlog interprets --stop-datetime in the local time zone.
+	binlogCmd.Env = append(binlogCmd.Environ(), "TZ=UTC")
+	binlogCmd.Stderr = &binlogStderr
+
+	binlogStdout, err := binlogCmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("failed to get %s stdout pipe: %w", mysqlbinlogBin, err)
+	}
+
+	mysqlArgs, mysqlEnv := connConf.mysqlClientArgs()
+	mysqlCmd := exec.CommandContext(pipeCtx, mysqlBin, mysqlArgs...) // #nosec G204
+	mysqlCmd.Env = append(mysqlCmd.Environ(), mysqlEnv...)
+	mysqlCmd.Stdin = binlogStdout
+	mysqlCmd.Stdout = &mysqlOutput
+	mysqlCmd.Stderr = &mysqlOutput
+
+	if err = binlogCmd.Start(); err != nil {
+		return fmt.Errorf("%s start failed: %w", mysqlbinlogBin, err)
+	}
+
+	if err = mysqlCmd.Start(); err != nil {
+		cancel()
+		_ = binlogCmd.Wait()
+		return fmt.Errorf("%s start failed: %w", mysqlBin, err)
+	}
+
+	if err = mysqlCmd.Wait(); err != nil {
+		cancel()
+		rerr = fmt.Errorf("%s failed, output=%s: %w", mysqlBin, mysqlOutput.String(), err)
+	}
+
+	if err = binlogCmd.Wait(); err != nil && rerr == nil {
+		rerr = fmt.Errorf("%s failed, stderr=%s: %w", mysqlbinlogBin, binlogStderr.String(), err)
+	}
+
+	return rerr
+}
+
+// downloadBinlog downloads gzipped binary log from the storage and decompresses it to target file.
+func downloadBinlog(ctx context.Context, storage backupStorage, name, target string) (rerr error) {
+	r, err := storage.Get(ctx, name)
+	if err != nil {
+		return err
+	}
+	defer r.Close() //nolint:errcheck
+
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return fmt.Errorf("failed to decompress %s: %w", name, err)
+	}
+	defer gr.Close() //nolint:errcheck
+
+	f, err := os.Create(target) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil && rerr == nil {
+			rerr = err
+		}
+	}()
+
+	if _, err = io.Copy(f, gr); err != nil { //nolint:gosec
+		return fmt.Errorf("failed to download %s: %w", name, err)
+	}
+
+	return nil
+}
+
+// readXtrabackupBinlogInfo returns binary log name and position of the snapshot in the given directory.
+func readXtrabackupBinlogInfo(backupDirectory string) (string, string, error) {
+	b, err := os.ReadFile(filepath.Join(backupDirectory, xtrabackupBinlogInfoFile)) //nolint:gosec
+	if err != nil {
+		return "", "", fmt.Errorf("failed to read binary log coordinates of the snapshot: %w", err)
+	}
+
+	return parseXtrabackupBinlogInfo(b)
+}
+
 func prepareRestoreCommands( //nolint:nonamedreturns
 	ctx context.Context,
 	folder string,
@@ -225,20 +424,25 @@ func prepareRestoreCommands( //nolint:nonamedreturns
 	return xbcloudCmd, xbstreamCmd, nil
 }
 
-func (j *MySQLRestoreJob) restoreMySQLFromS3(ctx context.Context, targetDirectory string) (rerr error) {
+// downloadMySQLBackupFromS3 downloads and extracts MySQL backup stored in artifactFolder to targetDirectory.
+func downloadMySQLBackupFromS3(
+	ctx context.Context,
+	l logrus.FieldLogger,
+	locationConfig *BackupLocationConfig,
+	artifactFolder string,
+	targetDirectory string,
+) (rerr error) {
 	pipeCtx, cancel := context.WithCancel(ctx)
 	defer cancel()
 
 	var stderr, stdout bytes.Buffer
 
-	artifactFolder := path.Join(j.folder, j.name)
-
-	j.l.Debugf("Artifact folder is: %s", artifactFolder)
+	l.Debugf("Artifact folder is: %s", artifactFolder)
 
 	xbcloudCmd, xbstreamCmd, err := prepareRestoreCommands(
 		pipeCtx,
 		artifactFolder,
-		&j.locationConfig,
+		locationConfig,
 		targetDirectory,
 		&stderr,
 		&stdout,
@@ -288,11 +492,11 @@ func (j *MySQLRestoreJob) restoreMySQLFromS3(ctx context.Context, targetDirector
 	return nil
 }
 
-func mySQLActive(ctx context.Context, mySQLServiceName string) (bool, error) {
+func systemServiceActive(ctx context.Context, serviceName string) (bool, error) {
 	ctx, cancel := context.WithTimeout(ctx, systemctlTimeout)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "systemctl", "is-active", "--quiet", mySQLServiceName) //nolint:gosec
+	cmd := exec.CommandContext(ctx, "systemctl", "is-active", "--quiet", serviceName) //nolint:gosec
 	err := cmd.Start()
 	if err != nil {
 		return false, fmt.Errorf("starting systemctl is-active command failed: %w", err)
@@ -311,11 +515,11 @@ func mySQLActive(ctx context.Context, mySQLServiceName string) (bool, error) {
 	}
 }
 
-func stopMySQL(ctx context.Context, mySQLServiceName string) error {
+func stopSystemService(ctx context.Context, serviceName string) error {
 	ctx, cancel := context.WithTimeout(ctx, systemctlTimeout)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "systemctl", "stop", mySQLServiceName) //nolint:gosec
+	cmd := exec.CommandContext(ctx, "systemctl", "stop", serviceName) //nolint:gosec
 	err := cmd.Start()
 	if err != nil {
 		return fmt.Errorf("starting systemctl stop command failed: %w", err)
@@ -328,11 +532,11 @@ func stopMySQL(ctx context.Context, mySQLServiceName string) error {
 	return nil
 }
 
-func startMySQL(ctx context.Context, mySQLServiceName string) error {
+func startSystemService(ctx context.Context, serviceName string) error {
 	ctx, cancel := context.WithTimeout(ctx, systemctlTimeout)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "systemctl", "start", mySQLServiceName) //nolint:gosec
+	cmd := exec.CommandContext(ctx, "systemctl", "start", serviceName) //nolint:gosec
 	err := cmd.Start()
 	if err != nil {
 		return fmt.Errorf("starting systemctl start command failed: %w", err)
@@ -357,7 +561,12 @@ func chownRecursive(path string, uid, gid int) error {
 
 // mySQLUserAndGroupIDs returns uid, gid if error is nil.
 func mySQLUserAndGroupIDs() (int, int, error) {
-	u, err := user.Lookup(mySQLSystemUserName)
+	return systemUserAndGroupIDs(mySQLSystemUserName, mySQLSystemGroupName)
+}
+
+// systemUserAndGroupIDs returns uid, gid of given system user and group if error is nil.
+func systemUserAndGroupIDs(userName, groupName string) (int, int, error) {
+	u, err := user.Lookup(userName)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -367,7 +576,7 @@ func mySQLUserAndGroupIDs() (int, int, error) {
 		return 0, 0, err
 	}
 
-	g, err := user.LookupGroup(mySQLSystemGroupName)
+	g, err := user.LookupGroup(groupName)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -405,24 +614,9 @@ func restoreBackup(ctx context.Context, backupDirectory, mySQLDirectory string)
 	// Setting default value in case the base MySQL folder have been lost.
 	mysqlDirPermissions := os.FileMode(0o750) //nolint:mnd
 
-	output, err := exec.CommandContext( //nolint:gosec
-		ctx,
-		xtrabackupBin,
-		"--decompress",
-		"--target-dir="+backupDirectory,
-	).CombinedOutput()
-	if err != nil {
-		return fmt.Errorf("failed to decompress, output=%s: %w", string(output), err)
-	}
-
-	output, err = exec.CommandContext( //nolint:gosec
-		ctx,
-		xtrabackupBin,
-		"--prepare",
-		"--target-dir="+backupDirectory,
-	).CombinedOutput()
+	err := prepareMySQLBackup(ctx, backupDirectory)
 	if err != nil {
-		return fmt.Errorf("failed to prepare, output=%s: %w", string(output), err)
+		return err
 	}
 
 	exists, err := isPathExists(mySQLDirectory)
@@ -441,7 +635,7 @@ func restoreBackup(ctx context.Context, backupDirectory, mySQLDirectory string)
 		}
 	}
 
-	output, err = exec.CommandContext( //nolint:gosec
+	output, err := exec.CommandContext( //nolint:gosec
 		ctx,
 		xtrabackupBin,
 		"--copy-back",
@@ -472,6 +666,31 @@ func restoreBackup(ctx context.Context, backupDirectory, mySQLDirectory string)
 	return nil
 }
 
+// prepareMySQLBackup decompresses downloaded backup and makes it consistent, so it is ready to be copied back.
+func prepareMySQLBackup(ctx context.Context, backupDirectory string) error {
+	output, err := exec.CommandContext( //nolint:gosec
+		ctx,
+		xtrabackupBin,
+		"--decompress",
+		"--target-dir="+backupDirectory,
+	).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to decompress, output=%s: %w", string(output), err)
+	}
+
+	output, err = exec.CommandContext( //nolint:gosec
+		ctx,
+		xtrabackupBin,
+		"--prepare",
+		"--target-dir="+backupDirectory,
+	).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to prepare, output=%s: %w", string(output), err)
+	}
+
+	return nil
+}
+
 // getMysqlServiceName returns MySQL system service name.
 func getMysqlServiceName(ctx context.Context) (string, error) {
 	ctx, cancel := context.WithTimeout(ctx, systemctlTimeout)
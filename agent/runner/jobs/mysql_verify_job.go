@@ -0,0 +1,125 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+)
+
+// MySQLVerifyJob implements Job for MySQL backup verification.
+// It downloads the artifact into a scratch directory and runs xtrabackup --prepare on it,
+// which is exactly what a restore does before copying the data back.
+type MySQLVerifyJob struct {
+	id             string
+	timeout        time.Duration
+	l              logrus.FieldLogger
+	name           string
+	locationConfig BackupLocationConfig
+	folder         string
+	tempDir        string
+}
+
+// NewMySQLVerifyJob constructs new Job for MySQL backup verification.
+func NewMySQLVerifyJob(id string, timeout time.Duration, name string, locationConfig BackupLocationConfig, folder, tempDir string) *MySQLVerifyJob {
+	return &MySQLVerifyJob{
+		id:             id,
+		timeout:        timeout,
+		l:              logrus.WithFields(logrus.Fields{"id": id, "type": "mysql_verify"}),
+		name:           name,
+		locationConfig: locationConfig,
+		folder:         folder,
+		tempDir:        tempDir,
+	}
+}
+
+// ID returns job id.
+func (j *MySQLVerifyJob) ID() string {
+	return j.id
+}
+
+// Type returns job type.
+func (j *MySQLVerifyJob) Type() JobType {
+	return MySQLVerify
+}
+
+// Timeout returns job timeout.
+func (j *MySQLVerifyJob) Timeout() time.Duration {
+	return j.timeout
+}
+
+// DSN returns DSN for the Job.
+func (j *MySQLVerifyJob) DSN() string {
+	return "" // not used for MySQL backup verification
+}
+
+// Run executes backup verification steps.
+func (j *MySQLVerifyJob) Run(ctx context.Context, send Send) error {
+	if j.locationConfig.S3Config == nil {
+		return errors.New("s3 config is not set")
+	}
+
+	err := mySQLRestoreBinariesInstalled()
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(j.tempDir, 0o750) //nolint:mnd
+	if err != nil {
+		return fmt.Errorf("cannot create temporary directory: %w", err)
+	}
+
+	scratchDir, err := os.MkdirTemp(j.tempDir, "mysql-verify-")
+	if err != nil {
+		return fmt.Errorf("cannot create scratch directory: %w", err)
+	}
+	defer func() {
+		err := os.RemoveAll(scratchDir)
+		if err != nil {
+			j.l.WithError(err).Warn("failed to remove scratch directory")
+		}
+	}()
+
+	j.l.Infof("Downloading artifact %s to %s.", j.name, scratchDir)
+	err = downloadMySQLBackupFromS3(ctx, j.l, &j.locationConfig, path.Join(j.folder, j.name), scratchDir)
+	if err != nil {
+		return err
+	}
+
+	j.l.Info("Preparing downloaded backup.")
+	err = prepareMySQLBackup(ctx, scratchDir)
+	if err != nil {
+		return err
+	}
+
+	send(&agentv1.JobResult{
+		JobId:     j.id,
+		Timestamp: timestamppb.Now(),
+		Result: &agentv1.JobResult_MysqlVerifyBackup{
+			MysqlVerifyBackup: &agentv1.JobResult_MySQLVerifyBackup{},
+		},
+	})
+
+	return nil
+}
@@ -0,0 +1,483 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/percona/pmm/agent/utils/templates"
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+	backuppb "github.com/percona/pmm/api/backup/v1"
+)
+
+const (
+	pgBasebackupBin = "pg_basebackup"
+	pgDumpBin       = "pg_dump"
+	pgDumpallBin    = "pg_dumpall"
+	pgRestoreBin    = "pg_restore"
+	psqlBin         = "psql"
+
+	// pgBasebackupFile is the name of the physical backup artifact file with the data directory.
+	pgBasebackupFile = "base.tar.gz"
+	// pgDumpFileSuffix is the suffix of logical backup artifact files, one file per database.
+	pgDumpFileSuffix = ".dump"
+	// pgGlobalsFile is the name of logical backup artifact file with roles and tablespaces.
+	pgGlobalsFile = "globals.sql"
+
+	postgreSQLBackupJobType = "postgresql_backup"
+
+	// listDatabasesQuery returns databases included into logical backups.
+	listDatabasesQuery = "SELECT /* pmm-agent */ datname FROM pg_database " +
+		"WHERE datallowconn AND NOT datistemplate AND has_database_privilege(current_user, datname, 'connect') ORDER BY datname"
+	// countTablespacesQuery returns the number of tablespaces outside of the data directory.
+	countTablespacesQuery = "SELECT /* pmm-agent */ count(*) FROM pg_tablespace WHERE spcname NOT IN ('pg_default', 'pg_global')"
+	// isSuperuserQuery returns true if the current user is a superuser.
+	isSuperuserQuery = "SELECT /* pmm-agent */ rolsuper FROM pg_roles WHERE rolname = current_user"
+)
+
+// pgTablespaceFileRegex matches physical backup artifact files with tablespaces, they are named after tablespace OIDs.
+var pgTablespaceFileRegex = regexp.MustCompile(`^\d+\.tar\.gz$`)
+
+// PostgreSQLBackupJob implements Job for PostgreSQL backup.
+// Physical backups are taken with pg_basebackup, logical backups are taken with pg_dump.
+// Both are streamed to the backup location without storing them on the local disk.
+type PostgreSQLBackupJob struct {
+	id             string
+	timeout        time.Duration
+	l              logrus.FieldLogger
+	name           string
+	dsn            string
+	tmpDir         string
+	dataModel      backuppb.DataModel
+	locationConfig BackupLocationConfig
+	folder         string
+}
+
+// NewPostgreSQLBackupJob constructs new Job for PostgreSQL backup.
+func NewPostgreSQLBackupJob(
+	id string,
+	timeout time.Duration,
+	name string,
+	dsn string,
+	files *agentv1.TextFiles,
+	dataModel backuppb.DataModel,
+	locationConfig BackupLocationConfig,
+	folder string,
+	tempDir string,
+) (*PostgreSQLBackupJob, error) {
+	if dataModel != backuppb.DataModel_DATA_MODEL_PHYSICAL && dataModel != backuppb.DataModel_DATA_MODEL_LOGICAL {
+		return nil, fmt.Errorf("'%s' is not a supported data model for PostgreSQL backups", dataModel)
+	}
+
+	tmpDir := filepath.Join(tempDir, postgreSQLBackupJobType, strings.ReplaceAll(id, "/", "_"))
+	dsn, err := templates.RenderDSN(dsn, files, tmpDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgreSQLBackupJob{
+		id:             id,
+		timeout:        timeout,
+		l:              logrus.WithFields(logrus.Fields{"id": id, "type": postgreSQLBackupJobType, "name": name}),
+		name:           name,
+		dsn:            dsn,
+		tmpDir:         tmpDir,
+		dataModel:      dataModel,
+		locationConfig: locationConfig,
+		folder:         folder,
+	}, nil
+}
+
+// ID returns Job id.
+func (j *PostgreSQLBackupJob) ID() string {
+	return j.id
+}
+
+// Type returns Job type.
+func (j *PostgreSQLBackupJob) Type() JobType {
+	return PostgreSQLBackup
+}
+
+// Timeout returns Job timeout.
+func (j *PostgreSQLBackupJob) Timeout() time.Duration {
+	return j.timeout
+}
+
+// DSN returns DSN for the Job.
+func (j *PostgreSQLBackupJob) DSN() string {
+	return j.dsn
+}
+
+// Run starts Job execution.
+func (j *PostgreSQLBackupJob) Run(ctx context.Context, send Send) error {
+	defer templates.CleanupTempDir(j.tmpDir, logrus.WithField("component", postgreSQLBackupJobType))
+
+	storage, err := newBackupStorage(&j.locationConfig)
+	if err != nil {
+		return err
+	}
+
+	artifactFolder := path.Join(j.folder, j.name)
+	j.l.Debugf("Artifact folder is: %s", artifactFolder)
+
+	var files []*backuppb.File
+	switch j.dataModel {
+	case backuppb.DataModel_DATA_MODEL_PHYSICAL:
+		files, err = j.physicalBackup(ctx, storage, artifactFolder)
+	case backuppb.DataModel_DATA_MODEL_LOGICAL:
+		files, err = j.logicalBackup(ctx, storage, artifactFolder)
+	default:
+		err = fmt.Errorf("unknown data model: %s", j.dataModel)
+	}
+	if err != nil {
+		return err
+	}
+
+	send(&agentv1.JobResult{
+		JobId:     j.id,
+		Timestamp: timestamppb.Now(),
+		Result: &agentv1.JobResult_PostgresqlBackup{
+			PostgresqlBackup: &agentv1.JobResult_PostgreSQLBackup{
+				Metadata: &backuppb.Metadata{
+					FileList: files,
+				},
+			},
+		},
+	})
+
+	return nil
+}
+
+// physicalBackup streams gzipped tar of the data directory with all WAL files required to make it consistent.
+// pg_basebackup can write only the data directory to the standard output, so if there are tablespaces
+// outside of it, the backup is written to the local temporary directory first and then uploaded file by file.
+func (j *PostgreSQLBackupJob) physicalBackup(ctx context.Context, storage backupStorage, artifactFolder string) ([]*backuppb.File, error) {
+	if _, err := exec.LookPath(pgBasebackupBin); err != nil {
+		return nil, fmt.Errorf("lookpath=%s: %w", pgBasebackupBin, err)
+	}
+
+	connString, env, err := postgreSQLConnParams(j.dsn, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var tablespaces int
+	if err = queryPostgreSQL(ctx, j.dsn, countTablespacesQuery, &tablespaces); err != nil {
+		return nil, fmt.Errorf("failed to count tablespaces: %w", err)
+	}
+
+	args := []string{
+		"--dbname=" + connString,
+		"--format=tar",
+		"--gzip",
+		"--wal-method=fetch",
+		"--checkpoint=fast",
+		"--no-password",
+		"--label=" + j.name,
+	}
+
+	if tablespaces == 0 {
+		err = runToStorage(ctx, storage, path.Join(artifactFolder, pgBasebackupFile), env, pgBasebackupBin, append(args, "--pgdata=-")...)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*backuppb.File{{Name: pgBasebackupFile}}, nil
+	}
+
+	j.l.Debugf("Found %d tablespaces, backing up to the local directory.", tablespaces)
+	backupDir := filepath.Join(j.tmpDir, "basebackup")
+	if err = os.MkdirAll(j.tmpDir, 0o700); err != nil { //nolint:mnd
+		return nil, fmt.Errorf("cannot create temporary directory: %w", err)
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, pgBasebackupBin, append(args, "--pgdata="+backupDir)...) // #nosec G204
+	cmd.Env = append(cmd.Environ(), env...)
+	cmd.Stderr = &stderr
+	if err = cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s failed: %w, stderr: %s", pgBasebackupBin, err, stderr.String())
+	}
+
+	// The directory contains base.tar.gz, <tablespace OID>.tar.gz for every tablespace and the backup manifest.
+	entries, err := os.ReadDir(backupDir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]*backuppb.File, 0, len(entries))
+	for _, e := range entries {
+		if !e.Type().IsRegular() {
+			continue
+		}
+
+		if err = uploadToStorage(ctx, storage, filepath.Join(backupDir, e.Name()), path.Join(artifactFolder, e.Name())); err != nil {
+			return nil, err
+		}
+		files = append(files, &backuppb.File{Name: e.Name()})
+	}
+
+	return files, nil
+}
+
+// logicalBackup dumps every database accessible by the user into a separate file in the custom pg_dump format.
+func (j *PostgreSQLBackupJob) logicalBackup(ctx context.Context, storage backupStorage, artifactFolder string) ([]*backuppb.File, error) {
+	if _, err := exec.LookPath(pgDumpBin); err != nil {
+		return nil, fmt.Errorf("lookpath=%s: %w", pgDumpBin, err)
+	}
+
+	if _, err := exec.LookPath(pgDumpallBin); err != nil {
+		return nil, fmt.Errorf("lookpath=%s: %w", pgDumpallBin, err)
+	}
+
+	databases, err := postgreSQLDatabases(ctx, j.dsn)
+	if err != nil {
+		return nil, err
+	}
+	if len(databases) == 0 {
+		return nil, errors.New("no databases to back up")
+	}
+
+	files := make([]*backuppb.File, 0, len(databases)+1)
+
+	// Roles and tablespaces are not included into dumps of databases, but dumped objects depend on them.
+	if err = j.dumpGlobals(ctx, storage, artifactFolder); err != nil {
+		return nil, err
+	}
+	files = append(files, &backuppb.File{Name: pgGlobalsFile})
+
+	for _, database := range databases {
+		connString, env, err := postgreSQLConnParams(j.dsn, database)
+		if err != nil {
+			return nil, err
+		}
+
+		fileName := pgDumpFileName(database)
+		j.l.Debugf("Dumping database %q to %s.", database, fileName)
+		err = runToStorage(ctx, storage, path.Join(artifactFolder, fileName), env,
+			pgDumpBin,
+			"--dbname="+connString,
+			"--format=custom",
+			"--no-password",
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to dump database %q: %w", database, err)
+		}
+
+		files = append(files, &backuppb.File{Name: fileName})
+	}
+
+	return files, nil
+}
+
+// dumpGlobals dumps roles and tablespaces with pg_dumpall. Passwords of roles can be read by superusers only,
+// so they are skipped for other users.
+func (j *PostgreSQLBackupJob) dumpGlobals(ctx context.Context, storage backupStorage, artifactFolder string) error {
+	var superuser bool
+	if err := queryPostgreSQL(ctx, j.dsn, isSuperuserQuery, &superuser); err != nil {
+		return fmt.Errorf("failed to check user privileges: %w", err)
+	}
+
+	connString, env, err := postgreSQLConnParams(j.dsn, "")
+	if err != nil {
+		return err
+	}
+
+	args := []string{
+		"--dbname=" + connString,
+		"--globals-only",
+		"--no-password",
+	}
+	if !superuser {
+		j.l.Warn("Backup user is not a superuser, passwords of roles are not backed up.")
+		args = append(args, "--no-role-passwords")
+	}
+
+	j.l.Debugf("Dumping roles and tablespaces to %s.", pgGlobalsFile)
+	if err = runToStorage(ctx, storage, path.Join(artifactFolder, pgGlobalsFile), env, pgDumpallBin, args...); err != nil {
+		return fmt.Errorf("failed to dump roles and tablespaces: %w", err)
+	}
+
+	return nil
+}
+
+// queryPostgreSQL runs the query returning a single value and scans it into dest.
+func queryPostgreSQL(ctx context.Context, dsn, query string, dest any) error {
+	connector, err := pq.NewConnector(dsn)
+	if err != nil {
+		return err
+	}
+	db := sql.OpenDB(connector)
+	defer db.Close() //nolint:errcheck
+
+	return db.QueryRowContext(ctx, query).Scan(dest)
+}
+
+// postgreSQLDatabases returns names of databases included into logical backups.
+func postgreSQLDatabases(ctx context.Context, dsn string) ([]string, error) {
+	connector, err := pq.NewConnector(dsn)
+	if err != nil {
+		return nil, err
+	}
+	db := sql.OpenDB(connector)
+	defer db.Close() //nolint:errcheck
+
+	rows, err := db.QueryContext(ctx, listDatabasesQuery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list databases: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var res []string
+	for rows.Next() {
+		var name string
+		if err = rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		res = append(res, name)
+	}
+
+	return res, rows.Err()
+}
+
+// pgDumpFileName returns logical backup file name for the database.
+// Database names may contain any characters, including slashes, so they are escaped.
+func pgDumpFileName(database string) string {
+	return url.PathEscape(database) + pgDumpFileSuffix
+}
+
+// databaseFromPGDumpFileName returns database name from the logical backup file name.
+func databaseFromPGDumpFileName(fileName string) (string, error) {
+	return url.PathUnescape(strings.TrimSuffix(path.Base(fileName), pgDumpFileSuffix))
+}
+
+// postgreSQLConnParams returns connection string for PostgreSQL client tools with optionally replaced database name.
+// Password is removed from the connection string and returned as PGPASSWORD environment variable,
+// so it is not visible in the process list.
+func postgreSQLConnParams(dsn, database string) (string, []string, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to parse DSN: %w", err)
+	}
+
+	if database != "" {
+		u.Path = "/" + database
+	}
+
+	var env []string
+	if password, ok := u.User.Password(); ok {
+		env = append(env, "PGPASSWORD="+password)
+		u.User = url.User(u.User.Username())
+	}
+
+	return u.String(), env, nil
+}
+
+// runToStorage runs the command and streams its standard output to the file in the storage.
+func runToStorage(ctx context.Context, storage backupStorage, fileName string, env []string, name string, args ...string) error {
+	pipeCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(pipeCtx, name, args...) // #nosec G204
+	cmd.Env = append(cmd.Environ(), env...)
+	cmd.Stderr = &stderr
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return fmt.Errorf("failed to get %s stdout pipe: %w", name, err)
+	}
+
+	if err = cmd.Start(); err != nil {
+		return fmt.Errorf("%s start failed: %w", name, err)
+	}
+
+	putErr := storage.Put(pipeCtx, fileName, stdout)
+	if putErr != nil {
+		// Stop the command, otherwise it may block forever writing to the pipe nobody reads.
+		cancel()
+	}
+
+	if err = cmd.Wait(); err != nil {
+		return errors.Join(putErr, fmt.Errorf("%s failed: %w, stderr: %s", name, err, stderr.String()))
+	}
+
+	return putErr
+}
+
+// uploadToStorage copies the local file to the file in the storage.
+func uploadToStorage(ctx context.Context, storage backupStorage, localFile, fileName string) error {
+	f, err := os.Open(localFile) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	defer f.Close() //nolint:errcheck
+
+	return storage.Put(ctx, fileName, f)
+}
+
+// runFromStorage runs the command and streams the file from the storage to its standard input.
+func runFromStorage(ctx context.Context, storage backupStorage, fileName string, env []string, name string, args ...string) error {
+	r, err := storage.Get(ctx, fileName)
+	if err != nil {
+		return err
+	}
+	defer r.Close() //nolint:errcheck
+
+	var output bytes.Buffer
+	cmd := exec.CommandContext(ctx, name, args...) // #nosec G204
+	cmd.Env = append(cmd.Environ(), env...)
+	cmd.Stdin = r
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	if err = cmd.Run(); err != nil {
+		return fmt.Errorf("%s failed: %w, output: %s", name, err, output.String())
+	}
+
+	return nil
+}
+
+// downloadFromStorage copies the file from the storage to the local file.
+func downloadFromStorage(ctx context.Context, storage backupStorage, fileName string, w io.Writer) error {
+	r, err := storage.Get(ctx, fileName)
+	if err != nil {
+		return err
+	}
+	defer r.Close() //nolint:errcheck
+
+	if _, err = io.Copy(w, r); err != nil {
+		return fmt.Errorf("failed to download %q: %w", fileName, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,505 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package jobs
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/percona/pmm/agent/utils/templates"
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+	backuppb "github.com/percona/pmm/api/backup/v1"
+)
+
+const (
+	tarBin                    = "tar"
+	postgreSQLSystemUserName  = "postgres"
+	postgreSQLSystemGroupName = "postgres"
+
+	postgreSQLRestoreJobType = "postgresql_restore"
+
+	// pgTablespaceMapFile lists locations of tablespaces included into the base backup.
+	pgTablespaceMapFile = "tablespace_map"
+)
+
+var (
+	// postgresqlServiceRegex is used to lookup PostgreSQL service in the list of all system services:
+	// postgresql@16-main.service on Debian based systems, postgresql-16.service on RHEL based systems.
+	postgresqlServiceRegex = regexp.MustCompile(`postgresql(@[\w.-]+|-[\d.]+)\.service`)
+	// postgresqlGenericServiceRegex matches the service that controls all PostgreSQL clusters on Debian based systems.
+	postgresqlGenericServiceRegex = regexp.MustCompile(`(?m)^postgresql\.service`)
+)
+
+// PostgreSQLRestoreJob implements Job for PostgreSQL backup restore.
+type PostgreSQLRestoreJob struct {
+	id             string
+	timeout        time.Duration
+	l              logrus.FieldLogger
+	name           string
+	dsn            string
+	tmpDir         string
+	dataModel      backuppb.DataModel
+	locationConfig BackupLocationConfig
+	folder         string
+}
+
+// NewPostgreSQLRestoreJob constructs new Job for PostgreSQL backup restore.
+func NewPostgreSQLRestoreJob(
+	id string,
+	timeout time.Duration,
+	name string,
+	dsn string,
+	files *agentv1.TextFiles,
+	dataModel backuppb.DataModel,
+	locationConfig BackupLocationConfig,
+	folder string,
+	tempDir string,
+) (*PostgreSQLRestoreJob, error) {
+	if dataModel != backuppb.DataModel_DATA_MODEL_PHYSICAL && dataModel != backuppb.DataModel_DATA_MODEL_LOGICAL {
+		return nil, fmt.Errorf("'%s' is not a supported data model for PostgreSQL restores", dataModel)
+	}
+
+	tmpDir := filepath.Join(tempDir, postgreSQLRestoreJobType, strings.ReplaceAll(id, "/", "_"))
+	dsn, err := templates.RenderDSN(dsn, files, tmpDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &PostgreSQLRestoreJob{
+		id:             id,
+		timeout:        timeout,
+		l:              logrus.WithFields(logrus.Fields{"id": id, "type": postgreSQLRestoreJobType, "name": name}),
+		name:           name,
+		dsn:            dsn,
+		tmpDir:         tmpDir,
+		dataModel:      dataModel,
+		locationConfig: locationConfig,
+		folder:         folder,
+	}, nil
+}
+
+// ID returns Job id.
+func (j *PostgreSQLRestoreJob) ID() string {
+	return j.id
+}
+
+// Type returns Job type.
+func (j *PostgreSQLRestoreJob) Type() JobType {
+	return PostgreSQLRestore
+}
+
+// Timeout returns Job timeout.
+func (j *PostgreSQLRestoreJob) Timeout() time.Duration {
+	return j.timeout
+}
+
+// DSN returns DSN for the Job.
+func (j *PostgreSQLRestoreJob) DSN() string {
+	return j.dsn
+}
+
+// Run executes backup restore steps.
+func (j *PostgreSQLRestoreJob) Run(ctx context.Context, send Send) error {
+	defer templates.CleanupTempDir(j.tmpDir, logrus.WithField("component", postgreSQLRestoreJobType))
+
+	storage, err := newBackupStorage(&j.locationConfig)
+	if err != nil {
+		return err
+	}
+
+	artifactFolder := path.Join(j.folder, j.name)
+	j.l.Debugf("Artifact folder is: %s", artifactFolder)
+
+	switch j.dataModel {
+	case backuppb.DataModel_DATA_MODEL_PHYSICAL:
+		err = j.physicalRestore(ctx, storage, artifactFolder)
+	case backuppb.DataModel_DATA_MODEL_LOGICAL:
+		err = j.logicalRestore(ctx, storage, artifactFolder)
+	default:
+		err = fmt.Errorf("unknown data model: %s", j.dataModel)
+	}
+	if err != nil {
+		return err
+	}
+
+	send(&agentv1.JobResult{
+		JobId:     j.id,
+		Timestamp: timestamppb.Now(),
+		Result: &agentv1.JobResult_PostgresqlRestoreBackup{
+			PostgresqlRestoreBackup: &agentv1.JobResult_PostgreSQLRestoreBackup{},
+		},
+	})
+
+	return nil
+}
+
+// physicalRestore replaces the data directory of the local PostgreSQL server with the one from the backup.
+// The server is stopped during the restore; the previous data directory is kept next to the restored one.
+func (j *PostgreSQLRestoreJob) physicalRestore(ctx context.Context, storage backupStorage, artifactFolder string) error {
+	if _, err := exec.LookPath(tarBin); err != nil {
+		return fmt.Errorf("lookpath=%s: %w", tarBin, err)
+	}
+
+	uid, gid, err := systemUserAndGroupIDs(postgreSQLSystemUserName, postgreSQLSystemGroupName)
+	if err != nil {
+		return err
+	}
+
+	dataDirectory, err := postgreSQLDataDirectory(ctx, j.dsn)
+	if err != nil {
+		return err
+	}
+	j.l.Debugf("Using PostgreSQL data directory: %s", dataDirectory)
+
+	serviceName, err := getPostgreSQLServiceName(ctx)
+	if err != nil {
+		return err
+	}
+	j.l.Debugf("Using PostgreSQL service name: %s", serviceName)
+
+	// Download the artifact before stopping the server to keep the downtime as short as possible.
+	if err = os.MkdirAll(j.tmpDir, 0o700); err != nil { //nolint:mnd
+		return fmt.Errorf("cannot create temporary directory: %w", err)
+	}
+	archive, err := j.download(ctx, storage, path.Join(artifactFolder, pgBasebackupFile))
+	if err != nil {
+		return err
+	}
+
+	files, err := storage.List(ctx, artifactFolder+"/")
+	if err != nil {
+		return err
+	}
+
+	// Tablespace archives are named after tablespace OIDs.
+	tablespaceArchives := make(map[string]string)
+	for _, file := range files {
+		if !pgTablespaceFileRegex.MatchString(path.Base(file)) {
+			continue
+		}
+
+		local, err := j.download(ctx, storage, file)
+		if err != nil {
+			return err
+		}
+		tablespaceArchives[strings.TrimSuffix(path.Base(file), ".tar.gz")] = local
+	}
+
+	active, err := systemServiceActive(ctx, serviceName)
+	if err != nil {
+		return err
+	}
+	if active {
+		if err = stopSystemService(ctx, serviceName); err != nil {
+			return err
+		}
+	}
+
+	if err = restorePostgreSQLDirectory(ctx, archive, dataDirectory, uid, gid); err != nil {
+		return fmt.Errorf("failed to restore data directory: %w", err)
+	}
+
+	if len(tablespaceArchives) != 0 {
+		if err = restorePostgreSQLTablespaces(ctx, tablespaceArchives, dataDirectory, uid, gid); err != nil {
+			return err
+		}
+	}
+
+	return startSystemService(ctx, serviceName)
+}
+
+// download copies the file from the storage to the temporary directory and returns the local file name.
+func (j *PostgreSQLRestoreJob) download(ctx context.Context, storage backupStorage, fileName string) (string, error) {
+	f, err := os.CreateTemp(j.tmpDir, path.Base(fileName))
+	if err != nil {
+		return "", fmt.Errorf("cannot create temporary file: %w", err)
+	}
+	defer f.Close() //nolint:errcheck
+
+	if err = downloadFromStorage(ctx, storage, fileName, f); err != nil {
+		return "", err
+	}
+
+	return f.Name(), nil
+}
+
+// logicalRestore restores every database dump from the artifact into the database with the same name,
+// creating the database if it does not exist yet. Existing objects are dropped and recreated.
+func (j *PostgreSQLRestoreJob) logicalRestore(ctx context.Context, storage backupStorage, artifactFolder string) error {
+	if _, err := exec.LookPath(pgRestoreBin); err != nil {
+		return fmt.Errorf("lookpath=%s: %w", pgRestoreBin, err)
+	}
+
+	files, err := storage.List(ctx, artifactFolder+"/")
+	if err != nil {
+		return err
+	}
+
+	// Roles and tablespaces go first, as restored objects depend on them.
+	// Artifacts created by older pmm-agent versions do not contain them.
+	if slices.Contains(files, path.Join(artifactFolder, pgGlobalsFile)) {
+		if err = j.restoreGlobals(ctx, storage, path.Join(artifactFolder, pgGlobalsFile)); err != nil {
+			return err
+		}
+	}
+
+	var restored int
+	for _, file := range files {
+		if !strings.HasSuffix(file, pgDumpFileSuffix) {
+			continue
+		}
+
+		database, err := databaseFromPGDumpFileName(file)
+		if err != nil {
+			return fmt.Errorf("invalid backup file name %q: %w", file, err)
+		}
+
+		if err = createPostgreSQLDatabase(ctx, j.dsn, database); err != nil {
+			return err
+		}
+
+		connString, env, err := postgreSQLConnParams(j.dsn, database)
+		if err != nil {
+			return err
+		}
+
+		j.l.Debugf("Restoring database %q from %s.", database, file)
+		err = runFromStorage(ctx, storage, file, env,
+			pgRestoreBin,
+			"--dbname="+connString,
+			"--clean",
+			"--if-exists",
+			"--no-password",
+		)
+		if err != nil {
+			return fmt.Errorf("failed to restore database %q: %w", database, err)
+		}
+		restored++
+	}
+
+	if restored == 0 {
+		return fmt.Errorf("no database dumps found in %q", artifactFolder)
+	}
+
+	return nil
+}
+
+// restoreGlobals creates roles and tablespaces from the pg_dumpall output. Errors are not fatal,
+// as most of them are caused by already existing roles, e.g. the current user.
+func (j *PostgreSQLRestoreJob) restoreGlobals(ctx context.Context, storage backupStorage, file string) error {
+	if _, err := exec.LookPath(psqlBin); err != nil {
+		return fmt.Errorf("lookpath=%s: %w", psqlBin, err)
+	}
+
+	connString, env, err := postgreSQLConnParams(j.dsn, "")
+	if err != nil {
+		return err
+	}
+
+	j.l.Debugf("Restoring roles and tablespaces from %s.", file)
+	err = runFromStorage(ctx, storage, file, env,
+		psqlBin,
+		"--dbname="+connString,
+		"--no-password",
+		"--quiet",
+	)
+	if err != nil {
+		return fmt.Errorf("failed to restore roles and tablespaces: %w", err)
+	}
+
+	return nil
+}
+
+// postgreSQLDataDirectory returns the data directory of the PostgreSQL server.
+func postgreSQLDataDirectory(ctx context.Context, dsn string) (string, error) {
+	connector, err := pq.NewConnector(dsn)
+	if err != nil {
+		return "", err
+	}
+	db := sql.OpenDB(connector)
+	defer db.Close() //nolint:errcheck
+
+	var dataDirectory string
+	err = db.QueryRowContext(ctx, "SHOW /* pmm-agent */ data_directory").Scan(&dataDirectory)
+	if err != nil {
+		return "", fmt.Errorf("failed to get PostgreSQL data directory: %w", err)
+	}
+
+	return dataDirectory, nil
+}
+
+// createPostgreSQLDatabase creates the database if it does not exist.
+func createPostgreSQLDatabase(ctx context.Context, dsn, database string) error {
+	connector, err := pq.NewConnector(dsn)
+	if err != nil {
+		return err
+	}
+	db := sql.OpenDB(connector)
+	defer db.Close() //nolint:errcheck
+
+	var exists bool
+	err = db.QueryRowContext(ctx, "SELECT /* pmm-agent */ EXISTS(SELECT 1 FROM pg_database WHERE datname = $1)", database).Scan(&exists)
+	if err != nil {
+		return fmt.Errorf("failed to check database %q: %w", database, err)
+	}
+	if exists {
+		return nil
+	}
+
+	_, err = db.ExecContext(ctx, "CREATE DATABASE "+pq.QuoteIdentifier(database))
+	if err != nil {
+		return fmt.Errorf("failed to create database %q: %w", database, err)
+	}
+
+	return nil
+}
+
+// restorePostgreSQLDirectory extracts the archive into the data directory or the tablespace directory.
+// Current directory is renamed, so it can be used to recover if something goes wrong.
+func restorePostgreSQLDirectory(ctx context.Context, archive, directory string, uid, gid int) error {
+	exists, err := isPathExists(directory)
+	if err != nil {
+		return err
+	}
+	if exists {
+		postfix := ".old" + strconv.FormatInt(time.Now().Unix(), 10)
+		err = os.Rename(directory, directory+postfix)
+		if err != nil {
+			return err
+		}
+	}
+
+	// PostgreSQL refuses to start if the data directory or tablespaces are accessible by other users.
+	err = os.Mkdir(directory, 0o700) //nolint:mnd
+	if err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	output, err := exec.CommandContext(ctx, tarBin, "--extract", "--gzip", "--file="+archive, "--directory="+directory).CombinedOutput() //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("failed to extract backup, output=%s: %w", string(output), err)
+	}
+
+	return chownRecursive(directory, uid, gid)
+}
+
+// restorePostgreSQLTablespaces extracts tablespace archives, mapped by tablespace OIDs, into their locations
+// listed in the tablespace_map file of the restored data directory. PostgreSQL recreates symbolic links
+// to tablespaces from that file on start. Current tablespace directories are renamed like the data directory.
+func restorePostgreSQLTablespaces(ctx context.Context, archives map[string]string, dataDirectory string, uid, gid int) error {
+	b, err := os.ReadFile(filepath.Join(dataDirectory, pgTablespaceMapFile)) //nolint:gosec
+	if err != nil {
+		return fmt.Errorf("failed to read tablespace map: %w", err)
+	}
+
+	locations, err := parsePostgreSQLTablespaceMap(b)
+	if err != nil {
+		return err
+	}
+
+	for oid, archive := range archives {
+		location, ok := locations[oid]
+		if !ok {
+			return fmt.Errorf("tablespace %s is not found in the tablespace map", oid)
+		}
+
+		if err = restorePostgreSQLDirectory(ctx, archive, location, uid, gid); err != nil {
+			return fmt.Errorf("failed to restore tablespace %s: %w", oid, err)
+		}
+	}
+
+	return nil
+}
+
+// parsePostgreSQLTablespaceMap parses the tablespace_map file: every line contains tablespace OID
+// and its location separated by a space. Backslashes in locations are escaped with backslashes.
+func parsePostgreSQLTablespaceMap(b []byte) (map[string]string, error) {
+	res := make(map[string]string)
+	for line := range strings.Lines(string(b)) {
+		line = strings.TrimSuffix(line, "\n")
+		if line == "" {
+			continue
+		}
+
+		oid, location, ok := strings.Cut(line, " ")
+		if !ok || location == "" {
+			return nil, fmt.Errorf("unexpected line in the tablespace map: %q", line)
+		}
+
+		var unescaped strings.Builder
+		for i := 0; i < len(location); i++ {
+			if location[i] == '\\' && i+1 < len(location) {
+				i++
+			}
+			unescaped.WriteByte(location[i])
+		}
+		res[oid] = unescaped.String()
+	}
+
+	return res, nil
+}
+
+// getPostgreSQLServiceName returns PostgreSQL system service name.
+func getPostgreSQLServiceName(ctx context.Context) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, systemctlTimeout)
+	defer cancel()
+
+	// Unlike list-unit-files, list-units also returns instances of template units like postgresql@16-main.service.
+	cmd := exec.CommandContext(ctx, "systemctl", "list-units", "--type=service", "--all", "--plain", "--no-legend")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", fmt.Errorf("failed to list system services, output %q: %w", string(output), err)
+	}
+
+	return postgreSQLServiceName(output)
+}
+
+// postgreSQLServiceName finds PostgreSQL system service name in the systemctl output.
+func postgreSQLServiceName(output []byte) (string, error) {
+	names := make(map[string]struct{})
+	for _, name := range postgresqlServiceRegex.FindAll(output, -1) {
+		names[string(name)] = struct{}{}
+	}
+
+	switch len(names) {
+	case 0:
+		if postgresqlGenericServiceRegex.Match(output) {
+			return "postgresql.service", nil
+		}
+		return "", errors.New("postgresql service not found in the system")
+	case 1:
+		for name := range names {
+			return name, nil
+		}
+	}
+
+	return "", fmt.Errorf("several postgresql services found in the system: %s", bytes.Join(postgresqlServiceRegex.FindAll(output, -1), []byte(", ")))
+}
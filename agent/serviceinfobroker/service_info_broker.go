@@ -39,6 +39,7 @@ import (
 	"github.com/percona/pmm/agent/utils/version"
 	agentv1 "github.com/percona/pmm/api/agent/v1"
 	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
+	"github.com/percona/pmm/utils/secrets"
 )
 
 // configGetter allows to get a config.
@@ -48,15 +49,17 @@ type configGetter interface {
 
 // ServiceInfoBroker helps query various information from services.
 type ServiceInfoBroker struct {
-	l   *logrus.Entry
-	cfg configGetter
+	l       *logrus.Entry
+	cfg     configGetter
+	secrets *secrets.Resolver
 }
 
 // New creates a new ServiceInfoBroker.
 func New(cfg configGetter) *ServiceInfoBroker {
 	return &ServiceInfoBroker{
-		l:   logrus.WithField("component", "serviceinfobroker"),
-		cfg: cfg,
+		l:       logrus.WithField("component", "serviceinfobroker"),
+		cfg:     cfg,
+		secrets: secrets.NewResolver(cfg.Get().Secrets.AllowedDirs),
 	}
 }
 
@@ -69,21 +72,28 @@ func (sib *ServiceInfoBroker) GetInfoFromService(ctx context.Context, msg *agent
 		defer cancel()
 	}
 
+	secretValues, err := sib.secrets.ResolveAll(ctx, msg.Secrets)
+	if err != nil {
+		sib.l.Debugf("failed to resolve secrets: %s", err)
+		return &agentv1.ServiceInfoResponse{Error: err.Error()}
+	}
+
 	switch msg.Type {
 	case inventoryv1.ServiceType_SERVICE_TYPE_MYSQL_SERVICE:
-		return sib.getMySQLInfo(ctx, msg.Dsn, msg.TextFiles, msg.TlsSkipVerify, id)
+		return sib.getMySQLInfo(ctx, msg.Dsn, msg.TextFiles, secretValues, msg.TlsSkipVerify, id)
 	case inventoryv1.ServiceType_SERVICE_TYPE_MONGODB_SERVICE:
-		return sib.getMongoDBInfo(ctx, msg.Dsn, msg.TextFiles, id)
+		return sib.getMongoDBInfo(ctx, msg.Dsn, msg.TextFiles, secretValues, id)
 	case inventoryv1.ServiceType_SERVICE_TYPE_POSTGRESQL_SERVICE:
-		return sib.getPostgreSQLInfo(ctx, msg.Dsn, msg.TextFiles, id)
+		return sib.getPostgreSQLInfo(ctx, msg.Dsn, msg.TextFiles, secretValues, id)
 	case inventoryv1.ServiceType_SERVICE_TYPE_PROXYSQL_SERVICE:
-		return sib.getProxySQLInfo(ctx, msg.Dsn)
+		return sib.getProxySQLInfo(ctx, msg.Dsn, msg.TextFiles, secretValues, id)
 	case inventoryv1.ServiceType_SERVICE_TYPE_VALKEY_SERVICE:
 		return sib.getValkeyInfo(
 			ctx,
 			msg.Dsn,
 			msg.Tls,
 			msg.TextFiles,
+			secretValues,
 			msg.TlsSkipVerify,
 			id,
 		)
@@ -95,7 +105,7 @@ func (sib *ServiceInfoBroker) GetInfoFromService(ctx context.Context, msg *agent
 	}
 }
 
-func (sib *ServiceInfoBroker) getMySQLInfo(ctx context.Context, dsn string, files *agentv1.TextFiles, tlsSkipVerify bool, id uint32) *agentv1.ServiceInfoResponse {
+func (sib *ServiceInfoBroker) getMySQLInfo(ctx context.Context, dsn string, files *agentv1.TextFiles, secretValues map[string]string, tlsSkipVerify bool, id uint32) *agentv1.ServiceInfoResponse {
 	var res agentv1.ServiceInfoResponse
 	var err error
 
@@ -108,18 +118,18 @@ func (sib *ServiceInfoBroker) getMySQLInfo(ctx context.Context, dsn string, file
 		}
 	}
 
-	cfg, err := mysql.ParseDSN(dsn)
+	tempdir := filepath.Join(sib.cfg.Get().Paths.TempDir, strings.ToLower("get-mysql-info"), strconv.Itoa(int(id)))
+	dsn, err = templates.RenderDSNWithSecrets(dsn, files, secretValues, tempdir)
+	defer templates.CleanupTempDir(tempdir, sib.l)
 	if err != nil {
-		sib.l.Debugf("getMySQLInfo: failed to parse DSN: %s", err)
+		sib.l.Debugf("getMySQLInfo: failed to Render DSN: %s", err)
 		res.Error = err.Error()
 		return &res
 	}
 
-	tempdir := filepath.Join(sib.cfg.Get().Paths.TempDir, strings.ToLower("get-mysql-info"), strconv.Itoa(int(id)))
-	_, err = templates.RenderDSN(dsn, files, tempdir)
-	defer templates.CleanupTempDir(tempdir, sib.l)
+	cfg, err := mysql.ParseDSN(dsn)
 	if err != nil {
-		sib.l.Debugf("getMySQLInfo: failed to Render DSN: %s", err)
+		sib.l.Debugf("getMySQLInfo: failed to parse DSN: %s", err)
 		res.Error = err.Error()
 		return &res
 	}
@@ -156,12 +166,12 @@ func (sib *ServiceInfoBroker) getMySQLInfo(ctx context.Context, dsn string, file
 	return &res
 }
 
-func (sib *ServiceInfoBroker) getMongoDBInfo(ctx context.Context, dsn string, files *agentv1.TextFiles, id uint32) *agentv1.ServiceInfoResponse {
+func (sib *ServiceInfoBroker) getMongoDBInfo(ctx context.Context, dsn string, files *agentv1.TextFiles, secretValues map[string]string, id uint32) *agentv1.ServiceInfoResponse {
 	var res agentv1.ServiceInfoResponse
 	var err error
 
 	tempdir := filepath.Join(sib.cfg.Get().Paths.TempDir, strings.ToLower("get-mongodb-info"), strconv.Itoa(int(id)))
-	dsn, err = templates.RenderDSN(dsn, files, tempdir)
+	dsn, err = templates.RenderDSNWithSecrets(dsn, files, secretValues, tempdir)
 	defer templates.CleanupTempDir(tempdir, sib.l)
 	if err != nil {
 		sib.l.Debugf("getMongoDBInfo: failed to Render DSN: %s", err)
@@ -202,12 +212,12 @@ func (sib *ServiceInfoBroker) getMongoDBInfo(ctx context.Context, dsn string, fi
 	return &res
 }
 
-func (sib *ServiceInfoBroker) getPostgreSQLInfo(ctx context.Context, dsn string, files *agentv1.TextFiles, id uint32) *agentv1.ServiceInfoResponse {
+func (sib *ServiceInfoBroker) getPostgreSQLInfo(ctx context.Context, dsn string, files *agentv1.TextFiles, secretValues map[string]string, id uint32) *agentv1.ServiceInfoResponse {
 	var res agentv1.ServiceInfoResponse
 	var err error
 
 	tempdir := filepath.Join(sib.cfg.Get().Paths.TempDir, strings.ToLower("get-postgresql-info"), strconv.Itoa(int(id)))
-	dsn, err = templates.RenderDSN(dsn, files, tempdir)
+	dsn, err = templates.RenderDSNWithSecrets(dsn, files, secretValues, tempdir)
 	defer templates.CleanupTempDir(tempdir, sib.l)
 	if err != nil {
 		sib.l.Debugf("getPostgreSQLInfo: failed to Render DSN: %s", err)
@@ -265,6 +275,7 @@ func (sib *ServiceInfoBroker) getValkeyInfo(
 	dsn string,
 	useTLS bool,
 	files *agentv1.TextFiles,
+	secretValues map[string]string,
 	tlsSkipVerify bool,
 	id uint32,
 ) *agentv1.ServiceInfoResponse {
@@ -272,7 +283,7 @@ func (sib *ServiceInfoBroker) getValkeyInfo(
 	var err error
 
 	tempdir := filepath.Join(sib.cfg.Get().Paths.TempDir, "get-valkey-info", strconv.Itoa(int(id)))
-	dsn, err = templates.RenderDSN(dsn, files, tempdir)
+	dsn, err = templates.RenderDSNWithSecrets(dsn, files, secretValues, tempdir)
 	defer templates.CleanupTempDir(tempdir, sib.l)
 	if err != nil {
 		sib.l.Debugf("getValkeyInfo: failed to Render DSN: %s", err)
@@ -320,9 +331,17 @@ func extractValkeyVersion(infoString string) (string, error) {
 	return "", errors.New("failed to get Valkey version")
 }
 
-func (sib *ServiceInfoBroker) getProxySQLInfo(ctx context.Context, dsn string) *agentv1.ServiceInfoResponse {
+func (sib *ServiceInfoBroker) getProxySQLInfo(ctx context.Context, dsn string, files *agentv1.TextFiles, secretValues map[string]string, id uint32) *agentv1.ServiceInfoResponse {
 	var res agentv1.ServiceInfoResponse
 
+	tempdir := filepath.Join(sib.cfg.Get().Paths.TempDir, "get-proxysql-info", strconv.Itoa(int(id)))
+	dsn, err := templates.RenderDSNWithSecrets(dsn, files, secretValues, tempdir)
+	defer templates.CleanupTempDir(tempdir, sib.l)
+	if err != nil {
+		res.Error = err.Error()
+		return &res
+	}
+
 	cfg, err := mysql.ParseDSN(dsn)
 	if err != nil {
 		res.Error = err.Error()
@@ -0,0 +1,104 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//  http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package clientcert
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// sign issues a certificate for the given PEM-encoded certificate signing request the same way PMM Server does.
+func sign(t *testing.T, csrPEM []byte, notBefore, notAfter time.Time) []byte {
+	t.Helper()
+
+	block, _ := pem.Decode(csrPEM)
+	require.NotNil(t, block)
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	require.NoError(t, err)
+	require.NoError(t, csr.CheckSignature())
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	ca := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "pmm-agent-id"},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, csr.PublicKey, caKey)
+	require.NoError(t, err)
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
+
+func TestClientCert(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	keyPEM, csrPEM, err := NewKey()
+	require.NoError(t, err)
+	certPEM := sign(t, csrPEM, now.Add(-time.Hour), now.Add(7*24*time.Hour))
+
+	path := filepath.Join(t.TempDir(), "pmm-agent.pem")
+	require.NoError(t, Save(path, certPEM, keyPEM))
+
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), fi.Mode().Perm())
+
+	cert, err := Load(path)
+	require.NoError(t, err)
+	require.NotNil(t, cert.Leaf)
+	assert.Equal(t, "pmm-agent-id", cert.Leaf.Subject.CommonName)
+
+	t.Run("KeyMismatch", func(t *testing.T) {
+		t.Parallel()
+
+		otherKeyPEM, _, err := NewKey()
+		require.NoError(t, err)
+		err = Save(filepath.Join(t.TempDir(), "pmm-agent.pem"), certPEM, otherKeyPEM)
+		assert.ErrorContains(t, err, "private key does not match public key")
+	})
+
+	t.Run("NeedsRenewal", func(t *testing.T) {
+		t.Parallel()
+
+		assert.False(t, NeedsRenewal(cert.Leaf, now))
+		assert.False(t, NeedsRenewal(cert.Leaf, now.Add(4*24*time.Hour)))
+		assert.True(t, NeedsRenewal(cert.Leaf, now.Add(5*24*time.Hour)))
+		assert.True(t, NeedsRenewal(cert.Leaf, now.Add(8*24*time.Hour)))
+	})
+}
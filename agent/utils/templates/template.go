@@ -17,10 +17,13 @@ package templates
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"text/template"
 
 	"github.com/sirupsen/logrus"
@@ -101,8 +104,32 @@ func (tr *TemplateRenderer) RenderFiles(templateParams map[string]any) (map[stri
 	return templateParams, nil
 }
 
+// AddSecrets adds resolved secret values to template parameters.
+// .Secrets.<name> is replaced with the value as is, and .URLSecrets.<name> - with the value escaped for URL userinfo.
+func AddSecrets(templateParams map[string]any, secrets map[string]string) {
+	if len(secrets) == 0 {
+		return
+	}
+
+	urlSecrets := make(map[string]string, len(secrets))
+	for name, value := range secrets {
+		urlSecrets[name] = strings.TrimPrefix(url.UserPassword("", value).String(), ":")
+	}
+	templateParams["Secrets"] = secrets
+	templateParams["URLSecrets"] = urlSecrets
+}
+
 // RenderDSN creates temporary files and replaces placeholders with real paths.
 func RenderDSN(dsn string, files *agentv1.TextFiles, tempDir string) (string, error) {
+	return RenderDSNWithSecrets(dsn, files, nil, tempDir)
+}
+
+// RenderDSNWithSecrets creates temporary files and replaces placeholders with real paths and resolved secret values.
+func RenderDSNWithSecrets(dsn string, files *agentv1.TextFiles, secrets map[string]string, tempDir string) (string, error) {
+	if files == nil && len(secrets) != 0 {
+		return "", errors.New("template delimiters are required to render secrets")
+	}
+
 	if files != nil {
 		tr := &TemplateRenderer{
 			TextFiles:          files.Files,
@@ -111,7 +138,9 @@ func RenderDSN(dsn string, files *agentv1.TextFiles, tempDir string) (string, er
 			TempDir:            tempDir,
 		}
 
-		templateParams, err := tr.RenderFiles(make(map[string]any))
+		templateParams := make(map[string]any)
+		AddSecrets(templateParams, secrets)
+		templateParams, err := tr.RenderFiles(templateParams)
 		if err != nil {
 			return "", err
 		}
@@ -125,6 +154,36 @@ func RenderDSN(dsn string, files *agentv1.TextFiles, tempDir string) (string, er
 	return dsn, nil
 }
 
+// RenderSecrets replaces placeholders for secrets in DSN with resolved secret values,
+// and keeps placeholders for text files to be replaced later by RenderDSN.
+// URL DSNs should use .URLSecrets placeholders, so that values can't be mistaken for template delimiters then.
+func RenderSecrets(dsn string, files *agentv1.TextFiles, secrets map[string]string) (string, error) {
+	if len(secrets) == 0 {
+		return dsn, nil
+	}
+	if files == nil {
+		return "", errors.New("template delimiters are required to render secrets")
+	}
+
+	tr := &TemplateRenderer{
+		TemplateLeftDelim:  files.TemplateLeftDelim,
+		TemplateRightDelim: files.TemplateRightDelim,
+	}
+
+	textFiles := make(map[string]string, len(files.Files)) // template name => the same placeholder
+	for name := range files.Files {
+		textFiles[name] = files.TemplateLeftDelim + ".TextFiles." + name + files.TemplateRightDelim
+	}
+	templateParams := map[string]any{"TextFiles": textFiles}
+	AddSecrets(templateParams, secrets)
+
+	b, err := tr.RenderTemplate("dsn", dsn, templateParams)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
 // CleanupTempDir removes the temporary directory.
 func CleanupTempDir(tempDir string, logger *logrus.Entry) {
 	err := os.RemoveAll(tempDir)
@@ -32,15 +32,21 @@ const (
 	qpressBin           = "qpress"
 	mongodbBin          = "mongod"
 	pbmBin              = "pbm"
+	pgBasebackupBin     = "pg_basebackup"
+	pgDumpBin           = "pg_dump"
+	pgRestoreBin        = "pg_restore"
 )
 
 var (
-	mysqldVersionRegexp     = regexp.MustCompile("^.*Ver ([!-~]*).*")
-	xtrabackupVersionRegexp = regexp.MustCompile("xtrabackup version ([!-~]*).*")
-	xbcloudVersionRegexp    = regexp.MustCompile("^xbcloud[ ][ ]Ver ([!-~]*).*")
-	qpressVersionRegexp     = regexp.MustCompile("^qpress[ ]([!-~]*).*")
-	mongodbVersionRegexp    = regexp.MustCompile("^db version v([!-~]*).*")
-	pbmVersionRegexp        = regexp.MustCompile("^Version:[ ]*([!-~]*).*")
+	mysqldVersionRegexp       = regexp.MustCompile("^.*Ver ([!-~]*).*")
+	xtrabackupVersionRegexp   = regexp.MustCompile("xtrabackup version ([!-~]*).*")
+	xbcloudVersionRegexp      = regexp.MustCompile("^xbcloud[ ][ ]Ver ([!-~]*).*")
+	qpressVersionRegexp       = regexp.MustCompile("^qpress[ ]([!-~]*).*")
+	mongodbVersionRegexp      = regexp.MustCompile("^db version v([!-~]*).*")
+	pbmVersionRegexp          = regexp.MustCompile("^Version:[ ]*([!-~]*).*")
+	pgBasebackupVersionRegexp = regexp.MustCompile(`^pg_basebackup \(PostgreSQL\) ([!-~]*).*`)
+	pgDumpVersionRegexp       = regexp.MustCompile(`^pg_dump \(PostgreSQL\) ([!-~]*).*`)
+	pgRestoreVersionRegexp    = regexp.MustCompile(`^pg_restore \(PostgreSQL\) ([!-~]*).*`)
 
 	// ErrNotFound is used for indicating that binary is not found.
 	ErrNotFound = errors.New("not found")
@@ -149,6 +155,21 @@ func (v *Versioner) PBMVersion() (string, error) {
 	return v.binaryVersion(pbmBin, 0, pbmVersionRegexp, "version")
 }
 
+// PGBasebackupVersion retrieves pg_basebackup binary version.
+func (v *Versioner) PGBasebackupVersion() (string, error) {
+	return v.binaryVersion(pgBasebackupBin, 0, pgBasebackupVersionRegexp, "--version")
+}
+
+// PGDumpVersion retrieves pg_dump binary version.
+func (v *Versioner) PGDumpVersion() (string, error) {
+	return v.binaryVersion(pgDumpBin, 0, pgDumpVersionRegexp, "--version")
+}
+
+// PGRestoreVersion retrieves pg_restore binary version.
+func (v *Versioner) PGRestoreVersion() (string, error) {
+	return v.binaryVersion(pgRestoreBin, 0, pgRestoreVersionRegexp, "--version")
+}
+
 // BinaryVersion retrieves agent binary version.
 func (v *Versioner) BinaryVersion(
 	binaryName string,
@@ -133,5 +133,39 @@ GitCommit: 3ec38a5fc6706515fb1be72b015972af1500aa17
 		assert.Equal(t, "2.0.2", version)
 	})
 
+	// postgresql software
+	t.Run("pg_basebackup", func(t *testing.T) {
+		pgBasebackupVersionOutput := []byte(`pg_basebackup (PostgreSQL) 16.4 (Ubuntu 16.4-1.pgdg22.04+1)
+`)
+		execMock.On("LookPath", pgBasebackupBin).Return("", nil).Once()
+		execMock.On("CommandContext", mock.Anything, pgBasebackupBin, "--version").
+			Return(&mockedExec{Output: pgBasebackupVersionOutput}).Once()
+		version, err := versioner.PGBasebackupVersion()
+		require.NoError(t, err)
+		assert.Equal(t, "16.4", version)
+	})
+
+	t.Run("pg_dump", func(t *testing.T) {
+		pgDumpVersionOutput := []byte(`pg_dump (PostgreSQL) 17.0
+`)
+		execMock.On("LookPath", pgDumpBin).Return("", nil).Once()
+		execMock.On("CommandContext", mock.Anything, pgDumpBin, "--version").
+			Return(&mockedExec{Output: pgDumpVersionOutput}).Once()
+		version, err := versioner.PGDumpVersion()
+		require.NoError(t, err)
+		assert.Equal(t, "17.0", version)
+	})
+
+	t.Run("pg_restore", func(t *testing.T) {
+		pgRestoreVersionOutput := []byte(`pg_restore (PostgreSQL) 17.0
+`)
+		execMock.On("LookPath", pgRestoreBin).Return("", nil).Once()
+		execMock.On("CommandContext", mock.Anything, pgRestoreBin, "--version").
+			Return(&mockedExec{Output: pgRestoreVersionOutput}).Once()
+		version, err := versioner.PGRestoreVersion()
+		require.NoError(t, err)
+		assert.Equal(t, "17.0", version)
+	})
+
 	mock.AssertExpectationsForObjects(t, execMock)
 }
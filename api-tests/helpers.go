@@ -329,6 +329,21 @@ func AddAgent(t *testing.T, body agents.AddAgentBody) *agents.AddAgentOKBody {
 	case body.RtaMongodbAgent != nil:
 		require.NotNil(t, res.Payload.RtaMongodbAgent)
 		agentID = res.Payload.RtaMongodbAgent.AgentID
+	case body.RtaMysqlAgent != nil:
+		require.NotNil(t, res.Payload.RtaMysqlAgent)
+		agentID = res.Payload.RtaMysqlAgent.AgentID
+	case body.RtaPostgresqlAgent != nil:
+		require.NotNil(t, res.Payload.RtaPostgresqlAgent)
+		agentID = res.Payload.RtaPostgresqlAgent.AgentID
+	case body.QANValkeySlowlogAgent != nil:
+		require.NotNil(t, res.Payload.QANValkeySlowlogAgent)
+		agentID = res.Payload.QANValkeySlowlogAgent.AgentID
+	case body.QANPostgresqlLogAgent != nil:
+		require.NotNil(t, res.Payload.QANPostgresqlLogAgent)
+		agentID = res.Payload.QANPostgresqlLogAgent.AgentID
+	case body.QANProxysqlDigestAgent != nil:
+		require.NotNil(t, res.Payload.QANProxysqlDigestAgent)
+		agentID = res.Payload.QANProxysqlDigestAgent.AgentID
 	case body.QANMongodbMongologAgent != nil:
 		require.NotNil(t, res.Payload.QANMongodbMongologAgent)
 		agentID = res.Payload.QANMongodbMongologAgent.AgentID
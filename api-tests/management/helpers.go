@@ -155,7 +155,12 @@ func removeAllAgentsInList(t *testing.T, listAgentsOK *agents.ListAgentsOK) {
 			len(listAgentsOK.Payload.QANPostgresqlPgstatementsAgent)+
 			len(listAgentsOK.Payload.ExternalExporter)+
 			len(listAgentsOK.Payload.VMAgent)+
-			len(listAgentsOK.Payload.RtaMongodbAgent))
+			len(listAgentsOK.Payload.RtaMongodbAgent)+
+			len(listAgentsOK.Payload.RtaMysqlAgent)+
+			len(listAgentsOK.Payload.RtaPostgresqlAgent)+
+			len(listAgentsOK.Payload.QANValkeySlowlogAgent)+
+			len(listAgentsOK.Payload.QANProxysqlDigestAgent)+
+			len(listAgentsOK.Payload.QANPostgresqlLogAgent))
 	for _, agent := range listAgentsOK.Payload.NodeExporter {
 		agentIDs = append(agentIDs, agent.AgentID)
 	}
@@ -198,6 +203,21 @@ func removeAllAgentsInList(t *testing.T, listAgentsOK *agents.ListAgentsOK) {
 	for _, agent := range listAgentsOK.Payload.RtaMongodbAgent {
 		agentIDs = append(agentIDs, agent.AgentID)
 	}
+	for _, agent := range listAgentsOK.Payload.RtaMysqlAgent {
+		agentIDs = append(agentIDs, agent.AgentID)
+	}
+	for _, agent := range listAgentsOK.Payload.RtaPostgresqlAgent {
+		agentIDs = append(agentIDs, agent.AgentID)
+	}
+	for _, agent := range listAgentsOK.Payload.QANValkeySlowlogAgent {
+		agentIDs = append(agentIDs, agent.AgentID)
+	}
+	for _, agent := range listAgentsOK.Payload.QANProxysqlDigestAgent {
+		agentIDs = append(agentIDs, agent.AgentID)
+	}
+	for _, agent := range listAgentsOK.Payload.QANPostgresqlLogAgent {
+		agentIDs = append(agentIDs, agent.AgentID)
+	}
 
 	pmmapitests.RemoveAgents(t, agentIDs...)
 }
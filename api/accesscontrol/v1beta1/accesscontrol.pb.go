@@ -16,6 +16,8 @@ import (
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+
+	common "github.com/percona/pmm/api/common"
 )
 
 const (
@@ -26,10 +28,13 @@ const (
 )
 
 type CreateRoleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Title         string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Filter        string                 `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Title       string                 `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Filter      string                 `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	Description string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Permission scopes granted by the role in addition to the Grafana role of the user, such as backups:restore.
+	// They are limited to services matching the filter.
+	Permissions   []string `protobuf:"bytes,4,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -85,6 +90,13 @@ func (x *CreateRoleRequest) GetDescription() string {
 	return ""
 }
 
+func (x *CreateRoleRequest) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
 type CreateRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoleId        uint32                 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
@@ -130,11 +142,13 @@ func (x *CreateRoleResponse) GetRoleId() uint32 {
 }
 
 type UpdateRoleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RoleId        uint32                 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
-	Title         *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
-	Filter        *string                `protobuf:"bytes,3,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
-	Description   *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	RoleId      uint32                 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
+	Title       *string                `protobuf:"bytes,2,opt,name=title,proto3,oneof" json:"title,omitempty"`
+	Filter      *string                `protobuf:"bytes,3,opt,name=filter,proto3,oneof" json:"filter,omitempty"`
+	Description *string                `protobuf:"bytes,4,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	// Permission scopes granted by the role; replaces existing ones.
+	Permissions   *common.StringArray `protobuf:"bytes,5,opt,name=permissions,proto3,oneof" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -197,6 +211,13 @@ func (x *UpdateRoleRequest) GetDescription() string {
 	return ""
 }
 
+func (x *UpdateRoleRequest) GetPermissions() *common.StringArray {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
 type UpdateRoleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -372,6 +393,7 @@ type GetRoleResponse struct {
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Filter        string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions   []string               `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -434,6 +456,13 @@ func (x *GetRoleResponse) GetDescription() string {
 	return ""
 }
 
+func (x *GetRoleResponse) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
 type SetDefaultRoleRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoleId        uint32                 `protobuf:"varint,1,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`
@@ -688,6 +717,7 @@ type ListRolesResponse_RoleData struct {
 	Title         string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
 	Filter        string                 `protobuf:"bytes,3,opt,name=filter,proto3" json:"filter,omitempty"`
 	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions   []string               `protobuf:"bytes,5,rep,name=permissions,proto3" json:"permissions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -750,37 +780,48 @@ func (x *ListRolesResponse_RoleData) GetDescription() string {
 	return ""
 }
 
+func (x *ListRolesResponse_RoleData) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
 var File_accesscontrol_v1beta1_accesscontrol_proto protoreflect.FileDescriptor
 
 const file_accesscontrol_v1beta1_accesscontrol_proto_rawDesc = "" +
 	"\n" +
-	")accesscontrol/v1beta1/accesscontrol.proto\x12\x15accesscontrol.v1beta1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x17validate/validate.proto\"l\n" +
+	")accesscontrol/v1beta1/accesscontrol.proto\x12\x15accesscontrol.v1beta1\x1a\x13common/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x17validate/validate.proto\"\x8e\x01\n" +
 	"\x11CreateRoleRequest\x12\x1d\n" +
 	"\x05title\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x05title\x12\x16\n" +
 	"\x06filter\x18\x02 \x01(\tR\x06filter\x12 \n" +
-	"\vdescription\x18\x03 \x01(\tR\vdescription\"-\n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x04 \x03(\tR\vpermissions\"-\n" +
 	"\x12CreateRoleResponse\x12\x17\n" +
-	"\arole_id\x18\x01 \x01(\rR\x06roleId\"\xc2\x01\n" +
+	"\arole_id\x18\x01 \x01(\rR\x06roleId\"\x8e\x02\n" +
 	"\x11UpdateRoleRequest\x12 \n" +
 	"\arole_id\x18\x01 \x01(\rB\a\xfaB\x04*\x02 \x00R\x06roleId\x12\"\n" +
 	"\x05title\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01H\x00R\x05title\x88\x01\x01\x12\x1b\n" +
 	"\x06filter\x18\x03 \x01(\tH\x01R\x06filter\x88\x01\x01\x12%\n" +
-	"\vdescription\x18\x04 \x01(\tH\x02R\vdescription\x88\x01\x01B\b\n" +
+	"\vdescription\x18\x04 \x01(\tH\x02R\vdescription\x88\x01\x01\x12:\n" +
+	"\vpermissions\x18\x05 \x01(\v2\x13.common.StringArrayH\x03R\vpermissions\x88\x01\x01B\b\n" +
 	"\x06_titleB\t\n" +
 	"\a_filterB\x0e\n" +
-	"\f_description\"\x14\n" +
+	"\f_descriptionB\x0e\n" +
+	"\f_permissions\"\x14\n" +
 	"\x12UpdateRoleResponse\"e\n" +
 	"\x11DeleteRoleRequest\x12 \n" +
 	"\arole_id\x18\x01 \x01(\rB\a\xfaB\x04*\x02 \x00R\x06roleId\x12.\n" +
 	"\x13replacement_role_id\x18\x02 \x01(\rR\x11replacementRoleId\"\x14\n" +
 	"\x12DeleteRoleResponse\"2\n" +
 	"\x0eGetRoleRequest\x12 \n" +
-	"\arole_id\x18\x01 \x01(\rB\a\xfaB\x04*\x02 \x00R\x06roleId\"z\n" +
+	"\arole_id\x18\x01 \x01(\rB\a\xfaB\x04*\x02 \x00R\x06roleId\"\x9c\x01\n" +
 	"\x0fGetRoleResponse\x12\x17\n" +
 	"\arole_id\x18\x01 \x01(\rR\x06roleId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
 	"\x06filter\x18\x03 \x01(\tR\x06filter\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription\"9\n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x05 \x03(\tR\vpermissions\"9\n" +
 	"\x15SetDefaultRoleRequest\x12 \n" +
 	"\arole_id\x18\x01 \x01(\rB\a\xfaB\x04*\x02 \x00R\x06roleId\"\x18\n" +
 	"\x16SetDefaultRoleResponse\"Q\n" +
@@ -788,14 +829,15 @@ const file_accesscontrol_v1beta1_accesscontrol_proto_rawDesc = "" +
 	"\brole_ids\x18\x01 \x03(\rR\aroleIds\x12 \n" +
 	"\auser_id\x18\x02 \x01(\rB\a\xfaB\x04*\x02 \x00R\x06userId\"\x15\n" +
 	"\x13AssignRolesResponse\"\x12\n" +
-	"\x10ListRolesRequest\"\xd1\x01\n" +
+	"\x10ListRolesRequest\"\xf4\x01\n" +
 	"\x11ListRolesResponse\x12G\n" +
-	"\x05roles\x18\x01 \x03(\v21.accesscontrol.v1beta1.ListRolesResponse.RoleDataR\x05roles\x1as\n" +
+	"\x05roles\x18\x01 \x03(\v21.accesscontrol.v1beta1.ListRolesResponse.RoleDataR\x05roles\x1a\x95\x01\n" +
 	"\bRoleData\x12\x17\n" +
 	"\arole_id\x18\x01 \x01(\rR\x06roleId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12\x16\n" +
 	"\x06filter\x18\x03 \x01(\tR\x06filter\x12 \n" +
-	"\vdescription\x18\x04 \x01(\tR\vdescription2\xc1\n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12 \n" +
+	"\vpermissions\x18\x05 \x03(\tR\vpermissions2\xc1\n" +
 	"\n" +
 	"\x14AccessControlService\x12\xac\x01\n" +
 	"\n" +
@@ -842,30 +884,32 @@ var (
 		(*ListRolesRequest)(nil),           // 12: accesscontrol.v1beta1.ListRolesRequest
 		(*ListRolesResponse)(nil),          // 13: accesscontrol.v1beta1.ListRolesResponse
 		(*ListRolesResponse_RoleData)(nil), // 14: accesscontrol.v1beta1.ListRolesResponse.RoleData
+		(*common.StringArray)(nil),         // 15: common.StringArray
 	}
 )
 
 var file_accesscontrol_v1beta1_accesscontrol_proto_depIdxs = []int32{
-	14, // 0: accesscontrol.v1beta1.ListRolesResponse.roles:type_name -> accesscontrol.v1beta1.ListRolesResponse.RoleData
-	0,  // 1: accesscontrol.v1beta1.AccessControlService.CreateRole:input_type -> accesscontrol.v1beta1.CreateRoleRequest
-	2,  // 2: accesscontrol.v1beta1.AccessControlService.UpdateRole:input_type -> accesscontrol.v1beta1.UpdateRoleRequest
-	4,  // 3: accesscontrol.v1beta1.AccessControlService.DeleteRole:input_type -> accesscontrol.v1beta1.DeleteRoleRequest
-	6,  // 4: accesscontrol.v1beta1.AccessControlService.GetRole:input_type -> accesscontrol.v1beta1.GetRoleRequest
-	12, // 5: accesscontrol.v1beta1.AccessControlService.ListRoles:input_type -> accesscontrol.v1beta1.ListRolesRequest
-	10, // 6: accesscontrol.v1beta1.AccessControlService.AssignRoles:input_type -> accesscontrol.v1beta1.AssignRolesRequest
-	8,  // 7: accesscontrol.v1beta1.AccessControlService.SetDefaultRole:input_type -> accesscontrol.v1beta1.SetDefaultRoleRequest
-	1,  // 8: accesscontrol.v1beta1.AccessControlService.CreateRole:output_type -> accesscontrol.v1beta1.CreateRoleResponse
-	3,  // 9: accesscontrol.v1beta1.AccessControlService.UpdateRole:output_type -> accesscontrol.v1beta1.UpdateRoleResponse
-	5,  // 10: accesscontrol.v1beta1.AccessControlService.DeleteRole:output_type -> accesscontrol.v1beta1.DeleteRoleResponse
-	7,  // 11: accesscontrol.v1beta1.AccessControlService.GetRole:output_type -> accesscontrol.v1beta1.GetRoleResponse
-	13, // 12: accesscontrol.v1beta1.AccessControlService.ListRoles:output_type -> accesscontrol.v1beta1.ListRolesResponse
-	11, // 13: accesscontrol.v1beta1.AccessControlService.AssignRoles:output_type -> accesscontrol.v1beta1.AssignRolesResponse
-	9,  // 14: accesscontrol.v1beta1.AccessControlService.SetDefaultRole:output_type -> accesscontrol.v1beta1.SetDefaultRoleResponse
-	8,  // [8:15] is the sub-list for method output_type
-	1,  // [1:8] is the sub-list for method input_type
-	1,  // [1:1] is the sub-list for extension type_name
-	1,  // [1:1] is the sub-list for extension extendee
-	0,  // [0:1] is the sub-list for field type_name
+	15, // 0: accesscontrol.v1beta1.UpdateRoleRequest.permissions:type_name -> common.StringArray
+	14, // 1: accesscontrol.v1beta1.ListRolesResponse.roles:type_name -> accesscontrol.v1beta1.ListRolesResponse.RoleData
+	0,  // 2: accesscontrol.v1beta1.AccessControlService.CreateRole:input_type -> accesscontrol.v1beta1.CreateRoleRequest
+	2,  // 3: accesscontrol.v1beta1.AccessControlService.UpdateRole:input_type -> accesscontrol.v1beta1.UpdateRoleRequest
+	4,  // 4: accesscontrol.v1beta1.AccessControlService.DeleteRole:input_type -> accesscontrol.v1beta1.DeleteRoleRequest
+	6,  // 5: accesscontrol.v1beta1.AccessControlService.GetRole:input_type -> accesscontrol.v1beta1.GetRoleRequest
+	12, // 6: accesscontrol.v1beta1.AccessControlService.ListRoles:input_type -> accesscontrol.v1beta1.ListRolesRequest
+	10, // 7: accesscontrol.v1beta1.AccessControlService.AssignRoles:input_type -> accesscontrol.v1beta1.AssignRolesRequest
+	8,  // 8: accesscontrol.v1beta1.AccessControlService.SetDefaultRole:input_type -> accesscontrol.v1beta1.SetDefaultRoleRequest
+	1,  // 9: accesscontrol.v1beta1.AccessControlService.CreateRole:output_type -> accesscontrol.v1beta1.CreateRoleResponse
+	3,  // 10: accesscontrol.v1beta1.AccessControlService.UpdateRole:output_type -> accesscontrol.v1beta1.UpdateRoleResponse
+	5,  // 11: accesscontrol.v1beta1.AccessControlService.DeleteRole:output_type -> accesscontrol.v1beta1.DeleteRoleResponse
+	7,  // 12: accesscontrol.v1beta1.AccessControlService.GetRole:output_type -> accesscontrol.v1beta1.GetRoleResponse
+	13, // 13: accesscontrol.v1beta1.AccessControlService.ListRoles:output_type -> accesscontrol.v1beta1.ListRolesResponse
+	11, // 14: accesscontrol.v1beta1.AccessControlService.AssignRoles:output_type -> accesscontrol.v1beta1.AssignRolesResponse
+	9,  // 15: accesscontrol.v1beta1.AccessControlService.SetDefaultRole:output_type -> accesscontrol.v1beta1.SetDefaultRoleResponse
+	9,  // [9:16] is the sub-list for method output_type
+	2,  // [2:9] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_accesscontrol_v1beta1_accesscontrol_proto_init() }
@@ -197,6 +197,10 @@ type CreateRoleBody struct {
 
 	// description
 	Description string `json:"description,omitempty"`
+
+	// Permission scopes granted by the role in addition to the Grafana role of the user, such as backups:restore.
+	// They are limited to services matching the filter.
+	Permissions []string `json:"permissions"`
 }
 
 // Validate validates this create role body
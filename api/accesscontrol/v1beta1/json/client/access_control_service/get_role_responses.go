@@ -428,6 +428,9 @@ type GetRoleOKBody struct {
 
 	// description
 	Description string `json:"description,omitempty"`
+
+	// permissions
+	Permissions []string `json:"permissions"`
 }
 
 // Validate validates this get role OK body
@@ -539,6 +539,9 @@ type ListRolesOKBodyRolesItems0 struct {
 
 	// description
 	Description string `json:"description,omitempty"`
+
+	// permissions
+	Permissions []string `json:"permissions"`
 }
 
 // Validate validates this list roles OK body roles items0
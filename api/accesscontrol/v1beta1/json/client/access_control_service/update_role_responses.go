@@ -195,15 +195,83 @@ type UpdateRoleBody struct {
 
 	// description
 	Description *string `json:"description,omitempty"`
+
+	// permissions
+	Permissions *UpdateRoleParamsBodyPermissions `json:"permissions,omitempty"`
 }
 
 // Validate validates this update role body
 func (o *UpdateRoleBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validatePermissions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
 	return nil
 }
 
-// ContextValidate validates this update role body based on context it is used
+func (o *UpdateRoleBody) validatePermissions(formats strfmt.Registry) error {
+	if swag.IsZero(o.Permissions) { // not required
+		return nil
+	}
+
+	if o.Permissions != nil {
+		if err := o.Permissions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "permissions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "permissions")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this update role body based on the context it is used
 func (o *UpdateRoleBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidatePermissions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *UpdateRoleBody) contextValidatePermissions(ctx context.Context, formats strfmt.Registry) error {
+	if o.Permissions != nil {
+
+		if swag.IsZero(o.Permissions) { // not required
+			return nil
+		}
+
+		if err := o.Permissions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "permissions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "permissions")
+			}
+
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -452,3 +520,40 @@ func (o *UpdateRoleDefaultBodyDetailsItems0) UnmarshalBinary(b []byte) error {
 	*o = res
 	return nil
 }
+
+/*
+UpdateRoleParamsBodyPermissions A wrapper for a string array. This type allows to distinguish between an empty array and a null value.
+swagger:model UpdateRoleParamsBodyPermissions
+*/
+type UpdateRoleParamsBodyPermissions struct {
+	// values
+	Values []string `json:"values"`
+}
+
+// Validate validates this update role params body permissions
+func (o *UpdateRoleParamsBodyPermissions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this update role params body permissions based on context it is used
+func (o *UpdateRoleParamsBodyPermissions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *UpdateRoleParamsBodyPermissions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *UpdateRoleParamsBodyPermissions) UnmarshalBinary(b []byte) error {
+	var res UpdateRoleParamsBodyPermissions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -86,6 +86,11 @@ func (m *JobResult) AgentMessageRequestPayload() isAgentMessage_Payload { //noli
 	return &AgentMessage_JobResult{JobResult: m}
 }
 
+// AgentMessageRequestPayload returns the payload for the AgentMessageRequest.
+func (m *RenewCertificateRequest) AgentMessageRequestPayload() isAgentMessage_Payload { //nolint:ireturn
+	return &AgentMessage_RenewCertificate{RenewCertificate: m}
+}
+
 // A list of AgentMessage response payloads.
 
 // AgentMessageResponsePayload returns the payload for the AgentMessageResponse.
@@ -180,6 +185,11 @@ func (m *ActionResultResponse) ServerMessageResponsePayload() isServerMessage_Pa
 	return &ServerMessage_ActionResult{ActionResult: m}
 }
 
+// ServerMessageResponsePayload returns the payload for the ServerMessageResponse.
+func (m *RenewCertificateResponse) ServerMessageResponsePayload() isServerMessage_Payload { //nolint:ireturn
+	return &ServerMessage_RenewCertificate{RenewCertificate: m}
+}
+
 // A list of ServerMessage request payloads.
 
 // ServerMessageRequestPayload returns the payload for the ServerMessageRequestPayload.
@@ -243,38 +253,40 @@ func (m *ServiceInfoRequest) ServerMessageRequestPayload() isServerMessage_Paylo
 }
 
 // in alphabetical order.
-func (*ActionResultRequest) sealed()     {}
-func (*ActionResultResponse) sealed()    {}
-func (*AgentLogsRequest) sealed()        {}
-func (*AgentLogsResponse) sealed()       {}
-func (*CheckConnectionRequest) sealed()  {}
-func (*CheckConnectionResponse) sealed() {}
-func (*GetVersionsRequest) sealed()      {}
-func (*GetVersionsResponse) sealed()     {}
-func (*JobProgress) sealed()             {}
-func (*JobResult) sealed()               {}
-func (*JobStatusRequest) sealed()        {}
-func (*JobStatusResponse) sealed()       {}
-func (*PBMSwitchPITRRequest) sealed()    {}
-func (*PBMSwitchPITRResponse) sealed()   {}
-func (*Ping) sealed()                    {}
-func (*Pong) sealed()                    {}
-func (*QANCollectRequest) sealed()       {}
-func (*QANCollectResponse) sealed()      {}
-func (*ServiceInfoRequest) sealed()      {}
-func (*ServiceInfoResponse) sealed()     {}
-func (*SetStateRequest) sealed()         {}
-func (*SetStateResponse) sealed()        {}
-func (*StartActionRequest) sealed()      {}
-func (*StartActionResponse) sealed()     {}
-func (*StartJobRequest) sealed()         {}
-func (*StartJobResponse) sealed()        {}
-func (*StateChangedRequest) sealed()     {}
-func (*StateChangedResponse) sealed()    {}
-func (*StopActionRequest) sealed()       {}
-func (*StopActionResponse) sealed()      {}
-func (*StopJobRequest) sealed()          {}
-func (*StopJobResponse) sealed()         {}
+func (*ActionResultRequest) sealed()      {}
+func (*ActionResultResponse) sealed()     {}
+func (*AgentLogsRequest) sealed()         {}
+func (*AgentLogsResponse) sealed()        {}
+func (*CheckConnectionRequest) sealed()   {}
+func (*CheckConnectionResponse) sealed()  {}
+func (*GetVersionsRequest) sealed()       {}
+func (*GetVersionsResponse) sealed()      {}
+func (*JobProgress) sealed()              {}
+func (*JobResult) sealed()                {}
+func (*JobStatusRequest) sealed()         {}
+func (*JobStatusResponse) sealed()        {}
+func (*PBMSwitchPITRRequest) sealed()     {}
+func (*PBMSwitchPITRResponse) sealed()    {}
+func (*Ping) sealed()                     {}
+func (*Pong) sealed()                     {}
+func (*QANCollectRequest) sealed()        {}
+func (*QANCollectResponse) sealed()       {}
+func (*RenewCertificateRequest) sealed()  {}
+func (*RenewCertificateResponse) sealed() {}
+func (*ServiceInfoRequest) sealed()       {}
+func (*ServiceInfoResponse) sealed()      {}
+func (*SetStateRequest) sealed()          {}
+func (*SetStateResponse) sealed()         {}
+func (*StartActionRequest) sealed()       {}
+func (*StartActionResponse) sealed()      {}
+func (*StartJobRequest) sealed()          {}
+func (*StartJobResponse) sealed()         {}
+func (*StateChangedRequest) sealed()      {}
+func (*StateChangedResponse) sealed()     {}
+func (*StopActionRequest) sealed()        {}
+func (*StopActionResponse) sealed()       {}
+func (*StopJobRequest) sealed()           {}
+func (*StopJobResponse) sealed()          {}
 
 // check interfaces.
 var (
@@ -283,6 +295,7 @@ var (
 	_ AgentRequestPayload = (*StateChangedRequest)(nil)
 	_ AgentRequestPayload = (*QANCollectRequest)(nil)
 	_ AgentRequestPayload = (*ActionResultRequest)(nil)
+	_ AgentRequestPayload = (*RenewCertificateRequest)(nil)
 
 	// A list of AgentMessage response payloads.
 	_ AgentResponsePayload = (*Pong)(nil)
@@ -304,6 +317,7 @@ var (
 	_ ServerResponsePayload = (*StateChangedResponse)(nil)
 	_ ServerResponsePayload = (*QANCollectResponse)(nil)
 	_ ServerResponsePayload = (*ActionResultResponse)(nil)
+	_ ServerResponsePayload = (*RenewCertificateResponse)(nil)
 
 	// A list of ServerMessage request payloads.
 	_ ServerRequestPayload = (*Ping)(nil)
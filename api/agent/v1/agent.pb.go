@@ -132,7 +132,7 @@ func (x StartActionRequest_RestartSystemServiceParams_SystemService) Number() pr
 
 // Deprecated: Use StartActionRequest_RestartSystemServiceParams_SystemService.Descriptor instead.
 func (StartActionRequest_RestartSystemServiceParams_SystemService) EnumDescriptor() ([]byte, []int) {
-	return file_agent_v1_agent_proto_rawDescGZIP(), []int{14, 20, 0}
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{14, 23, 0}
 }
 
 // TextFiles contains files which can be used to connect to DB (certificates, keys and etc).
@@ -991,6 +991,10 @@ type StartActionRequest struct {
 	ActionId string                 `protobuf:"bytes,1,opt,name=action_id,json=actionId,proto3" json:"action_id,omitempty"`
 	// Timeout for the whole action. If zero or absent, pmm-agent will pick one itself.
 	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Secret references by name to be resolved by pmm-agent (e.g. vault://kv/mysql/prod#password).
+	// Resolved values replace .Secrets.<name> and .URLSecrets.<name> placeholders in DSN,
+	// and passwords equal to the reference.
+	Secrets map[string]string `protobuf:"bytes,3,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Types that are valid to be assigned to Params:
 	//
 	//	*StartActionRequest_MysqlExplainParams
@@ -1013,6 +1017,9 @@ type StartActionRequest struct {
 	//	*StartActionRequest_MongodbQueryGetcmdlineoptsParams
 	//	*StartActionRequest_MongodbQueryReplsetgetstatusParams
 	//	*StartActionRequest_MongodbQueryGetdiagnosticdataParams
+	//	*StartActionRequest_MongodbKillOpParams
+	//	*StartActionRequest_MysqlKillQueryParams
+	//	*StartActionRequest_PostgresqlCancelBackendParams
 	//	*StartActionRequest_RestartSysServiceParams
 	Params        isStartActionRequest_Params `protobuf_oneof:"params"`
 	unknownFields protoimpl.UnknownFields
@@ -1063,6 +1070,13 @@ func (x *StartActionRequest) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *StartActionRequest) GetSecrets() map[string]string {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
 func (x *StartActionRequest) GetParams() isStartActionRequest_Params {
 	if x != nil {
 		return x.Params
@@ -1250,6 +1264,33 @@ func (x *StartActionRequest) GetMongodbQueryGetdiagnosticdataParams() *StartActi
 	return nil
 }
 
+func (x *StartActionRequest) GetMongodbKillOpParams() *StartActionRequest_MongoDBKillOpParams {
+	if x != nil {
+		if x, ok := x.Params.(*StartActionRequest_MongodbKillOpParams); ok {
+			return x.MongodbKillOpParams
+		}
+	}
+	return nil
+}
+
+func (x *StartActionRequest) GetMysqlKillQueryParams() *StartActionRequest_MySQLKillQueryParams {
+	if x != nil {
+		if x, ok := x.Params.(*StartActionRequest_MysqlKillQueryParams); ok {
+			return x.MysqlKillQueryParams
+		}
+	}
+	return nil
+}
+
+func (x *StartActionRequest) GetPostgresqlCancelBackendParams() *StartActionRequest_PostgreSQLCancelBackendParams {
+	if x != nil {
+		if x, ok := x.Params.(*StartActionRequest_PostgresqlCancelBackendParams); ok {
+			return x.PostgresqlCancelBackendParams
+		}
+	}
+	return nil
+}
+
 func (x *StartActionRequest) GetRestartSysServiceParams() *StartActionRequest_RestartSystemServiceParams {
 	if x != nil {
 		if x, ok := x.Params.(*StartActionRequest_RestartSysServiceParams); ok {
@@ -1343,6 +1384,18 @@ type StartActionRequest_MongodbQueryGetdiagnosticdataParams struct {
 	MongodbQueryGetdiagnosticdataParams *StartActionRequest_MongoDBQueryGetDiagnosticDataParams `protobuf:"bytes,29,opt,name=mongodb_query_getdiagnosticdata_params,json=mongodbQueryGetdiagnosticdataParams,proto3,oneof"`
 }
 
+type StartActionRequest_MongodbKillOpParams struct {
+	MongodbKillOpParams *StartActionRequest_MongoDBKillOpParams `protobuf:"bytes,30,opt,name=mongodb_kill_op_params,json=mongodbKillOpParams,proto3,oneof"`
+}
+
+type StartActionRequest_MysqlKillQueryParams struct {
+	MysqlKillQueryParams *StartActionRequest_MySQLKillQueryParams `protobuf:"bytes,31,opt,name=mysql_kill_query_params,json=mysqlKillQueryParams,proto3,oneof"`
+}
+
+type StartActionRequest_PostgresqlCancelBackendParams struct {
+	PostgresqlCancelBackendParams *StartActionRequest_PostgreSQLCancelBackendParams `protobuf:"bytes,32,opt,name=postgresql_cancel_backend_params,json=postgresqlCancelBackendParams,proto3,oneof"`
+}
+
 type StartActionRequest_RestartSysServiceParams struct {
 	RestartSysServiceParams *StartActionRequest_RestartSystemServiceParams `protobuf:"bytes,50,opt,name=restart_sys_service_params,json=restartSysServiceParams,proto3,oneof"`
 }
@@ -1387,6 +1440,12 @@ func (*StartActionRequest_MongodbQueryReplsetgetstatusParams) isStartActionReque
 
 func (*StartActionRequest_MongodbQueryGetdiagnosticdataParams) isStartActionRequest_Params() {}
 
+func (*StartActionRequest_MongodbKillOpParams) isStartActionRequest_Params() {}
+
+func (*StartActionRequest_MysqlKillQueryParams) isStartActionRequest_Params() {}
+
+func (*StartActionRequest_PostgresqlCancelBackendParams) isStartActionRequest_Params() {}
+
 func (*StartActionRequest_RestartSysServiceParams) isStartActionRequest_Params() {}
 
 // StartActionResponse is an AgentMessage for StartActionRequest acceptance.
@@ -1627,7 +1686,9 @@ type PBMSwitchPITRRequest struct {
 	// Contains files and their contents which can be used in DSN.
 	TextFiles *TextFiles `protobuf:"bytes,2,opt,name=text_files,json=textFiles,proto3" json:"text_files,omitempty"`
 	// Specifies if PITR should be enabled or disabled.
-	Enabled       bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Secret references by name to be resolved by pmm-agent and used in DSN.
+	Secrets       map[string]string `protobuf:"bytes,4,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1683,6 +1744,13 @@ func (x *PBMSwitchPITRRequest) GetEnabled() bool {
 	return false
 }
 
+func (x *PBMSwitchPITRRequest) GetSecrets() map[string]string {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
 // PBMSwitchPITRResponse is an AgentMessage for PBMSwitchPITRRequest success result.
 type PBMSwitchPITRResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1849,7 +1917,9 @@ type CheckConnectionRequest struct {
 	// TLS certificate wont be verified.
 	TlsSkipVerify bool `protobuf:"varint,5,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
 	// If true, use TLS for database connections.
-	Tls           bool `protobuf:"varint,6,opt,name=tls,proto3" json:"tls,omitempty"`
+	Tls bool `protobuf:"varint,6,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Secret references by name to be resolved by pmm-agent and used in DSN.
+	Secrets       map[string]string `protobuf:"bytes,7,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1926,6 +1996,13 @@ func (x *CheckConnectionRequest) GetTls() bool {
 	return false
 }
 
+func (x *CheckConnectionRequest) GetSecrets() map[string]string {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
 // CheckConnectionResponse is an AgentMessage containing the result of a connection check.
 type CheckConnectionResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1986,7 +2063,9 @@ type ServiceInfoRequest struct {
 	// TLS certificate wont be verified.
 	TlsSkipVerify bool `protobuf:"varint,5,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
 	// If true, use TLS for database connections.
-	Tls           bool `protobuf:"varint,6,opt,name=tls,proto3" json:"tls,omitempty"`
+	Tls bool `protobuf:"varint,6,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Secret references by name to be resolved by pmm-agent and used in DSN.
+	Secrets       map[string]string `protobuf:"bytes,7,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -2063,6 +2142,13 @@ func (x *ServiceInfoRequest) GetTls() bool {
 	return false
 }
 
+func (x *ServiceInfoRequest) GetSecrets() map[string]string {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
 // ServiceInfoResponse is an AgentMessage containing information gathered from a service.
 type ServiceInfoResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -2363,12 +2449,21 @@ type StartJobRequest struct {
 	JobId string                 `protobuf:"bytes,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
 	// Timeout for the job.
 	Timeout *durationpb.Duration `protobuf:"bytes,2,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Secret references by name to be resolved by pmm-agent (e.g. vault://kv/mysql/prod#password).
+	// Resolved values replace .Secrets.<name> and .URLSecrets.<name> placeholders in DSN,
+	// and passwords equal to the reference.
+	Secrets map[string]string `protobuf:"bytes,3,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Types that are valid to be assigned to Job:
 	//
 	//	*StartJobRequest_MysqlBackup
 	//	*StartJobRequest_MysqlRestoreBackup
 	//	*StartJobRequest_MongodbBackup
 	//	*StartJobRequest_MongodbRestoreBackup
+	//	*StartJobRequest_PostgresqlBackup
+	//	*StartJobRequest_PostgresqlRestoreBackup
+	//	*StartJobRequest_MysqlVerifyBackup
+	//	*StartJobRequest_MongodbVerifyBackup
+	//	*StartJobRequest_MysqlBinlogStream
 	Job           isStartJobRequest_Job `protobuf_oneof:"job"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -2418,6 +2513,13 @@ func (x *StartJobRequest) GetTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *StartJobRequest) GetSecrets() map[string]string {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
 func (x *StartJobRequest) GetJob() isStartJobRequest_Job {
 	if x != nil {
 		return x.Job
@@ -2461,6 +2563,51 @@ func (x *StartJobRequest) GetMongodbRestoreBackup() *StartJobRequest_MongoDBRest
 	return nil
 }
 
+func (x *StartJobRequest) GetPostgresqlBackup() *StartJobRequest_PostgreSQLBackup {
+	if x != nil {
+		if x, ok := x.Job.(*StartJobRequest_PostgresqlBackup); ok {
+			return x.PostgresqlBackup
+		}
+	}
+	return nil
+}
+
+func (x *StartJobRequest) GetPostgresqlRestoreBackup() *StartJobRequest_PostgreSQLRestoreBackup {
+	if x != nil {
+		if x, ok := x.Job.(*StartJobRequest_PostgresqlRestoreBackup); ok {
+			return x.PostgresqlRestoreBackup
+		}
+	}
+	return nil
+}
+
+func (x *StartJobRequest) GetMysqlVerifyBackup() *StartJobRequest_MySQLVerifyBackup {
+	if x != nil {
+		if x, ok := x.Job.(*StartJobRequest_MysqlVerifyBackup); ok {
+			return x.MysqlVerifyBackup
+		}
+	}
+	return nil
+}
+
+func (x *StartJobRequest) GetMongodbVerifyBackup() *StartJobRequest_MongoDBVerifyBackup {
+	if x != nil {
+		if x, ok := x.Job.(*StartJobRequest_MongodbVerifyBackup); ok {
+			return x.MongodbVerifyBackup
+		}
+	}
+	return nil
+}
+
+func (x *StartJobRequest) GetMysqlBinlogStream() *StartJobRequest_MySQLBinlogStream {
+	if x != nil {
+		if x, ok := x.Job.(*StartJobRequest_MysqlBinlogStream); ok {
+			return x.MysqlBinlogStream
+		}
+	}
+	return nil
+}
+
 type isStartJobRequest_Job interface {
 	isStartJobRequest_Job()
 }
@@ -2481,6 +2628,26 @@ type StartJobRequest_MongodbRestoreBackup struct {
 	MongodbRestoreBackup *StartJobRequest_MongoDBRestoreBackup `protobuf:"bytes,14,opt,name=mongodb_restore_backup,json=mongodbRestoreBackup,proto3,oneof"`
 }
 
+type StartJobRequest_PostgresqlBackup struct {
+	PostgresqlBackup *StartJobRequest_PostgreSQLBackup `protobuf:"bytes,15,opt,name=postgresql_backup,json=postgresqlBackup,proto3,oneof"`
+}
+
+type StartJobRequest_PostgresqlRestoreBackup struct {
+	PostgresqlRestoreBackup *StartJobRequest_PostgreSQLRestoreBackup `protobuf:"bytes,16,opt,name=postgresql_restore_backup,json=postgresqlRestoreBackup,proto3,oneof"`
+}
+
+type StartJobRequest_MysqlVerifyBackup struct {
+	MysqlVerifyBackup *StartJobRequest_MySQLVerifyBackup `protobuf:"bytes,17,opt,name=mysql_verify_backup,json=mysqlVerifyBackup,proto3,oneof"`
+}
+
+type StartJobRequest_MongodbVerifyBackup struct {
+	MongodbVerifyBackup *StartJobRequest_MongoDBVerifyBackup `protobuf:"bytes,18,opt,name=mongodb_verify_backup,json=mongodbVerifyBackup,proto3,oneof"`
+}
+
+type StartJobRequest_MysqlBinlogStream struct {
+	MysqlBinlogStream *StartJobRequest_MySQLBinlogStream `protobuf:"bytes,19,opt,name=mysql_binlog_stream,json=mysqlBinlogStream,proto3,oneof"`
+}
+
 func (*StartJobRequest_MysqlBackup) isStartJobRequest_Job() {}
 
 func (*StartJobRequest_MysqlRestoreBackup) isStartJobRequest_Job() {}
@@ -2489,6 +2656,16 @@ func (*StartJobRequest_MongodbBackup) isStartJobRequest_Job() {}
 
 func (*StartJobRequest_MongodbRestoreBackup) isStartJobRequest_Job() {}
 
+func (*StartJobRequest_PostgresqlBackup) isStartJobRequest_Job() {}
+
+func (*StartJobRequest_PostgresqlRestoreBackup) isStartJobRequest_Job() {}
+
+func (*StartJobRequest_MysqlVerifyBackup) isStartJobRequest_Job() {}
+
+func (*StartJobRequest_MongodbVerifyBackup) isStartJobRequest_Job() {}
+
+func (*StartJobRequest_MysqlBinlogStream) isStartJobRequest_Job() {}
+
 // StartJobResponse is an AgentMessage for StartJobRequest acceptance.
 type StartJobResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2628,6 +2805,11 @@ type JobResult struct {
 	//	*JobResult_MysqlRestoreBackup
 	//	*JobResult_MongodbBackup
 	//	*JobResult_MongodbRestoreBackup
+	//	*JobResult_PostgresqlBackup
+	//	*JobResult_PostgresqlRestoreBackup
+	//	*JobResult_MysqlVerifyBackup
+	//	*JobResult_MongodbVerifyBackup
+	//	*JobResult_MysqlBinlogStream
 	Result        isJobResult_Result `protobuf_oneof:"result"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -2729,6 +2911,51 @@ func (x *JobResult) GetMongodbRestoreBackup() *JobResult_MongoDBRestoreBackup {
 	return nil
 }
 
+func (x *JobResult) GetPostgresqlBackup() *JobResult_PostgreSQLBackup {
+	if x != nil {
+		if x, ok := x.Result.(*JobResult_PostgresqlBackup); ok {
+			return x.PostgresqlBackup
+		}
+	}
+	return nil
+}
+
+func (x *JobResult) GetPostgresqlRestoreBackup() *JobResult_PostgreSQLRestoreBackup {
+	if x != nil {
+		if x, ok := x.Result.(*JobResult_PostgresqlRestoreBackup); ok {
+			return x.PostgresqlRestoreBackup
+		}
+	}
+	return nil
+}
+
+func (x *JobResult) GetMysqlVerifyBackup() *JobResult_MySQLVerifyBackup {
+	if x != nil {
+		if x, ok := x.Result.(*JobResult_MysqlVerifyBackup); ok {
+			return x.MysqlVerifyBackup
+		}
+	}
+	return nil
+}
+
+func (x *JobResult) GetMongodbVerifyBackup() *JobResult_MongoDBVerifyBackup {
+	if x != nil {
+		if x, ok := x.Result.(*JobResult_MongodbVerifyBackup); ok {
+			return x.MongodbVerifyBackup
+		}
+	}
+	return nil
+}
+
+func (x *JobResult) GetMysqlBinlogStream() *JobResult_MySQLBinlogStream {
+	if x != nil {
+		if x, ok := x.Result.(*JobResult_MysqlBinlogStream); ok {
+			return x.MysqlBinlogStream
+		}
+	}
+	return nil
+}
+
 type isJobResult_Result interface {
 	isJobResult_Result()
 }
@@ -2753,6 +2980,26 @@ type JobResult_MongodbRestoreBackup struct {
 	MongodbRestoreBackup *JobResult_MongoDBRestoreBackup `protobuf:"bytes,15,opt,name=mongodb_restore_backup,json=mongodbRestoreBackup,proto3,oneof"`
 }
 
+type JobResult_PostgresqlBackup struct {
+	PostgresqlBackup *JobResult_PostgreSQLBackup `protobuf:"bytes,16,opt,name=postgresql_backup,json=postgresqlBackup,proto3,oneof"`
+}
+
+type JobResult_PostgresqlRestoreBackup struct {
+	PostgresqlRestoreBackup *JobResult_PostgreSQLRestoreBackup `protobuf:"bytes,17,opt,name=postgresql_restore_backup,json=postgresqlRestoreBackup,proto3,oneof"`
+}
+
+type JobResult_MysqlVerifyBackup struct {
+	MysqlVerifyBackup *JobResult_MySQLVerifyBackup `protobuf:"bytes,18,opt,name=mysql_verify_backup,json=mysqlVerifyBackup,proto3,oneof"`
+}
+
+type JobResult_MongodbVerifyBackup struct {
+	MongodbVerifyBackup *JobResult_MongoDBVerifyBackup `protobuf:"bytes,19,opt,name=mongodb_verify_backup,json=mongodbVerifyBackup,proto3,oneof"`
+}
+
+type JobResult_MysqlBinlogStream struct {
+	MysqlBinlogStream *JobResult_MySQLBinlogStream `protobuf:"bytes,20,opt,name=mysql_binlog_stream,json=mysqlBinlogStream,proto3,oneof"`
+}
+
 func (*JobResult_Error_) isJobResult_Result() {}
 
 func (*JobResult_MysqlBackup) isJobResult_Result() {}
@@ -2763,6 +3010,16 @@ func (*JobResult_MongodbBackup) isJobResult_Result() {}
 
 func (*JobResult_MongodbRestoreBackup) isJobResult_Result() {}
 
+func (*JobResult_PostgresqlBackup) isJobResult_Result() {}
+
+func (*JobResult_PostgresqlRestoreBackup) isJobResult_Result() {}
+
+func (*JobResult_MysqlVerifyBackup) isJobResult_Result() {}
+
+func (*JobResult_MongodbVerifyBackup) isJobResult_Result() {}
+
+func (*JobResult_MysqlBinlogStream) isJobResult_Result() {}
+
 // JobProgress represents job progress messages like percentage of completion, status updates, etc.
 type JobProgress struct {
 	state     protoimpl.MessageState `protogen:"open.v1"`
@@ -2968,14 +3225,106 @@ func (x *GetVersionsResponse) GetVersions() []*GetVersionsResponse_Version {
 	return nil
 }
 
+// RenewCertificateRequest is sent by pmm-agent to get a new client certificate before the current one expires.
+type RenewCertificateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PEM-encoded PKCS #10 certificate signing request with a new public key of pmm-agent.
+	CertificateSigningRequest string `protobuf:"bytes,1,opt,name=certificate_signing_request,json=certificateSigningRequest,proto3" json:"certificate_signing_request,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *RenewCertificateRequest) Reset() {
+	*x = RenewCertificateRequest{}
+	mi := &file_agent_v1_agent_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewCertificateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewCertificateRequest) ProtoMessage() {}
+
+func (x *RenewCertificateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewCertificateRequest.ProtoReflect.Descriptor instead.
+func (*RenewCertificateRequest) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *RenewCertificateRequest) GetCertificateSigningRequest() string {
+	if x != nil {
+		return x.CertificateSigningRequest
+	}
+	return ""
+}
+
+// RenewCertificateResponse contains a new client certificate issued by PMM Server.
+type RenewCertificateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// PEM-encoded client certificate.
+	Certificate   string `protobuf:"bytes,1,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RenewCertificateResponse) Reset() {
+	*x = RenewCertificateResponse{}
+	mi := &file_agent_v1_agent_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RenewCertificateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenewCertificateResponse) ProtoMessage() {}
+
+func (x *RenewCertificateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenewCertificateResponse.ProtoReflect.Descriptor instead.
+func (*RenewCertificateResponse) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *RenewCertificateResponse) GetCertificate() string {
+	if x != nil {
+		return x.Certificate
+	}
+	return ""
+}
+
 type AgentMessage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// The responder sets the status field in two situations:
-	//  1. When it received a request with the payload field not set.
-	//     That means that responded is older than the requester, and doesn't know about newer payload types.
-	//     Status code UNIMPLEMENTED (12) is reserved for that case.
-	//  2. When the payload is set, but the request can't be performed due to some error.
+	// 1. When it received a request with the payload field not set.
+	//    That means that responded is older than the requester, and doesn't know about newer payload types.
+	//    Status code UNIMPLEMENTED (12) is reserved for that case.
+	// 2. When the payload is set, but the request can't be performed due to some error.
 	Status *status.Status `protobuf:"bytes,2047,opt,name=status,proto3" json:"status,omitempty"`
 	// Types that are valid to be assigned to Payload:
 	//
@@ -2985,6 +3334,7 @@ type AgentMessage struct {
 	//	*AgentMessage_ActionResult
 	//	*AgentMessage_JobResult
 	//	*AgentMessage_JobProgress
+	//	*AgentMessage_RenewCertificate
 	//	*AgentMessage_Pong
 	//	*AgentMessage_SetState
 	//	*AgentMessage_StartAction
@@ -3004,7 +3354,7 @@ type AgentMessage struct {
 
 func (x *AgentMessage) Reset() {
 	*x = AgentMessage{}
-	mi := &file_agent_v1_agent_proto_msgTypes[40]
+	mi := &file_agent_v1_agent_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3016,7 +3366,7 @@ func (x *AgentMessage) String() string {
 func (*AgentMessage) ProtoMessage() {}
 
 func (x *AgentMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[40]
+	mi := &file_agent_v1_agent_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3029,7 +3379,7 @@ func (x *AgentMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentMessage.ProtoReflect.Descriptor instead.
 func (*AgentMessage) Descriptor() ([]byte, []int) {
-	return file_agent_v1_agent_proto_rawDescGZIP(), []int{40}
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *AgentMessage) GetId() uint32 {
@@ -3107,6 +3457,15 @@ func (x *AgentMessage) GetJobProgress() *JobProgress {
 	return nil
 }
 
+func (x *AgentMessage) GetRenewCertificate() *RenewCertificateRequest {
+	if x != nil {
+		if x, ok := x.Payload.(*AgentMessage_RenewCertificate); ok {
+			return x.RenewCertificate
+		}
+	}
+	return nil
+}
+
 func (x *AgentMessage) GetPong() *Pong {
 	if x != nil {
 		if x, ok := x.Payload.(*AgentMessage_Pong); ok {
@@ -3244,6 +3603,10 @@ type AgentMessage_JobProgress struct {
 	JobProgress *JobProgress `protobuf:"bytes,17,opt,name=job_progress,json=jobProgress,proto3,oneof"`
 }
 
+type AgentMessage_RenewCertificate struct {
+	RenewCertificate *RenewCertificateRequest `protobuf:"bytes,23,opt,name=renew_certificate,json=renewCertificate,proto3,oneof"`
+}
+
 type AgentMessage_Pong struct {
 	// responses from agent
 	Pong *Pong `protobuf:"bytes,8,opt,name=pong,proto3,oneof"`
@@ -3305,6 +3668,8 @@ func (*AgentMessage_JobResult) isAgentMessage_Payload() {}
 
 func (*AgentMessage_JobProgress) isAgentMessage_Payload() {}
 
+func (*AgentMessage_RenewCertificate) isAgentMessage_Payload() {}
+
 func (*AgentMessage_Pong) isAgentMessage_Payload() {}
 
 func (*AgentMessage_SetState) isAgentMessage_Payload() {}
@@ -3333,10 +3698,10 @@ type ServerMessage struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	Id    uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// The responder sets the status field in two situations:
-	//  1. When it received a request with the payload field not set.
-	//     That means that responded is older than the requester, and doesn't know about newer payload types.
-	//     Status code UNIMPLEMENTED (12) is reserved for that case.
-	//  2. When the payload is set, but the request can't be performed due to some error.
+	// 1. When it received a request with the payload field not set.
+	//    That means that responded is older than the requester, and doesn't know about newer payload types.
+	//    Status code UNIMPLEMENTED (12) is reserved for that case.
+	// 2. When the payload is set, but the request can't be performed due to some error.
 	Status *status.Status `protobuf:"bytes,2047,opt,name=status,proto3" json:"status,omitempty"`
 	// Types that are valid to be assigned to Payload:
 	//
@@ -3344,6 +3709,7 @@ type ServerMessage struct {
 	//	*ServerMessage_StateChanged
 	//	*ServerMessage_QanCollect
 	//	*ServerMessage_ActionResult
+	//	*ServerMessage_RenewCertificate
 	//	*ServerMessage_Ping
 	//	*ServerMessage_SetState
 	//	*ServerMessage_StartAction
@@ -3363,7 +3729,7 @@ type ServerMessage struct {
 
 func (x *ServerMessage) Reset() {
 	*x = ServerMessage{}
-	mi := &file_agent_v1_agent_proto_msgTypes[41]
+	mi := &file_agent_v1_agent_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3375,7 +3741,7 @@ func (x *ServerMessage) String() string {
 func (*ServerMessage) ProtoMessage() {}
 
 func (x *ServerMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[41]
+	mi := &file_agent_v1_agent_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3388,7 +3754,7 @@ func (x *ServerMessage) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerMessage.ProtoReflect.Descriptor instead.
 func (*ServerMessage) Descriptor() ([]byte, []int) {
-	return file_agent_v1_agent_proto_rawDescGZIP(), []int{41}
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{43}
 }
 
 func (x *ServerMessage) GetId() uint32 {
@@ -3448,6 +3814,15 @@ func (x *ServerMessage) GetActionResult() *ActionResultResponse {
 	return nil
 }
 
+func (x *ServerMessage) GetRenewCertificate() *RenewCertificateResponse {
+	if x != nil {
+		if x, ok := x.Payload.(*ServerMessage_RenewCertificate); ok {
+			return x.RenewCertificate
+		}
+	}
+	return nil
+}
+
 func (x *ServerMessage) GetPing() *Ping {
 	if x != nil {
 		if x, ok := x.Payload.(*ServerMessage_Ping); ok {
@@ -3577,6 +3952,10 @@ type ServerMessage_ActionResult struct {
 	ActionResult *ActionResultResponse `protobuf:"bytes,5,opt,name=action_result,json=actionResult,proto3,oneof"`
 }
 
+type ServerMessage_RenewCertificate struct {
+	RenewCertificate *RenewCertificateResponse `protobuf:"bytes,21,opt,name=renew_certificate,json=renewCertificate,proto3,oneof"`
+}
+
 type ServerMessage_Ping struct {
 	// requests from server
 	Ping *Ping `protobuf:"bytes,8,opt,name=ping,proto3,oneof"`
@@ -3634,6 +4013,8 @@ func (*ServerMessage_QanCollect) isServerMessage_Payload() {}
 
 func (*ServerMessage_ActionResult) isServerMessage_Payload() {}
 
+func (*ServerMessage_RenewCertificate) isServerMessage_Payload() {}
+
 func (*ServerMessage_Ping) isServerMessage_Payload() {}
 
 func (*ServerMessage_SetState) isServerMessage_Payload() {}
@@ -3670,13 +4051,16 @@ type SetStateRequest_AgentProcess struct {
 	RedactWords        []string               `protobuf:"bytes,7,rep,name=redact_words,json=redactWords,proto3" json:"redact_words,omitempty"`
 	// Environment variable names to be resolved from pmm-agent's environment.
 	EnvVariableNames []string `protobuf:"bytes,8,rep,name=env_variable_names,json=envVariableNames,proto3" json:"env_variable_names,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Secret references by name to be resolved by pmm-agent (e.g. vault://kv/mysql/prod#password).
+	// Resolved values replace .Secrets.<name> and .URLSecrets.<name> placeholders.
+	Secrets       map[string]string `protobuf:"bytes,9,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SetStateRequest_AgentProcess) Reset() {
 	*x = SetStateRequest_AgentProcess{}
-	mi := &file_agent_v1_agent_proto_msgTypes[43]
+	mi := &file_agent_v1_agent_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3688,7 +4072,7 @@ func (x *SetStateRequest_AgentProcess) String() string {
 func (*SetStateRequest_AgentProcess) ProtoMessage() {}
 
 func (x *SetStateRequest_AgentProcess) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[43]
+	mi := &file_agent_v1_agent_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3760,6 +4144,13 @@ func (x *SetStateRequest_AgentProcess) GetEnvVariableNames() []string {
 	return nil
 }
 
+func (x *SetStateRequest_AgentProcess) GetSecrets() map[string]string {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
 // BuiltinAgent describes desired configuration of a single built-in agent for pmm-agent.
 type SetStateRequest_BuiltinAgent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -3788,14 +4179,16 @@ type SetStateRequest_BuiltinAgent struct {
 	ServiceId string `protobuf:"bytes,12,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
 	// Service name of the service where the agent connects to.
 	// Currently used by Real-Time Analytics built-in agent only.
-	ServiceName   string `protobuf:"bytes,13,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	ServiceName string `protobuf:"bytes,13,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// Secret references by name to be resolved by pmm-agent and used in DSN.
+	Secrets       map[string]string `protobuf:"bytes,14,rep,name=secrets,proto3" json:"secrets,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *SetStateRequest_BuiltinAgent) Reset() {
 	*x = SetStateRequest_BuiltinAgent{}
-	mi := &file_agent_v1_agent_proto_msgTypes[45]
+	mi := &file_agent_v1_agent_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3807,7 +4200,7 @@ func (x *SetStateRequest_BuiltinAgent) String() string {
 func (*SetStateRequest_BuiltinAgent) ProtoMessage() {}
 
 func (x *SetStateRequest_BuiltinAgent) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[45]
+	mi := &file_agent_v1_agent_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3914,6 +4307,13 @@ func (x *SetStateRequest_BuiltinAgent) GetServiceName() string {
 	return ""
 }
 
+func (x *SetStateRequest_BuiltinAgent) GetSecrets() map[string]string {
+	if x != nil {
+		return x.Secrets
+	}
+	return nil
+}
+
 // MySQLExplainParams describes MySQL EXPLAIN action parameters.
 type StartActionRequest_MySQLExplainParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -3933,7 +4333,7 @@ type StartActionRequest_MySQLExplainParams struct {
 
 func (x *StartActionRequest_MySQLExplainParams) Reset() {
 	*x = StartActionRequest_MySQLExplainParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[50]
+	mi := &file_agent_v1_agent_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3945,7 +4345,7 @@ func (x *StartActionRequest_MySQLExplainParams) String() string {
 func (*StartActionRequest_MySQLExplainParams) ProtoMessage() {}
 
 func (x *StartActionRequest_MySQLExplainParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[50]
+	mi := &file_agent_v1_agent_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4026,7 +4426,7 @@ type StartActionRequest_MySQLShowCreateTableParams struct {
 
 func (x *StartActionRequest_MySQLShowCreateTableParams) Reset() {
 	*x = StartActionRequest_MySQLShowCreateTableParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[51]
+	mi := &file_agent_v1_agent_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4038,7 +4438,7 @@ func (x *StartActionRequest_MySQLShowCreateTableParams) String() string {
 func (*StartActionRequest_MySQLShowCreateTableParams) ProtoMessage() {}
 
 func (x *StartActionRequest_MySQLShowCreateTableParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[51]
+	mi := &file_agent_v1_agent_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4098,7 +4498,7 @@ type StartActionRequest_MySQLShowTableStatusParams struct {
 
 func (x *StartActionRequest_MySQLShowTableStatusParams) Reset() {
 	*x = StartActionRequest_MySQLShowTableStatusParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[52]
+	mi := &file_agent_v1_agent_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4110,7 +4510,7 @@ func (x *StartActionRequest_MySQLShowTableStatusParams) String() string {
 func (*StartActionRequest_MySQLShowTableStatusParams) ProtoMessage() {}
 
 func (x *StartActionRequest_MySQLShowTableStatusParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[52]
+	mi := &file_agent_v1_agent_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4170,7 +4570,7 @@ type StartActionRequest_MySQLShowIndexParams struct {
 
 func (x *StartActionRequest_MySQLShowIndexParams) Reset() {
 	*x = StartActionRequest_MySQLShowIndexParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[53]
+	mi := &file_agent_v1_agent_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4182,7 +4582,7 @@ func (x *StartActionRequest_MySQLShowIndexParams) String() string {
 func (*StartActionRequest_MySQLShowIndexParams) ProtoMessage() {}
 
 func (x *StartActionRequest_MySQLShowIndexParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[53]
+	mi := &file_agent_v1_agent_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4242,7 +4642,7 @@ type StartActionRequest_PostgreSQLShowCreateTableParams struct {
 
 func (x *StartActionRequest_PostgreSQLShowCreateTableParams) Reset() {
 	*x = StartActionRequest_PostgreSQLShowCreateTableParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[54]
+	mi := &file_agent_v1_agent_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4254,7 +4654,7 @@ func (x *StartActionRequest_PostgreSQLShowCreateTableParams) String() string {
 func (*StartActionRequest_PostgreSQLShowCreateTableParams) ProtoMessage() {}
 
 func (x *StartActionRequest_PostgreSQLShowCreateTableParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[54]
+	mi := &file_agent_v1_agent_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4314,7 +4714,7 @@ type StartActionRequest_PostgreSQLShowIndexParams struct {
 
 func (x *StartActionRequest_PostgreSQLShowIndexParams) Reset() {
 	*x = StartActionRequest_PostgreSQLShowIndexParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[55]
+	mi := &file_agent_v1_agent_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4326,7 +4726,7 @@ func (x *StartActionRequest_PostgreSQLShowIndexParams) String() string {
 func (*StartActionRequest_PostgreSQLShowIndexParams) ProtoMessage() {}
 
 func (x *StartActionRequest_PostgreSQLShowIndexParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[55]
+	mi := &file_agent_v1_agent_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4385,7 +4785,7 @@ type StartActionRequest_MongoDBExplainParams struct {
 
 func (x *StartActionRequest_MongoDBExplainParams) Reset() {
 	*x = StartActionRequest_MongoDBExplainParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[56]
+	mi := &file_agent_v1_agent_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4397,7 +4797,7 @@ func (x *StartActionRequest_MongoDBExplainParams) String() string {
 func (*StartActionRequest_MongoDBExplainParams) ProtoMessage() {}
 
 func (x *StartActionRequest_MongoDBExplainParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[56]
+	mi := &file_agent_v1_agent_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4443,7 +4843,7 @@ type StartActionRequest_PTSummaryParams struct {
 
 func (x *StartActionRequest_PTSummaryParams) Reset() {
 	*x = StartActionRequest_PTSummaryParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[57]
+	mi := &file_agent_v1_agent_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4455,7 +4855,7 @@ func (x *StartActionRequest_PTSummaryParams) String() string {
 func (*StartActionRequest_PTSummaryParams) ProtoMessage() {}
 
 func (x *StartActionRequest_PTSummaryParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[57]
+	mi := &file_agent_v1_agent_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4484,7 +4884,7 @@ type StartActionRequest_PTPgSummaryParams struct {
 
 func (x *StartActionRequest_PTPgSummaryParams) Reset() {
 	*x = StartActionRequest_PTPgSummaryParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[58]
+	mi := &file_agent_v1_agent_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4496,7 +4896,7 @@ func (x *StartActionRequest_PTPgSummaryParams) String() string {
 func (*StartActionRequest_PTPgSummaryParams) ProtoMessage() {}
 
 func (x *StartActionRequest_PTPgSummaryParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[58]
+	mi := &file_agent_v1_agent_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4553,7 +4953,7 @@ type StartActionRequest_PTMongoDBSummaryParams struct {
 
 func (x *StartActionRequest_PTMongoDBSummaryParams) Reset() {
 	*x = StartActionRequest_PTMongoDBSummaryParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[59]
+	mi := &file_agent_v1_agent_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4565,7 +4965,7 @@ func (x *StartActionRequest_PTMongoDBSummaryParams) String() string {
 func (*StartActionRequest_PTMongoDBSummaryParams) ProtoMessage() {}
 
 func (x *StartActionRequest_PTMongoDBSummaryParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[59]
+	mi := &file_agent_v1_agent_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4623,7 +5023,7 @@ type StartActionRequest_PTMySQLSummaryParams struct {
 
 func (x *StartActionRequest_PTMySQLSummaryParams) Reset() {
 	*x = StartActionRequest_PTMySQLSummaryParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[60]
+	mi := &file_agent_v1_agent_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4635,7 +5035,7 @@ func (x *StartActionRequest_PTMySQLSummaryParams) String() string {
 func (*StartActionRequest_PTMySQLSummaryParams) ProtoMessage() {}
 
 func (x *StartActionRequest_PTMySQLSummaryParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[60]
+	mi := &file_agent_v1_agent_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4703,7 +5103,7 @@ type StartActionRequest_MySQLQueryShowParams struct {
 
 func (x *StartActionRequest_MySQLQueryShowParams) Reset() {
 	*x = StartActionRequest_MySQLQueryShowParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[61]
+	mi := &file_agent_v1_agent_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4715,7 +5115,7 @@ func (x *StartActionRequest_MySQLQueryShowParams) String() string {
 func (*StartActionRequest_MySQLQueryShowParams) ProtoMessage() {}
 
 func (x *StartActionRequest_MySQLQueryShowParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[61]
+	mi := &file_agent_v1_agent_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4776,7 +5176,7 @@ type StartActionRequest_MySQLQuerySelectParams struct {
 
 func (x *StartActionRequest_MySQLQuerySelectParams) Reset() {
 	*x = StartActionRequest_MySQLQuerySelectParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[62]
+	mi := &file_agent_v1_agent_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4788,7 +5188,7 @@ func (x *StartActionRequest_MySQLQuerySelectParams) String() string {
 func (*StartActionRequest_MySQLQuerySelectParams) ProtoMessage() {}
 
 func (x *StartActionRequest_MySQLQuerySelectParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[62]
+	mi := &file_agent_v1_agent_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4847,7 +5247,7 @@ type StartActionRequest_PostgreSQLQueryShowParams struct {
 
 func (x *StartActionRequest_PostgreSQLQueryShowParams) Reset() {
 	*x = StartActionRequest_PostgreSQLQueryShowParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[63]
+	mi := &file_agent_v1_agent_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4859,7 +5259,7 @@ func (x *StartActionRequest_PostgreSQLQueryShowParams) String() string {
 func (*StartActionRequest_PostgreSQLQueryShowParams) ProtoMessage() {}
 
 func (x *StartActionRequest_PostgreSQLQueryShowParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[63]
+	mi := &file_agent_v1_agent_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4913,7 +5313,7 @@ type StartActionRequest_PostgreSQLQuerySelectParams struct {
 
 func (x *StartActionRequest_PostgreSQLQuerySelectParams) Reset() {
 	*x = StartActionRequest_PostgreSQLQuerySelectParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[64]
+	mi := &file_agent_v1_agent_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4925,7 +5325,7 @@ func (x *StartActionRequest_PostgreSQLQuerySelectParams) String() string {
 func (*StartActionRequest_PostgreSQLQuerySelectParams) ProtoMessage() {}
 
 func (x *StartActionRequest_PostgreSQLQuerySelectParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[64]
+	mi := &file_agent_v1_agent_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4983,7 +5383,7 @@ type StartActionRequest_MongoDBQueryGetParameterParams struct {
 
 func (x *StartActionRequest_MongoDBQueryGetParameterParams) Reset() {
 	*x = StartActionRequest_MongoDBQueryGetParameterParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[65]
+	mi := &file_agent_v1_agent_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4995,7 +5395,7 @@ func (x *StartActionRequest_MongoDBQueryGetParameterParams) String() string {
 func (*StartActionRequest_MongoDBQueryGetParameterParams) ProtoMessage() {}
 
 func (x *StartActionRequest_MongoDBQueryGetParameterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[65]
+	mi := &file_agent_v1_agent_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5039,7 +5439,7 @@ type StartActionRequest_MongoDBQueryBuildInfoParams struct {
 
 func (x *StartActionRequest_MongoDBQueryBuildInfoParams) Reset() {
 	*x = StartActionRequest_MongoDBQueryBuildInfoParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[66]
+	mi := &file_agent_v1_agent_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5051,7 +5451,7 @@ func (x *StartActionRequest_MongoDBQueryBuildInfoParams) String() string {
 func (*StartActionRequest_MongoDBQueryBuildInfoParams) ProtoMessage() {}
 
 func (x *StartActionRequest_MongoDBQueryBuildInfoParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[66]
+	mi := &file_agent_v1_agent_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5095,7 +5495,7 @@ type StartActionRequest_MongoDBQueryGetCmdLineOptsParams struct {
 
 func (x *StartActionRequest_MongoDBQueryGetCmdLineOptsParams) Reset() {
 	*x = StartActionRequest_MongoDBQueryGetCmdLineOptsParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[67]
+	mi := &file_agent_v1_agent_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5107,7 +5507,7 @@ func (x *StartActionRequest_MongoDBQueryGetCmdLineOptsParams) String() string {
 func (*StartActionRequest_MongoDBQueryGetCmdLineOptsParams) ProtoMessage() {}
 
 func (x *StartActionRequest_MongoDBQueryGetCmdLineOptsParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[67]
+	mi := &file_agent_v1_agent_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5151,7 +5551,7 @@ type StartActionRequest_MongoDBQueryReplSetGetStatusParams struct {
 
 func (x *StartActionRequest_MongoDBQueryReplSetGetStatusParams) Reset() {
 	*x = StartActionRequest_MongoDBQueryReplSetGetStatusParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[68]
+	mi := &file_agent_v1_agent_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5163,7 +5563,7 @@ func (x *StartActionRequest_MongoDBQueryReplSetGetStatusParams) String() string
 func (*StartActionRequest_MongoDBQueryReplSetGetStatusParams) ProtoMessage() {}
 
 func (x *StartActionRequest_MongoDBQueryReplSetGetStatusParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[68]
+	mi := &file_agent_v1_agent_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5207,7 +5607,7 @@ type StartActionRequest_MongoDBQueryGetDiagnosticDataParams struct {
 
 func (x *StartActionRequest_MongoDBQueryGetDiagnosticDataParams) Reset() {
 	*x = StartActionRequest_MongoDBQueryGetDiagnosticDataParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[69]
+	mi := &file_agent_v1_agent_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5219,7 +5619,7 @@ func (x *StartActionRequest_MongoDBQueryGetDiagnosticDataParams) String() string
 func (*StartActionRequest_MongoDBQueryGetDiagnosticDataParams) ProtoMessage() {}
 
 func (x *StartActionRequest_MongoDBQueryGetDiagnosticDataParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[69]
+	mi := &file_agent_v1_agent_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5249,29 +5649,35 @@ func (x *StartActionRequest_MongoDBQueryGetDiagnosticDataParams) GetTextFiles()
 	return nil
 }
 
-// RestartSystemServiceParams describes an action request to restart a systemctl service on a node.
-type StartActionRequest_RestartSystemServiceParams struct {
-	state         protoimpl.MessageState                                      `protogen:"open.v1"`
-	SystemService StartActionRequest_RestartSystemServiceParams_SystemService `protobuf:"varint,1,opt,name=system_service,json=systemService,proto3,enum=agent.v1.StartActionRequest_RestartSystemServiceParams_SystemService" json:"system_service,omitempty"`
+// MongoDBKillOpParams describes MongoDB killOp action parameters.
+type StartActionRequest_MongoDBKillOpParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// DSN for the service. May contain connection (dial) timeout.
+	// May contain placeholders for file paths in DSN.
+	Dsn string `protobuf:"bytes,1,opt,name=dsn,proto3" json:"dsn,omitempty"`
+	// Contains files and their contents which can be used in DSN.
+	TextFiles *TextFiles `protobuf:"bytes,2,opt,name=text_files,json=textFiles,proto3" json:"text_files,omitempty"`
+	// Operation ID to kill.
+	OpId          int64 `protobuf:"varint,3,opt,name=op_id,json=opId,proto3" json:"op_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartActionRequest_RestartSystemServiceParams) Reset() {
-	*x = StartActionRequest_RestartSystemServiceParams{}
-	mi := &file_agent_v1_agent_proto_msgTypes[70]
+func (x *StartActionRequest_MongoDBKillOpParams) Reset() {
+	*x = StartActionRequest_MongoDBKillOpParams{}
+	mi := &file_agent_v1_agent_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartActionRequest_RestartSystemServiceParams) String() string {
+func (x *StartActionRequest_MongoDBKillOpParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartActionRequest_RestartSystemServiceParams) ProtoMessage() {}
+func (*StartActionRequest_MongoDBKillOpParams) ProtoMessage() {}
 
-func (x *StartActionRequest_RestartSystemServiceParams) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[70]
+func (x *StartActionRequest_MongoDBKillOpParams) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5282,42 +5688,70 @@ func (x *StartActionRequest_RestartSystemServiceParams) ProtoReflect() protorefl
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartActionRequest_RestartSystemServiceParams.ProtoReflect.Descriptor instead.
-func (*StartActionRequest_RestartSystemServiceParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartActionRequest_MongoDBKillOpParams.ProtoReflect.Descriptor instead.
+func (*StartActionRequest_MongoDBKillOpParams) Descriptor() ([]byte, []int) {
 	return file_agent_v1_agent_proto_rawDescGZIP(), []int{14, 20}
 }
 
-func (x *StartActionRequest_RestartSystemServiceParams) GetSystemService() StartActionRequest_RestartSystemServiceParams_SystemService {
+func (x *StartActionRequest_MongoDBKillOpParams) GetDsn() string {
 	if x != nil {
-		return x.SystemService
+		return x.Dsn
 	}
-	return StartActionRequest_RestartSystemServiceParams_SYSTEM_SERVICE_UNSPECIFIED
+	return ""
 }
 
-// Stats contains various Service statistics.
-type CheckConnectionResponse_Stats struct {
+func (x *StartActionRequest_MongoDBKillOpParams) GetTextFiles() *TextFiles {
+	if x != nil {
+		return x.TextFiles
+	}
+	return nil
+}
+
+func (x *StartActionRequest_MongoDBKillOpParams) GetOpId() int64 {
+	if x != nil {
+		return x.OpId
+	}
+	return 0
+}
+
+// MySQLKillQueryParams describes MySQL KILL QUERY action parameters.
+type StartActionRequest_MySQLKillQueryParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// A number of tables, 0 if unknown.
-	TableCount    int32 `protobuf:"varint,1,opt,name=table_count,json=tableCount,proto3" json:"table_count,omitempty"`
+	// DSN for the service. May contain connection (dial) timeout.
+	Dsn string `protobuf:"bytes,1,opt,name=dsn,proto3" json:"dsn,omitempty"`
+	// Contains files and their contents which can be used in DSN.
+	TlsFiles *TextFiles `protobuf:"bytes,2,opt,name=tls_files,json=tlsFiles,proto3" json:"tls_files,omitempty"`
+	// TLS certificate wont be verified.
+	TlsSkipVerify bool `protobuf:"varint,3,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Connection ID which statement should be killed.
+	ConnectionId uint64 `protobuf:"varint,4,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Performance Schema thread and statement event identifiers of the statement.
+	// If set, the statement is killed only if the connection is still executing it.
+	ThreadId uint64 `protobuf:"varint,5,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	EventId  uint64 `protobuf:"varint,6,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	// Time when the statement was started, as observed in the processlist.
+	// If set and event_id is not, the statement is killed only if the connection
+	// is still executing a statement started at about that time.
+	QueryStart    *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=query_start,json=queryStart,proto3" json:"query_start,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckConnectionResponse_Stats) Reset() {
-	*x = CheckConnectionResponse_Stats{}
-	mi := &file_agent_v1_agent_proto_msgTypes[71]
+func (x *StartActionRequest_MySQLKillQueryParams) Reset() {
+	*x = StartActionRequest_MySQLKillQueryParams{}
+	mi := &file_agent_v1_agent_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckConnectionResponse_Stats) String() string {
+func (x *StartActionRequest_MySQLKillQueryParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckConnectionResponse_Stats) ProtoMessage() {}
+func (*StartActionRequest_MySQLKillQueryParams) ProtoMessage() {}
 
-func (x *CheckConnectionResponse_Stats) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[71]
+func (x *StartActionRequest_MySQLKillQueryParams) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5328,60 +5762,93 @@ func (x *CheckConnectionResponse_Stats) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckConnectionResponse_Stats.ProtoReflect.Descriptor instead.
-func (*CheckConnectionResponse_Stats) Descriptor() ([]byte, []int) {
-	return file_agent_v1_agent_proto_rawDescGZIP(), []int{25, 0}
+// Deprecated: Use StartActionRequest_MySQLKillQueryParams.ProtoReflect.Descriptor instead.
+func (*StartActionRequest_MySQLKillQueryParams) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{14, 21}
 }
 
-func (x *CheckConnectionResponse_Stats) GetTableCount() int32 {
+func (x *StartActionRequest_MySQLKillQueryParams) GetDsn() string {
 	if x != nil {
-		return x.TableCount
+		return x.Dsn
+	}
+	return ""
+}
+
+func (x *StartActionRequest_MySQLKillQueryParams) GetTlsFiles() *TextFiles {
+	if x != nil {
+		return x.TlsFiles
+	}
+	return nil
+}
+
+func (x *StartActionRequest_MySQLKillQueryParams) GetTlsSkipVerify() bool {
+	if x != nil {
+		return x.TlsSkipVerify
+	}
+	return false
+}
+
+func (x *StartActionRequest_MySQLKillQueryParams) GetConnectionId() uint64 {
+	if x != nil {
+		return x.ConnectionId
 	}
 	return 0
 }
 
-// MySQLBackup is job for backup MySQL service.
-type StartJobRequest_MySQLBackup struct {
+func (x *StartActionRequest_MySQLKillQueryParams) GetThreadId() uint64 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *StartActionRequest_MySQLKillQueryParams) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+func (x *StartActionRequest_MySQLKillQueryParams) GetQueryStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QueryStart
+	}
+	return nil
+}
+
+// PostgreSQLCancelBackendParams describes PostgreSQL pg_cancel_backend action parameters.
+type StartActionRequest_PostgreSQLCancelBackendParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Database user;
-	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	// Database password.
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	// Database address. Can't be specified with socket.
-	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	// Database port. Can't be specified with socket.
-	Port int32 `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
-	// Database unix socket. Can't be specified with address/port.
-	Socket string `protobuf:"bytes,5,opt,name=socket,proto3" json:"socket,omitempty"`
-	// Backup name.
-	Name string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
-	// Folder to store artifact on a storage.
-	Folder string `protobuf:"bytes,7,opt,name=folder,proto3" json:"folder,omitempty"`
-	// Backup target location.
-	//
-	// Types that are valid to be assigned to LocationConfig:
-	//
-	//	*StartJobRequest_MySQLBackup_S3Config
-	LocationConfig isStartJobRequest_MySQLBackup_LocationConfig `protobuf_oneof:"location_config"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// DSN for the service. May contain connection (dial) timeout.
+	Dsn string `protobuf:"bytes,1,opt,name=dsn,proto3" json:"dsn,omitempty"`
+	// Contains files and their contents which can be used in DSN.
+	TlsFiles *TextFiles `protobuf:"bytes,2,opt,name=tls_files,json=tlsFiles,proto3" json:"tls_files,omitempty"`
+	// TLS certificate wont be verified.
+	TlsSkipVerify bool `protobuf:"varint,3,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Backend process ID which query should be canceled.
+	Pid int32 `protobuf:"varint,4,opt,name=pid,proto3" json:"pid,omitempty"`
+	// Time when the query was started.
+	// If set, the query is canceled only if the backend is still executing it.
+	QueryStart    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=query_start,json=queryStart,proto3" json:"query_start,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartJobRequest_MySQLBackup) Reset() {
-	*x = StartJobRequest_MySQLBackup{}
-	mi := &file_agent_v1_agent_proto_msgTypes[72]
+func (x *StartActionRequest_PostgreSQLCancelBackendParams) Reset() {
+	*x = StartActionRequest_PostgreSQLCancelBackendParams{}
+	mi := &file_agent_v1_agent_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartJobRequest_MySQLBackup) String() string {
+func (x *StartActionRequest_PostgreSQLCancelBackendParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StartJobRequest_MySQLBackup) ProtoMessage() {}
+func (*StartActionRequest_PostgreSQLCancelBackendParams) ProtoMessage() {}
 
-func (x *StartJobRequest_MySQLBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[72]
+func (x *StartActionRequest_PostgreSQLCancelBackendParams) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5392,68 +5859,260 @@ func (x *StartJobRequest_MySQLBackup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StartJobRequest_MySQLBackup.ProtoReflect.Descriptor instead.
-func (*StartJobRequest_MySQLBackup) Descriptor() ([]byte, []int) {
-	return file_agent_v1_agent_proto_rawDescGZIP(), []int{32, 0}
+// Deprecated: Use StartActionRequest_PostgreSQLCancelBackendParams.ProtoReflect.Descriptor instead.
+func (*StartActionRequest_PostgreSQLCancelBackendParams) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{14, 22}
 }
 
-func (x *StartJobRequest_MySQLBackup) GetUser() string {
+func (x *StartActionRequest_PostgreSQLCancelBackendParams) GetDsn() string {
 	if x != nil {
-		return x.User
+		return x.Dsn
 	}
 	return ""
 }
 
-func (x *StartJobRequest_MySQLBackup) GetPassword() string {
+func (x *StartActionRequest_PostgreSQLCancelBackendParams) GetTlsFiles() *TextFiles {
 	if x != nil {
-		return x.Password
+		return x.TlsFiles
 	}
-	return ""
+	return nil
 }
 
-func (x *StartJobRequest_MySQLBackup) GetAddress() string {
+func (x *StartActionRequest_PostgreSQLCancelBackendParams) GetTlsSkipVerify() bool {
 	if x != nil {
-		return x.Address
+		return x.TlsSkipVerify
 	}
-	return ""
+	return false
 }
 
-func (x *StartJobRequest_MySQLBackup) GetPort() int32 {
+func (x *StartActionRequest_PostgreSQLCancelBackendParams) GetPid() int32 {
 	if x != nil {
-		return x.Port
+		return x.Pid
 	}
 	return 0
 }
 
-func (x *StartJobRequest_MySQLBackup) GetSocket() string {
+func (x *StartActionRequest_PostgreSQLCancelBackendParams) GetQueryStart() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Socket
+		return x.QueryStart
 	}
-	return ""
+	return nil
 }
 
-func (x *StartJobRequest_MySQLBackup) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+// RestartSystemServiceParams describes an action request to restart a systemctl service on a node.
+type StartActionRequest_RestartSystemServiceParams struct {
+	state         protoimpl.MessageState                                      `protogen:"open.v1"`
+	SystemService StartActionRequest_RestartSystemServiceParams_SystemService `protobuf:"varint,1,opt,name=system_service,json=systemService,proto3,enum=agent.v1.StartActionRequest_RestartSystemServiceParams_SystemService" json:"system_service,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StartJobRequest_MySQLBackup) GetFolder() string {
-	if x != nil {
-		return x.Folder
-	}
-	return ""
+func (x *StartActionRequest_RestartSystemServiceParams) Reset() {
+	*x = StartActionRequest_RestartSystemServiceParams{}
+	mi := &file_agent_v1_agent_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *StartJobRequest_MySQLBackup) GetLocationConfig() isStartJobRequest_MySQLBackup_LocationConfig {
-	if x != nil {
-		return x.LocationConfig
-	}
-	return nil
+func (x *StartActionRequest_RestartSystemServiceParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *StartJobRequest_MySQLBackup) GetS3Config() *S3LocationConfig {
+func (*StartActionRequest_RestartSystemServiceParams) ProtoMessage() {}
+
+func (x *StartActionRequest_RestartSystemServiceParams) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartActionRequest_RestartSystemServiceParams.ProtoReflect.Descriptor instead.
+func (*StartActionRequest_RestartSystemServiceParams) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{14, 23}
+}
+
+func (x *StartActionRequest_RestartSystemServiceParams) GetSystemService() StartActionRequest_RestartSystemServiceParams_SystemService {
+	if x != nil {
+		return x.SystemService
+	}
+	return StartActionRequest_RestartSystemServiceParams_SYSTEM_SERVICE_UNSPECIFIED
+}
+
+// Stats contains various Service statistics.
+type CheckConnectionResponse_Stats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A number of tables, 0 if unknown.
+	TableCount    int32 `protobuf:"varint,1,opt,name=table_count,json=tableCount,proto3" json:"table_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckConnectionResponse_Stats) Reset() {
+	*x = CheckConnectionResponse_Stats{}
+	mi := &file_agent_v1_agent_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckConnectionResponse_Stats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckConnectionResponse_Stats) ProtoMessage() {}
+
+func (x *CheckConnectionResponse_Stats) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckConnectionResponse_Stats.ProtoReflect.Descriptor instead.
+func (*CheckConnectionResponse_Stats) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{25, 0}
+}
+
+func (x *CheckConnectionResponse_Stats) GetTableCount() int32 {
+	if x != nil {
+		return x.TableCount
+	}
+	return 0
+}
+
+// MySQLBackup is job for backup MySQL service.
+type StartJobRequest_MySQLBackup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Database user;
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Database password.
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Database address. Can't be specified with socket.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// Database port. Can't be specified with socket.
+	Port int32 `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	// Database unix socket. Can't be specified with address/port.
+	Socket string `protobuf:"bytes,5,opt,name=socket,proto3" json:"socket,omitempty"`
+	// Backup name.
+	Name string `protobuf:"bytes,6,opt,name=name,proto3" json:"name,omitempty"`
+	// Folder to store artifact on a storage.
+	Folder string `protobuf:"bytes,7,opt,name=folder,proto3" json:"folder,omitempty"`
+	// If true, snapshot is stored as a part of Point-in-Time recovery artifact.
+	EnablePitr bool `protobuf:"varint,8,opt,name=enable_pitr,json=enablePitr,proto3" json:"enable_pitr,omitempty"`
+	// Backup target location.
+	//
+	// Types that are valid to be assigned to LocationConfig:
+	//
+	//	*StartJobRequest_MySQLBackup_S3Config
+	LocationConfig isStartJobRequest_MySQLBackup_LocationConfig `protobuf_oneof:"location_config"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartJobRequest_MySQLBackup) Reset() {
+	*x = StartJobRequest_MySQLBackup{}
+	mi := &file_agent_v1_agent_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartJobRequest_MySQLBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartJobRequest_MySQLBackup) ProtoMessage() {}
+
+func (x *StartJobRequest_MySQLBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartJobRequest_MySQLBackup.ProtoReflect.Descriptor instead.
+func (*StartJobRequest_MySQLBackup) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{32, 0}
+}
+
+func (x *StartJobRequest_MySQLBackup) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLBackup) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLBackup) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLBackup) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *StartJobRequest_MySQLBackup) GetSocket() string {
+	if x != nil {
+		return x.Socket
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLBackup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLBackup) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLBackup) GetEnablePitr() bool {
+	if x != nil {
+		return x.EnablePitr
+	}
+	return false
+}
+
+func (x *StartJobRequest_MySQLBackup) GetLocationConfig() isStartJobRequest_MySQLBackup_LocationConfig {
+	if x != nil {
+		return x.LocationConfig
+	}
+	return nil
+}
+
+func (x *StartJobRequest_MySQLBackup) GetS3Config() *S3LocationConfig {
 	if x != nil {
 		if x, ok := x.LocationConfig.(*StartJobRequest_MySQLBackup_S3Config); ok {
 			return x.S3Config
@@ -5481,6 +6140,20 @@ type StartJobRequest_MySQLRestoreBackup struct {
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	// Folder to store artifact on a storage.
 	Folder string `protobuf:"bytes,3,opt,name=folder,proto3" json:"folder,omitempty"`
+	// Point-in-Time recovery timestamp.
+	PitrTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=pitr_timestamp,json=pitrTimestamp,proto3" json:"pitr_timestamp,omitempty"`
+	// Folder with binary logs on a storage, required for Point-in-Time recovery.
+	BinlogFolder string `protobuf:"bytes,5,opt,name=binlog_folder,json=binlogFolder,proto3" json:"binlog_folder,omitempty"`
+	// Database user, used for applying binary logs.
+	User string `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	// Database password, used for applying binary logs.
+	Password string `protobuf:"bytes,7,opt,name=password,proto3" json:"password,omitempty"`
+	// Database address. Can't be specified with socket.
+	Address string `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	// Database port. Can't be specified with socket.
+	Port int32 `protobuf:"varint,9,opt,name=port,proto3" json:"port,omitempty"`
+	// Database unix socket. Can't be specified with address/port.
+	Socket string `protobuf:"bytes,12,opt,name=socket,proto3" json:"socket,omitempty"`
 	// Where backup is stored.
 	//
 	// Types that are valid to be assigned to LocationConfig:
@@ -5493,7 +6166,7 @@ type StartJobRequest_MySQLRestoreBackup struct {
 
 func (x *StartJobRequest_MySQLRestoreBackup) Reset() {
 	*x = StartJobRequest_MySQLRestoreBackup{}
-	mi := &file_agent_v1_agent_proto_msgTypes[73]
+	mi := &file_agent_v1_agent_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5505,7 +6178,7 @@ func (x *StartJobRequest_MySQLRestoreBackup) String() string {
 func (*StartJobRequest_MySQLRestoreBackup) ProtoMessage() {}
 
 func (x *StartJobRequest_MySQLRestoreBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[73]
+	mi := &file_agent_v1_agent_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5542,6 +6215,55 @@ func (x *StartJobRequest_MySQLRestoreBackup) GetFolder() string {
 	return ""
 }
 
+func (x *StartJobRequest_MySQLRestoreBackup) GetPitrTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PitrTimestamp
+	}
+	return nil
+}
+
+func (x *StartJobRequest_MySQLRestoreBackup) GetBinlogFolder() string {
+	if x != nil {
+		return x.BinlogFolder
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLRestoreBackup) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLRestoreBackup) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLRestoreBackup) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLRestoreBackup) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *StartJobRequest_MySQLRestoreBackup) GetSocket() string {
+	if x != nil {
+		return x.Socket
+	}
+	return ""
+}
+
 func (x *StartJobRequest_MySQLRestoreBackup) GetLocationConfig() isStartJobRequest_MySQLRestoreBackup_LocationConfig {
 	if x != nil {
 		return x.LocationConfig
@@ -5598,7 +6320,7 @@ type StartJobRequest_MongoDBBackup struct {
 
 func (x *StartJobRequest_MongoDBBackup) Reset() {
 	*x = StartJobRequest_MongoDBBackup{}
-	mi := &file_agent_v1_agent_proto_msgTypes[74]
+	mi := &file_agent_v1_agent_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5610,7 +6332,7 @@ func (x *StartJobRequest_MongoDBBackup) String() string {
 func (*StartJobRequest_MongoDBBackup) ProtoMessage() {}
 
 func (x *StartJobRequest_MongoDBBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[74]
+	mi := &file_agent_v1_agent_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5739,7 +6461,7 @@ type StartJobRequest_MongoDBRestoreBackup struct {
 
 func (x *StartJobRequest_MongoDBRestoreBackup) Reset() {
 	*x = StartJobRequest_MongoDBRestoreBackup{}
-	mi := &file_agent_v1_agent_proto_msgTypes[75]
+	mi := &file_agent_v1_agent_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -5751,7 +6473,7 @@ func (x *StartJobRequest_MongoDBRestoreBackup) String() string {
 func (*StartJobRequest_MongoDBRestoreBackup) ProtoMessage() {}
 
 func (x *StartJobRequest_MongoDBRestoreBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[75]
+	mi := &file_agent_v1_agent_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5852,29 +6574,841 @@ func (*StartJobRequest_MongoDBRestoreBackup_S3Config) isStartJobRequest_MongoDBR
 func (*StartJobRequest_MongoDBRestoreBackup_FilesystemConfig) isStartJobRequest_MongoDBRestoreBackup_LocationConfig() {
 }
 
-// Error contains job error message.
-type JobResult_Error struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *JobResult_Error) Reset() {
-	*x = JobResult_Error{}
-	mi := &file_agent_v1_agent_proto_msgTypes[76]
+// PostgreSQLBackup is job for backup PostgreSQL service.
+type StartJobRequest_PostgreSQLBackup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// DSN for the PostgreSQL service.
+	// May contain placeholders for file paths in DSN.
+	Dsn string `protobuf:"bytes,1,opt,name=dsn,proto3" json:"dsn,omitempty"`
+	// Contains files and their contents which can be used in DSN.
+	TextFiles *TextFiles `protobuf:"bytes,2,opt,name=text_files,json=textFiles,proto3" json:"text_files,omitempty"`
+	// Backup name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Folder to store artifact on a storage.
+	Folder string `protobuf:"bytes,4,opt,name=folder,proto3" json:"folder,omitempty"`
+	// Backup data model: physical (pg_basebackup) or logical (pg_dump).
+	DataModel v11.DataModel `protobuf:"varint,5,opt,name=data_model,json=dataModel,proto3,enum=backup.v1.DataModel" json:"data_model,omitempty"`
+	// Backup target location.
+	//
+	// Types that are valid to be assigned to LocationConfig:
+	//
+	//	*StartJobRequest_PostgreSQLBackup_S3Config
+	//	*StartJobRequest_PostgreSQLBackup_FilesystemConfig
+	LocationConfig isStartJobRequest_PostgreSQLBackup_LocationConfig `protobuf_oneof:"location_config"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartJobRequest_PostgreSQLBackup) Reset() {
+	*x = StartJobRequest_PostgreSQLBackup{}
+	mi := &file_agent_v1_agent_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartJobRequest_PostgreSQLBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartJobRequest_PostgreSQLBackup) ProtoMessage() {}
+
+func (x *StartJobRequest_PostgreSQLBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartJobRequest_PostgreSQLBackup.ProtoReflect.Descriptor instead.
+func (*StartJobRequest_PostgreSQLBackup) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{32, 4}
+}
+
+func (x *StartJobRequest_PostgreSQLBackup) GetDsn() string {
+	if x != nil {
+		return x.Dsn
+	}
+	return ""
+}
+
+func (x *StartJobRequest_PostgreSQLBackup) GetTextFiles() *TextFiles {
+	if x != nil {
+		return x.TextFiles
+	}
+	return nil
+}
+
+func (x *StartJobRequest_PostgreSQLBackup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartJobRequest_PostgreSQLBackup) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *StartJobRequest_PostgreSQLBackup) GetDataModel() v11.DataModel {
+	if x != nil {
+		return x.DataModel
+	}
+	return v11.DataModel(0)
+}
+
+func (x *StartJobRequest_PostgreSQLBackup) GetLocationConfig() isStartJobRequest_PostgreSQLBackup_LocationConfig {
+	if x != nil {
+		return x.LocationConfig
+	}
+	return nil
+}
+
+func (x *StartJobRequest_PostgreSQLBackup) GetS3Config() *S3LocationConfig {
+	if x != nil {
+		if x, ok := x.LocationConfig.(*StartJobRequest_PostgreSQLBackup_S3Config); ok {
+			return x.S3Config
+		}
+	}
+	return nil
+}
+
+func (x *StartJobRequest_PostgreSQLBackup) GetFilesystemConfig() *FilesystemLocationConfig {
+	if x != nil {
+		if x, ok := x.LocationConfig.(*StartJobRequest_PostgreSQLBackup_FilesystemConfig); ok {
+			return x.FilesystemConfig
+		}
+	}
+	return nil
+}
+
+type isStartJobRequest_PostgreSQLBackup_LocationConfig interface {
+	isStartJobRequest_PostgreSQLBackup_LocationConfig()
+}
+
+type StartJobRequest_PostgreSQLBackup_S3Config struct {
+	S3Config *S3LocationConfig `protobuf:"bytes,10,opt,name=s3_config,json=s3Config,proto3,oneof"`
+}
+
+type StartJobRequest_PostgreSQLBackup_FilesystemConfig struct {
+	FilesystemConfig *FilesystemLocationConfig `protobuf:"bytes,11,opt,name=filesystem_config,json=filesystemConfig,proto3,oneof"`
+}
+
+func (*StartJobRequest_PostgreSQLBackup_S3Config) isStartJobRequest_PostgreSQLBackup_LocationConfig() {
+}
+
+func (*StartJobRequest_PostgreSQLBackup_FilesystemConfig) isStartJobRequest_PostgreSQLBackup_LocationConfig() {
+}
+
+// PostgreSQLRestoreBackup is job for PostgreSQL restore backup service.
+type StartJobRequest_PostgreSQLRestoreBackup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// DSN for the PostgreSQL service.
+	// May contain placeholders for file paths in DSN.
+	Dsn string `protobuf:"bytes,1,opt,name=dsn,proto3" json:"dsn,omitempty"`
+	// Contains files and their contents which can be used in DSN.
+	TextFiles *TextFiles `protobuf:"bytes,2,opt,name=text_files,json=textFiles,proto3" json:"text_files,omitempty"`
+	// Backup name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Folder to store artifact on a storage.
+	Folder string `protobuf:"bytes,4,opt,name=folder,proto3" json:"folder,omitempty"`
+	// Backup data model: physical (pg_basebackup) or logical (pg_dump).
+	DataModel v11.DataModel `protobuf:"varint,5,opt,name=data_model,json=dataModel,proto3,enum=backup.v1.DataModel" json:"data_model,omitempty"`
+	// Where backup is stored.
+	//
+	// Types that are valid to be assigned to LocationConfig:
+	//
+	//	*StartJobRequest_PostgreSQLRestoreBackup_S3Config
+	//	*StartJobRequest_PostgreSQLRestoreBackup_FilesystemConfig
+	LocationConfig isStartJobRequest_PostgreSQLRestoreBackup_LocationConfig `protobuf_oneof:"location_config"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartJobRequest_PostgreSQLRestoreBackup) Reset() {
+	*x = StartJobRequest_PostgreSQLRestoreBackup{}
+	mi := &file_agent_v1_agent_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartJobRequest_PostgreSQLRestoreBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartJobRequest_PostgreSQLRestoreBackup) ProtoMessage() {}
+
+func (x *StartJobRequest_PostgreSQLRestoreBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartJobRequest_PostgreSQLRestoreBackup.ProtoReflect.Descriptor instead.
+func (*StartJobRequest_PostgreSQLRestoreBackup) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{32, 5}
+}
+
+func (x *StartJobRequest_PostgreSQLRestoreBackup) GetDsn() string {
+	if x != nil {
+		return x.Dsn
+	}
+	return ""
+}
+
+func (x *StartJobRequest_PostgreSQLRestoreBackup) GetTextFiles() *TextFiles {
+	if x != nil {
+		return x.TextFiles
+	}
+	return nil
+}
+
+func (x *StartJobRequest_PostgreSQLRestoreBackup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartJobRequest_PostgreSQLRestoreBackup) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *StartJobRequest_PostgreSQLRestoreBackup) GetDataModel() v11.DataModel {
+	if x != nil {
+		return x.DataModel
+	}
+	return v11.DataModel(0)
+}
+
+func (x *StartJobRequest_PostgreSQLRestoreBackup) GetLocationConfig() isStartJobRequest_PostgreSQLRestoreBackup_LocationConfig {
+	if x != nil {
+		return x.LocationConfig
+	}
+	return nil
+}
+
+func (x *StartJobRequest_PostgreSQLRestoreBackup) GetS3Config() *S3LocationConfig {
+	if x != nil {
+		if x, ok := x.LocationConfig.(*StartJobRequest_PostgreSQLRestoreBackup_S3Config); ok {
+			return x.S3Config
+		}
+	}
+	return nil
+}
+
+func (x *StartJobRequest_PostgreSQLRestoreBackup) GetFilesystemConfig() *FilesystemLocationConfig {
+	if x != nil {
+		if x, ok := x.LocationConfig.(*StartJobRequest_PostgreSQLRestoreBackup_FilesystemConfig); ok {
+			return x.FilesystemConfig
+		}
+	}
+	return nil
+}
+
+type isStartJobRequest_PostgreSQLRestoreBackup_LocationConfig interface {
+	isStartJobRequest_PostgreSQLRestoreBackup_LocationConfig()
+}
+
+type StartJobRequest_PostgreSQLRestoreBackup_S3Config struct {
+	S3Config *S3LocationConfig `protobuf:"bytes,10,opt,name=s3_config,json=s3Config,proto3,oneof"`
+}
+
+type StartJobRequest_PostgreSQLRestoreBackup_FilesystemConfig struct {
+	FilesystemConfig *FilesystemLocationConfig `protobuf:"bytes,11,opt,name=filesystem_config,json=filesystemConfig,proto3,oneof"`
+}
+
+func (*StartJobRequest_PostgreSQLRestoreBackup_S3Config) isStartJobRequest_PostgreSQLRestoreBackup_LocationConfig() {
+}
+
+func (*StartJobRequest_PostgreSQLRestoreBackup_FilesystemConfig) isStartJobRequest_PostgreSQLRestoreBackup_LocationConfig() {
+}
+
+// MySQLVerifyBackup is job for verifying MySQL backup artifact.
+type StartJobRequest_MySQLVerifyBackup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Backup name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Folder where artifact is stored on a storage.
+	Folder string `protobuf:"bytes,2,opt,name=folder,proto3" json:"folder,omitempty"`
+	// Where backup is stored.
+	//
+	// Types that are valid to be assigned to LocationConfig:
+	//
+	//	*StartJobRequest_MySQLVerifyBackup_S3Config
+	LocationConfig isStartJobRequest_MySQLVerifyBackup_LocationConfig `protobuf_oneof:"location_config"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartJobRequest_MySQLVerifyBackup) Reset() {
+	*x = StartJobRequest_MySQLVerifyBackup{}
+	mi := &file_agent_v1_agent_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartJobRequest_MySQLVerifyBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartJobRequest_MySQLVerifyBackup) ProtoMessage() {}
+
+func (x *StartJobRequest_MySQLVerifyBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartJobRequest_MySQLVerifyBackup.ProtoReflect.Descriptor instead.
+func (*StartJobRequest_MySQLVerifyBackup) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{32, 6}
+}
+
+func (x *StartJobRequest_MySQLVerifyBackup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLVerifyBackup) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLVerifyBackup) GetLocationConfig() isStartJobRequest_MySQLVerifyBackup_LocationConfig {
+	if x != nil {
+		return x.LocationConfig
+	}
+	return nil
+}
+
+func (x *StartJobRequest_MySQLVerifyBackup) GetS3Config() *S3LocationConfig {
+	if x != nil {
+		if x, ok := x.LocationConfig.(*StartJobRequest_MySQLVerifyBackup_S3Config); ok {
+			return x.S3Config
+		}
+	}
+	return nil
+}
+
+type isStartJobRequest_MySQLVerifyBackup_LocationConfig interface {
+	isStartJobRequest_MySQLVerifyBackup_LocationConfig()
+}
+
+type StartJobRequest_MySQLVerifyBackup_S3Config struct {
+	S3Config *S3LocationConfig `protobuf:"bytes,10,opt,name=s3_config,json=s3Config,proto3,oneof"`
+}
+
+func (*StartJobRequest_MySQLVerifyBackup_S3Config) isStartJobRequest_MySQLVerifyBackup_LocationConfig() {
+}
+
+// MongoDBVerifyBackup is job for verifying MongoDB backup artifact.
+type StartJobRequest_MongoDBVerifyBackup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Backup name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Folder where artifact is stored on a storage.
+	Folder string `protobuf:"bytes,2,opt,name=folder,proto3" json:"folder,omitempty"`
+	// Extra data for backup tool.
+	PbmMetadata *v11.PbmMetadata `protobuf:"bytes,3,opt,name=pbm_metadata,json=pbmMetadata,proto3" json:"pbm_metadata,omitempty"`
+	// Where backup is stored.
+	//
+	// Types that are valid to be assigned to LocationConfig:
+	//
+	//	*StartJobRequest_MongoDBVerifyBackup_S3Config
+	//	*StartJobRequest_MongoDBVerifyBackup_FilesystemConfig
+	LocationConfig isStartJobRequest_MongoDBVerifyBackup_LocationConfig `protobuf_oneof:"location_config"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartJobRequest_MongoDBVerifyBackup) Reset() {
+	*x = StartJobRequest_MongoDBVerifyBackup{}
+	mi := &file_agent_v1_agent_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartJobRequest_MongoDBVerifyBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartJobRequest_MongoDBVerifyBackup) ProtoMessage() {}
+
+func (x *StartJobRequest_MongoDBVerifyBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartJobRequest_MongoDBVerifyBackup.ProtoReflect.Descriptor instead.
+func (*StartJobRequest_MongoDBVerifyBackup) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{32, 7}
+}
+
+func (x *StartJobRequest_MongoDBVerifyBackup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MongoDBVerifyBackup) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MongoDBVerifyBackup) GetPbmMetadata() *v11.PbmMetadata {
+	if x != nil {
+		return x.PbmMetadata
+	}
+	return nil
+}
+
+func (x *StartJobRequest_MongoDBVerifyBackup) GetLocationConfig() isStartJobRequest_MongoDBVerifyBackup_LocationConfig {
+	if x != nil {
+		return x.LocationConfig
+	}
+	return nil
+}
+
+func (x *StartJobRequest_MongoDBVerifyBackup) GetS3Config() *S3LocationConfig {
+	if x != nil {
+		if x, ok := x.LocationConfig.(*StartJobRequest_MongoDBVerifyBackup_S3Config); ok {
+			return x.S3Config
+		}
+	}
+	return nil
+}
+
+func (x *StartJobRequest_MongoDBVerifyBackup) GetFilesystemConfig() *FilesystemLocationConfig {
+	if x != nil {
+		if x, ok := x.LocationConfig.(*StartJobRequest_MongoDBVerifyBackup_FilesystemConfig); ok {
+			return x.FilesystemConfig
+		}
+	}
+	return nil
+}
+
+type isStartJobRequest_MongoDBVerifyBackup_LocationConfig interface {
+	isStartJobRequest_MongoDBVerifyBackup_LocationConfig()
+}
+
+type StartJobRequest_MongoDBVerifyBackup_S3Config struct {
+	S3Config *S3LocationConfig `protobuf:"bytes,10,opt,name=s3_config,json=s3Config,proto3,oneof"`
+}
+
+type StartJobRequest_MongoDBVerifyBackup_FilesystemConfig struct {
+	FilesystemConfig *FilesystemLocationConfig `protobuf:"bytes,11,opt,name=filesystem_config,json=filesystemConfig,proto3,oneof"`
+}
+
+func (*StartJobRequest_MongoDBVerifyBackup_S3Config) isStartJobRequest_MongoDBVerifyBackup_LocationConfig() {
+}
+
+func (*StartJobRequest_MongoDBVerifyBackup_FilesystemConfig) isStartJobRequest_MongoDBVerifyBackup_LocationConfig() {
+}
+
+// MySQLBinlogStream is job for continuous streaming of MySQL binary logs to a storage.
+type StartJobRequest_MySQLBinlogStream struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Database user.
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Database password.
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// Database address. Can't be specified with socket.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// Database port. Can't be specified with socket.
+	Port int32 `protobuf:"varint,4,opt,name=port,proto3" json:"port,omitempty"`
+	// Database unix socket. Can't be specified with address/port.
+	Socket string `protobuf:"bytes,5,opt,name=socket,proto3" json:"socket,omitempty"`
+	// Folder to store binary logs on a storage.
+	Folder string `protobuf:"bytes,6,opt,name=folder,proto3" json:"folder,omitempty"`
+	// Where binary logs are stored.
+	//
+	// Types that are valid to be assigned to LocationConfig:
+	//
+	//	*StartJobRequest_MySQLBinlogStream_S3Config
+	LocationConfig isStartJobRequest_MySQLBinlogStream_LocationConfig `protobuf_oneof:"location_config"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *StartJobRequest_MySQLBinlogStream) Reset() {
+	*x = StartJobRequest_MySQLBinlogStream{}
+	mi := &file_agent_v1_agent_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartJobRequest_MySQLBinlogStream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartJobRequest_MySQLBinlogStream) ProtoMessage() {}
+
+func (x *StartJobRequest_MySQLBinlogStream) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartJobRequest_MySQLBinlogStream.ProtoReflect.Descriptor instead.
+func (*StartJobRequest_MySQLBinlogStream) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{32, 8}
+}
+
+func (x *StartJobRequest_MySQLBinlogStream) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLBinlogStream) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLBinlogStream) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLBinlogStream) GetPort() int32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *StartJobRequest_MySQLBinlogStream) GetSocket() string {
+	if x != nil {
+		return x.Socket
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLBinlogStream) GetFolder() string {
+	if x != nil {
+		return x.Folder
+	}
+	return ""
+}
+
+func (x *StartJobRequest_MySQLBinlogStream) GetLocationConfig() isStartJobRequest_MySQLBinlogStream_LocationConfig {
+	if x != nil {
+		return x.LocationConfig
+	}
+	return nil
+}
+
+func (x *StartJobRequest_MySQLBinlogStream) GetS3Config() *S3LocationConfig {
+	if x != nil {
+		if x, ok := x.LocationConfig.(*StartJobRequest_MySQLBinlogStream_S3Config); ok {
+			return x.S3Config
+		}
+	}
+	return nil
+}
+
+type isStartJobRequest_MySQLBinlogStream_LocationConfig interface {
+	isStartJobRequest_MySQLBinlogStream_LocationConfig()
+}
+
+type StartJobRequest_MySQLBinlogStream_S3Config struct {
+	S3Config *S3LocationConfig `protobuf:"bytes,10,opt,name=s3_config,json=s3Config,proto3,oneof"`
+}
+
+func (*StartJobRequest_MySQLBinlogStream_S3Config) isStartJobRequest_MySQLBinlogStream_LocationConfig() {
+}
+
+// Error contains job error message.
+type JobResult_Error struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Message       string                 `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobResult_Error) Reset() {
+	*x = JobResult_Error{}
+	mi := &file_agent_v1_agent_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobResult_Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobResult_Error) ProtoMessage() {}
+
+func (x *JobResult_Error) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobResult_Error.ProtoReflect.Descriptor instead.
+func (*JobResult_Error) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{36, 0}
+}
+
+func (x *JobResult_Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// MongoDBBackup contains result for MongoDB backup job.
+type JobResult_MongoDBBackup struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	IsShardedCluster bool                   `protobuf:"varint,1,opt,name=is_sharded_cluster,json=isShardedCluster,proto3" json:"is_sharded_cluster,omitempty"`
+	// Contains additional data describing artifact.
+	Metadata      *v11.Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobResult_MongoDBBackup) Reset() {
+	*x = JobResult_MongoDBBackup{}
+	mi := &file_agent_v1_agent_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobResult_MongoDBBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobResult_MongoDBBackup) ProtoMessage() {}
+
+func (x *JobResult_MongoDBBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobResult_MongoDBBackup.ProtoReflect.Descriptor instead.
+func (*JobResult_MongoDBBackup) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{36, 1}
+}
+
+func (x *JobResult_MongoDBBackup) GetIsShardedCluster() bool {
+	if x != nil {
+		return x.IsShardedCluster
+	}
+	return false
+}
+
+func (x *JobResult_MongoDBBackup) GetMetadata() *v11.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// MySQLBackup contains result for MySQL backup job.
+type JobResult_MySQLBackup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Contains additional data describing artifact.
+	Metadata      *v11.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobResult_MySQLBackup) Reset() {
+	*x = JobResult_MySQLBackup{}
+	mi := &file_agent_v1_agent_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobResult_MySQLBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobResult_MySQLBackup) ProtoMessage() {}
+
+func (x *JobResult_MySQLBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobResult_MySQLBackup.ProtoReflect.Descriptor instead.
+func (*JobResult_MySQLBackup) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{36, 2}
+}
+
+func (x *JobResult_MySQLBackup) GetMetadata() *v11.Metadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+// MySQLRestoreBackup contains result for MySQL restore backup job.
+type JobResult_MySQLRestoreBackup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobResult_MySQLRestoreBackup) Reset() {
+	*x = JobResult_MySQLRestoreBackup{}
+	mi := &file_agent_v1_agent_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobResult_MySQLRestoreBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobResult_MySQLRestoreBackup) ProtoMessage() {}
+
+func (x *JobResult_MySQLRestoreBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobResult_MySQLRestoreBackup.ProtoReflect.Descriptor instead.
+func (*JobResult_MySQLRestoreBackup) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{36, 3}
+}
+
+// MongoDBRestoreBackup contains result for MongoDB restore backup job.
+type JobResult_MongoDBRestoreBackup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobResult_MongoDBRestoreBackup) Reset() {
+	*x = JobResult_MongoDBRestoreBackup{}
+	mi := &file_agent_v1_agent_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JobResult_MongoDBRestoreBackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobResult_MongoDBRestoreBackup) ProtoMessage() {}
+
+func (x *JobResult_MongoDBRestoreBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobResult_MongoDBRestoreBackup.ProtoReflect.Descriptor instead.
+func (*JobResult_MongoDBRestoreBackup) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{36, 4}
+}
+
+// PostgreSQLBackup contains result for PostgreSQL backup job.
+type JobResult_PostgreSQLBackup struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Contains additional data describing artifact.
+	Metadata      *v11.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JobResult_PostgreSQLBackup) Reset() {
+	*x = JobResult_PostgreSQLBackup{}
+	mi := &file_agent_v1_agent_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JobResult_Error) String() string {
+func (x *JobResult_PostgreSQLBackup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobResult_Error) ProtoMessage() {}
+func (*JobResult_PostgreSQLBackup) ProtoMessage() {}
 
-func (x *JobResult_Error) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[76]
+func (x *JobResult_PostgreSQLBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5885,43 +7419,40 @@ func (x *JobResult_Error) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobResult_Error.ProtoReflect.Descriptor instead.
-func (*JobResult_Error) Descriptor() ([]byte, []int) {
-	return file_agent_v1_agent_proto_rawDescGZIP(), []int{36, 0}
+// Deprecated: Use JobResult_PostgreSQLBackup.ProtoReflect.Descriptor instead.
+func (*JobResult_PostgreSQLBackup) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{36, 5}
 }
 
-func (x *JobResult_Error) GetMessage() string {
+func (x *JobResult_PostgreSQLBackup) GetMetadata() *v11.Metadata {
 	if x != nil {
-		return x.Message
+		return x.Metadata
 	}
-	return ""
+	return nil
 }
 
-// MongoDBBackup contains result for MongoDB backup job.
-type JobResult_MongoDBBackup struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	IsShardedCluster bool                   `protobuf:"varint,1,opt,name=is_sharded_cluster,json=isShardedCluster,proto3" json:"is_sharded_cluster,omitempty"`
-	// Contains additional data describing artifact.
-	Metadata      *v11.Metadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+// PostgreSQLRestoreBackup contains result for PostgreSQL restore backup job.
+type JobResult_PostgreSQLRestoreBackup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JobResult_MongoDBBackup) Reset() {
-	*x = JobResult_MongoDBBackup{}
-	mi := &file_agent_v1_agent_proto_msgTypes[77]
+func (x *JobResult_PostgreSQLRestoreBackup) Reset() {
+	*x = JobResult_PostgreSQLRestoreBackup{}
+	mi := &file_agent_v1_agent_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JobResult_MongoDBBackup) String() string {
+func (x *JobResult_PostgreSQLRestoreBackup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobResult_MongoDBBackup) ProtoMessage() {}
+func (*JobResult_PostgreSQLRestoreBackup) ProtoMessage() {}
 
-func (x *JobResult_MongoDBBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[77]
+func (x *JobResult_PostgreSQLRestoreBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5932,49 +7463,33 @@ func (x *JobResult_MongoDBBackup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobResult_MongoDBBackup.ProtoReflect.Descriptor instead.
-func (*JobResult_MongoDBBackup) Descriptor() ([]byte, []int) {
-	return file_agent_v1_agent_proto_rawDescGZIP(), []int{36, 1}
-}
-
-func (x *JobResult_MongoDBBackup) GetIsShardedCluster() bool {
-	if x != nil {
-		return x.IsShardedCluster
-	}
-	return false
-}
-
-func (x *JobResult_MongoDBBackup) GetMetadata() *v11.Metadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
+// Deprecated: Use JobResult_PostgreSQLRestoreBackup.ProtoReflect.Descriptor instead.
+func (*JobResult_PostgreSQLRestoreBackup) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{36, 6}
 }
 
-// MySQLBackup contains result for MySQL backup job.
-type JobResult_MySQLBackup struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Contains additional data describing artifact.
-	Metadata      *v11.Metadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata,omitempty"`
+// MySQLVerifyBackup contains result for MySQL verify backup job.
+type JobResult_MySQLVerifyBackup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JobResult_MySQLBackup) Reset() {
-	*x = JobResult_MySQLBackup{}
-	mi := &file_agent_v1_agent_proto_msgTypes[78]
+func (x *JobResult_MySQLVerifyBackup) Reset() {
+	*x = JobResult_MySQLVerifyBackup{}
+	mi := &file_agent_v1_agent_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JobResult_MySQLBackup) String() string {
+func (x *JobResult_MySQLVerifyBackup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobResult_MySQLBackup) ProtoMessage() {}
+func (*JobResult_MySQLVerifyBackup) ProtoMessage() {}
 
-func (x *JobResult_MySQLBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[78]
+func (x *JobResult_MySQLVerifyBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5985,40 +7500,33 @@ func (x *JobResult_MySQLBackup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobResult_MySQLBackup.ProtoReflect.Descriptor instead.
-func (*JobResult_MySQLBackup) Descriptor() ([]byte, []int) {
-	return file_agent_v1_agent_proto_rawDescGZIP(), []int{36, 2}
-}
-
-func (x *JobResult_MySQLBackup) GetMetadata() *v11.Metadata {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
+// Deprecated: Use JobResult_MySQLVerifyBackup.ProtoReflect.Descriptor instead.
+func (*JobResult_MySQLVerifyBackup) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{36, 7}
 }
 
-// MySQLRestoreBackup contains result for MySQL restore backup job.
-type JobResult_MySQLRestoreBackup struct {
+// MongoDBVerifyBackup contains result for MongoDB verify backup job.
+type JobResult_MongoDBVerifyBackup struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JobResult_MySQLRestoreBackup) Reset() {
-	*x = JobResult_MySQLRestoreBackup{}
-	mi := &file_agent_v1_agent_proto_msgTypes[79]
+func (x *JobResult_MongoDBVerifyBackup) Reset() {
+	*x = JobResult_MongoDBVerifyBackup{}
+	mi := &file_agent_v1_agent_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JobResult_MySQLRestoreBackup) String() string {
+func (x *JobResult_MongoDBVerifyBackup) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobResult_MySQLRestoreBackup) ProtoMessage() {}
+func (*JobResult_MongoDBVerifyBackup) ProtoMessage() {}
 
-func (x *JobResult_MySQLRestoreBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[79]
+func (x *JobResult_MongoDBVerifyBackup) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6029,33 +7537,33 @@ func (x *JobResult_MySQLRestoreBackup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobResult_MySQLRestoreBackup.ProtoReflect.Descriptor instead.
-func (*JobResult_MySQLRestoreBackup) Descriptor() ([]byte, []int) {
-	return file_agent_v1_agent_proto_rawDescGZIP(), []int{36, 3}
+// Deprecated: Use JobResult_MongoDBVerifyBackup.ProtoReflect.Descriptor instead.
+func (*JobResult_MongoDBVerifyBackup) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{36, 8}
 }
 
-// MongoDBRestoreBackup contains result for MongoDB restore backup job.
-type JobResult_MongoDBRestoreBackup struct {
+// MySQLBinlogStream contains result for MySQL binlog streaming job, sent when streaming is stopped.
+type JobResult_MySQLBinlogStream struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *JobResult_MongoDBRestoreBackup) Reset() {
-	*x = JobResult_MongoDBRestoreBackup{}
-	mi := &file_agent_v1_agent_proto_msgTypes[80]
+func (x *JobResult_MySQLBinlogStream) Reset() {
+	*x = JobResult_MySQLBinlogStream{}
+	mi := &file_agent_v1_agent_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *JobResult_MongoDBRestoreBackup) String() string {
+func (x *JobResult_MySQLBinlogStream) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobResult_MongoDBRestoreBackup) ProtoMessage() {}
+func (*JobResult_MySQLBinlogStream) ProtoMessage() {}
 
-func (x *JobResult_MongoDBRestoreBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[80]
+func (x *JobResult_MySQLBinlogStream) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6066,9 +7574,9 @@ func (x *JobResult_MongoDBRestoreBackup) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobResult_MongoDBRestoreBackup.ProtoReflect.Descriptor instead.
-func (*JobResult_MongoDBRestoreBackup) Descriptor() ([]byte, []int) {
-	return file_agent_v1_agent_proto_rawDescGZIP(), []int{36, 4}
+// Deprecated: Use JobResult_MySQLBinlogStream.ProtoReflect.Descriptor instead.
+func (*JobResult_MySQLBinlogStream) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{36, 9}
 }
 
 // MySQLBackup contains backup job status update.
@@ -6080,7 +7588,7 @@ type JobProgress_MySQLBackup struct {
 
 func (x *JobProgress_MySQLBackup) Reset() {
 	*x = JobProgress_MySQLBackup{}
-	mi := &file_agent_v1_agent_proto_msgTypes[81]
+	mi := &file_agent_v1_agent_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6092,7 +7600,7 @@ func (x *JobProgress_MySQLBackup) String() string {
 func (*JobProgress_MySQLBackup) ProtoMessage() {}
 
 func (x *JobProgress_MySQLBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[81]
+	mi := &file_agent_v1_agent_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6117,7 +7625,7 @@ type JobProgress_MySQLRestoreBackup struct {
 
 func (x *JobProgress_MySQLRestoreBackup) Reset() {
 	*x = JobProgress_MySQLRestoreBackup{}
-	mi := &file_agent_v1_agent_proto_msgTypes[82]
+	mi := &file_agent_v1_agent_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6129,7 +7637,7 @@ func (x *JobProgress_MySQLRestoreBackup) String() string {
 func (*JobProgress_MySQLRestoreBackup) ProtoMessage() {}
 
 func (x *JobProgress_MySQLRestoreBackup) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[82]
+	mi := &file_agent_v1_agent_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6157,7 +7665,7 @@ type JobProgress_Logs struct {
 
 func (x *JobProgress_Logs) Reset() {
 	*x = JobProgress_Logs{}
-	mi := &file_agent_v1_agent_proto_msgTypes[83]
+	mi := &file_agent_v1_agent_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6169,7 +7677,7 @@ func (x *JobProgress_Logs) String() string {
 func (*JobProgress_Logs) ProtoMessage() {}
 
 func (x *JobProgress_Logs) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[83]
+	mi := &file_agent_v1_agent_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6215,7 +7723,7 @@ type GetVersionsRequest_MySQLd struct {
 
 func (x *GetVersionsRequest_MySQLd) Reset() {
 	*x = GetVersionsRequest_MySQLd{}
-	mi := &file_agent_v1_agent_proto_msgTypes[84]
+	mi := &file_agent_v1_agent_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6227,7 +7735,7 @@ func (x *GetVersionsRequest_MySQLd) String() string {
 func (*GetVersionsRequest_MySQLd) ProtoMessage() {}
 
 func (x *GetVersionsRequest_MySQLd) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[84]
+	mi := &file_agent_v1_agent_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6252,7 +7760,7 @@ type GetVersionsRequest_Xtrabackup struct {
 
 func (x *GetVersionsRequest_Xtrabackup) Reset() {
 	*x = GetVersionsRequest_Xtrabackup{}
-	mi := &file_agent_v1_agent_proto_msgTypes[85]
+	mi := &file_agent_v1_agent_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6264,7 +7772,7 @@ func (x *GetVersionsRequest_Xtrabackup) String() string {
 func (*GetVersionsRequest_Xtrabackup) ProtoMessage() {}
 
 func (x *GetVersionsRequest_Xtrabackup) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[85]
+	mi := &file_agent_v1_agent_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6289,7 +7797,7 @@ type GetVersionsRequest_Xbcloud struct {
 
 func (x *GetVersionsRequest_Xbcloud) Reset() {
 	*x = GetVersionsRequest_Xbcloud{}
-	mi := &file_agent_v1_agent_proto_msgTypes[86]
+	mi := &file_agent_v1_agent_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6301,7 +7809,7 @@ func (x *GetVersionsRequest_Xbcloud) String() string {
 func (*GetVersionsRequest_Xbcloud) ProtoMessage() {}
 
 func (x *GetVersionsRequest_Xbcloud) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[86]
+	mi := &file_agent_v1_agent_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6326,7 +7834,7 @@ type GetVersionsRequest_Qpress struct {
 
 func (x *GetVersionsRequest_Qpress) Reset() {
 	*x = GetVersionsRequest_Qpress{}
-	mi := &file_agent_v1_agent_proto_msgTypes[87]
+	mi := &file_agent_v1_agent_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6338,7 +7846,7 @@ func (x *GetVersionsRequest_Qpress) String() string {
 func (*GetVersionsRequest_Qpress) ProtoMessage() {}
 
 func (x *GetVersionsRequest_Qpress) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[87]
+	mi := &file_agent_v1_agent_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6363,7 +7871,7 @@ type GetVersionsRequest_MongoDB struct {
 
 func (x *GetVersionsRequest_MongoDB) Reset() {
 	*x = GetVersionsRequest_MongoDB{}
-	mi := &file_agent_v1_agent_proto_msgTypes[88]
+	mi := &file_agent_v1_agent_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6375,7 +7883,7 @@ func (x *GetVersionsRequest_MongoDB) String() string {
 func (*GetVersionsRequest_MongoDB) ProtoMessage() {}
 
 func (x *GetVersionsRequest_MongoDB) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[88]
+	mi := &file_agent_v1_agent_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6400,7 +7908,7 @@ type GetVersionsRequest_PBM struct {
 
 func (x *GetVersionsRequest_PBM) Reset() {
 	*x = GetVersionsRequest_PBM{}
-	mi := &file_agent_v1_agent_proto_msgTypes[89]
+	mi := &file_agent_v1_agent_proto_msgTypes[111]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6412,7 +7920,7 @@ func (x *GetVersionsRequest_PBM) String() string {
 func (*GetVersionsRequest_PBM) ProtoMessage() {}
 
 func (x *GetVersionsRequest_PBM) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[89]
+	mi := &file_agent_v1_agent_proto_msgTypes[111]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6428,6 +7936,117 @@ func (*GetVersionsRequest_PBM) Descriptor() ([]byte, []int) {
 	return file_agent_v1_agent_proto_rawDescGZIP(), []int{38, 5}
 }
 
+// PGBasebackup is used for pg_basebackup binary version retrieving.
+type GetVersionsRequest_PGBasebackup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVersionsRequest_PGBasebackup) Reset() {
+	*x = GetVersionsRequest_PGBasebackup{}
+	mi := &file_agent_v1_agent_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVersionsRequest_PGBasebackup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionsRequest_PGBasebackup) ProtoMessage() {}
+
+func (x *GetVersionsRequest_PGBasebackup) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionsRequest_PGBasebackup.ProtoReflect.Descriptor instead.
+func (*GetVersionsRequest_PGBasebackup) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{38, 6}
+}
+
+// PGDump is used for pg_dump binary version retrieving.
+type GetVersionsRequest_PGDump struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVersionsRequest_PGDump) Reset() {
+	*x = GetVersionsRequest_PGDump{}
+	mi := &file_agent_v1_agent_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVersionsRequest_PGDump) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionsRequest_PGDump) ProtoMessage() {}
+
+func (x *GetVersionsRequest_PGDump) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionsRequest_PGDump.ProtoReflect.Descriptor instead.
+func (*GetVersionsRequest_PGDump) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{38, 7}
+}
+
+// PGRestore is used for pg_restore binary version retrieving.
+type GetVersionsRequest_PGRestore struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVersionsRequest_PGRestore) Reset() {
+	*x = GetVersionsRequest_PGRestore{}
+	mi := &file_agent_v1_agent_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVersionsRequest_PGRestore) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionsRequest_PGRestore) ProtoMessage() {}
+
+func (x *GetVersionsRequest_PGRestore) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_agent_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionsRequest_PGRestore.ProtoReflect.Descriptor instead.
+func (*GetVersionsRequest_PGRestore) Descriptor() ([]byte, []int) {
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{38, 8}
+}
+
 // Software is used to select software for which retrieve version.
 type GetVersionsRequest_Software struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -6439,6 +8058,9 @@ type GetVersionsRequest_Software struct {
 	//	*GetVersionsRequest_Software_Qpress
 	//	*GetVersionsRequest_Software_Mongod
 	//	*GetVersionsRequest_Software_Pbm
+	//	*GetVersionsRequest_Software_PgBasebackup
+	//	*GetVersionsRequest_Software_PgDump
+	//	*GetVersionsRequest_Software_PgRestore
 	Software      isGetVersionsRequest_Software_Software `protobuf_oneof:"software"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -6446,7 +8068,7 @@ type GetVersionsRequest_Software struct {
 
 func (x *GetVersionsRequest_Software) Reset() {
 	*x = GetVersionsRequest_Software{}
-	mi := &file_agent_v1_agent_proto_msgTypes[90]
+	mi := &file_agent_v1_agent_proto_msgTypes[115]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6458,7 +8080,7 @@ func (x *GetVersionsRequest_Software) String() string {
 func (*GetVersionsRequest_Software) ProtoMessage() {}
 
 func (x *GetVersionsRequest_Software) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[90]
+	mi := &file_agent_v1_agent_proto_msgTypes[115]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6471,7 +8093,7 @@ func (x *GetVersionsRequest_Software) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetVersionsRequest_Software.ProtoReflect.Descriptor instead.
 func (*GetVersionsRequest_Software) Descriptor() ([]byte, []int) {
-	return file_agent_v1_agent_proto_rawDescGZIP(), []int{38, 6}
+	return file_agent_v1_agent_proto_rawDescGZIP(), []int{38, 9}
 }
 
 func (x *GetVersionsRequest_Software) GetSoftware() isGetVersionsRequest_Software_Software {
@@ -6535,6 +8157,33 @@ func (x *GetVersionsRequest_Software) GetPbm() *GetVersionsRequest_PBM {
 	return nil
 }
 
+func (x *GetVersionsRequest_Software) GetPgBasebackup() *GetVersionsRequest_PGBasebackup {
+	if x != nil {
+		if x, ok := x.Software.(*GetVersionsRequest_Software_PgBasebackup); ok {
+			return x.PgBasebackup
+		}
+	}
+	return nil
+}
+
+func (x *GetVersionsRequest_Software) GetPgDump() *GetVersionsRequest_PGDump {
+	if x != nil {
+		if x, ok := x.Software.(*GetVersionsRequest_Software_PgDump); ok {
+			return x.PgDump
+		}
+	}
+	return nil
+}
+
+func (x *GetVersionsRequest_Software) GetPgRestore() *GetVersionsRequest_PGRestore {
+	if x != nil {
+		if x, ok := x.Software.(*GetVersionsRequest_Software_PgRestore); ok {
+			return x.PgRestore
+		}
+	}
+	return nil
+}
+
 type isGetVersionsRequest_Software_Software interface {
 	isGetVersionsRequest_Software_Software()
 }
@@ -6563,6 +8212,18 @@ type GetVersionsRequest_Software_Pbm struct {
 	Pbm *GetVersionsRequest_PBM `protobuf:"bytes,6,opt,name=pbm,proto3,oneof"`
 }
 
+type GetVersionsRequest_Software_PgBasebackup struct {
+	PgBasebackup *GetVersionsRequest_PGBasebackup `protobuf:"bytes,7,opt,name=pg_basebackup,json=pgBasebackup,proto3,oneof"`
+}
+
+type GetVersionsRequest_Software_PgDump struct {
+	PgDump *GetVersionsRequest_PGDump `protobuf:"bytes,8,opt,name=pg_dump,json=pgDump,proto3,oneof"`
+}
+
+type GetVersionsRequest_Software_PgRestore struct {
+	PgRestore *GetVersionsRequest_PGRestore `protobuf:"bytes,9,opt,name=pg_restore,json=pgRestore,proto3,oneof"`
+}
+
 func (*GetVersionsRequest_Software_Mysqld) isGetVersionsRequest_Software_Software() {}
 
 func (*GetVersionsRequest_Software_Xtrabackup) isGetVersionsRequest_Software_Software() {}
@@ -6575,6 +8236,12 @@ func (*GetVersionsRequest_Software_Mongod) isGetVersionsRequest_Software_Softwar
 
 func (*GetVersionsRequest_Software_Pbm) isGetVersionsRequest_Software_Software() {}
 
+func (*GetVersionsRequest_Software_PgBasebackup) isGetVersionsRequest_Software_Software() {}
+
+func (*GetVersionsRequest_Software_PgDump) isGetVersionsRequest_Software_Software() {}
+
+func (*GetVersionsRequest_Software_PgRestore) isGetVersionsRequest_Software_Software() {}
+
 // Version contains the version field of the requested software and
 // the error field which is set in case of version retrieving error.
 type GetVersionsResponse_Version struct {
@@ -6587,7 +8254,7 @@ type GetVersionsResponse_Version struct {
 
 func (x *GetVersionsResponse_Version) Reset() {
 	*x = GetVersionsResponse_Version{}
-	mi := &file_agent_v1_agent_proto_msgTypes[91]
+	mi := &file_agent_v1_agent_proto_msgTypes[116]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -6599,7 +8266,7 @@ func (x *GetVersionsResponse_Version) String() string {
 func (*GetVersionsResponse_Version) ProtoMessage() {}
 
 func (x *GetVersionsResponse_Version) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_v1_agent_proto_msgTypes[91]
+	mi := &file_agent_v1_agent_proto_msgTypes[116]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6655,10 +8322,10 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"listenPort\x12*\n" +
 	"\x11process_exec_path\x18\x04 \x01(\tR\x0fprocessExecPath\x12\x18\n" +
 	"\aversion\x18\x05 \x01(\tR\aversion\"\x16\n" +
-	"\x14StateChangedResponse\"\xd9\v\n" +
+	"\x14StateChangedResponse\"\xef\r\n" +
 	"\x0fSetStateRequest\x12V\n" +
 	"\x0fagent_processes\x18\x01 \x03(\v2-.agent.v1.SetStateRequest.AgentProcessesEntryR\x0eagentProcesses\x12S\n" +
-	"\x0ebuiltin_agents\x18\x02 \x03(\v2,.agent.v1.SetStateRequest.BuiltinAgentsEntryR\rbuiltinAgents\x1a\xba\x03\n" +
+	"\x0ebuiltin_agents\x18\x02 \x03(\v2,.agent.v1.SetStateRequest.BuiltinAgentsEntryR\rbuiltinAgents\x1a\xc5\x04\n" +
 	"\fAgentProcess\x12+\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x17.inventory.v1.AgentTypeR\x04type\x12.\n" +
 	"\x13template_left_delim\x18\x02 \x01(\tR\x11templateLeftDelim\x120\n" +
@@ -6668,13 +8335,17 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\n" +
 	"text_files\x18\x06 \x03(\v25.agent.v1.SetStateRequest.AgentProcess.TextFilesEntryB\x04\x88\xb5\x18\x02R\ttextFiles\x12'\n" +
 	"\fredact_words\x18\a \x03(\tB\x04\x88\xb5\x18\x01R\vredactWords\x12,\n" +
-	"\x12env_variable_names\x18\b \x03(\tR\x10envVariableNames\x1a<\n" +
+	"\x12env_variable_names\x18\b \x03(\tR\x10envVariableNames\x12M\n" +
+	"\asecrets\x18\t \x03(\v23.agent.v1.SetStateRequest.AgentProcess.SecretsEntryR\asecrets\x1a<\n" +
 	"\x0eTextFilesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a:\n" +
+	"\fSecretsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1ai\n" +
 	"\x13AgentProcessesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12<\n" +
-	"\x05value\x18\x02 \x01(\v2&.agent.v1.SetStateRequest.AgentProcessR\x05value:\x028\x01\x1a\x86\x05\n" +
+	"\x05value\x18\x02 \x01(\v2&.agent.v1.SetStateRequest.AgentProcessR\x05value:\x028\x01\x1a\x91\x06\n" +
 	"\fBuiltinAgent\x12+\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x17.inventory.v1.AgentTypeR\x04type\x12\x16\n" +
 	"\x03dsn\x18\x02 \x01(\tB\x04\x88\xb5\x18\x03R\x03dsn\x12(\n" +
@@ -6692,9 +8363,13 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"rtaOptions\x12\x1d\n" +
 	"\n" +
 	"service_id\x18\f \x01(\tR\tserviceId\x12!\n" +
-	"\fservice_name\x18\r \x01(\tR\vserviceName\x1a6\n" +
+	"\fservice_name\x18\r \x01(\tR\vserviceName\x12M\n" +
+	"\asecrets\x18\x0e \x03(\v23.agent.v1.SetStateRequest.BuiltinAgent.SecretsEntryR\asecrets\x1a6\n" +
 	"\bEnvEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a:\n" +
+	"\fSecretsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1ah\n" +
 	"\x12BuiltinAgentsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12<\n" +
@@ -6726,10 +8401,11 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\x11QueryActionResult\x12\x18\n" +
 	"\acolumns\x18\x01 \x03(\tR\acolumns\x12.\n" +
 	"\x04rows\x18\x02 \x03(\v2\x1a.agent.v1.QueryActionSliceR\x04rows\x12,\n" +
-	"\x04docs\x18\x03 \x03(\v2\x18.agent.v1.QueryActionMapR\x04docs\"\x9d-\n" +
+	"\x04docs\x18\x03 \x03(\v2\x18.agent.v1.QueryActionMapR\x04docs\"\xfb5\n" +
 	"\x12StartActionRequest\x12\x1b\n" +
 	"\taction_id\x18\x01 \x01(\tR\bactionId\x123\n" +
-	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12c\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12C\n" +
+	"\asecrets\x18\x03 \x03(\v2).agent.v1.StartActionRequest.SecretsEntryR\asecrets\x12c\n" +
 	"\x14mysql_explain_params\x18\n" +
 	" \x01(\v2/.agent.v1.StartActionRequest.MySQLExplainParamsH\x00R\x12mysqlExplainParams\x12}\n" +
 	"\x1emysql_show_create_table_params\x18\v \x01(\v27.agent.v1.StartActionRequest.MySQLShowCreateTableParamsH\x00R\x1amysqlShowCreateTableParams\x12}\n" +
@@ -6750,7 +8426,10 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\x1emongodb_query_buildinfo_params\x18\x1a \x01(\v28.agent.v1.StartActionRequest.MongoDBQueryBuildInfoParamsH\x00R\x1bmongodbQueryBuildinfoParams\x12\x8e\x01\n" +
 	"#mongodb_query_getcmdlineopts_params\x18\x1b \x01(\v2=.agent.v1.StartActionRequest.MongoDBQueryGetCmdLineOptsParamsH\x00R mongodbQueryGetcmdlineoptsParams\x12\x94\x01\n" +
 	"%mongodb_query_replsetgetstatus_params\x18\x1c \x01(\v2?.agent.v1.StartActionRequest.MongoDBQueryReplSetGetStatusParamsH\x00R\"mongodbQueryReplsetgetstatusParams\x12\x97\x01\n" +
-	"&mongodb_query_getdiagnosticdata_params\x18\x1d \x01(\v2@.agent.v1.StartActionRequest.MongoDBQueryGetDiagnosticDataParamsH\x00R#mongodbQueryGetdiagnosticdataParams\x12v\n" +
+	"&mongodb_query_getdiagnosticdata_params\x18\x1d \x01(\v2@.agent.v1.StartActionRequest.MongoDBQueryGetDiagnosticDataParamsH\x00R#mongodbQueryGetdiagnosticdataParams\x12g\n" +
+	"\x16mongodb_kill_op_params\x18\x1e \x01(\v20.agent.v1.StartActionRequest.MongoDBKillOpParamsH\x00R\x13mongodbKillOpParams\x12j\n" +
+	"\x17mysql_kill_query_params\x18\x1f \x01(\v21.agent.v1.StartActionRequest.MySQLKillQueryParamsH\x00R\x14mysqlKillQueryParams\x12\x85\x01\n" +
+	" postgresql_cancel_backend_params\x18  \x01(\v2:.agent.v1.StartActionRequest.PostgreSQLCancelBackendParamsH\x00R\x1dpostgresqlCancelBackendParams\x12v\n" +
 	"\x1arestart_sys_service_params\x182 \x01(\v27.agent.v1.StartActionRequest.RestartSystemServiceParamsH\x00R\x17restartSysServiceParams\x1a\x95\x02\n" +
 	"\x12MySQLExplainParams\x12\x16\n" +
 	"\x03dsn\x18\x01 \x01(\tB\x04\x88\xb5\x18\x03R\x03dsn\x12\x14\n" +
@@ -6845,13 +8524,37 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"#MongoDBQueryGetDiagnosticDataParams\x12\x16\n" +
 	"\x03dsn\x18\x01 \x01(\tB\x04\x88\xb5\x18\x03R\x03dsn\x122\n" +
 	"\n" +
-	"text_files\x18\x02 \x01(\v2\x13.agent.v1.TextFilesR\ttextFiles\x1a\xf4\x01\n" +
+	"text_files\x18\x02 \x01(\v2\x13.agent.v1.TextFilesR\ttextFiles\x1av\n" +
+	"\x13MongoDBKillOpParams\x12\x16\n" +
+	"\x03dsn\x18\x01 \x01(\tB\x04\x88\xb5\x18\x03R\x03dsn\x122\n" +
+	"\n" +
+	"text_files\x18\x02 \x01(\v2\x13.agent.v1.TextFilesR\ttextFiles\x12\x13\n" +
+	"\x05op_id\x18\x03 \x01(\x03R\x04opId\x1a\xa2\x02\n" +
+	"\x14MySQLKillQueryParams\x12\x16\n" +
+	"\x03dsn\x18\x01 \x01(\tB\x04\x88\xb5\x18\x03R\x03dsn\x120\n" +
+	"\ttls_files\x18\x02 \x01(\v2\x13.agent.v1.TextFilesR\btlsFiles\x12&\n" +
+	"\x0ftls_skip_verify\x18\x03 \x01(\bR\rtlsSkipVerify\x12#\n" +
+	"\rconnection_id\x18\x04 \x01(\x04R\fconnectionId\x12\x1b\n" +
+	"\tthread_id\x18\x05 \x01(\x04R\bthreadId\x12\x19\n" +
+	"\bevent_id\x18\x06 \x01(\x04R\aeventId\x12;\n" +
+	"\vquery_start\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"queryStart\x1a\xe0\x01\n" +
+	"\x1dPostgreSQLCancelBackendParams\x12\x16\n" +
+	"\x03dsn\x18\x01 \x01(\tB\x04\x88\xb5\x18\x03R\x03dsn\x120\n" +
+	"\ttls_files\x18\x02 \x01(\v2\x13.agent.v1.TextFilesR\btlsFiles\x12&\n" +
+	"\x0ftls_skip_verify\x18\x03 \x01(\bR\rtlsSkipVerify\x12\x10\n" +
+	"\x03pid\x18\x04 \x01(\x05R\x03pid\x12;\n" +
+	"\vquery_start\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"queryStart\x1a\xf4\x01\n" +
 	"\x1aRestartSystemServiceParams\x12l\n" +
 	"\x0esystem_service\x18\x01 \x01(\x0e2E.agent.v1.StartActionRequest.RestartSystemServiceParams.SystemServiceR\rsystemService\"h\n" +
 	"\rSystemService\x12\x1e\n" +
 	"\x1aSYSTEM_SERVICE_UNSPECIFIED\x10\x00\x12\x19\n" +
 	"\x15SYSTEM_SERVICE_MONGOD\x10\x01\x12\x1c\n" +
-	"\x18SYSTEM_SERVICE_PBM_AGENT\x10\x02B\b\n" +
+	"\x18SYSTEM_SERVICE_PBM_AGENT\x10\x02\x1a:\n" +
+	"\fSecretsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\b\n" +
 	"\x06params\"\x15\n" +
 	"\x13StartActionResponse\"0\n" +
 	"\x11StopActionRequest\x12\x1b\n" +
@@ -6862,12 +8565,16 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\x06output\x18\x03 \x01(\fR\x06output\x12\x12\n" +
 	"\x04done\x18\x04 \x01(\bR\x04done\x12\x14\n" +
 	"\x05error\x18\x05 \x01(\tR\x05error\"\x16\n" +
-	"\x14ActionResultResponse\"|\n" +
+	"\x14ActionResultResponse\"\xff\x01\n" +
 	"\x14PBMSwitchPITRRequest\x12\x16\n" +
 	"\x03dsn\x18\x01 \x01(\tB\x04\x88\xb5\x18\x03R\x03dsn\x122\n" +
 	"\n" +
 	"text_files\x18\x02 \x01(\v2\x13.agent.v1.TextFilesR\ttextFiles\x12\x18\n" +
-	"\aenabled\x18\x03 \x01(\bR\aenabled\"-\n" +
+	"\aenabled\x18\x03 \x01(\bR\aenabled\x12E\n" +
+	"\asecrets\x18\x04 \x03(\v2+.agent.v1.PBMSwitchPITRRequest.SecretsEntryR\asecrets\x1a:\n" +
+	"\fSecretsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"-\n" +
 	"\x15PBMSwitchPITRResponse\x12\x14\n" +
 	"\x05error\x18\x01 \x01(\tR\x05error\"C\n" +
 	"\x10AgentLogsRequest\x12\x19\n" +
@@ -6875,7 +8582,7 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\x05limit\x18\x02 \x01(\rR\x05limit\"g\n" +
 	"\x11AgentLogsResponse\x12\x12\n" +
 	"\x04logs\x18\x01 \x03(\tR\x04logs\x12>\n" +
-	"\x1cagent_config_log_lines_count\x18\x02 \x01(\rR\x18agentConfigLogLinesCount\"\x82\x02\n" +
+	"\x1cagent_config_log_lines_count\x18\x02 \x01(\rR\x18agentConfigLogLinesCount\"\x87\x03\n" +
 	"\x16CheckConnectionRequest\x12-\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x19.inventory.v1.ServiceTypeR\x04type\x12\x16\n" +
 	"\x03dsn\x18\x02 \x01(\tB\x04\x88\xb5\x18\x03R\x03dsn\x123\n" +
@@ -6883,12 +8590,16 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\n" +
 	"text_files\x18\x04 \x01(\v2\x13.agent.v1.TextFilesR\ttextFiles\x12&\n" +
 	"\x0ftls_skip_verify\x18\x05 \x01(\bR\rtlsSkipVerify\x12\x10\n" +
-	"\x03tls\x18\x06 \x01(\bR\x03tls\"Y\n" +
+	"\x03tls\x18\x06 \x01(\bR\x03tls\x12G\n" +
+	"\asecrets\x18\a \x03(\v2-.agent.v1.CheckConnectionRequest.SecretsEntryR\asecrets\x1a:\n" +
+	"\fSecretsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"Y\n" +
 	"\x17CheckConnectionResponse\x12\x14\n" +
 	"\x05error\x18\x01 \x01(\tR\x05error\x1a(\n" +
 	"\x05Stats\x12\x1f\n" +
 	"\vtable_count\x18\x01 \x01(\x05R\n" +
-	"tableCount\"\xfe\x01\n" +
+	"tableCount\"\xff\x02\n" +
 	"\x12ServiceInfoRequest\x12-\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x19.inventory.v1.ServiceTypeR\x04type\x12\x16\n" +
 	"\x03dsn\x18\x02 \x01(\tB\x04\x88\xb5\x18\x03R\x03dsn\x123\n" +
@@ -6896,7 +8607,11 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\n" +
 	"text_files\x18\x04 \x01(\v2\x13.agent.v1.TextFilesR\ttextFiles\x12&\n" +
 	"\x0ftls_skip_verify\x18\x05 \x01(\bR\rtlsSkipVerify\x12\x10\n" +
-	"\x03tls\x18\x06 \x01(\bR\x03tls\"\xc4\x01\n" +
+	"\x03tls\x18\x06 \x01(\bR\x03tls\x12C\n" +
+	"\asecrets\x18\a \x03(\v2).agent.v1.ServiceInfoRequest.SecretsEntryR\asecrets\x1a:\n" +
+	"\fSecretsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc4\x01\n" +
 	"\x13ServiceInfoResponse\x12\x14\n" +
 	"\x05error\x18\x01 \x01(\tR\x05error\x12\x1f\n" +
 	"\vtable_count\x18\x02 \x01(\x05R\n" +
@@ -6919,14 +8634,20 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"bucketName\x12#\n" +
 	"\rbucket_region\x18\x05 \x01(\tR\fbucketRegion\".\n" +
 	"\x18FilesystemLocationConfig\x12\x12\n" +
-	"\x04path\x18\x01 \x01(\tR\x04path\"\xeb\r\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\"\xde\x1f\n" +
 	"\x0fStartJobRequest\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x123\n" +
-	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12J\n" +
+	"\atimeout\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\atimeout\x12@\n" +
+	"\asecrets\x18\x03 \x03(\v2&.agent.v1.StartJobRequest.SecretsEntryR\asecrets\x12J\n" +
 	"\fmysql_backup\x18\v \x01(\v2%.agent.v1.StartJobRequest.MySQLBackupH\x00R\vmysqlBackup\x12`\n" +
 	"\x14mysql_restore_backup\x18\f \x01(\v2,.agent.v1.StartJobRequest.MySQLRestoreBackupH\x00R\x12mysqlRestoreBackup\x12P\n" +
 	"\x0emongodb_backup\x18\r \x01(\v2'.agent.v1.StartJobRequest.MongoDBBackupH\x00R\rmongodbBackup\x12f\n" +
-	"\x16mongodb_restore_backup\x18\x0e \x01(\v2..agent.v1.StartJobRequest.MongoDBRestoreBackupH\x00R\x14mongodbRestoreBackup\x1a\xa2\x02\n" +
+	"\x16mongodb_restore_backup\x18\x0e \x01(\v2..agent.v1.StartJobRequest.MongoDBRestoreBackupH\x00R\x14mongodbRestoreBackup\x12Y\n" +
+	"\x11postgresql_backup\x18\x0f \x01(\v2*.agent.v1.StartJobRequest.PostgreSQLBackupH\x00R\x10postgresqlBackup\x12o\n" +
+	"\x19postgresql_restore_backup\x18\x10 \x01(\v21.agent.v1.StartJobRequest.PostgreSQLRestoreBackupH\x00R\x17postgresqlRestoreBackup\x12]\n" +
+	"\x13mysql_verify_backup\x18\x11 \x01(\v2+.agent.v1.StartJobRequest.MySQLVerifyBackupH\x00R\x11mysqlVerifyBackup\x12c\n" +
+	"\x15mongodb_verify_backup\x18\x12 \x01(\v2-.agent.v1.StartJobRequest.MongoDBVerifyBackupH\x00R\x13mongodbVerifyBackup\x12]\n" +
+	"\x13mysql_binlog_stream\x18\x13 \x01(\v2+.agent.v1.StartJobRequest.MySQLBinlogStreamH\x00R\x11mysqlBinlogStream\x1a\xc3\x02\n" +
 	"\vMySQLBackup\x12\x18\n" +
 	"\x04user\x18\x01 \x01(\tB\x04\x88\xb5\x18\x01R\x04user\x12 \n" +
 	"\bpassword\x18\x02 \x01(\tB\x04\x88\xb5\x18\x01R\bpassword\x12\x18\n" +
@@ -6934,15 +8655,24 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\x04port\x18\x04 \x01(\x05R\x04port\x12\x16\n" +
 	"\x06socket\x18\x05 \x01(\tR\x06socket\x12\x12\n" +
 	"\x04name\x18\x06 \x01(\tR\x04name\x12\x16\n" +
-	"\x06folder\x18\a \x01(\tR\x06folder\x129\n" +
+	"\x06folder\x18\a \x01(\tR\x06folder\x12\x1f\n" +
+	"\venable_pitr\x18\b \x01(\bR\n" +
+	"enablePitr\x129\n" +
 	"\ts3_config\x18\n" +
 	" \x01(\v2\x1a.agent.v1.S3LocationConfigH\x00R\bs3ConfigB\x11\n" +
-	"\x0flocation_configJ\x04\b\v\x10\fR\x11filesystem_config\x1a\xc6\x01\n" +
+	"\x0flocation_configJ\x04\b\v\x10\fR\x11filesystem_config\x1a\xb0\x03\n" +
 	"\x12MySQLRestoreBackup\x12\x1d\n" +
 	"\n" +
 	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
-	"\x06folder\x18\x03 \x01(\tR\x06folder\x129\n" +
+	"\x06folder\x18\x03 \x01(\tR\x06folder\x12A\n" +
+	"\x0epitr_timestamp\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rpitrTimestamp\x12#\n" +
+	"\rbinlog_folder\x18\x05 \x01(\tR\fbinlogFolder\x12\x18\n" +
+	"\x04user\x18\x06 \x01(\tB\x04\x88\xb5\x18\x01R\x04user\x12 \n" +
+	"\bpassword\x18\a \x01(\tB\x04\x88\xb5\x18\x01R\bpassword\x12\x18\n" +
+	"\aaddress\x18\b \x01(\tR\aaddress\x12\x12\n" +
+	"\x04port\x18\t \x01(\x05R\x04port\x12\x16\n" +
+	"\x06socket\x18\f \x01(\tR\x06socket\x129\n" +
 	"\ts3_config\x18\n" +
 	" \x01(\v2\x1a.agent.v1.S3LocationConfigH\x00R\bs3ConfigB\x11\n" +
 	"\x0flocation_configJ\x04\b\v\x10\fR\x11filesystem_config\x1a\xfe\x02\n" +
@@ -6971,13 +8701,65 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\ts3_config\x18\n" +
 	" \x01(\v2\x1a.agent.v1.S3LocationConfigH\x00R\bs3Config\x12Q\n" +
 	"\x11filesystem_config\x18\v \x01(\v2\".agent.v1.FilesystemLocationConfigH\x00R\x10filesystemConfigB\x11\n" +
-	"\x0flocation_configB\x05\n" +
+	"\x0flocation_config\x1a\xe0\x02\n" +
+	"\x10PostgreSQLBackup\x12\x16\n" +
+	"\x03dsn\x18\x01 \x01(\tB\x04\x88\xb5\x18\x03R\x03dsn\x122\n" +
+	"\n" +
+	"text_files\x18\x02 \x01(\v2\x13.agent.v1.TextFilesR\ttextFiles\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06folder\x18\x04 \x01(\tR\x06folder\x123\n" +
+	"\n" +
+	"data_model\x18\x05 \x01(\x0e2\x14.backup.v1.DataModelR\tdataModel\x129\n" +
+	"\ts3_config\x18\n" +
+	" \x01(\v2\x1a.agent.v1.S3LocationConfigH\x00R\bs3Config\x12Q\n" +
+	"\x11filesystem_config\x18\v \x01(\v2\".agent.v1.FilesystemLocationConfigH\x00R\x10filesystemConfigB\x11\n" +
+	"\x0flocation_config\x1a\xe7\x02\n" +
+	"\x17PostgreSQLRestoreBackup\x12\x16\n" +
+	"\x03dsn\x18\x01 \x01(\tB\x04\x88\xb5\x18\x03R\x03dsn\x122\n" +
+	"\n" +
+	"text_files\x18\x02 \x01(\v2\x13.agent.v1.TextFilesR\ttextFiles\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x16\n" +
+	"\x06folder\x18\x04 \x01(\tR\x06folder\x123\n" +
+	"\n" +
+	"data_model\x18\x05 \x01(\x0e2\x14.backup.v1.DataModelR\tdataModel\x129\n" +
+	"\ts3_config\x18\n" +
+	" \x01(\v2\x1a.agent.v1.S3LocationConfigH\x00R\bs3Config\x12Q\n" +
+	"\x11filesystem_config\x18\v \x01(\v2\".agent.v1.FilesystemLocationConfigH\x00R\x10filesystemConfigB\x11\n" +
+	"\x0flocation_config\x1a\x8d\x01\n" +
+	"\x11MySQLVerifyBackup\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06folder\x18\x02 \x01(\tR\x06folder\x129\n" +
+	"\ts3_config\x18\n" +
+	" \x01(\v2\x1a.agent.v1.S3LocationConfigH\x00R\bs3ConfigB\x11\n" +
+	"\x0flocation_config\x1a\x9d\x02\n" +
+	"\x13MongoDBVerifyBackup\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x16\n" +
+	"\x06folder\x18\x02 \x01(\tR\x06folder\x129\n" +
+	"\fpbm_metadata\x18\x03 \x01(\v2\x16.backup.v1.PbmMetadataR\vpbmMetadata\x129\n" +
+	"\ts3_config\x18\n" +
+	" \x01(\v2\x1a.agent.v1.S3LocationConfigH\x00R\bs3Config\x12Q\n" +
+	"\x11filesystem_config\x18\v \x01(\v2\".agent.v1.FilesystemLocationConfigH\x00R\x10filesystemConfigB\x11\n" +
+	"\x0flocation_config\x1a\xfb\x01\n" +
+	"\x11MySQLBinlogStream\x12\x18\n" +
+	"\x04user\x18\x01 \x01(\tB\x04\x88\xb5\x18\x01R\x04user\x12 \n" +
+	"\bpassword\x18\x02 \x01(\tB\x04\x88\xb5\x18\x01R\bpassword\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04port\x18\x04 \x01(\x05R\x04port\x12\x16\n" +
+	"\x06socket\x18\x05 \x01(\tR\x06socket\x12\x16\n" +
+	"\x06folder\x18\x06 \x01(\tR\x06folder\x129\n" +
+	"\ts3_config\x18\n" +
+	" \x01(\v2\x1a.agent.v1.S3LocationConfigH\x00R\bs3ConfigB\x11\n" +
+	"\x0flocation_config\x1a:\n" +
+	"\fSecretsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\x05\n" +
 	"\x03job\"(\n" +
 	"\x10StartJobResponse\x12\x14\n" +
 	"\x05error\x18\x01 \x01(\tR\x05error\"'\n" +
 	"\x0eStopJobRequest\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\"\x11\n" +
-	"\x0fStopJobResponse\"\xea\x05\n" +
+	"\x0fStopJobResponse\"\xdc\n" +
+	"\n" +
 	"\tJobResult\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x128\n" +
 	"\ttimestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\ttimestamp\x121\n" +
@@ -6986,7 +8768,12 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\fmysql_backup\x18\f \x01(\v2\x1f.agent.v1.JobResult.MySQLBackupH\x00R\vmysqlBackup\x12Z\n" +
 	"\x14mysql_restore_backup\x18\r \x01(\v2&.agent.v1.JobResult.MySQLRestoreBackupH\x00R\x12mysqlRestoreBackup\x12J\n" +
 	"\x0emongodb_backup\x18\x0e \x01(\v2!.agent.v1.JobResult.MongoDBBackupH\x00R\rmongodbBackup\x12`\n" +
-	"\x16mongodb_restore_backup\x18\x0f \x01(\v2(.agent.v1.JobResult.MongoDBRestoreBackupH\x00R\x14mongodbRestoreBackup\x1a!\n" +
+	"\x16mongodb_restore_backup\x18\x0f \x01(\v2(.agent.v1.JobResult.MongoDBRestoreBackupH\x00R\x14mongodbRestoreBackup\x12S\n" +
+	"\x11postgresql_backup\x18\x10 \x01(\v2$.agent.v1.JobResult.PostgreSQLBackupH\x00R\x10postgresqlBackup\x12i\n" +
+	"\x19postgresql_restore_backup\x18\x11 \x01(\v2+.agent.v1.JobResult.PostgreSQLRestoreBackupH\x00R\x17postgresqlRestoreBackup\x12W\n" +
+	"\x13mysql_verify_backup\x18\x12 \x01(\v2%.agent.v1.JobResult.MySQLVerifyBackupH\x00R\x11mysqlVerifyBackup\x12]\n" +
+	"\x15mongodb_verify_backup\x18\x13 \x01(\v2'.agent.v1.JobResult.MongoDBVerifyBackupH\x00R\x13mongodbVerifyBackup\x12W\n" +
+	"\x13mysql_binlog_stream\x18\x14 \x01(\v2%.agent.v1.JobResult.MySQLBinlogStreamH\x00R\x11mysqlBinlogStream\x1a!\n" +
 	"\x05Error\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\x1an\n" +
 	"\rMongoDBBackup\x12,\n" +
@@ -6995,7 +8782,13 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\vMySQLBackup\x12/\n" +
 	"\bmetadata\x18\x01 \x01(\v2\x13.backup.v1.MetadataR\bmetadata\x1a\x14\n" +
 	"\x12MySQLRestoreBackup\x1a\x16\n" +
-	"\x14MongoDBRestoreBackupB\b\n" +
+	"\x14MongoDBRestoreBackup\x1aC\n" +
+	"\x10PostgreSQLBackup\x12/\n" +
+	"\bmetadata\x18\x01 \x01(\v2\x13.backup.v1.MetadataR\bmetadata\x1a\x19\n" +
+	"\x17PostgreSQLRestoreBackup\x1a\x13\n" +
+	"\x11MySQLVerifyBackup\x1a\x15\n" +
+	"\x13MongoDBVerifyBackup\x1a\x13\n" +
+	"\x11MySQLBinlogStreamB\b\n" +
 	"\x06result\"\xb0\x03\n" +
 	"\vJobProgress\x12\x15\n" +
 	"\x06job_id\x18\x01 \x01(\tR\x05jobId\x128\n" +
@@ -7009,7 +8802,7 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\bchunk_id\x18\x01 \x01(\rR\achunkId\x12\x12\n" +
 	"\x04data\x18\x02 \x01(\tR\x04data\x12\x12\n" +
 	"\x04done\x18\x03 \x01(\bR\x04doneB\b\n" +
-	"\x06result\"\xb2\x04\n" +
+	"\x06result\"\xb4\x06\n" +
 	"\x12GetVersionsRequest\x12C\n" +
 	"\tsoftwares\x18\x01 \x03(\v2%.agent.v1.GetVersionsRequest.SoftwareR\tsoftwares\x1a\b\n" +
 	"\x06MySQLd\x1a\f\n" +
@@ -7018,7 +8811,10 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\aXbcloud\x1a\b\n" +
 	"\x06Qpress\x1a\t\n" +
 	"\aMongoDB\x1a\x05\n" +
-	"\x03PBM\x1a\x97\x03\n" +
+	"\x03PBM\x1a\x0e\n" +
+	"\fPGBasebackup\x1a\b\n" +
+	"\x06PGDump\x1a\v\n" +
+	"\tPGRestore\x1a\xf2\x04\n" +
 	"\bSoftware\x12=\n" +
 	"\x06mysqld\x18\x01 \x01(\v2#.agent.v1.GetVersionsRequest.MySQLdH\x00R\x06mysqld\x12I\n" +
 	"\n" +
@@ -7027,14 +8823,23 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\axbcloud\x18\x03 \x01(\v2$.agent.v1.GetVersionsRequest.XbcloudH\x00R\axbcloud\x12=\n" +
 	"\x06qpress\x18\x04 \x01(\v2#.agent.v1.GetVersionsRequest.QpressH\x00R\x06qpress\x12>\n" +
 	"\x06mongod\x18\x05 \x01(\v2$.agent.v1.GetVersionsRequest.MongoDBH\x00R\x06mongod\x124\n" +
-	"\x03pbm\x18\x06 \x01(\v2 .agent.v1.GetVersionsRequest.PBMH\x00R\x03pbmB\n" +
+	"\x03pbm\x18\x06 \x01(\v2 .agent.v1.GetVersionsRequest.PBMH\x00R\x03pbm\x12P\n" +
+	"\rpg_basebackup\x18\a \x01(\v2).agent.v1.GetVersionsRequest.PGBasebackupH\x00R\fpgBasebackup\x12>\n" +
+	"\apg_dump\x18\b \x01(\v2#.agent.v1.GetVersionsRequest.PGDumpH\x00R\x06pgDump\x12G\n" +
+	"\n" +
+	"pg_restore\x18\t \x01(\v2&.agent.v1.GetVersionsRequest.PGRestoreH\x00R\tpgRestoreB\n" +
 	"\n" +
 	"\bsoftware\"\x93\x01\n" +
 	"\x13GetVersionsResponse\x12A\n" +
 	"\bversions\x18\x01 \x03(\v2%.agent.v1.GetVersionsResponse.VersionR\bversions\x1a9\n" +
 	"\aVersion\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\tR\aversion\x12\x14\n" +
-	"\x05error\x18\x02 \x01(\tR\x05error\"\xb2\t\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\"Y\n" +
+	"\x17RenewCertificateRequest\x12>\n" +
+	"\x1bcertificate_signing_request\x18\x01 \x01(\tR\x19certificateSigningRequest\"<\n" +
+	"\x18RenewCertificateResponse\x12 \n" +
+	"\vcertificate\x18\x01 \x01(\tR\vcertificate\"\x84\n" +
+	"\n" +
 	"\fAgentMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12+\n" +
 	"\x06status\x18\xff\x0f \x01(\v2\x12.google.rpc.StatusR\x06status\x12$\n" +
@@ -7045,7 +8850,8 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\raction_result\x18\x05 \x01(\v2\x1d.agent.v1.ActionResultRequestH\x00R\factionResult\x124\n" +
 	"\n" +
 	"job_result\x18\x10 \x01(\v2\x13.agent.v1.JobResultH\x00R\tjobResult\x12:\n" +
-	"\fjob_progress\x18\x11 \x01(\v2\x15.agent.v1.JobProgressH\x00R\vjobProgress\x12$\n" +
+	"\fjob_progress\x18\x11 \x01(\v2\x15.agent.v1.JobProgressH\x00R\vjobProgress\x12P\n" +
+	"\x11renew_certificate\x18\x17 \x01(\v2!.agent.v1.RenewCertificateRequestH\x00R\x10renewCertificate\x12$\n" +
 	"\x04pong\x18\b \x01(\v2\x0e.agent.v1.PongH\x00R\x04pong\x129\n" +
 	"\tset_state\x18\t \x01(\v2\x1a.agent.v1.SetStateResponseH\x00R\bsetState\x12B\n" +
 	"\fstart_action\x18\n" +
@@ -7062,7 +8868,7 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\n" +
 	"agent_logs\x18\x15 \x01(\v2\x1b.agent.v1.AgentLogsResponseH\x00R\tagentLogs\x12B\n" +
 	"\fservice_info\x18\x16 \x01(\v2\x1d.agent.v1.ServiceInfoResponseH\x00R\vserviceInfoB\t\n" +
-	"\apayload\"\xb9\b\n" +
+	"\apayload\"\x8c\t\n" +
 	"\rServerMessage\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12+\n" +
 	"\x06status\x18\xff\x0f \x01(\v2\x12.google.rpc.StatusR\x06status\x12$\n" +
@@ -7070,7 +8876,8 @@ const file_agent_v1_agent_proto_rawDesc = "" +
 	"\rstate_changed\x18\x03 \x01(\v2\x1e.agent.v1.StateChangedResponseH\x00R\fstateChanged\x12?\n" +
 	"\vqan_collect\x18\x04 \x01(\v2\x1c.agent.v1.QANCollectResponseH\x00R\n" +
 	"qanCollect\x12E\n" +
-	"\raction_result\x18\x05 \x01(\v2\x1e.agent.v1.ActionResultResponseH\x00R\factionResult\x12$\n" +
+	"\raction_result\x18\x05 \x01(\v2\x1e.agent.v1.ActionResultResponseH\x00R\factionResult\x12Q\n" +
+	"\x11renew_certificate\x18\x15 \x01(\v2\".agent.v1.RenewCertificateResponseH\x00R\x10renewCertificate\x12$\n" +
 	"\x04ping\x18\b \x01(\v2\x0e.agent.v1.PingH\x00R\x04ping\x128\n" +
 	"\tset_state\x18\t \x01(\v2\x19.agent.v1.SetStateRequestH\x00R\bsetState\x12A\n" +
 	"\fstart_action\x18\n" +
@@ -7112,7 +8919,7 @@ func file_agent_v1_agent_proto_rawDescGZIP() []byte {
 
 var (
 	file_agent_v1_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-	file_agent_v1_agent_proto_msgTypes  = make([]protoimpl.MessageInfo, 92)
+	file_agent_v1_agent_proto_msgTypes  = make([]protoimpl.MessageInfo, 117)
 	file_agent_v1_agent_proto_goTypes   = []any{
 		MysqlExplainOutputFormat(0),                                    // 0: agent.v1.MysqlExplainOutputFormat
 		StartActionRequest_RestartSystemServiceParams_SystemService(0), // 1: agent.v1.StartActionRequest.RestartSystemServiceParams.SystemService
@@ -7156,222 +8963,292 @@ var (
 		(*JobProgress)(nil),                                            // 39: agent.v1.JobProgress
 		(*GetVersionsRequest)(nil),                                     // 40: agent.v1.GetVersionsRequest
 		(*GetVersionsResponse)(nil),                                    // 41: agent.v1.GetVersionsResponse
-		(*AgentMessage)(nil),                                           // 42: agent.v1.AgentMessage
-		(*ServerMessage)(nil),                                          // 43: agent.v1.ServerMessage
-		nil,                                                            // 44: agent.v1.TextFiles.FilesEntry
-		(*SetStateRequest_AgentProcess)(nil),                           // 45: agent.v1.SetStateRequest.AgentProcess
-		nil,                                                            // 46: agent.v1.SetStateRequest.AgentProcessesEntry
-		(*SetStateRequest_BuiltinAgent)(nil),                           // 47: agent.v1.SetStateRequest.BuiltinAgent
-		nil,                                                            // 48: agent.v1.SetStateRequest.BuiltinAgentsEntry
-		nil,                                                            // 49: agent.v1.SetStateRequest.AgentProcess.TextFilesEntry
-		nil,                                                            // 50: agent.v1.SetStateRequest.BuiltinAgent.EnvEntry
-		nil,                                                            // 51: agent.v1.QueryActionMap.MapEntry
-		(*StartActionRequest_MySQLExplainParams)(nil),                  // 52: agent.v1.StartActionRequest.MySQLExplainParams
-		(*StartActionRequest_MySQLShowCreateTableParams)(nil),          // 53: agent.v1.StartActionRequest.MySQLShowCreateTableParams
-		(*StartActionRequest_MySQLShowTableStatusParams)(nil),          // 54: agent.v1.StartActionRequest.MySQLShowTableStatusParams
-		(*StartActionRequest_MySQLShowIndexParams)(nil),                // 55: agent.v1.StartActionRequest.MySQLShowIndexParams
-		(*StartActionRequest_PostgreSQLShowCreateTableParams)(nil),     // 56: agent.v1.StartActionRequest.PostgreSQLShowCreateTableParams
-		(*StartActionRequest_PostgreSQLShowIndexParams)(nil),           // 57: agent.v1.StartActionRequest.PostgreSQLShowIndexParams
-		(*StartActionRequest_MongoDBExplainParams)(nil),                // 58: agent.v1.StartActionRequest.MongoDBExplainParams
-		(*StartActionRequest_PTSummaryParams)(nil),                     // 59: agent.v1.StartActionRequest.PTSummaryParams
-		(*StartActionRequest_PTPgSummaryParams)(nil),                   // 60: agent.v1.StartActionRequest.PTPgSummaryParams
-		(*StartActionRequest_PTMongoDBSummaryParams)(nil),              // 61: agent.v1.StartActionRequest.PTMongoDBSummaryParams
-		(*StartActionRequest_PTMySQLSummaryParams)(nil),                // 62: agent.v1.StartActionRequest.PTMySQLSummaryParams
-		(*StartActionRequest_MySQLQueryShowParams)(nil),                // 63: agent.v1.StartActionRequest.MySQLQueryShowParams
-		(*StartActionRequest_MySQLQuerySelectParams)(nil),              // 64: agent.v1.StartActionRequest.MySQLQuerySelectParams
-		(*StartActionRequest_PostgreSQLQueryShowParams)(nil),           // 65: agent.v1.StartActionRequest.PostgreSQLQueryShowParams
-		(*StartActionRequest_PostgreSQLQuerySelectParams)(nil),         // 66: agent.v1.StartActionRequest.PostgreSQLQuerySelectParams
-		(*StartActionRequest_MongoDBQueryGetParameterParams)(nil),      // 67: agent.v1.StartActionRequest.MongoDBQueryGetParameterParams
-		(*StartActionRequest_MongoDBQueryBuildInfoParams)(nil),         // 68: agent.v1.StartActionRequest.MongoDBQueryBuildInfoParams
-		(*StartActionRequest_MongoDBQueryGetCmdLineOptsParams)(nil),    // 69: agent.v1.StartActionRequest.MongoDBQueryGetCmdLineOptsParams
-		(*StartActionRequest_MongoDBQueryReplSetGetStatusParams)(nil),  // 70: agent.v1.StartActionRequest.MongoDBQueryReplSetGetStatusParams
-		(*StartActionRequest_MongoDBQueryGetDiagnosticDataParams)(nil), // 71: agent.v1.StartActionRequest.MongoDBQueryGetDiagnosticDataParams
-		(*StartActionRequest_RestartSystemServiceParams)(nil),          // 72: agent.v1.StartActionRequest.RestartSystemServiceParams
-		(*CheckConnectionResponse_Stats)(nil),                          // 73: agent.v1.CheckConnectionResponse.Stats
-		(*StartJobRequest_MySQLBackup)(nil),                            // 74: agent.v1.StartJobRequest.MySQLBackup
-		(*StartJobRequest_MySQLRestoreBackup)(nil),                     // 75: agent.v1.StartJobRequest.MySQLRestoreBackup
-		(*StartJobRequest_MongoDBBackup)(nil),                          // 76: agent.v1.StartJobRequest.MongoDBBackup
-		(*StartJobRequest_MongoDBRestoreBackup)(nil),                   // 77: agent.v1.StartJobRequest.MongoDBRestoreBackup
-		(*JobResult_Error)(nil),                                        // 78: agent.v1.JobResult.Error
-		(*JobResult_MongoDBBackup)(nil),                                // 79: agent.v1.JobResult.MongoDBBackup
-		(*JobResult_MySQLBackup)(nil),                                  // 80: agent.v1.JobResult.MySQLBackup
-		(*JobResult_MySQLRestoreBackup)(nil),                           // 81: agent.v1.JobResult.MySQLRestoreBackup
-		(*JobResult_MongoDBRestoreBackup)(nil),                         // 82: agent.v1.JobResult.MongoDBRestoreBackup
-		(*JobProgress_MySQLBackup)(nil),                                // 83: agent.v1.JobProgress.MySQLBackup
-		(*JobProgress_MySQLRestoreBackup)(nil),                         // 84: agent.v1.JobProgress.MySQLRestoreBackup
-		(*JobProgress_Logs)(nil),                                       // 85: agent.v1.JobProgress.Logs
-		(*GetVersionsRequest_MySQLd)(nil),                              // 86: agent.v1.GetVersionsRequest.MySQLd
-		(*GetVersionsRequest_Xtrabackup)(nil),                          // 87: agent.v1.GetVersionsRequest.Xtrabackup
-		(*GetVersionsRequest_Xbcloud)(nil),                             // 88: agent.v1.GetVersionsRequest.Xbcloud
-		(*GetVersionsRequest_Qpress)(nil),                              // 89: agent.v1.GetVersionsRequest.Qpress
-		(*GetVersionsRequest_MongoDB)(nil),                             // 90: agent.v1.GetVersionsRequest.MongoDB
-		(*GetVersionsRequest_PBM)(nil),                                 // 91: agent.v1.GetVersionsRequest.PBM
-		(*GetVersionsRequest_Software)(nil),                            // 92: agent.v1.GetVersionsRequest.Software
-		(*GetVersionsResponse_Version)(nil),                            // 93: agent.v1.GetVersionsResponse.Version
-		(*timestamppb.Timestamp)(nil),                                  // 94: google.protobuf.Timestamp
-		(*MetricsBucket)(nil),                                          // 95: agent.v1.MetricsBucket
-		v1.AgentStatus(0),                                              // 96: inventory.v1.AgentStatus
-		(*durationpb.Duration)(nil),                                    // 97: google.protobuf.Duration
-		v1.ServiceType(0),                                              // 98: inventory.v1.ServiceType
-		(*status.Status)(nil),                                          // 99: google.rpc.Status
-		v1.AgentType(0),                                                // 100: inventory.v1.AgentType
-		(*v1.RTAOptions)(nil),                                          // 101: inventory.v1.RTAOptions
-		v11.DataModel(0),                                               // 102: backup.v1.DataModel
-		(*v11.PbmMetadata)(nil),                                        // 103: backup.v1.PbmMetadata
-		(*v11.Metadata)(nil),                                           // 104: backup.v1.Metadata
+		(*RenewCertificateRequest)(nil),                                // 42: agent.v1.RenewCertificateRequest
+		(*RenewCertificateResponse)(nil),                               // 43: agent.v1.RenewCertificateResponse
+		(*AgentMessage)(nil),                                           // 44: agent.v1.AgentMessage
+		(*ServerMessage)(nil),                                          // 45: agent.v1.ServerMessage
+		nil,                                                            // 46: agent.v1.TextFiles.FilesEntry
+		(*SetStateRequest_AgentProcess)(nil),                           // 47: agent.v1.SetStateRequest.AgentProcess
+		nil,                                                            // 48: agent.v1.SetStateRequest.AgentProcessesEntry
+		(*SetStateRequest_BuiltinAgent)(nil),                           // 49: agent.v1.SetStateRequest.BuiltinAgent
+		nil,                                                            // 50: agent.v1.SetStateRequest.BuiltinAgentsEntry
+		nil,                                                            // 51: agent.v1.SetStateRequest.AgentProcess.TextFilesEntry
+		nil,                                                            // 52: agent.v1.SetStateRequest.AgentProcess.SecretsEntry
+		nil,                                                            // 53: agent.v1.SetStateRequest.BuiltinAgent.EnvEntry
+		nil,                                                            // 54: agent.v1.SetStateRequest.BuiltinAgent.SecretsEntry
+		nil,                                                            // 55: agent.v1.QueryActionMap.MapEntry
+		(*StartActionRequest_MySQLExplainParams)(nil),                  // 56: agent.v1.StartActionRequest.MySQLExplainParams
+		(*StartActionRequest_MySQLShowCreateTableParams)(nil),          // 57: agent.v1.StartActionRequest.MySQLShowCreateTableParams
+		(*StartActionRequest_MySQLShowTableStatusParams)(nil),          // 58: agent.v1.StartActionRequest.MySQLShowTableStatusParams
+		(*StartActionRequest_MySQLShowIndexParams)(nil),                // 59: agent.v1.StartActionRequest.MySQLShowIndexParams
+		(*StartActionRequest_PostgreSQLShowCreateTableParams)(nil),     // 60: agent.v1.StartActionRequest.PostgreSQLShowCreateTableParams
+		(*StartActionRequest_PostgreSQLShowIndexParams)(nil),           // 61: agent.v1.StartActionRequest.PostgreSQLShowIndexParams
+		(*StartActionRequest_MongoDBExplainParams)(nil),                // 62: agent.v1.StartActionRequest.MongoDBExplainParams
+		(*StartActionRequest_PTSummaryParams)(nil),                     // 63: agent.v1.StartActionRequest.PTSummaryParams
+		(*StartActionRequest_PTPgSummaryParams)(nil),                   // 64: agent.v1.StartActionRequest.PTPgSummaryParams
+		(*StartActionRequest_PTMongoDBSummaryParams)(nil),              // 65: agent.v1.StartActionRequest.PTMongoDBSummaryParams
+		(*StartActionRequest_PTMySQLSummaryParams)(nil),                // 66: agent.v1.StartActionRequest.PTMySQLSummaryParams
+		(*StartActionRequest_MySQLQueryShowParams)(nil),                // 67: agent.v1.StartActionRequest.MySQLQueryShowParams
+		(*StartActionRequest_MySQLQuerySelectParams)(nil),              // 68: agent.v1.StartActionRequest.MySQLQuerySelectParams
+		(*StartActionRequest_PostgreSQLQueryShowParams)(nil),           // 69: agent.v1.StartActionRequest.PostgreSQLQueryShowParams
+		(*StartActionRequest_PostgreSQLQuerySelectParams)(nil),         // 70: agent.v1.StartActionRequest.PostgreSQLQuerySelectParams
+		(*StartActionRequest_MongoDBQueryGetParameterParams)(nil),      // 71: agent.v1.StartActionRequest.MongoDBQueryGetParameterParams
+		(*StartActionRequest_MongoDBQueryBuildInfoParams)(nil),         // 72: agent.v1.StartActionRequest.MongoDBQueryBuildInfoParams
+		(*StartActionRequest_MongoDBQueryGetCmdLineOptsParams)(nil),    // 73: agent.v1.StartActionRequest.MongoDBQueryGetCmdLineOptsParams
+		(*StartActionRequest_MongoDBQueryReplSetGetStatusParams)(nil),  // 74: agent.v1.StartActionRequest.MongoDBQueryReplSetGetStatusParams
+		(*StartActionRequest_MongoDBQueryGetDiagnosticDataParams)(nil), // 75: agent.v1.StartActionRequest.MongoDBQueryGetDiagnosticDataParams
+		(*StartActionRequest_MongoDBKillOpParams)(nil),                 // 76: agent.v1.StartActionRequest.MongoDBKillOpParams
+		(*StartActionRequest_MySQLKillQueryParams)(nil),                // 77: agent.v1.StartActionRequest.MySQLKillQueryParams
+		(*StartActionRequest_PostgreSQLCancelBackendParams)(nil),       // 78: agent.v1.StartActionRequest.PostgreSQLCancelBackendParams
+		(*StartActionRequest_RestartSystemServiceParams)(nil),          // 79: agent.v1.StartActionRequest.RestartSystemServiceParams
+		nil,                                   // 80: agent.v1.StartActionRequest.SecretsEntry
+		nil,                                   // 81: agent.v1.PBMSwitchPITRRequest.SecretsEntry
+		nil,                                   // 82: agent.v1.CheckConnectionRequest.SecretsEntry
+		(*CheckConnectionResponse_Stats)(nil), // 83: agent.v1.CheckConnectionResponse.Stats
+		nil,                                   // 84: agent.v1.ServiceInfoRequest.SecretsEntry
+		(*StartJobRequest_MySQLBackup)(nil),   // 85: agent.v1.StartJobRequest.MySQLBackup
+		(*StartJobRequest_MySQLRestoreBackup)(nil),      // 86: agent.v1.StartJobRequest.MySQLRestoreBackup
+		(*StartJobRequest_MongoDBBackup)(nil),           // 87: agent.v1.StartJobRequest.MongoDBBackup
+		(*StartJobRequest_MongoDBRestoreBackup)(nil),    // 88: agent.v1.StartJobRequest.MongoDBRestoreBackup
+		(*StartJobRequest_PostgreSQLBackup)(nil),        // 89: agent.v1.StartJobRequest.PostgreSQLBackup
+		(*StartJobRequest_PostgreSQLRestoreBackup)(nil), // 90: agent.v1.StartJobRequest.PostgreSQLRestoreBackup
+		(*StartJobRequest_MySQLVerifyBackup)(nil),       // 91: agent.v1.StartJobRequest.MySQLVerifyBackup
+		(*StartJobRequest_MongoDBVerifyBackup)(nil),     // 92: agent.v1.StartJobRequest.MongoDBVerifyBackup
+		(*StartJobRequest_MySQLBinlogStream)(nil),       // 93: agent.v1.StartJobRequest.MySQLBinlogStream
+		nil,                                       // 94: agent.v1.StartJobRequest.SecretsEntry
+		(*JobResult_Error)(nil),                   // 95: agent.v1.JobResult.Error
+		(*JobResult_MongoDBBackup)(nil),           // 96: agent.v1.JobResult.MongoDBBackup
+		(*JobResult_MySQLBackup)(nil),             // 97: agent.v1.JobResult.MySQLBackup
+		(*JobResult_MySQLRestoreBackup)(nil),      // 98: agent.v1.JobResult.MySQLRestoreBackup
+		(*JobResult_MongoDBRestoreBackup)(nil),    // 99: agent.v1.JobResult.MongoDBRestoreBackup
+		(*JobResult_PostgreSQLBackup)(nil),        // 100: agent.v1.JobResult.PostgreSQLBackup
+		(*JobResult_PostgreSQLRestoreBackup)(nil), // 101: agent.v1.JobResult.PostgreSQLRestoreBackup
+		(*JobResult_MySQLVerifyBackup)(nil),       // 102: agent.v1.JobResult.MySQLVerifyBackup
+		(*JobResult_MongoDBVerifyBackup)(nil),     // 103: agent.v1.JobResult.MongoDBVerifyBackup
+		(*JobResult_MySQLBinlogStream)(nil),       // 104: agent.v1.JobResult.MySQLBinlogStream
+		(*JobProgress_MySQLBackup)(nil),           // 105: agent.v1.JobProgress.MySQLBackup
+		(*JobProgress_MySQLRestoreBackup)(nil),    // 106: agent.v1.JobProgress.MySQLRestoreBackup
+		(*JobProgress_Logs)(nil),                  // 107: agent.v1.JobProgress.Logs
+		(*GetVersionsRequest_MySQLd)(nil),         // 108: agent.v1.GetVersionsRequest.MySQLd
+		(*GetVersionsRequest_Xtrabackup)(nil),     // 109: agent.v1.GetVersionsRequest.Xtrabackup
+		(*GetVersionsRequest_Xbcloud)(nil),        // 110: agent.v1.GetVersionsRequest.Xbcloud
+		(*GetVersionsRequest_Qpress)(nil),         // 111: agent.v1.GetVersionsRequest.Qpress
+		(*GetVersionsRequest_MongoDB)(nil),        // 112: agent.v1.GetVersionsRequest.MongoDB
+		(*GetVersionsRequest_PBM)(nil),            // 113: agent.v1.GetVersionsRequest.PBM
+		(*GetVersionsRequest_PGBasebackup)(nil),   // 114: agent.v1.GetVersionsRequest.PGBasebackup
+		(*GetVersionsRequest_PGDump)(nil),         // 115: agent.v1.GetVersionsRequest.PGDump
+		(*GetVersionsRequest_PGRestore)(nil),      // 116: agent.v1.GetVersionsRequest.PGRestore
+		(*GetVersionsRequest_Software)(nil),       // 117: agent.v1.GetVersionsRequest.Software
+		(*GetVersionsResponse_Version)(nil),       // 118: agent.v1.GetVersionsResponse.Version
+		(*timestamppb.Timestamp)(nil),             // 119: google.protobuf.Timestamp
+		(*MetricsBucket)(nil),                     // 120: agent.v1.MetricsBucket
+		v1.AgentStatus(0),                         // 121: inventory.v1.AgentStatus
+		(*durationpb.Duration)(nil),               // 122: google.protobuf.Duration
+		v1.ServiceType(0),                         // 123: inventory.v1.ServiceType
+		(*status.Status)(nil),                     // 124: google.rpc.Status
+		v1.AgentType(0),                           // 125: inventory.v1.AgentType
+		(*v1.RTAOptions)(nil),                     // 126: inventory.v1.RTAOptions
+		v11.DataModel(0),                          // 127: backup.v1.DataModel
+		(*v11.PbmMetadata)(nil),                   // 128: backup.v1.PbmMetadata
+		(*v11.Metadata)(nil),                      // 129: backup.v1.Metadata
 	}
 )
 
 var file_agent_v1_agent_proto_depIdxs = []int32{
-	44,  // 0: agent.v1.TextFiles.files:type_name -> agent.v1.TextFiles.FilesEntry
-	94,  // 1: agent.v1.Pong.current_time:type_name -> google.protobuf.Timestamp
-	95,  // 2: agent.v1.QANCollectRequest.metrics_bucket:type_name -> agent.v1.MetricsBucket
-	96,  // 3: agent.v1.StateChangedRequest.status:type_name -> inventory.v1.AgentStatus
-	46,  // 4: agent.v1.SetStateRequest.agent_processes:type_name -> agent.v1.SetStateRequest.AgentProcessesEntry
-	48,  // 5: agent.v1.SetStateRequest.builtin_agents:type_name -> agent.v1.SetStateRequest.BuiltinAgentsEntry
-	94,  // 6: agent.v1.QueryActionValue.timestamp:type_name -> google.protobuf.Timestamp
+	46,  // 0: agent.v1.TextFiles.files:type_name -> agent.v1.TextFiles.FilesEntry
+	119, // 1: agent.v1.Pong.current_time:type_name -> google.protobuf.Timestamp
+	120, // 2: agent.v1.QANCollectRequest.metrics_bucket:type_name -> agent.v1.MetricsBucket
+	121, // 3: agent.v1.StateChangedRequest.status:type_name -> inventory.v1.AgentStatus
+	48,  // 4: agent.v1.SetStateRequest.agent_processes:type_name -> agent.v1.SetStateRequest.AgentProcessesEntry
+	50,  // 5: agent.v1.SetStateRequest.builtin_agents:type_name -> agent.v1.SetStateRequest.BuiltinAgentsEntry
+	119, // 6: agent.v1.QueryActionValue.timestamp:type_name -> google.protobuf.Timestamp
 	12,  // 7: agent.v1.QueryActionValue.slice:type_name -> agent.v1.QueryActionSlice
 	13,  // 8: agent.v1.QueryActionValue.map:type_name -> agent.v1.QueryActionMap
 	14,  // 9: agent.v1.QueryActionValue.binary:type_name -> agent.v1.QueryActionBinary
 	11,  // 10: agent.v1.QueryActionSlice.slice:type_name -> agent.v1.QueryActionValue
-	51,  // 11: agent.v1.QueryActionMap.map:type_name -> agent.v1.QueryActionMap.MapEntry
+	55,  // 11: agent.v1.QueryActionMap.map:type_name -> agent.v1.QueryActionMap.MapEntry
 	12,  // 12: agent.v1.QueryActionResult.rows:type_name -> agent.v1.QueryActionSlice
 	13,  // 13: agent.v1.QueryActionResult.docs:type_name -> agent.v1.QueryActionMap
-	97,  // 14: agent.v1.StartActionRequest.timeout:type_name -> google.protobuf.Duration
-	52,  // 15: agent.v1.StartActionRequest.mysql_explain_params:type_name -> agent.v1.StartActionRequest.MySQLExplainParams
-	53,  // 16: agent.v1.StartActionRequest.mysql_show_create_table_params:type_name -> agent.v1.StartActionRequest.MySQLShowCreateTableParams
-	54,  // 17: agent.v1.StartActionRequest.mysql_show_table_status_params:type_name -> agent.v1.StartActionRequest.MySQLShowTableStatusParams
-	55,  // 18: agent.v1.StartActionRequest.mysql_show_index_params:type_name -> agent.v1.StartActionRequest.MySQLShowIndexParams
-	56,  // 19: agent.v1.StartActionRequest.postgresql_show_create_table_params:type_name -> agent.v1.StartActionRequest.PostgreSQLShowCreateTableParams
-	57,  // 20: agent.v1.StartActionRequest.postgresql_show_index_params:type_name -> agent.v1.StartActionRequest.PostgreSQLShowIndexParams
-	58,  // 21: agent.v1.StartActionRequest.mongodb_explain_params:type_name -> agent.v1.StartActionRequest.MongoDBExplainParams
-	59,  // 22: agent.v1.StartActionRequest.pt_summary_params:type_name -> agent.v1.StartActionRequest.PTSummaryParams
-	60,  // 23: agent.v1.StartActionRequest.pt_pg_summary_params:type_name -> agent.v1.StartActionRequest.PTPgSummaryParams
-	61,  // 24: agent.v1.StartActionRequest.pt_mongodb_summary_params:type_name -> agent.v1.StartActionRequest.PTMongoDBSummaryParams
-	62,  // 25: agent.v1.StartActionRequest.pt_mysql_summary_params:type_name -> agent.v1.StartActionRequest.PTMySQLSummaryParams
-	63,  // 26: agent.v1.StartActionRequest.mysql_query_show_params:type_name -> agent.v1.StartActionRequest.MySQLQueryShowParams
-	64,  // 27: agent.v1.StartActionRequest.mysql_query_select_params:type_name -> agent.v1.StartActionRequest.MySQLQuerySelectParams
-	65,  // 28: agent.v1.StartActionRequest.postgresql_query_show_params:type_name -> agent.v1.StartActionRequest.PostgreSQLQueryShowParams
-	66,  // 29: agent.v1.StartActionRequest.postgresql_query_select_params:type_name -> agent.v1.StartActionRequest.PostgreSQLQuerySelectParams
-	67,  // 30: agent.v1.StartActionRequest.mongodb_query_getparameter_params:type_name -> agent.v1.StartActionRequest.MongoDBQueryGetParameterParams
-	68,  // 31: agent.v1.StartActionRequest.mongodb_query_buildinfo_params:type_name -> agent.v1.StartActionRequest.MongoDBQueryBuildInfoParams
-	69,  // 32: agent.v1.StartActionRequest.mongodb_query_getcmdlineopts_params:type_name -> agent.v1.StartActionRequest.MongoDBQueryGetCmdLineOptsParams
-	70,  // 33: agent.v1.StartActionRequest.mongodb_query_replsetgetstatus_params:type_name -> agent.v1.StartActionRequest.MongoDBQueryReplSetGetStatusParams
-	71,  // 34: agent.v1.StartActionRequest.mongodb_query_getdiagnosticdata_params:type_name -> agent.v1.StartActionRequest.MongoDBQueryGetDiagnosticDataParams
-	72,  // 35: agent.v1.StartActionRequest.restart_sys_service_params:type_name -> agent.v1.StartActionRequest.RestartSystemServiceParams
-	2,   // 36: agent.v1.PBMSwitchPITRRequest.text_files:type_name -> agent.v1.TextFiles
-	98,  // 37: agent.v1.CheckConnectionRequest.type:type_name -> inventory.v1.ServiceType
-	97,  // 38: agent.v1.CheckConnectionRequest.timeout:type_name -> google.protobuf.Duration
-	2,   // 39: agent.v1.CheckConnectionRequest.text_files:type_name -> agent.v1.TextFiles
-	98,  // 40: agent.v1.ServiceInfoRequest.type:type_name -> inventory.v1.ServiceType
-	97,  // 41: agent.v1.ServiceInfoRequest.timeout:type_name -> google.protobuf.Duration
-	2,   // 42: agent.v1.ServiceInfoRequest.text_files:type_name -> agent.v1.TextFiles
-	97,  // 43: agent.v1.StartJobRequest.timeout:type_name -> google.protobuf.Duration
-	74,  // 44: agent.v1.StartJobRequest.mysql_backup:type_name -> agent.v1.StartJobRequest.MySQLBackup
-	75,  // 45: agent.v1.StartJobRequest.mysql_restore_backup:type_name -> agent.v1.StartJobRequest.MySQLRestoreBackup
-	76,  // 46: agent.v1.StartJobRequest.mongodb_backup:type_name -> agent.v1.StartJobRequest.MongoDBBackup
-	77,  // 47: agent.v1.StartJobRequest.mongodb_restore_backup:type_name -> agent.v1.StartJobRequest.MongoDBRestoreBackup
-	94,  // 48: agent.v1.JobResult.timestamp:type_name -> google.protobuf.Timestamp
-	78,  // 49: agent.v1.JobResult.error:type_name -> agent.v1.JobResult.Error
-	80,  // 50: agent.v1.JobResult.mysql_backup:type_name -> agent.v1.JobResult.MySQLBackup
-	81,  // 51: agent.v1.JobResult.mysql_restore_backup:type_name -> agent.v1.JobResult.MySQLRestoreBackup
-	79,  // 52: agent.v1.JobResult.mongodb_backup:type_name -> agent.v1.JobResult.MongoDBBackup
-	82,  // 53: agent.v1.JobResult.mongodb_restore_backup:type_name -> agent.v1.JobResult.MongoDBRestoreBackup
-	94,  // 54: agent.v1.JobProgress.timestamp:type_name -> google.protobuf.Timestamp
-	83,  // 55: agent.v1.JobProgress.mysql_backup:type_name -> agent.v1.JobProgress.MySQLBackup
-	84,  // 56: agent.v1.JobProgress.mysql_restore_backup:type_name -> agent.v1.JobProgress.MySQLRestoreBackup
-	85,  // 57: agent.v1.JobProgress.logs:type_name -> agent.v1.JobProgress.Logs
-	92,  // 58: agent.v1.GetVersionsRequest.softwares:type_name -> agent.v1.GetVersionsRequest.Software
-	93,  // 59: agent.v1.GetVersionsResponse.versions:type_name -> agent.v1.GetVersionsResponse.Version
-	99,  // 60: agent.v1.AgentMessage.status:type_name -> google.rpc.Status
-	3,   // 61: agent.v1.AgentMessage.ping:type_name -> agent.v1.Ping
-	7,   // 62: agent.v1.AgentMessage.state_changed:type_name -> agent.v1.StateChangedRequest
-	5,   // 63: agent.v1.AgentMessage.qan_collect:type_name -> agent.v1.QANCollectRequest
-	20,  // 64: agent.v1.AgentMessage.action_result:type_name -> agent.v1.ActionResultRequest
-	38,  // 65: agent.v1.AgentMessage.job_result:type_name -> agent.v1.JobResult
-	39,  // 66: agent.v1.AgentMessage.job_progress:type_name -> agent.v1.JobProgress
-	4,   // 67: agent.v1.AgentMessage.pong:type_name -> agent.v1.Pong
-	10,  // 68: agent.v1.AgentMessage.set_state:type_name -> agent.v1.SetStateResponse
-	17,  // 69: agent.v1.AgentMessage.start_action:type_name -> agent.v1.StartActionResponse
-	19,  // 70: agent.v1.AgentMessage.stop_action:type_name -> agent.v1.StopActionResponse
-	27,  // 71: agent.v1.AgentMessage.check_connection:type_name -> agent.v1.CheckConnectionResponse
-	35,  // 72: agent.v1.AgentMessage.start_job:type_name -> agent.v1.StartJobResponse
-	37,  // 73: agent.v1.AgentMessage.stop_job:type_name -> agent.v1.StopJobResponse
-	31,  // 74: agent.v1.AgentMessage.job_status:type_name -> agent.v1.JobStatusResponse
-	41,  // 75: agent.v1.AgentMessage.get_versions:type_name -> agent.v1.GetVersionsResponse
-	23,  // 76: agent.v1.AgentMessage.pbm_switch_pitr:type_name -> agent.v1.PBMSwitchPITRResponse
-	25,  // 77: agent.v1.AgentMessage.agent_logs:type_name -> agent.v1.AgentLogsResponse
-	29,  // 78: agent.v1.AgentMessage.service_info:type_name -> agent.v1.ServiceInfoResponse
-	99,  // 79: agent.v1.ServerMessage.status:type_name -> google.rpc.Status
-	4,   // 80: agent.v1.ServerMessage.pong:type_name -> agent.v1.Pong
-	8,   // 81: agent.v1.ServerMessage.state_changed:type_name -> agent.v1.StateChangedResponse
-	6,   // 82: agent.v1.ServerMessage.qan_collect:type_name -> agent.v1.QANCollectResponse
-	21,  // 83: agent.v1.ServerMessage.action_result:type_name -> agent.v1.ActionResultResponse
-	3,   // 84: agent.v1.ServerMessage.ping:type_name -> agent.v1.Ping
-	9,   // 85: agent.v1.ServerMessage.set_state:type_name -> agent.v1.SetStateRequest
-	16,  // 86: agent.v1.ServerMessage.start_action:type_name -> agent.v1.StartActionRequest
-	18,  // 87: agent.v1.ServerMessage.stop_action:type_name -> agent.v1.StopActionRequest
-	26,  // 88: agent.v1.ServerMessage.check_connection:type_name -> agent.v1.CheckConnectionRequest
-	34,  // 89: agent.v1.ServerMessage.start_job:type_name -> agent.v1.StartJobRequest
-	36,  // 90: agent.v1.ServerMessage.stop_job:type_name -> agent.v1.StopJobRequest
-	30,  // 91: agent.v1.ServerMessage.job_status:type_name -> agent.v1.JobStatusRequest
-	40,  // 92: agent.v1.ServerMessage.get_versions:type_name -> agent.v1.GetVersionsRequest
-	22,  // 93: agent.v1.ServerMessage.pbm_switch_pitr:type_name -> agent.v1.PBMSwitchPITRRequest
-	24,  // 94: agent.v1.ServerMessage.agent_logs:type_name -> agent.v1.AgentLogsRequest
-	28,  // 95: agent.v1.ServerMessage.service_info:type_name -> agent.v1.ServiceInfoRequest
-	100, // 96: agent.v1.SetStateRequest.AgentProcess.type:type_name -> inventory.v1.AgentType
-	49,  // 97: agent.v1.SetStateRequest.AgentProcess.text_files:type_name -> agent.v1.SetStateRequest.AgentProcess.TextFilesEntry
-	45,  // 98: agent.v1.SetStateRequest.AgentProcessesEntry.value:type_name -> agent.v1.SetStateRequest.AgentProcess
-	100, // 99: agent.v1.SetStateRequest.BuiltinAgent.type:type_name -> inventory.v1.AgentType
-	2,   // 100: agent.v1.SetStateRequest.BuiltinAgent.text_files:type_name -> agent.v1.TextFiles
-	50,  // 101: agent.v1.SetStateRequest.BuiltinAgent.env:type_name -> agent.v1.SetStateRequest.BuiltinAgent.EnvEntry
-	101, // 102: agent.v1.SetStateRequest.BuiltinAgent.rta_options:type_name -> inventory.v1.RTAOptions
-	47,  // 103: agent.v1.SetStateRequest.BuiltinAgentsEntry.value:type_name -> agent.v1.SetStateRequest.BuiltinAgent
-	11,  // 104: agent.v1.QueryActionMap.MapEntry.value:type_name -> agent.v1.QueryActionValue
-	0,   // 105: agent.v1.StartActionRequest.MySQLExplainParams.output_format:type_name -> agent.v1.MysqlExplainOutputFormat
-	2,   // 106: agent.v1.StartActionRequest.MySQLExplainParams.tls_files:type_name -> agent.v1.TextFiles
-	2,   // 107: agent.v1.StartActionRequest.MySQLShowCreateTableParams.tls_files:type_name -> agent.v1.TextFiles
-	2,   // 108: agent.v1.StartActionRequest.MySQLShowTableStatusParams.tls_files:type_name -> agent.v1.TextFiles
-	2,   // 109: agent.v1.StartActionRequest.MySQLShowIndexParams.tls_files:type_name -> agent.v1.TextFiles
-	2,   // 110: agent.v1.StartActionRequest.PostgreSQLShowCreateTableParams.tls_files:type_name -> agent.v1.TextFiles
-	2,   // 111: agent.v1.StartActionRequest.PostgreSQLShowIndexParams.tls_files:type_name -> agent.v1.TextFiles
-	2,   // 112: agent.v1.StartActionRequest.MongoDBExplainParams.text_files:type_name -> agent.v1.TextFiles
-	2,   // 113: agent.v1.StartActionRequest.MySQLQueryShowParams.tls_files:type_name -> agent.v1.TextFiles
-	2,   // 114: agent.v1.StartActionRequest.MySQLQuerySelectParams.tls_files:type_name -> agent.v1.TextFiles
-	2,   // 115: agent.v1.StartActionRequest.PostgreSQLQueryShowParams.tls_files:type_name -> agent.v1.TextFiles
-	2,   // 116: agent.v1.StartActionRequest.PostgreSQLQuerySelectParams.tls_files:type_name -> agent.v1.TextFiles
-	2,   // 117: agent.v1.StartActionRequest.MongoDBQueryGetParameterParams.text_files:type_name -> agent.v1.TextFiles
-	2,   // 118: agent.v1.StartActionRequest.MongoDBQueryBuildInfoParams.text_files:type_name -> agent.v1.TextFiles
-	2,   // 119: agent.v1.StartActionRequest.MongoDBQueryGetCmdLineOptsParams.text_files:type_name -> agent.v1.TextFiles
-	2,   // 120: agent.v1.StartActionRequest.MongoDBQueryReplSetGetStatusParams.text_files:type_name -> agent.v1.TextFiles
-	2,   // 121: agent.v1.StartActionRequest.MongoDBQueryGetDiagnosticDataParams.text_files:type_name -> agent.v1.TextFiles
-	1,   // 122: agent.v1.StartActionRequest.RestartSystemServiceParams.system_service:type_name -> agent.v1.StartActionRequest.RestartSystemServiceParams.SystemService
-	32,  // 123: agent.v1.StartJobRequest.MySQLBackup.s3_config:type_name -> agent.v1.S3LocationConfig
-	32,  // 124: agent.v1.StartJobRequest.MySQLRestoreBackup.s3_config:type_name -> agent.v1.S3LocationConfig
-	2,   // 125: agent.v1.StartJobRequest.MongoDBBackup.text_files:type_name -> agent.v1.TextFiles
-	102, // 126: agent.v1.StartJobRequest.MongoDBBackup.data_model:type_name -> backup.v1.DataModel
-	32,  // 127: agent.v1.StartJobRequest.MongoDBBackup.s3_config:type_name -> agent.v1.S3LocationConfig
-	33,  // 128: agent.v1.StartJobRequest.MongoDBBackup.filesystem_config:type_name -> agent.v1.FilesystemLocationConfig
-	2,   // 129: agent.v1.StartJobRequest.MongoDBRestoreBackup.text_files:type_name -> agent.v1.TextFiles
-	103, // 130: agent.v1.StartJobRequest.MongoDBRestoreBackup.pbm_metadata:type_name -> backup.v1.PbmMetadata
-	94,  // 131: agent.v1.StartJobRequest.MongoDBRestoreBackup.pitr_timestamp:type_name -> google.protobuf.Timestamp
-	32,  // 132: agent.v1.StartJobRequest.MongoDBRestoreBackup.s3_config:type_name -> agent.v1.S3LocationConfig
-	33,  // 133: agent.v1.StartJobRequest.MongoDBRestoreBackup.filesystem_config:type_name -> agent.v1.FilesystemLocationConfig
-	104, // 134: agent.v1.JobResult.MongoDBBackup.metadata:type_name -> backup.v1.Metadata
-	104, // 135: agent.v1.JobResult.MySQLBackup.metadata:type_name -> backup.v1.Metadata
-	86,  // 136: agent.v1.GetVersionsRequest.Software.mysqld:type_name -> agent.v1.GetVersionsRequest.MySQLd
-	87,  // 137: agent.v1.GetVersionsRequest.Software.xtrabackup:type_name -> agent.v1.GetVersionsRequest.Xtrabackup
-	88,  // 138: agent.v1.GetVersionsRequest.Software.xbcloud:type_name -> agent.v1.GetVersionsRequest.Xbcloud
-	89,  // 139: agent.v1.GetVersionsRequest.Software.qpress:type_name -> agent.v1.GetVersionsRequest.Qpress
-	90,  // 140: agent.v1.GetVersionsRequest.Software.mongod:type_name -> agent.v1.GetVersionsRequest.MongoDB
-	91,  // 141: agent.v1.GetVersionsRequest.Software.pbm:type_name -> agent.v1.GetVersionsRequest.PBM
-	42,  // 142: agent.v1.AgentService.Connect:input_type -> agent.v1.AgentMessage
-	43,  // 143: agent.v1.AgentService.Connect:output_type -> agent.v1.ServerMessage
-	143, // [143:144] is the sub-list for method output_type
-	142, // [142:143] is the sub-list for method input_type
-	142, // [142:142] is the sub-list for extension type_name
-	142, // [142:142] is the sub-list for extension extendee
-	0,   // [0:142] is the sub-list for field type_name
+	122, // 14: agent.v1.StartActionRequest.timeout:type_name -> google.protobuf.Duration
+	80,  // 15: agent.v1.StartActionRequest.secrets:type_name -> agent.v1.StartActionRequest.SecretsEntry
+	56,  // 16: agent.v1.StartActionRequest.mysql_explain_params:type_name -> agent.v1.StartActionRequest.MySQLExplainParams
+	57,  // 17: agent.v1.StartActionRequest.mysql_show_create_table_params:type_name -> agent.v1.StartActionRequest.MySQLShowCreateTableParams
+	58,  // 18: agent.v1.StartActionRequest.mysql_show_table_status_params:type_name -> agent.v1.StartActionRequest.MySQLShowTableStatusParams
+	59,  // 19: agent.v1.StartActionRequest.mysql_show_index_params:type_name -> agent.v1.StartActionRequest.MySQLShowIndexParams
+	60,  // 20: agent.v1.StartActionRequest.postgresql_show_create_table_params:type_name -> agent.v1.StartActionRequest.PostgreSQLShowCreateTableParams
+	61,  // 21: agent.v1.StartActionRequest.postgresql_show_index_params:type_name -> agent.v1.StartActionRequest.PostgreSQLShowIndexParams
+	62,  // 22: agent.v1.StartActionRequest.mongodb_explain_params:type_name -> agent.v1.StartActionRequest.MongoDBExplainParams
+	63,  // 23: agent.v1.StartActionRequest.pt_summary_params:type_name -> agent.v1.StartActionRequest.PTSummaryParams
+	64,  // 24: agent.v1.StartActionRequest.pt_pg_summary_params:type_name -> agent.v1.StartActionRequest.PTPgSummaryParams
+	65,  // 25: agent.v1.StartActionRequest.pt_mongodb_summary_params:type_name -> agent.v1.StartActionRequest.PTMongoDBSummaryParams
+	66,  // 26: agent.v1.StartActionRequest.pt_mysql_summary_params:type_name -> agent.v1.StartActionRequest.PTMySQLSummaryParams
+	67,  // 27: agent.v1.StartActionRequest.mysql_query_show_params:type_name -> agent.v1.StartActionRequest.MySQLQueryShowParams
+	68,  // 28: agent.v1.StartActionRequest.mysql_query_select_params:type_name -> agent.v1.StartActionRequest.MySQLQuerySelectParams
+	69,  // 29: agent.v1.StartActionRequest.postgresql_query_show_params:type_name -> agent.v1.StartActionRequest.PostgreSQLQueryShowParams
+	70,  // 30: agent.v1.StartActionRequest.postgresql_query_select_params:type_name -> agent.v1.StartActionRequest.PostgreSQLQuerySelectParams
+	71,  // 31: agent.v1.StartActionRequest.mongodb_query_getparameter_params:type_name -> agent.v1.StartActionRequest.MongoDBQueryGetParameterParams
+	72,  // 32: agent.v1.StartActionRequest.mongodb_query_buildinfo_params:type_name -> agent.v1.StartActionRequest.MongoDBQueryBuildInfoParams
+	73,  // 33: agent.v1.StartActionRequest.mongodb_query_getcmdlineopts_params:type_name -> agent.v1.StartActionRequest.MongoDBQueryGetCmdLineOptsParams
+	74,  // 34: agent.v1.StartActionRequest.mongodb_query_replsetgetstatus_params:type_name -> agent.v1.StartActionRequest.MongoDBQueryReplSetGetStatusParams
+	75,  // 35: agent.v1.StartActionRequest.mongodb_query_getdiagnosticdata_params:type_name -> agent.v1.StartActionRequest.MongoDBQueryGetDiagnosticDataParams
+	76,  // 36: agent.v1.StartActionRequest.mongodb_kill_op_params:type_name -> agent.v1.StartActionRequest.MongoDBKillOpParams
+	77,  // 37: agent.v1.StartActionRequest.mysql_kill_query_params:type_name -> agent.v1.StartActionRequest.MySQLKillQueryParams
+	78,  // 38: agent.v1.StartActionRequest.postgresql_cancel_backend_params:type_name -> agent.v1.StartActionRequest.PostgreSQLCancelBackendParams
+	79,  // 39: agent.v1.StartActionRequest.restart_sys_service_params:type_name -> agent.v1.StartActionRequest.RestartSystemServiceParams
+	2,   // 40: agent.v1.PBMSwitchPITRRequest.text_files:type_name -> agent.v1.TextFiles
+	81,  // 41: agent.v1.PBMSwitchPITRRequest.secrets:type_name -> agent.v1.PBMSwitchPITRRequest.SecretsEntry
+	123, // 42: agent.v1.CheckConnectionRequest.type:type_name -> inventory.v1.ServiceType
+	122, // 43: agent.v1.CheckConnectionRequest.timeout:type_name -> google.protobuf.Duration
+	2,   // 44: agent.v1.CheckConnectionRequest.text_files:type_name -> agent.v1.TextFiles
+	82,  // 45: agent.v1.CheckConnectionRequest.secrets:type_name -> agent.v1.CheckConnectionRequest.SecretsEntry
+	123, // 46: agent.v1.ServiceInfoRequest.type:type_name -> inventory.v1.ServiceType
+	122, // 47: agent.v1.ServiceInfoRequest.timeout:type_name -> google.protobuf.Duration
+	2,   // 48: agent.v1.ServiceInfoRequest.text_files:type_name -> agent.v1.TextFiles
+	84,  // 49: agent.v1.ServiceInfoRequest.secrets:type_name -> agent.v1.ServiceInfoRequest.SecretsEntry
+	122, // 50: agent.v1.StartJobRequest.timeout:type_name -> google.protobuf.Duration
+	94,  // 51: agent.v1.StartJobRequest.secrets:type_name -> agent.v1.StartJobRequest.SecretsEntry
+	85,  // 52: agent.v1.StartJobRequest.mysql_backup:type_name -> agent.v1.StartJobRequest.MySQLBackup
+	86,  // 53: agent.v1.StartJobRequest.mysql_restore_backup:type_name -> agent.v1.StartJobRequest.MySQLRestoreBackup
+	87,  // 54: agent.v1.StartJobRequest.mongodb_backup:type_name -> agent.v1.StartJobRequest.MongoDBBackup
+	88,  // 55: agent.v1.StartJobRequest.mongodb_restore_backup:type_name -> agent.v1.StartJobRequest.MongoDBRestoreBackup
+	89,  // 56: agent.v1.StartJobRequest.postgresql_backup:type_name -> agent.v1.StartJobRequest.PostgreSQLBackup
+	90,  // 57: agent.v1.StartJobRequest.postgresql_restore_backup:type_name -> agent.v1.StartJobRequest.PostgreSQLRestoreBackup
+	91,  // 58: agent.v1.StartJobRequest.mysql_verify_backup:type_name -> agent.v1.StartJobRequest.MySQLVerifyBackup
+	92,  // 59: agent.v1.StartJobRequest.mongodb_verify_backup:type_name -> agent.v1.StartJobRequest.MongoDBVerifyBackup
+	93,  // 60: agent.v1.StartJobRequest.mysql_binlog_stream:type_name -> agent.v1.StartJobRequest.MySQLBinlogStream
+	119, // 61: agent.v1.JobResult.timestamp:type_name -> google.protobuf.Timestamp
+	95,  // 62: agent.v1.JobResult.error:type_name -> agent.v1.JobResult.Error
+	97,  // 63: agent.v1.JobResult.mysql_backup:type_name -> agent.v1.JobResult.MySQLBackup
+	98,  // 64: agent.v1.JobResult.mysql_restore_backup:type_name -> agent.v1.JobResult.MySQLRestoreBackup
+	96,  // 65: agent.v1.JobResult.mongodb_backup:type_name -> agent.v1.JobResult.MongoDBBackup
+	99,  // 66: agent.v1.JobResult.mongodb_restore_backup:type_name -> agent.v1.JobResult.MongoDBRestoreBackup
+	100, // 67: agent.v1.JobResult.postgresql_backup:type_name -> agent.v1.JobResult.PostgreSQLBackup
+	101, // 68: agent.v1.JobResult.postgresql_restore_backup:type_name -> agent.v1.JobResult.PostgreSQLRestoreBackup
+	102, // 69: agent.v1.JobResult.mysql_verify_backup:type_name -> agent.v1.JobResult.MySQLVerifyBackup
+	103, // 70: agent.v1.JobResult.mongodb_verify_backup:type_name -> agent.v1.JobResult.MongoDBVerifyBackup
+	104, // 71: agent.v1.JobResult.mysql_binlog_stream:type_name -> agent.v1.JobResult.MySQLBinlogStream
+	119, // 72: agent.v1.JobProgress.timestamp:type_name -> google.protobuf.Timestamp
+	105, // 73: agent.v1.JobProgress.mysql_backup:type_name -> agent.v1.JobProgress.MySQLBackup
+	106, // 74: agent.v1.JobProgress.mysql_restore_backup:type_name -> agent.v1.JobProgress.MySQLRestoreBackup
+	107, // 75: agent.v1.JobProgress.logs:type_name -> agent.v1.JobProgress.Logs
+	117, // 76: agent.v1.GetVersionsRequest.softwares:type_name -> agent.v1.GetVersionsRequest.Software
+	118, // 77: agent.v1.GetVersionsResponse.versions:type_name -> agent.v1.GetVersionsResponse.Version
+	124, // 78: agent.v1.AgentMessage.status:type_name -> google.rpc.Status
+	3,   // 79: agent.v1.AgentMessage.ping:type_name -> agent.v1.Ping
+	7,   // 80: agent.v1.AgentMessage.state_changed:type_name -> agent.v1.StateChangedRequest
+	5,   // 81: agent.v1.AgentMessage.qan_collect:type_name -> agent.v1.QANCollectRequest
+	20,  // 82: agent.v1.AgentMessage.action_result:type_name -> agent.v1.ActionResultRequest
+	38,  // 83: agent.v1.AgentMessage.job_result:type_name -> agent.v1.JobResult
+	39,  // 84: agent.v1.AgentMessage.job_progress:type_name -> agent.v1.JobProgress
+	42,  // 85: agent.v1.AgentMessage.renew_certificate:type_name -> agent.v1.RenewCertificateRequest
+	4,   // 86: agent.v1.AgentMessage.pong:type_name -> agent.v1.Pong
+	10,  // 87: agent.v1.AgentMessage.set_state:type_name -> agent.v1.SetStateResponse
+	17,  // 88: agent.v1.AgentMessage.start_action:type_name -> agent.v1.StartActionResponse
+	19,  // 89: agent.v1.AgentMessage.stop_action:type_name -> agent.v1.StopActionResponse
+	27,  // 90: agent.v1.AgentMessage.check_connection:type_name -> agent.v1.CheckConnectionResponse
+	35,  // 91: agent.v1.AgentMessage.start_job:type_name -> agent.v1.StartJobResponse
+	37,  // 92: agent.v1.AgentMessage.stop_job:type_name -> agent.v1.StopJobResponse
+	31,  // 93: agent.v1.AgentMessage.job_status:type_name -> agent.v1.JobStatusResponse
+	41,  // 94: agent.v1.AgentMessage.get_versions:type_name -> agent.v1.GetVersionsResponse
+	23,  // 95: agent.v1.AgentMessage.pbm_switch_pitr:type_name -> agent.v1.PBMSwitchPITRResponse
+	25,  // 96: agent.v1.AgentMessage.agent_logs:type_name -> agent.v1.AgentLogsResponse
+	29,  // 97: agent.v1.AgentMessage.service_info:type_name -> agent.v1.ServiceInfoResponse
+	124, // 98: agent.v1.ServerMessage.status:type_name -> google.rpc.Status
+	4,   // 99: agent.v1.ServerMessage.pong:type_name -> agent.v1.Pong
+	8,   // 100: agent.v1.ServerMessage.state_changed:type_name -> agent.v1.StateChangedResponse
+	6,   // 101: agent.v1.ServerMessage.qan_collect:type_name -> agent.v1.QANCollectResponse
+	21,  // 102: agent.v1.ServerMessage.action_result:type_name -> agent.v1.ActionResultResponse
+	43,  // 103: agent.v1.ServerMessage.renew_certificate:type_name -> agent.v1.RenewCertificateResponse
+	3,   // 104: agent.v1.ServerMessage.ping:type_name -> agent.v1.Ping
+	9,   // 105: agent.v1.ServerMessage.set_state:type_name -> agent.v1.SetStateRequest
+	16,  // 106: agent.v1.ServerMessage.start_action:type_name -> agent.v1.StartActionRequest
+	18,  // 107: agent.v1.ServerMessage.stop_action:type_name -> agent.v1.StopActionRequest
+	26,  // 108: agent.v1.ServerMessage.check_connection:type_name -> agent.v1.CheckConnectionRequest
+	34,  // 109: agent.v1.ServerMessage.start_job:type_name -> agent.v1.StartJobRequest
+	36,  // 110: agent.v1.ServerMessage.stop_job:type_name -> agent.v1.StopJobRequest
+	30,  // 111: agent.v1.ServerMessage.job_status:type_name -> agent.v1.JobStatusRequest
+	40,  // 112: agent.v1.ServerMessage.get_versions:type_name -> agent.v1.GetVersionsRequest
+	22,  // 113: agent.v1.ServerMessage.pbm_switch_pitr:type_name -> agent.v1.PBMSwitchPITRRequest
+	24,  // 114: agent.v1.ServerMessage.agent_logs:type_name -> agent.v1.AgentLogsRequest
+	28,  // 115: agent.v1.ServerMessage.service_info:type_name -> agent.v1.ServiceInfoRequest
+	125, // 116: agent.v1.SetStateRequest.AgentProcess.type:type_name -> inventory.v1.AgentType
+	51,  // 117: agent.v1.SetStateRequest.AgentProcess.text_files:type_name -> agent.v1.SetStateRequest.AgentProcess.TextFilesEntry
+	52,  // 118: agent.v1.SetStateRequest.AgentProcess.secrets:type_name -> agent.v1.SetStateRequest.AgentProcess.SecretsEntry
+	47,  // 119: agent.v1.SetStateRequest.AgentProcessesEntry.value:type_name -> agent.v1.SetStateRequest.AgentProcess
+	125, // 120: agent.v1.SetStateRequest.BuiltinAgent.type:type_name -> inventory.v1.AgentType
+	2,   // 121: agent.v1.SetStateRequest.BuiltinAgent.text_files:type_name -> agent.v1.TextFiles
+	53,  // 122: agent.v1.SetStateRequest.BuiltinAgent.env:type_name -> agent.v1.SetStateRequest.BuiltinAgent.EnvEntry
+	126, // 123: agent.v1.SetStateRequest.BuiltinAgent.rta_options:type_name -> inventory.v1.RTAOptions
+	54,  // 124: agent.v1.SetStateRequest.BuiltinAgent.secrets:type_name -> agent.v1.SetStateRequest.BuiltinAgent.SecretsEntry
+	49,  // 125: agent.v1.SetStateRequest.BuiltinAgentsEntry.value:type_name -> agent.v1.SetStateRequest.BuiltinAgent
+	11,  // 126: agent.v1.QueryActionMap.MapEntry.value:type_name -> agent.v1.QueryActionValue
+	0,   // 127: agent.v1.StartActionRequest.MySQLExplainParams.output_format:type_name -> agent.v1.MysqlExplainOutputFormat
+	2,   // 128: agent.v1.StartActionRequest.MySQLExplainParams.tls_files:type_name -> agent.v1.TextFiles
+	2,   // 129: agent.v1.StartActionRequest.MySQLShowCreateTableParams.tls_files:type_name -> agent.v1.TextFiles
+	2,   // 130: agent.v1.StartActionRequest.MySQLShowTableStatusParams.tls_files:type_name -> agent.v1.TextFiles
+	2,   // 131: agent.v1.StartActionRequest.MySQLShowIndexParams.tls_files:type_name -> agent.v1.TextFiles
+	2,   // 132: agent.v1.StartActionRequest.PostgreSQLShowCreateTableParams.tls_files:type_name -> agent.v1.TextFiles
+	2,   // 133: agent.v1.StartActionRequest.PostgreSQLShowIndexParams.tls_files:type_name -> agent.v1.TextFiles
+	2,   // 134: agent.v1.StartActionRequest.MongoDBExplainParams.text_files:type_name -> agent.v1.TextFiles
+	2,   // 135: agent.v1.StartActionRequest.MySQLQueryShowParams.tls_files:type_name -> agent.v1.TextFiles
+	2,   // 136: agent.v1.StartActionRequest.MySQLQuerySelectParams.tls_files:type_name -> agent.v1.TextFiles
+	2,   // 137: agent.v1.StartActionRequest.PostgreSQLQueryShowParams.tls_files:type_name -> agent.v1.TextFiles
+	2,   // 138: agent.v1.StartActionRequest.PostgreSQLQuerySelectParams.tls_files:type_name -> agent.v1.TextFiles
+	2,   // 139: agent.v1.StartActionRequest.MongoDBQueryGetParameterParams.text_files:type_name -> agent.v1.TextFiles
+	2,   // 140: agent.v1.StartActionRequest.MongoDBQueryBuildInfoParams.text_files:type_name -> agent.v1.TextFiles
+	2,   // 141: agent.v1.StartActionRequest.MongoDBQueryGetCmdLineOptsParams.text_files:type_name -> agent.v1.TextFiles
+	2,   // 142: agent.v1.StartActionRequest.MongoDBQueryReplSetGetStatusParams.text_files:type_name -> agent.v1.TextFiles
+	2,   // 143: agent.v1.StartActionRequest.MongoDBQueryGetDiagnosticDataParams.text_files:type_name -> agent.v1.TextFiles
+	2,   // 144: agent.v1.StartActionRequest.MongoDBKillOpParams.text_files:type_name -> agent.v1.TextFiles
+	2,   // 145: agent.v1.StartActionRequest.MySQLKillQueryParams.tls_files:type_name -> agent.v1.TextFiles
+	119, // 146: agent.v1.StartActionRequest.MySQLKillQueryParams.query_start:type_name -> google.protobuf.Timestamp
+	2,   // 147: agent.v1.StartActionRequest.PostgreSQLCancelBackendParams.tls_files:type_name -> agent.v1.TextFiles
+	119, // 148: agent.v1.StartActionRequest.PostgreSQLCancelBackendParams.query_start:type_name -> google.protobuf.Timestamp
+	1,   // 149: agent.v1.StartActionRequest.RestartSystemServiceParams.system_service:type_name -> agent.v1.StartActionRequest.RestartSystemServiceParams.SystemService
+	32,  // 150: agent.v1.StartJobRequest.MySQLBackup.s3_config:type_name -> agent.v1.S3LocationConfig
+	119, // 151: agent.v1.StartJobRequest.MySQLRestoreBackup.pitr_timestamp:type_name -> google.protobuf.Timestamp
+	32,  // 152: agent.v1.StartJobRequest.MySQLRestoreBackup.s3_config:type_name -> agent.v1.S3LocationConfig
+	2,   // 153: agent.v1.StartJobRequest.MongoDBBackup.text_files:type_name -> agent.v1.TextFiles
+	127, // 154: agent.v1.StartJobRequest.MongoDBBackup.data_model:type_name -> backup.v1.DataModel
+	32,  // 155: agent.v1.StartJobRequest.MongoDBBackup.s3_config:type_name -> agent.v1.S3LocationConfig
+	33,  // 156: agent.v1.StartJobRequest.MongoDBBackup.filesystem_config:type_name -> agent.v1.FilesystemLocationConfig
+	2,   // 157: agent.v1.StartJobRequest.MongoDBRestoreBackup.text_files:type_name -> agent.v1.TextFiles
+	128, // 158: agent.v1.StartJobRequest.MongoDBRestoreBackup.pbm_metadata:type_name -> backup.v1.PbmMetadata
+	119, // 159: agent.v1.StartJobRequest.MongoDBRestoreBackup.pitr_timestamp:type_name -> google.protobuf.Timestamp
+	32,  // 160: agent.v1.StartJobRequest.MongoDBRestoreBackup.s3_config:type_name -> agent.v1.S3LocationConfig
+	33,  // 161: agent.v1.StartJobRequest.MongoDBRestoreBackup.filesystem_config:type_name -> agent.v1.FilesystemLocationConfig
+	2,   // 162: agent.v1.StartJobRequest.PostgreSQLBackup.text_files:type_name -> agent.v1.TextFiles
+	127, // 163: agent.v1.StartJobRequest.PostgreSQLBackup.data_model:type_name -> backup.v1.DataModel
+	32,  // 164: agent.v1.StartJobRequest.PostgreSQLBackup.s3_config:type_name -> agent.v1.S3LocationConfig
+	33,  // 165: agent.v1.StartJobRequest.PostgreSQLBackup.filesystem_config:type_name -> agent.v1.FilesystemLocationConfig
+	2,   // 166: agent.v1.StartJobRequest.PostgreSQLRestoreBackup.text_files:type_name -> agent.v1.TextFiles
+	127, // 167: agent.v1.StartJobRequest.PostgreSQLRestoreBackup.data_model:type_name -> backup.v1.DataModel
+	32,  // 168: agent.v1.StartJobRequest.PostgreSQLRestoreBackup.s3_config:type_name -> agent.v1.S3LocationConfig
+	33,  // 169: agent.v1.StartJobRequest.PostgreSQLRestoreBackup.filesystem_config:type_name -> agent.v1.FilesystemLocationConfig
+	32,  // 170: agent.v1.StartJobRequest.MySQLVerifyBackup.s3_config:type_name -> agent.v1.S3LocationConfig
+	128, // 171: agent.v1.StartJobRequest.MongoDBVerifyBackup.pbm_metadata:type_name -> backup.v1.PbmMetadata
+	32,  // 172: agent.v1.StartJobRequest.MongoDBVerifyBackup.s3_config:type_name -> agent.v1.S3LocationConfig
+	33,  // 173: agent.v1.StartJobRequest.MongoDBVerifyBackup.filesystem_config:type_name -> agent.v1.FilesystemLocationConfig
+	32,  // 174: agent.v1.StartJobRequest.MySQLBinlogStream.s3_config:type_name -> agent.v1.S3LocationConfig
+	129, // 175: agent.v1.JobResult.MongoDBBackup.metadata:type_name -> backup.v1.Metadata
+	129, // 176: agent.v1.JobResult.MySQLBackup.metadata:type_name -> backup.v1.Metadata
+	129, // 177: agent.v1.JobResult.PostgreSQLBackup.metadata:type_name -> backup.v1.Metadata
+	108, // 178: agent.v1.GetVersionsRequest.Software.mysqld:type_name -> agent.v1.GetVersionsRequest.MySQLd
+	109, // 179: agent.v1.GetVersionsRequest.Software.xtrabackup:type_name -> agent.v1.GetVersionsRequest.Xtrabackup
+	110, // 180: agent.v1.GetVersionsRequest.Software.xbcloud:type_name -> agent.v1.GetVersionsRequest.Xbcloud
+	111, // 181: agent.v1.GetVersionsRequest.Software.qpress:type_name -> agent.v1.GetVersionsRequest.Qpress
+	112, // 182: agent.v1.GetVersionsRequest.Software.mongod:type_name -> agent.v1.GetVersionsRequest.MongoDB
+	113, // 183: agent.v1.GetVersionsRequest.Software.pbm:type_name -> agent.v1.GetVersionsRequest.PBM
+	114, // 184: agent.v1.GetVersionsRequest.Software.pg_basebackup:type_name -> agent.v1.GetVersionsRequest.PGBasebackup
+	115, // 185: agent.v1.GetVersionsRequest.Software.pg_dump:type_name -> agent.v1.GetVersionsRequest.PGDump
+	116, // 186: agent.v1.GetVersionsRequest.Software.pg_restore:type_name -> agent.v1.GetVersionsRequest.PGRestore
+	44,  // 187: agent.v1.AgentService.Connect:input_type -> agent.v1.AgentMessage
+	45,  // 188: agent.v1.AgentService.Connect:output_type -> agent.v1.ServerMessage
+	188, // [188:189] is the sub-list for method output_type
+	187, // [187:188] is the sub-list for method input_type
+	187, // [187:187] is the sub-list for extension type_name
+	187, // [187:187] is the sub-list for extension extendee
+	0,   // [0:187] is the sub-list for field type_name
 }
 
 func init() { file_agent_v1_agent_proto_init() }
@@ -7413,6 +9290,9 @@ func file_agent_v1_agent_proto_init() {
 		(*StartActionRequest_MongodbQueryGetcmdlineoptsParams)(nil),
 		(*StartActionRequest_MongodbQueryReplsetgetstatusParams)(nil),
 		(*StartActionRequest_MongodbQueryGetdiagnosticdataParams)(nil),
+		(*StartActionRequest_MongodbKillOpParams)(nil),
+		(*StartActionRequest_MysqlKillQueryParams)(nil),
+		(*StartActionRequest_PostgresqlCancelBackendParams)(nil),
 		(*StartActionRequest_RestartSysServiceParams)(nil),
 	}
 	file_agent_v1_agent_proto_msgTypes[27].OneofWrappers = []any{}
@@ -7421,6 +9301,11 @@ func file_agent_v1_agent_proto_init() {
 		(*StartJobRequest_MysqlRestoreBackup)(nil),
 		(*StartJobRequest_MongodbBackup)(nil),
 		(*StartJobRequest_MongodbRestoreBackup)(nil),
+		(*StartJobRequest_PostgresqlBackup)(nil),
+		(*StartJobRequest_PostgresqlRestoreBackup)(nil),
+		(*StartJobRequest_MysqlVerifyBackup)(nil),
+		(*StartJobRequest_MongodbVerifyBackup)(nil),
+		(*StartJobRequest_MysqlBinlogStream)(nil),
 	}
 	file_agent_v1_agent_proto_msgTypes[36].OneofWrappers = []any{
 		(*JobResult_Error_)(nil),
@@ -7428,19 +9313,25 @@ func file_agent_v1_agent_proto_init() {
 		(*JobResult_MysqlRestoreBackup)(nil),
 		(*JobResult_MongodbBackup)(nil),
 		(*JobResult_MongodbRestoreBackup)(nil),
+		(*JobResult_PostgresqlBackup)(nil),
+		(*JobResult_PostgresqlRestoreBackup)(nil),
+		(*JobResult_MysqlVerifyBackup)(nil),
+		(*JobResult_MongodbVerifyBackup)(nil),
+		(*JobResult_MysqlBinlogStream)(nil),
 	}
 	file_agent_v1_agent_proto_msgTypes[37].OneofWrappers = []any{
 		(*JobProgress_MysqlBackup)(nil),
 		(*JobProgress_MysqlRestoreBackup)(nil),
 		(*JobProgress_Logs_)(nil),
 	}
-	file_agent_v1_agent_proto_msgTypes[40].OneofWrappers = []any{
+	file_agent_v1_agent_proto_msgTypes[42].OneofWrappers = []any{
 		(*AgentMessage_Ping)(nil),
 		(*AgentMessage_StateChanged)(nil),
 		(*AgentMessage_QanCollect)(nil),
 		(*AgentMessage_ActionResult)(nil),
 		(*AgentMessage_JobResult)(nil),
 		(*AgentMessage_JobProgress)(nil),
+		(*AgentMessage_RenewCertificate)(nil),
 		(*AgentMessage_Pong)(nil),
 		(*AgentMessage_SetState)(nil),
 		(*AgentMessage_StartAction)(nil),
@@ -7454,11 +9345,12 @@ func file_agent_v1_agent_proto_init() {
 		(*AgentMessage_AgentLogs)(nil),
 		(*AgentMessage_ServiceInfo)(nil),
 	}
-	file_agent_v1_agent_proto_msgTypes[41].OneofWrappers = []any{
+	file_agent_v1_agent_proto_msgTypes[43].OneofWrappers = []any{
 		(*ServerMessage_Pong)(nil),
 		(*ServerMessage_StateChanged)(nil),
 		(*ServerMessage_QanCollect)(nil),
 		(*ServerMessage_ActionResult)(nil),
+		(*ServerMessage_RenewCertificate)(nil),
 		(*ServerMessage_Ping)(nil),
 		(*ServerMessage_SetState)(nil),
 		(*ServerMessage_StartAction)(nil),
@@ -7472,27 +9364,48 @@ func file_agent_v1_agent_proto_init() {
 		(*ServerMessage_AgentLogs)(nil),
 		(*ServerMessage_ServiceInfo)(nil),
 	}
-	file_agent_v1_agent_proto_msgTypes[72].OneofWrappers = []any{
+	file_agent_v1_agent_proto_msgTypes[83].OneofWrappers = []any{
 		(*StartJobRequest_MySQLBackup_S3Config)(nil),
 	}
-	file_agent_v1_agent_proto_msgTypes[73].OneofWrappers = []any{
+	file_agent_v1_agent_proto_msgTypes[84].OneofWrappers = []any{
 		(*StartJobRequest_MySQLRestoreBackup_S3Config)(nil),
 	}
-	file_agent_v1_agent_proto_msgTypes[74].OneofWrappers = []any{
+	file_agent_v1_agent_proto_msgTypes[85].OneofWrappers = []any{
 		(*StartJobRequest_MongoDBBackup_S3Config)(nil),
 		(*StartJobRequest_MongoDBBackup_FilesystemConfig)(nil),
 	}
-	file_agent_v1_agent_proto_msgTypes[75].OneofWrappers = []any{
+	file_agent_v1_agent_proto_msgTypes[86].OneofWrappers = []any{
 		(*StartJobRequest_MongoDBRestoreBackup_S3Config)(nil),
 		(*StartJobRequest_MongoDBRestoreBackup_FilesystemConfig)(nil),
 	}
+	file_agent_v1_agent_proto_msgTypes[87].OneofWrappers = []any{
+		(*StartJobRequest_PostgreSQLBackup_S3Config)(nil),
+		(*StartJobRequest_PostgreSQLBackup_FilesystemConfig)(nil),
+	}
+	file_agent_v1_agent_proto_msgTypes[88].OneofWrappers = []any{
+		(*StartJobRequest_PostgreSQLRestoreBackup_S3Config)(nil),
+		(*StartJobRequest_PostgreSQLRestoreBackup_FilesystemConfig)(nil),
+	}
+	file_agent_v1_agent_proto_msgTypes[89].OneofWrappers = []any{
+		(*StartJobRequest_MySQLVerifyBackup_S3Config)(nil),
+	}
 	file_agent_v1_agent_proto_msgTypes[90].OneofWrappers = []any{
+		(*StartJobRequest_MongoDBVerifyBackup_S3Config)(nil),
+		(*StartJobRequest_MongoDBVerifyBackup_FilesystemConfig)(nil),
+	}
+	file_agent_v1_agent_proto_msgTypes[91].OneofWrappers = []any{
+		(*StartJobRequest_MySQLBinlogStream_S3Config)(nil),
+	}
+	file_agent_v1_agent_proto_msgTypes[115].OneofWrappers = []any{
 		(*GetVersionsRequest_Software_Mysqld)(nil),
 		(*GetVersionsRequest_Software_Xtrabackup)(nil),
 		(*GetVersionsRequest_Software_Xbcloud)(nil),
 		(*GetVersionsRequest_Software_Qpress)(nil),
 		(*GetVersionsRequest_Software_Mongod)(nil),
 		(*GetVersionsRequest_Software_Pbm)(nil),
+		(*GetVersionsRequest_Software_PgBasebackup)(nil),
+		(*GetVersionsRequest_Software_PgDump)(nil),
+		(*GetVersionsRequest_Software_PgRestore)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -7500,7 +9413,7 @@ func file_agent_v1_agent_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agent_v1_agent_proto_rawDesc), len(file_agent_v1_agent_proto_rawDesc)),
 			NumEnums:      2,
-			NumMessages:   92,
+			NumMessages:   117,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
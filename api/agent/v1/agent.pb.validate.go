@@ -2080,6 +2080,8 @@ func (m *StartActionRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Secrets
+
 	switch v := m.Params.(type) {
 	case *StartActionRequest_MysqlExplainParams:
 		if v == nil {
@@ -2901,6 +2903,129 @@ func (m *StartActionRequest) validate(all bool) error {
 			}
 		}
 
+	case *StartActionRequest_MongodbKillOpParams:
+		if v == nil {
+			err := StartActionRequestValidationError{
+				field:  "Params",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMongodbKillOpParams()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartActionRequestValidationError{
+						field:  "MongodbKillOpParams",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartActionRequestValidationError{
+						field:  "MongodbKillOpParams",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMongodbKillOpParams()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartActionRequestValidationError{
+					field:  "MongodbKillOpParams",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StartActionRequest_MysqlKillQueryParams:
+		if v == nil {
+			err := StartActionRequestValidationError{
+				field:  "Params",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMysqlKillQueryParams()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartActionRequestValidationError{
+						field:  "MysqlKillQueryParams",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartActionRequestValidationError{
+						field:  "MysqlKillQueryParams",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqlKillQueryParams()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartActionRequestValidationError{
+					field:  "MysqlKillQueryParams",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StartActionRequest_PostgresqlCancelBackendParams:
+		if v == nil {
+			err := StartActionRequestValidationError{
+				field:  "Params",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPostgresqlCancelBackendParams()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartActionRequestValidationError{
+						field:  "PostgresqlCancelBackendParams",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartActionRequestValidationError{
+						field:  "PostgresqlCancelBackendParams",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPostgresqlCancelBackendParams()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartActionRequestValidationError{
+					field:  "PostgresqlCancelBackendParams",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	case *StartActionRequest_RestartSysServiceParams:
 		if v == nil {
 			err := StartActionRequestValidationError{
@@ -3607,6 +3732,8 @@ func (m *PBMSwitchPITRRequest) validate(all bool) error {
 
 	// no validation rules for Enabled
 
+	// no validation rules for Secrets
+
 	if len(errors) > 0 {
 		return PBMSwitchPITRRequestMultiError(errors)
 	}
@@ -4091,6 +4218,8 @@ func (m *CheckConnectionRequest) validate(all bool) error {
 
 	// no validation rules for Tls
 
+	// no validation rules for Secrets
+
 	if len(errors) > 0 {
 		return CheckConnectionRequestMultiError(errors)
 	}
@@ -4365,6 +4494,8 @@ func (m *ServiceInfoRequest) validate(all bool) error {
 
 	// no validation rules for Tls
 
+	// no validation rules for Secrets
+
 	if len(errors) > 0 {
 		return ServiceInfoRequestMultiError(errors)
 	}
@@ -5036,6 +5167,8 @@ func (m *StartJobRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for Secrets
+
 	switch v := m.Job.(type) {
 	case *StartJobRequest_MysqlBackup:
 		if v == nil {
@@ -5201,50 +5334,255 @@ func (m *StartJobRequest) validate(all bool) error {
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
-	}
-
-	if len(errors) > 0 {
-		return StartJobRequestMultiError(errors)
-	}
-
-	return nil
-}
+	case *StartJobRequest_PostgresqlBackup:
+		if v == nil {
+			err := StartJobRequestValidationError{
+				field:  "Job",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// StartJobRequestMultiError is an error wrapping multiple validation errors
-// returned by StartJobRequest.ValidateAll() if the designated constraints
-// aren't met.
-type StartJobRequestMultiError []error
+		if all {
+			switch v := interface{}(m.GetPostgresqlBackup()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartJobRequestValidationError{
+						field:  "PostgresqlBackup",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartJobRequestValidationError{
+						field:  "PostgresqlBackup",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPostgresqlBackup()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartJobRequestValidationError{
+					field:  "PostgresqlBackup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m StartJobRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
+	case *StartJobRequest_PostgresqlRestoreBackup:
+		if v == nil {
+			err := StartJobRequestValidationError{
+				field:  "Job",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// AllErrors returns a list of validation violation errors.
-func (m StartJobRequestMultiError) AllErrors() []error { return m }
+		if all {
+			switch v := interface{}(m.GetPostgresqlRestoreBackup()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartJobRequestValidationError{
+						field:  "PostgresqlRestoreBackup",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartJobRequestValidationError{
+						field:  "PostgresqlRestoreBackup",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPostgresqlRestoreBackup()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartJobRequestValidationError{
+					field:  "PostgresqlRestoreBackup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// StartJobRequestValidationError is the validation error returned by
-// StartJobRequest.Validate if the designated constraints aren't met.
-type StartJobRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
+	case *StartJobRequest_MysqlVerifyBackup:
+		if v == nil {
+			err := StartJobRequestValidationError{
+				field:  "Job",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Field function returns field value.
-func (e StartJobRequestValidationError) Field() string { return e.field }
+		if all {
+			switch v := interface{}(m.GetMysqlVerifyBackup()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartJobRequestValidationError{
+						field:  "MysqlVerifyBackup",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartJobRequestValidationError{
+						field:  "MysqlVerifyBackup",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqlVerifyBackup()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartJobRequestValidationError{
+					field:  "MysqlVerifyBackup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Reason function returns reason value.
-func (e StartJobRequestValidationError) Reason() string { return e.reason }
+	case *StartJobRequest_MongodbVerifyBackup:
+		if v == nil {
+			err := StartJobRequestValidationError{
+				field:  "Job",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Cause function returns cause value.
+		if all {
+			switch v := interface{}(m.GetMongodbVerifyBackup()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartJobRequestValidationError{
+						field:  "MongodbVerifyBackup",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartJobRequestValidationError{
+						field:  "MongodbVerifyBackup",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMongodbVerifyBackup()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartJobRequestValidationError{
+					field:  "MongodbVerifyBackup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StartJobRequest_MysqlBinlogStream:
+		if v == nil {
+			err := StartJobRequestValidationError{
+				field:  "Job",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMysqlBinlogStream()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartJobRequestValidationError{
+						field:  "MysqlBinlogStream",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartJobRequestValidationError{
+						field:  "MysqlBinlogStream",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqlBinlogStream()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartJobRequestValidationError{
+					field:  "MysqlBinlogStream",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return StartJobRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartJobRequestMultiError is an error wrapping multiple validation errors
+// returned by StartJobRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StartJobRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartJobRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartJobRequestMultiError) AllErrors() []error { return m }
+
+// StartJobRequestValidationError is the validation error returned by
+// StartJobRequest.Validate if the designated constraints aren't met.
+type StartJobRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartJobRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartJobRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
 func (e StartJobRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
@@ -5850,145 +6188,9 @@ func (m *JobResult) validate(all bool) error {
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
-	}
-
-	if len(errors) > 0 {
-		return JobResultMultiError(errors)
-	}
-
-	return nil
-}
-
-// JobResultMultiError is an error wrapping multiple validation errors returned
-// by JobResult.ValidateAll() if the designated constraints aren't met.
-type JobResultMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m JobResultMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m JobResultMultiError) AllErrors() []error { return m }
-
-// JobResultValidationError is the validation error returned by
-// JobResult.Validate if the designated constraints aren't met.
-type JobResultValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e JobResultValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e JobResultValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e JobResultValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e JobResultValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e JobResultValidationError) ErrorName() string { return "JobResultValidationError" }
-
-// Error satisfies the builtin error interface
-func (e JobResultValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sJobResult.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause,
-	)
-}
-
-var _ error = JobResultValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = JobResultValidationError{}
-
-// Validate checks the field values on JobProgress with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *JobProgress) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on JobProgress with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in JobProgressMultiError, or
-// nil if none found.
-func (m *JobProgress) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *JobProgress) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	// no validation rules for JobId
-
-	if all {
-		switch v := interface{}(m.GetTimestamp()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobProgressValidationError{
-					field:  "Timestamp",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobProgressValidationError{
-					field:  "Timestamp",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobProgressValidationError{
-				field:  "Timestamp",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	switch v := m.Result.(type) {
-	case *JobProgress_MysqlBackup:
+	case *JobResult_PostgresqlBackup:
 		if v == nil {
-			err := JobProgressValidationError{
+			err := JobResultValidationError{
 				field:  "Result",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5999,37 +6201,37 @@ func (m *JobProgress) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetMysqlBackup()).(type) {
+			switch v := interface{}(m.GetPostgresqlBackup()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobProgressValidationError{
-						field:  "MysqlBackup",
+					errors = append(errors, JobResultValidationError{
+						field:  "PostgresqlBackup",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, JobProgressValidationError{
-						field:  "MysqlBackup",
+					errors = append(errors, JobResultValidationError{
+						field:  "PostgresqlBackup",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetMysqlBackup()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetPostgresqlBackup()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return JobProgressValidationError{
-					field:  "MysqlBackup",
+				return JobResultValidationError{
+					field:  "PostgresqlBackup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *JobProgress_MysqlRestoreBackup:
+	case *JobResult_PostgresqlRestoreBackup:
 		if v == nil {
-			err := JobProgressValidationError{
+			err := JobResultValidationError{
 				field:  "Result",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6040,37 +6242,37 @@ func (m *JobProgress) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetMysqlRestoreBackup()).(type) {
+			switch v := interface{}(m.GetPostgresqlRestoreBackup()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobProgressValidationError{
-						field:  "MysqlRestoreBackup",
+					errors = append(errors, JobResultValidationError{
+						field:  "PostgresqlRestoreBackup",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, JobProgressValidationError{
-						field:  "MysqlRestoreBackup",
+					errors = append(errors, JobResultValidationError{
+						field:  "PostgresqlRestoreBackup",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetMysqlRestoreBackup()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetPostgresqlRestoreBackup()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return JobProgressValidationError{
-					field:  "MysqlRestoreBackup",
+				return JobResultValidationError{
+					field:  "PostgresqlRestoreBackup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *JobProgress_Logs_:
+	case *JobResult_MysqlVerifyBackup:
 		if v == nil {
-			err := JobProgressValidationError{
+			err := JobResultValidationError{
 				field:  "Result",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6081,28 +6283,110 @@ func (m *JobProgress) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetLogs()).(type) {
+			switch v := interface{}(m.GetMysqlVerifyBackup()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, JobProgressValidationError{
-						field:  "Logs",
+					errors = append(errors, JobResultValidationError{
+						field:  "MysqlVerifyBackup",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, JobProgressValidationError{
-						field:  "Logs",
+					errors = append(errors, JobResultValidationError{
+						field:  "MysqlVerifyBackup",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetLogs()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetMysqlVerifyBackup()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return JobProgressValidationError{
-					field:  "Logs",
+				return JobResultValidationError{
+					field:  "MysqlVerifyBackup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobResult_MongodbVerifyBackup:
+		if v == nil {
+			err := JobResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMongodbVerifyBackup()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobResultValidationError{
+						field:  "MongodbVerifyBackup",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobResultValidationError{
+						field:  "MongodbVerifyBackup",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMongodbVerifyBackup()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobResultValidationError{
+					field:  "MongodbVerifyBackup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *JobResult_MysqlBinlogStream:
+		if v == nil {
+			err := JobResultValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMysqlBinlogStream()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobResultValidationError{
+						field:  "MysqlBinlogStream",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobResultValidationError{
+						field:  "MysqlBinlogStream",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqlBinlogStream()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobResultValidationError{
+					field:  "MysqlBinlogStream",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -6114,18 +6398,18 @@ func (m *JobProgress) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return JobProgressMultiError(errors)
+		return JobResultMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobProgressMultiError is an error wrapping multiple validation errors
-// returned by JobProgress.ValidateAll() if the designated constraints aren't met.
-type JobProgressMultiError []error
+// JobResultMultiError is an error wrapping multiple validation errors returned
+// by JobResult.ValidateAll() if the designated constraints aren't met.
+type JobResultMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobProgressMultiError) Error() string {
+func (m JobResultMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6134,11 +6418,11 @@ func (m JobProgressMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobProgressMultiError) AllErrors() []error { return m }
+func (m JobResultMultiError) AllErrors() []error { return m }
 
-// JobProgressValidationError is the validation error returned by
-// JobProgress.Validate if the designated constraints aren't met.
-type JobProgressValidationError struct {
+// JobResultValidationError is the validation error returned by
+// JobResult.Validate if the designated constraints aren't met.
+type JobResultValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6146,22 +6430,22 @@ type JobProgressValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobProgressValidationError) Field() string { return e.field }
+func (e JobResultValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobProgressValidationError) Reason() string { return e.reason }
+func (e JobResultValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobProgressValidationError) Cause() error { return e.cause }
+func (e JobResultValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobProgressValidationError) Key() bool { return e.key }
+func (e JobResultValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobProgressValidationError) ErrorName() string { return "JobProgressValidationError" }
+func (e JobResultValidationError) ErrorName() string { return "JobResultValidationError" }
 
 // Error satisfies the builtin error interface
-func (e JobProgressValidationError) Error() string {
+func (e JobResultValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6173,7 +6457,7 @@ func (e JobProgressValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobProgress.%s: %s%s",
+		"invalid %sJobResult.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -6181,7 +6465,7 @@ func (e JobProgressValidationError) Error() string {
 	)
 }
 
-var _ error = JobProgressValidationError{}
+var _ error = JobResultValidationError{}
 
 var _ interface {
 	Field() string
@@ -6189,69 +6473,328 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobProgressValidationError{}
+} = JobResultValidationError{}
 
-// Validate checks the field values on GetVersionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetVersionsRequest) Validate() error {
+// Validate checks the field values on JobProgress with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobProgress) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetVersionsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetVersionsRequestMultiError, or nil if none found.
-func (m *GetVersionsRequest) ValidateAll() error {
+// ValidateAll checks the field values on JobProgress with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobProgressMultiError, or
+// nil if none found.
+func (m *JobProgress) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetVersionsRequest) validate(all bool) error {
+func (m *JobProgress) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetSoftwares() {
-		_, _ = idx, item
+	// no validation rules for JobId
+
+	if all {
+		switch v := interface{}(m.GetTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobProgressValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobProgressValidationError{
+					field:  "Timestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobProgressValidationError{
+				field:  "Timestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Result.(type) {
+	case *JobProgress_MysqlBackup:
+		if v == nil {
+			err := JobProgressValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
 		if all {
-			switch v := interface{}(item).(type) {
+			switch v := interface{}(m.GetMysqlBackup()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetVersionsRequestValidationError{
-						field:  fmt.Sprintf("Softwares[%v]", idx),
+					errors = append(errors, JobProgressValidationError{
+						field:  "MysqlBackup",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetVersionsRequestValidationError{
-						field:  fmt.Sprintf("Softwares[%v]", idx),
+					errors = append(errors, JobProgressValidationError{
+						field:  "MysqlBackup",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetMysqlBackup()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetVersionsRequestValidationError{
-					field:  fmt.Sprintf("Softwares[%v]", idx),
+				return JobProgressValidationError{
+					field:  "MysqlBackup",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	}
+	case *JobProgress_MysqlRestoreBackup:
+		if v == nil {
+			err := JobProgressValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	if len(errors) > 0 {
-		return GetVersionsRequestMultiError(errors)
-	}
+		if all {
+			switch v := interface{}(m.GetMysqlRestoreBackup()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobProgressValidationError{
+						field:  "MysqlRestoreBackup",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobProgressValidationError{
+						field:  "MysqlRestoreBackup",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqlRestoreBackup()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobProgressValidationError{
+					field:  "MysqlRestoreBackup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	return nil
+	case *JobProgress_Logs_:
+		if v == nil {
+			err := JobProgressValidationError{
+				field:  "Result",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetLogs()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JobProgressValidationError{
+						field:  "Logs",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JobProgressValidationError{
+						field:  "Logs",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetLogs()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JobProgressValidationError{
+					field:  "Logs",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return JobProgressMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobProgressMultiError is an error wrapping multiple validation errors
+// returned by JobProgress.ValidateAll() if the designated constraints aren't met.
+type JobProgressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobProgressMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobProgressMultiError) AllErrors() []error { return m }
+
+// JobProgressValidationError is the validation error returned by
+// JobProgress.Validate if the designated constraints aren't met.
+type JobProgressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobProgressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobProgressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobProgressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobProgressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobProgressValidationError) ErrorName() string { return "JobProgressValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobProgressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobProgress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = JobProgressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobProgressValidationError{}
+
+// Validate checks the field values on GetVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetVersionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetVersionsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVersionsRequestMultiError, or nil if none found.
+func (m *GetVersionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetVersionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetSoftwares() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetVersionsRequestValidationError{
+						field:  fmt.Sprintf("Softwares[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetVersionsRequestValidationError{
+						field:  fmt.Sprintf("Softwares[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetVersionsRequestValidationError{
+					field:  fmt.Sprintf("Softwares[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetVersionsRequestMultiError(errors)
+	}
+
+	return nil
 }
 
 // GetVersionsRequestMultiError is an error wrapping multiple validation errors
@@ -6465,87 +7008,297 @@ var _ interface {
 	ErrorName() string
 } = GetVersionsResponseValidationError{}
 
-// Validate checks the field values on AgentMessage with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AgentMessage) Validate() error {
+// Validate checks the field values on RenewCertificateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenewCertificateRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AgentMessage with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AgentMessageMultiError, or
-// nil if none found.
-func (m *AgentMessage) ValidateAll() error {
+// ValidateAll checks the field values on RenewCertificateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenewCertificateRequestMultiError, or nil if none found.
+func (m *RenewCertificateRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AgentMessage) validate(all bool) error {
+func (m *RenewCertificateRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for CertificateSigningRequest
 
-	if all {
-		switch v := interface{}(m.GetStatus()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AgentMessageValidationError{
-					field:  "Status",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AgentMessageValidationError{
-					field:  "Status",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AgentMessageValidationError{
-				field:  "Status",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return RenewCertificateRequestMultiError(errors)
 	}
 
-	switch v := m.Payload.(type) {
-	case *AgentMessage_Ping:
-		if v == nil {
-			err := AgentMessageValidationError{
-				field:  "Payload",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	return nil
+}
 
-		if all {
-			switch v := interface{}(m.GetPing()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AgentMessageValidationError{
-						field:  "Ping",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, AgentMessageValidationError{
-						field:  "Ping",
-						reason: "embedded message failed validation",
+// RenewCertificateRequestMultiError is an error wrapping multiple validation
+// errors returned by RenewCertificateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RenewCertificateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenewCertificateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenewCertificateRequestMultiError) AllErrors() []error { return m }
+
+// RenewCertificateRequestValidationError is the validation error returned by
+// RenewCertificateRequest.Validate if the designated constraints aren't met.
+type RenewCertificateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenewCertificateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenewCertificateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenewCertificateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenewCertificateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenewCertificateRequestValidationError) ErrorName() string {
+	return "RenewCertificateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenewCertificateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenewCertificateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = RenewCertificateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenewCertificateRequestValidationError{}
+
+// Validate checks the field values on RenewCertificateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RenewCertificateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RenewCertificateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RenewCertificateResponseMultiError, or nil if none found.
+func (m *RenewCertificateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RenewCertificateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Certificate
+
+	if len(errors) > 0 {
+		return RenewCertificateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RenewCertificateResponseMultiError is an error wrapping multiple validation
+// errors returned by RenewCertificateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RenewCertificateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RenewCertificateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RenewCertificateResponseMultiError) AllErrors() []error { return m }
+
+// RenewCertificateResponseValidationError is the validation error returned by
+// RenewCertificateResponse.Validate if the designated constraints aren't met.
+type RenewCertificateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RenewCertificateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RenewCertificateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RenewCertificateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RenewCertificateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RenewCertificateResponseValidationError) ErrorName() string {
+	return "RenewCertificateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RenewCertificateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRenewCertificateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = RenewCertificateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RenewCertificateResponseValidationError{}
+
+// Validate checks the field values on AgentMessage with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AgentMessage) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AgentMessage with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AgentMessageMultiError, or
+// nil if none found.
+func (m *AgentMessage) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AgentMessage) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AgentMessageValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AgentMessageValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AgentMessageValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.Payload.(type) {
+	case *AgentMessage_Ping:
+		if v == nil {
+			err := AgentMessageValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPing()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AgentMessageValidationError{
+						field:  "Ping",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AgentMessageValidationError{
+						field:  "Ping",
+						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
@@ -6765,6 +7518,47 @@ func (m *AgentMessage) validate(all bool) error {
 			}
 		}
 
+	case *AgentMessage_RenewCertificate:
+		if v == nil {
+			err := AgentMessageValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRenewCertificate()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AgentMessageValidationError{
+						field:  "RenewCertificate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AgentMessageValidationError{
+						field:  "RenewCertificate",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRenewCertificate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AgentMessageValidationError{
+					field:  "RenewCertificate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	case *AgentMessage_Pong:
 		if v == nil {
 			err := AgentMessageValidationError{
@@ -7557,7 +8351,7 @@ func (m *ServerMessage) validate(all bool) error {
 			}
 		}
 
-	case *ServerMessage_Ping:
+	case *ServerMessage_RenewCertificate:
 		if v == nil {
 			err := ServerMessageValidationError{
 				field:  "Payload",
@@ -7570,11 +8364,11 @@ func (m *ServerMessage) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetPing()).(type) {
+			switch v := interface{}(m.GetRenewCertificate()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, ServerMessageValidationError{
-						field:  "Ping",
+						field:  "RenewCertificate",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -7582,13 +8376,54 @@ func (m *ServerMessage) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, ServerMessageValidationError{
-						field:  "Ping",
+						field:  "RenewCertificate",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPing()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetRenewCertificate()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ServerMessageValidationError{
+					field:  "RenewCertificate",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ServerMessage_Ping:
+		if v == nil {
+			err := ServerMessageValidationError{
+				field:  "Payload",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPing()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "Ping",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ServerMessageValidationError{
+						field:  "Ping",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPing()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return ServerMessageValidationError{
 					field:  "Ping",
@@ -8162,6 +8997,8 @@ func (m *SetStateRequest_AgentProcess) validate(all bool) error {
 
 	// no validation rules for TextFiles
 
+	// no validation rules for Secrets
+
 	if len(errors) > 0 {
 		return SetStateRequest_AgentProcessMultiError(errors)
 	}
@@ -8346,6 +9183,8 @@ func (m *SetStateRequest_BuiltinAgent) validate(all bool) error {
 
 	// no validation rules for ServiceName
 
+	// no validation rules for Secrets
+
 	if len(errors) > 0 {
 		return SetStateRequest_BuiltinAgentMultiError(errors)
 	}
@@ -11192,47 +12031,78 @@ var _ interface {
 	ErrorName() string
 } = StartActionRequest_MongoDBQueryGetDiagnosticDataParamsValidationError{}
 
-// Validate checks the field values on
-// StartActionRequest_RestartSystemServiceParams with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *StartActionRequest_RestartSystemServiceParams) Validate() error {
+// Validate checks the field values on StartActionRequest_MongoDBKillOpParams
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *StartActionRequest_MongoDBKillOpParams) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// StartActionRequest_RestartSystemServiceParams with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// StartActionRequest_RestartSystemServiceParamsMultiError, or nil if none found.
-func (m *StartActionRequest_RestartSystemServiceParams) ValidateAll() error {
+// StartActionRequest_MongoDBKillOpParams with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// StartActionRequest_MongoDBKillOpParamsMultiError, or nil if none found.
+func (m *StartActionRequest_MongoDBKillOpParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StartActionRequest_RestartSystemServiceParams) validate(all bool) error {
+func (m *StartActionRequest_MongoDBKillOpParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SystemService
+	// no validation rules for Dsn
+
+	if all {
+		switch v := interface{}(m.GetTextFiles()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartActionRequest_MongoDBKillOpParamsValidationError{
+					field:  "TextFiles",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartActionRequest_MongoDBKillOpParamsValidationError{
+					field:  "TextFiles",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTextFiles()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartActionRequest_MongoDBKillOpParamsValidationError{
+				field:  "TextFiles",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OpId
 
 	if len(errors) > 0 {
-		return StartActionRequest_RestartSystemServiceParamsMultiError(errors)
+		return StartActionRequest_MongoDBKillOpParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// StartActionRequest_RestartSystemServiceParamsMultiError is an error wrapping
+// StartActionRequest_MongoDBKillOpParamsMultiError is an error wrapping
 // multiple validation errors returned by
-// StartActionRequest_RestartSystemServiceParams.ValidateAll() if the
-// designated constraints aren't met.
-type StartActionRequest_RestartSystemServiceParamsMultiError []error
+// StartActionRequest_MongoDBKillOpParams.ValidateAll() if the designated
+// constraints aren't met.
+type StartActionRequest_MongoDBKillOpParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StartActionRequest_RestartSystemServiceParamsMultiError) Error() string {
+func (m StartActionRequest_MongoDBKillOpParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11241,13 +12111,12 @@ func (m StartActionRequest_RestartSystemServiceParamsMultiError) Error() string
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StartActionRequest_RestartSystemServiceParamsMultiError) AllErrors() []error { return m }
+func (m StartActionRequest_MongoDBKillOpParamsMultiError) AllErrors() []error { return m }
 
-// StartActionRequest_RestartSystemServiceParamsValidationError is the
-// validation error returned by
-// StartActionRequest_RestartSystemServiceParams.Validate if the designated
-// constraints aren't met.
-type StartActionRequest_RestartSystemServiceParamsValidationError struct {
+// StartActionRequest_MongoDBKillOpParamsValidationError is the validation
+// error returned by StartActionRequest_MongoDBKillOpParams.Validate if the
+// designated constraints aren't met.
+type StartActionRequest_MongoDBKillOpParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11255,26 +12124,24 @@ type StartActionRequest_RestartSystemServiceParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartActionRequest_RestartSystemServiceParamsValidationError) Field() string { return e.field }
+func (e StartActionRequest_MongoDBKillOpParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartActionRequest_RestartSystemServiceParamsValidationError) Reason() string {
-	return e.reason
-}
+func (e StartActionRequest_MongoDBKillOpParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StartActionRequest_RestartSystemServiceParamsValidationError) Cause() error { return e.cause }
+func (e StartActionRequest_MongoDBKillOpParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartActionRequest_RestartSystemServiceParamsValidationError) Key() bool { return e.key }
+func (e StartActionRequest_MongoDBKillOpParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartActionRequest_RestartSystemServiceParamsValidationError) ErrorName() string {
-	return "StartActionRequest_RestartSystemServiceParamsValidationError"
+func (e StartActionRequest_MongoDBKillOpParamsValidationError) ErrorName() string {
+	return "StartActionRequest_MongoDBKillOpParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StartActionRequest_RestartSystemServiceParamsValidationError) Error() string {
+func (e StartActionRequest_MongoDBKillOpParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11286,7 +12153,7 @@ func (e StartActionRequest_RestartSystemServiceParamsValidationError) Error() st
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartActionRequest_RestartSystemServiceParams.%s: %s%s",
+		"invalid %sStartActionRequest_MongoDBKillOpParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -11294,7 +12161,7 @@ func (e StartActionRequest_RestartSystemServiceParamsValidationError) Error() st
 	)
 }
 
-var _ error = StartActionRequest_RestartSystemServiceParamsValidationError{}
+var _ error = StartActionRequest_MongoDBKillOpParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -11302,46 +12169,115 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartActionRequest_RestartSystemServiceParamsValidationError{}
+} = StartActionRequest_MongoDBKillOpParamsValidationError{}
 
-// Validate checks the field values on CheckConnectionResponse_Stats with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CheckConnectionResponse_Stats) Validate() error {
+// Validate checks the field values on StartActionRequest_MySQLKillQueryParams
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *StartActionRequest_MySQLKillQueryParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CheckConnectionResponse_Stats with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// CheckConnectionResponse_StatsMultiError, or nil if none found.
-func (m *CheckConnectionResponse_Stats) ValidateAll() error {
+// ValidateAll checks the field values on
+// StartActionRequest_MySQLKillQueryParams with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// StartActionRequest_MySQLKillQueryParamsMultiError, or nil if none found.
+func (m *StartActionRequest_MySQLKillQueryParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CheckConnectionResponse_Stats) validate(all bool) error {
+func (m *StartActionRequest_MySQLKillQueryParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for TableCount
+	// no validation rules for Dsn
+
+	if all {
+		switch v := interface{}(m.GetTlsFiles()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartActionRequest_MySQLKillQueryParamsValidationError{
+					field:  "TlsFiles",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartActionRequest_MySQLKillQueryParamsValidationError{
+					field:  "TlsFiles",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTlsFiles()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartActionRequest_MySQLKillQueryParamsValidationError{
+				field:  "TlsFiles",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TlsSkipVerify
+
+	// no validation rules for ConnectionId
+
+	// no validation rules for ThreadId
+
+	// no validation rules for EventId
+
+	if all {
+		switch v := interface{}(m.GetQueryStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartActionRequest_MySQLKillQueryParamsValidationError{
+					field:  "QueryStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartActionRequest_MySQLKillQueryParamsValidationError{
+					field:  "QueryStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQueryStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartActionRequest_MySQLKillQueryParamsValidationError{
+				field:  "QueryStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return CheckConnectionResponse_StatsMultiError(errors)
+		return StartActionRequest_MySQLKillQueryParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// CheckConnectionResponse_StatsMultiError is an error wrapping multiple
-// validation errors returned by CheckConnectionResponse_Stats.ValidateAll()
-// if the designated constraints aren't met.
-type CheckConnectionResponse_StatsMultiError []error
+// StartActionRequest_MySQLKillQueryParamsMultiError is an error wrapping
+// multiple validation errors returned by
+// StartActionRequest_MySQLKillQueryParams.ValidateAll() if the designated
+// constraints aren't met.
+type StartActionRequest_MySQLKillQueryParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CheckConnectionResponse_StatsMultiError) Error() string {
+func (m StartActionRequest_MySQLKillQueryParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11350,12 +12286,12 @@ func (m CheckConnectionResponse_StatsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CheckConnectionResponse_StatsMultiError) AllErrors() []error { return m }
+func (m StartActionRequest_MySQLKillQueryParamsMultiError) AllErrors() []error { return m }
 
-// CheckConnectionResponse_StatsValidationError is the validation error
-// returned by CheckConnectionResponse_Stats.Validate if the designated
-// constraints aren't met.
-type CheckConnectionResponse_StatsValidationError struct {
+// StartActionRequest_MySQLKillQueryParamsValidationError is the validation
+// error returned by StartActionRequest_MySQLKillQueryParams.Validate if the
+// designated constraints aren't met.
+type StartActionRequest_MySQLKillQueryParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11363,24 +12299,24 @@ type CheckConnectionResponse_StatsValidationError struct {
 }
 
 // Field function returns field value.
-func (e CheckConnectionResponse_StatsValidationError) Field() string { return e.field }
+func (e StartActionRequest_MySQLKillQueryParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CheckConnectionResponse_StatsValidationError) Reason() string { return e.reason }
+func (e StartActionRequest_MySQLKillQueryParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CheckConnectionResponse_StatsValidationError) Cause() error { return e.cause }
+func (e StartActionRequest_MySQLKillQueryParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CheckConnectionResponse_StatsValidationError) Key() bool { return e.key }
+func (e StartActionRequest_MySQLKillQueryParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CheckConnectionResponse_StatsValidationError) ErrorName() string {
-	return "CheckConnectionResponse_StatsValidationError"
+func (e StartActionRequest_MySQLKillQueryParamsValidationError) ErrorName() string {
+	return "StartActionRequest_MySQLKillQueryParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e CheckConnectionResponse_StatsValidationError) Error() string {
+func (e StartActionRequest_MySQLKillQueryParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11392,7 +12328,7 @@ func (e CheckConnectionResponse_StatsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCheckConnectionResponse_Stats.%s: %s%s",
+		"invalid %sStartActionRequest_MySQLKillQueryParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -11400,7 +12336,7 @@ func (e CheckConnectionResponse_StatsValidationError) Error() string {
 	)
 }
 
-var _ error = CheckConnectionResponse_StatsValidationError{}
+var _ error = StartActionRequest_MySQLKillQueryParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -11408,104 +12344,111 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CheckConnectionResponse_StatsValidationError{}
+} = StartActionRequest_MySQLKillQueryParamsValidationError{}
 
-// Validate checks the field values on StartJobRequest_MySQLBackup with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StartJobRequest_MySQLBackup) Validate() error {
+// Validate checks the field values on
+// StartActionRequest_PostgreSQLCancelBackendParams with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StartActionRequest_PostgreSQLCancelBackendParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StartJobRequest_MySQLBackup with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// StartJobRequest_MySQLBackupMultiError, or nil if none found.
-func (m *StartJobRequest_MySQLBackup) ValidateAll() error {
+// ValidateAll checks the field values on
+// StartActionRequest_PostgreSQLCancelBackendParams with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// StartActionRequest_PostgreSQLCancelBackendParamsMultiError, or nil if none found.
+func (m *StartActionRequest_PostgreSQLCancelBackendParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StartJobRequest_MySQLBackup) validate(all bool) error {
+func (m *StartActionRequest_PostgreSQLCancelBackendParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for User
-
-	// no validation rules for Password
+	// no validation rules for Dsn
 
-	// no validation rules for Address
+	if all {
+		switch v := interface{}(m.GetTlsFiles()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartActionRequest_PostgreSQLCancelBackendParamsValidationError{
+					field:  "TlsFiles",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartActionRequest_PostgreSQLCancelBackendParamsValidationError{
+					field:  "TlsFiles",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTlsFiles()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartActionRequest_PostgreSQLCancelBackendParamsValidationError{
+				field:  "TlsFiles",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for Port
+	// no validation rules for TlsSkipVerify
 
-	// no validation rules for Socket
+	// no validation rules for Pid
 
-	// no validation rules for Name
-
-	// no validation rules for Folder
-
-	switch v := m.LocationConfig.(type) {
-	case *StartJobRequest_MySQLBackup_S3Config:
-		if v == nil {
-			err := StartJobRequest_MySQLBackupValidationError{
-				field:  "LocationConfig",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetS3Config()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartJobRequest_MySQLBackupValidationError{
-						field:  "S3Config",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, StartJobRequest_MySQLBackupValidationError{
-						field:  "S3Config",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetQueryStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartActionRequest_PostgreSQLCancelBackendParamsValidationError{
+					field:  "QueryStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetS3Config()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return StartJobRequest_MySQLBackupValidationError{
-					field:  "S3Config",
+				errors = append(errors, StartActionRequest_PostgreSQLCancelBackendParamsValidationError{
+					field:  "QueryStart",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQueryStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartActionRequest_PostgreSQLCancelBackendParamsValidationError{
+				field:  "QueryStart",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
-	default:
-		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return StartJobRequest_MySQLBackupMultiError(errors)
+		return StartActionRequest_PostgreSQLCancelBackendParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// StartJobRequest_MySQLBackupMultiError is an error wrapping multiple
-// validation errors returned by StartJobRequest_MySQLBackup.ValidateAll() if
-// the designated constraints aren't met.
-type StartJobRequest_MySQLBackupMultiError []error
+// StartActionRequest_PostgreSQLCancelBackendParamsMultiError is an error
+// wrapping multiple validation errors returned by
+// StartActionRequest_PostgreSQLCancelBackendParams.ValidateAll() if the
+// designated constraints aren't met.
+type StartActionRequest_PostgreSQLCancelBackendParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StartJobRequest_MySQLBackupMultiError) Error() string {
+func (m StartActionRequest_PostgreSQLCancelBackendParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11514,12 +12457,13 @@ func (m StartJobRequest_MySQLBackupMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StartJobRequest_MySQLBackupMultiError) AllErrors() []error { return m }
+func (m StartActionRequest_PostgreSQLCancelBackendParamsMultiError) AllErrors() []error { return m }
 
-// StartJobRequest_MySQLBackupValidationError is the validation error returned
-// by StartJobRequest_MySQLBackup.Validate if the designated constraints
-// aren't met.
-type StartJobRequest_MySQLBackupValidationError struct {
+// StartActionRequest_PostgreSQLCancelBackendParamsValidationError is the
+// validation error returned by
+// StartActionRequest_PostgreSQLCancelBackendParams.Validate if the designated
+// constraints aren't met.
+type StartActionRequest_PostgreSQLCancelBackendParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11527,24 +12471,30 @@ type StartJobRequest_MySQLBackupValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartJobRequest_MySQLBackupValidationError) Field() string { return e.field }
+func (e StartActionRequest_PostgreSQLCancelBackendParamsValidationError) Field() string {
+	return e.field
+}
 
 // Reason function returns reason value.
-func (e StartJobRequest_MySQLBackupValidationError) Reason() string { return e.reason }
+func (e StartActionRequest_PostgreSQLCancelBackendParamsValidationError) Reason() string {
+	return e.reason
+}
 
 // Cause function returns cause value.
-func (e StartJobRequest_MySQLBackupValidationError) Cause() error { return e.cause }
+func (e StartActionRequest_PostgreSQLCancelBackendParamsValidationError) Cause() error {
+	return e.cause
+}
 
 // Key function returns key value.
-func (e StartJobRequest_MySQLBackupValidationError) Key() bool { return e.key }
+func (e StartActionRequest_PostgreSQLCancelBackendParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartJobRequest_MySQLBackupValidationError) ErrorName() string {
-	return "StartJobRequest_MySQLBackupValidationError"
+func (e StartActionRequest_PostgreSQLCancelBackendParamsValidationError) ErrorName() string {
+	return "StartActionRequest_PostgreSQLCancelBackendParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StartJobRequest_MySQLBackupValidationError) Error() string {
+func (e StartActionRequest_PostgreSQLCancelBackendParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11556,7 +12506,7 @@ func (e StartJobRequest_MySQLBackupValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartJobRequest_MySQLBackup.%s: %s%s",
+		"invalid %sStartActionRequest_PostgreSQLCancelBackendParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -11564,7 +12514,7 @@ func (e StartJobRequest_MySQLBackupValidationError) Error() string {
 	)
 }
 
-var _ error = StartJobRequest_MySQLBackupValidationError{}
+var _ error = StartActionRequest_PostgreSQLCancelBackendParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -11572,98 +12522,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartJobRequest_MySQLBackupValidationError{}
+} = StartActionRequest_PostgreSQLCancelBackendParamsValidationError{}
 
-// Validate checks the field values on StartJobRequest_MySQLRestoreBackup with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *StartJobRequest_MySQLRestoreBackup) Validate() error {
+// Validate checks the field values on
+// StartActionRequest_RestartSystemServiceParams with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StartActionRequest_RestartSystemServiceParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StartJobRequest_MySQLRestoreBackup
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// StartJobRequest_MySQLRestoreBackupMultiError, or nil if none found.
-func (m *StartJobRequest_MySQLRestoreBackup) ValidateAll() error {
+// ValidateAll checks the field values on
+// StartActionRequest_RestartSystemServiceParams with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// StartActionRequest_RestartSystemServiceParamsMultiError, or nil if none found.
+func (m *StartActionRequest_RestartSystemServiceParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StartJobRequest_MySQLRestoreBackup) validate(all bool) error {
+func (m *StartActionRequest_RestartSystemServiceParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ServiceId
-
-	// no validation rules for Name
-
-	// no validation rules for Folder
-
-	switch v := m.LocationConfig.(type) {
-	case *StartJobRequest_MySQLRestoreBackup_S3Config:
-		if v == nil {
-			err := StartJobRequest_MySQLRestoreBackupValidationError{
-				field:  "LocationConfig",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetS3Config()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartJobRequest_MySQLRestoreBackupValidationError{
-						field:  "S3Config",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, StartJobRequest_MySQLRestoreBackupValidationError{
-						field:  "S3Config",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetS3Config()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return StartJobRequest_MySQLRestoreBackupValidationError{
-					field:  "S3Config",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	default:
-		_ = v // ensures v is used
-	}
+	// no validation rules for SystemService
 
 	if len(errors) > 0 {
-		return StartJobRequest_MySQLRestoreBackupMultiError(errors)
+		return StartActionRequest_RestartSystemServiceParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// StartJobRequest_MySQLRestoreBackupMultiError is an error wrapping multiple
-// validation errors returned by
-// StartJobRequest_MySQLRestoreBackup.ValidateAll() if the designated
-// constraints aren't met.
-type StartJobRequest_MySQLRestoreBackupMultiError []error
+// StartActionRequest_RestartSystemServiceParamsMultiError is an error wrapping
+// multiple validation errors returned by
+// StartActionRequest_RestartSystemServiceParams.ValidateAll() if the
+// designated constraints aren't met.
+type StartActionRequest_RestartSystemServiceParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StartJobRequest_MySQLRestoreBackupMultiError) Error() string {
+func (m StartActionRequest_RestartSystemServiceParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11672,12 +12573,13 @@ func (m StartJobRequest_MySQLRestoreBackupMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StartJobRequest_MySQLRestoreBackupMultiError) AllErrors() []error { return m }
+func (m StartActionRequest_RestartSystemServiceParamsMultiError) AllErrors() []error { return m }
 
-// StartJobRequest_MySQLRestoreBackupValidationError is the validation error
-// returned by StartJobRequest_MySQLRestoreBackup.Validate if the designated
+// StartActionRequest_RestartSystemServiceParamsValidationError is the
+// validation error returned by
+// StartActionRequest_RestartSystemServiceParams.Validate if the designated
 // constraints aren't met.
-type StartJobRequest_MySQLRestoreBackupValidationError struct {
+type StartActionRequest_RestartSystemServiceParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11685,24 +12587,26 @@ type StartJobRequest_MySQLRestoreBackupValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartJobRequest_MySQLRestoreBackupValidationError) Field() string { return e.field }
+func (e StartActionRequest_RestartSystemServiceParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartJobRequest_MySQLRestoreBackupValidationError) Reason() string { return e.reason }
+func (e StartActionRequest_RestartSystemServiceParamsValidationError) Reason() string {
+	return e.reason
+}
 
 // Cause function returns cause value.
-func (e StartJobRequest_MySQLRestoreBackupValidationError) Cause() error { return e.cause }
+func (e StartActionRequest_RestartSystemServiceParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartJobRequest_MySQLRestoreBackupValidationError) Key() bool { return e.key }
+func (e StartActionRequest_RestartSystemServiceParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartJobRequest_MySQLRestoreBackupValidationError) ErrorName() string {
-	return "StartJobRequest_MySQLRestoreBackupValidationError"
+func (e StartActionRequest_RestartSystemServiceParamsValidationError) ErrorName() string {
+	return "StartActionRequest_RestartSystemServiceParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StartJobRequest_MySQLRestoreBackupValidationError) Error() string {
+func (e StartActionRequest_RestartSystemServiceParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11714,7 +12618,7 @@ func (e StartJobRequest_MySQLRestoreBackupValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartJobRequest_MySQLRestoreBackup.%s: %s%s",
+		"invalid %sStartActionRequest_RestartSystemServiceParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -11722,7 +12626,7 @@ func (e StartJobRequest_MySQLRestoreBackupValidationError) Error() string {
 	)
 }
 
-var _ error = StartJobRequest_MySQLRestoreBackupValidationError{}
+var _ error = StartActionRequest_RestartSystemServiceParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -11730,73 +12634,156 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartJobRequest_MySQLRestoreBackupValidationError{}
+} = StartActionRequest_RestartSystemServiceParamsValidationError{}
 
-// Validate checks the field values on StartJobRequest_MongoDBBackup with the
+// Validate checks the field values on CheckConnectionResponse_Stats with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *StartJobRequest_MongoDBBackup) Validate() error {
+func (m *CheckConnectionResponse_Stats) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StartJobRequest_MongoDBBackup with
+// ValidateAll checks the field values on CheckConnectionResponse_Stats with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// StartJobRequest_MongoDBBackupMultiError, or nil if none found.
-func (m *StartJobRequest_MongoDBBackup) ValidateAll() error {
+// CheckConnectionResponse_StatsMultiError, or nil if none found.
+func (m *CheckConnectionResponse_Stats) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StartJobRequest_MongoDBBackup) validate(all bool) error {
+func (m *CheckConnectionResponse_Stats) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Dsn
+	// no validation rules for TableCount
 
-	if all {
-		switch v := interface{}(m.GetTextFiles()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartJobRequest_MongoDBBackupValidationError{
-					field:  "TextFiles",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, StartJobRequest_MongoDBBackupValidationError{
-					field:  "TextFiles",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetTextFiles()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StartJobRequest_MongoDBBackupValidationError{
-				field:  "TextFiles",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return CheckConnectionResponse_StatsMultiError(errors)
 	}
 
-	// no validation rules for Name
-
-	// no validation rules for Folder
-
-	// no validation rules for EnablePitr
+	return nil
+}
 
-	// no validation rules for DataModel
+// CheckConnectionResponse_StatsMultiError is an error wrapping multiple
+// validation errors returned by CheckConnectionResponse_Stats.ValidateAll()
+// if the designated constraints aren't met.
+type CheckConnectionResponse_StatsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckConnectionResponse_StatsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckConnectionResponse_StatsMultiError) AllErrors() []error { return m }
+
+// CheckConnectionResponse_StatsValidationError is the validation error
+// returned by CheckConnectionResponse_Stats.Validate if the designated
+// constraints aren't met.
+type CheckConnectionResponse_StatsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckConnectionResponse_StatsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckConnectionResponse_StatsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckConnectionResponse_StatsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckConnectionResponse_StatsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckConnectionResponse_StatsValidationError) ErrorName() string {
+	return "CheckConnectionResponse_StatsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckConnectionResponse_StatsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckConnectionResponse_Stats.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = CheckConnectionResponse_StatsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckConnectionResponse_StatsValidationError{}
+
+// Validate checks the field values on StartJobRequest_MySQLBackup with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartJobRequest_MySQLBackup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartJobRequest_MySQLBackup with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StartJobRequest_MySQLBackupMultiError, or nil if none found.
+func (m *StartJobRequest_MySQLBackup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartJobRequest_MySQLBackup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for User
+
+	// no validation rules for Password
+
+	// no validation rules for Address
+
+	// no validation rules for Port
+
+	// no validation rules for Socket
+
+	// no validation rules for Name
+
+	// no validation rules for Folder
+
+	// no validation rules for EnablePitr
 
 	switch v := m.LocationConfig.(type) {
-	case *StartJobRequest_MongoDBBackup_S3Config:
+	case *StartJobRequest_MySQLBackup_S3Config:
 		if v == nil {
-			err := StartJobRequest_MongoDBBackupValidationError{
+			err := StartJobRequest_MySQLBackupValidationError{
 				field:  "LocationConfig",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -11810,7 +12797,7 @@ func (m *StartJobRequest_MongoDBBackup) validate(all bool) error {
 			switch v := interface{}(m.GetS3Config()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartJobRequest_MongoDBBackupValidationError{
+					errors = append(errors, StartJobRequest_MySQLBackupValidationError{
 						field:  "S3Config",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -11818,7 +12805,7 @@ func (m *StartJobRequest_MongoDBBackup) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, StartJobRequest_MongoDBBackupValidationError{
+					errors = append(errors, StartJobRequest_MySQLBackupValidationError{
 						field:  "S3Config",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -11827,7 +12814,7 @@ func (m *StartJobRequest_MongoDBBackup) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetS3Config()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return StartJobRequest_MongoDBBackupValidationError{
+				return StartJobRequest_MySQLBackupValidationError{
 					field:  "S3Config",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11835,65 +12822,24 @@ func (m *StartJobRequest_MongoDBBackup) validate(all bool) error {
 			}
 		}
 
-	case *StartJobRequest_MongoDBBackup_FilesystemConfig:
-		if v == nil {
-			err := StartJobRequest_MongoDBBackupValidationError{
-				field:  "LocationConfig",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetFilesystemConfig()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartJobRequest_MongoDBBackupValidationError{
-						field:  "FilesystemConfig",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, StartJobRequest_MongoDBBackupValidationError{
-						field:  "FilesystemConfig",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetFilesystemConfig()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return StartJobRequest_MongoDBBackupValidationError{
-					field:  "FilesystemConfig",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
 	default:
 		_ = v // ensures v is used
 	}
 
 	if len(errors) > 0 {
-		return StartJobRequest_MongoDBBackupMultiError(errors)
+		return StartJobRequest_MySQLBackupMultiError(errors)
 	}
 
 	return nil
 }
 
-// StartJobRequest_MongoDBBackupMultiError is an error wrapping multiple
-// validation errors returned by StartJobRequest_MongoDBBackup.ValidateAll()
-// if the designated constraints aren't met.
-type StartJobRequest_MongoDBBackupMultiError []error
+// StartJobRequest_MySQLBackupMultiError is an error wrapping multiple
+// validation errors returned by StartJobRequest_MySQLBackup.ValidateAll() if
+// the designated constraints aren't met.
+type StartJobRequest_MySQLBackupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StartJobRequest_MongoDBBackupMultiError) Error() string {
+func (m StartJobRequest_MySQLBackupMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11902,12 +12848,12 @@ func (m StartJobRequest_MongoDBBackupMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StartJobRequest_MongoDBBackupMultiError) AllErrors() []error { return m }
+func (m StartJobRequest_MySQLBackupMultiError) AllErrors() []error { return m }
 
-// StartJobRequest_MongoDBBackupValidationError is the validation error
-// returned by StartJobRequest_MongoDBBackup.Validate if the designated
-// constraints aren't met.
-type StartJobRequest_MongoDBBackupValidationError struct {
+// StartJobRequest_MySQLBackupValidationError is the validation error returned
+// by StartJobRequest_MySQLBackup.Validate if the designated constraints
+// aren't met.
+type StartJobRequest_MySQLBackupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11915,24 +12861,24 @@ type StartJobRequest_MongoDBBackupValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartJobRequest_MongoDBBackupValidationError) Field() string { return e.field }
+func (e StartJobRequest_MySQLBackupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartJobRequest_MongoDBBackupValidationError) Reason() string { return e.reason }
+func (e StartJobRequest_MySQLBackupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StartJobRequest_MongoDBBackupValidationError) Cause() error { return e.cause }
+func (e StartJobRequest_MySQLBackupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartJobRequest_MongoDBBackupValidationError) Key() bool { return e.key }
+func (e StartJobRequest_MySQLBackupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartJobRequest_MongoDBBackupValidationError) ErrorName() string {
-	return "StartJobRequest_MongoDBBackupValidationError"
+func (e StartJobRequest_MySQLBackupValidationError) ErrorName() string {
+	return "StartJobRequest_MySQLBackupValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StartJobRequest_MongoDBBackupValidationError) Error() string {
+func (e StartJobRequest_MySQLBackupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11944,7 +12890,7 @@ func (e StartJobRequest_MongoDBBackupValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartJobRequest_MongoDBBackup.%s: %s%s",
+		"invalid %sStartJobRequest_MySQLBackup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -11952,7 +12898,7 @@ func (e StartJobRequest_MongoDBBackupValidationError) Error() string {
 	)
 }
 
-var _ error = StartJobRequest_MongoDBBackupValidationError{}
+var _ error = StartJobRequest_MySQLBackupValidationError{}
 
 var _ interface {
 	Field() string
@@ -11960,128 +12906,272 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartJobRequest_MongoDBBackupValidationError{}
+} = StartJobRequest_MySQLBackupValidationError{}
 
-// Validate checks the field values on StartJobRequest_MongoDBRestoreBackup
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *StartJobRequest_MongoDBRestoreBackup) Validate() error {
+// Validate checks the field values on StartJobRequest_MySQLRestoreBackup with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *StartJobRequest_MySQLRestoreBackup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StartJobRequest_MongoDBRestoreBackup
+// ValidateAll checks the field values on StartJobRequest_MySQLRestoreBackup
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// StartJobRequest_MongoDBRestoreBackupMultiError, or nil if none found.
-func (m *StartJobRequest_MongoDBRestoreBackup) ValidateAll() error {
+// StartJobRequest_MySQLRestoreBackupMultiError, or nil if none found.
+func (m *StartJobRequest_MySQLRestoreBackup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StartJobRequest_MongoDBRestoreBackup) validate(all bool) error {
+func (m *StartJobRequest_MySQLRestoreBackup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Dsn
+	// no validation rules for ServiceId
+
+	// no validation rules for Name
+
+	// no validation rules for Folder
 
 	if all {
-		switch v := interface{}(m.GetTextFiles()).(type) {
+		switch v := interface{}(m.GetPitrTimestamp()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
-					field:  "TextFiles",
+				errors = append(errors, StartJobRequest_MySQLRestoreBackupValidationError{
+					field:  "PitrTimestamp",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
-					field:  "TextFiles",
+				errors = append(errors, StartJobRequest_MySQLRestoreBackupValidationError{
+					field:  "PitrTimestamp",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetTextFiles()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetPitrTimestamp()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return StartJobRequest_MongoDBRestoreBackupValidationError{
-				field:  "TextFiles",
+			return StartJobRequest_MySQLRestoreBackupValidationError{
+				field:  "PitrTimestamp",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	// no validation rules for Name
+	// no validation rules for BinlogFolder
 
-	// no validation rules for Folder
+	// no validation rules for User
 
-	if all {
-		switch v := interface{}(m.GetPbmMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
-					field:  "PbmMetadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
+	// no validation rules for Password
+
+	// no validation rules for Address
+
+	// no validation rules for Port
+
+	// no validation rules for Socket
+
+	switch v := m.LocationConfig.(type) {
+	case *StartJobRequest_MySQLRestoreBackup_S3Config:
+		if v == nil {
+			err := StartJobRequest_MySQLRestoreBackupValidationError{
+				field:  "LocationConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetS3Config()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartJobRequest_MySQLRestoreBackupValidationError{
+						field:  "S3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartJobRequest_MySQLRestoreBackupValidationError{
+						field:  "S3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetS3Config()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
-					field:  "PbmMetadata",
+				return StartJobRequest_MySQLRestoreBackupValidationError{
+					field:  "S3Config",
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetPbmMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return StartJobRequest_MongoDBRestoreBackupValidationError{
-				field:  "PbmMetadata",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return StartJobRequest_MySQLRestoreBackupMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartJobRequest_MySQLRestoreBackupMultiError is an error wrapping multiple
+// validation errors returned by
+// StartJobRequest_MySQLRestoreBackup.ValidateAll() if the designated
+// constraints aren't met.
+type StartJobRequest_MySQLRestoreBackupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartJobRequest_MySQLRestoreBackupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartJobRequest_MySQLRestoreBackupMultiError) AllErrors() []error { return m }
+
+// StartJobRequest_MySQLRestoreBackupValidationError is the validation error
+// returned by StartJobRequest_MySQLRestoreBackup.Validate if the designated
+// constraints aren't met.
+type StartJobRequest_MySQLRestoreBackupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartJobRequest_MySQLRestoreBackupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartJobRequest_MySQLRestoreBackupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartJobRequest_MySQLRestoreBackupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartJobRequest_MySQLRestoreBackupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartJobRequest_MySQLRestoreBackupValidationError) ErrorName() string {
+	return "StartJobRequest_MySQLRestoreBackupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartJobRequest_MySQLRestoreBackupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartJobRequest_MySQLRestoreBackup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = StartJobRequest_MySQLRestoreBackupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartJobRequest_MySQLRestoreBackupValidationError{}
+
+// Validate checks the field values on StartJobRequest_MongoDBBackup with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *StartJobRequest_MongoDBBackup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartJobRequest_MongoDBBackup with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StartJobRequest_MongoDBBackupMultiError, or nil if none found.
+func (m *StartJobRequest_MongoDBBackup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartJobRequest_MongoDBBackup) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for Dsn
+
 	if all {
-		switch v := interface{}(m.GetPitrTimestamp()).(type) {
+		switch v := interface{}(m.GetTextFiles()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
-					field:  "PitrTimestamp",
+				errors = append(errors, StartJobRequest_MongoDBBackupValidationError{
+					field:  "TextFiles",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
-					field:  "PitrTimestamp",
+				errors = append(errors, StartJobRequest_MongoDBBackupValidationError{
+					field:  "TextFiles",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetPitrTimestamp()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetTextFiles()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return StartJobRequest_MongoDBRestoreBackupValidationError{
-				field:  "PitrTimestamp",
+			return StartJobRequest_MongoDBBackupValidationError{
+				field:  "TextFiles",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for Name
+
+	// no validation rules for Folder
+
+	// no validation rules for EnablePitr
+
+	// no validation rules for DataModel
+
 	switch v := m.LocationConfig.(type) {
-	case *StartJobRequest_MongoDBRestoreBackup_S3Config:
+	case *StartJobRequest_MongoDBBackup_S3Config:
 		if v == nil {
-			err := StartJobRequest_MongoDBRestoreBackupValidationError{
+			err := StartJobRequest_MongoDBBackupValidationError{
 				field:  "LocationConfig",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -12095,7 +13185,7 @@ func (m *StartJobRequest_MongoDBRestoreBackup) validate(all bool) error {
 			switch v := interface{}(m.GetS3Config()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
+					errors = append(errors, StartJobRequest_MongoDBBackupValidationError{
 						field:  "S3Config",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -12103,7 +13193,7 @@ func (m *StartJobRequest_MongoDBRestoreBackup) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
+					errors = append(errors, StartJobRequest_MongoDBBackupValidationError{
 						field:  "S3Config",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -12112,7 +13202,7 @@ func (m *StartJobRequest_MongoDBRestoreBackup) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetS3Config()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return StartJobRequest_MongoDBRestoreBackupValidationError{
+				return StartJobRequest_MongoDBBackupValidationError{
 					field:  "S3Config",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12120,9 +13210,9 @@ func (m *StartJobRequest_MongoDBRestoreBackup) validate(all bool) error {
 			}
 		}
 
-	case *StartJobRequest_MongoDBRestoreBackup_FilesystemConfig:
+	case *StartJobRequest_MongoDBBackup_FilesystemConfig:
 		if v == nil {
-			err := StartJobRequest_MongoDBRestoreBackupValidationError{
+			err := StartJobRequest_MongoDBBackupValidationError{
 				field:  "LocationConfig",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -12136,7 +13226,7 @@ func (m *StartJobRequest_MongoDBRestoreBackup) validate(all bool) error {
 			switch v := interface{}(m.GetFilesystemConfig()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
+					errors = append(errors, StartJobRequest_MongoDBBackupValidationError{
 						field:  "FilesystemConfig",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -12144,42 +13234,2149 @@ func (m *StartJobRequest_MongoDBRestoreBackup) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
+					errors = append(errors, StartJobRequest_MongoDBBackupValidationError{
 						field:  "FilesystemConfig",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetFilesystemConfig()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetFilesystemConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartJobRequest_MongoDBBackupValidationError{
+					field:  "FilesystemConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return StartJobRequest_MongoDBBackupMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartJobRequest_MongoDBBackupMultiError is an error wrapping multiple
+// validation errors returned by StartJobRequest_MongoDBBackup.ValidateAll()
+// if the designated constraints aren't met.
+type StartJobRequest_MongoDBBackupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartJobRequest_MongoDBBackupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartJobRequest_MongoDBBackupMultiError) AllErrors() []error { return m }
+
+// StartJobRequest_MongoDBBackupValidationError is the validation error
+// returned by StartJobRequest_MongoDBBackup.Validate if the designated
+// constraints aren't met.
+type StartJobRequest_MongoDBBackupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartJobRequest_MongoDBBackupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartJobRequest_MongoDBBackupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartJobRequest_MongoDBBackupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartJobRequest_MongoDBBackupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartJobRequest_MongoDBBackupValidationError) ErrorName() string {
+	return "StartJobRequest_MongoDBBackupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartJobRequest_MongoDBBackupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartJobRequest_MongoDBBackup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = StartJobRequest_MongoDBBackupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartJobRequest_MongoDBBackupValidationError{}
+
+// Validate checks the field values on StartJobRequest_MongoDBRestoreBackup
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *StartJobRequest_MongoDBRestoreBackup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartJobRequest_MongoDBRestoreBackup
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// StartJobRequest_MongoDBRestoreBackupMultiError, or nil if none found.
+func (m *StartJobRequest_MongoDBRestoreBackup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartJobRequest_MongoDBRestoreBackup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Dsn
+
+	if all {
+		switch v := interface{}(m.GetTextFiles()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
+					field:  "TextFiles",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
+					field:  "TextFiles",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTextFiles()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartJobRequest_MongoDBRestoreBackupValidationError{
+				field:  "TextFiles",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Folder
+
+	if all {
+		switch v := interface{}(m.GetPbmMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
+					field:  "PbmMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
+					field:  "PbmMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPbmMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartJobRequest_MongoDBRestoreBackupValidationError{
+				field:  "PbmMetadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPitrTimestamp()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
+					field:  "PitrTimestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
+					field:  "PitrTimestamp",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPitrTimestamp()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartJobRequest_MongoDBRestoreBackupValidationError{
+				field:  "PitrTimestamp",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.LocationConfig.(type) {
+	case *StartJobRequest_MongoDBRestoreBackup_S3Config:
+		if v == nil {
+			err := StartJobRequest_MongoDBRestoreBackupValidationError{
+				field:  "LocationConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetS3Config()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
+						field:  "S3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
+						field:  "S3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetS3Config()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartJobRequest_MongoDBRestoreBackupValidationError{
+					field:  "S3Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StartJobRequest_MongoDBRestoreBackup_FilesystemConfig:
+		if v == nil {
+			err := StartJobRequest_MongoDBRestoreBackupValidationError{
+				field:  "LocationConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFilesystemConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
+						field:  "FilesystemConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartJobRequest_MongoDBRestoreBackupValidationError{
+						field:  "FilesystemConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilesystemConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartJobRequest_MongoDBRestoreBackupValidationError{
+					field:  "FilesystemConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return StartJobRequest_MongoDBRestoreBackupMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartJobRequest_MongoDBRestoreBackupMultiError is an error wrapping multiple
+// validation errors returned by
+// StartJobRequest_MongoDBRestoreBackup.ValidateAll() if the designated
+// constraints aren't met.
+type StartJobRequest_MongoDBRestoreBackupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartJobRequest_MongoDBRestoreBackupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartJobRequest_MongoDBRestoreBackupMultiError) AllErrors() []error { return m }
+
+// StartJobRequest_MongoDBRestoreBackupValidationError is the validation error
+// returned by StartJobRequest_MongoDBRestoreBackup.Validate if the designated
+// constraints aren't met.
+type StartJobRequest_MongoDBRestoreBackupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartJobRequest_MongoDBRestoreBackupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartJobRequest_MongoDBRestoreBackupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartJobRequest_MongoDBRestoreBackupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartJobRequest_MongoDBRestoreBackupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartJobRequest_MongoDBRestoreBackupValidationError) ErrorName() string {
+	return "StartJobRequest_MongoDBRestoreBackupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartJobRequest_MongoDBRestoreBackupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartJobRequest_MongoDBRestoreBackup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = StartJobRequest_MongoDBRestoreBackupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartJobRequest_MongoDBRestoreBackupValidationError{}
+
+// Validate checks the field values on StartJobRequest_PostgreSQLBackup with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *StartJobRequest_PostgreSQLBackup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartJobRequest_PostgreSQLBackup with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// StartJobRequest_PostgreSQLBackupMultiError, or nil if none found.
+func (m *StartJobRequest_PostgreSQLBackup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartJobRequest_PostgreSQLBackup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Dsn
+
+	if all {
+		switch v := interface{}(m.GetTextFiles()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartJobRequest_PostgreSQLBackupValidationError{
+					field:  "TextFiles",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartJobRequest_PostgreSQLBackupValidationError{
+					field:  "TextFiles",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTextFiles()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartJobRequest_PostgreSQLBackupValidationError{
+				field:  "TextFiles",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Folder
+
+	// no validation rules for DataModel
+
+	switch v := m.LocationConfig.(type) {
+	case *StartJobRequest_PostgreSQLBackup_S3Config:
+		if v == nil {
+			err := StartJobRequest_PostgreSQLBackupValidationError{
+				field:  "LocationConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetS3Config()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartJobRequest_PostgreSQLBackupValidationError{
+						field:  "S3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartJobRequest_PostgreSQLBackupValidationError{
+						field:  "S3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetS3Config()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartJobRequest_PostgreSQLBackupValidationError{
+					field:  "S3Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StartJobRequest_PostgreSQLBackup_FilesystemConfig:
+		if v == nil {
+			err := StartJobRequest_PostgreSQLBackupValidationError{
+				field:  "LocationConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFilesystemConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartJobRequest_PostgreSQLBackupValidationError{
+						field:  "FilesystemConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartJobRequest_PostgreSQLBackupValidationError{
+						field:  "FilesystemConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilesystemConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartJobRequest_PostgreSQLBackupValidationError{
+					field:  "FilesystemConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return StartJobRequest_PostgreSQLBackupMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartJobRequest_PostgreSQLBackupMultiError is an error wrapping multiple
+// validation errors returned by
+// StartJobRequest_PostgreSQLBackup.ValidateAll() if the designated
+// constraints aren't met.
+type StartJobRequest_PostgreSQLBackupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartJobRequest_PostgreSQLBackupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartJobRequest_PostgreSQLBackupMultiError) AllErrors() []error { return m }
+
+// StartJobRequest_PostgreSQLBackupValidationError is the validation error
+// returned by StartJobRequest_PostgreSQLBackup.Validate if the designated
+// constraints aren't met.
+type StartJobRequest_PostgreSQLBackupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartJobRequest_PostgreSQLBackupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartJobRequest_PostgreSQLBackupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartJobRequest_PostgreSQLBackupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartJobRequest_PostgreSQLBackupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartJobRequest_PostgreSQLBackupValidationError) ErrorName() string {
+	return "StartJobRequest_PostgreSQLBackupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartJobRequest_PostgreSQLBackupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartJobRequest_PostgreSQLBackup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = StartJobRequest_PostgreSQLBackupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartJobRequest_PostgreSQLBackupValidationError{}
+
+// Validate checks the field values on StartJobRequest_PostgreSQLRestoreBackup
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *StartJobRequest_PostgreSQLRestoreBackup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// StartJobRequest_PostgreSQLRestoreBackup with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// StartJobRequest_PostgreSQLRestoreBackupMultiError, or nil if none found.
+func (m *StartJobRequest_PostgreSQLRestoreBackup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartJobRequest_PostgreSQLRestoreBackup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Dsn
+
+	if all {
+		switch v := interface{}(m.GetTextFiles()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartJobRequest_PostgreSQLRestoreBackupValidationError{
+					field:  "TextFiles",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartJobRequest_PostgreSQLRestoreBackupValidationError{
+					field:  "TextFiles",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTextFiles()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartJobRequest_PostgreSQLRestoreBackupValidationError{
+				field:  "TextFiles",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Folder
+
+	// no validation rules for DataModel
+
+	switch v := m.LocationConfig.(type) {
+	case *StartJobRequest_PostgreSQLRestoreBackup_S3Config:
+		if v == nil {
+			err := StartJobRequest_PostgreSQLRestoreBackupValidationError{
+				field:  "LocationConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetS3Config()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartJobRequest_PostgreSQLRestoreBackupValidationError{
+						field:  "S3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartJobRequest_PostgreSQLRestoreBackupValidationError{
+						field:  "S3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetS3Config()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartJobRequest_PostgreSQLRestoreBackupValidationError{
+					field:  "S3Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StartJobRequest_PostgreSQLRestoreBackup_FilesystemConfig:
+		if v == nil {
+			err := StartJobRequest_PostgreSQLRestoreBackupValidationError{
+				field:  "LocationConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFilesystemConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartJobRequest_PostgreSQLRestoreBackupValidationError{
+						field:  "FilesystemConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartJobRequest_PostgreSQLRestoreBackupValidationError{
+						field:  "FilesystemConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilesystemConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartJobRequest_PostgreSQLRestoreBackupValidationError{
+					field:  "FilesystemConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return StartJobRequest_PostgreSQLRestoreBackupMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartJobRequest_PostgreSQLRestoreBackupMultiError is an error wrapping
+// multiple validation errors returned by
+// StartJobRequest_PostgreSQLRestoreBackup.ValidateAll() if the designated
+// constraints aren't met.
+type StartJobRequest_PostgreSQLRestoreBackupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartJobRequest_PostgreSQLRestoreBackupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartJobRequest_PostgreSQLRestoreBackupMultiError) AllErrors() []error { return m }
+
+// StartJobRequest_PostgreSQLRestoreBackupValidationError is the validation
+// error returned by StartJobRequest_PostgreSQLRestoreBackup.Validate if the
+// designated constraints aren't met.
+type StartJobRequest_PostgreSQLRestoreBackupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartJobRequest_PostgreSQLRestoreBackupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartJobRequest_PostgreSQLRestoreBackupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartJobRequest_PostgreSQLRestoreBackupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartJobRequest_PostgreSQLRestoreBackupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartJobRequest_PostgreSQLRestoreBackupValidationError) ErrorName() string {
+	return "StartJobRequest_PostgreSQLRestoreBackupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartJobRequest_PostgreSQLRestoreBackupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartJobRequest_PostgreSQLRestoreBackup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = StartJobRequest_PostgreSQLRestoreBackupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartJobRequest_PostgreSQLRestoreBackupValidationError{}
+
+// Validate checks the field values on StartJobRequest_MySQLVerifyBackup with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *StartJobRequest_MySQLVerifyBackup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartJobRequest_MySQLVerifyBackup
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// StartJobRequest_MySQLVerifyBackupMultiError, or nil if none found.
+func (m *StartJobRequest_MySQLVerifyBackup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartJobRequest_MySQLVerifyBackup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Folder
+
+	switch v := m.LocationConfig.(type) {
+	case *StartJobRequest_MySQLVerifyBackup_S3Config:
+		if v == nil {
+			err := StartJobRequest_MySQLVerifyBackupValidationError{
+				field:  "LocationConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetS3Config()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartJobRequest_MySQLVerifyBackupValidationError{
+						field:  "S3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartJobRequest_MySQLVerifyBackupValidationError{
+						field:  "S3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetS3Config()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartJobRequest_MySQLVerifyBackupValidationError{
+					field:  "S3Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return StartJobRequest_MySQLVerifyBackupMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartJobRequest_MySQLVerifyBackupMultiError is an error wrapping multiple
+// validation errors returned by
+// StartJobRequest_MySQLVerifyBackup.ValidateAll() if the designated
+// constraints aren't met.
+type StartJobRequest_MySQLVerifyBackupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartJobRequest_MySQLVerifyBackupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartJobRequest_MySQLVerifyBackupMultiError) AllErrors() []error { return m }
+
+// StartJobRequest_MySQLVerifyBackupValidationError is the validation error
+// returned by StartJobRequest_MySQLVerifyBackup.Validate if the designated
+// constraints aren't met.
+type StartJobRequest_MySQLVerifyBackupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartJobRequest_MySQLVerifyBackupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartJobRequest_MySQLVerifyBackupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartJobRequest_MySQLVerifyBackupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartJobRequest_MySQLVerifyBackupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartJobRequest_MySQLVerifyBackupValidationError) ErrorName() string {
+	return "StartJobRequest_MySQLVerifyBackupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartJobRequest_MySQLVerifyBackupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartJobRequest_MySQLVerifyBackup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = StartJobRequest_MySQLVerifyBackupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartJobRequest_MySQLVerifyBackupValidationError{}
+
+// Validate checks the field values on StartJobRequest_MongoDBVerifyBackup with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *StartJobRequest_MongoDBVerifyBackup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartJobRequest_MongoDBVerifyBackup
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// StartJobRequest_MongoDBVerifyBackupMultiError, or nil if none found.
+func (m *StartJobRequest_MongoDBVerifyBackup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartJobRequest_MongoDBVerifyBackup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Folder
+
+	if all {
+		switch v := interface{}(m.GetPbmMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StartJobRequest_MongoDBVerifyBackupValidationError{
+					field:  "PbmMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StartJobRequest_MongoDBVerifyBackupValidationError{
+					field:  "PbmMetadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPbmMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StartJobRequest_MongoDBVerifyBackupValidationError{
+				field:  "PbmMetadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	switch v := m.LocationConfig.(type) {
+	case *StartJobRequest_MongoDBVerifyBackup_S3Config:
+		if v == nil {
+			err := StartJobRequest_MongoDBVerifyBackupValidationError{
+				field:  "LocationConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetS3Config()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartJobRequest_MongoDBVerifyBackupValidationError{
+						field:  "S3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartJobRequest_MongoDBVerifyBackupValidationError{
+						field:  "S3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetS3Config()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartJobRequest_MongoDBVerifyBackupValidationError{
+					field:  "S3Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *StartJobRequest_MongoDBVerifyBackup_FilesystemConfig:
+		if v == nil {
+			err := StartJobRequest_MongoDBVerifyBackupValidationError{
+				field:  "LocationConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetFilesystemConfig()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartJobRequest_MongoDBVerifyBackupValidationError{
+						field:  "FilesystemConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartJobRequest_MongoDBVerifyBackupValidationError{
+						field:  "FilesystemConfig",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetFilesystemConfig()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartJobRequest_MongoDBVerifyBackupValidationError{
+					field:  "FilesystemConfig",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return StartJobRequest_MongoDBVerifyBackupMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartJobRequest_MongoDBVerifyBackupMultiError is an error wrapping multiple
+// validation errors returned by
+// StartJobRequest_MongoDBVerifyBackup.ValidateAll() if the designated
+// constraints aren't met.
+type StartJobRequest_MongoDBVerifyBackupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartJobRequest_MongoDBVerifyBackupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartJobRequest_MongoDBVerifyBackupMultiError) AllErrors() []error { return m }
+
+// StartJobRequest_MongoDBVerifyBackupValidationError is the validation error
+// returned by StartJobRequest_MongoDBVerifyBackup.Validate if the designated
+// constraints aren't met.
+type StartJobRequest_MongoDBVerifyBackupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartJobRequest_MongoDBVerifyBackupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartJobRequest_MongoDBVerifyBackupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartJobRequest_MongoDBVerifyBackupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartJobRequest_MongoDBVerifyBackupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartJobRequest_MongoDBVerifyBackupValidationError) ErrorName() string {
+	return "StartJobRequest_MongoDBVerifyBackupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartJobRequest_MongoDBVerifyBackupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartJobRequest_MongoDBVerifyBackup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = StartJobRequest_MongoDBVerifyBackupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartJobRequest_MongoDBVerifyBackupValidationError{}
+
+// Validate checks the field values on StartJobRequest_MySQLBinlogStream with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *StartJobRequest_MySQLBinlogStream) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StartJobRequest_MySQLBinlogStream
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// StartJobRequest_MySQLBinlogStreamMultiError, or nil if none found.
+func (m *StartJobRequest_MySQLBinlogStream) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StartJobRequest_MySQLBinlogStream) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for User
+
+	// no validation rules for Password
+
+	// no validation rules for Address
+
+	// no validation rules for Port
+
+	// no validation rules for Socket
+
+	// no validation rules for Folder
+
+	switch v := m.LocationConfig.(type) {
+	case *StartJobRequest_MySQLBinlogStream_S3Config:
+		if v == nil {
+			err := StartJobRequest_MySQLBinlogStreamValidationError{
+				field:  "LocationConfig",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetS3Config()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StartJobRequest_MySQLBinlogStreamValidationError{
+						field:  "S3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StartJobRequest_MySQLBinlogStreamValidationError{
+						field:  "S3Config",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetS3Config()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StartJobRequest_MySQLBinlogStreamValidationError{
+					field:  "S3Config",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return StartJobRequest_MySQLBinlogStreamMultiError(errors)
+	}
+
+	return nil
+}
+
+// StartJobRequest_MySQLBinlogStreamMultiError is an error wrapping multiple
+// validation errors returned by
+// StartJobRequest_MySQLBinlogStream.ValidateAll() if the designated
+// constraints aren't met.
+type StartJobRequest_MySQLBinlogStreamMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StartJobRequest_MySQLBinlogStreamMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StartJobRequest_MySQLBinlogStreamMultiError) AllErrors() []error { return m }
+
+// StartJobRequest_MySQLBinlogStreamValidationError is the validation error
+// returned by StartJobRequest_MySQLBinlogStream.Validate if the designated
+// constraints aren't met.
+type StartJobRequest_MySQLBinlogStreamValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StartJobRequest_MySQLBinlogStreamValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StartJobRequest_MySQLBinlogStreamValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StartJobRequest_MySQLBinlogStreamValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StartJobRequest_MySQLBinlogStreamValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StartJobRequest_MySQLBinlogStreamValidationError) ErrorName() string {
+	return "StartJobRequest_MySQLBinlogStreamValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StartJobRequest_MySQLBinlogStreamValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStartJobRequest_MySQLBinlogStream.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = StartJobRequest_MySQLBinlogStreamValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StartJobRequest_MySQLBinlogStreamValidationError{}
+
+// Validate checks the field values on JobResult_Error with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobResult_Error) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobResult_Error with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobResult_ErrorMultiError, or nil if none found.
+func (m *JobResult_Error) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobResult_Error) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return JobResult_ErrorMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobResult_ErrorMultiError is an error wrapping multiple validation errors
+// returned by JobResult_Error.ValidateAll() if the designated constraints
+// aren't met.
+type JobResult_ErrorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobResult_ErrorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobResult_ErrorMultiError) AllErrors() []error { return m }
+
+// JobResult_ErrorValidationError is the validation error returned by
+// JobResult_Error.Validate if the designated constraints aren't met.
+type JobResult_ErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobResult_ErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobResult_ErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobResult_ErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobResult_ErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobResult_ErrorValidationError) ErrorName() string { return "JobResult_ErrorValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobResult_ErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobResult_Error.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = JobResult_ErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobResult_ErrorValidationError{}
+
+// Validate checks the field values on JobResult_MongoDBBackup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobResult_MongoDBBackup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobResult_MongoDBBackup with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobResult_MongoDBBackupMultiError, or nil if none found.
+func (m *JobResult_MongoDBBackup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobResult_MongoDBBackup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for IsShardedCluster
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobResult_MongoDBBackupValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobResult_MongoDBBackupValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobResult_MongoDBBackupValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return JobResult_MongoDBBackupMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobResult_MongoDBBackupMultiError is an error wrapping multiple validation
+// errors returned by JobResult_MongoDBBackup.ValidateAll() if the designated
+// constraints aren't met.
+type JobResult_MongoDBBackupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobResult_MongoDBBackupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobResult_MongoDBBackupMultiError) AllErrors() []error { return m }
+
+// JobResult_MongoDBBackupValidationError is the validation error returned by
+// JobResult_MongoDBBackup.Validate if the designated constraints aren't met.
+type JobResult_MongoDBBackupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobResult_MongoDBBackupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobResult_MongoDBBackupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobResult_MongoDBBackupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobResult_MongoDBBackupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobResult_MongoDBBackupValidationError) ErrorName() string {
+	return "JobResult_MongoDBBackupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobResult_MongoDBBackupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobResult_MongoDBBackup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = JobResult_MongoDBBackupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobResult_MongoDBBackupValidationError{}
+
+// Validate checks the field values on JobResult_MySQLBackup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobResult_MySQLBackup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobResult_MySQLBackup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobResult_MySQLBackupMultiError, or nil if none found.
+func (m *JobResult_MySQLBackup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobResult_MySQLBackup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobResult_MySQLBackupValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JobResult_MySQLBackupValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobResult_MySQLBackupValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return JobResult_MySQLBackupMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobResult_MySQLBackupMultiError is an error wrapping multiple validation
+// errors returned by JobResult_MySQLBackup.ValidateAll() if the designated
+// constraints aren't met.
+type JobResult_MySQLBackupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobResult_MySQLBackupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobResult_MySQLBackupMultiError) AllErrors() []error { return m }
+
+// JobResult_MySQLBackupValidationError is the validation error returned by
+// JobResult_MySQLBackup.Validate if the designated constraints aren't met.
+type JobResult_MySQLBackupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobResult_MySQLBackupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobResult_MySQLBackupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobResult_MySQLBackupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobResult_MySQLBackupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobResult_MySQLBackupValidationError) ErrorName() string {
+	return "JobResult_MySQLBackupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobResult_MySQLBackupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobResult_MySQLBackup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = JobResult_MySQLBackupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobResult_MySQLBackupValidationError{}
+
+// Validate checks the field values on JobResult_MySQLRestoreBackup with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobResult_MySQLRestoreBackup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobResult_MySQLRestoreBackup with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobResult_MySQLRestoreBackupMultiError, or nil if none found.
+func (m *JobResult_MySQLRestoreBackup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobResult_MySQLRestoreBackup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return JobResult_MySQLRestoreBackupMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobResult_MySQLRestoreBackupMultiError is an error wrapping multiple
+// validation errors returned by JobResult_MySQLRestoreBackup.ValidateAll() if
+// the designated constraints aren't met.
+type JobResult_MySQLRestoreBackupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobResult_MySQLRestoreBackupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobResult_MySQLRestoreBackupMultiError) AllErrors() []error { return m }
+
+// JobResult_MySQLRestoreBackupValidationError is the validation error returned
+// by JobResult_MySQLRestoreBackup.Validate if the designated constraints
+// aren't met.
+type JobResult_MySQLRestoreBackupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobResult_MySQLRestoreBackupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobResult_MySQLRestoreBackupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobResult_MySQLRestoreBackupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobResult_MySQLRestoreBackupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobResult_MySQLRestoreBackupValidationError) ErrorName() string {
+	return "JobResult_MySQLRestoreBackupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobResult_MySQLRestoreBackupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobResult_MySQLRestoreBackup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = JobResult_MySQLRestoreBackupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobResult_MySQLRestoreBackupValidationError{}
+
+// Validate checks the field values on JobResult_MongoDBRestoreBackup with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobResult_MongoDBRestoreBackup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobResult_MongoDBRestoreBackup with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// JobResult_MongoDBRestoreBackupMultiError, or nil if none found.
+func (m *JobResult_MongoDBRestoreBackup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobResult_MongoDBRestoreBackup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return JobResult_MongoDBRestoreBackupMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobResult_MongoDBRestoreBackupMultiError is an error wrapping multiple
+// validation errors returned by JobResult_MongoDBRestoreBackup.ValidateAll()
+// if the designated constraints aren't met.
+type JobResult_MongoDBRestoreBackupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobResult_MongoDBRestoreBackupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobResult_MongoDBRestoreBackupMultiError) AllErrors() []error { return m }
+
+// JobResult_MongoDBRestoreBackupValidationError is the validation error
+// returned by JobResult_MongoDBRestoreBackup.Validate if the designated
+// constraints aren't met.
+type JobResult_MongoDBRestoreBackupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobResult_MongoDBRestoreBackupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobResult_MongoDBRestoreBackupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobResult_MongoDBRestoreBackupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobResult_MongoDBRestoreBackupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobResult_MongoDBRestoreBackupValidationError) ErrorName() string {
+	return "JobResult_MongoDBRestoreBackupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobResult_MongoDBRestoreBackupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobResult_MongoDBRestoreBackup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = JobResult_MongoDBRestoreBackupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobResult_MongoDBRestoreBackupValidationError{}
+
+// Validate checks the field values on JobResult_PostgreSQLBackup with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobResult_PostgreSQLBackup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobResult_PostgreSQLBackup with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobResult_PostgreSQLBackupMultiError, or nil if none found.
+func (m *JobResult_PostgreSQLBackup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobResult_PostgreSQLBackup) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetadata()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JobResult_PostgreSQLBackupValidationError{
+					field:  "Metadata",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return StartJobRequest_MongoDBRestoreBackupValidationError{
-					field:  "FilesystemConfig",
+				errors = append(errors, JobResult_PostgreSQLBackupValidationError{
+					field:  "Metadata",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JobResult_PostgreSQLBackupValidationError{
+				field:  "Metadata",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
-	default:
-		_ = v // ensures v is used
+	if len(errors) > 0 {
+		return JobResult_PostgreSQLBackupMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobResult_PostgreSQLBackupMultiError is an error wrapping multiple
+// validation errors returned by JobResult_PostgreSQLBackup.ValidateAll() if
+// the designated constraints aren't met.
+type JobResult_PostgreSQLBackupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobResult_PostgreSQLBackupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobResult_PostgreSQLBackupMultiError) AllErrors() []error { return m }
+
+// JobResult_PostgreSQLBackupValidationError is the validation error returned
+// by JobResult_PostgreSQLBackup.Validate if the designated constraints aren't met.
+type JobResult_PostgreSQLBackupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobResult_PostgreSQLBackupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobResult_PostgreSQLBackupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobResult_PostgreSQLBackupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobResult_PostgreSQLBackupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobResult_PostgreSQLBackupValidationError) ErrorName() string {
+	return "JobResult_PostgreSQLBackupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobResult_PostgreSQLBackupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobResult_PostgreSQLBackup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = JobResult_PostgreSQLBackupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobResult_PostgreSQLBackupValidationError{}
+
+// Validate checks the field values on JobResult_PostgreSQLRestoreBackup with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *JobResult_PostgreSQLRestoreBackup) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobResult_PostgreSQLRestoreBackup
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// JobResult_PostgreSQLRestoreBackupMultiError, or nil if none found.
+func (m *JobResult_PostgreSQLRestoreBackup) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobResult_PostgreSQLRestoreBackup) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if len(errors) > 0 {
-		return StartJobRequest_MongoDBRestoreBackupMultiError(errors)
+		return JobResult_PostgreSQLRestoreBackupMultiError(errors)
 	}
 
 	return nil
 }
 
-// StartJobRequest_MongoDBRestoreBackupMultiError is an error wrapping multiple
+// JobResult_PostgreSQLRestoreBackupMultiError is an error wrapping multiple
 // validation errors returned by
-// StartJobRequest_MongoDBRestoreBackup.ValidateAll() if the designated
+// JobResult_PostgreSQLRestoreBackup.ValidateAll() if the designated
 // constraints aren't met.
-type StartJobRequest_MongoDBRestoreBackupMultiError []error
+type JobResult_PostgreSQLRestoreBackupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StartJobRequest_MongoDBRestoreBackupMultiError) Error() string {
+func (m JobResult_PostgreSQLRestoreBackupMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12188,12 +15385,12 @@ func (m StartJobRequest_MongoDBRestoreBackupMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StartJobRequest_MongoDBRestoreBackupMultiError) AllErrors() []error { return m }
+func (m JobResult_PostgreSQLRestoreBackupMultiError) AllErrors() []error { return m }
 
-// StartJobRequest_MongoDBRestoreBackupValidationError is the validation error
-// returned by StartJobRequest_MongoDBRestoreBackup.Validate if the designated
+// JobResult_PostgreSQLRestoreBackupValidationError is the validation error
+// returned by JobResult_PostgreSQLRestoreBackup.Validate if the designated
 // constraints aren't met.
-type StartJobRequest_MongoDBRestoreBackupValidationError struct {
+type JobResult_PostgreSQLRestoreBackupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12201,24 +15398,24 @@ type StartJobRequest_MongoDBRestoreBackupValidationError struct {
 }
 
 // Field function returns field value.
-func (e StartJobRequest_MongoDBRestoreBackupValidationError) Field() string { return e.field }
+func (e JobResult_PostgreSQLRestoreBackupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StartJobRequest_MongoDBRestoreBackupValidationError) Reason() string { return e.reason }
+func (e JobResult_PostgreSQLRestoreBackupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StartJobRequest_MongoDBRestoreBackupValidationError) Cause() error { return e.cause }
+func (e JobResult_PostgreSQLRestoreBackupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StartJobRequest_MongoDBRestoreBackupValidationError) Key() bool { return e.key }
+func (e JobResult_PostgreSQLRestoreBackupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StartJobRequest_MongoDBRestoreBackupValidationError) ErrorName() string {
-	return "StartJobRequest_MongoDBRestoreBackupValidationError"
+func (e JobResult_PostgreSQLRestoreBackupValidationError) ErrorName() string {
+	return "JobResult_PostgreSQLRestoreBackupValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e StartJobRequest_MongoDBRestoreBackupValidationError) Error() string {
+func (e JobResult_PostgreSQLRestoreBackupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12230,7 +15427,7 @@ func (e StartJobRequest_MongoDBRestoreBackupValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStartJobRequest_MongoDBRestoreBackup.%s: %s%s",
+		"invalid %sJobResult_PostgreSQLRestoreBackup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -12238,7 +15435,7 @@ func (e StartJobRequest_MongoDBRestoreBackupValidationError) Error() string {
 	)
 }
 
-var _ error = StartJobRequest_MongoDBRestoreBackupValidationError{}
+var _ error = JobResult_PostgreSQLRestoreBackupValidationError{}
 
 var _ interface {
 	Field() string
@@ -12246,46 +15443,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StartJobRequest_MongoDBRestoreBackupValidationError{}
+} = JobResult_PostgreSQLRestoreBackupValidationError{}
 
-// Validate checks the field values on JobResult_Error with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *JobResult_Error) Validate() error {
+// Validate checks the field values on JobResult_MySQLVerifyBackup with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobResult_MySQLVerifyBackup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobResult_Error with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on JobResult_MySQLVerifyBackup with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobResult_ErrorMultiError, or nil if none found.
-func (m *JobResult_Error) ValidateAll() error {
+// JobResult_MySQLVerifyBackupMultiError, or nil if none found.
+func (m *JobResult_MySQLVerifyBackup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobResult_Error) validate(all bool) error {
+func (m *JobResult_MySQLVerifyBackup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Message
-
 	if len(errors) > 0 {
-		return JobResult_ErrorMultiError(errors)
+		return JobResult_MySQLVerifyBackupMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobResult_ErrorMultiError is an error wrapping multiple validation errors
-// returned by JobResult_Error.ValidateAll() if the designated constraints
-// aren't met.
-type JobResult_ErrorMultiError []error
+// JobResult_MySQLVerifyBackupMultiError is an error wrapping multiple
+// validation errors returned by JobResult_MySQLVerifyBackup.ValidateAll() if
+// the designated constraints aren't met.
+type JobResult_MySQLVerifyBackupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobResult_ErrorMultiError) Error() string {
+func (m JobResult_MySQLVerifyBackupMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12294,11 +15489,12 @@ func (m JobResult_ErrorMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobResult_ErrorMultiError) AllErrors() []error { return m }
+func (m JobResult_MySQLVerifyBackupMultiError) AllErrors() []error { return m }
 
-// JobResult_ErrorValidationError is the validation error returned by
-// JobResult_Error.Validate if the designated constraints aren't met.
-type JobResult_ErrorValidationError struct {
+// JobResult_MySQLVerifyBackupValidationError is the validation error returned
+// by JobResult_MySQLVerifyBackup.Validate if the designated constraints
+// aren't met.
+type JobResult_MySQLVerifyBackupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12306,22 +15502,24 @@ type JobResult_ErrorValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobResult_ErrorValidationError) Field() string { return e.field }
+func (e JobResult_MySQLVerifyBackupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobResult_ErrorValidationError) Reason() string { return e.reason }
+func (e JobResult_MySQLVerifyBackupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobResult_ErrorValidationError) Cause() error { return e.cause }
+func (e JobResult_MySQLVerifyBackupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobResult_ErrorValidationError) Key() bool { return e.key }
+func (e JobResult_MySQLVerifyBackupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobResult_ErrorValidationError) ErrorName() string { return "JobResult_ErrorValidationError" }
+func (e JobResult_MySQLVerifyBackupValidationError) ErrorName() string {
+	return "JobResult_MySQLVerifyBackupValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobResult_ErrorValidationError) Error() string {
+func (e JobResult_MySQLVerifyBackupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12333,7 +15531,7 @@ func (e JobResult_ErrorValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobResult_Error.%s: %s%s",
+		"invalid %sJobResult_MySQLVerifyBackup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -12341,7 +15539,7 @@ func (e JobResult_ErrorValidationError) Error() string {
 	)
 }
 
-var _ error = JobResult_ErrorValidationError{}
+var _ error = JobResult_MySQLVerifyBackupValidationError{}
 
 var _ interface {
 	Field() string
@@ -12349,75 +15547,148 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobResult_ErrorValidationError{}
+} = JobResult_MySQLVerifyBackupValidationError{}
 
-// Validate checks the field values on JobResult_MongoDBBackup with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on JobResult_MongoDBVerifyBackup with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobResult_MongoDBBackup) Validate() error {
+func (m *JobResult_MongoDBVerifyBackup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobResult_MongoDBBackup with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// JobResult_MongoDBBackupMultiError, or nil if none found.
-func (m *JobResult_MongoDBBackup) ValidateAll() error {
+// ValidateAll checks the field values on JobResult_MongoDBVerifyBackup with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// JobResult_MongoDBVerifyBackupMultiError, or nil if none found.
+func (m *JobResult_MongoDBVerifyBackup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobResult_MongoDBBackup) validate(all bool) error {
+func (m *JobResult_MongoDBVerifyBackup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for IsShardedCluster
+	if len(errors) > 0 {
+		return JobResult_MongoDBVerifyBackupMultiError(errors)
+	}
 
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobResult_MongoDBBackupValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobResult_MongoDBBackupValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobResult_MongoDBBackupValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	return nil
+}
+
+// JobResult_MongoDBVerifyBackupMultiError is an error wrapping multiple
+// validation errors returned by JobResult_MongoDBVerifyBackup.ValidateAll()
+// if the designated constraints aren't met.
+type JobResult_MongoDBVerifyBackupMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobResult_MongoDBVerifyBackupMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobResult_MongoDBVerifyBackupMultiError) AllErrors() []error { return m }
+
+// JobResult_MongoDBVerifyBackupValidationError is the validation error
+// returned by JobResult_MongoDBVerifyBackup.Validate if the designated
+// constraints aren't met.
+type JobResult_MongoDBVerifyBackupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobResult_MongoDBVerifyBackupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobResult_MongoDBVerifyBackupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobResult_MongoDBVerifyBackupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobResult_MongoDBVerifyBackupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobResult_MongoDBVerifyBackupValidationError) ErrorName() string {
+	return "JobResult_MongoDBVerifyBackupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e JobResult_MongoDBVerifyBackupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobResult_MongoDBVerifyBackup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = JobResult_MongoDBVerifyBackupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobResult_MongoDBVerifyBackupValidationError{}
+
+// Validate checks the field values on JobResult_MySQLBinlogStream with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *JobResult_MySQLBinlogStream) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobResult_MySQLBinlogStream with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobResult_MySQLBinlogStreamMultiError, or nil if none found.
+func (m *JobResult_MySQLBinlogStream) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobResult_MySQLBinlogStream) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if len(errors) > 0 {
-		return JobResult_MongoDBBackupMultiError(errors)
+		return JobResult_MySQLBinlogStreamMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobResult_MongoDBBackupMultiError is an error wrapping multiple validation
-// errors returned by JobResult_MongoDBBackup.ValidateAll() if the designated
-// constraints aren't met.
-type JobResult_MongoDBBackupMultiError []error
+// JobResult_MySQLBinlogStreamMultiError is an error wrapping multiple
+// validation errors returned by JobResult_MySQLBinlogStream.ValidateAll() if
+// the designated constraints aren't met.
+type JobResult_MySQLBinlogStreamMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobResult_MongoDBBackupMultiError) Error() string {
+func (m JobResult_MySQLBinlogStreamMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12426,11 +15697,12 @@ func (m JobResult_MongoDBBackupMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobResult_MongoDBBackupMultiError) AllErrors() []error { return m }
+func (m JobResult_MySQLBinlogStreamMultiError) AllErrors() []error { return m }
 
-// JobResult_MongoDBBackupValidationError is the validation error returned by
-// JobResult_MongoDBBackup.Validate if the designated constraints aren't met.
-type JobResult_MongoDBBackupValidationError struct {
+// JobResult_MySQLBinlogStreamValidationError is the validation error returned
+// by JobResult_MySQLBinlogStream.Validate if the designated constraints
+// aren't met.
+type JobResult_MySQLBinlogStreamValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12438,24 +15710,24 @@ type JobResult_MongoDBBackupValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobResult_MongoDBBackupValidationError) Field() string { return e.field }
+func (e JobResult_MySQLBinlogStreamValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobResult_MongoDBBackupValidationError) Reason() string { return e.reason }
+func (e JobResult_MySQLBinlogStreamValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobResult_MongoDBBackupValidationError) Cause() error { return e.cause }
+func (e JobResult_MySQLBinlogStreamValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobResult_MongoDBBackupValidationError) Key() bool { return e.key }
+func (e JobResult_MySQLBinlogStreamValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobResult_MongoDBBackupValidationError) ErrorName() string {
-	return "JobResult_MongoDBBackupValidationError"
+func (e JobResult_MySQLBinlogStreamValidationError) ErrorName() string {
+	return "JobResult_MySQLBinlogStreamValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobResult_MongoDBBackupValidationError) Error() string {
+func (e JobResult_MySQLBinlogStreamValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12467,7 +15739,7 @@ func (e JobResult_MongoDBBackupValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobResult_MongoDBBackup.%s: %s%s",
+		"invalid %sJobResult_MySQLBinlogStream.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -12475,7 +15747,7 @@ func (e JobResult_MongoDBBackupValidationError) Error() string {
 	)
 }
 
-var _ error = JobResult_MongoDBBackupValidationError{}
+var _ error = JobResult_MySQLBinlogStreamValidationError{}
 
 var _ interface {
 	Field() string
@@ -12483,73 +15755,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobResult_MongoDBBackupValidationError{}
+} = JobResult_MySQLBinlogStreamValidationError{}
 
-// Validate checks the field values on JobResult_MySQLBackup with the rules
+// Validate checks the field values on JobProgress_MySQLBackup with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobResult_MySQLBackup) Validate() error {
+func (m *JobProgress_MySQLBackup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobResult_MySQLBackup with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on JobProgress_MySQLBackup with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobResult_MySQLBackupMultiError, or nil if none found.
-func (m *JobResult_MySQLBackup) ValidateAll() error {
+// JobProgress_MySQLBackupMultiError, or nil if none found.
+func (m *JobProgress_MySQLBackup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobResult_MySQLBackup) validate(all bool) error {
+func (m *JobProgress_MySQLBackup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetMetadata()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, JobResult_MySQLBackupValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, JobResult_MySQLBackupValidationError{
-					field:  "Metadata",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetadata()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return JobResult_MySQLBackupValidationError{
-				field:  "Metadata",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return JobResult_MySQLBackupMultiError(errors)
+		return JobProgress_MySQLBackupMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobResult_MySQLBackupMultiError is an error wrapping multiple validation
-// errors returned by JobResult_MySQLBackup.ValidateAll() if the designated
+// JobProgress_MySQLBackupMultiError is an error wrapping multiple validation
+// errors returned by JobProgress_MySQLBackup.ValidateAll() if the designated
 // constraints aren't met.
-type JobResult_MySQLBackupMultiError []error
+type JobProgress_MySQLBackupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobResult_MySQLBackupMultiError) Error() string {
+func (m JobProgress_MySQLBackupMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12558,11 +15801,11 @@ func (m JobResult_MySQLBackupMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobResult_MySQLBackupMultiError) AllErrors() []error { return m }
+func (m JobProgress_MySQLBackupMultiError) AllErrors() []error { return m }
 
-// JobResult_MySQLBackupValidationError is the validation error returned by
-// JobResult_MySQLBackup.Validate if the designated constraints aren't met.
-type JobResult_MySQLBackupValidationError struct {
+// JobProgress_MySQLBackupValidationError is the validation error returned by
+// JobProgress_MySQLBackup.Validate if the designated constraints aren't met.
+type JobProgress_MySQLBackupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12570,24 +15813,24 @@ type JobResult_MySQLBackupValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobResult_MySQLBackupValidationError) Field() string { return e.field }
+func (e JobProgress_MySQLBackupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobResult_MySQLBackupValidationError) Reason() string { return e.reason }
+func (e JobProgress_MySQLBackupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobResult_MySQLBackupValidationError) Cause() error { return e.cause }
+func (e JobProgress_MySQLBackupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobResult_MySQLBackupValidationError) Key() bool { return e.key }
+func (e JobProgress_MySQLBackupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobResult_MySQLBackupValidationError) ErrorName() string {
-	return "JobResult_MySQLBackupValidationError"
+func (e JobProgress_MySQLBackupValidationError) ErrorName() string {
+	return "JobProgress_MySQLBackupValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobResult_MySQLBackupValidationError) Error() string {
+func (e JobProgress_MySQLBackupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12599,7 +15842,7 @@ func (e JobResult_MySQLBackupValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobResult_MySQLBackup.%s: %s%s",
+		"invalid %sJobProgress_MySQLBackup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -12607,7 +15850,7 @@ func (e JobResult_MySQLBackupValidationError) Error() string {
 	)
 }
 
-var _ error = JobResult_MySQLBackupValidationError{}
+var _ error = JobProgress_MySQLBackupValidationError{}
 
 var _ interface {
 	Field() string
@@ -12615,24 +15858,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobResult_MySQLBackupValidationError{}
+} = JobProgress_MySQLBackupValidationError{}
 
-// Validate checks the field values on JobResult_MySQLRestoreBackup with the
+// Validate checks the field values on JobProgress_MySQLRestoreBackup with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobResult_MySQLRestoreBackup) Validate() error {
+func (m *JobProgress_MySQLRestoreBackup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobResult_MySQLRestoreBackup with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// JobResult_MySQLRestoreBackupMultiError, or nil if none found.
-func (m *JobResult_MySQLRestoreBackup) ValidateAll() error {
+// ValidateAll checks the field values on JobProgress_MySQLRestoreBackup with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// JobProgress_MySQLRestoreBackupMultiError, or nil if none found.
+func (m *JobProgress_MySQLRestoreBackup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobResult_MySQLRestoreBackup) validate(all bool) error {
+func (m *JobProgress_MySQLRestoreBackup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12640,19 +15883,19 @@ func (m *JobResult_MySQLRestoreBackup) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return JobResult_MySQLRestoreBackupMultiError(errors)
+		return JobProgress_MySQLRestoreBackupMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobResult_MySQLRestoreBackupMultiError is an error wrapping multiple
-// validation errors returned by JobResult_MySQLRestoreBackup.ValidateAll() if
-// the designated constraints aren't met.
-type JobResult_MySQLRestoreBackupMultiError []error
+// JobProgress_MySQLRestoreBackupMultiError is an error wrapping multiple
+// validation errors returned by JobProgress_MySQLRestoreBackup.ValidateAll()
+// if the designated constraints aren't met.
+type JobProgress_MySQLRestoreBackupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobResult_MySQLRestoreBackupMultiError) Error() string {
+func (m JobProgress_MySQLRestoreBackupMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12661,12 +15904,12 @@ func (m JobResult_MySQLRestoreBackupMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobResult_MySQLRestoreBackupMultiError) AllErrors() []error { return m }
+func (m JobProgress_MySQLRestoreBackupMultiError) AllErrors() []error { return m }
 
-// JobResult_MySQLRestoreBackupValidationError is the validation error returned
-// by JobResult_MySQLRestoreBackup.Validate if the designated constraints
-// aren't met.
-type JobResult_MySQLRestoreBackupValidationError struct {
+// JobProgress_MySQLRestoreBackupValidationError is the validation error
+// returned by JobProgress_MySQLRestoreBackup.Validate if the designated
+// constraints aren't met.
+type JobProgress_MySQLRestoreBackupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12674,24 +15917,24 @@ type JobResult_MySQLRestoreBackupValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobResult_MySQLRestoreBackupValidationError) Field() string { return e.field }
+func (e JobProgress_MySQLRestoreBackupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobResult_MySQLRestoreBackupValidationError) Reason() string { return e.reason }
+func (e JobProgress_MySQLRestoreBackupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobResult_MySQLRestoreBackupValidationError) Cause() error { return e.cause }
+func (e JobProgress_MySQLRestoreBackupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobResult_MySQLRestoreBackupValidationError) Key() bool { return e.key }
+func (e JobProgress_MySQLRestoreBackupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobResult_MySQLRestoreBackupValidationError) ErrorName() string {
-	return "JobResult_MySQLRestoreBackupValidationError"
+func (e JobProgress_MySQLRestoreBackupValidationError) ErrorName() string {
+	return "JobProgress_MySQLRestoreBackupValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobResult_MySQLRestoreBackupValidationError) Error() string {
+func (e JobProgress_MySQLRestoreBackupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12703,7 +15946,7 @@ func (e JobResult_MySQLRestoreBackupValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobResult_MySQLRestoreBackup.%s: %s%s",
+		"invalid %sJobProgress_MySQLRestoreBackup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -12711,7 +15954,7 @@ func (e JobResult_MySQLRestoreBackupValidationError) Error() string {
 	)
 }
 
-var _ error = JobResult_MySQLRestoreBackupValidationError{}
+var _ error = JobProgress_MySQLRestoreBackupValidationError{}
 
 var _ interface {
 	Field() string
@@ -12719,44 +15962,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobResult_MySQLRestoreBackupValidationError{}
+} = JobProgress_MySQLRestoreBackupValidationError{}
 
-// Validate checks the field values on JobResult_MongoDBRestoreBackup with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobResult_MongoDBRestoreBackup) Validate() error {
+// Validate checks the field values on JobProgress_Logs with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *JobProgress_Logs) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobResult_MongoDBRestoreBackup with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// JobResult_MongoDBRestoreBackupMultiError, or nil if none found.
-func (m *JobResult_MongoDBRestoreBackup) ValidateAll() error {
+// ValidateAll checks the field values on JobProgress_Logs with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// JobProgress_LogsMultiError, or nil if none found.
+func (m *JobProgress_Logs) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobResult_MongoDBRestoreBackup) validate(all bool) error {
+func (m *JobProgress_Logs) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for ChunkId
+
+	// no validation rules for Data
+
+	// no validation rules for Done
+
 	if len(errors) > 0 {
-		return JobResult_MongoDBRestoreBackupMultiError(errors)
+		return JobProgress_LogsMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobResult_MongoDBRestoreBackupMultiError is an error wrapping multiple
-// validation errors returned by JobResult_MongoDBRestoreBackup.ValidateAll()
-// if the designated constraints aren't met.
-type JobResult_MongoDBRestoreBackupMultiError []error
+// JobProgress_LogsMultiError is an error wrapping multiple validation errors
+// returned by JobProgress_Logs.ValidateAll() if the designated constraints
+// aren't met.
+type JobProgress_LogsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobResult_MongoDBRestoreBackupMultiError) Error() string {
+func (m JobProgress_LogsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12765,12 +16014,11 @@ func (m JobResult_MongoDBRestoreBackupMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobResult_MongoDBRestoreBackupMultiError) AllErrors() []error { return m }
+func (m JobProgress_LogsMultiError) AllErrors() []error { return m }
 
-// JobResult_MongoDBRestoreBackupValidationError is the validation error
-// returned by JobResult_MongoDBRestoreBackup.Validate if the designated
-// constraints aren't met.
-type JobResult_MongoDBRestoreBackupValidationError struct {
+// JobProgress_LogsValidationError is the validation error returned by
+// JobProgress_Logs.Validate if the designated constraints aren't met.
+type JobProgress_LogsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12778,24 +16026,22 @@ type JobResult_MongoDBRestoreBackupValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobResult_MongoDBRestoreBackupValidationError) Field() string { return e.field }
+func (e JobProgress_LogsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobResult_MongoDBRestoreBackupValidationError) Reason() string { return e.reason }
+func (e JobProgress_LogsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobResult_MongoDBRestoreBackupValidationError) Cause() error { return e.cause }
+func (e JobProgress_LogsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobResult_MongoDBRestoreBackupValidationError) Key() bool { return e.key }
+func (e JobProgress_LogsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobResult_MongoDBRestoreBackupValidationError) ErrorName() string {
-	return "JobResult_MongoDBRestoreBackupValidationError"
-}
+func (e JobProgress_LogsValidationError) ErrorName() string { return "JobProgress_LogsValidationError" }
 
 // Error satisfies the builtin error interface
-func (e JobResult_MongoDBRestoreBackupValidationError) Error() string {
+func (e JobProgress_LogsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12807,7 +16053,7 @@ func (e JobResult_MongoDBRestoreBackupValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobResult_MongoDBRestoreBackup.%s: %s%s",
+		"invalid %sJobProgress_Logs.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -12815,7 +16061,7 @@ func (e JobResult_MongoDBRestoreBackupValidationError) Error() string {
 	)
 }
 
-var _ error = JobResult_MongoDBRestoreBackupValidationError{}
+var _ error = JobProgress_LogsValidationError{}
 
 var _ interface {
 	Field() string
@@ -12823,24 +16069,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobResult_MongoDBRestoreBackupValidationError{}
+} = JobProgress_LogsValidationError{}
 
-// Validate checks the field values on JobProgress_MySQLBackup with the rules
+// Validate checks the field values on GetVersionsRequest_MySQLd with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobProgress_MySQLBackup) Validate() error {
+func (m *GetVersionsRequest_MySQLd) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobProgress_MySQLBackup with the
+// ValidateAll checks the field values on GetVersionsRequest_MySQLd with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobProgress_MySQLBackupMultiError, or nil if none found.
-func (m *JobProgress_MySQLBackup) ValidateAll() error {
+// GetVersionsRequest_MySQLdMultiError, or nil if none found.
+func (m *GetVersionsRequest_MySQLd) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobProgress_MySQLBackup) validate(all bool) error {
+func (m *GetVersionsRequest_MySQLd) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12848,19 +16094,19 @@ func (m *JobProgress_MySQLBackup) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return JobProgress_MySQLBackupMultiError(errors)
+		return GetVersionsRequest_MySQLdMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobProgress_MySQLBackupMultiError is an error wrapping multiple validation
-// errors returned by JobProgress_MySQLBackup.ValidateAll() if the designated
-// constraints aren't met.
-type JobProgress_MySQLBackupMultiError []error
-
+// GetVersionsRequest_MySQLdMultiError is an error wrapping multiple validation
+// errors returned by GetVersionsRequest_MySQLd.ValidateAll() if the
+// designated constraints aren't met.
+type GetVersionsRequest_MySQLdMultiError []error
+
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobProgress_MySQLBackupMultiError) Error() string {
+func (m GetVersionsRequest_MySQLdMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12869,11 +16115,11 @@ func (m JobProgress_MySQLBackupMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobProgress_MySQLBackupMultiError) AllErrors() []error { return m }
+func (m GetVersionsRequest_MySQLdMultiError) AllErrors() []error { return m }
 
-// JobProgress_MySQLBackupValidationError is the validation error returned by
-// JobProgress_MySQLBackup.Validate if the designated constraints aren't met.
-type JobProgress_MySQLBackupValidationError struct {
+// GetVersionsRequest_MySQLdValidationError is the validation error returned by
+// GetVersionsRequest_MySQLd.Validate if the designated constraints aren't met.
+type GetVersionsRequest_MySQLdValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12881,24 +16127,24 @@ type JobProgress_MySQLBackupValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobProgress_MySQLBackupValidationError) Field() string { return e.field }
+func (e GetVersionsRequest_MySQLdValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobProgress_MySQLBackupValidationError) Reason() string { return e.reason }
+func (e GetVersionsRequest_MySQLdValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobProgress_MySQLBackupValidationError) Cause() error { return e.cause }
+func (e GetVersionsRequest_MySQLdValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobProgress_MySQLBackupValidationError) Key() bool { return e.key }
+func (e GetVersionsRequest_MySQLdValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobProgress_MySQLBackupValidationError) ErrorName() string {
-	return "JobProgress_MySQLBackupValidationError"
+func (e GetVersionsRequest_MySQLdValidationError) ErrorName() string {
+	return "GetVersionsRequest_MySQLdValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobProgress_MySQLBackupValidationError) Error() string {
+func (e GetVersionsRequest_MySQLdValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12910,7 +16156,7 @@ func (e JobProgress_MySQLBackupValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobProgress_MySQLBackup.%s: %s%s",
+		"invalid %sGetVersionsRequest_MySQLd.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -12918,7 +16164,7 @@ func (e JobProgress_MySQLBackupValidationError) Error() string {
 	)
 }
 
-var _ error = JobProgress_MySQLBackupValidationError{}
+var _ error = GetVersionsRequest_MySQLdValidationError{}
 
 var _ interface {
 	Field() string
@@ -12926,24 +16172,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobProgress_MySQLBackupValidationError{}
+} = GetVersionsRequest_MySQLdValidationError{}
 
-// Validate checks the field values on JobProgress_MySQLRestoreBackup with the
+// Validate checks the field values on GetVersionsRequest_Xtrabackup with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *JobProgress_MySQLRestoreBackup) Validate() error {
+func (m *GetVersionsRequest_Xtrabackup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobProgress_MySQLRestoreBackup with
+// ValidateAll checks the field values on GetVersionsRequest_Xtrabackup with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// JobProgress_MySQLRestoreBackupMultiError, or nil if none found.
-func (m *JobProgress_MySQLRestoreBackup) ValidateAll() error {
+// GetVersionsRequest_XtrabackupMultiError, or nil if none found.
+func (m *GetVersionsRequest_Xtrabackup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobProgress_MySQLRestoreBackup) validate(all bool) error {
+func (m *GetVersionsRequest_Xtrabackup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12951,19 +16197,19 @@ func (m *JobProgress_MySQLRestoreBackup) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return JobProgress_MySQLRestoreBackupMultiError(errors)
+		return GetVersionsRequest_XtrabackupMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobProgress_MySQLRestoreBackupMultiError is an error wrapping multiple
-// validation errors returned by JobProgress_MySQLRestoreBackup.ValidateAll()
+// GetVersionsRequest_XtrabackupMultiError is an error wrapping multiple
+// validation errors returned by GetVersionsRequest_Xtrabackup.ValidateAll()
 // if the designated constraints aren't met.
-type JobProgress_MySQLRestoreBackupMultiError []error
+type GetVersionsRequest_XtrabackupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobProgress_MySQLRestoreBackupMultiError) Error() string {
+func (m GetVersionsRequest_XtrabackupMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12972,12 +16218,12 @@ func (m JobProgress_MySQLRestoreBackupMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobProgress_MySQLRestoreBackupMultiError) AllErrors() []error { return m }
+func (m GetVersionsRequest_XtrabackupMultiError) AllErrors() []error { return m }
 
-// JobProgress_MySQLRestoreBackupValidationError is the validation error
-// returned by JobProgress_MySQLRestoreBackup.Validate if the designated
+// GetVersionsRequest_XtrabackupValidationError is the validation error
+// returned by GetVersionsRequest_Xtrabackup.Validate if the designated
 // constraints aren't met.
-type JobProgress_MySQLRestoreBackupValidationError struct {
+type GetVersionsRequest_XtrabackupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12985,24 +16231,24 @@ type JobProgress_MySQLRestoreBackupValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobProgress_MySQLRestoreBackupValidationError) Field() string { return e.field }
+func (e GetVersionsRequest_XtrabackupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobProgress_MySQLRestoreBackupValidationError) Reason() string { return e.reason }
+func (e GetVersionsRequest_XtrabackupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobProgress_MySQLRestoreBackupValidationError) Cause() error { return e.cause }
+func (e GetVersionsRequest_XtrabackupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobProgress_MySQLRestoreBackupValidationError) Key() bool { return e.key }
+func (e GetVersionsRequest_XtrabackupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobProgress_MySQLRestoreBackupValidationError) ErrorName() string {
-	return "JobProgress_MySQLRestoreBackupValidationError"
+func (e GetVersionsRequest_XtrabackupValidationError) ErrorName() string {
+	return "GetVersionsRequest_XtrabackupValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e JobProgress_MySQLRestoreBackupValidationError) Error() string {
+func (e GetVersionsRequest_XtrabackupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13014,7 +16260,7 @@ func (e JobProgress_MySQLRestoreBackupValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobProgress_MySQLRestoreBackup.%s: %s%s",
+		"invalid %sGetVersionsRequest_Xtrabackup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -13022,7 +16268,7 @@ func (e JobProgress_MySQLRestoreBackupValidationError) Error() string {
 	)
 }
 
-var _ error = JobProgress_MySQLRestoreBackupValidationError{}
+var _ error = GetVersionsRequest_XtrabackupValidationError{}
 
 var _ interface {
 	Field() string
@@ -13030,50 +16276,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobProgress_MySQLRestoreBackupValidationError{}
+} = GetVersionsRequest_XtrabackupValidationError{}
 
-// Validate checks the field values on JobProgress_Logs with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *JobProgress_Logs) Validate() error {
+// Validate checks the field values on GetVersionsRequest_Xbcloud with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetVersionsRequest_Xbcloud) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on JobProgress_Logs with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetVersionsRequest_Xbcloud with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// JobProgress_LogsMultiError, or nil if none found.
-func (m *JobProgress_Logs) ValidateAll() error {
+// GetVersionsRequest_XbcloudMultiError, or nil if none found.
+func (m *GetVersionsRequest_Xbcloud) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *JobProgress_Logs) validate(all bool) error {
+func (m *GetVersionsRequest_Xbcloud) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ChunkId
-
-	// no validation rules for Data
-
-	// no validation rules for Done
-
 	if len(errors) > 0 {
-		return JobProgress_LogsMultiError(errors)
+		return GetVersionsRequest_XbcloudMultiError(errors)
 	}
 
 	return nil
 }
 
-// JobProgress_LogsMultiError is an error wrapping multiple validation errors
-// returned by JobProgress_Logs.ValidateAll() if the designated constraints
-// aren't met.
-type JobProgress_LogsMultiError []error
+// GetVersionsRequest_XbcloudMultiError is an error wrapping multiple
+// validation errors returned by GetVersionsRequest_Xbcloud.ValidateAll() if
+// the designated constraints aren't met.
+type GetVersionsRequest_XbcloudMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m JobProgress_LogsMultiError) Error() string {
+func (m GetVersionsRequest_XbcloudMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13082,11 +16322,11 @@ func (m JobProgress_LogsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m JobProgress_LogsMultiError) AllErrors() []error { return m }
+func (m GetVersionsRequest_XbcloudMultiError) AllErrors() []error { return m }
 
-// JobProgress_LogsValidationError is the validation error returned by
-// JobProgress_Logs.Validate if the designated constraints aren't met.
-type JobProgress_LogsValidationError struct {
+// GetVersionsRequest_XbcloudValidationError is the validation error returned
+// by GetVersionsRequest_Xbcloud.Validate if the designated constraints aren't met.
+type GetVersionsRequest_XbcloudValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13094,22 +16334,24 @@ type JobProgress_LogsValidationError struct {
 }
 
 // Field function returns field value.
-func (e JobProgress_LogsValidationError) Field() string { return e.field }
+func (e GetVersionsRequest_XbcloudValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e JobProgress_LogsValidationError) Reason() string { return e.reason }
+func (e GetVersionsRequest_XbcloudValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e JobProgress_LogsValidationError) Cause() error { return e.cause }
+func (e GetVersionsRequest_XbcloudValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e JobProgress_LogsValidationError) Key() bool { return e.key }
+func (e GetVersionsRequest_XbcloudValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e JobProgress_LogsValidationError) ErrorName() string { return "JobProgress_LogsValidationError" }
+func (e GetVersionsRequest_XbcloudValidationError) ErrorName() string {
+	return "GetVersionsRequest_XbcloudValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e JobProgress_LogsValidationError) Error() string {
+func (e GetVersionsRequest_XbcloudValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13121,7 +16363,7 @@ func (e JobProgress_LogsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sJobProgress_Logs.%s: %s%s",
+		"invalid %sGetVersionsRequest_Xbcloud.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -13129,7 +16371,7 @@ func (e JobProgress_LogsValidationError) Error() string {
 	)
 }
 
-var _ error = JobProgress_LogsValidationError{}
+var _ error = GetVersionsRequest_XbcloudValidationError{}
 
 var _ interface {
 	Field() string
@@ -13137,24 +16379,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = JobProgress_LogsValidationError{}
+} = GetVersionsRequest_XbcloudValidationError{}
 
-// Validate checks the field values on GetVersionsRequest_MySQLd with the rules
+// Validate checks the field values on GetVersionsRequest_Qpress with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetVersionsRequest_MySQLd) Validate() error {
+func (m *GetVersionsRequest_Qpress) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetVersionsRequest_MySQLd with the
+// ValidateAll checks the field values on GetVersionsRequest_Qpress with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetVersionsRequest_MySQLdMultiError, or nil if none found.
-func (m *GetVersionsRequest_MySQLd) ValidateAll() error {
+// GetVersionsRequest_QpressMultiError, or nil if none found.
+func (m *GetVersionsRequest_Qpress) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetVersionsRequest_MySQLd) validate(all bool) error {
+func (m *GetVersionsRequest_Qpress) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13162,19 +16404,19 @@ func (m *GetVersionsRequest_MySQLd) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return GetVersionsRequest_MySQLdMultiError(errors)
+		return GetVersionsRequest_QpressMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetVersionsRequest_MySQLdMultiError is an error wrapping multiple validation
-// errors returned by GetVersionsRequest_MySQLd.ValidateAll() if the
+// GetVersionsRequest_QpressMultiError is an error wrapping multiple validation
+// errors returned by GetVersionsRequest_Qpress.ValidateAll() if the
 // designated constraints aren't met.
-type GetVersionsRequest_MySQLdMultiError []error
+type GetVersionsRequest_QpressMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetVersionsRequest_MySQLdMultiError) Error() string {
+func (m GetVersionsRequest_QpressMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13183,11 +16425,11 @@ func (m GetVersionsRequest_MySQLdMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetVersionsRequest_MySQLdMultiError) AllErrors() []error { return m }
+func (m GetVersionsRequest_QpressMultiError) AllErrors() []error { return m }
 
-// GetVersionsRequest_MySQLdValidationError is the validation error returned by
-// GetVersionsRequest_MySQLd.Validate if the designated constraints aren't met.
-type GetVersionsRequest_MySQLdValidationError struct {
+// GetVersionsRequest_QpressValidationError is the validation error returned by
+// GetVersionsRequest_Qpress.Validate if the designated constraints aren't met.
+type GetVersionsRequest_QpressValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13195,24 +16437,24 @@ type GetVersionsRequest_MySQLdValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetVersionsRequest_MySQLdValidationError) Field() string { return e.field }
+func (e GetVersionsRequest_QpressValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetVersionsRequest_MySQLdValidationError) Reason() string { return e.reason }
+func (e GetVersionsRequest_QpressValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetVersionsRequest_MySQLdValidationError) Cause() error { return e.cause }
+func (e GetVersionsRequest_QpressValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetVersionsRequest_MySQLdValidationError) Key() bool { return e.key }
+func (e GetVersionsRequest_QpressValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetVersionsRequest_MySQLdValidationError) ErrorName() string {
-	return "GetVersionsRequest_MySQLdValidationError"
+func (e GetVersionsRequest_QpressValidationError) ErrorName() string {
+	return "GetVersionsRequest_QpressValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetVersionsRequest_MySQLdValidationError) Error() string {
+func (e GetVersionsRequest_QpressValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13224,7 +16466,7 @@ func (e GetVersionsRequest_MySQLdValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetVersionsRequest_MySQLd.%s: %s%s",
+		"invalid %sGetVersionsRequest_Qpress.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -13232,7 +16474,7 @@ func (e GetVersionsRequest_MySQLdValidationError) Error() string {
 	)
 }
 
-var _ error = GetVersionsRequest_MySQLdValidationError{}
+var _ error = GetVersionsRequest_QpressValidationError{}
 
 var _ interface {
 	Field() string
@@ -13240,24 +16482,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetVersionsRequest_MySQLdValidationError{}
+} = GetVersionsRequest_QpressValidationError{}
 
-// Validate checks the field values on GetVersionsRequest_Xtrabackup with the
+// Validate checks the field values on GetVersionsRequest_MongoDB with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetVersionsRequest_Xtrabackup) Validate() error {
+func (m *GetVersionsRequest_MongoDB) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetVersionsRequest_Xtrabackup with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// GetVersionsRequest_XtrabackupMultiError, or nil if none found.
-func (m *GetVersionsRequest_Xtrabackup) ValidateAll() error {
+// ValidateAll checks the field values on GetVersionsRequest_MongoDB with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetVersionsRequest_MongoDBMultiError, or nil if none found.
+func (m *GetVersionsRequest_MongoDB) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetVersionsRequest_Xtrabackup) validate(all bool) error {
+func (m *GetVersionsRequest_MongoDB) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13265,19 +16507,19 @@ func (m *GetVersionsRequest_Xtrabackup) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return GetVersionsRequest_XtrabackupMultiError(errors)
+		return GetVersionsRequest_MongoDBMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetVersionsRequest_XtrabackupMultiError is an error wrapping multiple
-// validation errors returned by GetVersionsRequest_Xtrabackup.ValidateAll()
-// if the designated constraints aren't met.
-type GetVersionsRequest_XtrabackupMultiError []error
+// GetVersionsRequest_MongoDBMultiError is an error wrapping multiple
+// validation errors returned by GetVersionsRequest_MongoDB.ValidateAll() if
+// the designated constraints aren't met.
+type GetVersionsRequest_MongoDBMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetVersionsRequest_XtrabackupMultiError) Error() string {
+func (m GetVersionsRequest_MongoDBMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13286,12 +16528,11 @@ func (m GetVersionsRequest_XtrabackupMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetVersionsRequest_XtrabackupMultiError) AllErrors() []error { return m }
+func (m GetVersionsRequest_MongoDBMultiError) AllErrors() []error { return m }
 
-// GetVersionsRequest_XtrabackupValidationError is the validation error
-// returned by GetVersionsRequest_Xtrabackup.Validate if the designated
-// constraints aren't met.
-type GetVersionsRequest_XtrabackupValidationError struct {
+// GetVersionsRequest_MongoDBValidationError is the validation error returned
+// by GetVersionsRequest_MongoDB.Validate if the designated constraints aren't met.
+type GetVersionsRequest_MongoDBValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13299,24 +16540,24 @@ type GetVersionsRequest_XtrabackupValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetVersionsRequest_XtrabackupValidationError) Field() string { return e.field }
+func (e GetVersionsRequest_MongoDBValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetVersionsRequest_XtrabackupValidationError) Reason() string { return e.reason }
+func (e GetVersionsRequest_MongoDBValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetVersionsRequest_XtrabackupValidationError) Cause() error { return e.cause }
+func (e GetVersionsRequest_MongoDBValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetVersionsRequest_XtrabackupValidationError) Key() bool { return e.key }
+func (e GetVersionsRequest_MongoDBValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetVersionsRequest_XtrabackupValidationError) ErrorName() string {
-	return "GetVersionsRequest_XtrabackupValidationError"
+func (e GetVersionsRequest_MongoDBValidationError) ErrorName() string {
+	return "GetVersionsRequest_MongoDBValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetVersionsRequest_XtrabackupValidationError) Error() string {
+func (e GetVersionsRequest_MongoDBValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13328,7 +16569,7 @@ func (e GetVersionsRequest_XtrabackupValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetVersionsRequest_Xtrabackup.%s: %s%s",
+		"invalid %sGetVersionsRequest_MongoDB.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -13336,7 +16577,7 @@ func (e GetVersionsRequest_XtrabackupValidationError) Error() string {
 	)
 }
 
-var _ error = GetVersionsRequest_XtrabackupValidationError{}
+var _ error = GetVersionsRequest_MongoDBValidationError{}
 
 var _ interface {
 	Field() string
@@ -13344,24 +16585,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetVersionsRequest_XtrabackupValidationError{}
+} = GetVersionsRequest_MongoDBValidationError{}
 
-// Validate checks the field values on GetVersionsRequest_Xbcloud with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetVersionsRequest_PBM with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetVersionsRequest_Xbcloud) Validate() error {
+func (m *GetVersionsRequest_PBM) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetVersionsRequest_Xbcloud with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetVersionsRequest_PBM with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetVersionsRequest_XbcloudMultiError, or nil if none found.
-func (m *GetVersionsRequest_Xbcloud) ValidateAll() error {
+// GetVersionsRequest_PBMMultiError, or nil if none found.
+func (m *GetVersionsRequest_PBM) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetVersionsRequest_Xbcloud) validate(all bool) error {
+func (m *GetVersionsRequest_PBM) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13369,19 +16610,19 @@ func (m *GetVersionsRequest_Xbcloud) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return GetVersionsRequest_XbcloudMultiError(errors)
+		return GetVersionsRequest_PBMMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetVersionsRequest_XbcloudMultiError is an error wrapping multiple
-// validation errors returned by GetVersionsRequest_Xbcloud.ValidateAll() if
-// the designated constraints aren't met.
-type GetVersionsRequest_XbcloudMultiError []error
+// GetVersionsRequest_PBMMultiError is an error wrapping multiple validation
+// errors returned by GetVersionsRequest_PBM.ValidateAll() if the designated
+// constraints aren't met.
+type GetVersionsRequest_PBMMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetVersionsRequest_XbcloudMultiError) Error() string {
+func (m GetVersionsRequest_PBMMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13390,11 +16631,11 @@ func (m GetVersionsRequest_XbcloudMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetVersionsRequest_XbcloudMultiError) AllErrors() []error { return m }
+func (m GetVersionsRequest_PBMMultiError) AllErrors() []error { return m }
 
-// GetVersionsRequest_XbcloudValidationError is the validation error returned
-// by GetVersionsRequest_Xbcloud.Validate if the designated constraints aren't met.
-type GetVersionsRequest_XbcloudValidationError struct {
+// GetVersionsRequest_PBMValidationError is the validation error returned by
+// GetVersionsRequest_PBM.Validate if the designated constraints aren't met.
+type GetVersionsRequest_PBMValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13402,24 +16643,24 @@ type GetVersionsRequest_XbcloudValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetVersionsRequest_XbcloudValidationError) Field() string { return e.field }
+func (e GetVersionsRequest_PBMValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetVersionsRequest_XbcloudValidationError) Reason() string { return e.reason }
+func (e GetVersionsRequest_PBMValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetVersionsRequest_XbcloudValidationError) Cause() error { return e.cause }
+func (e GetVersionsRequest_PBMValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetVersionsRequest_XbcloudValidationError) Key() bool { return e.key }
+func (e GetVersionsRequest_PBMValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetVersionsRequest_XbcloudValidationError) ErrorName() string {
-	return "GetVersionsRequest_XbcloudValidationError"
+func (e GetVersionsRequest_PBMValidationError) ErrorName() string {
+	return "GetVersionsRequest_PBMValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetVersionsRequest_XbcloudValidationError) Error() string {
+func (e GetVersionsRequest_PBMValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13431,7 +16672,7 @@ func (e GetVersionsRequest_XbcloudValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetVersionsRequest_Xbcloud.%s: %s%s",
+		"invalid %sGetVersionsRequest_PBM.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -13439,7 +16680,7 @@ func (e GetVersionsRequest_XbcloudValidationError) Error() string {
 	)
 }
 
-var _ error = GetVersionsRequest_XbcloudValidationError{}
+var _ error = GetVersionsRequest_PBMValidationError{}
 
 var _ interface {
 	Field() string
@@ -13447,24 +16688,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetVersionsRequest_XbcloudValidationError{}
+} = GetVersionsRequest_PBMValidationError{}
 
-// Validate checks the field values on GetVersionsRequest_Qpress with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetVersionsRequest_PGBasebackup with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetVersionsRequest_Qpress) Validate() error {
+func (m *GetVersionsRequest_PGBasebackup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetVersionsRequest_Qpress with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetVersionsRequest_QpressMultiError, or nil if none found.
-func (m *GetVersionsRequest_Qpress) ValidateAll() error {
+// ValidateAll checks the field values on GetVersionsRequest_PGBasebackup with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetVersionsRequest_PGBasebackupMultiError, or nil if none found.
+func (m *GetVersionsRequest_PGBasebackup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetVersionsRequest_Qpress) validate(all bool) error {
+func (m *GetVersionsRequest_PGBasebackup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13472,19 +16713,19 @@ func (m *GetVersionsRequest_Qpress) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return GetVersionsRequest_QpressMultiError(errors)
+		return GetVersionsRequest_PGBasebackupMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetVersionsRequest_QpressMultiError is an error wrapping multiple validation
-// errors returned by GetVersionsRequest_Qpress.ValidateAll() if the
-// designated constraints aren't met.
-type GetVersionsRequest_QpressMultiError []error
+// GetVersionsRequest_PGBasebackupMultiError is an error wrapping multiple
+// validation errors returned by GetVersionsRequest_PGBasebackup.ValidateAll()
+// if the designated constraints aren't met.
+type GetVersionsRequest_PGBasebackupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetVersionsRequest_QpressMultiError) Error() string {
+func (m GetVersionsRequest_PGBasebackupMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13493,11 +16734,12 @@ func (m GetVersionsRequest_QpressMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetVersionsRequest_QpressMultiError) AllErrors() []error { return m }
+func (m GetVersionsRequest_PGBasebackupMultiError) AllErrors() []error { return m }
 
-// GetVersionsRequest_QpressValidationError is the validation error returned by
-// GetVersionsRequest_Qpress.Validate if the designated constraints aren't met.
-type GetVersionsRequest_QpressValidationError struct {
+// GetVersionsRequest_PGBasebackupValidationError is the validation error
+// returned by GetVersionsRequest_PGBasebackup.Validate if the designated
+// constraints aren't met.
+type GetVersionsRequest_PGBasebackupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13505,24 +16747,24 @@ type GetVersionsRequest_QpressValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetVersionsRequest_QpressValidationError) Field() string { return e.field }
+func (e GetVersionsRequest_PGBasebackupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetVersionsRequest_QpressValidationError) Reason() string { return e.reason }
+func (e GetVersionsRequest_PGBasebackupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetVersionsRequest_QpressValidationError) Cause() error { return e.cause }
+func (e GetVersionsRequest_PGBasebackupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetVersionsRequest_QpressValidationError) Key() bool { return e.key }
+func (e GetVersionsRequest_PGBasebackupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetVersionsRequest_QpressValidationError) ErrorName() string {
-	return "GetVersionsRequest_QpressValidationError"
+func (e GetVersionsRequest_PGBasebackupValidationError) ErrorName() string {
+	return "GetVersionsRequest_PGBasebackupValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetVersionsRequest_QpressValidationError) Error() string {
+func (e GetVersionsRequest_PGBasebackupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13534,7 +16776,7 @@ func (e GetVersionsRequest_QpressValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetVersionsRequest_Qpress.%s: %s%s",
+		"invalid %sGetVersionsRequest_PGBasebackup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -13542,7 +16784,7 @@ func (e GetVersionsRequest_QpressValidationError) Error() string {
 	)
 }
 
-var _ error = GetVersionsRequest_QpressValidationError{}
+var _ error = GetVersionsRequest_PGBasebackupValidationError{}
 
 var _ interface {
 	Field() string
@@ -13550,24 +16792,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetVersionsRequest_QpressValidationError{}
+} = GetVersionsRequest_PGBasebackupValidationError{}
 
-// Validate checks the field values on GetVersionsRequest_MongoDB with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetVersionsRequest_PGDump with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetVersionsRequest_MongoDB) Validate() error {
+func (m *GetVersionsRequest_PGDump) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetVersionsRequest_MongoDB with the
+// ValidateAll checks the field values on GetVersionsRequest_PGDump with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetVersionsRequest_MongoDBMultiError, or nil if none found.
-func (m *GetVersionsRequest_MongoDB) ValidateAll() error {
+// GetVersionsRequest_PGDumpMultiError, or nil if none found.
+func (m *GetVersionsRequest_PGDump) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetVersionsRequest_MongoDB) validate(all bool) error {
+func (m *GetVersionsRequest_PGDump) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13575,19 +16817,19 @@ func (m *GetVersionsRequest_MongoDB) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return GetVersionsRequest_MongoDBMultiError(errors)
+		return GetVersionsRequest_PGDumpMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetVersionsRequest_MongoDBMultiError is an error wrapping multiple
-// validation errors returned by GetVersionsRequest_MongoDB.ValidateAll() if
-// the designated constraints aren't met.
-type GetVersionsRequest_MongoDBMultiError []error
+// GetVersionsRequest_PGDumpMultiError is an error wrapping multiple validation
+// errors returned by GetVersionsRequest_PGDump.ValidateAll() if the
+// designated constraints aren't met.
+type GetVersionsRequest_PGDumpMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetVersionsRequest_MongoDBMultiError) Error() string {
+func (m GetVersionsRequest_PGDumpMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13596,11 +16838,11 @@ func (m GetVersionsRequest_MongoDBMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetVersionsRequest_MongoDBMultiError) AllErrors() []error { return m }
+func (m GetVersionsRequest_PGDumpMultiError) AllErrors() []error { return m }
 
-// GetVersionsRequest_MongoDBValidationError is the validation error returned
-// by GetVersionsRequest_MongoDB.Validate if the designated constraints aren't met.
-type GetVersionsRequest_MongoDBValidationError struct {
+// GetVersionsRequest_PGDumpValidationError is the validation error returned by
+// GetVersionsRequest_PGDump.Validate if the designated constraints aren't met.
+type GetVersionsRequest_PGDumpValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13608,24 +16850,24 @@ type GetVersionsRequest_MongoDBValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetVersionsRequest_MongoDBValidationError) Field() string { return e.field }
+func (e GetVersionsRequest_PGDumpValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetVersionsRequest_MongoDBValidationError) Reason() string { return e.reason }
+func (e GetVersionsRequest_PGDumpValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetVersionsRequest_MongoDBValidationError) Cause() error { return e.cause }
+func (e GetVersionsRequest_PGDumpValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetVersionsRequest_MongoDBValidationError) Key() bool { return e.key }
+func (e GetVersionsRequest_PGDumpValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetVersionsRequest_MongoDBValidationError) ErrorName() string {
-	return "GetVersionsRequest_MongoDBValidationError"
+func (e GetVersionsRequest_PGDumpValidationError) ErrorName() string {
+	return "GetVersionsRequest_PGDumpValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetVersionsRequest_MongoDBValidationError) Error() string {
+func (e GetVersionsRequest_PGDumpValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13637,7 +16879,7 @@ func (e GetVersionsRequest_MongoDBValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetVersionsRequest_MongoDB.%s: %s%s",
+		"invalid %sGetVersionsRequest_PGDump.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -13645,7 +16887,7 @@ func (e GetVersionsRequest_MongoDBValidationError) Error() string {
 	)
 }
 
-var _ error = GetVersionsRequest_MongoDBValidationError{}
+var _ error = GetVersionsRequest_PGDumpValidationError{}
 
 var _ interface {
 	Field() string
@@ -13653,24 +16895,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetVersionsRequest_MongoDBValidationError{}
+} = GetVersionsRequest_PGDumpValidationError{}
 
-// Validate checks the field values on GetVersionsRequest_PBM with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on GetVersionsRequest_PGRestore with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetVersionsRequest_PBM) Validate() error {
+func (m *GetVersionsRequest_PGRestore) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetVersionsRequest_PBM with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on GetVersionsRequest_PGRestore with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetVersionsRequest_PBMMultiError, or nil if none found.
-func (m *GetVersionsRequest_PBM) ValidateAll() error {
+// GetVersionsRequest_PGRestoreMultiError, or nil if none found.
+func (m *GetVersionsRequest_PGRestore) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetVersionsRequest_PBM) validate(all bool) error {
+func (m *GetVersionsRequest_PGRestore) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13678,19 +16920,19 @@ func (m *GetVersionsRequest_PBM) validate(all bool) error {
 	var errors []error
 
 	if len(errors) > 0 {
-		return GetVersionsRequest_PBMMultiError(errors)
+		return GetVersionsRequest_PGRestoreMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetVersionsRequest_PBMMultiError is an error wrapping multiple validation
-// errors returned by GetVersionsRequest_PBM.ValidateAll() if the designated
-// constraints aren't met.
-type GetVersionsRequest_PBMMultiError []error
+// GetVersionsRequest_PGRestoreMultiError is an error wrapping multiple
+// validation errors returned by GetVersionsRequest_PGRestore.ValidateAll() if
+// the designated constraints aren't met.
+type GetVersionsRequest_PGRestoreMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetVersionsRequest_PBMMultiError) Error() string {
+func (m GetVersionsRequest_PGRestoreMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13699,11 +16941,12 @@ func (m GetVersionsRequest_PBMMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetVersionsRequest_PBMMultiError) AllErrors() []error { return m }
+func (m GetVersionsRequest_PGRestoreMultiError) AllErrors() []error { return m }
 
-// GetVersionsRequest_PBMValidationError is the validation error returned by
-// GetVersionsRequest_PBM.Validate if the designated constraints aren't met.
-type GetVersionsRequest_PBMValidationError struct {
+// GetVersionsRequest_PGRestoreValidationError is the validation error returned
+// by GetVersionsRequest_PGRestore.Validate if the designated constraints
+// aren't met.
+type GetVersionsRequest_PGRestoreValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13711,24 +16954,24 @@ type GetVersionsRequest_PBMValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetVersionsRequest_PBMValidationError) Field() string { return e.field }
+func (e GetVersionsRequest_PGRestoreValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetVersionsRequest_PBMValidationError) Reason() string { return e.reason }
+func (e GetVersionsRequest_PGRestoreValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetVersionsRequest_PBMValidationError) Cause() error { return e.cause }
+func (e GetVersionsRequest_PGRestoreValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetVersionsRequest_PBMValidationError) Key() bool { return e.key }
+func (e GetVersionsRequest_PGRestoreValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetVersionsRequest_PBMValidationError) ErrorName() string {
-	return "GetVersionsRequest_PBMValidationError"
+func (e GetVersionsRequest_PGRestoreValidationError) ErrorName() string {
+	return "GetVersionsRequest_PGRestoreValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetVersionsRequest_PBMValidationError) Error() string {
+func (e GetVersionsRequest_PGRestoreValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13740,7 +16983,7 @@ func (e GetVersionsRequest_PBMValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sGetVersionsRequest_PBM.%s: %s%s",
+		"invalid %sGetVersionsRequest_PGRestore.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -13748,7 +16991,7 @@ func (e GetVersionsRequest_PBMValidationError) Error() string {
 	)
 }
 
-var _ error = GetVersionsRequest_PBMValidationError{}
+var _ error = GetVersionsRequest_PGRestoreValidationError{}
 
 var _ interface {
 	Field() string
@@ -13756,7 +16999,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetVersionsRequest_PBMValidationError{}
+} = GetVersionsRequest_PGRestoreValidationError{}
 
 // Validate checks the field values on GetVersionsRequest_Software with the
 // rules defined in the proto definition for this message. If any rules are
@@ -14027,6 +17270,129 @@ func (m *GetVersionsRequest_Software) validate(all bool) error {
 			}
 		}
 
+	case *GetVersionsRequest_Software_PgBasebackup:
+		if v == nil {
+			err := GetVersionsRequest_SoftwareValidationError{
+				field:  "Software",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPgBasebackup()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetVersionsRequest_SoftwareValidationError{
+						field:  "PgBasebackup",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetVersionsRequest_SoftwareValidationError{
+						field:  "PgBasebackup",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPgBasebackup()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetVersionsRequest_SoftwareValidationError{
+					field:  "PgBasebackup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GetVersionsRequest_Software_PgDump:
+		if v == nil {
+			err := GetVersionsRequest_SoftwareValidationError{
+				field:  "Software",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPgDump()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetVersionsRequest_SoftwareValidationError{
+						field:  "PgDump",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetVersionsRequest_SoftwareValidationError{
+						field:  "PgDump",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPgDump()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetVersionsRequest_SoftwareValidationError{
+					field:  "PgDump",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GetVersionsRequest_Software_PgRestore:
+		if v == nil {
+			err := GetVersionsRequest_SoftwareValidationError{
+				field:  "Software",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPgRestore()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetVersionsRequest_SoftwareValidationError{
+						field:  "PgRestore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetVersionsRequest_SoftwareValidationError{
+						field:  "PgRestore",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPgRestore()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetVersionsRequest_SoftwareValidationError{
+					field:  "PgRestore",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	default:
 		_ = v // ensures v is used
 	}
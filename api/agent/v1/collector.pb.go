@@ -89,6 +89,7 @@ type MetricsBucket struct {
 	Mysql         *MetricsBucket_MySQL      `protobuf:"bytes,2,opt,name=mysql,proto3" json:"mysql,omitempty"`
 	Mongodb       *MetricsBucket_MongoDB    `protobuf:"bytes,3,opt,name=mongodb,proto3" json:"mongodb,omitempty"`
 	Postgresql    *MetricsBucket_PostgreSQL `protobuf:"bytes,4,opt,name=postgresql,proto3" json:"postgresql,omitempty"`
+	Valkey        *MetricsBucket_Valkey     `protobuf:"bytes,5,opt,name=valkey,proto3" json:"valkey,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -151,6 +152,13 @@ func (x *MetricsBucket) GetPostgresql() *MetricsBucket_PostgreSQL {
 	return nil
 }
 
+func (x *MetricsBucket) GetValkey() *MetricsBucket_Valkey {
+	if x != nil {
+		return x.Valkey
+	}
+	return nil
+}
+
 // HistogramItem represents one item in histogram.
 type HistogramItem struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -2498,18 +2506,157 @@ func (x *MetricsBucket_PostgreSQL) GetHistogramItems() []*HistogramItem {
 	return nil
 }
 
+// Valkey contains metrics collected from SLOWLOG and INFO COMMANDSTATS.
+type MetricsBucket_Valkey struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	MArgsCnt float32                `protobuf:"fixed32,1,opt,name=m_args_cnt,json=mArgsCnt,proto3" json:"m_args_cnt,omitempty"`
+	// The number of command arguments.
+	MArgsSum       float32 `protobuf:"fixed32,2,opt,name=m_args_sum,json=mArgsSum,proto3" json:"m_args_sum,omitempty"`
+	MArgsMin       float32 `protobuf:"fixed32,3,opt,name=m_args_min,json=mArgsMin,proto3" json:"m_args_min,omitempty"`
+	MArgsMax       float32 `protobuf:"fixed32,4,opt,name=m_args_max,json=mArgsMax,proto3" json:"m_args_max,omitempty"`
+	MTotalCallsCnt float32 `protobuf:"fixed32,5,opt,name=m_total_calls_cnt,json=mTotalCallsCnt,proto3" json:"m_total_calls_cnt,omitempty"`
+	// The number of command calls, including calls not recorded in the slow log.
+	MTotalCallsSum float32 `protobuf:"fixed32,6,opt,name=m_total_calls_sum,json=mTotalCallsSum,proto3" json:"m_total_calls_sum,omitempty"`
+	MTotalTimeCnt  float32 `protobuf:"fixed32,7,opt,name=m_total_time_cnt,json=mTotalTimeCnt,proto3" json:"m_total_time_cnt,omitempty"`
+	// The execution time of command calls in seconds, including calls not recorded in the slow log.
+	MTotalTimeSum     float32 `protobuf:"fixed32,8,opt,name=m_total_time_sum,json=mTotalTimeSum,proto3" json:"m_total_time_sum,omitempty"`
+	MRejectedCallsCnt float32 `protobuf:"fixed32,9,opt,name=m_rejected_calls_cnt,json=mRejectedCallsCnt,proto3" json:"m_rejected_calls_cnt,omitempty"`
+	// The number of command calls rejected before execution.
+	MRejectedCallsSum float32 `protobuf:"fixed32,10,opt,name=m_rejected_calls_sum,json=mRejectedCallsSum,proto3" json:"m_rejected_calls_sum,omitempty"`
+	MFailedCallsCnt   float32 `protobuf:"fixed32,11,opt,name=m_failed_calls_cnt,json=mFailedCallsCnt,proto3" json:"m_failed_calls_cnt,omitempty"`
+	// The number of command calls failed during execution.
+	MFailedCallsSum float32 `protobuf:"fixed32,12,opt,name=m_failed_calls_sum,json=mFailedCallsSum,proto3" json:"m_failed_calls_sum,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MetricsBucket_Valkey) Reset() {
+	*x = MetricsBucket_Valkey{}
+	mi := &file_agent_v1_collector_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricsBucket_Valkey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricsBucket_Valkey) ProtoMessage() {}
+
+func (x *MetricsBucket_Valkey) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_v1_collector_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricsBucket_Valkey.ProtoReflect.Descriptor instead.
+func (*MetricsBucket_Valkey) Descriptor() ([]byte, []int) {
+	return file_agent_v1_collector_proto_rawDescGZIP(), []int{0, 4}
+}
+
+func (x *MetricsBucket_Valkey) GetMArgsCnt() float32 {
+	if x != nil {
+		return x.MArgsCnt
+	}
+	return 0
+}
+
+func (x *MetricsBucket_Valkey) GetMArgsSum() float32 {
+	if x != nil {
+		return x.MArgsSum
+	}
+	return 0
+}
+
+func (x *MetricsBucket_Valkey) GetMArgsMin() float32 {
+	if x != nil {
+		return x.MArgsMin
+	}
+	return 0
+}
+
+func (x *MetricsBucket_Valkey) GetMArgsMax() float32 {
+	if x != nil {
+		return x.MArgsMax
+	}
+	return 0
+}
+
+func (x *MetricsBucket_Valkey) GetMTotalCallsCnt() float32 {
+	if x != nil {
+		return x.MTotalCallsCnt
+	}
+	return 0
+}
+
+func (x *MetricsBucket_Valkey) GetMTotalCallsSum() float32 {
+	if x != nil {
+		return x.MTotalCallsSum
+	}
+	return 0
+}
+
+func (x *MetricsBucket_Valkey) GetMTotalTimeCnt() float32 {
+	if x != nil {
+		return x.MTotalTimeCnt
+	}
+	return 0
+}
+
+func (x *MetricsBucket_Valkey) GetMTotalTimeSum() float32 {
+	if x != nil {
+		return x.MTotalTimeSum
+	}
+	return 0
+}
+
+func (x *MetricsBucket_Valkey) GetMRejectedCallsCnt() float32 {
+	if x != nil {
+		return x.MRejectedCallsCnt
+	}
+	return 0
+}
+
+func (x *MetricsBucket_Valkey) GetMRejectedCallsSum() float32 {
+	if x != nil {
+		return x.MRejectedCallsSum
+	}
+	return 0
+}
+
+func (x *MetricsBucket_Valkey) GetMFailedCallsCnt() float32 {
+	if x != nil {
+		return x.MFailedCallsCnt
+	}
+	return 0
+}
+
+func (x *MetricsBucket_Valkey) GetMFailedCallsSum() float32 {
+	if x != nil {
+		return x.MFailedCallsSum
+	}
+	return 0
+}
+
 var File_agent_v1_collector_proto protoreflect.FileDescriptor
 
 const file_agent_v1_collector_proto_rawDesc = "" +
 	"\n" +
-	"\x18agent/v1/collector.proto\x12\bagent.v1\x1a\x1aextensions/v1/redact.proto\x1a\x19inventory/v1/agents.proto\"\xb1h\n" +
+	"\x18agent/v1/collector.proto\x12\bagent.v1\x1a\x1aextensions/v1/redact.proto\x1a\x19inventory/v1/agents.proto\"\xd0l\n" +
 	"\rMetricsBucket\x126\n" +
 	"\x06common\x18\x01 \x01(\v2\x1e.agent.v1.MetricsBucket.CommonR\x06common\x123\n" +
 	"\x05mysql\x18\x02 \x01(\v2\x1d.agent.v1.MetricsBucket.MySQLR\x05mysql\x129\n" +
 	"\amongodb\x18\x03 \x01(\v2\x1f.agent.v1.MetricsBucket.MongoDBR\amongodb\x12B\n" +
 	"\n" +
 	"postgresql\x18\x04 \x01(\v2\".agent.v1.MetricsBucket.PostgreSQLR\n" +
-	"postgresql\x1a\xdc\t\n" +
+	"postgresql\x126\n" +
+	"\x06valkey\x18\x05 \x01(\v2\x1e.agent.v1.MetricsBucket.ValkeyR\x06valkey\x1a\xdc\t\n" +
 	"\x06Common\x12\x18\n" +
 	"\aqueryid\x18\x01 \x01(\tR\aqueryid\x12/\n" +
 	"\x13explain_fingerprint\x18\x19 \x01(\tR\x12explainFingerprint\x12-\n" +
@@ -2787,7 +2934,25 @@ const file_agent_v1_collector_proto_rawDesc = "" +
 	"\x06planid\x18. \x01(\tR\x06planid\x12\x1d\n" +
 	"\n" +
 	"query_plan\x180 \x01(\tR\tqueryPlan\x12@\n" +
-	"\x0fhistogram_items\x181 \x03(\v2\x17.agent.v1.HistogramItemR\x0ehistogramItems\"C\n" +
+	"\x0fhistogram_items\x181 \x03(\v2\x17.agent.v1.HistogramItemR\x0ehistogramItems\x1a\xe4\x03\n" +
+	"\x06Valkey\x12\x1c\n" +
+	"\n" +
+	"m_args_cnt\x18\x01 \x01(\x02R\bmArgsCnt\x12\x1c\n" +
+	"\n" +
+	"m_args_sum\x18\x02 \x01(\x02R\bmArgsSum\x12\x1c\n" +
+	"\n" +
+	"m_args_min\x18\x03 \x01(\x02R\bmArgsMin\x12\x1c\n" +
+	"\n" +
+	"m_args_max\x18\x04 \x01(\x02R\bmArgsMax\x12)\n" +
+	"\x11m_total_calls_cnt\x18\x05 \x01(\x02R\x0emTotalCallsCnt\x12)\n" +
+	"\x11m_total_calls_sum\x18\x06 \x01(\x02R\x0emTotalCallsSum\x12'\n" +
+	"\x10m_total_time_cnt\x18\a \x01(\x02R\rmTotalTimeCnt\x12'\n" +
+	"\x10m_total_time_sum\x18\b \x01(\x02R\rmTotalTimeSum\x12/\n" +
+	"\x14m_rejected_calls_cnt\x18\t \x01(\x02R\x11mRejectedCallsCnt\x12/\n" +
+	"\x14m_rejected_calls_sum\x18\n" +
+	" \x01(\x02R\x11mRejectedCallsSum\x12+\n" +
+	"\x12m_failed_calls_cnt\x18\v \x01(\x02R\x0fmFailedCallsCnt\x12+\n" +
+	"\x12m_failed_calls_sum\x18\f \x01(\x02R\x0fmFailedCallsSum\"C\n" +
 	"\rHistogramItem\x12\x14\n" +
 	"\x05range\x18\x01 \x01(\tR\x05range\x12\x1c\n" +
 	"\tfrequency\x18\x02 \x01(\rR\tfrequency*\x95\x01\n" +
@@ -2813,7 +2978,7 @@ func file_agent_v1_collector_proto_rawDescGZIP() []byte {
 
 var (
 	file_agent_v1_collector_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-	file_agent_v1_collector_proto_msgTypes  = make([]protoimpl.MessageInfo, 8)
+	file_agent_v1_collector_proto_msgTypes  = make([]protoimpl.MessageInfo, 9)
 	file_agent_v1_collector_proto_goTypes   = []any{
 		ExampleType(0),                   // 0: agent.v1.ExampleType
 		(*MetricsBucket)(nil),            // 1: agent.v1.MetricsBucket
@@ -2822,27 +2987,29 @@ var (
 		(*MetricsBucket_MySQL)(nil),      // 4: agent.v1.MetricsBucket.MySQL
 		(*MetricsBucket_MongoDB)(nil),    // 5: agent.v1.MetricsBucket.MongoDB
 		(*MetricsBucket_PostgreSQL)(nil), // 6: agent.v1.MetricsBucket.PostgreSQL
-		nil,                              // 7: agent.v1.MetricsBucket.Common.CommentsEntry
-		nil,                              // 8: agent.v1.MetricsBucket.Common.ErrorsEntry
-		v1.AgentType(0),                  // 9: inventory.v1.AgentType
+		(*MetricsBucket_Valkey)(nil),     // 7: agent.v1.MetricsBucket.Valkey
+		nil,                              // 8: agent.v1.MetricsBucket.Common.CommentsEntry
+		nil,                              // 9: agent.v1.MetricsBucket.Common.ErrorsEntry
+		v1.AgentType(0),                  // 10: inventory.v1.AgentType
 	}
 )
 
 var file_agent_v1_collector_proto_depIdxs = []int32{
-	3, // 0: agent.v1.MetricsBucket.common:type_name -> agent.v1.MetricsBucket.Common
-	4, // 1: agent.v1.MetricsBucket.mysql:type_name -> agent.v1.MetricsBucket.MySQL
-	5, // 2: agent.v1.MetricsBucket.mongodb:type_name -> agent.v1.MetricsBucket.MongoDB
-	6, // 3: agent.v1.MetricsBucket.postgresql:type_name -> agent.v1.MetricsBucket.PostgreSQL
-	7, // 4: agent.v1.MetricsBucket.Common.comments:type_name -> agent.v1.MetricsBucket.Common.CommentsEntry
-	9, // 5: agent.v1.MetricsBucket.Common.agent_type:type_name -> inventory.v1.AgentType
-	0, // 6: agent.v1.MetricsBucket.Common.example_type:type_name -> agent.v1.ExampleType
-	8, // 7: agent.v1.MetricsBucket.Common.errors:type_name -> agent.v1.MetricsBucket.Common.ErrorsEntry
-	2, // 8: agent.v1.MetricsBucket.PostgreSQL.histogram_items:type_name -> agent.v1.HistogramItem
-	9, // [9:9] is the sub-list for method output_type
-	9, // [9:9] is the sub-list for method input_type
-	9, // [9:9] is the sub-list for extension type_name
-	9, // [9:9] is the sub-list for extension extendee
-	0, // [0:9] is the sub-list for field type_name
+	3,  // 0: agent.v1.MetricsBucket.common:type_name -> agent.v1.MetricsBucket.Common
+	4,  // 1: agent.v1.MetricsBucket.mysql:type_name -> agent.v1.MetricsBucket.MySQL
+	5,  // 2: agent.v1.MetricsBucket.mongodb:type_name -> agent.v1.MetricsBucket.MongoDB
+	6,  // 3: agent.v1.MetricsBucket.postgresql:type_name -> agent.v1.MetricsBucket.PostgreSQL
+	7,  // 4: agent.v1.MetricsBucket.valkey:type_name -> agent.v1.MetricsBucket.Valkey
+	8,  // 5: agent.v1.MetricsBucket.Common.comments:type_name -> agent.v1.MetricsBucket.Common.CommentsEntry
+	10, // 6: agent.v1.MetricsBucket.Common.agent_type:type_name -> inventory.v1.AgentType
+	0,  // 7: agent.v1.MetricsBucket.Common.example_type:type_name -> agent.v1.ExampleType
+	9,  // 8: agent.v1.MetricsBucket.Common.errors:type_name -> agent.v1.MetricsBucket.Common.ErrorsEntry
+	2,  // 9: agent.v1.MetricsBucket.PostgreSQL.histogram_items:type_name -> agent.v1.HistogramItem
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_agent_v1_collector_proto_init() }
@@ -2856,7 +3023,7 @@ func file_agent_v1_collector_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agent_v1_collector_proto_rawDesc), len(file_agent_v1_collector_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   8,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -177,6 +177,35 @@ func (m *MetricsBucket) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetValkey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricsBucketValidationError{
+					field:  "Valkey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricsBucketValidationError{
+					field:  "Valkey",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValkey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricsBucketValidationError{
+				field:  "Valkey",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return MetricsBucketMultiError(errors)
 	}
@@ -1310,3 +1339,130 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = MetricsBucket_PostgreSQLValidationError{}
+
+// Validate checks the field values on MetricsBucket_Valkey with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *MetricsBucket_Valkey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricsBucket_Valkey with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MetricsBucket_ValkeyMultiError, or nil if none found.
+func (m *MetricsBucket_Valkey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricsBucket_Valkey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for MArgsCnt
+
+	// no validation rules for MArgsSum
+
+	// no validation rules for MArgsMin
+
+	// no validation rules for MArgsMax
+
+	// no validation rules for MTotalCallsCnt
+
+	// no validation rules for MTotalCallsSum
+
+	// no validation rules for MTotalTimeCnt
+
+	// no validation rules for MTotalTimeSum
+
+	// no validation rules for MRejectedCallsCnt
+
+	// no validation rules for MRejectedCallsSum
+
+	// no validation rules for MFailedCallsCnt
+
+	// no validation rules for MFailedCallsSum
+
+	if len(errors) > 0 {
+		return MetricsBucket_ValkeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricsBucket_ValkeyMultiError is an error wrapping multiple validation
+// errors returned by MetricsBucket_Valkey.ValidateAll() if the designated
+// constraints aren't met.
+type MetricsBucket_ValkeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricsBucket_ValkeyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricsBucket_ValkeyMultiError) AllErrors() []error { return m }
+
+// MetricsBucket_ValkeyValidationError is the validation error returned by
+// MetricsBucket_Valkey.Validate if the designated constraints aren't met.
+type MetricsBucket_ValkeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricsBucket_ValkeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricsBucket_ValkeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricsBucket_ValkeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricsBucket_ValkeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricsBucket_ValkeyValidationError) ErrorName() string {
+	return "MetricsBucket_ValkeyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MetricsBucket_ValkeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricsBucket_Valkey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = MetricsBucket_ValkeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricsBucket_ValkeyValidationError{}
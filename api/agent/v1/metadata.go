@@ -33,6 +33,9 @@ const (
 	mdAgentNodeID      = "pmm-agent-node-id"
 	mdNodeName         = "pmm-node-name"
 	mdServerVersion    = "pmm-server-version"
+
+	// mdClientCertificate is set by nginx to the URL-encoded PEM client certificate of TLS connection.
+	mdClientCertificate = "x-client-certificate"
 )
 
 // AgentConnectMetadata represents metadata sent by pmm-agent with Connect RPC method call.
@@ -40,6 +43,10 @@ type AgentConnectMetadata struct {
 	ID          string
 	Version     string
 	MetricsPort uint16
+
+	// ClientCertificate is the URL-encoded PEM client certificate presented by pmm-agent, if any.
+	// It is received from nginx and never sent by pmm-agent itself.
+	ClientCertificate string
 }
 
 // ServerConnectMetadata represents metadata sent by pmm-managed in response to Connect RPC method call.
@@ -91,6 +98,8 @@ func ReceiveAgentConnectMetadata(stream grpc.ServerStream) (*AgentConnectMetadat
 		ID:          agentID,
 		Version:     getValue(md, mdAgentVersion),
 		MetricsPort: uint16(mp), //nolint:gosec // port is uint16
+
+		ClientCertificate: getValue(md, mdClientCertificate),
 	}, nil
 }
 
@@ -196,6 +196,70 @@ func (x *AgentInfo) GetProcessExecPath() string {
 	return ""
 }
 
+// QANSpoolInfo contains information about QAN data that was not sent to PMM Server yet.
+type QANSpoolInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Number of QAN data batches waiting to be sent.
+	Depth uint32 `protobuf:"varint,1,opt,name=depth,proto3" json:"depth,omitempty"`
+	// Total size of QAN data waiting to be sent in bytes.
+	SizeBytes uint64 `protobuf:"varint,2,opt,name=size_bytes,json=sizeBytes,proto3" json:"size_bytes,omitempty"`
+	// Number of QAN data batches dropped because of spool limits or errors since pmm-agent start.
+	Dropped       uint64 `protobuf:"varint,3,opt,name=dropped,proto3" json:"dropped,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QANSpoolInfo) Reset() {
+	*x = QANSpoolInfo{}
+	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QANSpoolInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QANSpoolInfo) ProtoMessage() {}
+
+func (x *QANSpoolInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QANSpoolInfo.ProtoReflect.Descriptor instead.
+func (*QANSpoolInfo) Descriptor() ([]byte, []int) {
+	return file_agentlocal_v1_agentlocal_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QANSpoolInfo) GetDepth() uint32 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *QANSpoolInfo) GetSizeBytes() uint64 {
+	if x != nil {
+		return x.SizeBytes
+	}
+	return 0
+}
+
+func (x *QANSpoolInfo) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
 type StatusRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Returns network info (latency and clock_drift) if true.
@@ -206,7 +270,7 @@ type StatusRequest struct {
 
 func (x *StatusRequest) Reset() {
 	*x = StatusRequest{}
-	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[2]
+	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -218,7 +282,7 @@ func (x *StatusRequest) String() string {
 func (*StatusRequest) ProtoMessage() {}
 
 func (x *StatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[2]
+	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -231,7 +295,7 @@ func (x *StatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return file_agentlocal_v1_agentlocal_proto_rawDescGZIP(), []int{2}
+	return file_agentlocal_v1_agentlocal_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *StatusRequest) GetGetNetworkInfo() bool {
@@ -254,13 +318,15 @@ type StatusResponse struct {
 	AgentVersion string `protobuf:"bytes,7,opt,name=agent_version,json=agentVersion,proto3" json:"agent_version,omitempty"`
 	// Shows connection uptime in percentage between agent and server
 	ConnectionUptime float32 `protobuf:"fixed32,8,opt,name=connection_uptime,json=connectionUptime,proto3" json:"connection_uptime,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// QAN data spool state (if spool is enabled).
+	QanSpool      *QANSpoolInfo `protobuf:"bytes,9,opt,name=qan_spool,json=qanSpool,proto3" json:"qan_spool,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *StatusResponse) Reset() {
 	*x = StatusResponse{}
-	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[3]
+	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -272,7 +338,7 @@ func (x *StatusResponse) String() string {
 func (*StatusResponse) ProtoMessage() {}
 
 func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[3]
+	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -285,7 +351,7 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_agentlocal_v1_agentlocal_proto_rawDescGZIP(), []int{3}
+	return file_agentlocal_v1_agentlocal_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *StatusResponse) GetAgentId() string {
@@ -344,6 +410,13 @@ func (x *StatusResponse) GetConnectionUptime() float32 {
 	return 0
 }
 
+func (x *StatusResponse) GetQanSpool() *QANSpoolInfo {
+	if x != nil {
+		return x.QanSpool
+	}
+	return nil
+}
+
 type ReloadRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -352,7 +425,7 @@ type ReloadRequest struct {
 
 func (x *ReloadRequest) Reset() {
 	*x = ReloadRequest{}
-	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[4]
+	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -364,7 +437,7 @@ func (x *ReloadRequest) String() string {
 func (*ReloadRequest) ProtoMessage() {}
 
 func (x *ReloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[4]
+	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -377,7 +450,7 @@ func (x *ReloadRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReloadRequest.ProtoReflect.Descriptor instead.
 func (*ReloadRequest) Descriptor() ([]byte, []int) {
-	return file_agentlocal_v1_agentlocal_proto_rawDescGZIP(), []int{4}
+	return file_agentlocal_v1_agentlocal_proto_rawDescGZIP(), []int{5}
 }
 
 // ReloadRequest may not be received by the client due to pmm-agent restart.
@@ -389,7 +462,7 @@ type ReloadResponse struct {
 
 func (x *ReloadResponse) Reset() {
 	*x = ReloadResponse{}
-	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[5]
+	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -401,7 +474,7 @@ func (x *ReloadResponse) String() string {
 func (*ReloadResponse) ProtoMessage() {}
 
 func (x *ReloadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[5]
+	mi := &file_agentlocal_v1_agentlocal_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -414,7 +487,7 @@ func (x *ReloadResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReloadResponse.ProtoReflect.Descriptor instead.
 func (*ReloadResponse) Descriptor() ([]byte, []int) {
-	return file_agentlocal_v1_agentlocal_proto_rawDescGZIP(), []int{5}
+	return file_agentlocal_v1_agentlocal_proto_rawDescGZIP(), []int{6}
 }
 
 var File_agentlocal_v1_agentlocal_proto protoreflect.FileDescriptor
@@ -438,9 +511,14 @@ const file_agentlocal_v1_agentlocal_proto_rawDesc = "" +
 	"\x06status\x18\x03 \x01(\x0e2\x19.inventory.v1.AgentStatusR\x06status\x12\x1f\n" +
 	"\vlisten_port\x18\x04 \x01(\rR\n" +
 	"listenPort\x12*\n" +
-	"\x11process_exec_path\x18\x05 \x01(\tR\x0fprocessExecPath\"9\n" +
+	"\x11process_exec_path\x18\x05 \x01(\tR\x0fprocessExecPath\"]\n" +
+	"\fQANSpoolInfo\x12\x14\n" +
+	"\x05depth\x18\x01 \x01(\rR\x05depth\x12\x1d\n" +
+	"\n" +
+	"size_bytes\x18\x02 \x01(\x04R\tsizeBytes\x12\x18\n" +
+	"\adropped\x18\x03 \x01(\x04R\adropped\"9\n" +
 	"\rStatusRequest\x12(\n" +
-	"\x10get_network_info\x18\x01 \x01(\bR\x0egetNetworkInfo\"\xe1\x02\n" +
+	"\x10get_network_info\x18\x01 \x01(\bR\x0egetNetworkInfo\"\x9b\x03\n" +
 	"\x0eStatusResponse\x12\x19\n" +
 	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12%\n" +
 	"\x0fruns_on_node_id\x18\x02 \x01(\tR\frunsOnNodeId\x12\x1b\n" +
@@ -451,7 +529,8 @@ const file_agentlocal_v1_agentlocal_proto_rawDesc = "" +
 	"agentsInfo\x12'\n" +
 	"\x0fconfig_filepath\x18\x06 \x01(\tR\x0econfigFilepath\x12#\n" +
 	"\ragent_version\x18\a \x01(\tR\fagentVersion\x12+\n" +
-	"\x11connection_uptime\x18\b \x01(\x02R\x10connectionUptime\"\x0f\n" +
+	"\x11connection_uptime\x18\b \x01(\x02R\x10connectionUptime\x128\n" +
+	"\tqan_spool\x18\t \x01(\v2\x1b.agentlocal.v1.QANSpoolInfoR\bqanSpool\"\x0f\n" +
 	"\rReloadRequest\"\x10\n" +
 	"\x0eReloadResponse2\xe6\x01\n" +
 	"\x11AgentLocalService\x12p\n" +
@@ -472,36 +551,38 @@ func file_agentlocal_v1_agentlocal_proto_rawDescGZIP() []byte {
 }
 
 var (
-	file_agentlocal_v1_agentlocal_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+	file_agentlocal_v1_agentlocal_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
 	file_agentlocal_v1_agentlocal_proto_goTypes  = []any{
 		(*ServerInfo)(nil),          // 0: agentlocal.v1.ServerInfo
 		(*AgentInfo)(nil),           // 1: agentlocal.v1.AgentInfo
-		(*StatusRequest)(nil),       // 2: agentlocal.v1.StatusRequest
-		(*StatusResponse)(nil),      // 3: agentlocal.v1.StatusResponse
-		(*ReloadRequest)(nil),       // 4: agentlocal.v1.ReloadRequest
-		(*ReloadResponse)(nil),      // 5: agentlocal.v1.ReloadResponse
-		(*durationpb.Duration)(nil), // 6: google.protobuf.Duration
-		v1.AgentType(0),             // 7: inventory.v1.AgentType
-		v1.AgentStatus(0),           // 8: inventory.v1.AgentStatus
+		(*QANSpoolInfo)(nil),        // 2: agentlocal.v1.QANSpoolInfo
+		(*StatusRequest)(nil),       // 3: agentlocal.v1.StatusRequest
+		(*StatusResponse)(nil),      // 4: agentlocal.v1.StatusResponse
+		(*ReloadRequest)(nil),       // 5: agentlocal.v1.ReloadRequest
+		(*ReloadResponse)(nil),      // 6: agentlocal.v1.ReloadResponse
+		(*durationpb.Duration)(nil), // 7: google.protobuf.Duration
+		v1.AgentType(0),             // 8: inventory.v1.AgentType
+		v1.AgentStatus(0),           // 9: inventory.v1.AgentStatus
 	}
 )
 
 var file_agentlocal_v1_agentlocal_proto_depIdxs = []int32{
-	6, // 0: agentlocal.v1.ServerInfo.latency:type_name -> google.protobuf.Duration
-	6, // 1: agentlocal.v1.ServerInfo.clock_drift:type_name -> google.protobuf.Duration
-	7, // 2: agentlocal.v1.AgentInfo.agent_type:type_name -> inventory.v1.AgentType
-	8, // 3: agentlocal.v1.AgentInfo.status:type_name -> inventory.v1.AgentStatus
+	7, // 0: agentlocal.v1.ServerInfo.latency:type_name -> google.protobuf.Duration
+	7, // 1: agentlocal.v1.ServerInfo.clock_drift:type_name -> google.protobuf.Duration
+	8, // 2: agentlocal.v1.AgentInfo.agent_type:type_name -> inventory.v1.AgentType
+	9, // 3: agentlocal.v1.AgentInfo.status:type_name -> inventory.v1.AgentStatus
 	0, // 4: agentlocal.v1.StatusResponse.server_info:type_name -> agentlocal.v1.ServerInfo
 	1, // 5: agentlocal.v1.StatusResponse.agents_info:type_name -> agentlocal.v1.AgentInfo
-	2, // 6: agentlocal.v1.AgentLocalService.Status:input_type -> agentlocal.v1.StatusRequest
-	4, // 7: agentlocal.v1.AgentLocalService.Reload:input_type -> agentlocal.v1.ReloadRequest
-	3, // 8: agentlocal.v1.AgentLocalService.Status:output_type -> agentlocal.v1.StatusResponse
-	5, // 9: agentlocal.v1.AgentLocalService.Reload:output_type -> agentlocal.v1.ReloadResponse
-	8, // [8:10] is the sub-list for method output_type
-	6, // [6:8] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	2, // 6: agentlocal.v1.StatusResponse.qan_spool:type_name -> agentlocal.v1.QANSpoolInfo
+	3, // 7: agentlocal.v1.AgentLocalService.Status:input_type -> agentlocal.v1.StatusRequest
+	5, // 8: agentlocal.v1.AgentLocalService.Reload:input_type -> agentlocal.v1.ReloadRequest
+	4, // 9: agentlocal.v1.AgentLocalService.Status:output_type -> agentlocal.v1.StatusResponse
+	6, // 10: agentlocal.v1.AgentLocalService.Reload:output_type -> agentlocal.v1.ReloadResponse
+	9, // [9:11] is the sub-list for method output_type
+	7, // [7:9] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_agentlocal_v1_agentlocal_proto_init() }
@@ -515,7 +596,7 @@ func file_agentlocal_v1_agentlocal_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agentlocal_v1_agentlocal_proto_rawDesc), len(file_agentlocal_v1_agentlocal_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   7,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -315,6 +315,112 @@ var _ interface {
 	ErrorName() string
 } = AgentInfoValidationError{}
 
+// Validate checks the field values on QANSpoolInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QANSpoolInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QANSpoolInfo with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QANSpoolInfoMultiError, or
+// nil if none found.
+func (m *QANSpoolInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QANSpoolInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Depth
+
+	// no validation rules for SizeBytes
+
+	// no validation rules for Dropped
+
+	if len(errors) > 0 {
+		return QANSpoolInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// QANSpoolInfoMultiError is an error wrapping multiple validation errors
+// returned by QANSpoolInfo.ValidateAll() if the designated constraints aren't met.
+type QANSpoolInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QANSpoolInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QANSpoolInfoMultiError) AllErrors() []error { return m }
+
+// QANSpoolInfoValidationError is the validation error returned by
+// QANSpoolInfo.Validate if the designated constraints aren't met.
+type QANSpoolInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QANSpoolInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QANSpoolInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QANSpoolInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QANSpoolInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QANSpoolInfoValidationError) ErrorName() string { return "QANSpoolInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QANSpoolInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQANSpoolInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = QANSpoolInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QANSpoolInfoValidationError{}
+
 // Validate checks the field values on StatusRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -515,6 +621,35 @@ func (m *StatusResponse) validate(all bool) error {
 
 	// no validation rules for ConnectionUptime
 
+	if all {
+		switch v := interface{}(m.GetQanSpool()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StatusResponseValidationError{
+					field:  "QanSpool",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StatusResponseValidationError{
+					field:  "QanSpool",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQanSpool()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StatusResponseValidationError{
+				field:  "QanSpool",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return StatusResponseMultiError(errors)
 	}
@@ -439,6 +439,9 @@ type Status2OKBody struct {
 	// Shows connection uptime in percentage between agent and server
 	ConnectionUptime float32 `json:"connection_uptime,omitempty"`
 
+	// qan spool
+	QANSpool *Status2OKBodyQANSpool `json:"qan_spool,omitempty"`
+
 	// server info
 	ServerInfo *Status2OKBodyServerInfo `json:"server_info,omitempty"`
 }
@@ -451,6 +454,10 @@ func (o *Status2OKBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateQANSpool(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateServerInfo(formats); err != nil {
 		res = append(res, err)
 	}
@@ -491,6 +498,29 @@ func (o *Status2OKBody) validateAgentsInfo(formats strfmt.Registry) error {
 	return nil
 }
 
+func (o *Status2OKBody) validateQANSpool(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANSpool) { // not required
+		return nil
+	}
+
+	if o.QANSpool != nil {
+		if err := o.QANSpool.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("status2Ok" + "." + "qan_spool")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("status2Ok" + "." + "qan_spool")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *Status2OKBody) validateServerInfo(formats strfmt.Registry) error {
 	if swag.IsZero(o.ServerInfo) { // not required
 		return nil
@@ -522,6 +552,10 @@ func (o *Status2OKBody) ContextValidate(ctx context.Context, formats strfmt.Regi
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateQANSpool(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateServerInfo(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -558,6 +592,30 @@ func (o *Status2OKBody) contextValidateAgentsInfo(ctx context.Context, formats s
 	return nil
 }
 
+func (o *Status2OKBody) contextValidateQANSpool(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANSpool != nil {
+
+		if swag.IsZero(o.QANSpool) { // not required
+			return nil
+		}
+
+		if err := o.QANSpool.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("status2Ok" + "." + "qan_spool")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("status2Ok" + "." + "qan_spool")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *Status2OKBody) contextValidateServerInfo(ctx context.Context, formats strfmt.Registry) error {
 	if o.ServerInfo != nil {
 
@@ -829,6 +887,49 @@ func (o *Status2OKBodyAgentsInfoItems0) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
+/*
+Status2OKBodyQANSpool QANSpoolInfo contains information about QAN data that was not sent to PMM Server yet.
+swagger:model Status2OKBodyQANSpool
+*/
+type Status2OKBodyQANSpool struct {
+	// Number of QAN data batches waiting to be sent.
+	Depth int64 `json:"depth,omitempty"`
+
+	// Total size of QAN data waiting to be sent in bytes.
+	SizeBytes string `json:"size_bytes,omitempty"`
+
+	// Number of QAN data batches dropped because of spool limits or errors since pmm-agent start.
+	Dropped string `json:"dropped,omitempty"`
+}
+
+// Validate validates this status2 OK body QAN spool
+func (o *Status2OKBodyQANSpool) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this status2 OK body QAN spool based on context it is used
+func (o *Status2OKBodyQANSpool) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *Status2OKBodyQANSpool) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *Status2OKBodyQANSpool) UnmarshalBinary(b []byte) error {
+	var res Status2OKBodyQANSpool
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
 /*
 Status2OKBodyServerInfo ServerInfo contains information about the PMM Server.
 swagger:model Status2OKBodyServerInfo
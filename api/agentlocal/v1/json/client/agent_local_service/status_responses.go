@@ -476,6 +476,9 @@ type StatusOKBody struct {
 	// Shows connection uptime in percentage between agent and server
 	ConnectionUptime float32 `json:"connection_uptime,omitempty"`
 
+	// qan spool
+	QANSpool *StatusOKBodyQANSpool `json:"qan_spool,omitempty"`
+
 	// server info
 	ServerInfo *StatusOKBodyServerInfo `json:"server_info,omitempty"`
 }
@@ -488,6 +491,10 @@ func (o *StatusOKBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateQANSpool(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateServerInfo(formats); err != nil {
 		res = append(res, err)
 	}
@@ -528,6 +535,29 @@ func (o *StatusOKBody) validateAgentsInfo(formats strfmt.Registry) error {
 	return nil
 }
 
+func (o *StatusOKBody) validateQANSpool(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANSpool) { // not required
+		return nil
+	}
+
+	if o.QANSpool != nil {
+		if err := o.QANSpool.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("statusOk" + "." + "qan_spool")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("statusOk" + "." + "qan_spool")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *StatusOKBody) validateServerInfo(formats strfmt.Registry) error {
 	if swag.IsZero(o.ServerInfo) { // not required
 		return nil
@@ -559,6 +589,10 @@ func (o *StatusOKBody) ContextValidate(ctx context.Context, formats strfmt.Regis
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateQANSpool(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateServerInfo(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -595,6 +629,30 @@ func (o *StatusOKBody) contextValidateAgentsInfo(ctx context.Context, formats st
 	return nil
 }
 
+func (o *StatusOKBody) contextValidateQANSpool(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANSpool != nil {
+
+		if swag.IsZero(o.QANSpool) { // not required
+			return nil
+		}
+
+		if err := o.QANSpool.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("statusOk" + "." + "qan_spool")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("statusOk" + "." + "qan_spool")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *StatusOKBody) contextValidateServerInfo(ctx context.Context, formats strfmt.Registry) error {
 	if o.ServerInfo != nil {
 
@@ -866,6 +924,49 @@ func (o *StatusOKBodyAgentsInfoItems0) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
+/*
+StatusOKBodyQANSpool QANSpoolInfo contains information about QAN data that was not sent to PMM Server yet.
+swagger:model StatusOKBodyQANSpool
+*/
+type StatusOKBodyQANSpool struct {
+	// Number of QAN data batches waiting to be sent.
+	Depth int64 `json:"depth,omitempty"`
+
+	// Total size of QAN data waiting to be sent in bytes.
+	SizeBytes string `json:"size_bytes,omitempty"`
+
+	// Number of QAN data batches dropped because of spool limits or errors since pmm-agent start.
+	Dropped string `json:"dropped,omitempty"`
+}
+
+// Validate validates this status OK body QAN spool
+func (o *StatusOKBodyQANSpool) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this status OK body QAN spool based on context it is used
+func (o *StatusOKBodyQANSpool) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *StatusOKBodyQANSpool) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *StatusOKBodyQANSpool) UnmarshalBinary(b []byte) error {
+	var res StatusOKBodyQANSpool
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
 /*
 StatusOKBodyServerInfo ServerInfo contains information about the PMM Server.
 swagger:model StatusOKBodyServerInfo
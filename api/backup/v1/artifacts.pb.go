@@ -92,6 +92,59 @@ func (BackupStatus) EnumDescriptor() ([]byte, []int) {
 	return file_backup_v1_artifacts_proto_rawDescGZIP(), []int{0}
 }
 
+// VerificationStatus shows the result of the latest artifact verification.
+type VerificationStatus int32
+
+const (
+	VerificationStatus_VERIFICATION_STATUS_UNSPECIFIED VerificationStatus = 0
+	VerificationStatus_VERIFICATION_STATUS_PENDING     VerificationStatus = 1
+	VerificationStatus_VERIFICATION_STATUS_VERIFIED    VerificationStatus = 2
+	VerificationStatus_VERIFICATION_STATUS_FAILED      VerificationStatus = 3
+)
+
+// Enum value maps for VerificationStatus.
+var (
+	VerificationStatus_name = map[int32]string{
+		0: "VERIFICATION_STATUS_UNSPECIFIED",
+		1: "VERIFICATION_STATUS_PENDING",
+		2: "VERIFICATION_STATUS_VERIFIED",
+		3: "VERIFICATION_STATUS_FAILED",
+	}
+	VerificationStatus_value = map[string]int32{
+		"VERIFICATION_STATUS_UNSPECIFIED": 0,
+		"VERIFICATION_STATUS_PENDING":     1,
+		"VERIFICATION_STATUS_VERIFIED":    2,
+		"VERIFICATION_STATUS_FAILED":      3,
+	}
+)
+
+func (x VerificationStatus) Enum() *VerificationStatus {
+	p := new(VerificationStatus)
+	*p = x
+	return p
+}
+
+func (x VerificationStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (VerificationStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_backup_v1_artifacts_proto_enumTypes[1].Descriptor()
+}
+
+func (VerificationStatus) Type() protoreflect.EnumType {
+	return &file_backup_v1_artifacts_proto_enumTypes[1]
+}
+
+func (x VerificationStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use VerificationStatus.Descriptor instead.
+func (VerificationStatus) EnumDescriptor() ([]byte, []int) {
+	return file_backup_v1_artifacts_proto_rawDescGZIP(), []int{1}
+}
+
 // Artifact represents single backup artifact.
 type Artifact struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -122,9 +175,13 @@ type Artifact struct {
 	// Folder to store artifact on a storage.
 	Folder string `protobuf:"bytes,13,opt,name=folder,proto3" json:"folder,omitempty"`
 	// List of artifact metadata.
-	MetadataList  []*Metadata `protobuf:"bytes,14,rep,name=metadata_list,json=metadataList,proto3" json:"metadata_list,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	MetadataList []*Metadata `protobuf:"bytes,14,rep,name=metadata_list,json=metadataList,proto3" json:"metadata_list,omitempty"`
+	// Result of the latest artifact verification, unspecified if artifact has never been verified.
+	VerificationStatus VerificationStatus `protobuf:"varint,15,opt,name=verification_status,json=verificationStatus,proto3,enum=backup.v1.VerificationStatus" json:"verification_status,omitempty"`
+	// Time when the latest artifact verification has finished.
+	LastVerifiedAt *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=last_verified_at,json=lastVerifiedAt,proto3" json:"last_verified_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *Artifact) Reset() {
@@ -255,6 +312,20 @@ func (x *Artifact) GetMetadataList() []*Metadata {
 	return nil
 }
 
+func (x *Artifact) GetVerificationStatus() VerificationStatus {
+	if x != nil {
+		return x.VerificationStatus
+	}
+	return VerificationStatus_VERIFICATION_STATUS_UNSPECIFIED
+}
+
+func (x *Artifact) GetLastVerifiedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastVerifiedAt
+	}
+	return nil
+}
+
 type ListArtifactsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -425,6 +496,87 @@ func (*DeleteArtifactResponse) Descriptor() ([]byte, []int) {
 	return file_backup_v1_artifacts_proto_rawDescGZIP(), []int{4}
 }
 
+type VerifyArtifactRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Machine-readable artifact ID.
+	ArtifactId    string `protobuf:"bytes,1,opt,name=artifact_id,json=artifactId,proto3" json:"artifact_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyArtifactRequest) Reset() {
+	*x = VerifyArtifactRequest{}
+	mi := &file_backup_v1_artifacts_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyArtifactRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyArtifactRequest) ProtoMessage() {}
+
+func (x *VerifyArtifactRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_artifacts_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyArtifactRequest.ProtoReflect.Descriptor instead.
+func (*VerifyArtifactRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_artifacts_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VerifyArtifactRequest) GetArtifactId() string {
+	if x != nil {
+		return x.ArtifactId
+	}
+	return ""
+}
+
+type VerifyArtifactResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyArtifactResponse) Reset() {
+	*x = VerifyArtifactResponse{}
+	mi := &file_backup_v1_artifacts_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyArtifactResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyArtifactResponse) ProtoMessage() {}
+
+func (x *VerifyArtifactResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_artifacts_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyArtifactResponse.ProtoReflect.Descriptor instead.
+func (*VerifyArtifactResponse) Descriptor() ([]byte, []int) {
+	return file_backup_v1_artifacts_proto_rawDescGZIP(), []int{6}
+}
+
 type PitrTimerange struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// start_timestamp is the time of the first event in the PITR chunk.
@@ -437,7 +589,7 @@ type PitrTimerange struct {
 
 func (x *PitrTimerange) Reset() {
 	*x = PitrTimerange{}
-	mi := &file_backup_v1_artifacts_proto_msgTypes[5]
+	mi := &file_backup_v1_artifacts_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -449,7 +601,7 @@ func (x *PitrTimerange) String() string {
 func (*PitrTimerange) ProtoMessage() {}
 
 func (x *PitrTimerange) ProtoReflect() protoreflect.Message {
-	mi := &file_backup_v1_artifacts_proto_msgTypes[5]
+	mi := &file_backup_v1_artifacts_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,7 +614,7 @@ func (x *PitrTimerange) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PitrTimerange.ProtoReflect.Descriptor instead.
 func (*PitrTimerange) Descriptor() ([]byte, []int) {
-	return file_backup_v1_artifacts_proto_rawDescGZIP(), []int{5}
+	return file_backup_v1_artifacts_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *PitrTimerange) GetStartTimestamp() *timestamppb.Timestamp {
@@ -489,7 +641,7 @@ type ListPitrTimerangesRequest struct {
 
 func (x *ListPitrTimerangesRequest) Reset() {
 	*x = ListPitrTimerangesRequest{}
-	mi := &file_backup_v1_artifacts_proto_msgTypes[6]
+	mi := &file_backup_v1_artifacts_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -501,7 +653,7 @@ func (x *ListPitrTimerangesRequest) String() string {
 func (*ListPitrTimerangesRequest) ProtoMessage() {}
 
 func (x *ListPitrTimerangesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_backup_v1_artifacts_proto_msgTypes[6]
+	mi := &file_backup_v1_artifacts_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -514,7 +666,7 @@ func (x *ListPitrTimerangesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPitrTimerangesRequest.ProtoReflect.Descriptor instead.
 func (*ListPitrTimerangesRequest) Descriptor() ([]byte, []int) {
-	return file_backup_v1_artifacts_proto_rawDescGZIP(), []int{6}
+	return file_backup_v1_artifacts_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListPitrTimerangesRequest) GetArtifactId() string {
@@ -533,7 +685,7 @@ type ListPitrTimerangesResponse struct {
 
 func (x *ListPitrTimerangesResponse) Reset() {
 	*x = ListPitrTimerangesResponse{}
-	mi := &file_backup_v1_artifacts_proto_msgTypes[7]
+	mi := &file_backup_v1_artifacts_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -545,7 +697,7 @@ func (x *ListPitrTimerangesResponse) String() string {
 func (*ListPitrTimerangesResponse) ProtoMessage() {}
 
 func (x *ListPitrTimerangesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_backup_v1_artifacts_proto_msgTypes[7]
+	mi := &file_backup_v1_artifacts_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -558,7 +710,7 @@ func (x *ListPitrTimerangesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListPitrTimerangesResponse.ProtoReflect.Descriptor instead.
 func (*ListPitrTimerangesResponse) Descriptor() ([]byte, []int) {
-	return file_backup_v1_artifacts_proto_rawDescGZIP(), []int{7}
+	return file_backup_v1_artifacts_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListPitrTimerangesResponse) GetTimeranges() []*PitrTimerange {
@@ -572,7 +724,7 @@ var File_backup_v1_artifacts_proto protoreflect.FileDescriptor
 
 const file_backup_v1_artifacts_proto_rawDesc = "" +
 	"\n" +
-	"\x19backup/v1/artifacts.proto\x12\tbackup.v1\x1a\x16backup/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xab\x04\n" +
+	"\x19backup/v1/artifacts.proto\x12\tbackup.v1\x1a\x16backup/v1/common.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\xc1\x05\n" +
 	"\bArtifact\x12\x1f\n" +
 	"\vartifact_id\x18\x01 \x01(\tR\n" +
 	"artifactId\x12\x12\n" +
@@ -593,7 +745,9 @@ const file_backup_v1_artifacts_proto_rawDesc = "" +
 	"\x04mode\x18\v \x01(\x0e2\x15.backup.v1.BackupModeR\x04mode\x12,\n" +
 	"\x12is_sharded_cluster\x18\f \x01(\bR\x10isShardedCluster\x12\x16\n" +
 	"\x06folder\x18\r \x01(\tR\x06folder\x128\n" +
-	"\rmetadata_list\x18\x0e \x03(\v2\x13.backup.v1.MetadataR\fmetadataList\"\x16\n" +
+	"\rmetadata_list\x18\x0e \x03(\v2\x13.backup.v1.MetadataR\fmetadataList\x12N\n" +
+	"\x13verification_status\x18\x0f \x01(\x0e2\x1d.backup.v1.VerificationStatusR\x12verificationStatus\x12D\n" +
+	"\x10last_verified_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\x0elastVerifiedAt\"\x16\n" +
 	"\x14ListArtifactsRequest\"J\n" +
 	"\x15ListArtifactsResponse\x121\n" +
 	"\tartifacts\x18\x01 \x03(\v2\x13.backup.v1.ArtifactR\tartifacts\"d\n" +
@@ -601,7 +755,11 @@ const file_backup_v1_artifacts_proto_rawDesc = "" +
 	"\vartifact_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
 	"artifactId\x12!\n" +
 	"\fremove_files\x18\x02 \x01(\bR\vremoveFiles\"\x18\n" +
-	"\x16DeleteArtifactResponse\"\x95\x01\n" +
+	"\x16DeleteArtifactResponse\"A\n" +
+	"\x15VerifyArtifactRequest\x12(\n" +
+	"\vartifact_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"artifactId\"\x18\n" +
+	"\x16VerifyArtifactResponse\"\x95\x01\n" +
 	"\rPitrTimerange\x12C\n" +
 	"\x0fstart_timestamp\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x0estartTimestamp\x12?\n" +
 	"\rend_timestamp\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\fendTimestamp\"E\n" +
@@ -621,7 +779,12 @@ const file_backup_v1_artifacts_proto_rawDesc = "" +
 	"\x13BACKUP_STATUS_ERROR\x10\x05\x12\x1a\n" +
 	"\x16BACKUP_STATUS_DELETING\x10\x06\x12\"\n" +
 	"\x1eBACKUP_STATUS_FAILED_TO_DELETE\x10\a\x12%\n" +
-	"!BACKUP_STATUS_CLEANUP_IN_PROGRESS\x10\bB\x93\x01\n" +
+	"!BACKUP_STATUS_CLEANUP_IN_PROGRESS\x10\b*\x9c\x01\n" +
+	"\x12VerificationStatus\x12#\n" +
+	"\x1fVERIFICATION_STATUS_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bVERIFICATION_STATUS_PENDING\x10\x01\x12 \n" +
+	"\x1cVERIFICATION_STATUS_VERIFIED\x10\x02\x12\x1e\n" +
+	"\x1aVERIFICATION_STATUS_FAILED\x10\x03B\x93\x01\n" +
 	"\rcom.backup.v1B\x0eArtifactsProtoP\x01Z-github.com/percona/pmm/api/backup/v1;backupv1\xa2\x02\x03BXX\xaa\x02\tBackup.V1\xca\x02\tBackup\\V1\xe2\x02\x15Backup\\V1\\GPBMetadata\xea\x02\n" +
 	"Backup::V1b\x06proto3"
 
@@ -638,40 +801,45 @@ func file_backup_v1_artifacts_proto_rawDescGZIP() []byte {
 }
 
 var (
-	file_backup_v1_artifacts_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-	file_backup_v1_artifacts_proto_msgTypes  = make([]protoimpl.MessageInfo, 8)
+	file_backup_v1_artifacts_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+	file_backup_v1_artifacts_proto_msgTypes  = make([]protoimpl.MessageInfo, 10)
 	file_backup_v1_artifacts_proto_goTypes   = []any{
 		BackupStatus(0),                    // 0: backup.v1.BackupStatus
-		(*Artifact)(nil),                   // 1: backup.v1.Artifact
-		(*ListArtifactsRequest)(nil),       // 2: backup.v1.ListArtifactsRequest
-		(*ListArtifactsResponse)(nil),      // 3: backup.v1.ListArtifactsResponse
-		(*DeleteArtifactRequest)(nil),      // 4: backup.v1.DeleteArtifactRequest
-		(*DeleteArtifactResponse)(nil),     // 5: backup.v1.DeleteArtifactResponse
-		(*PitrTimerange)(nil),              // 6: backup.v1.PitrTimerange
-		(*ListPitrTimerangesRequest)(nil),  // 7: backup.v1.ListPitrTimerangesRequest
-		(*ListPitrTimerangesResponse)(nil), // 8: backup.v1.ListPitrTimerangesResponse
-		DataModel(0),                       // 9: backup.v1.DataModel
-		(*timestamppb.Timestamp)(nil),      // 10: google.protobuf.Timestamp
-		BackupMode(0),                      // 11: backup.v1.BackupMode
-		(*Metadata)(nil),                   // 12: backup.v1.Metadata
+		VerificationStatus(0),              // 1: backup.v1.VerificationStatus
+		(*Artifact)(nil),                   // 2: backup.v1.Artifact
+		(*ListArtifactsRequest)(nil),       // 3: backup.v1.ListArtifactsRequest
+		(*ListArtifactsResponse)(nil),      // 4: backup.v1.ListArtifactsResponse
+		(*DeleteArtifactRequest)(nil),      // 5: backup.v1.DeleteArtifactRequest
+		(*DeleteArtifactResponse)(nil),     // 6: backup.v1.DeleteArtifactResponse
+		(*VerifyArtifactRequest)(nil),      // 7: backup.v1.VerifyArtifactRequest
+		(*VerifyArtifactResponse)(nil),     // 8: backup.v1.VerifyArtifactResponse
+		(*PitrTimerange)(nil),              // 9: backup.v1.PitrTimerange
+		(*ListPitrTimerangesRequest)(nil),  // 10: backup.v1.ListPitrTimerangesRequest
+		(*ListPitrTimerangesResponse)(nil), // 11: backup.v1.ListPitrTimerangesResponse
+		DataModel(0),                       // 12: backup.v1.DataModel
+		(*timestamppb.Timestamp)(nil),      // 13: google.protobuf.Timestamp
+		BackupMode(0),                      // 14: backup.v1.BackupMode
+		(*Metadata)(nil),                   // 15: backup.v1.Metadata
 	}
 )
 
 var file_backup_v1_artifacts_proto_depIdxs = []int32{
-	9,  // 0: backup.v1.Artifact.data_model:type_name -> backup.v1.DataModel
+	12, // 0: backup.v1.Artifact.data_model:type_name -> backup.v1.DataModel
 	0,  // 1: backup.v1.Artifact.status:type_name -> backup.v1.BackupStatus
-	10, // 2: backup.v1.Artifact.created_at:type_name -> google.protobuf.Timestamp
-	11, // 3: backup.v1.Artifact.mode:type_name -> backup.v1.BackupMode
-	12, // 4: backup.v1.Artifact.metadata_list:type_name -> backup.v1.Metadata
-	1,  // 5: backup.v1.ListArtifactsResponse.artifacts:type_name -> backup.v1.Artifact
-	10, // 6: backup.v1.PitrTimerange.start_timestamp:type_name -> google.protobuf.Timestamp
-	10, // 7: backup.v1.PitrTimerange.end_timestamp:type_name -> google.protobuf.Timestamp
-	6,  // 8: backup.v1.ListPitrTimerangesResponse.timeranges:type_name -> backup.v1.PitrTimerange
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	13, // 2: backup.v1.Artifact.created_at:type_name -> google.protobuf.Timestamp
+	14, // 3: backup.v1.Artifact.mode:type_name -> backup.v1.BackupMode
+	15, // 4: backup.v1.Artifact.metadata_list:type_name -> backup.v1.Metadata
+	1,  // 5: backup.v1.Artifact.verification_status:type_name -> backup.v1.VerificationStatus
+	13, // 6: backup.v1.Artifact.last_verified_at:type_name -> google.protobuf.Timestamp
+	2,  // 7: backup.v1.ListArtifactsResponse.artifacts:type_name -> backup.v1.Artifact
+	13, // 8: backup.v1.PitrTimerange.start_timestamp:type_name -> google.protobuf.Timestamp
+	13, // 9: backup.v1.PitrTimerange.end_timestamp:type_name -> google.protobuf.Timestamp
+	9,  // 10: backup.v1.ListPitrTimerangesResponse.timeranges:type_name -> backup.v1.PitrTimerange
+	11, // [11:11] is the sub-list for method output_type
+	11, // [11:11] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_backup_v1_artifacts_proto_init() }
@@ -685,8 +853,8 @@ func file_backup_v1_artifacts_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backup_v1_artifacts_proto_rawDesc), len(file_backup_v1_artifacts_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   8,
+			NumEnums:      2,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
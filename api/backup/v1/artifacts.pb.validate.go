@@ -144,6 +144,37 @@ func (m *Artifact) validate(all bool) error {
 
 	}
 
+	// no validation rules for VerificationStatus
+
+	if all {
+		switch v := interface{}(m.GetLastVerifiedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArtifactValidationError{
+					field:  "LastVerifiedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArtifactValidationError{
+					field:  "LastVerifiedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastVerifiedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArtifactValidationError{
+				field:  "LastVerifiedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ArtifactMultiError(errors)
 	}
@@ -681,6 +712,223 @@ var _ interface {
 	ErrorName() string
 } = DeleteArtifactResponseValidationError{}
 
+// Validate checks the field values on VerifyArtifactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyArtifactRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyArtifactRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyArtifactRequestMultiError, or nil if none found.
+func (m *VerifyArtifactRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyArtifactRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetArtifactId()) < 1 {
+		err := VerifyArtifactRequestValidationError{
+			field:  "ArtifactId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return VerifyArtifactRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyArtifactRequestMultiError is an error wrapping multiple validation
+// errors returned by VerifyArtifactRequest.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyArtifactRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyArtifactRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyArtifactRequestMultiError) AllErrors() []error { return m }
+
+// VerifyArtifactRequestValidationError is the validation error returned by
+// VerifyArtifactRequest.Validate if the designated constraints aren't met.
+type VerifyArtifactRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyArtifactRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyArtifactRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyArtifactRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyArtifactRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyArtifactRequestValidationError) ErrorName() string {
+	return "VerifyArtifactRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyArtifactRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyArtifactRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = VerifyArtifactRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyArtifactRequestValidationError{}
+
+// Validate checks the field values on VerifyArtifactResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *VerifyArtifactResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyArtifactResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyArtifactResponseMultiError, or nil if none found.
+func (m *VerifyArtifactResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyArtifactResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VerifyArtifactResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyArtifactResponseMultiError is an error wrapping multiple validation
+// errors returned by VerifyArtifactResponse.ValidateAll() if the designated
+// constraints aren't met.
+type VerifyArtifactResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyArtifactResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyArtifactResponseMultiError) AllErrors() []error { return m }
+
+// VerifyArtifactResponseValidationError is the validation error returned by
+// VerifyArtifactResponse.Validate if the designated constraints aren't met.
+type VerifyArtifactResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyArtifactResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyArtifactResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyArtifactResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyArtifactResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyArtifactResponseValidationError) ErrorName() string {
+	return "VerifyArtifactResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyArtifactResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyArtifactResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = VerifyArtifactResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyArtifactResponseValidationError{}
+
 // Validate checks the field values on PitrTimerange with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -228,9 +228,10 @@ func (x *ListArtifactCompatibleServicesRequest) GetArtifactId() string {
 }
 
 type ListArtifactCompatibleServicesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mysql         []*v1.MySQLService     `protobuf:"bytes,1,rep,name=mysql,proto3" json:"mysql,omitempty"`
-	Mongodb       []*v1.MongoDBService   `protobuf:"bytes,2,rep,name=mongodb,proto3" json:"mongodb,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Mysql         []*v1.MySQLService      `protobuf:"bytes,1,rep,name=mysql,proto3" json:"mysql,omitempty"`
+	Mongodb       []*v1.MongoDBService    `protobuf:"bytes,2,rep,name=mongodb,proto3" json:"mongodb,omitempty"`
+	Postgresql    []*v1.PostgreSQLService `protobuf:"bytes,3,rep,name=postgresql,proto3" json:"postgresql,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -279,6 +280,13 @@ func (x *ListArtifactCompatibleServicesResponse) GetMongodb() []*v1.MongoDBServi
 	return nil
 }
 
+func (x *ListArtifactCompatibleServicesResponse) GetPostgresql() []*v1.PostgreSQLService {
+	if x != nil {
+		return x.Postgresql
+	}
+	return nil
+}
+
 // ScheduledBackup represents scheduled task for backup.
 type ScheduledBackup struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1107,6 +1115,463 @@ func (x *GetLogsResponse) GetEnd() bool {
 	return false
 }
 
+// ScheduledVerification represents scheduled task for verification of backup artifacts.
+type ScheduledVerification struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Machine-readable ID.
+	ScheduledVerificationId string `protobuf:"bytes,1,opt,name=scheduled_verification_id,json=scheduledVerificationId,proto3" json:"scheduled_verification_id,omitempty"`
+	// Machine-readable service ID.
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Service name.
+	ServiceName string `protobuf:"bytes,3,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	// Machine-readable location ID.
+	LocationId string `protobuf:"bytes,4,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	// Location name.
+	LocationName string `protobuf:"bytes,5,opt,name=location_name,json=locationName,proto3" json:"location_name,omitempty"`
+	// How often verification will be run in cron format.
+	CronExpression string `protobuf:"bytes,6,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	// First verification wouldn't happen before this time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Scheduled verification name.
+	Name string `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	// Description.
+	Description string `protobuf:"bytes,9,opt,name=description,proto3" json:"description,omitempty"`
+	// If scheduling is enabled.
+	Enabled bool `protobuf:"varint,10,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Database vendor e.g. MongoDB, MySQL.
+	Vendor string `protobuf:"bytes,11,opt,name=vendor,proto3" json:"vendor,omitempty"`
+	// Last run.
+	LastRun *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=last_run,json=lastRun,proto3" json:"last_run,omitempty"`
+	// Next run.
+	NextRun       *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduledVerification) Reset() {
+	*x = ScheduledVerification{}
+	mi := &file_backup_v1_backup_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduledVerification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduledVerification) ProtoMessage() {}
+
+func (x *ScheduledVerification) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduledVerification.ProtoReflect.Descriptor instead.
+func (*ScheduledVerification) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ScheduledVerification) GetScheduledVerificationId() string {
+	if x != nil {
+		return x.ScheduledVerificationId
+	}
+	return ""
+}
+
+func (x *ScheduledVerification) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ScheduledVerification) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ScheduledVerification) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *ScheduledVerification) GetLocationName() string {
+	if x != nil {
+		return x.LocationName
+	}
+	return ""
+}
+
+func (x *ScheduledVerification) GetCronExpression() string {
+	if x != nil {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (x *ScheduledVerification) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ScheduledVerification) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScheduledVerification) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ScheduledVerification) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *ScheduledVerification) GetVendor() string {
+	if x != nil {
+		return x.Vendor
+	}
+	return ""
+}
+
+func (x *ScheduledVerification) GetLastRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastRun
+	}
+	return nil
+}
+
+func (x *ScheduledVerification) GetNextRun() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextRun
+	}
+	return nil
+}
+
+type ScheduleVerificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Service identifier whose latest successful artifact should be verified.
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Machine-readable location ID.
+	LocationId string `protobuf:"bytes,2,opt,name=location_id,json=locationId,proto3" json:"location_id,omitempty"`
+	// How often verification should be run in cron format.
+	CronExpression string `protobuf:"bytes,3,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	// First verification wouldn't happen before this time.
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Name of scheduled verification.
+	Name string `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	// Human-readable description.
+	Description string `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	// If scheduling is enabled.
+	Enabled       bool `protobuf:"varint,7,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleVerificationRequest) Reset() {
+	*x = ScheduleVerificationRequest{}
+	mi := &file_backup_v1_backup_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleVerificationRequest) ProtoMessage() {}
+
+func (x *ScheduleVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleVerificationRequest.ProtoReflect.Descriptor instead.
+func (*ScheduleVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ScheduleVerificationRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ScheduleVerificationRequest) GetLocationId() string {
+	if x != nil {
+		return x.LocationId
+	}
+	return ""
+}
+
+func (x *ScheduleVerificationRequest) GetCronExpression() string {
+	if x != nil {
+		return x.CronExpression
+	}
+	return ""
+}
+
+func (x *ScheduleVerificationRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *ScheduleVerificationRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScheduleVerificationRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ScheduleVerificationRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type ScheduleVerificationResponse struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledVerificationId string                 `protobuf:"bytes,1,opt,name=scheduled_verification_id,json=scheduledVerificationId,proto3" json:"scheduled_verification_id,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *ScheduleVerificationResponse) Reset() {
+	*x = ScheduleVerificationResponse{}
+	mi := &file_backup_v1_backup_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleVerificationResponse) ProtoMessage() {}
+
+func (x *ScheduleVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleVerificationResponse.ProtoReflect.Descriptor instead.
+func (*ScheduleVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ScheduleVerificationResponse) GetScheduledVerificationId() string {
+	if x != nil {
+		return x.ScheduledVerificationId
+	}
+	return ""
+}
+
+type ListScheduledVerificationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListScheduledVerificationsRequest) Reset() {
+	*x = ListScheduledVerificationsRequest{}
+	mi := &file_backup_v1_backup_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledVerificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledVerificationsRequest) ProtoMessage() {}
+
+func (x *ListScheduledVerificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledVerificationsRequest.ProtoReflect.Descriptor instead.
+func (*ListScheduledVerificationsRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{18}
+}
+
+type ListScheduledVerificationsResponse struct {
+	state                  protoimpl.MessageState   `protogen:"open.v1"`
+	ScheduledVerifications []*ScheduledVerification `protobuf:"bytes,1,rep,name=scheduled_verifications,json=scheduledVerifications,proto3" json:"scheduled_verifications,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *ListScheduledVerificationsResponse) Reset() {
+	*x = ListScheduledVerificationsResponse{}
+	mi := &file_backup_v1_backup_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListScheduledVerificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListScheduledVerificationsResponse) ProtoMessage() {}
+
+func (x *ListScheduledVerificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListScheduledVerificationsResponse.ProtoReflect.Descriptor instead.
+func (*ListScheduledVerificationsResponse) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListScheduledVerificationsResponse) GetScheduledVerifications() []*ScheduledVerification {
+	if x != nil {
+		return x.ScheduledVerifications
+	}
+	return nil
+}
+
+type RemoveScheduledVerificationRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	ScheduledVerificationId string                 `protobuf:"bytes,1,opt,name=scheduled_verification_id,json=scheduledVerificationId,proto3" json:"scheduled_verification_id,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RemoveScheduledVerificationRequest) Reset() {
+	*x = RemoveScheduledVerificationRequest{}
+	mi := &file_backup_v1_backup_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveScheduledVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveScheduledVerificationRequest) ProtoMessage() {}
+
+func (x *RemoveScheduledVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveScheduledVerificationRequest.ProtoReflect.Descriptor instead.
+func (*RemoveScheduledVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RemoveScheduledVerificationRequest) GetScheduledVerificationId() string {
+	if x != nil {
+		return x.ScheduledVerificationId
+	}
+	return ""
+}
+
+type RemoveScheduledVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveScheduledVerificationResponse) Reset() {
+	*x = RemoveScheduledVerificationResponse{}
+	mi := &file_backup_v1_backup_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveScheduledVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveScheduledVerificationResponse) ProtoMessage() {}
+
+func (x *RemoveScheduledVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_backup_v1_backup_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveScheduledVerificationResponse.ProtoReflect.Descriptor instead.
+func (*RemoveScheduledVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_backup_v1_backup_proto_rawDescGZIP(), []int{21}
+}
+
 var File_backup_v1_backup_proto protoreflect.FileDescriptor
 
 const file_backup_v1_backup_proto_rawDesc = "" +
@@ -1129,10 +1594,13 @@ const file_backup_v1_backup_proto_rawDesc = "" +
 	"artifactId\"Q\n" +
 	"%ListArtifactCompatibleServicesRequest\x12(\n" +
 	"\vartifact_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
-	"artifactId\"\x92\x01\n" +
+	"artifactId\"\xd3\x01\n" +
 	"&ListArtifactCompatibleServicesResponse\x120\n" +
 	"\x05mysql\x18\x01 \x03(\v2\x1a.inventory.v1.MySQLServiceR\x05mysql\x126\n" +
-	"\amongodb\x18\x02 \x03(\v2\x1c.inventory.v1.MongoDBServiceR\amongodb\"\xf5\x05\n" +
+	"\amongodb\x18\x02 \x03(\v2\x1c.inventory.v1.MongoDBServiceR\amongodb\x12?\n" +
+	"\n" +
+	"postgresql\x18\x03 \x03(\v2\x1f.inventory.v1.PostgreSQLServiceR\n" +
+	"postgresql\"\xf5\x05\n" +
 	"\x0fScheduledBackup\x12.\n" +
 	"\x13scheduled_backup_id\x18\x01 \x01(\tR\x11scheduledBackupId\x12\x1d\n" +
 	"\n" +
@@ -1213,7 +1681,44 @@ const file_backup_v1_backup_proto_rawDesc = "" +
 	"\x05limit\x18\x03 \x01(\rR\x05limit\"L\n" +
 	"\x0fGetLogsResponse\x12'\n" +
 	"\x04logs\x18\x01 \x03(\v2\x13.backup.v1.LogChunkR\x04logs\x12\x10\n" +
-	"\x03end\x18\x02 \x01(\bR\x03end2\xc5\x12\n" +
+	"\x03end\x18\x02 \x01(\bR\x03end\"\x95\x04\n" +
+	"\x15ScheduledVerification\x12:\n" +
+	"\x19scheduled_verification_id\x18\x01 \x01(\tR\x17scheduledVerificationId\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tR\tserviceId\x12!\n" +
+	"\fservice_name\x18\x03 \x01(\tR\vserviceName\x12\x1f\n" +
+	"\vlocation_id\x18\x04 \x01(\tR\n" +
+	"locationId\x12#\n" +
+	"\rlocation_name\x18\x05 \x01(\tR\flocationName\x12'\n" +
+	"\x0fcron_expression\x18\x06 \x01(\tR\x0ecronExpression\x129\n" +
+	"\n" +
+	"start_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12\x12\n" +
+	"\x04name\x18\b \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\t \x01(\tR\vdescription\x12\x18\n" +
+	"\aenabled\x18\n" +
+	" \x01(\bR\aenabled\x12\x16\n" +
+	"\x06vendor\x18\v \x01(\tR\x06vendor\x125\n" +
+	"\blast_run\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\alastRun\x125\n" +
+	"\bnext_run\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\anextRun\"\xb5\x02\n" +
+	"\x1bScheduleVerificationRequest\x12&\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tserviceId\x12(\n" +
+	"\vlocation_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"locationId\x120\n" +
+	"\x0fcron_expression\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x0ecronExpression\x129\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x12\x1b\n" +
+	"\x04name\x18\x05 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04name\x12 \n" +
+	"\vdescription\x18\x06 \x01(\tR\vdescription\x12\x18\n" +
+	"\aenabled\x18\a \x01(\bR\aenabled\"Z\n" +
+	"\x1cScheduleVerificationResponse\x12:\n" +
+	"\x19scheduled_verification_id\x18\x01 \x01(\tR\x17scheduledVerificationId\"#\n" +
+	"!ListScheduledVerificationsRequest\"\x7f\n" +
+	"\"ListScheduledVerificationsResponse\x12Y\n" +
+	"\x17scheduled_verifications\x18\x01 \x03(\v2 .backup.v1.ScheduledVerificationR\x16scheduledVerifications\"i\n" +
+	"\"RemoveScheduledVerificationRequest\x12C\n" +
+	"\x19scheduled_verification_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x17scheduledVerificationId\"%\n" +
+	"#RemoveScheduledVerificationResponse2\xfc\x1a\n" +
 	"\rBackupService\x12\xe7\x03\n" +
 	"\vStartBackup\x12\x1d.backup.v1.StartBackupRequest\x1a\x1e.backup.v1.StartBackupResponse\"\x98\x03\x92A\xf8\x02\x12\x0eStart a Backup\x1a\xe5\x02Could return the Error message in the details containing specific ErrorCode indicating failure reason:\n" +
 	"ERROR_CODE_XTRABACKUP_NOT_INSTALLED - xtrabackup is not installed on the service\n" +
@@ -1226,7 +1731,11 @@ const file_backup_v1_backup_proto_rawDesc = "" +
 	"\x15RemoveScheduledBackup\x12'.backup.v1.RemoveScheduledBackupRequest\x1a(.backup.v1.RemoveScheduledBackupResponse\"c\x92A7\x12\x19Remove a Scheduled Backup\x1a\x1aRemove a scheduled backup.\x82\xd3\xe4\x93\x02#*!/v1/backups/{scheduled_backup_id}\x12\xb3\x01\n" +
 	"\aGetLogs\x12\x19.backup.v1.GetLogsRequest\x1a\x1a.backup.v1.GetLogsResponse\"q\x92AH\x12\bGet Logs\x1a<Get logs from the underlying tools for a backup/restore job.\x82\xd3\xe4\x93\x02 \x12\x1e/v1/backups/{artifact_id}/logs\x12\xa8\x01\n" +
 	"\rListArtifacts\x12\x1f.backup.v1.ListArtifactsRequest\x1a .backup.v1.ListArtifactsResponse\"T\x92A4\x12\x0eList artifacts\x1a\"Return a list of backup artifacts.\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/backups/artifacts\x12\xac\x01\n" +
-	"\x0eDeleteArtifact\x12 .backup.v1.DeleteArtifactRequest\x1a!.backup.v1.DeleteArtifactResponse\"U\x92A'\x12\x0fDelete Artifact\x1a\x14Deletes an artifact.\x82\xd3\xe4\x93\x02%*#/v1/backups/artifacts/{artifact_id}\x12\xff\x01\n" +
+	"\x0eDeleteArtifact\x12 .backup.v1.DeleteArtifactRequest\x1a!.backup.v1.DeleteArtifactResponse\"U\x92A'\x12\x0fDelete Artifact\x1a\x14Deletes an artifact.\x82\xd3\xe4\x93\x02%*#/v1/backups/artifacts/{artifact_id}\x12\xa5\x02\n" +
+	"\x0eVerifyArtifact\x12 .backup.v1.VerifyArtifactRequest\x1a!.backup.v1.VerifyArtifactResponse\"\xcd\x01\x92A\x94\x01\x12\x0fVerify Artifact\x1a\x80\x01Starts a job that checks that the artifact can actually be restored. The result is reported in the artifact verification status.\x82\xd3\xe4\x93\x02/:\x01*\"*/v1/backups/artifacts/{artifact_id}:verify\x12\x88\x02\n" +
+	"\x14ScheduleVerification\x12&.backup.v1.ScheduleVerificationRequest\x1a'.backup.v1.ScheduleVerificationResponse\"\x9e\x01\x92An\x12\x17Schedule a Verification\x1aSSchedule verification of the latest successful artifact of a service in a location.\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/backups/verifications:schedule\x12\xf3\x01\n" +
+	"\x1aListScheduledVerifications\x12,.backup.v1.ListScheduledVerificationsRequest\x1a-.backup.v1.ListScheduledVerificationsResponse\"x\x92AJ\x12\x1cList Scheduled Verifications\x1a*List all scheduled artifact verifications.\x82\xd3\xe4\x93\x02%\x12#/v1/backups/verifications/scheduled\x12\x8b\x02\n" +
+	"\x1bRemoveScheduledVerification\x12-.backup.v1.RemoveScheduledVerificationRequest\x1a..backup.v1.RemoveScheduledVerificationResponse\"\x8c\x01\x92AL\x12\x1fRemove a Scheduled Verification\x1a)Remove a scheduled artifact verification.\x82\xd3\xe4\x93\x027*5/v1/backups/verifications/{scheduled_verification_id}\x12\xff\x01\n" +
 	"\x12ListPitrTimeranges\x12$.backup.v1.ListPitrTimerangesRequest\x1a%.backup.v1.ListPitrTimerangesResponse\"\x9b\x01\x92A]\x12\x14List PITR Timeranges\x1aEReturn a list of available MongoDB point-in-time-recovery timeranges.\x82\xd3\xe4\x93\x025\x123/v1/backups/artifacts/{artifact_id}/pitr-timerangesB\x90\x01\n" +
 	"\rcom.backup.v1B\vBackupProtoP\x01Z-github.com/percona/pmm/api/backup/v1;backupv1\xa2\x02\x03BXX\xaa\x02\tBackup.V1\xca\x02\tBackup\\V1\xe2\x02\x15Backup\\V1\\GPBMetadata\xea\x02\n" +
 	"Backup::V1b\x06proto3"
@@ -1244,7 +1753,7 @@ func file_backup_v1_backup_proto_rawDescGZIP() []byte {
 }
 
 var (
-	file_backup_v1_backup_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+	file_backup_v1_backup_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 	file_backup_v1_backup_proto_goTypes  = []any{
 		(*StartBackupRequest)(nil),                     // 0: backup.v1.StartBackupRequest
 		(*StartBackupResponse)(nil),                    // 1: backup.v1.StartBackupResponse
@@ -1261,66 +1770,90 @@ var (
 		(*RemoveScheduledBackupResponse)(nil),          // 12: backup.v1.RemoveScheduledBackupResponse
 		(*GetLogsRequest)(nil),                         // 13: backup.v1.GetLogsRequest
 		(*GetLogsResponse)(nil),                        // 14: backup.v1.GetLogsResponse
-		(*durationpb.Duration)(nil),                    // 15: google.protobuf.Duration
-		DataModel(0),                                   // 16: backup.v1.DataModel
-		(*v1.MySQLService)(nil),                        // 17: inventory.v1.MySQLService
-		(*v1.MongoDBService)(nil),                      // 18: inventory.v1.MongoDBService
-		(*timestamppb.Timestamp)(nil),                  // 19: google.protobuf.Timestamp
-		BackupMode(0),                                  // 20: backup.v1.BackupMode
-		(*LogChunk)(nil),                               // 21: backup.v1.LogChunk
-		(*ListArtifactsRequest)(nil),                   // 22: backup.v1.ListArtifactsRequest
-		(*DeleteArtifactRequest)(nil),                  // 23: backup.v1.DeleteArtifactRequest
-		(*ListPitrTimerangesRequest)(nil),              // 24: backup.v1.ListPitrTimerangesRequest
-		(*ListArtifactsResponse)(nil),                  // 25: backup.v1.ListArtifactsResponse
-		(*DeleteArtifactResponse)(nil),                 // 26: backup.v1.DeleteArtifactResponse
-		(*ListPitrTimerangesResponse)(nil),             // 27: backup.v1.ListPitrTimerangesResponse
+		(*ScheduledVerification)(nil),                  // 15: backup.v1.ScheduledVerification
+		(*ScheduleVerificationRequest)(nil),            // 16: backup.v1.ScheduleVerificationRequest
+		(*ScheduleVerificationResponse)(nil),           // 17: backup.v1.ScheduleVerificationResponse
+		(*ListScheduledVerificationsRequest)(nil),      // 18: backup.v1.ListScheduledVerificationsRequest
+		(*ListScheduledVerificationsResponse)(nil),     // 19: backup.v1.ListScheduledVerificationsResponse
+		(*RemoveScheduledVerificationRequest)(nil),     // 20: backup.v1.RemoveScheduledVerificationRequest
+		(*RemoveScheduledVerificationResponse)(nil),    // 21: backup.v1.RemoveScheduledVerificationResponse
+		(*durationpb.Duration)(nil),                    // 22: google.protobuf.Duration
+		DataModel(0),                                   // 23: backup.v1.DataModel
+		(*v1.MySQLService)(nil),                        // 24: inventory.v1.MySQLService
+		(*v1.MongoDBService)(nil),                      // 25: inventory.v1.MongoDBService
+		(*v1.PostgreSQLService)(nil),                   // 26: inventory.v1.PostgreSQLService
+		(*timestamppb.Timestamp)(nil),                  // 27: google.protobuf.Timestamp
+		BackupMode(0),                                  // 28: backup.v1.BackupMode
+		(*LogChunk)(nil),                               // 29: backup.v1.LogChunk
+		(*ListArtifactsRequest)(nil),                   // 30: backup.v1.ListArtifactsRequest
+		(*DeleteArtifactRequest)(nil),                  // 31: backup.v1.DeleteArtifactRequest
+		(*VerifyArtifactRequest)(nil),                  // 32: backup.v1.VerifyArtifactRequest
+		(*ListPitrTimerangesRequest)(nil),              // 33: backup.v1.ListPitrTimerangesRequest
+		(*ListArtifactsResponse)(nil),                  // 34: backup.v1.ListArtifactsResponse
+		(*DeleteArtifactResponse)(nil),                 // 35: backup.v1.DeleteArtifactResponse
+		(*VerifyArtifactResponse)(nil),                 // 36: backup.v1.VerifyArtifactResponse
+		(*ListPitrTimerangesResponse)(nil),             // 37: backup.v1.ListPitrTimerangesResponse
 	}
 )
 
 var file_backup_v1_backup_proto_depIdxs = []int32{
-	15, // 0: backup.v1.StartBackupRequest.retry_interval:type_name -> google.protobuf.Duration
-	16, // 1: backup.v1.StartBackupRequest.data_model:type_name -> backup.v1.DataModel
-	17, // 2: backup.v1.ListArtifactCompatibleServicesResponse.mysql:type_name -> inventory.v1.MySQLService
-	18, // 3: backup.v1.ListArtifactCompatibleServicesResponse.mongodb:type_name -> inventory.v1.MongoDBService
-	19, // 4: backup.v1.ScheduledBackup.start_time:type_name -> google.protobuf.Timestamp
-	15, // 5: backup.v1.ScheduledBackup.retry_interval:type_name -> google.protobuf.Duration
-	16, // 6: backup.v1.ScheduledBackup.data_model:type_name -> backup.v1.DataModel
-	20, // 7: backup.v1.ScheduledBackup.mode:type_name -> backup.v1.BackupMode
-	19, // 8: backup.v1.ScheduledBackup.last_run:type_name -> google.protobuf.Timestamp
-	19, // 9: backup.v1.ScheduledBackup.next_run:type_name -> google.protobuf.Timestamp
-	19, // 10: backup.v1.ScheduleBackupRequest.start_time:type_name -> google.protobuf.Timestamp
-	15, // 11: backup.v1.ScheduleBackupRequest.retry_interval:type_name -> google.protobuf.Duration
-	20, // 12: backup.v1.ScheduleBackupRequest.mode:type_name -> backup.v1.BackupMode
-	16, // 13: backup.v1.ScheduleBackupRequest.data_model:type_name -> backup.v1.DataModel
-	4,  // 14: backup.v1.ListScheduledBackupsResponse.scheduled_backups:type_name -> backup.v1.ScheduledBackup
-	19, // 15: backup.v1.ChangeScheduledBackupRequest.start_time:type_name -> google.protobuf.Timestamp
-	15, // 16: backup.v1.ChangeScheduledBackupRequest.retry_interval:type_name -> google.protobuf.Duration
-	21, // 17: backup.v1.GetLogsResponse.logs:type_name -> backup.v1.LogChunk
-	0,  // 18: backup.v1.BackupService.StartBackup:input_type -> backup.v1.StartBackupRequest
-	2,  // 19: backup.v1.BackupService.ListArtifactCompatibleServices:input_type -> backup.v1.ListArtifactCompatibleServicesRequest
-	5,  // 20: backup.v1.BackupService.ScheduleBackup:input_type -> backup.v1.ScheduleBackupRequest
-	7,  // 21: backup.v1.BackupService.ListScheduledBackups:input_type -> backup.v1.ListScheduledBackupsRequest
-	9,  // 22: backup.v1.BackupService.ChangeScheduledBackup:input_type -> backup.v1.ChangeScheduledBackupRequest
-	11, // 23: backup.v1.BackupService.RemoveScheduledBackup:input_type -> backup.v1.RemoveScheduledBackupRequest
-	13, // 24: backup.v1.BackupService.GetLogs:input_type -> backup.v1.GetLogsRequest
-	22, // 25: backup.v1.BackupService.ListArtifacts:input_type -> backup.v1.ListArtifactsRequest
-	23, // 26: backup.v1.BackupService.DeleteArtifact:input_type -> backup.v1.DeleteArtifactRequest
-	24, // 27: backup.v1.BackupService.ListPitrTimeranges:input_type -> backup.v1.ListPitrTimerangesRequest
-	1,  // 28: backup.v1.BackupService.StartBackup:output_type -> backup.v1.StartBackupResponse
-	3,  // 29: backup.v1.BackupService.ListArtifactCompatibleServices:output_type -> backup.v1.ListArtifactCompatibleServicesResponse
-	6,  // 30: backup.v1.BackupService.ScheduleBackup:output_type -> backup.v1.ScheduleBackupResponse
-	8,  // 31: backup.v1.BackupService.ListScheduledBackups:output_type -> backup.v1.ListScheduledBackupsResponse
-	10, // 32: backup.v1.BackupService.ChangeScheduledBackup:output_type -> backup.v1.ChangeScheduledBackupResponse
-	12, // 33: backup.v1.BackupService.RemoveScheduledBackup:output_type -> backup.v1.RemoveScheduledBackupResponse
-	14, // 34: backup.v1.BackupService.GetLogs:output_type -> backup.v1.GetLogsResponse
-	25, // 35: backup.v1.BackupService.ListArtifacts:output_type -> backup.v1.ListArtifactsResponse
-	26, // 36: backup.v1.BackupService.DeleteArtifact:output_type -> backup.v1.DeleteArtifactResponse
-	27, // 37: backup.v1.BackupService.ListPitrTimeranges:output_type -> backup.v1.ListPitrTimerangesResponse
-	28, // [28:38] is the sub-list for method output_type
-	18, // [18:28] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	22, // 0: backup.v1.StartBackupRequest.retry_interval:type_name -> google.protobuf.Duration
+	23, // 1: backup.v1.StartBackupRequest.data_model:type_name -> backup.v1.DataModel
+	24, // 2: backup.v1.ListArtifactCompatibleServicesResponse.mysql:type_name -> inventory.v1.MySQLService
+	25, // 3: backup.v1.ListArtifactCompatibleServicesResponse.mongodb:type_name -> inventory.v1.MongoDBService
+	26, // 4: backup.v1.ListArtifactCompatibleServicesResponse.postgresql:type_name -> inventory.v1.PostgreSQLService
+	27, // 5: backup.v1.ScheduledBackup.start_time:type_name -> google.protobuf.Timestamp
+	22, // 6: backup.v1.ScheduledBackup.retry_interval:type_name -> google.protobuf.Duration
+	23, // 7: backup.v1.ScheduledBackup.data_model:type_name -> backup.v1.DataModel
+	28, // 8: backup.v1.ScheduledBackup.mode:type_name -> backup.v1.BackupMode
+	27, // 9: backup.v1.ScheduledBackup.last_run:type_name -> google.protobuf.Timestamp
+	27, // 10: backup.v1.ScheduledBackup.next_run:type_name -> google.protobuf.Timestamp
+	27, // 11: backup.v1.ScheduleBackupRequest.start_time:type_name -> google.protobuf.Timestamp
+	22, // 12: backup.v1.ScheduleBackupRequest.retry_interval:type_name -> google.protobuf.Duration
+	28, // 13: backup.v1.ScheduleBackupRequest.mode:type_name -> backup.v1.BackupMode
+	23, // 14: backup.v1.ScheduleBackupRequest.data_model:type_name -> backup.v1.DataModel
+	4,  // 15: backup.v1.ListScheduledBackupsResponse.scheduled_backups:type_name -> backup.v1.ScheduledBackup
+	27, // 16: backup.v1.ChangeScheduledBackupRequest.start_time:type_name -> google.protobuf.Timestamp
+	22, // 17: backup.v1.ChangeScheduledBackupRequest.retry_interval:type_name -> google.protobuf.Duration
+	29, // 18: backup.v1.GetLogsResponse.logs:type_name -> backup.v1.LogChunk
+	27, // 19: backup.v1.ScheduledVerification.start_time:type_name -> google.protobuf.Timestamp
+	27, // 20: backup.v1.ScheduledVerification.last_run:type_name -> google.protobuf.Timestamp
+	27, // 21: backup.v1.ScheduledVerification.next_run:type_name -> google.protobuf.Timestamp
+	27, // 22: backup.v1.ScheduleVerificationRequest.start_time:type_name -> google.protobuf.Timestamp
+	15, // 23: backup.v1.ListScheduledVerificationsResponse.scheduled_verifications:type_name -> backup.v1.ScheduledVerification
+	0,  // 24: backup.v1.BackupService.StartBackup:input_type -> backup.v1.StartBackupRequest
+	2,  // 25: backup.v1.BackupService.ListArtifactCompatibleServices:input_type -> backup.v1.ListArtifactCompatibleServicesRequest
+	5,  // 26: backup.v1.BackupService.ScheduleBackup:input_type -> backup.v1.ScheduleBackupRequest
+	7,  // 27: backup.v1.BackupService.ListScheduledBackups:input_type -> backup.v1.ListScheduledBackupsRequest
+	9,  // 28: backup.v1.BackupService.ChangeScheduledBackup:input_type -> backup.v1.ChangeScheduledBackupRequest
+	11, // 29: backup.v1.BackupService.RemoveScheduledBackup:input_type -> backup.v1.RemoveScheduledBackupRequest
+	13, // 30: backup.v1.BackupService.GetLogs:input_type -> backup.v1.GetLogsRequest
+	30, // 31: backup.v1.BackupService.ListArtifacts:input_type -> backup.v1.ListArtifactsRequest
+	31, // 32: backup.v1.BackupService.DeleteArtifact:input_type -> backup.v1.DeleteArtifactRequest
+	32, // 33: backup.v1.BackupService.VerifyArtifact:input_type -> backup.v1.VerifyArtifactRequest
+	16, // 34: backup.v1.BackupService.ScheduleVerification:input_type -> backup.v1.ScheduleVerificationRequest
+	18, // 35: backup.v1.BackupService.ListScheduledVerifications:input_type -> backup.v1.ListScheduledVerificationsRequest
+	20, // 36: backup.v1.BackupService.RemoveScheduledVerification:input_type -> backup.v1.RemoveScheduledVerificationRequest
+	33, // 37: backup.v1.BackupService.ListPitrTimeranges:input_type -> backup.v1.ListPitrTimerangesRequest
+	1,  // 38: backup.v1.BackupService.StartBackup:output_type -> backup.v1.StartBackupResponse
+	3,  // 39: backup.v1.BackupService.ListArtifactCompatibleServices:output_type -> backup.v1.ListArtifactCompatibleServicesResponse
+	6,  // 40: backup.v1.BackupService.ScheduleBackup:output_type -> backup.v1.ScheduleBackupResponse
+	8,  // 41: backup.v1.BackupService.ListScheduledBackups:output_type -> backup.v1.ListScheduledBackupsResponse
+	10, // 42: backup.v1.BackupService.ChangeScheduledBackup:output_type -> backup.v1.ChangeScheduledBackupResponse
+	12, // 43: backup.v1.BackupService.RemoveScheduledBackup:output_type -> backup.v1.RemoveScheduledBackupResponse
+	14, // 44: backup.v1.BackupService.GetLogs:output_type -> backup.v1.GetLogsResponse
+	34, // 45: backup.v1.BackupService.ListArtifacts:output_type -> backup.v1.ListArtifactsResponse
+	35, // 46: backup.v1.BackupService.DeleteArtifact:output_type -> backup.v1.DeleteArtifactResponse
+	36, // 47: backup.v1.BackupService.VerifyArtifact:output_type -> backup.v1.VerifyArtifactResponse
+	17, // 48: backup.v1.BackupService.ScheduleVerification:output_type -> backup.v1.ScheduleVerificationResponse
+	19, // 49: backup.v1.BackupService.ListScheduledVerifications:output_type -> backup.v1.ListScheduledVerificationsResponse
+	21, // 50: backup.v1.BackupService.RemoveScheduledVerification:output_type -> backup.v1.RemoveScheduledVerificationResponse
+	37, // 51: backup.v1.BackupService.ListPitrTimeranges:output_type -> backup.v1.ListPitrTimerangesResponse
+	38, // [38:52] is the sub-list for method output_type
+	24, // [24:38] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_backup_v1_backup_proto_init() }
@@ -1337,7 +1870,7 @@ func file_backup_v1_backup_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_backup_v1_backup_proto_rawDesc), len(file_backup_v1_backup_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   15,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -513,6 +513,40 @@ func (m *ListArtifactCompatibleServicesResponse) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetPostgresql() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListArtifactCompatibleServicesResponseValidationError{
+						field:  fmt.Sprintf("Postgresql[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListArtifactCompatibleServicesResponseValidationError{
+						field:  fmt.Sprintf("Postgresql[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListArtifactCompatibleServicesResponseValidationError{
+					field:  fmt.Sprintf("Postgresql[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ListArtifactCompatibleServicesResponseMultiError(errors)
 	}
@@ -2172,3 +2206,969 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetLogsResponseValidationError{}
+
+// Validate checks the field values on ScheduledVerification with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScheduledVerification) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduledVerification with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduledVerificationMultiError, or nil if none found.
+func (m *ScheduledVerification) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduledVerification) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ScheduledVerificationId
+
+	// no validation rules for ServiceId
+
+	// no validation rules for ServiceName
+
+	// no validation rules for LocationId
+
+	// no validation rules for LocationName
+
+	// no validation rules for CronExpression
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduledVerificationValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduledVerificationValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduledVerificationValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for Description
+
+	// no validation rules for Enabled
+
+	// no validation rules for Vendor
+
+	if all {
+		switch v := interface{}(m.GetLastRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduledVerificationValidationError{
+					field:  "LastRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduledVerificationValidationError{
+					field:  "LastRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduledVerificationValidationError{
+				field:  "LastRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetNextRun()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduledVerificationValidationError{
+					field:  "NextRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduledVerificationValidationError{
+					field:  "NextRun",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNextRun()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduledVerificationValidationError{
+				field:  "NextRun",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ScheduledVerificationMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduledVerificationMultiError is an error wrapping multiple validation
+// errors returned by ScheduledVerification.ValidateAll() if the designated
+// constraints aren't met.
+type ScheduledVerificationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduledVerificationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduledVerificationMultiError) AllErrors() []error { return m }
+
+// ScheduledVerificationValidationError is the validation error returned by
+// ScheduledVerification.Validate if the designated constraints aren't met.
+type ScheduledVerificationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduledVerificationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduledVerificationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduledVerificationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduledVerificationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduledVerificationValidationError) ErrorName() string {
+	return "ScheduledVerificationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduledVerificationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduledVerification.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ScheduledVerificationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduledVerificationValidationError{}
+
+// Validate checks the field values on ScheduleVerificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScheduleVerificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduleVerificationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduleVerificationRequestMultiError, or nil if none found.
+func (m *ScheduleVerificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduleVerificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
+		err := ScheduleVerificationRequestValidationError{
+			field:  "ServiceId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetLocationId()) < 1 {
+		err := ScheduleVerificationRequestValidationError{
+			field:  "LocationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCronExpression()) < 1 {
+		err := ScheduleVerificationRequestValidationError{
+			field:  "CronExpression",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ScheduleVerificationRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ScheduleVerificationRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ScheduleVerificationRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetName()) < 1 {
+		err := ScheduleVerificationRequestValidationError{
+			field:  "Name",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Description
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return ScheduleVerificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduleVerificationRequestMultiError is an error wrapping multiple
+// validation errors returned by ScheduleVerificationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ScheduleVerificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleVerificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleVerificationRequestMultiError) AllErrors() []error { return m }
+
+// ScheduleVerificationRequestValidationError is the validation error returned
+// by ScheduleVerificationRequest.Validate if the designated constraints
+// aren't met.
+type ScheduleVerificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleVerificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleVerificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleVerificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleVerificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleVerificationRequestValidationError) ErrorName() string {
+	return "ScheduleVerificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleVerificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleVerificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ScheduleVerificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleVerificationRequestValidationError{}
+
+// Validate checks the field values on ScheduleVerificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ScheduleVerificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ScheduleVerificationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ScheduleVerificationResponseMultiError, or nil if none found.
+func (m *ScheduleVerificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ScheduleVerificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ScheduledVerificationId
+
+	if len(errors) > 0 {
+		return ScheduleVerificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ScheduleVerificationResponseMultiError is an error wrapping multiple
+// validation errors returned by ScheduleVerificationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ScheduleVerificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ScheduleVerificationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ScheduleVerificationResponseMultiError) AllErrors() []error { return m }
+
+// ScheduleVerificationResponseValidationError is the validation error returned
+// by ScheduleVerificationResponse.Validate if the designated constraints
+// aren't met.
+type ScheduleVerificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ScheduleVerificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ScheduleVerificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ScheduleVerificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ScheduleVerificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ScheduleVerificationResponseValidationError) ErrorName() string {
+	return "ScheduleVerificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ScheduleVerificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sScheduleVerificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ScheduleVerificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ScheduleVerificationResponseValidationError{}
+
+// Validate checks the field values on ListScheduledVerificationsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListScheduledVerificationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListScheduledVerificationsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListScheduledVerificationsRequestMultiError, or nil if none found.
+func (m *ListScheduledVerificationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListScheduledVerificationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListScheduledVerificationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListScheduledVerificationsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// ListScheduledVerificationsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListScheduledVerificationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListScheduledVerificationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListScheduledVerificationsRequestMultiError) AllErrors() []error { return m }
+
+// ListScheduledVerificationsRequestValidationError is the validation error
+// returned by ListScheduledVerificationsRequest.Validate if the designated
+// constraints aren't met.
+type ListScheduledVerificationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScheduledVerificationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScheduledVerificationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScheduledVerificationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScheduledVerificationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScheduledVerificationsRequestValidationError) ErrorName() string {
+	return "ListScheduledVerificationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScheduledVerificationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScheduledVerificationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ListScheduledVerificationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScheduledVerificationsRequestValidationError{}
+
+// Validate checks the field values on ListScheduledVerificationsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListScheduledVerificationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListScheduledVerificationsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ListScheduledVerificationsResponseMultiError, or nil if none found.
+func (m *ListScheduledVerificationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListScheduledVerificationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetScheduledVerifications() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListScheduledVerificationsResponseValidationError{
+						field:  fmt.Sprintf("ScheduledVerifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListScheduledVerificationsResponseValidationError{
+						field:  fmt.Sprintf("ScheduledVerifications[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListScheduledVerificationsResponseValidationError{
+					field:  fmt.Sprintf("ScheduledVerifications[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListScheduledVerificationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListScheduledVerificationsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListScheduledVerificationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListScheduledVerificationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListScheduledVerificationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListScheduledVerificationsResponseMultiError) AllErrors() []error { return m }
+
+// ListScheduledVerificationsResponseValidationError is the validation error
+// returned by ListScheduledVerificationsResponse.Validate if the designated
+// constraints aren't met.
+type ListScheduledVerificationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListScheduledVerificationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListScheduledVerificationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListScheduledVerificationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListScheduledVerificationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListScheduledVerificationsResponseValidationError) ErrorName() string {
+	return "ListScheduledVerificationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListScheduledVerificationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListScheduledVerificationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ListScheduledVerificationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListScheduledVerificationsResponseValidationError{}
+
+// Validate checks the field values on RemoveScheduledVerificationRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveScheduledVerificationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveScheduledVerificationRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveScheduledVerificationRequestMultiError, or nil if none found.
+func (m *RemoveScheduledVerificationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveScheduledVerificationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetScheduledVerificationId()) < 1 {
+		err := RemoveScheduledVerificationRequestValidationError{
+			field:  "ScheduledVerificationId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveScheduledVerificationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveScheduledVerificationRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveScheduledVerificationRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveScheduledVerificationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveScheduledVerificationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveScheduledVerificationRequestMultiError) AllErrors() []error { return m }
+
+// RemoveScheduledVerificationRequestValidationError is the validation error
+// returned by RemoveScheduledVerificationRequest.Validate if the designated
+// constraints aren't met.
+type RemoveScheduledVerificationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveScheduledVerificationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveScheduledVerificationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveScheduledVerificationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveScheduledVerificationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveScheduledVerificationRequestValidationError) ErrorName() string {
+	return "RemoveScheduledVerificationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveScheduledVerificationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveScheduledVerificationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = RemoveScheduledVerificationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveScheduledVerificationRequestValidationError{}
+
+// Validate checks the field values on RemoveScheduledVerificationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *RemoveScheduledVerificationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveScheduledVerificationResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// RemoveScheduledVerificationResponseMultiError, or nil if none found.
+func (m *RemoveScheduledVerificationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveScheduledVerificationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveScheduledVerificationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveScheduledVerificationResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// RemoveScheduledVerificationResponse.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveScheduledVerificationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveScheduledVerificationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveScheduledVerificationResponseMultiError) AllErrors() []error { return m }
+
+// RemoveScheduledVerificationResponseValidationError is the validation error
+// returned by RemoveScheduledVerificationResponse.Validate if the designated
+// constraints aren't met.
+type RemoveScheduledVerificationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveScheduledVerificationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveScheduledVerificationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveScheduledVerificationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveScheduledVerificationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveScheduledVerificationResponseValidationError) ErrorName() string {
+	return "RemoveScheduledVerificationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveScheduledVerificationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveScheduledVerificationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = RemoveScheduledVerificationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveScheduledVerificationResponseValidationError{}
@@ -29,6 +29,10 @@ const (
 	BackupService_GetLogs_FullMethodName                        = "/backup.v1.BackupService/GetLogs"
 	BackupService_ListArtifacts_FullMethodName                  = "/backup.v1.BackupService/ListArtifacts"
 	BackupService_DeleteArtifact_FullMethodName                 = "/backup.v1.BackupService/DeleteArtifact"
+	BackupService_VerifyArtifact_FullMethodName                 = "/backup.v1.BackupService/VerifyArtifact"
+	BackupService_ScheduleVerification_FullMethodName           = "/backup.v1.BackupService/ScheduleVerification"
+	BackupService_ListScheduledVerifications_FullMethodName     = "/backup.v1.BackupService/ListScheduledVerifications"
+	BackupService_RemoveScheduledVerification_FullMethodName    = "/backup.v1.BackupService/RemoveScheduledVerification"
 	BackupService_ListPitrTimeranges_FullMethodName             = "/backup.v1.BackupService/ListPitrTimeranges"
 )
 
@@ -56,6 +60,14 @@ type BackupServiceClient interface {
 	ListArtifacts(ctx context.Context, in *ListArtifactsRequest, opts ...grpc.CallOption) (*ListArtifactsResponse, error)
 	// DeleteArtifact deletes specified artifact.
 	DeleteArtifact(ctx context.Context, in *DeleteArtifactRequest, opts ...grpc.CallOption) (*DeleteArtifactResponse, error)
+	// VerifyArtifact starts verification of specified artifact.
+	VerifyArtifact(ctx context.Context, in *VerifyArtifactRequest, opts ...grpc.CallOption) (*VerifyArtifactResponse, error)
+	// ScheduleVerification schedules repeated verification of the latest artifact of a service.
+	ScheduleVerification(ctx context.Context, in *ScheduleVerificationRequest, opts ...grpc.CallOption) (*ScheduleVerificationResponse, error)
+	// ListScheduledVerifications returns all scheduled verifications.
+	ListScheduledVerifications(ctx context.Context, in *ListScheduledVerificationsRequest, opts ...grpc.CallOption) (*ListScheduledVerificationsResponse, error)
+	// RemoveScheduledVerification removes existing scheduled verification.
+	RemoveScheduledVerification(ctx context.Context, in *RemoveScheduledVerificationRequest, opts ...grpc.CallOption) (*RemoveScheduledVerificationResponse, error)
 	// ListPitrTimeranges list the available MongoDB PITR timeranges in a given backup location
 	ListPitrTimeranges(ctx context.Context, in *ListPitrTimerangesRequest, opts ...grpc.CallOption) (*ListPitrTimerangesResponse, error)
 }
@@ -158,6 +170,46 @@ func (c *backupServiceClient) DeleteArtifact(ctx context.Context, in *DeleteArti
 	return out, nil
 }
 
+func (c *backupServiceClient) VerifyArtifact(ctx context.Context, in *VerifyArtifactRequest, opts ...grpc.CallOption) (*VerifyArtifactResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyArtifactResponse)
+	err := c.cc.Invoke(ctx, BackupService_VerifyArtifact_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backupServiceClient) ScheduleVerification(ctx context.Context, in *ScheduleVerificationRequest, opts ...grpc.CallOption) (*ScheduleVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ScheduleVerificationResponse)
+	err := c.cc.Invoke(ctx, BackupService_ScheduleVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backupServiceClient) ListScheduledVerifications(ctx context.Context, in *ListScheduledVerificationsRequest, opts ...grpc.CallOption) (*ListScheduledVerificationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListScheduledVerificationsResponse)
+	err := c.cc.Invoke(ctx, BackupService_ListScheduledVerifications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *backupServiceClient) RemoveScheduledVerification(ctx context.Context, in *RemoveScheduledVerificationRequest, opts ...grpc.CallOption) (*RemoveScheduledVerificationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveScheduledVerificationResponse)
+	err := c.cc.Invoke(ctx, BackupService_RemoveScheduledVerification_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *backupServiceClient) ListPitrTimeranges(ctx context.Context, in *ListPitrTimerangesRequest, opts ...grpc.CallOption) (*ListPitrTimerangesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListPitrTimerangesResponse)
@@ -192,6 +244,14 @@ type BackupServiceServer interface {
 	ListArtifacts(context.Context, *ListArtifactsRequest) (*ListArtifactsResponse, error)
 	// DeleteArtifact deletes specified artifact.
 	DeleteArtifact(context.Context, *DeleteArtifactRequest) (*DeleteArtifactResponse, error)
+	// VerifyArtifact starts verification of specified artifact.
+	VerifyArtifact(context.Context, *VerifyArtifactRequest) (*VerifyArtifactResponse, error)
+	// ScheduleVerification schedules repeated verification of the latest artifact of a service.
+	ScheduleVerification(context.Context, *ScheduleVerificationRequest) (*ScheduleVerificationResponse, error)
+	// ListScheduledVerifications returns all scheduled verifications.
+	ListScheduledVerifications(context.Context, *ListScheduledVerificationsRequest) (*ListScheduledVerificationsResponse, error)
+	// RemoveScheduledVerification removes existing scheduled verification.
+	RemoveScheduledVerification(context.Context, *RemoveScheduledVerificationRequest) (*RemoveScheduledVerificationResponse, error)
 	// ListPitrTimeranges list the available MongoDB PITR timeranges in a given backup location
 	ListPitrTimeranges(context.Context, *ListPitrTimerangesRequest) (*ListPitrTimerangesResponse, error)
 	mustEmbedUnimplementedBackupServiceServer()
@@ -240,6 +300,22 @@ func (UnimplementedBackupServiceServer) DeleteArtifact(context.Context, *DeleteA
 	return nil, status.Error(codes.Unimplemented, "method DeleteArtifact not implemented")
 }
 
+func (UnimplementedBackupServiceServer) VerifyArtifact(context.Context, *VerifyArtifactRequest) (*VerifyArtifactResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyArtifact not implemented")
+}
+
+func (UnimplementedBackupServiceServer) ScheduleVerification(context.Context, *ScheduleVerificationRequest) (*ScheduleVerificationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ScheduleVerification not implemented")
+}
+
+func (UnimplementedBackupServiceServer) ListScheduledVerifications(context.Context, *ListScheduledVerificationsRequest) (*ListScheduledVerificationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListScheduledVerifications not implemented")
+}
+
+func (UnimplementedBackupServiceServer) RemoveScheduledVerification(context.Context, *RemoveScheduledVerificationRequest) (*RemoveScheduledVerificationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveScheduledVerification not implemented")
+}
+
 func (UnimplementedBackupServiceServer) ListPitrTimeranges(context.Context, *ListPitrTimerangesRequest) (*ListPitrTimerangesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListPitrTimeranges not implemented")
 }
@@ -426,6 +502,78 @@ func _BackupService_DeleteArtifact_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BackupService_VerifyArtifact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyArtifactRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupServiceServer).VerifyArtifact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BackupService_VerifyArtifact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupServiceServer).VerifyArtifact(ctx, req.(*VerifyArtifactRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackupService_ScheduleVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupServiceServer).ScheduleVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BackupService_ScheduleVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupServiceServer).ScheduleVerification(ctx, req.(*ScheduleVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackupService_ListScheduledVerifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledVerificationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupServiceServer).ListScheduledVerifications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BackupService_ListScheduledVerifications_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupServiceServer).ListScheduledVerifications(ctx, req.(*ListScheduledVerificationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BackupService_RemoveScheduledVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveScheduledVerificationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BackupServiceServer).RemoveScheduledVerification(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: BackupService_RemoveScheduledVerification_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BackupServiceServer).RemoveScheduledVerification(ctx, req.(*RemoveScheduledVerificationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _BackupService_ListPitrTimeranges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListPitrTimerangesRequest)
 	if err := dec(in); err != nil {
@@ -487,6 +635,22 @@ var BackupService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteArtifact",
 			Handler:    _BackupService_DeleteArtifact_Handler,
 		},
+		{
+			MethodName: "VerifyArtifact",
+			Handler:    _BackupService_VerifyArtifact_Handler,
+		},
+		{
+			MethodName: "ScheduleVerification",
+			Handler:    _BackupService_ScheduleVerification_Handler,
+		},
+		{
+			MethodName: "ListScheduledVerifications",
+			Handler:    _BackupService_ListScheduledVerifications_Handler,
+		},
+		{
+			MethodName: "RemoveScheduledVerification",
+			Handler:    _BackupService_RemoveScheduledVerification_Handler,
+		},
 		{
 			MethodName: "ListPitrTimeranges",
 			Handler:    _BackupService_ListPitrTimeranges_Handler,
@@ -38,6 +38,8 @@ const (
 	ErrorCode_ERROR_CODE_INCOMPATIBLE_TARGET_MYSQL ErrorCode = 4
 	// ERROR_CODE_INCOMPATIBLE_TARGET_MONGODB is returned if target version of MongoDB is not compatible for restoring selected artifact.
 	ErrorCode_ERROR_CODE_INCOMPATIBLE_TARGET_MONGODB ErrorCode = 5
+	// ERROR_CODE_INCOMPATIBLE_TARGET_POSTGRESQL is returned if target version of PostgreSQL is not compatible for restoring selected artifact.
+	ErrorCode_ERROR_CODE_INCOMPATIBLE_TARGET_POSTGRESQL ErrorCode = 6
 )
 
 // Enum value maps for ErrorCode.
@@ -49,14 +51,16 @@ var (
 		3: "ERROR_CODE_INCOMPATIBLE_XTRABACKUP",
 		4: "ERROR_CODE_INCOMPATIBLE_TARGET_MYSQL",
 		5: "ERROR_CODE_INCOMPATIBLE_TARGET_MONGODB",
+		6: "ERROR_CODE_INCOMPATIBLE_TARGET_POSTGRESQL",
 	}
 	ErrorCode_value = map[string]int32{
-		"ERROR_CODE_UNSPECIFIED":                 0,
-		"ERROR_CODE_XTRABACKUP_NOT_INSTALLED":    1,
-		"ERROR_CODE_INVALID_XTRABACKUP":          2,
-		"ERROR_CODE_INCOMPATIBLE_XTRABACKUP":     3,
-		"ERROR_CODE_INCOMPATIBLE_TARGET_MYSQL":   4,
-		"ERROR_CODE_INCOMPATIBLE_TARGET_MONGODB": 5,
+		"ERROR_CODE_UNSPECIFIED":                    0,
+		"ERROR_CODE_XTRABACKUP_NOT_INSTALLED":       1,
+		"ERROR_CODE_INVALID_XTRABACKUP":             2,
+		"ERROR_CODE_INCOMPATIBLE_XTRABACKUP":        3,
+		"ERROR_CODE_INCOMPATIBLE_TARGET_MYSQL":      4,
+		"ERROR_CODE_INCOMPATIBLE_TARGET_MONGODB":    5,
+		"ERROR_CODE_INCOMPATIBLE_TARGET_POSTGRESQL": 6,
 	}
 )
 
@@ -138,14 +142,15 @@ const file_backup_v1_errors_proto_rawDesc = "" +
 	"\n" +
 	"\x16backup/v1/errors.proto\x12\tbackup.v1\"1\n" +
 	"\x05Error\x12(\n" +
-	"\x04code\x18\x01 \x01(\x0e2\x14.backup.v1.ErrorCodeR\x04code*\xf1\x01\n" +
+	"\x04code\x18\x01 \x01(\x0e2\x14.backup.v1.ErrorCodeR\x04code*\xa0\x02\n" +
 	"\tErrorCode\x12\x1a\n" +
 	"\x16ERROR_CODE_UNSPECIFIED\x10\x00\x12'\n" +
 	"#ERROR_CODE_XTRABACKUP_NOT_INSTALLED\x10\x01\x12!\n" +
 	"\x1dERROR_CODE_INVALID_XTRABACKUP\x10\x02\x12&\n" +
 	"\"ERROR_CODE_INCOMPATIBLE_XTRABACKUP\x10\x03\x12(\n" +
 	"$ERROR_CODE_INCOMPATIBLE_TARGET_MYSQL\x10\x04\x12*\n" +
-	"&ERROR_CODE_INCOMPATIBLE_TARGET_MONGODB\x10\x05B\x90\x01\n" +
+	"&ERROR_CODE_INCOMPATIBLE_TARGET_MONGODB\x10\x05\x12-\n" +
+	")ERROR_CODE_INCOMPATIBLE_TARGET_POSTGRESQL\x10\x06B\x90\x01\n" +
 	"\rcom.backup.v1B\vErrorsProtoP\x01Z-github.com/percona/pmm/api/backup/v1;backupv1\xa2\x02\x03BXX\xaa\x02\tBackup.V1\xca\x02\tBackup\\V1\xe2\x02\x15Backup\\V1\\GPBMetadata\xea\x02\n" +
 	"Backup::V1b\x06proto3"
 
@@ -65,12 +65,20 @@ type ClientService interface {
 
 	ListScheduledBackups(params *ListScheduledBackupsParams, opts ...ClientOption) (*ListScheduledBackupsOK, error)
 
+	ListScheduledVerifications(params *ListScheduledVerificationsParams, opts ...ClientOption) (*ListScheduledVerificationsOK, error)
+
 	RemoveScheduledBackup(params *RemoveScheduledBackupParams, opts ...ClientOption) (*RemoveScheduledBackupOK, error)
 
+	RemoveScheduledVerification(params *RemoveScheduledVerificationParams, opts ...ClientOption) (*RemoveScheduledVerificationOK, error)
+
 	ScheduleBackup(params *ScheduleBackupParams, opts ...ClientOption) (*ScheduleBackupOK, error)
 
+	ScheduleVerification(params *ScheduleVerificationParams, opts ...ClientOption) (*ScheduleVerificationOK, error)
+
 	StartBackup(params *StartBackupParams, opts ...ClientOption) (*StartBackupOK, error)
 
+	VerifyArtifact(params *VerifyArtifactParams, opts ...ClientOption) (*VerifyArtifactOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
@@ -382,6 +390,50 @@ func (a *Client) ListScheduledBackups(params *ListScheduledBackupsParams, opts .
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+ListScheduledVerifications lists scheduled verifications
+
+List all scheduled artifact verifications.
+*/
+func (a *Client) ListScheduledVerifications(params *ListScheduledVerificationsParams, opts ...ClientOption) (*ListScheduledVerificationsOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewListScheduledVerificationsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ListScheduledVerifications",
+		Method:             "GET",
+		PathPattern:        "/v1/backups/verifications/scheduled",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListScheduledVerificationsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ListScheduledVerificationsOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*ListScheduledVerificationsDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 RemoveScheduledBackup removes a scheduled backup
 
@@ -426,6 +478,50 @@ func (a *Client) RemoveScheduledBackup(params *RemoveScheduledBackupParams, opts
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+RemoveScheduledVerification removes a scheduled verification
+
+Remove a scheduled artifact verification.
+*/
+func (a *Client) RemoveScheduledVerification(params *RemoveScheduledVerificationParams, opts ...ClientOption) (*RemoveScheduledVerificationOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewRemoveScheduledVerificationParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RemoveScheduledVerification",
+		Method:             "DELETE",
+		PathPattern:        "/v1/backups/verifications/{scheduled_verification_id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RemoveScheduledVerificationReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*RemoveScheduledVerificationOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*RemoveScheduledVerificationDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 ScheduleBackup schedules a backup
 
@@ -470,6 +566,50 @@ func (a *Client) ScheduleBackup(params *ScheduleBackupParams, opts ...ClientOpti
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+ScheduleVerification schedules a verification
+
+Schedule verification of the latest successful artifact of a service in a location.
+*/
+func (a *Client) ScheduleVerification(params *ScheduleVerificationParams, opts ...ClientOption) (*ScheduleVerificationOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewScheduleVerificationParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ScheduleVerification",
+		Method:             "POST",
+		PathPattern:        "/v1/backups/verifications:schedule",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ScheduleVerificationReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ScheduleVerificationOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*ScheduleVerificationDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 	StartBackup starts a backup
 
@@ -518,6 +658,50 @@ func (a *Client) StartBackup(params *StartBackupParams, opts ...ClientOption) (*
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+VerifyArtifact verifies artifact
+
+Starts a job that checks that the artifact can actually be restored. The result is reported in the artifact verification status.
+*/
+func (a *Client) VerifyArtifact(params *VerifyArtifactParams, opts ...ClientOption) (*VerifyArtifactOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewVerifyArtifactParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "VerifyArtifact",
+		Method:             "POST",
+		PathPattern:        "/v1/backups/artifacts/{artifact_id}:verify",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &VerifyArtifactReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*VerifyArtifactOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*VerifyArtifactDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
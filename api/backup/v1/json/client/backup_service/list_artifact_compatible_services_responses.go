@@ -422,6 +422,9 @@ type ListArtifactCompatibleServicesOKBody struct {
 
 	// mongodb
 	Mongodb []*ListArtifactCompatibleServicesOKBodyMongodbItems0 `json:"mongodb"`
+
+	// postgresql
+	Postgresql []*ListArtifactCompatibleServicesOKBodyPostgresqlItems0 `json:"postgresql"`
 }
 
 // Validate validates this list artifact compatible services OK body
@@ -436,6 +439,10 @@ func (o *ListArtifactCompatibleServicesOKBody) Validate(formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := o.validatePostgresql(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -502,6 +509,36 @@ func (o *ListArtifactCompatibleServicesOKBody) validateMongodb(formats strfmt.Re
 	return nil
 }
 
+func (o *ListArtifactCompatibleServicesOKBody) validatePostgresql(formats strfmt.Registry) error {
+	if swag.IsZero(o.Postgresql) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Postgresql); i++ {
+		if swag.IsZero(o.Postgresql[i]) { // not required
+			continue
+		}
+
+		if o.Postgresql[i] != nil {
+			if err := o.Postgresql[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("listArtifactCompatibleServicesOk" + "." + "postgresql" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("listArtifactCompatibleServicesOk" + "." + "postgresql" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this list artifact compatible services OK body based on the context it is used
 func (o *ListArtifactCompatibleServicesOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -514,6 +551,10 @@ func (o *ListArtifactCompatibleServicesOKBody) ContextValidate(ctx context.Conte
 		res = append(res, err)
 	}
 
+	if err := o.contextValidatePostgresql(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -572,6 +613,32 @@ func (o *ListArtifactCompatibleServicesOKBody) contextValidateMongodb(ctx contex
 	return nil
 }
 
+func (o *ListArtifactCompatibleServicesOKBody) contextValidatePostgresql(ctx context.Context, formats strfmt.Registry) error {
+	for i := 0; i < len(o.Postgresql); i++ {
+		if o.Postgresql[i] != nil {
+
+			if swag.IsZero(o.Postgresql[i]) { // not required
+				return nil
+			}
+
+			if err := o.Postgresql[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("listArtifactCompatibleServicesOk" + "." + "postgresql" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("listArtifactCompatibleServicesOk" + "." + "postgresql" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (o *ListArtifactCompatibleServicesOKBody) MarshalBinary() ([]byte, error) {
 	if o == nil {
@@ -732,3 +799,79 @@ func (o *ListArtifactCompatibleServicesOKBodyMysqlItems0) UnmarshalBinary(b []by
 	*o = res
 	return nil
 }
+
+/*
+ListArtifactCompatibleServicesOKBodyPostgresqlItems0 PostgreSQLService represents a generic PostgreSQL instance.
+swagger:model ListArtifactCompatibleServicesOKBodyPostgresqlItems0
+*/
+type ListArtifactCompatibleServicesOKBodyPostgresqlItems0 struct {
+	// Unique randomly generated instance identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// Unique across all Services user-defined name.
+	ServiceName string `json:"service_name,omitempty"`
+
+	// Database name.
+	DatabaseName string `json:"database_name,omitempty"`
+
+	// Node identifier where this instance runs.
+	NodeID string `json:"node_id,omitempty"`
+
+	// Access address (DNS name or IP).
+	// Address (and port) or socket is required.
+	Address string `json:"address,omitempty"`
+
+	// Access port.
+	// Port is required when the address present.
+	Port int64 `json:"port,omitempty"`
+
+	// Access unix socket.
+	// Address (and port) or socket is required.
+	Socket string `json:"socket,omitempty"`
+
+	// Environment name.
+	Environment string `json:"environment,omitempty"`
+
+	// Cluster name.
+	Cluster string `json:"cluster,omitempty"`
+
+	// Replication set name.
+	ReplicationSet string `json:"replication_set,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// PostgreSQL version.
+	Version string `json:"version,omitempty"`
+
+	// Limit of databases for auto-discovery.
+	AutoDiscoveryLimit int32 `json:"auto_discovery_limit,omitempty"`
+}
+
+// Validate validates this list artifact compatible services OK body postgresql items0
+func (o *ListArtifactCompatibleServicesOKBodyPostgresqlItems0) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this list artifact compatible services OK body postgresql items0 based on context it is used
+func (o *ListArtifactCompatibleServicesOKBodyPostgresqlItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ListArtifactCompatibleServicesOKBodyPostgresqlItems0) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ListArtifactCompatibleServicesOKBodyPostgresqlItems0) UnmarshalBinary(b []byte) error {
+	var res ListArtifactCompatibleServicesOKBodyPostgresqlItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -574,6 +574,14 @@ type ListArtifactsOKBodyArtifactsItems0 struct {
 
 	// List of artifact metadata.
 	MetadataList []*ListArtifactsOKBodyArtifactsItems0MetadataListItems0 `json:"metadata_list"`
+
+	// VerificationStatus shows the result of the latest artifact verification.
+	// Enum: ["VERIFICATION_STATUS_UNSPECIFIED","VERIFICATION_STATUS_PENDING","VERIFICATION_STATUS_VERIFIED","VERIFICATION_STATUS_FAILED"]
+	VerificationStatus *string `json:"verification_status,omitempty"`
+
+	// Time when the latest artifact verification has finished.
+	// Format: date-time
+	LastVerifiedAt strfmt.DateTime `json:"last_verified_at,omitempty"`
 }
 
 // Validate validates this list artifacts OK body artifacts items0
@@ -600,6 +608,14 @@ func (o *ListArtifactsOKBodyArtifactsItems0) Validate(formats strfmt.Registry) e
 		res = append(res, err)
 	}
 
+	if err := o.validateVerificationStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLastVerifiedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -804,6 +820,66 @@ func (o *ListArtifactsOKBodyArtifactsItems0) validateMetadataList(formats strfmt
 	return nil
 }
 
+var listArtifactsOkBodyArtifactsItems0TypeVerificationStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["VERIFICATION_STATUS_UNSPECIFIED","VERIFICATION_STATUS_PENDING","VERIFICATION_STATUS_VERIFIED","VERIFICATION_STATUS_FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		listArtifactsOkBodyArtifactsItems0TypeVerificationStatusPropEnum = append(listArtifactsOkBodyArtifactsItems0TypeVerificationStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ListArtifactsOKBodyArtifactsItems0VerificationStatusVERIFICATIONSTATUSUNSPECIFIED captures enum value "VERIFICATION_STATUS_UNSPECIFIED"
+	ListArtifactsOKBodyArtifactsItems0VerificationStatusVERIFICATIONSTATUSUNSPECIFIED string = "VERIFICATION_STATUS_UNSPECIFIED"
+
+	// ListArtifactsOKBodyArtifactsItems0VerificationStatusVERIFICATIONSTATUSPENDING captures enum value "VERIFICATION_STATUS_PENDING"
+	ListArtifactsOKBodyArtifactsItems0VerificationStatusVERIFICATIONSTATUSPENDING string = "VERIFICATION_STATUS_PENDING"
+
+	// ListArtifactsOKBodyArtifactsItems0VerificationStatusVERIFICATIONSTATUSVERIFIED captures enum value "VERIFICATION_STATUS_VERIFIED"
+	ListArtifactsOKBodyArtifactsItems0VerificationStatusVERIFICATIONSTATUSVERIFIED string = "VERIFICATION_STATUS_VERIFIED"
+
+	// ListArtifactsOKBodyArtifactsItems0VerificationStatusVERIFICATIONSTATUSFAILED captures enum value "VERIFICATION_STATUS_FAILED"
+	ListArtifactsOKBodyArtifactsItems0VerificationStatusVERIFICATIONSTATUSFAILED string = "VERIFICATION_STATUS_FAILED"
+)
+
+// prop value enum
+func (o *ListArtifactsOKBodyArtifactsItems0) validateVerificationStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, listArtifactsOkBodyArtifactsItems0TypeVerificationStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ListArtifactsOKBodyArtifactsItems0) validateVerificationStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.VerificationStatus) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateVerificationStatusEnum("verification_status", "body", *o.VerificationStatus); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ListArtifactsOKBodyArtifactsItems0) validateLastVerifiedAt(formats strfmt.Registry) error {
+	if swag.IsZero(o.LastVerifiedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_verified_at", "body", "date-time", o.LastVerifiedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validate this list artifacts OK body artifacts items0 based on the context it is used
 func (o *ListArtifactsOKBodyArtifactsItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -38,8 +38,13 @@ func (*QANMongoDBProfilerAgent) sealedAgent()         {}
 func (*QANMongoDBMongologAgent) sealedAgent()         {}
 func (*QANPostgreSQLPgStatementsAgent) sealedAgent()  {}
 func (*QANPostgreSQLPgStatMonitorAgent) sealedAgent() {}
+func (*QANPostgreSQLLogAgent) sealedAgent()           {}
 func (*RDSExporter) sealedAgent()                     {}
 func (*ExternalExporter) sealedAgent()                {}
 func (*AzureDatabaseExporter) sealedAgent()           {}
 func (*ValkeyExporter) sealedAgent()                  {}
 func (*RTAMongoDBAgent) sealedAgent()                 {}
+func (*RTAMySQLAgent) sealedAgent()                   {}
+func (*RTAPostgreSQLAgent) sealedAgent()              {}
+func (*QANValkeySlowlogAgent) sealedAgent()           {}
+func (*QANProxySQLDigestAgent) sealedAgent()          {}
@@ -53,6 +53,11 @@ const (
 	AgentType_AGENT_TYPE_AZURE_DATABASE_EXPORTER            AgentType = 15
 	AgentType_AGENT_TYPE_NOMAD_AGENT                        AgentType = 16
 	AgentType_AGENT_TYPE_RTA_MONGODB_AGENT                  AgentType = 19
+	AgentType_AGENT_TYPE_RTA_MYSQL_AGENT                    AgentType = 20
+	AgentType_AGENT_TYPE_RTA_POSTGRESQL_AGENT               AgentType = 21
+	AgentType_AGENT_TYPE_QAN_VALKEY_SLOWLOG_AGENT           AgentType = 22
+	AgentType_AGENT_TYPE_QAN_PROXYSQL_DIGEST_AGENT          AgentType = 23
+	AgentType_AGENT_TYPE_QAN_POSTGRESQL_LOG_AGENT           AgentType = 24
 )
 
 // Enum value maps for AgentType.
@@ -78,6 +83,11 @@ var (
 		15: "AGENT_TYPE_AZURE_DATABASE_EXPORTER",
 		16: "AGENT_TYPE_NOMAD_AGENT",
 		19: "AGENT_TYPE_RTA_MONGODB_AGENT",
+		20: "AGENT_TYPE_RTA_MYSQL_AGENT",
+		21: "AGENT_TYPE_RTA_POSTGRESQL_AGENT",
+		22: "AGENT_TYPE_QAN_VALKEY_SLOWLOG_AGENT",
+		23: "AGENT_TYPE_QAN_PROXYSQL_DIGEST_AGENT",
+		24: "AGENT_TYPE_QAN_POSTGRESQL_LOG_AGENT",
 	}
 	AgentType_value = map[string]int32{
 		"AGENT_TYPE_UNSPECIFIED":                        0,
@@ -100,6 +110,11 @@ var (
 		"AGENT_TYPE_AZURE_DATABASE_EXPORTER":            15,
 		"AGENT_TYPE_NOMAD_AGENT":                        16,
 		"AGENT_TYPE_RTA_MONGODB_AGENT":                  19,
+		"AGENT_TYPE_RTA_MYSQL_AGENT":                    20,
+		"AGENT_TYPE_RTA_POSTGRESQL_AGENT":               21,
+		"AGENT_TYPE_QAN_VALKEY_SLOWLOG_AGENT":           22,
+		"AGENT_TYPE_QAN_PROXYSQL_DIGEST_AGENT":          23,
+		"AGENT_TYPE_QAN_POSTGRESQL_LOG_AGENT":           24,
 	}
 )
 
@@ -2298,56 +2313,10 @@ func (x *QANMongoDBMongologAgent) GetLogLevel() LogLevel {
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-// RTAOptions holds Real-Time Query Analytics agent options.
-type RTAOptions struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Query collect interval (default 2s is set by server).
-	CollectInterval *durationpb.Duration `protobuf:"bytes,1,opt,name=collect_interval,json=collectInterval,proto3" json:"collect_interval,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
-}
-
-func (x *RTAOptions) Reset() {
-	*x = RTAOptions{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[13]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *RTAOptions) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*RTAOptions) ProtoMessage() {}
-
-func (x *RTAOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[13]
-	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use RTAOptions.ProtoReflect.Descriptor instead.
-func (*RTAOptions) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{13}
-}
-
-func (x *RTAOptions) GetCollectInterval() *durationpb.Duration {
-	if x != nil {
-		return x.CollectInterval
-	}
-	return nil
-}
-
-// RTAMongoDBAgent runs within pmm-agent and sends MongoDB Real-Time Query Analytics data to the PMM Server.
-type RTAMongoDBAgent struct {
+// QANValkeySlowlogAgent runs within pmm-agent and sends Valkey Query Analytics data to the PMM Server.
+type QANValkeySlowlogAgent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique agent identifier.
+	// Unique randomly generated instance identifier.
 	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 	// The pmm-agent identifier which runs this instance.
 	PmmAgentId string `protobuf:"bytes,2,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
@@ -2355,39 +2324,43 @@ type RTAMongoDBAgent struct {
 	Disabled bool `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
 	// Service identifier.
 	ServiceId string `protobuf:"bytes,4,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// MongoDB username for getting profiler data.
+	// Valkey username for getting slow log data.
 	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
 	// Use TLS for database connections.
 	Tls bool `protobuf:"varint,6,opt,name=tls,proto3" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
 	TlsSkipVerify bool `protobuf:"varint,7,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `protobuf:"varint,8,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
+	// True if query examples are disabled.
+	QueryExamplesDisabled bool `protobuf:"varint,9,opt,name=query_examples_disabled,json=queryExamplesDisabled,proto3" json:"query_examples_disabled,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,8,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Real-Time Analytics options.
-	RtaOptions *RTAOptions `protobuf:"bytes,9,opt,name=rta_options,json=rtaOptions,proto3" json:"rta_options,omitempty"`
+	CustomLabels map[string]string `protobuf:"bytes,10,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Actual Agent status.
-	Status AgentStatus `protobuf:"varint,10,opt,name=status,proto3,enum=inventory.v1.AgentStatus" json:"status,omitempty"`
+	Status AgentStatus `protobuf:"varint,20,opt,name=status,proto3,enum=inventory.v1.AgentStatus" json:"status,omitempty"`
+	// Path to exec process.
+	ProcessExecPath string `protobuf:"bytes,21,opt,name=process_exec_path,json=processExecPath,proto3" json:"process_exec_path,omitempty"`
 	// Log level for exporter.
-	LogLevel      LogLevel `protobuf:"varint,11,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	LogLevel      LogLevel `protobuf:"varint,22,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RTAMongoDBAgent) Reset() {
-	*x = RTAMongoDBAgent{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[14]
+func (x *QANValkeySlowlogAgent) Reset() {
+	*x = QANValkeySlowlogAgent{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RTAMongoDBAgent) String() string {
+func (x *QANValkeySlowlogAgent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RTAMongoDBAgent) ProtoMessage() {}
+func (*QANValkeySlowlogAgent) ProtoMessage() {}
 
-func (x *RTAMongoDBAgent) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[14]
+func (x *QANValkeySlowlogAgent) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2398,90 +2371,104 @@ func (x *RTAMongoDBAgent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RTAMongoDBAgent.ProtoReflect.Descriptor instead.
-func (*RTAMongoDBAgent) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use QANValkeySlowlogAgent.ProtoReflect.Descriptor instead.
+func (*QANValkeySlowlogAgent) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *RTAMongoDBAgent) GetAgentId() string {
+func (x *QANValkeySlowlogAgent) GetAgentId() string {
 	if x != nil {
 		return x.AgentId
 	}
 	return ""
 }
 
-func (x *RTAMongoDBAgent) GetPmmAgentId() string {
+func (x *QANValkeySlowlogAgent) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *RTAMongoDBAgent) GetDisabled() bool {
+func (x *QANValkeySlowlogAgent) GetDisabled() bool {
 	if x != nil {
 		return x.Disabled
 	}
 	return false
 }
 
-func (x *RTAMongoDBAgent) GetServiceId() string {
+func (x *QANValkeySlowlogAgent) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *RTAMongoDBAgent) GetUsername() string {
+func (x *QANValkeySlowlogAgent) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *RTAMongoDBAgent) GetTls() bool {
+func (x *QANValkeySlowlogAgent) GetTls() bool {
 	if x != nil {
 		return x.Tls
 	}
 	return false
 }
 
-func (x *RTAMongoDBAgent) GetTlsSkipVerify() bool {
+func (x *QANValkeySlowlogAgent) GetTlsSkipVerify() bool {
 	if x != nil {
 		return x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *RTAMongoDBAgent) GetCustomLabels() map[string]string {
+func (x *QANValkeySlowlogAgent) GetMaxQueryLength() int32 {
 	if x != nil {
-		return x.CustomLabels
+		return x.MaxQueryLength
 	}
-	return nil
+	return 0
 }
 
-func (x *RTAMongoDBAgent) GetRtaOptions() *RTAOptions {
+func (x *QANValkeySlowlogAgent) GetQueryExamplesDisabled() bool {
 	if x != nil {
-		return x.RtaOptions
+		return x.QueryExamplesDisabled
+	}
+	return false
+}
+
+func (x *QANValkeySlowlogAgent) GetCustomLabels() map[string]string {
+	if x != nil {
+		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *RTAMongoDBAgent) GetStatus() AgentStatus {
+func (x *QANValkeySlowlogAgent) GetStatus() AgentStatus {
 	if x != nil {
 		return x.Status
 	}
 	return AgentStatus_AGENT_STATUS_UNSPECIFIED
 }
 
-func (x *RTAMongoDBAgent) GetLogLevel() LogLevel {
+func (x *QANValkeySlowlogAgent) GetProcessExecPath() string {
+	if x != nil {
+		return x.ProcessExecPath
+	}
+	return ""
+}
+
+func (x *QANValkeySlowlogAgent) GetLogLevel() LogLevel {
 	if x != nil {
 		return x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-// QANPostgreSQLPgStatementsAgent runs within pmm-agent and sends PostgreSQL Query Analytics data to the PMM Server.
-type QANPostgreSQLPgStatementsAgent struct {
+// QANProxySQLDigestAgent runs within pmm-agent and sends ProxySQL Query Analytics data to the PMM Server.
+type QANProxySQLDigestAgent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Unique randomly generated instance identifier.
 	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
@@ -2491,18 +2478,16 @@ type QANPostgreSQLPgStatementsAgent struct {
 	Disabled bool `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
 	// Service identifier.
 	ServiceId string `protobuf:"bytes,4,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// PostgreSQL username for getting pg stat statements data.
+	// ProxySQL username for getting query digest data.
 	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing bool `protobuf:"varint,6,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3" json:"disable_comments_parsing,omitempty"`
-	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength int32 `protobuf:"varint,7,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
 	// Use TLS for database connections.
-	Tls bool `protobuf:"varint,8,opt,name=tls,proto3" json:"tls,omitempty"`
+	Tls bool `protobuf:"varint,6,opt,name=tls,proto3" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
-	TlsSkipVerify bool `protobuf:"varint,9,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	TlsSkipVerify bool `protobuf:"varint,7,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `protobuf:"varint,8,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,10,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CustomLabels map[string]string `protobuf:"bytes,9,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Actual Agent status.
 	Status AgentStatus `protobuf:"varint,20,opt,name=status,proto3,enum=inventory.v1.AgentStatus" json:"status,omitempty"`
 	// Path to exec process.
@@ -2513,21 +2498,21 @@ type QANPostgreSQLPgStatementsAgent struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QANPostgreSQLPgStatementsAgent) Reset() {
-	*x = QANPostgreSQLPgStatementsAgent{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[15]
+func (x *QANProxySQLDigestAgent) Reset() {
+	*x = QANProxySQLDigestAgent{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QANPostgreSQLPgStatementsAgent) String() string {
+func (x *QANProxySQLDigestAgent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QANPostgreSQLPgStatementsAgent) ProtoMessage() {}
+func (*QANProxySQLDigestAgent) ProtoMessage() {}
 
-func (x *QANPostgreSQLPgStatementsAgent) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[15]
+func (x *QANProxySQLDigestAgent) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2538,106 +2523,145 @@ func (x *QANPostgreSQLPgStatementsAgent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QANPostgreSQLPgStatementsAgent.ProtoReflect.Descriptor instead.
-func (*QANPostgreSQLPgStatementsAgent) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use QANProxySQLDigestAgent.ProtoReflect.Descriptor instead.
+func (*QANProxySQLDigestAgent) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *QANPostgreSQLPgStatementsAgent) GetAgentId() string {
+func (x *QANProxySQLDigestAgent) GetAgentId() string {
 	if x != nil {
 		return x.AgentId
 	}
 	return ""
 }
 
-func (x *QANPostgreSQLPgStatementsAgent) GetPmmAgentId() string {
+func (x *QANProxySQLDigestAgent) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *QANPostgreSQLPgStatementsAgent) GetDisabled() bool {
+func (x *QANProxySQLDigestAgent) GetDisabled() bool {
 	if x != nil {
 		return x.Disabled
 	}
 	return false
 }
 
-func (x *QANPostgreSQLPgStatementsAgent) GetServiceId() string {
+func (x *QANProxySQLDigestAgent) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *QANPostgreSQLPgStatementsAgent) GetUsername() string {
+func (x *QANProxySQLDigestAgent) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *QANPostgreSQLPgStatementsAgent) GetDisableCommentsParsing() bool {
+func (x *QANProxySQLDigestAgent) GetTls() bool {
 	if x != nil {
-		return x.DisableCommentsParsing
+		return x.Tls
 	}
 	return false
 }
 
-func (x *QANPostgreSQLPgStatementsAgent) GetMaxQueryLength() int32 {
-	if x != nil {
-		return x.MaxQueryLength
-	}
-	return 0
-}
-
-func (x *QANPostgreSQLPgStatementsAgent) GetTls() bool {
+func (x *QANProxySQLDigestAgent) GetTlsSkipVerify() bool {
 	if x != nil {
-		return x.Tls
+		return x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *QANPostgreSQLPgStatementsAgent) GetTlsSkipVerify() bool {
+func (x *QANProxySQLDigestAgent) GetMaxQueryLength() int32 {
 	if x != nil {
-		return x.TlsSkipVerify
+		return x.MaxQueryLength
 	}
-	return false
+	return 0
 }
 
-func (x *QANPostgreSQLPgStatementsAgent) GetCustomLabels() map[string]string {
+func (x *QANProxySQLDigestAgent) GetCustomLabels() map[string]string {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *QANPostgreSQLPgStatementsAgent) GetStatus() AgentStatus {
+func (x *QANProxySQLDigestAgent) GetStatus() AgentStatus {
 	if x != nil {
 		return x.Status
 	}
 	return AgentStatus_AGENT_STATUS_UNSPECIFIED
 }
 
-func (x *QANPostgreSQLPgStatementsAgent) GetProcessExecPath() string {
+func (x *QANProxySQLDigestAgent) GetProcessExecPath() string {
 	if x != nil {
 		return x.ProcessExecPath
 	}
 	return ""
 }
 
-func (x *QANPostgreSQLPgStatementsAgent) GetLogLevel() LogLevel {
+func (x *QANProxySQLDigestAgent) GetLogLevel() LogLevel {
 	if x != nil {
 		return x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-// QANPostgreSQLPgStatMonitorAgent runs within pmm-agent and sends PostgreSQL Query Analytics data to the PMM Server.
-type QANPostgreSQLPgStatMonitorAgent struct {
+// RTAOptions holds Real-Time Query Analytics agent options.
+type RTAOptions struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique randomly generated instance identifier.
+	// Query collect interval (default 2s is set by server).
+	CollectInterval *durationpb.Duration `protobuf:"bytes,1,opt,name=collect_interval,json=collectInterval,proto3" json:"collect_interval,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RTAOptions) Reset() {
+	*x = RTAOptions{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RTAOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RTAOptions) ProtoMessage() {}
+
+func (x *RTAOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RTAOptions.ProtoReflect.Descriptor instead.
+func (*RTAOptions) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RTAOptions) GetCollectInterval() *durationpb.Duration {
+	if x != nil {
+		return x.CollectInterval
+	}
+	return nil
+}
+
+// RTAMongoDBAgent runs within pmm-agent and sends MongoDB Real-Time Query Analytics data to the PMM Server.
+type RTAMongoDBAgent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique agent identifier.
 	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 	// The pmm-agent identifier which runs this instance.
 	PmmAgentId string `protobuf:"bytes,2,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
@@ -2645,44 +2669,38 @@ type QANPostgreSQLPgStatMonitorAgent struct {
 	Disabled bool `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
 	// Service identifier.
 	ServiceId string `protobuf:"bytes,4,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// PostgreSQL username for getting pg stat monitor data.
+	// MongoDB username for getting profiler data.
 	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
 	// Use TLS for database connections.
 	Tls bool `protobuf:"varint,6,opt,name=tls,proto3" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
 	TlsSkipVerify bool `protobuf:"varint,7,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing bool `protobuf:"varint,8,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3" json:"disable_comments_parsing,omitempty"`
-	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength int32 `protobuf:"varint,9,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
-	// True if query examples are disabled.
-	QueryExamplesDisabled bool `protobuf:"varint,10,opt,name=query_examples_disabled,json=queryExamplesDisabled,proto3" json:"query_examples_disabled,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,11,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CustomLabels map[string]string `protobuf:"bytes,8,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Real-Time Analytics options.
+	RtaOptions *RTAOptions `protobuf:"bytes,9,opt,name=rta_options,json=rtaOptions,proto3" json:"rta_options,omitempty"`
 	// Actual Agent status.
-	Status AgentStatus `protobuf:"varint,20,opt,name=status,proto3,enum=inventory.v1.AgentStatus" json:"status,omitempty"`
-	// Path to exec process.
-	ProcessExecPath string `protobuf:"bytes,21,opt,name=process_exec_path,json=processExecPath,proto3" json:"process_exec_path,omitempty"`
+	Status AgentStatus `protobuf:"varint,10,opt,name=status,proto3,enum=inventory.v1.AgentStatus" json:"status,omitempty"`
 	// Log level for exporter.
-	LogLevel      LogLevel `protobuf:"varint,22,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	LogLevel      LogLevel `protobuf:"varint,11,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QANPostgreSQLPgStatMonitorAgent) Reset() {
-	*x = QANPostgreSQLPgStatMonitorAgent{}
+func (x *RTAMongoDBAgent) Reset() {
+	*x = RTAMongoDBAgent{}
 	mi := &file_inventory_v1_agents_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QANPostgreSQLPgStatMonitorAgent) String() string {
+func (x *RTAMongoDBAgent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QANPostgreSQLPgStatMonitorAgent) ProtoMessage() {}
+func (*RTAMongoDBAgent) ProtoMessage() {}
 
-func (x *QANPostgreSQLPgStatMonitorAgent) ProtoReflect() protoreflect.Message {
+func (x *RTAMongoDBAgent) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_v1_agents_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2694,160 +2712,131 @@ func (x *QANPostgreSQLPgStatMonitorAgent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QANPostgreSQLPgStatMonitorAgent.ProtoReflect.Descriptor instead.
-func (*QANPostgreSQLPgStatMonitorAgent) Descriptor() ([]byte, []int) {
+// Deprecated: Use RTAMongoDBAgent.ProtoReflect.Descriptor instead.
+func (*RTAMongoDBAgent) Descriptor() ([]byte, []int) {
 	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *QANPostgreSQLPgStatMonitorAgent) GetAgentId() string {
+func (x *RTAMongoDBAgent) GetAgentId() string {
 	if x != nil {
 		return x.AgentId
 	}
 	return ""
 }
 
-func (x *QANPostgreSQLPgStatMonitorAgent) GetPmmAgentId() string {
+func (x *RTAMongoDBAgent) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *QANPostgreSQLPgStatMonitorAgent) GetDisabled() bool {
+func (x *RTAMongoDBAgent) GetDisabled() bool {
 	if x != nil {
 		return x.Disabled
 	}
 	return false
 }
 
-func (x *QANPostgreSQLPgStatMonitorAgent) GetServiceId() string {
+func (x *RTAMongoDBAgent) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *QANPostgreSQLPgStatMonitorAgent) GetUsername() string {
+func (x *RTAMongoDBAgent) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *QANPostgreSQLPgStatMonitorAgent) GetTls() bool {
+func (x *RTAMongoDBAgent) GetTls() bool {
 	if x != nil {
 		return x.Tls
 	}
 	return false
 }
 
-func (x *QANPostgreSQLPgStatMonitorAgent) GetTlsSkipVerify() bool {
+func (x *RTAMongoDBAgent) GetTlsSkipVerify() bool {
 	if x != nil {
 		return x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *QANPostgreSQLPgStatMonitorAgent) GetDisableCommentsParsing() bool {
-	if x != nil {
-		return x.DisableCommentsParsing
-	}
-	return false
-}
-
-func (x *QANPostgreSQLPgStatMonitorAgent) GetMaxQueryLength() int32 {
-	if x != nil {
-		return x.MaxQueryLength
-	}
-	return 0
-}
-
-func (x *QANPostgreSQLPgStatMonitorAgent) GetQueryExamplesDisabled() bool {
+func (x *RTAMongoDBAgent) GetCustomLabels() map[string]string {
 	if x != nil {
-		return x.QueryExamplesDisabled
+		return x.CustomLabels
 	}
-	return false
+	return nil
 }
 
-func (x *QANPostgreSQLPgStatMonitorAgent) GetCustomLabels() map[string]string {
+func (x *RTAMongoDBAgent) GetRtaOptions() *RTAOptions {
 	if x != nil {
-		return x.CustomLabels
+		return x.RtaOptions
 	}
 	return nil
 }
 
-func (x *QANPostgreSQLPgStatMonitorAgent) GetStatus() AgentStatus {
+func (x *RTAMongoDBAgent) GetStatus() AgentStatus {
 	if x != nil {
 		return x.Status
 	}
 	return AgentStatus_AGENT_STATUS_UNSPECIFIED
 }
 
-func (x *QANPostgreSQLPgStatMonitorAgent) GetProcessExecPath() string {
-	if x != nil {
-		return x.ProcessExecPath
-	}
-	return ""
-}
-
-func (x *QANPostgreSQLPgStatMonitorAgent) GetLogLevel() LogLevel {
+func (x *RTAMongoDBAgent) GetLogLevel() LogLevel {
 	if x != nil {
 		return x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-// RDSExporter runs on Generic or Container Node and exposes RemoteRDS Node metrics.
-type RDSExporter struct {
+// RTAMySQLAgent runs within pmm-agent and sends MySQL Real-Time Query Analytics data to the PMM Server.
+type RTAMySQLAgent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique randomly generated instance identifier.
+	// Unique agent identifier.
 	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 	// The pmm-agent identifier which runs this instance.
 	PmmAgentId string `protobuf:"bytes,2,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
 	// Desired Agent status: enabled (false) or disabled (true).
 	Disabled bool `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Node identifier.
-	NodeId string `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	// AWS Access Key.
-	AwsAccessKey string `protobuf:"bytes,5,opt,name=aws_access_key,json=awsAccessKey,proto3" json:"aws_access_key,omitempty"`
+	// Service identifier.
+	ServiceId string `protobuf:"bytes,4,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// MySQL username for getting currently running queries.
+	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	// Use TLS for database connections.
+	Tls bool `protobuf:"varint,6,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify bool `protobuf:"varint,7,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,6,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Actual Agent status (the same for several configurations).
+	CustomLabels map[string]string `protobuf:"bytes,8,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Real-Time Analytics options.
+	RtaOptions *RTAOptions `protobuf:"bytes,9,opt,name=rta_options,json=rtaOptions,proto3" json:"rta_options,omitempty"`
+	// Actual Agent status.
 	Status AgentStatus `protobuf:"varint,10,opt,name=status,proto3,enum=inventory.v1.AgentStatus" json:"status,omitempty"`
-	// Listen port for scraping metrics (the same for several configurations).
-	ListenPort uint32 `protobuf:"varint,11,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
-	// Basic metrics are disabled.
-	BasicMetricsDisabled bool `protobuf:"varint,20,opt,name=basic_metrics_disabled,json=basicMetricsDisabled,proto3" json:"basic_metrics_disabled,omitempty"`
-	// Enhanced metrics are disabled.
-	EnhancedMetricsDisabled bool `protobuf:"varint,21,opt,name=enhanced_metrics_disabled,json=enhancedMetricsDisabled,proto3" json:"enhanced_metrics_disabled,omitempty"`
-	// True if exporter uses push metrics mode.
-	PushMetricsEnabled bool `protobuf:"varint,22,opt,name=push_metrics_enabled,json=pushMetricsEnabled,proto3" json:"push_metrics_enabled,omitempty"`
-	// Path to exec process.
-	ProcessExecPath string `protobuf:"bytes,23,opt,name=process_exec_path,json=processExecPath,proto3" json:"process_exec_path,omitempty"`
 	// Log level for exporter.
-	LogLevel LogLevel `protobuf:"varint,24,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
-	// Limit of databases for auto-discovery.
-	AutoDiscoveryLimit int32 `protobuf:"varint,25,opt,name=auto_discovery_limit,json=autoDiscoveryLimit,proto3" json:"auto_discovery_limit,omitempty"`
-	// Metrics resolution for this agent.
-	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,26,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	LogLevel      LogLevel `protobuf:"varint,11,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RDSExporter) Reset() {
-	*x = RDSExporter{}
+func (x *RTAMySQLAgent) Reset() {
+	*x = RTAMySQLAgent{}
 	mi := &file_inventory_v1_agents_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RDSExporter) String() string {
+func (x *RTAMySQLAgent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RDSExporter) ProtoMessage() {}
+func (*RTAMySQLAgent) ProtoMessage() {}
 
-func (x *RDSExporter) ProtoReflect() protoreflect.Message {
+func (x *RTAMySQLAgent) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_v1_agents_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2859,165 +2848,131 @@ func (x *RDSExporter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RDSExporter.ProtoReflect.Descriptor instead.
-func (*RDSExporter) Descriptor() ([]byte, []int) {
+// Deprecated: Use RTAMySQLAgent.ProtoReflect.Descriptor instead.
+func (*RTAMySQLAgent) Descriptor() ([]byte, []int) {
 	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *RDSExporter) GetAgentId() string {
+func (x *RTAMySQLAgent) GetAgentId() string {
 	if x != nil {
 		return x.AgentId
 	}
 	return ""
 }
 
-func (x *RDSExporter) GetPmmAgentId() string {
+func (x *RTAMySQLAgent) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *RDSExporter) GetDisabled() bool {
+func (x *RTAMySQLAgent) GetDisabled() bool {
 	if x != nil {
 		return x.Disabled
 	}
 	return false
 }
 
-func (x *RDSExporter) GetNodeId() string {
+func (x *RTAMySQLAgent) GetServiceId() string {
 	if x != nil {
-		return x.NodeId
+		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *RDSExporter) GetAwsAccessKey() string {
+func (x *RTAMySQLAgent) GetUsername() string {
 	if x != nil {
-		return x.AwsAccessKey
+		return x.Username
 	}
 	return ""
 }
 
-func (x *RDSExporter) GetCustomLabels() map[string]string {
-	if x != nil {
-		return x.CustomLabels
-	}
-	return nil
-}
-
-func (x *RDSExporter) GetStatus() AgentStatus {
-	if x != nil {
-		return x.Status
-	}
-	return AgentStatus_AGENT_STATUS_UNSPECIFIED
-}
-
-func (x *RDSExporter) GetListenPort() uint32 {
+func (x *RTAMySQLAgent) GetTls() bool {
 	if x != nil {
-		return x.ListenPort
+		return x.Tls
 	}
-	return 0
+	return false
 }
 
-func (x *RDSExporter) GetBasicMetricsDisabled() bool {
+func (x *RTAMySQLAgent) GetTlsSkipVerify() bool {
 	if x != nil {
-		return x.BasicMetricsDisabled
+		return x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *RDSExporter) GetEnhancedMetricsDisabled() bool {
+func (x *RTAMySQLAgent) GetCustomLabels() map[string]string {
 	if x != nil {
-		return x.EnhancedMetricsDisabled
+		return x.CustomLabels
 	}
-	return false
+	return nil
 }
 
-func (x *RDSExporter) GetPushMetricsEnabled() bool {
+func (x *RTAMySQLAgent) GetRtaOptions() *RTAOptions {
 	if x != nil {
-		return x.PushMetricsEnabled
+		return x.RtaOptions
 	}
-	return false
+	return nil
 }
 
-func (x *RDSExporter) GetProcessExecPath() string {
+func (x *RTAMySQLAgent) GetStatus() AgentStatus {
 	if x != nil {
-		return x.ProcessExecPath
+		return x.Status
 	}
-	return ""
+	return AgentStatus_AGENT_STATUS_UNSPECIFIED
 }
 
-func (x *RDSExporter) GetLogLevel() LogLevel {
+func (x *RTAMySQLAgent) GetLogLevel() LogLevel {
 	if x != nil {
 		return x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *RDSExporter) GetAutoDiscoveryLimit() int32 {
-	if x != nil {
-		return x.AutoDiscoveryLimit
-	}
-	return 0
-}
-
-func (x *RDSExporter) GetMetricsResolutions() *common.MetricsResolutions {
-	if x != nil {
-		return x.MetricsResolutions
-	}
-	return nil
-}
-
-// ExternalExporter runs on any Node type, including Remote Node.
-type ExternalExporter struct {
+// RTAPostgreSQLAgent runs within pmm-agent and sends PostgreSQL Real-Time Query Analytics data to the PMM Server.
+type RTAPostgreSQLAgent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Unique randomly generated instance identifier.
+	// Unique agent identifier.
 	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	// Node identifier where this instance runs.
-	RunsOnNodeId string `protobuf:"bytes,2,opt,name=runs_on_node_id,json=runsOnNodeId,proto3" json:"runs_on_node_id,omitempty"`
-	// If disabled, metrics from this exporter will not be collected.
+	// The pmm-agent identifier which runs this instance.
+	PmmAgentId string `protobuf:"bytes,2,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
+	// Desired Agent status: enabled (false) or disabled (true).
 	Disabled bool `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
 	// Service identifier.
 	ServiceId string `protobuf:"bytes,4,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// HTTP basic auth username for collecting metrics.
+	// PostgreSQL username for getting currently running queries.
 	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
-	// Scheme to generate URI to exporter metrics endpoints.
-	Scheme string `protobuf:"bytes,6,opt,name=scheme,proto3" json:"scheme,omitempty"`
-	// Path under which metrics are exposed, used to generate URI.
-	MetricsPath string `protobuf:"bytes,7,opt,name=metrics_path,json=metricsPath,proto3" json:"metrics_path,omitempty"`
+	// Use TLS for database connections.
+	Tls bool `protobuf:"varint,6,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify bool `protobuf:"varint,7,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `protobuf:"bytes,8,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Listen port for scraping metrics.
-	ListenPort uint32 `protobuf:"varint,9,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
-	// True if exporter uses push metrics mode.
-	PushMetricsEnabled bool `protobuf:"varint,10,opt,name=push_metrics_enabled,json=pushMetricsEnabled,proto3" json:"push_metrics_enabled,omitempty"`
-	// Path to exec process.
-	ProcessExecPath string `protobuf:"bytes,11,opt,name=process_exec_path,json=processExecPath,proto3" json:"process_exec_path,omitempty"`
-	// Metrics resolution for this agent.
-	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,12,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	// Skip TLS certificate and hostname verification.
-	TlsSkipVerify bool `protobuf:"varint,13,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Real-Time Analytics options.
+	RtaOptions *RTAOptions `protobuf:"bytes,9,opt,name=rta_options,json=rtaOptions,proto3" json:"rta_options,omitempty"`
 	// Actual Agent status.
-	Status        AgentStatus `protobuf:"varint,14,opt,name=status,proto3,enum=inventory.v1.AgentStatus" json:"status,omitempty"`
+	Status AgentStatus `protobuf:"varint,10,opt,name=status,proto3,enum=inventory.v1.AgentStatus" json:"status,omitempty"`
+	// Log level for exporter.
+	LogLevel      LogLevel `protobuf:"varint,11,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ExternalExporter) Reset() {
-	*x = ExternalExporter{}
+func (x *RTAPostgreSQLAgent) Reset() {
+	*x = RTAPostgreSQLAgent{}
 	mi := &file_inventory_v1_agents_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ExternalExporter) String() string {
+func (x *RTAPostgreSQLAgent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExternalExporter) ProtoMessage() {}
+func (*RTAPostgreSQLAgent) ProtoMessage() {}
 
-func (x *ExternalExporter) ProtoReflect() protoreflect.Message {
+func (x *RTAPostgreSQLAgent) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_v1_agents_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3029,111 +2984,90 @@ func (x *ExternalExporter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ExternalExporter.ProtoReflect.Descriptor instead.
-func (*ExternalExporter) Descriptor() ([]byte, []int) {
+// Deprecated: Use RTAPostgreSQLAgent.ProtoReflect.Descriptor instead.
+func (*RTAPostgreSQLAgent) Descriptor() ([]byte, []int) {
 	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *ExternalExporter) GetAgentId() string {
+func (x *RTAPostgreSQLAgent) GetAgentId() string {
 	if x != nil {
 		return x.AgentId
 	}
 	return ""
 }
 
-func (x *ExternalExporter) GetRunsOnNodeId() string {
+func (x *RTAPostgreSQLAgent) GetPmmAgentId() string {
 	if x != nil {
-		return x.RunsOnNodeId
+		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *ExternalExporter) GetDisabled() bool {
+func (x *RTAPostgreSQLAgent) GetDisabled() bool {
 	if x != nil {
 		return x.Disabled
 	}
 	return false
 }
 
-func (x *ExternalExporter) GetServiceId() string {
+func (x *RTAPostgreSQLAgent) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *ExternalExporter) GetUsername() string {
+func (x *RTAPostgreSQLAgent) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *ExternalExporter) GetScheme() string {
+func (x *RTAPostgreSQLAgent) GetTls() bool {
 	if x != nil {
-		return x.Scheme
+		return x.Tls
 	}
-	return ""
+	return false
 }
 
-func (x *ExternalExporter) GetMetricsPath() string {
+func (x *RTAPostgreSQLAgent) GetTlsSkipVerify() bool {
 	if x != nil {
-		return x.MetricsPath
+		return x.TlsSkipVerify
 	}
-	return ""
+	return false
 }
 
-func (x *ExternalExporter) GetCustomLabels() map[string]string {
+func (x *RTAPostgreSQLAgent) GetCustomLabels() map[string]string {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ExternalExporter) GetListenPort() uint32 {
-	if x != nil {
-		return x.ListenPort
-	}
-	return 0
-}
-
-func (x *ExternalExporter) GetPushMetricsEnabled() bool {
-	if x != nil {
-		return x.PushMetricsEnabled
-	}
-	return false
-}
-
-func (x *ExternalExporter) GetProcessExecPath() string {
-	if x != nil {
-		return x.ProcessExecPath
-	}
-	return ""
-}
-
-func (x *ExternalExporter) GetMetricsResolutions() *common.MetricsResolutions {
+func (x *RTAPostgreSQLAgent) GetRtaOptions() *RTAOptions {
 	if x != nil {
-		return x.MetricsResolutions
+		return x.RtaOptions
 	}
 	return nil
 }
 
-func (x *ExternalExporter) GetTlsSkipVerify() bool {
+func (x *RTAPostgreSQLAgent) GetStatus() AgentStatus {
 	if x != nil {
-		return x.TlsSkipVerify
+		return x.Status
 	}
-	return false
+	return AgentStatus_AGENT_STATUS_UNSPECIFIED
 }
 
-func (x *ExternalExporter) GetStatus() AgentStatus {
+func (x *RTAPostgreSQLAgent) GetLogLevel() LogLevel {
 	if x != nil {
-		return x.Status
+		return x.LogLevel
 	}
-	return AgentStatus_AGENT_STATUS_UNSPECIFIED
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-// AzureDatabaseExporter runs on Generic or Container Node and exposes RemoteAzure Node metrics.
-type AzureDatabaseExporter struct {
+// QANPostgreSQLPgStatementsAgent runs within pmm-agent and sends PostgreSQL Query Analytics data to the PMM Server.
+type QANPostgreSQLPgStatementsAgent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Unique randomly generated instance identifier.
 	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
@@ -3141,44 +3075,44 @@ type AzureDatabaseExporter struct {
 	PmmAgentId string `protobuf:"bytes,2,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
 	// Desired Agent status: enabled (false) or disabled (true).
 	Disabled bool `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	// Node identifier.
-	NodeId string `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	// Azure database subscription ID.
-	AzureDatabaseSubscriptionId string `protobuf:"bytes,5,opt,name=azure_database_subscription_id,json=azureDatabaseSubscriptionId,proto3" json:"azure_database_subscription_id,omitempty"`
-	// Azure database resource type (mysql, maria, postgres)
-	AzureDatabaseResourceType string `protobuf:"bytes,6,opt,name=azure_database_resource_type,json=azureDatabaseResourceType,proto3" json:"azure_database_resource_type,omitempty"`
-	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,7,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Actual Agent status (the same for several configurations).
-	Status AgentStatus `protobuf:"varint,10,opt,name=status,proto3,enum=inventory.v1.AgentStatus" json:"status,omitempty"`
-	// Listen port for scraping metrics (the same for several configurations).
-	ListenPort uint32 `protobuf:"varint,11,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
-	// True if the exporter operates in push metrics mode.
-	PushMetricsEnabled bool `protobuf:"varint,12,opt,name=push_metrics_enabled,json=pushMetricsEnabled,proto3" json:"push_metrics_enabled,omitempty"`
+	// Service identifier.
+	ServiceId string `protobuf:"bytes,4,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// PostgreSQL username for getting pg stat statements data.
+	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `protobuf:"varint,6,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3" json:"disable_comments_parsing,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `protobuf:"varint,7,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
+	// Use TLS for database connections.
+	Tls bool `protobuf:"varint,8,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify bool `protobuf:"varint,9,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `protobuf:"bytes,10,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Actual Agent status.
+	Status AgentStatus `protobuf:"varint,20,opt,name=status,proto3,enum=inventory.v1.AgentStatus" json:"status,omitempty"`
 	// Path to exec process.
-	ProcessExecPath string `protobuf:"bytes,13,opt,name=process_exec_path,json=processExecPath,proto3" json:"process_exec_path,omitempty"`
+	ProcessExecPath string `protobuf:"bytes,21,opt,name=process_exec_path,json=processExecPath,proto3" json:"process_exec_path,omitempty"`
 	// Log level for exporter.
-	LogLevel LogLevel `protobuf:"varint,14,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
-	// Metrics resolution for this agent.
-	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,15,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	LogLevel      LogLevel `protobuf:"varint,22,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AzureDatabaseExporter) Reset() {
-	*x = AzureDatabaseExporter{}
+func (x *QANPostgreSQLPgStatementsAgent) Reset() {
+	*x = QANPostgreSQLPgStatementsAgent{}
 	mi := &file_inventory_v1_agents_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AzureDatabaseExporter) String() string {
+func (x *QANPostgreSQLPgStatementsAgent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AzureDatabaseExporter) ProtoMessage() {}
+func (*QANPostgreSQLPgStatementsAgent) ProtoMessage() {}
 
-func (x *AzureDatabaseExporter) ProtoReflect() protoreflect.Message {
+func (x *QANPostgreSQLPgStatementsAgent) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_v1_agents_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3190,131 +3124,151 @@ func (x *AzureDatabaseExporter) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AzureDatabaseExporter.ProtoReflect.Descriptor instead.
-func (*AzureDatabaseExporter) Descriptor() ([]byte, []int) {
+// Deprecated: Use QANPostgreSQLPgStatementsAgent.ProtoReflect.Descriptor instead.
+func (*QANPostgreSQLPgStatementsAgent) Descriptor() ([]byte, []int) {
 	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *AzureDatabaseExporter) GetAgentId() string {
+func (x *QANPostgreSQLPgStatementsAgent) GetAgentId() string {
 	if x != nil {
 		return x.AgentId
 	}
 	return ""
 }
 
-func (x *AzureDatabaseExporter) GetPmmAgentId() string {
+func (x *QANPostgreSQLPgStatementsAgent) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *AzureDatabaseExporter) GetDisabled() bool {
+func (x *QANPostgreSQLPgStatementsAgent) GetDisabled() bool {
 	if x != nil {
 		return x.Disabled
 	}
 	return false
 }
 
-func (x *AzureDatabaseExporter) GetNodeId() string {
+func (x *QANPostgreSQLPgStatementsAgent) GetServiceId() string {
 	if x != nil {
-		return x.NodeId
+		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *AzureDatabaseExporter) GetAzureDatabaseSubscriptionId() string {
+func (x *QANPostgreSQLPgStatementsAgent) GetUsername() string {
 	if x != nil {
-		return x.AzureDatabaseSubscriptionId
+		return x.Username
 	}
 	return ""
 }
 
-func (x *AzureDatabaseExporter) GetAzureDatabaseResourceType() string {
+func (x *QANPostgreSQLPgStatementsAgent) GetDisableCommentsParsing() bool {
 	if x != nil {
-		return x.AzureDatabaseResourceType
+		return x.DisableCommentsParsing
 	}
-	return ""
+	return false
 }
 
-func (x *AzureDatabaseExporter) GetCustomLabels() map[string]string {
+func (x *QANPostgreSQLPgStatementsAgent) GetMaxQueryLength() int32 {
 	if x != nil {
-		return x.CustomLabels
+		return x.MaxQueryLength
 	}
-	return nil
+	return 0
 }
 
-func (x *AzureDatabaseExporter) GetStatus() AgentStatus {
+func (x *QANPostgreSQLPgStatementsAgent) GetTls() bool {
 	if x != nil {
-		return x.Status
+		return x.Tls
 	}
-	return AgentStatus_AGENT_STATUS_UNSPECIFIED
+	return false
 }
 
-func (x *AzureDatabaseExporter) GetListenPort() uint32 {
+func (x *QANPostgreSQLPgStatementsAgent) GetTlsSkipVerify() bool {
 	if x != nil {
-		return x.ListenPort
+		return x.TlsSkipVerify
 	}
-	return 0
+	return false
 }
 
-func (x *AzureDatabaseExporter) GetPushMetricsEnabled() bool {
+func (x *QANPostgreSQLPgStatementsAgent) GetCustomLabels() map[string]string {
 	if x != nil {
-		return x.PushMetricsEnabled
+		return x.CustomLabels
 	}
-	return false
+	return nil
 }
 
-func (x *AzureDatabaseExporter) GetProcessExecPath() string {
+func (x *QANPostgreSQLPgStatementsAgent) GetStatus() AgentStatus {
 	if x != nil {
-		return x.ProcessExecPath
+		return x.Status
 	}
-	return ""
+	return AgentStatus_AGENT_STATUS_UNSPECIFIED
 }
 
-func (x *AzureDatabaseExporter) GetLogLevel() LogLevel {
+func (x *QANPostgreSQLPgStatementsAgent) GetProcessExecPath() string {
 	if x != nil {
-		return x.LogLevel
+		return x.ProcessExecPath
 	}
-	return LogLevel_LOG_LEVEL_UNSPECIFIED
+	return ""
 }
 
-func (x *AzureDatabaseExporter) GetMetricsResolutions() *common.MetricsResolutions {
+func (x *QANPostgreSQLPgStatementsAgent) GetLogLevel() LogLevel {
 	if x != nil {
-		return x.MetricsResolutions
+		return x.LogLevel
 	}
-	return nil
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-// ChangeCommonAgentParams contains parameters that can be changed for all Agents.
-type ChangeCommonAgentParams struct {
+// QANPostgreSQLPgStatMonitorAgent runs within pmm-agent and sends PostgreSQL Query Analytics data to the PMM Server.
+type QANPostgreSQLPgStatMonitorAgent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Enable this Agent. Agents are enabled by default when they get added.
-	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
-	// Replace all custom user-assigned labels.
-	CustomLabels *common.StringMap `protobuf:"bytes,2,opt,name=custom_labels,json=customLabels,proto3,oneof" json:"custom_labels,omitempty"`
-	// Enables push metrics with vmagent.
-	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
-	// Metrics resolution for this agent.
-	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Unique randomly generated instance identifier.
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// The pmm-agent identifier which runs this instance.
+	PmmAgentId string `protobuf:"bytes,2,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Service identifier.
+	ServiceId string `protobuf:"bytes,4,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// PostgreSQL username for getting pg stat monitor data.
+	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	// Use TLS for database connections.
+	Tls bool `protobuf:"varint,6,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify bool `protobuf:"varint,7,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `protobuf:"varint,8,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3" json:"disable_comments_parsing,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `protobuf:"varint,9,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
+	// True if query examples are disabled.
+	QueryExamplesDisabled bool `protobuf:"varint,10,opt,name=query_examples_disabled,json=queryExamplesDisabled,proto3" json:"query_examples_disabled,omitempty"`
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `protobuf:"bytes,11,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Actual Agent status.
+	Status AgentStatus `protobuf:"varint,20,opt,name=status,proto3,enum=inventory.v1.AgentStatus" json:"status,omitempty"`
+	// Path to exec process.
+	ProcessExecPath string `protobuf:"bytes,21,opt,name=process_exec_path,json=processExecPath,proto3" json:"process_exec_path,omitempty"`
+	// Log level for exporter.
+	LogLevel      LogLevel `protobuf:"varint,22,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeCommonAgentParams) Reset() {
-	*x = ChangeCommonAgentParams{}
+func (x *QANPostgreSQLPgStatMonitorAgent) Reset() {
+	*x = QANPostgreSQLPgStatMonitorAgent{}
 	mi := &file_inventory_v1_agents_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeCommonAgentParams) String() string {
+func (x *QANPostgreSQLPgStatMonitorAgent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeCommonAgentParams) ProtoMessage() {}
+func (*QANPostgreSQLPgStatMonitorAgent) ProtoMessage() {}
 
-func (x *ChangeCommonAgentParams) ProtoReflect() protoreflect.Message {
+func (x *QANPostgreSQLPgStatMonitorAgent) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_v1_agents_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3326,325 +3280,324 @@ func (x *ChangeCommonAgentParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeCommonAgentParams.ProtoReflect.Descriptor instead.
-func (*ChangeCommonAgentParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use QANPostgreSQLPgStatMonitorAgent.ProtoReflect.Descriptor instead.
+func (*QANPostgreSQLPgStatMonitorAgent) Descriptor() ([]byte, []int) {
 	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ChangeCommonAgentParams) GetEnable() bool {
-	if x != nil && x.Enable != nil {
-		return *x.Enable
+func (x *QANPostgreSQLPgStatMonitorAgent) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
 	}
-	return false
+	return ""
 }
 
-func (x *ChangeCommonAgentParams) GetCustomLabels() *common.StringMap {
+func (x *QANPostgreSQLPgStatMonitorAgent) GetPmmAgentId() string {
 	if x != nil {
-		return x.CustomLabels
+		return x.PmmAgentId
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangeCommonAgentParams) GetEnablePushMetrics() bool {
-	if x != nil && x.EnablePushMetrics != nil {
-		return *x.EnablePushMetrics
+func (x *QANPostgreSQLPgStatMonitorAgent) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
 	}
 	return false
 }
 
-func (x *ChangeCommonAgentParams) GetMetricsResolutions() *common.MetricsResolutions {
+func (x *QANPostgreSQLPgStatMonitorAgent) GetServiceId() string {
 	if x != nil {
-		return x.MetricsResolutions
+		return x.ServiceId
 	}
-	return nil
-}
-
-type ListAgentsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Return only Agents started by this pmm-agent.
-	// Exactly one of these parameters should be present: pmm_agent_id, node_id, service_id.
-	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
-	// Return only Agents that provide insights for that Node.
-	// Exactly one of these parameters should be present: pmm_agent_id, node_id, service_id.
-	NodeId string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	// Return only Agents that provide insights for that Service.
-	// Exactly one of these parameters should be present: pmm_agent_id, node_id, service_id.
-	ServiceId string `protobuf:"bytes,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// Return only agents of a particular type.
-	AgentType     AgentType `protobuf:"varint,4,opt,name=agent_type,json=agentType,proto3,enum=inventory.v1.AgentType" json:"agent_type,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ListAgentsRequest) Reset() {
-	*x = ListAgentsRequest{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *ListAgentsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*ListAgentsRequest) ProtoMessage() {}
-
-func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[21]
+func (x *QANPostgreSQLPgStatMonitorAgent) GetUsername() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Username
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ListAgentsRequest.ProtoReflect.Descriptor instead.
-func (*ListAgentsRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{21}
+func (x *QANPostgreSQLPgStatMonitorAgent) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
 }
 
-func (x *ListAgentsRequest) GetPmmAgentId() string {
+func (x *QANPostgreSQLPgStatMonitorAgent) GetTlsSkipVerify() bool {
 	if x != nil {
-		return x.PmmAgentId
+		return x.TlsSkipVerify
 	}
-	return ""
+	return false
 }
 
-func (x *ListAgentsRequest) GetNodeId() string {
+func (x *QANPostgreSQLPgStatMonitorAgent) GetDisableCommentsParsing() bool {
 	if x != nil {
-		return x.NodeId
+		return x.DisableCommentsParsing
 	}
-	return ""
+	return false
 }
 
-func (x *ListAgentsRequest) GetServiceId() string {
+func (x *QANPostgreSQLPgStatMonitorAgent) GetMaxQueryLength() int32 {
 	if x != nil {
-		return x.ServiceId
+		return x.MaxQueryLength
 	}
-	return ""
+	return 0
 }
 
-func (x *ListAgentsRequest) GetAgentType() AgentType {
+func (x *QANPostgreSQLPgStatMonitorAgent) GetQueryExamplesDisabled() bool {
 	if x != nil {
-		return x.AgentType
+		return x.QueryExamplesDisabled
 	}
-	return AgentType_AGENT_TYPE_UNSPECIFIED
+	return false
 }
 
-type ListAgentsResponse struct {
-	state                           protoimpl.MessageState             `protogen:"open.v1"`
-	PmmAgent                        []*PMMAgent                        `protobuf:"bytes,1,rep,name=pmm_agent,json=pmmAgent,proto3" json:"pmm_agent,omitempty"`
-	VmAgent                         []*VMAgent                         `protobuf:"bytes,2,rep,name=vm_agent,json=vmAgent,proto3" json:"vm_agent,omitempty"`
-	NodeExporter                    []*NodeExporter                    `protobuf:"bytes,3,rep,name=node_exporter,json=nodeExporter,proto3" json:"node_exporter,omitempty"`
-	MysqldExporter                  []*MySQLdExporter                  `protobuf:"bytes,4,rep,name=mysqld_exporter,json=mysqldExporter,proto3" json:"mysqld_exporter,omitempty"`
-	MongodbExporter                 []*MongoDBExporter                 `protobuf:"bytes,5,rep,name=mongodb_exporter,json=mongodbExporter,proto3" json:"mongodb_exporter,omitempty"`
-	PostgresExporter                []*PostgresExporter                `protobuf:"bytes,6,rep,name=postgres_exporter,json=postgresExporter,proto3" json:"postgres_exporter,omitempty"`
-	ProxysqlExporter                []*ProxySQLExporter                `protobuf:"bytes,7,rep,name=proxysql_exporter,json=proxysqlExporter,proto3" json:"proxysql_exporter,omitempty"`
-	QanMysqlPerfschemaAgent         []*QANMySQLPerfSchemaAgent         `protobuf:"bytes,8,rep,name=qan_mysql_perfschema_agent,json=qanMysqlPerfschemaAgent,proto3" json:"qan_mysql_perfschema_agent,omitempty"`
-	QanMysqlSlowlogAgent            []*QANMySQLSlowlogAgent            `protobuf:"bytes,9,rep,name=qan_mysql_slowlog_agent,json=qanMysqlSlowlogAgent,proto3" json:"qan_mysql_slowlog_agent,omitempty"`
-	QanMongodbProfilerAgent         []*QANMongoDBProfilerAgent         `protobuf:"bytes,10,rep,name=qan_mongodb_profiler_agent,json=qanMongodbProfilerAgent,proto3" json:"qan_mongodb_profiler_agent,omitempty"`
-	QanMongodbMongologAgent         []*QANMongoDBMongologAgent         `protobuf:"bytes,18,rep,name=qan_mongodb_mongolog_agent,json=qanMongodbMongologAgent,proto3" json:"qan_mongodb_mongolog_agent,omitempty"`
-	QanPostgresqlPgstatementsAgent  []*QANPostgreSQLPgStatementsAgent  `protobuf:"bytes,11,rep,name=qan_postgresql_pgstatements_agent,json=qanPostgresqlPgstatementsAgent,proto3" json:"qan_postgresql_pgstatements_agent,omitempty"`
-	QanPostgresqlPgstatmonitorAgent []*QANPostgreSQLPgStatMonitorAgent `protobuf:"bytes,12,rep,name=qan_postgresql_pgstatmonitor_agent,json=qanPostgresqlPgstatmonitorAgent,proto3" json:"qan_postgresql_pgstatmonitor_agent,omitempty"`
-	ExternalExporter                []*ExternalExporter                `protobuf:"bytes,13,rep,name=external_exporter,json=externalExporter,proto3" json:"external_exporter,omitempty"`
-	RdsExporter                     []*RDSExporter                     `protobuf:"bytes,14,rep,name=rds_exporter,json=rdsExporter,proto3" json:"rds_exporter,omitempty"`
-	AzureDatabaseExporter           []*AzureDatabaseExporter           `protobuf:"bytes,15,rep,name=azure_database_exporter,json=azureDatabaseExporter,proto3" json:"azure_database_exporter,omitempty"`
-	NomadAgent                      []*NomadAgent                      `protobuf:"bytes,16,rep,name=nomad_agent,json=nomadAgent,proto3" json:"nomad_agent,omitempty"`
-	ValkeyExporter                  []*ValkeyExporter                  `protobuf:"bytes,17,rep,name=valkey_exporter,json=valkeyExporter,proto3" json:"valkey_exporter,omitempty"`
-	RtaMongodbAgent                 []*RTAMongoDBAgent                 `protobuf:"bytes,19,rep,name=rta_mongodb_agent,json=rtaMongodbAgent,proto3" json:"rta_mongodb_agent,omitempty"`
-	unknownFields                   protoimpl.UnknownFields
-	sizeCache                       protoimpl.SizeCache
+func (x *QANPostgreSQLPgStatMonitorAgent) GetCustomLabels() map[string]string {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
 }
 
-func (x *ListAgentsResponse) Reset() {
-	*x = ListAgentsResponse{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[22]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *QANPostgreSQLPgStatMonitorAgent) GetStatus() AgentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AgentStatus_AGENT_STATUS_UNSPECIFIED
 }
 
-func (x *ListAgentsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *QANPostgreSQLPgStatMonitorAgent) GetProcessExecPath() string {
+	if x != nil {
+		return x.ProcessExecPath
+	}
+	return ""
 }
 
-func (*ListAgentsResponse) ProtoMessage() {}
-
-func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[22]
+func (x *QANPostgreSQLPgStatMonitorAgent) GetLogLevel() LogLevel {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.LogLevel
 	}
-	return mi.MessageOf(x)
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-// Deprecated: Use ListAgentsResponse.ProtoReflect.Descriptor instead.
-func (*ListAgentsResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{22}
+// QANPostgreSQLLogAgent runs within pmm-agent and sends PostgreSQL Query Analytics data from the server log to the PMM Server.
+type QANPostgreSQLLogAgent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique randomly generated instance identifier.
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// The pmm-agent identifier which runs this instance.
+	PmmAgentId string `protobuf:"bytes,2,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Service identifier.
+	ServiceId string `protobuf:"bytes,4,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// PostgreSQL username for getting server log settings.
+	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	// Use TLS for database connections.
+	Tls bool `protobuf:"varint,6,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify bool `protobuf:"varint,7,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `protobuf:"varint,8,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3" json:"disable_comments_parsing,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `protobuf:"varint,9,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
+	// True if query examples are disabled.
+	QueryExamplesDisabled bool `protobuf:"varint,10,opt,name=query_examples_disabled,json=queryExamplesDisabled,proto3" json:"query_examples_disabled,omitempty"`
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `protobuf:"bytes,11,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Actual Agent status.
+	Status AgentStatus `protobuf:"varint,20,opt,name=status,proto3,enum=inventory.v1.AgentStatus" json:"status,omitempty"`
+	// Path to exec process.
+	ProcessExecPath string `protobuf:"bytes,21,opt,name=process_exec_path,json=processExecPath,proto3" json:"process_exec_path,omitempty"`
+	// Log level for exporter.
+	LogLevel      LogLevel `protobuf:"varint,22,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListAgentsResponse) GetPmmAgent() []*PMMAgent {
-	if x != nil {
-		return x.PmmAgent
-	}
-	return nil
+func (x *QANPostgreSQLLogAgent) Reset() {
+	*x = QANPostgreSQLLogAgent{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListAgentsResponse) GetVmAgent() []*VMAgent {
-	if x != nil {
-		return x.VmAgent
-	}
-	return nil
+func (x *QANPostgreSQLLogAgent) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ListAgentsResponse) GetNodeExporter() []*NodeExporter {
-	if x != nil {
-		return x.NodeExporter
-	}
-	return nil
-}
+func (*QANPostgreSQLLogAgent) ProtoMessage() {}
 
-func (x *ListAgentsResponse) GetMysqldExporter() []*MySQLdExporter {
+func (x *QANPostgreSQLLogAgent) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[21]
 	if x != nil {
-		return x.MysqldExporter
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *ListAgentsResponse) GetMongodbExporter() []*MongoDBExporter {
-	if x != nil {
-		return x.MongodbExporter
-	}
-	return nil
+// Deprecated: Use QANPostgreSQLLogAgent.ProtoReflect.Descriptor instead.
+func (*QANPostgreSQLLogAgent) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListAgentsResponse) GetPostgresExporter() []*PostgresExporter {
+func (x *QANPostgreSQLLogAgent) GetAgentId() string {
 	if x != nil {
-		return x.PostgresExporter
+		return x.AgentId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListAgentsResponse) GetProxysqlExporter() []*ProxySQLExporter {
+func (x *QANPostgreSQLLogAgent) GetPmmAgentId() string {
 	if x != nil {
-		return x.ProxysqlExporter
+		return x.PmmAgentId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListAgentsResponse) GetQanMysqlPerfschemaAgent() []*QANMySQLPerfSchemaAgent {
+func (x *QANPostgreSQLLogAgent) GetDisabled() bool {
 	if x != nil {
-		return x.QanMysqlPerfschemaAgent
+		return x.Disabled
 	}
-	return nil
+	return false
 }
 
-func (x *ListAgentsResponse) GetQanMysqlSlowlogAgent() []*QANMySQLSlowlogAgent {
+func (x *QANPostgreSQLLogAgent) GetServiceId() string {
 	if x != nil {
-		return x.QanMysqlSlowlogAgent
+		return x.ServiceId
 	}
-	return nil
+	return ""
 }
 
-func (x *ListAgentsResponse) GetQanMongodbProfilerAgent() []*QANMongoDBProfilerAgent {
+func (x *QANPostgreSQLLogAgent) GetUsername() string {
 	if x != nil {
-		return x.QanMongodbProfilerAgent
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *ListAgentsResponse) GetQanMongodbMongologAgent() []*QANMongoDBMongologAgent {
+func (x *QANPostgreSQLLogAgent) GetTls() bool {
 	if x != nil {
-		return x.QanMongodbMongologAgent
+		return x.Tls
 	}
-	return nil
+	return false
 }
 
-func (x *ListAgentsResponse) GetQanPostgresqlPgstatementsAgent() []*QANPostgreSQLPgStatementsAgent {
+func (x *QANPostgreSQLLogAgent) GetTlsSkipVerify() bool {
 	if x != nil {
-		return x.QanPostgresqlPgstatementsAgent
+		return x.TlsSkipVerify
 	}
-	return nil
+	return false
 }
 
-func (x *ListAgentsResponse) GetQanPostgresqlPgstatmonitorAgent() []*QANPostgreSQLPgStatMonitorAgent {
+func (x *QANPostgreSQLLogAgent) GetDisableCommentsParsing() bool {
 	if x != nil {
-		return x.QanPostgresqlPgstatmonitorAgent
+		return x.DisableCommentsParsing
 	}
-	return nil
+	return false
 }
 
-func (x *ListAgentsResponse) GetExternalExporter() []*ExternalExporter {
+func (x *QANPostgreSQLLogAgent) GetMaxQueryLength() int32 {
 	if x != nil {
-		return x.ExternalExporter
+		return x.MaxQueryLength
 	}
-	return nil
+	return 0
 }
 
-func (x *ListAgentsResponse) GetRdsExporter() []*RDSExporter {
+func (x *QANPostgreSQLLogAgent) GetQueryExamplesDisabled() bool {
 	if x != nil {
-		return x.RdsExporter
+		return x.QueryExamplesDisabled
 	}
-	return nil
+	return false
 }
 
-func (x *ListAgentsResponse) GetAzureDatabaseExporter() []*AzureDatabaseExporter {
+func (x *QANPostgreSQLLogAgent) GetCustomLabels() map[string]string {
 	if x != nil {
-		return x.AzureDatabaseExporter
+		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ListAgentsResponse) GetNomadAgent() []*NomadAgent {
+func (x *QANPostgreSQLLogAgent) GetStatus() AgentStatus {
 	if x != nil {
-		return x.NomadAgent
+		return x.Status
 	}
-	return nil
+	return AgentStatus_AGENT_STATUS_UNSPECIFIED
 }
 
-func (x *ListAgentsResponse) GetValkeyExporter() []*ValkeyExporter {
+func (x *QANPostgreSQLLogAgent) GetProcessExecPath() string {
 	if x != nil {
-		return x.ValkeyExporter
+		return x.ProcessExecPath
 	}
-	return nil
+	return ""
 }
 
-func (x *ListAgentsResponse) GetRtaMongodbAgent() []*RTAMongoDBAgent {
+func (x *QANPostgreSQLLogAgent) GetLogLevel() LogLevel {
 	if x != nil {
-		return x.RtaMongodbAgent
+		return x.LogLevel
 	}
-	return nil
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-type GetAgentRequest struct {
+// RDSExporter runs on Generic or Container Node and exposes RemoteRDS Node metrics.
+type RDSExporter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Unique randomly generated instance identifier.
-	AgentId       string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// The pmm-agent identifier which runs this instance.
+	PmmAgentId string `protobuf:"bytes,2,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Node identifier.
+	NodeId string `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// AWS Access Key.
+	AwsAccessKey string `protobuf:"bytes,5,opt,name=aws_access_key,json=awsAccessKey,proto3" json:"aws_access_key,omitempty"`
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `protobuf:"bytes,6,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Actual Agent status (the same for several configurations).
+	Status AgentStatus `protobuf:"varint,10,opt,name=status,proto3,enum=inventory.v1.AgentStatus" json:"status,omitempty"`
+	// Listen port for scraping metrics (the same for several configurations).
+	ListenPort uint32 `protobuf:"varint,11,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
+	// Basic metrics are disabled.
+	BasicMetricsDisabled bool `protobuf:"varint,20,opt,name=basic_metrics_disabled,json=basicMetricsDisabled,proto3" json:"basic_metrics_disabled,omitempty"`
+	// Enhanced metrics are disabled.
+	EnhancedMetricsDisabled bool `protobuf:"varint,21,opt,name=enhanced_metrics_disabled,json=enhancedMetricsDisabled,proto3" json:"enhanced_metrics_disabled,omitempty"`
+	// True if exporter uses push metrics mode.
+	PushMetricsEnabled bool `protobuf:"varint,22,opt,name=push_metrics_enabled,json=pushMetricsEnabled,proto3" json:"push_metrics_enabled,omitempty"`
+	// Path to exec process.
+	ProcessExecPath string `protobuf:"bytes,23,opt,name=process_exec_path,json=processExecPath,proto3" json:"process_exec_path,omitempty"`
+	// Log level for exporter.
+	LogLevel LogLevel `protobuf:"varint,24,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	// Limit of databases for auto-discovery.
+	AutoDiscoveryLimit int32 `protobuf:"varint,25,opt,name=auto_discovery_limit,json=autoDiscoveryLimit,proto3" json:"auto_discovery_limit,omitempty"`
+	// Metrics resolution for this agent.
+	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,26,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetAgentRequest) Reset() {
-	*x = GetAgentRequest{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[23]
+func (x *RDSExporter) Reset() {
+	*x = RDSExporter{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAgentRequest) String() string {
+func (x *RDSExporter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAgentRequest) ProtoMessage() {}
+func (*RDSExporter) ProtoMessage() {}
 
-func (x *GetAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[23]
+func (x *RDSExporter) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3655,397 +3608,327 @@ func (x *GetAgentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAgentRequest.ProtoReflect.Descriptor instead.
-func (*GetAgentRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use RDSExporter.ProtoReflect.Descriptor instead.
+func (*RDSExporter) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *GetAgentRequest) GetAgentId() string {
+func (x *RDSExporter) GetAgentId() string {
 	if x != nil {
 		return x.AgentId
 	}
 	return ""
 }
 
-type GetAgentResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Agent:
-	//
-	//	*GetAgentResponse_PmmAgent
-	//	*GetAgentResponse_Vmagent
-	//	*GetAgentResponse_NodeExporter
-	//	*GetAgentResponse_MysqldExporter
-	//	*GetAgentResponse_MongodbExporter
-	//	*GetAgentResponse_PostgresExporter
-	//	*GetAgentResponse_ProxysqlExporter
-	//	*GetAgentResponse_QanMysqlPerfschemaAgent
-	//	*GetAgentResponse_QanMysqlSlowlogAgent
-	//	*GetAgentResponse_QanMongodbProfilerAgent
-	//	*GetAgentResponse_QanMongodbMongologAgent
-	//	*GetAgentResponse_QanPostgresqlPgstatementsAgent
-	//	*GetAgentResponse_QanPostgresqlPgstatmonitorAgent
-	//	*GetAgentResponse_ExternalExporter
-	//	*GetAgentResponse_RdsExporter
-	//	*GetAgentResponse_AzureDatabaseExporter
-	//	*GetAgentResponse_NomadAgent
-	//	*GetAgentResponse_ValkeyExporter
-	//	*GetAgentResponse_RtaMongodbAgent
-	Agent         isGetAgentResponse_Agent `protobuf_oneof:"agent"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetAgentResponse) Reset() {
-	*x = GetAgentResponse{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RDSExporter) GetPmmAgentId() string {
+	if x != nil {
+		return x.PmmAgentId
+	}
+	return ""
 }
 
-func (x *GetAgentResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RDSExporter) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
 }
 
-func (*GetAgentResponse) ProtoMessage() {}
-
-func (x *GetAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[24]
+func (x *RDSExporter) GetNodeId() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.NodeId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetAgentResponse.ProtoReflect.Descriptor instead.
-func (*GetAgentResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{24}
+func (x *RDSExporter) GetAwsAccessKey() string {
+	if x != nil {
+		return x.AwsAccessKey
+	}
+	return ""
 }
 
-func (x *GetAgentResponse) GetAgent() isGetAgentResponse_Agent {
+func (x *RDSExporter) GetCustomLabels() map[string]string {
 	if x != nil {
-		return x.Agent
+		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *GetAgentResponse) GetPmmAgent() *PMMAgent {
+func (x *RDSExporter) GetStatus() AgentStatus {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_PmmAgent); ok {
-			return x.PmmAgent
-		}
+		return x.Status
 	}
-	return nil
+	return AgentStatus_AGENT_STATUS_UNSPECIFIED
 }
 
-func (x *GetAgentResponse) GetVmagent() *VMAgent {
+func (x *RDSExporter) GetListenPort() uint32 {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_Vmagent); ok {
-			return x.Vmagent
-		}
+		return x.ListenPort
 	}
-	return nil
+	return 0
 }
 
-func (x *GetAgentResponse) GetNodeExporter() *NodeExporter {
+func (x *RDSExporter) GetBasicMetricsDisabled() bool {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_NodeExporter); ok {
-			return x.NodeExporter
-		}
+		return x.BasicMetricsDisabled
 	}
-	return nil
+	return false
 }
 
-func (x *GetAgentResponse) GetMysqldExporter() *MySQLdExporter {
+func (x *RDSExporter) GetEnhancedMetricsDisabled() bool {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_MysqldExporter); ok {
-			return x.MysqldExporter
-		}
+		return x.EnhancedMetricsDisabled
 	}
-	return nil
+	return false
 }
 
-func (x *GetAgentResponse) GetMongodbExporter() *MongoDBExporter {
+func (x *RDSExporter) GetPushMetricsEnabled() bool {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_MongodbExporter); ok {
-			return x.MongodbExporter
-		}
+		return x.PushMetricsEnabled
 	}
-	return nil
+	return false
 }
 
-func (x *GetAgentResponse) GetPostgresExporter() *PostgresExporter {
+func (x *RDSExporter) GetProcessExecPath() string {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_PostgresExporter); ok {
-			return x.PostgresExporter
-		}
+		return x.ProcessExecPath
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAgentResponse) GetProxysqlExporter() *ProxySQLExporter {
+func (x *RDSExporter) GetLogLevel() LogLevel {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_ProxysqlExporter); ok {
-			return x.ProxysqlExporter
-		}
+		return x.LogLevel
 	}
-	return nil
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *GetAgentResponse) GetQanMysqlPerfschemaAgent() *QANMySQLPerfSchemaAgent {
+func (x *RDSExporter) GetAutoDiscoveryLimit() int32 {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_QanMysqlPerfschemaAgent); ok {
-			return x.QanMysqlPerfschemaAgent
-		}
+		return x.AutoDiscoveryLimit
 	}
-	return nil
+	return 0
 }
 
-func (x *GetAgentResponse) GetQanMysqlSlowlogAgent() *QANMySQLSlowlogAgent {
+func (x *RDSExporter) GetMetricsResolutions() *common.MetricsResolutions {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_QanMysqlSlowlogAgent); ok {
-			return x.QanMysqlSlowlogAgent
-		}
+		return x.MetricsResolutions
 	}
 	return nil
 }
 
-func (x *GetAgentResponse) GetQanMongodbProfilerAgent() *QANMongoDBProfilerAgent {
+// ExternalExporter runs on any Node type, including Remote Node.
+type ExternalExporter struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique randomly generated instance identifier.
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// Node identifier where this instance runs.
+	RunsOnNodeId string `protobuf:"bytes,2,opt,name=runs_on_node_id,json=runsOnNodeId,proto3" json:"runs_on_node_id,omitempty"`
+	// If disabled, metrics from this exporter will not be collected.
+	Disabled bool `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Service identifier.
+	ServiceId string `protobuf:"bytes,4,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// HTTP basic auth username for collecting metrics.
+	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	// Scheme to generate URI to exporter metrics endpoints.
+	Scheme string `protobuf:"bytes,6,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	// Path under which metrics are exposed, used to generate URI.
+	MetricsPath string `protobuf:"bytes,7,opt,name=metrics_path,json=metricsPath,proto3" json:"metrics_path,omitempty"`
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `protobuf:"bytes,8,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Listen port for scraping metrics.
+	ListenPort uint32 `protobuf:"varint,9,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
+	// True if exporter uses push metrics mode.
+	PushMetricsEnabled bool `protobuf:"varint,10,opt,name=push_metrics_enabled,json=pushMetricsEnabled,proto3" json:"push_metrics_enabled,omitempty"`
+	// Path to exec process.
+	ProcessExecPath string `protobuf:"bytes,11,opt,name=process_exec_path,json=processExecPath,proto3" json:"process_exec_path,omitempty"`
+	// Metrics resolution for this agent.
+	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,12,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
+	// Skip TLS certificate and hostname verification.
+	TlsSkipVerify bool `protobuf:"varint,13,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Actual Agent status.
+	Status        AgentStatus `protobuf:"varint,14,opt,name=status,proto3,enum=inventory.v1.AgentStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExternalExporter) Reset() {
+	*x = ExternalExporter{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExternalExporter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExternalExporter) ProtoMessage() {}
+
+func (x *ExternalExporter) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[23]
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_QanMongodbProfilerAgent); ok {
-			return x.QanMongodbProfilerAgent
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetAgentResponse) GetQanMongodbMongologAgent() *QANMongoDBMongologAgent {
+// Deprecated: Use ExternalExporter.ProtoReflect.Descriptor instead.
+func (*ExternalExporter) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ExternalExporter) GetAgentId() string {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_QanMongodbMongologAgent); ok {
-			return x.QanMongodbMongologAgent
-		}
+		return x.AgentId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAgentResponse) GetQanPostgresqlPgstatementsAgent() *QANPostgreSQLPgStatementsAgent {
+func (x *ExternalExporter) GetRunsOnNodeId() string {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_QanPostgresqlPgstatementsAgent); ok {
-			return x.QanPostgresqlPgstatementsAgent
-		}
+		return x.RunsOnNodeId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAgentResponse) GetQanPostgresqlPgstatmonitorAgent() *QANPostgreSQLPgStatMonitorAgent {
+func (x *ExternalExporter) GetDisabled() bool {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_QanPostgresqlPgstatmonitorAgent); ok {
-			return x.QanPostgresqlPgstatmonitorAgent
-		}
+		return x.Disabled
 	}
-	return nil
+	return false
 }
 
-func (x *GetAgentResponse) GetExternalExporter() *ExternalExporter {
+func (x *ExternalExporter) GetServiceId() string {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_ExternalExporter); ok {
-			return x.ExternalExporter
-		}
+		return x.ServiceId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAgentResponse) GetRdsExporter() *RDSExporter {
+func (x *ExternalExporter) GetUsername() string {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_RdsExporter); ok {
-			return x.RdsExporter
-		}
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAgentResponse) GetAzureDatabaseExporter() *AzureDatabaseExporter {
+func (x *ExternalExporter) GetScheme() string {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_AzureDatabaseExporter); ok {
-			return x.AzureDatabaseExporter
-		}
+		return x.Scheme
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAgentResponse) GetNomadAgent() *NomadAgent {
+func (x *ExternalExporter) GetMetricsPath() string {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_NomadAgent); ok {
-			return x.NomadAgent
-		}
+		return x.MetricsPath
 	}
-	return nil
+	return ""
 }
 
-func (x *GetAgentResponse) GetValkeyExporter() *ValkeyExporter {
+func (x *ExternalExporter) GetCustomLabels() map[string]string {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_ValkeyExporter); ok {
-			return x.ValkeyExporter
-		}
+		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *GetAgentResponse) GetRtaMongodbAgent() *RTAMongoDBAgent {
+func (x *ExternalExporter) GetListenPort() uint32 {
 	if x != nil {
-		if x, ok := x.Agent.(*GetAgentResponse_RtaMongodbAgent); ok {
-			return x.RtaMongodbAgent
-		}
+		return x.ListenPort
 	}
-	return nil
-}
-
-type isGetAgentResponse_Agent interface {
-	isGetAgentResponse_Agent()
-}
-
-type GetAgentResponse_PmmAgent struct {
-	PmmAgent *PMMAgent `protobuf:"bytes,1,opt,name=pmm_agent,json=pmmAgent,proto3,oneof"`
-}
-
-type GetAgentResponse_Vmagent struct {
-	Vmagent *VMAgent `protobuf:"bytes,2,opt,name=vmagent,proto3,oneof"`
-}
-
-type GetAgentResponse_NodeExporter struct {
-	NodeExporter *NodeExporter `protobuf:"bytes,3,opt,name=node_exporter,json=nodeExporter,proto3,oneof"`
-}
-
-type GetAgentResponse_MysqldExporter struct {
-	MysqldExporter *MySQLdExporter `protobuf:"bytes,4,opt,name=mysqld_exporter,json=mysqldExporter,proto3,oneof"`
-}
-
-type GetAgentResponse_MongodbExporter struct {
-	MongodbExporter *MongoDBExporter `protobuf:"bytes,5,opt,name=mongodb_exporter,json=mongodbExporter,proto3,oneof"`
-}
-
-type GetAgentResponse_PostgresExporter struct {
-	PostgresExporter *PostgresExporter `protobuf:"bytes,6,opt,name=postgres_exporter,json=postgresExporter,proto3,oneof"`
-}
-
-type GetAgentResponse_ProxysqlExporter struct {
-	ProxysqlExporter *ProxySQLExporter `protobuf:"bytes,7,opt,name=proxysql_exporter,json=proxysqlExporter,proto3,oneof"`
-}
-
-type GetAgentResponse_QanMysqlPerfschemaAgent struct {
-	QanMysqlPerfschemaAgent *QANMySQLPerfSchemaAgent `protobuf:"bytes,8,opt,name=qan_mysql_perfschema_agent,json=qanMysqlPerfschemaAgent,proto3,oneof"`
-}
-
-type GetAgentResponse_QanMysqlSlowlogAgent struct {
-	QanMysqlSlowlogAgent *QANMySQLSlowlogAgent `protobuf:"bytes,9,opt,name=qan_mysql_slowlog_agent,json=qanMysqlSlowlogAgent,proto3,oneof"`
-}
-
-type GetAgentResponse_QanMongodbProfilerAgent struct {
-	QanMongodbProfilerAgent *QANMongoDBProfilerAgent `protobuf:"bytes,10,opt,name=qan_mongodb_profiler_agent,json=qanMongodbProfilerAgent,proto3,oneof"`
-}
-
-type GetAgentResponse_QanMongodbMongologAgent struct {
-	QanMongodbMongologAgent *QANMongoDBMongologAgent `protobuf:"bytes,18,opt,name=qan_mongodb_mongolog_agent,json=qanMongodbMongologAgent,proto3,oneof"`
-}
-
-type GetAgentResponse_QanPostgresqlPgstatementsAgent struct {
-	QanPostgresqlPgstatementsAgent *QANPostgreSQLPgStatementsAgent `protobuf:"bytes,11,opt,name=qan_postgresql_pgstatements_agent,json=qanPostgresqlPgstatementsAgent,proto3,oneof"`
-}
-
-type GetAgentResponse_QanPostgresqlPgstatmonitorAgent struct {
-	QanPostgresqlPgstatmonitorAgent *QANPostgreSQLPgStatMonitorAgent `protobuf:"bytes,12,opt,name=qan_postgresql_pgstatmonitor_agent,json=qanPostgresqlPgstatmonitorAgent,proto3,oneof"`
-}
-
-type GetAgentResponse_ExternalExporter struct {
-	ExternalExporter *ExternalExporter `protobuf:"bytes,13,opt,name=external_exporter,json=externalExporter,proto3,oneof"`
+	return 0
 }
 
-type GetAgentResponse_RdsExporter struct {
-	RdsExporter *RDSExporter `protobuf:"bytes,14,opt,name=rds_exporter,json=rdsExporter,proto3,oneof"`
+func (x *ExternalExporter) GetPushMetricsEnabled() bool {
+	if x != nil {
+		return x.PushMetricsEnabled
+	}
+	return false
 }
 
-type GetAgentResponse_AzureDatabaseExporter struct {
-	AzureDatabaseExporter *AzureDatabaseExporter `protobuf:"bytes,15,opt,name=azure_database_exporter,json=azureDatabaseExporter,proto3,oneof"`
+func (x *ExternalExporter) GetProcessExecPath() string {
+	if x != nil {
+		return x.ProcessExecPath
+	}
+	return ""
 }
 
-type GetAgentResponse_NomadAgent struct {
-	NomadAgent *NomadAgent `protobuf:"bytes,16,opt,name=nomad_agent,json=nomadAgent,proto3,oneof"`
+func (x *ExternalExporter) GetMetricsResolutions() *common.MetricsResolutions {
+	if x != nil {
+		return x.MetricsResolutions
+	}
+	return nil
 }
 
-type GetAgentResponse_ValkeyExporter struct {
-	ValkeyExporter *ValkeyExporter `protobuf:"bytes,17,opt,name=valkey_exporter,json=valkeyExporter,proto3,oneof"`
+func (x *ExternalExporter) GetTlsSkipVerify() bool {
+	if x != nil {
+		return x.TlsSkipVerify
+	}
+	return false
 }
 
-type GetAgentResponse_RtaMongodbAgent struct {
-	RtaMongodbAgent *RTAMongoDBAgent `protobuf:"bytes,19,opt,name=rta_mongodb_agent,json=rtaMongodbAgent,proto3,oneof"`
+func (x *ExternalExporter) GetStatus() AgentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AgentStatus_AGENT_STATUS_UNSPECIFIED
 }
 
-func (*GetAgentResponse_PmmAgent) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_Vmagent) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_NodeExporter) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_MysqldExporter) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_MongodbExporter) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_PostgresExporter) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_ProxysqlExporter) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_QanMysqlPerfschemaAgent) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_QanMysqlSlowlogAgent) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_QanMongodbProfilerAgent) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_QanMongodbMongologAgent) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_QanPostgresqlPgstatementsAgent) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_QanPostgresqlPgstatmonitorAgent) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_ExternalExporter) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_RdsExporter) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_AzureDatabaseExporter) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_NomadAgent) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_ValkeyExporter) isGetAgentResponse_Agent() {}
-
-func (*GetAgentResponse_RtaMongodbAgent) isGetAgentResponse_Agent() {}
-
-type GetAgentLogsRequest struct {
+// AzureDatabaseExporter runs on Generic or Container Node and exposes RemoteAzure Node metrics.
+type AzureDatabaseExporter struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Unique randomly generated instance identifier.
 	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
-	// Limit the number of log lines to this value. Pass 0 for no limit.
-	Limit         uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// The pmm-agent identifier which runs this instance.
+	PmmAgentId string `protobuf:"bytes,2,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `protobuf:"varint,3,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Node identifier.
+	NodeId string `protobuf:"bytes,4,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// Azure database subscription ID.
+	AzureDatabaseSubscriptionId string `protobuf:"bytes,5,opt,name=azure_database_subscription_id,json=azureDatabaseSubscriptionId,proto3" json:"azure_database_subscription_id,omitempty"`
+	// Azure database resource type (mysql, maria, postgres)
+	AzureDatabaseResourceType string `protobuf:"bytes,6,opt,name=azure_database_resource_type,json=azureDatabaseResourceType,proto3" json:"azure_database_resource_type,omitempty"`
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `protobuf:"bytes,7,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Actual Agent status (the same for several configurations).
+	Status AgentStatus `protobuf:"varint,10,opt,name=status,proto3,enum=inventory.v1.AgentStatus" json:"status,omitempty"`
+	// Listen port for scraping metrics (the same for several configurations).
+	ListenPort uint32 `protobuf:"varint,11,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
+	// True if the exporter operates in push metrics mode.
+	PushMetricsEnabled bool `protobuf:"varint,12,opt,name=push_metrics_enabled,json=pushMetricsEnabled,proto3" json:"push_metrics_enabled,omitempty"`
+	// Path to exec process.
+	ProcessExecPath string `protobuf:"bytes,13,opt,name=process_exec_path,json=processExecPath,proto3" json:"process_exec_path,omitempty"`
+	// Log level for exporter.
+	LogLevel LogLevel `protobuf:"varint,14,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	// Metrics resolution for this agent.
+	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,15,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *GetAgentLogsRequest) Reset() {
-	*x = GetAgentLogsRequest{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[25]
+func (x *AzureDatabaseExporter) Reset() {
+	*x = AzureDatabaseExporter{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAgentLogsRequest) String() string {
+func (x *AzureDatabaseExporter) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAgentLogsRequest) ProtoMessage() {}
+func (*AzureDatabaseExporter) ProtoMessage() {}
 
-func (x *GetAgentLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[25]
+func (x *AzureDatabaseExporter) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4056,118 +3939,132 @@ func (x *GetAgentLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAgentLogsRequest.ProtoReflect.Descriptor instead.
-func (*GetAgentLogsRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use AzureDatabaseExporter.ProtoReflect.Descriptor instead.
+func (*AzureDatabaseExporter) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *GetAgentLogsRequest) GetAgentId() string {
+func (x *AzureDatabaseExporter) GetAgentId() string {
 	if x != nil {
 		return x.AgentId
 	}
 	return ""
 }
 
-func (x *GetAgentLogsRequest) GetLimit() uint32 {
+func (x *AzureDatabaseExporter) GetPmmAgentId() string {
 	if x != nil {
-		return x.Limit
+		return x.PmmAgentId
 	}
-	return 0
+	return ""
 }
 
-type GetAgentLogsResponse struct {
-	state                    protoimpl.MessageState `protogen:"open.v1"`
-	Logs                     []string               `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
-	AgentConfigLogLinesCount uint32                 `protobuf:"varint,2,opt,name=agent_config_log_lines_count,json=agentConfigLogLinesCount,proto3" json:"agent_config_log_lines_count,omitempty"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+func (x *AzureDatabaseExporter) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
 }
 
-func (x *GetAgentLogsResponse) Reset() {
-	*x = GetAgentLogsResponse{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[26]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AzureDatabaseExporter) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
 }
 
-func (x *GetAgentLogsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AzureDatabaseExporter) GetAzureDatabaseSubscriptionId() string {
+	if x != nil {
+		return x.AzureDatabaseSubscriptionId
+	}
+	return ""
 }
 
-func (*GetAgentLogsResponse) ProtoMessage() {}
-
-func (x *GetAgentLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[26]
+func (x *AzureDatabaseExporter) GetAzureDatabaseResourceType() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.AzureDatabaseResourceType
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GetAgentLogsResponse.ProtoReflect.Descriptor instead.
-func (*GetAgentLogsResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{26}
+func (x *AzureDatabaseExporter) GetCustomLabels() map[string]string {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
 }
 
-func (x *GetAgentLogsResponse) GetLogs() []string {
+func (x *AzureDatabaseExporter) GetStatus() AgentStatus {
 	if x != nil {
-		return x.Logs
+		return x.Status
 	}
-	return nil
+	return AgentStatus_AGENT_STATUS_UNSPECIFIED
 }
 
-func (x *GetAgentLogsResponse) GetAgentConfigLogLinesCount() uint32 {
+func (x *AzureDatabaseExporter) GetListenPort() uint32 {
 	if x != nil {
-		return x.AgentConfigLogLinesCount
+		return x.ListenPort
 	}
 	return 0
 }
 
-type AddAgentRequest struct {
+func (x *AzureDatabaseExporter) GetPushMetricsEnabled() bool {
+	if x != nil {
+		return x.PushMetricsEnabled
+	}
+	return false
+}
+
+func (x *AzureDatabaseExporter) GetProcessExecPath() string {
+	if x != nil {
+		return x.ProcessExecPath
+	}
+	return ""
+}
+
+func (x *AzureDatabaseExporter) GetLogLevel() LogLevel {
+	if x != nil {
+		return x.LogLevel
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *AzureDatabaseExporter) GetMetricsResolutions() *common.MetricsResolutions {
+	if x != nil {
+		return x.MetricsResolutions
+	}
+	return nil
+}
+
+// ChangeCommonAgentParams contains parameters that can be changed for all Agents.
+type ChangeCommonAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Agent:
-	//
-	//	*AddAgentRequest_PmmAgent
-	//	*AddAgentRequest_NodeExporter
-	//	*AddAgentRequest_MysqldExporter
-	//	*AddAgentRequest_MongodbExporter
-	//	*AddAgentRequest_PostgresExporter
-	//	*AddAgentRequest_ProxysqlExporter
-	//	*AddAgentRequest_ExternalExporter
-	//	*AddAgentRequest_RdsExporter
-	//	*AddAgentRequest_AzureDatabaseExporter
-	//	*AddAgentRequest_QanMysqlPerfschemaAgent
-	//	*AddAgentRequest_QanMysqlSlowlogAgent
-	//	*AddAgentRequest_QanMongodbProfilerAgent
-	//	*AddAgentRequest_QanMongodbMongologAgent
-	//	*AddAgentRequest_QanPostgresqlPgstatementsAgent
-	//	*AddAgentRequest_QanPostgresqlPgstatmonitorAgent
-	//	*AddAgentRequest_ValkeyExporter
-	//	*AddAgentRequest_RtaMongodbAgent
-	Agent         isAddAgentRequest_Agent `protobuf_oneof:"agent"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Enable this Agent. Agents are enabled by default when they get added.
+	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
+	// Replace all custom user-assigned labels.
+	CustomLabels *common.StringMap `protobuf:"bytes,2,opt,name=custom_labels,json=customLabels,proto3,oneof" json:"custom_labels,omitempty"`
+	// Enables push metrics with vmagent.
+	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
+	// Metrics resolution for this agent.
+	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
-func (x *AddAgentRequest) Reset() {
-	*x = AddAgentRequest{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[27]
+func (x *ChangeCommonAgentParams) Reset() {
+	*x = ChangeCommonAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddAgentRequest) String() string {
+func (x *ChangeCommonAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAgentRequest) ProtoMessage() {}
+func (*ChangeCommonAgentParams) ProtoMessage() {}
 
-func (x *AddAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[27]
+func (x *ChangeCommonAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4178,318 +4075,159 @@ func (x *AddAgentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAgentRequest.ProtoReflect.Descriptor instead.
-func (*AddAgentRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{27}
-}
-
-func (x *AddAgentRequest) GetAgent() isAddAgentRequest_Agent {
-	if x != nil {
-		return x.Agent
-	}
-	return nil
-}
-
-func (x *AddAgentRequest) GetPmmAgent() *AddPMMAgentParams {
-	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_PmmAgent); ok {
-			return x.PmmAgent
-		}
-	}
-	return nil
+// Deprecated: Use ChangeCommonAgentParams.ProtoReflect.Descriptor instead.
+func (*ChangeCommonAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *AddAgentRequest) GetNodeExporter() *AddNodeExporterParams {
-	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_NodeExporter); ok {
-			return x.NodeExporter
-		}
+func (x *ChangeCommonAgentParams) GetEnable() bool {
+	if x != nil && x.Enable != nil {
+		return *x.Enable
 	}
-	return nil
+	return false
 }
 
-func (x *AddAgentRequest) GetMysqldExporter() *AddMySQLdExporterParams {
+func (x *ChangeCommonAgentParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_MysqldExporter); ok {
-			return x.MysqldExporter
-		}
+		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *AddAgentRequest) GetMongodbExporter() *AddMongoDBExporterParams {
-	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_MongodbExporter); ok {
-			return x.MongodbExporter
-		}
+func (x *ChangeCommonAgentParams) GetEnablePushMetrics() bool {
+	if x != nil && x.EnablePushMetrics != nil {
+		return *x.EnablePushMetrics
 	}
-	return nil
+	return false
 }
 
-func (x *AddAgentRequest) GetPostgresExporter() *AddPostgresExporterParams {
+func (x *ChangeCommonAgentParams) GetMetricsResolutions() *common.MetricsResolutions {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_PostgresExporter); ok {
-			return x.PostgresExporter
-		}
+		return x.MetricsResolutions
 	}
 	return nil
 }
 
-func (x *AddAgentRequest) GetProxysqlExporter() *AddProxySQLExporterParams {
-	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_ProxysqlExporter); ok {
-			return x.ProxysqlExporter
-		}
-	}
-	return nil
+type ListAgentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Return only Agents started by this pmm-agent.
+	// Exactly one of these parameters should be present: pmm_agent_id, node_id, service_id.
+	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
+	// Return only Agents that provide insights for that Node.
+	// Exactly one of these parameters should be present: pmm_agent_id, node_id, service_id.
+	NodeId string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// Return only Agents that provide insights for that Service.
+	// Exactly one of these parameters should be present: pmm_agent_id, node_id, service_id.
+	ServiceId string `protobuf:"bytes,3,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Return only agents of a particular type.
+	AgentType     AgentType `protobuf:"varint,4,opt,name=agent_type,json=agentType,proto3,enum=inventory.v1.AgentType" json:"agent_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddAgentRequest) GetExternalExporter() *AddExternalExporterParams {
-	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_ExternalExporter); ok {
-			return x.ExternalExporter
-		}
-	}
-	return nil
+func (x *ListAgentsRequest) Reset() {
+	*x = ListAgentsRequest{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddAgentRequest) GetRdsExporter() *AddRDSExporterParams {
-	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_RdsExporter); ok {
-			return x.RdsExporter
-		}
-	}
-	return nil
+func (x *ListAgentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *AddAgentRequest) GetAzureDatabaseExporter() *AddAzureDatabaseExporterParams {
-	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_AzureDatabaseExporter); ok {
-			return x.AzureDatabaseExporter
-		}
-	}
-	return nil
-}
+func (*ListAgentsRequest) ProtoMessage() {}
 
-func (x *AddAgentRequest) GetQanMysqlPerfschemaAgent() *AddQANMySQLPerfSchemaAgentParams {
+func (x *ListAgentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[26]
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_QanMysqlPerfschemaAgent); ok {
-			return x.QanMysqlPerfschemaAgent
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *AddAgentRequest) GetQanMysqlSlowlogAgent() *AddQANMySQLSlowlogAgentParams {
-	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_QanMysqlSlowlogAgent); ok {
-			return x.QanMysqlSlowlogAgent
-		}
-	}
-	return nil
+// Deprecated: Use ListAgentsRequest.ProtoReflect.Descriptor instead.
+func (*ListAgentsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *AddAgentRequest) GetQanMongodbProfilerAgent() *AddQANMongoDBProfilerAgentParams {
+func (x *ListAgentsRequest) GetPmmAgentId() string {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_QanMongodbProfilerAgent); ok {
-			return x.QanMongodbProfilerAgent
-		}
+		return x.PmmAgentId
 	}
-	return nil
+	return ""
 }
 
-func (x *AddAgentRequest) GetQanMongodbMongologAgent() *AddQANMongoDBMongologAgentParams {
+func (x *ListAgentsRequest) GetNodeId() string {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_QanMongodbMongologAgent); ok {
-			return x.QanMongodbMongologAgent
-		}
+		return x.NodeId
 	}
-	return nil
+	return ""
 }
 
-func (x *AddAgentRequest) GetQanPostgresqlPgstatementsAgent() *AddQANPostgreSQLPgStatementsAgentParams {
+func (x *ListAgentsRequest) GetServiceId() string {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_QanPostgresqlPgstatementsAgent); ok {
-			return x.QanPostgresqlPgstatementsAgent
-		}
+		return x.ServiceId
 	}
-	return nil
+	return ""
 }
 
-func (x *AddAgentRequest) GetQanPostgresqlPgstatmonitorAgent() *AddQANPostgreSQLPgStatMonitorAgentParams {
+func (x *ListAgentsRequest) GetAgentType() AgentType {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_QanPostgresqlPgstatmonitorAgent); ok {
-			return x.QanPostgresqlPgstatmonitorAgent
-		}
+		return x.AgentType
 	}
-	return nil
+	return AgentType_AGENT_TYPE_UNSPECIFIED
 }
 
-func (x *AddAgentRequest) GetValkeyExporter() *AddValkeyExporterParams {
-	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_ValkeyExporter); ok {
-			return x.ValkeyExporter
-		}
-	}
-	return nil
-}
-
-func (x *AddAgentRequest) GetRtaMongodbAgent() *AddRTAMongoDBAgentParams {
-	if x != nil {
-		if x, ok := x.Agent.(*AddAgentRequest_RtaMongodbAgent); ok {
-			return x.RtaMongodbAgent
-		}
-	}
-	return nil
-}
-
-type isAddAgentRequest_Agent interface {
-	isAddAgentRequest_Agent()
-}
-
-type AddAgentRequest_PmmAgent struct {
-	PmmAgent *AddPMMAgentParams `protobuf:"bytes,1,opt,name=pmm_agent,json=pmmAgent,proto3,oneof"`
-}
-
-type AddAgentRequest_NodeExporter struct {
-	NodeExporter *AddNodeExporterParams `protobuf:"bytes,2,opt,name=node_exporter,json=nodeExporter,proto3,oneof"`
-}
-
-type AddAgentRequest_MysqldExporter struct {
-	MysqldExporter *AddMySQLdExporterParams `protobuf:"bytes,3,opt,name=mysqld_exporter,json=mysqldExporter,proto3,oneof"`
-}
-
-type AddAgentRequest_MongodbExporter struct {
-	MongodbExporter *AddMongoDBExporterParams `protobuf:"bytes,4,opt,name=mongodb_exporter,json=mongodbExporter,proto3,oneof"`
-}
-
-type AddAgentRequest_PostgresExporter struct {
-	PostgresExporter *AddPostgresExporterParams `protobuf:"bytes,5,opt,name=postgres_exporter,json=postgresExporter,proto3,oneof"`
-}
-
-type AddAgentRequest_ProxysqlExporter struct {
-	ProxysqlExporter *AddProxySQLExporterParams `protobuf:"bytes,6,opt,name=proxysql_exporter,json=proxysqlExporter,proto3,oneof"`
-}
-
-type AddAgentRequest_ExternalExporter struct {
-	ExternalExporter *AddExternalExporterParams `protobuf:"bytes,7,opt,name=external_exporter,json=externalExporter,proto3,oneof"`
-}
-
-type AddAgentRequest_RdsExporter struct {
-	RdsExporter *AddRDSExporterParams `protobuf:"bytes,8,opt,name=rds_exporter,json=rdsExporter,proto3,oneof"`
-}
-
-type AddAgentRequest_AzureDatabaseExporter struct {
-	AzureDatabaseExporter *AddAzureDatabaseExporterParams `protobuf:"bytes,9,opt,name=azure_database_exporter,json=azureDatabaseExporter,proto3,oneof"`
-}
-
-type AddAgentRequest_QanMysqlPerfschemaAgent struct {
-	QanMysqlPerfschemaAgent *AddQANMySQLPerfSchemaAgentParams `protobuf:"bytes,10,opt,name=qan_mysql_perfschema_agent,json=qanMysqlPerfschemaAgent,proto3,oneof"`
-}
-
-type AddAgentRequest_QanMysqlSlowlogAgent struct {
-	QanMysqlSlowlogAgent *AddQANMySQLSlowlogAgentParams `protobuf:"bytes,11,opt,name=qan_mysql_slowlog_agent,json=qanMysqlSlowlogAgent,proto3,oneof"`
-}
-
-type AddAgentRequest_QanMongodbProfilerAgent struct {
-	QanMongodbProfilerAgent *AddQANMongoDBProfilerAgentParams `protobuf:"bytes,12,opt,name=qan_mongodb_profiler_agent,json=qanMongodbProfilerAgent,proto3,oneof"`
-}
-
-type AddAgentRequest_QanMongodbMongologAgent struct {
-	QanMongodbMongologAgent *AddQANMongoDBMongologAgentParams `protobuf:"bytes,16,opt,name=qan_mongodb_mongolog_agent,json=qanMongodbMongologAgent,proto3,oneof"`
-}
-
-type AddAgentRequest_QanPostgresqlPgstatementsAgent struct {
-	QanPostgresqlPgstatementsAgent *AddQANPostgreSQLPgStatementsAgentParams `protobuf:"bytes,13,opt,name=qan_postgresql_pgstatements_agent,json=qanPostgresqlPgstatementsAgent,proto3,oneof"`
-}
-
-type AddAgentRequest_QanPostgresqlPgstatmonitorAgent struct {
-	QanPostgresqlPgstatmonitorAgent *AddQANPostgreSQLPgStatMonitorAgentParams `protobuf:"bytes,14,opt,name=qan_postgresql_pgstatmonitor_agent,json=qanPostgresqlPgstatmonitorAgent,proto3,oneof"`
-}
-
-type AddAgentRequest_ValkeyExporter struct {
-	ValkeyExporter *AddValkeyExporterParams `protobuf:"bytes,15,opt,name=valkey_exporter,json=valkeyExporter,proto3,oneof"`
-}
-
-type AddAgentRequest_RtaMongodbAgent struct {
-	RtaMongodbAgent *AddRTAMongoDBAgentParams `protobuf:"bytes,17,opt,name=rta_mongodb_agent,json=rtaMongodbAgent,proto3,oneof"`
-}
-
-func (*AddAgentRequest_PmmAgent) isAddAgentRequest_Agent() {}
-
-func (*AddAgentRequest_NodeExporter) isAddAgentRequest_Agent() {}
-
-func (*AddAgentRequest_MysqldExporter) isAddAgentRequest_Agent() {}
-
-func (*AddAgentRequest_MongodbExporter) isAddAgentRequest_Agent() {}
-
-func (*AddAgentRequest_PostgresExporter) isAddAgentRequest_Agent() {}
-
-func (*AddAgentRequest_ProxysqlExporter) isAddAgentRequest_Agent() {}
-
-func (*AddAgentRequest_ExternalExporter) isAddAgentRequest_Agent() {}
-
-func (*AddAgentRequest_RdsExporter) isAddAgentRequest_Agent() {}
-
-func (*AddAgentRequest_AzureDatabaseExporter) isAddAgentRequest_Agent() {}
-
-func (*AddAgentRequest_QanMysqlPerfschemaAgent) isAddAgentRequest_Agent() {}
-
-func (*AddAgentRequest_QanMysqlSlowlogAgent) isAddAgentRequest_Agent() {}
-
-func (*AddAgentRequest_QanMongodbProfilerAgent) isAddAgentRequest_Agent() {}
-
-func (*AddAgentRequest_QanMongodbMongologAgent) isAddAgentRequest_Agent() {}
-
-func (*AddAgentRequest_QanPostgresqlPgstatementsAgent) isAddAgentRequest_Agent() {}
-
-func (*AddAgentRequest_QanPostgresqlPgstatmonitorAgent) isAddAgentRequest_Agent() {}
-
-func (*AddAgentRequest_ValkeyExporter) isAddAgentRequest_Agent() {}
-
-func (*AddAgentRequest_RtaMongodbAgent) isAddAgentRequest_Agent() {}
-
-type AddAgentResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Agent:
-	//
-	//	*AddAgentResponse_PmmAgent
-	//	*AddAgentResponse_NodeExporter
-	//	*AddAgentResponse_MysqldExporter
-	//	*AddAgentResponse_MongodbExporter
-	//	*AddAgentResponse_PostgresExporter
-	//	*AddAgentResponse_ProxysqlExporter
-	//	*AddAgentResponse_ExternalExporter
-	//	*AddAgentResponse_RdsExporter
-	//	*AddAgentResponse_AzureDatabaseExporter
-	//	*AddAgentResponse_QanMysqlPerfschemaAgent
-	//	*AddAgentResponse_QanMysqlSlowlogAgent
-	//	*AddAgentResponse_QanMongodbProfilerAgent
-	//	*AddAgentResponse_QanMongodbMongologAgent
-	//	*AddAgentResponse_QanPostgresqlPgstatementsAgent
-	//	*AddAgentResponse_QanPostgresqlPgstatmonitorAgent
-	//	*AddAgentResponse_ValkeyExporter
-	//	*AddAgentResponse_RtaMongodbAgent
-	Agent         isAddAgentResponse_Agent `protobuf_oneof:"agent"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type ListAgentsResponse struct {
+	state                           protoimpl.MessageState             `protogen:"open.v1"`
+	PmmAgent                        []*PMMAgent                        `protobuf:"bytes,1,rep,name=pmm_agent,json=pmmAgent,proto3" json:"pmm_agent,omitempty"`
+	VmAgent                         []*VMAgent                         `protobuf:"bytes,2,rep,name=vm_agent,json=vmAgent,proto3" json:"vm_agent,omitempty"`
+	NodeExporter                    []*NodeExporter                    `protobuf:"bytes,3,rep,name=node_exporter,json=nodeExporter,proto3" json:"node_exporter,omitempty"`
+	MysqldExporter                  []*MySQLdExporter                  `protobuf:"bytes,4,rep,name=mysqld_exporter,json=mysqldExporter,proto3" json:"mysqld_exporter,omitempty"`
+	MongodbExporter                 []*MongoDBExporter                 `protobuf:"bytes,5,rep,name=mongodb_exporter,json=mongodbExporter,proto3" json:"mongodb_exporter,omitempty"`
+	PostgresExporter                []*PostgresExporter                `protobuf:"bytes,6,rep,name=postgres_exporter,json=postgresExporter,proto3" json:"postgres_exporter,omitempty"`
+	ProxysqlExporter                []*ProxySQLExporter                `protobuf:"bytes,7,rep,name=proxysql_exporter,json=proxysqlExporter,proto3" json:"proxysql_exporter,omitempty"`
+	QanMysqlPerfschemaAgent         []*QANMySQLPerfSchemaAgent         `protobuf:"bytes,8,rep,name=qan_mysql_perfschema_agent,json=qanMysqlPerfschemaAgent,proto3" json:"qan_mysql_perfschema_agent,omitempty"`
+	QanMysqlSlowlogAgent            []*QANMySQLSlowlogAgent            `protobuf:"bytes,9,rep,name=qan_mysql_slowlog_agent,json=qanMysqlSlowlogAgent,proto3" json:"qan_mysql_slowlog_agent,omitempty"`
+	QanMongodbProfilerAgent         []*QANMongoDBProfilerAgent         `protobuf:"bytes,10,rep,name=qan_mongodb_profiler_agent,json=qanMongodbProfilerAgent,proto3" json:"qan_mongodb_profiler_agent,omitempty"`
+	QanMongodbMongologAgent         []*QANMongoDBMongologAgent         `protobuf:"bytes,18,rep,name=qan_mongodb_mongolog_agent,json=qanMongodbMongologAgent,proto3" json:"qan_mongodb_mongolog_agent,omitempty"`
+	QanPostgresqlPgstatementsAgent  []*QANPostgreSQLPgStatementsAgent  `protobuf:"bytes,11,rep,name=qan_postgresql_pgstatements_agent,json=qanPostgresqlPgstatementsAgent,proto3" json:"qan_postgresql_pgstatements_agent,omitempty"`
+	QanPostgresqlPgstatmonitorAgent []*QANPostgreSQLPgStatMonitorAgent `protobuf:"bytes,12,rep,name=qan_postgresql_pgstatmonitor_agent,json=qanPostgresqlPgstatmonitorAgent,proto3" json:"qan_postgresql_pgstatmonitor_agent,omitempty"`
+	ExternalExporter                []*ExternalExporter                `protobuf:"bytes,13,rep,name=external_exporter,json=externalExporter,proto3" json:"external_exporter,omitempty"`
+	RdsExporter                     []*RDSExporter                     `protobuf:"bytes,14,rep,name=rds_exporter,json=rdsExporter,proto3" json:"rds_exporter,omitempty"`
+	AzureDatabaseExporter           []*AzureDatabaseExporter           `protobuf:"bytes,15,rep,name=azure_database_exporter,json=azureDatabaseExporter,proto3" json:"azure_database_exporter,omitempty"`
+	NomadAgent                      []*NomadAgent                      `protobuf:"bytes,16,rep,name=nomad_agent,json=nomadAgent,proto3" json:"nomad_agent,omitempty"`
+	ValkeyExporter                  []*ValkeyExporter                  `protobuf:"bytes,17,rep,name=valkey_exporter,json=valkeyExporter,proto3" json:"valkey_exporter,omitempty"`
+	RtaMongodbAgent                 []*RTAMongoDBAgent                 `protobuf:"bytes,19,rep,name=rta_mongodb_agent,json=rtaMongodbAgent,proto3" json:"rta_mongodb_agent,omitempty"`
+	RtaMysqlAgent                   []*RTAMySQLAgent                   `protobuf:"bytes,20,rep,name=rta_mysql_agent,json=rtaMysqlAgent,proto3" json:"rta_mysql_agent,omitempty"`
+	RtaPostgresqlAgent              []*RTAPostgreSQLAgent              `protobuf:"bytes,21,rep,name=rta_postgresql_agent,json=rtaPostgresqlAgent,proto3" json:"rta_postgresql_agent,omitempty"`
+	QanValkeySlowlogAgent           []*QANValkeySlowlogAgent           `protobuf:"bytes,22,rep,name=qan_valkey_slowlog_agent,json=qanValkeySlowlogAgent,proto3" json:"qan_valkey_slowlog_agent,omitempty"`
+	QanProxysqlDigestAgent          []*QANProxySQLDigestAgent          `protobuf:"bytes,23,rep,name=qan_proxysql_digest_agent,json=qanProxysqlDigestAgent,proto3" json:"qan_proxysql_digest_agent,omitempty"`
+	QanPostgresqlLogAgent           []*QANPostgreSQLLogAgent           `protobuf:"bytes,24,rep,name=qan_postgresql_log_agent,json=qanPostgresqlLogAgent,proto3" json:"qan_postgresql_log_agent,omitempty"`
+	unknownFields                   protoimpl.UnknownFields
+	sizeCache                       protoimpl.SizeCache
 }
 
-func (x *AddAgentResponse) Reset() {
-	*x = AddAgentResponse{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[28]
+func (x *ListAgentsResponse) Reset() {
+	*x = ListAgentsResponse{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddAgentResponse) String() string {
+func (x *ListAgentsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAgentResponse) ProtoMessage() {}
+func (*ListAgentsResponse) ProtoMessage() {}
 
-func (x *AddAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[28]
+func (x *ListAgentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4500,318 +4238,271 @@ func (x *AddAgentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAgentResponse.ProtoReflect.Descriptor instead.
-func (*AddAgentResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ListAgentsResponse.ProtoReflect.Descriptor instead.
+func (*ListAgentsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *AddAgentResponse) GetAgent() isAddAgentResponse_Agent {
+func (x *ListAgentsResponse) GetPmmAgent() []*PMMAgent {
 	if x != nil {
-		return x.Agent
+		return x.PmmAgent
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetPmmAgent() *PMMAgent {
+func (x *ListAgentsResponse) GetVmAgent() []*VMAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_PmmAgent); ok {
-			return x.PmmAgent
-		}
+		return x.VmAgent
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetNodeExporter() *NodeExporter {
+func (x *ListAgentsResponse) GetNodeExporter() []*NodeExporter {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_NodeExporter); ok {
-			return x.NodeExporter
-		}
+		return x.NodeExporter
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetMysqldExporter() *MySQLdExporter {
+func (x *ListAgentsResponse) GetMysqldExporter() []*MySQLdExporter {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_MysqldExporter); ok {
-			return x.MysqldExporter
-		}
+		return x.MysqldExporter
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetMongodbExporter() *MongoDBExporter {
+func (x *ListAgentsResponse) GetMongodbExporter() []*MongoDBExporter {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_MongodbExporter); ok {
-			return x.MongodbExporter
-		}
+		return x.MongodbExporter
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetPostgresExporter() *PostgresExporter {
+func (x *ListAgentsResponse) GetPostgresExporter() []*PostgresExporter {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_PostgresExporter); ok {
-			return x.PostgresExporter
-		}
+		return x.PostgresExporter
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetProxysqlExporter() *ProxySQLExporter {
+func (x *ListAgentsResponse) GetProxysqlExporter() []*ProxySQLExporter {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_ProxysqlExporter); ok {
-			return x.ProxysqlExporter
-		}
+		return x.ProxysqlExporter
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetExternalExporter() *ExternalExporter {
+func (x *ListAgentsResponse) GetQanMysqlPerfschemaAgent() []*QANMySQLPerfSchemaAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_ExternalExporter); ok {
-			return x.ExternalExporter
-		}
+		return x.QanMysqlPerfschemaAgent
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetRdsExporter() *RDSExporter {
+func (x *ListAgentsResponse) GetQanMysqlSlowlogAgent() []*QANMySQLSlowlogAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_RdsExporter); ok {
-			return x.RdsExporter
-		}
+		return x.QanMysqlSlowlogAgent
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetAzureDatabaseExporter() *AzureDatabaseExporter {
+func (x *ListAgentsResponse) GetQanMongodbProfilerAgent() []*QANMongoDBProfilerAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_AzureDatabaseExporter); ok {
-			return x.AzureDatabaseExporter
-		}
+		return x.QanMongodbProfilerAgent
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetQanMysqlPerfschemaAgent() *QANMySQLPerfSchemaAgent {
+func (x *ListAgentsResponse) GetQanMongodbMongologAgent() []*QANMongoDBMongologAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_QanMysqlPerfschemaAgent); ok {
-			return x.QanMysqlPerfschemaAgent
-		}
+		return x.QanMongodbMongologAgent
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetQanMysqlSlowlogAgent() *QANMySQLSlowlogAgent {
+func (x *ListAgentsResponse) GetQanPostgresqlPgstatementsAgent() []*QANPostgreSQLPgStatementsAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_QanMysqlSlowlogAgent); ok {
-			return x.QanMysqlSlowlogAgent
-		}
+		return x.QanPostgresqlPgstatementsAgent
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetQanMongodbProfilerAgent() *QANMongoDBProfilerAgent {
+func (x *ListAgentsResponse) GetQanPostgresqlPgstatmonitorAgent() []*QANPostgreSQLPgStatMonitorAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_QanMongodbProfilerAgent); ok {
-			return x.QanMongodbProfilerAgent
-		}
+		return x.QanPostgresqlPgstatmonitorAgent
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetQanMongodbMongologAgent() *QANMongoDBMongologAgent {
+func (x *ListAgentsResponse) GetExternalExporter() []*ExternalExporter {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_QanMongodbMongologAgent); ok {
-			return x.QanMongodbMongologAgent
-		}
+		return x.ExternalExporter
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetQanPostgresqlPgstatementsAgent() *QANPostgreSQLPgStatementsAgent {
+func (x *ListAgentsResponse) GetRdsExporter() []*RDSExporter {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_QanPostgresqlPgstatementsAgent); ok {
-			return x.QanPostgresqlPgstatementsAgent
-		}
+		return x.RdsExporter
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetQanPostgresqlPgstatmonitorAgent() *QANPostgreSQLPgStatMonitorAgent {
+func (x *ListAgentsResponse) GetAzureDatabaseExporter() []*AzureDatabaseExporter {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_QanPostgresqlPgstatmonitorAgent); ok {
-			return x.QanPostgresqlPgstatmonitorAgent
-		}
+		return x.AzureDatabaseExporter
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetValkeyExporter() *ValkeyExporter {
+func (x *ListAgentsResponse) GetNomadAgent() []*NomadAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_ValkeyExporter); ok {
-			return x.ValkeyExporter
-		}
+		return x.NomadAgent
 	}
 	return nil
 }
 
-func (x *AddAgentResponse) GetRtaMongodbAgent() *RTAMongoDBAgent {
+func (x *ListAgentsResponse) GetValkeyExporter() []*ValkeyExporter {
 	if x != nil {
-		if x, ok := x.Agent.(*AddAgentResponse_RtaMongodbAgent); ok {
-			return x.RtaMongodbAgent
-		}
+		return x.ValkeyExporter
 	}
 	return nil
 }
 
-type isAddAgentResponse_Agent interface {
-	isAddAgentResponse_Agent()
-}
-
-type AddAgentResponse_PmmAgent struct {
-	PmmAgent *PMMAgent `protobuf:"bytes,1,opt,name=pmm_agent,json=pmmAgent,proto3,oneof"`
-}
-
-type AddAgentResponse_NodeExporter struct {
-	NodeExporter *NodeExporter `protobuf:"bytes,2,opt,name=node_exporter,json=nodeExporter,proto3,oneof"`
-}
-
-type AddAgentResponse_MysqldExporter struct {
-	MysqldExporter *MySQLdExporter `protobuf:"bytes,3,opt,name=mysqld_exporter,json=mysqldExporter,proto3,oneof"`
-}
-
-type AddAgentResponse_MongodbExporter struct {
-	MongodbExporter *MongoDBExporter `protobuf:"bytes,4,opt,name=mongodb_exporter,json=mongodbExporter,proto3,oneof"`
-}
-
-type AddAgentResponse_PostgresExporter struct {
-	PostgresExporter *PostgresExporter `protobuf:"bytes,5,opt,name=postgres_exporter,json=postgresExporter,proto3,oneof"`
+func (x *ListAgentsResponse) GetRtaMongodbAgent() []*RTAMongoDBAgent {
+	if x != nil {
+		return x.RtaMongodbAgent
+	}
+	return nil
 }
 
-type AddAgentResponse_ProxysqlExporter struct {
-	ProxysqlExporter *ProxySQLExporter `protobuf:"bytes,6,opt,name=proxysql_exporter,json=proxysqlExporter,proto3,oneof"`
+func (x *ListAgentsResponse) GetRtaMysqlAgent() []*RTAMySQLAgent {
+	if x != nil {
+		return x.RtaMysqlAgent
+	}
+	return nil
 }
 
-type AddAgentResponse_ExternalExporter struct {
-	ExternalExporter *ExternalExporter `protobuf:"bytes,7,opt,name=external_exporter,json=externalExporter,proto3,oneof"`
+func (x *ListAgentsResponse) GetRtaPostgresqlAgent() []*RTAPostgreSQLAgent {
+	if x != nil {
+		return x.RtaPostgresqlAgent
+	}
+	return nil
 }
 
-type AddAgentResponse_RdsExporter struct {
-	RdsExporter *RDSExporter `protobuf:"bytes,8,opt,name=rds_exporter,json=rdsExporter,proto3,oneof"`
+func (x *ListAgentsResponse) GetQanValkeySlowlogAgent() []*QANValkeySlowlogAgent {
+	if x != nil {
+		return x.QanValkeySlowlogAgent
+	}
+	return nil
 }
 
-type AddAgentResponse_AzureDatabaseExporter struct {
-	AzureDatabaseExporter *AzureDatabaseExporter `protobuf:"bytes,9,opt,name=azure_database_exporter,json=azureDatabaseExporter,proto3,oneof"`
+func (x *ListAgentsResponse) GetQanProxysqlDigestAgent() []*QANProxySQLDigestAgent {
+	if x != nil {
+		return x.QanProxysqlDigestAgent
+	}
+	return nil
 }
 
-type AddAgentResponse_QanMysqlPerfschemaAgent struct {
-	QanMysqlPerfschemaAgent *QANMySQLPerfSchemaAgent `protobuf:"bytes,10,opt,name=qan_mysql_perfschema_agent,json=qanMysqlPerfschemaAgent,proto3,oneof"`
+func (x *ListAgentsResponse) GetQanPostgresqlLogAgent() []*QANPostgreSQLLogAgent {
+	if x != nil {
+		return x.QanPostgresqlLogAgent
+	}
+	return nil
 }
 
-type AddAgentResponse_QanMysqlSlowlogAgent struct {
-	QanMysqlSlowlogAgent *QANMySQLSlowlogAgent `protobuf:"bytes,11,opt,name=qan_mysql_slowlog_agent,json=qanMysqlSlowlogAgent,proto3,oneof"`
+type GetAgentRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique randomly generated instance identifier.
+	AgentId       string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type AddAgentResponse_QanMongodbProfilerAgent struct {
-	QanMongodbProfilerAgent *QANMongoDBProfilerAgent `protobuf:"bytes,12,opt,name=qan_mongodb_profiler_agent,json=qanMongodbProfilerAgent,proto3,oneof"`
+func (x *GetAgentRequest) Reset() {
+	*x = GetAgentRequest{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type AddAgentResponse_QanMongodbMongologAgent struct {
-	QanMongodbMongologAgent *QANMongoDBMongologAgent `protobuf:"bytes,16,opt,name=qan_mongodb_mongolog_agent,json=qanMongodbMongologAgent,proto3,oneof"`
+func (x *GetAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type AddAgentResponse_QanPostgresqlPgstatementsAgent struct {
-	QanPostgresqlPgstatementsAgent *QANPostgreSQLPgStatementsAgent `protobuf:"bytes,13,opt,name=qan_postgresql_pgstatements_agent,json=qanPostgresqlPgstatementsAgent,proto3,oneof"`
-}
+func (*GetAgentRequest) ProtoMessage() {}
 
-type AddAgentResponse_QanPostgresqlPgstatmonitorAgent struct {
-	QanPostgresqlPgstatmonitorAgent *QANPostgreSQLPgStatMonitorAgent `protobuf:"bytes,14,opt,name=qan_postgresql_pgstatmonitor_agent,json=qanPostgresqlPgstatmonitorAgent,proto3,oneof"`
+func (x *GetAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type AddAgentResponse_ValkeyExporter struct {
-	ValkeyExporter *ValkeyExporter `protobuf:"bytes,15,opt,name=valkey_exporter,json=valkeyExporter,proto3,oneof"`
+// Deprecated: Use GetAgentRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{28}
 }
 
-type AddAgentResponse_RtaMongodbAgent struct {
-	RtaMongodbAgent *RTAMongoDBAgent `protobuf:"bytes,17,opt,name=rta_mongodb_agent,json=rtaMongodbAgent,proto3,oneof"`
+func (x *GetAgentRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
 }
 
-func (*AddAgentResponse_PmmAgent) isAddAgentResponse_Agent() {}
-
-func (*AddAgentResponse_NodeExporter) isAddAgentResponse_Agent() {}
-
-func (*AddAgentResponse_MysqldExporter) isAddAgentResponse_Agent() {}
-
-func (*AddAgentResponse_MongodbExporter) isAddAgentResponse_Agent() {}
-
-func (*AddAgentResponse_PostgresExporter) isAddAgentResponse_Agent() {}
-
-func (*AddAgentResponse_ProxysqlExporter) isAddAgentResponse_Agent() {}
-
-func (*AddAgentResponse_ExternalExporter) isAddAgentResponse_Agent() {}
-
-func (*AddAgentResponse_RdsExporter) isAddAgentResponse_Agent() {}
-
-func (*AddAgentResponse_AzureDatabaseExporter) isAddAgentResponse_Agent() {}
-
-func (*AddAgentResponse_QanMysqlPerfschemaAgent) isAddAgentResponse_Agent() {}
-
-func (*AddAgentResponse_QanMysqlSlowlogAgent) isAddAgentResponse_Agent() {}
-
-func (*AddAgentResponse_QanMongodbProfilerAgent) isAddAgentResponse_Agent() {}
-
-func (*AddAgentResponse_QanMongodbMongologAgent) isAddAgentResponse_Agent() {}
-
-func (*AddAgentResponse_QanPostgresqlPgstatementsAgent) isAddAgentResponse_Agent() {}
-
-func (*AddAgentResponse_QanPostgresqlPgstatmonitorAgent) isAddAgentResponse_Agent() {}
-
-func (*AddAgentResponse_ValkeyExporter) isAddAgentResponse_Agent() {}
-
-func (*AddAgentResponse_RtaMongodbAgent) isAddAgentResponse_Agent() {}
-
-type ChangeAgentRequest struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	AgentId string                 `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+type GetAgentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Agent:
 	//
-	//	*ChangeAgentRequest_NodeExporter
-	//	*ChangeAgentRequest_MysqldExporter
-	//	*ChangeAgentRequest_MongodbExporter
-	//	*ChangeAgentRequest_PostgresExporter
-	//	*ChangeAgentRequest_ProxysqlExporter
-	//	*ChangeAgentRequest_ExternalExporter
-	//	*ChangeAgentRequest_RdsExporter
-	//	*ChangeAgentRequest_AzureDatabaseExporter
-	//	*ChangeAgentRequest_QanMysqlPerfschemaAgent
-	//	*ChangeAgentRequest_QanMysqlSlowlogAgent
-	//	*ChangeAgentRequest_QanMongodbProfilerAgent
-	//	*ChangeAgentRequest_QanMongodbMongologAgent
-	//	*ChangeAgentRequest_QanPostgresqlPgstatementsAgent
-	//	*ChangeAgentRequest_QanPostgresqlPgstatmonitorAgent
-	//	*ChangeAgentRequest_NomadAgent
-	//	*ChangeAgentRequest_ValkeyExporter
-	//	*ChangeAgentRequest_RtaMongodbAgent
-	Agent         isChangeAgentRequest_Agent `protobuf_oneof:"agent"`
+	//	*GetAgentResponse_PmmAgent
+	//	*GetAgentResponse_Vmagent
+	//	*GetAgentResponse_NodeExporter
+	//	*GetAgentResponse_MysqldExporter
+	//	*GetAgentResponse_MongodbExporter
+	//	*GetAgentResponse_PostgresExporter
+	//	*GetAgentResponse_ProxysqlExporter
+	//	*GetAgentResponse_QanMysqlPerfschemaAgent
+	//	*GetAgentResponse_QanMysqlSlowlogAgent
+	//	*GetAgentResponse_QanMongodbProfilerAgent
+	//	*GetAgentResponse_QanMongodbMongologAgent
+	//	*GetAgentResponse_QanPostgresqlPgstatementsAgent
+	//	*GetAgentResponse_QanPostgresqlPgstatmonitorAgent
+	//	*GetAgentResponse_ExternalExporter
+	//	*GetAgentResponse_RdsExporter
+	//	*GetAgentResponse_AzureDatabaseExporter
+	//	*GetAgentResponse_NomadAgent
+	//	*GetAgentResponse_ValkeyExporter
+	//	*GetAgentResponse_RtaMongodbAgent
+	//	*GetAgentResponse_RtaMysqlAgent
+	//	*GetAgentResponse_RtaPostgresqlAgent
+	//	*GetAgentResponse_QanValkeySlowlogAgent
+	//	*GetAgentResponse_QanProxysqlDigestAgent
+	//	*GetAgentResponse_QanPostgresqlLogAgent
+	Agent         isGetAgentResponse_Agent `protobuf_oneof:"agent"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeAgentRequest) Reset() {
-	*x = ChangeAgentRequest{}
+func (x *GetAgentResponse) Reset() {
+	*x = GetAgentResponse{}
 	mi := &file_inventory_v1_agents_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeAgentRequest) String() string {
+func (x *GetAgentResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeAgentRequest) ProtoMessage() {}
+func (*GetAgentResponse) ProtoMessage() {}
 
-func (x *ChangeAgentRequest) ProtoReflect() protoreflect.Message {
+func (x *GetAgentResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_inventory_v1_agents_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4823,325 +4514,3716 @@ func (x *ChangeAgentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeAgentRequest.ProtoReflect.Descriptor instead.
-func (*ChangeAgentRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetAgentResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentResponse) Descriptor() ([]byte, []int) {
 	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ChangeAgentRequest) GetAgentId() string {
-	if x != nil {
-		return x.AgentId
-	}
-	return ""
-}
-
-func (x *ChangeAgentRequest) GetAgent() isChangeAgentRequest_Agent {
+func (x *GetAgentResponse) GetAgent() isGetAgentResponse_Agent {
 	if x != nil {
 		return x.Agent
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetNodeExporter() *ChangeNodeExporterParams {
+func (x *GetAgentResponse) GetPmmAgent() *PMMAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_NodeExporter); ok {
-			return x.NodeExporter
+		if x, ok := x.Agent.(*GetAgentResponse_PmmAgent); ok {
+			return x.PmmAgent
 		}
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetMysqldExporter() *ChangeMySQLdExporterParams {
+func (x *GetAgentResponse) GetVmagent() *VMAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_MysqldExporter); ok {
-			return x.MysqldExporter
+		if x, ok := x.Agent.(*GetAgentResponse_Vmagent); ok {
+			return x.Vmagent
 		}
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetMongodbExporter() *ChangeMongoDBExporterParams {
+func (x *GetAgentResponse) GetNodeExporter() *NodeExporter {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_MongodbExporter); ok {
-			return x.MongodbExporter
+		if x, ok := x.Agent.(*GetAgentResponse_NodeExporter); ok {
+			return x.NodeExporter
 		}
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetPostgresExporter() *ChangePostgresExporterParams {
+func (x *GetAgentResponse) GetMysqldExporter() *MySQLdExporter {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_PostgresExporter); ok {
+		if x, ok := x.Agent.(*GetAgentResponse_MysqldExporter); ok {
+			return x.MysqldExporter
+		}
+	}
+	return nil
+}
+
+func (x *GetAgentResponse) GetMongodbExporter() *MongoDBExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*GetAgentResponse_MongodbExporter); ok {
+			return x.MongodbExporter
+		}
+	}
+	return nil
+}
+
+func (x *GetAgentResponse) GetPostgresExporter() *PostgresExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*GetAgentResponse_PostgresExporter); ok {
 			return x.PostgresExporter
 		}
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetProxysqlExporter() *ChangeProxySQLExporterParams {
+func (x *GetAgentResponse) GetProxysqlExporter() *ProxySQLExporter {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_ProxysqlExporter); ok {
+		if x, ok := x.Agent.(*GetAgentResponse_ProxysqlExporter); ok {
 			return x.ProxysqlExporter
 		}
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetExternalExporter() *ChangeExternalExporterParams {
+func (x *GetAgentResponse) GetQanMysqlPerfschemaAgent() *QANMySQLPerfSchemaAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_ExternalExporter); ok {
-			return x.ExternalExporter
+		if x, ok := x.Agent.(*GetAgentResponse_QanMysqlPerfschemaAgent); ok {
+			return x.QanMysqlPerfschemaAgent
 		}
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetRdsExporter() *ChangeRDSExporterParams {
+func (x *GetAgentResponse) GetQanMysqlSlowlogAgent() *QANMySQLSlowlogAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_RdsExporter); ok {
-			return x.RdsExporter
+		if x, ok := x.Agent.(*GetAgentResponse_QanMysqlSlowlogAgent); ok {
+			return x.QanMysqlSlowlogAgent
 		}
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetAzureDatabaseExporter() *ChangeAzureDatabaseExporterParams {
+func (x *GetAgentResponse) GetQanMongodbProfilerAgent() *QANMongoDBProfilerAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_AzureDatabaseExporter); ok {
-			return x.AzureDatabaseExporter
+		if x, ok := x.Agent.(*GetAgentResponse_QanMongodbProfilerAgent); ok {
+			return x.QanMongodbProfilerAgent
 		}
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetQanMysqlPerfschemaAgent() *ChangeQANMySQLPerfSchemaAgentParams {
+func (x *GetAgentResponse) GetQanMongodbMongologAgent() *QANMongoDBMongologAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_QanMysqlPerfschemaAgent); ok {
-			return x.QanMysqlPerfschemaAgent
+		if x, ok := x.Agent.(*GetAgentResponse_QanMongodbMongologAgent); ok {
+			return x.QanMongodbMongologAgent
 		}
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetQanMysqlSlowlogAgent() *ChangeQANMySQLSlowlogAgentParams {
+func (x *GetAgentResponse) GetQanPostgresqlPgstatementsAgent() *QANPostgreSQLPgStatementsAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_QanMysqlSlowlogAgent); ok {
-			return x.QanMysqlSlowlogAgent
+		if x, ok := x.Agent.(*GetAgentResponse_QanPostgresqlPgstatementsAgent); ok {
+			return x.QanPostgresqlPgstatementsAgent
 		}
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetQanMongodbProfilerAgent() *ChangeQANMongoDBProfilerAgentParams {
+func (x *GetAgentResponse) GetQanPostgresqlPgstatmonitorAgent() *QANPostgreSQLPgStatMonitorAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_QanMongodbProfilerAgent); ok {
-			return x.QanMongodbProfilerAgent
+		if x, ok := x.Agent.(*GetAgentResponse_QanPostgresqlPgstatmonitorAgent); ok {
+			return x.QanPostgresqlPgstatmonitorAgent
 		}
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetQanMongodbMongologAgent() *ChangeQANMongoDBMongologAgentParams {
+func (x *GetAgentResponse) GetExternalExporter() *ExternalExporter {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_QanMongodbMongologAgent); ok {
-			return x.QanMongodbMongologAgent
+		if x, ok := x.Agent.(*GetAgentResponse_ExternalExporter); ok {
+			return x.ExternalExporter
 		}
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetQanPostgresqlPgstatementsAgent() *ChangeQANPostgreSQLPgStatementsAgentParams {
+func (x *GetAgentResponse) GetRdsExporter() *RDSExporter {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_QanPostgresqlPgstatementsAgent); ok {
-			return x.QanPostgresqlPgstatementsAgent
+		if x, ok := x.Agent.(*GetAgentResponse_RdsExporter); ok {
+			return x.RdsExporter
 		}
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetQanPostgresqlPgstatmonitorAgent() *ChangeQANPostgreSQLPgStatMonitorAgentParams {
+func (x *GetAgentResponse) GetAzureDatabaseExporter() *AzureDatabaseExporter {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_QanPostgresqlPgstatmonitorAgent); ok {
-			return x.QanPostgresqlPgstatmonitorAgent
+		if x, ok := x.Agent.(*GetAgentResponse_AzureDatabaseExporter); ok {
+			return x.AzureDatabaseExporter
 		}
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetNomadAgent() *ChangeNomadAgentParams {
+func (x *GetAgentResponse) GetNomadAgent() *NomadAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_NomadAgent); ok {
+		if x, ok := x.Agent.(*GetAgentResponse_NomadAgent); ok {
 			return x.NomadAgent
 		}
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetValkeyExporter() *ChangeValkeyExporterParams {
+func (x *GetAgentResponse) GetValkeyExporter() *ValkeyExporter {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_ValkeyExporter); ok {
+		if x, ok := x.Agent.(*GetAgentResponse_ValkeyExporter); ok {
 			return x.ValkeyExporter
 		}
 	}
 	return nil
 }
 
-func (x *ChangeAgentRequest) GetRtaMongodbAgent() *ChangeRTAMongoDBAgentParams {
+func (x *GetAgentResponse) GetRtaMongodbAgent() *RTAMongoDBAgent {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentRequest_RtaMongodbAgent); ok {
+		if x, ok := x.Agent.(*GetAgentResponse_RtaMongodbAgent); ok {
 			return x.RtaMongodbAgent
 		}
 	}
 	return nil
 }
 
-type isChangeAgentRequest_Agent interface {
-	isChangeAgentRequest_Agent()
+func (x *GetAgentResponse) GetRtaMysqlAgent() *RTAMySQLAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*GetAgentResponse_RtaMysqlAgent); ok {
+			return x.RtaMysqlAgent
+		}
+	}
+	return nil
 }
 
-type ChangeAgentRequest_NodeExporter struct {
-	NodeExporter *ChangeNodeExporterParams `protobuf:"bytes,2,opt,name=node_exporter,json=nodeExporter,proto3,oneof"`
+func (x *GetAgentResponse) GetRtaPostgresqlAgent() *RTAPostgreSQLAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*GetAgentResponse_RtaPostgresqlAgent); ok {
+			return x.RtaPostgresqlAgent
+		}
+	}
+	return nil
 }
 
-type ChangeAgentRequest_MysqldExporter struct {
-	MysqldExporter *ChangeMySQLdExporterParams `protobuf:"bytes,3,opt,name=mysqld_exporter,json=mysqldExporter,proto3,oneof"`
+func (x *GetAgentResponse) GetQanValkeySlowlogAgent() *QANValkeySlowlogAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*GetAgentResponse_QanValkeySlowlogAgent); ok {
+			return x.QanValkeySlowlogAgent
+		}
+	}
+	return nil
 }
 
-type ChangeAgentRequest_MongodbExporter struct {
-	MongodbExporter *ChangeMongoDBExporterParams `protobuf:"bytes,4,opt,name=mongodb_exporter,json=mongodbExporter,proto3,oneof"`
+func (x *GetAgentResponse) GetQanProxysqlDigestAgent() *QANProxySQLDigestAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*GetAgentResponse_QanProxysqlDigestAgent); ok {
+			return x.QanProxysqlDigestAgent
+		}
+	}
+	return nil
 }
 
-type ChangeAgentRequest_PostgresExporter struct {
-	PostgresExporter *ChangePostgresExporterParams `protobuf:"bytes,5,opt,name=postgres_exporter,json=postgresExporter,proto3,oneof"`
+func (x *GetAgentResponse) GetQanPostgresqlLogAgent() *QANPostgreSQLLogAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*GetAgentResponse_QanPostgresqlLogAgent); ok {
+			return x.QanPostgresqlLogAgent
+		}
+	}
+	return nil
 }
 
-type ChangeAgentRequest_ProxysqlExporter struct {
-	ProxysqlExporter *ChangeProxySQLExporterParams `protobuf:"bytes,6,opt,name=proxysql_exporter,json=proxysqlExporter,proto3,oneof"`
+type isGetAgentResponse_Agent interface {
+	isGetAgentResponse_Agent()
 }
 
-type ChangeAgentRequest_ExternalExporter struct {
-	ExternalExporter *ChangeExternalExporterParams `protobuf:"bytes,7,opt,name=external_exporter,json=externalExporter,proto3,oneof"`
+type GetAgentResponse_PmmAgent struct {
+	PmmAgent *PMMAgent `protobuf:"bytes,1,opt,name=pmm_agent,json=pmmAgent,proto3,oneof"`
 }
 
-type ChangeAgentRequest_RdsExporter struct {
-	RdsExporter *ChangeRDSExporterParams `protobuf:"bytes,8,opt,name=rds_exporter,json=rdsExporter,proto3,oneof"`
+type GetAgentResponse_Vmagent struct {
+	Vmagent *VMAgent `protobuf:"bytes,2,opt,name=vmagent,proto3,oneof"`
 }
 
-type ChangeAgentRequest_AzureDatabaseExporter struct {
-	AzureDatabaseExporter *ChangeAzureDatabaseExporterParams `protobuf:"bytes,9,opt,name=azure_database_exporter,json=azureDatabaseExporter,proto3,oneof"`
+type GetAgentResponse_NodeExporter struct {
+	NodeExporter *NodeExporter `protobuf:"bytes,3,opt,name=node_exporter,json=nodeExporter,proto3,oneof"`
 }
 
-type ChangeAgentRequest_QanMysqlPerfschemaAgent struct {
-	QanMysqlPerfschemaAgent *ChangeQANMySQLPerfSchemaAgentParams `protobuf:"bytes,10,opt,name=qan_mysql_perfschema_agent,json=qanMysqlPerfschemaAgent,proto3,oneof"`
+type GetAgentResponse_MysqldExporter struct {
+	MysqldExporter *MySQLdExporter `protobuf:"bytes,4,opt,name=mysqld_exporter,json=mysqldExporter,proto3,oneof"`
 }
 
-type ChangeAgentRequest_QanMysqlSlowlogAgent struct {
-	QanMysqlSlowlogAgent *ChangeQANMySQLSlowlogAgentParams `protobuf:"bytes,11,opt,name=qan_mysql_slowlog_agent,json=qanMysqlSlowlogAgent,proto3,oneof"`
+type GetAgentResponse_MongodbExporter struct {
+	MongodbExporter *MongoDBExporter `protobuf:"bytes,5,opt,name=mongodb_exporter,json=mongodbExporter,proto3,oneof"`
 }
 
-type ChangeAgentRequest_QanMongodbProfilerAgent struct {
-	QanMongodbProfilerAgent *ChangeQANMongoDBProfilerAgentParams `protobuf:"bytes,12,opt,name=qan_mongodb_profiler_agent,json=qanMongodbProfilerAgent,proto3,oneof"`
+type GetAgentResponse_PostgresExporter struct {
+	PostgresExporter *PostgresExporter `protobuf:"bytes,6,opt,name=postgres_exporter,json=postgresExporter,proto3,oneof"`
 }
 
-type ChangeAgentRequest_QanMongodbMongologAgent struct {
-	QanMongodbMongologAgent *ChangeQANMongoDBMongologAgentParams `protobuf:"bytes,17,opt,name=qan_mongodb_mongolog_agent,json=qanMongodbMongologAgent,proto3,oneof"`
+type GetAgentResponse_ProxysqlExporter struct {
+	ProxysqlExporter *ProxySQLExporter `protobuf:"bytes,7,opt,name=proxysql_exporter,json=proxysqlExporter,proto3,oneof"`
 }
 
-type ChangeAgentRequest_QanPostgresqlPgstatementsAgent struct {
-	QanPostgresqlPgstatementsAgent *ChangeQANPostgreSQLPgStatementsAgentParams `protobuf:"bytes,13,opt,name=qan_postgresql_pgstatements_agent,json=qanPostgresqlPgstatementsAgent,proto3,oneof"`
+type GetAgentResponse_QanMysqlPerfschemaAgent struct {
+	QanMysqlPerfschemaAgent *QANMySQLPerfSchemaAgent `protobuf:"bytes,8,opt,name=qan_mysql_perfschema_agent,json=qanMysqlPerfschemaAgent,proto3,oneof"`
 }
 
-type ChangeAgentRequest_QanPostgresqlPgstatmonitorAgent struct {
-	QanPostgresqlPgstatmonitorAgent *ChangeQANPostgreSQLPgStatMonitorAgentParams `protobuf:"bytes,14,opt,name=qan_postgresql_pgstatmonitor_agent,json=qanPostgresqlPgstatmonitorAgent,proto3,oneof"`
+type GetAgentResponse_QanMysqlSlowlogAgent struct {
+	QanMysqlSlowlogAgent *QANMySQLSlowlogAgent `protobuf:"bytes,9,opt,name=qan_mysql_slowlog_agent,json=qanMysqlSlowlogAgent,proto3,oneof"`
 }
 
-type ChangeAgentRequest_NomadAgent struct {
-	NomadAgent *ChangeNomadAgentParams `protobuf:"bytes,15,opt,name=nomad_agent,json=nomadAgent,proto3,oneof"`
+type GetAgentResponse_QanMongodbProfilerAgent struct {
+	QanMongodbProfilerAgent *QANMongoDBProfilerAgent `protobuf:"bytes,10,opt,name=qan_mongodb_profiler_agent,json=qanMongodbProfilerAgent,proto3,oneof"`
 }
 
-type ChangeAgentRequest_ValkeyExporter struct {
-	ValkeyExporter *ChangeValkeyExporterParams `protobuf:"bytes,16,opt,name=valkey_exporter,json=valkeyExporter,proto3,oneof"`
+type GetAgentResponse_QanMongodbMongologAgent struct {
+	QanMongodbMongologAgent *QANMongoDBMongologAgent `protobuf:"bytes,18,opt,name=qan_mongodb_mongolog_agent,json=qanMongodbMongologAgent,proto3,oneof"`
 }
 
-type ChangeAgentRequest_RtaMongodbAgent struct {
-	RtaMongodbAgent *ChangeRTAMongoDBAgentParams `protobuf:"bytes,18,opt,name=rta_mongodb_agent,json=rtaMongodbAgent,proto3,oneof"`
+type GetAgentResponse_QanPostgresqlPgstatementsAgent struct {
+	QanPostgresqlPgstatementsAgent *QANPostgreSQLPgStatementsAgent `protobuf:"bytes,11,opt,name=qan_postgresql_pgstatements_agent,json=qanPostgresqlPgstatementsAgent,proto3,oneof"`
 }
 
-func (*ChangeAgentRequest_NodeExporter) isChangeAgentRequest_Agent() {}
+type GetAgentResponse_QanPostgresqlPgstatmonitorAgent struct {
+	QanPostgresqlPgstatmonitorAgent *QANPostgreSQLPgStatMonitorAgent `protobuf:"bytes,12,opt,name=qan_postgresql_pgstatmonitor_agent,json=qanPostgresqlPgstatmonitorAgent,proto3,oneof"`
+}
 
-func (*ChangeAgentRequest_MysqldExporter) isChangeAgentRequest_Agent() {}
+type GetAgentResponse_ExternalExporter struct {
+	ExternalExporter *ExternalExporter `protobuf:"bytes,13,opt,name=external_exporter,json=externalExporter,proto3,oneof"`
+}
 
-func (*ChangeAgentRequest_MongodbExporter) isChangeAgentRequest_Agent() {}
+type GetAgentResponse_RdsExporter struct {
+	RdsExporter *RDSExporter `protobuf:"bytes,14,opt,name=rds_exporter,json=rdsExporter,proto3,oneof"`
+}
 
-func (*ChangeAgentRequest_PostgresExporter) isChangeAgentRequest_Agent() {}
+type GetAgentResponse_AzureDatabaseExporter struct {
+	AzureDatabaseExporter *AzureDatabaseExporter `protobuf:"bytes,15,opt,name=azure_database_exporter,json=azureDatabaseExporter,proto3,oneof"`
+}
 
-func (*ChangeAgentRequest_ProxysqlExporter) isChangeAgentRequest_Agent() {}
+type GetAgentResponse_NomadAgent struct {
+	NomadAgent *NomadAgent `protobuf:"bytes,16,opt,name=nomad_agent,json=nomadAgent,proto3,oneof"`
+}
 
-func (*ChangeAgentRequest_ExternalExporter) isChangeAgentRequest_Agent() {}
+type GetAgentResponse_ValkeyExporter struct {
+	ValkeyExporter *ValkeyExporter `protobuf:"bytes,17,opt,name=valkey_exporter,json=valkeyExporter,proto3,oneof"`
+}
 
-func (*ChangeAgentRequest_RdsExporter) isChangeAgentRequest_Agent() {}
+type GetAgentResponse_RtaMongodbAgent struct {
+	RtaMongodbAgent *RTAMongoDBAgent `protobuf:"bytes,19,opt,name=rta_mongodb_agent,json=rtaMongodbAgent,proto3,oneof"`
+}
 
-func (*ChangeAgentRequest_AzureDatabaseExporter) isChangeAgentRequest_Agent() {}
+type GetAgentResponse_RtaMysqlAgent struct {
+	RtaMysqlAgent *RTAMySQLAgent `protobuf:"bytes,20,opt,name=rta_mysql_agent,json=rtaMysqlAgent,proto3,oneof"`
+}
 
-func (*ChangeAgentRequest_QanMysqlPerfschemaAgent) isChangeAgentRequest_Agent() {}
+type GetAgentResponse_RtaPostgresqlAgent struct {
+	RtaPostgresqlAgent *RTAPostgreSQLAgent `protobuf:"bytes,21,opt,name=rta_postgresql_agent,json=rtaPostgresqlAgent,proto3,oneof"`
+}
 
-func (*ChangeAgentRequest_QanMysqlSlowlogAgent) isChangeAgentRequest_Agent() {}
+type GetAgentResponse_QanValkeySlowlogAgent struct {
+	QanValkeySlowlogAgent *QANValkeySlowlogAgent `protobuf:"bytes,22,opt,name=qan_valkey_slowlog_agent,json=qanValkeySlowlogAgent,proto3,oneof"`
+}
 
-func (*ChangeAgentRequest_QanMongodbProfilerAgent) isChangeAgentRequest_Agent() {}
+type GetAgentResponse_QanProxysqlDigestAgent struct {
+	QanProxysqlDigestAgent *QANProxySQLDigestAgent `protobuf:"bytes,23,opt,name=qan_proxysql_digest_agent,json=qanProxysqlDigestAgent,proto3,oneof"`
+}
 
-func (*ChangeAgentRequest_QanMongodbMongologAgent) isChangeAgentRequest_Agent() {}
+type GetAgentResponse_QanPostgresqlLogAgent struct {
+	QanPostgresqlLogAgent *QANPostgreSQLLogAgent `protobuf:"bytes,24,opt,name=qan_postgresql_log_agent,json=qanPostgresqlLogAgent,proto3,oneof"`
+}
 
-func (*ChangeAgentRequest_QanPostgresqlPgstatementsAgent) isChangeAgentRequest_Agent() {}
+func (*GetAgentResponse_PmmAgent) isGetAgentResponse_Agent() {}
 
-func (*ChangeAgentRequest_QanPostgresqlPgstatmonitorAgent) isChangeAgentRequest_Agent() {}
+func (*GetAgentResponse_Vmagent) isGetAgentResponse_Agent() {}
 
-func (*ChangeAgentRequest_NomadAgent) isChangeAgentRequest_Agent() {}
+func (*GetAgentResponse_NodeExporter) isGetAgentResponse_Agent() {}
 
-func (*ChangeAgentRequest_ValkeyExporter) isChangeAgentRequest_Agent() {}
+func (*GetAgentResponse_MysqldExporter) isGetAgentResponse_Agent() {}
 
-func (*ChangeAgentRequest_RtaMongodbAgent) isChangeAgentRequest_Agent() {}
+func (*GetAgentResponse_MongodbExporter) isGetAgentResponse_Agent() {}
 
-type ChangeAgentResponse struct {
+func (*GetAgentResponse_PostgresExporter) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_ProxysqlExporter) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_QanMysqlPerfschemaAgent) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_QanMysqlSlowlogAgent) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_QanMongodbProfilerAgent) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_QanMongodbMongologAgent) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_QanPostgresqlPgstatementsAgent) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_QanPostgresqlPgstatmonitorAgent) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_ExternalExporter) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_RdsExporter) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_AzureDatabaseExporter) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_NomadAgent) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_ValkeyExporter) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_RtaMongodbAgent) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_RtaMysqlAgent) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_RtaPostgresqlAgent) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_QanValkeySlowlogAgent) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_QanProxysqlDigestAgent) isGetAgentResponse_Agent() {}
+
+func (*GetAgentResponse_QanPostgresqlLogAgent) isGetAgentResponse_Agent() {}
+
+type GetAgentLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique randomly generated instance identifier.
+	AgentId string `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// Limit the number of log lines to this value. Pass 0 for no limit.
+	Limit         uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAgentLogsRequest) Reset() {
+	*x = GetAgentLogsRequest{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentLogsRequest) ProtoMessage() {}
+
+func (x *GetAgentLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentLogsRequest.ProtoReflect.Descriptor instead.
+func (*GetAgentLogsRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetAgentLogsRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *GetAgentLogsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetAgentLogsResponse struct {
+	state                    protoimpl.MessageState `protogen:"open.v1"`
+	Logs                     []string               `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	AgentConfigLogLinesCount uint32                 `protobuf:"varint,2,opt,name=agent_config_log_lines_count,json=agentConfigLogLinesCount,proto3" json:"agent_config_log_lines_count,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetAgentLogsResponse) Reset() {
+	*x = GetAgentLogsResponse{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAgentLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAgentLogsResponse) ProtoMessage() {}
+
+func (x *GetAgentLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAgentLogsResponse.ProtoReflect.Descriptor instead.
+func (*GetAgentLogsResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetAgentLogsResponse) GetLogs() []string {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *GetAgentLogsResponse) GetAgentConfigLogLinesCount() uint32 {
+	if x != nil {
+		return x.AgentConfigLogLinesCount
+	}
+	return 0
+}
+
+type AddAgentRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Types that are valid to be assigned to Agent:
 	//
-	//	*ChangeAgentResponse_NodeExporter
-	//	*ChangeAgentResponse_MysqldExporter
-	//	*ChangeAgentResponse_MongodbExporter
-	//	*ChangeAgentResponse_PostgresExporter
-	//	*ChangeAgentResponse_ProxysqlExporter
-	//	*ChangeAgentResponse_ExternalExporter
-	//	*ChangeAgentResponse_RdsExporter
-	//	*ChangeAgentResponse_AzureDatabaseExporter
-	//	*ChangeAgentResponse_QanMysqlPerfschemaAgent
-	//	*ChangeAgentResponse_QanMysqlSlowlogAgent
-	//	*ChangeAgentResponse_QanMongodbProfilerAgent
-	//	*ChangeAgentResponse_QanMongodbMongologAgent
-	//	*ChangeAgentResponse_QanPostgresqlPgstatementsAgent
-	//	*ChangeAgentResponse_QanPostgresqlPgstatmonitorAgent
-	//	*ChangeAgentResponse_NomadAgent
-	//	*ChangeAgentResponse_ValkeyExporter
-	//	*ChangeAgentResponse_RtaMongodbAgent
-	Agent         isChangeAgentResponse_Agent `protobuf_oneof:"agent"`
+	//	*AddAgentRequest_PmmAgent
+	//	*AddAgentRequest_NodeExporter
+	//	*AddAgentRequest_MysqldExporter
+	//	*AddAgentRequest_MongodbExporter
+	//	*AddAgentRequest_PostgresExporter
+	//	*AddAgentRequest_ProxysqlExporter
+	//	*AddAgentRequest_ExternalExporter
+	//	*AddAgentRequest_RdsExporter
+	//	*AddAgentRequest_AzureDatabaseExporter
+	//	*AddAgentRequest_QanMysqlPerfschemaAgent
+	//	*AddAgentRequest_QanMysqlSlowlogAgent
+	//	*AddAgentRequest_QanMongodbProfilerAgent
+	//	*AddAgentRequest_QanMongodbMongologAgent
+	//	*AddAgentRequest_QanPostgresqlPgstatementsAgent
+	//	*AddAgentRequest_QanPostgresqlPgstatmonitorAgent
+	//	*AddAgentRequest_ValkeyExporter
+	//	*AddAgentRequest_RtaMongodbAgent
+	//	*AddAgentRequest_RtaMysqlAgent
+	//	*AddAgentRequest_RtaPostgresqlAgent
+	//	*AddAgentRequest_QanValkeySlowlogAgent
+	//	*AddAgentRequest_QanProxysqlDigestAgent
+	//	*AddAgentRequest_QanPostgresqlLogAgent
+	Agent         isAddAgentRequest_Agent `protobuf_oneof:"agent"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeAgentResponse) Reset() {
-	*x = ChangeAgentResponse{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[30]
+func (x *AddAgentRequest) Reset() {
+	*x = AddAgentRequest{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAgentRequest) ProtoMessage() {}
+
+func (x *AddAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAgentRequest.ProtoReflect.Descriptor instead.
+func (*AddAgentRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AddAgentRequest) GetAgent() isAddAgentRequest_Agent {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetPmmAgent() *AddPMMAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_PmmAgent); ok {
+			return x.PmmAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetNodeExporter() *AddNodeExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_NodeExporter); ok {
+			return x.NodeExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetMysqldExporter() *AddMySQLdExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_MysqldExporter); ok {
+			return x.MysqldExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetMongodbExporter() *AddMongoDBExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_MongodbExporter); ok {
+			return x.MongodbExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetPostgresExporter() *AddPostgresExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_PostgresExporter); ok {
+			return x.PostgresExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetProxysqlExporter() *AddProxySQLExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_ProxysqlExporter); ok {
+			return x.ProxysqlExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetExternalExporter() *AddExternalExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_ExternalExporter); ok {
+			return x.ExternalExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetRdsExporter() *AddRDSExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_RdsExporter); ok {
+			return x.RdsExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetAzureDatabaseExporter() *AddAzureDatabaseExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_AzureDatabaseExporter); ok {
+			return x.AzureDatabaseExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetQanMysqlPerfschemaAgent() *AddQANMySQLPerfSchemaAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_QanMysqlPerfschemaAgent); ok {
+			return x.QanMysqlPerfschemaAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetQanMysqlSlowlogAgent() *AddQANMySQLSlowlogAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_QanMysqlSlowlogAgent); ok {
+			return x.QanMysqlSlowlogAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetQanMongodbProfilerAgent() *AddQANMongoDBProfilerAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_QanMongodbProfilerAgent); ok {
+			return x.QanMongodbProfilerAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetQanMongodbMongologAgent() *AddQANMongoDBMongologAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_QanMongodbMongologAgent); ok {
+			return x.QanMongodbMongologAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetQanPostgresqlPgstatementsAgent() *AddQANPostgreSQLPgStatementsAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_QanPostgresqlPgstatementsAgent); ok {
+			return x.QanPostgresqlPgstatementsAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetQanPostgresqlPgstatmonitorAgent() *AddQANPostgreSQLPgStatMonitorAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_QanPostgresqlPgstatmonitorAgent); ok {
+			return x.QanPostgresqlPgstatmonitorAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetValkeyExporter() *AddValkeyExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_ValkeyExporter); ok {
+			return x.ValkeyExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetRtaMongodbAgent() *AddRTAMongoDBAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_RtaMongodbAgent); ok {
+			return x.RtaMongodbAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetRtaMysqlAgent() *AddRTAMySQLAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_RtaMysqlAgent); ok {
+			return x.RtaMysqlAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetRtaPostgresqlAgent() *AddRTAPostgreSQLAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_RtaPostgresqlAgent); ok {
+			return x.RtaPostgresqlAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetQanValkeySlowlogAgent() *AddQANValkeySlowlogAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_QanValkeySlowlogAgent); ok {
+			return x.QanValkeySlowlogAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetQanProxysqlDigestAgent() *AddQANProxySQLDigestAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_QanProxysqlDigestAgent); ok {
+			return x.QanProxysqlDigestAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentRequest) GetQanPostgresqlLogAgent() *AddQANPostgreSQLLogAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentRequest_QanPostgresqlLogAgent); ok {
+			return x.QanPostgresqlLogAgent
+		}
+	}
+	return nil
+}
+
+type isAddAgentRequest_Agent interface {
+	isAddAgentRequest_Agent()
+}
+
+type AddAgentRequest_PmmAgent struct {
+	PmmAgent *AddPMMAgentParams `protobuf:"bytes,1,opt,name=pmm_agent,json=pmmAgent,proto3,oneof"`
+}
+
+type AddAgentRequest_NodeExporter struct {
+	NodeExporter *AddNodeExporterParams `protobuf:"bytes,2,opt,name=node_exporter,json=nodeExporter,proto3,oneof"`
+}
+
+type AddAgentRequest_MysqldExporter struct {
+	MysqldExporter *AddMySQLdExporterParams `protobuf:"bytes,3,opt,name=mysqld_exporter,json=mysqldExporter,proto3,oneof"`
+}
+
+type AddAgentRequest_MongodbExporter struct {
+	MongodbExporter *AddMongoDBExporterParams `protobuf:"bytes,4,opt,name=mongodb_exporter,json=mongodbExporter,proto3,oneof"`
+}
+
+type AddAgentRequest_PostgresExporter struct {
+	PostgresExporter *AddPostgresExporterParams `protobuf:"bytes,5,opt,name=postgres_exporter,json=postgresExporter,proto3,oneof"`
+}
+
+type AddAgentRequest_ProxysqlExporter struct {
+	ProxysqlExporter *AddProxySQLExporterParams `protobuf:"bytes,6,opt,name=proxysql_exporter,json=proxysqlExporter,proto3,oneof"`
+}
+
+type AddAgentRequest_ExternalExporter struct {
+	ExternalExporter *AddExternalExporterParams `protobuf:"bytes,7,opt,name=external_exporter,json=externalExporter,proto3,oneof"`
+}
+
+type AddAgentRequest_RdsExporter struct {
+	RdsExporter *AddRDSExporterParams `protobuf:"bytes,8,opt,name=rds_exporter,json=rdsExporter,proto3,oneof"`
+}
+
+type AddAgentRequest_AzureDatabaseExporter struct {
+	AzureDatabaseExporter *AddAzureDatabaseExporterParams `protobuf:"bytes,9,opt,name=azure_database_exporter,json=azureDatabaseExporter,proto3,oneof"`
+}
+
+type AddAgentRequest_QanMysqlPerfschemaAgent struct {
+	QanMysqlPerfschemaAgent *AddQANMySQLPerfSchemaAgentParams `protobuf:"bytes,10,opt,name=qan_mysql_perfschema_agent,json=qanMysqlPerfschemaAgent,proto3,oneof"`
+}
+
+type AddAgentRequest_QanMysqlSlowlogAgent struct {
+	QanMysqlSlowlogAgent *AddQANMySQLSlowlogAgentParams `protobuf:"bytes,11,opt,name=qan_mysql_slowlog_agent,json=qanMysqlSlowlogAgent,proto3,oneof"`
+}
+
+type AddAgentRequest_QanMongodbProfilerAgent struct {
+	QanMongodbProfilerAgent *AddQANMongoDBProfilerAgentParams `protobuf:"bytes,12,opt,name=qan_mongodb_profiler_agent,json=qanMongodbProfilerAgent,proto3,oneof"`
+}
+
+type AddAgentRequest_QanMongodbMongologAgent struct {
+	QanMongodbMongologAgent *AddQANMongoDBMongologAgentParams `protobuf:"bytes,16,opt,name=qan_mongodb_mongolog_agent,json=qanMongodbMongologAgent,proto3,oneof"`
+}
+
+type AddAgentRequest_QanPostgresqlPgstatementsAgent struct {
+	QanPostgresqlPgstatementsAgent *AddQANPostgreSQLPgStatementsAgentParams `protobuf:"bytes,13,opt,name=qan_postgresql_pgstatements_agent,json=qanPostgresqlPgstatementsAgent,proto3,oneof"`
+}
+
+type AddAgentRequest_QanPostgresqlPgstatmonitorAgent struct {
+	QanPostgresqlPgstatmonitorAgent *AddQANPostgreSQLPgStatMonitorAgentParams `protobuf:"bytes,14,opt,name=qan_postgresql_pgstatmonitor_agent,json=qanPostgresqlPgstatmonitorAgent,proto3,oneof"`
+}
+
+type AddAgentRequest_ValkeyExporter struct {
+	ValkeyExporter *AddValkeyExporterParams `protobuf:"bytes,15,opt,name=valkey_exporter,json=valkeyExporter,proto3,oneof"`
+}
+
+type AddAgentRequest_RtaMongodbAgent struct {
+	RtaMongodbAgent *AddRTAMongoDBAgentParams `protobuf:"bytes,17,opt,name=rta_mongodb_agent,json=rtaMongodbAgent,proto3,oneof"`
+}
+
+type AddAgentRequest_RtaMysqlAgent struct {
+	RtaMysqlAgent *AddRTAMySQLAgentParams `protobuf:"bytes,18,opt,name=rta_mysql_agent,json=rtaMysqlAgent,proto3,oneof"`
+}
+
+type AddAgentRequest_RtaPostgresqlAgent struct {
+	RtaPostgresqlAgent *AddRTAPostgreSQLAgentParams `protobuf:"bytes,19,opt,name=rta_postgresql_agent,json=rtaPostgresqlAgent,proto3,oneof"`
+}
+
+type AddAgentRequest_QanValkeySlowlogAgent struct {
+	QanValkeySlowlogAgent *AddQANValkeySlowlogAgentParams `protobuf:"bytes,20,opt,name=qan_valkey_slowlog_agent,json=qanValkeySlowlogAgent,proto3,oneof"`
+}
+
+type AddAgentRequest_QanProxysqlDigestAgent struct {
+	QanProxysqlDigestAgent *AddQANProxySQLDigestAgentParams `protobuf:"bytes,21,opt,name=qan_proxysql_digest_agent,json=qanProxysqlDigestAgent,proto3,oneof"`
+}
+
+type AddAgentRequest_QanPostgresqlLogAgent struct {
+	QanPostgresqlLogAgent *AddQANPostgreSQLLogAgentParams `protobuf:"bytes,22,opt,name=qan_postgresql_log_agent,json=qanPostgresqlLogAgent,proto3,oneof"`
+}
+
+func (*AddAgentRequest_PmmAgent) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_NodeExporter) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_MysqldExporter) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_MongodbExporter) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_PostgresExporter) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_ProxysqlExporter) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_ExternalExporter) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_RdsExporter) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_AzureDatabaseExporter) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_QanMysqlPerfschemaAgent) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_QanMysqlSlowlogAgent) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_QanMongodbProfilerAgent) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_QanMongodbMongologAgent) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_QanPostgresqlPgstatementsAgent) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_QanPostgresqlPgstatmonitorAgent) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_ValkeyExporter) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_RtaMongodbAgent) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_RtaMysqlAgent) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_RtaPostgresqlAgent) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_QanValkeySlowlogAgent) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_QanProxysqlDigestAgent) isAddAgentRequest_Agent() {}
+
+func (*AddAgentRequest_QanPostgresqlLogAgent) isAddAgentRequest_Agent() {}
+
+type AddAgentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Agent:
+	//
+	//	*AddAgentResponse_PmmAgent
+	//	*AddAgentResponse_NodeExporter
+	//	*AddAgentResponse_MysqldExporter
+	//	*AddAgentResponse_MongodbExporter
+	//	*AddAgentResponse_PostgresExporter
+	//	*AddAgentResponse_ProxysqlExporter
+	//	*AddAgentResponse_ExternalExporter
+	//	*AddAgentResponse_RdsExporter
+	//	*AddAgentResponse_AzureDatabaseExporter
+	//	*AddAgentResponse_QanMysqlPerfschemaAgent
+	//	*AddAgentResponse_QanMysqlSlowlogAgent
+	//	*AddAgentResponse_QanMongodbProfilerAgent
+	//	*AddAgentResponse_QanMongodbMongologAgent
+	//	*AddAgentResponse_QanPostgresqlPgstatementsAgent
+	//	*AddAgentResponse_QanPostgresqlPgstatmonitorAgent
+	//	*AddAgentResponse_ValkeyExporter
+	//	*AddAgentResponse_RtaMongodbAgent
+	//	*AddAgentResponse_RtaMysqlAgent
+	//	*AddAgentResponse_RtaPostgresqlAgent
+	//	*AddAgentResponse_QanValkeySlowlogAgent
+	//	*AddAgentResponse_QanProxysqlDigestAgent
+	//	*AddAgentResponse_QanPostgresqlLogAgent
+	Agent         isAddAgentResponse_Agent `protobuf_oneof:"agent"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddAgentResponse) Reset() {
+	*x = AddAgentResponse{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddAgentResponse) ProtoMessage() {}
+
+func (x *AddAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddAgentResponse.ProtoReflect.Descriptor instead.
+func (*AddAgentResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AddAgentResponse) GetAgent() isAddAgentResponse_Agent {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetPmmAgent() *PMMAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_PmmAgent); ok {
+			return x.PmmAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetNodeExporter() *NodeExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_NodeExporter); ok {
+			return x.NodeExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetMysqldExporter() *MySQLdExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_MysqldExporter); ok {
+			return x.MysqldExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetMongodbExporter() *MongoDBExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_MongodbExporter); ok {
+			return x.MongodbExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetPostgresExporter() *PostgresExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_PostgresExporter); ok {
+			return x.PostgresExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetProxysqlExporter() *ProxySQLExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_ProxysqlExporter); ok {
+			return x.ProxysqlExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetExternalExporter() *ExternalExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_ExternalExporter); ok {
+			return x.ExternalExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetRdsExporter() *RDSExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_RdsExporter); ok {
+			return x.RdsExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetAzureDatabaseExporter() *AzureDatabaseExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_AzureDatabaseExporter); ok {
+			return x.AzureDatabaseExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetQanMysqlPerfschemaAgent() *QANMySQLPerfSchemaAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_QanMysqlPerfschemaAgent); ok {
+			return x.QanMysqlPerfschemaAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetQanMysqlSlowlogAgent() *QANMySQLSlowlogAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_QanMysqlSlowlogAgent); ok {
+			return x.QanMysqlSlowlogAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetQanMongodbProfilerAgent() *QANMongoDBProfilerAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_QanMongodbProfilerAgent); ok {
+			return x.QanMongodbProfilerAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetQanMongodbMongologAgent() *QANMongoDBMongologAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_QanMongodbMongologAgent); ok {
+			return x.QanMongodbMongologAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetQanPostgresqlPgstatementsAgent() *QANPostgreSQLPgStatementsAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_QanPostgresqlPgstatementsAgent); ok {
+			return x.QanPostgresqlPgstatementsAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetQanPostgresqlPgstatmonitorAgent() *QANPostgreSQLPgStatMonitorAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_QanPostgresqlPgstatmonitorAgent); ok {
+			return x.QanPostgresqlPgstatmonitorAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetValkeyExporter() *ValkeyExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_ValkeyExporter); ok {
+			return x.ValkeyExporter
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetRtaMongodbAgent() *RTAMongoDBAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_RtaMongodbAgent); ok {
+			return x.RtaMongodbAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetRtaMysqlAgent() *RTAMySQLAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_RtaMysqlAgent); ok {
+			return x.RtaMysqlAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetRtaPostgresqlAgent() *RTAPostgreSQLAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_RtaPostgresqlAgent); ok {
+			return x.RtaPostgresqlAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetQanValkeySlowlogAgent() *QANValkeySlowlogAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_QanValkeySlowlogAgent); ok {
+			return x.QanValkeySlowlogAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetQanProxysqlDigestAgent() *QANProxySQLDigestAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_QanProxysqlDigestAgent); ok {
+			return x.QanProxysqlDigestAgent
+		}
+	}
+	return nil
+}
+
+func (x *AddAgentResponse) GetQanPostgresqlLogAgent() *QANPostgreSQLLogAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*AddAgentResponse_QanPostgresqlLogAgent); ok {
+			return x.QanPostgresqlLogAgent
+		}
+	}
+	return nil
+}
+
+type isAddAgentResponse_Agent interface {
+	isAddAgentResponse_Agent()
+}
+
+type AddAgentResponse_PmmAgent struct {
+	PmmAgent *PMMAgent `protobuf:"bytes,1,opt,name=pmm_agent,json=pmmAgent,proto3,oneof"`
+}
+
+type AddAgentResponse_NodeExporter struct {
+	NodeExporter *NodeExporter `protobuf:"bytes,2,opt,name=node_exporter,json=nodeExporter,proto3,oneof"`
+}
+
+type AddAgentResponse_MysqldExporter struct {
+	MysqldExporter *MySQLdExporter `protobuf:"bytes,3,opt,name=mysqld_exporter,json=mysqldExporter,proto3,oneof"`
+}
+
+type AddAgentResponse_MongodbExporter struct {
+	MongodbExporter *MongoDBExporter `protobuf:"bytes,4,opt,name=mongodb_exporter,json=mongodbExporter,proto3,oneof"`
+}
+
+type AddAgentResponse_PostgresExporter struct {
+	PostgresExporter *PostgresExporter `protobuf:"bytes,5,opt,name=postgres_exporter,json=postgresExporter,proto3,oneof"`
+}
+
+type AddAgentResponse_ProxysqlExporter struct {
+	ProxysqlExporter *ProxySQLExporter `protobuf:"bytes,6,opt,name=proxysql_exporter,json=proxysqlExporter,proto3,oneof"`
+}
+
+type AddAgentResponse_ExternalExporter struct {
+	ExternalExporter *ExternalExporter `protobuf:"bytes,7,opt,name=external_exporter,json=externalExporter,proto3,oneof"`
+}
+
+type AddAgentResponse_RdsExporter struct {
+	RdsExporter *RDSExporter `protobuf:"bytes,8,opt,name=rds_exporter,json=rdsExporter,proto3,oneof"`
+}
+
+type AddAgentResponse_AzureDatabaseExporter struct {
+	AzureDatabaseExporter *AzureDatabaseExporter `protobuf:"bytes,9,opt,name=azure_database_exporter,json=azureDatabaseExporter,proto3,oneof"`
+}
+
+type AddAgentResponse_QanMysqlPerfschemaAgent struct {
+	QanMysqlPerfschemaAgent *QANMySQLPerfSchemaAgent `protobuf:"bytes,10,opt,name=qan_mysql_perfschema_agent,json=qanMysqlPerfschemaAgent,proto3,oneof"`
+}
+
+type AddAgentResponse_QanMysqlSlowlogAgent struct {
+	QanMysqlSlowlogAgent *QANMySQLSlowlogAgent `protobuf:"bytes,11,opt,name=qan_mysql_slowlog_agent,json=qanMysqlSlowlogAgent,proto3,oneof"`
+}
+
+type AddAgentResponse_QanMongodbProfilerAgent struct {
+	QanMongodbProfilerAgent *QANMongoDBProfilerAgent `protobuf:"bytes,12,opt,name=qan_mongodb_profiler_agent,json=qanMongodbProfilerAgent,proto3,oneof"`
+}
+
+type AddAgentResponse_QanMongodbMongologAgent struct {
+	QanMongodbMongologAgent *QANMongoDBMongologAgent `protobuf:"bytes,16,opt,name=qan_mongodb_mongolog_agent,json=qanMongodbMongologAgent,proto3,oneof"`
+}
+
+type AddAgentResponse_QanPostgresqlPgstatementsAgent struct {
+	QanPostgresqlPgstatementsAgent *QANPostgreSQLPgStatementsAgent `protobuf:"bytes,13,opt,name=qan_postgresql_pgstatements_agent,json=qanPostgresqlPgstatementsAgent,proto3,oneof"`
+}
+
+type AddAgentResponse_QanPostgresqlPgstatmonitorAgent struct {
+	QanPostgresqlPgstatmonitorAgent *QANPostgreSQLPgStatMonitorAgent `protobuf:"bytes,14,opt,name=qan_postgresql_pgstatmonitor_agent,json=qanPostgresqlPgstatmonitorAgent,proto3,oneof"`
+}
+
+type AddAgentResponse_ValkeyExporter struct {
+	ValkeyExporter *ValkeyExporter `protobuf:"bytes,15,opt,name=valkey_exporter,json=valkeyExporter,proto3,oneof"`
+}
+
+type AddAgentResponse_RtaMongodbAgent struct {
+	RtaMongodbAgent *RTAMongoDBAgent `protobuf:"bytes,17,opt,name=rta_mongodb_agent,json=rtaMongodbAgent,proto3,oneof"`
+}
+
+type AddAgentResponse_RtaMysqlAgent struct {
+	RtaMysqlAgent *RTAMySQLAgent `protobuf:"bytes,18,opt,name=rta_mysql_agent,json=rtaMysqlAgent,proto3,oneof"`
+}
+
+type AddAgentResponse_RtaPostgresqlAgent struct {
+	RtaPostgresqlAgent *RTAPostgreSQLAgent `protobuf:"bytes,19,opt,name=rta_postgresql_agent,json=rtaPostgresqlAgent,proto3,oneof"`
+}
+
+type AddAgentResponse_QanValkeySlowlogAgent struct {
+	QanValkeySlowlogAgent *QANValkeySlowlogAgent `protobuf:"bytes,20,opt,name=qan_valkey_slowlog_agent,json=qanValkeySlowlogAgent,proto3,oneof"`
+}
+
+type AddAgentResponse_QanProxysqlDigestAgent struct {
+	QanProxysqlDigestAgent *QANProxySQLDigestAgent `protobuf:"bytes,21,opt,name=qan_proxysql_digest_agent,json=qanProxysqlDigestAgent,proto3,oneof"`
+}
+
+type AddAgentResponse_QanPostgresqlLogAgent struct {
+	QanPostgresqlLogAgent *QANPostgreSQLLogAgent `protobuf:"bytes,22,opt,name=qan_postgresql_log_agent,json=qanPostgresqlLogAgent,proto3,oneof"`
+}
+
+func (*AddAgentResponse_PmmAgent) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_NodeExporter) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_MysqldExporter) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_MongodbExporter) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_PostgresExporter) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_ProxysqlExporter) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_ExternalExporter) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_RdsExporter) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_AzureDatabaseExporter) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_QanMysqlPerfschemaAgent) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_QanMysqlSlowlogAgent) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_QanMongodbProfilerAgent) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_QanMongodbMongologAgent) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_QanPostgresqlPgstatementsAgent) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_QanPostgresqlPgstatmonitorAgent) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_ValkeyExporter) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_RtaMongodbAgent) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_RtaMysqlAgent) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_RtaPostgresqlAgent) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_QanValkeySlowlogAgent) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_QanProxysqlDigestAgent) isAddAgentResponse_Agent() {}
+
+func (*AddAgentResponse_QanPostgresqlLogAgent) isAddAgentResponse_Agent() {}
+
+type ChangeAgentRequest struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	AgentId string                 `protobuf:"bytes,1,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
+	// Types that are valid to be assigned to Agent:
+	//
+	//	*ChangeAgentRequest_NodeExporter
+	//	*ChangeAgentRequest_MysqldExporter
+	//	*ChangeAgentRequest_MongodbExporter
+	//	*ChangeAgentRequest_PostgresExporter
+	//	*ChangeAgentRequest_ProxysqlExporter
+	//	*ChangeAgentRequest_ExternalExporter
+	//	*ChangeAgentRequest_RdsExporter
+	//	*ChangeAgentRequest_AzureDatabaseExporter
+	//	*ChangeAgentRequest_QanMysqlPerfschemaAgent
+	//	*ChangeAgentRequest_QanMysqlSlowlogAgent
+	//	*ChangeAgentRequest_QanMongodbProfilerAgent
+	//	*ChangeAgentRequest_QanMongodbMongologAgent
+	//	*ChangeAgentRequest_QanPostgresqlPgstatementsAgent
+	//	*ChangeAgentRequest_QanPostgresqlPgstatmonitorAgent
+	//	*ChangeAgentRequest_NomadAgent
+	//	*ChangeAgentRequest_ValkeyExporter
+	//	*ChangeAgentRequest_RtaMongodbAgent
+	//	*ChangeAgentRequest_RtaMysqlAgent
+	//	*ChangeAgentRequest_RtaPostgresqlAgent
+	//	*ChangeAgentRequest_QanValkeySlowlogAgent
+	//	*ChangeAgentRequest_QanProxysqlDigestAgent
+	//	*ChangeAgentRequest_QanPostgresqlLogAgent
+	Agent         isChangeAgentRequest_Agent `protobuf_oneof:"agent"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeAgentRequest) Reset() {
+	*x = ChangeAgentRequest{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeAgentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAgentRequest) ProtoMessage() {}
+
+func (x *ChangeAgentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAgentRequest.ProtoReflect.Descriptor instead.
+func (*ChangeAgentRequest) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ChangeAgentRequest) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+func (x *ChangeAgentRequest) GetAgent() isChangeAgentRequest_Agent {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetNodeExporter() *ChangeNodeExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_NodeExporter); ok {
+			return x.NodeExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetMysqldExporter() *ChangeMySQLdExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_MysqldExporter); ok {
+			return x.MysqldExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetMongodbExporter() *ChangeMongoDBExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_MongodbExporter); ok {
+			return x.MongodbExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetPostgresExporter() *ChangePostgresExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_PostgresExporter); ok {
+			return x.PostgresExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetProxysqlExporter() *ChangeProxySQLExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_ProxysqlExporter); ok {
+			return x.ProxysqlExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetExternalExporter() *ChangeExternalExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_ExternalExporter); ok {
+			return x.ExternalExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetRdsExporter() *ChangeRDSExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_RdsExporter); ok {
+			return x.RdsExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetAzureDatabaseExporter() *ChangeAzureDatabaseExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_AzureDatabaseExporter); ok {
+			return x.AzureDatabaseExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetQanMysqlPerfschemaAgent() *ChangeQANMySQLPerfSchemaAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_QanMysqlPerfschemaAgent); ok {
+			return x.QanMysqlPerfschemaAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetQanMysqlSlowlogAgent() *ChangeQANMySQLSlowlogAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_QanMysqlSlowlogAgent); ok {
+			return x.QanMysqlSlowlogAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetQanMongodbProfilerAgent() *ChangeQANMongoDBProfilerAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_QanMongodbProfilerAgent); ok {
+			return x.QanMongodbProfilerAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetQanMongodbMongologAgent() *ChangeQANMongoDBMongologAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_QanMongodbMongologAgent); ok {
+			return x.QanMongodbMongologAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetQanPostgresqlPgstatementsAgent() *ChangeQANPostgreSQLPgStatementsAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_QanPostgresqlPgstatementsAgent); ok {
+			return x.QanPostgresqlPgstatementsAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetQanPostgresqlPgstatmonitorAgent() *ChangeQANPostgreSQLPgStatMonitorAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_QanPostgresqlPgstatmonitorAgent); ok {
+			return x.QanPostgresqlPgstatmonitorAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetNomadAgent() *ChangeNomadAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_NomadAgent); ok {
+			return x.NomadAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetValkeyExporter() *ChangeValkeyExporterParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_ValkeyExporter); ok {
+			return x.ValkeyExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetRtaMongodbAgent() *ChangeRTAMongoDBAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_RtaMongodbAgent); ok {
+			return x.RtaMongodbAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetRtaMysqlAgent() *ChangeRTAMySQLAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_RtaMysqlAgent); ok {
+			return x.RtaMysqlAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetRtaPostgresqlAgent() *ChangeRTAPostgreSQLAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_RtaPostgresqlAgent); ok {
+			return x.RtaPostgresqlAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetQanValkeySlowlogAgent() *ChangeQANValkeySlowlogAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_QanValkeySlowlogAgent); ok {
+			return x.QanValkeySlowlogAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetQanProxysqlDigestAgent() *ChangeQANProxySQLDigestAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_QanProxysqlDigestAgent); ok {
+			return x.QanProxysqlDigestAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentRequest) GetQanPostgresqlLogAgent() *ChangeQANPostgreSQLLogAgentParams {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentRequest_QanPostgresqlLogAgent); ok {
+			return x.QanPostgresqlLogAgent
+		}
+	}
+	return nil
+}
+
+type isChangeAgentRequest_Agent interface {
+	isChangeAgentRequest_Agent()
+}
+
+type ChangeAgentRequest_NodeExporter struct {
+	NodeExporter *ChangeNodeExporterParams `protobuf:"bytes,2,opt,name=node_exporter,json=nodeExporter,proto3,oneof"`
+}
+
+type ChangeAgentRequest_MysqldExporter struct {
+	MysqldExporter *ChangeMySQLdExporterParams `protobuf:"bytes,3,opt,name=mysqld_exporter,json=mysqldExporter,proto3,oneof"`
+}
+
+type ChangeAgentRequest_MongodbExporter struct {
+	MongodbExporter *ChangeMongoDBExporterParams `protobuf:"bytes,4,opt,name=mongodb_exporter,json=mongodbExporter,proto3,oneof"`
+}
+
+type ChangeAgentRequest_PostgresExporter struct {
+	PostgresExporter *ChangePostgresExporterParams `protobuf:"bytes,5,opt,name=postgres_exporter,json=postgresExporter,proto3,oneof"`
+}
+
+type ChangeAgentRequest_ProxysqlExporter struct {
+	ProxysqlExporter *ChangeProxySQLExporterParams `protobuf:"bytes,6,opt,name=proxysql_exporter,json=proxysqlExporter,proto3,oneof"`
+}
+
+type ChangeAgentRequest_ExternalExporter struct {
+	ExternalExporter *ChangeExternalExporterParams `protobuf:"bytes,7,opt,name=external_exporter,json=externalExporter,proto3,oneof"`
+}
+
+type ChangeAgentRequest_RdsExporter struct {
+	RdsExporter *ChangeRDSExporterParams `protobuf:"bytes,8,opt,name=rds_exporter,json=rdsExporter,proto3,oneof"`
+}
+
+type ChangeAgentRequest_AzureDatabaseExporter struct {
+	AzureDatabaseExporter *ChangeAzureDatabaseExporterParams `protobuf:"bytes,9,opt,name=azure_database_exporter,json=azureDatabaseExporter,proto3,oneof"`
+}
+
+type ChangeAgentRequest_QanMysqlPerfschemaAgent struct {
+	QanMysqlPerfschemaAgent *ChangeQANMySQLPerfSchemaAgentParams `protobuf:"bytes,10,opt,name=qan_mysql_perfschema_agent,json=qanMysqlPerfschemaAgent,proto3,oneof"`
+}
+
+type ChangeAgentRequest_QanMysqlSlowlogAgent struct {
+	QanMysqlSlowlogAgent *ChangeQANMySQLSlowlogAgentParams `protobuf:"bytes,11,opt,name=qan_mysql_slowlog_agent,json=qanMysqlSlowlogAgent,proto3,oneof"`
+}
+
+type ChangeAgentRequest_QanMongodbProfilerAgent struct {
+	QanMongodbProfilerAgent *ChangeQANMongoDBProfilerAgentParams `protobuf:"bytes,12,opt,name=qan_mongodb_profiler_agent,json=qanMongodbProfilerAgent,proto3,oneof"`
+}
+
+type ChangeAgentRequest_QanMongodbMongologAgent struct {
+	QanMongodbMongologAgent *ChangeQANMongoDBMongologAgentParams `protobuf:"bytes,17,opt,name=qan_mongodb_mongolog_agent,json=qanMongodbMongologAgent,proto3,oneof"`
+}
+
+type ChangeAgentRequest_QanPostgresqlPgstatementsAgent struct {
+	QanPostgresqlPgstatementsAgent *ChangeQANPostgreSQLPgStatementsAgentParams `protobuf:"bytes,13,opt,name=qan_postgresql_pgstatements_agent,json=qanPostgresqlPgstatementsAgent,proto3,oneof"`
+}
+
+type ChangeAgentRequest_QanPostgresqlPgstatmonitorAgent struct {
+	QanPostgresqlPgstatmonitorAgent *ChangeQANPostgreSQLPgStatMonitorAgentParams `protobuf:"bytes,14,opt,name=qan_postgresql_pgstatmonitor_agent,json=qanPostgresqlPgstatmonitorAgent,proto3,oneof"`
+}
+
+type ChangeAgentRequest_NomadAgent struct {
+	NomadAgent *ChangeNomadAgentParams `protobuf:"bytes,15,opt,name=nomad_agent,json=nomadAgent,proto3,oneof"`
+}
+
+type ChangeAgentRequest_ValkeyExporter struct {
+	ValkeyExporter *ChangeValkeyExporterParams `protobuf:"bytes,16,opt,name=valkey_exporter,json=valkeyExporter,proto3,oneof"`
+}
+
+type ChangeAgentRequest_RtaMongodbAgent struct {
+	RtaMongodbAgent *ChangeRTAMongoDBAgentParams `protobuf:"bytes,18,opt,name=rta_mongodb_agent,json=rtaMongodbAgent,proto3,oneof"`
+}
+
+type ChangeAgentRequest_RtaMysqlAgent struct {
+	RtaMysqlAgent *ChangeRTAMySQLAgentParams `protobuf:"bytes,19,opt,name=rta_mysql_agent,json=rtaMysqlAgent,proto3,oneof"`
+}
+
+type ChangeAgentRequest_RtaPostgresqlAgent struct {
+	RtaPostgresqlAgent *ChangeRTAPostgreSQLAgentParams `protobuf:"bytes,20,opt,name=rta_postgresql_agent,json=rtaPostgresqlAgent,proto3,oneof"`
+}
+
+type ChangeAgentRequest_QanValkeySlowlogAgent struct {
+	QanValkeySlowlogAgent *ChangeQANValkeySlowlogAgentParams `protobuf:"bytes,21,opt,name=qan_valkey_slowlog_agent,json=qanValkeySlowlogAgent,proto3,oneof"`
+}
+
+type ChangeAgentRequest_QanProxysqlDigestAgent struct {
+	QanProxysqlDigestAgent *ChangeQANProxySQLDigestAgentParams `protobuf:"bytes,22,opt,name=qan_proxysql_digest_agent,json=qanProxysqlDigestAgent,proto3,oneof"`
+}
+
+type ChangeAgentRequest_QanPostgresqlLogAgent struct {
+	QanPostgresqlLogAgent *ChangeQANPostgreSQLLogAgentParams `protobuf:"bytes,23,opt,name=qan_postgresql_log_agent,json=qanPostgresqlLogAgent,proto3,oneof"`
+}
+
+func (*ChangeAgentRequest_NodeExporter) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_MysqldExporter) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_MongodbExporter) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_PostgresExporter) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_ProxysqlExporter) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_ExternalExporter) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_RdsExporter) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_AzureDatabaseExporter) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_QanMysqlPerfschemaAgent) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_QanMysqlSlowlogAgent) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_QanMongodbProfilerAgent) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_QanMongodbMongologAgent) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_QanPostgresqlPgstatementsAgent) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_QanPostgresqlPgstatmonitorAgent) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_NomadAgent) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_ValkeyExporter) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_RtaMongodbAgent) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_RtaMysqlAgent) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_RtaPostgresqlAgent) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_QanValkeySlowlogAgent) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_QanProxysqlDigestAgent) isChangeAgentRequest_Agent() {}
+
+func (*ChangeAgentRequest_QanPostgresqlLogAgent) isChangeAgentRequest_Agent() {}
+
+type ChangeAgentResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Agent:
+	//
+	//	*ChangeAgentResponse_NodeExporter
+	//	*ChangeAgentResponse_MysqldExporter
+	//	*ChangeAgentResponse_MongodbExporter
+	//	*ChangeAgentResponse_PostgresExporter
+	//	*ChangeAgentResponse_ProxysqlExporter
+	//	*ChangeAgentResponse_ExternalExporter
+	//	*ChangeAgentResponse_RdsExporter
+	//	*ChangeAgentResponse_AzureDatabaseExporter
+	//	*ChangeAgentResponse_QanMysqlPerfschemaAgent
+	//	*ChangeAgentResponse_QanMysqlSlowlogAgent
+	//	*ChangeAgentResponse_QanMongodbProfilerAgent
+	//	*ChangeAgentResponse_QanMongodbMongologAgent
+	//	*ChangeAgentResponse_QanPostgresqlPgstatementsAgent
+	//	*ChangeAgentResponse_QanPostgresqlPgstatmonitorAgent
+	//	*ChangeAgentResponse_NomadAgent
+	//	*ChangeAgentResponse_ValkeyExporter
+	//	*ChangeAgentResponse_RtaMongodbAgent
+	//	*ChangeAgentResponse_RtaMysqlAgent
+	//	*ChangeAgentResponse_RtaPostgresqlAgent
+	//	*ChangeAgentResponse_QanValkeySlowlogAgent
+	//	*ChangeAgentResponse_QanProxysqlDigestAgent
+	//	*ChangeAgentResponse_QanPostgresqlLogAgent
+	Agent         isChangeAgentResponse_Agent `protobuf_oneof:"agent"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeAgentResponse) Reset() {
+	*x = ChangeAgentResponse{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeAgentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAgentResponse) ProtoMessage() {}
+
+func (x *ChangeAgentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAgentResponse.ProtoReflect.Descriptor instead.
+func (*ChangeAgentResponse) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ChangeAgentResponse) GetAgent() isChangeAgentResponse_Agent {
+	if x != nil {
+		return x.Agent
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetNodeExporter() *NodeExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_NodeExporter); ok {
+			return x.NodeExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetMysqldExporter() *MySQLdExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_MysqldExporter); ok {
+			return x.MysqldExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetMongodbExporter() *MongoDBExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_MongodbExporter); ok {
+			return x.MongodbExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetPostgresExporter() *PostgresExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_PostgresExporter); ok {
+			return x.PostgresExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetProxysqlExporter() *ProxySQLExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_ProxysqlExporter); ok {
+			return x.ProxysqlExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetExternalExporter() *ExternalExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_ExternalExporter); ok {
+			return x.ExternalExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetRdsExporter() *RDSExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_RdsExporter); ok {
+			return x.RdsExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetAzureDatabaseExporter() *AzureDatabaseExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_AzureDatabaseExporter); ok {
+			return x.AzureDatabaseExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetQanMysqlPerfschemaAgent() *QANMySQLPerfSchemaAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_QanMysqlPerfschemaAgent); ok {
+			return x.QanMysqlPerfschemaAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetQanMysqlSlowlogAgent() *QANMySQLSlowlogAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_QanMysqlSlowlogAgent); ok {
+			return x.QanMysqlSlowlogAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetQanMongodbProfilerAgent() *QANMongoDBProfilerAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_QanMongodbProfilerAgent); ok {
+			return x.QanMongodbProfilerAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetQanMongodbMongologAgent() *QANMongoDBMongologAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_QanMongodbMongologAgent); ok {
+			return x.QanMongodbMongologAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetQanPostgresqlPgstatementsAgent() *QANPostgreSQLPgStatementsAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_QanPostgresqlPgstatementsAgent); ok {
+			return x.QanPostgresqlPgstatementsAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetQanPostgresqlPgstatmonitorAgent() *QANPostgreSQLPgStatMonitorAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_QanPostgresqlPgstatmonitorAgent); ok {
+			return x.QanPostgresqlPgstatmonitorAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetNomadAgent() *NomadAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_NomadAgent); ok {
+			return x.NomadAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetValkeyExporter() *ValkeyExporter {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_ValkeyExporter); ok {
+			return x.ValkeyExporter
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetRtaMongodbAgent() *RTAMongoDBAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_RtaMongodbAgent); ok {
+			return x.RtaMongodbAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetRtaMysqlAgent() *RTAMySQLAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_RtaMysqlAgent); ok {
+			return x.RtaMysqlAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetRtaPostgresqlAgent() *RTAPostgreSQLAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_RtaPostgresqlAgent); ok {
+			return x.RtaPostgresqlAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetQanValkeySlowlogAgent() *QANValkeySlowlogAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_QanValkeySlowlogAgent); ok {
+			return x.QanValkeySlowlogAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetQanProxysqlDigestAgent() *QANProxySQLDigestAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_QanProxysqlDigestAgent); ok {
+			return x.QanProxysqlDigestAgent
+		}
+	}
+	return nil
+}
+
+func (x *ChangeAgentResponse) GetQanPostgresqlLogAgent() *QANPostgreSQLLogAgent {
+	if x != nil {
+		if x, ok := x.Agent.(*ChangeAgentResponse_QanPostgresqlLogAgent); ok {
+			return x.QanPostgresqlLogAgent
+		}
+	}
+	return nil
+}
+
+type isChangeAgentResponse_Agent interface {
+	isChangeAgentResponse_Agent()
+}
+
+type ChangeAgentResponse_NodeExporter struct {
+	NodeExporter *NodeExporter `protobuf:"bytes,2,opt,name=node_exporter,json=nodeExporter,proto3,oneof"`
+}
+
+type ChangeAgentResponse_MysqldExporter struct {
+	MysqldExporter *MySQLdExporter `protobuf:"bytes,3,opt,name=mysqld_exporter,json=mysqldExporter,proto3,oneof"`
+}
+
+type ChangeAgentResponse_MongodbExporter struct {
+	MongodbExporter *MongoDBExporter `protobuf:"bytes,4,opt,name=mongodb_exporter,json=mongodbExporter,proto3,oneof"`
+}
+
+type ChangeAgentResponse_PostgresExporter struct {
+	PostgresExporter *PostgresExporter `protobuf:"bytes,5,opt,name=postgres_exporter,json=postgresExporter,proto3,oneof"`
+}
+
+type ChangeAgentResponse_ProxysqlExporter struct {
+	ProxysqlExporter *ProxySQLExporter `protobuf:"bytes,6,opt,name=proxysql_exporter,json=proxysqlExporter,proto3,oneof"`
+}
+
+type ChangeAgentResponse_ExternalExporter struct {
+	ExternalExporter *ExternalExporter `protobuf:"bytes,7,opt,name=external_exporter,json=externalExporter,proto3,oneof"`
+}
+
+type ChangeAgentResponse_RdsExporter struct {
+	RdsExporter *RDSExporter `protobuf:"bytes,8,opt,name=rds_exporter,json=rdsExporter,proto3,oneof"`
+}
+
+type ChangeAgentResponse_AzureDatabaseExporter struct {
+	AzureDatabaseExporter *AzureDatabaseExporter `protobuf:"bytes,9,opt,name=azure_database_exporter,json=azureDatabaseExporter,proto3,oneof"`
+}
+
+type ChangeAgentResponse_QanMysqlPerfschemaAgent struct {
+	QanMysqlPerfschemaAgent *QANMySQLPerfSchemaAgent `protobuf:"bytes,10,opt,name=qan_mysql_perfschema_agent,json=qanMysqlPerfschemaAgent,proto3,oneof"`
+}
+
+type ChangeAgentResponse_QanMysqlSlowlogAgent struct {
+	QanMysqlSlowlogAgent *QANMySQLSlowlogAgent `protobuf:"bytes,11,opt,name=qan_mysql_slowlog_agent,json=qanMysqlSlowlogAgent,proto3,oneof"`
+}
+
+type ChangeAgentResponse_QanMongodbProfilerAgent struct {
+	QanMongodbProfilerAgent *QANMongoDBProfilerAgent `protobuf:"bytes,12,opt,name=qan_mongodb_profiler_agent,json=qanMongodbProfilerAgent,proto3,oneof"`
+}
+
+type ChangeAgentResponse_QanMongodbMongologAgent struct {
+	QanMongodbMongologAgent *QANMongoDBMongologAgent `protobuf:"bytes,17,opt,name=qan_mongodb_mongolog_agent,json=qanMongodbMongologAgent,proto3,oneof"`
+}
+
+type ChangeAgentResponse_QanPostgresqlPgstatementsAgent struct {
+	QanPostgresqlPgstatementsAgent *QANPostgreSQLPgStatementsAgent `protobuf:"bytes,13,opt,name=qan_postgresql_pgstatements_agent,json=qanPostgresqlPgstatementsAgent,proto3,oneof"`
+}
+
+type ChangeAgentResponse_QanPostgresqlPgstatmonitorAgent struct {
+	QanPostgresqlPgstatmonitorAgent *QANPostgreSQLPgStatMonitorAgent `protobuf:"bytes,14,opt,name=qan_postgresql_pgstatmonitor_agent,json=qanPostgresqlPgstatmonitorAgent,proto3,oneof"`
+}
+
+type ChangeAgentResponse_NomadAgent struct {
+	NomadAgent *NomadAgent `protobuf:"bytes,15,opt,name=nomad_agent,json=nomadAgent,proto3,oneof"`
+}
+
+type ChangeAgentResponse_ValkeyExporter struct {
+	ValkeyExporter *ValkeyExporter `protobuf:"bytes,16,opt,name=valkey_exporter,json=valkeyExporter,proto3,oneof"`
+}
+
+type ChangeAgentResponse_RtaMongodbAgent struct {
+	RtaMongodbAgent *RTAMongoDBAgent `protobuf:"bytes,18,opt,name=rta_mongodb_agent,json=rtaMongodbAgent,proto3,oneof"`
+}
+
+type ChangeAgentResponse_RtaMysqlAgent struct {
+	RtaMysqlAgent *RTAMySQLAgent `protobuf:"bytes,19,opt,name=rta_mysql_agent,json=rtaMysqlAgent,proto3,oneof"`
+}
+
+type ChangeAgentResponse_RtaPostgresqlAgent struct {
+	RtaPostgresqlAgent *RTAPostgreSQLAgent `protobuf:"bytes,20,opt,name=rta_postgresql_agent,json=rtaPostgresqlAgent,proto3,oneof"`
+}
+
+type ChangeAgentResponse_QanValkeySlowlogAgent struct {
+	QanValkeySlowlogAgent *QANValkeySlowlogAgent `protobuf:"bytes,21,opt,name=qan_valkey_slowlog_agent,json=qanValkeySlowlogAgent,proto3,oneof"`
+}
+
+type ChangeAgentResponse_QanProxysqlDigestAgent struct {
+	QanProxysqlDigestAgent *QANProxySQLDigestAgent `protobuf:"bytes,22,opt,name=qan_proxysql_digest_agent,json=qanProxysqlDigestAgent,proto3,oneof"`
+}
+
+type ChangeAgentResponse_QanPostgresqlLogAgent struct {
+	QanPostgresqlLogAgent *QANPostgreSQLLogAgent `protobuf:"bytes,23,opt,name=qan_postgresql_log_agent,json=qanPostgresqlLogAgent,proto3,oneof"`
+}
+
+func (*ChangeAgentResponse_NodeExporter) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_MysqldExporter) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_MongodbExporter) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_PostgresExporter) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_ProxysqlExporter) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_ExternalExporter) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_RdsExporter) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_AzureDatabaseExporter) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_QanMysqlPerfschemaAgent) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_QanMysqlSlowlogAgent) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_QanMongodbProfilerAgent) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_QanMongodbMongologAgent) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_QanPostgresqlPgstatementsAgent) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_QanPostgresqlPgstatmonitorAgent) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_NomadAgent) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_ValkeyExporter) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_RtaMongodbAgent) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_RtaMysqlAgent) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_RtaPostgresqlAgent) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_QanValkeySlowlogAgent) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_QanProxysqlDigestAgent) isChangeAgentResponse_Agent() {}
+
+func (*ChangeAgentResponse_QanPostgresqlLogAgent) isChangeAgentResponse_Agent() {}
+
+type AddPMMAgentParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Node identifier where this instance runs.
+	RunsOnNodeId string `protobuf:"bytes,1,opt,name=runs_on_node_id,json=runsOnNodeId,proto3" json:"runs_on_node_id,omitempty"`
+	// Custom user-assigned labels.
+	CustomLabels  map[string]string `protobuf:"bytes,2,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddPMMAgentParams) Reset() {
+	*x = AddPMMAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPMMAgentParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPMMAgentParams) ProtoMessage() {}
+
+func (x *AddPMMAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPMMAgentParams.ProtoReflect.Descriptor instead.
+func (*AddPMMAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *AddPMMAgentParams) GetRunsOnNodeId() string {
+	if x != nil {
+		return x.RunsOnNodeId
+	}
+	return ""
+}
+
+func (x *AddPMMAgentParams) GetCustomLabels() map[string]string {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
+}
+
+type AddNodeExporterParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pmm-agent identifier which runs this instance.
+	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `protobuf:"bytes,2,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Enables push metrics mode for exporter.
+	PushMetrics bool `protobuf:"varint,3,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `protobuf:"bytes,4,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
+	// Log level for exporter.
+	LogLevel LogLevel `protobuf:"varint,5,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	// Expose the node_exporter process on all public interfaces
+	ExposeExporter bool `protobuf:"varint,6,opt,name=expose_exporter,json=exposeExporter,proto3" json:"expose_exporter,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddNodeExporterParams) Reset() {
+	*x = AddNodeExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddNodeExporterParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddNodeExporterParams) ProtoMessage() {}
+
+func (x *AddNodeExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddNodeExporterParams.ProtoReflect.Descriptor instead.
+func (*AddNodeExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *AddNodeExporterParams) GetPmmAgentId() string {
+	if x != nil {
+		return x.PmmAgentId
+	}
+	return ""
+}
+
+func (x *AddNodeExporterParams) GetCustomLabels() map[string]string {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
+}
+
+func (x *AddNodeExporterParams) GetPushMetrics() bool {
+	if x != nil {
+		return x.PushMetrics
+	}
+	return false
+}
+
+func (x *AddNodeExporterParams) GetDisableCollectors() []string {
+	if x != nil {
+		return x.DisableCollectors
+	}
+	return nil
+}
+
+func (x *AddNodeExporterParams) GetLogLevel() LogLevel {
+	if x != nil {
+		return x.LogLevel
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *AddNodeExporterParams) GetExposeExporter() bool {
+	if x != nil {
+		return x.ExposeExporter
+	}
+	return false
+}
+
+type ChangeNodeExporterParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Enable this Agent. Agents are enabled by default when they get added.
+	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
+	// Replace all custom user-assigned labels.
+	CustomLabels *common.StringMap `protobuf:"bytes,2,opt,name=custom_labels,json=customLabels,proto3,oneof" json:"custom_labels,omitempty"`
+	// Enables push metrics with vmagent.
+	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
+	// Metrics resolution for this agent.
+	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `protobuf:"bytes,5,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
+	// Log level for exporter.
+	LogLevel *LogLevel `protobuf:"varint,6,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
+	// Expose the node_exporter process on all public interfaces.
+	ExposeExporter *bool `protobuf:"varint,7,opt,name=expose_exporter,json=exposeExporter,proto3,oneof" json:"expose_exporter,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChangeNodeExporterParams) Reset() {
+	*x = ChangeNodeExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeNodeExporterParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeNodeExporterParams) ProtoMessage() {}
+
+func (x *ChangeNodeExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeNodeExporterParams.ProtoReflect.Descriptor instead.
+func (*ChangeNodeExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ChangeNodeExporterParams) GetEnable() bool {
+	if x != nil && x.Enable != nil {
+		return *x.Enable
+	}
+	return false
+}
+
+func (x *ChangeNodeExporterParams) GetCustomLabels() *common.StringMap {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
+}
+
+func (x *ChangeNodeExporterParams) GetEnablePushMetrics() bool {
+	if x != nil && x.EnablePushMetrics != nil {
+		return *x.EnablePushMetrics
+	}
+	return false
+}
+
+func (x *ChangeNodeExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
+	if x != nil {
+		return x.MetricsResolutions
+	}
+	return nil
+}
+
+func (x *ChangeNodeExporterParams) GetDisableCollectors() []string {
+	if x != nil {
+		return x.DisableCollectors
+	}
+	return nil
+}
+
+func (x *ChangeNodeExporterParams) GetLogLevel() LogLevel {
+	if x != nil && x.LogLevel != nil {
+		return *x.LogLevel
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *ChangeNodeExporterParams) GetExposeExporter() bool {
+	if x != nil && x.ExposeExporter != nil {
+		return *x.ExposeExporter
+	}
+	return false
+}
+
+type AddMySQLdExporterParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pmm-agent identifier which runs this instance.
+	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
+	// Service identifier.
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// MySQL username for scraping metrics.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// MySQL password for scraping metrics.
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// Use TLS for database connections.
+	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Certificate Authority certificate chain.
+	TlsCa string `protobuf:"bytes,7,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// Client certificate.
+	TlsCert string `protobuf:"bytes,8,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
+	// Password for decrypting tls_cert.
+	TlsKey string `protobuf:"bytes,9,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
+	// Tablestats group collectors will be disabled if there are more than that number of tables.
+	// 0 means tablestats group collectors are always enabled (no limit).
+	// Negative value means tablestats group collectors are always disabled.
+	TablestatsGroupTableLimit int32 `protobuf:"varint,10,opt,name=tablestats_group_table_limit,json=tablestatsGroupTableLimit,proto3" json:"tablestats_group_table_limit,omitempty"`
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `protobuf:"bytes,11,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Skip connection check.
+	SkipConnectionCheck bool `protobuf:"varint,12,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
+	// Enables push metrics mode for exporter.
+	PushMetrics bool `protobuf:"varint,13,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `protobuf:"bytes,14,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword string `protobuf:"bytes,15,opt,name=agent_password,json=agentPassword,proto3" json:"agent_password,omitempty"`
+	// Log level for exporter.
+	LogLevel LogLevel `protobuf:"varint,16,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	// Optionally expose the exporter process on all public interfaces
+	ExposeExporter bool `protobuf:"varint,17,opt,name=expose_exporter,json=exposeExporter,proto3" json:"expose_exporter,omitempty"`
+	// Extra DSN parameters for MySQL connection.
+	ExtraDsnParams map[string]string `protobuf:"bytes,18,rep,name=extra_dsn_params,json=extraDsnParams,proto3" json:"extra_dsn_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,19,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AddMySQLdExporterParams) Reset() {
+	*x = AddMySQLdExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMySQLdExporterParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMySQLdExporterParams) ProtoMessage() {}
+
+func (x *AddMySQLdExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMySQLdExporterParams.ProtoReflect.Descriptor instead.
+func (*AddMySQLdExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *AddMySQLdExporterParams) GetPmmAgentId() string {
+	if x != nil {
+		return x.PmmAgentId
+	}
+	return ""
+}
+
+func (x *AddMySQLdExporterParams) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *AddMySQLdExporterParams) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AddMySQLdExporterParams) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *AddMySQLdExporterParams) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+func (x *AddMySQLdExporterParams) GetTlsSkipVerify() bool {
+	if x != nil {
+		return x.TlsSkipVerify
+	}
+	return false
+}
+
+func (x *AddMySQLdExporterParams) GetTlsCa() string {
+	if x != nil {
+		return x.TlsCa
+	}
+	return ""
+}
+
+func (x *AddMySQLdExporterParams) GetTlsCert() string {
+	if x != nil {
+		return x.TlsCert
+	}
+	return ""
+}
+
+func (x *AddMySQLdExporterParams) GetTlsKey() string {
+	if x != nil {
+		return x.TlsKey
+	}
+	return ""
+}
+
+func (x *AddMySQLdExporterParams) GetTablestatsGroupTableLimit() int32 {
+	if x != nil {
+		return x.TablestatsGroupTableLimit
+	}
+	return 0
+}
+
+func (x *AddMySQLdExporterParams) GetCustomLabels() map[string]string {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
+}
+
+func (x *AddMySQLdExporterParams) GetSkipConnectionCheck() bool {
+	if x != nil {
+		return x.SkipConnectionCheck
+	}
+	return false
+}
+
+func (x *AddMySQLdExporterParams) GetPushMetrics() bool {
+	if x != nil {
+		return x.PushMetrics
+	}
+	return false
+}
+
+func (x *AddMySQLdExporterParams) GetDisableCollectors() []string {
+	if x != nil {
+		return x.DisableCollectors
+	}
+	return nil
+}
+
+func (x *AddMySQLdExporterParams) GetAgentPassword() string {
+	if x != nil {
+		return x.AgentPassword
+	}
+	return ""
+}
+
+func (x *AddMySQLdExporterParams) GetLogLevel() LogLevel {
+	if x != nil {
+		return x.LogLevel
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *AddMySQLdExporterParams) GetExposeExporter() bool {
+	if x != nil {
+		return x.ExposeExporter
+	}
+	return false
+}
+
+func (x *AddMySQLdExporterParams) GetExtraDsnParams() map[string]string {
+	if x != nil {
+		return x.ExtraDsnParams
+	}
+	return nil
+}
+
+func (x *AddMySQLdExporterParams) GetConnectionTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ConnectionTimeout
+	}
+	return nil
+}
+
+type ChangeMySQLdExporterParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Enable this Agent. Agents are enabled by default when they get added.
+	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
+	// Replace all custom user-assigned labels.
+	CustomLabels *common.StringMap `protobuf:"bytes,2,opt,name=custom_labels,json=customLabels,proto3,oneof" json:"custom_labels,omitempty"`
+	// Enables push metrics with vmagent.
+	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
+	// Metrics resolution for this agent.
+	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
+	// MySQL username for scraping metrics.
+	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// MySQL password for scraping metrics.
+	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// Use TLS for database connections.
+	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify *bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
+	// Certificate Authority certificate chain.
+	TlsCa *string `protobuf:"bytes,9,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
+	// Client certificate.
+	TlsCert *string `protobuf:"bytes,10,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
+	// Password for decrypting tls_cert.
+	TlsKey *string `protobuf:"bytes,11,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
+	// Tablestats group collectors will be disabled if there are more than that number of tables.
+	TablestatsGroupTableLimit *int32 `protobuf:"varint,12,opt,name=tablestats_group_table_limit,json=tablestatsGroupTableLimit,proto3,oneof" json:"tablestats_group_table_limit,omitempty"`
+	// Skip connection check.
+	SkipConnectionCheck *bool `protobuf:"varint,13,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `protobuf:"bytes,14,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword *string `protobuf:"bytes,15,opt,name=agent_password,json=agentPassword,proto3,oneof" json:"agent_password,omitempty"`
+	// Log level for exporter.
+	LogLevel *LogLevel `protobuf:"varint,16,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
+	// Optionally expose the exporter process on all public interfaces.
+	ExposeExporter *bool `protobuf:"varint,17,opt,name=expose_exporter,json=exposeExporter,proto3,oneof" json:"expose_exporter,omitempty"`
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,18,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ChangeMySQLdExporterParams) Reset() {
+	*x = ChangeMySQLdExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeMySQLdExporterParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeMySQLdExporterParams) ProtoMessage() {}
+
+func (x *ChangeMySQLdExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeMySQLdExporterParams.ProtoReflect.Descriptor instead.
+func (*ChangeMySQLdExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ChangeMySQLdExporterParams) GetEnable() bool {
+	if x != nil && x.Enable != nil {
+		return *x.Enable
+	}
+	return false
+}
+
+func (x *ChangeMySQLdExporterParams) GetCustomLabels() *common.StringMap {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
+}
+
+func (x *ChangeMySQLdExporterParams) GetEnablePushMetrics() bool {
+	if x != nil && x.EnablePushMetrics != nil {
+		return *x.EnablePushMetrics
+	}
+	return false
+}
+
+func (x *ChangeMySQLdExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
+	if x != nil {
+		return x.MetricsResolutions
+	}
+	return nil
+}
+
+func (x *ChangeMySQLdExporterParams) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *ChangeMySQLdExporterParams) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *ChangeMySQLdExporterParams) GetTls() bool {
+	if x != nil && x.Tls != nil {
+		return *x.Tls
+	}
+	return false
+}
+
+func (x *ChangeMySQLdExporterParams) GetTlsSkipVerify() bool {
+	if x != nil && x.TlsSkipVerify != nil {
+		return *x.TlsSkipVerify
+	}
+	return false
+}
+
+func (x *ChangeMySQLdExporterParams) GetTlsCa() string {
+	if x != nil && x.TlsCa != nil {
+		return *x.TlsCa
+	}
+	return ""
+}
+
+func (x *ChangeMySQLdExporterParams) GetTlsCert() string {
+	if x != nil && x.TlsCert != nil {
+		return *x.TlsCert
+	}
+	return ""
+}
+
+func (x *ChangeMySQLdExporterParams) GetTlsKey() string {
+	if x != nil && x.TlsKey != nil {
+		return *x.TlsKey
+	}
+	return ""
+}
+
+func (x *ChangeMySQLdExporterParams) GetTablestatsGroupTableLimit() int32 {
+	if x != nil && x.TablestatsGroupTableLimit != nil {
+		return *x.TablestatsGroupTableLimit
+	}
+	return 0
+}
+
+func (x *ChangeMySQLdExporterParams) GetSkipConnectionCheck() bool {
+	if x != nil && x.SkipConnectionCheck != nil {
+		return *x.SkipConnectionCheck
+	}
+	return false
+}
+
+func (x *ChangeMySQLdExporterParams) GetDisableCollectors() []string {
+	if x != nil {
+		return x.DisableCollectors
+	}
+	return nil
+}
+
+func (x *ChangeMySQLdExporterParams) GetAgentPassword() string {
+	if x != nil && x.AgentPassword != nil {
+		return *x.AgentPassword
+	}
+	return ""
+}
+
+func (x *ChangeMySQLdExporterParams) GetLogLevel() LogLevel {
+	if x != nil && x.LogLevel != nil {
+		return *x.LogLevel
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *ChangeMySQLdExporterParams) GetExposeExporter() bool {
+	if x != nil && x.ExposeExporter != nil {
+		return *x.ExposeExporter
+	}
+	return false
+}
+
+func (x *ChangeMySQLdExporterParams) GetConnectionTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ConnectionTimeout
+	}
+	return nil
+}
+
+type AddMongoDBExporterParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pmm-agent identifier which runs this instance.
+	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
+	// Service identifier.
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// MongoDB username for scraping metrics.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// MongoDB password for scraping metrics.
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// Use TLS for database connections.
+	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Client certificate and key.
+	TlsCertificateKey string `protobuf:"bytes,7,opt,name=tls_certificate_key,json=tlsCertificateKey,proto3" json:"tls_certificate_key,omitempty"`
+	// Password for decrypting tls_certificate_key.
+	TlsCertificateKeyFilePassword string `protobuf:"bytes,8,opt,name=tls_certificate_key_file_password,json=tlsCertificateKeyFilePassword,proto3" json:"tls_certificate_key_file_password,omitempty"`
+	// Certificate Authority certificate chain.
+	TlsCa string `protobuf:"bytes,9,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `protobuf:"bytes,10,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Skip connection check.
+	SkipConnectionCheck bool `protobuf:"varint,11,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
+	// Enables push metrics mode for exporter.
+	PushMetrics bool `protobuf:"varint,12,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `protobuf:"bytes,13,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
+	// Authentication mechanism.
+	// See https://docs.mongodb.com/manual/reference/connection-string/#mongodb-urioption-urioption.authMechanism
+	// for details.
+	AuthenticationMechanism string `protobuf:"bytes,14,opt,name=authentication_mechanism,json=authenticationMechanism,proto3" json:"authentication_mechanism,omitempty"`
+	// Authentication database.
+	AuthenticationDatabase string `protobuf:"bytes,15,opt,name=authentication_database,json=authenticationDatabase,proto3" json:"authentication_database,omitempty"`
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword string `protobuf:"bytes,16,opt,name=agent_password,json=agentPassword,proto3" json:"agent_password,omitempty"`
+	// List of colletions to get stats from. Can use *
+	StatsCollections []string `protobuf:"bytes,17,rep,name=stats_collections,json=statsCollections,proto3" json:"stats_collections,omitempty"`
+	// Collections limit. Only get Databases and collection stats if the total number of collections in the server
+	// is less than this value. 0: no limit
+	CollectionsLimit int32 `protobuf:"varint,18,opt,name=collections_limit,json=collectionsLimit,proto3" json:"collections_limit,omitempty"`
+	// Log level for exporter.
+	LogLevel LogLevel `protobuf:"varint,19,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	// Optionally expose the exporter process on all public interfaces
+	ExposeExporter bool `protobuf:"varint,20,opt,name=expose_exporter,json=exposeExporter,proto3" json:"expose_exporter,omitempty"`
+	// Environment variable names to pass to the exporter.
+	// Values will be resolved from pmm-agent's environment when starting the exporter.
+	EnvironmentVariableNames []string `protobuf:"bytes,21,rep,name=environment_variable_names,json=environmentVariableNames,proto3" json:"environment_variable_names,omitempty"`
+	// Enable all collectors.
+	EnableAllCollectors bool `protobuf:"varint,22,opt,name=enable_all_collectors,json=enableAllCollectors,proto3" json:"enable_all_collectors,omitempty"`
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,23,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
+	// Enable collecting histogram bucket metrics from getDiagnosticData.
+	EnableDiagnosticDataHistograms bool `protobuf:"varint,24,opt,name=enable_diagnostic_data_histograms,json=enableDiagnosticDataHistograms,proto3" json:"enable_diagnostic_data_histograms,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *AddMongoDBExporterParams) Reset() {
+	*x = AddMongoDBExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddMongoDBExporterParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMongoDBExporterParams) ProtoMessage() {}
+
+func (x *AddMongoDBExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMongoDBExporterParams.ProtoReflect.Descriptor instead.
+func (*AddMongoDBExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AddMongoDBExporterParams) GetPmmAgentId() string {
+	if x != nil {
+		return x.PmmAgentId
+	}
+	return ""
+}
+
+func (x *AddMongoDBExporterParams) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *AddMongoDBExporterParams) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AddMongoDBExporterParams) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *AddMongoDBExporterParams) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+func (x *AddMongoDBExporterParams) GetTlsSkipVerify() bool {
+	if x != nil {
+		return x.TlsSkipVerify
+	}
+	return false
+}
+
+func (x *AddMongoDBExporterParams) GetTlsCertificateKey() string {
+	if x != nil {
+		return x.TlsCertificateKey
+	}
+	return ""
+}
+
+func (x *AddMongoDBExporterParams) GetTlsCertificateKeyFilePassword() string {
+	if x != nil {
+		return x.TlsCertificateKeyFilePassword
+	}
+	return ""
+}
+
+func (x *AddMongoDBExporterParams) GetTlsCa() string {
+	if x != nil {
+		return x.TlsCa
+	}
+	return ""
+}
+
+func (x *AddMongoDBExporterParams) GetCustomLabels() map[string]string {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
+}
+
+func (x *AddMongoDBExporterParams) GetSkipConnectionCheck() bool {
+	if x != nil {
+		return x.SkipConnectionCheck
+	}
+	return false
+}
+
+func (x *AddMongoDBExporterParams) GetPushMetrics() bool {
+	if x != nil {
+		return x.PushMetrics
+	}
+	return false
+}
+
+func (x *AddMongoDBExporterParams) GetDisableCollectors() []string {
+	if x != nil {
+		return x.DisableCollectors
+	}
+	return nil
+}
+
+func (x *AddMongoDBExporterParams) GetAuthenticationMechanism() string {
+	if x != nil {
+		return x.AuthenticationMechanism
+	}
+	return ""
+}
+
+func (x *AddMongoDBExporterParams) GetAuthenticationDatabase() string {
+	if x != nil {
+		return x.AuthenticationDatabase
+	}
+	return ""
+}
+
+func (x *AddMongoDBExporterParams) GetAgentPassword() string {
+	if x != nil {
+		return x.AgentPassword
+	}
+	return ""
+}
+
+func (x *AddMongoDBExporterParams) GetStatsCollections() []string {
+	if x != nil {
+		return x.StatsCollections
+	}
+	return nil
+}
+
+func (x *AddMongoDBExporterParams) GetCollectionsLimit() int32 {
+	if x != nil {
+		return x.CollectionsLimit
+	}
+	return 0
+}
+
+func (x *AddMongoDBExporterParams) GetLogLevel() LogLevel {
+	if x != nil {
+		return x.LogLevel
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *AddMongoDBExporterParams) GetExposeExporter() bool {
+	if x != nil {
+		return x.ExposeExporter
+	}
+	return false
+}
+
+func (x *AddMongoDBExporterParams) GetEnvironmentVariableNames() []string {
+	if x != nil {
+		return x.EnvironmentVariableNames
+	}
+	return nil
+}
+
+func (x *AddMongoDBExporterParams) GetEnableAllCollectors() bool {
+	if x != nil {
+		return x.EnableAllCollectors
+	}
+	return false
+}
+
+func (x *AddMongoDBExporterParams) GetConnectionTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ConnectionTimeout
+	}
+	return nil
+}
+
+func (x *AddMongoDBExporterParams) GetEnableDiagnosticDataHistograms() bool {
+	if x != nil {
+		return x.EnableDiagnosticDataHistograms
+	}
+	return false
+}
+
+type ChangeMongoDBExporterParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Enable this Agent. Agents are enabled by default when they get added.
+	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
+	// Replace all custom user-assigned labels.
+	CustomLabels *common.StringMap `protobuf:"bytes,2,opt,name=custom_labels,json=customLabels,proto3,oneof" json:"custom_labels,omitempty"`
+	// Enables push metrics with vmagent.
+	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
+	// Metrics resolution for this agent.
+	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
+	// MongoDB username for scraping metrics.
+	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// MongoDB password for scraping metrics.
+	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// Use TLS for database connections.
+	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify *bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
+	// Client certificate and key.
+	TlsCertificateKey *string `protobuf:"bytes,9,opt,name=tls_certificate_key,json=tlsCertificateKey,proto3,oneof" json:"tls_certificate_key,omitempty"`
+	// Password for decrypting tls_certificate_key.
+	TlsCertificateKeyFilePassword *string `protobuf:"bytes,10,opt,name=tls_certificate_key_file_password,json=tlsCertificateKeyFilePassword,proto3,oneof" json:"tls_certificate_key_file_password,omitempty"`
+	// Certificate Authority certificate chain.
+	TlsCa *string `protobuf:"bytes,11,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
+	// Skip connection check.
+	SkipConnectionCheck *bool `protobuf:"varint,12,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `protobuf:"bytes,13,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
+	// Authentication mechanism.
+	AuthenticationMechanism *string `protobuf:"bytes,14,opt,name=authentication_mechanism,json=authenticationMechanism,proto3,oneof" json:"authentication_mechanism,omitempty"`
+	// Authentication database.
+	AuthenticationDatabase *string `protobuf:"bytes,15,opt,name=authentication_database,json=authenticationDatabase,proto3,oneof" json:"authentication_database,omitempty"`
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword *string `protobuf:"bytes,16,opt,name=agent_password,json=agentPassword,proto3,oneof" json:"agent_password,omitempty"`
+	// List of collections to get stats from. Can use *
+	StatsCollections []string `protobuf:"bytes,17,rep,name=stats_collections,json=statsCollections,proto3" json:"stats_collections,omitempty"`
+	// Collections limit. Only get Databases and collection stats if the total number of collections in the server is less than this value. 0: no limit
+	CollectionsLimit *int32 `protobuf:"varint,18,opt,name=collections_limit,json=collectionsLimit,proto3,oneof" json:"collections_limit,omitempty"`
+	// Enable all collectors.
+	EnableAllCollectors *bool `protobuf:"varint,19,opt,name=enable_all_collectors,json=enableAllCollectors,proto3,oneof" json:"enable_all_collectors,omitempty"`
+	// Log level for exporter.
+	LogLevel *LogLevel `protobuf:"varint,20,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
+	// Optionally expose the exporter process on all public interfaces.
+	ExposeExporter *bool `protobuf:"varint,21,opt,name=expose_exporter,json=exposeExporter,proto3,oneof" json:"expose_exporter,omitempty"`
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,22,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
+	// Enable collecting histogram bucket metrics from getDiagnosticData.
+	EnableDiagnosticDataHistograms *bool `protobuf:"varint,23,opt,name=enable_diagnostic_data_histograms,json=enableDiagnosticDataHistograms,proto3,oneof" json:"enable_diagnostic_data_histograms,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
+}
+
+func (x *ChangeMongoDBExporterParams) Reset() {
+	*x = ChangeMongoDBExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeMongoDBExporterParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeMongoDBExporterParams) ProtoMessage() {}
+
+func (x *ChangeMongoDBExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeMongoDBExporterParams.ProtoReflect.Descriptor instead.
+func (*ChangeMongoDBExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ChangeMongoDBExporterParams) GetEnable() bool {
+	if x != nil && x.Enable != nil {
+		return *x.Enable
+	}
+	return false
+}
+
+func (x *ChangeMongoDBExporterParams) GetCustomLabels() *common.StringMap {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
+}
+
+func (x *ChangeMongoDBExporterParams) GetEnablePushMetrics() bool {
+	if x != nil && x.EnablePushMetrics != nil {
+		return *x.EnablePushMetrics
+	}
+	return false
+}
+
+func (x *ChangeMongoDBExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
+	if x != nil {
+		return x.MetricsResolutions
+	}
+	return nil
+}
+
+func (x *ChangeMongoDBExporterParams) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *ChangeMongoDBExporterParams) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *ChangeMongoDBExporterParams) GetTls() bool {
+	if x != nil && x.Tls != nil {
+		return *x.Tls
+	}
+	return false
+}
+
+func (x *ChangeMongoDBExporterParams) GetTlsSkipVerify() bool {
+	if x != nil && x.TlsSkipVerify != nil {
+		return *x.TlsSkipVerify
+	}
+	return false
+}
+
+func (x *ChangeMongoDBExporterParams) GetTlsCertificateKey() string {
+	if x != nil && x.TlsCertificateKey != nil {
+		return *x.TlsCertificateKey
+	}
+	return ""
+}
+
+func (x *ChangeMongoDBExporterParams) GetTlsCertificateKeyFilePassword() string {
+	if x != nil && x.TlsCertificateKeyFilePassword != nil {
+		return *x.TlsCertificateKeyFilePassword
+	}
+	return ""
+}
+
+func (x *ChangeMongoDBExporterParams) GetTlsCa() string {
+	if x != nil && x.TlsCa != nil {
+		return *x.TlsCa
+	}
+	return ""
+}
+
+func (x *ChangeMongoDBExporterParams) GetSkipConnectionCheck() bool {
+	if x != nil && x.SkipConnectionCheck != nil {
+		return *x.SkipConnectionCheck
+	}
+	return false
+}
+
+func (x *ChangeMongoDBExporterParams) GetDisableCollectors() []string {
+	if x != nil {
+		return x.DisableCollectors
+	}
+	return nil
+}
+
+func (x *ChangeMongoDBExporterParams) GetAuthenticationMechanism() string {
+	if x != nil && x.AuthenticationMechanism != nil {
+		return *x.AuthenticationMechanism
+	}
+	return ""
+}
+
+func (x *ChangeMongoDBExporterParams) GetAuthenticationDatabase() string {
+	if x != nil && x.AuthenticationDatabase != nil {
+		return *x.AuthenticationDatabase
+	}
+	return ""
+}
+
+func (x *ChangeMongoDBExporterParams) GetAgentPassword() string {
+	if x != nil && x.AgentPassword != nil {
+		return *x.AgentPassword
+	}
+	return ""
+}
+
+func (x *ChangeMongoDBExporterParams) GetStatsCollections() []string {
+	if x != nil {
+		return x.StatsCollections
+	}
+	return nil
+}
+
+func (x *ChangeMongoDBExporterParams) GetCollectionsLimit() int32 {
+	if x != nil && x.CollectionsLimit != nil {
+		return *x.CollectionsLimit
+	}
+	return 0
+}
+
+func (x *ChangeMongoDBExporterParams) GetEnableAllCollectors() bool {
+	if x != nil && x.EnableAllCollectors != nil {
+		return *x.EnableAllCollectors
+	}
+	return false
+}
+
+func (x *ChangeMongoDBExporterParams) GetLogLevel() LogLevel {
+	if x != nil && x.LogLevel != nil {
+		return *x.LogLevel
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *ChangeMongoDBExporterParams) GetExposeExporter() bool {
+	if x != nil && x.ExposeExporter != nil {
+		return *x.ExposeExporter
+	}
+	return false
+}
+
+func (x *ChangeMongoDBExporterParams) GetConnectionTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ConnectionTimeout
+	}
+	return nil
+}
+
+func (x *ChangeMongoDBExporterParams) GetEnableDiagnosticDataHistograms() bool {
+	if x != nil && x.EnableDiagnosticDataHistograms != nil {
+		return *x.EnableDiagnosticDataHistograms
+	}
+	return false
+}
+
+type AddPostgresExporterParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pmm-agent identifier which runs this instance.
+	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
+	// Service identifier.
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// PostgreSQL username for scraping metrics.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// PostgreSQL password for scraping metrics.
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// Use TLS for database connections.
+	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation. Uses sslmode=required instead of verify-full.
+	TlsSkipVerify bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `protobuf:"bytes,7,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Skip connection check.
+	SkipConnectionCheck bool `protobuf:"varint,8,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
+	// Enables push metrics mode for exporter.
+	PushMetrics bool `protobuf:"varint,9,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `protobuf:"bytes,10,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
+	// TLS CA certificate.
+	TlsCa string `protobuf:"bytes,11,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// TLS Certifcate.
+	TlsCert string `protobuf:"bytes,12,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
+	// TLS Certificate Key.
+	TlsKey string `protobuf:"bytes,13,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword string `protobuf:"bytes,14,opt,name=agent_password,json=agentPassword,proto3" json:"agent_password,omitempty"`
+	// Log level for exporter.
+	LogLevel LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	// Limit of databases for auto-discovery.
+	AutoDiscoveryLimit int32 `protobuf:"varint,16,opt,name=auto_discovery_limit,json=autoDiscoveryLimit,proto3" json:"auto_discovery_limit,omitempty"`
+	// Optionally expose the exporter process on all public interfaces
+	ExposeExporter bool `protobuf:"varint,17,opt,name=expose_exporter,json=exposeExporter,proto3" json:"expose_exporter,omitempty"`
+	// Maximum number of connections that exporter can open to the database instance.
+	MaxExporterConnections int32 `protobuf:"varint,18,opt,name=max_exporter_connections,json=maxExporterConnections,proto3" json:"max_exporter_connections,omitempty"`
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,19,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AddPostgresExporterParams) Reset() {
+	*x = AddPostgresExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddPostgresExporterParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddPostgresExporterParams) ProtoMessage() {}
+
+func (x *AddPostgresExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddPostgresExporterParams.ProtoReflect.Descriptor instead.
+func (*AddPostgresExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AddPostgresExporterParams) GetPmmAgentId() string {
+	if x != nil {
+		return x.PmmAgentId
+	}
+	return ""
+}
+
+func (x *AddPostgresExporterParams) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *AddPostgresExporterParams) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AddPostgresExporterParams) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *AddPostgresExporterParams) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+func (x *AddPostgresExporterParams) GetTlsSkipVerify() bool {
+	if x != nil {
+		return x.TlsSkipVerify
+	}
+	return false
+}
+
+func (x *AddPostgresExporterParams) GetCustomLabels() map[string]string {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
+}
+
+func (x *AddPostgresExporterParams) GetSkipConnectionCheck() bool {
+	if x != nil {
+		return x.SkipConnectionCheck
+	}
+	return false
+}
+
+func (x *AddPostgresExporterParams) GetPushMetrics() bool {
+	if x != nil {
+		return x.PushMetrics
+	}
+	return false
+}
+
+func (x *AddPostgresExporterParams) GetDisableCollectors() []string {
+	if x != nil {
+		return x.DisableCollectors
+	}
+	return nil
+}
+
+func (x *AddPostgresExporterParams) GetTlsCa() string {
+	if x != nil {
+		return x.TlsCa
+	}
+	return ""
+}
+
+func (x *AddPostgresExporterParams) GetTlsCert() string {
+	if x != nil {
+		return x.TlsCert
+	}
+	return ""
+}
+
+func (x *AddPostgresExporterParams) GetTlsKey() string {
+	if x != nil {
+		return x.TlsKey
+	}
+	return ""
+}
+
+func (x *AddPostgresExporterParams) GetAgentPassword() string {
+	if x != nil {
+		return x.AgentPassword
+	}
+	return ""
+}
+
+func (x *AddPostgresExporterParams) GetLogLevel() LogLevel {
+	if x != nil {
+		return x.LogLevel
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *AddPostgresExporterParams) GetAutoDiscoveryLimit() int32 {
+	if x != nil {
+		return x.AutoDiscoveryLimit
+	}
+	return 0
+}
+
+func (x *AddPostgresExporterParams) GetExposeExporter() bool {
+	if x != nil {
+		return x.ExposeExporter
+	}
+	return false
+}
+
+func (x *AddPostgresExporterParams) GetMaxExporterConnections() int32 {
+	if x != nil {
+		return x.MaxExporterConnections
+	}
+	return 0
+}
+
+func (x *AddPostgresExporterParams) GetConnectionTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ConnectionTimeout
+	}
+	return nil
+}
+
+type ChangePostgresExporterParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Enable this Agent. Agents are enabled by default when they get added.
+	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
+	// Replace all custom user-assigned labels.
+	CustomLabels *common.StringMap `protobuf:"bytes,2,opt,name=custom_labels,json=customLabels,proto3,oneof" json:"custom_labels,omitempty"`
+	// Enables push metrics with vmagent.
+	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
+	// Metrics resolution for this agent.
+	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
+	// PostgreSQL username for scraping metrics.
+	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// PostgreSQL password for scraping metrics.
+	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// Use TLS for database connections.
+	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify *bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
+	// Skip connection check.
+	SkipConnectionCheck *bool `protobuf:"varint,9,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `protobuf:"bytes,10,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
+	// TLS CA certificate.
+	TlsCa *string `protobuf:"bytes,11,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
+	// TLS Certificate.
+	TlsCert *string `protobuf:"bytes,12,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
+	// TLS Certificate Key.
+	TlsKey *string `protobuf:"bytes,13,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword *string `protobuf:"bytes,14,opt,name=agent_password,json=agentPassword,proto3,oneof" json:"agent_password,omitempty"`
+	// Log level for exporter.
+	LogLevel *LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
+	// Limit of databases for auto-discovery.
+	AutoDiscoveryLimit *int32 `protobuf:"varint,16,opt,name=auto_discovery_limit,json=autoDiscoveryLimit,proto3,oneof" json:"auto_discovery_limit,omitempty"`
+	// Optionally expose the exporter process on all public interfaces.
+	ExposeExporter *bool `protobuf:"varint,17,opt,name=expose_exporter,json=exposeExporter,proto3,oneof" json:"expose_exporter,omitempty"`
+	// Maximum number of connections that exporter can open to the database instance.
+	MaxExporterConnections *int32 `protobuf:"varint,18,opt,name=max_exporter_connections,json=maxExporterConnections,proto3,oneof" json:"max_exporter_connections,omitempty"`
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,19,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ChangePostgresExporterParams) Reset() {
+	*x = ChangePostgresExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePostgresExporterParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePostgresExporterParams) ProtoMessage() {}
+
+func (x *ChangePostgresExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePostgresExporterParams.ProtoReflect.Descriptor instead.
+func (*ChangePostgresExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ChangePostgresExporterParams) GetEnable() bool {
+	if x != nil && x.Enable != nil {
+		return *x.Enable
+	}
+	return false
+}
+
+func (x *ChangePostgresExporterParams) GetCustomLabels() *common.StringMap {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
+}
+
+func (x *ChangePostgresExporterParams) GetEnablePushMetrics() bool {
+	if x != nil && x.EnablePushMetrics != nil {
+		return *x.EnablePushMetrics
+	}
+	return false
+}
+
+func (x *ChangePostgresExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
+	if x != nil {
+		return x.MetricsResolutions
+	}
+	return nil
+}
+
+func (x *ChangePostgresExporterParams) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *ChangePostgresExporterParams) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+func (x *ChangePostgresExporterParams) GetTls() bool {
+	if x != nil && x.Tls != nil {
+		return *x.Tls
+	}
+	return false
+}
+
+func (x *ChangePostgresExporterParams) GetTlsSkipVerify() bool {
+	if x != nil && x.TlsSkipVerify != nil {
+		return *x.TlsSkipVerify
+	}
+	return false
+}
+
+func (x *ChangePostgresExporterParams) GetSkipConnectionCheck() bool {
+	if x != nil && x.SkipConnectionCheck != nil {
+		return *x.SkipConnectionCheck
+	}
+	return false
+}
+
+func (x *ChangePostgresExporterParams) GetDisableCollectors() []string {
+	if x != nil {
+		return x.DisableCollectors
+	}
+	return nil
+}
+
+func (x *ChangePostgresExporterParams) GetTlsCa() string {
+	if x != nil && x.TlsCa != nil {
+		return *x.TlsCa
+	}
+	return ""
+}
+
+func (x *ChangePostgresExporterParams) GetTlsCert() string {
+	if x != nil && x.TlsCert != nil {
+		return *x.TlsCert
+	}
+	return ""
+}
+
+func (x *ChangePostgresExporterParams) GetTlsKey() string {
+	if x != nil && x.TlsKey != nil {
+		return *x.TlsKey
+	}
+	return ""
+}
+
+func (x *ChangePostgresExporterParams) GetAgentPassword() string {
+	if x != nil && x.AgentPassword != nil {
+		return *x.AgentPassword
+	}
+	return ""
+}
+
+func (x *ChangePostgresExporterParams) GetLogLevel() LogLevel {
+	if x != nil && x.LogLevel != nil {
+		return *x.LogLevel
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *ChangePostgresExporterParams) GetAutoDiscoveryLimit() int32 {
+	if x != nil && x.AutoDiscoveryLimit != nil {
+		return *x.AutoDiscoveryLimit
+	}
+	return 0
+}
+
+func (x *ChangePostgresExporterParams) GetExposeExporter() bool {
+	if x != nil && x.ExposeExporter != nil {
+		return *x.ExposeExporter
+	}
+	return false
+}
+
+func (x *ChangePostgresExporterParams) GetMaxExporterConnections() int32 {
+	if x != nil && x.MaxExporterConnections != nil {
+		return *x.MaxExporterConnections
+	}
+	return 0
+}
+
+func (x *ChangePostgresExporterParams) GetConnectionTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ConnectionTimeout
+	}
+	return nil
+}
+
+type AddProxySQLExporterParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pmm-agent identifier which runs this instance.
+	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
+	// Service identifier.
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// ProxySQL username for scraping metrics.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// ProxySQL password for scraping metrics.
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// Use TLS for database connections.
+	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `protobuf:"bytes,7,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Skip connection check.
+	SkipConnectionCheck bool `protobuf:"varint,8,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
+	// Enables push metrics mode for exporter.
+	PushMetrics bool `protobuf:"varint,9,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `protobuf:"bytes,10,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword string `protobuf:"bytes,11,opt,name=agent_password,json=agentPassword,proto3" json:"agent_password,omitempty"`
+	// Log level for exporter.
+	LogLevel LogLevel `protobuf:"varint,12,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	// Optionally expose the exporter process on all public interfaces
+	ExposeExporter bool `protobuf:"varint,13,opt,name=expose_exporter,json=exposeExporter,proto3" json:"expose_exporter,omitempty"`
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,14,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *AddProxySQLExporterParams) Reset() {
+	*x = AddProxySQLExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeAgentResponse) String() string {
+func (x *AddProxySQLExporterParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeAgentResponse) ProtoMessage() {}
+func (*AddProxySQLExporterParams) ProtoMessage() {}
 
-func (x *ChangeAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[30]
+func (x *AddProxySQLExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5152,302 +8234,324 @@ func (x *ChangeAgentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeAgentResponse.ProtoReflect.Descriptor instead.
-func (*ChangeAgentResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *ChangeAgentResponse) GetAgent() isChangeAgentResponse_Agent {
-	if x != nil {
-		return x.Agent
-	}
-	return nil
-}
-
-func (x *ChangeAgentResponse) GetNodeExporter() *NodeExporter {
-	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_NodeExporter); ok {
-			return x.NodeExporter
-		}
-	}
-	return nil
+// Deprecated: Use AddProxySQLExporterParams.ProtoReflect.Descriptor instead.
+func (*AddProxySQLExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ChangeAgentResponse) GetMysqldExporter() *MySQLdExporter {
+func (x *AddProxySQLExporterParams) GetPmmAgentId() string {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_MysqldExporter); ok {
-			return x.MysqldExporter
-		}
+		return x.PmmAgentId
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangeAgentResponse) GetMongodbExporter() *MongoDBExporter {
+func (x *AddProxySQLExporterParams) GetServiceId() string {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_MongodbExporter); ok {
-			return x.MongodbExporter
-		}
+		return x.ServiceId
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangeAgentResponse) GetPostgresExporter() *PostgresExporter {
+func (x *AddProxySQLExporterParams) GetUsername() string {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_PostgresExporter); ok {
-			return x.PostgresExporter
-		}
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangeAgentResponse) GetProxysqlExporter() *ProxySQLExporter {
+func (x *AddProxySQLExporterParams) GetPassword() string {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_ProxysqlExporter); ok {
-			return x.ProxysqlExporter
-		}
+		return x.Password
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangeAgentResponse) GetExternalExporter() *ExternalExporter {
+func (x *AddProxySQLExporterParams) GetTls() bool {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_ExternalExporter); ok {
-			return x.ExternalExporter
-		}
+		return x.Tls
 	}
-	return nil
+	return false
 }
 
-func (x *ChangeAgentResponse) GetRdsExporter() *RDSExporter {
+func (x *AddProxySQLExporterParams) GetTlsSkipVerify() bool {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_RdsExporter); ok {
-			return x.RdsExporter
-		}
+		return x.TlsSkipVerify
 	}
-	return nil
+	return false
 }
 
-func (x *ChangeAgentResponse) GetAzureDatabaseExporter() *AzureDatabaseExporter {
+func (x *AddProxySQLExporterParams) GetCustomLabels() map[string]string {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_AzureDatabaseExporter); ok {
-			return x.AzureDatabaseExporter
-		}
+		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangeAgentResponse) GetQanMysqlPerfschemaAgent() *QANMySQLPerfSchemaAgent {
+func (x *AddProxySQLExporterParams) GetSkipConnectionCheck() bool {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_QanMysqlPerfschemaAgent); ok {
-			return x.QanMysqlPerfschemaAgent
-		}
+		return x.SkipConnectionCheck
 	}
-	return nil
+	return false
 }
 
-func (x *ChangeAgentResponse) GetQanMysqlSlowlogAgent() *QANMySQLSlowlogAgent {
+func (x *AddProxySQLExporterParams) GetPushMetrics() bool {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_QanMysqlSlowlogAgent); ok {
-			return x.QanMysqlSlowlogAgent
-		}
+		return x.PushMetrics
 	}
-	return nil
+	return false
 }
 
-func (x *ChangeAgentResponse) GetQanMongodbProfilerAgent() *QANMongoDBProfilerAgent {
+func (x *AddProxySQLExporterParams) GetDisableCollectors() []string {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_QanMongodbProfilerAgent); ok {
-			return x.QanMongodbProfilerAgent
-		}
+		return x.DisableCollectors
 	}
 	return nil
 }
 
-func (x *ChangeAgentResponse) GetQanMongodbMongologAgent() *QANMongoDBMongologAgent {
+func (x *AddProxySQLExporterParams) GetAgentPassword() string {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_QanMongodbMongologAgent); ok {
-			return x.QanMongodbMongologAgent
-		}
+		return x.AgentPassword
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangeAgentResponse) GetQanPostgresqlPgstatementsAgent() *QANPostgreSQLPgStatementsAgent {
+func (x *AddProxySQLExporterParams) GetLogLevel() LogLevel {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_QanPostgresqlPgstatementsAgent); ok {
-			return x.QanPostgresqlPgstatementsAgent
-		}
+		return x.LogLevel
 	}
-	return nil
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *ChangeAgentResponse) GetQanPostgresqlPgstatmonitorAgent() *QANPostgreSQLPgStatMonitorAgent {
+func (x *AddProxySQLExporterParams) GetExposeExporter() bool {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_QanPostgresqlPgstatmonitorAgent); ok {
-			return x.QanPostgresqlPgstatmonitorAgent
-		}
+		return x.ExposeExporter
 	}
-	return nil
+	return false
 }
 
-func (x *ChangeAgentResponse) GetNomadAgent() *NomadAgent {
+func (x *AddProxySQLExporterParams) GetConnectionTimeout() *durationpb.Duration {
 	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_NomadAgent); ok {
-			return x.NomadAgent
-		}
+		return x.ConnectionTimeout
 	}
 	return nil
 }
 
-func (x *ChangeAgentResponse) GetValkeyExporter() *ValkeyExporter {
-	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_ValkeyExporter); ok {
-			return x.ValkeyExporter
-		}
-	}
-	return nil
+type ChangeProxySQLExporterParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Enable this Agent. Agents are enabled by default when they get added.
+	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
+	// Replace all custom user-assigned labels.
+	CustomLabels *common.StringMap `protobuf:"bytes,2,opt,name=custom_labels,json=customLabels,proto3,oneof" json:"custom_labels,omitempty"`
+	// Enables push metrics with vmagent.
+	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
+	// Metrics resolution for this agent.
+	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
+	// ProxySQL username for scraping metrics.
+	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// ProxySQL password for scraping metrics.
+	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// Use TLS for database connections.
+	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify *bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `protobuf:"bytes,9,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword *string `protobuf:"bytes,10,opt,name=agent_password,json=agentPassword,proto3,oneof" json:"agent_password,omitempty"`
+	// Log level for exporter.
+	LogLevel *LogLevel `protobuf:"varint,11,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
+	// Optionally expose the exporter process on all public interfaces.
+	ExposeExporter *bool `protobuf:"varint,12,opt,name=expose_exporter,json=exposeExporter,proto3,oneof" json:"expose_exporter,omitempty"`
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,13,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
+	// Skip connection check.
+	SkipConnectionCheck *bool `protobuf:"varint,14,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ChangeAgentResponse) GetRtaMongodbAgent() *RTAMongoDBAgent {
-	if x != nil {
-		if x, ok := x.Agent.(*ChangeAgentResponse_RtaMongodbAgent); ok {
-			return x.RtaMongodbAgent
-		}
-	}
-	return nil
+func (x *ChangeProxySQLExporterParams) Reset() {
+	*x = ChangeProxySQLExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type isChangeAgentResponse_Agent interface {
-	isChangeAgentResponse_Agent()
+func (x *ChangeProxySQLExporterParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type ChangeAgentResponse_NodeExporter struct {
-	NodeExporter *NodeExporter `protobuf:"bytes,2,opt,name=node_exporter,json=nodeExporter,proto3,oneof"`
-}
+func (*ChangeProxySQLExporterParams) ProtoMessage() {}
 
-type ChangeAgentResponse_MysqldExporter struct {
-	MysqldExporter *MySQLdExporter `protobuf:"bytes,3,opt,name=mysqld_exporter,json=mysqldExporter,proto3,oneof"`
+func (x *ChangeProxySQLExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type ChangeAgentResponse_MongodbExporter struct {
-	MongodbExporter *MongoDBExporter `protobuf:"bytes,4,opt,name=mongodb_exporter,json=mongodbExporter,proto3,oneof"`
+// Deprecated: Use ChangeProxySQLExporterParams.ProtoReflect.Descriptor instead.
+func (*ChangeProxySQLExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{46}
 }
 
-type ChangeAgentResponse_PostgresExporter struct {
-	PostgresExporter *PostgresExporter `protobuf:"bytes,5,opt,name=postgres_exporter,json=postgresExporter,proto3,oneof"`
+func (x *ChangeProxySQLExporterParams) GetEnable() bool {
+	if x != nil && x.Enable != nil {
+		return *x.Enable
+	}
+	return false
 }
 
-type ChangeAgentResponse_ProxysqlExporter struct {
-	ProxysqlExporter *ProxySQLExporter `protobuf:"bytes,6,opt,name=proxysql_exporter,json=proxysqlExporter,proto3,oneof"`
+func (x *ChangeProxySQLExporterParams) GetCustomLabels() *common.StringMap {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
 }
 
-type ChangeAgentResponse_ExternalExporter struct {
-	ExternalExporter *ExternalExporter `protobuf:"bytes,7,opt,name=external_exporter,json=externalExporter,proto3,oneof"`
+func (x *ChangeProxySQLExporterParams) GetEnablePushMetrics() bool {
+	if x != nil && x.EnablePushMetrics != nil {
+		return *x.EnablePushMetrics
+	}
+	return false
 }
 
-type ChangeAgentResponse_RdsExporter struct {
-	RdsExporter *RDSExporter `protobuf:"bytes,8,opt,name=rds_exporter,json=rdsExporter,proto3,oneof"`
+func (x *ChangeProxySQLExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
+	if x != nil {
+		return x.MetricsResolutions
+	}
+	return nil
 }
 
-type ChangeAgentResponse_AzureDatabaseExporter struct {
-	AzureDatabaseExporter *AzureDatabaseExporter `protobuf:"bytes,9,opt,name=azure_database_exporter,json=azureDatabaseExporter,proto3,oneof"`
+func (x *ChangeProxySQLExporterParams) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
 }
 
-type ChangeAgentResponse_QanMysqlPerfschemaAgent struct {
-	QanMysqlPerfschemaAgent *QANMySQLPerfSchemaAgent `protobuf:"bytes,10,opt,name=qan_mysql_perfschema_agent,json=qanMysqlPerfschemaAgent,proto3,oneof"`
+func (x *ChangeProxySQLExporterParams) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
 }
 
-type ChangeAgentResponse_QanMysqlSlowlogAgent struct {
-	QanMysqlSlowlogAgent *QANMySQLSlowlogAgent `protobuf:"bytes,11,opt,name=qan_mysql_slowlog_agent,json=qanMysqlSlowlogAgent,proto3,oneof"`
+func (x *ChangeProxySQLExporterParams) GetTls() bool {
+	if x != nil && x.Tls != nil {
+		return *x.Tls
+	}
+	return false
 }
 
-type ChangeAgentResponse_QanMongodbProfilerAgent struct {
-	QanMongodbProfilerAgent *QANMongoDBProfilerAgent `protobuf:"bytes,12,opt,name=qan_mongodb_profiler_agent,json=qanMongodbProfilerAgent,proto3,oneof"`
+func (x *ChangeProxySQLExporterParams) GetTlsSkipVerify() bool {
+	if x != nil && x.TlsSkipVerify != nil {
+		return *x.TlsSkipVerify
+	}
+	return false
 }
 
-type ChangeAgentResponse_QanMongodbMongologAgent struct {
-	QanMongodbMongologAgent *QANMongoDBMongologAgent `protobuf:"bytes,17,opt,name=qan_mongodb_mongolog_agent,json=qanMongodbMongologAgent,proto3,oneof"`
+func (x *ChangeProxySQLExporterParams) GetDisableCollectors() []string {
+	if x != nil {
+		return x.DisableCollectors
+	}
+	return nil
 }
 
-type ChangeAgentResponse_QanPostgresqlPgstatementsAgent struct {
-	QanPostgresqlPgstatementsAgent *QANPostgreSQLPgStatementsAgent `protobuf:"bytes,13,opt,name=qan_postgresql_pgstatements_agent,json=qanPostgresqlPgstatementsAgent,proto3,oneof"`
+func (x *ChangeProxySQLExporterParams) GetAgentPassword() string {
+	if x != nil && x.AgentPassword != nil {
+		return *x.AgentPassword
+	}
+	return ""
 }
 
-type ChangeAgentResponse_QanPostgresqlPgstatmonitorAgent struct {
-	QanPostgresqlPgstatmonitorAgent *QANPostgreSQLPgStatMonitorAgent `protobuf:"bytes,14,opt,name=qan_postgresql_pgstatmonitor_agent,json=qanPostgresqlPgstatmonitorAgent,proto3,oneof"`
+func (x *ChangeProxySQLExporterParams) GetLogLevel() LogLevel {
+	if x != nil && x.LogLevel != nil {
+		return *x.LogLevel
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-type ChangeAgentResponse_NomadAgent struct {
-	NomadAgent *NomadAgent `protobuf:"bytes,15,opt,name=nomad_agent,json=nomadAgent,proto3,oneof"`
+func (x *ChangeProxySQLExporterParams) GetExposeExporter() bool {
+	if x != nil && x.ExposeExporter != nil {
+		return *x.ExposeExporter
+	}
+	return false
 }
 
-type ChangeAgentResponse_ValkeyExporter struct {
-	ValkeyExporter *ValkeyExporter `protobuf:"bytes,16,opt,name=valkey_exporter,json=valkeyExporter,proto3,oneof"`
+func (x *ChangeProxySQLExporterParams) GetConnectionTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ConnectionTimeout
+	}
+	return nil
 }
 
-type ChangeAgentResponse_RtaMongodbAgent struct {
-	RtaMongodbAgent *RTAMongoDBAgent `protobuf:"bytes,18,opt,name=rta_mongodb_agent,json=rtaMongodbAgent,proto3,oneof"`
+func (x *ChangeProxySQLExporterParams) GetSkipConnectionCheck() bool {
+	if x != nil && x.SkipConnectionCheck != nil {
+		return *x.SkipConnectionCheck
+	}
+	return false
 }
 
-func (*ChangeAgentResponse_NodeExporter) isChangeAgentResponse_Agent() {}
-
-func (*ChangeAgentResponse_MysqldExporter) isChangeAgentResponse_Agent() {}
-
-func (*ChangeAgentResponse_MongodbExporter) isChangeAgentResponse_Agent() {}
-
-func (*ChangeAgentResponse_PostgresExporter) isChangeAgentResponse_Agent() {}
-
-func (*ChangeAgentResponse_ProxysqlExporter) isChangeAgentResponse_Agent() {}
-
-func (*ChangeAgentResponse_ExternalExporter) isChangeAgentResponse_Agent() {}
-
-func (*ChangeAgentResponse_RdsExporter) isChangeAgentResponse_Agent() {}
-
-func (*ChangeAgentResponse_AzureDatabaseExporter) isChangeAgentResponse_Agent() {}
-
-func (*ChangeAgentResponse_QanMysqlPerfschemaAgent) isChangeAgentResponse_Agent() {}
-
-func (*ChangeAgentResponse_QanMysqlSlowlogAgent) isChangeAgentResponse_Agent() {}
-
-func (*ChangeAgentResponse_QanMongodbProfilerAgent) isChangeAgentResponse_Agent() {}
-
-func (*ChangeAgentResponse_QanMongodbMongologAgent) isChangeAgentResponse_Agent() {}
-
-func (*ChangeAgentResponse_QanPostgresqlPgstatementsAgent) isChangeAgentResponse_Agent() {}
-
-func (*ChangeAgentResponse_QanPostgresqlPgstatmonitorAgent) isChangeAgentResponse_Agent() {}
-
-func (*ChangeAgentResponse_NomadAgent) isChangeAgentResponse_Agent() {}
-
-func (*ChangeAgentResponse_ValkeyExporter) isChangeAgentResponse_Agent() {}
-
-func (*ChangeAgentResponse_RtaMongodbAgent) isChangeAgentResponse_Agent() {}
-
-type AddPMMAgentParams struct {
+type AddQANMySQLPerfSchemaAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Node identifier where this instance runs.
-	RunsOnNodeId string `protobuf:"bytes,1,opt,name=runs_on_node_id,json=runsOnNodeId,proto3" json:"runs_on_node_id,omitempty"`
+	// The pmm-agent identifier which runs this instance.
+	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
+	// Service identifier.
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// MySQL username for getting performance data.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// MySQL password for getting performance data.
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// Use TLS for database connections.
+	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Certificate Authority certificate chain.
+	TlsCa string `protobuf:"bytes,7,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// Client certificate.
+	TlsCert string `protobuf:"bytes,8,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
+	// Password for decrypting tls_cert.
+	TlsKey string `protobuf:"bytes,9,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `protobuf:"varint,10,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
+	// Disable query examples.
+	DisableQueryExamples bool `protobuf:"varint,11,opt,name=disable_query_examples,json=disableQueryExamples,proto3" json:"disable_query_examples,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels  map[string]string `protobuf:"bytes,2,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	CustomLabels map[string]string `protobuf:"bytes,12,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Skip connection check.
+	SkipConnectionCheck bool `protobuf:"varint,13,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `protobuf:"varint,14,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3" json:"disable_comments_parsing,omitempty"`
+	// Log level for exporter.
+	LogLevel LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	// Extra DSN parameters for MySQL connection.
+	ExtraDsnParams map[string]string `protobuf:"bytes,16,rep,name=extra_dsn_params,json=extraDsnParams,proto3" json:"extra_dsn_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *AddPMMAgentParams) Reset() {
-	*x = AddPMMAgentParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[31]
+func (x *AddQANMySQLPerfSchemaAgentParams) Reset() {
+	*x = AddQANMySQLPerfSchemaAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPMMAgentParams) String() string {
+func (x *AddQANMySQLPerfSchemaAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPMMAgentParams) ProtoMessage() {}
+func (*AddQANMySQLPerfSchemaAgentParams) ProtoMessage() {}
 
-func (x *AddPMMAgentParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[31]
+func (x *AddQANMySQLPerfSchemaAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5458,116 +8562,124 @@ func (x *AddPMMAgentParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPMMAgentParams.ProtoReflect.Descriptor instead.
-func (*AddPMMAgentParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use AddQANMySQLPerfSchemaAgentParams.ProtoReflect.Descriptor instead.
+func (*AddQANMySQLPerfSchemaAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *AddPMMAgentParams) GetRunsOnNodeId() string {
+func (x *AddQANMySQLPerfSchemaAgentParams) GetPmmAgentId() string {
 	if x != nil {
-		return x.RunsOnNodeId
+		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *AddPMMAgentParams) GetCustomLabels() map[string]string {
+func (x *AddQANMySQLPerfSchemaAgentParams) GetServiceId() string {
 	if x != nil {
-		return x.CustomLabels
+		return x.ServiceId
 	}
-	return nil
+	return ""
 }
 
-type AddNodeExporterParams struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The pmm-agent identifier which runs this instance.
-	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
-	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,2,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Enables push metrics mode for exporter.
-	PushMetrics bool `protobuf:"varint,3,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `protobuf:"bytes,4,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
-	// Log level for exporter.
-	LogLevel LogLevel `protobuf:"varint,5,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
-	// Expose the node_exporter process on all public interfaces
-	ExposeExporter bool `protobuf:"varint,6,opt,name=expose_exporter,json=exposeExporter,proto3" json:"expose_exporter,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *AddQANMySQLPerfSchemaAgentParams) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-func (x *AddNodeExporterParams) Reset() {
-	*x = AddNodeExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[32]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *AddQANMySQLPerfSchemaAgentParams) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *AddQANMySQLPerfSchemaAgentParams) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+func (x *AddQANMySQLPerfSchemaAgentParams) GetTlsSkipVerify() bool {
+	if x != nil {
+		return x.TlsSkipVerify
+	}
+	return false
 }
 
-func (x *AddNodeExporterParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *AddQANMySQLPerfSchemaAgentParams) GetTlsCa() string {
+	if x != nil {
+		return x.TlsCa
+	}
+	return ""
 }
 
-func (*AddNodeExporterParams) ProtoMessage() {}
+func (x *AddQANMySQLPerfSchemaAgentParams) GetTlsCert() string {
+	if x != nil {
+		return x.TlsCert
+	}
+	return ""
+}
 
-func (x *AddNodeExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[32]
+func (x *AddQANMySQLPerfSchemaAgentParams) GetTlsKey() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.TlsKey
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use AddNodeExporterParams.ProtoReflect.Descriptor instead.
-func (*AddNodeExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{32}
+func (x *AddQANMySQLPerfSchemaAgentParams) GetMaxQueryLength() int32 {
+	if x != nil {
+		return x.MaxQueryLength
+	}
+	return 0
 }
 
-func (x *AddNodeExporterParams) GetPmmAgentId() string {
+func (x *AddQANMySQLPerfSchemaAgentParams) GetDisableQueryExamples() bool {
 	if x != nil {
-		return x.PmmAgentId
+		return x.DisableQueryExamples
 	}
-	return ""
+	return false
 }
 
-func (x *AddNodeExporterParams) GetCustomLabels() map[string]string {
+func (x *AddQANMySQLPerfSchemaAgentParams) GetCustomLabels() map[string]string {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *AddNodeExporterParams) GetPushMetrics() bool {
+func (x *AddQANMySQLPerfSchemaAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil {
-		return x.PushMetrics
+		return x.SkipConnectionCheck
 	}
 	return false
 }
 
-func (x *AddNodeExporterParams) GetDisableCollectors() []string {
+func (x *AddQANMySQLPerfSchemaAgentParams) GetDisableCommentsParsing() bool {
 	if x != nil {
-		return x.DisableCollectors
+		return x.DisableCommentsParsing
 	}
-	return nil
+	return false
 }
 
-func (x *AddNodeExporterParams) GetLogLevel() LogLevel {
+func (x *AddQANMySQLPerfSchemaAgentParams) GetLogLevel() LogLevel {
 	if x != nil {
 		return x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *AddNodeExporterParams) GetExposeExporter() bool {
+func (x *AddQANMySQLPerfSchemaAgentParams) GetExtraDsnParams() map[string]string {
 	if x != nil {
-		return x.ExposeExporter
+		return x.ExtraDsnParams
 	}
-	return false
+	return nil
 }
 
-type ChangeNodeExporterParams struct {
+type ChangeQANMySQLPerfSchemaAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
@@ -5577,31 +8689,49 @@ type ChangeNodeExporterParams struct {
 	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
 	// Metrics resolution for this agent.
 	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `protobuf:"bytes,5,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
+	// MySQL username for getting performance data.
+	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// MySQL password for getting performance data.
+	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// Use TLS for database connections.
+	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify *bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
+	// Certificate Authority certificate chain.
+	TlsCa *string `protobuf:"bytes,9,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
+	// Client certificate.
+	TlsCert *string `protobuf:"bytes,10,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
+	// Password for decrypting tls_cert.
+	TlsKey *string `protobuf:"bytes,11,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength *int32 `protobuf:"varint,12,opt,name=max_query_length,json=maxQueryLength,proto3,oneof" json:"max_query_length,omitempty"`
+	// Disable query examples.
+	DisableQueryExamples *bool `protobuf:"varint,13,opt,name=disable_query_examples,json=disableQueryExamples,proto3,oneof" json:"disable_query_examples,omitempty"`
+	// Skip connection check.
+	SkipConnectionCheck *bool `protobuf:"varint,14,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing *bool `protobuf:"varint,15,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3,oneof" json:"disable_comments_parsing,omitempty"`
 	// Log level for exporter.
-	LogLevel *LogLevel `protobuf:"varint,6,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
-	// Expose the node_exporter process on all public interfaces.
-	ExposeExporter *bool `protobuf:"varint,7,opt,name=expose_exporter,json=exposeExporter,proto3,oneof" json:"expose_exporter,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	LogLevel      *LogLevel `protobuf:"varint,16,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeNodeExporterParams) Reset() {
-	*x = ChangeNodeExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[33]
+func (x *ChangeQANMySQLPerfSchemaAgentParams) Reset() {
+	*x = ChangeQANMySQLPerfSchemaAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeNodeExporterParams) String() string {
+func (x *ChangeQANMySQLPerfSchemaAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeNodeExporterParams) ProtoMessage() {}
+func (*ChangeQANMySQLPerfSchemaAgentParams) ProtoMessage() {}
 
-func (x *ChangeNodeExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[33]
+func (x *ChangeQANMySQLPerfSchemaAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5612,69 +8742,132 @@ func (x *ChangeNodeExporterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeNodeExporterParams.ProtoReflect.Descriptor instead.
-func (*ChangeNodeExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use ChangeQANMySQLPerfSchemaAgentParams.ProtoReflect.Descriptor instead.
+func (*ChangeQANMySQLPerfSchemaAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *ChangeNodeExporterParams) GetEnable() bool {
+func (x *ChangeQANMySQLPerfSchemaAgentParams) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
 	}
 	return false
 }
 
-func (x *ChangeNodeExporterParams) GetCustomLabels() *common.StringMap {
+func (x *ChangeQANMySQLPerfSchemaAgentParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangeNodeExporterParams) GetEnablePushMetrics() bool {
+func (x *ChangeQANMySQLPerfSchemaAgentParams) GetEnablePushMetrics() bool {
 	if x != nil && x.EnablePushMetrics != nil {
 		return *x.EnablePushMetrics
 	}
 	return false
 }
 
-func (x *ChangeNodeExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
+func (x *ChangeQANMySQLPerfSchemaAgentParams) GetMetricsResolutions() *common.MetricsResolutions {
 	if x != nil {
 		return x.MetricsResolutions
 	}
 	return nil
 }
 
-func (x *ChangeNodeExporterParams) GetDisableCollectors() []string {
-	if x != nil {
-		return x.DisableCollectors
+func (x *ChangeQANMySQLPerfSchemaAgentParams) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangeNodeExporterParams) GetLogLevel() LogLevel {
-	if x != nil && x.LogLevel != nil {
-		return *x.LogLevel
+func (x *ChangeQANMySQLPerfSchemaAgentParams) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
 	}
-	return LogLevel_LOG_LEVEL_UNSPECIFIED
+	return ""
 }
 
-func (x *ChangeNodeExporterParams) GetExposeExporter() bool {
-	if x != nil && x.ExposeExporter != nil {
-		return *x.ExposeExporter
+func (x *ChangeQANMySQLPerfSchemaAgentParams) GetTls() bool {
+	if x != nil && x.Tls != nil {
+		return *x.Tls
 	}
 	return false
 }
 
-type AddMySQLdExporterParams struct {
+func (x *ChangeQANMySQLPerfSchemaAgentParams) GetTlsSkipVerify() bool {
+	if x != nil && x.TlsSkipVerify != nil {
+		return *x.TlsSkipVerify
+	}
+	return false
+}
+
+func (x *ChangeQANMySQLPerfSchemaAgentParams) GetTlsCa() string {
+	if x != nil && x.TlsCa != nil {
+		return *x.TlsCa
+	}
+	return ""
+}
+
+func (x *ChangeQANMySQLPerfSchemaAgentParams) GetTlsCert() string {
+	if x != nil && x.TlsCert != nil {
+		return *x.TlsCert
+	}
+	return ""
+}
+
+func (x *ChangeQANMySQLPerfSchemaAgentParams) GetTlsKey() string {
+	if x != nil && x.TlsKey != nil {
+		return *x.TlsKey
+	}
+	return ""
+}
+
+func (x *ChangeQANMySQLPerfSchemaAgentParams) GetMaxQueryLength() int32 {
+	if x != nil && x.MaxQueryLength != nil {
+		return *x.MaxQueryLength
+	}
+	return 0
+}
+
+func (x *ChangeQANMySQLPerfSchemaAgentParams) GetDisableQueryExamples() bool {
+	if x != nil && x.DisableQueryExamples != nil {
+		return *x.DisableQueryExamples
+	}
+	return false
+}
+
+func (x *ChangeQANMySQLPerfSchemaAgentParams) GetSkipConnectionCheck() bool {
+	if x != nil && x.SkipConnectionCheck != nil {
+		return *x.SkipConnectionCheck
+	}
+	return false
+}
+
+func (x *ChangeQANMySQLPerfSchemaAgentParams) GetDisableCommentsParsing() bool {
+	if x != nil && x.DisableCommentsParsing != nil {
+		return *x.DisableCommentsParsing
+	}
+	return false
+}
+
+func (x *ChangeQANMySQLPerfSchemaAgentParams) GetLogLevel() LogLevel {
+	if x != nil && x.LogLevel != nil {
+		return *x.LogLevel
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+type AddQANMySQLSlowlogAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The pmm-agent identifier which runs this instance.
 	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
 	// Service identifier.
 	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// MySQL username for scraping metrics.
+	// MySQL username for getting slowlog data.
 	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	// MySQL password for scraping metrics.
+	// MySQL password for getting slowlog data.
 	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 	// Use TLS for database connections.
 	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
@@ -5686,47 +8879,42 @@ type AddMySQLdExporterParams struct {
 	TlsCert string `protobuf:"bytes,8,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
 	// Password for decrypting tls_cert.
 	TlsKey string `protobuf:"bytes,9,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
-	// Tablestats group collectors will be disabled if there are more than that number of tables.
-	// 0 means tablestats group collectors are always enabled (no limit).
-	// Negative value means tablestats group collectors are always disabled.
-	TablestatsGroupTableLimit int32 `protobuf:"varint,10,opt,name=tablestats_group_table_limit,json=tablestatsGroupTableLimit,proto3" json:"tablestats_group_table_limit,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `protobuf:"varint,10,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
+	// Disable query examples.
+	DisableQueryExamples bool `protobuf:"varint,11,opt,name=disable_query_examples,json=disableQueryExamples,proto3" json:"disable_query_examples,omitempty"`
+	// Rotate slowlog file at this size if > 0.
+	// Use zero or negative value to disable rotation.
+	MaxSlowlogFileSize int64 `protobuf:"varint,12,opt,name=max_slowlog_file_size,json=maxSlowlogFileSize,proto3" json:"max_slowlog_file_size,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,11,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CustomLabels map[string]string `protobuf:"bytes,13,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Skip connection check.
-	SkipConnectionCheck bool `protobuf:"varint,12,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
-	// Enables push metrics mode for exporter.
-	PushMetrics bool `protobuf:"varint,13,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `protobuf:"bytes,14,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword string `protobuf:"bytes,15,opt,name=agent_password,json=agentPassword,proto3" json:"agent_password,omitempty"`
+	SkipConnectionCheck bool `protobuf:"varint,14,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `protobuf:"varint,15,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3" json:"disable_comments_parsing,omitempty"`
 	// Log level for exporter.
 	LogLevel LogLevel `protobuf:"varint,16,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
-	// Optionally expose the exporter process on all public interfaces
-	ExposeExporter bool `protobuf:"varint,17,opt,name=expose_exporter,json=exposeExporter,proto3" json:"expose_exporter,omitempty"`
 	// Extra DSN parameters for MySQL connection.
-	ExtraDsnParams map[string]string `protobuf:"bytes,18,rep,name=extra_dsn_params,json=extraDsnParams,proto3" json:"extra_dsn_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,19,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	ExtraDsnParams map[string]string `protobuf:"bytes,17,rep,name=extra_dsn_params,json=extraDsnParams,proto3" json:"extra_dsn_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *AddMySQLdExporterParams) Reset() {
-	*x = AddMySQLdExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[34]
+func (x *AddQANMySQLSlowlogAgentParams) Reset() {
+	*x = AddQANMySQLSlowlogAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddMySQLdExporterParams) String() string {
+func (x *AddQANMySQLSlowlogAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddMySQLdExporterParams) ProtoMessage() {}
+func (*AddQANMySQLSlowlogAgentParams) ProtoMessage() {}
 
-func (x *AddMySQLdExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[34]
+func (x *AddQANMySQLSlowlogAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5737,145 +8925,131 @@ func (x *AddMySQLdExporterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddMySQLdExporterParams.ProtoReflect.Descriptor instead.
-func (*AddMySQLdExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use AddQANMySQLSlowlogAgentParams.ProtoReflect.Descriptor instead.
+func (*AddQANMySQLSlowlogAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *AddMySQLdExporterParams) GetPmmAgentId() string {
+func (x *AddQANMySQLSlowlogAgentParams) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *AddMySQLdExporterParams) GetServiceId() string {
+func (x *AddQANMySQLSlowlogAgentParams) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *AddMySQLdExporterParams) GetUsername() string {
+func (x *AddQANMySQLSlowlogAgentParams) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *AddMySQLdExporterParams) GetPassword() string {
+func (x *AddQANMySQLSlowlogAgentParams) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-func (x *AddMySQLdExporterParams) GetTls() bool {
+func (x *AddQANMySQLSlowlogAgentParams) GetTls() bool {
 	if x != nil {
 		return x.Tls
 	}
 	return false
 }
 
-func (x *AddMySQLdExporterParams) GetTlsSkipVerify() bool {
+func (x *AddQANMySQLSlowlogAgentParams) GetTlsSkipVerify() bool {
 	if x != nil {
 		return x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *AddMySQLdExporterParams) GetTlsCa() string {
+func (x *AddQANMySQLSlowlogAgentParams) GetTlsCa() string {
 	if x != nil {
 		return x.TlsCa
 	}
 	return ""
 }
 
-func (x *AddMySQLdExporterParams) GetTlsCert() string {
+func (x *AddQANMySQLSlowlogAgentParams) GetTlsCert() string {
 	if x != nil {
 		return x.TlsCert
 	}
 	return ""
 }
 
-func (x *AddMySQLdExporterParams) GetTlsKey() string {
+func (x *AddQANMySQLSlowlogAgentParams) GetTlsKey() string {
 	if x != nil {
 		return x.TlsKey
 	}
 	return ""
 }
 
-func (x *AddMySQLdExporterParams) GetTablestatsGroupTableLimit() int32 {
+func (x *AddQANMySQLSlowlogAgentParams) GetMaxQueryLength() int32 {
 	if x != nil {
-		return x.TablestatsGroupTableLimit
+		return x.MaxQueryLength
 	}
 	return 0
 }
 
-func (x *AddMySQLdExporterParams) GetCustomLabels() map[string]string {
-	if x != nil {
-		return x.CustomLabels
-	}
-	return nil
-}
-
-func (x *AddMySQLdExporterParams) GetSkipConnectionCheck() bool {
+func (x *AddQANMySQLSlowlogAgentParams) GetDisableQueryExamples() bool {
 	if x != nil {
-		return x.SkipConnectionCheck
+		return x.DisableQueryExamples
 	}
 	return false
 }
 
-func (x *AddMySQLdExporterParams) GetPushMetrics() bool {
+func (x *AddQANMySQLSlowlogAgentParams) GetMaxSlowlogFileSize() int64 {
 	if x != nil {
-		return x.PushMetrics
+		return x.MaxSlowlogFileSize
 	}
-	return false
+	return 0
 }
 
-func (x *AddMySQLdExporterParams) GetDisableCollectors() []string {
+func (x *AddQANMySQLSlowlogAgentParams) GetCustomLabels() map[string]string {
 	if x != nil {
-		return x.DisableCollectors
+		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *AddMySQLdExporterParams) GetAgentPassword() string {
-	if x != nil {
-		return x.AgentPassword
-	}
-	return ""
-}
-
-func (x *AddMySQLdExporterParams) GetLogLevel() LogLevel {
+func (x *AddQANMySQLSlowlogAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil {
-		return x.LogLevel
+		return x.SkipConnectionCheck
 	}
-	return LogLevel_LOG_LEVEL_UNSPECIFIED
+	return false
 }
 
-func (x *AddMySQLdExporterParams) GetExposeExporter() bool {
+func (x *AddQANMySQLSlowlogAgentParams) GetDisableCommentsParsing() bool {
 	if x != nil {
-		return x.ExposeExporter
+		return x.DisableCommentsParsing
 	}
 	return false
 }
 
-func (x *AddMySQLdExporterParams) GetExtraDsnParams() map[string]string {
+func (x *AddQANMySQLSlowlogAgentParams) GetLogLevel() LogLevel {
 	if x != nil {
-		return x.ExtraDsnParams
+		return x.LogLevel
 	}
-	return nil
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *AddMySQLdExporterParams) GetConnectionTimeout() *durationpb.Duration {
+func (x *AddQANMySQLSlowlogAgentParams) GetExtraDsnParams() map[string]string {
 	if x != nil {
-		return x.ConnectionTimeout
+		return x.ExtraDsnParams
 	}
 	return nil
 }
 
-type ChangeMySQLdExporterParams struct {
+type ChangeQANMySQLSlowlogAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
@@ -5885,9 +9059,9 @@ type ChangeMySQLdExporterParams struct {
 	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
 	// Metrics resolution for this agent.
 	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	// MySQL username for scraping metrics.
+	// MySQL username for getting slowlog data.
 	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// MySQL password for scraping metrics.
+	// MySQL password for getting slowlog data.
 	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
 	// Use TLS for database connections.
 	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
@@ -5899,39 +9073,37 @@ type ChangeMySQLdExporterParams struct {
 	TlsCert *string `protobuf:"bytes,10,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
 	// Password for decrypting tls_cert.
 	TlsKey *string `protobuf:"bytes,11,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
-	// Tablestats group collectors will be disabled if there are more than that number of tables.
-	TablestatsGroupTableLimit *int32 `protobuf:"varint,12,opt,name=tablestats_group_table_limit,json=tablestatsGroupTableLimit,proto3,oneof" json:"tablestats_group_table_limit,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength *int32 `protobuf:"varint,12,opt,name=max_query_length,json=maxQueryLength,proto3,oneof" json:"max_query_length,omitempty"`
+	// Disable query examples.
+	DisableQueryExamples *bool `protobuf:"varint,13,opt,name=disable_query_examples,json=disableQueryExamples,proto3,oneof" json:"disable_query_examples,omitempty"`
+	// Rotate slowlog file at this size if > 0.
+	MaxSlowlogFileSize *int64 `protobuf:"varint,14,opt,name=max_slowlog_file_size,json=maxSlowlogFileSize,proto3,oneof" json:"max_slowlog_file_size,omitempty"`
 	// Skip connection check.
-	SkipConnectionCheck *bool `protobuf:"varint,13,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `protobuf:"bytes,14,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword *string `protobuf:"bytes,15,opt,name=agent_password,json=agentPassword,proto3,oneof" json:"agent_password,omitempty"`
+	SkipConnectionCheck *bool `protobuf:"varint,15,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing *bool `protobuf:"varint,16,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3,oneof" json:"disable_comments_parsing,omitempty"`
 	// Log level for exporter.
-	LogLevel *LogLevel `protobuf:"varint,16,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
-	// Optionally expose the exporter process on all public interfaces.
-	ExposeExporter *bool `protobuf:"varint,17,opt,name=expose_exporter,json=exposeExporter,proto3,oneof" json:"expose_exporter,omitempty"`
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,18,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	LogLevel      *LogLevel `protobuf:"varint,17,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeMySQLdExporterParams) Reset() {
-	*x = ChangeMySQLdExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[35]
+func (x *ChangeQANMySQLSlowlogAgentParams) Reset() {
+	*x = ChangeQANMySQLSlowlogAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeMySQLdExporterParams) String() string {
+func (x *ChangeQANMySQLSlowlogAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeMySQLdExporterParams) ProtoMessage() {}
+func (*ChangeQANMySQLSlowlogAgentParams) ProtoMessage() {}
 
-func (x *ChangeMySQLdExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[35]
+func (x *ChangeQANMySQLSlowlogAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5942,146 +9114,139 @@ func (x *ChangeMySQLdExporterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeMySQLdExporterParams.ProtoReflect.Descriptor instead.
-func (*ChangeMySQLdExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use ChangeQANMySQLSlowlogAgentParams.ProtoReflect.Descriptor instead.
+func (*ChangeQANMySQLSlowlogAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ChangeMySQLdExporterParams) GetEnable() bool {
+func (x *ChangeQANMySQLSlowlogAgentParams) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
 	}
 	return false
 }
 
-func (x *ChangeMySQLdExporterParams) GetCustomLabels() *common.StringMap {
+func (x *ChangeQANMySQLSlowlogAgentParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangeMySQLdExporterParams) GetEnablePushMetrics() bool {
+func (x *ChangeQANMySQLSlowlogAgentParams) GetEnablePushMetrics() bool {
 	if x != nil && x.EnablePushMetrics != nil {
 		return *x.EnablePushMetrics
 	}
 	return false
 }
 
-func (x *ChangeMySQLdExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
+func (x *ChangeQANMySQLSlowlogAgentParams) GetMetricsResolutions() *common.MetricsResolutions {
 	if x != nil {
 		return x.MetricsResolutions
 	}
 	return nil
 }
 
-func (x *ChangeMySQLdExporterParams) GetUsername() string {
+func (x *ChangeQANMySQLSlowlogAgentParams) GetUsername() string {
 	if x != nil && x.Username != nil {
 		return *x.Username
 	}
 	return ""
 }
 
-func (x *ChangeMySQLdExporterParams) GetPassword() string {
+func (x *ChangeQANMySQLSlowlogAgentParams) GetPassword() string {
 	if x != nil && x.Password != nil {
 		return *x.Password
 	}
 	return ""
 }
 
-func (x *ChangeMySQLdExporterParams) GetTls() bool {
+func (x *ChangeQANMySQLSlowlogAgentParams) GetTls() bool {
 	if x != nil && x.Tls != nil {
 		return *x.Tls
 	}
 	return false
 }
 
-func (x *ChangeMySQLdExporterParams) GetTlsSkipVerify() bool {
+func (x *ChangeQANMySQLSlowlogAgentParams) GetTlsSkipVerify() bool {
 	if x != nil && x.TlsSkipVerify != nil {
 		return *x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *ChangeMySQLdExporterParams) GetTlsCa() string {
+func (x *ChangeQANMySQLSlowlogAgentParams) GetTlsCa() string {
 	if x != nil && x.TlsCa != nil {
 		return *x.TlsCa
 	}
 	return ""
 }
 
-func (x *ChangeMySQLdExporterParams) GetTlsCert() string {
+func (x *ChangeQANMySQLSlowlogAgentParams) GetTlsCert() string {
 	if x != nil && x.TlsCert != nil {
 		return *x.TlsCert
 	}
 	return ""
 }
 
-func (x *ChangeMySQLdExporterParams) GetTlsKey() string {
+func (x *ChangeQANMySQLSlowlogAgentParams) GetTlsKey() string {
 	if x != nil && x.TlsKey != nil {
 		return *x.TlsKey
 	}
 	return ""
 }
 
-func (x *ChangeMySQLdExporterParams) GetTablestatsGroupTableLimit() int32 {
-	if x != nil && x.TablestatsGroupTableLimit != nil {
-		return *x.TablestatsGroupTableLimit
+func (x *ChangeQANMySQLSlowlogAgentParams) GetMaxQueryLength() int32 {
+	if x != nil && x.MaxQueryLength != nil {
+		return *x.MaxQueryLength
 	}
 	return 0
 }
 
-func (x *ChangeMySQLdExporterParams) GetSkipConnectionCheck() bool {
-	if x != nil && x.SkipConnectionCheck != nil {
-		return *x.SkipConnectionCheck
+func (x *ChangeQANMySQLSlowlogAgentParams) GetDisableQueryExamples() bool {
+	if x != nil && x.DisableQueryExamples != nil {
+		return *x.DisableQueryExamples
 	}
 	return false
 }
 
-func (x *ChangeMySQLdExporterParams) GetDisableCollectors() []string {
-	if x != nil {
-		return x.DisableCollectors
-	}
-	return nil
-}
-
-func (x *ChangeMySQLdExporterParams) GetAgentPassword() string {
-	if x != nil && x.AgentPassword != nil {
-		return *x.AgentPassword
+func (x *ChangeQANMySQLSlowlogAgentParams) GetMaxSlowlogFileSize() int64 {
+	if x != nil && x.MaxSlowlogFileSize != nil {
+		return *x.MaxSlowlogFileSize
 	}
-	return ""
+	return 0
 }
 
-func (x *ChangeMySQLdExporterParams) GetLogLevel() LogLevel {
-	if x != nil && x.LogLevel != nil {
-		return *x.LogLevel
+func (x *ChangeQANMySQLSlowlogAgentParams) GetSkipConnectionCheck() bool {
+	if x != nil && x.SkipConnectionCheck != nil {
+		return *x.SkipConnectionCheck
 	}
-	return LogLevel_LOG_LEVEL_UNSPECIFIED
+	return false
 }
 
-func (x *ChangeMySQLdExporterParams) GetExposeExporter() bool {
-	if x != nil && x.ExposeExporter != nil {
-		return *x.ExposeExporter
+func (x *ChangeQANMySQLSlowlogAgentParams) GetDisableCommentsParsing() bool {
+	if x != nil && x.DisableCommentsParsing != nil {
+		return *x.DisableCommentsParsing
 	}
 	return false
 }
 
-func (x *ChangeMySQLdExporterParams) GetConnectionTimeout() *durationpb.Duration {
-	if x != nil {
-		return x.ConnectionTimeout
+func (x *ChangeQANMySQLSlowlogAgentParams) GetLogLevel() LogLevel {
+	if x != nil && x.LogLevel != nil {
+		return *x.LogLevel
 	}
-	return nil
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-type AddMongoDBExporterParams struct {
+type AddQANMongoDBProfilerAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The pmm-agent identifier which runs this instance.
 	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
 	// Service identifier.
 	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// MongoDB username for scraping metrics.
+	// MongoDB username for getting profile data.
 	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	// MongoDB password for scraping metrics.
+	// MongoDB password for getting profile data.
 	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 	// Use TLS for database connections.
 	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
@@ -6093,59 +9258,39 @@ type AddMongoDBExporterParams struct {
 	TlsCertificateKeyFilePassword string `protobuf:"bytes,8,opt,name=tls_certificate_key_file_password,json=tlsCertificateKeyFilePassword,proto3" json:"tls_certificate_key_file_password,omitempty"`
 	// Certificate Authority certificate chain.
 	TlsCa string `protobuf:"bytes,9,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `protobuf:"varint,10,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,10,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CustomLabels map[string]string `protobuf:"bytes,11,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Skip connection check.
-	SkipConnectionCheck bool `protobuf:"varint,11,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
-	// Enables push metrics mode for exporter.
-	PushMetrics bool `protobuf:"varint,12,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `protobuf:"bytes,13,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
+	SkipConnectionCheck bool `protobuf:"varint,12,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
 	// Authentication mechanism.
 	// See https://docs.mongodb.com/manual/reference/connection-string/#mongodb-urioption-urioption.authMechanism
 	// for details.
-	AuthenticationMechanism string `protobuf:"bytes,14,opt,name=authentication_mechanism,json=authenticationMechanism,proto3" json:"authentication_mechanism,omitempty"`
+	AuthenticationMechanism string `protobuf:"bytes,13,opt,name=authentication_mechanism,json=authenticationMechanism,proto3" json:"authentication_mechanism,omitempty"`
 	// Authentication database.
-	AuthenticationDatabase string `protobuf:"bytes,15,opt,name=authentication_database,json=authenticationDatabase,proto3" json:"authentication_database,omitempty"`
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword string `protobuf:"bytes,16,opt,name=agent_password,json=agentPassword,proto3" json:"agent_password,omitempty"`
-	// List of colletions to get stats from. Can use *
-	StatsCollections []string `protobuf:"bytes,17,rep,name=stats_collections,json=statsCollections,proto3" json:"stats_collections,omitempty"`
-	// Collections limit. Only get Databases and collection stats if the total number of collections in the server
-	// is less than this value. 0: no limit
-	CollectionsLimit int32 `protobuf:"varint,18,opt,name=collections_limit,json=collectionsLimit,proto3" json:"collections_limit,omitempty"`
-	// Log level for exporter.
-	LogLevel LogLevel `protobuf:"varint,19,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
-	// Optionally expose the exporter process on all public interfaces
-	ExposeExporter bool `protobuf:"varint,20,opt,name=expose_exporter,json=exposeExporter,proto3" json:"expose_exporter,omitempty"`
-	// Environment variable names to pass to the exporter.
-	// Values will be resolved from pmm-agent's environment when starting the exporter.
-	EnvironmentVariableNames []string `protobuf:"bytes,21,rep,name=environment_variable_names,json=environmentVariableNames,proto3" json:"environment_variable_names,omitempty"`
-	// Enable all collectors.
-	EnableAllCollectors bool `protobuf:"varint,22,opt,name=enable_all_collectors,json=enableAllCollectors,proto3" json:"enable_all_collectors,omitempty"`
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,23,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
-	// Enable collecting histogram bucket metrics from getDiagnosticData.
-	EnableDiagnosticDataHistograms bool `protobuf:"varint,24,opt,name=enable_diagnostic_data_histograms,json=enableDiagnosticDataHistograms,proto3" json:"enable_diagnostic_data_histograms,omitempty"`
-	unknownFields                  protoimpl.UnknownFields
-	sizeCache                      protoimpl.SizeCache
+	AuthenticationDatabase string `protobuf:"bytes,14,opt,name=authentication_database,json=authenticationDatabase,proto3" json:"authentication_database,omitempty"`
+	// Log level for agent.
+	LogLevel      LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddMongoDBExporterParams) Reset() {
-	*x = AddMongoDBExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[36]
+func (x *AddQANMongoDBProfilerAgentParams) Reset() {
+	*x = AddQANMongoDBProfilerAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddMongoDBExporterParams) String() string {
+func (x *AddQANMongoDBProfilerAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddMongoDBExporterParams) ProtoMessage() {}
+func (*AddQANMongoDBProfilerAgentParams) ProtoMessage() {}
 
-func (x *AddMongoDBExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[36]
+func (x *AddQANMongoDBProfilerAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6156,180 +9301,117 @@ func (x *AddMongoDBExporterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddMongoDBExporterParams.ProtoReflect.Descriptor instead.
-func (*AddMongoDBExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use AddQANMongoDBProfilerAgentParams.ProtoReflect.Descriptor instead.
+func (*AddQANMongoDBProfilerAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *AddMongoDBExporterParams) GetPmmAgentId() string {
+func (x *AddQANMongoDBProfilerAgentParams) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *AddMongoDBExporterParams) GetServiceId() string {
+func (x *AddQANMongoDBProfilerAgentParams) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *AddMongoDBExporterParams) GetUsername() string {
+func (x *AddQANMongoDBProfilerAgentParams) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *AddMongoDBExporterParams) GetPassword() string {
+func (x *AddQANMongoDBProfilerAgentParams) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-func (x *AddMongoDBExporterParams) GetTls() bool {
+func (x *AddQANMongoDBProfilerAgentParams) GetTls() bool {
 	if x != nil {
 		return x.Tls
 	}
 	return false
 }
 
-func (x *AddMongoDBExporterParams) GetTlsSkipVerify() bool {
+func (x *AddQANMongoDBProfilerAgentParams) GetTlsSkipVerify() bool {
 	if x != nil {
 		return x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *AddMongoDBExporterParams) GetTlsCertificateKey() string {
+func (x *AddQANMongoDBProfilerAgentParams) GetTlsCertificateKey() string {
 	if x != nil {
 		return x.TlsCertificateKey
 	}
 	return ""
 }
 
-func (x *AddMongoDBExporterParams) GetTlsCertificateKeyFilePassword() string {
+func (x *AddQANMongoDBProfilerAgentParams) GetTlsCertificateKeyFilePassword() string {
 	if x != nil {
 		return x.TlsCertificateKeyFilePassword
 	}
 	return ""
 }
 
-func (x *AddMongoDBExporterParams) GetTlsCa() string {
+func (x *AddQANMongoDBProfilerAgentParams) GetTlsCa() string {
 	if x != nil {
 		return x.TlsCa
 	}
 	return ""
 }
 
-func (x *AddMongoDBExporterParams) GetCustomLabels() map[string]string {
+func (x *AddQANMongoDBProfilerAgentParams) GetMaxQueryLength() int32 {
 	if x != nil {
-		return x.CustomLabels
+		return x.MaxQueryLength
 	}
-	return nil
+	return 0
 }
 
-func (x *AddMongoDBExporterParams) GetSkipConnectionCheck() bool {
+func (x *AddQANMongoDBProfilerAgentParams) GetCustomLabels() map[string]string {
 	if x != nil {
-		return x.SkipConnectionCheck
+		return x.CustomLabels
 	}
-	return false
+	return nil
 }
 
-func (x *AddMongoDBExporterParams) GetPushMetrics() bool {
+func (x *AddQANMongoDBProfilerAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil {
-		return x.PushMetrics
+		return x.SkipConnectionCheck
 	}
 	return false
 }
 
-func (x *AddMongoDBExporterParams) GetDisableCollectors() []string {
-	if x != nil {
-		return x.DisableCollectors
-	}
-	return nil
-}
-
-func (x *AddMongoDBExporterParams) GetAuthenticationMechanism() string {
+func (x *AddQANMongoDBProfilerAgentParams) GetAuthenticationMechanism() string {
 	if x != nil {
 		return x.AuthenticationMechanism
 	}
 	return ""
 }
 
-func (x *AddMongoDBExporterParams) GetAuthenticationDatabase() string {
+func (x *AddQANMongoDBProfilerAgentParams) GetAuthenticationDatabase() string {
 	if x != nil {
 		return x.AuthenticationDatabase
 	}
 	return ""
 }
 
-func (x *AddMongoDBExporterParams) GetAgentPassword() string {
-	if x != nil {
-		return x.AgentPassword
-	}
-	return ""
-}
-
-func (x *AddMongoDBExporterParams) GetStatsCollections() []string {
-	if x != nil {
-		return x.StatsCollections
-	}
-	return nil
-}
-
-func (x *AddMongoDBExporterParams) GetCollectionsLimit() int32 {
-	if x != nil {
-		return x.CollectionsLimit
-	}
-	return 0
-}
-
-func (x *AddMongoDBExporterParams) GetLogLevel() LogLevel {
+func (x *AddQANMongoDBProfilerAgentParams) GetLogLevel() LogLevel {
 	if x != nil {
 		return x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *AddMongoDBExporterParams) GetExposeExporter() bool {
-	if x != nil {
-		return x.ExposeExporter
-	}
-	return false
-}
-
-func (x *AddMongoDBExporterParams) GetEnvironmentVariableNames() []string {
-	if x != nil {
-		return x.EnvironmentVariableNames
-	}
-	return nil
-}
-
-func (x *AddMongoDBExporterParams) GetEnableAllCollectors() bool {
-	if x != nil {
-		return x.EnableAllCollectors
-	}
-	return false
-}
-
-func (x *AddMongoDBExporterParams) GetConnectionTimeout() *durationpb.Duration {
-	if x != nil {
-		return x.ConnectionTimeout
-	}
-	return nil
-}
-
-func (x *AddMongoDBExporterParams) GetEnableDiagnosticDataHistograms() bool {
-	if x != nil {
-		return x.EnableDiagnosticDataHistograms
-	}
-	return false
-}
-
-type ChangeMongoDBExporterParams struct {
+type ChangeQANMongoDBProfilerAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
@@ -6339,9 +9421,9 @@ type ChangeMongoDBExporterParams struct {
 	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
 	// Metrics resolution for this agent.
 	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	// MongoDB username for scraping metrics.
+	// MongoDB username for getting profile data.
 	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// MongoDB password for scraping metrics.
+	// MongoDB password for getting profile data.
 	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
 	// Use TLS for database connections.
 	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
@@ -6353,49 +9435,35 @@ type ChangeMongoDBExporterParams struct {
 	TlsCertificateKeyFilePassword *string `protobuf:"bytes,10,opt,name=tls_certificate_key_file_password,json=tlsCertificateKeyFilePassword,proto3,oneof" json:"tls_certificate_key_file_password,omitempty"`
 	// Certificate Authority certificate chain.
 	TlsCa *string `protobuf:"bytes,11,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
-	// Skip connection check.
-	SkipConnectionCheck *bool `protobuf:"varint,12,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `protobuf:"bytes,13,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength *int32 `protobuf:"varint,12,opt,name=max_query_length,json=maxQueryLength,proto3,oneof" json:"max_query_length,omitempty"`
 	// Authentication mechanism.
-	AuthenticationMechanism *string `protobuf:"bytes,14,opt,name=authentication_mechanism,json=authenticationMechanism,proto3,oneof" json:"authentication_mechanism,omitempty"`
+	AuthenticationMechanism *string `protobuf:"bytes,13,opt,name=authentication_mechanism,json=authenticationMechanism,proto3,oneof" json:"authentication_mechanism,omitempty"`
 	// Authentication database.
-	AuthenticationDatabase *string `protobuf:"bytes,15,opt,name=authentication_database,json=authenticationDatabase,proto3,oneof" json:"authentication_database,omitempty"`
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword *string `protobuf:"bytes,16,opt,name=agent_password,json=agentPassword,proto3,oneof" json:"agent_password,omitempty"`
-	// List of collections to get stats from. Can use *
-	StatsCollections []string `protobuf:"bytes,17,rep,name=stats_collections,json=statsCollections,proto3" json:"stats_collections,omitempty"`
-	// Collections limit. Only get Databases and collection stats if the total number of collections in the server is less than this value. 0: no limit
-	CollectionsLimit *int32 `protobuf:"varint,18,opt,name=collections_limit,json=collectionsLimit,proto3,oneof" json:"collections_limit,omitempty"`
-	// Enable all collectors.
-	EnableAllCollectors *bool `protobuf:"varint,19,opt,name=enable_all_collectors,json=enableAllCollectors,proto3,oneof" json:"enable_all_collectors,omitempty"`
+	AuthenticationDatabase *string `protobuf:"bytes,14,opt,name=authentication_database,json=authenticationDatabase,proto3,oneof" json:"authentication_database,omitempty"`
 	// Log level for exporter.
-	LogLevel *LogLevel `protobuf:"varint,20,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
-	// Optionally expose the exporter process on all public interfaces.
-	ExposeExporter *bool `protobuf:"varint,21,opt,name=expose_exporter,json=exposeExporter,proto3,oneof" json:"expose_exporter,omitempty"`
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,22,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
-	// Enable collecting histogram bucket metrics from getDiagnosticData.
-	EnableDiagnosticDataHistograms *bool `protobuf:"varint,23,opt,name=enable_diagnostic_data_histograms,json=enableDiagnosticDataHistograms,proto3,oneof" json:"enable_diagnostic_data_histograms,omitempty"`
-	unknownFields                  protoimpl.UnknownFields
-	sizeCache                      protoimpl.SizeCache
+	LogLevel *LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
+	// Skip connection check.
+	SkipConnectionCheck *bool `protobuf:"varint,16,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ChangeMongoDBExporterParams) Reset() {
-	*x = ChangeMongoDBExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[37]
+func (x *ChangeQANMongoDBProfilerAgentParams) Reset() {
+	*x = ChangeQANMongoDBProfilerAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeMongoDBExporterParams) String() string {
+func (x *ChangeQANMongoDBProfilerAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeMongoDBExporterParams) ProtoMessage() {}
+func (*ChangeQANMongoDBProfilerAgentParams) ProtoMessage() {}
 
-func (x *ChangeMongoDBExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[37]
+func (x *ChangeQANMongoDBProfilerAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6406,231 +9474,176 @@ func (x *ChangeMongoDBExporterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeMongoDBExporterParams.ProtoReflect.Descriptor instead.
-func (*ChangeMongoDBExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use ChangeQANMongoDBProfilerAgentParams.ProtoReflect.Descriptor instead.
+func (*ChangeQANMongoDBProfilerAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *ChangeMongoDBExporterParams) GetEnable() bool {
+func (x *ChangeQANMongoDBProfilerAgentParams) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
 	}
 	return false
 }
 
-func (x *ChangeMongoDBExporterParams) GetCustomLabels() *common.StringMap {
+func (x *ChangeQANMongoDBProfilerAgentParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangeMongoDBExporterParams) GetEnablePushMetrics() bool {
+func (x *ChangeQANMongoDBProfilerAgentParams) GetEnablePushMetrics() bool {
 	if x != nil && x.EnablePushMetrics != nil {
 		return *x.EnablePushMetrics
 	}
 	return false
 }
 
-func (x *ChangeMongoDBExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
+func (x *ChangeQANMongoDBProfilerAgentParams) GetMetricsResolutions() *common.MetricsResolutions {
 	if x != nil {
 		return x.MetricsResolutions
 	}
 	return nil
 }
 
-func (x *ChangeMongoDBExporterParams) GetUsername() string {
+func (x *ChangeQANMongoDBProfilerAgentParams) GetUsername() string {
 	if x != nil && x.Username != nil {
 		return *x.Username
 	}
 	return ""
 }
 
-func (x *ChangeMongoDBExporterParams) GetPassword() string {
+func (x *ChangeQANMongoDBProfilerAgentParams) GetPassword() string {
 	if x != nil && x.Password != nil {
 		return *x.Password
 	}
 	return ""
 }
 
-func (x *ChangeMongoDBExporterParams) GetTls() bool {
+func (x *ChangeQANMongoDBProfilerAgentParams) GetTls() bool {
 	if x != nil && x.Tls != nil {
 		return *x.Tls
 	}
 	return false
 }
 
-func (x *ChangeMongoDBExporterParams) GetTlsSkipVerify() bool {
+func (x *ChangeQANMongoDBProfilerAgentParams) GetTlsSkipVerify() bool {
 	if x != nil && x.TlsSkipVerify != nil {
 		return *x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *ChangeMongoDBExporterParams) GetTlsCertificateKey() string {
+func (x *ChangeQANMongoDBProfilerAgentParams) GetTlsCertificateKey() string {
 	if x != nil && x.TlsCertificateKey != nil {
 		return *x.TlsCertificateKey
 	}
 	return ""
 }
 
-func (x *ChangeMongoDBExporterParams) GetTlsCertificateKeyFilePassword() string {
+func (x *ChangeQANMongoDBProfilerAgentParams) GetTlsCertificateKeyFilePassword() string {
 	if x != nil && x.TlsCertificateKeyFilePassword != nil {
 		return *x.TlsCertificateKeyFilePassword
 	}
 	return ""
 }
 
-func (x *ChangeMongoDBExporterParams) GetTlsCa() string {
+func (x *ChangeQANMongoDBProfilerAgentParams) GetTlsCa() string {
 	if x != nil && x.TlsCa != nil {
 		return *x.TlsCa
 	}
 	return ""
 }
 
-func (x *ChangeMongoDBExporterParams) GetSkipConnectionCheck() bool {
-	if x != nil && x.SkipConnectionCheck != nil {
-		return *x.SkipConnectionCheck
-	}
-	return false
-}
-
-func (x *ChangeMongoDBExporterParams) GetDisableCollectors() []string {
-	if x != nil {
-		return x.DisableCollectors
+func (x *ChangeQANMongoDBProfilerAgentParams) GetMaxQueryLength() int32 {
+	if x != nil && x.MaxQueryLength != nil {
+		return *x.MaxQueryLength
 	}
-	return nil
+	return 0
 }
 
-func (x *ChangeMongoDBExporterParams) GetAuthenticationMechanism() string {
+func (x *ChangeQANMongoDBProfilerAgentParams) GetAuthenticationMechanism() string {
 	if x != nil && x.AuthenticationMechanism != nil {
 		return *x.AuthenticationMechanism
 	}
 	return ""
 }
 
-func (x *ChangeMongoDBExporterParams) GetAuthenticationDatabase() string {
-	if x != nil && x.AuthenticationDatabase != nil {
-		return *x.AuthenticationDatabase
-	}
-	return ""
-}
-
-func (x *ChangeMongoDBExporterParams) GetAgentPassword() string {
-	if x != nil && x.AgentPassword != nil {
-		return *x.AgentPassword
-	}
-	return ""
-}
-
-func (x *ChangeMongoDBExporterParams) GetStatsCollections() []string {
-	if x != nil {
-		return x.StatsCollections
-	}
-	return nil
-}
-
-func (x *ChangeMongoDBExporterParams) GetCollectionsLimit() int32 {
-	if x != nil && x.CollectionsLimit != nil {
-		return *x.CollectionsLimit
-	}
-	return 0
-}
-
-func (x *ChangeMongoDBExporterParams) GetEnableAllCollectors() bool {
-	if x != nil && x.EnableAllCollectors != nil {
-		return *x.EnableAllCollectors
-	}
-	return false
-}
-
-func (x *ChangeMongoDBExporterParams) GetLogLevel() LogLevel {
-	if x != nil && x.LogLevel != nil {
-		return *x.LogLevel
-	}
-	return LogLevel_LOG_LEVEL_UNSPECIFIED
-}
-
-func (x *ChangeMongoDBExporterParams) GetExposeExporter() bool {
-	if x != nil && x.ExposeExporter != nil {
-		return *x.ExposeExporter
+func (x *ChangeQANMongoDBProfilerAgentParams) GetAuthenticationDatabase() string {
+	if x != nil && x.AuthenticationDatabase != nil {
+		return *x.AuthenticationDatabase
 	}
-	return false
+	return ""
 }
 
-func (x *ChangeMongoDBExporterParams) GetConnectionTimeout() *durationpb.Duration {
-	if x != nil {
-		return x.ConnectionTimeout
+func (x *ChangeQANMongoDBProfilerAgentParams) GetLogLevel() LogLevel {
+	if x != nil && x.LogLevel != nil {
+		return *x.LogLevel
 	}
-	return nil
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *ChangeMongoDBExporterParams) GetEnableDiagnosticDataHistograms() bool {
-	if x != nil && x.EnableDiagnosticDataHistograms != nil {
-		return *x.EnableDiagnosticDataHistograms
+func (x *ChangeQANMongoDBProfilerAgentParams) GetSkipConnectionCheck() bool {
+	if x != nil && x.SkipConnectionCheck != nil {
+		return *x.SkipConnectionCheck
 	}
 	return false
 }
 
-type AddPostgresExporterParams struct {
+type AddQANMongoDBMongologAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The pmm-agent identifier which runs this instance.
 	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
 	// Service identifier.
 	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// PostgreSQL username for scraping metrics.
+	// MongoDB username for getting profile data.
 	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	// PostgreSQL password for scraping metrics.
+	// MongoDB password for getting profile data.
 	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 	// Use TLS for database connections.
 	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
-	// Skip TLS certificate and hostname validation. Uses sslmode=required instead of verify-full.
+	// Skip TLS certificate and hostname validation.
 	TlsSkipVerify bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Client certificate and key.
+	TlsCertificateKey string `protobuf:"bytes,7,opt,name=tls_certificate_key,json=tlsCertificateKey,proto3" json:"tls_certificate_key,omitempty"`
+	// Password for decrypting tls_certificate_key.
+	TlsCertificateKeyFilePassword string `protobuf:"bytes,8,opt,name=tls_certificate_key_file_password,json=tlsCertificateKeyFilePassword,proto3" json:"tls_certificate_key_file_password,omitempty"`
+	// Certificate Authority certificate chain.
+	TlsCa string `protobuf:"bytes,9,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `protobuf:"varint,10,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,7,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CustomLabels map[string]string `protobuf:"bytes,11,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Skip connection check.
-	SkipConnectionCheck bool `protobuf:"varint,8,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
-	// Enables push metrics mode for exporter.
-	PushMetrics bool `protobuf:"varint,9,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `protobuf:"bytes,10,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
-	// TLS CA certificate.
-	TlsCa string `protobuf:"bytes,11,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
-	// TLS Certifcate.
-	TlsCert string `protobuf:"bytes,12,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
-	// TLS Certificate Key.
-	TlsKey string `protobuf:"bytes,13,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword string `protobuf:"bytes,14,opt,name=agent_password,json=agentPassword,proto3" json:"agent_password,omitempty"`
-	// Log level for exporter.
-	LogLevel LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
-	// Limit of databases for auto-discovery.
-	AutoDiscoveryLimit int32 `protobuf:"varint,16,opt,name=auto_discovery_limit,json=autoDiscoveryLimit,proto3" json:"auto_discovery_limit,omitempty"`
-	// Optionally expose the exporter process on all public interfaces
-	ExposeExporter bool `protobuf:"varint,17,opt,name=expose_exporter,json=exposeExporter,proto3" json:"expose_exporter,omitempty"`
-	// Maximum number of connections that exporter can open to the database instance.
-	MaxExporterConnections int32 `protobuf:"varint,18,opt,name=max_exporter_connections,json=maxExporterConnections,proto3" json:"max_exporter_connections,omitempty"`
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,19,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	SkipConnectionCheck bool `protobuf:"varint,12,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
+	// Authentication mechanism.
+	// See https://docs.mongodb.com/manual/reference/connection-string/#mongodb-urioption-urioption.authMechanism
+	// for details.
+	AuthenticationMechanism string `protobuf:"bytes,13,opt,name=authentication_mechanism,json=authenticationMechanism,proto3" json:"authentication_mechanism,omitempty"`
+	// Authentication database.
+	AuthenticationDatabase string `protobuf:"bytes,14,opt,name=authentication_database,json=authenticationDatabase,proto3" json:"authentication_database,omitempty"`
+	// Log level for agent.
+	LogLevel      LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddPostgresExporterParams) Reset() {
-	*x = AddPostgresExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[38]
+func (x *AddQANMongoDBMongologAgentParams) Reset() {
+	*x = AddQANMongoDBMongologAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddPostgresExporterParams) String() string {
+func (x *AddQANMongoDBMongologAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddPostgresExporterParams) ProtoMessage() {}
+func (*AddQANMongoDBMongologAgentParams) ProtoMessage() {}
 
-func (x *AddPostgresExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[38]
+func (x *AddQANMongoDBMongologAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6641,145 +9654,117 @@ func (x *AddPostgresExporterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddPostgresExporterParams.ProtoReflect.Descriptor instead.
-func (*AddPostgresExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use AddQANMongoDBMongologAgentParams.ProtoReflect.Descriptor instead.
+func (*AddQANMongoDBMongologAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *AddPostgresExporterParams) GetPmmAgentId() string {
+func (x *AddQANMongoDBMongologAgentParams) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *AddPostgresExporterParams) GetServiceId() string {
+func (x *AddQANMongoDBMongologAgentParams) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *AddPostgresExporterParams) GetUsername() string {
+func (x *AddQANMongoDBMongologAgentParams) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *AddPostgresExporterParams) GetPassword() string {
+func (x *AddQANMongoDBMongologAgentParams) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-func (x *AddPostgresExporterParams) GetTls() bool {
+func (x *AddQANMongoDBMongologAgentParams) GetTls() bool {
 	if x != nil {
 		return x.Tls
 	}
 	return false
 }
 
-func (x *AddPostgresExporterParams) GetTlsSkipVerify() bool {
+func (x *AddQANMongoDBMongologAgentParams) GetTlsSkipVerify() bool {
 	if x != nil {
 		return x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *AddPostgresExporterParams) GetCustomLabels() map[string]string {
+func (x *AddQANMongoDBMongologAgentParams) GetTlsCertificateKey() string {
 	if x != nil {
-		return x.CustomLabels
+		return x.TlsCertificateKey
 	}
-	return nil
+	return ""
 }
 
-func (x *AddPostgresExporterParams) GetSkipConnectionCheck() bool {
+func (x *AddQANMongoDBMongologAgentParams) GetTlsCertificateKeyFilePassword() string {
 	if x != nil {
-		return x.SkipConnectionCheck
+		return x.TlsCertificateKeyFilePassword
 	}
-	return false
+	return ""
 }
 
-func (x *AddPostgresExporterParams) GetPushMetrics() bool {
+func (x *AddQANMongoDBMongologAgentParams) GetTlsCa() string {
 	if x != nil {
-		return x.PushMetrics
+		return x.TlsCa
 	}
-	return false
+	return ""
 }
 
-func (x *AddPostgresExporterParams) GetDisableCollectors() []string {
+func (x *AddQANMongoDBMongologAgentParams) GetMaxQueryLength() int32 {
 	if x != nil {
-		return x.DisableCollectors
+		return x.MaxQueryLength
 	}
-	return nil
+	return 0
 }
 
-func (x *AddPostgresExporterParams) GetTlsCa() string {
+func (x *AddQANMongoDBMongologAgentParams) GetCustomLabels() map[string]string {
 	if x != nil {
-		return x.TlsCa
+		return x.CustomLabels
 	}
-	return ""
+	return nil
 }
 
-func (x *AddPostgresExporterParams) GetTlsCert() string {
+func (x *AddQANMongoDBMongologAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil {
-		return x.TlsCert
+		return x.SkipConnectionCheck
 	}
-	return ""
+	return false
 }
 
-func (x *AddPostgresExporterParams) GetTlsKey() string {
+func (x *AddQANMongoDBMongologAgentParams) GetAuthenticationMechanism() string {
 	if x != nil {
-		return x.TlsKey
+		return x.AuthenticationMechanism
 	}
 	return ""
 }
 
-func (x *AddPostgresExporterParams) GetAgentPassword() string {
+func (x *AddQANMongoDBMongologAgentParams) GetAuthenticationDatabase() string {
 	if x != nil {
-		return x.AgentPassword
+		return x.AuthenticationDatabase
 	}
 	return ""
 }
 
-func (x *AddPostgresExporterParams) GetLogLevel() LogLevel {
+func (x *AddQANMongoDBMongologAgentParams) GetLogLevel() LogLevel {
 	if x != nil {
 		return x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *AddPostgresExporterParams) GetAutoDiscoveryLimit() int32 {
-	if x != nil {
-		return x.AutoDiscoveryLimit
-	}
-	return 0
-}
-
-func (x *AddPostgresExporterParams) GetExposeExporter() bool {
-	if x != nil {
-		return x.ExposeExporter
-	}
-	return false
-}
-
-func (x *AddPostgresExporterParams) GetMaxExporterConnections() int32 {
-	if x != nil {
-		return x.MaxExporterConnections
-	}
-	return 0
-}
-
-func (x *AddPostgresExporterParams) GetConnectionTimeout() *durationpb.Duration {
-	if x != nil {
-		return x.ConnectionTimeout
-	}
-	return nil
-}
-
-type ChangePostgresExporterParams struct {
+type ChangeQANMongoDBMongologAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
@@ -6789,55 +9774,49 @@ type ChangePostgresExporterParams struct {
 	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
 	// Metrics resolution for this agent.
 	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	// PostgreSQL username for scraping metrics.
+	// MongoDB username for getting mongolog data.
 	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// PostgreSQL password for scraping metrics.
+	// MongoDB password for getting mongolog data.
 	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
 	// Use TLS for database connections.
 	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
 	TlsSkipVerify *bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
-	// Skip connection check.
-	SkipConnectionCheck *bool `protobuf:"varint,9,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `protobuf:"bytes,10,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
-	// TLS CA certificate.
+	// Client certificate and key.
+	TlsCertificateKey *string `protobuf:"bytes,9,opt,name=tls_certificate_key,json=tlsCertificateKey,proto3,oneof" json:"tls_certificate_key,omitempty"`
+	// Password for decrypting tls_certificate_key.
+	TlsCertificateKeyFilePassword *string `protobuf:"bytes,10,opt,name=tls_certificate_key_file_password,json=tlsCertificateKeyFilePassword,proto3,oneof" json:"tls_certificate_key_file_password,omitempty"`
+	// Certificate Authority certificate chain.
 	TlsCa *string `protobuf:"bytes,11,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
-	// TLS Certificate.
-	TlsCert *string `protobuf:"bytes,12,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
-	// TLS Certificate Key.
-	TlsKey *string `protobuf:"bytes,13,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword *string `protobuf:"bytes,14,opt,name=agent_password,json=agentPassword,proto3,oneof" json:"agent_password,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength *int32 `protobuf:"varint,12,opt,name=max_query_length,json=maxQueryLength,proto3,oneof" json:"max_query_length,omitempty"`
+	// Authentication mechanism.
+	AuthenticationMechanism *string `protobuf:"bytes,13,opt,name=authentication_mechanism,json=authenticationMechanism,proto3,oneof" json:"authentication_mechanism,omitempty"`
+	// Authentication database.
+	AuthenticationDatabase *string `protobuf:"bytes,14,opt,name=authentication_database,json=authenticationDatabase,proto3,oneof" json:"authentication_database,omitempty"`
 	// Log level for exporter.
 	LogLevel *LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
-	// Limit of databases for auto-discovery.
-	AutoDiscoveryLimit *int32 `protobuf:"varint,16,opt,name=auto_discovery_limit,json=autoDiscoveryLimit,proto3,oneof" json:"auto_discovery_limit,omitempty"`
-	// Optionally expose the exporter process on all public interfaces.
-	ExposeExporter *bool `protobuf:"varint,17,opt,name=expose_exporter,json=exposeExporter,proto3,oneof" json:"expose_exporter,omitempty"`
-	// Maximum number of connections that exporter can open to the database instance.
-	MaxExporterConnections *int32 `protobuf:"varint,18,opt,name=max_exporter_connections,json=maxExporterConnections,proto3,oneof" json:"max_exporter_connections,omitempty"`
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,19,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// Skip connection check.
+	SkipConnectionCheck *bool `protobuf:"varint,16,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ChangePostgresExporterParams) Reset() {
-	*x = ChangePostgresExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[39]
+func (x *ChangeQANMongoDBMongologAgentParams) Reset() {
+	*x = ChangeQANMongoDBMongologAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePostgresExporterParams) String() string {
+func (x *ChangeQANMongoDBMongologAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePostgresExporterParams) ProtoMessage() {}
+func (*ChangeQANMongoDBMongologAgentParams) ProtoMessage() {}
 
-func (x *ChangePostgresExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[39]
+func (x *ChangeQANMongoDBMongologAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6848,193 +9827,172 @@ func (x *ChangePostgresExporterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePostgresExporterParams.ProtoReflect.Descriptor instead.
-func (*ChangePostgresExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use ChangeQANMongoDBMongologAgentParams.ProtoReflect.Descriptor instead.
+func (*ChangeQANMongoDBMongologAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ChangePostgresExporterParams) GetEnable() bool {
+func (x *ChangeQANMongoDBMongologAgentParams) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
 	}
 	return false
 }
 
-func (x *ChangePostgresExporterParams) GetCustomLabels() *common.StringMap {
+func (x *ChangeQANMongoDBMongologAgentParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangePostgresExporterParams) GetEnablePushMetrics() bool {
+func (x *ChangeQANMongoDBMongologAgentParams) GetEnablePushMetrics() bool {
 	if x != nil && x.EnablePushMetrics != nil {
 		return *x.EnablePushMetrics
 	}
 	return false
 }
 
-func (x *ChangePostgresExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
+func (x *ChangeQANMongoDBMongologAgentParams) GetMetricsResolutions() *common.MetricsResolutions {
 	if x != nil {
 		return x.MetricsResolutions
 	}
 	return nil
 }
 
-func (x *ChangePostgresExporterParams) GetUsername() string {
+func (x *ChangeQANMongoDBMongologAgentParams) GetUsername() string {
 	if x != nil && x.Username != nil {
 		return *x.Username
 	}
 	return ""
 }
 
-func (x *ChangePostgresExporterParams) GetPassword() string {
+func (x *ChangeQANMongoDBMongologAgentParams) GetPassword() string {
 	if x != nil && x.Password != nil {
 		return *x.Password
 	}
 	return ""
 }
 
-func (x *ChangePostgresExporterParams) GetTls() bool {
+func (x *ChangeQANMongoDBMongologAgentParams) GetTls() bool {
 	if x != nil && x.Tls != nil {
 		return *x.Tls
 	}
 	return false
 }
 
-func (x *ChangePostgresExporterParams) GetTlsSkipVerify() bool {
+func (x *ChangeQANMongoDBMongologAgentParams) GetTlsSkipVerify() bool {
 	if x != nil && x.TlsSkipVerify != nil {
 		return *x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *ChangePostgresExporterParams) GetSkipConnectionCheck() bool {
-	if x != nil && x.SkipConnectionCheck != nil {
-		return *x.SkipConnectionCheck
+func (x *ChangeQANMongoDBMongologAgentParams) GetTlsCertificateKey() string {
+	if x != nil && x.TlsCertificateKey != nil {
+		return *x.TlsCertificateKey
 	}
-	return false
+	return ""
 }
 
-func (x *ChangePostgresExporterParams) GetDisableCollectors() []string {
-	if x != nil {
-		return x.DisableCollectors
+func (x *ChangeQANMongoDBMongologAgentParams) GetTlsCertificateKeyFilePassword() string {
+	if x != nil && x.TlsCertificateKeyFilePassword != nil {
+		return *x.TlsCertificateKeyFilePassword
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangePostgresExporterParams) GetTlsCa() string {
+func (x *ChangeQANMongoDBMongologAgentParams) GetTlsCa() string {
 	if x != nil && x.TlsCa != nil {
 		return *x.TlsCa
 	}
 	return ""
 }
 
-func (x *ChangePostgresExporterParams) GetTlsCert() string {
-	if x != nil && x.TlsCert != nil {
-		return *x.TlsCert
+func (x *ChangeQANMongoDBMongologAgentParams) GetMaxQueryLength() int32 {
+	if x != nil && x.MaxQueryLength != nil {
+		return *x.MaxQueryLength
 	}
-	return ""
+	return 0
 }
 
-func (x *ChangePostgresExporterParams) GetTlsKey() string {
-	if x != nil && x.TlsKey != nil {
-		return *x.TlsKey
+func (x *ChangeQANMongoDBMongologAgentParams) GetAuthenticationMechanism() string {
+	if x != nil && x.AuthenticationMechanism != nil {
+		return *x.AuthenticationMechanism
 	}
 	return ""
 }
 
-func (x *ChangePostgresExporterParams) GetAgentPassword() string {
-	if x != nil && x.AgentPassword != nil {
-		return *x.AgentPassword
+func (x *ChangeQANMongoDBMongologAgentParams) GetAuthenticationDatabase() string {
+	if x != nil && x.AuthenticationDatabase != nil {
+		return *x.AuthenticationDatabase
 	}
 	return ""
 }
 
-func (x *ChangePostgresExporterParams) GetLogLevel() LogLevel {
+func (x *ChangeQANMongoDBMongologAgentParams) GetLogLevel() LogLevel {
 	if x != nil && x.LogLevel != nil {
 		return *x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *ChangePostgresExporterParams) GetAutoDiscoveryLimit() int32 {
-	if x != nil && x.AutoDiscoveryLimit != nil {
-		return *x.AutoDiscoveryLimit
-	}
-	return 0
-}
-
-func (x *ChangePostgresExporterParams) GetExposeExporter() bool {
-	if x != nil && x.ExposeExporter != nil {
-		return *x.ExposeExporter
+func (x *ChangeQANMongoDBMongologAgentParams) GetSkipConnectionCheck() bool {
+	if x != nil && x.SkipConnectionCheck != nil {
+		return *x.SkipConnectionCheck
 	}
 	return false
 }
 
-func (x *ChangePostgresExporterParams) GetMaxExporterConnections() int32 {
-	if x != nil && x.MaxExporterConnections != nil {
-		return *x.MaxExporterConnections
-	}
-	return 0
-}
-
-func (x *ChangePostgresExporterParams) GetConnectionTimeout() *durationpb.Duration {
-	if x != nil {
-		return x.ConnectionTimeout
-	}
-	return nil
-}
-
-type AddProxySQLExporterParams struct {
+type AddQANValkeySlowlogAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The pmm-agent identifier which runs this instance.
 	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
 	// Service identifier.
 	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// ProxySQL username for scraping metrics.
+	// Valkey username for getting slow log data.
 	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	// ProxySQL password for scraping metrics.
+	// Valkey password for getting slow log data.
 	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 	// Use TLS for database connections.
 	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
 	TlsSkipVerify bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// TLS CA certificate.
+	TlsCa string `protobuf:"bytes,7,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// TLS Certifcate.
+	TlsCert string `protobuf:"bytes,8,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
+	// TLS Certificate Key.
+	TlsKey string `protobuf:"bytes,9,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `protobuf:"varint,10,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
+	// Disable query examples.
+	DisableQueryExamples bool `protobuf:"varint,11,opt,name=disable_query_examples,json=disableQueryExamples,proto3" json:"disable_query_examples,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,7,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CustomLabels map[string]string `protobuf:"bytes,12,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Skip connection check.
-	SkipConnectionCheck bool `protobuf:"varint,8,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
-	// Enables push metrics mode for exporter.
-	PushMetrics bool `protobuf:"varint,9,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `protobuf:"bytes,10,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword string `protobuf:"bytes,11,opt,name=agent_password,json=agentPassword,proto3" json:"agent_password,omitempty"`
-	// Log level for exporter.
-	LogLevel LogLevel `protobuf:"varint,12,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
-	// Optionally expose the exporter process on all public interfaces
-	ExposeExporter bool `protobuf:"varint,13,opt,name=expose_exporter,json=exposeExporter,proto3" json:"expose_exporter,omitempty"`
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,14,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	SkipConnectionCheck bool `protobuf:"varint,13,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
+	// Log level for agent.
+	LogLevel      LogLevel `protobuf:"varint,14,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddProxySQLExporterParams) Reset() {
-	*x = AddProxySQLExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[40]
+func (x *AddQANValkeySlowlogAgentParams) Reset() {
+	*x = AddQANValkeySlowlogAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddProxySQLExporterParams) String() string {
+func (x *AddQANValkeySlowlogAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddProxySQLExporterParams) ProtoMessage() {}
+func (*AddQANValkeySlowlogAgentParams) ProtoMessage() {}
 
-func (x *AddProxySQLExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[40]
+func (x *AddQANValkeySlowlogAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7045,158 +10003,156 @@ func (x *AddProxySQLExporterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddProxySQLExporterParams.ProtoReflect.Descriptor instead.
-func (*AddProxySQLExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use AddQANValkeySlowlogAgentParams.ProtoReflect.Descriptor instead.
+func (*AddQANValkeySlowlogAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *AddProxySQLExporterParams) GetPmmAgentId() string {
+func (x *AddQANValkeySlowlogAgentParams) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *AddProxySQLExporterParams) GetServiceId() string {
+func (x *AddQANValkeySlowlogAgentParams) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *AddProxySQLExporterParams) GetUsername() string {
+func (x *AddQANValkeySlowlogAgentParams) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *AddProxySQLExporterParams) GetPassword() string {
+func (x *AddQANValkeySlowlogAgentParams) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-func (x *AddProxySQLExporterParams) GetTls() bool {
+func (x *AddQANValkeySlowlogAgentParams) GetTls() bool {
 	if x != nil {
 		return x.Tls
 	}
 	return false
 }
 
-func (x *AddProxySQLExporterParams) GetTlsSkipVerify() bool {
+func (x *AddQANValkeySlowlogAgentParams) GetTlsSkipVerify() bool {
 	if x != nil {
 		return x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *AddProxySQLExporterParams) GetCustomLabels() map[string]string {
+func (x *AddQANValkeySlowlogAgentParams) GetTlsCa() string {
 	if x != nil {
-		return x.CustomLabels
+		return x.TlsCa
 	}
-	return nil
+	return ""
 }
 
-func (x *AddProxySQLExporterParams) GetSkipConnectionCheck() bool {
+func (x *AddQANValkeySlowlogAgentParams) GetTlsCert() string {
 	if x != nil {
-		return x.SkipConnectionCheck
+		return x.TlsCert
 	}
-	return false
+	return ""
 }
 
-func (x *AddProxySQLExporterParams) GetPushMetrics() bool {
+func (x *AddQANValkeySlowlogAgentParams) GetTlsKey() string {
 	if x != nil {
-		return x.PushMetrics
+		return x.TlsKey
 	}
-	return false
+	return ""
 }
 
-func (x *AddProxySQLExporterParams) GetDisableCollectors() []string {
+func (x *AddQANValkeySlowlogAgentParams) GetMaxQueryLength() int32 {
 	if x != nil {
-		return x.DisableCollectors
+		return x.MaxQueryLength
 	}
-	return nil
+	return 0
 }
 
-func (x *AddProxySQLExporterParams) GetAgentPassword() string {
+func (x *AddQANValkeySlowlogAgentParams) GetDisableQueryExamples() bool {
 	if x != nil {
-		return x.AgentPassword
+		return x.DisableQueryExamples
 	}
-	return ""
+	return false
 }
 
-func (x *AddProxySQLExporterParams) GetLogLevel() LogLevel {
+func (x *AddQANValkeySlowlogAgentParams) GetCustomLabels() map[string]string {
 	if x != nil {
-		return x.LogLevel
+		return x.CustomLabels
 	}
-	return LogLevel_LOG_LEVEL_UNSPECIFIED
+	return nil
 }
 
-func (x *AddProxySQLExporterParams) GetExposeExporter() bool {
+func (x *AddQANValkeySlowlogAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil {
-		return x.ExposeExporter
+		return x.SkipConnectionCheck
 	}
 	return false
 }
 
-func (x *AddProxySQLExporterParams) GetConnectionTimeout() *durationpb.Duration {
+func (x *AddQANValkeySlowlogAgentParams) GetLogLevel() LogLevel {
 	if x != nil {
-		return x.ConnectionTimeout
+		return x.LogLevel
 	}
-	return nil
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-type ChangeProxySQLExporterParams struct {
+type ChangeQANValkeySlowlogAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
 	// Replace all custom user-assigned labels.
 	CustomLabels *common.StringMap `protobuf:"bytes,2,opt,name=custom_labels,json=customLabels,proto3,oneof" json:"custom_labels,omitempty"`
-	// Enables push metrics with vmagent.
-	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
-	// Metrics resolution for this agent.
-	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	// ProxySQL username for scraping metrics.
-	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// ProxySQL password for scraping metrics.
-	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// Valkey username for getting slow log data.
+	Username *string `protobuf:"bytes,3,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// Valkey password for getting slow log data.
+	Password *string `protobuf:"bytes,4,opt,name=password,proto3,oneof" json:"password,omitempty"`
 	// Use TLS for database connections.
-	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
+	Tls *bool `protobuf:"varint,5,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
-	TlsSkipVerify *bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `protobuf:"bytes,9,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword *string `protobuf:"bytes,10,opt,name=agent_password,json=agentPassword,proto3,oneof" json:"agent_password,omitempty"`
-	// Log level for exporter.
-	LogLevel *LogLevel `protobuf:"varint,11,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
-	// Optionally expose the exporter process on all public interfaces.
-	ExposeExporter *bool `protobuf:"varint,12,opt,name=expose_exporter,json=exposeExporter,proto3,oneof" json:"expose_exporter,omitempty"`
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,13,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
+	TlsSkipVerify *bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
+	// TLS CA certificate.
+	TlsCa *string `protobuf:"bytes,7,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
+	// TLS Certifcate.
+	TlsCert *string `protobuf:"bytes,8,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
+	// TLS Certificate Key.
+	TlsKey *string `protobuf:"bytes,9,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength *int32 `protobuf:"varint,10,opt,name=max_query_length,json=maxQueryLength,proto3,oneof" json:"max_query_length,omitempty"`
+	// Disable query examples.
+	DisableQueryExamples *bool `protobuf:"varint,11,opt,name=disable_query_examples,json=disableQueryExamples,proto3,oneof" json:"disable_query_examples,omitempty"`
+	// Log level for agent.
+	LogLevel *LogLevel `protobuf:"varint,12,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
 	// Skip connection check.
-	SkipConnectionCheck *bool `protobuf:"varint,14,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
+	SkipConnectionCheck *bool `protobuf:"varint,13,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ChangeProxySQLExporterParams) Reset() {
-	*x = ChangeProxySQLExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[41]
+func (x *ChangeQANValkeySlowlogAgentParams) Reset() {
+	*x = ChangeQANValkeySlowlogAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeProxySQLExporterParams) String() string {
+func (x *ChangeQANValkeySlowlogAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeProxySQLExporterParams) ProtoMessage() {}
+func (*ChangeQANValkeySlowlogAgentParams) ProtoMessage() {}
 
-func (x *ChangeProxySQLExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[41]
+func (x *ChangeQANValkeySlowlogAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7207,162 +10163,143 @@ func (x *ChangeProxySQLExporterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeProxySQLExporterParams.ProtoReflect.Descriptor instead.
-func (*ChangeProxySQLExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use ChangeQANValkeySlowlogAgentParams.ProtoReflect.Descriptor instead.
+func (*ChangeQANValkeySlowlogAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *ChangeProxySQLExporterParams) GetEnable() bool {
+func (x *ChangeQANValkeySlowlogAgentParams) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
 	}
 	return false
 }
 
-func (x *ChangeProxySQLExporterParams) GetCustomLabels() *common.StringMap {
+func (x *ChangeQANValkeySlowlogAgentParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangeProxySQLExporterParams) GetEnablePushMetrics() bool {
-	if x != nil && x.EnablePushMetrics != nil {
-		return *x.EnablePushMetrics
-	}
-	return false
-}
-
-func (x *ChangeProxySQLExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
-	if x != nil {
-		return x.MetricsResolutions
-	}
-	return nil
-}
-
-func (x *ChangeProxySQLExporterParams) GetUsername() string {
+func (x *ChangeQANValkeySlowlogAgentParams) GetUsername() string {
 	if x != nil && x.Username != nil {
 		return *x.Username
 	}
 	return ""
 }
 
-func (x *ChangeProxySQLExporterParams) GetPassword() string {
+func (x *ChangeQANValkeySlowlogAgentParams) GetPassword() string {
 	if x != nil && x.Password != nil {
 		return *x.Password
 	}
 	return ""
 }
 
-func (x *ChangeProxySQLExporterParams) GetTls() bool {
+func (x *ChangeQANValkeySlowlogAgentParams) GetTls() bool {
 	if x != nil && x.Tls != nil {
 		return *x.Tls
 	}
 	return false
 }
 
-func (x *ChangeProxySQLExporterParams) GetTlsSkipVerify() bool {
+func (x *ChangeQANValkeySlowlogAgentParams) GetTlsSkipVerify() bool {
 	if x != nil && x.TlsSkipVerify != nil {
 		return *x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *ChangeProxySQLExporterParams) GetDisableCollectors() []string {
-	if x != nil {
-		return x.DisableCollectors
+func (x *ChangeQANValkeySlowlogAgentParams) GetTlsCa() string {
+	if x != nil && x.TlsCa != nil {
+		return *x.TlsCa
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangeProxySQLExporterParams) GetAgentPassword() string {
-	if x != nil && x.AgentPassword != nil {
-		return *x.AgentPassword
+func (x *ChangeQANValkeySlowlogAgentParams) GetTlsCert() string {
+	if x != nil && x.TlsCert != nil {
+		return *x.TlsCert
 	}
 	return ""
 }
 
-func (x *ChangeProxySQLExporterParams) GetLogLevel() LogLevel {
-	if x != nil && x.LogLevel != nil {
-		return *x.LogLevel
+func (x *ChangeQANValkeySlowlogAgentParams) GetTlsKey() string {
+	if x != nil && x.TlsKey != nil {
+		return *x.TlsKey
 	}
-	return LogLevel_LOG_LEVEL_UNSPECIFIED
+	return ""
 }
 
-func (x *ChangeProxySQLExporterParams) GetExposeExporter() bool {
-	if x != nil && x.ExposeExporter != nil {
-		return *x.ExposeExporter
+func (x *ChangeQANValkeySlowlogAgentParams) GetMaxQueryLength() int32 {
+	if x != nil && x.MaxQueryLength != nil {
+		return *x.MaxQueryLength
+	}
+	return 0
+}
+
+func (x *ChangeQANValkeySlowlogAgentParams) GetDisableQueryExamples() bool {
+	if x != nil && x.DisableQueryExamples != nil {
+		return *x.DisableQueryExamples
 	}
 	return false
 }
 
-func (x *ChangeProxySQLExporterParams) GetConnectionTimeout() *durationpb.Duration {
-	if x != nil {
-		return x.ConnectionTimeout
+func (x *ChangeQANValkeySlowlogAgentParams) GetLogLevel() LogLevel {
+	if x != nil && x.LogLevel != nil {
+		return *x.LogLevel
 	}
-	return nil
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *ChangeProxySQLExporterParams) GetSkipConnectionCheck() bool {
+func (x *ChangeQANValkeySlowlogAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil && x.SkipConnectionCheck != nil {
 		return *x.SkipConnectionCheck
 	}
 	return false
 }
 
-type AddQANMySQLPerfSchemaAgentParams struct {
+type AddQANProxySQLDigestAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The pmm-agent identifier which runs this instance.
 	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
 	// Service identifier.
 	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// MySQL username for getting performance data.
+	// ProxySQL username for getting query digest data.
 	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	// MySQL password for getting performance data.
+	// ProxySQL password for getting query digest data.
 	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 	// Use TLS for database connections.
 	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
 	TlsSkipVerify bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
-	// Certificate Authority certificate chain.
-	TlsCa string `protobuf:"bytes,7,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
-	// Client certificate.
-	TlsCert string `protobuf:"bytes,8,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
-	// Password for decrypting tls_cert.
-	TlsKey string `protobuf:"bytes,9,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
 	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength int32 `protobuf:"varint,10,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
-	// Disable query examples.
-	DisableQueryExamples bool `protobuf:"varint,11,opt,name=disable_query_examples,json=disableQueryExamples,proto3" json:"disable_query_examples,omitempty"`
+	MaxQueryLength int32 `protobuf:"varint,7,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,12,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CustomLabels map[string]string `protobuf:"bytes,8,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Skip connection check.
-	SkipConnectionCheck bool `protobuf:"varint,13,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing bool `protobuf:"varint,14,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3" json:"disable_comments_parsing,omitempty"`
-	// Log level for exporter.
-	LogLevel LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
-	// Extra DSN parameters for MySQL connection.
-	ExtraDsnParams map[string]string `protobuf:"bytes,16,rep,name=extra_dsn_params,json=extraDsnParams,proto3" json:"extra_dsn_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	SkipConnectionCheck bool `protobuf:"varint,9,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
+	// Log level for agent.
+	LogLevel      LogLevel `protobuf:"varint,10,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddQANMySQLPerfSchemaAgentParams) Reset() {
-	*x = AddQANMySQLPerfSchemaAgentParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[42]
+func (x *AddQANProxySQLDigestAgentParams) Reset() {
+	*x = AddQANProxySQLDigestAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddQANMySQLPerfSchemaAgentParams) String() string {
+func (x *AddQANProxySQLDigestAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddQANMySQLPerfSchemaAgentParams) ProtoMessage() {}
+func (*AddQANProxySQLDigestAgentParams) ProtoMessage() {}
 
-func (x *AddQANMySQLPerfSchemaAgentParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[42]
+func (x *AddQANProxySQLDigestAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7373,176 +10310,120 @@ func (x *AddQANMySQLPerfSchemaAgentParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddQANMySQLPerfSchemaAgentParams.ProtoReflect.Descriptor instead.
-func (*AddQANMySQLPerfSchemaAgentParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use AddQANProxySQLDigestAgentParams.ProtoReflect.Descriptor instead.
+func (*AddQANProxySQLDigestAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *AddQANMySQLPerfSchemaAgentParams) GetPmmAgentId() string {
+func (x *AddQANProxySQLDigestAgentParams) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *AddQANMySQLPerfSchemaAgentParams) GetServiceId() string {
+func (x *AddQANProxySQLDigestAgentParams) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *AddQANMySQLPerfSchemaAgentParams) GetUsername() string {
+func (x *AddQANProxySQLDigestAgentParams) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *AddQANMySQLPerfSchemaAgentParams) GetPassword() string {
+func (x *AddQANProxySQLDigestAgentParams) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-func (x *AddQANMySQLPerfSchemaAgentParams) GetTls() bool {
+func (x *AddQANProxySQLDigestAgentParams) GetTls() bool {
 	if x != nil {
 		return x.Tls
 	}
 	return false
 }
 
-func (x *AddQANMySQLPerfSchemaAgentParams) GetTlsSkipVerify() bool {
+func (x *AddQANProxySQLDigestAgentParams) GetTlsSkipVerify() bool {
 	if x != nil {
 		return x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *AddQANMySQLPerfSchemaAgentParams) GetTlsCa() string {
-	if x != nil {
-		return x.TlsCa
-	}
-	return ""
-}
-
-func (x *AddQANMySQLPerfSchemaAgentParams) GetTlsCert() string {
-	if x != nil {
-		return x.TlsCert
-	}
-	return ""
-}
-
-func (x *AddQANMySQLPerfSchemaAgentParams) GetTlsKey() string {
-	if x != nil {
-		return x.TlsKey
-	}
-	return ""
-}
-
-func (x *AddQANMySQLPerfSchemaAgentParams) GetMaxQueryLength() int32 {
+func (x *AddQANProxySQLDigestAgentParams) GetMaxQueryLength() int32 {
 	if x != nil {
 		return x.MaxQueryLength
 	}
 	return 0
 }
 
-func (x *AddQANMySQLPerfSchemaAgentParams) GetDisableQueryExamples() bool {
-	if x != nil {
-		return x.DisableQueryExamples
-	}
-	return false
-}
-
-func (x *AddQANMySQLPerfSchemaAgentParams) GetCustomLabels() map[string]string {
+func (x *AddQANProxySQLDigestAgentParams) GetCustomLabels() map[string]string {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *AddQANMySQLPerfSchemaAgentParams) GetSkipConnectionCheck() bool {
+func (x *AddQANProxySQLDigestAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil {
 		return x.SkipConnectionCheck
 	}
 	return false
 }
 
-func (x *AddQANMySQLPerfSchemaAgentParams) GetDisableCommentsParsing() bool {
-	if x != nil {
-		return x.DisableCommentsParsing
-	}
-	return false
-}
-
-func (x *AddQANMySQLPerfSchemaAgentParams) GetLogLevel() LogLevel {
+func (x *AddQANProxySQLDigestAgentParams) GetLogLevel() LogLevel {
 	if x != nil {
 		return x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *AddQANMySQLPerfSchemaAgentParams) GetExtraDsnParams() map[string]string {
-	if x != nil {
-		return x.ExtraDsnParams
-	}
-	return nil
-}
-
-type ChangeQANMySQLPerfSchemaAgentParams struct {
+type ChangeQANProxySQLDigestAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
 	// Replace all custom user-assigned labels.
 	CustomLabels *common.StringMap `protobuf:"bytes,2,opt,name=custom_labels,json=customLabels,proto3,oneof" json:"custom_labels,omitempty"`
-	// Enables push metrics with vmagent.
-	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
-	// Metrics resolution for this agent.
-	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	// MySQL username for getting performance data.
-	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// MySQL password for getting performance data.
-	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// ProxySQL username for getting query digest data.
+	Username *string `protobuf:"bytes,3,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// ProxySQL password for getting query digest data.
+	Password *string `protobuf:"bytes,4,opt,name=password,proto3,oneof" json:"password,omitempty"`
 	// Use TLS for database connections.
-	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
+	Tls *bool `protobuf:"varint,5,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
-	TlsSkipVerify *bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
-	// Certificate Authority certificate chain.
-	TlsCa *string `protobuf:"bytes,9,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
-	// Client certificate.
-	TlsCert *string `protobuf:"bytes,10,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
-	// Password for decrypting tls_cert.
-	TlsKey *string `protobuf:"bytes,11,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
+	TlsSkipVerify *bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
 	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength *int32 `protobuf:"varint,12,opt,name=max_query_length,json=maxQueryLength,proto3,oneof" json:"max_query_length,omitempty"`
-	// Disable query examples.
-	DisableQueryExamples *bool `protobuf:"varint,13,opt,name=disable_query_examples,json=disableQueryExamples,proto3,oneof" json:"disable_query_examples,omitempty"`
+	MaxQueryLength *int32 `protobuf:"varint,7,opt,name=max_query_length,json=maxQueryLength,proto3,oneof" json:"max_query_length,omitempty"`
+	// Log level for agent.
+	LogLevel *LogLevel `protobuf:"varint,8,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
 	// Skip connection check.
-	SkipConnectionCheck *bool `protobuf:"varint,14,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing *bool `protobuf:"varint,15,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3,oneof" json:"disable_comments_parsing,omitempty"`
-	// Log level for exporter.
-	LogLevel      *LogLevel `protobuf:"varint,16,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	SkipConnectionCheck *bool `protobuf:"varint,9,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ChangeQANMySQLPerfSchemaAgentParams) Reset() {
-	*x = ChangeQANMySQLPerfSchemaAgentParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[43]
+func (x *ChangeQANProxySQLDigestAgentParams) Reset() {
+	*x = ChangeQANProxySQLDigestAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeQANMySQLPerfSchemaAgentParams) String() string {
+func (x *ChangeQANProxySQLDigestAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeQANMySQLPerfSchemaAgentParams) ProtoMessage() {}
+func (*ChangeQANProxySQLDigestAgentParams) ProtoMessage() {}
 
-func (x *ChangeQANMySQLPerfSchemaAgentParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[43]
+func (x *ChangeQANProxySQLDigestAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7553,179 +10434,123 @@ func (x *ChangeQANMySQLPerfSchemaAgentParams) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeQANMySQLPerfSchemaAgentParams.ProtoReflect.Descriptor instead.
-func (*ChangeQANMySQLPerfSchemaAgentParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ChangeQANProxySQLDigestAgentParams.ProtoReflect.Descriptor instead.
+func (*ChangeQANProxySQLDigestAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *ChangeQANMySQLPerfSchemaAgentParams) GetEnable() bool {
+func (x *ChangeQANProxySQLDigestAgentParams) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
 	}
 	return false
 }
 
-func (x *ChangeQANMySQLPerfSchemaAgentParams) GetCustomLabels() *common.StringMap {
+func (x *ChangeQANProxySQLDigestAgentParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangeQANMySQLPerfSchemaAgentParams) GetEnablePushMetrics() bool {
-	if x != nil && x.EnablePushMetrics != nil {
-		return *x.EnablePushMetrics
-	}
-	return false
-}
-
-func (x *ChangeQANMySQLPerfSchemaAgentParams) GetMetricsResolutions() *common.MetricsResolutions {
-	if x != nil {
-		return x.MetricsResolutions
-	}
-	return nil
-}
-
-func (x *ChangeQANMySQLPerfSchemaAgentParams) GetUsername() string {
+func (x *ChangeQANProxySQLDigestAgentParams) GetUsername() string {
 	if x != nil && x.Username != nil {
 		return *x.Username
 	}
 	return ""
 }
 
-func (x *ChangeQANMySQLPerfSchemaAgentParams) GetPassword() string {
+func (x *ChangeQANProxySQLDigestAgentParams) GetPassword() string {
 	if x != nil && x.Password != nil {
 		return *x.Password
 	}
 	return ""
 }
 
-func (x *ChangeQANMySQLPerfSchemaAgentParams) GetTls() bool {
+func (x *ChangeQANProxySQLDigestAgentParams) GetTls() bool {
 	if x != nil && x.Tls != nil {
 		return *x.Tls
 	}
 	return false
 }
 
-func (x *ChangeQANMySQLPerfSchemaAgentParams) GetTlsSkipVerify() bool {
+func (x *ChangeQANProxySQLDigestAgentParams) GetTlsSkipVerify() bool {
 	if x != nil && x.TlsSkipVerify != nil {
 		return *x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *ChangeQANMySQLPerfSchemaAgentParams) GetTlsCa() string {
-	if x != nil && x.TlsCa != nil {
-		return *x.TlsCa
-	}
-	return ""
-}
-
-func (x *ChangeQANMySQLPerfSchemaAgentParams) GetTlsCert() string {
-	if x != nil && x.TlsCert != nil {
-		return *x.TlsCert
-	}
-	return ""
-}
-
-func (x *ChangeQANMySQLPerfSchemaAgentParams) GetTlsKey() string {
-	if x != nil && x.TlsKey != nil {
-		return *x.TlsKey
-	}
-	return ""
-}
-
-func (x *ChangeQANMySQLPerfSchemaAgentParams) GetMaxQueryLength() int32 {
+func (x *ChangeQANProxySQLDigestAgentParams) GetMaxQueryLength() int32 {
 	if x != nil && x.MaxQueryLength != nil {
 		return *x.MaxQueryLength
 	}
 	return 0
 }
 
-func (x *ChangeQANMySQLPerfSchemaAgentParams) GetDisableQueryExamples() bool {
-	if x != nil && x.DisableQueryExamples != nil {
-		return *x.DisableQueryExamples
+func (x *ChangeQANProxySQLDigestAgentParams) GetLogLevel() LogLevel {
+	if x != nil && x.LogLevel != nil {
+		return *x.LogLevel
 	}
-	return false
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *ChangeQANMySQLPerfSchemaAgentParams) GetSkipConnectionCheck() bool {
+func (x *ChangeQANProxySQLDigestAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil && x.SkipConnectionCheck != nil {
 		return *x.SkipConnectionCheck
 	}
 	return false
 }
 
-func (x *ChangeQANMySQLPerfSchemaAgentParams) GetDisableCommentsParsing() bool {
-	if x != nil && x.DisableCommentsParsing != nil {
-		return *x.DisableCommentsParsing
-	}
-	return false
-}
-
-func (x *ChangeQANMySQLPerfSchemaAgentParams) GetLogLevel() LogLevel {
-	if x != nil && x.LogLevel != nil {
-		return *x.LogLevel
-	}
-	return LogLevel_LOG_LEVEL_UNSPECIFIED
-}
-
-type AddQANMySQLSlowlogAgentParams struct {
+type AddQANPostgreSQLPgStatementsAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The pmm-agent identifier which runs this instance.
 	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
 	// Service identifier.
 	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// MySQL username for getting slowlog data.
+	// PostgreSQL username for getting pg stat statements data.
 	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	// MySQL password for getting slowlog data.
+	// PostgreSQL password for getting pg stat statements data.
 	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 	// Use TLS for database connections.
 	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
 	TlsSkipVerify bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
-	// Certificate Authority certificate chain.
-	TlsCa string `protobuf:"bytes,7,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
-	// Client certificate.
-	TlsCert string `protobuf:"bytes,8,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
-	// Password for decrypting tls_cert.
-	TlsKey string `protobuf:"bytes,9,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
-	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength int32 `protobuf:"varint,10,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
-	// Disable query examples.
-	DisableQueryExamples bool `protobuf:"varint,11,opt,name=disable_query_examples,json=disableQueryExamples,proto3" json:"disable_query_examples,omitempty"`
-	// Rotate slowlog file at this size if > 0.
-	// Use zero or negative value to disable rotation.
-	MaxSlowlogFileSize int64 `protobuf:"varint,12,opt,name=max_slowlog_file_size,json=maxSlowlogFileSize,proto3" json:"max_slowlog_file_size,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,13,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CustomLabels map[string]string `protobuf:"bytes,7,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Skip connection check.
-	SkipConnectionCheck bool `protobuf:"varint,14,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
+	SkipConnectionCheck bool `protobuf:"varint,8,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
 	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing bool `protobuf:"varint,15,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3" json:"disable_comments_parsing,omitempty"`
+	DisableCommentsParsing bool `protobuf:"varint,9,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3" json:"disable_comments_parsing,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `protobuf:"varint,10,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
+	// TLS CA certificate.
+	TlsCa string `protobuf:"bytes,11,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// TLS Certifcate.
+	TlsCert string `protobuf:"bytes,12,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
+	// TLS Certificate Key.
+	TlsKey string `protobuf:"bytes,13,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
 	// Log level for exporter.
-	LogLevel LogLevel `protobuf:"varint,16,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
-	// Extra DSN parameters for MySQL connection.
-	ExtraDsnParams map[string]string `protobuf:"bytes,17,rep,name=extra_dsn_params,json=extraDsnParams,proto3" json:"extra_dsn_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	LogLevel      LogLevel `protobuf:"varint,14,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) Reset() {
-	*x = AddQANMySQLSlowlogAgentParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[44]
+func (x *AddQANPostgreSQLPgStatementsAgentParams) Reset() {
+	*x = AddQANPostgreSQLPgStatementsAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) String() string {
+func (x *AddQANPostgreSQLPgStatementsAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddQANMySQLSlowlogAgentParams) ProtoMessage() {}
+func (*AddQANPostgreSQLPgStatementsAgentParams) ProtoMessage() {}
 
-func (x *AddQANMySQLSlowlogAgentParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[44]
+func (x *AddQANPostgreSQLPgStatementsAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7736,131 +10561,110 @@ func (x *AddQANMySQLSlowlogAgentParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddQANMySQLSlowlogAgentParams.ProtoReflect.Descriptor instead.
-func (*AddQANMySQLSlowlogAgentParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use AddQANPostgreSQLPgStatementsAgentParams.ProtoReflect.Descriptor instead.
+func (*AddQANPostgreSQLPgStatementsAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) GetPmmAgentId() string {
+func (x *AddQANPostgreSQLPgStatementsAgentParams) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) GetServiceId() string {
+func (x *AddQANPostgreSQLPgStatementsAgentParams) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) GetUsername() string {
+func (x *AddQANPostgreSQLPgStatementsAgentParams) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) GetPassword() string {
+func (x *AddQANPostgreSQLPgStatementsAgentParams) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) GetTls() bool {
+func (x *AddQANPostgreSQLPgStatementsAgentParams) GetTls() bool {
 	if x != nil {
 		return x.Tls
 	}
 	return false
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) GetTlsSkipVerify() bool {
+func (x *AddQANPostgreSQLPgStatementsAgentParams) GetTlsSkipVerify() bool {
 	if x != nil {
 		return x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) GetTlsCa() string {
-	if x != nil {
-		return x.TlsCa
-	}
-	return ""
-}
-
-func (x *AddQANMySQLSlowlogAgentParams) GetTlsCert() string {
-	if x != nil {
-		return x.TlsCert
-	}
-	return ""
-}
-
-func (x *AddQANMySQLSlowlogAgentParams) GetTlsKey() string {
+func (x *AddQANPostgreSQLPgStatementsAgentParams) GetCustomLabels() map[string]string {
 	if x != nil {
-		return x.TlsKey
+		return x.CustomLabels
 	}
-	return ""
+	return nil
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) GetMaxQueryLength() int32 {
+func (x *AddQANPostgreSQLPgStatementsAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil {
-		return x.MaxQueryLength
+		return x.SkipConnectionCheck
 	}
-	return 0
+	return false
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) GetDisableQueryExamples() bool {
+func (x *AddQANPostgreSQLPgStatementsAgentParams) GetDisableCommentsParsing() bool {
 	if x != nil {
-		return x.DisableQueryExamples
+		return x.DisableCommentsParsing
 	}
 	return false
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) GetMaxSlowlogFileSize() int64 {
+func (x *AddQANPostgreSQLPgStatementsAgentParams) GetMaxQueryLength() int32 {
 	if x != nil {
-		return x.MaxSlowlogFileSize
+		return x.MaxQueryLength
 	}
 	return 0
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) GetCustomLabels() map[string]string {
+func (x *AddQANPostgreSQLPgStatementsAgentParams) GetTlsCa() string {
 	if x != nil {
-		return x.CustomLabels
+		return x.TlsCa
 	}
-	return nil
+	return ""
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) GetSkipConnectionCheck() bool {
+func (x *AddQANPostgreSQLPgStatementsAgentParams) GetTlsCert() string {
 	if x != nil {
-		return x.SkipConnectionCheck
+		return x.TlsCert
 	}
-	return false
+	return ""
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) GetDisableCommentsParsing() bool {
+func (x *AddQANPostgreSQLPgStatementsAgentParams) GetTlsKey() string {
 	if x != nil {
-		return x.DisableCommentsParsing
+		return x.TlsKey
 	}
-	return false
+	return ""
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) GetLogLevel() LogLevel {
+func (x *AddQANPostgreSQLPgStatementsAgentParams) GetLogLevel() LogLevel {
 	if x != nil {
 		return x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *AddQANMySQLSlowlogAgentParams) GetExtraDsnParams() map[string]string {
-	if x != nil {
-		return x.ExtraDsnParams
-	}
-	return nil
-}
-
-type ChangeQANMySQLSlowlogAgentParams struct {
+type ChangeQANPostgreSQLPgStatementsAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
@@ -7870,51 +10674,47 @@ type ChangeQANMySQLSlowlogAgentParams struct {
 	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
 	// Metrics resolution for this agent.
 	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	// MySQL username for getting slowlog data.
+	// PostgreSQL username for getting pg stat statements data.
 	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// MySQL password for getting slowlog data.
+	// PostgreSQL password for getting pg stat statements data.
 	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
 	// Use TLS for database connections.
 	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
 	TlsSkipVerify *bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
-	// Certificate Authority certificate chain.
-	TlsCa *string `protobuf:"bytes,9,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
-	// Client certificate.
-	TlsCert *string `protobuf:"bytes,10,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
-	// Password for decrypting tls_cert.
-	TlsKey *string `protobuf:"bytes,11,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing *bool `protobuf:"varint,9,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3,oneof" json:"disable_comments_parsing,omitempty"`
 	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength *int32 `protobuf:"varint,12,opt,name=max_query_length,json=maxQueryLength,proto3,oneof" json:"max_query_length,omitempty"`
-	// Disable query examples.
-	DisableQueryExamples *bool `protobuf:"varint,13,opt,name=disable_query_examples,json=disableQueryExamples,proto3,oneof" json:"disable_query_examples,omitempty"`
-	// Rotate slowlog file at this size if > 0.
-	MaxSlowlogFileSize *int64 `protobuf:"varint,14,opt,name=max_slowlog_file_size,json=maxSlowlogFileSize,proto3,oneof" json:"max_slowlog_file_size,omitempty"`
+	MaxQueryLength *int32 `protobuf:"varint,10,opt,name=max_query_length,json=maxQueryLength,proto3,oneof" json:"max_query_length,omitempty"`
+	// TLS CA certificate.
+	TlsCa *string `protobuf:"bytes,11,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
+	// TLS Certificate.
+	TlsCert *string `protobuf:"bytes,12,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
+	// TLS Certificate Key.
+	TlsKey *string `protobuf:"bytes,13,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
+	// Log level for exporter.
+	LogLevel *LogLevel `protobuf:"varint,14,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
 	// Skip connection check.
 	SkipConnectionCheck *bool `protobuf:"varint,15,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing *bool `protobuf:"varint,16,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3,oneof" json:"disable_comments_parsing,omitempty"`
-	// Log level for exporter.
-	LogLevel      *LogLevel `protobuf:"varint,17,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ChangeQANMySQLSlowlogAgentParams) Reset() {
-	*x = ChangeQANMySQLSlowlogAgentParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[45]
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) Reset() {
+	*x = ChangeQANPostgreSQLPgStatementsAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeQANMySQLSlowlogAgentParams) String() string {
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeQANMySQLSlowlogAgentParams) ProtoMessage() {}
+func (*ChangeQANPostgreSQLPgStatementsAgentParams) ProtoMessage() {}
 
-func (x *ChangeQANMySQLSlowlogAgentParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[45]
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7925,183 +10725,167 @@ func (x *ChangeQANMySQLSlowlogAgentParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeQANMySQLSlowlogAgentParams.ProtoReflect.Descriptor instead.
-func (*ChangeQANMySQLSlowlogAgentParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use ChangeQANPostgreSQLPgStatementsAgentParams.ProtoReflect.Descriptor instead.
+func (*ChangeQANPostgreSQLPgStatementsAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *ChangeQANMySQLSlowlogAgentParams) GetEnable() bool {
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
 	}
 	return false
 }
 
-func (x *ChangeQANMySQLSlowlogAgentParams) GetCustomLabels() *common.StringMap {
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangeQANMySQLSlowlogAgentParams) GetEnablePushMetrics() bool {
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetEnablePushMetrics() bool {
 	if x != nil && x.EnablePushMetrics != nil {
 		return *x.EnablePushMetrics
 	}
 	return false
 }
 
-func (x *ChangeQANMySQLSlowlogAgentParams) GetMetricsResolutions() *common.MetricsResolutions {
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetMetricsResolutions() *common.MetricsResolutions {
 	if x != nil {
 		return x.MetricsResolutions
 	}
 	return nil
 }
 
-func (x *ChangeQANMySQLSlowlogAgentParams) GetUsername() string {
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetUsername() string {
 	if x != nil && x.Username != nil {
 		return *x.Username
 	}
 	return ""
 }
 
-func (x *ChangeQANMySQLSlowlogAgentParams) GetPassword() string {
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetPassword() string {
 	if x != nil && x.Password != nil {
 		return *x.Password
 	}
 	return ""
 }
 
-func (x *ChangeQANMySQLSlowlogAgentParams) GetTls() bool {
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetTls() bool {
 	if x != nil && x.Tls != nil {
 		return *x.Tls
 	}
 	return false
 }
 
-func (x *ChangeQANMySQLSlowlogAgentParams) GetTlsSkipVerify() bool {
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetTlsSkipVerify() bool {
 	if x != nil && x.TlsSkipVerify != nil {
 		return *x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *ChangeQANMySQLSlowlogAgentParams) GetTlsCa() string {
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetDisableCommentsParsing() bool {
+	if x != nil && x.DisableCommentsParsing != nil {
+		return *x.DisableCommentsParsing
+	}
+	return false
+}
+
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetMaxQueryLength() int32 {
+	if x != nil && x.MaxQueryLength != nil {
+		return *x.MaxQueryLength
+	}
+	return 0
+}
+
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetTlsCa() string {
 	if x != nil && x.TlsCa != nil {
 		return *x.TlsCa
 	}
 	return ""
 }
 
-func (x *ChangeQANMySQLSlowlogAgentParams) GetTlsCert() string {
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetTlsCert() string {
 	if x != nil && x.TlsCert != nil {
 		return *x.TlsCert
 	}
 	return ""
 }
 
-func (x *ChangeQANMySQLSlowlogAgentParams) GetTlsKey() string {
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetTlsKey() string {
 	if x != nil && x.TlsKey != nil {
 		return *x.TlsKey
 	}
 	return ""
 }
 
-func (x *ChangeQANMySQLSlowlogAgentParams) GetMaxQueryLength() int32 {
-	if x != nil && x.MaxQueryLength != nil {
-		return *x.MaxQueryLength
-	}
-	return 0
-}
-
-func (x *ChangeQANMySQLSlowlogAgentParams) GetDisableQueryExamples() bool {
-	if x != nil && x.DisableQueryExamples != nil {
-		return *x.DisableQueryExamples
-	}
-	return false
-}
-
-func (x *ChangeQANMySQLSlowlogAgentParams) GetMaxSlowlogFileSize() int64 {
-	if x != nil && x.MaxSlowlogFileSize != nil {
-		return *x.MaxSlowlogFileSize
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetLogLevel() LogLevel {
+	if x != nil && x.LogLevel != nil {
+		return *x.LogLevel
 	}
-	return 0
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *ChangeQANMySQLSlowlogAgentParams) GetSkipConnectionCheck() bool {
+func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil && x.SkipConnectionCheck != nil {
 		return *x.SkipConnectionCheck
 	}
 	return false
 }
 
-func (x *ChangeQANMySQLSlowlogAgentParams) GetDisableCommentsParsing() bool {
-	if x != nil && x.DisableCommentsParsing != nil {
-		return *x.DisableCommentsParsing
-	}
-	return false
-}
-
-func (x *ChangeQANMySQLSlowlogAgentParams) GetLogLevel() LogLevel {
-	if x != nil && x.LogLevel != nil {
-		return *x.LogLevel
-	}
-	return LogLevel_LOG_LEVEL_UNSPECIFIED
-}
-
-type AddQANMongoDBProfilerAgentParams struct {
+type AddQANPostgreSQLPgStatMonitorAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The pmm-agent identifier which runs this instance.
 	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
 	// Service identifier.
 	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// MongoDB username for getting profile data.
+	// PostgreSQL username for getting pg stat monitor data.
 	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	// MongoDB password for getting profile data.
+	// PostgreSQL password for getting pg stat monitor data.
 	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 	// Use TLS for database connections.
 	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
 	TlsSkipVerify bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
-	// Client certificate and key.
-	TlsCertificateKey string `protobuf:"bytes,7,opt,name=tls_certificate_key,json=tlsCertificateKey,proto3" json:"tls_certificate_key,omitempty"`
-	// Password for decrypting tls_certificate_key.
-	TlsCertificateKeyFilePassword string `protobuf:"bytes,8,opt,name=tls_certificate_key_file_password,json=tlsCertificateKeyFilePassword,proto3" json:"tls_certificate_key_file_password,omitempty"`
-	// Certificate Authority certificate chain.
-	TlsCa string `protobuf:"bytes,9,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
 	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength int32 `protobuf:"varint,10,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
+	MaxQueryLength int32 `protobuf:"varint,7,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
+	// Disable query examples.
+	DisableQueryExamples bool `protobuf:"varint,8,opt,name=disable_query_examples,json=disableQueryExamples,proto3" json:"disable_query_examples,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,11,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CustomLabels map[string]string `protobuf:"bytes,9,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Skip connection check.
-	SkipConnectionCheck bool `protobuf:"varint,12,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
-	// Authentication mechanism.
-	// See https://docs.mongodb.com/manual/reference/connection-string/#mongodb-urioption-urioption.authMechanism
-	// for details.
-	AuthenticationMechanism string `protobuf:"bytes,13,opt,name=authentication_mechanism,json=authenticationMechanism,proto3" json:"authentication_mechanism,omitempty"`
-	// Authentication database.
-	AuthenticationDatabase string `protobuf:"bytes,14,opt,name=authentication_database,json=authenticationDatabase,proto3" json:"authentication_database,omitempty"`
-	// Log level for agent.
+	SkipConnectionCheck bool `protobuf:"varint,10,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `protobuf:"varint,11,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3" json:"disable_comments_parsing,omitempty"`
+	// TLS CA certificate.
+	TlsCa string `protobuf:"bytes,12,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// TLS Certifcate.
+	TlsCert string `protobuf:"bytes,13,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
+	// TLS Certificate Key.
+	TlsKey string `protobuf:"bytes,14,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
+	// Log level for exporter.
 	LogLevel      LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) Reset() {
-	*x = AddQANMongoDBProfilerAgentParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[46]
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) Reset() {
+	*x = AddQANPostgreSQLPgStatMonitorAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) String() string {
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddQANMongoDBProfilerAgentParams) ProtoMessage() {}
+func (*AddQANPostgreSQLPgStatMonitorAgentParams) ProtoMessage() {}
 
-func (x *AddQANMongoDBProfilerAgentParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[46]
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8112,117 +10896,117 @@ func (x *AddQANMongoDBProfilerAgentParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddQANMongoDBProfilerAgentParams.ProtoReflect.Descriptor instead.
-func (*AddQANMongoDBProfilerAgentParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use AddQANPostgreSQLPgStatMonitorAgentParams.ProtoReflect.Descriptor instead.
+func (*AddQANPostgreSQLPgStatMonitorAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) GetPmmAgentId() string {
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) GetServiceId() string {
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) GetUsername() string {
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) GetPassword() string {
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) GetTls() bool {
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetTls() bool {
 	if x != nil {
 		return x.Tls
 	}
 	return false
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) GetTlsSkipVerify() bool {
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetTlsSkipVerify() bool {
 	if x != nil {
 		return x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) GetTlsCertificateKey() string {
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetMaxQueryLength() int32 {
 	if x != nil {
-		return x.TlsCertificateKey
+		return x.MaxQueryLength
 	}
-	return ""
+	return 0
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) GetTlsCertificateKeyFilePassword() string {
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetDisableQueryExamples() bool {
 	if x != nil {
-		return x.TlsCertificateKeyFilePassword
+		return x.DisableQueryExamples
 	}
-	return ""
+	return false
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) GetTlsCa() string {
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetCustomLabels() map[string]string {
 	if x != nil {
-		return x.TlsCa
+		return x.CustomLabels
 	}
-	return ""
+	return nil
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) GetMaxQueryLength() int32 {
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil {
-		return x.MaxQueryLength
+		return x.SkipConnectionCheck
 	}
-	return 0
+	return false
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) GetCustomLabels() map[string]string {
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetDisableCommentsParsing() bool {
 	if x != nil {
-		return x.CustomLabels
+		return x.DisableCommentsParsing
 	}
-	return nil
+	return false
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) GetSkipConnectionCheck() bool {
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetTlsCa() string {
 	if x != nil {
-		return x.SkipConnectionCheck
+		return x.TlsCa
 	}
-	return false
+	return ""
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) GetAuthenticationMechanism() string {
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetTlsCert() string {
 	if x != nil {
-		return x.AuthenticationMechanism
+		return x.TlsCert
 	}
 	return ""
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) GetAuthenticationDatabase() string {
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetTlsKey() string {
 	if x != nil {
-		return x.AuthenticationDatabase
+		return x.TlsKey
 	}
 	return ""
 }
 
-func (x *AddQANMongoDBProfilerAgentParams) GetLogLevel() LogLevel {
+func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetLogLevel() LogLevel {
 	if x != nil {
 		return x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-type ChangeQANMongoDBProfilerAgentParams struct {
+type ChangeQANPostgreSQLPgStatMonitorAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
@@ -8232,26 +11016,26 @@ type ChangeQANMongoDBProfilerAgentParams struct {
 	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
 	// Metrics resolution for this agent.
 	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	// MongoDB username for getting profile data.
+	// PostgreSQL username for getting pg stat monitor data.
 	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// MongoDB password for getting profile data.
+	// PostgreSQL password for getting pg stat monitor data.
 	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
 	// Use TLS for database connections.
 	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
 	TlsSkipVerify *bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
-	// Client certificate and key.
-	TlsCertificateKey *string `protobuf:"bytes,9,opt,name=tls_certificate_key,json=tlsCertificateKey,proto3,oneof" json:"tls_certificate_key,omitempty"`
-	// Password for decrypting tls_certificate_key.
-	TlsCertificateKeyFilePassword *string `protobuf:"bytes,10,opt,name=tls_certificate_key_file_password,json=tlsCertificateKeyFilePassword,proto3,oneof" json:"tls_certificate_key_file_password,omitempty"`
-	// Certificate Authority certificate chain.
-	TlsCa *string `protobuf:"bytes,11,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
 	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength *int32 `protobuf:"varint,12,opt,name=max_query_length,json=maxQueryLength,proto3,oneof" json:"max_query_length,omitempty"`
-	// Authentication mechanism.
-	AuthenticationMechanism *string `protobuf:"bytes,13,opt,name=authentication_mechanism,json=authenticationMechanism,proto3,oneof" json:"authentication_mechanism,omitempty"`
-	// Authentication database.
-	AuthenticationDatabase *string `protobuf:"bytes,14,opt,name=authentication_database,json=authenticationDatabase,proto3,oneof" json:"authentication_database,omitempty"`
+	MaxQueryLength *int32 `protobuf:"varint,9,opt,name=max_query_length,json=maxQueryLength,proto3,oneof" json:"max_query_length,omitempty"`
+	// Disable query examples.
+	DisableQueryExamples *bool `protobuf:"varint,10,opt,name=disable_query_examples,json=disableQueryExamples,proto3,oneof" json:"disable_query_examples,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing *bool `protobuf:"varint,11,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3,oneof" json:"disable_comments_parsing,omitempty"`
+	// TLS CA certificate.
+	TlsCa *string `protobuf:"bytes,12,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
+	// TLS Certificate.
+	TlsCert *string `protobuf:"bytes,13,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
+	// TLS Certificate Key.
+	TlsKey *string `protobuf:"bytes,14,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
 	// Log level for exporter.
 	LogLevel *LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
 	// Skip connection check.
@@ -8260,21 +11044,21 @@ type ChangeQANMongoDBProfilerAgentParams struct {
 	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) Reset() {
-	*x = ChangeQANMongoDBProfilerAgentParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[47]
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) Reset() {
+	*x = ChangeQANPostgreSQLPgStatMonitorAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) String() string {
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeQANMongoDBProfilerAgentParams) ProtoMessage() {}
+func (*ChangeQANPostgreSQLPgStatMonitorAgentParams) ProtoMessage() {}
 
-func (x *ChangeQANMongoDBProfilerAgentParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[47]
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8285,176 +11069,174 @@ func (x *ChangeQANMongoDBProfilerAgentParams) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeQANMongoDBProfilerAgentParams.ProtoReflect.Descriptor instead.
-func (*ChangeQANMongoDBProfilerAgentParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use ChangeQANPostgreSQLPgStatMonitorAgentParams.ProtoReflect.Descriptor instead.
+func (*ChangeQANPostgreSQLPgStatMonitorAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) GetEnable() bool {
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
 	}
 	return false
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) GetCustomLabels() *common.StringMap {
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) GetEnablePushMetrics() bool {
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetEnablePushMetrics() bool {
 	if x != nil && x.EnablePushMetrics != nil {
 		return *x.EnablePushMetrics
 	}
 	return false
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) GetMetricsResolutions() *common.MetricsResolutions {
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetMetricsResolutions() *common.MetricsResolutions {
 	if x != nil {
 		return x.MetricsResolutions
 	}
 	return nil
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) GetUsername() string {
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetUsername() string {
 	if x != nil && x.Username != nil {
 		return *x.Username
 	}
 	return ""
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) GetPassword() string {
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetPassword() string {
 	if x != nil && x.Password != nil {
 		return *x.Password
 	}
 	return ""
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) GetTls() bool {
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetTls() bool {
 	if x != nil && x.Tls != nil {
 		return *x.Tls
 	}
 	return false
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) GetTlsSkipVerify() bool {
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetTlsSkipVerify() bool {
 	if x != nil && x.TlsSkipVerify != nil {
 		return *x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) GetTlsCertificateKey() string {
-	if x != nil && x.TlsCertificateKey != nil {
-		return *x.TlsCertificateKey
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetMaxQueryLength() int32 {
+	if x != nil && x.MaxQueryLength != nil {
+		return *x.MaxQueryLength
 	}
-	return ""
+	return 0
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) GetTlsCertificateKeyFilePassword() string {
-	if x != nil && x.TlsCertificateKeyFilePassword != nil {
-		return *x.TlsCertificateKeyFilePassword
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetDisableQueryExamples() bool {
+	if x != nil && x.DisableQueryExamples != nil {
+		return *x.DisableQueryExamples
 	}
-	return ""
+	return false
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) GetTlsCa() string {
-	if x != nil && x.TlsCa != nil {
-		return *x.TlsCa
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetDisableCommentsParsing() bool {
+	if x != nil && x.DisableCommentsParsing != nil {
+		return *x.DisableCommentsParsing
 	}
-	return ""
+	return false
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) GetMaxQueryLength() int32 {
-	if x != nil && x.MaxQueryLength != nil {
-		return *x.MaxQueryLength
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetTlsCa() string {
+	if x != nil && x.TlsCa != nil {
+		return *x.TlsCa
 	}
-	return 0
+	return ""
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) GetAuthenticationMechanism() string {
-	if x != nil && x.AuthenticationMechanism != nil {
-		return *x.AuthenticationMechanism
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetTlsCert() string {
+	if x != nil && x.TlsCert != nil {
+		return *x.TlsCert
 	}
 	return ""
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) GetAuthenticationDatabase() string {
-	if x != nil && x.AuthenticationDatabase != nil {
-		return *x.AuthenticationDatabase
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetTlsKey() string {
+	if x != nil && x.TlsKey != nil {
+		return *x.TlsKey
 	}
 	return ""
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) GetLogLevel() LogLevel {
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetLogLevel() LogLevel {
 	if x != nil && x.LogLevel != nil {
 		return *x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *ChangeQANMongoDBProfilerAgentParams) GetSkipConnectionCheck() bool {
+func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil && x.SkipConnectionCheck != nil {
 		return *x.SkipConnectionCheck
 	}
 	return false
 }
 
-type AddQANMongoDBMongologAgentParams struct {
+type AddQANPostgreSQLLogAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The pmm-agent identifier which runs this instance.
 	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
 	// Service identifier.
 	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// MongoDB username for getting profile data.
+	// PostgreSQL username for getting server log settings.
 	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	// MongoDB password for getting profile data.
+	// PostgreSQL password for getting server log settings.
 	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 	// Use TLS for database connections.
 	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
 	TlsSkipVerify bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
-	// Client certificate and key.
-	TlsCertificateKey string `protobuf:"bytes,7,opt,name=tls_certificate_key,json=tlsCertificateKey,proto3" json:"tls_certificate_key,omitempty"`
-	// Password for decrypting tls_certificate_key.
-	TlsCertificateKeyFilePassword string `protobuf:"bytes,8,opt,name=tls_certificate_key_file_password,json=tlsCertificateKeyFilePassword,proto3" json:"tls_certificate_key_file_password,omitempty"`
-	// Certificate Authority certificate chain.
-	TlsCa string `protobuf:"bytes,9,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
 	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength int32 `protobuf:"varint,10,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
+	MaxQueryLength int32 `protobuf:"varint,7,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
+	// Disable query examples.
+	DisableQueryExamples bool `protobuf:"varint,8,opt,name=disable_query_examples,json=disableQueryExamples,proto3" json:"disable_query_examples,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,11,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CustomLabels map[string]string `protobuf:"bytes,9,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Skip connection check.
-	SkipConnectionCheck bool `protobuf:"varint,12,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
-	// Authentication mechanism.
-	// See https://docs.mongodb.com/manual/reference/connection-string/#mongodb-urioption-urioption.authMechanism
-	// for details.
-	AuthenticationMechanism string `protobuf:"bytes,13,opt,name=authentication_mechanism,json=authenticationMechanism,proto3" json:"authentication_mechanism,omitempty"`
-	// Authentication database.
-	AuthenticationDatabase string `protobuf:"bytes,14,opt,name=authentication_database,json=authenticationDatabase,proto3" json:"authentication_database,omitempty"`
+	SkipConnectionCheck bool `protobuf:"varint,10,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `protobuf:"varint,11,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3" json:"disable_comments_parsing,omitempty"`
+	// TLS CA certificate.
+	TlsCa string `protobuf:"bytes,12,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// TLS Certifcate.
+	TlsCert string `protobuf:"bytes,13,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
+	// TLS Certificate Key.
+	TlsKey string `protobuf:"bytes,14,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
 	// Log level for agent.
 	LogLevel      LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddQANMongoDBMongologAgentParams) Reset() {
-	*x = AddQANMongoDBMongologAgentParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[48]
+func (x *AddQANPostgreSQLLogAgentParams) Reset() {
+	*x = AddQANPostgreSQLLogAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddQANMongoDBMongologAgentParams) String() string {
+func (x *AddQANPostgreSQLLogAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddQANMongoDBMongologAgentParams) ProtoMessage() {}
+func (*AddQANPostgreSQLLogAgentParams) ProtoMessage() {}
 
-func (x *AddQANMongoDBMongologAgentParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[48]
+func (x *AddQANPostgreSQLLogAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8465,169 +11247,165 @@ func (x *AddQANMongoDBMongologAgentParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddQANMongoDBMongologAgentParams.ProtoReflect.Descriptor instead.
-func (*AddQANMongoDBMongologAgentParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use AddQANPostgreSQLLogAgentParams.ProtoReflect.Descriptor instead.
+func (*AddQANPostgreSQLLogAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *AddQANMongoDBMongologAgentParams) GetPmmAgentId() string {
+func (x *AddQANPostgreSQLLogAgentParams) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *AddQANMongoDBMongologAgentParams) GetServiceId() string {
+func (x *AddQANPostgreSQLLogAgentParams) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *AddQANMongoDBMongologAgentParams) GetUsername() string {
+func (x *AddQANPostgreSQLLogAgentParams) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *AddQANMongoDBMongologAgentParams) GetPassword() string {
+func (x *AddQANPostgreSQLLogAgentParams) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-func (x *AddQANMongoDBMongologAgentParams) GetTls() bool {
+func (x *AddQANPostgreSQLLogAgentParams) GetTls() bool {
 	if x != nil {
 		return x.Tls
 	}
 	return false
 }
 
-func (x *AddQANMongoDBMongologAgentParams) GetTlsSkipVerify() bool {
+func (x *AddQANPostgreSQLLogAgentParams) GetTlsSkipVerify() bool {
 	if x != nil {
 		return x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *AddQANMongoDBMongologAgentParams) GetTlsCertificateKey() string {
-	if x != nil {
-		return x.TlsCertificateKey
-	}
-	return ""
-}
-
-func (x *AddQANMongoDBMongologAgentParams) GetTlsCertificateKeyFilePassword() string {
-	if x != nil {
-		return x.TlsCertificateKeyFilePassword
-	}
-	return ""
-}
-
-func (x *AddQANMongoDBMongologAgentParams) GetTlsCa() string {
+func (x *AddQANPostgreSQLLogAgentParams) GetMaxQueryLength() int32 {
 	if x != nil {
-		return x.TlsCa
+		return x.MaxQueryLength
 	}
-	return ""
+	return 0
 }
 
-func (x *AddQANMongoDBMongologAgentParams) GetMaxQueryLength() int32 {
+func (x *AddQANPostgreSQLLogAgentParams) GetDisableQueryExamples() bool {
 	if x != nil {
-		return x.MaxQueryLength
+		return x.DisableQueryExamples
 	}
-	return 0
+	return false
 }
 
-func (x *AddQANMongoDBMongologAgentParams) GetCustomLabels() map[string]string {
+func (x *AddQANPostgreSQLLogAgentParams) GetCustomLabels() map[string]string {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *AddQANMongoDBMongologAgentParams) GetSkipConnectionCheck() bool {
+func (x *AddQANPostgreSQLLogAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil {
 		return x.SkipConnectionCheck
 	}
 	return false
 }
 
-func (x *AddQANMongoDBMongologAgentParams) GetAuthenticationMechanism() string {
+func (x *AddQANPostgreSQLLogAgentParams) GetDisableCommentsParsing() bool {
 	if x != nil {
-		return x.AuthenticationMechanism
+		return x.DisableCommentsParsing
+	}
+	return false
+}
+
+func (x *AddQANPostgreSQLLogAgentParams) GetTlsCa() string {
+	if x != nil {
+		return x.TlsCa
 	}
 	return ""
 }
 
-func (x *AddQANMongoDBMongologAgentParams) GetAuthenticationDatabase() string {
+func (x *AddQANPostgreSQLLogAgentParams) GetTlsCert() string {
 	if x != nil {
-		return x.AuthenticationDatabase
+		return x.TlsCert
 	}
 	return ""
 }
 
-func (x *AddQANMongoDBMongologAgentParams) GetLogLevel() LogLevel {
+func (x *AddQANPostgreSQLLogAgentParams) GetTlsKey() string {
+	if x != nil {
+		return x.TlsKey
+	}
+	return ""
+}
+
+func (x *AddQANPostgreSQLLogAgentParams) GetLogLevel() LogLevel {
 	if x != nil {
 		return x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-type ChangeQANMongoDBMongologAgentParams struct {
+type ChangeQANPostgreSQLLogAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
 	// Replace all custom user-assigned labels.
 	CustomLabels *common.StringMap `protobuf:"bytes,2,opt,name=custom_labels,json=customLabels,proto3,oneof" json:"custom_labels,omitempty"`
-	// Enables push metrics with vmagent.
-	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
-	// Metrics resolution for this agent.
-	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	// MongoDB username for getting mongolog data.
-	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// MongoDB password for getting mongolog data.
-	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// PostgreSQL username for getting server log settings.
+	Username *string `protobuf:"bytes,3,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// PostgreSQL password for getting server log settings.
+	Password *string `protobuf:"bytes,4,opt,name=password,proto3,oneof" json:"password,omitempty"`
 	// Use TLS for database connections.
-	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
+	Tls *bool `protobuf:"varint,5,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
-	TlsSkipVerify *bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
-	// Client certificate and key.
-	TlsCertificateKey *string `protobuf:"bytes,9,opt,name=tls_certificate_key,json=tlsCertificateKey,proto3,oneof" json:"tls_certificate_key,omitempty"`
-	// Password for decrypting tls_certificate_key.
-	TlsCertificateKeyFilePassword *string `protobuf:"bytes,10,opt,name=tls_certificate_key_file_password,json=tlsCertificateKeyFilePassword,proto3,oneof" json:"tls_certificate_key_file_password,omitempty"`
-	// Certificate Authority certificate chain.
-	TlsCa *string `protobuf:"bytes,11,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
+	TlsSkipVerify *bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
 	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength *int32 `protobuf:"varint,12,opt,name=max_query_length,json=maxQueryLength,proto3,oneof" json:"max_query_length,omitempty"`
-	// Authentication mechanism.
-	AuthenticationMechanism *string `protobuf:"bytes,13,opt,name=authentication_mechanism,json=authenticationMechanism,proto3,oneof" json:"authentication_mechanism,omitempty"`
-	// Authentication database.
-	AuthenticationDatabase *string `protobuf:"bytes,14,opt,name=authentication_database,json=authenticationDatabase,proto3,oneof" json:"authentication_database,omitempty"`
-	// Log level for exporter.
-	LogLevel *LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
+	MaxQueryLength *int32 `protobuf:"varint,7,opt,name=max_query_length,json=maxQueryLength,proto3,oneof" json:"max_query_length,omitempty"`
+	// Disable query examples.
+	DisableQueryExamples *bool `protobuf:"varint,8,opt,name=disable_query_examples,json=disableQueryExamples,proto3,oneof" json:"disable_query_examples,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing *bool `protobuf:"varint,9,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3,oneof" json:"disable_comments_parsing,omitempty"`
+	// TLS CA certificate.
+	TlsCa *string `protobuf:"bytes,10,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
+	// TLS Certificate.
+	TlsCert *string `protobuf:"bytes,11,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
+	// TLS Certificate Key.
+	TlsKey *string `protobuf:"bytes,12,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
+	// Log level for agent.
+	LogLevel *LogLevel `protobuf:"varint,13,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
 	// Skip connection check.
-	SkipConnectionCheck *bool `protobuf:"varint,16,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
+	SkipConnectionCheck *bool `protobuf:"varint,14,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ChangeQANMongoDBMongologAgentParams) Reset() {
-	*x = ChangeQANMongoDBMongologAgentParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[49]
+func (x *ChangeQANPostgreSQLLogAgentParams) Reset() {
+	*x = ChangeQANPostgreSQLLogAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeQANMongoDBMongologAgentParams) String() string {
+func (x *ChangeQANPostgreSQLLogAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeQANMongoDBMongologAgentParams) ProtoMessage() {}
+func (*ChangeQANPostgreSQLLogAgentParams) ProtoMessage() {}
 
-func (x *ChangeQANMongoDBMongologAgentParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[49]
+func (x *ChangeQANPostgreSQLLogAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8638,172 +11416,150 @@ func (x *ChangeQANMongoDBMongologAgentParams) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeQANMongoDBMongologAgentParams.ProtoReflect.Descriptor instead.
-func (*ChangeQANMongoDBMongologAgentParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use ChangeQANPostgreSQLLogAgentParams.ProtoReflect.Descriptor instead.
+func (*ChangeQANPostgreSQLLogAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ChangeQANMongoDBMongologAgentParams) GetEnable() bool {
+func (x *ChangeQANPostgreSQLLogAgentParams) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
 	}
 	return false
 }
 
-func (x *ChangeQANMongoDBMongologAgentParams) GetCustomLabels() *common.StringMap {
+func (x *ChangeQANPostgreSQLLogAgentParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangeQANMongoDBMongologAgentParams) GetEnablePushMetrics() bool {
-	if x != nil && x.EnablePushMetrics != nil {
-		return *x.EnablePushMetrics
-	}
-	return false
-}
-
-func (x *ChangeQANMongoDBMongologAgentParams) GetMetricsResolutions() *common.MetricsResolutions {
-	if x != nil {
-		return x.MetricsResolutions
-	}
-	return nil
-}
-
-func (x *ChangeQANMongoDBMongologAgentParams) GetUsername() string {
+func (x *ChangeQANPostgreSQLLogAgentParams) GetUsername() string {
 	if x != nil && x.Username != nil {
 		return *x.Username
 	}
 	return ""
 }
 
-func (x *ChangeQANMongoDBMongologAgentParams) GetPassword() string {
+func (x *ChangeQANPostgreSQLLogAgentParams) GetPassword() string {
 	if x != nil && x.Password != nil {
 		return *x.Password
 	}
 	return ""
 }
 
-func (x *ChangeQANMongoDBMongologAgentParams) GetTls() bool {
+func (x *ChangeQANPostgreSQLLogAgentParams) GetTls() bool {
 	if x != nil && x.Tls != nil {
 		return *x.Tls
 	}
 	return false
 }
 
-func (x *ChangeQANMongoDBMongologAgentParams) GetTlsSkipVerify() bool {
+func (x *ChangeQANPostgreSQLLogAgentParams) GetTlsSkipVerify() bool {
 	if x != nil && x.TlsSkipVerify != nil {
 		return *x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *ChangeQANMongoDBMongologAgentParams) GetTlsCertificateKey() string {
-	if x != nil && x.TlsCertificateKey != nil {
-		return *x.TlsCertificateKey
+func (x *ChangeQANPostgreSQLLogAgentParams) GetMaxQueryLength() int32 {
+	if x != nil && x.MaxQueryLength != nil {
+		return *x.MaxQueryLength
 	}
-	return ""
+	return 0
 }
 
-func (x *ChangeQANMongoDBMongologAgentParams) GetTlsCertificateKeyFilePassword() string {
-	if x != nil && x.TlsCertificateKeyFilePassword != nil {
-		return *x.TlsCertificateKeyFilePassword
+func (x *ChangeQANPostgreSQLLogAgentParams) GetDisableQueryExamples() bool {
+	if x != nil && x.DisableQueryExamples != nil {
+		return *x.DisableQueryExamples
 	}
-	return ""
+	return false
 }
 
-func (x *ChangeQANMongoDBMongologAgentParams) GetTlsCa() string {
-	if x != nil && x.TlsCa != nil {
-		return *x.TlsCa
+func (x *ChangeQANPostgreSQLLogAgentParams) GetDisableCommentsParsing() bool {
+	if x != nil && x.DisableCommentsParsing != nil {
+		return *x.DisableCommentsParsing
 	}
-	return ""
+	return false
 }
 
-func (x *ChangeQANMongoDBMongologAgentParams) GetMaxQueryLength() int32 {
-	if x != nil && x.MaxQueryLength != nil {
-		return *x.MaxQueryLength
+func (x *ChangeQANPostgreSQLLogAgentParams) GetTlsCa() string {
+	if x != nil && x.TlsCa != nil {
+		return *x.TlsCa
 	}
-	return 0
+	return ""
 }
 
-func (x *ChangeQANMongoDBMongologAgentParams) GetAuthenticationMechanism() string {
-	if x != nil && x.AuthenticationMechanism != nil {
-		return *x.AuthenticationMechanism
+func (x *ChangeQANPostgreSQLLogAgentParams) GetTlsCert() string {
+	if x != nil && x.TlsCert != nil {
+		return *x.TlsCert
 	}
 	return ""
 }
 
-func (x *ChangeQANMongoDBMongologAgentParams) GetAuthenticationDatabase() string {
-	if x != nil && x.AuthenticationDatabase != nil {
-		return *x.AuthenticationDatabase
+func (x *ChangeQANPostgreSQLLogAgentParams) GetTlsKey() string {
+	if x != nil && x.TlsKey != nil {
+		return *x.TlsKey
 	}
 	return ""
 }
 
-func (x *ChangeQANMongoDBMongologAgentParams) GetLogLevel() LogLevel {
+func (x *ChangeQANPostgreSQLLogAgentParams) GetLogLevel() LogLevel {
 	if x != nil && x.LogLevel != nil {
 		return *x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *ChangeQANMongoDBMongologAgentParams) GetSkipConnectionCheck() bool {
+func (x *ChangeQANPostgreSQLLogAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil && x.SkipConnectionCheck != nil {
 		return *x.SkipConnectionCheck
 	}
 	return false
 }
 
-type AddQANPostgreSQLPgStatementsAgentParams struct {
+type AddRDSExporterParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The pmm-agent identifier which runs this instance.
 	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
-	// Service identifier.
-	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// PostgreSQL username for getting pg stat statements data.
-	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	// PostgreSQL password for getting pg stat statements data.
-	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	// Use TLS for database connections.
-	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
-	// Skip TLS certificate and hostname validation.
-	TlsSkipVerify bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Node identifier.
+	NodeId string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// AWS Access Key.
+	AwsAccessKey string `protobuf:"bytes,3,opt,name=aws_access_key,json=awsAccessKey,proto3" json:"aws_access_key,omitempty"`
+	// AWS Secret Key.
+	AwsSecretKey string `protobuf:"bytes,4,opt,name=aws_secret_key,json=awsSecretKey,proto3" json:"aws_secret_key,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,7,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CustomLabels map[string]string `protobuf:"bytes,5,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Skip connection check.
-	SkipConnectionCheck bool `protobuf:"varint,8,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing bool `protobuf:"varint,9,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3" json:"disable_comments_parsing,omitempty"`
-	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength int32 `protobuf:"varint,10,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
-	// TLS CA certificate.
-	TlsCa string `protobuf:"bytes,11,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
-	// TLS Certifcate.
-	TlsCert string `protobuf:"bytes,12,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
-	// TLS Certificate Key.
-	TlsKey string `protobuf:"bytes,13,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
+	SkipConnectionCheck bool `protobuf:"varint,6,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
+	// Disable basic metrics.
+	DisableBasicMetrics bool `protobuf:"varint,7,opt,name=disable_basic_metrics,json=disableBasicMetrics,proto3" json:"disable_basic_metrics,omitempty"`
+	// Disable enhanced metrics.
+	DisableEnhancedMetrics bool `protobuf:"varint,8,opt,name=disable_enhanced_metrics,json=disableEnhancedMetrics,proto3" json:"disable_enhanced_metrics,omitempty"`
+	// Enables push metrics mode for exporter.
+	PushMetrics bool `protobuf:"varint,9,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
 	// Log level for exporter.
-	LogLevel      LogLevel `protobuf:"varint,14,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	LogLevel      LogLevel `protobuf:"varint,10,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddQANPostgreSQLPgStatementsAgentParams) Reset() {
-	*x = AddQANPostgreSQLPgStatementsAgentParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[50]
+func (x *AddRDSExporterParams) Reset() {
+	*x = AddRDSExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddQANPostgreSQLPgStatementsAgentParams) String() string {
+func (x *AddRDSExporterParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddQANPostgreSQLPgStatementsAgentParams) ProtoMessage() {}
+func (*AddRDSExporterParams) ProtoMessage() {}
 
-func (x *AddQANPostgreSQLPgStatementsAgentParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[50]
+func (x *AddRDSExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8814,110 +11570,82 @@ func (x *AddQANPostgreSQLPgStatementsAgentParams) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddQANPostgreSQLPgStatementsAgentParams.ProtoReflect.Descriptor instead.
-func (*AddQANPostgreSQLPgStatementsAgentParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use AddRDSExporterParams.ProtoReflect.Descriptor instead.
+func (*AddRDSExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *AddQANPostgreSQLPgStatementsAgentParams) GetPmmAgentId() string {
+func (x *AddRDSExporterParams) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *AddQANPostgreSQLPgStatementsAgentParams) GetServiceId() string {
+func (x *AddRDSExporterParams) GetNodeId() string {
 	if x != nil {
-		return x.ServiceId
+		return x.NodeId
 	}
 	return ""
 }
 
-func (x *AddQANPostgreSQLPgStatementsAgentParams) GetUsername() string {
+func (x *AddRDSExporterParams) GetAwsAccessKey() string {
 	if x != nil {
-		return x.Username
+		return x.AwsAccessKey
 	}
 	return ""
 }
 
-func (x *AddQANPostgreSQLPgStatementsAgentParams) GetPassword() string {
+func (x *AddRDSExporterParams) GetAwsSecretKey() string {
 	if x != nil {
-		return x.Password
+		return x.AwsSecretKey
 	}
 	return ""
 }
 
-func (x *AddQANPostgreSQLPgStatementsAgentParams) GetTls() bool {
-	if x != nil {
-		return x.Tls
-	}
-	return false
-}
-
-func (x *AddQANPostgreSQLPgStatementsAgentParams) GetTlsSkipVerify() bool {
-	if x != nil {
-		return x.TlsSkipVerify
-	}
-	return false
-}
-
-func (x *AddQANPostgreSQLPgStatementsAgentParams) GetCustomLabels() map[string]string {
+func (x *AddRDSExporterParams) GetCustomLabels() map[string]string {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *AddQANPostgreSQLPgStatementsAgentParams) GetSkipConnectionCheck() bool {
+func (x *AddRDSExporterParams) GetSkipConnectionCheck() bool {
 	if x != nil {
 		return x.SkipConnectionCheck
 	}
 	return false
 }
 
-func (x *AddQANPostgreSQLPgStatementsAgentParams) GetDisableCommentsParsing() bool {
+func (x *AddRDSExporterParams) GetDisableBasicMetrics() bool {
 	if x != nil {
-		return x.DisableCommentsParsing
+		return x.DisableBasicMetrics
 	}
 	return false
 }
 
-func (x *AddQANPostgreSQLPgStatementsAgentParams) GetMaxQueryLength() int32 {
-	if x != nil {
-		return x.MaxQueryLength
-	}
-	return 0
-}
-
-func (x *AddQANPostgreSQLPgStatementsAgentParams) GetTlsCa() string {
-	if x != nil {
-		return x.TlsCa
-	}
-	return ""
-}
-
-func (x *AddQANPostgreSQLPgStatementsAgentParams) GetTlsCert() string {
+func (x *AddRDSExporterParams) GetDisableEnhancedMetrics() bool {
 	if x != nil {
-		return x.TlsCert
+		return x.DisableEnhancedMetrics
 	}
-	return ""
+	return false
 }
 
-func (x *AddQANPostgreSQLPgStatementsAgentParams) GetTlsKey() string {
+func (x *AddRDSExporterParams) GetPushMetrics() bool {
 	if x != nil {
-		return x.TlsKey
+		return x.PushMetrics
 	}
-	return ""
+	return false
 }
 
-func (x *AddQANPostgreSQLPgStatementsAgentParams) GetLogLevel() LogLevel {
+func (x *AddRDSExporterParams) GetLogLevel() LogLevel {
 	if x != nil {
 		return x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-type ChangeQANPostgreSQLPgStatementsAgentParams struct {
+type ChangeRDSExporterParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
@@ -8927,47 +11655,35 @@ type ChangeQANPostgreSQLPgStatementsAgentParams struct {
 	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
 	// Metrics resolution for this agent.
 	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	// PostgreSQL username for getting pg stat statements data.
-	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// PostgreSQL password for getting pg stat statements data.
-	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
-	// Use TLS for database connections.
-	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
-	// Skip TLS certificate and hostname validation.
-	TlsSkipVerify *bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing *bool `protobuf:"varint,9,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3,oneof" json:"disable_comments_parsing,omitempty"`
-	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength *int32 `protobuf:"varint,10,opt,name=max_query_length,json=maxQueryLength,proto3,oneof" json:"max_query_length,omitempty"`
-	// TLS CA certificate.
-	TlsCa *string `protobuf:"bytes,11,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
-	// TLS Certificate.
-	TlsCert *string `protobuf:"bytes,12,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
-	// TLS Certificate Key.
-	TlsKey *string `protobuf:"bytes,13,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
+	// AWS Access Key.
+	AwsAccessKey *string `protobuf:"bytes,5,opt,name=aws_access_key,json=awsAccessKey,proto3,oneof" json:"aws_access_key,omitempty"`
+	// AWS Secret Key.
+	AwsSecretKey *string `protobuf:"bytes,6,opt,name=aws_secret_key,json=awsSecretKey,proto3,oneof" json:"aws_secret_key,omitempty"`
+	// Disable basic metrics.
+	DisableBasicMetrics *bool `protobuf:"varint,7,opt,name=disable_basic_metrics,json=disableBasicMetrics,proto3,oneof" json:"disable_basic_metrics,omitempty"`
+	// Disable enhanced metrics.
+	DisableEnhancedMetrics *bool `protobuf:"varint,8,opt,name=disable_enhanced_metrics,json=disableEnhancedMetrics,proto3,oneof" json:"disable_enhanced_metrics,omitempty"`
 	// Log level for exporter.
-	LogLevel *LogLevel `protobuf:"varint,14,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
-	// Skip connection check.
-	SkipConnectionCheck *bool `protobuf:"varint,15,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	LogLevel      *LogLevel `protobuf:"varint,9,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) Reset() {
-	*x = ChangeQANPostgreSQLPgStatementsAgentParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[51]
+func (x *ChangeRDSExporterParams) Reset() {
+	*x = ChangeRDSExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) String() string {
+func (x *ChangeRDSExporterParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeQANPostgreSQLPgStatementsAgentParams) ProtoMessage() {}
+func (*ChangeRDSExporterParams) ProtoMessage() {}
 
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[51]
+func (x *ChangeRDSExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8978,167 +11694,115 @@ func (x *ChangeQANPostgreSQLPgStatementsAgentParams) ProtoReflect() protoreflect
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeQANPostgreSQLPgStatementsAgentParams.ProtoReflect.Descriptor instead.
-func (*ChangeQANPostgreSQLPgStatementsAgentParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use ChangeRDSExporterParams.ProtoReflect.Descriptor instead.
+func (*ChangeRDSExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetEnable() bool {
+func (x *ChangeRDSExporterParams) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
 	}
 	return false
 }
 
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetCustomLabels() *common.StringMap {
+func (x *ChangeRDSExporterParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetEnablePushMetrics() bool {
+func (x *ChangeRDSExporterParams) GetEnablePushMetrics() bool {
 	if x != nil && x.EnablePushMetrics != nil {
 		return *x.EnablePushMetrics
 	}
 	return false
 }
 
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetMetricsResolutions() *common.MetricsResolutions {
+func (x *ChangeRDSExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
 	if x != nil {
 		return x.MetricsResolutions
 	}
 	return nil
 }
 
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetUsername() string {
-	if x != nil && x.Username != nil {
-		return *x.Username
+func (x *ChangeRDSExporterParams) GetAwsAccessKey() string {
+	if x != nil && x.AwsAccessKey != nil {
+		return *x.AwsAccessKey
 	}
 	return ""
 }
 
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
+func (x *ChangeRDSExporterParams) GetAwsSecretKey() string {
+	if x != nil && x.AwsSecretKey != nil {
+		return *x.AwsSecretKey
 	}
 	return ""
 }
 
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetTls() bool {
-	if x != nil && x.Tls != nil {
-		return *x.Tls
-	}
-	return false
-}
-
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetTlsSkipVerify() bool {
-	if x != nil && x.TlsSkipVerify != nil {
-		return *x.TlsSkipVerify
+func (x *ChangeRDSExporterParams) GetDisableBasicMetrics() bool {
+	if x != nil && x.DisableBasicMetrics != nil {
+		return *x.DisableBasicMetrics
 	}
 	return false
 }
 
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetDisableCommentsParsing() bool {
-	if x != nil && x.DisableCommentsParsing != nil {
-		return *x.DisableCommentsParsing
+func (x *ChangeRDSExporterParams) GetDisableEnhancedMetrics() bool {
+	if x != nil && x.DisableEnhancedMetrics != nil {
+		return *x.DisableEnhancedMetrics
 	}
 	return false
 }
 
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetMaxQueryLength() int32 {
-	if x != nil && x.MaxQueryLength != nil {
-		return *x.MaxQueryLength
-	}
-	return 0
-}
-
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetTlsCa() string {
-	if x != nil && x.TlsCa != nil {
-		return *x.TlsCa
-	}
-	return ""
-}
-
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetTlsCert() string {
-	if x != nil && x.TlsCert != nil {
-		return *x.TlsCert
-	}
-	return ""
-}
-
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetTlsKey() string {
-	if x != nil && x.TlsKey != nil {
-		return *x.TlsKey
-	}
-	return ""
-}
-
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetLogLevel() LogLevel {
+func (x *ChangeRDSExporterParams) GetLogLevel() LogLevel {
 	if x != nil && x.LogLevel != nil {
 		return *x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *ChangeQANPostgreSQLPgStatementsAgentParams) GetSkipConnectionCheck() bool {
-	if x != nil && x.SkipConnectionCheck != nil {
-		return *x.SkipConnectionCheck
-	}
-	return false
-}
-
-type AddQANPostgreSQLPgStatMonitorAgentParams struct {
+type AddExternalExporterParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The pmm-agent identifier which runs this instance.
-	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
+	// The node identifier where this instance is run.
+	RunsOnNodeId string `protobuf:"bytes,1,opt,name=runs_on_node_id,json=runsOnNodeId,proto3" json:"runs_on_node_id,omitempty"`
 	// Service identifier.
 	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// PostgreSQL username for getting pg stat monitor data.
+	// HTTP basic auth username for collecting metrics.
 	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	// PostgreSQL password for getting pg stat monitor data.
+	// HTTP basic auth password for collecting metrics.
 	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	// Use TLS for database connections.
-	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
-	// Skip TLS certificate and hostname validation.
-	TlsSkipVerify bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
-	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength int32 `protobuf:"varint,7,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
-	// Disable query examples.
-	DisableQueryExamples bool `protobuf:"varint,8,opt,name=disable_query_examples,json=disableQueryExamples,proto3" json:"disable_query_examples,omitempty"`
+	// Scheme to generate URI to exporter metrics endpoints(default: http).
+	Scheme string `protobuf:"bytes,6,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	// Path under which metrics are exposed, used to generate URI(default: /metrics).
+	MetricsPath string `protobuf:"bytes,7,opt,name=metrics_path,json=metricsPath,proto3" json:"metrics_path,omitempty"`
+	// Listen port for scraping metrics.
+	ListenPort uint32 `protobuf:"varint,8,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,9,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	// Skip connection check.
-	SkipConnectionCheck bool `protobuf:"varint,10,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing bool `protobuf:"varint,11,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3" json:"disable_comments_parsing,omitempty"`
-	// TLS CA certificate.
-	TlsCa string `protobuf:"bytes,12,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
-	// TLS Certifcate.
-	TlsCert string `protobuf:"bytes,13,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
-	// TLS Certificate Key.
-	TlsKey string `protobuf:"bytes,14,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
-	// Log level for exporter.
-	LogLevel      LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	CustomLabels map[string]string `protobuf:"bytes,10,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Enables push metrics mode for exporter.
+	PushMetrics bool `protobuf:"varint,11,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
+	// Skip TLS certificate and hostname verification.
+	TlsSkipVerify bool `protobuf:"varint,12,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) Reset() {
-	*x = AddQANPostgreSQLPgStatMonitorAgentParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[52]
+func (x *AddExternalExporterParams) Reset() {
+	*x = AddExternalExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) String() string {
+func (x *AddExternalExporterParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddQANPostgreSQLPgStatMonitorAgentParams) ProtoMessage() {}
+func (*AddExternalExporterParams) ProtoMessage() {}
 
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[52]
+func (x *AddExternalExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9149,117 +11813,82 @@ func (x *AddQANPostgreSQLPgStatMonitorAgentParams) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddQANPostgreSQLPgStatMonitorAgentParams.ProtoReflect.Descriptor instead.
-func (*AddQANPostgreSQLPgStatMonitorAgentParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use AddExternalExporterParams.ProtoReflect.Descriptor instead.
+func (*AddExternalExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetPmmAgentId() string {
+func (x *AddExternalExporterParams) GetRunsOnNodeId() string {
 	if x != nil {
-		return x.PmmAgentId
+		return x.RunsOnNodeId
 	}
 	return ""
 }
 
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetServiceId() string {
+func (x *AddExternalExporterParams) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetUsername() string {
+func (x *AddExternalExporterParams) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetPassword() string {
+func (x *AddExternalExporterParams) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetTls() bool {
+func (x *AddExternalExporterParams) GetScheme() string {
 	if x != nil {
-		return x.Tls
+		return x.Scheme
 	}
-	return false
+	return ""
 }
 
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetTlsSkipVerify() bool {
+func (x *AddExternalExporterParams) GetMetricsPath() string {
 	if x != nil {
-		return x.TlsSkipVerify
+		return x.MetricsPath
 	}
-	return false
+	return ""
 }
 
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetMaxQueryLength() int32 {
+func (x *AddExternalExporterParams) GetListenPort() uint32 {
 	if x != nil {
-		return x.MaxQueryLength
+		return x.ListenPort
 	}
 	return 0
 }
 
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetDisableQueryExamples() bool {
-	if x != nil {
-		return x.DisableQueryExamples
-	}
-	return false
-}
-
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetCustomLabels() map[string]string {
-	if x != nil {
-		return x.CustomLabels
-	}
-	return nil
-}
-
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetSkipConnectionCheck() bool {
-	if x != nil {
-		return x.SkipConnectionCheck
-	}
-	return false
-}
-
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetDisableCommentsParsing() bool {
-	if x != nil {
-		return x.DisableCommentsParsing
-	}
-	return false
-}
-
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetTlsCa() string {
-	if x != nil {
-		return x.TlsCa
-	}
-	return ""
-}
-
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetTlsCert() string {
+func (x *AddExternalExporterParams) GetCustomLabels() map[string]string {
 	if x != nil {
-		return x.TlsCert
+		return x.CustomLabels
 	}
-	return ""
+	return nil
 }
 
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetTlsKey() string {
+func (x *AddExternalExporterParams) GetPushMetrics() bool {
 	if x != nil {
-		return x.TlsKey
+		return x.PushMetrics
 	}
-	return ""
+	return false
 }
 
-func (x *AddQANPostgreSQLPgStatMonitorAgentParams) GetLogLevel() LogLevel {
+func (x *AddExternalExporterParams) GetTlsSkipVerify() bool {
 	if x != nil {
-		return x.LogLevel
+		return x.TlsSkipVerify
 	}
-	return LogLevel_LOG_LEVEL_UNSPECIFIED
+	return false
 }
 
-type ChangeQANPostgreSQLPgStatMonitorAgentParams struct {
+type ChangeExternalExporterParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
@@ -9269,49 +11898,35 @@ type ChangeQANPostgreSQLPgStatMonitorAgentParams struct {
 	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
 	// Metrics resolution for this agent.
 	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	// PostgreSQL username for getting pg stat monitor data.
+	// HTTP basic auth username for collecting metrics.
 	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// PostgreSQL password for getting pg stat monitor data.
-	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
-	// Use TLS for database connections.
-	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
-	// Skip TLS certificate and hostname validation.
-	TlsSkipVerify *bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
-	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength *int32 `protobuf:"varint,9,opt,name=max_query_length,json=maxQueryLength,proto3,oneof" json:"max_query_length,omitempty"`
-	// Disable query examples.
-	DisableQueryExamples *bool `protobuf:"varint,10,opt,name=disable_query_examples,json=disableQueryExamples,proto3,oneof" json:"disable_query_examples,omitempty"`
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing *bool `protobuf:"varint,11,opt,name=disable_comments_parsing,json=disableCommentsParsing,proto3,oneof" json:"disable_comments_parsing,omitempty"`
-	// TLS CA certificate.
-	TlsCa *string `protobuf:"bytes,12,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
-	// TLS Certificate.
-	TlsCert *string `protobuf:"bytes,13,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
-	// TLS Certificate Key.
-	TlsKey *string `protobuf:"bytes,14,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
-	// Log level for exporter.
-	LogLevel *LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
+	// Scheme to generate URI to exporter metrics endpoints.
+	Scheme *string `protobuf:"bytes,6,opt,name=scheme,proto3,oneof" json:"scheme,omitempty"`
+	// Path under which metrics are exposed, used to generate URI.
+	MetricsPath *string `protobuf:"bytes,7,opt,name=metrics_path,json=metricsPath,proto3,oneof" json:"metrics_path,omitempty"`
+	// Listen port for scraping metrics.
+	ListenPort *uint32 `protobuf:"varint,8,opt,name=listen_port,json=listenPort,proto3,oneof" json:"listen_port,omitempty"`
 	// Skip connection check.
-	SkipConnectionCheck *bool `protobuf:"varint,16,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
+	SkipConnectionCheck *bool `protobuf:"varint,9,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) Reset() {
-	*x = ChangeQANPostgreSQLPgStatMonitorAgentParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[53]
+func (x *ChangeExternalExporterParams) Reset() {
+	*x = ChangeExternalExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) String() string {
+func (x *ChangeExternalExporterParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeQANPostgreSQLPgStatMonitorAgentParams) ProtoMessage() {}
+func (*ChangeExternalExporterParams) ProtoMessage() {}
 
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[53]
+func (x *ChangeExternalExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9322,164 +11937,119 @@ func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) ProtoReflect() protoreflec
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeQANPostgreSQLPgStatMonitorAgentParams.ProtoReflect.Descriptor instead.
-func (*ChangeQANPostgreSQLPgStatMonitorAgentParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use ChangeExternalExporterParams.ProtoReflect.Descriptor instead.
+func (*ChangeExternalExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetEnable() bool {
+func (x *ChangeExternalExporterParams) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
 	}
 	return false
 }
 
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetCustomLabels() *common.StringMap {
+func (x *ChangeExternalExporterParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetEnablePushMetrics() bool {
+func (x *ChangeExternalExporterParams) GetEnablePushMetrics() bool {
 	if x != nil && x.EnablePushMetrics != nil {
 		return *x.EnablePushMetrics
 	}
 	return false
 }
 
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetMetricsResolutions() *common.MetricsResolutions {
+func (x *ChangeExternalExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
 	if x != nil {
 		return x.MetricsResolutions
 	}
 	return nil
 }
 
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetUsername() string {
+func (x *ChangeExternalExporterParams) GetUsername() string {
 	if x != nil && x.Username != nil {
 		return *x.Username
 	}
 	return ""
 }
 
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
-	}
-	return ""
-}
-
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetTls() bool {
-	if x != nil && x.Tls != nil {
-		return *x.Tls
-	}
-	return false
-}
-
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetTlsSkipVerify() bool {
-	if x != nil && x.TlsSkipVerify != nil {
-		return *x.TlsSkipVerify
-	}
-	return false
-}
-
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetMaxQueryLength() int32 {
-	if x != nil && x.MaxQueryLength != nil {
-		return *x.MaxQueryLength
-	}
-	return 0
-}
-
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetDisableQueryExamples() bool {
-	if x != nil && x.DisableQueryExamples != nil {
-		return *x.DisableQueryExamples
-	}
-	return false
-}
-
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetDisableCommentsParsing() bool {
-	if x != nil && x.DisableCommentsParsing != nil {
-		return *x.DisableCommentsParsing
-	}
-	return false
-}
-
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetTlsCa() string {
-	if x != nil && x.TlsCa != nil {
-		return *x.TlsCa
-	}
-	return ""
-}
-
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetTlsCert() string {
-	if x != nil && x.TlsCert != nil {
-		return *x.TlsCert
+func (x *ChangeExternalExporterParams) GetScheme() string {
+	if x != nil && x.Scheme != nil {
+		return *x.Scheme
 	}
 	return ""
 }
 
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetTlsKey() string {
-	if x != nil && x.TlsKey != nil {
-		return *x.TlsKey
+func (x *ChangeExternalExporterParams) GetMetricsPath() string {
+	if x != nil && x.MetricsPath != nil {
+		return *x.MetricsPath
 	}
 	return ""
 }
 
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetLogLevel() LogLevel {
-	if x != nil && x.LogLevel != nil {
-		return *x.LogLevel
+func (x *ChangeExternalExporterParams) GetListenPort() uint32 {
+	if x != nil && x.ListenPort != nil {
+		return *x.ListenPort
 	}
-	return LogLevel_LOG_LEVEL_UNSPECIFIED
+	return 0
 }
 
-func (x *ChangeQANPostgreSQLPgStatMonitorAgentParams) GetSkipConnectionCheck() bool {
+func (x *ChangeExternalExporterParams) GetSkipConnectionCheck() bool {
 	if x != nil && x.SkipConnectionCheck != nil {
 		return *x.SkipConnectionCheck
 	}
 	return false
 }
 
-type AddRDSExporterParams struct {
+type AddAzureDatabaseExporterParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The pmm-agent identifier which runs this instance.
 	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
 	// Node identifier.
 	NodeId string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	// AWS Access Key.
-	AwsAccessKey string `protobuf:"bytes,3,opt,name=aws_access_key,json=awsAccessKey,proto3" json:"aws_access_key,omitempty"`
-	// AWS Secret Key.
-	AwsSecretKey string `protobuf:"bytes,4,opt,name=aws_secret_key,json=awsSecretKey,proto3" json:"aws_secret_key,omitempty"`
+	// Azure client ID
+	AzureClientId string `protobuf:"bytes,3,opt,name=azure_client_id,json=azureClientId,proto3" json:"azure_client_id,omitempty"`
+	// Azure client secret
+	AzureClientSecret string `protobuf:"bytes,4,opt,name=azure_client_secret,json=azureClientSecret,proto3" json:"azure_client_secret,omitempty"`
+	// Azure tanant ID
+	AzureTenantId string `protobuf:"bytes,5,opt,name=azure_tenant_id,json=azureTenantId,proto3" json:"azure_tenant_id,omitempty"`
+	// Azure subscription ID
+	AzureSubscriptionId string `protobuf:"bytes,6,opt,name=azure_subscription_id,json=azureSubscriptionId,proto3" json:"azure_subscription_id,omitempty"`
+	// Azure resource group.
+	AzureResourceGroup string `protobuf:"bytes,7,opt,name=azure_resource_group,json=azureResourceGroup,proto3" json:"azure_resource_group,omitempty"`
+	// Azure resource type (mysql, maria, postgres)
+	AzureDatabaseResourceType string `protobuf:"bytes,8,opt,name=azure_database_resource_type,json=azureDatabaseResourceType,proto3" json:"azure_database_resource_type,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,5,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CustomLabels map[string]string `protobuf:"bytes,9,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Skip connection check.
-	SkipConnectionCheck bool `protobuf:"varint,6,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
-	// Disable basic metrics.
-	DisableBasicMetrics bool `protobuf:"varint,7,opt,name=disable_basic_metrics,json=disableBasicMetrics,proto3" json:"disable_basic_metrics,omitempty"`
-	// Disable enhanced metrics.
-	DisableEnhancedMetrics bool `protobuf:"varint,8,opt,name=disable_enhanced_metrics,json=disableEnhancedMetrics,proto3" json:"disable_enhanced_metrics,omitempty"`
+	SkipConnectionCheck bool `protobuf:"varint,10,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
 	// Enables push metrics mode for exporter.
-	PushMetrics bool `protobuf:"varint,9,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
+	PushMetrics bool `protobuf:"varint,11,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
 	// Log level for exporter.
-	LogLevel      LogLevel `protobuf:"varint,10,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	LogLevel      LogLevel `protobuf:"varint,12,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddRDSExporterParams) Reset() {
-	*x = AddRDSExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[54]
+func (x *AddAzureDatabaseExporterParams) Reset() {
+	*x = AddAzureDatabaseExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddRDSExporterParams) String() string {
+func (x *AddAzureDatabaseExporterParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddRDSExporterParams) ProtoMessage() {}
+func (*AddAzureDatabaseExporterParams) ProtoMessage() {}
 
-func (x *AddRDSExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[54]
+func (x *AddAzureDatabaseExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9490,82 +12060,96 @@ func (x *AddRDSExporterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddRDSExporterParams.ProtoReflect.Descriptor instead.
-func (*AddRDSExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use AddAzureDatabaseExporterParams.ProtoReflect.Descriptor instead.
+func (*AddAzureDatabaseExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *AddRDSExporterParams) GetPmmAgentId() string {
+func (x *AddAzureDatabaseExporterParams) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *AddRDSExporterParams) GetNodeId() string {
+func (x *AddAzureDatabaseExporterParams) GetNodeId() string {
 	if x != nil {
 		return x.NodeId
 	}
 	return ""
 }
 
-func (x *AddRDSExporterParams) GetAwsAccessKey() string {
+func (x *AddAzureDatabaseExporterParams) GetAzureClientId() string {
 	if x != nil {
-		return x.AwsAccessKey
+		return x.AzureClientId
 	}
 	return ""
 }
 
-func (x *AddRDSExporterParams) GetAwsSecretKey() string {
+func (x *AddAzureDatabaseExporterParams) GetAzureClientSecret() string {
 	if x != nil {
-		return x.AwsSecretKey
+		return x.AzureClientSecret
 	}
 	return ""
 }
 
-func (x *AddRDSExporterParams) GetCustomLabels() map[string]string {
+func (x *AddAzureDatabaseExporterParams) GetAzureTenantId() string {
 	if x != nil {
-		return x.CustomLabels
+		return x.AzureTenantId
 	}
-	return nil
+	return ""
 }
 
-func (x *AddRDSExporterParams) GetSkipConnectionCheck() bool {
+func (x *AddAzureDatabaseExporterParams) GetAzureSubscriptionId() string {
 	if x != nil {
-		return x.SkipConnectionCheck
+		return x.AzureSubscriptionId
 	}
-	return false
+	return ""
 }
 
-func (x *AddRDSExporterParams) GetDisableBasicMetrics() bool {
+func (x *AddAzureDatabaseExporterParams) GetAzureResourceGroup() string {
 	if x != nil {
-		return x.DisableBasicMetrics
+		return x.AzureResourceGroup
 	}
-	return false
+	return ""
 }
 
-func (x *AddRDSExporterParams) GetDisableEnhancedMetrics() bool {
+func (x *AddAzureDatabaseExporterParams) GetAzureDatabaseResourceType() string {
 	if x != nil {
-		return x.DisableEnhancedMetrics
+		return x.AzureDatabaseResourceType
+	}
+	return ""
+}
+
+func (x *AddAzureDatabaseExporterParams) GetCustomLabels() map[string]string {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
+}
+
+func (x *AddAzureDatabaseExporterParams) GetSkipConnectionCheck() bool {
+	if x != nil {
+		return x.SkipConnectionCheck
 	}
 	return false
 }
 
-func (x *AddRDSExporterParams) GetPushMetrics() bool {
+func (x *AddAzureDatabaseExporterParams) GetPushMetrics() bool {
 	if x != nil {
 		return x.PushMetrics
 	}
 	return false
 }
 
-func (x *AddRDSExporterParams) GetLogLevel() LogLevel {
+func (x *AddAzureDatabaseExporterParams) GetLogLevel() LogLevel {
 	if x != nil {
 		return x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-type ChangeRDSExporterParams struct {
+type ChangeAzureDatabaseExporterParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
@@ -9575,35 +12159,37 @@ type ChangeRDSExporterParams struct {
 	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
 	// Metrics resolution for this agent.
 	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	// AWS Access Key.
-	AwsAccessKey *string `protobuf:"bytes,5,opt,name=aws_access_key,json=awsAccessKey,proto3,oneof" json:"aws_access_key,omitempty"`
-	// AWS Secret Key.
-	AwsSecretKey *string `protobuf:"bytes,6,opt,name=aws_secret_key,json=awsSecretKey,proto3,oneof" json:"aws_secret_key,omitempty"`
-	// Disable basic metrics.
-	DisableBasicMetrics *bool `protobuf:"varint,7,opt,name=disable_basic_metrics,json=disableBasicMetrics,proto3,oneof" json:"disable_basic_metrics,omitempty"`
-	// Disable enhanced metrics.
-	DisableEnhancedMetrics *bool `protobuf:"varint,8,opt,name=disable_enhanced_metrics,json=disableEnhancedMetrics,proto3,oneof" json:"disable_enhanced_metrics,omitempty"`
+	// Azure client ID
+	AzureClientId *string `protobuf:"bytes,5,opt,name=azure_client_id,json=azureClientId,proto3,oneof" json:"azure_client_id,omitempty"`
+	// Azure client secret
+	AzureClientSecret *string `protobuf:"bytes,6,opt,name=azure_client_secret,json=azureClientSecret,proto3,oneof" json:"azure_client_secret,omitempty"`
+	// Azure tenant ID
+	AzureTenantId *string `protobuf:"bytes,7,opt,name=azure_tenant_id,json=azureTenantId,proto3,oneof" json:"azure_tenant_id,omitempty"`
+	// Azure subscription ID
+	AzureSubscriptionId *string `protobuf:"bytes,8,opt,name=azure_subscription_id,json=azureSubscriptionId,proto3,oneof" json:"azure_subscription_id,omitempty"`
+	// Azure resource group.
+	AzureResourceGroup *string `protobuf:"bytes,9,opt,name=azure_resource_group,json=azureResourceGroup,proto3,oneof" json:"azure_resource_group,omitempty"`
 	// Log level for exporter.
-	LogLevel      *LogLevel `protobuf:"varint,9,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
+	LogLevel      *LogLevel `protobuf:"varint,11,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeRDSExporterParams) Reset() {
-	*x = ChangeRDSExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[55]
+func (x *ChangeAzureDatabaseExporterParams) Reset() {
+	*x = ChangeAzureDatabaseExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeRDSExporterParams) String() string {
+func (x *ChangeAzureDatabaseExporterParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeRDSExporterParams) ProtoMessage() {}
+func (*ChangeAzureDatabaseExporterParams) ProtoMessage() {}
 
-func (x *ChangeRDSExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[55]
+func (x *ChangeAzureDatabaseExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9614,115 +12200,181 @@ func (x *ChangeRDSExporterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeRDSExporterParams.ProtoReflect.Descriptor instead.
-func (*ChangeRDSExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use ChangeAzureDatabaseExporterParams.ProtoReflect.Descriptor instead.
+func (*ChangeAzureDatabaseExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ChangeRDSExporterParams) GetEnable() bool {
+func (x *ChangeAzureDatabaseExporterParams) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
 	}
 	return false
 }
 
-func (x *ChangeRDSExporterParams) GetCustomLabels() *common.StringMap {
+func (x *ChangeAzureDatabaseExporterParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangeRDSExporterParams) GetEnablePushMetrics() bool {
+func (x *ChangeAzureDatabaseExporterParams) GetEnablePushMetrics() bool {
 	if x != nil && x.EnablePushMetrics != nil {
 		return *x.EnablePushMetrics
 	}
 	return false
 }
 
-func (x *ChangeRDSExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
+func (x *ChangeAzureDatabaseExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
 	if x != nil {
 		return x.MetricsResolutions
 	}
 	return nil
 }
 
-func (x *ChangeRDSExporterParams) GetAwsAccessKey() string {
-	if x != nil && x.AwsAccessKey != nil {
-		return *x.AwsAccessKey
+func (x *ChangeAzureDatabaseExporterParams) GetAzureClientId() string {
+	if x != nil && x.AzureClientId != nil {
+		return *x.AzureClientId
 	}
 	return ""
 }
 
-func (x *ChangeRDSExporterParams) GetAwsSecretKey() string {
-	if x != nil && x.AwsSecretKey != nil {
-		return *x.AwsSecretKey
+func (x *ChangeAzureDatabaseExporterParams) GetAzureClientSecret() string {
+	if x != nil && x.AzureClientSecret != nil {
+		return *x.AzureClientSecret
 	}
 	return ""
 }
 
-func (x *ChangeRDSExporterParams) GetDisableBasicMetrics() bool {
-	if x != nil && x.DisableBasicMetrics != nil {
-		return *x.DisableBasicMetrics
+func (x *ChangeAzureDatabaseExporterParams) GetAzureTenantId() string {
+	if x != nil && x.AzureTenantId != nil {
+		return *x.AzureTenantId
 	}
-	return false
+	return ""
 }
 
-func (x *ChangeRDSExporterParams) GetDisableEnhancedMetrics() bool {
-	if x != nil && x.DisableEnhancedMetrics != nil {
-		return *x.DisableEnhancedMetrics
+func (x *ChangeAzureDatabaseExporterParams) GetAzureSubscriptionId() string {
+	if x != nil && x.AzureSubscriptionId != nil {
+		return *x.AzureSubscriptionId
 	}
-	return false
+	return ""
 }
 
-func (x *ChangeRDSExporterParams) GetLogLevel() LogLevel {
+func (x *ChangeAzureDatabaseExporterParams) GetAzureResourceGroup() string {
+	if x != nil && x.AzureResourceGroup != nil {
+		return *x.AzureResourceGroup
+	}
+	return ""
+}
+
+func (x *ChangeAzureDatabaseExporterParams) GetLogLevel() LogLevel {
 	if x != nil && x.LogLevel != nil {
 		return *x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-type AddExternalExporterParams struct {
+type ChangeNomadAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The node identifier where this instance is run.
-	RunsOnNodeId string `protobuf:"bytes,1,opt,name=runs_on_node_id,json=runsOnNodeId,proto3" json:"runs_on_node_id,omitempty"`
+	// Enable this Agent. Agents are enabled by default when they get added.
+	Enable        *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeNomadAgentParams) Reset() {
+	*x = ChangeNomadAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeNomadAgentParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeNomadAgentParams) ProtoMessage() {}
+
+func (x *ChangeNomadAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeNomadAgentParams.ProtoReflect.Descriptor instead.
+func (*ChangeNomadAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *ChangeNomadAgentParams) GetEnable() bool {
+	if x != nil && x.Enable != nil {
+		return *x.Enable
+	}
+	return false
+}
+
+type AddValkeyExporterParams struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The pmm-agent identifier which runs this instance.
+	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
 	// Service identifier.
 	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// HTTP basic auth username for collecting metrics.
+	// Valkey username for scraping metrics.
 	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	// HTTP basic auth password for collecting metrics.
+	// Valkey password for scraping metrics.
 	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
-	// Scheme to generate URI to exporter metrics endpoints(default: http).
-	Scheme string `protobuf:"bytes,6,opt,name=scheme,proto3" json:"scheme,omitempty"`
-	// Path under which metrics are exposed, used to generate URI(default: /metrics).
-	MetricsPath string `protobuf:"bytes,7,opt,name=metrics_path,json=metricsPath,proto3" json:"metrics_path,omitempty"`
-	// Listen port for scraping metrics.
-	ListenPort uint32 `protobuf:"varint,8,opt,name=listen_port,json=listenPort,proto3" json:"listen_port,omitempty"`
+	// Use TLS for database connections.
+	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,10,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CustomLabels map[string]string `protobuf:"bytes,7,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Skip connection check.
+	SkipConnectionCheck bool `protobuf:"varint,8,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
 	// Enables push metrics mode for exporter.
-	PushMetrics bool `protobuf:"varint,11,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
-	// Skip TLS certificate and hostname verification.
-	TlsSkipVerify bool `protobuf:"varint,12,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	PushMetrics bool `protobuf:"varint,9,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `protobuf:"bytes,10,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
+	// TLS CA certificate.
+	TlsCa string `protobuf:"bytes,11,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// TLS Certifcate.
+	TlsCert string `protobuf:"bytes,12,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
+	// TLS Certificate Key.
+	TlsKey string `protobuf:"bytes,13,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword string `protobuf:"bytes,14,opt,name=agent_password,json=agentPassword,proto3" json:"agent_password,omitempty"`
+	// Optionally expose the exporter process on all public interfaces
+	ExposeExporter bool `protobuf:"varint,15,opt,name=expose_exporter,json=exposeExporter,proto3" json:"expose_exporter,omitempty"`
+	// Log level for exporter.
+	LogLevel LogLevel `protobuf:"varint,16,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,17,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *AddExternalExporterParams) Reset() {
-	*x = AddExternalExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[56]
+func (x *AddValkeyExporterParams) Reset() {
+	*x = AddValkeyExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddExternalExporterParams) String() string {
+func (x *AddValkeyExporterParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddExternalExporterParams) ProtoMessage() {}
+func (*AddValkeyExporterParams) ProtoMessage() {}
 
-func (x *AddExternalExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[56]
+func (x *AddValkeyExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9733,82 +12385,131 @@ func (x *AddExternalExporterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddExternalExporterParams.ProtoReflect.Descriptor instead.
-func (*AddExternalExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use AddValkeyExporterParams.ProtoReflect.Descriptor instead.
+func (*AddValkeyExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *AddExternalExporterParams) GetRunsOnNodeId() string {
+func (x *AddValkeyExporterParams) GetPmmAgentId() string {
 	if x != nil {
-		return x.RunsOnNodeId
+		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *AddExternalExporterParams) GetServiceId() string {
+func (x *AddValkeyExporterParams) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *AddExternalExporterParams) GetUsername() string {
+func (x *AddValkeyExporterParams) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *AddExternalExporterParams) GetPassword() string {
+func (x *AddValkeyExporterParams) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-func (x *AddExternalExporterParams) GetScheme() string {
+func (x *AddValkeyExporterParams) GetTls() bool {
 	if x != nil {
-		return x.Scheme
+		return x.Tls
+	}
+	return false
+}
+
+func (x *AddValkeyExporterParams) GetTlsSkipVerify() bool {
+	if x != nil {
+		return x.TlsSkipVerify
+	}
+	return false
+}
+
+func (x *AddValkeyExporterParams) GetCustomLabels() map[string]string {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
+}
+
+func (x *AddValkeyExporterParams) GetSkipConnectionCheck() bool {
+	if x != nil {
+		return x.SkipConnectionCheck
+	}
+	return false
+}
+
+func (x *AddValkeyExporterParams) GetPushMetrics() bool {
+	if x != nil {
+		return x.PushMetrics
+	}
+	return false
+}
+
+func (x *AddValkeyExporterParams) GetDisableCollectors() []string {
+	if x != nil {
+		return x.DisableCollectors
+	}
+	return nil
+}
+
+func (x *AddValkeyExporterParams) GetTlsCa() string {
+	if x != nil {
+		return x.TlsCa
 	}
 	return ""
 }
 
-func (x *AddExternalExporterParams) GetMetricsPath() string {
+func (x *AddValkeyExporterParams) GetTlsCert() string {
 	if x != nil {
-		return x.MetricsPath
+		return x.TlsCert
 	}
 	return ""
 }
 
-func (x *AddExternalExporterParams) GetListenPort() uint32 {
+func (x *AddValkeyExporterParams) GetTlsKey() string {
 	if x != nil {
-		return x.ListenPort
+		return x.TlsKey
 	}
-	return 0
+	return ""
 }
 
-func (x *AddExternalExporterParams) GetCustomLabels() map[string]string {
+func (x *AddValkeyExporterParams) GetAgentPassword() string {
+	if x != nil {
+		return x.AgentPassword
+	}
+	return ""
+}
+
+func (x *AddValkeyExporterParams) GetExposeExporter() bool {
 	if x != nil {
-		return x.CustomLabels
+		return x.ExposeExporter
 	}
-	return nil
+	return false
 }
 
-func (x *AddExternalExporterParams) GetPushMetrics() bool {
+func (x *AddValkeyExporterParams) GetLogLevel() LogLevel {
 	if x != nil {
-		return x.PushMetrics
+		return x.LogLevel
 	}
-	return false
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *AddExternalExporterParams) GetTlsSkipVerify() bool {
+func (x *AddValkeyExporterParams) GetConnectionTimeout() *durationpb.Duration {
 	if x != nil {
-		return x.TlsSkipVerify
+		return x.ConnectionTimeout
 	}
-	return false
+	return nil
 }
 
-type ChangeExternalExporterParams struct {
+type ChangeValkeyExporterParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
@@ -9818,35 +12519,51 @@ type ChangeExternalExporterParams struct {
 	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
 	// Metrics resolution for this agent.
 	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	// HTTP basic auth username for collecting metrics.
+	// Valkey username for scraping metrics.
 	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// Scheme to generate URI to exporter metrics endpoints.
-	Scheme *string `protobuf:"bytes,6,opt,name=scheme,proto3,oneof" json:"scheme,omitempty"`
-	// Path under which metrics are exposed, used to generate URI.
-	MetricsPath *string `protobuf:"bytes,7,opt,name=metrics_path,json=metricsPath,proto3,oneof" json:"metrics_path,omitempty"`
-	// Listen port for scraping metrics.
-	ListenPort *uint32 `protobuf:"varint,8,opt,name=listen_port,json=listenPort,proto3,oneof" json:"listen_port,omitempty"`
+	// Valkey password for scraping metrics.
+	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// Use TLS for database connections.
+	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify *bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `protobuf:"bytes,9,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
+	// TLS CA certificate.
+	TlsCa *string `protobuf:"bytes,10,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
+	// TLS Certifcate.
+	TlsCert *string `protobuf:"bytes,11,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
+	// TLS Certificate Key.
+	TlsKey *string `protobuf:"bytes,12,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword *string `protobuf:"bytes,13,opt,name=agent_password,json=agentPassword,proto3,oneof" json:"agent_password,omitempty"`
+	// Optionally expose the exporter process on all public interfaces
+	ExposeExporter *bool `protobuf:"varint,14,opt,name=expose_exporter,json=exposeExporter,proto3,oneof" json:"expose_exporter,omitempty"`
+	// Log level for exporter.
+	LogLevel *LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,16,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
 	// Skip connection check.
-	SkipConnectionCheck *bool `protobuf:"varint,9,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
+	SkipConnectionCheck *bool `protobuf:"varint,17,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ChangeExternalExporterParams) Reset() {
-	*x = ChangeExternalExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[57]
+func (x *ChangeValkeyExporterParams) Reset() {
+	*x = ChangeValkeyExporterParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeExternalExporterParams) String() string {
+func (x *ChangeValkeyExporterParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeExternalExporterParams) ProtoMessage() {}
+func (*ChangeValkeyExporterParams) ProtoMessage() {}
 
-func (x *ChangeExternalExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[57]
+func (x *ChangeValkeyExporterParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9857,119 +12574,182 @@ func (x *ChangeExternalExporterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeExternalExporterParams.ProtoReflect.Descriptor instead.
-func (*ChangeExternalExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use ChangeValkeyExporterParams.ProtoReflect.Descriptor instead.
+func (*ChangeValkeyExporterParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *ChangeExternalExporterParams) GetEnable() bool {
+func (x *ChangeValkeyExporterParams) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
 	}
 	return false
 }
 
-func (x *ChangeExternalExporterParams) GetCustomLabels() *common.StringMap {
+func (x *ChangeValkeyExporterParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangeExternalExporterParams) GetEnablePushMetrics() bool {
+func (x *ChangeValkeyExporterParams) GetEnablePushMetrics() bool {
 	if x != nil && x.EnablePushMetrics != nil {
 		return *x.EnablePushMetrics
 	}
 	return false
 }
 
-func (x *ChangeExternalExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
+func (x *ChangeValkeyExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
 	if x != nil {
 		return x.MetricsResolutions
 	}
 	return nil
 }
 
-func (x *ChangeExternalExporterParams) GetUsername() string {
+func (x *ChangeValkeyExporterParams) GetUsername() string {
 	if x != nil && x.Username != nil {
 		return *x.Username
 	}
 	return ""
 }
 
-func (x *ChangeExternalExporterParams) GetScheme() string {
-	if x != nil && x.Scheme != nil {
-		return *x.Scheme
+func (x *ChangeValkeyExporterParams) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
 	}
 	return ""
 }
 
-func (x *ChangeExternalExporterParams) GetMetricsPath() string {
-	if x != nil && x.MetricsPath != nil {
-		return *x.MetricsPath
+func (x *ChangeValkeyExporterParams) GetTls() bool {
+	if x != nil && x.Tls != nil {
+		return *x.Tls
+	}
+	return false
+}
+
+func (x *ChangeValkeyExporterParams) GetTlsSkipVerify() bool {
+	if x != nil && x.TlsSkipVerify != nil {
+		return *x.TlsSkipVerify
+	}
+	return false
+}
+
+func (x *ChangeValkeyExporterParams) GetDisableCollectors() []string {
+	if x != nil {
+		return x.DisableCollectors
+	}
+	return nil
+}
+
+func (x *ChangeValkeyExporterParams) GetTlsCa() string {
+	if x != nil && x.TlsCa != nil {
+		return *x.TlsCa
 	}
 	return ""
 }
 
-func (x *ChangeExternalExporterParams) GetListenPort() uint32 {
-	if x != nil && x.ListenPort != nil {
-		return *x.ListenPort
+func (x *ChangeValkeyExporterParams) GetTlsCert() string {
+	if x != nil && x.TlsCert != nil {
+		return *x.TlsCert
 	}
-	return 0
+	return ""
 }
 
-func (x *ChangeExternalExporterParams) GetSkipConnectionCheck() bool {
+func (x *ChangeValkeyExporterParams) GetTlsKey() string {
+	if x != nil && x.TlsKey != nil {
+		return *x.TlsKey
+	}
+	return ""
+}
+
+func (x *ChangeValkeyExporterParams) GetAgentPassword() string {
+	if x != nil && x.AgentPassword != nil {
+		return *x.AgentPassword
+	}
+	return ""
+}
+
+func (x *ChangeValkeyExporterParams) GetExposeExporter() bool {
+	if x != nil && x.ExposeExporter != nil {
+		return *x.ExposeExporter
+	}
+	return false
+}
+
+func (x *ChangeValkeyExporterParams) GetLogLevel() LogLevel {
+	if x != nil && x.LogLevel != nil {
+		return *x.LogLevel
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *ChangeValkeyExporterParams) GetConnectionTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.ConnectionTimeout
+	}
+	return nil
+}
+
+func (x *ChangeValkeyExporterParams) GetSkipConnectionCheck() bool {
 	if x != nil && x.SkipConnectionCheck != nil {
 		return *x.SkipConnectionCheck
 	}
 	return false
 }
 
-type AddAzureDatabaseExporterParams struct {
+type AddRTAMongoDBAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The pmm-agent identifier which runs this instance.
 	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
-	// Node identifier.
-	NodeId string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	// Azure client ID
-	AzureClientId string `protobuf:"bytes,3,opt,name=azure_client_id,json=azureClientId,proto3" json:"azure_client_id,omitempty"`
-	// Azure client secret
-	AzureClientSecret string `protobuf:"bytes,4,opt,name=azure_client_secret,json=azureClientSecret,proto3" json:"azure_client_secret,omitempty"`
-	// Azure tanant ID
-	AzureTenantId string `protobuf:"bytes,5,opt,name=azure_tenant_id,json=azureTenantId,proto3" json:"azure_tenant_id,omitempty"`
-	// Azure subscription ID
-	AzureSubscriptionId string `protobuf:"bytes,6,opt,name=azure_subscription_id,json=azureSubscriptionId,proto3" json:"azure_subscription_id,omitempty"`
-	// Azure resource group.
-	AzureResourceGroup string `protobuf:"bytes,7,opt,name=azure_resource_group,json=azureResourceGroup,proto3" json:"azure_resource_group,omitempty"`
-	// Azure resource type (mysql, maria, postgres)
-	AzureDatabaseResourceType string `protobuf:"bytes,8,opt,name=azure_database_resource_type,json=azureDatabaseResourceType,proto3" json:"azure_database_resource_type,omitempty"`
+	// Service identifier.
+	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// MongoDB username for getting queries data.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// MongoDB password for getting queries data.
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,9,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	CustomLabels map[string]string `protobuf:"bytes,5,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Log level for agent.
+	LogLevel LogLevel `protobuf:"varint,6,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	// MongoDB specific options.
+	// Use TLS for database connections.
+	Tls bool `protobuf:"varint,7,opt,name=tls,proto3" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Client certificate and key.
+	TlsCertificateKey string `protobuf:"bytes,9,opt,name=tls_certificate_key,json=tlsCertificateKey,proto3" json:"tls_certificate_key,omitempty"`
+	// Password for decrypting tls_certificate_key.
+	TlsCertificateKeyFilePassword string `protobuf:"bytes,10,opt,name=tls_certificate_key_file_password,json=tlsCertificateKeyFilePassword,proto3" json:"tls_certificate_key_file_password,omitempty"`
+	// Certificate Authority certificate chain.
+	TlsCa string `protobuf:"bytes,11,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
 	// Skip connection check.
-	SkipConnectionCheck bool `protobuf:"varint,10,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
-	// Enables push metrics mode for exporter.
-	PushMetrics bool `protobuf:"varint,11,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
-	// Log level for exporter.
-	LogLevel      LogLevel `protobuf:"varint,12,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	SkipConnectionCheck bool `protobuf:"varint,12,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
+	// Authentication mechanism.
+	// See https://docs.mongodb.com/manual/reference/connection-string/#mongodb-urioption-urioption.authMechanism
+	// for details.
+	AuthenticationMechanism string `protobuf:"bytes,13,opt,name=authentication_mechanism,json=authenticationMechanism,proto3" json:"authentication_mechanism,omitempty"`
+	// Real-Time Analytics options.
+	RtaOptions    *RTAOptions `protobuf:"bytes,14,opt,name=rta_options,json=rtaOptions,proto3" json:"rta_options,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddAzureDatabaseExporterParams) Reset() {
-	*x = AddAzureDatabaseExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[58]
+func (x *AddRTAMongoDBAgentParams) Reset() {
+	*x = AddRTAMongoDBAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddAzureDatabaseExporterParams) String() string {
+func (x *AddRTAMongoDBAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddAzureDatabaseExporterParams) ProtoMessage() {}
+func (*AddRTAMongoDBAgentParams) ProtoMessage() {}
 
-func (x *AddAzureDatabaseExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[58]
+func (x *AddRTAMongoDBAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -9980,136 +12760,156 @@ func (x *AddAzureDatabaseExporterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddAzureDatabaseExporterParams.ProtoReflect.Descriptor instead.
-func (*AddAzureDatabaseExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use AddRTAMongoDBAgentParams.ProtoReflect.Descriptor instead.
+func (*AddRTAMongoDBAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *AddAzureDatabaseExporterParams) GetPmmAgentId() string {
+func (x *AddRTAMongoDBAgentParams) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *AddAzureDatabaseExporterParams) GetNodeId() string {
+func (x *AddRTAMongoDBAgentParams) GetServiceId() string {
 	if x != nil {
-		return x.NodeId
+		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *AddAzureDatabaseExporterParams) GetAzureClientId() string {
+func (x *AddRTAMongoDBAgentParams) GetUsername() string {
 	if x != nil {
-		return x.AzureClientId
+		return x.Username
 	}
 	return ""
 }
 
-func (x *AddAzureDatabaseExporterParams) GetAzureClientSecret() string {
+func (x *AddRTAMongoDBAgentParams) GetPassword() string {
 	if x != nil {
-		return x.AzureClientSecret
+		return x.Password
 	}
 	return ""
 }
 
-func (x *AddAzureDatabaseExporterParams) GetAzureTenantId() string {
+func (x *AddRTAMongoDBAgentParams) GetCustomLabels() map[string]string {
 	if x != nil {
-		return x.AzureTenantId
+		return x.CustomLabels
 	}
-	return ""
+	return nil
 }
 
-func (x *AddAzureDatabaseExporterParams) GetAzureSubscriptionId() string {
+func (x *AddRTAMongoDBAgentParams) GetLogLevel() LogLevel {
+	if x != nil {
+		return x.LogLevel
+	}
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
+}
+
+func (x *AddRTAMongoDBAgentParams) GetTls() bool {
+	if x != nil {
+		return x.Tls
+	}
+	return false
+}
+
+func (x *AddRTAMongoDBAgentParams) GetTlsSkipVerify() bool {
 	if x != nil {
-		return x.AzureSubscriptionId
+		return x.TlsSkipVerify
 	}
-	return ""
+	return false
 }
 
-func (x *AddAzureDatabaseExporterParams) GetAzureResourceGroup() string {
+func (x *AddRTAMongoDBAgentParams) GetTlsCertificateKey() string {
 	if x != nil {
-		return x.AzureResourceGroup
+		return x.TlsCertificateKey
 	}
 	return ""
 }
 
-func (x *AddAzureDatabaseExporterParams) GetAzureDatabaseResourceType() string {
+func (x *AddRTAMongoDBAgentParams) GetTlsCertificateKeyFilePassword() string {
 	if x != nil {
-		return x.AzureDatabaseResourceType
+		return x.TlsCertificateKeyFilePassword
 	}
 	return ""
 }
 
-func (x *AddAzureDatabaseExporterParams) GetCustomLabels() map[string]string {
+func (x *AddRTAMongoDBAgentParams) GetTlsCa() string {
 	if x != nil {
-		return x.CustomLabels
+		return x.TlsCa
 	}
-	return nil
+	return ""
 }
 
-func (x *AddAzureDatabaseExporterParams) GetSkipConnectionCheck() bool {
+func (x *AddRTAMongoDBAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil {
 		return x.SkipConnectionCheck
 	}
 	return false
 }
 
-func (x *AddAzureDatabaseExporterParams) GetPushMetrics() bool {
+func (x *AddRTAMongoDBAgentParams) GetAuthenticationMechanism() string {
 	if x != nil {
-		return x.PushMetrics
+		return x.AuthenticationMechanism
 	}
-	return false
+	return ""
 }
 
-func (x *AddAzureDatabaseExporterParams) GetLogLevel() LogLevel {
+func (x *AddRTAMongoDBAgentParams) GetRtaOptions() *RTAOptions {
 	if x != nil {
-		return x.LogLevel
+		return x.RtaOptions
 	}
-	return LogLevel_LOG_LEVEL_UNSPECIFIED
+	return nil
 }
 
-type ChangeAzureDatabaseExporterParams struct {
+type ChangeRTAMongoDBAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
 	// Replace all custom user-assigned labels.
 	CustomLabels *common.StringMap `protobuf:"bytes,2,opt,name=custom_labels,json=customLabels,proto3,oneof" json:"custom_labels,omitempty"`
-	// Enables push metrics with vmagent.
-	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
-	// Metrics resolution for this agent.
-	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	// Azure client ID
-	AzureClientId *string `protobuf:"bytes,5,opt,name=azure_client_id,json=azureClientId,proto3,oneof" json:"azure_client_id,omitempty"`
-	// Azure client secret
-	AzureClientSecret *string `protobuf:"bytes,6,opt,name=azure_client_secret,json=azureClientSecret,proto3,oneof" json:"azure_client_secret,omitempty"`
-	// Azure tenant ID
-	AzureTenantId *string `protobuf:"bytes,7,opt,name=azure_tenant_id,json=azureTenantId,proto3,oneof" json:"azure_tenant_id,omitempty"`
-	// Azure subscription ID
-	AzureSubscriptionId *string `protobuf:"bytes,8,opt,name=azure_subscription_id,json=azureSubscriptionId,proto3,oneof" json:"azure_subscription_id,omitempty"`
-	// Azure resource group.
-	AzureResourceGroup *string `protobuf:"bytes,9,opt,name=azure_resource_group,json=azureResourceGroup,proto3,oneof" json:"azure_resource_group,omitempty"`
 	// Log level for exporter.
-	LogLevel      *LogLevel `protobuf:"varint,11,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	LogLevel *LogLevel `protobuf:"varint,3,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
+	// MongoDB username for getting profile data.
+	Username *string `protobuf:"bytes,4,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// MongoDB password for getting profile data.
+	Password *string `protobuf:"bytes,5,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// Use TLS for database connections.
+	Tls *bool `protobuf:"varint,6,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TlsSkipVerify *bool `protobuf:"varint,7,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
+	// Client certificate and key.
+	TlsCertificateKey *string `protobuf:"bytes,8,opt,name=tls_certificate_key,json=tlsCertificateKey,proto3,oneof" json:"tls_certificate_key,omitempty"`
+	// Password for decrypting tls_certificate_key.
+	TlsCertificateKeyFilePassword *string `protobuf:"bytes,9,opt,name=tls_certificate_key_file_password,json=tlsCertificateKeyFilePassword,proto3,oneof" json:"tls_certificate_key_file_password,omitempty"`
+	// Certificate Authority certificate chain.
+	TlsCa *string `protobuf:"bytes,10,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
+	// Authentication mechanism.
+	AuthenticationMechanism *string `protobuf:"bytes,11,opt,name=authentication_mechanism,json=authenticationMechanism,proto3,oneof" json:"authentication_mechanism,omitempty"`
+	// Real-Time Analytics options.
+	RtaOptions *RTAOptions `protobuf:"bytes,12,opt,name=rta_options,json=rtaOptions,proto3,oneof" json:"rta_options,omitempty"`
+	// Skip connection check.
+	SkipConnectionCheck *bool `protobuf:"varint,13,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ChangeAzureDatabaseExporterParams) Reset() {
-	*x = ChangeAzureDatabaseExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[59]
+func (x *ChangeRTAMongoDBAgentParams) Reset() {
+	*x = ChangeRTAMongoDBAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeAzureDatabaseExporterParams) String() string {
+func (x *ChangeRTAMongoDBAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeAzureDatabaseExporterParams) ProtoMessage() {}
+func (*ChangeRTAMongoDBAgentParams) ProtoMessage() {}
 
-func (x *ChangeAzureDatabaseExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[59]
+func (x *ChangeRTAMongoDBAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10120,181 +12920,152 @@ func (x *ChangeAzureDatabaseExporterParams) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeAzureDatabaseExporterParams.ProtoReflect.Descriptor instead.
-func (*ChangeAzureDatabaseExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use ChangeRTAMongoDBAgentParams.ProtoReflect.Descriptor instead.
+func (*ChangeRTAMongoDBAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *ChangeAzureDatabaseExporterParams) GetEnable() bool {
+func (x *ChangeRTAMongoDBAgentParams) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
 	}
 	return false
 }
 
-func (x *ChangeAzureDatabaseExporterParams) GetCustomLabels() *common.StringMap {
+func (x *ChangeRTAMongoDBAgentParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangeAzureDatabaseExporterParams) GetEnablePushMetrics() bool {
-	if x != nil && x.EnablePushMetrics != nil {
-		return *x.EnablePushMetrics
+func (x *ChangeRTAMongoDBAgentParams) GetLogLevel() LogLevel {
+	if x != nil && x.LogLevel != nil {
+		return *x.LogLevel
 	}
-	return false
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *ChangeAzureDatabaseExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
-	if x != nil {
-		return x.MetricsResolutions
+func (x *ChangeRTAMongoDBAgentParams) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
 	}
-	return nil
+	return ""
 }
 
-func (x *ChangeAzureDatabaseExporterParams) GetAzureClientId() string {
-	if x != nil && x.AzureClientId != nil {
-		return *x.AzureClientId
+func (x *ChangeRTAMongoDBAgentParams) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
 	}
 	return ""
 }
 
-func (x *ChangeAzureDatabaseExporterParams) GetAzureClientSecret() string {
-	if x != nil && x.AzureClientSecret != nil {
-		return *x.AzureClientSecret
+func (x *ChangeRTAMongoDBAgentParams) GetTls() bool {
+	if x != nil && x.Tls != nil {
+		return *x.Tls
 	}
-	return ""
+	return false
 }
 
-func (x *ChangeAzureDatabaseExporterParams) GetAzureTenantId() string {
-	if x != nil && x.AzureTenantId != nil {
-		return *x.AzureTenantId
+func (x *ChangeRTAMongoDBAgentParams) GetTlsSkipVerify() bool {
+	if x != nil && x.TlsSkipVerify != nil {
+		return *x.TlsSkipVerify
 	}
-	return ""
+	return false
 }
 
-func (x *ChangeAzureDatabaseExporterParams) GetAzureSubscriptionId() string {
-	if x != nil && x.AzureSubscriptionId != nil {
-		return *x.AzureSubscriptionId
+func (x *ChangeRTAMongoDBAgentParams) GetTlsCertificateKey() string {
+	if x != nil && x.TlsCertificateKey != nil {
+		return *x.TlsCertificateKey
 	}
 	return ""
 }
 
-func (x *ChangeAzureDatabaseExporterParams) GetAzureResourceGroup() string {
-	if x != nil && x.AzureResourceGroup != nil {
-		return *x.AzureResourceGroup
+func (x *ChangeRTAMongoDBAgentParams) GetTlsCertificateKeyFilePassword() string {
+	if x != nil && x.TlsCertificateKeyFilePassword != nil {
+		return *x.TlsCertificateKeyFilePassword
 	}
 	return ""
 }
 
-func (x *ChangeAzureDatabaseExporterParams) GetLogLevel() LogLevel {
-	if x != nil && x.LogLevel != nil {
-		return *x.LogLevel
+func (x *ChangeRTAMongoDBAgentParams) GetTlsCa() string {
+	if x != nil && x.TlsCa != nil {
+		return *x.TlsCa
 	}
-	return LogLevel_LOG_LEVEL_UNSPECIFIED
-}
-
-type ChangeNomadAgentParams struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Enable this Agent. Agents are enabled by default when they get added.
-	Enable        *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *ChangeNomadAgentParams) Reset() {
-	*x = ChangeNomadAgentParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[60]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return ""
 }
 
-func (x *ChangeNomadAgentParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ChangeRTAMongoDBAgentParams) GetAuthenticationMechanism() string {
+	if x != nil && x.AuthenticationMechanism != nil {
+		return *x.AuthenticationMechanism
+	}
+	return ""
 }
 
-func (*ChangeNomadAgentParams) ProtoMessage() {}
-
-func (x *ChangeNomadAgentParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[60]
+func (x *ChangeRTAMongoDBAgentParams) GetRtaOptions() *RTAOptions {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RtaOptions
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use ChangeNomadAgentParams.ProtoReflect.Descriptor instead.
-func (*ChangeNomadAgentParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{60}
+	return nil
 }
 
-func (x *ChangeNomadAgentParams) GetEnable() bool {
-	if x != nil && x.Enable != nil {
-		return *x.Enable
+func (x *ChangeRTAMongoDBAgentParams) GetSkipConnectionCheck() bool {
+	if x != nil && x.SkipConnectionCheck != nil {
+		return *x.SkipConnectionCheck
 	}
 	return false
 }
 
-type AddValkeyExporterParams struct {
+type AddRTAMySQLAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The pmm-agent identifier which runs this instance.
 	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
 	// Service identifier.
 	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// Valkey username for scraping metrics.
+	// MySQL username for getting currently running queries.
 	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	// Valkey password for scraping metrics.
+	// MySQL password for getting currently running queries.
 	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `protobuf:"bytes,5,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Log level for agent.
+	LogLevel LogLevel `protobuf:"varint,6,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
+	// MySQL specific options.
 	// Use TLS for database connections.
-	Tls bool `protobuf:"varint,5,opt,name=tls,proto3" json:"tls,omitempty"`
+	Tls bool `protobuf:"varint,7,opt,name=tls,proto3" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
-	TlsSkipVerify bool `protobuf:"varint,6,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
-	// Custom user-assigned labels.
-	CustomLabels map[string]string `protobuf:"bytes,7,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TlsSkipVerify bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
+	// Certificate Authority certificate chain.
+	TlsCa string `protobuf:"bytes,9,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// Client certificate.
+	TlsCert string `protobuf:"bytes,10,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
+	// Password for decrypting tls_cert.
+	TlsKey string `protobuf:"bytes,11,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
 	// Skip connection check.
-	SkipConnectionCheck bool `protobuf:"varint,8,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
-	// Enables push metrics mode for exporter.
-	PushMetrics bool `protobuf:"varint,9,opt,name=push_metrics,json=pushMetrics,proto3" json:"push_metrics,omitempty"`
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `protobuf:"bytes,10,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
-	// TLS CA certificate.
-	TlsCa string `protobuf:"bytes,11,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
-	// TLS Certifcate.
-	TlsCert string `protobuf:"bytes,12,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
-	// TLS Certificate Key.
-	TlsKey string `protobuf:"bytes,13,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword string `protobuf:"bytes,14,opt,name=agent_password,json=agentPassword,proto3" json:"agent_password,omitempty"`
-	// Optionally expose the exporter process on all public interfaces
-	ExposeExporter bool `protobuf:"varint,15,opt,name=expose_exporter,json=exposeExporter,proto3" json:"expose_exporter,omitempty"`
-	// Log level for exporter.
-	LogLevel LogLevel `protobuf:"varint,16,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,17,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	SkipConnectionCheck bool `protobuf:"varint,12,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
+	// Extra DSN parameters for MySQL connection.
+	ExtraDsnParams map[string]string `protobuf:"bytes,13,rep,name=extra_dsn_params,json=extraDsnParams,proto3" json:"extra_dsn_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Real-Time Analytics options.
+	RtaOptions    *RTAOptions `protobuf:"bytes,14,opt,name=rta_options,json=rtaOptions,proto3" json:"rta_options,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddValkeyExporterParams) Reset() {
-	*x = AddValkeyExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[61]
+func (x *AddRTAMySQLAgentParams) Reset() {
+	*x = AddRTAMySQLAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddValkeyExporterParams) String() string {
+func (x *AddRTAMySQLAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddValkeyExporterParams) ProtoMessage() {}
+func (*AddRTAMySQLAgentParams) ProtoMessage() {}
 
-func (x *AddValkeyExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[61]
+func (x *AddRTAMySQLAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10305,185 +13076,154 @@ func (x *AddValkeyExporterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddValkeyExporterParams.ProtoReflect.Descriptor instead.
-func (*AddValkeyExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{61}
-}
-
-func (x *AddValkeyExporterParams) GetPmmAgentId() string {
+// Deprecated: Use AddRTAMySQLAgentParams.ProtoReflect.Descriptor instead.
+func (*AddRTAMySQLAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *AddRTAMySQLAgentParams) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *AddValkeyExporterParams) GetServiceId() string {
+func (x *AddRTAMySQLAgentParams) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *AddValkeyExporterParams) GetUsername() string {
+func (x *AddRTAMySQLAgentParams) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *AddValkeyExporterParams) GetPassword() string {
+func (x *AddRTAMySQLAgentParams) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-func (x *AddValkeyExporterParams) GetTls() bool {
-	if x != nil {
-		return x.Tls
-	}
-	return false
-}
-
-func (x *AddValkeyExporterParams) GetTlsSkipVerify() bool {
-	if x != nil {
-		return x.TlsSkipVerify
-	}
-	return false
-}
-
-func (x *AddValkeyExporterParams) GetCustomLabels() map[string]string {
+func (x *AddRTAMySQLAgentParams) GetCustomLabels() map[string]string {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *AddValkeyExporterParams) GetSkipConnectionCheck() bool {
+func (x *AddRTAMySQLAgentParams) GetLogLevel() LogLevel {
 	if x != nil {
-		return x.SkipConnectionCheck
+		return x.LogLevel
 	}
-	return false
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *AddValkeyExporterParams) GetPushMetrics() bool {
+func (x *AddRTAMySQLAgentParams) GetTls() bool {
 	if x != nil {
-		return x.PushMetrics
+		return x.Tls
 	}
 	return false
 }
 
-func (x *AddValkeyExporterParams) GetDisableCollectors() []string {
+func (x *AddRTAMySQLAgentParams) GetTlsSkipVerify() bool {
 	if x != nil {
-		return x.DisableCollectors
+		return x.TlsSkipVerify
 	}
-	return nil
+	return false
 }
 
-func (x *AddValkeyExporterParams) GetTlsCa() string {
+func (x *AddRTAMySQLAgentParams) GetTlsCa() string {
 	if x != nil {
 		return x.TlsCa
 	}
 	return ""
 }
 
-func (x *AddValkeyExporterParams) GetTlsCert() string {
+func (x *AddRTAMySQLAgentParams) GetTlsCert() string {
 	if x != nil {
 		return x.TlsCert
 	}
 	return ""
 }
 
-func (x *AddValkeyExporterParams) GetTlsKey() string {
+func (x *AddRTAMySQLAgentParams) GetTlsKey() string {
 	if x != nil {
 		return x.TlsKey
 	}
 	return ""
 }
 
-func (x *AddValkeyExporterParams) GetAgentPassword() string {
-	if x != nil {
-		return x.AgentPassword
-	}
-	return ""
-}
-
-func (x *AddValkeyExporterParams) GetExposeExporter() bool {
+func (x *AddRTAMySQLAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil {
-		return x.ExposeExporter
+		return x.SkipConnectionCheck
 	}
 	return false
 }
 
-func (x *AddValkeyExporterParams) GetLogLevel() LogLevel {
+func (x *AddRTAMySQLAgentParams) GetExtraDsnParams() map[string]string {
 	if x != nil {
-		return x.LogLevel
+		return x.ExtraDsnParams
 	}
-	return LogLevel_LOG_LEVEL_UNSPECIFIED
+	return nil
 }
 
-func (x *AddValkeyExporterParams) GetConnectionTimeout() *durationpb.Duration {
+func (x *AddRTAMySQLAgentParams) GetRtaOptions() *RTAOptions {
 	if x != nil {
-		return x.ConnectionTimeout
+		return x.RtaOptions
 	}
 	return nil
 }
 
-type ChangeValkeyExporterParams struct {
+type ChangeRTAMySQLAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
 	// Replace all custom user-assigned labels.
 	CustomLabels *common.StringMap `protobuf:"bytes,2,opt,name=custom_labels,json=customLabels,proto3,oneof" json:"custom_labels,omitempty"`
-	// Enables push metrics with vmagent.
-	EnablePushMetrics *bool `protobuf:"varint,3,opt,name=enable_push_metrics,json=enablePushMetrics,proto3,oneof" json:"enable_push_metrics,omitempty"`
-	// Metrics resolution for this agent.
-	MetricsResolutions *common.MetricsResolutions `protobuf:"bytes,4,opt,name=metrics_resolutions,json=metricsResolutions,proto3" json:"metrics_resolutions,omitempty"`
-	// Valkey username for scraping metrics.
-	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// Valkey password for scraping metrics.
-	Password *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	// Log level for exporter.
+	LogLevel *LogLevel `protobuf:"varint,3,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
+	// MySQL username for getting currently running queries.
+	Username *string `protobuf:"bytes,4,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// MySQL password for getting currently running queries.
+	Password *string `protobuf:"bytes,5,opt,name=password,proto3,oneof" json:"password,omitempty"`
 	// Use TLS for database connections.
-	Tls *bool `protobuf:"varint,7,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
+	Tls *bool `protobuf:"varint,6,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
-	TlsSkipVerify *bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `protobuf:"bytes,9,rep,name=disable_collectors,json=disableCollectors,proto3" json:"disable_collectors,omitempty"`
-	// TLS CA certificate.
-	TlsCa *string `protobuf:"bytes,10,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
-	// TLS Certifcate.
-	TlsCert *string `protobuf:"bytes,11,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
-	// TLS Certificate Key.
-	TlsKey *string `protobuf:"bytes,12,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword *string `protobuf:"bytes,13,opt,name=agent_password,json=agentPassword,proto3,oneof" json:"agent_password,omitempty"`
-	// Optionally expose the exporter process on all public interfaces
-	ExposeExporter *bool `protobuf:"varint,14,opt,name=expose_exporter,json=exposeExporter,proto3,oneof" json:"expose_exporter,omitempty"`
-	// Log level for exporter.
-	LogLevel *LogLevel `protobuf:"varint,15,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,16,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
+	TlsSkipVerify *bool `protobuf:"varint,7,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
+	// Certificate Authority certificate chain.
+	TlsCa *string `protobuf:"bytes,8,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
+	// Client certificate.
+	TlsCert *string `protobuf:"bytes,9,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
+	// Password for decrypting tls_cert.
+	TlsKey *string `protobuf:"bytes,10,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
+	// Real-Time Analytics options.
+	RtaOptions *RTAOptions `protobuf:"bytes,11,opt,name=rta_options,json=rtaOptions,proto3,oneof" json:"rta_options,omitempty"`
 	// Skip connection check.
-	SkipConnectionCheck *bool `protobuf:"varint,17,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
+	SkipConnectionCheck *bool `protobuf:"varint,12,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ChangeValkeyExporterParams) Reset() {
-	*x = ChangeValkeyExporterParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[62]
+func (x *ChangeRTAMySQLAgentParams) Reset() {
+	*x = ChangeRTAMySQLAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeValkeyExporterParams) String() string {
+func (x *ChangeRTAMySQLAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeValkeyExporterParams) ProtoMessage() {}
+func (*ChangeRTAMySQLAgentParams) ProtoMessage() {}
 
-func (x *ChangeValkeyExporterParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[62]
+func (x *ChangeRTAMySQLAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10494,182 +13234,143 @@ func (x *ChangeValkeyExporterParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeValkeyExporterParams.ProtoReflect.Descriptor instead.
-func (*ChangeValkeyExporterParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use ChangeRTAMySQLAgentParams.ProtoReflect.Descriptor instead.
+func (*ChangeRTAMySQLAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *ChangeValkeyExporterParams) GetEnable() bool {
+func (x *ChangeRTAMySQLAgentParams) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
 	}
 	return false
 }
 
-func (x *ChangeValkeyExporterParams) GetCustomLabels() *common.StringMap {
+func (x *ChangeRTAMySQLAgentParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangeValkeyExporterParams) GetEnablePushMetrics() bool {
-	if x != nil && x.EnablePushMetrics != nil {
-		return *x.EnablePushMetrics
-	}
-	return false
-}
-
-func (x *ChangeValkeyExporterParams) GetMetricsResolutions() *common.MetricsResolutions {
-	if x != nil {
-		return x.MetricsResolutions
+func (x *ChangeRTAMySQLAgentParams) GetLogLevel() LogLevel {
+	if x != nil && x.LogLevel != nil {
+		return *x.LogLevel
 	}
-	return nil
+	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *ChangeValkeyExporterParams) GetUsername() string {
+func (x *ChangeRTAMySQLAgentParams) GetUsername() string {
 	if x != nil && x.Username != nil {
 		return *x.Username
 	}
 	return ""
 }
 
-func (x *ChangeValkeyExporterParams) GetPassword() string {
+func (x *ChangeRTAMySQLAgentParams) GetPassword() string {
 	if x != nil && x.Password != nil {
 		return *x.Password
 	}
 	return ""
 }
 
-func (x *ChangeValkeyExporterParams) GetTls() bool {
+func (x *ChangeRTAMySQLAgentParams) GetTls() bool {
 	if x != nil && x.Tls != nil {
 		return *x.Tls
 	}
 	return false
 }
 
-func (x *ChangeValkeyExporterParams) GetTlsSkipVerify() bool {
+func (x *ChangeRTAMySQLAgentParams) GetTlsSkipVerify() bool {
 	if x != nil && x.TlsSkipVerify != nil {
 		return *x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *ChangeValkeyExporterParams) GetDisableCollectors() []string {
-	if x != nil {
-		return x.DisableCollectors
-	}
-	return nil
-}
-
-func (x *ChangeValkeyExporterParams) GetTlsCa() string {
+func (x *ChangeRTAMySQLAgentParams) GetTlsCa() string {
 	if x != nil && x.TlsCa != nil {
 		return *x.TlsCa
 	}
 	return ""
 }
 
-func (x *ChangeValkeyExporterParams) GetTlsCert() string {
+func (x *ChangeRTAMySQLAgentParams) GetTlsCert() string {
 	if x != nil && x.TlsCert != nil {
 		return *x.TlsCert
 	}
 	return ""
 }
 
-func (x *ChangeValkeyExporterParams) GetTlsKey() string {
+func (x *ChangeRTAMySQLAgentParams) GetTlsKey() string {
 	if x != nil && x.TlsKey != nil {
 		return *x.TlsKey
 	}
 	return ""
 }
 
-func (x *ChangeValkeyExporterParams) GetAgentPassword() string {
-	if x != nil && x.AgentPassword != nil {
-		return *x.AgentPassword
-	}
-	return ""
-}
-
-func (x *ChangeValkeyExporterParams) GetExposeExporter() bool {
-	if x != nil && x.ExposeExporter != nil {
-		return *x.ExposeExporter
-	}
-	return false
-}
-
-func (x *ChangeValkeyExporterParams) GetLogLevel() LogLevel {
-	if x != nil && x.LogLevel != nil {
-		return *x.LogLevel
-	}
-	return LogLevel_LOG_LEVEL_UNSPECIFIED
-}
-
-func (x *ChangeValkeyExporterParams) GetConnectionTimeout() *durationpb.Duration {
+func (x *ChangeRTAMySQLAgentParams) GetRtaOptions() *RTAOptions {
 	if x != nil {
-		return x.ConnectionTimeout
+		return x.RtaOptions
 	}
 	return nil
 }
 
-func (x *ChangeValkeyExporterParams) GetSkipConnectionCheck() bool {
+func (x *ChangeRTAMySQLAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil && x.SkipConnectionCheck != nil {
 		return *x.SkipConnectionCheck
 	}
 	return false
 }
 
-type AddRTAMongoDBAgentParams struct {
+type AddRTAPostgreSQLAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The pmm-agent identifier which runs this instance.
 	PmmAgentId string `protobuf:"bytes,1,opt,name=pmm_agent_id,json=pmmAgentId,proto3" json:"pmm_agent_id,omitempty"`
 	// Service identifier.
 	ServiceId string `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
-	// MongoDB username for getting queries data.
+	// PostgreSQL username for getting currently running queries.
 	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	// MongoDB password for getting queries data.
+	// PostgreSQL password for getting currently running queries.
 	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `protobuf:"bytes,5,rep,name=custom_labels,json=customLabels,proto3" json:"custom_labels,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	// Log level for agent.
 	LogLevel LogLevel `protobuf:"varint,6,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel" json:"log_level,omitempty"`
-	// MongoDB specific options.
+	// PostgreSQL specific options.
 	// Use TLS for database connections.
 	Tls bool `protobuf:"varint,7,opt,name=tls,proto3" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
 	TlsSkipVerify bool `protobuf:"varint,8,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3" json:"tls_skip_verify,omitempty"`
-	// Client certificate and key.
-	TlsCertificateKey string `protobuf:"bytes,9,opt,name=tls_certificate_key,json=tlsCertificateKey,proto3" json:"tls_certificate_key,omitempty"`
-	// Password for decrypting tls_certificate_key.
-	TlsCertificateKeyFilePassword string `protobuf:"bytes,10,opt,name=tls_certificate_key_file_password,json=tlsCertificateKeyFilePassword,proto3" json:"tls_certificate_key_file_password,omitempty"`
-	// Certificate Authority certificate chain.
-	TlsCa string `protobuf:"bytes,11,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// TLS CA certificate.
+	TlsCa string `protobuf:"bytes,9,opt,name=tls_ca,json=tlsCa,proto3" json:"tls_ca,omitempty"`
+	// TLS Certificate.
+	TlsCert string `protobuf:"bytes,10,opt,name=tls_cert,json=tlsCert,proto3" json:"tls_cert,omitempty"`
+	// TLS Certificate Key.
+	TlsKey string `protobuf:"bytes,11,opt,name=tls_key,json=tlsKey,proto3" json:"tls_key,omitempty"`
 	// Skip connection check.
 	SkipConnectionCheck bool `protobuf:"varint,12,opt,name=skip_connection_check,json=skipConnectionCheck,proto3" json:"skip_connection_check,omitempty"`
-	// Authentication mechanism.
-	// See https://docs.mongodb.com/manual/reference/connection-string/#mongodb-urioption-urioption.authMechanism
-	// for details.
-	AuthenticationMechanism string `protobuf:"bytes,13,opt,name=authentication_mechanism,json=authenticationMechanism,proto3" json:"authentication_mechanism,omitempty"`
 	// Real-Time Analytics options.
-	RtaOptions    *RTAOptions `protobuf:"bytes,14,opt,name=rta_options,json=rtaOptions,proto3" json:"rta_options,omitempty"`
+	RtaOptions    *RTAOptions `protobuf:"bytes,13,opt,name=rta_options,json=rtaOptions,proto3" json:"rta_options,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AddRTAMongoDBAgentParams) Reset() {
-	*x = AddRTAMongoDBAgentParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[63]
+func (x *AddRTAPostgreSQLAgentParams) Reset() {
+	*x = AddRTAPostgreSQLAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AddRTAMongoDBAgentParams) String() string {
+func (x *AddRTAPostgreSQLAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddRTAMongoDBAgentParams) ProtoMessage() {}
+func (*AddRTAPostgreSQLAgentParams) ProtoMessage() {}
 
-func (x *AddRTAMongoDBAgentParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[63]
+func (x *AddRTAPostgreSQLAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10680,110 +13381,103 @@ func (x *AddRTAMongoDBAgentParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddRTAMongoDBAgentParams.ProtoReflect.Descriptor instead.
-func (*AddRTAMongoDBAgentParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use AddRTAPostgreSQLAgentParams.ProtoReflect.Descriptor instead.
+func (*AddRTAPostgreSQLAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *AddRTAMongoDBAgentParams) GetPmmAgentId() string {
+func (x *AddRTAPostgreSQLAgentParams) GetPmmAgentId() string {
 	if x != nil {
 		return x.PmmAgentId
 	}
 	return ""
 }
 
-func (x *AddRTAMongoDBAgentParams) GetServiceId() string {
+func (x *AddRTAPostgreSQLAgentParams) GetServiceId() string {
 	if x != nil {
 		return x.ServiceId
 	}
 	return ""
 }
 
-func (x *AddRTAMongoDBAgentParams) GetUsername() string {
+func (x *AddRTAPostgreSQLAgentParams) GetUsername() string {
 	if x != nil {
 		return x.Username
 	}
 	return ""
 }
 
-func (x *AddRTAMongoDBAgentParams) GetPassword() string {
+func (x *AddRTAPostgreSQLAgentParams) GetPassword() string {
 	if x != nil {
 		return x.Password
 	}
 	return ""
 }
 
-func (x *AddRTAMongoDBAgentParams) GetCustomLabels() map[string]string {
+func (x *AddRTAPostgreSQLAgentParams) GetCustomLabels() map[string]string {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *AddRTAMongoDBAgentParams) GetLogLevel() LogLevel {
+func (x *AddRTAPostgreSQLAgentParams) GetLogLevel() LogLevel {
 	if x != nil {
 		return x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *AddRTAMongoDBAgentParams) GetTls() bool {
+func (x *AddRTAPostgreSQLAgentParams) GetTls() bool {
 	if x != nil {
 		return x.Tls
 	}
 	return false
 }
 
-func (x *AddRTAMongoDBAgentParams) GetTlsSkipVerify() bool {
+func (x *AddRTAPostgreSQLAgentParams) GetTlsSkipVerify() bool {
 	if x != nil {
 		return x.TlsSkipVerify
 	}
 	return false
 }
 
-func (x *AddRTAMongoDBAgentParams) GetTlsCertificateKey() string {
+func (x *AddRTAPostgreSQLAgentParams) GetTlsCa() string {
 	if x != nil {
-		return x.TlsCertificateKey
+		return x.TlsCa
 	}
 	return ""
 }
 
-func (x *AddRTAMongoDBAgentParams) GetTlsCertificateKeyFilePassword() string {
+func (x *AddRTAPostgreSQLAgentParams) GetTlsCert() string {
 	if x != nil {
-		return x.TlsCertificateKeyFilePassword
+		return x.TlsCert
 	}
 	return ""
 }
 
-func (x *AddRTAMongoDBAgentParams) GetTlsCa() string {
+func (x *AddRTAPostgreSQLAgentParams) GetTlsKey() string {
 	if x != nil {
-		return x.TlsCa
+		return x.TlsKey
 	}
 	return ""
 }
 
-func (x *AddRTAMongoDBAgentParams) GetSkipConnectionCheck() bool {
+func (x *AddRTAPostgreSQLAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil {
 		return x.SkipConnectionCheck
 	}
 	return false
 }
 
-func (x *AddRTAMongoDBAgentParams) GetAuthenticationMechanism() string {
-	if x != nil {
-		return x.AuthenticationMechanism
-	}
-	return ""
-}
-
-func (x *AddRTAMongoDBAgentParams) GetRtaOptions() *RTAOptions {
+func (x *AddRTAPostgreSQLAgentParams) GetRtaOptions() *RTAOptions {
 	if x != nil {
 		return x.RtaOptions
 	}
 	return nil
 }
 
-type ChangeRTAMongoDBAgentParams struct {
+type ChangeRTAPostgreSQLAgentParams struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `protobuf:"varint,1,opt,name=enable,proto3,oneof" json:"enable,omitempty"`
@@ -10791,45 +13485,43 @@ type ChangeRTAMongoDBAgentParams struct {
 	CustomLabels *common.StringMap `protobuf:"bytes,2,opt,name=custom_labels,json=customLabels,proto3,oneof" json:"custom_labels,omitempty"`
 	// Log level for exporter.
 	LogLevel *LogLevel `protobuf:"varint,3,opt,name=log_level,json=logLevel,proto3,enum=inventory.v1.LogLevel,oneof" json:"log_level,omitempty"`
-	// MongoDB username for getting profile data.
+	// PostgreSQL username for getting currently running queries.
 	Username *string `protobuf:"bytes,4,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// MongoDB password for getting profile data.
+	// PostgreSQL password for getting currently running queries.
 	Password *string `protobuf:"bytes,5,opt,name=password,proto3,oneof" json:"password,omitempty"`
 	// Use TLS for database connections.
 	Tls *bool `protobuf:"varint,6,opt,name=tls,proto3,oneof" json:"tls,omitempty"`
 	// Skip TLS certificate and hostname validation.
 	TlsSkipVerify *bool `protobuf:"varint,7,opt,name=tls_skip_verify,json=tlsSkipVerify,proto3,oneof" json:"tls_skip_verify,omitempty"`
-	// Client certificate and key.
-	TlsCertificateKey *string `protobuf:"bytes,8,opt,name=tls_certificate_key,json=tlsCertificateKey,proto3,oneof" json:"tls_certificate_key,omitempty"`
-	// Password for decrypting tls_certificate_key.
-	TlsCertificateKeyFilePassword *string `protobuf:"bytes,9,opt,name=tls_certificate_key_file_password,json=tlsCertificateKeyFilePassword,proto3,oneof" json:"tls_certificate_key_file_password,omitempty"`
-	// Certificate Authority certificate chain.
-	TlsCa *string `protobuf:"bytes,10,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
-	// Authentication mechanism.
-	AuthenticationMechanism *string `protobuf:"bytes,11,opt,name=authentication_mechanism,json=authenticationMechanism,proto3,oneof" json:"authentication_mechanism,omitempty"`
+	// TLS CA certificate.
+	TlsCa *string `protobuf:"bytes,8,opt,name=tls_ca,json=tlsCa,proto3,oneof" json:"tls_ca,omitempty"`
+	// TLS Certificate.
+	TlsCert *string `protobuf:"bytes,9,opt,name=tls_cert,json=tlsCert,proto3,oneof" json:"tls_cert,omitempty"`
+	// TLS Certificate Key.
+	TlsKey *string `protobuf:"bytes,10,opt,name=tls_key,json=tlsKey,proto3,oneof" json:"tls_key,omitempty"`
 	// Real-Time Analytics options.
-	RtaOptions *RTAOptions `protobuf:"bytes,12,opt,name=rta_options,json=rtaOptions,proto3,oneof" json:"rta_options,omitempty"`
+	RtaOptions *RTAOptions `protobuf:"bytes,11,opt,name=rta_options,json=rtaOptions,proto3,oneof" json:"rta_options,omitempty"`
 	// Skip connection check.
-	SkipConnectionCheck *bool `protobuf:"varint,13,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
+	SkipConnectionCheck *bool `protobuf:"varint,12,opt,name=skip_connection_check,json=skipConnectionCheck,proto3,oneof" json:"skip_connection_check,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
-func (x *ChangeRTAMongoDBAgentParams) Reset() {
-	*x = ChangeRTAMongoDBAgentParams{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[64]
+func (x *ChangeRTAPostgreSQLAgentParams) Reset() {
+	*x = ChangeRTAPostgreSQLAgentParams{}
+	mi := &file_inventory_v1_agents_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeRTAMongoDBAgentParams) String() string {
+func (x *ChangeRTAPostgreSQLAgentParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeRTAMongoDBAgentParams) ProtoMessage() {}
+func (*ChangeRTAPostgreSQLAgentParams) ProtoMessage() {}
 
-func (x *ChangeRTAMongoDBAgentParams) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[64]
+func (x *ChangeRTAPostgreSQLAgentParams) ProtoReflect() protoreflect.Message {
+	mi := &file_inventory_v1_agents_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10840,96 +13532,89 @@ func (x *ChangeRTAMongoDBAgentParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeRTAMongoDBAgentParams.ProtoReflect.Descriptor instead.
-func (*ChangeRTAMongoDBAgentParams) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use ChangeRTAPostgreSQLAgentParams.ProtoReflect.Descriptor instead.
+func (*ChangeRTAPostgreSQLAgentParams) Descriptor() ([]byte, []int) {
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *ChangeRTAMongoDBAgentParams) GetEnable() bool {
+func (x *ChangeRTAPostgreSQLAgentParams) GetEnable() bool {
 	if x != nil && x.Enable != nil {
 		return *x.Enable
 	}
 	return false
 }
 
-func (x *ChangeRTAMongoDBAgentParams) GetCustomLabels() *common.StringMap {
+func (x *ChangeRTAPostgreSQLAgentParams) GetCustomLabels() *common.StringMap {
 	if x != nil {
 		return x.CustomLabels
 	}
 	return nil
 }
 
-func (x *ChangeRTAMongoDBAgentParams) GetLogLevel() LogLevel {
+func (x *ChangeRTAPostgreSQLAgentParams) GetLogLevel() LogLevel {
 	if x != nil && x.LogLevel != nil {
 		return *x.LogLevel
 	}
 	return LogLevel_LOG_LEVEL_UNSPECIFIED
 }
 
-func (x *ChangeRTAMongoDBAgentParams) GetUsername() string {
+func (x *ChangeRTAPostgreSQLAgentParams) GetUsername() string {
 	if x != nil && x.Username != nil {
 		return *x.Username
 	}
 	return ""
 }
 
-func (x *ChangeRTAMongoDBAgentParams) GetPassword() string {
+func (x *ChangeRTAPostgreSQLAgentParams) GetPassword() string {
 	if x != nil && x.Password != nil {
 		return *x.Password
 	}
 	return ""
 }
 
-func (x *ChangeRTAMongoDBAgentParams) GetTls() bool {
+func (x *ChangeRTAPostgreSQLAgentParams) GetTls() bool {
 	if x != nil && x.Tls != nil {
 		return *x.Tls
 	}
 	return false
 }
 
-func (x *ChangeRTAMongoDBAgentParams) GetTlsSkipVerify() bool {
+func (x *ChangeRTAPostgreSQLAgentParams) GetTlsSkipVerify() bool {
 	if x != nil && x.TlsSkipVerify != nil {
 		return *x.TlsSkipVerify
 	}
-	return false
-}
-
-func (x *ChangeRTAMongoDBAgentParams) GetTlsCertificateKey() string {
-	if x != nil && x.TlsCertificateKey != nil {
-		return *x.TlsCertificateKey
-	}
-	return ""
+	return false
 }
 
-func (x *ChangeRTAMongoDBAgentParams) GetTlsCertificateKeyFilePassword() string {
-	if x != nil && x.TlsCertificateKeyFilePassword != nil {
-		return *x.TlsCertificateKeyFilePassword
+func (x *ChangeRTAPostgreSQLAgentParams) GetTlsCa() string {
+	if x != nil && x.TlsCa != nil {
+		return *x.TlsCa
 	}
 	return ""
 }
 
-func (x *ChangeRTAMongoDBAgentParams) GetTlsCa() string {
-	if x != nil && x.TlsCa != nil {
-		return *x.TlsCa
+func (x *ChangeRTAPostgreSQLAgentParams) GetTlsCert() string {
+	if x != nil && x.TlsCert != nil {
+		return *x.TlsCert
 	}
 	return ""
 }
 
-func (x *ChangeRTAMongoDBAgentParams) GetAuthenticationMechanism() string {
-	if x != nil && x.AuthenticationMechanism != nil {
-		return *x.AuthenticationMechanism
+func (x *ChangeRTAPostgreSQLAgentParams) GetTlsKey() string {
+	if x != nil && x.TlsKey != nil {
+		return *x.TlsKey
 	}
 	return ""
 }
 
-func (x *ChangeRTAMongoDBAgentParams) GetRtaOptions() *RTAOptions {
+func (x *ChangeRTAPostgreSQLAgentParams) GetRtaOptions() *RTAOptions {
 	if x != nil {
 		return x.RtaOptions
 	}
 	return nil
 }
 
-func (x *ChangeRTAMongoDBAgentParams) GetSkipConnectionCheck() bool {
+func (x *ChangeRTAPostgreSQLAgentParams) GetSkipConnectionCheck() bool {
 	if x != nil && x.SkipConnectionCheck != nil {
 		return *x.SkipConnectionCheck
 	}
@@ -10947,7 +13632,7 @@ type RemoveAgentRequest struct {
 
 func (x *RemoveAgentRequest) Reset() {
 	*x = RemoveAgentRequest{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[65]
+	mi := &file_inventory_v1_agents_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -10959,7 +13644,7 @@ func (x *RemoveAgentRequest) String() string {
 func (*RemoveAgentRequest) ProtoMessage() {}
 
 func (x *RemoveAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[65]
+	mi := &file_inventory_v1_agents_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -10972,7 +13657,7 @@ func (x *RemoveAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveAgentRequest.ProtoReflect.Descriptor instead.
 func (*RemoveAgentRequest) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{65}
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *RemoveAgentRequest) GetAgentId() string {
@@ -10997,7 +13682,7 @@ type RemoveAgentResponse struct {
 
 func (x *RemoveAgentResponse) Reset() {
 	*x = RemoveAgentResponse{}
-	mi := &file_inventory_v1_agents_proto_msgTypes[66]
+	mi := &file_inventory_v1_agents_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -11009,7 +13694,7 @@ func (x *RemoveAgentResponse) String() string {
 func (*RemoveAgentResponse) ProtoMessage() {}
 
 func (x *RemoveAgentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_inventory_v1_agents_proto_msgTypes[66]
+	mi := &file_inventory_v1_agents_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -11022,7 +13707,7 @@ func (x *RemoveAgentResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveAgentResponse.ProtoReflect.Descriptor instead.
 func (*RemoveAgentResponse) Descriptor() ([]byte, []int) {
-	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{66}
+	return file_inventory_v1_agents_proto_rawDescGZIP(), []int{81}
 }
 
 var File_inventory_v1_agents_proto protoreflect.FileDescriptor
@@ -11315,7 +14000,45 @@ const file_inventory_v1_agents_proto_rawDesc = "" +
 	"\tlog_level\x18\x16 \x01(\x0e2\x16.inventory.v1.LogLevelR\blogLevel\x1a?\n" +
 	"\x11CustomLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\b\x10\tR\x17query_examples_disabled\"^\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\b\x10\tR\x17query_examples_disabled\"\xfe\x04\n" +
+	"\x15QANValkeySlowlogAgent\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12 \n" +
+	"\fpmm_agent_id\x18\x02 \x01(\tR\n" +
+	"pmmAgentId\x12\x1a\n" +
+	"\bdisabled\x18\x03 \x01(\bR\bdisabled\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x04 \x01(\tR\tserviceId\x12 \n" +
+	"\busername\x18\x05 \x01(\tB\x04\x88\xb5\x18\x01R\busername\x12\x10\n" +
+	"\x03tls\x18\x06 \x01(\bR\x03tls\x12&\n" +
+	"\x0ftls_skip_verify\x18\a \x01(\bR\rtlsSkipVerify\x12(\n" +
+	"\x10max_query_length\x18\b \x01(\x05R\x0emaxQueryLength\x126\n" +
+	"\x17query_examples_disabled\x18\t \x01(\bR\x15queryExamplesDisabled\x12Z\n" +
+	"\rcustom_labels\x18\n" +
+	" \x03(\v25.inventory.v1.QANValkeySlowlogAgent.CustomLabelsEntryR\fcustomLabels\x121\n" +
+	"\x06status\x18\x14 \x01(\x0e2\x19.inventory.v1.AgentStatusR\x06status\x12*\n" +
+	"\x11process_exec_path\x18\x15 \x01(\tR\x0fprocessExecPath\x123\n" +
+	"\tlog_level\x18\x16 \x01(\x0e2\x16.inventory.v1.LogLevelR\blogLevel\x1a?\n" +
+	"\x11CustomLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc8\x04\n" +
+	"\x16QANProxySQLDigestAgent\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12 \n" +
+	"\fpmm_agent_id\x18\x02 \x01(\tR\n" +
+	"pmmAgentId\x12\x1a\n" +
+	"\bdisabled\x18\x03 \x01(\bR\bdisabled\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x04 \x01(\tR\tserviceId\x12 \n" +
+	"\busername\x18\x05 \x01(\tB\x04\x88\xb5\x18\x01R\busername\x12\x10\n" +
+	"\x03tls\x18\x06 \x01(\bR\x03tls\x12&\n" +
+	"\x0ftls_skip_verify\x18\a \x01(\bR\rtlsSkipVerify\x12(\n" +
+	"\x10max_query_length\x18\b \x01(\x05R\x0emaxQueryLength\x12[\n" +
+	"\rcustom_labels\x18\t \x03(\v26.inventory.v1.QANProxySQLDigestAgent.CustomLabelsEntryR\fcustomLabels\x121\n" +
+	"\x06status\x18\x14 \x01(\x0e2\x19.inventory.v1.AgentStatusR\x06status\x12*\n" +
+	"\x11process_exec_path\x18\x15 \x01(\tR\x0fprocessExecPath\x123\n" +
+	"\tlog_level\x18\x16 \x01(\x0e2\x16.inventory.v1.LogLevelR\blogLevel\x1a?\n" +
+	"\x11CustomLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"^\n" +
 	"\n" +
 	"RTAOptions\x12P\n" +
 	"\x10collect_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationB\n" +
@@ -11338,6 +14061,44 @@ const file_inventory_v1_agents_proto_rawDesc = "" +
 	"\tlog_level\x18\v \x01(\x0e2\x16.inventory.v1.LogLevelR\blogLevel\x1a?\n" +
 	"\x11CustomLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9b\x04\n" +
+	"\rRTAMySQLAgent\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12 \n" +
+	"\fpmm_agent_id\x18\x02 \x01(\tR\n" +
+	"pmmAgentId\x12\x1a\n" +
+	"\bdisabled\x18\x03 \x01(\bR\bdisabled\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x04 \x01(\tR\tserviceId\x12 \n" +
+	"\busername\x18\x05 \x01(\tB\x04\x88\xb5\x18\x01R\busername\x12\x10\n" +
+	"\x03tls\x18\x06 \x01(\bR\x03tls\x12&\n" +
+	"\x0ftls_skip_verify\x18\a \x01(\bR\rtlsSkipVerify\x12R\n" +
+	"\rcustom_labels\x18\b \x03(\v2-.inventory.v1.RTAMySQLAgent.CustomLabelsEntryR\fcustomLabels\x129\n" +
+	"\vrta_options\x18\t \x01(\v2\x18.inventory.v1.RTAOptionsR\n" +
+	"rtaOptions\x121\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x19.inventory.v1.AgentStatusR\x06status\x123\n" +
+	"\tlog_level\x18\v \x01(\x0e2\x16.inventory.v1.LogLevelR\blogLevel\x1a?\n" +
+	"\x11CustomLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa5\x04\n" +
+	"\x12RTAPostgreSQLAgent\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12 \n" +
+	"\fpmm_agent_id\x18\x02 \x01(\tR\n" +
+	"pmmAgentId\x12\x1a\n" +
+	"\bdisabled\x18\x03 \x01(\bR\bdisabled\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x04 \x01(\tR\tserviceId\x12 \n" +
+	"\busername\x18\x05 \x01(\tB\x04\x88\xb5\x18\x01R\busername\x12\x10\n" +
+	"\x03tls\x18\x06 \x01(\bR\x03tls\x12&\n" +
+	"\x0ftls_skip_verify\x18\a \x01(\bR\rtlsSkipVerify\x12W\n" +
+	"\rcustom_labels\x18\b \x03(\v22.inventory.v1.RTAPostgreSQLAgent.CustomLabelsEntryR\fcustomLabels\x129\n" +
+	"\vrta_options\x18\t \x01(\v2\x18.inventory.v1.RTAOptionsR\n" +
+	"rtaOptions\x121\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x19.inventory.v1.AgentStatusR\x06status\x123\n" +
+	"\tlog_level\x18\v \x01(\x0e2\x16.inventory.v1.LogLevelR\blogLevel\x1a?\n" +
+	"\x11CustomLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x92\x05\n" +
 	"\x1eQANPostgreSQLPgStatementsAgent\x12\x19\n" +
 	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12 \n" +
@@ -11379,6 +14140,27 @@ const file_inventory_v1_agents_proto_rawDesc = "" +
 	"\tlog_level\x18\x16 \x01(\x0e2\x16.inventory.v1.LogLevelR\blogLevel\x1a?\n" +
 	"\x11CustomLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb8\x05\n" +
+	"\x15QANPostgreSQLLogAgent\x12\x19\n" +
+	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12 \n" +
+	"\fpmm_agent_id\x18\x02 \x01(\tR\n" +
+	"pmmAgentId\x12\x1a\n" +
+	"\bdisabled\x18\x03 \x01(\bR\bdisabled\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x04 \x01(\tR\tserviceId\x12 \n" +
+	"\busername\x18\x05 \x01(\tB\x04\x88\xb5\x18\x01R\busername\x12\x10\n" +
+	"\x03tls\x18\x06 \x01(\bR\x03tls\x12&\n" +
+	"\x0ftls_skip_verify\x18\a \x01(\bR\rtlsSkipVerify\x128\n" +
+	"\x18disable_comments_parsing\x18\b \x01(\bR\x16disableCommentsParsing\x12(\n" +
+	"\x10max_query_length\x18\t \x01(\x05R\x0emaxQueryLength\x126\n" +
+	"\x17query_examples_disabled\x18\n" +
+	" \x01(\bR\x15queryExamplesDisabled\x12Z\n" +
+	"\rcustom_labels\x18\v \x03(\v25.inventory.v1.QANPostgreSQLLogAgent.CustomLabelsEntryR\fcustomLabels\x121\n" +
+	"\x06status\x18\x14 \x01(\x0e2\x19.inventory.v1.AgentStatusR\x06status\x12*\n" +
+	"\x11process_exec_path\x18\x15 \x01(\tR\x0fprocessExecPath\x123\n" +
+	"\tlog_level\x18\x16 \x01(\x0e2\x16.inventory.v1.LogLevelR\blogLevel\x1a?\n" +
+	"\x11CustomLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x96\x06\n" +
 	"\vRDSExporter\x12\x19\n" +
 	"\bagent_id\x18\x01 \x01(\tR\aagentId\x12 \n" +
@@ -11458,7 +14240,7 @@ const file_inventory_v1_agents_proto_rawDesc = "" +
 	"\n" +
 	"service_id\x18\x03 \x01(\tR\tserviceId\x126\n" +
 	"\n" +
-	"agent_type\x18\x04 \x01(\x0e2\x17.inventory.v1.AgentTypeR\tagentType\"\x98\f\n" +
+	"agent_type\x18\x04 \x01(\x0e2\x17.inventory.v1.AgentTypeR\tagentType\"\xce\x0f\n" +
 	"\x12ListAgentsResponse\x123\n" +
 	"\tpmm_agent\x18\x01 \x03(\v2\x16.inventory.v1.PMMAgentR\bpmmAgent\x120\n" +
 	"\bvm_agent\x18\x02 \x03(\v2\x15.inventory.v1.VMAgentR\avmAgent\x12?\n" +
@@ -11480,9 +14262,14 @@ const file_inventory_v1_agents_proto_rawDesc = "" +
 	"\vnomad_agent\x18\x10 \x03(\v2\x18.inventory.v1.NomadAgentR\n" +
 	"nomadAgent\x12E\n" +
 	"\x0fvalkey_exporter\x18\x11 \x03(\v2\x1c.inventory.v1.ValkeyExporterR\x0evalkeyExporter\x12I\n" +
-	"\x11rta_mongodb_agent\x18\x13 \x03(\v2\x1d.inventory.v1.RTAMongoDBAgentR\x0frtaMongodbAgent\"5\n" +
+	"\x11rta_mongodb_agent\x18\x13 \x03(\v2\x1d.inventory.v1.RTAMongoDBAgentR\x0frtaMongodbAgent\x12C\n" +
+	"\x0frta_mysql_agent\x18\x14 \x03(\v2\x1b.inventory.v1.RTAMySQLAgentR\rrtaMysqlAgent\x12R\n" +
+	"\x14rta_postgresql_agent\x18\x15 \x03(\v2 .inventory.v1.RTAPostgreSQLAgentR\x12rtaPostgresqlAgent\x12\\\n" +
+	"\x18qan_valkey_slowlog_agent\x18\x16 \x03(\v2#.inventory.v1.QANValkeySlowlogAgentR\x15qanValkeySlowlogAgent\x12_\n" +
+	"\x19qan_proxysql_digest_agent\x18\x17 \x03(\v2$.inventory.v1.QANProxySQLDigestAgentR\x16qanProxysqlDigestAgent\x12\\\n" +
+	"\x18qan_postgresql_log_agent\x18\x18 \x03(\v2#.inventory.v1.QANPostgreSQLLogAgentR\x15qanPostgresqlLogAgent\"5\n" +
 	"\x0fGetAgentRequest\x12\"\n" +
-	"\bagent_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10$R\aagentId\"\xc4\f\n" +
+	"\bagent_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10$R\aagentId\"\x84\x10\n" +
 	"\x10GetAgentResponse\x125\n" +
 	"\tpmm_agent\x18\x01 \x01(\v2\x16.inventory.v1.PMMAgentH\x00R\bpmmAgent\x121\n" +
 	"\avmagent\x18\x02 \x01(\v2\x15.inventory.v1.VMAgentH\x00R\avmagent\x12A\n" +
@@ -11504,14 +14291,19 @@ const file_inventory_v1_agents_proto_rawDesc = "" +
 	"\vnomad_agent\x18\x10 \x01(\v2\x18.inventory.v1.NomadAgentH\x00R\n" +
 	"nomadAgent\x12G\n" +
 	"\x0fvalkey_exporter\x18\x11 \x01(\v2\x1c.inventory.v1.ValkeyExporterH\x00R\x0evalkeyExporter\x12K\n" +
-	"\x11rta_mongodb_agent\x18\x13 \x01(\v2\x1d.inventory.v1.RTAMongoDBAgentH\x00R\x0frtaMongodbAgentB\a\n" +
+	"\x11rta_mongodb_agent\x18\x13 \x01(\v2\x1d.inventory.v1.RTAMongoDBAgentH\x00R\x0frtaMongodbAgent\x12E\n" +
+	"\x0frta_mysql_agent\x18\x14 \x01(\v2\x1b.inventory.v1.RTAMySQLAgentH\x00R\rrtaMysqlAgent\x12T\n" +
+	"\x14rta_postgresql_agent\x18\x15 \x01(\v2 .inventory.v1.RTAPostgreSQLAgentH\x00R\x12rtaPostgresqlAgent\x12^\n" +
+	"\x18qan_valkey_slowlog_agent\x18\x16 \x01(\v2#.inventory.v1.QANValkeySlowlogAgentH\x00R\x15qanValkeySlowlogAgent\x12a\n" +
+	"\x19qan_proxysql_digest_agent\x18\x17 \x01(\v2$.inventory.v1.QANProxySQLDigestAgentH\x00R\x16qanProxysqlDigestAgent\x12^\n" +
+	"\x18qan_postgresql_log_agent\x18\x18 \x01(\v2#.inventory.v1.QANPostgreSQLLogAgentH\x00R\x15qanPostgresqlLogAgentB\a\n" +
 	"\x05agent\"O\n" +
 	"\x13GetAgentLogsRequest\x12\"\n" +
 	"\bagent_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10$R\aagentId\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\rR\x05limit\"j\n" +
 	"\x14GetAgentLogsResponse\x12\x12\n" +
 	"\x04logs\x18\x01 \x03(\tR\x04logs\x12>\n" +
-	"\x1cagent_config_log_lines_count\x18\x02 \x01(\rR\x18agentConfigLogLinesCount\"\xee\f\n" +
+	"\x1cagent_config_log_lines_count\x18\x02 \x01(\rR\x18agentConfigLogLinesCount\"\xdb\x10\n" +
 	"\x0fAddAgentRequest\x12>\n" +
 	"\tpmm_agent\x18\x01 \x01(\v2\x1f.inventory.v1.AddPMMAgentParamsH\x00R\bpmmAgent\x12J\n" +
 	"\rnode_exporter\x18\x02 \x01(\v2#.inventory.v1.AddNodeExporterParamsH\x00R\fnodeExporter\x12P\n" +
@@ -11530,8 +14322,13 @@ const file_inventory_v1_agents_proto_rawDesc = "" +
 	"!qan_postgresql_pgstatements_agent\x18\r \x01(\v25.inventory.v1.AddQANPostgreSQLPgStatementsAgentParamsH\x00R\x1eqanPostgresqlPgstatementsAgent\x12\x85\x01\n" +
 	"\"qan_postgresql_pgstatmonitor_agent\x18\x0e \x01(\v26.inventory.v1.AddQANPostgreSQLPgStatMonitorAgentParamsH\x00R\x1fqanPostgresqlPgstatmonitorAgent\x12P\n" +
 	"\x0fvalkey_exporter\x18\x0f \x01(\v2%.inventory.v1.AddValkeyExporterParamsH\x00R\x0evalkeyExporter\x12T\n" +
-	"\x11rta_mongodb_agent\x18\x11 \x01(\v2&.inventory.v1.AddRTAMongoDBAgentParamsH\x00R\x0frtaMongodbAgentB\a\n" +
-	"\x05agent\"\xd4\v\n" +
+	"\x11rta_mongodb_agent\x18\x11 \x01(\v2&.inventory.v1.AddRTAMongoDBAgentParamsH\x00R\x0frtaMongodbAgent\x12N\n" +
+	"\x0frta_mysql_agent\x18\x12 \x01(\v2$.inventory.v1.AddRTAMySQLAgentParamsH\x00R\rrtaMysqlAgent\x12]\n" +
+	"\x14rta_postgresql_agent\x18\x13 \x01(\v2).inventory.v1.AddRTAPostgreSQLAgentParamsH\x00R\x12rtaPostgresqlAgent\x12g\n" +
+	"\x18qan_valkey_slowlog_agent\x18\x14 \x01(\v2,.inventory.v1.AddQANValkeySlowlogAgentParamsH\x00R\x15qanValkeySlowlogAgent\x12j\n" +
+	"\x19qan_proxysql_digest_agent\x18\x15 \x01(\v2-.inventory.v1.AddQANProxySQLDigestAgentParamsH\x00R\x16qanProxysqlDigestAgent\x12g\n" +
+	"\x18qan_postgresql_log_agent\x18\x16 \x01(\v2,.inventory.v1.AddQANPostgreSQLLogAgentParamsH\x00R\x15qanPostgresqlLogAgentB\a\n" +
+	"\x05agent\"\x94\x0f\n" +
 	"\x10AddAgentResponse\x125\n" +
 	"\tpmm_agent\x18\x01 \x01(\v2\x16.inventory.v1.PMMAgentH\x00R\bpmmAgent\x12A\n" +
 	"\rnode_exporter\x18\x02 \x01(\v2\x1a.inventory.v1.NodeExporterH\x00R\fnodeExporter\x12G\n" +
@@ -11550,8 +14347,13 @@ const file_inventory_v1_agents_proto_rawDesc = "" +
 	"!qan_postgresql_pgstatements_agent\x18\r \x01(\v2,.inventory.v1.QANPostgreSQLPgStatementsAgentH\x00R\x1eqanPostgresqlPgstatementsAgent\x12|\n" +
 	"\"qan_postgresql_pgstatmonitor_agent\x18\x0e \x01(\v2-.inventory.v1.QANPostgreSQLPgStatMonitorAgentH\x00R\x1fqanPostgresqlPgstatmonitorAgent\x12G\n" +
 	"\x0fvalkey_exporter\x18\x0f \x01(\v2\x1c.inventory.v1.ValkeyExporterH\x00R\x0evalkeyExporter\x12K\n" +
-	"\x11rta_mongodb_agent\x18\x11 \x01(\v2\x1d.inventory.v1.RTAMongoDBAgentH\x00R\x0frtaMongodbAgentB\a\n" +
-	"\x05agent\"\xce\r\n" +
+	"\x11rta_mongodb_agent\x18\x11 \x01(\v2\x1d.inventory.v1.RTAMongoDBAgentH\x00R\x0frtaMongodbAgent\x12E\n" +
+	"\x0frta_mysql_agent\x18\x12 \x01(\v2\x1b.inventory.v1.RTAMySQLAgentH\x00R\rrtaMysqlAgent\x12T\n" +
+	"\x14rta_postgresql_agent\x18\x13 \x01(\v2 .inventory.v1.RTAPostgreSQLAgentH\x00R\x12rtaPostgresqlAgent\x12^\n" +
+	"\x18qan_valkey_slowlog_agent\x18\x14 \x01(\v2#.inventory.v1.QANValkeySlowlogAgentH\x00R\x15qanValkeySlowlogAgent\x12a\n" +
+	"\x19qan_proxysql_digest_agent\x18\x15 \x01(\v2$.inventory.v1.QANProxySQLDigestAgentH\x00R\x16qanProxysqlDigestAgent\x12^\n" +
+	"\x18qan_postgresql_log_agent\x18\x16 \x01(\v2#.inventory.v1.QANPostgreSQLLogAgentH\x00R\x15qanPostgresqlLogAgentB\a\n" +
+	"\x05agent\"\xca\x11\n" +
 	"\x12ChangeAgentRequest\x12\"\n" +
 	"\bagent_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aagentId\x12M\n" +
 	"\rnode_exporter\x18\x02 \x01(\v2&.inventory.v1.ChangeNodeExporterParamsH\x00R\fnodeExporter\x12S\n" +
@@ -11572,8 +14374,13 @@ const file_inventory_v1_agents_proto_rawDesc = "" +
 	"\vnomad_agent\x18\x0f \x01(\v2$.inventory.v1.ChangeNomadAgentParamsH\x00R\n" +
 	"nomadAgent\x12S\n" +
 	"\x0fvalkey_exporter\x18\x10 \x01(\v2(.inventory.v1.ChangeValkeyExporterParamsH\x00R\x0evalkeyExporter\x12W\n" +
-	"\x11rta_mongodb_agent\x18\x12 \x01(\v2).inventory.v1.ChangeRTAMongoDBAgentParamsH\x00R\x0frtaMongodbAgentB\a\n" +
-	"\x05agent\"\xdd\v\n" +
+	"\x11rta_mongodb_agent\x18\x12 \x01(\v2).inventory.v1.ChangeRTAMongoDBAgentParamsH\x00R\x0frtaMongodbAgent\x12Q\n" +
+	"\x0frta_mysql_agent\x18\x13 \x01(\v2'.inventory.v1.ChangeRTAMySQLAgentParamsH\x00R\rrtaMysqlAgent\x12`\n" +
+	"\x14rta_postgresql_agent\x18\x14 \x01(\v2,.inventory.v1.ChangeRTAPostgreSQLAgentParamsH\x00R\x12rtaPostgresqlAgent\x12j\n" +
+	"\x18qan_valkey_slowlog_agent\x18\x15 \x01(\v2/.inventory.v1.ChangeQANValkeySlowlogAgentParamsH\x00R\x15qanValkeySlowlogAgent\x12m\n" +
+	"\x19qan_proxysql_digest_agent\x18\x16 \x01(\v20.inventory.v1.ChangeQANProxySQLDigestAgentParamsH\x00R\x16qanProxysqlDigestAgent\x12j\n" +
+	"\x18qan_postgresql_log_agent\x18\x17 \x01(\v2/.inventory.v1.ChangeQANPostgreSQLLogAgentParamsH\x00R\x15qanPostgresqlLogAgentB\a\n" +
+	"\x05agent\"\x9d\x0f\n" +
 	"\x13ChangeAgentResponse\x12A\n" +
 	"\rnode_exporter\x18\x02 \x01(\v2\x1a.inventory.v1.NodeExporterH\x00R\fnodeExporter\x12G\n" +
 	"\x0fmysqld_exporter\x18\x03 \x01(\v2\x1c.inventory.v1.MySQLdExporterH\x00R\x0emysqldExporter\x12J\n" +
@@ -11593,7 +14400,12 @@ const file_inventory_v1_agents_proto_rawDesc = "" +
 	"\vnomad_agent\x18\x0f \x01(\v2\x18.inventory.v1.NomadAgentH\x00R\n" +
 	"nomadAgent\x12G\n" +
 	"\x0fvalkey_exporter\x18\x10 \x01(\v2\x1c.inventory.v1.ValkeyExporterH\x00R\x0evalkeyExporter\x12K\n" +
-	"\x11rta_mongodb_agent\x18\x12 \x01(\v2\x1d.inventory.v1.RTAMongoDBAgentH\x00R\x0frtaMongodbAgentB\a\n" +
+	"\x11rta_mongodb_agent\x18\x12 \x01(\v2\x1d.inventory.v1.RTAMongoDBAgentH\x00R\x0frtaMongodbAgent\x12E\n" +
+	"\x0frta_mysql_agent\x18\x13 \x01(\v2\x1b.inventory.v1.RTAMySQLAgentH\x00R\rrtaMysqlAgent\x12T\n" +
+	"\x14rta_postgresql_agent\x18\x14 \x01(\v2 .inventory.v1.RTAPostgreSQLAgentH\x00R\x12rtaPostgresqlAgent\x12^\n" +
+	"\x18qan_valkey_slowlog_agent\x18\x15 \x01(\v2#.inventory.v1.QANValkeySlowlogAgentH\x00R\x15qanValkeySlowlogAgent\x12a\n" +
+	"\x19qan_proxysql_digest_agent\x18\x16 \x01(\v2$.inventory.v1.QANProxySQLDigestAgentH\x00R\x16qanProxysqlDigestAgent\x12^\n" +
+	"\x18qan_postgresql_log_agent\x18\x17 \x01(\v2#.inventory.v1.QANPostgreSQLLogAgentH\x00R\x15qanPostgresqlLogAgentB\a\n" +
 	"\x05agent\"\xdc\x01\n" +
 	"\x11AddPMMAgentParams\x12.\n" +
 	"\x0fruns_on_node_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\frunsOnNodeId\x12V\n" +
@@ -12127,6 +14939,95 @@ const file_inventory_v1_agents_proto_rawDesc = "" +
 	"\x18_authentication_databaseB\f\n" +
 	"\n" +
 	"_log_levelB\x18\n" +
+	"\x16_skip_connection_check\"\xb7\x05\n" +
+	"\x1eAddQANValkeySlowlogAgentParams\x12)\n" +
+	"\fpmm_agent_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"pmmAgentId\x12&\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tserviceId\x12 \n" +
+	"\busername\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\busername\x12 \n" +
+	"\bpassword\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01R\bpassword\x12\x10\n" +
+	"\x03tls\x18\x05 \x01(\bR\x03tls\x12&\n" +
+	"\x0ftls_skip_verify\x18\x06 \x01(\bR\rtlsSkipVerify\x12\x15\n" +
+	"\x06tls_ca\x18\a \x01(\tR\x05tlsCa\x12\x1f\n" +
+	"\btls_cert\x18\b \x01(\tB\x04\x88\xb5\x18\x01R\atlsCert\x12\x1d\n" +
+	"\atls_key\x18\t \x01(\tB\x04\x88\xb5\x18\x01R\x06tlsKey\x12(\n" +
+	"\x10max_query_length\x18\n" +
+	" \x01(\x05R\x0emaxQueryLength\x124\n" +
+	"\x16disable_query_examples\x18\v \x01(\bR\x14disableQueryExamples\x12c\n" +
+	"\rcustom_labels\x18\f \x03(\v2>.inventory.v1.AddQANValkeySlowlogAgentParams.CustomLabelsEntryR\fcustomLabels\x122\n" +
+	"\x15skip_connection_check\x18\r \x01(\bR\x13skipConnectionCheck\x123\n" +
+	"\tlog_level\x18\x0e \x01(\x0e2\x16.inventory.v1.LogLevelR\blogLevel\x1a?\n" +
+	"\x11CustomLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa1\x06\n" +
+	"!ChangeQANValkeySlowlogAgentParams\x12\x1b\n" +
+	"\x06enable\x18\x01 \x01(\bH\x00R\x06enable\x88\x01\x01\x12;\n" +
+	"\rcustom_labels\x18\x02 \x01(\v2\x11.common.StringMapH\x01R\fcustomLabels\x88\x01\x01\x12%\n" +
+	"\busername\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01H\x02R\busername\x88\x01\x01\x12%\n" +
+	"\bpassword\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01H\x03R\bpassword\x88\x01\x01\x12\x15\n" +
+	"\x03tls\x18\x05 \x01(\bH\x04R\x03tls\x88\x01\x01\x12+\n" +
+	"\x0ftls_skip_verify\x18\x06 \x01(\bH\x05R\rtlsSkipVerify\x88\x01\x01\x12\x1a\n" +
+	"\x06tls_ca\x18\a \x01(\tH\x06R\x05tlsCa\x88\x01\x01\x12$\n" +
+	"\btls_cert\x18\b \x01(\tB\x04\x88\xb5\x18\x01H\aR\atlsCert\x88\x01\x01\x12\"\n" +
+	"\atls_key\x18\t \x01(\tB\x04\x88\xb5\x18\x01H\bR\x06tlsKey\x88\x01\x01\x12-\n" +
+	"\x10max_query_length\x18\n" +
+	" \x01(\x05H\tR\x0emaxQueryLength\x88\x01\x01\x129\n" +
+	"\x16disable_query_examples\x18\v \x01(\bH\n" +
+	"R\x14disableQueryExamples\x88\x01\x01\x128\n" +
+	"\tlog_level\x18\f \x01(\x0e2\x16.inventory.v1.LogLevelH\vR\blogLevel\x88\x01\x01\x127\n" +
+	"\x15skip_connection_check\x18\r \x01(\bH\fR\x13skipConnectionCheck\x88\x01\x01B\t\n" +
+	"\a_enableB\x10\n" +
+	"\x0e_custom_labelsB\v\n" +
+	"\t_usernameB\v\n" +
+	"\t_passwordB\x06\n" +
+	"\x04_tlsB\x12\n" +
+	"\x10_tls_skip_verifyB\t\n" +
+	"\a_tls_caB\v\n" +
+	"\t_tls_certB\n" +
+	"\n" +
+	"\b_tls_keyB\x13\n" +
+	"\x11_max_query_lengthB\x19\n" +
+	"\x17_disable_query_examplesB\f\n" +
+	"\n" +
+	"_log_levelB\x18\n" +
+	"\x16_skip_connection_check\"\xb3\x04\n" +
+	"\x1fAddQANProxySQLDigestAgentParams\x12)\n" +
+	"\fpmm_agent_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"pmmAgentId\x12&\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tserviceId\x12'\n" +
+	"\busername\x18\x03 \x01(\tB\v\xfaB\x04r\x02\x10\x01\x88\xb5\x18\x01R\busername\x12 \n" +
+	"\bpassword\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01R\bpassword\x12\x10\n" +
+	"\x03tls\x18\x05 \x01(\bR\x03tls\x12&\n" +
+	"\x0ftls_skip_verify\x18\x06 \x01(\bR\rtlsSkipVerify\x12(\n" +
+	"\x10max_query_length\x18\a \x01(\x05R\x0emaxQueryLength\x12d\n" +
+	"\rcustom_labels\x18\b \x03(\v2?.inventory.v1.AddQANProxySQLDigestAgentParams.CustomLabelsEntryR\fcustomLabels\x122\n" +
+	"\x15skip_connection_check\x18\t \x01(\bR\x13skipConnectionCheck\x123\n" +
+	"\tlog_level\x18\n" +
+	" \x01(\x0e2\x16.inventory.v1.LogLevelR\blogLevel\x1a?\n" +
+	"\x11CustomLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xc2\x04\n" +
+	"\"ChangeQANProxySQLDigestAgentParams\x12\x1b\n" +
+	"\x06enable\x18\x01 \x01(\bH\x00R\x06enable\x88\x01\x01\x12;\n" +
+	"\rcustom_labels\x18\x02 \x01(\v2\x11.common.StringMapH\x01R\fcustomLabels\x88\x01\x01\x12%\n" +
+	"\busername\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01H\x02R\busername\x88\x01\x01\x12%\n" +
+	"\bpassword\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01H\x03R\bpassword\x88\x01\x01\x12\x15\n" +
+	"\x03tls\x18\x05 \x01(\bH\x04R\x03tls\x88\x01\x01\x12+\n" +
+	"\x0ftls_skip_verify\x18\x06 \x01(\bH\x05R\rtlsSkipVerify\x88\x01\x01\x12-\n" +
+	"\x10max_query_length\x18\a \x01(\x05H\x06R\x0emaxQueryLength\x88\x01\x01\x128\n" +
+	"\tlog_level\x18\b \x01(\x0e2\x16.inventory.v1.LogLevelH\aR\blogLevel\x88\x01\x01\x127\n" +
+	"\x15skip_connection_check\x18\t \x01(\bH\bR\x13skipConnectionCheck\x88\x01\x01B\t\n" +
+	"\a_enableB\x10\n" +
+	"\x0e_custom_labelsB\v\n" +
+	"\t_usernameB\v\n" +
+	"\t_passwordB\x06\n" +
+	"\x04_tlsB\x12\n" +
+	"\x10_tls_skip_verifyB\x13\n" +
+	"\x11_max_query_lengthB\f\n" +
+	"\n" +
+	"_log_levelB\x18\n" +
 	"\x16_skip_connection_check\"\xd4\x05\n" +
 	"'AddQANPostgreSQLPgStatementsAgentParams\x12)\n" +
 	"\fpmm_agent_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
@@ -12240,6 +15141,61 @@ const file_inventory_v1_agents_proto_rawDesc = "" +
 	"\b_tls_keyB\f\n" +
 	"\n" +
 	"_log_levelB\x18\n" +
+	"\x16_skip_connection_check\"\xf8\x05\n" +
+	"\x1eAddQANPostgreSQLLogAgentParams\x12)\n" +
+	"\fpmm_agent_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"pmmAgentId\x12&\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tserviceId\x12'\n" +
+	"\busername\x18\x03 \x01(\tB\v\xfaB\x04r\x02\x10\x01\x88\xb5\x18\x01R\busername\x12 \n" +
+	"\bpassword\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01R\bpassword\x12\x10\n" +
+	"\x03tls\x18\x05 \x01(\bR\x03tls\x12&\n" +
+	"\x0ftls_skip_verify\x18\x06 \x01(\bR\rtlsSkipVerify\x12(\n" +
+	"\x10max_query_length\x18\a \x01(\x05R\x0emaxQueryLength\x124\n" +
+	"\x16disable_query_examples\x18\b \x01(\bR\x14disableQueryExamples\x12c\n" +
+	"\rcustom_labels\x18\t \x03(\v2>.inventory.v1.AddQANPostgreSQLLogAgentParams.CustomLabelsEntryR\fcustomLabels\x122\n" +
+	"\x15skip_connection_check\x18\n" +
+	" \x01(\bR\x13skipConnectionCheck\x128\n" +
+	"\x18disable_comments_parsing\x18\v \x01(\bR\x16disableCommentsParsing\x12\x15\n" +
+	"\x06tls_ca\x18\f \x01(\tR\x05tlsCa\x12\x1f\n" +
+	"\btls_cert\x18\r \x01(\tB\x04\x88\xb5\x18\x01R\atlsCert\x12\x1d\n" +
+	"\atls_key\x18\x0e \x01(\tB\x04\x88\xb5\x18\x01R\x06tlsKey\x123\n" +
+	"\tlog_level\x18\x0f \x01(\x0e2\x16.inventory.v1.LogLevelR\blogLevel\x1a?\n" +
+	"\x11CustomLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xfd\x06\n" +
+	"!ChangeQANPostgreSQLLogAgentParams\x12\x1b\n" +
+	"\x06enable\x18\x01 \x01(\bH\x00R\x06enable\x88\x01\x01\x12;\n" +
+	"\rcustom_labels\x18\x02 \x01(\v2\x11.common.StringMapH\x01R\fcustomLabels\x88\x01\x01\x12%\n" +
+	"\busername\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01H\x02R\busername\x88\x01\x01\x12%\n" +
+	"\bpassword\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01H\x03R\bpassword\x88\x01\x01\x12\x15\n" +
+	"\x03tls\x18\x05 \x01(\bH\x04R\x03tls\x88\x01\x01\x12+\n" +
+	"\x0ftls_skip_verify\x18\x06 \x01(\bH\x05R\rtlsSkipVerify\x88\x01\x01\x12-\n" +
+	"\x10max_query_length\x18\a \x01(\x05H\x06R\x0emaxQueryLength\x88\x01\x01\x129\n" +
+	"\x16disable_query_examples\x18\b \x01(\bH\aR\x14disableQueryExamples\x88\x01\x01\x12=\n" +
+	"\x18disable_comments_parsing\x18\t \x01(\bH\bR\x16disableCommentsParsing\x88\x01\x01\x12\x1a\n" +
+	"\x06tls_ca\x18\n" +
+	" \x01(\tH\tR\x05tlsCa\x88\x01\x01\x12$\n" +
+	"\btls_cert\x18\v \x01(\tB\x04\x88\xb5\x18\x01H\n" +
+	"R\atlsCert\x88\x01\x01\x12\"\n" +
+	"\atls_key\x18\f \x01(\tB\x04\x88\xb5\x18\x01H\vR\x06tlsKey\x88\x01\x01\x128\n" +
+	"\tlog_level\x18\r \x01(\x0e2\x16.inventory.v1.LogLevelH\fR\blogLevel\x88\x01\x01\x127\n" +
+	"\x15skip_connection_check\x18\x0e \x01(\bH\rR\x13skipConnectionCheck\x88\x01\x01B\t\n" +
+	"\a_enableB\x10\n" +
+	"\x0e_custom_labelsB\v\n" +
+	"\t_usernameB\v\n" +
+	"\t_passwordB\x06\n" +
+	"\x04_tlsB\x12\n" +
+	"\x10_tls_skip_verifyB\x13\n" +
+	"\x11_max_query_lengthB\x19\n" +
+	"\x17_disable_query_examplesB\x1b\n" +
+	"\x19_disable_comments_parsingB\t\n" +
+	"\a_tls_caB\v\n" +
+	"\t_tls_certB\n" +
+	"\n" +
+	"\b_tls_keyB\f\n" +
+	"\n" +
+	"_log_levelB\x18\n" +
 	"\x16_skip_connection_check\"\xd1\x04\n" +
 	"\x14AddRDSExporterParams\x12)\n" +
 	"\fpmm_agent_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
@@ -12466,11 +15422,117 @@ const file_inventory_v1_agents_proto_rawDesc = "" +
 	"\a_tls_caB\x1b\n" +
 	"\x19_authentication_mechanismB\x0e\n" +
 	"\f_rta_optionsB\x18\n" +
+	"\x16_skip_connection_check\"\xb0\x06\n" +
+	"\x16AddRTAMySQLAgentParams\x12)\n" +
+	"\fpmm_agent_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"pmmAgentId\x12&\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tserviceId\x12'\n" +
+	"\busername\x18\x03 \x01(\tB\v\xfaB\x04r\x02\x10\x01\x88\xb5\x18\x01R\busername\x12 \n" +
+	"\bpassword\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01R\bpassword\x12[\n" +
+	"\rcustom_labels\x18\x05 \x03(\v26.inventory.v1.AddRTAMySQLAgentParams.CustomLabelsEntryR\fcustomLabels\x123\n" +
+	"\tlog_level\x18\x06 \x01(\x0e2\x16.inventory.v1.LogLevelR\blogLevel\x12\x10\n" +
+	"\x03tls\x18\a \x01(\bR\x03tls\x12&\n" +
+	"\x0ftls_skip_verify\x18\b \x01(\bR\rtlsSkipVerify\x12\x15\n" +
+	"\x06tls_ca\x18\t \x01(\tR\x05tlsCa\x12\x1f\n" +
+	"\btls_cert\x18\n" +
+	" \x01(\tB\x04\x88\xb5\x18\x01R\atlsCert\x12\x1d\n" +
+	"\atls_key\x18\v \x01(\tB\x04\x88\xb5\x18\x01R\x06tlsKey\x122\n" +
+	"\x15skip_connection_check\x18\f \x01(\bR\x13skipConnectionCheck\x12b\n" +
+	"\x10extra_dsn_params\x18\r \x03(\v28.inventory.v1.AddRTAMySQLAgentParams.ExtraDsnParamsEntryR\x0eextraDsnParams\x129\n" +
+	"\vrta_options\x18\x0e \x01(\v2\x18.inventory.v1.RTAOptionsR\n" +
+	"rtaOptions\x1a?\n" +
+	"\x11CustomLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aA\n" +
+	"\x13ExtraDsnParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xcf\x05\n" +
+	"\x19ChangeRTAMySQLAgentParams\x12\x1b\n" +
+	"\x06enable\x18\x01 \x01(\bH\x00R\x06enable\x88\x01\x01\x12;\n" +
+	"\rcustom_labels\x18\x02 \x01(\v2\x11.common.StringMapH\x01R\fcustomLabels\x88\x01\x01\x128\n" +
+	"\tlog_level\x18\x03 \x01(\x0e2\x16.inventory.v1.LogLevelH\x02R\blogLevel\x88\x01\x01\x12%\n" +
+	"\busername\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01H\x03R\busername\x88\x01\x01\x12%\n" +
+	"\bpassword\x18\x05 \x01(\tB\x04\x88\xb5\x18\x01H\x04R\bpassword\x88\x01\x01\x12\x15\n" +
+	"\x03tls\x18\x06 \x01(\bH\x05R\x03tls\x88\x01\x01\x12+\n" +
+	"\x0ftls_skip_verify\x18\a \x01(\bH\x06R\rtlsSkipVerify\x88\x01\x01\x12\x1a\n" +
+	"\x06tls_ca\x18\b \x01(\tH\aR\x05tlsCa\x88\x01\x01\x12$\n" +
+	"\btls_cert\x18\t \x01(\tB\x04\x88\xb5\x18\x01H\bR\atlsCert\x88\x01\x01\x12\"\n" +
+	"\atls_key\x18\n" +
+	" \x01(\tB\x04\x88\xb5\x18\x01H\tR\x06tlsKey\x88\x01\x01\x12>\n" +
+	"\vrta_options\x18\v \x01(\v2\x18.inventory.v1.RTAOptionsH\n" +
+	"R\n" +
+	"rtaOptions\x88\x01\x01\x127\n" +
+	"\x15skip_connection_check\x18\f \x01(\bH\vR\x13skipConnectionCheck\x88\x01\x01B\t\n" +
+	"\a_enableB\x10\n" +
+	"\x0e_custom_labelsB\f\n" +
+	"\n" +
+	"_log_levelB\v\n" +
+	"\t_usernameB\v\n" +
+	"\t_passwordB\x06\n" +
+	"\x04_tlsB\x12\n" +
+	"\x10_tls_skip_verifyB\t\n" +
+	"\a_tls_caB\v\n" +
+	"\t_tls_certB\n" +
+	"\n" +
+	"\b_tls_keyB\x0e\n" +
+	"\f_rta_optionsB\x18\n" +
+	"\x16_skip_connection_check\"\x93\x05\n" +
+	"\x1bAddRTAPostgreSQLAgentParams\x12)\n" +
+	"\fpmm_agent_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\n" +
+	"pmmAgentId\x12&\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tserviceId\x12'\n" +
+	"\busername\x18\x03 \x01(\tB\v\xfaB\x04r\x02\x10\x01\x88\xb5\x18\x01R\busername\x12 \n" +
+	"\bpassword\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01R\bpassword\x12`\n" +
+	"\rcustom_labels\x18\x05 \x03(\v2;.inventory.v1.AddRTAPostgreSQLAgentParams.CustomLabelsEntryR\fcustomLabels\x123\n" +
+	"\tlog_level\x18\x06 \x01(\x0e2\x16.inventory.v1.LogLevelR\blogLevel\x12\x10\n" +
+	"\x03tls\x18\a \x01(\bR\x03tls\x12&\n" +
+	"\x0ftls_skip_verify\x18\b \x01(\bR\rtlsSkipVerify\x12\x15\n" +
+	"\x06tls_ca\x18\t \x01(\tR\x05tlsCa\x12\x1f\n" +
+	"\btls_cert\x18\n" +
+	" \x01(\tB\x04\x88\xb5\x18\x01R\atlsCert\x12\x1d\n" +
+	"\atls_key\x18\v \x01(\tB\x04\x88\xb5\x18\x01R\x06tlsKey\x122\n" +
+	"\x15skip_connection_check\x18\f \x01(\bR\x13skipConnectionCheck\x129\n" +
+	"\vrta_options\x18\r \x01(\v2\x18.inventory.v1.RTAOptionsR\n" +
+	"rtaOptions\x1a?\n" +
+	"\x11CustomLabelsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xd4\x05\n" +
+	"\x1eChangeRTAPostgreSQLAgentParams\x12\x1b\n" +
+	"\x06enable\x18\x01 \x01(\bH\x00R\x06enable\x88\x01\x01\x12;\n" +
+	"\rcustom_labels\x18\x02 \x01(\v2\x11.common.StringMapH\x01R\fcustomLabels\x88\x01\x01\x128\n" +
+	"\tlog_level\x18\x03 \x01(\x0e2\x16.inventory.v1.LogLevelH\x02R\blogLevel\x88\x01\x01\x12%\n" +
+	"\busername\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01H\x03R\busername\x88\x01\x01\x12%\n" +
+	"\bpassword\x18\x05 \x01(\tB\x04\x88\xb5\x18\x01H\x04R\bpassword\x88\x01\x01\x12\x15\n" +
+	"\x03tls\x18\x06 \x01(\bH\x05R\x03tls\x88\x01\x01\x12+\n" +
+	"\x0ftls_skip_verify\x18\a \x01(\bH\x06R\rtlsSkipVerify\x88\x01\x01\x12\x1a\n" +
+	"\x06tls_ca\x18\b \x01(\tH\aR\x05tlsCa\x88\x01\x01\x12$\n" +
+	"\btls_cert\x18\t \x01(\tB\x04\x88\xb5\x18\x01H\bR\atlsCert\x88\x01\x01\x12\"\n" +
+	"\atls_key\x18\n" +
+	" \x01(\tB\x04\x88\xb5\x18\x01H\tR\x06tlsKey\x88\x01\x01\x12>\n" +
+	"\vrta_options\x18\v \x01(\v2\x18.inventory.v1.RTAOptionsH\n" +
+	"R\n" +
+	"rtaOptions\x88\x01\x01\x127\n" +
+	"\x15skip_connection_check\x18\f \x01(\bH\vR\x13skipConnectionCheck\x88\x01\x01B\t\n" +
+	"\a_enableB\x10\n" +
+	"\x0e_custom_labelsB\f\n" +
+	"\n" +
+	"_log_levelB\v\n" +
+	"\t_usernameB\v\n" +
+	"\t_passwordB\x06\n" +
+	"\x04_tlsB\x12\n" +
+	"\x10_tls_skip_verifyB\t\n" +
+	"\a_tls_caB\v\n" +
+	"\t_tls_certB\n" +
+	"\n" +
+	"\b_tls_keyB\x0e\n" +
+	"\f_rta_optionsB\x18\n" +
 	"\x16_skip_connection_check\"N\n" +
 	"\x12RemoveAgentRequest\x12\"\n" +
 	"\bagent_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aagentId\x12\x14\n" +
 	"\x05force\x18\x02 \x01(\bR\x05force\"\x15\n" +
-	"\x13RemoveAgentResponse*\xd0\x05\n" +
+	"\x13RemoveAgentResponse*\x91\a\n" +
 	"\tAgentType\x12\x1a\n" +
 	"\x16AGENT_TYPE_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14AGENT_TYPE_PMM_AGENT\x10\x01\x12\x17\n" +
@@ -12492,7 +15554,12 @@ const file_inventory_v1_agents_proto_rawDesc = "" +
 	"\x17AGENT_TYPE_RDS_EXPORTER\x10\v\x12&\n" +
 	"\"AGENT_TYPE_AZURE_DATABASE_EXPORTER\x10\x0f\x12\x1a\n" +
 	"\x16AGENT_TYPE_NOMAD_AGENT\x10\x10\x12 \n" +
-	"\x1cAGENT_TYPE_RTA_MONGODB_AGENT\x10\x132\x83\t\n" +
+	"\x1cAGENT_TYPE_RTA_MONGODB_AGENT\x10\x13\x12\x1e\n" +
+	"\x1aAGENT_TYPE_RTA_MYSQL_AGENT\x10\x14\x12#\n" +
+	"\x1fAGENT_TYPE_RTA_POSTGRESQL_AGENT\x10\x15\x12'\n" +
+	"#AGENT_TYPE_QAN_VALKEY_SLOWLOG_AGENT\x10\x16\x12(\n" +
+	"$AGENT_TYPE_QAN_PROXYSQL_DIGEST_AGENT\x10\x17\x12'\n" +
+	"#AGENT_TYPE_QAN_POSTGRESQL_LOG_AGENT\x10\x182\x83\t\n" +
 	"\rAgentsService\x12\x9c\x01\n" +
 	"\n" +
 	"ListAgents\x12\x1f.inventory.v1.ListAgentsRequest\x1a .inventory.v1.ListAgentsResponse\"K\x92A,\x12\vList Agents\x1a\x1dReturns a list of all Agents.\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/inventory/agents\x12\x9f\x01\n" +
@@ -12517,7 +15584,7 @@ func file_inventory_v1_agents_proto_rawDescGZIP() []byte {
 
 var (
 	file_inventory_v1_agents_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-	file_inventory_v1_agents_proto_msgTypes  = make([]protoimpl.MessageInfo, 107)
+	file_inventory_v1_agents_proto_msgTypes  = make([]protoimpl.MessageInfo, 133)
 	file_inventory_v1_agents_proto_goTypes   = []any{
 		AgentType(0),                                        // 0: inventory.v1.AgentType
 		(*PMMAgent)(nil),                                    // 1: inventory.v1.PMMAgent
@@ -12533,396 +15600,494 @@ var (
 		(*QANMySQLSlowlogAgent)(nil),                        // 11: inventory.v1.QANMySQLSlowlogAgent
 		(*QANMongoDBProfilerAgent)(nil),                     // 12: inventory.v1.QANMongoDBProfilerAgent
 		(*QANMongoDBMongologAgent)(nil),                     // 13: inventory.v1.QANMongoDBMongologAgent
-		(*RTAOptions)(nil),                                  // 14: inventory.v1.RTAOptions
-		(*RTAMongoDBAgent)(nil),                             // 15: inventory.v1.RTAMongoDBAgent
-		(*QANPostgreSQLPgStatementsAgent)(nil),              // 16: inventory.v1.QANPostgreSQLPgStatementsAgent
-		(*QANPostgreSQLPgStatMonitorAgent)(nil),             // 17: inventory.v1.QANPostgreSQLPgStatMonitorAgent
-		(*RDSExporter)(nil),                                 // 18: inventory.v1.RDSExporter
-		(*ExternalExporter)(nil),                            // 19: inventory.v1.ExternalExporter
-		(*AzureDatabaseExporter)(nil),                       // 20: inventory.v1.AzureDatabaseExporter
-		(*ChangeCommonAgentParams)(nil),                     // 21: inventory.v1.ChangeCommonAgentParams
-		(*ListAgentsRequest)(nil),                           // 22: inventory.v1.ListAgentsRequest
-		(*ListAgentsResponse)(nil),                          // 23: inventory.v1.ListAgentsResponse
-		(*GetAgentRequest)(nil),                             // 24: inventory.v1.GetAgentRequest
-		(*GetAgentResponse)(nil),                            // 25: inventory.v1.GetAgentResponse
-		(*GetAgentLogsRequest)(nil),                         // 26: inventory.v1.GetAgentLogsRequest
-		(*GetAgentLogsResponse)(nil),                        // 27: inventory.v1.GetAgentLogsResponse
-		(*AddAgentRequest)(nil),                             // 28: inventory.v1.AddAgentRequest
-		(*AddAgentResponse)(nil),                            // 29: inventory.v1.AddAgentResponse
-		(*ChangeAgentRequest)(nil),                          // 30: inventory.v1.ChangeAgentRequest
-		(*ChangeAgentResponse)(nil),                         // 31: inventory.v1.ChangeAgentResponse
-		(*AddPMMAgentParams)(nil),                           // 32: inventory.v1.AddPMMAgentParams
-		(*AddNodeExporterParams)(nil),                       // 33: inventory.v1.AddNodeExporterParams
-		(*ChangeNodeExporterParams)(nil),                    // 34: inventory.v1.ChangeNodeExporterParams
-		(*AddMySQLdExporterParams)(nil),                     // 35: inventory.v1.AddMySQLdExporterParams
-		(*ChangeMySQLdExporterParams)(nil),                  // 36: inventory.v1.ChangeMySQLdExporterParams
-		(*AddMongoDBExporterParams)(nil),                    // 37: inventory.v1.AddMongoDBExporterParams
-		(*ChangeMongoDBExporterParams)(nil),                 // 38: inventory.v1.ChangeMongoDBExporterParams
-		(*AddPostgresExporterParams)(nil),                   // 39: inventory.v1.AddPostgresExporterParams
-		(*ChangePostgresExporterParams)(nil),                // 40: inventory.v1.ChangePostgresExporterParams
-		(*AddProxySQLExporterParams)(nil),                   // 41: inventory.v1.AddProxySQLExporterParams
-		(*ChangeProxySQLExporterParams)(nil),                // 42: inventory.v1.ChangeProxySQLExporterParams
-		(*AddQANMySQLPerfSchemaAgentParams)(nil),            // 43: inventory.v1.AddQANMySQLPerfSchemaAgentParams
-		(*ChangeQANMySQLPerfSchemaAgentParams)(nil),         // 44: inventory.v1.ChangeQANMySQLPerfSchemaAgentParams
-		(*AddQANMySQLSlowlogAgentParams)(nil),               // 45: inventory.v1.AddQANMySQLSlowlogAgentParams
-		(*ChangeQANMySQLSlowlogAgentParams)(nil),            // 46: inventory.v1.ChangeQANMySQLSlowlogAgentParams
-		(*AddQANMongoDBProfilerAgentParams)(nil),            // 47: inventory.v1.AddQANMongoDBProfilerAgentParams
-		(*ChangeQANMongoDBProfilerAgentParams)(nil),         // 48: inventory.v1.ChangeQANMongoDBProfilerAgentParams
-		(*AddQANMongoDBMongologAgentParams)(nil),            // 49: inventory.v1.AddQANMongoDBMongologAgentParams
-		(*ChangeQANMongoDBMongologAgentParams)(nil),         // 50: inventory.v1.ChangeQANMongoDBMongologAgentParams
-		(*AddQANPostgreSQLPgStatementsAgentParams)(nil),     // 51: inventory.v1.AddQANPostgreSQLPgStatementsAgentParams
-		(*ChangeQANPostgreSQLPgStatementsAgentParams)(nil),  // 52: inventory.v1.ChangeQANPostgreSQLPgStatementsAgentParams
-		(*AddQANPostgreSQLPgStatMonitorAgentParams)(nil),    // 53: inventory.v1.AddQANPostgreSQLPgStatMonitorAgentParams
-		(*ChangeQANPostgreSQLPgStatMonitorAgentParams)(nil), // 54: inventory.v1.ChangeQANPostgreSQLPgStatMonitorAgentParams
-		(*AddRDSExporterParams)(nil),                        // 55: inventory.v1.AddRDSExporterParams
-		(*ChangeRDSExporterParams)(nil),                     // 56: inventory.v1.ChangeRDSExporterParams
-		(*AddExternalExporterParams)(nil),                   // 57: inventory.v1.AddExternalExporterParams
-		(*ChangeExternalExporterParams)(nil),                // 58: inventory.v1.ChangeExternalExporterParams
-		(*AddAzureDatabaseExporterParams)(nil),              // 59: inventory.v1.AddAzureDatabaseExporterParams
-		(*ChangeAzureDatabaseExporterParams)(nil),           // 60: inventory.v1.ChangeAzureDatabaseExporterParams
-		(*ChangeNomadAgentParams)(nil),                      // 61: inventory.v1.ChangeNomadAgentParams
-		(*AddValkeyExporterParams)(nil),                     // 62: inventory.v1.AddValkeyExporterParams
-		(*ChangeValkeyExporterParams)(nil),                  // 63: inventory.v1.ChangeValkeyExporterParams
-		(*AddRTAMongoDBAgentParams)(nil),                    // 64: inventory.v1.AddRTAMongoDBAgentParams
-		(*ChangeRTAMongoDBAgentParams)(nil),                 // 65: inventory.v1.ChangeRTAMongoDBAgentParams
-		(*RemoveAgentRequest)(nil),                          // 66: inventory.v1.RemoveAgentRequest
-		(*RemoveAgentResponse)(nil),                         // 67: inventory.v1.RemoveAgentResponse
-		nil,                                                 // 68: inventory.v1.PMMAgent.CustomLabelsEntry
-		nil,                                                 // 69: inventory.v1.NodeExporter.CustomLabelsEntry
-		nil,                                                 // 70: inventory.v1.MySQLdExporter.CustomLabelsEntry
-		nil,                                                 // 71: inventory.v1.MySQLdExporter.ExtraDsnParamsEntry
-		nil,                                                 // 72: inventory.v1.MongoDBExporter.CustomLabelsEntry
-		nil,                                                 // 73: inventory.v1.PostgresExporter.CustomLabelsEntry
-		nil,                                                 // 74: inventory.v1.ProxySQLExporter.CustomLabelsEntry
-		nil,                                                 // 75: inventory.v1.ValkeyExporter.CustomLabelsEntry
-		nil,                                                 // 76: inventory.v1.QANMySQLPerfSchemaAgent.CustomLabelsEntry
-		nil,                                                 // 77: inventory.v1.QANMySQLPerfSchemaAgent.ExtraDsnParamsEntry
-		nil,                                                 // 78: inventory.v1.QANMySQLSlowlogAgent.CustomLabelsEntry
-		nil,                                                 // 79: inventory.v1.QANMySQLSlowlogAgent.ExtraDsnParamsEntry
-		nil,                                                 // 80: inventory.v1.QANMongoDBProfilerAgent.CustomLabelsEntry
-		nil,                                                 // 81: inventory.v1.QANMongoDBMongologAgent.CustomLabelsEntry
-		nil,                                                 // 82: inventory.v1.RTAMongoDBAgent.CustomLabelsEntry
-		nil,                                                 // 83: inventory.v1.QANPostgreSQLPgStatementsAgent.CustomLabelsEntry
-		nil,                                                 // 84: inventory.v1.QANPostgreSQLPgStatMonitorAgent.CustomLabelsEntry
-		nil,                                                 // 85: inventory.v1.RDSExporter.CustomLabelsEntry
-		nil,                                                 // 86: inventory.v1.ExternalExporter.CustomLabelsEntry
-		nil,                                                 // 87: inventory.v1.AzureDatabaseExporter.CustomLabelsEntry
-		nil,                                                 // 88: inventory.v1.AddPMMAgentParams.CustomLabelsEntry
-		nil,                                                 // 89: inventory.v1.AddNodeExporterParams.CustomLabelsEntry
-		nil,                                                 // 90: inventory.v1.AddMySQLdExporterParams.CustomLabelsEntry
-		nil,                                                 // 91: inventory.v1.AddMySQLdExporterParams.ExtraDsnParamsEntry
-		nil,                                                 // 92: inventory.v1.AddMongoDBExporterParams.CustomLabelsEntry
-		nil,                                                 // 93: inventory.v1.AddPostgresExporterParams.CustomLabelsEntry
-		nil,                                                 // 94: inventory.v1.AddProxySQLExporterParams.CustomLabelsEntry
-		nil,                                                 // 95: inventory.v1.AddQANMySQLPerfSchemaAgentParams.CustomLabelsEntry
-		nil,                                                 // 96: inventory.v1.AddQANMySQLPerfSchemaAgentParams.ExtraDsnParamsEntry
-		nil,                                                 // 97: inventory.v1.AddQANMySQLSlowlogAgentParams.CustomLabelsEntry
-		nil,                                                 // 98: inventory.v1.AddQANMySQLSlowlogAgentParams.ExtraDsnParamsEntry
-		nil,                                                 // 99: inventory.v1.AddQANMongoDBProfilerAgentParams.CustomLabelsEntry
-		nil,                                                 // 100: inventory.v1.AddQANMongoDBMongologAgentParams.CustomLabelsEntry
-		nil,                                                 // 101: inventory.v1.AddQANPostgreSQLPgStatementsAgentParams.CustomLabelsEntry
-		nil,                                                 // 102: inventory.v1.AddQANPostgreSQLPgStatMonitorAgentParams.CustomLabelsEntry
-		nil,                                                 // 103: inventory.v1.AddRDSExporterParams.CustomLabelsEntry
-		nil,                                                 // 104: inventory.v1.AddExternalExporterParams.CustomLabelsEntry
-		nil,                                                 // 105: inventory.v1.AddAzureDatabaseExporterParams.CustomLabelsEntry
-		nil,                                                 // 106: inventory.v1.AddValkeyExporterParams.CustomLabelsEntry
-		nil,                                                 // 107: inventory.v1.AddRTAMongoDBAgentParams.CustomLabelsEntry
-		AgentStatus(0),                                      // 108: inventory.v1.AgentStatus
-		LogLevel(0),                                         // 109: inventory.v1.LogLevel
-		(*common.MetricsResolutions)(nil),                   // 110: common.MetricsResolutions
-		(*durationpb.Duration)(nil),                         // 111: google.protobuf.Duration
-		(*common.StringMap)(nil),                            // 112: common.StringMap
+		(*QANValkeySlowlogAgent)(nil),                       // 14: inventory.v1.QANValkeySlowlogAgent
+		(*QANProxySQLDigestAgent)(nil),                      // 15: inventory.v1.QANProxySQLDigestAgent
+		(*RTAOptions)(nil),                                  // 16: inventory.v1.RTAOptions
+		(*RTAMongoDBAgent)(nil),                             // 17: inventory.v1.RTAMongoDBAgent
+		(*RTAMySQLAgent)(nil),                               // 18: inventory.v1.RTAMySQLAgent
+		(*RTAPostgreSQLAgent)(nil),                          // 19: inventory.v1.RTAPostgreSQLAgent
+		(*QANPostgreSQLPgStatementsAgent)(nil),              // 20: inventory.v1.QANPostgreSQLPgStatementsAgent
+		(*QANPostgreSQLPgStatMonitorAgent)(nil),             // 21: inventory.v1.QANPostgreSQLPgStatMonitorAgent
+		(*QANPostgreSQLLogAgent)(nil),                       // 22: inventory.v1.QANPostgreSQLLogAgent
+		(*RDSExporter)(nil),                                 // 23: inventory.v1.RDSExporter
+		(*ExternalExporter)(nil),                            // 24: inventory.v1.ExternalExporter
+		(*AzureDatabaseExporter)(nil),                       // 25: inventory.v1.AzureDatabaseExporter
+		(*ChangeCommonAgentParams)(nil),                     // 26: inventory.v1.ChangeCommonAgentParams
+		(*ListAgentsRequest)(nil),                           // 27: inventory.v1.ListAgentsRequest
+		(*ListAgentsResponse)(nil),                          // 28: inventory.v1.ListAgentsResponse
+		(*GetAgentRequest)(nil),                             // 29: inventory.v1.GetAgentRequest
+		(*GetAgentResponse)(nil),                            // 30: inventory.v1.GetAgentResponse
+		(*GetAgentLogsRequest)(nil),                         // 31: inventory.v1.GetAgentLogsRequest
+		(*GetAgentLogsResponse)(nil),                        // 32: inventory.v1.GetAgentLogsResponse
+		(*AddAgentRequest)(nil),                             // 33: inventory.v1.AddAgentRequest
+		(*AddAgentResponse)(nil),                            // 34: inventory.v1.AddAgentResponse
+		(*ChangeAgentRequest)(nil),                          // 35: inventory.v1.ChangeAgentRequest
+		(*ChangeAgentResponse)(nil),                         // 36: inventory.v1.ChangeAgentResponse
+		(*AddPMMAgentParams)(nil),                           // 37: inventory.v1.AddPMMAgentParams
+		(*AddNodeExporterParams)(nil),                       // 38: inventory.v1.AddNodeExporterParams
+		(*ChangeNodeExporterParams)(nil),                    // 39: inventory.v1.ChangeNodeExporterParams
+		(*AddMySQLdExporterParams)(nil),                     // 40: inventory.v1.AddMySQLdExporterParams
+		(*ChangeMySQLdExporterParams)(nil),                  // 41: inventory.v1.ChangeMySQLdExporterParams
+		(*AddMongoDBExporterParams)(nil),                    // 42: inventory.v1.AddMongoDBExporterParams
+		(*ChangeMongoDBExporterParams)(nil),                 // 43: inventory.v1.ChangeMongoDBExporterParams
+		(*AddPostgresExporterParams)(nil),                   // 44: inventory.v1.AddPostgresExporterParams
+		(*ChangePostgresExporterParams)(nil),                // 45: inventory.v1.ChangePostgresExporterParams
+		(*AddProxySQLExporterParams)(nil),                   // 46: inventory.v1.AddProxySQLExporterParams
+		(*ChangeProxySQLExporterParams)(nil),                // 47: inventory.v1.ChangeProxySQLExporterParams
+		(*AddQANMySQLPerfSchemaAgentParams)(nil),            // 48: inventory.v1.AddQANMySQLPerfSchemaAgentParams
+		(*ChangeQANMySQLPerfSchemaAgentParams)(nil),         // 49: inventory.v1.ChangeQANMySQLPerfSchemaAgentParams
+		(*AddQANMySQLSlowlogAgentParams)(nil),               // 50: inventory.v1.AddQANMySQLSlowlogAgentParams
+		(*ChangeQANMySQLSlowlogAgentParams)(nil),            // 51: inventory.v1.ChangeQANMySQLSlowlogAgentParams
+		(*AddQANMongoDBProfilerAgentParams)(nil),            // 52: inventory.v1.AddQANMongoDBProfilerAgentParams
+		(*ChangeQANMongoDBProfilerAgentParams)(nil),         // 53: inventory.v1.ChangeQANMongoDBProfilerAgentParams
+		(*AddQANMongoDBMongologAgentParams)(nil),            // 54: inventory.v1.AddQANMongoDBMongologAgentParams
+		(*ChangeQANMongoDBMongologAgentParams)(nil),         // 55: inventory.v1.ChangeQANMongoDBMongologAgentParams
+		(*AddQANValkeySlowlogAgentParams)(nil),              // 56: inventory.v1.AddQANValkeySlowlogAgentParams
+		(*ChangeQANValkeySlowlogAgentParams)(nil),           // 57: inventory.v1.ChangeQANValkeySlowlogAgentParams
+		(*AddQANProxySQLDigestAgentParams)(nil),             // 58: inventory.v1.AddQANProxySQLDigestAgentParams
+		(*ChangeQANProxySQLDigestAgentParams)(nil),          // 59: inventory.v1.ChangeQANProxySQLDigestAgentParams
+		(*AddQANPostgreSQLPgStatementsAgentParams)(nil),     // 60: inventory.v1.AddQANPostgreSQLPgStatementsAgentParams
+		(*ChangeQANPostgreSQLPgStatementsAgentParams)(nil),  // 61: inventory.v1.ChangeQANPostgreSQLPgStatementsAgentParams
+		(*AddQANPostgreSQLPgStatMonitorAgentParams)(nil),    // 62: inventory.v1.AddQANPostgreSQLPgStatMonitorAgentParams
+		(*ChangeQANPostgreSQLPgStatMonitorAgentParams)(nil), // 63: inventory.v1.ChangeQANPostgreSQLPgStatMonitorAgentParams
+		(*AddQANPostgreSQLLogAgentParams)(nil),              // 64: inventory.v1.AddQANPostgreSQLLogAgentParams
+		(*ChangeQANPostgreSQLLogAgentParams)(nil),           // 65: inventory.v1.ChangeQANPostgreSQLLogAgentParams
+		(*AddRDSExporterParams)(nil),                        // 66: inventory.v1.AddRDSExporterParams
+		(*ChangeRDSExporterParams)(nil),                     // 67: inventory.v1.ChangeRDSExporterParams
+		(*AddExternalExporterParams)(nil),                   // 68: inventory.v1.AddExternalExporterParams
+		(*ChangeExternalExporterParams)(nil),                // 69: inventory.v1.ChangeExternalExporterParams
+		(*AddAzureDatabaseExporterParams)(nil),              // 70: inventory.v1.AddAzureDatabaseExporterParams
+		(*ChangeAzureDatabaseExporterParams)(nil),           // 71: inventory.v1.ChangeAzureDatabaseExporterParams
+		(*ChangeNomadAgentParams)(nil),                      // 72: inventory.v1.ChangeNomadAgentParams
+		(*AddValkeyExporterParams)(nil),                     // 73: inventory.v1.AddValkeyExporterParams
+		(*ChangeValkeyExporterParams)(nil),                  // 74: inventory.v1.ChangeValkeyExporterParams
+		(*AddRTAMongoDBAgentParams)(nil),                    // 75: inventory.v1.AddRTAMongoDBAgentParams
+		(*ChangeRTAMongoDBAgentParams)(nil),                 // 76: inventory.v1.ChangeRTAMongoDBAgentParams
+		(*AddRTAMySQLAgentParams)(nil),                      // 77: inventory.v1.AddRTAMySQLAgentParams
+		(*ChangeRTAMySQLAgentParams)(nil),                   // 78: inventory.v1.ChangeRTAMySQLAgentParams
+		(*AddRTAPostgreSQLAgentParams)(nil),                 // 79: inventory.v1.AddRTAPostgreSQLAgentParams
+		(*ChangeRTAPostgreSQLAgentParams)(nil),              // 80: inventory.v1.ChangeRTAPostgreSQLAgentParams
+		(*RemoveAgentRequest)(nil),                          // 81: inventory.v1.RemoveAgentRequest
+		(*RemoveAgentResponse)(nil),                         // 82: inventory.v1.RemoveAgentResponse
+		nil,                                                 // 83: inventory.v1.PMMAgent.CustomLabelsEntry
+		nil,                                                 // 84: inventory.v1.NodeExporter.CustomLabelsEntry
+		nil,                                                 // 85: inventory.v1.MySQLdExporter.CustomLabelsEntry
+		nil,                                                 // 86: inventory.v1.MySQLdExporter.ExtraDsnParamsEntry
+		nil,                                                 // 87: inventory.v1.MongoDBExporter.CustomLabelsEntry
+		nil,                                                 // 88: inventory.v1.PostgresExporter.CustomLabelsEntry
+		nil,                                                 // 89: inventory.v1.ProxySQLExporter.CustomLabelsEntry
+		nil,                                                 // 90: inventory.v1.ValkeyExporter.CustomLabelsEntry
+		nil,                                                 // 91: inventory.v1.QANMySQLPerfSchemaAgent.CustomLabelsEntry
+		nil,                                                 // 92: inventory.v1.QANMySQLPerfSchemaAgent.ExtraDsnParamsEntry
+		nil,                                                 // 93: inventory.v1.QANMySQLSlowlogAgent.CustomLabelsEntry
+		nil,                                                 // 94: inventory.v1.QANMySQLSlowlogAgent.ExtraDsnParamsEntry
+		nil,                                                 // 95: inventory.v1.QANMongoDBProfilerAgent.CustomLabelsEntry
+		nil,                                                 // 96: inventory.v1.QANMongoDBMongologAgent.CustomLabelsEntry
+		nil,                                                 // 97: inventory.v1.QANValkeySlowlogAgent.CustomLabelsEntry
+		nil,                                                 // 98: inventory.v1.QANProxySQLDigestAgent.CustomLabelsEntry
+		nil,                                                 // 99: inventory.v1.RTAMongoDBAgent.CustomLabelsEntry
+		nil,                                                 // 100: inventory.v1.RTAMySQLAgent.CustomLabelsEntry
+		nil,                                                 // 101: inventory.v1.RTAPostgreSQLAgent.CustomLabelsEntry
+		nil,                                                 // 102: inventory.v1.QANPostgreSQLPgStatementsAgent.CustomLabelsEntry
+		nil,                                                 // 103: inventory.v1.QANPostgreSQLPgStatMonitorAgent.CustomLabelsEntry
+		nil,                                                 // 104: inventory.v1.QANPostgreSQLLogAgent.CustomLabelsEntry
+		nil,                                                 // 105: inventory.v1.RDSExporter.CustomLabelsEntry
+		nil,                                                 // 106: inventory.v1.ExternalExporter.CustomLabelsEntry
+		nil,                                                 // 107: inventory.v1.AzureDatabaseExporter.CustomLabelsEntry
+		nil,                                                 // 108: inventory.v1.AddPMMAgentParams.CustomLabelsEntry
+		nil,                                                 // 109: inventory.v1.AddNodeExporterParams.CustomLabelsEntry
+		nil,                                                 // 110: inventory.v1.AddMySQLdExporterParams.CustomLabelsEntry
+		nil,                                                 // 111: inventory.v1.AddMySQLdExporterParams.ExtraDsnParamsEntry
+		nil,                                                 // 112: inventory.v1.AddMongoDBExporterParams.CustomLabelsEntry
+		nil,                                                 // 113: inventory.v1.AddPostgresExporterParams.CustomLabelsEntry
+		nil,                                                 // 114: inventory.v1.AddProxySQLExporterParams.CustomLabelsEntry
+		nil,                                                 // 115: inventory.v1.AddQANMySQLPerfSchemaAgentParams.CustomLabelsEntry
+		nil,                                                 // 116: inventory.v1.AddQANMySQLPerfSchemaAgentParams.ExtraDsnParamsEntry
+		nil,                                                 // 117: inventory.v1.AddQANMySQLSlowlogAgentParams.CustomLabelsEntry
+		nil,                                                 // 118: inventory.v1.AddQANMySQLSlowlogAgentParams.ExtraDsnParamsEntry
+		nil,                                                 // 119: inventory.v1.AddQANMongoDBProfilerAgentParams.CustomLabelsEntry
+		nil,                                                 // 120: inventory.v1.AddQANMongoDBMongologAgentParams.CustomLabelsEntry
+		nil,                                                 // 121: inventory.v1.AddQANValkeySlowlogAgentParams.CustomLabelsEntry
+		nil,                                                 // 122: inventory.v1.AddQANProxySQLDigestAgentParams.CustomLabelsEntry
+		nil,                                                 // 123: inventory.v1.AddQANPostgreSQLPgStatementsAgentParams.CustomLabelsEntry
+		nil,                                                 // 124: inventory.v1.AddQANPostgreSQLPgStatMonitorAgentParams.CustomLabelsEntry
+		nil,                                                 // 125: inventory.v1.AddQANPostgreSQLLogAgentParams.CustomLabelsEntry
+		nil,                                                 // 126: inventory.v1.AddRDSExporterParams.CustomLabelsEntry
+		nil,                                                 // 127: inventory.v1.AddExternalExporterParams.CustomLabelsEntry
+		nil,                                                 // 128: inventory.v1.AddAzureDatabaseExporterParams.CustomLabelsEntry
+		nil,                                                 // 129: inventory.v1.AddValkeyExporterParams.CustomLabelsEntry
+		nil,                                                 // 130: inventory.v1.AddRTAMongoDBAgentParams.CustomLabelsEntry
+		nil,                                                 // 131: inventory.v1.AddRTAMySQLAgentParams.CustomLabelsEntry
+		nil,                                                 // 132: inventory.v1.AddRTAMySQLAgentParams.ExtraDsnParamsEntry
+		nil,                                                 // 133: inventory.v1.AddRTAPostgreSQLAgentParams.CustomLabelsEntry
+		AgentStatus(0),                                      // 134: inventory.v1.AgentStatus
+		LogLevel(0),                                         // 135: inventory.v1.LogLevel
+		(*common.MetricsResolutions)(nil),                   // 136: common.MetricsResolutions
+		(*durationpb.Duration)(nil),                         // 137: google.protobuf.Duration
+		(*common.StringMap)(nil),                            // 138: common.StringMap
 	}
 )
 
 var file_inventory_v1_agents_proto_depIdxs = []int32{
-	68,  // 0: inventory.v1.PMMAgent.custom_labels:type_name -> inventory.v1.PMMAgent.CustomLabelsEntry
-	108, // 1: inventory.v1.VMAgent.status:type_name -> inventory.v1.AgentStatus
-	108, // 2: inventory.v1.NomadAgent.status:type_name -> inventory.v1.AgentStatus
-	69,  // 3: inventory.v1.NodeExporter.custom_labels:type_name -> inventory.v1.NodeExporter.CustomLabelsEntry
-	108, // 4: inventory.v1.NodeExporter.status:type_name -> inventory.v1.AgentStatus
-	109, // 5: inventory.v1.NodeExporter.log_level:type_name -> inventory.v1.LogLevel
-	110, // 6: inventory.v1.NodeExporter.metrics_resolutions:type_name -> common.MetricsResolutions
-	70,  // 7: inventory.v1.MySQLdExporter.custom_labels:type_name -> inventory.v1.MySQLdExporter.CustomLabelsEntry
-	108, // 8: inventory.v1.MySQLdExporter.status:type_name -> inventory.v1.AgentStatus
-	109, // 9: inventory.v1.MySQLdExporter.log_level:type_name -> inventory.v1.LogLevel
-	110, // 10: inventory.v1.MySQLdExporter.metrics_resolutions:type_name -> common.MetricsResolutions
-	71,  // 11: inventory.v1.MySQLdExporter.extra_dsn_params:type_name -> inventory.v1.MySQLdExporter.ExtraDsnParamsEntry
-	111, // 12: inventory.v1.MySQLdExporter.connection_timeout:type_name -> google.protobuf.Duration
-	72,  // 13: inventory.v1.MongoDBExporter.custom_labels:type_name -> inventory.v1.MongoDBExporter.CustomLabelsEntry
-	108, // 14: inventory.v1.MongoDBExporter.status:type_name -> inventory.v1.AgentStatus
-	109, // 15: inventory.v1.MongoDBExporter.log_level:type_name -> inventory.v1.LogLevel
-	110, // 16: inventory.v1.MongoDBExporter.metrics_resolutions:type_name -> common.MetricsResolutions
-	111, // 17: inventory.v1.MongoDBExporter.connection_timeout:type_name -> google.protobuf.Duration
-	73,  // 18: inventory.v1.PostgresExporter.custom_labels:type_name -> inventory.v1.PostgresExporter.CustomLabelsEntry
-	108, // 19: inventory.v1.PostgresExporter.status:type_name -> inventory.v1.AgentStatus
-	109, // 20: inventory.v1.PostgresExporter.log_level:type_name -> inventory.v1.LogLevel
-	110, // 21: inventory.v1.PostgresExporter.metrics_resolutions:type_name -> common.MetricsResolutions
-	111, // 22: inventory.v1.PostgresExporter.connection_timeout:type_name -> google.protobuf.Duration
-	74,  // 23: inventory.v1.ProxySQLExporter.custom_labels:type_name -> inventory.v1.ProxySQLExporter.CustomLabelsEntry
-	108, // 24: inventory.v1.ProxySQLExporter.status:type_name -> inventory.v1.AgentStatus
-	109, // 25: inventory.v1.ProxySQLExporter.log_level:type_name -> inventory.v1.LogLevel
-	110, // 26: inventory.v1.ProxySQLExporter.metrics_resolutions:type_name -> common.MetricsResolutions
-	111, // 27: inventory.v1.ProxySQLExporter.connection_timeout:type_name -> google.protobuf.Duration
-	75,  // 28: inventory.v1.ValkeyExporter.custom_labels:type_name -> inventory.v1.ValkeyExporter.CustomLabelsEntry
-	108, // 29: inventory.v1.ValkeyExporter.status:type_name -> inventory.v1.AgentStatus
-	110, // 30: inventory.v1.ValkeyExporter.metrics_resolutions:type_name -> common.MetricsResolutions
-	111, // 31: inventory.v1.ValkeyExporter.connection_timeout:type_name -> google.protobuf.Duration
-	76,  // 32: inventory.v1.QANMySQLPerfSchemaAgent.custom_labels:type_name -> inventory.v1.QANMySQLPerfSchemaAgent.CustomLabelsEntry
-	108, // 33: inventory.v1.QANMySQLPerfSchemaAgent.status:type_name -> inventory.v1.AgentStatus
-	109, // 34: inventory.v1.QANMySQLPerfSchemaAgent.log_level:type_name -> inventory.v1.LogLevel
-	77,  // 35: inventory.v1.QANMySQLPerfSchemaAgent.extra_dsn_params:type_name -> inventory.v1.QANMySQLPerfSchemaAgent.ExtraDsnParamsEntry
-	78,  // 36: inventory.v1.QANMySQLSlowlogAgent.custom_labels:type_name -> inventory.v1.QANMySQLSlowlogAgent.CustomLabelsEntry
-	108, // 37: inventory.v1.QANMySQLSlowlogAgent.status:type_name -> inventory.v1.AgentStatus
-	109, // 38: inventory.v1.QANMySQLSlowlogAgent.log_level:type_name -> inventory.v1.LogLevel
-	79,  // 39: inventory.v1.QANMySQLSlowlogAgent.extra_dsn_params:type_name -> inventory.v1.QANMySQLSlowlogAgent.ExtraDsnParamsEntry
-	80,  // 40: inventory.v1.QANMongoDBProfilerAgent.custom_labels:type_name -> inventory.v1.QANMongoDBProfilerAgent.CustomLabelsEntry
-	108, // 41: inventory.v1.QANMongoDBProfilerAgent.status:type_name -> inventory.v1.AgentStatus
-	109, // 42: inventory.v1.QANMongoDBProfilerAgent.log_level:type_name -> inventory.v1.LogLevel
-	81,  // 43: inventory.v1.QANMongoDBMongologAgent.custom_labels:type_name -> inventory.v1.QANMongoDBMongologAgent.CustomLabelsEntry
-	108, // 44: inventory.v1.QANMongoDBMongologAgent.status:type_name -> inventory.v1.AgentStatus
-	109, // 45: inventory.v1.QANMongoDBMongologAgent.log_level:type_name -> inventory.v1.LogLevel
-	111, // 46: inventory.v1.RTAOptions.collect_interval:type_name -> google.protobuf.Duration
-	82,  // 47: inventory.v1.RTAMongoDBAgent.custom_labels:type_name -> inventory.v1.RTAMongoDBAgent.CustomLabelsEntry
-	14,  // 48: inventory.v1.RTAMongoDBAgent.rta_options:type_name -> inventory.v1.RTAOptions
-	108, // 49: inventory.v1.RTAMongoDBAgent.status:type_name -> inventory.v1.AgentStatus
-	109, // 50: inventory.v1.RTAMongoDBAgent.log_level:type_name -> inventory.v1.LogLevel
-	83,  // 51: inventory.v1.QANPostgreSQLPgStatementsAgent.custom_labels:type_name -> inventory.v1.QANPostgreSQLPgStatementsAgent.CustomLabelsEntry
-	108, // 52: inventory.v1.QANPostgreSQLPgStatementsAgent.status:type_name -> inventory.v1.AgentStatus
-	109, // 53: inventory.v1.QANPostgreSQLPgStatementsAgent.log_level:type_name -> inventory.v1.LogLevel
-	84,  // 54: inventory.v1.QANPostgreSQLPgStatMonitorAgent.custom_labels:type_name -> inventory.v1.QANPostgreSQLPgStatMonitorAgent.CustomLabelsEntry
-	108, // 55: inventory.v1.QANPostgreSQLPgStatMonitorAgent.status:type_name -> inventory.v1.AgentStatus
-	109, // 56: inventory.v1.QANPostgreSQLPgStatMonitorAgent.log_level:type_name -> inventory.v1.LogLevel
-	85,  // 57: inventory.v1.RDSExporter.custom_labels:type_name -> inventory.v1.RDSExporter.CustomLabelsEntry
-	108, // 58: inventory.v1.RDSExporter.status:type_name -> inventory.v1.AgentStatus
-	109, // 59: inventory.v1.RDSExporter.log_level:type_name -> inventory.v1.LogLevel
-	110, // 60: inventory.v1.RDSExporter.metrics_resolutions:type_name -> common.MetricsResolutions
-	86,  // 61: inventory.v1.ExternalExporter.custom_labels:type_name -> inventory.v1.ExternalExporter.CustomLabelsEntry
-	110, // 62: inventory.v1.ExternalExporter.metrics_resolutions:type_name -> common.MetricsResolutions
-	108, // 63: inventory.v1.ExternalExporter.status:type_name -> inventory.v1.AgentStatus
-	87,  // 64: inventory.v1.AzureDatabaseExporter.custom_labels:type_name -> inventory.v1.AzureDatabaseExporter.CustomLabelsEntry
-	108, // 65: inventory.v1.AzureDatabaseExporter.status:type_name -> inventory.v1.AgentStatus
-	109, // 66: inventory.v1.AzureDatabaseExporter.log_level:type_name -> inventory.v1.LogLevel
-	110, // 67: inventory.v1.AzureDatabaseExporter.metrics_resolutions:type_name -> common.MetricsResolutions
-	112, // 68: inventory.v1.ChangeCommonAgentParams.custom_labels:type_name -> common.StringMap
-	110, // 69: inventory.v1.ChangeCommonAgentParams.metrics_resolutions:type_name -> common.MetricsResolutions
-	0,   // 70: inventory.v1.ListAgentsRequest.agent_type:type_name -> inventory.v1.AgentType
-	1,   // 71: inventory.v1.ListAgentsResponse.pmm_agent:type_name -> inventory.v1.PMMAgent
-	2,   // 72: inventory.v1.ListAgentsResponse.vm_agent:type_name -> inventory.v1.VMAgent
-	4,   // 73: inventory.v1.ListAgentsResponse.node_exporter:type_name -> inventory.v1.NodeExporter
-	5,   // 74: inventory.v1.ListAgentsResponse.mysqld_exporter:type_name -> inventory.v1.MySQLdExporter
-	6,   // 75: inventory.v1.ListAgentsResponse.mongodb_exporter:type_name -> inventory.v1.MongoDBExporter
-	7,   // 76: inventory.v1.ListAgentsResponse.postgres_exporter:type_name -> inventory.v1.PostgresExporter
-	8,   // 77: inventory.v1.ListAgentsResponse.proxysql_exporter:type_name -> inventory.v1.ProxySQLExporter
-	10,  // 78: inventory.v1.ListAgentsResponse.qan_mysql_perfschema_agent:type_name -> inventory.v1.QANMySQLPerfSchemaAgent
-	11,  // 79: inventory.v1.ListAgentsResponse.qan_mysql_slowlog_agent:type_name -> inventory.v1.QANMySQLSlowlogAgent
-	12,  // 80: inventory.v1.ListAgentsResponse.qan_mongodb_profiler_agent:type_name -> inventory.v1.QANMongoDBProfilerAgent
-	13,  // 81: inventory.v1.ListAgentsResponse.qan_mongodb_mongolog_agent:type_name -> inventory.v1.QANMongoDBMongologAgent
-	16,  // 82: inventory.v1.ListAgentsResponse.qan_postgresql_pgstatements_agent:type_name -> inventory.v1.QANPostgreSQLPgStatementsAgent
-	17,  // 83: inventory.v1.ListAgentsResponse.qan_postgresql_pgstatmonitor_agent:type_name -> inventory.v1.QANPostgreSQLPgStatMonitorAgent
-	19,  // 84: inventory.v1.ListAgentsResponse.external_exporter:type_name -> inventory.v1.ExternalExporter
-	18,  // 85: inventory.v1.ListAgentsResponse.rds_exporter:type_name -> inventory.v1.RDSExporter
-	20,  // 86: inventory.v1.ListAgentsResponse.azure_database_exporter:type_name -> inventory.v1.AzureDatabaseExporter
-	3,   // 87: inventory.v1.ListAgentsResponse.nomad_agent:type_name -> inventory.v1.NomadAgent
-	9,   // 88: inventory.v1.ListAgentsResponse.valkey_exporter:type_name -> inventory.v1.ValkeyExporter
-	15,  // 89: inventory.v1.ListAgentsResponse.rta_mongodb_agent:type_name -> inventory.v1.RTAMongoDBAgent
-	1,   // 90: inventory.v1.GetAgentResponse.pmm_agent:type_name -> inventory.v1.PMMAgent
-	2,   // 91: inventory.v1.GetAgentResponse.vmagent:type_name -> inventory.v1.VMAgent
-	4,   // 92: inventory.v1.GetAgentResponse.node_exporter:type_name -> inventory.v1.NodeExporter
-	5,   // 93: inventory.v1.GetAgentResponse.mysqld_exporter:type_name -> inventory.v1.MySQLdExporter
-	6,   // 94: inventory.v1.GetAgentResponse.mongodb_exporter:type_name -> inventory.v1.MongoDBExporter
-	7,   // 95: inventory.v1.GetAgentResponse.postgres_exporter:type_name -> inventory.v1.PostgresExporter
-	8,   // 96: inventory.v1.GetAgentResponse.proxysql_exporter:type_name -> inventory.v1.ProxySQLExporter
-	10,  // 97: inventory.v1.GetAgentResponse.qan_mysql_perfschema_agent:type_name -> inventory.v1.QANMySQLPerfSchemaAgent
-	11,  // 98: inventory.v1.GetAgentResponse.qan_mysql_slowlog_agent:type_name -> inventory.v1.QANMySQLSlowlogAgent
-	12,  // 99: inventory.v1.GetAgentResponse.qan_mongodb_profiler_agent:type_name -> inventory.v1.QANMongoDBProfilerAgent
-	13,  // 100: inventory.v1.GetAgentResponse.qan_mongodb_mongolog_agent:type_name -> inventory.v1.QANMongoDBMongologAgent
-	16,  // 101: inventory.v1.GetAgentResponse.qan_postgresql_pgstatements_agent:type_name -> inventory.v1.QANPostgreSQLPgStatementsAgent
-	17,  // 102: inventory.v1.GetAgentResponse.qan_postgresql_pgstatmonitor_agent:type_name -> inventory.v1.QANPostgreSQLPgStatMonitorAgent
-	19,  // 103: inventory.v1.GetAgentResponse.external_exporter:type_name -> inventory.v1.ExternalExporter
-	18,  // 104: inventory.v1.GetAgentResponse.rds_exporter:type_name -> inventory.v1.RDSExporter
-	20,  // 105: inventory.v1.GetAgentResponse.azure_database_exporter:type_name -> inventory.v1.AzureDatabaseExporter
-	3,   // 106: inventory.v1.GetAgentResponse.nomad_agent:type_name -> inventory.v1.NomadAgent
-	9,   // 107: inventory.v1.GetAgentResponse.valkey_exporter:type_name -> inventory.v1.ValkeyExporter
-	15,  // 108: inventory.v1.GetAgentResponse.rta_mongodb_agent:type_name -> inventory.v1.RTAMongoDBAgent
-	32,  // 109: inventory.v1.AddAgentRequest.pmm_agent:type_name -> inventory.v1.AddPMMAgentParams
-	33,  // 110: inventory.v1.AddAgentRequest.node_exporter:type_name -> inventory.v1.AddNodeExporterParams
-	35,  // 111: inventory.v1.AddAgentRequest.mysqld_exporter:type_name -> inventory.v1.AddMySQLdExporterParams
-	37,  // 112: inventory.v1.AddAgentRequest.mongodb_exporter:type_name -> inventory.v1.AddMongoDBExporterParams
-	39,  // 113: inventory.v1.AddAgentRequest.postgres_exporter:type_name -> inventory.v1.AddPostgresExporterParams
-	41,  // 114: inventory.v1.AddAgentRequest.proxysql_exporter:type_name -> inventory.v1.AddProxySQLExporterParams
-	57,  // 115: inventory.v1.AddAgentRequest.external_exporter:type_name -> inventory.v1.AddExternalExporterParams
-	55,  // 116: inventory.v1.AddAgentRequest.rds_exporter:type_name -> inventory.v1.AddRDSExporterParams
-	59,  // 117: inventory.v1.AddAgentRequest.azure_database_exporter:type_name -> inventory.v1.AddAzureDatabaseExporterParams
-	43,  // 118: inventory.v1.AddAgentRequest.qan_mysql_perfschema_agent:type_name -> inventory.v1.AddQANMySQLPerfSchemaAgentParams
-	45,  // 119: inventory.v1.AddAgentRequest.qan_mysql_slowlog_agent:type_name -> inventory.v1.AddQANMySQLSlowlogAgentParams
-	47,  // 120: inventory.v1.AddAgentRequest.qan_mongodb_profiler_agent:type_name -> inventory.v1.AddQANMongoDBProfilerAgentParams
-	49,  // 121: inventory.v1.AddAgentRequest.qan_mongodb_mongolog_agent:type_name -> inventory.v1.AddQANMongoDBMongologAgentParams
-	51,  // 122: inventory.v1.AddAgentRequest.qan_postgresql_pgstatements_agent:type_name -> inventory.v1.AddQANPostgreSQLPgStatementsAgentParams
-	53,  // 123: inventory.v1.AddAgentRequest.qan_postgresql_pgstatmonitor_agent:type_name -> inventory.v1.AddQANPostgreSQLPgStatMonitorAgentParams
-	62,  // 124: inventory.v1.AddAgentRequest.valkey_exporter:type_name -> inventory.v1.AddValkeyExporterParams
-	64,  // 125: inventory.v1.AddAgentRequest.rta_mongodb_agent:type_name -> inventory.v1.AddRTAMongoDBAgentParams
-	1,   // 126: inventory.v1.AddAgentResponse.pmm_agent:type_name -> inventory.v1.PMMAgent
-	4,   // 127: inventory.v1.AddAgentResponse.node_exporter:type_name -> inventory.v1.NodeExporter
-	5,   // 128: inventory.v1.AddAgentResponse.mysqld_exporter:type_name -> inventory.v1.MySQLdExporter
-	6,   // 129: inventory.v1.AddAgentResponse.mongodb_exporter:type_name -> inventory.v1.MongoDBExporter
-	7,   // 130: inventory.v1.AddAgentResponse.postgres_exporter:type_name -> inventory.v1.PostgresExporter
-	8,   // 131: inventory.v1.AddAgentResponse.proxysql_exporter:type_name -> inventory.v1.ProxySQLExporter
-	19,  // 132: inventory.v1.AddAgentResponse.external_exporter:type_name -> inventory.v1.ExternalExporter
-	18,  // 133: inventory.v1.AddAgentResponse.rds_exporter:type_name -> inventory.v1.RDSExporter
-	20,  // 134: inventory.v1.AddAgentResponse.azure_database_exporter:type_name -> inventory.v1.AzureDatabaseExporter
-	10,  // 135: inventory.v1.AddAgentResponse.qan_mysql_perfschema_agent:type_name -> inventory.v1.QANMySQLPerfSchemaAgent
-	11,  // 136: inventory.v1.AddAgentResponse.qan_mysql_slowlog_agent:type_name -> inventory.v1.QANMySQLSlowlogAgent
-	12,  // 137: inventory.v1.AddAgentResponse.qan_mongodb_profiler_agent:type_name -> inventory.v1.QANMongoDBProfilerAgent
-	13,  // 138: inventory.v1.AddAgentResponse.qan_mongodb_mongolog_agent:type_name -> inventory.v1.QANMongoDBMongologAgent
-	16,  // 139: inventory.v1.AddAgentResponse.qan_postgresql_pgstatements_agent:type_name -> inventory.v1.QANPostgreSQLPgStatementsAgent
-	17,  // 140: inventory.v1.AddAgentResponse.qan_postgresql_pgstatmonitor_agent:type_name -> inventory.v1.QANPostgreSQLPgStatMonitorAgent
-	9,   // 141: inventory.v1.AddAgentResponse.valkey_exporter:type_name -> inventory.v1.ValkeyExporter
-	15,  // 142: inventory.v1.AddAgentResponse.rta_mongodb_agent:type_name -> inventory.v1.RTAMongoDBAgent
-	34,  // 143: inventory.v1.ChangeAgentRequest.node_exporter:type_name -> inventory.v1.ChangeNodeExporterParams
-	36,  // 144: inventory.v1.ChangeAgentRequest.mysqld_exporter:type_name -> inventory.v1.ChangeMySQLdExporterParams
-	38,  // 145: inventory.v1.ChangeAgentRequest.mongodb_exporter:type_name -> inventory.v1.ChangeMongoDBExporterParams
-	40,  // 146: inventory.v1.ChangeAgentRequest.postgres_exporter:type_name -> inventory.v1.ChangePostgresExporterParams
-	42,  // 147: inventory.v1.ChangeAgentRequest.proxysql_exporter:type_name -> inventory.v1.ChangeProxySQLExporterParams
-	58,  // 148: inventory.v1.ChangeAgentRequest.external_exporter:type_name -> inventory.v1.ChangeExternalExporterParams
-	56,  // 149: inventory.v1.ChangeAgentRequest.rds_exporter:type_name -> inventory.v1.ChangeRDSExporterParams
-	60,  // 150: inventory.v1.ChangeAgentRequest.azure_database_exporter:type_name -> inventory.v1.ChangeAzureDatabaseExporterParams
-	44,  // 151: inventory.v1.ChangeAgentRequest.qan_mysql_perfschema_agent:type_name -> inventory.v1.ChangeQANMySQLPerfSchemaAgentParams
-	46,  // 152: inventory.v1.ChangeAgentRequest.qan_mysql_slowlog_agent:type_name -> inventory.v1.ChangeQANMySQLSlowlogAgentParams
-	48,  // 153: inventory.v1.ChangeAgentRequest.qan_mongodb_profiler_agent:type_name -> inventory.v1.ChangeQANMongoDBProfilerAgentParams
-	50,  // 154: inventory.v1.ChangeAgentRequest.qan_mongodb_mongolog_agent:type_name -> inventory.v1.ChangeQANMongoDBMongologAgentParams
-	52,  // 155: inventory.v1.ChangeAgentRequest.qan_postgresql_pgstatements_agent:type_name -> inventory.v1.ChangeQANPostgreSQLPgStatementsAgentParams
-	54,  // 156: inventory.v1.ChangeAgentRequest.qan_postgresql_pgstatmonitor_agent:type_name -> inventory.v1.ChangeQANPostgreSQLPgStatMonitorAgentParams
-	61,  // 157: inventory.v1.ChangeAgentRequest.nomad_agent:type_name -> inventory.v1.ChangeNomadAgentParams
-	63,  // 158: inventory.v1.ChangeAgentRequest.valkey_exporter:type_name -> inventory.v1.ChangeValkeyExporterParams
-	65,  // 159: inventory.v1.ChangeAgentRequest.rta_mongodb_agent:type_name -> inventory.v1.ChangeRTAMongoDBAgentParams
-	4,   // 160: inventory.v1.ChangeAgentResponse.node_exporter:type_name -> inventory.v1.NodeExporter
-	5,   // 161: inventory.v1.ChangeAgentResponse.mysqld_exporter:type_name -> inventory.v1.MySQLdExporter
-	6,   // 162: inventory.v1.ChangeAgentResponse.mongodb_exporter:type_name -> inventory.v1.MongoDBExporter
-	7,   // 163: inventory.v1.ChangeAgentResponse.postgres_exporter:type_name -> inventory.v1.PostgresExporter
-	8,   // 164: inventory.v1.ChangeAgentResponse.proxysql_exporter:type_name -> inventory.v1.ProxySQLExporter
-	19,  // 165: inventory.v1.ChangeAgentResponse.external_exporter:type_name -> inventory.v1.ExternalExporter
-	18,  // 166: inventory.v1.ChangeAgentResponse.rds_exporter:type_name -> inventory.v1.RDSExporter
-	20,  // 167: inventory.v1.ChangeAgentResponse.azure_database_exporter:type_name -> inventory.v1.AzureDatabaseExporter
-	10,  // 168: inventory.v1.ChangeAgentResponse.qan_mysql_perfschema_agent:type_name -> inventory.v1.QANMySQLPerfSchemaAgent
-	11,  // 169: inventory.v1.ChangeAgentResponse.qan_mysql_slowlog_agent:type_name -> inventory.v1.QANMySQLSlowlogAgent
-	12,  // 170: inventory.v1.ChangeAgentResponse.qan_mongodb_profiler_agent:type_name -> inventory.v1.QANMongoDBProfilerAgent
-	13,  // 171: inventory.v1.ChangeAgentResponse.qan_mongodb_mongolog_agent:type_name -> inventory.v1.QANMongoDBMongologAgent
-	16,  // 172: inventory.v1.ChangeAgentResponse.qan_postgresql_pgstatements_agent:type_name -> inventory.v1.QANPostgreSQLPgStatementsAgent
-	17,  // 173: inventory.v1.ChangeAgentResponse.qan_postgresql_pgstatmonitor_agent:type_name -> inventory.v1.QANPostgreSQLPgStatMonitorAgent
-	3,   // 174: inventory.v1.ChangeAgentResponse.nomad_agent:type_name -> inventory.v1.NomadAgent
-	9,   // 175: inventory.v1.ChangeAgentResponse.valkey_exporter:type_name -> inventory.v1.ValkeyExporter
-	15,  // 176: inventory.v1.ChangeAgentResponse.rta_mongodb_agent:type_name -> inventory.v1.RTAMongoDBAgent
-	88,  // 177: inventory.v1.AddPMMAgentParams.custom_labels:type_name -> inventory.v1.AddPMMAgentParams.CustomLabelsEntry
-	89,  // 178: inventory.v1.AddNodeExporterParams.custom_labels:type_name -> inventory.v1.AddNodeExporterParams.CustomLabelsEntry
-	109, // 179: inventory.v1.AddNodeExporterParams.log_level:type_name -> inventory.v1.LogLevel
-	112, // 180: inventory.v1.ChangeNodeExporterParams.custom_labels:type_name -> common.StringMap
-	110, // 181: inventory.v1.ChangeNodeExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
-	109, // 182: inventory.v1.ChangeNodeExporterParams.log_level:type_name -> inventory.v1.LogLevel
-	90,  // 183: inventory.v1.AddMySQLdExporterParams.custom_labels:type_name -> inventory.v1.AddMySQLdExporterParams.CustomLabelsEntry
-	109, // 184: inventory.v1.AddMySQLdExporterParams.log_level:type_name -> inventory.v1.LogLevel
-	91,  // 185: inventory.v1.AddMySQLdExporterParams.extra_dsn_params:type_name -> inventory.v1.AddMySQLdExporterParams.ExtraDsnParamsEntry
-	111, // 186: inventory.v1.AddMySQLdExporterParams.connection_timeout:type_name -> google.protobuf.Duration
-	112, // 187: inventory.v1.ChangeMySQLdExporterParams.custom_labels:type_name -> common.StringMap
-	110, // 188: inventory.v1.ChangeMySQLdExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
-	109, // 189: inventory.v1.ChangeMySQLdExporterParams.log_level:type_name -> inventory.v1.LogLevel
-	111, // 190: inventory.v1.ChangeMySQLdExporterParams.connection_timeout:type_name -> google.protobuf.Duration
-	92,  // 191: inventory.v1.AddMongoDBExporterParams.custom_labels:type_name -> inventory.v1.AddMongoDBExporterParams.CustomLabelsEntry
-	109, // 192: inventory.v1.AddMongoDBExporterParams.log_level:type_name -> inventory.v1.LogLevel
-	111, // 193: inventory.v1.AddMongoDBExporterParams.connection_timeout:type_name -> google.protobuf.Duration
-	112, // 194: inventory.v1.ChangeMongoDBExporterParams.custom_labels:type_name -> common.StringMap
-	110, // 195: inventory.v1.ChangeMongoDBExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
-	109, // 196: inventory.v1.ChangeMongoDBExporterParams.log_level:type_name -> inventory.v1.LogLevel
-	111, // 197: inventory.v1.ChangeMongoDBExporterParams.connection_timeout:type_name -> google.protobuf.Duration
-	93,  // 198: inventory.v1.AddPostgresExporterParams.custom_labels:type_name -> inventory.v1.AddPostgresExporterParams.CustomLabelsEntry
-	109, // 199: inventory.v1.AddPostgresExporterParams.log_level:type_name -> inventory.v1.LogLevel
-	111, // 200: inventory.v1.AddPostgresExporterParams.connection_timeout:type_name -> google.protobuf.Duration
-	112, // 201: inventory.v1.ChangePostgresExporterParams.custom_labels:type_name -> common.StringMap
-	110, // 202: inventory.v1.ChangePostgresExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
-	109, // 203: inventory.v1.ChangePostgresExporterParams.log_level:type_name -> inventory.v1.LogLevel
-	111, // 204: inventory.v1.ChangePostgresExporterParams.connection_timeout:type_name -> google.protobuf.Duration
-	94,  // 205: inventory.v1.AddProxySQLExporterParams.custom_labels:type_name -> inventory.v1.AddProxySQLExporterParams.CustomLabelsEntry
-	109, // 206: inventory.v1.AddProxySQLExporterParams.log_level:type_name -> inventory.v1.LogLevel
-	111, // 207: inventory.v1.AddProxySQLExporterParams.connection_timeout:type_name -> google.protobuf.Duration
-	112, // 208: inventory.v1.ChangeProxySQLExporterParams.custom_labels:type_name -> common.StringMap
-	110, // 209: inventory.v1.ChangeProxySQLExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
-	109, // 210: inventory.v1.ChangeProxySQLExporterParams.log_level:type_name -> inventory.v1.LogLevel
-	111, // 211: inventory.v1.ChangeProxySQLExporterParams.connection_timeout:type_name -> google.protobuf.Duration
-	95,  // 212: inventory.v1.AddQANMySQLPerfSchemaAgentParams.custom_labels:type_name -> inventory.v1.AddQANMySQLPerfSchemaAgentParams.CustomLabelsEntry
-	109, // 213: inventory.v1.AddQANMySQLPerfSchemaAgentParams.log_level:type_name -> inventory.v1.LogLevel
-	96,  // 214: inventory.v1.AddQANMySQLPerfSchemaAgentParams.extra_dsn_params:type_name -> inventory.v1.AddQANMySQLPerfSchemaAgentParams.ExtraDsnParamsEntry
-	112, // 215: inventory.v1.ChangeQANMySQLPerfSchemaAgentParams.custom_labels:type_name -> common.StringMap
-	110, // 216: inventory.v1.ChangeQANMySQLPerfSchemaAgentParams.metrics_resolutions:type_name -> common.MetricsResolutions
-	109, // 217: inventory.v1.ChangeQANMySQLPerfSchemaAgentParams.log_level:type_name -> inventory.v1.LogLevel
-	97,  // 218: inventory.v1.AddQANMySQLSlowlogAgentParams.custom_labels:type_name -> inventory.v1.AddQANMySQLSlowlogAgentParams.CustomLabelsEntry
-	109, // 219: inventory.v1.AddQANMySQLSlowlogAgentParams.log_level:type_name -> inventory.v1.LogLevel
-	98,  // 220: inventory.v1.AddQANMySQLSlowlogAgentParams.extra_dsn_params:type_name -> inventory.v1.AddQANMySQLSlowlogAgentParams.ExtraDsnParamsEntry
-	112, // 221: inventory.v1.ChangeQANMySQLSlowlogAgentParams.custom_labels:type_name -> common.StringMap
-	110, // 222: inventory.v1.ChangeQANMySQLSlowlogAgentParams.metrics_resolutions:type_name -> common.MetricsResolutions
-	109, // 223: inventory.v1.ChangeQANMySQLSlowlogAgentParams.log_level:type_name -> inventory.v1.LogLevel
-	99,  // 224: inventory.v1.AddQANMongoDBProfilerAgentParams.custom_labels:type_name -> inventory.v1.AddQANMongoDBProfilerAgentParams.CustomLabelsEntry
-	109, // 225: inventory.v1.AddQANMongoDBProfilerAgentParams.log_level:type_name -> inventory.v1.LogLevel
-	112, // 226: inventory.v1.ChangeQANMongoDBProfilerAgentParams.custom_labels:type_name -> common.StringMap
-	110, // 227: inventory.v1.ChangeQANMongoDBProfilerAgentParams.metrics_resolutions:type_name -> common.MetricsResolutions
-	109, // 228: inventory.v1.ChangeQANMongoDBProfilerAgentParams.log_level:type_name -> inventory.v1.LogLevel
-	100, // 229: inventory.v1.AddQANMongoDBMongologAgentParams.custom_labels:type_name -> inventory.v1.AddQANMongoDBMongologAgentParams.CustomLabelsEntry
-	109, // 230: inventory.v1.AddQANMongoDBMongologAgentParams.log_level:type_name -> inventory.v1.LogLevel
-	112, // 231: inventory.v1.ChangeQANMongoDBMongologAgentParams.custom_labels:type_name -> common.StringMap
-	110, // 232: inventory.v1.ChangeQANMongoDBMongologAgentParams.metrics_resolutions:type_name -> common.MetricsResolutions
-	109, // 233: inventory.v1.ChangeQANMongoDBMongologAgentParams.log_level:type_name -> inventory.v1.LogLevel
-	101, // 234: inventory.v1.AddQANPostgreSQLPgStatementsAgentParams.custom_labels:type_name -> inventory.v1.AddQANPostgreSQLPgStatementsAgentParams.CustomLabelsEntry
-	109, // 235: inventory.v1.AddQANPostgreSQLPgStatementsAgentParams.log_level:type_name -> inventory.v1.LogLevel
-	112, // 236: inventory.v1.ChangeQANPostgreSQLPgStatementsAgentParams.custom_labels:type_name -> common.StringMap
-	110, // 237: inventory.v1.ChangeQANPostgreSQLPgStatementsAgentParams.metrics_resolutions:type_name -> common.MetricsResolutions
-	109, // 238: inventory.v1.ChangeQANPostgreSQLPgStatementsAgentParams.log_level:type_name -> inventory.v1.LogLevel
-	102, // 239: inventory.v1.AddQANPostgreSQLPgStatMonitorAgentParams.custom_labels:type_name -> inventory.v1.AddQANPostgreSQLPgStatMonitorAgentParams.CustomLabelsEntry
-	109, // 240: inventory.v1.AddQANPostgreSQLPgStatMonitorAgentParams.log_level:type_name -> inventory.v1.LogLevel
-	112, // 241: inventory.v1.ChangeQANPostgreSQLPgStatMonitorAgentParams.custom_labels:type_name -> common.StringMap
-	110, // 242: inventory.v1.ChangeQANPostgreSQLPgStatMonitorAgentParams.metrics_resolutions:type_name -> common.MetricsResolutions
-	109, // 243: inventory.v1.ChangeQANPostgreSQLPgStatMonitorAgentParams.log_level:type_name -> inventory.v1.LogLevel
-	103, // 244: inventory.v1.AddRDSExporterParams.custom_labels:type_name -> inventory.v1.AddRDSExporterParams.CustomLabelsEntry
-	109, // 245: inventory.v1.AddRDSExporterParams.log_level:type_name -> inventory.v1.LogLevel
-	112, // 246: inventory.v1.ChangeRDSExporterParams.custom_labels:type_name -> common.StringMap
-	110, // 247: inventory.v1.ChangeRDSExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
-	109, // 248: inventory.v1.ChangeRDSExporterParams.log_level:type_name -> inventory.v1.LogLevel
-	104, // 249: inventory.v1.AddExternalExporterParams.custom_labels:type_name -> inventory.v1.AddExternalExporterParams.CustomLabelsEntry
-	112, // 250: inventory.v1.ChangeExternalExporterParams.custom_labels:type_name -> common.StringMap
-	110, // 251: inventory.v1.ChangeExternalExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
-	105, // 252: inventory.v1.AddAzureDatabaseExporterParams.custom_labels:type_name -> inventory.v1.AddAzureDatabaseExporterParams.CustomLabelsEntry
-	109, // 253: inventory.v1.AddAzureDatabaseExporterParams.log_level:type_name -> inventory.v1.LogLevel
-	112, // 254: inventory.v1.ChangeAzureDatabaseExporterParams.custom_labels:type_name -> common.StringMap
-	110, // 255: inventory.v1.ChangeAzureDatabaseExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
-	109, // 256: inventory.v1.ChangeAzureDatabaseExporterParams.log_level:type_name -> inventory.v1.LogLevel
-	106, // 257: inventory.v1.AddValkeyExporterParams.custom_labels:type_name -> inventory.v1.AddValkeyExporterParams.CustomLabelsEntry
-	109, // 258: inventory.v1.AddValkeyExporterParams.log_level:type_name -> inventory.v1.LogLevel
-	111, // 259: inventory.v1.AddValkeyExporterParams.connection_timeout:type_name -> google.protobuf.Duration
-	112, // 260: inventory.v1.ChangeValkeyExporterParams.custom_labels:type_name -> common.StringMap
-	110, // 261: inventory.v1.ChangeValkeyExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
-	109, // 262: inventory.v1.ChangeValkeyExporterParams.log_level:type_name -> inventory.v1.LogLevel
-	111, // 263: inventory.v1.ChangeValkeyExporterParams.connection_timeout:type_name -> google.protobuf.Duration
-	107, // 264: inventory.v1.AddRTAMongoDBAgentParams.custom_labels:type_name -> inventory.v1.AddRTAMongoDBAgentParams.CustomLabelsEntry
-	109, // 265: inventory.v1.AddRTAMongoDBAgentParams.log_level:type_name -> inventory.v1.LogLevel
-	14,  // 266: inventory.v1.AddRTAMongoDBAgentParams.rta_options:type_name -> inventory.v1.RTAOptions
-	112, // 267: inventory.v1.ChangeRTAMongoDBAgentParams.custom_labels:type_name -> common.StringMap
-	109, // 268: inventory.v1.ChangeRTAMongoDBAgentParams.log_level:type_name -> inventory.v1.LogLevel
-	14,  // 269: inventory.v1.ChangeRTAMongoDBAgentParams.rta_options:type_name -> inventory.v1.RTAOptions
-	22,  // 270: inventory.v1.AgentsService.ListAgents:input_type -> inventory.v1.ListAgentsRequest
-	24,  // 271: inventory.v1.AgentsService.GetAgent:input_type -> inventory.v1.GetAgentRequest
-	26,  // 272: inventory.v1.AgentsService.GetAgentLogs:input_type -> inventory.v1.GetAgentLogsRequest
-	28,  // 273: inventory.v1.AgentsService.AddAgent:input_type -> inventory.v1.AddAgentRequest
-	30,  // 274: inventory.v1.AgentsService.ChangeAgent:input_type -> inventory.v1.ChangeAgentRequest
-	66,  // 275: inventory.v1.AgentsService.RemoveAgent:input_type -> inventory.v1.RemoveAgentRequest
-	23,  // 276: inventory.v1.AgentsService.ListAgents:output_type -> inventory.v1.ListAgentsResponse
-	25,  // 277: inventory.v1.AgentsService.GetAgent:output_type -> inventory.v1.GetAgentResponse
-	27,  // 278: inventory.v1.AgentsService.GetAgentLogs:output_type -> inventory.v1.GetAgentLogsResponse
-	29,  // 279: inventory.v1.AgentsService.AddAgent:output_type -> inventory.v1.AddAgentResponse
-	31,  // 280: inventory.v1.AgentsService.ChangeAgent:output_type -> inventory.v1.ChangeAgentResponse
-	67,  // 281: inventory.v1.AgentsService.RemoveAgent:output_type -> inventory.v1.RemoveAgentResponse
-	276, // [276:282] is the sub-list for method output_type
-	270, // [270:276] is the sub-list for method input_type
-	270, // [270:270] is the sub-list for extension type_name
-	270, // [270:270] is the sub-list for extension extendee
-	0,   // [0:270] is the sub-list for field type_name
+	83,  // 0: inventory.v1.PMMAgent.custom_labels:type_name -> inventory.v1.PMMAgent.CustomLabelsEntry
+	134, // 1: inventory.v1.VMAgent.status:type_name -> inventory.v1.AgentStatus
+	134, // 2: inventory.v1.NomadAgent.status:type_name -> inventory.v1.AgentStatus
+	84,  // 3: inventory.v1.NodeExporter.custom_labels:type_name -> inventory.v1.NodeExporter.CustomLabelsEntry
+	134, // 4: inventory.v1.NodeExporter.status:type_name -> inventory.v1.AgentStatus
+	135, // 5: inventory.v1.NodeExporter.log_level:type_name -> inventory.v1.LogLevel
+	136, // 6: inventory.v1.NodeExporter.metrics_resolutions:type_name -> common.MetricsResolutions
+	85,  // 7: inventory.v1.MySQLdExporter.custom_labels:type_name -> inventory.v1.MySQLdExporter.CustomLabelsEntry
+	134, // 8: inventory.v1.MySQLdExporter.status:type_name -> inventory.v1.AgentStatus
+	135, // 9: inventory.v1.MySQLdExporter.log_level:type_name -> inventory.v1.LogLevel
+	136, // 10: inventory.v1.MySQLdExporter.metrics_resolutions:type_name -> common.MetricsResolutions
+	86,  // 11: inventory.v1.MySQLdExporter.extra_dsn_params:type_name -> inventory.v1.MySQLdExporter.ExtraDsnParamsEntry
+	137, // 12: inventory.v1.MySQLdExporter.connection_timeout:type_name -> google.protobuf.Duration
+	87,  // 13: inventory.v1.MongoDBExporter.custom_labels:type_name -> inventory.v1.MongoDBExporter.CustomLabelsEntry
+	134, // 14: inventory.v1.MongoDBExporter.status:type_name -> inventory.v1.AgentStatus
+	135, // 15: inventory.v1.MongoDBExporter.log_level:type_name -> inventory.v1.LogLevel
+	136, // 16: inventory.v1.MongoDBExporter.metrics_resolutions:type_name -> common.MetricsResolutions
+	137, // 17: inventory.v1.MongoDBExporter.connection_timeout:type_name -> google.protobuf.Duration
+	88,  // 18: inventory.v1.PostgresExporter.custom_labels:type_name -> inventory.v1.PostgresExporter.CustomLabelsEntry
+	134, // 19: inventory.v1.PostgresExporter.status:type_name -> inventory.v1.AgentStatus
+	135, // 20: inventory.v1.PostgresExporter.log_level:type_name -> inventory.v1.LogLevel
+	136, // 21: inventory.v1.PostgresExporter.metrics_resolutions:type_name -> common.MetricsResolutions
+	137, // 22: inventory.v1.PostgresExporter.connection_timeout:type_name -> google.protobuf.Duration
+	89,  // 23: inventory.v1.ProxySQLExporter.custom_labels:type_name -> inventory.v1.ProxySQLExporter.CustomLabelsEntry
+	134, // 24: inventory.v1.ProxySQLExporter.status:type_name -> inventory.v1.AgentStatus
+	135, // 25: inventory.v1.ProxySQLExporter.log_level:type_name -> inventory.v1.LogLevel
+	136, // 26: inventory.v1.ProxySQLExporter.metrics_resolutions:type_name -> common.MetricsResolutions
+	137, // 27: inventory.v1.ProxySQLExporter.connection_timeout:type_name -> google.protobuf.Duration
+	90,  // 28: inventory.v1.ValkeyExporter.custom_labels:type_name -> inventory.v1.ValkeyExporter.CustomLabelsEntry
+	134, // 29: inventory.v1.ValkeyExporter.status:type_name -> inventory.v1.AgentStatus
+	136, // 30: inventory.v1.ValkeyExporter.metrics_resolutions:type_name -> common.MetricsResolutions
+	137, // 31: inventory.v1.ValkeyExporter.connection_timeout:type_name -> google.protobuf.Duration
+	91,  // 32: inventory.v1.QANMySQLPerfSchemaAgent.custom_labels:type_name -> inventory.v1.QANMySQLPerfSchemaAgent.CustomLabelsEntry
+	134, // 33: inventory.v1.QANMySQLPerfSchemaAgent.status:type_name -> inventory.v1.AgentStatus
+	135, // 34: inventory.v1.QANMySQLPerfSchemaAgent.log_level:type_name -> inventory.v1.LogLevel
+	92,  // 35: inventory.v1.QANMySQLPerfSchemaAgent.extra_dsn_params:type_name -> inventory.v1.QANMySQLPerfSchemaAgent.ExtraDsnParamsEntry
+	93,  // 36: inventory.v1.QANMySQLSlowlogAgent.custom_labels:type_name -> inventory.v1.QANMySQLSlowlogAgent.CustomLabelsEntry
+	134, // 37: inventory.v1.QANMySQLSlowlogAgent.status:type_name -> inventory.v1.AgentStatus
+	135, // 38: inventory.v1.QANMySQLSlowlogAgent.log_level:type_name -> inventory.v1.LogLevel
+	94,  // 39: inventory.v1.QANMySQLSlowlogAgent.extra_dsn_params:type_name -> inventory.v1.QANMySQLSlowlogAgent.ExtraDsnParamsEntry
+	95,  // 40: inventory.v1.QANMongoDBProfilerAgent.custom_labels:type_name -> inventory.v1.QANMongoDBProfilerAgent.CustomLabelsEntry
+	134, // 41: inventory.v1.QANMongoDBProfilerAgent.status:type_name -> inventory.v1.AgentStatus
+	135, // 42: inventory.v1.QANMongoDBProfilerAgent.log_level:type_name -> inventory.v1.LogLevel
+	96,  // 43: inventory.v1.QANMongoDBMongologAgent.custom_labels:type_name -> inventory.v1.QANMongoDBMongologAgent.CustomLabelsEntry
+	134, // 44: inventory.v1.QANMongoDBMongologAgent.status:type_name -> inventory.v1.AgentStatus
+	135, // 45: inventory.v1.QANMongoDBMongologAgent.log_level:type_name -> inventory.v1.LogLevel
+	97,  // 46: inventory.v1.QANValkeySlowlogAgent.custom_labels:type_name -> inventory.v1.QANValkeySlowlogAgent.CustomLabelsEntry
+	134, // 47: inventory.v1.QANValkeySlowlogAgent.status:type_name -> inventory.v1.AgentStatus
+	135, // 48: inventory.v1.QANValkeySlowlogAgent.log_level:type_name -> inventory.v1.LogLevel
+	98,  // 49: inventory.v1.QANProxySQLDigestAgent.custom_labels:type_name -> inventory.v1.QANProxySQLDigestAgent.CustomLabelsEntry
+	134, // 50: inventory.v1.QANProxySQLDigestAgent.status:type_name -> inventory.v1.AgentStatus
+	135, // 51: inventory.v1.QANProxySQLDigestAgent.log_level:type_name -> inventory.v1.LogLevel
+	137, // 52: inventory.v1.RTAOptions.collect_interval:type_name -> google.protobuf.Duration
+	99,  // 53: inventory.v1.RTAMongoDBAgent.custom_labels:type_name -> inventory.v1.RTAMongoDBAgent.CustomLabelsEntry
+	16,  // 54: inventory.v1.RTAMongoDBAgent.rta_options:type_name -> inventory.v1.RTAOptions
+	134, // 55: inventory.v1.RTAMongoDBAgent.status:type_name -> inventory.v1.AgentStatus
+	135, // 56: inventory.v1.RTAMongoDBAgent.log_level:type_name -> inventory.v1.LogLevel
+	100, // 57: inventory.v1.RTAMySQLAgent.custom_labels:type_name -> inventory.v1.RTAMySQLAgent.CustomLabelsEntry
+	16,  // 58: inventory.v1.RTAMySQLAgent.rta_options:type_name -> inventory.v1.RTAOptions
+	134, // 59: inventory.v1.RTAMySQLAgent.status:type_name -> inventory.v1.AgentStatus
+	135, // 60: inventory.v1.RTAMySQLAgent.log_level:type_name -> inventory.v1.LogLevel
+	101, // 61: inventory.v1.RTAPostgreSQLAgent.custom_labels:type_name -> inventory.v1.RTAPostgreSQLAgent.CustomLabelsEntry
+	16,  // 62: inventory.v1.RTAPostgreSQLAgent.rta_options:type_name -> inventory.v1.RTAOptions
+	134, // 63: inventory.v1.RTAPostgreSQLAgent.status:type_name -> inventory.v1.AgentStatus
+	135, // 64: inventory.v1.RTAPostgreSQLAgent.log_level:type_name -> inventory.v1.LogLevel
+	102, // 65: inventory.v1.QANPostgreSQLPgStatementsAgent.custom_labels:type_name -> inventory.v1.QANPostgreSQLPgStatementsAgent.CustomLabelsEntry
+	134, // 66: inventory.v1.QANPostgreSQLPgStatementsAgent.status:type_name -> inventory.v1.AgentStatus
+	135, // 67: inventory.v1.QANPostgreSQLPgStatementsAgent.log_level:type_name -> inventory.v1.LogLevel
+	103, // 68: inventory.v1.QANPostgreSQLPgStatMonitorAgent.custom_labels:type_name -> inventory.v1.QANPostgreSQLPgStatMonitorAgent.CustomLabelsEntry
+	134, // 69: inventory.v1.QANPostgreSQLPgStatMonitorAgent.status:type_name -> inventory.v1.AgentStatus
+	135, // 70: inventory.v1.QANPostgreSQLPgStatMonitorAgent.log_level:type_name -> inventory.v1.LogLevel
+	104, // 71: inventory.v1.QANPostgreSQLLogAgent.custom_labels:type_name -> inventory.v1.QANPostgreSQLLogAgent.CustomLabelsEntry
+	134, // 72: inventory.v1.QANPostgreSQLLogAgent.status:type_name -> inventory.v1.AgentStatus
+	135, // 73: inventory.v1.QANPostgreSQLLogAgent.log_level:type_name -> inventory.v1.LogLevel
+	105, // 74: inventory.v1.RDSExporter.custom_labels:type_name -> inventory.v1.RDSExporter.CustomLabelsEntry
+	134, // 75: inventory.v1.RDSExporter.status:type_name -> inventory.v1.AgentStatus
+	135, // 76: inventory.v1.RDSExporter.log_level:type_name -> inventory.v1.LogLevel
+	136, // 77: inventory.v1.RDSExporter.metrics_resolutions:type_name -> common.MetricsResolutions
+	106, // 78: inventory.v1.ExternalExporter.custom_labels:type_name -> inventory.v1.ExternalExporter.CustomLabelsEntry
+	136, // 79: inventory.v1.ExternalExporter.metrics_resolutions:type_name -> common.MetricsResolutions
+	134, // 80: inventory.v1.ExternalExporter.status:type_name -> inventory.v1.AgentStatus
+	107, // 81: inventory.v1.AzureDatabaseExporter.custom_labels:type_name -> inventory.v1.AzureDatabaseExporter.CustomLabelsEntry
+	134, // 82: inventory.v1.AzureDatabaseExporter.status:type_name -> inventory.v1.AgentStatus
+	135, // 83: inventory.v1.AzureDatabaseExporter.log_level:type_name -> inventory.v1.LogLevel
+	136, // 84: inventory.v1.AzureDatabaseExporter.metrics_resolutions:type_name -> common.MetricsResolutions
+	138, // 85: inventory.v1.ChangeCommonAgentParams.custom_labels:type_name -> common.StringMap
+	136, // 86: inventory.v1.ChangeCommonAgentParams.metrics_resolutions:type_name -> common.MetricsResolutions
+	0,   // 87: inventory.v1.ListAgentsRequest.agent_type:type_name -> inventory.v1.AgentType
+	1,   // 88: inventory.v1.ListAgentsResponse.pmm_agent:type_name -> inventory.v1.PMMAgent
+	2,   // 89: inventory.v1.ListAgentsResponse.vm_agent:type_name -> inventory.v1.VMAgent
+	4,   // 90: inventory.v1.ListAgentsResponse.node_exporter:type_name -> inventory.v1.NodeExporter
+	5,   // 91: inventory.v1.ListAgentsResponse.mysqld_exporter:type_name -> inventory.v1.MySQLdExporter
+	6,   // 92: inventory.v1.ListAgentsResponse.mongodb_exporter:type_name -> inventory.v1.MongoDBExporter
+	7,   // 93: inventory.v1.ListAgentsResponse.postgres_exporter:type_name -> inventory.v1.PostgresExporter
+	8,   // 94: inventory.v1.ListAgentsResponse.proxysql_exporter:type_name -> inventory.v1.ProxySQLExporter
+	10,  // 95: inventory.v1.ListAgentsResponse.qan_mysql_perfschema_agent:type_name -> inventory.v1.QANMySQLPerfSchemaAgent
+	11,  // 96: inventory.v1.ListAgentsResponse.qan_mysql_slowlog_agent:type_name -> inventory.v1.QANMySQLSlowlogAgent
+	12,  // 97: inventory.v1.ListAgentsResponse.qan_mongodb_profiler_agent:type_name -> inventory.v1.QANMongoDBProfilerAgent
+	13,  // 98: inventory.v1.ListAgentsResponse.qan_mongodb_mongolog_agent:type_name -> inventory.v1.QANMongoDBMongologAgent
+	20,  // 99: inventory.v1.ListAgentsResponse.qan_postgresql_pgstatements_agent:type_name -> inventory.v1.QANPostgreSQLPgStatementsAgent
+	21,  // 100: inventory.v1.ListAgentsResponse.qan_postgresql_pgstatmonitor_agent:type_name -> inventory.v1.QANPostgreSQLPgStatMonitorAgent
+	24,  // 101: inventory.v1.ListAgentsResponse.external_exporter:type_name -> inventory.v1.ExternalExporter
+	23,  // 102: inventory.v1.ListAgentsResponse.rds_exporter:type_name -> inventory.v1.RDSExporter
+	25,  // 103: inventory.v1.ListAgentsResponse.azure_database_exporter:type_name -> inventory.v1.AzureDatabaseExporter
+	3,   // 104: inventory.v1.ListAgentsResponse.nomad_agent:type_name -> inventory.v1.NomadAgent
+	9,   // 105: inventory.v1.ListAgentsResponse.valkey_exporter:type_name -> inventory.v1.ValkeyExporter
+	17,  // 106: inventory.v1.ListAgentsResponse.rta_mongodb_agent:type_name -> inventory.v1.RTAMongoDBAgent
+	18,  // 107: inventory.v1.ListAgentsResponse.rta_mysql_agent:type_name -> inventory.v1.RTAMySQLAgent
+	19,  // 108: inventory.v1.ListAgentsResponse.rta_postgresql_agent:type_name -> inventory.v1.RTAPostgreSQLAgent
+	14,  // 109: inventory.v1.ListAgentsResponse.qan_valkey_slowlog_agent:type_name -> inventory.v1.QANValkeySlowlogAgent
+	15,  // 110: inventory.v1.ListAgentsResponse.qan_proxysql_digest_agent:type_name -> inventory.v1.QANProxySQLDigestAgent
+	22,  // 111: inventory.v1.ListAgentsResponse.qan_postgresql_log_agent:type_name -> inventory.v1.QANPostgreSQLLogAgent
+	1,   // 112: inventory.v1.GetAgentResponse.pmm_agent:type_name -> inventory.v1.PMMAgent
+	2,   // 113: inventory.v1.GetAgentResponse.vmagent:type_name -> inventory.v1.VMAgent
+	4,   // 114: inventory.v1.GetAgentResponse.node_exporter:type_name -> inventory.v1.NodeExporter
+	5,   // 115: inventory.v1.GetAgentResponse.mysqld_exporter:type_name -> inventory.v1.MySQLdExporter
+	6,   // 116: inventory.v1.GetAgentResponse.mongodb_exporter:type_name -> inventory.v1.MongoDBExporter
+	7,   // 117: inventory.v1.GetAgentResponse.postgres_exporter:type_name -> inventory.v1.PostgresExporter
+	8,   // 118: inventory.v1.GetAgentResponse.proxysql_exporter:type_name -> inventory.v1.ProxySQLExporter
+	10,  // 119: inventory.v1.GetAgentResponse.qan_mysql_perfschema_agent:type_name -> inventory.v1.QANMySQLPerfSchemaAgent
+	11,  // 120: inventory.v1.GetAgentResponse.qan_mysql_slowlog_agent:type_name -> inventory.v1.QANMySQLSlowlogAgent
+	12,  // 121: inventory.v1.GetAgentResponse.qan_mongodb_profiler_agent:type_name -> inventory.v1.QANMongoDBProfilerAgent
+	13,  // 122: inventory.v1.GetAgentResponse.qan_mongodb_mongolog_agent:type_name -> inventory.v1.QANMongoDBMongologAgent
+	20,  // 123: inventory.v1.GetAgentResponse.qan_postgresql_pgstatements_agent:type_name -> inventory.v1.QANPostgreSQLPgStatementsAgent
+	21,  // 124: inventory.v1.GetAgentResponse.qan_postgresql_pgstatmonitor_agent:type_name -> inventory.v1.QANPostgreSQLPgStatMonitorAgent
+	24,  // 125: inventory.v1.GetAgentResponse.external_exporter:type_name -> inventory.v1.ExternalExporter
+	23,  // 126: inventory.v1.GetAgentResponse.rds_exporter:type_name -> inventory.v1.RDSExporter
+	25,  // 127: inventory.v1.GetAgentResponse.azure_database_exporter:type_name -> inventory.v1.AzureDatabaseExporter
+	3,   // 128: inventory.v1.GetAgentResponse.nomad_agent:type_name -> inventory.v1.NomadAgent
+	9,   // 129: inventory.v1.GetAgentResponse.valkey_exporter:type_name -> inventory.v1.ValkeyExporter
+	17,  // 130: inventory.v1.GetAgentResponse.rta_mongodb_agent:type_name -> inventory.v1.RTAMongoDBAgent
+	18,  // 131: inventory.v1.GetAgentResponse.rta_mysql_agent:type_name -> inventory.v1.RTAMySQLAgent
+	19,  // 132: inventory.v1.GetAgentResponse.rta_postgresql_agent:type_name -> inventory.v1.RTAPostgreSQLAgent
+	14,  // 133: inventory.v1.GetAgentResponse.qan_valkey_slowlog_agent:type_name -> inventory.v1.QANValkeySlowlogAgent
+	15,  // 134: inventory.v1.GetAgentResponse.qan_proxysql_digest_agent:type_name -> inventory.v1.QANProxySQLDigestAgent
+	22,  // 135: inventory.v1.GetAgentResponse.qan_postgresql_log_agent:type_name -> inventory.v1.QANPostgreSQLLogAgent
+	37,  // 136: inventory.v1.AddAgentRequest.pmm_agent:type_name -> inventory.v1.AddPMMAgentParams
+	38,  // 137: inventory.v1.AddAgentRequest.node_exporter:type_name -> inventory.v1.AddNodeExporterParams
+	40,  // 138: inventory.v1.AddAgentRequest.mysqld_exporter:type_name -> inventory.v1.AddMySQLdExporterParams
+	42,  // 139: inventory.v1.AddAgentRequest.mongodb_exporter:type_name -> inventory.v1.AddMongoDBExporterParams
+	44,  // 140: inventory.v1.AddAgentRequest.postgres_exporter:type_name -> inventory.v1.AddPostgresExporterParams
+	46,  // 141: inventory.v1.AddAgentRequest.proxysql_exporter:type_name -> inventory.v1.AddProxySQLExporterParams
+	68,  // 142: inventory.v1.AddAgentRequest.external_exporter:type_name -> inventory.v1.AddExternalExporterParams
+	66,  // 143: inventory.v1.AddAgentRequest.rds_exporter:type_name -> inventory.v1.AddRDSExporterParams
+	70,  // 144: inventory.v1.AddAgentRequest.azure_database_exporter:type_name -> inventory.v1.AddAzureDatabaseExporterParams
+	48,  // 145: inventory.v1.AddAgentRequest.qan_mysql_perfschema_agent:type_name -> inventory.v1.AddQANMySQLPerfSchemaAgentParams
+	50,  // 146: inventory.v1.AddAgentRequest.qan_mysql_slowlog_agent:type_name -> inventory.v1.AddQANMySQLSlowlogAgentParams
+	52,  // 147: inventory.v1.AddAgentRequest.qan_mongodb_profiler_agent:type_name -> inventory.v1.AddQANMongoDBProfilerAgentParams
+	54,  // 148: inventory.v1.AddAgentRequest.qan_mongodb_mongolog_agent:type_name -> inventory.v1.AddQANMongoDBMongologAgentParams
+	60,  // 149: inventory.v1.AddAgentRequest.qan_postgresql_pgstatements_agent:type_name -> inventory.v1.AddQANPostgreSQLPgStatementsAgentParams
+	62,  // 150: inventory.v1.AddAgentRequest.qan_postgresql_pgstatmonitor_agent:type_name -> inventory.v1.AddQANPostgreSQLPgStatMonitorAgentParams
+	73,  // 151: inventory.v1.AddAgentRequest.valkey_exporter:type_name -> inventory.v1.AddValkeyExporterParams
+	75,  // 152: inventory.v1.AddAgentRequest.rta_mongodb_agent:type_name -> inventory.v1.AddRTAMongoDBAgentParams
+	77,  // 153: inventory.v1.AddAgentRequest.rta_mysql_agent:type_name -> inventory.v1.AddRTAMySQLAgentParams
+	79,  // 154: inventory.v1.AddAgentRequest.rta_postgresql_agent:type_name -> inventory.v1.AddRTAPostgreSQLAgentParams
+	56,  // 155: inventory.v1.AddAgentRequest.qan_valkey_slowlog_agent:type_name -> inventory.v1.AddQANValkeySlowlogAgentParams
+	58,  // 156: inventory.v1.AddAgentRequest.qan_proxysql_digest_agent:type_name -> inventory.v1.AddQANProxySQLDigestAgentParams
+	64,  // 157: inventory.v1.AddAgentRequest.qan_postgresql_log_agent:type_name -> inventory.v1.AddQANPostgreSQLLogAgentParams
+	1,   // 158: inventory.v1.AddAgentResponse.pmm_agent:type_name -> inventory.v1.PMMAgent
+	4,   // 159: inventory.v1.AddAgentResponse.node_exporter:type_name -> inventory.v1.NodeExporter
+	5,   // 160: inventory.v1.AddAgentResponse.mysqld_exporter:type_name -> inventory.v1.MySQLdExporter
+	6,   // 161: inventory.v1.AddAgentResponse.mongodb_exporter:type_name -> inventory.v1.MongoDBExporter
+	7,   // 162: inventory.v1.AddAgentResponse.postgres_exporter:type_name -> inventory.v1.PostgresExporter
+	8,   // 163: inventory.v1.AddAgentResponse.proxysql_exporter:type_name -> inventory.v1.ProxySQLExporter
+	24,  // 164: inventory.v1.AddAgentResponse.external_exporter:type_name -> inventory.v1.ExternalExporter
+	23,  // 165: inventory.v1.AddAgentResponse.rds_exporter:type_name -> inventory.v1.RDSExporter
+	25,  // 166: inventory.v1.AddAgentResponse.azure_database_exporter:type_name -> inventory.v1.AzureDatabaseExporter
+	10,  // 167: inventory.v1.AddAgentResponse.qan_mysql_perfschema_agent:type_name -> inventory.v1.QANMySQLPerfSchemaAgent
+	11,  // 168: inventory.v1.AddAgentResponse.qan_mysql_slowlog_agent:type_name -> inventory.v1.QANMySQLSlowlogAgent
+	12,  // 169: inventory.v1.AddAgentResponse.qan_mongodb_profiler_agent:type_name -> inventory.v1.QANMongoDBProfilerAgent
+	13,  // 170: inventory.v1.AddAgentResponse.qan_mongodb_mongolog_agent:type_name -> inventory.v1.QANMongoDBMongologAgent
+	20,  // 171: inventory.v1.AddAgentResponse.qan_postgresql_pgstatements_agent:type_name -> inventory.v1.QANPostgreSQLPgStatementsAgent
+	21,  // 172: inventory.v1.AddAgentResponse.qan_postgresql_pgstatmonitor_agent:type_name -> inventory.v1.QANPostgreSQLPgStatMonitorAgent
+	9,   // 173: inventory.v1.AddAgentResponse.valkey_exporter:type_name -> inventory.v1.ValkeyExporter
+	17,  // 174: inventory.v1.AddAgentResponse.rta_mongodb_agent:type_name -> inventory.v1.RTAMongoDBAgent
+	18,  // 175: inventory.v1.AddAgentResponse.rta_mysql_agent:type_name -> inventory.v1.RTAMySQLAgent
+	19,  // 176: inventory.v1.AddAgentResponse.rta_postgresql_agent:type_name -> inventory.v1.RTAPostgreSQLAgent
+	14,  // 177: inventory.v1.AddAgentResponse.qan_valkey_slowlog_agent:type_name -> inventory.v1.QANValkeySlowlogAgent
+	15,  // 178: inventory.v1.AddAgentResponse.qan_proxysql_digest_agent:type_name -> inventory.v1.QANProxySQLDigestAgent
+	22,  // 179: inventory.v1.AddAgentResponse.qan_postgresql_log_agent:type_name -> inventory.v1.QANPostgreSQLLogAgent
+	39,  // 180: inventory.v1.ChangeAgentRequest.node_exporter:type_name -> inventory.v1.ChangeNodeExporterParams
+	41,  // 181: inventory.v1.ChangeAgentRequest.mysqld_exporter:type_name -> inventory.v1.ChangeMySQLdExporterParams
+	43,  // 182: inventory.v1.ChangeAgentRequest.mongodb_exporter:type_name -> inventory.v1.ChangeMongoDBExporterParams
+	45,  // 183: inventory.v1.ChangeAgentRequest.postgres_exporter:type_name -> inventory.v1.ChangePostgresExporterParams
+	47,  // 184: inventory.v1.ChangeAgentRequest.proxysql_exporter:type_name -> inventory.v1.ChangeProxySQLExporterParams
+	69,  // 185: inventory.v1.ChangeAgentRequest.external_exporter:type_name -> inventory.v1.ChangeExternalExporterParams
+	67,  // 186: inventory.v1.ChangeAgentRequest.rds_exporter:type_name -> inventory.v1.ChangeRDSExporterParams
+	71,  // 187: inventory.v1.ChangeAgentRequest.azure_database_exporter:type_name -> inventory.v1.ChangeAzureDatabaseExporterParams
+	49,  // 188: inventory.v1.ChangeAgentRequest.qan_mysql_perfschema_agent:type_name -> inventory.v1.ChangeQANMySQLPerfSchemaAgentParams
+	51,  // 189: inventory.v1.ChangeAgentRequest.qan_mysql_slowlog_agent:type_name -> inventory.v1.ChangeQANMySQLSlowlogAgentParams
+	53,  // 190: inventory.v1.ChangeAgentRequest.qan_mongodb_profiler_agent:type_name -> inventory.v1.ChangeQANMongoDBProfilerAgentParams
+	55,  // 191: inventory.v1.ChangeAgentRequest.qan_mongodb_mongolog_agent:type_name -> inventory.v1.ChangeQANMongoDBMongologAgentParams
+	61,  // 192: inventory.v1.ChangeAgentRequest.qan_postgresql_pgstatements_agent:type_name -> inventory.v1.ChangeQANPostgreSQLPgStatementsAgentParams
+	63,  // 193: inventory.v1.ChangeAgentRequest.qan_postgresql_pgstatmonitor_agent:type_name -> inventory.v1.ChangeQANPostgreSQLPgStatMonitorAgentParams
+	72,  // 194: inventory.v1.ChangeAgentRequest.nomad_agent:type_name -> inventory.v1.ChangeNomadAgentParams
+	74,  // 195: inventory.v1.ChangeAgentRequest.valkey_exporter:type_name -> inventory.v1.ChangeValkeyExporterParams
+	76,  // 196: inventory.v1.ChangeAgentRequest.rta_mongodb_agent:type_name -> inventory.v1.ChangeRTAMongoDBAgentParams
+	78,  // 197: inventory.v1.ChangeAgentRequest.rta_mysql_agent:type_name -> inventory.v1.ChangeRTAMySQLAgentParams
+	80,  // 198: inventory.v1.ChangeAgentRequest.rta_postgresql_agent:type_name -> inventory.v1.ChangeRTAPostgreSQLAgentParams
+	57,  // 199: inventory.v1.ChangeAgentRequest.qan_valkey_slowlog_agent:type_name -> inventory.v1.ChangeQANValkeySlowlogAgentParams
+	59,  // 200: inventory.v1.ChangeAgentRequest.qan_proxysql_digest_agent:type_name -> inventory.v1.ChangeQANProxySQLDigestAgentParams
+	65,  // 201: inventory.v1.ChangeAgentRequest.qan_postgresql_log_agent:type_name -> inventory.v1.ChangeQANPostgreSQLLogAgentParams
+	4,   // 202: inventory.v1.ChangeAgentResponse.node_exporter:type_name -> inventory.v1.NodeExporter
+	5,   // 203: inventory.v1.ChangeAgentResponse.mysqld_exporter:type_name -> inventory.v1.MySQLdExporter
+	6,   // 204: inventory.v1.ChangeAgentResponse.mongodb_exporter:type_name -> inventory.v1.MongoDBExporter
+	7,   // 205: inventory.v1.ChangeAgentResponse.postgres_exporter:type_name -> inventory.v1.PostgresExporter
+	8,   // 206: inventory.v1.ChangeAgentResponse.proxysql_exporter:type_name -> inventory.v1.ProxySQLExporter
+	24,  // 207: inventory.v1.ChangeAgentResponse.external_exporter:type_name -> inventory.v1.ExternalExporter
+	23,  // 208: inventory.v1.ChangeAgentResponse.rds_exporter:type_name -> inventory.v1.RDSExporter
+	25,  // 209: inventory.v1.ChangeAgentResponse.azure_database_exporter:type_name -> inventory.v1.AzureDatabaseExporter
+	10,  // 210: inventory.v1.ChangeAgentResponse.qan_mysql_perfschema_agent:type_name -> inventory.v1.QANMySQLPerfSchemaAgent
+	11,  // 211: inventory.v1.ChangeAgentResponse.qan_mysql_slowlog_agent:type_name -> inventory.v1.QANMySQLSlowlogAgent
+	12,  // 212: inventory.v1.ChangeAgentResponse.qan_mongodb_profiler_agent:type_name -> inventory.v1.QANMongoDBProfilerAgent
+	13,  // 213: inventory.v1.ChangeAgentResponse.qan_mongodb_mongolog_agent:type_name -> inventory.v1.QANMongoDBMongologAgent
+	20,  // 214: inventory.v1.ChangeAgentResponse.qan_postgresql_pgstatements_agent:type_name -> inventory.v1.QANPostgreSQLPgStatementsAgent
+	21,  // 215: inventory.v1.ChangeAgentResponse.qan_postgresql_pgstatmonitor_agent:type_name -> inventory.v1.QANPostgreSQLPgStatMonitorAgent
+	3,   // 216: inventory.v1.ChangeAgentResponse.nomad_agent:type_name -> inventory.v1.NomadAgent
+	9,   // 217: inventory.v1.ChangeAgentResponse.valkey_exporter:type_name -> inventory.v1.ValkeyExporter
+	17,  // 218: inventory.v1.ChangeAgentResponse.rta_mongodb_agent:type_name -> inventory.v1.RTAMongoDBAgent
+	18,  // 219: inventory.v1.ChangeAgentResponse.rta_mysql_agent:type_name -> inventory.v1.RTAMySQLAgent
+	19,  // 220: inventory.v1.ChangeAgentResponse.rta_postgresql_agent:type_name -> inventory.v1.RTAPostgreSQLAgent
+	14,  // 221: inventory.v1.ChangeAgentResponse.qan_valkey_slowlog_agent:type_name -> inventory.v1.QANValkeySlowlogAgent
+	15,  // 222: inventory.v1.ChangeAgentResponse.qan_proxysql_digest_agent:type_name -> inventory.v1.QANProxySQLDigestAgent
+	22,  // 223: inventory.v1.ChangeAgentResponse.qan_postgresql_log_agent:type_name -> inventory.v1.QANPostgreSQLLogAgent
+	108, // 224: inventory.v1.AddPMMAgentParams.custom_labels:type_name -> inventory.v1.AddPMMAgentParams.CustomLabelsEntry
+	109, // 225: inventory.v1.AddNodeExporterParams.custom_labels:type_name -> inventory.v1.AddNodeExporterParams.CustomLabelsEntry
+	135, // 226: inventory.v1.AddNodeExporterParams.log_level:type_name -> inventory.v1.LogLevel
+	138, // 227: inventory.v1.ChangeNodeExporterParams.custom_labels:type_name -> common.StringMap
+	136, // 228: inventory.v1.ChangeNodeExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
+	135, // 229: inventory.v1.ChangeNodeExporterParams.log_level:type_name -> inventory.v1.LogLevel
+	110, // 230: inventory.v1.AddMySQLdExporterParams.custom_labels:type_name -> inventory.v1.AddMySQLdExporterParams.CustomLabelsEntry
+	135, // 231: inventory.v1.AddMySQLdExporterParams.log_level:type_name -> inventory.v1.LogLevel
+	111, // 232: inventory.v1.AddMySQLdExporterParams.extra_dsn_params:type_name -> inventory.v1.AddMySQLdExporterParams.ExtraDsnParamsEntry
+	137, // 233: inventory.v1.AddMySQLdExporterParams.connection_timeout:type_name -> google.protobuf.Duration
+	138, // 234: inventory.v1.ChangeMySQLdExporterParams.custom_labels:type_name -> common.StringMap
+	136, // 235: inventory.v1.ChangeMySQLdExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
+	135, // 236: inventory.v1.ChangeMySQLdExporterParams.log_level:type_name -> inventory.v1.LogLevel
+	137, // 237: inventory.v1.ChangeMySQLdExporterParams.connection_timeout:type_name -> google.protobuf.Duration
+	112, // 238: inventory.v1.AddMongoDBExporterParams.custom_labels:type_name -> inventory.v1.AddMongoDBExporterParams.CustomLabelsEntry
+	135, // 239: inventory.v1.AddMongoDBExporterParams.log_level:type_name -> inventory.v1.LogLevel
+	137, // 240: inventory.v1.AddMongoDBExporterParams.connection_timeout:type_name -> google.protobuf.Duration
+	138, // 241: inventory.v1.ChangeMongoDBExporterParams.custom_labels:type_name -> common.StringMap
+	136, // 242: inventory.v1.ChangeMongoDBExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
+	135, // 243: inventory.v1.ChangeMongoDBExporterParams.log_level:type_name -> inventory.v1.LogLevel
+	137, // 244: inventory.v1.ChangeMongoDBExporterParams.connection_timeout:type_name -> google.protobuf.Duration
+	113, // 245: inventory.v1.AddPostgresExporterParams.custom_labels:type_name -> inventory.v1.AddPostgresExporterParams.CustomLabelsEntry
+	135, // 246: inventory.v1.AddPostgresExporterParams.log_level:type_name -> inventory.v1.LogLevel
+	137, // 247: inventory.v1.AddPostgresExporterParams.connection_timeout:type_name -> google.protobuf.Duration
+	138, // 248: inventory.v1.ChangePostgresExporterParams.custom_labels:type_name -> common.StringMap
+	136, // 249: inventory.v1.ChangePostgresExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
+	135, // 250: inventory.v1.ChangePostgresExporterParams.log_level:type_name -> inventory.v1.LogLevel
+	137, // 251: inventory.v1.ChangePostgresExporterParams.connection_timeout:type_name -> google.protobuf.Duration
+	114, // 252: inventory.v1.AddProxySQLExporterParams.custom_labels:type_name -> inventory.v1.AddProxySQLExporterParams.CustomLabelsEntry
+	135, // 253: inventory.v1.AddProxySQLExporterParams.log_level:type_name -> inventory.v1.LogLevel
+	137, // 254: inventory.v1.AddProxySQLExporterParams.connection_timeout:type_name -> google.protobuf.Duration
+	138, // 255: inventory.v1.ChangeProxySQLExporterParams.custom_labels:type_name -> common.StringMap
+	136, // 256: inventory.v1.ChangeProxySQLExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
+	135, // 257: inventory.v1.ChangeProxySQLExporterParams.log_level:type_name -> inventory.v1.LogLevel
+	137, // 258: inventory.v1.ChangeProxySQLExporterParams.connection_timeout:type_name -> google.protobuf.Duration
+	115, // 259: inventory.v1.AddQANMySQLPerfSchemaAgentParams.custom_labels:type_name -> inventory.v1.AddQANMySQLPerfSchemaAgentParams.CustomLabelsEntry
+	135, // 260: inventory.v1.AddQANMySQLPerfSchemaAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	116, // 261: inventory.v1.AddQANMySQLPerfSchemaAgentParams.extra_dsn_params:type_name -> inventory.v1.AddQANMySQLPerfSchemaAgentParams.ExtraDsnParamsEntry
+	138, // 262: inventory.v1.ChangeQANMySQLPerfSchemaAgentParams.custom_labels:type_name -> common.StringMap
+	136, // 263: inventory.v1.ChangeQANMySQLPerfSchemaAgentParams.metrics_resolutions:type_name -> common.MetricsResolutions
+	135, // 264: inventory.v1.ChangeQANMySQLPerfSchemaAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	117, // 265: inventory.v1.AddQANMySQLSlowlogAgentParams.custom_labels:type_name -> inventory.v1.AddQANMySQLSlowlogAgentParams.CustomLabelsEntry
+	135, // 266: inventory.v1.AddQANMySQLSlowlogAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	118, // 267: inventory.v1.AddQANMySQLSlowlogAgentParams.extra_dsn_params:type_name -> inventory.v1.AddQANMySQLSlowlogAgentParams.ExtraDsnParamsEntry
+	138, // 268: inventory.v1.ChangeQANMySQLSlowlogAgentParams.custom_labels:type_name -> common.StringMap
+	136, // 269: inventory.v1.ChangeQANMySQLSlowlogAgentParams.metrics_resolutions:type_name -> common.MetricsResolutions
+	135, // 270: inventory.v1.ChangeQANMySQLSlowlogAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	119, // 271: inventory.v1.AddQANMongoDBProfilerAgentParams.custom_labels:type_name -> inventory.v1.AddQANMongoDBProfilerAgentParams.CustomLabelsEntry
+	135, // 272: inventory.v1.AddQANMongoDBProfilerAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	138, // 273: inventory.v1.ChangeQANMongoDBProfilerAgentParams.custom_labels:type_name -> common.StringMap
+	136, // 274: inventory.v1.ChangeQANMongoDBProfilerAgentParams.metrics_resolutions:type_name -> common.MetricsResolutions
+	135, // 275: inventory.v1.ChangeQANMongoDBProfilerAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	120, // 276: inventory.v1.AddQANMongoDBMongologAgentParams.custom_labels:type_name -> inventory.v1.AddQANMongoDBMongologAgentParams.CustomLabelsEntry
+	135, // 277: inventory.v1.AddQANMongoDBMongologAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	138, // 278: inventory.v1.ChangeQANMongoDBMongologAgentParams.custom_labels:type_name -> common.StringMap
+	136, // 279: inventory.v1.ChangeQANMongoDBMongologAgentParams.metrics_resolutions:type_name -> common.MetricsResolutions
+	135, // 280: inventory.v1.ChangeQANMongoDBMongologAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	121, // 281: inventory.v1.AddQANValkeySlowlogAgentParams.custom_labels:type_name -> inventory.v1.AddQANValkeySlowlogAgentParams.CustomLabelsEntry
+	135, // 282: inventory.v1.AddQANValkeySlowlogAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	138, // 283: inventory.v1.ChangeQANValkeySlowlogAgentParams.custom_labels:type_name -> common.StringMap
+	135, // 284: inventory.v1.ChangeQANValkeySlowlogAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	122, // 285: inventory.v1.AddQANProxySQLDigestAgentParams.custom_labels:type_name -> inventory.v1.AddQANProxySQLDigestAgentParams.CustomLabelsEntry
+	135, // 286: inventory.v1.AddQANProxySQLDigestAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	138, // 287: inventory.v1.ChangeQANProxySQLDigestAgentParams.custom_labels:type_name -> common.StringMap
+	135, // 288: inventory.v1.ChangeQANProxySQLDigestAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	123, // 289: inventory.v1.AddQANPostgreSQLPgStatementsAgentParams.custom_labels:type_name -> inventory.v1.AddQANPostgreSQLPgStatementsAgentParams.CustomLabelsEntry
+	135, // 290: inventory.v1.AddQANPostgreSQLPgStatementsAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	138, // 291: inventory.v1.ChangeQANPostgreSQLPgStatementsAgentParams.custom_labels:type_name -> common.StringMap
+	136, // 292: inventory.v1.ChangeQANPostgreSQLPgStatementsAgentParams.metrics_resolutions:type_name -> common.MetricsResolutions
+	135, // 293: inventory.v1.ChangeQANPostgreSQLPgStatementsAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	124, // 294: inventory.v1.AddQANPostgreSQLPgStatMonitorAgentParams.custom_labels:type_name -> inventory.v1.AddQANPostgreSQLPgStatMonitorAgentParams.CustomLabelsEntry
+	135, // 295: inventory.v1.AddQANPostgreSQLPgStatMonitorAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	138, // 296: inventory.v1.ChangeQANPostgreSQLPgStatMonitorAgentParams.custom_labels:type_name -> common.StringMap
+	136, // 297: inventory.v1.ChangeQANPostgreSQLPgStatMonitorAgentParams.metrics_resolutions:type_name -> common.MetricsResolutions
+	135, // 298: inventory.v1.ChangeQANPostgreSQLPgStatMonitorAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	125, // 299: inventory.v1.AddQANPostgreSQLLogAgentParams.custom_labels:type_name -> inventory.v1.AddQANPostgreSQLLogAgentParams.CustomLabelsEntry
+	135, // 300: inventory.v1.AddQANPostgreSQLLogAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	138, // 301: inventory.v1.ChangeQANPostgreSQLLogAgentParams.custom_labels:type_name -> common.StringMap
+	135, // 302: inventory.v1.ChangeQANPostgreSQLLogAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	126, // 303: inventory.v1.AddRDSExporterParams.custom_labels:type_name -> inventory.v1.AddRDSExporterParams.CustomLabelsEntry
+	135, // 304: inventory.v1.AddRDSExporterParams.log_level:type_name -> inventory.v1.LogLevel
+	138, // 305: inventory.v1.ChangeRDSExporterParams.custom_labels:type_name -> common.StringMap
+	136, // 306: inventory.v1.ChangeRDSExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
+	135, // 307: inventory.v1.ChangeRDSExporterParams.log_level:type_name -> inventory.v1.LogLevel
+	127, // 308: inventory.v1.AddExternalExporterParams.custom_labels:type_name -> inventory.v1.AddExternalExporterParams.CustomLabelsEntry
+	138, // 309: inventory.v1.ChangeExternalExporterParams.custom_labels:type_name -> common.StringMap
+	136, // 310: inventory.v1.ChangeExternalExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
+	128, // 311: inventory.v1.AddAzureDatabaseExporterParams.custom_labels:type_name -> inventory.v1.AddAzureDatabaseExporterParams.CustomLabelsEntry
+	135, // 312: inventory.v1.AddAzureDatabaseExporterParams.log_level:type_name -> inventory.v1.LogLevel
+	138, // 313: inventory.v1.ChangeAzureDatabaseExporterParams.custom_labels:type_name -> common.StringMap
+	136, // 314: inventory.v1.ChangeAzureDatabaseExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
+	135, // 315: inventory.v1.ChangeAzureDatabaseExporterParams.log_level:type_name -> inventory.v1.LogLevel
+	129, // 316: inventory.v1.AddValkeyExporterParams.custom_labels:type_name -> inventory.v1.AddValkeyExporterParams.CustomLabelsEntry
+	135, // 317: inventory.v1.AddValkeyExporterParams.log_level:type_name -> inventory.v1.LogLevel
+	137, // 318: inventory.v1.AddValkeyExporterParams.connection_timeout:type_name -> google.protobuf.Duration
+	138, // 319: inventory.v1.ChangeValkeyExporterParams.custom_labels:type_name -> common.StringMap
+	136, // 320: inventory.v1.ChangeValkeyExporterParams.metrics_resolutions:type_name -> common.MetricsResolutions
+	135, // 321: inventory.v1.ChangeValkeyExporterParams.log_level:type_name -> inventory.v1.LogLevel
+	137, // 322: inventory.v1.ChangeValkeyExporterParams.connection_timeout:type_name -> google.protobuf.Duration
+	130, // 323: inventory.v1.AddRTAMongoDBAgentParams.custom_labels:type_name -> inventory.v1.AddRTAMongoDBAgentParams.CustomLabelsEntry
+	135, // 324: inventory.v1.AddRTAMongoDBAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	16,  // 325: inventory.v1.AddRTAMongoDBAgentParams.rta_options:type_name -> inventory.v1.RTAOptions
+	138, // 326: inventory.v1.ChangeRTAMongoDBAgentParams.custom_labels:type_name -> common.StringMap
+	135, // 327: inventory.v1.ChangeRTAMongoDBAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	16,  // 328: inventory.v1.ChangeRTAMongoDBAgentParams.rta_options:type_name -> inventory.v1.RTAOptions
+	131, // 329: inventory.v1.AddRTAMySQLAgentParams.custom_labels:type_name -> inventory.v1.AddRTAMySQLAgentParams.CustomLabelsEntry
+	135, // 330: inventory.v1.AddRTAMySQLAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	132, // 331: inventory.v1.AddRTAMySQLAgentParams.extra_dsn_params:type_name -> inventory.v1.AddRTAMySQLAgentParams.ExtraDsnParamsEntry
+	16,  // 332: inventory.v1.AddRTAMySQLAgentParams.rta_options:type_name -> inventory.v1.RTAOptions
+	138, // 333: inventory.v1.ChangeRTAMySQLAgentParams.custom_labels:type_name -> common.StringMap
+	135, // 334: inventory.v1.ChangeRTAMySQLAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	16,  // 335: inventory.v1.ChangeRTAMySQLAgentParams.rta_options:type_name -> inventory.v1.RTAOptions
+	133, // 336: inventory.v1.AddRTAPostgreSQLAgentParams.custom_labels:type_name -> inventory.v1.AddRTAPostgreSQLAgentParams.CustomLabelsEntry
+	135, // 337: inventory.v1.AddRTAPostgreSQLAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	16,  // 338: inventory.v1.AddRTAPostgreSQLAgentParams.rta_options:type_name -> inventory.v1.RTAOptions
+	138, // 339: inventory.v1.ChangeRTAPostgreSQLAgentParams.custom_labels:type_name -> common.StringMap
+	135, // 340: inventory.v1.ChangeRTAPostgreSQLAgentParams.log_level:type_name -> inventory.v1.LogLevel
+	16,  // 341: inventory.v1.ChangeRTAPostgreSQLAgentParams.rta_options:type_name -> inventory.v1.RTAOptions
+	27,  // 342: inventory.v1.AgentsService.ListAgents:input_type -> inventory.v1.ListAgentsRequest
+	29,  // 343: inventory.v1.AgentsService.GetAgent:input_type -> inventory.v1.GetAgentRequest
+	31,  // 344: inventory.v1.AgentsService.GetAgentLogs:input_type -> inventory.v1.GetAgentLogsRequest
+	33,  // 345: inventory.v1.AgentsService.AddAgent:input_type -> inventory.v1.AddAgentRequest
+	35,  // 346: inventory.v1.AgentsService.ChangeAgent:input_type -> inventory.v1.ChangeAgentRequest
+	81,  // 347: inventory.v1.AgentsService.RemoveAgent:input_type -> inventory.v1.RemoveAgentRequest
+	28,  // 348: inventory.v1.AgentsService.ListAgents:output_type -> inventory.v1.ListAgentsResponse
+	30,  // 349: inventory.v1.AgentsService.GetAgent:output_type -> inventory.v1.GetAgentResponse
+	32,  // 350: inventory.v1.AgentsService.GetAgentLogs:output_type -> inventory.v1.GetAgentLogsResponse
+	34,  // 351: inventory.v1.AgentsService.AddAgent:output_type -> inventory.v1.AddAgentResponse
+	36,  // 352: inventory.v1.AgentsService.ChangeAgent:output_type -> inventory.v1.ChangeAgentResponse
+	82,  // 353: inventory.v1.AgentsService.RemoveAgent:output_type -> inventory.v1.RemoveAgentResponse
+	348, // [348:354] is the sub-list for method output_type
+	342, // [342:348] is the sub-list for method input_type
+	342, // [342:342] is the sub-list for extension type_name
+	342, // [342:342] is the sub-list for extension extendee
+	0,   // [0:342] is the sub-list for field type_name
 }
 
 func init() { file_inventory_v1_agents_proto_init() }
@@ -12932,8 +16097,8 @@ func file_inventory_v1_agents_proto_init() {
 	}
 	file_inventory_v1_agent_status_proto_init()
 	file_inventory_v1_log_level_proto_init()
-	file_inventory_v1_agents_proto_msgTypes[20].OneofWrappers = []any{}
-	file_inventory_v1_agents_proto_msgTypes[24].OneofWrappers = []any{
+	file_inventory_v1_agents_proto_msgTypes[25].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[29].OneofWrappers = []any{
 		(*GetAgentResponse_PmmAgent)(nil),
 		(*GetAgentResponse_Vmagent)(nil),
 		(*GetAgentResponse_NodeExporter)(nil),
@@ -12953,8 +16118,13 @@ func file_inventory_v1_agents_proto_init() {
 		(*GetAgentResponse_NomadAgent)(nil),
 		(*GetAgentResponse_ValkeyExporter)(nil),
 		(*GetAgentResponse_RtaMongodbAgent)(nil),
+		(*GetAgentResponse_RtaMysqlAgent)(nil),
+		(*GetAgentResponse_RtaPostgresqlAgent)(nil),
+		(*GetAgentResponse_QanValkeySlowlogAgent)(nil),
+		(*GetAgentResponse_QanProxysqlDigestAgent)(nil),
+		(*GetAgentResponse_QanPostgresqlLogAgent)(nil),
 	}
-	file_inventory_v1_agents_proto_msgTypes[27].OneofWrappers = []any{
+	file_inventory_v1_agents_proto_msgTypes[32].OneofWrappers = []any{
 		(*AddAgentRequest_PmmAgent)(nil),
 		(*AddAgentRequest_NodeExporter)(nil),
 		(*AddAgentRequest_MysqldExporter)(nil),
@@ -12972,8 +16142,13 @@ func file_inventory_v1_agents_proto_init() {
 		(*AddAgentRequest_QanPostgresqlPgstatmonitorAgent)(nil),
 		(*AddAgentRequest_ValkeyExporter)(nil),
 		(*AddAgentRequest_RtaMongodbAgent)(nil),
+		(*AddAgentRequest_RtaMysqlAgent)(nil),
+		(*AddAgentRequest_RtaPostgresqlAgent)(nil),
+		(*AddAgentRequest_QanValkeySlowlogAgent)(nil),
+		(*AddAgentRequest_QanProxysqlDigestAgent)(nil),
+		(*AddAgentRequest_QanPostgresqlLogAgent)(nil),
 	}
-	file_inventory_v1_agents_proto_msgTypes[28].OneofWrappers = []any{
+	file_inventory_v1_agents_proto_msgTypes[33].OneofWrappers = []any{
 		(*AddAgentResponse_PmmAgent)(nil),
 		(*AddAgentResponse_NodeExporter)(nil),
 		(*AddAgentResponse_MysqldExporter)(nil),
@@ -12991,8 +16166,13 @@ func file_inventory_v1_agents_proto_init() {
 		(*AddAgentResponse_QanPostgresqlPgstatmonitorAgent)(nil),
 		(*AddAgentResponse_ValkeyExporter)(nil),
 		(*AddAgentResponse_RtaMongodbAgent)(nil),
+		(*AddAgentResponse_RtaMysqlAgent)(nil),
+		(*AddAgentResponse_RtaPostgresqlAgent)(nil),
+		(*AddAgentResponse_QanValkeySlowlogAgent)(nil),
+		(*AddAgentResponse_QanProxysqlDigestAgent)(nil),
+		(*AddAgentResponse_QanPostgresqlLogAgent)(nil),
 	}
-	file_inventory_v1_agents_proto_msgTypes[29].OneofWrappers = []any{
+	file_inventory_v1_agents_proto_msgTypes[34].OneofWrappers = []any{
 		(*ChangeAgentRequest_NodeExporter)(nil),
 		(*ChangeAgentRequest_MysqldExporter)(nil),
 		(*ChangeAgentRequest_MongodbExporter)(nil),
@@ -13010,8 +16190,13 @@ func file_inventory_v1_agents_proto_init() {
 		(*ChangeAgentRequest_NomadAgent)(nil),
 		(*ChangeAgentRequest_ValkeyExporter)(nil),
 		(*ChangeAgentRequest_RtaMongodbAgent)(nil),
+		(*ChangeAgentRequest_RtaMysqlAgent)(nil),
+		(*ChangeAgentRequest_RtaPostgresqlAgent)(nil),
+		(*ChangeAgentRequest_QanValkeySlowlogAgent)(nil),
+		(*ChangeAgentRequest_QanProxysqlDigestAgent)(nil),
+		(*ChangeAgentRequest_QanPostgresqlLogAgent)(nil),
 	}
-	file_inventory_v1_agents_proto_msgTypes[30].OneofWrappers = []any{
+	file_inventory_v1_agents_proto_msgTypes[35].OneofWrappers = []any{
 		(*ChangeAgentResponse_NodeExporter)(nil),
 		(*ChangeAgentResponse_MysqldExporter)(nil),
 		(*ChangeAgentResponse_MongodbExporter)(nil),
@@ -13029,31 +16214,41 @@ func file_inventory_v1_agents_proto_init() {
 		(*ChangeAgentResponse_NomadAgent)(nil),
 		(*ChangeAgentResponse_ValkeyExporter)(nil),
 		(*ChangeAgentResponse_RtaMongodbAgent)(nil),
-	}
-	file_inventory_v1_agents_proto_msgTypes[33].OneofWrappers = []any{}
-	file_inventory_v1_agents_proto_msgTypes[35].OneofWrappers = []any{}
-	file_inventory_v1_agents_proto_msgTypes[37].OneofWrappers = []any{}
-	file_inventory_v1_agents_proto_msgTypes[39].OneofWrappers = []any{}
-	file_inventory_v1_agents_proto_msgTypes[41].OneofWrappers = []any{}
-	file_inventory_v1_agents_proto_msgTypes[43].OneofWrappers = []any{}
-	file_inventory_v1_agents_proto_msgTypes[45].OneofWrappers = []any{}
-	file_inventory_v1_agents_proto_msgTypes[47].OneofWrappers = []any{}
-	file_inventory_v1_agents_proto_msgTypes[49].OneofWrappers = []any{}
-	file_inventory_v1_agents_proto_msgTypes[51].OneofWrappers = []any{}
-	file_inventory_v1_agents_proto_msgTypes[53].OneofWrappers = []any{}
-	file_inventory_v1_agents_proto_msgTypes[55].OneofWrappers = []any{}
-	file_inventory_v1_agents_proto_msgTypes[57].OneofWrappers = []any{}
-	file_inventory_v1_agents_proto_msgTypes[59].OneofWrappers = []any{}
+		(*ChangeAgentResponse_RtaMysqlAgent)(nil),
+		(*ChangeAgentResponse_RtaPostgresqlAgent)(nil),
+		(*ChangeAgentResponse_QanValkeySlowlogAgent)(nil),
+		(*ChangeAgentResponse_QanProxysqlDigestAgent)(nil),
+		(*ChangeAgentResponse_QanPostgresqlLogAgent)(nil),
+	}
+	file_inventory_v1_agents_proto_msgTypes[38].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[40].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[42].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[44].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[46].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[48].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[50].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[52].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[54].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[56].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[58].OneofWrappers = []any{}
 	file_inventory_v1_agents_proto_msgTypes[60].OneofWrappers = []any{}
 	file_inventory_v1_agents_proto_msgTypes[62].OneofWrappers = []any{}
 	file_inventory_v1_agents_proto_msgTypes[64].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[66].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[68].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[70].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[71].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[73].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[75].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[77].OneofWrappers = []any{}
+	file_inventory_v1_agents_proto_msgTypes[79].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_inventory_v1_agents_proto_rawDesc), len(file_inventory_v1_agents_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   107,
+			NumMessages:   133,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
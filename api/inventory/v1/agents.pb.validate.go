@@ -2009,6 +2009,262 @@ var _ interface {
 	ErrorName() string
 } = QANMongoDBMongologAgentValidationError{}
 
+// Validate checks the field values on QANValkeySlowlogAgent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QANValkeySlowlogAgent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QANValkeySlowlogAgent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QANValkeySlowlogAgentMultiError, or nil if none found.
+func (m *QANValkeySlowlogAgent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QANValkeySlowlogAgent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AgentId
+
+	// no validation rules for PmmAgentId
+
+	// no validation rules for Disabled
+
+	// no validation rules for ServiceId
+
+	// no validation rules for Username
+
+	// no validation rules for Tls
+
+	// no validation rules for TlsSkipVerify
+
+	// no validation rules for MaxQueryLength
+
+	// no validation rules for QueryExamplesDisabled
+
+	// no validation rules for CustomLabels
+
+	// no validation rules for Status
+
+	// no validation rules for ProcessExecPath
+
+	// no validation rules for LogLevel
+
+	if len(errors) > 0 {
+		return QANValkeySlowlogAgentMultiError(errors)
+	}
+
+	return nil
+}
+
+// QANValkeySlowlogAgentMultiError is an error wrapping multiple validation
+// errors returned by QANValkeySlowlogAgent.ValidateAll() if the designated
+// constraints aren't met.
+type QANValkeySlowlogAgentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QANValkeySlowlogAgentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QANValkeySlowlogAgentMultiError) AllErrors() []error { return m }
+
+// QANValkeySlowlogAgentValidationError is the validation error returned by
+// QANValkeySlowlogAgent.Validate if the designated constraints aren't met.
+type QANValkeySlowlogAgentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QANValkeySlowlogAgentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QANValkeySlowlogAgentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QANValkeySlowlogAgentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QANValkeySlowlogAgentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QANValkeySlowlogAgentValidationError) ErrorName() string {
+	return "QANValkeySlowlogAgentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QANValkeySlowlogAgentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQANValkeySlowlogAgent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = QANValkeySlowlogAgentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QANValkeySlowlogAgentValidationError{}
+
+// Validate checks the field values on QANProxySQLDigestAgent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QANProxySQLDigestAgent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QANProxySQLDigestAgent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QANProxySQLDigestAgentMultiError, or nil if none found.
+func (m *QANProxySQLDigestAgent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QANProxySQLDigestAgent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AgentId
+
+	// no validation rules for PmmAgentId
+
+	// no validation rules for Disabled
+
+	// no validation rules for ServiceId
+
+	// no validation rules for Username
+
+	// no validation rules for Tls
+
+	// no validation rules for TlsSkipVerify
+
+	// no validation rules for MaxQueryLength
+
+	// no validation rules for CustomLabels
+
+	// no validation rules for Status
+
+	// no validation rules for ProcessExecPath
+
+	// no validation rules for LogLevel
+
+	if len(errors) > 0 {
+		return QANProxySQLDigestAgentMultiError(errors)
+	}
+
+	return nil
+}
+
+// QANProxySQLDigestAgentMultiError is an error wrapping multiple validation
+// errors returned by QANProxySQLDigestAgent.ValidateAll() if the designated
+// constraints aren't met.
+type QANProxySQLDigestAgentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QANProxySQLDigestAgentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QANProxySQLDigestAgentMultiError) AllErrors() []error { return m }
+
+// QANProxySQLDigestAgentValidationError is the validation error returned by
+// QANProxySQLDigestAgent.Validate if the designated constraints aren't met.
+type QANProxySQLDigestAgentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QANProxySQLDigestAgentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QANProxySQLDigestAgentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QANProxySQLDigestAgentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QANProxySQLDigestAgentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QANProxySQLDigestAgentValidationError) ErrorName() string {
+	return "QANProxySQLDigestAgentValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e QANProxySQLDigestAgentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQANProxySQLDigestAgent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = QANProxySQLDigestAgentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QANProxySQLDigestAgentValidationError{}
+
 // Validate checks the field values on RTAOptions with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -2289,22 +2545,22 @@ var _ interface {
 	ErrorName() string
 } = RTAMongoDBAgentValidationError{}
 
-// Validate checks the field values on QANPostgreSQLPgStatementsAgent with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QANPostgreSQLPgStatementsAgent) Validate() error {
+// Validate checks the field values on RTAMySQLAgent with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RTAMySQLAgent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QANPostgreSQLPgStatementsAgent with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// QANPostgreSQLPgStatementsAgentMultiError, or nil if none found.
-func (m *QANPostgreSQLPgStatementsAgent) ValidateAll() error {
+// ValidateAll checks the field values on RTAMySQLAgent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RTAMySQLAgentMultiError, or
+// nil if none found.
+func (m *RTAMySQLAgent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QANPostgreSQLPgStatementsAgent) validate(all bool) error {
+func (m *RTAMySQLAgent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2321,36 +2577,59 @@ func (m *QANPostgreSQLPgStatementsAgent) validate(all bool) error {
 
 	// no validation rules for Username
 
-	// no validation rules for DisableCommentsParsing
-
-	// no validation rules for MaxQueryLength
-
 	// no validation rules for Tls
 
 	// no validation rules for TlsSkipVerify
 
 	// no validation rules for CustomLabels
 
-	// no validation rules for Status
+	if all {
+		switch v := interface{}(m.GetRtaOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RTAMySQLAgentValidationError{
+					field:  "RtaOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RTAMySQLAgentValidationError{
+					field:  "RtaOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRtaOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RTAMySQLAgentValidationError{
+				field:  "RtaOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for ProcessExecPath
+	// no validation rules for Status
 
 	// no validation rules for LogLevel
 
 	if len(errors) > 0 {
-		return QANPostgreSQLPgStatementsAgentMultiError(errors)
+		return RTAMySQLAgentMultiError(errors)
 	}
 
 	return nil
 }
 
-// QANPostgreSQLPgStatementsAgentMultiError is an error wrapping multiple
-// validation errors returned by QANPostgreSQLPgStatementsAgent.ValidateAll()
-// if the designated constraints aren't met.
-type QANPostgreSQLPgStatementsAgentMultiError []error
+// RTAMySQLAgentMultiError is an error wrapping multiple validation errors
+// returned by RTAMySQLAgent.ValidateAll() if the designated constraints
+// aren't met.
+type RTAMySQLAgentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QANPostgreSQLPgStatementsAgentMultiError) Error() string {
+func (m RTAMySQLAgentMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2359,12 +2638,11 @@ func (m QANPostgreSQLPgStatementsAgentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QANPostgreSQLPgStatementsAgentMultiError) AllErrors() []error { return m }
+func (m RTAMySQLAgentMultiError) AllErrors() []error { return m }
 
-// QANPostgreSQLPgStatementsAgentValidationError is the validation error
-// returned by QANPostgreSQLPgStatementsAgent.Validate if the designated
-// constraints aren't met.
-type QANPostgreSQLPgStatementsAgentValidationError struct {
+// RTAMySQLAgentValidationError is the validation error returned by
+// RTAMySQLAgent.Validate if the designated constraints aren't met.
+type RTAMySQLAgentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2372,24 +2650,22 @@ type QANPostgreSQLPgStatementsAgentValidationError struct {
 }
 
 // Field function returns field value.
-func (e QANPostgreSQLPgStatementsAgentValidationError) Field() string { return e.field }
+func (e RTAMySQLAgentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QANPostgreSQLPgStatementsAgentValidationError) Reason() string { return e.reason }
+func (e RTAMySQLAgentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QANPostgreSQLPgStatementsAgentValidationError) Cause() error { return e.cause }
+func (e RTAMySQLAgentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QANPostgreSQLPgStatementsAgentValidationError) Key() bool { return e.key }
+func (e RTAMySQLAgentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QANPostgreSQLPgStatementsAgentValidationError) ErrorName() string {
-	return "QANPostgreSQLPgStatementsAgentValidationError"
-}
+func (e RTAMySQLAgentValidationError) ErrorName() string { return "RTAMySQLAgentValidationError" }
 
 // Error satisfies the builtin error interface
-func (e QANPostgreSQLPgStatementsAgentValidationError) Error() string {
+func (e RTAMySQLAgentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2401,7 +2677,7 @@ func (e QANPostgreSQLPgStatementsAgentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQANPostgreSQLPgStatementsAgent.%s: %s%s",
+		"invalid %sRTAMySQLAgent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -2409,7 +2685,7 @@ func (e QANPostgreSQLPgStatementsAgentValidationError) Error() string {
 	)
 }
 
-var _ error = QANPostgreSQLPgStatementsAgentValidationError{}
+var _ error = RTAMySQLAgentValidationError{}
 
 var _ interface {
 	Field() string
@@ -2417,24 +2693,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QANPostgreSQLPgStatementsAgentValidationError{}
+} = RTAMySQLAgentValidationError{}
 
-// Validate checks the field values on QANPostgreSQLPgStatMonitorAgent with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on RTAPostgreSQLAgent with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *QANPostgreSQLPgStatMonitorAgent) Validate() error {
+func (m *RTAPostgreSQLAgent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on QANPostgreSQLPgStatMonitorAgent with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// QANPostgreSQLPgStatMonitorAgentMultiError, or nil if none found.
-func (m *QANPostgreSQLPgStatMonitorAgent) ValidateAll() error {
+// ValidateAll checks the field values on RTAPostgreSQLAgent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RTAPostgreSQLAgentMultiError, or nil if none found.
+func (m *RTAPostgreSQLAgent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *QANPostgreSQLPgStatMonitorAgent) validate(all bool) error {
+func (m *RTAPostgreSQLAgent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2455,34 +2731,55 @@ func (m *QANPostgreSQLPgStatMonitorAgent) validate(all bool) error {
 
 	// no validation rules for TlsSkipVerify
 
-	// no validation rules for DisableCommentsParsing
-
-	// no validation rules for MaxQueryLength
-
-	// no validation rules for QueryExamplesDisabled
-
 	// no validation rules for CustomLabels
 
-	// no validation rules for Status
+	if all {
+		switch v := interface{}(m.GetRtaOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RTAPostgreSQLAgentValidationError{
+					field:  "RtaOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RTAPostgreSQLAgentValidationError{
+					field:  "RtaOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRtaOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RTAPostgreSQLAgentValidationError{
+				field:  "RtaOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
-	// no validation rules for ProcessExecPath
+	// no validation rules for Status
 
 	// no validation rules for LogLevel
 
 	if len(errors) > 0 {
-		return QANPostgreSQLPgStatMonitorAgentMultiError(errors)
+		return RTAPostgreSQLAgentMultiError(errors)
 	}
 
 	return nil
 }
 
-// QANPostgreSQLPgStatMonitorAgentMultiError is an error wrapping multiple
-// validation errors returned by QANPostgreSQLPgStatMonitorAgent.ValidateAll()
-// if the designated constraints aren't met.
-type QANPostgreSQLPgStatMonitorAgentMultiError []error
+// RTAPostgreSQLAgentMultiError is an error wrapping multiple validation errors
+// returned by RTAPostgreSQLAgent.ValidateAll() if the designated constraints
+// aren't met.
+type RTAPostgreSQLAgentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m QANPostgreSQLPgStatMonitorAgentMultiError) Error() string {
+func (m RTAPostgreSQLAgentMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2491,12 +2788,11 @@ func (m QANPostgreSQLPgStatMonitorAgentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m QANPostgreSQLPgStatMonitorAgentMultiError) AllErrors() []error { return m }
+func (m RTAPostgreSQLAgentMultiError) AllErrors() []error { return m }
 
-// QANPostgreSQLPgStatMonitorAgentValidationError is the validation error
-// returned by QANPostgreSQLPgStatMonitorAgent.Validate if the designated
-// constraints aren't met.
-type QANPostgreSQLPgStatMonitorAgentValidationError struct {
+// RTAPostgreSQLAgentValidationError is the validation error returned by
+// RTAPostgreSQLAgent.Validate if the designated constraints aren't met.
+type RTAPostgreSQLAgentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2504,24 +2800,24 @@ type QANPostgreSQLPgStatMonitorAgentValidationError struct {
 }
 
 // Field function returns field value.
-func (e QANPostgreSQLPgStatMonitorAgentValidationError) Field() string { return e.field }
+func (e RTAPostgreSQLAgentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e QANPostgreSQLPgStatMonitorAgentValidationError) Reason() string { return e.reason }
+func (e RTAPostgreSQLAgentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e QANPostgreSQLPgStatMonitorAgentValidationError) Cause() error { return e.cause }
+func (e RTAPostgreSQLAgentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e QANPostgreSQLPgStatMonitorAgentValidationError) Key() bool { return e.key }
+func (e RTAPostgreSQLAgentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e QANPostgreSQLPgStatMonitorAgentValidationError) ErrorName() string {
-	return "QANPostgreSQLPgStatMonitorAgentValidationError"
+func (e RTAPostgreSQLAgentValidationError) ErrorName() string {
+	return "RTAPostgreSQLAgentValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e QANPostgreSQLPgStatMonitorAgentValidationError) Error() string {
+func (e RTAPostgreSQLAgentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2533,7 +2829,7 @@ func (e QANPostgreSQLPgStatMonitorAgentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sQANPostgreSQLPgStatMonitorAgent.%s: %s%s",
+		"invalid %sRTAPostgreSQLAgent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -2541,7 +2837,7 @@ func (e QANPostgreSQLPgStatMonitorAgentValidationError) Error() string {
 	)
 }
 
-var _ error = QANPostgreSQLPgStatMonitorAgentValidationError{}
+var _ error = RTAPostgreSQLAgentValidationError{}
 
 var _ interface {
 	Field() string
@@ -2549,24 +2845,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = QANPostgreSQLPgStatMonitorAgentValidationError{}
+} = RTAPostgreSQLAgentValidationError{}
 
-// Validate checks the field values on RDSExporter with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *RDSExporter) Validate() error {
+// Validate checks the field values on QANPostgreSQLPgStatementsAgent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QANPostgreSQLPgStatementsAgent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on RDSExporter with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in RDSExporterMultiError, or
-// nil if none found.
-func (m *RDSExporter) ValidateAll() error {
+// ValidateAll checks the field values on QANPostgreSQLPgStatementsAgent with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// QANPostgreSQLPgStatementsAgentMultiError, or nil if none found.
+func (m *QANPostgreSQLPgStatementsAgent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *RDSExporter) validate(all bool) error {
+func (m *QANPostgreSQLPgStatementsAgent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2579,70 +2875,40 @@ func (m *RDSExporter) validate(all bool) error {
 
 	// no validation rules for Disabled
 
-	// no validation rules for NodeId
+	// no validation rules for ServiceId
 
-	// no validation rules for AwsAccessKey
+	// no validation rules for Username
 
-	// no validation rules for CustomLabels
+	// no validation rules for DisableCommentsParsing
 
-	// no validation rules for Status
+	// no validation rules for MaxQueryLength
 
-	// no validation rules for ListenPort
+	// no validation rules for Tls
 
-	// no validation rules for BasicMetricsDisabled
+	// no validation rules for TlsSkipVerify
 
-	// no validation rules for EnhancedMetricsDisabled
+	// no validation rules for CustomLabels
 
-	// no validation rules for PushMetricsEnabled
+	// no validation rules for Status
 
 	// no validation rules for ProcessExecPath
 
 	// no validation rules for LogLevel
 
-	// no validation rules for AutoDiscoveryLimit
-
-	if all {
-		switch v := interface{}(m.GetMetricsResolutions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, RDSExporterValidationError{
-					field:  "MetricsResolutions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, RDSExporterValidationError{
-					field:  "MetricsResolutions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return RDSExporterValidationError{
-				field:  "MetricsResolutions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return RDSExporterMultiError(errors)
+		return QANPostgreSQLPgStatementsAgentMultiError(errors)
 	}
 
 	return nil
 }
 
-// RDSExporterMultiError is an error wrapping multiple validation errors
-// returned by RDSExporter.ValidateAll() if the designated constraints aren't met.
-type RDSExporterMultiError []error
+// QANPostgreSQLPgStatementsAgentMultiError is an error wrapping multiple
+// validation errors returned by QANPostgreSQLPgStatementsAgent.ValidateAll()
+// if the designated constraints aren't met.
+type QANPostgreSQLPgStatementsAgentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m RDSExporterMultiError) Error() string {
+func (m QANPostgreSQLPgStatementsAgentMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2651,11 +2917,12 @@ func (m RDSExporterMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m RDSExporterMultiError) AllErrors() []error { return m }
+func (m QANPostgreSQLPgStatementsAgentMultiError) AllErrors() []error { return m }
 
-// RDSExporterValidationError is the validation error returned by
-// RDSExporter.Validate if the designated constraints aren't met.
-type RDSExporterValidationError struct {
+// QANPostgreSQLPgStatementsAgentValidationError is the validation error
+// returned by QANPostgreSQLPgStatementsAgent.Validate if the designated
+// constraints aren't met.
+type QANPostgreSQLPgStatementsAgentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2663,22 +2930,24 @@ type RDSExporterValidationError struct {
 }
 
 // Field function returns field value.
-func (e RDSExporterValidationError) Field() string { return e.field }
+func (e QANPostgreSQLPgStatementsAgentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e RDSExporterValidationError) Reason() string { return e.reason }
+func (e QANPostgreSQLPgStatementsAgentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e RDSExporterValidationError) Cause() error { return e.cause }
+func (e QANPostgreSQLPgStatementsAgentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e RDSExporterValidationError) Key() bool { return e.key }
+func (e QANPostgreSQLPgStatementsAgentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e RDSExporterValidationError) ErrorName() string { return "RDSExporterValidationError" }
+func (e QANPostgreSQLPgStatementsAgentValidationError) ErrorName() string {
+	return "QANPostgreSQLPgStatementsAgentValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e RDSExporterValidationError) Error() string {
+func (e QANPostgreSQLPgStatementsAgentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2690,7 +2959,7 @@ func (e RDSExporterValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sRDSExporter.%s: %s%s",
+		"invalid %sQANPostgreSQLPgStatementsAgent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -2698,7 +2967,7 @@ func (e RDSExporterValidationError) Error() string {
 	)
 }
 
-var _ error = RDSExporterValidationError{}
+var _ error = QANPostgreSQLPgStatementsAgentValidationError{}
 
 var _ interface {
 	Field() string
@@ -2706,24 +2975,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = RDSExporterValidationError{}
+} = QANPostgreSQLPgStatementsAgentValidationError{}
 
-// Validate checks the field values on ExternalExporter with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ExternalExporter) Validate() error {
+// Validate checks the field values on QANPostgreSQLPgStatMonitorAgent with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *QANPostgreSQLPgStatMonitorAgent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ExternalExporter with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ExternalExporterMultiError, or nil if none found.
-func (m *ExternalExporter) ValidateAll() error {
+// ValidateAll checks the field values on QANPostgreSQLPgStatMonitorAgent with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// QANPostgreSQLPgStatMonitorAgentMultiError, or nil if none found.
+func (m *QANPostgreSQLPgStatMonitorAgent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ExternalExporter) validate(all bool) error {
+func (m *QANPostgreSQLPgStatMonitorAgent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2732,7 +3001,7 @@ func (m *ExternalExporter) validate(all bool) error {
 
 	// no validation rules for AgentId
 
-	// no validation rules for RunsOnNodeId
+	// no validation rules for PmmAgentId
 
 	// no validation rules for Disabled
 
@@ -2740,65 +3009,38 @@ func (m *ExternalExporter) validate(all bool) error {
 
 	// no validation rules for Username
 
-	// no validation rules for Scheme
+	// no validation rules for Tls
 
-	// no validation rules for MetricsPath
+	// no validation rules for TlsSkipVerify
 
-	// no validation rules for CustomLabels
+	// no validation rules for DisableCommentsParsing
 
-	// no validation rules for ListenPort
+	// no validation rules for MaxQueryLength
 
-	// no validation rules for PushMetricsEnabled
+	// no validation rules for QueryExamplesDisabled
+
+	// no validation rules for CustomLabels
+
+	// no validation rules for Status
 
 	// no validation rules for ProcessExecPath
 
-	if all {
-		switch v := interface{}(m.GetMetricsResolutions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ExternalExporterValidationError{
-					field:  "MetricsResolutions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ExternalExporterValidationError{
-					field:  "MetricsResolutions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ExternalExporterValidationError{
-				field:  "MetricsResolutions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	// no validation rules for TlsSkipVerify
-
-	// no validation rules for Status
+	// no validation rules for LogLevel
 
 	if len(errors) > 0 {
-		return ExternalExporterMultiError(errors)
+		return QANPostgreSQLPgStatMonitorAgentMultiError(errors)
 	}
 
 	return nil
 }
 
-// ExternalExporterMultiError is an error wrapping multiple validation errors
-// returned by ExternalExporter.ValidateAll() if the designated constraints
-// aren't met.
-type ExternalExporterMultiError []error
+// QANPostgreSQLPgStatMonitorAgentMultiError is an error wrapping multiple
+// validation errors returned by QANPostgreSQLPgStatMonitorAgent.ValidateAll()
+// if the designated constraints aren't met.
+type QANPostgreSQLPgStatMonitorAgentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ExternalExporterMultiError) Error() string {
+func (m QANPostgreSQLPgStatMonitorAgentMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2807,11 +3049,12 @@ func (m ExternalExporterMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ExternalExporterMultiError) AllErrors() []error { return m }
+func (m QANPostgreSQLPgStatMonitorAgentMultiError) AllErrors() []error { return m }
 
-// ExternalExporterValidationError is the validation error returned by
-// ExternalExporter.Validate if the designated constraints aren't met.
-type ExternalExporterValidationError struct {
+// QANPostgreSQLPgStatMonitorAgentValidationError is the validation error
+// returned by QANPostgreSQLPgStatMonitorAgent.Validate if the designated
+// constraints aren't met.
+type QANPostgreSQLPgStatMonitorAgentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2819,22 +3062,24 @@ type ExternalExporterValidationError struct {
 }
 
 // Field function returns field value.
-func (e ExternalExporterValidationError) Field() string { return e.field }
+func (e QANPostgreSQLPgStatMonitorAgentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ExternalExporterValidationError) Reason() string { return e.reason }
+func (e QANPostgreSQLPgStatMonitorAgentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ExternalExporterValidationError) Cause() error { return e.cause }
+func (e QANPostgreSQLPgStatMonitorAgentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ExternalExporterValidationError) Key() bool { return e.key }
+func (e QANPostgreSQLPgStatMonitorAgentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ExternalExporterValidationError) ErrorName() string { return "ExternalExporterValidationError" }
+func (e QANPostgreSQLPgStatMonitorAgentValidationError) ErrorName() string {
+	return "QANPostgreSQLPgStatMonitorAgentValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ExternalExporterValidationError) Error() string {
+func (e QANPostgreSQLPgStatMonitorAgentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2846,7 +3091,7 @@ func (e ExternalExporterValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sExternalExporter.%s: %s%s",
+		"invalid %sQANPostgreSQLPgStatMonitorAgent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -2854,7 +3099,7 @@ func (e ExternalExporterValidationError) Error() string {
 	)
 }
 
-var _ error = ExternalExporterValidationError{}
+var _ error = QANPostgreSQLPgStatMonitorAgentValidationError{}
 
 var _ interface {
 	Field() string
@@ -2862,24 +3107,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ExternalExporterValidationError{}
+} = QANPostgreSQLPgStatMonitorAgentValidationError{}
 
-// Validate checks the field values on AzureDatabaseExporter with the rules
+// Validate checks the field values on QANPostgreSQLLogAgent with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AzureDatabaseExporter) Validate() error {
+func (m *QANPostgreSQLLogAgent) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AzureDatabaseExporter with the rules
+// ValidateAll checks the field values on QANPostgreSQLLogAgent with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AzureDatabaseExporterMultiError, or nil if none found.
-func (m *AzureDatabaseExporter) ValidateAll() error {
+// QANPostgreSQLLogAgentMultiError, or nil if none found.
+func (m *QANPostgreSQLLogAgent) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AzureDatabaseExporter) validate(all bool) error {
+func (m *QANPostgreSQLLogAgent) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -2892,67 +3137,42 @@ func (m *AzureDatabaseExporter) validate(all bool) error {
 
 	// no validation rules for Disabled
 
-	// no validation rules for NodeId
+	// no validation rules for ServiceId
 
-	// no validation rules for AzureDatabaseSubscriptionId
+	// no validation rules for Username
 
-	// no validation rules for AzureDatabaseResourceType
+	// no validation rules for Tls
 
-	// no validation rules for CustomLabels
+	// no validation rules for TlsSkipVerify
 
-	// no validation rules for Status
+	// no validation rules for DisableCommentsParsing
 
-	// no validation rules for ListenPort
+	// no validation rules for MaxQueryLength
 
-	// no validation rules for PushMetricsEnabled
+	// no validation rules for QueryExamplesDisabled
+
+	// no validation rules for CustomLabels
+
+	// no validation rules for Status
 
 	// no validation rules for ProcessExecPath
 
 	// no validation rules for LogLevel
 
-	if all {
-		switch v := interface{}(m.GetMetricsResolutions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AzureDatabaseExporterValidationError{
-					field:  "MetricsResolutions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AzureDatabaseExporterValidationError{
-					field:  "MetricsResolutions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AzureDatabaseExporterValidationError{
-				field:  "MetricsResolutions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return AzureDatabaseExporterMultiError(errors)
+		return QANPostgreSQLLogAgentMultiError(errors)
 	}
 
 	return nil
 }
 
-// AzureDatabaseExporterMultiError is an error wrapping multiple validation
-// errors returned by AzureDatabaseExporter.ValidateAll() if the designated
+// QANPostgreSQLLogAgentMultiError is an error wrapping multiple validation
+// errors returned by QANPostgreSQLLogAgent.ValidateAll() if the designated
 // constraints aren't met.
-type AzureDatabaseExporterMultiError []error
+type QANPostgreSQLLogAgentMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AzureDatabaseExporterMultiError) Error() string {
+func (m QANPostgreSQLLogAgentMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2961,11 +3181,11 @@ func (m AzureDatabaseExporterMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AzureDatabaseExporterMultiError) AllErrors() []error { return m }
+func (m QANPostgreSQLLogAgentMultiError) AllErrors() []error { return m }
 
-// AzureDatabaseExporterValidationError is the validation error returned by
-// AzureDatabaseExporter.Validate if the designated constraints aren't met.
-type AzureDatabaseExporterValidationError struct {
+// QANPostgreSQLLogAgentValidationError is the validation error returned by
+// QANPostgreSQLLogAgent.Validate if the designated constraints aren't met.
+type QANPostgreSQLLogAgentValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2973,24 +3193,24 @@ type AzureDatabaseExporterValidationError struct {
 }
 
 // Field function returns field value.
-func (e AzureDatabaseExporterValidationError) Field() string { return e.field }
+func (e QANPostgreSQLLogAgentValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AzureDatabaseExporterValidationError) Reason() string { return e.reason }
+func (e QANPostgreSQLLogAgentValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AzureDatabaseExporterValidationError) Cause() error { return e.cause }
+func (e QANPostgreSQLLogAgentValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AzureDatabaseExporterValidationError) Key() bool { return e.key }
+func (e QANPostgreSQLLogAgentValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AzureDatabaseExporterValidationError) ErrorName() string {
-	return "AzureDatabaseExporterValidationError"
+func (e QANPostgreSQLLogAgentValidationError) ErrorName() string {
+	return "QANPostgreSQLLogAgentValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AzureDatabaseExporterValidationError) Error() string {
+func (e QANPostgreSQLLogAgentValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3002,7 +3222,7 @@ func (e AzureDatabaseExporterValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAzureDatabaseExporter.%s: %s%s",
+		"invalid %sQANPostgreSQLLogAgent.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -3010,7 +3230,7 @@ func (e AzureDatabaseExporterValidationError) Error() string {
 	)
 }
 
-var _ error = AzureDatabaseExporterValidationError{}
+var _ error = QANPostgreSQLLogAgentValidationError{}
 
 var _ interface {
 	Field() string
@@ -3018,35 +3238,63 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AzureDatabaseExporterValidationError{}
+} = QANPostgreSQLLogAgentValidationError{}
 
-// Validate checks the field values on ChangeCommonAgentParams with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChangeCommonAgentParams) Validate() error {
+// Validate checks the field values on RDSExporter with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RDSExporter) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeCommonAgentParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ChangeCommonAgentParamsMultiError, or nil if none found.
-func (m *ChangeCommonAgentParams) ValidateAll() error {
+// ValidateAll checks the field values on RDSExporter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RDSExporterMultiError, or
+// nil if none found.
+func (m *RDSExporter) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeCommonAgentParams) validate(all bool) error {
+func (m *RDSExporter) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for AgentId
+
+	// no validation rules for PmmAgentId
+
+	// no validation rules for Disabled
+
+	// no validation rules for NodeId
+
+	// no validation rules for AwsAccessKey
+
+	// no validation rules for CustomLabels
+
+	// no validation rules for Status
+
+	// no validation rules for ListenPort
+
+	// no validation rules for BasicMetricsDisabled
+
+	// no validation rules for EnhancedMetricsDisabled
+
+	// no validation rules for PushMetricsEnabled
+
+	// no validation rules for ProcessExecPath
+
+	// no validation rules for LogLevel
+
+	// no validation rules for AutoDiscoveryLimit
+
 	if all {
 		switch v := interface{}(m.GetMetricsResolutions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeCommonAgentParamsValidationError{
+				errors = append(errors, RDSExporterValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3054,7 +3302,7 @@ func (m *ChangeCommonAgentParams) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeCommonAgentParamsValidationError{
+				errors = append(errors, RDSExporterValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -3063,7 +3311,7 @@ func (m *ChangeCommonAgentParams) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ChangeCommonAgentParamsValidationError{
+			return RDSExporterValidationError{
 				field:  "MetricsResolutions",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -3071,59 +3319,19 @@ func (m *ChangeCommonAgentParams) validate(all bool) error {
 		}
 	}
 
-	if m.Enable != nil {
-		// no validation rules for Enable
-	}
-
-	if m.CustomLabels != nil {
-		if all {
-			switch v := interface{}(m.GetCustomLabels()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeCommonAgentParamsValidationError{
-						field:  "CustomLabels",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeCommonAgentParamsValidationError{
-						field:  "CustomLabels",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeCommonAgentParamsValidationError{
-					field:  "CustomLabels",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-	}
-
-	if m.EnablePushMetrics != nil {
-		// no validation rules for EnablePushMetrics
-	}
-
 	if len(errors) > 0 {
-		return ChangeCommonAgentParamsMultiError(errors)
+		return RDSExporterMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeCommonAgentParamsMultiError is an error wrapping multiple validation
-// errors returned by ChangeCommonAgentParams.ValidateAll() if the designated
-// constraints aren't met.
-type ChangeCommonAgentParamsMultiError []error
+// RDSExporterMultiError is an error wrapping multiple validation errors
+// returned by RDSExporter.ValidateAll() if the designated constraints aren't met.
+type RDSExporterMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeCommonAgentParamsMultiError) Error() string {
+func (m RDSExporterMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3132,11 +3340,11 @@ func (m ChangeCommonAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeCommonAgentParamsMultiError) AllErrors() []error { return m }
+func (m RDSExporterMultiError) AllErrors() []error { return m }
 
-// ChangeCommonAgentParamsValidationError is the validation error returned by
-// ChangeCommonAgentParams.Validate if the designated constraints aren't met.
-type ChangeCommonAgentParamsValidationError struct {
+// RDSExporterValidationError is the validation error returned by
+// RDSExporter.Validate if the designated constraints aren't met.
+type RDSExporterValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3144,24 +3352,22 @@ type ChangeCommonAgentParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeCommonAgentParamsValidationError) Field() string { return e.field }
+func (e RDSExporterValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeCommonAgentParamsValidationError) Reason() string { return e.reason }
+func (e RDSExporterValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeCommonAgentParamsValidationError) Cause() error { return e.cause }
+func (e RDSExporterValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeCommonAgentParamsValidationError) Key() bool { return e.key }
+func (e RDSExporterValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeCommonAgentParamsValidationError) ErrorName() string {
-	return "ChangeCommonAgentParamsValidationError"
-}
+func (e RDSExporterValidationError) ErrorName() string { return "RDSExporterValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ChangeCommonAgentParamsValidationError) Error() string {
+func (e RDSExporterValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3173,7 +3379,7 @@ func (e ChangeCommonAgentParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeCommonAgentParams.%s: %s%s",
+		"invalid %sRDSExporter.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -3181,7 +3387,7 @@ func (e ChangeCommonAgentParamsValidationError) Error() string {
 	)
 }
 
-var _ error = ChangeCommonAgentParamsValidationError{}
+var _ error = RDSExporterValidationError{}
 
 var _ interface {
 	Field() string
@@ -3189,52 +3395,99 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeCommonAgentParamsValidationError{}
+} = RDSExporterValidationError{}
 
-// Validate checks the field values on ListAgentsRequest with the rules defined
+// Validate checks the field values on ExternalExporter with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *ListAgentsRequest) Validate() error {
+func (m *ExternalExporter) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListAgentsRequest with the rules
+// ValidateAll checks the field values on ExternalExporter with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListAgentsRequestMultiError, or nil if none found.
-func (m *ListAgentsRequest) ValidateAll() error {
+// ExternalExporterMultiError, or nil if none found.
+func (m *ExternalExporter) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListAgentsRequest) validate(all bool) error {
+func (m *ExternalExporter) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PmmAgentId
+	// no validation rules for AgentId
 
-	// no validation rules for NodeId
+	// no validation rules for RunsOnNodeId
+
+	// no validation rules for Disabled
 
 	// no validation rules for ServiceId
 
-	// no validation rules for AgentType
+	// no validation rules for Username
+
+	// no validation rules for Scheme
+
+	// no validation rules for MetricsPath
+
+	// no validation rules for CustomLabels
+
+	// no validation rules for ListenPort
+
+	// no validation rules for PushMetricsEnabled
+
+	// no validation rules for ProcessExecPath
+
+	if all {
+		switch v := interface{}(m.GetMetricsResolutions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExternalExporterValidationError{
+					field:  "MetricsResolutions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExternalExporterValidationError{
+					field:  "MetricsResolutions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExternalExporterValidationError{
+				field:  "MetricsResolutions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for TlsSkipVerify
+
+	// no validation rules for Status
 
 	if len(errors) > 0 {
-		return ListAgentsRequestMultiError(errors)
+		return ExternalExporterMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListAgentsRequestMultiError is an error wrapping multiple validation errors
-// returned by ListAgentsRequest.ValidateAll() if the designated constraints
+// ExternalExporterMultiError is an error wrapping multiple validation errors
+// returned by ExternalExporter.ValidateAll() if the designated constraints
 // aren't met.
-type ListAgentsRequestMultiError []error
+type ExternalExporterMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListAgentsRequestMultiError) Error() string {
+func (m ExternalExporterMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3243,11 +3496,11 @@ func (m ListAgentsRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListAgentsRequestMultiError) AllErrors() []error { return m }
+func (m ExternalExporterMultiError) AllErrors() []error { return m }
 
-// ListAgentsRequestValidationError is the validation error returned by
-// ListAgentsRequest.Validate if the designated constraints aren't met.
-type ListAgentsRequestValidationError struct {
+// ExternalExporterValidationError is the validation error returned by
+// ExternalExporter.Validate if the designated constraints aren't met.
+type ExternalExporterValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3255,24 +3508,22 @@ type ListAgentsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListAgentsRequestValidationError) Field() string { return e.field }
+func (e ExternalExporterValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListAgentsRequestValidationError) Reason() string { return e.reason }
+func (e ExternalExporterValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListAgentsRequestValidationError) Cause() error { return e.cause }
+func (e ExternalExporterValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListAgentsRequestValidationError) Key() bool { return e.key }
+func (e ExternalExporterValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListAgentsRequestValidationError) ErrorName() string {
-	return "ListAgentsRequestValidationError"
-}
+func (e ExternalExporterValidationError) ErrorName() string { return "ExternalExporterValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListAgentsRequestValidationError) Error() string {
+func (e ExternalExporterValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3284,7 +3535,7 @@ func (e ListAgentsRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListAgentsRequest.%s: %s%s",
+		"invalid %sExternalExporter.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -3292,7 +3543,7 @@ func (e ListAgentsRequestValidationError) Error() string {
 	)
 }
 
-var _ error = ListAgentsRequestValidationError{}
+var _ error = ExternalExporterValidationError{}
 
 var _ interface {
 	Field() string
@@ -3300,337 +3551,469 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListAgentsRequestValidationError{}
+} = ExternalExporterValidationError{}
 
-// Validate checks the field values on ListAgentsResponse with the rules
+// Validate checks the field values on AzureDatabaseExporter with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ListAgentsResponse) Validate() error {
+func (m *AzureDatabaseExporter) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListAgentsResponse with the rules
+// ValidateAll checks the field values on AzureDatabaseExporter with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListAgentsResponseMultiError, or nil if none found.
-func (m *ListAgentsResponse) ValidateAll() error {
+// AzureDatabaseExporterMultiError, or nil if none found.
+func (m *AzureDatabaseExporter) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListAgentsResponse) validate(all bool) error {
+func (m *AzureDatabaseExporter) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPmmAgent() {
-		_, _ = idx, item
+	// no validation rules for AgentId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("PmmAgent[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("PmmAgent[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("PmmAgent[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for PmmAgentId
 
-	}
+	// no validation rules for Disabled
 
-	for idx, item := range m.GetVmAgent() {
-		_, _ = idx, item
+	// no validation rules for NodeId
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("VmAgent[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("VmAgent[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("VmAgent[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for AzureDatabaseSubscriptionId
 
-	}
+	// no validation rules for AzureDatabaseResourceType
 
-	for idx, item := range m.GetNodeExporter() {
-		_, _ = idx, item
+	// no validation rules for CustomLabels
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("NodeExporter[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("NodeExporter[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	// no validation rules for Status
+
+	// no validation rules for ListenPort
+
+	// no validation rules for PushMetricsEnabled
+
+	// no validation rules for ProcessExecPath
+
+	// no validation rules for LogLevel
+
+	if all {
+		switch v := interface{}(m.GetMetricsResolutions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AzureDatabaseExporterValidationError{
+					field:  "MetricsResolutions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("NodeExporter[%v]", idx),
+				errors = append(errors, AzureDatabaseExporterValidationError{
+					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AzureDatabaseExporterValidationError{
+				field:  "MetricsResolutions",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
+	if len(errors) > 0 {
+		return AzureDatabaseExporterMultiError(errors)
 	}
 
-	for idx, item := range m.GetMysqldExporter() {
-		_, _ = idx, item
+	return nil
+}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("MysqldExporter[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("MysqldExporter[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("MysqldExporter[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// AzureDatabaseExporterMultiError is an error wrapping multiple validation
+// errors returned by AzureDatabaseExporter.ValidateAll() if the designated
+// constraints aren't met.
+type AzureDatabaseExporterMultiError []error
 
+// Error returns a concatenation of all the error messages it wraps.
+func (m AzureDatabaseExporterMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
 	}
+	return strings.Join(msgs, "; ")
+}
 
-	for idx, item := range m.GetMongodbExporter() {
-		_, _ = idx, item
+// AllErrors returns a list of validation violation errors.
+func (m AzureDatabaseExporterMultiError) AllErrors() []error { return m }
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("MongodbExporter[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("MongodbExporter[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("MongodbExporter[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// AzureDatabaseExporterValidationError is the validation error returned by
+// AzureDatabaseExporter.Validate if the designated constraints aren't met.
+type AzureDatabaseExporterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	}
+// Field function returns field value.
+func (e AzureDatabaseExporterValidationError) Field() string { return e.field }
 
-	for idx, item := range m.GetPostgresExporter() {
-		_, _ = idx, item
+// Reason function returns reason value.
+func (e AzureDatabaseExporterValidationError) Reason() string { return e.reason }
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("PostgresExporter[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("PostgresExporter[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("PostgresExporter[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// Cause function returns cause value.
+func (e AzureDatabaseExporterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AzureDatabaseExporterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AzureDatabaseExporterValidationError) ErrorName() string {
+	return "AzureDatabaseExporterValidationError"
+}
 
+// Error satisfies the builtin error interface
+func (e AzureDatabaseExporterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	for idx, item := range m.GetProxysqlExporter() {
-		_, _ = idx, item
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("ProxysqlExporter[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("ProxysqlExporter[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("ProxysqlExporter[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	return fmt.Sprintf(
+		"invalid %sAzureDatabaseExporter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = AzureDatabaseExporterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AzureDatabaseExporterValidationError{}
+
+// Validate checks the field values on ChangeCommonAgentParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeCommonAgentParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeCommonAgentParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeCommonAgentParamsMultiError, or nil if none found.
+func (m *ChangeCommonAgentParams) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *ChangeCommonAgentParams) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	for idx, item := range m.GetQanMysqlPerfschemaAgent() {
-		_, _ = idx, item
+	var errors []error
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("QanMysqlPerfschemaAgent[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("QanMysqlPerfschemaAgent[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetMetricsResolutions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangeCommonAgentParamsValidationError{
+					field:  "MetricsResolutions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("QanMysqlPerfschemaAgent[%v]", idx),
+				errors = append(errors, ChangeCommonAgentParamsValidationError{
+					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangeCommonAgentParamsValidationError{
+				field:  "MetricsResolutions",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
-	for idx, item := range m.GetQanMysqlSlowlogAgent() {
-		_, _ = idx, item
+	if m.Enable != nil {
+		// no validation rules for Enable
+	}
 
+	if m.CustomLabels != nil {
 		if all {
-			switch v := interface{}(item).(type) {
+			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("QanMysqlSlowlogAgent[%v]", idx),
+					errors = append(errors, ChangeCommonAgentParamsValidationError{
+						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("QanMysqlSlowlogAgent[%v]", idx),
+					errors = append(errors, ChangeCommonAgentParamsValidationError{
+						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("QanMysqlSlowlogAgent[%v]", idx),
+				return ChangeCommonAgentParamsValidationError{
+					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
-
 	}
 
-	for idx, item := range m.GetQanMongodbProfilerAgent() {
+	if m.EnablePushMetrics != nil {
+		// no validation rules for EnablePushMetrics
+	}
+
+	if len(errors) > 0 {
+		return ChangeCommonAgentParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeCommonAgentParamsMultiError is an error wrapping multiple validation
+// errors returned by ChangeCommonAgentParams.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeCommonAgentParamsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeCommonAgentParamsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeCommonAgentParamsMultiError) AllErrors() []error { return m }
+
+// ChangeCommonAgentParamsValidationError is the validation error returned by
+// ChangeCommonAgentParams.Validate if the designated constraints aren't met.
+type ChangeCommonAgentParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeCommonAgentParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeCommonAgentParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeCommonAgentParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeCommonAgentParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeCommonAgentParamsValidationError) ErrorName() string {
+	return "ChangeCommonAgentParamsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeCommonAgentParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeCommonAgentParams.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ChangeCommonAgentParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeCommonAgentParamsValidationError{}
+
+// Validate checks the field values on ListAgentsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListAgentsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAgentsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAgentsRequestMultiError, or nil if none found.
+func (m *ListAgentsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAgentsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PmmAgentId
+
+	// no validation rules for NodeId
+
+	// no validation rules for ServiceId
+
+	// no validation rules for AgentType
+
+	if len(errors) > 0 {
+		return ListAgentsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAgentsRequestMultiError is an error wrapping multiple validation errors
+// returned by ListAgentsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListAgentsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAgentsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAgentsRequestMultiError) AllErrors() []error { return m }
+
+// ListAgentsRequestValidationError is the validation error returned by
+// ListAgentsRequest.Validate if the designated constraints aren't met.
+type ListAgentsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAgentsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAgentsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAgentsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAgentsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAgentsRequestValidationError) ErrorName() string {
+	return "ListAgentsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAgentsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAgentsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ListAgentsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAgentsRequestValidationError{}
+
+// Validate checks the field values on ListAgentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAgentsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAgentsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAgentsResponseMultiError, or nil if none found.
+func (m *ListAgentsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAgentsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPmmAgent() {
 		_, _ = idx, item
 
 		if all {
@@ -3638,7 +4021,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("QanMongodbProfilerAgent[%v]", idx),
+						field:  fmt.Sprintf("PmmAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3646,7 +4029,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("QanMongodbProfilerAgent[%v]", idx),
+						field:  fmt.Sprintf("PmmAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3655,7 +4038,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("QanMongodbProfilerAgent[%v]", idx),
+					field:  fmt.Sprintf("PmmAgent[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3664,7 +4047,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 
 	}
 
-	for idx, item := range m.GetQanMongodbMongologAgent() {
+	for idx, item := range m.GetVmAgent() {
 		_, _ = idx, item
 
 		if all {
@@ -3672,7 +4055,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("QanMongodbMongologAgent[%v]", idx),
+						field:  fmt.Sprintf("VmAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3680,7 +4063,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("QanMongodbMongologAgent[%v]", idx),
+						field:  fmt.Sprintf("VmAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3689,7 +4072,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("QanMongodbMongologAgent[%v]", idx),
+					field:  fmt.Sprintf("VmAgent[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3698,7 +4081,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 
 	}
 
-	for idx, item := range m.GetQanPostgresqlPgstatementsAgent() {
+	for idx, item := range m.GetNodeExporter() {
 		_, _ = idx, item
 
 		if all {
@@ -3706,7 +4089,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("QanPostgresqlPgstatementsAgent[%v]", idx),
+						field:  fmt.Sprintf("NodeExporter[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3714,7 +4097,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("QanPostgresqlPgstatementsAgent[%v]", idx),
+						field:  fmt.Sprintf("NodeExporter[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3723,7 +4106,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("QanPostgresqlPgstatementsAgent[%v]", idx),
+					field:  fmt.Sprintf("NodeExporter[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3732,7 +4115,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 
 	}
 
-	for idx, item := range m.GetQanPostgresqlPgstatmonitorAgent() {
+	for idx, item := range m.GetMysqldExporter() {
 		_, _ = idx, item
 
 		if all {
@@ -3740,7 +4123,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("QanPostgresqlPgstatmonitorAgent[%v]", idx),
+						field:  fmt.Sprintf("MysqldExporter[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3748,7 +4131,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("QanPostgresqlPgstatmonitorAgent[%v]", idx),
+						field:  fmt.Sprintf("MysqldExporter[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3757,7 +4140,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("QanPostgresqlPgstatmonitorAgent[%v]", idx),
+					field:  fmt.Sprintf("MysqldExporter[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3766,7 +4149,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 
 	}
 
-	for idx, item := range m.GetExternalExporter() {
+	for idx, item := range m.GetMongodbExporter() {
 		_, _ = idx, item
 
 		if all {
@@ -3774,7 +4157,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("ExternalExporter[%v]", idx),
+						field:  fmt.Sprintf("MongodbExporter[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3782,7 +4165,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("ExternalExporter[%v]", idx),
+						field:  fmt.Sprintf("MongodbExporter[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3791,7 +4174,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("ExternalExporter[%v]", idx),
+					field:  fmt.Sprintf("MongodbExporter[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3800,7 +4183,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 
 	}
 
-	for idx, item := range m.GetRdsExporter() {
+	for idx, item := range m.GetPostgresExporter() {
 		_, _ = idx, item
 
 		if all {
@@ -3808,7 +4191,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("RdsExporter[%v]", idx),
+						field:  fmt.Sprintf("PostgresExporter[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3816,7 +4199,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("RdsExporter[%v]", idx),
+						field:  fmt.Sprintf("PostgresExporter[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3825,7 +4208,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("RdsExporter[%v]", idx),
+					field:  fmt.Sprintf("PostgresExporter[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3834,7 +4217,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 
 	}
 
-	for idx, item := range m.GetAzureDatabaseExporter() {
+	for idx, item := range m.GetProxysqlExporter() {
 		_, _ = idx, item
 
 		if all {
@@ -3842,7 +4225,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("AzureDatabaseExporter[%v]", idx),
+						field:  fmt.Sprintf("ProxysqlExporter[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3850,7 +4233,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("AzureDatabaseExporter[%v]", idx),
+						field:  fmt.Sprintf("ProxysqlExporter[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3859,7 +4242,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("AzureDatabaseExporter[%v]", idx),
+					field:  fmt.Sprintf("ProxysqlExporter[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3868,7 +4251,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 
 	}
 
-	for idx, item := range m.GetNomadAgent() {
+	for idx, item := range m.GetQanMysqlPerfschemaAgent() {
 		_, _ = idx, item
 
 		if all {
@@ -3876,7 +4259,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("NomadAgent[%v]", idx),
+						field:  fmt.Sprintf("QanMysqlPerfschemaAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3884,7 +4267,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("NomadAgent[%v]", idx),
+						field:  fmt.Sprintf("QanMysqlPerfschemaAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3893,7 +4276,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("NomadAgent[%v]", idx),
+					field:  fmt.Sprintf("QanMysqlPerfschemaAgent[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3902,7 +4285,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 
 	}
 
-	for idx, item := range m.GetValkeyExporter() {
+	for idx, item := range m.GetQanMysqlSlowlogAgent() {
 		_, _ = idx, item
 
 		if all {
@@ -3910,7 +4293,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("ValkeyExporter[%v]", idx),
+						field:  fmt.Sprintf("QanMysqlSlowlogAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3918,7 +4301,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("ValkeyExporter[%v]", idx),
+						field:  fmt.Sprintf("QanMysqlSlowlogAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3927,7 +4310,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("ValkeyExporter[%v]", idx),
+					field:  fmt.Sprintf("QanMysqlSlowlogAgent[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3936,7 +4319,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 
 	}
 
-	for idx, item := range m.GetRtaMongodbAgent() {
+	for idx, item := range m.GetQanMongodbProfilerAgent() {
 		_, _ = idx, item
 
 		if all {
@@ -3944,7 +4327,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("RtaMongodbAgent[%v]", idx),
+						field:  fmt.Sprintf("QanMongodbProfilerAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3952,7 +4335,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, ListAgentsResponseValidationError{
-						field:  fmt.Sprintf("RtaMongodbAgent[%v]", idx),
+						field:  fmt.Sprintf("QanMongodbProfilerAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -3961,7 +4344,7 @@ func (m *ListAgentsResponse) validate(all bool) error {
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return ListAgentsResponseValidationError{
-					field:  fmt.Sprintf("RtaMongodbAgent[%v]", idx),
+					field:  fmt.Sprintf("QanMongodbProfilerAgent[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -3970,715 +4353,699 @@ func (m *ListAgentsResponse) validate(all bool) error {
 
 	}
 
-	if len(errors) > 0 {
-		return ListAgentsResponseMultiError(errors)
+	for idx, item := range m.GetQanMongodbMongologAgent() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("QanMongodbMongologAgent[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("QanMongodbMongologAgent[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAgentsResponseValidationError{
+					field:  fmt.Sprintf("QanMongodbMongologAgent[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	return nil
-}
+	for idx, item := range m.GetQanPostgresqlPgstatementsAgent() {
+		_, _ = idx, item
 
-// ListAgentsResponseMultiError is an error wrapping multiple validation errors
-// returned by ListAgentsResponse.ValidateAll() if the designated constraints
-// aren't met.
-type ListAgentsResponseMultiError []error
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("QanPostgresqlPgstatementsAgent[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("QanPostgresqlPgstatementsAgent[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAgentsResponseValidationError{
+					field:  fmt.Sprintf("QanPostgresqlPgstatementsAgent[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m ListAgentsResponseMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
 	}
-	return strings.Join(msgs, "; ")
-}
 
-// AllErrors returns a list of validation violation errors.
-func (m ListAgentsResponseMultiError) AllErrors() []error { return m }
+	for idx, item := range m.GetQanPostgresqlPgstatmonitorAgent() {
+		_, _ = idx, item
 
-// ListAgentsResponseValidationError is the validation error returned by
-// ListAgentsResponse.Validate if the designated constraints aren't met.
-type ListAgentsResponseValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("QanPostgresqlPgstatmonitorAgent[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("QanPostgresqlPgstatmonitorAgent[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAgentsResponseValidationError{
+					field:  fmt.Sprintf("QanPostgresqlPgstatmonitorAgent[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Field function returns field value.
-func (e ListAgentsResponseValidationError) Field() string { return e.field }
+	}
 
-// Reason function returns reason value.
-func (e ListAgentsResponseValidationError) Reason() string { return e.reason }
+	for idx, item := range m.GetExternalExporter() {
+		_, _ = idx, item
 
-// Cause function returns cause value.
-func (e ListAgentsResponseValidationError) Cause() error { return e.cause }
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("ExternalExporter[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("ExternalExporter[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAgentsResponseValidationError{
+					field:  fmt.Sprintf("ExternalExporter[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Key function returns key value.
-func (e ListAgentsResponseValidationError) Key() bool { return e.key }
+	}
 
-// ErrorName returns error name.
-func (e ListAgentsResponseValidationError) ErrorName() string {
-	return "ListAgentsResponseValidationError"
-}
+	for idx, item := range m.GetRdsExporter() {
+		_, _ = idx, item
 
-// Error satisfies the builtin error interface
-func (e ListAgentsResponseValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("RdsExporter[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("RdsExporter[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAgentsResponseValidationError{
+					field:  fmt.Sprintf("RdsExporter[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	key := ""
-	if e.key {
-		key = "key for "
 	}
 
-	return fmt.Sprintf(
-		"invalid %sListAgentsResponse.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause,
-	)
-}
-
-var _ error = ListAgentsResponseValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = ListAgentsResponseValidationError{}
-
-// Validate checks the field values on GetAgentRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetAgentRequest) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on GetAgentRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetAgentRequestMultiError, or nil if none found.
-func (m *GetAgentRequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *GetAgentRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if utf8.RuneCountInString(m.GetAgentId()) < 36 {
-		err := GetAgentRequestValidationError{
-			field:  "AgentId",
-			reason: "value length must be at least 36 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if len(errors) > 0 {
-		return GetAgentRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// GetAgentRequestMultiError is an error wrapping multiple validation errors
-// returned by GetAgentRequest.ValidateAll() if the designated constraints
-// aren't met.
-type GetAgentRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m GetAgentRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m GetAgentRequestMultiError) AllErrors() []error { return m }
-
-// GetAgentRequestValidationError is the validation error returned by
-// GetAgentRequest.Validate if the designated constraints aren't met.
-type GetAgentRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e GetAgentRequestValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e GetAgentRequestValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e GetAgentRequestValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e GetAgentRequestValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e GetAgentRequestValidationError) ErrorName() string { return "GetAgentRequestValidationError" }
-
-// Error satisfies the builtin error interface
-func (e GetAgentRequestValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sGetAgentRequest.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause,
-	)
-}
-
-var _ error = GetAgentRequestValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = GetAgentRequestValidationError{}
-
-// Validate checks the field values on GetAgentResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *GetAgentResponse) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on GetAgentResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetAgentResponseMultiError, or nil if none found.
-func (m *GetAgentResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *GetAgentResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	switch v := m.Agent.(type) {
-	case *GetAgentResponse_PmmAgent:
-		if v == nil {
-			err := GetAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	for idx, item := range m.GetAzureDatabaseExporter() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetPmmAgent()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "PmmAgent",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("AzureDatabaseExporter[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "PmmAgent",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("AzureDatabaseExporter[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPmmAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetAgentResponseValidationError{
-					field:  "PmmAgent",
+				return ListAgentsResponseValidationError{
+					field:  fmt.Sprintf("AzureDatabaseExporter[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *GetAgentResponse_Vmagent:
-		if v == nil {
-			err := GetAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	}
+
+	for idx, item := range m.GetNomadAgent() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetVmagent()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "Vmagent",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("NomadAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "Vmagent",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("NomadAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetVmagent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetAgentResponseValidationError{
-					field:  "Vmagent",
+				return ListAgentsResponseValidationError{
+					field:  fmt.Sprintf("NomadAgent[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *GetAgentResponse_NodeExporter:
-		if v == nil {
-			err := GetAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	}
+
+	for idx, item := range m.GetValkeyExporter() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetNodeExporter()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "NodeExporter",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("ValkeyExporter[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "NodeExporter",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("ValkeyExporter[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetNodeExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetAgentResponseValidationError{
-					field:  "NodeExporter",
+				return ListAgentsResponseValidationError{
+					field:  fmt.Sprintf("ValkeyExporter[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *GetAgentResponse_MysqldExporter:
-		if v == nil {
-			err := GetAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	}
+
+	for idx, item := range m.GetRtaMongodbAgent() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetMysqldExporter()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "MysqldExporter",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("RtaMongodbAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "MysqldExporter",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("RtaMongodbAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetMysqldExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetAgentResponseValidationError{
-					field:  "MysqldExporter",
+				return ListAgentsResponseValidationError{
+					field:  fmt.Sprintf("RtaMongodbAgent[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *GetAgentResponse_MongodbExporter:
-		if v == nil {
-			err := GetAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	}
+
+	for idx, item := range m.GetRtaMysqlAgent() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetMongodbExporter()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "MongodbExporter",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("RtaMysqlAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "MongodbExporter",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("RtaMysqlAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetMongodbExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetAgentResponseValidationError{
-					field:  "MongodbExporter",
+				return ListAgentsResponseValidationError{
+					field:  fmt.Sprintf("RtaMysqlAgent[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *GetAgentResponse_PostgresExporter:
-		if v == nil {
-			err := GetAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	}
+
+	for idx, item := range m.GetRtaPostgresqlAgent() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetPostgresExporter()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "PostgresExporter",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("RtaPostgresqlAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "PostgresExporter",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("RtaPostgresqlAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPostgresExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetAgentResponseValidationError{
-					field:  "PostgresExporter",
+				return ListAgentsResponseValidationError{
+					field:  fmt.Sprintf("RtaPostgresqlAgent[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *GetAgentResponse_ProxysqlExporter:
-		if v == nil {
-			err := GetAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	}
+
+	for idx, item := range m.GetQanValkeySlowlogAgent() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetProxysqlExporter()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "ProxysqlExporter",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("QanValkeySlowlogAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "ProxysqlExporter",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("QanValkeySlowlogAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetProxysqlExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetAgentResponseValidationError{
-					field:  "ProxysqlExporter",
+				return ListAgentsResponseValidationError{
+					field:  fmt.Sprintf("QanValkeySlowlogAgent[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *GetAgentResponse_QanMysqlPerfschemaAgent:
-		if v == nil {
-			err := GetAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	}
+
+	for idx, item := range m.GetQanProxysqlDigestAgent() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetQanMysqlPerfschemaAgent()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "QanMysqlPerfschemaAgent",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("QanProxysqlDigestAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "QanMysqlPerfschemaAgent",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("QanProxysqlDigestAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanMysqlPerfschemaAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetAgentResponseValidationError{
-					field:  "QanMysqlPerfschemaAgent",
+				return ListAgentsResponseValidationError{
+					field:  fmt.Sprintf("QanProxysqlDigestAgent[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *GetAgentResponse_QanMysqlSlowlogAgent:
-		if v == nil {
-			err := GetAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	}
+
+	for idx, item := range m.GetQanPostgresqlLogAgent() {
+		_, _ = idx, item
 
 		if all {
-			switch v := interface{}(m.GetQanMysqlSlowlogAgent()).(type) {
+			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "QanMysqlSlowlogAgent",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("QanPostgresqlLogAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "QanMysqlSlowlogAgent",
+					errors = append(errors, ListAgentsResponseValidationError{
+						field:  fmt.Sprintf("QanPostgresqlLogAgent[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanMysqlSlowlogAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return GetAgentResponseValidationError{
-					field:  "QanMysqlSlowlogAgent",
+				return ListAgentsResponseValidationError{
+					field:  fmt.Sprintf("QanPostgresqlLogAgent[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *GetAgentResponse_QanMongodbProfilerAgent:
-		if v == nil {
-			err := GetAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetQanMongodbProfilerAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "QanMongodbProfilerAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "QanMongodbProfilerAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetQanMongodbProfilerAgent()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetAgentResponseValidationError{
-					field:  "QanMongodbProfilerAgent",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	if len(errors) > 0 {
+		return ListAgentsResponseMultiError(errors)
+	}
 
-	case *GetAgentResponse_QanMongodbMongologAgent:
-		if v == nil {
-			err := GetAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	return nil
+}
 
-		if all {
-			switch v := interface{}(m.GetQanMongodbMongologAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "QanMongodbMongologAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "QanMongodbMongologAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetQanMongodbMongologAgent()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetAgentResponseValidationError{
-					field:  "QanMongodbMongologAgent",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// ListAgentsResponseMultiError is an error wrapping multiple validation errors
+// returned by ListAgentsResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListAgentsResponseMultiError []error
 
-	case *GetAgentResponse_QanPostgresqlPgstatementsAgent:
-		if v == nil {
-			err := GetAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAgentsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-		if all {
-			switch v := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "QanPostgresqlPgstatementsAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, GetAgentResponseValidationError{
-						field:  "QanPostgresqlPgstatementsAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return GetAgentResponseValidationError{
-					field:  "QanPostgresqlPgstatementsAgent",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+// AllErrors returns a list of validation violation errors.
+func (m ListAgentsResponseMultiError) AllErrors() []error { return m }
+
+// ListAgentsResponseValidationError is the validation error returned by
+// ListAgentsResponse.Validate if the designated constraints aren't met.
+type ListAgentsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAgentsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAgentsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAgentsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAgentsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAgentsResponseValidationError) ErrorName() string {
+	return "ListAgentsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAgentsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAgentsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ListAgentsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAgentsResponseValidationError{}
+
+// Validate checks the field values on GetAgentRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAgentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAgentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAgentRequestMultiError, or nil if none found.
+func (m *GetAgentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAgentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAgentId()) < 36 {
+		err := GetAgentRequestValidationError{
+			field:  "AgentId",
+			reason: "value length must be at least 36 runes",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	case *GetAgentResponse_QanPostgresqlPgstatmonitorAgent:
+	if len(errors) > 0 {
+		return GetAgentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAgentRequestMultiError is an error wrapping multiple validation errors
+// returned by GetAgentRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetAgentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAgentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAgentRequestMultiError) AllErrors() []error { return m }
+
+// GetAgentRequestValidationError is the validation error returned by
+// GetAgentRequest.Validate if the designated constraints aren't met.
+type GetAgentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAgentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAgentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAgentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAgentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAgentRequestValidationError) ErrorName() string { return "GetAgentRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetAgentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAgentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = GetAgentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAgentRequestValidationError{}
+
+// Validate checks the field values on GetAgentResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetAgentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAgentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAgentResponseMultiError, or nil if none found.
+func (m *GetAgentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAgentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Agent.(type) {
+	case *GetAgentResponse_PmmAgent:
 		if v == nil {
 			err := GetAgentResponseValidationError{
 				field:  "Agent",
@@ -4691,11 +5058,11 @@ func (m *GetAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(type) {
+			switch v := interface{}(m.GetPmmAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, GetAgentResponseValidationError{
-						field:  "QanPostgresqlPgstatmonitorAgent",
+						field:  "PmmAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4703,23 +5070,23 @@ func (m *GetAgentResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, GetAgentResponseValidationError{
-						field:  "QanPostgresqlPgstatmonitorAgent",
+						field:  "PmmAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetPmmAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return GetAgentResponseValidationError{
-					field:  "QanPostgresqlPgstatmonitorAgent",
+					field:  "PmmAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *GetAgentResponse_ExternalExporter:
+	case *GetAgentResponse_Vmagent:
 		if v == nil {
 			err := GetAgentResponseValidationError{
 				field:  "Agent",
@@ -4732,11 +5099,11 @@ func (m *GetAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetExternalExporter()).(type) {
+			switch v := interface{}(m.GetVmagent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, GetAgentResponseValidationError{
-						field:  "ExternalExporter",
+						field:  "Vmagent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4744,23 +5111,23 @@ func (m *GetAgentResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, GetAgentResponseValidationError{
-						field:  "ExternalExporter",
+						field:  "Vmagent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetExternalExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetVmagent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return GetAgentResponseValidationError{
-					field:  "ExternalExporter",
+					field:  "Vmagent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *GetAgentResponse_RdsExporter:
+	case *GetAgentResponse_NodeExporter:
 		if v == nil {
 			err := GetAgentResponseValidationError{
 				field:  "Agent",
@@ -4773,11 +5140,11 @@ func (m *GetAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetRdsExporter()).(type) {
+			switch v := interface{}(m.GetNodeExporter()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, GetAgentResponseValidationError{
-						field:  "RdsExporter",
+						field:  "NodeExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4785,23 +5152,23 @@ func (m *GetAgentResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, GetAgentResponseValidationError{
-						field:  "RdsExporter",
+						field:  "NodeExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetRdsExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetNodeExporter()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return GetAgentResponseValidationError{
-					field:  "RdsExporter",
+					field:  "NodeExporter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *GetAgentResponse_AzureDatabaseExporter:
+	case *GetAgentResponse_MysqldExporter:
 		if v == nil {
 			err := GetAgentResponseValidationError{
 				field:  "Agent",
@@ -4814,11 +5181,11 @@ func (m *GetAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetAzureDatabaseExporter()).(type) {
+			switch v := interface{}(m.GetMysqldExporter()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, GetAgentResponseValidationError{
-						field:  "AzureDatabaseExporter",
+						field:  "MysqldExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4826,23 +5193,23 @@ func (m *GetAgentResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, GetAgentResponseValidationError{
-						field:  "AzureDatabaseExporter",
+						field:  "MysqldExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetAzureDatabaseExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetMysqldExporter()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return GetAgentResponseValidationError{
-					field:  "AzureDatabaseExporter",
+					field:  "MysqldExporter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *GetAgentResponse_NomadAgent:
+	case *GetAgentResponse_MongodbExporter:
 		if v == nil {
 			err := GetAgentResponseValidationError{
 				field:  "Agent",
@@ -4855,11 +5222,11 @@ func (m *GetAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetNomadAgent()).(type) {
+			switch v := interface{}(m.GetMongodbExporter()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, GetAgentResponseValidationError{
-						field:  "NomadAgent",
+						field:  "MongodbExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4867,23 +5234,23 @@ func (m *GetAgentResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, GetAgentResponseValidationError{
-						field:  "NomadAgent",
+						field:  "MongodbExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetNomadAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetMongodbExporter()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return GetAgentResponseValidationError{
-					field:  "NomadAgent",
+					field:  "MongodbExporter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *GetAgentResponse_ValkeyExporter:
+	case *GetAgentResponse_PostgresExporter:
 		if v == nil {
 			err := GetAgentResponseValidationError{
 				field:  "Agent",
@@ -4896,11 +5263,11 @@ func (m *GetAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetValkeyExporter()).(type) {
+			switch v := interface{}(m.GetPostgresExporter()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, GetAgentResponseValidationError{
-						field:  "ValkeyExporter",
+						field:  "PostgresExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4908,23 +5275,23 @@ func (m *GetAgentResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, GetAgentResponseValidationError{
-						field:  "ValkeyExporter",
+						field:  "PostgresExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetValkeyExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetPostgresExporter()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return GetAgentResponseValidationError{
-					field:  "ValkeyExporter",
+					field:  "PostgresExporter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *GetAgentResponse_RtaMongodbAgent:
+	case *GetAgentResponse_ProxysqlExporter:
 		if v == nil {
 			err := GetAgentResponseValidationError{
 				field:  "Agent",
@@ -4937,11 +5304,11 @@ func (m *GetAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetRtaMongodbAgent()).(type) {
+			switch v := interface{}(m.GetProxysqlExporter()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
 					errors = append(errors, GetAgentResponseValidationError{
-						field:  "RtaMongodbAgent",
+						field:  "ProxysqlExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -4949,259 +5316,851 @@ func (m *GetAgentResponse) validate(all bool) error {
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
 					errors = append(errors, GetAgentResponseValidationError{
-						field:  "RtaMongodbAgent",
+						field:  "ProxysqlExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetRtaMongodbAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetProxysqlExporter()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
 				return GetAgentResponseValidationError{
-					field:  "RtaMongodbAgent",
+					field:  "ProxysqlExporter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
-	}
-
-	if len(errors) > 0 {
-		return GetAgentResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// GetAgentResponseMultiError is an error wrapping multiple validation errors
-// returned by GetAgentResponse.ValidateAll() if the designated constraints
-// aren't met.
-type GetAgentResponseMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m GetAgentResponseMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
+	case *GetAgentResponse_QanMysqlPerfschemaAgent:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// AllErrors returns a list of validation violation errors.
-func (m GetAgentResponseMultiError) AllErrors() []error { return m }
+		if all {
+			switch v := interface{}(m.GetQanMysqlPerfschemaAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanMysqlPerfschemaAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanMysqlPerfschemaAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanMysqlPerfschemaAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "QanMysqlPerfschemaAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// GetAgentResponseValidationError is the validation error returned by
-// GetAgentResponse.Validate if the designated constraints aren't met.
-type GetAgentResponseValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
+	case *GetAgentResponse_QanMysqlSlowlogAgent:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Field function returns field value.
-func (e GetAgentResponseValidationError) Field() string { return e.field }
+		if all {
+			switch v := interface{}(m.GetQanMysqlSlowlogAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanMysqlSlowlogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanMysqlSlowlogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanMysqlSlowlogAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "QanMysqlSlowlogAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Reason function returns reason value.
-func (e GetAgentResponseValidationError) Reason() string { return e.reason }
+	case *GetAgentResponse_QanMongodbProfilerAgent:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Cause function returns cause value.
-func (e GetAgentResponseValidationError) Cause() error { return e.cause }
+		if all {
+			switch v := interface{}(m.GetQanMongodbProfilerAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanMongodbProfilerAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanMongodbProfilerAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanMongodbProfilerAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "QanMongodbProfilerAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Key function returns key value.
-func (e GetAgentResponseValidationError) Key() bool { return e.key }
+	case *GetAgentResponse_QanMongodbMongologAgent:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// ErrorName returns error name.
-func (e GetAgentResponseValidationError) ErrorName() string { return "GetAgentResponseValidationError" }
+		if all {
+			switch v := interface{}(m.GetQanMongodbMongologAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanMongodbMongologAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanMongodbMongologAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanMongodbMongologAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "QanMongodbMongologAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Error satisfies the builtin error interface
-func (e GetAgentResponseValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
+	case *GetAgentResponse_QanPostgresqlPgstatementsAgent:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sGetAgentResponse.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause,
-	)
-}
-
-var _ error = GetAgentResponseValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = GetAgentResponseValidationError{}
-
-// Validate checks the field values on GetAgentLogsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAgentLogsRequest) Validate() error {
-	return m.validate(false)
-}
+		if all {
+			switch v := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanPostgresqlPgstatementsAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanPostgresqlPgstatementsAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "QanPostgresqlPgstatementsAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// ValidateAll checks the field values on GetAgentLogsRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// GetAgentLogsRequestMultiError, or nil if none found.
-func (m *GetAgentLogsRequest) ValidateAll() error {
-	return m.validate(true)
-}
+	case *GetAgentResponse_QanPostgresqlPgstatmonitorAgent:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-func (m *GetAgentLogsRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
+		if all {
+			switch v := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanPostgresqlPgstatmonitorAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanPostgresqlPgstatmonitorAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "QanPostgresqlPgstatmonitorAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	var errors []error
+	case *GetAgentResponse_ExternalExporter:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	if utf8.RuneCountInString(m.GetAgentId()) < 36 {
-		err := GetAgentLogsRequestValidationError{
-			field:  "AgentId",
-			reason: "value length must be at least 36 runes",
+		if all {
+			switch v := interface{}(m.GetExternalExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "ExternalExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "ExternalExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExternalExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "ExternalExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		if !all {
-			return err
+
+	case *GetAgentResponse_RdsExporter:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
 		}
-		errors = append(errors, err)
-	}
 
-	// no validation rules for Limit
+		if all {
+			switch v := interface{}(m.GetRdsExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "RdsExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "RdsExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRdsExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "RdsExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	if len(errors) > 0 {
-		return GetAgentLogsRequestMultiError(errors)
-	}
+	case *GetAgentResponse_AzureDatabaseExporter:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	return nil
-}
+		if all {
+			switch v := interface{}(m.GetAzureDatabaseExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "AzureDatabaseExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "AzureDatabaseExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAzureDatabaseExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "AzureDatabaseExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// GetAgentLogsRequestMultiError is an error wrapping multiple validation
-// errors returned by GetAgentLogsRequest.ValidateAll() if the designated
-// constraints aren't met.
-type GetAgentLogsRequestMultiError []error
+	case *GetAgentResponse_NomadAgent:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m GetAgentLogsRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m GetAgentLogsRequestMultiError) AllErrors() []error { return m }
+		if all {
+			switch v := interface{}(m.GetNomadAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "NomadAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "NomadAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNomadAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "NomadAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// GetAgentLogsRequestValidationError is the validation error returned by
-// GetAgentLogsRequest.Validate if the designated constraints aren't met.
-type GetAgentLogsRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
+	case *GetAgentResponse_ValkeyExporter:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Field function returns field value.
-func (e GetAgentLogsRequestValidationError) Field() string { return e.field }
+		if all {
+			switch v := interface{}(m.GetValkeyExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "ValkeyExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "ValkeyExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetValkeyExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "ValkeyExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Reason function returns reason value.
-func (e GetAgentLogsRequestValidationError) Reason() string { return e.reason }
+	case *GetAgentResponse_RtaMongodbAgent:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// Cause function returns cause value.
-func (e GetAgentLogsRequestValidationError) Cause() error { return e.cause }
+		if all {
+			switch v := interface{}(m.GetRtaMongodbAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "RtaMongodbAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "RtaMongodbAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRtaMongodbAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "RtaMongodbAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Key function returns key value.
-func (e GetAgentLogsRequestValidationError) Key() bool { return e.key }
+	case *GetAgentResponse_RtaMysqlAgent:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// ErrorName returns error name.
-func (e GetAgentLogsRequestValidationError) ErrorName() string {
-	return "GetAgentLogsRequestValidationError"
-}
+		if all {
+			switch v := interface{}(m.GetRtaMysqlAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "RtaMysqlAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "RtaMysqlAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRtaMysqlAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "RtaMysqlAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Error satisfies the builtin error interface
-func (e GetAgentLogsRequestValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
+	case *GetAgentResponse_RtaPostgresqlAgent:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	key := ""
-	if e.key {
-		key = "key for "
-	}
+		if all {
+			switch v := interface{}(m.GetRtaPostgresqlAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "RtaPostgresqlAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "RtaPostgresqlAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRtaPostgresqlAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "RtaPostgresqlAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	return fmt.Sprintf(
-		"invalid %sGetAgentLogsRequest.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause,
-	)
-}
+	case *GetAgentResponse_QanValkeySlowlogAgent:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-var _ error = GetAgentLogsRequestValidationError{}
+		if all {
+			switch v := interface{}(m.GetQanValkeySlowlogAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanValkeySlowlogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanValkeySlowlogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanValkeySlowlogAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "QanValkeySlowlogAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-var _ interface {
-	Field() string
-	Reason() string
+	case *GetAgentResponse_QanProxysqlDigestAgent:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanProxysqlDigestAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanProxysqlDigestAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanProxysqlDigestAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanProxysqlDigestAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "QanProxysqlDigestAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *GetAgentResponse_QanPostgresqlLogAgent:
+		if v == nil {
+			err := GetAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanPostgresqlLogAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanPostgresqlLogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetAgentResponseValidationError{
+						field:  "QanPostgresqlLogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanPostgresqlLogAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetAgentResponseValidationError{
+					field:  "QanPostgresqlLogAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return GetAgentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAgentResponseMultiError is an error wrapping multiple validation errors
+// returned by GetAgentResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetAgentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAgentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAgentResponseMultiError) AllErrors() []error { return m }
+
+// GetAgentResponseValidationError is the validation error returned by
+// GetAgentResponse.Validate if the designated constraints aren't met.
+type GetAgentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAgentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAgentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAgentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAgentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAgentResponseValidationError) ErrorName() string { return "GetAgentResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetAgentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAgentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = GetAgentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = GetAgentLogsRequestValidationError{}
+} = GetAgentResponseValidationError{}
 
-// Validate checks the field values on GetAgentLogsResponse with the rules
+// Validate checks the field values on GetAgentLogsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *GetAgentLogsResponse) Validate() error {
+func (m *GetAgentLogsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on GetAgentLogsResponse with the rules
+// ValidateAll checks the field values on GetAgentLogsRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// GetAgentLogsResponseMultiError, or nil if none found.
-func (m *GetAgentLogsResponse) ValidateAll() error {
+// GetAgentLogsRequestMultiError, or nil if none found.
+func (m *GetAgentLogsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *GetAgentLogsResponse) validate(all bool) error {
+func (m *GetAgentLogsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AgentConfigLogLinesCount
+	if utf8.RuneCountInString(m.GetAgentId()) < 36 {
+		err := GetAgentLogsRequestValidationError{
+			field:  "AgentId",
+			reason: "value length must be at least 36 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Limit
 
 	if len(errors) > 0 {
-		return GetAgentLogsResponseMultiError(errors)
+		return GetAgentLogsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// GetAgentLogsResponseMultiError is an error wrapping multiple validation
-// errors returned by GetAgentLogsResponse.ValidateAll() if the designated
+// GetAgentLogsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetAgentLogsRequest.ValidateAll() if the designated
 // constraints aren't met.
-type GetAgentLogsResponseMultiError []error
+type GetAgentLogsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m GetAgentLogsResponseMultiError) Error() string {
+func (m GetAgentLogsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5210,11 +6169,11 @@ func (m GetAgentLogsResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m GetAgentLogsResponseMultiError) AllErrors() []error { return m }
+func (m GetAgentLogsRequestMultiError) AllErrors() []error { return m }
 
-// GetAgentLogsResponseValidationError is the validation error returned by
-// GetAgentLogsResponse.Validate if the designated constraints aren't met.
-type GetAgentLogsResponseValidationError struct {
+// GetAgentLogsRequestValidationError is the validation error returned by
+// GetAgentLogsRequest.Validate if the designated constraints aren't met.
+type GetAgentLogsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5222,79 +6181,2621 @@ type GetAgentLogsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e GetAgentLogsResponseValidationError) Field() string { return e.field }
+func (e GetAgentLogsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e GetAgentLogsResponseValidationError) Reason() string { return e.reason }
+func (e GetAgentLogsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e GetAgentLogsResponseValidationError) Cause() error { return e.cause }
+func (e GetAgentLogsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e GetAgentLogsResponseValidationError) Key() bool { return e.key }
+func (e GetAgentLogsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e GetAgentLogsResponseValidationError) ErrorName() string {
-	return "GetAgentLogsResponseValidationError"
+func (e GetAgentLogsRequestValidationError) ErrorName() string {
+	return "GetAgentLogsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e GetAgentLogsResponseValidationError) Error() string {
+func (e GetAgentLogsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
 	}
 
-	key := ""
-	if e.key {
-		key = "key for "
-	}
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAgentLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = GetAgentLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAgentLogsRequestValidationError{}
+
+// Validate checks the field values on GetAgentLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetAgentLogsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetAgentLogsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetAgentLogsResponseMultiError, or nil if none found.
+func (m *GetAgentLogsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetAgentLogsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AgentConfigLogLinesCount
+
+	if len(errors) > 0 {
+		return GetAgentLogsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetAgentLogsResponseMultiError is an error wrapping multiple validation
+// errors returned by GetAgentLogsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetAgentLogsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetAgentLogsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetAgentLogsResponseMultiError) AllErrors() []error { return m }
+
+// GetAgentLogsResponseValidationError is the validation error returned by
+// GetAgentLogsResponse.Validate if the designated constraints aren't met.
+type GetAgentLogsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetAgentLogsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetAgentLogsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetAgentLogsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetAgentLogsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetAgentLogsResponseValidationError) ErrorName() string {
+	return "GetAgentLogsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetAgentLogsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetAgentLogsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = GetAgentLogsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetAgentLogsResponseValidationError{}
+
+// Validate checks the field values on AddAgentRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddAgentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddAgentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddAgentRequestMultiError, or nil if none found.
+func (m *AddAgentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddAgentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Agent.(type) {
+	case *AddAgentRequest_PmmAgent:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPmmAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "PmmAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "PmmAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPmmAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "PmmAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_NodeExporter:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetNodeExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "NodeExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "NodeExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNodeExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "NodeExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_MysqldExporter:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMysqldExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "MysqldExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "MysqldExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqldExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "MysqldExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_MongodbExporter:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMongodbExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "MongodbExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "MongodbExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMongodbExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "MongodbExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_PostgresExporter:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPostgresExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "PostgresExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "PostgresExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPostgresExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "PostgresExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_ProxysqlExporter:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetProxysqlExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "ProxysqlExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "ProxysqlExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetProxysqlExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "ProxysqlExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_ExternalExporter:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetExternalExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "ExternalExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "ExternalExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExternalExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "ExternalExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_RdsExporter:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRdsExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "RdsExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "RdsExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRdsExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "RdsExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_AzureDatabaseExporter:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAzureDatabaseExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "AzureDatabaseExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "AzureDatabaseExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAzureDatabaseExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "AzureDatabaseExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_QanMysqlPerfschemaAgent:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanMysqlPerfschemaAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanMysqlPerfschemaAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanMysqlPerfschemaAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanMysqlPerfschemaAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "QanMysqlPerfschemaAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_QanMysqlSlowlogAgent:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanMysqlSlowlogAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanMysqlSlowlogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanMysqlSlowlogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanMysqlSlowlogAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "QanMysqlSlowlogAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_QanMongodbProfilerAgent:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanMongodbProfilerAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanMongodbProfilerAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanMongodbProfilerAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanMongodbProfilerAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "QanMongodbProfilerAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_QanMongodbMongologAgent:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanMongodbMongologAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanMongodbMongologAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanMongodbMongologAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanMongodbMongologAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "QanMongodbMongologAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_QanPostgresqlPgstatementsAgent:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanPostgresqlPgstatementsAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanPostgresqlPgstatementsAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "QanPostgresqlPgstatementsAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_QanPostgresqlPgstatmonitorAgent:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanPostgresqlPgstatmonitorAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanPostgresqlPgstatmonitorAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "QanPostgresqlPgstatmonitorAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_ValkeyExporter:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetValkeyExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "ValkeyExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "ValkeyExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetValkeyExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "ValkeyExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_RtaMongodbAgent:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRtaMongodbAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "RtaMongodbAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "RtaMongodbAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRtaMongodbAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "RtaMongodbAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_RtaMysqlAgent:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRtaMysqlAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "RtaMysqlAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "RtaMysqlAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRtaMysqlAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "RtaMysqlAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_RtaPostgresqlAgent:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRtaPostgresqlAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "RtaPostgresqlAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "RtaPostgresqlAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRtaPostgresqlAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "RtaPostgresqlAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_QanValkeySlowlogAgent:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanValkeySlowlogAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanValkeySlowlogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanValkeySlowlogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanValkeySlowlogAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "QanValkeySlowlogAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_QanProxysqlDigestAgent:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanProxysqlDigestAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanProxysqlDigestAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanProxysqlDigestAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanProxysqlDigestAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "QanProxysqlDigestAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentRequest_QanPostgresqlLogAgent:
+		if v == nil {
+			err := AddAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanPostgresqlLogAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanPostgresqlLogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentRequestValidationError{
+						field:  "QanPostgresqlLogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanPostgresqlLogAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentRequestValidationError{
+					field:  "QanPostgresqlLogAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return AddAgentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddAgentRequestMultiError is an error wrapping multiple validation errors
+// returned by AddAgentRequest.ValidateAll() if the designated constraints
+// aren't met.
+type AddAgentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddAgentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddAgentRequestMultiError) AllErrors() []error { return m }
+
+// AddAgentRequestValidationError is the validation error returned by
+// AddAgentRequest.Validate if the designated constraints aren't met.
+type AddAgentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddAgentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddAgentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddAgentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddAgentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddAgentRequestValidationError) ErrorName() string { return "AddAgentRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddAgentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddAgentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = AddAgentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddAgentRequestValidationError{}
+
+// Validate checks the field values on AddAgentResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddAgentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddAgentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddAgentResponseMultiError, or nil if none found.
+func (m *AddAgentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddAgentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Agent.(type) {
+	case *AddAgentResponse_PmmAgent:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPmmAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "PmmAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "PmmAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPmmAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "PmmAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_NodeExporter:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetNodeExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "NodeExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "NodeExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNodeExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "NodeExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_MysqldExporter:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMysqldExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "MysqldExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "MysqldExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqldExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "MysqldExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_MongodbExporter:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMongodbExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "MongodbExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "MongodbExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMongodbExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "MongodbExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_PostgresExporter:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPostgresExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "PostgresExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "PostgresExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPostgresExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "PostgresExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_ProxysqlExporter:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetProxysqlExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "ProxysqlExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "ProxysqlExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetProxysqlExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "ProxysqlExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_ExternalExporter:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetExternalExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "ExternalExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "ExternalExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExternalExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "ExternalExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_RdsExporter:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRdsExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "RdsExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "RdsExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRdsExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "RdsExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_AzureDatabaseExporter:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetAzureDatabaseExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "AzureDatabaseExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "AzureDatabaseExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAzureDatabaseExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "AzureDatabaseExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_QanMysqlPerfschemaAgent:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanMysqlPerfschemaAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanMysqlPerfschemaAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanMysqlPerfschemaAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanMysqlPerfschemaAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "QanMysqlPerfschemaAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_QanMysqlSlowlogAgent:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanMysqlSlowlogAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanMysqlSlowlogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanMysqlSlowlogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanMysqlSlowlogAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "QanMysqlSlowlogAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_QanMongodbProfilerAgent:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanMongodbProfilerAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanMongodbProfilerAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanMongodbProfilerAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanMongodbProfilerAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "QanMongodbProfilerAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_QanMongodbMongologAgent:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanMongodbMongologAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanMongodbMongologAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanMongodbMongologAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanMongodbMongologAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "QanMongodbMongologAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_QanPostgresqlPgstatementsAgent:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanPostgresqlPgstatementsAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanPostgresqlPgstatementsAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "QanPostgresqlPgstatementsAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_QanPostgresqlPgstatmonitorAgent:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanPostgresqlPgstatmonitorAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanPostgresqlPgstatmonitorAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "QanPostgresqlPgstatmonitorAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_ValkeyExporter:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetValkeyExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "ValkeyExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "ValkeyExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetValkeyExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "ValkeyExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_RtaMongodbAgent:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRtaMongodbAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "RtaMongodbAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "RtaMongodbAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRtaMongodbAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "RtaMongodbAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_RtaMysqlAgent:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRtaMysqlAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "RtaMysqlAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "RtaMysqlAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRtaMysqlAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "RtaMysqlAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_RtaPostgresqlAgent:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetRtaPostgresqlAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "RtaPostgresqlAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "RtaPostgresqlAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRtaPostgresqlAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "RtaPostgresqlAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_QanValkeySlowlogAgent:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanValkeySlowlogAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanValkeySlowlogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanValkeySlowlogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanValkeySlowlogAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "QanValkeySlowlogAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_QanProxysqlDigestAgent:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanProxysqlDigestAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanProxysqlDigestAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanProxysqlDigestAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanProxysqlDigestAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "QanProxysqlDigestAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *AddAgentResponse_QanPostgresqlLogAgent:
+		if v == nil {
+			err := AddAgentResponseValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetQanPostgresqlLogAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanPostgresqlLogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AddAgentResponseValidationError{
+						field:  "QanPostgresqlLogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanPostgresqlLogAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AddAgentResponseValidationError{
+					field:  "QanPostgresqlLogAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return AddAgentResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddAgentResponseMultiError is an error wrapping multiple validation errors
+// returned by AddAgentResponse.ValidateAll() if the designated constraints
+// aren't met.
+type AddAgentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddAgentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddAgentResponseMultiError) AllErrors() []error { return m }
+
+// AddAgentResponseValidationError is the validation error returned by
+// AddAgentResponse.Validate if the designated constraints aren't met.
+type AddAgentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddAgentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddAgentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddAgentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddAgentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddAgentResponseValidationError) ErrorName() string { return "AddAgentResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AddAgentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddAgentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = AddAgentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddAgentResponseValidationError{}
+
+// Validate checks the field values on ChangeAgentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeAgentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeAgentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeAgentRequestMultiError, or nil if none found.
+func (m *ChangeAgentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeAgentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetAgentId()) < 1 {
+		err := ChangeAgentRequestValidationError{
+			field:  "AgentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	switch v := m.Agent.(type) {
+	case *ChangeAgentRequest_NodeExporter:
+		if v == nil {
+			err := ChangeAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetNodeExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "NodeExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "NodeExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetNodeExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeAgentRequestValidationError{
+					field:  "NodeExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChangeAgentRequest_MysqldExporter:
+		if v == nil {
+			err := ChangeAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMysqldExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "MysqldExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "MysqldExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMysqldExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeAgentRequestValidationError{
+					field:  "MysqldExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChangeAgentRequest_MongodbExporter:
+		if v == nil {
+			err := ChangeAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetMongodbExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "MongodbExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "MongodbExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetMongodbExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeAgentRequestValidationError{
+					field:  "MongodbExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChangeAgentRequest_PostgresExporter:
+		if v == nil {
+			err := ChangeAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPostgresExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "PostgresExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "PostgresExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPostgresExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeAgentRequestValidationError{
+					field:  "PostgresExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChangeAgentRequest_ProxysqlExporter:
+		if v == nil {
+			err := ChangeAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetProxysqlExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "ProxysqlExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "ProxysqlExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetProxysqlExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeAgentRequestValidationError{
+					field:  "ProxysqlExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChangeAgentRequest_ExternalExporter:
+		if v == nil {
+			err := ChangeAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetExternalExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "ExternalExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "ExternalExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExternalExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeAgentRequestValidationError{
+					field:  "ExternalExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ChangeAgentRequest_RdsExporter:
+		if v == nil {
+			err := ChangeAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	return fmt.Sprintf(
-		"invalid %sGetAgentLogsResponse.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause,
-	)
-}
+		if all {
+			switch v := interface{}(m.GetRdsExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "RdsExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "RdsExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRdsExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeAgentRequestValidationError{
+					field:  "RdsExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-var _ error = GetAgentLogsResponseValidationError{}
+	case *ChangeAgentRequest_AzureDatabaseExporter:
+		if v == nil {
+			err := ChangeAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = GetAgentLogsResponseValidationError{}
+		if all {
+			switch v := interface{}(m.GetAzureDatabaseExporter()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "AzureDatabaseExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "AzureDatabaseExporter",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetAzureDatabaseExporter()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeAgentRequestValidationError{
+					field:  "AzureDatabaseExporter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-// Validate checks the field values on AddAgentRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AddAgentRequest) Validate() error {
-	return m.validate(false)
-}
+	case *ChangeAgentRequest_QanMysqlPerfschemaAgent:
+		if v == nil {
+			err := ChangeAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-// ValidateAll checks the field values on AddAgentRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddAgentRequestMultiError, or nil if none found.
-func (m *AddAgentRequest) ValidateAll() error {
-	return m.validate(true)
-}
+		if all {
+			switch v := interface{}(m.GetQanMysqlPerfschemaAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanMysqlPerfschemaAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanMysqlPerfschemaAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanMysqlPerfschemaAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeAgentRequestValidationError{
+					field:  "QanMysqlPerfschemaAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-func (m *AddAgentRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
+	case *ChangeAgentRequest_QanMysqlSlowlogAgent:
+		if v == nil {
+			err := ChangeAgentRequestValidationError{
+				field:  "Agent",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	var errors []error
+		if all {
+			switch v := interface{}(m.GetQanMysqlSlowlogAgent()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanMysqlSlowlogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanMysqlSlowlogAgent",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetQanMysqlSlowlogAgent()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeAgentRequestValidationError{
+					field:  "QanMysqlSlowlogAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 
-	switch v := m.Agent.(type) {
-	case *AddAgentRequest_PmmAgent:
+	case *ChangeAgentRequest_QanMongodbProfilerAgent:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentRequestValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5305,37 +8806,37 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetPmmAgent()).(type) {
+			switch v := interface{}(m.GetQanMongodbProfilerAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "PmmAgent",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanMongodbProfilerAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "PmmAgent",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanMongodbProfilerAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPmmAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetQanMongodbProfilerAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "PmmAgent",
+				return ChangeAgentRequestValidationError{
+					field:  "QanMongodbProfilerAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentRequest_NodeExporter:
+	case *ChangeAgentRequest_QanMongodbMongologAgent:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentRequestValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5346,37 +8847,37 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetNodeExporter()).(type) {
+			switch v := interface{}(m.GetQanMongodbMongologAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "NodeExporter",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanMongodbMongologAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "NodeExporter",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanMongodbMongologAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetNodeExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetQanMongodbMongologAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "NodeExporter",
+				return ChangeAgentRequestValidationError{
+					field:  "QanMongodbMongologAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentRequest_MysqldExporter:
+	case *ChangeAgentRequest_QanPostgresqlPgstatementsAgent:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentRequestValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5387,37 +8888,37 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetMysqldExporter()).(type) {
+			switch v := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "MysqldExporter",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanPostgresqlPgstatementsAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "MysqldExporter",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanPostgresqlPgstatementsAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetMysqldExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "MysqldExporter",
+				return ChangeAgentRequestValidationError{
+					field:  "QanPostgresqlPgstatementsAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentRequest_MongodbExporter:
+	case *ChangeAgentRequest_QanPostgresqlPgstatmonitorAgent:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentRequestValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5428,37 +8929,37 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetMongodbExporter()).(type) {
+			switch v := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "MongodbExporter",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanPostgresqlPgstatmonitorAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "MongodbExporter",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanPostgresqlPgstatmonitorAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetMongodbExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "MongodbExporter",
+				return ChangeAgentRequestValidationError{
+					field:  "QanPostgresqlPgstatmonitorAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentRequest_PostgresExporter:
+	case *ChangeAgentRequest_NomadAgent:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentRequestValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5469,37 +8970,37 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetPostgresExporter()).(type) {
+			switch v := interface{}(m.GetNomadAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "PostgresExporter",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "NomadAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "PostgresExporter",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "NomadAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPostgresExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetNomadAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "PostgresExporter",
+				return ChangeAgentRequestValidationError{
+					field:  "NomadAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentRequest_ProxysqlExporter:
+	case *ChangeAgentRequest_ValkeyExporter:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentRequestValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5510,37 +9011,37 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetProxysqlExporter()).(type) {
+			switch v := interface{}(m.GetValkeyExporter()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "ProxysqlExporter",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "ValkeyExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "ProxysqlExporter",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "ValkeyExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetProxysqlExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetValkeyExporter()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "ProxysqlExporter",
+				return ChangeAgentRequestValidationError{
+					field:  "ValkeyExporter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentRequest_ExternalExporter:
+	case *ChangeAgentRequest_RtaMongodbAgent:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentRequestValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5551,37 +9052,37 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetExternalExporter()).(type) {
+			switch v := interface{}(m.GetRtaMongodbAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "ExternalExporter",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "RtaMongodbAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "ExternalExporter",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "RtaMongodbAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetExternalExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetRtaMongodbAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "ExternalExporter",
+				return ChangeAgentRequestValidationError{
+					field:  "RtaMongodbAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentRequest_RdsExporter:
+	case *ChangeAgentRequest_RtaMysqlAgent:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentRequestValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5592,37 +9093,37 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetRdsExporter()).(type) {
+			switch v := interface{}(m.GetRtaMysqlAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "RdsExporter",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "RtaMysqlAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "RdsExporter",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "RtaMysqlAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetRdsExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetRtaMysqlAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "RdsExporter",
+				return ChangeAgentRequestValidationError{
+					field:  "RtaMysqlAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentRequest_AzureDatabaseExporter:
+	case *ChangeAgentRequest_RtaPostgresqlAgent:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentRequestValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5633,37 +9134,37 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetAzureDatabaseExporter()).(type) {
+			switch v := interface{}(m.GetRtaPostgresqlAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "AzureDatabaseExporter",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "RtaPostgresqlAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "AzureDatabaseExporter",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "RtaPostgresqlAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetAzureDatabaseExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetRtaPostgresqlAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "AzureDatabaseExporter",
+				return ChangeAgentRequestValidationError{
+					field:  "RtaPostgresqlAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentRequest_QanMysqlPerfschemaAgent:
+	case *ChangeAgentRequest_QanValkeySlowlogAgent:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentRequestValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5674,37 +9175,37 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetQanMysqlPerfschemaAgent()).(type) {
+			switch v := interface{}(m.GetQanValkeySlowlogAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "QanMysqlPerfschemaAgent",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanValkeySlowlogAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "QanMysqlPerfschemaAgent",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanValkeySlowlogAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanMysqlPerfschemaAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetQanValkeySlowlogAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "QanMysqlPerfschemaAgent",
+				return ChangeAgentRequestValidationError{
+					field:  "QanValkeySlowlogAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentRequest_QanMysqlSlowlogAgent:
+	case *ChangeAgentRequest_QanProxysqlDigestAgent:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentRequestValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5715,37 +9216,37 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetQanMysqlSlowlogAgent()).(type) {
+			switch v := interface{}(m.GetQanProxysqlDigestAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "QanMysqlSlowlogAgent",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanProxysqlDigestAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "QanMysqlSlowlogAgent",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanProxysqlDigestAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanMysqlSlowlogAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetQanProxysqlDigestAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "QanMysqlSlowlogAgent",
+				return ChangeAgentRequestValidationError{
+					field:  "QanProxysqlDigestAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentRequest_QanMongodbProfilerAgent:
+	case *ChangeAgentRequest_QanPostgresqlLogAgent:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentRequestValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5756,37 +9257,145 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetQanMongodbProfilerAgent()).(type) {
+			switch v := interface{}(m.GetQanPostgresqlLogAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "QanMongodbProfilerAgent",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanPostgresqlLogAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "QanMongodbProfilerAgent",
+					errors = append(errors, ChangeAgentRequestValidationError{
+						field:  "QanPostgresqlLogAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanMongodbProfilerAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetQanPostgresqlLogAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "QanMongodbProfilerAgent",
+				return ChangeAgentRequestValidationError{
+					field:  "QanPostgresqlLogAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentRequest_QanMongodbMongologAgent:
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ChangeAgentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeAgentRequestMultiError is an error wrapping multiple validation errors
+// returned by ChangeAgentRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ChangeAgentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeAgentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeAgentRequestMultiError) AllErrors() []error { return m }
+
+// ChangeAgentRequestValidationError is the validation error returned by
+// ChangeAgentRequest.Validate if the designated constraints aren't met.
+type ChangeAgentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeAgentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeAgentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeAgentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeAgentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeAgentRequestValidationError) ErrorName() string {
+	return "ChangeAgentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeAgentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeAgentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ChangeAgentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeAgentRequestValidationError{}
+
+// Validate checks the field values on ChangeAgentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeAgentResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeAgentResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeAgentResponseMultiError, or nil if none found.
+func (m *ChangeAgentResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeAgentResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	switch v := m.Agent.(type) {
+	case *ChangeAgentResponse_NodeExporter:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5797,37 +9406,37 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetQanMongodbMongologAgent()).(type) {
+			switch v := interface{}(m.GetNodeExporter()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "QanMongodbMongologAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "NodeExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "QanMongodbMongologAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "NodeExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanMongodbMongologAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetNodeExporter()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "QanMongodbMongologAgent",
+				return ChangeAgentResponseValidationError{
+					field:  "NodeExporter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentRequest_QanPostgresqlPgstatementsAgent:
+	case *ChangeAgentResponse_MysqldExporter:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5838,37 +9447,37 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(type) {
+			switch v := interface{}(m.GetMysqldExporter()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "QanPostgresqlPgstatementsAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "MysqldExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "QanPostgresqlPgstatementsAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "MysqldExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetMysqldExporter()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "QanPostgresqlPgstatementsAgent",
+				return ChangeAgentResponseValidationError{
+					field:  "MysqldExporter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentRequest_QanPostgresqlPgstatmonitorAgent:
+	case *ChangeAgentResponse_MongodbExporter:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5879,37 +9488,37 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(type) {
+			switch v := interface{}(m.GetMongodbExporter()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "QanPostgresqlPgstatmonitorAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "MongodbExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "QanPostgresqlPgstatmonitorAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "MongodbExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetMongodbExporter()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "QanPostgresqlPgstatmonitorAgent",
+				return ChangeAgentResponseValidationError{
+					field:  "MongodbExporter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentRequest_ValkeyExporter:
+	case *ChangeAgentResponse_PostgresExporter:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5920,37 +9529,37 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetValkeyExporter()).(type) {
+			switch v := interface{}(m.GetPostgresExporter()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "ValkeyExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "PostgresExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "ValkeyExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "PostgresExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetValkeyExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetPostgresExporter()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "ValkeyExporter",
+				return ChangeAgentResponseValidationError{
+					field:  "PostgresExporter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentRequest_RtaMongodbAgent:
+	case *ChangeAgentResponse_ProxysqlExporter:
 		if v == nil {
-			err := AddAgentRequestValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -5961,143 +9570,37 @@ func (m *AddAgentRequest) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetRtaMongodbAgent()).(type) {
+			switch v := interface{}(m.GetProxysqlExporter()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "RtaMongodbAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "ProxysqlExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentRequestValidationError{
-						field:  "RtaMongodbAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "ProxysqlExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetRtaMongodbAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetProxysqlExporter()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentRequestValidationError{
-					field:  "RtaMongodbAgent",
+				return ChangeAgentResponseValidationError{
+					field:  "ProxysqlExporter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	default:
-		_ = v // ensures v is used
-	}
-
-	if len(errors) > 0 {
-		return AddAgentRequestMultiError(errors)
-	}
-
-	return nil
-}
-
-// AddAgentRequestMultiError is an error wrapping multiple validation errors
-// returned by AddAgentRequest.ValidateAll() if the designated constraints
-// aren't met.
-type AddAgentRequestMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m AddAgentRequestMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m AddAgentRequestMultiError) AllErrors() []error { return m }
-
-// AddAgentRequestValidationError is the validation error returned by
-// AddAgentRequest.Validate if the designated constraints aren't met.
-type AddAgentRequestValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e AddAgentRequestValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e AddAgentRequestValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e AddAgentRequestValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e AddAgentRequestValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e AddAgentRequestValidationError) ErrorName() string { return "AddAgentRequestValidationError" }
-
-// Error satisfies the builtin error interface
-func (e AddAgentRequestValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sAddAgentRequest.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause,
-	)
-}
-
-var _ error = AddAgentRequestValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = AddAgentRequestValidationError{}
-
-// Validate checks the field values on AddAgentResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AddAgentResponse) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on AddAgentResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddAgentResponseMultiError, or nil if none found.
-func (m *AddAgentResponse) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *AddAgentResponse) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	switch v := m.Agent.(type) {
-	case *AddAgentResponse_PmmAgent:
+	case *ChangeAgentResponse_ExternalExporter:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6108,37 +9611,37 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetPmmAgent()).(type) {
+			switch v := interface{}(m.GetExternalExporter()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "PmmAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "ExternalExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "PmmAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "ExternalExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPmmAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetExternalExporter()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "PmmAgent",
+				return ChangeAgentResponseValidationError{
+					field:  "ExternalExporter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentResponse_NodeExporter:
+	case *ChangeAgentResponse_RdsExporter:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6149,37 +9652,37 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetNodeExporter()).(type) {
+			switch v := interface{}(m.GetRdsExporter()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "NodeExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "RdsExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "NodeExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "RdsExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetNodeExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetRdsExporter()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "NodeExporter",
+				return ChangeAgentResponseValidationError{
+					field:  "RdsExporter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentResponse_MysqldExporter:
+	case *ChangeAgentResponse_AzureDatabaseExporter:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6190,37 +9693,37 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetMysqldExporter()).(type) {
+			switch v := interface{}(m.GetAzureDatabaseExporter()).(type) {
 			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "MysqldExporter",
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "AzureDatabaseExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "MysqldExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "AzureDatabaseExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetMysqldExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetAzureDatabaseExporter()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "MysqldExporter",
+				return ChangeAgentResponseValidationError{
+					field:  "AzureDatabaseExporter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentResponse_MongodbExporter:
+	case *ChangeAgentResponse_QanMysqlPerfschemaAgent:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6231,37 +9734,37 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetMongodbExporter()).(type) {
+			switch v := interface{}(m.GetQanMysqlPerfschemaAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "MongodbExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanMysqlPerfschemaAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "MongodbExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanMysqlPerfschemaAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetMongodbExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetQanMysqlPerfschemaAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "MongodbExporter",
+				return ChangeAgentResponseValidationError{
+					field:  "QanMysqlPerfschemaAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentResponse_PostgresExporter:
+	case *ChangeAgentResponse_QanMysqlSlowlogAgent:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6272,37 +9775,37 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetPostgresExporter()).(type) {
+			switch v := interface{}(m.GetQanMysqlSlowlogAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "PostgresExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanMysqlSlowlogAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "PostgresExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanMysqlSlowlogAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetPostgresExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetQanMysqlSlowlogAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "PostgresExporter",
+				return ChangeAgentResponseValidationError{
+					field:  "QanMysqlSlowlogAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentResponse_ProxysqlExporter:
+	case *ChangeAgentResponse_QanMongodbProfilerAgent:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6313,37 +9816,37 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetProxysqlExporter()).(type) {
+			switch v := interface{}(m.GetQanMongodbProfilerAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "ProxysqlExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanMongodbProfilerAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "ProxysqlExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanMongodbProfilerAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetProxysqlExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetQanMongodbProfilerAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "ProxysqlExporter",
+				return ChangeAgentResponseValidationError{
+					field:  "QanMongodbProfilerAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentResponse_ExternalExporter:
+	case *ChangeAgentResponse_QanMongodbMongologAgent:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6354,37 +9857,37 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetExternalExporter()).(type) {
+			switch v := interface{}(m.GetQanMongodbMongologAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "ExternalExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanMongodbMongologAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "ExternalExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanMongodbMongologAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetExternalExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetQanMongodbMongologAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "ExternalExporter",
+				return ChangeAgentResponseValidationError{
+					field:  "QanMongodbMongologAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentResponse_RdsExporter:
+	case *ChangeAgentResponse_QanPostgresqlPgstatementsAgent:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6395,37 +9898,37 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetRdsExporter()).(type) {
+			switch v := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "RdsExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanPostgresqlPgstatementsAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "RdsExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanPostgresqlPgstatementsAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetRdsExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "RdsExporter",
+				return ChangeAgentResponseValidationError{
+					field:  "QanPostgresqlPgstatementsAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentResponse_AzureDatabaseExporter:
+	case *ChangeAgentResponse_QanPostgresqlPgstatmonitorAgent:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6436,37 +9939,37 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetAzureDatabaseExporter()).(type) {
+			switch v := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "AzureDatabaseExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanPostgresqlPgstatmonitorAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "AzureDatabaseExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanPostgresqlPgstatmonitorAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetAzureDatabaseExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "AzureDatabaseExporter",
+				return ChangeAgentResponseValidationError{
+					field:  "QanPostgresqlPgstatmonitorAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentResponse_QanMysqlPerfschemaAgent:
+	case *ChangeAgentResponse_NomadAgent:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6477,37 +9980,37 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetQanMysqlPerfschemaAgent()).(type) {
+			switch v := interface{}(m.GetNomadAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "QanMysqlPerfschemaAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "NomadAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "QanMysqlPerfschemaAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "NomadAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanMysqlPerfschemaAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetNomadAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "QanMysqlPerfschemaAgent",
+				return ChangeAgentResponseValidationError{
+					field:  "NomadAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentResponse_QanMysqlSlowlogAgent:
+	case *ChangeAgentResponse_ValkeyExporter:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6518,37 +10021,37 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetQanMysqlSlowlogAgent()).(type) {
+			switch v := interface{}(m.GetValkeyExporter()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "QanMysqlSlowlogAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "ValkeyExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "QanMysqlSlowlogAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "ValkeyExporter",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanMysqlSlowlogAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetValkeyExporter()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "QanMysqlSlowlogAgent",
+				return ChangeAgentResponseValidationError{
+					field:  "ValkeyExporter",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentResponse_QanMongodbProfilerAgent:
+	case *ChangeAgentResponse_RtaMongodbAgent:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6559,37 +10062,37 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetQanMongodbProfilerAgent()).(type) {
+			switch v := interface{}(m.GetRtaMongodbAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "QanMongodbProfilerAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "RtaMongodbAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "QanMongodbProfilerAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "RtaMongodbAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanMongodbProfilerAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetRtaMongodbAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "QanMongodbProfilerAgent",
+				return ChangeAgentResponseValidationError{
+					field:  "RtaMongodbAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentResponse_QanMongodbMongologAgent:
+	case *ChangeAgentResponse_RtaMysqlAgent:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6600,37 +10103,37 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetQanMongodbMongologAgent()).(type) {
+			switch v := interface{}(m.GetRtaMysqlAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "QanMongodbMongologAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "RtaMysqlAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "QanMongodbMongologAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "RtaMysqlAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanMongodbMongologAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetRtaMysqlAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "QanMongodbMongologAgent",
+				return ChangeAgentResponseValidationError{
+					field:  "RtaMysqlAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentResponse_QanPostgresqlPgstatementsAgent:
+	case *ChangeAgentResponse_RtaPostgresqlAgent:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6641,37 +10144,37 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(type) {
+			switch v := interface{}(m.GetRtaPostgresqlAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "QanPostgresqlPgstatementsAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "RtaPostgresqlAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "QanPostgresqlPgstatementsAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "RtaPostgresqlAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetRtaPostgresqlAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "QanPostgresqlPgstatementsAgent",
+				return ChangeAgentResponseValidationError{
+					field:  "RtaPostgresqlAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentResponse_QanPostgresqlPgstatmonitorAgent:
+	case *ChangeAgentResponse_QanValkeySlowlogAgent:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6682,37 +10185,37 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(type) {
+			switch v := interface{}(m.GetQanValkeySlowlogAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "QanPostgresqlPgstatmonitorAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanValkeySlowlogAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "QanPostgresqlPgstatmonitorAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanValkeySlowlogAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetQanValkeySlowlogAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "QanPostgresqlPgstatmonitorAgent",
+				return ChangeAgentResponseValidationError{
+					field:  "QanValkeySlowlogAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentResponse_ValkeyExporter:
+	case *ChangeAgentResponse_QanProxysqlDigestAgent:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6723,37 +10226,37 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetValkeyExporter()).(type) {
+			switch v := interface{}(m.GetQanProxysqlDigestAgent()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "ValkeyExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanProxysqlDigestAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "ValkeyExporter",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanProxysqlDigestAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetValkeyExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetQanProxysqlDigestAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "ValkeyExporter",
+				return ChangeAgentResponseValidationError{
+					field:  "QanProxysqlDigestAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
 
-	case *AddAgentResponse_RtaMongodbAgent:
+	case *ChangeAgentResponse_QanPostgresqlLogAgent:
 		if v == nil {
-			err := AddAgentResponseValidationError{
+			err := ChangeAgentResponseValidationError{
 				field:  "Agent",
 				reason: "oneof value cannot be a typed-nil",
 			}
@@ -6764,28 +10267,28 @@ func (m *AddAgentResponse) validate(all bool) error {
 		}
 
 		if all {
-			switch v := interface{}(m.GetRtaMongodbAgent()).(type) {
+			switch v := interface{}(m.GetQanPostgresqlLogAgent()).(type) {
 			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "RtaMongodbAgent",
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanPostgresqlLogAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, AddAgentResponseValidationError{
-						field:  "RtaMongodbAgent",
+					errors = append(errors, ChangeAgentResponseValidationError{
+						field:  "QanPostgresqlLogAgent",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetRtaMongodbAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetQanPostgresqlLogAgent()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return AddAgentResponseValidationError{
-					field:  "RtaMongodbAgent",
+				return ChangeAgentResponseValidationError{
+					field:  "QanPostgresqlLogAgent",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -6797,19 +10300,257 @@ func (m *AddAgentResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AddAgentResponseMultiError(errors)
+		return ChangeAgentResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddAgentResponseMultiError is an error wrapping multiple validation errors
-// returned by AddAgentResponse.ValidateAll() if the designated constraints
+// ChangeAgentResponseMultiError is an error wrapping multiple validation
+// errors returned by ChangeAgentResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeAgentResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeAgentResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeAgentResponseMultiError) AllErrors() []error { return m }
+
+// ChangeAgentResponseValidationError is the validation error returned by
+// ChangeAgentResponse.Validate if the designated constraints aren't met.
+type ChangeAgentResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeAgentResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeAgentResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeAgentResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeAgentResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeAgentResponseValidationError) ErrorName() string {
+	return "ChangeAgentResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeAgentResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeAgentResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ChangeAgentResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeAgentResponseValidationError{}
+
+// Validate checks the field values on AddPMMAgentParams with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AddPMMAgentParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddPMMAgentParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddPMMAgentParamsMultiError, or nil if none found.
+func (m *AddPMMAgentParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddPMMAgentParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetRunsOnNodeId()) < 1 {
+		err := AddPMMAgentParamsValidationError{
+			field:  "RunsOnNodeId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CustomLabels
+
+	if len(errors) > 0 {
+		return AddPMMAgentParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddPMMAgentParamsMultiError is an error wrapping multiple validation errors
+// returned by AddPMMAgentParams.ValidateAll() if the designated constraints
 // aren't met.
-type AddAgentResponseMultiError []error
+type AddPMMAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddAgentResponseMultiError) Error() string {
+func (m AddPMMAgentParamsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddPMMAgentParamsMultiError) AllErrors() []error { return m }
+
+// AddPMMAgentParamsValidationError is the validation error returned by
+// AddPMMAgentParams.Validate if the designated constraints aren't met.
+type AddPMMAgentParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddPMMAgentParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddPMMAgentParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddPMMAgentParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddPMMAgentParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddPMMAgentParamsValidationError) ErrorName() string {
+	return "AddPMMAgentParamsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddPMMAgentParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddPMMAgentParams.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = AddPMMAgentParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddPMMAgentParamsValidationError{}
+
+// Validate checks the field values on AddNodeExporterParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddNodeExporterParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddNodeExporterParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddNodeExporterParamsMultiError, or nil if none found.
+func (m *AddNodeExporterParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddNodeExporterParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
+		err := AddNodeExporterParamsValidationError{
+			field:  "PmmAgentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for CustomLabels
+
+	// no validation rules for PushMetrics
+
+	// no validation rules for LogLevel
+
+	// no validation rules for ExposeExporter
+
+	if len(errors) > 0 {
+		return AddNodeExporterParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddNodeExporterParamsMultiError is an error wrapping multiple validation
+// errors returned by AddNodeExporterParams.ValidateAll() if the designated
+// constraints aren't met.
+type AddNodeExporterParamsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddNodeExporterParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6818,11 +10559,11 @@ func (m AddAgentResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddAgentResponseMultiError) AllErrors() []error { return m }
+func (m AddNodeExporterParamsMultiError) AllErrors() []error { return m }
 
-// AddAgentResponseValidationError is the validation error returned by
-// AddAgentResponse.Validate if the designated constraints aren't met.
-type AddAgentResponseValidationError struct {
+// AddNodeExporterParamsValidationError is the validation error returned by
+// AddNodeExporterParams.Validate if the designated constraints aren't met.
+type AddNodeExporterParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6830,22 +10571,24 @@ type AddAgentResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddAgentResponseValidationError) Field() string { return e.field }
+func (e AddNodeExporterParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddAgentResponseValidationError) Reason() string { return e.reason }
+func (e AddNodeExporterParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddAgentResponseValidationError) Cause() error { return e.cause }
+func (e AddNodeExporterParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddAgentResponseValidationError) Key() bool { return e.key }
+func (e AddNodeExporterParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddAgentResponseValidationError) ErrorName() string { return "AddAgentResponseValidationError" }
+func (e AddNodeExporterParamsValidationError) ErrorName() string {
+	return "AddNodeExporterParamsValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AddAgentResponseValidationError) Error() string {
+func (e AddNodeExporterParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6857,7 +10600,7 @@ func (e AddAgentResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddAgentResponse.%s: %s%s",
+		"invalid %sAddNodeExporterParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -6865,7 +10608,7 @@ func (e AddAgentResponseValidationError) Error() string {
 	)
 }
 
-var _ error = AddAgentResponseValidationError{}
+var _ error = AddNodeExporterParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -6873,757 +10616,754 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddAgentResponseValidationError{}
+} = AddNodeExporterParamsValidationError{}
 
-// Validate checks the field values on ChangeAgentRequest with the rules
+// Validate checks the field values on ChangeNodeExporterParams with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChangeAgentRequest) Validate() error {
+func (m *ChangeNodeExporterParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeAgentRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ChangeAgentRequestMultiError, or nil if none found.
-func (m *ChangeAgentRequest) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *ChangeAgentRequest) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if utf8.RuneCountInString(m.GetAgentId()) < 1 {
-		err := ChangeAgentRequestValidationError{
-			field:  "AgentId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	switch v := m.Agent.(type) {
-	case *ChangeAgentRequest_NodeExporter:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetNodeExporter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "NodeExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "NodeExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetNodeExporter()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "NodeExporter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *ChangeAgentRequest_MysqldExporter:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetMysqldExporter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "MysqldExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "MysqldExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetMysqldExporter()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "MysqldExporter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *ChangeAgentRequest_MongodbExporter:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetMongodbExporter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "MongodbExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "MongodbExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetMongodbExporter()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "MongodbExporter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *ChangeAgentRequest_PostgresExporter:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetPostgresExporter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "PostgresExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "PostgresExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetPostgresExporter()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "PostgresExporter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *ChangeAgentRequest_ProxysqlExporter:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetProxysqlExporter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "ProxysqlExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "ProxysqlExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+// ValidateAll checks the field values on ChangeNodeExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeNodeExporterParamsMultiError, or nil if none found.
+func (m *ChangeNodeExporterParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeNodeExporterParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetricsResolutions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangeNodeExporterParamsValidationError{
+					field:  "MetricsResolutions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetProxysqlExporter()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "ProxysqlExporter",
+				errors = append(errors, ChangeNodeExporterParamsValidationError{
+					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
 			}
 		}
-
-	case *ChangeAgentRequest_ExternalExporter:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
+	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangeNodeExporterParamsValidationError{
+				field:  "MetricsResolutions",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-			errors = append(errors, err)
 		}
+	}
+
+	if m.Enable != nil {
+		// no validation rules for Enable
+	}
 
+	if m.CustomLabels != nil {
 		if all {
-			switch v := interface{}(m.GetExternalExporter()).(type) {
+			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "ExternalExporter",
+					errors = append(errors, ChangeNodeExporterParamsValidationError{
+						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "ExternalExporter",
+					errors = append(errors, ChangeNodeExporterParamsValidationError{
+						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetExternalExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "ExternalExporter",
+				return ChangeNodeExporterParamsValidationError{
+					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
+	}
 
-	case *ChangeAgentRequest_RdsExporter:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
+	if m.EnablePushMetrics != nil {
+		// no validation rules for EnablePushMetrics
+	}
+
+	if m.LogLevel != nil {
+		// no validation rules for LogLevel
+	}
+
+	if m.ExposeExporter != nil {
+		// no validation rules for ExposeExporter
+	}
+
+	if len(errors) > 0 {
+		return ChangeNodeExporterParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeNodeExporterParamsMultiError is an error wrapping multiple validation
+// errors returned by ChangeNodeExporterParams.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeNodeExporterParamsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeNodeExporterParamsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeNodeExporterParamsMultiError) AllErrors() []error { return m }
+
+// ChangeNodeExporterParamsValidationError is the validation error returned by
+// ChangeNodeExporterParams.Validate if the designated constraints aren't met.
+type ChangeNodeExporterParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeNodeExporterParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeNodeExporterParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeNodeExporterParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeNodeExporterParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeNodeExporterParamsValidationError) ErrorName() string {
+	return "ChangeNodeExporterParamsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeNodeExporterParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeNodeExporterParams.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ChangeNodeExporterParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeNodeExporterParamsValidationError{}
+
+// Validate checks the field values on AddMySQLdExporterParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddMySQLdExporterParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddMySQLdExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddMySQLdExporterParamsMultiError, or nil if none found.
+func (m *AddMySQLdExporterParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddMySQLdExporterParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
+		err := AddMySQLdExporterParamsValidationError{
+			field:  "PmmAgentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
+		err := AddMySQLdExporterParamsValidationError{
+			field:  "ServiceId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := AddMySQLdExporterParamsValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Password
+
+	// no validation rules for Tls
+
+	// no validation rules for TlsSkipVerify
+
+	// no validation rules for TlsCa
+
+	// no validation rules for TlsCert
+
+	// no validation rules for TlsKey
+
+	// no validation rules for TablestatsGroupTableLimit
+
+	// no validation rules for CustomLabels
+
+	// no validation rules for SkipConnectionCheck
+
+	// no validation rules for PushMetrics
+
+	// no validation rules for AgentPassword
+
+	// no validation rules for LogLevel
+
+	// no validation rules for ExposeExporter
+
+	// no validation rules for ExtraDsnParams
+
+	if d := m.GetConnectionTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = AddMySQLdExporterParamsValidationError{
+				field:  "ConnectionTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
 			}
 			if !all {
 				return err
 			}
 			errors = append(errors, err)
-		}
+		} else {
 
-		if all {
-			switch v := interface{}(m.GetRdsExporter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "RdsExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "RdsExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := AddMySQLdExporterParamsValidationError{
+					field:  "ConnectionTimeout",
+					reason: "value must be greater than or equal to 0s",
 				}
-			}
-		} else if v, ok := interface{}(m.GetRdsExporter()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "RdsExporter",
-					reason: "embedded message failed validation",
-					cause:  err,
+				if !all {
+					return err
 				}
+				errors = append(errors, err)
 			}
-		}
 
-	case *ChangeAgentRequest_AzureDatabaseExporter:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
 		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetAzureDatabaseExporter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "AzureDatabaseExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "AzureDatabaseExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetAzureDatabaseExporter()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "AzureDatabaseExporter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	if len(errors) > 0 {
+		return AddMySQLdExporterParamsMultiError(errors)
+	}
 
-	case *ChangeAgentRequest_QanMysqlPerfschemaAgent:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	return nil
+}
 
-		if all {
-			switch v := interface{}(m.GetQanMysqlPerfschemaAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "QanMysqlPerfschemaAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "QanMysqlPerfschemaAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetQanMysqlPerfschemaAgent()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "QanMysqlPerfschemaAgent",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+// AddMySQLdExporterParamsMultiError is an error wrapping multiple validation
+// errors returned by AddMySQLdExporterParams.ValidateAll() if the designated
+// constraints aren't met.
+type AddMySQLdExporterParamsMultiError []error
 
-	case *ChangeAgentRequest_QanMysqlSlowlogAgent:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddMySQLdExporterParamsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-		if all {
-			switch v := interface{}(m.GetQanMysqlSlowlogAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "QanMysqlSlowlogAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "QanMysqlSlowlogAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+// AllErrors returns a list of validation violation errors.
+func (m AddMySQLdExporterParamsMultiError) AllErrors() []error { return m }
+
+// AddMySQLdExporterParamsValidationError is the validation error returned by
+// AddMySQLdExporterParams.Validate if the designated constraints aren't met.
+type AddMySQLdExporterParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddMySQLdExporterParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddMySQLdExporterParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddMySQLdExporterParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddMySQLdExporterParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddMySQLdExporterParamsValidationError) ErrorName() string {
+	return "AddMySQLdExporterParamsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddMySQLdExporterParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddMySQLdExporterParams.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = AddMySQLdExporterParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddMySQLdExporterParamsValidationError{}
+
+// Validate checks the field values on ChangeMySQLdExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeMySQLdExporterParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeMySQLdExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeMySQLdExporterParamsMultiError, or nil if none found.
+func (m *ChangeMySQLdExporterParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeMySQLdExporterParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetricsResolutions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangeMySQLdExporterParamsValidationError{
+					field:  "MetricsResolutions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetQanMysqlSlowlogAgent()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "QanMysqlSlowlogAgent",
+				errors = append(errors, ChangeMySQLdExporterParamsValidationError{
+					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangeMySQLdExporterParamsValidationError{
+				field:  "MetricsResolutions",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
-	case *ChangeAgentRequest_QanMongodbProfilerAgent:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
+	if d := m.GetConnectionTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ChangeMySQLdExporterParamsValidationError{
+				field:  "ConnectionTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
 			}
 			if !all {
 				return err
 			}
 			errors = append(errors, err)
-		}
+		} else {
 
-		if all {
-			switch v := interface{}(m.GetQanMongodbProfilerAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "QanMongodbProfilerAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "QanMongodbProfilerAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := ChangeMySQLdExporterParamsValidationError{
+					field:  "ConnectionTimeout",
+					reason: "value must be greater than or equal to 0s",
 				}
-			}
-		} else if v, ok := interface{}(m.GetQanMongodbProfilerAgent()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "QanMongodbProfilerAgent",
-					reason: "embedded message failed validation",
-					cause:  err,
+				if !all {
+					return err
 				}
+				errors = append(errors, err)
 			}
-		}
 
-	case *ChangeAgentRequest_QanMongodbMongologAgent:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
 		}
+	}
 
+	if m.Enable != nil {
+		// no validation rules for Enable
+	}
+
+	if m.CustomLabels != nil {
 		if all {
-			switch v := interface{}(m.GetQanMongodbMongologAgent()).(type) {
+			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "QanMongodbMongologAgent",
+					errors = append(errors, ChangeMySQLdExporterParamsValidationError{
+						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "QanMongodbMongologAgent",
+					errors = append(errors, ChangeMySQLdExporterParamsValidationError{
+						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanMongodbMongologAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "QanMongodbMongologAgent",
+				return ChangeMySQLdExporterParamsValidationError{
+					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
+	}
+
+	if m.EnablePushMetrics != nil {
+		// no validation rules for EnablePushMetrics
+	}
+
+	if m.Username != nil {
+		// no validation rules for Username
+	}
+
+	if m.Password != nil {
+		// no validation rules for Password
+	}
+
+	if m.Tls != nil {
+		// no validation rules for Tls
+	}
+
+	if m.TlsSkipVerify != nil {
+		// no validation rules for TlsSkipVerify
+	}
+
+	if m.TlsCa != nil {
+		// no validation rules for TlsCa
+	}
+
+	if m.TlsCert != nil {
+		// no validation rules for TlsCert
+	}
+
+	if m.TlsKey != nil {
+		// no validation rules for TlsKey
+	}
+
+	if m.TablestatsGroupTableLimit != nil {
+		// no validation rules for TablestatsGroupTableLimit
+	}
+
+	if m.SkipConnectionCheck != nil {
+		// no validation rules for SkipConnectionCheck
+	}
+
+	if m.AgentPassword != nil {
+		// no validation rules for AgentPassword
+	}
+
+	if m.LogLevel != nil {
+		// no validation rules for LogLevel
+	}
+
+	if m.ExposeExporter != nil {
+		// no validation rules for ExposeExporter
+	}
+
+	if len(errors) > 0 {
+		return ChangeMySQLdExporterParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeMySQLdExporterParamsMultiError is an error wrapping multiple
+// validation errors returned by ChangeMySQLdExporterParams.ValidateAll() if
+// the designated constraints aren't met.
+type ChangeMySQLdExporterParamsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeMySQLdExporterParamsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeMySQLdExporterParamsMultiError) AllErrors() []error { return m }
+
+// ChangeMySQLdExporterParamsValidationError is the validation error returned
+// by ChangeMySQLdExporterParams.Validate if the designated constraints aren't met.
+type ChangeMySQLdExporterParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeMySQLdExporterParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeMySQLdExporterParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeMySQLdExporterParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeMySQLdExporterParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeMySQLdExporterParamsValidationError) ErrorName() string {
+	return "ChangeMySQLdExporterParamsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeMySQLdExporterParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeMySQLdExporterParams.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ChangeMySQLdExporterParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeMySQLdExporterParamsValidationError{}
+
+// Validate checks the field values on AddMongoDBExporterParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddMongoDBExporterParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddMongoDBExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddMongoDBExporterParamsMultiError, or nil if none found.
+func (m *AddMongoDBExporterParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddMongoDBExporterParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
+		err := AddMongoDBExporterParamsValidationError{
+			field:  "PmmAgentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	case *ChangeAgentRequest_QanPostgresqlPgstatementsAgent:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
+		err := AddMongoDBExporterParamsValidationError{
+			field:  "ServiceId",
+			reason: "value length must be at least 1 runes",
 		}
-
-		if all {
-			switch v := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "QanPostgresqlPgstatementsAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "QanPostgresqlPgstatementsAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "QanPostgresqlPgstatementsAgent",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-	case *ChangeAgentRequest_QanPostgresqlPgstatmonitorAgent:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for Username
 
-		if all {
-			switch v := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "QanPostgresqlPgstatmonitorAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "QanPostgresqlPgstatmonitorAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "QanPostgresqlPgstatmonitorAgent",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for Password
 
-	case *ChangeAgentRequest_NomadAgent:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for Tls
 
-		if all {
-			switch v := interface{}(m.GetNomadAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "NomadAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "NomadAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetNomadAgent()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "NomadAgent",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for TlsSkipVerify
 
-	case *ChangeAgentRequest_ValkeyExporter:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+	// no validation rules for TlsCertificateKey
 
-		if all {
-			switch v := interface{}(m.GetValkeyExporter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "ValkeyExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "ValkeyExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetValkeyExporter()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "ValkeyExporter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	// no validation rules for TlsCertificateKeyFilePassword
 
-	case *ChangeAgentRequest_RtaMongodbAgent:
-		if v == nil {
-			err := ChangeAgentRequestValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
+	// no validation rules for TlsCa
+
+	// no validation rules for CustomLabels
+
+	// no validation rules for SkipConnectionCheck
+
+	// no validation rules for PushMetrics
+
+	// no validation rules for AuthenticationMechanism
+
+	// no validation rules for AuthenticationDatabase
+
+	// no validation rules for AgentPassword
+
+	// no validation rules for CollectionsLimit
+
+	// no validation rules for LogLevel
+
+	// no validation rules for ExposeExporter
+
+	// no validation rules for EnableAllCollectors
+
+	if d := m.GetConnectionTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = AddMongoDBExporterParamsValidationError{
+				field:  "ConnectionTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
 			}
 			if !all {
 				return err
 			}
 			errors = append(errors, err)
-		}
+		} else {
 
-		if all {
-			switch v := interface{}(m.GetRtaMongodbAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "RtaMongodbAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentRequestValidationError{
-						field:  "RtaMongodbAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := AddMongoDBExporterParamsValidationError{
+					field:  "ConnectionTimeout",
+					reason: "value must be greater than or equal to 0s",
 				}
-			}
-		} else if v, ok := interface{}(m.GetRtaMongodbAgent()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentRequestValidationError{
-					field:  "RtaMongodbAgent",
-					reason: "embedded message failed validation",
-					cause:  err,
+				if !all {
+					return err
 				}
+				errors = append(errors, err)
 			}
-		}
 
-	default:
-		_ = v // ensures v is used
+		}
 	}
 
+	// no validation rules for EnableDiagnosticDataHistograms
+
 	if len(errors) > 0 {
-		return ChangeAgentRequestMultiError(errors)
+		return AddMongoDBExporterParamsMultiError(errors)
 	}
 
 	return nil
-}
-
-// ChangeAgentRequestMultiError is an error wrapping multiple validation errors
-// returned by ChangeAgentRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ChangeAgentRequestMultiError []error
+}
+
+// AddMongoDBExporterParamsMultiError is an error wrapping multiple validation
+// errors returned by AddMongoDBExporterParams.ValidateAll() if the designated
+// constraints aren't met.
+type AddMongoDBExporterParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeAgentRequestMultiError) Error() string {
+func (m AddMongoDBExporterParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -7632,11 +11372,11 @@ func (m ChangeAgentRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeAgentRequestMultiError) AllErrors() []error { return m }
+func (m AddMongoDBExporterParamsMultiError) AllErrors() []error { return m }
 
-// ChangeAgentRequestValidationError is the validation error returned by
-// ChangeAgentRequest.Validate if the designated constraints aren't met.
-type ChangeAgentRequestValidationError struct {
+// AddMongoDBExporterParamsValidationError is the validation error returned by
+// AddMongoDBExporterParams.Validate if the designated constraints aren't met.
+type AddMongoDBExporterParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -7644,24 +11384,24 @@ type ChangeAgentRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeAgentRequestValidationError) Field() string { return e.field }
+func (e AddMongoDBExporterParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeAgentRequestValidationError) Reason() string { return e.reason }
+func (e AddMongoDBExporterParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeAgentRequestValidationError) Cause() error { return e.cause }
+func (e AddMongoDBExporterParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeAgentRequestValidationError) Key() bool { return e.key }
+func (e AddMongoDBExporterParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeAgentRequestValidationError) ErrorName() string {
-	return "ChangeAgentRequestValidationError"
+func (e AddMongoDBExporterParamsValidationError) ErrorName() string {
+	return "AddMongoDBExporterParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangeAgentRequestValidationError) Error() string {
+func (e AddMongoDBExporterParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -7673,7 +11413,7 @@ func (e ChangeAgentRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeAgentRequest.%s: %s%s",
+		"invalid %sAddMongoDBExporterParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -7681,7 +11421,7 @@ func (e ChangeAgentRequestValidationError) Error() string {
 	)
 }
 
-var _ error = ChangeAgentRequestValidationError{}
+var _ error = AddMongoDBExporterParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -7689,746 +11429,1237 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeAgentRequestValidationError{}
+} = AddMongoDBExporterParamsValidationError{}
 
-// Validate checks the field values on ChangeAgentResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ChangeMongoDBExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChangeAgentResponse) Validate() error {
+func (m *ChangeMongoDBExporterParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeAgentResponse with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ChangeMongoDBExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ChangeAgentResponseMultiError, or nil if none found.
-func (m *ChangeAgentResponse) ValidateAll() error {
+// ChangeMongoDBExporterParamsMultiError, or nil if none found.
+func (m *ChangeMongoDBExporterParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeAgentResponse) validate(all bool) error {
+func (m *ChangeMongoDBExporterParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	switch v := m.Agent.(type) {
-	case *ChangeAgentResponse_NodeExporter:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetNodeExporter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "NodeExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "NodeExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetNodeExporter()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "NodeExporter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *ChangeAgentResponse_MysqldExporter:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetMysqldExporter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "MysqldExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "MysqldExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetMysqldExporter()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "MysqldExporter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *ChangeAgentResponse_MongodbExporter:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetMongodbExporter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "MongodbExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "MongodbExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetMongodbExporter()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "MongodbExporter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	case *ChangeAgentResponse_PostgresExporter:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetPostgresExporter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "PostgresExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "PostgresExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetPostgresExporter()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "PostgresExporter",
+	if all {
+		switch v := interface{}(m.GetMetricsResolutions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangeMongoDBExporterParamsValidationError{
+					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
-			}
-		}
-
-	case *ChangeAgentResponse_ProxysqlExporter:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
-
-		if all {
-			switch v := interface{}(m.GetProxysqlExporter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "ProxysqlExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "ProxysqlExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+				})
 			}
-		} else if v, ok := interface{}(m.GetProxysqlExporter()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "ProxysqlExporter",
+				errors = append(errors, ChangeMongoDBExporterParamsValidationError{
+					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangeMongoDBExporterParamsValidationError{
+				field:  "MetricsResolutions",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
-	case *ChangeAgentResponse_ExternalExporter:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
+	if d := m.GetConnectionTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ChangeMongoDBExporterParamsValidationError{
+				field:  "ConnectionTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
 			}
 			if !all {
 				return err
 			}
 			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := ChangeMongoDBExporterParamsValidationError{
+					field:  "ConnectionTimeout",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
 		}
+	}
+
+	if m.Enable != nil {
+		// no validation rules for Enable
+	}
 
+	if m.CustomLabels != nil {
 		if all {
-			switch v := interface{}(m.GetExternalExporter()).(type) {
+			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "ExternalExporter",
+					errors = append(errors, ChangeMongoDBExporterParamsValidationError{
+						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "ExternalExporter",
+					errors = append(errors, ChangeMongoDBExporterParamsValidationError{
+						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetExternalExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "ExternalExporter",
+				return ChangeMongoDBExporterParamsValidationError{
+					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
+	}
 
-	case *ChangeAgentResponse_RdsExporter:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if m.EnablePushMetrics != nil {
+		// no validation rules for EnablePushMetrics
+	}
+
+	if m.Username != nil {
+		// no validation rules for Username
+	}
+
+	if m.Password != nil {
+		// no validation rules for Password
+	}
+
+	if m.Tls != nil {
+		// no validation rules for Tls
+	}
+
+	if m.TlsSkipVerify != nil {
+		// no validation rules for TlsSkipVerify
+	}
+
+	if m.TlsCertificateKey != nil {
+		// no validation rules for TlsCertificateKey
+	}
+
+	if m.TlsCertificateKeyFilePassword != nil {
+		// no validation rules for TlsCertificateKeyFilePassword
+	}
+
+	if m.TlsCa != nil {
+		// no validation rules for TlsCa
+	}
+
+	if m.SkipConnectionCheck != nil {
+		// no validation rules for SkipConnectionCheck
+	}
+
+	if m.AuthenticationMechanism != nil {
+		// no validation rules for AuthenticationMechanism
+	}
+
+	if m.AuthenticationDatabase != nil {
+		// no validation rules for AuthenticationDatabase
+	}
+
+	if m.AgentPassword != nil {
+		// no validation rules for AgentPassword
+	}
+
+	if m.CollectionsLimit != nil {
+		// no validation rules for CollectionsLimit
+	}
+
+	if m.EnableAllCollectors != nil {
+		// no validation rules for EnableAllCollectors
+	}
+
+	if m.LogLevel != nil {
+		// no validation rules for LogLevel
+	}
+
+	if m.ExposeExporter != nil {
+		// no validation rules for ExposeExporter
+	}
+
+	if m.EnableDiagnosticDataHistograms != nil {
+		// no validation rules for EnableDiagnosticDataHistograms
+	}
+
+	if len(errors) > 0 {
+		return ChangeMongoDBExporterParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeMongoDBExporterParamsMultiError is an error wrapping multiple
+// validation errors returned by ChangeMongoDBExporterParams.ValidateAll() if
+// the designated constraints aren't met.
+type ChangeMongoDBExporterParamsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeMongoDBExporterParamsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeMongoDBExporterParamsMultiError) AllErrors() []error { return m }
+
+// ChangeMongoDBExporterParamsValidationError is the validation error returned
+// by ChangeMongoDBExporterParams.Validate if the designated constraints
+// aren't met.
+type ChangeMongoDBExporterParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeMongoDBExporterParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeMongoDBExporterParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeMongoDBExporterParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeMongoDBExporterParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeMongoDBExporterParamsValidationError) ErrorName() string {
+	return "ChangeMongoDBExporterParamsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeMongoDBExporterParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeMongoDBExporterParams.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ChangeMongoDBExporterParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeMongoDBExporterParamsValidationError{}
+
+// Validate checks the field values on AddPostgresExporterParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddPostgresExporterParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddPostgresExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddPostgresExporterParamsMultiError, or nil if none found.
+func (m *AddPostgresExporterParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddPostgresExporterParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
+		err := AddPostgresExporterParamsValidationError{
+			field:  "PmmAgentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
+		err := AddPostgresExporterParamsValidationError{
+			field:  "ServiceId",
+			reason: "value length must be at least 1 runes",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(m.GetRdsExporter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "RdsExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "RdsExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetRdsExporter()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "RdsExporter",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := AddPostgresExporterParamsValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
 		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	case *ChangeAgentResponse_AzureDatabaseExporter:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
+	// no validation rules for Password
+
+	// no validation rules for Tls
+
+	// no validation rules for TlsSkipVerify
+
+	// no validation rules for CustomLabels
+
+	// no validation rules for SkipConnectionCheck
+
+	// no validation rules for PushMetrics
+
+	// no validation rules for TlsCa
+
+	// no validation rules for TlsCert
+
+	// no validation rules for TlsKey
+
+	// no validation rules for AgentPassword
+
+	// no validation rules for LogLevel
+
+	// no validation rules for AutoDiscoveryLimit
+
+	// no validation rules for ExposeExporter
+
+	// no validation rules for MaxExporterConnections
+
+	if d := m.GetConnectionTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = AddPostgresExporterParamsValidationError{
+				field:  "ConnectionTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
 			}
 			if !all {
 				return err
 			}
 			errors = append(errors, err)
-		}
+		} else {
 
-		if all {
-			switch v := interface{}(m.GetAzureDatabaseExporter()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "AzureDatabaseExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "AzureDatabaseExporter",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := AddPostgresExporterParamsValidationError{
+					field:  "ConnectionTimeout",
+					reason: "value must be greater than or equal to 0s",
 				}
-			}
-		} else if v, ok := interface{}(m.GetAzureDatabaseExporter()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "AzureDatabaseExporter",
-					reason: "embedded message failed validation",
-					cause:  err,
+				if !all {
+					return err
 				}
+				errors = append(errors, err)
 			}
-		}
 
-	case *ChangeAgentResponse_QanMysqlPerfschemaAgent:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
 		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetQanMysqlPerfschemaAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "QanMysqlPerfschemaAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "QanMysqlPerfschemaAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if len(errors) > 0 {
+		return AddPostgresExporterParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddPostgresExporterParamsMultiError is an error wrapping multiple validation
+// errors returned by AddPostgresExporterParams.ValidateAll() if the
+// designated constraints aren't met.
+type AddPostgresExporterParamsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddPostgresExporterParamsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddPostgresExporterParamsMultiError) AllErrors() []error { return m }
+
+// AddPostgresExporterParamsValidationError is the validation error returned by
+// AddPostgresExporterParams.Validate if the designated constraints aren't met.
+type AddPostgresExporterParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddPostgresExporterParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddPostgresExporterParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddPostgresExporterParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddPostgresExporterParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddPostgresExporterParamsValidationError) ErrorName() string {
+	return "AddPostgresExporterParamsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddPostgresExporterParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddPostgresExporterParams.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = AddPostgresExporterParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddPostgresExporterParamsValidationError{}
+
+// Validate checks the field values on ChangePostgresExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangePostgresExporterParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangePostgresExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangePostgresExporterParamsMultiError, or nil if none found.
+func (m *ChangePostgresExporterParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangePostgresExporterParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetricsResolutions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangePostgresExporterParamsValidationError{
+					field:  "MetricsResolutions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetQanMysqlPerfschemaAgent()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "QanMysqlPerfschemaAgent",
+				errors = append(errors, ChangePostgresExporterParamsValidationError{
+					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangePostgresExporterParamsValidationError{
+				field:  "MetricsResolutions",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
-	case *ChangeAgentResponse_QanMysqlSlowlogAgent:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
+	if d := m.GetConnectionTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ChangePostgresExporterParamsValidationError{
+				field:  "ConnectionTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
 			}
 			if !all {
 				return err
 			}
 			errors = append(errors, err)
-		}
+		} else {
 
-		if all {
-			switch v := interface{}(m.GetQanMysqlSlowlogAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "QanMysqlSlowlogAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "QanMysqlSlowlogAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := ChangePostgresExporterParamsValidationError{
+					field:  "ConnectionTimeout",
+					reason: "value must be greater than or equal to 0s",
 				}
-			}
-		} else if v, ok := interface{}(m.GetQanMysqlSlowlogAgent()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "QanMysqlSlowlogAgent",
-					reason: "embedded message failed validation",
-					cause:  err,
+				if !all {
+					return err
 				}
+				errors = append(errors, err)
 			}
-		}
 
-	case *ChangeAgentResponse_QanMongodbProfilerAgent:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
 		}
+	}
+
+	if m.Enable != nil {
+		// no validation rules for Enable
+	}
 
+	if m.CustomLabels != nil {
 		if all {
-			switch v := interface{}(m.GetQanMongodbProfilerAgent()).(type) {
+			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "QanMongodbProfilerAgent",
+					errors = append(errors, ChangePostgresExporterParamsValidationError{
+						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "QanMongodbProfilerAgent",
+					errors = append(errors, ChangePostgresExporterParamsValidationError{
+						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetQanMongodbProfilerAgent()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "QanMongodbProfilerAgent",
+				return ChangePostgresExporterParamsValidationError{
+					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
+	}
+
+	if m.EnablePushMetrics != nil {
+		// no validation rules for EnablePushMetrics
+	}
+
+	if m.Username != nil {
+		// no validation rules for Username
+	}
+
+	if m.Password != nil {
+		// no validation rules for Password
+	}
+
+	if m.Tls != nil {
+		// no validation rules for Tls
+	}
+
+	if m.TlsSkipVerify != nil {
+		// no validation rules for TlsSkipVerify
+	}
+
+	if m.SkipConnectionCheck != nil {
+		// no validation rules for SkipConnectionCheck
+	}
+
+	if m.TlsCa != nil {
+		// no validation rules for TlsCa
+	}
+
+	if m.TlsCert != nil {
+		// no validation rules for TlsCert
+	}
+
+	if m.TlsKey != nil {
+		// no validation rules for TlsKey
+	}
+
+	if m.AgentPassword != nil {
+		// no validation rules for AgentPassword
+	}
+
+	if m.LogLevel != nil {
+		// no validation rules for LogLevel
+	}
+
+	if m.AutoDiscoveryLimit != nil {
+		// no validation rules for AutoDiscoveryLimit
+	}
+
+	if m.ExposeExporter != nil {
+		// no validation rules for ExposeExporter
+	}
+
+	if m.MaxExporterConnections != nil {
+		// no validation rules for MaxExporterConnections
+	}
+
+	if len(errors) > 0 {
+		return ChangePostgresExporterParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangePostgresExporterParamsMultiError is an error wrapping multiple
+// validation errors returned by ChangePostgresExporterParams.ValidateAll() if
+// the designated constraints aren't met.
+type ChangePostgresExporterParamsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangePostgresExporterParamsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangePostgresExporterParamsMultiError) AllErrors() []error { return m }
+
+// ChangePostgresExporterParamsValidationError is the validation error returned
+// by ChangePostgresExporterParams.Validate if the designated constraints
+// aren't met.
+type ChangePostgresExporterParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangePostgresExporterParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangePostgresExporterParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangePostgresExporterParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangePostgresExporterParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangePostgresExporterParamsValidationError) ErrorName() string {
+	return "ChangePostgresExporterParamsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangePostgresExporterParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangePostgresExporterParams.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ChangePostgresExporterParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangePostgresExporterParamsValidationError{}
+
+// Validate checks the field values on AddProxySQLExporterParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddProxySQLExporterParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddProxySQLExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddProxySQLExporterParamsMultiError, or nil if none found.
+func (m *AddProxySQLExporterParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AddProxySQLExporterParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
+		err := AddProxySQLExporterParamsValidationError{
+			field:  "PmmAgentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	case *ChangeAgentResponse_QanMongodbMongologAgent:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
+		err := AddProxySQLExporterParamsValidationError{
+			field:  "ServiceId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(m.GetQanMongodbMongologAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "QanMongodbMongologAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "QanMongodbMongologAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetQanMongodbMongologAgent()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "QanMongodbMongologAgent",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := AddProxySQLExporterParamsValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-	case *ChangeAgentResponse_QanPostgresqlPgstatementsAgent:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
+	// no validation rules for Password
+
+	// no validation rules for Tls
+
+	// no validation rules for TlsSkipVerify
+
+	// no validation rules for CustomLabels
+
+	// no validation rules for SkipConnectionCheck
+
+	// no validation rules for PushMetrics
+
+	// no validation rules for AgentPassword
+
+	// no validation rules for LogLevel
+
+	// no validation rules for ExposeExporter
+
+	if d := m.GetConnectionTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = AddProxySQLExporterParamsValidationError{
+				field:  "ConnectionTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
 			}
 			if !all {
 				return err
 			}
 			errors = append(errors, err)
-		}
+		} else {
 
-		if all {
-			switch v := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "QanPostgresqlPgstatementsAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "QanPostgresqlPgstatementsAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := AddProxySQLExporterParamsValidationError{
+					field:  "ConnectionTimeout",
+					reason: "value must be greater than or equal to 0s",
 				}
-			}
-		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatementsAgent()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "QanPostgresqlPgstatementsAgent",
-					reason: "embedded message failed validation",
-					cause:  err,
+				if !all {
+					return err
 				}
+				errors = append(errors, err)
 			}
-		}
 
-	case *ChangeAgentResponse_QanPostgresqlPgstatmonitorAgent:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
 		}
+	}
 
-		if all {
-			switch v := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "QanPostgresqlPgstatmonitorAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "QanPostgresqlPgstatmonitorAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if len(errors) > 0 {
+		return AddProxySQLExporterParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AddProxySQLExporterParamsMultiError is an error wrapping multiple validation
+// errors returned by AddProxySQLExporterParams.ValidateAll() if the
+// designated constraints aren't met.
+type AddProxySQLExporterParamsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AddProxySQLExporterParamsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AddProxySQLExporterParamsMultiError) AllErrors() []error { return m }
+
+// AddProxySQLExporterParamsValidationError is the validation error returned by
+// AddProxySQLExporterParams.Validate if the designated constraints aren't met.
+type AddProxySQLExporterParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AddProxySQLExporterParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AddProxySQLExporterParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AddProxySQLExporterParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AddProxySQLExporterParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AddProxySQLExporterParamsValidationError) ErrorName() string {
+	return "AddProxySQLExporterParamsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AddProxySQLExporterParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAddProxySQLExporterParams.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = AddProxySQLExporterParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AddProxySQLExporterParamsValidationError{}
+
+// Validate checks the field values on ChangeProxySQLExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeProxySQLExporterParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChangeProxySQLExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeProxySQLExporterParamsMultiError, or nil if none found.
+func (m *ChangeProxySQLExporterParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeProxySQLExporterParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetricsResolutions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangeProxySQLExporterParamsValidationError{
+					field:  "MetricsResolutions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(m.GetQanPostgresqlPgstatmonitorAgent()).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "QanPostgresqlPgstatmonitorAgent",
+				errors = append(errors, ChangeProxySQLExporterParamsValidationError{
+					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangeProxySQLExporterParamsValidationError{
+				field:  "MetricsResolutions",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
+	}
 
-	case *ChangeAgentResponse_NomadAgent:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
+	if d := m.GetConnectionTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ChangeProxySQLExporterParamsValidationError{
+				field:  "ConnectionTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
 			}
 			if !all {
 				return err
 			}
 			errors = append(errors, err)
-		}
+		} else {
 
-		if all {
-			switch v := interface{}(m.GetNomadAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "NomadAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "NomadAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := ChangeProxySQLExporterParamsValidationError{
+					field:  "ConnectionTimeout",
+					reason: "value must be greater than or equal to 0s",
 				}
-			}
-		} else if v, ok := interface{}(m.GetNomadAgent()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "NomadAgent",
-					reason: "embedded message failed validation",
-					cause:  err,
+				if !all {
+					return err
 				}
+				errors = append(errors, err)
 			}
-		}
 
-	case *ChangeAgentResponse_ValkeyExporter:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
 		}
+	}
+
+	if m.Enable != nil {
+		// no validation rules for Enable
+	}
 
+	if m.CustomLabels != nil {
 		if all {
-			switch v := interface{}(m.GetValkeyExporter()).(type) {
+			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "ValkeyExporter",
+					errors = append(errors, ChangeProxySQLExporterParamsValidationError{
+						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "ValkeyExporter",
+					errors = append(errors, ChangeProxySQLExporterParamsValidationError{
+						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(m.GetValkeyExporter()).(interface{ Validate() error }); ok {
+		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "ValkeyExporter",
+				return ChangeProxySQLExporterParamsValidationError{
+					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
 			}
 		}
+	}
+
+	if m.EnablePushMetrics != nil {
+		// no validation rules for EnablePushMetrics
+	}
+
+	if m.Username != nil {
+		// no validation rules for Username
+	}
+
+	if m.Password != nil {
+		// no validation rules for Password
+	}
+
+	if m.Tls != nil {
+		// no validation rules for Tls
+	}
+
+	if m.TlsSkipVerify != nil {
+		// no validation rules for TlsSkipVerify
+	}
+
+	if m.AgentPassword != nil {
+		// no validation rules for AgentPassword
+	}
+
+	if m.LogLevel != nil {
+		// no validation rules for LogLevel
+	}
+
+	if m.ExposeExporter != nil {
+		// no validation rules for ExposeExporter
+	}
+
+	if m.SkipConnectionCheck != nil {
+		// no validation rules for SkipConnectionCheck
+	}
+
+	if len(errors) > 0 {
+		return ChangeProxySQLExporterParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChangeProxySQLExporterParamsMultiError is an error wrapping multiple
+// validation errors returned by ChangeProxySQLExporterParams.ValidateAll() if
+// the designated constraints aren't met.
+type ChangeProxySQLExporterParamsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChangeProxySQLExporterParamsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChangeProxySQLExporterParamsMultiError) AllErrors() []error { return m }
+
+// ChangeProxySQLExporterParamsValidationError is the validation error returned
+// by ChangeProxySQLExporterParams.Validate if the designated constraints
+// aren't met.
+type ChangeProxySQLExporterParamsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChangeProxySQLExporterParamsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChangeProxySQLExporterParamsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChangeProxySQLExporterParamsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChangeProxySQLExporterParamsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChangeProxySQLExporterParamsValidationError) ErrorName() string {
+	return "ChangeProxySQLExporterParamsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChangeProxySQLExporterParamsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChangeProxySQLExporterParams.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ChangeProxySQLExporterParamsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChangeProxySQLExporterParamsValidationError{}
+
+// Validate checks the field values on AddQANMySQLPerfSchemaAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AddQANMySQLPerfSchemaAgentParams) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AddQANMySQLPerfSchemaAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddQANMySQLPerfSchemaAgentParamsMultiError, or nil if none found.
+func (m *AddQANMySQLPerfSchemaAgentParams) ValidateAll() error {
+	return m.validate(true)
+}
 
-	case *ChangeAgentResponse_RtaMongodbAgent:
-		if v == nil {
-			err := ChangeAgentResponseValidationError{
-				field:  "Agent",
-				reason: "oneof value cannot be a typed-nil",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
+func (m *AddQANMySQLPerfSchemaAgentParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
+		err := AddQANMySQLPerfSchemaAgentParamsValidationError{
+			field:  "PmmAgentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(m.GetRtaMongodbAgent()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "RtaMongodbAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAgentResponseValidationError{
-						field:  "RtaMongodbAgent",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetRtaMongodbAgent()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAgentResponseValidationError{
-					field:  "RtaMongodbAgent",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
+	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
+		err := AddQANMySQLPerfSchemaAgentParamsValidationError{
+			field:  "ServiceId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
 		}
+		errors = append(errors, err)
+	}
 
-	default:
-		_ = v // ensures v is used
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := AddQANMySQLPerfSchemaAgentParamsValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
 	}
 
+	// no validation rules for Password
+
+	// no validation rules for Tls
+
+	// no validation rules for TlsSkipVerify
+
+	// no validation rules for TlsCa
+
+	// no validation rules for TlsCert
+
+	// no validation rules for TlsKey
+
+	// no validation rules for MaxQueryLength
+
+	// no validation rules for DisableQueryExamples
+
+	// no validation rules for CustomLabels
+
+	// no validation rules for SkipConnectionCheck
+
+	// no validation rules for DisableCommentsParsing
+
+	// no validation rules for LogLevel
+
+	// no validation rules for ExtraDsnParams
+
 	if len(errors) > 0 {
-		return ChangeAgentResponseMultiError(errors)
+		return AddQANMySQLPerfSchemaAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeAgentResponseMultiError is an error wrapping multiple validation
-// errors returned by ChangeAgentResponse.ValidateAll() if the designated
+// AddQANMySQLPerfSchemaAgentParamsMultiError is an error wrapping multiple
+// validation errors returned by
+// AddQANMySQLPerfSchemaAgentParams.ValidateAll() if the designated
 // constraints aren't met.
-type ChangeAgentResponseMultiError []error
+type AddQANMySQLPerfSchemaAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeAgentResponseMultiError) Error() string {
+func (m AddQANMySQLPerfSchemaAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8437,11 +12668,12 @@ func (m ChangeAgentResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeAgentResponseMultiError) AllErrors() []error { return m }
+func (m AddQANMySQLPerfSchemaAgentParamsMultiError) AllErrors() []error { return m }
 
-// ChangeAgentResponseValidationError is the validation error returned by
-// ChangeAgentResponse.Validate if the designated constraints aren't met.
-type ChangeAgentResponseValidationError struct {
+// AddQANMySQLPerfSchemaAgentParamsValidationError is the validation error
+// returned by AddQANMySQLPerfSchemaAgentParams.Validate if the designated
+// constraints aren't met.
+type AddQANMySQLPerfSchemaAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8449,24 +12681,24 @@ type ChangeAgentResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeAgentResponseValidationError) Field() string { return e.field }
+func (e AddQANMySQLPerfSchemaAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeAgentResponseValidationError) Reason() string { return e.reason }
+func (e AddQANMySQLPerfSchemaAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeAgentResponseValidationError) Cause() error { return e.cause }
+func (e AddQANMySQLPerfSchemaAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeAgentResponseValidationError) Key() bool { return e.key }
+func (e AddQANMySQLPerfSchemaAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeAgentResponseValidationError) ErrorName() string {
-	return "ChangeAgentResponseValidationError"
+func (e AddQANMySQLPerfSchemaAgentParamsValidationError) ErrorName() string {
+	return "AddQANMySQLPerfSchemaAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangeAgentResponseValidationError) Error() string {
+func (e AddQANMySQLPerfSchemaAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8478,7 +12710,7 @@ func (e ChangeAgentResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeAgentResponse.%s: %s%s",
+		"invalid %sAddQANMySQLPerfSchemaAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -8486,7 +12718,7 @@ func (e ChangeAgentResponseValidationError) Error() string {
 	)
 }
 
-var _ error = ChangeAgentResponseValidationError{}
+var _ error = AddQANMySQLPerfSchemaAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -8494,57 +12726,162 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeAgentResponseValidationError{}
+} = AddQANMySQLPerfSchemaAgentParamsValidationError{}
 
-// Validate checks the field values on AddPMMAgentParams with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AddPMMAgentParams) Validate() error {
+// Validate checks the field values on ChangeQANMySQLPerfSchemaAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ChangeQANMySQLPerfSchemaAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddPMMAgentParams with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddPMMAgentParamsMultiError, or nil if none found.
-func (m *AddPMMAgentParams) ValidateAll() error {
-	return m.validate(true)
-}
+// ValidateAll checks the field values on ChangeQANMySQLPerfSchemaAgentParams
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ChangeQANMySQLPerfSchemaAgentParamsMultiError, or nil if none found.
+func (m *ChangeQANMySQLPerfSchemaAgentParams) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChangeQANMySQLPerfSchemaAgentParams) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetMetricsResolutions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ChangeQANMySQLPerfSchemaAgentParamsValidationError{
+					field:  "MetricsResolutions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ChangeQANMySQLPerfSchemaAgentParamsValidationError{
+					field:  "MetricsResolutions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ChangeQANMySQLPerfSchemaAgentParamsValidationError{
+				field:  "MetricsResolutions",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.Enable != nil {
+		// no validation rules for Enable
+	}
+
+	if m.CustomLabels != nil {
+		if all {
+			switch v := interface{}(m.GetCustomLabels()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeQANMySQLPerfSchemaAgentParamsValidationError{
+						field:  "CustomLabels",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeQANMySQLPerfSchemaAgentParamsValidationError{
+						field:  "CustomLabels",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeQANMySQLPerfSchemaAgentParamsValidationError{
+					field:  "CustomLabels",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	if m.EnablePushMetrics != nil {
+		// no validation rules for EnablePushMetrics
+	}
+
+	if m.Username != nil {
+		// no validation rules for Username
+	}
+
+	if m.Password != nil {
+		// no validation rules for Password
+	}
+
+	if m.Tls != nil {
+		// no validation rules for Tls
+	}
+
+	if m.TlsSkipVerify != nil {
+		// no validation rules for TlsSkipVerify
+	}
+
+	if m.TlsCa != nil {
+		// no validation rules for TlsCa
+	}
+
+	if m.TlsCert != nil {
+		// no validation rules for TlsCert
+	}
+
+	if m.TlsKey != nil {
+		// no validation rules for TlsKey
+	}
 
-func (m *AddPMMAgentParams) validate(all bool) error {
-	if m == nil {
-		return nil
+	if m.MaxQueryLength != nil {
+		// no validation rules for MaxQueryLength
 	}
 
-	var errors []error
+	if m.DisableQueryExamples != nil {
+		// no validation rules for DisableQueryExamples
+	}
 
-	if utf8.RuneCountInString(m.GetRunsOnNodeId()) < 1 {
-		err := AddPMMAgentParamsValidationError{
-			field:  "RunsOnNodeId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if m.SkipConnectionCheck != nil {
+		// no validation rules for SkipConnectionCheck
 	}
 
-	// no validation rules for CustomLabels
+	if m.DisableCommentsParsing != nil {
+		// no validation rules for DisableCommentsParsing
+	}
+
+	if m.LogLevel != nil {
+		// no validation rules for LogLevel
+	}
 
 	if len(errors) > 0 {
-		return AddPMMAgentParamsMultiError(errors)
+		return ChangeQANMySQLPerfSchemaAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddPMMAgentParamsMultiError is an error wrapping multiple validation errors
-// returned by AddPMMAgentParams.ValidateAll() if the designated constraints
-// aren't met.
-type AddPMMAgentParamsMultiError []error
+// ChangeQANMySQLPerfSchemaAgentParamsMultiError is an error wrapping multiple
+// validation errors returned by
+// ChangeQANMySQLPerfSchemaAgentParams.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeQANMySQLPerfSchemaAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddPMMAgentParamsMultiError) Error() string {
+func (m ChangeQANMySQLPerfSchemaAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8553,11 +12890,12 @@ func (m AddPMMAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddPMMAgentParamsMultiError) AllErrors() []error { return m }
+func (m ChangeQANMySQLPerfSchemaAgentParamsMultiError) AllErrors() []error { return m }
 
-// AddPMMAgentParamsValidationError is the validation error returned by
-// AddPMMAgentParams.Validate if the designated constraints aren't met.
-type AddPMMAgentParamsValidationError struct {
+// ChangeQANMySQLPerfSchemaAgentParamsValidationError is the validation error
+// returned by ChangeQANMySQLPerfSchemaAgentParams.Validate if the designated
+// constraints aren't met.
+type ChangeQANMySQLPerfSchemaAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8565,24 +12903,24 @@ type AddPMMAgentParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddPMMAgentParamsValidationError) Field() string { return e.field }
+func (e ChangeQANMySQLPerfSchemaAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddPMMAgentParamsValidationError) Reason() string { return e.reason }
+func (e ChangeQANMySQLPerfSchemaAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddPMMAgentParamsValidationError) Cause() error { return e.cause }
+func (e ChangeQANMySQLPerfSchemaAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddPMMAgentParamsValidationError) Key() bool { return e.key }
+func (e ChangeQANMySQLPerfSchemaAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddPMMAgentParamsValidationError) ErrorName() string {
-	return "AddPMMAgentParamsValidationError"
+func (e ChangeQANMySQLPerfSchemaAgentParamsValidationError) ErrorName() string {
+	return "ChangeQANMySQLPerfSchemaAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddPMMAgentParamsValidationError) Error() string {
+func (e ChangeQANMySQLPerfSchemaAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8594,7 +12932,7 @@ func (e AddPMMAgentParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddPMMAgentParams.%s: %s%s",
+		"invalid %sChangeQANMySQLPerfSchemaAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -8602,7 +12940,7 @@ func (e AddPMMAgentParamsValidationError) Error() string {
 	)
 }
 
-var _ error = AddPMMAgentParamsValidationError{}
+var _ error = ChangeQANMySQLPerfSchemaAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -8610,24 +12948,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddPMMAgentParamsValidationError{}
+} = ChangeQANMySQLPerfSchemaAgentParamsValidationError{}
 
-// Validate checks the field values on AddNodeExporterParams with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AddQANMySQLSlowlogAgentParams with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddNodeExporterParams) Validate() error {
+func (m *AddQANMySQLSlowlogAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddNodeExporterParams with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddNodeExporterParamsMultiError, or nil if none found.
-func (m *AddNodeExporterParams) ValidateAll() error {
+// ValidateAll checks the field values on AddQANMySQLSlowlogAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddQANMySQLSlowlogAgentParamsMultiError, or nil if none found.
+func (m *AddQANMySQLSlowlogAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddNodeExporterParams) validate(all bool) error {
+func (m *AddQANMySQLSlowlogAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8635,7 +12973,7 @@ func (m *AddNodeExporterParams) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
-		err := AddNodeExporterParamsValidationError{
+		err := AddQANMySQLSlowlogAgentParamsValidationError{
 			field:  "PmmAgentId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -8645,28 +12983,70 @@ func (m *AddNodeExporterParams) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
+		err := AddQANMySQLSlowlogAgentParamsValidationError{
+			field:  "ServiceId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := AddQANMySQLSlowlogAgentParamsValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Password
+
+	// no validation rules for Tls
+
+	// no validation rules for TlsSkipVerify
+
+	// no validation rules for TlsCa
+
+	// no validation rules for TlsCert
+
+	// no validation rules for TlsKey
+
+	// no validation rules for MaxQueryLength
+
+	// no validation rules for DisableQueryExamples
+
+	// no validation rules for MaxSlowlogFileSize
+
 	// no validation rules for CustomLabels
 
-	// no validation rules for PushMetrics
+	// no validation rules for SkipConnectionCheck
+
+	// no validation rules for DisableCommentsParsing
 
 	// no validation rules for LogLevel
 
-	// no validation rules for ExposeExporter
+	// no validation rules for ExtraDsnParams
 
 	if len(errors) > 0 {
-		return AddNodeExporterParamsMultiError(errors)
+		return AddQANMySQLSlowlogAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddNodeExporterParamsMultiError is an error wrapping multiple validation
-// errors returned by AddNodeExporterParams.ValidateAll() if the designated
-// constraints aren't met.
-type AddNodeExporterParamsMultiError []error
+// AddQANMySQLSlowlogAgentParamsMultiError is an error wrapping multiple
+// validation errors returned by AddQANMySQLSlowlogAgentParams.ValidateAll()
+// if the designated constraints aren't met.
+type AddQANMySQLSlowlogAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddNodeExporterParamsMultiError) Error() string {
+func (m AddQANMySQLSlowlogAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8675,11 +13055,12 @@ func (m AddNodeExporterParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddNodeExporterParamsMultiError) AllErrors() []error { return m }
+func (m AddQANMySQLSlowlogAgentParamsMultiError) AllErrors() []error { return m }
 
-// AddNodeExporterParamsValidationError is the validation error returned by
-// AddNodeExporterParams.Validate if the designated constraints aren't met.
-type AddNodeExporterParamsValidationError struct {
+// AddQANMySQLSlowlogAgentParamsValidationError is the validation error
+// returned by AddQANMySQLSlowlogAgentParams.Validate if the designated
+// constraints aren't met.
+type AddQANMySQLSlowlogAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8687,24 +13068,24 @@ type AddNodeExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddNodeExporterParamsValidationError) Field() string { return e.field }
+func (e AddQANMySQLSlowlogAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddNodeExporterParamsValidationError) Reason() string { return e.reason }
+func (e AddQANMySQLSlowlogAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddNodeExporterParamsValidationError) Cause() error { return e.cause }
+func (e AddQANMySQLSlowlogAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddNodeExporterParamsValidationError) Key() bool { return e.key }
+func (e AddQANMySQLSlowlogAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddNodeExporterParamsValidationError) ErrorName() string {
-	return "AddNodeExporterParamsValidationError"
+func (e AddQANMySQLSlowlogAgentParamsValidationError) ErrorName() string {
+	return "AddQANMySQLSlowlogAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddNodeExporterParamsValidationError) Error() string {
+func (e AddQANMySQLSlowlogAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8716,7 +13097,7 @@ func (e AddNodeExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddNodeExporterParams.%s: %s%s",
+		"invalid %sAddQANMySQLSlowlogAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -8724,7 +13105,7 @@ func (e AddNodeExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = AddNodeExporterParamsValidationError{}
+var _ error = AddQANMySQLSlowlogAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -8732,24 +13113,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddNodeExporterParamsValidationError{}
+} = AddQANMySQLSlowlogAgentParamsValidationError{}
 
-// Validate checks the field values on ChangeNodeExporterParams with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChangeNodeExporterParams) Validate() error {
+// Validate checks the field values on ChangeQANMySQLSlowlogAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ChangeQANMySQLSlowlogAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeNodeExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ChangeNodeExporterParamsMultiError, or nil if none found.
-func (m *ChangeNodeExporterParams) ValidateAll() error {
+// ValidateAll checks the field values on ChangeQANMySQLSlowlogAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ChangeQANMySQLSlowlogAgentParamsMultiError, or nil if none found.
+func (m *ChangeQANMySQLSlowlogAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeNodeExporterParams) validate(all bool) error {
+func (m *ChangeQANMySQLSlowlogAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8760,7 +13142,7 @@ func (m *ChangeNodeExporterParams) validate(all bool) error {
 		switch v := interface{}(m.GetMetricsResolutions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeNodeExporterParamsValidationError{
+				errors = append(errors, ChangeQANMySQLSlowlogAgentParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -8768,7 +13150,7 @@ func (m *ChangeNodeExporterParams) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeNodeExporterParamsValidationError{
+				errors = append(errors, ChangeQANMySQLSlowlogAgentParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -8777,7 +13159,7 @@ func (m *ChangeNodeExporterParams) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ChangeNodeExporterParamsValidationError{
+			return ChangeQANMySQLSlowlogAgentParamsValidationError{
 				field:  "MetricsResolutions",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -8794,7 +13176,7 @@ func (m *ChangeNodeExporterParams) validate(all bool) error {
 			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeNodeExporterParamsValidationError{
+					errors = append(errors, ChangeQANMySQLSlowlogAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -8802,7 +13184,7 @@ func (m *ChangeNodeExporterParams) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeNodeExporterParamsValidationError{
+					errors = append(errors, ChangeQANMySQLSlowlogAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -8811,7 +13193,7 @@ func (m *ChangeNodeExporterParams) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeNodeExporterParamsValidationError{
+				return ChangeQANMySQLSlowlogAgentParamsValidationError{
 					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -8820,32 +13202,77 @@ func (m *ChangeNodeExporterParams) validate(all bool) error {
 		}
 	}
 
-	if m.EnablePushMetrics != nil {
-		// no validation rules for EnablePushMetrics
+	if m.EnablePushMetrics != nil {
+		// no validation rules for EnablePushMetrics
+	}
+
+	if m.Username != nil {
+		// no validation rules for Username
+	}
+
+	if m.Password != nil {
+		// no validation rules for Password
+	}
+
+	if m.Tls != nil {
+		// no validation rules for Tls
+	}
+
+	if m.TlsSkipVerify != nil {
+		// no validation rules for TlsSkipVerify
+	}
+
+	if m.TlsCa != nil {
+		// no validation rules for TlsCa
+	}
+
+	if m.TlsCert != nil {
+		// no validation rules for TlsCert
+	}
+
+	if m.TlsKey != nil {
+		// no validation rules for TlsKey
+	}
+
+	if m.MaxQueryLength != nil {
+		// no validation rules for MaxQueryLength
+	}
+
+	if m.DisableQueryExamples != nil {
+		// no validation rules for DisableQueryExamples
 	}
 
-	if m.LogLevel != nil {
-		// no validation rules for LogLevel
+	if m.MaxSlowlogFileSize != nil {
+		// no validation rules for MaxSlowlogFileSize
 	}
 
-	if m.ExposeExporter != nil {
-		// no validation rules for ExposeExporter
+	if m.SkipConnectionCheck != nil {
+		// no validation rules for SkipConnectionCheck
+	}
+
+	if m.DisableCommentsParsing != nil {
+		// no validation rules for DisableCommentsParsing
+	}
+
+	if m.LogLevel != nil {
+		// no validation rules for LogLevel
 	}
 
 	if len(errors) > 0 {
-		return ChangeNodeExporterParamsMultiError(errors)
+		return ChangeQANMySQLSlowlogAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeNodeExporterParamsMultiError is an error wrapping multiple validation
-// errors returned by ChangeNodeExporterParams.ValidateAll() if the designated
+// ChangeQANMySQLSlowlogAgentParamsMultiError is an error wrapping multiple
+// validation errors returned by
+// ChangeQANMySQLSlowlogAgentParams.ValidateAll() if the designated
 // constraints aren't met.
-type ChangeNodeExporterParamsMultiError []error
+type ChangeQANMySQLSlowlogAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeNodeExporterParamsMultiError) Error() string {
+func (m ChangeQANMySQLSlowlogAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -8854,11 +13281,12 @@ func (m ChangeNodeExporterParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeNodeExporterParamsMultiError) AllErrors() []error { return m }
+func (m ChangeQANMySQLSlowlogAgentParamsMultiError) AllErrors() []error { return m }
 
-// ChangeNodeExporterParamsValidationError is the validation error returned by
-// ChangeNodeExporterParams.Validate if the designated constraints aren't met.
-type ChangeNodeExporterParamsValidationError struct {
+// ChangeQANMySQLSlowlogAgentParamsValidationError is the validation error
+// returned by ChangeQANMySQLSlowlogAgentParams.Validate if the designated
+// constraints aren't met.
+type ChangeQANMySQLSlowlogAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -8866,24 +13294,24 @@ type ChangeNodeExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeNodeExporterParamsValidationError) Field() string { return e.field }
+func (e ChangeQANMySQLSlowlogAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeNodeExporterParamsValidationError) Reason() string { return e.reason }
+func (e ChangeQANMySQLSlowlogAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeNodeExporterParamsValidationError) Cause() error { return e.cause }
+func (e ChangeQANMySQLSlowlogAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeNodeExporterParamsValidationError) Key() bool { return e.key }
+func (e ChangeQANMySQLSlowlogAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeNodeExporterParamsValidationError) ErrorName() string {
-	return "ChangeNodeExporterParamsValidationError"
+func (e ChangeQANMySQLSlowlogAgentParamsValidationError) ErrorName() string {
+	return "ChangeQANMySQLSlowlogAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangeNodeExporterParamsValidationError) Error() string {
+func (e ChangeQANMySQLSlowlogAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -8895,7 +13323,7 @@ func (e ChangeNodeExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeNodeExporterParams.%s: %s%s",
+		"invalid %sChangeQANMySQLSlowlogAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -8903,7 +13331,7 @@ func (e ChangeNodeExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = ChangeNodeExporterParamsValidationError{}
+var _ error = ChangeQANMySQLSlowlogAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -8911,24 +13339,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeNodeExporterParamsValidationError{}
+} = ChangeQANMySQLSlowlogAgentParamsValidationError{}
 
-// Validate checks the field values on AddMySQLdExporterParams with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddMySQLdExporterParams) Validate() error {
+// Validate checks the field values on AddQANMongoDBProfilerAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AddQANMongoDBProfilerAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddMySQLdExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddMySQLdExporterParamsMultiError, or nil if none found.
-func (m *AddMySQLdExporterParams) ValidateAll() error {
+// ValidateAll checks the field values on AddQANMongoDBProfilerAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddQANMongoDBProfilerAgentParamsMultiError, or nil if none found.
+func (m *AddQANMongoDBProfilerAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddMySQLdExporterParams) validate(all bool) error {
+func (m *AddQANMongoDBProfilerAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -8936,7 +13365,7 @@ func (m *AddMySQLdExporterParams) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
-		err := AddMySQLdExporterParamsValidationError{
+		err := AddQANMongoDBProfilerAgentParamsValidationError{
 			field:  "PmmAgentId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -8947,7 +13376,7 @@ func (m *AddMySQLdExporterParams) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
-		err := AddMySQLdExporterParamsValidationError{
+		err := AddQANMongoDBProfilerAgentParamsValidationError{
 			field:  "ServiceId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -8957,16 +13386,7 @@ func (m *AddMySQLdExporterParams) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetUsername()) < 1 {
-		err := AddMySQLdExporterParamsValidationError{
-			field:  "Username",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Username
 
 	// no validation rules for Password
 
@@ -8974,72 +13394,39 @@ func (m *AddMySQLdExporterParams) validate(all bool) error {
 
 	// no validation rules for TlsSkipVerify
 
-	// no validation rules for TlsCa
+	// no validation rules for TlsCertificateKey
 
-	// no validation rules for TlsCert
+	// no validation rules for TlsCertificateKeyFilePassword
 
-	// no validation rules for TlsKey
+	// no validation rules for TlsCa
 
-	// no validation rules for TablestatsGroupTableLimit
+	// no validation rules for MaxQueryLength
 
 	// no validation rules for CustomLabels
 
 	// no validation rules for SkipConnectionCheck
 
-	// no validation rules for PushMetrics
+	// no validation rules for AuthenticationMechanism
 
-	// no validation rules for AgentPassword
+	// no validation rules for AuthenticationDatabase
 
 	// no validation rules for LogLevel
 
-	// no validation rules for ExposeExporter
-
-	// no validation rules for ExtraDsnParams
-
-	if d := m.GetConnectionTimeout(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = AddMySQLdExporterParamsValidationError{
-				field:  "ConnectionTimeout",
-				reason: "value is not a valid duration",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-
-			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
-
-			if dur < gte {
-				err := AddMySQLdExporterParamsValidationError{
-					field:  "ConnectionTimeout",
-					reason: "value must be greater than or equal to 0s",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
-		}
-	}
-
 	if len(errors) > 0 {
-		return AddMySQLdExporterParamsMultiError(errors)
+		return AddQANMongoDBProfilerAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddMySQLdExporterParamsMultiError is an error wrapping multiple validation
-// errors returned by AddMySQLdExporterParams.ValidateAll() if the designated
+// AddQANMongoDBProfilerAgentParamsMultiError is an error wrapping multiple
+// validation errors returned by
+// AddQANMongoDBProfilerAgentParams.ValidateAll() if the designated
 // constraints aren't met.
-type AddMySQLdExporterParamsMultiError []error
+type AddQANMongoDBProfilerAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddMySQLdExporterParamsMultiError) Error() string {
+func (m AddQANMongoDBProfilerAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9048,11 +13435,12 @@ func (m AddMySQLdExporterParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddMySQLdExporterParamsMultiError) AllErrors() []error { return m }
+func (m AddQANMongoDBProfilerAgentParamsMultiError) AllErrors() []error { return m }
 
-// AddMySQLdExporterParamsValidationError is the validation error returned by
-// AddMySQLdExporterParams.Validate if the designated constraints aren't met.
-type AddMySQLdExporterParamsValidationError struct {
+// AddQANMongoDBProfilerAgentParamsValidationError is the validation error
+// returned by AddQANMongoDBProfilerAgentParams.Validate if the designated
+// constraints aren't met.
+type AddQANMongoDBProfilerAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9060,24 +13448,24 @@ type AddMySQLdExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddMySQLdExporterParamsValidationError) Field() string { return e.field }
+func (e AddQANMongoDBProfilerAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddMySQLdExporterParamsValidationError) Reason() string { return e.reason }
+func (e AddQANMongoDBProfilerAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddMySQLdExporterParamsValidationError) Cause() error { return e.cause }
+func (e AddQANMongoDBProfilerAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddMySQLdExporterParamsValidationError) Key() bool { return e.key }
+func (e AddQANMongoDBProfilerAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddMySQLdExporterParamsValidationError) ErrorName() string {
-	return "AddMySQLdExporterParamsValidationError"
+func (e AddQANMongoDBProfilerAgentParamsValidationError) ErrorName() string {
+	return "AddQANMongoDBProfilerAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddMySQLdExporterParamsValidationError) Error() string {
+func (e AddQANMongoDBProfilerAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9089,7 +13477,7 @@ func (e AddMySQLdExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddMySQLdExporterParams.%s: %s%s",
+		"invalid %sAddQANMongoDBProfilerAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -9097,7 +13485,7 @@ func (e AddMySQLdExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = AddMySQLdExporterParamsValidationError{}
+var _ error = AddQANMongoDBProfilerAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -9105,24 +13493,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddMySQLdExporterParamsValidationError{}
+} = AddQANMongoDBProfilerAgentParamsValidationError{}
 
-// Validate checks the field values on ChangeMySQLdExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChangeMySQLdExporterParams) Validate() error {
+// Validate checks the field values on ChangeQANMongoDBProfilerAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ChangeQANMongoDBProfilerAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeMySQLdExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ChangeMySQLdExporterParamsMultiError, or nil if none found.
-func (m *ChangeMySQLdExporterParams) ValidateAll() error {
+// ValidateAll checks the field values on ChangeQANMongoDBProfilerAgentParams
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ChangeQANMongoDBProfilerAgentParamsMultiError, or nil if none found.
+func (m *ChangeQANMongoDBProfilerAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeMySQLdExporterParams) validate(all bool) error {
+func (m *ChangeQANMongoDBProfilerAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9133,7 +13522,7 @@ func (m *ChangeMySQLdExporterParams) validate(all bool) error {
 		switch v := interface{}(m.GetMetricsResolutions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeMySQLdExporterParamsValidationError{
+				errors = append(errors, ChangeQANMongoDBProfilerAgentParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -9141,7 +13530,7 @@ func (m *ChangeMySQLdExporterParams) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeMySQLdExporterParamsValidationError{
+				errors = append(errors, ChangeQANMongoDBProfilerAgentParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -9150,7 +13539,7 @@ func (m *ChangeMySQLdExporterParams) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ChangeMySQLdExporterParamsValidationError{
+			return ChangeQANMongoDBProfilerAgentParamsValidationError{
 				field:  "MetricsResolutions",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -9158,36 +13547,6 @@ func (m *ChangeMySQLdExporterParams) validate(all bool) error {
 		}
 	}
 
-	if d := m.GetConnectionTimeout(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = ChangeMySQLdExporterParamsValidationError{
-				field:  "ConnectionTimeout",
-				reason: "value is not a valid duration",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-
-			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
-
-			if dur < gte {
-				err := ChangeMySQLdExporterParamsValidationError{
-					field:  "ConnectionTimeout",
-					reason: "value must be greater than or equal to 0s",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
-		}
-	}
-
 	if m.Enable != nil {
 		// no validation rules for Enable
 	}
@@ -9197,7 +13556,7 @@ func (m *ChangeMySQLdExporterParams) validate(all bool) error {
 			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeMySQLdExporterParamsValidationError{
+					errors = append(errors, ChangeQANMongoDBProfilerAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -9205,7 +13564,7 @@ func (m *ChangeMySQLdExporterParams) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeMySQLdExporterParamsValidationError{
+					errors = append(errors, ChangeQANMongoDBProfilerAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -9214,7 +13573,7 @@ func (m *ChangeMySQLdExporterParams) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeMySQLdExporterParamsValidationError{
+				return ChangeQANMongoDBProfilerAgentParamsValidationError{
 					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -9243,52 +13602,53 @@ func (m *ChangeMySQLdExporterParams) validate(all bool) error {
 		// no validation rules for TlsSkipVerify
 	}
 
-	if m.TlsCa != nil {
-		// no validation rules for TlsCa
+	if m.TlsCertificateKey != nil {
+		// no validation rules for TlsCertificateKey
 	}
 
-	if m.TlsCert != nil {
-		// no validation rules for TlsCert
+	if m.TlsCertificateKeyFilePassword != nil {
+		// no validation rules for TlsCertificateKeyFilePassword
 	}
-
-	if m.TlsKey != nil {
-		// no validation rules for TlsKey
+
+	if m.TlsCa != nil {
+		// no validation rules for TlsCa
 	}
 
-	if m.TablestatsGroupTableLimit != nil {
-		// no validation rules for TablestatsGroupTableLimit
+	if m.MaxQueryLength != nil {
+		// no validation rules for MaxQueryLength
 	}
 
-	if m.SkipConnectionCheck != nil {
-		// no validation rules for SkipConnectionCheck
+	if m.AuthenticationMechanism != nil {
+		// no validation rules for AuthenticationMechanism
 	}
 
-	if m.AgentPassword != nil {
-		// no validation rules for AgentPassword
+	if m.AuthenticationDatabase != nil {
+		// no validation rules for AuthenticationDatabase
 	}
 
 	if m.LogLevel != nil {
 		// no validation rules for LogLevel
 	}
 
-	if m.ExposeExporter != nil {
-		// no validation rules for ExposeExporter
+	if m.SkipConnectionCheck != nil {
+		// no validation rules for SkipConnectionCheck
 	}
 
 	if len(errors) > 0 {
-		return ChangeMySQLdExporterParamsMultiError(errors)
+		return ChangeQANMongoDBProfilerAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeMySQLdExporterParamsMultiError is an error wrapping multiple
-// validation errors returned by ChangeMySQLdExporterParams.ValidateAll() if
-// the designated constraints aren't met.
-type ChangeMySQLdExporterParamsMultiError []error
+// ChangeQANMongoDBProfilerAgentParamsMultiError is an error wrapping multiple
+// validation errors returned by
+// ChangeQANMongoDBProfilerAgentParams.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeQANMongoDBProfilerAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeMySQLdExporterParamsMultiError) Error() string {
+func (m ChangeQANMongoDBProfilerAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9297,11 +13657,12 @@ func (m ChangeMySQLdExporterParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeMySQLdExporterParamsMultiError) AllErrors() []error { return m }
+func (m ChangeQANMongoDBProfilerAgentParamsMultiError) AllErrors() []error { return m }
 
-// ChangeMySQLdExporterParamsValidationError is the validation error returned
-// by ChangeMySQLdExporterParams.Validate if the designated constraints aren't met.
-type ChangeMySQLdExporterParamsValidationError struct {
+// ChangeQANMongoDBProfilerAgentParamsValidationError is the validation error
+// returned by ChangeQANMongoDBProfilerAgentParams.Validate if the designated
+// constraints aren't met.
+type ChangeQANMongoDBProfilerAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9309,24 +13670,24 @@ type ChangeMySQLdExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeMySQLdExporterParamsValidationError) Field() string { return e.field }
+func (e ChangeQANMongoDBProfilerAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeMySQLdExporterParamsValidationError) Reason() string { return e.reason }
+func (e ChangeQANMongoDBProfilerAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeMySQLdExporterParamsValidationError) Cause() error { return e.cause }
+func (e ChangeQANMongoDBProfilerAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeMySQLdExporterParamsValidationError) Key() bool { return e.key }
+func (e ChangeQANMongoDBProfilerAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeMySQLdExporterParamsValidationError) ErrorName() string {
-	return "ChangeMySQLdExporterParamsValidationError"
+func (e ChangeQANMongoDBProfilerAgentParamsValidationError) ErrorName() string {
+	return "ChangeQANMongoDBProfilerAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangeMySQLdExporterParamsValidationError) Error() string {
+func (e ChangeQANMongoDBProfilerAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9338,7 +13699,7 @@ func (e ChangeMySQLdExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeMySQLdExporterParams.%s: %s%s",
+		"invalid %sChangeQANMongoDBProfilerAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -9346,7 +13707,7 @@ func (e ChangeMySQLdExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = ChangeMySQLdExporterParamsValidationError{}
+var _ error = ChangeQANMongoDBProfilerAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -9354,24 +13715,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeMySQLdExporterParamsValidationError{}
+} = ChangeQANMongoDBProfilerAgentParamsValidationError{}
 
-// Validate checks the field values on AddMongoDBExporterParams with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddMongoDBExporterParams) Validate() error {
+// Validate checks the field values on AddQANMongoDBMongologAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *AddQANMongoDBMongologAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddMongoDBExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddMongoDBExporterParamsMultiError, or nil if none found.
-func (m *AddMongoDBExporterParams) ValidateAll() error {
+// ValidateAll checks the field values on AddQANMongoDBMongologAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddQANMongoDBMongologAgentParamsMultiError, or nil if none found.
+func (m *AddQANMongoDBMongologAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddMongoDBExporterParams) validate(all bool) error {
+func (m *AddQANMongoDBMongologAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9379,7 +13741,7 @@ func (m *AddMongoDBExporterParams) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
-		err := AddMongoDBExporterParamsValidationError{
+		err := AddQANMongoDBMongologAgentParamsValidationError{
 			field:  "PmmAgentId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -9390,7 +13752,7 @@ func (m *AddMongoDBExporterParams) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
-		err := AddMongoDBExporterParamsValidationError{
+		err := AddQANMongoDBMongologAgentParamsValidationError{
 			field:  "ServiceId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -9414,72 +13776,33 @@ func (m *AddMongoDBExporterParams) validate(all bool) error {
 
 	// no validation rules for TlsCa
 
+	// no validation rules for MaxQueryLength
+
 	// no validation rules for CustomLabels
 
 	// no validation rules for SkipConnectionCheck
 
-	// no validation rules for PushMetrics
-
 	// no validation rules for AuthenticationMechanism
 
 	// no validation rules for AuthenticationDatabase
 
-	// no validation rules for AgentPassword
-
-	// no validation rules for CollectionsLimit
-
 	// no validation rules for LogLevel
 
-	// no validation rules for ExposeExporter
-
-	// no validation rules for EnableAllCollectors
-
-	if d := m.GetConnectionTimeout(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = AddMongoDBExporterParamsValidationError{
-				field:  "ConnectionTimeout",
-				reason: "value is not a valid duration",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-
-			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
-
-			if dur < gte {
-				err := AddMongoDBExporterParamsValidationError{
-					field:  "ConnectionTimeout",
-					reason: "value must be greater than or equal to 0s",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
-		}
-	}
-
-	// no validation rules for EnableDiagnosticDataHistograms
-
 	if len(errors) > 0 {
-		return AddMongoDBExporterParamsMultiError(errors)
+		return AddQANMongoDBMongologAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddMongoDBExporterParamsMultiError is an error wrapping multiple validation
-// errors returned by AddMongoDBExporterParams.ValidateAll() if the designated
+// AddQANMongoDBMongologAgentParamsMultiError is an error wrapping multiple
+// validation errors returned by
+// AddQANMongoDBMongologAgentParams.ValidateAll() if the designated
 // constraints aren't met.
-type AddMongoDBExporterParamsMultiError []error
+type AddQANMongoDBMongologAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddMongoDBExporterParamsMultiError) Error() string {
+func (m AddQANMongoDBMongologAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9488,11 +13811,12 @@ func (m AddMongoDBExporterParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddMongoDBExporterParamsMultiError) AllErrors() []error { return m }
+func (m AddQANMongoDBMongologAgentParamsMultiError) AllErrors() []error { return m }
 
-// AddMongoDBExporterParamsValidationError is the validation error returned by
-// AddMongoDBExporterParams.Validate if the designated constraints aren't met.
-type AddMongoDBExporterParamsValidationError struct {
+// AddQANMongoDBMongologAgentParamsValidationError is the validation error
+// returned by AddQANMongoDBMongologAgentParams.Validate if the designated
+// constraints aren't met.
+type AddQANMongoDBMongologAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9500,24 +13824,24 @@ type AddMongoDBExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddMongoDBExporterParamsValidationError) Field() string { return e.field }
+func (e AddQANMongoDBMongologAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddMongoDBExporterParamsValidationError) Reason() string { return e.reason }
+func (e AddQANMongoDBMongologAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddMongoDBExporterParamsValidationError) Cause() error { return e.cause }
+func (e AddQANMongoDBMongologAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddMongoDBExporterParamsValidationError) Key() bool { return e.key }
+func (e AddQANMongoDBMongologAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddMongoDBExporterParamsValidationError) ErrorName() string {
-	return "AddMongoDBExporterParamsValidationError"
+func (e AddQANMongoDBMongologAgentParamsValidationError) ErrorName() string {
+	return "AddQANMongoDBMongologAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddMongoDBExporterParamsValidationError) Error() string {
+func (e AddQANMongoDBMongologAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9529,7 +13853,7 @@ func (e AddMongoDBExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddMongoDBExporterParams.%s: %s%s",
+		"invalid %sAddQANMongoDBMongologAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -9537,7 +13861,7 @@ func (e AddMongoDBExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = AddMongoDBExporterParamsValidationError{}
+var _ error = AddQANMongoDBMongologAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -9545,24 +13869,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddMongoDBExporterParamsValidationError{}
+} = AddQANMongoDBMongologAgentParamsValidationError{}
 
-// Validate checks the field values on ChangeMongoDBExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChangeMongoDBExporterParams) Validate() error {
+// Validate checks the field values on ChangeQANMongoDBMongologAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ChangeQANMongoDBMongologAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeMongoDBExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ChangeMongoDBExporterParamsMultiError, or nil if none found.
-func (m *ChangeMongoDBExporterParams) ValidateAll() error {
+// ValidateAll checks the field values on ChangeQANMongoDBMongologAgentParams
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ChangeQANMongoDBMongologAgentParamsMultiError, or nil if none found.
+func (m *ChangeQANMongoDBMongologAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeMongoDBExporterParams) validate(all bool) error {
+func (m *ChangeQANMongoDBMongologAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9573,7 +13898,7 @@ func (m *ChangeMongoDBExporterParams) validate(all bool) error {
 		switch v := interface{}(m.GetMetricsResolutions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeMongoDBExporterParamsValidationError{
+				errors = append(errors, ChangeQANMongoDBMongologAgentParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -9581,7 +13906,7 @@ func (m *ChangeMongoDBExporterParams) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeMongoDBExporterParamsValidationError{
+				errors = append(errors, ChangeQANMongoDBMongologAgentParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -9590,7 +13915,7 @@ func (m *ChangeMongoDBExporterParams) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ChangeMongoDBExporterParamsValidationError{
+			return ChangeQANMongoDBMongologAgentParamsValidationError{
 				field:  "MetricsResolutions",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -9598,36 +13923,6 @@ func (m *ChangeMongoDBExporterParams) validate(all bool) error {
 		}
 	}
 
-	if d := m.GetConnectionTimeout(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = ChangeMongoDBExporterParamsValidationError{
-				field:  "ConnectionTimeout",
-				reason: "value is not a valid duration",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-
-			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
-
-			if dur < gte {
-				err := ChangeMongoDBExporterParamsValidationError{
-					field:  "ConnectionTimeout",
-					reason: "value must be greater than or equal to 0s",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
-		}
-	}
-
 	if m.Enable != nil {
 		// no validation rules for Enable
 	}
@@ -9637,7 +13932,7 @@ func (m *ChangeMongoDBExporterParams) validate(all bool) error {
 			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeMongoDBExporterParamsValidationError{
+					errors = append(errors, ChangeQANMongoDBMongologAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -9645,7 +13940,7 @@ func (m *ChangeMongoDBExporterParams) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeMongoDBExporterParamsValidationError{
+					errors = append(errors, ChangeQANMongoDBMongologAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -9654,7 +13949,7 @@ func (m *ChangeMongoDBExporterParams) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeMongoDBExporterParamsValidationError{
+				return ChangeQANMongoDBMongologAgentParamsValidationError{
 					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -9695,8 +13990,8 @@ func (m *ChangeMongoDBExporterParams) validate(all bool) error {
 		// no validation rules for TlsCa
 	}
 
-	if m.SkipConnectionCheck != nil {
-		// no validation rules for SkipConnectionCheck
+	if m.MaxQueryLength != nil {
+		// no validation rules for MaxQueryLength
 	}
 
 	if m.AuthenticationMechanism != nil {
@@ -9706,45 +14001,30 @@ func (m *ChangeMongoDBExporterParams) validate(all bool) error {
 	if m.AuthenticationDatabase != nil {
 		// no validation rules for AuthenticationDatabase
 	}
-
-	if m.AgentPassword != nil {
-		// no validation rules for AgentPassword
-	}
-
-	if m.CollectionsLimit != nil {
-		// no validation rules for CollectionsLimit
-	}
-
-	if m.EnableAllCollectors != nil {
-		// no validation rules for EnableAllCollectors
-	}
-
-	if m.LogLevel != nil {
-		// no validation rules for LogLevel
-	}
-
-	if m.ExposeExporter != nil {
-		// no validation rules for ExposeExporter
+
+	if m.LogLevel != nil {
+		// no validation rules for LogLevel
 	}
 
-	if m.EnableDiagnosticDataHistograms != nil {
-		// no validation rules for EnableDiagnosticDataHistograms
+	if m.SkipConnectionCheck != nil {
+		// no validation rules for SkipConnectionCheck
 	}
 
 	if len(errors) > 0 {
-		return ChangeMongoDBExporterParamsMultiError(errors)
+		return ChangeQANMongoDBMongologAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeMongoDBExporterParamsMultiError is an error wrapping multiple
-// validation errors returned by ChangeMongoDBExporterParams.ValidateAll() if
-// the designated constraints aren't met.
-type ChangeMongoDBExporterParamsMultiError []error
+// ChangeQANMongoDBMongologAgentParamsMultiError is an error wrapping multiple
+// validation errors returned by
+// ChangeQANMongoDBMongologAgentParams.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeQANMongoDBMongologAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeMongoDBExporterParamsMultiError) Error() string {
+func (m ChangeQANMongoDBMongologAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9753,12 +14033,12 @@ func (m ChangeMongoDBExporterParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeMongoDBExporterParamsMultiError) AllErrors() []error { return m }
+func (m ChangeQANMongoDBMongologAgentParamsMultiError) AllErrors() []error { return m }
 
-// ChangeMongoDBExporterParamsValidationError is the validation error returned
-// by ChangeMongoDBExporterParams.Validate if the designated constraints
-// aren't met.
-type ChangeMongoDBExporterParamsValidationError struct {
+// ChangeQANMongoDBMongologAgentParamsValidationError is the validation error
+// returned by ChangeQANMongoDBMongologAgentParams.Validate if the designated
+// constraints aren't met.
+type ChangeQANMongoDBMongologAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9766,24 +14046,24 @@ type ChangeMongoDBExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeMongoDBExporterParamsValidationError) Field() string { return e.field }
+func (e ChangeQANMongoDBMongologAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeMongoDBExporterParamsValidationError) Reason() string { return e.reason }
+func (e ChangeQANMongoDBMongologAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeMongoDBExporterParamsValidationError) Cause() error { return e.cause }
+func (e ChangeQANMongoDBMongologAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeMongoDBExporterParamsValidationError) Key() bool { return e.key }
+func (e ChangeQANMongoDBMongologAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeMongoDBExporterParamsValidationError) ErrorName() string {
-	return "ChangeMongoDBExporterParamsValidationError"
+func (e ChangeQANMongoDBMongologAgentParamsValidationError) ErrorName() string {
+	return "ChangeQANMongoDBMongologAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangeMongoDBExporterParamsValidationError) Error() string {
+func (e ChangeQANMongoDBMongologAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9795,7 +14075,7 @@ func (e ChangeMongoDBExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeMongoDBExporterParams.%s: %s%s",
+		"invalid %sChangeQANMongoDBMongologAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -9803,7 +14083,7 @@ func (e ChangeMongoDBExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = ChangeMongoDBExporterParamsValidationError{}
+var _ error = ChangeQANMongoDBMongologAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -9811,24 +14091,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeMongoDBExporterParamsValidationError{}
+} = ChangeQANMongoDBMongologAgentParamsValidationError{}
 
-// Validate checks the field values on AddPostgresExporterParams with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AddQANValkeySlowlogAgentParams with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddPostgresExporterParams) Validate() error {
+func (m *AddQANValkeySlowlogAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddPostgresExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddPostgresExporterParamsMultiError, or nil if none found.
-func (m *AddPostgresExporterParams) ValidateAll() error {
+// ValidateAll checks the field values on AddQANValkeySlowlogAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddQANValkeySlowlogAgentParamsMultiError, or nil if none found.
+func (m *AddQANValkeySlowlogAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddPostgresExporterParams) validate(all bool) error {
+func (m *AddQANValkeySlowlogAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -9836,7 +14116,7 @@ func (m *AddPostgresExporterParams) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
-		err := AddPostgresExporterParamsValidationError{
+		err := AddQANValkeySlowlogAgentParamsValidationError{
 			field:  "PmmAgentId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -9847,7 +14127,7 @@ func (m *AddPostgresExporterParams) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
-		err := AddPostgresExporterParamsValidationError{
+		err := AddQANValkeySlowlogAgentParamsValidationError{
 			field:  "ServiceId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -9857,16 +14137,7 @@ func (m *AddPostgresExporterParams) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetUsername()) < 1 {
-		err := AddPostgresExporterParamsValidationError{
-			field:  "Username",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Username
 
 	// no validation rules for Password
 
@@ -9874,72 +14145,36 @@ func (m *AddPostgresExporterParams) validate(all bool) error {
 
 	// no validation rules for TlsSkipVerify
 
-	// no validation rules for CustomLabels
-
-	// no validation rules for SkipConnectionCheck
-
-	// no validation rules for PushMetrics
-
 	// no validation rules for TlsCa
 
 	// no validation rules for TlsCert
 
 	// no validation rules for TlsKey
 
-	// no validation rules for AgentPassword
-
-	// no validation rules for LogLevel
-
-	// no validation rules for AutoDiscoveryLimit
-
-	// no validation rules for ExposeExporter
-
-	// no validation rules for MaxExporterConnections
+	// no validation rules for MaxQueryLength
 
-	if d := m.GetConnectionTimeout(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = AddPostgresExporterParamsValidationError{
-				field:  "ConnectionTimeout",
-				reason: "value is not a valid duration",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
+	// no validation rules for DisableQueryExamples
 
-			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+	// no validation rules for CustomLabels
 
-			if dur < gte {
-				err := AddPostgresExporterParamsValidationError{
-					field:  "ConnectionTimeout",
-					reason: "value must be greater than or equal to 0s",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
+	// no validation rules for SkipConnectionCheck
 
-		}
-	}
+	// no validation rules for LogLevel
 
 	if len(errors) > 0 {
-		return AddPostgresExporterParamsMultiError(errors)
+		return AddQANValkeySlowlogAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddPostgresExporterParamsMultiError is an error wrapping multiple validation
-// errors returned by AddPostgresExporterParams.ValidateAll() if the
-// designated constraints aren't met.
-type AddPostgresExporterParamsMultiError []error
+// AddQANValkeySlowlogAgentParamsMultiError is an error wrapping multiple
+// validation errors returned by AddQANValkeySlowlogAgentParams.ValidateAll()
+// if the designated constraints aren't met.
+type AddQANValkeySlowlogAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddPostgresExporterParamsMultiError) Error() string {
+func (m AddQANValkeySlowlogAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -9948,11 +14183,12 @@ func (m AddPostgresExporterParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddPostgresExporterParamsMultiError) AllErrors() []error { return m }
+func (m AddQANValkeySlowlogAgentParamsMultiError) AllErrors() []error { return m }
 
-// AddPostgresExporterParamsValidationError is the validation error returned by
-// AddPostgresExporterParams.Validate if the designated constraints aren't met.
-type AddPostgresExporterParamsValidationError struct {
+// AddQANValkeySlowlogAgentParamsValidationError is the validation error
+// returned by AddQANValkeySlowlogAgentParams.Validate if the designated
+// constraints aren't met.
+type AddQANValkeySlowlogAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -9960,24 +14196,24 @@ type AddPostgresExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddPostgresExporterParamsValidationError) Field() string { return e.field }
+func (e AddQANValkeySlowlogAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddPostgresExporterParamsValidationError) Reason() string { return e.reason }
+func (e AddQANValkeySlowlogAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddPostgresExporterParamsValidationError) Cause() error { return e.cause }
+func (e AddQANValkeySlowlogAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddPostgresExporterParamsValidationError) Key() bool { return e.key }
+func (e AddQANValkeySlowlogAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddPostgresExporterParamsValidationError) ErrorName() string {
-	return "AddPostgresExporterParamsValidationError"
+func (e AddQANValkeySlowlogAgentParamsValidationError) ErrorName() string {
+	return "AddQANValkeySlowlogAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddPostgresExporterParamsValidationError) Error() string {
+func (e AddQANValkeySlowlogAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -9989,7 +14225,7 @@ func (e AddPostgresExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddPostgresExporterParams.%s: %s%s",
+		"invalid %sAddQANValkeySlowlogAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -9997,7 +14233,7 @@ func (e AddPostgresExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = AddPostgresExporterParamsValidationError{}
+var _ error = AddQANValkeySlowlogAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -10005,89 +14241,31 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddPostgresExporterParamsValidationError{}
+} = AddQANValkeySlowlogAgentParamsValidationError{}
 
-// Validate checks the field values on ChangePostgresExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChangePostgresExporterParams) Validate() error {
+// Validate checks the field values on ChangeQANValkeySlowlogAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ChangeQANValkeySlowlogAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangePostgresExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ChangePostgresExporterParamsMultiError, or nil if none found.
-func (m *ChangePostgresExporterParams) ValidateAll() error {
+// ValidateAll checks the field values on ChangeQANValkeySlowlogAgentParams
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ChangeQANValkeySlowlogAgentParamsMultiError, or nil if none found.
+func (m *ChangeQANValkeySlowlogAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangePostgresExporterParams) validate(all bool) error {
+func (m *ChangeQANValkeySlowlogAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetMetricsResolutions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangePostgresExporterParamsValidationError{
-					field:  "MetricsResolutions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangePostgresExporterParamsValidationError{
-					field:  "MetricsResolutions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ChangePostgresExporterParamsValidationError{
-				field:  "MetricsResolutions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if d := m.GetConnectionTimeout(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = ChangePostgresExporterParamsValidationError{
-				field:  "ConnectionTimeout",
-				reason: "value is not a valid duration",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-
-			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
-
-			if dur < gte {
-				err := ChangePostgresExporterParamsValidationError{
-					field:  "ConnectionTimeout",
-					reason: "value must be greater than or equal to 0s",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
-		}
-	}
-
 	if m.Enable != nil {
 		// no validation rules for Enable
 	}
@@ -10097,7 +14275,7 @@ func (m *ChangePostgresExporterParams) validate(all bool) error {
 			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangePostgresExporterParamsValidationError{
+					errors = append(errors, ChangeQANValkeySlowlogAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -10105,7 +14283,7 @@ func (m *ChangePostgresExporterParams) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangePostgresExporterParamsValidationError{
+					errors = append(errors, ChangeQANValkeySlowlogAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -10114,7 +14292,7 @@ func (m *ChangePostgresExporterParams) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangePostgresExporterParamsValidationError{
+				return ChangeQANValkeySlowlogAgentParamsValidationError{
 					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -10123,10 +14301,6 @@ func (m *ChangePostgresExporterParams) validate(all bool) error {
 		}
 	}
 
-	if m.EnablePushMetrics != nil {
-		// no validation rules for EnablePushMetrics
-	}
-
 	if m.Username != nil {
 		// no validation rules for Username
 	}
@@ -10143,10 +14317,6 @@ func (m *ChangePostgresExporterParams) validate(all bool) error {
 		// no validation rules for TlsSkipVerify
 	}
 
-	if m.SkipConnectionCheck != nil {
-		// no validation rules for SkipConnectionCheck
-	}
-
 	if m.TlsCa != nil {
 		// no validation rules for TlsCa
 	}
@@ -10159,40 +14329,37 @@ func (m *ChangePostgresExporterParams) validate(all bool) error {
 		// no validation rules for TlsKey
 	}
 
-	if m.AgentPassword != nil {
-		// no validation rules for AgentPassword
-	}
-
-	if m.LogLevel != nil {
-		// no validation rules for LogLevel
+	if m.MaxQueryLength != nil {
+		// no validation rules for MaxQueryLength
 	}
 
-	if m.AutoDiscoveryLimit != nil {
-		// no validation rules for AutoDiscoveryLimit
+	if m.DisableQueryExamples != nil {
+		// no validation rules for DisableQueryExamples
 	}
 
-	if m.ExposeExporter != nil {
-		// no validation rules for ExposeExporter
+	if m.LogLevel != nil {
+		// no validation rules for LogLevel
 	}
 
-	if m.MaxExporterConnections != nil {
-		// no validation rules for MaxExporterConnections
+	if m.SkipConnectionCheck != nil {
+		// no validation rules for SkipConnectionCheck
 	}
 
 	if len(errors) > 0 {
-		return ChangePostgresExporterParamsMultiError(errors)
+		return ChangeQANValkeySlowlogAgentParamsMultiError(errors)
 	}
 
-	return nil
-}
-
-// ChangePostgresExporterParamsMultiError is an error wrapping multiple
-// validation errors returned by ChangePostgresExporterParams.ValidateAll() if
-// the designated constraints aren't met.
-type ChangePostgresExporterParamsMultiError []error
+	return nil
+}
+
+// ChangeQANValkeySlowlogAgentParamsMultiError is an error wrapping multiple
+// validation errors returned by
+// ChangeQANValkeySlowlogAgentParams.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeQANValkeySlowlogAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangePostgresExporterParamsMultiError) Error() string {
+func (m ChangeQANValkeySlowlogAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10201,12 +14368,12 @@ func (m ChangePostgresExporterParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangePostgresExporterParamsMultiError) AllErrors() []error { return m }
+func (m ChangeQANValkeySlowlogAgentParamsMultiError) AllErrors() []error { return m }
 
-// ChangePostgresExporterParamsValidationError is the validation error returned
-// by ChangePostgresExporterParams.Validate if the designated constraints
-// aren't met.
-type ChangePostgresExporterParamsValidationError struct {
+// ChangeQANValkeySlowlogAgentParamsValidationError is the validation error
+// returned by ChangeQANValkeySlowlogAgentParams.Validate if the designated
+// constraints aren't met.
+type ChangeQANValkeySlowlogAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10214,24 +14381,24 @@ type ChangePostgresExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangePostgresExporterParamsValidationError) Field() string { return e.field }
+func (e ChangeQANValkeySlowlogAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangePostgresExporterParamsValidationError) Reason() string { return e.reason }
+func (e ChangeQANValkeySlowlogAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangePostgresExporterParamsValidationError) Cause() error { return e.cause }
+func (e ChangeQANValkeySlowlogAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangePostgresExporterParamsValidationError) Key() bool { return e.key }
+func (e ChangeQANValkeySlowlogAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangePostgresExporterParamsValidationError) ErrorName() string {
-	return "ChangePostgresExporterParamsValidationError"
+func (e ChangeQANValkeySlowlogAgentParamsValidationError) ErrorName() string {
+	return "ChangeQANValkeySlowlogAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangePostgresExporterParamsValidationError) Error() string {
+func (e ChangeQANValkeySlowlogAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10243,7 +14410,7 @@ func (e ChangePostgresExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangePostgresExporterParams.%s: %s%s",
+		"invalid %sChangeQANValkeySlowlogAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -10251,7 +14418,7 @@ func (e ChangePostgresExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = ChangePostgresExporterParamsValidationError{}
+var _ error = ChangeQANValkeySlowlogAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -10259,24 +14426,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangePostgresExporterParamsValidationError{}
+} = ChangeQANValkeySlowlogAgentParamsValidationError{}
 
-// Validate checks the field values on AddProxySQLExporterParams with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AddQANProxySQLDigestAgentParams with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddProxySQLExporterParams) Validate() error {
+func (m *AddQANProxySQLDigestAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddProxySQLExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddProxySQLExporterParamsMultiError, or nil if none found.
-func (m *AddProxySQLExporterParams) ValidateAll() error {
+// ValidateAll checks the field values on AddQANProxySQLDigestAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddQANProxySQLDigestAgentParamsMultiError, or nil if none found.
+func (m *AddQANProxySQLDigestAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddProxySQLExporterParams) validate(all bool) error {
+func (m *AddQANProxySQLDigestAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -10284,7 +14451,7 @@ func (m *AddProxySQLExporterParams) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
-		err := AddProxySQLExporterParamsValidationError{
+		err := AddQANProxySQLDigestAgentParamsValidationError{
 			field:  "PmmAgentId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -10295,7 +14462,7 @@ func (m *AddProxySQLExporterParams) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
-		err := AddProxySQLExporterParamsValidationError{
+		err := AddQANProxySQLDigestAgentParamsValidationError{
 			field:  "ServiceId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -10306,7 +14473,7 @@ func (m *AddProxySQLExporterParams) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetUsername()) < 1 {
-		err := AddProxySQLExporterParamsValidationError{
+		err := AddQANProxySQLDigestAgentParamsValidationError{
 			field:  "Username",
 			reason: "value length must be at least 1 runes",
 		}
@@ -10322,62 +14489,28 @@ func (m *AddProxySQLExporterParams) validate(all bool) error {
 
 	// no validation rules for TlsSkipVerify
 
+	// no validation rules for MaxQueryLength
+
 	// no validation rules for CustomLabels
 
 	// no validation rules for SkipConnectionCheck
 
-	// no validation rules for PushMetrics
-
-	// no validation rules for AgentPassword
-
 	// no validation rules for LogLevel
 
-	// no validation rules for ExposeExporter
-
-	if d := m.GetConnectionTimeout(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = AddProxySQLExporterParamsValidationError{
-				field:  "ConnectionTimeout",
-				reason: "value is not a valid duration",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-
-			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
-
-			if dur < gte {
-				err := AddProxySQLExporterParamsValidationError{
-					field:  "ConnectionTimeout",
-					reason: "value must be greater than or equal to 0s",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
-		}
-	}
-
 	if len(errors) > 0 {
-		return AddProxySQLExporterParamsMultiError(errors)
+		return AddQANProxySQLDigestAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddProxySQLExporterParamsMultiError is an error wrapping multiple validation
-// errors returned by AddProxySQLExporterParams.ValidateAll() if the
-// designated constraints aren't met.
-type AddProxySQLExporterParamsMultiError []error
+// AddQANProxySQLDigestAgentParamsMultiError is an error wrapping multiple
+// validation errors returned by AddQANProxySQLDigestAgentParams.ValidateAll()
+// if the designated constraints aren't met.
+type AddQANProxySQLDigestAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddProxySQLExporterParamsMultiError) Error() string {
+func (m AddQANProxySQLDigestAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10386,11 +14519,12 @@ func (m AddProxySQLExporterParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddProxySQLExporterParamsMultiError) AllErrors() []error { return m }
+func (m AddQANProxySQLDigestAgentParamsMultiError) AllErrors() []error { return m }
 
-// AddProxySQLExporterParamsValidationError is the validation error returned by
-// AddProxySQLExporterParams.Validate if the designated constraints aren't met.
-type AddProxySQLExporterParamsValidationError struct {
+// AddQANProxySQLDigestAgentParamsValidationError is the validation error
+// returned by AddQANProxySQLDigestAgentParams.Validate if the designated
+// constraints aren't met.
+type AddQANProxySQLDigestAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10398,24 +14532,24 @@ type AddProxySQLExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddProxySQLExporterParamsValidationError) Field() string { return e.field }
+func (e AddQANProxySQLDigestAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddProxySQLExporterParamsValidationError) Reason() string { return e.reason }
+func (e AddQANProxySQLDigestAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddProxySQLExporterParamsValidationError) Cause() error { return e.cause }
+func (e AddQANProxySQLDigestAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddProxySQLExporterParamsValidationError) Key() bool { return e.key }
+func (e AddQANProxySQLDigestAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddProxySQLExporterParamsValidationError) ErrorName() string {
-	return "AddProxySQLExporterParamsValidationError"
+func (e AddQANProxySQLDigestAgentParamsValidationError) ErrorName() string {
+	return "AddQANProxySQLDigestAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddProxySQLExporterParamsValidationError) Error() string {
+func (e AddQANProxySQLDigestAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10427,7 +14561,7 @@ func (e AddProxySQLExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddProxySQLExporterParams.%s: %s%s",
+		"invalid %sAddQANProxySQLDigestAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -10435,7 +14569,7 @@ func (e AddProxySQLExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = AddProxySQLExporterParamsValidationError{}
+var _ error = AddQANProxySQLDigestAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -10443,89 +14577,31 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddProxySQLExporterParamsValidationError{}
+} = AddQANProxySQLDigestAgentParamsValidationError{}
 
-// Validate checks the field values on ChangeProxySQLExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChangeProxySQLExporterParams) Validate() error {
+// Validate checks the field values on ChangeQANProxySQLDigestAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ChangeQANProxySQLDigestAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeProxySQLExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ChangeProxySQLExporterParamsMultiError, or nil if none found.
-func (m *ChangeProxySQLExporterParams) ValidateAll() error {
+// ValidateAll checks the field values on ChangeQANProxySQLDigestAgentParams
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ChangeQANProxySQLDigestAgentParamsMultiError, or nil if none found.
+func (m *ChangeQANProxySQLDigestAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeProxySQLExporterParams) validate(all bool) error {
+func (m *ChangeQANProxySQLDigestAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetMetricsResolutions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeProxySQLExporterParamsValidationError{
-					field:  "MetricsResolutions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeProxySQLExporterParamsValidationError{
-					field:  "MetricsResolutions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ChangeProxySQLExporterParamsValidationError{
-				field:  "MetricsResolutions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if d := m.GetConnectionTimeout(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = ChangeProxySQLExporterParamsValidationError{
-				field:  "ConnectionTimeout",
-				reason: "value is not a valid duration",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-
-			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
-
-			if dur < gte {
-				err := ChangeProxySQLExporterParamsValidationError{
-					field:  "ConnectionTimeout",
-					reason: "value must be greater than or equal to 0s",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
-		}
-	}
-
 	if m.Enable != nil {
 		// no validation rules for Enable
 	}
@@ -10535,7 +14611,7 @@ func (m *ChangeProxySQLExporterParams) validate(all bool) error {
 			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeProxySQLExporterParamsValidationError{
+					errors = append(errors, ChangeQANProxySQLDigestAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -10543,7 +14619,7 @@ func (m *ChangeProxySQLExporterParams) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeProxySQLExporterParamsValidationError{
+					errors = append(errors, ChangeQANProxySQLDigestAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -10552,7 +14628,7 @@ func (m *ChangeProxySQLExporterParams) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeProxySQLExporterParamsValidationError{
+				return ChangeQANProxySQLDigestAgentParamsValidationError{
 					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -10561,10 +14637,6 @@ func (m *ChangeProxySQLExporterParams) validate(all bool) error {
 		}
 	}
 
-	if m.EnablePushMetrics != nil {
-		// no validation rules for EnablePushMetrics
-	}
-
 	if m.Username != nil {
 		// no validation rules for Username
 	}
@@ -10581,36 +14653,33 @@ func (m *ChangeProxySQLExporterParams) validate(all bool) error {
 		// no validation rules for TlsSkipVerify
 	}
 
-	if m.AgentPassword != nil {
-		// no validation rules for AgentPassword
+	if m.MaxQueryLength != nil {
+		// no validation rules for MaxQueryLength
 	}
 
 	if m.LogLevel != nil {
 		// no validation rules for LogLevel
 	}
 
-	if m.ExposeExporter != nil {
-		// no validation rules for ExposeExporter
-	}
-
 	if m.SkipConnectionCheck != nil {
 		// no validation rules for SkipConnectionCheck
 	}
 
 	if len(errors) > 0 {
-		return ChangeProxySQLExporterParamsMultiError(errors)
+		return ChangeQANProxySQLDigestAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeProxySQLExporterParamsMultiError is an error wrapping multiple
-// validation errors returned by ChangeProxySQLExporterParams.ValidateAll() if
-// the designated constraints aren't met.
-type ChangeProxySQLExporterParamsMultiError []error
+// ChangeQANProxySQLDigestAgentParamsMultiError is an error wrapping multiple
+// validation errors returned by
+// ChangeQANProxySQLDigestAgentParams.ValidateAll() if the designated
+// constraints aren't met.
+type ChangeQANProxySQLDigestAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeProxySQLExporterParamsMultiError) Error() string {
+func (m ChangeQANProxySQLDigestAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10619,12 +14688,12 @@ func (m ChangeProxySQLExporterParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeProxySQLExporterParamsMultiError) AllErrors() []error { return m }
+func (m ChangeQANProxySQLDigestAgentParamsMultiError) AllErrors() []error { return m }
 
-// ChangeProxySQLExporterParamsValidationError is the validation error returned
-// by ChangeProxySQLExporterParams.Validate if the designated constraints
-// aren't met.
-type ChangeProxySQLExporterParamsValidationError struct {
+// ChangeQANProxySQLDigestAgentParamsValidationError is the validation error
+// returned by ChangeQANProxySQLDigestAgentParams.Validate if the designated
+// constraints aren't met.
+type ChangeQANProxySQLDigestAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10632,24 +14701,24 @@ type ChangeProxySQLExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeProxySQLExporterParamsValidationError) Field() string { return e.field }
+func (e ChangeQANProxySQLDigestAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeProxySQLExporterParamsValidationError) Reason() string { return e.reason }
+func (e ChangeQANProxySQLDigestAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeProxySQLExporterParamsValidationError) Cause() error { return e.cause }
+func (e ChangeQANProxySQLDigestAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeProxySQLExporterParamsValidationError) Key() bool { return e.key }
+func (e ChangeQANProxySQLDigestAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeProxySQLExporterParamsValidationError) ErrorName() string {
-	return "ChangeProxySQLExporterParamsValidationError"
+func (e ChangeQANProxySQLDigestAgentParamsValidationError) ErrorName() string {
+	return "ChangeQANProxySQLDigestAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangeProxySQLExporterParamsValidationError) Error() string {
+func (e ChangeQANProxySQLDigestAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10661,7 +14730,7 @@ func (e ChangeProxySQLExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeProxySQLExporterParams.%s: %s%s",
+		"invalid %sChangeQANProxySQLDigestAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -10669,7 +14738,7 @@ func (e ChangeProxySQLExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = ChangeProxySQLExporterParamsValidationError{}
+var _ error = ChangeQANProxySQLDigestAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -10677,25 +14746,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeProxySQLExporterParamsValidationError{}
+} = ChangeQANProxySQLDigestAgentParamsValidationError{}
 
-// Validate checks the field values on AddQANMySQLPerfSchemaAgentParams with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AddQANMySQLPerfSchemaAgentParams) Validate() error {
+// Validate checks the field values on AddQANPostgreSQLPgStatementsAgentParams
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AddQANPostgreSQLPgStatementsAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddQANMySQLPerfSchemaAgentParams with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AddQANMySQLPerfSchemaAgentParamsMultiError, or nil if none found.
-func (m *AddQANMySQLPerfSchemaAgentParams) ValidateAll() error {
+// ValidateAll checks the field values on
+// AddQANPostgreSQLPgStatementsAgentParams with the rules defined in the proto
+// definition for this message. If any rules are violated, the result is a
+// list of violation errors wrapped in
+// AddQANPostgreSQLPgStatementsAgentParamsMultiError, or nil if none found.
+func (m *AddQANPostgreSQLPgStatementsAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddQANMySQLPerfSchemaAgentParams) validate(all bool) error {
+func (m *AddQANPostgreSQLPgStatementsAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -10703,7 +14773,7 @@ func (m *AddQANMySQLPerfSchemaAgentParams) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
-		err := AddQANMySQLPerfSchemaAgentParamsValidationError{
+		err := AddQANPostgreSQLPgStatementsAgentParamsValidationError{
 			field:  "PmmAgentId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -10714,7 +14784,7 @@ func (m *AddQANMySQLPerfSchemaAgentParams) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
-		err := AddQANMySQLPerfSchemaAgentParamsValidationError{
+		err := AddQANPostgreSQLPgStatementsAgentParamsValidationError{
 			field:  "ServiceId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -10725,7 +14795,7 @@ func (m *AddQANMySQLPerfSchemaAgentParams) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetUsername()) < 1 {
-		err := AddQANMySQLPerfSchemaAgentParamsValidationError{
+		err := AddQANPostgreSQLPgStatementsAgentParamsValidationError{
 			field:  "Username",
 			reason: "value length must be at least 1 runes",
 		}
@@ -10741,41 +14811,37 @@ func (m *AddQANMySQLPerfSchemaAgentParams) validate(all bool) error {
 
 	// no validation rules for TlsSkipVerify
 
-	// no validation rules for TlsCa
+	// no validation rules for CustomLabels
 
-	// no validation rules for TlsCert
+	// no validation rules for SkipConnectionCheck
 
-	// no validation rules for TlsKey
+	// no validation rules for DisableCommentsParsing
 
 	// no validation rules for MaxQueryLength
 
-	// no validation rules for DisableQueryExamples
-
-	// no validation rules for CustomLabels
+	// no validation rules for TlsCa
 
-	// no validation rules for SkipConnectionCheck
+	// no validation rules for TlsCert
 
-	// no validation rules for DisableCommentsParsing
+	// no validation rules for TlsKey
 
 	// no validation rules for LogLevel
 
-	// no validation rules for ExtraDsnParams
-
 	if len(errors) > 0 {
-		return AddQANMySQLPerfSchemaAgentParamsMultiError(errors)
+		return AddQANPostgreSQLPgStatementsAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddQANMySQLPerfSchemaAgentParamsMultiError is an error wrapping multiple
-// validation errors returned by
-// AddQANMySQLPerfSchemaAgentParams.ValidateAll() if the designated
+// AddQANPostgreSQLPgStatementsAgentParamsMultiError is an error wrapping
+// multiple validation errors returned by
+// AddQANPostgreSQLPgStatementsAgentParams.ValidateAll() if the designated
 // constraints aren't met.
-type AddQANMySQLPerfSchemaAgentParamsMultiError []error
+type AddQANPostgreSQLPgStatementsAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddQANMySQLPerfSchemaAgentParamsMultiError) Error() string {
+func (m AddQANPostgreSQLPgStatementsAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -10784,12 +14850,12 @@ func (m AddQANMySQLPerfSchemaAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddQANMySQLPerfSchemaAgentParamsMultiError) AllErrors() []error { return m }
+func (m AddQANPostgreSQLPgStatementsAgentParamsMultiError) AllErrors() []error { return m }
 
-// AddQANMySQLPerfSchemaAgentParamsValidationError is the validation error
-// returned by AddQANMySQLPerfSchemaAgentParams.Validate if the designated
-// constraints aren't met.
-type AddQANMySQLPerfSchemaAgentParamsValidationError struct {
+// AddQANPostgreSQLPgStatementsAgentParamsValidationError is the validation
+// error returned by AddQANPostgreSQLPgStatementsAgentParams.Validate if the
+// designated constraints aren't met.
+type AddQANPostgreSQLPgStatementsAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -10797,24 +14863,24 @@ type AddQANMySQLPerfSchemaAgentParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddQANMySQLPerfSchemaAgentParamsValidationError) Field() string { return e.field }
+func (e AddQANPostgreSQLPgStatementsAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddQANMySQLPerfSchemaAgentParamsValidationError) Reason() string { return e.reason }
+func (e AddQANPostgreSQLPgStatementsAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddQANMySQLPerfSchemaAgentParamsValidationError) Cause() error { return e.cause }
+func (e AddQANPostgreSQLPgStatementsAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddQANMySQLPerfSchemaAgentParamsValidationError) Key() bool { return e.key }
+func (e AddQANPostgreSQLPgStatementsAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddQANMySQLPerfSchemaAgentParamsValidationError) ErrorName() string {
-	return "AddQANMySQLPerfSchemaAgentParamsValidationError"
+func (e AddQANPostgreSQLPgStatementsAgentParamsValidationError) ErrorName() string {
+	return "AddQANPostgreSQLPgStatementsAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddQANMySQLPerfSchemaAgentParamsValidationError) Error() string {
+func (e AddQANPostgreSQLPgStatementsAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -10826,7 +14892,7 @@ func (e AddQANMySQLPerfSchemaAgentParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddQANMySQLPerfSchemaAgentParams.%s: %s%s",
+		"invalid %sAddQANPostgreSQLPgStatementsAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -10834,7 +14900,7 @@ func (e AddQANMySQLPerfSchemaAgentParamsValidationError) Error() string {
 	)
 }
 
-var _ error = AddQANMySQLPerfSchemaAgentParamsValidationError{}
+var _ error = AddQANPostgreSQLPgStatementsAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -10842,25 +14908,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddQANMySQLPerfSchemaAgentParamsValidationError{}
+} = AddQANPostgreSQLPgStatementsAgentParamsValidationError{}
 
-// Validate checks the field values on ChangeQANMySQLPerfSchemaAgentParams with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ChangeQANMySQLPerfSchemaAgentParams) Validate() error {
+// Validate checks the field values on
+// ChangeQANPostgreSQLPgStatementsAgentParams with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChangeQANPostgreSQLPgStatementsAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeQANMySQLPerfSchemaAgentParams
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ChangeQANMySQLPerfSchemaAgentParamsMultiError, or nil if none found.
-func (m *ChangeQANMySQLPerfSchemaAgentParams) ValidateAll() error {
+// ValidateAll checks the field values on
+// ChangeQANPostgreSQLPgStatementsAgentParams with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// ChangeQANPostgreSQLPgStatementsAgentParamsMultiError, or nil if none found.
+func (m *ChangeQANPostgreSQLPgStatementsAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeQANMySQLPerfSchemaAgentParams) validate(all bool) error {
+func (m *ChangeQANPostgreSQLPgStatementsAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -10871,7 +14938,7 @@ func (m *ChangeQANMySQLPerfSchemaAgentParams) validate(all bool) error {
 		switch v := interface{}(m.GetMetricsResolutions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeQANMySQLPerfSchemaAgentParamsValidationError{
+				errors = append(errors, ChangeQANPostgreSQLPgStatementsAgentParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -10879,7 +14946,7 @@ func (m *ChangeQANMySQLPerfSchemaAgentParams) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeQANMySQLPerfSchemaAgentParamsValidationError{
+				errors = append(errors, ChangeQANPostgreSQLPgStatementsAgentParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -10888,7 +14955,7 @@ func (m *ChangeQANMySQLPerfSchemaAgentParams) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ChangeQANMySQLPerfSchemaAgentParamsValidationError{
+			return ChangeQANPostgreSQLPgStatementsAgentParamsValidationError{
 				field:  "MetricsResolutions",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -10905,7 +14972,7 @@ func (m *ChangeQANMySQLPerfSchemaAgentParams) validate(all bool) error {
 			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeQANMySQLPerfSchemaAgentParamsValidationError{
+					errors = append(errors, ChangeQANPostgreSQLPgStatementsAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -10913,7 +14980,7 @@ func (m *ChangeQANMySQLPerfSchemaAgentParams) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeQANMySQLPerfSchemaAgentParamsValidationError{
+					errors = append(errors, ChangeQANPostgreSQLPgStatementsAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -10922,7 +14989,7 @@ func (m *ChangeQANMySQLPerfSchemaAgentParams) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeQANMySQLPerfSchemaAgentParamsValidationError{
+				return ChangeQANPostgreSQLPgStatementsAgentParamsValidationError{
 					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -10951,6 +15018,14 @@ func (m *ChangeQANMySQLPerfSchemaAgentParams) validate(all bool) error {
 		// no validation rules for TlsSkipVerify
 	}
 
+	if m.DisableCommentsParsing != nil {
+		// no validation rules for DisableCommentsParsing
+	}
+
+	if m.MaxQueryLength != nil {
+		// no validation rules for MaxQueryLength
+	}
+
 	if m.TlsCa != nil {
 		// no validation rules for TlsCa
 	}
@@ -10963,41 +15038,29 @@ func (m *ChangeQANMySQLPerfSchemaAgentParams) validate(all bool) error {
 		// no validation rules for TlsKey
 	}
 
-	if m.MaxQueryLength != nil {
-		// no validation rules for MaxQueryLength
-	}
-
-	if m.DisableQueryExamples != nil {
-		// no validation rules for DisableQueryExamples
+	if m.LogLevel != nil {
+		// no validation rules for LogLevel
 	}
 
 	if m.SkipConnectionCheck != nil {
 		// no validation rules for SkipConnectionCheck
 	}
 
-	if m.DisableCommentsParsing != nil {
-		// no validation rules for DisableCommentsParsing
-	}
-
-	if m.LogLevel != nil {
-		// no validation rules for LogLevel
-	}
-
 	if len(errors) > 0 {
-		return ChangeQANMySQLPerfSchemaAgentParamsMultiError(errors)
+		return ChangeQANPostgreSQLPgStatementsAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeQANMySQLPerfSchemaAgentParamsMultiError is an error wrapping multiple
-// validation errors returned by
-// ChangeQANMySQLPerfSchemaAgentParams.ValidateAll() if the designated
+// ChangeQANPostgreSQLPgStatementsAgentParamsMultiError is an error wrapping
+// multiple validation errors returned by
+// ChangeQANPostgreSQLPgStatementsAgentParams.ValidateAll() if the designated
 // constraints aren't met.
-type ChangeQANMySQLPerfSchemaAgentParamsMultiError []error
+type ChangeQANPostgreSQLPgStatementsAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeQANMySQLPerfSchemaAgentParamsMultiError) Error() string {
+func (m ChangeQANPostgreSQLPgStatementsAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11006,12 +15069,12 @@ func (m ChangeQANMySQLPerfSchemaAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeQANMySQLPerfSchemaAgentParamsMultiError) AllErrors() []error { return m }
+func (m ChangeQANPostgreSQLPgStatementsAgentParamsMultiError) AllErrors() []error { return m }
 
-// ChangeQANMySQLPerfSchemaAgentParamsValidationError is the validation error
-// returned by ChangeQANMySQLPerfSchemaAgentParams.Validate if the designated
-// constraints aren't met.
-type ChangeQANMySQLPerfSchemaAgentParamsValidationError struct {
+// ChangeQANPostgreSQLPgStatementsAgentParamsValidationError is the validation
+// error returned by ChangeQANPostgreSQLPgStatementsAgentParams.Validate if
+// the designated constraints aren't met.
+type ChangeQANPostgreSQLPgStatementsAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11019,24 +15082,24 @@ type ChangeQANMySQLPerfSchemaAgentParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeQANMySQLPerfSchemaAgentParamsValidationError) Field() string { return e.field }
+func (e ChangeQANPostgreSQLPgStatementsAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeQANMySQLPerfSchemaAgentParamsValidationError) Reason() string { return e.reason }
+func (e ChangeQANPostgreSQLPgStatementsAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeQANMySQLPerfSchemaAgentParamsValidationError) Cause() error { return e.cause }
+func (e ChangeQANPostgreSQLPgStatementsAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeQANMySQLPerfSchemaAgentParamsValidationError) Key() bool { return e.key }
+func (e ChangeQANPostgreSQLPgStatementsAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeQANMySQLPerfSchemaAgentParamsValidationError) ErrorName() string {
-	return "ChangeQANMySQLPerfSchemaAgentParamsValidationError"
+func (e ChangeQANPostgreSQLPgStatementsAgentParamsValidationError) ErrorName() string {
+	return "ChangeQANPostgreSQLPgStatementsAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangeQANMySQLPerfSchemaAgentParamsValidationError) Error() string {
+func (e ChangeQANPostgreSQLPgStatementsAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11048,7 +15111,7 @@ func (e ChangeQANMySQLPerfSchemaAgentParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeQANMySQLPerfSchemaAgentParams.%s: %s%s",
+		"invalid %sChangeQANPostgreSQLPgStatementsAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -11056,7 +15119,7 @@ func (e ChangeQANMySQLPerfSchemaAgentParamsValidationError) Error() string {
 	)
 }
 
-var _ error = ChangeQANMySQLPerfSchemaAgentParamsValidationError{}
+var _ error = ChangeQANPostgreSQLPgStatementsAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -11064,24 +15127,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeQANMySQLPerfSchemaAgentParamsValidationError{}
+} = ChangeQANPostgreSQLPgStatementsAgentParamsValidationError{}
 
-// Validate checks the field values on AddQANMySQLSlowlogAgentParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddQANMySQLSlowlogAgentParams) Validate() error {
+// Validate checks the field values on AddQANPostgreSQLPgStatMonitorAgentParams
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AddQANPostgreSQLPgStatMonitorAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddQANMySQLSlowlogAgentParams with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AddQANMySQLSlowlogAgentParamsMultiError, or nil if none found.
-func (m *AddQANMySQLSlowlogAgentParams) ValidateAll() error {
+// ValidateAll checks the field values on
+// AddQANPostgreSQLPgStatMonitorAgentParams with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AddQANPostgreSQLPgStatMonitorAgentParamsMultiError, or nil if none found.
+func (m *AddQANPostgreSQLPgStatMonitorAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddQANMySQLSlowlogAgentParams) validate(all bool) error {
+func (m *AddQANPostgreSQLPgStatMonitorAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11089,7 +15154,7 @@ func (m *AddQANMySQLSlowlogAgentParams) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
-		err := AddQANMySQLSlowlogAgentParamsValidationError{
+		err := AddQANPostgreSQLPgStatMonitorAgentParamsValidationError{
 			field:  "PmmAgentId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -11100,7 +15165,7 @@ func (m *AddQANMySQLSlowlogAgentParams) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
-		err := AddQANMySQLSlowlogAgentParamsValidationError{
+		err := AddQANPostgreSQLPgStatMonitorAgentParamsValidationError{
 			field:  "ServiceId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -11111,7 +15176,7 @@ func (m *AddQANMySQLSlowlogAgentParams) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetUsername()) < 1 {
-		err := AddQANMySQLSlowlogAgentParamsValidationError{
+		err := AddQANPostgreSQLPgStatMonitorAgentParamsValidationError{
 			field:  "Username",
 			reason: "value length must be at least 1 runes",
 		}
@@ -11127,42 +15192,39 @@ func (m *AddQANMySQLSlowlogAgentParams) validate(all bool) error {
 
 	// no validation rules for TlsSkipVerify
 
-	// no validation rules for TlsCa
-
-	// no validation rules for TlsCert
-
-	// no validation rules for TlsKey
-
 	// no validation rules for MaxQueryLength
 
 	// no validation rules for DisableQueryExamples
 
-	// no validation rules for MaxSlowlogFileSize
-
 	// no validation rules for CustomLabels
 
 	// no validation rules for SkipConnectionCheck
 
 	// no validation rules for DisableCommentsParsing
 
-	// no validation rules for LogLevel
+	// no validation rules for TlsCa
 
-	// no validation rules for ExtraDsnParams
+	// no validation rules for TlsCert
+
+	// no validation rules for TlsKey
+
+	// no validation rules for LogLevel
 
 	if len(errors) > 0 {
-		return AddQANMySQLSlowlogAgentParamsMultiError(errors)
+		return AddQANPostgreSQLPgStatMonitorAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddQANMySQLSlowlogAgentParamsMultiError is an error wrapping multiple
-// validation errors returned by AddQANMySQLSlowlogAgentParams.ValidateAll()
-// if the designated constraints aren't met.
-type AddQANMySQLSlowlogAgentParamsMultiError []error
+// AddQANPostgreSQLPgStatMonitorAgentParamsMultiError is an error wrapping
+// multiple validation errors returned by
+// AddQANPostgreSQLPgStatMonitorAgentParams.ValidateAll() if the designated
+// constraints aren't met.
+type AddQANPostgreSQLPgStatMonitorAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddQANMySQLSlowlogAgentParamsMultiError) Error() string {
+func (m AddQANPostgreSQLPgStatMonitorAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11171,12 +15233,12 @@ func (m AddQANMySQLSlowlogAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddQANMySQLSlowlogAgentParamsMultiError) AllErrors() []error { return m }
+func (m AddQANPostgreSQLPgStatMonitorAgentParamsMultiError) AllErrors() []error { return m }
 
-// AddQANMySQLSlowlogAgentParamsValidationError is the validation error
-// returned by AddQANMySQLSlowlogAgentParams.Validate if the designated
-// constraints aren't met.
-type AddQANMySQLSlowlogAgentParamsValidationError struct {
+// AddQANPostgreSQLPgStatMonitorAgentParamsValidationError is the validation
+// error returned by AddQANPostgreSQLPgStatMonitorAgentParams.Validate if the
+// designated constraints aren't met.
+type AddQANPostgreSQLPgStatMonitorAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11184,24 +15246,24 @@ type AddQANMySQLSlowlogAgentParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddQANMySQLSlowlogAgentParamsValidationError) Field() string { return e.field }
+func (e AddQANPostgreSQLPgStatMonitorAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddQANMySQLSlowlogAgentParamsValidationError) Reason() string { return e.reason }
+func (e AddQANPostgreSQLPgStatMonitorAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddQANMySQLSlowlogAgentParamsValidationError) Cause() error { return e.cause }
+func (e AddQANPostgreSQLPgStatMonitorAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddQANMySQLSlowlogAgentParamsValidationError) Key() bool { return e.key }
+func (e AddQANPostgreSQLPgStatMonitorAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddQANMySQLSlowlogAgentParamsValidationError) ErrorName() string {
-	return "AddQANMySQLSlowlogAgentParamsValidationError"
+func (e AddQANPostgreSQLPgStatMonitorAgentParamsValidationError) ErrorName() string {
+	return "AddQANPostgreSQLPgStatMonitorAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddQANMySQLSlowlogAgentParamsValidationError) Error() string {
+func (e AddQANPostgreSQLPgStatMonitorAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11213,7 +15275,7 @@ func (e AddQANMySQLSlowlogAgentParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddQANMySQLSlowlogAgentParams.%s: %s%s",
+		"invalid %sAddQANPostgreSQLPgStatMonitorAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -11221,7 +15283,7 @@ func (e AddQANMySQLSlowlogAgentParamsValidationError) Error() string {
 	)
 }
 
-var _ error = AddQANMySQLSlowlogAgentParamsValidationError{}
+var _ error = AddQANPostgreSQLPgStatMonitorAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -11229,25 +15291,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddQANMySQLSlowlogAgentParamsValidationError{}
+} = AddQANPostgreSQLPgStatMonitorAgentParamsValidationError{}
 
-// Validate checks the field values on ChangeQANMySQLSlowlogAgentParams with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ChangeQANMySQLSlowlogAgentParams) Validate() error {
+// Validate checks the field values on
+// ChangeQANPostgreSQLPgStatMonitorAgentParams with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ChangeQANPostgreSQLPgStatMonitorAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeQANMySQLSlowlogAgentParams with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// ChangeQANMySQLSlowlogAgentParamsMultiError, or nil if none found.
-func (m *ChangeQANMySQLSlowlogAgentParams) ValidateAll() error {
+// ValidateAll checks the field values on
+// ChangeQANPostgreSQLPgStatMonitorAgentParams with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// ChangeQANPostgreSQLPgStatMonitorAgentParamsMultiError, or nil if none found.
+func (m *ChangeQANPostgreSQLPgStatMonitorAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeQANMySQLSlowlogAgentParams) validate(all bool) error {
+func (m *ChangeQANPostgreSQLPgStatMonitorAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11258,7 +15321,7 @@ func (m *ChangeQANMySQLSlowlogAgentParams) validate(all bool) error {
 		switch v := interface{}(m.GetMetricsResolutions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeQANMySQLSlowlogAgentParamsValidationError{
+				errors = append(errors, ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11266,7 +15329,7 @@ func (m *ChangeQANMySQLSlowlogAgentParams) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeQANMySQLSlowlogAgentParamsValidationError{
+				errors = append(errors, ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11275,7 +15338,7 @@ func (m *ChangeQANMySQLSlowlogAgentParams) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ChangeQANMySQLSlowlogAgentParamsValidationError{
+			return ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError{
 				field:  "MetricsResolutions",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -11292,7 +15355,7 @@ func (m *ChangeQANMySQLSlowlogAgentParams) validate(all bool) error {
 			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeQANMySQLSlowlogAgentParamsValidationError{
+					errors = append(errors, ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -11300,7 +15363,7 @@ func (m *ChangeQANMySQLSlowlogAgentParams) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeQANMySQLSlowlogAgentParamsValidationError{
+					errors = append(errors, ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -11309,7 +15372,7 @@ func (m *ChangeQANMySQLSlowlogAgentParams) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeQANMySQLSlowlogAgentParamsValidationError{
+				return ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError{
 					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11338,18 +15401,6 @@ func (m *ChangeQANMySQLSlowlogAgentParams) validate(all bool) error {
 		// no validation rules for TlsSkipVerify
 	}
 
-	if m.TlsCa != nil {
-		// no validation rules for TlsCa
-	}
-
-	if m.TlsCert != nil {
-		// no validation rules for TlsCert
-	}
-
-	if m.TlsKey != nil {
-		// no validation rules for TlsKey
-	}
-
 	if m.MaxQueryLength != nil {
 		// no validation rules for MaxQueryLength
 	}
@@ -11358,37 +15409,45 @@ func (m *ChangeQANMySQLSlowlogAgentParams) validate(all bool) error {
 		// no validation rules for DisableQueryExamples
 	}
 
-	if m.MaxSlowlogFileSize != nil {
-		// no validation rules for MaxSlowlogFileSize
+	if m.DisableCommentsParsing != nil {
+		// no validation rules for DisableCommentsParsing
 	}
 
-	if m.SkipConnectionCheck != nil {
-		// no validation rules for SkipConnectionCheck
+	if m.TlsCa != nil {
+		// no validation rules for TlsCa
 	}
 
-	if m.DisableCommentsParsing != nil {
-		// no validation rules for DisableCommentsParsing
+	if m.TlsCert != nil {
+		// no validation rules for TlsCert
+	}
+
+	if m.TlsKey != nil {
+		// no validation rules for TlsKey
 	}
 
 	if m.LogLevel != nil {
 		// no validation rules for LogLevel
 	}
 
+	if m.SkipConnectionCheck != nil {
+		// no validation rules for SkipConnectionCheck
+	}
+
 	if len(errors) > 0 {
-		return ChangeQANMySQLSlowlogAgentParamsMultiError(errors)
+		return ChangeQANPostgreSQLPgStatMonitorAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeQANMySQLSlowlogAgentParamsMultiError is an error wrapping multiple
-// validation errors returned by
-// ChangeQANMySQLSlowlogAgentParams.ValidateAll() if the designated
+// ChangeQANPostgreSQLPgStatMonitorAgentParamsMultiError is an error wrapping
+// multiple validation errors returned by
+// ChangeQANPostgreSQLPgStatMonitorAgentParams.ValidateAll() if the designated
 // constraints aren't met.
-type ChangeQANMySQLSlowlogAgentParamsMultiError []error
+type ChangeQANPostgreSQLPgStatMonitorAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeQANMySQLSlowlogAgentParamsMultiError) Error() string {
+func (m ChangeQANPostgreSQLPgStatMonitorAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11397,12 +15456,12 @@ func (m ChangeQANMySQLSlowlogAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeQANMySQLSlowlogAgentParamsMultiError) AllErrors() []error { return m }
+func (m ChangeQANPostgreSQLPgStatMonitorAgentParamsMultiError) AllErrors() []error { return m }
 
-// ChangeQANMySQLSlowlogAgentParamsValidationError is the validation error
-// returned by ChangeQANMySQLSlowlogAgentParams.Validate if the designated
-// constraints aren't met.
-type ChangeQANMySQLSlowlogAgentParamsValidationError struct {
+// ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError is the validation
+// error returned by ChangeQANPostgreSQLPgStatMonitorAgentParams.Validate if
+// the designated constraints aren't met.
+type ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11410,24 +15469,24 @@ type ChangeQANMySQLSlowlogAgentParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeQANMySQLSlowlogAgentParamsValidationError) Field() string { return e.field }
+func (e ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeQANMySQLSlowlogAgentParamsValidationError) Reason() string { return e.reason }
+func (e ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeQANMySQLSlowlogAgentParamsValidationError) Cause() error { return e.cause }
+func (e ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeQANMySQLSlowlogAgentParamsValidationError) Key() bool { return e.key }
+func (e ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeQANMySQLSlowlogAgentParamsValidationError) ErrorName() string {
-	return "ChangeQANMySQLSlowlogAgentParamsValidationError"
+func (e ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError) ErrorName() string {
+	return "ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangeQANMySQLSlowlogAgentParamsValidationError) Error() string {
+func (e ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11439,7 +15498,7 @@ func (e ChangeQANMySQLSlowlogAgentParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeQANMySQLSlowlogAgentParams.%s: %s%s",
+		"invalid %sChangeQANPostgreSQLPgStatMonitorAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -11447,7 +15506,7 @@ func (e ChangeQANMySQLSlowlogAgentParamsValidationError) Error() string {
 	)
 }
 
-var _ error = ChangeQANMySQLSlowlogAgentParamsValidationError{}
+var _ error = ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -11455,25 +15514,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeQANMySQLSlowlogAgentParamsValidationError{}
+} = ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError{}
 
-// Validate checks the field values on AddQANMongoDBProfilerAgentParams with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AddQANMongoDBProfilerAgentParams) Validate() error {
+// Validate checks the field values on AddQANPostgreSQLLogAgentParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddQANPostgreSQLLogAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddQANMongoDBProfilerAgentParams with
+// ValidateAll checks the field values on AddQANPostgreSQLLogAgentParams with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the result is a list of violation errors wrapped in
-// AddQANMongoDBProfilerAgentParamsMultiError, or nil if none found.
-func (m *AddQANMongoDBProfilerAgentParams) ValidateAll() error {
+// AddQANPostgreSQLLogAgentParamsMultiError, or nil if none found.
+func (m *AddQANPostgreSQLLogAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddQANMongoDBProfilerAgentParams) validate(all bool) error {
+func (m *AddQANPostgreSQLLogAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11481,7 +15539,7 @@ func (m *AddQANMongoDBProfilerAgentParams) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
-		err := AddQANMongoDBProfilerAgentParamsValidationError{
+		err := AddQANPostgreSQLLogAgentParamsValidationError{
 			field:  "PmmAgentId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -11492,7 +15550,7 @@ func (m *AddQANMongoDBProfilerAgentParams) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
-		err := AddQANMongoDBProfilerAgentParamsValidationError{
+		err := AddQANPostgreSQLLogAgentParamsValidationError{
 			field:  "ServiceId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -11502,7 +15560,16 @@ func (m *AddQANMongoDBProfilerAgentParams) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Username
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := AddQANPostgreSQLLogAgentParamsValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for Password
 
@@ -11510,39 +15577,38 @@ func (m *AddQANMongoDBProfilerAgentParams) validate(all bool) error {
 
 	// no validation rules for TlsSkipVerify
 
-	// no validation rules for TlsCertificateKey
-
-	// no validation rules for TlsCertificateKeyFilePassword
-
-	// no validation rules for TlsCa
-
 	// no validation rules for MaxQueryLength
 
+	// no validation rules for DisableQueryExamples
+
 	// no validation rules for CustomLabels
 
 	// no validation rules for SkipConnectionCheck
 
-	// no validation rules for AuthenticationMechanism
+	// no validation rules for DisableCommentsParsing
 
-	// no validation rules for AuthenticationDatabase
+	// no validation rules for TlsCa
+
+	// no validation rules for TlsCert
+
+	// no validation rules for TlsKey
 
 	// no validation rules for LogLevel
 
 	if len(errors) > 0 {
-		return AddQANMongoDBProfilerAgentParamsMultiError(errors)
+		return AddQANPostgreSQLLogAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddQANMongoDBProfilerAgentParamsMultiError is an error wrapping multiple
-// validation errors returned by
-// AddQANMongoDBProfilerAgentParams.ValidateAll() if the designated
-// constraints aren't met.
-type AddQANMongoDBProfilerAgentParamsMultiError []error
+// AddQANPostgreSQLLogAgentParamsMultiError is an error wrapping multiple
+// validation errors returned by AddQANPostgreSQLLogAgentParams.ValidateAll()
+// if the designated constraints aren't met.
+type AddQANPostgreSQLLogAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddQANMongoDBProfilerAgentParamsMultiError) Error() string {
+func (m AddQANPostgreSQLLogAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11551,12 +15617,12 @@ func (m AddQANMongoDBProfilerAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddQANMongoDBProfilerAgentParamsMultiError) AllErrors() []error { return m }
+func (m AddQANPostgreSQLLogAgentParamsMultiError) AllErrors() []error { return m }
 
-// AddQANMongoDBProfilerAgentParamsValidationError is the validation error
-// returned by AddQANMongoDBProfilerAgentParams.Validate if the designated
+// AddQANPostgreSQLLogAgentParamsValidationError is the validation error
+// returned by AddQANPostgreSQLLogAgentParams.Validate if the designated
 // constraints aren't met.
-type AddQANMongoDBProfilerAgentParamsValidationError struct {
+type AddQANPostgreSQLLogAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11564,24 +15630,24 @@ type AddQANMongoDBProfilerAgentParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddQANMongoDBProfilerAgentParamsValidationError) Field() string { return e.field }
+func (e AddQANPostgreSQLLogAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddQANMongoDBProfilerAgentParamsValidationError) Reason() string { return e.reason }
+func (e AddQANPostgreSQLLogAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddQANMongoDBProfilerAgentParamsValidationError) Cause() error { return e.cause }
+func (e AddQANPostgreSQLLogAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddQANMongoDBProfilerAgentParamsValidationError) Key() bool { return e.key }
+func (e AddQANPostgreSQLLogAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddQANMongoDBProfilerAgentParamsValidationError) ErrorName() string {
-	return "AddQANMongoDBProfilerAgentParamsValidationError"
+func (e AddQANPostgreSQLLogAgentParamsValidationError) ErrorName() string {
+	return "AddQANPostgreSQLLogAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddQANMongoDBProfilerAgentParamsValidationError) Error() string {
+func (e AddQANPostgreSQLLogAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11593,7 +15659,7 @@ func (e AddQANMongoDBProfilerAgentParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddQANMongoDBProfilerAgentParams.%s: %s%s",
+		"invalid %sAddQANPostgreSQLLogAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -11601,7 +15667,7 @@ func (e AddQANMongoDBProfilerAgentParamsValidationError) Error() string {
 	)
 }
 
-var _ error = AddQANMongoDBProfilerAgentParamsValidationError{}
+var _ error = AddQANPostgreSQLLogAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -11609,60 +15675,31 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddQANMongoDBProfilerAgentParamsValidationError{}
+} = AddQANPostgreSQLLogAgentParamsValidationError{}
 
-// Validate checks the field values on ChangeQANMongoDBProfilerAgentParams with
+// Validate checks the field values on ChangeQANPostgreSQLLogAgentParams with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, the first error encountered is returned, or nil if there are
 // no violations.
-func (m *ChangeQANMongoDBProfilerAgentParams) Validate() error {
+func (m *ChangeQANPostgreSQLLogAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeQANMongoDBProfilerAgentParams
+// ValidateAll checks the field values on ChangeQANPostgreSQLLogAgentParams
 // with the rules defined in the proto definition for this message. If any
 // rules are violated, the result is a list of violation errors wrapped in
-// ChangeQANMongoDBProfilerAgentParamsMultiError, or nil if none found.
-func (m *ChangeQANMongoDBProfilerAgentParams) ValidateAll() error {
+// ChangeQANPostgreSQLLogAgentParamsMultiError, or nil if none found.
+func (m *ChangeQANPostgreSQLLogAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeQANMongoDBProfilerAgentParams) validate(all bool) error {
+func (m *ChangeQANPostgreSQLLogAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetMetricsResolutions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeQANMongoDBProfilerAgentParamsValidationError{
-					field:  "MetricsResolutions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeQANMongoDBProfilerAgentParamsValidationError{
-					field:  "MetricsResolutions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ChangeQANMongoDBProfilerAgentParamsValidationError{
-				field:  "MetricsResolutions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if m.Enable != nil {
 		// no validation rules for Enable
 	}
@@ -11672,7 +15709,7 @@ func (m *ChangeQANMongoDBProfilerAgentParams) validate(all bool) error {
 			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeQANMongoDBProfilerAgentParamsValidationError{
+					errors = append(errors, ChangeQANPostgreSQLLogAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -11680,7 +15717,7 @@ func (m *ChangeQANMongoDBProfilerAgentParams) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeQANMongoDBProfilerAgentParamsValidationError{
+					errors = append(errors, ChangeQANPostgreSQLLogAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -11689,7 +15726,7 @@ func (m *ChangeQANMongoDBProfilerAgentParams) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeQANMongoDBProfilerAgentParamsValidationError{
+				return ChangeQANPostgreSQLLogAgentParamsValidationError{
 					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -11698,10 +15735,6 @@ func (m *ChangeQANMongoDBProfilerAgentParams) validate(all bool) error {
 		}
 	}
 
-	if m.EnablePushMetrics != nil {
-		// no validation rules for EnablePushMetrics
-	}
-
 	if m.Username != nil {
 		// no validation rules for Username
 	}
@@ -11718,28 +15751,28 @@ func (m *ChangeQANMongoDBProfilerAgentParams) validate(all bool) error {
 		// no validation rules for TlsSkipVerify
 	}
 
-	if m.TlsCertificateKey != nil {
-		// no validation rules for TlsCertificateKey
+	if m.MaxQueryLength != nil {
+		// no validation rules for MaxQueryLength
 	}
 
-	if m.TlsCertificateKeyFilePassword != nil {
-		// no validation rules for TlsCertificateKeyFilePassword
+	if m.DisableQueryExamples != nil {
+		// no validation rules for DisableQueryExamples
 	}
 
-	if m.TlsCa != nil {
-		// no validation rules for TlsCa
+	if m.DisableCommentsParsing != nil {
+		// no validation rules for DisableCommentsParsing
 	}
 
-	if m.MaxQueryLength != nil {
-		// no validation rules for MaxQueryLength
+	if m.TlsCa != nil {
+		// no validation rules for TlsCa
 	}
 
-	if m.AuthenticationMechanism != nil {
-		// no validation rules for AuthenticationMechanism
+	if m.TlsCert != nil {
+		// no validation rules for TlsCert
 	}
 
-	if m.AuthenticationDatabase != nil {
-		// no validation rules for AuthenticationDatabase
+	if m.TlsKey != nil {
+		// no validation rules for TlsKey
 	}
 
 	if m.LogLevel != nil {
@@ -11751,20 +15784,20 @@ func (m *ChangeQANMongoDBProfilerAgentParams) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ChangeQANMongoDBProfilerAgentParamsMultiError(errors)
+		return ChangeQANPostgreSQLLogAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeQANMongoDBProfilerAgentParamsMultiError is an error wrapping multiple
+// ChangeQANPostgreSQLLogAgentParamsMultiError is an error wrapping multiple
 // validation errors returned by
-// ChangeQANMongoDBProfilerAgentParams.ValidateAll() if the designated
+// ChangeQANPostgreSQLLogAgentParams.ValidateAll() if the designated
 // constraints aren't met.
-type ChangeQANMongoDBProfilerAgentParamsMultiError []error
+type ChangeQANPostgreSQLLogAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeQANMongoDBProfilerAgentParamsMultiError) Error() string {
+func (m ChangeQANPostgreSQLLogAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11773,12 +15806,12 @@ func (m ChangeQANMongoDBProfilerAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeQANMongoDBProfilerAgentParamsMultiError) AllErrors() []error { return m }
+func (m ChangeQANPostgreSQLLogAgentParamsMultiError) AllErrors() []error { return m }
 
-// ChangeQANMongoDBProfilerAgentParamsValidationError is the validation error
-// returned by ChangeQANMongoDBProfilerAgentParams.Validate if the designated
+// ChangeQANPostgreSQLLogAgentParamsValidationError is the validation error
+// returned by ChangeQANPostgreSQLLogAgentParams.Validate if the designated
 // constraints aren't met.
-type ChangeQANMongoDBProfilerAgentParamsValidationError struct {
+type ChangeQANPostgreSQLLogAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11786,24 +15819,24 @@ type ChangeQANMongoDBProfilerAgentParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeQANMongoDBProfilerAgentParamsValidationError) Field() string { return e.field }
+func (e ChangeQANPostgreSQLLogAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeQANMongoDBProfilerAgentParamsValidationError) Reason() string { return e.reason }
+func (e ChangeQANPostgreSQLLogAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeQANMongoDBProfilerAgentParamsValidationError) Cause() error { return e.cause }
+func (e ChangeQANPostgreSQLLogAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeQANMongoDBProfilerAgentParamsValidationError) Key() bool { return e.key }
+func (e ChangeQANPostgreSQLLogAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeQANMongoDBProfilerAgentParamsValidationError) ErrorName() string {
-	return "ChangeQANMongoDBProfilerAgentParamsValidationError"
+func (e ChangeQANPostgreSQLLogAgentParamsValidationError) ErrorName() string {
+	return "ChangeQANPostgreSQLLogAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangeQANMongoDBProfilerAgentParamsValidationError) Error() string {
+func (e ChangeQANPostgreSQLLogAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11815,7 +15848,7 @@ func (e ChangeQANMongoDBProfilerAgentParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeQANMongoDBProfilerAgentParams.%s: %s%s",
+		"invalid %sChangeQANPostgreSQLLogAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -11823,7 +15856,7 @@ func (e ChangeQANMongoDBProfilerAgentParamsValidationError) Error() string {
 	)
 }
 
-var _ error = ChangeQANMongoDBProfilerAgentParamsValidationError{}
+var _ error = ChangeQANPostgreSQLLogAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -11831,25 +15864,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeQANMongoDBProfilerAgentParamsValidationError{}
+} = ChangeQANPostgreSQLLogAgentParamsValidationError{}
 
-// Validate checks the field values on AddQANMongoDBMongologAgentParams with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *AddQANMongoDBMongologAgentParams) Validate() error {
+// Validate checks the field values on AddRDSExporterParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddRDSExporterParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddQANMongoDBMongologAgentParams with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AddQANMongoDBMongologAgentParamsMultiError, or nil if none found.
-func (m *AddQANMongoDBMongologAgentParams) ValidateAll() error {
+// ValidateAll checks the field values on AddRDSExporterParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddRDSExporterParamsMultiError, or nil if none found.
+func (m *AddRDSExporterParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddQANMongoDBMongologAgentParams) validate(all bool) error {
+func (m *AddRDSExporterParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -11857,7 +15889,7 @@ func (m *AddQANMongoDBMongologAgentParams) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
-		err := AddQANMongoDBMongologAgentParamsValidationError{
+		err := AddRDSExporterParamsValidationError{
 			field:  "PmmAgentId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -11865,11 +15897,11 @@ func (m *AddQANMongoDBMongologAgentParams) validate(all bool) error {
 			return err
 		}
 		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
-		err := AddQANMongoDBMongologAgentParamsValidationError{
-			field:  "ServiceId",
+	}
+
+	if utf8.RuneCountInString(m.GetNodeId()) < 1 {
+		err := AddRDSExporterParamsValidationError{
+			field:  "NodeId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -11878,47 +15910,36 @@ func (m *AddQANMongoDBMongologAgentParams) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Username
-
-	// no validation rules for Password
-
-	// no validation rules for Tls
-
-	// no validation rules for TlsSkipVerify
-
-	// no validation rules for TlsCertificateKey
-
-	// no validation rules for TlsCertificateKeyFilePassword
-
-	// no validation rules for TlsCa
+	// no validation rules for AwsAccessKey
 
-	// no validation rules for MaxQueryLength
+	// no validation rules for AwsSecretKey
 
 	// no validation rules for CustomLabels
 
 	// no validation rules for SkipConnectionCheck
 
-	// no validation rules for AuthenticationMechanism
+	// no validation rules for DisableBasicMetrics
 
-	// no validation rules for AuthenticationDatabase
+	// no validation rules for DisableEnhancedMetrics
+
+	// no validation rules for PushMetrics
 
 	// no validation rules for LogLevel
 
 	if len(errors) > 0 {
-		return AddQANMongoDBMongologAgentParamsMultiError(errors)
+		return AddRDSExporterParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddQANMongoDBMongologAgentParamsMultiError is an error wrapping multiple
-// validation errors returned by
-// AddQANMongoDBMongologAgentParams.ValidateAll() if the designated
+// AddRDSExporterParamsMultiError is an error wrapping multiple validation
+// errors returned by AddRDSExporterParams.ValidateAll() if the designated
 // constraints aren't met.
-type AddQANMongoDBMongologAgentParamsMultiError []error
+type AddRDSExporterParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddQANMongoDBMongologAgentParamsMultiError) Error() string {
+func (m AddRDSExporterParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -11927,12 +15948,11 @@ func (m AddQANMongoDBMongologAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddQANMongoDBMongologAgentParamsMultiError) AllErrors() []error { return m }
+func (m AddRDSExporterParamsMultiError) AllErrors() []error { return m }
 
-// AddQANMongoDBMongologAgentParamsValidationError is the validation error
-// returned by AddQANMongoDBMongologAgentParams.Validate if the designated
-// constraints aren't met.
-type AddQANMongoDBMongologAgentParamsValidationError struct {
+// AddRDSExporterParamsValidationError is the validation error returned by
+// AddRDSExporterParams.Validate if the designated constraints aren't met.
+type AddRDSExporterParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -11940,24 +15960,24 @@ type AddQANMongoDBMongologAgentParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddQANMongoDBMongologAgentParamsValidationError) Field() string { return e.field }
+func (e AddRDSExporterParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddQANMongoDBMongologAgentParamsValidationError) Reason() string { return e.reason }
+func (e AddRDSExporterParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddQANMongoDBMongologAgentParamsValidationError) Cause() error { return e.cause }
+func (e AddRDSExporterParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddQANMongoDBMongologAgentParamsValidationError) Key() bool { return e.key }
+func (e AddRDSExporterParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddQANMongoDBMongologAgentParamsValidationError) ErrorName() string {
-	return "AddQANMongoDBMongologAgentParamsValidationError"
+func (e AddRDSExporterParamsValidationError) ErrorName() string {
+	return "AddRDSExporterParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddQANMongoDBMongologAgentParamsValidationError) Error() string {
+func (e AddRDSExporterParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -11969,7 +15989,7 @@ func (e AddQANMongoDBMongologAgentParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddQANMongoDBMongologAgentParams.%s: %s%s",
+		"invalid %sAddRDSExporterParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -11977,7 +15997,7 @@ func (e AddQANMongoDBMongologAgentParamsValidationError) Error() string {
 	)
 }
 
-var _ error = AddQANMongoDBMongologAgentParamsValidationError{}
+var _ error = AddRDSExporterParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -11985,25 +16005,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddQANMongoDBMongologAgentParamsValidationError{}
+} = AddRDSExporterParamsValidationError{}
 
-// Validate checks the field values on ChangeQANMongoDBMongologAgentParams with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ChangeQANMongoDBMongologAgentParams) Validate() error {
+// Validate checks the field values on ChangeRDSExporterParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeRDSExporterParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeQANMongoDBMongologAgentParams
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ChangeQANMongoDBMongologAgentParamsMultiError, or nil if none found.
-func (m *ChangeQANMongoDBMongologAgentParams) ValidateAll() error {
+// ValidateAll checks the field values on ChangeRDSExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeRDSExporterParamsMultiError, or nil if none found.
+func (m *ChangeRDSExporterParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeQANMongoDBMongologAgentParams) validate(all bool) error {
+func (m *ChangeRDSExporterParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12014,7 +16033,7 @@ func (m *ChangeQANMongoDBMongologAgentParams) validate(all bool) error {
 		switch v := interface{}(m.GetMetricsResolutions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeQANMongoDBMongologAgentParamsValidationError{
+				errors = append(errors, ChangeRDSExporterParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12022,7 +16041,7 @@ func (m *ChangeQANMongoDBMongologAgentParams) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeQANMongoDBMongologAgentParamsValidationError{
+				errors = append(errors, ChangeRDSExporterParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12031,7 +16050,7 @@ func (m *ChangeQANMongoDBMongologAgentParams) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ChangeQANMongoDBMongologAgentParamsValidationError{
+			return ChangeRDSExporterParamsValidationError{
 				field:  "MetricsResolutions",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -12048,7 +16067,7 @@ func (m *ChangeQANMongoDBMongologAgentParams) validate(all bool) error {
 			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeQANMongoDBMongologAgentParamsValidationError{
+					errors = append(errors, ChangeRDSExporterParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -12056,7 +16075,7 @@ func (m *ChangeQANMongoDBMongologAgentParams) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeQANMongoDBMongologAgentParamsValidationError{
+					errors = append(errors, ChangeRDSExporterParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -12065,7 +16084,7 @@ func (m *ChangeQANMongoDBMongologAgentParams) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeQANMongoDBMongologAgentParamsValidationError{
+				return ChangeRDSExporterParamsValidationError{
 					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12078,69 +16097,40 @@ func (m *ChangeQANMongoDBMongologAgentParams) validate(all bool) error {
 		// no validation rules for EnablePushMetrics
 	}
 
-	if m.Username != nil {
-		// no validation rules for Username
-	}
-
-	if m.Password != nil {
-		// no validation rules for Password
-	}
-
-	if m.Tls != nil {
-		// no validation rules for Tls
-	}
-
-	if m.TlsSkipVerify != nil {
-		// no validation rules for TlsSkipVerify
-	}
-
-	if m.TlsCertificateKey != nil {
-		// no validation rules for TlsCertificateKey
-	}
-
-	if m.TlsCertificateKeyFilePassword != nil {
-		// no validation rules for TlsCertificateKeyFilePassword
-	}
-
-	if m.TlsCa != nil {
-		// no validation rules for TlsCa
+	if m.AwsAccessKey != nil {
+		// no validation rules for AwsAccessKey
 	}
 
-	if m.MaxQueryLength != nil {
-		// no validation rules for MaxQueryLength
+	if m.AwsSecretKey != nil {
+		// no validation rules for AwsSecretKey
 	}
 
-	if m.AuthenticationMechanism != nil {
-		// no validation rules for AuthenticationMechanism
+	if m.DisableBasicMetrics != nil {
+		// no validation rules for DisableBasicMetrics
 	}
 
-	if m.AuthenticationDatabase != nil {
-		// no validation rules for AuthenticationDatabase
+	if m.DisableEnhancedMetrics != nil {
+		// no validation rules for DisableEnhancedMetrics
 	}
 
 	if m.LogLevel != nil {
 		// no validation rules for LogLevel
 	}
 
-	if m.SkipConnectionCheck != nil {
-		// no validation rules for SkipConnectionCheck
-	}
-
 	if len(errors) > 0 {
-		return ChangeQANMongoDBMongologAgentParamsMultiError(errors)
+		return ChangeRDSExporterParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeQANMongoDBMongologAgentParamsMultiError is an error wrapping multiple
-// validation errors returned by
-// ChangeQANMongoDBMongologAgentParams.ValidateAll() if the designated
+// ChangeRDSExporterParamsMultiError is an error wrapping multiple validation
+// errors returned by ChangeRDSExporterParams.ValidateAll() if the designated
 // constraints aren't met.
-type ChangeQANMongoDBMongologAgentParamsMultiError []error
+type ChangeRDSExporterParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeQANMongoDBMongologAgentParamsMultiError) Error() string {
+func (m ChangeRDSExporterParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12149,12 +16139,11 @@ func (m ChangeQANMongoDBMongologAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeQANMongoDBMongologAgentParamsMultiError) AllErrors() []error { return m }
+func (m ChangeRDSExporterParamsMultiError) AllErrors() []error { return m }
 
-// ChangeQANMongoDBMongologAgentParamsValidationError is the validation error
-// returned by ChangeQANMongoDBMongologAgentParams.Validate if the designated
-// constraints aren't met.
-type ChangeQANMongoDBMongologAgentParamsValidationError struct {
+// ChangeRDSExporterParamsValidationError is the validation error returned by
+// ChangeRDSExporterParams.Validate if the designated constraints aren't met.
+type ChangeRDSExporterParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12162,24 +16151,24 @@ type ChangeQANMongoDBMongologAgentParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeQANMongoDBMongologAgentParamsValidationError) Field() string { return e.field }
+func (e ChangeRDSExporterParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeQANMongoDBMongologAgentParamsValidationError) Reason() string { return e.reason }
+func (e ChangeRDSExporterParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeQANMongoDBMongologAgentParamsValidationError) Cause() error { return e.cause }
+func (e ChangeRDSExporterParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeQANMongoDBMongologAgentParamsValidationError) Key() bool { return e.key }
+func (e ChangeRDSExporterParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeQANMongoDBMongologAgentParamsValidationError) ErrorName() string {
-	return "ChangeQANMongoDBMongologAgentParamsValidationError"
+func (e ChangeRDSExporterParamsValidationError) ErrorName() string {
+	return "ChangeRDSExporterParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangeQANMongoDBMongologAgentParamsValidationError) Error() string {
+func (e ChangeRDSExporterParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12191,7 +16180,7 @@ func (e ChangeQANMongoDBMongologAgentParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeQANMongoDBMongologAgentParams.%s: %s%s",
+		"invalid %sChangeRDSExporterParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -12199,7 +16188,7 @@ func (e ChangeQANMongoDBMongologAgentParamsValidationError) Error() string {
 	)
 }
 
-var _ error = ChangeQANMongoDBMongologAgentParamsValidationError{}
+var _ error = ChangeRDSExporterParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -12207,35 +16196,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeQANMongoDBMongologAgentParamsValidationError{}
+} = ChangeRDSExporterParamsValidationError{}
 
-// Validate checks the field values on AddQANPostgreSQLPgStatementsAgentParams
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *AddQANPostgreSQLPgStatementsAgentParams) Validate() error {
+// Validate checks the field values on AddExternalExporterParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddExternalExporterParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AddQANPostgreSQLPgStatementsAgentParams with the rules defined in the proto
-// definition for this message. If any rules are violated, the result is a
-// list of violation errors wrapped in
-// AddQANPostgreSQLPgStatementsAgentParamsMultiError, or nil if none found.
-func (m *AddQANPostgreSQLPgStatementsAgentParams) ValidateAll() error {
+// ValidateAll checks the field values on AddExternalExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddExternalExporterParamsMultiError, or nil if none found.
+func (m *AddExternalExporterParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddQANPostgreSQLPgStatementsAgentParams) validate(all bool) error {
+func (m *AddExternalExporterParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
-		err := AddQANPostgreSQLPgStatementsAgentParamsValidationError{
-			field:  "PmmAgentId",
+	if utf8.RuneCountInString(m.GetRunsOnNodeId()) < 1 {
+		err := AddExternalExporterParamsValidationError{
+			field:  "RunsOnNodeId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -12244,65 +16231,47 @@ func (m *AddQANPostgreSQLPgStatementsAgentParams) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
-		err := AddQANPostgreSQLPgStatementsAgentParamsValidationError{
-			field:  "ServiceId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for ServiceId
 
-	if utf8.RuneCountInString(m.GetUsername()) < 1 {
-		err := AddQANPostgreSQLPgStatementsAgentParamsValidationError{
-			field:  "Username",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Username
 
 	// no validation rules for Password
 
-	// no validation rules for Tls
-
-	// no validation rules for TlsSkipVerify
-
-	// no validation rules for CustomLabels
-
-	// no validation rules for SkipConnectionCheck
-
-	// no validation rules for DisableCommentsParsing
+	// no validation rules for Scheme
 
-	// no validation rules for MaxQueryLength
+	// no validation rules for MetricsPath
 
-	// no validation rules for TlsCa
+	if val := m.GetListenPort(); val <= 0 || val >= 65536 {
+		err := AddExternalExporterParamsValidationError{
+			field:  "ListenPort",
+			reason: "value must be inside range (0, 65536)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for TlsCert
+	// no validation rules for CustomLabels
 
-	// no validation rules for TlsKey
+	// no validation rules for PushMetrics
 
-	// no validation rules for LogLevel
+	// no validation rules for TlsSkipVerify
 
 	if len(errors) > 0 {
-		return AddQANPostgreSQLPgStatementsAgentParamsMultiError(errors)
+		return AddExternalExporterParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddQANPostgreSQLPgStatementsAgentParamsMultiError is an error wrapping
-// multiple validation errors returned by
-// AddQANPostgreSQLPgStatementsAgentParams.ValidateAll() if the designated
-// constraints aren't met.
-type AddQANPostgreSQLPgStatementsAgentParamsMultiError []error
+// AddExternalExporterParamsMultiError is an error wrapping multiple validation
+// errors returned by AddExternalExporterParams.ValidateAll() if the
+// designated constraints aren't met.
+type AddExternalExporterParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddQANPostgreSQLPgStatementsAgentParamsMultiError) Error() string {
+func (m AddExternalExporterParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12311,12 +16280,11 @@ func (m AddQANPostgreSQLPgStatementsAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddQANPostgreSQLPgStatementsAgentParamsMultiError) AllErrors() []error { return m }
+func (m AddExternalExporterParamsMultiError) AllErrors() []error { return m }
 
-// AddQANPostgreSQLPgStatementsAgentParamsValidationError is the validation
-// error returned by AddQANPostgreSQLPgStatementsAgentParams.Validate if the
-// designated constraints aren't met.
-type AddQANPostgreSQLPgStatementsAgentParamsValidationError struct {
+// AddExternalExporterParamsValidationError is the validation error returned by
+// AddExternalExporterParams.Validate if the designated constraints aren't met.
+type AddExternalExporterParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12324,24 +16292,24 @@ type AddQANPostgreSQLPgStatementsAgentParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddQANPostgreSQLPgStatementsAgentParamsValidationError) Field() string { return e.field }
+func (e AddExternalExporterParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddQANPostgreSQLPgStatementsAgentParamsValidationError) Reason() string { return e.reason }
+func (e AddExternalExporterParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddQANPostgreSQLPgStatementsAgentParamsValidationError) Cause() error { return e.cause }
+func (e AddExternalExporterParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddQANPostgreSQLPgStatementsAgentParamsValidationError) Key() bool { return e.key }
+func (e AddExternalExporterParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddQANPostgreSQLPgStatementsAgentParamsValidationError) ErrorName() string {
-	return "AddQANPostgreSQLPgStatementsAgentParamsValidationError"
+func (e AddExternalExporterParamsValidationError) ErrorName() string {
+	return "AddExternalExporterParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddQANPostgreSQLPgStatementsAgentParamsValidationError) Error() string {
+func (e AddExternalExporterParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12353,7 +16321,7 @@ func (e AddQANPostgreSQLPgStatementsAgentParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddQANPostgreSQLPgStatementsAgentParams.%s: %s%s",
+		"invalid %sAddExternalExporterParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -12361,7 +16329,7 @@ func (e AddQANPostgreSQLPgStatementsAgentParamsValidationError) Error() string {
 	)
 }
 
-var _ error = AddQANPostgreSQLPgStatementsAgentParamsValidationError{}
+var _ error = AddExternalExporterParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -12369,26 +16337,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddQANPostgreSQLPgStatementsAgentParamsValidationError{}
+} = AddExternalExporterParamsValidationError{}
 
-// Validate checks the field values on
-// ChangeQANPostgreSQLPgStatementsAgentParams with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ChangeQANPostgreSQLPgStatementsAgentParams) Validate() error {
+// Validate checks the field values on ChangeExternalExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ChangeExternalExporterParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// ChangeQANPostgreSQLPgStatementsAgentParams with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// ChangeQANPostgreSQLPgStatementsAgentParamsMultiError, or nil if none found.
-func (m *ChangeQANPostgreSQLPgStatementsAgentParams) ValidateAll() error {
+// ValidateAll checks the field values on ChangeExternalExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChangeExternalExporterParamsMultiError, or nil if none found.
+func (m *ChangeExternalExporterParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeQANPostgreSQLPgStatementsAgentParams) validate(all bool) error {
+func (m *ChangeExternalExporterParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12399,7 +16365,7 @@ func (m *ChangeQANPostgreSQLPgStatementsAgentParams) validate(all bool) error {
 		switch v := interface{}(m.GetMetricsResolutions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeQANPostgreSQLPgStatementsAgentParamsValidationError{
+				errors = append(errors, ChangeExternalExporterParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12407,7 +16373,7 @@ func (m *ChangeQANPostgreSQLPgStatementsAgentParams) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeQANPostgreSQLPgStatementsAgentParamsValidationError{
+				errors = append(errors, ChangeExternalExporterParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12416,7 +16382,7 @@ func (m *ChangeQANPostgreSQLPgStatementsAgentParams) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ChangeQANPostgreSQLPgStatementsAgentParamsValidationError{
+			return ChangeExternalExporterParamsValidationError{
 				field:  "MetricsResolutions",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -12433,7 +16399,7 @@ func (m *ChangeQANPostgreSQLPgStatementsAgentParams) validate(all bool) error {
 			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeQANPostgreSQLPgStatementsAgentParamsValidationError{
+					errors = append(errors, ChangeExternalExporterParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -12441,7 +16407,7 @@ func (m *ChangeQANPostgreSQLPgStatementsAgentParams) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeQANPostgreSQLPgStatementsAgentParamsValidationError{
+					errors = append(errors, ChangeExternalExporterParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -12450,7 +16416,7 @@ func (m *ChangeQANPostgreSQLPgStatementsAgentParams) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeQANPostgreSQLPgStatementsAgentParamsValidationError{
+				return ChangeExternalExporterParamsValidationError{
 					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12467,40 +16433,16 @@ func (m *ChangeQANPostgreSQLPgStatementsAgentParams) validate(all bool) error {
 		// no validation rules for Username
 	}
 
-	if m.Password != nil {
-		// no validation rules for Password
-	}
-
-	if m.Tls != nil {
-		// no validation rules for Tls
-	}
-
-	if m.TlsSkipVerify != nil {
-		// no validation rules for TlsSkipVerify
-	}
-
-	if m.DisableCommentsParsing != nil {
-		// no validation rules for DisableCommentsParsing
-	}
-
-	if m.MaxQueryLength != nil {
-		// no validation rules for MaxQueryLength
-	}
-
-	if m.TlsCa != nil {
-		// no validation rules for TlsCa
-	}
-
-	if m.TlsCert != nil {
-		// no validation rules for TlsCert
+	if m.Scheme != nil {
+		// no validation rules for Scheme
 	}
 
-	if m.TlsKey != nil {
-		// no validation rules for TlsKey
+	if m.MetricsPath != nil {
+		// no validation rules for MetricsPath
 	}
 
-	if m.LogLevel != nil {
-		// no validation rules for LogLevel
+	if m.ListenPort != nil {
+		// no validation rules for ListenPort
 	}
 
 	if m.SkipConnectionCheck != nil {
@@ -12508,20 +16450,19 @@ func (m *ChangeQANPostgreSQLPgStatementsAgentParams) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ChangeQANPostgreSQLPgStatementsAgentParamsMultiError(errors)
+		return ChangeExternalExporterParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeQANPostgreSQLPgStatementsAgentParamsMultiError is an error wrapping
-// multiple validation errors returned by
-// ChangeQANPostgreSQLPgStatementsAgentParams.ValidateAll() if the designated
-// constraints aren't met.
-type ChangeQANPostgreSQLPgStatementsAgentParamsMultiError []error
+// ChangeExternalExporterParamsMultiError is an error wrapping multiple
+// validation errors returned by ChangeExternalExporterParams.ValidateAll() if
+// the designated constraints aren't met.
+type ChangeExternalExporterParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeQANPostgreSQLPgStatementsAgentParamsMultiError) Error() string {
+func (m ChangeExternalExporterParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12530,12 +16471,12 @@ func (m ChangeQANPostgreSQLPgStatementsAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeQANPostgreSQLPgStatementsAgentParamsMultiError) AllErrors() []error { return m }
+func (m ChangeExternalExporterParamsMultiError) AllErrors() []error { return m }
 
-// ChangeQANPostgreSQLPgStatementsAgentParamsValidationError is the validation
-// error returned by ChangeQANPostgreSQLPgStatementsAgentParams.Validate if
-// the designated constraints aren't met.
-type ChangeQANPostgreSQLPgStatementsAgentParamsValidationError struct {
+// ChangeExternalExporterParamsValidationError is the validation error returned
+// by ChangeExternalExporterParams.Validate if the designated constraints
+// aren't met.
+type ChangeExternalExporterParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12543,24 +16484,24 @@ type ChangeQANPostgreSQLPgStatementsAgentParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeQANPostgreSQLPgStatementsAgentParamsValidationError) Field() string { return e.field }
+func (e ChangeExternalExporterParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeQANPostgreSQLPgStatementsAgentParamsValidationError) Reason() string { return e.reason }
+func (e ChangeExternalExporterParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeQANPostgreSQLPgStatementsAgentParamsValidationError) Cause() error { return e.cause }
+func (e ChangeExternalExporterParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeQANPostgreSQLPgStatementsAgentParamsValidationError) Key() bool { return e.key }
+func (e ChangeExternalExporterParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeQANPostgreSQLPgStatementsAgentParamsValidationError) ErrorName() string {
-	return "ChangeQANPostgreSQLPgStatementsAgentParamsValidationError"
+func (e ChangeExternalExporterParamsValidationError) ErrorName() string {
+	return "ChangeExternalExporterParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangeQANPostgreSQLPgStatementsAgentParamsValidationError) Error() string {
+func (e ChangeExternalExporterParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12572,7 +16513,7 @@ func (e ChangeQANPostgreSQLPgStatementsAgentParamsValidationError) Error() strin
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeQANPostgreSQLPgStatementsAgentParams.%s: %s%s",
+		"invalid %sChangeExternalExporterParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -12580,7 +16521,7 @@ func (e ChangeQANPostgreSQLPgStatementsAgentParamsValidationError) Error() strin
 	)
 }
 
-var _ error = ChangeQANPostgreSQLPgStatementsAgentParamsValidationError{}
+var _ error = ChangeExternalExporterParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -12588,26 +16529,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeQANPostgreSQLPgStatementsAgentParamsValidationError{}
+} = ChangeExternalExporterParamsValidationError{}
 
-// Validate checks the field values on AddQANPostgreSQLPgStatMonitorAgentParams
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *AddQANPostgreSQLPgStatMonitorAgentParams) Validate() error {
+// Validate checks the field values on AddAzureDatabaseExporterParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddAzureDatabaseExporterParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AddQANPostgreSQLPgStatMonitorAgentParams with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// AddQANPostgreSQLPgStatMonitorAgentParamsMultiError, or nil if none found.
-func (m *AddQANPostgreSQLPgStatMonitorAgentParams) ValidateAll() error {
+// ValidateAll checks the field values on AddAzureDatabaseExporterParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// AddAzureDatabaseExporterParamsMultiError, or nil if none found.
+func (m *AddAzureDatabaseExporterParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddQANPostgreSQLPgStatMonitorAgentParams) validate(all bool) error {
+func (m *AddAzureDatabaseExporterParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12615,7 +16554,7 @@ func (m *AddQANPostgreSQLPgStatMonitorAgentParams) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
-		err := AddQANPostgreSQLPgStatMonitorAgentParamsValidationError{
+		err := AddAzureDatabaseExporterParamsValidationError{
 			field:  "PmmAgentId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -12625,9 +16564,9 @@ func (m *AddQANPostgreSQLPgStatMonitorAgentParams) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
-		err := AddQANPostgreSQLPgStatMonitorAgentParamsValidationError{
-			field:  "ServiceId",
+	if utf8.RuneCountInString(m.GetNodeId()) < 1 {
+		err := AddAzureDatabaseExporterParamsValidationError{
+			field:  "NodeId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -12636,9 +16575,19 @@ func (m *AddQANPostgreSQLPgStatMonitorAgentParams) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if utf8.RuneCountInString(m.GetUsername()) < 1 {
-		err := AddQANPostgreSQLPgStatMonitorAgentParamsValidationError{
-			field:  "Username",
+	// no validation rules for AzureClientId
+
+	// no validation rules for AzureClientSecret
+
+	// no validation rules for AzureTenantId
+
+	// no validation rules for AzureSubscriptionId
+
+	// no validation rules for AzureResourceGroup
+
+	if utf8.RuneCountInString(m.GetAzureDatabaseResourceType()) < 1 {
+		err := AddAzureDatabaseExporterParamsValidationError{
+			field:  "AzureDatabaseResourceType",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -12647,45 +16596,28 @@ func (m *AddQANPostgreSQLPgStatMonitorAgentParams) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Password
-
-	// no validation rules for Tls
-
-	// no validation rules for TlsSkipVerify
-
-	// no validation rules for MaxQueryLength
-
-	// no validation rules for DisableQueryExamples
-
 	// no validation rules for CustomLabels
 
 	// no validation rules for SkipConnectionCheck
 
-	// no validation rules for DisableCommentsParsing
-
-	// no validation rules for TlsCa
-
-	// no validation rules for TlsCert
-
-	// no validation rules for TlsKey
+	// no validation rules for PushMetrics
 
 	// no validation rules for LogLevel
 
 	if len(errors) > 0 {
-		return AddQANPostgreSQLPgStatMonitorAgentParamsMultiError(errors)
+		return AddAzureDatabaseExporterParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddQANPostgreSQLPgStatMonitorAgentParamsMultiError is an error wrapping
-// multiple validation errors returned by
-// AddQANPostgreSQLPgStatMonitorAgentParams.ValidateAll() if the designated
-// constraints aren't met.
-type AddQANPostgreSQLPgStatMonitorAgentParamsMultiError []error
+// AddAzureDatabaseExporterParamsMultiError is an error wrapping multiple
+// validation errors returned by AddAzureDatabaseExporterParams.ValidateAll()
+// if the designated constraints aren't met.
+type AddAzureDatabaseExporterParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddQANPostgreSQLPgStatMonitorAgentParamsMultiError) Error() string {
+func (m AddAzureDatabaseExporterParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12694,12 +16626,12 @@ func (m AddQANPostgreSQLPgStatMonitorAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddQANPostgreSQLPgStatMonitorAgentParamsMultiError) AllErrors() []error { return m }
+func (m AddAzureDatabaseExporterParamsMultiError) AllErrors() []error { return m }
 
-// AddQANPostgreSQLPgStatMonitorAgentParamsValidationError is the validation
-// error returned by AddQANPostgreSQLPgStatMonitorAgentParams.Validate if the
-// designated constraints aren't met.
-type AddQANPostgreSQLPgStatMonitorAgentParamsValidationError struct {
+// AddAzureDatabaseExporterParamsValidationError is the validation error
+// returned by AddAzureDatabaseExporterParams.Validate if the designated
+// constraints aren't met.
+type AddAzureDatabaseExporterParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -12707,24 +16639,24 @@ type AddQANPostgreSQLPgStatMonitorAgentParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddQANPostgreSQLPgStatMonitorAgentParamsValidationError) Field() string { return e.field }
+func (e AddAzureDatabaseExporterParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddQANPostgreSQLPgStatMonitorAgentParamsValidationError) Reason() string { return e.reason }
+func (e AddAzureDatabaseExporterParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddQANPostgreSQLPgStatMonitorAgentParamsValidationError) Cause() error { return e.cause }
+func (e AddAzureDatabaseExporterParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddQANPostgreSQLPgStatMonitorAgentParamsValidationError) Key() bool { return e.key }
+func (e AddAzureDatabaseExporterParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddQANPostgreSQLPgStatMonitorAgentParamsValidationError) ErrorName() string {
-	return "AddQANPostgreSQLPgStatMonitorAgentParamsValidationError"
+func (e AddAzureDatabaseExporterParamsValidationError) ErrorName() string {
+	return "AddAzureDatabaseExporterParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddQANPostgreSQLPgStatMonitorAgentParamsValidationError) Error() string {
+func (e AddAzureDatabaseExporterParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -12736,7 +16668,7 @@ func (e AddQANPostgreSQLPgStatMonitorAgentParamsValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddQANPostgreSQLPgStatMonitorAgentParams.%s: %s%s",
+		"invalid %sAddAzureDatabaseExporterParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -12744,7 +16676,7 @@ func (e AddQANPostgreSQLPgStatMonitorAgentParamsValidationError) Error() string
 	)
 }
 
-var _ error = AddQANPostgreSQLPgStatMonitorAgentParamsValidationError{}
+var _ error = AddAzureDatabaseExporterParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -12752,26 +16684,25 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddQANPostgreSQLPgStatMonitorAgentParamsValidationError{}
+} = AddAzureDatabaseExporterParamsValidationError{}
 
-// Validate checks the field values on
-// ChangeQANPostgreSQLPgStatMonitorAgentParams with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ChangeQANPostgreSQLPgStatMonitorAgentParams) Validate() error {
+// Validate checks the field values on ChangeAzureDatabaseExporterParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ChangeAzureDatabaseExporterParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// ChangeQANPostgreSQLPgStatMonitorAgentParams with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// ChangeQANPostgreSQLPgStatMonitorAgentParamsMultiError, or nil if none found.
-func (m *ChangeQANPostgreSQLPgStatMonitorAgentParams) ValidateAll() error {
+// ValidateAll checks the field values on ChangeAzureDatabaseExporterParams
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// ChangeAzureDatabaseExporterParamsMultiError, or nil if none found.
+func (m *ChangeAzureDatabaseExporterParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeQANPostgreSQLPgStatMonitorAgentParams) validate(all bool) error {
+func (m *ChangeAzureDatabaseExporterParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -12782,7 +16713,7 @@ func (m *ChangeQANPostgreSQLPgStatMonitorAgentParams) validate(all bool) error {
 		switch v := interface{}(m.GetMetricsResolutions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError{
+				errors = append(errors, ChangeAzureDatabaseExporterParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12790,7 +16721,7 @@ func (m *ChangeQANPostgreSQLPgStatMonitorAgentParams) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError{
+				errors = append(errors, ChangeAzureDatabaseExporterParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12799,7 +16730,7 @@ func (m *ChangeQANPostgreSQLPgStatMonitorAgentParams) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError{
+			return ChangeAzureDatabaseExporterParamsValidationError{
 				field:  "MetricsResolutions",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -12816,7 +16747,7 @@ func (m *ChangeQANPostgreSQLPgStatMonitorAgentParams) validate(all bool) error {
 			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError{
+					errors = append(errors, ChangeAzureDatabaseExporterParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -12824,7 +16755,7 @@ func (m *ChangeQANPostgreSQLPgStatMonitorAgentParams) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError{
+					errors = append(errors, ChangeAzureDatabaseExporterParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -12833,7 +16764,7 @@ func (m *ChangeQANPostgreSQLPgStatMonitorAgentParams) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError{
+				return ChangeAzureDatabaseExporterParamsValidationError{
 					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -12846,69 +16777,45 @@ func (m *ChangeQANPostgreSQLPgStatMonitorAgentParams) validate(all bool) error {
 		// no validation rules for EnablePushMetrics
 	}
 
-	if m.Username != nil {
-		// no validation rules for Username
-	}
-
-	if m.Password != nil {
-		// no validation rules for Password
-	}
-
-	if m.Tls != nil {
-		// no validation rules for Tls
-	}
-
-	if m.TlsSkipVerify != nil {
-		// no validation rules for TlsSkipVerify
-	}
-
-	if m.MaxQueryLength != nil {
-		// no validation rules for MaxQueryLength
-	}
-
-	if m.DisableQueryExamples != nil {
-		// no validation rules for DisableQueryExamples
+	if m.AzureClientId != nil {
+		// no validation rules for AzureClientId
 	}
 
-	if m.DisableCommentsParsing != nil {
-		// no validation rules for DisableCommentsParsing
+	if m.AzureClientSecret != nil {
+		// no validation rules for AzureClientSecret
 	}
 
-	if m.TlsCa != nil {
-		// no validation rules for TlsCa
+	if m.AzureTenantId != nil {
+		// no validation rules for AzureTenantId
 	}
 
-	if m.TlsCert != nil {
-		// no validation rules for TlsCert
+	if m.AzureSubscriptionId != nil {
+		// no validation rules for AzureSubscriptionId
 	}
 
-	if m.TlsKey != nil {
-		// no validation rules for TlsKey
+	if m.AzureResourceGroup != nil {
+		// no validation rules for AzureResourceGroup
 	}
 
 	if m.LogLevel != nil {
 		// no validation rules for LogLevel
 	}
 
-	if m.SkipConnectionCheck != nil {
-		// no validation rules for SkipConnectionCheck
-	}
-
 	if len(errors) > 0 {
-		return ChangeQANPostgreSQLPgStatMonitorAgentParamsMultiError(errors)
+		return ChangeAzureDatabaseExporterParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeQANPostgreSQLPgStatMonitorAgentParamsMultiError is an error wrapping
-// multiple validation errors returned by
-// ChangeQANPostgreSQLPgStatMonitorAgentParams.ValidateAll() if the designated
+// ChangeAzureDatabaseExporterParamsMultiError is an error wrapping multiple
+// validation errors returned by
+// ChangeAzureDatabaseExporterParams.ValidateAll() if the designated
 // constraints aren't met.
-type ChangeQANPostgreSQLPgStatMonitorAgentParamsMultiError []error
+type ChangeAzureDatabaseExporterParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeQANPostgreSQLPgStatMonitorAgentParamsMultiError) Error() string {
+func (m ChangeAzureDatabaseExporterParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -12917,153 +16824,12 @@ func (m ChangeQANPostgreSQLPgStatMonitorAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeQANPostgreSQLPgStatMonitorAgentParamsMultiError) AllErrors() []error { return m }
-
-// ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError is the validation
-// error returned by ChangeQANPostgreSQLPgStatMonitorAgentParams.Validate if
-// the designated constraints aren't met.
-type ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError) ErrorName() string {
-	return "ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
-	}
-
-	key := ""
-	if e.key {
-		key = "key for "
-	}
-
-	return fmt.Sprintf(
-		"invalid %sChangeQANPostgreSQLPgStatMonitorAgentParams.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause,
-	)
-}
-
-var _ error = ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = ChangeQANPostgreSQLPgStatMonitorAgentParamsValidationError{}
-
-// Validate checks the field values on AddRDSExporterParams with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddRDSExporterParams) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on AddRDSExporterParams with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AddRDSExporterParamsMultiError, or nil if none found.
-func (m *AddRDSExporterParams) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *AddRDSExporterParams) validate(all bool) error {
-	if m == nil {
-		return nil
-	}
-
-	var errors []error
-
-	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
-		err := AddRDSExporterParamsValidationError{
-			field:  "PmmAgentId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	if utf8.RuneCountInString(m.GetNodeId()) < 1 {
-		err := AddRDSExporterParamsValidationError{
-			field:  "NodeId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
-
-	// no validation rules for AwsAccessKey
-
-	// no validation rules for AwsSecretKey
-
-	// no validation rules for CustomLabels
-
-	// no validation rules for SkipConnectionCheck
-
-	// no validation rules for DisableBasicMetrics
-
-	// no validation rules for DisableEnhancedMetrics
-
-	// no validation rules for PushMetrics
-
-	// no validation rules for LogLevel
-
-	if len(errors) > 0 {
-		return AddRDSExporterParamsMultiError(errors)
-	}
-
-	return nil
-}
+func (m ChangeAzureDatabaseExporterParamsMultiError) AllErrors() []error { return m }
 
-// AddRDSExporterParamsMultiError is an error wrapping multiple validation
-// errors returned by AddRDSExporterParams.ValidateAll() if the designated
+// ChangeAzureDatabaseExporterParamsValidationError is the validation error
+// returned by ChangeAzureDatabaseExporterParams.Validate if the designated
 // constraints aren't met.
-type AddRDSExporterParamsMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m AddRDSExporterParamsMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m AddRDSExporterParamsMultiError) AllErrors() []error { return m }
-
-// AddRDSExporterParamsValidationError is the validation error returned by
-// AddRDSExporterParams.Validate if the designated constraints aren't met.
-type AddRDSExporterParamsValidationError struct {
+type ChangeAzureDatabaseExporterParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13071,24 +16837,24 @@ type AddRDSExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddRDSExporterParamsValidationError) Field() string { return e.field }
+func (e ChangeAzureDatabaseExporterParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddRDSExporterParamsValidationError) Reason() string { return e.reason }
+func (e ChangeAzureDatabaseExporterParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddRDSExporterParamsValidationError) Cause() error { return e.cause }
+func (e ChangeAzureDatabaseExporterParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddRDSExporterParamsValidationError) Key() bool { return e.key }
+func (e ChangeAzureDatabaseExporterParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddRDSExporterParamsValidationError) ErrorName() string {
-	return "AddRDSExporterParamsValidationError"
+func (e ChangeAzureDatabaseExporterParamsValidationError) ErrorName() string {
+	return "ChangeAzureDatabaseExporterParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddRDSExporterParamsValidationError) Error() string {
+func (e ChangeAzureDatabaseExporterParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13100,7 +16866,7 @@ func (e AddRDSExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddRDSExporterParams.%s: %s%s",
+		"invalid %sChangeAzureDatabaseExporterParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -13108,7 +16874,7 @@ func (e AddRDSExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = AddRDSExporterParamsValidationError{}
+var _ error = ChangeAzureDatabaseExporterParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -13116,132 +16882,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddRDSExporterParamsValidationError{}
+} = ChangeAzureDatabaseExporterParamsValidationError{}
 
-// Validate checks the field values on ChangeRDSExporterParams with the rules
+// Validate checks the field values on ChangeNomadAgentParams with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChangeRDSExporterParams) Validate() error {
+func (m *ChangeNomadAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeRDSExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ChangeNomadAgentParams with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ChangeRDSExporterParamsMultiError, or nil if none found.
-func (m *ChangeRDSExporterParams) ValidateAll() error {
+// ChangeNomadAgentParamsMultiError, or nil if none found.
+func (m *ChangeNomadAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeRDSExporterParams) validate(all bool) error {
+func (m *ChangeNomadAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetMetricsResolutions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeRDSExporterParamsValidationError{
-					field:  "MetricsResolutions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeRDSExporterParamsValidationError{
-					field:  "MetricsResolutions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ChangeRDSExporterParamsValidationError{
-				field:  "MetricsResolutions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if m.Enable != nil {
 		// no validation rules for Enable
 	}
 
-	if m.CustomLabels != nil {
-		if all {
-			switch v := interface{}(m.GetCustomLabels()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeRDSExporterParamsValidationError{
-						field:  "CustomLabels",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeRDSExporterParamsValidationError{
-						field:  "CustomLabels",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeRDSExporterParamsValidationError{
-					field:  "CustomLabels",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-	}
-
-	if m.EnablePushMetrics != nil {
-		// no validation rules for EnablePushMetrics
-	}
-
-	if m.AwsAccessKey != nil {
-		// no validation rules for AwsAccessKey
-	}
-
-	if m.AwsSecretKey != nil {
-		// no validation rules for AwsSecretKey
-	}
-
-	if m.DisableBasicMetrics != nil {
-		// no validation rules for DisableBasicMetrics
-	}
-
-	if m.DisableEnhancedMetrics != nil {
-		// no validation rules for DisableEnhancedMetrics
-	}
-
-	if m.LogLevel != nil {
-		// no validation rules for LogLevel
-	}
-
 	if len(errors) > 0 {
-		return ChangeRDSExporterParamsMultiError(errors)
+		return ChangeNomadAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeRDSExporterParamsMultiError is an error wrapping multiple validation
-// errors returned by ChangeRDSExporterParams.ValidateAll() if the designated
+// ChangeNomadAgentParamsMultiError is an error wrapping multiple validation
+// errors returned by ChangeNomadAgentParams.ValidateAll() if the designated
 // constraints aren't met.
-type ChangeRDSExporterParamsMultiError []error
+type ChangeNomadAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeRDSExporterParamsMultiError) Error() string {
+func (m ChangeNomadAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13250,11 +16932,11 @@ func (m ChangeRDSExporterParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeRDSExporterParamsMultiError) AllErrors() []error { return m }
+func (m ChangeNomadAgentParamsMultiError) AllErrors() []error { return m }
 
-// ChangeRDSExporterParamsValidationError is the validation error returned by
-// ChangeRDSExporterParams.Validate if the designated constraints aren't met.
-type ChangeRDSExporterParamsValidationError struct {
+// ChangeNomadAgentParamsValidationError is the validation error returned by
+// ChangeNomadAgentParams.Validate if the designated constraints aren't met.
+type ChangeNomadAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13262,24 +16944,24 @@ type ChangeRDSExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeRDSExporterParamsValidationError) Field() string { return e.field }
+func (e ChangeNomadAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeRDSExporterParamsValidationError) Reason() string { return e.reason }
+func (e ChangeNomadAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeRDSExporterParamsValidationError) Cause() error { return e.cause }
+func (e ChangeNomadAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeRDSExporterParamsValidationError) Key() bool { return e.key }
+func (e ChangeNomadAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeRDSExporterParamsValidationError) ErrorName() string {
-	return "ChangeRDSExporterParamsValidationError"
+func (e ChangeNomadAgentParamsValidationError) ErrorName() string {
+	return "ChangeNomadAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangeRDSExporterParamsValidationError) Error() string {
+func (e ChangeNomadAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13291,7 +16973,7 @@ func (e ChangeRDSExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeRDSExporterParams.%s: %s%s",
+		"invalid %sChangeNomadAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -13299,7 +16981,7 @@ func (e ChangeRDSExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = ChangeRDSExporterParamsValidationError{}
+var _ error = ChangeNomadAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -13307,33 +16989,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeRDSExporterParamsValidationError{}
+} = ChangeNomadAgentParamsValidationError{}
 
-// Validate checks the field values on AddExternalExporterParams with the rules
+// Validate checks the field values on AddValkeyExporterParams with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddExternalExporterParams) Validate() error {
+func (m *AddValkeyExporterParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddExternalExporterParams with the
+// ValidateAll checks the field values on AddValkeyExporterParams with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddExternalExporterParamsMultiError, or nil if none found.
-func (m *AddExternalExporterParams) ValidateAll() error {
+// AddValkeyExporterParamsMultiError, or nil if none found.
+func (m *AddValkeyExporterParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddExternalExporterParams) validate(all bool) error {
+func (m *AddValkeyExporterParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if utf8.RuneCountInString(m.GetRunsOnNodeId()) < 1 {
-		err := AddExternalExporterParamsValidationError{
-			field:  "RunsOnNodeId",
+	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
+		err := AddValkeyExporterParamsValidationError{
+			field:  "PmmAgentId",
 			reason: "value length must be at least 1 runes",
 		}
 		if !all {
@@ -13342,20 +17024,22 @@ func (m *AddExternalExporterParams) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for ServiceId
-
-	// no validation rules for Username
-
-	// no validation rules for Password
-
-	// no validation rules for Scheme
-
-	// no validation rules for MetricsPath
+	if err := m._validateUuid(m.GetServiceId()); err != nil {
+		err = AddValkeyExporterParamsValidationError{
+			field:  "ServiceId",
+			reason: "value must be a valid UUID",
+			cause:  err,
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	if val := m.GetListenPort(); val <= 0 || val >= 65536 {
-		err := AddExternalExporterParamsValidationError{
-			field:  "ListenPort",
-			reason: "value must be inside range (0, 65536)",
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := AddValkeyExporterParamsValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -13363,26 +17047,82 @@ func (m *AddExternalExporterParams) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Password
+
+	// no validation rules for Tls
+
+	// no validation rules for TlsSkipVerify
+
 	// no validation rules for CustomLabels
 
+	// no validation rules for SkipConnectionCheck
+
 	// no validation rules for PushMetrics
 
-	// no validation rules for TlsSkipVerify
+	// no validation rules for TlsCa
+
+	// no validation rules for TlsCert
+
+	// no validation rules for TlsKey
+
+	// no validation rules for AgentPassword
+
+	// no validation rules for ExposeExporter
+
+	// no validation rules for LogLevel
+
+	if d := m.GetConnectionTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = AddValkeyExporterParamsValidationError{
+				field:  "ConnectionTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := AddValkeyExporterParamsValidationError{
+					field:  "ConnectionTimeout",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
 
 	if len(errors) > 0 {
-		return AddExternalExporterParamsMultiError(errors)
+		return AddValkeyExporterParamsMultiError(errors)
+	}
+
+	return nil
+}
+
+func (m *AddValkeyExporterParams) _validateUuid(uuid string) error {
+	if matched := _agents_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
 	}
 
 	return nil
 }
 
-// AddExternalExporterParamsMultiError is an error wrapping multiple validation
-// errors returned by AddExternalExporterParams.ValidateAll() if the
-// designated constraints aren't met.
-type AddExternalExporterParamsMultiError []error
+// AddValkeyExporterParamsMultiError is an error wrapping multiple validation
+// errors returned by AddValkeyExporterParams.ValidateAll() if the designated
+// constraints aren't met.
+type AddValkeyExporterParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddExternalExporterParamsMultiError) Error() string {
+func (m AddValkeyExporterParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13391,11 +17131,11 @@ func (m AddExternalExporterParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddExternalExporterParamsMultiError) AllErrors() []error { return m }
+func (m AddValkeyExporterParamsMultiError) AllErrors() []error { return m }
 
-// AddExternalExporterParamsValidationError is the validation error returned by
-// AddExternalExporterParams.Validate if the designated constraints aren't met.
-type AddExternalExporterParamsValidationError struct {
+// AddValkeyExporterParamsValidationError is the validation error returned by
+// AddValkeyExporterParams.Validate if the designated constraints aren't met.
+type AddValkeyExporterParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13403,24 +17143,24 @@ type AddExternalExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddExternalExporterParamsValidationError) Field() string { return e.field }
+func (e AddValkeyExporterParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddExternalExporterParamsValidationError) Reason() string { return e.reason }
+func (e AddValkeyExporterParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddExternalExporterParamsValidationError) Cause() error { return e.cause }
+func (e AddValkeyExporterParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddExternalExporterParamsValidationError) Key() bool { return e.key }
+func (e AddValkeyExporterParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddExternalExporterParamsValidationError) ErrorName() string {
-	return "AddExternalExporterParamsValidationError"
+func (e AddValkeyExporterParamsValidationError) ErrorName() string {
+	return "AddValkeyExporterParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddExternalExporterParamsValidationError) Error() string {
+func (e AddValkeyExporterParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13432,7 +17172,7 @@ func (e AddExternalExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddExternalExporterParams.%s: %s%s",
+		"invalid %sAddValkeyExporterParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -13440,7 +17180,7 @@ func (e AddExternalExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = AddExternalExporterParamsValidationError{}
+var _ error = AddValkeyExporterParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -13448,24 +17188,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddExternalExporterParamsValidationError{}
+} = AddValkeyExporterParamsValidationError{}
 
-// Validate checks the field values on ChangeExternalExporterParams with the
+// Validate checks the field values on ChangeValkeyExporterParams with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChangeExternalExporterParams) Validate() error {
+func (m *ChangeValkeyExporterParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeExternalExporterParams with the
+// ValidateAll checks the field values on ChangeValkeyExporterParams with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ChangeExternalExporterParamsMultiError, or nil if none found.
-func (m *ChangeExternalExporterParams) ValidateAll() error {
+// ChangeValkeyExporterParamsMultiError, or nil if none found.
+func (m *ChangeValkeyExporterParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeExternalExporterParams) validate(all bool) error {
+func (m *ChangeValkeyExporterParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -13476,7 +17216,7 @@ func (m *ChangeExternalExporterParams) validate(all bool) error {
 		switch v := interface{}(m.GetMetricsResolutions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeExternalExporterParamsValidationError{
+				errors = append(errors, ChangeValkeyExporterParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -13484,7 +17224,7 @@ func (m *ChangeExternalExporterParams) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeExternalExporterParamsValidationError{
+				errors = append(errors, ChangeValkeyExporterParamsValidationError{
 					field:  "MetricsResolutions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -13493,7 +17233,7 @@ func (m *ChangeExternalExporterParams) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ChangeExternalExporterParamsValidationError{
+			return ChangeValkeyExporterParamsValidationError{
 				field:  "MetricsResolutions",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -13501,6 +17241,36 @@ func (m *ChangeExternalExporterParams) validate(all bool) error {
 		}
 	}
 
+	if d := m.GetConnectionTimeout(); d != nil {
+		dur, err := d.AsDuration(), d.CheckValid()
+		if err != nil {
+			err = ChangeValkeyExporterParamsValidationError{
+				field:  "ConnectionTimeout",
+				reason: "value is not a valid duration",
+				cause:  err,
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+
+			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
+
+			if dur < gte {
+				err := ChangeValkeyExporterParamsValidationError{
+					field:  "ConnectionTimeout",
+					reason: "value must be greater than or equal to 0s",
+				}
+				if !all {
+					return err
+				}
+				errors = append(errors, err)
+			}
+
+		}
+	}
+
 	if m.Enable != nil {
 		// no validation rules for Enable
 	}
@@ -13510,7 +17280,7 @@ func (m *ChangeExternalExporterParams) validate(all bool) error {
 			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeExternalExporterParamsValidationError{
+					errors = append(errors, ChangeValkeyExporterParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -13518,7 +17288,7 @@ func (m *ChangeExternalExporterParams) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeExternalExporterParamsValidationError{
+					errors = append(errors, ChangeValkeyExporterParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -13527,7 +17297,7 @@ func (m *ChangeExternalExporterParams) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeExternalExporterParamsValidationError{
+				return ChangeValkeyExporterParamsValidationError{
 					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -13541,194 +17311,72 @@ func (m *ChangeExternalExporterParams) validate(all bool) error {
 	}
 
 	if m.Username != nil {
-		// no validation rules for Username
-	}
-
-	if m.Scheme != nil {
-		// no validation rules for Scheme
-	}
-
-	if m.MetricsPath != nil {
-		// no validation rules for MetricsPath
-	}
-
-	if m.ListenPort != nil {
-		// no validation rules for ListenPort
-	}
-
-	if m.SkipConnectionCheck != nil {
-		// no validation rules for SkipConnectionCheck
-	}
-
-	if len(errors) > 0 {
-		return ChangeExternalExporterParamsMultiError(errors)
-	}
-
-	return nil
-}
-
-// ChangeExternalExporterParamsMultiError is an error wrapping multiple
-// validation errors returned by ChangeExternalExporterParams.ValidateAll() if
-// the designated constraints aren't met.
-type ChangeExternalExporterParamsMultiError []error
-
-// Error returns a concatenation of all the error messages it wraps.
-func (m ChangeExternalExporterParamsMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
-		msgs = append(msgs, err.Error())
-	}
-	return strings.Join(msgs, "; ")
-}
-
-// AllErrors returns a list of validation violation errors.
-func (m ChangeExternalExporterParamsMultiError) AllErrors() []error { return m }
-
-// ChangeExternalExporterParamsValidationError is the validation error returned
-// by ChangeExternalExporterParams.Validate if the designated constraints
-// aren't met.
-type ChangeExternalExporterParamsValidationError struct {
-	field  string
-	reason string
-	cause  error
-	key    bool
-}
-
-// Field function returns field value.
-func (e ChangeExternalExporterParamsValidationError) Field() string { return e.field }
-
-// Reason function returns reason value.
-func (e ChangeExternalExporterParamsValidationError) Reason() string { return e.reason }
-
-// Cause function returns cause value.
-func (e ChangeExternalExporterParamsValidationError) Cause() error { return e.cause }
-
-// Key function returns key value.
-func (e ChangeExternalExporterParamsValidationError) Key() bool { return e.key }
-
-// ErrorName returns error name.
-func (e ChangeExternalExporterParamsValidationError) ErrorName() string {
-	return "ChangeExternalExporterParamsValidationError"
-}
-
-// Error satisfies the builtin error interface
-func (e ChangeExternalExporterParamsValidationError) Error() string {
-	cause := ""
-	if e.cause != nil {
-		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+		if utf8.RuneCountInString(m.GetUsername()) < 1 {
+			err := ChangeValkeyExporterParamsValidationError{
+				field:  "Username",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 	}
 
-	key := ""
-	if e.key {
-		key = "key for "
+	if m.Password != nil {
+		// no validation rules for Password
 	}
 
-	return fmt.Sprintf(
-		"invalid %sChangeExternalExporterParams.%s: %s%s",
-		key,
-		e.field,
-		e.reason,
-		cause,
-	)
-}
-
-var _ error = ChangeExternalExporterParamsValidationError{}
-
-var _ interface {
-	Field() string
-	Reason() string
-	Key() bool
-	Cause() error
-	ErrorName() string
-} = ChangeExternalExporterParamsValidationError{}
-
-// Validate checks the field values on AddAzureDatabaseExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddAzureDatabaseExporterParams) Validate() error {
-	return m.validate(false)
-}
-
-// ValidateAll checks the field values on AddAzureDatabaseExporterParams with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// AddAzureDatabaseExporterParamsMultiError, or nil if none found.
-func (m *AddAzureDatabaseExporterParams) ValidateAll() error {
-	return m.validate(true)
-}
-
-func (m *AddAzureDatabaseExporterParams) validate(all bool) error {
-	if m == nil {
-		return nil
+	if m.Tls != nil {
+		// no validation rules for Tls
 	}
 
-	var errors []error
-
-	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
-		err := AddAzureDatabaseExporterParamsValidationError{
-			field:  "PmmAgentId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if m.TlsSkipVerify != nil {
+		// no validation rules for TlsSkipVerify
 	}
 
-	if utf8.RuneCountInString(m.GetNodeId()) < 1 {
-		err := AddAzureDatabaseExporterParamsValidationError{
-			field:  "NodeId",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if m.TlsCa != nil {
+		// no validation rules for TlsCa
 	}
 
-	// no validation rules for AzureClientId
-
-	// no validation rules for AzureClientSecret
-
-	// no validation rules for AzureTenantId
-
-	// no validation rules for AzureSubscriptionId
-
-	// no validation rules for AzureResourceGroup
+	if m.TlsCert != nil {
+		// no validation rules for TlsCert
+	}
 
-	if utf8.RuneCountInString(m.GetAzureDatabaseResourceType()) < 1 {
-		err := AddAzureDatabaseExporterParamsValidationError{
-			field:  "AzureDatabaseResourceType",
-			reason: "value length must be at least 1 runes",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
+	if m.TlsKey != nil {
+		// no validation rules for TlsKey
 	}
 
-	// no validation rules for CustomLabels
+	if m.AgentPassword != nil {
+		// no validation rules for AgentPassword
+	}
 
-	// no validation rules for SkipConnectionCheck
+	if m.ExposeExporter != nil {
+		// no validation rules for ExposeExporter
+	}
 
-	// no validation rules for PushMetrics
+	if m.LogLevel != nil {
+		// no validation rules for LogLevel
+	}
 
-	// no validation rules for LogLevel
+	if m.SkipConnectionCheck != nil {
+		// no validation rules for SkipConnectionCheck
+	}
 
 	if len(errors) > 0 {
-		return AddAzureDatabaseExporterParamsMultiError(errors)
+		return ChangeValkeyExporterParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddAzureDatabaseExporterParamsMultiError is an error wrapping multiple
-// validation errors returned by AddAzureDatabaseExporterParams.ValidateAll()
-// if the designated constraints aren't met.
-type AddAzureDatabaseExporterParamsMultiError []error
+// ChangeValkeyExporterParamsMultiError is an error wrapping multiple
+// validation errors returned by ChangeValkeyExporterParams.ValidateAll() if
+// the designated constraints aren't met.
+type ChangeValkeyExporterParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddAzureDatabaseExporterParamsMultiError) Error() string {
+func (m ChangeValkeyExporterParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13737,12 +17385,11 @@ func (m AddAzureDatabaseExporterParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddAzureDatabaseExporterParamsMultiError) AllErrors() []error { return m }
+func (m ChangeValkeyExporterParamsMultiError) AllErrors() []error { return m }
 
-// AddAzureDatabaseExporterParamsValidationError is the validation error
-// returned by AddAzureDatabaseExporterParams.Validate if the designated
-// constraints aren't met.
-type AddAzureDatabaseExporterParamsValidationError struct {
+// ChangeValkeyExporterParamsValidationError is the validation error returned
+// by ChangeValkeyExporterParams.Validate if the designated constraints aren't met.
+type ChangeValkeyExporterParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13750,24 +17397,24 @@ type AddAzureDatabaseExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddAzureDatabaseExporterParamsValidationError) Field() string { return e.field }
+func (e ChangeValkeyExporterParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddAzureDatabaseExporterParamsValidationError) Reason() string { return e.reason }
+func (e ChangeValkeyExporterParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddAzureDatabaseExporterParamsValidationError) Cause() error { return e.cause }
+func (e ChangeValkeyExporterParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddAzureDatabaseExporterParamsValidationError) Key() bool { return e.key }
+func (e ChangeValkeyExporterParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddAzureDatabaseExporterParamsValidationError) ErrorName() string {
-	return "AddAzureDatabaseExporterParamsValidationError"
+func (e ChangeValkeyExporterParamsValidationError) ErrorName() string {
+	return "ChangeValkeyExporterParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddAzureDatabaseExporterParamsValidationError) Error() string {
+func (e ChangeValkeyExporterParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13779,7 +17426,7 @@ func (e AddAzureDatabaseExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddAzureDatabaseExporterParams.%s: %s%s",
+		"invalid %sChangeValkeyExporterParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -13787,7 +17434,7 @@ func (e AddAzureDatabaseExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = AddAzureDatabaseExporterParamsValidationError{}
+var _ error = ChangeValkeyExporterParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -13795,138 +17442,117 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddAzureDatabaseExporterParamsValidationError{}
+} = ChangeValkeyExporterParamsValidationError{}
 
-// Validate checks the field values on ChangeAzureDatabaseExporterParams with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *ChangeAzureDatabaseExporterParams) Validate() error {
+// Validate checks the field values on AddRTAMongoDBAgentParams with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AddRTAMongoDBAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeAzureDatabaseExporterParams
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// ChangeAzureDatabaseExporterParamsMultiError, or nil if none found.
-func (m *ChangeAzureDatabaseExporterParams) ValidateAll() error {
+// ValidateAll checks the field values on AddRTAMongoDBAgentParams with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AddRTAMongoDBAgentParamsMultiError, or nil if none found.
+func (m *AddRTAMongoDBAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeAzureDatabaseExporterParams) validate(all bool) error {
+func (m *AddRTAMongoDBAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
+		err := AddRTAMongoDBAgentParamsValidationError{
+			field:  "PmmAgentId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
+		err := AddRTAMongoDBAgentParamsValidationError{
+			field:  "ServiceId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for CustomLabels
+
+	// no validation rules for LogLevel
+
+	// no validation rules for Tls
+
+	// no validation rules for TlsSkipVerify
+
+	// no validation rules for TlsCertificateKey
+
+	// no validation rules for TlsCertificateKeyFilePassword
+
+	// no validation rules for TlsCa
+
+	// no validation rules for SkipConnectionCheck
+
+	// no validation rules for AuthenticationMechanism
+
 	if all {
-		switch v := interface{}(m.GetMetricsResolutions()).(type) {
+		switch v := interface{}(m.GetRtaOptions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeAzureDatabaseExporterParamsValidationError{
-					field:  "MetricsResolutions",
+				errors = append(errors, AddRTAMongoDBAgentParamsValidationError{
+					field:  "RtaOptions",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeAzureDatabaseExporterParamsValidationError{
-					field:  "MetricsResolutions",
+				errors = append(errors, AddRTAMongoDBAgentParamsValidationError{
+					field:  "RtaOptions",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetRtaOptions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ChangeAzureDatabaseExporterParamsValidationError{
-				field:  "MetricsResolutions",
+			return AddRTAMongoDBAgentParamsValidationError{
+				field:  "RtaOptions",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
-	if m.Enable != nil {
-		// no validation rules for Enable
-	}
-
-	if m.CustomLabels != nil {
-		if all {
-			switch v := interface{}(m.GetCustomLabels()).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeAzureDatabaseExporterParamsValidationError{
-						field:  "CustomLabels",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeAzureDatabaseExporterParamsValidationError{
-						field:  "CustomLabels",
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ChangeAzureDatabaseExporterParamsValidationError{
-					field:  "CustomLabels",
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-	}
-
-	if m.EnablePushMetrics != nil {
-		// no validation rules for EnablePushMetrics
-	}
-
-	if m.AzureClientId != nil {
-		// no validation rules for AzureClientId
-	}
-
-	if m.AzureClientSecret != nil {
-		// no validation rules for AzureClientSecret
-	}
-
-	if m.AzureTenantId != nil {
-		// no validation rules for AzureTenantId
-	}
-
-	if m.AzureSubscriptionId != nil {
-		// no validation rules for AzureSubscriptionId
-	}
-
-	if m.AzureResourceGroup != nil {
-		// no validation rules for AzureResourceGroup
-	}
-
-	if m.LogLevel != nil {
-		// no validation rules for LogLevel
-	}
-
 	if len(errors) > 0 {
-		return ChangeAzureDatabaseExporterParamsMultiError(errors)
+		return AddRTAMongoDBAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeAzureDatabaseExporterParamsMultiError is an error wrapping multiple
-// validation errors returned by
-// ChangeAzureDatabaseExporterParams.ValidateAll() if the designated
+// AddRTAMongoDBAgentParamsMultiError is an error wrapping multiple validation
+// errors returned by AddRTAMongoDBAgentParams.ValidateAll() if the designated
 // constraints aren't met.
-type ChangeAzureDatabaseExporterParamsMultiError []error
+type AddRTAMongoDBAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeAzureDatabaseExporterParamsMultiError) Error() string {
+func (m AddRTAMongoDBAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -13935,12 +17561,11 @@ func (m ChangeAzureDatabaseExporterParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeAzureDatabaseExporterParamsMultiError) AllErrors() []error { return m }
+func (m AddRTAMongoDBAgentParamsMultiError) AllErrors() []error { return m }
 
-// ChangeAzureDatabaseExporterParamsValidationError is the validation error
-// returned by ChangeAzureDatabaseExporterParams.Validate if the designated
-// constraints aren't met.
-type ChangeAzureDatabaseExporterParamsValidationError struct {
+// AddRTAMongoDBAgentParamsValidationError is the validation error returned by
+// AddRTAMongoDBAgentParams.Validate if the designated constraints aren't met.
+type AddRTAMongoDBAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -13948,24 +17573,24 @@ type ChangeAzureDatabaseExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeAzureDatabaseExporterParamsValidationError) Field() string { return e.field }
+func (e AddRTAMongoDBAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeAzureDatabaseExporterParamsValidationError) Reason() string { return e.reason }
+func (e AddRTAMongoDBAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeAzureDatabaseExporterParamsValidationError) Cause() error { return e.cause }
+func (e AddRTAMongoDBAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeAzureDatabaseExporterParamsValidationError) Key() bool { return e.key }
+func (e AddRTAMongoDBAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeAzureDatabaseExporterParamsValidationError) ErrorName() string {
-	return "ChangeAzureDatabaseExporterParamsValidationError"
+func (e AddRTAMongoDBAgentParamsValidationError) ErrorName() string {
+	return "AddRTAMongoDBAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangeAzureDatabaseExporterParamsValidationError) Error() string {
+func (e AddRTAMongoDBAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -13977,7 +17602,7 @@ func (e ChangeAzureDatabaseExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeAzureDatabaseExporterParams.%s: %s%s",
+		"invalid %sAddRTAMongoDBAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -13985,7 +17610,7 @@ func (e ChangeAzureDatabaseExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = ChangeAzureDatabaseExporterParamsValidationError{}
+var _ error = AddRTAMongoDBAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -13993,24 +17618,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeAzureDatabaseExporterParamsValidationError{}
+} = AddRTAMongoDBAgentParamsValidationError{}
 
-// Validate checks the field values on ChangeNomadAgentParams with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ChangeRTAMongoDBAgentParams with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChangeNomadAgentParams) Validate() error {
+func (m *ChangeRTAMongoDBAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeNomadAgentParams with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on ChangeRTAMongoDBAgentParams with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ChangeNomadAgentParamsMultiError, or nil if none found.
-func (m *ChangeNomadAgentParams) ValidateAll() error {
+// ChangeRTAMongoDBAgentParamsMultiError, or nil if none found.
+func (m *ChangeRTAMongoDBAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeNomadAgentParams) validate(all bool) error {
+func (m *ChangeRTAMongoDBAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14021,20 +17646,122 @@ func (m *ChangeNomadAgentParams) validate(all bool) error {
 		// no validation rules for Enable
 	}
 
+	if m.CustomLabels != nil {
+		if all {
+			switch v := interface{}(m.GetCustomLabels()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeRTAMongoDBAgentParamsValidationError{
+						field:  "CustomLabels",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeRTAMongoDBAgentParamsValidationError{
+						field:  "CustomLabels",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeRTAMongoDBAgentParamsValidationError{
+					field:  "CustomLabels",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	if m.LogLevel != nil {
+		// no validation rules for LogLevel
+	}
+
+	if m.Username != nil {
+		// no validation rules for Username
+	}
+
+	if m.Password != nil {
+		// no validation rules for Password
+	}
+
+	if m.Tls != nil {
+		// no validation rules for Tls
+	}
+
+	if m.TlsSkipVerify != nil {
+		// no validation rules for TlsSkipVerify
+	}
+
+	if m.TlsCertificateKey != nil {
+		// no validation rules for TlsCertificateKey
+	}
+
+	if m.TlsCertificateKeyFilePassword != nil {
+		// no validation rules for TlsCertificateKeyFilePassword
+	}
+
+	if m.TlsCa != nil {
+		// no validation rules for TlsCa
+	}
+
+	if m.AuthenticationMechanism != nil {
+		// no validation rules for AuthenticationMechanism
+	}
+
+	if m.RtaOptions != nil {
+		if all {
+			switch v := interface{}(m.GetRtaOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeRTAMongoDBAgentParamsValidationError{
+						field:  "RtaOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeRTAMongoDBAgentParamsValidationError{
+						field:  "RtaOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRtaOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeRTAMongoDBAgentParamsValidationError{
+					field:  "RtaOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+	}
+
+	if m.SkipConnectionCheck != nil {
+		// no validation rules for SkipConnectionCheck
+	}
+
 	if len(errors) > 0 {
-		return ChangeNomadAgentParamsMultiError(errors)
+		return ChangeRTAMongoDBAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeNomadAgentParamsMultiError is an error wrapping multiple validation
-// errors returned by ChangeNomadAgentParams.ValidateAll() if the designated
-// constraints aren't met.
-type ChangeNomadAgentParamsMultiError []error
+// ChangeRTAMongoDBAgentParamsMultiError is an error wrapping multiple
+// validation errors returned by ChangeRTAMongoDBAgentParams.ValidateAll() if
+// the designated constraints aren't met.
+type ChangeRTAMongoDBAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeNomadAgentParamsMultiError) Error() string {
+func (m ChangeRTAMongoDBAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14043,11 +17770,12 @@ func (m ChangeNomadAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeNomadAgentParamsMultiError) AllErrors() []error { return m }
+func (m ChangeRTAMongoDBAgentParamsMultiError) AllErrors() []error { return m }
 
-// ChangeNomadAgentParamsValidationError is the validation error returned by
-// ChangeNomadAgentParams.Validate if the designated constraints aren't met.
-type ChangeNomadAgentParamsValidationError struct {
+// ChangeRTAMongoDBAgentParamsValidationError is the validation error returned
+// by ChangeRTAMongoDBAgentParams.Validate if the designated constraints
+// aren't met.
+type ChangeRTAMongoDBAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14055,24 +17783,24 @@ type ChangeNomadAgentParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeNomadAgentParamsValidationError) Field() string { return e.field }
+func (e ChangeRTAMongoDBAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeNomadAgentParamsValidationError) Reason() string { return e.reason }
+func (e ChangeRTAMongoDBAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeNomadAgentParamsValidationError) Cause() error { return e.cause }
+func (e ChangeRTAMongoDBAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeNomadAgentParamsValidationError) Key() bool { return e.key }
+func (e ChangeRTAMongoDBAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeNomadAgentParamsValidationError) ErrorName() string {
-	return "ChangeNomadAgentParamsValidationError"
+func (e ChangeRTAMongoDBAgentParamsValidationError) ErrorName() string {
+	return "ChangeRTAMongoDBAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangeNomadAgentParamsValidationError) Error() string {
+func (e ChangeRTAMongoDBAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14084,7 +17812,7 @@ func (e ChangeNomadAgentParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeNomadAgentParams.%s: %s%s",
+		"invalid %sChangeRTAMongoDBAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -14092,7 +17820,7 @@ func (e ChangeNomadAgentParamsValidationError) Error() string {
 	)
 }
 
-var _ error = ChangeNomadAgentParamsValidationError{}
+var _ error = ChangeRTAMongoDBAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -14100,24 +17828,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeNomadAgentParamsValidationError{}
+} = ChangeRTAMongoDBAgentParamsValidationError{}
 
-// Validate checks the field values on AddValkeyExporterParams with the rules
+// Validate checks the field values on AddRTAMySQLAgentParams with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddValkeyExporterParams) Validate() error {
+func (m *AddRTAMySQLAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddValkeyExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on AddRTAMySQLAgentParams with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddValkeyExporterParamsMultiError, or nil if none found.
-func (m *AddValkeyExporterParams) ValidateAll() error {
+// AddRTAMySQLAgentParamsMultiError, or nil if none found.
+func (m *AddRTAMySQLAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddValkeyExporterParams) validate(all bool) error {
+func (m *AddRTAMySQLAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14125,7 +17853,7 @@ func (m *AddValkeyExporterParams) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
-		err := AddValkeyExporterParamsValidationError{
+		err := AddRTAMySQLAgentParamsValidationError{
 			field:  "PmmAgentId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -14135,11 +17863,10 @@ func (m *AddValkeyExporterParams) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if err := m._validateUuid(m.GetServiceId()); err != nil {
-		err = AddValkeyExporterParamsValidationError{
+	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
+		err := AddRTAMySQLAgentParamsValidationError{
 			field:  "ServiceId",
-			reason: "value must be a valid UUID",
-			cause:  err,
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -14148,7 +17875,7 @@ func (m *AddValkeyExporterParams) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetUsername()) < 1 {
-		err := AddValkeyExporterParamsValidationError{
+		err := AddRTAMySQLAgentParamsValidationError{
 			field:  "Username",
 			reason: "value length must be at least 1 runes",
 		}
@@ -14160,15 +17887,13 @@ func (m *AddValkeyExporterParams) validate(all bool) error {
 
 	// no validation rules for Password
 
-	// no validation rules for Tls
-
-	// no validation rules for TlsSkipVerify
-
 	// no validation rules for CustomLabels
 
-	// no validation rules for SkipConnectionCheck
+	// no validation rules for LogLevel
 
-	// no validation rules for PushMetrics
+	// no validation rules for Tls
+
+	// no validation rules for TlsSkipVerify
 
 	// no validation rules for TlsCa
 
@@ -14176,64 +17901,53 @@ func (m *AddValkeyExporterParams) validate(all bool) error {
 
 	// no validation rules for TlsKey
 
-	// no validation rules for AgentPassword
-
-	// no validation rules for ExposeExporter
+	// no validation rules for SkipConnectionCheck
 
-	// no validation rules for LogLevel
+	// no validation rules for ExtraDsnParams
 
-	if d := m.GetConnectionTimeout(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = AddValkeyExporterParamsValidationError{
-				field:  "ConnectionTimeout",
-				reason: "value is not a valid duration",
-				cause:  err,
+	if all {
+		switch v := interface{}(m.GetRtaOptions()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AddRTAMySQLAgentParamsValidationError{
+					field:  "RtaOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			if !all {
-				return err
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AddRTAMySQLAgentParamsValidationError{
+					field:  "RtaOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-			errors = append(errors, err)
-		} else {
-
-			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
-
-			if dur < gte {
-				err := AddValkeyExporterParamsValidationError{
-					field:  "ConnectionTimeout",
-					reason: "value must be greater than or equal to 0s",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
+		}
+	} else if v, ok := interface{}(m.GetRtaOptions()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AddRTAMySQLAgentParamsValidationError{
+				field:  "RtaOptions",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
-
 		}
 	}
 
 	if len(errors) > 0 {
-		return AddValkeyExporterParamsMultiError(errors)
-	}
-
-	return nil
-}
-
-func (m *AddValkeyExporterParams) _validateUuid(uuid string) error {
-	if matched := _agents_uuidPattern.MatchString(uuid); !matched {
-		return errors.New("invalid uuid format")
+		return AddRTAMySQLAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddValkeyExporterParamsMultiError is an error wrapping multiple validation
-// errors returned by AddValkeyExporterParams.ValidateAll() if the designated
+// AddRTAMySQLAgentParamsMultiError is an error wrapping multiple validation
+// errors returned by AddRTAMySQLAgentParams.ValidateAll() if the designated
 // constraints aren't met.
-type AddValkeyExporterParamsMultiError []error
+type AddRTAMySQLAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddValkeyExporterParamsMultiError) Error() string {
+func (m AddRTAMySQLAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14242,11 +17956,11 @@ func (m AddValkeyExporterParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddValkeyExporterParamsMultiError) AllErrors() []error { return m }
+func (m AddRTAMySQLAgentParamsMultiError) AllErrors() []error { return m }
 
-// AddValkeyExporterParamsValidationError is the validation error returned by
-// AddValkeyExporterParams.Validate if the designated constraints aren't met.
-type AddValkeyExporterParamsValidationError struct {
+// AddRTAMySQLAgentParamsValidationError is the validation error returned by
+// AddRTAMySQLAgentParams.Validate if the designated constraints aren't met.
+type AddRTAMySQLAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14254,24 +17968,24 @@ type AddValkeyExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddValkeyExporterParamsValidationError) Field() string { return e.field }
+func (e AddRTAMySQLAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddValkeyExporterParamsValidationError) Reason() string { return e.reason }
+func (e AddRTAMySQLAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddValkeyExporterParamsValidationError) Cause() error { return e.cause }
+func (e AddRTAMySQLAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddValkeyExporterParamsValidationError) Key() bool { return e.key }
+func (e AddRTAMySQLAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddValkeyExporterParamsValidationError) ErrorName() string {
-	return "AddValkeyExporterParamsValidationError"
+func (e AddRTAMySQLAgentParamsValidationError) ErrorName() string {
+	return "AddRTAMySQLAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddValkeyExporterParamsValidationError) Error() string {
+func (e AddRTAMySQLAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14283,7 +17997,7 @@ func (e AddValkeyExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddValkeyExporterParams.%s: %s%s",
+		"invalid %sAddRTAMySQLAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -14291,7 +18005,7 @@ func (e AddValkeyExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = AddValkeyExporterParamsValidationError{}
+var _ error = AddRTAMySQLAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -14299,89 +18013,30 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddValkeyExporterParamsValidationError{}
+} = AddRTAMySQLAgentParamsValidationError{}
 
-// Validate checks the field values on ChangeValkeyExporterParams with the
-// rules defined in the proto definition for this message. If any rules are
+// Validate checks the field values on ChangeRTAMySQLAgentParams with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChangeValkeyExporterParams) Validate() error {
+func (m *ChangeRTAMySQLAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeValkeyExporterParams with the
+// ValidateAll checks the field values on ChangeRTAMySQLAgentParams with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ChangeValkeyExporterParamsMultiError, or nil if none found.
-func (m *ChangeValkeyExporterParams) ValidateAll() error {
+// ChangeRTAMySQLAgentParamsMultiError, or nil if none found.
+func (m *ChangeRTAMySQLAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeValkeyExporterParams) validate(all bool) error {
+func (m *ChangeRTAMySQLAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetMetricsResolutions()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ChangeValkeyExporterParamsValidationError{
-					field:  "MetricsResolutions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ChangeValkeyExporterParamsValidationError{
-					field:  "MetricsResolutions",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetMetricsResolutions()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ChangeValkeyExporterParamsValidationError{
-				field:  "MetricsResolutions",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
-	if d := m.GetConnectionTimeout(); d != nil {
-		dur, err := d.AsDuration(), d.CheckValid()
-		if err != nil {
-			err = ChangeValkeyExporterParamsValidationError{
-				field:  "ConnectionTimeout",
-				reason: "value is not a valid duration",
-				cause:  err,
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		} else {
-
-			gte := time.Duration(0*time.Second + 0*time.Nanosecond)
-
-			if dur < gte {
-				err := ChangeValkeyExporterParamsValidationError{
-					field:  "ConnectionTimeout",
-					reason: "value must be greater than or equal to 0s",
-				}
-				if !all {
-					return err
-				}
-				errors = append(errors, err)
-			}
-
-		}
-	}
-
 	if m.Enable != nil {
 		// no validation rules for Enable
 	}
@@ -14391,7 +18046,7 @@ func (m *ChangeValkeyExporterParams) validate(all bool) error {
 			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeValkeyExporterParamsValidationError{
+					errors = append(errors, ChangeRTAMySQLAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -14399,7 +18054,7 @@ func (m *ChangeValkeyExporterParams) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeValkeyExporterParamsValidationError{
+					errors = append(errors, ChangeRTAMySQLAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -14408,7 +18063,7 @@ func (m *ChangeValkeyExporterParams) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeValkeyExporterParamsValidationError{
+				return ChangeRTAMySQLAgentParamsValidationError{
 					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14417,21 +18072,12 @@ func (m *ChangeValkeyExporterParams) validate(all bool) error {
 		}
 	}
 
-	if m.EnablePushMetrics != nil {
-		// no validation rules for EnablePushMetrics
+	if m.LogLevel != nil {
+		// no validation rules for LogLevel
 	}
 
 	if m.Username != nil {
-		if utf8.RuneCountInString(m.GetUsername()) < 1 {
-			err := ChangeValkeyExporterParamsValidationError{
-				field:  "Username",
-				reason: "value length must be at least 1 runes",
-			}
-			if !all {
-				return err
-			}
-			errors = append(errors, err)
-		}
+		// no validation rules for Username
 	}
 
 	if m.Password != nil {
@@ -14458,16 +18104,35 @@ func (m *ChangeValkeyExporterParams) validate(all bool) error {
 		// no validation rules for TlsKey
 	}
 
-	if m.AgentPassword != nil {
-		// no validation rules for AgentPassword
-	}
-
-	if m.ExposeExporter != nil {
-		// no validation rules for ExposeExporter
-	}
-
-	if m.LogLevel != nil {
-		// no validation rules for LogLevel
+	if m.RtaOptions != nil {
+		if all {
+			switch v := interface{}(m.GetRtaOptions()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ChangeRTAMySQLAgentParamsValidationError{
+						field:  "RtaOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ChangeRTAMySQLAgentParamsValidationError{
+						field:  "RtaOptions",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetRtaOptions()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ChangeRTAMySQLAgentParamsValidationError{
+					field:  "RtaOptions",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
 	}
 
 	if m.SkipConnectionCheck != nil {
@@ -14475,19 +18140,19 @@ func (m *ChangeValkeyExporterParams) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ChangeValkeyExporterParamsMultiError(errors)
+		return ChangeRTAMySQLAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeValkeyExporterParamsMultiError is an error wrapping multiple
-// validation errors returned by ChangeValkeyExporterParams.ValidateAll() if
-// the designated constraints aren't met.
-type ChangeValkeyExporterParamsMultiError []error
+// ChangeRTAMySQLAgentParamsMultiError is an error wrapping multiple validation
+// errors returned by ChangeRTAMySQLAgentParams.ValidateAll() if the
+// designated constraints aren't met.
+type ChangeRTAMySQLAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeValkeyExporterParamsMultiError) Error() string {
+func (m ChangeRTAMySQLAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14496,11 +18161,11 @@ func (m ChangeValkeyExporterParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeValkeyExporterParamsMultiError) AllErrors() []error { return m }
+func (m ChangeRTAMySQLAgentParamsMultiError) AllErrors() []error { return m }
 
-// ChangeValkeyExporterParamsValidationError is the validation error returned
-// by ChangeValkeyExporterParams.Validate if the designated constraints aren't met.
-type ChangeValkeyExporterParamsValidationError struct {
+// ChangeRTAMySQLAgentParamsValidationError is the validation error returned by
+// ChangeRTAMySQLAgentParams.Validate if the designated constraints aren't met.
+type ChangeRTAMySQLAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14508,24 +18173,24 @@ type ChangeValkeyExporterParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeValkeyExporterParamsValidationError) Field() string { return e.field }
+func (e ChangeRTAMySQLAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeValkeyExporterParamsValidationError) Reason() string { return e.reason }
+func (e ChangeRTAMySQLAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeValkeyExporterParamsValidationError) Cause() error { return e.cause }
+func (e ChangeRTAMySQLAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeValkeyExporterParamsValidationError) Key() bool { return e.key }
+func (e ChangeRTAMySQLAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeValkeyExporterParamsValidationError) ErrorName() string {
-	return "ChangeValkeyExporterParamsValidationError"
+func (e ChangeRTAMySQLAgentParamsValidationError) ErrorName() string {
+	return "ChangeRTAMySQLAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangeValkeyExporterParamsValidationError) Error() string {
+func (e ChangeRTAMySQLAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14537,7 +18202,7 @@ func (e ChangeValkeyExporterParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeValkeyExporterParams.%s: %s%s",
+		"invalid %sChangeRTAMySQLAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -14545,7 +18210,7 @@ func (e ChangeValkeyExporterParamsValidationError) Error() string {
 	)
 }
 
-var _ error = ChangeValkeyExporterParamsValidationError{}
+var _ error = ChangeRTAMySQLAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -14553,24 +18218,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeValkeyExporterParamsValidationError{}
+} = ChangeRTAMySQLAgentParamsValidationError{}
 
-// Validate checks the field values on AddRTAMongoDBAgentParams with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on AddRTAPostgreSQLAgentParams with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AddRTAMongoDBAgentParams) Validate() error {
+func (m *AddRTAPostgreSQLAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AddRTAMongoDBAgentParams with the
+// ValidateAll checks the field values on AddRTAPostgreSQLAgentParams with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// AddRTAMongoDBAgentParamsMultiError, or nil if none found.
-func (m *AddRTAMongoDBAgentParams) ValidateAll() error {
+// AddRTAPostgreSQLAgentParamsMultiError, or nil if none found.
+func (m *AddRTAPostgreSQLAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AddRTAMongoDBAgentParams) validate(all bool) error {
+func (m *AddRTAPostgreSQLAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14578,7 +18243,7 @@ func (m *AddRTAMongoDBAgentParams) validate(all bool) error {
 	var errors []error
 
 	if utf8.RuneCountInString(m.GetPmmAgentId()) < 1 {
-		err := AddRTAMongoDBAgentParamsValidationError{
+		err := AddRTAPostgreSQLAgentParamsValidationError{
 			field:  "PmmAgentId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -14589,7 +18254,7 @@ func (m *AddRTAMongoDBAgentParams) validate(all bool) error {
 	}
 
 	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
-		err := AddRTAMongoDBAgentParamsValidationError{
+		err := AddRTAPostgreSQLAgentParamsValidationError{
 			field:  "ServiceId",
 			reason: "value length must be at least 1 runes",
 		}
@@ -14599,7 +18264,16 @@ func (m *AddRTAMongoDBAgentParams) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	// no validation rules for Username
+	if utf8.RuneCountInString(m.GetUsername()) < 1 {
+		err := AddRTAPostgreSQLAgentParamsValidationError{
+			field:  "Username",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	// no validation rules for Password
 
@@ -14611,21 +18285,19 @@ func (m *AddRTAMongoDBAgentParams) validate(all bool) error {
 
 	// no validation rules for TlsSkipVerify
 
-	// no validation rules for TlsCertificateKey
+	// no validation rules for TlsCa
 
-	// no validation rules for TlsCertificateKeyFilePassword
+	// no validation rules for TlsCert
 
-	// no validation rules for TlsCa
+	// no validation rules for TlsKey
 
 	// no validation rules for SkipConnectionCheck
 
-	// no validation rules for AuthenticationMechanism
-
 	if all {
 		switch v := interface{}(m.GetRtaOptions()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AddRTAMongoDBAgentParamsValidationError{
+				errors = append(errors, AddRTAPostgreSQLAgentParamsValidationError{
 					field:  "RtaOptions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14633,7 +18305,7 @@ func (m *AddRTAMongoDBAgentParams) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AddRTAMongoDBAgentParamsValidationError{
+				errors = append(errors, AddRTAPostgreSQLAgentParamsValidationError{
 					field:  "RtaOptions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14642,7 +18314,7 @@ func (m *AddRTAMongoDBAgentParams) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetRtaOptions()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AddRTAMongoDBAgentParamsValidationError{
+			return AddRTAPostgreSQLAgentParamsValidationError{
 				field:  "RtaOptions",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -14651,19 +18323,19 @@ func (m *AddRTAMongoDBAgentParams) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return AddRTAMongoDBAgentParamsMultiError(errors)
+		return AddRTAPostgreSQLAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// AddRTAMongoDBAgentParamsMultiError is an error wrapping multiple validation
-// errors returned by AddRTAMongoDBAgentParams.ValidateAll() if the designated
-// constraints aren't met.
-type AddRTAMongoDBAgentParamsMultiError []error
+// AddRTAPostgreSQLAgentParamsMultiError is an error wrapping multiple
+// validation errors returned by AddRTAPostgreSQLAgentParams.ValidateAll() if
+// the designated constraints aren't met.
+type AddRTAPostgreSQLAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AddRTAMongoDBAgentParamsMultiError) Error() string {
+func (m AddRTAPostgreSQLAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14672,11 +18344,12 @@ func (m AddRTAMongoDBAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AddRTAMongoDBAgentParamsMultiError) AllErrors() []error { return m }
+func (m AddRTAPostgreSQLAgentParamsMultiError) AllErrors() []error { return m }
 
-// AddRTAMongoDBAgentParamsValidationError is the validation error returned by
-// AddRTAMongoDBAgentParams.Validate if the designated constraints aren't met.
-type AddRTAMongoDBAgentParamsValidationError struct {
+// AddRTAPostgreSQLAgentParamsValidationError is the validation error returned
+// by AddRTAPostgreSQLAgentParams.Validate if the designated constraints
+// aren't met.
+type AddRTAPostgreSQLAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14684,24 +18357,24 @@ type AddRTAMongoDBAgentParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e AddRTAMongoDBAgentParamsValidationError) Field() string { return e.field }
+func (e AddRTAPostgreSQLAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AddRTAMongoDBAgentParamsValidationError) Reason() string { return e.reason }
+func (e AddRTAPostgreSQLAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AddRTAMongoDBAgentParamsValidationError) Cause() error { return e.cause }
+func (e AddRTAPostgreSQLAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AddRTAMongoDBAgentParamsValidationError) Key() bool { return e.key }
+func (e AddRTAPostgreSQLAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AddRTAMongoDBAgentParamsValidationError) ErrorName() string {
-	return "AddRTAMongoDBAgentParamsValidationError"
+func (e AddRTAPostgreSQLAgentParamsValidationError) ErrorName() string {
+	return "AddRTAPostgreSQLAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AddRTAMongoDBAgentParamsValidationError) Error() string {
+func (e AddRTAPostgreSQLAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14713,7 +18386,7 @@ func (e AddRTAMongoDBAgentParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAddRTAMongoDBAgentParams.%s: %s%s",
+		"invalid %sAddRTAPostgreSQLAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -14721,7 +18394,7 @@ func (e AddRTAMongoDBAgentParamsValidationError) Error() string {
 	)
 }
 
-var _ error = AddRTAMongoDBAgentParamsValidationError{}
+var _ error = AddRTAPostgreSQLAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -14729,24 +18402,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AddRTAMongoDBAgentParamsValidationError{}
+} = AddRTAPostgreSQLAgentParamsValidationError{}
 
-// Validate checks the field values on ChangeRTAMongoDBAgentParams with the
+// Validate checks the field values on ChangeRTAPostgreSQLAgentParams with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *ChangeRTAMongoDBAgentParams) Validate() error {
+func (m *ChangeRTAPostgreSQLAgentParams) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ChangeRTAMongoDBAgentParams with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ChangeRTAMongoDBAgentParamsMultiError, or nil if none found.
-func (m *ChangeRTAMongoDBAgentParams) ValidateAll() error {
+// ValidateAll checks the field values on ChangeRTAPostgreSQLAgentParams with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ChangeRTAPostgreSQLAgentParamsMultiError, or nil if none found.
+func (m *ChangeRTAPostgreSQLAgentParams) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ChangeRTAMongoDBAgentParams) validate(all bool) error {
+func (m *ChangeRTAPostgreSQLAgentParams) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -14762,7 +18435,7 @@ func (m *ChangeRTAMongoDBAgentParams) validate(all bool) error {
 			switch v := interface{}(m.GetCustomLabels()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeRTAMongoDBAgentParamsValidationError{
+					errors = append(errors, ChangeRTAPostgreSQLAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -14770,7 +18443,7 @@ func (m *ChangeRTAMongoDBAgentParams) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeRTAMongoDBAgentParamsValidationError{
+					errors = append(errors, ChangeRTAPostgreSQLAgentParamsValidationError{
 						field:  "CustomLabels",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -14779,7 +18452,7 @@ func (m *ChangeRTAMongoDBAgentParams) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetCustomLabels()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeRTAMongoDBAgentParamsValidationError{
+				return ChangeRTAPostgreSQLAgentParamsValidationError{
 					field:  "CustomLabels",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14808,20 +18481,16 @@ func (m *ChangeRTAMongoDBAgentParams) validate(all bool) error {
 		// no validation rules for TlsSkipVerify
 	}
 
-	if m.TlsCertificateKey != nil {
-		// no validation rules for TlsCertificateKey
-	}
-
-	if m.TlsCertificateKeyFilePassword != nil {
-		// no validation rules for TlsCertificateKeyFilePassword
-	}
-
 	if m.TlsCa != nil {
 		// no validation rules for TlsCa
 	}
 
-	if m.AuthenticationMechanism != nil {
-		// no validation rules for AuthenticationMechanism
+	if m.TlsCert != nil {
+		// no validation rules for TlsCert
+	}
+
+	if m.TlsKey != nil {
+		// no validation rules for TlsKey
 	}
 
 	if m.RtaOptions != nil {
@@ -14829,7 +18498,7 @@ func (m *ChangeRTAMongoDBAgentParams) validate(all bool) error {
 			switch v := interface{}(m.GetRtaOptions()).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ChangeRTAMongoDBAgentParamsValidationError{
+					errors = append(errors, ChangeRTAPostgreSQLAgentParamsValidationError{
 						field:  "RtaOptions",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -14837,7 +18506,7 @@ func (m *ChangeRTAMongoDBAgentParams) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ChangeRTAMongoDBAgentParamsValidationError{
+					errors = append(errors, ChangeRTAPostgreSQLAgentParamsValidationError{
 						field:  "RtaOptions",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -14846,7 +18515,7 @@ func (m *ChangeRTAMongoDBAgentParams) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(m.GetRtaOptions()).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ChangeRTAMongoDBAgentParamsValidationError{
+				return ChangeRTAPostgreSQLAgentParamsValidationError{
 					field:  "RtaOptions",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -14860,19 +18529,19 @@ func (m *ChangeRTAMongoDBAgentParams) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return ChangeRTAMongoDBAgentParamsMultiError(errors)
+		return ChangeRTAPostgreSQLAgentParamsMultiError(errors)
 	}
 
 	return nil
 }
 
-// ChangeRTAMongoDBAgentParamsMultiError is an error wrapping multiple
-// validation errors returned by ChangeRTAMongoDBAgentParams.ValidateAll() if
-// the designated constraints aren't met.
-type ChangeRTAMongoDBAgentParamsMultiError []error
+// ChangeRTAPostgreSQLAgentParamsMultiError is an error wrapping multiple
+// validation errors returned by ChangeRTAPostgreSQLAgentParams.ValidateAll()
+// if the designated constraints aren't met.
+type ChangeRTAPostgreSQLAgentParamsMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ChangeRTAMongoDBAgentParamsMultiError) Error() string {
+func (m ChangeRTAPostgreSQLAgentParamsMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -14881,12 +18550,12 @@ func (m ChangeRTAMongoDBAgentParamsMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ChangeRTAMongoDBAgentParamsMultiError) AllErrors() []error { return m }
+func (m ChangeRTAPostgreSQLAgentParamsMultiError) AllErrors() []error { return m }
 
-// ChangeRTAMongoDBAgentParamsValidationError is the validation error returned
-// by ChangeRTAMongoDBAgentParams.Validate if the designated constraints
-// aren't met.
-type ChangeRTAMongoDBAgentParamsValidationError struct {
+// ChangeRTAPostgreSQLAgentParamsValidationError is the validation error
+// returned by ChangeRTAPostgreSQLAgentParams.Validate if the designated
+// constraints aren't met.
+type ChangeRTAPostgreSQLAgentParamsValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -14894,24 +18563,24 @@ type ChangeRTAMongoDBAgentParamsValidationError struct {
 }
 
 // Field function returns field value.
-func (e ChangeRTAMongoDBAgentParamsValidationError) Field() string { return e.field }
+func (e ChangeRTAPostgreSQLAgentParamsValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ChangeRTAMongoDBAgentParamsValidationError) Reason() string { return e.reason }
+func (e ChangeRTAPostgreSQLAgentParamsValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ChangeRTAMongoDBAgentParamsValidationError) Cause() error { return e.cause }
+func (e ChangeRTAPostgreSQLAgentParamsValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ChangeRTAMongoDBAgentParamsValidationError) Key() bool { return e.key }
+func (e ChangeRTAPostgreSQLAgentParamsValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ChangeRTAMongoDBAgentParamsValidationError) ErrorName() string {
-	return "ChangeRTAMongoDBAgentParamsValidationError"
+func (e ChangeRTAPostgreSQLAgentParamsValidationError) ErrorName() string {
+	return "ChangeRTAPostgreSQLAgentParamsValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ChangeRTAMongoDBAgentParamsValidationError) Error() string {
+func (e ChangeRTAPostgreSQLAgentParamsValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -14923,7 +18592,7 @@ func (e ChangeRTAMongoDBAgentParamsValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sChangeRTAMongoDBAgentParams.%s: %s%s",
+		"invalid %sChangeRTAPostgreSQLAgentParams.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
@@ -14931,7 +18600,7 @@ func (e ChangeRTAMongoDBAgentParamsValidationError) Error() string {
 	)
 }
 
-var _ error = ChangeRTAMongoDBAgentParamsValidationError{}
+var _ error = ChangeRTAPostgreSQLAgentParamsValidationError{}
 
 var _ interface {
 	Field() string
@@ -14939,7 +18608,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ChangeRTAMongoDBAgentParamsValidationError{}
+} = ChangeRTAPostgreSQLAgentParamsValidationError{}
 
 // Validate checks the field values on RemoveAgentRequest with the rules
 // defined in the proto definition for this message. If any rules are
@@ -226,18 +226,33 @@ type AddAgentBody struct {
 	// qan mysql slowlog agent
 	QANMysqlSlowlogAgent *AddAgentParamsBodyQANMysqlSlowlogAgent `json:"qan_mysql_slowlog_agent,omitempty"`
 
+	// qan postgresql log agent
+	QANPostgresqlLogAgent *AddAgentParamsBodyQANPostgresqlLogAgent `json:"qan_postgresql_log_agent,omitempty"`
+
 	// qan postgresql pgstatements agent
 	QANPostgresqlPgstatementsAgent *AddAgentParamsBodyQANPostgresqlPgstatementsAgent `json:"qan_postgresql_pgstatements_agent,omitempty"`
 
 	// qan postgresql pgstatmonitor agent
 	QANPostgresqlPgstatmonitorAgent *AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent `json:"qan_postgresql_pgstatmonitor_agent,omitempty"`
 
+	// qan proxysql digest agent
+	QANProxysqlDigestAgent *AddAgentParamsBodyQANProxysqlDigestAgent `json:"qan_proxysql_digest_agent,omitempty"`
+
+	// qan valkey slowlog agent
+	QANValkeySlowlogAgent *AddAgentParamsBodyQANValkeySlowlogAgent `json:"qan_valkey_slowlog_agent,omitempty"`
+
 	// rds exporter
 	RDSExporter *AddAgentParamsBodyRDSExporter `json:"rds_exporter,omitempty"`
 
 	// rta mongodb agent
 	RtaMongodbAgent *AddAgentParamsBodyRtaMongodbAgent `json:"rta_mongodb_agent,omitempty"`
 
+	// rta mysql agent
+	RtaMysqlAgent *AddAgentParamsBodyRtaMysqlAgent `json:"rta_mysql_agent,omitempty"`
+
+	// rta postgresql agent
+	RtaPostgresqlAgent *AddAgentParamsBodyRtaPostgresqlAgent `json:"rta_postgresql_agent,omitempty"`
+
 	// valkey exporter
 	ValkeyExporter *AddAgentParamsBodyValkeyExporter `json:"valkey_exporter,omitempty"`
 }
@@ -294,6 +309,10 @@ func (o *AddAgentBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateQANPostgresqlLogAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateQANPostgresqlPgstatementsAgent(formats); err != nil {
 		res = append(res, err)
 	}
@@ -302,6 +321,14 @@ func (o *AddAgentBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateQANProxysqlDigestAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateQANValkeySlowlogAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateRDSExporter(formats); err != nil {
 		res = append(res, err)
 	}
@@ -310,6 +337,14 @@ func (o *AddAgentBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateRtaMysqlAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateRtaPostgresqlAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateValkeyExporter(formats); err != nil {
 		res = append(res, err)
 	}
@@ -596,6 +631,29 @@ func (o *AddAgentBody) validateQANMysqlSlowlogAgent(formats strfmt.Registry) err
 	return nil
 }
 
+func (o *AddAgentBody) validateQANPostgresqlLogAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANPostgresqlLogAgent) { // not required
+		return nil
+	}
+
+	if o.QANPostgresqlLogAgent != nil {
+		if err := o.QANPostgresqlLogAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "qan_postgresql_log_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "qan_postgresql_log_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddAgentBody) validateQANPostgresqlPgstatementsAgent(formats strfmt.Registry) error {
 	if swag.IsZero(o.QANPostgresqlPgstatementsAgent) { // not required
 		return nil
@@ -642,6 +700,52 @@ func (o *AddAgentBody) validateQANPostgresqlPgstatmonitorAgent(formats strfmt.Re
 	return nil
 }
 
+func (o *AddAgentBody) validateQANProxysqlDigestAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANProxysqlDigestAgent) { // not required
+		return nil
+	}
+
+	if o.QANProxysqlDigestAgent != nil {
+		if err := o.QANProxysqlDigestAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "qan_proxysql_digest_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "qan_proxysql_digest_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *AddAgentBody) validateQANValkeySlowlogAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANValkeySlowlogAgent) { // not required
+		return nil
+	}
+
+	if o.QANValkeySlowlogAgent != nil {
+		if err := o.QANValkeySlowlogAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "qan_valkey_slowlog_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "qan_valkey_slowlog_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddAgentBody) validateRDSExporter(formats strfmt.Registry) error {
 	if swag.IsZero(o.RDSExporter) { // not required
 		return nil
@@ -688,6 +792,52 @@ func (o *AddAgentBody) validateRtaMongodbAgent(formats strfmt.Registry) error {
 	return nil
 }
 
+func (o *AddAgentBody) validateRtaMysqlAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaMysqlAgent) { // not required
+		return nil
+	}
+
+	if o.RtaMysqlAgent != nil {
+		if err := o.RtaMysqlAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "rta_mysql_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "rta_mysql_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *AddAgentBody) validateRtaPostgresqlAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaPostgresqlAgent) { // not required
+		return nil
+	}
+
+	if o.RtaPostgresqlAgent != nil {
+		if err := o.RtaPostgresqlAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "rta_postgresql_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "rta_postgresql_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddAgentBody) validateValkeyExporter(formats strfmt.Registry) error {
 	if swag.IsZero(o.ValkeyExporter) { // not required
 		return nil
@@ -763,6 +913,10 @@ func (o *AddAgentBody) ContextValidate(ctx context.Context, formats strfmt.Regis
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateQANPostgresqlLogAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateQANPostgresqlPgstatementsAgent(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -771,6 +925,14 @@ func (o *AddAgentBody) ContextValidate(ctx context.Context, formats strfmt.Regis
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateQANProxysqlDigestAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateQANValkeySlowlogAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateRDSExporter(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -779,6 +941,14 @@ func (o *AddAgentBody) ContextValidate(ctx context.Context, formats strfmt.Regis
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateRtaMysqlAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateRtaPostgresqlAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateValkeyExporter(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -1077,6 +1247,30 @@ func (o *AddAgentBody) contextValidateQANMysqlSlowlogAgent(ctx context.Context,
 	return nil
 }
 
+func (o *AddAgentBody) contextValidateQANPostgresqlLogAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANPostgresqlLogAgent != nil {
+
+		if swag.IsZero(o.QANPostgresqlLogAgent) { // not required
+			return nil
+		}
+
+		if err := o.QANPostgresqlLogAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "qan_postgresql_log_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "qan_postgresql_log_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddAgentBody) contextValidateQANPostgresqlPgstatementsAgent(ctx context.Context, formats strfmt.Registry) error {
 	if o.QANPostgresqlPgstatementsAgent != nil {
 
@@ -1125,6 +1319,54 @@ func (o *AddAgentBody) contextValidateQANPostgresqlPgstatmonitorAgent(ctx contex
 	return nil
 }
 
+func (o *AddAgentBody) contextValidateQANProxysqlDigestAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANProxysqlDigestAgent != nil {
+
+		if swag.IsZero(o.QANProxysqlDigestAgent) { // not required
+			return nil
+		}
+
+		if err := o.QANProxysqlDigestAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "qan_proxysql_digest_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "qan_proxysql_digest_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *AddAgentBody) contextValidateQANValkeySlowlogAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANValkeySlowlogAgent != nil {
+
+		if swag.IsZero(o.QANValkeySlowlogAgent) { // not required
+			return nil
+		}
+
+		if err := o.QANValkeySlowlogAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "qan_valkey_slowlog_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "qan_valkey_slowlog_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddAgentBody) contextValidateRDSExporter(ctx context.Context, formats strfmt.Registry) error {
 	if o.RDSExporter != nil {
 
@@ -1173,6 +1415,54 @@ func (o *AddAgentBody) contextValidateRtaMongodbAgent(ctx context.Context, forma
 	return nil
 }
 
+func (o *AddAgentBody) contextValidateRtaMysqlAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaMysqlAgent != nil {
+
+		if swag.IsZero(o.RtaMysqlAgent) { // not required
+			return nil
+		}
+
+		if err := o.RtaMysqlAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "rta_mysql_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "rta_mysql_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *AddAgentBody) contextValidateRtaPostgresqlAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaPostgresqlAgent != nil {
+
+		if swag.IsZero(o.RtaPostgresqlAgent) { // not required
+			return nil
+		}
+
+		if err := o.RtaPostgresqlAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "rta_postgresql_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "rta_postgresql_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddAgentBody) contextValidateValkeyExporter(ctx context.Context, formats strfmt.Registry) error {
 	if o.ValkeyExporter != nil {
 
@@ -1484,18 +1774,33 @@ type AddAgentOKBody struct {
 	// qan mysql slowlog agent
 	QANMysqlSlowlogAgent *AddAgentOKBodyQANMysqlSlowlogAgent `json:"qan_mysql_slowlog_agent,omitempty"`
 
+	// qan postgresql log agent
+	QANPostgresqlLogAgent *AddAgentOKBodyQANPostgresqlLogAgent `json:"qan_postgresql_log_agent,omitempty"`
+
 	// qan postgresql pgstatements agent
 	QANPostgresqlPgstatementsAgent *AddAgentOKBodyQANPostgresqlPgstatementsAgent `json:"qan_postgresql_pgstatements_agent,omitempty"`
 
 	// qan postgresql pgstatmonitor agent
 	QANPostgresqlPgstatmonitorAgent *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent `json:"qan_postgresql_pgstatmonitor_agent,omitempty"`
 
+	// qan proxysql digest agent
+	QANProxysqlDigestAgent *AddAgentOKBodyQANProxysqlDigestAgent `json:"qan_proxysql_digest_agent,omitempty"`
+
+	// qan valkey slowlog agent
+	QANValkeySlowlogAgent *AddAgentOKBodyQANValkeySlowlogAgent `json:"qan_valkey_slowlog_agent,omitempty"`
+
 	// rds exporter
 	RDSExporter *AddAgentOKBodyRDSExporter `json:"rds_exporter,omitempty"`
 
 	// rta mongodb agent
 	RtaMongodbAgent *AddAgentOKBodyRtaMongodbAgent `json:"rta_mongodb_agent,omitempty"`
 
+	// rta mysql agent
+	RtaMysqlAgent *AddAgentOKBodyRtaMysqlAgent `json:"rta_mysql_agent,omitempty"`
+
+	// rta postgresql agent
+	RtaPostgresqlAgent *AddAgentOKBodyRtaPostgresqlAgent `json:"rta_postgresql_agent,omitempty"`
+
 	// valkey exporter
 	ValkeyExporter *AddAgentOKBodyValkeyExporter `json:"valkey_exporter,omitempty"`
 }
@@ -1552,6 +1857,10 @@ func (o *AddAgentOKBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateQANPostgresqlLogAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateQANPostgresqlPgstatementsAgent(formats); err != nil {
 		res = append(res, err)
 	}
@@ -1560,6 +1869,14 @@ func (o *AddAgentOKBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateQANProxysqlDigestAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateQANValkeySlowlogAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateRDSExporter(formats); err != nil {
 		res = append(res, err)
 	}
@@ -1568,6 +1885,14 @@ func (o *AddAgentOKBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateRtaMysqlAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateRtaPostgresqlAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateValkeyExporter(formats); err != nil {
 		res = append(res, err)
 	}
@@ -1854,6 +2179,29 @@ func (o *AddAgentOKBody) validateQANMysqlSlowlogAgent(formats strfmt.Registry) e
 	return nil
 }
 
+func (o *AddAgentOKBody) validateQANPostgresqlLogAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANPostgresqlLogAgent) { // not required
+		return nil
+	}
+
+	if o.QANPostgresqlLogAgent != nil {
+		if err := o.QANPostgresqlLogAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "qan_postgresql_log_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "qan_postgresql_log_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddAgentOKBody) validateQANPostgresqlPgstatementsAgent(formats strfmt.Registry) error {
 	if swag.IsZero(o.QANPostgresqlPgstatementsAgent) { // not required
 		return nil
@@ -1900,20 +2248,20 @@ func (o *AddAgentOKBody) validateQANPostgresqlPgstatmonitorAgent(formats strfmt.
 	return nil
 }
 
-func (o *AddAgentOKBody) validateRDSExporter(formats strfmt.Registry) error {
-	if swag.IsZero(o.RDSExporter) { // not required
+func (o *AddAgentOKBody) validateQANProxysqlDigestAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANProxysqlDigestAgent) { // not required
 		return nil
 	}
 
-	if o.RDSExporter != nil {
-		if err := o.RDSExporter.Validate(formats); err != nil {
+	if o.QANProxysqlDigestAgent != nil {
+		if err := o.QANProxysqlDigestAgent.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("addAgentOk" + "." + "rds_exporter")
+				return ve.ValidateName("addAgentOk" + "." + "qan_proxysql_digest_agent")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("addAgentOk" + "." + "rds_exporter")
+				return ce.ValidateName("addAgentOk" + "." + "qan_proxysql_digest_agent")
 			}
 
 			return err
@@ -1923,12 +2271,58 @@ func (o *AddAgentOKBody) validateRDSExporter(formats strfmt.Registry) error {
 	return nil
 }
 
-func (o *AddAgentOKBody) validateRtaMongodbAgent(formats strfmt.Registry) error {
-	if swag.IsZero(o.RtaMongodbAgent) { // not required
+func (o *AddAgentOKBody) validateQANValkeySlowlogAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANValkeySlowlogAgent) { // not required
 		return nil
 	}
 
-	if o.RtaMongodbAgent != nil {
+	if o.QANValkeySlowlogAgent != nil {
+		if err := o.QANValkeySlowlogAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "qan_valkey_slowlog_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "qan_valkey_slowlog_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *AddAgentOKBody) validateRDSExporter(formats strfmt.Registry) error {
+	if swag.IsZero(o.RDSExporter) { // not required
+		return nil
+	}
+
+	if o.RDSExporter != nil {
+		if err := o.RDSExporter.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "rds_exporter")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "rds_exporter")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *AddAgentOKBody) validateRtaMongodbAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaMongodbAgent) { // not required
+		return nil
+	}
+
+	if o.RtaMongodbAgent != nil {
 		if err := o.RtaMongodbAgent.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
@@ -1946,6 +2340,52 @@ func (o *AddAgentOKBody) validateRtaMongodbAgent(formats strfmt.Registry) error
 	return nil
 }
 
+func (o *AddAgentOKBody) validateRtaMysqlAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaMysqlAgent) { // not required
+		return nil
+	}
+
+	if o.RtaMysqlAgent != nil {
+		if err := o.RtaMysqlAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "rta_mysql_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "rta_mysql_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *AddAgentOKBody) validateRtaPostgresqlAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaPostgresqlAgent) { // not required
+		return nil
+	}
+
+	if o.RtaPostgresqlAgent != nil {
+		if err := o.RtaPostgresqlAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "rta_postgresql_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "rta_postgresql_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddAgentOKBody) validateValkeyExporter(formats strfmt.Registry) error {
 	if swag.IsZero(o.ValkeyExporter) { // not required
 		return nil
@@ -2021,6 +2461,10 @@ func (o *AddAgentOKBody) ContextValidate(ctx context.Context, formats strfmt.Reg
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateQANPostgresqlLogAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateQANPostgresqlPgstatementsAgent(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -2029,6 +2473,14 @@ func (o *AddAgentOKBody) ContextValidate(ctx context.Context, formats strfmt.Reg
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateQANProxysqlDigestAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateQANValkeySlowlogAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateRDSExporter(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -2037,6 +2489,14 @@ func (o *AddAgentOKBody) ContextValidate(ctx context.Context, formats strfmt.Reg
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateRtaMysqlAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateRtaPostgresqlAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateValkeyExporter(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -2335,6 +2795,30 @@ func (o *AddAgentOKBody) contextValidateQANMysqlSlowlogAgent(ctx context.Context
 	return nil
 }
 
+func (o *AddAgentOKBody) contextValidateQANPostgresqlLogAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANPostgresqlLogAgent != nil {
+
+		if swag.IsZero(o.QANPostgresqlLogAgent) { // not required
+			return nil
+		}
+
+		if err := o.QANPostgresqlLogAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "qan_postgresql_log_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "qan_postgresql_log_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddAgentOKBody) contextValidateQANPostgresqlPgstatementsAgent(ctx context.Context, formats strfmt.Registry) error {
 	if o.QANPostgresqlPgstatementsAgent != nil {
 
@@ -2383,6 +2867,54 @@ func (o *AddAgentOKBody) contextValidateQANPostgresqlPgstatmonitorAgent(ctx cont
 	return nil
 }
 
+func (o *AddAgentOKBody) contextValidateQANProxysqlDigestAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANProxysqlDigestAgent != nil {
+
+		if swag.IsZero(o.QANProxysqlDigestAgent) { // not required
+			return nil
+		}
+
+		if err := o.QANProxysqlDigestAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "qan_proxysql_digest_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "qan_proxysql_digest_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *AddAgentOKBody) contextValidateQANValkeySlowlogAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANValkeySlowlogAgent != nil {
+
+		if swag.IsZero(o.QANValkeySlowlogAgent) { // not required
+			return nil
+		}
+
+		if err := o.QANValkeySlowlogAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "qan_valkey_slowlog_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "qan_valkey_slowlog_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddAgentOKBody) contextValidateRDSExporter(ctx context.Context, formats strfmt.Registry) error {
 	if o.RDSExporter != nil {
 
@@ -2431,6 +2963,54 @@ func (o *AddAgentOKBody) contextValidateRtaMongodbAgent(ctx context.Context, for
 	return nil
 }
 
+func (o *AddAgentOKBody) contextValidateRtaMysqlAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaMysqlAgent != nil {
+
+		if swag.IsZero(o.RtaMysqlAgent) { // not required
+			return nil
+		}
+
+		if err := o.RtaMysqlAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "rta_mysql_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "rta_mysql_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *AddAgentOKBody) contextValidateRtaPostgresqlAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaPostgresqlAgent != nil {
+
+		if swag.IsZero(o.RtaPostgresqlAgent) { // not required
+			return nil
+		}
+
+		if err := o.RtaPostgresqlAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "rta_postgresql_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "rta_postgresql_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddAgentOKBody) contextValidateValkeyExporter(ctx context.Context, formats strfmt.Registry) error {
 	if o.ValkeyExporter != nil {
 
@@ -5635,10 +6215,10 @@ func (o *AddAgentOKBodyQANMysqlSlowlogAgent) UnmarshalBinary(b []byte) error {
 }
 
 /*
-AddAgentOKBodyQANPostgresqlPgstatementsAgent QANPostgreSQLPgStatementsAgent runs within pmm-agent and sends PostgreSQL Query Analytics data to the PMM Server.
-swagger:model AddAgentOKBodyQANPostgresqlPgstatementsAgent
+AddAgentOKBodyQANPostgresqlLogAgent QANPostgreSQLLogAgent runs within pmm-agent and sends PostgreSQL Query Analytics data from the server log to the PMM Server.
+swagger:model AddAgentOKBodyQANPostgresqlLogAgent
 */
-type AddAgentOKBodyQANPostgresqlPgstatementsAgent struct {
+type AddAgentOKBodyQANPostgresqlLogAgent struct {
 	// Unique randomly generated instance identifier.
 	AgentID string `json:"agent_id,omitempty"`
 
@@ -5651,20 +6231,23 @@ type AddAgentOKBodyQANPostgresqlPgstatementsAgent struct {
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// PostgreSQL username for getting pg stat statements data.
+	// PostgreSQL username for getting server log settings.
 	Username string `json:"username,omitempty"`
 
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
 	// Disable parsing comments from queries and showing them in QAN.
 	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
 
 	// Limit query length in QAN (default: server-defined; -1: no limit).
 	MaxQueryLength int32 `json:"max_query_length,omitempty"`
 
-	// Use TLS for database connections.
-	TLS bool `json:"tls,omitempty"`
-
-	// Skip TLS certificate and hostname validation.
-	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+	// True if query examples are disabled.
+	QueryExamplesDisabled bool `json:"query_examples_disabled,omitempty"`
 
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
@@ -5691,8 +6274,8 @@ type AddAgentOKBodyQANPostgresqlPgstatementsAgent struct {
 	LogLevel *string `json:"log_level,omitempty"`
 }
 
-// Validate validates this add agent OK body QAN postgresql pgstatements agent
-func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent OK body QAN postgresql log agent
+func (o *AddAgentOKBodyQANPostgresqlLogAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateStatus(formats); err != nil {
@@ -5709,7 +6292,7 @@ func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) Validate(formats strfmt.R
 	return nil
 }
 
-var addAgentOkBodyQanPostgresqlPgstatementsAgentTypeStatusPropEnum []any
+var addAgentOkBodyQanPostgresqlLogAgentTypeStatusPropEnum []any
 
 func init() {
 	var res []string
@@ -5717,59 +6300,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentOkBodyQanPostgresqlPgstatementsAgentTypeStatusPropEnum = append(addAgentOkBodyQanPostgresqlPgstatementsAgentTypeStatusPropEnum, v)
+		addAgentOkBodyQanPostgresqlLogAgentTypeStatusPropEnum = append(addAgentOkBodyQanPostgresqlLogAgentTypeStatusPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
-	AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+	// AddAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	AddAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
 
-	// AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
-	AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+	// AddAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	AddAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
 
-	// AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
-	AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+	// AddAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	AddAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
 
-	// AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
-	AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+	// AddAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	AddAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
 
-	// AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
-	AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+	// AddAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	AddAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
 
-	// AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
-	AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+	// AddAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	AddAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
 
-	// AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
-	AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+	// AddAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	AddAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
 
-	// AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
-	AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+	// AddAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	AddAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
 )
 
 // prop value enum
-func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) validateStatusEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentOkBodyQanPostgresqlPgstatementsAgentTypeStatusPropEnum, true); err != nil {
+func (o *AddAgentOKBodyQANPostgresqlLogAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyQanPostgresqlLogAgentTypeStatusPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) validateStatus(formats strfmt.Registry) error {
+func (o *AddAgentOKBodyQANPostgresqlLogAgent) validateStatus(formats strfmt.Registry) error {
 	if swag.IsZero(o.Status) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateStatusEnum("addAgentOk"+"."+"qan_postgresql_pgstatements_agent"+"."+"status", "body", *o.Status); err != nil {
+	if err := o.validateStatusEnum("addAgentOk"+"."+"qan_postgresql_log_agent"+"."+"status", "body", *o.Status); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-var addAgentOkBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum []any
+var addAgentOkBodyQanPostgresqlLogAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -5777,59 +6360,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentOkBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum = append(addAgentOkBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum, v)
+		addAgentOkBodyQanPostgresqlLogAgentTypeLogLevelPropEnum = append(addAgentOkBodyQanPostgresqlLogAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentOkBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentOKBodyQANPostgresqlLogAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyQanPostgresqlLogAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentOKBodyQANPostgresqlLogAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("addAgentOk"+"."+"qan_postgresql_pgstatements_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("addAgentOk"+"."+"qan_postgresql_log_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this add agent OK body QAN postgresql pgstatements agent based on context it is used
-func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent OK body QAN postgresql log agent based on context it is used
+func (o *AddAgentOKBodyQANPostgresqlLogAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) MarshalBinary() ([]byte, error) {
+func (o *AddAgentOKBodyQANPostgresqlLogAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -5837,8 +6420,8 @@ func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) MarshalBinary() ([]byte,
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) UnmarshalBinary(b []byte) error {
-	var res AddAgentOKBodyQANPostgresqlPgstatementsAgent
+func (o *AddAgentOKBodyQANPostgresqlLogAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentOKBodyQANPostgresqlLogAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -5847,10 +6430,10 @@ func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) UnmarshalBinary(b []byte)
 }
 
 /*
-AddAgentOKBodyQANPostgresqlPgstatmonitorAgent QANPostgreSQLPgStatMonitorAgent runs within pmm-agent and sends PostgreSQL Query Analytics data to the PMM Server.
-swagger:model AddAgentOKBodyQANPostgresqlPgstatmonitorAgent
+AddAgentOKBodyQANPostgresqlPgstatementsAgent QANPostgreSQLPgStatementsAgent runs within pmm-agent and sends PostgreSQL Query Analytics data to the PMM Server.
+swagger:model AddAgentOKBodyQANPostgresqlPgstatementsAgent
 */
-type AddAgentOKBodyQANPostgresqlPgstatmonitorAgent struct {
+type AddAgentOKBodyQANPostgresqlPgstatementsAgent struct {
 	// Unique randomly generated instance identifier.
 	AgentID string `json:"agent_id,omitempty"`
 
@@ -5863,23 +6446,20 @@ type AddAgentOKBodyQANPostgresqlPgstatmonitorAgent struct {
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// PostgreSQL username for getting pg stat monitor data.
+	// PostgreSQL username for getting pg stat statements data.
 	Username string `json:"username,omitempty"`
 
-	// Use TLS for database connections.
-	TLS bool `json:"tls,omitempty"`
-
-	// Skip TLS certificate and hostname validation.
-	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
-
 	// Disable parsing comments from queries and showing them in QAN.
 	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
 
 	// Limit query length in QAN (default: server-defined; -1: no limit).
 	MaxQueryLength int32 `json:"max_query_length,omitempty"`
 
-	// True if query examples are disabled.
-	QueryExamplesDisabled bool `json:"query_examples_disabled,omitempty"`
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
 
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
@@ -5906,8 +6486,8 @@ type AddAgentOKBodyQANPostgresqlPgstatmonitorAgent struct {
 	LogLevel *string `json:"log_level,omitempty"`
 }
 
-// Validate validates this add agent OK body QAN postgresql pgstatmonitor agent
-func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent OK body QAN postgresql pgstatements agent
+func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateStatus(formats); err != nil {
@@ -5924,7 +6504,7 @@ func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) Validate(formats strfmt.
 	return nil
 }
 
-var addAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeStatusPropEnum []any
+var addAgentOkBodyQanPostgresqlPgstatementsAgentTypeStatusPropEnum []any
 
 func init() {
 	var res []string
@@ -5932,59 +6512,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeStatusPropEnum = append(addAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeStatusPropEnum, v)
+		addAgentOkBodyQanPostgresqlPgstatementsAgentTypeStatusPropEnum = append(addAgentOkBodyQanPostgresqlPgstatementsAgentTypeStatusPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
-	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+	// AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
 
-	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
-	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+	// AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
 
-	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
-	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+	// AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
 
-	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
-	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+	// AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
 
-	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
-	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+	// AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
 
-	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
-	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+	// AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
 
-	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
-	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+	// AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
 
-	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
-	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+	// AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	AddAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
 )
 
 // prop value enum
-func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) validateStatusEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeStatusPropEnum, true); err != nil {
+func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyQanPostgresqlPgstatementsAgentTypeStatusPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) validateStatus(formats strfmt.Registry) error {
+func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) validateStatus(formats strfmt.Registry) error {
 	if swag.IsZero(o.Status) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateStatusEnum("addAgentOk"+"."+"qan_postgresql_pgstatmonitor_agent"+"."+"status", "body", *o.Status); err != nil {
+	if err := o.validateStatusEnum("addAgentOk"+"."+"qan_postgresql_pgstatements_agent"+"."+"status", "body", *o.Status); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-var addAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum []any
+var addAgentOkBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -5992,59 +6572,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum = append(addAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum, v)
+		addAgentOkBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum = append(addAgentOkBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("addAgentOk"+"."+"qan_postgresql_pgstatmonitor_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("addAgentOk"+"."+"qan_postgresql_pgstatements_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this add agent OK body QAN postgresql pgstatmonitor agent based on context it is used
-func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent OK body QAN postgresql pgstatements agent based on context it is used
+func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) MarshalBinary() ([]byte, error) {
+func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -6052,8 +6632,8 @@ func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) MarshalBinary() ([]byte,
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) UnmarshalBinary(b []byte) error {
-	var res AddAgentOKBodyQANPostgresqlPgstatmonitorAgent
+func (o *AddAgentOKBodyQANPostgresqlPgstatementsAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentOKBodyQANPostgresqlPgstatementsAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -6062,10 +6642,10 @@ func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) UnmarshalBinary(b []byte
 }
 
 /*
-AddAgentOKBodyRDSExporter RDSExporter runs on Generic or Container Node and exposes RemoteRDS Node metrics.
-swagger:model AddAgentOKBodyRDSExporter
+AddAgentOKBodyQANPostgresqlPgstatmonitorAgent QANPostgreSQLPgStatMonitorAgent runs within pmm-agent and sends PostgreSQL Query Analytics data to the PMM Server.
+swagger:model AddAgentOKBodyQANPostgresqlPgstatmonitorAgent
 */
-type AddAgentOKBodyRDSExporter struct {
+type AddAgentOKBodyQANPostgresqlPgstatmonitorAgent struct {
 	// Unique randomly generated instance identifier.
 	AgentID string `json:"agent_id,omitempty"`
 
@@ -6075,11 +6655,26 @@ type AddAgentOKBodyRDSExporter struct {
 	// Desired Agent status: enabled (false) or disabled (true).
 	Disabled bool `json:"disabled,omitempty"`
 
-	// Node identifier.
-	NodeID string `json:"node_id,omitempty"`
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
 
-	// AWS Access Key.
-	AWSAccessKey string `json:"aws_access_key,omitempty"`
+	// PostgreSQL username for getting pg stat monitor data.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
+
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
+	// True if query examples are disabled.
+	QueryExamplesDisabled bool `json:"query_examples_disabled,omitempty"`
 
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
@@ -6096,18 +6691,6 @@ type AddAgentOKBodyRDSExporter struct {
 	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
 	Status *string `json:"status,omitempty"`
 
-	// Listen port for scraping metrics (the same for several configurations).
-	ListenPort int64 `json:"listen_port,omitempty"`
-
-	// Basic metrics are disabled.
-	BasicMetricsDisabled bool `json:"basic_metrics_disabled,omitempty"`
-
-	// Enhanced metrics are disabled.
-	EnhancedMetricsDisabled bool `json:"enhanced_metrics_disabled,omitempty"`
-
-	// True if exporter uses push metrics mode.
-	PushMetricsEnabled bool `json:"push_metrics_enabled,omitempty"`
-
 	// Path to exec process.
 	ProcessExecPath string `json:"process_exec_path,omitempty"`
 
@@ -6116,16 +6699,10 @@ type AddAgentOKBodyRDSExporter struct {
 	// - LOG_LEVEL_UNSPECIFIED: Auto
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
-
-	// Limit of databases for auto-discovery.
-	AutoDiscoveryLimit int32 `json:"auto_discovery_limit,omitempty"`
-
-	// metrics resolutions
-	MetricsResolutions *AddAgentOKBodyRDSExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
 }
 
-// Validate validates this add agent OK body RDS exporter
-func (o *AddAgentOKBodyRDSExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent OK body QAN postgresql pgstatmonitor agent
+func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateStatus(formats); err != nil {
@@ -6136,17 +6713,13 @@ func (o *AddAgentOKBodyRDSExporter) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
-	if err := o.validateMetricsResolutions(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
-var addAgentOkBodyRdsExporterTypeStatusPropEnum []any
+var addAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeStatusPropEnum []any
 
 func init() {
 	var res []string
@@ -6154,59 +6727,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentOkBodyRdsExporterTypeStatusPropEnum = append(addAgentOkBodyRdsExporterTypeStatusPropEnum, v)
+		addAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeStatusPropEnum = append(addAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeStatusPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentOKBodyRDSExporterStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
-	AddAgentOKBodyRDSExporterStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
 
-	// AddAgentOKBodyRDSExporterStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
-	AddAgentOKBodyRDSExporterStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
 
-	// AddAgentOKBodyRDSExporterStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
-	AddAgentOKBodyRDSExporterStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
 
-	// AddAgentOKBodyRDSExporterStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
-	AddAgentOKBodyRDSExporterStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
 
-	// AddAgentOKBodyRDSExporterStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
-	AddAgentOKBodyRDSExporterStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
 
-	// AddAgentOKBodyRDSExporterStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
-	AddAgentOKBodyRDSExporterStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
 
-	// AddAgentOKBodyRDSExporterStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
-	AddAgentOKBodyRDSExporterStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
 
-	// AddAgentOKBodyRDSExporterStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
-	AddAgentOKBodyRDSExporterStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
 )
 
 // prop value enum
-func (o *AddAgentOKBodyRDSExporter) validateStatusEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentOkBodyRdsExporterTypeStatusPropEnum, true); err != nil {
+func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeStatusPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentOKBodyRDSExporter) validateStatus(formats strfmt.Registry) error {
+func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) validateStatus(formats strfmt.Registry) error {
 	if swag.IsZero(o.Status) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateStatusEnum("addAgentOk"+"."+"rds_exporter"+"."+"status", "body", *o.Status); err != nil {
+	if err := o.validateStatusEnum("addAgentOk"+"."+"qan_postgresql_pgstatmonitor_agent"+"."+"status", "body", *o.Status); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-var addAgentOkBodyRdsExporterTypeLogLevelPropEnum []any
+var addAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -6214,80 +6787,138 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentOkBodyRdsExporterTypeLogLevelPropEnum = append(addAgentOkBodyRdsExporterTypeLogLevelPropEnum, v)
+		addAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum = append(addAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentOKBodyRDSExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentOKBodyRDSExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentOKBodyRDSExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentOKBodyRDSExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentOKBodyRDSExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentOKBodyRDSExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentOKBodyRDSExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentOKBodyRDSExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentOKBodyRDSExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentOKBodyRDSExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentOKBodyRDSExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentOKBodyRDSExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentOKBodyRDSExporter) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentOkBodyRdsExporterTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentOKBodyRDSExporter) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("addAgentOk"+"."+"rds_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("addAgentOk"+"."+"qan_postgresql_pgstatmonitor_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *AddAgentOKBodyRDSExporter) validateMetricsResolutions(formats strfmt.Registry) error {
-	if swag.IsZero(o.MetricsResolutions) { // not required
-		return nil
+// ContextValidate validates this add agent OK body QAN postgresql pgstatmonitor agent based on context it is used
+func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
 	}
+	return swag.WriteJSON(o)
+}
 
-	if o.MetricsResolutions != nil {
-		if err := o.MetricsResolutions.Validate(formats); err != nil {
-			ve := new(errors.Validation)
-			if stderrors.As(err, &ve) {
-				return ve.ValidateName("addAgentOk" + "." + "rds_exporter" + "." + "metrics_resolutions")
-			}
-			ce := new(errors.CompositeError)
-			if stderrors.As(err, &ce) {
-				return ce.ValidateName("addAgentOk" + "." + "rds_exporter" + "." + "metrics_resolutions")
-			}
-
-			return err
-		}
+// UnmarshalBinary interface implementation
+func (o *AddAgentOKBodyQANPostgresqlPgstatmonitorAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentOKBodyQANPostgresqlPgstatmonitorAgent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
 	}
-
+	*o = res
 	return nil
 }
 
-// ContextValidate validate this add agent OK body RDS exporter based on the context it is used
-func (o *AddAgentOKBodyRDSExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+/*
+AddAgentOKBodyQANProxysqlDigestAgent QANProxySQLDigestAgent runs within pmm-agent and sends ProxySQL Query Analytics data to the PMM Server.
+swagger:model AddAgentOKBodyQANProxysqlDigestAgent
+*/
+type AddAgentOKBodyQANProxysqlDigestAgent struct {
+	// Unique randomly generated instance identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// ProxySQL username for getting query digest data.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Path to exec process.
+	ProcessExecPath string `json:"process_exec_path,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+}
+
+// Validate validates this add agent OK body QAN proxysql digest agent
+func (o *AddAgentOKBodyQANProxysqlDigestAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
-	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -6297,75 +6928,127 @@ func (o *AddAgentOKBodyRDSExporter) ContextValidate(ctx context.Context, formats
 	return nil
 }
 
-func (o *AddAgentOKBodyRDSExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
-	if o.MetricsResolutions != nil {
+var addAgentOkBodyQanProxysqlDigestAgentTypeStatusPropEnum []any
 
-		if swag.IsZero(o.MetricsResolutions) { // not required
-			return nil
-		}
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addAgentOkBodyQanProxysqlDigestAgentTypeStatusPropEnum = append(addAgentOkBodyQanProxysqlDigestAgentTypeStatusPropEnum, v)
+	}
+}
 
-		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
-			ve := new(errors.Validation)
-			if stderrors.As(err, &ve) {
-				return ve.ValidateName("addAgentOk" + "." + "rds_exporter" + "." + "metrics_resolutions")
-			}
-			ce := new(errors.CompositeError)
-			if stderrors.As(err, &ce) {
-				return ce.ValidateName("addAgentOk" + "." + "rds_exporter" + "." + "metrics_resolutions")
-			}
+const (
 
-			return err
-		}
-	}
+	// AddAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	AddAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// AddAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	AddAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// AddAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	AddAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
 
+	// AddAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	AddAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// AddAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	AddAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// AddAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	AddAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// AddAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	AddAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// AddAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	AddAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *AddAgentOKBodyQANProxysqlDigestAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyQanProxysqlDigestAgentTypeStatusPropEnum, true); err != nil {
+		return err
+	}
 	return nil
 }
 
-// MarshalBinary interface implementation
-func (o *AddAgentOKBodyRDSExporter) MarshalBinary() ([]byte, error) {
-	if o == nil {
-		return nil, nil
+func (o *AddAgentOKBodyQANProxysqlDigestAgent) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
 	}
-	return swag.WriteJSON(o)
-}
 
-// UnmarshalBinary interface implementation
-func (o *AddAgentOKBodyRDSExporter) UnmarshalBinary(b []byte) error {
-	var res AddAgentOKBodyRDSExporter
-	if err := swag.ReadJSON(b, &res); err != nil {
+	// value enum
+	if err := o.validateStatusEnum("addAgentOk"+"."+"qan_proxysql_digest_agent"+"."+"status", "body", *o.Status); err != nil {
 		return err
 	}
-	*o = res
+
 	return nil
 }
 
-/*
-AddAgentOKBodyRDSExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model AddAgentOKBodyRDSExporterMetricsResolutions
-*/
-type AddAgentOKBodyRDSExporterMetricsResolutions struct {
-	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Hr string `json:"hr,omitempty"`
+var addAgentOkBodyQanProxysqlDigestAgentTypeLogLevelPropEnum []any
 
-	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Mr string `json:"mr,omitempty"`
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addAgentOkBodyQanProxysqlDigestAgentTypeLogLevelPropEnum = append(addAgentOkBodyQanProxysqlDigestAgentTypeLogLevelPropEnum, v)
+	}
+}
 
-	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Lr string `json:"lr,omitempty"`
+const (
+
+	// AddAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// AddAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// AddAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// AddAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// AddAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// AddAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *AddAgentOKBodyQANProxysqlDigestAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyQanProxysqlDigestAgentTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
 }
 
-// Validate validates this add agent OK body RDS exporter metrics resolutions
-func (o *AddAgentOKBodyRDSExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+func (o *AddAgentOKBodyQANProxysqlDigestAgent) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("addAgentOk"+"."+"qan_proxysql_digest_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-// ContextValidate validates this add agent OK body RDS exporter metrics resolutions based on context it is used
-func (o *AddAgentOKBodyRDSExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent OK body QAN proxysql digest agent based on context it is used
+func (o *AddAgentOKBodyQANProxysqlDigestAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentOKBodyRDSExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *AddAgentOKBodyQANProxysqlDigestAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -6373,8 +7056,8 @@ func (o *AddAgentOKBodyRDSExporterMetricsResolutions) MarshalBinary() ([]byte, e
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentOKBodyRDSExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res AddAgentOKBodyRDSExporterMetricsResolutions
+func (o *AddAgentOKBodyQANProxysqlDigestAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentOKBodyQANProxysqlDigestAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -6383,11 +7066,11 @@ func (o *AddAgentOKBodyRDSExporterMetricsResolutions) UnmarshalBinary(b []byte)
 }
 
 /*
-AddAgentOKBodyRtaMongodbAgent RTAMongoDBAgent runs within pmm-agent and sends MongoDB Real-Time Query Analytics data to the PMM Server.
-swagger:model AddAgentOKBodyRtaMongodbAgent
+AddAgentOKBodyQANValkeySlowlogAgent QANValkeySlowlogAgent runs within pmm-agent and sends Valkey Query Analytics data to the PMM Server.
+swagger:model AddAgentOKBodyQANValkeySlowlogAgent
 */
-type AddAgentOKBodyRtaMongodbAgent struct {
-	// Unique agent identifier.
+type AddAgentOKBodyQANValkeySlowlogAgent struct {
+	// Unique randomly generated instance identifier.
 	AgentID string `json:"agent_id,omitempty"`
 
 	// The pmm-agent identifier which runs this instance.
@@ -6399,7 +7082,7 @@ type AddAgentOKBodyRtaMongodbAgent struct {
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// MongoDB username for getting profiler data.
+	// Valkey username for getting slow log data.
 	Username string `json:"username,omitempty"`
 
 	// Use TLS for database connections.
@@ -6408,6 +7091,12 @@ type AddAgentOKBodyRtaMongodbAgent struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
 
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
+	// True if query examples are disabled.
+	QueryExamplesDisabled bool `json:"query_examples_disabled,omitempty"`
+
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
 
@@ -6423,18 +7112,18 @@ type AddAgentOKBodyRtaMongodbAgent struct {
 	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
 	Status *string `json:"status,omitempty"`
 
+	// Path to exec process.
+	ProcessExecPath string `json:"process_exec_path,omitempty"`
+
 	// Log level for exporters
 	//
 	// - LOG_LEVEL_UNSPECIFIED: Auto
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
-
-	// rta options
-	RtaOptions *AddAgentOKBodyRtaMongodbAgentRtaOptions `json:"rta_options,omitempty"`
 }
 
-// Validate validates this add agent OK body rta mongodb agent
-func (o *AddAgentOKBodyRtaMongodbAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent OK body QAN valkey slowlog agent
+func (o *AddAgentOKBodyQANValkeySlowlogAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateStatus(formats); err != nil {
@@ -6445,17 +7134,13 @@ func (o *AddAgentOKBodyRtaMongodbAgent) Validate(formats strfmt.Registry) error
 		res = append(res, err)
 	}
 
-	if err := o.validateRtaOptions(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
-var addAgentOkBodyRtaMongodbAgentTypeStatusPropEnum []any
+var addAgentOkBodyQanValkeySlowlogAgentTypeStatusPropEnum []any
 
 func init() {
 	var res []string
@@ -6463,59 +7148,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentOkBodyRtaMongodbAgentTypeStatusPropEnum = append(addAgentOkBodyRtaMongodbAgentTypeStatusPropEnum, v)
+		addAgentOkBodyQanValkeySlowlogAgentTypeStatusPropEnum = append(addAgentOkBodyQanValkeySlowlogAgentTypeStatusPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
-	AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+	// AddAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	AddAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
 
-	// AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
-	AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+	// AddAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	AddAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
 
-	// AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
-	AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+	// AddAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	AddAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
 
-	// AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
-	AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+	// AddAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	AddAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
 
-	// AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
-	AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+	// AddAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	AddAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
 
-	// AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
-	AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+	// AddAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	AddAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
 
-	// AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
-	AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+	// AddAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	AddAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
 
-	// AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
-	AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+	// AddAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	AddAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
 )
 
 // prop value enum
-func (o *AddAgentOKBodyRtaMongodbAgent) validateStatusEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentOkBodyRtaMongodbAgentTypeStatusPropEnum, true); err != nil {
+func (o *AddAgentOKBodyQANValkeySlowlogAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyQanValkeySlowlogAgentTypeStatusPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentOKBodyRtaMongodbAgent) validateStatus(formats strfmt.Registry) error {
+func (o *AddAgentOKBodyQANValkeySlowlogAgent) validateStatus(formats strfmt.Registry) error {
 	if swag.IsZero(o.Status) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateStatusEnum("addAgentOk"+"."+"rta_mongodb_agent"+"."+"status", "body", *o.Status); err != nil {
+	if err := o.validateStatusEnum("addAgentOk"+"."+"qan_valkey_slowlog_agent"+"."+"status", "body", *o.Status); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-var addAgentOkBodyRtaMongodbAgentTypeLogLevelPropEnum []any
+var addAgentOkBodyQanValkeySlowlogAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -6523,66 +7208,288 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentOkBodyRtaMongodbAgentTypeLogLevelPropEnum = append(addAgentOkBodyRtaMongodbAgentTypeLogLevelPropEnum, v)
+		addAgentOkBodyQanValkeySlowlogAgentTypeLogLevelPropEnum = append(addAgentOkBodyQanValkeySlowlogAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentOKBodyRtaMongodbAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentOkBodyRtaMongodbAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentOKBodyQANValkeySlowlogAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyQanValkeySlowlogAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentOKBodyRtaMongodbAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentOKBodyQANValkeySlowlogAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("addAgentOk"+"."+"rta_mongodb_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("addAgentOk"+"."+"qan_valkey_slowlog_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *AddAgentOKBodyRtaMongodbAgent) validateRtaOptions(formats strfmt.Registry) error {
-	if swag.IsZero(o.RtaOptions) { // not required
-		return nil
+// ContextValidate validates this add agent OK body QAN valkey slowlog agent based on context it is used
+func (o *AddAgentOKBodyQANValkeySlowlogAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddAgentOKBodyQANValkeySlowlogAgent) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
 	}
+	return swag.WriteJSON(o)
+}
 
-	if o.RtaOptions != nil {
-		if err := o.RtaOptions.Validate(formats); err != nil {
-			ve := new(errors.Validation)
-			if stderrors.As(err, &ve) {
-				return ve.ValidateName("addAgentOk" + "." + "rta_mongodb_agent" + "." + "rta_options")
-			}
+// UnmarshalBinary interface implementation
+func (o *AddAgentOKBodyQANValkeySlowlogAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentOKBodyQANValkeySlowlogAgent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+AddAgentOKBodyRDSExporter RDSExporter runs on Generic or Container Node and exposes RemoteRDS Node metrics.
+swagger:model AddAgentOKBodyRDSExporter
+*/
+type AddAgentOKBodyRDSExporter struct {
+	// Unique randomly generated instance identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Node identifier.
+	NodeID string `json:"node_id,omitempty"`
+
+	// AWS Access Key.
+	AWSAccessKey string `json:"aws_access_key,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Listen port for scraping metrics (the same for several configurations).
+	ListenPort int64 `json:"listen_port,omitempty"`
+
+	// Basic metrics are disabled.
+	BasicMetricsDisabled bool `json:"basic_metrics_disabled,omitempty"`
+
+	// Enhanced metrics are disabled.
+	EnhancedMetricsDisabled bool `json:"enhanced_metrics_disabled,omitempty"`
+
+	// True if exporter uses push metrics mode.
+	PushMetricsEnabled bool `json:"push_metrics_enabled,omitempty"`
+
+	// Path to exec process.
+	ProcessExecPath string `json:"process_exec_path,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// Limit of databases for auto-discovery.
+	AutoDiscoveryLimit int32 `json:"auto_discovery_limit,omitempty"`
+
+	// metrics resolutions
+	MetricsResolutions *AddAgentOKBodyRDSExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+}
+
+// Validate validates this add agent OK body RDS exporter
+func (o *AddAgentOKBodyRDSExporter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMetricsResolutions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var addAgentOkBodyRdsExporterTypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addAgentOkBodyRdsExporterTypeStatusPropEnum = append(addAgentOkBodyRdsExporterTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// AddAgentOKBodyRDSExporterStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	AddAgentOKBodyRDSExporterStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// AddAgentOKBodyRDSExporterStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	AddAgentOKBodyRDSExporterStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// AddAgentOKBodyRDSExporterStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	AddAgentOKBodyRDSExporterStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// AddAgentOKBodyRDSExporterStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	AddAgentOKBodyRDSExporterStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// AddAgentOKBodyRDSExporterStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	AddAgentOKBodyRDSExporterStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// AddAgentOKBodyRDSExporterStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	AddAgentOKBodyRDSExporterStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// AddAgentOKBodyRDSExporterStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	AddAgentOKBodyRDSExporterStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// AddAgentOKBodyRDSExporterStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	AddAgentOKBodyRDSExporterStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *AddAgentOKBodyRDSExporter) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyRdsExporterTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddAgentOKBodyRDSExporter) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("addAgentOk"+"."+"rds_exporter"+"."+"status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var addAgentOkBodyRdsExporterTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addAgentOkBodyRdsExporterTypeLogLevelPropEnum = append(addAgentOkBodyRdsExporterTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// AddAgentOKBodyRDSExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentOKBodyRDSExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// AddAgentOKBodyRDSExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentOKBodyRDSExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// AddAgentOKBodyRDSExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentOKBodyRDSExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// AddAgentOKBodyRDSExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentOKBodyRDSExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// AddAgentOKBodyRDSExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentOKBodyRDSExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// AddAgentOKBodyRDSExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentOKBodyRDSExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *AddAgentOKBodyRDSExporter) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyRdsExporterTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddAgentOKBodyRDSExporter) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("addAgentOk"+"."+"rds_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *AddAgentOKBodyRDSExporter) validateMetricsResolutions(formats strfmt.Registry) error {
+	if swag.IsZero(o.MetricsResolutions) { // not required
+		return nil
+	}
+
+	if o.MetricsResolutions != nil {
+		if err := o.MetricsResolutions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "rds_exporter" + "." + "metrics_resolutions")
+			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("addAgentOk" + "." + "rta_mongodb_agent" + "." + "rta_options")
+				return ce.ValidateName("addAgentOk" + "." + "rds_exporter" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -6592,11 +7499,11 @@ func (o *AddAgentOKBodyRtaMongodbAgent) validateRtaOptions(formats strfmt.Regist
 	return nil
 }
 
-// ContextValidate validate this add agent OK body rta mongodb agent based on the context it is used
-func (o *AddAgentOKBodyRtaMongodbAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this add agent OK body RDS exporter based on the context it is used
+func (o *AddAgentOKBodyRDSExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
-	if err := o.contextValidateRtaOptions(ctx, formats); err != nil {
+	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -6606,21 +7513,21 @@ func (o *AddAgentOKBodyRtaMongodbAgent) ContextValidate(ctx context.Context, for
 	return nil
 }
 
-func (o *AddAgentOKBodyRtaMongodbAgent) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
-	if o.RtaOptions != nil {
+func (o *AddAgentOKBodyRDSExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+	if o.MetricsResolutions != nil {
 
-		if swag.IsZero(o.RtaOptions) { // not required
+		if swag.IsZero(o.MetricsResolutions) { // not required
 			return nil
 		}
 
-		if err := o.RtaOptions.ContextValidate(ctx, formats); err != nil {
+		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("addAgentOk" + "." + "rta_mongodb_agent" + "." + "rta_options")
+				return ve.ValidateName("addAgentOk" + "." + "rds_exporter" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("addAgentOk" + "." + "rta_mongodb_agent" + "." + "rta_options")
+				return ce.ValidateName("addAgentOk" + "." + "rds_exporter" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -6631,7 +7538,7 @@ func (o *AddAgentOKBodyRtaMongodbAgent) contextValidateRtaOptions(ctx context.Co
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentOKBodyRtaMongodbAgent) MarshalBinary() ([]byte, error) {
+func (o *AddAgentOKBodyRDSExporter) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -6639,8 +7546,8 @@ func (o *AddAgentOKBodyRtaMongodbAgent) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentOKBodyRtaMongodbAgent) UnmarshalBinary(b []byte) error {
-	var res AddAgentOKBodyRtaMongodbAgent
+func (o *AddAgentOKBodyRDSExporter) UnmarshalBinary(b []byte) error {
+	var res AddAgentOKBodyRDSExporter
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -6648,27 +7555,1740 @@ func (o *AddAgentOKBodyRtaMongodbAgent) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
-/*
-AddAgentOKBodyRtaMongodbAgentRtaOptions RTAOptions holds Real-Time Query Analytics agent options.
-swagger:model AddAgentOKBodyRtaMongodbAgentRtaOptions
-*/
-type AddAgentOKBodyRtaMongodbAgentRtaOptions struct {
-	// Query collect interval (default 2s is set by server).
-	CollectInterval string `json:"collect_interval,omitempty"`
-}
+/*
+AddAgentOKBodyRDSExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model AddAgentOKBodyRDSExporterMetricsResolutions
+*/
+type AddAgentOKBodyRDSExporterMetricsResolutions struct {
+	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Hr string `json:"hr,omitempty"`
+
+	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Mr string `json:"mr,omitempty"`
+
+	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Lr string `json:"lr,omitempty"`
+}
+
+// Validate validates this add agent OK body RDS exporter metrics resolutions
+func (o *AddAgentOKBodyRDSExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this add agent OK body RDS exporter metrics resolutions based on context it is used
+func (o *AddAgentOKBodyRDSExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddAgentOKBodyRDSExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddAgentOKBodyRDSExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res AddAgentOKBodyRDSExporterMetricsResolutions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+AddAgentOKBodyRtaMongodbAgent RTAMongoDBAgent runs within pmm-agent and sends MongoDB Real-Time Query Analytics data to the PMM Server.
+swagger:model AddAgentOKBodyRtaMongodbAgent
+*/
+type AddAgentOKBodyRtaMongodbAgent struct {
+	// Unique agent identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// MongoDB username for getting profiler data.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// rta options
+	RtaOptions *AddAgentOKBodyRtaMongodbAgentRtaOptions `json:"rta_options,omitempty"`
+}
+
+// Validate validates this add agent OK body rta mongodb agent
+func (o *AddAgentOKBodyRtaMongodbAgent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateRtaOptions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var addAgentOkBodyRtaMongodbAgentTypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addAgentOkBodyRtaMongodbAgentTypeStatusPropEnum = append(addAgentOkBodyRtaMongodbAgentTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	AddAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *AddAgentOKBodyRtaMongodbAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyRtaMongodbAgentTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddAgentOKBodyRtaMongodbAgent) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("addAgentOk"+"."+"rta_mongodb_agent"+"."+"status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var addAgentOkBodyRtaMongodbAgentTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addAgentOkBodyRtaMongodbAgentTypeLogLevelPropEnum = append(addAgentOkBodyRtaMongodbAgentTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *AddAgentOKBodyRtaMongodbAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyRtaMongodbAgentTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddAgentOKBodyRtaMongodbAgent) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("addAgentOk"+"."+"rta_mongodb_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *AddAgentOKBodyRtaMongodbAgent) validateRtaOptions(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaOptions) { // not required
+		return nil
+	}
+
+	if o.RtaOptions != nil {
+		if err := o.RtaOptions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "rta_mongodb_agent" + "." + "rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "rta_mongodb_agent" + "." + "rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this add agent OK body rta mongodb agent based on the context it is used
+func (o *AddAgentOKBodyRtaMongodbAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateRtaOptions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *AddAgentOKBodyRtaMongodbAgent) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaOptions != nil {
+
+		if swag.IsZero(o.RtaOptions) { // not required
+			return nil
+		}
+
+		if err := o.RtaOptions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "rta_mongodb_agent" + "." + "rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "rta_mongodb_agent" + "." + "rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddAgentOKBodyRtaMongodbAgent) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddAgentOKBodyRtaMongodbAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentOKBodyRtaMongodbAgent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+AddAgentOKBodyRtaMongodbAgentRtaOptions RTAOptions holds Real-Time Query Analytics agent options.
+swagger:model AddAgentOKBodyRtaMongodbAgentRtaOptions
+*/
+type AddAgentOKBodyRtaMongodbAgentRtaOptions struct {
+	// Query collect interval (default 2s is set by server).
+	CollectInterval string `json:"collect_interval,omitempty"`
+}
+
+// Validate validates this add agent OK body rta mongodb agent rta options
+func (o *AddAgentOKBodyRtaMongodbAgentRtaOptions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this add agent OK body rta mongodb agent rta options based on context it is used
+func (o *AddAgentOKBodyRtaMongodbAgentRtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddAgentOKBodyRtaMongodbAgentRtaOptions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddAgentOKBodyRtaMongodbAgentRtaOptions) UnmarshalBinary(b []byte) error {
+	var res AddAgentOKBodyRtaMongodbAgentRtaOptions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+AddAgentOKBodyRtaMysqlAgent RTAMySQLAgent runs within pmm-agent and sends MySQL Real-Time Query Analytics data to the PMM Server.
+swagger:model AddAgentOKBodyRtaMysqlAgent
+*/
+type AddAgentOKBodyRtaMysqlAgent struct {
+	// Unique agent identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// MySQL username for getting currently running queries.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// rta options
+	RtaOptions *AddAgentOKBodyRtaMysqlAgentRtaOptions `json:"rta_options,omitempty"`
+}
+
+// Validate validates this add agent OK body rta mysql agent
+func (o *AddAgentOKBodyRtaMysqlAgent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateRtaOptions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var addAgentOkBodyRtaMysqlAgentTypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addAgentOkBodyRtaMysqlAgentTypeStatusPropEnum = append(addAgentOkBodyRtaMysqlAgentTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// AddAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	AddAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// AddAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	AddAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// AddAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	AddAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// AddAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	AddAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// AddAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	AddAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// AddAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	AddAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// AddAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	AddAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// AddAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	AddAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *AddAgentOKBodyRtaMysqlAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyRtaMysqlAgentTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddAgentOKBodyRtaMysqlAgent) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("addAgentOk"+"."+"rta_mysql_agent"+"."+"status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var addAgentOkBodyRtaMysqlAgentTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addAgentOkBodyRtaMysqlAgentTypeLogLevelPropEnum = append(addAgentOkBodyRtaMysqlAgentTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// AddAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// AddAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// AddAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// AddAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// AddAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// AddAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *AddAgentOKBodyRtaMysqlAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyRtaMysqlAgentTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddAgentOKBodyRtaMysqlAgent) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("addAgentOk"+"."+"rta_mysql_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *AddAgentOKBodyRtaMysqlAgent) validateRtaOptions(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaOptions) { // not required
+		return nil
+	}
+
+	if o.RtaOptions != nil {
+		if err := o.RtaOptions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "rta_mysql_agent" + "." + "rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "rta_mysql_agent" + "." + "rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this add agent OK body rta mysql agent based on the context it is used
+func (o *AddAgentOKBodyRtaMysqlAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateRtaOptions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *AddAgentOKBodyRtaMysqlAgent) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaOptions != nil {
+
+		if swag.IsZero(o.RtaOptions) { // not required
+			return nil
+		}
+
+		if err := o.RtaOptions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "rta_mysql_agent" + "." + "rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "rta_mysql_agent" + "." + "rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddAgentOKBodyRtaMysqlAgent) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddAgentOKBodyRtaMysqlAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentOKBodyRtaMysqlAgent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+AddAgentOKBodyRtaMysqlAgentRtaOptions RTAOptions holds Real-Time Query Analytics agent options.
+swagger:model AddAgentOKBodyRtaMysqlAgentRtaOptions
+*/
+type AddAgentOKBodyRtaMysqlAgentRtaOptions struct {
+	// Query collect interval (default 2s is set by server).
+	CollectInterval string `json:"collect_interval,omitempty"`
+}
+
+// Validate validates this add agent OK body rta mysql agent rta options
+func (o *AddAgentOKBodyRtaMysqlAgentRtaOptions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this add agent OK body rta mysql agent rta options based on context it is used
+func (o *AddAgentOKBodyRtaMysqlAgentRtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddAgentOKBodyRtaMysqlAgentRtaOptions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddAgentOKBodyRtaMysqlAgentRtaOptions) UnmarshalBinary(b []byte) error {
+	var res AddAgentOKBodyRtaMysqlAgentRtaOptions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+AddAgentOKBodyRtaPostgresqlAgent RTAPostgreSQLAgent runs within pmm-agent and sends PostgreSQL Real-Time Query Analytics data to the PMM Server.
+swagger:model AddAgentOKBodyRtaPostgresqlAgent
+*/
+type AddAgentOKBodyRtaPostgresqlAgent struct {
+	// Unique agent identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// PostgreSQL username for getting currently running queries.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// rta options
+	RtaOptions *AddAgentOKBodyRtaPostgresqlAgentRtaOptions `json:"rta_options,omitempty"`
+}
+
+// Validate validates this add agent OK body rta postgresql agent
+func (o *AddAgentOKBodyRtaPostgresqlAgent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateRtaOptions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var addAgentOkBodyRtaPostgresqlAgentTypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addAgentOkBodyRtaPostgresqlAgentTypeStatusPropEnum = append(addAgentOkBodyRtaPostgresqlAgentTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// AddAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	AddAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// AddAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	AddAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// AddAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	AddAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// AddAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	AddAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// AddAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	AddAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// AddAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	AddAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// AddAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	AddAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// AddAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	AddAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *AddAgentOKBodyRtaPostgresqlAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyRtaPostgresqlAgentTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddAgentOKBodyRtaPostgresqlAgent) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("addAgentOk"+"."+"rta_postgresql_agent"+"."+"status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var addAgentOkBodyRtaPostgresqlAgentTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addAgentOkBodyRtaPostgresqlAgentTypeLogLevelPropEnum = append(addAgentOkBodyRtaPostgresqlAgentTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// AddAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// AddAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// AddAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// AddAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// AddAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// AddAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *AddAgentOKBodyRtaPostgresqlAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyRtaPostgresqlAgentTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddAgentOKBodyRtaPostgresqlAgent) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("addAgentOk"+"."+"rta_postgresql_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *AddAgentOKBodyRtaPostgresqlAgent) validateRtaOptions(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaOptions) { // not required
+		return nil
+	}
+
+	if o.RtaOptions != nil {
+		if err := o.RtaOptions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "rta_postgresql_agent" + "." + "rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "rta_postgresql_agent" + "." + "rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this add agent OK body rta postgresql agent based on the context it is used
+func (o *AddAgentOKBodyRtaPostgresqlAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateRtaOptions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *AddAgentOKBodyRtaPostgresqlAgent) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaOptions != nil {
+
+		if swag.IsZero(o.RtaOptions) { // not required
+			return nil
+		}
+
+		if err := o.RtaOptions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "rta_postgresql_agent" + "." + "rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "rta_postgresql_agent" + "." + "rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddAgentOKBodyRtaPostgresqlAgent) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddAgentOKBodyRtaPostgresqlAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentOKBodyRtaPostgresqlAgent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+AddAgentOKBodyRtaPostgresqlAgentRtaOptions RTAOptions holds Real-Time Query Analytics agent options.
+swagger:model AddAgentOKBodyRtaPostgresqlAgentRtaOptions
+*/
+type AddAgentOKBodyRtaPostgresqlAgentRtaOptions struct {
+	// Query collect interval (default 2s is set by server).
+	CollectInterval string `json:"collect_interval,omitempty"`
+}
+
+// Validate validates this add agent OK body rta postgresql agent rta options
+func (o *AddAgentOKBodyRtaPostgresqlAgentRtaOptions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this add agent OK body rta postgresql agent rta options based on context it is used
+func (o *AddAgentOKBodyRtaPostgresqlAgentRtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddAgentOKBodyRtaPostgresqlAgentRtaOptions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddAgentOKBodyRtaPostgresqlAgentRtaOptions) UnmarshalBinary(b []byte) error {
+	var res AddAgentOKBodyRtaPostgresqlAgentRtaOptions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+AddAgentOKBodyValkeyExporter ValkeyExporter runs on Generic or Container Node and exposes Valkey Service metrics.
+swagger:model AddAgentOKBodyValkeyExporter
+*/
+type AddAgentOKBodyValkeyExporter struct {
+	// Unique randomly generated instance identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// Valkey username for scraping metrics.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname verification.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// True if exporter uses push metrics mode.
+	PushMetricsEnabled bool `json:"push_metrics_enabled,omitempty"`
+
+	// List of disabled collector names.
+	DisabledCollectors []string `json:"disabled_collectors"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Listen port for scraping metrics.
+	ListenPort int64 `json:"listen_port,omitempty"`
+
+	// Path to exec process.
+	ProcessExecPath string `json:"process_exec_path,omitempty"`
+
+	// Optionally expose the exporter process on all public interfaces
+	ExposeExporter bool `json:"expose_exporter,omitempty"`
+
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout string `json:"connection_timeout,omitempty"`
+
+	// metrics resolutions
+	MetricsResolutions *AddAgentOKBodyValkeyExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+}
+
+// Validate validates this add agent OK body valkey exporter
+func (o *AddAgentOKBodyValkeyExporter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMetricsResolutions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var addAgentOkBodyValkeyExporterTypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addAgentOkBodyValkeyExporterTypeStatusPropEnum = append(addAgentOkBodyValkeyExporterTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// AddAgentOKBodyValkeyExporterStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	AddAgentOKBodyValkeyExporterStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// AddAgentOKBodyValkeyExporterStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	AddAgentOKBodyValkeyExporterStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// AddAgentOKBodyValkeyExporterStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	AddAgentOKBodyValkeyExporterStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// AddAgentOKBodyValkeyExporterStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	AddAgentOKBodyValkeyExporterStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// AddAgentOKBodyValkeyExporterStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	AddAgentOKBodyValkeyExporterStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// AddAgentOKBodyValkeyExporterStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	AddAgentOKBodyValkeyExporterStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// AddAgentOKBodyValkeyExporterStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	AddAgentOKBodyValkeyExporterStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// AddAgentOKBodyValkeyExporterStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	AddAgentOKBodyValkeyExporterStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *AddAgentOKBodyValkeyExporter) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentOkBodyValkeyExporterTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddAgentOKBodyValkeyExporter) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("addAgentOk"+"."+"valkey_exporter"+"."+"status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *AddAgentOKBodyValkeyExporter) validateMetricsResolutions(formats strfmt.Registry) error {
+	if swag.IsZero(o.MetricsResolutions) { // not required
+		return nil
+	}
+
+	if o.MetricsResolutions != nil {
+		if err := o.MetricsResolutions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "valkey_exporter" + "." + "metrics_resolutions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "valkey_exporter" + "." + "metrics_resolutions")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this add agent OK body valkey exporter based on the context it is used
+func (o *AddAgentOKBodyValkeyExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *AddAgentOKBodyValkeyExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+	if o.MetricsResolutions != nil {
+
+		if swag.IsZero(o.MetricsResolutions) { // not required
+			return nil
+		}
+
+		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addAgentOk" + "." + "valkey_exporter" + "." + "metrics_resolutions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addAgentOk" + "." + "valkey_exporter" + "." + "metrics_resolutions")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddAgentOKBodyValkeyExporter) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddAgentOKBodyValkeyExporter) UnmarshalBinary(b []byte) error {
+	var res AddAgentOKBodyValkeyExporter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+AddAgentOKBodyValkeyExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model AddAgentOKBodyValkeyExporterMetricsResolutions
+*/
+type AddAgentOKBodyValkeyExporterMetricsResolutions struct {
+	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Hr string `json:"hr,omitempty"`
+
+	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Mr string `json:"mr,omitempty"`
+
+	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Lr string `json:"lr,omitempty"`
+}
+
+// Validate validates this add agent OK body valkey exporter metrics resolutions
+func (o *AddAgentOKBodyValkeyExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this add agent OK body valkey exporter metrics resolutions based on context it is used
+func (o *AddAgentOKBodyValkeyExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddAgentOKBodyValkeyExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddAgentOKBodyValkeyExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res AddAgentOKBodyValkeyExporterMetricsResolutions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+AddAgentParamsBodyAzureDatabaseExporter add agent params body azure database exporter
+swagger:model AddAgentParamsBodyAzureDatabaseExporter
+*/
+type AddAgentParamsBodyAzureDatabaseExporter struct {
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Node identifier.
+	NodeID string `json:"node_id,omitempty"`
+
+	// Azure client ID
+	AzureClientID string `json:"azure_client_id,omitempty"`
+
+	// Azure client secret
+	AzureClientSecret string `json:"azure_client_secret,omitempty"`
+
+	// Azure tanant ID
+	AzureTenantID string `json:"azure_tenant_id,omitempty"`
+
+	// Azure subscription ID
+	AzureSubscriptionID string `json:"azure_subscription_id,omitempty"`
+
+	// Azure resource group.
+	AzureResourceGroup string `json:"azure_resource_group,omitempty"`
+
+	// Azure resource type (mysql, maria, postgres)
+	AzureDatabaseResourceType string `json:"azure_database_resource_type,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// Skip connection check.
+	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
+
+	// Enables push metrics mode for exporter.
+	PushMetrics bool `json:"push_metrics,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+}
+
+// Validate validates this add agent params body azure database exporter
+func (o *AddAgentParamsBodyAzureDatabaseExporter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var addAgentParamsBodyAzureDatabaseExporterTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addAgentParamsBodyAzureDatabaseExporterTypeLogLevelPropEnum = append(addAgentParamsBodyAzureDatabaseExporterTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *AddAgentParamsBodyAzureDatabaseExporter) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyAzureDatabaseExporterTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddAgentParamsBodyAzureDatabaseExporter) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("body"+"."+"azure_database_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this add agent params body azure database exporter based on context it is used
+func (o *AddAgentParamsBodyAzureDatabaseExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddAgentParamsBodyAzureDatabaseExporter) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddAgentParamsBodyAzureDatabaseExporter) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyAzureDatabaseExporter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+AddAgentParamsBodyExternalExporter add agent params body external exporter
+swagger:model AddAgentParamsBodyExternalExporter
+*/
+type AddAgentParamsBodyExternalExporter struct {
+	// The node identifier where this instance is run.
+	RunsOnNodeID string `json:"runs_on_node_id,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// HTTP basic auth username for collecting metrics.
+	Username string `json:"username,omitempty"`
+
+	// HTTP basic auth password for collecting metrics.
+	Password string `json:"password,omitempty"`
+
+	// Scheme to generate URI to exporter metrics endpoints(default: http).
+	Scheme string `json:"scheme,omitempty"`
+
+	// Path under which metrics are exposed, used to generate URI(default: /metrics).
+	MetricsPath string `json:"metrics_path,omitempty"`
+
+	// Listen port for scraping metrics.
+	ListenPort int64 `json:"listen_port,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// Enables push metrics mode for exporter.
+	PushMetrics bool `json:"push_metrics,omitempty"`
+
+	// Skip TLS certificate and hostname verification.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+}
+
+// Validate validates this add agent params body external exporter
+func (o *AddAgentParamsBodyExternalExporter) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this add agent params body external exporter based on context it is used
+func (o *AddAgentParamsBodyExternalExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddAgentParamsBodyExternalExporter) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddAgentParamsBodyExternalExporter) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyExternalExporter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+AddAgentParamsBodyMongodbExporter add agent params body mongodb exporter
+swagger:model AddAgentParamsBodyMongodbExporter
+*/
+type AddAgentParamsBodyMongodbExporter struct {
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// MongoDB username for scraping metrics.
+	Username string `json:"username,omitempty"`
+
+	// MongoDB password for scraping metrics.
+	Password string `json:"password,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Client certificate and key.
+	TLSCertificateKey string `json:"tls_certificate_key,omitempty"`
+
+	// Password for decrypting tls_certificate_key.
+	TLSCertificateKeyFilePassword string `json:"tls_certificate_key_file_password,omitempty"`
+
+	// Certificate Authority certificate chain.
+	TLSCa string `json:"tls_ca,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// Skip connection check.
+	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
+
+	// Enables push metrics mode for exporter.
+	PushMetrics bool `json:"push_metrics,omitempty"`
+
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `json:"disable_collectors"`
+
+	// Authentication mechanism.
+	// See https://docs.mongodb.com/manual/reference/connection-string/#mongodb-urioption-urioption.authMechanism
+	// for details.
+	AuthenticationMechanism string `json:"authentication_mechanism,omitempty"`
+
+	// Authentication database.
+	AuthenticationDatabase string `json:"authentication_database,omitempty"`
+
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword string `json:"agent_password,omitempty"`
+
+	// List of colletions to get stats from. Can use *
+	StatsCollections []string `json:"stats_collections"`
+
+	// Collections limit. Only get Databases and collection stats if the total number of collections in the server
+	// is less than this value. 0: no limit
+	CollectionsLimit int32 `json:"collections_limit,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// Optionally expose the exporter process on all public interfaces
+	ExposeExporter bool `json:"expose_exporter,omitempty"`
+
+	// Environment variable names to pass to the exporter.
+	// Values will be resolved from pmm-agent's environment when starting the exporter.
+	EnvironmentVariableNames []string `json:"environment_variable_names"`
+
+	// Enable all collectors.
+	EnableAllCollectors bool `json:"enable_all_collectors,omitempty"`
+
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout string `json:"connection_timeout,omitempty"`
+
+	// Enable collecting histogram bucket metrics from getDiagnosticData.
+	EnableDiagnosticDataHistograms bool `json:"enable_diagnostic_data_histograms,omitempty"`
+}
+
+// Validate validates this add agent params body mongodb exporter
+func (o *AddAgentParamsBodyMongodbExporter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var addAgentParamsBodyMongodbExporterTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addAgentParamsBodyMongodbExporterTypeLogLevelPropEnum = append(addAgentParamsBodyMongodbExporterTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *AddAgentParamsBodyMongodbExporter) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyMongodbExporterTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddAgentParamsBodyMongodbExporter) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("body"+"."+"mongodb_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this add agent params body mongodb exporter based on context it is used
+func (o *AddAgentParamsBodyMongodbExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddAgentParamsBodyMongodbExporter) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddAgentParamsBodyMongodbExporter) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyMongodbExporter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+AddAgentParamsBodyMysqldExporter add agent params body mysqld exporter
+swagger:model AddAgentParamsBodyMysqldExporter
+*/
+type AddAgentParamsBodyMysqldExporter struct {
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// MySQL username for scraping metrics.
+	Username string `json:"username,omitempty"`
+
+	// MySQL password for scraping metrics.
+	Password string `json:"password,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Certificate Authority certificate chain.
+	TLSCa string `json:"tls_ca,omitempty"`
+
+	// Client certificate.
+	TLSCert string `json:"tls_cert,omitempty"`
+
+	// Password for decrypting tls_cert.
+	TLSKey string `json:"tls_key,omitempty"`
+
+	// Tablestats group collectors will be disabled if there are more than that number of tables.
+	// 0 means tablestats group collectors are always enabled (no limit).
+	// Negative value means tablestats group collectors are always disabled.
+	TablestatsGroupTableLimit int32 `json:"tablestats_group_table_limit,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// Skip connection check.
+	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
+
+	// Enables push metrics mode for exporter.
+	PushMetrics bool `json:"push_metrics,omitempty"`
+
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `json:"disable_collectors"`
+
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword string `json:"agent_password,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// Optionally expose the exporter process on all public interfaces
+	ExposeExporter bool `json:"expose_exporter,omitempty"`
+
+	// Extra DSN parameters for MySQL connection.
+	ExtraDsnParams map[string]string `json:"extra_dsn_params,omitempty"`
+
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout string `json:"connection_timeout,omitempty"`
+}
+
+// Validate validates this add agent params body mysqld exporter
+func (o *AddAgentParamsBodyMysqldExporter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var addAgentParamsBodyMysqldExporterTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addAgentParamsBodyMysqldExporterTypeLogLevelPropEnum = append(addAgentParamsBodyMysqldExporterTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *AddAgentParamsBodyMysqldExporter) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyMysqldExporterTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddAgentParamsBodyMysqldExporter) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("body"+"."+"mysqld_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
 
-// Validate validates this add agent OK body rta mongodb agent rta options
-func (o *AddAgentOKBodyRtaMongodbAgentRtaOptions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this add agent OK body rta mongodb agent rta options based on context it is used
-func (o *AddAgentOKBodyRtaMongodbAgentRtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent params body mysqld exporter based on context it is used
+func (o *AddAgentParamsBodyMysqldExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentOKBodyRtaMongodbAgentRtaOptions) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyMysqldExporter) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -6676,8 +9296,8 @@ func (o *AddAgentOKBodyRtaMongodbAgentRtaOptions) MarshalBinary() ([]byte, error
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentOKBodyRtaMongodbAgentRtaOptions) UnmarshalBinary(b []byte) error {
-	var res AddAgentOKBodyRtaMongodbAgentRtaOptions
+func (o *AddAgentParamsBodyMysqldExporter) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyMysqldExporter
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -6686,77 +9306,37 @@ func (o *AddAgentOKBodyRtaMongodbAgentRtaOptions) UnmarshalBinary(b []byte) erro
 }
 
 /*
-AddAgentOKBodyValkeyExporter ValkeyExporter runs on Generic or Container Node and exposes Valkey Service metrics.
-swagger:model AddAgentOKBodyValkeyExporter
+AddAgentParamsBodyNodeExporter add agent params body node exporter
+swagger:model AddAgentParamsBodyNodeExporter
 */
-type AddAgentOKBodyValkeyExporter struct {
-	// Unique randomly generated instance identifier.
-	AgentID string `json:"agent_id,omitempty"`
-
+type AddAgentParamsBodyNodeExporter struct {
 	// The pmm-agent identifier which runs this instance.
 	PMMAgentID string `json:"pmm_agent_id,omitempty"`
 
-	// Desired Agent status: enabled (false) or disabled (true).
-	Disabled bool `json:"disabled,omitempty"`
-
-	// Service identifier.
-	ServiceID string `json:"service_id,omitempty"`
-
-	// Valkey username for scraping metrics.
-	Username string `json:"username,omitempty"`
-
-	// Use TLS for database connections.
-	TLS bool `json:"tls,omitempty"`
-
-	// Skip TLS certificate and hostname verification.
-	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
-
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
 
-	// True if exporter uses push metrics mode.
-	PushMetricsEnabled bool `json:"push_metrics_enabled,omitempty"`
+	// Enables push metrics mode for exporter.
+	PushMetrics bool `json:"push_metrics,omitempty"`
 
-	// List of disabled collector names.
-	DisabledCollectors []string `json:"disabled_collectors"`
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `json:"disable_collectors"`
 
-	// AgentStatus represents actual Agent status.
+	// Log level for exporters
 	//
-	//  - AGENT_STATUS_STARTING: Agent is starting.
-	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
-	//  - AGENT_STATUS_RUNNING: Agent is running.
-	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
-	//  - AGENT_STATUS_STOPPING: Agent is stopping.
-	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
-	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
-	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
-	Status *string `json:"status,omitempty"`
-
-	// Listen port for scraping metrics.
-	ListenPort int64 `json:"listen_port,omitempty"`
-
-	// Path to exec process.
-	ProcessExecPath string `json:"process_exec_path,omitempty"`
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
 
-	// Optionally expose the exporter process on all public interfaces
+	// Expose the node_exporter process on all public interfaces
 	ExposeExporter bool `json:"expose_exporter,omitempty"`
-
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout string `json:"connection_timeout,omitempty"`
-
-	// metrics resolutions
-	MetricsResolutions *AddAgentOKBodyValkeyExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
 }
 
-// Validate validates this add agent OK body valkey exporter
-func (o *AddAgentOKBodyValkeyExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body node exporter
+func (o *AddAgentParamsBodyNodeExporter) Validate(formats strfmt.Registry) error {
 	var res []error
 
-	if err := o.validateStatus(formats); err != nil {
-		res = append(res, err)
-	}
-
-	if err := o.validateMetricsResolutions(formats); err != nil {
+	if err := o.validateLogLevel(formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -6766,129 +9346,67 @@ func (o *AddAgentOKBodyValkeyExporter) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-var addAgentOkBodyValkeyExporterTypeStatusPropEnum []any
+var addAgentParamsBodyNodeExporterTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentOkBodyValkeyExporterTypeStatusPropEnum = append(addAgentOkBodyValkeyExporterTypeStatusPropEnum, v)
+		addAgentParamsBodyNodeExporterTypeLogLevelPropEnum = append(addAgentParamsBodyNodeExporterTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentOKBodyValkeyExporterStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
-	AddAgentOKBodyValkeyExporterStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
-
-	// AddAgentOKBodyValkeyExporterStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
-	AddAgentOKBodyValkeyExporterStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
-
-	// AddAgentOKBodyValkeyExporterStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
-	AddAgentOKBodyValkeyExporterStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+	// AddAgentParamsBodyNodeExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyNodeExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentOKBodyValkeyExporterStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
-	AddAgentOKBodyValkeyExporterStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+	// AddAgentParamsBodyNodeExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyNodeExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentOKBodyValkeyExporterStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
-	AddAgentOKBodyValkeyExporterStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+	// AddAgentParamsBodyNodeExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyNodeExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentOKBodyValkeyExporterStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
-	AddAgentOKBodyValkeyExporterStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+	// AddAgentParamsBodyNodeExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyNodeExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentOKBodyValkeyExporterStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
-	AddAgentOKBodyValkeyExporterStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+	// AddAgentParamsBodyNodeExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyNodeExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentOKBodyValkeyExporterStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
-	AddAgentOKBodyValkeyExporterStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+	// AddAgentParamsBodyNodeExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyNodeExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentOKBodyValkeyExporter) validateStatusEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentOkBodyValkeyExporterTypeStatusPropEnum, true); err != nil {
+func (o *AddAgentParamsBodyNodeExporter) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyNodeExporterTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentOKBodyValkeyExporter) validateStatus(formats strfmt.Registry) error {
-	if swag.IsZero(o.Status) { // not required
+func (o *AddAgentParamsBodyNodeExporter) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateStatusEnum("addAgentOk"+"."+"valkey_exporter"+"."+"status", "body", *o.Status); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"node_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *AddAgentOKBodyValkeyExporter) validateMetricsResolutions(formats strfmt.Registry) error {
-	if swag.IsZero(o.MetricsResolutions) { // not required
-		return nil
-	}
-
-	if o.MetricsResolutions != nil {
-		if err := o.MetricsResolutions.Validate(formats); err != nil {
-			ve := new(errors.Validation)
-			if stderrors.As(err, &ve) {
-				return ve.ValidateName("addAgentOk" + "." + "valkey_exporter" + "." + "metrics_resolutions")
-			}
-			ce := new(errors.CompositeError)
-			if stderrors.As(err, &ce) {
-				return ce.ValidateName("addAgentOk" + "." + "valkey_exporter" + "." + "metrics_resolutions")
-			}
-
-			return err
-		}
-	}
-
-	return nil
-}
-
-// ContextValidate validate this add agent OK body valkey exporter based on the context it is used
-func (o *AddAgentOKBodyValkeyExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	var res []error
-
-	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
-		res = append(res, err)
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
-
-func (o *AddAgentOKBodyValkeyExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
-	if o.MetricsResolutions != nil {
-
-		if swag.IsZero(o.MetricsResolutions) { // not required
-			return nil
-		}
-
-		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
-			ve := new(errors.Validation)
-			if stderrors.As(err, &ve) {
-				return ve.ValidateName("addAgentOk" + "." + "valkey_exporter" + "." + "metrics_resolutions")
-			}
-			ce := new(errors.CompositeError)
-			if stderrors.As(err, &ce) {
-				return ce.ValidateName("addAgentOk" + "." + "valkey_exporter" + "." + "metrics_resolutions")
-			}
-
-			return err
-		}
-	}
-
+// ContextValidate validates this add agent params body node exporter based on context it is used
+func (o *AddAgentParamsBodyNodeExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentOKBodyValkeyExporter) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyNodeExporter) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -6896,8 +9414,8 @@ func (o *AddAgentOKBodyValkeyExporter) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentOKBodyValkeyExporter) UnmarshalBinary(b []byte) error {
-	var res AddAgentOKBodyValkeyExporter
+func (o *AddAgentParamsBodyNodeExporter) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyNodeExporter
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -6906,32 +9424,29 @@ func (o *AddAgentOKBodyValkeyExporter) UnmarshalBinary(b []byte) error {
 }
 
 /*
-AddAgentOKBodyValkeyExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model AddAgentOKBodyValkeyExporterMetricsResolutions
+AddAgentParamsBodyPMMAgent add agent params body PMM agent
+swagger:model AddAgentParamsBodyPMMAgent
 */
-type AddAgentOKBodyValkeyExporterMetricsResolutions struct {
-	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Hr string `json:"hr,omitempty"`
-
-	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Mr string `json:"mr,omitempty"`
+type AddAgentParamsBodyPMMAgent struct {
+	// Node identifier where this instance runs.
+	RunsOnNodeID string `json:"runs_on_node_id,omitempty"`
 
-	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Lr string `json:"lr,omitempty"`
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
 }
 
-// Validate validates this add agent OK body valkey exporter metrics resolutions
-func (o *AddAgentOKBodyValkeyExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body PMM agent
+func (o *AddAgentParamsBodyPMMAgent) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this add agent OK body valkey exporter metrics resolutions based on context it is used
-func (o *AddAgentOKBodyValkeyExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent params body PMM agent based on context it is used
+func (o *AddAgentParamsBodyPMMAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentOKBodyValkeyExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyPMMAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -6939,8 +9454,8 @@ func (o *AddAgentOKBodyValkeyExporterMetricsResolutions) MarshalBinary() ([]byte
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentOKBodyValkeyExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res AddAgentOKBodyValkeyExporterMetricsResolutions
+func (o *AddAgentParamsBodyPMMAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyPMMAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -6949,33 +9464,27 @@ func (o *AddAgentOKBodyValkeyExporterMetricsResolutions) UnmarshalBinary(b []byt
 }
 
 /*
-AddAgentParamsBodyAzureDatabaseExporter add agent params body azure database exporter
-swagger:model AddAgentParamsBodyAzureDatabaseExporter
-*/
-type AddAgentParamsBodyAzureDatabaseExporter struct {
-	// The pmm-agent identifier which runs this instance.
-	PMMAgentID string `json:"pmm_agent_id,omitempty"`
-
-	// Node identifier.
-	NodeID string `json:"node_id,omitempty"`
-
-	// Azure client ID
-	AzureClientID string `json:"azure_client_id,omitempty"`
+AddAgentParamsBodyPostgresExporter add agent params body postgres exporter
+swagger:model AddAgentParamsBodyPostgresExporter
+*/
+type AddAgentParamsBodyPostgresExporter struct {
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
 
-	// Azure client secret
-	AzureClientSecret string `json:"azure_client_secret,omitempty"`
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
 
-	// Azure tanant ID
-	AzureTenantID string `json:"azure_tenant_id,omitempty"`
+	// PostgreSQL username for scraping metrics.
+	Username string `json:"username,omitempty"`
 
-	// Azure subscription ID
-	AzureSubscriptionID string `json:"azure_subscription_id,omitempty"`
+	// PostgreSQL password for scraping metrics.
+	Password string `json:"password,omitempty"`
 
-	// Azure resource group.
-	AzureResourceGroup string `json:"azure_resource_group,omitempty"`
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
 
-	// Azure resource type (mysql, maria, postgres)
-	AzureDatabaseResourceType string `json:"azure_database_resource_type,omitempty"`
+	// Skip TLS certificate and hostname validation. Uses sslmode=required instead of verify-full.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
 
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
@@ -6986,15 +9495,42 @@ type AddAgentParamsBodyAzureDatabaseExporter struct {
 	// Enables push metrics mode for exporter.
 	PushMetrics bool `json:"push_metrics,omitempty"`
 
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `json:"disable_collectors"`
+
+	// TLS CA certificate.
+	TLSCa string `json:"tls_ca,omitempty"`
+
+	// TLS Certifcate.
+	TLSCert string `json:"tls_cert,omitempty"`
+
+	// TLS Certificate Key.
+	TLSKey string `json:"tls_key,omitempty"`
+
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword string `json:"agent_password,omitempty"`
+
 	// Log level for exporters
 	//
 	// - LOG_LEVEL_UNSPECIFIED: Auto
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
+
+	// Limit of databases for auto-discovery.
+	AutoDiscoveryLimit int32 `json:"auto_discovery_limit,omitempty"`
+
+	// Optionally expose the exporter process on all public interfaces
+	ExposeExporter bool `json:"expose_exporter,omitempty"`
+
+	// Maximum number of connections that exporter can open to the database instance.
+	MaxExporterConnections int32 `json:"max_exporter_connections,omitempty"`
+
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout string `json:"connection_timeout,omitempty"`
 }
 
-// Validate validates this add agent params body azure database exporter
-func (o *AddAgentParamsBodyAzureDatabaseExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body postgres exporter
+func (o *AddAgentParamsBodyPostgresExporter) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -7007,7 +9543,7 @@ func (o *AddAgentParamsBodyAzureDatabaseExporter) Validate(formats strfmt.Regist
 	return nil
 }
 
-var addAgentParamsBodyAzureDatabaseExporterTypeLogLevelPropEnum []any
+var addAgentParamsBodyPostgresExporterTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -7015,59 +9551,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentParamsBodyAzureDatabaseExporterTypeLogLevelPropEnum = append(addAgentParamsBodyAzureDatabaseExporterTypeLogLevelPropEnum, v)
+		addAgentParamsBodyPostgresExporterTypeLogLevelPropEnum = append(addAgentParamsBodyPostgresExporterTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentParamsBodyAzureDatabaseExporter) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentParamsBodyAzureDatabaseExporterTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentParamsBodyPostgresExporter) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyPostgresExporterTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentParamsBodyAzureDatabaseExporter) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentParamsBodyPostgresExporter) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"azure_database_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"postgres_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this add agent params body azure database exporter based on context it is used
-func (o *AddAgentParamsBodyAzureDatabaseExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent params body postgres exporter based on context it is used
+func (o *AddAgentParamsBodyPostgresExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentParamsBodyAzureDatabaseExporter) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyPostgresExporter) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7075,8 +9611,8 @@ func (o *AddAgentParamsBodyAzureDatabaseExporter) MarshalBinary() ([]byte, error
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyAzureDatabaseExporter) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyAzureDatabaseExporter
+func (o *AddAgentParamsBodyPostgresExporter) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyPostgresExporter
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7085,53 +9621,131 @@ func (o *AddAgentParamsBodyAzureDatabaseExporter) UnmarshalBinary(b []byte) erro
 }
 
 /*
-AddAgentParamsBodyExternalExporter add agent params body external exporter
-swagger:model AddAgentParamsBodyExternalExporter
+AddAgentParamsBodyProxysqlExporter add agent params body proxysql exporter
+swagger:model AddAgentParamsBodyProxysqlExporter
 */
-type AddAgentParamsBodyExternalExporter struct {
-	// The node identifier where this instance is run.
-	RunsOnNodeID string `json:"runs_on_node_id,omitempty"`
+type AddAgentParamsBodyProxysqlExporter struct {
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
 
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// HTTP basic auth username for collecting metrics.
+	// ProxySQL username for scraping metrics.
 	Username string `json:"username,omitempty"`
 
-	// HTTP basic auth password for collecting metrics.
+	// ProxySQL password for scraping metrics.
 	Password string `json:"password,omitempty"`
 
-	// Scheme to generate URI to exporter metrics endpoints(default: http).
-	Scheme string `json:"scheme,omitempty"`
-
-	// Path under which metrics are exposed, used to generate URI(default: /metrics).
-	MetricsPath string `json:"metrics_path,omitempty"`
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
 
-	// Listen port for scraping metrics.
-	ListenPort int64 `json:"listen_port,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
 
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
 
+	// Skip connection check.
+	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
+
 	// Enables push metrics mode for exporter.
 	PushMetrics bool `json:"push_metrics,omitempty"`
 
-	// Skip TLS certificate and hostname verification.
-	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `json:"disable_collectors"`
+
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword string `json:"agent_password,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// Optionally expose the exporter process on all public interfaces
+	ExposeExporter bool `json:"expose_exporter,omitempty"`
+
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout string `json:"connection_timeout,omitempty"`
 }
 
-// Validate validates this add agent params body external exporter
-func (o *AddAgentParamsBodyExternalExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body proxysql exporter
+func (o *AddAgentParamsBodyProxysqlExporter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
 	return nil
 }
 
-// ContextValidate validates this add agent params body external exporter based on context it is used
-func (o *AddAgentParamsBodyExternalExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+var addAgentParamsBodyProxysqlExporterTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addAgentParamsBodyProxysqlExporterTypeLogLevelPropEnum = append(addAgentParamsBodyProxysqlExporterTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *AddAgentParamsBodyProxysqlExporter) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyProxysqlExporterTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddAgentParamsBodyProxysqlExporter) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("body"+"."+"proxysql_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this add agent params body proxysql exporter based on context it is used
+func (o *AddAgentParamsBodyProxysqlExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentParamsBodyExternalExporter) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyProxysqlExporter) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7139,8 +9753,8 @@ func (o *AddAgentParamsBodyExternalExporter) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyExternalExporter) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyExternalExporter
+func (o *AddAgentParamsBodyProxysqlExporter) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyProxysqlExporter
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7149,20 +9763,20 @@ func (o *AddAgentParamsBodyExternalExporter) UnmarshalBinary(b []byte) error {
 }
 
 /*
-AddAgentParamsBodyMongodbExporter add agent params body mongodb exporter
-swagger:model AddAgentParamsBodyMongodbExporter
+AddAgentParamsBodyQANMongodbMongologAgent add agent params body QAN mongodb mongolog agent
+swagger:model AddAgentParamsBodyQANMongodbMongologAgent
 */
-type AddAgentParamsBodyMongodbExporter struct {
+type AddAgentParamsBodyQANMongodbMongologAgent struct {
 	// The pmm-agent identifier which runs this instance.
 	PMMAgentID string `json:"pmm_agent_id,omitempty"`
 
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// MongoDB username for scraping metrics.
+	// MongoDB username for getting profile data.
 	Username string `json:"username,omitempty"`
 
-	// MongoDB password for scraping metrics.
+	// MongoDB password for getting profile data.
 	Password string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -7180,61 +9794,32 @@ type AddAgentParamsBodyMongodbExporter struct {
 	// Certificate Authority certificate chain.
 	TLSCa string `json:"tls_ca,omitempty"`
 
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
 
 	// Skip connection check.
 	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
 
-	// Enables push metrics mode for exporter.
-	PushMetrics bool `json:"push_metrics,omitempty"`
-
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `json:"disable_collectors"`
-
 	// Authentication mechanism.
-	// See https://docs.mongodb.com/manual/reference/connection-string/#mongodb-urioption-urioption.authMechanism
-	// for details.
-	AuthenticationMechanism string `json:"authentication_mechanism,omitempty"`
-
-	// Authentication database.
-	AuthenticationDatabase string `json:"authentication_database,omitempty"`
-
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword string `json:"agent_password,omitempty"`
-
-	// List of colletions to get stats from. Can use *
-	StatsCollections []string `json:"stats_collections"`
+	// See https://docs.mongodb.com/manual/reference/connection-string/#mongodb-urioption-urioption.authMechanism
+	// for details.
+	AuthenticationMechanism string `json:"authentication_mechanism,omitempty"`
 
-	// Collections limit. Only get Databases and collection stats if the total number of collections in the server
-	// is less than this value. 0: no limit
-	CollectionsLimit int32 `json:"collections_limit,omitempty"`
+	// Authentication database.
+	AuthenticationDatabase string `json:"authentication_database,omitempty"`
 
 	// Log level for exporters
 	//
 	// - LOG_LEVEL_UNSPECIFIED: Auto
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
-
-	// Optionally expose the exporter process on all public interfaces
-	ExposeExporter bool `json:"expose_exporter,omitempty"`
-
-	// Environment variable names to pass to the exporter.
-	// Values will be resolved from pmm-agent's environment when starting the exporter.
-	EnvironmentVariableNames []string `json:"environment_variable_names"`
-
-	// Enable all collectors.
-	EnableAllCollectors bool `json:"enable_all_collectors,omitempty"`
-
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout string `json:"connection_timeout,omitempty"`
-
-	// Enable collecting histogram bucket metrics from getDiagnosticData.
-	EnableDiagnosticDataHistograms bool `json:"enable_diagnostic_data_histograms,omitempty"`
 }
 
-// Validate validates this add agent params body mongodb exporter
-func (o *AddAgentParamsBodyMongodbExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body QAN mongodb mongolog agent
+func (o *AddAgentParamsBodyQANMongodbMongologAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -7247,7 +9832,7 @@ func (o *AddAgentParamsBodyMongodbExporter) Validate(formats strfmt.Registry) er
 	return nil
 }
 
-var addAgentParamsBodyMongodbExporterTypeLogLevelPropEnum []any
+var addAgentParamsBodyQanMongodbMongologAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -7255,59 +9840,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentParamsBodyMongodbExporterTypeLogLevelPropEnum = append(addAgentParamsBodyMongodbExporterTypeLogLevelPropEnum, v)
+		addAgentParamsBodyQanMongodbMongologAgentTypeLogLevelPropEnum = append(addAgentParamsBodyQanMongodbMongologAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentParamsBodyMongodbExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentParamsBodyMongodbExporter) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentParamsBodyMongodbExporterTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentParamsBodyQANMongodbMongologAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyQanMongodbMongologAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentParamsBodyMongodbExporter) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentParamsBodyQANMongodbMongologAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"mongodb_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_mongodb_mongolog_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this add agent params body mongodb exporter based on context it is used
-func (o *AddAgentParamsBodyMongodbExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent params body QAN mongodb mongolog agent based on context it is used
+func (o *AddAgentParamsBodyQANMongodbMongologAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentParamsBodyMongodbExporter) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyQANMongodbMongologAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7315,8 +9900,8 @@ func (o *AddAgentParamsBodyMongodbExporter) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyMongodbExporter) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyMongodbExporter
+func (o *AddAgentParamsBodyQANMongodbMongologAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyQANMongodbMongologAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7325,20 +9910,20 @@ func (o *AddAgentParamsBodyMongodbExporter) UnmarshalBinary(b []byte) error {
 }
 
 /*
-AddAgentParamsBodyMysqldExporter add agent params body mysqld exporter
-swagger:model AddAgentParamsBodyMysqldExporter
+AddAgentParamsBodyQANMongodbProfilerAgent add agent params body QAN mongodb profiler agent
+swagger:model AddAgentParamsBodyQANMongodbProfilerAgent
 */
-type AddAgentParamsBodyMysqldExporter struct {
+type AddAgentParamsBodyQANMongodbProfilerAgent struct {
 	// The pmm-agent identifier which runs this instance.
 	PMMAgentID string `json:"pmm_agent_id,omitempty"`
 
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// MySQL username for scraping metrics.
+	// MongoDB username for getting profile data.
 	Username string `json:"username,omitempty"`
 
-	// MySQL password for scraping metrics.
+	// MongoDB password for getting profile data.
 	Password string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -7347,19 +9932,17 @@ type AddAgentParamsBodyMysqldExporter struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
 
-	// Certificate Authority certificate chain.
-	TLSCa string `json:"tls_ca,omitempty"`
+	// Client certificate and key.
+	TLSCertificateKey string `json:"tls_certificate_key,omitempty"`
 
-	// Client certificate.
-	TLSCert string `json:"tls_cert,omitempty"`
+	// Password for decrypting tls_certificate_key.
+	TLSCertificateKeyFilePassword string `json:"tls_certificate_key_file_password,omitempty"`
 
-	// Password for decrypting tls_cert.
-	TLSKey string `json:"tls_key,omitempty"`
+	// Certificate Authority certificate chain.
+	TLSCa string `json:"tls_ca,omitempty"`
 
-	// Tablestats group collectors will be disabled if there are more than that number of tables.
-	// 0 means tablestats group collectors are always enabled (no limit).
-	// Negative value means tablestats group collectors are always disabled.
-	TablestatsGroupTableLimit int32 `json:"tablestats_group_table_limit,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
 
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
@@ -7367,33 +9950,23 @@ type AddAgentParamsBodyMysqldExporter struct {
 	// Skip connection check.
 	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
 
-	// Enables push metrics mode for exporter.
-	PushMetrics bool `json:"push_metrics,omitempty"`
-
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `json:"disable_collectors"`
+	// Authentication mechanism.
+	// See https://docs.mongodb.com/manual/reference/connection-string/#mongodb-urioption-urioption.authMechanism
+	// for details.
+	AuthenticationMechanism string `json:"authentication_mechanism,omitempty"`
 
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword string `json:"agent_password,omitempty"`
+	// Authentication database.
+	AuthenticationDatabase string `json:"authentication_database,omitempty"`
 
 	// Log level for exporters
 	//
 	// - LOG_LEVEL_UNSPECIFIED: Auto
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
-
-	// Optionally expose the exporter process on all public interfaces
-	ExposeExporter bool `json:"expose_exporter,omitempty"`
-
-	// Extra DSN parameters for MySQL connection.
-	ExtraDsnParams map[string]string `json:"extra_dsn_params,omitempty"`
-
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout string `json:"connection_timeout,omitempty"`
 }
 
-// Validate validates this add agent params body mysqld exporter
-func (o *AddAgentParamsBodyMysqldExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body QAN mongodb profiler agent
+func (o *AddAgentParamsBodyQANMongodbProfilerAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -7406,7 +9979,7 @@ func (o *AddAgentParamsBodyMysqldExporter) Validate(formats strfmt.Registry) err
 	return nil
 }
 
-var addAgentParamsBodyMysqldExporterTypeLogLevelPropEnum []any
+var addAgentParamsBodyQanMongodbProfilerAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -7414,59 +9987,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentParamsBodyMysqldExporterTypeLogLevelPropEnum = append(addAgentParamsBodyMysqldExporterTypeLogLevelPropEnum, v)
+		addAgentParamsBodyQanMongodbProfilerAgentTypeLogLevelPropEnum = append(addAgentParamsBodyQanMongodbProfilerAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentParamsBodyMysqldExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentParamsBodyMysqldExporter) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentParamsBodyMysqldExporterTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentParamsBodyQANMongodbProfilerAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyQanMongodbProfilerAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentParamsBodyMysqldExporter) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentParamsBodyQANMongodbProfilerAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"mysqld_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_mongodb_profiler_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this add agent params body mysqld exporter based on context it is used
-func (o *AddAgentParamsBodyMysqldExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent params body QAN mongodb profiler agent based on context it is used
+func (o *AddAgentParamsBodyQANMongodbProfilerAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentParamsBodyMysqldExporter) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyQANMongodbProfilerAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7474,8 +10047,8 @@ func (o *AddAgentParamsBodyMysqldExporter) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyMysqldExporter) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyMysqldExporter
+func (o *AddAgentParamsBodyQANMongodbProfilerAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyQANMongodbProfilerAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7484,21 +10057,51 @@ func (o *AddAgentParamsBodyMysqldExporter) UnmarshalBinary(b []byte) error {
 }
 
 /*
-AddAgentParamsBodyNodeExporter add agent params body node exporter
-swagger:model AddAgentParamsBodyNodeExporter
+AddAgentParamsBodyQANMysqlPerfschemaAgent add agent params body QAN mysql perfschema agent
+swagger:model AddAgentParamsBodyQANMysqlPerfschemaAgent
 */
-type AddAgentParamsBodyNodeExporter struct {
+type AddAgentParamsBodyQANMysqlPerfschemaAgent struct {
 	// The pmm-agent identifier which runs this instance.
 	PMMAgentID string `json:"pmm_agent_id,omitempty"`
 
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// MySQL username for getting performance data.
+	Username string `json:"username,omitempty"`
+
+	// MySQL password for getting performance data.
+	Password string `json:"password,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Certificate Authority certificate chain.
+	TLSCa string `json:"tls_ca,omitempty"`
+
+	// Client certificate.
+	TLSCert string `json:"tls_cert,omitempty"`
+
+	// Password for decrypting tls_cert.
+	TLSKey string `json:"tls_key,omitempty"`
+
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
+	// Disable query examples.
+	DisableQueryExamples bool `json:"disable_query_examples,omitempty"`
+
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
 
-	// Enables push metrics mode for exporter.
-	PushMetrics bool `json:"push_metrics,omitempty"`
+	// Skip connection check.
+	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
 
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `json:"disable_collectors"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
 
 	// Log level for exporters
 	//
@@ -7506,12 +10109,12 @@ type AddAgentParamsBodyNodeExporter struct {
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
 
-	// Expose the node_exporter process on all public interfaces
-	ExposeExporter bool `json:"expose_exporter,omitempty"`
+	// Extra DSN parameters for MySQL connection.
+	ExtraDsnParams map[string]string `json:"extra_dsn_params,omitempty"`
 }
 
-// Validate validates this add agent params body node exporter
-func (o *AddAgentParamsBodyNodeExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body QAN mysql perfschema agent
+func (o *AddAgentParamsBodyQANMysqlPerfschemaAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -7524,7 +10127,7 @@ func (o *AddAgentParamsBodyNodeExporter) Validate(formats strfmt.Registry) error
 	return nil
 }
 
-var addAgentParamsBodyNodeExporterTypeLogLevelPropEnum []any
+var addAgentParamsBodyQanMysqlPerfschemaAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -7532,99 +10135,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentParamsBodyNodeExporterTypeLogLevelPropEnum = append(addAgentParamsBodyNodeExporterTypeLogLevelPropEnum, v)
+		addAgentParamsBodyQanMysqlPerfschemaAgentTypeLogLevelPropEnum = append(addAgentParamsBodyQanMysqlPerfschemaAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentParamsBodyNodeExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentParamsBodyNodeExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentParamsBodyNodeExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentParamsBodyNodeExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentParamsBodyNodeExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentParamsBodyNodeExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentParamsBodyNodeExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentParamsBodyNodeExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentParamsBodyNodeExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentParamsBodyNodeExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentParamsBodyNodeExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentParamsBodyNodeExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentParamsBodyNodeExporter) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentParamsBodyNodeExporterTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentParamsBodyQANMysqlPerfschemaAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyQanMysqlPerfschemaAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentParamsBodyNodeExporter) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentParamsBodyQANMysqlPerfschemaAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"node_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
-		return err
-	}
-
-	return nil
-}
-
-// ContextValidate validates this add agent params body node exporter based on context it is used
-func (o *AddAgentParamsBodyNodeExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (o *AddAgentParamsBodyNodeExporter) MarshalBinary() ([]byte, error) {
-	if o == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(o)
-}
-
-// UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyNodeExporter) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyNodeExporter
-	if err := swag.ReadJSON(b, &res); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_mysql_perfschema_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
-	*o = res
-	return nil
-}
 
-/*
-AddAgentParamsBodyPMMAgent add agent params body PMM agent
-swagger:model AddAgentParamsBodyPMMAgent
-*/
-type AddAgentParamsBodyPMMAgent struct {
-	// Node identifier where this instance runs.
-	RunsOnNodeID string `json:"runs_on_node_id,omitempty"`
-
-	// Custom user-assigned labels.
-	CustomLabels map[string]string `json:"custom_labels,omitempty"`
-}
-
-// Validate validates this add agent params body PMM agent
-func (o *AddAgentParamsBodyPMMAgent) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this add agent params body PMM agent based on context it is used
-func (o *AddAgentParamsBodyPMMAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent params body QAN mysql perfschema agent based on context it is used
+func (o *AddAgentParamsBodyQANMysqlPerfschemaAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentParamsBodyPMMAgent) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyQANMysqlPerfschemaAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7632,8 +10195,8 @@ func (o *AddAgentParamsBodyPMMAgent) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyPMMAgent) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyPMMAgent
+func (o *AddAgentParamsBodyQANMysqlPerfschemaAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyQANMysqlPerfschemaAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7642,51 +10205,55 @@ func (o *AddAgentParamsBodyPMMAgent) UnmarshalBinary(b []byte) error {
 }
 
 /*
-AddAgentParamsBodyPostgresExporter add agent params body postgres exporter
-swagger:model AddAgentParamsBodyPostgresExporter
+AddAgentParamsBodyQANMysqlSlowlogAgent add agent params body QAN mysql slowlog agent
+swagger:model AddAgentParamsBodyQANMysqlSlowlogAgent
 */
-type AddAgentParamsBodyPostgresExporter struct {
+type AddAgentParamsBodyQANMysqlSlowlogAgent struct {
 	// The pmm-agent identifier which runs this instance.
 	PMMAgentID string `json:"pmm_agent_id,omitempty"`
 
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// PostgreSQL username for scraping metrics.
+	// MySQL username for getting slowlog data.
 	Username string `json:"username,omitempty"`
 
-	// PostgreSQL password for scraping metrics.
+	// MySQL password for getting slowlog data.
 	Password string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
 	TLS bool `json:"tls,omitempty"`
 
-	// Skip TLS certificate and hostname validation. Uses sslmode=required instead of verify-full.
+	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
 
-	// Custom user-assigned labels.
-	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+	// Certificate Authority certificate chain.
+	TLSCa string `json:"tls_ca,omitempty"`
 
-	// Skip connection check.
-	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
+	// Client certificate.
+	TLSCert string `json:"tls_cert,omitempty"`
 
-	// Enables push metrics mode for exporter.
-	PushMetrics bool `json:"push_metrics,omitempty"`
+	// Password for decrypting tls_cert.
+	TLSKey string `json:"tls_key,omitempty"`
 
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `json:"disable_collectors"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
 
-	// TLS CA certificate.
-	TLSCa string `json:"tls_ca,omitempty"`
+	// Disable query examples.
+	DisableQueryExamples bool `json:"disable_query_examples,omitempty"`
 
-	// TLS Certifcate.
-	TLSCert string `json:"tls_cert,omitempty"`
+	// Rotate slowlog file at this size if > 0.
+	// Use zero or negative value to disable rotation.
+	MaxSlowlogFileSize string `json:"max_slowlog_file_size,omitempty"`
 
-	// TLS Certificate Key.
-	TLSKey string `json:"tls_key,omitempty"`
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
 
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword string `json:"agent_password,omitempty"`
+	// Skip connection check.
+	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
+
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
 
 	// Log level for exporters
 	//
@@ -7694,21 +10261,12 @@ type AddAgentParamsBodyPostgresExporter struct {
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
 
-	// Limit of databases for auto-discovery.
-	AutoDiscoveryLimit int32 `json:"auto_discovery_limit,omitempty"`
-
-	// Optionally expose the exporter process on all public interfaces
-	ExposeExporter bool `json:"expose_exporter,omitempty"`
-
-	// Maximum number of connections that exporter can open to the database instance.
-	MaxExporterConnections int32 `json:"max_exporter_connections,omitempty"`
-
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout string `json:"connection_timeout,omitempty"`
+	// Extra DSN parameters for MySQL connection.
+	ExtraDsnParams map[string]string `json:"extra_dsn_params,omitempty"`
 }
 
-// Validate validates this add agent params body postgres exporter
-func (o *AddAgentParamsBodyPostgresExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body QAN mysql slowlog agent
+func (o *AddAgentParamsBodyQANMysqlSlowlogAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -7721,7 +10279,7 @@ func (o *AddAgentParamsBodyPostgresExporter) Validate(formats strfmt.Registry) e
 	return nil
 }
 
-var addAgentParamsBodyPostgresExporterTypeLogLevelPropEnum []any
+var addAgentParamsBodyQanMysqlSlowlogAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -7729,59 +10287,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentParamsBodyPostgresExporterTypeLogLevelPropEnum = append(addAgentParamsBodyPostgresExporterTypeLogLevelPropEnum, v)
+		addAgentParamsBodyQanMysqlSlowlogAgentTypeLogLevelPropEnum = append(addAgentParamsBodyQanMysqlSlowlogAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentParamsBodyPostgresExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentParamsBodyPostgresExporter) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentParamsBodyPostgresExporterTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentParamsBodyQANMysqlSlowlogAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyQanMysqlSlowlogAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentParamsBodyPostgresExporter) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentParamsBodyQANMysqlSlowlogAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"postgres_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_mysql_slowlog_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this add agent params body postgres exporter based on context it is used
-func (o *AddAgentParamsBodyPostgresExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent params body QAN mysql slowlog agent based on context it is used
+func (o *AddAgentParamsBodyQANMysqlSlowlogAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentParamsBodyPostgresExporter) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyQANMysqlSlowlogAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7789,8 +10347,8 @@ func (o *AddAgentParamsBodyPostgresExporter) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyPostgresExporter) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyPostgresExporter
+func (o *AddAgentParamsBodyQANMysqlSlowlogAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyQANMysqlSlowlogAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7799,20 +10357,20 @@ func (o *AddAgentParamsBodyPostgresExporter) UnmarshalBinary(b []byte) error {
 }
 
 /*
-AddAgentParamsBodyProxysqlExporter add agent params body proxysql exporter
-swagger:model AddAgentParamsBodyProxysqlExporter
+AddAgentParamsBodyQANPostgresqlLogAgent add agent params body QAN postgresql log agent
+swagger:model AddAgentParamsBodyQANPostgresqlLogAgent
 */
-type AddAgentParamsBodyProxysqlExporter struct {
+type AddAgentParamsBodyQANPostgresqlLogAgent struct {
 	// The pmm-agent identifier which runs this instance.
 	PMMAgentID string `json:"pmm_agent_id,omitempty"`
 
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// ProxySQL username for scraping metrics.
+	// PostgreSQL username for getting server log settings.
 	Username string `json:"username,omitempty"`
 
-	// ProxySQL password for scraping metrics.
+	// PostgreSQL password for getting server log settings.
 	Password string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -7821,36 +10379,39 @@ type AddAgentParamsBodyProxysqlExporter struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
 
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
+	// Disable query examples.
+	DisableQueryExamples bool `json:"disable_query_examples,omitempty"`
+
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
 
 	// Skip connection check.
 	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
 
-	// Enables push metrics mode for exporter.
-	PushMetrics bool `json:"push_metrics,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
 
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `json:"disable_collectors"`
+	// TLS CA certificate.
+	TLSCa string `json:"tls_ca,omitempty"`
 
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword string `json:"agent_password,omitempty"`
+	// TLS Certifcate.
+	TLSCert string `json:"tls_cert,omitempty"`
+
+	// TLS Certificate Key.
+	TLSKey string `json:"tls_key,omitempty"`
 
 	// Log level for exporters
 	//
 	// - LOG_LEVEL_UNSPECIFIED: Auto
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
-
-	// Optionally expose the exporter process on all public interfaces
-	ExposeExporter bool `json:"expose_exporter,omitempty"`
-
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout string `json:"connection_timeout,omitempty"`
 }
 
-// Validate validates this add agent params body proxysql exporter
-func (o *AddAgentParamsBodyProxysqlExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body QAN postgresql log agent
+func (o *AddAgentParamsBodyQANPostgresqlLogAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -7863,7 +10424,7 @@ func (o *AddAgentParamsBodyProxysqlExporter) Validate(formats strfmt.Registry) e
 	return nil
 }
 
-var addAgentParamsBodyProxysqlExporterTypeLogLevelPropEnum []any
+var addAgentParamsBodyQanPostgresqlLogAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -7871,59 +10432,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentParamsBodyProxysqlExporterTypeLogLevelPropEnum = append(addAgentParamsBodyProxysqlExporterTypeLogLevelPropEnum, v)
+		addAgentParamsBodyQanPostgresqlLogAgentTypeLogLevelPropEnum = append(addAgentParamsBodyQanPostgresqlLogAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentParamsBodyProxysqlExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentParamsBodyProxysqlExporter) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentParamsBodyProxysqlExporterTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentParamsBodyQANPostgresqlLogAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyQanPostgresqlLogAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentParamsBodyProxysqlExporter) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentParamsBodyQANPostgresqlLogAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"proxysql_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_postgresql_log_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this add agent params body proxysql exporter based on context it is used
-func (o *AddAgentParamsBodyProxysqlExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent params body QAN postgresql log agent based on context it is used
+func (o *AddAgentParamsBodyQANPostgresqlLogAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentParamsBodyProxysqlExporter) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyQANPostgresqlLogAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7931,8 +10492,8 @@ func (o *AddAgentParamsBodyProxysqlExporter) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyProxysqlExporter) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyProxysqlExporter
+func (o *AddAgentParamsBodyQANPostgresqlLogAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyQANPostgresqlLogAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7941,20 +10502,20 @@ func (o *AddAgentParamsBodyProxysqlExporter) UnmarshalBinary(b []byte) error {
 }
 
 /*
-AddAgentParamsBodyQANMongodbMongologAgent add agent params body QAN mongodb mongolog agent
-swagger:model AddAgentParamsBodyQANMongodbMongologAgent
+AddAgentParamsBodyQANPostgresqlPgstatementsAgent add agent params body QAN postgresql pgstatements agent
+swagger:model AddAgentParamsBodyQANPostgresqlPgstatementsAgent
 */
-type AddAgentParamsBodyQANMongodbMongologAgent struct {
+type AddAgentParamsBodyQANPostgresqlPgstatementsAgent struct {
 	// The pmm-agent identifier which runs this instance.
 	PMMAgentID string `json:"pmm_agent_id,omitempty"`
 
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// MongoDB username for getting profile data.
+	// PostgreSQL username for getting pg stat statements data.
 	Username string `json:"username,omitempty"`
 
-	// MongoDB password for getting profile data.
+	// PostgreSQL password for getting pg stat statements data.
 	Password string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -7963,31 +10524,26 @@ type AddAgentParamsBodyQANMongodbMongologAgent struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
 
-	// Client certificate and key.
-	TLSCertificateKey string `json:"tls_certificate_key,omitempty"`
-
-	// Password for decrypting tls_certificate_key.
-	TLSCertificateKeyFilePassword string `json:"tls_certificate_key_file_password,omitempty"`
-
-	// Certificate Authority certificate chain.
-	TLSCa string `json:"tls_ca,omitempty"`
-
-	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength int32 `json:"max_query_length,omitempty"`
-
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
 
 	// Skip connection check.
 	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
 
-	// Authentication mechanism.
-	// See https://docs.mongodb.com/manual/reference/connection-string/#mongodb-urioption-urioption.authMechanism
-	// for details.
-	AuthenticationMechanism string `json:"authentication_mechanism,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
+
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
+	// TLS CA certificate.
+	TLSCa string `json:"tls_ca,omitempty"`
 
-	// Authentication database.
-	AuthenticationDatabase string `json:"authentication_database,omitempty"`
+	// TLS Certifcate.
+	TLSCert string `json:"tls_cert,omitempty"`
+
+	// TLS Certificate Key.
+	TLSKey string `json:"tls_key,omitempty"`
 
 	// Log level for exporters
 	//
@@ -7996,8 +10552,8 @@ type AddAgentParamsBodyQANMongodbMongologAgent struct {
 	LogLevel *string `json:"log_level,omitempty"`
 }
 
-// Validate validates this add agent params body QAN mongodb mongolog agent
-func (o *AddAgentParamsBodyQANMongodbMongologAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body QAN postgresql pgstatements agent
+func (o *AddAgentParamsBodyQANPostgresqlPgstatementsAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -8010,7 +10566,7 @@ func (o *AddAgentParamsBodyQANMongodbMongologAgent) Validate(formats strfmt.Regi
 	return nil
 }
 
-var addAgentParamsBodyQanMongodbMongologAgentTypeLogLevelPropEnum []any
+var addAgentParamsBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -8018,59 +10574,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentParamsBodyQanMongodbMongologAgentTypeLogLevelPropEnum = append(addAgentParamsBodyQanMongodbMongologAgentTypeLogLevelPropEnum, v)
+		addAgentParamsBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum = append(addAgentParamsBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentParamsBodyQANMongodbMongologAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentParamsBodyQanMongodbMongologAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentParamsBodyQANPostgresqlPgstatementsAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentParamsBodyQANMongodbMongologAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentParamsBodyQANPostgresqlPgstatementsAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"qan_mongodb_mongolog_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_postgresql_pgstatements_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this add agent params body QAN mongodb mongolog agent based on context it is used
-func (o *AddAgentParamsBodyQANMongodbMongologAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent params body QAN postgresql pgstatements agent based on context it is used
+func (o *AddAgentParamsBodyQANPostgresqlPgstatementsAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentParamsBodyQANMongodbMongologAgent) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyQANPostgresqlPgstatementsAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -8078,8 +10634,8 @@ func (o *AddAgentParamsBodyQANMongodbMongologAgent) MarshalBinary() ([]byte, err
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyQANMongodbMongologAgent) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyQANMongodbMongologAgent
+func (o *AddAgentParamsBodyQANPostgresqlPgstatementsAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyQANPostgresqlPgstatementsAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8088,20 +10644,20 @@ func (o *AddAgentParamsBodyQANMongodbMongologAgent) UnmarshalBinary(b []byte) er
 }
 
 /*
-AddAgentParamsBodyQANMongodbProfilerAgent add agent params body QAN mongodb profiler agent
-swagger:model AddAgentParamsBodyQANMongodbProfilerAgent
+AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent add agent params body QAN postgresql pgstatmonitor agent
+swagger:model AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent
 */
-type AddAgentParamsBodyQANMongodbProfilerAgent struct {
+type AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent struct {
 	// The pmm-agent identifier which runs this instance.
 	PMMAgentID string `json:"pmm_agent_id,omitempty"`
 
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// MongoDB username for getting profile data.
+	// PostgreSQL username for getting pg stat monitor data.
 	Username string `json:"username,omitempty"`
 
-	// MongoDB password for getting profile data.
+	// PostgreSQL password for getting pg stat monitor data.
 	Password string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -8110,31 +10666,29 @@ type AddAgentParamsBodyQANMongodbProfilerAgent struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
 
-	// Client certificate and key.
-	TLSCertificateKey string `json:"tls_certificate_key,omitempty"`
-
-	// Password for decrypting tls_certificate_key.
-	TLSCertificateKeyFilePassword string `json:"tls_certificate_key_file_password,omitempty"`
-
-	// Certificate Authority certificate chain.
-	TLSCa string `json:"tls_ca,omitempty"`
-
 	// Limit query length in QAN (default: server-defined; -1: no limit).
 	MaxQueryLength int32 `json:"max_query_length,omitempty"`
 
+	// Disable query examples.
+	DisableQueryExamples bool `json:"disable_query_examples,omitempty"`
+
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
 
 	// Skip connection check.
 	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
 
-	// Authentication mechanism.
-	// See https://docs.mongodb.com/manual/reference/connection-string/#mongodb-urioption-urioption.authMechanism
-	// for details.
-	AuthenticationMechanism string `json:"authentication_mechanism,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
 
-	// Authentication database.
-	AuthenticationDatabase string `json:"authentication_database,omitempty"`
+	// TLS CA certificate.
+	TLSCa string `json:"tls_ca,omitempty"`
+
+	// TLS Certifcate.
+	TLSCert string `json:"tls_cert,omitempty"`
+
+	// TLS Certificate Key.
+	TLSKey string `json:"tls_key,omitempty"`
 
 	// Log level for exporters
 	//
@@ -8143,8 +10697,8 @@ type AddAgentParamsBodyQANMongodbProfilerAgent struct {
 	LogLevel *string `json:"log_level,omitempty"`
 }
 
-// Validate validates this add agent params body QAN mongodb profiler agent
-func (o *AddAgentParamsBodyQANMongodbProfilerAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body QAN postgresql pgstatmonitor agent
+func (o *AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -8157,7 +10711,7 @@ func (o *AddAgentParamsBodyQANMongodbProfilerAgent) Validate(formats strfmt.Regi
 	return nil
 }
 
-var addAgentParamsBodyQanMongodbProfilerAgentTypeLogLevelPropEnum []any
+var addAgentParamsBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -8165,59 +10719,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentParamsBodyQanMongodbProfilerAgentTypeLogLevelPropEnum = append(addAgentParamsBodyQanMongodbProfilerAgentTypeLogLevelPropEnum, v)
+		addAgentParamsBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum = append(addAgentParamsBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentParamsBodyQANMongodbProfilerAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentParamsBodyQanMongodbProfilerAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentParamsBodyQANMongodbProfilerAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"qan_mongodb_profiler_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_postgresql_pgstatmonitor_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this add agent params body QAN mongodb profiler agent based on context it is used
-func (o *AddAgentParamsBodyQANMongodbProfilerAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent params body QAN postgresql pgstatmonitor agent based on context it is used
+func (o *AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentParamsBodyQANMongodbProfilerAgent) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -8225,8 +10779,8 @@ func (o *AddAgentParamsBodyQANMongodbProfilerAgent) MarshalBinary() ([]byte, err
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyQANMongodbProfilerAgent) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyQANMongodbProfilerAgent
+func (o *AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8235,20 +10789,20 @@ func (o *AddAgentParamsBodyQANMongodbProfilerAgent) UnmarshalBinary(b []byte) er
 }
 
 /*
-AddAgentParamsBodyQANMysqlPerfschemaAgent add agent params body QAN mysql perfschema agent
-swagger:model AddAgentParamsBodyQANMysqlPerfschemaAgent
+AddAgentParamsBodyQANProxysqlDigestAgent add agent params body QAN proxysql digest agent
+swagger:model AddAgentParamsBodyQANProxysqlDigestAgent
 */
-type AddAgentParamsBodyQANMysqlPerfschemaAgent struct {
+type AddAgentParamsBodyQANProxysqlDigestAgent struct {
 	// The pmm-agent identifier which runs this instance.
 	PMMAgentID string `json:"pmm_agent_id,omitempty"`
 
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// MySQL username for getting performance data.
+	// ProxySQL username for getting query digest data.
 	Username string `json:"username,omitempty"`
 
-	// MySQL password for getting performance data.
+	// ProxySQL password for getting query digest data.
 	Password string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -8257,42 +10811,24 @@ type AddAgentParamsBodyQANMysqlPerfschemaAgent struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
 
-	// Certificate Authority certificate chain.
-	TLSCa string `json:"tls_ca,omitempty"`
-
-	// Client certificate.
-	TLSCert string `json:"tls_cert,omitempty"`
-
-	// Password for decrypting tls_cert.
-	TLSKey string `json:"tls_key,omitempty"`
-
 	// Limit query length in QAN (default: server-defined; -1: no limit).
 	MaxQueryLength int32 `json:"max_query_length,omitempty"`
 
-	// Disable query examples.
-	DisableQueryExamples bool `json:"disable_query_examples,omitempty"`
-
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
 
 	// Skip connection check.
 	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
 
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
-
 	// Log level for exporters
 	//
 	// - LOG_LEVEL_UNSPECIFIED: Auto
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
-
-	// Extra DSN parameters for MySQL connection.
-	ExtraDsnParams map[string]string `json:"extra_dsn_params,omitempty"`
 }
 
-// Validate validates this add agent params body QAN mysql perfschema agent
-func (o *AddAgentParamsBodyQANMysqlPerfschemaAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body QAN proxysql digest agent
+func (o *AddAgentParamsBodyQANProxysqlDigestAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -8305,7 +10841,7 @@ func (o *AddAgentParamsBodyQANMysqlPerfschemaAgent) Validate(formats strfmt.Regi
 	return nil
 }
 
-var addAgentParamsBodyQanMysqlPerfschemaAgentTypeLogLevelPropEnum []any
+var addAgentParamsBodyQanProxysqlDigestAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -8313,59 +10849,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentParamsBodyQanMysqlPerfschemaAgentTypeLogLevelPropEnum = append(addAgentParamsBodyQanMysqlPerfschemaAgentTypeLogLevelPropEnum, v)
+		addAgentParamsBodyQanProxysqlDigestAgentTypeLogLevelPropEnum = append(addAgentParamsBodyQanProxysqlDigestAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentParamsBodyQANMysqlPerfschemaAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentParamsBodyQanMysqlPerfschemaAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentParamsBodyQANProxysqlDigestAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyQanProxysqlDigestAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentParamsBodyQANMysqlPerfschemaAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentParamsBodyQANProxysqlDigestAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"qan_mysql_perfschema_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_proxysql_digest_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this add agent params body QAN mysql perfschema agent based on context it is used
-func (o *AddAgentParamsBodyQANMysqlPerfschemaAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent params body QAN proxysql digest agent based on context it is used
+func (o *AddAgentParamsBodyQANProxysqlDigestAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentParamsBodyQANMysqlPerfschemaAgent) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyQANProxysqlDigestAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -8373,8 +10909,8 @@ func (o *AddAgentParamsBodyQANMysqlPerfschemaAgent) MarshalBinary() ([]byte, err
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyQANMysqlPerfschemaAgent) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyQANMysqlPerfschemaAgent
+func (o *AddAgentParamsBodyQANProxysqlDigestAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyQANProxysqlDigestAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8383,20 +10919,20 @@ func (o *AddAgentParamsBodyQANMysqlPerfschemaAgent) UnmarshalBinary(b []byte) er
 }
 
 /*
-AddAgentParamsBodyQANMysqlSlowlogAgent add agent params body QAN mysql slowlog agent
-swagger:model AddAgentParamsBodyQANMysqlSlowlogAgent
+AddAgentParamsBodyQANValkeySlowlogAgent add agent params body QAN valkey slowlog agent
+swagger:model AddAgentParamsBodyQANValkeySlowlogAgent
 */
-type AddAgentParamsBodyQANMysqlSlowlogAgent struct {
+type AddAgentParamsBodyQANValkeySlowlogAgent struct {
 	// The pmm-agent identifier which runs this instance.
 	PMMAgentID string `json:"pmm_agent_id,omitempty"`
 
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// MySQL username for getting slowlog data.
+	// Valkey username for getting slow log data.
 	Username string `json:"username,omitempty"`
 
-	// MySQL password for getting slowlog data.
+	// Valkey password for getting slow log data.
 	Password string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -8405,13 +10941,13 @@ type AddAgentParamsBodyQANMysqlSlowlogAgent struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
 
-	// Certificate Authority certificate chain.
+	// TLS CA certificate.
 	TLSCa string `json:"tls_ca,omitempty"`
 
-	// Client certificate.
+	// TLS Certifcate.
 	TLSCert string `json:"tls_cert,omitempty"`
 
-	// Password for decrypting tls_cert.
+	// TLS Certificate Key.
 	TLSKey string `json:"tls_key,omitempty"`
 
 	// Limit query length in QAN (default: server-defined; -1: no limit).
@@ -8420,31 +10956,21 @@ type AddAgentParamsBodyQANMysqlSlowlogAgent struct {
 	// Disable query examples.
 	DisableQueryExamples bool `json:"disable_query_examples,omitempty"`
 
-	// Rotate slowlog file at this size if > 0.
-	// Use zero or negative value to disable rotation.
-	MaxSlowlogFileSize string `json:"max_slowlog_file_size,omitempty"`
-
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
 
 	// Skip connection check.
 	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
 
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
-
 	// Log level for exporters
 	//
 	// - LOG_LEVEL_UNSPECIFIED: Auto
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
-
-	// Extra DSN parameters for MySQL connection.
-	ExtraDsnParams map[string]string `json:"extra_dsn_params,omitempty"`
 }
 
-// Validate validates this add agent params body QAN mysql slowlog agent
-func (o *AddAgentParamsBodyQANMysqlSlowlogAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body QAN valkey slowlog agent
+func (o *AddAgentParamsBodyQANValkeySlowlogAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -8457,7 +10983,7 @@ func (o *AddAgentParamsBodyQANMysqlSlowlogAgent) Validate(formats strfmt.Registr
 	return nil
 }
 
-var addAgentParamsBodyQanMysqlSlowlogAgentTypeLogLevelPropEnum []any
+var addAgentParamsBodyQanValkeySlowlogAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -8465,59 +10991,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentParamsBodyQanMysqlSlowlogAgentTypeLogLevelPropEnum = append(addAgentParamsBodyQanMysqlSlowlogAgentTypeLogLevelPropEnum, v)
+		addAgentParamsBodyQanValkeySlowlogAgentTypeLogLevelPropEnum = append(addAgentParamsBodyQanValkeySlowlogAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentParamsBodyQANMysqlSlowlogAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentParamsBodyQanMysqlSlowlogAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentParamsBodyQANValkeySlowlogAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyQanValkeySlowlogAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentParamsBodyQANMysqlSlowlogAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentParamsBodyQANValkeySlowlogAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"qan_mysql_slowlog_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_valkey_slowlog_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this add agent params body QAN mysql slowlog agent based on context it is used
-func (o *AddAgentParamsBodyQANMysqlSlowlogAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent params body QAN valkey slowlog agent based on context it is used
+func (o *AddAgentParamsBodyQANValkeySlowlogAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentParamsBodyQANMysqlSlowlogAgent) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyQANValkeySlowlogAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -8525,8 +11051,8 @@ func (o *AddAgentParamsBodyQANMysqlSlowlogAgent) MarshalBinary() ([]byte, error)
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyQANMysqlSlowlogAgent) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyQANMysqlSlowlogAgent
+func (o *AddAgentParamsBodyQANValkeySlowlogAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyQANValkeySlowlogAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8535,27 +11061,21 @@ func (o *AddAgentParamsBodyQANMysqlSlowlogAgent) UnmarshalBinary(b []byte) error
 }
 
 /*
-AddAgentParamsBodyQANPostgresqlPgstatementsAgent add agent params body QAN postgresql pgstatements agent
-swagger:model AddAgentParamsBodyQANPostgresqlPgstatementsAgent
+AddAgentParamsBodyRDSExporter add agent params body RDS exporter
+swagger:model AddAgentParamsBodyRDSExporter
 */
-type AddAgentParamsBodyQANPostgresqlPgstatementsAgent struct {
+type AddAgentParamsBodyRDSExporter struct {
 	// The pmm-agent identifier which runs this instance.
 	PMMAgentID string `json:"pmm_agent_id,omitempty"`
 
-	// Service identifier.
-	ServiceID string `json:"service_id,omitempty"`
-
-	// PostgreSQL username for getting pg stat statements data.
-	Username string `json:"username,omitempty"`
-
-	// PostgreSQL password for getting pg stat statements data.
-	Password string `json:"password,omitempty"`
+	// Node identifier.
+	NodeID string `json:"node_id,omitempty"`
 
-	// Use TLS for database connections.
-	TLS bool `json:"tls,omitempty"`
+	// AWS Access Key.
+	AWSAccessKey string `json:"aws_access_key,omitempty"`
 
-	// Skip TLS certificate and hostname validation.
-	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+	// AWS Secret Key.
+	AWSSecretKey string `json:"aws_secret_key,omitempty"`
 
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
@@ -8563,20 +11083,14 @@ type AddAgentParamsBodyQANPostgresqlPgstatementsAgent struct {
 	// Skip connection check.
 	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
 
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
-
-	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength int32 `json:"max_query_length,omitempty"`
-
-	// TLS CA certificate.
-	TLSCa string `json:"tls_ca,omitempty"`
+	// Disable basic metrics.
+	DisableBasicMetrics bool `json:"disable_basic_metrics,omitempty"`
 
-	// TLS Certifcate.
-	TLSCert string `json:"tls_cert,omitempty"`
+	// Disable enhanced metrics.
+	DisableEnhancedMetrics bool `json:"disable_enhanced_metrics,omitempty"`
 
-	// TLS Certificate Key.
-	TLSKey string `json:"tls_key,omitempty"`
+	// Enables push metrics mode for exporter.
+	PushMetrics bool `json:"push_metrics,omitempty"`
 
 	// Log level for exporters
 	//
@@ -8585,8 +11099,8 @@ type AddAgentParamsBodyQANPostgresqlPgstatementsAgent struct {
 	LogLevel *string `json:"log_level,omitempty"`
 }
 
-// Validate validates this add agent params body QAN postgresql pgstatements agent
-func (o *AddAgentParamsBodyQANPostgresqlPgstatementsAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body RDS exporter
+func (o *AddAgentParamsBodyRDSExporter) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -8599,7 +11113,7 @@ func (o *AddAgentParamsBodyQANPostgresqlPgstatementsAgent) Validate(formats strf
 	return nil
 }
 
-var addAgentParamsBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum []any
+var addAgentParamsBodyRdsExporterTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -8607,59 +11121,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentParamsBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum = append(addAgentParamsBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum, v)
+		addAgentParamsBodyRdsExporterTypeLogLevelPropEnum = append(addAgentParamsBodyRdsExporterTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentParamsBodyRDSExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyRDSExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentParamsBodyRDSExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyRDSExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentParamsBodyRDSExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyRDSExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentParamsBodyRDSExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyRDSExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentParamsBodyRDSExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyRDSExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentParamsBodyRDSExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyRDSExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentParamsBodyQANPostgresqlPgstatementsAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentParamsBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentParamsBodyRDSExporter) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyRdsExporterTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentParamsBodyQANPostgresqlPgstatementsAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentParamsBodyRDSExporter) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"qan_postgresql_pgstatements_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"rds_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this add agent params body QAN postgresql pgstatements agent based on context it is used
-func (o *AddAgentParamsBodyQANPostgresqlPgstatementsAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent params body RDS exporter based on context it is used
+func (o *AddAgentParamsBodyRDSExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentParamsBodyQANPostgresqlPgstatementsAgent) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyRDSExporter) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -8667,8 +11181,8 @@ func (o *AddAgentParamsBodyQANPostgresqlPgstatementsAgent) MarshalBinary() ([]by
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyQANPostgresqlPgstatementsAgent) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyQANPostgresqlPgstatementsAgent
+func (o *AddAgentParamsBodyRDSExporter) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyRDSExporter
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8677,74 +11191,78 @@ func (o *AddAgentParamsBodyQANPostgresqlPgstatementsAgent) UnmarshalBinary(b []b
 }
 
 /*
-AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent add agent params body QAN postgresql pgstatmonitor agent
-swagger:model AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent
+AddAgentParamsBodyRtaMongodbAgent add agent params body rta mongodb agent
+swagger:model AddAgentParamsBodyRtaMongodbAgent
 */
-type AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent struct {
+type AddAgentParamsBodyRtaMongodbAgent struct {
 	// The pmm-agent identifier which runs this instance.
 	PMMAgentID string `json:"pmm_agent_id,omitempty"`
 
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// PostgreSQL username for getting pg stat monitor data.
+	// MongoDB username for getting queries data.
 	Username string `json:"username,omitempty"`
 
-	// PostgreSQL password for getting pg stat monitor data.
+	// MongoDB password for getting queries data.
 	Password string `json:"password,omitempty"`
 
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// MongoDB specific options.
 	// Use TLS for database connections.
 	TLS bool `json:"tls,omitempty"`
 
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
 
-	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+	// Client certificate and key.
+	TLSCertificateKey string `json:"tls_certificate_key,omitempty"`
 
-	// Disable query examples.
-	DisableQueryExamples bool `json:"disable_query_examples,omitempty"`
+	// Password for decrypting tls_certificate_key.
+	TLSCertificateKeyFilePassword string `json:"tls_certificate_key_file_password,omitempty"`
 
-	// Custom user-assigned labels.
-	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+	// Certificate Authority certificate chain.
+	TLSCa string `json:"tls_ca,omitempty"`
 
 	// Skip connection check.
 	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
 
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
-
-	// TLS CA certificate.
-	TLSCa string `json:"tls_ca,omitempty"`
-
-	// TLS Certifcate.
-	TLSCert string `json:"tls_cert,omitempty"`
-
-	// TLS Certificate Key.
-	TLSKey string `json:"tls_key,omitempty"`
+	// Authentication mechanism.
+	// See https://docs.mongodb.com/manual/reference/connection-string/#mongodb-urioption-urioption.authMechanism
+	// for details.
+	AuthenticationMechanism string `json:"authentication_mechanism,omitempty"`
 
-	// Log level for exporters
-	//
-	// - LOG_LEVEL_UNSPECIFIED: Auto
-	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
-	LogLevel *string `json:"log_level,omitempty"`
+	// rta options
+	RtaOptions *AddAgentParamsBodyRtaMongodbAgentRtaOptions `json:"rta_options,omitempty"`
 }
 
-// Validate validates this add agent params body QAN postgresql pgstatmonitor agent
-func (o *AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body rta mongodb agent
+func (o *AddAgentParamsBodyRtaMongodbAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := o.validateRtaOptions(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
-var addAgentParamsBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum []any
+var addAgentParamsBodyRtaMongodbAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -8752,59 +11270,152 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentParamsBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum = append(addAgentParamsBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum, v)
+		addAgentParamsBodyRtaMongodbAgentTypeLogLevelPropEnum = append(addAgentParamsBodyRtaMongodbAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
-// prop value enum
-func (o *AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentParamsBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum, true); err != nil {
+// prop value enum
+func (o *AddAgentParamsBodyRtaMongodbAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyRtaMongodbAgentTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddAgentParamsBodyRtaMongodbAgent) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("body"+"."+"rta_mongodb_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *AddAgentParamsBodyRtaMongodbAgent) validateRtaOptions(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaOptions) { // not required
+		return nil
+	}
+
+	if o.RtaOptions != nil {
+		if err := o.RtaOptions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this add agent params body rta mongodb agent based on the context it is used
+func (o *AddAgentParamsBodyRtaMongodbAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateRtaOptions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *AddAgentParamsBodyRtaMongodbAgent) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaOptions != nil {
+
+		if swag.IsZero(o.RtaOptions) { // not required
+			return nil
+		}
+
+		if err := o.RtaOptions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddAgentParamsBodyRtaMongodbAgent) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddAgentParamsBodyRtaMongodbAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyRtaMongodbAgent
+	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
+	*o = res
 	return nil
 }
 
-func (o *AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent) validateLogLevel(formats strfmt.Registry) error {
-	if swag.IsZero(o.LogLevel) { // not required
-		return nil
-	}
-
-	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"qan_postgresql_pgstatmonitor_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
-		return err
-	}
+/*
+AddAgentParamsBodyRtaMongodbAgentRtaOptions RTAOptions holds Real-Time Query Analytics agent options.
+swagger:model AddAgentParamsBodyRtaMongodbAgentRtaOptions
+*/
+type AddAgentParamsBodyRtaMongodbAgentRtaOptions struct {
+	// Query collect interval (default 2s is set by server).
+	CollectInterval string `json:"collect_interval,omitempty"`
+}
 
+// Validate validates this add agent params body rta mongodb agent rta options
+func (o *AddAgentParamsBodyRtaMongodbAgentRtaOptions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this add agent params body QAN postgresql pgstatmonitor agent based on context it is used
-func (o *AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent params body rta mongodb agent rta options based on context it is used
+func (o *AddAgentParamsBodyRtaMongodbAgentRtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyRtaMongodbAgentRtaOptions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -8812,8 +11423,8 @@ func (o *AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent) MarshalBinary() ([]b
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent
+func (o *AddAgentParamsBodyRtaMongodbAgentRtaOptions) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyRtaMongodbAgentRtaOptions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8822,59 +11433,76 @@ func (o *AddAgentParamsBodyQANPostgresqlPgstatmonitorAgent) UnmarshalBinary(b []
 }
 
 /*
-AddAgentParamsBodyRDSExporter add agent params body RDS exporter
-swagger:model AddAgentParamsBodyRDSExporter
+AddAgentParamsBodyRtaMysqlAgent add agent params body rta mysql agent
+swagger:model AddAgentParamsBodyRtaMysqlAgent
 */
-type AddAgentParamsBodyRDSExporter struct {
+type AddAgentParamsBodyRtaMysqlAgent struct {
 	// The pmm-agent identifier which runs this instance.
 	PMMAgentID string `json:"pmm_agent_id,omitempty"`
 
-	// Node identifier.
-	NodeID string `json:"node_id,omitempty"`
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
 
-	// AWS Access Key.
-	AWSAccessKey string `json:"aws_access_key,omitempty"`
+	// MySQL username for getting currently running queries.
+	Username string `json:"username,omitempty"`
 
-	// AWS Secret Key.
-	AWSSecretKey string `json:"aws_secret_key,omitempty"`
+	// MySQL password for getting currently running queries.
+	Password string `json:"password,omitempty"`
 
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
 
-	// Skip connection check.
-	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
-
-	// Disable basic metrics.
-	DisableBasicMetrics bool `json:"disable_basic_metrics,omitempty"`
-
-	// Disable enhanced metrics.
-	DisableEnhancedMetrics bool `json:"disable_enhanced_metrics,omitempty"`
-
-	// Enables push metrics mode for exporter.
-	PushMetrics bool `json:"push_metrics,omitempty"`
-
 	// Log level for exporters
 	//
 	// - LOG_LEVEL_UNSPECIFIED: Auto
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
+
+	// MySQL specific options.
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Certificate Authority certificate chain.
+	TLSCa string `json:"tls_ca,omitempty"`
+
+	// Client certificate.
+	TLSCert string `json:"tls_cert,omitempty"`
+
+	// Password for decrypting tls_cert.
+	TLSKey string `json:"tls_key,omitempty"`
+
+	// Skip connection check.
+	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
+
+	// Extra DSN parameters for MySQL connection.
+	ExtraDsnParams map[string]string `json:"extra_dsn_params,omitempty"`
+
+	// rta options
+	RtaOptions *AddAgentParamsBodyRtaMysqlAgentRtaOptions `json:"rta_options,omitempty"`
 }
 
-// Validate validates this add agent params body RDS exporter
-func (o *AddAgentParamsBodyRDSExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body rta mysql agent
+func (o *AddAgentParamsBodyRtaMysqlAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := o.validateRtaOptions(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
-var addAgentParamsBodyRdsExporterTypeLogLevelPropEnum []any
+var addAgentParamsBodyRtaMysqlAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -8882,59 +11510,115 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentParamsBodyRdsExporterTypeLogLevelPropEnum = append(addAgentParamsBodyRdsExporterTypeLogLevelPropEnum, v)
+		addAgentParamsBodyRtaMysqlAgentTypeLogLevelPropEnum = append(addAgentParamsBodyRtaMysqlAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentParamsBodyRDSExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentParamsBodyRDSExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentParamsBodyRDSExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentParamsBodyRDSExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentParamsBodyRDSExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentParamsBodyRDSExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentParamsBodyRDSExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentParamsBodyRDSExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentParamsBodyRDSExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentParamsBodyRDSExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentParamsBodyRDSExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentParamsBodyRDSExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentParamsBodyRDSExporter) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentParamsBodyRdsExporterTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentParamsBodyRtaMysqlAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyRtaMysqlAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentParamsBodyRDSExporter) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentParamsBodyRtaMysqlAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"rds_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"rta_mysql_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this add agent params body RDS exporter based on context it is used
-func (o *AddAgentParamsBodyRDSExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+func (o *AddAgentParamsBodyRtaMysqlAgent) validateRtaOptions(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaOptions) { // not required
+		return nil
+	}
+
+	if o.RtaOptions != nil {
+		if err := o.RtaOptions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "rta_mysql_agent" + "." + "rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "rta_mysql_agent" + "." + "rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this add agent params body rta mysql agent based on the context it is used
+func (o *AddAgentParamsBodyRtaMysqlAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateRtaOptions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *AddAgentParamsBodyRtaMysqlAgent) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaOptions != nil {
+
+		if swag.IsZero(o.RtaOptions) { // not required
+			return nil
+		}
+
+		if err := o.RtaOptions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "rta_mysql_agent" + "." + "rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "rta_mysql_agent" + "." + "rta_options")
+			}
+
+			return err
+		}
+	}
+
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentParamsBodyRDSExporter) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyRtaMysqlAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -8942,8 +11626,8 @@ func (o *AddAgentParamsBodyRDSExporter) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyRDSExporter) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyRDSExporter
+func (o *AddAgentParamsBodyRtaMysqlAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyRtaMysqlAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8952,20 +11636,57 @@ func (o *AddAgentParamsBodyRDSExporter) UnmarshalBinary(b []byte) error {
 }
 
 /*
-AddAgentParamsBodyRtaMongodbAgent add agent params body rta mongodb agent
-swagger:model AddAgentParamsBodyRtaMongodbAgent
+AddAgentParamsBodyRtaMysqlAgentRtaOptions RTAOptions holds Real-Time Query Analytics agent options.
+swagger:model AddAgentParamsBodyRtaMysqlAgentRtaOptions
 */
-type AddAgentParamsBodyRtaMongodbAgent struct {
+type AddAgentParamsBodyRtaMysqlAgentRtaOptions struct {
+	// Query collect interval (default 2s is set by server).
+	CollectInterval string `json:"collect_interval,omitempty"`
+}
+
+// Validate validates this add agent params body rta mysql agent rta options
+func (o *AddAgentParamsBodyRtaMysqlAgentRtaOptions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this add agent params body rta mysql agent rta options based on context it is used
+func (o *AddAgentParamsBodyRtaMysqlAgentRtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddAgentParamsBodyRtaMysqlAgentRtaOptions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddAgentParamsBodyRtaMysqlAgentRtaOptions) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyRtaMysqlAgentRtaOptions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+AddAgentParamsBodyRtaPostgresqlAgent add agent params body rta postgresql agent
+swagger:model AddAgentParamsBodyRtaPostgresqlAgent
+*/
+type AddAgentParamsBodyRtaPostgresqlAgent struct {
 	// The pmm-agent identifier which runs this instance.
 	PMMAgentID string `json:"pmm_agent_id,omitempty"`
 
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// MongoDB username for getting queries data.
+	// PostgreSQL username for getting currently running queries.
 	Username string `json:"username,omitempty"`
 
-	// MongoDB password for getting queries data.
+	// PostgreSQL password for getting currently running queries.
 	Password string `json:"password,omitempty"`
 
 	// Custom user-assigned labels.
@@ -8977,36 +11698,31 @@ type AddAgentParamsBodyRtaMongodbAgent struct {
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
 
-	// MongoDB specific options.
+	// PostgreSQL specific options.
 	// Use TLS for database connections.
 	TLS bool `json:"tls,omitempty"`
 
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
 
-	// Client certificate and key.
-	TLSCertificateKey string `json:"tls_certificate_key,omitempty"`
+	// TLS CA certificate.
+	TLSCa string `json:"tls_ca,omitempty"`
 
-	// Password for decrypting tls_certificate_key.
-	TLSCertificateKeyFilePassword string `json:"tls_certificate_key_file_password,omitempty"`
+	// TLS Certificate.
+	TLSCert string `json:"tls_cert,omitempty"`
 
-	// Certificate Authority certificate chain.
-	TLSCa string `json:"tls_ca,omitempty"`
+	// TLS Certificate Key.
+	TLSKey string `json:"tls_key,omitempty"`
 
 	// Skip connection check.
 	SkipConnectionCheck bool `json:"skip_connection_check,omitempty"`
 
-	// Authentication mechanism.
-	// See https://docs.mongodb.com/manual/reference/connection-string/#mongodb-urioption-urioption.authMechanism
-	// for details.
-	AuthenticationMechanism string `json:"authentication_mechanism,omitempty"`
-
 	// rta options
-	RtaOptions *AddAgentParamsBodyRtaMongodbAgentRtaOptions `json:"rta_options,omitempty"`
+	RtaOptions *AddAgentParamsBodyRtaPostgresqlAgentRtaOptions `json:"rta_options,omitempty"`
 }
 
-// Validate validates this add agent params body rta mongodb agent
-func (o *AddAgentParamsBodyRtaMongodbAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body rta postgresql agent
+func (o *AddAgentParamsBodyRtaPostgresqlAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -9023,7 +11739,7 @@ func (o *AddAgentParamsBodyRtaMongodbAgent) Validate(formats strfmt.Registry) er
 	return nil
 }
 
-var addAgentParamsBodyRtaMongodbAgentTypeLogLevelPropEnum []any
+var addAgentParamsBodyRtaPostgresqlAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -9031,53 +11747,53 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		addAgentParamsBodyRtaMongodbAgentTypeLogLevelPropEnum = append(addAgentParamsBodyRtaMongodbAgentTypeLogLevelPropEnum, v)
+		addAgentParamsBodyRtaPostgresqlAgentTypeLogLevelPropEnum = append(addAgentParamsBodyRtaPostgresqlAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// AddAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// AddAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// AddAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// AddAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// AddAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	AddAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// AddAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *AddAgentParamsBodyRtaMongodbAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, addAgentParamsBodyRtaMongodbAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *AddAgentParamsBodyRtaPostgresqlAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addAgentParamsBodyRtaPostgresqlAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *AddAgentParamsBodyRtaMongodbAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *AddAgentParamsBodyRtaPostgresqlAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"rta_mongodb_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"rta_postgresql_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *AddAgentParamsBodyRtaMongodbAgent) validateRtaOptions(formats strfmt.Registry) error {
+func (o *AddAgentParamsBodyRtaPostgresqlAgent) validateRtaOptions(formats strfmt.Registry) error {
 	if swag.IsZero(o.RtaOptions) { // not required
 		return nil
 	}
@@ -9086,11 +11802,11 @@ func (o *AddAgentParamsBodyRtaMongodbAgent) validateRtaOptions(formats strfmt.Re
 		if err := o.RtaOptions.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "rta_options")
+				return ve.ValidateName("body" + "." + "rta_postgresql_agent" + "." + "rta_options")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "rta_options")
+				return ce.ValidateName("body" + "." + "rta_postgresql_agent" + "." + "rta_options")
 			}
 
 			return err
@@ -9100,8 +11816,8 @@ func (o *AddAgentParamsBodyRtaMongodbAgent) validateRtaOptions(formats strfmt.Re
 	return nil
 }
 
-// ContextValidate validate this add agent params body rta mongodb agent based on the context it is used
-func (o *AddAgentParamsBodyRtaMongodbAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this add agent params body rta postgresql agent based on the context it is used
+func (o *AddAgentParamsBodyRtaPostgresqlAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateRtaOptions(ctx, formats); err != nil {
@@ -9114,7 +11830,7 @@ func (o *AddAgentParamsBodyRtaMongodbAgent) ContextValidate(ctx context.Context,
 	return nil
 }
 
-func (o *AddAgentParamsBodyRtaMongodbAgent) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
+func (o *AddAgentParamsBodyRtaPostgresqlAgent) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
 	if o.RtaOptions != nil {
 
 		if swag.IsZero(o.RtaOptions) { // not required
@@ -9124,11 +11840,11 @@ func (o *AddAgentParamsBodyRtaMongodbAgent) contextValidateRtaOptions(ctx contex
 		if err := o.RtaOptions.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "rta_options")
+				return ve.ValidateName("body" + "." + "rta_postgresql_agent" + "." + "rta_options")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "rta_options")
+				return ce.ValidateName("body" + "." + "rta_postgresql_agent" + "." + "rta_options")
 			}
 
 			return err
@@ -9139,7 +11855,7 @@ func (o *AddAgentParamsBodyRtaMongodbAgent) contextValidateRtaOptions(ctx contex
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentParamsBodyRtaMongodbAgent) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyRtaPostgresqlAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -9147,8 +11863,8 @@ func (o *AddAgentParamsBodyRtaMongodbAgent) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyRtaMongodbAgent) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyRtaMongodbAgent
+func (o *AddAgentParamsBodyRtaPostgresqlAgent) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyRtaPostgresqlAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -9157,26 +11873,26 @@ func (o *AddAgentParamsBodyRtaMongodbAgent) UnmarshalBinary(b []byte) error {
 }
 
 /*
-AddAgentParamsBodyRtaMongodbAgentRtaOptions RTAOptions holds Real-Time Query Analytics agent options.
-swagger:model AddAgentParamsBodyRtaMongodbAgentRtaOptions
+AddAgentParamsBodyRtaPostgresqlAgentRtaOptions RTAOptions holds Real-Time Query Analytics agent options.
+swagger:model AddAgentParamsBodyRtaPostgresqlAgentRtaOptions
 */
-type AddAgentParamsBodyRtaMongodbAgentRtaOptions struct {
+type AddAgentParamsBodyRtaPostgresqlAgentRtaOptions struct {
 	// Query collect interval (default 2s is set by server).
 	CollectInterval string `json:"collect_interval,omitempty"`
 }
 
-// Validate validates this add agent params body rta mongodb agent rta options
-func (o *AddAgentParamsBodyRtaMongodbAgentRtaOptions) Validate(formats strfmt.Registry) error {
+// Validate validates this add agent params body rta postgresql agent rta options
+func (o *AddAgentParamsBodyRtaPostgresqlAgentRtaOptions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this add agent params body rta mongodb agent rta options based on context it is used
-func (o *AddAgentParamsBodyRtaMongodbAgentRtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add agent params body rta postgresql agent rta options based on context it is used
+func (o *AddAgentParamsBodyRtaPostgresqlAgentRtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddAgentParamsBodyRtaMongodbAgentRtaOptions) MarshalBinary() ([]byte, error) {
+func (o *AddAgentParamsBodyRtaPostgresqlAgentRtaOptions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -9184,8 +11900,8 @@ func (o *AddAgentParamsBodyRtaMongodbAgentRtaOptions) MarshalBinary() ([]byte, e
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddAgentParamsBodyRtaMongodbAgentRtaOptions) UnmarshalBinary(b []byte) error {
-	var res AddAgentParamsBodyRtaMongodbAgentRtaOptions
+func (o *AddAgentParamsBodyRtaPostgresqlAgentRtaOptions) UnmarshalBinary(b []byte) error {
+	var res AddAgentParamsBodyRtaPostgresqlAgentRtaOptions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -226,18 +226,33 @@ type ChangeAgentBody struct {
 	// qan mysql slowlog agent
 	QANMysqlSlowlogAgent *ChangeAgentParamsBodyQANMysqlSlowlogAgent `json:"qan_mysql_slowlog_agent,omitempty"`
 
+	// qan postgresql log agent
+	QANPostgresqlLogAgent *ChangeAgentParamsBodyQANPostgresqlLogAgent `json:"qan_postgresql_log_agent,omitempty"`
+
 	// qan postgresql pgstatements agent
 	QANPostgresqlPgstatementsAgent *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent `json:"qan_postgresql_pgstatements_agent,omitempty"`
 
 	// qan postgresql pgstatmonitor agent
 	QANPostgresqlPgstatmonitorAgent *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent `json:"qan_postgresql_pgstatmonitor_agent,omitempty"`
 
+	// qan proxysql digest agent
+	QANProxysqlDigestAgent *ChangeAgentParamsBodyQANProxysqlDigestAgent `json:"qan_proxysql_digest_agent,omitempty"`
+
+	// qan valkey slowlog agent
+	QANValkeySlowlogAgent *ChangeAgentParamsBodyQANValkeySlowlogAgent `json:"qan_valkey_slowlog_agent,omitempty"`
+
 	// rds exporter
 	RDSExporter *ChangeAgentParamsBodyRDSExporter `json:"rds_exporter,omitempty"`
 
 	// rta mongodb agent
 	RtaMongodbAgent *ChangeAgentParamsBodyRtaMongodbAgent `json:"rta_mongodb_agent,omitempty"`
 
+	// rta mysql agent
+	RtaMysqlAgent *ChangeAgentParamsBodyRtaMysqlAgent `json:"rta_mysql_agent,omitempty"`
+
+	// rta postgresql agent
+	RtaPostgresqlAgent *ChangeAgentParamsBodyRtaPostgresqlAgent `json:"rta_postgresql_agent,omitempty"`
+
 	// valkey exporter
 	ValkeyExporter *ChangeAgentParamsBodyValkeyExporter `json:"valkey_exporter,omitempty"`
 }
@@ -294,6 +309,10 @@ func (o *ChangeAgentBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateQANPostgresqlLogAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateQANPostgresqlPgstatementsAgent(formats); err != nil {
 		res = append(res, err)
 	}
@@ -302,6 +321,14 @@ func (o *ChangeAgentBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateQANProxysqlDigestAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateQANValkeySlowlogAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateRDSExporter(formats); err != nil {
 		res = append(res, err)
 	}
@@ -310,6 +337,14 @@ func (o *ChangeAgentBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateRtaMysqlAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateRtaPostgresqlAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateValkeyExporter(formats); err != nil {
 		res = append(res, err)
 	}
@@ -596,6 +631,29 @@ func (o *ChangeAgentBody) validateQANMysqlSlowlogAgent(formats strfmt.Registry)
 	return nil
 }
 
+func (o *ChangeAgentBody) validateQANPostgresqlLogAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANPostgresqlLogAgent) { // not required
+		return nil
+	}
+
+	if o.QANPostgresqlLogAgent != nil {
+		if err := o.QANPostgresqlLogAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "qan_postgresql_log_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "qan_postgresql_log_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *ChangeAgentBody) validateQANPostgresqlPgstatementsAgent(formats strfmt.Registry) error {
 	if swag.IsZero(o.QANPostgresqlPgstatementsAgent) { // not required
 		return nil
@@ -642,6 +700,52 @@ func (o *ChangeAgentBody) validateQANPostgresqlPgstatmonitorAgent(formats strfmt
 	return nil
 }
 
+func (o *ChangeAgentBody) validateQANProxysqlDigestAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANProxysqlDigestAgent) { // not required
+		return nil
+	}
+
+	if o.QANProxysqlDigestAgent != nil {
+		if err := o.QANProxysqlDigestAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "qan_proxysql_digest_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "qan_proxysql_digest_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentBody) validateQANValkeySlowlogAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANValkeySlowlogAgent) { // not required
+		return nil
+	}
+
+	if o.QANValkeySlowlogAgent != nil {
+		if err := o.QANValkeySlowlogAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "qan_valkey_slowlog_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "qan_valkey_slowlog_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *ChangeAgentBody) validateRDSExporter(formats strfmt.Registry) error {
 	if swag.IsZero(o.RDSExporter) { // not required
 		return nil
@@ -688,6 +792,52 @@ func (o *ChangeAgentBody) validateRtaMongodbAgent(formats strfmt.Registry) error
 	return nil
 }
 
+func (o *ChangeAgentBody) validateRtaMysqlAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaMysqlAgent) { // not required
+		return nil
+	}
+
+	if o.RtaMysqlAgent != nil {
+		if err := o.RtaMysqlAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "rta_mysql_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "rta_mysql_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentBody) validateRtaPostgresqlAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaPostgresqlAgent) { // not required
+		return nil
+	}
+
+	if o.RtaPostgresqlAgent != nil {
+		if err := o.RtaPostgresqlAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "rta_postgresql_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "rta_postgresql_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *ChangeAgentBody) validateValkeyExporter(formats strfmt.Registry) error {
 	if swag.IsZero(o.ValkeyExporter) { // not required
 		return nil
@@ -763,6 +913,10 @@ func (o *ChangeAgentBody) ContextValidate(ctx context.Context, formats strfmt.Re
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateQANPostgresqlLogAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateQANPostgresqlPgstatementsAgent(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -771,6 +925,14 @@ func (o *ChangeAgentBody) ContextValidate(ctx context.Context, formats strfmt.Re
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateQANProxysqlDigestAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateQANValkeySlowlogAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateRDSExporter(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -779,6 +941,14 @@ func (o *ChangeAgentBody) ContextValidate(ctx context.Context, formats strfmt.Re
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateRtaMysqlAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateRtaPostgresqlAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateValkeyExporter(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -1077,6 +1247,30 @@ func (o *ChangeAgentBody) contextValidateQANMysqlSlowlogAgent(ctx context.Contex
 	return nil
 }
 
+func (o *ChangeAgentBody) contextValidateQANPostgresqlLogAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANPostgresqlLogAgent != nil {
+
+		if swag.IsZero(o.QANPostgresqlLogAgent) { // not required
+			return nil
+		}
+
+		if err := o.QANPostgresqlLogAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "qan_postgresql_log_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "qan_postgresql_log_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *ChangeAgentBody) contextValidateQANPostgresqlPgstatementsAgent(ctx context.Context, formats strfmt.Registry) error {
 	if o.QANPostgresqlPgstatementsAgent != nil {
 
@@ -1125,6 +1319,54 @@ func (o *ChangeAgentBody) contextValidateQANPostgresqlPgstatmonitorAgent(ctx con
 	return nil
 }
 
+func (o *ChangeAgentBody) contextValidateQANProxysqlDigestAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANProxysqlDigestAgent != nil {
+
+		if swag.IsZero(o.QANProxysqlDigestAgent) { // not required
+			return nil
+		}
+
+		if err := o.QANProxysqlDigestAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "qan_proxysql_digest_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "qan_proxysql_digest_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentBody) contextValidateQANValkeySlowlogAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANValkeySlowlogAgent != nil {
+
+		if swag.IsZero(o.QANValkeySlowlogAgent) { // not required
+			return nil
+		}
+
+		if err := o.QANValkeySlowlogAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "qan_valkey_slowlog_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "qan_valkey_slowlog_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *ChangeAgentBody) contextValidateRDSExporter(ctx context.Context, formats strfmt.Registry) error {
 	if o.RDSExporter != nil {
 
@@ -1173,6 +1415,54 @@ func (o *ChangeAgentBody) contextValidateRtaMongodbAgent(ctx context.Context, fo
 	return nil
 }
 
+func (o *ChangeAgentBody) contextValidateRtaMysqlAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaMysqlAgent != nil {
+
+		if swag.IsZero(o.RtaMysqlAgent) { // not required
+			return nil
+		}
+
+		if err := o.RtaMysqlAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "rta_mysql_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "rta_mysql_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentBody) contextValidateRtaPostgresqlAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaPostgresqlAgent != nil {
+
+		if swag.IsZero(o.RtaPostgresqlAgent) { // not required
+			return nil
+		}
+
+		if err := o.RtaPostgresqlAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "rta_postgresql_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "rta_postgresql_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *ChangeAgentBody) contextValidateValkeyExporter(ctx context.Context, formats strfmt.Registry) error {
 	if o.ValkeyExporter != nil {
 
@@ -1484,18 +1774,33 @@ type ChangeAgentOKBody struct {
 	// qan mysql slowlog agent
 	QANMysqlSlowlogAgent *ChangeAgentOKBodyQANMysqlSlowlogAgent `json:"qan_mysql_slowlog_agent,omitempty"`
 
+	// qan postgresql log agent
+	QANPostgresqlLogAgent *ChangeAgentOKBodyQANPostgresqlLogAgent `json:"qan_postgresql_log_agent,omitempty"`
+
 	// qan postgresql pgstatements agent
 	QANPostgresqlPgstatementsAgent *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent `json:"qan_postgresql_pgstatements_agent,omitempty"`
 
 	// qan postgresql pgstatmonitor agent
 	QANPostgresqlPgstatmonitorAgent *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent `json:"qan_postgresql_pgstatmonitor_agent,omitempty"`
 
+	// qan proxysql digest agent
+	QANProxysqlDigestAgent *ChangeAgentOKBodyQANProxysqlDigestAgent `json:"qan_proxysql_digest_agent,omitempty"`
+
+	// qan valkey slowlog agent
+	QANValkeySlowlogAgent *ChangeAgentOKBodyQANValkeySlowlogAgent `json:"qan_valkey_slowlog_agent,omitempty"`
+
 	// rds exporter
 	RDSExporter *ChangeAgentOKBodyRDSExporter `json:"rds_exporter,omitempty"`
 
 	// rta mongodb agent
 	RtaMongodbAgent *ChangeAgentOKBodyRtaMongodbAgent `json:"rta_mongodb_agent,omitempty"`
 
+	// rta mysql agent
+	RtaMysqlAgent *ChangeAgentOKBodyRtaMysqlAgent `json:"rta_mysql_agent,omitempty"`
+
+	// rta postgresql agent
+	RtaPostgresqlAgent *ChangeAgentOKBodyRtaPostgresqlAgent `json:"rta_postgresql_agent,omitempty"`
+
 	// valkey exporter
 	ValkeyExporter *ChangeAgentOKBodyValkeyExporter `json:"valkey_exporter,omitempty"`
 }
@@ -1552,6 +1857,10 @@ func (o *ChangeAgentOKBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateQANPostgresqlLogAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateQANPostgresqlPgstatementsAgent(formats); err != nil {
 		res = append(res, err)
 	}
@@ -1560,6 +1869,14 @@ func (o *ChangeAgentOKBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateQANProxysqlDigestAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateQANValkeySlowlogAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateRDSExporter(formats); err != nil {
 		res = append(res, err)
 	}
@@ -1568,6 +1885,14 @@ func (o *ChangeAgentOKBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateRtaMysqlAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateRtaPostgresqlAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateValkeyExporter(formats); err != nil {
 		res = append(res, err)
 	}
@@ -1854,6 +2179,29 @@ func (o *ChangeAgentOKBody) validateQANMysqlSlowlogAgent(formats strfmt.Registry
 	return nil
 }
 
+func (o *ChangeAgentOKBody) validateQANPostgresqlLogAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANPostgresqlLogAgent) { // not required
+		return nil
+	}
+
+	if o.QANPostgresqlLogAgent != nil {
+		if err := o.QANPostgresqlLogAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "qan_postgresql_log_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "qan_postgresql_log_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *ChangeAgentOKBody) validateQANPostgresqlPgstatementsAgent(formats strfmt.Registry) error {
 	if swag.IsZero(o.QANPostgresqlPgstatementsAgent) { // not required
 		return nil
@@ -1900,20 +2248,20 @@ func (o *ChangeAgentOKBody) validateQANPostgresqlPgstatmonitorAgent(formats strf
 	return nil
 }
 
-func (o *ChangeAgentOKBody) validateRDSExporter(formats strfmt.Registry) error {
-	if swag.IsZero(o.RDSExporter) { // not required
+func (o *ChangeAgentOKBody) validateQANProxysqlDigestAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANProxysqlDigestAgent) { // not required
 		return nil
 	}
 
-	if o.RDSExporter != nil {
-		if err := o.RDSExporter.Validate(formats); err != nil {
+	if o.QANProxysqlDigestAgent != nil {
+		if err := o.QANProxysqlDigestAgent.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("changeAgentOk" + "." + "rds_exporter")
+				return ve.ValidateName("changeAgentOk" + "." + "qan_proxysql_digest_agent")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("changeAgentOk" + "." + "rds_exporter")
+				return ce.ValidateName("changeAgentOk" + "." + "qan_proxysql_digest_agent")
 			}
 
 			return err
@@ -1923,12 +2271,58 @@ func (o *ChangeAgentOKBody) validateRDSExporter(formats strfmt.Registry) error {
 	return nil
 }
 
-func (o *ChangeAgentOKBody) validateRtaMongodbAgent(formats strfmt.Registry) error {
-	if swag.IsZero(o.RtaMongodbAgent) { // not required
+func (o *ChangeAgentOKBody) validateQANValkeySlowlogAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANValkeySlowlogAgent) { // not required
 		return nil
 	}
 
-	if o.RtaMongodbAgent != nil {
+	if o.QANValkeySlowlogAgent != nil {
+		if err := o.QANValkeySlowlogAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "qan_valkey_slowlog_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "qan_valkey_slowlog_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentOKBody) validateRDSExporter(formats strfmt.Registry) error {
+	if swag.IsZero(o.RDSExporter) { // not required
+		return nil
+	}
+
+	if o.RDSExporter != nil {
+		if err := o.RDSExporter.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "rds_exporter")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "rds_exporter")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentOKBody) validateRtaMongodbAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaMongodbAgent) { // not required
+		return nil
+	}
+
+	if o.RtaMongodbAgent != nil {
 		if err := o.RtaMongodbAgent.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
@@ -1946,6 +2340,52 @@ func (o *ChangeAgentOKBody) validateRtaMongodbAgent(formats strfmt.Registry) err
 	return nil
 }
 
+func (o *ChangeAgentOKBody) validateRtaMysqlAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaMysqlAgent) { // not required
+		return nil
+	}
+
+	if o.RtaMysqlAgent != nil {
+		if err := o.RtaMysqlAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "rta_mysql_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "rta_mysql_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentOKBody) validateRtaPostgresqlAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaPostgresqlAgent) { // not required
+		return nil
+	}
+
+	if o.RtaPostgresqlAgent != nil {
+		if err := o.RtaPostgresqlAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "rta_postgresql_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "rta_postgresql_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *ChangeAgentOKBody) validateValkeyExporter(formats strfmt.Registry) error {
 	if swag.IsZero(o.ValkeyExporter) { // not required
 		return nil
@@ -2021,6 +2461,10 @@ func (o *ChangeAgentOKBody) ContextValidate(ctx context.Context, formats strfmt.
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateQANPostgresqlLogAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateQANPostgresqlPgstatementsAgent(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -2029,6 +2473,14 @@ func (o *ChangeAgentOKBody) ContextValidate(ctx context.Context, formats strfmt.
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateQANProxysqlDigestAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateQANValkeySlowlogAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateRDSExporter(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -2037,6 +2489,14 @@ func (o *ChangeAgentOKBody) ContextValidate(ctx context.Context, formats strfmt.
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateRtaMysqlAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateRtaPostgresqlAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateValkeyExporter(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -2335,6 +2795,30 @@ func (o *ChangeAgentOKBody) contextValidateQANMysqlSlowlogAgent(ctx context.Cont
 	return nil
 }
 
+func (o *ChangeAgentOKBody) contextValidateQANPostgresqlLogAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANPostgresqlLogAgent != nil {
+
+		if swag.IsZero(o.QANPostgresqlLogAgent) { // not required
+			return nil
+		}
+
+		if err := o.QANPostgresqlLogAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "qan_postgresql_log_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "qan_postgresql_log_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *ChangeAgentOKBody) contextValidateQANPostgresqlPgstatementsAgent(ctx context.Context, formats strfmt.Registry) error {
 	if o.QANPostgresqlPgstatementsAgent != nil {
 
@@ -2383,6 +2867,54 @@ func (o *ChangeAgentOKBody) contextValidateQANPostgresqlPgstatmonitorAgent(ctx c
 	return nil
 }
 
+func (o *ChangeAgentOKBody) contextValidateQANProxysqlDigestAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANProxysqlDigestAgent != nil {
+
+		if swag.IsZero(o.QANProxysqlDigestAgent) { // not required
+			return nil
+		}
+
+		if err := o.QANProxysqlDigestAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "qan_proxysql_digest_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "qan_proxysql_digest_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentOKBody) contextValidateQANValkeySlowlogAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANValkeySlowlogAgent != nil {
+
+		if swag.IsZero(o.QANValkeySlowlogAgent) { // not required
+			return nil
+		}
+
+		if err := o.QANValkeySlowlogAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "qan_valkey_slowlog_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "qan_valkey_slowlog_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *ChangeAgentOKBody) contextValidateRDSExporter(ctx context.Context, formats strfmt.Registry) error {
 	if o.RDSExporter != nil {
 
@@ -2431,6 +2963,54 @@ func (o *ChangeAgentOKBody) contextValidateRtaMongodbAgent(ctx context.Context,
 	return nil
 }
 
+func (o *ChangeAgentOKBody) contextValidateRtaMysqlAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaMysqlAgent != nil {
+
+		if swag.IsZero(o.RtaMysqlAgent) { // not required
+			return nil
+		}
+
+		if err := o.RtaMysqlAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "rta_mysql_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "rta_mysql_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentOKBody) contextValidateRtaPostgresqlAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaPostgresqlAgent != nil {
+
+		if swag.IsZero(o.RtaPostgresqlAgent) { // not required
+			return nil
+		}
+
+		if err := o.RtaPostgresqlAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "rta_postgresql_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "rta_postgresql_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *ChangeAgentOKBody) contextValidateValkeyExporter(ctx context.Context, formats strfmt.Registry) error {
 	if o.ValkeyExporter != nil {
 
@@ -5716,10 +6296,10 @@ func (o *ChangeAgentOKBodyQANMysqlSlowlogAgent) UnmarshalBinary(b []byte) error
 }
 
 /*
-ChangeAgentOKBodyQANPostgresqlPgstatementsAgent QANPostgreSQLPgStatementsAgent runs within pmm-agent and sends PostgreSQL Query Analytics data to the PMM Server.
-swagger:model ChangeAgentOKBodyQANPostgresqlPgstatementsAgent
+ChangeAgentOKBodyQANPostgresqlLogAgent QANPostgreSQLLogAgent runs within pmm-agent and sends PostgreSQL Query Analytics data from the server log to the PMM Server.
+swagger:model ChangeAgentOKBodyQANPostgresqlLogAgent
 */
-type ChangeAgentOKBodyQANPostgresqlPgstatementsAgent struct {
+type ChangeAgentOKBodyQANPostgresqlLogAgent struct {
 	// Unique randomly generated instance identifier.
 	AgentID string `json:"agent_id,omitempty"`
 
@@ -5732,20 +6312,23 @@ type ChangeAgentOKBodyQANPostgresqlPgstatementsAgent struct {
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// PostgreSQL username for getting pg stat statements data.
+	// PostgreSQL username for getting server log settings.
 	Username string `json:"username,omitempty"`
 
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
 	// Disable parsing comments from queries and showing them in QAN.
 	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
 
 	// Limit query length in QAN (default: server-defined; -1: no limit).
 	MaxQueryLength int32 `json:"max_query_length,omitempty"`
 
-	// Use TLS for database connections.
-	TLS bool `json:"tls,omitempty"`
-
-	// Skip TLS certificate and hostname validation.
-	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+	// True if query examples are disabled.
+	QueryExamplesDisabled bool `json:"query_examples_disabled,omitempty"`
 
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
@@ -5772,8 +6355,8 @@ type ChangeAgentOKBodyQANPostgresqlPgstatementsAgent struct {
 	LogLevel *string `json:"log_level,omitempty"`
 }
 
-// Validate validates this change agent OK body QAN postgresql pgstatements agent
-func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent OK body QAN postgresql log agent
+func (o *ChangeAgentOKBodyQANPostgresqlLogAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateStatus(formats); err != nil {
@@ -5790,7 +6373,7 @@ func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) Validate(formats strfm
 	return nil
 }
 
-var changeAgentOkBodyQanPostgresqlPgstatementsAgentTypeStatusPropEnum []any
+var changeAgentOkBodyQanPostgresqlLogAgentTypeStatusPropEnum []any
 
 func init() {
 	var res []string
@@ -5798,59 +6381,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentOkBodyQanPostgresqlPgstatementsAgentTypeStatusPropEnum = append(changeAgentOkBodyQanPostgresqlPgstatementsAgentTypeStatusPropEnum, v)
+		changeAgentOkBodyQanPostgresqlLogAgentTypeStatusPropEnum = append(changeAgentOkBodyQanPostgresqlLogAgentTypeStatusPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
-	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+	// ChangeAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ChangeAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
-	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+	// ChangeAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ChangeAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
-	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+	// ChangeAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ChangeAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
-	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+	// ChangeAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ChangeAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
-	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+	// ChangeAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ChangeAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
-	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+	// ChangeAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ChangeAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
-	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+	// ChangeAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ChangeAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
-	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+	// ChangeAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ChangeAgentOKBodyQANPostgresqlLogAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
 )
 
 // prop value enum
-func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) validateStatusEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentOkBodyQanPostgresqlPgstatementsAgentTypeStatusPropEnum, true); err != nil {
+func (o *ChangeAgentOKBodyQANPostgresqlLogAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyQanPostgresqlLogAgentTypeStatusPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) validateStatus(formats strfmt.Registry) error {
+func (o *ChangeAgentOKBodyQANPostgresqlLogAgent) validateStatus(formats strfmt.Registry) error {
 	if swag.IsZero(o.Status) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateStatusEnum("changeAgentOk"+"."+"qan_postgresql_pgstatements_agent"+"."+"status", "body", *o.Status); err != nil {
+	if err := o.validateStatusEnum("changeAgentOk"+"."+"qan_postgresql_log_agent"+"."+"status", "body", *o.Status); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-var changeAgentOkBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum []any
+var changeAgentOkBodyQanPostgresqlLogAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -5858,59 +6441,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentOkBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum = append(changeAgentOkBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum, v)
+		changeAgentOkBodyQanPostgresqlLogAgentTypeLogLevelPropEnum = append(changeAgentOkBodyQanPostgresqlLogAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentOKBodyQANPostgresqlLogAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentOkBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentOKBodyQANPostgresqlLogAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyQanPostgresqlLogAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentOKBodyQANPostgresqlLogAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("changeAgentOk"+"."+"qan_postgresql_pgstatements_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("changeAgentOk"+"."+"qan_postgresql_log_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this change agent OK body QAN postgresql pgstatements agent based on context it is used
-func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent OK body QAN postgresql log agent based on context it is used
+func (o *ChangeAgentOKBodyQANPostgresqlLogAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentOKBodyQANPostgresqlLogAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -5918,8 +6501,8 @@ func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) MarshalBinary() ([]byt
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentOKBodyQANPostgresqlPgstatementsAgent
+func (o *ChangeAgentOKBodyQANPostgresqlLogAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentOKBodyQANPostgresqlLogAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -5928,10 +6511,10 @@ func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) UnmarshalBinary(b []by
 }
 
 /*
-ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent QANPostgreSQLPgStatMonitorAgent runs within pmm-agent and sends PostgreSQL Query Analytics data to the PMM Server.
-swagger:model ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent
+ChangeAgentOKBodyQANPostgresqlPgstatementsAgent QANPostgreSQLPgStatementsAgent runs within pmm-agent and sends PostgreSQL Query Analytics data to the PMM Server.
+swagger:model ChangeAgentOKBodyQANPostgresqlPgstatementsAgent
 */
-type ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent struct {
+type ChangeAgentOKBodyQANPostgresqlPgstatementsAgent struct {
 	// Unique randomly generated instance identifier.
 	AgentID string `json:"agent_id,omitempty"`
 
@@ -5944,23 +6527,20 @@ type ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent struct {
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// PostgreSQL username for getting pg stat monitor data.
+	// PostgreSQL username for getting pg stat statements data.
 	Username string `json:"username,omitempty"`
 
-	// Use TLS for database connections.
-	TLS bool `json:"tls,omitempty"`
-
-	// Skip TLS certificate and hostname validation.
-	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
-
 	// Disable parsing comments from queries and showing them in QAN.
 	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
 
 	// Limit query length in QAN (default: server-defined; -1: no limit).
 	MaxQueryLength int32 `json:"max_query_length,omitempty"`
 
-	// True if query examples are disabled.
-	QueryExamplesDisabled bool `json:"query_examples_disabled,omitempty"`
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
 
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
@@ -5987,8 +6567,8 @@ type ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent struct {
 	LogLevel *string `json:"log_level,omitempty"`
 }
 
-// Validate validates this change agent OK body QAN postgresql pgstatmonitor agent
-func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent OK body QAN postgresql pgstatements agent
+func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateStatus(formats); err != nil {
@@ -6005,7 +6585,7 @@ func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) Validate(formats strf
 	return nil
 }
 
-var changeAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeStatusPropEnum []any
+var changeAgentOkBodyQanPostgresqlPgstatementsAgentTypeStatusPropEnum []any
 
 func init() {
 	var res []string
@@ -6013,59 +6593,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeStatusPropEnum = append(changeAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeStatusPropEnum, v)
+		changeAgentOkBodyQanPostgresqlPgstatementsAgentTypeStatusPropEnum = append(changeAgentOkBodyQanPostgresqlPgstatementsAgentTypeStatusPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
-	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
-	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
-	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
-	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
-	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
-	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
-	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
-	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
 )
 
 // prop value enum
-func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) validateStatusEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeStatusPropEnum, true); err != nil {
+func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyQanPostgresqlPgstatementsAgentTypeStatusPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) validateStatus(formats strfmt.Registry) error {
+func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) validateStatus(formats strfmt.Registry) error {
 	if swag.IsZero(o.Status) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateStatusEnum("changeAgentOk"+"."+"qan_postgresql_pgstatmonitor_agent"+"."+"status", "body", *o.Status); err != nil {
+	if err := o.validateStatusEnum("changeAgentOk"+"."+"qan_postgresql_pgstatements_agent"+"."+"status", "body", *o.Status); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-var changeAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum []any
+var changeAgentOkBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -6073,59 +6653,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum = append(changeAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum, v)
+		changeAgentOkBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum = append(changeAgentOkBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentOKBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("changeAgentOk"+"."+"qan_postgresql_pgstatmonitor_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("changeAgentOk"+"."+"qan_postgresql_pgstatements_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// ContextValidate validates this change agent OK body QAN postgresql pgstatmonitor agent based on context it is used
-func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent OK body QAN postgresql pgstatements agent based on context it is used
+func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -6133,8 +6713,8 @@ func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) MarshalBinary() ([]by
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent
+func (o *ChangeAgentOKBodyQANPostgresqlPgstatementsAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentOKBodyQANPostgresqlPgstatementsAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -6143,10 +6723,10 @@ func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) UnmarshalBinary(b []b
 }
 
 /*
-ChangeAgentOKBodyRDSExporter RDSExporter runs on Generic or Container Node and exposes RemoteRDS Node metrics.
-swagger:model ChangeAgentOKBodyRDSExporter
+ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent QANPostgreSQLPgStatMonitorAgent runs within pmm-agent and sends PostgreSQL Query Analytics data to the PMM Server.
+swagger:model ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent
 */
-type ChangeAgentOKBodyRDSExporter struct {
+type ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent struct {
 	// Unique randomly generated instance identifier.
 	AgentID string `json:"agent_id,omitempty"`
 
@@ -6156,11 +6736,26 @@ type ChangeAgentOKBodyRDSExporter struct {
 	// Desired Agent status: enabled (false) or disabled (true).
 	Disabled bool `json:"disabled,omitempty"`
 
-	// Node identifier.
-	NodeID string `json:"node_id,omitempty"`
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
 
-	// AWS Access Key.
-	AWSAccessKey string `json:"aws_access_key,omitempty"`
+	// PostgreSQL username for getting pg stat monitor data.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
+
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
+	// True if query examples are disabled.
+	QueryExamplesDisabled bool `json:"query_examples_disabled,omitempty"`
 
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
@@ -6177,18 +6772,6 @@ type ChangeAgentOKBodyRDSExporter struct {
 	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
 	Status *string `json:"status,omitempty"`
 
-	// Listen port for scraping metrics (the same for several configurations).
-	ListenPort int64 `json:"listen_port,omitempty"`
-
-	// Basic metrics are disabled.
-	BasicMetricsDisabled bool `json:"basic_metrics_disabled,omitempty"`
-
-	// Enhanced metrics are disabled.
-	EnhancedMetricsDisabled bool `json:"enhanced_metrics_disabled,omitempty"`
-
-	// True if exporter uses push metrics mode.
-	PushMetricsEnabled bool `json:"push_metrics_enabled,omitempty"`
-
 	// Path to exec process.
 	ProcessExecPath string `json:"process_exec_path,omitempty"`
 
@@ -6197,16 +6780,10 @@ type ChangeAgentOKBodyRDSExporter struct {
 	// - LOG_LEVEL_UNSPECIFIED: Auto
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
-
-	// Limit of databases for auto-discovery.
-	AutoDiscoveryLimit int32 `json:"auto_discovery_limit,omitempty"`
-
-	// metrics resolutions
-	MetricsResolutions *ChangeAgentOKBodyRDSExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
 }
 
-// Validate validates this change agent OK body RDS exporter
-func (o *ChangeAgentOKBodyRDSExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent OK body QAN postgresql pgstatmonitor agent
+func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateStatus(formats); err != nil {
@@ -6217,17 +6794,13 @@ func (o *ChangeAgentOKBodyRDSExporter) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
-	if err := o.validateMetricsResolutions(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
-var changeAgentOkBodyRdsExporterTypeStatusPropEnum []any
+var changeAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeStatusPropEnum []any
 
 func init() {
 	var res []string
@@ -6235,59 +6808,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentOkBodyRdsExporterTypeStatusPropEnum = append(changeAgentOkBodyRdsExporterTypeStatusPropEnum, v)
+		changeAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeStatusPropEnum = append(changeAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeStatusPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
-	ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
 
-	// ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
-	ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
 
-	// ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
-	ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
 
-	// ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
-	ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
 
-	// ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
-	ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
 
-	// ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
-	ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
 
-	// ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
-	ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
 
-	// ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
-	ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
 )
 
 // prop value enum
-func (o *ChangeAgentOKBodyRDSExporter) validateStatusEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentOkBodyRdsExporterTypeStatusPropEnum, true); err != nil {
+func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeStatusPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentOKBodyRDSExporter) validateStatus(formats strfmt.Registry) error {
+func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) validateStatus(formats strfmt.Registry) error {
 	if swag.IsZero(o.Status) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateStatusEnum("changeAgentOk"+"."+"rds_exporter"+"."+"status", "body", *o.Status); err != nil {
+	if err := o.validateStatusEnum("changeAgentOk"+"."+"qan_postgresql_pgstatmonitor_agent"+"."+"status", "body", *o.Status); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-var changeAgentOkBodyRdsExporterTypeLogLevelPropEnum []any
+var changeAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -6295,80 +6868,138 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentOkBodyRdsExporterTypeLogLevelPropEnum = append(changeAgentOkBodyRdsExporterTypeLogLevelPropEnum, v)
+		changeAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum = append(changeAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentOKBodyRDSExporter) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentOkBodyRdsExporterTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentOKBodyRDSExporter) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("changeAgentOk"+"."+"rds_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("changeAgentOk"+"."+"qan_postgresql_pgstatmonitor_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentOKBodyRDSExporter) validateMetricsResolutions(formats strfmt.Registry) error {
-	if swag.IsZero(o.MetricsResolutions) { // not required
-		return nil
+// ContextValidate validates this change agent OK body QAN postgresql pgstatmonitor agent based on context it is used
+func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
 	}
+	return swag.WriteJSON(o)
+}
 
-	if o.MetricsResolutions != nil {
-		if err := o.MetricsResolutions.Validate(formats); err != nil {
-			ve := new(errors.Validation)
-			if stderrors.As(err, &ve) {
-				return ve.ValidateName("changeAgentOk" + "." + "rds_exporter" + "." + "metrics_resolutions")
-			}
-			ce := new(errors.CompositeError)
-			if stderrors.As(err, &ce) {
-				return ce.ValidateName("changeAgentOk" + "." + "rds_exporter" + "." + "metrics_resolutions")
-			}
-
-			return err
-		}
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentOKBodyQANPostgresqlPgstatmonitorAgent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
 	}
-
+	*o = res
 	return nil
 }
 
-// ContextValidate validate this change agent OK body RDS exporter based on the context it is used
-func (o *ChangeAgentOKBodyRDSExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+/*
+ChangeAgentOKBodyQANProxysqlDigestAgent QANProxySQLDigestAgent runs within pmm-agent and sends ProxySQL Query Analytics data to the PMM Server.
+swagger:model ChangeAgentOKBodyQANProxysqlDigestAgent
+*/
+type ChangeAgentOKBodyQANProxysqlDigestAgent struct {
+	// Unique randomly generated instance identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// ProxySQL username for getting query digest data.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Path to exec process.
+	ProcessExecPath string `json:"process_exec_path,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+}
+
+// Validate validates this change agent OK body QAN proxysql digest agent
+func (o *ChangeAgentOKBodyQANProxysqlDigestAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
-	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -6378,75 +7009,127 @@ func (o *ChangeAgentOKBodyRDSExporter) ContextValidate(ctx context.Context, form
 	return nil
 }
 
-func (o *ChangeAgentOKBodyRDSExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
-	if o.MetricsResolutions != nil {
+var changeAgentOkBodyQanProxysqlDigestAgentTypeStatusPropEnum []any
 
-		if swag.IsZero(o.MetricsResolutions) { // not required
-			return nil
-		}
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		changeAgentOkBodyQanProxysqlDigestAgentTypeStatusPropEnum = append(changeAgentOkBodyQanProxysqlDigestAgentTypeStatusPropEnum, v)
+	}
+}
 
-		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
-			ve := new(errors.Validation)
-			if stderrors.As(err, &ve) {
-				return ve.ValidateName("changeAgentOk" + "." + "rds_exporter" + "." + "metrics_resolutions")
-			}
-			ce := new(errors.CompositeError)
-			if stderrors.As(err, &ce) {
-				return ce.ValidateName("changeAgentOk" + "." + "rds_exporter" + "." + "metrics_resolutions")
-			}
+const (
 
-			return err
-		}
-	}
+	// ChangeAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ChangeAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// ChangeAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ChangeAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// ChangeAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ChangeAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
 
+	// ChangeAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ChangeAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// ChangeAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ChangeAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// ChangeAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ChangeAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// ChangeAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ChangeAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// ChangeAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ChangeAgentOKBodyQANProxysqlDigestAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *ChangeAgentOKBodyQANProxysqlDigestAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyQanProxysqlDigestAgentTypeStatusPropEnum, true); err != nil {
+		return err
+	}
 	return nil
 }
 
-// MarshalBinary interface implementation
-func (o *ChangeAgentOKBodyRDSExporter) MarshalBinary() ([]byte, error) {
-	if o == nil {
-		return nil, nil
+func (o *ChangeAgentOKBodyQANProxysqlDigestAgent) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
 	}
-	return swag.WriteJSON(o)
-}
 
-// UnmarshalBinary interface implementation
-func (o *ChangeAgentOKBodyRDSExporter) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentOKBodyRDSExporter
-	if err := swag.ReadJSON(b, &res); err != nil {
+	// value enum
+	if err := o.validateStatusEnum("changeAgentOk"+"."+"qan_proxysql_digest_agent"+"."+"status", "body", *o.Status); err != nil {
 		return err
 	}
-	*o = res
+
 	return nil
 }
 
-/*
-ChangeAgentOKBodyRDSExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ChangeAgentOKBodyRDSExporterMetricsResolutions
-*/
-type ChangeAgentOKBodyRDSExporterMetricsResolutions struct {
-	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Hr string `json:"hr,omitempty"`
+var changeAgentOkBodyQanProxysqlDigestAgentTypeLogLevelPropEnum []any
 
-	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Mr string `json:"mr,omitempty"`
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		changeAgentOkBodyQanProxysqlDigestAgentTypeLogLevelPropEnum = append(changeAgentOkBodyQanProxysqlDigestAgentTypeLogLevelPropEnum, v)
+	}
+}
 
-	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Lr string `json:"lr,omitempty"`
+const (
+
+	// ChangeAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// ChangeAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// ChangeAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// ChangeAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// ChangeAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// ChangeAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentOKBodyQANProxysqlDigestAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *ChangeAgentOKBodyQANProxysqlDigestAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyQanProxysqlDigestAgentTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
 }
 
-// Validate validates this change agent OK body RDS exporter metrics resolutions
-func (o *ChangeAgentOKBodyRDSExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+func (o *ChangeAgentOKBodyQANProxysqlDigestAgent) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("changeAgentOk"+"."+"qan_proxysql_digest_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
 	return nil
 }
 
-// ContextValidate validates this change agent OK body RDS exporter metrics resolutions based on context it is used
-func (o *ChangeAgentOKBodyRDSExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent OK body QAN proxysql digest agent based on context it is used
+func (o *ChangeAgentOKBodyQANProxysqlDigestAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentOKBodyRDSExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentOKBodyQANProxysqlDigestAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -6454,8 +7137,8 @@ func (o *ChangeAgentOKBodyRDSExporterMetricsResolutions) MarshalBinary() ([]byte
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentOKBodyRDSExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentOKBodyRDSExporterMetricsResolutions
+func (o *ChangeAgentOKBodyQANProxysqlDigestAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentOKBodyQANProxysqlDigestAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -6464,11 +7147,11 @@ func (o *ChangeAgentOKBodyRDSExporterMetricsResolutions) UnmarshalBinary(b []byt
 }
 
 /*
-ChangeAgentOKBodyRtaMongodbAgent RTAMongoDBAgent runs within pmm-agent and sends MongoDB Real-Time Query Analytics data to the PMM Server.
-swagger:model ChangeAgentOKBodyRtaMongodbAgent
+ChangeAgentOKBodyQANValkeySlowlogAgent QANValkeySlowlogAgent runs within pmm-agent and sends Valkey Query Analytics data to the PMM Server.
+swagger:model ChangeAgentOKBodyQANValkeySlowlogAgent
 */
-type ChangeAgentOKBodyRtaMongodbAgent struct {
-	// Unique agent identifier.
+type ChangeAgentOKBodyQANValkeySlowlogAgent struct {
+	// Unique randomly generated instance identifier.
 	AgentID string `json:"agent_id,omitempty"`
 
 	// The pmm-agent identifier which runs this instance.
@@ -6480,7 +7163,7 @@ type ChangeAgentOKBodyRtaMongodbAgent struct {
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// MongoDB username for getting profiler data.
+	// Valkey username for getting slow log data.
 	Username string `json:"username,omitempty"`
 
 	// Use TLS for database connections.
@@ -6489,6 +7172,12 @@ type ChangeAgentOKBodyRtaMongodbAgent struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
 
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
+	// True if query examples are disabled.
+	QueryExamplesDisabled bool `json:"query_examples_disabled,omitempty"`
+
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
 
@@ -6504,18 +7193,18 @@ type ChangeAgentOKBodyRtaMongodbAgent struct {
 	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
 	Status *string `json:"status,omitempty"`
 
+	// Path to exec process.
+	ProcessExecPath string `json:"process_exec_path,omitempty"`
+
 	// Log level for exporters
 	//
 	// - LOG_LEVEL_UNSPECIFIED: Auto
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
-
-	// rta options
-	RtaOptions *ChangeAgentOKBodyRtaMongodbAgentRtaOptions `json:"rta_options,omitempty"`
 }
 
-// Validate validates this change agent OK body rta mongodb agent
-func (o *ChangeAgentOKBodyRtaMongodbAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent OK body QAN valkey slowlog agent
+func (o *ChangeAgentOKBodyQANValkeySlowlogAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateStatus(formats); err != nil {
@@ -6526,17 +7215,13 @@ func (o *ChangeAgentOKBodyRtaMongodbAgent) Validate(formats strfmt.Registry) err
 		res = append(res, err)
 	}
 
-	if err := o.validateRtaOptions(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
-var changeAgentOkBodyRtaMongodbAgentTypeStatusPropEnum []any
+var changeAgentOkBodyQanValkeySlowlogAgentTypeStatusPropEnum []any
 
 func init() {
 	var res []string
@@ -6544,59 +7229,59 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentOkBodyRtaMongodbAgentTypeStatusPropEnum = append(changeAgentOkBodyRtaMongodbAgentTypeStatusPropEnum, v)
+		changeAgentOkBodyQanValkeySlowlogAgentTypeStatusPropEnum = append(changeAgentOkBodyQanValkeySlowlogAgentTypeStatusPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
-	ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+	// ChangeAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ChangeAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
 
-	// ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
-	ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+	// ChangeAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ChangeAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
 
-	// ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
-	ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+	// ChangeAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ChangeAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
 
-	// ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
-	ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+	// ChangeAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ChangeAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
 
-	// ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
-	ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+	// ChangeAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ChangeAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
 
-	// ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
-	ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+	// ChangeAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ChangeAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
 
-	// ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
-	ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+	// ChangeAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ChangeAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
 
-	// ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
-	ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+	// ChangeAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ChangeAgentOKBodyQANValkeySlowlogAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
 )
 
 // prop value enum
-func (o *ChangeAgentOKBodyRtaMongodbAgent) validateStatusEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentOkBodyRtaMongodbAgentTypeStatusPropEnum, true); err != nil {
+func (o *ChangeAgentOKBodyQANValkeySlowlogAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyQanValkeySlowlogAgentTypeStatusPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentOKBodyRtaMongodbAgent) validateStatus(formats strfmt.Registry) error {
+func (o *ChangeAgentOKBodyQANValkeySlowlogAgent) validateStatus(formats strfmt.Registry) error {
 	if swag.IsZero(o.Status) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateStatusEnum("changeAgentOk"+"."+"rta_mongodb_agent"+"."+"status", "body", *o.Status); err != nil {
+	if err := o.validateStatusEnum("changeAgentOk"+"."+"qan_valkey_slowlog_agent"+"."+"status", "body", *o.Status); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-var changeAgentOkBodyRtaMongodbAgentTypeLogLevelPropEnum []any
+var changeAgentOkBodyQanValkeySlowlogAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -6604,66 +7289,2671 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentOkBodyRtaMongodbAgentTypeLogLevelPropEnum = append(changeAgentOkBodyRtaMongodbAgentTypeLogLevelPropEnum, v)
+		changeAgentOkBodyQanValkeySlowlogAgentTypeLogLevelPropEnum = append(changeAgentOkBodyQanValkeySlowlogAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentOKBodyQANValkeySlowlogAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentOKBodyRtaMongodbAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentOkBodyRtaMongodbAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentOKBodyQANValkeySlowlogAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyQanValkeySlowlogAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentOKBodyRtaMongodbAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentOKBodyQANValkeySlowlogAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("changeAgentOk"+"."+"rta_mongodb_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("changeAgentOk"+"."+"qan_valkey_slowlog_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentOKBodyRtaMongodbAgent) validateRtaOptions(formats strfmt.Registry) error {
-	if swag.IsZero(o.RtaOptions) { // not required
-		return nil
+// ContextValidate validates this change agent OK body QAN valkey slowlog agent based on context it is used
+func (o *ChangeAgentOKBodyQANValkeySlowlogAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentOKBodyQANValkeySlowlogAgent) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
 	}
+	return swag.WriteJSON(o)
+}
 
-	if o.RtaOptions != nil {
-		if err := o.RtaOptions.Validate(formats); err != nil {
-			ve := new(errors.Validation)
-			if stderrors.As(err, &ve) {
-				return ve.ValidateName("changeAgentOk" + "." + "rta_mongodb_agent" + "." + "rta_options")
-			}
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentOKBodyQANValkeySlowlogAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentOKBodyQANValkeySlowlogAgent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentOKBodyRDSExporter RDSExporter runs on Generic or Container Node and exposes RemoteRDS Node metrics.
+swagger:model ChangeAgentOKBodyRDSExporter
+*/
+type ChangeAgentOKBodyRDSExporter struct {
+	// Unique randomly generated instance identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Node identifier.
+	NodeID string `json:"node_id,omitempty"`
+
+	// AWS Access Key.
+	AWSAccessKey string `json:"aws_access_key,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Listen port for scraping metrics (the same for several configurations).
+	ListenPort int64 `json:"listen_port,omitempty"`
+
+	// Basic metrics are disabled.
+	BasicMetricsDisabled bool `json:"basic_metrics_disabled,omitempty"`
+
+	// Enhanced metrics are disabled.
+	EnhancedMetricsDisabled bool `json:"enhanced_metrics_disabled,omitempty"`
+
+	// True if exporter uses push metrics mode.
+	PushMetricsEnabled bool `json:"push_metrics_enabled,omitempty"`
+
+	// Path to exec process.
+	ProcessExecPath string `json:"process_exec_path,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// Limit of databases for auto-discovery.
+	AutoDiscoveryLimit int32 `json:"auto_discovery_limit,omitempty"`
+
+	// metrics resolutions
+	MetricsResolutions *ChangeAgentOKBodyRDSExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+}
+
+// Validate validates this change agent OK body RDS exporter
+func (o *ChangeAgentOKBodyRDSExporter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMetricsResolutions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var changeAgentOkBodyRdsExporterTypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		changeAgentOkBodyRdsExporterTypeStatusPropEnum = append(changeAgentOkBodyRdsExporterTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ChangeAgentOKBodyRDSExporterStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *ChangeAgentOKBodyRDSExporter) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyRdsExporterTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ChangeAgentOKBodyRDSExporter) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("changeAgentOk"+"."+"rds_exporter"+"."+"status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var changeAgentOkBodyRdsExporterTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		changeAgentOkBodyRdsExporterTypeLogLevelPropEnum = append(changeAgentOkBodyRdsExporterTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentOKBodyRDSExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *ChangeAgentOKBodyRDSExporter) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyRdsExporterTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ChangeAgentOKBodyRDSExporter) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("changeAgentOk"+"."+"rds_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentOKBodyRDSExporter) validateMetricsResolutions(formats strfmt.Registry) error {
+	if swag.IsZero(o.MetricsResolutions) { // not required
+		return nil
+	}
+
+	if o.MetricsResolutions != nil {
+		if err := o.MetricsResolutions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "rds_exporter" + "." + "metrics_resolutions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "rds_exporter" + "." + "metrics_resolutions")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this change agent OK body RDS exporter based on the context it is used
+func (o *ChangeAgentOKBodyRDSExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ChangeAgentOKBodyRDSExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+	if o.MetricsResolutions != nil {
+
+		if swag.IsZero(o.MetricsResolutions) { // not required
+			return nil
+		}
+
+		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "rds_exporter" + "." + "metrics_resolutions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "rds_exporter" + "." + "metrics_resolutions")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentOKBodyRDSExporter) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentOKBodyRDSExporter) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentOKBodyRDSExporter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentOKBodyRDSExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ChangeAgentOKBodyRDSExporterMetricsResolutions
+*/
+type ChangeAgentOKBodyRDSExporterMetricsResolutions struct {
+	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Hr string `json:"hr,omitempty"`
+
+	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Mr string `json:"mr,omitempty"`
+
+	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Lr string `json:"lr,omitempty"`
+}
+
+// Validate validates this change agent OK body RDS exporter metrics resolutions
+func (o *ChangeAgentOKBodyRDSExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this change agent OK body RDS exporter metrics resolutions based on context it is used
+func (o *ChangeAgentOKBodyRDSExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentOKBodyRDSExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentOKBodyRDSExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentOKBodyRDSExporterMetricsResolutions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentOKBodyRtaMongodbAgent RTAMongoDBAgent runs within pmm-agent and sends MongoDB Real-Time Query Analytics data to the PMM Server.
+swagger:model ChangeAgentOKBodyRtaMongodbAgent
+*/
+type ChangeAgentOKBodyRtaMongodbAgent struct {
+	// Unique agent identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// MongoDB username for getting profiler data.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// rta options
+	RtaOptions *ChangeAgentOKBodyRtaMongodbAgentRtaOptions `json:"rta_options,omitempty"`
+}
+
+// Validate validates this change agent OK body rta mongodb agent
+func (o *ChangeAgentOKBodyRtaMongodbAgent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateRtaOptions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var changeAgentOkBodyRtaMongodbAgentTypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		changeAgentOkBodyRtaMongodbAgentTypeStatusPropEnum = append(changeAgentOkBodyRtaMongodbAgentTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ChangeAgentOKBodyRtaMongodbAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *ChangeAgentOKBodyRtaMongodbAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyRtaMongodbAgentTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ChangeAgentOKBodyRtaMongodbAgent) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("changeAgentOk"+"."+"rta_mongodb_agent"+"."+"status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var changeAgentOkBodyRtaMongodbAgentTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		changeAgentOkBodyRtaMongodbAgentTypeLogLevelPropEnum = append(changeAgentOkBodyRtaMongodbAgentTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentOKBodyRtaMongodbAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *ChangeAgentOKBodyRtaMongodbAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyRtaMongodbAgentTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ChangeAgentOKBodyRtaMongodbAgent) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("changeAgentOk"+"."+"rta_mongodb_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentOKBodyRtaMongodbAgent) validateRtaOptions(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaOptions) { // not required
+		return nil
+	}
+
+	if o.RtaOptions != nil {
+		if err := o.RtaOptions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "rta_mongodb_agent" + "." + "rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "rta_mongodb_agent" + "." + "rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this change agent OK body rta mongodb agent based on the context it is used
+func (o *ChangeAgentOKBodyRtaMongodbAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateRtaOptions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ChangeAgentOKBodyRtaMongodbAgent) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaOptions != nil {
+
+		if swag.IsZero(o.RtaOptions) { // not required
+			return nil
+		}
+
+		if err := o.RtaOptions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "rta_mongodb_agent" + "." + "rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "rta_mongodb_agent" + "." + "rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentOKBodyRtaMongodbAgent) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentOKBodyRtaMongodbAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentOKBodyRtaMongodbAgent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentOKBodyRtaMongodbAgentRtaOptions RTAOptions holds Real-Time Query Analytics agent options.
+swagger:model ChangeAgentOKBodyRtaMongodbAgentRtaOptions
+*/
+type ChangeAgentOKBodyRtaMongodbAgentRtaOptions struct {
+	// Query collect interval (default 2s is set by server).
+	CollectInterval string `json:"collect_interval,omitempty"`
+}
+
+// Validate validates this change agent OK body rta mongodb agent rta options
+func (o *ChangeAgentOKBodyRtaMongodbAgentRtaOptions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this change agent OK body rta mongodb agent rta options based on context it is used
+func (o *ChangeAgentOKBodyRtaMongodbAgentRtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentOKBodyRtaMongodbAgentRtaOptions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentOKBodyRtaMongodbAgentRtaOptions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentOKBodyRtaMongodbAgentRtaOptions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentOKBodyRtaMysqlAgent RTAMySQLAgent runs within pmm-agent and sends MySQL Real-Time Query Analytics data to the PMM Server.
+swagger:model ChangeAgentOKBodyRtaMysqlAgent
+*/
+type ChangeAgentOKBodyRtaMysqlAgent struct {
+	// Unique agent identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// MySQL username for getting currently running queries.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// rta options
+	RtaOptions *ChangeAgentOKBodyRtaMysqlAgentRtaOptions `json:"rta_options,omitempty"`
+}
+
+// Validate validates this change agent OK body rta mysql agent
+func (o *ChangeAgentOKBodyRtaMysqlAgent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateRtaOptions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var changeAgentOkBodyRtaMysqlAgentTypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		changeAgentOkBodyRtaMysqlAgentTypeStatusPropEnum = append(changeAgentOkBodyRtaMysqlAgentTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ChangeAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ChangeAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// ChangeAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ChangeAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// ChangeAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ChangeAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// ChangeAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ChangeAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// ChangeAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ChangeAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// ChangeAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ChangeAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// ChangeAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ChangeAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// ChangeAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ChangeAgentOKBodyRtaMysqlAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *ChangeAgentOKBodyRtaMysqlAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyRtaMysqlAgentTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ChangeAgentOKBodyRtaMysqlAgent) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("changeAgentOk"+"."+"rta_mysql_agent"+"."+"status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var changeAgentOkBodyRtaMysqlAgentTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		changeAgentOkBodyRtaMysqlAgentTypeLogLevelPropEnum = append(changeAgentOkBodyRtaMysqlAgentTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ChangeAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// ChangeAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// ChangeAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// ChangeAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// ChangeAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// ChangeAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentOKBodyRtaMysqlAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *ChangeAgentOKBodyRtaMysqlAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyRtaMysqlAgentTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ChangeAgentOKBodyRtaMysqlAgent) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("changeAgentOk"+"."+"rta_mysql_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentOKBodyRtaMysqlAgent) validateRtaOptions(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaOptions) { // not required
+		return nil
+	}
+
+	if o.RtaOptions != nil {
+		if err := o.RtaOptions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "rta_mysql_agent" + "." + "rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "rta_mysql_agent" + "." + "rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this change agent OK body rta mysql agent based on the context it is used
+func (o *ChangeAgentOKBodyRtaMysqlAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateRtaOptions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ChangeAgentOKBodyRtaMysqlAgent) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaOptions != nil {
+
+		if swag.IsZero(o.RtaOptions) { // not required
+			return nil
+		}
+
+		if err := o.RtaOptions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "rta_mysql_agent" + "." + "rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "rta_mysql_agent" + "." + "rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentOKBodyRtaMysqlAgent) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentOKBodyRtaMysqlAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentOKBodyRtaMysqlAgent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentOKBodyRtaMysqlAgentRtaOptions RTAOptions holds Real-Time Query Analytics agent options.
+swagger:model ChangeAgentOKBodyRtaMysqlAgentRtaOptions
+*/
+type ChangeAgentOKBodyRtaMysqlAgentRtaOptions struct {
+	// Query collect interval (default 2s is set by server).
+	CollectInterval string `json:"collect_interval,omitempty"`
+}
+
+// Validate validates this change agent OK body rta mysql agent rta options
+func (o *ChangeAgentOKBodyRtaMysqlAgentRtaOptions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this change agent OK body rta mysql agent rta options based on context it is used
+func (o *ChangeAgentOKBodyRtaMysqlAgentRtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentOKBodyRtaMysqlAgentRtaOptions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentOKBodyRtaMysqlAgentRtaOptions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentOKBodyRtaMysqlAgentRtaOptions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentOKBodyRtaPostgresqlAgent RTAPostgreSQLAgent runs within pmm-agent and sends PostgreSQL Real-Time Query Analytics data to the PMM Server.
+swagger:model ChangeAgentOKBodyRtaPostgresqlAgent
+*/
+type ChangeAgentOKBodyRtaPostgresqlAgent struct {
+	// Unique agent identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// PostgreSQL username for getting currently running queries.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// rta options
+	RtaOptions *ChangeAgentOKBodyRtaPostgresqlAgentRtaOptions `json:"rta_options,omitempty"`
+}
+
+// Validate validates this change agent OK body rta postgresql agent
+func (o *ChangeAgentOKBodyRtaPostgresqlAgent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateRtaOptions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var changeAgentOkBodyRtaPostgresqlAgentTypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		changeAgentOkBodyRtaPostgresqlAgentTypeStatusPropEnum = append(changeAgentOkBodyRtaPostgresqlAgentTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ChangeAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ChangeAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// ChangeAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ChangeAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// ChangeAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ChangeAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// ChangeAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ChangeAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// ChangeAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ChangeAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// ChangeAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ChangeAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// ChangeAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ChangeAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// ChangeAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ChangeAgentOKBodyRtaPostgresqlAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *ChangeAgentOKBodyRtaPostgresqlAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyRtaPostgresqlAgentTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ChangeAgentOKBodyRtaPostgresqlAgent) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("changeAgentOk"+"."+"rta_postgresql_agent"+"."+"status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var changeAgentOkBodyRtaPostgresqlAgentTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		changeAgentOkBodyRtaPostgresqlAgentTypeLogLevelPropEnum = append(changeAgentOkBodyRtaPostgresqlAgentTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ChangeAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// ChangeAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// ChangeAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// ChangeAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// ChangeAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// ChangeAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentOKBodyRtaPostgresqlAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *ChangeAgentOKBodyRtaPostgresqlAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyRtaPostgresqlAgentTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ChangeAgentOKBodyRtaPostgresqlAgent) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("changeAgentOk"+"."+"rta_postgresql_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentOKBodyRtaPostgresqlAgent) validateRtaOptions(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaOptions) { // not required
+		return nil
+	}
+
+	if o.RtaOptions != nil {
+		if err := o.RtaOptions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "rta_postgresql_agent" + "." + "rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "rta_postgresql_agent" + "." + "rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this change agent OK body rta postgresql agent based on the context it is used
+func (o *ChangeAgentOKBodyRtaPostgresqlAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateRtaOptions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ChangeAgentOKBodyRtaPostgresqlAgent) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaOptions != nil {
+
+		if swag.IsZero(o.RtaOptions) { // not required
+			return nil
+		}
+
+		if err := o.RtaOptions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "rta_postgresql_agent" + "." + "rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "rta_postgresql_agent" + "." + "rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentOKBodyRtaPostgresqlAgent) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentOKBodyRtaPostgresqlAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentOKBodyRtaPostgresqlAgent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentOKBodyRtaPostgresqlAgentRtaOptions RTAOptions holds Real-Time Query Analytics agent options.
+swagger:model ChangeAgentOKBodyRtaPostgresqlAgentRtaOptions
+*/
+type ChangeAgentOKBodyRtaPostgresqlAgentRtaOptions struct {
+	// Query collect interval (default 2s is set by server).
+	CollectInterval string `json:"collect_interval,omitempty"`
+}
+
+// Validate validates this change agent OK body rta postgresql agent rta options
+func (o *ChangeAgentOKBodyRtaPostgresqlAgentRtaOptions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this change agent OK body rta postgresql agent rta options based on context it is used
+func (o *ChangeAgentOKBodyRtaPostgresqlAgentRtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentOKBodyRtaPostgresqlAgentRtaOptions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentOKBodyRtaPostgresqlAgentRtaOptions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentOKBodyRtaPostgresqlAgentRtaOptions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentOKBodyValkeyExporter ValkeyExporter runs on Generic or Container Node and exposes Valkey Service metrics.
+swagger:model ChangeAgentOKBodyValkeyExporter
+*/
+type ChangeAgentOKBodyValkeyExporter struct {
+	// Unique randomly generated instance identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// Valkey username for scraping metrics.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname verification.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// True if exporter uses push metrics mode.
+	PushMetricsEnabled bool `json:"push_metrics_enabled,omitempty"`
+
+	// List of disabled collector names.
+	DisabledCollectors []string `json:"disabled_collectors"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Listen port for scraping metrics.
+	ListenPort int64 `json:"listen_port,omitempty"`
+
+	// Path to exec process.
+	ProcessExecPath string `json:"process_exec_path,omitempty"`
+
+	// Optionally expose the exporter process on all public interfaces
+	ExposeExporter bool `json:"expose_exporter,omitempty"`
+
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout string `json:"connection_timeout,omitempty"`
+
+	// metrics resolutions
+	MetricsResolutions *ChangeAgentOKBodyValkeyExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+}
+
+// Validate validates this change agent OK body valkey exporter
+func (o *ChangeAgentOKBodyValkeyExporter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMetricsResolutions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var changeAgentOkBodyValkeyExporterTypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		changeAgentOkBodyValkeyExporterTypeStatusPropEnum = append(changeAgentOkBodyValkeyExporterTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *ChangeAgentOKBodyValkeyExporter) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentOkBodyValkeyExporterTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ChangeAgentOKBodyValkeyExporter) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("changeAgentOk"+"."+"valkey_exporter"+"."+"status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentOKBodyValkeyExporter) validateMetricsResolutions(formats strfmt.Registry) error {
+	if swag.IsZero(o.MetricsResolutions) { // not required
+		return nil
+	}
+
+	if o.MetricsResolutions != nil {
+		if err := o.MetricsResolutions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "valkey_exporter" + "." + "metrics_resolutions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "valkey_exporter" + "." + "metrics_resolutions")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this change agent OK body valkey exporter based on the context it is used
+func (o *ChangeAgentOKBodyValkeyExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ChangeAgentOKBodyValkeyExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+	if o.MetricsResolutions != nil {
+
+		if swag.IsZero(o.MetricsResolutions) { // not required
+			return nil
+		}
+
+		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("changeAgentOk" + "." + "valkey_exporter" + "." + "metrics_resolutions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("changeAgentOk" + "." + "valkey_exporter" + "." + "metrics_resolutions")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentOKBodyValkeyExporter) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentOKBodyValkeyExporter) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentOKBodyValkeyExporter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentOKBodyValkeyExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ChangeAgentOKBodyValkeyExporterMetricsResolutions
+*/
+type ChangeAgentOKBodyValkeyExporterMetricsResolutions struct {
+	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Hr string `json:"hr,omitempty"`
+
+	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Mr string `json:"mr,omitempty"`
+
+	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Lr string `json:"lr,omitempty"`
+}
+
+// Validate validates this change agent OK body valkey exporter metrics resolutions
+func (o *ChangeAgentOKBodyValkeyExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this change agent OK body valkey exporter metrics resolutions based on context it is used
+func (o *ChangeAgentOKBodyValkeyExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentOKBodyValkeyExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentOKBodyValkeyExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentOKBodyValkeyExporterMetricsResolutions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentParamsBodyAzureDatabaseExporter change agent params body azure database exporter
+swagger:model ChangeAgentParamsBodyAzureDatabaseExporter
+*/
+type ChangeAgentParamsBodyAzureDatabaseExporter struct {
+	// Enable this Agent. Agents are enabled by default when they get added.
+	Enable *bool `json:"enable,omitempty"`
+
+	// Enables push metrics with vmagent.
+	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
+
+	// Azure client ID
+	AzureClientID *string `json:"azure_client_id,omitempty"`
+
+	// Azure client secret
+	AzureClientSecret *string `json:"azure_client_secret,omitempty"`
+
+	// Azure tenant ID
+	AzureTenantID *string `json:"azure_tenant_id,omitempty"`
+
+	// Azure subscription ID
+	AzureSubscriptionID *string `json:"azure_subscription_id,omitempty"`
+
+	// Azure resource group.
+	AzureResourceGroup *string `json:"azure_resource_group,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// custom labels
+	CustomLabels *ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels `json:"custom_labels,omitempty"`
+
+	// metrics resolutions
+	MetricsResolutions *ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+}
+
+// Validate validates this change agent params body azure database exporter
+func (o *ChangeAgentParamsBodyAzureDatabaseExporter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateCustomLabels(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMetricsResolutions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var changeAgentParamsBodyAzureDatabaseExporterTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		changeAgentParamsBodyAzureDatabaseExporterTypeLogLevelPropEnum = append(changeAgentParamsBodyAzureDatabaseExporterTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *ChangeAgentParamsBodyAzureDatabaseExporter) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyAzureDatabaseExporterTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyAzureDatabaseExporter) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("body"+"."+"azure_database_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyAzureDatabaseExporter) validateCustomLabels(formats strfmt.Registry) error {
+	if swag.IsZero(o.CustomLabels) { // not required
+		return nil
+	}
+
+	if o.CustomLabels != nil {
+		if err := o.CustomLabels.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "azure_database_exporter" + "." + "custom_labels")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "azure_database_exporter" + "." + "custom_labels")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyAzureDatabaseExporter) validateMetricsResolutions(formats strfmt.Registry) error {
+	if swag.IsZero(o.MetricsResolutions) { // not required
+		return nil
+	}
+
+	if o.MetricsResolutions != nil {
+		if err := o.MetricsResolutions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "azure_database_exporter" + "." + "metrics_resolutions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "azure_database_exporter" + "." + "metrics_resolutions")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this change agent params body azure database exporter based on the context it is used
+func (o *ChangeAgentParamsBodyAzureDatabaseExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyAzureDatabaseExporter) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+	if o.CustomLabels != nil {
+
+		if swag.IsZero(o.CustomLabels) { // not required
+			return nil
+		}
+
+		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "azure_database_exporter" + "." + "custom_labels")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "azure_database_exporter" + "." + "custom_labels")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyAzureDatabaseExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+	if o.MetricsResolutions != nil {
+
+		if swag.IsZero(o.MetricsResolutions) { // not required
+			return nil
+		}
+
+		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "azure_database_exporter" + "." + "metrics_resolutions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "azure_database_exporter" + "." + "metrics_resolutions")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyAzureDatabaseExporter) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyAzureDatabaseExporter) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyAzureDatabaseExporter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels
+*/
+type ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels struct {
+	// values
+	Values map[string]string `json:"values,omitempty"`
+}
+
+// Validate validates this change agent params body azure database exporter custom labels
+func (o *ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this change agent params body azure database exporter custom labels based on context it is used
+func (o *ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions
+*/
+type ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions struct {
+	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Hr string `json:"hr,omitempty"`
+
+	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Mr string `json:"mr,omitempty"`
+
+	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Lr string `json:"lr,omitempty"`
+}
+
+// Validate validates this change agent params body azure database exporter metrics resolutions
+func (o *ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this change agent params body azure database exporter metrics resolutions based on context it is used
+func (o *ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentParamsBodyExternalExporter change agent params body external exporter
+swagger:model ChangeAgentParamsBodyExternalExporter
+*/
+type ChangeAgentParamsBodyExternalExporter struct {
+	// Enable this Agent. Agents are enabled by default when they get added.
+	Enable *bool `json:"enable,omitempty"`
+
+	// Enables push metrics with vmagent.
+	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
+
+	// HTTP basic auth username for collecting metrics.
+	Username *string `json:"username,omitempty"`
+
+	// Scheme to generate URI to exporter metrics endpoints.
+	Scheme *string `json:"scheme,omitempty"`
+
+	// Path under which metrics are exposed, used to generate URI.
+	MetricsPath *string `json:"metrics_path,omitempty"`
+
+	// Listen port for scraping metrics.
+	ListenPort *int64 `json:"listen_port,omitempty"`
+
+	// Skip connection check.
+	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
+
+	// custom labels
+	CustomLabels *ChangeAgentParamsBodyExternalExporterCustomLabels `json:"custom_labels,omitempty"`
+
+	// metrics resolutions
+	MetricsResolutions *ChangeAgentParamsBodyExternalExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+}
+
+// Validate validates this change agent params body external exporter
+func (o *ChangeAgentParamsBodyExternalExporter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateCustomLabels(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMetricsResolutions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyExternalExporter) validateCustomLabels(formats strfmt.Registry) error {
+	if swag.IsZero(o.CustomLabels) { // not required
+		return nil
+	}
+
+	if o.CustomLabels != nil {
+		if err := o.CustomLabels.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "external_exporter" + "." + "custom_labels")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "external_exporter" + "." + "custom_labels")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyExternalExporter) validateMetricsResolutions(formats strfmt.Registry) error {
+	if swag.IsZero(o.MetricsResolutions) { // not required
+		return nil
+	}
+
+	if o.MetricsResolutions != nil {
+		if err := o.MetricsResolutions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "external_exporter" + "." + "metrics_resolutions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "external_exporter" + "." + "metrics_resolutions")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this change agent params body external exporter based on the context it is used
+func (o *ChangeAgentParamsBodyExternalExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyExternalExporter) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+	if o.CustomLabels != nil {
+
+		if swag.IsZero(o.CustomLabels) { // not required
+			return nil
+		}
+
+		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "external_exporter" + "." + "custom_labels")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "external_exporter" + "." + "custom_labels")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyExternalExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+	if o.MetricsResolutions != nil {
+
+		if swag.IsZero(o.MetricsResolutions) { // not required
+			return nil
+		}
+
+		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "external_exporter" + "." + "metrics_resolutions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "external_exporter" + "." + "metrics_resolutions")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyExternalExporter) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyExternalExporter) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyExternalExporter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentParamsBodyExternalExporterCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyExternalExporterCustomLabels
+*/
+type ChangeAgentParamsBodyExternalExporterCustomLabels struct {
+	// values
+	Values map[string]string `json:"values,omitempty"`
+}
+
+// Validate validates this change agent params body external exporter custom labels
+func (o *ChangeAgentParamsBodyExternalExporterCustomLabels) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this change agent params body external exporter custom labels based on context it is used
+func (o *ChangeAgentParamsBodyExternalExporterCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyExternalExporterCustomLabels) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyExternalExporterCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyExternalExporterCustomLabels
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentParamsBodyExternalExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ChangeAgentParamsBodyExternalExporterMetricsResolutions
+*/
+type ChangeAgentParamsBodyExternalExporterMetricsResolutions struct {
+	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Hr string `json:"hr,omitempty"`
+
+	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Mr string `json:"mr,omitempty"`
+
+	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Lr string `json:"lr,omitempty"`
+}
+
+// Validate validates this change agent params body external exporter metrics resolutions
+func (o *ChangeAgentParamsBodyExternalExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this change agent params body external exporter metrics resolutions based on context it is used
+func (o *ChangeAgentParamsBodyExternalExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyExternalExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyExternalExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyExternalExporterMetricsResolutions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentParamsBodyMongodbExporter change agent params body mongodb exporter
+swagger:model ChangeAgentParamsBodyMongodbExporter
+*/
+type ChangeAgentParamsBodyMongodbExporter struct {
+	// Enable this Agent. Agents are enabled by default when they get added.
+	Enable *bool `json:"enable,omitempty"`
+
+	// Enables push metrics with vmagent.
+	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
+
+	// MongoDB username for scraping metrics.
+	Username *string `json:"username,omitempty"`
+
+	// MongoDB password for scraping metrics.
+	Password *string `json:"password,omitempty"`
+
+	// Use TLS for database connections.
+	TLS *bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify *bool `json:"tls_skip_verify,omitempty"`
+
+	// Client certificate and key.
+	TLSCertificateKey *string `json:"tls_certificate_key,omitempty"`
+
+	// Password for decrypting tls_certificate_key.
+	TLSCertificateKeyFilePassword *string `json:"tls_certificate_key_file_password,omitempty"`
+
+	// Certificate Authority certificate chain.
+	TLSCa *string `json:"tls_ca,omitempty"`
+
+	// Skip connection check.
+	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
+
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `json:"disable_collectors"`
+
+	// Authentication mechanism.
+	AuthenticationMechanism *string `json:"authentication_mechanism,omitempty"`
+
+	// Authentication database.
+	AuthenticationDatabase *string `json:"authentication_database,omitempty"`
+
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword *string `json:"agent_password,omitempty"`
+
+	// List of collections to get stats from. Can use *
+	StatsCollections []string `json:"stats_collections"`
+
+	// Collections limit. Only get Databases and collection stats if the total number of collections in the server is less than this value. 0: no limit
+	CollectionsLimit *int32 `json:"collections_limit,omitempty"`
+
+	// Enable all collectors.
+	EnableAllCollectors *bool `json:"enable_all_collectors,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// Optionally expose the exporter process on all public interfaces.
+	ExposeExporter *bool `json:"expose_exporter,omitempty"`
+
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout string `json:"connection_timeout,omitempty"`
+
+	// Enable collecting histogram bucket metrics from getDiagnosticData.
+	EnableDiagnosticDataHistograms *bool `json:"enable_diagnostic_data_histograms,omitempty"`
+
+	// custom labels
+	CustomLabels *ChangeAgentParamsBodyMongodbExporterCustomLabels `json:"custom_labels,omitempty"`
+
+	// metrics resolutions
+	MetricsResolutions *ChangeAgentParamsBodyMongodbExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+}
+
+// Validate validates this change agent params body mongodb exporter
+func (o *ChangeAgentParamsBodyMongodbExporter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateCustomLabels(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMetricsResolutions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var changeAgentParamsBodyMongodbExporterTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		changeAgentParamsBodyMongodbExporterTypeLogLevelPropEnum = append(changeAgentParamsBodyMongodbExporterTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *ChangeAgentParamsBodyMongodbExporter) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyMongodbExporterTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyMongodbExporter) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("body"+"."+"mongodb_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyMongodbExporter) validateCustomLabels(formats strfmt.Registry) error {
+	if swag.IsZero(o.CustomLabels) { // not required
+		return nil
+	}
+
+	if o.CustomLabels != nil {
+		if err := o.CustomLabels.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "mongodb_exporter" + "." + "custom_labels")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "mongodb_exporter" + "." + "custom_labels")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyMongodbExporter) validateMetricsResolutions(formats strfmt.Registry) error {
+	if swag.IsZero(o.MetricsResolutions) { // not required
+		return nil
+	}
+
+	if o.MetricsResolutions != nil {
+		if err := o.MetricsResolutions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "mongodb_exporter" + "." + "metrics_resolutions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "mongodb_exporter" + "." + "metrics_resolutions")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this change agent params body mongodb exporter based on the context it is used
+func (o *ChangeAgentParamsBodyMongodbExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyMongodbExporter) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+	if o.CustomLabels != nil {
+
+		if swag.IsZero(o.CustomLabels) { // not required
+			return nil
+		}
+
+		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "mongodb_exporter" + "." + "custom_labels")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "mongodb_exporter" + "." + "custom_labels")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyMongodbExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+	if o.MetricsResolutions != nil {
+
+		if swag.IsZero(o.MetricsResolutions) { // not required
+			return nil
+		}
+
+		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "mongodb_exporter" + "." + "metrics_resolutions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "mongodb_exporter" + "." + "metrics_resolutions")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyMongodbExporter) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyMongodbExporter) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyMongodbExporter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentParamsBodyMongodbExporterCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyMongodbExporterCustomLabels
+*/
+type ChangeAgentParamsBodyMongodbExporterCustomLabels struct {
+	// values
+	Values map[string]string `json:"values,omitempty"`
+}
+
+// Validate validates this change agent params body mongodb exporter custom labels
+func (o *ChangeAgentParamsBodyMongodbExporterCustomLabels) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this change agent params body mongodb exporter custom labels based on context it is used
+func (o *ChangeAgentParamsBodyMongodbExporterCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyMongodbExporterCustomLabels) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyMongodbExporterCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyMongodbExporterCustomLabels
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentParamsBodyMongodbExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ChangeAgentParamsBodyMongodbExporterMetricsResolutions
+*/
+type ChangeAgentParamsBodyMongodbExporterMetricsResolutions struct {
+	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Hr string `json:"hr,omitempty"`
+
+	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Mr string `json:"mr,omitempty"`
+
+	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Lr string `json:"lr,omitempty"`
+}
+
+// Validate validates this change agent params body mongodb exporter metrics resolutions
+func (o *ChangeAgentParamsBodyMongodbExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this change agent params body mongodb exporter metrics resolutions based on context it is used
+func (o *ChangeAgentParamsBodyMongodbExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyMongodbExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyMongodbExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyMongodbExporterMetricsResolutions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentParamsBodyMysqldExporter change agent params body mysqld exporter
+swagger:model ChangeAgentParamsBodyMysqldExporter
+*/
+type ChangeAgentParamsBodyMysqldExporter struct {
+	// Enable this Agent. Agents are enabled by default when they get added.
+	Enable *bool `json:"enable,omitempty"`
+
+	// Enables push metrics with vmagent.
+	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
+
+	// MySQL username for scraping metrics.
+	Username *string `json:"username,omitempty"`
+
+	// MySQL password for scraping metrics.
+	Password *string `json:"password,omitempty"`
+
+	// Use TLS for database connections.
+	TLS *bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify *bool `json:"tls_skip_verify,omitempty"`
+
+	// Certificate Authority certificate chain.
+	TLSCa *string `json:"tls_ca,omitempty"`
+
+	// Client certificate.
+	TLSCert *string `json:"tls_cert,omitempty"`
+
+	// Password for decrypting tls_cert.
+	TLSKey *string `json:"tls_key,omitempty"`
+
+	// Tablestats group collectors will be disabled if there are more than that number of tables.
+	TablestatsGroupTableLimit *int32 `json:"tablestats_group_table_limit,omitempty"`
+
+	// Skip connection check.
+	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
+
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `json:"disable_collectors"`
+
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword *string `json:"agent_password,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// Optionally expose the exporter process on all public interfaces.
+	ExposeExporter *bool `json:"expose_exporter,omitempty"`
+
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout string `json:"connection_timeout,omitempty"`
+
+	// custom labels
+	CustomLabels *ChangeAgentParamsBodyMysqldExporterCustomLabels `json:"custom_labels,omitempty"`
+
+	// metrics resolutions
+	MetricsResolutions *ChangeAgentParamsBodyMysqldExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+}
+
+// Validate validates this change agent params body mysqld exporter
+func (o *ChangeAgentParamsBodyMysqldExporter) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateCustomLabels(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMetricsResolutions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var changeAgentParamsBodyMysqldExporterTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		changeAgentParamsBodyMysqldExporterTypeLogLevelPropEnum = append(changeAgentParamsBodyMysqldExporterTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *ChangeAgentParamsBodyMysqldExporter) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyMysqldExporterTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyMysqldExporter) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("body"+"."+"mysqld_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyMysqldExporter) validateCustomLabels(formats strfmt.Registry) error {
+	if swag.IsZero(o.CustomLabels) { // not required
+		return nil
+	}
+
+	if o.CustomLabels != nil {
+		if err := o.CustomLabels.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "mysqld_exporter" + "." + "custom_labels")
+			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("changeAgentOk" + "." + "rta_mongodb_agent" + "." + "rta_options")
+				return ce.ValidateName("body" + "." + "mysqld_exporter" + "." + "custom_labels")
 			}
 
 			return err
@@ -6673,11 +9963,38 @@ func (o *ChangeAgentOKBodyRtaMongodbAgent) validateRtaOptions(formats strfmt.Reg
 	return nil
 }
 
-// ContextValidate validate this change agent OK body rta mongodb agent based on the context it is used
-func (o *ChangeAgentOKBodyRtaMongodbAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyMysqldExporter) validateMetricsResolutions(formats strfmt.Registry) error {
+	if swag.IsZero(o.MetricsResolutions) { // not required
+		return nil
+	}
+
+	if o.MetricsResolutions != nil {
+		if err := o.MetricsResolutions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "mysqld_exporter" + "." + "metrics_resolutions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "mysqld_exporter" + "." + "metrics_resolutions")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this change agent params body mysqld exporter based on the context it is used
+func (o *ChangeAgentParamsBodyMysqldExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
-	if err := o.contextValidateRtaOptions(ctx, formats); err != nil {
+	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -6687,21 +10004,45 @@ func (o *ChangeAgentOKBodyRtaMongodbAgent) ContextValidate(ctx context.Context,
 	return nil
 }
 
-func (o *ChangeAgentOKBodyRtaMongodbAgent) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
-	if o.RtaOptions != nil {
+func (o *ChangeAgentParamsBodyMysqldExporter) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+	if o.CustomLabels != nil {
 
-		if swag.IsZero(o.RtaOptions) { // not required
+		if swag.IsZero(o.CustomLabels) { // not required
 			return nil
 		}
 
-		if err := o.RtaOptions.ContextValidate(ctx, formats); err != nil {
+		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("changeAgentOk" + "." + "rta_mongodb_agent" + "." + "rta_options")
+				return ve.ValidateName("body" + "." + "mysqld_exporter" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("changeAgentOk" + "." + "rta_mongodb_agent" + "." + "rta_options")
+				return ce.ValidateName("body" + "." + "mysqld_exporter" + "." + "custom_labels")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyMysqldExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+	if o.MetricsResolutions != nil {
+
+		if swag.IsZero(o.MetricsResolutions) { // not required
+			return nil
+		}
+
+		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "mysqld_exporter" + "." + "metrics_resolutions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "mysqld_exporter" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -6712,7 +10053,7 @@ func (o *ChangeAgentOKBodyRtaMongodbAgent) contextValidateRtaOptions(ctx context
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentOKBodyRtaMongodbAgent) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyMysqldExporter) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -6720,8 +10061,45 @@ func (o *ChangeAgentOKBodyRtaMongodbAgent) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentOKBodyRtaMongodbAgent) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentOKBodyRtaMongodbAgent
+func (o *ChangeAgentParamsBodyMysqldExporter) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyMysqldExporter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentParamsBodyMysqldExporterCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyMysqldExporterCustomLabels
+*/
+type ChangeAgentParamsBodyMysqldExporterCustomLabels struct {
+	// values
+	Values map[string]string `json:"values,omitempty"`
+}
+
+// Validate validates this change agent params body mysqld exporter custom labels
+func (o *ChangeAgentParamsBodyMysqldExporterCustomLabels) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this change agent params body mysqld exporter custom labels based on context it is used
+func (o *ChangeAgentParamsBodyMysqldExporterCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyMysqldExporterCustomLabels) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyMysqldExporterCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyMysqldExporterCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -6730,26 +10108,32 @@ func (o *ChangeAgentOKBodyRtaMongodbAgent) UnmarshalBinary(b []byte) error {
 }
 
 /*
-ChangeAgentOKBodyRtaMongodbAgentRtaOptions RTAOptions holds Real-Time Query Analytics agent options.
-swagger:model ChangeAgentOKBodyRtaMongodbAgentRtaOptions
+ChangeAgentParamsBodyMysqldExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ChangeAgentParamsBodyMysqldExporterMetricsResolutions
 */
-type ChangeAgentOKBodyRtaMongodbAgentRtaOptions struct {
-	// Query collect interval (default 2s is set by server).
-	CollectInterval string `json:"collect_interval,omitempty"`
+type ChangeAgentParamsBodyMysqldExporterMetricsResolutions struct {
+	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Hr string `json:"hr,omitempty"`
+
+	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Mr string `json:"mr,omitempty"`
+
+	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Lr string `json:"lr,omitempty"`
 }
 
-// Validate validates this change agent OK body rta mongodb agent rta options
-func (o *ChangeAgentOKBodyRtaMongodbAgentRtaOptions) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body mysqld exporter metrics resolutions
+func (o *ChangeAgentParamsBodyMysqldExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent OK body rta mongodb agent rta options based on context it is used
-func (o *ChangeAgentOKBodyRtaMongodbAgentRtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body mysqld exporter metrics resolutions based on context it is used
+func (o *ChangeAgentParamsBodyMysqldExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentOKBodyRtaMongodbAgentRtaOptions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyMysqldExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -6757,8 +10141,8 @@ func (o *ChangeAgentOKBodyRtaMongodbAgentRtaOptions) MarshalBinary() ([]byte, er
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentOKBodyRtaMongodbAgentRtaOptions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentOKBodyRtaMongodbAgentRtaOptions
+func (o *ChangeAgentParamsBodyMysqldExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyMysqldExporterMetricsResolutions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -6767,73 +10151,44 @@ func (o *ChangeAgentOKBodyRtaMongodbAgentRtaOptions) UnmarshalBinary(b []byte) e
 }
 
 /*
-ChangeAgentOKBodyValkeyExporter ValkeyExporter runs on Generic or Container Node and exposes Valkey Service metrics.
-swagger:model ChangeAgentOKBodyValkeyExporter
+ChangeAgentParamsBodyNodeExporter change agent params body node exporter
+swagger:model ChangeAgentParamsBodyNodeExporter
 */
-type ChangeAgentOKBodyValkeyExporter struct {
-	// Unique randomly generated instance identifier.
-	AgentID string `json:"agent_id,omitempty"`
-
-	// The pmm-agent identifier which runs this instance.
-	PMMAgentID string `json:"pmm_agent_id,omitempty"`
-
-	// Desired Agent status: enabled (false) or disabled (true).
-	Disabled bool `json:"disabled,omitempty"`
-
-	// Service identifier.
-	ServiceID string `json:"service_id,omitempty"`
-
-	// Valkey username for scraping metrics.
-	Username string `json:"username,omitempty"`
-
-	// Use TLS for database connections.
-	TLS bool `json:"tls,omitempty"`
-
-	// Skip TLS certificate and hostname verification.
-	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
-
-	// Custom user-assigned labels.
-	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+type ChangeAgentParamsBodyNodeExporter struct {
+	// Enable this Agent. Agents are enabled by default when they get added.
+	Enable *bool `json:"enable,omitempty"`
 
-	// True if exporter uses push metrics mode.
-	PushMetricsEnabled bool `json:"push_metrics_enabled,omitempty"`
+	// Enables push metrics with vmagent.
+	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
 
-	// List of disabled collector names.
-	DisabledCollectors []string `json:"disabled_collectors"`
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `json:"disable_collectors"`
 
-	// AgentStatus represents actual Agent status.
+	// Log level for exporters
 	//
-	//  - AGENT_STATUS_STARTING: Agent is starting.
-	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
-	//  - AGENT_STATUS_RUNNING: Agent is running.
-	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
-	//  - AGENT_STATUS_STOPPING: Agent is stopping.
-	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
-	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
-	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
-	Status *string `json:"status,omitempty"`
-
-	// Listen port for scraping metrics.
-	ListenPort int64 `json:"listen_port,omitempty"`
-
-	// Path to exec process.
-	ProcessExecPath string `json:"process_exec_path,omitempty"`
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
 
-	// Optionally expose the exporter process on all public interfaces
-	ExposeExporter bool `json:"expose_exporter,omitempty"`
+	// Expose the node_exporter process on all public interfaces.
+	ExposeExporter *bool `json:"expose_exporter,omitempty"`
 
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout string `json:"connection_timeout,omitempty"`
+	// custom labels
+	CustomLabels *ChangeAgentParamsBodyNodeExporterCustomLabels `json:"custom_labels,omitempty"`
 
 	// metrics resolutions
-	MetricsResolutions *ChangeAgentOKBodyValkeyExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+	MetricsResolutions *ChangeAgentParamsBodyNodeExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
 }
 
-// Validate validates this change agent OK body valkey exporter
-func (o *ChangeAgentOKBodyValkeyExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body node exporter
+func (o *ChangeAgentParamsBodyNodeExporter) Validate(formats strfmt.Registry) error {
 	var res []error
 
-	if err := o.validateStatus(formats); err != nil {
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateCustomLabels(formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -6847,67 +10202,84 @@ func (o *ChangeAgentOKBodyValkeyExporter) Validate(formats strfmt.Registry) erro
 	return nil
 }
 
-var changeAgentOkBodyValkeyExporterTypeStatusPropEnum []any
+var changeAgentParamsBodyNodeExporterTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentOkBodyValkeyExporterTypeStatusPropEnum = append(changeAgentOkBodyValkeyExporterTypeStatusPropEnum, v)
+		changeAgentParamsBodyNodeExporterTypeLogLevelPropEnum = append(changeAgentParamsBodyNodeExporterTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
-	ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
-
-	// ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
-	ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
-
-	// ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
-	ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+	// ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
-	ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+	// ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
-	ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+	// ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
-	ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+	// ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
-	ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+	// ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
-	ChangeAgentOKBodyValkeyExporterStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+	// ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentOKBodyValkeyExporter) validateStatusEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentOkBodyValkeyExporterTypeStatusPropEnum, true); err != nil {
+func (o *ChangeAgentParamsBodyNodeExporter) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyNodeExporterTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentOKBodyValkeyExporter) validateStatus(formats strfmt.Registry) error {
-	if swag.IsZero(o.Status) { // not required
+func (o *ChangeAgentParamsBodyNodeExporter) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateStatusEnum("changeAgentOk"+"."+"valkey_exporter"+"."+"status", "body", *o.Status); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"node_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentOKBodyValkeyExporter) validateMetricsResolutions(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyNodeExporter) validateCustomLabels(formats strfmt.Registry) error {
+	if swag.IsZero(o.CustomLabels) { // not required
+		return nil
+	}
+
+	if o.CustomLabels != nil {
+		if err := o.CustomLabels.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "node_exporter" + "." + "custom_labels")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "node_exporter" + "." + "custom_labels")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyNodeExporter) validateMetricsResolutions(formats strfmt.Registry) error {
 	if swag.IsZero(o.MetricsResolutions) { // not required
 		return nil
 	}
@@ -6916,11 +10288,11 @@ func (o *ChangeAgentOKBodyValkeyExporter) validateMetricsResolutions(formats str
 		if err := o.MetricsResolutions.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("changeAgentOk" + "." + "valkey_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "node_exporter" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("changeAgentOk" + "." + "valkey_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "node_exporter" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -6930,10 +10302,14 @@ func (o *ChangeAgentOKBodyValkeyExporter) validateMetricsResolutions(formats str
 	return nil
 }
 
-// ContextValidate validate this change agent OK body valkey exporter based on the context it is used
-func (o *ChangeAgentOKBodyValkeyExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this change agent params body node exporter based on the context it is used
+func (o *ChangeAgentParamsBodyNodeExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -6944,7 +10320,31 @@ func (o *ChangeAgentOKBodyValkeyExporter) ContextValidate(ctx context.Context, f
 	return nil
 }
 
-func (o *ChangeAgentOKBodyValkeyExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyNodeExporter) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+	if o.CustomLabels != nil {
+
+		if swag.IsZero(o.CustomLabels) { // not required
+			return nil
+		}
+
+		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "node_exporter" + "." + "custom_labels")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "node_exporter" + "." + "custom_labels")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyNodeExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
 	if o.MetricsResolutions != nil {
 
 		if swag.IsZero(o.MetricsResolutions) { // not required
@@ -6954,11 +10354,11 @@ func (o *ChangeAgentOKBodyValkeyExporter) contextValidateMetricsResolutions(ctx
 		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("changeAgentOk" + "." + "valkey_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "node_exporter" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("changeAgentOk" + "." + "valkey_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "node_exporter" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -6969,7 +10369,44 @@ func (o *ChangeAgentOKBodyValkeyExporter) contextValidateMetricsResolutions(ctx
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentOKBodyValkeyExporter) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyNodeExporter) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyNodeExporter) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyNodeExporter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentParamsBodyNodeExporterCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyNodeExporterCustomLabels
+*/
+type ChangeAgentParamsBodyNodeExporterCustomLabels struct {
+	// values
+	Values map[string]string `json:"values,omitempty"`
+}
+
+// Validate validates this change agent params body node exporter custom labels
+func (o *ChangeAgentParamsBodyNodeExporterCustomLabels) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this change agent params body node exporter custom labels based on context it is used
+func (o *ChangeAgentParamsBodyNodeExporterCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyNodeExporterCustomLabels) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -6977,8 +10414,8 @@ func (o *ChangeAgentOKBodyValkeyExporter) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentOKBodyValkeyExporter) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentOKBodyValkeyExporter
+func (o *ChangeAgentParamsBodyNodeExporterCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyNodeExporterCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -6987,10 +10424,10 @@ func (o *ChangeAgentOKBodyValkeyExporter) UnmarshalBinary(b []byte) error {
 }
 
 /*
-ChangeAgentOKBodyValkeyExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ChangeAgentOKBodyValkeyExporterMetricsResolutions
+ChangeAgentParamsBodyNodeExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ChangeAgentParamsBodyNodeExporterMetricsResolutions
 */
-type ChangeAgentOKBodyValkeyExporterMetricsResolutions struct {
+type ChangeAgentParamsBodyNodeExporterMetricsResolutions struct {
 	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
 	Hr string `json:"hr,omitempty"`
 
@@ -7001,18 +10438,18 @@ type ChangeAgentOKBodyValkeyExporterMetricsResolutions struct {
 	Lr string `json:"lr,omitempty"`
 }
 
-// Validate validates this change agent OK body valkey exporter metrics resolutions
-func (o *ChangeAgentOKBodyValkeyExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body node exporter metrics resolutions
+func (o *ChangeAgentParamsBodyNodeExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent OK body valkey exporter metrics resolutions based on context it is used
-func (o *ChangeAgentOKBodyValkeyExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body node exporter metrics resolutions based on context it is used
+func (o *ChangeAgentParamsBodyNodeExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentOKBodyValkeyExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyNodeExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7020,8 +10457,8 @@ func (o *ChangeAgentOKBodyValkeyExporterMetricsResolutions) MarshalBinary() ([]b
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentOKBodyValkeyExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentOKBodyValkeyExporterMetricsResolutions
+func (o *ChangeAgentParamsBodyNodeExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyNodeExporterMetricsResolutions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7030,30 +10467,82 @@ func (o *ChangeAgentOKBodyValkeyExporterMetricsResolutions) UnmarshalBinary(b []
 }
 
 /*
-ChangeAgentParamsBodyAzureDatabaseExporter change agent params body azure database exporter
-swagger:model ChangeAgentParamsBodyAzureDatabaseExporter
+ChangeAgentParamsBodyNomadAgent change agent params body nomad agent
+swagger:model ChangeAgentParamsBodyNomadAgent
 */
-type ChangeAgentParamsBodyAzureDatabaseExporter struct {
+type ChangeAgentParamsBodyNomadAgent struct {
+	// Enable this Agent. Agents are enabled by default when they get added.
+	Enable *bool `json:"enable,omitempty"`
+}
+
+// Validate validates this change agent params body nomad agent
+func (o *ChangeAgentParamsBodyNomadAgent) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this change agent params body nomad agent based on context it is used
+func (o *ChangeAgentParamsBodyNomadAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyNomadAgent) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ChangeAgentParamsBodyNomadAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyNomadAgent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ChangeAgentParamsBodyPostgresExporter change agent params body postgres exporter
+swagger:model ChangeAgentParamsBodyPostgresExporter
+*/
+type ChangeAgentParamsBodyPostgresExporter struct {
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `json:"enable,omitempty"`
 
 	// Enables push metrics with vmagent.
 	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
 
-	// Azure client ID
-	AzureClientID *string `json:"azure_client_id,omitempty"`
+	// PostgreSQL username for scraping metrics.
+	Username *string `json:"username,omitempty"`
 
-	// Azure client secret
-	AzureClientSecret *string `json:"azure_client_secret,omitempty"`
+	// PostgreSQL password for scraping metrics.
+	Password *string `json:"password,omitempty"`
 
-	// Azure tenant ID
-	AzureTenantID *string `json:"azure_tenant_id,omitempty"`
+	// Use TLS for database connections.
+	TLS *bool `json:"tls,omitempty"`
 
-	// Azure subscription ID
-	AzureSubscriptionID *string `json:"azure_subscription_id,omitempty"`
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify *bool `json:"tls_skip_verify,omitempty"`
 
-	// Azure resource group.
-	AzureResourceGroup *string `json:"azure_resource_group,omitempty"`
+	// Skip connection check.
+	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
+
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `json:"disable_collectors"`
+
+	// TLS CA certificate.
+	TLSCa *string `json:"tls_ca,omitempty"`
+
+	// TLS Certificate.
+	TLSCert *string `json:"tls_cert,omitempty"`
+
+	// TLS Certificate Key.
+	TLSKey *string `json:"tls_key,omitempty"`
+
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword *string `json:"agent_password,omitempty"`
 
 	// Log level for exporters
 	//
@@ -7061,15 +10550,27 @@ type ChangeAgentParamsBodyAzureDatabaseExporter struct {
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
 
+	// Limit of databases for auto-discovery.
+	AutoDiscoveryLimit *int32 `json:"auto_discovery_limit,omitempty"`
+
+	// Optionally expose the exporter process on all public interfaces.
+	ExposeExporter *bool `json:"expose_exporter,omitempty"`
+
+	// Maximum number of connections that exporter can open to the database instance.
+	MaxExporterConnections *int32 `json:"max_exporter_connections,omitempty"`
+
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout string `json:"connection_timeout,omitempty"`
+
 	// custom labels
-	CustomLabels *ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels `json:"custom_labels,omitempty"`
+	CustomLabels *ChangeAgentParamsBodyPostgresExporterCustomLabels `json:"custom_labels,omitempty"`
 
 	// metrics resolutions
-	MetricsResolutions *ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+	MetricsResolutions *ChangeAgentParamsBodyPostgresExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
 }
 
-// Validate validates this change agent params body azure database exporter
-func (o *ChangeAgentParamsBodyAzureDatabaseExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body postgres exporter
+func (o *ChangeAgentParamsBodyPostgresExporter) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -7090,7 +10591,7 @@ func (o *ChangeAgentParamsBodyAzureDatabaseExporter) Validate(formats strfmt.Reg
 	return nil
 }
 
-var changeAgentParamsBodyAzureDatabaseExporterTypeLogLevelPropEnum []any
+var changeAgentParamsBodyPostgresExporterTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -7098,53 +10599,53 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentParamsBodyAzureDatabaseExporterTypeLogLevelPropEnum = append(changeAgentParamsBodyAzureDatabaseExporterTypeLogLevelPropEnum, v)
+		changeAgentParamsBodyPostgresExporterTypeLogLevelPropEnum = append(changeAgentParamsBodyPostgresExporterTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentParamsBodyAzureDatabaseExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentParamsBodyAzureDatabaseExporter) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyAzureDatabaseExporterTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentParamsBodyPostgresExporter) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyPostgresExporterTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyAzureDatabaseExporter) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyPostgresExporter) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"azure_database_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"postgres_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyAzureDatabaseExporter) validateCustomLabels(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyPostgresExporter) validateCustomLabels(formats strfmt.Registry) error {
 	if swag.IsZero(o.CustomLabels) { // not required
 		return nil
 	}
@@ -7153,11 +10654,11 @@ func (o *ChangeAgentParamsBodyAzureDatabaseExporter) validateCustomLabels(format
 		if err := o.CustomLabels.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "azure_database_exporter" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "postgres_exporter" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "azure_database_exporter" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "postgres_exporter" + "." + "custom_labels")
 			}
 
 			return err
@@ -7167,7 +10668,7 @@ func (o *ChangeAgentParamsBodyAzureDatabaseExporter) validateCustomLabels(format
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyAzureDatabaseExporter) validateMetricsResolutions(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyPostgresExporter) validateMetricsResolutions(formats strfmt.Registry) error {
 	if swag.IsZero(o.MetricsResolutions) { // not required
 		return nil
 	}
@@ -7176,11 +10677,11 @@ func (o *ChangeAgentParamsBodyAzureDatabaseExporter) validateMetricsResolutions(
 		if err := o.MetricsResolutions.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "azure_database_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "postgres_exporter" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "azure_database_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "postgres_exporter" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -7190,8 +10691,8 @@ func (o *ChangeAgentParamsBodyAzureDatabaseExporter) validateMetricsResolutions(
 	return nil
 }
 
-// ContextValidate validate this change agent params body azure database exporter based on the context it is used
-func (o *ChangeAgentParamsBodyAzureDatabaseExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this change agent params body postgres exporter based on the context it is used
+func (o *ChangeAgentParamsBodyPostgresExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
@@ -7208,7 +10709,7 @@ func (o *ChangeAgentParamsBodyAzureDatabaseExporter) ContextValidate(ctx context
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyAzureDatabaseExporter) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyPostgresExporter) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
 	if o.CustomLabels != nil {
 
 		if swag.IsZero(o.CustomLabels) { // not required
@@ -7218,11 +10719,11 @@ func (o *ChangeAgentParamsBodyAzureDatabaseExporter) contextValidateCustomLabels
 		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "azure_database_exporter" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "postgres_exporter" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "azure_database_exporter" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "postgres_exporter" + "." + "custom_labels")
 			}
 
 			return err
@@ -7232,7 +10733,7 @@ func (o *ChangeAgentParamsBodyAzureDatabaseExporter) contextValidateCustomLabels
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyAzureDatabaseExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyPostgresExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
 	if o.MetricsResolutions != nil {
 
 		if swag.IsZero(o.MetricsResolutions) { // not required
@@ -7242,11 +10743,11 @@ func (o *ChangeAgentParamsBodyAzureDatabaseExporter) contextValidateMetricsResol
 		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "azure_database_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "postgres_exporter" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "azure_database_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "postgres_exporter" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -7257,7 +10758,7 @@ func (o *ChangeAgentParamsBodyAzureDatabaseExporter) contextValidateMetricsResol
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyAzureDatabaseExporter) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyPostgresExporter) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7265,8 +10766,8 @@ func (o *ChangeAgentParamsBodyAzureDatabaseExporter) MarshalBinary() ([]byte, er
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyAzureDatabaseExporter) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyAzureDatabaseExporter
+func (o *ChangeAgentParamsBodyPostgresExporter) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyPostgresExporter
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7275,26 +10776,26 @@ func (o *ChangeAgentParamsBodyAzureDatabaseExporter) UnmarshalBinary(b []byte) e
 }
 
 /*
-ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
-swagger:model ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels
+ChangeAgentParamsBodyPostgresExporterCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyPostgresExporterCustomLabels
 */
-type ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels struct {
+type ChangeAgentParamsBodyPostgresExporterCustomLabels struct {
 	// values
 	Values map[string]string `json:"values,omitempty"`
 }
 
-// Validate validates this change agent params body azure database exporter custom labels
-func (o *ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body postgres exporter custom labels
+func (o *ChangeAgentParamsBodyPostgresExporterCustomLabels) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body azure database exporter custom labels based on context it is used
-func (o *ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body postgres exporter custom labels based on context it is used
+func (o *ChangeAgentParamsBodyPostgresExporterCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyPostgresExporterCustomLabels) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7302,8 +10803,8 @@ func (o *ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels) MarshalBinary()
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels
+func (o *ChangeAgentParamsBodyPostgresExporterCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyPostgresExporterCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7312,10 +10813,10 @@ func (o *ChangeAgentParamsBodyAzureDatabaseExporterCustomLabels) UnmarshalBinary
 }
 
 /*
-ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions
+ChangeAgentParamsBodyPostgresExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ChangeAgentParamsBodyPostgresExporterMetricsResolutions
 */
-type ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions struct {
+type ChangeAgentParamsBodyPostgresExporterMetricsResolutions struct {
 	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
 	Hr string `json:"hr,omitempty"`
 
@@ -7326,18 +10827,18 @@ type ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions struct {
 	Lr string `json:"lr,omitempty"`
 }
 
-// Validate validates this change agent params body azure database exporter metrics resolutions
-func (o *ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body postgres exporter metrics resolutions
+func (o *ChangeAgentParamsBodyPostgresExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body azure database exporter metrics resolutions based on context it is used
-func (o *ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body postgres exporter metrics resolutions based on context it is used
+func (o *ChangeAgentParamsBodyPostgresExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyPostgresExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7345,8 +10846,8 @@ func (o *ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions) MarshalBi
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions
+func (o *ChangeAgentParamsBodyPostgresExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyPostgresExporterMetricsResolutions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7355,42 +10856,64 @@ func (o *ChangeAgentParamsBodyAzureDatabaseExporterMetricsResolutions) Unmarshal
 }
 
 /*
-ChangeAgentParamsBodyExternalExporter change agent params body external exporter
-swagger:model ChangeAgentParamsBodyExternalExporter
+ChangeAgentParamsBodyProxysqlExporter change agent params body proxysql exporter
+swagger:model ChangeAgentParamsBodyProxysqlExporter
 */
-type ChangeAgentParamsBodyExternalExporter struct {
+type ChangeAgentParamsBodyProxysqlExporter struct {
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `json:"enable,omitempty"`
 
-	// Enables push metrics with vmagent.
-	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
+	// Enables push metrics with vmagent.
+	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
+
+	// ProxySQL username for scraping metrics.
+	Username *string `json:"username,omitempty"`
+
+	// ProxySQL password for scraping metrics.
+	Password *string `json:"password,omitempty"`
+
+	// Use TLS for database connections.
+	TLS *bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify *bool `json:"tls_skip_verify,omitempty"`
+
+	// List of collector names to disable in this exporter.
+	DisableCollectors []string `json:"disable_collectors"`
 
-	// HTTP basic auth username for collecting metrics.
-	Username *string `json:"username,omitempty"`
+	// Custom password for exporter endpoint /metrics.
+	AgentPassword *string `json:"agent_password,omitempty"`
 
-	// Scheme to generate URI to exporter metrics endpoints.
-	Scheme *string `json:"scheme,omitempty"`
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
 
-	// Path under which metrics are exposed, used to generate URI.
-	MetricsPath *string `json:"metrics_path,omitempty"`
+	// Optionally expose the exporter process on all public interfaces.
+	ExposeExporter *bool `json:"expose_exporter,omitempty"`
 
-	// Listen port for scraping metrics.
-	ListenPort *int64 `json:"listen_port,omitempty"`
+	// Connection timeout for exporter (if set).
+	ConnectionTimeout string `json:"connection_timeout,omitempty"`
 
 	// Skip connection check.
 	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
 
 	// custom labels
-	CustomLabels *ChangeAgentParamsBodyExternalExporterCustomLabels `json:"custom_labels,omitempty"`
+	CustomLabels *ChangeAgentParamsBodyProxysqlExporterCustomLabels `json:"custom_labels,omitempty"`
 
 	// metrics resolutions
-	MetricsResolutions *ChangeAgentParamsBodyExternalExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+	MetricsResolutions *ChangeAgentParamsBodyProxysqlExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
 }
 
-// Validate validates this change agent params body external exporter
-func (o *ChangeAgentParamsBodyExternalExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body proxysql exporter
+func (o *ChangeAgentParamsBodyProxysqlExporter) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateCustomLabels(formats); err != nil {
 		res = append(res, err)
 	}
@@ -7405,7 +10928,61 @@ func (o *ChangeAgentParamsBodyExternalExporter) Validate(formats strfmt.Registry
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyExternalExporter) validateCustomLabels(formats strfmt.Registry) error {
+var changeAgentParamsBodyProxysqlExporterTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		changeAgentParamsBodyProxysqlExporterTypeLogLevelPropEnum = append(changeAgentParamsBodyProxysqlExporterTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *ChangeAgentParamsBodyProxysqlExporter) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyProxysqlExporterTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyProxysqlExporter) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("body"+"."+"proxysql_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ChangeAgentParamsBodyProxysqlExporter) validateCustomLabels(formats strfmt.Registry) error {
 	if swag.IsZero(o.CustomLabels) { // not required
 		return nil
 	}
@@ -7414,11 +10991,11 @@ func (o *ChangeAgentParamsBodyExternalExporter) validateCustomLabels(formats str
 		if err := o.CustomLabels.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "external_exporter" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "proxysql_exporter" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "external_exporter" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "proxysql_exporter" + "." + "custom_labels")
 			}
 
 			return err
@@ -7428,7 +11005,7 @@ func (o *ChangeAgentParamsBodyExternalExporter) validateCustomLabels(formats str
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyExternalExporter) validateMetricsResolutions(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyProxysqlExporter) validateMetricsResolutions(formats strfmt.Registry) error {
 	if swag.IsZero(o.MetricsResolutions) { // not required
 		return nil
 	}
@@ -7437,11 +11014,11 @@ func (o *ChangeAgentParamsBodyExternalExporter) validateMetricsResolutions(forma
 		if err := o.MetricsResolutions.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "external_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "proxysql_exporter" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "external_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "proxysql_exporter" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -7451,8 +11028,8 @@ func (o *ChangeAgentParamsBodyExternalExporter) validateMetricsResolutions(forma
 	return nil
 }
 
-// ContextValidate validate this change agent params body external exporter based on the context it is used
-func (o *ChangeAgentParamsBodyExternalExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this change agent params body proxysql exporter based on the context it is used
+func (o *ChangeAgentParamsBodyProxysqlExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
@@ -7469,7 +11046,7 @@ func (o *ChangeAgentParamsBodyExternalExporter) ContextValidate(ctx context.Cont
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyExternalExporter) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyProxysqlExporter) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
 	if o.CustomLabels != nil {
 
 		if swag.IsZero(o.CustomLabels) { // not required
@@ -7479,11 +11056,11 @@ func (o *ChangeAgentParamsBodyExternalExporter) contextValidateCustomLabels(ctx
 		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "external_exporter" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "proxysql_exporter" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "external_exporter" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "proxysql_exporter" + "." + "custom_labels")
 			}
 
 			return err
@@ -7493,7 +11070,7 @@ func (o *ChangeAgentParamsBodyExternalExporter) contextValidateCustomLabels(ctx
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyExternalExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyProxysqlExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
 	if o.MetricsResolutions != nil {
 
 		if swag.IsZero(o.MetricsResolutions) { // not required
@@ -7503,11 +11080,11 @@ func (o *ChangeAgentParamsBodyExternalExporter) contextValidateMetricsResolution
 		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "external_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "proxysql_exporter" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "external_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "proxysql_exporter" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -7518,7 +11095,7 @@ func (o *ChangeAgentParamsBodyExternalExporter) contextValidateMetricsResolution
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyExternalExporter) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyProxysqlExporter) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7526,8 +11103,8 @@ func (o *ChangeAgentParamsBodyExternalExporter) MarshalBinary() ([]byte, error)
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyExternalExporter) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyExternalExporter
+func (o *ChangeAgentParamsBodyProxysqlExporter) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyProxysqlExporter
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7536,26 +11113,26 @@ func (o *ChangeAgentParamsBodyExternalExporter) UnmarshalBinary(b []byte) error
 }
 
 /*
-ChangeAgentParamsBodyExternalExporterCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
-swagger:model ChangeAgentParamsBodyExternalExporterCustomLabels
+ChangeAgentParamsBodyProxysqlExporterCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyProxysqlExporterCustomLabels
 */
-type ChangeAgentParamsBodyExternalExporterCustomLabels struct {
+type ChangeAgentParamsBodyProxysqlExporterCustomLabels struct {
 	// values
 	Values map[string]string `json:"values,omitempty"`
 }
 
-// Validate validates this change agent params body external exporter custom labels
-func (o *ChangeAgentParamsBodyExternalExporterCustomLabels) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body proxysql exporter custom labels
+func (o *ChangeAgentParamsBodyProxysqlExporterCustomLabels) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body external exporter custom labels based on context it is used
-func (o *ChangeAgentParamsBodyExternalExporterCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body proxysql exporter custom labels based on context it is used
+func (o *ChangeAgentParamsBodyProxysqlExporterCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyExternalExporterCustomLabels) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyProxysqlExporterCustomLabels) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7563,8 +11140,8 @@ func (o *ChangeAgentParamsBodyExternalExporterCustomLabels) MarshalBinary() ([]b
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyExternalExporterCustomLabels) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyExternalExporterCustomLabels
+func (o *ChangeAgentParamsBodyProxysqlExporterCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyProxysqlExporterCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7573,10 +11150,10 @@ func (o *ChangeAgentParamsBodyExternalExporterCustomLabels) UnmarshalBinary(b []
 }
 
 /*
-ChangeAgentParamsBodyExternalExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ChangeAgentParamsBodyExternalExporterMetricsResolutions
+ChangeAgentParamsBodyProxysqlExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ChangeAgentParamsBodyProxysqlExporterMetricsResolutions
 */
-type ChangeAgentParamsBodyExternalExporterMetricsResolutions struct {
+type ChangeAgentParamsBodyProxysqlExporterMetricsResolutions struct {
 	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
 	Hr string `json:"hr,omitempty"`
 
@@ -7587,18 +11164,18 @@ type ChangeAgentParamsBodyExternalExporterMetricsResolutions struct {
 	Lr string `json:"lr,omitempty"`
 }
 
-// Validate validates this change agent params body external exporter metrics resolutions
-func (o *ChangeAgentParamsBodyExternalExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body proxysql exporter metrics resolutions
+func (o *ChangeAgentParamsBodyProxysqlExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body external exporter metrics resolutions based on context it is used
-func (o *ChangeAgentParamsBodyExternalExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body proxysql exporter metrics resolutions based on context it is used
+func (o *ChangeAgentParamsBodyProxysqlExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyExternalExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyProxysqlExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7606,8 +11183,8 @@ func (o *ChangeAgentParamsBodyExternalExporterMetricsResolutions) MarshalBinary(
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyExternalExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyExternalExporterMetricsResolutions
+func (o *ChangeAgentParamsBodyProxysqlExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyProxysqlExporterMetricsResolutions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7616,20 +11193,20 @@ func (o *ChangeAgentParamsBodyExternalExporterMetricsResolutions) UnmarshalBinar
 }
 
 /*
-ChangeAgentParamsBodyMongodbExporter change agent params body mongodb exporter
-swagger:model ChangeAgentParamsBodyMongodbExporter
+ChangeAgentParamsBodyQANMongodbMongologAgent change agent params body QAN mongodb mongolog agent
+swagger:model ChangeAgentParamsBodyQANMongodbMongologAgent
 */
-type ChangeAgentParamsBodyMongodbExporter struct {
+type ChangeAgentParamsBodyQANMongodbMongologAgent struct {
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `json:"enable,omitempty"`
 
 	// Enables push metrics with vmagent.
 	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
 
-	// MongoDB username for scraping metrics.
+	// MongoDB username for getting mongolog data.
 	Username *string `json:"username,omitempty"`
 
-	// MongoDB password for scraping metrics.
+	// MongoDB password for getting mongolog data.
 	Password *string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -7647,11 +11224,8 @@ type ChangeAgentParamsBodyMongodbExporter struct {
 	// Certificate Authority certificate chain.
 	TLSCa *string `json:"tls_ca,omitempty"`
 
-	// Skip connection check.
-	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
-
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `json:"disable_collectors"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength *int32 `json:"max_query_length,omitempty"`
 
 	// Authentication mechanism.
 	AuthenticationMechanism *string `json:"authentication_mechanism,omitempty"`
@@ -7659,42 +11233,24 @@ type ChangeAgentParamsBodyMongodbExporter struct {
 	// Authentication database.
 	AuthenticationDatabase *string `json:"authentication_database,omitempty"`
 
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword *string `json:"agent_password,omitempty"`
-
-	// List of collections to get stats from. Can use *
-	StatsCollections []string `json:"stats_collections"`
-
-	// Collections limit. Only get Databases and collection stats if the total number of collections in the server is less than this value. 0: no limit
-	CollectionsLimit *int32 `json:"collections_limit,omitempty"`
-
-	// Enable all collectors.
-	EnableAllCollectors *bool `json:"enable_all_collectors,omitempty"`
-
 	// Log level for exporters
 	//
 	// - LOG_LEVEL_UNSPECIFIED: Auto
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
 
-	// Optionally expose the exporter process on all public interfaces.
-	ExposeExporter *bool `json:"expose_exporter,omitempty"`
-
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout string `json:"connection_timeout,omitempty"`
-
-	// Enable collecting histogram bucket metrics from getDiagnosticData.
-	EnableDiagnosticDataHistograms *bool `json:"enable_diagnostic_data_histograms,omitempty"`
+	// Skip connection check.
+	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
 
 	// custom labels
-	CustomLabels *ChangeAgentParamsBodyMongodbExporterCustomLabels `json:"custom_labels,omitempty"`
+	CustomLabels *ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels `json:"custom_labels,omitempty"`
 
 	// metrics resolutions
-	MetricsResolutions *ChangeAgentParamsBodyMongodbExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+	MetricsResolutions *ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions `json:"metrics_resolutions,omitempty"`
 }
 
-// Validate validates this change agent params body mongodb exporter
-func (o *ChangeAgentParamsBodyMongodbExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN mongodb mongolog agent
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -7715,7 +11271,7 @@ func (o *ChangeAgentParamsBodyMongodbExporter) Validate(formats strfmt.Registry)
 	return nil
 }
 
-var changeAgentParamsBodyMongodbExporterTypeLogLevelPropEnum []any
+var changeAgentParamsBodyQanMongodbMongologAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -7723,53 +11279,53 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentParamsBodyMongodbExporterTypeLogLevelPropEnum = append(changeAgentParamsBodyMongodbExporterTypeLogLevelPropEnum, v)
+		changeAgentParamsBodyQanMongodbMongologAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyQanMongodbMongologAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentParamsBodyMongodbExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentParamsBodyMongodbExporter) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyMongodbExporterTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyQanMongodbMongologAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyMongodbExporter) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"mongodb_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_mongodb_mongolog_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyMongodbExporter) validateCustomLabels(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) validateCustomLabels(formats strfmt.Registry) error {
 	if swag.IsZero(o.CustomLabels) { // not required
 		return nil
 	}
@@ -7778,11 +11334,11 @@ func (o *ChangeAgentParamsBodyMongodbExporter) validateCustomLabels(formats strf
 		if err := o.CustomLabels.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "mongodb_exporter" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "qan_mongodb_mongolog_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "mongodb_exporter" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "qan_mongodb_mongolog_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -7792,7 +11348,7 @@ func (o *ChangeAgentParamsBodyMongodbExporter) validateCustomLabels(formats strf
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyMongodbExporter) validateMetricsResolutions(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) validateMetricsResolutions(formats strfmt.Registry) error {
 	if swag.IsZero(o.MetricsResolutions) { // not required
 		return nil
 	}
@@ -7801,11 +11357,11 @@ func (o *ChangeAgentParamsBodyMongodbExporter) validateMetricsResolutions(format
 		if err := o.MetricsResolutions.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "mongodb_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "qan_mongodb_mongolog_agent" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "mongodb_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "qan_mongodb_mongolog_agent" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -7815,8 +11371,8 @@ func (o *ChangeAgentParamsBodyMongodbExporter) validateMetricsResolutions(format
 	return nil
 }
 
-// ContextValidate validate this change agent params body mongodb exporter based on the context it is used
-func (o *ChangeAgentParamsBodyMongodbExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this change agent params body QAN mongodb mongolog agent based on the context it is used
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
@@ -7833,7 +11389,7 @@ func (o *ChangeAgentParamsBodyMongodbExporter) ContextValidate(ctx context.Conte
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyMongodbExporter) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
 	if o.CustomLabels != nil {
 
 		if swag.IsZero(o.CustomLabels) { // not required
@@ -7843,11 +11399,11 @@ func (o *ChangeAgentParamsBodyMongodbExporter) contextValidateCustomLabels(ctx c
 		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "mongodb_exporter" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "qan_mongodb_mongolog_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "mongodb_exporter" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "qan_mongodb_mongolog_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -7857,7 +11413,7 @@ func (o *ChangeAgentParamsBodyMongodbExporter) contextValidateCustomLabels(ctx c
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyMongodbExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
 	if o.MetricsResolutions != nil {
 
 		if swag.IsZero(o.MetricsResolutions) { // not required
@@ -7867,11 +11423,11 @@ func (o *ChangeAgentParamsBodyMongodbExporter) contextValidateMetricsResolutions
 		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "mongodb_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "qan_mongodb_mongolog_agent" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "mongodb_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "qan_mongodb_mongolog_agent" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -7882,7 +11438,7 @@ func (o *ChangeAgentParamsBodyMongodbExporter) contextValidateMetricsResolutions
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyMongodbExporter) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7890,8 +11446,8 @@ func (o *ChangeAgentParamsBodyMongodbExporter) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyMongodbExporter) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyMongodbExporter
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANMongodbMongologAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7900,26 +11456,26 @@ func (o *ChangeAgentParamsBodyMongodbExporter) UnmarshalBinary(b []byte) error {
 }
 
 /*
-ChangeAgentParamsBodyMongodbExporterCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
-swagger:model ChangeAgentParamsBodyMongodbExporterCustomLabels
+ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels
 */
-type ChangeAgentParamsBodyMongodbExporterCustomLabels struct {
+type ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels struct {
 	// values
 	Values map[string]string `json:"values,omitempty"`
 }
 
-// Validate validates this change agent params body mongodb exporter custom labels
-func (o *ChangeAgentParamsBodyMongodbExporterCustomLabels) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN mongodb mongolog agent custom labels
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body mongodb exporter custom labels based on context it is used
-func (o *ChangeAgentParamsBodyMongodbExporterCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body QAN mongodb mongolog agent custom labels based on context it is used
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyMongodbExporterCustomLabels) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7927,8 +11483,8 @@ func (o *ChangeAgentParamsBodyMongodbExporterCustomLabels) MarshalBinary() ([]by
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyMongodbExporterCustomLabels) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyMongodbExporterCustomLabels
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7937,10 +11493,10 @@ func (o *ChangeAgentParamsBodyMongodbExporterCustomLabels) UnmarshalBinary(b []b
 }
 
 /*
-ChangeAgentParamsBodyMongodbExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ChangeAgentParamsBodyMongodbExporterMetricsResolutions
+ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions
 */
-type ChangeAgentParamsBodyMongodbExporterMetricsResolutions struct {
+type ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions struct {
 	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
 	Hr string `json:"hr,omitempty"`
 
@@ -7951,18 +11507,18 @@ type ChangeAgentParamsBodyMongodbExporterMetricsResolutions struct {
 	Lr string `json:"lr,omitempty"`
 }
 
-// Validate validates this change agent params body mongodb exporter metrics resolutions
-func (o *ChangeAgentParamsBodyMongodbExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN mongodb mongolog agent metrics resolutions
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body mongodb exporter metrics resolutions based on context it is used
-func (o *ChangeAgentParamsBodyMongodbExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body QAN mongodb mongolog agent metrics resolutions based on context it is used
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyMongodbExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -7970,8 +11526,8 @@ func (o *ChangeAgentParamsBodyMongodbExporterMetricsResolutions) MarshalBinary()
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyMongodbExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyMongodbExporterMetricsResolutions
+func (o *ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -7980,20 +11536,20 @@ func (o *ChangeAgentParamsBodyMongodbExporterMetricsResolutions) UnmarshalBinary
 }
 
 /*
-ChangeAgentParamsBodyMysqldExporter change agent params body mysqld exporter
-swagger:model ChangeAgentParamsBodyMysqldExporter
+ChangeAgentParamsBodyQANMongodbProfilerAgent change agent params body QAN mongodb profiler agent
+swagger:model ChangeAgentParamsBodyQANMongodbProfilerAgent
 */
-type ChangeAgentParamsBodyMysqldExporter struct {
+type ChangeAgentParamsBodyQANMongodbProfilerAgent struct {
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `json:"enable,omitempty"`
 
 	// Enables push metrics with vmagent.
 	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
 
-	// MySQL username for scraping metrics.
+	// MongoDB username for getting profile data.
 	Username *string `json:"username,omitempty"`
 
-	// MySQL password for scraping metrics.
+	// MongoDB password for getting profile data.
 	Password *string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -8002,26 +11558,23 @@ type ChangeAgentParamsBodyMysqldExporter struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify *bool `json:"tls_skip_verify,omitempty"`
 
-	// Certificate Authority certificate chain.
-	TLSCa *string `json:"tls_ca,omitempty"`
-
-	// Client certificate.
-	TLSCert *string `json:"tls_cert,omitempty"`
+	// Client certificate and key.
+	TLSCertificateKey *string `json:"tls_certificate_key,omitempty"`
 
-	// Password for decrypting tls_cert.
-	TLSKey *string `json:"tls_key,omitempty"`
+	// Password for decrypting tls_certificate_key.
+	TLSCertificateKeyFilePassword *string `json:"tls_certificate_key_file_password,omitempty"`
 
-	// Tablestats group collectors will be disabled if there are more than that number of tables.
-	TablestatsGroupTableLimit *int32 `json:"tablestats_group_table_limit,omitempty"`
+	// Certificate Authority certificate chain.
+	TLSCa *string `json:"tls_ca,omitempty"`
 
-	// Skip connection check.
-	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength *int32 `json:"max_query_length,omitempty"`
 
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `json:"disable_collectors"`
+	// Authentication mechanism.
+	AuthenticationMechanism *string `json:"authentication_mechanism,omitempty"`
 
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword *string `json:"agent_password,omitempty"`
+	// Authentication database.
+	AuthenticationDatabase *string `json:"authentication_database,omitempty"`
 
 	// Log level for exporters
 	//
@@ -8029,21 +11582,18 @@ type ChangeAgentParamsBodyMysqldExporter struct {
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
 
-	// Optionally expose the exporter process on all public interfaces.
-	ExposeExporter *bool `json:"expose_exporter,omitempty"`
-
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout string `json:"connection_timeout,omitempty"`
+	// Skip connection check.
+	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
 
 	// custom labels
-	CustomLabels *ChangeAgentParamsBodyMysqldExporterCustomLabels `json:"custom_labels,omitempty"`
+	CustomLabels *ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels `json:"custom_labels,omitempty"`
 
 	// metrics resolutions
-	MetricsResolutions *ChangeAgentParamsBodyMysqldExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+	MetricsResolutions *ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions `json:"metrics_resolutions,omitempty"`
 }
 
-// Validate validates this change agent params body mysqld exporter
-func (o *ChangeAgentParamsBodyMysqldExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN mongodb profiler agent
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -8064,7 +11614,7 @@ func (o *ChangeAgentParamsBodyMysqldExporter) Validate(formats strfmt.Registry)
 	return nil
 }
 
-var changeAgentParamsBodyMysqldExporterTypeLogLevelPropEnum []any
+var changeAgentParamsBodyQanMongodbProfilerAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -8072,53 +11622,53 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentParamsBodyMysqldExporterTypeLogLevelPropEnum = append(changeAgentParamsBodyMysqldExporterTypeLogLevelPropEnum, v)
+		changeAgentParamsBodyQanMongodbProfilerAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyQanMongodbProfilerAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentParamsBodyMysqldExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentParamsBodyMysqldExporter) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyMysqldExporterTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyQanMongodbProfilerAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyMysqldExporter) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"mysqld_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_mongodb_profiler_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyMysqldExporter) validateCustomLabels(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) validateCustomLabels(formats strfmt.Registry) error {
 	if swag.IsZero(o.CustomLabels) { // not required
 		return nil
 	}
@@ -8127,11 +11677,11 @@ func (o *ChangeAgentParamsBodyMysqldExporter) validateCustomLabels(formats strfm
 		if err := o.CustomLabels.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "mysqld_exporter" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "qan_mongodb_profiler_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "mysqld_exporter" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "qan_mongodb_profiler_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -8141,7 +11691,7 @@ func (o *ChangeAgentParamsBodyMysqldExporter) validateCustomLabels(formats strfm
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyMysqldExporter) validateMetricsResolutions(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) validateMetricsResolutions(formats strfmt.Registry) error {
 	if swag.IsZero(o.MetricsResolutions) { // not required
 		return nil
 	}
@@ -8150,11 +11700,11 @@ func (o *ChangeAgentParamsBodyMysqldExporter) validateMetricsResolutions(formats
 		if err := o.MetricsResolutions.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "mysqld_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "qan_mongodb_profiler_agent" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "mysqld_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "qan_mongodb_profiler_agent" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -8164,8 +11714,8 @@ func (o *ChangeAgentParamsBodyMysqldExporter) validateMetricsResolutions(formats
 	return nil
 }
 
-// ContextValidate validate this change agent params body mysqld exporter based on the context it is used
-func (o *ChangeAgentParamsBodyMysqldExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this change agent params body QAN mongodb profiler agent based on the context it is used
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
@@ -8182,7 +11732,7 @@ func (o *ChangeAgentParamsBodyMysqldExporter) ContextValidate(ctx context.Contex
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyMysqldExporter) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
 	if o.CustomLabels != nil {
 
 		if swag.IsZero(o.CustomLabels) { // not required
@@ -8192,11 +11742,11 @@ func (o *ChangeAgentParamsBodyMysqldExporter) contextValidateCustomLabels(ctx co
 		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "mysqld_exporter" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "qan_mongodb_profiler_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "mysqld_exporter" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "qan_mongodb_profiler_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -8206,7 +11756,7 @@ func (o *ChangeAgentParamsBodyMysqldExporter) contextValidateCustomLabels(ctx co
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyMysqldExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
 	if o.MetricsResolutions != nil {
 
 		if swag.IsZero(o.MetricsResolutions) { // not required
@@ -8216,11 +11766,11 @@ func (o *ChangeAgentParamsBodyMysqldExporter) contextValidateMetricsResolutions(
 		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "mysqld_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "qan_mongodb_profiler_agent" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "mysqld_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "qan_mongodb_profiler_agent" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -8231,7 +11781,7 @@ func (o *ChangeAgentParamsBodyMysqldExporter) contextValidateMetricsResolutions(
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyMysqldExporter) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -8239,8 +11789,8 @@ func (o *ChangeAgentParamsBodyMysqldExporter) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyMysqldExporter) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyMysqldExporter
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANMongodbProfilerAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8249,26 +11799,26 @@ func (o *ChangeAgentParamsBodyMysqldExporter) UnmarshalBinary(b []byte) error {
 }
 
 /*
-ChangeAgentParamsBodyMysqldExporterCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
-swagger:model ChangeAgentParamsBodyMysqldExporterCustomLabels
+ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels
 */
-type ChangeAgentParamsBodyMysqldExporterCustomLabels struct {
+type ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels struct {
 	// values
 	Values map[string]string `json:"values,omitempty"`
 }
 
-// Validate validates this change agent params body mysqld exporter custom labels
-func (o *ChangeAgentParamsBodyMysqldExporterCustomLabels) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN mongodb profiler agent custom labels
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body mysqld exporter custom labels based on context it is used
-func (o *ChangeAgentParamsBodyMysqldExporterCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body QAN mongodb profiler agent custom labels based on context it is used
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyMysqldExporterCustomLabels) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -8276,8 +11826,8 @@ func (o *ChangeAgentParamsBodyMysqldExporterCustomLabels) MarshalBinary() ([]byt
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyMysqldExporterCustomLabels) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyMysqldExporterCustomLabels
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8286,10 +11836,10 @@ func (o *ChangeAgentParamsBodyMysqldExporterCustomLabels) UnmarshalBinary(b []by
 }
 
 /*
-ChangeAgentParamsBodyMysqldExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ChangeAgentParamsBodyMysqldExporterMetricsResolutions
+ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions
 */
-type ChangeAgentParamsBodyMysqldExporterMetricsResolutions struct {
+type ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions struct {
 	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
 	Hr string `json:"hr,omitempty"`
 
@@ -8300,18 +11850,18 @@ type ChangeAgentParamsBodyMysqldExporterMetricsResolutions struct {
 	Lr string `json:"lr,omitempty"`
 }
 
-// Validate validates this change agent params body mysqld exporter metrics resolutions
-func (o *ChangeAgentParamsBodyMysqldExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN mongodb profiler agent metrics resolutions
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body mysqld exporter metrics resolutions based on context it is used
-func (o *ChangeAgentParamsBodyMysqldExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body QAN mongodb profiler agent metrics resolutions based on context it is used
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyMysqldExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -8319,8 +11869,8 @@ func (o *ChangeAgentParamsBodyMysqldExporterMetricsResolutions) MarshalBinary()
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyMysqldExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyMysqldExporterMetricsResolutions
+func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8328,19 +11878,49 @@ func (o *ChangeAgentParamsBodyMysqldExporterMetricsResolutions) UnmarshalBinary(
 	return nil
 }
 
-/*
-ChangeAgentParamsBodyNodeExporter change agent params body node exporter
-swagger:model ChangeAgentParamsBodyNodeExporter
-*/
-type ChangeAgentParamsBodyNodeExporter struct {
-	// Enable this Agent. Agents are enabled by default when they get added.
-	Enable *bool `json:"enable,omitempty"`
+/*
+ChangeAgentParamsBodyQANMysqlPerfschemaAgent change agent params body QAN mysql perfschema agent
+swagger:model ChangeAgentParamsBodyQANMysqlPerfschemaAgent
+*/
+type ChangeAgentParamsBodyQANMysqlPerfschemaAgent struct {
+	// Enable this Agent. Agents are enabled by default when they get added.
+	Enable *bool `json:"enable,omitempty"`
+
+	// Enables push metrics with vmagent.
+	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
+
+	// MySQL username for getting performance data.
+	Username *string `json:"username,omitempty"`
+
+	// MySQL password for getting performance data.
+	Password *string `json:"password,omitempty"`
+
+	// Use TLS for database connections.
+	TLS *bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify *bool `json:"tls_skip_verify,omitempty"`
+
+	// Certificate Authority certificate chain.
+	TLSCa *string `json:"tls_ca,omitempty"`
+
+	// Client certificate.
+	TLSCert *string `json:"tls_cert,omitempty"`
+
+	// Password for decrypting tls_cert.
+	TLSKey *string `json:"tls_key,omitempty"`
 
-	// Enables push metrics with vmagent.
-	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength *int32 `json:"max_query_length,omitempty"`
 
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `json:"disable_collectors"`
+	// Disable query examples.
+	DisableQueryExamples *bool `json:"disable_query_examples,omitempty"`
+
+	// Skip connection check.
+	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
+
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing *bool `json:"disable_comments_parsing,omitempty"`
 
 	// Log level for exporters
 	//
@@ -8348,18 +11928,15 @@ type ChangeAgentParamsBodyNodeExporter struct {
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
 
-	// Expose the node_exporter process on all public interfaces.
-	ExposeExporter *bool `json:"expose_exporter,omitempty"`
-
 	// custom labels
-	CustomLabels *ChangeAgentParamsBodyNodeExporterCustomLabels `json:"custom_labels,omitempty"`
+	CustomLabels *ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels `json:"custom_labels,omitempty"`
 
 	// metrics resolutions
-	MetricsResolutions *ChangeAgentParamsBodyNodeExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+	MetricsResolutions *ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions `json:"metrics_resolutions,omitempty"`
 }
 
-// Validate validates this change agent params body node exporter
-func (o *ChangeAgentParamsBodyNodeExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN mysql perfschema agent
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -8380,7 +11957,7 @@ func (o *ChangeAgentParamsBodyNodeExporter) Validate(formats strfmt.Registry) er
 	return nil
 }
 
-var changeAgentParamsBodyNodeExporterTypeLogLevelPropEnum []any
+var changeAgentParamsBodyQanMysqlPerfschemaAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -8388,53 +11965,53 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentParamsBodyNodeExporterTypeLogLevelPropEnum = append(changeAgentParamsBodyNodeExporterTypeLogLevelPropEnum, v)
+		changeAgentParamsBodyQanMysqlPerfschemaAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyQanMysqlPerfschemaAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentParamsBodyNodeExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentParamsBodyNodeExporter) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyNodeExporterTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyQanMysqlPerfschemaAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyNodeExporter) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"node_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_mysql_perfschema_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyNodeExporter) validateCustomLabels(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) validateCustomLabels(formats strfmt.Registry) error {
 	if swag.IsZero(o.CustomLabels) { // not required
 		return nil
 	}
@@ -8443,11 +12020,11 @@ func (o *ChangeAgentParamsBodyNodeExporter) validateCustomLabels(formats strfmt.
 		if err := o.CustomLabels.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "node_exporter" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "qan_mysql_perfschema_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "node_exporter" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "qan_mysql_perfschema_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -8457,7 +12034,7 @@ func (o *ChangeAgentParamsBodyNodeExporter) validateCustomLabels(formats strfmt.
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyNodeExporter) validateMetricsResolutions(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) validateMetricsResolutions(formats strfmt.Registry) error {
 	if swag.IsZero(o.MetricsResolutions) { // not required
 		return nil
 	}
@@ -8466,11 +12043,11 @@ func (o *ChangeAgentParamsBodyNodeExporter) validateMetricsResolutions(formats s
 		if err := o.MetricsResolutions.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "node_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "qan_mysql_perfschema_agent" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "node_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "qan_mysql_perfschema_agent" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -8480,8 +12057,8 @@ func (o *ChangeAgentParamsBodyNodeExporter) validateMetricsResolutions(formats s
 	return nil
 }
 
-// ContextValidate validate this change agent params body node exporter based on the context it is used
-func (o *ChangeAgentParamsBodyNodeExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this change agent params body QAN mysql perfschema agent based on the context it is used
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
@@ -8498,7 +12075,7 @@ func (o *ChangeAgentParamsBodyNodeExporter) ContextValidate(ctx context.Context,
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyNodeExporter) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
 	if o.CustomLabels != nil {
 
 		if swag.IsZero(o.CustomLabels) { // not required
@@ -8508,11 +12085,11 @@ func (o *ChangeAgentParamsBodyNodeExporter) contextValidateCustomLabels(ctx cont
 		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "node_exporter" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "qan_mysql_perfschema_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "node_exporter" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "qan_mysql_perfschema_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -8522,7 +12099,7 @@ func (o *ChangeAgentParamsBodyNodeExporter) contextValidateCustomLabels(ctx cont
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyNodeExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
 	if o.MetricsResolutions != nil {
 
 		if swag.IsZero(o.MetricsResolutions) { // not required
@@ -8532,11 +12109,11 @@ func (o *ChangeAgentParamsBodyNodeExporter) contextValidateMetricsResolutions(ct
 		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "node_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "qan_mysql_perfschema_agent" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "node_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "qan_mysql_perfschema_agent" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -8547,7 +12124,7 @@ func (o *ChangeAgentParamsBodyNodeExporter) contextValidateMetricsResolutions(ct
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyNodeExporter) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -8555,8 +12132,8 @@ func (o *ChangeAgentParamsBodyNodeExporter) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyNodeExporter) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyNodeExporter
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANMysqlPerfschemaAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8565,26 +12142,26 @@ func (o *ChangeAgentParamsBodyNodeExporter) UnmarshalBinary(b []byte) error {
 }
 
 /*
-ChangeAgentParamsBodyNodeExporterCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
-swagger:model ChangeAgentParamsBodyNodeExporterCustomLabels
+ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels
 */
-type ChangeAgentParamsBodyNodeExporterCustomLabels struct {
+type ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels struct {
 	// values
 	Values map[string]string `json:"values,omitempty"`
 }
 
-// Validate validates this change agent params body node exporter custom labels
-func (o *ChangeAgentParamsBodyNodeExporterCustomLabels) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN mysql perfschema agent custom labels
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body node exporter custom labels based on context it is used
-func (o *ChangeAgentParamsBodyNodeExporterCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body QAN mysql perfschema agent custom labels based on context it is used
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyNodeExporterCustomLabels) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -8592,8 +12169,8 @@ func (o *ChangeAgentParamsBodyNodeExporterCustomLabels) MarshalBinary() ([]byte,
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyNodeExporterCustomLabels) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyNodeExporterCustomLabels
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8602,10 +12179,10 @@ func (o *ChangeAgentParamsBodyNodeExporterCustomLabels) UnmarshalBinary(b []byte
 }
 
 /*
-ChangeAgentParamsBodyNodeExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ChangeAgentParamsBodyNodeExporterMetricsResolutions
+ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions
 */
-type ChangeAgentParamsBodyNodeExporterMetricsResolutions struct {
+type ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions struct {
 	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
 	Hr string `json:"hr,omitempty"`
 
@@ -8616,55 +12193,18 @@ type ChangeAgentParamsBodyNodeExporterMetricsResolutions struct {
 	Lr string `json:"lr,omitempty"`
 }
 
-// Validate validates this change agent params body node exporter metrics resolutions
-func (o *ChangeAgentParamsBodyNodeExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
-	return nil
-}
-
-// ContextValidate validates this change agent params body node exporter metrics resolutions based on context it is used
-func (o *ChangeAgentParamsBodyNodeExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyNodeExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
-	if o == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(o)
-}
-
-// UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyNodeExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyNodeExporterMetricsResolutions
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*o = res
-	return nil
-}
-
-/*
-ChangeAgentParamsBodyNomadAgent change agent params body nomad agent
-swagger:model ChangeAgentParamsBodyNomadAgent
-*/
-type ChangeAgentParamsBodyNomadAgent struct {
-	// Enable this Agent. Agents are enabled by default when they get added.
-	Enable *bool `json:"enable,omitempty"`
-}
-
-// Validate validates this change agent params body nomad agent
-func (o *ChangeAgentParamsBodyNomadAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN mysql perfschema agent metrics resolutions
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body nomad agent based on context it is used
-func (o *ChangeAgentParamsBodyNomadAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body QAN mysql perfschema agent metrics resolutions based on context it is used
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyNomadAgent) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -8672,8 +12212,8 @@ func (o *ChangeAgentParamsBodyNomadAgent) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyNomadAgent) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyNomadAgent
+func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8682,20 +12222,20 @@ func (o *ChangeAgentParamsBodyNomadAgent) UnmarshalBinary(b []byte) error {
 }
 
 /*
-ChangeAgentParamsBodyPostgresExporter change agent params body postgres exporter
-swagger:model ChangeAgentParamsBodyPostgresExporter
+ChangeAgentParamsBodyQANMysqlSlowlogAgent change agent params body QAN mysql slowlog agent
+swagger:model ChangeAgentParamsBodyQANMysqlSlowlogAgent
 */
-type ChangeAgentParamsBodyPostgresExporter struct {
+type ChangeAgentParamsBodyQANMysqlSlowlogAgent struct {
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `json:"enable,omitempty"`
 
 	// Enables push metrics with vmagent.
 	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
 
-	// PostgreSQL username for scraping metrics.
+	// MySQL username for getting slowlog data.
 	Username *string `json:"username,omitempty"`
 
-	// PostgreSQL password for scraping metrics.
+	// MySQL password for getting slowlog data.
 	Password *string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -8704,23 +12244,29 @@ type ChangeAgentParamsBodyPostgresExporter struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify *bool `json:"tls_skip_verify,omitempty"`
 
-	// Skip connection check.
-	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
-
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `json:"disable_collectors"`
-
-	// TLS CA certificate.
+	// Certificate Authority certificate chain.
 	TLSCa *string `json:"tls_ca,omitempty"`
 
-	// TLS Certificate.
+	// Client certificate.
 	TLSCert *string `json:"tls_cert,omitempty"`
 
-	// TLS Certificate Key.
+	// Password for decrypting tls_cert.
 	TLSKey *string `json:"tls_key,omitempty"`
 
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword *string `json:"agent_password,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength *int32 `json:"max_query_length,omitempty"`
+
+	// Disable query examples.
+	DisableQueryExamples *bool `json:"disable_query_examples,omitempty"`
+
+	// Rotate slowlog file at this size if > 0.
+	MaxSlowlogFileSize *string `json:"max_slowlog_file_size,omitempty"`
+
+	// Skip connection check.
+	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
+
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing *bool `json:"disable_comments_parsing,omitempty"`
 
 	// Log level for exporters
 	//
@@ -8728,27 +12274,15 @@ type ChangeAgentParamsBodyPostgresExporter struct {
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
 
-	// Limit of databases for auto-discovery.
-	AutoDiscoveryLimit *int32 `json:"auto_discovery_limit,omitempty"`
-
-	// Optionally expose the exporter process on all public interfaces.
-	ExposeExporter *bool `json:"expose_exporter,omitempty"`
-
-	// Maximum number of connections that exporter can open to the database instance.
-	MaxExporterConnections *int32 `json:"max_exporter_connections,omitempty"`
-
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout string `json:"connection_timeout,omitempty"`
-
 	// custom labels
-	CustomLabels *ChangeAgentParamsBodyPostgresExporterCustomLabels `json:"custom_labels,omitempty"`
+	CustomLabels *ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels `json:"custom_labels,omitempty"`
 
 	// metrics resolutions
-	MetricsResolutions *ChangeAgentParamsBodyPostgresExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+	MetricsResolutions *ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions `json:"metrics_resolutions,omitempty"`
 }
 
-// Validate validates this change agent params body postgres exporter
-func (o *ChangeAgentParamsBodyPostgresExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN mysql slowlog agent
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -8769,7 +12303,7 @@ func (o *ChangeAgentParamsBodyPostgresExporter) Validate(formats strfmt.Registry
 	return nil
 }
 
-var changeAgentParamsBodyPostgresExporterTypeLogLevelPropEnum []any
+var changeAgentParamsBodyQanMysqlSlowlogAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -8777,53 +12311,53 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentParamsBodyPostgresExporterTypeLogLevelPropEnum = append(changeAgentParamsBodyPostgresExporterTypeLogLevelPropEnum, v)
+		changeAgentParamsBodyQanMysqlSlowlogAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyQanMysqlSlowlogAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentParamsBodyPostgresExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentParamsBodyPostgresExporter) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyPostgresExporterTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyQanMysqlSlowlogAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyPostgresExporter) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"postgres_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_mysql_slowlog_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyPostgresExporter) validateCustomLabels(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) validateCustomLabels(formats strfmt.Registry) error {
 	if swag.IsZero(o.CustomLabels) { // not required
 		return nil
 	}
@@ -8832,11 +12366,11 @@ func (o *ChangeAgentParamsBodyPostgresExporter) validateCustomLabels(formats str
 		if err := o.CustomLabels.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "postgres_exporter" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "qan_mysql_slowlog_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "postgres_exporter" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "qan_mysql_slowlog_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -8846,7 +12380,7 @@ func (o *ChangeAgentParamsBodyPostgresExporter) validateCustomLabels(formats str
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyPostgresExporter) validateMetricsResolutions(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) validateMetricsResolutions(formats strfmt.Registry) error {
 	if swag.IsZero(o.MetricsResolutions) { // not required
 		return nil
 	}
@@ -8855,11 +12389,11 @@ func (o *ChangeAgentParamsBodyPostgresExporter) validateMetricsResolutions(forma
 		if err := o.MetricsResolutions.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "postgres_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "qan_mysql_slowlog_agent" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "postgres_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "qan_mysql_slowlog_agent" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -8869,8 +12403,8 @@ func (o *ChangeAgentParamsBodyPostgresExporter) validateMetricsResolutions(forma
 	return nil
 }
 
-// ContextValidate validate this change agent params body postgres exporter based on the context it is used
-func (o *ChangeAgentParamsBodyPostgresExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this change agent params body QAN mysql slowlog agent based on the context it is used
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
@@ -8887,7 +12421,7 @@ func (o *ChangeAgentParamsBodyPostgresExporter) ContextValidate(ctx context.Cont
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyPostgresExporter) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
 	if o.CustomLabels != nil {
 
 		if swag.IsZero(o.CustomLabels) { // not required
@@ -8897,11 +12431,11 @@ func (o *ChangeAgentParamsBodyPostgresExporter) contextValidateCustomLabels(ctx
 		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "postgres_exporter" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "qan_mysql_slowlog_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "postgres_exporter" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "qan_mysql_slowlog_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -8911,7 +12445,7 @@ func (o *ChangeAgentParamsBodyPostgresExporter) contextValidateCustomLabels(ctx
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyPostgresExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
 	if o.MetricsResolutions != nil {
 
 		if swag.IsZero(o.MetricsResolutions) { // not required
@@ -8921,11 +12455,11 @@ func (o *ChangeAgentParamsBodyPostgresExporter) contextValidateMetricsResolution
 		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "postgres_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "qan_mysql_slowlog_agent" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "postgres_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "qan_mysql_slowlog_agent" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -8936,7 +12470,7 @@ func (o *ChangeAgentParamsBodyPostgresExporter) contextValidateMetricsResolution
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyPostgresExporter) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -8944,8 +12478,8 @@ func (o *ChangeAgentParamsBodyPostgresExporter) MarshalBinary() ([]byte, error)
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyPostgresExporter) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyPostgresExporter
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANMysqlSlowlogAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8954,26 +12488,26 @@ func (o *ChangeAgentParamsBodyPostgresExporter) UnmarshalBinary(b []byte) error
 }
 
 /*
-ChangeAgentParamsBodyPostgresExporterCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
-swagger:model ChangeAgentParamsBodyPostgresExporterCustomLabels
+ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels
 */
-type ChangeAgentParamsBodyPostgresExporterCustomLabels struct {
+type ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels struct {
 	// values
 	Values map[string]string `json:"values,omitempty"`
 }
 
-// Validate validates this change agent params body postgres exporter custom labels
-func (o *ChangeAgentParamsBodyPostgresExporterCustomLabels) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN mysql slowlog agent custom labels
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body postgres exporter custom labels based on context it is used
-func (o *ChangeAgentParamsBodyPostgresExporterCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body QAN mysql slowlog agent custom labels based on context it is used
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyPostgresExporterCustomLabels) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -8981,8 +12515,8 @@ func (o *ChangeAgentParamsBodyPostgresExporterCustomLabels) MarshalBinary() ([]b
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyPostgresExporterCustomLabels) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyPostgresExporterCustomLabels
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8991,10 +12525,10 @@ func (o *ChangeAgentParamsBodyPostgresExporterCustomLabels) UnmarshalBinary(b []
 }
 
 /*
-ChangeAgentParamsBodyPostgresExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ChangeAgentParamsBodyPostgresExporterMetricsResolutions
+ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions
 */
-type ChangeAgentParamsBodyPostgresExporterMetricsResolutions struct {
+type ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions struct {
 	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
 	Hr string `json:"hr,omitempty"`
 
@@ -9005,18 +12539,18 @@ type ChangeAgentParamsBodyPostgresExporterMetricsResolutions struct {
 	Lr string `json:"lr,omitempty"`
 }
 
-// Validate validates this change agent params body postgres exporter metrics resolutions
-func (o *ChangeAgentParamsBodyPostgresExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN mysql slowlog agent metrics resolutions
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body postgres exporter metrics resolutions based on context it is used
-func (o *ChangeAgentParamsBodyPostgresExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body QAN mysql slowlog agent metrics resolutions based on context it is used
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyPostgresExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -9024,8 +12558,8 @@ func (o *ChangeAgentParamsBodyPostgresExporterMetricsResolutions) MarshalBinary(
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyPostgresExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyPostgresExporterMetricsResolutions
+func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -9034,20 +12568,17 @@ func (o *ChangeAgentParamsBodyPostgresExporterMetricsResolutions) UnmarshalBinar
 }
 
 /*
-ChangeAgentParamsBodyProxysqlExporter change agent params body proxysql exporter
-swagger:model ChangeAgentParamsBodyProxysqlExporter
+ChangeAgentParamsBodyQANPostgresqlLogAgent change agent params body QAN postgresql log agent
+swagger:model ChangeAgentParamsBodyQANPostgresqlLogAgent
 */
-type ChangeAgentParamsBodyProxysqlExporter struct {
+type ChangeAgentParamsBodyQANPostgresqlLogAgent struct {
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `json:"enable,omitempty"`
 
-	// Enables push metrics with vmagent.
-	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
-
-	// ProxySQL username for scraping metrics.
+	// PostgreSQL username for getting server log settings.
 	Username *string `json:"username,omitempty"`
 
-	// ProxySQL password for scraping metrics.
+	// PostgreSQL password for getting server log settings.
 	Password *string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -9056,11 +12587,23 @@ type ChangeAgentParamsBodyProxysqlExporter struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify *bool `json:"tls_skip_verify,omitempty"`
 
-	// List of collector names to disable in this exporter.
-	DisableCollectors []string `json:"disable_collectors"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength *int32 `json:"max_query_length,omitempty"`
 
-	// Custom password for exporter endpoint /metrics.
-	AgentPassword *string `json:"agent_password,omitempty"`
+	// Disable query examples.
+	DisableQueryExamples *bool `json:"disable_query_examples,omitempty"`
+
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing *bool `json:"disable_comments_parsing,omitempty"`
+
+	// TLS CA certificate.
+	TLSCa *string `json:"tls_ca,omitempty"`
+
+	// TLS Certificate.
+	TLSCert *string `json:"tls_cert,omitempty"`
+
+	// TLS Certificate Key.
+	TLSKey *string `json:"tls_key,omitempty"`
 
 	// Log level for exporters
 	//
@@ -9068,24 +12611,15 @@ type ChangeAgentParamsBodyProxysqlExporter struct {
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
 
-	// Optionally expose the exporter process on all public interfaces.
-	ExposeExporter *bool `json:"expose_exporter,omitempty"`
-
-	// Connection timeout for exporter (if set).
-	ConnectionTimeout string `json:"connection_timeout,omitempty"`
-
 	// Skip connection check.
 	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
 
 	// custom labels
-	CustomLabels *ChangeAgentParamsBodyProxysqlExporterCustomLabels `json:"custom_labels,omitempty"`
-
-	// metrics resolutions
-	MetricsResolutions *ChangeAgentParamsBodyProxysqlExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+	CustomLabels *ChangeAgentParamsBodyQANPostgresqlLogAgentCustomLabels `json:"custom_labels,omitempty"`
 }
 
-// Validate validates this change agent params body proxysql exporter
-func (o *ChangeAgentParamsBodyProxysqlExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN postgresql log agent
+func (o *ChangeAgentParamsBodyQANPostgresqlLogAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -9096,17 +12630,13 @@ func (o *ChangeAgentParamsBodyProxysqlExporter) Validate(formats strfmt.Registry
 		res = append(res, err)
 	}
 
-	if err := o.validateMetricsResolutions(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
-var changeAgentParamsBodyProxysqlExporterTypeLogLevelPropEnum []any
+var changeAgentParamsBodyQanPostgresqlLogAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -9114,53 +12644,53 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentParamsBodyProxysqlExporterTypeLogLevelPropEnum = append(changeAgentParamsBodyProxysqlExporterTypeLogLevelPropEnum, v)
+		changeAgentParamsBodyQanPostgresqlLogAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyQanPostgresqlLogAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentParamsBodyProxysqlExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyQANPostgresqlLogAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentParamsBodyProxysqlExporter) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyProxysqlExporterTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentParamsBodyQANPostgresqlLogAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyQanPostgresqlLogAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyProxysqlExporter) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANPostgresqlLogAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"proxysql_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_postgresql_log_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyProxysqlExporter) validateCustomLabels(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANPostgresqlLogAgent) validateCustomLabels(formats strfmt.Registry) error {
 	if swag.IsZero(o.CustomLabels) { // not required
 		return nil
 	}
@@ -9169,34 +12699,11 @@ func (o *ChangeAgentParamsBodyProxysqlExporter) validateCustomLabels(formats str
 		if err := o.CustomLabels.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "proxysql_exporter" + "." + "custom_labels")
-			}
-			ce := new(errors.CompositeError)
-			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "proxysql_exporter" + "." + "custom_labels")
-			}
-
-			return err
-		}
-	}
-
-	return nil
-}
-
-func (o *ChangeAgentParamsBodyProxysqlExporter) validateMetricsResolutions(formats strfmt.Registry) error {
-	if swag.IsZero(o.MetricsResolutions) { // not required
-		return nil
-	}
-
-	if o.MetricsResolutions != nil {
-		if err := o.MetricsResolutions.Validate(formats); err != nil {
-			ve := new(errors.Validation)
-			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "proxysql_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "qan_postgresql_log_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "proxysql_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "qan_postgresql_log_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -9206,111 +12713,46 @@ func (o *ChangeAgentParamsBodyProxysqlExporter) validateMetricsResolutions(forma
 	return nil
 }
 
-// ContextValidate validate this change agent params body proxysql exporter based on the context it is used
-func (o *ChangeAgentParamsBodyProxysqlExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this change agent params body QAN postgresql log agent based on the context it is used
+func (o *ChangeAgentParamsBodyQANPostgresqlLogAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
-	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
-		res = append(res, err)
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyProxysqlExporter) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANPostgresqlLogAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
 	if o.CustomLabels != nil {
 
 		if swag.IsZero(o.CustomLabels) { // not required
 			return nil
 		}
 
-		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
-			ve := new(errors.Validation)
-			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "proxysql_exporter" + "." + "custom_labels")
-			}
-			ce := new(errors.CompositeError)
-			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "proxysql_exporter" + "." + "custom_labels")
-			}
-
-			return err
-		}
-	}
-
-	return nil
-}
-
-func (o *ChangeAgentParamsBodyProxysqlExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
-	if o.MetricsResolutions != nil {
-
-		if swag.IsZero(o.MetricsResolutions) { // not required
-			return nil
-		}
-
-		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
-			ve := new(errors.Validation)
-			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "proxysql_exporter" + "." + "metrics_resolutions")
-			}
-			ce := new(errors.CompositeError)
-			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "proxysql_exporter" + "." + "metrics_resolutions")
-			}
-
-			return err
-		}
-	}
-
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyProxysqlExporter) MarshalBinary() ([]byte, error) {
-	if o == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(o)
-}
-
-// UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyProxysqlExporter) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyProxysqlExporter
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*o = res
-	return nil
-}
-
-/*
-ChangeAgentParamsBodyProxysqlExporterCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
-swagger:model ChangeAgentParamsBodyProxysqlExporterCustomLabels
-*/
-type ChangeAgentParamsBodyProxysqlExporterCustomLabels struct {
-	// values
-	Values map[string]string `json:"values,omitempty"`
-}
+		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "qan_postgresql_log_agent" + "." + "custom_labels")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "qan_postgresql_log_agent" + "." + "custom_labels")
+			}
 
-// Validate validates this change agent params body proxysql exporter custom labels
-func (o *ChangeAgentParamsBodyProxysqlExporterCustomLabels) Validate(formats strfmt.Registry) error {
-	return nil
-}
+			return err
+		}
+	}
 
-// ContextValidate validates this change agent params body proxysql exporter custom labels based on context it is used
-func (o *ChangeAgentParamsBodyProxysqlExporterCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyProxysqlExporterCustomLabels) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANPostgresqlLogAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -9318,8 +12760,8 @@ func (o *ChangeAgentParamsBodyProxysqlExporterCustomLabels) MarshalBinary() ([]b
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyProxysqlExporterCustomLabels) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyProxysqlExporterCustomLabels
+func (o *ChangeAgentParamsBodyQANPostgresqlLogAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANPostgresqlLogAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -9328,32 +12770,26 @@ func (o *ChangeAgentParamsBodyProxysqlExporterCustomLabels) UnmarshalBinary(b []
 }
 
 /*
-ChangeAgentParamsBodyProxysqlExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ChangeAgentParamsBodyProxysqlExporterMetricsResolutions
+ChangeAgentParamsBodyQANPostgresqlLogAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyQANPostgresqlLogAgentCustomLabels
 */
-type ChangeAgentParamsBodyProxysqlExporterMetricsResolutions struct {
-	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Hr string `json:"hr,omitempty"`
-
-	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Mr string `json:"mr,omitempty"`
-
-	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Lr string `json:"lr,omitempty"`
+type ChangeAgentParamsBodyQANPostgresqlLogAgentCustomLabels struct {
+	// values
+	Values map[string]string `json:"values,omitempty"`
 }
 
-// Validate validates this change agent params body proxysql exporter metrics resolutions
-func (o *ChangeAgentParamsBodyProxysqlExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN postgresql log agent custom labels
+func (o *ChangeAgentParamsBodyQANPostgresqlLogAgentCustomLabels) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body proxysql exporter metrics resolutions based on context it is used
-func (o *ChangeAgentParamsBodyProxysqlExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body QAN postgresql log agent custom labels based on context it is used
+func (o *ChangeAgentParamsBodyQANPostgresqlLogAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyProxysqlExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANPostgresqlLogAgentCustomLabels) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -9361,8 +12797,8 @@ func (o *ChangeAgentParamsBodyProxysqlExporterMetricsResolutions) MarshalBinary(
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyProxysqlExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyProxysqlExporterMetricsResolutions
+func (o *ChangeAgentParamsBodyQANPostgresqlLogAgentCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANPostgresqlLogAgentCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -9371,20 +12807,20 @@ func (o *ChangeAgentParamsBodyProxysqlExporterMetricsResolutions) UnmarshalBinar
 }
 
 /*
-ChangeAgentParamsBodyQANMongodbMongologAgent change agent params body QAN mongodb mongolog agent
-swagger:model ChangeAgentParamsBodyQANMongodbMongologAgent
+ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent change agent params body QAN postgresql pgstatements agent
+swagger:model ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent
 */
-type ChangeAgentParamsBodyQANMongodbMongologAgent struct {
+type ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent struct {
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `json:"enable,omitempty"`
 
 	// Enables push metrics with vmagent.
 	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
 
-	// MongoDB username for getting mongolog data.
+	// PostgreSQL username for getting pg stat statements data.
 	Username *string `json:"username,omitempty"`
 
-	// MongoDB password for getting mongolog data.
+	// PostgreSQL password for getting pg stat statements data.
 	Password *string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -9393,23 +12829,20 @@ type ChangeAgentParamsBodyQANMongodbMongologAgent struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify *bool `json:"tls_skip_verify,omitempty"`
 
-	// Client certificate and key.
-	TLSCertificateKey *string `json:"tls_certificate_key,omitempty"`
-
-	// Password for decrypting tls_certificate_key.
-	TLSCertificateKeyFilePassword *string `json:"tls_certificate_key_file_password,omitempty"`
-
-	// Certificate Authority certificate chain.
-	TLSCa *string `json:"tls_ca,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing *bool `json:"disable_comments_parsing,omitempty"`
 
 	// Limit query length in QAN (default: server-defined; -1: no limit).
 	MaxQueryLength *int32 `json:"max_query_length,omitempty"`
 
-	// Authentication mechanism.
-	AuthenticationMechanism *string `json:"authentication_mechanism,omitempty"`
+	// TLS CA certificate.
+	TLSCa *string `json:"tls_ca,omitempty"`
 
-	// Authentication database.
-	AuthenticationDatabase *string `json:"authentication_database,omitempty"`
+	// TLS Certificate.
+	TLSCert *string `json:"tls_cert,omitempty"`
+
+	// TLS Certificate Key.
+	TLSKey *string `json:"tls_key,omitempty"`
 
 	// Log level for exporters
 	//
@@ -9421,14 +12854,14 @@ type ChangeAgentParamsBodyQANMongodbMongologAgent struct {
 	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
 
 	// custom labels
-	CustomLabels *ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels `json:"custom_labels,omitempty"`
+	CustomLabels *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels `json:"custom_labels,omitempty"`
 
 	// metrics resolutions
-	MetricsResolutions *ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions `json:"metrics_resolutions,omitempty"`
+	MetricsResolutions *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions `json:"metrics_resolutions,omitempty"`
 }
 
-// Validate validates this change agent params body QAN mongodb mongolog agent
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN postgresql pgstatements agent
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -9449,7 +12882,7 @@ func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) Validate(formats strfmt.R
 	return nil
 }
 
-var changeAgentParamsBodyQanMongodbMongologAgentTypeLogLevelPropEnum []any
+var changeAgentParamsBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -9457,53 +12890,53 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentParamsBodyQanMongodbMongologAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyQanMongodbMongologAgentTypeLogLevelPropEnum, v)
+		changeAgentParamsBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentParamsBodyQANMongodbMongologAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyQanMongodbMongologAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"qan_mongodb_mongolog_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_postgresql_pgstatements_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) validateCustomLabels(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) validateCustomLabels(formats strfmt.Registry) error {
 	if swag.IsZero(o.CustomLabels) { // not required
 		return nil
 	}
@@ -9512,11 +12945,11 @@ func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) validateCustomLabels(form
 		if err := o.CustomLabels.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_mongodb_mongolog_agent" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "qan_postgresql_pgstatements_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_mongodb_mongolog_agent" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "qan_postgresql_pgstatements_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -9526,7 +12959,7 @@ func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) validateCustomLabels(form
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) validateMetricsResolutions(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) validateMetricsResolutions(formats strfmt.Registry) error {
 	if swag.IsZero(o.MetricsResolutions) { // not required
 		return nil
 	}
@@ -9535,11 +12968,11 @@ func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) validateMetricsResolution
 		if err := o.MetricsResolutions.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_mongodb_mongolog_agent" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "qan_postgresql_pgstatements_agent" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_mongodb_mongolog_agent" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "qan_postgresql_pgstatements_agent" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -9549,8 +12982,8 @@ func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) validateMetricsResolution
 	return nil
 }
 
-// ContextValidate validate this change agent params body QAN mongodb mongolog agent based on the context it is used
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this change agent params body QAN postgresql pgstatements agent based on the context it is used
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
@@ -9567,7 +13000,7 @@ func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) ContextValidate(ctx conte
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
 	if o.CustomLabels != nil {
 
 		if swag.IsZero(o.CustomLabels) { // not required
@@ -9577,11 +13010,11 @@ func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) contextValidateCustomLabe
 		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_mongodb_mongolog_agent" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "qan_postgresql_pgstatements_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_mongodb_mongolog_agent" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "qan_postgresql_pgstatements_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -9591,7 +13024,7 @@ func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) contextValidateCustomLabe
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
 	if o.MetricsResolutions != nil {
 
 		if swag.IsZero(o.MetricsResolutions) { // not required
@@ -9601,11 +13034,11 @@ func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) contextValidateMetricsRes
 		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_mongodb_mongolog_agent" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "qan_postgresql_pgstatements_agent" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_mongodb_mongolog_agent" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "qan_postgresql_pgstatements_agent" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -9616,7 +13049,7 @@ func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) contextValidateMetricsRes
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -9624,8 +13057,8 @@ func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) MarshalBinary() ([]byte,
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANMongodbMongologAgent
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -9634,26 +13067,26 @@ func (o *ChangeAgentParamsBodyQANMongodbMongologAgent) UnmarshalBinary(b []byte)
 }
 
 /*
-ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
-swagger:model ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels
+ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels
 */
-type ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels struct {
+type ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels struct {
 	// values
 	Values map[string]string `json:"values,omitempty"`
 }
 
-// Validate validates this change agent params body QAN mongodb mongolog agent custom labels
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN postgresql pgstatements agent custom labels
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body QAN mongodb mongolog agent custom labels based on context it is used
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body QAN postgresql pgstatements agent custom labels based on context it is used
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -9661,8 +13094,8 @@ func (o *ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels) MarshalBinary
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -9671,10 +13104,10 @@ func (o *ChangeAgentParamsBodyQANMongodbMongologAgentCustomLabels) UnmarshalBina
 }
 
 /*
-ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions
+ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions
 */
-type ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions struct {
+type ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions struct {
 	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
 	Hr string `json:"hr,omitempty"`
 
@@ -9685,18 +13118,18 @@ type ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions struct {
 	Lr string `json:"lr,omitempty"`
 }
 
-// Validate validates this change agent params body QAN mongodb mongolog agent metrics resolutions
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN postgresql pgstatements agent metrics resolutions
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body QAN mongodb mongolog agent metrics resolutions based on context it is used
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body QAN postgresql pgstatements agent metrics resolutions based on context it is used
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -9704,8 +13137,8 @@ func (o *ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions) Marshal
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -9714,20 +13147,20 @@ func (o *ChangeAgentParamsBodyQANMongodbMongologAgentMetricsResolutions) Unmarsh
 }
 
 /*
-ChangeAgentParamsBodyQANMongodbProfilerAgent change agent params body QAN mongodb profiler agent
-swagger:model ChangeAgentParamsBodyQANMongodbProfilerAgent
+ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent change agent params body QAN postgresql pgstatmonitor agent
+swagger:model ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent
 */
-type ChangeAgentParamsBodyQANMongodbProfilerAgent struct {
+type ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent struct {
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `json:"enable,omitempty"`
 
 	// Enables push metrics with vmagent.
 	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
 
-	// MongoDB username for getting profile data.
+	// PostgreSQL username for getting pg stat monitor data.
 	Username *string `json:"username,omitempty"`
 
-	// MongoDB password for getting profile data.
+	// PostgreSQL password for getting pg stat monitor data.
 	Password *string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -9736,23 +13169,23 @@ type ChangeAgentParamsBodyQANMongodbProfilerAgent struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify *bool `json:"tls_skip_verify,omitempty"`
 
-	// Client certificate and key.
-	TLSCertificateKey *string `json:"tls_certificate_key,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength *int32 `json:"max_query_length,omitempty"`
 
-	// Password for decrypting tls_certificate_key.
-	TLSCertificateKeyFilePassword *string `json:"tls_certificate_key_file_password,omitempty"`
+	// Disable query examples.
+	DisableQueryExamples *bool `json:"disable_query_examples,omitempty"`
 
-	// Certificate Authority certificate chain.
-	TLSCa *string `json:"tls_ca,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing *bool `json:"disable_comments_parsing,omitempty"`
 
-	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength *int32 `json:"max_query_length,omitempty"`
+	// TLS CA certificate.
+	TLSCa *string `json:"tls_ca,omitempty"`
 
-	// Authentication mechanism.
-	AuthenticationMechanism *string `json:"authentication_mechanism,omitempty"`
+	// TLS Certificate.
+	TLSCert *string `json:"tls_cert,omitempty"`
 
-	// Authentication database.
-	AuthenticationDatabase *string `json:"authentication_database,omitempty"`
+	// TLS Certificate Key.
+	TLSKey *string `json:"tls_key,omitempty"`
 
 	// Log level for exporters
 	//
@@ -9764,14 +13197,14 @@ type ChangeAgentParamsBodyQANMongodbProfilerAgent struct {
 	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
 
 	// custom labels
-	CustomLabels *ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels `json:"custom_labels,omitempty"`
+	CustomLabels *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels `json:"custom_labels,omitempty"`
 
 	// metrics resolutions
-	MetricsResolutions *ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions `json:"metrics_resolutions,omitempty"`
+	MetricsResolutions *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions `json:"metrics_resolutions,omitempty"`
 }
 
-// Validate validates this change agent params body QAN mongodb profiler agent
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN postgresql pgstatmonitor agent
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -9792,7 +13225,7 @@ func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) Validate(formats strfmt.R
 	return nil
 }
 
-var changeAgentParamsBodyQanMongodbProfilerAgentTypeLogLevelPropEnum []any
+var changeAgentParamsBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -9800,53 +13233,53 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentParamsBodyQanMongodbProfilerAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyQanMongodbProfilerAgentTypeLogLevelPropEnum, v)
+		changeAgentParamsBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentParamsBodyQANMongodbProfilerAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyQanMongodbProfilerAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"qan_mongodb_profiler_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_postgresql_pgstatmonitor_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) validateCustomLabels(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) validateCustomLabels(formats strfmt.Registry) error {
 	if swag.IsZero(o.CustomLabels) { // not required
 		return nil
 	}
@@ -9855,11 +13288,11 @@ func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) validateCustomLabels(form
 		if err := o.CustomLabels.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_mongodb_profiler_agent" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "qan_postgresql_pgstatmonitor_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_mongodb_profiler_agent" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "qan_postgresql_pgstatmonitor_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -9869,7 +13302,7 @@ func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) validateCustomLabels(form
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) validateMetricsResolutions(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) validateMetricsResolutions(formats strfmt.Registry) error {
 	if swag.IsZero(o.MetricsResolutions) { // not required
 		return nil
 	}
@@ -9878,11 +13311,11 @@ func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) validateMetricsResolution
 		if err := o.MetricsResolutions.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_mongodb_profiler_agent" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "qan_postgresql_pgstatmonitor_agent" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_mongodb_profiler_agent" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "qan_postgresql_pgstatmonitor_agent" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -9892,8 +13325,8 @@ func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) validateMetricsResolution
 	return nil
 }
 
-// ContextValidate validate this change agent params body QAN mongodb profiler agent based on the context it is used
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this change agent params body QAN postgresql pgstatmonitor agent based on the context it is used
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
@@ -9910,7 +13343,7 @@ func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) ContextValidate(ctx conte
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
 	if o.CustomLabels != nil {
 
 		if swag.IsZero(o.CustomLabels) { // not required
@@ -9920,11 +13353,11 @@ func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) contextValidateCustomLabe
 		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_mongodb_profiler_agent" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "qan_postgresql_pgstatmonitor_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_mongodb_profiler_agent" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "qan_postgresql_pgstatmonitor_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -9934,7 +13367,7 @@ func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) contextValidateCustomLabe
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
 	if o.MetricsResolutions != nil {
 
 		if swag.IsZero(o.MetricsResolutions) { // not required
@@ -9944,11 +13377,11 @@ func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) contextValidateMetricsRes
 		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_mongodb_profiler_agent" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "qan_postgresql_pgstatmonitor_agent" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_mongodb_profiler_agent" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "qan_postgresql_pgstatmonitor_agent" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -9959,7 +13392,7 @@ func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) contextValidateMetricsRes
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -9967,8 +13400,8 @@ func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) MarshalBinary() ([]byte,
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANMongodbProfilerAgent
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -9977,26 +13410,26 @@ func (o *ChangeAgentParamsBodyQANMongodbProfilerAgent) UnmarshalBinary(b []byte)
 }
 
 /*
-ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
-swagger:model ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels
+ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels
 */
-type ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels struct {
+type ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels struct {
 	// values
 	Values map[string]string `json:"values,omitempty"`
 }
 
-// Validate validates this change agent params body QAN mongodb profiler agent custom labels
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN postgresql pgstatmonitor agent custom labels
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body QAN mongodb profiler agent custom labels based on context it is used
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body QAN postgresql pgstatmonitor agent custom labels based on context it is used
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -10004,8 +13437,8 @@ func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels) MarshalBinary
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -10014,10 +13447,10 @@ func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentCustomLabels) UnmarshalBina
 }
 
 /*
-ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions
+ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions
 */
-type ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions struct {
+type ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions struct {
 	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
 	Hr string `json:"hr,omitempty"`
 
@@ -10028,18 +13461,18 @@ type ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions struct {
 	Lr string `json:"lr,omitempty"`
 }
 
-// Validate validates this change agent params body QAN mongodb profiler agent metrics resolutions
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN postgresql pgstatmonitor agent metrics resolutions
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body QAN mongodb profiler agent metrics resolutions based on context it is used
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body QAN postgresql pgstatmonitor agent metrics resolutions based on context it is used
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -10047,8 +13480,8 @@ func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions) Marshal
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions
+func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -10057,20 +13490,17 @@ func (o *ChangeAgentParamsBodyQANMongodbProfilerAgentMetricsResolutions) Unmarsh
 }
 
 /*
-ChangeAgentParamsBodyQANMysqlPerfschemaAgent change agent params body QAN mysql perfschema agent
-swagger:model ChangeAgentParamsBodyQANMysqlPerfschemaAgent
+ChangeAgentParamsBodyQANProxysqlDigestAgent change agent params body QAN proxysql digest agent
+swagger:model ChangeAgentParamsBodyQANProxysqlDigestAgent
 */
-type ChangeAgentParamsBodyQANMysqlPerfschemaAgent struct {
+type ChangeAgentParamsBodyQANProxysqlDigestAgent struct {
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `json:"enable,omitempty"`
 
-	// Enables push metrics with vmagent.
-	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
-
-	// MySQL username for getting performance data.
+	// ProxySQL username for getting query digest data.
 	Username *string `json:"username,omitempty"`
 
-	// MySQL password for getting performance data.
+	// ProxySQL password for getting query digest data.
 	Password *string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -10079,42 +13509,24 @@ type ChangeAgentParamsBodyQANMysqlPerfschemaAgent struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify *bool `json:"tls_skip_verify,omitempty"`
 
-	// Certificate Authority certificate chain.
-	TLSCa *string `json:"tls_ca,omitempty"`
-
-	// Client certificate.
-	TLSCert *string `json:"tls_cert,omitempty"`
-
-	// Password for decrypting tls_cert.
-	TLSKey *string `json:"tls_key,omitempty"`
-
 	// Limit query length in QAN (default: server-defined; -1: no limit).
 	MaxQueryLength *int32 `json:"max_query_length,omitempty"`
 
-	// Disable query examples.
-	DisableQueryExamples *bool `json:"disable_query_examples,omitempty"`
-
-	// Skip connection check.
-	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
-
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing *bool `json:"disable_comments_parsing,omitempty"`
-
 	// Log level for exporters
 	//
 	// - LOG_LEVEL_UNSPECIFIED: Auto
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
 
-	// custom labels
-	CustomLabels *ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels `json:"custom_labels,omitempty"`
+	// Skip connection check.
+	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
 
-	// metrics resolutions
-	MetricsResolutions *ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions `json:"metrics_resolutions,omitempty"`
+	// custom labels
+	CustomLabels *ChangeAgentParamsBodyQANProxysqlDigestAgentCustomLabels `json:"custom_labels,omitempty"`
 }
 
-// Validate validates this change agent params body QAN mysql perfschema agent
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN proxysql digest agent
+func (o *ChangeAgentParamsBodyQANProxysqlDigestAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -10125,17 +13537,13 @@ func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) Validate(formats strfmt.R
 		res = append(res, err)
 	}
 
-	if err := o.validateMetricsResolutions(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
-var changeAgentParamsBodyQanMysqlPerfschemaAgentTypeLogLevelPropEnum []any
+var changeAgentParamsBodyQanProxysqlDigestAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -10143,53 +13551,53 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentParamsBodyQanMysqlPerfschemaAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyQanMysqlPerfschemaAgentTypeLogLevelPropEnum, v)
+		changeAgentParamsBodyQanProxysqlDigestAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyQanProxysqlDigestAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentParamsBodyQANMysqlPerfschemaAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyQANProxysqlDigestAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyQanMysqlPerfschemaAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentParamsBodyQANProxysqlDigestAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyQanProxysqlDigestAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANProxysqlDigestAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"qan_mysql_perfschema_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_proxysql_digest_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) validateCustomLabels(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANProxysqlDigestAgent) validateCustomLabels(formats strfmt.Registry) error {
 	if swag.IsZero(o.CustomLabels) { // not required
 		return nil
 	}
@@ -10198,34 +13606,11 @@ func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) validateCustomLabels(form
 		if err := o.CustomLabels.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_mysql_perfschema_agent" + "." + "custom_labels")
-			}
-			ce := new(errors.CompositeError)
-			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_mysql_perfschema_agent" + "." + "custom_labels")
-			}
-
-			return err
-		}
-	}
-
-	return nil
-}
-
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) validateMetricsResolutions(formats strfmt.Registry) error {
-	if swag.IsZero(o.MetricsResolutions) { // not required
-		return nil
-	}
-
-	if o.MetricsResolutions != nil {
-		if err := o.MetricsResolutions.Validate(formats); err != nil {
-			ve := new(errors.Validation)
-			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_mysql_perfschema_agent" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "qan_proxysql_digest_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_mysql_perfschema_agent" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "qan_proxysql_digest_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -10235,111 +13620,46 @@ func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) validateMetricsResolution
 	return nil
 }
 
-// ContextValidate validate this change agent params body QAN mysql perfschema agent based on the context it is used
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this change agent params body QAN proxysql digest agent based on the context it is used
+func (o *ChangeAgentParamsBodyQANProxysqlDigestAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
-	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
-		res = append(res, err)
-	}
-
-	if len(res) > 0 {
-		return errors.CompositeValidationError(res...)
-	}
-	return nil
-}
-
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
-	if o.CustomLabels != nil {
-
-		if swag.IsZero(o.CustomLabels) { // not required
-			return nil
-		}
-
-		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
-			ve := new(errors.Validation)
-			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_mysql_perfschema_agent" + "." + "custom_labels")
-			}
-			ce := new(errors.CompositeError)
-			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_mysql_perfschema_agent" + "." + "custom_labels")
-			}
-
-			return err
-		}
-	}
-
-	return nil
-}
-
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
-	if o.MetricsResolutions != nil {
-
-		if swag.IsZero(o.MetricsResolutions) { // not required
-			return nil
-		}
-
-		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
-			ve := new(errors.Validation)
-			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_mysql_perfschema_agent" + "." + "metrics_resolutions")
-			}
-			ce := new(errors.CompositeError)
-			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_mysql_perfschema_agent" + "." + "metrics_resolutions")
-			}
-
-			return err
-		}
-	}
-
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) MarshalBinary() ([]byte, error) {
-	if o == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(o)
-}
-
-// UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgent) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANMysqlPerfschemaAgent
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*o = res
-	return nil
-}
-
-/*
-ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
-swagger:model ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels
-*/
-type ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels struct {
-	// values
-	Values map[string]string `json:"values,omitempty"`
-}
-
-// Validate validates this change agent params body QAN mysql perfschema agent custom labels
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels) Validate(formats strfmt.Registry) error {
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
 	return nil
 }
 
-// ContextValidate validates this change agent params body QAN mysql perfschema agent custom labels based on context it is used
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANProxysqlDigestAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+	if o.CustomLabels != nil {
+
+		if swag.IsZero(o.CustomLabels) { // not required
+			return nil
+		}
+
+		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("body" + "." + "qan_proxysql_digest_agent" + "." + "custom_labels")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("body" + "." + "qan_proxysql_digest_agent" + "." + "custom_labels")
+			}
+
+			return err
+		}
+	}
+
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANProxysqlDigestAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -10347,8 +13667,8 @@ func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels) MarshalBinary
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels
+func (o *ChangeAgentParamsBodyQANProxysqlDigestAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANProxysqlDigestAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -10357,32 +13677,26 @@ func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentCustomLabels) UnmarshalBina
 }
 
 /*
-ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions
+ChangeAgentParamsBodyQANProxysqlDigestAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyQANProxysqlDigestAgentCustomLabels
 */
-type ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions struct {
-	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Hr string `json:"hr,omitempty"`
-
-	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Mr string `json:"mr,omitempty"`
-
-	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Lr string `json:"lr,omitempty"`
+type ChangeAgentParamsBodyQANProxysqlDigestAgentCustomLabels struct {
+	// values
+	Values map[string]string `json:"values,omitempty"`
 }
 
-// Validate validates this change agent params body QAN mysql perfschema agent metrics resolutions
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN proxysql digest agent custom labels
+func (o *ChangeAgentParamsBodyQANProxysqlDigestAgentCustomLabels) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body QAN mysql perfschema agent metrics resolutions based on context it is used
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body QAN proxysql digest agent custom labels based on context it is used
+func (o *ChangeAgentParamsBodyQANProxysqlDigestAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANProxysqlDigestAgentCustomLabels) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -10390,8 +13704,8 @@ func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions) Marshal
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions
+func (o *ChangeAgentParamsBodyQANProxysqlDigestAgentCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANProxysqlDigestAgentCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -10400,20 +13714,17 @@ func (o *ChangeAgentParamsBodyQANMysqlPerfschemaAgentMetricsResolutions) Unmarsh
 }
 
 /*
-ChangeAgentParamsBodyQANMysqlSlowlogAgent change agent params body QAN mysql slowlog agent
-swagger:model ChangeAgentParamsBodyQANMysqlSlowlogAgent
+ChangeAgentParamsBodyQANValkeySlowlogAgent change agent params body QAN valkey slowlog agent
+swagger:model ChangeAgentParamsBodyQANValkeySlowlogAgent
 */
-type ChangeAgentParamsBodyQANMysqlSlowlogAgent struct {
+type ChangeAgentParamsBodyQANValkeySlowlogAgent struct {
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `json:"enable,omitempty"`
 
-	// Enables push metrics with vmagent.
-	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
-
-	// MySQL username for getting slowlog data.
+	// Valkey username for getting slow log data.
 	Username *string `json:"username,omitempty"`
 
-	// MySQL password for getting slowlog data.
+	// Valkey password for getting slow log data.
 	Password *string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -10422,13 +13733,13 @@ type ChangeAgentParamsBodyQANMysqlSlowlogAgent struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify *bool `json:"tls_skip_verify,omitempty"`
 
-	// Certificate Authority certificate chain.
+	// TLS CA certificate.
 	TLSCa *string `json:"tls_ca,omitempty"`
 
-	// Client certificate.
+	// TLS Certifcate.
 	TLSCert *string `json:"tls_cert,omitempty"`
 
-	// Password for decrypting tls_cert.
+	// TLS Certificate Key.
 	TLSKey *string `json:"tls_key,omitempty"`
 
 	// Limit query length in QAN (default: server-defined; -1: no limit).
@@ -10437,30 +13748,21 @@ type ChangeAgentParamsBodyQANMysqlSlowlogAgent struct {
 	// Disable query examples.
 	DisableQueryExamples *bool `json:"disable_query_examples,omitempty"`
 
-	// Rotate slowlog file at this size if > 0.
-	MaxSlowlogFileSize *string `json:"max_slowlog_file_size,omitempty"`
-
-	// Skip connection check.
-	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
-
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing *bool `json:"disable_comments_parsing,omitempty"`
-
 	// Log level for exporters
 	//
 	// - LOG_LEVEL_UNSPECIFIED: Auto
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
 
-	// custom labels
-	CustomLabels *ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels `json:"custom_labels,omitempty"`
+	// Skip connection check.
+	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
 
-	// metrics resolutions
-	MetricsResolutions *ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions `json:"metrics_resolutions,omitempty"`
+	// custom labels
+	CustomLabels *ChangeAgentParamsBodyQANValkeySlowlogAgentCustomLabels `json:"custom_labels,omitempty"`
 }
 
-// Validate validates this change agent params body QAN mysql slowlog agent
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN valkey slowlog agent
+func (o *ChangeAgentParamsBodyQANValkeySlowlogAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -10471,17 +13773,13 @@ func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) Validate(formats strfmt.Regi
 		res = append(res, err)
 	}
 
-	if err := o.validateMetricsResolutions(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
-var changeAgentParamsBodyQanMysqlSlowlogAgentTypeLogLevelPropEnum []any
+var changeAgentParamsBodyQanValkeySlowlogAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -10489,53 +13787,53 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentParamsBodyQanMysqlSlowlogAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyQanMysqlSlowlogAgentTypeLogLevelPropEnum, v)
+		changeAgentParamsBodyQanValkeySlowlogAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyQanValkeySlowlogAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentParamsBodyQANMysqlSlowlogAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyQANValkeySlowlogAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyQanMysqlSlowlogAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentParamsBodyQANValkeySlowlogAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyQanValkeySlowlogAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANValkeySlowlogAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"qan_mysql_slowlog_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"qan_valkey_slowlog_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) validateCustomLabels(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANValkeySlowlogAgent) validateCustomLabels(formats strfmt.Registry) error {
 	if swag.IsZero(o.CustomLabels) { // not required
 		return nil
 	}
@@ -10544,34 +13842,11 @@ func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) validateCustomLabels(formats
 		if err := o.CustomLabels.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_mysql_slowlog_agent" + "." + "custom_labels")
-			}
-			ce := new(errors.CompositeError)
-			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_mysql_slowlog_agent" + "." + "custom_labels")
-			}
-
-			return err
-		}
-	}
-
-	return nil
-}
-
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) validateMetricsResolutions(formats strfmt.Registry) error {
-	if swag.IsZero(o.MetricsResolutions) { // not required
-		return nil
-	}
-
-	if o.MetricsResolutions != nil {
-		if err := o.MetricsResolutions.Validate(formats); err != nil {
-			ve := new(errors.Validation)
-			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_mysql_slowlog_agent" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "qan_valkey_slowlog_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_mysql_slowlog_agent" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "qan_valkey_slowlog_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -10581,25 +13856,21 @@ func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) validateMetricsResolutions(f
 	return nil
 }
 
-// ContextValidate validate this change agent params body QAN mysql slowlog agent based on the context it is used
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this change agent params body QAN valkey slowlog agent based on the context it is used
+func (o *ChangeAgentParamsBodyQANValkeySlowlogAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
-	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
-		res = append(res, err)
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyQANValkeySlowlogAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
 	if o.CustomLabels != nil {
 
 		if swag.IsZero(o.CustomLabels) { // not required
@@ -10609,35 +13880,11 @@ func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) contextValidateCustomLabels(
 		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_mysql_slowlog_agent" + "." + "custom_labels")
-			}
-			ce := new(errors.CompositeError)
-			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_mysql_slowlog_agent" + "." + "custom_labels")
-			}
-
-			return err
-		}
-	}
-
-	return nil
-}
-
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
-	if o.MetricsResolutions != nil {
-
-		if swag.IsZero(o.MetricsResolutions) { // not required
-			return nil
-		}
-
-		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
-			ve := new(errors.Validation)
-			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_mysql_slowlog_agent" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "qan_valkey_slowlog_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_mysql_slowlog_agent" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "qan_valkey_slowlog_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -10648,7 +13895,7 @@ func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) contextValidateMetricsResolu
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANValkeySlowlogAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -10656,8 +13903,8 @@ func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) MarshalBinary() ([]byte, err
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANMysqlSlowlogAgent
+func (o *ChangeAgentParamsBodyQANValkeySlowlogAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANValkeySlowlogAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -10666,69 +13913,26 @@ func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgent) UnmarshalBinary(b []byte) er
 }
 
 /*
-ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
-swagger:model ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels
+ChangeAgentParamsBodyQANValkeySlowlogAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyQANValkeySlowlogAgentCustomLabels
 */
-type ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels struct {
+type ChangeAgentParamsBodyQANValkeySlowlogAgentCustomLabels struct {
 	// values
 	Values map[string]string `json:"values,omitempty"`
 }
 
-// Validate validates this change agent params body QAN mysql slowlog agent custom labels
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels) Validate(formats strfmt.Registry) error {
-	return nil
-}
-
-// ContextValidate validates this change agent params body QAN mysql slowlog agent custom labels based on context it is used
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
-	return nil
-}
-
-// MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels) MarshalBinary() ([]byte, error) {
-	if o == nil {
-		return nil, nil
-	}
-	return swag.WriteJSON(o)
-}
-
-// UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANMysqlSlowlogAgentCustomLabels
-	if err := swag.ReadJSON(b, &res); err != nil {
-		return err
-	}
-	*o = res
-	return nil
-}
-
-/*
-ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions
-*/
-type ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions struct {
-	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Hr string `json:"hr,omitempty"`
-
-	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Mr string `json:"mr,omitempty"`
-
-	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Lr string `json:"lr,omitempty"`
-}
-
-// Validate validates this change agent params body QAN mysql slowlog agent metrics resolutions
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body QAN valkey slowlog agent custom labels
+func (o *ChangeAgentParamsBodyQANValkeySlowlogAgentCustomLabels) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body QAN mysql slowlog agent metrics resolutions based on context it is used
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body QAN valkey slowlog agent custom labels based on context it is used
+func (o *ChangeAgentParamsBodyQANValkeySlowlogAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyQANValkeySlowlogAgentCustomLabels) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -10736,8 +13940,8 @@ func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions) MarshalBin
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions
+func (o *ChangeAgentParamsBodyQANValkeySlowlogAgentCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyQANValkeySlowlogAgentCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -10746,42 +13950,27 @@ func (o *ChangeAgentParamsBodyQANMysqlSlowlogAgentMetricsResolutions) UnmarshalB
 }
 
 /*
-ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent change agent params body QAN postgresql pgstatements agent
-swagger:model ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent
+ChangeAgentParamsBodyRDSExporter change agent params body RDS exporter
+swagger:model ChangeAgentParamsBodyRDSExporter
 */
-type ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent struct {
+type ChangeAgentParamsBodyRDSExporter struct {
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `json:"enable,omitempty"`
 
 	// Enables push metrics with vmagent.
 	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
 
-	// PostgreSQL username for getting pg stat statements data.
-	Username *string `json:"username,omitempty"`
-
-	// PostgreSQL password for getting pg stat statements data.
-	Password *string `json:"password,omitempty"`
-
-	// Use TLS for database connections.
-	TLS *bool `json:"tls,omitempty"`
-
-	// Skip TLS certificate and hostname validation.
-	TLSSkipVerify *bool `json:"tls_skip_verify,omitempty"`
-
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing *bool `json:"disable_comments_parsing,omitempty"`
-
-	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength *int32 `json:"max_query_length,omitempty"`
+	// AWS Access Key.
+	AWSAccessKey *string `json:"aws_access_key,omitempty"`
 
-	// TLS CA certificate.
-	TLSCa *string `json:"tls_ca,omitempty"`
+	// AWS Secret Key.
+	AWSSecretKey *string `json:"aws_secret_key,omitempty"`
 
-	// TLS Certificate.
-	TLSCert *string `json:"tls_cert,omitempty"`
+	// Disable basic metrics.
+	DisableBasicMetrics *bool `json:"disable_basic_metrics,omitempty"`
 
-	// TLS Certificate Key.
-	TLSKey *string `json:"tls_key,omitempty"`
+	// Disable enhanced metrics.
+	DisableEnhancedMetrics *bool `json:"disable_enhanced_metrics,omitempty"`
 
 	// Log level for exporters
 	//
@@ -10789,18 +13978,15 @@ type ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent struct {
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
 
-	// Skip connection check.
-	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
-
 	// custom labels
-	CustomLabels *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels `json:"custom_labels,omitempty"`
+	CustomLabels *ChangeAgentParamsBodyRDSExporterCustomLabels `json:"custom_labels,omitempty"`
 
 	// metrics resolutions
-	MetricsResolutions *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions `json:"metrics_resolutions,omitempty"`
+	MetricsResolutions *ChangeAgentParamsBodyRDSExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
 }
 
-// Validate validates this change agent params body QAN postgresql pgstatements agent
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body RDS exporter
+func (o *ChangeAgentParamsBodyRDSExporter) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -10821,7 +14007,7 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) Validate(formats s
 	return nil
 }
 
-var changeAgentParamsBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum []any
+var changeAgentParamsBodyRdsExporterTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -10829,53 +14015,53 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentParamsBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum, v)
+		changeAgentParamsBodyRdsExporterTypeLogLevelPropEnum = append(changeAgentParamsBodyRdsExporterTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyQanPostgresqlPgstatementsAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentParamsBodyRDSExporter) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyRdsExporterTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyRDSExporter) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"qan_postgresql_pgstatements_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"rds_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) validateCustomLabels(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyRDSExporter) validateCustomLabels(formats strfmt.Registry) error {
 	if swag.IsZero(o.CustomLabels) { // not required
 		return nil
 	}
@@ -10884,11 +14070,11 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) validateCustomLabe
 		if err := o.CustomLabels.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_postgresql_pgstatements_agent" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "rds_exporter" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_postgresql_pgstatements_agent" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "rds_exporter" + "." + "custom_labels")
 			}
 
 			return err
@@ -10898,7 +14084,7 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) validateCustomLabe
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) validateMetricsResolutions(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyRDSExporter) validateMetricsResolutions(formats strfmt.Registry) error {
 	if swag.IsZero(o.MetricsResolutions) { // not required
 		return nil
 	}
@@ -10907,11 +14093,11 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) validateMetricsRes
 		if err := o.MetricsResolutions.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_postgresql_pgstatements_agent" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "rds_exporter" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_postgresql_pgstatements_agent" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "rds_exporter" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -10921,8 +14107,8 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) validateMetricsRes
 	return nil
 }
 
-// ContextValidate validate this change agent params body QAN postgresql pgstatements agent based on the context it is used
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this change agent params body RDS exporter based on the context it is used
+func (o *ChangeAgentParamsBodyRDSExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
@@ -10939,7 +14125,7 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) ContextValidate(ct
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyRDSExporter) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
 	if o.CustomLabels != nil {
 
 		if swag.IsZero(o.CustomLabels) { // not required
@@ -10949,11 +14135,11 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) contextValidateCus
 		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_postgresql_pgstatements_agent" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "rds_exporter" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_postgresql_pgstatements_agent" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "rds_exporter" + "." + "custom_labels")
 			}
 
 			return err
@@ -10963,7 +14149,7 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) contextValidateCus
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyRDSExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
 	if o.MetricsResolutions != nil {
 
 		if swag.IsZero(o.MetricsResolutions) { // not required
@@ -10973,11 +14159,11 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) contextValidateMet
 		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_postgresql_pgstatements_agent" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "rds_exporter" + "." + "metrics_resolutions")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_postgresql_pgstatements_agent" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "rds_exporter" + "." + "metrics_resolutions")
 			}
 
 			return err
@@ -10988,7 +14174,7 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) contextValidateMet
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyRDSExporter) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -10996,8 +14182,8 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) MarshalBinary() ([
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent
+func (o *ChangeAgentParamsBodyRDSExporter) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyRDSExporter
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -11006,26 +14192,26 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgent) UnmarshalBinary(b
 }
 
 /*
-ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
-swagger:model ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels
+ChangeAgentParamsBodyRDSExporterCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyRDSExporterCustomLabels
 */
-type ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels struct {
+type ChangeAgentParamsBodyRDSExporterCustomLabels struct {
 	// values
 	Values map[string]string `json:"values,omitempty"`
 }
 
-// Validate validates this change agent params body QAN postgresql pgstatements agent custom labels
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body RDS exporter custom labels
+func (o *ChangeAgentParamsBodyRDSExporterCustomLabels) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body QAN postgresql pgstatements agent custom labels based on context it is used
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body RDS exporter custom labels based on context it is used
+func (o *ChangeAgentParamsBodyRDSExporterCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyRDSExporterCustomLabels) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -11033,8 +14219,8 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels) Marsha
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels
+func (o *ChangeAgentParamsBodyRDSExporterCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyRDSExporterCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -11043,10 +14229,10 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentCustomLabels) Unmars
 }
 
 /*
-ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions
+ChangeAgentParamsBodyRDSExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ChangeAgentParamsBodyRDSExporterMetricsResolutions
 */
-type ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions struct {
+type ChangeAgentParamsBodyRDSExporterMetricsResolutions struct {
 	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
 	Hr string `json:"hr,omitempty"`
 
@@ -11057,18 +14243,18 @@ type ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions struc
 	Lr string `json:"lr,omitempty"`
 }
 
-// Validate validates this change agent params body QAN postgresql pgstatements agent metrics resolutions
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body RDS exporter metrics resolutions
+func (o *ChangeAgentParamsBodyRDSExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body QAN postgresql pgstatements agent metrics resolutions based on context it is used
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body RDS exporter metrics resolutions based on context it is used
+func (o *ChangeAgentParamsBodyRDSExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyRDSExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -11076,8 +14262,8 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions)
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions
+func (o *ChangeAgentParamsBodyRDSExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyRDSExporterMetricsResolutions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -11086,20 +14272,23 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatementsAgentMetricsResolutions)
 }
 
 /*
-ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent change agent params body QAN postgresql pgstatmonitor agent
-swagger:model ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent
+ChangeAgentParamsBodyRtaMongodbAgent change agent params body rta mongodb agent
+swagger:model ChangeAgentParamsBodyRtaMongodbAgent
 */
-type ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent struct {
+type ChangeAgentParamsBodyRtaMongodbAgent struct {
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `json:"enable,omitempty"`
 
-	// Enables push metrics with vmagent.
-	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
 
-	// PostgreSQL username for getting pg stat monitor data.
+	// MongoDB username for getting profile data.
 	Username *string `json:"username,omitempty"`
 
-	// PostgreSQL password for getting pg stat monitor data.
+	// MongoDB password for getting profile data.
 	Password *string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -11108,42 +14297,30 @@ type ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify *bool `json:"tls_skip_verify,omitempty"`
 
-	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength *int32 `json:"max_query_length,omitempty"`
-
-	// Disable query examples.
-	DisableQueryExamples *bool `json:"disable_query_examples,omitempty"`
+	// Client certificate and key.
+	TLSCertificateKey *string `json:"tls_certificate_key,omitempty"`
 
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing *bool `json:"disable_comments_parsing,omitempty"`
+	// Password for decrypting tls_certificate_key.
+	TLSCertificateKeyFilePassword *string `json:"tls_certificate_key_file_password,omitempty"`
 
-	// TLS CA certificate.
+	// Certificate Authority certificate chain.
 	TLSCa *string `json:"tls_ca,omitempty"`
 
-	// TLS Certificate.
-	TLSCert *string `json:"tls_cert,omitempty"`
-
-	// TLS Certificate Key.
-	TLSKey *string `json:"tls_key,omitempty"`
-
-	// Log level for exporters
-	//
-	// - LOG_LEVEL_UNSPECIFIED: Auto
-	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
-	LogLevel *string `json:"log_level,omitempty"`
+	// Authentication mechanism.
+	AuthenticationMechanism *string `json:"authentication_mechanism,omitempty"`
 
 	// Skip connection check.
 	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
 
 	// custom labels
-	CustomLabels *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels `json:"custom_labels,omitempty"`
+	CustomLabels *ChangeAgentParamsBodyRtaMongodbAgentCustomLabels `json:"custom_labels,omitempty"`
 
-	// metrics resolutions
-	MetricsResolutions *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions `json:"metrics_resolutions,omitempty"`
+	// rta options
+	RtaOptions *ChangeAgentParamsBodyRtaMongodbAgentRtaOptions `json:"rta_options,omitempty"`
 }
 
-// Validate validates this change agent params body QAN postgresql pgstatmonitor agent
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body rta mongodb agent
+func (o *ChangeAgentParamsBodyRtaMongodbAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -11154,7 +14331,7 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) Validate(formats
 		res = append(res, err)
 	}
 
-	if err := o.validateMetricsResolutions(formats); err != nil {
+	if err := o.validateRtaOptions(formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -11164,7 +14341,7 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) Validate(formats
 	return nil
 }
 
-var changeAgentParamsBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum []any
+var changeAgentParamsBodyRtaMongodbAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -11172,53 +14349,53 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentParamsBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum, v)
+		changeAgentParamsBodyRtaMongodbAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyRtaMongodbAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyQanPostgresqlPgstatmonitorAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentParamsBodyRtaMongodbAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyRtaMongodbAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyRtaMongodbAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"qan_postgresql_pgstatmonitor_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"rta_mongodb_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) validateCustomLabels(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyRtaMongodbAgent) validateCustomLabels(formats strfmt.Registry) error {
 	if swag.IsZero(o.CustomLabels) { // not required
 		return nil
 	}
@@ -11227,11 +14404,11 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) validateCustomLab
 		if err := o.CustomLabels.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_postgresql_pgstatmonitor_agent" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_postgresql_pgstatmonitor_agent" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -11241,20 +14418,20 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) validateCustomLab
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) validateMetricsResolutions(formats strfmt.Registry) error {
-	if swag.IsZero(o.MetricsResolutions) { // not required
+func (o *ChangeAgentParamsBodyRtaMongodbAgent) validateRtaOptions(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaOptions) { // not required
 		return nil
 	}
 
-	if o.MetricsResolutions != nil {
-		if err := o.MetricsResolutions.Validate(formats); err != nil {
+	if o.RtaOptions != nil {
+		if err := o.RtaOptions.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_postgresql_pgstatmonitor_agent" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "rta_options")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_postgresql_pgstatmonitor_agent" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "rta_options")
 			}
 
 			return err
@@ -11264,15 +14441,15 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) validateMetricsRe
 	return nil
 }
 
-// ContextValidate validate this change agent params body QAN postgresql pgstatmonitor agent based on the context it is used
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this change agent params body rta mongodb agent based on the context it is used
+func (o *ChangeAgentParamsBodyRtaMongodbAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
-	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
+	if err := o.contextValidateRtaOptions(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -11282,7 +14459,7 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) ContextValidate(c
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyRtaMongodbAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
 	if o.CustomLabels != nil {
 
 		if swag.IsZero(o.CustomLabels) { // not required
@@ -11292,11 +14469,11 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) contextValidateCu
 		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_postgresql_pgstatmonitor_agent" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_postgresql_pgstatmonitor_agent" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -11306,21 +14483,21 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) contextValidateCu
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
-	if o.MetricsResolutions != nil {
+func (o *ChangeAgentParamsBodyRtaMongodbAgent) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaOptions != nil {
 
-		if swag.IsZero(o.MetricsResolutions) { // not required
+		if swag.IsZero(o.RtaOptions) { // not required
 			return nil
 		}
 
-		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
+		if err := o.RtaOptions.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "qan_postgresql_pgstatmonitor_agent" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "rta_options")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "qan_postgresql_pgstatmonitor_agent" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "rta_options")
 			}
 
 			return err
@@ -11331,7 +14508,7 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) contextValidateMe
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyRtaMongodbAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -11339,8 +14516,8 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) MarshalBinary() (
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent
+func (o *ChangeAgentParamsBodyRtaMongodbAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyRtaMongodbAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -11349,26 +14526,26 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgent) UnmarshalBinary(b
 }
 
 /*
-ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
-swagger:model ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels
+ChangeAgentParamsBodyRtaMongodbAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyRtaMongodbAgentCustomLabels
 */
-type ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels struct {
+type ChangeAgentParamsBodyRtaMongodbAgentCustomLabels struct {
 	// values
 	Values map[string]string `json:"values,omitempty"`
 }
 
-// Validate validates this change agent params body QAN postgresql pgstatmonitor agent custom labels
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body rta mongodb agent custom labels
+func (o *ChangeAgentParamsBodyRtaMongodbAgentCustomLabels) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body QAN postgresql pgstatmonitor agent custom labels based on context it is used
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body rta mongodb agent custom labels based on context it is used
+func (o *ChangeAgentParamsBodyRtaMongodbAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyRtaMongodbAgentCustomLabels) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -11376,8 +14553,8 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels) Marsh
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels
+func (o *ChangeAgentParamsBodyRtaMongodbAgentCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyRtaMongodbAgentCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -11386,32 +14563,26 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentCustomLabels) Unmar
 }
 
 /*
-ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions
+ChangeAgentParamsBodyRtaMongodbAgentRtaOptions RTAOptions holds Real-Time Query Analytics agent options.
+swagger:model ChangeAgentParamsBodyRtaMongodbAgentRtaOptions
 */
-type ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions struct {
-	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Hr string `json:"hr,omitempty"`
-
-	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Mr string `json:"mr,omitempty"`
-
-	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Lr string `json:"lr,omitempty"`
+type ChangeAgentParamsBodyRtaMongodbAgentRtaOptions struct {
+	// Query collect interval (default 2s is set by server).
+	CollectInterval string `json:"collect_interval,omitempty"`
 }
 
-// Validate validates this change agent params body QAN postgresql pgstatmonitor agent metrics resolutions
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body rta mongodb agent rta options
+func (o *ChangeAgentParamsBodyRtaMongodbAgentRtaOptions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body QAN postgresql pgstatmonitor agent metrics resolutions based on context it is used
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body rta mongodb agent rta options based on context it is used
+func (o *ChangeAgentParamsBodyRtaMongodbAgentRtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyRtaMongodbAgentRtaOptions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -11419,8 +14590,8 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions)
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions
+func (o *ChangeAgentParamsBodyRtaMongodbAgentRtaOptions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyRtaMongodbAgentRtaOptions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -11429,43 +14600,52 @@ func (o *ChangeAgentParamsBodyQANPostgresqlPgstatmonitorAgentMetricsResolutions)
 }
 
 /*
-ChangeAgentParamsBodyRDSExporter change agent params body RDS exporter
-swagger:model ChangeAgentParamsBodyRDSExporter
+ChangeAgentParamsBodyRtaMysqlAgent change agent params body rta mysql agent
+swagger:model ChangeAgentParamsBodyRtaMysqlAgent
 */
-type ChangeAgentParamsBodyRDSExporter struct {
+type ChangeAgentParamsBodyRtaMysqlAgent struct {
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `json:"enable,omitempty"`
 
-	// Enables push metrics with vmagent.
-	EnablePushMetrics *bool `json:"enable_push_metrics,omitempty"`
-
-	// AWS Access Key.
-	AWSAccessKey *string `json:"aws_access_key,omitempty"`
-
-	// AWS Secret Key.
-	AWSSecretKey *string `json:"aws_secret_key,omitempty"`
-
-	// Disable basic metrics.
-	DisableBasicMetrics *bool `json:"disable_basic_metrics,omitempty"`
-
-	// Disable enhanced metrics.
-	DisableEnhancedMetrics *bool `json:"disable_enhanced_metrics,omitempty"`
-
 	// Log level for exporters
 	//
 	// - LOG_LEVEL_UNSPECIFIED: Auto
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
 
+	// MySQL username for getting currently running queries.
+	Username *string `json:"username,omitempty"`
+
+	// MySQL password for getting currently running queries.
+	Password *string `json:"password,omitempty"`
+
+	// Use TLS for database connections.
+	TLS *bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify *bool `json:"tls_skip_verify,omitempty"`
+
+	// Certificate Authority certificate chain.
+	TLSCa *string `json:"tls_ca,omitempty"`
+
+	// Client certificate.
+	TLSCert *string `json:"tls_cert,omitempty"`
+
+	// Password for decrypting tls_cert.
+	TLSKey *string `json:"tls_key,omitempty"`
+
+	// Skip connection check.
+	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
+
 	// custom labels
-	CustomLabels *ChangeAgentParamsBodyRDSExporterCustomLabels `json:"custom_labels,omitempty"`
+	CustomLabels *ChangeAgentParamsBodyRtaMysqlAgentCustomLabels `json:"custom_labels,omitempty"`
 
-	// metrics resolutions
-	MetricsResolutions *ChangeAgentParamsBodyRDSExporterMetricsResolutions `json:"metrics_resolutions,omitempty"`
+	// rta options
+	RtaOptions *ChangeAgentParamsBodyRtaMysqlAgentRtaOptions `json:"rta_options,omitempty"`
 }
 
-// Validate validates this change agent params body RDS exporter
-func (o *ChangeAgentParamsBodyRDSExporter) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body rta mysql agent
+func (o *ChangeAgentParamsBodyRtaMysqlAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -11476,7 +14656,7 @@ func (o *ChangeAgentParamsBodyRDSExporter) Validate(formats strfmt.Registry) err
 		res = append(res, err)
 	}
 
-	if err := o.validateMetricsResolutions(formats); err != nil {
+	if err := o.validateRtaOptions(formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -11486,7 +14666,7 @@ func (o *ChangeAgentParamsBodyRDSExporter) Validate(formats strfmt.Registry) err
 	return nil
 }
 
-var changeAgentParamsBodyRdsExporterTypeLogLevelPropEnum []any
+var changeAgentParamsBodyRtaMysqlAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -11494,53 +14674,53 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentParamsBodyRdsExporterTypeLogLevelPropEnum = append(changeAgentParamsBodyRdsExporterTypeLogLevelPropEnum, v)
+		changeAgentParamsBodyRtaMysqlAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyRtaMysqlAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentParamsBodyRDSExporterLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyRtaMysqlAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentParamsBodyRDSExporter) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyRdsExporterTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentParamsBodyRtaMysqlAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyRtaMysqlAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyRDSExporter) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyRtaMysqlAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"rds_exporter"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"rta_mysql_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyRDSExporter) validateCustomLabels(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyRtaMysqlAgent) validateCustomLabels(formats strfmt.Registry) error {
 	if swag.IsZero(o.CustomLabels) { // not required
 		return nil
 	}
@@ -11549,11 +14729,11 @@ func (o *ChangeAgentParamsBodyRDSExporter) validateCustomLabels(formats strfmt.R
 		if err := o.CustomLabels.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "rds_exporter" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "rta_mysql_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "rds_exporter" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "rta_mysql_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -11563,20 +14743,20 @@ func (o *ChangeAgentParamsBodyRDSExporter) validateCustomLabels(formats strfmt.R
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyRDSExporter) validateMetricsResolutions(formats strfmt.Registry) error {
-	if swag.IsZero(o.MetricsResolutions) { // not required
+func (o *ChangeAgentParamsBodyRtaMysqlAgent) validateRtaOptions(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaOptions) { // not required
 		return nil
 	}
 
-	if o.MetricsResolutions != nil {
-		if err := o.MetricsResolutions.Validate(formats); err != nil {
+	if o.RtaOptions != nil {
+		if err := o.RtaOptions.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "rds_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "rta_mysql_agent" + "." + "rta_options")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "rds_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "rta_mysql_agent" + "." + "rta_options")
 			}
 
 			return err
@@ -11586,15 +14766,15 @@ func (o *ChangeAgentParamsBodyRDSExporter) validateMetricsResolutions(formats st
 	return nil
 }
 
-// ContextValidate validate this change agent params body RDS exporter based on the context it is used
-func (o *ChangeAgentParamsBodyRDSExporter) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this change agent params body rta mysql agent based on the context it is used
+func (o *ChangeAgentParamsBodyRtaMysqlAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
-	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
+	if err := o.contextValidateRtaOptions(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -11604,7 +14784,7 @@ func (o *ChangeAgentParamsBodyRDSExporter) ContextValidate(ctx context.Context,
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyRDSExporter) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyRtaMysqlAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
 	if o.CustomLabels != nil {
 
 		if swag.IsZero(o.CustomLabels) { // not required
@@ -11614,11 +14794,11 @@ func (o *ChangeAgentParamsBodyRDSExporter) contextValidateCustomLabels(ctx conte
 		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "rds_exporter" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "rta_mysql_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "rds_exporter" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "rta_mysql_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -11628,21 +14808,21 @@ func (o *ChangeAgentParamsBodyRDSExporter) contextValidateCustomLabels(ctx conte
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyRDSExporter) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
-	if o.MetricsResolutions != nil {
+func (o *ChangeAgentParamsBodyRtaMysqlAgent) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaOptions != nil {
 
-		if swag.IsZero(o.MetricsResolutions) { // not required
+		if swag.IsZero(o.RtaOptions) { // not required
 			return nil
 		}
 
-		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
+		if err := o.RtaOptions.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "rds_exporter" + "." + "metrics_resolutions")
+				return ve.ValidateName("body" + "." + "rta_mysql_agent" + "." + "rta_options")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "rds_exporter" + "." + "metrics_resolutions")
+				return ce.ValidateName("body" + "." + "rta_mysql_agent" + "." + "rta_options")
 			}
 
 			return err
@@ -11653,7 +14833,7 @@ func (o *ChangeAgentParamsBodyRDSExporter) contextValidateMetricsResolutions(ctx
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyRDSExporter) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyRtaMysqlAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -11661,8 +14841,8 @@ func (o *ChangeAgentParamsBodyRDSExporter) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyRDSExporter) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyRDSExporter
+func (o *ChangeAgentParamsBodyRtaMysqlAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyRtaMysqlAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -11671,26 +14851,26 @@ func (o *ChangeAgentParamsBodyRDSExporter) UnmarshalBinary(b []byte) error {
 }
 
 /*
-ChangeAgentParamsBodyRDSExporterCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
-swagger:model ChangeAgentParamsBodyRDSExporterCustomLabels
+ChangeAgentParamsBodyRtaMysqlAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyRtaMysqlAgentCustomLabels
 */
-type ChangeAgentParamsBodyRDSExporterCustomLabels struct {
+type ChangeAgentParamsBodyRtaMysqlAgentCustomLabels struct {
 	// values
 	Values map[string]string `json:"values,omitempty"`
 }
 
-// Validate validates this change agent params body RDS exporter custom labels
-func (o *ChangeAgentParamsBodyRDSExporterCustomLabels) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body rta mysql agent custom labels
+func (o *ChangeAgentParamsBodyRtaMysqlAgentCustomLabels) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body RDS exporter custom labels based on context it is used
-func (o *ChangeAgentParamsBodyRDSExporterCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body rta mysql agent custom labels based on context it is used
+func (o *ChangeAgentParamsBodyRtaMysqlAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyRDSExporterCustomLabels) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyRtaMysqlAgentCustomLabels) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -11698,8 +14878,8 @@ func (o *ChangeAgentParamsBodyRDSExporterCustomLabels) MarshalBinary() ([]byte,
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyRDSExporterCustomLabels) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyRDSExporterCustomLabels
+func (o *ChangeAgentParamsBodyRtaMysqlAgentCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyRtaMysqlAgentCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -11708,32 +14888,26 @@ func (o *ChangeAgentParamsBodyRDSExporterCustomLabels) UnmarshalBinary(b []byte)
 }
 
 /*
-ChangeAgentParamsBodyRDSExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ChangeAgentParamsBodyRDSExporterMetricsResolutions
+ChangeAgentParamsBodyRtaMysqlAgentRtaOptions RTAOptions holds Real-Time Query Analytics agent options.
+swagger:model ChangeAgentParamsBodyRtaMysqlAgentRtaOptions
 */
-type ChangeAgentParamsBodyRDSExporterMetricsResolutions struct {
-	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Hr string `json:"hr,omitempty"`
-
-	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Mr string `json:"mr,omitempty"`
-
-	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Lr string `json:"lr,omitempty"`
+type ChangeAgentParamsBodyRtaMysqlAgentRtaOptions struct {
+	// Query collect interval (default 2s is set by server).
+	CollectInterval string `json:"collect_interval,omitempty"`
 }
 
-// Validate validates this change agent params body RDS exporter metrics resolutions
-func (o *ChangeAgentParamsBodyRDSExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body rta mysql agent rta options
+func (o *ChangeAgentParamsBodyRtaMysqlAgentRtaOptions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body RDS exporter metrics resolutions based on context it is used
-func (o *ChangeAgentParamsBodyRDSExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body rta mysql agent rta options based on context it is used
+func (o *ChangeAgentParamsBodyRtaMysqlAgentRtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyRDSExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyRtaMysqlAgentRtaOptions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -11741,8 +14915,8 @@ func (o *ChangeAgentParamsBodyRDSExporterMetricsResolutions) MarshalBinary() ([]
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyRDSExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyRDSExporterMetricsResolutions
+func (o *ChangeAgentParamsBodyRtaMysqlAgentRtaOptions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyRtaMysqlAgentRtaOptions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -11751,10 +14925,10 @@ func (o *ChangeAgentParamsBodyRDSExporterMetricsResolutions) UnmarshalBinary(b [
 }
 
 /*
-ChangeAgentParamsBodyRtaMongodbAgent change agent params body rta mongodb agent
-swagger:model ChangeAgentParamsBodyRtaMongodbAgent
+ChangeAgentParamsBodyRtaPostgresqlAgent change agent params body rta postgresql agent
+swagger:model ChangeAgentParamsBodyRtaPostgresqlAgent
 */
-type ChangeAgentParamsBodyRtaMongodbAgent struct {
+type ChangeAgentParamsBodyRtaPostgresqlAgent struct {
 	// Enable this Agent. Agents are enabled by default when they get added.
 	Enable *bool `json:"enable,omitempty"`
 
@@ -11764,10 +14938,10 @@ type ChangeAgentParamsBodyRtaMongodbAgent struct {
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
 
-	// MongoDB username for getting profile data.
+	// PostgreSQL username for getting currently running queries.
 	Username *string `json:"username,omitempty"`
 
-	// MongoDB password for getting profile data.
+	// PostgreSQL password for getting currently running queries.
 	Password *string `json:"password,omitempty"`
 
 	// Use TLS for database connections.
@@ -11776,30 +14950,27 @@ type ChangeAgentParamsBodyRtaMongodbAgent struct {
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify *bool `json:"tls_skip_verify,omitempty"`
 
-	// Client certificate and key.
-	TLSCertificateKey *string `json:"tls_certificate_key,omitempty"`
-
-	// Password for decrypting tls_certificate_key.
-	TLSCertificateKeyFilePassword *string `json:"tls_certificate_key_file_password,omitempty"`
-
-	// Certificate Authority certificate chain.
+	// TLS CA certificate.
 	TLSCa *string `json:"tls_ca,omitempty"`
 
-	// Authentication mechanism.
-	AuthenticationMechanism *string `json:"authentication_mechanism,omitempty"`
+	// TLS Certificate.
+	TLSCert *string `json:"tls_cert,omitempty"`
+
+	// TLS Certificate Key.
+	TLSKey *string `json:"tls_key,omitempty"`
 
 	// Skip connection check.
 	SkipConnectionCheck *bool `json:"skip_connection_check,omitempty"`
 
 	// custom labels
-	CustomLabels *ChangeAgentParamsBodyRtaMongodbAgentCustomLabels `json:"custom_labels,omitempty"`
+	CustomLabels *ChangeAgentParamsBodyRtaPostgresqlAgentCustomLabels `json:"custom_labels,omitempty"`
 
 	// rta options
-	RtaOptions *ChangeAgentParamsBodyRtaMongodbAgentRtaOptions `json:"rta_options,omitempty"`
+	RtaOptions *ChangeAgentParamsBodyRtaPostgresqlAgentRtaOptions `json:"rta_options,omitempty"`
 }
 
-// Validate validates this change agent params body rta mongodb agent
-func (o *ChangeAgentParamsBodyRtaMongodbAgent) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body rta postgresql agent
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgent) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateLogLevel(formats); err != nil {
@@ -11820,7 +14991,7 @@ func (o *ChangeAgentParamsBodyRtaMongodbAgent) Validate(formats strfmt.Registry)
 	return nil
 }
 
-var changeAgentParamsBodyRtaMongodbAgentTypeLogLevelPropEnum []any
+var changeAgentParamsBodyRtaPostgresqlAgentTypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -11828,53 +14999,53 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		changeAgentParamsBodyRtaMongodbAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyRtaMongodbAgentTypeLogLevelPropEnum, v)
+		changeAgentParamsBodyRtaPostgresqlAgentTypeLogLevelPropEnum = append(changeAgentParamsBodyRtaPostgresqlAgentTypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ChangeAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ChangeAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ChangeAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ChangeAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ChangeAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ChangeAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ChangeAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ChangeAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ChangeAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ChangeAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ChangeAgentParamsBodyRtaMongodbAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ChangeAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ChangeAgentParamsBodyRtaPostgresqlAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ChangeAgentParamsBodyRtaMongodbAgent) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyRtaMongodbAgentTypeLogLevelPropEnum, true); err != nil {
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, changeAgentParamsBodyRtaPostgresqlAgentTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyRtaMongodbAgent) validateLogLevel(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgent) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
 
 	// value enum
-	if err := o.validateLogLevelEnum("body"+"."+"rta_mongodb_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+	if err := o.validateLogLevelEnum("body"+"."+"rta_postgresql_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyRtaMongodbAgent) validateCustomLabels(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgent) validateCustomLabels(formats strfmt.Registry) error {
 	if swag.IsZero(o.CustomLabels) { // not required
 		return nil
 	}
@@ -11883,11 +15054,11 @@ func (o *ChangeAgentParamsBodyRtaMongodbAgent) validateCustomLabels(formats strf
 		if err := o.CustomLabels.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "rta_postgresql_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "rta_postgresql_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -11897,7 +15068,7 @@ func (o *ChangeAgentParamsBodyRtaMongodbAgent) validateCustomLabels(formats strf
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyRtaMongodbAgent) validateRtaOptions(formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgent) validateRtaOptions(formats strfmt.Registry) error {
 	if swag.IsZero(o.RtaOptions) { // not required
 		return nil
 	}
@@ -11906,11 +15077,11 @@ func (o *ChangeAgentParamsBodyRtaMongodbAgent) validateRtaOptions(formats strfmt
 		if err := o.RtaOptions.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "rta_options")
+				return ve.ValidateName("body" + "." + "rta_postgresql_agent" + "." + "rta_options")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "rta_options")
+				return ce.ValidateName("body" + "." + "rta_postgresql_agent" + "." + "rta_options")
 			}
 
 			return err
@@ -11920,8 +15091,8 @@ func (o *ChangeAgentParamsBodyRtaMongodbAgent) validateRtaOptions(formats strfmt
 	return nil
 }
 
-// ContextValidate validate this change agent params body rta mongodb agent based on the context it is used
-func (o *ChangeAgentParamsBodyRtaMongodbAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this change agent params body rta postgresql agent based on the context it is used
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateCustomLabels(ctx, formats); err != nil {
@@ -11938,7 +15109,7 @@ func (o *ChangeAgentParamsBodyRtaMongodbAgent) ContextValidate(ctx context.Conte
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyRtaMongodbAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgent) contextValidateCustomLabels(ctx context.Context, formats strfmt.Registry) error {
 	if o.CustomLabels != nil {
 
 		if swag.IsZero(o.CustomLabels) { // not required
@@ -11948,11 +15119,11 @@ func (o *ChangeAgentParamsBodyRtaMongodbAgent) contextValidateCustomLabels(ctx c
 		if err := o.CustomLabels.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "custom_labels")
+				return ve.ValidateName("body" + "." + "rta_postgresql_agent" + "." + "custom_labels")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "custom_labels")
+				return ce.ValidateName("body" + "." + "rta_postgresql_agent" + "." + "custom_labels")
 			}
 
 			return err
@@ -11962,7 +15133,7 @@ func (o *ChangeAgentParamsBodyRtaMongodbAgent) contextValidateCustomLabels(ctx c
 	return nil
 }
 
-func (o *ChangeAgentParamsBodyRtaMongodbAgent) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgent) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
 	if o.RtaOptions != nil {
 
 		if swag.IsZero(o.RtaOptions) { // not required
@@ -11972,11 +15143,11 @@ func (o *ChangeAgentParamsBodyRtaMongodbAgent) contextValidateRtaOptions(ctx con
 		if err := o.RtaOptions.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "rta_options")
+				return ve.ValidateName("body" + "." + "rta_postgresql_agent" + "." + "rta_options")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("body" + "." + "rta_mongodb_agent" + "." + "rta_options")
+				return ce.ValidateName("body" + "." + "rta_postgresql_agent" + "." + "rta_options")
 			}
 
 			return err
@@ -11987,7 +15158,7 @@ func (o *ChangeAgentParamsBodyRtaMongodbAgent) contextValidateRtaOptions(ctx con
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyRtaMongodbAgent) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgent) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -11995,8 +15166,8 @@ func (o *ChangeAgentParamsBodyRtaMongodbAgent) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyRtaMongodbAgent) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyRtaMongodbAgent
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgent) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyRtaPostgresqlAgent
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -12005,26 +15176,26 @@ func (o *ChangeAgentParamsBodyRtaMongodbAgent) UnmarshalBinary(b []byte) error {
 }
 
 /*
-ChangeAgentParamsBodyRtaMongodbAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
-swagger:model ChangeAgentParamsBodyRtaMongodbAgentCustomLabels
+ChangeAgentParamsBodyRtaPostgresqlAgentCustomLabels A wrapper for map[string]string. This type allows to distinguish between an empty map and a null value.
+swagger:model ChangeAgentParamsBodyRtaPostgresqlAgentCustomLabels
 */
-type ChangeAgentParamsBodyRtaMongodbAgentCustomLabels struct {
+type ChangeAgentParamsBodyRtaPostgresqlAgentCustomLabels struct {
 	// values
 	Values map[string]string `json:"values,omitempty"`
 }
 
-// Validate validates this change agent params body rta mongodb agent custom labels
-func (o *ChangeAgentParamsBodyRtaMongodbAgentCustomLabels) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body rta postgresql agent custom labels
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgentCustomLabels) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body rta mongodb agent custom labels based on context it is used
-func (o *ChangeAgentParamsBodyRtaMongodbAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body rta postgresql agent custom labels based on context it is used
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgentCustomLabels) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyRtaMongodbAgentCustomLabels) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgentCustomLabels) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -12032,8 +15203,8 @@ func (o *ChangeAgentParamsBodyRtaMongodbAgentCustomLabels) MarshalBinary() ([]by
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyRtaMongodbAgentCustomLabels) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyRtaMongodbAgentCustomLabels
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgentCustomLabels) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyRtaPostgresqlAgentCustomLabels
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -12042,26 +15213,26 @@ func (o *ChangeAgentParamsBodyRtaMongodbAgentCustomLabels) UnmarshalBinary(b []b
 }
 
 /*
-ChangeAgentParamsBodyRtaMongodbAgentRtaOptions RTAOptions holds Real-Time Query Analytics agent options.
-swagger:model ChangeAgentParamsBodyRtaMongodbAgentRtaOptions
+ChangeAgentParamsBodyRtaPostgresqlAgentRtaOptions RTAOptions holds Real-Time Query Analytics agent options.
+swagger:model ChangeAgentParamsBodyRtaPostgresqlAgentRtaOptions
 */
-type ChangeAgentParamsBodyRtaMongodbAgentRtaOptions struct {
+type ChangeAgentParamsBodyRtaPostgresqlAgentRtaOptions struct {
 	// Query collect interval (default 2s is set by server).
 	CollectInterval string `json:"collect_interval,omitempty"`
 }
 
-// Validate validates this change agent params body rta mongodb agent rta options
-func (o *ChangeAgentParamsBodyRtaMongodbAgentRtaOptions) Validate(formats strfmt.Registry) error {
+// Validate validates this change agent params body rta postgresql agent rta options
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgentRtaOptions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this change agent params body rta mongodb agent rta options based on context it is used
-func (o *ChangeAgentParamsBodyRtaMongodbAgentRtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this change agent params body rta postgresql agent rta options based on context it is used
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgentRtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyRtaMongodbAgentRtaOptions) MarshalBinary() ([]byte, error) {
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgentRtaOptions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -12069,8 +15240,8 @@ func (o *ChangeAgentParamsBodyRtaMongodbAgentRtaOptions) MarshalBinary() ([]byte
 }
 
 // UnmarshalBinary interface implementation
-func (o *ChangeAgentParamsBodyRtaMongodbAgentRtaOptions) UnmarshalBinary(b []byte) error {
-	var res ChangeAgentParamsBodyRtaMongodbAgentRtaOptions
+func (o *ChangeAgentParamsBodyRtaPostgresqlAgentRtaOptions) UnmarshalBinary(b []byte) error {
+	var res ChangeAgentParamsBodyRtaPostgresqlAgentRtaOptions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
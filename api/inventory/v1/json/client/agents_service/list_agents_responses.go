@@ -474,6 +474,21 @@ type ListAgentsOKBody struct {
 
 	// rta mongodb agent
 	RtaMongodbAgent []*ListAgentsOKBodyRtaMongodbAgentItems0 `json:"rta_mongodb_agent"`
+
+	// rta mysql agent
+	RtaMysqlAgent []*ListAgentsOKBodyRtaMysqlAgentItems0 `json:"rta_mysql_agent"`
+
+	// rta postgresql agent
+	RtaPostgresqlAgent []*ListAgentsOKBodyRtaPostgresqlAgentItems0 `json:"rta_postgresql_agent"`
+
+	// qan valkey slowlog agent
+	QANValkeySlowlogAgent []*ListAgentsOKBodyQANValkeySlowlogAgentItems0 `json:"qan_valkey_slowlog_agent"`
+
+	// qan proxysql digest agent
+	QANProxysqlDigestAgent []*ListAgentsOKBodyQANProxysqlDigestAgentItems0 `json:"qan_proxysql_digest_agent"`
+
+	// qan postgresql log agent
+	QANPostgresqlLogAgent []*ListAgentsOKBodyQANPostgresqlLogAgentItems0 `json:"qan_postgresql_log_agent"`
 }
 
 // Validate validates this list agents OK body
@@ -556,6 +571,26 @@ func (o *ListAgentsOKBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateRtaMysqlAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateRtaPostgresqlAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateQANValkeySlowlogAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateQANProxysqlDigestAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateQANPostgresqlLogAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -1132,6 +1167,156 @@ func (o *ListAgentsOKBody) validateRtaMongodbAgent(formats strfmt.Registry) erro
 	return nil
 }
 
+func (o *ListAgentsOKBody) validateRtaMysqlAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaMysqlAgent) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.RtaMysqlAgent); i++ {
+		if swag.IsZero(o.RtaMysqlAgent[i]) { // not required
+			continue
+		}
+
+		if o.RtaMysqlAgent[i] != nil {
+			if err := o.RtaMysqlAgent[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("listAgentsOk" + "." + "rta_mysql_agent" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("listAgentsOk" + "." + "rta_mysql_agent" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (o *ListAgentsOKBody) validateRtaPostgresqlAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaPostgresqlAgent) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.RtaPostgresqlAgent); i++ {
+		if swag.IsZero(o.RtaPostgresqlAgent[i]) { // not required
+			continue
+		}
+
+		if o.RtaPostgresqlAgent[i] != nil {
+			if err := o.RtaPostgresqlAgent[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("listAgentsOk" + "." + "rta_postgresql_agent" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("listAgentsOk" + "." + "rta_postgresql_agent" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (o *ListAgentsOKBody) validateQANValkeySlowlogAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANValkeySlowlogAgent) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.QANValkeySlowlogAgent); i++ {
+		if swag.IsZero(o.QANValkeySlowlogAgent[i]) { // not required
+			continue
+		}
+
+		if o.QANValkeySlowlogAgent[i] != nil {
+			if err := o.QANValkeySlowlogAgent[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("listAgentsOk" + "." + "qan_valkey_slowlog_agent" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("listAgentsOk" + "." + "qan_valkey_slowlog_agent" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (o *ListAgentsOKBody) validateQANProxysqlDigestAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANProxysqlDigestAgent) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.QANProxysqlDigestAgent); i++ {
+		if swag.IsZero(o.QANProxysqlDigestAgent[i]) { // not required
+			continue
+		}
+
+		if o.QANProxysqlDigestAgent[i] != nil {
+			if err := o.QANProxysqlDigestAgent[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("listAgentsOk" + "." + "qan_proxysql_digest_agent" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("listAgentsOk" + "." + "qan_proxysql_digest_agent" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (o *ListAgentsOKBody) validateQANPostgresqlLogAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANPostgresqlLogAgent) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.QANPostgresqlLogAgent); i++ {
+		if swag.IsZero(o.QANPostgresqlLogAgent[i]) { // not required
+			continue
+		}
+
+		if o.QANPostgresqlLogAgent[i] != nil {
+			if err := o.QANPostgresqlLogAgent[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("listAgentsOk" + "." + "qan_postgresql_log_agent" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("listAgentsOk" + "." + "qan_postgresql_log_agent" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this list agents OK body based on the context it is used
 func (o *ListAgentsOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -1212,6 +1397,26 @@ func (o *ListAgentsOKBody) ContextValidate(ctx context.Context, formats strfmt.R
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateRtaMysqlAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateRtaPostgresqlAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateQANValkeySlowlogAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateQANProxysqlDigestAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateQANPostgresqlLogAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -1712,6 +1917,136 @@ func (o *ListAgentsOKBody) contextValidateRtaMongodbAgent(ctx context.Context, f
 	return nil
 }
 
+func (o *ListAgentsOKBody) contextValidateRtaMysqlAgent(ctx context.Context, formats strfmt.Registry) error {
+	for i := 0; i < len(o.RtaMysqlAgent); i++ {
+		if o.RtaMysqlAgent[i] != nil {
+
+			if swag.IsZero(o.RtaMysqlAgent[i]) { // not required
+				return nil
+			}
+
+			if err := o.RtaMysqlAgent[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("listAgentsOk" + "." + "rta_mysql_agent" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("listAgentsOk" + "." + "rta_mysql_agent" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (o *ListAgentsOKBody) contextValidateRtaPostgresqlAgent(ctx context.Context, formats strfmt.Registry) error {
+	for i := 0; i < len(o.RtaPostgresqlAgent); i++ {
+		if o.RtaPostgresqlAgent[i] != nil {
+
+			if swag.IsZero(o.RtaPostgresqlAgent[i]) { // not required
+				return nil
+			}
+
+			if err := o.RtaPostgresqlAgent[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("listAgentsOk" + "." + "rta_postgresql_agent" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("listAgentsOk" + "." + "rta_postgresql_agent" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (o *ListAgentsOKBody) contextValidateQANValkeySlowlogAgent(ctx context.Context, formats strfmt.Registry) error {
+	for i := 0; i < len(o.QANValkeySlowlogAgent); i++ {
+		if o.QANValkeySlowlogAgent[i] != nil {
+
+			if swag.IsZero(o.QANValkeySlowlogAgent[i]) { // not required
+				return nil
+			}
+
+			if err := o.QANValkeySlowlogAgent[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("listAgentsOk" + "." + "qan_valkey_slowlog_agent" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("listAgentsOk" + "." + "qan_valkey_slowlog_agent" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (o *ListAgentsOKBody) contextValidateQANProxysqlDigestAgent(ctx context.Context, formats strfmt.Registry) error {
+	for i := 0; i < len(o.QANProxysqlDigestAgent); i++ {
+		if o.QANProxysqlDigestAgent[i] != nil {
+
+			if swag.IsZero(o.QANProxysqlDigestAgent[i]) { // not required
+				return nil
+			}
+
+			if err := o.QANProxysqlDigestAgent[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("listAgentsOk" + "." + "qan_proxysql_digest_agent" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("listAgentsOk" + "." + "qan_proxysql_digest_agent" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (o *ListAgentsOKBody) contextValidateQANPostgresqlLogAgent(ctx context.Context, formats strfmt.Registry) error {
+	for i := 0; i < len(o.QANPostgresqlLogAgent); i++ {
+		if o.QANPostgresqlLogAgent[i] != nil {
+
+			if swag.IsZero(o.QANPostgresqlLogAgent[i]) { // not required
+				return nil
+			}
+
+			if err := o.QANPostgresqlLogAgent[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("listAgentsOk" + "." + "qan_postgresql_log_agent" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("listAgentsOk" + "." + "qan_postgresql_log_agent" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (o *ListAgentsOKBody) MarshalBinary() ([]byte, error) {
 	if o == nil {
@@ -5022,10 +5357,10 @@ func (o *ListAgentsOKBodyQANMysqlSlowlogAgentItems0) UnmarshalBinary(b []byte) e
 }
 
 /*
-ListAgentsOKBodyQANPostgresqlPgstatementsAgentItems0 QANPostgreSQLPgStatementsAgent runs within pmm-agent and sends PostgreSQL Query Analytics data to the PMM Server.
-swagger:model ListAgentsOKBodyQANPostgresqlPgstatementsAgentItems0
+ListAgentsOKBodyQANPostgresqlLogAgentItems0 QANPostgreSQLLogAgent runs within pmm-agent and sends PostgreSQL Query Analytics data from the server log to the PMM Server.
+swagger:model ListAgentsOKBodyQANPostgresqlLogAgentItems0
 */
-type ListAgentsOKBodyQANPostgresqlPgstatementsAgentItems0 struct {
+type ListAgentsOKBodyQANPostgresqlLogAgentItems0 struct {
 	// Unique randomly generated instance identifier.
 	AgentID string `json:"agent_id,omitempty"`
 
@@ -5038,26 +5373,241 @@ type ListAgentsOKBodyQANPostgresqlPgstatementsAgentItems0 struct {
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// PostgreSQL username for getting pg stat statements data.
+	// PostgreSQL username for getting server log settings.
 	Username string `json:"username,omitempty"`
 
-	// Disable parsing comments from queries and showing them in QAN.
-	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
-
-	// Limit query length in QAN (default: server-defined; -1: no limit).
-	MaxQueryLength int32 `json:"max_query_length,omitempty"`
-
 	// Use TLS for database connections.
 	TLS bool `json:"tls,omitempty"`
 
 	// Skip TLS certificate and hostname validation.
 	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
 
-	// Custom user-assigned labels.
-	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
 
-	// AgentStatus represents actual Agent status.
-	//
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
+	// True if query examples are disabled.
+	QueryExamplesDisabled bool `json:"query_examples_disabled,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Path to exec process.
+	ProcessExecPath string `json:"process_exec_path,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+}
+
+// Validate validates this list agents OK body QAN postgresql log agent items0
+func (o *ListAgentsOKBodyQANPostgresqlLogAgentItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var listAgentsOkBodyQanPostgresqlLogAgentItems0TypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		listAgentsOkBodyQanPostgresqlLogAgentItems0TypeStatusPropEnum = append(listAgentsOkBodyQanPostgresqlLogAgentItems0TypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ListAgentsOKBodyQANPostgresqlLogAgentItems0StatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ListAgentsOKBodyQANPostgresqlLogAgentItems0StatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// ListAgentsOKBodyQANPostgresqlLogAgentItems0StatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ListAgentsOKBodyQANPostgresqlLogAgentItems0StatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// ListAgentsOKBodyQANPostgresqlLogAgentItems0StatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ListAgentsOKBodyQANPostgresqlLogAgentItems0StatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// ListAgentsOKBodyQANPostgresqlLogAgentItems0StatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ListAgentsOKBodyQANPostgresqlLogAgentItems0StatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// ListAgentsOKBodyQANPostgresqlLogAgentItems0StatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ListAgentsOKBodyQANPostgresqlLogAgentItems0StatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// ListAgentsOKBodyQANPostgresqlLogAgentItems0StatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ListAgentsOKBodyQANPostgresqlLogAgentItems0StatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// ListAgentsOKBodyQANPostgresqlLogAgentItems0StatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ListAgentsOKBodyQANPostgresqlLogAgentItems0StatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// ListAgentsOKBodyQANPostgresqlLogAgentItems0StatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ListAgentsOKBodyQANPostgresqlLogAgentItems0StatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *ListAgentsOKBodyQANPostgresqlLogAgentItems0) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, listAgentsOkBodyQanPostgresqlLogAgentItems0TypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ListAgentsOKBodyQANPostgresqlLogAgentItems0) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var listAgentsOkBodyQanPostgresqlLogAgentItems0TypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		listAgentsOkBodyQanPostgresqlLogAgentItems0TypeLogLevelPropEnum = append(listAgentsOkBodyQanPostgresqlLogAgentItems0TypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ListAgentsOKBodyQANPostgresqlLogAgentItems0LogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ListAgentsOKBodyQANPostgresqlLogAgentItems0LogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// ListAgentsOKBodyQANPostgresqlLogAgentItems0LogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ListAgentsOKBodyQANPostgresqlLogAgentItems0LogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// ListAgentsOKBodyQANPostgresqlLogAgentItems0LogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ListAgentsOKBodyQANPostgresqlLogAgentItems0LogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// ListAgentsOKBodyQANPostgresqlLogAgentItems0LogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ListAgentsOKBodyQANPostgresqlLogAgentItems0LogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// ListAgentsOKBodyQANPostgresqlLogAgentItems0LogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ListAgentsOKBodyQANPostgresqlLogAgentItems0LogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// ListAgentsOKBodyQANPostgresqlLogAgentItems0LogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ListAgentsOKBodyQANPostgresqlLogAgentItems0LogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *ListAgentsOKBodyQANPostgresqlLogAgentItems0) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, listAgentsOkBodyQanPostgresqlLogAgentItems0TypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ListAgentsOKBodyQANPostgresqlLogAgentItems0) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this list agents OK body QAN postgresql log agent items0 based on context it is used
+func (o *ListAgentsOKBodyQANPostgresqlLogAgentItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ListAgentsOKBodyQANPostgresqlLogAgentItems0) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ListAgentsOKBodyQANPostgresqlLogAgentItems0) UnmarshalBinary(b []byte) error {
+	var res ListAgentsOKBodyQANPostgresqlLogAgentItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ListAgentsOKBodyQANPostgresqlPgstatementsAgentItems0 QANPostgreSQLPgStatementsAgent runs within pmm-agent and sends PostgreSQL Query Analytics data to the PMM Server.
+swagger:model ListAgentsOKBodyQANPostgresqlPgstatementsAgentItems0
+*/
+type ListAgentsOKBodyQANPostgresqlPgstatementsAgentItems0 struct {
+	// Unique randomly generated instance identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// PostgreSQL username for getting pg stat statements data.
+	Username string `json:"username,omitempty"`
+
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
+
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
 	//  - AGENT_STATUS_STARTING: Agent is starting.
 	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
 	//  - AGENT_STATUS_RUNNING: Agent is running.
@@ -5449,10 +5999,10 @@ func (o *ListAgentsOKBodyQANPostgresqlPgstatmonitorAgentItems0) UnmarshalBinary(
 }
 
 /*
-ListAgentsOKBodyRDSExporterItems0 RDSExporter runs on Generic or Container Node and exposes RemoteRDS Node metrics.
-swagger:model ListAgentsOKBodyRDSExporterItems0
+ListAgentsOKBodyQANProxysqlDigestAgentItems0 QANProxySQLDigestAgent runs within pmm-agent and sends ProxySQL Query Analytics data to the PMM Server.
+swagger:model ListAgentsOKBodyQANProxysqlDigestAgentItems0
 */
-type ListAgentsOKBodyRDSExporterItems0 struct {
+type ListAgentsOKBodyQANProxysqlDigestAgentItems0 struct {
 	// Unique randomly generated instance identifier.
 	AgentID string `json:"agent_id,omitempty"`
 
@@ -5462,11 +6012,20 @@ type ListAgentsOKBodyRDSExporterItems0 struct {
 	// Desired Agent status: enabled (false) or disabled (true).
 	Disabled bool `json:"disabled,omitempty"`
 
-	// Node identifier.
-	NodeID string `json:"node_id,omitempty"`
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
 
-	// AWS Access Key.
-	AWSAccessKey string `json:"aws_access_key,omitempty"`
+	// ProxySQL username for getting query digest data.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
 
 	// Custom user-assigned labels.
 	CustomLabels map[string]string `json:"custom_labels,omitempty"`
@@ -5483,18 +6042,6 @@ type ListAgentsOKBodyRDSExporterItems0 struct {
 	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
 	Status *string `json:"status,omitempty"`
 
-	// Listen port for scraping metrics (the same for several configurations).
-	ListenPort int64 `json:"listen_port,omitempty"`
-
-	// Basic metrics are disabled.
-	BasicMetricsDisabled bool `json:"basic_metrics_disabled,omitempty"`
-
-	// Enhanced metrics are disabled.
-	EnhancedMetricsDisabled bool `json:"enhanced_metrics_disabled,omitempty"`
-
-	// True if exporter uses push metrics mode.
-	PushMetricsEnabled bool `json:"push_metrics_enabled,omitempty"`
-
 	// Path to exec process.
 	ProcessExecPath string `json:"process_exec_path,omitempty"`
 
@@ -5503,16 +6050,10 @@ type ListAgentsOKBodyRDSExporterItems0 struct {
 	// - LOG_LEVEL_UNSPECIFIED: Auto
 	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
 	LogLevel *string `json:"log_level,omitempty"`
-
-	// Limit of databases for auto-discovery.
-	AutoDiscoveryLimit int32 `json:"auto_discovery_limit,omitempty"`
-
-	// metrics resolutions
-	MetricsResolutions *ListAgentsOKBodyRDSExporterItems0MetricsResolutions `json:"metrics_resolutions,omitempty"`
 }
 
-// Validate validates this list agents OK body RDS exporter items0
-func (o *ListAgentsOKBodyRDSExporterItems0) Validate(formats strfmt.Registry) error {
+// Validate validates this list agents OK body QAN proxysql digest agent items0
+func (o *ListAgentsOKBodyQANProxysqlDigestAgentItems0) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateStatus(formats); err != nil {
@@ -5523,17 +6064,13 @@ func (o *ListAgentsOKBodyRDSExporterItems0) Validate(formats strfmt.Registry) er
 		res = append(res, err)
 	}
 
-	if err := o.validateMetricsResolutions(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
-var listAgentsOkBodyRdsExporterItems0TypeStatusPropEnum []any
+var listAgentsOkBodyQanProxysqlDigestAgentItems0TypeStatusPropEnum []any
 
 func init() {
 	var res []string
@@ -5541,46 +6078,46 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		listAgentsOkBodyRdsExporterItems0TypeStatusPropEnum = append(listAgentsOkBodyRdsExporterItems0TypeStatusPropEnum, v)
+		listAgentsOkBodyQanProxysqlDigestAgentItems0TypeStatusPropEnum = append(listAgentsOkBodyQanProxysqlDigestAgentItems0TypeStatusPropEnum, v)
 	}
 }
 
 const (
 
-	// ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
-	ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+	// ListAgentsOKBodyQANProxysqlDigestAgentItems0StatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ListAgentsOKBodyQANProxysqlDigestAgentItems0StatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
 
-	// ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
-	ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+	// ListAgentsOKBodyQANProxysqlDigestAgentItems0StatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ListAgentsOKBodyQANProxysqlDigestAgentItems0StatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
 
-	// ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
-	ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+	// ListAgentsOKBodyQANProxysqlDigestAgentItems0StatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ListAgentsOKBodyQANProxysqlDigestAgentItems0StatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
 
-	// ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
-	ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+	// ListAgentsOKBodyQANProxysqlDigestAgentItems0StatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ListAgentsOKBodyQANProxysqlDigestAgentItems0StatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
 
-	// ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
-	ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+	// ListAgentsOKBodyQANProxysqlDigestAgentItems0StatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ListAgentsOKBodyQANProxysqlDigestAgentItems0StatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
 
-	// ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
-	ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+	// ListAgentsOKBodyQANProxysqlDigestAgentItems0StatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ListAgentsOKBodyQANProxysqlDigestAgentItems0StatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
 
-	// ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
-	ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+	// ListAgentsOKBodyQANProxysqlDigestAgentItems0StatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ListAgentsOKBodyQANProxysqlDigestAgentItems0StatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
 
-	// ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
-	ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+	// ListAgentsOKBodyQANProxysqlDigestAgentItems0StatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ListAgentsOKBodyQANProxysqlDigestAgentItems0StatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
 )
 
 // prop value enum
-func (o *ListAgentsOKBodyRDSExporterItems0) validateStatusEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, listAgentsOkBodyRdsExporterItems0TypeStatusPropEnum, true); err != nil {
+func (o *ListAgentsOKBodyQANProxysqlDigestAgentItems0) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, listAgentsOkBodyQanProxysqlDigestAgentItems0TypeStatusPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ListAgentsOKBodyRDSExporterItems0) validateStatus(formats strfmt.Registry) error {
+func (o *ListAgentsOKBodyQANProxysqlDigestAgentItems0) validateStatus(formats strfmt.Registry) error {
 	if swag.IsZero(o.Status) { // not required
 		return nil
 	}
@@ -5593,7 +6130,7 @@ func (o *ListAgentsOKBodyRDSExporterItems0) validateStatus(formats strfmt.Regist
 	return nil
 }
 
-var listAgentsOkBodyRdsExporterItems0TypeLogLevelPropEnum []any
+var listAgentsOkBodyQanProxysqlDigestAgentItems0TypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -5601,29 +6138,463 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		listAgentsOkBodyRdsExporterItems0TypeLogLevelPropEnum = append(listAgentsOkBodyRdsExporterItems0TypeLogLevelPropEnum, v)
+		listAgentsOkBodyQanProxysqlDigestAgentItems0TypeLogLevelPropEnum = append(listAgentsOkBodyQanProxysqlDigestAgentItems0TypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ListAgentsOKBodyQANProxysqlDigestAgentItems0LogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ListAgentsOKBodyQANProxysqlDigestAgentItems0LogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ListAgentsOKBodyQANProxysqlDigestAgentItems0LogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ListAgentsOKBodyQANProxysqlDigestAgentItems0LogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ListAgentsOKBodyQANProxysqlDigestAgentItems0LogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ListAgentsOKBodyQANProxysqlDigestAgentItems0LogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ListAgentsOKBodyQANProxysqlDigestAgentItems0LogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ListAgentsOKBodyQANProxysqlDigestAgentItems0LogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ListAgentsOKBodyQANProxysqlDigestAgentItems0LogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ListAgentsOKBodyQANProxysqlDigestAgentItems0LogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ListAgentsOKBodyQANProxysqlDigestAgentItems0LogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ListAgentsOKBodyQANProxysqlDigestAgentItems0LogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *ListAgentsOKBodyQANProxysqlDigestAgentItems0) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, listAgentsOkBodyQanProxysqlDigestAgentItems0TypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ListAgentsOKBodyQANProxysqlDigestAgentItems0) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this list agents OK body QAN proxysql digest agent items0 based on context it is used
+func (o *ListAgentsOKBodyQANProxysqlDigestAgentItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ListAgentsOKBodyQANProxysqlDigestAgentItems0) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ListAgentsOKBodyQANProxysqlDigestAgentItems0) UnmarshalBinary(b []byte) error {
+	var res ListAgentsOKBodyQANProxysqlDigestAgentItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ListAgentsOKBodyQANValkeySlowlogAgentItems0 QANValkeySlowlogAgent runs within pmm-agent and sends Valkey Query Analytics data to the PMM Server.
+swagger:model ListAgentsOKBodyQANValkeySlowlogAgentItems0
+*/
+type ListAgentsOKBodyQANValkeySlowlogAgentItems0 struct {
+	// Unique randomly generated instance identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// Valkey username for getting slow log data.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
+	// True if query examples are disabled.
+	QueryExamplesDisabled bool `json:"query_examples_disabled,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Path to exec process.
+	ProcessExecPath string `json:"process_exec_path,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+}
+
+// Validate validates this list agents OK body QAN valkey slowlog agent items0
+func (o *ListAgentsOKBodyQANValkeySlowlogAgentItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var listAgentsOkBodyQanValkeySlowlogAgentItems0TypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		listAgentsOkBodyQanValkeySlowlogAgentItems0TypeStatusPropEnum = append(listAgentsOkBodyQanValkeySlowlogAgentItems0TypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ListAgentsOKBodyQANValkeySlowlogAgentItems0StatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ListAgentsOKBodyQANValkeySlowlogAgentItems0StatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// ListAgentsOKBodyQANValkeySlowlogAgentItems0StatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ListAgentsOKBodyQANValkeySlowlogAgentItems0StatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// ListAgentsOKBodyQANValkeySlowlogAgentItems0StatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ListAgentsOKBodyQANValkeySlowlogAgentItems0StatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// ListAgentsOKBodyQANValkeySlowlogAgentItems0StatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ListAgentsOKBodyQANValkeySlowlogAgentItems0StatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// ListAgentsOKBodyQANValkeySlowlogAgentItems0StatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ListAgentsOKBodyQANValkeySlowlogAgentItems0StatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// ListAgentsOKBodyQANValkeySlowlogAgentItems0StatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ListAgentsOKBodyQANValkeySlowlogAgentItems0StatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// ListAgentsOKBodyQANValkeySlowlogAgentItems0StatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ListAgentsOKBodyQANValkeySlowlogAgentItems0StatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// ListAgentsOKBodyQANValkeySlowlogAgentItems0StatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ListAgentsOKBodyQANValkeySlowlogAgentItems0StatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *ListAgentsOKBodyQANValkeySlowlogAgentItems0) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, listAgentsOkBodyQanValkeySlowlogAgentItems0TypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ListAgentsOKBodyQANValkeySlowlogAgentItems0) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var listAgentsOkBodyQanValkeySlowlogAgentItems0TypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		listAgentsOkBodyQanValkeySlowlogAgentItems0TypeLogLevelPropEnum = append(listAgentsOkBodyQanValkeySlowlogAgentItems0TypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ListAgentsOKBodyQANValkeySlowlogAgentItems0LogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ListAgentsOKBodyQANValkeySlowlogAgentItems0LogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// ListAgentsOKBodyQANValkeySlowlogAgentItems0LogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ListAgentsOKBodyQANValkeySlowlogAgentItems0LogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// ListAgentsOKBodyQANValkeySlowlogAgentItems0LogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ListAgentsOKBodyQANValkeySlowlogAgentItems0LogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// ListAgentsOKBodyQANValkeySlowlogAgentItems0LogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ListAgentsOKBodyQANValkeySlowlogAgentItems0LogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// ListAgentsOKBodyQANValkeySlowlogAgentItems0LogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ListAgentsOKBodyQANValkeySlowlogAgentItems0LogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// ListAgentsOKBodyQANValkeySlowlogAgentItems0LogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ListAgentsOKBodyQANValkeySlowlogAgentItems0LogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *ListAgentsOKBodyQANValkeySlowlogAgentItems0) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, listAgentsOkBodyQanValkeySlowlogAgentItems0TypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ListAgentsOKBodyQANValkeySlowlogAgentItems0) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this list agents OK body QAN valkey slowlog agent items0 based on context it is used
+func (o *ListAgentsOKBodyQANValkeySlowlogAgentItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ListAgentsOKBodyQANValkeySlowlogAgentItems0) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ListAgentsOKBodyQANValkeySlowlogAgentItems0) UnmarshalBinary(b []byte) error {
+	var res ListAgentsOKBodyQANValkeySlowlogAgentItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ListAgentsOKBodyRDSExporterItems0 RDSExporter runs on Generic or Container Node and exposes RemoteRDS Node metrics.
+swagger:model ListAgentsOKBodyRDSExporterItems0
+*/
+type ListAgentsOKBodyRDSExporterItems0 struct {
+	// Unique randomly generated instance identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Node identifier.
+	NodeID string `json:"node_id,omitempty"`
+
+	// AWS Access Key.
+	AWSAccessKey string `json:"aws_access_key,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Listen port for scraping metrics (the same for several configurations).
+	ListenPort int64 `json:"listen_port,omitempty"`
+
+	// Basic metrics are disabled.
+	BasicMetricsDisabled bool `json:"basic_metrics_disabled,omitempty"`
+
+	// Enhanced metrics are disabled.
+	EnhancedMetricsDisabled bool `json:"enhanced_metrics_disabled,omitempty"`
+
+	// True if exporter uses push metrics mode.
+	PushMetricsEnabled bool `json:"push_metrics_enabled,omitempty"`
+
+	// Path to exec process.
+	ProcessExecPath string `json:"process_exec_path,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// Limit of databases for auto-discovery.
+	AutoDiscoveryLimit int32 `json:"auto_discovery_limit,omitempty"`
+
+	// metrics resolutions
+	MetricsResolutions *ListAgentsOKBodyRDSExporterItems0MetricsResolutions `json:"metrics_resolutions,omitempty"`
+}
+
+// Validate validates this list agents OK body RDS exporter items0
+func (o *ListAgentsOKBodyRDSExporterItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateMetricsResolutions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var listAgentsOkBodyRdsExporterItems0TypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		listAgentsOkBodyRdsExporterItems0TypeStatusPropEnum = append(listAgentsOkBodyRdsExporterItems0TypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ListAgentsOKBodyRDSExporterItems0StatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *ListAgentsOKBodyRDSExporterItems0) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, listAgentsOkBodyRdsExporterItems0TypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ListAgentsOKBodyRDSExporterItems0) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var listAgentsOkBodyRdsExporterItems0TypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		listAgentsOkBodyRdsExporterItems0TypeLogLevelPropEnum = append(listAgentsOkBodyRdsExporterItems0TypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ListAgentsOKBodyRDSExporterItems0LogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
@@ -5634,7 +6605,619 @@ func (o *ListAgentsOKBodyRDSExporterItems0) validateLogLevelEnum(path, location
 	return nil
 }
 
-func (o *ListAgentsOKBodyRDSExporterItems0) validateLogLevel(formats strfmt.Registry) error {
+func (o *ListAgentsOKBodyRDSExporterItems0) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ListAgentsOKBodyRDSExporterItems0) validateMetricsResolutions(formats strfmt.Registry) error {
+	if swag.IsZero(o.MetricsResolutions) { // not required
+		return nil
+	}
+
+	if o.MetricsResolutions != nil {
+		if err := o.MetricsResolutions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("metrics_resolutions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("metrics_resolutions")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this list agents OK body RDS exporter items0 based on the context it is used
+func (o *ListAgentsOKBodyRDSExporterItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ListAgentsOKBodyRDSExporterItems0) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
+	if o.MetricsResolutions != nil {
+
+		if swag.IsZero(o.MetricsResolutions) { // not required
+			return nil
+		}
+
+		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("metrics_resolutions")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("metrics_resolutions")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ListAgentsOKBodyRDSExporterItems0) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ListAgentsOKBodyRDSExporterItems0) UnmarshalBinary(b []byte) error {
+	var res ListAgentsOKBodyRDSExporterItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ListAgentsOKBodyRDSExporterItems0MetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model ListAgentsOKBodyRDSExporterItems0MetricsResolutions
+*/
+type ListAgentsOKBodyRDSExporterItems0MetricsResolutions struct {
+	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Hr string `json:"hr,omitempty"`
+
+	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Mr string `json:"mr,omitempty"`
+
+	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Lr string `json:"lr,omitempty"`
+}
+
+// Validate validates this list agents OK body RDS exporter items0 metrics resolutions
+func (o *ListAgentsOKBodyRDSExporterItems0MetricsResolutions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this list agents OK body RDS exporter items0 metrics resolutions based on context it is used
+func (o *ListAgentsOKBodyRDSExporterItems0MetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ListAgentsOKBodyRDSExporterItems0MetricsResolutions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ListAgentsOKBodyRDSExporterItems0MetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res ListAgentsOKBodyRDSExporterItems0MetricsResolutions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ListAgentsOKBodyRtaMongodbAgentItems0 RTAMongoDBAgent runs within pmm-agent and sends MongoDB Real-Time Query Analytics data to the PMM Server.
+swagger:model ListAgentsOKBodyRtaMongodbAgentItems0
+*/
+type ListAgentsOKBodyRtaMongodbAgentItems0 struct {
+	// Unique agent identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// MongoDB username for getting profiler data.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// rta options
+	RtaOptions *ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions `json:"rta_options,omitempty"`
+}
+
+// Validate validates this list agents OK body rta mongodb agent items0
+func (o *ListAgentsOKBodyRtaMongodbAgentItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateRtaOptions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var listAgentsOkBodyRtaMongodbAgentItems0TypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		listAgentsOkBodyRtaMongodbAgentItems0TypeStatusPropEnum = append(listAgentsOkBodyRtaMongodbAgentItems0TypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *ListAgentsOKBodyRtaMongodbAgentItems0) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, listAgentsOkBodyRtaMongodbAgentItems0TypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ListAgentsOKBodyRtaMongodbAgentItems0) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var listAgentsOkBodyRtaMongodbAgentItems0TypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		listAgentsOkBodyRtaMongodbAgentItems0TypeLogLevelPropEnum = append(listAgentsOkBodyRtaMongodbAgentItems0TypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *ListAgentsOKBodyRtaMongodbAgentItems0) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, listAgentsOkBodyRtaMongodbAgentItems0TypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ListAgentsOKBodyRtaMongodbAgentItems0) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *ListAgentsOKBodyRtaMongodbAgentItems0) validateRtaOptions(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaOptions) { // not required
+		return nil
+	}
+
+	if o.RtaOptions != nil {
+		if err := o.RtaOptions.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this list agents OK body rta mongodb agent items0 based on the context it is used
+func (o *ListAgentsOKBodyRtaMongodbAgentItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateRtaOptions(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *ListAgentsOKBodyRtaMongodbAgentItems0) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaOptions != nil {
+
+		if swag.IsZero(o.RtaOptions) { // not required
+			return nil
+		}
+
+		if err := o.RtaOptions.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("rta_options")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("rta_options")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ListAgentsOKBodyRtaMongodbAgentItems0) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ListAgentsOKBodyRtaMongodbAgentItems0) UnmarshalBinary(b []byte) error {
+	var res ListAgentsOKBodyRtaMongodbAgentItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions RTAOptions holds Real-Time Query Analytics agent options.
+swagger:model ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions
+*/
+type ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions struct {
+	// Query collect interval (default 2s is set by server).
+	CollectInterval string `json:"collect_interval,omitempty"`
+}
+
+// Validate validates this list agents OK body rta mongodb agent items0 rta options
+func (o *ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this list agents OK body rta mongodb agent items0 rta options based on context it is used
+func (o *ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions) UnmarshalBinary(b []byte) error {
+	var res ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ListAgentsOKBodyRtaMysqlAgentItems0 RTAMySQLAgent runs within pmm-agent and sends MySQL Real-Time Query Analytics data to the PMM Server.
+swagger:model ListAgentsOKBodyRtaMysqlAgentItems0
+*/
+type ListAgentsOKBodyRtaMysqlAgentItems0 struct {
+	// Unique agent identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// MySQL username for getting currently running queries.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+
+	// rta options
+	RtaOptions *ListAgentsOKBodyRtaMysqlAgentItems0RtaOptions `json:"rta_options,omitempty"`
+}
+
+// Validate validates this list agents OK body rta mysql agent items0
+func (o *ListAgentsOKBodyRtaMysqlAgentItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateRtaOptions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var listAgentsOkBodyRtaMysqlAgentItems0TypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		listAgentsOkBodyRtaMysqlAgentItems0TypeStatusPropEnum = append(listAgentsOkBodyRtaMysqlAgentItems0TypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// ListAgentsOKBodyRtaMysqlAgentItems0StatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ListAgentsOKBodyRtaMysqlAgentItems0StatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// ListAgentsOKBodyRtaMysqlAgentItems0StatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ListAgentsOKBodyRtaMysqlAgentItems0StatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// ListAgentsOKBodyRtaMysqlAgentItems0StatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ListAgentsOKBodyRtaMysqlAgentItems0StatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// ListAgentsOKBodyRtaMysqlAgentItems0StatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ListAgentsOKBodyRtaMysqlAgentItems0StatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// ListAgentsOKBodyRtaMysqlAgentItems0StatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ListAgentsOKBodyRtaMysqlAgentItems0StatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// ListAgentsOKBodyRtaMysqlAgentItems0StatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ListAgentsOKBodyRtaMysqlAgentItems0StatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// ListAgentsOKBodyRtaMysqlAgentItems0StatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ListAgentsOKBodyRtaMysqlAgentItems0StatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// ListAgentsOKBodyRtaMysqlAgentItems0StatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ListAgentsOKBodyRtaMysqlAgentItems0StatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *ListAgentsOKBodyRtaMysqlAgentItems0) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, listAgentsOkBodyRtaMysqlAgentItems0TypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ListAgentsOKBodyRtaMysqlAgentItems0) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var listAgentsOkBodyRtaMysqlAgentItems0TypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		listAgentsOkBodyRtaMysqlAgentItems0TypeLogLevelPropEnum = append(listAgentsOkBodyRtaMysqlAgentItems0TypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// ListAgentsOKBodyRtaMysqlAgentItems0LogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ListAgentsOKBodyRtaMysqlAgentItems0LogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// ListAgentsOKBodyRtaMysqlAgentItems0LogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ListAgentsOKBodyRtaMysqlAgentItems0LogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// ListAgentsOKBodyRtaMysqlAgentItems0LogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ListAgentsOKBodyRtaMysqlAgentItems0LogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// ListAgentsOKBodyRtaMysqlAgentItems0LogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ListAgentsOKBodyRtaMysqlAgentItems0LogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// ListAgentsOKBodyRtaMysqlAgentItems0LogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ListAgentsOKBodyRtaMysqlAgentItems0LogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// ListAgentsOKBodyRtaMysqlAgentItems0LogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ListAgentsOKBodyRtaMysqlAgentItems0LogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *ListAgentsOKBodyRtaMysqlAgentItems0) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, listAgentsOkBodyRtaMysqlAgentItems0TypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ListAgentsOKBodyRtaMysqlAgentItems0) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
@@ -5647,20 +7230,20 @@ func (o *ListAgentsOKBodyRDSExporterItems0) validateLogLevel(formats strfmt.Regi
 	return nil
 }
 
-func (o *ListAgentsOKBodyRDSExporterItems0) validateMetricsResolutions(formats strfmt.Registry) error {
-	if swag.IsZero(o.MetricsResolutions) { // not required
+func (o *ListAgentsOKBodyRtaMysqlAgentItems0) validateRtaOptions(formats strfmt.Registry) error {
+	if swag.IsZero(o.RtaOptions) { // not required
 		return nil
 	}
 
-	if o.MetricsResolutions != nil {
-		if err := o.MetricsResolutions.Validate(formats); err != nil {
+	if o.RtaOptions != nil {
+		if err := o.RtaOptions.Validate(formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("metrics_resolutions")
+				return ve.ValidateName("rta_options")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("metrics_resolutions")
+				return ce.ValidateName("rta_options")
 			}
 
 			return err
@@ -5670,11 +7253,11 @@ func (o *ListAgentsOKBodyRDSExporterItems0) validateMetricsResolutions(formats s
 	return nil
 }
 
-// ContextValidate validate this list agents OK body RDS exporter items0 based on the context it is used
-func (o *ListAgentsOKBodyRDSExporterItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this list agents OK body rta mysql agent items0 based on the context it is used
+func (o *ListAgentsOKBodyRtaMysqlAgentItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
-	if err := o.contextValidateMetricsResolutions(ctx, formats); err != nil {
+	if err := o.contextValidateRtaOptions(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
@@ -5684,21 +7267,21 @@ func (o *ListAgentsOKBodyRDSExporterItems0) ContextValidate(ctx context.Context,
 	return nil
 }
 
-func (o *ListAgentsOKBodyRDSExporterItems0) contextValidateMetricsResolutions(ctx context.Context, formats strfmt.Registry) error {
-	if o.MetricsResolutions != nil {
+func (o *ListAgentsOKBodyRtaMysqlAgentItems0) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
+	if o.RtaOptions != nil {
 
-		if swag.IsZero(o.MetricsResolutions) { // not required
+		if swag.IsZero(o.RtaOptions) { // not required
 			return nil
 		}
 
-		if err := o.MetricsResolutions.ContextValidate(ctx, formats); err != nil {
+		if err := o.RtaOptions.ContextValidate(ctx, formats); err != nil {
 			ve := new(errors.Validation)
 			if stderrors.As(err, &ve) {
-				return ve.ValidateName("metrics_resolutions")
+				return ve.ValidateName("rta_options")
 			}
 			ce := new(errors.CompositeError)
 			if stderrors.As(err, &ce) {
-				return ce.ValidateName("metrics_resolutions")
+				return ce.ValidateName("rta_options")
 			}
 
 			return err
@@ -5709,7 +7292,7 @@ func (o *ListAgentsOKBodyRDSExporterItems0) contextValidateMetricsResolutions(ct
 }
 
 // MarshalBinary interface implementation
-func (o *ListAgentsOKBodyRDSExporterItems0) MarshalBinary() ([]byte, error) {
+func (o *ListAgentsOKBodyRtaMysqlAgentItems0) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -5717,8 +7300,8 @@ func (o *ListAgentsOKBodyRDSExporterItems0) MarshalBinary() ([]byte, error) {
 }
 
 // UnmarshalBinary interface implementation
-func (o *ListAgentsOKBodyRDSExporterItems0) UnmarshalBinary(b []byte) error {
-	var res ListAgentsOKBodyRDSExporterItems0
+func (o *ListAgentsOKBodyRtaMysqlAgentItems0) UnmarshalBinary(b []byte) error {
+	var res ListAgentsOKBodyRtaMysqlAgentItems0
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -5727,32 +7310,26 @@ func (o *ListAgentsOKBodyRDSExporterItems0) UnmarshalBinary(b []byte) error {
 }
 
 /*
-ListAgentsOKBodyRDSExporterItems0MetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model ListAgentsOKBodyRDSExporterItems0MetricsResolutions
+ListAgentsOKBodyRtaMysqlAgentItems0RtaOptions RTAOptions holds Real-Time Query Analytics agent options.
+swagger:model ListAgentsOKBodyRtaMysqlAgentItems0RtaOptions
 */
-type ListAgentsOKBodyRDSExporterItems0MetricsResolutions struct {
-	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Hr string `json:"hr,omitempty"`
-
-	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Mr string `json:"mr,omitempty"`
-
-	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Lr string `json:"lr,omitempty"`
+type ListAgentsOKBodyRtaMysqlAgentItems0RtaOptions struct {
+	// Query collect interval (default 2s is set by server).
+	CollectInterval string `json:"collect_interval,omitempty"`
 }
 
-// Validate validates this list agents OK body RDS exporter items0 metrics resolutions
-func (o *ListAgentsOKBodyRDSExporterItems0MetricsResolutions) Validate(formats strfmt.Registry) error {
+// Validate validates this list agents OK body rta mysql agent items0 rta options
+func (o *ListAgentsOKBodyRtaMysqlAgentItems0RtaOptions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this list agents OK body RDS exporter items0 metrics resolutions based on context it is used
-func (o *ListAgentsOKBodyRDSExporterItems0MetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this list agents OK body rta mysql agent items0 rta options based on context it is used
+func (o *ListAgentsOKBodyRtaMysqlAgentItems0RtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ListAgentsOKBodyRDSExporterItems0MetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *ListAgentsOKBodyRtaMysqlAgentItems0RtaOptions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -5760,8 +7337,8 @@ func (o *ListAgentsOKBodyRDSExporterItems0MetricsResolutions) MarshalBinary() ([
 }
 
 // UnmarshalBinary interface implementation
-func (o *ListAgentsOKBodyRDSExporterItems0MetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res ListAgentsOKBodyRDSExporterItems0MetricsResolutions
+func (o *ListAgentsOKBodyRtaMysqlAgentItems0RtaOptions) UnmarshalBinary(b []byte) error {
+	var res ListAgentsOKBodyRtaMysqlAgentItems0RtaOptions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -5770,10 +7347,10 @@ func (o *ListAgentsOKBodyRDSExporterItems0MetricsResolutions) UnmarshalBinary(b
 }
 
 /*
-ListAgentsOKBodyRtaMongodbAgentItems0 RTAMongoDBAgent runs within pmm-agent and sends MongoDB Real-Time Query Analytics data to the PMM Server.
-swagger:model ListAgentsOKBodyRtaMongodbAgentItems0
+ListAgentsOKBodyRtaPostgresqlAgentItems0 RTAPostgreSQLAgent runs within pmm-agent and sends PostgreSQL Real-Time Query Analytics data to the PMM Server.
+swagger:model ListAgentsOKBodyRtaPostgresqlAgentItems0
 */
-type ListAgentsOKBodyRtaMongodbAgentItems0 struct {
+type ListAgentsOKBodyRtaPostgresqlAgentItems0 struct {
 	// Unique agent identifier.
 	AgentID string `json:"agent_id,omitempty"`
 
@@ -5786,7 +7363,7 @@ type ListAgentsOKBodyRtaMongodbAgentItems0 struct {
 	// Service identifier.
 	ServiceID string `json:"service_id,omitempty"`
 
-	// MongoDB username for getting profiler data.
+	// PostgreSQL username for getting currently running queries.
 	Username string `json:"username,omitempty"`
 
 	// Use TLS for database connections.
@@ -5817,11 +7394,11 @@ type ListAgentsOKBodyRtaMongodbAgentItems0 struct {
 	LogLevel *string `json:"log_level,omitempty"`
 
 	// rta options
-	RtaOptions *ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions `json:"rta_options,omitempty"`
+	RtaOptions *ListAgentsOKBodyRtaPostgresqlAgentItems0RtaOptions `json:"rta_options,omitempty"`
 }
 
-// Validate validates this list agents OK body rta mongodb agent items0
-func (o *ListAgentsOKBodyRtaMongodbAgentItems0) Validate(formats strfmt.Registry) error {
+// Validate validates this list agents OK body rta postgresql agent items0
+func (o *ListAgentsOKBodyRtaPostgresqlAgentItems0) Validate(formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.validateStatus(formats); err != nil {
@@ -5842,7 +7419,7 @@ func (o *ListAgentsOKBodyRtaMongodbAgentItems0) Validate(formats strfmt.Registry
 	return nil
 }
 
-var listAgentsOkBodyRtaMongodbAgentItems0TypeStatusPropEnum []any
+var listAgentsOkBodyRtaPostgresqlAgentItems0TypeStatusPropEnum []any
 
 func init() {
 	var res []string
@@ -5850,46 +7427,46 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		listAgentsOkBodyRtaMongodbAgentItems0TypeStatusPropEnum = append(listAgentsOkBodyRtaMongodbAgentItems0TypeStatusPropEnum, v)
+		listAgentsOkBodyRtaPostgresqlAgentItems0TypeStatusPropEnum = append(listAgentsOkBodyRtaPostgresqlAgentItems0TypeStatusPropEnum, v)
 	}
 }
 
 const (
 
-	// ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
-	ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+	// ListAgentsOKBodyRtaPostgresqlAgentItems0StatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	ListAgentsOKBodyRtaPostgresqlAgentItems0StatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
 
-	// ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
-	ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+	// ListAgentsOKBodyRtaPostgresqlAgentItems0StatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	ListAgentsOKBodyRtaPostgresqlAgentItems0StatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
 
-	// ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
-	ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+	// ListAgentsOKBodyRtaPostgresqlAgentItems0StatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	ListAgentsOKBodyRtaPostgresqlAgentItems0StatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
 
-	// ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
-	ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+	// ListAgentsOKBodyRtaPostgresqlAgentItems0StatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	ListAgentsOKBodyRtaPostgresqlAgentItems0StatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
 
-	// ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
-	ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+	// ListAgentsOKBodyRtaPostgresqlAgentItems0StatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	ListAgentsOKBodyRtaPostgresqlAgentItems0StatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
 
-	// ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
-	ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+	// ListAgentsOKBodyRtaPostgresqlAgentItems0StatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	ListAgentsOKBodyRtaPostgresqlAgentItems0StatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
 
-	// ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
-	ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+	// ListAgentsOKBodyRtaPostgresqlAgentItems0StatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	ListAgentsOKBodyRtaPostgresqlAgentItems0StatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
 
-	// ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
-	ListAgentsOKBodyRtaMongodbAgentItems0StatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+	// ListAgentsOKBodyRtaPostgresqlAgentItems0StatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	ListAgentsOKBodyRtaPostgresqlAgentItems0StatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
 )
 
 // prop value enum
-func (o *ListAgentsOKBodyRtaMongodbAgentItems0) validateStatusEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, listAgentsOkBodyRtaMongodbAgentItems0TypeStatusPropEnum, true); err != nil {
+func (o *ListAgentsOKBodyRtaPostgresqlAgentItems0) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, listAgentsOkBodyRtaPostgresqlAgentItems0TypeStatusPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ListAgentsOKBodyRtaMongodbAgentItems0) validateStatus(formats strfmt.Registry) error {
+func (o *ListAgentsOKBodyRtaPostgresqlAgentItems0) validateStatus(formats strfmt.Registry) error {
 	if swag.IsZero(o.Status) { // not required
 		return nil
 	}
@@ -5902,7 +7479,7 @@ func (o *ListAgentsOKBodyRtaMongodbAgentItems0) validateStatus(formats strfmt.Re
 	return nil
 }
 
-var listAgentsOkBodyRtaMongodbAgentItems0TypeLogLevelPropEnum []any
+var listAgentsOkBodyRtaPostgresqlAgentItems0TypeLogLevelPropEnum []any
 
 func init() {
 	var res []string
@@ -5910,40 +7487,40 @@ func init() {
 		panic(err)
 	}
 	for _, v := range res {
-		listAgentsOkBodyRtaMongodbAgentItems0TypeLogLevelPropEnum = append(listAgentsOkBodyRtaMongodbAgentItems0TypeLogLevelPropEnum, v)
+		listAgentsOkBodyRtaPostgresqlAgentItems0TypeLogLevelPropEnum = append(listAgentsOkBodyRtaPostgresqlAgentItems0TypeLogLevelPropEnum, v)
 	}
 }
 
 const (
 
-	// ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
-	ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+	// ListAgentsOKBodyRtaPostgresqlAgentItems0LogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	ListAgentsOKBodyRtaPostgresqlAgentItems0LogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
 
-	// ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
-	ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+	// ListAgentsOKBodyRtaPostgresqlAgentItems0LogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	ListAgentsOKBodyRtaPostgresqlAgentItems0LogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
 
-	// ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
-	ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+	// ListAgentsOKBodyRtaPostgresqlAgentItems0LogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	ListAgentsOKBodyRtaPostgresqlAgentItems0LogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
 
-	// ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
-	ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+	// ListAgentsOKBodyRtaPostgresqlAgentItems0LogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	ListAgentsOKBodyRtaPostgresqlAgentItems0LogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
 
-	// ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
-	ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+	// ListAgentsOKBodyRtaPostgresqlAgentItems0LogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	ListAgentsOKBodyRtaPostgresqlAgentItems0LogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
 
-	// ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
-	ListAgentsOKBodyRtaMongodbAgentItems0LogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+	// ListAgentsOKBodyRtaPostgresqlAgentItems0LogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	ListAgentsOKBodyRtaPostgresqlAgentItems0LogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
 )
 
 // prop value enum
-func (o *ListAgentsOKBodyRtaMongodbAgentItems0) validateLogLevelEnum(path, location string, value string) error {
-	if err := validate.EnumCase(path, location, value, listAgentsOkBodyRtaMongodbAgentItems0TypeLogLevelPropEnum, true); err != nil {
+func (o *ListAgentsOKBodyRtaPostgresqlAgentItems0) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, listAgentsOkBodyRtaPostgresqlAgentItems0TypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (o *ListAgentsOKBodyRtaMongodbAgentItems0) validateLogLevel(formats strfmt.Registry) error {
+func (o *ListAgentsOKBodyRtaPostgresqlAgentItems0) validateLogLevel(formats strfmt.Registry) error {
 	if swag.IsZero(o.LogLevel) { // not required
 		return nil
 	}
@@ -5956,7 +7533,7 @@ func (o *ListAgentsOKBodyRtaMongodbAgentItems0) validateLogLevel(formats strfmt.
 	return nil
 }
 
-func (o *ListAgentsOKBodyRtaMongodbAgentItems0) validateRtaOptions(formats strfmt.Registry) error {
+func (o *ListAgentsOKBodyRtaPostgresqlAgentItems0) validateRtaOptions(formats strfmt.Registry) error {
 	if swag.IsZero(o.RtaOptions) { // not required
 		return nil
 	}
@@ -5979,8 +7556,8 @@ func (o *ListAgentsOKBodyRtaMongodbAgentItems0) validateRtaOptions(formats strfm
 	return nil
 }
 
-// ContextValidate validate this list agents OK body rta mongodb agent items0 based on the context it is used
-func (o *ListAgentsOKBodyRtaMongodbAgentItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validate this list agents OK body rta postgresql agent items0 based on the context it is used
+func (o *ListAgentsOKBodyRtaPostgresqlAgentItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
 	if err := o.contextValidateRtaOptions(ctx, formats); err != nil {
@@ -5993,7 +7570,7 @@ func (o *ListAgentsOKBodyRtaMongodbAgentItems0) ContextValidate(ctx context.Cont
 	return nil
 }
 
-func (o *ListAgentsOKBodyRtaMongodbAgentItems0) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
+func (o *ListAgentsOKBodyRtaPostgresqlAgentItems0) contextValidateRtaOptions(ctx context.Context, formats strfmt.Registry) error {
 	if o.RtaOptions != nil {
 
 		if swag.IsZero(o.RtaOptions) { // not required
@@ -6018,7 +7595,7 @@ func (o *ListAgentsOKBodyRtaMongodbAgentItems0) contextValidateRtaOptions(ctx co
 }
 
 // MarshalBinary interface implementation
-func (o *ListAgentsOKBodyRtaMongodbAgentItems0) MarshalBinary() ([]byte, error) {
+func (o *ListAgentsOKBodyRtaPostgresqlAgentItems0) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -6026,8 +7603,8 @@ func (o *ListAgentsOKBodyRtaMongodbAgentItems0) MarshalBinary() ([]byte, error)
 }
 
 // UnmarshalBinary interface implementation
-func (o *ListAgentsOKBodyRtaMongodbAgentItems0) UnmarshalBinary(b []byte) error {
-	var res ListAgentsOKBodyRtaMongodbAgentItems0
+func (o *ListAgentsOKBodyRtaPostgresqlAgentItems0) UnmarshalBinary(b []byte) error {
+	var res ListAgentsOKBodyRtaPostgresqlAgentItems0
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -6036,26 +7613,26 @@ func (o *ListAgentsOKBodyRtaMongodbAgentItems0) UnmarshalBinary(b []byte) error
 }
 
 /*
-ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions RTAOptions holds Real-Time Query Analytics agent options.
-swagger:model ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions
+ListAgentsOKBodyRtaPostgresqlAgentItems0RtaOptions RTAOptions holds Real-Time Query Analytics agent options.
+swagger:model ListAgentsOKBodyRtaPostgresqlAgentItems0RtaOptions
 */
-type ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions struct {
+type ListAgentsOKBodyRtaPostgresqlAgentItems0RtaOptions struct {
 	// Query collect interval (default 2s is set by server).
 	CollectInterval string `json:"collect_interval,omitempty"`
 }
 
-// Validate validates this list agents OK body rta mongodb agent items0 rta options
-func (o *ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions) Validate(formats strfmt.Registry) error {
+// Validate validates this list agents OK body rta postgresql agent items0 rta options
+func (o *ListAgentsOKBodyRtaPostgresqlAgentItems0RtaOptions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this list agents OK body rta mongodb agent items0 rta options based on context it is used
-func (o *ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this list agents OK body rta postgresql agent items0 rta options based on context it is used
+func (o *ListAgentsOKBodyRtaPostgresqlAgentItems0RtaOptions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions) MarshalBinary() ([]byte, error) {
+func (o *ListAgentsOKBodyRtaPostgresqlAgentItems0RtaOptions) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -6063,8 +7640,8 @@ func (o *ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions) MarshalBinary() ([]byt
 }
 
 // UnmarshalBinary interface implementation
-func (o *ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions) UnmarshalBinary(b []byte) error {
-	var res ListAgentsOKBodyRtaMongodbAgentItems0RtaOptions
+func (o *ListAgentsOKBodyRtaPostgresqlAgentItems0RtaOptions) UnmarshalBinary(b []byte) error {
+	var res ListAgentsOKBodyRtaPostgresqlAgentItems0RtaOptions
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
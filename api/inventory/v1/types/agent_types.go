@@ -37,6 +37,11 @@ const (
 	AgentTypeExternalExporter                = "AGENT_TYPE_EXTERNAL_EXPORTER"
 	AgentTypeAzureDatabaseExporter           = "AGENT_TYPE_AZURE_DATABASE_EXPORTER"
 	AgentTypeRTAMongoDBAgent                 = "AGENT_TYPE_RTA_MONGODB_AGENT"
+	AgentTypeRTAMySQLAgent                   = "AGENT_TYPE_RTA_MYSQL_AGENT"
+	AgentTypeRTAPostgreSQLAgent              = "AGENT_TYPE_RTA_POSTGRESQL_AGENT"
+	AgentTypeQANValkeySlowlogAgent           = "AGENT_TYPE_QAN_VALKEY_SLOWLOG_AGENT"
+	AgentTypeQANProxySQLDigestAgent          = "AGENT_TYPE_QAN_PROXYSQL_DIGEST_AGENT"
+	AgentTypeQANPostgreSQLLogAgent           = "AGENT_TYPE_QAN_POSTGRESQL_LOG_AGENT"
 )
 
 var agentTypeNames = map[string]string{
@@ -60,6 +65,11 @@ var agentTypeNames = map[string]string{
 	AgentTypeExternalExporter:                "external-exporter",
 	AgentTypeAzureDatabaseExporter:           "azure_database_exporter",
 	AgentTypeRTAMongoDBAgent:                 "rta_mongodb_agent",
+	AgentTypeRTAMySQLAgent:                   "rta_mysql_agent",
+	AgentTypeRTAPostgreSQLAgent:              "rta_postgresql_agent",
+	AgentTypeQANValkeySlowlogAgent:           "valkey_slowlog_agent",
+	AgentTypeQANProxySQLDigestAgent:          "proxysql_digest_agent",
+	AgentTypeQANPostgreSQLLogAgent:           "postgresql_log_agent",
 }
 
 // AgentTypeName returns human friendly agent type to be used in reports.
@@ -5020,6 +5020,9 @@ type AddServiceOKBodyPostgresql struct {
 	// postgres exporter
 	PostgresExporter *AddServiceOKBodyPostgresqlPostgresExporter `json:"postgres_exporter,omitempty"`
 
+	// qan postgresql log agent
+	QANPostgresqlLogAgent *AddServiceOKBodyPostgresqlQANPostgresqlLogAgent `json:"qan_postgresql_log_agent,omitempty"`
+
 	// qan postgresql pgstatements agent
 	QANPostgresqlPgstatementsAgent *AddServiceOKBodyPostgresqlQANPostgresqlPgstatementsAgent `json:"qan_postgresql_pgstatements_agent,omitempty"`
 
@@ -5038,6 +5041,10 @@ func (o *AddServiceOKBodyPostgresql) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateQANPostgresqlLogAgent(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateQANPostgresqlPgstatementsAgent(formats); err != nil {
 		res = append(res, err)
 	}
@@ -5079,6 +5086,29 @@ func (o *AddServiceOKBodyPostgresql) validatePostgresExporter(formats strfmt.Reg
 	return nil
 }
 
+func (o *AddServiceOKBodyPostgresql) validateQANPostgresqlLogAgent(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANPostgresqlLogAgent) { // not required
+		return nil
+	}
+
+	if o.QANPostgresqlLogAgent != nil {
+		if err := o.QANPostgresqlLogAgent.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addServiceOk" + "." + "postgresql" + "." + "qan_postgresql_log_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addServiceOk" + "." + "postgresql" + "." + "qan_postgresql_log_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddServiceOKBodyPostgresql) validateQANPostgresqlPgstatementsAgent(formats strfmt.Registry) error {
 	if swag.IsZero(o.QANPostgresqlPgstatementsAgent) { // not required
 		return nil
@@ -5156,6 +5186,10 @@ func (o *AddServiceOKBodyPostgresql) ContextValidate(ctx context.Context, format
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateQANPostgresqlLogAgent(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateQANPostgresqlPgstatementsAgent(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -5198,6 +5232,30 @@ func (o *AddServiceOKBodyPostgresql) contextValidatePostgresExporter(ctx context
 	return nil
 }
 
+func (o *AddServiceOKBodyPostgresql) contextValidateQANPostgresqlLogAgent(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANPostgresqlLogAgent != nil {
+
+		if swag.IsZero(o.QANPostgresqlLogAgent) { // not required
+			return nil
+		}
+
+		if err := o.QANPostgresqlLogAgent.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addServiceOk" + "." + "postgresql" + "." + "qan_postgresql_log_agent")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addServiceOk" + "." + "postgresql" + "." + "qan_postgresql_log_agent")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddServiceOKBodyPostgresql) contextValidateQANPostgresqlPgstatementsAgent(ctx context.Context, formats strfmt.Registry) error {
 	if o.QANPostgresqlPgstatementsAgent != nil {
 
@@ -5621,6 +5679,221 @@ func (o *AddServiceOKBodyPostgresqlPostgresExporterMetricsResolutions) Unmarshal
 	return nil
 }
 
+/*
+AddServiceOKBodyPostgresqlQANPostgresqlLogAgent QANPostgreSQLLogAgent runs within pmm-agent and sends PostgreSQL Query Analytics data from the server log to the PMM Server.
+swagger:model AddServiceOKBodyPostgresqlQANPostgresqlLogAgent
+*/
+type AddServiceOKBodyPostgresqlQANPostgresqlLogAgent struct {
+	// Unique randomly generated instance identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// PostgreSQL username for getting server log settings.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Disable parsing comments from queries and showing them in QAN.
+	DisableCommentsParsing bool `json:"disable_comments_parsing,omitempty"`
+
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
+	// True if query examples are disabled.
+	QueryExamplesDisabled bool `json:"query_examples_disabled,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Path to exec process.
+	ProcessExecPath string `json:"process_exec_path,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+}
+
+// Validate validates this add service OK body postgresql QAN postgresql log agent
+func (o *AddServiceOKBodyPostgresqlQANPostgresqlLogAgent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var addServiceOkBodyPostgresqlQanPostgresqlLogAgentTypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addServiceOkBodyPostgresqlQanPostgresqlLogAgentTypeStatusPropEnum = append(addServiceOkBodyPostgresqlQanPostgresqlLogAgentTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// AddServiceOKBodyPostgresqlQANPostgresqlLogAgentStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	AddServiceOKBodyPostgresqlQANPostgresqlLogAgentStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// AddServiceOKBodyPostgresqlQANPostgresqlLogAgentStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	AddServiceOKBodyPostgresqlQANPostgresqlLogAgentStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// AddServiceOKBodyPostgresqlQANPostgresqlLogAgentStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	AddServiceOKBodyPostgresqlQANPostgresqlLogAgentStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// AddServiceOKBodyPostgresqlQANPostgresqlLogAgentStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	AddServiceOKBodyPostgresqlQANPostgresqlLogAgentStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// AddServiceOKBodyPostgresqlQANPostgresqlLogAgentStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	AddServiceOKBodyPostgresqlQANPostgresqlLogAgentStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// AddServiceOKBodyPostgresqlQANPostgresqlLogAgentStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	AddServiceOKBodyPostgresqlQANPostgresqlLogAgentStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// AddServiceOKBodyPostgresqlQANPostgresqlLogAgentStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	AddServiceOKBodyPostgresqlQANPostgresqlLogAgentStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// AddServiceOKBodyPostgresqlQANPostgresqlLogAgentStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	AddServiceOKBodyPostgresqlQANPostgresqlLogAgentStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *AddServiceOKBodyPostgresqlQANPostgresqlLogAgent) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addServiceOkBodyPostgresqlQanPostgresqlLogAgentTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddServiceOKBodyPostgresqlQANPostgresqlLogAgent) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("addServiceOk"+"."+"postgresql"+"."+"qan_postgresql_log_agent"+"."+"status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var addServiceOkBodyPostgresqlQanPostgresqlLogAgentTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addServiceOkBodyPostgresqlQanPostgresqlLogAgentTypeLogLevelPropEnum = append(addServiceOkBodyPostgresqlQanPostgresqlLogAgentTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// AddServiceOKBodyPostgresqlQANPostgresqlLogAgentLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddServiceOKBodyPostgresqlQANPostgresqlLogAgentLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// AddServiceOKBodyPostgresqlQANPostgresqlLogAgentLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddServiceOKBodyPostgresqlQANPostgresqlLogAgentLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// AddServiceOKBodyPostgresqlQANPostgresqlLogAgentLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddServiceOKBodyPostgresqlQANPostgresqlLogAgentLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// AddServiceOKBodyPostgresqlQANPostgresqlLogAgentLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddServiceOKBodyPostgresqlQANPostgresqlLogAgentLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// AddServiceOKBodyPostgresqlQANPostgresqlLogAgentLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddServiceOKBodyPostgresqlQANPostgresqlLogAgentLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// AddServiceOKBodyPostgresqlQANPostgresqlLogAgentLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddServiceOKBodyPostgresqlQANPostgresqlLogAgentLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *AddServiceOKBodyPostgresqlQANPostgresqlLogAgent) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addServiceOkBodyPostgresqlQanPostgresqlLogAgentTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddServiceOKBodyPostgresqlQANPostgresqlLogAgent) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("addServiceOk"+"."+"postgresql"+"."+"qan_postgresql_log_agent"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this add service OK body postgresql QAN postgresql log agent based on context it is used
+func (o *AddServiceOKBodyPostgresqlQANPostgresqlLogAgent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddServiceOKBodyPostgresqlQANPostgresqlLogAgent) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddServiceOKBodyPostgresqlQANPostgresqlLogAgent) UnmarshalBinary(b []byte) error {
+	var res AddServiceOKBodyPostgresqlQANPostgresqlLogAgent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
 /*
 AddServiceOKBodyPostgresqlQANPostgresqlPgstatementsAgent QANPostgreSQLPgStatementsAgent runs within pmm-agent and sends PostgreSQL Query Analytics data to the PMM Server.
 swagger:model AddServiceOKBodyPostgresqlQANPostgresqlPgstatementsAgent
@@ -6132,6 +6405,9 @@ type AddServiceOKBodyProxysql struct {
 	// proxysql exporter
 	ProxysqlExporter *AddServiceOKBodyProxysqlProxysqlExporter `json:"proxysql_exporter,omitempty"`
 
+	// qan proxysql digest
+	QANProxysqlDigest *AddServiceOKBodyProxysqlQANProxysqlDigest `json:"qan_proxysql_digest,omitempty"`
+
 	// service
 	Service *AddServiceOKBodyProxysqlService `json:"service,omitempty"`
 }
@@ -6144,6 +6420,10 @@ func (o *AddServiceOKBodyProxysql) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateQANProxysqlDigest(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateService(formats); err != nil {
 		res = append(res, err)
 	}
@@ -6177,6 +6457,29 @@ func (o *AddServiceOKBodyProxysql) validateProxysqlExporter(formats strfmt.Regis
 	return nil
 }
 
+func (o *AddServiceOKBodyProxysql) validateQANProxysqlDigest(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANProxysqlDigest) { // not required
+		return nil
+	}
+
+	if o.QANProxysqlDigest != nil {
+		if err := o.QANProxysqlDigest.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addServiceOk" + "." + "proxysql" + "." + "qan_proxysql_digest")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addServiceOk" + "." + "proxysql" + "." + "qan_proxysql_digest")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddServiceOKBodyProxysql) validateService(formats strfmt.Registry) error {
 	if swag.IsZero(o.Service) { // not required
 		return nil
@@ -6208,6 +6511,10 @@ func (o *AddServiceOKBodyProxysql) ContextValidate(ctx context.Context, formats
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateQANProxysqlDigest(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateService(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -6242,6 +6549,30 @@ func (o *AddServiceOKBodyProxysql) contextValidateProxysqlExporter(ctx context.C
 	return nil
 }
 
+func (o *AddServiceOKBodyProxysql) contextValidateQANProxysqlDigest(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANProxysqlDigest != nil {
+
+		if swag.IsZero(o.QANProxysqlDigest) { // not required
+			return nil
+		}
+
+		if err := o.QANProxysqlDigest.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addServiceOk" + "." + "proxysql" + "." + "qan_proxysql_digest")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addServiceOk" + "." + "proxysql" + "." + "qan_proxysql_digest")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddServiceOKBodyProxysql) contextValidateService(ctx context.Context, formats strfmt.Registry) error {
 	if o.Service != nil {
 
@@ -6558,43 +6889,252 @@ func (o *AddServiceOKBodyProxysqlProxysqlExporter) MarshalBinary() ([]byte, erro
 	return swag.WriteJSON(o)
 }
 
-// UnmarshalBinary interface implementation
-func (o *AddServiceOKBodyProxysqlProxysqlExporter) UnmarshalBinary(b []byte) error {
-	var res AddServiceOKBodyProxysqlProxysqlExporter
-	if err := swag.ReadJSON(b, &res); err != nil {
+// UnmarshalBinary interface implementation
+func (o *AddServiceOKBodyProxysqlProxysqlExporter) UnmarshalBinary(b []byte) error {
+	var res AddServiceOKBodyProxysqlProxysqlExporter
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
+swagger:model AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions
+*/
+type AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions struct {
+	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Hr string `json:"hr,omitempty"`
+
+	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Mr string `json:"mr,omitempty"`
+
+	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
+	Lr string `json:"lr,omitempty"`
+}
+
+// Validate validates this add service OK body proxysql proxysql exporter metrics resolutions
+func (o *AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this add service OK body proxysql proxysql exporter metrics resolutions based on context it is used
+func (o *AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
+	var res AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+AddServiceOKBodyProxysqlQANProxysqlDigest QANProxySQLDigestAgent runs within pmm-agent and sends ProxySQL Query Analytics data to the PMM Server.
+swagger:model AddServiceOKBodyProxysqlQANProxysqlDigest
+*/
+type AddServiceOKBodyProxysqlQANProxysqlDigest struct {
+	// Unique randomly generated instance identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// ProxySQL username for getting query digest data.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Path to exec process.
+	ProcessExecPath string `json:"process_exec_path,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+}
+
+// Validate validates this add service OK body proxysql QAN proxysql digest
+func (o *AddServiceOKBodyProxysqlQANProxysqlDigest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var addServiceOkBodyProxysqlQanProxysqlDigestTypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addServiceOkBodyProxysqlQanProxysqlDigestTypeStatusPropEnum = append(addServiceOkBodyProxysqlQanProxysqlDigestTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// AddServiceOKBodyProxysqlQANProxysqlDigestStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	AddServiceOKBodyProxysqlQANProxysqlDigestStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// AddServiceOKBodyProxysqlQANProxysqlDigestStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	AddServiceOKBodyProxysqlQANProxysqlDigestStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// AddServiceOKBodyProxysqlQANProxysqlDigestStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	AddServiceOKBodyProxysqlQANProxysqlDigestStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// AddServiceOKBodyProxysqlQANProxysqlDigestStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	AddServiceOKBodyProxysqlQANProxysqlDigestStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// AddServiceOKBodyProxysqlQANProxysqlDigestStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	AddServiceOKBodyProxysqlQANProxysqlDigestStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// AddServiceOKBodyProxysqlQANProxysqlDigestStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	AddServiceOKBodyProxysqlQANProxysqlDigestStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// AddServiceOKBodyProxysqlQANProxysqlDigestStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	AddServiceOKBodyProxysqlQANProxysqlDigestStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// AddServiceOKBodyProxysqlQANProxysqlDigestStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	AddServiceOKBodyProxysqlQANProxysqlDigestStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *AddServiceOKBodyProxysqlQANProxysqlDigest) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addServiceOkBodyProxysqlQanProxysqlDigestTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddServiceOKBodyProxysqlQANProxysqlDigest) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("addServiceOk"+"."+"proxysql"+"."+"qan_proxysql_digest"+"."+"status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var addServiceOkBodyProxysqlQanProxysqlDigestTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addServiceOkBodyProxysqlQanProxysqlDigestTypeLogLevelPropEnum = append(addServiceOkBodyProxysqlQanProxysqlDigestTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// AddServiceOKBodyProxysqlQANProxysqlDigestLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddServiceOKBodyProxysqlQANProxysqlDigestLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// AddServiceOKBodyProxysqlQANProxysqlDigestLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddServiceOKBodyProxysqlQANProxysqlDigestLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// AddServiceOKBodyProxysqlQANProxysqlDigestLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddServiceOKBodyProxysqlQANProxysqlDigestLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// AddServiceOKBodyProxysqlQANProxysqlDigestLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddServiceOKBodyProxysqlQANProxysqlDigestLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// AddServiceOKBodyProxysqlQANProxysqlDigestLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddServiceOKBodyProxysqlQANProxysqlDigestLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// AddServiceOKBodyProxysqlQANProxysqlDigestLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddServiceOKBodyProxysqlQANProxysqlDigestLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *AddServiceOKBodyProxysqlQANProxysqlDigest) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addServiceOkBodyProxysqlQanProxysqlDigestTypeLogLevelPropEnum, true); err != nil {
 		return err
 	}
-	*o = res
 	return nil
 }
 
-/*
-AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions MetricsResolutions represents Prometheus exporters metrics resolutions.
-swagger:model AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions
-*/
-type AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions struct {
-	// High resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Hr string `json:"hr,omitempty"`
-
-	// Medium resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Mr string `json:"mr,omitempty"`
+func (o *AddServiceOKBodyProxysqlQANProxysqlDigest) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
 
-	// Low resolution. In JSON should be represented as a string with number of seconds with `s` suffix.
-	Lr string `json:"lr,omitempty"`
-}
+	// value enum
+	if err := o.validateLogLevelEnum("addServiceOk"+"."+"proxysql"+"."+"qan_proxysql_digest"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
 
-// Validate validates this add service OK body proxysql proxysql exporter metrics resolutions
-func (o *AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this add service OK body proxysql proxysql exporter metrics resolutions based on context it is used
-func (o *AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+// ContextValidate validates this add service OK body proxysql QAN proxysql digest based on context it is used
+func (o *AddServiceOKBodyProxysqlQANProxysqlDigest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
 }
 
 // MarshalBinary interface implementation
-func (o *AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions) MarshalBinary() ([]byte, error) {
+func (o *AddServiceOKBodyProxysqlQANProxysqlDigest) MarshalBinary() ([]byte, error) {
 	if o == nil {
 		return nil, nil
 	}
@@ -6602,8 +7142,8 @@ func (o *AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions) MarshalBina
 }
 
 // UnmarshalBinary interface implementation
-func (o *AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions) UnmarshalBinary(b []byte) error {
-	var res AddServiceOKBodyProxysqlProxysqlExporterMetricsResolutions
+func (o *AddServiceOKBodyProxysqlQANProxysqlDigest) UnmarshalBinary(b []byte) error {
+	var res AddServiceOKBodyProxysqlQANProxysqlDigest
 	if err := swag.ReadJSON(b, &res); err != nil {
 		return err
 	}
@@ -8844,6 +9384,9 @@ AddServiceOKBodyValkey add service OK body valkey
 swagger:model AddServiceOKBodyValkey
 */
 type AddServiceOKBodyValkey struct {
+	// qan valkey slowlog
+	QANValkeySlowlog *AddServiceOKBodyValkeyQANValkeySlowlog `json:"qan_valkey_slowlog,omitempty"`
+
 	// service
 	Service *AddServiceOKBodyValkeyService `json:"service,omitempty"`
 
@@ -8855,6 +9398,10 @@ type AddServiceOKBodyValkey struct {
 func (o *AddServiceOKBodyValkey) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := o.validateQANValkeySlowlog(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateService(formats); err != nil {
 		res = append(res, err)
 	}
@@ -8869,6 +9416,29 @@ func (o *AddServiceOKBodyValkey) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (o *AddServiceOKBodyValkey) validateQANValkeySlowlog(formats strfmt.Registry) error {
+	if swag.IsZero(o.QANValkeySlowlog) { // not required
+		return nil
+	}
+
+	if o.QANValkeySlowlog != nil {
+		if err := o.QANValkeySlowlog.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addServiceOk" + "." + "valkey" + "." + "qan_valkey_slowlog")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addServiceOk" + "." + "valkey" + "." + "qan_valkey_slowlog")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddServiceOKBodyValkey) validateService(formats strfmt.Registry) error {
 	if swag.IsZero(o.Service) { // not required
 		return nil
@@ -8919,6 +9489,10 @@ func (o *AddServiceOKBodyValkey) validateValkeyExporter(formats strfmt.Registry)
 func (o *AddServiceOKBodyValkey) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := o.contextValidateQANValkeySlowlog(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateService(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -8933,6 +9507,30 @@ func (o *AddServiceOKBodyValkey) ContextValidate(ctx context.Context, formats st
 	return nil
 }
 
+func (o *AddServiceOKBodyValkey) contextValidateQANValkeySlowlog(ctx context.Context, formats strfmt.Registry) error {
+	if o.QANValkeySlowlog != nil {
+
+		if swag.IsZero(o.QANValkeySlowlog) { // not required
+			return nil
+		}
+
+		if err := o.QANValkeySlowlog.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("addServiceOk" + "." + "valkey" + "." + "qan_valkey_slowlog")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("addServiceOk" + "." + "valkey" + "." + "qan_valkey_slowlog")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *AddServiceOKBodyValkey) contextValidateService(ctx context.Context, formats strfmt.Registry) error {
 	if o.Service != nil {
 
@@ -8999,6 +9597,218 @@ func (o *AddServiceOKBodyValkey) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
+/*
+AddServiceOKBodyValkeyQANValkeySlowlog QANValkeySlowlogAgent runs within pmm-agent and sends Valkey Query Analytics data to the PMM Server.
+swagger:model AddServiceOKBodyValkeyQANValkeySlowlog
+*/
+type AddServiceOKBodyValkeyQANValkeySlowlog struct {
+	// Unique randomly generated instance identifier.
+	AgentID string `json:"agent_id,omitempty"`
+
+	// The pmm-agent identifier which runs this instance.
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+
+	// Desired Agent status: enabled (false) or disabled (true).
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Service identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// Valkey username for getting slow log data.
+	Username string `json:"username,omitempty"`
+
+	// Use TLS for database connections.
+	TLS bool `json:"tls,omitempty"`
+
+	// Skip TLS certificate and hostname validation.
+	TLSSkipVerify bool `json:"tls_skip_verify,omitempty"`
+
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
+	// True if query examples are disabled.
+	QueryExamplesDisabled bool `json:"query_examples_disabled,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// AgentStatus represents actual Agent status.
+	//
+	//  - AGENT_STATUS_STARTING: Agent is starting.
+	//  - AGENT_STATUS_INITIALIZATION_ERROR: Agent encountered error when starting.
+	//  - AGENT_STATUS_RUNNING: Agent is running.
+	//  - AGENT_STATUS_WAITING: Agent encountered error and will be restarted automatically soon.
+	//  - AGENT_STATUS_STOPPING: Agent is stopping.
+	//  - AGENT_STATUS_DONE: Agent has been stopped or disabled.
+	//  - AGENT_STATUS_UNKNOWN: Agent is not connected, we don't know anything about it's state.
+	// Enum: ["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]
+	Status *string `json:"status,omitempty"`
+
+	// Path to exec process.
+	ProcessExecPath string `json:"process_exec_path,omitempty"`
+
+	// Log level for exporters
+	//
+	// - LOG_LEVEL_UNSPECIFIED: Auto
+	// Enum: ["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]
+	LogLevel *string `json:"log_level,omitempty"`
+}
+
+// Validate validates this add service OK body valkey QAN valkey slowlog
+func (o *AddServiceOKBodyValkeyQANValkeySlowlog) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateLogLevel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var addServiceOkBodyValkeyQanValkeySlowlogTypeStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["AGENT_STATUS_UNSPECIFIED","AGENT_STATUS_STARTING","AGENT_STATUS_INITIALIZATION_ERROR","AGENT_STATUS_RUNNING","AGENT_STATUS_WAITING","AGENT_STATUS_STOPPING","AGENT_STATUS_DONE","AGENT_STATUS_UNKNOWN"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addServiceOkBodyValkeyQanValkeySlowlogTypeStatusPropEnum = append(addServiceOkBodyValkeyQanValkeySlowlogTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// AddServiceOKBodyValkeyQANValkeySlowlogStatusAGENTSTATUSUNSPECIFIED captures enum value "AGENT_STATUS_UNSPECIFIED"
+	AddServiceOKBodyValkeyQANValkeySlowlogStatusAGENTSTATUSUNSPECIFIED string = "AGENT_STATUS_UNSPECIFIED"
+
+	// AddServiceOKBodyValkeyQANValkeySlowlogStatusAGENTSTATUSSTARTING captures enum value "AGENT_STATUS_STARTING"
+	AddServiceOKBodyValkeyQANValkeySlowlogStatusAGENTSTATUSSTARTING string = "AGENT_STATUS_STARTING"
+
+	// AddServiceOKBodyValkeyQANValkeySlowlogStatusAGENTSTATUSINITIALIZATIONERROR captures enum value "AGENT_STATUS_INITIALIZATION_ERROR"
+	AddServiceOKBodyValkeyQANValkeySlowlogStatusAGENTSTATUSINITIALIZATIONERROR string = "AGENT_STATUS_INITIALIZATION_ERROR"
+
+	// AddServiceOKBodyValkeyQANValkeySlowlogStatusAGENTSTATUSRUNNING captures enum value "AGENT_STATUS_RUNNING"
+	AddServiceOKBodyValkeyQANValkeySlowlogStatusAGENTSTATUSRUNNING string = "AGENT_STATUS_RUNNING"
+
+	// AddServiceOKBodyValkeyQANValkeySlowlogStatusAGENTSTATUSWAITING captures enum value "AGENT_STATUS_WAITING"
+	AddServiceOKBodyValkeyQANValkeySlowlogStatusAGENTSTATUSWAITING string = "AGENT_STATUS_WAITING"
+
+	// AddServiceOKBodyValkeyQANValkeySlowlogStatusAGENTSTATUSSTOPPING captures enum value "AGENT_STATUS_STOPPING"
+	AddServiceOKBodyValkeyQANValkeySlowlogStatusAGENTSTATUSSTOPPING string = "AGENT_STATUS_STOPPING"
+
+	// AddServiceOKBodyValkeyQANValkeySlowlogStatusAGENTSTATUSDONE captures enum value "AGENT_STATUS_DONE"
+	AddServiceOKBodyValkeyQANValkeySlowlogStatusAGENTSTATUSDONE string = "AGENT_STATUS_DONE"
+
+	// AddServiceOKBodyValkeyQANValkeySlowlogStatusAGENTSTATUSUNKNOWN captures enum value "AGENT_STATUS_UNKNOWN"
+	AddServiceOKBodyValkeyQANValkeySlowlogStatusAGENTSTATUSUNKNOWN string = "AGENT_STATUS_UNKNOWN"
+)
+
+// prop value enum
+func (o *AddServiceOKBodyValkeyQANValkeySlowlog) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addServiceOkBodyValkeyQanValkeySlowlogTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddServiceOKBodyValkeyQANValkeySlowlog) validateStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateStatusEnum("addServiceOk"+"."+"valkey"+"."+"qan_valkey_slowlog"+"."+"status", "body", *o.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var addServiceOkBodyValkeyQanValkeySlowlogTypeLogLevelPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["LOG_LEVEL_UNSPECIFIED","LOG_LEVEL_FATAL","LOG_LEVEL_ERROR","LOG_LEVEL_WARN","LOG_LEVEL_INFO","LOG_LEVEL_DEBUG"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		addServiceOkBodyValkeyQanValkeySlowlogTypeLogLevelPropEnum = append(addServiceOkBodyValkeyQanValkeySlowlogTypeLogLevelPropEnum, v)
+	}
+}
+
+const (
+
+	// AddServiceOKBodyValkeyQANValkeySlowlogLogLevelLOGLEVELUNSPECIFIED captures enum value "LOG_LEVEL_UNSPECIFIED"
+	AddServiceOKBodyValkeyQANValkeySlowlogLogLevelLOGLEVELUNSPECIFIED string = "LOG_LEVEL_UNSPECIFIED"
+
+	// AddServiceOKBodyValkeyQANValkeySlowlogLogLevelLOGLEVELFATAL captures enum value "LOG_LEVEL_FATAL"
+	AddServiceOKBodyValkeyQANValkeySlowlogLogLevelLOGLEVELFATAL string = "LOG_LEVEL_FATAL"
+
+	// AddServiceOKBodyValkeyQANValkeySlowlogLogLevelLOGLEVELERROR captures enum value "LOG_LEVEL_ERROR"
+	AddServiceOKBodyValkeyQANValkeySlowlogLogLevelLOGLEVELERROR string = "LOG_LEVEL_ERROR"
+
+	// AddServiceOKBodyValkeyQANValkeySlowlogLogLevelLOGLEVELWARN captures enum value "LOG_LEVEL_WARN"
+	AddServiceOKBodyValkeyQANValkeySlowlogLogLevelLOGLEVELWARN string = "LOG_LEVEL_WARN"
+
+	// AddServiceOKBodyValkeyQANValkeySlowlogLogLevelLOGLEVELINFO captures enum value "LOG_LEVEL_INFO"
+	AddServiceOKBodyValkeyQANValkeySlowlogLogLevelLOGLEVELINFO string = "LOG_LEVEL_INFO"
+
+	// AddServiceOKBodyValkeyQANValkeySlowlogLogLevelLOGLEVELDEBUG captures enum value "LOG_LEVEL_DEBUG"
+	AddServiceOKBodyValkeyQANValkeySlowlogLogLevelLOGLEVELDEBUG string = "LOG_LEVEL_DEBUG"
+)
+
+// prop value enum
+func (o *AddServiceOKBodyValkeyQANValkeySlowlog) validateLogLevelEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, addServiceOkBodyValkeyQanValkeySlowlogTypeLogLevelPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *AddServiceOKBodyValkeyQANValkeySlowlog) validateLogLevel(formats strfmt.Registry) error {
+	if swag.IsZero(o.LogLevel) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateLogLevelEnum("addServiceOk"+"."+"valkey"+"."+"qan_valkey_slowlog"+"."+"log_level", "body", *o.LogLevel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this add service OK body valkey QAN valkey slowlog based on context it is used
+func (o *AddServiceOKBodyValkeyQANValkeySlowlog) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *AddServiceOKBodyValkeyQANValkeySlowlog) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *AddServiceOKBodyValkeyQANValkeySlowlog) UnmarshalBinary(b []byte) error {
+	var res AddServiceOKBodyValkeyQANValkeySlowlog
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
 /*
 AddServiceOKBodyValkeyService ValkeyService represents a generic Valkey instance.
 swagger:model AddServiceOKBodyValkeyService
@@ -11037,6 +11847,9 @@ type AddServiceParamsBodyPostgresql struct {
 	// Connection timeout for exporter (if set).
 	ConnectionTimeout string `json:"connection_timeout,omitempty"`
 
+	// If true, adds qan-postgresql-log-agent for provided service.
+	QANPostgresqlLogAgent bool `json:"qan_postgresql_log_agent,omitempty"`
+
 	// add node
 	AddNode *AddServiceParamsBodyPostgresqlAddNode `json:"add_node,omitempty"`
 }
@@ -11452,6 +12265,12 @@ type AddServiceParamsBodyProxysql struct {
 	// Connection timeout for exporter (if set).
 	ConnectionTimeout string `json:"connection_timeout,omitempty"`
 
+	// If true, adds qan-proxysql-digest-agent for provided service.
+	QANProxysqlDigest bool `json:"qan_proxysql_digest,omitempty"`
+
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
 	// add node
 	AddNode *AddServiceParamsBodyProxysqlAddNode `json:"add_node,omitempty"`
 }
@@ -12130,6 +12949,15 @@ type AddServiceParamsBodyValkey struct {
 	// Connection timeout for exporter (if set).
 	ConnectionTimeout string `json:"connection_timeout,omitempty"`
 
+	// If true, adds qan-valkey-slowlog-agent for provided service.
+	QANValkeySlowlog bool `json:"qan_valkey_slowlog,omitempty"`
+
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `json:"max_query_length,omitempty"`
+
+	// Disable query examples.
+	DisableQueryExamples bool `json:"disable_query_examples,omitempty"`
+
 	// add node
 	AddNode *AddServiceParamsBodyValkeyAddNode `json:"add_node,omitempty"`
 }
@@ -75,6 +75,8 @@ type ClientService interface {
 
 	RemoveService(params *RemoveServiceParams, opts ...ClientOption) (*RemoveServiceOK, error)
 
+	RevokeNodeCertificates(params *RevokeNodeCertificatesParams, opts ...ClientOption) (*RevokeNodeCertificatesOK, error)
+
 	UnregisterNode(params *UnregisterNodeParams, opts ...ClientOption) (*UnregisterNodeOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -608,6 +610,50 @@ func (a *Client) RemoveService(params *RemoveServiceParams, opts ...ClientOption
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+RevokeNodeCertificates revokes node certificates
+
+Revokes client certificates of pmm-agents running on a Node and disconnects them. pmm-agent can connect again only after it is registered again.
+*/
+func (a *Client) RevokeNodeCertificates(params *RevokeNodeCertificatesParams, opts ...ClientOption) (*RevokeNodeCertificatesOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewRevokeNodeCertificatesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RevokeNodeCertificates",
+		Method:             "POST",
+		PathPattern:        "/v1/management/nodes/{node_id}:revokeCertificates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RevokeNodeCertificatesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*RevokeNodeCertificatesOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*RevokeNodeCertificatesDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 UnregisterNode unregisters a node
 
@@ -245,6 +245,10 @@ type RegisterNodeBody struct {
 
 	// AWS instance ID.
 	InstanceID string `json:"instance_id,omitempty"`
+
+	// PEM-encoded PKCS #10 certificate signing request of pmm-agent.
+	// If set, PMM Server issues a client certificate for pmm-agent authentication.
+	CertificateSigningRequest string `json:"certificate_signing_request,omitempty"`
 }
 
 // Validate validates this register node body
@@ -626,6 +630,9 @@ type RegisterNodeOKBody struct {
 	// Warning message.
 	Warning string `json:"warning,omitempty"`
 
+	// PEM-encoded client certificate issued for pmm-agent if certificate_signing_request was set.
+	ClientCertificate string `json:"client_certificate,omitempty"`
+
 	// container node
 	ContainerNode *RegisterNodeOKBodyContainerNode `json:"container_node,omitempty"`
 
@@ -81,7 +81,7 @@ func (x UniversalNode_Status) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use UniversalNode_Status.Descriptor instead.
 func (UniversalNode_Status) EnumDescriptor() ([]byte, []int) {
-	return file_management_v1_node_proto_rawDescGZIP(), []int{5, 0}
+	return file_management_v1_node_proto_rawDescGZIP(), []int{7, 0}
 }
 
 // AddNodeParams holds node params and is used to add new node to inventory while adding new service.
@@ -248,9 +248,12 @@ type RegisterNodeRequest struct {
 	// Optionally expose the exporter process on all public interfaces
 	ExposeExporter bool `protobuf:"varint,16,opt,name=expose_exporter,json=exposeExporter,proto3" json:"expose_exporter,omitempty"`
 	// AWS instance ID.
-	InstanceId    string `protobuf:"bytes,17,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	InstanceId string `protobuf:"bytes,17,opt,name=instance_id,json=instanceId,proto3" json:"instance_id,omitempty"`
+	// PEM-encoded PKCS #10 certificate signing request of pmm-agent.
+	// If set, PMM Server issues a client certificate for pmm-agent authentication.
+	CertificateSigningRequest string `protobuf:"bytes,18,opt,name=certificate_signing_request,json=certificateSigningRequest,proto3" json:"certificate_signing_request,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
 }
 
 func (x *RegisterNodeRequest) Reset() {
@@ -402,6 +405,13 @@ func (x *RegisterNodeRequest) GetInstanceId() string {
 	return ""
 }
 
+func (x *RegisterNodeRequest) GetCertificateSigningRequest() string {
+	if x != nil {
+		return x.CertificateSigningRequest
+	}
+	return ""
+}
+
 type RegisterNodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	GenericNode   *v1.GenericNode        `protobuf:"bytes,1,opt,name=generic_node,json=genericNode,proto3" json:"generic_node,omitempty"`
@@ -410,9 +420,11 @@ type RegisterNodeResponse struct {
 	// Token represents token for vmagent auth config.
 	Token string `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`
 	// Warning message.
-	Warning       string `protobuf:"bytes,5,opt,name=warning,proto3" json:"warning,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Warning string `protobuf:"bytes,5,opt,name=warning,proto3" json:"warning,omitempty"`
+	// PEM-encoded client certificate issued for pmm-agent if certificate_signing_request was set.
+	ClientCertificate string `protobuf:"bytes,6,opt,name=client_certificate,json=clientCertificate,proto3" json:"client_certificate,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *RegisterNodeResponse) Reset() {
@@ -480,6 +492,13 @@ func (x *RegisterNodeResponse) GetWarning() string {
 	return ""
 }
 
+func (x *RegisterNodeResponse) GetClientCertificate() string {
+	if x != nil {
+		return x.ClientCertificate
+	}
+	return ""
+}
+
 type UnregisterNodeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Node_id to be unregistered.
@@ -579,6 +598,87 @@ func (x *UnregisterNodeResponse) GetWarning() string {
 	return ""
 }
 
+type RevokeNodeCertificatesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unique Node identifier.
+	NodeId        string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeNodeCertificatesRequest) Reset() {
+	*x = RevokeNodeCertificatesRequest{}
+	mi := &file_management_v1_node_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeNodeCertificatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeNodeCertificatesRequest) ProtoMessage() {}
+
+func (x *RevokeNodeCertificatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_management_v1_node_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeNodeCertificatesRequest.ProtoReflect.Descriptor instead.
+func (*RevokeNodeCertificatesRequest) Descriptor() ([]byte, []int) {
+	return file_management_v1_node_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RevokeNodeCertificatesRequest) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+type RevokeNodeCertificatesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeNodeCertificatesResponse) Reset() {
+	*x = RevokeNodeCertificatesResponse{}
+	mi := &file_management_v1_node_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeNodeCertificatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeNodeCertificatesResponse) ProtoMessage() {}
+
+func (x *RevokeNodeCertificatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_management_v1_node_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeNodeCertificatesResponse.ProtoReflect.Descriptor instead.
+func (*RevokeNodeCertificatesResponse) Descriptor() ([]byte, []int) {
+	return file_management_v1_node_proto_rawDescGZIP(), []int{6}
+}
+
 type UniversalNode struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Unique Node identifier.
@@ -625,7 +725,7 @@ type UniversalNode struct {
 
 func (x *UniversalNode) Reset() {
 	*x = UniversalNode{}
-	mi := &file_management_v1_node_proto_msgTypes[5]
+	mi := &file_management_v1_node_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -637,7 +737,7 @@ func (x *UniversalNode) String() string {
 func (*UniversalNode) ProtoMessage() {}
 
 func (x *UniversalNode) ProtoReflect() protoreflect.Message {
-	mi := &file_management_v1_node_proto_msgTypes[5]
+	mi := &file_management_v1_node_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,7 +750,7 @@ func (x *UniversalNode) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UniversalNode.ProtoReflect.Descriptor instead.
 func (*UniversalNode) Descriptor() ([]byte, []int) {
-	return file_management_v1_node_proto_rawDescGZIP(), []int{5}
+	return file_management_v1_node_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *UniversalNode) GetNodeId() string {
@@ -796,7 +896,7 @@ type ListNodesRequest struct {
 
 func (x *ListNodesRequest) Reset() {
 	*x = ListNodesRequest{}
-	mi := &file_management_v1_node_proto_msgTypes[6]
+	mi := &file_management_v1_node_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -808,7 +908,7 @@ func (x *ListNodesRequest) String() string {
 func (*ListNodesRequest) ProtoMessage() {}
 
 func (x *ListNodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_management_v1_node_proto_msgTypes[6]
+	mi := &file_management_v1_node_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -821,7 +921,7 @@ func (x *ListNodesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodesRequest.ProtoReflect.Descriptor instead.
 func (*ListNodesRequest) Descriptor() ([]byte, []int) {
-	return file_management_v1_node_proto_rawDescGZIP(), []int{6}
+	return file_management_v1_node_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ListNodesRequest) GetNodeType() v1.NodeType {
@@ -840,7 +940,7 @@ type ListNodesResponse struct {
 
 func (x *ListNodesResponse) Reset() {
 	*x = ListNodesResponse{}
-	mi := &file_management_v1_node_proto_msgTypes[7]
+	mi := &file_management_v1_node_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -852,7 +952,7 @@ func (x *ListNodesResponse) String() string {
 func (*ListNodesResponse) ProtoMessage() {}
 
 func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_management_v1_node_proto_msgTypes[7]
+	mi := &file_management_v1_node_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -865,7 +965,7 @@ func (x *ListNodesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListNodesResponse.ProtoReflect.Descriptor instead.
 func (*ListNodesResponse) Descriptor() ([]byte, []int) {
-	return file_management_v1_node_proto_rawDescGZIP(), []int{7}
+	return file_management_v1_node_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ListNodesResponse) GetNodes() []*UniversalNode {
@@ -885,7 +985,7 @@ type GetNodeRequest struct {
 
 func (x *GetNodeRequest) Reset() {
 	*x = GetNodeRequest{}
-	mi := &file_management_v1_node_proto_msgTypes[8]
+	mi := &file_management_v1_node_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -897,7 +997,7 @@ func (x *GetNodeRequest) String() string {
 func (*GetNodeRequest) ProtoMessage() {}
 
 func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_management_v1_node_proto_msgTypes[8]
+	mi := &file_management_v1_node_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -910,7 +1010,7 @@ func (x *GetNodeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNodeRequest.ProtoReflect.Descriptor instead.
 func (*GetNodeRequest) Descriptor() ([]byte, []int) {
-	return file_management_v1_node_proto_rawDescGZIP(), []int{8}
+	return file_management_v1_node_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetNodeRequest) GetNodeId() string {
@@ -929,7 +1029,7 @@ type GetNodeResponse struct {
 
 func (x *GetNodeResponse) Reset() {
 	*x = GetNodeResponse{}
-	mi := &file_management_v1_node_proto_msgTypes[9]
+	mi := &file_management_v1_node_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -941,7 +1041,7 @@ func (x *GetNodeResponse) String() string {
 func (*GetNodeResponse) ProtoMessage() {}
 
 func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_management_v1_node_proto_msgTypes[9]
+	mi := &file_management_v1_node_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -954,7 +1054,7 @@ func (x *GetNodeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetNodeResponse.ProtoReflect.Descriptor instead.
 func (*GetNodeResponse) Descriptor() ([]byte, []int) {
-	return file_management_v1_node_proto_rawDescGZIP(), []int{9}
+	return file_management_v1_node_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *GetNodeResponse) GetNode() *UniversalNode {
@@ -979,7 +1079,7 @@ type UniversalNode_Service struct {
 
 func (x *UniversalNode_Service) Reset() {
 	*x = UniversalNode_Service{}
-	mi := &file_management_v1_node_proto_msgTypes[12]
+	mi := &file_management_v1_node_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -991,7 +1091,7 @@ func (x *UniversalNode_Service) String() string {
 func (*UniversalNode_Service) ProtoMessage() {}
 
 func (x *UniversalNode_Service) ProtoReflect() protoreflect.Message {
-	mi := &file_management_v1_node_proto_msgTypes[12]
+	mi := &file_management_v1_node_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1004,7 +1104,7 @@ func (x *UniversalNode_Service) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UniversalNode_Service.ProtoReflect.Descriptor instead.
 func (*UniversalNode_Service) Descriptor() ([]byte, []int) {
-	return file_management_v1_node_proto_rawDescGZIP(), []int{5, 0}
+	return file_management_v1_node_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *UniversalNode_Service) GetServiceId() string {
@@ -1044,7 +1144,7 @@ type UniversalNode_Agent struct {
 
 func (x *UniversalNode_Agent) Reset() {
 	*x = UniversalNode_Agent{}
-	mi := &file_management_v1_node_proto_msgTypes[13]
+	mi := &file_management_v1_node_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1056,7 +1156,7 @@ func (x *UniversalNode_Agent) String() string {
 func (*UniversalNode_Agent) ProtoMessage() {}
 
 func (x *UniversalNode_Agent) ProtoReflect() protoreflect.Message {
-	mi := &file_management_v1_node_proto_msgTypes[13]
+	mi := &file_management_v1_node_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,7 +1169,7 @@ func (x *UniversalNode_Agent) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UniversalNode_Agent.ProtoReflect.Descriptor instead.
 func (*UniversalNode_Agent) Descriptor() ([]byte, []int) {
-	return file_management_v1_node_proto_rawDescGZIP(), []int{5, 1}
+	return file_management_v1_node_proto_rawDescGZIP(), []int{7, 1}
 }
 
 func (x *UniversalNode_Agent) GetAgentId() string {
@@ -1121,7 +1221,7 @@ const file_management_v1_node_proto_rawDesc = "" +
 	" \x03(\v2..management.v1.AddNodeParams.CustomLabelsEntryR\fcustomLabels\x1a?\n" +
 	"\x11CustomLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf3\x05\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb3\x06\n" +
 	"\x13RegisterNodeRequest\x123\n" +
 	"\tnode_type\x18\x01 \x01(\x0e2\x16.inventory.v1.NodeTypeR\bnodeType\x12$\n" +
 	"\tnode_name\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\bnodeName\x12\x18\n" +
@@ -1145,21 +1245,26 @@ const file_management_v1_node_proto_rawDesc = "" +
 	"\x0eagent_password\x18\x0f \x01(\tB\x04\x88\xb5\x18\x01R\ragentPassword\x12'\n" +
 	"\x0fexpose_exporter\x18\x10 \x01(\bR\x0eexposeExporter\x12\x1f\n" +
 	"\vinstance_id\x18\x11 \x01(\tR\n" +
-	"instanceId\x1a?\n" +
+	"instanceId\x12>\n" +
+	"\x1bcertificate_signing_request\x18\x12 \x01(\tR\x19certificateSigningRequest\x1a?\n" +
 	"\x11CustomLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x83\x02\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb2\x02\n" +
 	"\x14RegisterNodeResponse\x12<\n" +
 	"\fgeneric_node\x18\x01 \x01(\v2\x19.inventory.v1.GenericNodeR\vgenericNode\x12B\n" +
 	"\x0econtainer_node\x18\x02 \x01(\v2\x1b.inventory.v1.ContainerNodeR\rcontainerNode\x123\n" +
 	"\tpmm_agent\x18\x03 \x01(\v2\x16.inventory.v1.PMMAgentR\bpmmAgent\x12\x1a\n" +
 	"\x05token\x18\x04 \x01(\tB\x04\x88\xb5\x18\x01R\x05token\x12\x18\n" +
-	"\awarning\x18\x05 \x01(\tR\awarning\"O\n" +
+	"\awarning\x18\x05 \x01(\tR\awarning\x12-\n" +
+	"\x12client_certificate\x18\x06 \x01(\tR\x11clientCertificate\"O\n" +
 	"\x15UnregisterNodeRequest\x12 \n" +
 	"\anode_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06nodeId\x12\x14\n" +
 	"\x05force\x18\x02 \x01(\bR\x05force\"2\n" +
 	"\x16UnregisterNodeResponse\x12\x18\n" +
-	"\awarning\x18\x01 \x01(\tR\awarning\"\x9d\t\n" +
+	"\awarning\x18\x01 \x01(\tR\awarning\"A\n" +
+	"\x1dRevokeNodeCertificatesRequest\x12 \n" +
+	"\anode_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06nodeId\" \n" +
+	"\x1eRevokeNodeCertificatesResponse\"\x9d\t\n" +
 	"\rUniversalNode\x12\x17\n" +
 	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1b\n" +
 	"\tnode_type\x18\x02 \x01(\tR\bnodeType\x12\x1b\n" +
@@ -1229,51 +1334,53 @@ func file_management_v1_node_proto_rawDescGZIP() []byte {
 
 var (
 	file_management_v1_node_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-	file_management_v1_node_proto_msgTypes  = make([]protoimpl.MessageInfo, 15)
+	file_management_v1_node_proto_msgTypes  = make([]protoimpl.MessageInfo, 17)
 	file_management_v1_node_proto_goTypes   = []any{
-		UniversalNode_Status(0),        // 0: management.v1.UniversalNode.Status
-		(*AddNodeParams)(nil),          // 1: management.v1.AddNodeParams
-		(*RegisterNodeRequest)(nil),    // 2: management.v1.RegisterNodeRequest
-		(*RegisterNodeResponse)(nil),   // 3: management.v1.RegisterNodeResponse
-		(*UnregisterNodeRequest)(nil),  // 4: management.v1.UnregisterNodeRequest
-		(*UnregisterNodeResponse)(nil), // 5: management.v1.UnregisterNodeResponse
-		(*UniversalNode)(nil),          // 6: management.v1.UniversalNode
-		(*ListNodesRequest)(nil),       // 7: management.v1.ListNodesRequest
-		(*ListNodesResponse)(nil),      // 8: management.v1.ListNodesResponse
-		(*GetNodeRequest)(nil),         // 9: management.v1.GetNodeRequest
-		(*GetNodeResponse)(nil),        // 10: management.v1.GetNodeResponse
-		nil,                            // 11: management.v1.AddNodeParams.CustomLabelsEntry
-		nil,                            // 12: management.v1.RegisterNodeRequest.CustomLabelsEntry
-		(*UniversalNode_Service)(nil),  // 13: management.v1.UniversalNode.Service
-		(*UniversalNode_Agent)(nil),    // 14: management.v1.UniversalNode.Agent
-		nil,                            // 15: management.v1.UniversalNode.CustomLabelsEntry
-		v1.NodeType(0),                 // 16: inventory.v1.NodeType
-		MetricsMode(0),                 // 17: management.v1.MetricsMode
-		(*v1.GenericNode)(nil),         // 18: inventory.v1.GenericNode
-		(*v1.ContainerNode)(nil),       // 19: inventory.v1.ContainerNode
-		(*v1.PMMAgent)(nil),            // 20: inventory.v1.PMMAgent
-		(*timestamppb.Timestamp)(nil),  // 21: google.protobuf.Timestamp
+		UniversalNode_Status(0),                // 0: management.v1.UniversalNode.Status
+		(*AddNodeParams)(nil),                  // 1: management.v1.AddNodeParams
+		(*RegisterNodeRequest)(nil),            // 2: management.v1.RegisterNodeRequest
+		(*RegisterNodeResponse)(nil),           // 3: management.v1.RegisterNodeResponse
+		(*UnregisterNodeRequest)(nil),          // 4: management.v1.UnregisterNodeRequest
+		(*UnregisterNodeResponse)(nil),         // 5: management.v1.UnregisterNodeResponse
+		(*RevokeNodeCertificatesRequest)(nil),  // 6: management.v1.RevokeNodeCertificatesRequest
+		(*RevokeNodeCertificatesResponse)(nil), // 7: management.v1.RevokeNodeCertificatesResponse
+		(*UniversalNode)(nil),                  // 8: management.v1.UniversalNode
+		(*ListNodesRequest)(nil),               // 9: management.v1.ListNodesRequest
+		(*ListNodesResponse)(nil),              // 10: management.v1.ListNodesResponse
+		(*GetNodeRequest)(nil),                 // 11: management.v1.GetNodeRequest
+		(*GetNodeResponse)(nil),                // 12: management.v1.GetNodeResponse
+		nil,                                    // 13: management.v1.AddNodeParams.CustomLabelsEntry
+		nil,                                    // 14: management.v1.RegisterNodeRequest.CustomLabelsEntry
+		(*UniversalNode_Service)(nil),          // 15: management.v1.UniversalNode.Service
+		(*UniversalNode_Agent)(nil),            // 16: management.v1.UniversalNode.Agent
+		nil,                                    // 17: management.v1.UniversalNode.CustomLabelsEntry
+		v1.NodeType(0),                         // 18: inventory.v1.NodeType
+		MetricsMode(0),                         // 19: management.v1.MetricsMode
+		(*v1.GenericNode)(nil),                 // 20: inventory.v1.GenericNode
+		(*v1.ContainerNode)(nil),               // 21: inventory.v1.ContainerNode
+		(*v1.PMMAgent)(nil),                    // 22: inventory.v1.PMMAgent
+		(*timestamppb.Timestamp)(nil),          // 23: google.protobuf.Timestamp
 	}
 )
 
 var file_management_v1_node_proto_depIdxs = []int32{
-	16, // 0: management.v1.AddNodeParams.node_type:type_name -> inventory.v1.NodeType
-	11, // 1: management.v1.AddNodeParams.custom_labels:type_name -> management.v1.AddNodeParams.CustomLabelsEntry
-	16, // 2: management.v1.RegisterNodeRequest.node_type:type_name -> inventory.v1.NodeType
-	12, // 3: management.v1.RegisterNodeRequest.custom_labels:type_name -> management.v1.RegisterNodeRequest.CustomLabelsEntry
-	17, // 4: management.v1.RegisterNodeRequest.metrics_mode:type_name -> management.v1.MetricsMode
-	18, // 5: management.v1.RegisterNodeResponse.generic_node:type_name -> inventory.v1.GenericNode
-	19, // 6: management.v1.RegisterNodeResponse.container_node:type_name -> inventory.v1.ContainerNode
-	20, // 7: management.v1.RegisterNodeResponse.pmm_agent:type_name -> inventory.v1.PMMAgent
-	15, // 8: management.v1.UniversalNode.custom_labels:type_name -> management.v1.UniversalNode.CustomLabelsEntry
-	21, // 9: management.v1.UniversalNode.created_at:type_name -> google.protobuf.Timestamp
-	21, // 10: management.v1.UniversalNode.updated_at:type_name -> google.protobuf.Timestamp
+	18, // 0: management.v1.AddNodeParams.node_type:type_name -> inventory.v1.NodeType
+	13, // 1: management.v1.AddNodeParams.custom_labels:type_name -> management.v1.AddNodeParams.CustomLabelsEntry
+	18, // 2: management.v1.RegisterNodeRequest.node_type:type_name -> inventory.v1.NodeType
+	14, // 3: management.v1.RegisterNodeRequest.custom_labels:type_name -> management.v1.RegisterNodeRequest.CustomLabelsEntry
+	19, // 4: management.v1.RegisterNodeRequest.metrics_mode:type_name -> management.v1.MetricsMode
+	20, // 5: management.v1.RegisterNodeResponse.generic_node:type_name -> inventory.v1.GenericNode
+	21, // 6: management.v1.RegisterNodeResponse.container_node:type_name -> inventory.v1.ContainerNode
+	22, // 7: management.v1.RegisterNodeResponse.pmm_agent:type_name -> inventory.v1.PMMAgent
+	17, // 8: management.v1.UniversalNode.custom_labels:type_name -> management.v1.UniversalNode.CustomLabelsEntry
+	23, // 9: management.v1.UniversalNode.created_at:type_name -> google.protobuf.Timestamp
+	23, // 10: management.v1.UniversalNode.updated_at:type_name -> google.protobuf.Timestamp
 	0,  // 11: management.v1.UniversalNode.status:type_name -> management.v1.UniversalNode.Status
-	14, // 12: management.v1.UniversalNode.agents:type_name -> management.v1.UniversalNode.Agent
-	13, // 13: management.v1.UniversalNode.services:type_name -> management.v1.UniversalNode.Service
-	16, // 14: management.v1.ListNodesRequest.node_type:type_name -> inventory.v1.NodeType
-	6,  // 15: management.v1.ListNodesResponse.nodes:type_name -> management.v1.UniversalNode
-	6,  // 16: management.v1.GetNodeResponse.node:type_name -> management.v1.UniversalNode
+	16, // 12: management.v1.UniversalNode.agents:type_name -> management.v1.UniversalNode.Agent
+	15, // 13: management.v1.UniversalNode.services:type_name -> management.v1.UniversalNode.Service
+	18, // 14: management.v1.ListNodesRequest.node_type:type_name -> inventory.v1.NodeType
+	8,  // 15: management.v1.ListNodesResponse.nodes:type_name -> management.v1.UniversalNode
+	8,  // 16: management.v1.GetNodeResponse.node:type_name -> management.v1.UniversalNode
 	17, // [17:17] is the sub-list for method output_type
 	17, // [17:17] is the sub-list for method input_type
 	17, // [17:17] is the sub-list for extension type_name
@@ -1293,7 +1400,7 @@ func file_management_v1_node_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_management_v1_node_proto_rawDesc), len(file_management_v1_node_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   15,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
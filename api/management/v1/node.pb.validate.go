@@ -232,6 +232,8 @@ func (m *RegisterNodeRequest) validate(all bool) error {
 
 	// no validation rules for InstanceId
 
+	// no validation rules for CertificateSigningRequest
+
 	if len(errors) > 0 {
 		return RegisterNodeRequestMultiError(errors)
 	}
@@ -426,6 +428,8 @@ func (m *RegisterNodeResponse) validate(all bool) error {
 
 	// no validation rules for Warning
 
+	// no validation rules for ClientCertificate
+
 	if len(errors) > 0 {
 		return RegisterNodeResponseMultiError(errors)
 	}
@@ -728,6 +732,225 @@ var _ interface {
 	ErrorName() string
 } = UnregisterNodeResponseValidationError{}
 
+// Validate checks the field values on RevokeNodeCertificatesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeNodeCertificatesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeNodeCertificatesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeNodeCertificatesRequestMultiError, or nil if none found.
+func (m *RevokeNodeCertificatesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeNodeCertificatesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetNodeId()) < 1 {
+		err := RevokeNodeCertificatesRequestValidationError{
+			field:  "NodeId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeNodeCertificatesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeNodeCertificatesRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeNodeCertificatesRequest.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeNodeCertificatesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeNodeCertificatesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeNodeCertificatesRequestMultiError) AllErrors() []error { return m }
+
+// RevokeNodeCertificatesRequestValidationError is the validation error
+// returned by RevokeNodeCertificatesRequest.Validate if the designated
+// constraints aren't met.
+type RevokeNodeCertificatesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeNodeCertificatesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeNodeCertificatesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeNodeCertificatesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeNodeCertificatesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeNodeCertificatesRequestValidationError) ErrorName() string {
+	return "RevokeNodeCertificatesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeNodeCertificatesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeNodeCertificatesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = RevokeNodeCertificatesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeNodeCertificatesRequestValidationError{}
+
+// Validate checks the field values on RevokeNodeCertificatesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RevokeNodeCertificatesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeNodeCertificatesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RevokeNodeCertificatesResponseMultiError, or nil if none found.
+func (m *RevokeNodeCertificatesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeNodeCertificatesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeNodeCertificatesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeNodeCertificatesResponseMultiError is an error wrapping multiple
+// validation errors returned by RevokeNodeCertificatesResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RevokeNodeCertificatesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeNodeCertificatesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeNodeCertificatesResponseMultiError) AllErrors() []error { return m }
+
+// RevokeNodeCertificatesResponseValidationError is the validation error
+// returned by RevokeNodeCertificatesResponse.Validate if the designated
+// constraints aren't met.
+type RevokeNodeCertificatesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeNodeCertificatesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeNodeCertificatesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeNodeCertificatesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeNodeCertificatesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeNodeCertificatesResponseValidationError) ErrorName() string {
+	return "RevokeNodeCertificatesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeNodeCertificatesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeNodeCertificatesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = RevokeNodeCertificatesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeNodeCertificatesResponseValidationError{}
+
 // Validate checks the field values on UniversalNode with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
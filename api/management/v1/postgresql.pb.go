@@ -105,8 +105,10 @@ type AddPostgreSQLServiceParams struct {
 	MaxExporterConnections int32 `protobuf:"varint,33,opt,name=max_exporter_connections,json=maxExporterConnections,proto3" json:"max_exporter_connections,omitempty"`
 	// Connection timeout for exporter (if set).
 	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,34,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// If true, adds qan-postgresql-log-agent for provided service.
+	QanPostgresqlLogAgent bool `protobuf:"varint,35,opt,name=qan_postgresql_log_agent,json=qanPostgresqlLogAgent,proto3" json:"qan_postgresql_log_agent,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *AddPostgreSQLServiceParams) Reset() {
@@ -377,6 +379,13 @@ func (x *AddPostgreSQLServiceParams) GetConnectionTimeout() *durationpb.Duration
 	return nil
 }
 
+func (x *AddPostgreSQLServiceParams) GetQanPostgresqlLogAgent() bool {
+	if x != nil {
+		return x.QanPostgresqlLogAgent
+	}
+	return false
+}
+
 type PostgreSQLServiceResult struct {
 	state                           protoimpl.MessageState              `protogen:"open.v1"`
 	Service                         *v1.PostgreSQLService               `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
@@ -384,9 +393,10 @@ type PostgreSQLServiceResult struct {
 	QanPostgresqlPgstatementsAgent  *v1.QANPostgreSQLPgStatementsAgent  `protobuf:"bytes,3,opt,name=qan_postgresql_pgstatements_agent,json=qanPostgresqlPgstatementsAgent,proto3" json:"qan_postgresql_pgstatements_agent,omitempty"`
 	QanPostgresqlPgstatmonitorAgent *v1.QANPostgreSQLPgStatMonitorAgent `protobuf:"bytes,4,opt,name=qan_postgresql_pgstatmonitor_agent,json=qanPostgresqlPgstatmonitorAgent,proto3" json:"qan_postgresql_pgstatmonitor_agent,omitempty"`
 	// Warning message.
-	Warning       string `protobuf:"bytes,5,opt,name=warning,proto3" json:"warning,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Warning               string                    `protobuf:"bytes,5,opt,name=warning,proto3" json:"warning,omitempty"`
+	QanPostgresqlLogAgent *v1.QANPostgreSQLLogAgent `protobuf:"bytes,6,opt,name=qan_postgresql_log_agent,json=qanPostgresqlLogAgent,proto3" json:"qan_postgresql_log_agent,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *PostgreSQLServiceResult) Reset() {
@@ -454,11 +464,18 @@ func (x *PostgreSQLServiceResult) GetWarning() string {
 	return ""
 }
 
+func (x *PostgreSQLServiceResult) GetQanPostgresqlLogAgent() *v1.QANPostgreSQLLogAgent {
+	if x != nil {
+		return x.QanPostgresqlLogAgent
+	}
+	return nil
+}
+
 var File_management_v1_postgresql_proto protoreflect.FileDescriptor
 
 const file_management_v1_postgresql_proto_rawDesc = "" +
 	"\n" +
-	"\x1emanagement/v1/postgresql.proto\x12\rmanagement.v1\x1a\x1aextensions/v1/redact.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x19inventory/v1/agents.proto\x1a\x1cinventory/v1/log_level.proto\x1a\x1binventory/v1/services.proto\x1a\x1bmanagement/v1/metrics.proto\x1a\x18management/v1/node.proto\x1a\x17validate/validate.proto\"\xc7\f\n" +
+	"\x1emanagement/v1/postgresql.proto\x12\rmanagement.v1\x1a\x1aextensions/v1/redact.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x19inventory/v1/agents.proto\x1a\x1cinventory/v1/log_level.proto\x1a\x1binventory/v1/services.proto\x1a\x1bmanagement/v1/metrics.proto\x1a\x18management/v1/node.proto\x1a\x17validate/validate.proto\"\x80\r\n" +
 	"\x1aAddPostgreSQLServiceParams\x12\x17\n" +
 	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1b\n" +
 	"\tnode_name\x18\x02 \x01(\tR\bnodeName\x127\n" +
@@ -495,16 +512,18 @@ const file_management_v1_postgresql_proto_rawDesc = "" +
 	"\x14auto_discovery_limit\x18\x1f \x01(\x05R\x12autoDiscoveryLimit\x12'\n" +
 	"\x0fexpose_exporter\x18  \x01(\bR\x0eexposeExporter\x128\n" +
 	"\x18max_exporter_connections\x18! \x01(\x05R\x16maxExporterConnections\x12R\n" +
-	"\x12connection_timeout\x18\" \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x022\x00R\x11connectionTimeout\x1a?\n" +
+	"\x12connection_timeout\x18\" \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x022\x00R\x11connectionTimeout\x127\n" +
+	"\x18qan_postgresql_log_agent\x18# \x01(\bR\x15qanPostgresqlLogAgent\x1a?\n" +
 	"\x11CustomLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb0\x03\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8e\x04\n" +
 	"\x17PostgreSQLServiceResult\x129\n" +
 	"\aservice\x18\x01 \x01(\v2\x1f.inventory.v1.PostgreSQLServiceR\aservice\x12K\n" +
 	"\x11postgres_exporter\x18\x02 \x01(\v2\x1e.inventory.v1.PostgresExporterR\x10postgresExporter\x12w\n" +
 	"!qan_postgresql_pgstatements_agent\x18\x03 \x01(\v2,.inventory.v1.QANPostgreSQLPgStatementsAgentR\x1eqanPostgresqlPgstatementsAgent\x12z\n" +
 	"\"qan_postgresql_pgstatmonitor_agent\x18\x04 \x01(\v2-.inventory.v1.QANPostgreSQLPgStatMonitorAgentR\x1fqanPostgresqlPgstatmonitorAgent\x12\x18\n" +
-	"\awarning\x18\x05 \x01(\tR\awarningB\xb0\x01\n" +
+	"\awarning\x18\x05 \x01(\tR\awarning\x12\\\n" +
+	"\x18qan_postgresql_log_agent\x18\x06 \x01(\v2#.inventory.v1.QANPostgreSQLLogAgentR\x15qanPostgresqlLogAgentB\xb0\x01\n" +
 	"\x11com.management.v1B\x0fPostgresqlProtoP\x01Z5github.com/percona/pmm/api/management/v1;managementv1\xa2\x02\x03MXX\xaa\x02\rManagement.V1\xca\x02\rManagement\\V1\xe2\x02\x19Management\\V1\\GPBMetadata\xea\x02\x0eManagement::V1b\x06proto3"
 
 var (
@@ -533,6 +552,7 @@ var (
 		(*v1.PostgresExporter)(nil),                // 8: inventory.v1.PostgresExporter
 		(*v1.QANPostgreSQLPgStatementsAgent)(nil),  // 9: inventory.v1.QANPostgreSQLPgStatementsAgent
 		(*v1.QANPostgreSQLPgStatMonitorAgent)(nil), // 10: inventory.v1.QANPostgreSQLPgStatMonitorAgent
+		(*v1.QANPostgreSQLLogAgent)(nil),           // 11: inventory.v1.QANPostgreSQLLogAgent
 	}
 )
 
@@ -546,11 +566,12 @@ var file_management_v1_postgresql_proto_depIdxs = []int32{
 	8,  // 6: management.v1.PostgreSQLServiceResult.postgres_exporter:type_name -> inventory.v1.PostgresExporter
 	9,  // 7: management.v1.PostgreSQLServiceResult.qan_postgresql_pgstatements_agent:type_name -> inventory.v1.QANPostgreSQLPgStatementsAgent
 	10, // 8: management.v1.PostgreSQLServiceResult.qan_postgresql_pgstatmonitor_agent:type_name -> inventory.v1.QANPostgreSQLPgStatMonitorAgent
-	9,  // [9:9] is the sub-list for method output_type
-	9,  // [9:9] is the sub-list for method input_type
-	9,  // [9:9] is the sub-list for extension type_name
-	9,  // [9:9] is the sub-list for extension extendee
-	0,  // [0:9] is the sub-list for field type_name
+	11, // 9: management.v1.PostgreSQLServiceResult.qan_postgresql_log_agent:type_name -> inventory.v1.QANPostgreSQLLogAgent
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_management_v1_postgresql_proto_init() }
@@ -209,6 +209,8 @@ func (m *AddPostgreSQLServiceParams) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for QanPostgresqlLogAgent
+
 	if len(errors) > 0 {
 		return AddPostgreSQLServiceParamsMultiError(errors)
 	}
@@ -430,6 +432,35 @@ func (m *PostgreSQLServiceResult) validate(all bool) error {
 
 	// no validation rules for Warning
 
+	if all {
+		switch v := interface{}(m.GetQanPostgresqlLogAgent()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PostgreSQLServiceResultValidationError{
+					field:  "QanPostgresqlLogAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PostgreSQLServiceResultValidationError{
+					field:  "QanPostgresqlLogAgent",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQanPostgresqlLogAgent()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PostgreSQLServiceResultValidationError{
+				field:  "QanPostgresqlLogAgent",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return PostgreSQLServiceResultMultiError(errors)
 	}
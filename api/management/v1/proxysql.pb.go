@@ -83,8 +83,12 @@ type AddProxySQLServiceParams struct {
 	ExposeExporter bool `protobuf:"varint,23,opt,name=expose_exporter,json=exposeExporter,proto3" json:"expose_exporter,omitempty"`
 	// Connection timeout for exporter (if set).
 	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,24,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// If true, adds qan-proxysql-digest-agent for provided service.
+	QanProxysqlDigest bool `protobuf:"varint,25,opt,name=qan_proxysql_digest,json=qanProxysqlDigest,proto3" json:"qan_proxysql_digest,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `protobuf:"varint,26,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *AddProxySQLServiceParams) Reset() {
@@ -278,12 +282,27 @@ func (x *AddProxySQLServiceParams) GetConnectionTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *AddProxySQLServiceParams) GetQanProxysqlDigest() bool {
+	if x != nil {
+		return x.QanProxysqlDigest
+	}
+	return false
+}
+
+func (x *AddProxySQLServiceParams) GetMaxQueryLength() int32 {
+	if x != nil {
+		return x.MaxQueryLength
+	}
+	return 0
+}
+
 type ProxySQLServiceResult struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	Service          *v1.ProxySQLService    `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
-	ProxysqlExporter *v1.ProxySQLExporter   `protobuf:"bytes,2,opt,name=proxysql_exporter,json=proxysqlExporter,proto3" json:"proxysql_exporter,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	state             protoimpl.MessageState     `protogen:"open.v1"`
+	Service           *v1.ProxySQLService        `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	ProxysqlExporter  *v1.ProxySQLExporter       `protobuf:"bytes,2,opt,name=proxysql_exporter,json=proxysqlExporter,proto3" json:"proxysql_exporter,omitempty"`
+	QanProxysqlDigest *v1.QANProxySQLDigestAgent `protobuf:"bytes,3,opt,name=qan_proxysql_digest,json=qanProxysqlDigest,proto3" json:"qan_proxysql_digest,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ProxySQLServiceResult) Reset() {
@@ -330,11 +349,18 @@ func (x *ProxySQLServiceResult) GetProxysqlExporter() *v1.ProxySQLExporter {
 	return nil
 }
 
+func (x *ProxySQLServiceResult) GetQanProxysqlDigest() *v1.QANProxySQLDigestAgent {
+	if x != nil {
+		return x.QanProxysqlDigest
+	}
+	return nil
+}
+
 var File_management_v1_proxysql_proto protoreflect.FileDescriptor
 
 const file_management_v1_proxysql_proto_rawDesc = "" +
 	"\n" +
-	"\x1cmanagement/v1/proxysql.proto\x12\rmanagement.v1\x1a\x1aextensions/v1/redact.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x19inventory/v1/agents.proto\x1a\x1cinventory/v1/log_level.proto\x1a\x1binventory/v1/services.proto\x1a\x1bmanagement/v1/metrics.proto\x1a\x18management/v1/node.proto\x1a\x17validate/validate.proto\"\xb2\b\n" +
+	"\x1cmanagement/v1/proxysql.proto\x12\rmanagement.v1\x1a\x1aextensions/v1/redact.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x19inventory/v1/agents.proto\x1a\x1cinventory/v1/log_level.proto\x1a\x1binventory/v1/services.proto\x1a\x1bmanagement/v1/metrics.proto\x1a\x18management/v1/node.proto\x1a\x17validate/validate.proto\"\x8c\t\n" +
 	"\x18AddProxySQLServiceParams\x12\x17\n" +
 	"\anode_id\x18\x01 \x01(\tR\x06nodeId\x12\x1b\n" +
 	"\tnode_name\x18\x02 \x01(\tR\bnodeName\x127\n" +
@@ -360,13 +386,16 @@ const file_management_v1_proxysql_proto_rawDesc = "" +
 	"\x0eagent_password\x18\x15 \x01(\tB\x04\x88\xb5\x18\x01R\ragentPassword\x123\n" +
 	"\tlog_level\x18\x16 \x01(\x0e2\x16.inventory.v1.LogLevelR\blogLevel\x12'\n" +
 	"\x0fexpose_exporter\x18\x17 \x01(\bR\x0eexposeExporter\x12R\n" +
-	"\x12connection_timeout\x18\x18 \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x022\x00R\x11connectionTimeout\x1a?\n" +
+	"\x12connection_timeout\x18\x18 \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x022\x00R\x11connectionTimeout\x12.\n" +
+	"\x13qan_proxysql_digest\x18\x19 \x01(\bR\x11qanProxysqlDigest\x12(\n" +
+	"\x10max_query_length\x18\x1a \x01(\x05R\x0emaxQueryLength\x1a?\n" +
 	"\x11CustomLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9d\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xf3\x01\n" +
 	"\x15ProxySQLServiceResult\x127\n" +
 	"\aservice\x18\x01 \x01(\v2\x1d.inventory.v1.ProxySQLServiceR\aservice\x12K\n" +
-	"\x11proxysql_exporter\x18\x02 \x01(\v2\x1e.inventory.v1.ProxySQLExporterR\x10proxysqlExporterB\xae\x01\n" +
+	"\x11proxysql_exporter\x18\x02 \x01(\v2\x1e.inventory.v1.ProxySQLExporterR\x10proxysqlExporter\x12T\n" +
+	"\x13qan_proxysql_digest\x18\x03 \x01(\v2$.inventory.v1.QANProxySQLDigestAgentR\x11qanProxysqlDigestB\xae\x01\n" +
 	"\x11com.management.v1B\rProxysqlProtoP\x01Z5github.com/percona/pmm/api/management/v1;managementv1\xa2\x02\x03MXX\xaa\x02\rManagement.V1\xca\x02\rManagement\\V1\xe2\x02\x19Management\\V1\\GPBMetadata\xea\x02\x0eManagement::V1b\x06proto3"
 
 var (
@@ -384,15 +413,16 @@ func file_management_v1_proxysql_proto_rawDescGZIP() []byte {
 var (
 	file_management_v1_proxysql_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 	file_management_v1_proxysql_proto_goTypes  = []any{
-		(*AddProxySQLServiceParams)(nil), // 0: management.v1.AddProxySQLServiceParams
-		(*ProxySQLServiceResult)(nil),    // 1: management.v1.ProxySQLServiceResult
-		nil,                              // 2: management.v1.AddProxySQLServiceParams.CustomLabelsEntry
-		(*AddNodeParams)(nil),            // 3: management.v1.AddNodeParams
-		MetricsMode(0),                   // 4: management.v1.MetricsMode
-		v1.LogLevel(0),                   // 5: inventory.v1.LogLevel
-		(*durationpb.Duration)(nil),      // 6: google.protobuf.Duration
-		(*v1.ProxySQLService)(nil),       // 7: inventory.v1.ProxySQLService
-		(*v1.ProxySQLExporter)(nil),      // 8: inventory.v1.ProxySQLExporter
+		(*AddProxySQLServiceParams)(nil),  // 0: management.v1.AddProxySQLServiceParams
+		(*ProxySQLServiceResult)(nil),     // 1: management.v1.ProxySQLServiceResult
+		nil,                               // 2: management.v1.AddProxySQLServiceParams.CustomLabelsEntry
+		(*AddNodeParams)(nil),             // 3: management.v1.AddNodeParams
+		MetricsMode(0),                    // 4: management.v1.MetricsMode
+		v1.LogLevel(0),                    // 5: inventory.v1.LogLevel
+		(*durationpb.Duration)(nil),       // 6: google.protobuf.Duration
+		(*v1.ProxySQLService)(nil),        // 7: inventory.v1.ProxySQLService
+		(*v1.ProxySQLExporter)(nil),       // 8: inventory.v1.ProxySQLExporter
+		(*v1.QANProxySQLDigestAgent)(nil), // 9: inventory.v1.QANProxySQLDigestAgent
 	}
 )
 
@@ -404,11 +434,12 @@ var file_management_v1_proxysql_proto_depIdxs = []int32{
 	6, // 4: management.v1.AddProxySQLServiceParams.connection_timeout:type_name -> google.protobuf.Duration
 	7, // 5: management.v1.ProxySQLServiceResult.service:type_name -> inventory.v1.ProxySQLService
 	8, // 6: management.v1.ProxySQLServiceResult.proxysql_exporter:type_name -> inventory.v1.ProxySQLExporter
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	9, // 7: management.v1.ProxySQLServiceResult.qan_proxysql_digest:type_name -> inventory.v1.QANProxySQLDigestAgent
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_management_v1_proxysql_proto_init() }
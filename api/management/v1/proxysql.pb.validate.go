@@ -187,6 +187,10 @@ func (m *AddProxySQLServiceParams) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for QanProxysqlDigest
+
+	// no validation rules for MaxQueryLength
+
 	if len(errors) > 0 {
 		return AddProxySQLServiceParamsMultiError(errors)
 	}
@@ -348,6 +352,35 @@ func (m *ProxySQLServiceResult) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetQanProxysqlDigest()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ProxySQLServiceResultValidationError{
+					field:  "QanProxysqlDigest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ProxySQLServiceResultValidationError{
+					field:  "QanProxysqlDigest",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQanProxysqlDigest()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ProxySQLServiceResultValidationError{
+				field:  "QanProxysqlDigest",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ProxySQLServiceResultMultiError(errors)
 	}
@@ -919,14 +919,15 @@ const file_management_v1_service_proto_rawDesc = "" +
 	"\fservice_type\x18\x02 \x01(\x0e2\x19.inventory.v1.ServiceTypeR\vserviceType\x12%\n" +
 	"\x0eexternal_group\x18\x03 \x01(\tR\rexternalGroup\"S\n" +
 	"\x14ListServicesResponse\x12;\n" +
-	"\bservices\x18\x01 \x03(\v2\x1f.management.v1.UniversalServiceR\bservices2\xa2\x13\n" +
+	"\bservices\x18\x01 \x03(\v2\x1f.management.v1.UniversalServiceR\bservices2\x8a\x16\n" +
 	"\x11ManagementService\x12\xac\x01\n" +
 	"\rAddAnnotation\x12#.management.v1.AddAnnotationRequest\x1a$.management.v1.AddAnnotationResponse\"P\x92A(\x12\x11Add an Annotation\x1a\x13Adds an annotation.\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/management/annotations\x12\x9b\x01\n" +
 	"\n" +
 	"ListAgents\x12 .management.v1.ListAgentsRequest\x1a!.management.v1.ListAgentsResponse\"H\x92A(\x12\vList Agents\x1a\x19Lists Agents with filter.\x82\xd3\xe4\x93\x02\x17\x12\x15/v1/management/agents\x12\xd7\x01\n" +
 	"\x11ListAgentVersions\x12'.management.v1.ListAgentVersionsRequest\x1a(.management.v1.ListAgentVersionsResponse\"o\x92AF\x12\x13List Agent Versions\x1a/Lists Agent versions and their update severity.\x82\xd3\xe4\x93\x02 \x12\x1e/v1/management/agents/versions\x12\xb3\x01\n" +
 	"\fRegisterNode\x12\".management.v1.RegisterNodeRequest\x1a#.management.v1.RegisterNodeResponse\"Z\x92A8\x12\x0fRegister a Node\x1a%Registers a new Node and a pmm-agent.\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/management/nodes\x12\xbd\x01\n" +
-	"\x0eUnregisterNode\x12$.management.v1.UnregisterNodeRequest\x1a%.management.v1.UnregisterNodeResponse\"^\x92A5\x12\x11Unregister a Node\x1a Unregisters a Node and pmm-agent\x82\xd3\xe4\x93\x02 *\x1e/v1/management/nodes/{node_id}\x12\x95\x01\n" +
+	"\x0eUnregisterNode\x12$.management.v1.UnregisterNodeRequest\x1a%.management.v1.UnregisterNodeResponse\"^\x92A5\x12\x11Unregister a Node\x1a Unregisters a Node and pmm-agent\x82\xd3\xe4\x93\x02 *\x1e/v1/management/nodes/{node_id}\x12\xe5\x02\n" +
+	"\x16RevokeNodeCertificates\x12,.management.v1.RevokeNodeCertificatesRequest\x1a-.management.v1.RevokeNodeCertificatesResponse\"\xed\x01\x92A\xad\x01\x12\x18Revoke Node Certificates\x1a\x90\x01Revokes client certificates of pmm-agents running on a Node and disconnects them. pmm-agent can connect again only after it is registered again.\x82\xd3\xe4\x93\x026:\x01*\"1/v1/management/nodes/{node_id}:revokeCertificates\x12\x95\x01\n" +
 	"\tListNodes\x12\x1f.management.v1.ListNodesRequest\x1a .management.v1.ListNodesResponse\"E\x92A&\x12\n" +
 	"List Nodes\x1a\x18Lists Nodes with filter.\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/management/nodes\x12\x98\x01\n" +
 	"\aGetNode\x12\x1d.management.v1.GetNodeRequest\x1a\x1e.management.v1.GetNodeResponse\"N\x92A%\x12\bGet Node\x1a\x19Gets a single Node by ID.\x82\xd3\xe4\x93\x02 \x12\x1e/v1/management/nodes/{node_id}\x12\xb2\x01\n" +
@@ -955,54 +956,56 @@ var (
 	file_management_v1_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
 	file_management_v1_service_proto_msgTypes  = make([]protoimpl.MessageInfo, 8)
 	file_management_v1_service_proto_goTypes   = []any{
-		UniversalService_Status(0),            // 0: management.v1.UniversalService.Status
-		(*AddServiceRequest)(nil),             // 1: management.v1.AddServiceRequest
-		(*AddServiceResponse)(nil),            // 2: management.v1.AddServiceResponse
-		(*RemoveServiceRequest)(nil),          // 3: management.v1.RemoveServiceRequest
-		(*RemoveServiceResponse)(nil),         // 4: management.v1.RemoveServiceResponse
-		(*UniversalService)(nil),              // 5: management.v1.UniversalService
-		(*ListServicesRequest)(nil),           // 6: management.v1.ListServicesRequest
-		(*ListServicesResponse)(nil),          // 7: management.v1.ListServicesResponse
-		nil,                                   // 8: management.v1.UniversalService.CustomLabelsEntry
-		(*AddMySQLServiceParams)(nil),         // 9: management.v1.AddMySQLServiceParams
-		(*AddMongoDBServiceParams)(nil),       // 10: management.v1.AddMongoDBServiceParams
-		(*AddPostgreSQLServiceParams)(nil),    // 11: management.v1.AddPostgreSQLServiceParams
-		(*AddProxySQLServiceParams)(nil),      // 12: management.v1.AddProxySQLServiceParams
-		(*AddHAProxyServiceParams)(nil),       // 13: management.v1.AddHAProxyServiceParams
-		(*AddExternalServiceParams)(nil),      // 14: management.v1.AddExternalServiceParams
-		(*AddRDSServiceParams)(nil),           // 15: management.v1.AddRDSServiceParams
-		(*AddValkeyServiceParams)(nil),        // 16: management.v1.AddValkeyServiceParams
-		(*MySQLServiceResult)(nil),            // 17: management.v1.MySQLServiceResult
-		(*MongoDBServiceResult)(nil),          // 18: management.v1.MongoDBServiceResult
-		(*PostgreSQLServiceResult)(nil),       // 19: management.v1.PostgreSQLServiceResult
-		(*ProxySQLServiceResult)(nil),         // 20: management.v1.ProxySQLServiceResult
-		(*HAProxyServiceResult)(nil),          // 21: management.v1.HAProxyServiceResult
-		(*ExternalServiceResult)(nil),         // 22: management.v1.ExternalServiceResult
-		(*RDSServiceResult)(nil),              // 23: management.v1.RDSServiceResult
-		(*ValkeyServiceResult)(nil),           // 24: management.v1.ValkeyServiceResult
-		v1.ServiceType(0),                     // 25: inventory.v1.ServiceType
-		(*timestamppb.Timestamp)(nil),         // 26: google.protobuf.Timestamp
-		(*UniversalAgent)(nil),                // 27: management.v1.UniversalAgent
-		(*AddAnnotationRequest)(nil),          // 28: management.v1.AddAnnotationRequest
-		(*ListAgentsRequest)(nil),             // 29: management.v1.ListAgentsRequest
-		(*ListAgentVersionsRequest)(nil),      // 30: management.v1.ListAgentVersionsRequest
-		(*RegisterNodeRequest)(nil),           // 31: management.v1.RegisterNodeRequest
-		(*UnregisterNodeRequest)(nil),         // 32: management.v1.UnregisterNodeRequest
-		(*ListNodesRequest)(nil),              // 33: management.v1.ListNodesRequest
-		(*GetNodeRequest)(nil),                // 34: management.v1.GetNodeRequest
-		(*DiscoverRDSRequest)(nil),            // 35: management.v1.DiscoverRDSRequest
-		(*DiscoverAzureDatabaseRequest)(nil),  // 36: management.v1.DiscoverAzureDatabaseRequest
-		(*AddAzureDatabaseRequest)(nil),       // 37: management.v1.AddAzureDatabaseRequest
-		(*AddAnnotationResponse)(nil),         // 38: management.v1.AddAnnotationResponse
-		(*ListAgentsResponse)(nil),            // 39: management.v1.ListAgentsResponse
-		(*ListAgentVersionsResponse)(nil),     // 40: management.v1.ListAgentVersionsResponse
-		(*RegisterNodeResponse)(nil),          // 41: management.v1.RegisterNodeResponse
-		(*UnregisterNodeResponse)(nil),        // 42: management.v1.UnregisterNodeResponse
-		(*ListNodesResponse)(nil),             // 43: management.v1.ListNodesResponse
-		(*GetNodeResponse)(nil),               // 44: management.v1.GetNodeResponse
-		(*DiscoverRDSResponse)(nil),           // 45: management.v1.DiscoverRDSResponse
-		(*DiscoverAzureDatabaseResponse)(nil), // 46: management.v1.DiscoverAzureDatabaseResponse
-		(*AddAzureDatabaseResponse)(nil),      // 47: management.v1.AddAzureDatabaseResponse
+		UniversalService_Status(0),             // 0: management.v1.UniversalService.Status
+		(*AddServiceRequest)(nil),              // 1: management.v1.AddServiceRequest
+		(*AddServiceResponse)(nil),             // 2: management.v1.AddServiceResponse
+		(*RemoveServiceRequest)(nil),           // 3: management.v1.RemoveServiceRequest
+		(*RemoveServiceResponse)(nil),          // 4: management.v1.RemoveServiceResponse
+		(*UniversalService)(nil),               // 5: management.v1.UniversalService
+		(*ListServicesRequest)(nil),            // 6: management.v1.ListServicesRequest
+		(*ListServicesResponse)(nil),           // 7: management.v1.ListServicesResponse
+		nil,                                    // 8: management.v1.UniversalService.CustomLabelsEntry
+		(*AddMySQLServiceParams)(nil),          // 9: management.v1.AddMySQLServiceParams
+		(*AddMongoDBServiceParams)(nil),        // 10: management.v1.AddMongoDBServiceParams
+		(*AddPostgreSQLServiceParams)(nil),     // 11: management.v1.AddPostgreSQLServiceParams
+		(*AddProxySQLServiceParams)(nil),       // 12: management.v1.AddProxySQLServiceParams
+		(*AddHAProxyServiceParams)(nil),        // 13: management.v1.AddHAProxyServiceParams
+		(*AddExternalServiceParams)(nil),       // 14: management.v1.AddExternalServiceParams
+		(*AddRDSServiceParams)(nil),            // 15: management.v1.AddRDSServiceParams
+		(*AddValkeyServiceParams)(nil),         // 16: management.v1.AddValkeyServiceParams
+		(*MySQLServiceResult)(nil),             // 17: management.v1.MySQLServiceResult
+		(*MongoDBServiceResult)(nil),           // 18: management.v1.MongoDBServiceResult
+		(*PostgreSQLServiceResult)(nil),        // 19: management.v1.PostgreSQLServiceResult
+		(*ProxySQLServiceResult)(nil),          // 20: management.v1.ProxySQLServiceResult
+		(*HAProxyServiceResult)(nil),           // 21: management.v1.HAProxyServiceResult
+		(*ExternalServiceResult)(nil),          // 22: management.v1.ExternalServiceResult
+		(*RDSServiceResult)(nil),               // 23: management.v1.RDSServiceResult
+		(*ValkeyServiceResult)(nil),            // 24: management.v1.ValkeyServiceResult
+		v1.ServiceType(0),                      // 25: inventory.v1.ServiceType
+		(*timestamppb.Timestamp)(nil),          // 26: google.protobuf.Timestamp
+		(*UniversalAgent)(nil),                 // 27: management.v1.UniversalAgent
+		(*AddAnnotationRequest)(nil),           // 28: management.v1.AddAnnotationRequest
+		(*ListAgentsRequest)(nil),              // 29: management.v1.ListAgentsRequest
+		(*ListAgentVersionsRequest)(nil),       // 30: management.v1.ListAgentVersionsRequest
+		(*RegisterNodeRequest)(nil),            // 31: management.v1.RegisterNodeRequest
+		(*UnregisterNodeRequest)(nil),          // 32: management.v1.UnregisterNodeRequest
+		(*RevokeNodeCertificatesRequest)(nil),  // 33: management.v1.RevokeNodeCertificatesRequest
+		(*ListNodesRequest)(nil),               // 34: management.v1.ListNodesRequest
+		(*GetNodeRequest)(nil),                 // 35: management.v1.GetNodeRequest
+		(*DiscoverRDSRequest)(nil),             // 36: management.v1.DiscoverRDSRequest
+		(*DiscoverAzureDatabaseRequest)(nil),   // 37: management.v1.DiscoverAzureDatabaseRequest
+		(*AddAzureDatabaseRequest)(nil),        // 38: management.v1.AddAzureDatabaseRequest
+		(*AddAnnotationResponse)(nil),          // 39: management.v1.AddAnnotationResponse
+		(*ListAgentsResponse)(nil),             // 40: management.v1.ListAgentsResponse
+		(*ListAgentVersionsResponse)(nil),      // 41: management.v1.ListAgentVersionsResponse
+		(*RegisterNodeResponse)(nil),           // 42: management.v1.RegisterNodeResponse
+		(*UnregisterNodeResponse)(nil),         // 43: management.v1.UnregisterNodeResponse
+		(*RevokeNodeCertificatesResponse)(nil), // 44: management.v1.RevokeNodeCertificatesResponse
+		(*ListNodesResponse)(nil),              // 45: management.v1.ListNodesResponse
+		(*GetNodeResponse)(nil),                // 46: management.v1.GetNodeResponse
+		(*DiscoverRDSResponse)(nil),            // 47: management.v1.DiscoverRDSResponse
+		(*DiscoverAzureDatabaseResponse)(nil),  // 48: management.v1.DiscoverAzureDatabaseResponse
+		(*AddAzureDatabaseResponse)(nil),       // 49: management.v1.AddAzureDatabaseResponse
 	}
 )
 
@@ -1036,29 +1039,31 @@ var file_management_v1_service_proto_depIdxs = []int32{
 	30, // 26: management.v1.ManagementService.ListAgentVersions:input_type -> management.v1.ListAgentVersionsRequest
 	31, // 27: management.v1.ManagementService.RegisterNode:input_type -> management.v1.RegisterNodeRequest
 	32, // 28: management.v1.ManagementService.UnregisterNode:input_type -> management.v1.UnregisterNodeRequest
-	33, // 29: management.v1.ManagementService.ListNodes:input_type -> management.v1.ListNodesRequest
-	34, // 30: management.v1.ManagementService.GetNode:input_type -> management.v1.GetNodeRequest
-	1,  // 31: management.v1.ManagementService.AddService:input_type -> management.v1.AddServiceRequest
-	6,  // 32: management.v1.ManagementService.ListServices:input_type -> management.v1.ListServicesRequest
-	35, // 33: management.v1.ManagementService.DiscoverRDS:input_type -> management.v1.DiscoverRDSRequest
-	36, // 34: management.v1.ManagementService.DiscoverAzureDatabase:input_type -> management.v1.DiscoverAzureDatabaseRequest
-	37, // 35: management.v1.ManagementService.AddAzureDatabase:input_type -> management.v1.AddAzureDatabaseRequest
-	3,  // 36: management.v1.ManagementService.RemoveService:input_type -> management.v1.RemoveServiceRequest
-	38, // 37: management.v1.ManagementService.AddAnnotation:output_type -> management.v1.AddAnnotationResponse
-	39, // 38: management.v1.ManagementService.ListAgents:output_type -> management.v1.ListAgentsResponse
-	40, // 39: management.v1.ManagementService.ListAgentVersions:output_type -> management.v1.ListAgentVersionsResponse
-	41, // 40: management.v1.ManagementService.RegisterNode:output_type -> management.v1.RegisterNodeResponse
-	42, // 41: management.v1.ManagementService.UnregisterNode:output_type -> management.v1.UnregisterNodeResponse
-	43, // 42: management.v1.ManagementService.ListNodes:output_type -> management.v1.ListNodesResponse
-	44, // 43: management.v1.ManagementService.GetNode:output_type -> management.v1.GetNodeResponse
-	2,  // 44: management.v1.ManagementService.AddService:output_type -> management.v1.AddServiceResponse
-	7,  // 45: management.v1.ManagementService.ListServices:output_type -> management.v1.ListServicesResponse
-	45, // 46: management.v1.ManagementService.DiscoverRDS:output_type -> management.v1.DiscoverRDSResponse
-	46, // 47: management.v1.ManagementService.DiscoverAzureDatabase:output_type -> management.v1.DiscoverAzureDatabaseResponse
-	47, // 48: management.v1.ManagementService.AddAzureDatabase:output_type -> management.v1.AddAzureDatabaseResponse
-	4,  // 49: management.v1.ManagementService.RemoveService:output_type -> management.v1.RemoveServiceResponse
-	37, // [37:50] is the sub-list for method output_type
-	24, // [24:37] is the sub-list for method input_type
+	33, // 29: management.v1.ManagementService.RevokeNodeCertificates:input_type -> management.v1.RevokeNodeCertificatesRequest
+	34, // 30: management.v1.ManagementService.ListNodes:input_type -> management.v1.ListNodesRequest
+	35, // 31: management.v1.ManagementService.GetNode:input_type -> management.v1.GetNodeRequest
+	1,  // 32: management.v1.ManagementService.AddService:input_type -> management.v1.AddServiceRequest
+	6,  // 33: management.v1.ManagementService.ListServices:input_type -> management.v1.ListServicesRequest
+	36, // 34: management.v1.ManagementService.DiscoverRDS:input_type -> management.v1.DiscoverRDSRequest
+	37, // 35: management.v1.ManagementService.DiscoverAzureDatabase:input_type -> management.v1.DiscoverAzureDatabaseRequest
+	38, // 36: management.v1.ManagementService.AddAzureDatabase:input_type -> management.v1.AddAzureDatabaseRequest
+	3,  // 37: management.v1.ManagementService.RemoveService:input_type -> management.v1.RemoveServiceRequest
+	39, // 38: management.v1.ManagementService.AddAnnotation:output_type -> management.v1.AddAnnotationResponse
+	40, // 39: management.v1.ManagementService.ListAgents:output_type -> management.v1.ListAgentsResponse
+	41, // 40: management.v1.ManagementService.ListAgentVersions:output_type -> management.v1.ListAgentVersionsResponse
+	42, // 41: management.v1.ManagementService.RegisterNode:output_type -> management.v1.RegisterNodeResponse
+	43, // 42: management.v1.ManagementService.UnregisterNode:output_type -> management.v1.UnregisterNodeResponse
+	44, // 43: management.v1.ManagementService.RevokeNodeCertificates:output_type -> management.v1.RevokeNodeCertificatesResponse
+	45, // 44: management.v1.ManagementService.ListNodes:output_type -> management.v1.ListNodesResponse
+	46, // 45: management.v1.ManagementService.GetNode:output_type -> management.v1.GetNodeResponse
+	2,  // 46: management.v1.ManagementService.AddService:output_type -> management.v1.AddServiceResponse
+	7,  // 47: management.v1.ManagementService.ListServices:output_type -> management.v1.ListServicesResponse
+	47, // 48: management.v1.ManagementService.DiscoverRDS:output_type -> management.v1.DiscoverRDSResponse
+	48, // 49: management.v1.ManagementService.DiscoverAzureDatabase:output_type -> management.v1.DiscoverAzureDatabaseResponse
+	49, // 50: management.v1.ManagementService.AddAzureDatabase:output_type -> management.v1.AddAzureDatabaseResponse
+	4,  // 51: management.v1.ManagementService.RemoveService:output_type -> management.v1.RemoveServiceResponse
+	38, // [38:52] is the sub-list for method output_type
+	24, // [24:38] is the sub-list for method input_type
 	24, // [24:24] is the sub-list for extension type_name
 	24, // [24:24] is the sub-list for extension extendee
 	0,  // [0:24] is the sub-list for field type_name
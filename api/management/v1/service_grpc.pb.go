@@ -20,19 +20,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ManagementService_AddAnnotation_FullMethodName         = "/management.v1.ManagementService/AddAnnotation"
-	ManagementService_ListAgents_FullMethodName            = "/management.v1.ManagementService/ListAgents"
-	ManagementService_ListAgentVersions_FullMethodName     = "/management.v1.ManagementService/ListAgentVersions"
-	ManagementService_RegisterNode_FullMethodName          = "/management.v1.ManagementService/RegisterNode"
-	ManagementService_UnregisterNode_FullMethodName        = "/management.v1.ManagementService/UnregisterNode"
-	ManagementService_ListNodes_FullMethodName             = "/management.v1.ManagementService/ListNodes"
-	ManagementService_GetNode_FullMethodName               = "/management.v1.ManagementService/GetNode"
-	ManagementService_AddService_FullMethodName            = "/management.v1.ManagementService/AddService"
-	ManagementService_ListServices_FullMethodName          = "/management.v1.ManagementService/ListServices"
-	ManagementService_DiscoverRDS_FullMethodName           = "/management.v1.ManagementService/DiscoverRDS"
-	ManagementService_DiscoverAzureDatabase_FullMethodName = "/management.v1.ManagementService/DiscoverAzureDatabase"
-	ManagementService_AddAzureDatabase_FullMethodName      = "/management.v1.ManagementService/AddAzureDatabase"
-	ManagementService_RemoveService_FullMethodName         = "/management.v1.ManagementService/RemoveService"
+	ManagementService_AddAnnotation_FullMethodName          = "/management.v1.ManagementService/AddAnnotation"
+	ManagementService_ListAgents_FullMethodName             = "/management.v1.ManagementService/ListAgents"
+	ManagementService_ListAgentVersions_FullMethodName      = "/management.v1.ManagementService/ListAgentVersions"
+	ManagementService_RegisterNode_FullMethodName           = "/management.v1.ManagementService/RegisterNode"
+	ManagementService_UnregisterNode_FullMethodName         = "/management.v1.ManagementService/UnregisterNode"
+	ManagementService_RevokeNodeCertificates_FullMethodName = "/management.v1.ManagementService/RevokeNodeCertificates"
+	ManagementService_ListNodes_FullMethodName              = "/management.v1.ManagementService/ListNodes"
+	ManagementService_GetNode_FullMethodName                = "/management.v1.ManagementService/GetNode"
+	ManagementService_AddService_FullMethodName             = "/management.v1.ManagementService/AddService"
+	ManagementService_ListServices_FullMethodName           = "/management.v1.ManagementService/ListServices"
+	ManagementService_DiscoverRDS_FullMethodName            = "/management.v1.ManagementService/DiscoverRDS"
+	ManagementService_DiscoverAzureDatabase_FullMethodName  = "/management.v1.ManagementService/DiscoverAzureDatabase"
+	ManagementService_AddAzureDatabase_FullMethodName       = "/management.v1.ManagementService/AddAzureDatabase"
+	ManagementService_RemoveService_FullMethodName          = "/management.v1.ManagementService/RemoveService"
 )
 
 // ManagementServiceClient is the client API for ManagementService service.
@@ -51,6 +52,8 @@ type ManagementServiceClient interface {
 	RegisterNode(ctx context.Context, in *RegisterNodeRequest, opts ...grpc.CallOption) (*RegisterNodeResponse, error)
 	// UnregisterNode unregisters a Node, pmm-agent and removes the service account and its token.
 	UnregisterNode(ctx context.Context, in *UnregisterNodeRequest, opts ...grpc.CallOption) (*UnregisterNodeResponse, error)
+	// RevokeNodeCertificates revokes client certificates of pmm-agents running on a Node and disconnects them.
+	RevokeNodeCertificates(ctx context.Context, in *RevokeNodeCertificatesRequest, opts ...grpc.CallOption) (*RevokeNodeCertificatesResponse, error)
 	// ListNode returns a list of nodes.
 	ListNodes(ctx context.Context, in *ListNodesRequest, opts ...grpc.CallOption) (*ListNodesResponse, error)
 	// GetNode returns a single Node by ID.
@@ -127,6 +130,16 @@ func (c *managementServiceClient) UnregisterNode(ctx context.Context, in *Unregi
 	return out, nil
 }
 
+func (c *managementServiceClient) RevokeNodeCertificates(ctx context.Context, in *RevokeNodeCertificatesRequest, opts ...grpc.CallOption) (*RevokeNodeCertificatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeNodeCertificatesResponse)
+	err := c.cc.Invoke(ctx, ManagementService_RevokeNodeCertificates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *managementServiceClient) ListNodes(ctx context.Context, in *ListNodesRequest, opts ...grpc.CallOption) (*ListNodesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListNodesResponse)
@@ -223,6 +236,8 @@ type ManagementServiceServer interface {
 	RegisterNode(context.Context, *RegisterNodeRequest) (*RegisterNodeResponse, error)
 	// UnregisterNode unregisters a Node, pmm-agent and removes the service account and its token.
 	UnregisterNode(context.Context, *UnregisterNodeRequest) (*UnregisterNodeResponse, error)
+	// RevokeNodeCertificates revokes client certificates of pmm-agents running on a Node and disconnects them.
+	RevokeNodeCertificates(context.Context, *RevokeNodeCertificatesRequest) (*RevokeNodeCertificatesResponse, error)
 	// ListNode returns a list of nodes.
 	ListNodes(context.Context, *ListNodesRequest) (*ListNodesResponse, error)
 	// GetNode returns a single Node by ID.
@@ -269,6 +284,10 @@ func (UnimplementedManagementServiceServer) UnregisterNode(context.Context, *Unr
 	return nil, status.Error(codes.Unimplemented, "method UnregisterNode not implemented")
 }
 
+func (UnimplementedManagementServiceServer) RevokeNodeCertificates(context.Context, *RevokeNodeCertificatesRequest) (*RevokeNodeCertificatesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeNodeCertificates not implemented")
+}
+
 func (UnimplementedManagementServiceServer) ListNodes(context.Context, *ListNodesRequest) (*ListNodesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ListNodes not implemented")
 }
@@ -411,6 +430,24 @@ func _ManagementService_UnregisterNode_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ManagementService_RevokeNodeCertificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeNodeCertificatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ManagementServiceServer).RevokeNodeCertificates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ManagementService_RevokeNodeCertificates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ManagementServiceServer).RevokeNodeCertificates(ctx, req.(*RevokeNodeCertificatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ManagementService_ListNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListNodesRequest)
 	if err := dec(in); err != nil {
@@ -582,6 +619,10 @@ var ManagementService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnregisterNode",
 			Handler:    _ManagementService_UnregisterNode_Handler,
 		},
+		{
+			MethodName: "RevokeNodeCertificates",
+			Handler:    _ManagementService_RevokeNodeCertificates_Handler,
+		},
 		{
 			MethodName: "ListNodes",
 			Handler:    _ManagementService_ListNodes_Handler,
@@ -87,8 +87,14 @@ type AddValkeyServiceParams struct {
 	AgentPassword string `protobuf:"bytes,24,opt,name=agent_password,json=agentPassword,proto3" json:"agent_password,omitempty"`
 	// Connection timeout for exporter (if set).
 	ConnectionTimeout *durationpb.Duration `protobuf:"bytes,25,opt,name=connection_timeout,json=connectionTimeout,proto3" json:"connection_timeout,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// If true, adds qan-valkey-slowlog-agent for provided service.
+	QanValkeySlowlog bool `protobuf:"varint,26,opt,name=qan_valkey_slowlog,json=qanValkeySlowlog,proto3" json:"qan_valkey_slowlog,omitempty"`
+	// Limit query length in QAN (default: server-defined; -1: no limit).
+	MaxQueryLength int32 `protobuf:"varint,27,opt,name=max_query_length,json=maxQueryLength,proto3" json:"max_query_length,omitempty"`
+	// Disable query examples.
+	DisableQueryExamples bool `protobuf:"varint,28,opt,name=disable_query_examples,json=disableQueryExamples,proto3" json:"disable_query_examples,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *AddValkeyServiceParams) Reset() {
@@ -296,12 +302,34 @@ func (x *AddValkeyServiceParams) GetConnectionTimeout() *durationpb.Duration {
 	return nil
 }
 
+func (x *AddValkeyServiceParams) GetQanValkeySlowlog() bool {
+	if x != nil {
+		return x.QanValkeySlowlog
+	}
+	return false
+}
+
+func (x *AddValkeyServiceParams) GetMaxQueryLength() int32 {
+	if x != nil {
+		return x.MaxQueryLength
+	}
+	return 0
+}
+
+func (x *AddValkeyServiceParams) GetDisableQueryExamples() bool {
+	if x != nil {
+		return x.DisableQueryExamples
+	}
+	return false
+}
+
 type ValkeyServiceResult struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Service        *v1.ValkeyService      `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
-	ValkeyExporter *v1.ValkeyExporter     `protobuf:"bytes,2,opt,name=valkey_exporter,json=valkeyExporter,proto3" json:"valkey_exporter,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	state            protoimpl.MessageState    `protogen:"open.v1"`
+	Service          *v1.ValkeyService         `protobuf:"bytes,1,opt,name=service,proto3" json:"service,omitempty"`
+	ValkeyExporter   *v1.ValkeyExporter        `protobuf:"bytes,2,opt,name=valkey_exporter,json=valkeyExporter,proto3" json:"valkey_exporter,omitempty"`
+	QanValkeySlowlog *v1.QANValkeySlowlogAgent `protobuf:"bytes,3,opt,name=qan_valkey_slowlog,json=qanValkeySlowlog,proto3" json:"qan_valkey_slowlog,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ValkeyServiceResult) Reset() {
@@ -348,11 +376,18 @@ func (x *ValkeyServiceResult) GetValkeyExporter() *v1.ValkeyExporter {
 	return nil
 }
 
+func (x *ValkeyServiceResult) GetQanValkeySlowlog() *v1.QANValkeySlowlogAgent {
+	if x != nil {
+		return x.QanValkeySlowlog
+	}
+	return nil
+}
+
 var File_management_v1_valkey_proto protoreflect.FileDescriptor
 
 const file_management_v1_valkey_proto_rawDesc = "" +
 	"\n" +
-	"\x1amanagement/v1/valkey.proto\x12\rmanagement.v1\x1a\x1aextensions/v1/redact.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x19inventory/v1/agents.proto\x1a\x1cinventory/v1/log_level.proto\x1a\x1binventory/v1/services.proto\x1a\x1bmanagement/v1/metrics.proto\x1a\x18management/v1/node.proto\x1a\x17validate/validate.proto\"\xe7\b\n" +
+	"\x1amanagement/v1/valkey.proto\x12\rmanagement.v1\x1a\x1aextensions/v1/redact.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x19inventory/v1/agents.proto\x1a\x1cinventory/v1/log_level.proto\x1a\x1binventory/v1/services.proto\x1a\x1bmanagement/v1/metrics.proto\x1a\x18management/v1/node.proto\x1a\x17validate/validate.proto\"\xf5\t\n" +
 	"\x16AddValkeyServiceParams\x12#\n" +
 	"\anode_id\x18\x01 \x01(\tB\n" +
 	"\xfaB\ar\x05\x10\x01\xd0\x01\x01R\x06nodeId\x12'\n" +
@@ -382,13 +417,17 @@ const file_management_v1_valkey_proto_rawDesc = "" +
 	"\btls_cert\x18\x16 \x01(\tB\x04\x88\xb5\x18\x01R\atlsCert\x12\x1d\n" +
 	"\atls_key\x18\x17 \x01(\tB\x04\x88\xb5\x18\x01R\x06tlsKey\x12+\n" +
 	"\x0eagent_password\x18\x18 \x01(\tB\x04\x88\xb5\x18\x01R\ragentPassword\x12R\n" +
-	"\x12connection_timeout\x18\x19 \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x022\x00R\x11connectionTimeout\x1a?\n" +
+	"\x12connection_timeout\x18\x19 \x01(\v2\x19.google.protobuf.DurationB\b\xfaB\x05\xaa\x01\x022\x00R\x11connectionTimeout\x12,\n" +
+	"\x12qan_valkey_slowlog\x18\x1a \x01(\bR\x10qanValkeySlowlog\x12(\n" +
+	"\x10max_query_length\x18\x1b \x01(\x05R\x0emaxQueryLength\x124\n" +
+	"\x16disable_query_examples\x18\x1c \x01(\bR\x14disableQueryExamples\x1a?\n" +
 	"\x11CustomLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x93\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xe6\x01\n" +
 	"\x13ValkeyServiceResult\x125\n" +
 	"\aservice\x18\x01 \x01(\v2\x1b.inventory.v1.ValkeyServiceR\aservice\x12E\n" +
-	"\x0fvalkey_exporter\x18\x02 \x01(\v2\x1c.inventory.v1.ValkeyExporterR\x0evalkeyExporterB\xac\x01\n" +
+	"\x0fvalkey_exporter\x18\x02 \x01(\v2\x1c.inventory.v1.ValkeyExporterR\x0evalkeyExporter\x12Q\n" +
+	"\x12qan_valkey_slowlog\x18\x03 \x01(\v2#.inventory.v1.QANValkeySlowlogAgentR\x10qanValkeySlowlogB\xac\x01\n" +
 	"\x11com.management.v1B\vValkeyProtoP\x01Z5github.com/percona/pmm/api/management/v1;managementv1\xa2\x02\x03MXX\xaa\x02\rManagement.V1\xca\x02\rManagement\\V1\xe2\x02\x19Management\\V1\\GPBMetadata\xea\x02\x0eManagement::V1b\x06proto3"
 
 var (
@@ -406,15 +445,16 @@ func file_management_v1_valkey_proto_rawDescGZIP() []byte {
 var (
 	file_management_v1_valkey_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 	file_management_v1_valkey_proto_goTypes  = []any{
-		(*AddValkeyServiceParams)(nil), // 0: management.v1.AddValkeyServiceParams
-		(*ValkeyServiceResult)(nil),    // 1: management.v1.ValkeyServiceResult
-		nil,                            // 2: management.v1.AddValkeyServiceParams.CustomLabelsEntry
-		(*AddNodeParams)(nil),          // 3: management.v1.AddNodeParams
-		MetricsMode(0),                 // 4: management.v1.MetricsMode
-		v1.LogLevel(0),                 // 5: inventory.v1.LogLevel
-		(*durationpb.Duration)(nil),    // 6: google.protobuf.Duration
-		(*v1.ValkeyService)(nil),       // 7: inventory.v1.ValkeyService
-		(*v1.ValkeyExporter)(nil),      // 8: inventory.v1.ValkeyExporter
+		(*AddValkeyServiceParams)(nil),   // 0: management.v1.AddValkeyServiceParams
+		(*ValkeyServiceResult)(nil),      // 1: management.v1.ValkeyServiceResult
+		nil,                              // 2: management.v1.AddValkeyServiceParams.CustomLabelsEntry
+		(*AddNodeParams)(nil),            // 3: management.v1.AddNodeParams
+		MetricsMode(0),                   // 4: management.v1.MetricsMode
+		v1.LogLevel(0),                   // 5: inventory.v1.LogLevel
+		(*durationpb.Duration)(nil),      // 6: google.protobuf.Duration
+		(*v1.ValkeyService)(nil),         // 7: inventory.v1.ValkeyService
+		(*v1.ValkeyExporter)(nil),        // 8: inventory.v1.ValkeyExporter
+		(*v1.QANValkeySlowlogAgent)(nil), // 9: inventory.v1.QANValkeySlowlogAgent
 	}
 )
 
@@ -426,11 +466,12 @@ var file_management_v1_valkey_proto_depIdxs = []int32{
 	6, // 4: management.v1.AddValkeyServiceParams.connection_timeout:type_name -> google.protobuf.Duration
 	7, // 5: management.v1.ValkeyServiceResult.service:type_name -> inventory.v1.ValkeyService
 	8, // 6: management.v1.ValkeyServiceResult.valkey_exporter:type_name -> inventory.v1.ValkeyExporter
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	9, // 7: management.v1.ValkeyServiceResult.qan_valkey_slowlog:type_name -> inventory.v1.QANValkeySlowlogAgent
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_management_v1_valkey_proto_init() }
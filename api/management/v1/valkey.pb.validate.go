@@ -206,6 +206,12 @@ func (m *AddValkeyServiceParams) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for QanValkeySlowlog
+
+	// no validation rules for MaxQueryLength
+
+	// no validation rules for DisableQueryExamples
+
 	if len(errors) > 0 {
 		return AddValkeyServiceParamsMultiError(errors)
 	}
@@ -367,6 +373,35 @@ func (m *ValkeyServiceResult) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetQanValkeySlowlog()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ValkeyServiceResultValidationError{
+					field:  "QanValkeySlowlog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ValkeyServiceResultValidationError{
+					field:  "QanValkeySlowlog",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQanValkeySlowlog()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ValkeyServiceResultValidationError{
+				field:  "QanValkeySlowlog",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return ValkeyServiceResultMultiError(errors)
 	}
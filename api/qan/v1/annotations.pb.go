@@ -0,0 +1,492 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: qan/v1/annotations.proto
+
+package qanv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryAnnotation is metadata attached to a query.
+type QueryAnnotation struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Queryid string                 `protobuf:"bytes,1,opt,name=queryid,proto3" json:"queryid,omitempty"`
+	// Team or person owning the query.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Link to the ticket tracking the query.
+	TicketUrl string `protobuf:"bytes,3,opt,name=ticket_url,json=ticketUrl,proto3" json:"ticket_url,omitempty"`
+	// True if the query is known and its performance is acknowledged.
+	Acknowledged bool `protobuf:"varint,4,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	// Free-form notes.
+	Notes string `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	// Time of the last change. Ignored in SetQueryAnnotationRequest.
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryAnnotation) Reset() {
+	*x = QueryAnnotation{}
+	mi := &file_qan_v1_annotations_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryAnnotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAnnotation) ProtoMessage() {}
+
+func (x *QueryAnnotation) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_annotations_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAnnotation.ProtoReflect.Descriptor instead.
+func (*QueryAnnotation) Descriptor() ([]byte, []int) {
+	return file_qan_v1_annotations_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueryAnnotation) GetQueryid() string {
+	if x != nil {
+		return x.Queryid
+	}
+	return ""
+}
+
+func (x *QueryAnnotation) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *QueryAnnotation) GetTicketUrl() string {
+	if x != nil {
+		return x.TicketUrl
+	}
+	return ""
+}
+
+func (x *QueryAnnotation) GetAcknowledged() bool {
+	if x != nil {
+		return x.Acknowledged
+	}
+	return false
+}
+
+func (x *QueryAnnotation) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *QueryAnnotation) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+// SetQueryAnnotationRequest creates or replaces the query annotation.
+type SetQueryAnnotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Annotation    *QueryAnnotation       `protobuf:"bytes,1,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQueryAnnotationRequest) Reset() {
+	*x = SetQueryAnnotationRequest{}
+	mi := &file_qan_v1_annotations_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQueryAnnotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQueryAnnotationRequest) ProtoMessage() {}
+
+func (x *SetQueryAnnotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_annotations_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQueryAnnotationRequest.ProtoReflect.Descriptor instead.
+func (*SetQueryAnnotationRequest) Descriptor() ([]byte, []int) {
+	return file_qan_v1_annotations_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetQueryAnnotationRequest) GetAnnotation() *QueryAnnotation {
+	if x != nil {
+		return x.Annotation
+	}
+	return nil
+}
+
+// SetQueryAnnotationResponse contains the stored annotation.
+type SetQueryAnnotationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Annotation    *QueryAnnotation       `protobuf:"bytes,1,opt,name=annotation,proto3" json:"annotation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetQueryAnnotationResponse) Reset() {
+	*x = SetQueryAnnotationResponse{}
+	mi := &file_qan_v1_annotations_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetQueryAnnotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetQueryAnnotationResponse) ProtoMessage() {}
+
+func (x *SetQueryAnnotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_annotations_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetQueryAnnotationResponse.ProtoReflect.Descriptor instead.
+func (*SetQueryAnnotationResponse) Descriptor() ([]byte, []int) {
+	return file_qan_v1_annotations_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SetQueryAnnotationResponse) GetAnnotation() *QueryAnnotation {
+	if x != nil {
+		return x.Annotation
+	}
+	return nil
+}
+
+// RemoveQueryAnnotationRequest removes the annotation of the query.
+type RemoveQueryAnnotationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Queryid       string                 `protobuf:"bytes,1,opt,name=queryid,proto3" json:"queryid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveQueryAnnotationRequest) Reset() {
+	*x = RemoveQueryAnnotationRequest{}
+	mi := &file_qan_v1_annotations_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveQueryAnnotationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveQueryAnnotationRequest) ProtoMessage() {}
+
+func (x *RemoveQueryAnnotationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_annotations_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveQueryAnnotationRequest.ProtoReflect.Descriptor instead.
+func (*RemoveQueryAnnotationRequest) Descriptor() ([]byte, []int) {
+	return file_qan_v1_annotations_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RemoveQueryAnnotationRequest) GetQueryid() string {
+	if x != nil {
+		return x.Queryid
+	}
+	return ""
+}
+
+type RemoveQueryAnnotationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveQueryAnnotationResponse) Reset() {
+	*x = RemoveQueryAnnotationResponse{}
+	mi := &file_qan_v1_annotations_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveQueryAnnotationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveQueryAnnotationResponse) ProtoMessage() {}
+
+func (x *RemoveQueryAnnotationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_annotations_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveQueryAnnotationResponse.ProtoReflect.Descriptor instead.
+func (*RemoveQueryAnnotationResponse) Descriptor() ([]byte, []int) {
+	return file_qan_v1_annotations_proto_rawDescGZIP(), []int{4}
+}
+
+// ListQueryAnnotationsRequest defines filtering of query annotations.
+type ListQueryAnnotationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Return only annotations of the given queries.
+	Queryids []string `protobuf:"bytes,1,rep,name=queryids,proto3" json:"queryids,omitempty"`
+	// Return only annotations with the given owner.
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Return only acknowledged or not acknowledged annotations. All annotations are returned if not set.
+	Acknowledged  *bool `protobuf:"varint,3,opt,name=acknowledged,proto3,oneof" json:"acknowledged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueryAnnotationsRequest) Reset() {
+	*x = ListQueryAnnotationsRequest{}
+	mi := &file_qan_v1_annotations_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueryAnnotationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueryAnnotationsRequest) ProtoMessage() {}
+
+func (x *ListQueryAnnotationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_annotations_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueryAnnotationsRequest.ProtoReflect.Descriptor instead.
+func (*ListQueryAnnotationsRequest) Descriptor() ([]byte, []int) {
+	return file_qan_v1_annotations_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListQueryAnnotationsRequest) GetQueryids() []string {
+	if x != nil {
+		return x.Queryids
+	}
+	return nil
+}
+
+func (x *ListQueryAnnotationsRequest) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ListQueryAnnotationsRequest) GetAcknowledged() bool {
+	if x != nil && x.Acknowledged != nil {
+		return *x.Acknowledged
+	}
+	return false
+}
+
+// ListQueryAnnotationsResponse is a list of query annotations.
+type ListQueryAnnotationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Annotations   []*QueryAnnotation     `protobuf:"bytes,1,rep,name=annotations,proto3" json:"annotations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueryAnnotationsResponse) Reset() {
+	*x = ListQueryAnnotationsResponse{}
+	mi := &file_qan_v1_annotations_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueryAnnotationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueryAnnotationsResponse) ProtoMessage() {}
+
+func (x *ListQueryAnnotationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_annotations_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueryAnnotationsResponse.ProtoReflect.Descriptor instead.
+func (*ListQueryAnnotationsResponse) Descriptor() ([]byte, []int) {
+	return file_qan_v1_annotations_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ListQueryAnnotationsResponse) GetAnnotations() []*QueryAnnotation {
+	if x != nil {
+		return x.Annotations
+	}
+	return nil
+}
+
+var File_qan_v1_annotations_proto protoreflect.FileDescriptor
+
+const file_qan_v1_annotations_proto_rawDesc = "" +
+	"\n" +
+	"\x18qan/v1/annotations.proto\x12\x06qan.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd5\x01\n" +
+	"\x0fQueryAnnotation\x12\x18\n" +
+	"\aqueryid\x18\x01 \x01(\tR\aqueryid\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x1d\n" +
+	"\n" +
+	"ticket_url\x18\x03 \x01(\tR\tticketUrl\x12\"\n" +
+	"\facknowledged\x18\x04 \x01(\bR\facknowledged\x12\x14\n" +
+	"\x05notes\x18\x05 \x01(\tR\x05notes\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"T\n" +
+	"\x19SetQueryAnnotationRequest\x127\n" +
+	"\n" +
+	"annotation\x18\x01 \x01(\v2\x17.qan.v1.QueryAnnotationR\n" +
+	"annotation\"U\n" +
+	"\x1aSetQueryAnnotationResponse\x127\n" +
+	"\n" +
+	"annotation\x18\x01 \x01(\v2\x17.qan.v1.QueryAnnotationR\n" +
+	"annotation\"8\n" +
+	"\x1cRemoveQueryAnnotationRequest\x12\x18\n" +
+	"\aqueryid\x18\x01 \x01(\tR\aqueryid\"\x1f\n" +
+	"\x1dRemoveQueryAnnotationResponse\"\x89\x01\n" +
+	"\x1bListQueryAnnotationsRequest\x12\x1a\n" +
+	"\bqueryids\x18\x01 \x03(\tR\bqueryids\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\tR\x05owner\x12'\n" +
+	"\facknowledged\x18\x03 \x01(\bH\x00R\facknowledged\x88\x01\x01B\x0f\n" +
+	"\r_acknowledged\"Y\n" +
+	"\x1cListQueryAnnotationsResponse\x129\n" +
+	"\vannotations\x18\x01 \x03(\v2\x17.qan.v1.QueryAnnotationR\vannotationsB\x80\x01\n" +
+	"\n" +
+	"com.qan.v1B\x10AnnotationsProtoP\x01Z'github.com/percona/pmm/api/qan/v1;qanv1\xa2\x02\x03QXX\xaa\x02\x06Qan.V1\xca\x02\x06Qan\\V1\xe2\x02\x12Qan\\V1\\GPBMetadata\xea\x02\aQan::V1b\x06proto3"
+
+var (
+	file_qan_v1_annotations_proto_rawDescOnce sync.Once
+	file_qan_v1_annotations_proto_rawDescData []byte
+)
+
+func file_qan_v1_annotations_proto_rawDescGZIP() []byte {
+	file_qan_v1_annotations_proto_rawDescOnce.Do(func() {
+		file_qan_v1_annotations_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_qan_v1_annotations_proto_rawDesc), len(file_qan_v1_annotations_proto_rawDesc)))
+	})
+	return file_qan_v1_annotations_proto_rawDescData
+}
+
+var (
+	file_qan_v1_annotations_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+	file_qan_v1_annotations_proto_goTypes  = []any{
+		(*QueryAnnotation)(nil),               // 0: qan.v1.QueryAnnotation
+		(*SetQueryAnnotationRequest)(nil),     // 1: qan.v1.SetQueryAnnotationRequest
+		(*SetQueryAnnotationResponse)(nil),    // 2: qan.v1.SetQueryAnnotationResponse
+		(*RemoveQueryAnnotationRequest)(nil),  // 3: qan.v1.RemoveQueryAnnotationRequest
+		(*RemoveQueryAnnotationResponse)(nil), // 4: qan.v1.RemoveQueryAnnotationResponse
+		(*ListQueryAnnotationsRequest)(nil),   // 5: qan.v1.ListQueryAnnotationsRequest
+		(*ListQueryAnnotationsResponse)(nil),  // 6: qan.v1.ListQueryAnnotationsResponse
+		(*timestamppb.Timestamp)(nil),         // 7: google.protobuf.Timestamp
+	}
+)
+
+var file_qan_v1_annotations_proto_depIdxs = []int32{
+	7, // 0: qan.v1.QueryAnnotation.updated_at:type_name -> google.protobuf.Timestamp
+	0, // 1: qan.v1.SetQueryAnnotationRequest.annotation:type_name -> qan.v1.QueryAnnotation
+	0, // 2: qan.v1.SetQueryAnnotationResponse.annotation:type_name -> qan.v1.QueryAnnotation
+	0, // 3: qan.v1.ListQueryAnnotationsResponse.annotations:type_name -> qan.v1.QueryAnnotation
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_qan_v1_annotations_proto_init() }
+func file_qan_v1_annotations_proto_init() {
+	if File_qan_v1_annotations_proto != nil {
+		return
+	}
+	file_qan_v1_annotations_proto_msgTypes[5].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_qan_v1_annotations_proto_rawDesc), len(file_qan_v1_annotations_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   7,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_qan_v1_annotations_proto_goTypes,
+		DependencyIndexes: file_qan_v1_annotations_proto_depIdxs,
+		MessageInfos:      file_qan_v1_annotations_proto_msgTypes,
+	}.Build()
+	File_qan_v1_annotations_proto = out.File
+	file_qan_v1_annotations_proto_goTypes = nil
+	file_qan_v1_annotations_proto_depIdxs = nil
+}
@@ -0,0 +1,898 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: qan/v1/annotations.proto
+
+package qanv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on QueryAnnotation with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *QueryAnnotation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryAnnotation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryAnnotationMultiError, or nil if none found.
+func (m *QueryAnnotation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryAnnotation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Queryid
+
+	// no validation rules for Owner
+
+	// no validation rules for TicketUrl
+
+	// no validation rules for Acknowledged
+
+	// no validation rules for Notes
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryAnnotationValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryAnnotationValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryAnnotationValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return QueryAnnotationMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryAnnotationMultiError is an error wrapping multiple validation errors
+// returned by QueryAnnotation.ValidateAll() if the designated constraints
+// aren't met.
+type QueryAnnotationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryAnnotationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryAnnotationMultiError) AllErrors() []error { return m }
+
+// QueryAnnotationValidationError is the validation error returned by
+// QueryAnnotation.Validate if the designated constraints aren't met.
+type QueryAnnotationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryAnnotationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryAnnotationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryAnnotationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryAnnotationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryAnnotationValidationError) ErrorName() string { return "QueryAnnotationValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueryAnnotationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryAnnotation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = QueryAnnotationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryAnnotationValidationError{}
+
+// Validate checks the field values on SetQueryAnnotationRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetQueryAnnotationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetQueryAnnotationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetQueryAnnotationRequestMultiError, or nil if none found.
+func (m *SetQueryAnnotationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetQueryAnnotationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAnnotation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetQueryAnnotationRequestValidationError{
+					field:  "Annotation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetQueryAnnotationRequestValidationError{
+					field:  "Annotation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnotation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetQueryAnnotationRequestValidationError{
+				field:  "Annotation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetQueryAnnotationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetQueryAnnotationRequestMultiError is an error wrapping multiple validation
+// errors returned by SetQueryAnnotationRequest.ValidateAll() if the
+// designated constraints aren't met.
+type SetQueryAnnotationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetQueryAnnotationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetQueryAnnotationRequestMultiError) AllErrors() []error { return m }
+
+// SetQueryAnnotationRequestValidationError is the validation error returned by
+// SetQueryAnnotationRequest.Validate if the designated constraints aren't met.
+type SetQueryAnnotationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetQueryAnnotationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetQueryAnnotationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetQueryAnnotationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetQueryAnnotationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetQueryAnnotationRequestValidationError) ErrorName() string {
+	return "SetQueryAnnotationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetQueryAnnotationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetQueryAnnotationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = SetQueryAnnotationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetQueryAnnotationRequestValidationError{}
+
+// Validate checks the field values on SetQueryAnnotationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetQueryAnnotationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetQueryAnnotationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetQueryAnnotationResponseMultiError, or nil if none found.
+func (m *SetQueryAnnotationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetQueryAnnotationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetAnnotation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SetQueryAnnotationResponseValidationError{
+					field:  "Annotation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SetQueryAnnotationResponseValidationError{
+					field:  "Annotation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnotation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SetQueryAnnotationResponseValidationError{
+				field:  "Annotation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return SetQueryAnnotationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetQueryAnnotationResponseMultiError is an error wrapping multiple
+// validation errors returned by SetQueryAnnotationResponse.ValidateAll() if
+// the designated constraints aren't met.
+type SetQueryAnnotationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetQueryAnnotationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetQueryAnnotationResponseMultiError) AllErrors() []error { return m }
+
+// SetQueryAnnotationResponseValidationError is the validation error returned
+// by SetQueryAnnotationResponse.Validate if the designated constraints aren't met.
+type SetQueryAnnotationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetQueryAnnotationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetQueryAnnotationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetQueryAnnotationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetQueryAnnotationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetQueryAnnotationResponseValidationError) ErrorName() string {
+	return "SetQueryAnnotationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetQueryAnnotationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetQueryAnnotationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = SetQueryAnnotationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetQueryAnnotationResponseValidationError{}
+
+// Validate checks the field values on RemoveQueryAnnotationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveQueryAnnotationRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveQueryAnnotationRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemoveQueryAnnotationRequestMultiError, or nil if none found.
+func (m *RemoveQueryAnnotationRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveQueryAnnotationRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Queryid
+
+	if len(errors) > 0 {
+		return RemoveQueryAnnotationRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveQueryAnnotationRequestMultiError is an error wrapping multiple
+// validation errors returned by RemoveQueryAnnotationRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RemoveQueryAnnotationRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveQueryAnnotationRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveQueryAnnotationRequestMultiError) AllErrors() []error { return m }
+
+// RemoveQueryAnnotationRequestValidationError is the validation error returned
+// by RemoveQueryAnnotationRequest.Validate if the designated constraints
+// aren't met.
+type RemoveQueryAnnotationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveQueryAnnotationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveQueryAnnotationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveQueryAnnotationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveQueryAnnotationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveQueryAnnotationRequestValidationError) ErrorName() string {
+	return "RemoveQueryAnnotationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveQueryAnnotationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveQueryAnnotationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = RemoveQueryAnnotationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveQueryAnnotationRequestValidationError{}
+
+// Validate checks the field values on RemoveQueryAnnotationResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveQueryAnnotationResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemoveQueryAnnotationResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// RemoveQueryAnnotationResponseMultiError, or nil if none found.
+func (m *RemoveQueryAnnotationResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemoveQueryAnnotationResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RemoveQueryAnnotationResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveQueryAnnotationResponseMultiError is an error wrapping multiple
+// validation errors returned by RemoveQueryAnnotationResponse.ValidateAll()
+// if the designated constraints aren't met.
+type RemoveQueryAnnotationResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveQueryAnnotationResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveQueryAnnotationResponseMultiError) AllErrors() []error { return m }
+
+// RemoveQueryAnnotationResponseValidationError is the validation error
+// returned by RemoveQueryAnnotationResponse.Validate if the designated
+// constraints aren't met.
+type RemoveQueryAnnotationResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveQueryAnnotationResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveQueryAnnotationResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveQueryAnnotationResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveQueryAnnotationResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveQueryAnnotationResponseValidationError) ErrorName() string {
+	return "RemoveQueryAnnotationResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveQueryAnnotationResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveQueryAnnotationResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = RemoveQueryAnnotationResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveQueryAnnotationResponseValidationError{}
+
+// Validate checks the field values on ListQueryAnnotationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQueryAnnotationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQueryAnnotationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQueryAnnotationsRequestMultiError, or nil if none found.
+func (m *ListQueryAnnotationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQueryAnnotationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Owner
+
+	if m.Acknowledged != nil {
+		// no validation rules for Acknowledged
+	}
+
+	if len(errors) > 0 {
+		return ListQueryAnnotationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQueryAnnotationsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListQueryAnnotationsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ListQueryAnnotationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQueryAnnotationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQueryAnnotationsRequestMultiError) AllErrors() []error { return m }
+
+// ListQueryAnnotationsRequestValidationError is the validation error returned
+// by ListQueryAnnotationsRequest.Validate if the designated constraints
+// aren't met.
+type ListQueryAnnotationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueryAnnotationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueryAnnotationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueryAnnotationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueryAnnotationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueryAnnotationsRequestValidationError) ErrorName() string {
+	return "ListQueryAnnotationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueryAnnotationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueryAnnotationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ListQueryAnnotationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueryAnnotationsRequestValidationError{}
+
+// Validate checks the field values on ListQueryAnnotationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQueryAnnotationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQueryAnnotationsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQueryAnnotationsResponseMultiError, or nil if none found.
+func (m *ListQueryAnnotationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQueryAnnotationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAnnotations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListQueryAnnotationsResponseValidationError{
+						field:  fmt.Sprintf("Annotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListQueryAnnotationsResponseValidationError{
+						field:  fmt.Sprintf("Annotations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListQueryAnnotationsResponseValidationError{
+					field:  fmt.Sprintf("Annotations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListQueryAnnotationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQueryAnnotationsResponseMultiError is an error wrapping multiple
+// validation errors returned by ListQueryAnnotationsResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListQueryAnnotationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQueryAnnotationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQueryAnnotationsResponseMultiError) AllErrors() []error { return m }
+
+// ListQueryAnnotationsResponseValidationError is the validation error returned
+// by ListQueryAnnotationsResponse.Validate if the designated constraints
+// aren't met.
+type ListQueryAnnotationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueryAnnotationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueryAnnotationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueryAnnotationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueryAnnotationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueryAnnotationsResponseValidationError) ErrorName() string {
+	return "ListQueryAnnotationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueryAnnotationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueryAnnotationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ListQueryAnnotationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueryAnnotationsResponseValidationError{}
@@ -0,0 +1,453 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: qan/v1/anomalies.proto
+
+package qanv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryAnomalyType is a kind of detected query anomaly.
+type QueryAnomalyType int32
+
+const (
+	QueryAnomalyType_QUERY_ANOMALY_TYPE_UNSPECIFIED QueryAnomalyType = 0
+	// Average query latency is significantly higher than the baseline.
+	QueryAnomalyType_QUERY_ANOMALY_TYPE_LATENCY QueryAnomalyType = 1
+	// Average number of rows examined per query is significantly higher than the baseline.
+	QueryAnomalyType_QUERY_ANOMALY_TYPE_ROWS_EXAMINED QueryAnomalyType = 2
+	// Query call rate is significantly higher than the baseline.
+	QueryAnomalyType_QUERY_ANOMALY_TYPE_CALL_RATE QueryAnomalyType = 3
+	// Query started to use an execution plan not seen in the baseline.
+	QueryAnomalyType_QUERY_ANOMALY_TYPE_PLAN_FLIP QueryAnomalyType = 4
+)
+
+// Enum value maps for QueryAnomalyType.
+var (
+	QueryAnomalyType_name = map[int32]string{
+		0: "QUERY_ANOMALY_TYPE_UNSPECIFIED",
+		1: "QUERY_ANOMALY_TYPE_LATENCY",
+		2: "QUERY_ANOMALY_TYPE_ROWS_EXAMINED",
+		3: "QUERY_ANOMALY_TYPE_CALL_RATE",
+		4: "QUERY_ANOMALY_TYPE_PLAN_FLIP",
+	}
+	QueryAnomalyType_value = map[string]int32{
+		"QUERY_ANOMALY_TYPE_UNSPECIFIED":   0,
+		"QUERY_ANOMALY_TYPE_LATENCY":       1,
+		"QUERY_ANOMALY_TYPE_ROWS_EXAMINED": 2,
+		"QUERY_ANOMALY_TYPE_CALL_RATE":     3,
+		"QUERY_ANOMALY_TYPE_PLAN_FLIP":     4,
+	}
+)
+
+func (x QueryAnomalyType) Enum() *QueryAnomalyType {
+	p := new(QueryAnomalyType)
+	*p = x
+	return p
+}
+
+func (x QueryAnomalyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueryAnomalyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_qan_v1_anomalies_proto_enumTypes[0].Descriptor()
+}
+
+func (QueryAnomalyType) Type() protoreflect.EnumType {
+	return &file_qan_v1_anomalies_proto_enumTypes[0]
+}
+
+func (x QueryAnomalyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryAnomalyType.Descriptor instead.
+func (QueryAnomalyType) EnumDescriptor() ([]byte, []int) {
+	return file_qan_v1_anomalies_proto_rawDescGZIP(), []int{0}
+}
+
+// ListQueryAnomaliesRequest defines filtering of detected query anomalies.
+type ListQueryAnomaliesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Return only anomalies of the given service.
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Return only anomalies of the given types. All types are returned if empty.
+	Types         []QueryAnomalyType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=qan.v1.QueryAnomalyType" json:"types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueryAnomaliesRequest) Reset() {
+	*x = ListQueryAnomaliesRequest{}
+	mi := &file_qan_v1_anomalies_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueryAnomaliesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueryAnomaliesRequest) ProtoMessage() {}
+
+func (x *ListQueryAnomaliesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_anomalies_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueryAnomaliesRequest.ProtoReflect.Descriptor instead.
+func (*ListQueryAnomaliesRequest) Descriptor() ([]byte, []int) {
+	return file_qan_v1_anomalies_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListQueryAnomaliesRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ListQueryAnomaliesRequest) GetTypes() []QueryAnomalyType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+// ListQueryAnomaliesResponse is a list of query anomalies found by the last detection run.
+type ListQueryAnomaliesResponse struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Anomalies []*QueryAnomaly        `protobuf:"bytes,1,rep,name=anomalies,proto3" json:"anomalies,omitempty"`
+	// Time of the last detection run.
+	EvaluatedAt   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=evaluated_at,json=evaluatedAt,proto3" json:"evaluated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueryAnomaliesResponse) Reset() {
+	*x = ListQueryAnomaliesResponse{}
+	mi := &file_qan_v1_anomalies_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueryAnomaliesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueryAnomaliesResponse) ProtoMessage() {}
+
+func (x *ListQueryAnomaliesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_anomalies_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueryAnomaliesResponse.ProtoReflect.Descriptor instead.
+func (*ListQueryAnomaliesResponse) Descriptor() ([]byte, []int) {
+	return file_qan_v1_anomalies_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListQueryAnomaliesResponse) GetAnomalies() []*QueryAnomaly {
+	if x != nil {
+		return x.Anomalies
+	}
+	return nil
+}
+
+func (x *ListQueryAnomaliesResponse) GetEvaluatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EvaluatedAt
+	}
+	return nil
+}
+
+// QueryAnomaly describes a statistically significant deviation of a query from its baseline.
+type QueryAnomaly struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Type        QueryAnomalyType       `protobuf:"varint,1,opt,name=type,proto3,enum=qan.v1.QueryAnomalyType" json:"type,omitempty"`
+	ServiceId   string                 `protobuf:"bytes,2,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ServiceName string                 `protobuf:"bytes,3,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	ServiceType string                 `protobuf:"bytes,4,opt,name=service_type,json=serviceType,proto3" json:"service_type,omitempty"`
+	Queryid     string                 `protobuf:"bytes,5,opt,name=queryid,proto3" json:"queryid,omitempty"`
+	Fingerprint string                 `protobuf:"bytes,6,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// Metric value in the detection window. Not set for plan flips.
+	CurrentValue float64 `protobuf:"fixed64,7,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
+	// Mean of the metric value in the baseline window. Not set for plan flips.
+	BaselineMean float64 `protobuf:"fixed64,8,opt,name=baseline_mean,json=baselineMean,proto3" json:"baseline_mean,omitempty"`
+	// Standard deviation of the metric value in the baseline window. Not set for plan flips.
+	BaselineStddev float64 `protobuf:"fixed64,9,opt,name=baseline_stddev,json=baselineStddev,proto3" json:"baseline_stddev,omitempty"`
+	// Number of standard deviations between the current value and the baseline mean. Not set for plan flips.
+	ZScore float64 `protobuf:"fixed64,10,opt,name=z_score,json=zScore,proto3" json:"z_score,omitempty"`
+	// Plan IDs seen in the detection window but not in the baseline window. Set for plan flips only.
+	NewPlanIds []string `protobuf:"bytes,11,rep,name=new_plan_ids,json=newPlanIds,proto3" json:"new_plan_ids,omitempty"`
+	// Plan IDs seen in the baseline window. Set for plan flips only.
+	BaselinePlanIds []string `protobuf:"bytes,12,rep,name=baseline_plan_ids,json=baselinePlanIds,proto3" json:"baseline_plan_ids,omitempty"`
+	// Start of the detection window.
+	WindowStart *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	// End of the detection window.
+	WindowEnd     *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=window_end,json=windowEnd,proto3" json:"window_end,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryAnomaly) Reset() {
+	*x = QueryAnomaly{}
+	mi := &file_qan_v1_anomalies_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryAnomaly) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryAnomaly) ProtoMessage() {}
+
+func (x *QueryAnomaly) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_anomalies_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryAnomaly.ProtoReflect.Descriptor instead.
+func (*QueryAnomaly) Descriptor() ([]byte, []int) {
+	return file_qan_v1_anomalies_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryAnomaly) GetType() QueryAnomalyType {
+	if x != nil {
+		return x.Type
+	}
+	return QueryAnomalyType_QUERY_ANOMALY_TYPE_UNSPECIFIED
+}
+
+func (x *QueryAnomaly) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *QueryAnomaly) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *QueryAnomaly) GetServiceType() string {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ""
+}
+
+func (x *QueryAnomaly) GetQueryid() string {
+	if x != nil {
+		return x.Queryid
+	}
+	return ""
+}
+
+func (x *QueryAnomaly) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *QueryAnomaly) GetCurrentValue() float64 {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return 0
+}
+
+func (x *QueryAnomaly) GetBaselineMean() float64 {
+	if x != nil {
+		return x.BaselineMean
+	}
+	return 0
+}
+
+func (x *QueryAnomaly) GetBaselineStddev() float64 {
+	if x != nil {
+		return x.BaselineStddev
+	}
+	return 0
+}
+
+func (x *QueryAnomaly) GetZScore() float64 {
+	if x != nil {
+		return x.ZScore
+	}
+	return 0
+}
+
+func (x *QueryAnomaly) GetNewPlanIds() []string {
+	if x != nil {
+		return x.NewPlanIds
+	}
+	return nil
+}
+
+func (x *QueryAnomaly) GetBaselinePlanIds() []string {
+	if x != nil {
+		return x.BaselinePlanIds
+	}
+	return nil
+}
+
+func (x *QueryAnomaly) GetWindowStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowStart
+	}
+	return nil
+}
+
+func (x *QueryAnomaly) GetWindowEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.WindowEnd
+	}
+	return nil
+}
+
+var File_qan_v1_anomalies_proto protoreflect.FileDescriptor
+
+const file_qan_v1_anomalies_proto_rawDesc = "" +
+	"\n" +
+	"\x16qan/v1/anomalies.proto\x12\x06qan.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"j\n" +
+	"\x19ListQueryAnomaliesRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12.\n" +
+	"\x05types\x18\x02 \x03(\x0e2\x18.qan.v1.QueryAnomalyTypeR\x05types\"\x8f\x01\n" +
+	"\x1aListQueryAnomaliesResponse\x122\n" +
+	"\tanomalies\x18\x01 \x03(\v2\x14.qan.v1.QueryAnomalyR\tanomalies\x12=\n" +
+	"\fevaluated_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\vevaluatedAt\"\xb1\x04\n" +
+	"\fQueryAnomaly\x12,\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x18.qan.v1.QueryAnomalyTypeR\x04type\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x02 \x01(\tR\tserviceId\x12!\n" +
+	"\fservice_name\x18\x03 \x01(\tR\vserviceName\x12!\n" +
+	"\fservice_type\x18\x04 \x01(\tR\vserviceType\x12\x18\n" +
+	"\aqueryid\x18\x05 \x01(\tR\aqueryid\x12 \n" +
+	"\vfingerprint\x18\x06 \x01(\tR\vfingerprint\x12#\n" +
+	"\rcurrent_value\x18\a \x01(\x01R\fcurrentValue\x12#\n" +
+	"\rbaseline_mean\x18\b \x01(\x01R\fbaselineMean\x12'\n" +
+	"\x0fbaseline_stddev\x18\t \x01(\x01R\x0ebaselineStddev\x12\x17\n" +
+	"\az_score\x18\n" +
+	" \x01(\x01R\x06zScore\x12 \n" +
+	"\fnew_plan_ids\x18\v \x03(\tR\n" +
+	"newPlanIds\x12*\n" +
+	"\x11baseline_plan_ids\x18\f \x03(\tR\x0fbaselinePlanIds\x12=\n" +
+	"\fwindow_start\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vwindowStart\x129\n" +
+	"\n" +
+	"window_end\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\twindowEnd*\xc0\x01\n" +
+	"\x10QueryAnomalyType\x12\"\n" +
+	"\x1eQUERY_ANOMALY_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aQUERY_ANOMALY_TYPE_LATENCY\x10\x01\x12$\n" +
+	" QUERY_ANOMALY_TYPE_ROWS_EXAMINED\x10\x02\x12 \n" +
+	"\x1cQUERY_ANOMALY_TYPE_CALL_RATE\x10\x03\x12 \n" +
+	"\x1cQUERY_ANOMALY_TYPE_PLAN_FLIP\x10\x04B~\n" +
+	"\n" +
+	"com.qan.v1B\x0eAnomaliesProtoP\x01Z'github.com/percona/pmm/api/qan/v1;qanv1\xa2\x02\x03QXX\xaa\x02\x06Qan.V1\xca\x02\x06Qan\\V1\xe2\x02\x12Qan\\V1\\GPBMetadata\xea\x02\aQan::V1b\x06proto3"
+
+var (
+	file_qan_v1_anomalies_proto_rawDescOnce sync.Once
+	file_qan_v1_anomalies_proto_rawDescData []byte
+)
+
+func file_qan_v1_anomalies_proto_rawDescGZIP() []byte {
+	file_qan_v1_anomalies_proto_rawDescOnce.Do(func() {
+		file_qan_v1_anomalies_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_qan_v1_anomalies_proto_rawDesc), len(file_qan_v1_anomalies_proto_rawDesc)))
+	})
+	return file_qan_v1_anomalies_proto_rawDescData
+}
+
+var (
+	file_qan_v1_anomalies_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+	file_qan_v1_anomalies_proto_msgTypes  = make([]protoimpl.MessageInfo, 3)
+	file_qan_v1_anomalies_proto_goTypes   = []any{
+		QueryAnomalyType(0),                // 0: qan.v1.QueryAnomalyType
+		(*ListQueryAnomaliesRequest)(nil),  // 1: qan.v1.ListQueryAnomaliesRequest
+		(*ListQueryAnomaliesResponse)(nil), // 2: qan.v1.ListQueryAnomaliesResponse
+		(*QueryAnomaly)(nil),               // 3: qan.v1.QueryAnomaly
+		(*timestamppb.Timestamp)(nil),      // 4: google.protobuf.Timestamp
+	}
+)
+
+var file_qan_v1_anomalies_proto_depIdxs = []int32{
+	0, // 0: qan.v1.ListQueryAnomaliesRequest.types:type_name -> qan.v1.QueryAnomalyType
+	3, // 1: qan.v1.ListQueryAnomaliesResponse.anomalies:type_name -> qan.v1.QueryAnomaly
+	4, // 2: qan.v1.ListQueryAnomaliesResponse.evaluated_at:type_name -> google.protobuf.Timestamp
+	0, // 3: qan.v1.QueryAnomaly.type:type_name -> qan.v1.QueryAnomalyType
+	4, // 4: qan.v1.QueryAnomaly.window_start:type_name -> google.protobuf.Timestamp
+	4, // 5: qan.v1.QueryAnomaly.window_end:type_name -> google.protobuf.Timestamp
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_qan_v1_anomalies_proto_init() }
+func file_qan_v1_anomalies_proto_init() {
+	if File_qan_v1_anomalies_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_qan_v1_anomalies_proto_rawDesc), len(file_qan_v1_anomalies_proto_rawDesc)),
+			NumEnums:      1,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_qan_v1_anomalies_proto_goTypes,
+		DependencyIndexes: file_qan_v1_anomalies_proto_depIdxs,
+		EnumInfos:         file_qan_v1_anomalies_proto_enumTypes,
+		MessageInfos:      file_qan_v1_anomalies_proto_msgTypes,
+	}.Build()
+	File_qan_v1_anomalies_proto = out.File
+	file_qan_v1_anomalies_proto_goTypes = nil
+	file_qan_v1_anomalies_proto_depIdxs = nil
+}
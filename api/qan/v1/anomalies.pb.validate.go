@@ -0,0 +1,485 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: qan/v1/anomalies.proto
+
+package qanv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on ListQueryAnomaliesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQueryAnomaliesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQueryAnomaliesRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQueryAnomaliesRequestMultiError, or nil if none found.
+func (m *ListQueryAnomaliesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQueryAnomaliesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceId
+
+	if len(errors) > 0 {
+		return ListQueryAnomaliesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQueryAnomaliesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListQueryAnomaliesRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListQueryAnomaliesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQueryAnomaliesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQueryAnomaliesRequestMultiError) AllErrors() []error { return m }
+
+// ListQueryAnomaliesRequestValidationError is the validation error returned by
+// ListQueryAnomaliesRequest.Validate if the designated constraints aren't met.
+type ListQueryAnomaliesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueryAnomaliesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueryAnomaliesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueryAnomaliesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueryAnomaliesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueryAnomaliesRequestValidationError) ErrorName() string {
+	return "ListQueryAnomaliesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueryAnomaliesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueryAnomaliesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ListQueryAnomaliesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueryAnomaliesRequestValidationError{}
+
+// Validate checks the field values on ListQueryAnomaliesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQueryAnomaliesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQueryAnomaliesResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQueryAnomaliesResponseMultiError, or nil if none found.
+func (m *ListQueryAnomaliesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQueryAnomaliesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAnomalies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListQueryAnomaliesResponseValidationError{
+						field:  fmt.Sprintf("Anomalies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListQueryAnomaliesResponseValidationError{
+						field:  fmt.Sprintf("Anomalies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListQueryAnomaliesResponseValidationError{
+					field:  fmt.Sprintf("Anomalies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetEvaluatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListQueryAnomaliesResponseValidationError{
+					field:  "EvaluatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListQueryAnomaliesResponseValidationError{
+					field:  "EvaluatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEvaluatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListQueryAnomaliesResponseValidationError{
+				field:  "EvaluatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListQueryAnomaliesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQueryAnomaliesResponseMultiError is an error wrapping multiple
+// validation errors returned by ListQueryAnomaliesResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ListQueryAnomaliesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQueryAnomaliesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQueryAnomaliesResponseMultiError) AllErrors() []error { return m }
+
+// ListQueryAnomaliesResponseValidationError is the validation error returned
+// by ListQueryAnomaliesResponse.Validate if the designated constraints aren't met.
+type ListQueryAnomaliesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueryAnomaliesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueryAnomaliesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueryAnomaliesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueryAnomaliesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueryAnomaliesResponseValidationError) ErrorName() string {
+	return "ListQueryAnomaliesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueryAnomaliesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueryAnomaliesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ListQueryAnomaliesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueryAnomaliesResponseValidationError{}
+
+// Validate checks the field values on QueryAnomaly with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QueryAnomaly) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryAnomaly with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QueryAnomalyMultiError, or
+// nil if none found.
+func (m *QueryAnomaly) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryAnomaly) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for ServiceId
+
+	// no validation rules for ServiceName
+
+	// no validation rules for ServiceType
+
+	// no validation rules for Queryid
+
+	// no validation rules for Fingerprint
+
+	// no validation rules for CurrentValue
+
+	// no validation rules for BaselineMean
+
+	// no validation rules for BaselineStddev
+
+	// no validation rules for ZScore
+
+	if all {
+		switch v := interface{}(m.GetWindowStart()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryAnomalyValidationError{
+					field:  "WindowStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryAnomalyValidationError{
+					field:  "WindowStart",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindowStart()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryAnomalyValidationError{
+				field:  "WindowStart",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetWindowEnd()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryAnomalyValidationError{
+					field:  "WindowEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryAnomalyValidationError{
+					field:  "WindowEnd",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetWindowEnd()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryAnomalyValidationError{
+				field:  "WindowEnd",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return QueryAnomalyMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryAnomalyMultiError is an error wrapping multiple validation errors
+// returned by QueryAnomaly.ValidateAll() if the designated constraints aren't met.
+type QueryAnomalyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryAnomalyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryAnomalyMultiError) AllErrors() []error { return m }
+
+// QueryAnomalyValidationError is the validation error returned by
+// QueryAnomaly.Validate if the designated constraints aren't met.
+type QueryAnomalyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryAnomalyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryAnomalyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryAnomalyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryAnomalyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryAnomalyValidationError) ErrorName() string { return "QueryAnomalyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueryAnomalyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryAnomaly.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = QueryAnomalyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryAnomalyValidationError{}
@@ -467,6 +467,23 @@ type MetricsBucket struct {
 	Planid          string   `protobuf:"bytes,266,opt,name=planid,proto3" json:"planid,omitempty"`
 	QueryPlan       string   `protobuf:"bytes,267,opt,name=query_plan,json=queryPlan,proto3" json:"query_plan,omitempty"`
 	HistogramItems  []string `protobuf:"bytes,268,rep,name=histogram_items,json=histogramItems,proto3" json:"histogram_items,omitempty"`
+	MArgsCnt        float32  `protobuf:"fixed32,320,opt,name=m_args_cnt,json=mArgsCnt,proto3" json:"m_args_cnt,omitempty"`
+	// The number of command arguments.
+	MArgsSum       float32 `protobuf:"fixed32,321,opt,name=m_args_sum,json=mArgsSum,proto3" json:"m_args_sum,omitempty"`
+	MArgsMin       float32 `protobuf:"fixed32,322,opt,name=m_args_min,json=mArgsMin,proto3" json:"m_args_min,omitempty"`
+	MArgsMax       float32 `protobuf:"fixed32,323,opt,name=m_args_max,json=mArgsMax,proto3" json:"m_args_max,omitempty"`
+	MTotalCallsCnt float32 `protobuf:"fixed32,324,opt,name=m_total_calls_cnt,json=mTotalCallsCnt,proto3" json:"m_total_calls_cnt,omitempty"`
+	// The number of command calls, including calls not recorded in the slow log.
+	MTotalCallsSum float32 `protobuf:"fixed32,325,opt,name=m_total_calls_sum,json=mTotalCallsSum,proto3" json:"m_total_calls_sum,omitempty"`
+	MTotalTimeCnt  float32 `protobuf:"fixed32,326,opt,name=m_total_time_cnt,json=mTotalTimeCnt,proto3" json:"m_total_time_cnt,omitempty"`
+	// The execution time of command calls in seconds, including calls not recorded in the slow log.
+	MTotalTimeSum     float32 `protobuf:"fixed32,327,opt,name=m_total_time_sum,json=mTotalTimeSum,proto3" json:"m_total_time_sum,omitempty"`
+	MRejectedCallsCnt float32 `protobuf:"fixed32,328,opt,name=m_rejected_calls_cnt,json=mRejectedCallsCnt,proto3" json:"m_rejected_calls_cnt,omitempty"`
+	// The number of command calls rejected before execution.
+	MRejectedCallsSum float32 `protobuf:"fixed32,329,opt,name=m_rejected_calls_sum,json=mRejectedCallsSum,proto3" json:"m_rejected_calls_sum,omitempty"`
+	MFailedCallsCnt   float32 `protobuf:"fixed32,330,opt,name=m_failed_calls_cnt,json=mFailedCallsCnt,proto3" json:"m_failed_calls_cnt,omitempty"`
+	// The number of command calls failed during execution.
+	MFailedCallsSum float32 `protobuf:"fixed32,331,opt,name=m_failed_calls_sum,json=mFailedCallsSum,proto3" json:"m_failed_calls_sum,omitempty"`
 	unknownFields   protoimpl.UnknownFields
 	sizeCache       protoimpl.SizeCache
 }
@@ -2391,6 +2408,90 @@ func (x *MetricsBucket) GetHistogramItems() []string {
 	return nil
 }
 
+func (x *MetricsBucket) GetMArgsCnt() float32 {
+	if x != nil {
+		return x.MArgsCnt
+	}
+	return 0
+}
+
+func (x *MetricsBucket) GetMArgsSum() float32 {
+	if x != nil {
+		return x.MArgsSum
+	}
+	return 0
+}
+
+func (x *MetricsBucket) GetMArgsMin() float32 {
+	if x != nil {
+		return x.MArgsMin
+	}
+	return 0
+}
+
+func (x *MetricsBucket) GetMArgsMax() float32 {
+	if x != nil {
+		return x.MArgsMax
+	}
+	return 0
+}
+
+func (x *MetricsBucket) GetMTotalCallsCnt() float32 {
+	if x != nil {
+		return x.MTotalCallsCnt
+	}
+	return 0
+}
+
+func (x *MetricsBucket) GetMTotalCallsSum() float32 {
+	if x != nil {
+		return x.MTotalCallsSum
+	}
+	return 0
+}
+
+func (x *MetricsBucket) GetMTotalTimeCnt() float32 {
+	if x != nil {
+		return x.MTotalTimeCnt
+	}
+	return 0
+}
+
+func (x *MetricsBucket) GetMTotalTimeSum() float32 {
+	if x != nil {
+		return x.MTotalTimeSum
+	}
+	return 0
+}
+
+func (x *MetricsBucket) GetMRejectedCallsCnt() float32 {
+	if x != nil {
+		return x.MRejectedCallsCnt
+	}
+	return 0
+}
+
+func (x *MetricsBucket) GetMRejectedCallsSum() float32 {
+	if x != nil {
+		return x.MRejectedCallsSum
+	}
+	return 0
+}
+
+func (x *MetricsBucket) GetMFailedCallsCnt() float32 {
+	if x != nil {
+		return x.MFailedCallsCnt
+	}
+	return 0
+}
+
+func (x *MetricsBucket) GetMFailedCallsSum() float32 {
+	if x != nil {
+		return x.MFailedCallsSum
+	}
+	return 0
+}
+
 type CollectResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -2433,7 +2534,7 @@ const file_qan_v1_collector_proto_rawDesc = "" +
 	"\n" +
 	"\x16qan/v1/collector.proto\x12\x06qan.v1\x1a\x1aextensions/v1/redact.proto\x1a\x1bgoogle/api/visibility.proto\x1a\x19inventory/v1/agents.proto\x1a\x10qan/v1/qan.proto\"N\n" +
 	"\x0eCollectRequest\x12<\n" +
-	"\x0emetrics_bucket\x18\x01 \x03(\v2\x15.qan.v1.MetricsBucketR\rmetricsBucket\"\xe4k\n" +
+	"\x0emetrics_bucket\x18\x01 \x03(\v2\x15.qan.v1.MetricsBucketR\rmetricsBucket\"\xcco\n" +
 	"\rMetricsBucket\x12\x18\n" +
 	"\aqueryid\x18\x01 \x01(\tR\aqueryid\x12/\n" +
 	"\x13explain_fingerprint\x18\x02 \x01(\tR\x12explainFingerprint\x12-\n" +
@@ -2715,7 +2816,23 @@ const file_qan_v1_collector_proto_rawDesc = "" +
 	"\x06planid\x18\x8a\x02 \x01(\tR\x06planid\x12\x1e\n" +
 	"\n" +
 	"query_plan\x18\x8b\x02 \x01(\tR\tqueryPlan\x12(\n" +
-	"\x0fhistogram_items\x18\x8c\x02 \x03(\tR\x0ehistogramItems\x1a9\n" +
+	"\x0fhistogram_items\x18\x8c\x02 \x03(\tR\x0ehistogramItems\x12\x1d\n" +
+	"\n" +
+	"m_args_cnt\x18\xc0\x02 \x01(\x02R\bmArgsCnt\x12\x1d\n" +
+	"\n" +
+	"m_args_sum\x18\xc1\x02 \x01(\x02R\bmArgsSum\x12\x1d\n" +
+	"\n" +
+	"m_args_min\x18\xc2\x02 \x01(\x02R\bmArgsMin\x12\x1d\n" +
+	"\n" +
+	"m_args_max\x18\xc3\x02 \x01(\x02R\bmArgsMax\x12*\n" +
+	"\x11m_total_calls_cnt\x18\xc4\x02 \x01(\x02R\x0emTotalCallsCnt\x12*\n" +
+	"\x11m_total_calls_sum\x18\xc5\x02 \x01(\x02R\x0emTotalCallsSum\x12(\n" +
+	"\x10m_total_time_cnt\x18\xc6\x02 \x01(\x02R\rmTotalTimeCnt\x12(\n" +
+	"\x10m_total_time_sum\x18\xc7\x02 \x01(\x02R\rmTotalTimeSum\x120\n" +
+	"\x14m_rejected_calls_cnt\x18\xc8\x02 \x01(\x02R\x11mRejectedCallsCnt\x120\n" +
+	"\x14m_rejected_calls_sum\x18\xc9\x02 \x01(\x02R\x11mRejectedCallsSum\x12,\n" +
+	"\x12m_failed_calls_cnt\x18\xca\x02 \x01(\x02R\x0fmFailedCallsCnt\x12,\n" +
+	"\x12m_failed_calls_sum\x18\xcb\x02 \x01(\x02R\x0fmFailedCallsSum\x1a9\n" +
 	"\vLabelsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a;\n" +
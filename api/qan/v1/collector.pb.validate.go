@@ -732,6 +732,30 @@ func (m *MetricsBucket) validate(all bool) error {
 
 	// no validation rules for QueryPlan
 
+	// no validation rules for MArgsCnt
+
+	// no validation rules for MArgsSum
+
+	// no validation rules for MArgsMin
+
+	// no validation rules for MArgsMax
+
+	// no validation rules for MTotalCallsCnt
+
+	// no validation rules for MTotalCallsSum
+
+	// no validation rules for MTotalTimeCnt
+
+	// no validation rules for MTotalTimeSum
+
+	// no validation rules for MRejectedCallsCnt
+
+	// no validation rules for MRejectedCallsSum
+
+	// no validation rules for MFailedCallsCnt
+
+	// no validation rules for MFailedCallsSum
+
 	if len(errors) > 0 {
 		return MetricsBucketMultiError(errors)
 	}
@@ -221,6 +221,19 @@ type GetReportBody struct {
 
 	// search
 	Search string `json:"search,omitempty"`
+
+	// Start of the baseline period to compare the report period with.
+	// Comparison is enabled when both baseline period bounds are set.
+	// Format: date-time
+	BaselinePeriodStartFrom strfmt.DateTime `json:"baseline_period_start_from,omitempty"`
+
+	// End of the baseline period.
+	// Format: date-time
+	BaselinePeriodStartTo strfmt.DateTime `json:"baseline_period_start_to,omitempty"`
+
+	// Order rows by the change of the order column between the baseline and report periods
+	// instead of its value, so regressions can be ranked directly. Requires the baseline period.
+	OrderByDelta bool `json:"order_by_delta,omitempty"`
 }
 
 // Validate validates this get report body
@@ -239,6 +252,14 @@ func (o *GetReportBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateBaselinePeriodStartFrom(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateBaselinePeriodStartTo(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -299,6 +320,30 @@ func (o *GetReportBody) validateLabels(formats strfmt.Registry) error {
 	return nil
 }
 
+func (o *GetReportBody) validateBaselinePeriodStartFrom(formats strfmt.Registry) error {
+	if swag.IsZero(o.BaselinePeriodStartFrom) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("body"+"."+"baseline_period_start_from", "body", "date-time", o.BaselinePeriodStartFrom.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *GetReportBody) validateBaselinePeriodStartTo(formats strfmt.Registry) error {
+	if swag.IsZero(o.BaselinePeriodStartTo) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("body"+"."+"baseline_period_start_to", "body", "date-time", o.BaselinePeriodStartTo.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validate this get report body based on the context it is used
 func (o *GetReportBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -475,11 +520,122 @@ func (o *GetReportDefaultBody) UnmarshalBinary(b []byte) error {
 }
 
 /*
-GetReportDefaultBodyDetailsItems0 get report default body details items0
+GetReportDefaultBodyDetailsItems0 `Any` contains an arbitrary serialized protocol buffer message along with a
+// URL that describes the type of the serialized message.
+//
+// Protobuf library provides support to pack/unpack Any values in the form
+// of utility functions or additional generated methods of the Any type.
+//
+// Example 1: Pack and unpack a message in C++.
+//
+//     Foo foo = ...;
+//     Any any;
+//     any.PackFrom(foo);
+//     ...
+//     if (any.UnpackTo(&foo)) {
+//       ...
+//     }
+//
+// Example 2: Pack and unpack a message in Java.
+//
+//     Foo foo = ...;
+//     Any any = Any.pack(foo);
+//     ...
+//     if (any.is(Foo.class)) {
+//       foo = any.unpack(Foo.class);
+//     }
+//     // or ...
+//     if (any.isSameTypeAs(Foo.getDefaultInstance())) {
+//       foo = any.unpack(Foo.getDefaultInstance());
+//     }
+//
+//  Example 3: Pack and unpack a message in Python.
+//
+//     foo = Foo(...)
+//     any = Any()
+//     any.Pack(foo)
+//     ...
+//     if any.Is(Foo.DESCRIPTOR):
+//       any.Unpack(foo)
+//       ...
+//
+//  Example 4: Pack and unpack a message in Go
+//
+//      foo := &pb.Foo{...}
+//      any, err := anypb.New(foo)
+//      if err != nil {
+//        ...
+//      }
+//      ...
+//      foo := &pb.Foo{}
+//      if err := any.UnmarshalTo(foo); err != nil {
+//        ...
+//      }
+//
+// The pack methods provided by protobuf library will by default use
+// 'type.googleapis.com/full.type.name' as the type URL and the unpack
+// methods only use the fully qualified type name after the last '/'
+// in the type URL, for example "foo.bar.com/x/y.z" will yield type
+// name "y.z".
+//
+// JSON
+// ====
+// The JSON representation of an `Any` value uses the regular
+// representation of the deserialized, embedded message, with an
+// additional field `@type` which contains the type URL. Example:
+//
+//     package google.profile;
+//     message Person {
+//       string first_name = 1;
+//       string last_name = 2;
+//     }
+//
+//     {
+//       "@type": "type.googleapis.com/google.profile.Person",
+//       "firstName": <string>,
+//       "lastName": <string>
+//     }
+//
+// If the embedded message type is well-known and has a custom JSON
+// representation, that representation will be embedded adding a field
+// `value` which holds the custom JSON in addition to the `@type`
+// field. Example (for message [google.protobuf.Duration][]):
+//
+//     {
+//       "@type": "type.googleapis.com/google.protobuf.Duration",
+//       "value": "1.212s"
+//     }
 swagger:model GetReportDefaultBodyDetailsItems0
 */
 type GetReportDefaultBodyDetailsItems0 struct {
-	// at type
+	// A URL/resource name that uniquely identifies the type of the serialized
+	// protocol buffer message. This string must contain at least
+	// one "/" character. The last segment of the URL's path must represent
+	// the fully qualified name of the type (as in
+	// `path/google.protobuf.Duration`). The name should be in a canonical form
+	// (e.g., leading "." is not accepted).
+	//
+	// In practice, teams usually precompile into the binary all types that they
+	// expect it to use in the context of Any. However, for URLs which use the
+	// scheme `http`, `https`, or no scheme, one can optionally set up a type
+	// server that maps type URLs to message definitions as follows:
+	//
+	// * If no scheme is provided, `https` is assumed.
+	// * An HTTP GET on the URL must yield a [google.protobuf.Type][]
+	//   value in binary format, or produce an error.
+	// * Applications are allowed to cache lookup results based on the
+	//   URL, or have them precompiled into a binary to avoid any
+	//   lookup. Therefore, binary compatibility needs to be preserved
+	//   on changes to types. (Use versioned type names to manage
+	//   breaking changes.)
+	//
+	// Note: this functionality is not currently available in the official
+	// protobuf release, and it is not used for type URLs beginning with
+	// type.googleapis.com. As of May 2023, there are no widely used type server
+	// implementations and no plans to implement one.
+	//
+	// Schemes other than `http`, `https` (or the empty scheme) might be
+	// used with implementation specific semantics.
 	AtType string `json:"@type,omitempty"`
 
 	// get report default body details items0
@@ -490,7 +646,34 @@ type GetReportDefaultBodyDetailsItems0 struct {
 func (o *GetReportDefaultBodyDetailsItems0) UnmarshalJSON(data []byte) error {
 	// stage 1, bind the properties
 	var stage1 struct {
-		// at type
+		// A URL/resource name that uniquely identifies the type of the serialized
+		// protocol buffer message. This string must contain at least
+		// one "/" character. The last segment of the URL's path must represent
+		// the fully qualified name of the type (as in
+		// `path/google.protobuf.Duration`). The name should be in a canonical form
+		// (e.g., leading "." is not accepted).
+		//
+		// In practice, teams usually precompile into the binary all types that they
+		// expect it to use in the context of Any. However, for URLs which use the
+		// scheme `http`, `https`, or no scheme, one can optionally set up a type
+		// server that maps type URLs to message definitions as follows:
+		//
+		// * If no scheme is provided, `https` is assumed.
+		// * An HTTP GET on the URL must yield a [google.protobuf.Type][]
+		//   value in binary format, or produce an error.
+		// * Applications are allowed to cache lookup results based on the
+		//   URL, or have them precompiled into a binary to avoid any
+		//   lookup. Therefore, binary compatibility needs to be preserved
+		//   on changes to types. (Use versioned type names to manage
+		//   breaking changes.)
+		//
+		// Note: this functionality is not currently available in the official
+		// protobuf release, and it is not used for type URLs beginning with
+		// type.googleapis.com. As of May 2023, there are no widely used type server
+		// implementations and no plans to implement one.
+		//
+		// Schemes other than `http`, `https` (or the empty scheme) might be
+		// used with implementation specific semantics.
 		AtType string `json:"@type,omitempty"`
 	}
 	if err := json.Unmarshal(data, &stage1); err != nil {
@@ -527,7 +710,34 @@ func (o *GetReportDefaultBodyDetailsItems0) UnmarshalJSON(data []byte) error {
 // MarshalJSON marshals this object with additional properties into a JSON object
 func (o GetReportDefaultBodyDetailsItems0) MarshalJSON() ([]byte, error) {
 	var stage1 struct {
-		// at type
+		// A URL/resource name that uniquely identifies the type of the serialized
+		// protocol buffer message. This string must contain at least
+		// one "/" character. The last segment of the URL's path must represent
+		// the fully qualified name of the type (as in
+		// `path/google.protobuf.Duration`). The name should be in a canonical form
+		// (e.g., leading "." is not accepted).
+		//
+		// In practice, teams usually precompile into the binary all types that they
+		// expect it to use in the context of Any. However, for URLs which use the
+		// scheme `http`, `https`, or no scheme, one can optionally set up a type
+		// server that maps type URLs to message definitions as follows:
+		//
+		// * If no scheme is provided, `https` is assumed.
+		// * An HTTP GET on the URL must yield a [google.protobuf.Type][]
+		//   value in binary format, or produce an error.
+		// * Applications are allowed to cache lookup results based on the
+		//   URL, or have them precompiled into a binary to avoid any
+		//   lookup. Therefore, binary compatibility needs to be preserved
+		//   on changes to types. (Use versioned type names to manage
+		//   breaking changes.)
+		//
+		// Note: this functionality is not currently available in the official
+		// protobuf release, and it is not used for type URLs beginning with
+		// type.googleapis.com. As of May 2023, there are no widely used type server
+		// implementations and no plans to implement one.
+		//
+		// Schemes other than `http`, `https` (or the empty scheme) might be
+		// used with implementation specific semantics.
 		AtType string `json:"@type,omitempty"`
 	}
 
@@ -736,6 +946,17 @@ type GetReportOKBodyRowsItems0 struct {
 
 	// load
 	Load float32 `json:"load,omitempty"`
+
+	// ComparisonStatus shows whether the row is present in the report and baseline periods.
+	//
+	//  - COMPARISON_STATUS_PRESENT: Present in both periods.
+	//  - COMPARISON_STATUS_NEW: Present only in the report period.
+	//  - COMPARISON_STATUS_DISAPPEARED: Present only in the baseline period.
+	// Enum: ["COMPARISON_STATUS_UNSPECIFIED","COMPARISON_STATUS_PRESENT","COMPARISON_STATUS_NEW","COMPARISON_STATUS_DISAPPEARED"]
+	ComparisonStatus *string `json:"comparison_status,omitempty"`
+
+	// annotation
+	Annotation *GetReportOKBodyRowsItems0Annotation `json:"annotation,omitempty"`
 }
 
 // Validate validates this get report OK body rows items0
@@ -750,6 +971,14 @@ func (o *GetReportOKBodyRowsItems0) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateComparisonStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateAnnotation(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -816,6 +1045,77 @@ func (o *GetReportOKBodyRowsItems0) validateSparkline(formats strfmt.Registry) e
 	return nil
 }
 
+var getReportOkBodyRowsItems0TypeComparisonStatusPropEnum []any
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["COMPARISON_STATUS_UNSPECIFIED","COMPARISON_STATUS_PRESENT","COMPARISON_STATUS_NEW","COMPARISON_STATUS_DISAPPEARED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		getReportOkBodyRowsItems0TypeComparisonStatusPropEnum = append(getReportOkBodyRowsItems0TypeComparisonStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// GetReportOKBodyRowsItems0ComparisonStatusCOMPARISONSTATUSUNSPECIFIED captures enum value "COMPARISON_STATUS_UNSPECIFIED"
+	GetReportOKBodyRowsItems0ComparisonStatusCOMPARISONSTATUSUNSPECIFIED string = "COMPARISON_STATUS_UNSPECIFIED"
+
+	// GetReportOKBodyRowsItems0ComparisonStatusCOMPARISONSTATUSPRESENT captures enum value "COMPARISON_STATUS_PRESENT"
+	GetReportOKBodyRowsItems0ComparisonStatusCOMPARISONSTATUSPRESENT string = "COMPARISON_STATUS_PRESENT"
+
+	// GetReportOKBodyRowsItems0ComparisonStatusCOMPARISONSTATUSNEW captures enum value "COMPARISON_STATUS_NEW"
+	GetReportOKBodyRowsItems0ComparisonStatusCOMPARISONSTATUSNEW string = "COMPARISON_STATUS_NEW"
+
+	// GetReportOKBodyRowsItems0ComparisonStatusCOMPARISONSTATUSDISAPPEARED captures enum value "COMPARISON_STATUS_DISAPPEARED"
+	GetReportOKBodyRowsItems0ComparisonStatusCOMPARISONSTATUSDISAPPEARED string = "COMPARISON_STATUS_DISAPPEARED"
+)
+
+// prop value enum
+func (o *GetReportOKBodyRowsItems0) validateComparisonStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, getReportOkBodyRowsItems0TypeComparisonStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *GetReportOKBodyRowsItems0) validateComparisonStatus(formats strfmt.Registry) error {
+	if swag.IsZero(o.ComparisonStatus) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateComparisonStatusEnum("comparison_status", "body", *o.ComparisonStatus); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (o *GetReportOKBodyRowsItems0) validateAnnotation(formats strfmt.Registry) error {
+	if swag.IsZero(o.Annotation) { // not required
+		return nil
+	}
+
+	if o.Annotation != nil {
+		if err := o.Annotation.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("annotation")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("annotation")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this get report OK body rows items0 based on the context it is used
 func (o *GetReportOKBodyRowsItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -828,6 +1128,10 @@ func (o *GetReportOKBodyRowsItems0) ContextValidate(ctx context.Context, formats
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateAnnotation(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -872,6 +1176,30 @@ func (o *GetReportOKBodyRowsItems0) contextValidateSparkline(ctx context.Context
 	return nil
 }
 
+func (o *GetReportOKBodyRowsItems0) contextValidateAnnotation(ctx context.Context, formats strfmt.Registry) error {
+	if o.Annotation != nil {
+
+		if swag.IsZero(o.Annotation) { // not required
+			return nil
+		}
+
+		if err := o.Annotation.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("annotation")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("annotation")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (o *GetReportOKBodyRowsItems0) MarshalBinary() ([]byte, error) {
 	if o == nil {
@@ -890,11 +1218,91 @@ func (o *GetReportOKBodyRowsItems0) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
+/*
+GetReportOKBodyRowsItems0Annotation QueryAnnotation is metadata attached to a query.
+swagger:model GetReportOKBodyRowsItems0Annotation
+*/
+type GetReportOKBodyRowsItems0Annotation struct {
+	// queryid
+	Queryid string `json:"queryid,omitempty"`
+
+	// Team or person owning the query.
+	Owner string `json:"owner,omitempty"`
+
+	// Link to the ticket tracking the query.
+	TicketURL string `json:"ticket_url,omitempty"`
+
+	// True if the query is known and its performance is acknowledged.
+	Acknowledged bool `json:"acknowledged,omitempty"`
+
+	// Free-form notes.
+	Notes string `json:"notes,omitempty"`
+
+	// Time of the last change. Ignored in SetQueryAnnotationRequest.
+	// Format: date-time
+	UpdatedAt strfmt.DateTime `json:"updated_at,omitempty"`
+}
+
+// Validate validates this get report OK body rows items0 annotation
+func (o *GetReportOKBodyRowsItems0Annotation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateUpdatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetReportOKBodyRowsItems0Annotation) validateUpdatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(o.UpdatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("annotation"+"."+"updated_at", "body", "date-time", o.UpdatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this get report OK body rows items0 annotation based on context it is used
+func (o *GetReportOKBodyRowsItems0Annotation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetReportOKBodyRowsItems0Annotation) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetReportOKBodyRowsItems0Annotation) UnmarshalBinary(b []byte) error {
+	var res GetReportOKBodyRowsItems0Annotation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
 /*
 GetReportOKBodyRowsItems0MetricsAnon Metric cell.
 swagger:model GetReportOKBodyRowsItems0MetricsAnon
 */
 type GetReportOKBodyRowsItems0MetricsAnon struct {
+	// baseline stats
+	BaselineStats *GetReportOKBodyRowsItems0MetricsAnonBaselineStats `json:"baseline_stats,omitempty"`
+
+	// delta
+	Delta *GetReportOKBodyRowsItems0MetricsAnonDelta `json:"delta,omitempty"`
+
 	// stats
 	Stats *GetReportOKBodyRowsItems0MetricsAnonStats `json:"stats,omitempty"`
 }
@@ -903,6 +1311,14 @@ type GetReportOKBodyRowsItems0MetricsAnon struct {
 func (o *GetReportOKBodyRowsItems0MetricsAnon) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := o.validateBaselineStats(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateDelta(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateStats(formats); err != nil {
 		res = append(res, err)
 	}
@@ -913,6 +1329,52 @@ func (o *GetReportOKBodyRowsItems0MetricsAnon) Validate(formats strfmt.Registry)
 	return nil
 }
 
+func (o *GetReportOKBodyRowsItems0MetricsAnon) validateBaselineStats(formats strfmt.Registry) error {
+	if swag.IsZero(o.BaselineStats) { // not required
+		return nil
+	}
+
+	if o.BaselineStats != nil {
+		if err := o.BaselineStats.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("baseline_stats")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("baseline_stats")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *GetReportOKBodyRowsItems0MetricsAnon) validateDelta(formats strfmt.Registry) error {
+	if swag.IsZero(o.Delta) { // not required
+		return nil
+	}
+
+	if o.Delta != nil {
+		if err := o.Delta.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("delta")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("delta")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *GetReportOKBodyRowsItems0MetricsAnon) validateStats(formats strfmt.Registry) error {
 	if swag.IsZero(o.Stats) { // not required
 		return nil
@@ -940,6 +1402,14 @@ func (o *GetReportOKBodyRowsItems0MetricsAnon) validateStats(formats strfmt.Regi
 func (o *GetReportOKBodyRowsItems0MetricsAnon) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := o.contextValidateBaselineStats(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidateDelta(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidateStats(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -950,6 +1420,54 @@ func (o *GetReportOKBodyRowsItems0MetricsAnon) ContextValidate(ctx context.Conte
 	return nil
 }
 
+func (o *GetReportOKBodyRowsItems0MetricsAnon) contextValidateBaselineStats(ctx context.Context, formats strfmt.Registry) error {
+	if o.BaselineStats != nil {
+
+		if swag.IsZero(o.BaselineStats) { // not required
+			return nil
+		}
+
+		if err := o.BaselineStats.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("baseline_stats")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("baseline_stats")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *GetReportOKBodyRowsItems0MetricsAnon) contextValidateDelta(ctx context.Context, formats strfmt.Registry) error {
+	if o.Delta != nil {
+
+		if swag.IsZero(o.Delta) { // not required
+			return nil
+		}
+
+		if err := o.Delta.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("delta")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("delta")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *GetReportOKBodyRowsItems0MetricsAnon) contextValidateStats(ctx context.Context, formats strfmt.Registry) error {
 	if o.Stats != nil {
 
@@ -992,6 +1510,105 @@ func (o *GetReportOKBodyRowsItems0MetricsAnon) UnmarshalBinary(b []byte) error {
 	return nil
 }
 
+/*
+GetReportOKBodyRowsItems0MetricsAnonBaselineStats Stat is statistics of specific metric.
+swagger:model GetReportOKBodyRowsItems0MetricsAnonBaselineStats
+*/
+type GetReportOKBodyRowsItems0MetricsAnonBaselineStats struct {
+	// rate
+	Rate float32 `json:"rate,omitempty"`
+
+	// cnt
+	Cnt float32 `json:"cnt,omitempty"`
+
+	// sum
+	Sum float32 `json:"sum,omitempty"`
+
+	// min
+	Min float32 `json:"min,omitempty"`
+
+	// max
+	Max float32 `json:"max,omitempty"`
+
+	// p99
+	P99 float32 `json:"p99,omitempty"`
+
+	// avg
+	Avg float32 `json:"avg,omitempty"`
+
+	// sum per sec
+	SumPerSec float32 `json:"sum_per_sec,omitempty"`
+}
+
+// Validate validates this get report OK body rows items0 metrics anon baseline stats
+func (o *GetReportOKBodyRowsItems0MetricsAnonBaselineStats) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this get report OK body rows items0 metrics anon baseline stats based on context it is used
+func (o *GetReportOKBodyRowsItems0MetricsAnonBaselineStats) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetReportOKBodyRowsItems0MetricsAnonBaselineStats) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetReportOKBodyRowsItems0MetricsAnonBaselineStats) UnmarshalBinary(b []byte) error {
+	var res GetReportOKBodyRowsItems0MetricsAnonBaselineStats
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+GetReportOKBodyRowsItems0MetricsAnonDelta MetricDelta is a change of the metric between the baseline and report periods.
+// Averages per query are compared for metrics that have them, and rates per second for others.
+swagger:model GetReportOKBodyRowsItems0MetricsAnonDelta
+*/
+type GetReportOKBodyRowsItems0MetricsAnonDelta struct {
+	// Report period value minus baseline period value.
+	Delta float32 `json:"delta,omitempty"`
+
+	// Report period value divided by baseline period value; 0 if the baseline value is 0.
+	Ratio float32 `json:"ratio,omitempty"`
+}
+
+// Validate validates this get report OK body rows items0 metrics anon delta
+func (o *GetReportOKBodyRowsItems0MetricsAnonDelta) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this get report OK body rows items0 metrics anon delta based on context it is used
+func (o *GetReportOKBodyRowsItems0MetricsAnonDelta) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetReportOKBodyRowsItems0MetricsAnonDelta) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetReportOKBodyRowsItems0MetricsAnonDelta) UnmarshalBinary(b []byte) error {
+	var res GetReportOKBodyRowsItems0MetricsAnonDelta
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
 /*
 GetReportOKBodyRowsItems0MetricsAnonStats Stat is statistics of specific metric.
 swagger:model GetReportOKBodyRowsItems0MetricsAnonStats
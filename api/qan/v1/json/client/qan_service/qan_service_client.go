@@ -3,6 +3,8 @@
 package qan_service
 
 import (
+	"io"
+
 	"github.com/go-openapi/runtime"
 	httptransport "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
@@ -51,8 +53,12 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	DiffQueryPlans(params *DiffQueryPlansParams, opts ...ClientOption) (*DiffQueryPlansOK, error)
+
 	ExplainFingerprintByQueryID(params *ExplainFingerprintByQueryIDParams, opts ...ClientOption) (*ExplainFingerprintByQueryIDOK, error)
 
+	ExportReport(params *ExportReportParams, writer io.Writer, opts ...ClientOption) (*ExportReportOK, error)
+
 	GetFilteredMetricsNames(params *GetFilteredMetricsNamesParams, opts ...ClientOption) (*GetFilteredMetricsNamesOK, error)
 
 	GetHistogram(params *GetHistogramParams, opts ...ClientOption) (*GetHistogramOK, error)
@@ -71,13 +77,69 @@ type ClientService interface {
 
 	HealthCheck(params *HealthCheckParams, opts ...ClientOption) (*HealthCheckOK, error)
 
+	ListIndexRecommendations(params *ListIndexRecommendationsParams, opts ...ClientOption) (*ListIndexRecommendationsOK, error)
+
+	ListQueryAnnotations(params *ListQueryAnnotationsParams, opts ...ClientOption) (*ListQueryAnnotationsOK, error)
+
+	ListQueryAnomalies(params *ListQueryAnomaliesParams, opts ...ClientOption) (*ListQueryAnomaliesOK, error)
+
+	ListQueryPlans(params *ListQueryPlansParams, opts ...ClientOption) (*ListQueryPlansOK, error)
+
 	QueryExists(params *QueryExistsParams, opts ...ClientOption) (*QueryExistsOK, error)
 
+	RemoveQueryAnnotation(params *RemoveQueryAnnotationParams, opts ...ClientOption) (*RemoveQueryAnnotationOK, error)
+
 	SchemaByQueryID(params *SchemaByQueryIDParams, opts ...ClientOption) (*SchemaByQueryIDOK, error)
 
+	SetQueryAnnotation(params *SetQueryAnnotationParams, opts ...ClientOption) (*SetQueryAnnotationOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+DiffQueryPlans diffs query plans
+
+Returns a unified diff between two normalized plans of the query.
+*/
+func (a *Client) DiffQueryPlans(params *DiffQueryPlansParams, opts ...ClientOption) (*DiffQueryPlansOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewDiffQueryPlansParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "DiffQueryPlans",
+		Method:             "POST",
+		PathPattern:        "/v1/qan/plans:diff",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &DiffQueryPlansReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*DiffQueryPlansOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*DiffQueryPlansDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 ExplainFingerprintByQueryID gets explain fingerprint
 
@@ -122,6 +184,50 @@ func (a *Client) ExplainFingerprintByQueryID(params *ExplainFingerprintByQueryID
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+ExportReport exports report
+
+Streams metrics grouped by queryid or other dimensions in CSV, JSON Lines or Parquet format, without pagination.
+*/
+func (a *Client) ExportReport(params *ExportReportParams, writer io.Writer, opts ...ClientOption) (*ExportReportOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewExportReportParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ExportReport",
+		Method:             "POST",
+		PathPattern:        "/v1/qan/metrics:export",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ExportReportReader{formats: a.formats, writer: writer},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ExportReportOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*ExportReportDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GetFilteredMetricsNames gets filters
 
@@ -518,6 +624,182 @@ func (a *Client) HealthCheck(params *HealthCheckParams, opts ...ClientOption) (*
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+ListIndexRecommendations lists index recommendations
+
+Returns the latest candidate indexes for query classes with the QAN metrics and existing indexes they are based on.
+*/
+func (a *Client) ListIndexRecommendations(params *ListIndexRecommendationsParams, opts ...ClientOption) (*ListIndexRecommendationsOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewListIndexRecommendationsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ListIndexRecommendations",
+		Method:             "POST",
+		PathPattern:        "/v1/qan/recommendations:list",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListIndexRecommendationsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ListIndexRecommendationsOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*ListIndexRecommendationsDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ListQueryAnnotations lists query annotations
+
+Returns query annotations.
+*/
+func (a *Client) ListQueryAnnotations(params *ListQueryAnnotationsParams, opts ...ClientOption) (*ListQueryAnnotationsOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewListQueryAnnotationsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ListQueryAnnotations",
+		Method:             "POST",
+		PathPattern:        "/v1/qan/annotations:list",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListQueryAnnotationsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ListQueryAnnotationsOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*ListQueryAnnotationsDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ListQueryAnomalies lists query anomalies
+
+Returns query regressions and plan flips found by the last anomaly detection run.
+*/
+func (a *Client) ListQueryAnomalies(params *ListQueryAnomaliesParams, opts ...ClientOption) (*ListQueryAnomaliesOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewListQueryAnomaliesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ListQueryAnomalies",
+		Method:             "POST",
+		PathPattern:        "/v1/qan/anomalies:list",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListQueryAnomaliesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ListQueryAnomaliesOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*ListQueryAnomaliesDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+ListQueryPlans lists query plans
+
+Returns plan versions of the query ordered by time, so plan changes can be correlated with latency changes.
+*/
+func (a *Client) ListQueryPlans(params *ListQueryPlansParams, opts ...ClientOption) (*ListQueryPlansOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewListQueryPlansParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ListQueryPlans",
+		Method:             "POST",
+		PathPattern:        "/v1/qan/plans:list",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListQueryPlansReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ListQueryPlansOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*ListQueryPlansDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 QueryExists checks query existence
 
@@ -562,6 +844,50 @@ func (a *Client) QueryExists(params *QueryExistsParams, opts ...ClientOption) (*
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+RemoveQueryAnnotation removes query annotation
+
+Removes the annotation of the query.
+*/
+func (a *Client) RemoveQueryAnnotation(params *RemoveQueryAnnotationParams, opts ...ClientOption) (*RemoveQueryAnnotationOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewRemoveQueryAnnotationParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "RemoveQueryAnnotation",
+		Method:             "POST",
+		PathPattern:        "/v1/qan/annotations:remove",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &RemoveQueryAnnotationReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*RemoveQueryAnnotationOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*RemoveQueryAnnotationDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 SchemaByQueryID gets schema
 
@@ -606,6 +932,50 @@ func (a *Client) SchemaByQueryID(params *SchemaByQueryIDParams, opts ...ClientOp
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+SetQueryAnnotation sets query annotation
+
+Creates or replaces the owner, ticket link, acknowledgement and notes of the query.
+*/
+func (a *Client) SetQueryAnnotation(params *SetQueryAnnotationParams, opts ...ClientOption) (*SetQueryAnnotationOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewSetQueryAnnotationParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "SetQueryAnnotation",
+		Method:             "POST",
+		PathPattern:        "/v1/qan/annotations:set",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &SetQueryAnnotationReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*SetQueryAnnotationOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*SetQueryAnnotationDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
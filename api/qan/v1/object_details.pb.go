@@ -123,13 +123,15 @@ func (x *GetMetricsRequest) GetTotals() bool {
 
 // GetMetricsResponse defines metrics for specific value of dimension (ex.: host=hostname1 or queryid=1D410B4BE5060972.
 type GetMetricsResponse struct {
-	state         protoimpl.MessageState            `protogen:"open.v1"`
-	Metrics       map[string]*MetricValues          `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	TextMetrics   map[string]string                 `protobuf:"bytes,7,rep,name=text_metrics,json=textMetrics,proto3" json:"text_metrics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Sparkline     []*Point                          `protobuf:"bytes,4,rep,name=sparkline,proto3" json:"sparkline,omitempty"`
-	Totals        map[string]*MetricValues          `protobuf:"bytes,5,rep,name=totals,proto3" json:"totals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Fingerprint   string                            `protobuf:"bytes,6,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	Metadata      *GetSelectedQueryMetadataResponse `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	state       protoimpl.MessageState            `protogen:"open.v1"`
+	Metrics     map[string]*MetricValues          `protobuf:"bytes,3,rep,name=metrics,proto3" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	TextMetrics map[string]string                 `protobuf:"bytes,7,rep,name=text_metrics,json=textMetrics,proto3" json:"text_metrics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Sparkline   []*Point                          `protobuf:"bytes,4,rep,name=sparkline,proto3" json:"sparkline,omitempty"`
+	Totals      map[string]*MetricValues          `protobuf:"bytes,5,rep,name=totals,proto3" json:"totals,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Fingerprint string                            `protobuf:"bytes,6,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	Metadata    *GetSelectedQueryMetadataResponse `protobuf:"bytes,8,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// Annotation of the query, set only when filtered by queryid.
+	Annotation    *QueryAnnotation `protobuf:"bytes,9,opt,name=annotation,proto3" json:"annotation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -206,6 +208,13 @@ func (x *GetMetricsResponse) GetMetadata() *GetSelectedQueryMetadataResponse {
 	return nil
 }
 
+func (x *GetMetricsResponse) GetAnnotation() *QueryAnnotation {
+	if x != nil {
+		return x.Annotation
+	}
+	return nil
+}
+
 // MetricValues is statistics of specific metric.
 type MetricValues struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
@@ -1476,7 +1485,7 @@ var File_qan_v1_object_details_proto protoreflect.FileDescriptor
 
 const file_qan_v1_object_details_proto_rawDesc = "" +
 	"\n" +
-	"\x1bqan/v1/object_details.proto\x12\x06qan.v1\x1a\x1aextensions/v1/redact.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x10qan/v1/qan.proto\"\xce\x02\n" +
+	"\x1bqan/v1/object_details.proto\x12\x06qan.v1\x1a\x1aextensions/v1/redact.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18qan/v1/annotations.proto\x1a\x10qan/v1/qan.proto\"\xce\x02\n" +
 	"\x11GetMetricsRequest\x12F\n" +
 	"\x11period_start_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x0fperiodStartFrom\x12B\n" +
 	"\x0fperiod_start_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rperiodStartTo\x12\x1b\n" +
@@ -1484,14 +1493,17 @@ const file_qan_v1_object_details_proto_rawDesc = "" +
 	"\bgroup_by\x18\x04 \x01(\tR\agroupBy\x12-\n" +
 	"\x06labels\x18\x05 \x03(\v2\x15.qan.v1.MapFieldEntryR\x06labels\x12.\n" +
 	"\x13include_only_fields\x18\x06 \x03(\tR\x11includeOnlyFields\x12\x16\n" +
-	"\x06totals\x18\a \x01(\bR\x06totals\"\xdf\x04\n" +
+	"\x06totals\x18\a \x01(\bR\x06totals\"\x98\x05\n" +
 	"\x12GetMetricsResponse\x12A\n" +
 	"\ametrics\x18\x03 \x03(\v2'.qan.v1.GetMetricsResponse.MetricsEntryR\ametrics\x12N\n" +
 	"\ftext_metrics\x18\a \x03(\v2+.qan.v1.GetMetricsResponse.TextMetricsEntryR\vtextMetrics\x12+\n" +
 	"\tsparkline\x18\x04 \x03(\v2\r.qan.v1.PointR\tsparkline\x12>\n" +
 	"\x06totals\x18\x05 \x03(\v2&.qan.v1.GetMetricsResponse.TotalsEntryR\x06totals\x12 \n" +
 	"\vfingerprint\x18\x06 \x01(\tR\vfingerprint\x12D\n" +
-	"\bmetadata\x18\b \x01(\v2(.qan.v1.GetSelectedQueryMetadataResponseR\bmetadata\x1aP\n" +
+	"\bmetadata\x18\b \x01(\v2(.qan.v1.GetSelectedQueryMetadataResponseR\bmetadata\x127\n" +
+	"\n" +
+	"annotation\x18\t \x01(\v2\x17.qan.v1.QueryAnnotationR\n" +
+	"annotation\x1aP\n" +
 	"\fMetricsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12*\n" +
 	"\x05value\x18\x02 \x01(\v2\x14.qan.v1.MetricValuesR\x05value:\x028\x01\x1a>\n" +
@@ -1642,7 +1654,8 @@ var (
 		(*timestamppb.Timestamp)(nil),               // 26: google.protobuf.Timestamp
 		(*MapFieldEntry)(nil),                       // 27: qan.v1.MapFieldEntry
 		(*Point)(nil),                               // 28: qan.v1.Point
-		ExampleType(0),                              // 29: qan.v1.ExampleType
+		(*QueryAnnotation)(nil),                     // 29: qan.v1.QueryAnnotation
+		ExampleType(0),                              // 30: qan.v1.ExampleType
 	}
 )
 
@@ -1655,26 +1668,27 @@ var file_qan_v1_object_details_proto_depIdxs = []int32{
 	28, // 5: qan.v1.GetMetricsResponse.sparkline:type_name -> qan.v1.Point
 	24, // 6: qan.v1.GetMetricsResponse.totals:type_name -> qan.v1.GetMetricsResponse.TotalsEntry
 	21, // 7: qan.v1.GetMetricsResponse.metadata:type_name -> qan.v1.GetSelectedQueryMetadataResponse
-	26, // 8: qan.v1.GetQueryExampleRequest.period_start_from:type_name -> google.protobuf.Timestamp
-	26, // 9: qan.v1.GetQueryExampleRequest.period_start_to:type_name -> google.protobuf.Timestamp
-	27, // 10: qan.v1.GetQueryExampleRequest.labels:type_name -> qan.v1.MapFieldEntry
-	6,  // 11: qan.v1.GetQueryExampleResponse.query_examples:type_name -> qan.v1.QueryExample
-	29, // 12: qan.v1.QueryExample.example_type:type_name -> qan.v1.ExampleType
-	26, // 13: qan.v1.GetLabelsRequest.period_start_from:type_name -> google.protobuf.Timestamp
-	26, // 14: qan.v1.GetLabelsRequest.period_start_to:type_name -> google.protobuf.Timestamp
-	25, // 15: qan.v1.GetLabelsResponse.labels:type_name -> qan.v1.GetLabelsResponse.LabelsEntry
-	26, // 16: qan.v1.GetHistogramRequest.period_start_from:type_name -> google.protobuf.Timestamp
-	26, // 17: qan.v1.GetHistogramRequest.period_start_to:type_name -> google.protobuf.Timestamp
-	27, // 18: qan.v1.GetHistogramRequest.labels:type_name -> qan.v1.MapFieldEntry
-	14, // 19: qan.v1.GetHistogramResponse.histogram_items:type_name -> qan.v1.HistogramItem
-	2,  // 20: qan.v1.GetMetricsResponse.MetricsEntry.value:type_name -> qan.v1.MetricValues
-	2,  // 21: qan.v1.GetMetricsResponse.TotalsEntry.value:type_name -> qan.v1.MetricValues
-	9,  // 22: qan.v1.GetLabelsResponse.LabelsEntry.value:type_name -> qan.v1.ListLabelValues
-	23, // [23:23] is the sub-list for method output_type
-	23, // [23:23] is the sub-list for method input_type
-	23, // [23:23] is the sub-list for extension type_name
-	23, // [23:23] is the sub-list for extension extendee
-	0,  // [0:23] is the sub-list for field type_name
+	29, // 8: qan.v1.GetMetricsResponse.annotation:type_name -> qan.v1.QueryAnnotation
+	26, // 9: qan.v1.GetQueryExampleRequest.period_start_from:type_name -> google.protobuf.Timestamp
+	26, // 10: qan.v1.GetQueryExampleRequest.period_start_to:type_name -> google.protobuf.Timestamp
+	27, // 11: qan.v1.GetQueryExampleRequest.labels:type_name -> qan.v1.MapFieldEntry
+	6,  // 12: qan.v1.GetQueryExampleResponse.query_examples:type_name -> qan.v1.QueryExample
+	30, // 13: qan.v1.QueryExample.example_type:type_name -> qan.v1.ExampleType
+	26, // 14: qan.v1.GetLabelsRequest.period_start_from:type_name -> google.protobuf.Timestamp
+	26, // 15: qan.v1.GetLabelsRequest.period_start_to:type_name -> google.protobuf.Timestamp
+	25, // 16: qan.v1.GetLabelsResponse.labels:type_name -> qan.v1.GetLabelsResponse.LabelsEntry
+	26, // 17: qan.v1.GetHistogramRequest.period_start_from:type_name -> google.protobuf.Timestamp
+	26, // 18: qan.v1.GetHistogramRequest.period_start_to:type_name -> google.protobuf.Timestamp
+	27, // 19: qan.v1.GetHistogramRequest.labels:type_name -> qan.v1.MapFieldEntry
+	14, // 20: qan.v1.GetHistogramResponse.histogram_items:type_name -> qan.v1.HistogramItem
+	2,  // 21: qan.v1.GetMetricsResponse.MetricsEntry.value:type_name -> qan.v1.MetricValues
+	2,  // 22: qan.v1.GetMetricsResponse.TotalsEntry.value:type_name -> qan.v1.MetricValues
+	9,  // 23: qan.v1.GetLabelsResponse.LabelsEntry.value:type_name -> qan.v1.ListLabelValues
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_qan_v1_object_details_proto_init() }
@@ -1682,6 +1696,7 @@ func file_qan_v1_object_details_proto_init() {
 	if File_qan_v1_object_details_proto != nil {
 		return
 	}
+	file_qan_v1_annotations_proto_init()
 	file_qan_v1_qan_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
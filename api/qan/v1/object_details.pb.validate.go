@@ -417,6 +417,35 @@ func (m *GetMetricsResponse) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetAnnotation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetMetricsResponseValidationError{
+					field:  "Annotation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetMetricsResponseValidationError{
+					field:  "Annotation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnotation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetMetricsResponseValidationError{
+				field:  "Annotation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return GetMetricsResponseMultiError(errors)
 	}
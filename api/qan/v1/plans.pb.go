@@ -0,0 +1,469 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: qan/v1/plans.proto
+
+package qanv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// QueryPlanVersion is a plan of the query observed between two points in time.
+// Consecutive samples of the same normalized plan are collapsed into a single version,
+// so a new version means a plan change.
+type QueryPlanVersion struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Hash of the normalized plan.
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// Normalized plan in JSON format.
+	Plan string `protobuf:"bytes,2,opt,name=plan,proto3" json:"plan,omitempty"`
+	// Time when the plan was collected for the first time in this version.
+	FirstSeen *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=first_seen,json=firstSeen,proto3" json:"first_seen,omitempty"`
+	// Time when the plan was collected for the last time in this version.
+	LastSeen *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	// Number of times the plan was collected in this version.
+	Samples       uint32 `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPlanVersion) Reset() {
+	*x = QueryPlanVersion{}
+	mi := &file_qan_v1_plans_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPlanVersion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPlanVersion) ProtoMessage() {}
+
+func (x *QueryPlanVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_plans_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPlanVersion.ProtoReflect.Descriptor instead.
+func (*QueryPlanVersion) Descriptor() ([]byte, []int) {
+	return file_qan_v1_plans_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *QueryPlanVersion) GetPlanId() string {
+	if x != nil {
+		return x.PlanId
+	}
+	return ""
+}
+
+func (x *QueryPlanVersion) GetPlan() string {
+	if x != nil {
+		return x.Plan
+	}
+	return ""
+}
+
+func (x *QueryPlanVersion) GetFirstSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FirstSeen
+	}
+	return nil
+}
+
+func (x *QueryPlanVersion) GetLastSeen() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeen
+	}
+	return nil
+}
+
+func (x *QueryPlanVersion) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+// ListQueryPlansRequest defines the query and the period to return plan versions for.
+type ListQueryPlansRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId       string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Queryid         string                 `protobuf:"bytes,2,opt,name=queryid,proto3" json:"queryid,omitempty"`
+	PeriodStartFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=period_start_from,json=periodStartFrom,proto3" json:"period_start_from,omitempty"`
+	PeriodStartTo   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=period_start_to,json=periodStartTo,proto3" json:"period_start_to,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListQueryPlansRequest) Reset() {
+	*x = ListQueryPlansRequest{}
+	mi := &file_qan_v1_plans_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueryPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueryPlansRequest) ProtoMessage() {}
+
+func (x *ListQueryPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_plans_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueryPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListQueryPlansRequest) Descriptor() ([]byte, []int) {
+	return file_qan_v1_plans_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListQueryPlansRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ListQueryPlansRequest) GetQueryid() string {
+	if x != nil {
+		return x.Queryid
+	}
+	return ""
+}
+
+func (x *ListQueryPlansRequest) GetPeriodStartFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStartFrom
+	}
+	return nil
+}
+
+func (x *ListQueryPlansRequest) GetPeriodStartTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStartTo
+	}
+	return nil
+}
+
+// ListQueryPlansResponse is a list of plan versions ordered by time.
+type ListQueryPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ServiceName   string                 `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	ServiceType   string                 `protobuf:"bytes,3,opt,name=service_type,json=serviceType,proto3" json:"service_type,omitempty"`
+	Queryid       string                 `protobuf:"bytes,4,opt,name=queryid,proto3" json:"queryid,omitempty"`
+	Versions      []*QueryPlanVersion    `protobuf:"bytes,5,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListQueryPlansResponse) Reset() {
+	*x = ListQueryPlansResponse{}
+	mi := &file_qan_v1_plans_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListQueryPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListQueryPlansResponse) ProtoMessage() {}
+
+func (x *ListQueryPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_plans_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListQueryPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListQueryPlansResponse) Descriptor() ([]byte, []int) {
+	return file_qan_v1_plans_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListQueryPlansResponse) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ListQueryPlansResponse) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *ListQueryPlansResponse) GetServiceType() string {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ""
+}
+
+func (x *ListQueryPlansResponse) GetQueryid() string {
+	if x != nil {
+		return x.Queryid
+	}
+	return ""
+}
+
+func (x *ListQueryPlansResponse) GetVersions() []*QueryPlanVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+// DiffQueryPlansRequest defines two plans of the query to compare.
+type DiffQueryPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId     string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	Queryid       string                 `protobuf:"bytes,2,opt,name=queryid,proto3" json:"queryid,omitempty"`
+	FromPlanId    string                 `protobuf:"bytes,3,opt,name=from_plan_id,json=fromPlanId,proto3" json:"from_plan_id,omitempty"`
+	ToPlanId      string                 `protobuf:"bytes,4,opt,name=to_plan_id,json=toPlanId,proto3" json:"to_plan_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffQueryPlansRequest) Reset() {
+	*x = DiffQueryPlansRequest{}
+	mi := &file_qan_v1_plans_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffQueryPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffQueryPlansRequest) ProtoMessage() {}
+
+func (x *DiffQueryPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_plans_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffQueryPlansRequest.ProtoReflect.Descriptor instead.
+func (*DiffQueryPlansRequest) Descriptor() ([]byte, []int) {
+	return file_qan_v1_plans_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DiffQueryPlansRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *DiffQueryPlansRequest) GetQueryid() string {
+	if x != nil {
+		return x.Queryid
+	}
+	return ""
+}
+
+func (x *DiffQueryPlansRequest) GetFromPlanId() string {
+	if x != nil {
+		return x.FromPlanId
+	}
+	return ""
+}
+
+func (x *DiffQueryPlansRequest) GetToPlanId() string {
+	if x != nil {
+		return x.ToPlanId
+	}
+	return ""
+}
+
+// DiffQueryPlansResponse contains the difference between two plans.
+type DiffQueryPlansResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Unified diff of normalized plans.
+	Diff          string `protobuf:"bytes,1,opt,name=diff,proto3" json:"diff,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiffQueryPlansResponse) Reset() {
+	*x = DiffQueryPlansResponse{}
+	mi := &file_qan_v1_plans_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiffQueryPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiffQueryPlansResponse) ProtoMessage() {}
+
+func (x *DiffQueryPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_plans_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiffQueryPlansResponse.ProtoReflect.Descriptor instead.
+func (*DiffQueryPlansResponse) Descriptor() ([]byte, []int) {
+	return file_qan_v1_plans_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DiffQueryPlansResponse) GetDiff() string {
+	if x != nil {
+		return x.Diff
+	}
+	return ""
+}
+
+var File_qan_v1_plans_proto protoreflect.FileDescriptor
+
+const file_qan_v1_plans_proto_rawDesc = "" +
+	"\n" +
+	"\x12qan/v1/plans.proto\x12\x06qan.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xcd\x01\n" +
+	"\x10QueryPlanVersion\x12\x17\n" +
+	"\aplan_id\x18\x01 \x01(\tR\x06planId\x12\x12\n" +
+	"\x04plan\x18\x02 \x01(\tR\x04plan\x129\n" +
+	"\n" +
+	"first_seen\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tfirstSeen\x127\n" +
+	"\tlast_seen\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\blastSeen\x12\x18\n" +
+	"\asamples\x18\x05 \x01(\rR\asamples\"\xdc\x01\n" +
+	"\x15ListQueryPlansRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x18\n" +
+	"\aqueryid\x18\x02 \x01(\tR\aqueryid\x12F\n" +
+	"\x11period_start_from\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x0fperiodStartFrom\x12B\n" +
+	"\x0fperiod_start_to\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\rperiodStartTo\"\xcd\x01\n" +
+	"\x16ListQueryPlansResponse\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12!\n" +
+	"\fservice_name\x18\x02 \x01(\tR\vserviceName\x12!\n" +
+	"\fservice_type\x18\x03 \x01(\tR\vserviceType\x12\x18\n" +
+	"\aqueryid\x18\x04 \x01(\tR\aqueryid\x124\n" +
+	"\bversions\x18\x05 \x03(\v2\x18.qan.v1.QueryPlanVersionR\bversions\"\x90\x01\n" +
+	"\x15DiffQueryPlansRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x18\n" +
+	"\aqueryid\x18\x02 \x01(\tR\aqueryid\x12 \n" +
+	"\ffrom_plan_id\x18\x03 \x01(\tR\n" +
+	"fromPlanId\x12\x1c\n" +
+	"\n" +
+	"to_plan_id\x18\x04 \x01(\tR\btoPlanId\",\n" +
+	"\x16DiffQueryPlansResponse\x12\x12\n" +
+	"\x04diff\x18\x01 \x01(\tR\x04diffBz\n" +
+	"\n" +
+	"com.qan.v1B\n" +
+	"PlansProtoP\x01Z'github.com/percona/pmm/api/qan/v1;qanv1\xa2\x02\x03QXX\xaa\x02\x06Qan.V1\xca\x02\x06Qan\\V1\xe2\x02\x12Qan\\V1\\GPBMetadata\xea\x02\aQan::V1b\x06proto3"
+
+var (
+	file_qan_v1_plans_proto_rawDescOnce sync.Once
+	file_qan_v1_plans_proto_rawDescData []byte
+)
+
+func file_qan_v1_plans_proto_rawDescGZIP() []byte {
+	file_qan_v1_plans_proto_rawDescOnce.Do(func() {
+		file_qan_v1_plans_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_qan_v1_plans_proto_rawDesc), len(file_qan_v1_plans_proto_rawDesc)))
+	})
+	return file_qan_v1_plans_proto_rawDescData
+}
+
+var (
+	file_qan_v1_plans_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+	file_qan_v1_plans_proto_goTypes  = []any{
+		(*QueryPlanVersion)(nil),       // 0: qan.v1.QueryPlanVersion
+		(*ListQueryPlansRequest)(nil),  // 1: qan.v1.ListQueryPlansRequest
+		(*ListQueryPlansResponse)(nil), // 2: qan.v1.ListQueryPlansResponse
+		(*DiffQueryPlansRequest)(nil),  // 3: qan.v1.DiffQueryPlansRequest
+		(*DiffQueryPlansResponse)(nil), // 4: qan.v1.DiffQueryPlansResponse
+		(*timestamppb.Timestamp)(nil),  // 5: google.protobuf.Timestamp
+	}
+)
+
+var file_qan_v1_plans_proto_depIdxs = []int32{
+	5, // 0: qan.v1.QueryPlanVersion.first_seen:type_name -> google.protobuf.Timestamp
+	5, // 1: qan.v1.QueryPlanVersion.last_seen:type_name -> google.protobuf.Timestamp
+	5, // 2: qan.v1.ListQueryPlansRequest.period_start_from:type_name -> google.protobuf.Timestamp
+	5, // 3: qan.v1.ListQueryPlansRequest.period_start_to:type_name -> google.protobuf.Timestamp
+	0, // 4: qan.v1.ListQueryPlansResponse.versions:type_name -> qan.v1.QueryPlanVersion
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_qan_v1_plans_proto_init() }
+func file_qan_v1_plans_proto_init() {
+	if File_qan_v1_plans_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_qan_v1_plans_proto_rawDesc), len(file_qan_v1_plans_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_qan_v1_plans_proto_goTypes,
+		DependencyIndexes: file_qan_v1_plans_proto_depIdxs,
+		MessageInfos:      file_qan_v1_plans_proto_msgTypes,
+	}.Build()
+	File_qan_v1_plans_proto = out.File
+	file_qan_v1_plans_proto_goTypes = nil
+	file_qan_v1_plans_proto_depIdxs = nil
+}
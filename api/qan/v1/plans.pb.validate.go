@@ -0,0 +1,727 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: qan/v1/plans.proto
+
+package qanv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on QueryPlanVersion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *QueryPlanVersion) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryPlanVersion with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// QueryPlanVersionMultiError, or nil if none found.
+func (m *QueryPlanVersion) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryPlanVersion) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PlanId
+
+	// no validation rules for Plan
+
+	if all {
+		switch v := interface{}(m.GetFirstSeen()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryPlanVersionValidationError{
+					field:  "FirstSeen",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryPlanVersionValidationError{
+					field:  "FirstSeen",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFirstSeen()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryPlanVersionValidationError{
+				field:  "FirstSeen",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetLastSeen()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryPlanVersionValidationError{
+					field:  "LastSeen",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryPlanVersionValidationError{
+					field:  "LastSeen",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLastSeen()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryPlanVersionValidationError{
+				field:  "LastSeen",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Samples
+
+	if len(errors) > 0 {
+		return QueryPlanVersionMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryPlanVersionMultiError is an error wrapping multiple validation errors
+// returned by QueryPlanVersion.ValidateAll() if the designated constraints
+// aren't met.
+type QueryPlanVersionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryPlanVersionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryPlanVersionMultiError) AllErrors() []error { return m }
+
+// QueryPlanVersionValidationError is the validation error returned by
+// QueryPlanVersion.Validate if the designated constraints aren't met.
+type QueryPlanVersionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryPlanVersionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryPlanVersionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryPlanVersionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryPlanVersionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryPlanVersionValidationError) ErrorName() string { return "QueryPlanVersionValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueryPlanVersionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryPlanVersion.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = QueryPlanVersionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryPlanVersionValidationError{}
+
+// Validate checks the field values on ListQueryPlansRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQueryPlansRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQueryPlansRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQueryPlansRequestMultiError, or nil if none found.
+func (m *ListQueryPlansRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQueryPlansRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceId
+
+	// no validation rules for Queryid
+
+	if all {
+		switch v := interface{}(m.GetPeriodStartFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListQueryPlansRequestValidationError{
+					field:  "PeriodStartFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListQueryPlansRequestValidationError{
+					field:  "PeriodStartFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodStartFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListQueryPlansRequestValidationError{
+				field:  "PeriodStartFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPeriodStartTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListQueryPlansRequestValidationError{
+					field:  "PeriodStartTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListQueryPlansRequestValidationError{
+					field:  "PeriodStartTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodStartTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListQueryPlansRequestValidationError{
+				field:  "PeriodStartTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListQueryPlansRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQueryPlansRequestMultiError is an error wrapping multiple validation
+// errors returned by ListQueryPlansRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListQueryPlansRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQueryPlansRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQueryPlansRequestMultiError) AllErrors() []error { return m }
+
+// ListQueryPlansRequestValidationError is the validation error returned by
+// ListQueryPlansRequest.Validate if the designated constraints aren't met.
+type ListQueryPlansRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueryPlansRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueryPlansRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueryPlansRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueryPlansRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueryPlansRequestValidationError) ErrorName() string {
+	return "ListQueryPlansRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueryPlansRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueryPlansRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ListQueryPlansRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueryPlansRequestValidationError{}
+
+// Validate checks the field values on ListQueryPlansResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListQueryPlansResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListQueryPlansResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListQueryPlansResponseMultiError, or nil if none found.
+func (m *ListQueryPlansResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListQueryPlansResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceId
+
+	// no validation rules for ServiceName
+
+	// no validation rules for ServiceType
+
+	// no validation rules for Queryid
+
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListQueryPlansResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListQueryPlansResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListQueryPlansResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListQueryPlansResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListQueryPlansResponseMultiError is an error wrapping multiple validation
+// errors returned by ListQueryPlansResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListQueryPlansResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListQueryPlansResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListQueryPlansResponseMultiError) AllErrors() []error { return m }
+
+// ListQueryPlansResponseValidationError is the validation error returned by
+// ListQueryPlansResponse.Validate if the designated constraints aren't met.
+type ListQueryPlansResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListQueryPlansResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListQueryPlansResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListQueryPlansResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListQueryPlansResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListQueryPlansResponseValidationError) ErrorName() string {
+	return "ListQueryPlansResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListQueryPlansResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListQueryPlansResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ListQueryPlansResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListQueryPlansResponseValidationError{}
+
+// Validate checks the field values on DiffQueryPlansRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffQueryPlansRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffQueryPlansRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffQueryPlansRequestMultiError, or nil if none found.
+func (m *DiffQueryPlansRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffQueryPlansRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceId
+
+	// no validation rules for Queryid
+
+	// no validation rules for FromPlanId
+
+	// no validation rules for ToPlanId
+
+	if len(errors) > 0 {
+		return DiffQueryPlansRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffQueryPlansRequestMultiError is an error wrapping multiple validation
+// errors returned by DiffQueryPlansRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DiffQueryPlansRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffQueryPlansRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffQueryPlansRequestMultiError) AllErrors() []error { return m }
+
+// DiffQueryPlansRequestValidationError is the validation error returned by
+// DiffQueryPlansRequest.Validate if the designated constraints aren't met.
+type DiffQueryPlansRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffQueryPlansRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffQueryPlansRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffQueryPlansRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffQueryPlansRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffQueryPlansRequestValidationError) ErrorName() string {
+	return "DiffQueryPlansRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffQueryPlansRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffQueryPlansRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = DiffQueryPlansRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffQueryPlansRequestValidationError{}
+
+// Validate checks the field values on DiffQueryPlansResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DiffQueryPlansResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DiffQueryPlansResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DiffQueryPlansResponseMultiError, or nil if none found.
+func (m *DiffQueryPlansResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DiffQueryPlansResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Diff
+
+	if len(errors) > 0 {
+		return DiffQueryPlansResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// DiffQueryPlansResponseMultiError is an error wrapping multiple validation
+// errors returned by DiffQueryPlansResponse.ValidateAll() if the designated
+// constraints aren't met.
+type DiffQueryPlansResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DiffQueryPlansResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DiffQueryPlansResponseMultiError) AllErrors() []error { return m }
+
+// DiffQueryPlansResponseValidationError is the validation error returned by
+// DiffQueryPlansResponse.Validate if the designated constraints aren't met.
+type DiffQueryPlansResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DiffQueryPlansResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DiffQueryPlansResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DiffQueryPlansResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DiffQueryPlansResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DiffQueryPlansResponseValidationError) ErrorName() string {
+	return "DiffQueryPlansResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DiffQueryPlansResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDiffQueryPlansResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = DiffQueryPlansResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DiffQueryPlansResponseValidationError{}
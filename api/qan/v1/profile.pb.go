@@ -13,6 +13,7 @@ import (
 
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -23,6 +24,118 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// ExportFormat is a format of exported report data.
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	// Comma-separated values with a header row.
+	ExportFormat_EXPORT_FORMAT_CSV ExportFormat = 1
+	// JSON Lines: a JSON object per row.
+	ExportFormat_EXPORT_FORMAT_JSONL ExportFormat = 2
+	// Apache Parquet.
+	ExportFormat_EXPORT_FORMAT_PARQUET ExportFormat = 3
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_JSONL",
+		3: "EXPORT_FORMAT_PARQUET",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_JSONL":       2,
+		"EXPORT_FORMAT_PARQUET":     3,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_qan_v1_profile_proto_enumTypes[0].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_qan_v1_profile_proto_enumTypes[0]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_qan_v1_profile_proto_rawDescGZIP(), []int{0}
+}
+
+// ComparisonStatus shows whether the row is present in the report and baseline periods.
+type ComparisonStatus int32
+
+const (
+	ComparisonStatus_COMPARISON_STATUS_UNSPECIFIED ComparisonStatus = 0
+	// Present in both periods.
+	ComparisonStatus_COMPARISON_STATUS_PRESENT ComparisonStatus = 1
+	// Present only in the report period.
+	ComparisonStatus_COMPARISON_STATUS_NEW ComparisonStatus = 2
+	// Present only in the baseline period.
+	ComparisonStatus_COMPARISON_STATUS_DISAPPEARED ComparisonStatus = 3
+)
+
+// Enum value maps for ComparisonStatus.
+var (
+	ComparisonStatus_name = map[int32]string{
+		0: "COMPARISON_STATUS_UNSPECIFIED",
+		1: "COMPARISON_STATUS_PRESENT",
+		2: "COMPARISON_STATUS_NEW",
+		3: "COMPARISON_STATUS_DISAPPEARED",
+	}
+	ComparisonStatus_value = map[string]int32{
+		"COMPARISON_STATUS_UNSPECIFIED": 0,
+		"COMPARISON_STATUS_PRESENT":     1,
+		"COMPARISON_STATUS_NEW":         2,
+		"COMPARISON_STATUS_DISAPPEARED": 3,
+	}
+)
+
+func (x ComparisonStatus) Enum() *ComparisonStatus {
+	p := new(ComparisonStatus)
+	*p = x
+	return p
+}
+
+func (x ComparisonStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ComparisonStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_qan_v1_profile_proto_enumTypes[1].Descriptor()
+}
+
+func (ComparisonStatus) Type() protoreflect.EnumType {
+	return &file_qan_v1_profile_proto_enumTypes[1]
+}
+
+func (x ComparisonStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ComparisonStatus.Descriptor instead.
+func (ComparisonStatus) EnumDescriptor() ([]byte, []int) {
+	return file_qan_v1_profile_proto_rawDescGZIP(), []int{1}
+}
+
 // ReportRequest defines filtering of metrics report for db server or other dimentions.
 type GetReportRequest struct {
 	state           protoimpl.MessageState `protogen:"open.v1"`
@@ -36,8 +149,16 @@ type GetReportRequest struct {
 	Limit           uint32                 `protobuf:"varint,8,opt,name=limit,proto3" json:"limit,omitempty"`
 	MainMetric      string                 `protobuf:"bytes,9,opt,name=main_metric,json=mainMetric,proto3" json:"main_metric,omitempty"`
 	Search          string                 `protobuf:"bytes,10,opt,name=search,proto3" json:"search,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// Start of the baseline period to compare the report period with.
+	// Comparison is enabled when both baseline period bounds are set.
+	BaselinePeriodStartFrom *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=baseline_period_start_from,json=baselinePeriodStartFrom,proto3" json:"baseline_period_start_from,omitempty"`
+	// End of the baseline period.
+	BaselinePeriodStartTo *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=baseline_period_start_to,json=baselinePeriodStartTo,proto3" json:"baseline_period_start_to,omitempty"`
+	// Order rows by the change of the order column between the baseline and report periods
+	// instead of its value, so regressions can be ranked directly. Requires the baseline period.
+	OrderByDelta  bool `protobuf:"varint,13,opt,name=order_by_delta,json=orderByDelta,proto3" json:"order_by_delta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetReportRequest) Reset() {
@@ -140,6 +261,130 @@ func (x *GetReportRequest) GetSearch() string {
 	return ""
 }
 
+func (x *GetReportRequest) GetBaselinePeriodStartFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BaselinePeriodStartFrom
+	}
+	return nil
+}
+
+func (x *GetReportRequest) GetBaselinePeriodStartTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BaselinePeriodStartTo
+	}
+	return nil
+}
+
+func (x *GetReportRequest) GetOrderByDelta() bool {
+	if x != nil {
+		return x.OrderByDelta
+	}
+	return false
+}
+
+// ExportReportRequest defines filtering and format of exported metrics report.
+type ExportReportRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PeriodStartFrom *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=period_start_from,json=periodStartFrom,proto3" json:"period_start_from,omitempty"`
+	PeriodStartTo   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=period_start_to,json=periodStartTo,proto3" json:"period_start_to,omitempty"`
+	GroupBy         string                 `protobuf:"bytes,3,opt,name=group_by,json=groupBy,proto3" json:"group_by,omitempty"`
+	Labels          []*ReportMapFieldEntry `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty"`
+	Columns         []string               `protobuf:"bytes,5,rep,name=columns,proto3" json:"columns,omitempty"`
+	Search          string                 `protobuf:"bytes,6,opt,name=search,proto3" json:"search,omitempty"`
+	// Granularity of exported rows: metrics are aggregated into buckets of that length, rounded to whole minutes.
+	// If not set, metrics are aggregated over the whole period.
+	Bucket        *durationpb.Duration `protobuf:"bytes,7,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Format        ExportFormat         `protobuf:"varint,8,opt,name=format,proto3,enum=qan.v1.ExportFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportReportRequest) Reset() {
+	*x = ExportReportRequest{}
+	mi := &file_qan_v1_profile_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportReportRequest) ProtoMessage() {}
+
+func (x *ExportReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_profile_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportReportRequest.ProtoReflect.Descriptor instead.
+func (*ExportReportRequest) Descriptor() ([]byte, []int) {
+	return file_qan_v1_profile_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ExportReportRequest) GetPeriodStartFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStartFrom
+	}
+	return nil
+}
+
+func (x *ExportReportRequest) GetPeriodStartTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PeriodStartTo
+	}
+	return nil
+}
+
+func (x *ExportReportRequest) GetGroupBy() string {
+	if x != nil {
+		return x.GroupBy
+	}
+	return ""
+}
+
+func (x *ExportReportRequest) GetLabels() []*ReportMapFieldEntry {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *ExportReportRequest) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *ExportReportRequest) GetSearch() string {
+	if x != nil {
+		return x.Search
+	}
+	return ""
+}
+
+func (x *ExportReportRequest) GetBucket() *durationpb.Duration {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+func (x *ExportReportRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
 // ReportMapFieldEntry allows to pass labels/dimentions in form like {"server": ["db1", "db2"...]}.
 type ReportMapFieldEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -151,7 +396,7 @@ type ReportMapFieldEntry struct {
 
 func (x *ReportMapFieldEntry) Reset() {
 	*x = ReportMapFieldEntry{}
-	mi := &file_qan_v1_profile_proto_msgTypes[1]
+	mi := &file_qan_v1_profile_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -163,7 +408,7 @@ func (x *ReportMapFieldEntry) String() string {
 func (*ReportMapFieldEntry) ProtoMessage() {}
 
 func (x *ReportMapFieldEntry) ProtoReflect() protoreflect.Message {
-	mi := &file_qan_v1_profile_proto_msgTypes[1]
+	mi := &file_qan_v1_profile_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -176,7 +421,7 @@ func (x *ReportMapFieldEntry) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportMapFieldEntry.ProtoReflect.Descriptor instead.
 func (*ReportMapFieldEntry) Descriptor() ([]byte, []int) {
-	return file_qan_v1_profile_proto_rawDescGZIP(), []int{1}
+	return file_qan_v1_profile_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *ReportMapFieldEntry) GetKey() string {
@@ -206,7 +451,7 @@ type GetReportResponse struct {
 
 func (x *GetReportResponse) Reset() {
 	*x = GetReportResponse{}
-	mi := &file_qan_v1_profile_proto_msgTypes[2]
+	mi := &file_qan_v1_profile_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -218,7 +463,7 @@ func (x *GetReportResponse) String() string {
 func (*GetReportResponse) ProtoMessage() {}
 
 func (x *GetReportResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_qan_v1_profile_proto_msgTypes[2]
+	mi := &file_qan_v1_profile_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -231,7 +476,7 @@ func (x *GetReportResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetReportResponse.ProtoReflect.Descriptor instead.
 func (*GetReportResponse) Descriptor() ([]byte, []int) {
-	return file_qan_v1_profile_proto_rawDescGZIP(), []int{2}
+	return file_qan_v1_profile_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *GetReportResponse) GetTotalRows() uint32 {
@@ -264,23 +509,27 @@ func (x *GetReportResponse) GetRows() []*Row {
 
 // Row define metrics for selected dimention.
 type Row struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Rank          uint32                 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
-	Dimension     string                 `protobuf:"bytes,2,opt,name=dimension,proto3" json:"dimension,omitempty"`
-	Database      string                 `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
-	Metrics       map[string]*Metric     `protobuf:"bytes,4,rep,name=metrics,proto3" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	Sparkline     []*Point               `protobuf:"bytes,5,rep,name=sparkline,proto3" json:"sparkline,omitempty"`
-	Fingerprint   string                 `protobuf:"bytes,6,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-	NumQueries    uint32                 `protobuf:"varint,7,opt,name=num_queries,json=numQueries,proto3" json:"num_queries,omitempty"`
-	Qps           float32                `protobuf:"fixed32,8,opt,name=qps,proto3" json:"qps,omitempty"`
-	Load          float32                `protobuf:"fixed32,9,opt,name=load,proto3" json:"load,omitempty"`
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Rank        uint32                 `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	Dimension   string                 `protobuf:"bytes,2,opt,name=dimension,proto3" json:"dimension,omitempty"`
+	Database    string                 `protobuf:"bytes,3,opt,name=database,proto3" json:"database,omitempty"`
+	Metrics     map[string]*Metric     `protobuf:"bytes,4,rep,name=metrics,proto3" json:"metrics,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Sparkline   []*Point               `protobuf:"bytes,5,rep,name=sparkline,proto3" json:"sparkline,omitempty"`
+	Fingerprint string                 `protobuf:"bytes,6,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	NumQueries  uint32                 `protobuf:"varint,7,opt,name=num_queries,json=numQueries,proto3" json:"num_queries,omitempty"`
+	Qps         float32                `protobuf:"fixed32,8,opt,name=qps,proto3" json:"qps,omitempty"`
+	Load        float32                `protobuf:"fixed32,9,opt,name=load,proto3" json:"load,omitempty"`
+	// Set only when the baseline period is requested.
+	ComparisonStatus ComparisonStatus `protobuf:"varint,10,opt,name=comparison_status,json=comparisonStatus,proto3,enum=qan.v1.ComparisonStatus" json:"comparison_status,omitempty"`
+	// Annotation of the query, set only for rows grouped by queryid.
+	Annotation    *QueryAnnotation `protobuf:"bytes,11,opt,name=annotation,proto3" json:"annotation,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Row) Reset() {
 	*x = Row{}
-	mi := &file_qan_v1_profile_proto_msgTypes[3]
+	mi := &file_qan_v1_profile_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -292,7 +541,7 @@ func (x *Row) String() string {
 func (*Row) ProtoMessage() {}
 
 func (x *Row) ProtoReflect() protoreflect.Message {
-	mi := &file_qan_v1_profile_proto_msgTypes[3]
+	mi := &file_qan_v1_profile_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -305,7 +554,7 @@ func (x *Row) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Row.ProtoReflect.Descriptor instead.
 func (*Row) Descriptor() ([]byte, []int) {
-	return file_qan_v1_profile_proto_rawDescGZIP(), []int{3}
+	return file_qan_v1_profile_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *Row) GetRank() uint32 {
@@ -371,17 +620,35 @@ func (x *Row) GetLoad() float32 {
 	return 0
 }
 
+func (x *Row) GetComparisonStatus() ComparisonStatus {
+	if x != nil {
+		return x.ComparisonStatus
+	}
+	return ComparisonStatus_COMPARISON_STATUS_UNSPECIFIED
+}
+
+func (x *Row) GetAnnotation() *QueryAnnotation {
+	if x != nil {
+		return x.Annotation
+	}
+	return nil
+}
+
 // Metric cell.
 type Metric struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Stats         *Stat                  `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Stats *Stat                  `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats,omitempty"`
+	// Statistics for the baseline period, set only when it is requested.
+	BaselineStats *Stat `protobuf:"bytes,2,opt,name=baseline_stats,json=baselineStats,proto3" json:"baseline_stats,omitempty"`
+	// Change between the baseline and report periods, set only when the baseline period is requested.
+	Delta         *MetricDelta `protobuf:"bytes,3,opt,name=delta,proto3" json:"delta,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *Metric) Reset() {
 	*x = Metric{}
-	mi := &file_qan_v1_profile_proto_msgTypes[4]
+	mi := &file_qan_v1_profile_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -393,7 +660,7 @@ func (x *Metric) String() string {
 func (*Metric) ProtoMessage() {}
 
 func (x *Metric) ProtoReflect() protoreflect.Message {
-	mi := &file_qan_v1_profile_proto_msgTypes[4]
+	mi := &file_qan_v1_profile_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -406,7 +673,7 @@ func (x *Metric) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Metric.ProtoReflect.Descriptor instead.
 func (*Metric) Descriptor() ([]byte, []int) {
-	return file_qan_v1_profile_proto_rawDescGZIP(), []int{4}
+	return file_qan_v1_profile_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *Metric) GetStats() *Stat {
@@ -416,6 +683,76 @@ func (x *Metric) GetStats() *Stat {
 	return nil
 }
 
+func (x *Metric) GetBaselineStats() *Stat {
+	if x != nil {
+		return x.BaselineStats
+	}
+	return nil
+}
+
+func (x *Metric) GetDelta() *MetricDelta {
+	if x != nil {
+		return x.Delta
+	}
+	return nil
+}
+
+// MetricDelta is a change of the metric between the baseline and report periods.
+// Averages per query are compared for metrics that have them, and rates per second for others.
+type MetricDelta struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Report period value minus baseline period value.
+	Delta float32 `protobuf:"fixed32,1,opt,name=delta,proto3" json:"delta,omitempty"`
+	// Report period value divided by baseline period value; 0 if the baseline value is 0.
+	Ratio         float32 `protobuf:"fixed32,2,opt,name=ratio,proto3" json:"ratio,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MetricDelta) Reset() {
+	*x = MetricDelta{}
+	mi := &file_qan_v1_profile_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MetricDelta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MetricDelta) ProtoMessage() {}
+
+func (x *MetricDelta) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_profile_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MetricDelta.ProtoReflect.Descriptor instead.
+func (*MetricDelta) Descriptor() ([]byte, []int) {
+	return file_qan_v1_profile_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MetricDelta) GetDelta() float32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *MetricDelta) GetRatio() float32 {
+	if x != nil {
+		return x.Ratio
+	}
+	return 0
+}
+
 // Stat is statistics of specific metric.
 type Stat struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -433,7 +770,7 @@ type Stat struct {
 
 func (x *Stat) Reset() {
 	*x = Stat{}
-	mi := &file_qan_v1_profile_proto_msgTypes[5]
+	mi := &file_qan_v1_profile_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -445,7 +782,7 @@ func (x *Stat) String() string {
 func (*Stat) ProtoMessage() {}
 
 func (x *Stat) ProtoReflect() protoreflect.Message {
-	mi := &file_qan_v1_profile_proto_msgTypes[5]
+	mi := &file_qan_v1_profile_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -458,7 +795,7 @@ func (x *Stat) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Stat.ProtoReflect.Descriptor instead.
 func (*Stat) Descriptor() ([]byte, []int) {
-	return file_qan_v1_profile_proto_rawDescGZIP(), []int{5}
+	return file_qan_v1_profile_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Stat) GetRate() float32 {
@@ -521,7 +858,7 @@ var File_qan_v1_profile_proto protoreflect.FileDescriptor
 
 const file_qan_v1_profile_proto_rawDesc = "" +
 	"\n" +
-	"\x14qan/v1/profile.proto\x12\x06qan.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x10qan/v1/qan.proto\"\x8a\x03\n" +
+	"\x14qan/v1/profile.proto\x12\x06qan.v1\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18qan/v1/annotations.proto\x1a\x10qan/v1/qan.proto\"\xde\x04\n" +
 	"\x10GetReportRequest\x12F\n" +
 	"\x11period_start_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x0fperiodStartFrom\x12B\n" +
 	"\x0fperiod_start_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rperiodStartTo\x12\x19\n" +
@@ -534,7 +871,19 @@ const file_qan_v1_profile_proto_rawDesc = "" +
 	"\vmain_metric\x18\t \x01(\tR\n" +
 	"mainMetric\x12\x16\n" +
 	"\x06search\x18\n" +
-	" \x01(\tR\x06search\"=\n" +
+	" \x01(\tR\x06search\x12W\n" +
+	"\x1abaseline_period_start_from\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\x17baselinePeriodStartFrom\x12S\n" +
+	"\x18baseline_period_start_to\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x15baselinePeriodStartTo\x12$\n" +
+	"\x0eorder_by_delta\x18\r \x01(\bR\forderByDelta\"\x84\x03\n" +
+	"\x13ExportReportRequest\x12F\n" +
+	"\x11period_start_from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x0fperiodStartFrom\x12B\n" +
+	"\x0fperiod_start_to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rperiodStartTo\x12\x19\n" +
+	"\bgroup_by\x18\x03 \x01(\tR\agroupBy\x123\n" +
+	"\x06labels\x18\x04 \x03(\v2\x1b.qan.v1.ReportMapFieldEntryR\x06labels\x12\x18\n" +
+	"\acolumns\x18\x05 \x03(\tR\acolumns\x12\x16\n" +
+	"\x06search\x18\x06 \x01(\tR\x06search\x121\n" +
+	"\x06bucket\x18\a \x01(\v2\x19.google.protobuf.DurationR\x06bucket\x12,\n" +
+	"\x06format\x18\b \x01(\x0e2\x14.qan.v1.ExportFormatR\x06format\"=\n" +
 	"\x13ReportMapFieldEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x03(\tR\x05value\"\x81\x01\n" +
@@ -543,7 +892,7 @@ const file_qan_v1_profile_proto_rawDesc = "" +
 	"total_rows\x18\x01 \x01(\rR\ttotalRows\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\rR\x06offset\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\rR\x05limit\x12\x1f\n" +
-	"\x04rows\x18\x04 \x03(\v2\v.qan.v1.RowR\x04rows\"\xe9\x02\n" +
+	"\x04rows\x18\x04 \x03(\v2\v.qan.v1.RowR\x04rows\"\xe9\x03\n" +
 	"\x03Row\x12\x12\n" +
 	"\x04rank\x18\x01 \x01(\rR\x04rank\x12\x1c\n" +
 	"\tdimension\x18\x02 \x01(\tR\tdimension\x12\x1a\n" +
@@ -554,12 +903,22 @@ const file_qan_v1_profile_proto_rawDesc = "" +
 	"\vnum_queries\x18\a \x01(\rR\n" +
 	"numQueries\x12\x10\n" +
 	"\x03qps\x18\b \x01(\x02R\x03qps\x12\x12\n" +
-	"\x04load\x18\t \x01(\x02R\x04load\x1aJ\n" +
+	"\x04load\x18\t \x01(\x02R\x04load\x12E\n" +
+	"\x11comparison_status\x18\n" +
+	" \x01(\x0e2\x18.qan.v1.ComparisonStatusR\x10comparisonStatus\x127\n" +
+	"\n" +
+	"annotation\x18\v \x01(\v2\x17.qan.v1.QueryAnnotationR\n" +
+	"annotation\x1aJ\n" +
 	"\fMetricsEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12$\n" +
-	"\x05value\x18\x02 \x01(\v2\x0e.qan.v1.MetricR\x05value:\x028\x01\",\n" +
+	"\x05value\x18\x02 \x01(\v2\x0e.qan.v1.MetricR\x05value:\x028\x01\"\x8c\x01\n" +
 	"\x06Metric\x12\"\n" +
-	"\x05stats\x18\x01 \x01(\v2\f.qan.v1.StatR\x05stats\"\xa6\x01\n" +
+	"\x05stats\x18\x01 \x01(\v2\f.qan.v1.StatR\x05stats\x123\n" +
+	"\x0ebaseline_stats\x18\x02 \x01(\v2\f.qan.v1.StatR\rbaselineStats\x12)\n" +
+	"\x05delta\x18\x03 \x01(\v2\x13.qan.v1.MetricDeltaR\x05delta\"9\n" +
+	"\vMetricDelta\x12\x14\n" +
+	"\x05delta\x18\x01 \x01(\x02R\x05delta\x12\x14\n" +
+	"\x05ratio\x18\x02 \x01(\x02R\x05ratio\"\xa6\x01\n" +
 	"\x04Stat\x12\x12\n" +
 	"\x04rate\x18\x01 \x01(\x02R\x04rate\x12\x10\n" +
 	"\x03cnt\x18\x02 \x01(\x02R\x03cnt\x12\x10\n" +
@@ -568,7 +927,17 @@ const file_qan_v1_profile_proto_rawDesc = "" +
 	"\x03max\x18\x05 \x01(\x02R\x03max\x12\x10\n" +
 	"\x03p99\x18\x06 \x01(\x02R\x03p99\x12\x10\n" +
 	"\x03avg\x18\a \x01(\x02R\x03avg\x12\x1e\n" +
-	"\vsum_per_sec\x18\b \x01(\x02R\tsumPerSecB|\n" +
+	"\vsum_per_sec\x18\b \x01(\x02R\tsumPerSec*x\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x01\x12\x17\n" +
+	"\x13EXPORT_FORMAT_JSONL\x10\x02\x12\x19\n" +
+	"\x15EXPORT_FORMAT_PARQUET\x10\x03*\x92\x01\n" +
+	"\x10ComparisonStatus\x12!\n" +
+	"\x1dCOMPARISON_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19COMPARISON_STATUS_PRESENT\x10\x01\x12\x19\n" +
+	"\x15COMPARISON_STATUS_NEW\x10\x02\x12!\n" +
+	"\x1dCOMPARISON_STATUS_DISAPPEARED\x10\x03B|\n" +
 	"\n" +
 	"com.qan.v1B\fProfileProtoP\x01Z'github.com/percona/pmm/api/qan/v1;qanv1\xa2\x02\x03QXX\xaa\x02\x06Qan.V1\xca\x02\x06Qan\\V1\xe2\x02\x12Qan\\V1\\GPBMetadata\xea\x02\aQan::V1b\x06proto3"
 
@@ -585,34 +954,52 @@ func file_qan_v1_profile_proto_rawDescGZIP() []byte {
 }
 
 var (
-	file_qan_v1_profile_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
-	file_qan_v1_profile_proto_goTypes  = []any{
-		(*GetReportRequest)(nil),      // 0: qan.v1.GetReportRequest
-		(*ReportMapFieldEntry)(nil),   // 1: qan.v1.ReportMapFieldEntry
-		(*GetReportResponse)(nil),     // 2: qan.v1.GetReportResponse
-		(*Row)(nil),                   // 3: qan.v1.Row
-		(*Metric)(nil),                // 4: qan.v1.Metric
-		(*Stat)(nil),                  // 5: qan.v1.Stat
-		nil,                           // 6: qan.v1.Row.MetricsEntry
-		(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
-		(*Point)(nil),                 // 8: qan.v1.Point
+	file_qan_v1_profile_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+	file_qan_v1_profile_proto_msgTypes  = make([]protoimpl.MessageInfo, 9)
+	file_qan_v1_profile_proto_goTypes   = []any{
+		ExportFormat(0),               // 0: qan.v1.ExportFormat
+		ComparisonStatus(0),           // 1: qan.v1.ComparisonStatus
+		(*GetReportRequest)(nil),      // 2: qan.v1.GetReportRequest
+		(*ExportReportRequest)(nil),   // 3: qan.v1.ExportReportRequest
+		(*ReportMapFieldEntry)(nil),   // 4: qan.v1.ReportMapFieldEntry
+		(*GetReportResponse)(nil),     // 5: qan.v1.GetReportResponse
+		(*Row)(nil),                   // 6: qan.v1.Row
+		(*Metric)(nil),                // 7: qan.v1.Metric
+		(*MetricDelta)(nil),           // 8: qan.v1.MetricDelta
+		(*Stat)(nil),                  // 9: qan.v1.Stat
+		nil,                           // 10: qan.v1.Row.MetricsEntry
+		(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+		(*durationpb.Duration)(nil),   // 12: google.protobuf.Duration
+		(*Point)(nil),                 // 13: qan.v1.Point
+		(*QueryAnnotation)(nil),       // 14: qan.v1.QueryAnnotation
 	}
 )
 
 var file_qan_v1_profile_proto_depIdxs = []int32{
-	7, // 0: qan.v1.GetReportRequest.period_start_from:type_name -> google.protobuf.Timestamp
-	7, // 1: qan.v1.GetReportRequest.period_start_to:type_name -> google.protobuf.Timestamp
-	1, // 2: qan.v1.GetReportRequest.labels:type_name -> qan.v1.ReportMapFieldEntry
-	3, // 3: qan.v1.GetReportResponse.rows:type_name -> qan.v1.Row
-	6, // 4: qan.v1.Row.metrics:type_name -> qan.v1.Row.MetricsEntry
-	8, // 5: qan.v1.Row.sparkline:type_name -> qan.v1.Point
-	5, // 6: qan.v1.Metric.stats:type_name -> qan.v1.Stat
-	4, // 7: qan.v1.Row.MetricsEntry.value:type_name -> qan.v1.Metric
-	8, // [8:8] is the sub-list for method output_type
-	8, // [8:8] is the sub-list for method input_type
-	8, // [8:8] is the sub-list for extension type_name
-	8, // [8:8] is the sub-list for extension extendee
-	0, // [0:8] is the sub-list for field type_name
+	11, // 0: qan.v1.GetReportRequest.period_start_from:type_name -> google.protobuf.Timestamp
+	11, // 1: qan.v1.GetReportRequest.period_start_to:type_name -> google.protobuf.Timestamp
+	4,  // 2: qan.v1.GetReportRequest.labels:type_name -> qan.v1.ReportMapFieldEntry
+	11, // 3: qan.v1.GetReportRequest.baseline_period_start_from:type_name -> google.protobuf.Timestamp
+	11, // 4: qan.v1.GetReportRequest.baseline_period_start_to:type_name -> google.protobuf.Timestamp
+	11, // 5: qan.v1.ExportReportRequest.period_start_from:type_name -> google.protobuf.Timestamp
+	11, // 6: qan.v1.ExportReportRequest.period_start_to:type_name -> google.protobuf.Timestamp
+	4,  // 7: qan.v1.ExportReportRequest.labels:type_name -> qan.v1.ReportMapFieldEntry
+	12, // 8: qan.v1.ExportReportRequest.bucket:type_name -> google.protobuf.Duration
+	0,  // 9: qan.v1.ExportReportRequest.format:type_name -> qan.v1.ExportFormat
+	6,  // 10: qan.v1.GetReportResponse.rows:type_name -> qan.v1.Row
+	10, // 11: qan.v1.Row.metrics:type_name -> qan.v1.Row.MetricsEntry
+	13, // 12: qan.v1.Row.sparkline:type_name -> qan.v1.Point
+	1,  // 13: qan.v1.Row.comparison_status:type_name -> qan.v1.ComparisonStatus
+	14, // 14: qan.v1.Row.annotation:type_name -> qan.v1.QueryAnnotation
+	9,  // 15: qan.v1.Metric.stats:type_name -> qan.v1.Stat
+	9,  // 16: qan.v1.Metric.baseline_stats:type_name -> qan.v1.Stat
+	8,  // 17: qan.v1.Metric.delta:type_name -> qan.v1.MetricDelta
+	7,  // 18: qan.v1.Row.MetricsEntry.value:type_name -> qan.v1.Metric
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_qan_v1_profile_proto_init() }
@@ -620,19 +1007,21 @@ func file_qan_v1_profile_proto_init() {
 	if File_qan_v1_profile_proto != nil {
 		return
 	}
+	file_qan_v1_annotations_proto_init()
 	file_qan_v1_qan_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_qan_v1_profile_proto_rawDesc), len(file_qan_v1_profile_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      2,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_qan_v1_profile_proto_goTypes,
 		DependencyIndexes: file_qan_v1_profile_proto_depIdxs,
+		EnumInfos:         file_qan_v1_profile_proto_enumTypes,
 		MessageInfos:      file_qan_v1_profile_proto_msgTypes,
 	}.Build()
 	File_qan_v1_profile_proto = out.File
@@ -161,6 +161,66 @@ func (m *GetReportRequest) validate(all bool) error {
 
 	// no validation rules for Search
 
+	if all {
+		switch v := interface{}(m.GetBaselinePeriodStartFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReportRequestValidationError{
+					field:  "BaselinePeriodStartFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReportRequestValidationError{
+					field:  "BaselinePeriodStartFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBaselinePeriodStartFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReportRequestValidationError{
+				field:  "BaselinePeriodStartFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetBaselinePeriodStartTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetReportRequestValidationError{
+					field:  "BaselinePeriodStartTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetReportRequestValidationError{
+					field:  "BaselinePeriodStartTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBaselinePeriodStartTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetReportRequestValidationError{
+				field:  "BaselinePeriodStartTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for OrderByDelta
+
 	if len(errors) > 0 {
 		return GetReportRequestMultiError(errors)
 	}
@@ -240,6 +300,236 @@ var _ interface {
 	ErrorName() string
 } = GetReportRequestValidationError{}
 
+// Validate checks the field values on ExportReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportReportRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportReportRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportReportRequestMultiError, or nil if none found.
+func (m *ExportReportRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportReportRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPeriodStartFrom()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportReportRequestValidationError{
+					field:  "PeriodStartFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportReportRequestValidationError{
+					field:  "PeriodStartFrom",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodStartFrom()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportReportRequestValidationError{
+				field:  "PeriodStartFrom",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetPeriodStartTo()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportReportRequestValidationError{
+					field:  "PeriodStartTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportReportRequestValidationError{
+					field:  "PeriodStartTo",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPeriodStartTo()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportReportRequestValidationError{
+				field:  "PeriodStartTo",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for GroupBy
+
+	for idx, item := range m.GetLabels() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ExportReportRequestValidationError{
+						field:  fmt.Sprintf("Labels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ExportReportRequestValidationError{
+						field:  fmt.Sprintf("Labels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ExportReportRequestValidationError{
+					field:  fmt.Sprintf("Labels[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Search
+
+	if all {
+		switch v := interface{}(m.GetBucket()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ExportReportRequestValidationError{
+					field:  "Bucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ExportReportRequestValidationError{
+					field:  "Bucket",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBucket()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ExportReportRequestValidationError{
+				field:  "Bucket",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Format
+
+	if len(errors) > 0 {
+		return ExportReportRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportReportRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportReportRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportReportRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportReportRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportReportRequestMultiError) AllErrors() []error { return m }
+
+// ExportReportRequestValidationError is the validation error returned by
+// ExportReportRequest.Validate if the designated constraints aren't met.
+type ExportReportRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportReportRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportReportRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportReportRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportReportRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportReportRequestValidationError) ErrorName() string {
+	return "ExportReportRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportReportRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportReportRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ExportReportRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportReportRequestValidationError{}
+
 // Validate checks the field values on ReportMapFieldEntry with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -603,6 +893,37 @@ func (m *Row) validate(all bool) error {
 
 	// no validation rules for Load
 
+	// no validation rules for ComparisonStatus
+
+	if all {
+		switch v := interface{}(m.GetAnnotation()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RowValidationError{
+					field:  "Annotation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RowValidationError{
+					field:  "Annotation",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAnnotation()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RowValidationError{
+				field:  "Annotation",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return RowMultiError(errors)
 	}
@@ -731,6 +1052,64 @@ func (m *Metric) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetBaselineStats()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricValidationError{
+					field:  "BaselineStats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricValidationError{
+					field:  "BaselineStats",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBaselineStats()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricValidationError{
+				field:  "BaselineStats",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDelta()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MetricValidationError{
+					field:  "Delta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MetricValidationError{
+					field:  "Delta",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDelta()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MetricValidationError{
+				field:  "Delta",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return MetricMultiError(errors)
 	}
@@ -809,6 +1188,110 @@ var _ interface {
 	ErrorName() string
 } = MetricValidationError{}
 
+// Validate checks the field values on MetricDelta with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MetricDelta) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MetricDelta with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MetricDeltaMultiError, or
+// nil if none found.
+func (m *MetricDelta) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MetricDelta) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Delta
+
+	// no validation rules for Ratio
+
+	if len(errors) > 0 {
+		return MetricDeltaMultiError(errors)
+	}
+
+	return nil
+}
+
+// MetricDeltaMultiError is an error wrapping multiple validation errors
+// returned by MetricDelta.ValidateAll() if the designated constraints aren't met.
+type MetricDeltaMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MetricDeltaMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MetricDeltaMultiError) AllErrors() []error { return m }
+
+// MetricDeltaValidationError is the validation error returned by
+// MetricDelta.Validate if the designated constraints aren't met.
+type MetricDeltaValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MetricDeltaValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MetricDeltaValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MetricDeltaValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MetricDeltaValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MetricDeltaValidationError) ErrorName() string { return "MetricDeltaValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MetricDeltaValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMetricDelta.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = MetricDeltaValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MetricDeltaValidationError{}
+
 // Validate checks the field values on Stat with the rules defined in the proto
 // definition for this message. If any rules are violated, the first error
 // encountered is returned, or nil if there are no violations.
@@ -0,0 +1,365 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        (unknown)
+// source: qan/v1/recommendations.proto
+
+package qanv1
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// IndexRecommendation is a candidate index for the query class with the evidence it is based on.
+type IndexRecommendation struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	ServiceId   string                 `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	ServiceName string                 `protobuf:"bytes,2,opt,name=service_name,json=serviceName,proto3" json:"service_name,omitempty"`
+	ServiceType string                 `protobuf:"bytes,3,opt,name=service_type,json=serviceType,proto3" json:"service_type,omitempty"`
+	Queryid     string                 `protobuf:"bytes,4,opt,name=queryid,proto3" json:"queryid,omitempty"`
+	Fingerprint string                 `protobuf:"bytes,5,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// Database (MySQL, PostgreSQL) or database name (MongoDB) of the table.
+	Database string `protobuf:"bytes,6,opt,name=database,proto3" json:"database,omitempty"`
+	// Table (MySQL, PostgreSQL) or collection (MongoDB) to create the index on.
+	Table string `protobuf:"bytes,7,opt,name=table,proto3" json:"table,omitempty"`
+	// Columns (fields) of the candidate index in their order.
+	Columns []string `protobuf:"bytes,8,rep,name=columns,proto3" json:"columns,omitempty"`
+	// Statement creating the candidate index.
+	Statement string `protobuf:"bytes,9,opt,name=statement,proto3" json:"statement,omitempty"`
+	// Human-readable reason of the recommendation.
+	Reason string `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason,omitempty"`
+	// QAN metrics of the query class per query execution, such as rows_examined and rows_sent.
+	Evidence map[string]float64 `protobuf:"bytes,11,rep,name=evidence,proto3" json:"evidence,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"fixed64,2,opt,name=value"`
+	// Definitions of existing indexes of the table.
+	ExistingIndexes []string `protobuf:"bytes,12,rep,name=existing_indexes,json=existingIndexes,proto3" json:"existing_indexes,omitempty"`
+	// Time when the recommendation was made.
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndexRecommendation) Reset() {
+	*x = IndexRecommendation{}
+	mi := &file_qan_v1_recommendations_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndexRecommendation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexRecommendation) ProtoMessage() {}
+
+func (x *IndexRecommendation) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_recommendations_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexRecommendation.ProtoReflect.Descriptor instead.
+func (*IndexRecommendation) Descriptor() ([]byte, []int) {
+	return file_qan_v1_recommendations_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *IndexRecommendation) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *IndexRecommendation) GetServiceName() string {
+	if x != nil {
+		return x.ServiceName
+	}
+	return ""
+}
+
+func (x *IndexRecommendation) GetServiceType() string {
+	if x != nil {
+		return x.ServiceType
+	}
+	return ""
+}
+
+func (x *IndexRecommendation) GetQueryid() string {
+	if x != nil {
+		return x.Queryid
+	}
+	return ""
+}
+
+func (x *IndexRecommendation) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
+func (x *IndexRecommendation) GetDatabase() string {
+	if x != nil {
+		return x.Database
+	}
+	return ""
+}
+
+func (x *IndexRecommendation) GetTable() string {
+	if x != nil {
+		return x.Table
+	}
+	return ""
+}
+
+func (x *IndexRecommendation) GetColumns() []string {
+	if x != nil {
+		return x.Columns
+	}
+	return nil
+}
+
+func (x *IndexRecommendation) GetStatement() string {
+	if x != nil {
+		return x.Statement
+	}
+	return ""
+}
+
+func (x *IndexRecommendation) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *IndexRecommendation) GetEvidence() map[string]float64 {
+	if x != nil {
+		return x.Evidence
+	}
+	return nil
+}
+
+func (x *IndexRecommendation) GetExistingIndexes() []string {
+	if x != nil {
+		return x.ExistingIndexes
+	}
+	return nil
+}
+
+func (x *IndexRecommendation) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+// ListIndexRecommendationsRequest defines filters of index recommendations.
+type ListIndexRecommendationsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Return only recommendations for this service.
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Return only recommendations for this query class.
+	Queryid       string `protobuf:"bytes,2,opt,name=queryid,proto3" json:"queryid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListIndexRecommendationsRequest) Reset() {
+	*x = ListIndexRecommendationsRequest{}
+	mi := &file_qan_v1_recommendations_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIndexRecommendationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIndexRecommendationsRequest) ProtoMessage() {}
+
+func (x *ListIndexRecommendationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_recommendations_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIndexRecommendationsRequest.ProtoReflect.Descriptor instead.
+func (*ListIndexRecommendationsRequest) Descriptor() ([]byte, []int) {
+	return file_qan_v1_recommendations_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListIndexRecommendationsRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *ListIndexRecommendationsRequest) GetQueryid() string {
+	if x != nil {
+		return x.Queryid
+	}
+	return ""
+}
+
+// ListIndexRecommendationsResponse is a list of the latest index recommendations.
+type ListIndexRecommendationsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Recommendations []*IndexRecommendation `protobuf:"bytes,1,rep,name=recommendations,proto3" json:"recommendations,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListIndexRecommendationsResponse) Reset() {
+	*x = ListIndexRecommendationsResponse{}
+	mi := &file_qan_v1_recommendations_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListIndexRecommendationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListIndexRecommendationsResponse) ProtoMessage() {}
+
+func (x *ListIndexRecommendationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_qan_v1_recommendations_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListIndexRecommendationsResponse.ProtoReflect.Descriptor instead.
+func (*ListIndexRecommendationsResponse) Descriptor() ([]byte, []int) {
+	return file_qan_v1_recommendations_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ListIndexRecommendationsResponse) GetRecommendations() []*IndexRecommendation {
+	if x != nil {
+		return x.Recommendations
+	}
+	return nil
+}
+
+var File_qan_v1_recommendations_proto protoreflect.FileDescriptor
+
+const file_qan_v1_recommendations_proto_rawDesc = "" +
+	"\n" +
+	"\x1cqan/v1/recommendations.proto\x12\x06qan.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"\xa2\x04\n" +
+	"\x13IndexRecommendation\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12!\n" +
+	"\fservice_name\x18\x02 \x01(\tR\vserviceName\x12!\n" +
+	"\fservice_type\x18\x03 \x01(\tR\vserviceType\x12\x18\n" +
+	"\aqueryid\x18\x04 \x01(\tR\aqueryid\x12 \n" +
+	"\vfingerprint\x18\x05 \x01(\tR\vfingerprint\x12\x1a\n" +
+	"\bdatabase\x18\x06 \x01(\tR\bdatabase\x12\x14\n" +
+	"\x05table\x18\a \x01(\tR\x05table\x12\x18\n" +
+	"\acolumns\x18\b \x03(\tR\acolumns\x12\x1c\n" +
+	"\tstatement\x18\t \x01(\tR\tstatement\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\x12E\n" +
+	"\bevidence\x18\v \x03(\v2).qan.v1.IndexRecommendation.EvidenceEntryR\bevidence\x12)\n" +
+	"\x10existing_indexes\x18\f \x03(\tR\x0fexistingIndexes\x129\n" +
+	"\n" +
+	"created_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x1a;\n" +
+	"\rEvidenceEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value:\x028\x01\"Z\n" +
+	"\x1fListIndexRecommendationsRequest\x12\x1d\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tR\tserviceId\x12\x18\n" +
+	"\aqueryid\x18\x02 \x01(\tR\aqueryid\"i\n" +
+	" ListIndexRecommendationsResponse\x12E\n" +
+	"\x0frecommendations\x18\x01 \x03(\v2\x1b.qan.v1.IndexRecommendationR\x0frecommendationsB\x84\x01\n" +
+	"\n" +
+	"com.qan.v1B\x14RecommendationsProtoP\x01Z'github.com/percona/pmm/api/qan/v1;qanv1\xa2\x02\x03QXX\xaa\x02\x06Qan.V1\xca\x02\x06Qan\\V1\xe2\x02\x12Qan\\V1\\GPBMetadata\xea\x02\aQan::V1b\x06proto3"
+
+var (
+	file_qan_v1_recommendations_proto_rawDescOnce sync.Once
+	file_qan_v1_recommendations_proto_rawDescData []byte
+)
+
+func file_qan_v1_recommendations_proto_rawDescGZIP() []byte {
+	file_qan_v1_recommendations_proto_rawDescOnce.Do(func() {
+		file_qan_v1_recommendations_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_qan_v1_recommendations_proto_rawDesc), len(file_qan_v1_recommendations_proto_rawDesc)))
+	})
+	return file_qan_v1_recommendations_proto_rawDescData
+}
+
+var (
+	file_qan_v1_recommendations_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+	file_qan_v1_recommendations_proto_goTypes  = []any{
+		(*IndexRecommendation)(nil),              // 0: qan.v1.IndexRecommendation
+		(*ListIndexRecommendationsRequest)(nil),  // 1: qan.v1.ListIndexRecommendationsRequest
+		(*ListIndexRecommendationsResponse)(nil), // 2: qan.v1.ListIndexRecommendationsResponse
+		nil,                                      // 3: qan.v1.IndexRecommendation.EvidenceEntry
+		(*timestamppb.Timestamp)(nil),            // 4: google.protobuf.Timestamp
+	}
+)
+
+var file_qan_v1_recommendations_proto_depIdxs = []int32{
+	3, // 0: qan.v1.IndexRecommendation.evidence:type_name -> qan.v1.IndexRecommendation.EvidenceEntry
+	4, // 1: qan.v1.IndexRecommendation.created_at:type_name -> google.protobuf.Timestamp
+	0, // 2: qan.v1.ListIndexRecommendationsResponse.recommendations:type_name -> qan.v1.IndexRecommendation
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_qan_v1_recommendations_proto_init() }
+func file_qan_v1_recommendations_proto_init() {
+	if File_qan_v1_recommendations_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_qan_v1_recommendations_proto_rawDesc), len(file_qan_v1_recommendations_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_qan_v1_recommendations_proto_goTypes,
+		DependencyIndexes: file_qan_v1_recommendations_proto_depIdxs,
+		MessageInfos:      file_qan_v1_recommendations_proto_msgTypes,
+	}.Build()
+	File_qan_v1_recommendations_proto = out.File
+	file_qan_v1_recommendations_proto_goTypes = nil
+	file_qan_v1_recommendations_proto_depIdxs = nil
+}
@@ -0,0 +1,436 @@
+// Code generated by protoc-gen-validate. DO NOT EDIT.
+// source: qan/v1/recommendations.proto
+
+package qanv1
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+	_ = sort.Sort
+)
+
+// Validate checks the field values on IndexRecommendation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *IndexRecommendation) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on IndexRecommendation with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// IndexRecommendationMultiError, or nil if none found.
+func (m *IndexRecommendation) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *IndexRecommendation) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceId
+
+	// no validation rules for ServiceName
+
+	// no validation rules for ServiceType
+
+	// no validation rules for Queryid
+
+	// no validation rules for Fingerprint
+
+	// no validation rules for Database
+
+	// no validation rules for Table
+
+	// no validation rules for Statement
+
+	// no validation rules for Reason
+
+	// no validation rules for Evidence
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, IndexRecommendationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, IndexRecommendationValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return IndexRecommendationValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return IndexRecommendationMultiError(errors)
+	}
+
+	return nil
+}
+
+// IndexRecommendationMultiError is an error wrapping multiple validation
+// errors returned by IndexRecommendation.ValidateAll() if the designated
+// constraints aren't met.
+type IndexRecommendationMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m IndexRecommendationMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m IndexRecommendationMultiError) AllErrors() []error { return m }
+
+// IndexRecommendationValidationError is the validation error returned by
+// IndexRecommendation.Validate if the designated constraints aren't met.
+type IndexRecommendationValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e IndexRecommendationValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e IndexRecommendationValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e IndexRecommendationValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e IndexRecommendationValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e IndexRecommendationValidationError) ErrorName() string {
+	return "IndexRecommendationValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e IndexRecommendationValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sIndexRecommendation.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = IndexRecommendationValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = IndexRecommendationValidationError{}
+
+// Validate checks the field values on ListIndexRecommendationsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListIndexRecommendationsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIndexRecommendationsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListIndexRecommendationsRequestMultiError, or nil if none found.
+func (m *ListIndexRecommendationsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIndexRecommendationsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ServiceId
+
+	// no validation rules for Queryid
+
+	if len(errors) > 0 {
+		return ListIndexRecommendationsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIndexRecommendationsRequestMultiError is an error wrapping multiple
+// validation errors returned by ListIndexRecommendationsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type ListIndexRecommendationsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIndexRecommendationsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIndexRecommendationsRequestMultiError) AllErrors() []error { return m }
+
+// ListIndexRecommendationsRequestValidationError is the validation error
+// returned by ListIndexRecommendationsRequest.Validate if the designated
+// constraints aren't met.
+type ListIndexRecommendationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIndexRecommendationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIndexRecommendationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIndexRecommendationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIndexRecommendationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIndexRecommendationsRequestValidationError) ErrorName() string {
+	return "ListIndexRecommendationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIndexRecommendationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIndexRecommendationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ListIndexRecommendationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIndexRecommendationsRequestValidationError{}
+
+// Validate checks the field values on ListIndexRecommendationsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *ListIndexRecommendationsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListIndexRecommendationsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// ListIndexRecommendationsResponseMultiError, or nil if none found.
+func (m *ListIndexRecommendationsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListIndexRecommendationsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRecommendations() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListIndexRecommendationsResponseValidationError{
+						field:  fmt.Sprintf("Recommendations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListIndexRecommendationsResponseValidationError{
+						field:  fmt.Sprintf("Recommendations[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListIndexRecommendationsResponseValidationError{
+					field:  fmt.Sprintf("Recommendations[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListIndexRecommendationsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListIndexRecommendationsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// ListIndexRecommendationsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListIndexRecommendationsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListIndexRecommendationsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListIndexRecommendationsResponseMultiError) AllErrors() []error { return m }
+
+// ListIndexRecommendationsResponseValidationError is the validation error
+// returned by ListIndexRecommendationsResponse.Validate if the designated
+// constraints aren't met.
+type ListIndexRecommendationsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListIndexRecommendationsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListIndexRecommendationsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListIndexRecommendationsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListIndexRecommendationsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListIndexRecommendationsResponseValidationError) ErrorName() string {
+	return "ListIndexRecommendationsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListIndexRecommendationsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListIndexRecommendationsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ListIndexRecommendationsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListIndexRecommendationsResponseValidationError{}
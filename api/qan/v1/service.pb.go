@@ -13,6 +13,7 @@ import (
 
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 )
@@ -186,7 +187,7 @@ var File_qan_v1_service_proto protoreflect.FileDescriptor
 
 const file_qan_v1_service_proto_rawDesc = "" +
 	"\n" +
-	"\x14qan/v1/service.proto\x12\x06qan.v1\x1a\x1cgoogle/api/annotations.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x14qan/v1/filters.proto\x1a\x1bqan/v1/object_details.proto\x1a\x14qan/v1/profile.proto\"\x18\n" +
+	"\x14qan/v1/service.proto\x12\x06qan.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x18qan/v1/annotations.proto\x1a\x16qan/v1/anomalies.proto\x1a\x14qan/v1/filters.proto\x1a\x1bqan/v1/object_details.proto\x1a\x12qan/v1/plans.proto\x1a\x14qan/v1/profile.proto\x1a\x1cqan/v1/recommendations.proto\"\x18\n" +
 	"\x16GetMetricsNamesRequest\"\x91\x01\n" +
 	"\x17GetMetricsNamesResponse\x12=\n" +
 	"\x04data\x18\x01 \x03(\v2).qan.v1.GetMetricsNamesResponse.DataEntryR\x04data\x1a7\n" +
@@ -194,11 +195,12 @@ const file_qan_v1_service_proto_rawDesc = "" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x14\n" +
 	"\x12HealthCheckRequest\"\x15\n" +
-	"\x13HealthCheckResponse2\xae\x11\n" +
+	"\x13HealthCheckResponse2\xf8\x1f\n" +
 	"\n" +
 	"QANService\x12\xb8\x01\n" +
 	"\tGetReport\x12\x18.qan.v1.GetReportRequest\x1a\x19.qan.v1.GetReportResponse\"v\x92AO\x12\n" +
-	"Get Report\x1aAReturns a list of metrics grouped by queryid or other dimensions.\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/qan/metrics:getReport\x12\xcc\x01\n" +
+	"Get Report\x1aAReturns a list of metrics grouped by queryid or other dimensions.\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/qan/metrics:getReport\x12\xec\x01\n" +
+	"\fExportReport\x12\x1b.qan.v1.ExportReportRequest\x1a\x14.google.api.HttpBody\"\xa6\x01\x92A\x81\x01\x12\rExport Report\x1apStreams metrics grouped by queryid or other dimensions in CSV, JSON Lines or Parquet format, without pagination.\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/qan/metrics:export0\x01\x12\xcc\x01\n" +
 	"\x17GetFilteredMetricsNames\x12&.qan.v1.GetFilteredMetricsNamesRequest\x1a'.qan.v1.GetFilteredMetricsNamesResponse\"`\x92A8\x12\vGet Filters\x1a)Provides a filtered map of metrics names.\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/qan/metrics:getFilters\x12\xb3\x01\n" +
 	"\x0fGetMetricsNames\x12\x1e.qan.v1.GetMetricsNamesRequest\x1a\x1f.qan.v1.GetMetricsNamesResponse\"_\x92A9\x12\x11Get Metrics Names\x1a$Provides a map of all metrics names.\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/qan/metrics:getNames\x12\xa5\x01\n" +
 	"\n" +
@@ -211,7 +213,14 @@ const file_qan_v1_service_proto_rawDesc = "" +
 	"\vQueryExists\x12\x1a.qan.v1.QueryExistsRequest\x1a\x1b.qan.v1.QueryExistsResponse\"`\x92A>\x12\x15Check Query Existence\x1a%Checks if query exists in clickhouse.\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/qan/query:exists\x12\xbd\x01\n" +
 	"\x0fSchemaByQueryID\x12\x1e.qan.v1.SchemaByQueryIDRequest\x1a\x1f.qan.v1.SchemaByQueryIDResponse\"i\x92AD\x12\n" +
 	"Get Schema\x1a6Provides the schema for a given queryID and serviceID.\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/qan/query:getSchema\x12\xb1\x01\n" +
-	"\x0fGetQueryExample\x12\x1e.qan.v1.GetQueryExampleRequest\x1a\x1f.qan.v1.GetQueryExampleResponse\"]\x92A7\x12\x11Get Query Example\x1a\"Provides a list of query examples.\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/qan/query:getExample\x12\x97\x01\n" +
+	"\x0fGetQueryExample\x12\x1e.qan.v1.GetQueryExampleRequest\x1a\x1f.qan.v1.GetQueryExampleResponse\"]\x92A7\x12\x11Get Query Example\x1a\"Provides a list of query examples.\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/qan/query:getExample\x12\xeb\x01\n" +
+	"\x12ListQueryAnomalies\x12!.qan.v1.ListQueryAnomaliesRequest\x1a\".qan.v1.ListQueryAnomaliesResponse\"\x8d\x01\x92Ai\x12\x14List Query Anomalies\x1aQReturns query regressions and plan flips found by the last anomaly detection run.\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/qan/anomalies:list\x12\xee\x01\n" +
+	"\x12SetQueryAnnotation\x12!.qan.v1.SetQueryAnnotationRequest\x1a\".qan.v1.SetQueryAnnotationResponse\"\x90\x01\x92Ak\x12\x14Set Query Annotation\x1aSCreates or replaces the owner, ticket link, acknowledgement and notes of the query.\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/qan/annotations:set\x12\xcd\x01\n" +
+	"\x15RemoveQueryAnnotation\x12$.qan.v1.RemoveQueryAnnotationRequest\x1a%.qan.v1.RemoveQueryAnnotationResponse\"g\x92A?\x12\x17Remove Query Annotation\x1a$Removes the annotation of the query.\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/v1/qan/annotations:remove\x12\xbd\x01\n" +
+	"\x14ListQueryAnnotations\x12#.qan.v1.ListQueryAnnotationsRequest\x1a$.qan.v1.ListQueryAnnotationsResponse\"Z\x92A4\x12\x16List Query Annotations\x1a\x1aReturns query annotations.\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/qan/annotations:list\x12\xf1\x01\n" +
+	"\x0eListQueryPlans\x12\x1d.qan.v1.ListQueryPlansRequest\x1a\x1e.qan.v1.ListQueryPlansResponse\"\x9f\x01\x92A\x7f\x12\x10List Query Plans\x1akReturns plan versions of the query ordered by time, so plan changes can be correlated with latency changes.\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/qan/plans:list\x12\xc6\x01\n" +
+	"\x0eDiffQueryPlans\x12\x1d.qan.v1.DiffQueryPlansRequest\x1a\x1e.qan.v1.DiffQueryPlansResponse\"u\x92AU\x12\x10Diff Query Plans\x1aAReturns a unified diff between two normalized plans of the query.\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/qan/plans:diff\x12\xac\x02\n" +
+	"\x18ListIndexRecommendations\x12'.qan.v1.ListIndexRecommendationsRequest\x1a(.qan.v1.ListIndexRecommendationsResponse\"\xbc\x01\x92A\x91\x01\x12\x1aList Index Recommendations\x1asReturns the latest candidate indexes for query classes with the QAN metrics and existing indexes they are based on.\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/v1/qan/recommendations:list\x12\x97\x01\n" +
 	"\vHealthCheck\x12\x1a.qan.v1.HealthCheckRequest\x1a\x1b.qan.v1.HealthCheckResponse\"O\x92A6\x12\fHealth Check\x1a&Returns readiness of QAN API2 service.\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/qan/healthB|\n" +
 	"\n" +
 	"com.qan.v1B\fServiceProtoP\x01Z'github.com/percona/pmm/api/qan/v1;qanv1\xa2\x02\x03QXX\xaa\x02\x06Qan.V1\xca\x02\x06Qan\\V1\xe2\x02\x12Qan\\V1\\GPBMetadata\xea\x02\aQan::V1b\x06proto3"
@@ -237,56 +246,88 @@ var (
 		(*HealthCheckResponse)(nil),                 // 3: qan.v1.HealthCheckResponse
 		nil,                                         // 4: qan.v1.GetMetricsNamesResponse.DataEntry
 		(*GetReportRequest)(nil),                    // 5: qan.v1.GetReportRequest
-		(*GetFilteredMetricsNamesRequest)(nil),      // 6: qan.v1.GetFilteredMetricsNamesRequest
-		(*GetMetricsRequest)(nil),                   // 7: qan.v1.GetMetricsRequest
-		(*GetLabelsRequest)(nil),                    // 8: qan.v1.GetLabelsRequest
-		(*GetHistogramRequest)(nil),                 // 9: qan.v1.GetHistogramRequest
-		(*ExplainFingerprintByQueryIDRequest)(nil),  // 10: qan.v1.ExplainFingerprintByQueryIDRequest
-		(*GetQueryPlanRequest)(nil),                 // 11: qan.v1.GetQueryPlanRequest
-		(*QueryExistsRequest)(nil),                  // 12: qan.v1.QueryExistsRequest
-		(*SchemaByQueryIDRequest)(nil),              // 13: qan.v1.SchemaByQueryIDRequest
-		(*GetQueryExampleRequest)(nil),              // 14: qan.v1.GetQueryExampleRequest
-		(*GetReportResponse)(nil),                   // 15: qan.v1.GetReportResponse
-		(*GetFilteredMetricsNamesResponse)(nil),     // 16: qan.v1.GetFilteredMetricsNamesResponse
-		(*GetMetricsResponse)(nil),                  // 17: qan.v1.GetMetricsResponse
-		(*GetLabelsResponse)(nil),                   // 18: qan.v1.GetLabelsResponse
-		(*GetHistogramResponse)(nil),                // 19: qan.v1.GetHistogramResponse
-		(*ExplainFingerprintByQueryIDResponse)(nil), // 20: qan.v1.ExplainFingerprintByQueryIDResponse
-		(*GetQueryPlanResponse)(nil),                // 21: qan.v1.GetQueryPlanResponse
-		(*QueryExistsResponse)(nil),                 // 22: qan.v1.QueryExistsResponse
-		(*SchemaByQueryIDResponse)(nil),             // 23: qan.v1.SchemaByQueryIDResponse
-		(*GetQueryExampleResponse)(nil),             // 24: qan.v1.GetQueryExampleResponse
+		(*ExportReportRequest)(nil),                 // 6: qan.v1.ExportReportRequest
+		(*GetFilteredMetricsNamesRequest)(nil),      // 7: qan.v1.GetFilteredMetricsNamesRequest
+		(*GetMetricsRequest)(nil),                   // 8: qan.v1.GetMetricsRequest
+		(*GetLabelsRequest)(nil),                    // 9: qan.v1.GetLabelsRequest
+		(*GetHistogramRequest)(nil),                 // 10: qan.v1.GetHistogramRequest
+		(*ExplainFingerprintByQueryIDRequest)(nil),  // 11: qan.v1.ExplainFingerprintByQueryIDRequest
+		(*GetQueryPlanRequest)(nil),                 // 12: qan.v1.GetQueryPlanRequest
+		(*QueryExistsRequest)(nil),                  // 13: qan.v1.QueryExistsRequest
+		(*SchemaByQueryIDRequest)(nil),              // 14: qan.v1.SchemaByQueryIDRequest
+		(*GetQueryExampleRequest)(nil),              // 15: qan.v1.GetQueryExampleRequest
+		(*ListQueryAnomaliesRequest)(nil),           // 16: qan.v1.ListQueryAnomaliesRequest
+		(*SetQueryAnnotationRequest)(nil),           // 17: qan.v1.SetQueryAnnotationRequest
+		(*RemoveQueryAnnotationRequest)(nil),        // 18: qan.v1.RemoveQueryAnnotationRequest
+		(*ListQueryAnnotationsRequest)(nil),         // 19: qan.v1.ListQueryAnnotationsRequest
+		(*ListQueryPlansRequest)(nil),               // 20: qan.v1.ListQueryPlansRequest
+		(*DiffQueryPlansRequest)(nil),               // 21: qan.v1.DiffQueryPlansRequest
+		(*ListIndexRecommendationsRequest)(nil),     // 22: qan.v1.ListIndexRecommendationsRequest
+		(*GetReportResponse)(nil),                   // 23: qan.v1.GetReportResponse
+		(*httpbody.HttpBody)(nil),                   // 24: google.api.HttpBody
+		(*GetFilteredMetricsNamesResponse)(nil),     // 25: qan.v1.GetFilteredMetricsNamesResponse
+		(*GetMetricsResponse)(nil),                  // 26: qan.v1.GetMetricsResponse
+		(*GetLabelsResponse)(nil),                   // 27: qan.v1.GetLabelsResponse
+		(*GetHistogramResponse)(nil),                // 28: qan.v1.GetHistogramResponse
+		(*ExplainFingerprintByQueryIDResponse)(nil), // 29: qan.v1.ExplainFingerprintByQueryIDResponse
+		(*GetQueryPlanResponse)(nil),                // 30: qan.v1.GetQueryPlanResponse
+		(*QueryExistsResponse)(nil),                 // 31: qan.v1.QueryExistsResponse
+		(*SchemaByQueryIDResponse)(nil),             // 32: qan.v1.SchemaByQueryIDResponse
+		(*GetQueryExampleResponse)(nil),             // 33: qan.v1.GetQueryExampleResponse
+		(*ListQueryAnomaliesResponse)(nil),          // 34: qan.v1.ListQueryAnomaliesResponse
+		(*SetQueryAnnotationResponse)(nil),          // 35: qan.v1.SetQueryAnnotationResponse
+		(*RemoveQueryAnnotationResponse)(nil),       // 36: qan.v1.RemoveQueryAnnotationResponse
+		(*ListQueryAnnotationsResponse)(nil),        // 37: qan.v1.ListQueryAnnotationsResponse
+		(*ListQueryPlansResponse)(nil),              // 38: qan.v1.ListQueryPlansResponse
+		(*DiffQueryPlansResponse)(nil),              // 39: qan.v1.DiffQueryPlansResponse
+		(*ListIndexRecommendationsResponse)(nil),    // 40: qan.v1.ListIndexRecommendationsResponse
 	}
 )
 
 var file_qan_v1_service_proto_depIdxs = []int32{
 	4,  // 0: qan.v1.GetMetricsNamesResponse.data:type_name -> qan.v1.GetMetricsNamesResponse.DataEntry
 	5,  // 1: qan.v1.QANService.GetReport:input_type -> qan.v1.GetReportRequest
-	6,  // 2: qan.v1.QANService.GetFilteredMetricsNames:input_type -> qan.v1.GetFilteredMetricsNamesRequest
-	0,  // 3: qan.v1.QANService.GetMetricsNames:input_type -> qan.v1.GetMetricsNamesRequest
-	7,  // 4: qan.v1.QANService.GetMetrics:input_type -> qan.v1.GetMetricsRequest
-	8,  // 5: qan.v1.QANService.GetLabels:input_type -> qan.v1.GetLabelsRequest
-	9,  // 6: qan.v1.QANService.GetHistogram:input_type -> qan.v1.GetHistogramRequest
-	10, // 7: qan.v1.QANService.ExplainFingerprintByQueryID:input_type -> qan.v1.ExplainFingerprintByQueryIDRequest
-	11, // 8: qan.v1.QANService.GetQueryPlan:input_type -> qan.v1.GetQueryPlanRequest
-	12, // 9: qan.v1.QANService.QueryExists:input_type -> qan.v1.QueryExistsRequest
-	13, // 10: qan.v1.QANService.SchemaByQueryID:input_type -> qan.v1.SchemaByQueryIDRequest
-	14, // 11: qan.v1.QANService.GetQueryExample:input_type -> qan.v1.GetQueryExampleRequest
-	2,  // 12: qan.v1.QANService.HealthCheck:input_type -> qan.v1.HealthCheckRequest
-	15, // 13: qan.v1.QANService.GetReport:output_type -> qan.v1.GetReportResponse
-	16, // 14: qan.v1.QANService.GetFilteredMetricsNames:output_type -> qan.v1.GetFilteredMetricsNamesResponse
-	1,  // 15: qan.v1.QANService.GetMetricsNames:output_type -> qan.v1.GetMetricsNamesResponse
-	17, // 16: qan.v1.QANService.GetMetrics:output_type -> qan.v1.GetMetricsResponse
-	18, // 17: qan.v1.QANService.GetLabels:output_type -> qan.v1.GetLabelsResponse
-	19, // 18: qan.v1.QANService.GetHistogram:output_type -> qan.v1.GetHistogramResponse
-	20, // 19: qan.v1.QANService.ExplainFingerprintByQueryID:output_type -> qan.v1.ExplainFingerprintByQueryIDResponse
-	21, // 20: qan.v1.QANService.GetQueryPlan:output_type -> qan.v1.GetQueryPlanResponse
-	22, // 21: qan.v1.QANService.QueryExists:output_type -> qan.v1.QueryExistsResponse
-	23, // 22: qan.v1.QANService.SchemaByQueryID:output_type -> qan.v1.SchemaByQueryIDResponse
-	24, // 23: qan.v1.QANService.GetQueryExample:output_type -> qan.v1.GetQueryExampleResponse
-	3,  // 24: qan.v1.QANService.HealthCheck:output_type -> qan.v1.HealthCheckResponse
-	13, // [13:25] is the sub-list for method output_type
-	1,  // [1:13] is the sub-list for method input_type
+	6,  // 2: qan.v1.QANService.ExportReport:input_type -> qan.v1.ExportReportRequest
+	7,  // 3: qan.v1.QANService.GetFilteredMetricsNames:input_type -> qan.v1.GetFilteredMetricsNamesRequest
+	0,  // 4: qan.v1.QANService.GetMetricsNames:input_type -> qan.v1.GetMetricsNamesRequest
+	8,  // 5: qan.v1.QANService.GetMetrics:input_type -> qan.v1.GetMetricsRequest
+	9,  // 6: qan.v1.QANService.GetLabels:input_type -> qan.v1.GetLabelsRequest
+	10, // 7: qan.v1.QANService.GetHistogram:input_type -> qan.v1.GetHistogramRequest
+	11, // 8: qan.v1.QANService.ExplainFingerprintByQueryID:input_type -> qan.v1.ExplainFingerprintByQueryIDRequest
+	12, // 9: qan.v1.QANService.GetQueryPlan:input_type -> qan.v1.GetQueryPlanRequest
+	13, // 10: qan.v1.QANService.QueryExists:input_type -> qan.v1.QueryExistsRequest
+	14, // 11: qan.v1.QANService.SchemaByQueryID:input_type -> qan.v1.SchemaByQueryIDRequest
+	15, // 12: qan.v1.QANService.GetQueryExample:input_type -> qan.v1.GetQueryExampleRequest
+	16, // 13: qan.v1.QANService.ListQueryAnomalies:input_type -> qan.v1.ListQueryAnomaliesRequest
+	17, // 14: qan.v1.QANService.SetQueryAnnotation:input_type -> qan.v1.SetQueryAnnotationRequest
+	18, // 15: qan.v1.QANService.RemoveQueryAnnotation:input_type -> qan.v1.RemoveQueryAnnotationRequest
+	19, // 16: qan.v1.QANService.ListQueryAnnotations:input_type -> qan.v1.ListQueryAnnotationsRequest
+	20, // 17: qan.v1.QANService.ListQueryPlans:input_type -> qan.v1.ListQueryPlansRequest
+	21, // 18: qan.v1.QANService.DiffQueryPlans:input_type -> qan.v1.DiffQueryPlansRequest
+	22, // 19: qan.v1.QANService.ListIndexRecommendations:input_type -> qan.v1.ListIndexRecommendationsRequest
+	2,  // 20: qan.v1.QANService.HealthCheck:input_type -> qan.v1.HealthCheckRequest
+	23, // 21: qan.v1.QANService.GetReport:output_type -> qan.v1.GetReportResponse
+	24, // 22: qan.v1.QANService.ExportReport:output_type -> google.api.HttpBody
+	25, // 23: qan.v1.QANService.GetFilteredMetricsNames:output_type -> qan.v1.GetFilteredMetricsNamesResponse
+	1,  // 24: qan.v1.QANService.GetMetricsNames:output_type -> qan.v1.GetMetricsNamesResponse
+	26, // 25: qan.v1.QANService.GetMetrics:output_type -> qan.v1.GetMetricsResponse
+	27, // 26: qan.v1.QANService.GetLabels:output_type -> qan.v1.GetLabelsResponse
+	28, // 27: qan.v1.QANService.GetHistogram:output_type -> qan.v1.GetHistogramResponse
+	29, // 28: qan.v1.QANService.ExplainFingerprintByQueryID:output_type -> qan.v1.ExplainFingerprintByQueryIDResponse
+	30, // 29: qan.v1.QANService.GetQueryPlan:output_type -> qan.v1.GetQueryPlanResponse
+	31, // 30: qan.v1.QANService.QueryExists:output_type -> qan.v1.QueryExistsResponse
+	32, // 31: qan.v1.QANService.SchemaByQueryID:output_type -> qan.v1.SchemaByQueryIDResponse
+	33, // 32: qan.v1.QANService.GetQueryExample:output_type -> qan.v1.GetQueryExampleResponse
+	34, // 33: qan.v1.QANService.ListQueryAnomalies:output_type -> qan.v1.ListQueryAnomaliesResponse
+	35, // 34: qan.v1.QANService.SetQueryAnnotation:output_type -> qan.v1.SetQueryAnnotationResponse
+	36, // 35: qan.v1.QANService.RemoveQueryAnnotation:output_type -> qan.v1.RemoveQueryAnnotationResponse
+	37, // 36: qan.v1.QANService.ListQueryAnnotations:output_type -> qan.v1.ListQueryAnnotationsResponse
+	38, // 37: qan.v1.QANService.ListQueryPlans:output_type -> qan.v1.ListQueryPlansResponse
+	39, // 38: qan.v1.QANService.DiffQueryPlans:output_type -> qan.v1.DiffQueryPlansResponse
+	40, // 39: qan.v1.QANService.ListIndexRecommendations:output_type -> qan.v1.ListIndexRecommendationsResponse
+	3,  // 40: qan.v1.QANService.HealthCheck:output_type -> qan.v1.HealthCheckResponse
+	21, // [21:41] is the sub-list for method output_type
+	1,  // [1:21] is the sub-list for method input_type
 	1,  // [1:1] is the sub-list for extension type_name
 	1,  // [1:1] is the sub-list for extension extendee
 	0,  // [0:1] is the sub-list for field type_name
@@ -297,9 +338,13 @@ func file_qan_v1_service_proto_init() {
 	if File_qan_v1_service_proto != nil {
 		return
 	}
+	file_qan_v1_annotations_proto_init()
+	file_qan_v1_anomalies_proto_init()
 	file_qan_v1_filters_proto_init()
 	file_qan_v1_object_details_proto_init()
+	file_qan_v1_plans_proto_init()
 	file_qan_v1_profile_proto_init()
+	file_qan_v1_recommendations_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
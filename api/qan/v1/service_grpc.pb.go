@@ -9,6 +9,7 @@ package qanv1
 import (
 	context "context"
 
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -21,6 +22,7 @@ const _ = grpc.SupportPackageIsVersion9
 
 const (
 	QANService_GetReport_FullMethodName                   = "/qan.v1.QANService/GetReport"
+	QANService_ExportReport_FullMethodName                = "/qan.v1.QANService/ExportReport"
 	QANService_GetFilteredMetricsNames_FullMethodName     = "/qan.v1.QANService/GetFilteredMetricsNames"
 	QANService_GetMetricsNames_FullMethodName             = "/qan.v1.QANService/GetMetricsNames"
 	QANService_GetMetrics_FullMethodName                  = "/qan.v1.QANService/GetMetrics"
@@ -31,6 +33,13 @@ const (
 	QANService_QueryExists_FullMethodName                 = "/qan.v1.QANService/QueryExists"
 	QANService_SchemaByQueryID_FullMethodName             = "/qan.v1.QANService/SchemaByQueryID"
 	QANService_GetQueryExample_FullMethodName             = "/qan.v1.QANService/GetQueryExample"
+	QANService_ListQueryAnomalies_FullMethodName          = "/qan.v1.QANService/ListQueryAnomalies"
+	QANService_SetQueryAnnotation_FullMethodName          = "/qan.v1.QANService/SetQueryAnnotation"
+	QANService_RemoveQueryAnnotation_FullMethodName       = "/qan.v1.QANService/RemoveQueryAnnotation"
+	QANService_ListQueryAnnotations_FullMethodName        = "/qan.v1.QANService/ListQueryAnnotations"
+	QANService_ListQueryPlans_FullMethodName              = "/qan.v1.QANService/ListQueryPlans"
+	QANService_DiffQueryPlans_FullMethodName              = "/qan.v1.QANService/DiffQueryPlans"
+	QANService_ListIndexRecommendations_FullMethodName    = "/qan.v1.QANService/ListIndexRecommendations"
 	QANService_HealthCheck_FullMethodName                 = "/qan.v1.QANService/HealthCheck"
 )
 
@@ -42,6 +51,8 @@ const (
 type QANServiceClient interface {
 	// GetReport returns a list of metrics grouped by queryid or other dimensions.
 	GetReport(ctx context.Context, in *GetReportRequest, opts ...grpc.CallOption) (*GetReportResponse, error)
+	// ExportReport streams metrics grouped by queryid or other dimensions in CSV, JSON Lines or Parquet format.
+	ExportReport(ctx context.Context, in *ExportReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error)
 	// Get provides a map of metrics names.
 	GetFilteredMetricsNames(ctx context.Context, in *GetFilteredMetricsNamesRequest, opts ...grpc.CallOption) (*GetFilteredMetricsNamesResponse, error)
 	// GetMetricsNames provides a map of metrics names.
@@ -62,6 +73,20 @@ type QANServiceClient interface {
 	SchemaByQueryID(ctx context.Context, in *SchemaByQueryIDRequest, opts ...grpc.CallOption) (*SchemaByQueryIDResponse, error)
 	// GetQueryExample returns a list of query examples.
 	GetQueryExample(ctx context.Context, in *GetQueryExampleRequest, opts ...grpc.CallOption) (*GetQueryExampleResponse, error)
+	// ListQueryAnomalies returns query regressions and plan flips found by the last anomaly detection run.
+	ListQueryAnomalies(ctx context.Context, in *ListQueryAnomaliesRequest, opts ...grpc.CallOption) (*ListQueryAnomaliesResponse, error)
+	// SetQueryAnnotation creates or replaces the annotation of the query.
+	SetQueryAnnotation(ctx context.Context, in *SetQueryAnnotationRequest, opts ...grpc.CallOption) (*SetQueryAnnotationResponse, error)
+	// RemoveQueryAnnotation removes the annotation of the query.
+	RemoveQueryAnnotation(ctx context.Context, in *RemoveQueryAnnotationRequest, opts ...grpc.CallOption) (*RemoveQueryAnnotationResponse, error)
+	// ListQueryAnnotations returns query annotations.
+	ListQueryAnnotations(ctx context.Context, in *ListQueryAnnotationsRequest, opts ...grpc.CallOption) (*ListQueryAnnotationsResponse, error)
+	// ListQueryPlans returns plan versions of the query collected by plan history.
+	ListQueryPlans(ctx context.Context, in *ListQueryPlansRequest, opts ...grpc.CallOption) (*ListQueryPlansResponse, error)
+	// DiffQueryPlans compares two plans of the query.
+	DiffQueryPlans(ctx context.Context, in *DiffQueryPlansRequest, opts ...grpc.CallOption) (*DiffQueryPlansResponse, error)
+	// ListIndexRecommendations returns candidate indexes for query classes made by the index advisor.
+	ListIndexRecommendations(ctx context.Context, in *ListIndexRecommendationsRequest, opts ...grpc.CallOption) (*ListIndexRecommendationsResponse, error)
 	// HealthCheck returns readiness of QAN API2 service.
 	HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error)
 }
@@ -84,6 +109,25 @@ func (c *qANServiceClient) GetReport(ctx context.Context, in *GetReportRequest,
 	return out, nil
 }
 
+func (c *qANServiceClient) ExportReport(ctx context.Context, in *ExportReportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[httpbody.HttpBody], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &QANService_ServiceDesc.Streams[0], QANService_ExportReport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportReportRequest, httpbody.HttpBody]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QANService_ExportReportClient = grpc.ServerStreamingClient[httpbody.HttpBody]
+
 func (c *qANServiceClient) GetFilteredMetricsNames(ctx context.Context, in *GetFilteredMetricsNamesRequest, opts ...grpc.CallOption) (*GetFilteredMetricsNamesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetFilteredMetricsNamesResponse)
@@ -184,6 +228,76 @@ func (c *qANServiceClient) GetQueryExample(ctx context.Context, in *GetQueryExam
 	return out, nil
 }
 
+func (c *qANServiceClient) ListQueryAnomalies(ctx context.Context, in *ListQueryAnomaliesRequest, opts ...grpc.CallOption) (*ListQueryAnomaliesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQueryAnomaliesResponse)
+	err := c.cc.Invoke(ctx, QANService_ListQueryAnomalies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qANServiceClient) SetQueryAnnotation(ctx context.Context, in *SetQueryAnnotationRequest, opts ...grpc.CallOption) (*SetQueryAnnotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetQueryAnnotationResponse)
+	err := c.cc.Invoke(ctx, QANService_SetQueryAnnotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qANServiceClient) RemoveQueryAnnotation(ctx context.Context, in *RemoveQueryAnnotationRequest, opts ...grpc.CallOption) (*RemoveQueryAnnotationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveQueryAnnotationResponse)
+	err := c.cc.Invoke(ctx, QANService_RemoveQueryAnnotation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qANServiceClient) ListQueryAnnotations(ctx context.Context, in *ListQueryAnnotationsRequest, opts ...grpc.CallOption) (*ListQueryAnnotationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQueryAnnotationsResponse)
+	err := c.cc.Invoke(ctx, QANService_ListQueryAnnotations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qANServiceClient) ListQueryPlans(ctx context.Context, in *ListQueryPlansRequest, opts ...grpc.CallOption) (*ListQueryPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQueryPlansResponse)
+	err := c.cc.Invoke(ctx, QANService_ListQueryPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qANServiceClient) DiffQueryPlans(ctx context.Context, in *DiffQueryPlansRequest, opts ...grpc.CallOption) (*DiffQueryPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffQueryPlansResponse)
+	err := c.cc.Invoke(ctx, QANService_DiffQueryPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *qANServiceClient) ListIndexRecommendations(ctx context.Context, in *ListIndexRecommendationsRequest, opts ...grpc.CallOption) (*ListIndexRecommendationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIndexRecommendationsResponse)
+	err := c.cc.Invoke(ctx, QANService_ListIndexRecommendations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *qANServiceClient) HealthCheck(ctx context.Context, in *HealthCheckRequest, opts ...grpc.CallOption) (*HealthCheckResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(HealthCheckResponse)
@@ -202,6 +316,8 @@ func (c *qANServiceClient) HealthCheck(ctx context.Context, in *HealthCheckReque
 type QANServiceServer interface {
 	// GetReport returns a list of metrics grouped by queryid or other dimensions.
 	GetReport(context.Context, *GetReportRequest) (*GetReportResponse, error)
+	// ExportReport streams metrics grouped by queryid or other dimensions in CSV, JSON Lines or Parquet format.
+	ExportReport(*ExportReportRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error
 	// Get provides a map of metrics names.
 	GetFilteredMetricsNames(context.Context, *GetFilteredMetricsNamesRequest) (*GetFilteredMetricsNamesResponse, error)
 	// GetMetricsNames provides a map of metrics names.
@@ -222,6 +338,20 @@ type QANServiceServer interface {
 	SchemaByQueryID(context.Context, *SchemaByQueryIDRequest) (*SchemaByQueryIDResponse, error)
 	// GetQueryExample returns a list of query examples.
 	GetQueryExample(context.Context, *GetQueryExampleRequest) (*GetQueryExampleResponse, error)
+	// ListQueryAnomalies returns query regressions and plan flips found by the last anomaly detection run.
+	ListQueryAnomalies(context.Context, *ListQueryAnomaliesRequest) (*ListQueryAnomaliesResponse, error)
+	// SetQueryAnnotation creates or replaces the annotation of the query.
+	SetQueryAnnotation(context.Context, *SetQueryAnnotationRequest) (*SetQueryAnnotationResponse, error)
+	// RemoveQueryAnnotation removes the annotation of the query.
+	RemoveQueryAnnotation(context.Context, *RemoveQueryAnnotationRequest) (*RemoveQueryAnnotationResponse, error)
+	// ListQueryAnnotations returns query annotations.
+	ListQueryAnnotations(context.Context, *ListQueryAnnotationsRequest) (*ListQueryAnnotationsResponse, error)
+	// ListQueryPlans returns plan versions of the query collected by plan history.
+	ListQueryPlans(context.Context, *ListQueryPlansRequest) (*ListQueryPlansResponse, error)
+	// DiffQueryPlans compares two plans of the query.
+	DiffQueryPlans(context.Context, *DiffQueryPlansRequest) (*DiffQueryPlansResponse, error)
+	// ListIndexRecommendations returns candidate indexes for query classes made by the index advisor.
+	ListIndexRecommendations(context.Context, *ListIndexRecommendationsRequest) (*ListIndexRecommendationsResponse, error)
 	// HealthCheck returns readiness of QAN API2 service.
 	HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error)
 	mustEmbedUnimplementedQANServiceServer()
@@ -238,6 +368,10 @@ func (UnimplementedQANServiceServer) GetReport(context.Context, *GetReportReques
 	return nil, status.Error(codes.Unimplemented, "method GetReport not implemented")
 }
 
+func (UnimplementedQANServiceServer) ExportReport(*ExportReportRequest, grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	return status.Error(codes.Unimplemented, "method ExportReport not implemented")
+}
+
 func (UnimplementedQANServiceServer) GetFilteredMetricsNames(context.Context, *GetFilteredMetricsNamesRequest) (*GetFilteredMetricsNamesResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetFilteredMetricsNames not implemented")
 }
@@ -278,6 +412,34 @@ func (UnimplementedQANServiceServer) GetQueryExample(context.Context, *GetQueryE
 	return nil, status.Error(codes.Unimplemented, "method GetQueryExample not implemented")
 }
 
+func (UnimplementedQANServiceServer) ListQueryAnomalies(context.Context, *ListQueryAnomaliesRequest) (*ListQueryAnomaliesResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListQueryAnomalies not implemented")
+}
+
+func (UnimplementedQANServiceServer) SetQueryAnnotation(context.Context, *SetQueryAnnotationRequest) (*SetQueryAnnotationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetQueryAnnotation not implemented")
+}
+
+func (UnimplementedQANServiceServer) RemoveQueryAnnotation(context.Context, *RemoveQueryAnnotationRequest) (*RemoveQueryAnnotationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RemoveQueryAnnotation not implemented")
+}
+
+func (UnimplementedQANServiceServer) ListQueryAnnotations(context.Context, *ListQueryAnnotationsRequest) (*ListQueryAnnotationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListQueryAnnotations not implemented")
+}
+
+func (UnimplementedQANServiceServer) ListQueryPlans(context.Context, *ListQueryPlansRequest) (*ListQueryPlansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListQueryPlans not implemented")
+}
+
+func (UnimplementedQANServiceServer) DiffQueryPlans(context.Context, *DiffQueryPlansRequest) (*DiffQueryPlansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DiffQueryPlans not implemented")
+}
+
+func (UnimplementedQANServiceServer) ListIndexRecommendations(context.Context, *ListIndexRecommendationsRequest) (*ListIndexRecommendationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListIndexRecommendations not implemented")
+}
+
 func (UnimplementedQANServiceServer) HealthCheck(context.Context, *HealthCheckRequest) (*HealthCheckResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method HealthCheck not implemented")
 }
@@ -320,6 +482,17 @@ func _QANService_GetReport_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QANService_ExportReport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportReportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(QANServiceServer).ExportReport(m, &grpc.GenericServerStream[ExportReportRequest, httpbody.HttpBody]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type QANService_ExportReportServer = grpc.ServerStreamingServer[httpbody.HttpBody]
+
 func _QANService_GetFilteredMetricsNames_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFilteredMetricsNamesRequest)
 	if err := dec(in); err != nil {
@@ -500,6 +673,132 @@ func _QANService_GetQueryExample_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _QANService_ListQueryAnomalies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryAnomaliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QANServiceServer).ListQueryAnomalies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QANService_ListQueryAnomalies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QANServiceServer).ListQueryAnomalies(ctx, req.(*ListQueryAnomaliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QANService_SetQueryAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQueryAnnotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QANServiceServer).SetQueryAnnotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QANService_SetQueryAnnotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QANServiceServer).SetQueryAnnotation(ctx, req.(*SetQueryAnnotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QANService_RemoveQueryAnnotation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveQueryAnnotationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QANServiceServer).RemoveQueryAnnotation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QANService_RemoveQueryAnnotation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QANServiceServer).RemoveQueryAnnotation(ctx, req.(*RemoveQueryAnnotationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QANService_ListQueryAnnotations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryAnnotationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QANServiceServer).ListQueryAnnotations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QANService_ListQueryAnnotations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QANServiceServer).ListQueryAnnotations(ctx, req.(*ListQueryAnnotationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QANService_ListQueryPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQueryPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QANServiceServer).ListQueryPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QANService_ListQueryPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QANServiceServer).ListQueryPlans(ctx, req.(*ListQueryPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QANService_DiffQueryPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffQueryPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QANServiceServer).DiffQueryPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QANService_DiffQueryPlans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QANServiceServer).DiffQueryPlans(ctx, req.(*DiffQueryPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QANService_ListIndexRecommendations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIndexRecommendationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QANServiceServer).ListIndexRecommendations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: QANService_ListIndexRecommendations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QANServiceServer).ListIndexRecommendations(ctx, req.(*ListIndexRecommendationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _QANService_HealthCheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HealthCheckRequest)
 	if err := dec(in); err != nil {
@@ -569,11 +868,45 @@ var QANService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetQueryExample",
 			Handler:    _QANService_GetQueryExample_Handler,
 		},
+		{
+			MethodName: "ListQueryAnomalies",
+			Handler:    _QANService_ListQueryAnomalies_Handler,
+		},
+		{
+			MethodName: "SetQueryAnnotation",
+			Handler:    _QANService_SetQueryAnnotation_Handler,
+		},
+		{
+			MethodName: "RemoveQueryAnnotation",
+			Handler:    _QANService_RemoveQueryAnnotation_Handler,
+		},
+		{
+			MethodName: "ListQueryAnnotations",
+			Handler:    _QANService_ListQueryAnnotations_Handler,
+		},
+		{
+			MethodName: "ListQueryPlans",
+			Handler:    _QANService_ListQueryPlans_Handler,
+		},
+		{
+			MethodName: "DiffQueryPlans",
+			Handler:    _QANService_DiffQueryPlans_Handler,
+		},
+		{
+			MethodName: "ListIndexRecommendations",
+			Handler:    _QANService_ListIndexRecommendations_Handler,
+		},
 		{
 			MethodName: "HealthCheck",
 			Handler:    _QANService_HealthCheck_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportReport",
+			Handler:       _QANService_ExportReport_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "qan/v1/service.proto",
 }
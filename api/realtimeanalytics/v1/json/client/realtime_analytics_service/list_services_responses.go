@@ -419,6 +419,12 @@ swagger:model ListServicesOKBody
 type ListServicesOKBody struct {
 	// mongodb
 	Mongodb []*ListServicesOKBodyMongodbItems0 `json:"mongodb"`
+
+	// mysql
+	Mysql []*ListServicesOKBodyMysqlItems0 `json:"mysql"`
+
+	// postgresql
+	Postgresql []*ListServicesOKBodyPostgresqlItems0 `json:"postgresql"`
 }
 
 // Validate validates this list services OK body
@@ -429,6 +435,14 @@ func (o *ListServicesOKBody) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := o.validateMysql(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validatePostgresql(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -465,6 +479,66 @@ func (o *ListServicesOKBody) validateMongodb(formats strfmt.Registry) error {
 	return nil
 }
 
+func (o *ListServicesOKBody) validateMysql(formats strfmt.Registry) error {
+	if swag.IsZero(o.Mysql) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Mysql); i++ {
+		if swag.IsZero(o.Mysql[i]) { // not required
+			continue
+		}
+
+		if o.Mysql[i] != nil {
+			if err := o.Mysql[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("listServicesOk" + "." + "mysql" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("listServicesOk" + "." + "mysql" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (o *ListServicesOKBody) validatePostgresql(formats strfmt.Registry) error {
+	if swag.IsZero(o.Postgresql) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Postgresql); i++ {
+		if swag.IsZero(o.Postgresql[i]) { // not required
+			continue
+		}
+
+		if o.Postgresql[i] != nil {
+			if err := o.Postgresql[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("listServicesOk" + "." + "postgresql" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("listServicesOk" + "." + "postgresql" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this list services OK body based on the context it is used
 func (o *ListServicesOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -473,6 +547,14 @@ func (o *ListServicesOKBody) ContextValidate(ctx context.Context, formats strfmt
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateMysql(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidatePostgresql(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -505,6 +587,58 @@ func (o *ListServicesOKBody) contextValidateMongodb(ctx context.Context, formats
 	return nil
 }
 
+func (o *ListServicesOKBody) contextValidateMysql(ctx context.Context, formats strfmt.Registry) error {
+	for i := 0; i < len(o.Mysql); i++ {
+		if o.Mysql[i] != nil {
+
+			if swag.IsZero(o.Mysql[i]) { // not required
+				return nil
+			}
+
+			if err := o.Mysql[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("listServicesOk" + "." + "mysql" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("listServicesOk" + "." + "mysql" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (o *ListServicesOKBody) contextValidatePostgresql(ctx context.Context, formats strfmt.Registry) error {
+	for i := 0; i < len(o.Postgresql); i++ {
+		if o.Postgresql[i] != nil {
+
+			if swag.IsZero(o.Postgresql[i]) { // not required
+				return nil
+			}
+
+			if err := o.Postgresql[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("listServicesOk" + "." + "postgresql" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("listServicesOk" + "." + "postgresql" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (o *ListServicesOKBody) MarshalBinary() ([]byte, error) {
 	if o == nil {
@@ -592,3 +726,152 @@ func (o *ListServicesOKBodyMongodbItems0) UnmarshalBinary(b []byte) error {
 	*o = res
 	return nil
 }
+
+/*
+ListServicesOKBodyMysqlItems0 MySQLService represents a generic MySQL instance.
+swagger:model ListServicesOKBodyMysqlItems0
+*/
+type ListServicesOKBodyMysqlItems0 struct {
+	// Unique randomly generated instance identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// Unique across all Services user-defined name.
+	ServiceName string `json:"service_name,omitempty"`
+
+	// Node identifier where this instance runs.
+	NodeID string `json:"node_id,omitempty"`
+
+	// Access address (DNS name or IP).
+	// Address (and port) or socket is required.
+	Address string `json:"address,omitempty"`
+
+	// Access port.
+	// Port is required when the address present.
+	Port int64 `json:"port,omitempty"`
+
+	// Access unix socket.
+	// Address (and port) or socket is required.
+	Socket string `json:"socket,omitempty"`
+
+	// Environment name.
+	Environment string `json:"environment,omitempty"`
+
+	// Cluster name.
+	Cluster string `json:"cluster,omitempty"`
+
+	// Replication set name.
+	ReplicationSet string `json:"replication_set,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// MySQL version.
+	Version string `json:"version,omitempty"`
+
+	// Extra parameters to be added to the DSN.
+	ExtraDsnParams map[string]string `json:"extra_dsn_params,omitempty"`
+}
+
+// Validate validates this list services OK body mysql items0
+func (o *ListServicesOKBodyMysqlItems0) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this list services OK body mysql items0 based on context it is used
+func (o *ListServicesOKBodyMysqlItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ListServicesOKBodyMysqlItems0) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ListServicesOKBodyMysqlItems0) UnmarshalBinary(b []byte) error {
+	var res ListServicesOKBodyMysqlItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+ListServicesOKBodyPostgresqlItems0 PostgreSQLService represents a generic PostgreSQL instance.
+swagger:model ListServicesOKBodyPostgresqlItems0
+*/
+type ListServicesOKBodyPostgresqlItems0 struct {
+	// Unique randomly generated instance identifier.
+	ServiceID string `json:"service_id,omitempty"`
+
+	// Unique across all Services user-defined name.
+	ServiceName string `json:"service_name,omitempty"`
+
+	// Database name.
+	DatabaseName string `json:"database_name,omitempty"`
+
+	// Node identifier where this instance runs.
+	NodeID string `json:"node_id,omitempty"`
+
+	// Access address (DNS name or IP).
+	// Address (and port) or socket is required.
+	Address string `json:"address,omitempty"`
+
+	// Access port.
+	// Port is required when the address present.
+	Port int64 `json:"port,omitempty"`
+
+	// Access unix socket.
+	// Address (and port) or socket is required.
+	Socket string `json:"socket,omitempty"`
+
+	// Environment name.
+	Environment string `json:"environment,omitempty"`
+
+	// Cluster name.
+	Cluster string `json:"cluster,omitempty"`
+
+	// Replication set name.
+	ReplicationSet string `json:"replication_set,omitempty"`
+
+	// Custom user-assigned labels.
+	CustomLabels map[string]string `json:"custom_labels,omitempty"`
+
+	// PostgreSQL version.
+	Version string `json:"version,omitempty"`
+
+	// Limit of databases for auto-discovery.
+	AutoDiscoveryLimit int32 `json:"auto_discovery_limit,omitempty"`
+}
+
+// Validate validates this list services OK body postgresql items0
+func (o *ListServicesOKBodyPostgresqlItems0) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this list services OK body postgresql items0 based on context it is used
+func (o *ListServicesOKBodyPostgresqlItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ListServicesOKBodyPostgresqlItems0) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ListServicesOKBodyPostgresqlItems0) UnmarshalBinary(b []byte) error {
+	var res ListServicesOKBodyPostgresqlItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
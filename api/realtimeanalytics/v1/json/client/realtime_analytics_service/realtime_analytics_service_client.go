@@ -51,19 +51,69 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	KillQuery(params *KillQueryParams, opts ...ClientOption) (*KillQueryOK, error)
+
 	ListServices(params *ListServicesParams, opts ...ClientOption) (*ListServicesOK, error)
 
 	ListSessions(params *ListSessionsParams, opts ...ClientOption) (*ListSessionsOK, error)
 
+	ReplaySession(params *ReplaySessionParams, opts ...ClientOption) (*ReplaySessionOK, error)
+
 	SearchQueries(params *SearchQueriesParams, opts ...ClientOption) (*SearchQueriesOK, error)
 
 	StartSession(params *StartSessionParams, opts ...ClientOption) (*StartSessionOK, error)
 
 	StopSession(params *StopSessionParams, opts ...ClientOption) (*StopSessionOK, error)
 
+	WatchQueries(params *WatchQueriesParams, opts ...ClientOption) (*WatchQueriesOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
+/*
+KillQuery kills running database query
+
+Terminates a running Database Query in a particular service. The kill is recorded as a Grafana annotation.
+*/
+func (a *Client) KillQuery(params *KillQueryParams, opts ...ClientOption) (*KillQueryOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewKillQueryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "KillQuery",
+		Method:             "POST",
+		PathPattern:        "/v1/realtimeanalytics/queries:kill",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &KillQueryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*KillQueryOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*KillQueryDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 ListServices lists services that support real time analytics
 
@@ -152,6 +202,50 @@ func (a *Client) ListSessions(params *ListSessionsParams, opts ...ClientOption)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+ReplaySession replays real time analytics session
+
+Returns Database queries that were running in a particular services at the requested point in time. Requires Real-Time Analytics recorder to be enabled.
+*/
+func (a *Client) ReplaySession(params *ReplaySessionParams, opts ...ClientOption) (*ReplaySessionOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewReplaySessionParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ReplaySession",
+		Method:             "POST",
+		PathPattern:        "/v1/realtimeanalytics/sessions:replay",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ReplaySessionReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ReplaySessionOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*ReplaySessionDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 SearchQueries lists running database queries in a particular services
 
@@ -284,6 +378,50 @@ func (a *Client) StopSession(params *StopSessionParams, opts ...ClientOption) (*
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+WatchQueries watches running database queries in a particular services
+
+Streams added, updated and finished Database queries in a particular services as they are collected. Send 'Accept: text/event-stream' header to receive Server-Sent Events.
+*/
+func (a *Client) WatchQueries(params *WatchQueriesParams, opts ...ClientOption) (*WatchQueriesOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewWatchQueriesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "WatchQueries",
+		Method:             "GET",
+		PathPattern:        "/v1/realtimeanalytics/queries:watch",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &WatchQueriesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*WatchQueriesOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*WatchQueriesDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 // SetTransport changes the transport on the client
 func (a *Client) SetTransport(transport runtime.ClientTransport) {
 	a.transport = transport
@@ -597,6 +597,12 @@ type SearchQueriesOKBodyQueriesItems0 struct {
 
 	// mongo db payload
 	MongoDBPayload *SearchQueriesOKBodyQueriesItems0MongoDBPayload `json:"mongo_db_payload,omitempty"`
+
+	// mysql payload
+	MysqlPayload *SearchQueriesOKBodyQueriesItems0MysqlPayload `json:"mysql_payload,omitempty"`
+
+	// postgresql payload
+	PostgresqlPayload *SearchQueriesOKBodyQueriesItems0PostgresqlPayload `json:"postgresql_payload,omitempty"`
 }
 
 // Validate validates this search queries OK body queries items0
@@ -611,6 +617,14 @@ func (o *SearchQueriesOKBodyQueriesItems0) Validate(formats strfmt.Registry) err
 		res = append(res, err)
 	}
 
+	if err := o.validateMysqlPayload(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validatePostgresqlPayload(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -652,6 +666,52 @@ func (o *SearchQueriesOKBodyQueriesItems0) validateMongoDBPayload(formats strfmt
 	return nil
 }
 
+func (o *SearchQueriesOKBodyQueriesItems0) validateMysqlPayload(formats strfmt.Registry) error {
+	if swag.IsZero(o.MysqlPayload) { // not required
+		return nil
+	}
+
+	if o.MysqlPayload != nil {
+		if err := o.MysqlPayload.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("mysql_payload")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("mysql_payload")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *SearchQueriesOKBodyQueriesItems0) validatePostgresqlPayload(formats strfmt.Registry) error {
+	if swag.IsZero(o.PostgresqlPayload) { // not required
+		return nil
+	}
+
+	if o.PostgresqlPayload != nil {
+		if err := o.PostgresqlPayload.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("postgresql_payload")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("postgresql_payload")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 // ContextValidate validate this search queries OK body queries items0 based on the context it is used
 func (o *SearchQueriesOKBodyQueriesItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -660,6 +720,14 @@ func (o *SearchQueriesOKBodyQueriesItems0) ContextValidate(ctx context.Context,
 		res = append(res, err)
 	}
 
+	if err := o.contextValidateMysqlPayload(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.contextValidatePostgresqlPayload(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -690,6 +758,54 @@ func (o *SearchQueriesOKBodyQueriesItems0) contextValidateMongoDBPayload(ctx con
 	return nil
 }
 
+func (o *SearchQueriesOKBodyQueriesItems0) contextValidateMysqlPayload(ctx context.Context, formats strfmt.Registry) error {
+	if o.MysqlPayload != nil {
+
+		if swag.IsZero(o.MysqlPayload) { // not required
+			return nil
+		}
+
+		if err := o.MysqlPayload.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("mysql_payload")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("mysql_payload")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (o *SearchQueriesOKBodyQueriesItems0) contextValidatePostgresqlPayload(ctx context.Context, formats strfmt.Registry) error {
+	if o.PostgresqlPayload != nil {
+
+		if swag.IsZero(o.PostgresqlPayload) { // not required
+			return nil
+		}
+
+		if err := o.PostgresqlPayload.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("postgresql_payload")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("postgresql_payload")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (o *SearchQueriesOKBodyQueriesItems0) MarshalBinary() ([]byte, error) {
 	if o == nil {
@@ -787,3 +903,159 @@ func (o *SearchQueriesOKBodyQueriesItems0MongoDBPayload) UnmarshalBinary(b []byt
 	*o = res
 	return nil
 }
+
+/*
+SearchQueriesOKBodyQueriesItems0MysqlPayload QueryMySQLData holds MySQL-specific Real-Time Analytics query information.
+swagger:model SearchQueriesOKBodyQueriesItems0MysqlPayload
+*/
+type SearchQueriesOKBodyQueriesItems0MysqlPayload struct {
+	// MySQL connection (processlist) identifier executing the query.
+	ConnectionID string `json:"connection_id,omitempty"`
+
+	// Performance Schema thread identifier (0 when collected from processlist).
+	ThreadID string `json:"thread_id,omitempty"`
+
+	// MySQL user name associated with the query.
+	Username string `json:"username,omitempty"`
+
+	// Default database of the connection.
+	DatabaseName string `json:"database_name,omitempty"`
+
+	// Thread command ("Query", "Execute", etc).
+	Command string `json:"command,omitempty"`
+
+	// Thread state (e.g. "executing", "Waiting for table metadata lock").
+	State string `json:"state,omitempty"`
+
+	// Statement digest (empty when collected from processlist).
+	Digest string `json:"digest,omitempty"`
+
+	// Number of rows examined by the statement so far.
+	RowsExamined string `json:"rows_examined,omitempty"`
+
+	// Number of rows sent by the statement so far.
+	RowsSent string `json:"rows_sent,omitempty"`
+
+	// Time spent waiting for table locks.
+	LockTime string `json:"lock_time,omitempty"`
+
+	// Data source the query was collected from ("performance_schema" or "processlist").
+	Source string `json:"source,omitempty"`
+
+	// Performance Schema statement event identifier (0 when collected from processlist).
+	EventID string `json:"event_id,omitempty"`
+}
+
+// Validate validates this search queries OK body queries items0 mysql payload
+func (o *SearchQueriesOKBodyQueriesItems0MysqlPayload) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this search queries OK body queries items0 mysql payload based on context it is used
+func (o *SearchQueriesOKBodyQueriesItems0MysqlPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *SearchQueriesOKBodyQueriesItems0MysqlPayload) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *SearchQueriesOKBodyQueriesItems0MysqlPayload) UnmarshalBinary(b []byte) error {
+	var res SearchQueriesOKBodyQueriesItems0MysqlPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
+
+/*
+SearchQueriesOKBodyQueriesItems0PostgresqlPayload QueryPostgreSQLData holds PostgreSQL-specific Real-Time Analytics query information.
+swagger:model SearchQueriesOKBodyQueriesItems0PostgresqlPayload
+*/
+type SearchQueriesOKBodyQueriesItems0PostgresqlPayload struct {
+	// Process ID of the backend executing the query.
+	Pid int32 `json:"pid,omitempty"`
+
+	// Name of the database the backend is connected to.
+	DatabaseName string `json:"database_name,omitempty"`
+
+	// Name of the user logged into the backend.
+	Username string `json:"username,omitempty"`
+
+	// Name of the application connected to the backend.
+	ApplicationName string `json:"application_name,omitempty"`
+
+	// Current overall state of the backend ("active", "idle in transaction", etc).
+	State string `json:"state,omitempty"`
+
+	// Type of event the backend is waiting for, if any.
+	WaitEventType string `json:"wait_event_type,omitempty"`
+
+	// Wait event name if backend is currently waiting.
+	WaitEvent string `json:"wait_event,omitempty"`
+
+	// Top-level transaction identifier of the backend, if any.
+	BackendXid string `json:"backend_xid,omitempty"`
+
+	// Time when the currently active query was started.
+	// Format: date-time
+	QueryStart strfmt.DateTime `json:"query_start,omitempty"`
+
+	// Query fingerprint (normalized query text).
+	Fingerprint string `json:"fingerprint,omitempty"`
+}
+
+// Validate validates this search queries OK body queries items0 postgresql payload
+func (o *SearchQueriesOKBodyQueriesItems0PostgresqlPayload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateQueryStart(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *SearchQueriesOKBodyQueriesItems0PostgresqlPayload) validateQueryStart(formats strfmt.Registry) error {
+	if swag.IsZero(o.QueryStart) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("postgresql_payload"+"."+"query_start", "body", "date-time", o.QueryStart.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this search queries OK body queries items0 postgresql payload based on context it is used
+func (o *SearchQueriesOKBodyQueriesItems0PostgresqlPayload) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *SearchQueriesOKBodyQueriesItems0PostgresqlPayload) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *SearchQueriesOKBodyQueriesItems0PostgresqlPayload) UnmarshalBinary(b []byte) error {
+	var res SearchQueriesOKBodyQueriesItems0PostgresqlPayload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -135,6 +135,278 @@ func (x *QueryMongoDBData) GetPlanSummary() string {
 	return ""
 }
 
+// QueryMySQLData holds MySQL-specific Real-Time Analytics query information.
+type QueryMySQLData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// MySQL connection (processlist) identifier executing the query.
+	ConnectionId uint64 `protobuf:"varint,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty"`
+	// Performance Schema thread identifier (0 when collected from processlist).
+	ThreadId uint64 `protobuf:"varint,2,opt,name=thread_id,json=threadId,proto3" json:"thread_id,omitempty"`
+	// MySQL user name associated with the query.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// Default database of the connection.
+	DatabaseName string `protobuf:"bytes,4,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	// Thread command ("Query", "Execute", etc).
+	Command string `protobuf:"bytes,5,opt,name=command,proto3" json:"command,omitempty"`
+	// Thread state (e.g. "executing", "Waiting for table metadata lock").
+	State string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	// Statement digest (empty when collected from processlist).
+	Digest string `protobuf:"bytes,7,opt,name=digest,proto3" json:"digest,omitempty"`
+	// Number of rows examined by the statement so far.
+	RowsExamined uint64 `protobuf:"varint,8,opt,name=rows_examined,json=rowsExamined,proto3" json:"rows_examined,omitempty"`
+	// Number of rows sent by the statement so far.
+	RowsSent uint64 `protobuf:"varint,9,opt,name=rows_sent,json=rowsSent,proto3" json:"rows_sent,omitempty"`
+	// Time spent waiting for table locks.
+	LockTime *durationpb.Duration `protobuf:"bytes,10,opt,name=lock_time,json=lockTime,proto3" json:"lock_time,omitempty"`
+	// Data source the query was collected from ("performance_schema" or "processlist").
+	Source string `protobuf:"bytes,11,opt,name=source,proto3" json:"source,omitempty"`
+	// Performance Schema statement event identifier (0 when collected from processlist).
+	EventId       uint64 `protobuf:"varint,12,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryMySQLData) Reset() {
+	*x = QueryMySQLData{}
+	mi := &file_realtimeanalytics_v1_query_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryMySQLData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryMySQLData) ProtoMessage() {}
+
+func (x *QueryMySQLData) ProtoReflect() protoreflect.Message {
+	mi := &file_realtimeanalytics_v1_query_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryMySQLData.ProtoReflect.Descriptor instead.
+func (*QueryMySQLData) Descriptor() ([]byte, []int) {
+	return file_realtimeanalytics_v1_query_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *QueryMySQLData) GetConnectionId() uint64 {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return 0
+}
+
+func (x *QueryMySQLData) GetThreadId() uint64 {
+	if x != nil {
+		return x.ThreadId
+	}
+	return 0
+}
+
+func (x *QueryMySQLData) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *QueryMySQLData) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *QueryMySQLData) GetCommand() string {
+	if x != nil {
+		return x.Command
+	}
+	return ""
+}
+
+func (x *QueryMySQLData) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *QueryMySQLData) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *QueryMySQLData) GetRowsExamined() uint64 {
+	if x != nil {
+		return x.RowsExamined
+	}
+	return 0
+}
+
+func (x *QueryMySQLData) GetRowsSent() uint64 {
+	if x != nil {
+		return x.RowsSent
+	}
+	return 0
+}
+
+func (x *QueryMySQLData) GetLockTime() *durationpb.Duration {
+	if x != nil {
+		return x.LockTime
+	}
+	return nil
+}
+
+func (x *QueryMySQLData) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *QueryMySQLData) GetEventId() uint64 {
+	if x != nil {
+		return x.EventId
+	}
+	return 0
+}
+
+// QueryPostgreSQLData holds PostgreSQL-specific Real-Time Analytics query information.
+type QueryPostgreSQLData struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Process ID of the backend executing the query.
+	Pid int32 `protobuf:"varint,1,opt,name=pid,proto3" json:"pid,omitempty"`
+	// Name of the database the backend is connected to.
+	DatabaseName string `protobuf:"bytes,2,opt,name=database_name,json=databaseName,proto3" json:"database_name,omitempty"`
+	// Name of the user logged into the backend.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// Name of the application connected to the backend.
+	ApplicationName string `protobuf:"bytes,4,opt,name=application_name,json=applicationName,proto3" json:"application_name,omitempty"`
+	// Current overall state of the backend ("active", "idle in transaction", etc).
+	State string `protobuf:"bytes,5,opt,name=state,proto3" json:"state,omitempty"`
+	// Type of event the backend is waiting for, if any.
+	WaitEventType string `protobuf:"bytes,6,opt,name=wait_event_type,json=waitEventType,proto3" json:"wait_event_type,omitempty"`
+	// Wait event name if backend is currently waiting.
+	WaitEvent string `protobuf:"bytes,7,opt,name=wait_event,json=waitEvent,proto3" json:"wait_event,omitempty"`
+	// Top-level transaction identifier of the backend, if any.
+	BackendXid string `protobuf:"bytes,8,opt,name=backend_xid,json=backendXid,proto3" json:"backend_xid,omitempty"`
+	// Time when the currently active query was started.
+	QueryStart *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=query_start,json=queryStart,proto3" json:"query_start,omitempty"`
+	// Query fingerprint (normalized query text).
+	Fingerprint   string `protobuf:"bytes,10,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryPostgreSQLData) Reset() {
+	*x = QueryPostgreSQLData{}
+	mi := &file_realtimeanalytics_v1_query_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryPostgreSQLData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryPostgreSQLData) ProtoMessage() {}
+
+func (x *QueryPostgreSQLData) ProtoReflect() protoreflect.Message {
+	mi := &file_realtimeanalytics_v1_query_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryPostgreSQLData.ProtoReflect.Descriptor instead.
+func (*QueryPostgreSQLData) Descriptor() ([]byte, []int) {
+	return file_realtimeanalytics_v1_query_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *QueryPostgreSQLData) GetPid() int32 {
+	if x != nil {
+		return x.Pid
+	}
+	return 0
+}
+
+func (x *QueryPostgreSQLData) GetDatabaseName() string {
+	if x != nil {
+		return x.DatabaseName
+	}
+	return ""
+}
+
+func (x *QueryPostgreSQLData) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *QueryPostgreSQLData) GetApplicationName() string {
+	if x != nil {
+		return x.ApplicationName
+	}
+	return ""
+}
+
+func (x *QueryPostgreSQLData) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *QueryPostgreSQLData) GetWaitEventType() string {
+	if x != nil {
+		return x.WaitEventType
+	}
+	return ""
+}
+
+func (x *QueryPostgreSQLData) GetWaitEvent() string {
+	if x != nil {
+		return x.WaitEvent
+	}
+	return ""
+}
+
+func (x *QueryPostgreSQLData) GetBackendXid() string {
+	if x != nil {
+		return x.BackendXid
+	}
+	return ""
+}
+
+func (x *QueryPostgreSQLData) GetQueryStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.QueryStart
+	}
+	return nil
+}
+
+func (x *QueryPostgreSQLData) GetFingerprint() string {
+	if x != nil {
+		return x.Fingerprint
+	}
+	return ""
+}
+
 // QueryData represents a single Real-Time Analytics query data point.
 // It includes general query information and a payload for database-specific details.
 type QueryData struct {
@@ -160,6 +432,8 @@ type QueryData struct {
 	// Types that are valid to be assigned to Payload:
 	//
 	//	*QueryData_MongoDbPayload
+	//	*QueryData_MysqlPayload
+	//	*QueryData_PostgresqlPayload
 	Payload       isQueryData_Payload `protobuf_oneof:"payload"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -167,7 +441,7 @@ type QueryData struct {
 
 func (x *QueryData) Reset() {
 	*x = QueryData{}
-	mi := &file_realtimeanalytics_v1_query_proto_msgTypes[1]
+	mi := &file_realtimeanalytics_v1_query_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -179,7 +453,7 @@ func (x *QueryData) String() string {
 func (*QueryData) ProtoMessage() {}
 
 func (x *QueryData) ProtoReflect() protoreflect.Message {
-	mi := &file_realtimeanalytics_v1_query_proto_msgTypes[1]
+	mi := &file_realtimeanalytics_v1_query_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -192,7 +466,7 @@ func (x *QueryData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueryData.ProtoReflect.Descriptor instead.
 func (*QueryData) Descriptor() ([]byte, []int) {
-	return file_realtimeanalytics_v1_query_proto_rawDescGZIP(), []int{1}
+	return file_realtimeanalytics_v1_query_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *QueryData) GetServiceId() string {
@@ -267,6 +541,24 @@ func (x *QueryData) GetMongoDbPayload() *QueryMongoDBData {
 	return nil
 }
 
+func (x *QueryData) GetMysqlPayload() *QueryMySQLData {
+	if x != nil {
+		if x, ok := x.Payload.(*QueryData_MysqlPayload); ok {
+			return x.MysqlPayload
+		}
+	}
+	return nil
+}
+
+func (x *QueryData) GetPostgresqlPayload() *QueryPostgreSQLData {
+	if x != nil {
+		if x, ok := x.Payload.(*QueryData_PostgresqlPayload); ok {
+			return x.PostgresqlPayload
+		}
+	}
+	return nil
+}
+
 type isQueryData_Payload interface {
 	isQueryData_Payload()
 }
@@ -276,8 +568,22 @@ type QueryData_MongoDbPayload struct {
 	MongoDbPayload *QueryMongoDBData `protobuf:"bytes,9,opt,name=mongo_db_payload,json=mongoDbPayload,proto3,oneof"`
 }
 
+type QueryData_MysqlPayload struct {
+	// MySQL-specific query data.
+	MysqlPayload *QueryMySQLData `protobuf:"bytes,10,opt,name=mysql_payload,json=mysqlPayload,proto3,oneof"`
+}
+
+type QueryData_PostgresqlPayload struct {
+	// PostgreSQL-specific query data.
+	PostgresqlPayload *QueryPostgreSQLData `protobuf:"bytes,11,opt,name=postgresql_payload,json=postgresqlPayload,proto3,oneof"`
+}
+
 func (*QueryData_MongoDbPayload) isQueryData_Payload() {}
 
+func (*QueryData_MysqlPayload) isQueryData_Payload() {}
+
+func (*QueryData_PostgresqlPayload) isQueryData_Payload() {}
+
 var File_realtimeanalytics_v1_query_proto protoreflect.FileDescriptor
 
 const file_realtimeanalytics_v1_query_proto_rawDesc = "" +
@@ -293,7 +599,36 @@ const file_realtimeanalytics_v1_query_proto_rawDesc = "" +
 	"\toperation\x18\x05 \x01(\tR\toperation\x12L\n" +
 	"\x14operation_start_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x12operationStartTime\x12 \n" +
 	"\busername\x18\a \x01(\tB\x04\x88\xb5\x18\x01R\busername\x12!\n" +
-	"\fplan_summary\x18\b \x01(\tR\vplanSummary\"\xd2\x03\n" +
+	"\fplan_summary\x18\b \x01(\tR\vplanSummary\"\x8e\x03\n" +
+	"\x0eQueryMySQLData\x12#\n" +
+	"\rconnection_id\x18\x01 \x01(\x04R\fconnectionId\x12\x1b\n" +
+	"\tthread_id\x18\x02 \x01(\x04R\bthreadId\x12 \n" +
+	"\busername\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\busername\x12#\n" +
+	"\rdatabase_name\x18\x04 \x01(\tR\fdatabaseName\x12\x18\n" +
+	"\acommand\x18\x05 \x01(\tR\acommand\x12\x14\n" +
+	"\x05state\x18\x06 \x01(\tR\x05state\x12\x16\n" +
+	"\x06digest\x18\a \x01(\tR\x06digest\x12#\n" +
+	"\rrows_examined\x18\b \x01(\x04R\frowsExamined\x12\x1b\n" +
+	"\trows_sent\x18\t \x01(\x04R\browsSent\x126\n" +
+	"\tlock_time\x18\n" +
+	" \x01(\v2\x19.google.protobuf.DurationR\blockTime\x12\x16\n" +
+	"\x06source\x18\v \x01(\tR\x06source\x12\x19\n" +
+	"\bevent_id\x18\f \x01(\x04R\aeventId\"\xf6\x02\n" +
+	"\x13QueryPostgreSQLData\x12\x10\n" +
+	"\x03pid\x18\x01 \x01(\x05R\x03pid\x12#\n" +
+	"\rdatabase_name\x18\x02 \x01(\tR\fdatabaseName\x12 \n" +
+	"\busername\x18\x03 \x01(\tB\x04\x88\xb5\x18\x01R\busername\x12)\n" +
+	"\x10application_name\x18\x04 \x01(\tR\x0fapplicationName\x12\x14\n" +
+	"\x05state\x18\x05 \x01(\tR\x05state\x12&\n" +
+	"\x0fwait_event_type\x18\x06 \x01(\tR\rwaitEventType\x12\x1d\n" +
+	"\n" +
+	"wait_event\x18\a \x01(\tR\twaitEvent\x12\x1f\n" +
+	"\vbackend_xid\x18\b \x01(\tR\n" +
+	"backendXid\x12;\n" +
+	"\vquery_start\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"queryStart\x12 \n" +
+	"\vfingerprint\x18\n" +
+	" \x01(\tR\vfingerprint\"\xfb\x04\n" +
 	"\tQueryData\x12\x1d\n" +
 	"\n" +
 	"service_id\x18\x01 \x01(\tR\tserviceId\x12!\n" +
@@ -305,7 +640,10 @@ const file_realtimeanalytics_v1_query_proto_rawDesc = "" +
 	"\x18query_execution_duration\x18\x06 \x01(\v2\x19.google.protobuf.DurationR\x16queryExecutionDuration\x12H\n" +
 	"\x12query_collect_time\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x10queryCollectTime\x12%\n" +
 	"\x0eclient_address\x18\b \x01(\tR\rclientAddress\x12R\n" +
-	"\x10mongo_db_payload\x18\t \x01(\v2&.realtimeanalytics.v1.QueryMongoDBDataH\x00R\x0emongoDbPayloadB\t\n" +
+	"\x10mongo_db_payload\x18\t \x01(\v2&.realtimeanalytics.v1.QueryMongoDBDataH\x00R\x0emongoDbPayload\x12K\n" +
+	"\rmysql_payload\x18\n" +
+	" \x01(\v2$.realtimeanalytics.v1.QueryMySQLDataH\x00R\fmysqlPayload\x12Z\n" +
+	"\x12postgresql_payload\x18\v \x01(\v2).realtimeanalytics.v1.QueryPostgreSQLDataH\x00R\x11postgresqlPayloadB\t\n" +
 	"\apayloadB\xdc\x01\n" +
 	"\x18com.realtimeanalytics.v1B\n" +
 	"QueryProtoP\x01ZCgithub.com/percona/pmm/api/realtimeanalytics/v1;realtimeanalyticsv1\xa2\x02\x03RXX\xaa\x02\x14Realtimeanalytics.V1\xca\x02\x14Realtimeanalytics\\V1\xe2\x02 Realtimeanalytics\\V1\\GPBMetadata\xea\x02\x15Realtimeanalytics::V1b\x06proto3"
@@ -323,25 +661,31 @@ func file_realtimeanalytics_v1_query_proto_rawDescGZIP() []byte {
 }
 
 var (
-	file_realtimeanalytics_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+	file_realtimeanalytics_v1_query_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 	file_realtimeanalytics_v1_query_proto_goTypes  = []any{
 		(*QueryMongoDBData)(nil),      // 0: realtimeanalytics.v1.QueryMongoDBData
-		(*QueryData)(nil),             // 1: realtimeanalytics.v1.QueryData
-		(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
-		(*durationpb.Duration)(nil),   // 3: google.protobuf.Duration
+		(*QueryMySQLData)(nil),        // 1: realtimeanalytics.v1.QueryMySQLData
+		(*QueryPostgreSQLData)(nil),   // 2: realtimeanalytics.v1.QueryPostgreSQLData
+		(*QueryData)(nil),             // 3: realtimeanalytics.v1.QueryData
+		(*timestamppb.Timestamp)(nil), // 4: google.protobuf.Timestamp
+		(*durationpb.Duration)(nil),   // 5: google.protobuf.Duration
 	}
 )
 
 var file_realtimeanalytics_v1_query_proto_depIdxs = []int32{
-	2, // 0: realtimeanalytics.v1.QueryMongoDBData.operation_start_time:type_name -> google.protobuf.Timestamp
-	3, // 1: realtimeanalytics.v1.QueryData.query_execution_duration:type_name -> google.protobuf.Duration
-	2, // 2: realtimeanalytics.v1.QueryData.query_collect_time:type_name -> google.protobuf.Timestamp
-	0, // 3: realtimeanalytics.v1.QueryData.mongo_db_payload:type_name -> realtimeanalytics.v1.QueryMongoDBData
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	4, // 0: realtimeanalytics.v1.QueryMongoDBData.operation_start_time:type_name -> google.protobuf.Timestamp
+	5, // 1: realtimeanalytics.v1.QueryMySQLData.lock_time:type_name -> google.protobuf.Duration
+	4, // 2: realtimeanalytics.v1.QueryPostgreSQLData.query_start:type_name -> google.protobuf.Timestamp
+	5, // 3: realtimeanalytics.v1.QueryData.query_execution_duration:type_name -> google.protobuf.Duration
+	4, // 4: realtimeanalytics.v1.QueryData.query_collect_time:type_name -> google.protobuf.Timestamp
+	0, // 5: realtimeanalytics.v1.QueryData.mongo_db_payload:type_name -> realtimeanalytics.v1.QueryMongoDBData
+	1, // 6: realtimeanalytics.v1.QueryData.mysql_payload:type_name -> realtimeanalytics.v1.QueryMySQLData
+	2, // 7: realtimeanalytics.v1.QueryData.postgresql_payload:type_name -> realtimeanalytics.v1.QueryPostgreSQLData
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
 }
 
 func init() { file_realtimeanalytics_v1_query_proto_init() }
@@ -349,8 +693,10 @@ func file_realtimeanalytics_v1_query_proto_init() {
 	if File_realtimeanalytics_v1_query_proto != nil {
 		return
 	}
-	file_realtimeanalytics_v1_query_proto_msgTypes[1].OneofWrappers = []any{
+	file_realtimeanalytics_v1_query_proto_msgTypes[3].OneofWrappers = []any{
 		(*QueryData_MongoDbPayload)(nil),
+		(*QueryData_MysqlPayload)(nil),
+		(*QueryData_PostgresqlPayload)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -358,7 +704,7 @@ func file_realtimeanalytics_v1_query_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_realtimeanalytics_v1_query_proto_rawDesc), len(file_realtimeanalytics_v1_query_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
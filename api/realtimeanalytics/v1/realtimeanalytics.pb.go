@@ -85,6 +85,62 @@ func (SessionStatus) EnumDescriptor() ([]byte, []int) {
 	return file_realtimeanalytics_v1_realtimeanalytics_proto_rawDescGZIP(), []int{0}
 }
 
+// QueryEventType represents a change of a running Database Query.
+type QueryEventType int32
+
+const (
+	QueryEventType_QUERY_EVENT_TYPE_UNSPECIFIED QueryEventType = 0
+	// Query has started running or has started matching the filters.
+	QueryEventType_QUERY_EVENT_TYPE_ADDED QueryEventType = 1
+	// Query is still running, its data was updated.
+	QueryEventType_QUERY_EVENT_TYPE_UPDATED QueryEventType = 2
+	// Query has finished or its service has stopped reporting it.
+	QueryEventType_QUERY_EVENT_TYPE_FINISHED QueryEventType = 3
+)
+
+// Enum value maps for QueryEventType.
+var (
+	QueryEventType_name = map[int32]string{
+		0: "QUERY_EVENT_TYPE_UNSPECIFIED",
+		1: "QUERY_EVENT_TYPE_ADDED",
+		2: "QUERY_EVENT_TYPE_UPDATED",
+		3: "QUERY_EVENT_TYPE_FINISHED",
+	}
+	QueryEventType_value = map[string]int32{
+		"QUERY_EVENT_TYPE_UNSPECIFIED": 0,
+		"QUERY_EVENT_TYPE_ADDED":       1,
+		"QUERY_EVENT_TYPE_UPDATED":     2,
+		"QUERY_EVENT_TYPE_FINISHED":    3,
+	}
+)
+
+func (x QueryEventType) Enum() *QueryEventType {
+	p := new(QueryEventType)
+	*p = x
+	return p
+}
+
+func (x QueryEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (QueryEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_realtimeanalytics_v1_realtimeanalytics_proto_enumTypes[1].Descriptor()
+}
+
+func (QueryEventType) Type() protoreflect.EnumType {
+	return &file_realtimeanalytics_v1_realtimeanalytics_proto_enumTypes[1]
+}
+
+func (x QueryEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use QueryEventType.Descriptor instead.
+func (QueryEventType) EnumDescriptor() ([]byte, []int) {
+	return file_realtimeanalytics_v1_realtimeanalytics_proto_rawDescGZIP(), []int{1}
+}
+
 type ListServicesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Return only services filtered by service type.
@@ -131,8 +187,10 @@ func (x *ListServicesRequest) GetServiceType() v1.ServiceType {
 }
 
 type ListServicesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Mongodb       []*v1.MongoDBService   `protobuf:"bytes,1,rep,name=mongodb,proto3" json:"mongodb,omitempty"`
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Mongodb       []*v1.MongoDBService    `protobuf:"bytes,1,rep,name=mongodb,proto3" json:"mongodb,omitempty"`
+	Mysql         []*v1.MySQLService      `protobuf:"bytes,2,rep,name=mysql,proto3" json:"mysql,omitempty"`
+	Postgresql    []*v1.PostgreSQLService `protobuf:"bytes,3,rep,name=postgresql,proto3" json:"postgresql,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -174,6 +232,20 @@ func (x *ListServicesResponse) GetMongodb() []*v1.MongoDBService {
 	return nil
 }
 
+func (x *ListServicesResponse) GetMysql() []*v1.MySQLService {
+	if x != nil {
+		return x.Mysql
+	}
+	return nil
+}
+
+func (x *ListServicesResponse) GetPostgresql() []*v1.PostgreSQLService {
+	if x != nil {
+		return x.Postgresql
+	}
+	return nil
+}
+
 // Session represents an active Real-Time Analytics session.
 type Session struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -632,15 +704,388 @@ func (x *SearchQueriesResponse) GetQueries() []*QueryData {
 	return nil
 }
 
+// QueryEvent represents a change of a single running Database Query.
+type QueryEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Type of the change.
+	Type QueryEventType `protobuf:"varint,1,opt,name=type,proto3,enum=realtimeanalytics.v1.QueryEventType" json:"type,omitempty"`
+	// Query data. For finished queries it is the last reported data.
+	Query         *QueryData `protobuf:"bytes,2,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueryEvent) Reset() {
+	*x = QueryEvent{}
+	mi := &file_realtimeanalytics_v1_realtimeanalytics_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueryEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueryEvent) ProtoMessage() {}
+
+func (x *QueryEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_realtimeanalytics_v1_realtimeanalytics_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueryEvent.ProtoReflect.Descriptor instead.
+func (*QueryEvent) Descriptor() ([]byte, []int) {
+	return file_realtimeanalytics_v1_realtimeanalytics_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *QueryEvent) GetType() QueryEventType {
+	if x != nil {
+		return x.Type
+	}
+	return QueryEventType_QUERY_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *QueryEvent) GetQuery() *QueryData {
+	if x != nil {
+		return x.Query
+	}
+	return nil
+}
+
+// WatchQueriesRequest contains filters for watching active Real-Time Analytics session Queries.
+type WatchQueriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required filter by Service identifiers.
+	ServiceIds []string `protobuf:"bytes,1,rep,name=service_ids,json=serviceIds,proto3" json:"service_ids,omitempty"`
+	// Optional filter by minimal query execution duration.
+	MinDuration *durationpb.Duration `protobuf:"bytes,2,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
+	// Optional filter by query operation: MongoDB operation ("find", "aggregate", etc)
+	// or SQL statement type ("select", "update", etc). Case-insensitive.
+	Operation     string `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchQueriesRequest) Reset() {
+	*x = WatchQueriesRequest{}
+	mi := &file_realtimeanalytics_v1_realtimeanalytics_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchQueriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchQueriesRequest) ProtoMessage() {}
+
+func (x *WatchQueriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realtimeanalytics_v1_realtimeanalytics_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchQueriesRequest.ProtoReflect.Descriptor instead.
+func (*WatchQueriesRequest) Descriptor() ([]byte, []int) {
+	return file_realtimeanalytics_v1_realtimeanalytics_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *WatchQueriesRequest) GetServiceIds() []string {
+	if x != nil {
+		return x.ServiceIds
+	}
+	return nil
+}
+
+func (x *WatchQueriesRequest) GetMinDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MinDuration
+	}
+	return nil
+}
+
+func (x *WatchQueriesRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+// WatchQueriesResponse contains a batch of Query changes.
+// Empty batch is sent periodically as a heartbeat.
+type WatchQueriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of Query changes.
+	Events        []*QueryEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchQueriesResponse) Reset() {
+	*x = WatchQueriesResponse{}
+	mi := &file_realtimeanalytics_v1_realtimeanalytics_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchQueriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchQueriesResponse) ProtoMessage() {}
+
+func (x *WatchQueriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realtimeanalytics_v1_realtimeanalytics_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchQueriesResponse.ProtoReflect.Descriptor instead.
+func (*WatchQueriesResponse) Descriptor() ([]byte, []int) {
+	return file_realtimeanalytics_v1_realtimeanalytics_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *WatchQueriesResponse) GetEvents() []*QueryEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// KillQueryRequest contains parameters for terminating a running Database Query.
+type KillQueryRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required service identifier the Query is running in.
+	ServiceId string `protobuf:"bytes,1,opt,name=service_id,json=serviceId,proto3" json:"service_id,omitempty"`
+	// Required Query identifier as reported by Real-Time Analytics session.
+	QueryId       string `protobuf:"bytes,2,opt,name=query_id,json=queryId,proto3" json:"query_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KillQueryRequest) Reset() {
+	*x = KillQueryRequest{}
+	mi := &file_realtimeanalytics_v1_realtimeanalytics_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillQueryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillQueryRequest) ProtoMessage() {}
+
+func (x *KillQueryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realtimeanalytics_v1_realtimeanalytics_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillQueryRequest.ProtoReflect.Descriptor instead.
+func (*KillQueryRequest) Descriptor() ([]byte, []int) {
+	return file_realtimeanalytics_v1_realtimeanalytics_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *KillQueryRequest) GetServiceId() string {
+	if x != nil {
+		return x.ServiceId
+	}
+	return ""
+}
+
+func (x *KillQueryRequest) GetQueryId() string {
+	if x != nil {
+		return x.QueryId
+	}
+	return ""
+}
+
+// KillQueryResponse is the response for terminating a running Database Query.
+type KillQueryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KillQueryResponse) Reset() {
+	*x = KillQueryResponse{}
+	mi := &file_realtimeanalytics_v1_realtimeanalytics_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KillQueryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KillQueryResponse) ProtoMessage() {}
+
+func (x *KillQueryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realtimeanalytics_v1_realtimeanalytics_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KillQueryResponse.ProtoReflect.Descriptor instead.
+func (*KillQueryResponse) Descriptor() ([]byte, []int) {
+	return file_realtimeanalytics_v1_realtimeanalytics_proto_rawDescGZIP(), []int{15}
+}
+
+// ReplaySessionRequest contains parameters for reconstructing Database Queries recorded by Real-Time Analytics.
+type ReplaySessionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Required filter by Service identifiers.
+	ServiceIds []string `protobuf:"bytes,1,rep,name=service_ids,json=serviceIds,proto3" json:"service_ids,omitempty"`
+	// Required point in time to return running Queries for.
+	Time *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// Optional limit the number of queries in response.
+	Limit         int64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaySessionRequest) Reset() {
+	*x = ReplaySessionRequest{}
+	mi := &file_realtimeanalytics_v1_realtimeanalytics_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaySessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaySessionRequest) ProtoMessage() {}
+
+func (x *ReplaySessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_realtimeanalytics_v1_realtimeanalytics_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaySessionRequest.ProtoReflect.Descriptor instead.
+func (*ReplaySessionRequest) Descriptor() ([]byte, []int) {
+	return file_realtimeanalytics_v1_realtimeanalytics_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ReplaySessionRequest) GetServiceIds() []string {
+	if x != nil {
+		return x.ServiceIds
+	}
+	return nil
+}
+
+func (x *ReplaySessionRequest) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *ReplaySessionRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// ReplaySessionResponse returns the list of Database Queries that were running at the requested point in time.
+type ReplaySessionResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// List of recorded Queries. Execution duration is calculated as of the requested point in time.
+	Queries       []*QueryData `protobuf:"bytes,1,rep,name=queries,proto3" json:"queries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplaySessionResponse) Reset() {
+	*x = ReplaySessionResponse{}
+	mi := &file_realtimeanalytics_v1_realtimeanalytics_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplaySessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplaySessionResponse) ProtoMessage() {}
+
+func (x *ReplaySessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_realtimeanalytics_v1_realtimeanalytics_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplaySessionResponse.ProtoReflect.Descriptor instead.
+func (*ReplaySessionResponse) Descriptor() ([]byte, []int) {
+	return file_realtimeanalytics_v1_realtimeanalytics_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ReplaySessionResponse) GetQueries() []*QueryData {
+	if x != nil {
+		return x.Queries
+	}
+	return nil
+}
+
 var File_realtimeanalytics_v1_realtimeanalytics_proto protoreflect.FileDescriptor
 
 const file_realtimeanalytics_v1_realtimeanalytics_proto_rawDesc = "" +
 	"\n" +
 	",realtimeanalytics/v1/realtimeanalytics.proto\x12\x14realtimeanalytics.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x1binventory/v1/services.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a realtimeanalytics/v1/query.proto\x1a\x17validate/validate.proto\"S\n" +
 	"\x13ListServicesRequest\x12<\n" +
-	"\fservice_type\x18\x01 \x01(\x0e2\x19.inventory.v1.ServiceTypeR\vserviceType\"N\n" +
+	"\fservice_type\x18\x01 \x01(\x0e2\x19.inventory.v1.ServiceTypeR\vserviceType\"\xc1\x01\n" +
 	"\x14ListServicesResponse\x126\n" +
-	"\amongodb\x18\x01 \x03(\v2\x1c.inventory.v1.MongoDBServiceR\amongodb\"\xac\x02\n" +
+	"\amongodb\x18\x01 \x03(\v2\x1c.inventory.v1.MongoDBServiceR\amongodb\x120\n" +
+	"\x05mysql\x18\x02 \x03(\v2\x1a.inventory.v1.MySQLServiceR\x05mysql\x12?\n" +
+	"\n" +
+	"postgresql\x18\x03 \x03(\v2\x1f.inventory.v1.PostgreSQLServiceR\n" +
+	"postgresql\"\xac\x02\n" +
 	"\aSession\x12\x1d\n" +
 	"\n" +
 	"service_id\x18\x01 \x01(\tR\tserviceId\x12!\n" +
@@ -669,18 +1114,51 @@ const file_realtimeanalytics_v1_realtimeanalytics_proto_rawDesc = "" +
 	"serviceIds\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x03R\x05limit\"R\n" +
 	"\x15SearchQueriesResponse\x129\n" +
+	"\aqueries\x18\x01 \x03(\v2\x1f.realtimeanalytics.v1.QueryDataR\aqueries\"}\n" +
+	"\n" +
+	"QueryEvent\x128\n" +
+	"\x04type\x18\x01 \x01(\x0e2$.realtimeanalytics.v1.QueryEventTypeR\x04type\x125\n" +
+	"\x05query\x18\x02 \x01(\v2\x1f.realtimeanalytics.v1.QueryDataR\x05query\"\x9e\x01\n" +
+	"\x13WatchQueriesRequest\x12+\n" +
+	"\vservice_ids\x18\x01 \x03(\tB\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x18\x01R\n" +
+	"serviceIds\x12<\n" +
+	"\fmin_duration\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\vminDuration\x12\x1c\n" +
+	"\toperation\x18\x03 \x01(\tR\toperation\"P\n" +
+	"\x14WatchQueriesResponse\x128\n" +
+	"\x06events\x18\x01 \x03(\v2 .realtimeanalytics.v1.QueryEventR\x06events\"^\n" +
+	"\x10KillQueryRequest\x12&\n" +
+	"\n" +
+	"service_id\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\tserviceId\x12\"\n" +
+	"\bquery_id\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aqueryId\"\x13\n" +
+	"\x11KillQueryResponse\"\x93\x01\n" +
+	"\x14ReplaySessionRequest\x12+\n" +
+	"\vservice_ids\x18\x01 \x03(\tB\n" +
+	"\xfaB\a\x92\x01\x04\b\x01\x18\x01R\n" +
+	"serviceIds\x128\n" +
+	"\x04time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampB\b\xfaB\x05\xb2\x01\x02\b\x01R\x04time\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x03R\x05limit\"R\n" +
+	"\x15ReplaySessionResponse\x129\n" +
 	"\aqueries\x18\x01 \x03(\v2\x1f.realtimeanalytics.v1.QueryDataR\aqueries*~\n" +
 	"\rSessionStatus\x12\x1e\n" +
 	"\x1aSESSION_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
 	"\x14SESSION_STATUS_ERROR\x10\x01\x12\x1a\n" +
 	"\x16SESSION_STATUS_RUNNING\x10\x02\x12\x17\n" +
-	"\x13SESSION_STATUS_DOWN\x10\x032\x94\v\n" +
+	"\x13SESSION_STATUS_DOWN\x10\x03*\x8b\x01\n" +
+	"\x0eQueryEventType\x12 \n" +
+	"\x1cQUERY_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16QUERY_EVENT_TYPE_ADDED\x10\x01\x12\x1c\n" +
+	"\x18QUERY_EVENT_TYPE_UPDATED\x10\x02\x12\x1d\n" +
+	"\x19QUERY_EVENT_TYPE_FINISHED\x10\x032\x94\x13\n" +
 	"\x18RealtimeAnalyticsService\x12\x90\x02\n" +
 	"\fListServices\x12).realtimeanalytics.v1.ListServicesRequest\x1a*.realtimeanalytics.v1.ListServicesResponse\"\xa8\x01\x92A\x7f\x12.List Services that support Real-Time Analytics\x1aMReturns a list of Services that support Real-Time Analytics filtered by type.\x82\xd3\xe4\x93\x02 \x12\x1e/v1/realtimeanalytics/services\x12\xcc\x02\n" +
 	"\fListSessions\x12).realtimeanalytics.v1.ListSessionsRequest\x1a*.realtimeanalytics.v1.ListSessionsResponse\"\xe4\x01\x92A\xba\x01\x12)List Running Real-Time Analytics Sessions\x1a\x8c\x01Returns the list of all currently running Real-Time Analytics sessions with their details including service, cluster and status information.\x82\xd3\xe4\x93\x02 \x12\x1e/v1/realtimeanalytics/sessions\x12\xf9\x01\n" +
 	"\fStartSession\x12).realtimeanalytics.v1.StartSessionRequest\x1a*.realtimeanalytics.v1.StartSessionResponse\"\x91\x01\x92A_\x12!Start Real-Time Analytics session\x1a:Start Real-Time Analytics session for a specified service.\x82\xd3\xe4\x93\x02):\x01*\"$/v1/realtimeanalytics/sessions:start\x12\xf3\x01\n" +
 	"\vStopSession\x12(.realtimeanalytics.v1.StopSessionRequest\x1a).realtimeanalytics.v1.StopSessionResponse\"\x8e\x01\x92A]\x12 Stop Real-Time Analytics session\x1a9Stop Real-Time Analytics session for a specified service.\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/realtimeanalytics/sessions:stop\x12\xa3\x02\n" +
-	"\rSearchQueries\x12*.realtimeanalytics.v1.SearchQueriesRequest\x1a+.realtimeanalytics.v1.SearchQueriesResponse\"\xb8\x01\x92A\x85\x01\x126List Running Database Queries in a particular services\x1aKReturns list of currently running Database queries in a particular services\x82\xd3\xe4\x93\x02):\x01*\"$/v1/realtimeanalytics/queries:searchB\xe8\x01\n" +
+	"\rSearchQueries\x12*.realtimeanalytics.v1.SearchQueriesRequest\x1a+.realtimeanalytics.v1.SearchQueriesResponse\"\xb8\x01\x92A\x85\x01\x126List Running Database Queries in a particular services\x1aKReturns list of currently running Database queries in a particular services\x82\xd3\xe4\x93\x02):\x01*\"$/v1/realtimeanalytics/queries:search\x12\x80\x03\n" +
+	"\fWatchQueries\x12).realtimeanalytics.v1.WatchQueriesRequest\x1a*.realtimeanalytics.v1.WatchQueriesResponse\"\x96\x02\x92A\xe7\x01\x127Watch Running Database Queries in a particular services\x1a\xab\x01Streams added, updated and finished Database queries in a particular services as they are collected. Send 'Accept: text/event-stream' header to receive Server-Sent Events.\x82\xd3\xe4\x93\x02%\x12#/v1/realtimeanalytics/queries:watch0\x01\x12\x99\x02\n" +
+	"\tKillQuery\x12&.realtimeanalytics.v1.KillQueryRequest\x1a'.realtimeanalytics.v1.KillQueryResponse\"\xba\x01\x92A\x89\x01\x12\x1bKill running Database Query\x1ajTerminates a running Database Query in a particular service. The kill is recorded as a Grafana annotation.\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/realtimeanalytics/queries:kill\x12\xde\x02\n" +
+	"\rReplaySession\x12*.realtimeanalytics.v1.ReplaySessionRequest\x1a+.realtimeanalytics.v1.ReplaySessionResponse\"\xf3\x01\x92A\xbf\x01\x12\"Replay Real-Time Analytics session\x1a\x98\x01Returns Database queries that were running in a particular services at the requested point in time. Requires Real-Time Analytics recorder to be enabled.\x82\xd3\xe4\x93\x02*:\x01*\"%/v1/realtimeanalytics/sessions:replayB\xe8\x01\n" +
 	"\x18com.realtimeanalytics.v1B\x16RealtimeanalyticsProtoP\x01ZCgithub.com/percona/pmm/api/realtimeanalytics/v1;realtimeanalyticsv1\xa2\x02\x03RXX\xaa\x02\x14Realtimeanalytics.V1\xca\x02\x14Realtimeanalytics\\V1\xe2\x02 Realtimeanalytics\\V1\\GPBMetadata\xea\x02\x15Realtimeanalytics::V1b\x06proto3"
 
 var (
@@ -696,53 +1174,77 @@ func file_realtimeanalytics_v1_realtimeanalytics_proto_rawDescGZIP() []byte {
 }
 
 var (
-	file_realtimeanalytics_v1_realtimeanalytics_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-	file_realtimeanalytics_v1_realtimeanalytics_proto_msgTypes  = make([]protoimpl.MessageInfo, 11)
+	file_realtimeanalytics_v1_realtimeanalytics_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+	file_realtimeanalytics_v1_realtimeanalytics_proto_msgTypes  = make([]protoimpl.MessageInfo, 18)
 	file_realtimeanalytics_v1_realtimeanalytics_proto_goTypes   = []any{
 		SessionStatus(0),              // 0: realtimeanalytics.v1.SessionStatus
-		(*ListServicesRequest)(nil),   // 1: realtimeanalytics.v1.ListServicesRequest
-		(*ListServicesResponse)(nil),  // 2: realtimeanalytics.v1.ListServicesResponse
-		(*Session)(nil),               // 3: realtimeanalytics.v1.Session
-		(*ListSessionsRequest)(nil),   // 4: realtimeanalytics.v1.ListSessionsRequest
-		(*ListSessionsResponse)(nil),  // 5: realtimeanalytics.v1.ListSessionsResponse
-		(*StartSessionRequest)(nil),   // 6: realtimeanalytics.v1.StartSessionRequest
-		(*StartSessionResponse)(nil),  // 7: realtimeanalytics.v1.StartSessionResponse
-		(*StopSessionRequest)(nil),    // 8: realtimeanalytics.v1.StopSessionRequest
-		(*StopSessionResponse)(nil),   // 9: realtimeanalytics.v1.StopSessionResponse
-		(*SearchQueriesRequest)(nil),  // 10: realtimeanalytics.v1.SearchQueriesRequest
-		(*SearchQueriesResponse)(nil), // 11: realtimeanalytics.v1.SearchQueriesResponse
-		v1.ServiceType(0),             // 12: inventory.v1.ServiceType
-		(*v1.MongoDBService)(nil),     // 13: inventory.v1.MongoDBService
-		(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
-		(*durationpb.Duration)(nil),   // 15: google.protobuf.Duration
-		(*QueryData)(nil),             // 16: realtimeanalytics.v1.QueryData
+		QueryEventType(0),             // 1: realtimeanalytics.v1.QueryEventType
+		(*ListServicesRequest)(nil),   // 2: realtimeanalytics.v1.ListServicesRequest
+		(*ListServicesResponse)(nil),  // 3: realtimeanalytics.v1.ListServicesResponse
+		(*Session)(nil),               // 4: realtimeanalytics.v1.Session
+		(*ListSessionsRequest)(nil),   // 5: realtimeanalytics.v1.ListSessionsRequest
+		(*ListSessionsResponse)(nil),  // 6: realtimeanalytics.v1.ListSessionsResponse
+		(*StartSessionRequest)(nil),   // 7: realtimeanalytics.v1.StartSessionRequest
+		(*StartSessionResponse)(nil),  // 8: realtimeanalytics.v1.StartSessionResponse
+		(*StopSessionRequest)(nil),    // 9: realtimeanalytics.v1.StopSessionRequest
+		(*StopSessionResponse)(nil),   // 10: realtimeanalytics.v1.StopSessionResponse
+		(*SearchQueriesRequest)(nil),  // 11: realtimeanalytics.v1.SearchQueriesRequest
+		(*SearchQueriesResponse)(nil), // 12: realtimeanalytics.v1.SearchQueriesResponse
+		(*QueryEvent)(nil),            // 13: realtimeanalytics.v1.QueryEvent
+		(*WatchQueriesRequest)(nil),   // 14: realtimeanalytics.v1.WatchQueriesRequest
+		(*WatchQueriesResponse)(nil),  // 15: realtimeanalytics.v1.WatchQueriesResponse
+		(*KillQueryRequest)(nil),      // 16: realtimeanalytics.v1.KillQueryRequest
+		(*KillQueryResponse)(nil),     // 17: realtimeanalytics.v1.KillQueryResponse
+		(*ReplaySessionRequest)(nil),  // 18: realtimeanalytics.v1.ReplaySessionRequest
+		(*ReplaySessionResponse)(nil), // 19: realtimeanalytics.v1.ReplaySessionResponse
+		v1.ServiceType(0),             // 20: inventory.v1.ServiceType
+		(*v1.MongoDBService)(nil),     // 21: inventory.v1.MongoDBService
+		(*v1.MySQLService)(nil),       // 22: inventory.v1.MySQLService
+		(*v1.PostgreSQLService)(nil),  // 23: inventory.v1.PostgreSQLService
+		(*timestamppb.Timestamp)(nil), // 24: google.protobuf.Timestamp
+		(*durationpb.Duration)(nil),   // 25: google.protobuf.Duration
+		(*QueryData)(nil),             // 26: realtimeanalytics.v1.QueryData
 	}
 )
 
 var file_realtimeanalytics_v1_realtimeanalytics_proto_depIdxs = []int32{
-	12, // 0: realtimeanalytics.v1.ListServicesRequest.service_type:type_name -> inventory.v1.ServiceType
-	13, // 1: realtimeanalytics.v1.ListServicesResponse.mongodb:type_name -> inventory.v1.MongoDBService
-	14, // 2: realtimeanalytics.v1.Session.start_time:type_name -> google.protobuf.Timestamp
-	15, // 3: realtimeanalytics.v1.Session.collect_interval:type_name -> google.protobuf.Duration
-	0,  // 4: realtimeanalytics.v1.Session.status:type_name -> realtimeanalytics.v1.SessionStatus
-	3,  // 5: realtimeanalytics.v1.ListSessionsResponse.sessions:type_name -> realtimeanalytics.v1.Session
-	3,  // 6: realtimeanalytics.v1.StartSessionResponse.session:type_name -> realtimeanalytics.v1.Session
-	16, // 7: realtimeanalytics.v1.SearchQueriesResponse.queries:type_name -> realtimeanalytics.v1.QueryData
-	1,  // 8: realtimeanalytics.v1.RealtimeAnalyticsService.ListServices:input_type -> realtimeanalytics.v1.ListServicesRequest
-	4,  // 9: realtimeanalytics.v1.RealtimeAnalyticsService.ListSessions:input_type -> realtimeanalytics.v1.ListSessionsRequest
-	6,  // 10: realtimeanalytics.v1.RealtimeAnalyticsService.StartSession:input_type -> realtimeanalytics.v1.StartSessionRequest
-	8,  // 11: realtimeanalytics.v1.RealtimeAnalyticsService.StopSession:input_type -> realtimeanalytics.v1.StopSessionRequest
-	10, // 12: realtimeanalytics.v1.RealtimeAnalyticsService.SearchQueries:input_type -> realtimeanalytics.v1.SearchQueriesRequest
-	2,  // 13: realtimeanalytics.v1.RealtimeAnalyticsService.ListServices:output_type -> realtimeanalytics.v1.ListServicesResponse
-	5,  // 14: realtimeanalytics.v1.RealtimeAnalyticsService.ListSessions:output_type -> realtimeanalytics.v1.ListSessionsResponse
-	7,  // 15: realtimeanalytics.v1.RealtimeAnalyticsService.StartSession:output_type -> realtimeanalytics.v1.StartSessionResponse
-	9,  // 16: realtimeanalytics.v1.RealtimeAnalyticsService.StopSession:output_type -> realtimeanalytics.v1.StopSessionResponse
-	11, // 17: realtimeanalytics.v1.RealtimeAnalyticsService.SearchQueries:output_type -> realtimeanalytics.v1.SearchQueriesResponse
-	13, // [13:18] is the sub-list for method output_type
-	8,  // [8:13] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	20, // 0: realtimeanalytics.v1.ListServicesRequest.service_type:type_name -> inventory.v1.ServiceType
+	21, // 1: realtimeanalytics.v1.ListServicesResponse.mongodb:type_name -> inventory.v1.MongoDBService
+	22, // 2: realtimeanalytics.v1.ListServicesResponse.mysql:type_name -> inventory.v1.MySQLService
+	23, // 3: realtimeanalytics.v1.ListServicesResponse.postgresql:type_name -> inventory.v1.PostgreSQLService
+	24, // 4: realtimeanalytics.v1.Session.start_time:type_name -> google.protobuf.Timestamp
+	25, // 5: realtimeanalytics.v1.Session.collect_interval:type_name -> google.protobuf.Duration
+	0,  // 6: realtimeanalytics.v1.Session.status:type_name -> realtimeanalytics.v1.SessionStatus
+	4,  // 7: realtimeanalytics.v1.ListSessionsResponse.sessions:type_name -> realtimeanalytics.v1.Session
+	4,  // 8: realtimeanalytics.v1.StartSessionResponse.session:type_name -> realtimeanalytics.v1.Session
+	26, // 9: realtimeanalytics.v1.SearchQueriesResponse.queries:type_name -> realtimeanalytics.v1.QueryData
+	1,  // 10: realtimeanalytics.v1.QueryEvent.type:type_name -> realtimeanalytics.v1.QueryEventType
+	26, // 11: realtimeanalytics.v1.QueryEvent.query:type_name -> realtimeanalytics.v1.QueryData
+	25, // 12: realtimeanalytics.v1.WatchQueriesRequest.min_duration:type_name -> google.protobuf.Duration
+	13, // 13: realtimeanalytics.v1.WatchQueriesResponse.events:type_name -> realtimeanalytics.v1.QueryEvent
+	24, // 14: realtimeanalytics.v1.ReplaySessionRequest.time:type_name -> google.protobuf.Timestamp
+	26, // 15: realtimeanalytics.v1.ReplaySessionResponse.queries:type_name -> realtimeanalytics.v1.QueryData
+	2,  // 16: realtimeanalytics.v1.RealtimeAnalyticsService.ListServices:input_type -> realtimeanalytics.v1.ListServicesRequest
+	5,  // 17: realtimeanalytics.v1.RealtimeAnalyticsService.ListSessions:input_type -> realtimeanalytics.v1.ListSessionsRequest
+	7,  // 18: realtimeanalytics.v1.RealtimeAnalyticsService.StartSession:input_type -> realtimeanalytics.v1.StartSessionRequest
+	9,  // 19: realtimeanalytics.v1.RealtimeAnalyticsService.StopSession:input_type -> realtimeanalytics.v1.StopSessionRequest
+	11, // 20: realtimeanalytics.v1.RealtimeAnalyticsService.SearchQueries:input_type -> realtimeanalytics.v1.SearchQueriesRequest
+	14, // 21: realtimeanalytics.v1.RealtimeAnalyticsService.WatchQueries:input_type -> realtimeanalytics.v1.WatchQueriesRequest
+	16, // 22: realtimeanalytics.v1.RealtimeAnalyticsService.KillQuery:input_type -> realtimeanalytics.v1.KillQueryRequest
+	18, // 23: realtimeanalytics.v1.RealtimeAnalyticsService.ReplaySession:input_type -> realtimeanalytics.v1.ReplaySessionRequest
+	3,  // 24: realtimeanalytics.v1.RealtimeAnalyticsService.ListServices:output_type -> realtimeanalytics.v1.ListServicesResponse
+	6,  // 25: realtimeanalytics.v1.RealtimeAnalyticsService.ListSessions:output_type -> realtimeanalytics.v1.ListSessionsResponse
+	8,  // 26: realtimeanalytics.v1.RealtimeAnalyticsService.StartSession:output_type -> realtimeanalytics.v1.StartSessionResponse
+	10, // 27: realtimeanalytics.v1.RealtimeAnalyticsService.StopSession:output_type -> realtimeanalytics.v1.StopSessionResponse
+	12, // 28: realtimeanalytics.v1.RealtimeAnalyticsService.SearchQueries:output_type -> realtimeanalytics.v1.SearchQueriesResponse
+	15, // 29: realtimeanalytics.v1.RealtimeAnalyticsService.WatchQueries:output_type -> realtimeanalytics.v1.WatchQueriesResponse
+	17, // 30: realtimeanalytics.v1.RealtimeAnalyticsService.KillQuery:output_type -> realtimeanalytics.v1.KillQueryResponse
+	19, // 31: realtimeanalytics.v1.RealtimeAnalyticsService.ReplaySession:output_type -> realtimeanalytics.v1.ReplaySessionResponse
+	24, // [24:32] is the sub-list for method output_type
+	16, // [16:24] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
 }
 
 func init() { file_realtimeanalytics_v1_realtimeanalytics_proto_init() }
@@ -756,8 +1258,8 @@ func file_realtimeanalytics_v1_realtimeanalytics_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_realtimeanalytics_v1_realtimeanalytics_proto_rawDesc), len(file_realtimeanalytics_v1_realtimeanalytics_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   11,
+			NumEnums:      2,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -200,6 +200,74 @@ func (m *ListServicesResponse) validate(all bool) error {
 
 	}
 
+	for idx, item := range m.GetMysql() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListServicesResponseValidationError{
+						field:  fmt.Sprintf("Mysql[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListServicesResponseValidationError{
+						field:  fmt.Sprintf("Mysql[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListServicesResponseValidationError{
+					field:  fmt.Sprintf("Mysql[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPostgresql() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListServicesResponseValidationError{
+						field:  fmt.Sprintf("Postgresql[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListServicesResponseValidationError{
+						field:  fmt.Sprintf("Postgresql[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListServicesResponseValidationError{
+					field:  fmt.Sprintf("Postgresql[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return ListServicesResponseMultiError(errors)
 	}
@@ -1424,3 +1492,948 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = SearchQueriesResponseValidationError{}
+
+// Validate checks the field values on QueryEvent with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *QueryEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on QueryEvent with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in QueryEventMultiError, or
+// nil if none found.
+func (m *QueryEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *QueryEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	if all {
+		switch v := interface{}(m.GetQuery()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, QueryEventValidationError{
+					field:  "Query",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, QueryEventValidationError{
+					field:  "Query",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetQuery()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return QueryEventValidationError{
+				field:  "Query",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return QueryEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// QueryEventMultiError is an error wrapping multiple validation errors
+// returned by QueryEvent.ValidateAll() if the designated constraints aren't met.
+type QueryEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m QueryEventMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m QueryEventMultiError) AllErrors() []error { return m }
+
+// QueryEventValidationError is the validation error returned by
+// QueryEvent.Validate if the designated constraints aren't met.
+type QueryEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e QueryEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e QueryEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e QueryEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e QueryEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e QueryEventValidationError) ErrorName() string { return "QueryEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e QueryEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sQueryEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = QueryEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = QueryEventValidationError{}
+
+// Validate checks the field values on WatchQueriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchQueriesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchQueriesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchQueriesRequestMultiError, or nil if none found.
+func (m *WatchQueriesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchQueriesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetServiceIds()) < 1 {
+		err := WatchQueriesRequestValidationError{
+			field:  "ServiceIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_WatchQueriesRequest_ServiceIds_Unique := make(map[string]struct{}, len(m.GetServiceIds()))
+
+	for idx, item := range m.GetServiceIds() {
+		_, _ = idx, item
+
+		if _, exists := _WatchQueriesRequest_ServiceIds_Unique[item]; exists {
+			err := WatchQueriesRequestValidationError{
+				field:  fmt.Sprintf("ServiceIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_WatchQueriesRequest_ServiceIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for ServiceIds[idx]
+	}
+
+	if all {
+		switch v := interface{}(m.GetMinDuration()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, WatchQueriesRequestValidationError{
+					field:  "MinDuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, WatchQueriesRequestValidationError{
+					field:  "MinDuration",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMinDuration()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return WatchQueriesRequestValidationError{
+				field:  "MinDuration",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Operation
+
+	if len(errors) > 0 {
+		return WatchQueriesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchQueriesRequestMultiError is an error wrapping multiple validation
+// errors returned by WatchQueriesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WatchQueriesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchQueriesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchQueriesRequestMultiError) AllErrors() []error { return m }
+
+// WatchQueriesRequestValidationError is the validation error returned by
+// WatchQueriesRequest.Validate if the designated constraints aren't met.
+type WatchQueriesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchQueriesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchQueriesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchQueriesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchQueriesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchQueriesRequestValidationError) ErrorName() string {
+	return "WatchQueriesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchQueriesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchQueriesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = WatchQueriesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchQueriesRequestValidationError{}
+
+// Validate checks the field values on WatchQueriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WatchQueriesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WatchQueriesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WatchQueriesResponseMultiError, or nil if none found.
+func (m *WatchQueriesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WatchQueriesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WatchQueriesResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WatchQueriesResponseValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WatchQueriesResponseValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return WatchQueriesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WatchQueriesResponseMultiError is an error wrapping multiple validation
+// errors returned by WatchQueriesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WatchQueriesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WatchQueriesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WatchQueriesResponseMultiError) AllErrors() []error { return m }
+
+// WatchQueriesResponseValidationError is the validation error returned by
+// WatchQueriesResponse.Validate if the designated constraints aren't met.
+type WatchQueriesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WatchQueriesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WatchQueriesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WatchQueriesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WatchQueriesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WatchQueriesResponseValidationError) ErrorName() string {
+	return "WatchQueriesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WatchQueriesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWatchQueriesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = WatchQueriesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WatchQueriesResponseValidationError{}
+
+// Validate checks the field values on KillQueryRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *KillQueryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KillQueryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KillQueryRequestMultiError, or nil if none found.
+func (m *KillQueryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KillQueryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetServiceId()) < 1 {
+		err := KillQueryRequestValidationError{
+			field:  "ServiceId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetQueryId()) < 1 {
+		err := KillQueryRequestValidationError{
+			field:  "QueryId",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return KillQueryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// KillQueryRequestMultiError is an error wrapping multiple validation errors
+// returned by KillQueryRequest.ValidateAll() if the designated constraints
+// aren't met.
+type KillQueryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KillQueryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KillQueryRequestMultiError) AllErrors() []error { return m }
+
+// KillQueryRequestValidationError is the validation error returned by
+// KillQueryRequest.Validate if the designated constraints aren't met.
+type KillQueryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KillQueryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KillQueryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KillQueryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KillQueryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KillQueryRequestValidationError) ErrorName() string { return "KillQueryRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KillQueryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKillQueryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = KillQueryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KillQueryRequestValidationError{}
+
+// Validate checks the field values on KillQueryResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *KillQueryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KillQueryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// KillQueryResponseMultiError, or nil if none found.
+func (m *KillQueryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KillQueryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return KillQueryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// KillQueryResponseMultiError is an error wrapping multiple validation errors
+// returned by KillQueryResponse.ValidateAll() if the designated constraints
+// aren't met.
+type KillQueryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KillQueryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KillQueryResponseMultiError) AllErrors() []error { return m }
+
+// KillQueryResponseValidationError is the validation error returned by
+// KillQueryResponse.Validate if the designated constraints aren't met.
+type KillQueryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KillQueryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KillQueryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KillQueryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KillQueryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KillQueryResponseValidationError) ErrorName() string {
+	return "KillQueryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e KillQueryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKillQueryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = KillQueryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KillQueryResponseValidationError{}
+
+// Validate checks the field values on ReplaySessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplaySessionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplaySessionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplaySessionRequestMultiError, or nil if none found.
+func (m *ReplaySessionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplaySessionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(m.GetServiceIds()) < 1 {
+		err := ReplaySessionRequestValidationError{
+			field:  "ServiceIds",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_ReplaySessionRequest_ServiceIds_Unique := make(map[string]struct{}, len(m.GetServiceIds()))
+
+	for idx, item := range m.GetServiceIds() {
+		_, _ = idx, item
+
+		if _, exists := _ReplaySessionRequest_ServiceIds_Unique[item]; exists {
+			err := ReplaySessionRequestValidationError{
+				field:  fmt.Sprintf("ServiceIds[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_ReplaySessionRequest_ServiceIds_Unique[item] = struct{}{}
+		}
+
+		// no validation rules for ServiceIds[idx]
+	}
+
+	if m.GetTime() == nil {
+		err := ReplaySessionRequestValidationError{
+			field:  "Time",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return ReplaySessionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplaySessionRequestMultiError is an error wrapping multiple validation
+// errors returned by ReplaySessionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReplaySessionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplaySessionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplaySessionRequestMultiError) AllErrors() []error { return m }
+
+// ReplaySessionRequestValidationError is the validation error returned by
+// ReplaySessionRequest.Validate if the designated constraints aren't met.
+type ReplaySessionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplaySessionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplaySessionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplaySessionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplaySessionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplaySessionRequestValidationError) ErrorName() string {
+	return "ReplaySessionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplaySessionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplaySessionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ReplaySessionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplaySessionRequestValidationError{}
+
+// Validate checks the field values on ReplaySessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplaySessionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplaySessionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplaySessionResponseMultiError, or nil if none found.
+func (m *ReplaySessionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplaySessionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetQueries() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ReplaySessionResponseValidationError{
+						field:  fmt.Sprintf("Queries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ReplaySessionResponseValidationError{
+						field:  fmt.Sprintf("Queries[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ReplaySessionResponseValidationError{
+					field:  fmt.Sprintf("Queries[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReplaySessionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplaySessionResponseMultiError is an error wrapping multiple validation
+// errors returned by ReplaySessionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ReplaySessionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplaySessionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplaySessionResponseMultiError) AllErrors() []error { return m }
+
+// ReplaySessionResponseValidationError is the validation error returned by
+// ReplaySessionResponse.Validate if the designated constraints aren't met.
+type ReplaySessionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplaySessionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplaySessionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplaySessionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplaySessionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplaySessionResponseValidationError) ErrorName() string {
+	return "ReplaySessionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplaySessionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplaySessionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause,
+	)
+}
+
+var _ error = ReplaySessionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplaySessionResponseValidationError{}
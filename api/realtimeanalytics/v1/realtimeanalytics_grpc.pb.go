@@ -25,6 +25,9 @@ const (
 	RealtimeAnalyticsService_StartSession_FullMethodName  = "/realtimeanalytics.v1.RealtimeAnalyticsService/StartSession"
 	RealtimeAnalyticsService_StopSession_FullMethodName   = "/realtimeanalytics.v1.RealtimeAnalyticsService/StopSession"
 	RealtimeAnalyticsService_SearchQueries_FullMethodName = "/realtimeanalytics.v1.RealtimeAnalyticsService/SearchQueries"
+	RealtimeAnalyticsService_WatchQueries_FullMethodName  = "/realtimeanalytics.v1.RealtimeAnalyticsService/WatchQueries"
+	RealtimeAnalyticsService_KillQuery_FullMethodName     = "/realtimeanalytics.v1.RealtimeAnalyticsService/KillQuery"
+	RealtimeAnalyticsService_ReplaySession_FullMethodName = "/realtimeanalytics.v1.RealtimeAnalyticsService/ReplaySession"
 )
 
 // RealtimeAnalyticsServiceClient is the client API for RealtimeAnalyticsService service.
@@ -43,6 +46,12 @@ type RealtimeAnalyticsServiceClient interface {
 	StopSession(ctx context.Context, in *StopSessionRequest, opts ...grpc.CallOption) (*StopSessionResponse, error)
 	// SearchQueries returns the list of currently running Database Queries that match criteria(s).
 	SearchQueries(ctx context.Context, in *SearchQueriesRequest, opts ...grpc.CallOption) (*SearchQueriesResponse, error)
+	// WatchQueries streams changes of currently running Database Queries that match criteria(s).
+	WatchQueries(ctx context.Context, in *WatchQueriesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchQueriesResponse], error)
+	// KillQuery terminates a running Database Query observed in Real-Time Analytics session.
+	KillQuery(ctx context.Context, in *KillQueryRequest, opts ...grpc.CallOption) (*KillQueryResponse, error)
+	// ReplaySession returns Database Queries that were running at a point in time, as recorded by Real-Time Analytics.
+	ReplaySession(ctx context.Context, in *ReplaySessionRequest, opts ...grpc.CallOption) (*ReplaySessionResponse, error)
 }
 
 type realtimeAnalyticsServiceClient struct {
@@ -103,6 +112,45 @@ func (c *realtimeAnalyticsServiceClient) SearchQueries(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *realtimeAnalyticsServiceClient) WatchQueries(ctx context.Context, in *WatchQueriesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchQueriesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &RealtimeAnalyticsService_ServiceDesc.Streams[0], RealtimeAnalyticsService_WatchQueries_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchQueriesRequest, WatchQueriesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RealtimeAnalyticsService_WatchQueriesClient = grpc.ServerStreamingClient[WatchQueriesResponse]
+
+func (c *realtimeAnalyticsServiceClient) KillQuery(ctx context.Context, in *KillQueryRequest, opts ...grpc.CallOption) (*KillQueryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(KillQueryResponse)
+	err := c.cc.Invoke(ctx, RealtimeAnalyticsService_KillQuery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *realtimeAnalyticsServiceClient) ReplaySession(ctx context.Context, in *ReplaySessionRequest, opts ...grpc.CallOption) (*ReplaySessionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplaySessionResponse)
+	err := c.cc.Invoke(ctx, RealtimeAnalyticsService_ReplaySession_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RealtimeAnalyticsServiceServer is the server API for RealtimeAnalyticsService service.
 // All implementations must embed UnimplementedRealtimeAnalyticsServiceServer
 // for forward compatibility.
@@ -119,6 +167,12 @@ type RealtimeAnalyticsServiceServer interface {
 	StopSession(context.Context, *StopSessionRequest) (*StopSessionResponse, error)
 	// SearchQueries returns the list of currently running Database Queries that match criteria(s).
 	SearchQueries(context.Context, *SearchQueriesRequest) (*SearchQueriesResponse, error)
+	// WatchQueries streams changes of currently running Database Queries that match criteria(s).
+	WatchQueries(*WatchQueriesRequest, grpc.ServerStreamingServer[WatchQueriesResponse]) error
+	// KillQuery terminates a running Database Query observed in Real-Time Analytics session.
+	KillQuery(context.Context, *KillQueryRequest) (*KillQueryResponse, error)
+	// ReplaySession returns Database Queries that were running at a point in time, as recorded by Real-Time Analytics.
+	ReplaySession(context.Context, *ReplaySessionRequest) (*ReplaySessionResponse, error)
 	mustEmbedUnimplementedRealtimeAnalyticsServiceServer()
 }
 
@@ -149,6 +203,18 @@ func (UnimplementedRealtimeAnalyticsServiceServer) SearchQueries(context.Context
 	return nil, status.Error(codes.Unimplemented, "method SearchQueries not implemented")
 }
 
+func (UnimplementedRealtimeAnalyticsServiceServer) WatchQueries(*WatchQueriesRequest, grpc.ServerStreamingServer[WatchQueriesResponse]) error {
+	return status.Error(codes.Unimplemented, "method WatchQueries not implemented")
+}
+
+func (UnimplementedRealtimeAnalyticsServiceServer) KillQuery(context.Context, *KillQueryRequest) (*KillQueryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method KillQuery not implemented")
+}
+
+func (UnimplementedRealtimeAnalyticsServiceServer) ReplaySession(context.Context, *ReplaySessionRequest) (*ReplaySessionResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReplaySession not implemented")
+}
+
 func (UnimplementedRealtimeAnalyticsServiceServer) mustEmbedUnimplementedRealtimeAnalyticsServiceServer() {
 }
 func (UnimplementedRealtimeAnalyticsServiceServer) testEmbeddedByValue() {}
@@ -261,6 +327,53 @@ func _RealtimeAnalyticsService_SearchQueries_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RealtimeAnalyticsService_WatchQueries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchQueriesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RealtimeAnalyticsServiceServer).WatchQueries(m, &grpc.GenericServerStream[WatchQueriesRequest, WatchQueriesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type RealtimeAnalyticsService_WatchQueriesServer = grpc.ServerStreamingServer[WatchQueriesResponse]
+
+func _RealtimeAnalyticsService_KillQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(KillQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealtimeAnalyticsServiceServer).KillQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealtimeAnalyticsService_KillQuery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealtimeAnalyticsServiceServer).KillQuery(ctx, req.(*KillQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RealtimeAnalyticsService_ReplaySession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplaySessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RealtimeAnalyticsServiceServer).ReplaySession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RealtimeAnalyticsService_ReplaySession_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RealtimeAnalyticsServiceServer).ReplaySession(ctx, req.(*ReplaySessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // RealtimeAnalyticsService_ServiceDesc is the grpc.ServiceDesc for RealtimeAnalyticsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -288,7 +401,21 @@ var RealtimeAnalyticsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchQueries",
 			Handler:    _RealtimeAnalyticsService_SearchQueries_Handler,
 		},
+		{
+			MethodName: "KillQuery",
+			Handler:    _RealtimeAnalyticsService_KillQuery_Handler,
+		},
+		{
+			MethodName: "ReplaySession",
+			Handler:    _RealtimeAnalyticsService_ReplaySession_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchQueries",
+			Handler:       _RealtimeAnalyticsService_WatchQueries_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "realtimeanalytics/v1/realtimeanalytics.proto",
 }
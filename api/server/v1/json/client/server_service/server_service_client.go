@@ -81,12 +81,16 @@ type ClientService interface {
 
 	CheckUpdates(params *CheckUpdatesParams, opts ...ClientOption) (*CheckUpdatesOK, error)
 
+	ExportAuditEvents(params *ExportAuditEventsParams, writer io.Writer, opts ...ClientOption) (*ExportAuditEventsOK, error)
+
 	GetReadOnlySettings(params *GetReadOnlySettingsParams, opts ...ClientOption) (*GetReadOnlySettingsOK, error)
 
 	GetSettings(params *GetSettingsParams, opts ...ClientOption) (*GetSettingsOK, error)
 
 	LeaderHealthCheck(params *LeaderHealthCheckParams, opts ...ClientOption) (*LeaderHealthCheckOK, error)
 
+	ListAuditEvents(params *ListAuditEventsParams, opts ...ClientOption) (*ListAuditEventsOK, error)
+
 	ListChangeLogs(params *ListChangeLogsParams, opts ...ClientOption) (*ListChangeLogsOK, error)
 
 	Logs(params *LogsParams, writer io.Writer, opts ...ClientOption) (*LogsOK, error)
@@ -186,6 +190,50 @@ func (a *Client) CheckUpdates(params *CheckUpdatesParams, opts ...ClientOption)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+ExportAuditEvents exports audit events
+
+Streams audit log events in CSV or JSON Lines format, without pagination.
+*/
+func (a *Client) ExportAuditEvents(params *ExportAuditEventsParams, writer io.Writer, opts ...ClientOption) (*ExportAuditEventsOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewExportAuditEventsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ExportAuditEvents",
+		Method:             "GET",
+		PathPattern:        "/v1/server/audit/events:export",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ExportAuditEventsReader{formats: a.formats, writer: writer},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ExportAuditEventsOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*ExportAuditEventsDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 GetReadOnlySettings gets read only settings
 
@@ -318,6 +366,50 @@ func (a *Client) LeaderHealthCheck(params *LeaderHealthCheckParams, opts ...Clie
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+ListAuditEvents lists audit events
+
+Returns audit log events of mutating API calls with the caller, the targets and the outcome, newest first.
+*/
+func (a *Client) ListAuditEvents(params *ListAuditEventsParams, opts ...ClientOption) (*ListAuditEventsOK, error) {
+	// NOTE: parameters are not validated before sending
+	if params == nil {
+		params = NewListAuditEventsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "ListAuditEvents",
+		Method:             "GET",
+		PathPattern:        "/v1/server/audit/events",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http", "https"},
+		Params:             params,
+		Reader:             &ListAuditEventsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+
+	// only one success response has to be checked
+	success, ok := result.(*ListAuditEventsOK)
+	if ok {
+		return success, nil
+	}
+
+	// unexpected success response.
+	//
+	// a default response is provided: fill this and return an error
+	unexpectedSuccess := result.(*ListAuditEventsDefault)
+
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
 ListChangeLogs gets the changelog
 
@@ -13,6 +13,7 @@ import (
 
 	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	durationpb "google.golang.org/protobuf/types/known/durationpb"
@@ -883,8 +884,10 @@ type Settings struct {
 	DefaultRoleId uint32 `protobuf:"varint,18,opt,name=default_role_id,json=defaultRoleId,proto3" json:"default_role_id,omitempty"`
 	// True if Query Analytics for PMM's internal PG database is enabled.
 	EnableInternalPgQan bool `protobuf:"varint,19,opt,name=enable_internal_pg_qan,json=enableInternalPgQan,proto3" json:"enable_internal_pg_qan,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// Audit log retention period.
+	AuditLogRetention *durationpb.Duration `protobuf:"bytes,21,opt,name=audit_log_retention,json=auditLogRetention,proto3" json:"audit_log_retention,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *Settings) Reset() {
@@ -1045,6 +1048,13 @@ func (x *Settings) GetEnableInternalPgQan() bool {
 	return false
 }
 
+func (x *Settings) GetAuditLogRetention() *durationpb.Duration {
+	if x != nil {
+		return x.AuditLogRetention
+	}
+	return nil
+}
+
 // ReadOnlySettings represents a stripped-down version of PMM Server settings that can be accessed by users of all roles.
 type ReadOnlySettings struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1339,8 +1349,10 @@ type ChangeSettingsRequest struct {
 	EnableAccessControl *bool `protobuf:"varint,13,opt,name=enable_access_control,json=enableAccessControl,proto3,oneof" json:"enable_access_control,omitempty"`
 	// Enable Query Analytics for PMM's internal PG database.
 	EnableInternalPgQan *bool `protobuf:"varint,14,opt,name=enable_internal_pg_qan,json=enableInternalPgQan,proto3,oneof" json:"enable_internal_pg_qan,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// A number of full days for audit log retention. Must be specified in seconds in JSON, for example: 7776000s.
+	AuditLogRetention *durationpb.Duration `protobuf:"bytes,16,opt,name=audit_log_retention,json=auditLogRetention,proto3" json:"audit_log_retention,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *ChangeSettingsRequest) Reset() {
@@ -1471,6 +1483,13 @@ func (x *ChangeSettingsRequest) GetEnableInternalPgQan() bool {
 	return false
 }
 
+func (x *ChangeSettingsRequest) GetAuditLogRetention() *durationpb.Duration {
+	if x != nil {
+		return x.AuditLogRetention
+	}
+	return nil
+}
+
 type ChangeSettingsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Settings      *Settings              `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
@@ -1519,7 +1538,7 @@ var File_server_v1_server_proto protoreflect.FileDescriptor
 
 const file_server_v1_server_proto_rawDesc = "" +
 	"\n" +
-	"\x16server/v1/server.proto\x12\tserver.v1\x1a\x13common/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x84\x01\n" +
+	"\x16server/v1/server.proto\x12\tserver.v1\x1a\x13common/common.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x19google/api/httpbody.proto\x1a\x1egoogle/protobuf/duration.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\x1a\x15server/v1/audit.proto\"\x84\x01\n" +
 	"\vVersionInfo\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\tR\aversion\x12!\n" +
 	"\ffull_version\x18\x02 \x01(\tR\vfullVersion\x128\n" +
@@ -1563,7 +1582,7 @@ const file_server_v1_server_proto_rawDesc = "" +
 	"\x13AdvisorRunIntervals\x12F\n" +
 	"\x11standard_interval\x18\x01 \x01(\v2\x19.google.protobuf.DurationR\x10standardInterval\x12>\n" +
 	"\rrare_interval\x18\x02 \x01(\v2\x19.google.protobuf.DurationR\frareInterval\x12F\n" +
-	"\x11frequent_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x10frequentInterval\"\xbc\a\n" +
+	"\x11frequent_interval\x18\x03 \x01(\v2\x19.google.protobuf.DurationR\x10frequentInterval\"\x87\b\n" +
 	"\bSettings\x12'\n" +
 	"\x0fupdates_enabled\x18\x01 \x01(\bR\x0eupdatesEnabled\x12+\n" +
 	"\x11telemetry_enabled\x18\x02 \x01(\bR\x10telemetryEnabled\x12N\n" +
@@ -1583,7 +1602,8 @@ const file_server_v1_server_proto_rawDesc = "" +
 	"\x13telemetry_summaries\x18\x10 \x03(\tR\x12telemetrySummaries\x122\n" +
 	"\x15enable_access_control\x18\x11 \x01(\bR\x13enableAccessControl\x12&\n" +
 	"\x0fdefault_role_id\x18\x12 \x01(\rR\rdefaultRoleId\x123\n" +
-	"\x16enable_internal_pg_qan\x18\x13 \x01(\bR\x13enableInternalPgQanJ\x04\b\x14\x10\x15R\x16update_snooze_duration\"\x8f\x03\n" +
+	"\x16enable_internal_pg_qan\x18\x13 \x01(\bR\x13enableInternalPgQan\x12I\n" +
+	"\x13audit_log_retention\x18\x15 \x01(\v2\x19.google.protobuf.DurationR\x11auditLogRetentionJ\x04\b\x14\x10\x15R\x16update_snooze_duration\"\x8f\x03\n" +
 	"\x10ReadOnlySettings\x12'\n" +
 	"\x0fupdates_enabled\x18\x01 \x01(\bR\x0eupdatesEnabled\x12+\n" +
 	"\x11telemetry_enabled\x18\x02 \x01(\bR\x10telemetryEnabled\x12'\n" +
@@ -1598,7 +1618,7 @@ const file_server_v1_server_proto_rawDesc = "" +
 	"\x13GetSettingsResponse\x12/\n" +
 	"\bsettings\x18\x01 \x01(\v2\x13.server.v1.SettingsR\bsettings\"V\n" +
 	"\x1bGetReadOnlySettingsResponse\x127\n" +
-	"\bsettings\x18\x01 \x01(\v2\x1b.server.v1.ReadOnlySettingsR\bsettings\"\xbd\b\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1b.server.v1.ReadOnlySettingsR\bsettings\"\x88\t\n" +
 	"\x15ChangeSettingsRequest\x12*\n" +
 	"\x0eenable_updates\x18\x01 \x01(\bH\x00R\renableUpdates\x88\x01\x01\x12.\n" +
 	"\x10enable_telemetry\x18\x02 \x01(\bH\x01R\x0fenableTelemetry\x88\x01\x01\x12N\n" +
@@ -1615,7 +1635,8 @@ const file_server_v1_server_proto_rawDesc = "" +
 	"\x18enable_backup_management\x18\f \x01(\bH\bR\x16enableBackupManagement\x88\x01\x01\x127\n" +
 	"\x15enable_access_control\x18\r \x01(\bH\tR\x13enableAccessControl\x88\x01\x01\x128\n" +
 	"\x16enable_internal_pg_qan\x18\x0e \x01(\bH\n" +
-	"R\x13enableInternalPgQan\x88\x01\x01B\x11\n" +
+	"R\x13enableInternalPgQan\x88\x01\x01\x12I\n" +
+	"\x13audit_log_retention\x18\x10 \x01(\v2\x19.google.protobuf.DurationR\x11auditLogRetentionB\x11\n" +
 	"\x0f_enable_updatesB\x13\n" +
 	"\x11_enable_telemetryB\n" +
 	"\n" +
@@ -1636,7 +1657,7 @@ const file_server_v1_server_proto_rawDesc = "" +
 	"\x17DISTRIBUTION_METHOD_OVF\x10\x02\x12\x1b\n" +
 	"\x17DISTRIBUTION_METHOD_AMI\x10\x03\x12\x1d\n" +
 	"\x19DISTRIBUTION_METHOD_AZURE\x10\x04\x12\x1a\n" +
-	"\x16DISTRIBUTION_METHOD_DO\x10\x052\x89\r\n" +
+	"\x16DISTRIBUTION_METHOD_DO\x10\x052\xe7\x10\n" +
 	"\rServerService\x12\x86\x01\n" +
 	"\aVersion\x12\x19.server.v1.VersionRequest\x1a\x1a.server.v1.VersionResponse\"D\x92A'\x12\aVersion\x1a\x1cReturns PMM Server versions.\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/server/version\x12\xab\x02\n" +
 	"\tReadiness\x12\x1b.server.v1.ReadinessRequest\x1a\x1c.server.v1.ReadinessResponse\"\xe2\x01\x92A\xc5\x01\x12\x16Check server readiness\x1a\xaa\x01Returns an error when Server components being restarted are not ready yet. Use this API for checking the health of Docker containers and for probing Kubernetes readiness.\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/server/readyz\x12\x81\x02\n" +
@@ -1645,7 +1666,9 @@ const file_server_v1_server_proto_rawDesc = "" +
 	"\x0eListChangeLogs\x12 .server.v1.ListChangeLogsRequest\x1a!.server.v1.ListChangeLogsResponse\"\x91\x01\x92Ai\x12\x11Get the changelog\x1aTDisplay a changelog comparing the installed version to the latest available version.\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/server/updates/changelogs\x12\xa0\x01\n" +
 	"\vGetSettings\x12\x1d.server.v1.GetSettingsRequest\x1a\x1e.server.v1.GetSettingsResponse\"R\x92A4\x12\fGet settings\x1a$Returns current PMM Server settings.\x82\xd3\xe4\x93\x02\x15\x12\x13/v1/server/settings\x12\xd9\x01\n" +
 	"\x13GetReadOnlySettings\x12%.server.v1.GetReadOnlySettingsRequest\x1a&.server.v1.GetReadOnlySettingsResponse\"s\x92AL\x12\x16Get read-only settings\x1a2Returns a stripped version of PMM Server settings.\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/server/settings/readonly\x12\xa7\x01\n" +
-	"\x0eChangeSettings\x12 .server.v1.ChangeSettingsRequest\x1a!.server.v1.ChangeSettingsResponse\"P\x92A/\x12\x0fChange settings\x1a\x1cChanges PMM Server settings.\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/server/settingsB\x90\x01\n" +
+	"\x0eChangeSettings\x12 .server.v1.ChangeSettingsRequest\x1a!.server.v1.ChangeSettingsResponse\"P\x92A/\x12\x0fChange settings\x1a\x1cChanges PMM Server settings.\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/v1/server/settings\x12\xfc\x01\n" +
+	"\x0fListAuditEvents\x12!.server.v1.ListAuditEventsRequest\x1a\".server.v1.ListAuditEventsResponse\"\xa1\x01\x92A\x7f\x12\x11List audit events\x1ajReturns audit log events of mutating API calls with the caller, the targets and the outcome, newest first.\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/server/audit/events\x12\xdc\x01\n" +
+	"\x11ExportAuditEvents\x12#.server.v1.ExportAuditEventsRequest\x1a\x14.google.api.HttpBody\"\x89\x01\x92A`\x12\x13Export audit events\x1aIStreams audit log events in CSV or JSON Lines format, without pagination.\x82\xd3\xe4\x93\x02 \x12\x1e/v1/server/audit/events:export0\x01B\x90\x01\n" +
 	"\rcom.server.v1B\vServerProtoP\x01Z-github.com/percona/pmm/api/server/v1;serverv1\xa2\x02\x03SXX\xaa\x02\tServer.V1\xca\x02\tServer\\V1\xe2\x02\x15Server\\V1\\GPBMetadata\xea\x02\n" +
 	"Server::V1b\x06proto3"
 
@@ -1691,6 +1714,10 @@ var (
 		(*timestamppb.Timestamp)(nil),       // 23: google.protobuf.Timestamp
 		(*durationpb.Duration)(nil),         // 24: google.protobuf.Duration
 		(*common.StringArray)(nil),          // 25: common.StringArray
+		(*ListAuditEventsRequest)(nil),      // 26: server.v1.ListAuditEventsRequest
+		(*ExportAuditEventsRequest)(nil),    // 27: server.v1.ExportAuditEventsRequest
+		(*ListAuditEventsResponse)(nil),     // 28: server.v1.ListAuditEventsResponse
+		(*httpbody.HttpBody)(nil),           // 29: google.api.HttpBody
 	}
 )
 
@@ -1714,34 +1741,40 @@ var file_server_v1_server_proto_depIdxs = []int32{
 	13, // 16: server.v1.Settings.metrics_resolutions:type_name -> server.v1.MetricsResolutions
 	24, // 17: server.v1.Settings.data_retention:type_name -> google.protobuf.Duration
 	14, // 18: server.v1.Settings.advisor_run_intervals:type_name -> server.v1.AdvisorRunIntervals
-	15, // 19: server.v1.GetSettingsResponse.settings:type_name -> server.v1.Settings
-	16, // 20: server.v1.GetReadOnlySettingsResponse.settings:type_name -> server.v1.ReadOnlySettings
-	13, // 21: server.v1.ChangeSettingsRequest.metrics_resolutions:type_name -> server.v1.MetricsResolutions
-	24, // 22: server.v1.ChangeSettingsRequest.data_retention:type_name -> google.protobuf.Duration
-	25, // 23: server.v1.ChangeSettingsRequest.aws_partitions:type_name -> common.StringArray
-	14, // 24: server.v1.ChangeSettingsRequest.advisor_run_intervals:type_name -> server.v1.AdvisorRunIntervals
-	15, // 25: server.v1.ChangeSettingsResponse.settings:type_name -> server.v1.Settings
-	2,  // 26: server.v1.ServerService.Version:input_type -> server.v1.VersionRequest
-	4,  // 27: server.v1.ServerService.Readiness:input_type -> server.v1.ReadinessRequest
-	6,  // 28: server.v1.ServerService.LeaderHealthCheck:input_type -> server.v1.LeaderHealthCheckRequest
-	8,  // 29: server.v1.ServerService.CheckUpdates:input_type -> server.v1.CheckUpdatesRequest
-	11, // 30: server.v1.ServerService.ListChangeLogs:input_type -> server.v1.ListChangeLogsRequest
-	17, // 31: server.v1.ServerService.GetSettings:input_type -> server.v1.GetSettingsRequest
-	18, // 32: server.v1.ServerService.GetReadOnlySettings:input_type -> server.v1.GetReadOnlySettingsRequest
-	21, // 33: server.v1.ServerService.ChangeSettings:input_type -> server.v1.ChangeSettingsRequest
-	3,  // 34: server.v1.ServerService.Version:output_type -> server.v1.VersionResponse
-	5,  // 35: server.v1.ServerService.Readiness:output_type -> server.v1.ReadinessResponse
-	7,  // 36: server.v1.ServerService.LeaderHealthCheck:output_type -> server.v1.LeaderHealthCheckResponse
-	10, // 37: server.v1.ServerService.CheckUpdates:output_type -> server.v1.CheckUpdatesResponse
-	12, // 38: server.v1.ServerService.ListChangeLogs:output_type -> server.v1.ListChangeLogsResponse
-	19, // 39: server.v1.ServerService.GetSettings:output_type -> server.v1.GetSettingsResponse
-	20, // 40: server.v1.ServerService.GetReadOnlySettings:output_type -> server.v1.GetReadOnlySettingsResponse
-	22, // 41: server.v1.ServerService.ChangeSettings:output_type -> server.v1.ChangeSettingsResponse
-	34, // [34:42] is the sub-list for method output_type
-	26, // [26:34] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	24, // 19: server.v1.Settings.audit_log_retention:type_name -> google.protobuf.Duration
+	15, // 20: server.v1.GetSettingsResponse.settings:type_name -> server.v1.Settings
+	16, // 21: server.v1.GetReadOnlySettingsResponse.settings:type_name -> server.v1.ReadOnlySettings
+	13, // 22: server.v1.ChangeSettingsRequest.metrics_resolutions:type_name -> server.v1.MetricsResolutions
+	24, // 23: server.v1.ChangeSettingsRequest.data_retention:type_name -> google.protobuf.Duration
+	25, // 24: server.v1.ChangeSettingsRequest.aws_partitions:type_name -> common.StringArray
+	14, // 25: server.v1.ChangeSettingsRequest.advisor_run_intervals:type_name -> server.v1.AdvisorRunIntervals
+	24, // 26: server.v1.ChangeSettingsRequest.audit_log_retention:type_name -> google.protobuf.Duration
+	15, // 27: server.v1.ChangeSettingsResponse.settings:type_name -> server.v1.Settings
+	2,  // 28: server.v1.ServerService.Version:input_type -> server.v1.VersionRequest
+	4,  // 29: server.v1.ServerService.Readiness:input_type -> server.v1.ReadinessRequest
+	6,  // 30: server.v1.ServerService.LeaderHealthCheck:input_type -> server.v1.LeaderHealthCheckRequest
+	8,  // 31: server.v1.ServerService.CheckUpdates:input_type -> server.v1.CheckUpdatesRequest
+	11, // 32: server.v1.ServerService.ListChangeLogs:input_type -> server.v1.ListChangeLogsRequest
+	17, // 33: server.v1.ServerService.GetSettings:input_type -> server.v1.GetSettingsRequest
+	18, // 34: server.v1.ServerService.GetReadOnlySettings:input_type -> server.v1.GetReadOnlySettingsRequest
+	21, // 35: server.v1.ServerService.ChangeSettings:input_type -> server.v1.ChangeSettingsRequest
+	26, // 36: server.v1.ServerService.ListAuditEvents:input_type -> server.v1.ListAuditEventsRequest
+	27, // 37: server.v1.ServerService.ExportAuditEvents:input_type -> server.v1.ExportAuditEventsRequest
+	3,  // 38: server.v1.ServerService.Version:output_type -> server.v1.VersionResponse
+	5,  // 39: server.v1.ServerService.Readiness:output_type -> server.v1.ReadinessResponse
+	7,  // 40: server.v1.ServerService.LeaderHealthCheck:output_type -> server.v1.LeaderHealthCheckResponse
+	10, // 41: server.v1.ServerService.CheckUpdates:output_type -> server.v1.CheckUpdatesResponse
+	12, // 42: server.v1.ServerService.ListChangeLogs:output_type -> server.v1.ListChangeLogsResponse
+	19, // 43: server.v1.ServerService.GetSettings:output_type -> server.v1.GetSettingsResponse
+	20, // 44: server.v1.ServerService.GetReadOnlySettings:output_type -> server.v1.GetReadOnlySettingsResponse
+	22, // 45: server.v1.ServerService.ChangeSettings:output_type -> server.v1.ChangeSettingsResponse
+	28, // 46: server.v1.ServerService.ListAuditEvents:output_type -> server.v1.ListAuditEventsResponse
+	29, // 47: server.v1.ServerService.ExportAuditEvents:output_type -> google.api.HttpBody
+	38, // [38:48] is the sub-list for method output_type
+	28, // [28:38] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_server_v1_server_proto_init() }
@@ -1749,6 +1782,7 @@ func file_server_v1_server_proto_init() {
 	if File_server_v1_server_proto != nil {
 		return
 	}
+	file_server_v1_audit_proto_init()
 	file_server_v1_server_proto_msgTypes[20].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -16,6 +16,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"os/signal"
@@ -68,6 +69,15 @@ func main() {
 		os.Exit(0)
 	}
 
+	if opts.RotateKEK {
+		if err := encryption.RotateKEK(context.Background()); err != nil {
+			logrus.Errorf("Failed to rotate key-encryption key: %v", err)
+			os.Exit(1)
+		}
+		logrus.Infoln("Key-encryption key is rotated, encryption key is wrapped with the new version")
+		os.Exit(0)
+	}
+
 	sqlDB, err := models.OpenDB(setupParams(opts))
 	if err != nil {
 		logrus.Error(err)
@@ -93,6 +103,7 @@ type flags struct {
 	SSLKeyPath  string `name:"postgres-ssl-key-path" help:"PostgreSQL SSL key path" type:"path"`
 	SSLCertPath string `name:"postgres-ssl-cert-path" help:"PostgreSQL SSL certificate path" type:"path"`
 	GenerateKey bool   `name:"generate-key" help:"Only generate a new encryption key and print to stdout"`
+	RotateKEK   bool   `name:"rotate-kek" help:"Only rotate the key-encryption key in KMS and wrap the encryption key with it, without stopping PMM Server"`
 }
 
 func setupParams(opts flags) models.SetupDBParams {
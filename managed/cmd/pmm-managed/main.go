@@ -19,6 +19,7 @@ import (
 	"bytes"
 	"context"
 	"database/sql"
+	"encoding/base64"
 	"errors"
 	_ "expvar" // register /debug/vars
 	"fmt"
@@ -80,12 +81,15 @@ import (
 	"github.com/percona/pmm/managed/services/agents"
 	agentgrpc "github.com/percona/pmm/managed/services/agents/grpc"
 	"github.com/percona/pmm/managed/services/alerting"
+	"github.com/percona/pmm/managed/services/audit"
 	"github.com/percona/pmm/managed/services/backup"
+	"github.com/percona/pmm/managed/services/ca"
 	"github.com/percona/pmm/managed/services/checks"
 	"github.com/percona/pmm/managed/services/config" //nolint:staticcheck
 	"github.com/percona/pmm/managed/services/dump"
 	"github.com/percona/pmm/managed/services/grafana"
 	"github.com/percona/pmm/managed/services/ha"
+	"github.com/percona/pmm/managed/services/indexadvisor"
 	"github.com/percona/pmm/managed/services/inventory"
 	inventorygrpc "github.com/percona/pmm/managed/services/inventory/grpc"
 	"github.com/percona/pmm/managed/services/management"
@@ -95,6 +99,8 @@ import (
 	managementgrpc "github.com/percona/pmm/managed/services/management/grpc"
 	"github.com/percona/pmm/managed/services/minio"
 	"github.com/percona/pmm/managed/services/nomad"
+	"github.com/percona/pmm/managed/services/permissions"
+	"github.com/percona/pmm/managed/services/planhistory"
 	"github.com/percona/pmm/managed/services/qan"
 	"github.com/percona/pmm/managed/services/realtimeanalytics"
 	"github.com/percona/pmm/managed/services/scheduler"
@@ -110,6 +116,7 @@ import (
 	"github.com/percona/pmm/managed/utils/envvars"
 	"github.com/percona/pmm/managed/utils/interceptors"
 	platformClient "github.com/percona/pmm/managed/utils/platform"
+	"github.com/percona/pmm/managed/utils/sse"
 	pmmerrors "github.com/percona/pmm/utils/errors"
 	"github.com/percona/pmm/utils/logger"
 	"github.com/percona/pmm/utils/sqlmetrics"
@@ -224,6 +231,7 @@ type gRPCServerDeps struct {
 	compatibilityService      *backup.CompatibilityService
 	backupRemovalService      *backup.RemovalService
 	pbmPITRService            *backup.PBMPITRService
+	mysqlPITRService          *backup.MySQLPITRService
 	vmClient                  *metrics.Client
 	minioClient               *minio.Client
 	settings                  *models.Settings
@@ -234,6 +242,10 @@ type gRPCServerDeps struct {
 	versionCache              *versioncache.Service
 	vmdb                      *victoriametrics.Service
 	vmalert                   *vmalert.Service
+	rtaRecorder               *realtimeanalytics.Recorder
+	auditService              *audit.Service
+	permissionsService        *permissions.Service
+	agentCA                   *ca.CA
 }
 
 // runGRPCServer runs gRPC server until context is canceled, then gracefully stops it.
@@ -256,6 +268,8 @@ func runGRPCServer(ctx context.Context, deps *gRPCServerDeps) {
 
 		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			interceptors.UnaryAdd(grpcMetrics.UnaryServerInterceptor()),
+			deps.auditService.UnaryInterceptor(),
+			deps.permissionsService.UnaryInterceptor(),
 			interceptors.UnaryServiceEnabledInterceptor(),
 			grpc_validator.UnaryServerInterceptor(),
 		)),
@@ -288,6 +302,7 @@ func runGRPCServer(ctx context.Context, deps *gRPCServerDeps) {
 		deps.db, deps.backupService,
 		deps.compatibilityService, deps.schedulerService,
 		deps.backupRemovalService, deps.pbmPITRService,
+		deps.mysqlPITRService,
 	)
 	mgmtRestoreService := managementbackup.NewRestoreService(deps.db, deps.backupService, deps.schedulerService)
 	mgmtServices := common.NewMgmtServices(mgmtBackupService, mgmtRestoreService)
@@ -304,6 +319,7 @@ func runGRPCServer(ctx context.Context, deps *gRPCServerDeps) {
 		deps.db, deps.agentsRegistry, deps.agentsStateUpdater,
 		deps.connectionCheck, deps.serviceInfoBroker, deps.vmdb,
 		deps.versionCache, deps.grafanaClient, v1.NewAPI(*deps.vmClient),
+		deps.agentCA,
 	)
 
 	managementv1.RegisterManagementServiceServer(gRPCServer, managementSvc)
@@ -326,7 +342,7 @@ func runGRPCServer(ctx context.Context, deps *gRPCServerDeps) {
 
 	// Register RTA service with in-memory store
 	rtaStore := realtimeanalytics.NewStore()
-	rtaSvc := realtimeanalytics.NewService(deps.db, deps.agentsRegistry, deps.agentsStateUpdater, rtaStore)
+	rtaSvc := realtimeanalytics.NewService(deps.db, deps.agentsRegistry, deps.agentsStateUpdater, deps.actions, managementSvc, rtaStore, deps.rtaRecorder)
 	rtav1.RegisterRealtimeAnalyticsServiceServer(gRPCServer, rtaSvc)
 	rtav1.RegisterCollectorServiceServer(gRPCServer, rtaSvc)
 
@@ -367,6 +383,16 @@ type http1ServerDeps struct {
 	currentUserHandler http.Handler
 }
 
+// customMatcher allows to pass custom headers to the backend gRPC server.
+func customMatcher(key string) (string, bool) {
+	switch key {
+	case audit.UserHeaderName:
+		return key, true
+	default:
+		return grpc_gateway.DefaultHeaderMatcher(key)
+	}
+}
+
 // runHTTP1Server runs grpc-gateway and other HTTP 1.1 APIs (like auth_request and logs.zip)
 // until context is canceled, then gracefully stops it.
 func runHTTP1Server(ctx context.Context, deps *http1ServerDeps) {
@@ -386,7 +412,9 @@ func runHTTP1Server(ctx context.Context, deps *http1ServerDeps) {
 	}
 
 	proxyMux := grpc_gateway.NewServeMux(
-		grpc_gateway.WithMarshalerOption(grpc_gateway.MIMEWildcard, marshaller),
+		grpc_gateway.WithIncomingHeaderMatcher(customMatcher),
+		grpc_gateway.WithMarshalerOption(grpc_gateway.MIMEWildcard, &grpc_gateway.HTTPBodyMarshaler{Marshaler: marshaller}),
+		grpc_gateway.WithMarshalerOption(sse.MIMEType, &sse.Marshaler{Marshaler: marshaller}),
 		grpc_gateway.WithErrorHandler(pmmerrors.PMMHTTPErrorHandler),
 		grpc_gateway.WithRoutingErrorHandler(pmmerrors.PMMRoutingErrorHandler),
 	)
@@ -742,6 +770,12 @@ func main() { //nolint:gocognit,maintidx,cyclop
 		Envar("PMM_HA_GRAFANA_GOSSIP_PORT").
 		Default("9762").
 		Int()
+	haReplicateState := kingpin.Flag("ha-replicate-state", "Replicate control-plane state between HA nodes through Raft").
+		Envar("PMM_HA_REPLICATE_STATE").
+		Bool()
+	haGossipSecretKey := kingpin.Flag("ha-gossip-secret-key", "Base64-encoded 16, 24 or 32 bytes key for HA gossip encryption").
+		Envar("PMM_HA_GOSSIP_SECRET_KEY").
+		String()
 
 	supervisordConfigDirF := kingpin.Flag("supervisord-config-dir", "Supervisord configuration directory").Required().String()
 
@@ -754,6 +788,28 @@ func main() { //nolint:gocognit,maintidx,cyclop
 	clickhouseUsernameF := kingpin.Flag("clickhouse-username", "Clickhouse database user").Default("default").Envar("PMM_CLICKHOUSE_USER").String()
 	clickhousePasswordF := kingpin.Flag("clickhouse-password", "Clickhouse database user password").Default("clickhouse").Envar("PMM_CLICKHOUSE_PASSWORD").String()
 
+	rtaRecorderF := kingpin.Flag("enable-rta-recorder", "Record Real-Time Analytics queries into Clickhouse for replay").Envar("PMM_ENABLE_RTA_RECORDER").Bool()
+
+	planHistoryF := kingpin.Flag("enable-plan-history", "Collect plans of top MySQL and MongoDB queries into Clickhouse").Envar("PMM_ENABLE_PLAN_HISTORY").Bool()
+	planHistoryIntervalF := kingpin.Flag("plan-history-interval", "Interval of query plans collection").
+		Default("1h").
+		Envar("PMM_PLAN_HISTORY_INTERVAL").
+		Duration()
+	planHistoryTopQueriesF := kingpin.Flag("plan-history-top-queries", "Number of the heaviest queries of each service to collect plans for").
+		Default("10").
+		Envar("PMM_PLAN_HISTORY_TOP_QUERIES").
+		Int()
+
+	indexAdvisorF := kingpin.Flag("enable-index-advisor", "Recommend indexes for top MySQL, PostgreSQL and MongoDB queries").Envar("PMM_ENABLE_INDEX_ADVISOR").Bool()
+	indexAdvisorIntervalF := kingpin.Flag("index-advisor-interval", "Interval of index recommendations").
+		Default("6h").
+		Envar("PMM_INDEX_ADVISOR_INTERVAL").
+		Duration()
+	indexAdvisorTopQueriesF := kingpin.Flag("index-advisor-top-queries", "Number of the heaviest queries of each service to recommend indexes for").
+		Default("20").
+		Envar("PMM_INDEX_ADVISOR_TOP_QUERIES").
+		Int()
+
 	// Nomad garbage collection flags
 	nomadGCIntervalF := kingpin.Flag("nomad-gc-interval", "Interval at which Nomad attempts to garbage collect terminal allocation directories.").
 		Default("1m").
@@ -804,6 +860,10 @@ func main() { //nolint:gocognit,maintidx,cyclop
 	if *haPeers != "" {
 		nodes = strings.Split(*haPeers, ",")
 	}
+	gossipSecretKey, err := base64.StdEncoding.DecodeString(*haGossipSecretKey)
+	if err != nil {
+		l.Fatalf("Failed to decode HA gossip secret key: %s", err)
+	}
 	haParams := &models.HAParams{
 		Enabled:           *haEnabled,
 		NodeID:            *haNodeID,
@@ -812,11 +872,13 @@ func main() { //nolint:gocognit,maintidx,cyclop
 		RaftPort:          *haRaftPort,
 		GossipPort:        *haGossipPort,
 		GrafanaGossipPort: *haGrafanaGossipPort,
+		ReplicateState:    *haReplicateState,
+		GossipSecretKey:   gossipSecretKey,
 	}
 	haService := ha.New(haParams)
 
 	cfg := config.NewService()
-	err := cfg.Load()
+	err = cfg.Load()
 	if err != nil {
 		l.Panicf("Failed to load config: %+v", err)
 	}
@@ -923,7 +985,12 @@ func main() { //nolint:gocognit,maintidx,cyclop
 
 	qanClient := getQANClient(sqlDB, *postgresDBNameF, *qanAPIAddrF)
 
-	agentsRegistry := agents.NewRegistry(db, vmParams, haService)
+	agentCA, err := ca.New(ca.DefaultDir)
+	if err != nil {
+		l.Fatalf("Could not create pmm-agent certificate authority: %s", err)
+	}
+
+	agentsRegistry := agents.NewRegistry(db, vmParams, haService, agentCA)
 
 	// TODO remove once PMM cluster is Active-Active
 	// TODO kick non-pmm-server agents only
@@ -933,7 +1000,8 @@ func main() { //nolint:gocognit,maintidx,cyclop
 	// 	func() { agentsRegistry.KickAll(ctx) }))
 
 	pbmPITRService := backup.NewPBMPITRService()
-	backupRemovalService := backup.NewRemovalService(db, pbmPITRService)
+	mysqlPITRService := backup.NewMySQLPITRService()
+	backupRemovalService := backup.NewRemovalService(db, pbmPITRService, mysqlPITRService)
 	backupRetentionService := backup.NewRetentionService(db, backupRemovalService)
 	prom.MustRegister(agentsRegistry)
 
@@ -949,6 +1017,8 @@ func main() { //nolint:gocognit,maintidx,cyclop
 
 	updater := server.NewUpdater(db)
 
+	auditService := audit.New(db)
+
 	logs := server.NewLogs(version.FullInfo(), updater, vmParams)
 
 	supervisord := supervisord.New(
@@ -983,7 +1053,7 @@ func main() { //nolint:gocognit,maintidx,cyclop
 	platformClient := platformClient.NewClient(platformAddress)
 
 	dus := distribution.NewService(distributionInfoFilePath, osInfoFilePath, l)
-	telemetry, err := telemetry.NewService(db, platformClient, version.Version, dus, cfg.Config.Services.Telemetry)
+	telemetry, err := telemetry.NewService(db, platformClient, version.Version, dus, haService, cfg.Config.Services.Telemetry)
 	if err != nil {
 		l.Fatalf("Could not create telemetry service: %s", err)
 	}
@@ -1005,7 +1075,7 @@ func main() { //nolint:gocognit,maintidx,cyclop
 
 	jobsService := agents.NewJobsService(db, agentsRegistry, backupRetentionService)
 	agentsStateUpdater := agents.NewStateUpdater(db, agentsRegistry, vmdb, vmParams, nomad)
-	agentsHandler := agents.NewHandler(db, qanClient, vmdb, agentsRegistry, agentsStateUpdater, jobsService)
+	agentsHandler := agents.NewHandler(db, qanClient, vmdb, agentsRegistry, agentsStateUpdater, jobsService, agentCA)
 
 	actionsService := agents.NewActionsService(qanClient, agentsRegistry)
 
@@ -1020,7 +1090,28 @@ func main() { //nolint:gocognit,maintidx,cyclop
 	}
 	externalExporterStatusSvc := agents.NewExternalExporterStatusService(db, v1.NewAPI(vmClient))
 
-	checksService := checks.New(db, actionsService, v1.NewAPI(vmClient), clickhouseClient)
+	var rtaRecorder *realtimeanalytics.Recorder
+	if *rtaRecorderF {
+		rtaRecorder = realtimeanalytics.NewRecorder(clickhouseClient)
+	}
+
+	var planHistory *planhistory.Collector
+	if *planHistoryF {
+		if *planHistoryIntervalF <= 0 || *planHistoryTopQueriesF <= 0 {
+			l.Fatalf("Plan history interval and top queries must be positive, got %s and %d.", *planHistoryIntervalF, *planHistoryTopQueriesF)
+		}
+		planHistory = planhistory.NewCollector(db, clickhouseClient, actionsService, qanClient, *planHistoryIntervalF, *planHistoryTopQueriesF)
+	}
+
+	var indexAdvisor *indexadvisor.Advisor
+	if *indexAdvisorF {
+		if *indexAdvisorIntervalF <= 0 || *indexAdvisorTopQueriesF <= 0 {
+			l.Fatalf("Index advisor interval and top queries must be positive, got %s and %d.", *indexAdvisorIntervalF, *indexAdvisorTopQueriesF)
+		}
+		indexAdvisor = indexadvisor.NewAdvisor(db, clickhouseClient, actionsService, qanClient, *indexAdvisorIntervalF, *indexAdvisorTopQueriesF)
+	}
+
+	checksService := checks.New(db, actionsService, haService, v1.NewAPI(vmClient), clickhouseClient)
 	prom.MustRegister(checksService)
 
 	alertingService, err := alerting.NewService(db, grafanaClient)
@@ -1033,11 +1124,11 @@ func main() { //nolint:gocognit,maintidx,cyclop
 
 	versioner := agents.NewVersionerService(agentsRegistry)
 	compatibilityService := backup.NewCompatibilityService(db, versioner)
-	backupService := backup.NewService(db, jobsService, agentService, compatibilityService, pbmPITRService)
+	backupService := backup.NewService(db, jobsService, agentService, compatibilityService, pbmPITRService, mysqlPITRService)
 	backupMetricsCollector := backup.NewMetricsCollector(db)
 	prom.MustRegister(backupMetricsCollector)
 
-	schedulerService := scheduler.New(db, backupService)
+	schedulerService := scheduler.New(db, backupService, haService)
 	versionCache := versioncache.New(db, versioner)
 
 	dumpService := dump.New(db, &dump.URLs{
@@ -1128,7 +1219,7 @@ func main() { //nolint:gocognit,maintidx,cyclop
 		l.Fatalf("Failed to get settings: %+v.", err)
 	}
 
-	authServer := grafana.NewAuthServer(grafanaClient, db)
+	authServer := grafana.NewAuthServer(grafanaClient, db, agentCA)
 
 	l.Info("Starting services...")
 	var wg sync.WaitGroup
@@ -1149,11 +1240,36 @@ func main() { //nolint:gocognit,maintidx,cyclop
 		externalExporterStatusSvc.Run(ctx)
 	})
 
+	if rtaRecorder != nil {
+		wg.Go(func() {
+			rtaRecorder.Run(ctx)
+		})
+	}
+
 	haService.AddLeaderService(ha.NewContextService("checks", func(ctx context.Context) error {
 		checksService.Run(ctx)
 		return nil
 	}))
 
+	if planHistory != nil {
+		haService.AddLeaderService(ha.NewContextService("plan-history", func(ctx context.Context) error {
+			planHistory.Run(ctx)
+			return nil
+		}))
+	}
+
+	if indexAdvisor != nil {
+		haService.AddLeaderService(ha.NewContextService("index-advisor", func(ctx context.Context) error {
+			indexAdvisor.Run(ctx)
+			return nil
+		}))
+	}
+
+	haService.AddLeaderService(ha.NewContextService("audit-log-retention", func(ctx context.Context) error {
+		auditService.Run(ctx)
+		return nil
+	}))
+
 	wg.Go(func() {
 		updater.Run(ctx)
 	})
@@ -1195,6 +1311,7 @@ func main() { //nolint:gocognit,maintidx,cyclop
 				jobsService:               jobsService,
 				minioClient:               minioClient,
 				pbmPITRService:            pbmPITRService,
+				mysqlPITRService:          mysqlPITRService,
 				platformClient:            platformClient,
 				schedulerService:          schedulerService,
 				server:                    server,
@@ -1206,6 +1323,10 @@ func main() { //nolint:gocognit,maintidx,cyclop
 				vmalert:                   vmalert,
 				vmClient:                  &vmClient,
 				vmdb:                      vmdb,
+				rtaRecorder:               rtaRecorder,
+				auditService:              auditService,
+				permissionsService:        permissions.New(db),
+				agentCA:                   agentCA,
 			})
 	})
 
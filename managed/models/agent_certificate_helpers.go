@@ -0,0 +1,103 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gopkg.in/reform.v1"
+)
+
+// CreateAgentCertificateParams represents the parameters for recording a certificate issued to pmm-agent.
+type CreateAgentCertificateParams struct {
+	SerialNumber string
+	PMMAgentID   string
+	NotAfter     time.Time
+}
+
+// FindAgentCertificateBySerialNumber returns pmm-agent certificate by given serial number if found, ErrNotFound if not.
+func FindAgentCertificateBySerialNumber(q *reform.Querier, serialNumber string) (*AgentCertificate, error) {
+	if serialNumber == "" {
+		return nil, NewInvalidArgumentError("Empty certificate serial number.")
+	}
+
+	c := &AgentCertificate{SerialNumber: serialNumber}
+	if err := q.Reload(c); err != nil {
+		if errors.Is(err, reform.ErrNoRows) {
+			return nil, fmt.Errorf("certificate with serial number %s: %w", serialNumber, ErrNotFound)
+		}
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// CreateAgentCertificate records a certificate issued to pmm-agent.
+// Expired certificates of the same pmm-agent are removed.
+func CreateAgentCertificate(q *reform.Querier, params CreateAgentCertificateParams) (*AgentCertificate, error) {
+	if params.SerialNumber == "" {
+		return nil, NewInvalidArgumentError("Empty certificate serial number.")
+	}
+	if params.PMMAgentID == "" {
+		return nil, NewInvalidArgumentError("Empty pmm-agent ID.")
+	}
+
+	if _, err := q.DeleteFrom(AgentCertificateTable, "WHERE pmm_agent_id = $1 AND not_after < $2", params.PMMAgentID, Now()); err != nil {
+		return nil, fmt.Errorf("failed to remove expired certificates: %w", err)
+	}
+
+	c := &AgentCertificate{
+		SerialNumber: params.SerialNumber,
+		PMMAgentID:   params.PMMAgentID,
+		NotAfter:     params.NotAfter.UTC(),
+	}
+	if err := q.Insert(c); err != nil {
+		return nil, fmt.Errorf("failed to insert certificate: %w", err)
+	}
+
+	return c, nil
+}
+
+// HasAgentCertificates returns true if any certificate was issued to pmm-agent, including expired and revoked ones.
+// Such pmm-agent may authenticate only with a valid certificate.
+func HasAgentCertificates(q *reform.Querier, pmmAgentID string) (bool, error) {
+	n, err := q.Count(AgentCertificateTable, "WHERE pmm_agent_id = $1", pmmAgentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to count certificates: %w", err)
+	}
+	return n > 0, nil
+}
+
+// RevokeAgentCertificates revokes certificates of pmm-agent issued before the given time.
+func RevokeAgentCertificates(q *reform.Querier, pmmAgentID string, issuedBefore time.Time) error {
+	_, err := q.Exec("UPDATE agent_certificates SET revoked_at = $1 WHERE pmm_agent_id = $2 AND created_at < $3 AND revoked_at IS NULL",
+		Now(), pmmAgentID, issuedBefore.UTC())
+	if err != nil {
+		return fmt.Errorf("failed to revoke certificates: %w", err)
+	}
+	return nil
+}
+
+// RevokeAllAgentCertificates revokes all certificates of pmm-agent.
+func RevokeAllAgentCertificates(q *reform.Querier, pmmAgentID string) error {
+	_, err := q.Exec("UPDATE agent_certificates SET revoked_at = $1 WHERE pmm_agent_id = $2 AND revoked_at IS NULL", Now(), pmmAgentID)
+	if err != nil {
+		return fmt.Errorf("failed to revoke certificates: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/reform.v1"
+	"gopkg.in/reform.v1/dialects/postgresql"
+
+	"github.com/percona/pmm/managed/models"
+	"github.com/percona/pmm/managed/utils/testdb"
+)
+
+func TestAgentCertificates(t *testing.T) {
+	now, origNowF := models.Now(), models.Now
+	sqlDB := testdb.Open(t, models.SkipFixtures, nil)
+	t.Cleanup(func() {
+		models.Now = origNowF
+		require.NoError(t, sqlDB.Close())
+	})
+
+	db := reform.NewDB(sqlDB, postgresql.Dialect, reform.NewPrintfLogger(t.Logf))
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, tx.Rollback())
+	})
+	q := tx.Querier
+
+	node, err := models.CreateNode(q, models.GenericNodeType, &models.CreateNodeParams{NodeName: t.Name()})
+	require.NoError(t, err)
+	pmmAgent, err := models.CreatePMMAgent(q, node.NodeID, nil)
+	require.NoError(t, err)
+
+	create := func(at time.Time, serialNumber string, notAfter time.Time) *models.AgentCertificate {
+		models.Now = func() time.Time { return at }
+		c, err := models.CreateAgentCertificate(q, models.CreateAgentCertificateParams{
+			SerialNumber: serialNumber,
+			PMMAgentID:   pmmAgent.AgentID,
+			NotAfter:     notAfter,
+		})
+		require.NoError(t, err)
+		return c
+	}
+
+	create(now.Add(-3*time.Hour), "1", now.Add(-time.Minute))
+	c2 := create(now.Add(-2*time.Hour), "2", now.Add(24*time.Hour))
+	c3 := create(now.Add(-time.Hour), "3", now.Add(24*time.Hour))
+
+	t.Run("ExpiredRemoved", func(t *testing.T) {
+		_, err := models.FindAgentCertificateBySerialNumber(q, "1")
+		assert.ErrorIs(t, err, models.ErrNotFound)
+	})
+
+	t.Run("Revoke", func(t *testing.T) {
+		models.Now = func() time.Time { return now }
+		require.NoError(t, models.RevokeAgentCertificates(q, pmmAgent.AgentID, c3.CreatedAt))
+
+		actual, err := models.FindAgentCertificateBySerialNumber(q, c2.SerialNumber)
+		require.NoError(t, err)
+		require.NotNil(t, actual.RevokedAt)
+		assert.Equal(t, now.UTC(), *actual.RevokedAt)
+
+		actual, err = models.FindAgentCertificateBySerialNumber(q, c3.SerialNumber)
+		require.NoError(t, err)
+		assert.Nil(t, actual.RevokedAt)
+	})
+
+	t.Run("Has", func(t *testing.T) {
+		has, err := models.HasAgentCertificates(q, pmmAgent.AgentID)
+		require.NoError(t, err)
+		assert.True(t, has)
+
+		has, err = models.HasAgentCertificates(q, "/agent_id/unknown")
+		require.NoError(t, err)
+		assert.False(t, has)
+	})
+
+	t.Run("RevokeAll", func(t *testing.T) {
+		models.Now = func() time.Time { return now }
+		require.NoError(t, models.RevokeAllAgentCertificates(q, pmmAgent.AgentID))
+
+		actual, err := models.FindAgentCertificateBySerialNumber(q, c3.SerialNumber)
+		require.NoError(t, err)
+		require.NotNil(t, actual.RevokedAt)
+		assert.Equal(t, now.UTC(), *actual.RevokedAt)
+	})
+
+	t.Run("RemovedWithAgent", func(t *testing.T) {
+		_, err := models.RemoveAgent(q, pmmAgent.AgentID, models.RemoveCascade)
+		require.NoError(t, err)
+
+		_, err = models.FindAgentCertificateBySerialNumber(q, c3.SerialNumber)
+		assert.ErrorIs(t, err, models.ErrNotFound)
+	})
+}
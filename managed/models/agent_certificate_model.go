@@ -0,0 +1,59 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"time"
+
+	"gopkg.in/reform.v1"
+)
+
+//go:generate go tool reform
+
+// AgentCertificate represents a client certificate issued by PMM Server to pmm-agent.
+// Certificates are removed together with their pmm-agent.
+//
+//reform:agent_certificates
+type AgentCertificate struct {
+	SerialNumber string     `reform:"serial_number,pk"`
+	PMMAgentID   string     `reform:"pmm_agent_id"`
+	NotAfter     time.Time  `reform:"not_after"`
+	RevokedAt    *time.Time `reform:"revoked_at"`
+	CreatedAt    time.Time  `reform:"created_at"`
+}
+
+// BeforeInsert implements reform.BeforeInserter interface.
+func (c *AgentCertificate) BeforeInsert() error {
+	c.CreatedAt = Now()
+	return nil
+}
+
+// AfterFind implements reform.AfterFinder interface.
+func (c *AgentCertificate) AfterFind() error {
+	c.NotAfter = c.NotAfter.UTC()
+	if c.RevokedAt != nil {
+		revokedAt := c.RevokedAt.UTC()
+		c.RevokedAt = &revokedAt
+	}
+	c.CreatedAt = c.CreatedAt.UTC()
+	return nil
+}
+
+// check interfaces.
+var (
+	_ reform.BeforeInserter = (*AgentCertificate)(nil)
+	_ reform.AfterFinder    = (*AgentCertificate)(nil)
+)
@@ -30,6 +30,7 @@ import (
 	"google.golang.org/protobuf/types/known/durationpb"
 	"gopkg.in/reform.v1"
 
+	"github.com/percona/pmm/utils/secrets"
 	"github.com/percona/pmm/version"
 )
 
@@ -214,6 +215,18 @@ func checkUniqueAgentID(q *reform.Querier, id string) error {
 	return status.Errorf(codes.AlreadyExists, "Agent with ID %s already exists.", id)
 }
 
+// checkPasswordReference returns an error if the password looks like a secret reference, but is not a valid one.
+func checkPasswordReference(password string) error {
+	if !secrets.IsReference(password) {
+		return nil
+	}
+
+	if err := secrets.Validate(password); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid secret reference: %s.", err)
+	}
+	return nil
+}
+
 // AgentFilters represents filters for agents list.
 type AgentFilters struct {
 	// Return only Agents started by this pmm-agent.
@@ -358,12 +371,15 @@ func FindDBConfigForService(q *reform.Querier, serviceID string) (*DBConfig, err
 			MySQLdExporterType,
 			QANMySQLSlowlogAgentType,
 			QANMySQLPerfSchemaAgentType,
+			RTAMySQLAgentType,
 		}
 	case PostgreSQLServiceType:
 		agentTypes = []AgentType{
 			PostgresExporterType,
 			QANPostgreSQLPgStatementsAgentType,
 			QANPostgreSQLPgStatMonitorAgentType,
+			QANPostgreSQLLogAgentType,
+			RTAPostgreSQLAgentType,
 		}
 	case MongoDBServiceType:
 		agentTypes = []AgentType{
@@ -871,6 +887,9 @@ func compatibleServiceAndAgent(serviceType ServiceType, agentType AgentType) boo
 		ValkeyExporterType: {
 			ValkeyServiceType,
 		},
+		QANValkeySlowlogAgentType: {
+			ValkeyServiceType,
+		},
 		QANMongoDBProfilerAgentType: {
 			MongoDBServiceType,
 		},
@@ -880,12 +899,21 @@ func compatibleServiceAndAgent(serviceType ServiceType, agentType AgentType) boo
 		RTAMongoDBAgentType: {
 			MongoDBServiceType,
 		},
+		RTAMySQLAgentType: {
+			MySQLServiceType,
+		},
+		RTAPostgreSQLAgentType: {
+			PostgreSQLServiceType,
+		},
 		PostgresExporterType: {
 			PostgreSQLServiceType,
 		},
 		ProxySQLExporterType: {
 			ProxySQLServiceType,
 		},
+		QANProxySQLDigestAgentType: {
+			ProxySQLServiceType,
+		},
 		AzureDatabaseExporterType: {
 			PostgreSQLServiceType,
 			MySQLServiceType,
@@ -897,6 +925,9 @@ func compatibleServiceAndAgent(serviceType ServiceType, agentType AgentType) boo
 		QANPostgreSQLPgStatMonitorAgentType: {
 			PostgreSQLServiceType,
 		},
+		QANPostgreSQLLogAgentType: {
+			PostgreSQLServiceType,
+		},
 		QANPostgreSQLPgStatementsAgentType: {
 			PostgreSQLServiceType,
 		},
@@ -948,6 +979,10 @@ func CreateAgent(q *reform.Querier, agentType AgentType, params *CreateAgentPara
 		}
 	}
 
+	if err = checkPasswordReference(params.Password); err != nil {
+		return nil, err
+	}
+
 	exporterOptions := params.ExporterOptions
 	if pointer.Get(exporterOptions.ConnectionTimeout) == 0 {
 		exporterOptions.ConnectionTimeout = nil
@@ -986,8 +1021,7 @@ func CreateAgent(q *reform.Querier, agentType AgentType, params *CreateAgentPara
 	}
 
 	switch agentType {
-	// For the time being only RTA MangoDB Agent has RTA options.
-	case RTAMongoDBAgentType:
+	case RTAMongoDBAgentType, RTAMySQLAgentType, RTAPostgreSQLAgentType:
 		row.RTAOptions = RTAOptions{
 			// default value
 			CollectInterval: new(2 * time.Second), //nolint:mnd
@@ -1262,6 +1296,9 @@ func ChangeAgent(q *reform.Querier, agentID string, params *ChangeAgentParams) (
 		row.Username = params.Username
 	}
 	if params.Password != nil {
+		if err = checkPasswordReference(*params.Password); err != nil {
+			return nil, err
+		}
 		row.Password = params.Password
 	}
 	if params.AgentPassword != nil {
@@ -34,6 +34,7 @@ import (
 	"gopkg.in/reform.v1"
 
 	"github.com/percona/pmm/managed/utils/crypto/bcrypt"
+	"github.com/percona/pmm/utils/secrets"
 	"github.com/percona/pmm/version"
 )
 
@@ -59,6 +60,7 @@ const (
 	valkeyDialTimeout  = 3 * time.Second
 	agentIDLabel       = "agent_id"
 	agentTypeLabel     = "agent_type"
+	passwordSecretName = "password"
 )
 
 // Agent types (in the same order as in agents.proto).
@@ -82,12 +84,19 @@ const (
 	NomadAgentType                      AgentType = "nomad-agent"
 	ValkeyExporterType                  AgentType = "valkey_exporter"
 	RTAMongoDBAgentType                 AgentType = "rta-mongodb-agent"
+	RTAMySQLAgentType                   AgentType = "rta-mysql-agent"
+	RTAPostgreSQLAgentType              AgentType = "rta-postgresql-agent"
+	QANValkeySlowlogAgentType           AgentType = "qan-valkey-slowlog-agent"
+	QANProxySQLDigestAgentType          AgentType = "qan-proxysql-digest-agent"
+	QANPostgreSQLLogAgentType           AgentType = "qan-postgresql-log-agent"
 )
 
 // GetRTAAgentTypes returns all Real-Time Analytics Agent types.
 func GetRTAAgentTypes() []AgentType {
 	return []AgentType{
 		RTAMongoDBAgentType,
+		RTAMySQLAgentType,
+		RTAPostgreSQLAgentType,
 		// Add more types here once they are implemented.
 	}
 }
@@ -502,6 +511,34 @@ func (a *Agent) UnifiedLabels() (map[string]string, error) {
 	return res, nil
 }
 
+// IsPasswordReference returns true if the database password is a reference to the secret
+// in the external secret provider (for example, vault://kv/mysql/prod#password) resolved by pmm-agent.
+func (a *Agent) IsPasswordReference() bool {
+	return secrets.IsReference(pointer.GetString(a.Password))
+}
+
+// Secrets returns references to secrets by name that should be resolved by pmm-agent, or nil.
+func (a *Agent) Secrets() map[string]string {
+	if !a.IsPasswordReference() {
+		return nil
+	}
+
+	return map[string]string{passwordSecretName: *a.Password}
+}
+
+// TemplatePassword returns the database password, or the placeholder for the secret value resolved by pmm-agent
+// if the password is a secret reference. If urlEscaped is true, the resolved value is escaped for URL userinfo.
+func (a *Agent) TemplatePassword(tdp *DelimiterPair, urlEscaped bool) string {
+	if !a.IsPasswordReference() {
+		return pointer.GetString(a.Password)
+	}
+
+	if urlEscaped {
+		return tdp.Left + ".URLSecrets." + passwordSecretName + tdp.Right
+	}
+	return tdp.Left + ".Secrets." + passwordSecretName + tdp.Right
+}
+
 // DBConfig contains values required to connect to DB.
 type DBConfig struct {
 	User     string
@@ -509,6 +546,8 @@ type DBConfig struct {
 	Address  string
 	Port     int
 	Socket   string
+	// Secrets contains references to secrets by name if Password is a reference resolved by pmm-agent.
+	Secrets map[string]string
 }
 
 // Valid returns true if config is valid.
@@ -524,6 +563,7 @@ func (a *Agent) DBConfig(service *Service) *DBConfig {
 		Address:  pointer.GetString(service.Address),
 		Port:     int(pointer.GetUint16(service.Port)),
 		Socket:   pointer.GetString(service.Socket),
+		Secrets:  a.Secrets(),
 	}
 }
 
@@ -541,11 +581,11 @@ func (a *Agent) DSN(service *Service, dsnParams DSNParams, tdp *DelimiterPair, p
 	port := pointer.GetUint16(service.Port)
 	socket := pointer.GetString(service.Socket)
 	username := pointer.GetString(a.Username)
-	password := pointer.GetString(a.Password)
 
 	if tdp == nil {
 		tdp = a.TemplateDelimiters(service)
 	}
+	password := a.TemplatePassword(tdp, false)
 
 	switch a.AgentType {
 	case MySQLdExporterType:
@@ -588,7 +628,7 @@ func (a *Agent) DSN(service *Service, dsnParams DSNParams, tdp *DelimiterPair, p
 
 		return cfg.FormatDSN()
 
-	case QANMySQLPerfSchemaAgentType, QANMySQLSlowlogAgentType:
+	case QANMySQLPerfSchemaAgentType, QANMySQLSlowlogAgentType, RTAMySQLAgentType:
 		cfg := mysql.NewConfig()
 		cfg.User = username
 		cfg.Passwd = password
@@ -632,7 +672,7 @@ func (a *Agent) DSN(service *Service, dsnParams DSNParams, tdp *DelimiterPair, p
 
 		return cfg.FormatDSN()
 
-	case ProxySQLExporterType:
+	case ProxySQLExporterType, QANProxySQLDigestAgentType:
 		cfg := mysql.NewConfig()
 		cfg.User = username
 		cfg.Passwd = password
@@ -714,7 +754,7 @@ func (a *Agent) DSN(service *Service, dsnParams DSNParams, tdp *DelimiterPair, p
 		}
 		switch {
 		case password != "":
-			u.User = url.UserPassword(username, password)
+			u.User = url.UserPassword(username, a.TemplatePassword(tdp, true))
 		case username != "":
 			u.User = url.User(username)
 		}
@@ -723,7 +763,7 @@ func (a *Agent) DSN(service *Service, dsnParams DSNParams, tdp *DelimiterPair, p
 		dsn = strings.ReplaceAll(dsn, url.QueryEscape(tdp.Right), tdp.Right)
 		return dsn
 
-	case PostgresExporterType, QANPostgreSQLPgStatementsAgentType, QANPostgreSQLPgStatMonitorAgentType:
+	case PostgresExporterType, QANPostgreSQLPgStatementsAgentType, QANPostgreSQLPgStatMonitorAgentType, QANPostgreSQLLogAgentType, RTAPostgreSQLAgentType:
 		q := make(url.Values)
 
 		sslmode := DisableSSLMode
@@ -775,7 +815,7 @@ func (a *Agent) DSN(service *Service, dsnParams DSNParams, tdp *DelimiterPair, p
 		}
 		switch {
 		case password != "":
-			u.User = url.UserPassword(username, password)
+			u.User = url.UserPassword(username, a.TemplatePassword(tdp, true))
 		case username != "":
 			u.User = url.User(username)
 		}
@@ -786,7 +826,7 @@ func (a *Agent) DSN(service *Service, dsnParams DSNParams, tdp *DelimiterPair, p
 
 		return dsn
 
-	case ValkeyExporterType:
+	case ValkeyExporterType, QANValkeySlowlogAgentType:
 		urlScheme := "redis"
 		if a.TLS {
 			urlScheme += "s"
@@ -805,7 +845,7 @@ func (a *Agent) DSN(service *Service, dsnParams DSNParams, tdp *DelimiterPair, p
 		}
 		switch {
 		case password != "":
-			u.User = url.UserPassword(username, password)
+			u.User = url.UserPassword(username, a.TemplatePassword(tdp, true))
 		case username != "":
 			u.User = url.User(username)
 		}
@@ -831,7 +871,7 @@ func (a *Agent) EffectiveDialTimeout() time.Duration {
 		return *a.ExporterOptions.ConnectionTimeout
 	}
 
-	if a.AgentType == ValkeyExporterType {
+	if a.AgentType == ValkeyExporterType || a.AgentType == QANValkeySlowlogAgentType {
 		return valkeyDialTimeout
 	}
 
@@ -900,7 +940,7 @@ func (a *Agent) IsMySQLTablestatsGroupEnabled() bool {
 // Files returns files map required to connect to DB.
 func (a Agent) Files() map[string]string { //nolint:gocognit
 	switch a.AgentType {
-	case MySQLdExporterType, QANMySQLPerfSchemaAgentType, QANMySQLSlowlogAgentType:
+	case MySQLdExporterType, QANMySQLPerfSchemaAgentType, QANMySQLSlowlogAgentType, RTAMySQLAgentType:
 		files := make(map[string]string)
 		if a.MySQLOptions.TLSCa != "" {
 			files["tlsCa"] = a.MySQLOptions.TLSCa
@@ -917,7 +957,7 @@ func (a Agent) Files() map[string]string { //nolint:gocognit
 		}
 
 		return nil
-	case ProxySQLExporterType:
+	case ProxySQLExporterType, QANProxySQLDigestAgentType:
 		return nil
 	case QANMongoDBProfilerAgentType, QANMongoDBMongologAgentType, MongoDBExporterType, RTAMongoDBAgentType:
 		files := make(map[string]string)
@@ -933,7 +973,7 @@ func (a Agent) Files() map[string]string { //nolint:gocognit
 		}
 
 		return nil
-	case PostgresExporterType, QANPostgreSQLPgStatementsAgentType, QANPostgreSQLPgStatMonitorAgentType:
+	case PostgresExporterType, QANPostgreSQLPgStatementsAgentType, QANPostgreSQLPgStatMonitorAgentType, QANPostgreSQLLogAgentType, RTAPostgreSQLAgentType:
 		files := make(map[string]string)
 
 		if a.PostgreSQLOptions.SSLCa != "" {
@@ -951,7 +991,7 @@ func (a Agent) Files() map[string]string { //nolint:gocognit
 		}
 
 		return nil
-	case ValkeyExporterType:
+	case ValkeyExporterType, QANValkeySlowlogAgentType:
 		files := make(map[string]string)
 
 		if a.ValkeyOptions.SSLCa != "" {
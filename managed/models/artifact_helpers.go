@@ -18,7 +18,9 @@ package models
 import (
 	"errors"
 	"fmt"
+	"path"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	"google.golang.org/grpc/codes"
@@ -269,12 +271,14 @@ func CreateArtifact(q *reform.Querier, params CreateArtifactParams) (*Artifact,
 
 // UpdateArtifactParams are params for changing existing artifact.
 type UpdateArtifactParams struct {
-	ServiceID        *string
-	Status           *BackupStatus
-	ScheduleID       *string
-	IsShardedCluster bool
-	Metadata         *Metadata
-	Folder           *string
+	ServiceID          *string
+	Status             *BackupStatus
+	ScheduleID         *string
+	IsShardedCluster   bool
+	Metadata           *Metadata
+	Folder             *string
+	VerificationStatus *VerificationStatus
+	LastVerifiedAt     *time.Time
 }
 
 // UpdateArtifact updates existing artifact.
@@ -306,6 +310,17 @@ func UpdateArtifact(q *reform.Querier, artifactID string, params UpdateArtifactP
 		row.Folder = *params.Folder
 	}
 
+	if params.VerificationStatus != nil {
+		if err := params.VerificationStatus.Validate(); err != nil {
+			return nil, err
+		}
+		row.VerificationStatus = *params.VerificationStatus
+	}
+
+	if params.LastVerifiedAt != nil {
+		row.LastVerifiedAt = params.LastVerifiedAt
+	}
+
 	err = q.Update(row)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update backup artifact: %w", err)
@@ -341,6 +356,12 @@ func (s *Artifact) MetadataRemoveFirstN(q *reform.Querier, n uint32) error {
 	return nil
 }
 
+// MySQLBinlogFolder returns the folder where binary logs of MySQL PITR artifact are stored.
+// Keep in sync with agent/runner/jobs/mysql_binlog.go.
+func (s *Artifact) MySQLBinlogFolder() string {
+	return path.Join(s.Folder, s.Name, "binlogs")
+}
+
 // IsArtifactFinalStatus checks if artifact status is one of the final ones.
 func IsArtifactFinalStatus(backupStatus BackupStatus) bool {
 	switch backupStatus {
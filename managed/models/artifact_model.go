@@ -84,6 +84,35 @@ func (bs BackupStatus) Pointer() *BackupStatus {
 	return &bs
 }
 
+// VerificationStatus shows the result of the latest artifact verification.
+type VerificationStatus string
+
+// VerificationStatus statuses (in the same order as in artifacts.proto).
+// Empty value means that artifact has never been verified.
+const (
+	PendingVerificationStatus  VerificationStatus = "pending"
+	VerifiedVerificationStatus VerificationStatus = "verified"
+	FailedVerificationStatus   VerificationStatus = "failed"
+)
+
+// Validate validates verification status.
+func (vs VerificationStatus) Validate() error {
+	switch vs {
+	case PendingVerificationStatus:
+	case VerifiedVerificationStatus:
+	case FailedVerificationStatus:
+	default:
+		return NewInvalidArgumentError("invalid verification status '%s'", vs)
+	}
+
+	return nil
+}
+
+// Pointer returns a pointer to verification status value.
+func (vs VerificationStatus) Pointer() *VerificationStatus {
+	return &vs
+}
+
 // ArtifactType represents type how artifact was created.
 type ArtifactType string
 
@@ -155,22 +184,24 @@ func (p *MetadataList) Scan(src any) error { return jsonScan(p, src) }
 //
 //reform:artifacts
 type Artifact struct {
-	ID               string       `reform:"id,pk"`
-	Name             string       `reform:"name"`
-	Vendor           string       `reform:"vendor"`
-	DBVersion        string       `reform:"db_version"`
-	LocationID       string       `reform:"location_id"`
-	ServiceID        string       `reform:"service_id"`
-	DataModel        DataModel    `reform:"data_model"`
-	Mode             BackupMode   `reform:"mode"`
-	Status           BackupStatus `reform:"status"`
-	Type             ArtifactType `reform:"type"`
-	ScheduleID       string       `reform:"schedule_id"`
-	CreatedAt        time.Time    `reform:"created_at"`
-	UpdatedAt        time.Time    `reform:"updated_at"`
-	IsShardedCluster bool         `reform:"is_sharded_cluster"`
-	Folder           string       `reform:"folder"`
-	MetadataList     MetadataList `reform:"metadata_list"`
+	ID                 string             `reform:"id,pk"`
+	Name               string             `reform:"name"`
+	Vendor             string             `reform:"vendor"`
+	DBVersion          string             `reform:"db_version"`
+	LocationID         string             `reform:"location_id"`
+	ServiceID          string             `reform:"service_id"`
+	DataModel          DataModel          `reform:"data_model"`
+	Mode               BackupMode         `reform:"mode"`
+	Status             BackupStatus       `reform:"status"`
+	Type               ArtifactType       `reform:"type"`
+	ScheduleID         string             `reform:"schedule_id"`
+	CreatedAt          time.Time          `reform:"created_at"`
+	UpdatedAt          time.Time          `reform:"updated_at"`
+	IsShardedCluster   bool               `reform:"is_sharded_cluster"`
+	Folder             string             `reform:"folder"`
+	MetadataList       MetadataList       `reform:"metadata_list"`
+	VerificationStatus VerificationStatus `reform:"verification_status"`
+	LastVerifiedAt     *time.Time         `reform:"last_verified_at"`
 }
 
 // BeforeInsert implements reform.BeforeInserter interface.
@@ -191,6 +222,9 @@ func (s *Artifact) BeforeUpdate() error {
 func (s *Artifact) AfterFind() error {
 	s.CreatedAt = s.CreatedAt.UTC()
 	s.UpdatedAt = s.UpdatedAt.UTC()
+	if s.LastVerifiedAt != nil {
+		s.LastVerifiedAt = new(s.LastVerifiedAt.UTC())
+	}
 	return nil
 }
 
@@ -44,6 +44,8 @@ func (v *artifactTableType) Columns() []string {
 		"is_sharded_cluster",
 		"folder",
 		"metadata_list",
+		"verification_status",
+		"last_verified_at",
 	}
 }
 
@@ -84,6 +86,8 @@ var ArtifactTable = &artifactTableType{
 			{Name: "IsShardedCluster", Type: "bool", Column: "is_sharded_cluster"},
 			{Name: "Folder", Type: "string", Column: "folder"},
 			{Name: "MetadataList", Type: "MetadataList", Column: "metadata_list"},
+			{Name: "VerificationStatus", Type: "VerificationStatus", Column: "verification_status"},
+			{Name: "LastVerifiedAt", Type: "*time.Time", Column: "last_verified_at"},
 		},
 		PKFieldIndex: 0,
 	},
@@ -92,7 +96,7 @@ var ArtifactTable = &artifactTableType{
 
 // String returns a string representation of this struct or record.
 func (s Artifact) String() string {
-	res := make([]string, 16)
+	res := make([]string, 18)
 	res[0] = "ID: " + reform.Inspect(s.ID, true)
 	res[1] = "Name: " + reform.Inspect(s.Name, true)
 	res[2] = "Vendor: " + reform.Inspect(s.Vendor, true)
@@ -109,6 +113,8 @@ func (s Artifact) String() string {
 	res[13] = "IsShardedCluster: " + reform.Inspect(s.IsShardedCluster, true)
 	res[14] = "Folder: " + reform.Inspect(s.Folder, true)
 	res[15] = "MetadataList: " + reform.Inspect(s.MetadataList, true)
+	res[16] = "VerificationStatus: " + reform.Inspect(s.VerificationStatus, true)
+	res[17] = "LastVerifiedAt: " + reform.Inspect(s.LastVerifiedAt, true)
 	return strings.Join(res, ", ")
 }
 
@@ -132,6 +138,8 @@ func (s *Artifact) Values() []interface{} {
 		s.IsShardedCluster,
 		s.Folder,
 		s.MetadataList,
+		s.VerificationStatus,
+		s.LastVerifiedAt,
 	}
 }
 
@@ -155,6 +163,8 @@ func (s *Artifact) Pointers() []interface{} {
 		&s.IsShardedCluster,
 		&s.Folder,
 		&s.MetadataList,
+		&s.VerificationStatus,
+		&s.LastVerifiedAt,
 	}
 }
 
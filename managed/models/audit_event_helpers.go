@@ -0,0 +1,158 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gopkg.in/reform.v1"
+)
+
+// AuditEventFilters represents filters for audit events list.
+type AuditEventFilters struct {
+	// Return only events since that time.
+	StartTime *time.Time
+	// Return only events before that time.
+	EndTime *time.Time
+	// Return only events of the user with that login.
+	Username string
+	// Return only events of methods containing that string.
+	Method string
+	// Return only events with that target ID.
+	TargetID string
+	// Return only events with that status code.
+	StatusCode string
+	// Skip that number of events.
+	Offset int
+	// Return at most that number of events; all events if zero.
+	Limit int
+}
+
+// CreateAuditEventParams represents the parameters for creating an audit event.
+type CreateAuditEventParams struct {
+	UserID     int64
+	Username   string
+	Role       string
+	Method     string
+	TargetIDs  []string
+	Request    string
+	StatusCode string
+	Error      string
+}
+
+// CreateAuditEvent persists an audit event.
+func CreateAuditEvent(q *reform.Querier, params CreateAuditEventParams) (*AuditEvent, error) {
+	if params.Method == "" {
+		return nil, NewInvalidArgumentError("Empty audit event method.")
+	}
+
+	event := &AuditEvent{
+		ID:         uuid.New().String(),
+		UserID:     params.UserID,
+		Username:   params.Username,
+		Role:       params.Role,
+		Method:     params.Method,
+		TargetIDs:  params.TargetIDs,
+		Request:    params.Request,
+		StatusCode: params.StatusCode,
+		Error:      params.Error,
+	}
+	if err := q.Insert(event); err != nil {
+		return nil, fmt.Errorf("failed to insert audit event: %w", err)
+	}
+
+	return event, nil
+}
+
+func auditEventsWhere(q *reform.Querier, filters AuditEventFilters) (string, []any) {
+	var conditions []string
+	var args []any
+	add := func(condition string, arg any) {
+		args = append(args, arg)
+		conditions = append(conditions, fmt.Sprintf(condition, q.Placeholder(len(args))))
+	}
+
+	if filters.StartTime != nil {
+		add("created_at >= %s", filters.StartTime.UTC())
+	}
+	if filters.EndTime != nil {
+		add("created_at < %s", filters.EndTime.UTC())
+	}
+	if filters.Username != "" {
+		add("username = %s", filters.Username)
+	}
+	if filters.Method != "" {
+		add("strpos(method, %s) > 0", filters.Method)
+	}
+	if filters.TargetID != "" {
+		add("%s = ANY(target_ids)", filters.TargetID)
+	}
+	if filters.StatusCode != "" {
+		add("status_code = %s", filters.StatusCode)
+	}
+
+	if len(conditions) == 0 {
+		return "", nil
+	}
+	return "WHERE " + strings.Join(conditions, " AND "), args
+}
+
+// FindAuditEvents returns audit events sorted by creation time in DESCENDING order.
+func FindAuditEvents(q *reform.Querier, filters AuditEventFilters) ([]*AuditEvent, error) {
+	tail, args := auditEventsWhere(q, filters)
+	tail += " ORDER BY created_at DESC, id"
+	if filters.Limit > 0 {
+		args = append(args, filters.Limit)
+		tail += " LIMIT " + q.Placeholder(len(args))
+	}
+	if filters.Offset > 0 {
+		args = append(args, filters.Offset)
+		tail += " OFFSET " + q.Placeholder(len(args))
+	}
+
+	rows, err := q.SelectAllFrom(AuditEventTable, tail, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select audit events: %w", err)
+	}
+
+	events := make([]*AuditEvent, 0, len(rows))
+	for _, r := range rows {
+		events = append(events, r.(*AuditEvent)) //nolint:forcetypeassert
+	}
+	return events, nil
+}
+
+// CountAuditEvents returns the number of audit events matching filters; Offset and Limit are ignored.
+func CountAuditEvents(q *reform.Querier, filters AuditEventFilters) (int, error) {
+	tail, args := auditEventsWhere(q, filters)
+	count, err := q.Count(AuditEventTable, tail, args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count audit events: %w", err)
+	}
+	return count, nil
+}
+
+// DeleteAuditEventsBefore removes audit events created before the given time, and returns the number of removed events.
+func DeleteAuditEventsBefore(q *reform.Querier, t time.Time) (uint, error) {
+	deleted, err := q.DeleteFrom(AuditEventTable, "WHERE created_at < $1", t.UTC())
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete audit events: %w", err)
+	}
+	return deleted, nil
+}
@@ -0,0 +1,144 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/reform.v1"
+	"gopkg.in/reform.v1/dialects/postgresql"
+
+	"github.com/percona/pmm/managed/models"
+	"github.com/percona/pmm/managed/utils/testdb"
+)
+
+func TestAuditEvents(t *testing.T) {
+	now, origNowF := models.Now(), models.Now
+	sqlDB := testdb.Open(t, models.SkipFixtures, nil)
+	t.Cleanup(func() {
+		models.Now = origNowF
+		require.NoError(t, sqlDB.Close())
+	})
+
+	db := reform.NewDB(sqlDB, postgresql.Dialect, reform.NewPrintfLogger(t.Logf))
+	tx, err := db.Begin()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		require.NoError(t, tx.Rollback())
+	})
+	q := tx.Querier
+
+	create := func(at time.Time, params models.CreateAuditEventParams) *models.AuditEvent {
+		models.Now = func() time.Time { return at }
+		event, err := models.CreateAuditEvent(q, params)
+		require.NoError(t, err)
+		return event
+	}
+
+	e1 := create(now.Add(-3*time.Hour), models.CreateAuditEventParams{
+		UserID:     1,
+		Username:   "admin",
+		Role:       "admin",
+		Method:     "/inventory.v1.ServicesService/RemoveService",
+		TargetIDs:  []string{"service1"},
+		Request:    `{"serviceId":"service1"}`,
+		StatusCode: "OK",
+	})
+	e2 := create(now.Add(-2*time.Hour), models.CreateAuditEventParams{
+		UserID:     2,
+		Username:   "editor",
+		Role:       "editor",
+		Method:     "/server.v1.ServerService/ChangeSettings",
+		Request:    `{}`,
+		StatusCode: "PermissionDenied",
+		Error:      "Access denied.",
+	})
+	e3 := create(now.Add(-time.Hour), models.CreateAuditEventParams{
+		UserID:     1,
+		Username:   "admin",
+		Role:       "admin",
+		Method:     "/inventory.v1.NodesService/RemoveNode",
+		TargetIDs:  []string{"node1", "service1"},
+		Request:    `{"nodeId":"node1","force":true}`,
+		StatusCode: "OK",
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := models.CreateAuditEvent(q, models.CreateAuditEventParams{})
+		require.EqualError(t, err, "Empty audit event method.")
+	})
+
+	t.Run("Find", func(t *testing.T) {
+		ids := func(events []*models.AuditEvent) []string {
+			res := make([]string, 0, len(events))
+			for _, e := range events {
+				res = append(res, e.ID)
+			}
+			return res
+		}
+
+		events, err := models.FindAuditEvents(q, models.AuditEventFilters{})
+		require.NoError(t, err)
+		assert.Equal(t, []string{e3.ID, e2.ID, e1.ID}, ids(events))
+		assert.Equal(t, []string{"node1", "service1"}, []string(events[0].TargetIDs))
+
+		events, err = models.FindAuditEvents(q, models.AuditEventFilters{TargetID: "service1"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{e3.ID, e1.ID}, ids(events))
+
+		events, err = models.FindAuditEvents(q, models.AuditEventFilters{Username: "admin", Method: "RemoveService"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{e1.ID}, ids(events))
+
+		events, err = models.FindAuditEvents(q, models.AuditEventFilters{StatusCode: "PermissionDenied"})
+		require.NoError(t, err)
+		assert.Equal(t, []string{e2.ID}, ids(events))
+
+		filters := models.AuditEventFilters{StartTime: new(now.Add(-150 * time.Minute)), EndTime: &now, Offset: 1, Limit: 1}
+		events, err = models.FindAuditEvents(q, filters)
+		require.NoError(t, err)
+		assert.Equal(t, []string{e2.ID}, ids(events))
+
+		count, err := models.CountAuditEvents(q, filters)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+	})
+
+	t.Run("AppendOnly", func(t *testing.T) {
+		_, err := q.Exec("SAVEPOINT audit_update")
+		require.NoError(t, err)
+
+		e1.Username = "someone-else"
+		err = q.Update(e1)
+		require.ErrorContains(t, err, "audit events are append-only")
+
+		_, err = q.Exec("ROLLBACK TO SAVEPOINT audit_update")
+		require.NoError(t, err)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		deleted, err := models.DeleteAuditEventsBefore(q, now.Add(-90*time.Minute))
+		require.NoError(t, err)
+		assert.Equal(t, uint(2), deleted)
+
+		count, err := models.CountAuditEvents(q, models.AuditEventFilters{})
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
@@ -0,0 +1,60 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+	"gopkg.in/reform.v1"
+)
+
+//go:generate go tool reform
+
+// AuditEvent represents a record of a mutating API call.
+// Audit events are append-only: the database rejects updates, and rows are only deleted by retention.
+//
+//reform:audit_events
+type AuditEvent struct {
+	ID         string         `reform:"id,pk"`
+	UserID     int64          `reform:"user_id"`
+	Username   string         `reform:"username"`
+	Role       string         `reform:"role"`
+	Method     string         `reform:"method"`
+	TargetIDs  pq.StringArray `reform:"target_ids"`
+	Request    string         `reform:"request"`
+	StatusCode string         `reform:"status_code"`
+	Error      string         `reform:"error"`
+	CreatedAt  time.Time      `reform:"created_at"`
+}
+
+// BeforeInsert implements reform.BeforeInserter interface.
+func (e *AuditEvent) BeforeInsert() error {
+	e.CreatedAt = Now()
+	return nil
+}
+
+// AfterFind implements reform.AfterFinder interface.
+func (e *AuditEvent) AfterFind() error {
+	e.CreatedAt = e.CreatedAt.UTC()
+	return nil
+}
+
+// check interfaces.
+var (
+	_ reform.BeforeInserter = (*AuditEvent)(nil)
+	_ reform.AfterFinder    = (*AuditEvent)(nil)
+)
@@ -1185,6 +1185,73 @@ var databaseSchema = [][]string{
 		`ALTER TABLE dumps ADD COLUMN encrypted boolean NOT NULL DEFAULT false`,
 		`UPDATE dumps SET encrypted = false`,
 	},
+	119: {
+		`INSERT INTO service_software_versions(
+			service_id,
+			service_type,
+			software_versions,
+			next_check_at,
+			created_at,
+			updated_at
+		)
+		SELECT
+			service_id,
+			service_type,
+			'[]' AS software_versions,
+			(NOW() AT TIME ZONE 'utc') AS next_check_at,
+			(NOW() AT TIME ZONE 'utc') AS created_at,
+			(NOW() AT TIME ZONE 'utc') AS updated_at
+		FROM services
+		WHERE service_type = 'postgresql'
+		ON CONFLICT (service_id) DO NOTHING;`,
+	},
+	120: {
+		`ALTER TABLE artifacts
+			ADD COLUMN verification_status VARCHAR NOT NULL DEFAULT '',
+			ADD COLUMN last_verified_at TIMESTAMP`,
+		`ALTER TABLE artifacts ALTER COLUMN verification_status DROP DEFAULT`,
+	},
+	121: {
+		`CREATE TABLE audit_events (
+			id VARCHAR NOT NULL,
+			user_id BIGINT NOT NULL,
+			username VARCHAR NOT NULL,
+			role VARCHAR NOT NULL,
+			method VARCHAR NOT NULL CHECK (method <> ''),
+			target_ids VARCHAR[],
+			request TEXT NOT NULL,
+			status_code VARCHAR NOT NULL,
+			error TEXT NOT NULL,
+			created_at TIMESTAMP NOT NULL,
+
+			PRIMARY KEY (id)
+		)`,
+		`CREATE INDEX audit_events_created_at_idx ON audit_events (created_at)`,
+		`CREATE INDEX audit_events_target_ids_idx ON audit_events USING GIN (target_ids)`,
+		`CREATE FUNCTION audit_events_forbid_update() RETURNS trigger AS $$
+		BEGIN
+			RAISE EXCEPTION 'audit events are append-only';
+		END;
+		$$ LANGUAGE plpgsql`,
+		`CREATE TRIGGER audit_events_forbid_update BEFORE UPDATE ON audit_events
+			FOR EACH ROW EXECUTE FUNCTION audit_events_forbid_update()`,
+	},
+	122: {
+		`ALTER TABLE roles ADD COLUMN permissions VARCHAR[]`,
+	},
+	123: {
+		`CREATE TABLE agent_certificates (
+			serial_number VARCHAR NOT NULL,
+			pmm_agent_id VARCHAR NOT NULL CHECK (pmm_agent_id <> ''),
+			not_after TIMESTAMP NOT NULL,
+			revoked_at TIMESTAMP,
+			created_at TIMESTAMP NOT NULL,
+
+			PRIMARY KEY (serial_number),
+			FOREIGN KEY (pmm_agent_id) REFERENCES agents (agent_id) ON DELETE CASCADE
+		)`,
+		`CREATE INDEX agent_certificates_pmm_agent_id_idx ON agent_certificates (pmm_agent_id)`,
+	},
 }
 
 // ^^^ Avoid default values in schema definition. ^^^
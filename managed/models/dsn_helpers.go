@@ -56,13 +56,16 @@ func FindDSNByServiceIDandPMMAgentID(q *reform.Querier, serviceID, pmmAgentID, d
 			QANMySQLSlowlogAgentType,
 			QANMySQLPerfSchemaAgentType,
 			MySQLdExporterType,
+			RTAMySQLAgentType,
 		)
 	case PostgreSQLServiceType:
 		agentTypes = append(
 			agentTypes,
 			QANPostgreSQLPgStatementsAgentType,
 			QANPostgreSQLPgStatMonitorAgentType,
+			QANPostgreSQLLogAgentType,
 			PostgresExporterType,
+			RTAPostgreSQLAgentType,
 		)
 		dsnParams.PostgreSQLSupportsSSLSNI, err = IsPostgreSQLSSLSniSupported(q, pmmAgentID)
 		if err != nil {
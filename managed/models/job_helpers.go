@@ -125,6 +125,11 @@ func (p CreateJobParams) Validate() error {
 	case MySQLRestoreBackupJob:
 	case MongoDBBackupJob:
 	case MongoDBRestoreBackupJob:
+	case PostgreSQLBackupJob:
+	case PostgreSQLRestoreBackupJob:
+	case MySQLVerifyBackupJob:
+	case MongoDBVerifyBackupJob:
+	case MySQLBinlogStreamJob:
 	default:
 		return fmt.Errorf("unknown job type: %v", p.Type)
 	}
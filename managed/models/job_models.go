@@ -29,10 +29,15 @@ type JobType string
 
 // Supported job types.
 const (
-	MySQLBackupJob          = JobType("mysql_backup")
-	MySQLRestoreBackupJob   = JobType("mysql_restore_backup")
-	MongoDBBackupJob        = JobType("mongodb_backup")
-	MongoDBRestoreBackupJob = JobType("mongodb_restore_backup")
+	MySQLBackupJob             = JobType("mysql_backup")
+	MySQLRestoreBackupJob      = JobType("mysql_restore_backup")
+	MongoDBBackupJob           = JobType("mongodb_backup")
+	MongoDBRestoreBackupJob    = JobType("mongodb_restore_backup")
+	PostgreSQLBackupJob        = JobType("postgresql_backup")
+	PostgreSQLRestoreBackupJob = JobType("postgresql_restore_backup")
+	MySQLVerifyBackupJob       = JobType("mysql_verify_backup")
+	MongoDBVerifyBackupJob     = JobType("mongodb_verify_backup")
+	MySQLBinlogStreamJob       = JobType("mysql_binlog_stream")
 )
 
 // MySQLBackupJobResult stores MySQL job specific result data.
@@ -47,12 +52,32 @@ type MongoDBBackupJobResult struct{}
 // MongoDBRestoreBackupJobResult stores MongoDB restore backup job specific result data.
 type MongoDBRestoreBackupJobResult struct{}
 
+// PostgreSQLBackupJobResult stores PostgreSQL job specific result data.
+type PostgreSQLBackupJobResult struct{}
+
+// PostgreSQLRestoreBackupJobResult stores PostgreSQL restore backup job specific result data.
+type PostgreSQLRestoreBackupJobResult struct{}
+
+// MySQLVerifyBackupJobResult stores MySQL verify backup job specific result data.
+type MySQLVerifyBackupJobResult struct{}
+
+// MongoDBVerifyBackupJobResult stores MongoDB verify backup job specific result data.
+type MongoDBVerifyBackupJobResult struct{}
+
+// MySQLBinlogStreamJobResult stores MySQL binlog stream job specific result data.
+type MySQLBinlogStreamJobResult struct{}
+
 // JobResult holds result data for different job types.
 type JobResult struct {
-	MySQLBackup          *MySQLBackupJobResult          `json:"mysql_backup,omitempty"`
-	MySQLRestoreBackup   *MySQLRestoreBackupJobResult   `json:"mysql_restore_backup,omitempty"`
-	MongoDBBackup        *MongoDBBackupJobResult        `json:"mongo_db_backup,omitempty"`
-	MongoDBRestoreBackup *MongoDBRestoreBackupJobResult `json:"mongo_db_restore_backup,omitempty"`
+	MySQLBackup             *MySQLBackupJobResult             `json:"mysql_backup,omitempty"`
+	MySQLRestoreBackup      *MySQLRestoreBackupJobResult      `json:"mysql_restore_backup,omitempty"`
+	MongoDBBackup           *MongoDBBackupJobResult           `json:"mongo_db_backup,omitempty"`
+	MongoDBRestoreBackup    *MongoDBRestoreBackupJobResult    `json:"mongo_db_restore_backup,omitempty"`
+	PostgreSQLBackup        *PostgreSQLBackupJobResult        `json:"postgresql_backup,omitempty"`
+	PostgreSQLRestoreBackup *PostgreSQLRestoreBackupJobResult `json:"postgresql_restore_backup,omitempty"`
+	MySQLVerifyBackup       *MySQLVerifyBackupJobResult       `json:"mysql_verify_backup,omitempty"`
+	MongoDBVerifyBackup     *MongoDBVerifyBackupJobResult     `json:"mongodb_verify_backup,omitempty"`
+	MySQLBinlogStream       *MySQLBinlogStreamJobResult       `json:"mysql_binlog_stream,omitempty"`
 }
 
 // Value implements database/sql/driver.Valuer interface. Should be defined on the value.
@@ -63,8 +88,9 @@ func (r *JobResult) Scan(src any) error { return jsonScan(r, src) }
 
 // MySQLBackupJobData stores MySQL job specific result data.
 type MySQLBackupJobData struct {
-	ServiceID  string `json:"service_id"`
-	ArtifactID string `json:"artifact_id"`
+	ServiceID  string     `json:"service_id"`
+	ArtifactID string     `json:"artifact_id"`
+	Mode       BackupMode `json:"mode"`
 }
 
 // MySQLRestoreBackupJobData stores MySQL restore backup job specific result data.
@@ -88,12 +114,49 @@ type MongoDBRestoreBackupJobData struct {
 	DataModel DataModel `json:"data_model"`
 }
 
+// PostgreSQLBackupJobData stores PostgreSQL job specific result data.
+type PostgreSQLBackupJobData struct {
+	ServiceID  string    `json:"service_id"`
+	ArtifactID string    `json:"artifact_id"`
+	DataModel  DataModel `json:"data_model"`
+}
+
+// PostgreSQLRestoreBackupJobData stores PostgreSQL restore backup job specific result data.
+type PostgreSQLRestoreBackupJobData struct {
+	ServiceID string    `json:"service_id"`
+	RestoreID string    `json:"restore_id"`
+	DataModel DataModel `json:"data_model"`
+}
+
+// MySQLVerifyBackupJobData stores MySQL verify backup job specific result data.
+type MySQLVerifyBackupJobData struct {
+	ServiceID  string `json:"service_id"`
+	ArtifactID string `json:"artifact_id"`
+}
+
+// MongoDBVerifyBackupJobData stores MongoDB verify backup job specific result data.
+type MongoDBVerifyBackupJobData struct {
+	ServiceID  string `json:"service_id"`
+	ArtifactID string `json:"artifact_id"`
+}
+
+// MySQLBinlogStreamJobData stores MySQL binlog stream job specific data.
+type MySQLBinlogStreamJobData struct {
+	ServiceID  string `json:"service_id"`
+	ArtifactID string `json:"artifact_id"`
+}
+
 // JobData contains data required for running a job.
 type JobData struct {
-	MySQLBackup          *MySQLBackupJobData          `json:"mysql_backup,omitempty"`
-	MySQLRestoreBackup   *MySQLRestoreBackupJobData   `json:"mysql_restore_backup,omitempty"`
-	MongoDBBackup        *MongoDBBackupJobData        `json:"mongodb_backup,omitempty"`
-	MongoDBRestoreBackup *MongoDBRestoreBackupJobData `json:"mongodb_restore_backup,omitempty"`
+	MySQLBackup             *MySQLBackupJobData             `json:"mysql_backup,omitempty"`
+	MySQLRestoreBackup      *MySQLRestoreBackupJobData      `json:"mysql_restore_backup,omitempty"`
+	MongoDBBackup           *MongoDBBackupJobData           `json:"mongodb_backup,omitempty"`
+	MongoDBRestoreBackup    *MongoDBRestoreBackupJobData    `json:"mongodb_restore_backup,omitempty"`
+	PostgreSQLBackup        *PostgreSQLBackupJobData        `json:"postgresql_backup,omitempty"`
+	PostgreSQLRestoreBackup *PostgreSQLRestoreBackupJobData `json:"postgresql_restore_backup,omitempty"`
+	MySQLVerifyBackup       *MySQLVerifyBackupJobData       `json:"mysql_verify_backup,omitempty"`
+	MongoDBVerifyBackup     *MongoDBVerifyBackupJobData     `json:"mongodb_verify_backup,omitempty"`
+	MySQLBinlogStream       *MySQLBinlogStreamJobData       `json:"mysql_binlog_stream,omitempty"`
 }
 
 // Value implements database/sql/driver.Valuer interface. Should be defined on the value.
@@ -26,6 +26,10 @@ type HAParams struct {
 	Nodes      []string
 	RaftPort   int
 	GossipPort int
+	// ReplicateState enables replication of control-plane state through Raft.
+	ReplicateState bool
+	// GossipSecretKey encrypts and authenticates memberlist gossip; it is required for state replication.
+	GossipSecretKey []byte
 }
 
 // Params defines parameters for supervisor.
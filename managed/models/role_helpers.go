@@ -18,14 +18,32 @@ package models
 import (
 	"errors"
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gopkg.in/reform.v1"
 )
 
+// ValidatePermissions checks that all permission scopes are known.
+func ValidatePermissions(permissions []string) error {
+	for _, p := range permissions {
+		if !slices.Contains(Permissions, Permission(p)) {
+			return status.Errorf(codes.InvalidArgument, "Unknown permission %q.", p)
+		}
+	}
+
+	return nil
+}
+
 // CreateRole creates a new role.
 func CreateRole(q *reform.Querier, role *Role) error {
+	if err := ValidatePermissions(role.Permissions); err != nil {
+		return err
+	}
+
 	err := q.Insert(role)
 	if err != nil {
 		return err
@@ -245,3 +263,13 @@ func GetUserRoles(q *reform.Querier, userID int) ([]Role, error) {
 
 	return roles, nil
 }
+
+// GetUserRolesWithPermission retrieves roles assigned to a user that grant the given permission scope.
+func GetUserRolesWithPermission(q *reform.Querier, userID int, p Permission) ([]Role, error) {
+	roles, err := GetUserRoles(q, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(roles, func(r Role) bool { return !r.HasPermission(p) }), nil
+}
@@ -19,11 +19,14 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gopkg.in/reform.v1"
 	"gopkg.in/reform.v1/dialects/postgresql"
 
 	"github.com/percona/pmm/managed/models"
 	"github.com/percona/pmm/managed/utils/testdb"
+	"github.com/percona/pmm/managed/utils/tests"
 )
 
 const (
@@ -236,4 +239,49 @@ func TestRoleHelpers(t *testing.T) {
 		require.Equal(t, roles[0].ID, roleA.ID)
 		require.Equal(t, roles[1].ID, roleB.ID)
 	})
+
+	//nolint:paralleltest
+	t.Run("shall return roles with permission", func(t *testing.T) {
+		tx, teardown := setup(t)
+		defer teardown(t)
+
+		roleA := models.Role{
+			Title:       roleATitle,
+			Filter:      `{environment="prod"}`,
+			Permissions: []string{string(models.PermissionBackupsRestore)},
+		}
+		roleB := models.Role{
+			Title:       roleBTitle,
+			Permissions: []string{string(models.PermissionServicesAdd), string(models.PermissionBackupsRestore)},
+		}
+		require.NoError(t, models.CreateRole(tx.Querier, &roleA))
+		require.NoError(t, models.CreateRole(tx.Querier, &roleB))
+		require.NoError(t, models.AssignRoles(tx, userID, []int{int(roleA.ID), int(roleB.ID)}))
+
+		roles, err := models.GetUserRolesWithPermission(tx.Querier, userID, models.PermissionBackupsRestore)
+		require.NoError(t, err)
+		require.Len(t, roles, 2)
+
+		roles, err = models.GetUserRolesWithPermission(tx.Querier, userID, models.PermissionServicesAdd)
+		require.NoError(t, err)
+		require.Len(t, roles, 1)
+		require.Equal(t, roleB.ID, roles[0].ID)
+
+		roles, err = models.GetUserRolesWithPermission(tx.Querier, userID, models.PermissionDumpsCreate)
+		require.NoError(t, err)
+		require.Empty(t, roles)
+	})
+
+	//nolint:paralleltest
+	t.Run("shall not create role with unknown permission", func(t *testing.T) {
+		tx, teardown := setup(t)
+		defer teardown(t)
+
+		role := models.Role{
+			Title:       roleATitle,
+			Permissions: []string{"backups:destroy"},
+		}
+		err := models.CreateRole(tx.Querier, &role)
+		tests.AssertGRPCError(t, status.New(codes.InvalidArgument, `Unknown permission "backups:destroy".`), err)
+	})
 }
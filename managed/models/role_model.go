@@ -16,13 +16,40 @@
 package models
 
 import (
+	"slices"
 	"time"
 
+	"github.com/lib/pq"
 	"gopkg.in/reform.v1"
 )
 
 //go:generate go tool reform
 
+// Permission represents a permission scope granted by a role in addition to the Grafana role of the user.
+type Permission string
+
+// Permission scopes.
+const (
+	PermissionBackupsCreate  Permission = "backups:create"
+	PermissionBackupsRestore Permission = "backups:restore"
+	PermissionServicesAdd    Permission = "services:add"
+	PermissionServicesRemove Permission = "services:remove"
+	PermissionRTAStart       Permission = "rta:start"
+	PermissionRTAKill        Permission = "rta:kill"
+	PermissionDumpsCreate    Permission = "dumps:create"
+)
+
+// Permissions contains all known permission scopes.
+var Permissions = []Permission{
+	PermissionBackupsCreate,
+	PermissionBackupsRestore,
+	PermissionServicesAdd,
+	PermissionServicesRemove,
+	PermissionRTAStart,
+	PermissionRTAKill,
+	PermissionDumpsCreate,
+}
+
 // Role represents Role as stored in database.
 //
 //reform:roles
@@ -31,9 +58,11 @@ type Role struct {
 	Title       string `reform:"title"`
 	Description string `reform:"description"`
 	// Filter holds Prometheus filter to which can be applied to a query
-	Filter    string    `reform:"filter"`
-	CreatedAt time.Time `reform:"created_at"`
-	UpdatedAt time.Time `reform:"updated_at"`
+	Filter string `reform:"filter"`
+	// Permissions holds permission scopes granted by the role; they are limited to services matching Filter.
+	Permissions pq.StringArray `reform:"permissions"`
+	CreatedAt   time.Time      `reform:"created_at"`
+	UpdatedAt   time.Time      `reform:"updated_at"`
 }
 
 // BeforeInsert implements reform.BeforeInserter interface.
@@ -57,6 +86,11 @@ func (s *Role) AfterFind() error {
 	return nil
 }
 
+// HasPermission returns true if the role grants the given permission scope.
+func (s *Role) HasPermission(p Permission) bool {
+	return slices.Contains(s.Permissions, string(p))
+}
+
 // check interfaces.
 var (
 	_ reform.BeforeInserter = (*Role)(nil)
@@ -32,6 +32,7 @@ func (v *roleTableType) Columns() []string {
 		"title",
 		"description",
 		"filter",
+		"permissions",
 		"created_at",
 		"updated_at",
 	}
@@ -62,6 +63,7 @@ var RoleTable = &roleTableType{
 			{Name: "Title", Type: "string", Column: "title"},
 			{Name: "Description", Type: "string", Column: "description"},
 			{Name: "Filter", Type: "string", Column: "filter"},
+			{Name: "Permissions", Type: "pq.StringArray", Column: "permissions"},
 			{Name: "CreatedAt", Type: "time.Time", Column: "created_at"},
 			{Name: "UpdatedAt", Type: "time.Time", Column: "updated_at"},
 		},
@@ -72,13 +74,14 @@ var RoleTable = &roleTableType{
 
 // String returns a string representation of this struct or record.
 func (s Role) String() string {
-	res := make([]string, 6)
+	res := make([]string, 7)
 	res[0] = "ID: " + reform.Inspect(s.ID, true)
 	res[1] = "Title: " + reform.Inspect(s.Title, true)
 	res[2] = "Description: " + reform.Inspect(s.Description, true)
 	res[3] = "Filter: " + reform.Inspect(s.Filter, true)
-	res[4] = "CreatedAt: " + reform.Inspect(s.CreatedAt, true)
-	res[5] = "UpdatedAt: " + reform.Inspect(s.UpdatedAt, true)
+	res[4] = "Permissions: " + reform.Inspect(s.Permissions, true)
+	res[5] = "CreatedAt: " + reform.Inspect(s.CreatedAt, true)
+	res[6] = "UpdatedAt: " + reform.Inspect(s.UpdatedAt, true)
 	return strings.Join(res, ", ")
 }
 
@@ -90,6 +93,7 @@ func (s *Role) Values() []interface{} {
 		s.Title,
 		s.Description,
 		s.Filter,
+		s.Permissions,
 		s.CreatedAt,
 		s.UpdatedAt,
 	}
@@ -103,6 +107,7 @@ func (s *Role) Pointers() []interface{} {
 		&s.Title,
 		&s.Description,
 		&s.Filter,
+		&s.Permissions,
 		&s.CreatedAt,
 		&s.UpdatedAt,
 	}
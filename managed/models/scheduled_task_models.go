@@ -29,8 +29,10 @@ type ScheduledTaskType string
 
 // Supported scheduled task types.
 const (
-	ScheduledMySQLBackupTask   = ScheduledTaskType("mysql_backup")
-	ScheduledMongoDBBackupTask = ScheduledTaskType("mongodb_backup")
+	ScheduledMySQLBackupTask      = ScheduledTaskType("mysql_backup")
+	ScheduledMongoDBBackupTask    = ScheduledTaskType("mongodb_backup")
+	ScheduledPostgreSQLBackupTask = ScheduledTaskType("postgresql_backup")
+	ScheduledVerificationTask     = ScheduledTaskType("artifact_verification")
 )
 
 // ScheduledTask describes a scheduled task.
@@ -53,8 +55,10 @@ type ScheduledTask struct {
 
 // ScheduledTaskData contains result data for different task types.
 type ScheduledTaskData struct {
-	MySQLBackupTask   *MySQLBackupTaskData `json:"mysql_backup,omitempty"`
-	MongoDBBackupTask *MongoBackupTaskData `json:"mongodb_backup,omitempty"`
+	MySQLBackupTask      *MySQLBackupTaskData      `json:"mysql_backup,omitempty"`
+	MongoDBBackupTask    *MongoBackupTaskData      `json:"mongodb_backup,omitempty"`
+	PostgreSQLBackupTask *PostgreSQLBackupTaskData `json:"postgresql_backup,omitempty"`
+	VerificationTask     *VerificationTaskData     `json:"artifact_verification,omitempty"`
 }
 
 // CommonBackupTaskData contains common data for all backup tasks.
@@ -82,6 +86,20 @@ type MongoBackupTaskData struct {
 	CommonBackupTaskData
 }
 
+// PostgreSQLBackupTaskData contains data for postgresql backup task.
+type PostgreSQLBackupTaskData struct {
+	CommonBackupTaskData
+}
+
+// VerificationTaskData contains data for artifact verification task.
+// The task verifies the latest successful artifact of the service stored in the location.
+type VerificationTaskData struct {
+	ServiceID   string `json:"service_id"`
+	LocationID  string `json:"location_id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
 // Value implements database/sql/driver.Valuer interface. Should be defined on the value.
 func (c ScheduledTaskData) Value() (driver.Value, error) { return jsonValue(c) }
 
@@ -158,6 +158,8 @@ func (p CreateScheduledTaskParams) Validate() error {
 	switch p.Type {
 	case ScheduledMySQLBackupTask:
 	case ScheduledMongoDBBackupTask:
+	case ScheduledPostgreSQLBackupTask:
+	case ScheduledVerificationTask:
 	default:
 		return status.Errorf(codes.InvalidArgument, "Unknown type: %s", p.Type)
 	}
@@ -349,6 +351,14 @@ func nameFromTaskData(taskType ScheduledTaskType, taskData *ScheduledTaskData) (
 			if taskData.MongoDBBackupTask != nil {
 				return taskData.MongoDBBackupTask.Name, nil
 			}
+		case ScheduledPostgreSQLBackupTask:
+			if taskData.PostgreSQLBackupTask != nil {
+				return taskData.PostgreSQLBackupTask.Name, nil
+			}
+		case ScheduledVerificationTask:
+			if taskData.VerificationTask != nil {
+				return taskData.VerificationTask.Name, nil
+			}
 		default:
 			return "", status.Errorf(codes.InvalidArgument, "Unknown type: %s", taskType)
 		}
@@ -404,6 +414,10 @@ func (s *ScheduledTask) CommonBackupData() (*CommonBackupTaskData, error) {
 			if s.Data.MongoDBBackupTask != nil {
 				return &s.Data.MongoDBBackupTask.CommonBackupTaskData, nil
 			}
+		case ScheduledPostgreSQLBackupTask:
+			if s.Data.PostgreSQLBackupTask != nil {
+				return &s.Data.PostgreSQLBackupTask.CommonBackupTaskData, nil
+			}
 		default:
 			return nil, fmt.Errorf("invalid backup type %s of scheduled task %s", s.Type, s.ID)
 		}
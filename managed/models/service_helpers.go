@@ -500,9 +500,7 @@ func ChangeStandardLabels(q *reform.Querier, serviceID string, labels ServiceSta
 
 func initSoftwareVersions(q *reform.Querier, serviceID string, serviceType ServiceType) error {
 	switch serviceType {
-	case MySQLServiceType:
-		fallthrough
-	case MongoDBServiceType:
+	case MySQLServiceType, MongoDBServiceType, PostgreSQLServiceType:
 		_, err := CreateServiceSoftwareVersions(q, CreateServiceSoftwareVersionsParams{
 			ServiceID:        serviceID,
 			ServiceType:      serviceType,
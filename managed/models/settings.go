@@ -116,6 +116,12 @@ type Settings struct {
 		Enabled *bool `json:"enabled"`
 	} `json:"access_control"`
 
+	// AuditLog holds audit log settings.
+	AuditLog struct {
+		// Retention is the period after which audit events are removed.
+		Retention time.Duration `json:"retention"`
+	} `json:"audit_log"`
+
 	// Contains all encrypted tables in format 'db.table.column'.
 	EncryptedItems []string `json:"encrypted_items"`
 }
@@ -216,6 +222,10 @@ func (s *Settings) fillDefaults() {
 		s.DataRetention = 30 * 24 * time.Hour //nolint:mnd
 	}
 
+	if s.AuditLog.Retention == 0 {
+		s.AuditLog.Retention = 90 * 24 * time.Hour //nolint:mnd
+	}
+
 	if len(s.AWSPartitions) == 0 {
 		s.AWSPartitions = []string{awsPartitionID}
 	}
@@ -60,6 +60,8 @@ type ChangeSettingsParams struct {
 
 	DataRetention time.Duration
 
+	AuditLogRetention time.Duration
+
 	// List of AWS partitions to use. If empty - default partitions will be used. If nil - no changes will be made.
 	AWSPartitions []string
 
@@ -165,6 +167,9 @@ func UpdateSettings(q reform.DBTX, params *ChangeSettingsParams) (*Settings, err
 	if params.DataRetention != 0 {
 		settings.DataRetention = params.DataRetention
 	}
+	if params.AuditLogRetention != 0 {
+		settings.AuditLog.Retention = params.AuditLogRetention
+	}
 
 	if params.AWSPartitions != nil {
 		settings.AWSPartitions = deduplicateStrings(params.AWSPartitions)
@@ -319,6 +324,20 @@ func ValidateSettings(params *ChangeSettingsParams) error {
 		}
 	}
 
+	if params.AuditLogRetention != 0 {
+		_, err := validators.ValidateDataRetention(params.AuditLogRetention)
+		if err != nil {
+			switch err.(type) { //nolint:errorlint
+			case validators.DurationNotAllowedError:
+				return errors.New("audit_log_retention: should be a natural number of days")
+			case validators.MinDurationError:
+				return errors.New("audit_log_retention: minimal resolution is 24h")
+			default:
+				return fmt.Errorf("audit_log_retention: unknown error: %w", err)
+			}
+		}
+	}
+
 	err := validators.ValidateAWSPartitions(params.AWSPartitions)
 	if err != nil {
 		return err
@@ -55,6 +55,7 @@ func TestSettings(t *testing.T) {
 			DefaultRoleID:  1,
 			EncryptedItems: actual.EncryptedItems,
 		}
+		expected.AuditLog.Retention = 90 * 24 * time.Hour
 		assert.Equal(t, expected, actual)
 	})
 
@@ -78,6 +79,7 @@ func TestSettings(t *testing.T) {
 				},
 			},
 		}
+		expected.AuditLog.Retention = 90 * 24 * time.Hour
 		assert.Equal(t, expected, s)
 	})
 
@@ -146,6 +148,18 @@ func TestSettings(t *testing.T) {
 			})
 			assert.True(t, errors.As(err, &errInvalidArgument))
 			require.EqualError(t, err, `invalid argument: data_retention: minimal resolution is 24h`)
+
+			_, err = models.UpdateSettings(sqlDB, &models.ChangeSettingsParams{
+				AuditLogRetention: 90000 * time.Second, // 25h
+			})
+			assert.True(t, errors.As(err, &errInvalidArgument))
+			require.EqualError(t, err, `invalid argument: audit_log_retention: should be a natural number of days`)
+
+			_, err = models.UpdateSettings(sqlDB, &models.ChangeSettingsParams{
+				AuditLogRetention: 43200 * time.Second, // 12h
+			})
+			assert.True(t, errors.As(err, &errInvalidArgument))
+			require.EqualError(t, err, `invalid argument: audit_log_retention: minimal resolution is 24h`)
 		})
 
 		t.Run("Updates validation", func(t *testing.T) {
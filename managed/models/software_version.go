@@ -34,7 +34,10 @@ func (sn SoftwareName) Validate() error {
 	case MysqldSoftwareName,
 		XtrabackupSoftwareName,
 		XbcloudSoftwareName,
-		QpressSoftwareName:
+		QpressSoftwareName,
+		PGBasebackupSoftwareName,
+		PGDumpSoftwareName,
+		PGRestoreSoftwareName:
 	default:
 		return fmt.Errorf("invalid software name %q", sn)
 	}
@@ -50,6 +53,12 @@ const (
 	QpressSoftwareName     SoftwareName = "qpress"
 	MongoDBSoftwareName    SoftwareName = "mongodb"
 	PBMSoftwareName        SoftwareName = "pbm"
+
+	PGBasebackupSoftwareName SoftwareName = "pg_basebackup"
+	PGDumpSoftwareName       SoftwareName = "pg_dump"
+	PGRestoreSoftwareName    SoftwareName = "pg_restore"
+	// PostgreSQLSoftwareName is not reported by pmm-agent, PostgreSQL server version is taken from the service.
+	PostgreSQLSoftwareName SoftwareName = "postgresql"
 )
 
 // SoftwareVersion represents version of the given software.
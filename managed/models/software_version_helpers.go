@@ -66,6 +66,9 @@ func (p *CreateServiceSoftwareVersionsParams) Validate() error {
 		case QpressSoftwareName:
 		case MongoDBSoftwareName:
 		case PBMSoftwareName:
+		case PGBasebackupSoftwareName:
+		case PGDumpSoftwareName:
+		case PGRestoreSoftwareName:
 		default:
 			return NewInvalidArgumentError("invalid software name %q", sv.Name)
 		}
@@ -116,6 +119,9 @@ func (u *UpdateServiceSoftwareVersionsParams) Validate() error {
 		case QpressSoftwareName:
 		case MongoDBSoftwareName:
 		case PBMSoftwareName:
+		case PGBasebackupSoftwareName:
+		case PGDumpSoftwareName:
+		case PGRestoreSoftwareName:
 		default:
 			return NewInvalidArgumentError("invalid software name %q", sv.Name)
 		}
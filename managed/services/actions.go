@@ -0,0 +1,138 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm/managed/models"
+)
+
+const (
+	// ActionResultAwaitTimeout should be greater than agents.defaultActionTimeout.
+	ActionResultAwaitTimeout  = 20 * time.Second
+	actionResultCheckInterval = time.Second
+)
+
+// WaitForActionResult periodically checks the action result state and returns its output when the action is done.
+// Results are stored in the database, so it works on any PMM Server instance, not only on the one
+// that pmm-agent is connected to.
+func WaitForActionResult(ctx context.Context, q *reform.Querier, resultID string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, ActionResultAwaitTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(actionResultCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+
+		res, err := models.FindActionResultByID(q, resultID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !res.Done {
+			continue
+		}
+
+		if res.Error != "" {
+			return nil, fmt.Errorf("action %s failed: %s", resultID, res.Error)
+		}
+
+		return []byte(res.Output), nil
+	}
+}
+
+// RunAction prepares the action result, starts the action with it, waits for the result and returns its output.
+// The result is removed afterwards.
+func RunAction(ctx context.Context, db *reform.DB, l *logrus.Entry, pmmAgentID string, start func(resultID string) error) ([]byte, error) {
+	r, err := models.CreateActionResult(db.Querier, pmmAgentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare result: %w", err)
+	}
+	defer func() {
+		if err := db.Delete(r); err != nil {
+			l.Warnf("Failed to delete action result %s: %s.", r.ID, err)
+		}
+	}()
+
+	if err = start(r.ID); err != nil {
+		return nil, err
+	}
+
+	return WaitForActionResult(ctx, db.Querier, r.ID)
+}
+
+// FindActionTarget returns the service with pmm-agent and DSN to run actions against.
+// databases maps supported service types to the database of the DSN; empty database means the default one.
+// It returns nil for PMM own services and services of types missing in databases.
+func FindActionTarget(db *reform.DB, serviceID string, databases map[models.ServiceType]string) (*Target, error) {
+	var target *Target
+	err := db.InTransaction(func(tx *reform.TX) error {
+		service, err := models.FindServiceByID(tx.Querier, serviceID)
+		if err != nil {
+			return err
+		}
+
+		if service.NodeID == models.PMMServerNodeID {
+			return nil
+		}
+		database, ok := databases[service.ServiceType]
+		if !ok {
+			return nil
+		}
+
+		pmmAgents, err := models.FindPMMAgentsForService(tx.Querier, serviceID)
+		if err != nil {
+			return err
+		}
+		if len(pmmAgents) == 0 {
+			return errors.New("no available pmm agents")
+		}
+
+		dsn, agent, err := models.FindDSNByServiceIDandPMMAgentID(tx.Querier, serviceID, pmmAgents[0].AgentID, database)
+		if err != nil {
+			return err
+		}
+
+		target = &Target{
+			AgentID:       pmmAgents[0].AgentID,
+			ServiceID:     service.ServiceID,
+			ServiceName:   service.ServiceName,
+			ServiceType:   service.ServiceType,
+			DSN:           dsn,
+			Files:         agent.Files(),
+			TDP:           agent.TemplateDelimiters(service),
+			Secrets:       agent.Secrets(),
+			TLSSkipVerify: agent.TLSSkipVerify,
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return target, nil
+}
@@ -22,6 +22,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	agentv1 "github.com/percona/pmm/api/agent/v1"
 	"github.com/percona/pmm/managed/models"
@@ -48,11 +49,7 @@ func NewActionsService(qanClient qanClient, r *Registry) *ActionsService {
 }
 
 func (s *ActionsService) sendActionRequest(ctx context.Context, pmmAgentID string, req agentv1.ServerRequestPayload) error {
-	agent, err := s.r.get(pmmAgentID)
-	if err != nil {
-		return err
-	}
-	_, err = agent.channel.SendAndWaitResponse(ctx, req)
+	_, err := s.r.sendAndWaitResponse(ctx, pmmAgentID, req)
 	return err
 }
 
@@ -69,6 +66,7 @@ func (s *ActionsService) StartMySQLExplainAction(
 	files map[string]string,
 	tdp *models.DelimiterPair,
 	tlsSkipVerify bool,
+	secrets map[string]string,
 ) error {
 	if queryID == "" {
 		return status.Error(codes.FailedPrecondition, "query or query_id is required")
@@ -109,6 +107,7 @@ func (s *ActionsService) StartMySQLExplainAction(
 			},
 		},
 		Timeout: defaultActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
@@ -120,6 +119,7 @@ func (s *ActionsService) StartMySQLShowCreateTableAction(
 	id, pmmAgentID, dsn, table string,
 	files map[string]string,
 	tdp *models.DelimiterPair, tlsSkipVerify bool,
+	secrets map[string]string,
 ) error {
 	aRequest := &agentv1.StartActionRequest{
 		ActionId: id,
@@ -136,6 +136,7 @@ func (s *ActionsService) StartMySQLShowCreateTableAction(
 			},
 		},
 		Timeout: defaultActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
@@ -146,6 +147,7 @@ func (s *ActionsService) StartMySQLShowTableStatusAction(
 	ctx context.Context, id, pmmAgentID, dsn, table string,
 	files map[string]string,
 	tdp *models.DelimiterPair, tlsSkipVerify bool,
+	secrets map[string]string,
 ) error {
 	aRequest := &agentv1.StartActionRequest{
 		ActionId: id,
@@ -162,6 +164,7 @@ func (s *ActionsService) StartMySQLShowTableStatusAction(
 			},
 		},
 		Timeout: defaultActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
@@ -172,6 +175,7 @@ func (s *ActionsService) StartMySQLShowIndexAction(
 	ctx context.Context, id, pmmAgentID, dsn, table string,
 	files map[string]string,
 	tdp *models.DelimiterPair, tlsSkipVerify bool,
+	secrets map[string]string,
 ) error {
 	aRequest := &agentv1.StartActionRequest{
 		ActionId: id,
@@ -188,45 +192,73 @@ func (s *ActionsService) StartMySQLShowIndexAction(
 			},
 		},
 		Timeout: defaultActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
 }
 
 // StartPostgreSQLShowCreateTableAction starts postgresql-show-create-table action on pmm-agent.
-func (s *ActionsService) StartPostgreSQLShowCreateTableAction(ctx context.Context, id, pmmAgentID, dsn, table string) error {
+func (s *ActionsService) StartPostgreSQLShowCreateTableAction(
+	ctx context.Context, id, pmmAgentID, dsn, table string,
+	files map[string]string,
+	tdp *models.DelimiterPair,
+	secrets map[string]string,
+) error {
 	aRequest := &agentv1.StartActionRequest{
 		ActionId: id,
 		Params: &agentv1.StartActionRequest_PostgresqlShowCreateTableParams{
 			PostgresqlShowCreateTableParams: &agentv1.StartActionRequest_PostgreSQLShowCreateTableParams{
 				Dsn:   dsn,
 				Table: table,
+				TlsFiles: &agentv1.TextFiles{
+					Files:              files,
+					TemplateLeftDelim:  tdp.Left,
+					TemplateRightDelim: tdp.Right,
+				},
 			},
 		},
 		Timeout: defaultActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
 }
 
 // StartPostgreSQLShowIndexAction starts postgresql-show-index action on pmm-agent.
-func (s *ActionsService) StartPostgreSQLShowIndexAction(ctx context.Context, id, pmmAgentID, dsn, table string) error {
+func (s *ActionsService) StartPostgreSQLShowIndexAction(
+	ctx context.Context, id, pmmAgentID, dsn, table string,
+	files map[string]string,
+	tdp *models.DelimiterPair,
+	secrets map[string]string,
+) error {
 	aRequest := &agentv1.StartActionRequest{
 		ActionId: id,
 		Params: &agentv1.StartActionRequest_PostgresqlShowIndexParams{
 			PostgresqlShowIndexParams: &agentv1.StartActionRequest_PostgreSQLShowIndexParams{
 				Dsn:   dsn,
 				Table: table,
+				TlsFiles: &agentv1.TextFiles{
+					Files:              files,
+					TemplateLeftDelim:  tdp.Left,
+					TemplateRightDelim: tdp.Right,
+				},
 			},
 		},
 		Timeout: defaultActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
 }
 
 // StartMongoDBExplainAction starts MongoDB query explain action on pmm-agent.
-func (s *ActionsService) StartMongoDBExplainAction(ctx context.Context, id, pmmAgentID, dsn, query string, files map[string]string, tdp *models.DelimiterPair) error {
+func (s *ActionsService) StartMongoDBExplainAction(
+	ctx context.Context, id, pmmAgentID, dsn, query string,
+	files map[string]string,
+	tdp *models.DelimiterPair,
+	secrets map[string]string,
+) error {
 	aRequest := &agentv1.StartActionRequest{
 		ActionId: id,
 		Params: &agentv1.StartActionRequest_MongodbExplainParams{
@@ -241,6 +273,7 @@ func (s *ActionsService) StartMongoDBExplainAction(ctx context.Context, id, pmmA
 			},
 		},
 		Timeout: defaultActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
@@ -251,6 +284,7 @@ func (s *ActionsService) StartMySQLQueryShowAction(
 	ctx context.Context, id, pmmAgentID, dsn, query string,
 	files map[string]string,
 	tdp *models.DelimiterPair, tlsSkipVerify bool,
+	secrets map[string]string,
 ) error {
 	aRequest := &agentv1.StartActionRequest{
 		ActionId: id,
@@ -267,6 +301,7 @@ func (s *ActionsService) StartMySQLQueryShowAction(
 			},
 		},
 		Timeout: defaultQueryActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
@@ -277,6 +312,7 @@ func (s *ActionsService) StartMySQLQuerySelectAction(
 	ctx context.Context, id, pmmAgentID, dsn, query string,
 	files map[string]string,
 	tdp *models.DelimiterPair, tlsSkipVerify bool,
+	secrets map[string]string,
 ) error {
 	aRequest := &agentv1.StartActionRequest{
 		ActionId: id,
@@ -293,44 +329,72 @@ func (s *ActionsService) StartMySQLQuerySelectAction(
 			},
 		},
 		Timeout: defaultQueryActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
 }
 
 // StartPostgreSQLQueryShowAction starts PostgreSQL SHOW query action on pmm-agent.
-func (s *ActionsService) StartPostgreSQLQueryShowAction(ctx context.Context, id, pmmAgentID, dsn string) error {
+func (s *ActionsService) StartPostgreSQLQueryShowAction(
+	ctx context.Context, id, pmmAgentID, dsn string,
+	files map[string]string,
+	tdp *models.DelimiterPair,
+	secrets map[string]string,
+) error {
 	aRequest := &agentv1.StartActionRequest{
 		ActionId: id,
 		Params: &agentv1.StartActionRequest_PostgresqlQueryShowParams{
 			PostgresqlQueryShowParams: &agentv1.StartActionRequest_PostgreSQLQueryShowParams{
 				Dsn: dsn,
+				TlsFiles: &agentv1.TextFiles{
+					Files:              files,
+					TemplateLeftDelim:  tdp.Left,
+					TemplateRightDelim: tdp.Right,
+				},
 			},
 		},
 		Timeout: defaultQueryActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
 }
 
 // StartPostgreSQLQuerySelectAction starts PostgreSQL SELECT query action on pmm-agent.
-func (s *ActionsService) StartPostgreSQLQuerySelectAction(ctx context.Context, id, pmmAgentID, dsn, query string) error {
+func (s *ActionsService) StartPostgreSQLQuerySelectAction(
+	ctx context.Context, id, pmmAgentID, dsn, query string,
+	files map[string]string,
+	tdp *models.DelimiterPair,
+	secrets map[string]string,
+) error {
 	aRequest := &agentv1.StartActionRequest{
 		ActionId: id,
 		Params: &agentv1.StartActionRequest_PostgresqlQuerySelectParams{
 			PostgresqlQuerySelectParams: &agentv1.StartActionRequest_PostgreSQLQuerySelectParams{
 				Dsn:   dsn,
 				Query: query,
+				TlsFiles: &agentv1.TextFiles{
+					Files:              files,
+					TemplateLeftDelim:  tdp.Left,
+					TemplateRightDelim: tdp.Right,
+				},
 			},
 		},
 		Timeout: defaultQueryActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
 }
 
 // StartMongoDBQueryGetParameterAction starts MongoDB getParameter query action on pmm-agent.
-func (s *ActionsService) StartMongoDBQueryGetParameterAction(ctx context.Context, id, pmmAgentID, dsn string, files map[string]string, tdp *models.DelimiterPair) error {
+func (s *ActionsService) StartMongoDBQueryGetParameterAction(
+	ctx context.Context, id, pmmAgentID, dsn string,
+	files map[string]string,
+	tdp *models.DelimiterPair,
+	secrets map[string]string,
+) error {
 	aRequest := &agentv1.StartActionRequest{
 		ActionId: id,
 		Params: &agentv1.StartActionRequest_MongodbQueryGetparameterParams{
@@ -344,13 +408,19 @@ func (s *ActionsService) StartMongoDBQueryGetParameterAction(ctx context.Context
 			},
 		},
 		Timeout: defaultQueryActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
 }
 
 // StartMongoDBQueryBuildInfoAction starts MongoDB buildInfo query action on pmm-agent.
-func (s *ActionsService) StartMongoDBQueryBuildInfoAction(ctx context.Context, id, pmmAgentID, dsn string, files map[string]string, tdp *models.DelimiterPair) error {
+func (s *ActionsService) StartMongoDBQueryBuildInfoAction(
+	ctx context.Context, id, pmmAgentID, dsn string,
+	files map[string]string,
+	tdp *models.DelimiterPair,
+	secrets map[string]string,
+) error {
 	aRequest := &agentv1.StartActionRequest{
 		ActionId: id,
 		Params: &agentv1.StartActionRequest_MongodbQueryBuildinfoParams{
@@ -364,6 +434,7 @@ func (s *ActionsService) StartMongoDBQueryBuildInfoAction(ctx context.Context, i
 			},
 		},
 		Timeout: defaultQueryActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
@@ -374,6 +445,7 @@ func (s *ActionsService) StartMongoDBQueryGetCmdLineOptsAction(
 	ctx context.Context, id, pmmAgentID, dsn string,
 	files map[string]string,
 	tdp *models.DelimiterPair,
+	secrets map[string]string,
 ) error {
 	aRequest := &agentv1.StartActionRequest{
 		ActionId: id,
@@ -388,6 +460,7 @@ func (s *ActionsService) StartMongoDBQueryGetCmdLineOptsAction(
 			},
 		},
 		Timeout: defaultQueryActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
@@ -398,6 +471,7 @@ func (s *ActionsService) StartMongoDBQueryReplSetGetStatusAction(
 	ctx context.Context, id, pmmAgentID, dsn string,
 	files map[string]string,
 	tdp *models.DelimiterPair,
+	secrets map[string]string,
 ) error {
 	aRequest := &agentv1.StartActionRequest{
 		ActionId: id,
@@ -412,6 +486,7 @@ func (s *ActionsService) StartMongoDBQueryReplSetGetStatusAction(
 			},
 		},
 		Timeout: defaultQueryActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
@@ -422,6 +497,7 @@ func (s *ActionsService) StartMongoDBQueryGetDiagnosticDataAction(
 	ctx context.Context, id, pmmAgentID, dsn string,
 	files map[string]string,
 	tdp *models.DelimiterPair,
+	secrets map[string]string,
 ) error {
 	aRequest := &agentv1.StartActionRequest{
 		ActionId: id,
@@ -436,6 +512,109 @@ func (s *ActionsService) StartMongoDBQueryGetDiagnosticDataAction(
 			},
 		},
 		Timeout: defaultQueryActionTimeout,
+		Secrets: secrets,
+	}
+
+	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
+}
+
+// StartMongoDBKillOpAction starts MongoDB killOp action on pmm-agent.
+func (s *ActionsService) StartMongoDBKillOpAction(
+	ctx context.Context,
+	id, pmmAgentID, dsn string,
+	opID int64,
+	files map[string]string,
+	tdp *models.DelimiterPair,
+	secrets map[string]string,
+) error {
+	aRequest := &agentv1.StartActionRequest{
+		ActionId: id,
+		Params: &agentv1.StartActionRequest_MongodbKillOpParams{
+			MongodbKillOpParams: &agentv1.StartActionRequest_MongoDBKillOpParams{
+				Dsn:  dsn,
+				OpId: opID,
+				TextFiles: &agentv1.TextFiles{
+					Files:              files,
+					TemplateLeftDelim:  tdp.Left,
+					TemplateRightDelim: tdp.Right,
+				},
+			},
+		},
+		Timeout: defaultActionTimeout,
+		Secrets: secrets,
+	}
+
+	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
+}
+
+// StartMySQLKillQueryAction starts MySQL KILL QUERY action on pmm-agent.
+// The statement is identified by Performance Schema thread and event IDs if eventID is not zero,
+// by its start time otherwise; pmm-agent kills it only if the connection is still executing it.
+func (s *ActionsService) StartMySQLKillQueryAction(
+	ctx context.Context,
+	id, pmmAgentID, dsn string,
+	connectionID, threadID, eventID uint64,
+	queryStart time.Time,
+	files map[string]string,
+	tdp *models.DelimiterPair,
+	tlsSkipVerify bool,
+	secrets map[string]string,
+) error {
+	params := &agentv1.StartActionRequest_MySQLKillQueryParams{
+		Dsn:          dsn,
+		ConnectionId: connectionID,
+		ThreadId:     threadID,
+		EventId:      eventID,
+		TlsFiles: &agentv1.TextFiles{
+			Files:              files,
+			TemplateLeftDelim:  tdp.Left,
+			TemplateRightDelim: tdp.Right,
+		},
+		TlsSkipVerify: tlsSkipVerify,
+	}
+	if !queryStart.IsZero() {
+		params.QueryStart = timestamppb.New(queryStart)
+	}
+
+	aRequest := &agentv1.StartActionRequest{
+		ActionId: id,
+		Params: &agentv1.StartActionRequest_MysqlKillQueryParams{
+			MysqlKillQueryParams: params,
+		},
+		Timeout: defaultActionTimeout,
+		Secrets: secrets,
+	}
+
+	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
+}
+
+// StartPostgreSQLCancelBackendAction starts PostgreSQL pg_cancel_backend action on pmm-agent.
+// pmm-agent cancels the query only if the backend is still executing the query started at queryStart.
+func (s *ActionsService) StartPostgreSQLCancelBackendAction(
+	ctx context.Context,
+	id, pmmAgentID, dsn string,
+	pid int32,
+	queryStart time.Time,
+	files map[string]string,
+	tdp *models.DelimiterPair,
+	secrets map[string]string,
+) error {
+	aRequest := &agentv1.StartActionRequest{
+		ActionId: id,
+		Params: &agentv1.StartActionRequest_PostgresqlCancelBackendParams{
+			PostgresqlCancelBackendParams: &agentv1.StartActionRequest_PostgreSQLCancelBackendParams{
+				Dsn:        dsn,
+				Pid:        pid,
+				QueryStart: timestamppb.New(queryStart),
+				TlsFiles: &agentv1.TextFiles{
+					Files:              files,
+					TemplateLeftDelim:  tdp.Left,
+					TemplateRightDelim: tdp.Right,
+				},
+			},
+		},
+		Timeout: defaultActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, aRequest)
@@ -456,7 +635,10 @@ func (s *ActionsService) StartPTSummaryAction(ctx context.Context, id, pmmAgentI
 }
 
 // StartPTPgSummaryAction starts pt-pg-summary action on the pmm-agent.
-func (s *ActionsService) StartPTPgSummaryAction(ctx context.Context, id, pmmAgentID, address string, port uint16, username, password string) error {
+func (s *ActionsService) StartPTPgSummaryAction(
+	ctx context.Context, id, pmmAgentID, address string, port uint16, username, password string,
+	secrets map[string]string,
+) error {
 	actionRequest := &agentv1.StartActionRequest{
 		ActionId: id,
 		Params: &agentv1.StartActionRequest_PtPgSummaryParams{
@@ -468,13 +650,17 @@ func (s *ActionsService) StartPTPgSummaryAction(ctx context.Context, id, pmmAgen
 			},
 		},
 		Timeout: defaultPtActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, actionRequest)
 }
 
 // StartPTMongoDBSummaryAction starts pt-mongodb-summary action on the pmm-agent.
-func (s *ActionsService) StartPTMongoDBSummaryAction(ctx context.Context, id, pmmAgentID, address string, port uint16, username, password string) error {
+func (s *ActionsService) StartPTMongoDBSummaryAction(
+	ctx context.Context, id, pmmAgentID, address string, port uint16, username, password string,
+	secrets map[string]string,
+) error {
 	actionRequest := &agentv1.StartActionRequest{
 		ActionId: id,
 		// Proper params that'll will be passed to the command on the agent's side, even empty, othervise request's marshal fail.
@@ -487,6 +673,7 @@ func (s *ActionsService) StartPTMongoDBSummaryAction(ctx context.Context, id, pm
 			},
 		},
 		Timeout: defaultPtActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, actionRequest)
@@ -494,7 +681,10 @@ func (s *ActionsService) StartPTMongoDBSummaryAction(ctx context.Context, id, pm
 
 // StartPTMySQLSummaryAction starts pt-mysql-summary action on the pmm-agent.
 // The pt-mysql-summary's execution may require some of the following params: host, port, socket, username, password.
-func (s *ActionsService) StartPTMySQLSummaryAction(ctx context.Context, id, pmmAgentID, address string, port uint16, socket, username, password string) error {
+func (s *ActionsService) StartPTMySQLSummaryAction(
+	ctx context.Context, id, pmmAgentID, address string, port uint16, socket, username, password string,
+	secrets map[string]string,
+) error {
 	actionRequest := &agentv1.StartActionRequest{
 		ActionId: id,
 		// Proper params that'll will be passed to the command on the agent's side.
@@ -508,6 +698,7 @@ func (s *ActionsService) StartPTMySQLSummaryAction(ctx context.Context, id, pmmA
 			},
 		},
 		Timeout: defaultPtActionTimeout,
+		Secrets: secrets,
 	}
 
 	return s.sendActionRequest(ctx, pmmAgentID, actionRequest)
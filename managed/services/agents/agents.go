@@ -40,12 +40,7 @@ func NewAgentService(r *Registry) *AgentService {
 
 // Logs by Agent ID.
 func (a *AgentService) Logs(ctx context.Context, pmmAgentID, agentID string, limit uint32) ([]string, uint32, error) {
-	agent, err := a.r.get(pmmAgentID)
-	if err != nil {
-		return nil, 0, err
-	}
-
-	resp, err := agent.channel.SendAndWaitResponse(ctx, &agentv1.AgentLogsRequest{
+	resp, err := a.r.sendAndWaitResponse(ctx, pmmAgentID, &agentv1.AgentLogsRequest{
 		AgentId: agentID,
 		Limit:   limit,
 	})
@@ -62,12 +57,13 @@ func (a *AgentService) Logs(ctx context.Context, pmmAgentID, agentID string, lim
 }
 
 // PBMSwitchPITR switches Point-in-Time Recovery feature for pbm on the pmm-agent.
-func (a *AgentService) PBMSwitchPITR(pmmAgentID, dsn string, files map[string]string, tdp *models.DelimiterPair, enabled bool) error {
-	agent, err := a.r.get(pmmAgentID)
-	if err != nil {
-		return err
-	}
-
+func (a *AgentService) PBMSwitchPITR(
+	pmmAgentID, dsn string,
+	files map[string]string,
+	tdp *models.DelimiterPair,
+	secrets map[string]string,
+	enabled bool,
+) error {
 	req := &agentv1.PBMSwitchPITRRequest{
 		Dsn: dsn,
 		TextFiles: &agentv1.TextFiles{
@@ -75,10 +71,11 @@ func (a *AgentService) PBMSwitchPITR(pmmAgentID, dsn string, files map[string]st
 			TemplateLeftDelim:  tdp.Left,
 			TemplateRightDelim: tdp.Right,
 		},
+		Secrets: secrets,
 		Enabled: enabled,
 	}
 
-	_, err = agent.channel.SendAndWaitResponse(context.TODO(), req)
+	_, err := a.r.sendAndWaitResponse(context.TODO(), pmmAgentID, req)
 	return err
 }
 
@@ -289,6 +289,11 @@ func (c *Channel) runReceiver() {
 				ID:      msg.Id,
 				Payload: p.ActionResult,
 			}
+		case *agentv1.AgentMessage_RenewCertificate:
+			c.requests <- &AgentRequest{
+				ID:      msg.Id,
+				Payload: p.RenewCertificate,
+			}
 
 		// simple messages
 		case *agentv1.AgentMessage_JobResult:
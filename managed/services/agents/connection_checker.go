@@ -79,11 +79,6 @@ func (c *ConnectionChecker) CheckConnectionToService(ctx context.Context, q *ref
 		}
 	}
 
-	pmmAgent, err := c.r.get(pmmAgentID)
-	if err != nil {
-		return err
-	}
-
 	request, err := connectionRequest(q, service, agent)
 	if err != nil {
 		return err
@@ -94,7 +89,7 @@ func (c *ConnectionChecker) CheckConnectionToService(ctx context.Context, q *ref
 		request.Type, logger.MaskDSN(request.Dsn), request.Timeout,
 	)
 
-	resp, err := pmmAgent.channel.SendAndWaitResponse(ctx, request)
+	resp, err := c.r.sendAndWaitResponse(ctx, pmmAgentID, request)
 	if err != nil {
 		return err
 	}
@@ -153,6 +148,7 @@ func connectionRequest(q *reform.Querier, service *models.Service, agent *models
 				TemplateLeftDelim:  tdp.Left,
 				TemplateRightDelim: tdp.Right,
 			},
+			Secrets:       agent.Secrets(),
 			TlsSkipVerify: agent.TLSSkipVerify,
 		}
 	case models.PostgreSQLServiceType:
@@ -171,6 +167,7 @@ func connectionRequest(q *reform.Querier, service *models.Service, agent *models
 				TemplateLeftDelim:  tdp.Left,
 				TemplateRightDelim: tdp.Right,
 			},
+			Secrets: agent.Secrets(),
 		}
 	case models.MongoDBServiceType:
 		tdp := agent.TemplateDelimiters(service)
@@ -183,12 +180,19 @@ func connectionRequest(q *reform.Querier, service *models.Service, agent *models
 				TemplateLeftDelim:  tdp.Left,
 				TemplateRightDelim: tdp.Right,
 			},
+			Secrets: agent.Secrets(),
 		}
 	case models.ProxySQLServiceType:
+		tdp := agent.TemplateDelimiters(service)
 		request = &agentv1.CheckConnectionRequest{
 			Type:    inventoryv1.ServiceType_SERVICE_TYPE_PROXYSQL_SERVICE,
 			Dsn:     agent.DSN(service, models.DSNParams{DialTimeout: dialTimeout, Database: service.DatabaseName}, nil, pmmAgentVersion),
 			Timeout: requestDeadline,
+			TextFiles: &agentv1.TextFiles{
+				TemplateLeftDelim:  tdp.Left,
+				TemplateRightDelim: tdp.Right,
+			},
+			Secrets: agent.Secrets(),
 		}
 	case models.ExternalServiceType:
 		exporterURL, err := agent.ExporterURL(q)
@@ -226,6 +230,7 @@ func connectionRequest(q *reform.Querier, service *models.Service, agent *models
 				TemplateLeftDelim:  tdp.Left,
 				TemplateRightDelim: tdp.Right,
 			},
+			Secrets: agent.Secrets(),
 		}
 	default:
 		return nil, fmt.Errorf("unhandled Service type %s", service.ServiceType)
@@ -23,6 +23,7 @@ import (
 	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	"github.com/sirupsen/logrus"
+	"gopkg.in/reform.v1"
 
 	agentv1 "github.com/percona/pmm/api/agent/v1"
 	qanv1 "github.com/percona/pmm/api/qan/v1"
@@ -78,6 +79,14 @@ type victoriaMetricsClient interface {
 	Query(ctx context.Context, query string, ts time.Time, opts ...v1.Option) (model.Value, v1.Warnings, error)
 }
 
+// certificateAuthority is a subset of methods of ca.CA used by this package.
+// We use it instead of real type for testing.
+type certificateAuthority interface {
+	Sign(q *reform.Querier, pmmAgentID, csrPEM string) (string, error)
+	Verify(q *reform.Querier, certPEM string) (*models.AgentCertificate, error)
+	HasCertificates(q *reform.Querier, pmmAgentID string) (bool, error)
+}
+
 type nomad interface {
 	GetCACert() (string, error)
 	GetClientCert() (string, error)
@@ -52,11 +52,12 @@ type Handler struct {
 	qanClient   qanClient
 	state       *StateUpdater
 	jobsService jobsService
+	ca          certificateAuthority
 }
 
 // NewHandler creates new agents handler.
 func NewHandler(db *reform.DB, qanClient qanClient, vmdb prometheusService, registry *Registry, state *StateUpdater,
-	jobsService jobsService,
+	jobsService jobsService, ca certificateAuthority,
 ) *Handler {
 	h := &Handler{
 		db:          db,
@@ -65,6 +66,7 @@ func NewHandler(db *reform.DB, qanClient qanClient, vmdb prometheusService, regi
 		qanClient:   qanClient,
 		state:       state,
 		jobsService: jobsService,
+		ca:          ca,
 	}
 	return h
 }
@@ -168,6 +170,23 @@ func (h *Handler) Run(stream agentv1.AgentService_ConnectServer) error { //nolin
 					Payload: &agentv1.ActionResultResponse{},
 				})
 
+			case *agentv1.RenewCertificateRequest:
+				res := &agentv1.RenewCertificateResponse{}
+				err := h.db.InTransactionContext(ctx, nil, func(tx *reform.TX) error {
+					var e error
+					res.Certificate, e = h.ca.Sign(tx.Querier, agent.id, p.CertificateSigningRequest)
+					return e
+				})
+				resp := &channel.ServerResponse{
+					ID:      req.ID,
+					Payload: res,
+				}
+				if err != nil {
+					l.Errorf("Failed to renew client certificate: %+v.", err)
+					resp.Status, _ = status.FromError(err)
+				}
+				agent.channel.Send(resp)
+
 			case *agentv1.JobResult:
 				h.jobsService.handleJobResult(ctx, l, p)
 			case *agentv1.JobProgress:
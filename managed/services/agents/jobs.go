@@ -41,6 +41,9 @@ var (
 	pmmAgentMinVersionForMongoPhysicalBackupAndRestore = version.Must(version.NewVersion("2.31.0-0"))
 	pmmAgentMinVersionForMongoDBUseFilesystemStorage   = version.Must(version.NewVersion("2.32.0-0"))
 	pmmAgentMinVersionForMongoPITRRestore              = version.Must(version.NewVersion("2.32.0-0"))
+	pmmAgentMinVersionForPostgreSQLBackupAndRestore    = version.Must(version.NewVersion("3.10.0-0"))
+	pmmAgentMinVersionForBackupVerification            = version.Must(version.NewVersion("3.10.0-0"))
+	pmmAgentMinVersionForMySQLPITR                     = version.Must(version.NewVersion("3.10.0-0"))
 )
 
 const (
@@ -121,7 +124,35 @@ func (s *JobsService) RestartJob(ctx context.Context, jobID string) error { //no
 				return err
 			}
 
-		case models.MySQLRestoreBackupJob, models.MongoDBRestoreBackupJob:
+		case models.PostgreSQLBackupJob:
+			artifact, err = models.FindArtifactByID(tx.Querier, job.Data.PostgreSQLBackup.ArtifactID)
+			if err != nil {
+				return err
+			}
+
+			locationModel, err = models.FindBackupLocationByID(tx.Querier, artifact.LocationID)
+			if err != nil {
+				return err
+			}
+
+		case models.MySQLBinlogStreamJob:
+			artifact, err = models.FindArtifactByID(tx.Querier, job.Data.MySQLBinlogStream.ArtifactID)
+			if err != nil {
+				return err
+			}
+
+			locationModel, err = models.FindBackupLocationByID(tx.Querier, artifact.LocationID)
+			if err != nil {
+				return err
+			}
+
+			dbConfig, err = models.FindDBConfigForService(tx.Querier, job.Data.MySQLBinlogStream.ServiceID)
+			if err != nil {
+				return err
+			}
+
+		case models.MySQLRestoreBackupJob, models.MongoDBRestoreBackupJob, models.PostgreSQLRestoreBackupJob,
+			models.MySQLVerifyBackupJob, models.MongoDBVerifyBackupJob:
 			fallthrough
 		default:
 			return fmt.Errorf("job type %v can't be restarted", job.Type)
@@ -153,7 +184,8 @@ func (s *JobsService) RestartJob(ctx context.Context, jobID string) error { //no
 	switch job.Type {
 	case models.MySQLBackupJob:
 		//nolint:contextcheck
-		err := s.StartMySQLBackupJob(job.ID, job.PMMAgentID, job.Timeout, artifact.Name, dbConfig, locationConfig, artifact.Folder)
+		err := s.StartMySQLBackupJob(job.ID, job.PMMAgentID, job.Timeout, artifact.Name, job.Data.MySQLBackup.Mode, dbConfig,
+			locationConfig, artifact.Folder)
 		if err != nil {
 			return err
 		}
@@ -169,8 +201,29 @@ func (s *JobsService) RestartJob(ctx context.Context, jobID string) error { //no
 		if err != nil {
 			return err
 		}
+	case models.PostgreSQLBackupJob:
+		service, err := models.FindServiceByID(s.db.Querier, job.Data.PostgreSQLBackup.ServiceID)
+		if err != nil {
+			return err
+		}
+
+		//nolint:contextcheck
+		err = s.StartPostgreSQLBackupJob(service, job.ID, job.PMMAgentID, job.Timeout, artifact.Name,
+			job.Data.PostgreSQLBackup.DataModel, locationConfig, artifact.Folder)
+		if err != nil {
+			return err
+		}
+	case models.MySQLBinlogStreamJob:
+		//nolint:contextcheck
+		err := s.StartMySQLBinlogStreamJob(job.ID, job.PMMAgentID, dbConfig, locationConfig, artifact.MySQLBinlogFolder())
+		if err != nil {
+			return err
+		}
 	case models.MySQLRestoreBackupJob:
 	case models.MongoDBRestoreBackupJob:
+	case models.PostgreSQLRestoreBackupJob:
+	case models.MySQLVerifyBackupJob:
+	case models.MongoDBVerifyBackupJob:
 	}
 
 	return nil
@@ -259,6 +312,27 @@ func (s *JobsService) handleJobResult(_ context.Context, l *logrus.Entry, result
 				}
 			}
 
+		case *agentv1.JobResult_PostgresqlBackup:
+			if job.Type != models.PostgreSQLBackupJob {
+				return fmt.Errorf("result type %s doesn't match job type %s", models.PostgreSQLBackupJob, job.Type)
+			}
+
+			artifact, err := models.UpdateArtifact(
+				t.Querier,
+				job.Data.PostgreSQLBackup.ArtifactID,
+				models.UpdateArtifactParams{
+					Status:   models.SuccessBackupStatus.Pointer(),
+					Metadata: artifactMetadataFromProto(result.PostgresqlBackup.Metadata),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			if artifact.Type == models.ScheduledArtifactType {
+				scheduleID = artifact.ScheduleID
+			}
+
 		case *agentv1.JobResult_MysqlRestoreBackup:
 			if job.Type != models.MySQLRestoreBackupJob {
 				return fmt.Errorf("result type %s doesn't match job type %s", models.MySQLRestoreBackupJob, job.Type)
@@ -315,6 +389,56 @@ func (s *JobsService) handleJobResult(_ context.Context, l *logrus.Entry, result
 			if err != nil {
 				return err
 			}
+
+		case *agentv1.JobResult_PostgresqlRestoreBackup:
+			if job.Type != models.PostgreSQLRestoreBackupJob {
+				return fmt.Errorf("result type %s doesn't match job type %s", models.PostgreSQLRestoreBackupJob, job.Type)
+			}
+
+			_, err = models.ChangeRestoreHistoryItem(
+				t.Querier,
+				job.Data.PostgreSQLRestoreBackup.RestoreID,
+				models.ChangeRestoreHistoryItemParams{
+					Status:     models.SuccessRestoreStatus,
+					FinishedAt: new(models.Now()),
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+		case *agentv1.JobResult_MysqlVerifyBackup:
+			if job.Type != models.MySQLVerifyBackupJob {
+				return fmt.Errorf("result type %s doesn't match job type %s", models.MySQLVerifyBackupJob, job.Type)
+			}
+
+			_, err = models.UpdateArtifact(t.Querier, job.Data.MySQLVerifyBackup.ArtifactID, models.UpdateArtifactParams{
+				VerificationStatus: models.VerifiedVerificationStatus.Pointer(),
+				LastVerifiedAt:     new(models.Now()),
+			})
+			if err != nil {
+				return err
+			}
+
+		case *agentv1.JobResult_MongodbVerifyBackup:
+			if job.Type != models.MongoDBVerifyBackupJob {
+				return fmt.Errorf("result type %s doesn't match job type %s", models.MongoDBVerifyBackupJob, job.Type)
+			}
+
+			_, err = models.UpdateArtifact(t.Querier, job.Data.MongoDBVerifyBackup.ArtifactID, models.UpdateArtifactParams{
+				VerificationStatus: models.VerifiedVerificationStatus.Pointer(),
+				LastVerifiedAt:     new(models.Now()),
+			})
+			if err != nil {
+				return err
+			}
+
+		case *agentv1.JobResult_MysqlBinlogStream:
+			if job.Type != models.MySQLBinlogStreamJob {
+				return fmt.Errorf("result type %s doesn't match job type %s", models.MySQLBinlogStreamJob, job.Type)
+			}
+
+			// Streaming has been stopped, there is nothing to update.
 		default:
 			return fmt.Errorf("unexpected job result type: %T", result)
 		}
@@ -364,6 +488,31 @@ func (s *JobsService) handleJobError(job *models.Job) error {
 				FinishedAt: new(models.Now()),
 			},
 		)
+	case models.PostgreSQLBackupJob:
+		_, err = models.UpdateArtifact(s.db.Querier, job.Data.PostgreSQLBackup.ArtifactID, models.UpdateArtifactParams{
+			Status: models.ErrorBackupStatus.Pointer(),
+		})
+	case models.PostgreSQLRestoreBackupJob:
+		_, err = models.ChangeRestoreHistoryItem(
+			s.db.Querier,
+			job.Data.PostgreSQLRestoreBackup.RestoreID,
+			models.ChangeRestoreHistoryItemParams{
+				Status:     models.ErrorRestoreStatus,
+				FinishedAt: new(models.Now()),
+			},
+		)
+	case models.MySQLVerifyBackupJob:
+		_, err = models.UpdateArtifact(s.db.Querier, job.Data.MySQLVerifyBackup.ArtifactID, models.UpdateArtifactParams{
+			VerificationStatus: models.FailedVerificationStatus.Pointer(),
+			LastVerifiedAt:     new(models.Now()),
+		})
+	case models.MongoDBVerifyBackupJob:
+		_, err = models.UpdateArtifact(s.db.Querier, job.Data.MongoDBVerifyBackup.ArtifactID, models.UpdateArtifactParams{
+			VerificationStatus: models.FailedVerificationStatus.Pointer(),
+			LastVerifiedAt:     new(models.Now()),
+		})
+	case models.MySQLBinlogStreamJob:
+		// Snapshots stay usable, binary logs streamed so far too. Streaming is resumed by the restart below.
 	default:
 		return fmt.Errorf("unknown job type %s", job.Type)
 	}
@@ -397,6 +546,7 @@ func (s *JobsService) StartMySQLBackupJob(
 	jobID, pmmAgentID string,
 	timeout time.Duration,
 	name string,
+	mode models.BackupMode,
 	dbConfig *models.DBConfig,
 	locationConfig *models.BackupLocationConfig,
 	folder string,
@@ -407,14 +557,23 @@ func (s *JobsService) StartMySQLBackupJob(
 		return err
 	}
 
+	if mode == models.PITR {
+		err = models.PMMAgentSupported(s.r.db.Querier, pmmAgentID,
+			"mysql pitr backup", pmmAgentMinVersionForMySQLPITR)
+		if err != nil {
+			return err
+		}
+	}
+
 	mySQLReq := &agentv1.StartJobRequest_MySQLBackup{
-		Name:     name,
-		User:     dbConfig.User,
-		Password: dbConfig.Password,
-		Address:  dbConfig.Address,
-		Port:     int32(dbConfig.Port), //nolint:gosec // port is uint16
-		Socket:   dbConfig.Socket,
-		Folder:   folder,
+		Name:       name,
+		User:       dbConfig.User,
+		Password:   dbConfig.Password,
+		Address:    dbConfig.Address,
+		Port:       int32(dbConfig.Port), //nolint:gosec // port is uint16
+		Socket:     dbConfig.Socket,
+		Folder:     folder,
+		EnablePitr: mode == models.PITR,
 	}
 
 	switch {
@@ -428,22 +587,70 @@ func (s *JobsService) StartMySQLBackupJob(
 	req := &agentv1.StartJobRequest{
 		JobId:   jobID,
 		Timeout: durationpb.New(timeout),
+		Secrets: dbConfig.Secrets,
 		Job: &agentv1.StartJobRequest_MysqlBackup{
 			MysqlBackup: mySQLReq,
 		},
 	}
 
-	agent, err := s.r.get(pmmAgentID)
+	resp, err := s.r.sendAndWaitResponse(context.TODO(), pmmAgentID, req)
+	if err != nil {
+		return err
+	}
+	if e := resp.(*agentv1.StartJobResponse).Error; e != "" { //nolint:forcetypeassert
+		return fmt.Errorf("failed to start MySQL backup job: %s", e)
+	}
+
+	return nil
+}
+
+// StartMySQLBinlogStreamJob starts streaming of MySQL binary logs to the given folder on the pmm-agent.
+// The job runs until it is stopped.
+func (s *JobsService) StartMySQLBinlogStreamJob(
+	jobID, pmmAgentID string,
+	dbConfig *models.DBConfig,
+	locationConfig *models.BackupLocationConfig,
+	folder string,
+) error {
+	err := models.PMMAgentSupported(s.r.db.Querier, pmmAgentID,
+		"mysql binlog streaming", pmmAgentMinVersionForMySQLPITR)
 	if err != nil {
 		return err
 	}
 
-	resp, err := agent.channel.SendAndWaitResponse(context.TODO(), req)
+	mySQLReq := &agentv1.StartJobRequest_MySQLBinlogStream{
+		User:     dbConfig.User,
+		Password: dbConfig.Password,
+		Address:  dbConfig.Address,
+		Port:     int32(dbConfig.Port), //nolint:gosec // port is uint16
+		Socket:   dbConfig.Socket,
+		Folder:   folder,
+	}
+
+	switch {
+	case locationConfig.S3Config != nil:
+		mySQLReq.LocationConfig = &agentv1.StartJobRequest_MySQLBinlogStream_S3Config{
+			S3Config: convertS3ConfigModel(locationConfig.S3Config),
+		}
+	default:
+		return errors.New("unsupported location config")
+	}
+	req := &agentv1.StartJobRequest{
+		JobId: jobID,
+		// Zero timeout means no timeout, streaming lasts until the job is stopped.
+		Timeout: durationpb.New(0),
+		Secrets: dbConfig.Secrets,
+		Job: &agentv1.StartJobRequest_MysqlBinlogStream{
+			MysqlBinlogStream: mySQLReq,
+		},
+	}
+
+	resp, err := s.r.sendAndWaitResponse(context.TODO(), pmmAgentID, req)
 	if err != nil {
 		return err
 	}
 	if e := resp.(*agentv1.StartJobResponse).Error; e != "" { //nolint:forcetypeassert
-		return fmt.Errorf("failed to start MySQL backup job: %s", e)
+		return fmt.Errorf("failed to start MySQL binlog stream job: %s", e)
 	}
 
 	return nil
@@ -520,22 +727,89 @@ func (s *JobsService) StartMongoDBBackupJob(
 	req := &agentv1.StartJobRequest{
 		JobId:   jobID,
 		Timeout: durationpb.New(timeout),
+		Secrets: agent.Secrets(),
 		Job: &agentv1.StartJobRequest_MongodbBackup{
 			MongodbBackup: mongoDBReq,
 		},
 	}
 
-	agentInfo, err := s.r.get(pmmAgentID)
+	resp, err := s.r.sendAndWaitResponse(context.TODO(), pmmAgentID, req)
+	if err != nil {
+		return err
+	}
+	if e := resp.(*agentv1.StartJobResponse).Error; e != "" { //nolint:forcetypeassert
+		return fmt.Errorf("failed to start MongoDB backup job: %s", e)
+	}
+
+	return nil
+}
+
+// StartPostgreSQLBackupJob starts PostgreSQL backup job on the pmm-agent.
+func (s *JobsService) StartPostgreSQLBackupJob(
+	service *models.Service,
+	jobID string,
+	pmmAgentID string,
+	timeout time.Duration,
+	name string,
+	dataModel models.DataModel,
+	locationConfig *models.BackupLocationConfig,
+	folder string,
+) error {
+	err := models.PMMAgentSupported(s.r.db.Querier, pmmAgentID,
+		"postgresql backup", pmmAgentMinVersionForPostgreSQLBackupAndRestore)
+	if err != nil {
+		return err
+	}
+
+	dsn, agent, err := models.FindDSNByServiceIDandPMMAgentID(s.db.Querier, service.ServiceID, pmmAgentID, "")
+	if err != nil {
+		return err
+	}
+
+	delimiters := agent.TemplateDelimiters(service)
+
+	postgreSQLReq := &agentv1.StartJobRequest_PostgreSQLBackup{
+		Name:   name,
+		Folder: folder,
+		Dsn:    dsn,
+		TextFiles: &agentv1.TextFiles{
+			Files:              agent.Files(),
+			TemplateLeftDelim:  delimiters.Left,
+			TemplateRightDelim: delimiters.Right,
+		},
+	}
+	postgreSQLReq.DataModel, err = convertDataModel(dataModel)
 	if err != nil {
 		return err
 	}
 
-	resp, err := agentInfo.channel.SendAndWaitResponse(context.TODO(), req)
+	switch {
+	case locationConfig.S3Config != nil:
+		postgreSQLReq.LocationConfig = &agentv1.StartJobRequest_PostgreSQLBackup_S3Config{
+			S3Config: convertS3ConfigModel(locationConfig.S3Config),
+		}
+	case locationConfig.FilesystemConfig != nil:
+		postgreSQLReq.LocationConfig = &agentv1.StartJobRequest_PostgreSQLBackup_FilesystemConfig{
+			FilesystemConfig: &agentv1.FilesystemLocationConfig{Path: locationConfig.FilesystemConfig.Path},
+		}
+	default:
+		return errors.New("unsupported location config")
+	}
+	req := &agentv1.StartJobRequest{
+		JobId:   jobID,
+		Timeout: durationpb.New(timeout),
+		Secrets: agent.Secrets(),
+		Job: &agentv1.StartJobRequest_PostgresqlBackup{
+			PostgresqlBackup: postgreSQLReq,
+		},
+	}
+
+	resp, err := s.r.sendAndWaitResponse(context.TODO(), pmmAgentID, req)
 	if err != nil {
 		return err
 	}
 	if e := resp.(*agentv1.StartJobResponse).Error; e != "" { //nolint:forcetypeassert
-		return fmt.Errorf("failed to start MongoDB backup job: %s", e)
+		return fmt.Errorf("failed to start PostgreSQL backup job: %s", e)
 	}
 
 	return nil
@@ -550,6 +824,9 @@ func (s *JobsService) StartMySQLRestoreBackupJob(
 	name string,
 	locationConfig *models.BackupLocationConfig,
 	folder string,
+	pitrTimestamp time.Time,
+	binlogFolder string,
+	dbConfig *models.DBConfig,
 ) error {
 	err := models.PMMAgentSupported(s.r.db.Querier, pmmAgentID,
 		"mysql restore", pmmAgentMinVersionForMySQLBackupAndRestore)
@@ -561,27 +838,43 @@ func (s *JobsService) StartMySQLRestoreBackupJob(
 		return errors.New("location config is not set")
 	}
 
+	mySQLReq := &agentv1.StartJobRequest_MySQLRestoreBackup{
+		ServiceId: serviceID,
+		Name:      name,
+		Folder:    folder,
+		LocationConfig: &agentv1.StartJobRequest_MySQLRestoreBackup_S3Config{
+			S3Config: convertS3ConfigModel(locationConfig.S3Config),
+		},
+	}
+
+	var secrets map[string]string
+	if pitrTimestamp.Unix() != 0 {
+		err = models.PMMAgentSupported(s.r.db.Querier, pmmAgentID,
+			"mysql pitr restore", pmmAgentMinVersionForMySQLPITR)
+		if err != nil {
+			return err
+		}
+
+		mySQLReq.PitrTimestamp = timestamppb.New(pitrTimestamp)
+		mySQLReq.BinlogFolder = binlogFolder
+		mySQLReq.User = dbConfig.User
+		mySQLReq.Password = dbConfig.Password
+		mySQLReq.Address = dbConfig.Address
+		mySQLReq.Port = int32(dbConfig.Port) //nolint:gosec // port is uint16
+		mySQLReq.Socket = dbConfig.Socket
+		secrets = dbConfig.Secrets
+	}
+
 	req := &agentv1.StartJobRequest{
 		JobId:   jobID,
 		Timeout: durationpb.New(timeout),
+		Secrets: secrets,
 		Job: &agentv1.StartJobRequest_MysqlRestoreBackup{
-			MysqlRestoreBackup: &agentv1.StartJobRequest_MySQLRestoreBackup{
-				ServiceId: serviceID,
-				Name:      name,
-				Folder:    folder,
-				LocationConfig: &agentv1.StartJobRequest_MySQLRestoreBackup_S3Config{
-					S3Config: convertS3ConfigModel(locationConfig.S3Config),
-				},
-			},
+			MysqlRestoreBackup: mySQLReq,
 		},
 	}
 
-	agent, err := s.r.get(pmmAgentID)
-	if err != nil {
-		return err
-	}
-
-	resp, err := agent.channel.SendAndWaitResponse(context.TODO(), req)
+	resp, err := s.r.sendAndWaitResponse(context.TODO(), pmmAgentID, req)
 	if err != nil {
 		return err
 	}
@@ -672,22 +965,188 @@ func (s *JobsService) StartMongoDBRestoreBackupJob(
 	req := &agentv1.StartJobRequest{
 		JobId:   jobID,
 		Timeout: durationpb.New(timeout),
+		Secrets: agent.Secrets(),
 		Job: &agentv1.StartJobRequest_MongodbRestoreBackup{
 			MongodbRestoreBackup: mongoDBReq,
 		},
 	}
 
-	agentInfo, err := s.r.get(pmmAgentID)
+	resp, err := s.r.sendAndWaitResponse(context.TODO(), pmmAgentID, req)
+	if err != nil {
+		return err
+	}
+	if e := resp.(*agentv1.StartJobResponse).Error; e != "" { //nolint:forcetypeassert
+		return fmt.Errorf("failed to start MongoDB restore backup job: %s", e)
+	}
+
+	return nil
+}
+
+// StartPostgreSQLRestoreBackupJob starts PostgreSQL restore backup job on the pmm-agent.
+func (s *JobsService) StartPostgreSQLRestoreBackupJob(
+	service *models.Service,
+	jobID string,
+	pmmAgentID string,
+	timeout time.Duration,
+	name string,
+	dataModel models.DataModel,
+	locationConfig *models.BackupLocationConfig,
+	folder string,
+) error {
+	err := models.PMMAgentSupported(s.r.db.Querier, pmmAgentID,
+		"postgresql restore", pmmAgentMinVersionForPostgreSQLBackupAndRestore)
+	if err != nil {
+		return err
+	}
+
+	dsn, agent, err := models.FindDSNByServiceIDandPMMAgentID(s.db.Querier, service.ServiceID, pmmAgentID, "")
+	if err != nil {
+		return err
+	}
+
+	delimiters := agent.TemplateDelimiters(service)
+
+	postgreSQLReq := &agentv1.StartJobRequest_PostgreSQLRestoreBackup{
+		Name:   name,
+		Folder: folder,
+		Dsn:    dsn,
+		TextFiles: &agentv1.TextFiles{
+			Files:              agent.Files(),
+			TemplateLeftDelim:  delimiters.Left,
+			TemplateRightDelim: delimiters.Right,
+		},
+	}
+	postgreSQLReq.DataModel, err = convertDataModel(dataModel)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case locationConfig.S3Config != nil:
+		postgreSQLReq.LocationConfig = &agentv1.StartJobRequest_PostgreSQLRestoreBackup_S3Config{
+			S3Config: convertS3ConfigModel(locationConfig.S3Config),
+		}
+	case locationConfig.FilesystemConfig != nil:
+		postgreSQLReq.LocationConfig = &agentv1.StartJobRequest_PostgreSQLRestoreBackup_FilesystemConfig{
+			FilesystemConfig: &agentv1.FilesystemLocationConfig{Path: locationConfig.FilesystemConfig.Path},
+		}
+	default:
+		return errors.New("unsupported location config")
+	}
+
+	req := &agentv1.StartJobRequest{
+		JobId:   jobID,
+		Timeout: durationpb.New(timeout),
+		Secrets: agent.Secrets(),
+		Job: &agentv1.StartJobRequest_PostgresqlRestoreBackup{
+			PostgresqlRestoreBackup: postgreSQLReq,
+		},
+	}
+
+	resp, err := s.r.sendAndWaitResponse(context.TODO(), pmmAgentID, req)
+	if err != nil {
+		return err
+	}
+	if e := resp.(*agentv1.StartJobResponse).Error; e != "" { //nolint:forcetypeassert
+		return fmt.Errorf("failed to start PostgreSQL restore backup job: %s", e)
+	}
+
+	return nil
+}
+
+// StartMySQLVerifyBackupJob starts MySQL backup verification job on the pmm-agent.
+func (s *JobsService) StartMySQLVerifyBackupJob(
+	jobID string,
+	pmmAgentID string,
+	timeout time.Duration,
+	name string,
+	locationConfig *models.BackupLocationConfig,
+	folder string,
+) error {
+	err := models.PMMAgentSupported(s.r.db.Querier, pmmAgentID,
+		"mysql backup verification", pmmAgentMinVersionForBackupVerification)
 	if err != nil {
 		return err
 	}
 
-	resp, err := agentInfo.channel.SendAndWaitResponse(context.TODO(), req)
+	if locationConfig.S3Config == nil {
+		return errors.New("location config is not set")
+	}
+
+	req := &agentv1.StartJobRequest{
+		JobId:   jobID,
+		Timeout: durationpb.New(timeout),
+		Job: &agentv1.StartJobRequest_MysqlVerifyBackup{
+			MysqlVerifyBackup: &agentv1.StartJobRequest_MySQLVerifyBackup{
+				Name:   name,
+				Folder: folder,
+				LocationConfig: &agentv1.StartJobRequest_MySQLVerifyBackup_S3Config{
+					S3Config: convertS3ConfigModel(locationConfig.S3Config),
+				},
+			},
+		},
+	}
+
+	resp, err := s.r.sendAndWaitResponse(context.TODO(), pmmAgentID, req)
 	if err != nil {
 		return err
 	}
 	if e := resp.(*agentv1.StartJobResponse).Error; e != "" { //nolint:forcetypeassert
-		return fmt.Errorf("failed to start MongoDB restore backup job: %s", e)
+		return fmt.Errorf("failed to start MySQL verify backup job: %s", e)
+	}
+
+	return nil
+}
+
+// StartMongoDBVerifyBackupJob starts MongoDB backup verification job on the pmm-agent.
+func (s *JobsService) StartMongoDBVerifyBackupJob(
+	jobID string,
+	pmmAgentID string,
+	timeout time.Duration,
+	name string,
+	pbmBackupName string,
+	locationConfig *models.BackupLocationConfig,
+	folder string,
+) error {
+	err := models.PMMAgentSupported(s.r.db.Querier, pmmAgentID,
+		"mongodb backup verification", pmmAgentMinVersionForBackupVerification)
+	if err != nil {
+		return err
+	}
+
+	mongoDBReq := &agentv1.StartJobRequest_MongoDBVerifyBackup{
+		Name:        name,
+		Folder:      folder,
+		PbmMetadata: &backuppb.PbmMetadata{Name: pbmBackupName},
+	}
+
+	switch {
+	case locationConfig.S3Config != nil:
+		mongoDBReq.LocationConfig = &agentv1.StartJobRequest_MongoDBVerifyBackup_S3Config{
+			S3Config: convertS3ConfigModel(locationConfig.S3Config),
+		}
+	case locationConfig.FilesystemConfig != nil:
+		mongoDBReq.LocationConfig = &agentv1.StartJobRequest_MongoDBVerifyBackup_FilesystemConfig{
+			FilesystemConfig: &agentv1.FilesystemLocationConfig{Path: locationConfig.FilesystemConfig.Path},
+		}
+	default:
+		return errors.New("unsupported location config")
+	}
+
+	req := &agentv1.StartJobRequest{
+		JobId:   jobID,
+		Timeout: durationpb.New(timeout),
+		Job: &agentv1.StartJobRequest_MongodbVerifyBackup{
+			MongodbVerifyBackup: mongoDBReq,
+		},
+	}
+
+	resp, err := s.r.sendAndWaitResponse(context.TODO(), pmmAgentID, req)
+	if err != nil {
+		return err
+	}
+	if e := resp.(*agentv1.StartJobResponse).Error; e != "" { //nolint:forcetypeassert
+		return fmt.Errorf("failed to start MongoDB verify backup job: %s", e)
 	}
 
 	return nil
@@ -769,11 +1228,7 @@ func (s *JobsService) restartSystemService(agentID string, service agentv1.Start
 		},
 	}
 
-	agent, err := s.r.get(agentID)
-	if err != nil {
-		return fmt.Errorf("failed to get information about PMM agent %s: %w", agentID, err)
-	}
-	_, err = agent.channel.SendAndWaitResponse(context.TODO(), req)
+	_, err = s.r.sendAndWaitResponse(context.TODO(), agentID, req)
 	if err != nil {
 		return fmt.Errorf("failed to restart %s on agent %s: %w", service, agentID, err)
 	}
@@ -792,12 +1247,7 @@ func (s *JobsService) StopJob(jobID string) error {
 		return nil
 	}
 
-	agent, err := s.r.get(jobResult.PMMAgentID)
-	if err != nil {
-		return err
-	}
-
-	_, err = agent.channel.SendAndWaitResponse(context.TODO(), &agentv1.StopJobRequest{JobId: jobID})
+	_, err = s.r.sendAndWaitResponse(context.TODO(), jobResult.PMMAgentID, &agentv1.StopJobRequest{JobId: jobID})
 
 	return err
 }
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/AlekSi/pointer"
+	"google.golang.org/protobuf/types/known/durationpb"
 
 	agentv1 "github.com/percona/pmm/api/agent/v1"
 	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
@@ -231,6 +232,30 @@ func qanMySQLSlowlogAgentConfig(service *models.Service, agent *models.Agent, pm
 	}
 }
 
+// rtaMySQLAgentConfig returns desired configuration of rta-mysql-agent RTA agent.
+func rtaMySQLAgentConfig(service *models.Service, agent *models.Agent, pmmAgentVersion *version.Parsed) *agentv1.SetStateRequest_BuiltinAgent {
+	tdp := agent.TemplateDelimiters(service)
+
+	apiRTAOptions := &inventoryv1.RTAOptions{}
+	if agent.RTAOptions.CollectInterval != nil {
+		apiRTAOptions.CollectInterval = durationpb.New(*agent.RTAOptions.CollectInterval)
+	}
+
+	return &agentv1.SetStateRequest_BuiltinAgent{
+		Type:        inventoryv1.AgentType_AGENT_TYPE_RTA_MYSQL_AGENT,
+		Dsn:         agent.DSN(service, models.DSNParams{DialTimeout: time.Second, Database: ""}, nil, pmmAgentVersion),
+		RtaOptions:  apiRTAOptions,
+		ServiceId:   service.ServiceID,
+		ServiceName: service.ServiceName,
+		TextFiles: &agentv1.TextFiles{
+			Files:              agent.Files(),
+			TemplateLeftDelim:  tdp.Left,
+			TemplateRightDelim: tdp.Right,
+		},
+		TlsSkipVerify: agent.TLSSkipVerify,
+	}
+}
+
 // https://dev.mysql.com/doc/refman/8.4/en/mysql-command-options.html
 // https://dev.mysql.com/doc/refman/8.4/en/connection-options.html#encrypted-connection-options
 const myCnfTemplate = `[client]
@@ -269,7 +294,7 @@ func buildMyCnfConfig(service *models.Service, agent *models.Agent, files map[st
 		MyCnfPath               string
 	}{
 		User:           pointer.GetString(agent.Username),
-		Password:       pointer.GetString(agent.Password),
+		Password:       agent.TemplatePassword(tdp, false),
 		Host:           pointer.GetString(service.Address),
 		Port:           int(pointer.GetUint16(service.Port)),
 		ConnectTimeout: max(1, int(connectTimeout.Seconds())),
@@ -659,3 +659,40 @@ func TestMySQLdExporterConfigRoundsUpConnectionTimeout(t *testing.T) {
 		assert.Contains(t, actual.Env[0], "timeout=2s")
 	})
 }
+
+func TestMySQLdExporterConfigSecretReference(t *testing.T) {
+	t.Parallel()
+
+	node := &models.Node{
+		Address: "1.2.3.4",
+	}
+	service := &models.Service{
+		Address: new("1.2.3.4"),
+		Port:    new(uint16(3306)),
+	}
+	exporter := &models.Agent{
+		AgentID:       "agent-id",
+		AgentType:     models.MySQLdExporterType,
+		Username:      new("username"),
+		Password:      new("vault://kv/mysql/prod#password"),
+		AgentPassword: new("agent-password"),
+	}
+
+	t.Run("myCnf", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := mysqldExporterConfig(node, service, exporter, exposeSecrets, version.MustParse("3.2.0"))
+		require.NoError(t, err)
+		require.Contains(t, actual.TextFiles, "myCnf")
+		assert.Contains(t, actual.TextFiles["myCnf"], "password={{.Secrets.password}}\n")
+		assert.NotContains(t, actual.TextFiles["myCnf"], "vault://")
+	})
+
+	t.Run("legacy dsn", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := mysqldExporterConfig(node, service, exporter, exposeSecrets, version.MustParse("2.21.0"))
+		require.NoError(t, err)
+		assert.Equal(t, "DATA_SOURCE_NAME=username:{{.Secrets.password}}@tcp(1.2.3.4:3306)/?timeout=2s", actual.Env[0])
+	})
+}
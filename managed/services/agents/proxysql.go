@@ -17,6 +17,7 @@ package agents
 
 import (
 	"sort"
+	"time"
 
 	agentv1 "github.com/percona/pmm/api/agent/v1"
 	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
@@ -78,3 +79,12 @@ func proxysqlExporterConfig(node *models.Node, service *models.Service, exporter
 	}
 	return res
 }
+
+// qanProxySQLDigestAgentConfig returns desired configuration of qan-proxysql-digest-agent built-in agent.
+func qanProxySQLDigestAgentConfig(service *models.Service, agent *models.Agent, pmmAgentVersion *version.Parsed) *agentv1.SetStateRequest_BuiltinAgent {
+	return &agentv1.SetStateRequest_BuiltinAgent{
+		Type:           inventoryv1.AgentType_AGENT_TYPE_QAN_PROXYSQL_DIGEST_AGENT,
+		Dsn:            agent.DSN(service, models.DSNParams{DialTimeout: time.Second, Database: ""}, nil, pmmAgentVersion),
+		MaxQueryLength: agent.QANOptions.MaxQueryLength,
+	}
+}
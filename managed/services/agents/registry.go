@@ -27,11 +27,14 @@ import (
 	prom "github.com/prometheus/client_golang/prometheus"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
 	"gopkg.in/reform.v1"
 
 	agentv1 "github.com/percona/pmm/api/agent/v1"
 	"github.com/percona/pmm/managed/models"
 	"github.com/percona/pmm/managed/services/agents/channel"
+	"github.com/percona/pmm/managed/services/ha"
 	"github.com/percona/pmm/utils/logger"
 	"github.com/percona/pmm/version"
 )
@@ -85,6 +88,12 @@ type pmmAgentInfo struct {
 // haService is a subset of methods from ha.Service used by Registry.
 type haService interface {
 	Params() *models.HAParams
+	ReplicationEnabled() bool
+	SetAgentNode(pmmAgentID string) error
+	ClearAgentNode(pmmAgentID string) error
+	AgentNode(pmmAgentID string) (string, bool)
+	SetAgentRequestHandler(fn ha.AgentRequestHandler)
+	ForwardAgentRequest(ctx context.Context, nodeID, pmmAgentID string, req []byte) ([]byte, error)
 }
 
 // Registry keeps track of all connected pmm-agents.
@@ -98,6 +107,8 @@ type Registry struct {
 
 	haService haService
 
+	ca certificateAuthority
+
 	// Cache for connection status in HA mode
 	connectionCache    map[string]struct{}
 	connectionCacheTTL time.Time
@@ -113,7 +124,7 @@ type Registry struct {
 }
 
 // NewRegistry creates a new registry with given database connection.
-func NewRegistry(db *reform.DB, vmParams victoriaMetricsParams, ha haService) *Registry {
+func NewRegistry(db *reform.DB, vmParams victoriaMetricsParams, haSvc haService, ca certificateAuthority) *Registry {
 	agents := make(map[string]*pmmAgentInfo)
 	r := &Registry{
 		db: db,
@@ -122,7 +133,9 @@ func NewRegistry(db *reform.DB, vmParams victoriaMetricsParams, ha haService) *R
 
 		roster: newRoster(db),
 
-		haService: ha,
+		haService: haSvc,
+
+		ca: ca,
 
 		connectionCache: make(map[string]struct{}),
 
@@ -171,11 +184,14 @@ func NewRegistry(db *reform.DB, vmParams victoriaMetricsParams, ha haService) *R
 	// initialize metrics with labels
 	r.mDisconnects.WithLabelValues("unknown")
 
+	haSvc.SetAgentRequestHandler(r.handleForwardedRequest)
+
 	return r
 }
 
 // IsConnected returns true if pmm-agent is currently connected, false otherwise.
-// In HA mode, this queries the database (with 10-second caching) to support distributed environments.
+// In HA mode, this queries the database (with 10-second caching) to support distributed environments,
+// or the replicated agent routes if state replication is enabled.
 // In non-HA mode, this checks the in-memory registry for better performance.
 func (r *Registry) IsConnected(pmmAgentID string) bool {
 	if !r.haService.Params().Enabled {
@@ -184,6 +200,11 @@ func (r *Registry) IsConnected(pmmAgentID string) bool {
 		return err == nil
 	}
 
+	if r.haService.ReplicationEnabled() {
+		_, ok := r.haService.AgentNode(pmmAgentID)
+		return ok
+	}
+
 	// HA mode: check cache first, then database
 	if !time.Now().After(r.connectionCacheTTL) {
 		r.cacheMu.RLock()
@@ -329,6 +350,12 @@ func (r *Registry) register(stream agentv1.AgentService_ConnectServer) (*pmmAgen
 		r.cacheMu.Lock()
 		r.connectionCache[agentMD.ID] = struct{}{}
 		r.cacheMu.Unlock()
+
+		err = r.haService.SetAgentNode(agentMD.ID)
+		if err != nil {
+			// Log but don't fail - the database still has the connection status
+			l.Errorf("Failed to replicate the route for agent %s: %v", agentMD.ID, err)
+		}
 	}
 
 	return agent, nil
@@ -352,6 +379,10 @@ func (r *Registry) authenticate(md *agentv1.AgentConnectMetadata, q *reform.Quer
 		return nil, status.Errorf(codes.PermissionDenied, "No pmm-agent with ID %q.", md.ID)
 	}
 
+	if err = r.authenticateCertificate(md, q); err != nil {
+		return nil, err
+	}
+
 	runsOnNodeID := pointer.GetString(agent.RunsOnNodeID)
 	if runsOnNodeID == "" {
 		return nil, status.Errorf(codes.PermissionDenied, "Can't get 'runs_on_node_id' for pmm-agent with ID %q.", md.ID)
@@ -387,6 +418,33 @@ func (r *Registry) authenticate(md *agentv1.AgentConnectMetadata, q *reform.Quer
 	return node, nil
 }
 
+// authenticateCertificate checks the client certificate of pmm-agent passed by nginx.
+// pmm-agent that was issued certificates must present a valid one: it can't fall back to a token
+// once its certificates are revoked or expired.
+// Once pmm-agent connects with a certificate, certificates issued to it earlier are revoked.
+func (r *Registry) authenticateCertificate(md *agentv1.AgentConnectMetadata, q *reform.Querier) error {
+	if md.ClientCertificate == "" {
+		required, err := r.ca.HasCertificates(q, md.ID)
+		if err != nil {
+			return err
+		}
+		if required {
+			return status.Errorf(codes.Unauthenticated, "pmm-agent with ID %q must authenticate with a client certificate.", md.ID)
+		}
+		return nil
+	}
+
+	c, err := r.ca.Verify(q, md.ClientCertificate)
+	if err != nil {
+		return err
+	}
+	if c.PMMAgentID != md.ID {
+		return status.Errorf(codes.PermissionDenied, "Client certificate is not issued to pmm-agent with ID %q.", md.ID)
+	}
+
+	return models.RevokeAgentCertificates(q, md.ID, c.CreatedAt)
+}
+
 // unregister removes pmm-agent with given ID from the registry.
 //
 // If conn is not nil, the agent is removed only while it is still the registered connection.
@@ -442,6 +500,11 @@ func (r *Registry) unregister(ctx context.Context, pmmAgentID, disconnectReason
 		r.cacheMu.Lock()
 		delete(r.connectionCache, pmmAgentID)
 		r.cacheMu.Unlock()
+
+		err = r.haService.ClearAgentNode(pmmAgentID)
+		if err != nil {
+			l.Errorf("Failed to remove the route for agent %s: %v", pmmAgentID, err)
+		}
 	}
 
 	return agent
@@ -552,11 +615,99 @@ func (r *Registry) kick(ctx context.Context, pmmAgentID string, conn *pmmAgentIn
 	// closing agent.kickChan is enough to exit runStateChangeHandler goroutine.
 }
 
+// sendAndWaitResponse sends the request to pmm-agent and waits for its response.
+// If pmm-agent is connected to another HA node, the request is forwarded to that node.
+func (r *Registry) sendAndWaitResponse(
+	ctx context.Context,
+	pmmAgentID string,
+	req agentv1.ServerRequestPayload,
+) (agentv1.AgentResponsePayload, error) { //nolint:ireturn
+	r.rw.RLock()
+	pmmAgent := r.agents[pmmAgentID]
+	r.rw.RUnlock()
+	if pmmAgent != nil {
+		return pmmAgent.channel.SendAndWaitResponse(ctx, req)
+	}
+
+	nodeID, ok := r.haService.AgentNode(pmmAgentID)
+	if !ok || nodeID == r.haService.Params().NodeID {
+		return nil, status.Errorf(codes.FailedPrecondition, "pmm-agent with ID %s is not currently connected", pmmAgentID)
+	}
+
+	b, err := marshalForwarded(req)
+	if err != nil {
+		return nil, err
+	}
+	b, err = r.haService.ForwardAgentRequest(ctx, nodeID, pmmAgentID, b)
+	if err != nil {
+		return nil, err
+	}
+	m, err := unmarshalForwarded(b)
+	if err != nil {
+		return nil, err
+	}
+	res, ok := m.(agentv1.AgentResponsePayload)
+	if !ok {
+		return nil, fmt.Errorf("unexpected response %T from node %s", m, nodeID)
+	}
+	return res, nil
+}
+
+// handleForwardedRequest sends the request forwarded by another HA node to pmm-agent connected to this node.
+func (r *Registry) handleForwardedRequest(ctx context.Context, pmmAgentID string, b []byte) ([]byte, error) {
+	m, err := unmarshalForwarded(b)
+	if err != nil {
+		return nil, err
+	}
+	req, ok := m.(agentv1.ServerRequestPayload)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unexpected request %T", m)
+	}
+
+	pmmAgent, err := r.get(pmmAgentID)
+	if err != nil {
+		return nil, err
+	}
+	res, err := pmmAgent.channel.SendAndWaitResponse(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return marshalForwarded(res)
+}
+
+// marshalForwarded encodes request or response forwarded between HA nodes together with its type.
+func marshalForwarded(payload any) ([]byte, error) {
+	m, ok := payload.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("unexpected payload %T", payload)
+	}
+	a, err := anypb.New(m)
+	if err != nil {
+		return nil, err
+	}
+	return proto.Marshal(a)
+}
+
+// unmarshalForwarded decodes request or response encoded by marshalForwarded.
+func unmarshalForwarded(b []byte) (proto.Message, error) {
+	var a anypb.Any
+	if err := proto.Unmarshal(b, &a); err != nil {
+		return nil, err
+	}
+	return a.UnmarshalNew()
+}
+
+// get returns pmm-agent connected to this node.
+// Requests to pmm-agent connected to another HA node are sent with sendAndWaitResponse,
+// so in that case the error only names the node holding the stream.
 func (r *Registry) get(pmmAgentID string) (*pmmAgentInfo, error) {
 	r.rw.RLock()
 	pmmAgent := r.agents[pmmAgentID]
 	r.rw.RUnlock()
 	if pmmAgent == nil {
+		if nodeID, ok := r.haService.AgentNode(pmmAgentID); ok {
+			return nil, status.Errorf(codes.FailedPrecondition, "pmm-agent with ID %s is connected to PMM Server node %s", pmmAgentID, nodeID)
+		}
 		return nil, status.Errorf(codes.FailedPrecondition, "pmm-agent with ID %s is not currently connected", pmmAgentID)
 	}
 	return pmmAgent, nil
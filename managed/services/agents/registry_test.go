@@ -16,14 +16,24 @@
 package agents
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"sync"
 	"testing"
 
 	prom "github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/reform.v1"
 
+	agentv1 "github.com/percona/pmm/api/agent/v1"
 	"github.com/percona/pmm/managed/models"
+	"github.com/percona/pmm/managed/services/agents/channel"
+	"github.com/percona/pmm/managed/services/ha"
 	"github.com/percona/pmm/utils/logger"
 )
 
@@ -33,6 +43,69 @@ type haServiceStub struct{}
 
 func (haServiceStub) Params() *models.HAParams { return &models.HAParams{} }
 
+func (haServiceStub) ReplicationEnabled() bool { return false }
+
+func (haServiceStub) SetAgentNode(string) error { return nil }
+
+func (haServiceStub) ClearAgentNode(string) error { return nil }
+
+func (haServiceStub) AgentNode(string) (string, bool) { return "", false }
+
+func (haServiceStub) SetAgentRequestHandler(ha.AgentRequestHandler) {}
+
+func (haServiceStub) ForwardAgentRequest(context.Context, string, string, []byte) ([]byte, error) {
+	return nil, errors.New("not forwarded")
+}
+
+// forwardingHAServiceStub routes pmm-agent to another node and passes forwarded requests to its handler.
+type forwardingHAServiceStub struct {
+	haServiceStub
+	node    string
+	handler ha.AgentRequestHandler
+}
+
+func (s forwardingHAServiceStub) AgentNode(string) (string, bool) { return s.node, true }
+
+func (s forwardingHAServiceStub) ForwardAgentRequest(ctx context.Context, nodeID, pmmAgentID string, req []byte) ([]byte, error) {
+	if nodeID != s.node {
+		return nil, fmt.Errorf("unexpected node %s", nodeID)
+	}
+	return s.handler(ctx, pmmAgentID, req)
+}
+
+// stopActionStream is a pmm-agent stream that responds to every request with StopActionResponse.
+type stopActionStream struct {
+	ch chan *agentv1.AgentMessage
+}
+
+func (s *stopActionStream) Send(msg *agentv1.ServerMessage) error {
+	s.ch <- &agentv1.AgentMessage{
+		Id:      msg.Id,
+		Payload: (&agentv1.StopActionResponse{}).AgentMessageResponsePayload(),
+	}
+	return nil
+}
+
+func (s *stopActionStream) Recv() (*agentv1.AgentMessage, error) {
+	return <-s.ch, nil
+}
+
+type certificateAuthorityStub struct {
+	cert *models.AgentCertificate
+	err  error
+	has  bool
+}
+
+func (certificateAuthorityStub) Sign(*reform.Querier, string, string) (string, error) { return "", nil }
+
+func (c certificateAuthorityStub) Verify(*reform.Querier, string) (*models.AgentCertificate, error) {
+	return c.cert, c.err
+}
+
+func (c certificateAuthorityStub) HasCertificates(*reform.Querier, string) (bool, error) {
+	return c.has, nil
+}
+
 func newTestConn() *pmmAgentInfo {
 	return &pmmAgentInfo{
 		id:              testAgentID,
@@ -180,3 +253,95 @@ func TestKickConn(t *testing.T) {
 		}
 	})
 }
+
+func TestAuthenticateCertificate(t *testing.T) {
+	t.Parallel()
+
+	md := &agentv1.AgentConnectMetadata{
+		ID:                testAgentID,
+		ClientCertificate: "certificate",
+	}
+
+	t.Run("invalid certificate", func(t *testing.T) {
+		t.Parallel()
+
+		r := newTestRegistry()
+		r.ca = certificateAuthorityStub{err: status.Error(codes.Unauthenticated, "Client certificate is revoked.")}
+
+		err := r.authenticateCertificate(md, nil)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("certificate of another pmm-agent", func(t *testing.T) {
+		t.Parallel()
+
+		r := newTestRegistry()
+		r.ca = certificateAuthorityStub{cert: &models.AgentCertificate{PMMAgentID: "/agent_id/another"}}
+
+		err := r.authenticateCertificate(md, nil)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("token of pmm-agent without certificates", func(t *testing.T) {
+		t.Parallel()
+
+		r := newTestRegistry()
+		r.ca = certificateAuthorityStub{}
+
+		err := r.authenticateCertificate(&agentv1.AgentConnectMetadata{ID: testAgentID}, nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("token of pmm-agent with certificates", func(t *testing.T) {
+		t.Parallel()
+
+		r := newTestRegistry()
+		r.ca = certificateAuthorityStub{has: true}
+
+		err := r.authenticateCertificate(&agentv1.AgentConnectMetadata{ID: testAgentID}, nil)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+}
+
+func TestForwardedRequests(t *testing.T) {
+	t.Parallel()
+
+	ctx := logger.SetEntry(t.Context(), logrus.WithField("test", t.Name()))
+
+	t.Run("sent to node holding the stream", func(t *testing.T) {
+		t.Parallel()
+
+		owner := newTestRegistry()
+		owner.agents[testAgentID] = &pmmAgentInfo{
+			id:      testAgentID,
+			channel: channel.New(ctx, &stopActionStream{ch: make(chan *agentv1.AgentMessage, 1)}),
+		}
+
+		r := newTestRegistry()
+		r.haService = forwardingHAServiceStub{node: "node-2", handler: owner.handleForwardedRequest}
+
+		res, err := r.sendAndWaitResponse(ctx, testAgentID, &agentv1.StopActionRequest{ActionId: "action-1"})
+		require.NoError(t, err)
+		assert.IsType(t, &agentv1.StopActionResponse{}, res)
+	})
+
+	t.Run("pmm-agent disconnected from that node", func(t *testing.T) {
+		t.Parallel()
+
+		owner := newTestRegistry()
+		r := newTestRegistry()
+		r.haService = forwardingHAServiceStub{node: "node-2", handler: owner.handleForwardedRequest}
+
+		_, err := r.sendAndWaitResponse(ctx, testAgentID, &agentv1.StopActionRequest{ActionId: "action-1"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("pmm-agent not connected", func(t *testing.T) {
+		t.Parallel()
+
+		r := newTestRegistry()
+
+		_, err := r.sendAndWaitResponse(ctx, testAgentID, &agentv1.StopActionRequest{ActionId: "action-1"})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
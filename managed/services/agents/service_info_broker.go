@@ -63,6 +63,7 @@ func serviceInfoRequest(q *reform.Querier, service *models.Service, agent *model
 				TemplateLeftDelim:  tdp.Left,
 				TemplateRightDelim: tdp.Right,
 			},
+			Secrets:       agent.Secrets(),
 			TlsSkipVerify: agent.TLSSkipVerify,
 		}
 	case models.PostgreSQLServiceType:
@@ -81,6 +82,7 @@ func serviceInfoRequest(q *reform.Querier, service *models.Service, agent *model
 				TemplateLeftDelim:  tdp.Left,
 				TemplateRightDelim: tdp.Right,
 			},
+			Secrets: agent.Secrets(),
 		}
 	case models.MongoDBServiceType:
 		tdp := agent.TemplateDelimiters(service)
@@ -93,12 +95,19 @@ func serviceInfoRequest(q *reform.Querier, service *models.Service, agent *model
 				TemplateLeftDelim:  tdp.Left,
 				TemplateRightDelim: tdp.Right,
 			},
+			Secrets: agent.Secrets(),
 		}
 	case models.ProxySQLServiceType:
+		tdp := agent.TemplateDelimiters(service)
 		request = &agentv1.ServiceInfoRequest{
 			Type:    inventoryv1.ServiceType_SERVICE_TYPE_PROXYSQL_SERVICE,
 			Dsn:     agent.DSN(service, models.DSNParams{DialTimeout: time.Second, Database: service.DatabaseName}, nil, pmmAgentVersion),
 			Timeout: durationpb.New(3 * time.Second), //nolint:mnd
+			TextFiles: &agentv1.TextFiles{
+				TemplateLeftDelim:  tdp.Left,
+				TemplateRightDelim: tdp.Right,
+			},
+			Secrets: agent.Secrets(),
 		}
 	case models.ExternalServiceType:
 		exporterURL, err := agent.ExporterURL(q)
@@ -136,6 +145,7 @@ func serviceInfoRequest(q *reform.Querier, service *models.Service, agent *model
 				TemplateLeftDelim:  tdp.Left,
 				TemplateRightDelim: tdp.Right,
 			},
+			Secrets: agent.Secrets(),
 		}
 	default:
 		return nil, fmt.Errorf("unhandled Service type %s", service.ServiceType)
@@ -159,11 +169,6 @@ func (c *ServiceInfoBroker) GetInfoFromService(ctx context.Context, q *reform.Qu
 	}
 
 	pmmAgentID := pointer.GetString(agent.PMMAgentID)
-	pmmAgent, err := c.r.get(pmmAgentID)
-	if err != nil {
-		return err
-	}
-
 	request, err := serviceInfoRequest(q, service, agent)
 	if err != nil {
 		return err
@@ -172,7 +177,7 @@ func (c *ServiceInfoBroker) GetInfoFromService(ctx context.Context, q *reform.Qu
 	l.Infof("ServiceInfoRequest: type: %s, DSN: %s timeout: %s.",
 		request.Type, logger.MaskDSN(request.Dsn), request.Timeout)
 
-	resp, err := pmmAgent.channel.SendAndWaitResponse(ctx, request)
+	resp, err := c.r.sendAndWaitResponse(ctx, pmmAgentID, request)
 	if err != nil {
 		return err
 	}
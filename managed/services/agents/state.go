@@ -245,7 +245,8 @@ func (u *StateUpdater) sendSetStateRequest(ctx context.Context, agent *pmmAgentI
 			models.ValkeyExporterType, models.QANMySQLPerfSchemaAgentType, models.QANMySQLSlowlogAgentType,
 			models.QANMongoDBProfilerAgentType, models.QANMongoDBMongologAgentType,
 			models.QANPostgreSQLPgStatementsAgentType, models.QANPostgreSQLPgStatMonitorAgentType,
-			models.RTAMongoDBAgentType:
+			models.RTAMongoDBAgentType, models.RTAMySQLAgentType, models.RTAPostgreSQLAgentType,
+			models.QANValkeySlowlogAgentType, models.QANProxySQLDigestAgentType, models.QANPostgreSQLLogAgentType:
 			service, err := models.FindServiceByID(u.db.Querier, pointer.GetString(row.ServiceID))
 			if err != nil {
 				return err
@@ -288,6 +289,33 @@ func (u *StateUpdater) sendSetStateRequest(ctx context.Context, agent *pmmAgentI
 				builtinAgents[row.AgentID] = qanPostgreSQLPgStatMonitorAgentConfig(service, row, pmmAgentVersion)
 			case models.RTAMongoDBAgentType:
 				builtinAgents[row.AgentID] = rtaMongoDBAgentConfig(service, row, pmmAgentVersion)
+			case models.RTAMySQLAgentType:
+				builtinAgents[row.AgentID] = rtaMySQLAgentConfig(service, row, pmmAgentVersion)
+			case models.RTAPostgreSQLAgentType:
+				builtinAgents[row.AgentID] = rtaPostgreSQLAgentConfig(service, row, pmmAgentVersion)
+			case models.QANValkeySlowlogAgentType:
+				builtinAgents[row.AgentID] = qanValkeySlowlogAgentConfig(service, row, pmmAgentVersion)
+			case models.QANProxySQLDigestAgentType:
+				builtinAgents[row.AgentID] = qanProxySQLDigestAgentConfig(service, row, pmmAgentVersion)
+			case models.QANPostgreSQLLogAgentType:
+				builtinAgents[row.AgentID] = qanPostgreSQLLogAgentConfig(service, row, pmmAgentVersion)
+			}
+
+			// DSN contains placeholders for secret values; pmm-agent resolves references and renders them.
+			if secrets := row.Secrets(); secrets != nil {
+				if p := agentProcesses[row.AgentID]; p != nil {
+					p.Secrets = secrets
+				}
+				if b := builtinAgents[row.AgentID]; b != nil {
+					b.Secrets = secrets
+					if b.TextFiles == nil {
+						tdp := row.TemplateDelimiters(service)
+						b.TextFiles = &agentv1.TextFiles{
+							TemplateLeftDelim:  tdp.Left,
+							TemplateRightDelim: tdp.Right,
+						}
+					}
+				}
 			}
 
 		default:
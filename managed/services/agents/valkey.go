@@ -60,3 +60,22 @@ func valkeyExporterConfig(node *models.Node, service *models.Service, exporter *
 	}
 	return res
 }
+
+// qanValkeySlowlogAgentConfig returns desired configuration of qan-valkey-slowlog-agent built-in agent.
+func qanValkeySlowlogAgentConfig(service *models.Service, agent *models.Agent, pmmAgentVersion *version.Parsed) *agentv1.SetStateRequest_BuiltinAgent {
+	tdp := agent.TemplateDelimiters(service)
+
+	return &agentv1.SetStateRequest_BuiltinAgent{
+		Type:                 inventoryv1.AgentType_AGENT_TYPE_QAN_VALKEY_SLOWLOG_AGENT,
+		Dsn:                  agent.DSN(service, models.DSNParams{}, nil, pmmAgentVersion),
+		MaxQueryLength:       agent.QANOptions.MaxQueryLength,
+		DisableQueryExamples: agent.QANOptions.QueryExamplesDisabled,
+		TextFiles: &agentv1.TextFiles{
+			Files:              agent.Files(),
+			TemplateLeftDelim:  tdp.Left,
+			TemplateRightDelim: tdp.Right,
+		},
+		Tls:           agent.TLS,
+		TlsSkipVerify: agent.TLSSkipVerify,
+	}
+}
@@ -145,6 +145,51 @@ func (*PBM) GetVersionRequest() *agentv1.GetVersionsRequest_Software {
 	}
 }
 
+// PGBasebackup represents pg_basebackup software.
+type PGBasebackup struct{}
+
+func (*PGBasebackup) isSoftware() {}
+
+// Name returns the software name for PGBasebackup.
+func (*PGBasebackup) Name() models.SoftwareName { return models.PGBasebackupSoftwareName }
+
+// GetVersionRequest constructs a request for pg_basebackup software.
+func (*PGBasebackup) GetVersionRequest() *agentv1.GetVersionsRequest_Software {
+	return &agentv1.GetVersionsRequest_Software{
+		Software: &agentv1.GetVersionsRequest_Software_PgBasebackup{},
+	}
+}
+
+// PGDump represents pg_dump software.
+type PGDump struct{}
+
+func (*PGDump) isSoftware() {}
+
+// Name returns the software name for PGDump.
+func (*PGDump) Name() models.SoftwareName { return models.PGDumpSoftwareName }
+
+// GetVersionRequest constructs a request for pg_dump software.
+func (*PGDump) GetVersionRequest() *agentv1.GetVersionsRequest_Software {
+	return &agentv1.GetVersionsRequest_Software{
+		Software: &agentv1.GetVersionsRequest_Software_PgDump{},
+	}
+}
+
+// PGRestore represents pg_restore software.
+type PGRestore struct{}
+
+func (*PGRestore) isSoftware() {}
+
+// Name returns the software name for PGRestore.
+func (*PGRestore) Name() models.SoftwareName { return models.PGRestoreSoftwareName }
+
+// GetVersionRequest constructs a request for pg_restore software.
+func (*PGRestore) GetVersionRequest() *agentv1.GetVersionsRequest_Software {
+	return &agentv1.GetVersionsRequest_Software{
+		Software: &agentv1.GetVersionsRequest_Software_PgRestore{},
+	}
+}
+
 // getMysqlSoftwareList returns list of software required for MySQL backups.
 func getMysqlSoftwareList() []Software {
 	return []Software{&Mysqld{}, &Xtrabackup{}, &Xbcloud{}, &Qpress{}}
@@ -155,6 +200,11 @@ func getMongodbSoftwareList() []Software {
 	return []Software{&MongoDB{}, &PBM{}}
 }
 
+// getPostgresqlSoftwareList returns list of software required for PostgreSQL backups.
+func getPostgresqlSoftwareList() []Software {
+	return []Software{&PGBasebackup{}, &PGDump{}, &PGRestore{}}
+}
+
 // GetRequiredBackupSoftwareList maps service type into list of software required for backups. Returns empty list if no software specified for the type.
 func GetRequiredBackupSoftwareList(serviceType models.ServiceType) []Software {
 	switch serviceType {
@@ -162,6 +212,8 @@ func GetRequiredBackupSoftwareList(serviceType models.ServiceType) []Software {
 		return getMysqlSoftwareList()
 	case models.MongoDBServiceType:
 		return getMongodbSoftwareList()
+	case models.PostgreSQLServiceType:
+		return getPostgresqlSoftwareList()
 	default:
 		return nil
 	}
@@ -175,18 +227,13 @@ func (s *VersionerService) GetVersions(pmmAgentID string, softwareList []Softwar
 		return nil, err
 	}
 
-	agent, err := s.r.get(pmmAgentID)
-	if err != nil {
-		return nil, err
-	}
-
 	softwareRequest := make([]*agentv1.GetVersionsRequest_Software, 0, len(softwareList))
 	for _, software := range softwareList {
 		softwareRequest = append(softwareRequest, software.GetVersionRequest())
 	}
 
 	request := &agentv1.GetVersionsRequest{Softwares: softwareRequest}
-	response, err := agent.channel.SendAndWaitResponse(context.TODO(), request)
+	response, err := s.r.sendAndWaitResponse(context.TODO(), pmmAgentID, request)
 	if err != nil {
 		return nil, err
 	}
@@ -37,6 +37,10 @@ func TestSoftwareName(t *testing.T) {
 
 		{&MongoDB{}, models.MongoDBSoftwareName},
 		{&PBM{}, models.PBMSoftwareName},
+
+		{&PGBasebackup{}, models.PGBasebackupSoftwareName},
+		{&PGDump{}, models.PGDumpSoftwareName},
+		{&PGRestore{}, models.PGRestoreSoftwareName},
 	}
 
 	for _, tc := range tests {
@@ -62,6 +66,10 @@ func TestGetVersionRequest(t *testing.T) {
 
 		{"mongodb", &MongoDB{}, &agentv1.GetVersionsRequest_Software{Software: &agentv1.GetVersionsRequest_Software_Mongod{}}},
 		{"pbm", &PBM{}, &agentv1.GetVersionsRequest_Software{Software: &agentv1.GetVersionsRequest_Software_Pbm{}}},
+
+		{"pg_basebackup", &PGBasebackup{}, &agentv1.GetVersionsRequest_Software{Software: &agentv1.GetVersionsRequest_Software_PgBasebackup{}}},
+		{"pg_dump", &PGDump{}, &agentv1.GetVersionsRequest_Software{Software: &agentv1.GetVersionsRequest_Software_PgDump{}}},
+		{"pg_restore", &PGRestore{}, &agentv1.GetVersionsRequest_Software{Software: &agentv1.GetVersionsRequest_Software_PgRestore{}}},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,305 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// Package audit implements the audit log of mutating API calls.
+package audit
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm/managed/models"
+	"github.com/percona/pmm/utils/logger"
+)
+
+// UserHeaderName is the name of the header with the caller identity set by the authentication server.
+const UserHeaderName = "X-Proxy-User"
+
+// retentionInterval is the interval between removals of audit events older than the retention period.
+const retentionInterval = time.Hour
+
+// mutatingMethods are full names of methods recorded in the audit log.
+// Every method mapped to a non-GET HTTP method must be listed either there or in readOnlyMethods.
+var mutatingMethods = map[string]struct{}{
+	"/accesscontrol.v1beta1.AccessControlService/AssignRoles":    {},
+	"/accesscontrol.v1beta1.AccessControlService/CreateRole":     {},
+	"/accesscontrol.v1beta1.AccessControlService/DeleteRole":     {},
+	"/accesscontrol.v1beta1.AccessControlService/SetDefaultRole": {},
+	"/accesscontrol.v1beta1.AccessControlService/UpdateRole":     {},
+
+	"/actions.v1.ActionsService/CancelAction":         {},
+	"/actions.v1.ActionsService/StartPTSummaryAction": {},
+	"/actions.v1.ActionsService/StartServiceAction":   {},
+
+	"/advisors.v1.AdvisorService/ChangeAdvisorChecks": {},
+	"/advisors.v1.AdvisorService/StartAdvisorChecks":  {},
+
+	"/alerting.v1.AlertingService/CreateRule":     {},
+	"/alerting.v1.AlertingService/CreateTemplate": {},
+	"/alerting.v1.AlertingService/DeleteTemplate": {},
+	"/alerting.v1.AlertingService/UpdateTemplate": {},
+
+	"/backup.v1.BackupService/ChangeScheduledBackup":       {},
+	"/backup.v1.BackupService/DeleteArtifact":              {},
+	"/backup.v1.BackupService/RemoveScheduledBackup":       {},
+	"/backup.v1.BackupService/RemoveScheduledVerification": {},
+	"/backup.v1.BackupService/ScheduleBackup":              {},
+	"/backup.v1.BackupService/ScheduleVerification":        {},
+	"/backup.v1.BackupService/StartBackup":                 {},
+	"/backup.v1.BackupService/VerifyArtifact":              {},
+
+	"/backup.v1.LocationsService/AddLocation":    {},
+	"/backup.v1.LocationsService/ChangeLocation": {},
+	"/backup.v1.LocationsService/RemoveLocation": {},
+
+	"/backup.v1.RestoreService/RestoreBackup": {},
+
+	"/dump.v1beta1.DumpService/DeleteDump": {},
+	"/dump.v1beta1.DumpService/StartDump":  {},
+	"/dump.v1beta1.DumpService/UploadDump": {},
+
+	"/inventory.v1.AgentsService/AddAgent":    {},
+	"/inventory.v1.AgentsService/ChangeAgent": {},
+	"/inventory.v1.AgentsService/RemoveAgent": {},
+
+	"/inventory.v1.NodesService/AddNode":    {},
+	"/inventory.v1.NodesService/RemoveNode": {},
+
+	"/inventory.v1.ServicesService/AddService":    {},
+	"/inventory.v1.ServicesService/ChangeService": {},
+	"/inventory.v1.ServicesService/RemoveService": {},
+
+	"/management.v1.ManagementService/AddAnnotation":          {},
+	"/management.v1.ManagementService/AddAzureDatabase":       {},
+	"/management.v1.ManagementService/AddService":             {},
+	"/management.v1.ManagementService/RegisterNode":           {},
+	"/management.v1.ManagementService/RemoveService":          {},
+	"/management.v1.ManagementService/RevokeNodeCertificates": {},
+	"/management.v1.ManagementService/UnregisterNode":         {},
+
+	"/realtimeanalytics.v1.RealtimeAnalyticsService/KillQuery":    {},
+	"/realtimeanalytics.v1.RealtimeAnalyticsService/StartSession": {},
+	"/realtimeanalytics.v1.RealtimeAnalyticsService/StopSession":  {},
+
+	"/server.v1.ServerService/ChangeSettings": {},
+
+	"/user.v1.UserService/UpdateUser": {},
+}
+
+// readOnlyMethods are full names of methods that don't change anything
+// even though they are mapped to a non-GET HTTP method (for example, because they have a request body).
+var readOnlyMethods = map[string]struct{}{
+	"/backup.v1.LocationsService/TestLocationConfig": {},
+
+	"/inventory.v1.ServicesService/ListActiveServiceTypes": {},
+
+	"/management.v1.ManagementService/DiscoverAzureDatabase": {},
+	"/management.v1.ManagementService/DiscoverRDS":           {},
+
+	"/realtimeanalytics.v1.RealtimeAnalyticsService/ReplaySession": {},
+	"/realtimeanalytics.v1.RealtimeAnalyticsService/SearchQueries": {},
+}
+
+// User represents the caller identity passed in the UserHeaderName header.
+type User struct {
+	ID    int64  `json:"id"`
+	Login string `json:"login"`
+	Role  string `json:"role"`
+}
+
+// EncodeUser encodes the caller identity for the UserHeaderName header.
+func EncodeUser(u *User) (string, error) {
+	b, err := json.Marshal(u)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(b), nil
+}
+
+// UserFromContext returns the caller identity from the incoming gRPC metadata,
+// or an empty user for internal callers.
+func UserFromContext(ctx context.Context) *User {
+	var u User
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(UserHeaderName)
+	if len(values) == 0 {
+		return &u
+	}
+
+	b, err := base64.StdEncoding.DecodeString(values[0])
+	if err != nil {
+		return &u
+	}
+	_ = json.Unmarshal(b, &u)
+	return &u
+}
+
+// Service records audit events of mutating API calls and removes expired ones.
+type Service struct {
+	db *reform.DB
+	l  *logrus.Entry
+}
+
+// New creates a new audit log service.
+func New(db *reform.DB) *Service {
+	return &Service{
+		db: db,
+		l:  logrus.WithField("component", "audit"),
+	}
+}
+
+// UnaryInterceptor returns a new unary server interceptor that records audit events of mutating calls.
+func (s *Service) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if !isAudited(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		res, err := handler(ctx, req)
+		s.record(ctx, info.FullMethod, req, res, err)
+		return res, err
+	}
+}
+
+// isAudited returns true if the method is listed in mutatingMethods.
+func isAudited(fullMethod string) bool {
+	_, ok := mutatingMethods[fullMethod]
+	return ok
+}
+
+// record stores the audit event of a single call. Errors are logged, but don't affect the call.
+func (s *Service) record(ctx context.Context, fullMethod string, req, res any, callErr error) {
+	l := logger.Get(ctx)
+	user := UserFromContext(ctx)
+
+	params := models.CreateAuditEventParams{
+		UserID:     user.ID,
+		Username:   user.Login,
+		Role:       user.Role,
+		Method:     fullMethod,
+		StatusCode: status.Code(callErr).String(),
+	}
+	if callErr != nil {
+		params.Error = status.Convert(callErr).Message()
+	}
+
+	if m, ok := req.(proto.Message); ok {
+		params.TargetIDs = targetIDs(m, nil)
+		b, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(logger.RedactMessage(m))
+		if err != nil {
+			l.Warnf("Failed to marshal audited request: %s.", err)
+		}
+		params.Request = string(b)
+	}
+	if m, ok := res.(proto.Message); ok && callErr == nil {
+		params.TargetIDs = targetIDs(m, params.TargetIDs)
+	}
+
+	// record the event even if the call was canceled by the client
+	if _, err := models.CreateAuditEvent(s.db.WithContext(context.WithoutCancel(ctx)), params); err != nil {
+		l.Errorf("Failed to record audit event: %s.", err)
+	}
+}
+
+// targetIDs appends non-empty values of *_id and *_ids string fields of the message and its nested messages to ids,
+// skipping duplicates.
+func targetIDs(m proto.Message, ids []string) []string {
+	seen := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		seen[id] = struct{}{}
+	}
+	add := func(id string) {
+		if _, ok := seen[id]; id == "" || ok {
+			return
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+
+	var walk func(m protoreflect.Message)
+	walk = func(m protoreflect.Message) {
+		m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			name := string(fd.Name())
+			switch {
+			case fd.IsMap():
+				return true
+			case fd.Kind() == protoreflect.MessageKind && fd.IsList():
+				for i := range v.List().Len() {
+					walk(v.List().Get(i).Message())
+				}
+			case fd.Kind() == protoreflect.MessageKind:
+				walk(v.Message())
+			case fd.Kind() == protoreflect.StringKind && fd.IsList() && strings.HasSuffix(name, "_ids"):
+				for i := range v.List().Len() {
+					add(v.List().Get(i).String())
+				}
+			case fd.Kind() == protoreflect.StringKind && !fd.IsList() && strings.HasSuffix(name, "_id"):
+				add(v.String())
+			}
+			return true
+		})
+	}
+	walk(m.ProtoReflect())
+
+	return ids
+}
+
+// Run removes audit events older than the retention period until ctx is canceled.
+func (s *Service) Run(ctx context.Context) {
+	s.l.Info("Starting...")
+	defer s.l.Info("Done.")
+
+	ticker := time.NewTicker(retentionInterval)
+	defer ticker.Stop()
+
+	for {
+		s.removeExpired(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) removeExpired(ctx context.Context) {
+	settings, err := models.GetSettings(s.db.WithContext(ctx))
+	if err != nil {
+		s.l.Errorf("Failed to get settings: %s.", err)
+		return
+	}
+
+	deleted, err := models.DeleteAuditEventsBefore(s.db.WithContext(ctx), models.Now().Add(-settings.AuditLog.Retention))
+	if err != nil {
+		s.l.Errorf("Failed to remove expired audit events: %s.", err)
+		return
+	}
+	if deleted > 0 {
+		s.l.Infof("Removed %d expired audit events.", deleted)
+	}
+}
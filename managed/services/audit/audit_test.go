@@ -0,0 +1,161 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package audit
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	accesscontrolv1beta1 "github.com/percona/pmm/api/accesscontrol/v1beta1"
+	actionsv1 "github.com/percona/pmm/api/actions/v1"
+	advisorsv1 "github.com/percona/pmm/api/advisors/v1"
+	alertingv1 "github.com/percona/pmm/api/alerting/v1"
+	backupv1 "github.com/percona/pmm/api/backup/v1"
+	dumpv1beta1 "github.com/percona/pmm/api/dump/v1beta1"
+	hav1beta1 "github.com/percona/pmm/api/ha/v1beta1"
+	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
+	managementv1 "github.com/percona/pmm/api/management/v1"
+	realtimeanalyticsv1 "github.com/percona/pmm/api/realtimeanalytics/v1"
+	serverv1 "github.com/percona/pmm/api/server/v1"
+	userv1 "github.com/percona/pmm/api/user/v1"
+)
+
+func TestIsAudited(t *testing.T) {
+	t.Parallel()
+
+	for method, expected := range map[string]bool{
+		"/inventory.v1.ServicesService/AddService":                     true,
+		"/inventory.v1.ServicesService/RemoveService":                  true,
+		"/inventory.v1.ServicesService/ChangeService":                  true,
+		"/inventory.v1.ServicesService/GetService":                     false,
+		"/inventory.v1.ServicesService/ListServices":                   false,
+		"/inventory.v1.ServicesService/ListActiveServiceTypes":         false, // POST, but read-only
+		"/realtimeanalytics.v1.RealtimeAnalyticsService/ReplaySession": false, // POST, but read-only
+		"/realtimeanalytics.v1.RealtimeAnalyticsService/KillQuery":     true,
+		"/server.v1.ServerService/ChangeSettings":                      true,
+		"/server.v1.ServerService/ListAuditEvents":                     false,
+		"/server.v1.ServerService/NoSuchMethod":                        false,
+		"/no.such.Service/AddService":                                  false,
+		"invalid":                                                      false,
+	} {
+		assert.Equal(t, expected, isAudited(method), method)
+	}
+}
+
+// TestMethodsListed checks that every method of APIs served by pmm-managed that is mapped
+// to a non-GET HTTP method is explicitly marked as mutating or read-only.
+func TestMethodsListed(t *testing.T) {
+	t.Parallel()
+
+	files := []protoreflect.FileDescriptor{
+		accesscontrolv1beta1.File_accesscontrol_v1beta1_accesscontrol_proto,
+		actionsv1.File_actions_v1_actions_proto,
+		advisorsv1.File_advisors_v1_advisors_proto,
+		alertingv1.File_alerting_v1_alerting_proto,
+		backupv1.File_backup_v1_backup_proto,
+		backupv1.File_backup_v1_locations_proto,
+		backupv1.File_backup_v1_restores_proto,
+		dumpv1beta1.File_dump_v1beta1_dump_proto,
+		hav1beta1.File_ha_v1beta1_ha_proto,
+		inventoryv1.File_inventory_v1_agents_proto,
+		inventoryv1.File_inventory_v1_nodes_proto,
+		inventoryv1.File_inventory_v1_services_proto,
+		managementv1.File_management_v1_service_proto,
+		realtimeanalyticsv1.File_realtimeanalytics_v1_realtimeanalytics_proto,
+		serverv1.File_server_v1_server_proto,
+		userv1.File_user_v1_user_proto,
+	}
+
+	nonGet := make(map[string]struct{})
+	for _, fd := range files {
+		for i := range fd.Services().Len() {
+			sd := fd.Services().Get(i)
+			for j := range sd.Methods().Len() {
+				md := sd.Methods().Get(j)
+				rule, _ := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+				if rule == nil || rule.GetGet() != "" {
+					continue
+				}
+				nonGet[fmt.Sprintf("/%s/%s", sd.FullName(), md.Name())] = struct{}{}
+			}
+		}
+	}
+
+	for method := range nonGet {
+		_, mutating := mutatingMethods[method]
+		_, readOnly := readOnlyMethods[method]
+		assert.True(t, mutating != readOnly, "%s must be listed in exactly one of mutatingMethods and readOnlyMethods", method)
+	}
+	for _, methods := range []map[string]struct{}{mutatingMethods, readOnlyMethods} {
+		for method := range methods {
+			assert.Contains(t, nonGet, method, "%s is not a known non-GET method", method)
+		}
+	}
+}
+
+func TestTargetIDs(t *testing.T) {
+	t.Parallel()
+
+	req := &inventoryv1.RemoveServiceRequest{ServiceId: "service1", Force: true}
+	ids := targetIDs(req, nil)
+	assert.Equal(t, []string{"service1"}, ids)
+
+	res := &inventoryv1.AddServiceResponse{
+		Service: &inventoryv1.AddServiceResponse_Mysql{
+			Mysql: &inventoryv1.MySQLService{ServiceId: "service1", NodeId: "node1"},
+		},
+	}
+	ids = targetIDs(res, ids)
+	assert.Equal(t, []string{"service1", "node1"}, ids)
+
+	assert.Empty(t, targetIDs(&inventoryv1.RemoveServiceRequest{}, nil))
+}
+
+func TestUser(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Normal", func(t *testing.T) {
+		t.Parallel()
+
+		expected := &User{ID: 3, Login: "jane", Role: "Admin"}
+		v, err := EncodeUser(expected)
+		require.NoError(t, err)
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(UserHeaderName, v))
+		assert.Equal(t, expected, UserFromContext(ctx))
+	})
+
+	t.Run("Internal", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, &User{}, UserFromContext(context.Background()))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(UserHeaderName, "not base64!"))
+		assert.Equal(t, &User{}, UserFromContext(ctx))
+	})
+}
@@ -42,10 +42,18 @@ type Service struct {
 	agentService         agentService
 	compatibilityService compatibilityService
 	pbmPITRService       pbmPITRService
+	mysqlPITRService     mysqlPITRService
 }
 
 // NewService creates new backups logic service.
-func NewService(db *reform.DB, jobsService jobsService, agentService agentService, cSvc compatibilityService, pbmPITRService pbmPITRService) *Service {
+func NewService(
+	db *reform.DB,
+	jobsService jobsService,
+	agentService agentService,
+	cSvc compatibilityService,
+	pbmPITRService pbmPITRService,
+	mysqlPITRService mysqlPITRService,
+) *Service {
 	return &Service{
 		l:                    logrus.WithField("component", "management/backup/backup"),
 		db:                   db,
@@ -53,6 +61,7 @@ func NewService(db *reform.DB, jobsService jobsService, agentService agentServic
 		agentService:         agentService,
 		compatibilityService: cSvc,
 		pbmPITRService:       pbmPITRService,
+		mysqlPITRService:     mysqlPITRService,
 	}
 }
 
@@ -121,8 +130,21 @@ func (s *Service) PerformBackup(ctx context.Context, params PerformBackupParams)
 					return fmt.Errorf("the only supported location type for mySQL is S3: %w", ErrIncompatibleLocationType)
 				}
 
-				if params.Mode != models.Snapshot {
-					return errors.New("the only supported backup mode for mySQL is snapshot")
+				if params.Mode != models.Snapshot && params.Mode != models.PITR {
+					return errors.New("the only supported backups mode for mySQL is snapshot and PITR")
+				}
+
+				err = services.CheckMySQLBackupPreconditions(tx.Querier, params.Mode, svc.ServiceID, params.ScheduleID)
+				if err != nil {
+					return err
+				}
+
+				// For PITR backups reuse existing artifact if it's present.
+				if params.Mode == models.PITR {
+					artifact, err = models.FindArtifactByName(tx.Querier, name)
+					if err != nil && !errors.Is(err, models.ErrNotFound) {
+						return err
+					}
 				}
 			case models.MongoDBServiceType:
 				jobType = models.MongoDBBackupJob
@@ -148,8 +170,18 @@ func (s *Service) PerformBackup(ctx context.Context, params PerformBackupParams)
 					}
 				}
 
-			case models.PostgreSQLServiceType,
-				models.ProxySQLServiceType,
+			case models.PostgreSQLServiceType:
+				jobType = models.PostgreSQLBackupJob
+
+				if params.DataModel != models.PhysicalDataModel && params.DataModel != models.LogicalDataModel {
+					return fmt.Errorf("the only supported data models for PostgreSQL are physical and logical: %w", ErrIncompatibleDataModel)
+				}
+
+				if params.Mode != models.Snapshot {
+					return errors.New("the only supported backup mode for PostgreSQL is snapshot")
+				}
+
+			case models.ProxySQLServiceType,
 				models.HAProxyServiceType,
 				models.ExternalServiceType:
 				return status.Errorf(codes.Unimplemented, "Unimplemented service: %s", svc.ServiceType)
@@ -223,12 +255,19 @@ func (s *Service) PerformBackup(ctx context.Context, params PerformBackupParams)
 
 	switch svc.ServiceType {
 	case models.MySQLServiceType:
-		err = s.jobsService.StartMySQLBackupJob(job.ID, job.PMMAgentID, 0, name, dbConfig, locationConfig, params.Folder)
+		err = s.jobsService.StartMySQLBackupJob(job.ID, job.PMMAgentID, 0, name, job.Data.MySQLBackup.Mode, dbConfig,
+			locationConfig, params.Folder)
+		if err == nil && params.Mode == models.PITR {
+			// Every snapshot of PITR artifact is accompanied by binary logs streamed since it was taken.
+			err = s.startMySQLBinlogStreaming(ctx, artifact, job, dbConfig, locationConfig)
+		}
 	case models.MongoDBServiceType:
 		err = s.jobsService.StartMongoDBBackupJob(svc, job.ID, job.PMMAgentID, 0, name,
 			job.Data.MongoDBBackup.Mode, job.Data.MongoDBBackup.DataModel, locationConfig, params.Folder)
-	case models.PostgreSQLServiceType,
-		models.ProxySQLServiceType,
+	case models.PostgreSQLServiceType:
+		err = s.jobsService.StartPostgreSQLBackupJob(svc, job.ID, job.PMMAgentID, 0, name,
+			job.Data.PostgreSQLBackup.DataModel, locationConfig, params.Folder)
+	case models.ProxySQLServiceType,
 		models.HAProxyServiceType,
 		models.ExternalServiceType:
 		err = status.Errorf(codes.Unimplemented, "Unimplemented service: %s", svc.ServiceType)
@@ -253,6 +292,85 @@ func (s *Service) PerformBackup(ctx context.Context, params PerformBackupParams)
 	return artifact.ID, nil
 }
 
+// startMySQLBinlogStreaming (re)starts streaming of binary logs of the artifact's service to the artifact folder.
+// The streaming job runs on the same pmm-agent and with the same retry policy as the given backup job.
+func (s *Service) startMySQLBinlogStreaming(
+	ctx context.Context,
+	artifact *models.Artifact,
+	backupJob *models.Job,
+	dbConfig *models.DBConfig,
+	locationConfig *models.BackupLocationConfig,
+) error {
+	// Streaming is restarted on every run to recover from connection losses unnoticed by the server.
+	err := s.StopMySQLBinlogStreaming(ctx, artifact.ServiceID)
+	if err != nil {
+		return err
+	}
+
+	job, err := models.CreateJob(s.db.Querier, models.CreateJobParams{
+		PMMAgentID: backupJob.PMMAgentID,
+		Type:       models.MySQLBinlogStreamJob,
+		Data: &models.JobData{
+			MySQLBinlogStream: &models.MySQLBinlogStreamJobData{
+				ServiceID:  artifact.ServiceID,
+				ArtifactID: artifact.ID,
+			},
+		},
+		Retries:  backupJob.Retries,
+		Interval: backupJob.Interval,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.jobsService.StartMySQLBinlogStreamJob(job.ID, job.PMMAgentID, dbConfig, locationConfig, artifact.MySQLBinlogFolder())
+	if err != nil {
+		// The job has never been started, so nobody else would finish it.
+		job.Done = true
+		job.Error = err.Error()
+		if updateErr := s.db.Update(job); updateErr != nil {
+			s.l.WithError(updateErr).Errorf("failed to update job %s", job.ID)
+		}
+		return fmt.Errorf("failed to start binary logs streaming: %w", err)
+	}
+
+	return nil
+}
+
+// StopMySQLBinlogStreaming stops streaming of binary logs of MySQL service with given serviceID.
+func (s *Service) StopMySQLBinlogStreaming(_ context.Context, serviceID string) error {
+	jobs, err := models.FindJobs(s.db.Querier, models.JobsFilter{
+		Types: []models.JobType{models.MySQLBinlogStreamJob},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, job := range jobs {
+		if job.Done || job.Data.MySQLBinlogStream.ServiceID != serviceID {
+			continue
+		}
+
+		// Stopped streaming should not be restarted on error.
+		job.Retries = 0
+		if err = s.db.Update(job); err != nil {
+			return err
+		}
+
+		if err = s.jobsService.StopJob(job.ID); err != nil {
+			s.l.WithError(err).Warnf("failed to stop binary logs streaming job %s", job.ID)
+		}
+
+		// The pmm-agent may be disconnected and never report the job result.
+		job.Done = true
+		if err = s.db.Update(job); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 type restoreJobParams struct {
 	JobID         string
 	Service       *models.Service
@@ -264,6 +382,7 @@ type restoreJobParams struct {
 	DataModel     models.DataModel
 	PITRTimestamp time.Time
 	Folder        string
+	BinlogFolder  string
 }
 
 // RestoreBackup starts restore backup job.
@@ -346,8 +465,16 @@ func (s *Service) RestoreBackup(ctx context.Context, serviceID, artifactID strin
 					DataModel: artifact.DataModel,
 				},
 			}
-		case models.PostgreSQLServiceType,
-			models.ProxySQLServiceType,
+		case models.PostgreSQLServiceType:
+			jobType = models.PostgreSQLRestoreBackupJob
+			jobData = &models.JobData{
+				PostgreSQLRestoreBackup: &models.PostgreSQLRestoreBackupJobData{
+					ServiceID: serviceID,
+					RestoreID: restoreID,
+					DataModel: artifact.DataModel,
+				},
+			}
+		case models.ProxySQLServiceType,
 			models.HAProxyServiceType,
 			models.ExternalServiceType:
 			return fmt.Errorf("backup restore unimplemented for service type: %s", service.ServiceType)
@@ -383,6 +510,15 @@ func (s *Service) RestoreBackup(ctx context.Context, serviceID, artifactID strin
 			Folder:        artifactFolder,
 		}
 
+		if service.ServiceType == models.MySQLServiceType && artifact.Mode == models.PITR {
+			snapshot, err := mysqlPITRSnapshot(artifact, pitrTimestamp)
+			if err != nil {
+				return err
+			}
+			params.ArtifactName = snapshot.FileList[0].Name
+			params.BinlogFolder = artifact.MySQLBinlogFolder()
+		}
+
 		if len(artifact.MetadataList) != 0 &&
 			artifact.MetadataList[0].BackupToolData != nil &&
 			artifact.MetadataList[0].BackupToolData.PbmMetadata != nil {
@@ -395,6 +531,14 @@ func (s *Service) RestoreBackup(ctx context.Context, serviceID, artifactID strin
 		return "", errTx
 	}
 
+	if params.Service.ServiceType == models.MySQLServiceType {
+		// Binary logs of the restored server don't belong to the artifacts taken before the restore.
+		err = s.StopMySQLBinlogStreaming(ctx, serviceID)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	err = s.startRestoreJob(&params)
 	if err != nil {
 		return "", err
@@ -403,6 +547,163 @@ func (s *Service) RestoreBackup(ctx context.Context, serviceID, artifactID strin
 	return restoreID, nil
 }
 
+// mysqlPITRSnapshot returns the latest snapshot of MySQL PITR artifact taken before the given time.
+func mysqlPITRSnapshot(artifact *models.Artifact, pitrTimestamp time.Time) (*models.Metadata, error) {
+	var res *models.Metadata
+	for i, metadata := range artifact.MetadataList {
+		if metadata.RestoreTo == nil || len(metadata.FileList) == 0 || metadata.RestoreTo.After(pitrTimestamp) {
+			continue
+		}
+		if res == nil || metadata.RestoreTo.After(*res.RestoreTo) {
+			res = &artifact.MetadataList[i]
+		}
+	}
+
+	if res == nil {
+		return nil, fmt.Errorf("no snapshot taken before %s: %w", pitrTimestamp, ErrTimestampOutOfRange)
+	}
+
+	return res, nil
+}
+
+// VerifyArtifact starts verification job for the given artifact. Verification runs on the pmm-agent
+// of the service the artifact was taken from. Its result is stored in the artifact verification status.
+func (s *Service) VerifyArtifact(ctx context.Context, artifactID string) error {
+	var jobID, pmmAgentID, pbmBackupName string
+	var artifact *models.Artifact
+	var locationConfig *models.BackupLocationConfig
+	errTx := s.db.InTransactionContext(ctx, nil, func(tx *reform.TX) error {
+		var err error
+		artifact, err = models.FindArtifactByID(tx.Querier, artifactID)
+		if err != nil {
+			return err
+		}
+
+		err = checkArtifactVerifiable(artifact)
+		if err != nil {
+			return err
+		}
+
+		if artifact.ServiceID == "" {
+			return fmt.Errorf("service of artifact %q no longer exists: %w", artifactID, ErrIncompatibleService)
+		}
+
+		pmmAgents, err := models.FindPMMAgentsForService(tx.Querier, artifact.ServiceID)
+		if err != nil {
+			return err
+		}
+		if len(pmmAgents) == 0 {
+			return fmt.Errorf("cannot find pmm agent for service %s", artifact.ServiceID)
+		}
+		pmmAgentID = pmmAgents[0].AgentID
+
+		location, err := models.FindBackupLocationByID(tx.Querier, artifact.LocationID)
+		if err != nil {
+			return err
+		}
+		locationConfig = &models.BackupLocationConfig{
+			FilesystemConfig: location.FilesystemConfig,
+			S3Config:         location.S3Config,
+		}
+
+		var jobType models.JobType
+		var jobData *models.JobData
+		switch models.ServiceType(artifact.Vendor) {
+		case models.MySQLServiceType:
+			jobType = models.MySQLVerifyBackupJob
+			jobData = &models.JobData{
+				MySQLVerifyBackup: &models.MySQLVerifyBackupJobData{
+					ServiceID:  artifact.ServiceID,
+					ArtifactID: artifact.ID,
+				},
+			}
+		case models.MongoDBServiceType:
+			jobType = models.MongoDBVerifyBackupJob
+			jobData = &models.JobData{
+				MongoDBVerifyBackup: &models.MongoDBVerifyBackupJobData{
+					ServiceID:  artifact.ServiceID,
+					ArtifactID: artifact.ID,
+				},
+			}
+			pbmBackupName = artifact.MetadataList[0].BackupToolData.PbmMetadata.Name
+		default:
+			return fmt.Errorf("verification of %s artifacts is not supported: %w", artifact.Vendor, ErrIncompatibleService)
+		}
+
+		job, err := models.CreateJob(tx.Querier, models.CreateJobParams{
+			PMMAgentID: pmmAgentID,
+			Type:       jobType,
+			Data:       jobData,
+		})
+		if err != nil {
+			return err
+		}
+		jobID = job.ID
+
+		_, err = models.UpdateArtifact(tx.Querier, artifact.ID, models.UpdateArtifactParams{
+			VerificationStatus: models.PendingVerificationStatus.Pointer(),
+		})
+		return err
+	})
+	if errTx != nil {
+		return errTx
+	}
+
+	var err error
+	switch models.ServiceType(artifact.Vendor) { //nolint:exhaustive
+	case models.MySQLServiceType:
+		err = s.jobsService.StartMySQLVerifyBackupJob(jobID, pmmAgentID, 0, artifact.Name, locationConfig, artifact.Folder)
+	case models.MongoDBServiceType:
+		err = s.jobsService.StartMongoDBVerifyBackupJob(jobID, pmmAgentID, 0, artifact.Name, pbmBackupName, locationConfig, artifact.Folder)
+	}
+	if err != nil {
+		// The job has never been started, so nobody else would finish verification.
+		_, updateErr := models.UpdateArtifact(s.db.Querier, artifact.ID, models.UpdateArtifactParams{
+			VerificationStatus: models.FailedVerificationStatus.Pointer(),
+			LastVerifiedAt:     new(models.Now()),
+		})
+		if updateErr != nil {
+			s.l.WithError(updateErr).Errorf("failed to update verification status of artifact %s", artifact.ID)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// checkArtifactVerifiable checks that artifact can be verified.
+func checkArtifactVerifiable(artifact *models.Artifact) error {
+	if artifact.Status != models.SuccessBackupStatus {
+		return fmt.Errorf("artifact %q in status: %q: %w", artifact.ID, artifact.Status, ErrArtifactNotReady)
+	}
+
+	if artifact.VerificationStatus == models.PendingVerificationStatus {
+		return fmt.Errorf("artifact %q is already being verified: %w", artifact.ID, ErrAnotherOperationInProgress)
+	}
+
+	if artifact.Mode != models.Snapshot {
+		return fmt.Errorf("only snapshot artifacts can be verified: %w", ErrIncompatibleArtifactMode)
+	}
+
+	if artifact.IsShardedCluster {
+		return fmt.Errorf("artifact %q was made for a sharded cluster and cannot be verified: %w", artifact.ID, ErrIncompatibleService)
+	}
+
+	// Artifacts taken with old agents don't contain metadata required for verification.
+	if len(artifact.MetadataList) == 0 {
+		return fmt.Errorf("artifact %q has no metadata and cannot be verified: %w", artifact.ID, ErrIncompatibleArtifactMode)
+	}
+
+	if models.ServiceType(artifact.Vendor) == models.MongoDBServiceType {
+		toolData := artifact.MetadataList[0].BackupToolData
+		if toolData == nil || toolData.PbmMetadata == nil || toolData.PbmMetadata.Name == "" {
+			return fmt.Errorf("artifact %q has no PBM metadata and cannot be verified: %w", artifact.ID, ErrIncompatibleArtifactMode)
+		}
+	}
+
+	return nil
+}
+
 // SwitchMongoPITR switches Point-in-Time recovery feature for mongoDB with given serviceID.
 func (s *Service) SwitchMongoPITR(ctx context.Context, serviceID string, enabled bool) error {
 	var pmmAgentID, dsn string
@@ -445,6 +746,7 @@ func (s *Service) SwitchMongoPITR(ctx context.Context, serviceID string, enabled
 		dsn,
 		agent.Files(),
 		agent.TemplateDelimiters(service),
+		agent.Secrets(),
 		enabled,
 	)
 }
@@ -465,6 +767,9 @@ func (s *Service) startRestoreJob(params *restoreJobParams) error {
 			params.ArtifactName,
 			locationConfig,
 			params.Folder,
+			params.PITRTimestamp,
+			params.BinlogFolder,
+			params.DBConfig,
 		)
 	case models.MongoDBServiceType:
 		return s.jobsService.StartMongoDBRestoreBackupJob(
@@ -479,8 +784,18 @@ func (s *Service) startRestoreJob(params *restoreJobParams) error {
 			params.PITRTimestamp,
 			params.Folder,
 		)
-	case models.PostgreSQLServiceType,
-		models.ProxySQLServiceType,
+	case models.PostgreSQLServiceType:
+		return s.jobsService.StartPostgreSQLRestoreBackupJob(
+			params.Service,
+			params.JobID,
+			params.AgentID,
+			0,
+			params.ArtifactName,
+			params.DataModel,
+			locationConfig,
+			params.Folder,
+		)
+	case models.ProxySQLServiceType,
 		models.HAProxyServiceType,
 		models.ExternalServiceType:
 		return status.Errorf(codes.Unimplemented, "Unimplemented service: %s", params.Service.ServiceType)
@@ -520,6 +835,7 @@ func (s *Service) prepareBackupJob(
 			MySQLBackup: &models.MySQLBackupJobData{
 				ServiceID:  service.ServiceID,
 				ArtifactID: artifactID,
+				Mode:       mode,
 			},
 		}
 	case models.MongoDBBackupJob:
@@ -531,8 +847,20 @@ func (s *Service) prepareBackupJob(
 				DataModel:  dataModel,
 			},
 		}
+	case models.PostgreSQLBackupJob:
+		jobData = &models.JobData{
+			PostgreSQLBackup: &models.PostgreSQLBackupJobData{
+				ServiceID:  service.ServiceID,
+				ArtifactID: artifactID,
+				DataModel:  dataModel,
+			},
+		}
 	case models.MySQLRestoreBackupJob,
-		models.MongoDBRestoreBackupJob:
+		models.MongoDBRestoreBackupJob,
+		models.PostgreSQLRestoreBackupJob,
+		models.MySQLVerifyBackupJob,
+		models.MongoDBVerifyBackupJob,
+		models.MySQLBinlogStreamJob:
 		return nil, nil, fmt.Errorf("%s is not a backup job type", jobType)
 	default:
 		return nil, nil, fmt.Errorf("unsupported backup job type: %s", jobType)
@@ -588,7 +916,13 @@ func (s *Service) checkArtifactModePreconditions(ctx context.Context, artifactID
 	}
 
 	storage := GetStorageForLocation(location)
-	timeRanges, err := s.pbmPITRService.ListPITRTimeranges(ctx, storage, location, artifact)
+	var timeRanges []Timeline
+	switch models.ServiceType(artifact.Vendor) { //nolint:exhaustive
+	case models.MySQLServiceType:
+		timeRanges, err = s.mysqlPITRService.ListPITRTimeranges(ctx, storage, location, artifact)
+	default:
+		timeRanges, err = s.pbmPITRService.ListPITRTimeranges(ctx, storage, location, artifact)
+	}
 	if err != nil {
 		return err
 	}
@@ -604,15 +938,16 @@ func (s *Service) checkArtifactModePreconditions(ctx context.Context, artifactID
 
 // checkArtifactMode crosschecks artifact params and requested restore mode.
 func checkArtifactMode(artifact *models.Artifact, pitrTimestamp time.Time) error {
-	if artifact.Vendor != string(models.MongoDBServiceType) && artifact.Mode == models.PITR {
-		return fmt.Errorf("restore to point in time is only available for MongoDB: %w", ErrIncompatibleService)
+	if artifact.Vendor != string(models.MongoDBServiceType) && artifact.Vendor != string(models.MySQLServiceType) &&
+		artifact.Mode == models.PITR {
+		return fmt.Errorf("restore to point in time is only available for MySQL and MongoDB: %w", ErrIncompatibleService)
 	}
 
 	if artifact.Mode == models.PITR {
 		if pitrTimestamp.Unix() == 0 {
 			return fmt.Errorf("artifact of type '%s' requires 'time' parameter to be restored to: %w", artifact.Mode, ErrIncompatibleArtifactMode)
 		}
-		if artifact.DataModel == models.PhysicalDataModel {
+		if artifact.Vendor == string(models.MongoDBServiceType) && artifact.DataModel == models.PhysicalDataModel {
 			return fmt.Errorf("point in time recovery is only available for Logical data model: %w", ErrIncompatibleArtifactMode)
 		}
 	} else if pitrTimestamp.Unix() != 0 {
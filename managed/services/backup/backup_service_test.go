@@ -78,7 +78,7 @@ func TestPerformBackup(t *testing.T) {
 	mockedJobsService := &mockJobsService{}
 	mockedAgentService := &mockAgentService{}
 	mockedCompatibilityService := &mockCompatibilityService{}
-	backupService := NewService(db, mockedJobsService, mockedAgentService, mockedCompatibilityService, nil)
+	backupService := NewService(db, mockedJobsService, mockedAgentService, mockedCompatibilityService, nil, nil)
 
 	s3Location, err := models.CreateBackupLocation(db.Querier, models.CreateBackupLocationParams{
 		Name:        "Test s3 location",
@@ -161,7 +161,7 @@ func TestPerformBackup(t *testing.T) {
 						S3Config:         tc.locationModel.S3Config,
 					}
 					mockedJobsService.On("StartMySQLBackupJob", mock.Anything, pointer.GetString(agent.PMMAgentID), time.Duration(0),
-						mock.Anything, mock.Anything, locationConfig, "artifact_folder").Return(nil).Once()
+						mock.Anything, models.Snapshot, mock.Anything, locationConfig, "artifact_folder").Return(nil).Once()
 				}
 
 				artifactID, err := backupService.PerformBackup(ctx, PerformBackupParams{
@@ -252,7 +252,7 @@ func TestRestoreBackup(t *testing.T) {
 	mockedJobsService := &mockJobsService{}
 	mockedAgentService := &mockAgentService{}
 	mockedCompatibilityService := &mockCompatibilityService{}
-	backupService := NewService(db, mockedJobsService, mockedAgentService, mockedCompatibilityService, nil)
+	backupService := NewService(db, mockedJobsService, mockedAgentService, mockedCompatibilityService, nil, nil)
 
 	artifactFolder := "artifact_folder"
 
@@ -325,7 +325,8 @@ func TestRestoreBackup(t *testing.T) {
 
 				if tc.expectedError == nil {
 					mockedJobsService.On("StartMySQLRestoreBackupJob", mock.Anything, pointer.GetString(agent.PMMAgentID),
-						pointer.GetString(agent.ServiceID), mock.Anything, artifact.Name, mock.Anything, artifactFolder).Return(nil).Once()
+						pointer.GetString(agent.ServiceID), mock.Anything, artifact.Name, mock.Anything, artifactFolder,
+						time.Unix(0, 0), "", mock.Anything).Return(nil).Once()
 				}
 				restoreID, err := backupService.RestoreBackup(ctx, pointer.GetString(agent.ServiceID), artifact.ID, time.Unix(0, 0))
 				if tc.expectedError != nil {
@@ -488,7 +489,8 @@ func TestCheckArtifactModePreconditions(t *testing.T) {
 
 	db := reform.NewDB(sqlDB, postgresql.Dialect, reform.NewPrintfLogger(t.Logf))
 	mockedPbmPITRService := &mockPbmPITRService{}
-	backupService := NewService(db, nil, nil, nil, mockedPbmPITRService)
+	mockedMySQLPITRService := &mockMysqlPITRService{}
+	backupService := NewService(db, nil, nil, nil, mockedPbmPITRService, mockedMySQLPITRService)
 
 	locationRes, err := models.CreateBackupLocation(db.Querier, models.CreateBackupLocationParams{
 		Name:        "Test location",
@@ -508,9 +510,17 @@ func TestCheckArtifactModePreconditions(t *testing.T) {
 	t.Run("mysql", func(t *testing.T) {
 		agent, _ := setup(t, db.Querier, models.MySQLServiceType, "test-mysql-restore-service")
 
+		rangeStart := uint32(time.Now().Unix())
+		rangeEnd := rangeStart + (60 * 60 * 3) // plus 3 hours
+
+		timelineList := []Timeline{
+			{Start: rangeStart, End: rangeEnd},
+		}
+
 		for _, tc := range []struct {
 			name           string
 			pitrValue      time.Time
+			prepareMock    bool
 			artifactParams models.CreateArtifactParams
 			err            error
 		}{
@@ -530,7 +540,7 @@ func TestCheckArtifactModePreconditions(t *testing.T) {
 				err: nil,
 			},
 			{
-				name:      "PITR not supported for MySQL",
+				name:      "timestamp not provided for pitr artifact",
 				pitrValue: time.Unix(0, 0),
 				artifactParams: models.CreateArtifactParams{
 					Name:       "mysql-artifact-name-2",
@@ -542,7 +552,7 @@ func TestCheckArtifactModePreconditions(t *testing.T) {
 					Mode:       models.PITR,
 					Status:     models.SuccessBackupStatus,
 				},
-				err: ErrIncompatibleService,
+				err: ErrIncompatibleArtifactMode,
 			},
 			{
 				name:      "snapshot artifact is not compatible with non-empty pitr date",
@@ -559,11 +569,47 @@ func TestCheckArtifactModePreconditions(t *testing.T) {
 				},
 				err: ErrIncompatibleArtifactMode,
 			},
+			{
+				name:        "pitr timestamp out of range",
+				pitrValue:   time.Unix(int64(rangeStart)-1, 0),
+				prepareMock: true,
+				artifactParams: models.CreateArtifactParams{
+					Name:       "mysql-artifact-name-4",
+					Vendor:     string(models.MySQLServiceType),
+					DBVersion:  "8.0.25",
+					LocationID: locationRes.ID,
+					ServiceID:  *agent.ServiceID,
+					DataModel:  models.PhysicalDataModel,
+					Mode:       models.PITR,
+					Status:     models.SuccessBackupStatus,
+				},
+				err: ErrTimestampOutOfRange,
+			},
+			{
+				name:        "success pitr timestamp inside the range",
+				pitrValue:   time.Unix(int64(rangeStart)+1, 0),
+				prepareMock: true,
+				artifactParams: models.CreateArtifactParams{
+					Name:       "mysql-artifact-name-5",
+					Vendor:     string(models.MySQLServiceType),
+					DBVersion:  "8.0.25",
+					LocationID: locationRes.ID,
+					ServiceID:  *agent.ServiceID,
+					DataModel:  models.PhysicalDataModel,
+					Mode:       models.PITR,
+					Status:     models.SuccessBackupStatus,
+				},
+				err: nil,
+			},
 		} {
 			t.Run(tc.name, func(t *testing.T) {
 				artifact, err := models.CreateArtifact(db.Querier, tc.artifactParams)
 				require.NoError(t, err)
 
+				if tc.prepareMock {
+					mockedMySQLPITRService.On("ListPITRTimeranges", ctx, mock.Anything, locationRes, artifact).Return(timelineList, nil).Once()
+				}
+
 				err = backupService.checkArtifactModePreconditions(ctx, artifact.ID, tc.pitrValue)
 				if tc.err == nil {
 					require.NoError(t, err)
@@ -715,7 +761,7 @@ func TestCheckArtifactModePreconditions(t *testing.T) {
 		}
 	})
 
-	mock.AssertExpectationsForObjects(t, mockedPbmPITRService)
+	mock.AssertExpectationsForObjects(t, mockedPbmPITRService, mockedMySQLPITRService)
 }
 
 func TestInTimeSpan(t *testing.T) {
@@ -762,3 +808,107 @@ func TestInTimeSpan(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckArtifactVerifiable(t *testing.T) {
+	mysqlMetadata := models.MetadataList{{FileList: []models.File{{Name: "artifact", IsDirectory: true}}}}
+	mongoMetadata := models.MetadataList{{
+		FileList:       []models.File{{Name: "2026-01-01T00:00:00Z", IsDirectory: true}},
+		BackupToolData: &models.BackupToolData{PbmMetadata: &models.PbmMetadata{Name: "2026-01-01T00:00:00Z"}},
+	}}
+
+	for _, tc := range []struct {
+		name     string
+		artifact models.Artifact
+		err      error
+	}{
+		{
+			name: "mysql",
+			artifact: models.Artifact{
+				Vendor:       string(models.MySQLServiceType),
+				Status:       models.SuccessBackupStatus,
+				Mode:         models.Snapshot,
+				MetadataList: mysqlMetadata,
+			},
+		},
+		{
+			name: "mongodb verified before",
+			artifact: models.Artifact{
+				Vendor:             string(models.MongoDBServiceType),
+				Status:             models.SuccessBackupStatus,
+				Mode:               models.Snapshot,
+				MetadataList:       mongoMetadata,
+				VerificationStatus: models.FailedVerificationStatus,
+			},
+		},
+		{
+			name: "not finished",
+			artifact: models.Artifact{
+				Vendor:       string(models.MySQLServiceType),
+				Status:       models.InProgressBackupStatus,
+				Mode:         models.Snapshot,
+				MetadataList: mysqlMetadata,
+			},
+			err: ErrArtifactNotReady,
+		},
+		{
+			name: "verification in progress",
+			artifact: models.Artifact{
+				Vendor:             string(models.MySQLServiceType),
+				Status:             models.SuccessBackupStatus,
+				Mode:               models.Snapshot,
+				MetadataList:       mysqlMetadata,
+				VerificationStatus: models.PendingVerificationStatus,
+			},
+			err: ErrAnotherOperationInProgress,
+		},
+		{
+			name: "pitr",
+			artifact: models.Artifact{
+				Vendor:       string(models.MongoDBServiceType),
+				Status:       models.SuccessBackupStatus,
+				Mode:         models.PITR,
+				MetadataList: mongoMetadata,
+			},
+			err: ErrIncompatibleArtifactMode,
+		},
+		{
+			name: "sharded cluster",
+			artifact: models.Artifact{
+				Vendor:           string(models.MongoDBServiceType),
+				Status:           models.SuccessBackupStatus,
+				Mode:             models.Snapshot,
+				MetadataList:     mongoMetadata,
+				IsShardedCluster: true,
+			},
+			err: ErrIncompatibleService,
+		},
+		{
+			name: "no metadata",
+			artifact: models.Artifact{
+				Vendor: string(models.MySQLServiceType),
+				Status: models.SuccessBackupStatus,
+				Mode:   models.Snapshot,
+			},
+			err: ErrIncompatibleArtifactMode,
+		},
+		{
+			name: "mongodb without pbm metadata",
+			artifact: models.Artifact{
+				Vendor:       string(models.MongoDBServiceType),
+				Status:       models.SuccessBackupStatus,
+				Mode:         models.Snapshot,
+				MetadataList: mysqlMetadata,
+			},
+			err: ErrIncompatibleArtifactMode,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkArtifactVerifiable(&tc.artifact)
+			if tc.err == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorIs(t, err, tc.err)
+		})
+	}
+}
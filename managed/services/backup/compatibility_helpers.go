@@ -17,6 +17,7 @@ package backup
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/hashicorp/go-version"
 	"google.golang.org/grpc/codes"
@@ -283,9 +284,9 @@ func vendorToServiceType(vendor string) (models.ServiceType, error) {
 	serviceType := models.ServiceType(vendor)
 	switch serviceType {
 	case models.MySQLServiceType,
-		models.MongoDBServiceType:
-	case models.PostgreSQLServiceType,
-		models.ProxySQLServiceType,
+		models.MongoDBServiceType,
+		models.PostgreSQLServiceType:
+	case models.ProxySQLServiceType,
 		models.HAProxyServiceType,
 		models.ExternalServiceType:
 		return "", status.Errorf(codes.Unimplemented, "unimplemented service type: %s", serviceType)
@@ -356,6 +357,57 @@ func mongoDBBackupSoftwareInstalledAndCompatible(svm map[models.SoftwareName]str
 	return nil
 }
 
+// postgreSQLBackupSoftwareInstalledAndCompatible checks that PostgreSQL client tools are installed
+// and their major version is not older than the PostgreSQL server major version.
+func postgreSQLBackupSoftwareInstalledAndCompatible(svm map[models.SoftwareName]string) error {
+	if svm[models.PostgreSQLSoftwareName] == "" {
+		return fmt.Errorf("postgresql server version is unknown: %w", ErrComparisonImpossible)
+	}
+
+	serverVersion, err := version.NewVersion(svm[models.PostgreSQLSoftwareName])
+	if err != nil {
+		return err
+	}
+
+	for _, name := range []models.SoftwareName{
+		models.PGBasebackupSoftwareName,
+		models.PGDumpSoftwareName,
+		models.PGRestoreSoftwareName,
+	} {
+		if svm[name] == "" {
+			return fmt.Errorf("software %q is not installed: %w", name, ErrIncompatibleService)
+		}
+
+		toolVersion, err := version.NewVersion(svm[name])
+		if err != nil {
+			return err
+		}
+
+		// PostgreSQL client tools support servers of the same or older major versions only.
+		if toolVersion.Segments()[0] < serverVersion.Segments()[0] {
+			return fmt.Errorf("software %q version %q is older than postgresql server version %q: %w",
+				name, svm[name], svm[models.PostgreSQLSoftwareName], ErrIncompatibleService)
+		}
+	}
+
+	return nil
+}
+
+// postgreSQLVersion returns PostgreSQL server version of the service without the build details,
+// i.e. "16.2" for "16.2 (Ubuntu 16.2-1.pgdg22.04+1)".
+func postgreSQLVersion(service *models.Service) string {
+	if service.Version == nil {
+		return ""
+	}
+
+	fields := strings.Fields(*service.Version)
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return fields[0]
+}
+
 // isOnlySameService checks if restore is only available to the same service.
 func isOnlySameService(artifactDBVersion string) bool {
 	// allow restore only to the same service if db version is unknown.
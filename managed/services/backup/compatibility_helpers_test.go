@@ -552,3 +552,77 @@ func TestMongoDBBackupSoftwareInstalledAndCompatible(t *testing.T) {
 		})
 	}
 }
+
+func TestPostgreSQLBackupSoftwareInstalledAndCompatible(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		input map[models.SoftwareName]string
+		err   error
+	}{
+		{
+			name: "successful",
+			input: map[models.SoftwareName]string{
+				models.SoftwareName("postgresql"):    "16.2",
+				models.SoftwareName("pg_basebackup"): "16.4",
+				models.SoftwareName("pg_dump"):       "16.4",
+				models.SoftwareName("pg_restore"):    "16.4",
+			},
+			err: nil,
+		},
+		{
+			name: "newer client tools",
+			input: map[models.SoftwareName]string{
+				models.SoftwareName("postgresql"):    "15.8",
+				models.SoftwareName("pg_basebackup"): "17.0",
+				models.SoftwareName("pg_dump"):       "17.0",
+				models.SoftwareName("pg_restore"):    "17.0",
+			},
+			err: nil,
+		},
+		{
+			name: "older client tools",
+			input: map[models.SoftwareName]string{
+				models.SoftwareName("postgresql"):    "17.0",
+				models.SoftwareName("pg_basebackup"): "17.0",
+				models.SoftwareName("pg_dump"):       "16.4",
+				models.SoftwareName("pg_restore"):    "17.0",
+			},
+			err: fmt.Errorf("software %q version %q is older than postgresql server version %q: %w", "pg_dump", "16.4", "17.0", ErrIncompatibleService),
+		},
+		{
+			name: "pg_restore not installed",
+			input: map[models.SoftwareName]string{
+				models.SoftwareName("postgresql"):    "16.2",
+				models.SoftwareName("pg_basebackup"): "16.4",
+				models.SoftwareName("pg_dump"):       "16.4",
+				models.SoftwareName("pg_restore"):    "",
+			},
+			err: fmt.Errorf("software %q is not installed: %w", "pg_restore", ErrIncompatibleService),
+		},
+		{
+			name: "unknown server version",
+			input: map[models.SoftwareName]string{
+				models.SoftwareName("pg_basebackup"): "16.4",
+				models.SoftwareName("pg_dump"):       "16.4",
+				models.SoftwareName("pg_restore"):    "16.4",
+			},
+			err: fmt.Errorf("postgresql server version is unknown: %w", ErrComparisonImpossible),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := postgreSQLBackupSoftwareInstalledAndCompatible(test.input)
+			if test.err != nil {
+				require.Error(t, err)
+				require.Equal(t, test.err.Error(), err.Error())
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestPostgreSQLVersion(t *testing.T) {
+	assert.Empty(t, postgreSQLVersion(&models.Service{}))
+	assert.Equal(t, "16.2", postgreSQLVersion(&models.Service{Version: new("16.2 (Ubuntu 16.2-1.pgdg22.04+1)")}))
+	assert.Equal(t, "17.0", postgreSQLVersion(&models.Service{Version: new("17.0")}))
+}
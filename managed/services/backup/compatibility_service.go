@@ -31,6 +31,9 @@ import (
 // pmmAgentMinVersionForMongoBackupSoftwareCheck minimum agent version for getting required backup software versions.
 var pmmAgentMinVersionForMongoBackupSoftwareCheck = version.Must(version.NewVersion("2.35.0-0"))
 
+// pmmAgentMinVersionForPostgreSQLBackupSoftwareCheck minimum agent version for getting PostgreSQL client tools versions.
+var pmmAgentMinVersionForPostgreSQLBackupSoftwareCheck = version.Must(version.NewVersion("3.10.0-0"))
+
 // CompatibilityService is responsible for checking software and artifacts compatibility during backup and restore.
 type CompatibilityService struct {
 	db *reform.DB
@@ -74,6 +77,10 @@ func (s *CompatibilityService) checkCompatibility(serviceModel *models.Service,
 		svm[name] = svs[i].Version
 	}
 
+	if serviceModel.ServiceType == models.PostgreSQLServiceType {
+		svm[models.PostgreSQLSoftwareName] = postgreSQLVersion(serviceModel)
+	}
+
 	var binaryName models.SoftwareName
 	switch serviceModel.ServiceType {
 	case models.MySQLServiceType:
@@ -82,6 +89,9 @@ func (s *CompatibilityService) checkCompatibility(serviceModel *models.Service,
 	case models.MongoDBServiceType:
 		binaryName = models.MongoDBSoftwareName
 		err = mongoDBBackupSoftwareInstalledAndCompatible(svm)
+	case models.PostgreSQLServiceType:
+		binaryName = models.PostgreSQLSoftwareName
+		err = postgreSQLBackupSoftwareInstalledAndCompatible(svm)
 	default:
 		return "", nil
 	}
@@ -93,7 +103,12 @@ func (s *CompatibilityService) checkCompatibility(serviceModel *models.Service,
 }
 
 // findCompatibleServiceIDs looks for services compatible to artifact in given array of services' software versions.
-func (s *CompatibilityService) findCompatibleServiceIDs(artifactModel *models.Artifact, svs []*models.ServiceSoftwareVersions) []string {
+// PostgreSQL server versions are not reported by pmm-agent, so they are taken from the pgVersions map by service ID.
+func (s *CompatibilityService) findCompatibleServiceIDs(
+	artifactModel *models.Artifact,
+	svs []*models.ServiceSoftwareVersions,
+	pgVersions map[string]string,
+) []string {
 	compatibleServiceIDs := make([]string, 0, len(svs))
 	for _, sv := range svs {
 		svm := softwareVersionsToMap(sv.SoftwareVersions)
@@ -111,6 +126,11 @@ func (s *CompatibilityService) findCompatibleServiceIDs(artifactModel *models.Ar
 			serviceDBVersion = svm[models.MongoDBSoftwareName]
 			err = mongoDBBackupSoftwareInstalledAndCompatible(svm)
 
+		case "postgresql":
+			svm[models.PostgreSQLSoftwareName] = pgVersions[sv.ServiceID]
+			serviceDBVersion = svm[models.PostgreSQLSoftwareName]
+			err = postgreSQLBackupSoftwareInstalledAndCompatible(svm)
+
 		default:
 			return nil
 		}
@@ -172,6 +192,14 @@ func (s *CompatibilityService) CheckSoftwareCompatibilityForService(ctx context.
 		}
 	}
 
+	if serviceModel.ServiceType == models.PostgreSQLServiceType {
+		err := models.PMMAgentSupported(s.db.Querier, agentModel.AgentID, "get postgresql backup software versions",
+			pmmAgentMinVersionForPostgreSQLBackupSoftwareCheck)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	return s.checkCompatibility(serviceModel, agentModel)
 }
 
@@ -210,7 +238,15 @@ func (s *CompatibilityService) FindArtifactCompatibleServices(
 			return err
 		}
 
-		compatibleServiceIDs := s.findCompatibleServiceIDs(artifactModel, svs)
+		var pgVersions map[string]string
+		if serviceType == models.PostgreSQLServiceType {
+			pgVersions, err = findPostgreSQLVersions(tx.Querier, svs)
+			if err != nil {
+				return err
+			}
+		}
+
+		compatibleServiceIDs := s.findCompatibleServiceIDs(artifactModel, svs, pgVersions)
 		if len(compatibleServiceIDs) == 0 {
 			return nil
 		}
@@ -258,6 +294,8 @@ func (s *CompatibilityService) artifactCompatibility(artifactModel *models.Artif
 			err = ErrIncompatibleTargetMySQL
 		case models.MongoDBServiceType:
 			err = ErrIncompatibleTargetMongoDB
+		case models.PostgreSQLServiceType:
+			err = ErrIncompatibleTargetPostgreSQL
 		default:
 			return nil
 		}
@@ -266,3 +304,23 @@ func (s *CompatibilityService) artifactCompatibility(artifactModel *models.Artif
 
 	return nil
 }
+
+// findPostgreSQLVersions returns PostgreSQL server versions of the given services by service ID.
+func findPostgreSQLVersions(q *reform.Querier, svs []*models.ServiceSoftwareVersions) (map[string]string, error) {
+	serviceIDs := make([]string, 0, len(svs))
+	for _, sv := range svs {
+		serviceIDs = append(serviceIDs, sv.ServiceID)
+	}
+
+	services, err := models.FindServicesByIDs(q, serviceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]string, len(services))
+	for id, service := range services {
+		res[id] = postgreSQLVersion(service)
+	}
+
+	return res, nil
+}
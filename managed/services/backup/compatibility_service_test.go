@@ -50,11 +50,20 @@ func TestCheckCompatibility(t *testing.T) {
 	}
 	require.Equal(t, expectedMongoSoftware, mongoSoftware)
 
+	postgresqlSoftware := agents.GetRequiredBackupSoftwareList(models.PostgreSQLServiceType)
+	expectedPostgresqlSoftware := []agents.Software{
+		&agents.PGBasebackup{},
+		&agents.PGDump{},
+		&agents.PGRestore{},
+	}
+	require.Equal(t, expectedPostgresqlSoftware, postgresqlSoftware)
+
 	for _, tc := range []struct {
-		name          string
-		serviceType   models.ServiceType
-		versions      []agents.Version
-		expectedError error
+		name           string
+		serviceType    models.ServiceType
+		serviceVersion *string
+		versions       []agents.Version
+		expectedError  error
 	}{
 		// mysql cases
 		{
@@ -179,6 +188,39 @@ func TestCheckCompatibility(t *testing.T) {
 			},
 			expectedError: ErrIncompatibleService,
 		},
+		// postgresql cases
+		{
+			name:           "successful",
+			serviceType:    models.PostgreSQLServiceType,
+			serviceVersion: new("16.2 (Ubuntu 16.2-1.pgdg22.04+1)"),
+			versions: []agents.Version{
+				{Version: "16.4"},
+				{Version: "16.4"},
+				{Version: "16.4"},
+			},
+			expectedError: nil,
+		},
+		{
+			name:           "old client tools",
+			serviceType:    models.PostgreSQLServiceType,
+			serviceVersion: new("17.0"),
+			versions: []agents.Version{
+				{Version: "16.4"},
+				{Version: "16.4"},
+				{Version: "16.4"},
+			},
+			expectedError: ErrIncompatibleService,
+		},
+		{
+			name:        "unknown server version",
+			serviceType: models.PostgreSQLServiceType,
+			versions: []agents.Version{
+				{Version: "16.4"},
+				{Version: "16.4"},
+				{Version: "16.4"},
+			},
+			expectedError: ErrComparisonImpossible,
+		},
 	} {
 		t.Run(string(tc.serviceType)+"_"+tc.name, func(t *testing.T) {
 			t.Parallel()
@@ -188,16 +230,23 @@ func TestCheckCompatibility(t *testing.T) {
 				sw = mysqlSoftware
 			case models.MongoDBServiceType:
 				sw = mongoSoftware
+			case models.PostgreSQLServiceType:
+				sw = postgresqlSoftware
 			default: // just to satisfy linters
 			}
 			mockVersioner := mockVersioner{}
 			mockVersioner.On("GetVersions", agentModel.AgentID, sw).Return(tc.versions, nil).Once()
 			cSvc := NewCompatibilityService(nil, &mockVersioner)
-			dbVersion, err := cSvc.checkCompatibility(&models.Service{ServiceType: tc.serviceType}, &agentModel)
-			if tc.expectedError != nil {
+			service := &models.Service{ServiceType: tc.serviceType, Version: tc.serviceVersion}
+			dbVersion, err := cSvc.checkCompatibility(service, &agentModel)
+			switch {
+			case tc.expectedError != nil:
 				require.ErrorIs(t, err, tc.expectedError)
 				assert.Empty(t, dbVersion)
-			} else {
+			case tc.serviceType == models.PostgreSQLServiceType:
+				require.NoError(t, err)
+				assert.Equal(t, postgreSQLVersion(service), dbVersion)
+			default:
 				require.NoError(t, err)
 				assert.Equal(t, tc.versions[0].Version, dbVersion)
 			}
@@ -290,22 +339,22 @@ func TestFindCompatibleServiceIDs(t *testing.T) {
 
 		t.Run("empty db version", func(t *testing.T) {
 			t.Parallel()
-			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "mysql", DBVersion: ""}, testSet)
+			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "mysql", DBVersion: ""}, testSet, nil)
 			assert.Empty(t, res)
 		})
 		t.Run("matches several", func(t *testing.T) {
 			t.Parallel()
-			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "mysql", DBVersion: "8.0.25"}, testSet)
+			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "mysql", DBVersion: "8.0.25"}, testSet, nil)
 			assert.ElementsMatch(t, []string{"5", "8"}, res)
 		})
 		t.Run("matches one", func(t *testing.T) {
 			t.Parallel()
-			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "mysql", DBVersion: "8.0.24"}, testSet)
+			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "mysql", DBVersion: "8.0.24"}, testSet, nil)
 			assert.ElementsMatch(t, []string{"7"}, res)
 		})
 		t.Run("artifact version greater then existing services", func(t *testing.T) {
 			t.Parallel()
-			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "mysql", DBVersion: "8.0.30"}, testSet)
+			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "mysql", DBVersion: "8.0.30"}, testSet, nil)
 			assert.Empty(t, res)
 		})
 	})
@@ -360,20 +409,62 @@ func TestFindCompatibleServiceIDs(t *testing.T) {
 
 		t.Run("empty db version", func(t *testing.T) {
 			t.Parallel()
-			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "mongodb", DBVersion: ""}, testSet)
+			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "mongodb", DBVersion: ""}, testSet, nil)
 			assert.Empty(t, res)
 		})
 		t.Run("matches several", func(t *testing.T) {
 			t.Parallel()
-			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "mongodb", DBVersion: "5.0.5"}, testSet)
+			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "mongodb", DBVersion: "5.0.5"}, testSet, nil)
 			assert.ElementsMatch(t, []string{"5", "6"}, res)
 		})
 		t.Run("matches one", func(t *testing.T) {
 			t.Parallel()
-			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "mongodb", DBVersion: "6.0.5"}, testSet)
+			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "mongodb", DBVersion: "6.0.5"}, testSet, nil)
 			assert.ElementsMatch(t, []string{"4"}, res)
 		})
 	})
+
+	t.Run("postgresql", func(t *testing.T) {
+		t.Parallel()
+
+		pgTools := func(v string) models.SoftwareVersions {
+			return models.SoftwareVersions{
+				{Name: models.PGBasebackupSoftwareName, Version: v},
+				{Name: models.PGDumpSoftwareName, Version: v},
+				{Name: models.PGRestoreSoftwareName, Version: v},
+			}
+		}
+		testSet := []*models.ServiceSoftwareVersions{
+			{ServiceID: "1", SoftwareVersions: pgTools("16.4")},
+			{ServiceID: "2", SoftwareVersions: pgTools("15.8")},
+			{ServiceID: "3", SoftwareVersions: pgTools("")},
+			{ServiceID: "4", SoftwareVersions: pgTools("17.0")},
+			{ServiceID: "5", SoftwareVersions: pgTools("16.4")},
+		}
+		pgVersions := map[string]string{
+			"1": "16.2",
+			"2": "16.2",
+			"3": "16.2",
+			"4": "16.2",
+			"5": "15.8",
+		}
+
+		t.Run("empty db version", func(t *testing.T) {
+			t.Parallel()
+			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "postgresql", DBVersion: ""}, testSet, pgVersions)
+			assert.Empty(t, res)
+		})
+		t.Run("matches several", func(t *testing.T) {
+			t.Parallel()
+			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "postgresql", DBVersion: "16.2"}, testSet, pgVersions)
+			assert.ElementsMatch(t, []string{"1", "4"}, res)
+		})
+		t.Run("matches one", func(t *testing.T) {
+			t.Parallel()
+			res := cSvc.findCompatibleServiceIDs(&models.Artifact{Vendor: "postgresql", DBVersion: "15.8"}, testSet, pgVersions)
+			assert.ElementsMatch(t, []string{"5"}, res)
+		})
+	})
 }
 
 func TestFindArtifactCompatibleServices(t *testing.T) {
@@ -629,9 +720,11 @@ func TestArtifactCompatibility(t *testing.T) {
 
 	artifactModelMySQL := &models.Artifact{DBVersion: "8.0.25"}
 	artifactModelMongo := &models.Artifact{DBVersion: "6.0.2"}
+	artifactModelPostgreSQL := &models.Artifact{DBVersion: "16.2"}
 
 	serviceModelMySQL := &models.Service{ServiceType: models.MySQLServiceType}
 	serviceModelMongo := &models.Service{ServiceType: models.MongoDBServiceType}
+	serviceModelPostgreSQL := &models.Service{ServiceType: models.PostgreSQLServiceType}
 
 	tests := []struct {
 		name            string
@@ -685,6 +778,22 @@ func TestArtifactCompatibility(t *testing.T) {
 			targetDBVersion: "6.0.1",
 			expectedErr:     ErrIncompatibleTargetMongoDB,
 		},
+
+		// postgresql cases
+		{
+			name:            "postgresql successful",
+			artifact:        artifactModelPostgreSQL,
+			service:         serviceModelPostgreSQL,
+			targetDBVersion: "16.2",
+			expectedErr:     nil,
+		},
+		{
+			name:            "postgresql incompatible version",
+			artifact:        artifactModelPostgreSQL,
+			service:         serviceModelPostgreSQL,
+			targetDBVersion: "16.4",
+			expectedErr:     ErrIncompatibleTargetPostgreSQL,
+		},
 	}
 
 	for _, tc := range tests {
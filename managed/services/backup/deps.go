@@ -33,6 +33,7 @@ type jobsService interface {
 		pmmAgentID string,
 		timeout time.Duration,
 		name string,
+		mode models.BackupMode,
 		dbConfig *models.DBConfig,
 		locationConfig *models.BackupLocationConfig,
 		folder string,
@@ -45,6 +46,16 @@ type jobsService interface {
 		name string,
 		locationConfig *models.BackupLocationConfig,
 		folder string,
+		pitrTimestamp time.Time,
+		binlogFolder string,
+		dbConfig *models.DBConfig,
+	) error
+	StartMySQLBinlogStreamJob(
+		jobID string,
+		pmmAgentID string,
+		dbConfig *models.DBConfig,
+		locationConfig *models.BackupLocationConfig,
+		folder string,
 	) error
 	StartMongoDBBackupJob(
 		service *models.Service,
@@ -69,6 +80,43 @@ type jobsService interface {
 		pitrTimestamp time.Time,
 		folder string,
 	) error
+	StartPostgreSQLBackupJob(
+		service *models.Service,
+		jobID string,
+		pmmAgentID string,
+		timeout time.Duration,
+		name string,
+		dataModel models.DataModel,
+		locationConfig *models.BackupLocationConfig,
+		folder string,
+	) error
+	StartPostgreSQLRestoreBackupJob(
+		service *models.Service,
+		jobID string,
+		pmmAgentID string,
+		timeout time.Duration,
+		name string,
+		dataModel models.DataModel,
+		locationConfig *models.BackupLocationConfig,
+		folder string,
+	) error
+	StartMySQLVerifyBackupJob(
+		jobID string,
+		pmmAgentID string,
+		timeout time.Duration,
+		name string,
+		locationConfig *models.BackupLocationConfig,
+		folder string,
+	) error
+	StartMongoDBVerifyBackupJob(
+		jobID string,
+		pmmAgentID string,
+		timeout time.Duration,
+		name string,
+		pbmBackupName string,
+		locationConfig *models.BackupLocationConfig,
+		folder string,
+	) error
 }
 
 type removalService interface {
@@ -81,7 +129,7 @@ type removalService interface {
 // agentService is a subset of methods of agents.AgentService used by this package.
 // We use it instead of real type for testing and to avoid dependency cycle.
 type agentService interface {
-	PBMSwitchPITR(pmmAgentID, dsn string, files map[string]string, tdp *models.DelimiterPair, enabled bool) error
+	PBMSwitchPITR(pmmAgentID, dsn string, files map[string]string, tdp *models.DelimiterPair, secrets map[string]string, enabled bool) error
 }
 
 // versioner contains method for retrieving versions of different software.
@@ -106,6 +154,14 @@ type pbmPITRService interface {
 	GetPITRFiles(ctx context.Context, locationClient Storage, location *models.BackupLocation, artifact *models.Artifact, until *time.Time) ([]*OplogChunk, error)
 }
 
+// mysqlPITRService provides methods that help us inspect and manage MySQL binary logs.
+type mysqlPITRService interface {
+	// ListPITRTimeranges list the available PITR timeranges for the given artifact in the provided location
+	ListPITRTimeranges(ctx context.Context, locationClient Storage, location *models.BackupLocation, artifact *models.Artifact) ([]Timeline, error)
+	// GetBinlogFiles returns list of binary logs. If 'until' specified, returns only binary logs completed before that date, otherwise returns all artifact binary logs.
+	GetBinlogFiles(ctx context.Context, locationClient Storage, location *models.BackupLocation, artifact *models.Artifact, until *time.Time) ([]*BinlogChunk, error)
+}
+
 // Storage represents the interface for interacting with storage.
 type Storage interface {
 	// FileStat returns file info. It returns error if file is empty or not exists.
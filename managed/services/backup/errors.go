@@ -32,6 +32,8 @@ var (
 	ErrIncompatibleTargetMySQL = errors.New("incompatible version of target mysql")
 	// ErrIncompatibleTargetMongoDB is returned if target version of MongoDB is not compatible for restoring selected artifact.
 	ErrIncompatibleTargetMongoDB = errors.New("incompatible version of target mongodb")
+	// ErrIncompatibleTargetPostgreSQL is returned if target version of PostgreSQL is not compatible for restoring selected artifact.
+	ErrIncompatibleTargetPostgreSQL = errors.New("incompatible version of target postgresql")
 	// ErrComparisonImpossible is returned when comparison of versions is impossible for some reasons.
 	ErrComparisonImpossible = errors.New("cannot compare software versions")
 	// ErrIncompatibleDataModel is returned if the specified data model (logical or physical) is not compatible with other parameters.
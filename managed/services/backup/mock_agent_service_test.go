@@ -3,9 +3,8 @@
 package backup
 
 import (
-	mock "github.com/stretchr/testify/mock"
-
 	models "github.com/percona/pmm/managed/models"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // mockAgentService is an autogenerated mock type for the agentService type
@@ -13,17 +12,17 @@ type mockAgentService struct {
 	mock.Mock
 }
 
-// PBMSwitchPITR provides a mock function with given fields: pmmAgentID, dsn, files, tdp, enabled
-func (_m *mockAgentService) PBMSwitchPITR(pmmAgentID string, dsn string, files map[string]string, tdp *models.DelimiterPair, enabled bool) error {
-	ret := _m.Called(pmmAgentID, dsn, files, tdp, enabled)
+// PBMSwitchPITR provides a mock function with given fields: pmmAgentID, dsn, files, tdp, secrets, enabled
+func (_m *mockAgentService) PBMSwitchPITR(pmmAgentID string, dsn string, files map[string]string, tdp *models.DelimiterPair, secrets map[string]string, enabled bool) error {
+	ret := _m.Called(pmmAgentID, dsn, files, tdp, secrets, enabled)
 
 	if len(ret) == 0 {
 		panic("no return value specified for PBMSwitchPITR")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, map[string]string, *models.DelimiterPair, bool) error); ok {
-		r0 = rf(pmmAgentID, dsn, files, tdp, enabled)
+	if rf, ok := ret.Get(0).(func(string, string, map[string]string, *models.DelimiterPair, map[string]string, bool) error); ok {
+		r0 = rf(pmmAgentID, dsn, files, tdp, secrets, enabled)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -36,8 +35,7 @@ func (_m *mockAgentService) PBMSwitchPITR(pmmAgentID string, dsn string, files m
 func newMockAgentService(t interface {
 	mock.TestingT
 	Cleanup(func())
-},
-) *mockAgentService {
+}) *mockAgentService {
 	mock := &mockAgentService{}
 	mock.Mock.Test(t)
 
@@ -51,17 +51,53 @@ func (_m *mockJobsService) StartMongoDBRestoreBackupJob(service *models.Service,
 	return r0
 }
 
-// StartMySQLBackupJob provides a mock function with given fields: jobID, pmmAgentID, timeout, name, dbConfig, locationConfig, folder
-func (_m *mockJobsService) StartMySQLBackupJob(jobID string, pmmAgentID string, timeout time.Duration, name string, dbConfig *models.DBConfig, locationConfig *models.BackupLocationConfig, folder string) error {
-	ret := _m.Called(jobID, pmmAgentID, timeout, name, dbConfig, locationConfig, folder)
+// StartMongoDBVerifyBackupJob provides a mock function with given fields: jobID, pmmAgentID, timeout, name, pbmBackupName, locationConfig, folder
+func (_m *mockJobsService) StartMongoDBVerifyBackupJob(jobID string, pmmAgentID string, timeout time.Duration, name string, pbmBackupName string, locationConfig *models.BackupLocationConfig, folder string) error {
+	ret := _m.Called(jobID, pmmAgentID, timeout, name, pbmBackupName, locationConfig, folder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartMongoDBVerifyBackupJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration, string, string, *models.BackupLocationConfig, string) error); ok {
+		r0 = rf(jobID, pmmAgentID, timeout, name, pbmBackupName, locationConfig, folder)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StartMySQLBackupJob provides a mock function with given fields: jobID, pmmAgentID, timeout, name, mode, dbConfig, locationConfig, folder
+func (_m *mockJobsService) StartMySQLBackupJob(jobID string, pmmAgentID string, timeout time.Duration, name string, mode models.BackupMode, dbConfig *models.DBConfig, locationConfig *models.BackupLocationConfig, folder string) error {
+	ret := _m.Called(jobID, pmmAgentID, timeout, name, mode, dbConfig, locationConfig, folder)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StartMySQLBackupJob")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, time.Duration, string, *models.DBConfig, *models.BackupLocationConfig, string) error); ok {
-		r0 = rf(jobID, pmmAgentID, timeout, name, dbConfig, locationConfig, folder)
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration, string, models.BackupMode, *models.DBConfig, *models.BackupLocationConfig, string) error); ok {
+		r0 = rf(jobID, pmmAgentID, timeout, name, mode, dbConfig, locationConfig, folder)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StartMySQLBinlogStreamJob provides a mock function with given fields: jobID, pmmAgentID, dbConfig, locationConfig, folder
+func (_m *mockJobsService) StartMySQLBinlogStreamJob(jobID string, pmmAgentID string, dbConfig *models.DBConfig, locationConfig *models.BackupLocationConfig, folder string) error {
+	ret := _m.Called(jobID, pmmAgentID, dbConfig, locationConfig, folder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartMySQLBinlogStreamJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, *models.DBConfig, *models.BackupLocationConfig, string) error); ok {
+		r0 = rf(jobID, pmmAgentID, dbConfig, locationConfig, folder)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -69,17 +105,71 @@ func (_m *mockJobsService) StartMySQLBackupJob(jobID string, pmmAgentID string,
 	return r0
 }
 
-// StartMySQLRestoreBackupJob provides a mock function with given fields: jobID, pmmAgentID, serviceID, timeout, name, locationConfig, folder
-func (_m *mockJobsService) StartMySQLRestoreBackupJob(jobID string, pmmAgentID string, serviceID string, timeout time.Duration, name string, locationConfig *models.BackupLocationConfig, folder string) error {
-	ret := _m.Called(jobID, pmmAgentID, serviceID, timeout, name, locationConfig, folder)
+// StartMySQLRestoreBackupJob provides a mock function with given fields: jobID, pmmAgentID, serviceID, timeout, name, locationConfig, folder, pitrTimestamp, binlogFolder, dbConfig
+func (_m *mockJobsService) StartMySQLRestoreBackupJob(jobID string, pmmAgentID string, serviceID string, timeout time.Duration, name string, locationConfig *models.BackupLocationConfig, folder string, pitrTimestamp time.Time, binlogFolder string, dbConfig *models.DBConfig) error {
+	ret := _m.Called(jobID, pmmAgentID, serviceID, timeout, name, locationConfig, folder, pitrTimestamp, binlogFolder, dbConfig)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StartMySQLRestoreBackupJob")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(string, string, string, time.Duration, string, *models.BackupLocationConfig, string) error); ok {
-		r0 = rf(jobID, pmmAgentID, serviceID, timeout, name, locationConfig, folder)
+	if rf, ok := ret.Get(0).(func(string, string, string, time.Duration, string, *models.BackupLocationConfig, string, time.Time, string, *models.DBConfig) error); ok {
+		r0 = rf(jobID, pmmAgentID, serviceID, timeout, name, locationConfig, folder, pitrTimestamp, binlogFolder, dbConfig)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StartMySQLVerifyBackupJob provides a mock function with given fields: jobID, pmmAgentID, timeout, name, locationConfig, folder
+func (_m *mockJobsService) StartMySQLVerifyBackupJob(jobID string, pmmAgentID string, timeout time.Duration, name string, locationConfig *models.BackupLocationConfig, folder string) error {
+	ret := _m.Called(jobID, pmmAgentID, timeout, name, locationConfig, folder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartMySQLVerifyBackupJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Duration, string, *models.BackupLocationConfig, string) error); ok {
+		r0 = rf(jobID, pmmAgentID, timeout, name, locationConfig, folder)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StartPostgreSQLBackupJob provides a mock function with given fields: service, jobID, pmmAgentID, timeout, name, dataModel, locationConfig, folder
+func (_m *mockJobsService) StartPostgreSQLBackupJob(service *models.Service, jobID string, pmmAgentID string, timeout time.Duration, name string, dataModel models.DataModel, locationConfig *models.BackupLocationConfig, folder string) error {
+	ret := _m.Called(service, jobID, pmmAgentID, timeout, name, dataModel, locationConfig, folder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartPostgreSQLBackupJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Service, string, string, time.Duration, string, models.DataModel, *models.BackupLocationConfig, string) error); ok {
+		r0 = rf(service, jobID, pmmAgentID, timeout, name, dataModel, locationConfig, folder)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StartPostgreSQLRestoreBackupJob provides a mock function with given fields: service, jobID, pmmAgentID, timeout, name, dataModel, locationConfig, folder
+func (_m *mockJobsService) StartPostgreSQLRestoreBackupJob(service *models.Service, jobID string, pmmAgentID string, timeout time.Duration, name string, dataModel models.DataModel, locationConfig *models.BackupLocationConfig, folder string) error {
+	ret := _m.Called(service, jobID, pmmAgentID, timeout, name, dataModel, locationConfig, folder)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartPostgreSQLRestoreBackupJob")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Service, string, string, time.Duration, string, models.DataModel, *models.BackupLocationConfig, string) error); ok {
+		r0 = rf(service, jobID, pmmAgentID, timeout, name, dataModel, locationConfig, folder)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -110,8 +200,7 @@ func (_m *mockJobsService) StopJob(jobID string) error {
 func newMockJobsService(t interface {
 	mock.TestingT
 	Cleanup(func())
-},
-) *mockJobsService {
+}) *mockJobsService {
 	mock := &mockJobsService{}
 	mock.Mock.Test(t)
 
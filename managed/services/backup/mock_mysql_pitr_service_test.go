@@ -0,0 +1,91 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package backup
+
+import (
+	context "context"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
+
+	models "github.com/percona/pmm/managed/models"
+)
+
+// mockMysqlPITRService is an autogenerated mock type for the mysqlPITRService type
+type mockMysqlPITRService struct {
+	mock.Mock
+}
+
+// GetBinlogFiles provides a mock function with given fields: ctx, locationClient, location, artifact, until
+func (_m *mockMysqlPITRService) GetBinlogFiles(ctx context.Context, locationClient Storage, location *models.BackupLocation, artifact *models.Artifact, until *time.Time) ([]*BinlogChunk, error) {
+	ret := _m.Called(ctx, locationClient, location, artifact, until)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBinlogFiles")
+	}
+
+	var r0 []*BinlogChunk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, Storage, *models.BackupLocation, *models.Artifact, *time.Time) ([]*BinlogChunk, error)); ok {
+		return rf(ctx, locationClient, location, artifact, until)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, Storage, *models.BackupLocation, *models.Artifact, *time.Time) []*BinlogChunk); ok {
+		r0 = rf(ctx, locationClient, location, artifact, until)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*BinlogChunk)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, Storage, *models.BackupLocation, *models.Artifact, *time.Time) error); ok {
+		r1 = rf(ctx, locationClient, location, artifact, until)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPITRTimeranges provides a mock function with given fields: ctx, locationClient, location, artifact
+func (_m *mockMysqlPITRService) ListPITRTimeranges(ctx context.Context, locationClient Storage, location *models.BackupLocation, artifact *models.Artifact) ([]Timeline, error) {
+	ret := _m.Called(ctx, locationClient, location, artifact)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPITRTimeranges")
+	}
+
+	var r0 []Timeline
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, Storage, *models.BackupLocation, *models.Artifact) ([]Timeline, error)); ok {
+		return rf(ctx, locationClient, location, artifact)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, Storage, *models.BackupLocation, *models.Artifact) []Timeline); ok {
+		r0 = rf(ctx, locationClient, location, artifact)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]Timeline)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, Storage, *models.BackupLocation, *models.Artifact) error); ok {
+		r1 = rf(ctx, locationClient, location, artifact)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// newMockMysqlPITRService creates a new instance of mockMysqlPITRService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockMysqlPITRService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockMysqlPITRService {
+	mock := &mockMysqlPITRService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
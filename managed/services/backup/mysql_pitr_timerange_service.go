@@ -0,0 +1,274 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+package backup
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/percona/pmm/managed/models"
+)
+
+const (
+	// binlogChunkTimeFormat is the format of timestamps in the names of binary logs stored by pmm-agent.
+	binlogChunkTimeFormat = "20060102150405"
+	// binlogChunkSuffix is the suffix of binary logs stored by pmm-agent, they are gzipped.
+	binlogChunkSuffix = ".gz"
+)
+
+// MySQLPITRService helps to perform MySQL binary logs lookup in a backup storage.
+type MySQLPITRService struct {
+	l *logrus.Entry
+}
+
+// NewMySQLPITRService creates new MySQLPITRService service.
+func NewMySQLPITRService() *MySQLPITRService {
+	return &MySQLPITRService{
+		l: logrus.WithField("component", "services/backup/mysql_pitr_storage"),
+	}
+}
+
+// BinlogChunk describes MySQL binary log stored in a backup storage.
+type BinlogChunk struct {
+	// FName is the name of the file in the storage.
+	FName string
+	// Binlog is the original binary log file name, e.g. binlog.000042.
+	Binlog string
+	// Start and End are timestamps of the first and the last events in the binary log.
+	Start time.Time
+	End   time.Time
+	size  int64
+}
+
+// binlogChunkFromFileName parses given file name and returns binary log metadata.
+// It returns nil if the file name has unexpected format.
+// Current format is binlog.000042.20240301100000.20240301100959.gz.
+//
+// !!! Should be agreed with pmm-agent binlogChunkName().
+func binlogChunkFromFileName(prefix, f string) *BinlogChunk {
+	if !strings.HasSuffix(f, binlogChunkSuffix) || strings.Contains(f, "/") {
+		return nil
+	}
+
+	// Binary log name contains a dot itself, so there are at least four parts.
+	parts := strings.Split(strings.TrimSuffix(f, binlogChunkSuffix), ".")
+	if len(parts) < 4 { //nolint:mnd
+		return nil
+	}
+
+	start, err := time.Parse(binlogChunkTimeFormat, parts[len(parts)-2])
+	if err != nil {
+		return nil
+	}
+
+	end, err := time.Parse(binlogChunkTimeFormat, parts[len(parts)-1])
+	if err != nil {
+		return nil
+	}
+
+	binlog := strings.Join(parts[:len(parts)-2], ".")
+	if _, _, ok := splitBinlogName(binlog); !ok {
+		return nil
+	}
+
+	return &BinlogChunk{
+		FName:  path.Join(prefix, f),
+		Binlog: binlog,
+		Start:  start,
+		End:    end,
+	}
+}
+
+// splitBinlogName splits binary log file name into base name and sequence number.
+func splitBinlogName(binlog string) (string, int, bool) {
+	i := strings.LastIndex(binlog, ".")
+	if i <= 0 {
+		return "", 0, false
+	}
+
+	seq, err := strconv.Atoi(binlog[i+1:])
+	if err != nil {
+		return "", 0, false
+	}
+
+	return binlog[:i], seq, true
+}
+
+// binlogFollows returns true if next binary log directly follows prev one.
+func binlogFollows(prev, next string) bool {
+	prevBase, prevSeq, ok := splitBinlogName(prev)
+	if !ok {
+		return false
+	}
+
+	nextBase, nextSeq, ok := splitBinlogName(next)
+	if !ok {
+		return false
+	}
+
+	return prevBase == nextBase && nextSeq == prevSeq+1
+}
+
+// binlogBefore returns true if binary log a was written before b. Sequence numbers are compared as numbers,
+// as they are not zero-padded after reaching the padding width (e.g. binlog.999999 is followed by binlog.1000000).
+func binlogBefore(a, b string) bool {
+	aBase, aSeq, aOK := splitBinlogName(a)
+	bBase, bSeq, bOK := splitBinlogName(b)
+	if !aOK || !bOK || aBase != bBase {
+		return a < b
+	}
+
+	return aSeq < bSeq
+}
+
+// getBinlogs returns binary logs of the given artifact sorted by sequence number.
+func (s *MySQLPITRService) getBinlogs(ctx context.Context, storage Storage, location *models.BackupLocation, artifact *models.Artifact) ([]*BinlogChunk, error) {
+	if storage == nil {
+		return nil, nil
+	}
+	if location.S3Config == nil {
+		return nil, errUnsupportedLocation
+	}
+
+	prefix := artifact.MySQLBinlogFolder()
+
+	s3Config := location.S3Config
+	files, err := storage.List(ctx, s3Config.Endpoint, s3Config.AccessKey, s3Config.SecretKey, s3Config.BucketName, prefix, binlogChunkSuffix)
+	if err != nil {
+		return nil, fmt.Errorf("get list of binary logs: %w", err)
+	}
+
+	var binlogs []*BinlogChunk
+	for _, f := range files {
+		if f.IsDeleteMarker {
+			s.l.Debugf("skip binary log %s/%s because of file has delete marker", prefix, f.Name)
+			continue
+		}
+		chunk := binlogChunkFromFileName(prefix, f.Name)
+		if chunk != nil {
+			chunk.size = f.Size
+			binlogs = append(binlogs, chunk)
+		}
+	}
+
+	sort.SliceStable(binlogs, func(i, j int) bool { return binlogBefore(binlogs[i].Binlog, binlogs[j].Binlog) })
+
+	return binlogs, nil
+}
+
+// ListPITRTimeranges returns the available PITR timeranges for the given artifact in the provided location.
+// Consecutive binary logs form a timeline. A point in time can be restored only if there is a snapshot
+// taken within the same timeline before it, so every timeline starts at the earliest of such snapshots.
+func (s *MySQLPITRService) ListPITRTimeranges(ctx context.Context, storage Storage, location *models.BackupLocation, artifact *models.Artifact) ([]Timeline, error) {
+	binlogs, err := s.getBinlogs(ctx, storage, location, artifact)
+	if err != nil {
+		return nil, fmt.Errorf("get binary logs: %w", err)
+	}
+	if len(binlogs) == 0 {
+		return nil, nil
+	}
+
+	var snapshots []time.Time
+	for _, metadata := range artifact.MetadataList {
+		if metadata.RestoreTo != nil {
+			snapshots = append(snapshots, *metadata.RestoreTo)
+		}
+	}
+	sort.Slice(snapshots, func(i, j int) bool { return snapshots[i].Before(snapshots[j]) })
+
+	var res []Timeline
+	for _, tl := range getBinlogTimelines(binlogs) {
+		start := time.Unix(int64(tl.Start), 0)
+		end := time.Unix(int64(tl.End), 0)
+		for _, snapshot := range snapshots {
+			if inTimeSpan(start, end, snapshot) {
+				tl.Start = uint32(snapshot.Unix()) //nolint:gosec
+				res = append(res, tl)
+				break
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// getBinlogTimelines returns timelines formed by consecutive binary logs. Binary logs should be sorted by sequence number.
+func getBinlogTimelines(binlogs []*BinlogChunk) []Timeline {
+	var timelines []Timeline
+	var tl Timeline
+	var prev *BinlogChunk
+	for _, b := range binlogs {
+		if prev != nil && b.Binlog == prev.Binlog {
+			// The same binary log may be uploaded twice if streaming was restarted.
+			continue
+		}
+
+		if prev != nil && !binlogFollows(prev.Binlog, b.Binlog) {
+			timelines = append(timelines, tl)
+			tl = Timeline{}
+		}
+
+		if tl.Start == 0 {
+			tl.Start = uint32(b.Start.Unix()) //nolint:gosec
+		}
+		tl.End = uint32(b.End.Unix()) //nolint:gosec
+		tl.Size += b.size
+		prev = b
+	}
+
+	return append(timelines, tl)
+}
+
+// GetBinlogFiles returns list of binary logs. If 'until' specified, returns only binary logs completed before that time,
+// i.e. followed by a binary log started before it, otherwise returns all artifact binary logs.
+func (s *MySQLPITRService) GetBinlogFiles(
+	ctx context.Context,
+	storage Storage,
+	location *models.BackupLocation,
+	artifact *models.Artifact,
+	until *time.Time,
+) ([]*BinlogChunk, error) {
+	binlogs, err := s.getBinlogs(ctx, storage, location, artifact)
+	if err != nil {
+		return nil, err
+	}
+
+	if until == nil {
+		return binlogs, nil
+	}
+
+	// The binary log that was current at 'until' contains the position of the snapshot taken at that time,
+	// so it has to be kept together with all the following ones.
+	var res []*BinlogChunk
+	for i, b := range binlogs {
+		next := i + 1
+		for next < len(binlogs) && binlogs[next].Binlog == b.Binlog {
+			next++
+		}
+		if next == len(binlogs) || binlogs[next].Start.After(*until) {
+			break
+		}
+		res = append(res, b)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,168 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+package backup
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm/managed/models"
+	"github.com/percona/pmm/managed/services/minio"
+)
+
+func TestBinlogChunkFromFileName(t *testing.T) {
+	tests := []struct {
+		name     string
+		filename string
+		expected *BinlogChunk
+	}{
+		{
+			name:     "correctly formatted file name",
+			filename: "binlog.000042.20240301100000.20240301100959.gz",
+			expected: &BinlogChunk{
+				FName:  "folder/artifact/binlogs/binlog.000042.20240301100000.20240301100959.gz",
+				Binlog: "binlog.000042",
+				Start:  time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+				End:    time.Date(2024, 3, 1, 10, 9, 59, 0, time.UTC),
+			},
+		},
+		{
+			name:     "binary log name with dashes",
+			filename: "mysql-bin.000001.20240301100000.20240301100959.gz",
+			expected: &BinlogChunk{
+				FName:  "folder/artifact/binlogs/mysql-bin.000001.20240301100000.20240301100959.gz",
+				Binlog: "mysql-bin.000001",
+				Start:  time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+				End:    time.Date(2024, 3, 1, 10, 9, 59, 0, time.UTC),
+			},
+		},
+		{
+			name:     "without end timestamp",
+			filename: "binlog.000042.20240301100000.gz",
+			expected: nil,
+		},
+		{
+			name:     "invalid binary log sequence",
+			filename: "binlog.abc.20240301100000.20240301100959.gz",
+			expected: nil,
+		},
+		{
+			name:     "not gzipped",
+			filename: "binlog.000042.20240301100000.20240301100959",
+			expected: nil,
+		},
+		{
+			name:     "nested file",
+			filename: "subfolder/binlog.000042.20240301100000.20240301100959.gz",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chunk := binlogChunkFromFileName("folder/artifact/binlogs", tt.filename)
+			assert.Equal(t, tt.expected, chunk)
+		})
+	}
+}
+
+func TestBinlogBefore(t *testing.T) {
+	assert.True(t, binlogBefore("binlog.000009", "binlog.000010"))
+	assert.True(t, binlogBefore("mysql-bin.999999", "mysql-bin.1000000"))
+	assert.False(t, binlogBefore("mysql-bin.1000000", "mysql-bin.999999"))
+	assert.False(t, binlogBefore("binlog.000009", "binlog.000009"))
+}
+
+func TestMySQLListPITRTimeranges(t *testing.T) {
+	ctx := t.Context()
+	S3Config := models.S3LocationConfig{
+		Endpoint:     "https://s3.us-west-2.amazonaws.com",
+		AccessKey:    "access_key",
+		SecretKey:    "secret_key",
+		BucketName:   "example_bucket",
+		BucketRegion: "us-east-1",
+	}
+	location := &models.BackupLocation{
+		S3Config: &S3Config,
+	}
+
+	listedFiles := []minio.FileInfo{
+		{Name: "binlog.000003.20240301102000.20240301102959.gz"},
+		{Name: "binlog.000001.20240301100000.20240301100959.gz"},
+		{Name: "binlog.000002.20240301101000.20240301101959.gz"},
+		{Name: "binlog.000002.20240301101000.20240301101959.gz"},
+		// Gap: binlog.000004 is missing.
+		{Name: "binlog.000005.20240301104000.20240301104959.gz"},
+		{Name: "binlog.000006.20240301105000.20240301105959.gz"},
+		{Name: "binlog.000007.20240301110000.20240301110959.gz", IsDeleteMarker: true},
+	}
+
+	restoreTo := func(hour, minute int) *time.Time {
+		return new(time.Date(2024, 3, 1, hour, minute, 0, 0, time.UTC))
+	}
+
+	artifact := &models.Artifact{
+		Name:   "artifact",
+		Folder: "folder",
+		MetadataList: models.MetadataList{
+			{FileList: []models.File{{Name: "artifact/20240301100500", IsDirectory: true}}, RestoreTo: restoreTo(10, 5)},
+			{FileList: []models.File{{Name: "artifact/20240301101500", IsDirectory: true}}, RestoreTo: restoreTo(10, 15)},
+		},
+	}
+
+	mockedStorage := &MockStorage{}
+	service := NewMySQLPITRService()
+
+	t.Run("timelines start at snapshots", func(t *testing.T) {
+		mockedStorage.On("List", ctx, S3Config.Endpoint, S3Config.AccessKey, S3Config.SecretKey, S3Config.BucketName,
+			"folder/artifact/binlogs", binlogChunkSuffix).Return(listedFiles, nil).Once()
+
+		timelines, err := service.ListPITRTimeranges(ctx, mockedStorage, location, artifact)
+		require.NoError(t, err)
+
+		// The second timeline has no snapshot, so it can't be used for restore.
+		assert.Equal(t, []Timeline{{
+			Start: uint32(restoreTo(10, 5).Unix()),
+			End:   uint32(time.Date(2024, 3, 1, 10, 29, 59, 0, time.UTC).Unix()),
+		}}, timelines)
+	})
+
+	t.Run("binlogs completed before snapshot", func(t *testing.T) {
+		mockedStorage.On("List", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(listedFiles, nil).Once()
+
+		binlogs, err := service.GetBinlogFiles(ctx, mockedStorage, location, artifact, restoreTo(10, 15))
+		require.NoError(t, err)
+
+		// binlog.000002 was current at the time of the snapshot, so it is kept.
+		require.Len(t, binlogs, 1)
+		assert.Equal(t, "binlog.000001", binlogs[0].Binlog)
+	})
+
+	t.Run("all binlogs", func(t *testing.T) {
+		mockedStorage.On("List", ctx, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(listedFiles, nil).Once()
+
+		binlogs, err := service.GetBinlogFiles(ctx, mockedStorage, location, artifact, nil)
+		require.NoError(t, err)
+		assert.Len(t, binlogs, 6)
+	})
+
+	mockedStorage.AssertExpectations(t)
+}
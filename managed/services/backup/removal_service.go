@@ -32,17 +32,19 @@ import (
 
 // RemovalService manages removing of backup artifacts.
 type RemovalService struct {
-	l              *logrus.Entry
-	db             *reform.DB
-	pbmPITRService pbmPITRService
+	l                *logrus.Entry
+	db               *reform.DB
+	pbmPITRService   pbmPITRService
+	mysqlPITRService mysqlPITRService
 }
 
 // NewRemovalService creates new backup removal service.
-func NewRemovalService(db *reform.DB, pbmPITRService pbmPITRService) *RemovalService {
+func NewRemovalService(db *reform.DB, pbmPITRService pbmPITRService, mysqlPITRService mysqlPITRService) *RemovalService {
 	return &RemovalService{
-		l:              logrus.WithField("component", "services/backup/removal"),
-		db:             db,
-		pbmPITRService: pbmPITRService,
+		l:                logrus.WithField("component", "services/backup/removal"),
+		db:               db,
+		pbmPITRService:   pbmPITRService,
+		mysqlPITRService: mysqlPITRService,
 	}
 }
 
@@ -98,7 +100,7 @@ func (s *RemovalService) DeleteArtifact(storage Storage, artifactID string, remo
 			return
 		}
 
-		if artifact.Vendor == string(models.MongoDBServiceType) && artifact.Mode == models.PITR {
+		if artifact.Mode == models.PITR {
 			err = s.deleteArtifactPITRChunks(context.Background(), storage, location, artifact, nil)
 			if err != nil {
 				s.l.WithError(err).Error("couldn't delete artifact PITR chunks")
@@ -319,7 +321,8 @@ func (s *RemovalService) deleteArtifactFiles(ctx context.Context, storage Storag
 	return nil
 }
 
-// deleteArtifactPITRChunks deletes artifact PITR chunks. If "until" provided, deletes only chunks created before that time. Deletes all artifact chunks otherwise.
+// deleteArtifactPITRChunks deletes artifact PITR chunks: oplog slices for MongoDB and binary logs for MySQL.
+// If "until" provided, deletes only chunks created before that time. Deletes all artifact chunks otherwise.
 func (s *RemovalService) deleteArtifactPITRChunks(
 	ctx context.Context,
 	storage Storage,
@@ -333,22 +336,36 @@ func (s *RemovalService) deleteArtifactPITRChunks(
 		return nil
 	}
 
-	chunks, err := s.pbmPITRService.GetPITRFiles(ctx, storage, location, artifact, until)
-	if err != nil {
-		return fmt.Errorf("failed to get pitr chunks: %w", err)
+	var files []string
+	if artifact.Vendor == string(models.MySQLServiceType) {
+		binlogs, err := s.mysqlPITRService.GetBinlogFiles(ctx, storage, location, artifact, until)
+		if err != nil {
+			return fmt.Errorf("failed to get binary logs: %w", err)
+		}
+		for _, binlog := range binlogs {
+			files = append(files, binlog.FName)
+		}
+	} else {
+		chunks, err := s.pbmPITRService.GetPITRFiles(ctx, storage, location, artifact, until)
+		if err != nil {
+			return fmt.Errorf("failed to get pitr chunks: %w", err)
+		}
+		for _, chunk := range chunks {
+			files = append(files, chunk.FName)
+		}
 	}
 
-	if len(chunks) == 0 {
+	if len(files) == 0 {
 		s.l.Debug("No chunks to delete.")
 		return nil
 	}
 
-	for _, chunk := range chunks {
-		s.l.Debugf("Deleting %s.", chunk.FName)
+	for _, file := range files {
+		s.l.Debugf("Deleting %s.", file)
 
-		err := storage.Remove(ctx, s3Config.Endpoint, s3Config.AccessKey, s3Config.SecretKey, s3Config.BucketName, chunk.FName)
+		err := storage.Remove(ctx, s3Config.Endpoint, s3Config.AccessKey, s3Config.SecretKey, s3Config.BucketName, file)
 		if err != nil {
-			return fmt.Errorf("failed to remove pitr chunk '%s' (%v) from storage: %w", chunk.FName, chunk, err)
+			return fmt.Errorf("failed to remove pitr chunk '%s' from storage: %w", file, err)
 		}
 	}
 
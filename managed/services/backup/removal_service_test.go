@@ -47,7 +47,7 @@ func TestDeleteArtifact(t *testing.T) {
 
 	db := reform.NewDB(sqlDB, postgresql.Dialect, reform.NewPrintfLogger(t.Logf))
 	mockedPbmPITRService := &mockPbmPITRService{}
-	removalService := NewRemovalService(db, mockedPbmPITRService)
+	removalService := NewRemovalService(db, mockedPbmPITRService, nil)
 
 	agent, _ := setup(t, db.Querier, models.MySQLServiceType, "test-service")
 
@@ -246,7 +246,7 @@ func TestTrimPITRArtifact(t *testing.T) {
 
 	db := reform.NewDB(sqlDB, postgresql.Dialect, reform.NewPrintfLogger(t.Logf))
 	mockedPbmPITRService := &mockPbmPITRService{}
-	removalService := NewRemovalService(db, mockedPbmPITRService)
+	removalService := NewRemovalService(db, mockedPbmPITRService, nil)
 	mockedStorage := &MockStorage{}
 
 	agent, _ := setup(t, db.Querier, models.MongoDBServiceType, "test-service2")
@@ -445,7 +445,7 @@ func TestLockArtifact(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	removalService := NewRemovalService(db, nil)
+	removalService := NewRemovalService(db, nil, nil)
 
 	t.Run("wrong locking status", func(t *testing.T) {
 		res, oldStatus, err := removalService.lockArtifact(artifact.ID, models.FailedToDeleteBackupStatus)
@@ -541,7 +541,7 @@ func TestReleaseArtifact(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	removalService := NewRemovalService(db, nil)
+	removalService := NewRemovalService(db, nil, nil)
 
 	t.Run("wrong releasing status", func(t *testing.T) {
 		err := removalService.releaseArtifact(artifact.ID, models.PendingBackupStatus)
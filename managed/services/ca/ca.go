@@ -0,0 +1,282 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// Package ca implements a certificate authority that issues client certificates to pmm-agents.
+package ca
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm/managed/models"
+)
+
+const (
+	// DefaultDir is the directory where CA certificate and key are stored.
+	DefaultDir = "/srv/pmm-agent-ca"
+
+	caCertFile = "ca.crt"
+	caKeyFile  = "ca.key"
+
+	caValidity     = 10 * 365 * 24 * time.Hour
+	clientValidity = 7 * 24 * time.Hour
+	clockSkew      = 5 * time.Minute
+	serialBits     = 128
+)
+
+// CA issues and verifies pmm-agent client certificates.
+type CA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pool *x509.CertPool
+}
+
+// New loads CA certificate and key from the given directory, generating them if they don't exist.
+func New(dir string) (*CA, error) {
+	if err := os.MkdirAll(dir, 0o750); err != nil { //nolint:mnd
+		return nil, err
+	}
+
+	certPath, keyPath := filepath.Join(dir, caCertFile), filepath.Join(dir, caKeyFile)
+	certPEM, err := os.ReadFile(certPath) //nolint:gosec
+	switch {
+	case err == nil:
+		// use existing
+	case errors.Is(err, os.ErrNotExist):
+		if err = generate(certPath, keyPath); err != nil {
+			return nil, fmt.Errorf("failed to generate CA: %w", err)
+		}
+		if certPEM, err = os.ReadFile(certPath); err != nil { //nolint:gosec
+			return nil, err
+		}
+	default:
+		return nil, err
+	}
+
+	keyPEM, err := os.ReadFile(keyPath) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+
+	return load(certPEM, keyPEM)
+}
+
+// generate creates a self-signed CA certificate and its key.
+func generate(certPath, keyPath string) error {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return err
+	}
+	serial, err := newSerialNumber()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{Organization: []string{"Percona"}, CommonName: "PMM Server pmm-agent CA"},
+		NotBefore:             now.Add(-clockSkew),
+		NotAfter:              now.Add(caValidity),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLenZero:        true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return err
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	// write key first so a certificate never exists without it
+	if err = os.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil { //nolint:mnd
+		return err
+	}
+	return os.WriteFile(certPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o644) //nolint:gosec,mnd
+}
+
+// load parses PEM-encoded CA certificate and key.
+func load(certPEM, keyPEM []byte) (*CA, error) {
+	cert, err := parseCertificate(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CA certificate: %w", err)
+	}
+
+	block, _ := pem.Decode(keyPEM)
+	if block == nil {
+		return nil, errors.New("failed to decode CA key")
+	}
+	k, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse CA key: %w", err)
+	}
+	key, ok := k.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("unexpected CA key type %T", k)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &CA{
+		cert: cert,
+		key:  key,
+		pool: pool,
+	}, nil
+}
+
+// Sign issues a client certificate for the given pmm-agent from PEM-encoded certificate signing request,
+// records it in the database, and returns PEM-encoded certificate.
+func (ca *CA) Sign(q *reform.Querier, pmmAgentID, csrPEM string) (string, error) {
+	cert, der, err := ca.issue(csrPEM, pmmAgentID, models.Now())
+	if err != nil {
+		return "", err
+	}
+
+	_, err = models.CreateAgentCertificate(q, models.CreateAgentCertificateParams{
+		SerialNumber: cert.SerialNumber.Text(16), //nolint:mnd
+		PMMAgentID:   pmmAgentID,
+		NotAfter:     cert.NotAfter,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), nil
+}
+
+// Verify checks PEM-encoded client certificate (possibly URL-escaped by nginx) and returns its record.
+// It returns Unauthenticated error if certificate is invalid, unknown, or revoked.
+func (ca *CA) Verify(q *reform.Querier, certPEM string) (*models.AgentCertificate, error) {
+	cert, err := ca.verify(certPEM, models.Now())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	serialNumber := cert.SerialNumber.Text(16) //nolint:mnd
+	c, err := models.FindAgentCertificateBySerialNumber(q, serialNumber)
+	if err != nil {
+		if errors.Is(err, models.ErrNotFound) {
+			return nil, status.Errorf(codes.Unauthenticated, "Unknown client certificate %s.", serialNumber)
+		}
+		return nil, err
+	}
+	if c.RevokedAt != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Client certificate %s is revoked.", serialNumber)
+	}
+	if c.PMMAgentID != cert.Subject.CommonName {
+		return nil, status.Errorf(codes.Unauthenticated, "Client certificate %s is issued to another pmm-agent.", serialNumber)
+	}
+
+	return c, nil
+}
+
+// HasCertificates returns true if any certificate was issued to the given pmm-agent.
+// Such pmm-agent must authenticate with a valid certificate instead of a token.
+func (ca *CA) HasCertificates(q *reform.Querier, pmmAgentID string) (bool, error) {
+	return models.HasAgentCertificates(q, pmmAgentID)
+}
+
+// issue creates a client certificate with the given common name from PEM-encoded certificate signing request.
+func (ca *CA) issue(csrPEM, commonName string, now time.Time) (*x509.Certificate, []byte, error) {
+	block, _ := pem.Decode([]byte(csrPEM))
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return nil, nil, status.Error(codes.InvalidArgument, "Failed to decode certificate signing request.")
+	}
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "Failed to parse certificate signing request: %s.", err)
+	}
+	if err = csr.CheckSignature(); err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "Invalid certificate signing request signature: %s.", err)
+	}
+
+	serial, err := newSerialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{Organization: []string{"Percona"}, CommonName: commonName},
+		NotBefore:    now.Add(-clockSkew),
+		NotAfter:     now.Add(clientValidity),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca.cert, csr.PublicKey, ca.key)
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, err
+	}
+	return cert, der, nil
+}
+
+// verify checks that client certificate is issued by this CA and valid at the given time.
+func (ca *CA) verify(certPEM string, now time.Time) (*x509.Certificate, error) {
+	// nginx passes $ssl_client_escaped_cert; unescaping is a no-op for non-escaped PEM
+	s, err := url.PathUnescape(certPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unescape client certificate: %w", err)
+	}
+	cert, err := parseCertificate([]byte(s))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse client certificate: %w", err)
+	}
+
+	_, err = cert.Verify(x509.VerifyOptions{
+		Roots:       ca.pool,
+		CurrentTime: now,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify client certificate: %w", err)
+	}
+	return cert, nil
+}
+
+// parseCertificate parses a single PEM-encoded certificate.
+func parseCertificate(b []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(b)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("failed to decode PEM certificate")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// newSerialNumber returns a random certificate serial number.
+func newSerialNumber() (*big.Int, error) {
+	return rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), serialBits))
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package ca
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func newCSR(t *testing.T) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, key)
+	require.NoError(t, err)
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}))
+}
+
+func TestCA(t *testing.T) {
+	dir := t.TempDir()
+	ca, err := New(dir)
+	require.NoError(t, err)
+
+	fi, err := os.Stat(filepath.Join(dir, caKeyFile))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), fi.Mode().Perm())
+
+	t.Run("Reload", func(t *testing.T) {
+		ca2, err := New(dir)
+		require.NoError(t, err)
+		assert.True(t, ca.cert.Equal(ca2.cert))
+		assert.True(t, ca.key.Equal(ca2.key))
+	})
+
+	now := time.Now()
+	cert, der, err := ca.issue(newCSR(t), "pmm-agent-id", now)
+	require.NoError(t, err)
+	assert.Equal(t, "pmm-agent-id", cert.Subject.CommonName)
+	assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}, cert.ExtKeyUsage)
+	certPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	t.Run("Verify", func(t *testing.T) {
+		actual, err := ca.verify(certPEM, now)
+		require.NoError(t, err)
+		assert.Equal(t, cert.SerialNumber, actual.SerialNumber)
+	})
+
+	t.Run("VerifyEscaped", func(t *testing.T) {
+		actual, err := ca.verify(url.PathEscape(certPEM), now)
+		require.NoError(t, err)
+		assert.Equal(t, cert.SerialNumber, actual.SerialNumber)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		_, err := ca.verify(certPEM, now.Add(clientValidity+time.Minute))
+		assert.ErrorContains(t, err, "certificate has expired or is not yet valid")
+	})
+
+	t.Run("AnotherCA", func(t *testing.T) {
+		other, err := New(t.TempDir())
+		require.NoError(t, err)
+		_, err = other.verify(certPEM, now)
+		assert.ErrorContains(t, err, "certificate signed by unknown authority")
+	})
+
+	t.Run("InvalidCSR", func(t *testing.T) {
+		_, _, err := ca.issue("foo", "pmm-agent-id", now)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		// CA certificate is not a CSR
+		_, _, err = ca.issue(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.cert.Raw})), "pmm-agent-id", now)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -85,6 +85,7 @@ var (
 // Service is responsible for interactions with Percona Check service.
 type Service struct {
 	agentsRegistry agentsRegistry
+	haService      haService
 	db             *reform.DB
 	alertsRegistry *registry
 	vmClient       v1.API
@@ -123,6 +124,7 @@ type queryPlaceholders struct {
 func New(
 	db *reform.DB,
 	agentsRegistry agentsRegistry,
+	haService haService,
 	vmClient v1.API,
 	clickhouseDB *sql.DB,
 ) *Service {
@@ -131,6 +133,7 @@ func New(
 	s := &Service{
 		db:             db,
 		agentsRegistry: agentsRegistry,
+		haService:      haService,
 		alertsRegistry: newRegistry(),
 		vmClient:       vmClient,
 		clickhouseDB:   clickhouseDB,
@@ -404,15 +407,19 @@ func (s *Service) DisableChecks(checkNames []string) error {
 		}
 	}
 
+	var settings *models.Settings
 	errTx := s.db.InTransaction(func(tx *reform.TX) error {
 		params := models.ChangeSettingsParams{DisableAdvisorChecks: checkNames}
-		_, err := models.UpdateSettings(tx.Querier, &params)
+		var err error
+		settings, err = models.UpdateSettings(tx.Querier, &params)
 		return err
 	})
 	if errTx != nil {
 		return fmt.Errorf("failed to disable checks: %w", errTx)
 	}
 
+	s.replicateSettings(settings)
+
 	return nil
 }
 
@@ -422,18 +429,29 @@ func (s *Service) EnableChecks(checkNames []string) error {
 		return nil
 	}
 
+	var settings *models.Settings
 	err := s.db.InTransaction(func(tx *reform.TX) error {
 		params := models.ChangeSettingsParams{EnableAdvisorChecks: checkNames}
-		_, err := models.UpdateSettings(tx.Querier, &params)
+		var err error
+		settings, err = models.UpdateSettings(tx.Querier, &params)
 		return err
 	})
 	if err != nil {
 		return fmt.Errorf("failed to update disabled checks list: %w", err)
 	}
 
+	s.replicateSettings(settings)
+
 	return nil
 }
 
+// replicateSettings shares changed settings with other PMM Server nodes in HA setup.
+func (s *Service) replicateSettings(settings *models.Settings) {
+	if err := s.haService.SetSettings(settings); err != nil {
+		s.l.Warnf("Failed to replicate settings: %v", err)
+	}
+}
+
 // ChangeInterval changes a check's interval to the value received from the UI.
 func (s *Service) ChangeInterval(params map[string]check.Interval) error {
 	checks, err := s.GetChecks()
@@ -824,7 +842,10 @@ func (s *Service) executeMySQLShowQuery(ctx context.Context, query check.Query,
 		}
 	}()
 
-	err = s.agentsRegistry.StartMySQLQueryShowAction(ctx, r.ID, target.AgentID, target.DSN, query.Query, target.Files, target.TDP, target.TLSSkipVerify)
+	err = s.agentsRegistry.StartMySQLQueryShowAction(
+		ctx, r.ID, target.AgentID,
+		target.DSN, query.Query, target.Files, target.TDP, target.TLSSkipVerify, target.Secrets,
+	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to start mySQL show action: %w", err)
 	}
@@ -850,7 +871,7 @@ func (s *Service) executeMySQLSelectQuery(ctx context.Context, query check.Query
 
 	err = s.agentsRegistry.StartMySQLQuerySelectAction(
 		ctx, r.ID, target.AgentID,
-		target.DSN, query.Query, target.Files, target.TDP, target.TLSSkipVerify,
+		target.DSN, query.Query, target.Files, target.TDP, target.TLSSkipVerify, target.Secrets,
 	)
 	if err != nil {
 		return "", fmt.Errorf("failed to start mySQL select action: %w", err)
@@ -874,7 +895,7 @@ func (s *Service) executePostgreSQLShowQuery(ctx context.Context, target service
 			s.l.Warnf("Failed to delete action result %s: %s.", r.ID, err)
 		}
 	}()
-	err = s.agentsRegistry.StartPostgreSQLQueryShowAction(ctx, r.ID, target.AgentID, target.DSN)
+	err = s.agentsRegistry.StartPostgreSQLQueryShowAction(ctx, r.ID, target.AgentID, target.DSN, target.Files, target.TDP, target.Secrets)
 	if err != nil {
 		return "", fmt.Errorf("failed to start postgreSQL show action: %w", err)
 	}
@@ -927,7 +948,7 @@ func (s *Service) executePostgreSQLSelectQueryForSingleDB(ctx context.Context, q
 		}
 	}()
 
-	err = s.agentsRegistry.StartPostgreSQLQuerySelectAction(ctx, r.ID, target.AgentID, target.DSN, query.Query)
+	err = s.agentsRegistry.StartPostgreSQLQuerySelectAction(ctx, r.ID, target.AgentID, target.DSN, query.Query, target.Files, target.TDP, target.Secrets)
 	if err != nil {
 		return "", fmt.Errorf("failed to start postgreSQL select action: %w", err)
 	}
@@ -952,7 +973,7 @@ func (s *Service) executeMongoDBGetParameterQuery(ctx context.Context, target se
 		}
 	}()
 
-	err = s.agentsRegistry.StartMongoDBQueryGetParameterAction(ctx, r.ID, target.AgentID, target.DSN, target.Files, target.TDP)
+	err = s.agentsRegistry.StartMongoDBQueryGetParameterAction(ctx, r.ID, target.AgentID, target.DSN, target.Files, target.TDP, target.Secrets)
 	if err != nil {
 		return "", fmt.Errorf("failed to start mongoDB getParameter action: %w", err)
 	}
@@ -976,7 +997,7 @@ func (s *Service) executeMongoDBBuildInfoQuery(ctx context.Context, target servi
 			s.l.Warnf("Failed to delete action result %s: %s.", r.ID, err)
 		}
 	}()
-	err = s.agentsRegistry.StartMongoDBQueryBuildInfoAction(ctx, r.ID, target.AgentID, target.DSN, target.Files, target.TDP)
+	err = s.agentsRegistry.StartMongoDBQueryBuildInfoAction(ctx, r.ID, target.AgentID, target.DSN, target.Files, target.TDP, target.Secrets)
 	if err != nil {
 		return "", fmt.Errorf("failed to start mongoDB buildInfo action: %w", err)
 	}
@@ -1001,7 +1022,7 @@ func (s *Service) executeMongoDBGetCmdLineOptsQuery(ctx context.Context, target
 		}
 	}()
 
-	err = s.agentsRegistry.StartMongoDBQueryGetCmdLineOptsAction(ctx, r.ID, target.AgentID, target.DSN, target.Files, target.TDP)
+	err = s.agentsRegistry.StartMongoDBQueryGetCmdLineOptsAction(ctx, r.ID, target.AgentID, target.DSN, target.Files, target.TDP, target.Secrets)
 	if err != nil {
 		return "", fmt.Errorf("failed to start mongoDB getCmdLineOpts action: %w", err)
 	}
@@ -1026,7 +1047,7 @@ func (s *Service) executeMongoDBReplSetGetStatusQuery(ctx context.Context, targe
 		}
 	}()
 
-	err = s.agentsRegistry.StartMongoDBQueryReplSetGetStatusAction(ctx, r.ID, target.AgentID, target.DSN, target.Files, target.TDP)
+	err = s.agentsRegistry.StartMongoDBQueryReplSetGetStatusAction(ctx, r.ID, target.AgentID, target.DSN, target.Files, target.TDP, target.Secrets)
 	if err != nil {
 		return "", fmt.Errorf("failed to start mongoDB replSetGetStatus action: %w", err)
 	}
@@ -1051,7 +1072,7 @@ func (s *Service) executeMongoDBGetDiagnosticQuery(ctx context.Context, target s
 		}
 	}()
 
-	err = s.agentsRegistry.StartMongoDBQueryGetDiagnosticDataAction(ctx, r.ID, target.AgentID, target.DSN, target.Files, target.TDP)
+	err = s.agentsRegistry.StartMongoDBQueryGetDiagnosticDataAction(ctx, r.ID, target.AgentID, target.DSN, target.Files, target.TDP, target.Secrets)
 	if err != nil {
 		return "", fmt.Errorf("failed to start mongoDB getDiagnosticData action: %w", err)
 	}
@@ -1419,6 +1440,7 @@ func (s *Service) findTargets(serviceType models.ServiceType, minPMMAgentVersion
 				DSN:           DSN,
 				Files:         agent.Files(),
 				TDP:           agent.TemplateDelimiters(service),
+				Secrets:       agent.Secrets(),
 				TLSSkipVerify: agent.TLSSkipVerify,
 			})
 			return nil
@@ -27,6 +27,7 @@ import (
 	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/reform.v1"
 	"gopkg.in/reform.v1/dialects/postgresql"
@@ -57,7 +58,7 @@ func TestLoadBuiltinAdvisors(t *testing.T) {
 
 	db := reform.NewDB(sqlDB, postgresql.Dialect, nil)
 
-	s := New(db, nil, vmClient, clickhouseDB)
+	s := New(db, nil, nil, vmClient, clickhouseDB)
 
 	t.Run("normal", func(t *testing.T) {
 		checks, err := s.GetAdvisors()
@@ -105,7 +106,7 @@ func TestUpdateAdvisorsList(t *testing.T) {
 	db := reform.NewDB(sqlDB, postgresql.Dialect, nil)
 
 	t.Run("collect custom checks", func(t *testing.T) {
-		s := New(db, nil, vmClient, clickhouseDB)
+		s := New(db, nil, nil, vmClient, clickhouseDB)
 		s.customCheckFile = testChecksFile
 
 		s.UpdateAdvisorsList(t.Context())
@@ -141,7 +142,9 @@ func TestDisableChecks(t *testing.T) {
 
 		db := reform.NewDB(sqlDB, postgresql.Dialect, nil)
 
-		s := New(db, nil, vmClient, clickhouseDB)
+		ha := newMockHaService(t)
+		ha.On("SetSettings", mock.Anything).Return(nil)
+		s := New(db, nil, ha, vmClient, clickhouseDB)
 		s.customCheckFile = testChecksFile
 
 		s.UpdateAdvisorsList(t.Context())
@@ -170,7 +173,9 @@ func TestDisableChecks(t *testing.T) {
 
 		db := reform.NewDB(sqlDB, postgresql.Dialect, nil)
 
-		s := New(db, nil, vmClient, clickhouseDB)
+		ha := newMockHaService(t)
+		ha.On("SetSettings", mock.Anything).Return(nil)
+		s := New(db, nil, ha, vmClient, clickhouseDB)
 		s.customCheckFile = testChecksFile
 
 		s.UpdateAdvisorsList(t.Context())
@@ -202,7 +207,7 @@ func TestDisableChecks(t *testing.T) {
 
 		db := reform.NewDB(sqlDB, postgresql.Dialect, nil)
 
-		s := New(db, nil, vmClient, clickhouseDB)
+		s := New(db, nil, nil, vmClient, clickhouseDB)
 		s.customCheckFile = testChecksFile
 
 		s.UpdateAdvisorsList(t.Context())
@@ -225,7 +230,9 @@ func TestEnableChecks(t *testing.T) {
 
 		db := reform.NewDB(sqlDB, postgresql.Dialect, nil)
 
-		s := New(db, nil, vmClient, clickhouseDB)
+		ha := newMockHaService(t)
+		ha.On("SetSettings", mock.Anything).Return(nil)
+		s := New(db, nil, ha, vmClient, clickhouseDB)
 		s.customCheckFile = testChecksFile
 
 		s.UpdateAdvisorsList(t.Context())
@@ -256,7 +263,7 @@ func TestChangeInterval(t *testing.T) {
 
 		db := reform.NewDB(sqlDB, postgresql.Dialect, nil)
 
-		s := New(db, nil, vmClient, clickhouseDB)
+		s := New(db, nil, nil, vmClient, clickhouseDB)
 		s.customCheckFile = testChecksFile
 
 		s.UpdateAdvisorsList(t.Context())
@@ -302,7 +309,7 @@ func TestStartChecks(t *testing.T) {
 	setupClients(t)
 
 	t.Run("unknown interval", func(t *testing.T) {
-		s := New(db, nil, vmClient, clickhouseDB)
+		s := New(db, nil, nil, vmClient, clickhouseDB)
 		s.customCheckFile = testChecksFile
 
 		err := s.runChecksGroup(t.Context(), "unknown")
@@ -310,7 +317,7 @@ func TestStartChecks(t *testing.T) {
 	})
 
 	t.Run("advisors enabled", func(t *testing.T) {
-		s := New(db, nil, vmClient, clickhouseDB)
+		s := New(db, nil, nil, vmClient, clickhouseDB)
 
 		s.customCheckFile = testChecksFile
 		s.UpdateAdvisorsList(t.Context())
@@ -322,7 +329,7 @@ func TestStartChecks(t *testing.T) {
 	})
 
 	t.Run("advisors disabled", func(t *testing.T) {
-		s := New(db, nil, vmClient, clickhouseDB)
+		s := New(db, nil, nil, vmClient, clickhouseDB)
 
 		settings, err := models.GetSettings(db)
 		require.NoError(t, err)
@@ -340,7 +347,7 @@ func TestNewInitializesStartCheckChannel(t *testing.T) {
 	t.Parallel()
 	// New must initialize the on-demand channel so StartChecks can enqueue a
 	// run before Run starts draining it.
-	s := New(nil, nil, nil, nil)
+	s := New(nil, nil, nil, nil, nil)
 	require.NotNil(t, s.startCheckCh)
 }
 
@@ -348,7 +355,7 @@ func TestUpdateIntervalsBeforeRun(t *testing.T) {
 	t.Parallel()
 	// UpdateIntervals must not panic when Run has not created the tickers yet
 	// (e.g. a settings change on a node that is not the leader).
-	s := New(nil, nil, nil, nil)
+	s := New(nil, nil, nil, nil, nil)
 	assert.NotPanics(t, func() {
 		s.UpdateIntervals(time.Hour, time.Minute, time.Second)
 	})
@@ -425,7 +432,7 @@ func TestFilterChecks(t *testing.T) {
 	partiallyValidAdvisor.Checks = partiallyValidAdvisor.Checks[0:1] // remove invalid check
 	expected := append(valid, partiallyValidAdvisor)                 //nolint:gocritic
 
-	s := New(nil, nil, vmClient, clickhouseDB)
+	s := New(nil, nil, nil, vmClient, clickhouseDB)
 	actual := s.filterSupportedChecks(checks)
 	assert.ElementsMatch(t, expected, actual)
 }
@@ -450,7 +457,7 @@ func TestMinPMMAgents(t *testing.T) {
 		{name: "PostgreSQL Family", minVersion: pmmAgent2_6_0, check: check.Check{Version: 2, Queries: []check.Query{{Type: check.PostgreSQLShow}, {Type: check.PostgreSQLSelect}}}},
 	}
 
-	s := New(nil, nil, vmClient, clickhouseDB)
+	s := New(nil, nil, nil, vmClient, clickhouseDB)
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -509,7 +516,7 @@ func TestFindTargets(t *testing.T) {
 
 	db := reform.NewDB(sqlDB, postgresql.Dialect, reform.NewPrintfLogger(t.Logf))
 
-	s := New(db, nil, vmClient, clickhouseDB)
+	s := New(db, nil, nil, vmClient, clickhouseDB)
 
 	t.Run("unknown service", func(t *testing.T) {
 		t.Parallel()
@@ -560,7 +567,7 @@ func TestFindTargets(t *testing.T) {
 
 func TestFilterChecksByInterval(t *testing.T) {
 	t.Parallel()
-	s := New(nil, nil, vmClient, clickhouseDB)
+	s := New(nil, nil, nil, vmClient, clickhouseDB)
 
 	rareCheck := check.Check{Name: "rareCheck", Interval: check.Rare}
 	standardCheck := check.Check{Name: "standardCheck", Interval: check.Standard}
@@ -593,7 +600,7 @@ func TestGetFailedChecks(t *testing.T) {
 	db := reform.NewDB(sqlDB, postgresql.Dialect, nil)
 
 	t.Run("no failed check for service", func(t *testing.T) {
-		s := New(db, nil, vmClient, clickhouseDB)
+		s := New(db, nil, nil, vmClient, clickhouseDB)
 
 		results, err := s.GetChecksResults(t.Context(), "test_svc")
 		assert.Empty(t, results)
@@ -644,7 +651,7 @@ func TestGetFailedChecks(t *testing.T) {
 			},
 		}
 
-		s := New(db, nil, vmClient, clickhouseDB)
+		s := New(db, nil, nil, vmClient, clickhouseDB)
 		s.alertsRegistry.set(checkResults)
 
 		response, err := s.GetChecksResults(t.Context(), "")
@@ -696,7 +703,7 @@ func TestGetFailedChecks(t *testing.T) {
 			},
 		}
 
-		s := New(db, nil, vmClient, clickhouseDB)
+		s := New(db, nil, nil, vmClient, clickhouseDB)
 		s.alertsRegistry.set(checkResults)
 
 		response, err := s.GetChecksResults(t.Context(), "test_svc1")
@@ -706,7 +713,7 @@ func TestGetFailedChecks(t *testing.T) {
 	})
 
 	t.Run("Advisors disabled", func(t *testing.T) {
-		s := New(db, nil, vmClient, clickhouseDB)
+		s := New(db, nil, nil, vmClient, clickhouseDB)
 
 		settings, err := models.GetSettings(db)
 		require.NoError(t, err)
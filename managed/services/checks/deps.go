@@ -24,13 +24,75 @@ import (
 // agentsRegistry is a subset of methods of agents.Registry used by this package.
 // We use it instead of real type for testing and to avoid dependency cycle.
 type agentsRegistry interface {
-	StartMySQLQueryShowAction(ctx context.Context, id, pmmAgentID, dsn, query string, files map[string]string, tdp *models.DelimiterPair, tlsSkipVerify bool) error
-	StartMySQLQuerySelectAction(ctx context.Context, id, pmmAgentID, dsn, query string, files map[string]string, tdp *models.DelimiterPair, tlsSkipVerify bool) error
-	StartPostgreSQLQueryShowAction(ctx context.Context, id, pmmAgentID, dsn string) error
-	StartPostgreSQLQuerySelectAction(ctx context.Context, id, pmmAgentID, dsn, query string) error
-	StartMongoDBQueryGetParameterAction(ctx context.Context, id, pmmAgentID, dsn string, files map[string]string, tdp *models.DelimiterPair) error
-	StartMongoDBQueryBuildInfoAction(ctx context.Context, id, pmmAgentID, dsn string, files map[string]string, tdp *models.DelimiterPair) error
-	StartMongoDBQueryGetCmdLineOptsAction(ctx context.Context, id, pmmAgentID, dsn string, files map[string]string, tdp *models.DelimiterPair) error
-	StartMongoDBQueryReplSetGetStatusAction(ctx context.Context, id, pmmAgentID, dsn string, files map[string]string, tdp *models.DelimiterPair) error
-	StartMongoDBQueryGetDiagnosticDataAction(ctx context.Context, id, pmmAgentID, dsn string, files map[string]string, tdp *models.DelimiterPair) error
+	StartMySQLQueryShowAction(
+		ctx context.Context,
+		id, pmmAgentID, dsn, query string,
+		files map[string]string,
+		tdp *models.DelimiterPair,
+		tlsSkipVerify bool,
+		secrets map[string]string,
+	) error
+	StartMySQLQuerySelectAction(
+		ctx context.Context,
+		id, pmmAgentID, dsn, query string,
+		files map[string]string,
+		tdp *models.DelimiterPair,
+		tlsSkipVerify bool,
+		secrets map[string]string,
+	) error
+	StartPostgreSQLQueryShowAction(
+		ctx context.Context,
+		id, pmmAgentID, dsn string,
+		files map[string]string,
+		tdp *models.DelimiterPair,
+		secrets map[string]string,
+	) error
+	StartPostgreSQLQuerySelectAction(
+		ctx context.Context,
+		id, pmmAgentID, dsn, query string,
+		files map[string]string,
+		tdp *models.DelimiterPair,
+		secrets map[string]string,
+	) error
+	StartMongoDBQueryGetParameterAction(
+		ctx context.Context,
+		id, pmmAgentID, dsn string,
+		files map[string]string,
+		tdp *models.DelimiterPair,
+		secrets map[string]string,
+	) error
+	StartMongoDBQueryBuildInfoAction(
+		ctx context.Context,
+		id, pmmAgentID, dsn string,
+		files map[string]string,
+		tdp *models.DelimiterPair,
+		secrets map[string]string,
+	) error
+	StartMongoDBQueryGetCmdLineOptsAction(
+		ctx context.Context,
+		id, pmmAgentID, dsn string,
+		files map[string]string,
+		tdp *models.DelimiterPair,
+		secrets map[string]string,
+	) error
+	StartMongoDBQueryReplSetGetStatusAction(
+		ctx context.Context,
+		id, pmmAgentID, dsn string,
+		files map[string]string,
+		tdp *models.DelimiterPair,
+		secrets map[string]string,
+	) error
+	StartMongoDBQueryGetDiagnosticDataAction(
+		ctx context.Context,
+		id, pmmAgentID, dsn string,
+		files map[string]string,
+		tdp *models.DelimiterPair,
+		secrets map[string]string,
+	) error
+}
+
+// haService is a subset of methods of ha.Service used by this package.
+// We use it instead of real type for testing and to avoid dependency cycle.
+type haService interface {
+	SetSettings(settings *models.Settings) error
 }
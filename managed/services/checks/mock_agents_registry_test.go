@@ -5,9 +5,8 @@ package checks
 import (
 	context "context"
 
-	mock "github.com/stretchr/testify/mock"
-
 	models "github.com/percona/pmm/managed/models"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // mockAgentsRegistry is an autogenerated mock type for the agentsRegistry type
@@ -15,17 +14,17 @@ type mockAgentsRegistry struct {
 	mock.Mock
 }
 
-// StartMongoDBQueryBuildInfoAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, files, tdp
-func (_m *mockAgentsRegistry) StartMongoDBQueryBuildInfoAction(ctx context.Context, id string, pmmAgentID string, dsn string, files map[string]string, tdp *models.DelimiterPair) error {
-	ret := _m.Called(ctx, id, pmmAgentID, dsn, files, tdp)
+// StartMongoDBQueryBuildInfoAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, files, tdp, secrets
+func (_m *mockAgentsRegistry) StartMongoDBQueryBuildInfoAction(ctx context.Context, id string, pmmAgentID string, dsn string, files map[string]string, tdp *models.DelimiterPair, secrets map[string]string) error {
+	ret := _m.Called(ctx, id, pmmAgentID, dsn, files, tdp, secrets)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StartMongoDBQueryBuildInfoAction")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, map[string]string, *models.DelimiterPair) error); ok {
-		r0 = rf(ctx, id, pmmAgentID, dsn, files, tdp)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, map[string]string, *models.DelimiterPair, map[string]string) error); ok {
+		r0 = rf(ctx, id, pmmAgentID, dsn, files, tdp, secrets)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -33,17 +32,17 @@ func (_m *mockAgentsRegistry) StartMongoDBQueryBuildInfoAction(ctx context.Conte
 	return r0
 }
 
-// StartMongoDBQueryGetCmdLineOptsAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, files, tdp
-func (_m *mockAgentsRegistry) StartMongoDBQueryGetCmdLineOptsAction(ctx context.Context, id string, pmmAgentID string, dsn string, files map[string]string, tdp *models.DelimiterPair) error {
-	ret := _m.Called(ctx, id, pmmAgentID, dsn, files, tdp)
+// StartMongoDBQueryGetCmdLineOptsAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, files, tdp, secrets
+func (_m *mockAgentsRegistry) StartMongoDBQueryGetCmdLineOptsAction(ctx context.Context, id string, pmmAgentID string, dsn string, files map[string]string, tdp *models.DelimiterPair, secrets map[string]string) error {
+	ret := _m.Called(ctx, id, pmmAgentID, dsn, files, tdp, secrets)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StartMongoDBQueryGetCmdLineOptsAction")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, map[string]string, *models.DelimiterPair) error); ok {
-		r0 = rf(ctx, id, pmmAgentID, dsn, files, tdp)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, map[string]string, *models.DelimiterPair, map[string]string) error); ok {
+		r0 = rf(ctx, id, pmmAgentID, dsn, files, tdp, secrets)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -51,17 +50,17 @@ func (_m *mockAgentsRegistry) StartMongoDBQueryGetCmdLineOptsAction(ctx context.
 	return r0
 }
 
-// StartMongoDBQueryGetDiagnosticDataAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, files, tdp
-func (_m *mockAgentsRegistry) StartMongoDBQueryGetDiagnosticDataAction(ctx context.Context, id string, pmmAgentID string, dsn string, files map[string]string, tdp *models.DelimiterPair) error {
-	ret := _m.Called(ctx, id, pmmAgentID, dsn, files, tdp)
+// StartMongoDBQueryGetDiagnosticDataAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, files, tdp, secrets
+func (_m *mockAgentsRegistry) StartMongoDBQueryGetDiagnosticDataAction(ctx context.Context, id string, pmmAgentID string, dsn string, files map[string]string, tdp *models.DelimiterPair, secrets map[string]string) error {
+	ret := _m.Called(ctx, id, pmmAgentID, dsn, files, tdp, secrets)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StartMongoDBQueryGetDiagnosticDataAction")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, map[string]string, *models.DelimiterPair) error); ok {
-		r0 = rf(ctx, id, pmmAgentID, dsn, files, tdp)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, map[string]string, *models.DelimiterPair, map[string]string) error); ok {
+		r0 = rf(ctx, id, pmmAgentID, dsn, files, tdp, secrets)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -69,17 +68,17 @@ func (_m *mockAgentsRegistry) StartMongoDBQueryGetDiagnosticDataAction(ctx conte
 	return r0
 }
 
-// StartMongoDBQueryGetParameterAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, files, tdp
-func (_m *mockAgentsRegistry) StartMongoDBQueryGetParameterAction(ctx context.Context, id string, pmmAgentID string, dsn string, files map[string]string, tdp *models.DelimiterPair) error {
-	ret := _m.Called(ctx, id, pmmAgentID, dsn, files, tdp)
+// StartMongoDBQueryGetParameterAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, files, tdp, secrets
+func (_m *mockAgentsRegistry) StartMongoDBQueryGetParameterAction(ctx context.Context, id string, pmmAgentID string, dsn string, files map[string]string, tdp *models.DelimiterPair, secrets map[string]string) error {
+	ret := _m.Called(ctx, id, pmmAgentID, dsn, files, tdp, secrets)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StartMongoDBQueryGetParameterAction")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, map[string]string, *models.DelimiterPair) error); ok {
-		r0 = rf(ctx, id, pmmAgentID, dsn, files, tdp)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, map[string]string, *models.DelimiterPair, map[string]string) error); ok {
+		r0 = rf(ctx, id, pmmAgentID, dsn, files, tdp, secrets)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -87,17 +86,17 @@ func (_m *mockAgentsRegistry) StartMongoDBQueryGetParameterAction(ctx context.Co
 	return r0
 }
 
-// StartMongoDBQueryReplSetGetStatusAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, files, tdp
-func (_m *mockAgentsRegistry) StartMongoDBQueryReplSetGetStatusAction(ctx context.Context, id string, pmmAgentID string, dsn string, files map[string]string, tdp *models.DelimiterPair) error {
-	ret := _m.Called(ctx, id, pmmAgentID, dsn, files, tdp)
+// StartMongoDBQueryReplSetGetStatusAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, files, tdp, secrets
+func (_m *mockAgentsRegistry) StartMongoDBQueryReplSetGetStatusAction(ctx context.Context, id string, pmmAgentID string, dsn string, files map[string]string, tdp *models.DelimiterPair, secrets map[string]string) error {
+	ret := _m.Called(ctx, id, pmmAgentID, dsn, files, tdp, secrets)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StartMongoDBQueryReplSetGetStatusAction")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, map[string]string, *models.DelimiterPair) error); ok {
-		r0 = rf(ctx, id, pmmAgentID, dsn, files, tdp)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, map[string]string, *models.DelimiterPair, map[string]string) error); ok {
+		r0 = rf(ctx, id, pmmAgentID, dsn, files, tdp, secrets)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -105,17 +104,17 @@ func (_m *mockAgentsRegistry) StartMongoDBQueryReplSetGetStatusAction(ctx contex
 	return r0
 }
 
-// StartMySQLQuerySelectAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, query, files, tdp, tlsSkipVerify
-func (_m *mockAgentsRegistry) StartMySQLQuerySelectAction(ctx context.Context, id string, pmmAgentID string, dsn string, query string, files map[string]string, tdp *models.DelimiterPair, tlsSkipVerify bool) error {
-	ret := _m.Called(ctx, id, pmmAgentID, dsn, query, files, tdp, tlsSkipVerify)
+// StartMySQLQuerySelectAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, query, files, tdp, tlsSkipVerify, secrets
+func (_m *mockAgentsRegistry) StartMySQLQuerySelectAction(ctx context.Context, id string, pmmAgentID string, dsn string, query string, files map[string]string, tdp *models.DelimiterPair, tlsSkipVerify bool, secrets map[string]string) error {
+	ret := _m.Called(ctx, id, pmmAgentID, dsn, query, files, tdp, tlsSkipVerify, secrets)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StartMySQLQuerySelectAction")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, map[string]string, *models.DelimiterPair, bool) error); ok {
-		r0 = rf(ctx, id, pmmAgentID, dsn, query, files, tdp, tlsSkipVerify)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, map[string]string, *models.DelimiterPair, bool, map[string]string) error); ok {
+		r0 = rf(ctx, id, pmmAgentID, dsn, query, files, tdp, tlsSkipVerify, secrets)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -123,17 +122,17 @@ func (_m *mockAgentsRegistry) StartMySQLQuerySelectAction(ctx context.Context, i
 	return r0
 }
 
-// StartMySQLQueryShowAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, query, files, tdp, tlsSkipVerify
-func (_m *mockAgentsRegistry) StartMySQLQueryShowAction(ctx context.Context, id string, pmmAgentID string, dsn string, query string, files map[string]string, tdp *models.DelimiterPair, tlsSkipVerify bool) error {
-	ret := _m.Called(ctx, id, pmmAgentID, dsn, query, files, tdp, tlsSkipVerify)
+// StartMySQLQueryShowAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, query, files, tdp, tlsSkipVerify, secrets
+func (_m *mockAgentsRegistry) StartMySQLQueryShowAction(ctx context.Context, id string, pmmAgentID string, dsn string, query string, files map[string]string, tdp *models.DelimiterPair, tlsSkipVerify bool, secrets map[string]string) error {
+	ret := _m.Called(ctx, id, pmmAgentID, dsn, query, files, tdp, tlsSkipVerify, secrets)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StartMySQLQueryShowAction")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, map[string]string, *models.DelimiterPair, bool) error); ok {
-		r0 = rf(ctx, id, pmmAgentID, dsn, query, files, tdp, tlsSkipVerify)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, map[string]string, *models.DelimiterPair, bool, map[string]string) error); ok {
+		r0 = rf(ctx, id, pmmAgentID, dsn, query, files, tdp, tlsSkipVerify, secrets)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -141,17 +140,17 @@ func (_m *mockAgentsRegistry) StartMySQLQueryShowAction(ctx context.Context, id
 	return r0
 }
 
-// StartPostgreSQLQuerySelectAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, query
-func (_m *mockAgentsRegistry) StartPostgreSQLQuerySelectAction(ctx context.Context, id string, pmmAgentID string, dsn string, query string) error {
-	ret := _m.Called(ctx, id, pmmAgentID, dsn, query)
+// StartPostgreSQLQuerySelectAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, query, files, tdp, secrets
+func (_m *mockAgentsRegistry) StartPostgreSQLQuerySelectAction(ctx context.Context, id string, pmmAgentID string, dsn string, query string, files map[string]string, tdp *models.DelimiterPair, secrets map[string]string) error {
+	ret := _m.Called(ctx, id, pmmAgentID, dsn, query, files, tdp, secrets)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StartPostgreSQLQuerySelectAction")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) error); ok {
-		r0 = rf(ctx, id, pmmAgentID, dsn, query)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, map[string]string, *models.DelimiterPair, map[string]string) error); ok {
+		r0 = rf(ctx, id, pmmAgentID, dsn, query, files, tdp, secrets)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -159,17 +158,17 @@ func (_m *mockAgentsRegistry) StartPostgreSQLQuerySelectAction(ctx context.Conte
 	return r0
 }
 
-// StartPostgreSQLQueryShowAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn
-func (_m *mockAgentsRegistry) StartPostgreSQLQueryShowAction(ctx context.Context, id string, pmmAgentID string, dsn string) error {
-	ret := _m.Called(ctx, id, pmmAgentID, dsn)
+// StartPostgreSQLQueryShowAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, files, tdp, secrets
+func (_m *mockAgentsRegistry) StartPostgreSQLQueryShowAction(ctx context.Context, id string, pmmAgentID string, dsn string, files map[string]string, tdp *models.DelimiterPair, secrets map[string]string) error {
+	ret := _m.Called(ctx, id, pmmAgentID, dsn, files, tdp, secrets)
 
 	if len(ret) == 0 {
 		panic("no return value specified for StartPostgreSQLQueryShowAction")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
-		r0 = rf(ctx, id, pmmAgentID, dsn)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, map[string]string, *models.DelimiterPair, map[string]string) error); ok {
+		r0 = rf(ctx, id, pmmAgentID, dsn, files, tdp, secrets)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -182,8 +181,7 @@ func (_m *mockAgentsRegistry) StartPostgreSQLQueryShowAction(ctx context.Context
 func newMockAgentsRegistry(t interface {
 	mock.TestingT
 	Cleanup(func())
-},
-) *mockAgentsRegistry {
+}) *mockAgentsRegistry {
 	mock := &mockAgentsRegistry{}
 	mock.Mock.Test(t)
 
@@ -0,0 +1,59 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package services
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// InsertIntoClickHouse inserts rows with the given INSERT statement in one batch.
+// ClickHouse driver sends the batch on commit, so either all rows are stored or none.
+func InsertIntoClickHouse(ctx context.Context, db *sql.DB, query string, rows [][]any) (err error) {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	// begin "transaction" and commit or rollback it on exit
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			if err != nil {
+				err = fmt.Errorf("failed to commit transaction: %w", err)
+			}
+		} else {
+			_ = tx.Rollback()
+		}
+	}()
+
+	stmt, err := tx.PrepareContext(ctx, query)
+	if err != nil {
+		return fmt.Errorf("failed to prepare statement: %w", err)
+	}
+	defer stmt.Close() //nolint:errcheck
+
+	for _, row := range rows {
+		if _, err = stmt.ExecContext(ctx, row...); err != nil {
+			return fmt.Errorf("failed to insert row: %w", err)
+		}
+	}
+	return nil
+}
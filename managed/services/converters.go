@@ -488,6 +488,24 @@ func ToAPIAgent(q *reform.Querier, agent *models.Agent) (inventoryv1.Agent, erro
 			LogLevel:               inventoryv1.LogLevelAPIValue(agent.LogLevel),
 		}, nil
 
+	case models.QANPostgreSQLLogAgentType:
+		return &inventoryv1.QANPostgreSQLLogAgent{
+			AgentId:                agent.AgentID,
+			PmmAgentId:             pointer.GetString(agent.PMMAgentID),
+			ServiceId:              serviceID,
+			Username:               pointer.GetString(agent.Username),
+			Disabled:               agent.Disabled,
+			Status:                 inventoryv1.AgentStatus(inventoryv1.AgentStatus_value[agent.Status]),
+			CustomLabels:           labels,
+			MaxQueryLength:         agent.QANOptions.MaxQueryLength,
+			Tls:                    agent.TLS,
+			TlsSkipVerify:          agent.TLSSkipVerify,
+			QueryExamplesDisabled:  agent.QANOptions.QueryExamplesDisabled,
+			DisableCommentsParsing: agent.QANOptions.CommentsParsingDisabled,
+			ProcessExecPath:        processExecPath,
+			LogLevel:               inventoryv1.LogLevelAPIValue(agent.LogLevel),
+		}, nil
+
 	case models.RDSExporterType:
 		exporter := &inventoryv1.RDSExporter{
 			AgentId:                 agent.AgentID,
@@ -607,6 +625,69 @@ func ToAPIAgent(q *reform.Querier, agent *models.Agent) (inventoryv1.Agent, erro
 			RtaOptions:    ToAPIRTAOptions(&agent.RTAOptions),
 		}, nil
 
+	case models.RTAMySQLAgentType:
+		return &inventoryv1.RTAMySQLAgent{
+			AgentId:       agent.AgentID,
+			PmmAgentId:    pointer.GetString(agent.PMMAgentID),
+			ServiceId:     serviceID,
+			Username:      pointer.GetString(agent.Username),
+			Disabled:      agent.Disabled,
+			Status:        inventoryv1.AgentStatus(inventoryv1.AgentStatus_value[agent.Status]),
+			CustomLabels:  labels,
+			Tls:           agent.TLS,
+			TlsSkipVerify: agent.TLSSkipVerify,
+			LogLevel:      inventoryv1.LogLevelAPIValue(agent.LogLevel),
+			RtaOptions:    ToAPIRTAOptions(&agent.RTAOptions),
+		}, nil
+
+	case models.RTAPostgreSQLAgentType:
+		return &inventoryv1.RTAPostgreSQLAgent{
+			AgentId:       agent.AgentID,
+			PmmAgentId:    pointer.GetString(agent.PMMAgentID),
+			ServiceId:     serviceID,
+			Username:      pointer.GetString(agent.Username),
+			Disabled:      agent.Disabled,
+			Status:        inventoryv1.AgentStatus(inventoryv1.AgentStatus_value[agent.Status]),
+			CustomLabels:  labels,
+			Tls:           agent.TLS,
+			TlsSkipVerify: agent.TLSSkipVerify,
+			LogLevel:      inventoryv1.LogLevelAPIValue(agent.LogLevel),
+			RtaOptions:    ToAPIRTAOptions(&agent.RTAOptions),
+		}, nil
+
+	case models.QANValkeySlowlogAgentType:
+		return &inventoryv1.QANValkeySlowlogAgent{
+			AgentId:               agent.AgentID,
+			PmmAgentId:            pointer.GetString(agent.PMMAgentID),
+			ServiceId:             serviceID,
+			Username:              pointer.GetString(agent.Username),
+			Disabled:              agent.Disabled,
+			Status:                inventoryv1.AgentStatus(inventoryv1.AgentStatus_value[agent.Status]),
+			CustomLabels:          labels,
+			Tls:                   agent.TLS,
+			TlsSkipVerify:         agent.TLSSkipVerify,
+			MaxQueryLength:        agent.QANOptions.MaxQueryLength,
+			QueryExamplesDisabled: agent.QANOptions.QueryExamplesDisabled,
+			ProcessExecPath:       processExecPath,
+			LogLevel:              inventoryv1.LogLevelAPIValue(agent.LogLevel),
+		}, nil
+
+	case models.QANProxySQLDigestAgentType:
+		return &inventoryv1.QANProxySQLDigestAgent{
+			AgentId:         agent.AgentID,
+			PmmAgentId:      pointer.GetString(agent.PMMAgentID),
+			ServiceId:       serviceID,
+			Username:        pointer.GetString(agent.Username),
+			Disabled:        agent.Disabled,
+			Status:          inventoryv1.AgentStatus(inventoryv1.AgentStatus_value[agent.Status]),
+			CustomLabels:    labels,
+			Tls:             agent.TLS,
+			TlsSkipVerify:   agent.TLSSkipVerify,
+			MaxQueryLength:  agent.QANOptions.MaxQueryLength,
+			ProcessExecPath: processExecPath,
+			LogLevel:        inventoryv1.LogLevelAPIValue(agent.LogLevel),
+		}, nil
+
 	default:
 		panic(fmt.Errorf("cannot convert unknown agent type %s", agent.AgentType))
 	}
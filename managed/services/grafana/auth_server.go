@@ -37,12 +37,15 @@ import (
 	"gopkg.in/reform.v1"
 
 	"github.com/percona/pmm/managed/models"
+	"github.com/percona/pmm/managed/services/audit"
+	"github.com/percona/pmm/managed/services/permissions"
 )
 
 const (
 	connectionEndpointV2 = "/agent.Agent/Connect"
 	connectionEndpoint   = "/agent.v1.AgentService/Connect"
 	rtaCollectEndpoint   = "/realtimeanalytics.v1.CollectorService/Collect"
+	vmWriteEndpoint      = "/victoriametrics/api/v1/write"
 )
 
 // rules maps original URL prefix to minimal required role.
@@ -85,6 +88,7 @@ var rules = map[string]role{
 	"/v1/server/updates":              viewer,
 	"/v1/server/settings":             admin,
 	"/v1/server/settings/readonly":    viewer,
+	"/v1/server/audit":                admin,
 	"/v1/platform:":                   admin,
 	"/v1/platform":                    viewer,
 	"/v1/users":                       viewer,
@@ -99,11 +103,14 @@ var rules = map[string]role{
 	// must not be available without authentication as it can leak data
 	"/v1/server/version": viewer,
 
-	"/v1/qan":  viewer,
-	"/v1/qan:": viewer,
+	"/v1/qan":                    viewer,
+	"/v1/qan:":                   viewer,
+	"/v1/qan/annotations:set":    editor,
+	"/v1/qan/annotations:remove": editor,
 
 	"/prometheus":      admin,
 	"/victoriametrics": admin,
+	vmWriteEndpoint:    admin, // vmagent of pmm-agent in push mode
 	"/nomad":           admin,
 	"/graph":           none,
 	"/swagger":         viewer,
@@ -116,12 +123,15 @@ var rules = map[string]role{
 	"/logs.zip":   admin,  // redirects to /v1/server/logs.zip
 
 	// Real-Time Analytics endpoints.
-	rtaCollectEndpoint:                     admin,
-	"/v1/realtimeanalytics/sessions:start": admin,
-	"/v1/realtimeanalytics/sessions:stop":  admin,
-	"/v1/realtimeanalytics/sessions":       viewer,
-	"/v1/realtimeanalytics/services":       viewer,
-	"/v1/realtimeanalytics/queries:search": viewer,
+	rtaCollectEndpoint:                      admin,
+	"/v1/realtimeanalytics/sessions:start":  admin,
+	"/v1/realtimeanalytics/sessions:stop":   admin,
+	"/v1/realtimeanalytics/sessions:replay": viewer,
+	"/v1/realtimeanalytics/sessions":        viewer,
+	"/v1/realtimeanalytics/services":        viewer,
+	"/v1/realtimeanalytics/queries:search":  viewer,
+	"/v1/realtimeanalytics/queries:watch":   viewer,
+	"/v1/realtimeanalytics/queries:kill":    admin,
 
 	// "/auth_request"  has auth_request disabled in nginx config
 
@@ -188,6 +198,7 @@ type clientInterface interface {
 type AuthServer struct {
 	c  clientInterface
 	db *reform.DB
+	ca certificateVerifier
 	l  *logrus.Entry
 
 	cache map[string]cacheItem
@@ -199,10 +210,11 @@ type AuthServer struct {
 }
 
 // NewAuthServer creates new AuthServer.
-func NewAuthServer(c clientInterface, db *reform.DB) *AuthServer {
+func NewAuthServer(c clientInterface, db *reform.DB, ca certificateVerifier) *AuthServer {
 	return &AuthServer{
 		c:     c,
 		db:    db,
+		ca:    ca,
 		l:     logrus.WithField("component", "grafana/auth"),
 		cache: make(map[string]cacheItem),
 		accessControl: &accessControl{
@@ -273,6 +285,7 @@ func (s *AuthServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	var userID int
 	if authUser != nil {
 		userID = authUser.userID
+		s.addUserHeader(rw, authUser, l)
 	}
 
 	errF := s.maybeAddLBACFilters(ctx, rw, req, userID, l)
@@ -290,6 +303,20 @@ func (s *AuthServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
 	}
 }
 
+// addUserHeader passes the identity of the authenticated user to the upstream for the audit log.
+func (s *AuthServer) addUserHeader(rw http.ResponseWriter, user *authUser, l *logrus.Entry) {
+	v, err := audit.EncodeUser(&audit.User{
+		ID:    int64(user.userID),
+		Login: user.login,
+		Role:  user.role.String(),
+	})
+	if err != nil {
+		l.Warnf("Failed to encode user: %s.", err)
+		return
+	}
+	rw.Header().Set(audit.UserHeaderName, v)
+}
+
 // httpStatusForAuthError maps an authError code to the HTTP status nginx receives.
 // PermissionDenied uses 403 so nginx denies outright; the 401 re-run is a GET and would
 // wrongly pass method-specific rules. Authentication and internal errors stay 401.
@@ -425,6 +452,24 @@ func (s *AuthServer) getLBACFilters(ctx context.Context, userID int) ([]string,
 	return filters, nil
 }
 
+// hasPermission returns true if access control is enabled, and the user has a role with the given permission scope.
+func (s *AuthServer) hasPermission(ctx context.Context, userID int, p models.Permission) (bool, error) {
+	q := s.db.WithContext(ctx)
+	settings, err := models.GetSettings(q)
+	if err != nil {
+		return false, err
+	}
+	if !settings.IsAccessControlEnabled() {
+		return false, nil
+	}
+
+	roles, err := models.GetUserRolesWithPermission(q, userID, p)
+	if err != nil {
+		return false, err
+	}
+	return len(roles) > 0, nil
+}
+
 // extractOriginalRequest replaces req.Method and req.URL.Path with values from original request.
 // Error is returned if original request information is missing or invalid.
 func extractOriginalRequest(req *http.Request) error {
@@ -508,6 +553,62 @@ func isLocalAgentConnection(req *http.Request) bool {
 	return false
 }
 
+// agentCertificateUser authenticates requests of pmm-agent and its vmagent by the client certificate passed by nginx.
+// It returns pmm-agent user if the certificate is valid, and nil user and nil error if the request should be
+// authenticated by Grafana. The certificate is required for pmm-agent that was issued one,
+// so that it can't fall back to a token once its certificates are revoked or expired.
+func (s *AuthServer) agentCertificateUser(req *http.Request, path string, l *logrus.Entry) (*authUser, *authError) {
+	if s.ca == nil {
+		return nil, nil
+	}
+
+	var pmmAgentID string
+	switch path {
+	case connectionEndpoint, rtaCollectEndpoint:
+		pmmAgentID = req.Header.Get("Pmm-Agent-Id")
+		if pmmAgentID == "" {
+			return nil, nil
+		}
+	case vmWriteEndpoint:
+		// vmagent does not send pmm-agent ID, it is taken from the certificate
+	default:
+		return nil, nil
+	}
+
+	cert := req.Header.Get("X-Client-Certificate")
+	if cert == "" {
+		if pmmAgentID == "" {
+			return nil, nil
+		}
+
+		required, err := s.ca.HasCertificates(s.db.Querier, pmmAgentID)
+		if err != nil {
+			l.Errorf("Failed to check pmm-agent certificates: %s.", err)
+			return nil, &authError{code: codes.Internal, message: "Internal server error."}
+		}
+		if required {
+			l.Warnf("pmm-agent %s connects without a client certificate.", pmmAgentID)
+			return nil, &authError{code: codes.Unauthenticated, message: "Client certificate is required."}
+		}
+		return nil, nil
+	}
+
+	c, err := s.ca.Verify(s.db.Querier, cert)
+	if err != nil {
+		l.Warnf("Invalid pmm-agent client certificate: %s.", err)
+		return nil, &authError{code: codes.Unauthenticated, message: "Invalid client certificate."}
+	}
+	if pmmAgentID != "" && pmmAgentID != c.PMMAgentID {
+		l.Warnf("Client certificate of pmm-agent %s is used by %q.", c.PMMAgentID, pmmAgentID)
+		return nil, &authError{code: codes.Unauthenticated, message: "Invalid client certificate."}
+	}
+
+	return &authUser{
+		role:   rules[path],
+		userID: 0,
+	}, nil
+}
+
 // authenticate checks if user has access to a specific path.
 // It returns user information retrieved during authentication.
 // Paths which require no Grafana role return zero value for
@@ -547,11 +648,17 @@ func (s *AuthServer) authenticate(ctx context.Context, req *http.Request, l *log
 		}
 	} else {
 		var authErr *authError
-		// Get authenticated user from Grafana
-		user, authErr = s.getAuthUser(ctx, req, l)
+		user, authErr = s.agentCertificateUser(req, cleanedPath, l)
 		if authErr != nil {
 			return nil, authErr
 		}
+		if user == nil {
+			// Get authenticated user from Grafana
+			user, authErr = s.getAuthUser(ctx, req, l)
+			if authErr != nil {
+				return nil, authErr
+			}
+		}
 	}
 	l = l.WithField("role", user.role.String())
 
@@ -565,6 +672,20 @@ func (s *AuthServer) authenticate(ctx context.Context, req *http.Request, l *log
 		return user, nil
 	}
 
+	// Access control roles may grant permission scopes for some requests to users without the required Grafana role.
+	// They are checked in detail, including label filters, by the pmm-managed gRPC interceptor.
+	if p, ok := permissions.ForRequest(req.Method, cleanedPath); ok && user.userID > 0 {
+		granted, err := s.hasPermission(ctx, user.userID, p)
+		if err != nil {
+			l.Errorf("Failed to check permission %s: %s.", p, err)
+			return nil, &authError{code: codes.Internal, message: "Internal server error."}
+		}
+		if granted {
+			l.Debugf("Permission %s is granted by access control roles, granting access.", p)
+			return user, nil
+		}
+	}
+
 	l.Warnf("Minimal required role is %s, denying access.", minRole)
 	return nil, &authError{code: codes.PermissionDenied, message: "Access denied"}
 }
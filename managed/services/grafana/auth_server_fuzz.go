@@ -38,7 +38,7 @@ func Fuzz(data []byte) int {
 	logrus.SetOutput(io.Discard)
 
 	var c clientStub
-	s := NewAuthServer(c, nil)
+	s := NewAuthServer(c, nil, nil)
 
 	req, err := http.NewRequest(http.MethodGet, string(data), nil)
 	if err != nil {
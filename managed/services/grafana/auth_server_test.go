@@ -18,6 +18,7 @@ package grafana
 import (
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -76,6 +77,10 @@ func TestResolveRule(t *testing.T) {
 		{http.MethodPut, "/v1/alerting/templates/foo", editor},    // UpdateTemplate
 		{http.MethodDelete, "/v1/alerting/templates/foo", editor}, // DeleteTemplate
 		{http.MethodPost, "/v1/alerting/rules", editor},           // CreateRule
+		// QAN: annotations are viewable, changing them needs editor.
+		{http.MethodPost, "/v1/qan/annotations:list", viewer},
+		{http.MethodPost, "/v1/qan/annotations:set", editor},
+		{http.MethodPost, "/v1/qan/annotations:remove", editor},
 		// No matching rule falls back to grafanaAdmin.
 		{http.MethodGet, "/v1/unknown", grafanaAdmin},
 	} {
@@ -93,7 +98,7 @@ func TestAuthServerAuthenticate(t *testing.T) {
 
 	ctx := t.Context()
 	c := NewClient("127.0.0.1:3000")
-	s := NewAuthServer(c, nil)
+	s := NewAuthServer(c, nil, nil)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "/dummy", nil)
 	require.NoError(t, err)
@@ -157,7 +162,7 @@ func TestServerClientConnection(t *testing.T) {
 
 	ctx := t.Context()
 	c := NewClient("127.0.0.1:3000")
-	s := NewAuthServer(c, nil)
+	s := NewAuthServer(c, nil, nil)
 
 	t.Run("Basic auth - success", func(t *testing.T) {
 		t.Parallel()
@@ -218,6 +223,72 @@ func TestServerClientConnection(t *testing.T) {
 	})
 }
 
+type certificateVerifierStub struct {
+	cert *models.AgentCertificate
+	err  error
+	has  bool
+}
+
+func (c certificateVerifierStub) Verify(*reform.Querier, string) (*models.AgentCertificate, error) {
+	return c.cert, c.err
+}
+
+func (c certificateVerifierStub) HasCertificates(*reform.Querier, string) (bool, error) {
+	return c.has, nil
+}
+
+func TestAgentCertificateUser(t *testing.T) {
+	t.Parallel()
+
+	db := reform.NewDB(nil, postgresql.Dialect, nil)
+	l := logrus.WithField("test", t.Name())
+	cert := &models.AgentCertificate{PMMAgentID: "pmm-agent-id"}
+	invalid := &authError{code: codes.Unauthenticated, message: "Invalid client certificate."}
+
+	for _, tc := range []struct {
+		name        string
+		ca          certificateVerifier
+		path        string
+		agentID     string
+		certificate string
+		expected    *authUser
+		expectedErr *authError
+	}{
+		{"Valid", certificateVerifierStub{cert: cert}, connectionEndpoint, "pmm-agent-id", "certificate", &authUser{role: admin}, nil},
+		{"ValidRTA", certificateVerifierStub{cert: cert}, rtaCollectEndpoint, "pmm-agent-id", "certificate", &authUser{role: admin}, nil},
+		{"ValidVMAgent", certificateVerifierStub{cert: cert}, vmWriteEndpoint, "", "certificate", &authUser{role: admin}, nil},
+		{"NoCA", nil, connectionEndpoint, "pmm-agent-id", "certificate", nil, nil},
+		{"Invalid", certificateVerifierStub{err: errors.New("revoked")}, connectionEndpoint, "pmm-agent-id", "certificate", nil, invalid},
+		{"InvalidVMAgent", certificateVerifierStub{err: errors.New("revoked")}, vmWriteEndpoint, "", "certificate", nil, invalid},
+		{"AnotherAgent", certificateVerifierStub{cert: cert}, connectionEndpoint, "another-pmm-agent-id", "certificate", nil, invalid},
+		{"OtherEndpoint", certificateVerifierStub{cert: cert}, "/v1/inventory/nodes", "pmm-agent-id", "certificate", nil, nil},
+		{"TokenAgent", certificateVerifierStub{}, connectionEndpoint, "pmm-agent-id", "", nil, nil},
+		{"TokenVMAgent", certificateVerifierStub{}, vmWriteEndpoint, "", "", nil, nil},
+		{
+			"TokenCertificateAgent", certificateVerifierStub{has: true}, connectionEndpoint, "pmm-agent-id", "", nil,
+			&authError{code: codes.Unauthenticated, message: "Client certificate is required."},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := NewAuthServer(nil, db, tc.ca)
+			req := httptest.NewRequest(http.MethodPost, "/auth_request", nil)
+			req.Header.Set("X-Original-Uri", tc.path)
+			if tc.certificate != "" {
+				req.Header.Set("X-Client-Certificate", tc.certificate)
+			}
+			if tc.agentID != "" {
+				req.Header.Set("Pmm-Agent-Id", tc.agentID)
+			}
+
+			user, err := s.agentCertificateUser(req, tc.path, l)
+			assert.Equal(t, tc.expected, user)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
 func TestAuthServerAddVMGatewayToken(t *testing.T) {
 	ctx := logger.Set(t.Context(), t.Name())
 	uuid.SetRand(&tests.IDReader{})
@@ -234,7 +305,7 @@ func TestAuthServerAddVMGatewayToken(t *testing.T) {
 	}(t)
 
 	c := NewClient("127.0.0.1:3000")
-	s := NewAuthServer(c, db)
+	s := NewAuthServer(c, db, nil)
 
 	roleA := models.Role{
 		Title:  "Role A",
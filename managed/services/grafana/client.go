@@ -205,6 +205,7 @@ func (c *Client) do(ctx context.Context, method, path, rawQuery string, headers
 type authUser struct {
 	role   role
 	userID int
+	login  string
 }
 
 // CurrentUser represents Grafana user payload.
@@ -332,10 +333,12 @@ func (c *Client) getAuthUser(ctx context.Context, authHeaders http.Header, l *lo
 
 	id, _ := m["id"].(float64)
 	userID := int(id)
+	login, _ := m["login"].(string)
 	if a, _ := m["isGrafanaAdmin"].(bool); a {
 		return authUser{
 			role:   grafanaAdmin,
 			userID: userID,
+			login:  login,
 		}, nil
 	}
 
@@ -346,6 +349,7 @@ func (c *Client) getAuthUser(ctx context.Context, authHeaders http.Header, l *lo
 		return authUser{
 			role:   none,
 			userID: userID,
+			login:  login,
 		}, err
 	}
 
@@ -361,6 +365,7 @@ func (c *Client) getAuthUser(ctx context.Context, authHeaders http.Header, l *lo
 			return authUser{
 				role:   c.convertRole(role),
 				userID: userID,
+				login:  login,
 			}, nil
 		}
 	}
@@ -368,6 +373,7 @@ func (c *Client) getAuthUser(ctx context.Context, authHeaders http.Header, l *lo
 	return authUser{
 		role:   none,
 		userID: userID,
+		login:  login,
 	}, nil
 }
 
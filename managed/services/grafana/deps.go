@@ -14,3 +14,16 @@
 // along with this program. If not, see <https://www.gnu.org/licenses/>.
 
 package grafana
+
+import (
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm/managed/models"
+)
+
+// certificateVerifier is a subset of methods of ca.CA used by this package.
+// We use it instead of real type for testing.
+type certificateVerifier interface {
+	Verify(q *reform.Querier, certPEM string) (*models.AgentCertificate, error)
+	HasCertificates(q *reform.Querier, pmmAgentID string) (bool, error)
+}
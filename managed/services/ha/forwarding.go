@@ -0,0 +1,179 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// agentRequestTimeout limits handling of a request forwarded to pmm-agent connected to another node.
+const agentRequestTimeout = 30 * time.Second
+
+// agentMessageType is a type of message exchanged between nodes to reach pmm-agent connected to another node.
+type agentMessageType string
+
+const (
+	agentMessageRequest  agentMessageType = "agent_request"
+	agentMessageResponse agentMessageType = "agent_response"
+)
+
+// agentMessage is a request to pmm-agent forwarded to the node holding its gRPC stream, or a response to it.
+// Unlike commands, it is sent directly to that node over encrypted memberlist and is not stored in the Raft log.
+type agentMessage struct {
+	Type agentMessageType `json:"type"`
+	ID   uint64           `json:"id"`
+	// Node is an ID of the node that sent the message.
+	Node       string `json:"node"`
+	PMMAgentID string `json:"pmm_agent_id,omitempty"`
+	Payload    []byte `json:"payload,omitempty"`
+	// Code and Error describe gRPC status of the failed request.
+	Code  codes.Code `json:"code,omitempty"`
+	Error string     `json:"error,omitempty"`
+}
+
+// AgentRequestHandler sends the request forwarded by another node to pmm-agent connected to this node
+// and returns pmm-agent's response. Requests and responses are opaque for the HA service.
+type AgentRequestHandler func(ctx context.Context, pmmAgentID string, req []byte) ([]byte, error)
+
+// SetAgentRequestHandler sets the function handling requests forwarded by other nodes.
+// It does nothing when state replication is disabled.
+func (s *Service) SetAgentRequestHandler(fn AgentRequestHandler) {
+	if !s.ReplicationEnabled() {
+		return
+	}
+
+	s.agentRW.Lock()
+	s.agentHandler = fn
+	s.agentRW.Unlock()
+}
+
+// ForwardAgentRequest sends the request to pmm-agent connected to the given node and waits for the response.
+func (s *Service) ForwardAgentRequest(ctx context.Context, nodeID, pmmAgentID string, req []byte) ([]byte, error) {
+	if !s.ReplicationEnabled() {
+		return nil, errors.New("state replication is disabled")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, agentRequestTimeout)
+	defer cancel()
+
+	id := s.lastAgentRequestID.Add(1)
+	ch := make(chan *agentMessage, 1)
+	s.agentRW.Lock()
+	s.agentResponses[id] = ch
+	s.agentRW.Unlock()
+
+	defer func() {
+		s.agentRW.Lock()
+		delete(s.agentResponses, id)
+		s.agentRW.Unlock()
+	}()
+
+	err := s.sendAgentMessage(nodeID, &agentMessage{
+		Type:       agentMessageRequest,
+		ID:         id,
+		Node:       s.params.NodeID,
+		PMMAgentID: pmmAgentID,
+		Payload:    req,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to forward request to pmm-agent with ID %s to node %s: %w", pmmAgentID, nodeID, err)
+	}
+
+	select {
+	case res := <-ch:
+		if res.Code != codes.OK {
+			return nil, status.Error(res.Code, res.Error)
+		}
+		return res.Payload, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// handleAgentMessage handles a request or response received from another node.
+// It is called from memberlist, so it must not block.
+func (s *Service) handleAgentMessage(msg *agentMessage) {
+	switch msg.Type {
+	case agentMessageRequest:
+		s.wg.Go(func() {
+			s.serveAgentRequest(msg)
+		})
+
+	case agentMessageResponse:
+		s.agentRW.RLock()
+		ch := s.agentResponses[msg.ID]
+		s.agentRW.RUnlock()
+		if ch == nil {
+			s.l.Debugf("Dropping response %d from node %s: request is not awaited anymore.", msg.ID, msg.Node)
+			return
+		}
+
+		select {
+		case ch <- msg:
+		default:
+		}
+
+	default:
+		s.l.Warnf("Dropping message of unknown type %q from node %s.", msg.Type, msg.Node)
+	}
+}
+
+// serveAgentRequest passes the request to pmm-agent connected to this node and sends the response back.
+func (s *Service) serveAgentRequest(msg *agentMessage) {
+	ctx, cancel := context.WithTimeout(context.Background(), agentRequestTimeout)
+	defer cancel()
+
+	s.agentRW.RLock()
+	fn := s.agentHandler
+	s.agentRW.RUnlock()
+
+	res := &agentMessage{
+		Type: agentMessageResponse,
+		ID:   msg.ID,
+		Node: s.params.NodeID,
+	}
+
+	var err error
+	if fn == nil {
+		err = status.Error(codes.Unavailable, "pmm-agent requests are not handled by this node yet")
+	} else {
+		res.Payload, err = fn(ctx, msg.PMMAgentID, msg.Payload)
+	}
+	if err != nil {
+		st := status.Convert(err)
+		res.Code = st.Code()
+		res.Error = st.Message()
+	}
+
+	if err = s.sendAgentMessage(msg.Node, res); err != nil {
+		s.l.Warnf("Failed to send response to request %d of node %s: %v", msg.ID, msg.Node, err)
+	}
+}
+
+func (s *Service) sendAgentMessage(nodeID string, msg *agentMessage) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return s.sendToNode(nodeID, data)
+}
@@ -0,0 +1,126 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/percona/pmm/managed/models"
+)
+
+func notifyMsg(t *testing.T, s *Service, msg any) {
+	t.Helper()
+
+	data, err := json.Marshal(msg)
+	require.NoError(t, err)
+	(&memberlistDelegate{s: s}).NotifyMsg(data)
+}
+
+func TestAgentMessages(t *testing.T) {
+	t.Parallel()
+
+	t.Run("response is passed to waiting request", func(t *testing.T) {
+		t.Parallel()
+
+		s := newReplicatingService(t, "node-1")
+		ch := make(chan *agentMessage, 1)
+		s.agentResponses[1] = ch
+
+		notifyMsg(t, s, &agentMessage{Type: agentMessageResponse, ID: 1, Node: "node-2", Code: codes.FailedPrecondition, Error: "not connected"})
+		res := <-ch
+		assert.Equal(t, codes.FailedPrecondition, res.Code)
+		assert.Equal(t, "not connected", res.Error)
+
+		// late responses are dropped
+		notifyMsg(t, s, &agentMessage{Type: agentMessageResponse, ID: 2, Node: "node-2"})
+		assert.Empty(t, ch)
+		assert.Empty(t, s.forwardCh)
+	})
+
+	t.Run("request is passed to handler", func(t *testing.T) {
+		t.Parallel()
+
+		s := newReplicatingService(t, "node-1")
+		type request struct {
+			pmmAgentID string
+			payload    []byte
+		}
+		requests := make(chan request, 1)
+		s.SetAgentRequestHandler(func(_ context.Context, pmmAgentID string, req []byte) ([]byte, error) {
+			requests <- request{pmmAgentID: pmmAgentID, payload: req}
+			return req, nil
+		})
+
+		notifyMsg(t, s, &agentMessage{Type: agentMessageRequest, ID: 1, Node: "node-2", PMMAgentID: "agent-1", Payload: []byte("request")})
+		assert.Equal(t, request{pmmAgentID: "agent-1", payload: []byte("request")}, <-requests)
+		s.wg.Wait()
+		assert.Empty(t, s.forwardCh)
+	})
+
+	t.Run("commands are forwarded to leader", func(t *testing.T) {
+		t.Parallel()
+
+		s := newReplicatingService(t, "node-1")
+		notifyMsg(t, s, &command{Op: opInvalidate, Kind: StateScheduledTasks, Key: "task-1", Node: "node-2"})
+		require.Len(t, s.forwardCh, 1)
+
+		var cmd command
+		require.NoError(t, json.Unmarshal(<-s.forwardCh, &cmd))
+		assert.Equal(t, opInvalidate, cmd.Op)
+	})
+
+	t.Run("request is not forwarded without memberlist", func(t *testing.T) {
+		t.Parallel()
+
+		s := newReplicatingService(t, "node-1")
+		_, err := s.ForwardAgentRequest(t.Context(), "node-2", "agent-1", []byte("request"))
+		require.EqualError(t, err, "failed to forward request to pmm-agent with ID agent-1 to node node-2: HA service is not started yet")
+		assert.Empty(t, s.agentResponses)
+
+		s = New(&models.HAParams{Enabled: true, NodeID: "node-1"})
+		_, err = s.ForwardAgentRequest(t.Context(), "node-2", "agent-1", []byte("request"))
+		require.EqualError(t, err, "state replication is disabled")
+	})
+}
+
+func TestScheduledTaskState(t *testing.T) {
+	t.Parallel()
+
+	s := newReplicatingService(t, "node-1")
+	_, ok, err := s.ScheduledTask("task-1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	value, err := json.Marshal(&models.ScheduledTask{ID: "task-1", CronExpression: "* * * * *"})
+	require.NoError(t, err)
+	applyCommand(t, s, &command{Op: opSet, Kind: StateScheduledTasks, Key: "task-1", Value: value, Node: "node-2"})
+
+	task, ok, err := s.ScheduledTask("task-1")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "* * * * *", task.CronExpression)
+
+	applyCommand(t, s, &command{Op: opDelete, Kind: StateScheduledTasks, Key: "task-1", Node: "node-2"})
+	_, ok, err = s.ScheduledTask("task-1")
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
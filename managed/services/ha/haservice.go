@@ -19,6 +19,7 @@ package ha
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -29,6 +30,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/hashicorp/memberlist"
@@ -70,6 +72,15 @@ type Service struct {
 	nodeCh   chan memberlist.NodeEvent
 	leaderCh chan raft.Observation
 
+	// state is nil when state replication is disabled.
+	state     *state
+	forwardCh chan []byte
+
+	agentRW            sync.RWMutex
+	agentHandler       AgentRequestHandler
+	agentResponses     map[uint64]chan *agentMessage
+	lastAgentRequestID atomic.Uint64
+
 	l  *logrus.Entry
 	wg *sync.WaitGroup
 
@@ -78,43 +89,81 @@ type Service struct {
 	memberlist *memberlist.Memberlist
 }
 
-// Apply applies a log entry to the high-availability service.
-// Currently only used for Raft consensus, not for state replication.
+// Apply applies a log entry to the replicated state.
+// When state replication is disabled, Raft is used for leader election only and entries are ignored.
 func (s *Service) Apply(logEntry *raft.Log) any {
-	s.l.Debugf("raft: applied log entry: index=%d, data=%s", logEntry.Index, string(logEntry.Data))
+	if s.state == nil {
+		s.l.Debugf("raft: applied log entry: index=%d, data=%s", logEntry.Index, string(logEntry.Data))
+		return nil
+	}
+
+	var cmd command
+	err := json.Unmarshal(logEntry.Data, &cmd)
+	if err == nil {
+		err = s.state.apply(&cmd, s.params.NodeID)
+	}
+	if err != nil {
+		s.l.Warnf("raft: failed to apply log entry %d: %s", logEntry.Index, err)
+		return err
+	}
+
 	return nil
 }
 
-// Snapshot returns a snapshot of the high-availability service.
-// Since PMM HA uses Raft for leader election only (not state replication),
-// the FSM has no state to snapshot. Cluster configuration (voters) is
-// automatically stored by Raft in the snapshot metadata.
+// Snapshot returns a snapshot of the replicated state.
+// Cluster configuration (voters) is automatically stored by Raft in the snapshot metadata.
 func (s *Service) Snapshot() (raft.FSMSnapshot, error) { //nolint:ireturn
-	return &fsmSnapshot{}, nil
+	if s.state == nil {
+		return &fsmSnapshot{}, nil
+	}
+
+	data, err := s.state.marshal()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode state snapshot: %w", err)
+	}
+
+	return &fsmSnapshot{data: data}, nil
 }
 
-// Restore restores the high availability service to a previous state.
-// Since PMM HA is stateless (leader election only), there's nothing to restore.
+// Restore restores the replicated state from a snapshot.
 // Cluster configuration (voters) is automatically restored by Raft from snapshot metadata.
 func (s *Service) Restore(rc io.ReadCloser) error {
-	// FSM has no state, but we need to consume the reader
-	// Raft automatically restores cluster configuration from metadata
-	s.l.Debug("Restore called - FSM is stateless, cluster config restored by Raft")
-	return rc.Close()
+	if s.state == nil {
+		// FSM has no state, but we need to consume the reader
+		s.l.Debug("Restore called - FSM is stateless, cluster config restored by Raft")
+		return rc.Close()
+	}
+
+	err := s.state.restore(rc)
+	if cerr := rc.Close(); err == nil {
+		err = cerr
+	}
+
+	return err
 }
 
-// fsmSnapshot implements raft.FSMSnapshot for stateless PMM HA.
-type fsmSnapshot struct{}
+// fsmSnapshot implements raft.FSMSnapshot.
+type fsmSnapshot struct {
+	// data is empty when state replication is disabled.
+	data []byte
+}
 
-// Persist writes an empty snapshot since PMM HA FSM is stateless.
+// Persist writes the snapshot to the sink.
 // Cluster configuration (voters, etc.) is automatically persisted by Raft in metadata.
 func (f *fsmSnapshot) Persist(sink raft.SnapshotSink) error {
+	if len(f.data) != 0 {
+		_, err := sink.Write(f.data)
+		if err != nil {
+			return errors.Join(err, sink.Cancel())
+		}
+	}
+
 	return sink.Close()
 }
 
 // Release is called when we are finished with the snapshot.
 func (f *fsmSnapshot) Release() {
-	// Nothing to release for stateless FSM
+	// Nothing to release, the snapshot holds a copy of the state
 }
 
 // memberlistLogWriter is an io.Writer that converts memberlist's standard log format to structured output.
@@ -210,12 +259,20 @@ func setupRaftStorage(nodeID string, l *logrus.Entry) (*raftboltdb.BoltStore, *r
 
 // New provides a new instance of the high availability service.
 func New(params *models.HAParams) *Service {
+	var st *state
+	if params.Enabled && params.ReplicateState {
+		st = newState()
+	}
+
 	return &Service{
 		params:           params,
 		bootstrapCluster: true,
 		services:         newServices(),
 		nodeCh:           make(chan memberlist.NodeEvent, defaultNodeEventChanSize),
 		leaderCh:         make(chan raft.Observation),
+		state:            st,
+		forwardCh:        make(chan []byte, defaultForwardChanSize),
+		agentResponses:   make(map[uint64]chan *agentMessage),
 		l:                logrus.WithField("component", "ha"),
 		wg:               &sync.WaitGroup{},
 	}
@@ -244,6 +301,12 @@ func (s *Service) Run(ctx context.Context) error {
 		return nil
 	}
 
+	// Replicated commands forwarded to the leader over memberlist change state of all nodes,
+	// so they must not be accepted from anyone who can reach the gossip port.
+	if s.ReplicationEnabled() && len(s.params.GossipSecretKey) == 0 {
+		return errors.New("state replication requires gossip secret key, set PMM_HA_GOSSIP_SECRET_KEY")
+	}
+
 	s.l.Infoln("Starting...")
 	defer s.l.Infoln("Done.")
 
@@ -266,7 +329,7 @@ func (s *Service) Run(ctx context.Context) error {
 	raftConfig.LeaderLeaseTimeout = defaultLeaderLeaseTimeout
 
 	// Configure snapshots for log compaction
-	// Since PMM HA is stateless (leader election only), snapshots are minimal
+	// Snapshots are empty unless state replication is enabled, and small otherwise
 	raftConfig.SnapshotInterval = defaultSnapshotInterval
 	raftConfig.SnapshotThreshold = defaultSnapshotThreshold
 	raftConfig.TrailingLogs = defaultTrailingLogs
@@ -334,6 +397,12 @@ func (s *Service) Run(ctx context.Context) error {
 	memberlistConfig.AdvertisePort = s.params.GossipPort
 	memberlistConfig.Events = &memberlist.ChannelEventDelegate{Ch: s.nodeCh}
 	memberlistConfig.LogOutput = newMemberlistLogWriter(s.l.WithField("subsystem", "memberlist"))
+	if len(s.params.GossipSecretKey) != 0 {
+		memberlistConfig.SecretKey = s.params.GossipSecretKey
+	}
+	if s.ReplicationEnabled() {
+		memberlistConfig.Delegate = &memberlistDelegate{s: s}
+	}
 
 	// Create the memberlist
 	s.memberlist, err = memberlist.Create(memberlistConfig)
@@ -385,6 +454,13 @@ func (s *Service) Run(ctx context.Context) error {
 		s.runRaftNodesSynchronizer(ctx)
 	})
 
+	if s.ReplicationEnabled() {
+		s.l.Info("Control-plane state replication is enabled")
+		s.wg.Go(func() {
+			s.runForwardedCommandsApplier(ctx)
+		})
+	}
+
 	<-ctx.Done()
 
 	s.wg.Wait()
@@ -573,8 +649,8 @@ func (s *Service) AddLeaderService(leaderService LeaderService) {
 	}
 }
 
-// BroadcastMessage broadcasts a message from the high availability service.
-// Note: Currently unused. Reserved for future cluster-wide message distribution.
+// BroadcastMessage broadcasts a message to all nodes through the Raft log.
+// It is used for replicated state changes and cache invalidation.
 // This method should only be called by the leader node.
 func (s *Service) BroadcastMessage(message []byte) error {
 	if !s.params.Enabled {
@@ -590,6 +666,9 @@ func (s *Service) BroadcastMessage(message []byte) error {
 	if err != nil {
 		return fmt.Errorf("failed to apply log to raft: %w", err)
 	}
+	if err, ok := future.Response().(error); ok {
+		return fmt.Errorf("failed to apply log to FSM: %w", err)
+	}
 	return nil
 }
 
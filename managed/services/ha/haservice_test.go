@@ -17,6 +17,7 @@ package ha
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"os"
 	"path/filepath"
@@ -176,6 +177,26 @@ func TestNew(t *testing.T) {
 	assert.Equal(t, defaultNodeEventChanSize, cap(service.nodeCh))
 }
 
+func TestService_RunReplicationWithoutSecretKey(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	service := New(&models.HAParams{
+		Enabled:          true,
+		NodeID:           "node-1",
+		AdvertiseAddress: "127.0.0.1",
+		RaftPort:         7300,
+		GossipPort:       7301,
+		ReplicateState:   true,
+	})
+
+	err := service.Run(ctx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "gossip secret key")
+}
+
 func TestService_IsLeader(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,300 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+package ha
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/memberlist"
+
+	"github.com/percona/pmm/managed/models"
+)
+
+const defaultForwardChanSize = 100
+
+// settingsStateKey is a key of PMM Server settings in StateSettings.
+const settingsStateKey = "settings"
+
+// ReplicationEnabled returns true if control-plane state is replicated between nodes through Raft.
+func (s *Service) ReplicationEnabled() bool {
+	return s.params.Enabled && s.params.ReplicateState
+}
+
+// SetState stores the value under the given kind and key on all nodes.
+// On followers the command is forwarded to the leader and applied asynchronously.
+// It does nothing when state replication is disabled.
+func (s *Service) SetState(kind StateKind, key string, value any) error {
+	if !s.ReplicationEnabled() {
+		return nil
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s state: %w", kind, err)
+	}
+
+	return s.submit(&command{Op: opSet, Kind: kind, Key: key, Value: b})
+}
+
+// DeleteState removes the value stored under the given kind and key on all nodes.
+// It does nothing when state replication is disabled.
+func (s *Service) DeleteState(kind StateKind, key string) error {
+	if !s.ReplicationEnabled() {
+		return nil
+	}
+
+	return s.submit(&command{Op: opDelete, Kind: kind, Key: key})
+}
+
+// State decodes the value stored under the given kind and key into dst.
+// It returns false if there is no such value or state replication is disabled.
+func (s *Service) State(kind StateKind, key string, dst any) (bool, error) {
+	if !s.ReplicationEnabled() {
+		return false, nil
+	}
+
+	b, ok := s.state.get(kind, key)
+	if !ok {
+		return false, nil
+	}
+
+	if err := json.Unmarshal(b, dst); err != nil {
+		return false, fmt.Errorf("failed to decode %s state: %w", kind, err)
+	}
+
+	return true, nil
+}
+
+// Invalidate notifies other nodes that their cached data of the given kind and key is stale.
+// It does nothing when state replication is disabled.
+func (s *Service) Invalidate(kind StateKind, key string) error {
+	if !s.ReplicationEnabled() {
+		return nil
+	}
+
+	return s.submit(&command{Op: opInvalidate, Kind: kind, Key: key})
+}
+
+// OnInvalidate registers a function called when state of the given kind is changed or invalidated by another node,
+// or restored from a snapshot. It does nothing when state replication is disabled.
+func (s *Service) OnInvalidate(kind StateKind, fn InvalidateFunc) {
+	if !s.ReplicationEnabled() {
+		return
+	}
+
+	s.state.subscribe(kind, fn)
+}
+
+// SetSettings shares PMM Server settings changed by this node with other nodes.
+// It must be called after every change of settings in the database, otherwise followers serve stale settings.
+// It does nothing when state replication is disabled.
+func (s *Service) SetSettings(settings *models.Settings) error {
+	return s.SetState(StateSettings, settingsStateKey, settings)
+}
+
+// SetScheduledTask shares the scheduled task added or changed by this node with other nodes.
+// It does nothing when state replication is disabled.
+func (s *Service) SetScheduledTask(task *models.ScheduledTask) error {
+	return s.SetState(StateScheduledTasks, task.ID, task)
+}
+
+// DeleteScheduledTask shares the removal of the scheduled task by this node with other nodes.
+// It does nothing when state replication is disabled.
+func (s *Service) DeleteScheduledTask(id string) error {
+	return s.DeleteState(StateScheduledTasks, id)
+}
+
+// ScheduledTask returns the scheduled task replicated by other nodes.
+// It returns false if there is no such task or state replication is disabled.
+func (s *Service) ScheduledTask(id string) (*models.ScheduledTask, bool, error) {
+	var task models.ScheduledTask
+	ok, err := s.State(StateScheduledTasks, id, &task)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	return &task, true, nil
+}
+
+// Settings returns PMM Server settings replicated by other nodes.
+// It returns false if there are none yet or state replication is disabled.
+func (s *Service) Settings() (*models.Settings, bool, error) {
+	var settings models.Settings
+	ok, err := s.State(StateSettings, settingsStateKey, &settings)
+	if err != nil || !ok {
+		return nil, false, err
+	}
+
+	return &settings, true, nil
+}
+
+// SetAgentNode records that pmm-agent's gRPC stream is held by this node.
+func (s *Service) SetAgentNode(pmmAgentID string) error {
+	return s.SetState(StateAgentRoutes, pmmAgentID, s.params.NodeID)
+}
+
+// ClearAgentNode removes the pmm-agent's route if it still points to this node.
+// A route set by another node after pmm-agent has reconnected to it is kept.
+func (s *Service) ClearAgentNode(pmmAgentID string) error {
+	if !s.ReplicationEnabled() {
+		return nil
+	}
+
+	expected, err := json.Marshal(s.params.NodeID)
+	if err != nil {
+		return err
+	}
+
+	return s.submit(&command{Op: opDelete, Kind: StateAgentRoutes, Key: pmmAgentID, Expected: expected})
+}
+
+// AgentNode returns ID of the node holding the pmm-agent's gRPC stream.
+// It returns false if pmm-agent is not connected to any node or state replication is disabled.
+// Requests to pmm-agent connected to another node are sent there with ForwardAgentRequest.
+func (s *Service) AgentNode(pmmAgentID string) (string, bool) {
+	var nodeID string
+	ok, err := s.State(StateAgentRoutes, pmmAgentID, &nodeID)
+	if err != nil {
+		s.l.Warn(err)
+		return "", false
+	}
+
+	return nodeID, ok
+}
+
+// submit applies the command through the Raft log, forwarding it to the leader if needed.
+func (s *Service) submit(cmd *command) error {
+	cmd.Node = s.params.NodeID
+	if err := cmd.validate(); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(cmd)
+	if err != nil {
+		return err
+	}
+
+	if s.IsLeader() {
+		return s.BroadcastMessage(data)
+	}
+
+	return s.forwardToLeader(data)
+}
+
+// forwardToLeader sends the command to the leader over memberlist, as only the leader can append to the Raft log.
+func (s *Service) forwardToLeader(data []byte) error {
+	s.rw.RLock()
+	raftNode := s.raftNode
+	s.rw.RUnlock()
+
+	if raftNode == nil {
+		return errors.New("HA service is not started yet")
+	}
+
+	_, leaderID := raftNode.LeaderWithID()
+	if leaderID == "" {
+		return errors.New("there is no leader in the cluster")
+	}
+
+	return s.sendToNode(string(leaderID), data)
+}
+
+// sendToNode sends the message to the given node over memberlist.
+func (s *Service) sendToNode(nodeID string, data []byte) error {
+	s.rw.RLock()
+	ml := s.memberlist
+	s.rw.RUnlock()
+
+	if ml == nil {
+		return errors.New("HA service is not started yet")
+	}
+
+	for _, member := range ml.Members() {
+		if member.Name == nodeID {
+			return ml.SendReliable(member, data)
+		}
+	}
+
+	return fmt.Errorf("node %s is not a member of the cluster", nodeID)
+}
+
+// runForwardedCommandsApplier applies commands forwarded by followers while this node is the leader.
+func (s *Service) runForwardedCommandsApplier(ctx context.Context) {
+	for {
+		select {
+		case data := <-s.forwardCh:
+			if !s.IsLeader() {
+				s.l.Warn("Dropping a command forwarded by follower: not a leader anymore.")
+				continue
+			}
+
+			err := s.BroadcastMessage(data)
+			if err != nil {
+				s.l.Errorf("Failed to apply a command forwarded by follower: %v", err)
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// memberlistDelegate receives commands forwarded by followers, and requests to pmm-agents and responses to them.
+// It is used only with encrypted gossip, so messages from nodes without the secret key are dropped by memberlist.
+type memberlistDelegate struct {
+	s *Service
+}
+
+// NodeMeta implements memberlist.Delegate.
+func (d *memberlistDelegate) NodeMeta(int) []byte {
+	return nil
+}
+
+// NotifyMsg implements memberlist.Delegate.
+func (d *memberlistDelegate) NotifyMsg(b []byte) {
+	// memberlist reuses the buffer after the call returns.
+	data := make([]byte, len(b))
+	copy(data, b)
+
+	// commands have no type
+	var msg agentMessage
+	if err := json.Unmarshal(data, &msg); err == nil && msg.Type != "" {
+		d.s.handleAgentMessage(&msg)
+		return
+	}
+
+	select {
+	case d.s.forwardCh <- data:
+	default:
+		d.s.l.Warn("Dropping a command forwarded by follower: queue is full.")
+	}
+}
+
+// GetBroadcasts implements memberlist.Delegate.
+func (d *memberlistDelegate) GetBroadcasts(int, int) [][]byte {
+	return nil
+}
+
+// LocalState implements memberlist.Delegate.
+func (d *memberlistDelegate) LocalState(bool) []byte {
+	return nil
+}
+
+// MergeRemoteState implements memberlist.Delegate.
+func (d *memberlistDelegate) MergeRemoteState([]byte, bool) {}
+
+var _ memberlist.Delegate = (*memberlistDelegate)(nil)
@@ -0,0 +1,216 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+package ha
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"sync"
+)
+
+// StateKind is a kind of control-plane state replicated between PMM Server nodes.
+type StateKind string
+
+const (
+	// StateSettings holds PMM Server settings, so followers can serve them without querying the database.
+	StateSettings StateKind = "settings"
+	// StateScheduledTasks holds scheduled tasks changed by any node, so the scheduler running on the leader
+	// applies them without reloading all tasks from the database.
+	StateScheduledTasks StateKind = "scheduled_tasks"
+	// StateAgentRoutes maps pmm-agent IDs to IDs of nodes holding their gRPC streams,
+	// so requests to pmm-agent are forwarded to that node.
+	StateAgentRoutes StateKind = "agent_routes"
+)
+
+// commandOp is an operation performed by a replicated command.
+type commandOp string
+
+const (
+	opSet        commandOp = "set"
+	opDelete     commandOp = "delete"
+	opInvalidate commandOp = "invalidate"
+)
+
+// command is a single entry of the Raft log applied to the replicated state.
+type command struct {
+	Op   commandOp `json:"op"`
+	Kind StateKind `json:"kind"`
+	Key  string    `json:"key"`
+	// Value is a new value for set operation.
+	Value json.RawMessage `json:"value,omitempty"`
+	// Expected, if set, makes delete operation conditional: the key is deleted only if it holds that value.
+	Expected json.RawMessage `json:"expected,omitempty"`
+	// Node is an ID of the node that submitted the command.
+	Node string `json:"node"`
+}
+
+// validate checks that the command can be applied.
+func (c *command) validate() error {
+	if c.Kind == "" {
+		return errors.New("empty state kind")
+	}
+
+	switch c.Op {
+	case opSet:
+		if c.Key == "" {
+			return fmt.Errorf("empty key for %s operation", c.Op)
+		}
+		if len(c.Value) == 0 {
+			return fmt.Errorf("empty value for %s operation", c.Op)
+		}
+	case opDelete:
+		if c.Key == "" {
+			return fmt.Errorf("empty key for %s operation", c.Op)
+		}
+	case opInvalidate:
+	default:
+		return fmt.Errorf("unknown operation %q", c.Op)
+	}
+
+	return nil
+}
+
+// InvalidateFunc is called when the replicated state of a given kind is changed by another node.
+// Key is empty when the whole state of the kind should be considered stale.
+// It is called from the Raft FSM goroutine, so it must not block.
+type InvalidateFunc func(key string)
+
+// state is a replicated key-value store of control-plane state.
+type state struct {
+	rw   sync.RWMutex
+	data map[StateKind]map[string]json.RawMessage
+
+	subsRW sync.RWMutex
+	subs   map[StateKind][]InvalidateFunc
+}
+
+// newState creates an empty replicated state.
+func newState() *state {
+	return &state{
+		data: make(map[StateKind]map[string]json.RawMessage),
+		subs: make(map[StateKind][]InvalidateFunc),
+	}
+}
+
+// apply applies the command to the state and notifies subscribers if the command was submitted by another node.
+func (s *state) apply(cmd *command, nodeID string) error {
+	if err := cmd.validate(); err != nil {
+		return err
+	}
+
+	s.rw.Lock()
+	switch cmd.Op {
+	case opSet:
+		if s.data[cmd.Kind] == nil {
+			s.data[cmd.Kind] = make(map[string]json.RawMessage)
+		}
+		s.data[cmd.Kind][cmd.Key] = cmd.Value
+	case opDelete:
+		current, ok := s.data[cmd.Kind][cmd.Key]
+		if ok && (cmd.Expected == nil || bytes.Equal(current, cmd.Expected)) {
+			delete(s.data[cmd.Kind], cmd.Key)
+		}
+	case opInvalidate:
+		// nothing to change, only notify subscribers
+	}
+	s.rw.Unlock()
+
+	if cmd.Node != nodeID {
+		s.notify(cmd.Kind, cmd.Key)
+	}
+
+	return nil
+}
+
+// get returns a value stored by the given kind and key.
+func (s *state) get(kind StateKind, key string) (json.RawMessage, bool) {
+	s.rw.RLock()
+	defer s.rw.RUnlock()
+
+	v, ok := s.data[kind][key]
+	return v, ok
+}
+
+// list returns a copy of all values of the given kind.
+func (s *state) list(kind StateKind) map[string]json.RawMessage {
+	s.rw.RLock()
+	defer s.rw.RUnlock()
+
+	return maps.Clone(s.data[kind])
+}
+
+// subscribe registers a function called on changes of the given kind made by other nodes.
+func (s *state) subscribe(kind StateKind, fn InvalidateFunc) {
+	s.subsRW.Lock()
+	defer s.subsRW.Unlock()
+
+	s.subs[kind] = append(s.subs[kind], fn)
+}
+
+func (s *state) notify(kind StateKind, key string) {
+	s.subsRW.RLock()
+	subs := s.subs[kind]
+	s.subsRW.RUnlock()
+
+	for _, fn := range subs {
+		fn(key)
+	}
+}
+
+// marshal returns a serialized copy of the state for a snapshot.
+func (s *state) marshal() ([]byte, error) {
+	s.rw.RLock()
+	defer s.rw.RUnlock()
+
+	return json.Marshal(s.data)
+}
+
+// restore replaces the state with the one read from a snapshot.
+// All subscribers are notified, as any part of the state could be changed.
+func (s *state) restore(r io.Reader) error {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	data := make(map[StateKind]map[string]json.RawMessage)
+	// Snapshots taken before state replication was enabled are empty.
+	if len(b) != 0 {
+		if err = json.Unmarshal(b, &data); err != nil {
+			return fmt.Errorf("failed to decode state snapshot: %w", err)
+		}
+	}
+
+	s.rw.Lock()
+	s.data = data
+	s.rw.Unlock()
+
+	s.subsRW.RLock()
+	kinds := make([]StateKind, 0, len(s.subs))
+	for kind := range s.subs {
+		kinds = append(kinds, kind)
+	}
+	s.subsRW.RUnlock()
+
+	for _, kind := range kinds {
+		s.notify(kind, "")
+	}
+
+	return nil
+}
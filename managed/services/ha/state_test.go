@@ -0,0 +1,225 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+package ha
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/hashicorp/raft"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm/managed/models"
+)
+
+func newReplicatingService(t *testing.T, nodeID string) *Service {
+	t.Helper()
+
+	s := New(&models.HAParams{
+		Enabled:        true,
+		NodeID:         nodeID,
+		ReplicateState: true,
+	})
+	s.l = logrus.WithField("test", t.Name())
+	return s
+}
+
+func applyCommand(t *testing.T, s *Service, cmd *command) any {
+	t.Helper()
+
+	data, err := json.Marshal(cmd)
+	require.NoError(t, err)
+	return s.Apply(&raft.Log{Index: 1, Data: data})
+}
+
+func TestNewState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("replication disabled", func(t *testing.T) {
+		t.Parallel()
+
+		s := New(&models.HAParams{Enabled: true})
+		assert.Nil(t, s.state)
+		assert.False(t, s.ReplicationEnabled())
+
+		require.NoError(t, s.SetState(StateSettings, "settings", "value"))
+		var v string
+		ok, err := s.State(StateSettings, "settings", &v)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("HA disabled", func(t *testing.T) {
+		t.Parallel()
+
+		s := New(&models.HAParams{ReplicateState: true})
+		assert.Nil(t, s.state)
+		assert.False(t, s.ReplicationEnabled())
+	})
+}
+
+func TestStateApply(t *testing.T) {
+	t.Parallel()
+
+	t.Run("set and delete", func(t *testing.T) {
+		t.Parallel()
+
+		s := newReplicatingService(t, "node-1")
+
+		res := applyCommand(t, s, &command{Op: opSet, Kind: StateAgentRoutes, Key: "agent-1", Value: json.RawMessage(`"node-2"`), Node: "node-2"})
+		assert.Nil(t, res)
+
+		nodeID, ok := s.AgentNode("agent-1")
+		assert.True(t, ok)
+		assert.Equal(t, "node-2", nodeID)
+
+		res = applyCommand(t, s, &command{Op: opDelete, Kind: StateAgentRoutes, Key: "agent-1", Node: "node-2"})
+		assert.Nil(t, res)
+
+		_, ok = s.AgentNode("agent-1")
+		assert.False(t, ok)
+	})
+
+	t.Run("conditional delete", func(t *testing.T) {
+		t.Parallel()
+
+		s := newReplicatingService(t, "node-1")
+
+		applyCommand(t, s, &command{Op: opSet, Kind: StateAgentRoutes, Key: "agent-1", Value: json.RawMessage(`"node-2"`), Node: "node-2"})
+
+		// pmm-agent has reconnected to node-2, so the stale route removal from node-1 is ignored
+		applyCommand(t, s, &command{Op: opDelete, Kind: StateAgentRoutes, Key: "agent-1", Expected: json.RawMessage(`"node-1"`), Node: "node-1"})
+		nodeID, ok := s.AgentNode("agent-1")
+		assert.True(t, ok)
+		assert.Equal(t, "node-2", nodeID)
+
+		applyCommand(t, s, &command{Op: opDelete, Kind: StateAgentRoutes, Key: "agent-1", Expected: json.RawMessage(`"node-2"`), Node: "node-2"})
+		_, ok = s.AgentNode("agent-1")
+		assert.False(t, ok)
+	})
+
+	t.Run("invalid commands", func(t *testing.T) {
+		t.Parallel()
+
+		s := newReplicatingService(t, "node-1")
+
+		res := s.Apply(&raft.Log{Index: 1, Data: []byte("not a command")})
+		assert.Error(t, res.(error))
+
+		res = applyCommand(t, s, &command{Op: "unknown", Kind: StateSettings, Key: "settings"})
+		assert.EqualError(t, res.(error), `unknown operation "unknown"`)
+
+		res = applyCommand(t, s, &command{Op: opSet, Kind: StateSettings, Key: "settings"})
+		assert.EqualError(t, res.(error), "empty value for set operation")
+
+		res = applyCommand(t, s, &command{Op: opDelete, Kind: StateSettings})
+		assert.EqualError(t, res.(error), "empty key for delete operation")
+	})
+
+	t.Run("notifies subscribers about changes made by other nodes", func(t *testing.T) {
+		t.Parallel()
+
+		s := newReplicatingService(t, "node-1")
+
+		var keys []string
+		s.OnInvalidate(StateScheduledTasks, func(key string) {
+			keys = append(keys, key)
+		})
+
+		applyCommand(t, s, &command{Op: opInvalidate, Kind: StateScheduledTasks, Key: "task-1", Node: "node-2"})
+		applyCommand(t, s, &command{Op: opInvalidate, Kind: StateScheduledTasks, Key: "task-2", Node: "node-1"})
+		applyCommand(t, s, &command{Op: opInvalidate, Kind: StateSettings, Key: "settings", Node: "node-2"})
+
+		assert.Equal(t, []string{"task-1"}, keys)
+	})
+}
+
+func TestStateSnapshotRestore(t *testing.T) {
+	t.Parallel()
+
+	leader := newReplicatingService(t, "node-1")
+	applyCommand(t, leader, &command{Op: opSet, Kind: StateAgentRoutes, Key: "agent-1", Value: json.RawMessage(`"node-1"`), Node: "node-1"})
+	applyCommand(t, leader, &command{Op: opSet, Kind: StateSettings, Key: "settings", Value: json.RawMessage(`{"a":1}`), Node: "node-1"})
+
+	snapshot, err := leader.Snapshot()
+	require.NoError(t, err)
+
+	sink := &bufferSnapshotSink{}
+	require.NoError(t, snapshot.Persist(sink))
+	snapshot.Release()
+	assert.True(t, sink.closed)
+
+	follower := newReplicatingService(t, "node-2")
+	applyCommand(t, follower, &command{Op: opSet, Kind: StateAgentRoutes, Key: "agent-2", Value: json.RawMessage(`"node-2"`), Node: "node-2"})
+
+	var invalidated []string
+	follower.OnInvalidate(StateSettings, func(key string) {
+		invalidated = append(invalidated, key)
+	})
+
+	require.NoError(t, follower.Restore(io.NopCloser(bytes.NewReader(sink.Bytes()))))
+	assert.Equal(t, []string{""}, invalidated)
+
+	nodeID, ok := follower.AgentNode("agent-1")
+	assert.True(t, ok)
+	assert.Equal(t, "node-1", nodeID)
+
+	_, ok = follower.AgentNode("agent-2")
+	assert.False(t, ok, "state should be replaced by the snapshot")
+
+	var settings map[string]int
+	ok, err = follower.State(StateSettings, "settings", &settings)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, map[string]int{"a": 1}, settings)
+
+	t.Run("empty snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		s := newReplicatingService(t, "node-3")
+		require.NoError(t, s.Restore(io.NopCloser(bytes.NewReader(nil))))
+		assert.Empty(t, s.state.list(StateAgentRoutes))
+	})
+
+	t.Run("invalid snapshot", func(t *testing.T) {
+		t.Parallel()
+
+		s := newReplicatingService(t, "node-3")
+		err := s.Restore(io.NopCloser(bytes.NewReader([]byte("invalid"))))
+		require.Error(t, err)
+	})
+}
+
+type bufferSnapshotSink struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *bufferSnapshotSink) Close() error {
+	b.closed = true
+	return nil
+}
+
+func (b *bufferSnapshotSink) ID() string {
+	return "buffer-snapshot"
+}
+
+func (b *bufferSnapshotSink) Cancel() error {
+	return nil
+}
@@ -0,0 +1,461 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// Package indexadvisor recommends indexes for top query classes based on QAN metrics.
+package indexadvisor
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm/managed/models"
+	"github.com/percona/pmm/managed/services"
+)
+
+// topQueryClassesSQL returns the heaviest query classes of each MySQL, PostgreSQL and MongoDB service
+// by total query time with metrics used to detect missing indexes.
+// MongoDB profiler reports scanned documents, while mongolog reports examined ones.
+const topQueryClassesSQL = `SELECT
+		service_id, service_type, queryid, any(fingerprint), any(database), any(schema),
+		sum(num_queries), sum(m_rows_examined_sum), sum(m_rows_sent_sum),
+		sum(m_no_index_used_sum), sum(m_full_scan_sum), sum(m_shared_blks_read_sum),
+		sum(m_docs_examined_sum) + sum(m_docs_scanned_sum), sum(m_docs_returned_sum), sum(m_keys_examined_sum)
+	FROM metrics
+	WHERE period_start >= ? AND service_type IN ('mysql', 'postgresql', 'mongodb') AND queryid != ''
+	GROUP BY service_id, service_type, queryid
+	ORDER BY sum(m_query_time_sum) DESC
+	LIMIT ? BY service_id`
+
+const insertRecommendationSQL = "INSERT INTO index_recommendations " +
+	"(service_id, service_name, service_type, queryid, fingerprint, database, table_name, columns, " +
+	"statement, reason, `evidence.name`, `evidence.value`, existing_indexes, created_at) " +
+	"VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)"
+
+// activeRecommendationsSQL returns candidate indexes of the service which are still recommended,
+// i.e. the latest row of them is not resolved.
+const activeRecommendationsSQL = `SELECT queryid, statement
+	FROM (
+		SELECT queryid, statement, resolved
+		FROM index_recommendations
+		WHERE service_id = ?
+		ORDER BY created_at DESC
+		LIMIT 1 BY queryid, statement
+	)
+	WHERE resolved = 0`
+
+const insertResolvedSQL = "INSERT INTO index_recommendations " +
+	"(service_id, service_name, service_type, queryid, statement, resolved, created_at) " +
+	"VALUES (?, ?, ?, ?, ?, ?, ?)"
+
+// errNoExample is returned for MongoDB queries that can't be explained without query examples.
+var errNoExample = errors.New("query example is not available")
+
+// recommendationKey identifies the candidate index of the query class.
+type recommendationKey struct {
+	queryID   string
+	statement string
+}
+
+// recommendation is a candidate index for the query class.
+type recommendation struct {
+	queryID         string
+	fingerprint     string
+	database        string
+	table           string
+	columns         []string
+	statement       string
+	reason          string
+	evidence        map[string]float64
+	existingIndexes []string
+	createdAt       time.Time
+}
+
+// Advisor periodically analyzes QAN metrics of top query classes of MySQL, PostgreSQL and MongoDB services,
+// checks existing indexes with show index and explain actions,
+// and stores candidate indexes with the evidence into ClickHouse.
+type Advisor struct {
+	db           *reform.DB
+	clickhouseDB *sql.DB
+	actions      actionsService
+	qanClient    qanClient
+	interval     time.Duration
+	topQueries   int
+	l            *logrus.Entry
+}
+
+// NewAdvisor creates a new index advisor.
+// It analyzes topQueries heaviest query classes of each service every interval.
+func NewAdvisor(
+	db *reform.DB,
+	clickhouseDB *sql.DB,
+	actions actionsService,
+	qanClient qanClient,
+	interval time.Duration,
+	topQueries int,
+) *Advisor {
+	return &Advisor{
+		db:           db,
+		clickhouseDB: clickhouseDB,
+		actions:      actions,
+		qanClient:    qanClient,
+		interval:     interval,
+		topQueries:   topQueries,
+		l:            logrus.WithField("component", "index-advisor"),
+	}
+}
+
+// Run analyzes query classes every interval until context is canceled.
+func (a *Advisor) Run(ctx context.Context) {
+	a.l.Info("Starting...")
+	defer a.l.Info("Done.")
+
+	ticker := time.NewTicker(a.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		a.advise(ctx)
+	}
+}
+
+// advise analyzes query classes that were the heaviest during the last interval.
+func (a *Advisor) advise(ctx context.Context) {
+	start := time.Now()
+	classes, err := a.findTopQueryClasses(ctx, start.Add(-a.interval))
+	if err != nil {
+		a.l.Errorf("Failed to find top query classes: %s.", err)
+		return
+	}
+
+	byService := make(map[string][]*queryClass)
+	for _, q := range classes {
+		byService[q.serviceID] = append(byService[q.serviceID], q)
+	}
+
+	var recommended int
+	for _, serviceID := range slices.Sorted(maps.Keys(byService)) {
+		if ctx.Err() != nil {
+			return
+		}
+
+		n, err := a.adviseService(ctx, serviceID, byService[serviceID])
+		if err != nil {
+			a.l.Warnf("Failed to recommend indexes for service %s: %s.", serviceID, err)
+		}
+		recommended += n
+	}
+
+	a.l.Infof("Recommended %d indexes for %d query classes in %s.", recommended, len(classes), time.Since(start))
+}
+
+// findTopQueryClasses returns top query classes of each service since the given time.
+func (a *Advisor) findTopQueryClasses(ctx context.Context, since time.Time) ([]*queryClass, error) {
+	rows, err := a.clickhouseDB.QueryContext(ctx, topQueryClassesSQL, since, a.topQueries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var res []*queryClass
+	for rows.Next() {
+		var q queryClass
+		var serviceType string
+		err = rows.Scan(
+			&q.serviceID, &serviceType, &q.queryID, &q.fingerprint, &q.database, &q.schema,
+			&q.numQueries, &q.rowsExamined, &q.rowsSent,
+			&q.noIndexUsed, &q.fullScan, &q.sharedBlksRead,
+			&q.docsExamined, &q.docsReturned, &q.keysExamined,
+		)
+		if err != nil {
+			return nil, err
+		}
+		q.serviceType = models.ServiceType(serviceType)
+		res = append(res, &q)
+	}
+	return res, rows.Err()
+}
+
+// adviseService recommends indexes for query classes of the service with metrics indicating a missing index
+// and stores recommendations. Previous recommendations for analyzed query classes which are not made anymore
+// are resolved. It returns the number of stored recommendations.
+func (a *Advisor) adviseService(ctx context.Context, serviceID string, classes []*queryClass) (int, error) {
+	var recommendations []*recommendation
+	var service *models.Service
+	analyzed := make(map[string]struct{}, len(classes))
+	for _, q := range classes {
+		reason, evidence, ok := q.analyze()
+		if !ok {
+			analyzed[q.queryID] = struct{}{}
+			continue
+		}
+
+		target, err := services.FindActionTarget(a.db, serviceID, map[models.ServiceType]string{
+			models.MySQLServiceType: "",
+			// show index action must be executed against the database of the query
+			models.PostgreSQLServiceType: q.database,
+			// explain action must be executed against the admin database
+			models.MongoDBServiceType: "admin",
+		})
+		if err != nil {
+			return 0, err
+		}
+		if target == nil {
+			return 0, nil
+		}
+		service = &models.Service{ServiceID: target.ServiceID, ServiceName: target.ServiceName, ServiceType: target.ServiceType}
+
+		var r *recommendation
+		switch q.serviceType {
+		case models.MySQLServiceType, models.PostgreSQLServiceType:
+			r, err = a.recommendSQL(ctx, target, q)
+		case models.MongoDBServiceType:
+			r, err = a.recommendMongoDB(ctx, target, q)
+		}
+		if err != nil {
+			// previous recommendations are kept, as the query class was not analyzed
+			a.l.Debugf("Failed to recommend index for query %s of service %s: %s.", q.queryID, serviceID, err)
+			continue
+		}
+		analyzed[q.queryID] = struct{}{}
+		if r == nil {
+			continue
+		}
+
+		r.queryID = q.queryID
+		r.fingerprint = q.fingerprint
+		r.reason = reason
+		r.evidence = evidence
+		r.createdAt = time.Now().UTC().Truncate(time.Millisecond)
+		recommendations = append(recommendations, r)
+	}
+
+	if err := a.insert(ctx, service, recommendations); err != nil {
+		return 0, err
+	}
+
+	if err := a.resolve(ctx, serviceID, analyzed, recommendations); err != nil {
+		return len(recommendations), err
+	}
+	return len(recommendations), nil
+}
+
+// recommendSQL recommends an index for the MySQL or PostgreSQL query class based on its fingerprint
+// and existing indexes of the table. It returns nil if the candidate index is already present.
+func (a *Advisor) recommendSQL(ctx context.Context, target *services.Target, q *queryClass) (*recommendation, error) {
+	query, err := parseSQL(q.fingerprint)
+	if err != nil {
+		return nil, err
+	}
+
+	// MySQL schema is the database of the query, PostgreSQL schema is empty unless reported by pg_stat_monitor
+	table := query.table
+	if !strings.Contains(table, ".") && q.schema != "" {
+		table = q.schema + "." + table
+	}
+
+	output, err := services.RunAction(ctx, a.db, a.l, target.AgentID, func(id string) error {
+		if target.ServiceType == models.MySQLServiceType {
+			return a.actions.StartMySQLShowIndexAction(ctx, id, target.AgentID, target.DSN, table, target.Files, target.TDP, target.TLSSkipVerify, target.Secrets)
+		}
+		return a.actions.StartPostgreSQLShowIndexAction(ctx, id, target.AgentID, target.DSN, table, target.Files, target.TDP, target.Secrets)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var indexes []*tableIndex
+	if target.ServiceType == models.MySQLServiceType {
+		indexes, err = parseMySQLIndexes(output)
+	} else {
+		indexes, err = parsePostgreSQLIndexes(output)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	columns := query.indexColumns()
+	hasRange := len(columns) > len(query.eqColumns)
+	existing := make([]string, 0, len(indexes))
+	for _, i := range indexes {
+		if i.covers(columns, hasRange) {
+			return nil, nil //nolint:nilnil
+		}
+		existing = append(existing, i.definition)
+	}
+
+	database := q.database
+	if target.ServiceType == models.MySQLServiceType {
+		// MySQL database is the qualifier of the table
+		database = ""
+		if db, _, ok := strings.Cut(table, "."); ok {
+			database = db
+		}
+	}
+
+	return &recommendation{
+		database:        database,
+		table:           table,
+		columns:         columns,
+		statement:       createIndexStatement(target.ServiceType, table, columns),
+		existingIndexes: existing,
+	}, nil
+}
+
+// recommendMongoDB recommends an index for the MongoDB query class based on the explain of its example.
+// It returns nil if the winning plan does not scan the whole collection.
+func (a *Advisor) recommendMongoDB(ctx context.Context, target *services.Target, q *queryClass) (*recommendation, error) {
+	res, err := a.qanClient.ExplainFingerprintByQueryID(ctx, target.ServiceID, q.queryID)
+	if err != nil {
+		return nil, err
+	}
+	if res.PlaceholdersCount != 0 || res.ExplainFingerprint == "" {
+		return nil, errNoExample
+	}
+
+	output, err := services.RunAction(ctx, a.db, a.l, target.AgentID, func(id string) error {
+		return a.actions.StartMongoDBExplainAction(ctx, id, target.AgentID, target.DSN, res.ExplainFingerprint, target.Files, target.TDP, target.Secrets)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := parseMongoDBExplain(output)
+	if err != nil {
+		return nil, err
+	}
+	if !query.collScan {
+		return nil, nil //nolint:nilnil
+	}
+
+	fields := query.indexFields()
+	return &recommendation{
+		database:  query.database,
+		table:     query.collection,
+		columns:   fields,
+		statement: createMongoDBIndexStatement(query.database, query.collection, fields),
+	}, nil
+}
+
+// insert stores recommendations for the service into ClickHouse.
+func (a *Advisor) insert(ctx context.Context, service *models.Service, recommendations []*recommendation) error {
+	rows := make([][]any, 0, len(recommendations))
+	for _, r := range recommendations {
+		names := slices.Sorted(maps.Keys(r.evidence))
+		values := make([]float64, 0, len(names))
+		for _, name := range names {
+			values = append(values, r.evidence[name])
+		}
+		existing := r.existingIndexes
+		if existing == nil {
+			existing = []string{}
+		}
+
+		rows = append(rows, []any{
+			service.ServiceID, service.ServiceName, string(service.ServiceType),
+			r.queryID, r.fingerprint, r.database, r.table, r.columns,
+			r.statement, r.reason, names, values, existing, r.createdAt,
+		})
+	}
+
+	if err := services.InsertIntoClickHouse(ctx, a.clickhouseDB, insertRecommendationSQL, rows); err != nil {
+		return fmt.Errorf("failed to insert recommendations: %w", err)
+	}
+	return nil
+}
+
+// resolve stores resolved rows for still active recommendations of analyzed query classes of the service
+// which are not among the current recommendations, so they are not listed anymore.
+func (a *Advisor) resolve(ctx context.Context, serviceID string, analyzed map[string]struct{}, current []*recommendation) error {
+	if len(analyzed) == 0 {
+		return nil
+	}
+
+	active, err := a.findActiveRecommendations(ctx, serviceID)
+	if err != nil {
+		return fmt.Errorf("failed to find active recommendations: %w", err)
+	}
+
+	resolved := resolvedRecommendations(active, analyzed, current)
+	if len(resolved) == 0 {
+		return nil
+	}
+
+	// only the key is needed, the row is used to hide previous recommendations
+	createdAt := time.Now().UTC().Truncate(time.Millisecond)
+	rows := make([][]any, 0, len(resolved))
+	for _, k := range resolved {
+		rows = append(rows, []any{serviceID, "", "", k.queryID, k.statement, uint8(1), createdAt})
+	}
+
+	if err = services.InsertIntoClickHouse(ctx, a.clickhouseDB, insertResolvedSQL, rows); err != nil {
+		return fmt.Errorf("failed to resolve recommendations: %w", err)
+	}
+
+	a.l.Debugf("Resolved %d recommendations of service %s.", len(resolved), serviceID)
+	return nil
+}
+
+// findActiveRecommendations returns candidate indexes of the service which are still recommended.
+func (a *Advisor) findActiveRecommendations(ctx context.Context, serviceID string) ([]recommendationKey, error) {
+	rows, err := a.clickhouseDB.QueryContext(ctx, activeRecommendationsSQL, serviceID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var res []recommendationKey
+	for rows.Next() {
+		var k recommendationKey
+		if err = rows.Scan(&k.queryID, &k.statement); err != nil {
+			return nil, err
+		}
+		res = append(res, k)
+	}
+	return res, rows.Err()
+}
+
+// resolvedRecommendations returns active recommendations of analyzed query classes which are not recommended anymore.
+func resolvedRecommendations(active []recommendationKey, analyzed map[string]struct{}, current []*recommendation) []recommendationKey {
+	recommended := make(map[recommendationKey]struct{}, len(current))
+	for _, r := range current {
+		recommended[recommendationKey{queryID: r.queryID, statement: r.statement}] = struct{}{}
+	}
+
+	var res []recommendationKey
+	for _, k := range active {
+		if _, ok := analyzed[k.queryID]; !ok {
+			continue
+		}
+		if _, ok := recommended[k]; ok {
+			continue
+		}
+		res = append(res, k)
+	}
+	return res
+}
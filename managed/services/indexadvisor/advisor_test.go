@@ -0,0 +1,92 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package indexadvisor
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	setup := func(t *testing.T) (*Advisor, sqlmock.Sqlmock) {
+		t.Helper()
+
+		sqlDB, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		return NewAdvisor(nil, sqlDB, nil, nil, time.Hour, 5), mock
+	}
+
+	current := []*recommendation{{queryID: "Q1", statement: "CREATE INDEX idx_b ON t (b)"}}
+
+	t.Run("Resolved", func(t *testing.T) {
+		t.Parallel()
+
+		a, mock := setup(t)
+
+		mock.ExpectQuery(regexp.QuoteMeta("LIMIT 1 BY queryid, statement")).
+			WithArgs("s1").
+			WillReturnRows(sqlmock.NewRows([]string{"queryid", "statement"}).
+				AddRow("Q1", "CREATE INDEX idx_a ON t (a)").
+				AddRow("Q1", "CREATE INDEX idx_b ON t (b)").
+				AddRow("Q2", "CREATE INDEX idx_c ON t (c)").
+				AddRow("Q3", "CREATE INDEX idx_d ON t (d)"))
+		mock.ExpectBegin()
+		prepare := mock.ExpectPrepare(regexp.QuoteMeta("INSERT INTO index_recommendations"))
+		prepare.ExpectExec().
+			WithArgs("s1", "", "", "Q1", "CREATE INDEX idx_a ON t (a)", uint8(1), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		prepare.ExpectExec().
+			WithArgs("s1", "", "", "Q2", "CREATE INDEX idx_c ON t (c)", uint8(1), sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		// Q3 was not analyzed, so its recommendation is kept
+		analyzed := map[string]struct{}{"Q1": {}, "Q2": {}}
+		require.NoError(t, a.resolve(t.Context(), "s1", analyzed, current))
+	})
+
+	t.Run("NothingResolved", func(t *testing.T) {
+		t.Parallel()
+
+		a, mock := setup(t)
+
+		mock.ExpectQuery(regexp.QuoteMeta("LIMIT 1 BY queryid, statement")).
+			WithArgs("s1").
+			WillReturnRows(sqlmock.NewRows([]string{"queryid", "statement"}).
+				AddRow("Q1", "CREATE INDEX idx_b ON t (b)"))
+
+		require.NoError(t, a.resolve(t.Context(), "s1", map[string]struct{}{"Q1": {}}, current))
+	})
+
+	t.Run("NothingAnalyzed", func(t *testing.T) {
+		t.Parallel()
+
+		a, _ := setup(t)
+
+		require.NoError(t, a.resolve(t.Context(), "s1", nil, nil))
+	})
+}
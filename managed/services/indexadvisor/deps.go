@@ -0,0 +1,56 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package indexadvisor
+
+import (
+	"context"
+
+	qanv1 "github.com/percona/pmm/api/qan/v1"
+	"github.com/percona/pmm/managed/models"
+)
+
+// actionsService is a subset of methods of agents.ActionsService used by this package.
+// We use it instead of real type for testing and to avoid dependency cycle.
+type actionsService interface {
+	StartMySQLShowIndexAction(
+		ctx context.Context,
+		id, pmmAgentID, dsn, table string,
+		files map[string]string,
+		tdp *models.DelimiterPair,
+		tlsSkipVerify bool,
+		secrets map[string]string,
+	) error
+	StartPostgreSQLShowIndexAction(
+		ctx context.Context,
+		id, pmmAgentID, dsn, table string,
+		files map[string]string,
+		tdp *models.DelimiterPair,
+		secrets map[string]string,
+	) error
+	StartMongoDBExplainAction(
+		ctx context.Context,
+		id, pmmAgentID, dsn, query string,
+		files map[string]string,
+		tdp *models.DelimiterPair,
+		secrets map[string]string,
+	) error
+}
+
+// qanClient is a subset of methods of qan.Client used by this package.
+// We use it instead of real type for testing and to avoid dependency cycle.
+type qanClient interface {
+	ExplainFingerprintByQueryID(ctx context.Context, serviceID, queryID string) (*qanv1.ExplainFingerprintByQueryIDResponse, error)
+}
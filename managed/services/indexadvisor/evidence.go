@@ -0,0 +1,127 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package indexadvisor
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/percona/pmm/managed/models"
+)
+
+const (
+	// minExaminedPerQuery is the minimal number of rows or documents examined by a single execution
+	// of the query class to consider it for an index.
+	minExaminedPerQuery = 1000
+	// minExaminedRatio is the minimal number of rows or documents examined per returned one
+	// to consider the query class for an index without other evidence.
+	minExaminedRatio = 100
+	// minBlksReadPerQuery is the minimal number of shared blocks read from disk by a single execution
+	// of the PostgreSQL query class to consider it for an index.
+	minBlksReadPerQuery = 1000
+	// minBlksReadPerRow is the minimal number of shared blocks read per returned row
+	// to consider the PostgreSQL query class for an index.
+	minBlksReadPerRow = 10
+)
+
+// queryClass is a top query class of the service with its QAN metrics over the analysis period.
+type queryClass struct {
+	serviceID   string
+	serviceType models.ServiceType
+	queryID     string
+	fingerprint string
+	database    string
+	schema      string
+
+	numQueries     float64
+	rowsExamined   float64
+	rowsSent       float64
+	noIndexUsed    float64
+	fullScan       float64
+	sharedBlksRead float64
+	docsExamined   float64
+	docsReturned   float64
+	keysExamined   float64
+}
+
+// analyze checks whether QAN metrics of the query class indicate a missing index.
+// It returns the reason and the metrics per query execution it is based on.
+func (q *queryClass) analyze() (string, map[string]float64, bool) {
+	if q.numQueries <= 0 {
+		return "", nil, false
+	}
+	perQuery := func(v float64) float64 {
+		return v / q.numQueries
+	}
+
+	var reasons []string
+	var evidence map[string]float64
+	switch q.serviceType {
+	case models.MySQLServiceType:
+		evidence = map[string]float64{
+			"rows_examined": perQuery(q.rowsExamined),
+			"rows_sent":     perQuery(q.rowsSent),
+			"no_index_used": perQuery(q.noIndexUsed),
+			"full_scan":     perQuery(q.fullScan),
+		}
+		if evidence["rows_examined"] < minExaminedPerQuery {
+			return "", nil, false
+		}
+		if q.noIndexUsed > 0 {
+			reasons = append(reasons, fmt.Sprintf("no index is used by %.0f%% of executions", 100*evidence["no_index_used"]))
+		}
+		if q.fullScan > 0 {
+			reasons = append(reasons, fmt.Sprintf("full table scan is done by %.0f%% of executions", 100*evidence["full_scan"]))
+		}
+		if ratio := q.rowsExamined / max(q.rowsSent, 1); ratio >= minExaminedRatio {
+			reasons = append(reasons, fmt.Sprintf("%.0f rows are examined per row sent", ratio))
+		}
+
+	case models.PostgreSQLServiceType:
+		evidence = map[string]float64{
+			"shared_blks_read": perQuery(q.sharedBlksRead),
+			"rows":             perQuery(q.rowsSent),
+		}
+		if evidence["shared_blks_read"] < minBlksReadPerQuery {
+			return "", nil, false
+		}
+		if ratio := q.sharedBlksRead / max(q.rowsSent, 1); ratio >= minBlksReadPerRow {
+			reasons = append(reasons, fmt.Sprintf("%.0f shared blocks are read from disk per returned row", ratio))
+		}
+
+	case models.MongoDBServiceType:
+		evidence = map[string]float64{
+			"docs_examined": perQuery(q.docsExamined),
+			"docs_returned": perQuery(q.docsReturned),
+			"keys_examined": perQuery(q.keysExamined),
+			"full_scan":     perQuery(q.fullScan),
+		}
+		if evidence["docs_examined"] < minExaminedPerQuery {
+			return "", nil, false
+		}
+		if q.fullScan > 0 {
+			reasons = append(reasons, fmt.Sprintf("collection scan is done by %.0f%% of executions", 100*evidence["full_scan"]))
+		}
+		if ratio := q.docsExamined / max(q.docsReturned, 1); ratio >= minExaminedRatio {
+			reasons = append(reasons, fmt.Sprintf("%.0f documents are examined per returned document", ratio))
+		}
+	}
+
+	if len(reasons) == 0 {
+		return "", nil, false
+	}
+	return strings.Join(reasons, "; "), evidence, true
+}
@@ -0,0 +1,79 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package indexadvisor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/percona/pmm/managed/models"
+)
+
+func TestQueryClassAnalyze(t *testing.T) {
+	t.Parallel()
+
+	t.Run("MySQL", func(t *testing.T) {
+		t.Parallel()
+
+		q := &queryClass{
+			serviceType:  models.MySQLServiceType,
+			numQueries:   10,
+			rowsExamined: 100000,
+			rowsSent:     10,
+			noIndexUsed:  10,
+			fullScan:     5,
+		}
+		reason, evidence, ok := q.analyze()
+		assert.True(t, ok)
+		assert.Equal(t, "no index is used by 100% of executions; full table scan is done by 50% of executions; "+
+			"10000 rows are examined per row sent", reason)
+		assert.Equal(t, map[string]float64{"rows_examined": 10000, "rows_sent": 1, "no_index_used": 1, "full_scan": 0.5}, evidence)
+	})
+
+	t.Run("MySQLFewRows", func(t *testing.T) {
+		t.Parallel()
+
+		q := &queryClass{serviceType: models.MySQLServiceType, numQueries: 10, rowsExamined: 100, noIndexUsed: 10}
+		_, _, ok := q.analyze()
+		assert.False(t, ok)
+	})
+
+	t.Run("PostgreSQL", func(t *testing.T) {
+		t.Parallel()
+
+		q := &queryClass{serviceType: models.PostgreSQLServiceType, numQueries: 2, sharedBlksRead: 20000, rowsSent: 2}
+		reason, evidence, ok := q.analyze()
+		assert.True(t, ok)
+		assert.Equal(t, "10000 shared blocks are read from disk per returned row", reason)
+		assert.Equal(t, map[string]float64{"shared_blks_read": 10000, "rows": 1}, evidence)
+	})
+
+	t.Run("MongoDBSelective", func(t *testing.T) {
+		t.Parallel()
+
+		q := &queryClass{serviceType: models.MongoDBServiceType, numQueries: 1, docsExamined: 5000, docsReturned: 1000}
+		_, _, ok := q.analyze()
+		assert.False(t, ok)
+	})
+
+	t.Run("NoQueries", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, ok := (&queryClass{serviceType: models.MySQLServiceType}).analyze()
+		assert.False(t, ok)
+	})
+}
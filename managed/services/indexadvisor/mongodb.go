@@ -0,0 +1,143 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package indexadvisor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// mongoDBQuery is a MongoDB query with fields of its conditions and the winning plan properties.
+type mongoDBQuery struct {
+	database    string
+	collection  string
+	eqFields    []string
+	rangeFields []string
+	collScan    bool
+}
+
+// mongoDBExplainOutput is the part of the explain command output used by this package.
+type mongoDBExplainOutput struct {
+	QueryPlanner struct {
+		Namespace   string         `json:"namespace"`
+		ParsedQuery map[string]any `json:"parsedQuery"`
+		WinningPlan map[string]any `json:"winningPlan"`
+	} `json:"queryPlanner"`
+}
+
+// parseMongoDBExplain extracts the collection, fields of equality and range conditions,
+// and whether the winning plan scans the whole collection from the explain action output.
+// Only AND-ed conditions are supported.
+func parseMongoDBExplain(output []byte) (*mongoDBQuery, error) {
+	var res mongoDBExplainOutput
+	if err := json.Unmarshal(output, &res); err != nil {
+		return nil, fmt.Errorf("failed to decode explain output: %w", err)
+	}
+
+	database, collection, ok := strings.Cut(res.QueryPlanner.Namespace, ".")
+	if !ok {
+		return nil, fmt.Errorf("unexpected namespace %q", res.QueryPlanner.Namespace)
+	}
+
+	q := &mongoDBQuery{
+		database:   database,
+		collection: collection,
+		collScan:   hasStage(res.QueryPlanner.WinningPlan, "COLLSCAN"),
+	}
+	if err := q.addConditions(res.QueryPlanner.ParsedQuery); err != nil {
+		return nil, err
+	}
+	if len(q.eqFields) == 0 && len(q.rangeFields) == 0 {
+		return nil, errors.New("no indexable conditions")
+	}
+	return q, nil
+}
+
+// addConditions adds fields of the parsed query conditions.
+// Fields of a single object are added in the alphabetical order, as JSON objects are not ordered.
+func (q *mongoDBQuery) addConditions(conditions map[string]any) error {
+	for _, field := range slices.Sorted(maps.Keys(conditions)) {
+		cond := conditions[field]
+		switch field {
+		case "$and":
+			list, _ := cond.([]any)
+			for _, c := range list {
+				m, _ := c.(map[string]any)
+				if err := q.addConditions(m); err != nil {
+					return err
+				}
+			}
+			continue
+		case "$or", "$nor", "$expr", "$text", "$where":
+			return fmt.Errorf("%s conditions are not supported", field)
+		}
+
+		ops, ok := cond.(map[string]any)
+		if !ok {
+			q.eqFields = appendColumn(q.eqFields, field)
+			continue
+		}
+		for op := range ops {
+			switch op {
+			case "$eq", "$in":
+				q.eqFields = appendColumn(q.eqFields, field)
+			case "$gt", "$gte", "$lt", "$lte":
+				q.rangeFields = appendColumn(q.rangeFields, field)
+			}
+		}
+	}
+	return nil
+}
+
+// indexFields returns fields of the candidate index: equality fields followed by the first range field.
+func (q *mongoDBQuery) indexFields() []string {
+	return candidateColumns(q.eqFields, q.rangeFields)
+}
+
+// hasStage recursively checks whether the plan contains the given stage.
+func hasStage(plan any, stage string) bool {
+	switch p := plan.(type) {
+	case map[string]any:
+		if s, _ := p["stage"].(string); s == stage {
+			return true
+		}
+		for _, child := range p {
+			if hasStage(child, stage) {
+				return true
+			}
+		}
+	case []any:
+		for _, child := range p {
+			if hasStage(child, stage) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// createMongoDBIndexStatement returns mongosh command creating the ascending index on the given fields.
+func createMongoDBIndexStatement(database, collection string, fields []string) string {
+	keys := make([]string, 0, len(fields))
+	for _, f := range fields {
+		keys = append(keys, fmt.Sprintf("%q: 1", f))
+	}
+	return fmt.Sprintf("db.getSiblingDB(%q).getCollection(%q).createIndex({%s})", database, collection, strings.Join(keys, ", "))
+}
@@ -0,0 +1,78 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package indexadvisor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMongoDBExplain(t *testing.T) {
+	t.Parallel()
+
+	t.Run("CollScan", func(t *testing.T) {
+		t.Parallel()
+
+		output := []byte(`{
+			"queryPlanner": {
+				"namespace": "shop.orders",
+				"parsedQuery": {"$and": [{"status": {"$eq": "new"}}, {"created": {"$gt": 1}}, {"customer": {"$in": [1, 2]}}]},
+				"winningPlan": {"stage": "SORT", "inputStage": {"stage": "COLLSCAN"}}
+			},
+			"ok": 1
+		}`)
+		q, err := parseMongoDBExplain(output)
+		require.NoError(t, err)
+		assert.Equal(t, "shop", q.database)
+		assert.Equal(t, "orders", q.collection)
+		assert.True(t, q.collScan)
+		assert.Equal(t, []string{"status", "customer", "created"}, q.indexFields())
+		assert.Equal(t,
+			`db.getSiblingDB("shop").getCollection("orders").createIndex({"status": 1, "customer": 1, "created": 1})`,
+			createMongoDBIndexStatement(q.database, q.collection, q.indexFields()))
+	})
+
+	t.Run("IndexScan", func(t *testing.T) {
+		t.Parallel()
+
+		output := []byte(`{
+			"queryPlanner": {
+				"namespace": "shop.orders",
+				"parsedQuery": {"status": {"$eq": "new"}},
+				"winningPlan": {"stage": "FETCH", "inputStage": {"stage": "IXSCAN", "indexName": "status_1"}}
+			}
+		}`)
+		q, err := parseMongoDBExplain(output)
+		require.NoError(t, err)
+		assert.False(t, q.collScan)
+	})
+
+	t.Run("Or", func(t *testing.T) {
+		t.Parallel()
+
+		output := []byte(`{
+			"queryPlanner": {
+				"namespace": "shop.orders",
+				"parsedQuery": {"$or": [{"a": {"$eq": 1}}, {"b": {"$eq": 2}}]},
+				"winningPlan": {"stage": "COLLSCAN"}
+			}
+		}`)
+		_, err := parseMongoDBExplain(output)
+		assert.EqualError(t, err, "$or conditions are not supported")
+	})
+}
@@ -0,0 +1,321 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package indexadvisor
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/percona/pmm/managed/models"
+)
+
+// maxMySQLIdentifierLength is the maximal length of MySQL index name.
+const maxMySQLIdentifierLength = 64
+
+var (
+	sqlTableRE     = regexp.MustCompile(`(?is)^\s*(?:select\b.+?\bfrom|delete\s+from|update)\s+((?:[` + "`" + `"]?\w+[` + "`" + `"]?\.)?[` + "`" + `"]?\w+[` + "`" + `"]?)(.*)$`)
+	sqlWhereRE     = regexp.MustCompile(`(?is)\bwhere\b(.+?)(?:\bgroup\s+by\b|\border\s+by\b|\blimit\b|\bhaving\b|\bfor\s+update\b|$)`)
+	sqlJoinRE      = regexp.MustCompile(`(?is)\bjoin\b|^\s*,|^\s*\w+\s*,`)
+	sqlBetweenRE   = regexp.MustCompile(`(?is)\bbetween\s+\S+\s+and\s+\S+`)
+	sqlAndRE       = regexp.MustCompile(`(?i)\s+and\s+`)
+	sqlOrRE        = regexp.MustCompile(`(?i)\bor\b|\(\s*select\b`)
+	sqlPredicateRE = regexp.MustCompile(`(?is)^\(*\s*((?:[` + "`" + `"]?\w+[` + "`" + `"]?\.)*[` + "`" + `"]?[a-z_]\w*[` + "`" + `"]?)\s*(<=>|>=|<=|<>|!=|=|>|<|\bin\b|\blike\b|\bbetween\b)`)
+	pgIdentifierRE = regexp.MustCompile(`^[a-z_][a-z0-9_]*$`)
+	pgIndexUsingRE = regexp.MustCompile(`(?i)\busing\s+\w+\s+\(`)
+)
+
+// sqlQuery is a single-table SQL query with columns of its conditions.
+type sqlQuery struct {
+	table        string // optionally qualified with the schema or database
+	eqColumns    []string
+	rangeColumns []string
+}
+
+// parseSQL extracts the table and columns of equality and range conditions from the query fingerprint.
+// Only single-table SELECT, UPDATE and DELETE queries with AND-ed conditions are supported.
+func parseSQL(fingerprint string) (*sqlQuery, error) {
+	m := sqlTableRE.FindStringSubmatch(fingerprint)
+	if m == nil {
+		return nil, errors.New("not a SELECT, UPDATE or DELETE query")
+	}
+	if sqlJoinRE.MatchString(m[2]) {
+		return nil, errors.New("multi-table queries are not supported")
+	}
+
+	where := sqlWhereRE.FindStringSubmatch(m[2])
+	if where == nil {
+		return nil, errors.New("no conditions")
+	}
+	if sqlOrRE.MatchString(where[1]) {
+		return nil, errors.New("OR conditions and subqueries are not supported")
+	}
+
+	q := &sqlQuery{table: unquoteSQLIdentifier(m[1])}
+	conditions := sqlBetweenRE.ReplaceAllString(where[1], "BETWEEN ?")
+	for _, cond := range sqlAndRE.Split(conditions, -1) {
+		p := sqlPredicateRE.FindStringSubmatch(strings.TrimSpace(cond))
+		if p == nil {
+			continue
+		}
+
+		parts := strings.Split(unquoteSQLIdentifier(p[1]), ".")
+		column := parts[len(parts)-1]
+		switch strings.ToLower(p[2]) {
+		case "=", "<=>", "in":
+			q.eqColumns = appendColumn(q.eqColumns, column)
+		case ">", ">=", "<", "<=", "between", "like":
+			q.rangeColumns = appendColumn(q.rangeColumns, column)
+		}
+	}
+
+	if len(q.eqColumns) == 0 && len(q.rangeColumns) == 0 {
+		return nil, errors.New("no indexable conditions")
+	}
+	return q, nil
+}
+
+// indexColumns returns columns of the candidate index: equality columns followed by the first range column.
+func (q *sqlQuery) indexColumns() []string {
+	return candidateColumns(q.eqColumns, q.rangeColumns)
+}
+
+// candidateColumns returns equality columns followed by the first range column that is not an equality column.
+// Only one range column is useful, as columns after the range one can't be used for lookups.
+func candidateColumns(eqColumns, rangeColumns []string) []string {
+	res := slices.Clone(eqColumns)
+	for _, c := range rangeColumns {
+		if !containsFold(res, c) {
+			return append(res, c)
+		}
+	}
+	return res
+}
+
+// appendColumn appends the column if it is not present yet.
+func appendColumn(columns []string, column string) []string {
+	if containsFold(columns, column) {
+		return columns
+	}
+	return append(columns, column)
+}
+
+// containsFold checks if columns contain the given one ignoring case.
+func containsFold(columns []string, column string) bool {
+	return slices.ContainsFunc(columns, func(c string) bool { return strings.EqualFold(c, column) })
+}
+
+// unquoteSQLIdentifier removes MySQL and PostgreSQL quotes from the (qualified) identifier.
+func unquoteSQLIdentifier(s string) string {
+	return strings.NewReplacer("`", "", `"`, "").Replace(strings.TrimSpace(s))
+}
+
+// tableIndex is an existing index of the table.
+type tableIndex struct {
+	name       string
+	columns    []string
+	definition string
+}
+
+// covers checks whether the index can be used for lookups by all given columns,
+// where the last column may be the range one.
+func (i *tableIndex) covers(columns []string, hasRange bool) bool {
+	if len(i.columns) < len(columns) {
+		return false
+	}
+
+	eq := columns
+	if hasRange {
+		eq = columns[:len(columns)-1]
+		if !strings.EqualFold(i.columns[len(eq)], columns[len(columns)-1]) {
+			return false
+		}
+	}
+	for _, c := range i.columns[:len(eq)] {
+		if !containsFold(eq, c) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseActionRows decodes the output of show index actions: the header row followed by data rows.
+func parseActionRows(output []byte) ([]map[string]any, error) {
+	var rows [][]any
+	if err := json.Unmarshal(output, &rows); err != nil {
+		return nil, fmt.Errorf("failed to decode action output: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("empty action output")
+	}
+
+	header := rows[0]
+	res := make([]map[string]any, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		r := make(map[string]any, len(header))
+		for i, col := range header {
+			if i < len(row) {
+				r[fmt.Sprint(col)] = row[i]
+			}
+		}
+		res = append(res, r)
+	}
+	return res, nil
+}
+
+// parseMySQLIndexes returns indexes from the output of MySQL show index action.
+func parseMySQLIndexes(output []byte) ([]*tableIndex, error) {
+	rows, err := parseActionRows(output)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*tableIndex
+	byName := make(map[string]*tableIndex)
+	for _, row := range rows {
+		name, _ := row["Key_name"].(string)
+		column, _ := row["Column_name"].(string)
+		if name == "" {
+			continue
+		}
+
+		i := byName[name]
+		if i == nil {
+			i = &tableIndex{name: name}
+			byName[name] = i
+			res = append(res, i)
+		}
+
+		seq, _ := strconv.Atoi(fmt.Sprint(row["Seq_in_index"]))
+		for len(i.columns) < seq {
+			i.columns = append(i.columns, "")
+		}
+		if seq > 0 {
+			// functional key parts have no column name; they can't be used for lookups by columns
+			i.columns[seq-1] = column
+		}
+	}
+
+	for _, i := range res {
+		i.definition = fmt.Sprintf("%s (%s)", i.name, strings.Join(i.columns, ", "))
+	}
+	return res, nil
+}
+
+// parsePostgreSQLIndexes returns indexes from the output of PostgreSQL show index action.
+func parsePostgreSQLIndexes(output []byte) ([]*tableIndex, error) {
+	rows, err := parseActionRows(output)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*tableIndex, 0, len(rows))
+	for _, row := range rows {
+		name, _ := row["indexname"].(string)
+		def, _ := row["indexdef"].(string)
+		if name == "" {
+			continue
+		}
+		res = append(res, &tableIndex{name: name, columns: postgreSQLIndexColumns(def), definition: def})
+	}
+	return res, nil
+}
+
+// postgreSQLIndexColumns returns key columns of the index from its definition,
+// such as "CREATE INDEX i ON public.t USING btree (a, b DESC)".
+// Expressions are returned as empty strings.
+func postgreSQLIndexColumns(def string) []string {
+	loc := pgIndexUsingRE.FindStringIndex(def)
+	if loc == nil {
+		return nil
+	}
+
+	var res []string
+	var depth int
+	start := loc[1]
+	for i := start; i < len(def); i++ {
+		switch def[i] {
+		case '(':
+			depth++
+		case ')', ',':
+			if depth > 0 {
+				if def[i] == ')' {
+					depth--
+				}
+				continue
+			}
+
+			key := strings.Fields(strings.TrimSpace(def[start:i]))
+			var column string
+			if len(key) != 0 && !strings.Contains(key[0], "(") {
+				column = unquoteSQLIdentifier(key[0])
+			}
+			res = append(res, column)
+			if def[i] == ')' {
+				return res
+			}
+			start = i + 1
+		}
+	}
+	return res
+}
+
+// createIndexStatement returns the statement creating the index on the given columns of the table.
+func createIndexStatement(serviceType models.ServiceType, table string, columns []string) string {
+	switch serviceType {
+	case models.MySQLServiceType:
+		quote := func(s string) string {
+			return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+		}
+		parts := strings.Split(table, ".")
+		for i, p := range parts {
+			parts[i] = quote(p)
+		}
+		quoted := make([]string, 0, len(columns))
+		for _, c := range columns {
+			quoted = append(quoted, quote(c))
+		}
+		name := "idx_" + strings.Join(columns, "_")
+		if len(name) > maxMySQLIdentifierLength {
+			name = name[:maxMySQLIdentifierLength]
+		}
+		return fmt.Sprintf("ALTER TABLE %s ADD INDEX %s (%s)", strings.Join(parts, "."), quote(name), strings.Join(quoted, ", "))
+
+	case models.PostgreSQLServiceType:
+		quote := func(s string) string {
+			if pgIdentifierRE.MatchString(s) {
+				return s
+			}
+			return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+		}
+		parts := strings.Split(table, ".")
+		for i, p := range parts {
+			parts[i] = quote(p)
+		}
+		quoted := make([]string, 0, len(columns))
+		for _, c := range columns {
+			quoted = append(quoted, quote(c))
+		}
+		return fmt.Sprintf("CREATE INDEX CONCURRENTLY ON %s (%s)", strings.Join(parts, "."), strings.Join(quoted, ", "))
+
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,156 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package indexadvisor
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm/managed/models"
+)
+
+func TestParseSQL(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name        string
+		fingerprint string
+		table       string
+		columns     []string
+	}{
+		{
+			name:        "EqualityAndRange",
+			fingerprint: "select * from orders where customer_id = ? and created_at > ? and status in (?+) order by id limit ?",
+			table:       "orders",
+			columns:     []string{"customer_id", "status", "created_at"},
+		},
+		{
+			name:        "QualifiedQuoted",
+			fingerprint: "SELECT `o`.`id` FROM `shop`.`orders` WHERE `o`.`status` = ? AND `o`.`total` BETWEEN ? AND ?",
+			table:       "shop.orders",
+			columns:     []string{"status", "total"},
+		},
+		{
+			name:        "Update",
+			fingerprint: `UPDATE "public"."users" SET name = $1 WHERE email = $2`,
+			table:       "public.users",
+			columns:     []string{"email"},
+		},
+		{
+			name:        "Delete",
+			fingerprint: "delete from sessions where expires_at < ? and expires_at > ?",
+			table:       "sessions",
+			columns:     []string{"expires_at"},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			q, err := parseSQL(tc.fingerprint)
+			require.NoError(t, err)
+			assert.Equal(t, tc.table, q.table)
+			assert.Equal(t, tc.columns, q.indexColumns())
+		})
+	}
+
+	for _, tc := range []struct {
+		name        string
+		fingerprint string
+		err         string
+	}{
+		{name: "Insert", fingerprint: "insert into t values (?)", err: "not a SELECT, UPDATE or DELETE query"},
+		{name: "Join", fingerprint: "select * from a join b on a.id = b.id where a.x = ?", err: "multi-table queries are not supported"},
+		{name: "NoWhere", fingerprint: "select * from t", err: "no conditions"},
+		{name: "Or", fingerprint: "select * from t where a = ? or b = ?", err: "OR conditions and subqueries are not supported"},
+		{name: "Functions", fingerprint: "select * from t where lower(a) = ?", err: "no indexable conditions"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseSQL(tc.fingerprint)
+			assert.EqualError(t, err, tc.err)
+		})
+	}
+}
+
+func TestTableIndexCovers(t *testing.T) {
+	t.Parallel()
+
+	i := &tableIndex{columns: []string{"status", "customer_id", "created_at"}}
+	assert.True(t, i.covers([]string{"customer_id", "status"}, false))
+	assert.True(t, i.covers([]string{"customer_id", "status", "created_at"}, true))
+	assert.False(t, i.covers([]string{"customer_id", "created_at"}, true))
+	assert.False(t, i.covers([]string{"created_at"}, false))
+	assert.False(t, i.covers([]string{"status", "customer_id", "created_at", "id"}, false))
+}
+
+func TestParseIndexes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("MySQL", func(t *testing.T) {
+		t.Parallel()
+
+		output := []byte(`[
+			["Table", "Non_unique", "Key_name", "Seq_in_index", "Column_name"],
+			["orders", 0, "PRIMARY", 1, "id"],
+			["orders", 1, "idx_customer", 1, "customer_id"],
+			["orders", 1, "idx_customer", 2, "status"],
+			["orders", 1, "idx_expr", 1, null]
+		]`)
+		indexes, err := parseMySQLIndexes(output)
+		require.NoError(t, err)
+		require.Len(t, indexes, 3)
+		assert.Equal(t, &tableIndex{name: "PRIMARY", columns: []string{"id"}, definition: "PRIMARY (id)"}, indexes[0])
+		assert.Equal(t, []string{"customer_id", "status"}, indexes[1].columns)
+		assert.Equal(t, []string{""}, indexes[2].columns)
+	})
+
+	t.Run("PostgreSQL", func(t *testing.T) {
+		t.Parallel()
+
+		output := []byte(`[
+			["schemaname", "tablename", "indexname", "tablespace", "indexdef"],
+			["public", "users", "users_pkey", null, "CREATE UNIQUE INDEX users_pkey ON public.users USING btree (id)"],
+			["public", "users", "users_email", null, "CREATE INDEX users_email ON public.users USING btree (\"Email\" DESC, lower(name), tenant_id) WHERE (deleted IS NULL)"]
+		]`)
+		indexes, err := parsePostgreSQLIndexes(output)
+		require.NoError(t, err)
+		require.Len(t, indexes, 2)
+		assert.Equal(t, []string{"id"}, indexes[0].columns)
+		assert.Equal(t, []string{"Email", "", "tenant_id"}, indexes[1].columns)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseMySQLIndexes([]byte(`[]`))
+		assert.EqualError(t, err, "empty action output")
+	})
+}
+
+func TestCreateIndexStatement(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t,
+		"ALTER TABLE `shop`.`orders` ADD INDEX `idx_customer_id_created_at` (`customer_id`, `created_at`)",
+		createIndexStatement(models.MySQLServiceType, "shop.orders", []string{"customer_id", "created_at"}))
+	assert.Equal(t,
+		`CREATE INDEX CONCURRENTLY ON public.users ("Email", tenant_id)`,
+		createIndexStatement(models.PostgreSQLServiceType, "public.users", []string{"Email", "tenant_id"}))
+	assert.Empty(t, createIndexStatement(models.MongoDBServiceType, "users", []string{"email"}))
+}
@@ -851,6 +851,94 @@ func (as *AgentsService) ChangeValkeyExporter(ctx context.Context, agentID strin
 	return res, nil
 }
 
+// AddQANValkeySlowlogAgent adds Valkey Slowlog QAN Agent.
+func (as *AgentsService) AddQANValkeySlowlogAgent(ctx context.Context, p *inventoryv1.AddQANValkeySlowlogAgentParams) (*inventoryv1.AddAgentResponse, error) {
+	params := &models.CreateAgentParams{
+		PMMAgentID:    p.PmmAgentId,
+		ServiceID:     p.ServiceId,
+		Username:      p.Username,
+		Password:      p.Password,
+		CustomLabels:  p.CustomLabels,
+		TLS:           p.Tls,
+		TLSSkipVerify: p.TlsSkipVerify,
+		QANOptions: models.QANOptions{
+			MaxQueryLength:        p.MaxQueryLength,
+			QueryExamplesDisabled: p.DisableQueryExamples,
+		},
+		ValkeyOptions:       models.ValkeyOptionsFromRequest(p),
+		LogLevel:            services.SpecifyLogLevel(p.LogLevel, inventoryv1.LogLevel_LOG_LEVEL_FATAL),
+		SkipConnectionCheck: p.SkipConnectionCheck,
+	}
+
+	agent, err := as.executeAgentAdd(ctx, models.QANValkeySlowlogAgentType, params, false)
+	if err != nil {
+		return nil, err
+	}
+
+	qanAgent, ok := agent.(*inventoryv1.QANValkeySlowlogAgent)
+	if !ok {
+		return nil, unexpectedAgentTypeError(agent)
+	}
+	as.state.RequestStateUpdate(ctx, p.PmmAgentId)
+
+	res := &inventoryv1.AddAgentResponse{
+		Agent: &inventoryv1.AddAgentResponse_QanValkeySlowlogAgent{
+			QanValkeySlowlogAgent: qanAgent,
+		},
+	}
+
+	return res, nil
+}
+
+// ChangeQANValkeySlowlogAgent updates Valkey Slowlog QAN Agent with given parameters.
+func (as *AgentsService) ChangeQANValkeySlowlogAgent(
+	ctx context.Context, agentID string,
+	p *inventoryv1.ChangeQANValkeySlowlogAgentParams,
+) (*inventoryv1.ChangeAgentResponse, error) {
+	// Convert protobuf parameters to model parameters
+	params := &models.ChangeAgentParams{
+		Enabled:             p.Enable,
+		Username:            p.Username,
+		Password:            p.Password,
+		TLS:                 p.Tls,
+		TLSSkipVerify:       p.TlsSkipVerify,
+		CustomLabels:        convertCustomLabels(p.CustomLabels),
+		LogLevel:            convertLogLevel(p.LogLevel),
+		SkipConnectionCheck: p.GetSkipConnectionCheck(),
+	}
+
+	// Set QANOptions
+	params.QANOptions = &models.ChangeQANOptions{
+		MaxQueryLength:        p.MaxQueryLength,
+		QueryExamplesDisabled: p.DisableQueryExamples,
+	}
+
+	// Set ValkeyOptions
+	params.ValkeyOptions = &models.ChangeValkeyOptions{
+		SSLCa:   p.TlsCa,
+		SSLCert: p.TlsCert,
+		SSLKey:  p.TlsKey,
+	}
+
+	agent, err := as.executeAgentChange(ctx, agentID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	qanAgent, ok := agent.(*inventoryv1.QANValkeySlowlogAgent)
+	if !ok {
+		return nil, unexpectedAgentTypeError(agent)
+	}
+	as.state.RequestStateUpdate(ctx, qanAgent.PmmAgentId)
+
+	res := &inventoryv1.ChangeAgentResponse{
+		Agent: &inventoryv1.ChangeAgentResponse_QanValkeySlowlogAgent{
+			QanValkeySlowlogAgent: qanAgent,
+		},
+	}
+	return res, nil
+}
+
 // AddQANMongoDBProfilerAgent adds MongoDB Profiler QAN Agent.
 func (as *AgentsService) AddQANMongoDBProfilerAgent(ctx context.Context, p *inventoryv1.AddQANMongoDBProfilerAgentParams) (*inventoryv1.AddAgentResponse, error) {
 	params := &models.CreateAgentParams{
@@ -1132,6 +1220,84 @@ func (as *AgentsService) ChangeProxySQLExporter(
 	return res, nil
 }
 
+// AddQANProxySQLDigestAgent adds ProxySQL query digest QAN Agent.
+func (as *AgentsService) AddQANProxySQLDigestAgent(ctx context.Context, p *inventoryv1.AddQANProxySQLDigestAgentParams) (*inventoryv1.AddAgentResponse, error) {
+	params := &models.CreateAgentParams{
+		PMMAgentID:    p.PmmAgentId,
+		ServiceID:     p.ServiceId,
+		Username:      p.Username,
+		Password:      p.Password,
+		CustomLabels:  p.CustomLabels,
+		TLS:           p.Tls,
+		TLSSkipVerify: p.TlsSkipVerify,
+		QANOptions: models.QANOptions{
+			MaxQueryLength: p.MaxQueryLength,
+		},
+		LogLevel:            services.SpecifyLogLevel(p.LogLevel, inventoryv1.LogLevel_LOG_LEVEL_FATAL),
+		SkipConnectionCheck: p.SkipConnectionCheck,
+	}
+
+	agent, err := as.executeAgentAdd(ctx, models.QANProxySQLDigestAgentType, params, false)
+	if err != nil {
+		return nil, err
+	}
+
+	qanAgent, ok := agent.(*inventoryv1.QANProxySQLDigestAgent)
+	if !ok {
+		return nil, unexpectedAgentTypeError(agent)
+	}
+	as.state.RequestStateUpdate(ctx, p.PmmAgentId)
+
+	res := &inventoryv1.AddAgentResponse{
+		Agent: &inventoryv1.AddAgentResponse_QanProxysqlDigestAgent{
+			QanProxysqlDigestAgent: qanAgent,
+		},
+	}
+
+	return res, nil
+}
+
+// ChangeQANProxySQLDigestAgent updates ProxySQL query digest QAN Agent with given parameters.
+func (as *AgentsService) ChangeQANProxySQLDigestAgent(
+	ctx context.Context, agentID string,
+	p *inventoryv1.ChangeQANProxySQLDigestAgentParams,
+) (*inventoryv1.ChangeAgentResponse, error) {
+	// Convert protobuf parameters to model parameters
+	params := &models.ChangeAgentParams{
+		Enabled:             p.Enable,
+		Username:            p.Username,
+		Password:            p.Password,
+		TLS:                 p.Tls,
+		TLSSkipVerify:       p.TlsSkipVerify,
+		CustomLabels:        convertCustomLabels(p.CustomLabels),
+		LogLevel:            convertLogLevel(p.LogLevel),
+		SkipConnectionCheck: p.GetSkipConnectionCheck(),
+	}
+
+	// Set QANOptions
+	params.QANOptions = &models.ChangeQANOptions{
+		MaxQueryLength: p.MaxQueryLength,
+	}
+
+	agent, err := as.executeAgentChange(ctx, agentID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	qanAgent, ok := agent.(*inventoryv1.QANProxySQLDigestAgent)
+	if !ok {
+		return nil, unexpectedAgentTypeError(agent)
+	}
+	as.state.RequestStateUpdate(ctx, qanAgent.PmmAgentId)
+
+	res := &inventoryv1.ChangeAgentResponse{
+		Agent: &inventoryv1.ChangeAgentResponse_QanProxysqlDigestAgent{
+			QanProxysqlDigestAgent: qanAgent,
+		},
+	}
+	return res, nil
+}
+
 // AddQANPostgreSQLPgStatementsAgent adds PostgreSQL Pg stat statements QAN Agent.
 func (as *AgentsService) AddQANPostgreSQLPgStatementsAgent(
 	ctx context.Context,
@@ -1343,6 +1509,94 @@ func (as *AgentsService) ChangeQANPostgreSQLPgStatMonitorAgent(
 	return res, nil
 }
 
+// AddQANPostgreSQLLogAgent adds PostgreSQL server log QAN Agent.
+func (as *AgentsService) AddQANPostgreSQLLogAgent(
+	ctx context.Context,
+	p *inventoryv1.AddQANPostgreSQLLogAgentParams,
+) (*inventoryv1.AddAgentResponse, error) {
+	params := &models.CreateAgentParams{
+		PMMAgentID:    p.PmmAgentId,
+		ServiceID:     p.ServiceId,
+		Username:      p.Username,
+		Password:      p.Password,
+		CustomLabels:  p.CustomLabels,
+		TLS:           p.Tls,
+		TLSSkipVerify: p.TlsSkipVerify,
+		QANOptions: models.QANOptions{
+			MaxQueryLength:          p.MaxQueryLength,
+			QueryExamplesDisabled:   p.DisableQueryExamples,
+			CommentsParsingDisabled: p.DisableCommentsParsing,
+		},
+		PostgreSQLOptions:   models.PostgreSQLOptionsFromRequest(p),
+		LogLevel:            services.SpecifyLogLevel(p.LogLevel, inventoryv1.LogLevel_LOG_LEVEL_FATAL),
+		SkipConnectionCheck: p.SkipConnectionCheck,
+	}
+
+	agent, err := as.executeAgentAdd(ctx, models.QANPostgreSQLLogAgentType, params, false)
+	if err != nil {
+		return nil, err
+	}
+
+	qanAgent, ok := agent.(*inventoryv1.QANPostgreSQLLogAgent)
+	if !ok {
+		return nil, unexpectedAgentTypeError(agent)
+	}
+	as.state.RequestStateUpdate(ctx, p.PmmAgentId)
+
+	res := &inventoryv1.AddAgentResponse{
+		Agent: &inventoryv1.AddAgentResponse_QanPostgresqlLogAgent{
+			QanPostgresqlLogAgent: qanAgent,
+		},
+	}
+
+	return res, nil
+}
+
+// ChangeQANPostgreSQLLogAgent updates PostgreSQL server log QAN Agent with given parameters.
+func (as *AgentsService) ChangeQANPostgreSQLLogAgent(
+	ctx context.Context, agentID string,
+	p *inventoryv1.ChangeQANPostgreSQLLogAgentParams,
+) (*inventoryv1.ChangeAgentResponse, error) {
+	params := &models.ChangeAgentParams{
+		Enabled:             p.Enable,
+		Username:            p.Username,
+		Password:            p.Password,
+		TLS:                 p.Tls,
+		TLSSkipVerify:       p.TlsSkipVerify,
+		CustomLabels:        convertCustomLabels(p.CustomLabels),
+		LogLevel:            convertLogLevel(p.LogLevel),
+		SkipConnectionCheck: p.GetSkipConnectionCheck(),
+		QANOptions: &models.ChangeQANOptions{
+			MaxQueryLength:          p.MaxQueryLength,
+			QueryExamplesDisabled:   p.DisableQueryExamples,
+			CommentsParsingDisabled: p.DisableCommentsParsing,
+		},
+		PostgreSQLOptions: &models.ChangePostgreSQLOptions{
+			SSLCa:   p.TlsCa,
+			SSLCert: p.TlsCert,
+			SSLKey:  p.TlsKey,
+		},
+	}
+
+	agent, err := as.executeAgentChange(ctx, agentID, params)
+	if err != nil {
+		return nil, err
+	}
+
+	logAgent, ok := agent.(*inventoryv1.QANPostgreSQLLogAgent)
+	if !ok {
+		return nil, unexpectedAgentTypeError(agent)
+	}
+	as.state.RequestStateUpdate(ctx, logAgent.PmmAgentId)
+
+	res := &inventoryv1.ChangeAgentResponse{
+		Agent: &inventoryv1.ChangeAgentResponse_QanPostgresqlLogAgent{
+			QanPostgresqlLogAgent: logAgent,
+		},
+	}
+	return res, nil
+}
+
 // AddRDSExporter inserts rds_exporter Agent with given parameters.
 func (as *AgentsService) AddRDSExporter(ctx context.Context, p *inventoryv1.AddRDSExporterParams) (*inventoryv1.AddAgentResponse, error) {
 	params := &models.CreateAgentParams{
@@ -1732,6 +1986,183 @@ func (as *AgentsService) ChangeRTAMongoDBAgent(
 	return res, nil
 }
 
+// AddRTAMySQLAgent adds MySQL Real-Time Analytics Agent.
+func (as *AgentsService) AddRTAMySQLAgent(ctx context.Context, p *inventoryv1.AddRTAMySQLAgentParams) (*inventoryv1.AddAgentResponse, error) {
+	mysqlOptions, err := models.MySQLOptionsFromRequest(p)
+	if err != nil {
+		return nil, err
+	}
+
+	params := &models.CreateAgentParams{
+		PMMAgentID:          p.PmmAgentId,
+		ServiceID:           p.ServiceId,
+		Username:            p.Username,
+		Password:            p.Password,
+		CustomLabels:        p.CustomLabels,
+		TLS:                 p.Tls,
+		TLSSkipVerify:       p.TlsSkipVerify,
+		MySQLOptions:        mysqlOptions,
+		LogLevel:            services.SpecifyLogLevel(p.LogLevel, inventoryv1.LogLevel_LOG_LEVEL_FATAL),
+		SkipConnectionCheck: p.SkipConnectionCheck,
+	}
+
+	// Set RTA options if provided
+	if p.RtaOptions != nil {
+		params.RTAOptions = *models.RTAOptionsFromRequest(p.RtaOptions)
+	}
+
+	agent, err := as.executeAgentAdd(ctx, models.RTAMySQLAgentType, params, false)
+	if err != nil {
+		return nil, err
+	}
+
+	rtaMySQLAgent, ok := agent.(*inventoryv1.RTAMySQLAgent)
+	if !ok {
+		return nil, unexpectedAgentTypeError(agent)
+	}
+	as.state.RequestStateUpdate(ctx, p.PmmAgentId)
+
+	res := &inventoryv1.AddAgentResponse{
+		Agent: &inventoryv1.AddAgentResponse_RtaMysqlAgent{
+			RtaMysqlAgent: rtaMySQLAgent,
+		},
+	}
+
+	return res, nil
+}
+
+// ChangeRTAMySQLAgent updates MySQL Real-Time Analytics Agent with given parameters.
+func (as *AgentsService) ChangeRTAMySQLAgent(
+	ctx context.Context, agentID string,
+	p *inventoryv1.ChangeRTAMySQLAgentParams,
+) (*inventoryv1.ChangeAgentResponse, error) {
+	changeParams := &models.ChangeAgentParams{
+		Enabled:       p.Enable,
+		Username:      p.Username,
+		Password:      p.Password,
+		TLS:           p.Tls,
+		TLSSkipVerify: p.TlsSkipVerify,
+		LogLevel:      convertLogLevel(p.LogLevel),
+		CustomLabels:  convertCustomLabels(p.CustomLabels),
+		MySQLOptions: &models.ChangeMySQLOptions{
+			TLSCa:   p.TlsCa,
+			TLSCert: p.TlsCert,
+			TLSKey:  p.TlsKey,
+		},
+		SkipConnectionCheck: p.GetSkipConnectionCheck(),
+	}
+
+	// Set RTA options if provided
+	if p.RtaOptions != nil {
+		changeParams.RTAOptions = models.RTAOptionsFromRequest(p.RtaOptions)
+	}
+
+	ag, err := as.executeAgentChange(ctx, agentID, changeParams)
+	if err != nil {
+		return nil, err
+	}
+
+	agent, ok := ag.(*inventoryv1.RTAMySQLAgent)
+	if !ok {
+		return nil, unexpectedAgentTypeError(ag)
+	}
+	as.state.RequestStateUpdate(ctx, agent.PmmAgentId)
+
+	res := &inventoryv1.ChangeAgentResponse{
+		Agent: &inventoryv1.ChangeAgentResponse_RtaMysqlAgent{
+			RtaMysqlAgent: agent,
+		},
+	}
+
+	return res, nil
+}
+
+// AddRTAPostgreSQLAgent adds PostgreSQL Real-Time Analytics Agent.
+func (as *AgentsService) AddRTAPostgreSQLAgent(ctx context.Context, p *inventoryv1.AddRTAPostgreSQLAgentParams) (*inventoryv1.AddAgentResponse, error) {
+	params := &models.CreateAgentParams{
+		PMMAgentID:          p.PmmAgentId,
+		ServiceID:           p.ServiceId,
+		Username:            p.Username,
+		Password:            p.Password,
+		CustomLabels:        p.CustomLabels,
+		TLS:                 p.Tls,
+		TLSSkipVerify:       p.TlsSkipVerify,
+		PostgreSQLOptions:   models.PostgreSQLOptionsFromRequest(p),
+		LogLevel:            services.SpecifyLogLevel(p.LogLevel, inventoryv1.LogLevel_LOG_LEVEL_FATAL),
+		SkipConnectionCheck: p.SkipConnectionCheck,
+	}
+
+	// Set RTA options if provided
+	if p.RtaOptions != nil {
+		params.RTAOptions = *models.RTAOptionsFromRequest(p.RtaOptions)
+	}
+
+	agent, err := as.executeAgentAdd(ctx, models.RTAPostgreSQLAgentType, params, false)
+	if err != nil {
+		return nil, err
+	}
+
+	rtaPostgreSQLAgent, ok := agent.(*inventoryv1.RTAPostgreSQLAgent)
+	if !ok {
+		return nil, unexpectedAgentTypeError(agent)
+	}
+	as.state.RequestStateUpdate(ctx, p.PmmAgentId)
+
+	res := &inventoryv1.AddAgentResponse{
+		Agent: &inventoryv1.AddAgentResponse_RtaPostgresqlAgent{
+			RtaPostgresqlAgent: rtaPostgreSQLAgent,
+		},
+	}
+
+	return res, nil
+}
+
+// ChangeRTAPostgreSQLAgent updates PostgreSQL Real-Time Analytics Agent with given parameters.
+func (as *AgentsService) ChangeRTAPostgreSQLAgent(
+	ctx context.Context, agentID string,
+	p *inventoryv1.ChangeRTAPostgreSQLAgentParams,
+) (*inventoryv1.ChangeAgentResponse, error) {
+	changeParams := &models.ChangeAgentParams{
+		Enabled:       p.Enable,
+		Username:      p.Username,
+		Password:      p.Password,
+		TLS:           p.Tls,
+		TLSSkipVerify: p.TlsSkipVerify,
+		LogLevel:      convertLogLevel(p.LogLevel),
+		CustomLabels:  convertCustomLabels(p.CustomLabels),
+		PostgreSQLOptions: &models.ChangePostgreSQLOptions{
+			SSLCa:   p.TlsCa,
+			SSLCert: p.TlsCert,
+			SSLKey:  p.TlsKey,
+		},
+		SkipConnectionCheck: p.GetSkipConnectionCheck(),
+	}
+
+	// Set RTA options if provided
+	if p.RtaOptions != nil {
+		changeParams.RTAOptions = models.RTAOptionsFromRequest(p.RtaOptions)
+	}
+
+	ag, err := as.executeAgentChange(ctx, agentID, changeParams)
+	if err != nil {
+		return nil, err
+	}
+
+	agent, ok := ag.(*inventoryv1.RTAPostgreSQLAgent)
+	if !ok {
+		return nil, unexpectedAgentTypeError(ag)
+	}
+	as.state.RequestStateUpdate(ctx, agent.PmmAgentId)
+
+	res := &inventoryv1.ChangeAgentResponse{
+		Agent: &inventoryv1.ChangeAgentResponse_RtaPostgresqlAgent{
+			RtaPostgresqlAgent: agent,
+		},
+	}
+
+	return res, nil
+}
+
 // Remove removes Agent, and sends state update to pmm-agent, or kicks it.
 func (as *AgentsService) Remove(ctx context.Context, id string, force bool) error {
 	var removedAgent *models.Agent
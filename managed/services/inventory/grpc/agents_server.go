@@ -58,6 +58,11 @@ var agentTypes = map[inventoryv1.AgentType]models.AgentType{
 	inventoryv1.AgentType_AGENT_TYPE_VM_AGENT:                           models.VMAgentType,
 	inventoryv1.AgentType_AGENT_TYPE_NOMAD_AGENT:                        models.NomadAgentType,
 	inventoryv1.AgentType_AGENT_TYPE_RTA_MONGODB_AGENT:                  models.RTAMongoDBAgentType,
+	inventoryv1.AgentType_AGENT_TYPE_RTA_MYSQL_AGENT:                    models.RTAMySQLAgentType,
+	inventoryv1.AgentType_AGENT_TYPE_RTA_POSTGRESQL_AGENT:               models.RTAPostgreSQLAgentType,
+	inventoryv1.AgentType_AGENT_TYPE_QAN_VALKEY_SLOWLOG_AGENT:           models.QANValkeySlowlogAgentType,
+	inventoryv1.AgentType_AGENT_TYPE_QAN_PROXYSQL_DIGEST_AGENT:          models.QANProxySQLDigestAgentType,
+	inventoryv1.AgentType_AGENT_TYPE_QAN_POSTGRESQL_LOG_AGENT:           models.QANPostgreSQLLogAgentType,
 }
 
 func agentType(req *inventoryv1.ListAgentsRequest) *models.AgentType {
@@ -121,6 +126,16 @@ func (s *agentsServer) ListAgents(ctx context.Context, req *inventoryv1.ListAgen
 			res.NomadAgent = append(res.NomadAgent, agent)
 		case *inventoryv1.RTAMongoDBAgent:
 			res.RtaMongodbAgent = append(res.RtaMongodbAgent, agent)
+		case *inventoryv1.RTAMySQLAgent:
+			res.RtaMysqlAgent = append(res.RtaMysqlAgent, agent)
+		case *inventoryv1.RTAPostgreSQLAgent:
+			res.RtaPostgresqlAgent = append(res.RtaPostgresqlAgent, agent)
+		case *inventoryv1.QANValkeySlowlogAgent:
+			res.QanValkeySlowlogAgent = append(res.QanValkeySlowlogAgent, agent)
+		case *inventoryv1.QANProxySQLDigestAgent:
+			res.QanProxysqlDigestAgent = append(res.QanProxysqlDigestAgent, agent)
+		case *inventoryv1.QANPostgreSQLLogAgent:
+			res.QanPostgresqlLogAgent = append(res.QanPostgresqlLogAgent, agent)
 		default:
 			panic(fmt.Errorf("unhandled inventory Agent type %T", agent))
 		}
@@ -175,6 +190,16 @@ func (s *agentsServer) GetAgent(ctx context.Context, req *inventoryv1.GetAgentRe
 		res.Agent = &inventoryv1.GetAgentResponse_NomadAgent{NomadAgent: agent}
 	case *inventoryv1.RTAMongoDBAgent:
 		res.Agent = &inventoryv1.GetAgentResponse_RtaMongodbAgent{RtaMongodbAgent: agent}
+	case *inventoryv1.RTAMySQLAgent:
+		res.Agent = &inventoryv1.GetAgentResponse_RtaMysqlAgent{RtaMysqlAgent: agent}
+	case *inventoryv1.RTAPostgreSQLAgent:
+		res.Agent = &inventoryv1.GetAgentResponse_RtaPostgresqlAgent{RtaPostgresqlAgent: agent}
+	case *inventoryv1.QANValkeySlowlogAgent:
+		res.Agent = &inventoryv1.GetAgentResponse_QanValkeySlowlogAgent{QanValkeySlowlogAgent: agent}
+	case *inventoryv1.QANProxySQLDigestAgent:
+		res.Agent = &inventoryv1.GetAgentResponse_QanProxysqlDigestAgent{QanProxysqlDigestAgent: agent}
+	case *inventoryv1.QANPostgreSQLLogAgent:
+		res.Agent = &inventoryv1.GetAgentResponse_QanPostgresqlLogAgent{QanPostgresqlLogAgent: agent}
 	default:
 		panic(fmt.Errorf("unhandled inventory Agent type %T", agent))
 	}
@@ -231,6 +256,16 @@ func (s *agentsServer) AddAgent(ctx context.Context, req *inventoryv1.AddAgentRe
 		return s.s.AddQANPostgreSQLPgStatMonitorAgent(ctx, req.GetQanPostgresqlPgstatmonitorAgent())
 	case *inventoryv1.AddAgentRequest_RtaMongodbAgent:
 		return s.s.AddRTAMongoDBAgent(ctx, req.GetRtaMongodbAgent())
+	case *inventoryv1.AddAgentRequest_RtaMysqlAgent:
+		return s.s.AddRTAMySQLAgent(ctx, req.GetRtaMysqlAgent())
+	case *inventoryv1.AddAgentRequest_RtaPostgresqlAgent:
+		return s.s.AddRTAPostgreSQLAgent(ctx, req.GetRtaPostgresqlAgent())
+	case *inventoryv1.AddAgentRequest_QanValkeySlowlogAgent:
+		return s.s.AddQANValkeySlowlogAgent(ctx, req.GetQanValkeySlowlogAgent())
+	case *inventoryv1.AddAgentRequest_QanProxysqlDigestAgent:
+		return s.s.AddQANProxySQLDigestAgent(ctx, req.GetQanProxysqlDigestAgent())
+	case *inventoryv1.AddAgentRequest_QanPostgresqlLogAgent:
+		return s.s.AddQANPostgreSQLLogAgent(ctx, req.GetQanPostgresqlLogAgent())
 	default:
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid agent type %T", req.Agent))
 	}
@@ -275,6 +310,16 @@ func (s *agentsServer) ChangeAgent(ctx context.Context, req *inventoryv1.ChangeA
 		return s.s.ChangeNomadAgent(ctx, agentID, req.GetNomadAgent())
 	case *inventoryv1.ChangeAgentRequest_RtaMongodbAgent:
 		return s.s.ChangeRTAMongoDBAgent(ctx, agentID, req.GetRtaMongodbAgent())
+	case *inventoryv1.ChangeAgentRequest_RtaMysqlAgent:
+		return s.s.ChangeRTAMySQLAgent(ctx, agentID, req.GetRtaMysqlAgent())
+	case *inventoryv1.ChangeAgentRequest_RtaPostgresqlAgent:
+		return s.s.ChangeRTAPostgreSQLAgent(ctx, agentID, req.GetRtaPostgresqlAgent())
+	case *inventoryv1.ChangeAgentRequest_QanValkeySlowlogAgent:
+		return s.s.ChangeQANValkeySlowlogAgent(ctx, agentID, req.GetQanValkeySlowlogAgent())
+	case *inventoryv1.ChangeAgentRequest_QanProxysqlDigestAgent:
+		return s.s.ChangeQANProxySQLDigestAgent(ctx, agentID, req.GetQanProxysqlDigestAgent())
+	case *inventoryv1.ChangeAgentRequest_QanPostgresqlLogAgent:
+		return s.s.ChangeQANPostgreSQLLogAgent(ctx, agentID, req.GetQanPostgresqlLogAgent())
 	default:
 		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid agent type %T", req.Agent))
 	}
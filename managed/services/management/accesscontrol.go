@@ -63,6 +63,7 @@ func (acs *AccessControlService) CreateRole(_ context.Context, req *rolev1beta1.
 		Title:       req.Title,
 		Description: req.Description,
 		Filter:      req.Filter,
+		Permissions: req.Permissions,
 	}
 
 	err := models.CreateRole(acs.db.Querier, &role)
@@ -97,6 +98,12 @@ func (acs *AccessControlService) UpdateRole(_ context.Context, req *rolev1beta1.
 	if req.Filter != nil {
 		role.Filter = *req.Filter
 	}
+	if req.Permissions != nil {
+		if err := models.ValidatePermissions(req.Permissions.Values); err != nil {
+			return nil, err
+		}
+		role.Permissions = req.Permissions.Values
+	}
 
 	err = acs.db.Update(&role)
 	if err != nil {
@@ -146,6 +153,7 @@ func (acs *AccessControlService) GetRole(_ context.Context, req *rolev1beta1.Get
 		Title:       role.Title,
 		Description: role.Description,
 		Filter:      role.Filter,
+		Permissions: role.Permissions,
 	}, nil
 }
 
@@ -171,6 +179,7 @@ func (acs *AccessControlService) ListRoles(_ context.Context, _ *rolev1beta1.Lis
 			Title:       role.Title,
 			Description: role.Description,
 			Filter:      role.Filter,
+			Permissions: role.Permissions,
 		})
 	}
 
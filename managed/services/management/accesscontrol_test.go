@@ -23,10 +23,12 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"gopkg.in/reform.v1"
 	"gopkg.in/reform.v1/dialects/postgresql"
 
 	rolev1beta1 "github.com/percona/pmm/api/accesscontrol/v1beta1"
+	"github.com/percona/pmm/api/common"
 	"github.com/percona/pmm/managed/models"
 	"github.com/percona/pmm/managed/utils/testdb"
 	"github.com/percona/pmm/managed/utils/tests"
@@ -85,6 +87,7 @@ func TestAccessControlService(t *testing.T) {
 				Title:       new("Role B - updated"),
 				Filter:      new(""), // Filter was reset.
 				Description: nil,     // Description is not updated.
+				Permissions: &common.StringArray{Values: []string{"backups:restore"}},
 			})
 			require.NoError(t, err)
 
@@ -96,6 +99,20 @@ func TestAccessControlService(t *testing.T) {
 			assert.Equal(t, "Role B - updated", roles.Roles[1].Title)
 			assert.Empty(t, roles.Roles[1].Filter)
 			assert.Equal(t, "Role B description", roles.Roles[1].Description)
+			assert.Empty(t, roles.Roles[0].Permissions)
+			assert.Equal(t, []string{"backups:restore"}, roles.Roles[1].Permissions)
+		})
+
+		t.Run("Shall reject unknown permission", func(t *testing.T) {
+			defer teardown(t)
+
+			_, roleID := createDummyRoles(ctx, t, s)
+
+			_, err := s.UpdateRole(ctx, &rolev1beta1.UpdateRoleRequest{
+				RoleId:      roleID,
+				Permissions: &common.StringArray{Values: []string{"backups:destroy"}},
+			})
+			tests.AssertGRPCError(t, status.New(codes.InvalidArgument, `Unknown permission "backups:destroy".`), err)
 		})
 
 		t.Run("Shall return not found", func(t *testing.T) {
@@ -49,6 +49,7 @@ type BackupService struct { //nolint:revive
 	scheduleService      scheduleService
 	removalSVC           removalService
 	pbmPITRService       pbmPITRService
+	mysqlPITRService     mysqlPITRService
 	l                    *logrus.Entry
 }
 
@@ -70,6 +71,7 @@ func NewBackupsService(
 	scheduleService scheduleService,
 	removalSVC removalService,
 	pbmPITRService pbmPITRService,
+	mysqlPITRService mysqlPITRService,
 ) *BackupService {
 	return &BackupService{
 		l:                    logrus.WithField("component", "management/backup"),
@@ -79,6 +81,7 @@ func NewBackupsService(
 		scheduleService:      scheduleService,
 		removalSVC:           removalSVC,
 		pbmPITRService:       pbmPITRService,
+		mysqlPITRService:     mysqlPITRService,
 	}
 }
 
@@ -210,8 +213,12 @@ func (s *BackupService) ScheduleBackup(ctx context.Context, req *backupv1.Schedu
 			if err != nil {
 				return status.Errorf(codes.InvalidArgument, "Can't create mongoDB backup task: %v", err)
 			}
-		case models.PostgreSQLServiceType,
-			models.ProxySQLServiceType,
+		case models.PostgreSQLServiceType:
+			task, err = scheduler.NewPostgreSQLBackupTask(backupParams)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "Can't create PostgreSQL backup task: %v", err)
+			}
+		case models.ProxySQLServiceType,
 			models.HAProxyServiceType,
 			models.ExternalServiceType:
 			return status.Errorf(codes.Unimplemented, "Unimplemented service: %s.", svc.ServiceType)
@@ -248,6 +255,7 @@ func (s *BackupService) ListScheduledBackups(ctx context.Context, _ *backupv1.Li
 		Types: []models.ScheduledTaskType{
 			models.ScheduledMySQLBackupTask,
 			models.ScheduledMongoDBBackupTask,
+			models.ScheduledPostgreSQLBackupTask,
 		},
 	})
 	if err != nil {
@@ -266,6 +274,9 @@ func (s *BackupService) ListScheduledBackups(ctx context.Context, _ *backupv1.Li
 		case models.ScheduledMongoDBBackupTask:
 			serviceID = t.Data.MongoDBBackupTask.ServiceID
 			locationID = t.Data.MongoDBBackupTask.LocationID
+		case models.ScheduledPostgreSQLBackupTask:
+			serviceID = t.Data.PostgreSQLBackupTask.ServiceID
+			locationID = t.Data.PostgreSQLBackupTask.LocationID
 		default:
 			continue
 		}
@@ -301,6 +312,7 @@ func (s *BackupService) ListScheduledBackups(ctx context.Context, _ *backupv1.Li
 func (s *BackupService) ChangeScheduledBackup(ctx context.Context, req *backupv1.ChangeScheduledBackupRequest) (*backupv1.ChangeScheduledBackupResponse, error) {
 	var disablePITR bool
 	var serviceID string
+	var taskType models.ScheduledTaskType
 
 	errTx := s.db.InTransactionContext(ctx, nil, func(tx *reform.TX) error {
 		scheduledTask, err := models.FindScheduledTaskByID(tx.Querier, req.ScheduledBackupId)
@@ -314,6 +326,8 @@ func (s *BackupService) ChangeScheduledBackup(ctx context.Context, req *backupv1
 			data = &scheduledTask.Data.MySQLBackupTask.CommonBackupTaskData
 		case models.ScheduledMongoDBBackupTask:
 			data = &scheduledTask.Data.MongoDBBackupTask.CommonBackupTaskData
+		case models.ScheduledPostgreSQLBackupTask:
+			data = &scheduledTask.Data.PostgreSQLBackupTask.CommonBackupTaskData
 		default:
 			return status.Errorf(codes.InvalidArgument, "Unknown type: %s", scheduledTask.Type)
 		}
@@ -341,6 +355,7 @@ func (s *BackupService) ChangeScheduledBackup(ctx context.Context, req *backupv1
 		}
 
 		serviceID = data.ServiceID
+		taskType = scheduledTask.Type
 		params := models.ChangeScheduledTaskParams{
 			Data:           scheduledTask.Data,
 			CronExpression: req.CronExpression,
@@ -348,7 +363,7 @@ func (s *BackupService) ChangeScheduledBackup(ctx context.Context, req *backupv1
 
 		if req.Enabled != nil {
 			params.Disable = new(!*req.Enabled)
-			if scheduledTask.Type == models.ScheduledMongoDBBackupTask && !*req.Enabled {
+			if !*req.Enabled {
 				disablePITR = data.Mode == models.PITR
 			}
 		}
@@ -362,10 +377,7 @@ func (s *BackupService) ChangeScheduledBackup(ctx context.Context, req *backupv1
 	}
 
 	if disablePITR {
-		err := s.backupService.SwitchMongoPITR(ctx, serviceID, false)
-		if err != nil {
-			s.l.WithError(err).Error("failed to disable PITR")
-		}
+		s.disablePITR(ctx, taskType, serviceID)
 	}
 
 	return &backupv1.ChangeScheduledBackupResponse{}, nil
@@ -379,12 +391,18 @@ func (s *BackupService) RemoveScheduledBackup(ctx context.Context, req *backupv1
 	}
 
 	var disablePITR bool
+	var serviceID string
 	switch task.Type {
-	case models.ScheduledMySQLBackupTask:
+	case models.ScheduledPostgreSQLBackupTask:
 		// nothing
+	case models.ScheduledMySQLBackupTask:
+		// for enabled incremental MySQL backups stop binary logs streaming
+		disablePITR = task.Data.MySQLBackupTask.Mode == models.PITR && !task.Disabled
+		serviceID = task.Data.MySQLBackupTask.ServiceID
 	case models.ScheduledMongoDBBackupTask:
 		// for enabled incremental mongoDB backups switch-off PITR
 		disablePITR = task.Data.MongoDBBackupTask.Mode == models.PITR && !task.Disabled
+		serviceID = task.Data.MongoDBBackupTask.ServiceID
 	default:
 		return nil, fmt.Errorf("non-backup task: %s", task.Type)
 	}
@@ -413,15 +431,28 @@ func (s *BackupService) RemoveScheduledBackup(ctx context.Context, req *backupv1
 	}
 
 	if disablePITR {
-		err = s.backupService.SwitchMongoPITR(ctx, task.Data.MongoDBBackupTask.ServiceID, false)
-		if err != nil {
-			s.l.WithError(err).Error("failed to disable PITR")
-		}
+		s.disablePITR(ctx, task.Type, serviceID)
 	}
 
 	return &backupv1.RemoveScheduledBackupResponse{}, nil
 }
 
+// disablePITR stops continuous backup of the service made by the scheduled backup task of the given type.
+func (s *BackupService) disablePITR(ctx context.Context, taskType models.ScheduledTaskType, serviceID string) {
+	var err error
+	switch taskType { //nolint:exhaustive
+	case models.ScheduledMongoDBBackupTask:
+		err = s.backupService.SwitchMongoPITR(ctx, serviceID, false)
+	case models.ScheduledMySQLBackupTask:
+		err = s.backupService.StopMySQLBinlogStreaming(ctx, serviceID)
+	default:
+		return
+	}
+	if err != nil {
+		s.l.WithError(err).Error("failed to disable PITR")
+	}
+}
+
 // GetLogs returns logs from the underlying tools for a backup/restore job.
 func (s *BackupService) GetLogs(_ context.Context, req *backupv1.GetLogsRequest) (*backupv1.GetLogsResponse, error) {
 	jobsFilter := models.JobsFilter{
@@ -429,6 +460,8 @@ func (s *BackupService) GetLogs(_ context.Context, req *backupv1.GetLogsRequest)
 			models.MySQLBackupJob,
 			models.MongoDBBackupJob,
 			models.MongoDBRestoreBackupJob,
+			models.PostgreSQLBackupJob,
+			models.PostgreSQLRestoreBackupJob,
 		},
 	}
 
@@ -501,8 +534,9 @@ func (s *BackupService) ListArtifactCompatibleServices(
 			res.Mysql = append(res.Mysql, s)
 		case *inventoryv1.MongoDBService:
 			res.Mongodb = append(res.Mongodb, s)
-		case *inventoryv1.PostgreSQLService,
-			*inventoryv1.ProxySQLService,
+		case *inventoryv1.PostgreSQLService:
+			res.Postgresql = append(res.Postgresql, s)
+		case *inventoryv1.ProxySQLService,
 			*inventoryv1.HAProxyService,
 			*inventoryv1.ExternalService:
 			return nil, status.Errorf(codes.Unimplemented, "unimplemented service type %T", service)
@@ -589,7 +623,7 @@ func (s *BackupService) DeleteArtifact(ctx context.Context, req *backupv1.Delete
 	return &backupv1.DeleteArtifactResponse{}, nil
 }
 
-// ListPitrTimeranges lists available PITR timelines/time-ranges (for MongoDB).
+// ListPitrTimeranges lists available PITR timelines/time-ranges (for MySQL and MongoDB).
 func (s *BackupService) ListPitrTimeranges(ctx context.Context, req *backupv1.ListPitrTimerangesRequest) (*backupv1.ListPitrTimerangesResponse, error) {
 	var artifact *models.Artifact
 	var err error
@@ -617,7 +651,13 @@ func (s *BackupService) ListPitrTimeranges(ctx context.Context, req *backupv1.Li
 
 	storage := backup.GetStorageForLocation(location)
 
-	timelines, err := s.pbmPITRService.ListPITRTimeranges(ctx, storage, location, artifact)
+	var timelines []backup.Timeline
+	switch models.ServiceType(artifact.Vendor) { //nolint:exhaustive
+	case models.MySQLServiceType:
+		timelines, err = s.mysqlPITRService.ListPITRTimeranges(ctx, storage, location, artifact)
+	default:
+		timelines, err = s.pbmPITRService.ListPITRTimeranges(ctx, storage, location, artifact)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -633,6 +673,140 @@ func (s *BackupService) ListPitrTimeranges(ctx context.Context, req *backupv1.Li
 	}, nil
 }
 
+// VerifyArtifact starts verification of the specified artifact.
+func (s *BackupService) VerifyArtifact(ctx context.Context, req *backupv1.VerifyArtifactRequest) (*backupv1.VerifyArtifactResponse, error) {
+	err := s.backupService.VerifyArtifact(ctx, req.ArtifactId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &backupv1.VerifyArtifactResponse{}, nil
+}
+
+// ScheduleVerification adds artifact verification task to scheduler.
+func (s *BackupService) ScheduleVerification(ctx context.Context, req *backupv1.ScheduleVerificationRequest) (*backupv1.ScheduleVerificationResponse, error) {
+	var id string
+
+	err := isNameSafe(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	errTx := s.db.InTransactionContext(ctx, nil, func(tx *reform.TX) error {
+		svc, err := models.FindServiceByID(tx.Querier, req.ServiceId)
+		if err != nil {
+			return convertError(err)
+		}
+
+		_, err = models.FindBackupLocationByID(tx.Querier, req.LocationId)
+		if err != nil {
+			return convertError(err)
+		}
+
+		switch svc.ServiceType {
+		case models.MySQLServiceType, models.MongoDBServiceType:
+		default:
+			return status.Errorf(codes.Unimplemented, "Artifact verification is not supported for service: %s.", svc.ServiceType)
+		}
+
+		task, err := scheduler.NewVerificationTask(&scheduler.VerificationTaskParams{
+			ServiceID:   svc.ServiceID,
+			LocationID:  req.LocationId,
+			Name:        req.Name,
+			Description: req.Description,
+		})
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "Can't create verification task: %v", err)
+		}
+
+		t := req.StartTime.AsTime()
+		if t.Unix() == 0 {
+			t = time.Time{}
+		}
+
+		scheduledTask, err := s.scheduleService.Add(task, scheduler.AddParams{
+			CronExpression: req.CronExpression,
+			Disabled:       !req.Enabled,
+			StartAt:        t,
+		})
+		if err != nil {
+			return convertError(err)
+		}
+
+		id = scheduledTask.ID
+		return nil
+	})
+	if errTx != nil {
+		return nil, errTx
+	}
+	return &backupv1.ScheduleVerificationResponse{ScheduledVerificationId: id}, nil
+}
+
+// ListScheduledVerifications lists all artifact verification tasks.
+func (s *BackupService) ListScheduledVerifications(
+	ctx context.Context,
+	_ *backupv1.ListScheduledVerificationsRequest,
+) (*backupv1.ListScheduledVerificationsResponse, error) {
+	tasks, err := models.FindScheduledTasks(s.db.WithContext(ctx), models.ScheduledTasksFilter{
+		Types: []models.ScheduledTaskType{models.ScheduledVerificationTask},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	locationIDs := make([]string, 0, len(tasks))
+	serviceIDs := make([]string, 0, len(tasks))
+	for _, t := range tasks {
+		serviceIDs = append(serviceIDs, t.Data.VerificationTask.ServiceID)
+		locationIDs = append(locationIDs, t.Data.VerificationTask.LocationID)
+	}
+	locations, err := models.FindBackupLocationsByIDs(s.db.Querier, locationIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	svcs, err := models.FindServicesByIDs(s.db.Querier, serviceIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledVerifications := make([]*backupv1.ScheduledVerification, 0, len(tasks))
+	for _, task := range tasks {
+		scheduledVerification, err := convertTaskToScheduledVerification(task, svcs, locations)
+		if err != nil {
+			s.l.WithError(err).Warnf("convert task to scheduledVerification")
+			continue
+		}
+		scheduledVerifications = append(scheduledVerifications, scheduledVerification)
+	}
+
+	return &backupv1.ListScheduledVerificationsResponse{
+		ScheduledVerifications: scheduledVerifications,
+	}, nil
+}
+
+// RemoveScheduledVerification stops and removes existing artifact verification task.
+func (s *BackupService) RemoveScheduledVerification(
+	ctx context.Context,
+	req *backupv1.RemoveScheduledVerificationRequest,
+) (*backupv1.RemoveScheduledVerificationResponse, error) {
+	task, err := models.FindScheduledTaskByID(s.db.WithContext(ctx), req.ScheduledVerificationId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	if task.Type != models.ScheduledVerificationTask {
+		return nil, status.Errorf(codes.InvalidArgument, "Non-verification task: %s.", task.Type)
+	}
+
+	err = s.scheduleService.Remove(req.ScheduledVerificationId)
+	if err != nil {
+		return nil, convertError(err)
+	}
+
+	return &backupv1.RemoveScheduledVerificationResponse{}, nil
+}
+
 func convertTaskToScheduledBackup(task *models.ScheduledTask,
 	services map[string]*models.Service,
 	locationModels map[string]*models.BackupLocation,
@@ -661,6 +835,8 @@ func convertTaskToScheduledBackup(task *models.ScheduledTask,
 		commonBackupData = task.Data.MySQLBackupTask.CommonBackupTaskData
 	case models.ScheduledMongoDBBackupTask:
 		commonBackupData = task.Data.MongoDBBackupTask.CommonBackupTaskData
+	case models.ScheduledPostgreSQLBackupTask:
+		commonBackupData = task.Data.PostgreSQLBackupTask.CommonBackupTaskData
 	default:
 		return nil, fmt.Errorf("unknown task type: %s", task.Type)
 	}
@@ -695,6 +871,53 @@ func convertTaskToScheduledBackup(task *models.ScheduledTask,
 	return scheduledBackup, nil
 }
 
+func convertTaskToScheduledVerification(task *models.ScheduledTask,
+	services map[string]*models.Service,
+	locationModels map[string]*models.BackupLocation,
+) (*backupv1.ScheduledVerification, error) {
+	if task.Type != models.ScheduledVerificationTask {
+		return nil, fmt.Errorf("unknown task type: %s", task.Type)
+	}
+
+	data := task.Data.VerificationTask
+	svc, ok := services[data.ServiceID]
+	if !ok {
+		return nil, fmt.Errorf("no service id '%s' in the map", data.ServiceID)
+	}
+
+	location, ok := locationModels[data.LocationID]
+	if !ok {
+		return nil, fmt.Errorf("no location id '%s' in the map", data.LocationID)
+	}
+
+	scheduledVerification := &backupv1.ScheduledVerification{
+		ScheduledVerificationId: task.ID,
+		ServiceId:               data.ServiceID,
+		ServiceName:             svc.ServiceName,
+		LocationId:              data.LocationID,
+		LocationName:            location.Name,
+		CronExpression:          task.CronExpression,
+		Name:                    data.Name,
+		Description:             data.Description,
+		Enabled:                 !task.Disabled,
+		Vendor:                  string(svc.ServiceType),
+	}
+
+	if !task.LastRun.IsZero() {
+		scheduledVerification.LastRun = timestamppb.New(task.LastRun)
+	}
+
+	if !task.NextRun.IsZero() {
+		scheduledVerification.NextRun = timestamppb.New(task.NextRun)
+	}
+
+	if !task.StartAt.IsZero() {
+		scheduledVerification.StartTime = timestamppb.New(task.StartAt)
+	}
+
+	return scheduledVerification, nil
+}
+
 func convertBackupModeToModel(mode backupv1.BackupMode) (models.BackupMode, error) {
 	switch mode {
 	case backupv1.BackupMode_BACKUP_MODE_SNAPSHOT:
@@ -757,6 +980,8 @@ func convertError(e error) error {
 		code = backupv1.ErrorCode_ERROR_CODE_INCOMPATIBLE_TARGET_MYSQL
 	case errors.Is(e, backup.ErrIncompatibleTargetMongoDB):
 		code = backupv1.ErrorCode_ERROR_CODE_INCOMPATIBLE_TARGET_MONGODB
+	case errors.Is(e, backup.ErrIncompatibleTargetPostgreSQL):
+		code = backupv1.ErrorCode_ERROR_CODE_INCOMPATIBLE_TARGET_POSTGRESQL
 	case errors.Is(e, backup.ErrTimestampOutOfRange):
 		return status.Error(codes.OutOfRange, e.Error())
 	case errors.Is(e, models.ErrNotFound):
@@ -853,6 +1078,21 @@ func convertBackupStatus(status models.BackupStatus) (backupv1.BackupStatus, err
 	}
 }
 
+func convertVerificationStatus(status models.VerificationStatus) (backupv1.VerificationStatus, error) {
+	switch status {
+	case "":
+		return backupv1.VerificationStatus_VERIFICATION_STATUS_UNSPECIFIED, nil
+	case models.PendingVerificationStatus:
+		return backupv1.VerificationStatus_VERIFICATION_STATUS_PENDING, nil
+	case models.VerifiedVerificationStatus:
+		return backupv1.VerificationStatus_VERIFICATION_STATUS_VERIFIED, nil
+	case models.FailedVerificationStatus:
+		return backupv1.VerificationStatus_VERIFICATION_STATUS_FAILED, nil
+	default:
+		return 0, fmt.Errorf("invalid verification status '%s'", status)
+	}
+}
+
 func convertArtifact(
 	a *models.Artifact,
 	services map[string]*models.Service,
@@ -891,21 +1131,33 @@ func convertArtifact(
 		return nil, fmt.Errorf("artifact id '%s': %w", a.ID, err)
 	}
 
+	verificationStatus, err := convertVerificationStatus(a.VerificationStatus)
+	if err != nil {
+		return nil, fmt.Errorf("artifact id '%s': %w", a.ID, err)
+	}
+
+	var lastVerifiedAt *timestamppb.Timestamp
+	if a.LastVerifiedAt != nil {
+		lastVerifiedAt = timestamppb.New(*a.LastVerifiedAt)
+	}
+
 	return &backupv1.Artifact{
-		ArtifactId:       a.ID,
-		Name:             a.Name,
-		Vendor:           a.Vendor,
-		LocationId:       a.LocationID,
-		LocationName:     l.Name,
-		ServiceId:        a.ServiceID,
-		ServiceName:      serviceName,
-		DataModel:        dataModel,
-		Mode:             backupMode,
-		Status:           backupStatus,
-		CreatedAt:        createdAt,
-		IsShardedCluster: a.IsShardedCluster,
-		Folder:           a.Folder,
-		MetadataList:     artifactMetadataListToProto(a),
+		ArtifactId:         a.ID,
+		Name:               a.Name,
+		Vendor:             a.Vendor,
+		LocationId:         a.LocationID,
+		LocationName:       l.Name,
+		ServiceId:          a.ServiceID,
+		ServiceName:        serviceName,
+		DataModel:          dataModel,
+		Mode:               backupMode,
+		Status:             backupStatus,
+		CreatedAt:          createdAt,
+		IsShardedCluster:   a.IsShardedCluster,
+		Folder:             a.Folder,
+		MetadataList:       artifactMetadataListToProto(a),
+		VerificationStatus: verificationStatus,
+		LastVerifiedAt:     lastVerifiedAt,
 	}, nil
 }
 
@@ -36,6 +36,7 @@ import (
 	backupv1 "github.com/percona/pmm/api/backup/v1"
 	"github.com/percona/pmm/managed/models"
 	"github.com/percona/pmm/managed/services/backup"
+	"github.com/percona/pmm/managed/services/ha"
 	"github.com/percona/pmm/managed/services/scheduler"
 	"github.com/percona/pmm/managed/utils/testdb"
 	"github.com/percona/pmm/managed/utils/tests"
@@ -83,7 +84,7 @@ func TestStartBackup(t *testing.T) {
 		mockedPbmPITRService := &mockPbmPITRService{}
 		sqlDB := testdb.Open(t, models.SkipFixtures, nil)
 		db := reform.NewDB(sqlDB, postgresql.Dialect, reform.NewPrintfLogger(t.Logf))
-		backupSvc := NewBackupsService(db, backupService, nil, nil, nil, mockedPbmPITRService)
+		backupSvc := NewBackupsService(db, backupService, nil, nil, nil, mockedPbmPITRService, nil)
 		agent := setup(t, db.Querier, models.MySQLServiceType, t.Name())
 
 		for _, tc := range []struct {
@@ -158,7 +159,7 @@ func TestStartBackup(t *testing.T) {
 			ctx := t.Context()
 			backupService := &mockBackupService{}
 			mockedPbmPITRService := &mockPbmPITRService{}
-			backupSvc := NewBackupsService(db, backupService, nil, nil, nil, mockedPbmPITRService)
+			backupSvc := NewBackupsService(db, backupService, nil, nil, nil, mockedPbmPITRService, nil)
 			backupService.On("PerformBackup", mock.Anything, mock.Anything).Return("", nil)
 			_, err := backupSvc.StartBackup(ctx, &backupv1.StartBackupRequest{
 				ServiceId:     *agent.ServiceID,
@@ -177,7 +178,7 @@ func TestStartBackup(t *testing.T) {
 			backupService := &mockBackupService{}
 			mockedPbmPITRService := &mockPbmPITRService{}
 
-			backupSvc := NewBackupsService(db, backupService, nil, nil, nil, mockedPbmPITRService)
+			backupSvc := NewBackupsService(db, backupService, nil, nil, nil, mockedPbmPITRService, nil)
 
 			tc := []struct {
 				TestName   string
@@ -274,8 +275,8 @@ func TestScheduledBackups(t *testing.T) {
 	t.Run("mysql", func(t *testing.T) {
 		backupService := &mockBackupService{}
 		mockedPbmPITRService := &mockPbmPITRService{}
-		schedulerService := scheduler.New(db, backupService)
-		backupSvc := NewBackupsService(db, backupService, nil, schedulerService, nil, mockedPbmPITRService)
+		schedulerService := scheduler.New(db, backupService, ha.New(&models.HAParams{}))
+		backupSvc := NewBackupsService(db, backupService, nil, schedulerService, nil, mockedPbmPITRService, nil)
 
 		agent := setup(t, db.Querier, models.MySQLServiceType, t.Name())
 
@@ -388,7 +389,7 @@ func TestScheduledBackups(t *testing.T) {
 			ctx := t.Context()
 			schedulerService := &mockScheduleService{}
 			mockedPbmPITRService := &mockPbmPITRService{}
-			backupSvc := NewBackupsService(db, nil, nil, schedulerService, nil, mockedPbmPITRService)
+			backupSvc := NewBackupsService(db, nil, nil, schedulerService, nil, mockedPbmPITRService, nil)
 
 			schedulerService.On("Add", mock.Anything, mock.Anything).Return("", nil)
 			_, err := backupSvc.ScheduleBackup(ctx, &backupv1.ScheduleBackupRequest{
@@ -409,7 +410,7 @@ func TestScheduledBackups(t *testing.T) {
 			ctx := t.Context()
 			schedulerService := &mockScheduleService{}
 			mockedPbmPITRService := &mockPbmPITRService{}
-			backupSvc := NewBackupsService(db, nil, nil, schedulerService, nil, mockedPbmPITRService)
+			backupSvc := NewBackupsService(db, nil, nil, schedulerService, nil, mockedPbmPITRService, nil)
 			schedulerService.On("Add", mock.Anything, mock.Anything).Return(&models.ScheduledTask{}, nil)
 			_, err := backupSvc.ScheduleBackup(ctx, &backupv1.ScheduleBackupRequest{
 				ServiceId:     *agent.ServiceID,
@@ -433,7 +434,7 @@ func TestGetLogs(t *testing.T) {
 	backupService := &mockBackupService{}
 	schedulerService := &mockScheduleService{}
 	mockedPbmPITRService := &mockPbmPITRService{}
-	backupSvc := NewBackupsService(db, backupService, nil, schedulerService, nil, mockedPbmPITRService)
+	backupSvc := NewBackupsService(db, backupService, nil, schedulerService, nil, mockedPbmPITRService, nil)
 	t.Cleanup(func() {
 		_ = sqlDB.Close()
 	})
@@ -527,7 +528,7 @@ func TestListPitrTimeranges(t *testing.T) {
 
 	backupService := &mockBackupService{}
 	schedulerService := &mockScheduleService{}
-	backupSvc := NewBackupsService(db, backupService, nil, schedulerService, nil, mockedPbmPITRService)
+	backupSvc := NewBackupsService(db, backupService, nil, schedulerService, nil, mockedPbmPITRService, nil)
 
 	var locationID string
 
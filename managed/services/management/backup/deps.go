@@ -34,6 +34,8 @@ type backupService interface {
 	PerformBackup(ctx context.Context, params backup.PerformBackupParams) (string, error)
 	RestoreBackup(ctx context.Context, serviceID, artifactID string, pitrTimestamp time.Time) (string, error)
 	SwitchMongoPITR(ctx context.Context, serviceID string, enabled bool) error
+	StopMySQLBinlogStreaming(ctx context.Context, serviceID string) error
+	VerifyArtifact(ctx context.Context, artifactID string) error
 }
 
 type compatibilityService interface {
@@ -59,3 +61,9 @@ type pbmPITRService interface {
 	// ListPITRTimeranges returns the available PITR timeranges for the given artifact in the provided location.
 	ListPITRTimeranges(ctx context.Context, locationClient backup.Storage, location *models.BackupLocation, artifact *models.Artifact) ([]backup.Timeline, error)
 }
+
+// mysqlPITRService provides methods that help us inspect MySQL PITR artifacts.
+type mysqlPITRService interface {
+	// ListPITRTimeranges returns the available PITR timeranges for the given artifact in the provided location.
+	ListPITRTimeranges(ctx context.Context, locationClient backup.Storage, location *models.BackupLocation, artifact *models.Artifact) ([]backup.Timeline, error)
+}
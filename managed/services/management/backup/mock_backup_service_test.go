@@ -72,6 +72,24 @@ func (_m *mockBackupService) RestoreBackup(ctx context.Context, serviceID string
 	return r0, r1
 }
 
+// StopMySQLBinlogStreaming provides a mock function with given fields: ctx, serviceID
+func (_m *mockBackupService) StopMySQLBinlogStreaming(ctx context.Context, serviceID string) error {
+	ret := _m.Called(ctx, serviceID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StopMySQLBinlogStreaming")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, serviceID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SwitchMongoPITR provides a mock function with given fields: ctx, serviceID, enabled
 func (_m *mockBackupService) SwitchMongoPITR(ctx context.Context, serviceID string, enabled bool) error {
 	ret := _m.Called(ctx, serviceID, enabled)
@@ -90,13 +108,30 @@ func (_m *mockBackupService) SwitchMongoPITR(ctx context.Context, serviceID stri
 	return r0
 }
 
+// VerifyArtifact provides a mock function with given fields: ctx, artifactID
+func (_m *mockBackupService) VerifyArtifact(ctx context.Context, artifactID string) error {
+	ret := _m.Called(ctx, artifactID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyArtifact")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, artifactID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // newMockBackupService creates a new instance of mockBackupService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func newMockBackupService(t interface {
 	mock.TestingT
 	Cleanup(func())
-},
-) *mockBackupService {
+}) *mockBackupService {
 	mock := &mockBackupService{}
 	mock.Mock.Test(t)
 
@@ -128,6 +128,8 @@ func (s *RestoreService) GetLogs(_ context.Context, req *backupv1.RestoreService
 			models.MySQLBackupJob,
 			models.MongoDBBackupJob,
 			models.MongoDBRestoreBackupJob,
+			models.PostgreSQLBackupJob,
+			models.PostgreSQLRestoreBackupJob,
 		},
 	}
 
@@ -177,7 +179,14 @@ func (s *RestoreService) GetLogs(_ context.Context, req *backupv1.RestoreService
 // RestoreBackup starts restore backup job.
 func (s *RestoreService) RestoreBackup(ctx context.Context, req *backupv1.RestoreBackupRequest) (*backupv1.RestoreBackupResponse, error) {
 	// Disable all related scheduled backups before restoring
-	tasks, err := models.FindScheduledTasks(s.db.Querier, models.ScheduledTasksFilter{ServiceID: req.ServiceId})
+	tasks, err := models.FindScheduledTasks(s.db.Querier, models.ScheduledTasksFilter{
+		ServiceID: req.ServiceId,
+		Types: []models.ScheduledTaskType{
+			models.ScheduledMySQLBackupTask,
+			models.ScheduledMongoDBBackupTask,
+			models.ScheduledPostgreSQLBackupTask,
+		},
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -198,6 +207,8 @@ func (s *RestoreService) RestoreBackup(ctx context.Context, req *backupv1.Restor
 				data = &scheduledTask.Data.MySQLBackupTask.CommonBackupTaskData
 			case models.ScheduledMongoDBBackupTask:
 				data = &scheduledTask.Data.MongoDBBackupTask.CommonBackupTaskData
+			case models.ScheduledPostgreSQLBackupTask:
+				data = &scheduledTask.Data.PostgreSQLBackupTask.CommonBackupTaskData
 			default:
 				return status.Errorf(codes.InvalidArgument, "Unknown type: %s", scheduledTask.Type)
 			}
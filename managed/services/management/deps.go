@@ -101,3 +101,9 @@ type versionCache interface {
 type victoriaMetricsClient interface {
 	Query(ctx context.Context, query string, ts time.Time, opts ...v1.Option) (model.Value, v1.Warnings, error)
 }
+
+// certificateAuthority is a subset of methods of ca.CA used by this package.
+// We use it instead of real type for testing.
+type certificateAuthority interface {
+	Sign(q *reform.Querier, pmmAgentID, csrPEM string) (string, error)
+}
@@ -101,7 +101,7 @@ func (s *actionsServer) StartServiceAction(ctx context.Context, req *actionsv1.S
 
 // StartMySQLExplainAction starts MySQL EXPLAIN Action with traditional output.
 func (s *actionsServer) StartMySQLExplainAction(ctx context.Context, req *actionsv1.StartMySQLExplainActionParams) (*actionsv1.StartServiceActionResponse, error) {
-	res, dsn, files, tdp, err := s.prepareServiceActionWithFiles(req.ServiceId, req.PmmAgentId, req.Database)
+	res, dsn, files, tdp, secrets, err := s.prepareServiceActionWithFiles(req.ServiceId, req.PmmAgentId, req.Database)
 	if err != nil {
 		return nil, err
 	}
@@ -122,7 +122,7 @@ func (s *actionsServer) StartMySQLExplainAction(ctx context.Context, req *action
 	}
 
 	err = s.a.StartMySQLExplainAction(ctx, res.ID, res.PMMAgentID, req.ServiceId, dsn,
-		req.QueryId, req.Placeholders, agentv1.MysqlExplainOutputFormat_MYSQL_EXPLAIN_OUTPUT_FORMAT_DEFAULT, files, tdp, agents[0].TLSSkipVerify)
+		req.QueryId, req.Placeholders, agentv1.MysqlExplainOutputFormat_MYSQL_EXPLAIN_OUTPUT_FORMAT_DEFAULT, files, tdp, agents[0].TLSSkipVerify, secrets)
 	if err != nil {
 		return nil, err
 	}
@@ -142,7 +142,7 @@ func (s *actionsServer) StartMySQLExplainJSONAction(
 	ctx context.Context,
 	req *actionsv1.StartMySQLExplainJSONActionParams,
 ) (*actionsv1.StartServiceActionResponse, error) {
-	res, dsn, files, tdp, err := s.prepareServiceActionWithFiles(req.ServiceId, req.PmmAgentId, req.Database)
+	res, dsn, files, tdp, secrets, err := s.prepareServiceActionWithFiles(req.ServiceId, req.PmmAgentId, req.Database)
 	if err != nil {
 		return nil, err
 	}
@@ -163,7 +163,7 @@ func (s *actionsServer) StartMySQLExplainJSONAction(
 	}
 
 	err = s.a.StartMySQLExplainAction(ctx, res.ID, res.PMMAgentID, req.ServiceId, dsn,
-		req.QueryId, req.Placeholders, agentv1.MysqlExplainOutputFormat_MYSQL_EXPLAIN_OUTPUT_FORMAT_JSON, files, tdp, agents[0].TLSSkipVerify)
+		req.QueryId, req.Placeholders, agentv1.MysqlExplainOutputFormat_MYSQL_EXPLAIN_OUTPUT_FORMAT_JSON, files, tdp, agents[0].TLSSkipVerify, secrets)
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +183,7 @@ func (s *actionsServer) StartMySQLExplainTraditionalJSONAction(
 	ctx context.Context,
 	req *actionsv1.StartMySQLExplainTraditionalJSONActionParams,
 ) (*actionsv1.StartServiceActionResponse, error) {
-	res, dsn, files, tdp, err := s.prepareServiceActionWithFiles(req.ServiceId, req.PmmAgentId, req.Database)
+	res, dsn, files, tdp, secrets, err := s.prepareServiceActionWithFiles(req.ServiceId, req.PmmAgentId, req.Database)
 	if err != nil {
 		return nil, err
 	}
@@ -204,7 +204,7 @@ func (s *actionsServer) StartMySQLExplainTraditionalJSONAction(
 	}
 
 	err = s.a.StartMySQLExplainAction(ctx, res.ID, res.PMMAgentID, req.ServiceId, dsn,
-		req.QueryId, req.Placeholders, agentv1.MysqlExplainOutputFormat_MYSQL_EXPLAIN_OUTPUT_FORMAT_TRADITIONAL_JSON, files, tdp, agents[0].TLSSkipVerify)
+		req.QueryId, req.Placeholders, agentv1.MysqlExplainOutputFormat_MYSQL_EXPLAIN_OUTPUT_FORMAT_TRADITIONAL_JSON, files, tdp, agents[0].TLSSkipVerify, secrets)
 	if err != nil {
 		return nil, err
 	}
@@ -224,7 +224,7 @@ func (s *actionsServer) StartMySQLShowCreateTableAction(
 	ctx context.Context,
 	req *actionsv1.StartMySQLShowCreateTableActionParams,
 ) (*actionsv1.StartServiceActionResponse, error) {
-	res, dsn, files, tdp, err := s.prepareServiceActionWithFiles(req.ServiceId, req.PmmAgentId, req.Database)
+	res, dsn, files, tdp, secrets, err := s.prepareServiceActionWithFiles(req.ServiceId, req.PmmAgentId, req.Database)
 	if err != nil {
 		return nil, err
 	}
@@ -244,7 +244,7 @@ func (s *actionsServer) StartMySQLShowCreateTableAction(
 		return nil, status.Errorf(codes.FailedPrecondition, "Cannot find right agent")
 	}
 
-	err = s.a.StartMySQLShowCreateTableAction(ctx, res.ID, res.PMMAgentID, dsn, req.TableName, files, tdp, agents[0].TLSSkipVerify)
+	err = s.a.StartMySQLShowCreateTableAction(ctx, res.ID, res.PMMAgentID, dsn, req.TableName, files, tdp, agents[0].TLSSkipVerify, secrets)
 	if err != nil {
 		return nil, err
 	}
@@ -264,7 +264,7 @@ func (s *actionsServer) StartMySQLShowTableStatusAction(
 	ctx context.Context,
 	req *actionsv1.StartMySQLShowTableStatusActionParams,
 ) (*actionsv1.StartServiceActionResponse, error) {
-	res, dsn, files, tdp, err := s.prepareServiceActionWithFiles(req.ServiceId, req.PmmAgentId, req.Database)
+	res, dsn, files, tdp, secrets, err := s.prepareServiceActionWithFiles(req.ServiceId, req.PmmAgentId, req.Database)
 	if err != nil {
 		return nil, err
 	}
@@ -284,7 +284,7 @@ func (s *actionsServer) StartMySQLShowTableStatusAction(
 		return nil, status.Errorf(codes.FailedPrecondition, "Cannot find right agent")
 	}
 
-	err = s.a.StartMySQLShowTableStatusAction(ctx, res.ID, res.PMMAgentID, dsn, req.TableName, files, tdp, agents[0].TLSSkipVerify)
+	err = s.a.StartMySQLShowTableStatusAction(ctx, res.ID, res.PMMAgentID, dsn, req.TableName, files, tdp, agents[0].TLSSkipVerify, secrets)
 	if err != nil {
 		return nil, err
 	}
@@ -304,7 +304,7 @@ func (s *actionsServer) StartMySQLShowIndexAction(
 	ctx context.Context,
 	req *actionsv1.StartMySQLShowIndexActionParams,
 ) (*actionsv1.StartServiceActionResponse, error) {
-	res, dsn, files, tdp, err := s.prepareServiceActionWithFiles(req.ServiceId, req.PmmAgentId, req.Database)
+	res, dsn, files, tdp, secrets, err := s.prepareServiceActionWithFiles(req.ServiceId, req.PmmAgentId, req.Database)
 	if err != nil {
 		return nil, err
 	}
@@ -324,7 +324,7 @@ func (s *actionsServer) StartMySQLShowIndexAction(
 		return nil, status.Errorf(codes.FailedPrecondition, "Cannot find right agent")
 	}
 
-	err = s.a.StartMySQLShowIndexAction(ctx, res.ID, res.PMMAgentID, dsn, req.TableName, files, tdp, agents[0].TLSSkipVerify)
+	err = s.a.StartMySQLShowIndexAction(ctx, res.ID, res.PMMAgentID, dsn, req.TableName, files, tdp, agents[0].TLSSkipVerify, secrets)
 	if err != nil {
 		return nil, err
 	}
@@ -344,12 +344,12 @@ func (s *actionsServer) StartPostgreSQLShowCreateTableAction(
 	ctx context.Context,
 	req *actionsv1.StartPostgreSQLShowCreateTableActionParams,
 ) (*actionsv1.StartServiceActionResponse, error) {
-	res, dsn, err := s.prepareServiceAction(req.ServiceId, req.PmmAgentId, req.Database)
+	res, dsn, files, tdp, secrets, err := s.prepareServiceActionWithFiles(req.ServiceId, req.PmmAgentId, req.Database)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.a.StartPostgreSQLShowCreateTableAction(ctx, res.ID, res.PMMAgentID, dsn, req.TableName)
+	err = s.a.StartPostgreSQLShowCreateTableAction(ctx, res.ID, res.PMMAgentID, dsn, req.TableName, files, tdp, secrets)
 	if err != nil {
 		return nil, err
 	}
@@ -369,12 +369,12 @@ func (s *actionsServer) StartPostgreSQLShowIndexAction(
 	ctx context.Context,
 	req *actionsv1.StartPostgreSQLShowIndexActionParams,
 ) (*actionsv1.StartServiceActionResponse, error) {
-	res, dsn, err := s.prepareServiceAction(req.ServiceId, req.PmmAgentId, req.Database)
+	res, dsn, files, tdp, secrets, err := s.prepareServiceActionWithFiles(req.ServiceId, req.PmmAgentId, req.Database)
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.a.StartPostgreSQLShowIndexAction(ctx, res.ID, res.PMMAgentID, dsn, req.TableName)
+	err = s.a.StartPostgreSQLShowIndexAction(ctx, res.ID, res.PMMAgentID, dsn, req.TableName, files, tdp, secrets)
 	if err != nil {
 		return nil, err
 	}
@@ -394,12 +394,12 @@ func (s *actionsServer) StartMongoDBExplainAction(ctx context.Context, req *acti
 	*actionsv1.StartServiceActionResponse, error,
 ) {
 	// Explain action must be executed against the admin database
-	res, dsn, files, tdp, err := s.prepareServiceActionWithFiles(req.ServiceId, req.PmmAgentId, "admin")
+	res, dsn, files, tdp, secrets, err := s.prepareServiceActionWithFiles(req.ServiceId, req.PmmAgentId, "admin")
 	if err != nil {
 		return nil, err
 	}
 
-	err = s.a.StartMongoDBExplainAction(ctx, res.ID, res.PMMAgentID, dsn, req.Query, files, tdp)
+	err = s.a.StartMongoDBExplainAction(ctx, res.ID, res.PMMAgentID, dsn, req.Query, files, tdp, secrets)
 	if err != nil {
 		return nil, err
 	}
@@ -506,7 +506,7 @@ func (s *actionsServer) StartPTPgSummaryAction(ctx context.Context, req *actions
 	}
 
 	err = s.a.StartPTPgSummaryAction(ctx, res.ID, pmmAgentID, pointer.GetString(service.Address), pointer.GetUint16(service.Port),
-		pointer.GetString(postgresExporters[0].Username), pointer.GetString(postgresExporters[0].Password))
+		pointer.GetString(postgresExporters[0].Username), pointer.GetString(postgresExporters[0].Password), postgresExporters[0].Secrets())
 	if err != nil {
 		return nil, err
 	}
@@ -573,7 +573,7 @@ func (s *actionsServer) StartPTMongoDBSummaryAction(
 
 	// Starts the pt-pg-summary with the host address, port, username and password
 	err = s.a.StartPTMongoDBSummaryAction(ctx, res.ID, pmmAgentID, pointer.GetString(service.Address), pointer.GetUint16(service.Port),
-		pointer.GetString(mongoDBExporters[0].Username), pointer.GetString(mongoDBExporters[0].Password))
+		pointer.GetString(mongoDBExporters[0].Username), pointer.GetString(mongoDBExporters[0].Password), mongoDBExporters[0].Secrets())
 	if err != nil {
 		return nil, err
 	}
@@ -646,7 +646,7 @@ func (s *actionsServer) StartPTMySQLSummaryAction(ctx context.Context, req *acti
 
 	err = s.a.StartPTMySQLSummaryAction(ctx, res.ID, pmmAgentID, pointer.GetString(service.Address), pointer.GetUint16(service.Port),
 		pointer.GetString(service.Socket), pointer.GetString(mysqldExporters[0].Username),
-		pointer.GetString(mysqldExporters[0].Password))
+		pointer.GetString(mysqldExporters[0].Password), mysqldExporters[0].Secrets())
 	if err != nil {
 		return nil, err
 	}
@@ -676,41 +676,14 @@ func (s *actionsServer) CancelAction(ctx context.Context, req *actionsv1.CancelA
 	return &actionsv1.CancelActionResponse{}, nil
 }
 
-func (s *actionsServer) prepareServiceAction(serviceID, pmmAgentID, database string) (*models.ActionResult, string, error) {
-	var res *models.ActionResult
-	var dsn string
-	e := s.db.InTransaction(func(tx *reform.TX) error {
-		agents, err := models.FindPMMAgentsForService(tx.Querier, serviceID)
-		if err != nil {
-			return err
-		}
-
-		pmmAgentID, err = models.FindPmmAgentIDToRunActionOrJob(pmmAgentID, agents)
-		if err != nil {
-			return err
-		}
-
-		dsn, _, err = models.FindDSNByServiceIDandPMMAgentID(tx.Querier, serviceID, pmmAgentID, database)
-		if err != nil {
-			return err
-		}
-
-		res, err = models.CreateActionResult(tx.Querier, pmmAgentID)
-		return err
-	})
-	if e != nil {
-		return nil, "", e
-	}
-	return res, dsn, nil
-}
-
 func (s *actionsServer) prepareServiceActionWithFiles(
 	serviceID, pmmAgentID, database string,
-) (*models.ActionResult, string, map[string]string, *models.DelimiterPair, error) {
+) (*models.ActionResult, string, map[string]string, *models.DelimiterPair, map[string]string, error) {
 	var res *models.ActionResult
 	var dsn string
 	var files map[string]string
 	var tdp *models.DelimiterPair
+	var secrets map[string]string
 	e := s.db.InTransaction(func(tx *reform.TX) error {
 		svc, err := models.FindServiceByID(tx.Querier, serviceID)
 		if err != nil {
@@ -735,12 +708,13 @@ func (s *actionsServer) prepareServiceActionWithFiles(
 
 		tdp = agent.TemplateDelimiters(svc)
 		files = agent.Files()
+		secrets = agent.Secrets()
 
 		res, err = models.CreateActionResult(tx.Querier, pmmAgentID)
 		return err
 	})
 	if e != nil {
-		return nil, "", nil, nil, e
+		return nil, "", nil, nil, nil, e
 	}
-	return res, dsn, files, tdp, nil
+	return res, dsn, files, tdp, secrets, nil
 }
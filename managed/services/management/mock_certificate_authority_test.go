@@ -0,0 +1,56 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package management
+
+import (
+	mock "github.com/stretchr/testify/mock"
+	reform "gopkg.in/reform.v1"
+)
+
+// mockCertificateAuthority is an autogenerated mock type for the certificateAuthority type
+type mockCertificateAuthority struct {
+	mock.Mock
+}
+
+// Sign provides a mock function with given fields: q, pmmAgentID, csrPEM
+func (_m *mockCertificateAuthority) Sign(q *reform.Querier, pmmAgentID string, csrPEM string) (string, error) {
+	ret := _m.Called(q, pmmAgentID, csrPEM)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Sign")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*reform.Querier, string, string) (string, error)); ok {
+		return rf(q, pmmAgentID, csrPEM)
+	}
+	if rf, ok := ret.Get(0).(func(*reform.Querier, string, string) string); ok {
+		r0 = rf(q, pmmAgentID, csrPEM)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(*reform.Querier, string, string) error); ok {
+		r1 = rf(q, pmmAgentID, csrPEM)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// newMockCertificateAuthority creates a new instance of mockCertificateAuthority. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockCertificateAuthority(t interface {
+	mock.TestingT
+	Cleanup(func())
+},
+) *mockCertificateAuthority {
+	mock := &mockCertificateAuthority{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
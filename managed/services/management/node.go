@@ -117,12 +117,27 @@ func (s *ManagementService) RegisterNode(ctx context.Context, req *managementv1.
 		if err != nil {
 			return err
 		}
+
+		if req.CertificateSigningRequest != "" {
+			res.ClientCertificate, err = s.ca.Sign(tx.Querier, pmmAgent.AgentID, req.CertificateSigningRequest)
+		}
 		return err
 	})
 	if e != nil {
 		return nil, e
 	}
 
+	// pmm-agent with a client certificate authenticates with it only,
+	// so it doesn't get a long-lived token, and the token of the previous registration is removed.
+	if res.ClientCertificate != "" {
+		if req.Reregister {
+			if _, err := s.grafanaClient.DeleteServiceAccount(ctx, req.NodeName, false); err != nil {
+				s.l.Debugf("Failed to delete service account of Node %q: %s.", req.NodeName, err)
+			}
+		}
+		return res, nil
+	}
+
 	authHeaders, _ := auth.GetHeadersFromContext(ctx)
 	token := auth.GetTokenFromHeaders(authHeaders)
 	if token != "" {
@@ -210,6 +225,41 @@ func (s *ManagementService) UnregisterNode(ctx context.Context, req *managementv
 	}, nil
 }
 
+// RevokeNodeCertificates revokes client certificates of pmm-agents running on the Node and disconnects them.
+// Certificates are also removed together with pmm-agents when the Node is unregistered.
+func (s *ManagementService) RevokeNodeCertificates(
+	ctx context.Context,
+	req *managementv1.RevokeNodeCertificatesRequest,
+) (*managementv1.RevokeNodeCertificatesResponse, error) {
+	var idsToKick []string
+	e := s.db.InTransactionContext(ctx, nil, func(tx *reform.TX) error {
+		if _, err := models.FindNodeByID(tx.Querier, req.NodeId); err != nil {
+			return err
+		}
+
+		agents, err := models.FindPMMAgentsRunningOnNode(tx.Querier, req.NodeId)
+		if err != nil {
+			return fmt.Errorf("failed to find pmm-agent on node %s: %w", req.NodeId, err)
+		}
+		for _, a := range agents {
+			if err = models.RevokeAllAgentCertificates(tx.Querier, a.AgentID); err != nil {
+				return err
+			}
+			idsToKick = append(idsToKick, a.AgentID)
+		}
+		return nil
+	})
+	if e != nil {
+		return nil, e
+	}
+
+	for _, id := range idsToKick {
+		s.r.Kick(ctx, id)
+	}
+
+	return &managementv1.RevokeNodeCertificatesResponse{}, nil
+}
+
 const upQuery = `up{job=~".*_hr$"}`
 
 // ListNodes returns a filtered list of Nodes.
@@ -88,7 +88,7 @@ func TestNodeService(t *testing.T) {
 				vmClient.AssertExpectations(t)
 			}
 
-			s := NewManagementService(db, r, state, nil, nil, vmdb, nil, authProvider, vmClient)
+			s := NewManagementService(db, r, state, nil, nil, vmdb, nil, authProvider, vmClient, nil)
 
 			return ctx, s, teardown
 		}
@@ -229,6 +229,43 @@ func TestNodeService(t *testing.T) {
 			require.NoError(t, err)
 			assert.Empty(t, res.Warning)
 		})
+
+		t.Run("ClientCertificate", func(t *testing.T) {
+			nodeName := "test-node-certificate"
+
+			authProvider := &mockGrafanaClient{}
+			authProvider.Test(t)
+			s.grafanaClient = authProvider
+
+			ca := &mockCertificateAuthority{}
+			ca.Test(t)
+			ca.On("Sign", mock.Anything, mock.AnythingOfType("string"), "test-csr").Return("test-certificate", nil)
+			s.ca = ca
+
+			res, err := s.RegisterNode(ctx, &managementv1.RegisterNodeRequest{
+				NodeType:                  inventoryv1.NodeType_NODE_TYPE_GENERIC_NODE,
+				NodeName:                  nodeName,
+				Address:                   "certificate.address.org",
+				CertificateSigningRequest: "test-csr",
+			})
+			require.NoError(t, err)
+			assert.Equal(t, "test-certificate", res.ClientCertificate)
+			assert.Empty(t, res.Token)
+			ca.AssertCalled(t, "Sign", mock.Anything, res.PmmAgent.AgentId, "test-csr")
+			authProvider.AssertExpectations(t)
+
+			r := &mockAgentsRegistry{}
+			r.Test(t)
+			r.On("Kick", ctx, res.PmmAgent.AgentId).Return()
+			s.r = r
+
+			_, err = s.RevokeNodeCertificates(ctx, &managementv1.RevokeNodeCertificatesRequest{NodeId: res.GenericNode.NodeId})
+			require.NoError(t, err)
+			r.AssertExpectations(t)
+
+			_, err = s.RevokeNodeCertificates(ctx, &managementv1.RevokeNodeCertificatesRequest{NodeId: "unknown"})
+			tests.AssertGRPCError(t, status.New(codes.NotFound, `Node with ID "unknown" not found.`), err)
+		})
 	})
 
 	t.Run("ListNodes", func(t *testing.T) {
@@ -272,7 +309,7 @@ func TestNodeService(t *testing.T) {
 			grafanaClient := &mockGrafanaClient{}
 			grafanaClient.Test(t)
 
-			s := NewManagementService(db, ar, state, cc, sib, vmdb, vc, grafanaClient, vmClient)
+			s := NewManagementService(db, ar, state, cc, sib, vmdb, vc, grafanaClient, vmClient, nil)
 
 			teardown := func(t *testing.T) {
 				t.Helper()
@@ -549,7 +586,7 @@ func TestNodeService(t *testing.T) {
 			vmClient := &mockVictoriaMetricsClient{}
 			vmClient.Test(t)
 
-			s := NewManagementService(db, ar, state, cc, sib, vmdb, vc, grafanaClient, vmClient)
+			s := NewManagementService(db, ar, state, cc, sib, vmdb, vc, grafanaClient, vmClient, nil)
 
 			teardown := func(t *testing.T) {
 				t.Helper()
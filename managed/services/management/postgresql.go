@@ -165,6 +165,33 @@ func (s *ManagementService) addPostgreSQL(ctx context.Context, req *managementv1
 			postgres.QanPostgresqlPgstatmonitorAgent = agent.(*inventoryv1.QANPostgreSQLPgStatMonitorAgent) //nolint:forcetypeassert
 		}
 
+		if req.QanPostgresqlLogAgent {
+			row, err = models.CreateAgent(tx.Querier, models.QANPostgreSQLLogAgentType, &models.CreateAgentParams{
+				PMMAgentID:    req.PmmAgentId,
+				ServiceID:     service.ServiceID,
+				Username:      req.Username,
+				Password:      req.Password,
+				TLS:           req.Tls,
+				TLSSkipVerify: req.TlsSkipVerify,
+				QANOptions: models.QANOptions{
+					MaxQueryLength:          req.MaxQueryLength,
+					QueryExamplesDisabled:   req.DisableQueryExamples,
+					CommentsParsingDisabled: req.DisableCommentsParsing,
+				},
+				PostgreSQLOptions: models.PostgreSQLOptionsFromRequest(req),
+				LogLevel:          services.SpecifyLogLevel(req.LogLevel, inventoryv1.LogLevel_LOG_LEVEL_FATAL),
+			})
+			if err != nil {
+				return err
+			}
+
+			agent, err = services.ToAPIAgent(tx.Querier, row)
+			if err != nil {
+				return err
+			}
+			postgres.QanPostgresqlLogAgent = agent.(*inventoryv1.QANPostgreSQLLogAgent) //nolint:forcetypeassert
+		}
+
 		return nil
 	})
 
@@ -28,7 +28,7 @@ import (
 	"github.com/percona/pmm/managed/utils/duration"
 )
 
-// AddProxySQL adds "ProxySQL Service", "ProxySQL Exporter Agent" and "QAN ProxySQL PerfSchema Agent".
+// AddProxySQL adds "ProxySQL Service", "ProxySQL Exporter Agent" and, optionally, "QAN ProxySQL Digest Agent".
 func (s *ManagementService) addProxySQL(ctx context.Context, req *managementv1.AddProxySQLServiceParams) (*managementv1.AddServiceResponse, error) {
 	proxysql := &managementv1.ProxySQLServiceResult{}
 
@@ -101,6 +101,30 @@ func (s *ManagementService) addProxySQL(ctx context.Context, req *managementv1.A
 		}
 		proxysql.ProxysqlExporter = agent.(*inventoryv1.ProxySQLExporter) //nolint:forcetypeassert
 
+		if req.QanProxysqlDigest {
+			row, err = models.CreateAgent(tx.Querier, models.QANProxySQLDigestAgentType, &models.CreateAgentParams{
+				PMMAgentID:    req.PmmAgentId,
+				ServiceID:     service.ServiceID,
+				Username:      req.Username,
+				Password:      req.Password,
+				TLS:           req.Tls,
+				TLSSkipVerify: req.TlsSkipVerify,
+				QANOptions: models.QANOptions{
+					MaxQueryLength: req.MaxQueryLength,
+				},
+				LogLevel: services.SpecifyLogLevel(req.LogLevel, inventoryv1.LogLevel_LOG_LEVEL_FATAL),
+			})
+			if err != nil {
+				return err
+			}
+
+			agent, err = services.ToAPIAgent(tx.Querier, row)
+			if err != nil {
+				return err
+			}
+			proxysql.QanProxysqlDigest = agent.(*inventoryv1.QANProxySQLDigestAgent) //nolint:forcetypeassert
+		}
+
 		return nil
 	})
 
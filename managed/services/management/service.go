@@ -48,6 +48,7 @@ type ManagementService struct { //nolint:revive
 	vc            versionCache
 	grafanaClient grafanaClient
 	vmClient      victoriaMetricsClient
+	ca            certificateAuthority
 	l             *logrus.Entry
 }
 
@@ -80,6 +81,7 @@ func NewManagementService(
 	vc versionCache,
 	grafanaClient grafanaClient,
 	vmClient victoriaMetricsClient,
+	ca certificateAuthority,
 ) *ManagementService {
 	return &ManagementService{
 		db:            db,
@@ -91,6 +93,7 @@ func NewManagementService(
 		vc:            vc,
 		grafanaClient: grafanaClient,
 		vmClient:      vmClient,
+		ca:            ca,
 		l:             logrus.WithField("service", "management"),
 	}
 }
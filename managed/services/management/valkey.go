@@ -28,7 +28,7 @@ import (
 	"github.com/percona/pmm/managed/utils/duration"
 )
 
-// addValkey adds a new Valkey service and an accompanying "Valkey Exporter agent".
+// addValkey adds a new Valkey service and an accompanying "Valkey Exporter agent" and, optionally, "QAN Valkey Slowlog agent".
 func (s *ManagementService) addValkey(ctx context.Context, req *managementv1.AddValkeyServiceParams) (*managementv1.AddServiceResponse, error) {
 	valkey := &managementv1.ValkeyServiceResult{}
 
@@ -98,6 +98,33 @@ func (s *ManagementService) addValkey(ctx context.Context, req *managementv1.Add
 			return err
 		}
 		valkey.ValkeyExporter = agent.(*inventoryv1.ValkeyExporter) //nolint:forcetypeassert
+
+		if req.QanValkeySlowlog {
+			row, err = models.CreateAgent(tx.Querier, models.QANValkeySlowlogAgentType, &models.CreateAgentParams{
+				PMMAgentID:    req.PmmAgentId,
+				ServiceID:     service.ServiceID,
+				Username:      req.Username,
+				Password:      req.Password,
+				TLS:           req.Tls,
+				TLSSkipVerify: req.TlsSkipVerify,
+				ValkeyOptions: models.ValkeyOptionsFromRequest(req),
+				QANOptions: models.QANOptions{
+					MaxQueryLength:        req.MaxQueryLength,
+					QueryExamplesDisabled: req.DisableQueryExamples,
+				},
+				LogLevel: services.SpecifyLogLevel(req.LogLevel, inventoryv1.LogLevel_LOG_LEVEL_FATAL),
+			})
+			if err != nil {
+				return err
+			}
+
+			agent, err = services.ToAPIAgent(tx.Querier, row)
+			if err != nil {
+				return err
+			}
+			valkey.QanValkeySlowlog = agent.(*inventoryv1.QANValkeySlowlogAgent) //nolint:forcetypeassert
+		}
+
 		return nil
 	})
 	if errTx != nil {
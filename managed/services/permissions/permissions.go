@@ -0,0 +1,404 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// Package permissions enforces permission scopes granted by access control roles.
+package permissions
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/prometheus/prometheus/promql/parser"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm/managed/models"
+	"github.com/percona/pmm/managed/services/audit"
+)
+
+var promParser = parser.NewParser(parser.Options{})
+
+// rule describes the permission scope required by a gRPC method.
+type rule struct {
+	permission models.Permission
+	// allIfEmpty is true if the request without services applies to all services.
+	allIfEmpty bool
+	// newService is true if the request adds a service, so its labels are taken from the request.
+	newService bool
+}
+
+// methods maps full gRPC method names to permission scopes.
+var methods = map[string]rule{
+	"/backup.v1.BackupService/StartBackup":                        {permission: models.PermissionBackupsCreate},
+	"/backup.v1.BackupService/ScheduleBackup":                     {permission: models.PermissionBackupsCreate},
+	"/backup.v1.RestoreService/RestoreBackup":                     {permission: models.PermissionBackupsRestore},
+	"/inventory.v1.ServicesService/AddService":                    {permission: models.PermissionServicesAdd, newService: true},
+	"/management.v1.ManagementService/AddService":                 {permission: models.PermissionServicesAdd, newService: true},
+	"/inventory.v1.ServicesService/RemoveService":                 {permission: models.PermissionServicesRemove},
+	"/management.v1.ManagementService/RemoveService":              {permission: models.PermissionServicesRemove},
+	"/realtimeanalytics.v1.RealtimeAnalyticsService/StartSession": {permission: models.PermissionRTAStart},
+	"/realtimeanalytics.v1.RealtimeAnalyticsService/KillQuery":    {permission: models.PermissionRTAKill},
+	"/dump.v1beta1.DumpService/StartDump":                         {permission: models.PermissionDumpsCreate, allIfEmpty: true},
+}
+
+// paths maps "METHOD path" of JSON API endpoints to permission scopes.
+// Paths ending with a slash match a single trailing path segment, such as an ID.
+var paths = map[string]models.Permission{
+	"POST /v1/backups:start":                    models.PermissionBackupsCreate,
+	"POST /v1/backups:schedule":                 models.PermissionBackupsCreate,
+	"POST /v1/backups/restores:start":           models.PermissionBackupsRestore,
+	"POST /v1/inventory/services":               models.PermissionServicesAdd,
+	"POST /v1/management/services":              models.PermissionServicesAdd,
+	"DELETE /v1/inventory/services/":            models.PermissionServicesRemove,
+	"DELETE /v1/management/services/":           models.PermissionServicesRemove,
+	"POST /v1/realtimeanalytics/sessions:start": models.PermissionRTAStart,
+	"POST /v1/realtimeanalytics/queries:kill":   models.PermissionRTAKill,
+	"POST /v1/dumps:start":                      models.PermissionDumpsCreate,
+}
+
+// ForRequest returns the permission scope that allows the HTTP request to JSON or gRPC API
+// regardless of the Grafana role of the user.
+func ForRequest(method, path string) (models.Permission, bool) {
+	if r, ok := methods[path]; ok && method == "POST" {
+		return r.permission, true
+	}
+
+	if p, ok := paths[method+" "+path]; ok {
+		return p, true
+	}
+
+	if i := strings.LastIndex(path, "/"); i > 0 {
+		if p, ok := paths[method+" "+path[:i+1]]; ok {
+			return p, true
+		}
+	}
+
+	return "", false
+}
+
+// Service checks permission scopes of users that are not Grafana admins.
+type Service struct {
+	db *reform.DB
+	l  *logrus.Entry
+}
+
+// New creates a new permissions service.
+func New(db *reform.DB) *Service {
+	return &Service{
+		db: db,
+		l:  logrus.WithField("component", "permissions"),
+	}
+}
+
+// UnaryInterceptor returns a new unary server interceptor that checks permission scopes
+// and label filters of access control roles.
+func (s *Service) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		r, ok := methods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		if err := s.check(ctx, r, req); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// check returns PermissionDenied error if the caller can't make the request.
+func (s *Service) check(ctx context.Context, r rule, req any) error {
+	user := audit.UserFromContext(ctx)
+
+	// internal callers and admins are not limited by permission scopes;
+	// other Grafana roles are checked by the authentication server
+	switch user.Role {
+	case "", "Admin", "GrafanaAdmin":
+		return nil
+	}
+
+	q := s.db.WithContext(ctx)
+	settings, err := models.GetSettings(q)
+	if err != nil {
+		return err
+	}
+	if !settings.IsAccessControlEnabled() {
+		return nil
+	}
+
+	roles, err := models.GetUserRolesWithPermission(q, int(user.ID), r.permission)
+	if err != nil {
+		return err
+	}
+	if len(roles) == 0 {
+		return status.Errorf(codes.PermissionDenied, "Permission %s is required.", r.permission)
+	}
+
+	selectors := make([][]*labels.Matcher, 0, len(roles))
+	for _, role := range roles {
+		if role.Filter == "" {
+			// role without filter grants the permission for all services
+			return nil
+		}
+
+		m, err := promParser.ParseMetricSelector(role.Filter)
+		if err != nil {
+			s.l.Warnf("Failed to parse filter %q of role %q: %s.", role.Filter, role.Title, err)
+			continue
+		}
+		selectors = append(selectors, m)
+	}
+
+	m, _ := req.(proto.Message)
+	if r.newService && m != nil {
+		l, err := newServiceLabels(q, m)
+		if err != nil {
+			return err
+		}
+		if !matchesLabels(l, selectors) {
+			return status.Errorf(codes.PermissionDenied, "Permission %s is not granted for service %q.", r.permission, l["service_name"])
+		}
+	}
+
+	services, err := findServices(q, m, r.allIfEmpty)
+	if err != nil {
+		return err
+	}
+
+	for _, service := range services {
+		ok, err := matches(q, service, selectors)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return status.Errorf(codes.PermissionDenied, "Permission %s is not granted for service %q.", r.permission, service.ServiceName)
+		}
+	}
+
+	return nil
+}
+
+// findServices returns services referenced by service_id, service_ids, service_name and service_names fields
+// of the request, and source services of artifacts referenced by artifact_id field,
+// or all services if there are none and allIfEmpty is true.
+// Services and artifacts that are not found are skipped: the request handler returns a proper error for them.
+func findServices(q *reform.Querier, m proto.Message, allIfEmpty bool) ([]*models.Service, error) {
+	var ids, names, artifactIDs []string
+	if m != nil {
+		m.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+			if fd.Kind() != protoreflect.StringKind {
+				return true
+			}
+
+			var values []string
+			if fd.IsList() {
+				for i := range v.List().Len() {
+					if s := v.List().Get(i).String(); s != "" {
+						values = append(values, s)
+					}
+				}
+			} else {
+				values = []string{v.String()}
+			}
+
+			switch fd.Name() {
+			case "service_id", "service_ids":
+				ids = append(ids, values...)
+			case "service_name", "service_names":
+				names = append(names, values...)
+			case "artifact_id":
+				artifactIDs = append(artifactIDs, values...)
+			}
+			return true
+		})
+	}
+
+	for _, id := range artifactIDs {
+		artifact, err := models.FindArtifactByID(q, id)
+		if err = skipNotFound(err); err != nil {
+			return nil, err
+		}
+		if artifact != nil && artifact.ServiceID != "" {
+			ids = append(ids, artifact.ServiceID)
+		}
+	}
+
+	if len(ids) == 0 && len(names) == 0 {
+		if allIfEmpty {
+			return models.FindServices(q, models.ServiceFilters{})
+		}
+		return nil, nil
+	}
+
+	res := make([]*models.Service, 0, len(ids)+len(names))
+	for _, id := range ids {
+		service, err := models.FindServiceByID(q, id)
+		if status.Code(err) == codes.NotFound {
+			// some APIs accept either service ID or service name in the same field
+			service, err = models.FindServiceByName(q, id)
+		}
+		if err = skipNotFound(err); err != nil {
+			return nil, err
+		}
+		if service != nil {
+			res = append(res, service)
+		}
+	}
+
+	for _, name := range names {
+		service, err := models.FindServiceByName(q, name)
+		if err = skipNotFound(err); err != nil {
+			return nil, err
+		}
+		if service != nil {
+			res = append(res, service)
+		}
+	}
+
+	return res, nil
+}
+
+// newServiceLabels returns labels of the service added by the request, as they would be returned by models.MergeLabels:
+// labels of the existing or added node, overridden by the service type, name, environment, cluster,
+// replication set and custom labels from the request.
+func newServiceLabels(q *reform.Querier, m proto.Message) (map[string]string, error) {
+	res := make(map[string]string)
+
+	// the request has a single field with service parameters named after the service type
+	var serviceType string
+	var params protoreflect.Message
+	m.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Kind() != protoreflect.MessageKind {
+			return true
+		}
+		serviceType = string(fd.Name())
+		params = v.Message()
+		return false
+	})
+	if params == nil {
+		return res, nil
+	}
+
+	fields := params.Descriptor().Fields()
+	getString := func(name protoreflect.Name) string {
+		if fd := fields.ByName(name); fd != nil && fd.Kind() == protoreflect.StringKind && !fd.IsList() {
+			return params.Get(fd).String()
+		}
+		return ""
+	}
+
+	var node *models.Node
+	var err error
+	switch nodeID, nodeName := getString("node_id"), getString("node_name"); {
+	case nodeID != "":
+		node, err = models.FindNodeByID(q, nodeID)
+	case nodeName != "":
+		node, err = models.FindNodeByName(q, nodeName)
+	}
+	if err = skipNotFound(err); err != nil {
+		return nil, err
+	}
+	if node != nil {
+		if res, err = models.MergeLabels(node, nil, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	if fd := fields.ByName("add_node"); fd != nil && fd.Kind() == protoreflect.MessageKind && params.Has(fd) {
+		copyLabels(res, params.Get(fd).Message(), "node_name", "machine_id", "container_id", "container_name", "node_model", "region", "az")
+	}
+
+	// RDS instances are added with a new node described by the same parameters
+	if fd := fields.ByName("engine"); fd != nil && fd.Kind() == protoreflect.EnumKind {
+		if ev := fd.Enum().Values().ByNumber(params.Get(fd).Enum()); ev != nil {
+			serviceType = strings.ToLower(strings.TrimPrefix(string(ev.Name()), "DISCOVER_RDS_ENGINE_"))
+		}
+	}
+	res["service_type"] = serviceType
+
+	copyLabels(res, params, "node_name", "node_model", "region", "az",
+		"service_name", "environment", "cluster", "replication_set", "external_group")
+
+	return res, nil
+}
+
+// copyLabels copies non-empty values of given string fields and custom_labels map field of the message to l.
+func copyLabels(l map[string]string, m protoreflect.Message, names ...protoreflect.Name) {
+	fields := m.Descriptor().Fields()
+	for _, name := range names {
+		fd := fields.ByName(name)
+		if fd == nil || fd.Kind() != protoreflect.StringKind || fd.IsList() {
+			continue
+		}
+		if v := m.Get(fd).String(); v != "" {
+			l[string(name)] = v
+		}
+	}
+
+	if fd := fields.ByName("custom_labels"); fd != nil && fd.IsMap() {
+		m.Get(fd).Map().Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+			l[k.String()] = v.String()
+			return true
+		})
+	}
+}
+
+func skipNotFound(err error) error {
+	if status.Code(err) == codes.NotFound || errors.Is(err, models.ErrNotFound) {
+		return nil
+	}
+	return err
+}
+
+// matches returns true if labels of the service and its node match at least one of selectors.
+func matches(q *reform.Querier, service *models.Service, selectors [][]*labels.Matcher) (bool, error) {
+	node, err := models.FindNodeByID(q, service.NodeID)
+	if err != nil {
+		return false, err
+	}
+
+	serviceLabels, err := models.MergeLabels(node, service, nil)
+	if err != nil {
+		return false, err
+	}
+
+	return matchesLabels(serviceLabels, selectors), nil
+}
+
+// matchesLabels returns true if labels match all matchers of at least one of selectors.
+func matchesLabels(l map[string]string, selectors [][]*labels.Matcher) bool {
+	for _, matchers := range selectors {
+		ok := true
+		for _, m := range matchers {
+			if !m.Matches(l[m.Name]) {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+
+	return false
+}
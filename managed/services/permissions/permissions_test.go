@@ -0,0 +1,337 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package permissions
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/lib/pq"
+	"github.com/prometheus/prometheus/model/labels"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"gopkg.in/reform.v1"
+	"gopkg.in/reform.v1/dialects/postgresql"
+
+	backupv1 "github.com/percona/pmm/api/backup/v1"
+	dumpv1beta1 "github.com/percona/pmm/api/dump/v1beta1"
+	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
+	managementv1 "github.com/percona/pmm/api/management/v1"
+	rtav1 "github.com/percona/pmm/api/realtimeanalytics/v1"
+	"github.com/percona/pmm/managed/models"
+	"github.com/percona/pmm/managed/services/audit"
+	"github.com/percona/pmm/managed/utils/testdb"
+)
+
+func TestMethods(t *testing.T) {
+	t.Parallel()
+
+	// make sure descriptors are registered
+	_ = backupv1.File_backup_v1_backup_proto
+	_ = backupv1.File_backup_v1_restores_proto
+	_ = dumpv1beta1.File_dump_v1beta1_dump_proto
+	_ = inventoryv1.File_inventory_v1_services_proto
+	_ = managementv1.File_management_v1_service_proto
+	_ = rtav1.File_realtimeanalytics_v1_realtimeanalytics_proto
+
+	for fullMethod := range methods {
+		service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/")
+		require.True(t, ok, fullMethod)
+
+		d, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+		require.NoError(t, err, fullMethod)
+		sd, ok := d.(protoreflect.ServiceDescriptor)
+		require.True(t, ok, fullMethod)
+		assert.NotNil(t, sd.Methods().ByName(protoreflect.Name(method)), fullMethod)
+	}
+}
+
+func TestForRequest(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		method     string
+		path       string
+		permission models.Permission
+	}{
+		{http.MethodPost, "/v1/backups/restores:start", models.PermissionBackupsRestore},
+		{http.MethodPost, "/v1/backups:start", models.PermissionBackupsCreate},
+		{http.MethodDelete, "/v1/inventory/services/service1", models.PermissionServicesRemove},
+		{http.MethodPost, "/v1/dumps:start", models.PermissionDumpsCreate},
+		{http.MethodPost, "/v1/realtimeanalytics/queries:kill", models.PermissionRTAKill},
+		{http.MethodPost, "/backup.v1.RestoreService/RestoreBackup", models.PermissionBackupsRestore},
+		{http.MethodPost, "/v1/inventory/services:getTypes", ""},
+		{http.MethodGet, "/v1/inventory/services/service1", ""},
+		{http.MethodDelete, "/v1/inventory/services/service1/agents", ""},
+		{http.MethodGet, "/v1/backups/restores", ""},
+		{http.MethodPost, "/backup.v1.RestoreService/ListRestores", ""},
+	} {
+		p, ok := ForRequest(tc.method, tc.path)
+		assert.Equal(t, tc.permission != "", ok, "%s %s", tc.method, tc.path)
+		assert.Equal(t, tc.permission, p, "%s %s", tc.method, tc.path)
+	}
+}
+
+func TestMatchesLabels(t *testing.T) {
+	t.Parallel()
+
+	parse := func(selector string) [][]*labels.Matcher {
+		m, err := promParser.ParseMetricSelector(selector)
+		require.NoError(t, err)
+		return [][]*labels.Matcher{m}
+	}
+
+	l := map[string]string{"environment": "prod", "cluster": "dba-team1"}
+	assert.True(t, matchesLabels(l, parse(`{environment="prod"}`)))
+	assert.True(t, matchesLabels(l, parse(`{environment="prod", cluster=~"dba-.*"}`)))
+	assert.False(t, matchesLabels(l, parse(`{environment="prod", cluster="dba-team2"}`)))
+	assert.False(t, matchesLabels(l, parse(`{region="eu"}`)))
+	assert.True(t, matchesLabels(l, append(parse(`{region="eu"}`), parse(`{cluster="dba-team1"}`)...)))
+	assert.False(t, matchesLabels(l, nil))
+}
+
+func TestNewServiceLabels(t *testing.T) {
+	t.Parallel()
+
+	t.Run("AddNode", func(t *testing.T) {
+		t.Parallel()
+
+		req := &managementv1.AddServiceRequest{
+			Service: &managementv1.AddServiceRequest_Mysql{
+				Mysql: &managementv1.AddMySQLServiceParams{
+					AddNode: &managementv1.AddNodeParams{
+						NodeName:     "node1",
+						Region:       "eu",
+						CustomLabels: map[string]string{"team": "dba", "environment": "node"},
+					},
+					ServiceName:  "mysql1",
+					Environment:  "prod",
+					CustomLabels: map[string]string{"team": "app"},
+				},
+			},
+		}
+		l, err := newServiceLabels(nil, req)
+		require.NoError(t, err)
+		expected := map[string]string{
+			"node_name":    "node1",
+			"region":       "eu",
+			"service_type": "mysql",
+			"service_name": "mysql1",
+			"environment":  "prod",
+			"team":         "app",
+		}
+		assert.Equal(t, expected, l)
+	})
+
+	t.Run("RDS", func(t *testing.T) {
+		t.Parallel()
+
+		req := &managementv1.AddServiceRequest{
+			Service: &managementv1.AddServiceRequest_Rds{
+				Rds: &managementv1.AddRDSServiceParams{
+					Region:      "us-east-1",
+					Az:          "us-east-1a",
+					Engine:      managementv1.DiscoverRDSEngine_DISCOVER_RDS_ENGINE_POSTGRESQL,
+					ServiceName: "rds1",
+					Cluster:     "dba-team1",
+				},
+			},
+		}
+		l, err := newServiceLabels(nil, req)
+		require.NoError(t, err)
+		expected := map[string]string{
+			"region":       "us-east-1",
+			"az":           "us-east-1a",
+			"service_type": "postgresql",
+			"service_name": "rds1",
+			"cluster":      "dba-team1",
+		}
+		assert.Equal(t, expected, l)
+	})
+}
+
+func TestCheckAdmins(t *testing.T) {
+	t.Parallel()
+
+	// admins and internal callers are allowed without database queries
+	s := New(nil)
+	r := methods["/backup.v1.RestoreService/RestoreBackup"]
+	req := &backupv1.RestoreBackupRequest{ServiceId: "service1", ArtifactId: "artifact1"}
+
+	assert.NoError(t, s.check(context.Background(), r, req))
+
+	for _, role := range []string{"Admin", "GrafanaAdmin"} {
+		v, err := audit.EncodeUser(&audit.User{ID: 2, Login: "admin", Role: role})
+		require.NoError(t, err)
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(audit.UserHeaderName, v))
+		assert.NoError(t, s.check(ctx, r, req), role)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	sqlDB := testdb.Open(t, models.SkipFixtures, nil)
+	db := reform.NewDB(sqlDB, postgresql.Dialect, reform.NewPrintfLogger(t.Logf))
+
+	_, err := models.UpdateSettings(db.Querier, &models.ChangeSettingsParams{EnableAccessControl: new(true)})
+	require.NoError(t, err)
+
+	node, err := models.CreateNode(db.Querier, models.GenericNodeType, &models.CreateNodeParams{
+		NodeName: "test-node",
+	})
+	require.NoError(t, err)
+
+	prod, err := models.AddNewService(db.Querier, models.MySQLServiceType, &models.AddDBMSServiceParams{
+		ServiceName: "mysql-prod",
+		NodeID:      node.NodeID,
+		Environment: "prod",
+		Address:     new("127.0.0.1"),
+		Port:        new(uint16(3306)),
+	})
+	require.NoError(t, err)
+
+	dev, err := models.AddNewService(db.Querier, models.MySQLServiceType, &models.AddDBMSServiceParams{
+		ServiceName: "mysql-dev",
+		NodeID:      node.NodeID,
+		Environment: "dev",
+		Address:     new("127.0.0.2"),
+		Port:        new(uint16(3306)),
+	})
+	require.NoError(t, err)
+
+	role := &models.Role{
+		Title:  "DBA team",
+		Filter: `{environment="prod"}`,
+		Permissions: pq.StringArray{
+			string(models.PermissionRTAKill),
+			string(models.PermissionServicesAdd),
+			string(models.PermissionBackupsRestore),
+		},
+	}
+	require.NoError(t, models.CreateRole(db.Querier, role))
+
+	// user 2 has the role, user 3 has none
+	err = db.InTransaction(func(tx *reform.TX) error {
+		return models.AssignRoles(tx, 2, []int{int(role.ID)})
+	})
+	require.NoError(t, err)
+
+	userContext := func(userID int64) context.Context {
+		v, err := audit.EncodeUser(&audit.User{ID: userID, Login: "user", Role: "Viewer"})
+		require.NoError(t, err)
+		return metadata.NewIncomingContext(t.Context(), metadata.Pairs(audit.UserHeaderName, v))
+	}
+
+	s := New(db)
+	r := methods["/realtimeanalytics.v1.RealtimeAnalyticsService/KillQuery"]
+
+	t.Run("KillQueryAllowed", func(t *testing.T) {
+		req := &rtav1.KillQueryRequest{ServiceId: prod.ServiceID, QueryId: "81-1234"}
+		assert.NoError(t, s.check(userContext(2), r, req))
+	})
+
+	t.Run("KillQueryDeniedByFilter", func(t *testing.T) {
+		req := &rtav1.KillQueryRequest{ServiceId: dev.ServiceID, QueryId: "81-1234"}
+		err := s.check(userContext(2), r, req)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("KillQueryDeniedWithoutPermission", func(t *testing.T) {
+		req := &rtav1.KillQueryRequest{ServiceId: prod.ServiceID, QueryId: "81-1234"}
+		err := s.check(userContext(3), r, req)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+	t.Run("AddServiceAllowed", func(t *testing.T) {
+		r := methods["/inventory.v1.ServicesService/AddService"]
+		req := &inventoryv1.AddServiceRequest{
+			Service: &inventoryv1.AddServiceRequest_Mysql{
+				Mysql: &inventoryv1.AddMySQLServiceParams{ServiceName: "mysql-new", NodeId: node.NodeID, Environment: "prod"},
+			},
+		}
+		assert.NoError(t, s.check(userContext(2), r, req))
+	})
+
+	t.Run("AddServiceDeniedByFilter", func(t *testing.T) {
+		r := methods["/inventory.v1.ServicesService/AddService"]
+		req := &inventoryv1.AddServiceRequest{
+			Service: &inventoryv1.AddServiceRequest_Mysql{
+				Mysql: &inventoryv1.AddMySQLServiceParams{ServiceName: "mysql-new", NodeId: node.NodeID, Environment: "dev"},
+			},
+		}
+		err := s.check(userContext(2), r, req)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("AddServiceDeniedByCustomLabels", func(t *testing.T) {
+		// custom labels override the environment, like in models.Service.UnifiedLabels
+		r := methods["/management.v1.ManagementService/AddService"]
+		req := &managementv1.AddServiceRequest{
+			Service: &managementv1.AddServiceRequest_Postgresql{
+				Postgresql: &managementv1.AddPostgreSQLServiceParams{
+					ServiceName:  "postgresql-new",
+					NodeName:     node.NodeName,
+					Environment:  "prod",
+					CustomLabels: map[string]string{"environment": "dev"},
+				},
+			},
+		}
+		err := s.check(userContext(2), r, req)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	location, err := models.CreateBackupLocation(db.Querier, models.CreateBackupLocationParams{
+		Name: "test-location",
+		BackupLocationConfig: models.BackupLocationConfig{
+			FilesystemConfig: &models.FilesystemLocationConfig{Path: "/opt/data"},
+		},
+	})
+	require.NoError(t, err)
+
+	createArtifact := func(name, serviceID string) *models.Artifact {
+		artifact, err := models.CreateArtifact(db.Querier, models.CreateArtifactParams{
+			Name:       name,
+			Vendor:     "mysql",
+			LocationID: location.ID,
+			ServiceID:  serviceID,
+			DataModel:  models.PhysicalDataModel,
+			Mode:       models.Snapshot,
+			Status:     models.SuccessBackupStatus,
+		})
+		require.NoError(t, err)
+		return artifact
+	}
+	prodArtifact := createArtifact("prod-backup", prod.ServiceID)
+	devArtifact := createArtifact("dev-backup", dev.ServiceID)
+
+	t.Run("RestoreBackupAllowed", func(t *testing.T) {
+		r := methods["/backup.v1.RestoreService/RestoreBackup"]
+		req := &backupv1.RestoreBackupRequest{ServiceId: prod.ServiceID, ArtifactId: prodArtifact.ID}
+		assert.NoError(t, s.check(userContext(2), r, req))
+	})
+
+	t.Run("RestoreBackupDeniedByArtifactService", func(t *testing.T) {
+		r := methods["/backup.v1.RestoreService/RestoreBackup"]
+		req := &backupv1.RestoreBackupRequest{ServiceId: prod.ServiceID, ArtifactId: devArtifact.ID}
+		err := s.check(userContext(2), r, req)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+}
@@ -0,0 +1,251 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// Package planhistory collects plans of top queries periodically to track plan changes.
+package planhistory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"gopkg.in/reform.v1"
+
+	agentv1 "github.com/percona/pmm/api/agent/v1"
+	"github.com/percona/pmm/managed/models"
+	"github.com/percona/pmm/managed/services"
+)
+
+// topQueriesSQL returns the heaviest queries of each MySQL and MongoDB service by total query time.
+const topQueriesSQL = `SELECT service_id, queryid
+	FROM metrics
+	WHERE period_start >= ? AND service_type IN ('mysql', 'mongodb') AND queryid != ''
+	GROUP BY service_id, queryid
+	ORDER BY sum(m_query_time_sum) DESC
+	LIMIT ? BY service_id`
+
+const insertPlanSQL = `INSERT INTO query_plans
+	(service_id, service_name, service_type, queryid, plan_id, plan, collected_at)
+	VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+// errNoExample is returned for queries that can't be explained without query examples.
+var errNoExample = errors.New("query example is not available")
+
+// topQuery is a query class selected for plan collection.
+type topQuery struct {
+	serviceID string
+	queryID   string
+}
+
+// queryPlan is a normalized plan of the query.
+type queryPlan struct {
+	queryID     string
+	planID      string
+	plan        string
+	collectedAt time.Time
+}
+
+// Collector periodically runs explain actions for top queries of MySQL and MongoDB services
+// and stores normalized plans into ClickHouse.
+type Collector struct {
+	db           *reform.DB
+	clickhouseDB *sql.DB
+	actions      actionsService
+	qanClient    qanClient
+	interval     time.Duration
+	topQueries   int
+	l            *logrus.Entry
+}
+
+// NewCollector creates a new plan history collector.
+// It collects plans of topQueries heaviest queries of each service every interval.
+func NewCollector(
+	db *reform.DB,
+	clickhouseDB *sql.DB,
+	actions actionsService,
+	qanClient qanClient,
+	interval time.Duration,
+	topQueries int,
+) *Collector {
+	return &Collector{
+		db:           db,
+		clickhouseDB: clickhouseDB,
+		actions:      actions,
+		qanClient:    qanClient,
+		interval:     interval,
+		topQueries:   topQueries,
+		l:            logrus.WithField("component", "plan-history"),
+	}
+}
+
+// Run collects plans every interval until context is canceled.
+func (c *Collector) Run(ctx context.Context) {
+	c.l.Info("Starting...")
+	defer c.l.Info("Done.")
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		c.collect(ctx)
+	}
+}
+
+// collect collects plans of queries that were the heaviest during the last interval.
+func (c *Collector) collect(ctx context.Context) {
+	start := time.Now()
+	queries, err := c.findTopQueries(ctx, start.Add(-c.interval))
+	if err != nil {
+		c.l.Errorf("Failed to find top queries: %s.", err)
+		return
+	}
+
+	byService := make(map[string][]string)
+	for _, q := range queries {
+		byService[q.serviceID] = append(byService[q.serviceID], q.queryID)
+	}
+
+	var collected int
+	for _, serviceID := range slices.Sorted(maps.Keys(byService)) {
+		if ctx.Err() != nil {
+			return
+		}
+
+		n, err := c.collectService(ctx, serviceID, byService[serviceID])
+		if err != nil {
+			c.l.Warnf("Failed to collect plans of service %s: %s.", serviceID, err)
+		}
+		collected += n
+	}
+
+	c.l.Infof("Collected %d plans of %d queries in %s.", collected, len(queries), time.Since(start))
+}
+
+// findTopQueries returns top queries of each service since the given time.
+func (c *Collector) findTopQueries(ctx context.Context, since time.Time) ([]topQuery, error) {
+	rows, err := c.clickhouseDB.QueryContext(ctx, topQueriesSQL, since, c.topQueries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close() //nolint:errcheck
+
+	var res []topQuery
+	for rows.Next() {
+		var q topQuery
+		if err = rows.Scan(&q.serviceID, &q.queryID); err != nil {
+			return nil, err
+		}
+		res = append(res, q)
+	}
+	return res, rows.Err()
+}
+
+// collectService collects and stores plans of the given queries of the service.
+// It returns the number of stored plans.
+func (c *Collector) collectService(ctx context.Context, serviceID string, queryIDs []string) (int, error) {
+	// explain action for MongoDB must be executed against the admin database
+	target, err := services.FindActionTarget(c.db, serviceID, map[models.ServiceType]string{
+		models.MySQLServiceType:   "",
+		models.MongoDBServiceType: "admin",
+	})
+	if err != nil {
+		return 0, err
+	}
+	if target == nil {
+		return 0, nil
+	}
+
+	plans := make([]*queryPlan, 0, len(queryIDs))
+	for _, queryID := range queryIDs {
+		output, err := c.explain(ctx, target, queryID)
+		if err != nil {
+			c.l.Debugf("Failed to explain query %s of service %s: %s.", queryID, serviceID, err)
+			continue
+		}
+
+		plan, err := normalizePlan(target.ServiceType, output)
+		if err != nil {
+			c.l.Debugf("Failed to normalize plan of query %s of service %s: %s.", queryID, serviceID, err)
+			continue
+		}
+
+		plans = append(plans, &queryPlan{
+			queryID:     queryID,
+			planID:      planID(plan),
+			plan:        plan,
+			collectedAt: time.Now().UTC().Truncate(time.Millisecond),
+		})
+	}
+
+	if err = c.insert(ctx, target, plans); err != nil {
+		return 0, err
+	}
+	return len(plans), nil
+}
+
+// explain runs explain action for the query and returns its output.
+func (c *Collector) explain(ctx context.Context, target *services.Target, queryID string) ([]byte, error) {
+	var explainFingerprint string
+	switch target.ServiceType {
+	case models.MySQLServiceType:
+	case models.MongoDBServiceType:
+		res, err := c.qanClient.ExplainFingerprintByQueryID(ctx, target.ServiceID, queryID)
+		if err != nil {
+			return nil, err
+		}
+		if res.PlaceholdersCount != 0 || res.ExplainFingerprint == "" {
+			return nil, errNoExample
+		}
+		explainFingerprint = res.ExplainFingerprint
+	default:
+		return nil, fmt.Errorf("unsupported service type %s", target.ServiceType)
+	}
+
+	return services.RunAction(ctx, c.db, c.l, target.AgentID, func(id string) error {
+		if target.ServiceType == models.MySQLServiceType {
+			// queries without examples have placeholders, so they are rejected by the action service
+			return c.actions.StartMySQLExplainAction(ctx, id, target.AgentID, target.ServiceID, target.DSN, queryID, nil,
+				agentv1.MysqlExplainOutputFormat_MYSQL_EXPLAIN_OUTPUT_FORMAT_JSON, target.Files, target.TDP, target.TLSSkipVerify, target.Secrets)
+		}
+		return c.actions.StartMongoDBExplainAction(ctx, id, target.AgentID, target.DSN, explainFingerprint, target.Files, target.TDP, target.Secrets)
+	})
+}
+
+// insert stores plans of the service into ClickHouse.
+func (c *Collector) insert(ctx context.Context, target *services.Target, plans []*queryPlan) error {
+	rows := make([][]any, 0, len(plans))
+	for _, p := range plans {
+		rows = append(rows, []any{
+			target.ServiceID, target.ServiceName, string(target.ServiceType),
+			p.queryID, p.planID, p.plan, p.collectedAt,
+		})
+	}
+
+	if err := services.InsertIntoClickHouse(ctx, c.clickhouseDB, insertPlanSQL, rows); err != nil {
+		return fmt.Errorf("failed to insert plans: %w", err)
+	}
+	return nil
+}
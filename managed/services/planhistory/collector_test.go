@@ -0,0 +1,89 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package planhistory
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm/managed/models"
+	"github.com/percona/pmm/managed/services"
+)
+
+func setupCollector(t *testing.T) (*Collector, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	return NewCollector(nil, sqlDB, nil, nil, time.Hour, 5), mock
+}
+
+func TestCollector(t *testing.T) {
+	t.Parallel()
+
+	t.Run("FindTopQueries", func(t *testing.T) {
+		t.Parallel()
+
+		c, mock := setupCollector(t)
+
+		since := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+		mock.ExpectQuery(regexp.QuoteMeta("LIMIT ? BY service_id")).
+			WithArgs(since, 5).
+			WillReturnRows(sqlmock.NewRows([]string{"service_id", "queryid"}).
+				AddRow("s1", "Q1").
+				AddRow("s2", "Q2"))
+
+		queries, err := c.findTopQueries(t.Context(), since)
+		require.NoError(t, err)
+		assert.Equal(t, []topQuery{{serviceID: "s1", queryID: "Q1"}, {serviceID: "s2", queryID: "Q2"}}, queries)
+	})
+
+	t.Run("Insert", func(t *testing.T) {
+		t.Parallel()
+
+		c, mock := setupCollector(t)
+
+		target := &services.Target{ServiceID: "s1", ServiceName: "mysql1", ServiceType: models.MySQLServiceType}
+		collectedAt := time.Date(2026, 10, 1, 13, 0, 0, 0, time.UTC)
+		plan := &queryPlan{queryID: "Q1", planID: planID("{}"), plan: "{}", collectedAt: collectedAt}
+
+		mock.ExpectBegin()
+		mock.ExpectPrepare(regexp.QuoteMeta("INSERT INTO query_plans")).
+			ExpectExec().
+			WithArgs("s1", "mysql1", "mysql", "Q1", plan.planID, "{}", collectedAt).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, c.insert(t.Context(), target, []*queryPlan{plan}))
+	})
+
+	t.Run("InsertNothing", func(t *testing.T) {
+		t.Parallel()
+
+		c, _ := setupCollector(t)
+
+		require.NoError(t, c.insert(t.Context(), &services.Target{}, nil))
+	})
+}
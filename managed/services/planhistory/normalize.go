@@ -0,0 +1,119 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package planhistory
+
+import (
+	"crypto/md5" //nolint:gosec
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/percona/pmm/managed/models"
+)
+
+// volatileMySQLKeys are EXPLAIN FORMAT=JSON properties that change with table statistics
+// without a change of the plan: costs and row estimates.
+// Warnings are added by the explain action and contain the rewritten query.
+var volatileMySQLKeys = map[string]struct{}{
+	"cost_info":              {},
+	"rows_examined_per_scan": {},
+	"rows_produced_per_join": {},
+	"filtered":               {},
+	"warnings":               {},
+}
+
+// volatileMongoDBKeys are explain command properties that describe the server, the command,
+// or the execution rather than the winning plan.
+var volatileMongoDBKeys = map[string]struct{}{
+	"$clusterTime":           {},
+	"command":                {},
+	"executionStats":         {},
+	"operationTime":          {},
+	"ok":                     {},
+	"optimizationTimeMillis": {},
+	"planCacheKey":           {},
+	"planCacheShapeHash":     {},
+	"queryHash":              {},
+	"queryShapeHash":         {},
+	"rejectedPlans":          {},
+	"serverInfo":             {},
+	"serverParameters":       {},
+}
+
+// mysqlExplainOutput is the output of MySQL explain action.
+type mysqlExplainOutput struct {
+	ExplainResult []byte `json:"explain_result"`
+}
+
+// normalizePlan extracts the plan from the explain action output and removes volatile properties from it.
+// The result is indented JSON with sorted keys, so equal plans are equal strings.
+func normalizePlan(serviceType models.ServiceType, output []byte) (string, error) {
+	var plan []byte
+	var volatileKeys map[string]struct{}
+	switch serviceType {
+	case models.MySQLServiceType:
+		var res mysqlExplainOutput
+		if err := json.Unmarshal(output, &res); err != nil {
+			return "", fmt.Errorf("failed to decode explain output: %w", err)
+		}
+		plan, volatileKeys = res.ExplainResult, volatileMySQLKeys
+	case models.MongoDBServiceType:
+		plan, volatileKeys = output, volatileMongoDBKeys
+	default:
+		return "", fmt.Errorf("unsupported service type %s", serviceType)
+	}
+
+	var p map[string]any
+	if err := json.Unmarshal(plan, &p); err != nil {
+		return "", fmt.Errorf("failed to decode plan: %w", err)
+	}
+	if len(p) == 0 {
+		return "", errors.New("empty plan")
+	}
+
+	b, err := json.MarshalIndent(removeKeys(p, volatileKeys), "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to encode plan: %w", err)
+	}
+	return string(b), nil
+}
+
+// removeKeys recursively removes given keys from JSON objects.
+func removeKeys(v any, keys map[string]struct{}) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			if _, ok := keys[k]; ok {
+				delete(v, k)
+				continue
+			}
+			v[k] = removeKeys(child, keys)
+		}
+	case []any:
+		for i, child := range v {
+			v[i] = removeKeys(child, keys)
+		}
+	}
+	return v
+}
+
+// planID returns the hash of the normalized plan in the same format as QAN plan IDs.
+func planID(plan string) string {
+	h := md5.Sum([]byte(plan)) //nolint:gosec
+	return strings.ToUpper(hex.EncodeToString(h[:]))
+}
@@ -0,0 +1,103 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package planhistory
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/percona/pmm/managed/models"
+)
+
+func TestNormalizePlan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("MySQL", func(t *testing.T) {
+		t.Parallel()
+
+		mysqlOutput := func(rows, cost string) []byte {
+			explain := `{"query_block": {"select_id": 1, "cost_info": {"query_cost": "` + cost + `"},
+				"table": {"table_name": "orders", "access_type": "ref", "key": "idx_customer",
+				"rows_examined_per_scan": ` + rows + `, "rows_produced_per_join": ` + rows + `, "filtered": "100.00"}},
+				"warnings": [{"Level": "Note", "Code": 1003, "Message": "/* select#1 */ select ..."}]}`
+			b, err := json.Marshal(mysqlExplainOutput{ExplainResult: []byte(explain)})
+			require.NoError(t, err)
+			return b
+		}
+
+		plan, err := normalizePlan(models.MySQLServiceType, mysqlOutput("10", "3.50"))
+		require.NoError(t, err)
+		expected := "{\n" +
+			"  \"query_block\": {\n" +
+			"    \"select_id\": 1,\n" +
+			"    \"table\": {\n" +
+			"      \"access_type\": \"ref\",\n" +
+			"      \"key\": \"idx_customer\",\n" +
+			"      \"table_name\": \"orders\"\n" +
+			"    }\n" +
+			"  }\n" +
+			"}"
+		assert.Equal(t, expected, plan)
+
+		// changed statistics do not change the plan
+		other, err := normalizePlan(models.MySQLServiceType, mysqlOutput("100500", "42.00"))
+		require.NoError(t, err)
+		assert.Equal(t, plan, other)
+		assert.Equal(t, planID(plan), planID(other))
+	})
+
+	t.Run("MongoDB", func(t *testing.T) {
+		t.Parallel()
+
+		output := `{"explainVersion": "1", "queryPlanner": {"namespace": "test.orders",
+			"winningPlan": {"stage": "FETCH", "inputStage": {"stage": "IXSCAN", "indexName": "customer_1"}},
+			"rejectedPlans": [{"stage": "COLLSCAN"}], "queryHash": "8E1A0C4D"},
+			"executionStats": {"nReturned": 3, "executionTimeMillis": 1},
+			"command": {"find": "orders", "filter": {"customer": 42}},
+			"serverInfo": {"host": "mongo1", "port": 27017}, "ok": 1}`
+
+		plan, err := normalizePlan(models.MongoDBServiceType, []byte(output))
+		require.NoError(t, err)
+		expected := "{\n" +
+			"  \"explainVersion\": \"1\",\n" +
+			"  \"queryPlanner\": {\n" +
+			"    \"namespace\": \"test.orders\",\n" +
+			"    \"winningPlan\": {\n" +
+			"      \"inputStage\": {\n" +
+			"        \"indexName\": \"customer_1\",\n" +
+			"        \"stage\": \"IXSCAN\"\n" +
+			"      },\n" +
+			"      \"stage\": \"FETCH\"\n" +
+			"    }\n" +
+			"  }\n" +
+			"}"
+		assert.Equal(t, expected, plan)
+		assert.Len(t, planID(plan), 32)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := normalizePlan(models.MongoDBServiceType, []byte(`{}`))
+		require.EqualError(t, err, "empty plan")
+
+		_, err = normalizePlan(models.PostgreSQLServiceType, []byte(`{}`))
+		require.EqualError(t, err, "unsupported service type postgresql")
+	})
+}
@@ -30,7 +30,13 @@ import (
 // WARNING: This function is valid only when executed as part of transaction with serializable isolation level.
 func CheckMongoDBBackupPreconditions(q *reform.Querier, mode models.BackupMode, clusterName, serviceID, scheduleID string) error {
 	filter := models.ScheduledTasksFilter{
-		Disabled:    new(false),
+		Disabled: new(false),
+		// Verification tasks don't make backups, so they don't conflict with PITR.
+		Types: []models.ScheduledTaskType{
+			models.ScheduledMySQLBackupTask,
+			models.ScheduledMongoDBBackupTask,
+			models.ScheduledPostgreSQLBackupTask,
+		},
 		ClusterName: clusterName,
 	}
 
@@ -90,6 +96,40 @@ func CheckMongoDBBackupPreconditions(q *reform.Querier, mode models.BackupMode,
 	return nil
 }
 
+// CheckMySQLBackupPreconditions checks compatibility of different types of scheduled backups and on-demand backups for MySQL.
+// Snapshots don't interfere with binary logs streaming, so only the number of PITR backups per service is limited.
+//
+// WARNING: This function is valid only when executed as part of transaction with serializable isolation level.
+func CheckMySQLBackupPreconditions(q *reform.Querier, mode models.BackupMode, serviceID, scheduleID string) error {
+	switch mode {
+	case models.PITR:
+		tasks, err := models.FindScheduledTasks(q, models.ScheduledTasksFilter{
+			Disabled:  new(false),
+			Types:     []models.ScheduledTaskType{models.ScheduledMySQLBackupTask},
+			ServiceID: serviceID,
+			Mode:      models.PITR,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, task := range tasks {
+			if task.ID == scheduleID {
+				// When we are updating existing scheduled PITR backup we should pass this check.
+				continue
+			}
+
+			return status.Errorf(codes.FailedPrecondition, "A PITR backup for the service with ID '%s' can be enabled only if "+
+				"there are no other enabled PITR backups for this service.", serviceID)
+		}
+	case models.Snapshot:
+	case models.Incremental:
+		return status.Error(codes.InvalidArgument, "Incremental backups unsupported for MySQL")
+	}
+
+	return nil
+}
+
 // CheckArtifactOverlapping checks if there are other artifacts or scheduled tasks pointing to the same location and folder.
 // Placing MySQL and MongoDB artifacts in the same folder is not desirable, while placing MongoDB artifacts of different clusters
 // in the same folder may cause data inconsistency.
@@ -129,6 +169,10 @@ func CheckArtifactOverlapping(q *reform.Querier, serviceID, locationID, folder s
 				continue
 			}
 
+			if service.ServiceType == models.PostgreSQLServiceType && svc.ServiceType == models.PostgreSQLServiceType {
+				continue
+			}
+
 			if service.ServiceType == models.MongoDBServiceType && svc.ServiceType == models.MongoDBServiceType {
 				if svc.Cluster != service.Cluster {
 					return fmt.Errorf(usedByArtifactMsg+": %w", artifact.ID, serviceID, ErrLocationFolderPairAlreadyUsed)
@@ -161,6 +205,10 @@ func CheckArtifactOverlapping(q *reform.Querier, serviceID, locationID, folder s
 				continue
 			}
 
+			if service.ServiceType == models.PostgreSQLServiceType && task.Type == models.ScheduledPostgreSQLBackupTask {
+				continue
+			}
+
 			if service.ServiceType == models.MongoDBServiceType && task.Type == models.ScheduledMongoDBBackupTask {
 				if task.Data.MongoDBBackupTask.ClusterName != service.Cluster {
 					return fmt.Errorf(usedByScheduledTaskMsg+": %w", task.ID, serviceID, ErrLocationFolderPairAlreadyUsed)
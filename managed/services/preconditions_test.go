@@ -145,6 +145,66 @@ func TestCheckMongoDBBackupPreconditions(t *testing.T) {
 	})
 }
 
+func TestCheckMySQLBackupPreconditions(t *testing.T) {
+	sqlDB := testdb.Open(t, models.SkipFixtures, nil)
+	db := reform.NewDB(sqlDB, postgresql.Dialect, reform.NewPrintfLogger(t.Logf))
+	t.Cleanup(func() {
+		require.NoError(t, sqlDB.Close())
+	})
+
+	schedule, err := models.CreateScheduledTask(db.Querier, models.CreateScheduledTaskParams{
+		CronExpression: "* * * * *",
+		Type:           models.ScheduledMySQLBackupTask,
+		Data: &models.ScheduledTaskData{
+			MySQLBackupTask: &models.MySQLBackupTaskData{
+				CommonBackupTaskData: models.CommonBackupTaskData{
+					ServiceID:  "service1",
+					Name:       "mysql1",
+					LocationID: "loc",
+					Mode:       models.PITR,
+				},
+			},
+		},
+		Disabled: false,
+	})
+	require.NoError(t, err)
+
+	t.Run("unable to create second PITR backup for service", func(t *testing.T) {
+		err := db.InTransactionContext(t.Context(), &sql.TxOptions{Isolation: sql.LevelSerializable}, func(_ *reform.TX) error {
+			return CheckMySQLBackupPreconditions(db.Querier, models.PITR, "service1", "")
+		})
+		tests.AssertGRPCError(t, status.New(codes.FailedPrecondition, "A PITR backup for the service with ID 'service1' can be enabled only if there are no other enabled PITR backups for this service."), err)
+	})
+
+	t.Run("able to update existing PITR backup for service", func(t *testing.T) {
+		err := db.InTransactionContext(t.Context(), &sql.TxOptions{Isolation: sql.LevelSerializable}, func(_ *reform.TX) error {
+			return CheckMySQLBackupPreconditions(db.Querier, models.PITR, "service1", schedule.ID)
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("able to create snapshot backup for service with enabled PITR backup", func(t *testing.T) {
+		err := db.InTransactionContext(t.Context(), &sql.TxOptions{Isolation: sql.LevelSerializable}, func(_ *reform.TX) error {
+			return CheckMySQLBackupPreconditions(db.Querier, models.Snapshot, "service1", "")
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("able to create PITR backup for other service", func(t *testing.T) {
+		err := db.InTransactionContext(t.Context(), &sql.TxOptions{Isolation: sql.LevelSerializable}, func(_ *reform.TX) error {
+			return CheckMySQLBackupPreconditions(db.Querier, models.PITR, "service2", "")
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("incremental backups are not supported", func(t *testing.T) {
+		err := db.InTransactionContext(t.Context(), &sql.TxOptions{Isolation: sql.LevelSerializable}, func(_ *reform.TX) error {
+			return CheckMySQLBackupPreconditions(db.Querier, models.Incremental, "service1", "")
+		})
+		tests.AssertGRPCError(t, status.New(codes.InvalidArgument, "Incremental backups unsupported for MySQL"), err)
+	})
+}
+
 func TestCheckArtifactOverlapping(t *testing.T) {
 	sqlDB := testdb.Open(t, models.SkipFixtures, nil)
 	db := reform.NewDB(sqlDB, postgresql.Dialect, reform.NewPrintfLogger(t.Logf))
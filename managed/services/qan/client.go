@@ -261,6 +261,8 @@ func (c *Client) Collect(ctx context.Context, metricsBuckets []*agentv1.MetricsB
 			fillMongoDB(mb, m.Mongodb)
 		case m.Postgresql != nil:
 			fillPostgreSQL(mb, m.Postgresql)
+		case m.Valkey != nil:
+			fillValkey(mb, m.Valkey)
 		}
 
 		// Ordered the same as fields in MetricsBucket
@@ -644,6 +646,22 @@ func fillPostgreSQL(mb *qanv1.MetricsBucket, bp *agentv1.MetricsBucket_PostgreSQ
 	mb.HistogramItems = convertHistogramItems(bp.HistogramItems)
 }
 
+func fillValkey(mb *qanv1.MetricsBucket, bv *agentv1.MetricsBucket_Valkey) {
+	mb.MArgsCnt = bv.MArgsCnt
+	mb.MArgsSum = bv.MArgsSum
+	mb.MArgsMin = bv.MArgsMin
+	mb.MArgsMax = bv.MArgsMax
+
+	mb.MTotalCallsCnt = bv.MTotalCallsCnt
+	mb.MTotalCallsSum = bv.MTotalCallsSum
+	mb.MTotalTimeCnt = bv.MTotalTimeCnt
+	mb.MTotalTimeSum = bv.MTotalTimeSum
+	mb.MRejectedCallsCnt = bv.MRejectedCallsCnt
+	mb.MRejectedCallsSum = bv.MRejectedCallsSum
+	mb.MFailedCallsCnt = bv.MFailedCallsCnt
+	mb.MFailedCallsSum = bv.MFailedCallsSum
+}
+
 func convertHistogramItems(items []*agentv1.HistogramItem) []string {
 	res := []string{}
 	for _, v := range items {
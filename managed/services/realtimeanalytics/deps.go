@@ -15,7 +15,13 @@
 
 package realtimeanalytics
 
-import "context"
+import (
+	"context"
+	"time"
+
+	managementv1 "github.com/percona/pmm/api/management/v1"
+	"github.com/percona/pmm/managed/models"
+)
 
 // agentsRegistry provides information about running agents.
 type agentsRegistry interface {
@@ -27,3 +33,40 @@ type agentsRegistry interface {
 type agentsStateUpdater interface {
 	RequestStateUpdate(ctx context.Context, pmmAgentID string)
 }
+
+// actionsService is a subset of methods of agents.ActionsService used by this package.
+// We use it instead of real type for testing and to avoid dependency cycle.
+type actionsService interface {
+	StartMongoDBKillOpAction(
+		ctx context.Context,
+		id, pmmAgentID, dsn string,
+		opID int64,
+		files map[string]string,
+		tdp *models.DelimiterPair,
+		secrets map[string]string,
+	) error
+	StartMySQLKillQueryAction(
+		ctx context.Context,
+		id, pmmAgentID, dsn string,
+		connectionID, threadID, eventID uint64,
+		queryStart time.Time,
+		files map[string]string,
+		tdp *models.DelimiterPair,
+		tlsSkipVerify bool,
+		secrets map[string]string,
+	) error
+	StartPostgreSQLCancelBackendAction(
+		ctx context.Context,
+		id, pmmAgentID, dsn string,
+		pid int32,
+		queryStart time.Time,
+		files map[string]string,
+		tdp *models.DelimiterPair,
+		secrets map[string]string,
+	) error
+}
+
+// annotationService is a subset of methods of management.ManagementService used by this package.
+type annotationService interface {
+	AddAnnotation(ctx context.Context, req *managementv1.AddAnnotationRequest) (*managementv1.AddAnnotationResponse, error)
+}
@@ -0,0 +1,85 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package realtimeanalytics
+
+import (
+	context "context"
+
+	models "github.com/percona/pmm/managed/models"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// mockActionsService is an autogenerated mock type for the actionsService type
+type mockActionsService struct {
+	mock.Mock
+}
+
+// StartMongoDBKillOpAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, opID, files, tdp, secrets
+func (_m *mockActionsService) StartMongoDBKillOpAction(ctx context.Context, id string, pmmAgentID string, dsn string, opID int64, files map[string]string, tdp *models.DelimiterPair, secrets map[string]string) error {
+	ret := _m.Called(ctx, id, pmmAgentID, dsn, opID, files, tdp, secrets)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartMongoDBKillOpAction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int64, map[string]string, *models.DelimiterPair, map[string]string) error); ok {
+		r0 = rf(ctx, id, pmmAgentID, dsn, opID, files, tdp, secrets)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StartMySQLKillQueryAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, connectionID, threadID, eventID, queryStart, files, tdp, tlsSkipVerify, secrets
+func (_m *mockActionsService) StartMySQLKillQueryAction(ctx context.Context, id string, pmmAgentID string, dsn string, connectionID uint64, threadID uint64, eventID uint64, queryStart time.Time, files map[string]string, tdp *models.DelimiterPair, tlsSkipVerify bool, secrets map[string]string) error {
+	ret := _m.Called(ctx, id, pmmAgentID, dsn, connectionID, threadID, eventID, queryStart, files, tdp, tlsSkipVerify, secrets)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartMySQLKillQueryAction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, uint64, uint64, uint64, time.Time, map[string]string, *models.DelimiterPair, bool, map[string]string) error); ok {
+		r0 = rf(ctx, id, pmmAgentID, dsn, connectionID, threadID, eventID, queryStart, files, tdp, tlsSkipVerify, secrets)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// StartPostgreSQLCancelBackendAction provides a mock function with given fields: ctx, id, pmmAgentID, dsn, pid, queryStart, files, tdp, secrets
+func (_m *mockActionsService) StartPostgreSQLCancelBackendAction(ctx context.Context, id string, pmmAgentID string, dsn string, pid int32, queryStart time.Time, files map[string]string, tdp *models.DelimiterPair, secrets map[string]string) error {
+	ret := _m.Called(ctx, id, pmmAgentID, dsn, pid, queryStart, files, tdp, secrets)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartPostgreSQLCancelBackendAction")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int32, time.Time, map[string]string, *models.DelimiterPair, map[string]string) error); ok {
+		r0 = rf(ctx, id, pmmAgentID, dsn, pid, queryStart, files, tdp, secrets)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// newMockActionsService creates a new instance of mockActionsService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockActionsService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockActionsService {
+	mock := &mockActionsService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,59 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package realtimeanalytics
+
+import (
+	context "context"
+
+	managementv1 "github.com/percona/pmm/api/management/v1"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockAnnotationService is an autogenerated mock type for the annotationService type
+type mockAnnotationService struct {
+	mock.Mock
+}
+
+// AddAnnotation provides a mock function with given fields: ctx, req
+func (_m *mockAnnotationService) AddAnnotation(ctx context.Context, req *managementv1.AddAnnotationRequest) (*managementv1.AddAnnotationResponse, error) {
+	ret := _m.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAnnotation")
+	}
+
+	var r0 *managementv1.AddAnnotationResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *managementv1.AddAnnotationRequest) (*managementv1.AddAnnotationResponse, error)); ok {
+		return rf(ctx, req)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *managementv1.AddAnnotationRequest) *managementv1.AddAnnotationResponse); ok {
+		r0 = rf(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*managementv1.AddAnnotationResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *managementv1.AddAnnotationRequest) error); ok {
+		r1 = rf(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// newMockAnnotationService creates a new instance of mockAnnotationService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockAnnotationService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockAnnotationService {
+	mock := &mockAnnotationService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
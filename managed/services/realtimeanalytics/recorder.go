@@ -0,0 +1,227 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+package realtimeanalytics
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	rtav1 "github.com/percona/pmm/api/realtimeanalytics/v1"
+)
+
+const (
+	// recorderBufferSize is the number of collected query buckets that may wait to be recorded.
+	recorderBufferSize = 1000
+	// recorderBatchSize is the number of queries that triggers inserting the batch before flush interval.
+	recorderBatchSize = 10000
+	// recorderFlushInterval is how often collected queries are inserted into ClickHouse.
+	recorderFlushInterval = 5 * time.Second
+	// recorderShutdownTimeout is how long the last batch may be inserted on shutdown.
+	recorderShutdownTimeout = 5 * time.Second
+	// replayWindow is how far after the requested point in time samples are looked up.
+	// It shall be larger than the longest collect interval of Real-Time Analytics agents.
+	replayWindow = time.Minute
+)
+
+const insertRecordedQuerySQL = `INSERT INTO rta_queries
+	(service_id, service_name, query_id, collect_time, query_start, execution_duration_ms, data)
+	VALUES (?, ?, ?, ?, ?, ?, ?)`
+
+// replayQuerySQL returns the first sample observed at or after the requested point in time
+// for each query that had already been started by then.
+// Since a connection (or backend) runs one query at a time, a query with the same ID
+// that started before and was still observed after the requested time was running at that time.
+const replayQuerySQL = `SELECT argMin(data, collect_time)
+	FROM rta_queries
+	WHERE service_id IN (%s)
+		AND collect_time BETWEEN ? AND ?
+		AND query_start <= ?
+	GROUP BY service_id, query_id
+	ORDER BY min(query_start)`
+
+// Recorder persists Real-Time Analytics queries into ClickHouse for post-incident replay.
+type Recorder struct {
+	db        *sql.DB
+	l         *logrus.Entry
+	queriesCh chan []*rtav1.QueryData
+}
+
+// NewRecorder creates a new Real-Time Analytics recorder.
+func NewRecorder(db *sql.DB) *Recorder {
+	return &Recorder{
+		db:        db,
+		l:         logrus.WithField("component", "rta-recorder"),
+		queriesCh: make(chan []*rtav1.QueryData, recorderBufferSize),
+	}
+}
+
+// Record enqueues collected queries to be inserted into ClickHouse.
+// It never blocks: queries are dropped if ClickHouse can't keep up.
+func (r *Recorder) Record(queries []*rtav1.QueryData) {
+	select {
+	case r.queriesCh <- queries:
+	default:
+		r.l.Warnf("Recorder buffer is full, dropping %d queries.", len(queries))
+	}
+}
+
+// Run inserts enqueued queries into ClickHouse until context is canceled.
+func (r *Recorder) Run(ctx context.Context) {
+	r.l.Info("Starting...")
+	defer r.l.Info("Done.")
+
+	ticker := time.NewTicker(recorderFlushInterval)
+	defer ticker.Stop()
+
+	var batch []*rtav1.QueryData
+	for {
+		select {
+		case <-ctx.Done():
+			insertCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), recorderShutdownTimeout)
+			r.insertBatch(insertCtx, batch)
+			cancel()
+			return
+		case queries := <-r.queriesCh:
+			batch = append(batch, queries...)
+			if len(batch) < recorderBatchSize {
+				continue
+			}
+		case <-ticker.C:
+		}
+
+		// Failed batch is dropped to keep memory usage bounded while ClickHouse is unavailable.
+		r.insertBatch(ctx, batch)
+		batch = batch[:0]
+	}
+}
+
+// insertBatch inserts given queries into ClickHouse and logs the result.
+func (r *Recorder) insertBatch(ctx context.Context, queries []*rtav1.QueryData) {
+	if len(queries) == 0 {
+		return
+	}
+
+	start := time.Now()
+	err := r.insert(ctx, queries)
+	if err != nil {
+		r.l.Errorf("Failed to record %d queries in %s: %s.", len(queries), time.Since(start), err)
+		return
+	}
+
+	r.l.Debugf("Recorded %d queries in %s.", len(queries), time.Since(start))
+}
+
+func (r *Recorder) insert(ctx context.Context, queries []*rtav1.QueryData) (err error) {
+	// begin "transaction" and commit or rollback it on exit
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			if err != nil {
+				err = fmt.Errorf("failed to commit transaction: %w", err)
+			}
+		} else {
+			_ = tx.Rollback()
+		}
+	}()
+
+	stmt, err := tx.PrepareContext(ctx, insertRecordedQuerySQL)
+	if err != nil {
+		return fmt.Errorf("failed to prepare statement: %w", err)
+	}
+	defer stmt.Close() //nolint:errcheck
+
+	for _, q := range queries {
+		data, err := protojson.Marshal(q)
+		if err != nil {
+			return fmt.Errorf("failed to marshal query %s of service %s: %w", q.QueryId, q.ServiceId, err)
+		}
+
+		collectTime := time.Now()
+		if q.QueryCollectTime != nil {
+			collectTime = q.QueryCollectTime.AsTime()
+		}
+		duration := q.GetQueryExecutionDuration().AsDuration()
+
+		_, err = stmt.ExecContext(ctx,
+			q.ServiceId,
+			q.ServiceName,
+			q.QueryId,
+			collectTime,
+			collectTime.Add(-duration),
+			uint64(duration.Milliseconds()),
+			string(data),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to exec: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Replay returns queries of given services that were running at the given point in time,
+// longest running first. Execution duration of returned queries is calculated as of that point in time.
+// Queries that finished between the given time and the next collection are not returned.
+func (r *Recorder) Replay(ctx context.Context, serviceIDs []string, t time.Time, limit int64) ([]*rtav1.QueryData, error) {
+	args := make([]any, 0, len(serviceIDs))
+	for _, serviceID := range serviceIDs {
+		args = append(args, serviceID)
+	}
+	args = append(args, t, t.Add(replayWindow), t)
+
+	query := fmt.Sprintf(replayQuerySQL, strings.TrimSuffix(strings.Repeat("?, ", len(serviceIDs)), ", "))
+	if limit > 0 {
+		query += fmt.Sprintf("\n\tLIMIT %d", limit)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to select recorded queries: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	res := []*rtav1.QueryData{}
+	for rows.Next() {
+		var data string
+		err = rows.Scan(&data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan recorded query: %w", err)
+		}
+
+		q := &rtav1.QueryData{}
+		err = protojson.Unmarshal([]byte(data), q)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal recorded query: %w", err)
+		}
+
+		queryStart := q.GetQueryCollectTime().AsTime().Add(-q.GetQueryExecutionDuration().AsDuration())
+		q.QueryExecutionDuration = durationpb.New(t.Sub(queryStart))
+
+		res = append(res, q)
+	}
+
+	return res, rows.Err()
+}
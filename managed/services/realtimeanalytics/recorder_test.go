@@ -0,0 +1,117 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+package realtimeanalytics
+
+import (
+	"regexp"
+	"testing"
+	"time"
+
+	sqlmock "github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	rtav1 "github.com/percona/pmm/api/realtimeanalytics/v1"
+)
+
+func setupRecorder(t *testing.T) (*Recorder, sqlmock.Sqlmock) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	return NewRecorder(sqlDB), mock
+}
+
+func TestRecorder(t *testing.T) {
+	t.Parallel()
+
+	collectTime := time.Date(2026, 3, 14, 3, 14, 30, 0, time.UTC)
+
+	t.Run("Insert", func(t *testing.T) {
+		t.Parallel()
+
+		r, mock := setupRecorder(t)
+
+		q := newQuery("service-id", "42", "SELECT SLEEP(60)", 30*time.Second)
+		q.ServiceName = "service-name"
+		q.QueryCollectTime = timestamppb.New(collectTime)
+		data, err := protojson.Marshal(q)
+		require.NoError(t, err)
+
+		mock.ExpectBegin()
+		mock.ExpectPrepare(regexp.QuoteMeta("INSERT INTO rta_queries")).
+			ExpectExec().
+			WithArgs("service-id", "service-name", "42", collectTime, collectTime.Add(-30*time.Second), uint64(30000), string(data)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, r.insert(t.Context(), []*rtav1.QueryData{q}))
+	})
+
+	t.Run("Replay", func(t *testing.T) {
+		t.Parallel()
+
+		r, mock := setupRecorder(t)
+
+		q := newQuery("service-id", "42", "SELECT SLEEP(60)", 30*time.Second)
+		q.QueryCollectTime = timestamppb.New(collectTime)
+		data, err := protojson.Marshal(q)
+		require.NoError(t, err)
+
+		replayTime := collectTime.Add(-10 * time.Second)
+		mock.ExpectQuery(regexp.QuoteMeta("WHERE service_id IN (?, ?)")).
+			WithArgs("service-id", "service-id-2", replayTime, replayTime.Add(replayWindow), replayTime).
+			WillReturnRows(sqlmock.NewRows([]string{"data"}).AddRow(string(data)))
+
+		res, err := r.Replay(t.Context(), []string{"service-id", "service-id-2"}, replayTime, 0)
+		require.NoError(t, err)
+		require.Len(t, res, 1)
+		assert.Equal(t, "42", res[0].QueryId)
+		assert.Equal(t, "SELECT SLEEP(60)", res[0].QueryText)
+		// the query started at 03:14:00, so it was running for 20 seconds at 03:14:20
+		assert.Equal(t, 20*time.Second, res[0].QueryExecutionDuration.AsDuration())
+	})
+
+	t.Run("Limit", func(t *testing.T) {
+		t.Parallel()
+
+		r, mock := setupRecorder(t)
+
+		mock.ExpectQuery(regexp.QuoteMeta("LIMIT 10")).
+			WillReturnRows(sqlmock.NewRows([]string{"data"}))
+
+		res, err := r.Replay(t.Context(), []string{"service-id"}, collectTime, 10)
+		require.NoError(t, err)
+		assert.Empty(t, res)
+		assert.NotNil(t, res)
+	})
+
+	t.Run("DropWhenFull", func(t *testing.T) {
+		t.Parallel()
+
+		r, _ := setupRecorder(t)
+
+		for range recorderBufferSize + 1 {
+			r.Record([]*rtav1.QueryData{newQuery("service-id", "1", "SELECT 1", time.Second)})
+		}
+		assert.Len(t, r.queriesCh, recorderBufferSize)
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -39,31 +40,55 @@ import (
 
 	agentv1 "github.com/percona/pmm/api/agent/v1"
 	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
+	managementv1 "github.com/percona/pmm/api/management/v1"
 	rtav1 "github.com/percona/pmm/api/realtimeanalytics/v1"
 	"github.com/percona/pmm/managed/models"
 	"github.com/percona/pmm/managed/services"
+	"github.com/percona/pmm/managed/services/audit"
 	"github.com/percona/pmm/utils/logger"
 	"github.com/percona/pmm/version"
 )
 
+const (
+	// killQueryAnnotationTag is a Grafana annotation tag for killed queries.
+	killQueryAnnotationTag = "pmm_rta_kill_query"
+	// watchHeartbeatInterval is how often an empty batch is sent to WatchQueries stream
+	// to keep idle connections (especially Server-Sent Events through proxies) alive.
+	watchHeartbeatInterval = 15 * time.Second
+)
+
 // Service provides API for managing Real-Time Analytics.
 type Service struct {
 	rtav1.UnimplementedRealtimeAnalyticsServiceServer
 	rtav1.UnimplementedCollectorServiceServer
 
-	db           *reform.DB
-	registry     agentsRegistry
-	stateUpdater agentsStateUpdater
-	store        *Store
+	db                *reform.DB
+	registry          agentsRegistry
+	stateUpdater      agentsStateUpdater
+	actions           actionsService
+	annotationService annotationService
+	store             *Store
+	recorder          *Recorder
 }
 
 // NewService creates a new Real-Time Analytics service.
-func NewService(db *reform.DB, registry agentsRegistry, stateUpdater agentsStateUpdater, store *Store) *Service {
+func NewService(
+	db *reform.DB,
+	registry agentsRegistry,
+	stateUpdater agentsStateUpdater,
+	actions actionsService,
+	annotationService annotationService,
+	store *Store,
+	recorder *Recorder,
+) *Service {
 	return &Service{
-		db:           db,
-		registry:     registry,
-		stateUpdater: stateUpdater,
-		store:        store,
+		db:                db,
+		registry:          registry,
+		stateUpdater:      stateUpdater,
+		actions:           actions,
+		annotationService: annotationService,
+		store:             store,
+		recorder:          recorder,
 	}
 }
 
@@ -92,7 +117,6 @@ func (s *Service) ListServices(ctx context.Context, req *rtav1.ListServicesReque
 		}
 	} else {
 		// No service type filter specified - return all services that support RTA.
-		// For the time being we only support MongoDB, so we can just filter by service type here.
 		for _, modelServiceType := range services.ServiceTypes {
 			_, err := getRTAAgentTypeForServiceType(modelServiceType)
 			if err != nil {
@@ -137,7 +161,7 @@ func (s *Service) ListServices(ctx context.Context, req *rtav1.ListServicesReque
 
 		// PMM Agent that is linked to the requested service may be outdated and doesn't support RTA.
 		// In this case we cannot start RTA session for this service and should return an error.
-		if !isRtaFeatureSupported(*pmmAgents[0].Version) {
+		if !isRtaFeatureSupported(svc.ServiceType, *pmmAgents[0].Version) {
 			continue // skip services with unsupported pmm-agent version
 		}
 
@@ -150,7 +174,10 @@ func (s *Service) ListServices(ctx context.Context, req *rtav1.ListServicesReque
 		switch apiSvc := apiSvc.(type) {
 		case *inventoryv1.MongoDBService:
 			res.Mongodb = append(res.Mongodb, apiSvc)
-		// Add other service types once RTA is supported for them
+		case *inventoryv1.MySQLService:
+			res.Mysql = append(res.Mysql, apiSvc)
+		case *inventoryv1.PostgreSQLService:
+			res.Postgresql = append(res.Postgresql, apiSvc)
 		default:
 			return nil, fmt.Errorf("unhandled inventory Service type %T", apiSvc)
 		}
@@ -159,6 +186,12 @@ func (s *Service) ListServices(ctx context.Context, req *rtav1.ListServicesReque
 	slices.SortStableFunc(res.Mongodb, func(a, b *inventoryv1.MongoDBService) int {
 		return strings.Compare(a.ServiceName, b.ServiceName)
 	})
+	slices.SortStableFunc(res.Mysql, func(a, b *inventoryv1.MySQLService) int {
+		return strings.Compare(a.ServiceName, b.ServiceName)
+	})
+	slices.SortStableFunc(res.Postgresql, func(a, b *inventoryv1.PostgreSQLService) int {
+		return strings.Compare(a.ServiceName, b.ServiceName)
+	})
 
 	return res, nil
 }
@@ -299,7 +332,7 @@ func (s *Service) StartSession(ctx context.Context, req *rtav1.StartSessionReque
 
 	// In this context we do not have any credentials for connecting to the service.
 	// So we need to copy them from existing agents for this service.
-	// Retrieve credentials and pmm-agent ID from existing MongoDB agents for this service
+	// Retrieve credentials and pmm-agent ID from existing agents for this service
 	// Try to find from QAN or exporter agents
 	var agentTypes []models.AgentType
 
@@ -310,7 +343,19 @@ func (s *Service) StartSession(ctx context.Context, req *rtav1.StartSessionReque
 			models.QANMongoDBProfilerAgentType,
 			models.QANMongoDBMongologAgentType,
 		}
-		// Add other service types once RTA is supported for them
+	case models.MySQLServiceType:
+		agentTypes = []models.AgentType{
+			models.MySQLdExporterType,
+			models.QANMySQLPerfSchemaAgentType,
+			models.QANMySQLSlowlogAgentType,
+		}
+	case models.PostgreSQLServiceType:
+		agentTypes = []models.AgentType{
+			models.PostgresExporterType,
+			models.QANPostgreSQLPgStatementsAgentType,
+			models.QANPostgreSQLPgStatMonitorAgentType,
+			models.QANPostgreSQLLogAgentType,
+		}
 	default:
 		return nil, status.Errorf(codes.InvalidArgument,
 			"Service %s of type %s does not support Real-Time Analytics",
@@ -352,7 +397,7 @@ func (s *Service) StartSession(ctx context.Context, req *rtav1.StartSessionReque
 
 	// PMM Agent that is linked to the requested service may be outdated and doesn't support RTA.
 	// In this case we cannot start RTA session for this service and should return an error.
-	if !isRtaFeatureSupported(*pmmAgent.Version) {
+	if !isRtaFeatureSupported(service.ServiceType, *pmmAgent.Version) {
 		return nil, status.Errorf(codes.FailedPrecondition,
 			"Service %s has pmm-agent with version not supporting Real-Time Analytics.", service.ServiceID)
 	}
@@ -514,6 +559,192 @@ func (s *Service) SearchQueries(ctx context.Context, req *rtav1.SearchQueriesReq
 	return resp, nil
 }
 
+// WatchQueries streams changes of currently running Database Queries for specified services (gRPC handler).
+func (s *Service) WatchQueries(req *rtav1.WatchQueriesRequest, stream grpc.ServerStreamingServer[rtav1.WatchQueriesResponse]) error {
+	ctx := stream.Context()
+
+	// Validate that all the requested services exist
+	dbWithCtx := s.db.WithContext(ctx)
+	for _, serviceID := range req.ServiceIds {
+		_, err := models.FindServiceByID(dbWithCtx, serviceID)
+		if err != nil {
+			return err
+		}
+	}
+
+	err := stream.SendHeader(metadata.Pairs("Cache-Control", "no-store"))
+	if err != nil {
+		return status.Errorf(codes.Internal, "Failed to set response headers: %v", err)
+	}
+
+	sub := s.store.subscribe(newQueriesFilter(req))
+	defer s.store.unsubscribe(sub)
+
+	ticker := time.NewTicker(watchHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		var resp *rtav1.WatchQueriesResponse
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.overflow:
+			return status.Error(codes.ResourceExhausted, "Client is too slow to receive query events, please reconnect.")
+		case events := <-sub.events:
+			resp = &rtav1.WatchQueriesResponse{Events: events}
+		case <-ticker.C:
+			resp = &rtav1.WatchQueriesResponse{}
+		}
+
+		err = stream.Send(resp)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// KillQuery terminates a running Database Query observed in Real-Time Analytics session (gRPC handler).
+// It waits for the result of the kill, and only a successful kill is recorded as a Grafana annotation for the service.
+func (s *Service) KillQuery(ctx context.Context, req *rtav1.KillQueryRequest) (*rtav1.KillQueryResponse, error) {
+	// Only queries reported by the running session may be killed,
+	// so arbitrary connections (e.g. replication ones) can't be terminated via this API.
+	queries := s.store.Get(req.ServiceId)
+	i := slices.IndexFunc(queries, func(q *rtav1.QueryData) bool { return q.QueryId == req.QueryId })
+	if i < 0 {
+		return nil, status.Errorf(codes.NotFound, "Query %s is not running in service %s", req.QueryId, req.ServiceId)
+	}
+	query := queries[i]
+
+	var (
+		service  *models.Service
+		rtaAgent *models.Agent
+		agent    *models.Agent
+		res      *models.ActionResult
+		dsn      string
+	)
+
+	err := s.db.InTransactionContext(ctx, nil, func(tx *reform.TX) error {
+		var err error
+		service, err = models.FindServiceByID(tx.Querier, req.ServiceId)
+		if err != nil {
+			return err
+		}
+
+		rtaAgentType, err := getRTAAgentTypeForServiceType(service.ServiceType)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument,
+				"Service %s of type %s does not support Real-Time Analytics",
+				req.ServiceId, service.ServiceType)
+		}
+
+		rtaAgents, err := models.FindAgents(tx.Querier, models.AgentFilters{
+			ServiceID: req.ServiceId,
+			AgentType: &rtaAgentType,
+			Disabled:  new(false), // fetch enabled only
+		})
+		if err != nil {
+			return status.Errorf(codes.Internal, "Failed to find Real-Time Analytics agents for service %s: %v", req.ServiceId, err)
+		}
+
+		if len(rtaAgents) == 0 || rtaAgents[0].PMMAgentID == nil {
+			return status.Errorf(codes.FailedPrecondition, "Real-Time Analytics session is not running for service %s", req.ServiceId)
+		}
+		rtaAgent = rtaAgents[0]
+
+		// Kill action runs on the same pmm-agent as RTA agent with the same credentials.
+		dsn, agent, err = models.FindDSNByServiceIDandPMMAgentID(tx.Querier, req.ServiceId, *rtaAgent.PMMAgentID, "")
+		if err != nil {
+			return err
+		}
+
+		res, err = models.CreateActionResult(tx.Querier, *rtaAgent.PMMAgentID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	files := agent.Files()
+	tdp := agent.TemplateDelimiters(service)
+	secrets := agent.Secrets()
+
+	switch service.ServiceType {
+	case models.MongoDBServiceType:
+		opID, e := strconv.ParseInt(req.QueryId, 10, 64)
+		if e != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid MongoDB operation ID %q: %v", req.QueryId, e)
+		}
+		err = s.actions.StartMongoDBKillOpAction(ctx, res.ID, *rtaAgent.PMMAgentID, dsn, opID, files, tdp, secrets)
+	case models.MySQLServiceType:
+		d := query.GetMysqlPayload()
+		if d == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Query %s has no MySQL data", req.QueryId)
+		}
+
+		// The processlist has no statement identifier, so the statement is recognized by its start time.
+		var queryStart time.Time
+		if d.EventId == 0 {
+			queryStart = query.QueryCollectTime.AsTime().Add(-query.QueryExecutionDuration.AsDuration())
+		}
+		err = s.actions.StartMySQLKillQueryAction(ctx, res.ID, *rtaAgent.PMMAgentID, dsn,
+			d.ConnectionId, d.ThreadId, d.EventId, queryStart, files, tdp, agent.TLSSkipVerify, secrets)
+	case models.PostgreSQLServiceType:
+		d := query.GetPostgresqlPayload()
+		if d == nil || d.QueryStart == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Query %s has no PostgreSQL data", req.QueryId)
+		}
+		err = s.actions.StartPostgreSQLCancelBackendAction(ctx, res.ID, *rtaAgent.PMMAgentID, dsn, d.Pid, d.QueryStart.AsTime(), files, tdp, secrets)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Service %s of type %s does not support Real-Time Analytics",
+			req.ServiceId, service.ServiceType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = services.WaitForActionResult(ctx, s.db.Querier, res.ID); err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return nil, status.Errorf(codes.DeadlineExceeded, "Query %s was not killed in time, it may still be killed later.", req.QueryId)
+		}
+		return nil, status.Errorf(codes.FailedPrecondition, "Failed to kill query %s: %v", req.QueryId, err)
+	}
+
+	text := fmt.Sprintf("Real-Time Analytics: query %s was killed", req.QueryId)
+	if user := audit.UserFromContext(ctx); user.Login != "" {
+		text += " by " + user.Login
+	}
+
+	// The query is already killed at this point, so failing to record the annotation
+	// shall not make the user think the kill failed and retry it.
+	_, err = s.annotationService.AddAnnotation(ctx, &managementv1.AddAnnotationRequest{
+		Text:         text,
+		Tags:         []string{killQueryAnnotationTag},
+		ServiceNames: []string{service.ServiceName},
+	})
+	if err != nil {
+		logger.Get(ctx).Errorf("Failed to add annotation for killed query %s of service %s: %v", req.QueryId, req.ServiceId, err)
+	}
+
+	return &rtav1.KillQueryResponse{}, nil
+}
+
+// ReplaySession returns Database Queries that were running at a point in time, as recorded by Real-Time Analytics (gRPC handler).
+func (s *Service) ReplaySession(ctx context.Context, req *rtav1.ReplaySessionRequest) (*rtav1.ReplaySessionResponse, error) {
+	if s.recorder == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Real-Time Analytics recorder is disabled.")
+	}
+
+	// Services are not validated: recorded queries of already removed services may be replayed too.
+	queries, err := s.recorder.Replay(ctx, req.ServiceIds, req.Time.AsTime(), req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &rtav1.ReplaySessionResponse{Queries: queries}, nil
+}
+
 // Collect handles incoming streaming RTA query data from agents (gRPC handler).
 func (s *Service) Collect(stream grpc.ClientStreamingServer[rtav1.CollectRequest, rtav1.CollectResponse]) error {
 	streamCtx := stream.Context()
@@ -572,6 +803,10 @@ func (s *Service) Collect(stream grpc.ClientStreamingServer[rtav1.CollectRequest
 		// Store received queries into the in-memory storage.
 		// All queries in the message belong to the same service.
 		s.store.Set(msg.Queries[0].ServiceId, msg.Queries)
+
+		if s.recorder != nil {
+			s.recorder.Record(msg.Queries)
+		}
 	}
 }
 
@@ -615,19 +850,32 @@ func getRTAAgentTypeForServiceType(serviceType models.ServiceType) (models.Agent
 	switch serviceType {
 	case models.MongoDBServiceType:
 		return models.RTAMongoDBAgentType, nil
+	case models.MySQLServiceType:
+		return models.RTAMySQLAgentType, nil
+	case models.PostgreSQLServiceType:
+		return models.RTAPostgreSQLAgentType, nil
 	default:
 		return "", fmt.Errorf("service of type %s does not support Real-Time Analytics", serviceType)
 	}
 }
 
-// isRtaFeatureSupported checks if the passed pmm-agent's version supporting RTA.
-func isRtaFeatureSupported(pmmAgentVersion string) bool {
+// isRtaFeatureSupported checks if the passed pmm-agent's version supports RTA for the given service type.
+func isRtaFeatureSupported(serviceType models.ServiceType, pmmAgentVersion string) bool {
 	versionParsed, versionParseErr := version.Parse(pmmAgentVersion)
 	if versionParseErr != nil {
 		return false
 	}
 
-	return versionParsed.IsFeatureSupported(version.MongoDBRtaAgentSupportVersion)
+	switch serviceType {
+	case models.MongoDBServiceType:
+		return versionParsed.IsFeatureSupported(version.MongoDBRtaAgentSupportVersion)
+	case models.MySQLServiceType:
+		return versionParsed.IsFeatureSupported(version.MySQLRtaAgentSupportVersion)
+	case models.PostgreSQLServiceType:
+		return versionParsed.IsFeatureSupported(version.PostgreSQLRtaAgentSupportVersion)
+	default:
+		return false
+	}
 }
 
 // check interfaces.
@@ -31,6 +31,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/grpc/test/bufconn"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -40,8 +41,10 @@ import (
 
 	agentv1 "github.com/percona/pmm/api/agent/v1"
 	inventoryv1 "github.com/percona/pmm/api/inventory/v1"
+	managementv1 "github.com/percona/pmm/api/management/v1"
 	rtav1 "github.com/percona/pmm/api/realtimeanalytics/v1"
 	"github.com/percona/pmm/managed/models"
+	"github.com/percona/pmm/managed/services/audit"
 	"github.com/percona/pmm/managed/utils/interceptors"
 	"github.com/percona/pmm/managed/utils/testdb"
 )
@@ -129,7 +132,7 @@ func TestListServices(t *testing.T) {
 	registry := newMockAgentsRegistry(t)
 	stateUpdater := newMockAgentsStateUpdater(t)
 	store := NewStore()
-	svc := NewService(db, registry, stateUpdater, store)
+	svc := NewService(db, registry, stateUpdater, nil, nil, store, nil)
 
 	t.Run("list all supported services", func(t *testing.T) {
 		resp, err := svc.ListServices(t.Context(), &rtav1.ListServicesRequest{})
@@ -193,6 +196,80 @@ func TestListServices(t *testing.T) {
 		require.Len(t, resp.Mongodb, 1)
 		assert.Equal(t, mongodbService.ServiceID, resp.Mongodb[0].ServiceId)
 	})
+
+	t.Run("list mysqlService with supported pmm-agent version", func(t *testing.T) {
+		node3, err := models.CreateNode(db.Querier, models.GenericNodeType, &models.CreateNodeParams{
+			NodeName: "test-node-3",
+		})
+		require.NoError(t, err)
+		mysqlService, err := models.AddNewService(db.Querier, models.MySQLServiceType, &models.AddDBMSServiceParams{
+			ServiceName: "mysql-new",
+			NodeID:      node3.NodeID,
+			Address:     new("127.0.0.3"),
+			Port:        new(uint16(3306)),
+		})
+		require.NoError(t, err)
+		pmmAgent3, err := models.CreatePMMAgent(db.Querier, node3.NodeID, nil)
+		require.NoError(t, err)
+
+		pmmAgent3.Version = new("3.8.0")
+		err = db.Update(pmmAgent3)
+		require.NoError(t, err)
+
+		_, err = models.CreateAgent(db.Querier, models.QANMySQLPerfSchemaAgentType, &models.CreateAgentParams{
+			PMMAgentID: pmmAgent3.AgentID,
+			ServiceID:  mysqlService.ServiceID,
+			Username:   "qan-user",
+			Password:   "qan-pass",
+		})
+		require.NoError(t, err)
+
+		resp, err := svc.ListServices(t.Context(), &rtav1.ListServicesRequest{
+			ServiceType: inventoryv1.ServiceType_SERVICE_TYPE_MYSQL_SERVICE,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Empty(t, resp.Mongodb)
+		require.Len(t, resp.Mysql, 1)
+		assert.Equal(t, mysqlService.ServiceID, resp.Mysql[0].ServiceId)
+	})
+
+	t.Run("list postgresqlService with supported pmm-agent version", func(t *testing.T) {
+		node4, err := models.CreateNode(db.Querier, models.GenericNodeType, &models.CreateNodeParams{
+			NodeName: "test-node-4",
+		})
+		require.NoError(t, err)
+		postgresqlService, err := models.AddNewService(db.Querier, models.PostgreSQLServiceType, &models.AddDBMSServiceParams{
+			ServiceName: "postgresql-new",
+			NodeID:      node4.NodeID,
+			Address:     new("127.0.0.4"),
+			Port:        new(uint16(5432)),
+		})
+		require.NoError(t, err)
+		pmmAgent4, err := models.CreatePMMAgent(db.Querier, node4.NodeID, nil)
+		require.NoError(t, err)
+
+		pmmAgent4.Version = new("3.8.0")
+		err = db.Update(pmmAgent4)
+		require.NoError(t, err)
+
+		_, err = models.CreateAgent(db.Querier, models.QANPostgreSQLPgStatementsAgentType, &models.CreateAgentParams{
+			PMMAgentID: pmmAgent4.AgentID,
+			ServiceID:  postgresqlService.ServiceID,
+			Username:   "qan-user",
+			Password:   "qan-pass",
+		})
+		require.NoError(t, err)
+
+		resp, err := svc.ListServices(t.Context(), &rtav1.ListServicesRequest{
+			ServiceType: inventoryv1.ServiceType_SERVICE_TYPE_POSTGRESQL_SERVICE,
+		})
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+		require.Empty(t, resp.Mysql)
+		require.Len(t, resp.Postgresql, 1)
+		assert.Equal(t, postgresqlService.ServiceID, resp.Postgresql[0].ServiceId)
+	})
 }
 
 func TestListSessions(t *testing.T) {
@@ -235,7 +312,7 @@ func TestListSessions(t *testing.T) {
 	t.Run("list running sessions", func(t *testing.T) {
 		registry := newMockAgentsRegistry(t)
 		registry.On("IsConnected", pmmAgent.AgentID).Return(true)
-		svc := NewService(db, registry, stateUpdater, store)
+		svc := NewService(db, registry, stateUpdater, nil, nil, store, nil)
 
 		rtaAgent.Status = inventoryv1.AgentStatus_name[int32(inventoryv1.AgentStatus_AGENT_STATUS_RUNNING)]
 		err = db.Update(rtaAgent)
@@ -254,7 +331,7 @@ func TestListSessions(t *testing.T) {
 	t.Run("filter sessions by cluster", func(t *testing.T) {
 		registry := newMockAgentsRegistry(t)
 		registry.On("IsConnected", pmmAgent.AgentID).Return(true)
-		svc := NewService(db, registry, stateUpdater, store)
+		svc := NewService(db, registry, stateUpdater, nil, nil, store, nil)
 
 		resp, err := svc.ListSessions(t.Context(), &rtav1.ListSessionsRequest{ClusterName: "test-cluster"})
 		require.NoError(t, err)
@@ -268,7 +345,7 @@ func TestListSessions(t *testing.T) {
 	t.Run("show disconnected agents with unknown status", func(t *testing.T) {
 		registry := newMockAgentsRegistry(t)
 		registry.On("IsConnected", pmmAgent.AgentID).Return(false)
-		svc := NewService(db, registry, stateUpdater, store)
+		svc := NewService(db, registry, stateUpdater, nil, nil, store, nil)
 
 		resp, err := svc.ListSessions(t.Context(), &rtav1.ListSessionsRequest{})
 		require.NoError(t, err)
@@ -320,7 +397,7 @@ func TestStartSession(t *testing.T) {
 	stateUpdater.On("RequestStateUpdate", mock.Anything, pmmAgent.AgentID).Return()
 
 	store := NewStore()
-	svc := NewService(db, registry, stateUpdater, store)
+	svc := NewService(db, registry, stateUpdater, nil, nil, store, nil)
 
 	t.Run("start session for single service", func(t *testing.T) {
 		resp, err := svc.StartSession(t.Context(), &rtav1.StartSessionRequest{
@@ -548,7 +625,7 @@ func TestStopSession(t *testing.T) {
 	stateUpdater.On("RequestStateUpdate", mock.Anything, pmmAgent.AgentID).Return()
 
 	store := NewStore()
-	svc := NewService(db, registry, stateUpdater, store)
+	svc := NewService(db, registry, stateUpdater, nil, nil, store, nil)
 
 	t.Run("stop session for single service", func(t *testing.T) {
 		resp, err := svc.StopSession(t.Context(), &rtav1.StopSessionRequest{
@@ -652,6 +729,158 @@ func TestStopSession(t *testing.T) {
 	})
 }
 
+func TestKillQuery(t *testing.T) {
+	sqlDB := testdb.Open(t, models.SkipFixtures, nil)
+	db := reform.NewDB(sqlDB, postgresql.Dialect, reform.NewPrintfLogger(t.Logf))
+
+	// Create test data
+	node, err := models.CreateNode(db.Querier, models.GenericNodeType, &models.CreateNodeParams{
+		NodeName: "test-node",
+	})
+	require.NoError(t, err)
+
+	pmmAgent, err := models.CreatePMMAgent(db.Querier, node.NodeID, nil)
+	require.NoError(t, err)
+
+	service1, err := models.AddNewService(db.Querier, models.MongoDBServiceType, &models.AddDBMSServiceParams{
+		ServiceName: "mongodb-1",
+		NodeID:      node.NodeID,
+		Address:     new("127.0.0.1"),
+		Port:        new(uint16(27017)),
+	})
+	require.NoError(t, err)
+
+	service2, err := models.AddNewService(db.Querier, models.MongoDBServiceType, &models.AddDBMSServiceParams{
+		ServiceName: "mongodb-2",
+		NodeID:      node.NodeID,
+		Address:     new("127.0.0.2"),
+		Port:        new(uint16(27017)),
+	})
+	require.NoError(t, err)
+
+	service3, err := models.AddNewService(db.Querier, models.MySQLServiceType, &models.AddDBMSServiceParams{
+		ServiceName: "mysql-1",
+		NodeID:      node.NodeID,
+		Address:     new("127.0.0.3"),
+		Port:        new(uint16(3306)),
+	})
+	require.NoError(t, err)
+
+	// service1 and service3 have running RTA sessions, service2 has stopped one.
+	for _, params := range []struct {
+		agentType models.AgentType
+		serviceID string
+		disabled  bool
+	}{
+		{models.RTAMongoDBAgentType, service1.ServiceID, false},
+		{models.RTAMongoDBAgentType, service2.ServiceID, true},
+		{models.RTAMySQLAgentType, service3.ServiceID, false},
+	} {
+		_, err = models.CreateAgent(db.Querier, params.agentType, &models.CreateAgentParams{
+			PMMAgentID: pmmAgent.AgentID,
+			ServiceID:  params.serviceID,
+			Username:   "test-user",
+			Password:   "test-pass",
+			Disabled:   params.disabled,
+			RTAOptions: models.RTAOptions{CollectInterval: new(2 * time.Second)},
+		})
+		require.NoError(t, err)
+	}
+
+	store := NewStore()
+	store.Set(service1.ServiceID, []*rtav1.QueryData{{ServiceId: service1.ServiceID, QueryId: "12345"}})
+	store.Set(service2.ServiceID, []*rtav1.QueryData{{ServiceId: service2.ServiceID, QueryId: "12345"}})
+	store.Set(service3.ServiceID, []*rtav1.QueryData{{
+		ServiceId: service3.ServiceID,
+		QueryId:   "81-1234",
+		Payload: &rtav1.QueryData_MysqlPayload{MysqlPayload: &rtav1.QueryMySQLData{
+			ConnectionId: 42,
+			ThreadId:     81,
+			EventId:      1234,
+			Source:       "performance_schema",
+		}},
+	}})
+
+	actions := newMockActionsService(t)
+	annotations := newMockAnnotationService(t)
+	svc := NewService(db, newMockAgentsRegistry(t), newMockAgentsStateUpdater(t), actions, annotations, store, nil)
+
+	// finishAction makes pmm-agent report the result of the started action.
+	finishAction := func(aError string) func(mock.Arguments) {
+		return func(args mock.Arguments) {
+			require.NoError(t, models.ChangeActionResult(db.Querier, args.String(1), pmmAgent.AgentID, aError, "", true))
+		}
+	}
+
+	t.Run("kill running query", func(t *testing.T) {
+		actions.On("StartMongoDBKillOpAction", mock.Anything, mock.Anything, pmmAgent.AgentID, mock.Anything, int64(12345),
+			mock.Anything, mock.Anything, mock.Anything).Run(finishAction("")).Return(nil).Once()
+		annotations.On("AddAnnotation", mock.Anything, mock.MatchedBy(func(req *managementv1.AddAnnotationRequest) bool {
+			return req.Text == "Real-Time Analytics: query 12345 was killed by alice" &&
+				assert.ObjectsAreEqual([]string{killQueryAnnotationTag}, req.Tags) &&
+				assert.ObjectsAreEqual([]string{service1.ServiceName}, req.ServiceNames)
+		})).Return(&managementv1.AddAnnotationResponse{}, nil).Once()
+
+		user, err := audit.EncodeUser(&audit.User{ID: 2, Login: "alice", Role: "Admin"})
+		require.NoError(t, err)
+		ctx := metadata.NewIncomingContext(t.Context(), metadata.Pairs(audit.UserHeaderName, user))
+
+		resp, err := svc.KillQuery(ctx, &rtav1.KillQueryRequest{
+			ServiceId: service1.ServiceID,
+			QueryId:   "12345",
+		})
+		require.NoError(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("kill running MySQL statement", func(t *testing.T) {
+		actions.On("StartMySQLKillQueryAction", mock.Anything, mock.Anything, pmmAgent.AgentID, mock.Anything,
+			uint64(42), uint64(81), uint64(1234), time.Time{}, mock.Anything, mock.Anything, false, mock.Anything).Run(finishAction("")).Return(nil).Once()
+		annotations.On("AddAnnotation", mock.Anything, mock.MatchedBy(func(req *managementv1.AddAnnotationRequest) bool {
+			return req.Text == "Real-Time Analytics: query 81-1234 was killed"
+		})).Return(&managementv1.AddAnnotationResponse{}, nil).Once()
+
+		resp, err := svc.KillQuery(t.Context(), &rtav1.KillQueryRequest{
+			ServiceId: service3.ServiceID,
+			QueryId:   "81-1234",
+		})
+		require.NoError(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("failed kill is not annotated", func(t *testing.T) {
+		actions.On("StartMySQLKillQueryAction", mock.Anything, mock.Anything, pmmAgent.AgentID, mock.Anything,
+			uint64(42), uint64(81), uint64(1234), time.Time{}, mock.Anything, mock.Anything, false, mock.Anything).
+			Run(finishAction("statement is not running on connection 42 anymore")).Return(nil).Once()
+
+		_, err := svc.KillQuery(t.Context(), &rtav1.KillQueryRequest{
+			ServiceId: service3.ServiceID,
+			QueryId:   "81-1234",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+		assert.Contains(t, status.Convert(err).Message(), "statement is not running on connection 42 anymore")
+	})
+
+	t.Run("error on query that is not running", func(t *testing.T) {
+		_, err := svc.KillQuery(t.Context(), &rtav1.KillQueryRequest{
+			ServiceId: service1.ServiceID,
+			QueryId:   "54321",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.NotFound, status.Convert(err).Code())
+	})
+
+	t.Run("error on stopped session", func(t *testing.T) {
+		_, err := svc.KillQuery(t.Context(), &rtav1.KillQueryRequest{
+			ServiceId: service2.ServiceID,
+			QueryId:   "12345",
+		})
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Convert(err).Code())
+	})
+}
+
 func TestSearchQueries(t *testing.T) {
 	t.Parallel()
 
@@ -686,7 +915,7 @@ func TestSearchQueries(t *testing.T) {
 	registry := newMockAgentsRegistry(t)
 	stateUpdater := newMockAgentsStateUpdater(t)
 	store := NewStore()
-	svc := NewService(db, registry, stateUpdater, store)
+	svc := NewService(db, registry, stateUpdater, nil, nil, store, nil)
 
 	// Populate store with static query data for service1
 	store.Set(service1.ServiceID, getServiceQueries(service1.ServiceID, service1.ServiceName, 2))
@@ -840,7 +1069,7 @@ func TestService_Collect(t *testing.T) {
 	registry := newMockAgentsRegistry(t)
 	stateUpdater := newMockAgentsStateUpdater(t)
 	store := NewStore()
-	svc := NewService(db, registry, stateUpdater, store)
+	svc := NewService(db, registry, stateUpdater, nil, nil, store, nil)
 	// // Create in-memory listener for testing
 	const bufSize = 1024 * 1024
 
@@ -75,17 +75,23 @@ type shard struct {
 //   - Without sharding: All writes compete for a single lock (bottleneck at ~100-200/sec)
 //   - With sharding: Writes are distributed across 256 shards (~4 writes/sec per shard)
 //     allowing the system to handle 10,000+ writes/sec with minimal lock contention.
+//
+// Changes of the stored queries are published to subscribers (see subscribe) as added/updated/finished events.
 type Store struct {
 	l      *logrus.Entry
 	shards [numShards]*shard // Fixed array of shards (no allocation overhead)
 	ttl    time.Duration
+
+	subsMu sync.RWMutex
+	subs   map[*subscriber]struct{}
 }
 
 // NewStore creates a new in-memory store for RTA query data.
 func NewStore() *Store {
 	s := &Store{
-		l:   logrus.WithField("component", "rta-store"),
-		ttl: defaultTTL,
+		l:    logrus.WithField("component", "rta-store"),
+		ttl:  defaultTTL,
+		subs: make(map[*subscriber]struct{}),
 	}
 
 	// Initialize all shards
@@ -150,10 +156,19 @@ func (s *Store) Set(serviceID string, queries []*rtav1.QueryData) {
 	shard.mu.Lock() // Lock ONLY this shard, not the entire store
 	defer shard.mu.Unlock()
 
+	var prev []*rtav1.QueryData
+	if bucket, exists := shard.buckets[serviceID]; exists {
+		prev = bucket.queries
+	}
+
 	shard.buckets[serviceID] = &queryBucket{
 		timestamp: time.Now(),
 		queries:   queries,
 	}
+
+	// Publishing under the shard lock keeps events of a single service ordered.
+	// It doesn't block as subscribers never wait for slow readers.
+	s.publish(serviceID, prev, queries)
 }
 
 // Get retrieves queries for a specific service.
@@ -201,6 +216,7 @@ func (s *Store) cleanup() {
 			// Check if bucket is expired
 			if bucket.timestamp.Before(cutoff) {
 				delete(shard.buckets, serviceID)
+				s.publish(serviceID, bucket.queries, nil)
 			}
 		}
 
@@ -216,9 +232,73 @@ func (s *Store) Clear(serviceID string) {
 	shard.mu.Lock()
 	defer shard.mu.Unlock()
 
+	if bucket, exists := shard.buckets[serviceID]; exists {
+		s.publish(serviceID, bucket.queries, nil)
+	}
+
 	delete(shard.buckets, serviceID) // Safe: delete on non-existent key is a no-op
 }
 
+// subscribe registers a new subscriber of query events matching the filter.
+// Currently running queries of the filtered services are sent to the subscriber as added right away.
+// The caller must call unsubscribe when done.
+func (s *Store) subscribe(filter queriesFilter) *subscriber {
+	sub := newSubscriber(filter)
+
+	s.subsMu.Lock()
+	s.subs[sub] = struct{}{}
+	s.subsMu.Unlock()
+
+	cutoff := time.Now().Add(-s.ttl)
+	for serviceID := range filter.serviceIDs {
+		shard := s.getShard(serviceID)
+
+		// Hold the shard lock so the snapshot is not interleaved with concurrent Set for the same service.
+		shard.mu.RLock()
+		if bucket, exists := shard.buckets[serviceID]; exists && !bucket.timestamp.Before(cutoff) {
+			sub.notify(serviceID, bucket.queries, nil)
+		}
+		shard.mu.RUnlock()
+	}
+
+	return sub
+}
+
+// unsubscribe removes the subscriber; it stops receiving events.
+func (s *Store) unsubscribe(sub *subscriber) {
+	s.subsMu.Lock()
+	defer s.subsMu.Unlock()
+
+	delete(s.subs, sub)
+}
+
+// publish sends changes of the service queries to all subscribers.
+// Queries present in prev but absent in current are reported as finished.
+func (s *Store) publish(serviceID string, prev, current []*rtav1.QueryData) {
+	s.subsMu.RLock()
+	defer s.subsMu.RUnlock()
+
+	if len(s.subs) == 0 {
+		return
+	}
+
+	currentIDs := make(map[string]struct{}, len(current))
+	for _, q := range current {
+		currentIDs[q.QueryId] = struct{}{}
+	}
+
+	var finished []*rtav1.QueryData
+	for _, q := range prev {
+		if _, ok := currentIDs[q.QueryId]; !ok {
+			finished = append(finished, q)
+		}
+	}
+
+	for sub := range s.subs {
+		sub.notify(serviceID, current, finished)
+	}
+}
+
 // Stats returns the number of queries stored per service across all shards.
 func (s *Store) Stats() map[string]int {
 	stats := make(map[string]int)
@@ -0,0 +1,166 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package realtimeanalytics
+
+import (
+	"strings"
+	"sync"
+	"time"
+
+	rtav1 "github.com/percona/pmm/api/realtimeanalytics/v1"
+)
+
+// subscriberBufferSize is the number of event batches buffered for a single subscriber.
+// A subscriber that doesn't keep up is disconnected instead of stalling the store.
+const subscriberBufferSize = 64
+
+// queriesFilter contains per-subscriber filters for query events.
+type queriesFilter struct {
+	serviceIDs  map[string]struct{}
+	minDuration time.Duration
+	operation   string // lowercase
+}
+
+// newQueriesFilter creates queriesFilter from WatchQueries request.
+func newQueriesFilter(req *rtav1.WatchQueriesRequest) queriesFilter {
+	f := queriesFilter{
+		serviceIDs:  make(map[string]struct{}, len(req.ServiceIds)),
+		minDuration: req.MinDuration.AsDuration(),
+		operation:   strings.ToLower(strings.TrimSpace(req.Operation)),
+	}
+	for _, id := range req.ServiceIds {
+		f.serviceIDs[id] = struct{}{}
+	}
+
+	return f
+}
+
+// match returns true if query matches duration and operation filters.
+func (f *queriesFilter) match(q *rtav1.QueryData) bool {
+	if f.minDuration > 0 && q.QueryExecutionDuration.AsDuration() < f.minDuration {
+		return false
+	}
+
+	if f.operation != "" && queryOperation(q) != f.operation {
+		return false
+	}
+
+	return true
+}
+
+// queryOperation returns lowercase MongoDB operation or SQL statement type of the query.
+// For SQL it is the first keyword of the query text after leading comments.
+func queryOperation(q *rtav1.QueryData) string {
+	if p := q.GetMongoDbPayload(); p != nil {
+		return strings.ToLower(p.Operation)
+	}
+
+	text := strings.TrimSpace(q.QueryText)
+	for strings.HasPrefix(text, "/*") {
+		end := strings.Index(text, "*/")
+		if end < 0 {
+			return ""
+		}
+		text = strings.TrimSpace(text[end+2:])
+	}
+
+	text = strings.TrimLeft(text, "(")
+	if i := strings.IndexFunc(text, func(r rune) bool { return r == ' ' || r == '\t' || r == '\n' || r == '\r' || r == '(' }); i >= 0 {
+		text = text[:i]
+	}
+
+	return strings.ToLower(text)
+}
+
+// queryKey identifies a query across services.
+type queryKey struct {
+	serviceID string
+	queryID   string
+}
+
+// subscriber receives batches of query events matching its filter.
+type subscriber struct {
+	filter queriesFilter
+
+	events       chan []*rtav1.QueryEvent
+	overflow     chan struct{} // closed when events buffer overflows
+	overflowOnce sync.Once
+
+	mu   sync.Mutex
+	sent map[queryKey]struct{} // queries reported to the subscriber as added and not finished yet
+}
+
+func newSubscriber(filter queriesFilter) *subscriber {
+	return &subscriber{
+		filter:   filter,
+		events:   make(chan []*rtav1.QueryEvent, subscriberBufferSize),
+		overflow: make(chan struct{}),
+		sent:     make(map[queryKey]struct{}),
+	}
+}
+
+// notify converts the current and finished queries of the service into events for this subscriber.
+// It never blocks: if the subscriber's buffer is full, the overflow channel is closed.
+func (s *subscriber) notify(serviceID string, current, finished []*rtav1.QueryData) {
+	if _, ok := s.filter.serviceIDs[serviceID]; !ok {
+		return
+	}
+
+	select {
+	case <-s.overflow:
+		return
+	default:
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var events []*rtav1.QueryEvent
+	for _, q := range current {
+		if !s.filter.match(q) {
+			continue
+		}
+
+		key := queryKey{serviceID: serviceID, queryID: q.QueryId}
+		eventType := rtav1.QueryEventType_QUERY_EVENT_TYPE_UPDATED
+		if _, ok := s.sent[key]; !ok {
+			eventType = rtav1.QueryEventType_QUERY_EVENT_TYPE_ADDED
+			s.sent[key] = struct{}{}
+		}
+
+		events = append(events, &rtav1.QueryEvent{Type: eventType, Query: q})
+	}
+
+	for _, q := range finished {
+		key := queryKey{serviceID: serviceID, queryID: q.QueryId}
+		if _, ok := s.sent[key]; !ok {
+			continue
+		}
+
+		delete(s.sent, key)
+		events = append(events, &rtav1.QueryEvent{Type: rtav1.QueryEventType_QUERY_EVENT_TYPE_FINISHED, Query: q})
+	}
+
+	if len(events) == 0 {
+		return
+	}
+
+	select {
+	case s.events <- events:
+	default:
+		s.overflowOnce.Do(func() { close(s.overflow) })
+	}
+}
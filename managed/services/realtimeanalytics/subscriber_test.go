@@ -0,0 +1,170 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package realtimeanalytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	rtav1 "github.com/percona/pmm/api/realtimeanalytics/v1"
+)
+
+func newQuery(serviceID, queryID, text string, d time.Duration) *rtav1.QueryData {
+	return &rtav1.QueryData{
+		ServiceId:              serviceID,
+		QueryId:                queryID,
+		QueryText:              text,
+		QueryExecutionDuration: durationpb.New(d),
+	}
+}
+
+// receive returns events batch from the subscriber or fails the test if there is none.
+func receive(t *testing.T, sub *subscriber) map[string]rtav1.QueryEventType {
+	t.Helper()
+
+	select {
+	case events := <-sub.events:
+		res := make(map[string]rtav1.QueryEventType, len(events))
+		for _, e := range events {
+			res[e.Query.QueryId] = e.Type
+		}
+		return res
+	default:
+		require.Fail(t, "no events")
+		return nil
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Events", func(t *testing.T) {
+		t.Parallel()
+
+		store := NewStore()
+		store.Set("service1", []*rtav1.QueryData{newQuery("service1", "q1", "SELECT 1", time.Second)})
+
+		sub := store.subscribe(newQueriesFilter(&rtav1.WatchQueriesRequest{ServiceIds: []string{"service1"}}))
+		defer store.unsubscribe(sub)
+
+		// currently running queries are sent right away
+		assert.Equal(t, map[string]rtav1.QueryEventType{
+			"q1": rtav1.QueryEventType_QUERY_EVENT_TYPE_ADDED,
+		}, receive(t, sub))
+
+		store.Set("service1", []*rtav1.QueryData{
+			newQuery("service1", "q1", "SELECT 1", 2*time.Second),
+			newQuery("service1", "q2", "SELECT 2", time.Second),
+		})
+		assert.Equal(t, map[string]rtav1.QueryEventType{
+			"q1": rtav1.QueryEventType_QUERY_EVENT_TYPE_UPDATED,
+			"q2": rtav1.QueryEventType_QUERY_EVENT_TYPE_ADDED,
+		}, receive(t, sub))
+
+		store.Set("service1", []*rtav1.QueryData{newQuery("service1", "q2", "SELECT 2", 2*time.Second)})
+		assert.Equal(t, map[string]rtav1.QueryEventType{
+			"q1": rtav1.QueryEventType_QUERY_EVENT_TYPE_FINISHED,
+			"q2": rtav1.QueryEventType_QUERY_EVENT_TYPE_UPDATED,
+		}, receive(t, sub))
+
+		// other services are not sent
+		store.Set("service2", []*rtav1.QueryData{newQuery("service2", "q3", "SELECT 3", time.Second)})
+		assert.Empty(t, sub.events)
+
+		store.Clear("service1")
+		assert.Equal(t, map[string]rtav1.QueryEventType{
+			"q2": rtav1.QueryEventType_QUERY_EVENT_TYPE_FINISHED,
+		}, receive(t, sub))
+	})
+
+	t.Run("Filters", func(t *testing.T) {
+		t.Parallel()
+
+		store := NewStore()
+		sub := store.subscribe(newQueriesFilter(&rtav1.WatchQueriesRequest{
+			ServiceIds:  []string{"service1"},
+			MinDuration: durationpb.New(time.Second),
+			Operation:   "SELECT",
+		}))
+		defer store.unsubscribe(sub)
+
+		store.Set("service1", []*rtav1.QueryData{
+			newQuery("service1", "q1", "select * from t", 100*time.Millisecond),
+			newQuery("service1", "q2", "UPDATE t SET a = 1", 5*time.Second),
+		})
+		assert.Empty(t, sub.events)
+
+		// the query is added once it runs long enough
+		store.Set("service1", []*rtav1.QueryData{
+			newQuery("service1", "q1", "select * from t", 1500*time.Millisecond),
+			newQuery("service1", "q2", "UPDATE t SET a = 1", 6*time.Second),
+		})
+		assert.Equal(t, map[string]rtav1.QueryEventType{
+			"q1": rtav1.QueryEventType_QUERY_EVENT_TYPE_ADDED,
+		}, receive(t, sub))
+
+		// finish of never sent query is not sent
+		store.Set("service1", []*rtav1.QueryData{newQuery("service1", "q1", "select * from t", 2*time.Second)})
+		assert.Equal(t, map[string]rtav1.QueryEventType{
+			"q1": rtav1.QueryEventType_QUERY_EVENT_TYPE_UPDATED,
+		}, receive(t, sub))
+	})
+
+	t.Run("Overflow", func(t *testing.T) {
+		t.Parallel()
+
+		store := NewStore()
+		sub := store.subscribe(newQueriesFilter(&rtav1.WatchQueriesRequest{ServiceIds: []string{"service1"}}))
+		defer store.unsubscribe(sub)
+
+		// slow subscriber doesn't block the store
+		for range subscriberBufferSize + 1 {
+			store.Set("service1", []*rtav1.QueryData{newQuery("service1", "q1", "SELECT 1", time.Second)})
+		}
+
+		assert.Len(t, sub.events, subscriberBufferSize)
+		select {
+		case <-sub.overflow:
+		default:
+			assert.Fail(t, "overflow channel is not closed")
+		}
+	})
+}
+
+func TestQueryOperation(t *testing.T) {
+	t.Parallel()
+
+	for text, expected := range map[string]string{
+		"SELECT * FROM t":                         "select",
+		"  update t set a = 1":                    "update",
+		"/* comment */ /* other */ DELETE FROM t": "delete",
+		"(SELECT 1) UNION (SELECT 2)":             "select",
+		"/* unterminated":                         "",
+		"":                                        "",
+	} {
+		assert.Equal(t, expected, queryOperation(&rtav1.QueryData{QueryText: text}), text)
+	}
+
+	q := &rtav1.QueryData{
+		QueryText: `{"find": "users"}`,
+		Payload:   &rtav1.QueryData_MongoDbPayload{MongoDbPayload: &rtav1.QueryMongoDBData{Operation: "find"}},
+	}
+	assert.Equal(t, "find", queryOperation(q))
+}
@@ -18,9 +18,20 @@ package scheduler
 import (
 	"context"
 
+	"github.com/percona/pmm/managed/models"
 	"github.com/percona/pmm/managed/services/backup"
+	"github.com/percona/pmm/managed/services/ha"
 )
 
 type backupService interface {
 	PerformBackup(ctx context.Context, params backup.PerformBackupParams) (string, error)
+	VerifyArtifact(ctx context.Context, artifactID string) error
+}
+
+// haService is a subset of methods of ha.Service used by this package.
+type haService interface {
+	SetScheduledTask(task *models.ScheduledTask) error
+	DeleteScheduledTask(id string) error
+	ScheduledTask(id string) (*models.ScheduledTask, bool, error)
+	OnInvalidate(kind ha.StateKind, fn ha.InvalidateFunc)
 }
@@ -43,13 +43,30 @@ func (_m *mockBackupService) PerformBackup(ctx context.Context, params backup.Pe
 	return r0, r1
 }
 
+// VerifyArtifact provides a mock function with given fields: ctx, artifactID
+func (_m *mockBackupService) VerifyArtifact(ctx context.Context, artifactID string) error {
+	ret := _m.Called(ctx, artifactID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyArtifact")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, artifactID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // newMockBackupService creates a new instance of mockBackupService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func newMockBackupService(t interface {
 	mock.TestingT
 	Cleanup(func())
-},
-) *mockBackupService {
+}) *mockBackupService {
 	mock := &mockBackupService{}
 	mock.Mock.Test(t)
 
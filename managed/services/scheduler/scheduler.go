@@ -19,6 +19,7 @@ package scheduler
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
@@ -30,6 +31,7 @@ import (
 
 	"github.com/percona/pmm/managed/models"
 	"github.com/percona/pmm/managed/services"
+	"github.com/percona/pmm/managed/services/ha"
 )
 
 // Service is responsible for executing tasks and storing them to DB.
@@ -37,6 +39,13 @@ type Service struct {
 	db            *reform.DB
 	l             *logrus.Entry
 	backupService backupService
+	haService     haService
+
+	// reload is signaled when scheduled tasks are changed by another node.
+	reload chan struct{}
+
+	changedMx sync.Mutex
+	changed   map[string]struct{} // IDs of tasks changed by another node, empty ID means all tasks
 
 	mx        sync.Mutex
 	scheduler *gocron.Scheduler
@@ -49,18 +58,34 @@ type Service struct {
 }
 
 // New creates new scheduler service.
-func New(db *reform.DB, backupService backupService) *Service {
+func New(db *reform.DB, backupService backupService, haService haService) *Service {
 	scheduler := gocron.NewScheduler(time.UTC)
 	scheduler.TagsUnique()
 	scheduler.WaitForScheduleAll()
-	return &Service{
+	s := &Service{
 		db:            db,
 		scheduler:     scheduler,
 		l:             logrus.WithField("component", "scheduler"),
 		backupService: backupService,
+		haService:     haService,
+		reload:        make(chan struct{}, 1),
+		changed:       make(map[string]struct{}),
 		tasks:         make(map[string]context.CancelFunc),
 		jobs:          make(map[string]*gocron.Job),
 	}
+
+	haService.OnInvalidate(ha.StateScheduledTasks, func(id string) {
+		s.changedMx.Lock()
+		s.changed[id] = struct{}{}
+		s.changedMx.Unlock()
+
+		select {
+		case s.reload <- struct{}{}:
+		default:
+		}
+	})
+
+	return s
 }
 
 // Run loads tasks from DB and starts scheduler.
@@ -70,8 +95,19 @@ func (s *Service) Run(ctx context.Context) {
 		s.l.Warn(err)
 	}
 	s.scheduler.StartAsync()
-	<-ctx.Done()
-	s.scheduler.Stop()
+	for {
+		select {
+		case <-s.reload:
+			s.l.Debug("Scheduled tasks were changed by another node, applying changes.")
+			err := s.applyChanged() //nolint:contextcheck
+			if err != nil {
+				s.l.Warn(err)
+			}
+		case <-ctx.Done():
+			s.scheduler.Stop()
+			return
+		}
+	}
 }
 
 // AddParams contains parameters for adding new add to service.
@@ -129,7 +165,12 @@ func (s *Service) Add(task Task, params AddParams) (*models.ScheduledTask, error
 
 		return nil
 	})
-	return scheduledTask, errTx
+	if errTx != nil {
+		return nil, errTx
+	}
+
+	s.replicate(scheduledTask)
+	return scheduledTask, nil
 }
 
 // Remove stops task specified by id and removes it from DB and scheduler.
@@ -155,18 +196,22 @@ func (s *Service) Remove(id string) error {
 	_ = s.scheduler.RemoveByTag(id)
 	s.mx.Unlock()
 
+	if err = s.haService.DeleteScheduledTask(id); err != nil {
+		s.l.Warnf("Failed to replicate removal of scheduled task %s: %v", id, err)
+	}
 	return nil
 }
 
 // Update changes scheduled task in DB and re-add it to scheduler.
 func (s *Service) Update(id string, params models.ChangeScheduledTaskParams) error {
-	return s.db.InTransactionContext(s.db.Context(), &sql.TxOptions{Isolation: sql.LevelSerializable}, func(tx *reform.TX) error {
+	var scheduledTask *models.ScheduledTask
+	err := s.db.InTransactionContext(s.db.Context(), &sql.TxOptions{Isolation: sql.LevelSerializable}, func(tx *reform.TX) error {
 		err := checkUpdatePreconditions(tx.Querier, params.Data, !pointer.GetBool(params.Disable), id)
 		if err != nil {
 			return err
 		}
 
-		scheduledTask, err := models.ChangeScheduledTask(tx.Querier, id, params)
+		scheduledTask, err = models.ChangeScheduledTask(tx.Querier, id, params)
 		if err != nil {
 			return err
 		}
@@ -178,6 +223,66 @@ func (s *Service) Update(id string, params models.ChangeScheduledTaskParams) err
 
 		return s.addDBTask(scheduledTask)
 	})
+	if err != nil {
+		return err
+	}
+
+	s.replicate(scheduledTask)
+	return nil
+}
+
+// replicate shares the scheduled task changed by this node with other nodes in HA mode.
+func (s *Service) replicate(task *models.ScheduledTask) {
+	err := s.haService.SetScheduledTask(task)
+	if err != nil {
+		s.l.Warnf("Failed to replicate scheduled task %s: %v", task.ID, err)
+	}
+}
+
+// applyChanged applies scheduled tasks changed by other nodes, using their replicated copies.
+// Tasks missing in the replicated state are checked in the database, and all tasks are reloaded from it
+// when the whole replicated state is replaced.
+func (s *Service) applyChanged() error {
+	s.changedMx.Lock()
+	changed := s.changed
+	s.changed = make(map[string]struct{})
+	s.changedMx.Unlock()
+
+	if _, ok := changed[""]; ok {
+		return s.loadFromDB()
+	}
+
+	for id := range changed {
+		task, ok, err := s.haService.ScheduledTask(id)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			task, err = models.FindScheduledTaskByID(s.db.Querier, id)
+			if err != nil && !errors.Is(err, models.ErrNotFound) {
+				return err
+			}
+		}
+
+		s.mx.Lock()
+		_ = s.scheduler.RemoveByTag(id)
+		s.mx.Unlock()
+
+		s.jobsMx.Lock()
+		delete(s.jobs, id)
+		s.jobsMx.Unlock()
+
+		// removed by another node
+		if task == nil {
+			continue
+		}
+
+		if err = s.addDBTask(task); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 func (s *Service) loadFromDB() error {
@@ -348,6 +453,38 @@ func (s *Service) convertDBTask(dbTask *models.ScheduledTask) (Task, error) { //
 				Folder:        data.Folder,
 			},
 		}
+	case models.ScheduledPostgreSQLBackupTask:
+		data := dbTask.Data.PostgreSQLBackupTask
+		task = &postgreSQLBackupTask{
+			common: common{
+				id: dbTask.ID,
+			},
+			BackupTaskParams: &BackupTaskParams{
+				ServiceID:     data.ServiceID,
+				LocationID:    data.LocationID,
+				Name:          data.Name,
+				Description:   data.Description,
+				DataModel:     data.DataModel,
+				Mode:          data.Mode,
+				Retention:     data.Retention,
+				Retries:       data.Retries,
+				RetryInterval: data.RetryInterval,
+				Folder:        data.Folder,
+			},
+		}
+	case models.ScheduledVerificationTask:
+		data := dbTask.Data.VerificationTask
+		task = &verificationTask{
+			common: common{
+				id: dbTask.ID,
+			},
+			VerificationTaskParams: &VerificationTaskParams{
+				ServiceID:   data.ServiceID,
+				LocationID:  data.LocationID,
+				Name:        data.Name,
+				Description: data.Description,
+			},
+		}
 
 	default:
 		return nil, fmt.Errorf("unknown task type: %s", dbTask.Type)
@@ -363,6 +500,14 @@ func checkAddPreconditions(q *reform.Querier, data *models.ScheduledTaskData, en
 		if err != nil {
 			return err
 		}
+		if enabled {
+			return services.CheckMySQLBackupPreconditions(
+				q,
+				data.MySQLBackupTask.Mode,
+				data.MySQLBackupTask.ServiceID,
+				scheduledTaskID,
+			)
+		}
 	case data.MongoDBBackupTask != nil:
 		err := services.CheckArtifactOverlapping(q, data.MongoDBBackupTask.ServiceID, data.MongoDBBackupTask.LocationID, data.MongoDBBackupTask.Folder)
 		if err != nil {
@@ -377,13 +522,27 @@ func checkAddPreconditions(q *reform.Querier, data *models.ScheduledTaskData, en
 				scheduledTaskID,
 			)
 		}
+	case data.PostgreSQLBackupTask != nil:
+		err := services.CheckArtifactOverlapping(q, data.PostgreSQLBackupTask.ServiceID, data.PostgreSQLBackupTask.LocationID, data.PostgreSQLBackupTask.Folder)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
 func checkUpdatePreconditions(q *reform.Querier, data *models.ScheduledTaskData, enabled bool, scheduledTaskID string) error {
 	switch {
+	case data.PostgreSQLBackupTask != nil, data.VerificationTask != nil:
 	case data.MySQLBackupTask != nil:
+		if enabled {
+			return services.CheckMySQLBackupPreconditions(
+				q,
+				data.MySQLBackupTask.Mode,
+				data.MySQLBackupTask.ServiceID,
+				scheduledTaskID,
+			)
+		}
 	case data.MongoDBBackupTask != nil:
 		if enabled {
 			return services.CheckMongoDBBackupPreconditions(
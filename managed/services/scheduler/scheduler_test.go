@@ -28,6 +28,7 @@ import (
 	"gopkg.in/reform.v1/dialects/postgresql"
 
 	"github.com/percona/pmm/managed/models"
+	"github.com/percona/pmm/managed/services/ha"
 	"github.com/percona/pmm/managed/utils/testdb"
 	"github.com/percona/pmm/managed/utils/tests"
 )
@@ -66,7 +67,7 @@ func TestService(t *testing.T) {
 		require.NoError(t, err)
 
 		backupService := &mockBackupService{}
-		schedulerSvc := New(db, backupService)
+		schedulerSvc := New(db, backupService, ha.New(&models.HAParams{}))
 
 		go schedulerSvc.Run(ctx)
 		for !schedulerSvc.scheduler.IsRunning() {
@@ -91,7 +91,7 @@ func NewMySQLBackupTask(params *BackupTaskParams) (Task, error) { //nolint:iretu
 		return nil, err
 	}
 
-	if params.Mode != models.Snapshot {
+	if params.Mode != models.Snapshot && params.Mode != models.PITR {
 		return nil, fmt.Errorf("unsupported backup mode for MySQL: %s", params.Mode)
 	}
 
@@ -206,3 +206,144 @@ func (t *mongoDBBackupTask) Data() *models.ScheduledTaskData {
 		},
 	}
 }
+
+type postgreSQLBackupTask struct {
+	common
+	*BackupTaskParams
+}
+
+// NewPostgreSQLBackupTask create new task for PostgreSQL backup.
+func NewPostgreSQLBackupTask(params *BackupTaskParams) (Task, error) { //nolint:ireturn,nolintlint
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if params.Mode != models.Snapshot {
+		return nil, fmt.Errorf("unsupported backup mode for PostgreSQL: %s", params.Mode)
+	}
+
+	if params.DataModel != models.PhysicalDataModel && params.DataModel != models.LogicalDataModel {
+		return nil, fmt.Errorf("unsupported backup data model for PostgreSQL: %s", params.DataModel)
+	}
+
+	return &postgreSQLBackupTask{
+		BackupTaskParams: params,
+	}, nil
+}
+
+func (t *postgreSQLBackupTask) Run(ctx context.Context, scheduler *Service) error {
+	_, err := scheduler.backupService.PerformBackup(ctx, backup.PerformBackupParams{
+		ServiceID:     t.ServiceID,
+		LocationID:    t.LocationID,
+		Name:          t.Name,
+		DataModel:     t.DataModel,
+		Mode:          t.Mode,
+		ScheduleID:    t.ID(),
+		Retries:       t.Retries,
+		RetryInterval: t.RetryInterval,
+		Folder:        t.Folder,
+	})
+	return err
+}
+
+func (t *postgreSQLBackupTask) Type() models.ScheduledTaskType {
+	return models.ScheduledPostgreSQLBackupTask
+}
+
+func (t *postgreSQLBackupTask) Data() *models.ScheduledTaskData {
+	return &models.ScheduledTaskData{
+		PostgreSQLBackupTask: &models.PostgreSQLBackupTaskData{
+			CommonBackupTaskData: models.CommonBackupTaskData{
+				ServiceID:     t.ServiceID,
+				ClusterName:   t.ClusterName,
+				LocationID:    t.LocationID,
+				Name:          t.Name,
+				Description:   t.Description,
+				DataModel:     t.DataModel,
+				Mode:          t.Mode,
+				Retention:     t.Retention,
+				Retries:       t.Retries,
+				RetryInterval: t.RetryInterval,
+				Folder:        t.Folder,
+			},
+		},
+	}
+}
+
+// VerificationTaskParams contains fields for artifact verification task.
+type VerificationTaskParams struct {
+	ServiceID   string
+	LocationID  string
+	Name        string
+	Description string
+}
+
+// Validate checks verification task parameters for correctness.
+func (p *VerificationTaskParams) Validate() error {
+	if p.Name == "" {
+		return errors.New("verification name can't be empty")
+	}
+
+	if p.ServiceID == "" {
+		return errors.New("service id can't be empty")
+	}
+
+	if p.LocationID == "" {
+		return errors.New("location id can't be empty")
+	}
+
+	return nil
+}
+
+type verificationTask struct {
+	common
+	*VerificationTaskParams
+}
+
+// NewVerificationTask creates new task for verification of the latest artifact of the service.
+func NewVerificationTask(params *VerificationTaskParams) (Task, error) { //nolint:ireturn,nolintlint
+	err := params.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	return &verificationTask{
+		VerificationTaskParams: params,
+	}, nil
+}
+
+func (t *verificationTask) Run(ctx context.Context, scheduler *Service) error {
+	artifacts, err := models.FindArtifacts(scheduler.db.Querier, models.ArtifactFilters{
+		ServiceID:  t.ServiceID,
+		LocationID: t.LocationID,
+		Status:     models.SuccessBackupStatus,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Artifacts are sorted by creation time in descending order.
+	for _, artifact := range artifacts {
+		if artifact.Mode == models.Snapshot {
+			return scheduler.backupService.VerifyArtifact(ctx, artifact.ID)
+		}
+	}
+
+	return fmt.Errorf("no successful snapshot artifacts of service %s found in location %s", t.ServiceID, t.LocationID)
+}
+
+func (t *verificationTask) Type() models.ScheduledTaskType {
+	return models.ScheduledVerificationTask
+}
+
+func (t *verificationTask) Data() *models.ScheduledTaskData {
+	return &models.ScheduledTaskData{
+		VerificationTask: &models.VerificationTaskData{
+			ServiceID:   t.ServiceID,
+			LocationID:  t.LocationID,
+			Name:        t.Name,
+			Description: t.Description,
+		},
+	}
+}
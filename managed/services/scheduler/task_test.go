@@ -145,7 +145,7 @@ func TestValidation(t *testing.T) {
 				errMsg: "unsupported backup mode for MySQL: incremental",
 			},
 			{
-				name: "unsupported PITR backup mode",
+				name: "PITR backup mode",
 				params: &BackupTaskParams{
 					ServiceID:  "service-id",
 					LocationID: "location-id",
@@ -153,7 +153,7 @@ func TestValidation(t *testing.T) {
 					DataModel:  models.PhysicalDataModel,
 					Mode:       models.PITR,
 				},
-				errMsg: "unsupported backup mode for MySQL: pitr",
+				errMsg: "",
 			},
 		}
 
@@ -328,4 +328,131 @@ func TestValidation(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("PostgreSQL task", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name   string
+			params *BackupTaskParams
+			errMsg string
+		}{
+			{
+				name: "physical",
+				params: &BackupTaskParams{
+					ServiceID:  "service-id",
+					LocationID: "location-id",
+					Name:       "name",
+					DataModel:  models.PhysicalDataModel,
+					Mode:       models.Snapshot,
+				},
+				errMsg: "",
+			},
+			{
+				name: "logical",
+				params: &BackupTaskParams{
+					ServiceID:  "service-id",
+					LocationID: "location-id",
+					Name:       "name",
+					DataModel:  models.LogicalDataModel,
+					Mode:       models.Snapshot,
+				},
+				errMsg: "",
+			},
+			{
+				name: "empty name",
+				params: &BackupTaskParams{
+					ServiceID:  "service-id",
+					LocationID: "location-id",
+					Name:       "",
+					DataModel:  models.LogicalDataModel,
+					Mode:       models.Snapshot,
+				},
+				errMsg: "backup name can't be empty",
+			},
+			{
+				name: "unsupported PITR backup mode",
+				params: &BackupTaskParams{
+					ServiceID:  "service-id",
+					LocationID: "location-id",
+					Name:       "name",
+					DataModel:  models.LogicalDataModel,
+					Mode:       models.PITR,
+				},
+				errMsg: "unsupported backup mode for PostgreSQL: pitr",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				_, err := NewPostgreSQLBackupTask(tt.params)
+
+				if tt.errMsg != "" {
+					require.EqualError(t, err, tt.errMsg)
+					return
+				}
+
+				require.NoError(t, err)
+			})
+		}
+	})
+
+	t.Run("verification task", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name   string
+			params *VerificationTaskParams
+			errMsg string
+		}{
+			{
+				name: "normal",
+				params: &VerificationTaskParams{
+					ServiceID:  "service-id",
+					LocationID: "location-id",
+					Name:       "name",
+				},
+				errMsg: "",
+			},
+			{
+				name: "empty name",
+				params: &VerificationTaskParams{
+					ServiceID:  "service-id",
+					LocationID: "location-id",
+				},
+				errMsg: "verification name can't be empty",
+			},
+			{
+				name: "empty serviceID",
+				params: &VerificationTaskParams{
+					LocationID: "location-id",
+					Name:       "name",
+				},
+				errMsg: "service id can't be empty",
+			},
+			{
+				name: "empty locationID",
+				params: &VerificationTaskParams{
+					ServiceID: "service-id",
+					Name:      "name",
+				},
+				errMsg: "location id can't be empty",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				t.Parallel()
+				_, err := NewVerificationTask(tt.params)
+
+				if tt.errMsg != "" {
+					require.EqualError(t, err, tt.errMsg)
+					return
+				}
+
+				require.NoError(t, err)
+			})
+		}
+	})
 }
@@ -0,0 +1,226 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AlekSi/pointer"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	serverv1 "github.com/percona/pmm/api/server/v1"
+	"github.com/percona/pmm/managed/models"
+)
+
+const (
+	// defaultAuditPageSize is the page size of the audit events list if not specified in the request.
+	defaultAuditPageSize = 100
+
+	// auditExportBatchSize is the number of audit events loaded from the database at once during export.
+	auditExportBatchSize = 1000
+
+	// auditExportChunkSize is the approximate maximum size of exported data sent in a single message.
+	auditExportChunkSize = 64 * 1024
+)
+
+var auditExportContentTypes = map[serverv1.AuditExportFormat]string{
+	serverv1.AuditExportFormat_AUDIT_EXPORT_FORMAT_CSV:   "text/csv",
+	serverv1.AuditExportFormat_AUDIT_EXPORT_FORMAT_JSONL: "application/jsonl",
+}
+
+var auditCSVHeader = []string{
+	"event_id", "time", "user_id", "username", "role", "method", "target_ids", "request", "status_code", "error",
+}
+
+// auditEventFilters converts request filters to models.
+func auditEventFilters(startTime, endTime *timestamppb.Timestamp, username, method, targetID, statusCode string) models.AuditEventFilters {
+	filters := models.AuditEventFilters{
+		Username:   username,
+		Method:     method,
+		TargetID:   targetID,
+		StatusCode: statusCode,
+	}
+	if startTime != nil {
+		filters.StartTime = pointer.ToTime(startTime.AsTime())
+	}
+	if endTime != nil {
+		filters.EndTime = pointer.ToTime(endTime.AsTime())
+	}
+	return filters
+}
+
+func convertAuditEvent(e *models.AuditEvent) *serverv1.AuditEvent {
+	return &serverv1.AuditEvent{
+		EventId:    e.ID,
+		Time:       timestamppb.New(e.CreatedAt),
+		UserId:     e.UserID,
+		Username:   e.Username,
+		Role:       e.Role,
+		Method:     e.Method,
+		TargetIds:  e.TargetIDs,
+		Request:    e.Request,
+		StatusCode: e.StatusCode,
+		Error:      e.Error,
+	}
+}
+
+// ListAuditEvents returns audit log events of mutating API calls.
+func (s *Server) ListAuditEvents(ctx context.Context, req *serverv1.ListAuditEventsRequest) (*serverv1.ListAuditEventsResponse, error) {
+	pageIndex := int(req.GetPageIndex())
+	pageSize := defaultAuditPageSize
+	if req.PageSize != nil {
+		pageSize = int(req.GetPageSize())
+	}
+
+	filters := auditEventFilters(req.StartTime, req.EndTime, req.Username, req.Method, req.TargetId, req.StatusCode)
+
+	q := s.db.WithContext(ctx)
+	totalItems, err := models.CountAuditEvents(q, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	filters.Offset = pageIndex * pageSize
+	filters.Limit = pageSize
+	events, err := models.FindAuditEvents(q, filters)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := totalItems / pageSize
+	if totalItems%pageSize > 0 {
+		totalPages++
+	}
+
+	res := &serverv1.ListAuditEventsResponse{
+		TotalItems: int32(totalItems),
+		TotalPages: int32(totalPages),
+		Events:     make([]*serverv1.AuditEvent, 0, len(events)),
+	}
+	for _, e := range events {
+		res.Events = append(res.Events, convertAuditEvent(e))
+	}
+
+	return res, nil
+}
+
+// ExportAuditEvents streams audit log events in CSV or JSON Lines format.
+//
+// The gateway terminates each streamed message with a newline,
+// so every message contains whole lines without the trailing newline.
+func (s *Server) ExportAuditEvents(req *serverv1.ExportAuditEventsRequest, stream grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	contentType, ok := auditExportContentTypes[req.Format]
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "unsupported export format: %s", req.Format)
+	}
+
+	var lines []string
+	var size int
+	flush := func() error {
+		if len(lines) == 0 {
+			return nil
+		}
+		data := []byte(strings.Join(lines, "\n"))
+		lines, size = lines[:0], 0
+		return stream.Send(&httpbody.HttpBody{ContentType: contentType, Data: data})
+	}
+	add := func(line string) error {
+		lines = append(lines, line)
+		size += len(line) + 1
+		if size >= auditExportChunkSize {
+			return flush()
+		}
+		return nil
+	}
+
+	if req.Format == serverv1.AuditExportFormat_AUDIT_EXPORT_FORMAT_CSV {
+		line, err := csvLine(auditCSVHeader)
+		if err != nil {
+			return err
+		}
+		if err = add(line); err != nil {
+			return err
+		}
+	}
+
+	filters := auditEventFilters(req.StartTime, req.EndTime, req.Username, req.Method, req.TargetId, req.StatusCode)
+	filters.Limit = auditExportBatchSize
+	q := s.db.WithContext(stream.Context())
+	for {
+		events, err := models.FindAuditEvents(q, filters)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range events {
+			var line string
+			if req.Format == serverv1.AuditExportFormat_AUDIT_EXPORT_FORMAT_CSV {
+				line, err = csvLine([]string{
+					e.ID,
+					e.CreatedAt.Format(time.RFC3339Nano),
+					strconv.FormatInt(e.UserID, 10),
+					e.Username,
+					e.Role,
+					e.Method,
+					strings.Join(e.TargetIDs, " "),
+					e.Request,
+					e.StatusCode,
+					e.Error,
+				})
+			} else {
+				var b []byte
+				b, err = protojson.MarshalOptions{UseProtoNames: true}.Marshal(convertAuditEvent(e))
+				line = string(b)
+			}
+			if err != nil {
+				return err
+			}
+
+			if err = add(line); err != nil {
+				return err
+			}
+		}
+
+		if len(events) < filters.Limit {
+			return flush()
+		}
+		filters.Offset += len(events)
+	}
+}
+
+// csvLine returns a single CSV record without the trailing newline.
+func csvLine(record []string) (string, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(record); err != nil {
+		return "", err
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
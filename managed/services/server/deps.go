@@ -104,6 +104,9 @@ type templatesService interface {
 type haService interface {
 	IsLeader() bool
 	Params() *models.HAParams
+	ReplicationEnabled() bool
+	SetSettings(settings *models.Settings) error
+	Settings() (*models.Settings, bool, error)
 }
 
 // victoriaMetricsParams is a subset of methods of models.VMParams used by this package.
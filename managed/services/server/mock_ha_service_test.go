@@ -3,9 +3,8 @@
 package server
 
 import (
-	mock "github.com/stretchr/testify/mock"
-
 	models "github.com/percona/pmm/managed/models"
+	mock "github.com/stretchr/testify/mock"
 )
 
 // mockHaService is an autogenerated mock type for the haService type
@@ -51,13 +50,85 @@ func (_m *mockHaService) Params() *models.HAParams {
 	return r0
 }
 
+// ReplicationEnabled provides a mock function with no fields
+func (_m *mockHaService) ReplicationEnabled() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for ReplicationEnabled")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// SetSettings provides a mock function with given fields: settings
+func (_m *mockHaService) SetSettings(settings *models.Settings) error {
+	ret := _m.Called(settings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSettings")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Settings) error); ok {
+		r0 = rf(settings)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Settings provides a mock function with no fields
+func (_m *mockHaService) Settings() (*models.Settings, bool, error) {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for Settings")
+	}
+
+	var r0 *models.Settings
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func() (*models.Settings, bool, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() *models.Settings); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*models.Settings)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	if rf, ok := ret.Get(2).(func() error); ok {
+		r2 = rf()
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // newMockHaService creates a new instance of mockHaService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func newMockHaService(t interface {
 	mock.TestingT
 	Cleanup(func())
-},
-) *mockHaService {
+}) *mockHaService {
 	mock := &mockHaService{}
 	mock.Mock.Test(t)
 
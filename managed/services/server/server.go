@@ -134,13 +134,16 @@ func (s *Server) UpdateSettingsFromEnv(ctx context.Context, env []string) []erro
 		return errs
 	}
 
+	var settings *models.Settings
 	err := s.db.InTransactionContext(ctx, nil, func(tx *reform.TX) error {
-		_, err := models.UpdateSettings(tx, envSettings)
+		var err error
+		settings, err = models.UpdateSettings(tx, envSettings)
 		return err
 	})
 	if err != nil {
 		return []error{err}
 	}
+	s.replicateSettings(settings)
 	s.envSettings = envSettings
 	err = s.UpdateConfigurations(ctx)
 	if err != nil {
@@ -362,6 +365,8 @@ func (s *Server) convertSettings(settings *models.Settings, disableInternalPgQan
 
 		EnableAccessControl: settings.IsAccessControlEnabled(),
 		DefaultRoleId:       convertDefaultRoleID(settings.DefaultRoleID),
+
+		AuditLogRetention: durationpb.New(settings.AuditLog.Retention),
 	}
 
 	return res
@@ -397,7 +402,7 @@ func (s *Server) GetSettings(ctx context.Context, _ *serverv1.GetSettingsRequest
 	defer s.envRW.RUnlock()
 
 	dbCtx := s.db.WithContext(ctx)
-	settings, err := models.GetSettings(dbCtx)
+	settings, err := s.getSettings(dbCtx)
 	if err != nil {
 		return nil, err
 	}
@@ -426,7 +431,7 @@ func (s *Server) GetReadOnlySettings(ctx context.Context, _ *serverv1.GetReadOnl
 	s.envRW.RLock()
 	defer s.envRW.RUnlock()
 
-	settings, err := models.GetSettings(s.db.WithContext(ctx))
+	settings, err := s.getSettings(s.db.WithContext(ctx))
 	if err != nil {
 		return nil, err
 	}
@@ -530,8 +535,9 @@ func (s *Server) ChangeSettings(ctx context.Context, req *serverv1.ChangeSetting
 				MR: metricsRes.GetMr().AsDuration(),
 				LR: metricsRes.GetLr().AsDuration(),
 			},
-			DataRetention: req.DataRetention.AsDuration(),
-			SSHKey:        req.SshKey,
+			DataRetention:     req.DataRetention.AsDuration(),
+			AuditLogRetention: req.AuditLogRetention.AsDuration(),
+			SSHKey:            req.SshKey,
 		}
 
 		if req.AwsPartitions != nil {
@@ -578,6 +584,8 @@ func (s *Server) ChangeSettings(ctx context.Context, req *serverv1.ChangeSetting
 		return nil, err
 	}
 
+	s.replicateSettings(newSettings)
+
 	// If Advisors run intervals are changed reset timers.
 	if oldSettings.SaaS.AdvisorRunIntervals != newSettings.SaaS.AdvisorRunIntervals {
 		s.checksService.UpdateIntervals(
@@ -616,6 +624,30 @@ func (s *Server) ChangeSettings(ctx context.Context, req *serverv1.ChangeSetting
 	}, nil
 }
 
+// getSettings returns PMM Server settings. If state replication is enabled in HA mode,
+// followers use settings replicated by other nodes and fall back to the database if there are none yet.
+func (s *Server) getSettings(q reform.DBTX) (*models.Settings, error) {
+	if s.haService.ReplicationEnabled() && !s.haService.IsLeader() {
+		settings, ok, err := s.haService.Settings()
+		switch {
+		case err != nil:
+			s.l.Warnf("Failed to get replicated settings: %v", err)
+		case ok:
+			return settings, nil
+		}
+	}
+
+	return models.GetSettings(q)
+}
+
+// replicateSettings shares changed settings with other nodes in HA mode.
+func (s *Server) replicateSettings(settings *models.Settings) {
+	err := s.haService.SetSettings(settings)
+	if err != nil {
+		s.l.Warnf("Failed to replicate settings: %v", err)
+	}
+}
+
 func (s *Server) getInternalPgQANAgent(q *reform.Querier) (*models.Agent, error) {
 	agents, err := models.FindAgents(q, models.AgentFilters{
 		PMMAgentID: models.PMMServerAgentID,
@@ -81,6 +81,8 @@ func TestServer(t *testing.T) {
 		ha.Test(t)
 		ha.On("IsLeader").Return(true)
 		ha.On("Params").Return(&models.HAParams{Enabled: false})
+		ha.On("ReplicationEnabled").Return(false)
+		ha.On("SetSettings", mock.Anything).Return(nil)
 
 		s, err := NewServer(&Params{
 			DB:                   reform.NewDB(sqlDB, postgresql.Dialect, reform.NewPrintfLogger(t.Logf)),
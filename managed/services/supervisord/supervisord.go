@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"io/fs"
+	"net"
 	"net/url"
 	"os"
 	"os/exec"
@@ -42,6 +43,7 @@ import (
 const (
 	defaultClickhouseDatabase           = "pmm"
 	defaultClickhouseAddr               = "127.0.0.1:9000"
+	defaultClickhouseHTTPPort           = "8123"
 	defaultClickhouseUser               = "default"
 	defaultClickhousePassword           = "clickhouse"
 	defaultClickhouseDatasourceUser     = "grafana"
@@ -246,6 +248,7 @@ command =
 		--data-retention={{ .DataRetentionDays }}
 environment =
 	PMM_CLICKHOUSE_ADDR="{{ .ClickhouseAddr }}",
+	PMM_CLICKHOUSE_HTTP_ADDR="{{ .ClickhouseHTTPAddr }}",
 	PMM_CLICKHOUSE_DATABASE="{{ .ClickhouseDatabase }}",
 	PMM_CLICKHOUSE_USER="{{ .ClickhouseUser }}",
 	PMM_CLICKHOUSE_PASSWORD="{{ .ClickhousePassword }}",
@@ -359,6 +362,7 @@ func (s *Service) marshalConfig(tmpl *template.Template, settings *models.Settin
 	clickhouseDatabase := envvars.GetEnv("PMM_CLICKHOUSE_DATABASE", defaultClickhouseDatabase)
 	clickhouseAddr := envvars.GetEnv("PMM_CLICKHOUSE_ADDR", defaultClickhouseAddr)
 	clickhouseAddrPair := strings.SplitN(clickhouseAddr, ":", 2) //nolint:mnd
+	clickhouseHTTPAddr := envvars.GetEnv("PMM_CLICKHOUSE_HTTP_ADDR", net.JoinHostPort(clickhouseAddrPair[0], defaultClickhouseHTTPPort))
 	clickhouseUser := envvars.GetEnv("PMM_CLICKHOUSE_USER", defaultClickhouseUser)
 	clickhousePassword := envvars.GetEnv("PMM_CLICKHOUSE_PASSWORD", defaultClickhousePassword)
 	clickhouseDatasourceUser := envvars.GetEnv("PMM_CLICKHOUSE_DATASOURCE_USER", defaultClickhouseDatasourceUser)
@@ -393,6 +397,7 @@ func (s *Service) marshalConfig(tmpl *template.Template, settings *models.Settin
 		"NomadEnabled":                 settings.IsNomadEnabled(),
 		"InterfaceToBind":              envvars.GetInterfaceToBind(),
 		"ClickhouseAddr":               clickhouseAddr,
+		"ClickhouseHTTPAddr":           clickhouseHTTPAddr,
 		"ClickhouseDatabase":           clickhouseDatabase,
 		"ClickhouseHost":               clickhouseAddrPair[0],
 		"ClickhousePort":               clickhouseAddrPair[1],
@@ -22,6 +22,7 @@ import (
 	telemetryv1 "github.com/percona/platform/gen/telemetry/generic"
 
 	serverv1 "github.com/percona/pmm/api/server/v1"
+	"github.com/percona/pmm/managed/models"
 )
 
 // distributionUtilService service to get info about OS on which pmm server is running.
@@ -29,6 +30,12 @@ type distributionUtilService interface {
 	GetDistributionMethodAndOS() (serverv1.DistributionMethod, pmmv1.DistributionMethod, string)
 }
 
+// haService is a subset of methods of ha.Service used by this package.
+// We use it instead of real type for testing and to avoid dependency cycle.
+type haService interface {
+	SetSettings(settings *models.Settings) error
+}
+
 // sender is interface which defines method for client which sends report with metrics.
 type sender interface {
 	SendTelemetry(ctx context.Context, report *telemetryv1.ReportRequest) error
@@ -0,0 +1,45 @@
+// Code generated by mockery. DO NOT EDIT.
+
+package telemetry
+
+import (
+	models "github.com/percona/pmm/managed/models"
+	mock "github.com/stretchr/testify/mock"
+)
+
+// mockHaService is an autogenerated mock type for the haService type
+type mockHaService struct {
+	mock.Mock
+}
+
+// SetSettings provides a mock function with given fields: settings
+func (_m *mockHaService) SetSettings(settings *models.Settings) error {
+	ret := _m.Called(settings)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSettings")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*models.Settings) error); ok {
+		r0 = rf(settings)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// newMockHaService creates a new instance of mockHaService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func newMockHaService(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *mockHaService {
+	mock := &mockHaService{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -58,7 +58,8 @@ type Service struct {
 	sendCh              chan *telemetryv1.GenericReport
 	dataSourcesMap      map[DataSourceName]DataSource
 
-	dus distributionUtilService
+	dus       distributionUtilService
+	haService haService
 }
 
 // check interfaces.
@@ -88,7 +89,7 @@ func getLogger() *logrus.Entry {
 
 // NewService creates a new service.
 func NewService(db *reform.DB, portalClient *platform.Client, pmmVersion string,
-	dus distributionUtilService, config ServiceConfig,
+	dus distributionUtilService, haService haService, config ServiceConfig,
 ) (*Service, error) {
 	if config.SaasHostname == "" {
 		return nil, errors.New("empty host")
@@ -109,6 +110,7 @@ func NewService(db *reform.DB, portalClient *platform.Client, pmmVersion string,
 		config:       config,
 		dsRegistry:   registry,
 		dus:          dus,
+		haService:    haService,
 		sendCh:       make(chan *telemetryv1.GenericReport, sendChSize),
 	}
 
@@ -341,6 +343,7 @@ func (s *Service) locateDataSources(telemetryConfig []Config) map[DataSourceName
 
 func (s *Service) makeMetric(ctx context.Context) (*telemetryv1.GenericReport, error) {
 	var settings *models.Settings
+	var uuidGenerated bool
 	err := s.db.InTransactionContext(ctx, nil, func(tx *reform.TX) error {
 		var e error
 		settings, e = models.GetSettings(tx)
@@ -350,6 +353,7 @@ func (s *Service) makeMetric(ctx context.Context) (*telemetryv1.GenericReport, e
 
 		if settings.Telemetry.UUID == "" {
 			settings.Telemetry.UUID = uuid.NewString()
+			uuidGenerated = true
 			return models.SaveSettings(tx, settings)
 		}
 		return nil
@@ -358,6 +362,12 @@ func (s *Service) makeMetric(ctx context.Context) (*telemetryv1.GenericReport, e
 		return nil, err
 	}
 
+	if uuidGenerated {
+		if err = s.haService.SetSettings(settings); err != nil {
+			s.l.Warnf("Failed to replicate settings: %v", err)
+		}
+	}
+
 	serverID := settings.Telemetry.UUID
 
 	_, distMethod, _ := s.dus.GetDistributionMethodAndOS()
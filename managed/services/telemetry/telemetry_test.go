@@ -166,6 +166,9 @@ func TestRunTelemetryService(t *testing.T) {
 			registry, err := NewDataSourceRegistry(serviceConfig, logEntry)
 			require.NoError(t, err)
 
+			ha := newMockHaService(t)
+			ha.On("SetSettings", mock.Anything).Return(nil).Maybe()
+
 			s := Service{
 				db:                  db,
 				l:                   logEntry,
@@ -177,6 +180,7 @@ func TestRunTelemetryService(t *testing.T) {
 				sDistributionMethod: 0,
 				tDistributionMethod: 0,
 				dus:                 tt.fields.dus,
+				haService:           ha,
 				portalClient:        tt.mockTelemetrySender(),
 				sendCh:              make(chan *telemetryv1.GenericReport, sendChSize),
 			}
@@ -33,6 +33,7 @@ type Target struct {
 	DSN           string
 	Files         map[string]string
 	TDP           *models.DelimiterPair
+	Secrets       map[string]string
 	TLSSkipVerify bool
 }
 
@@ -54,6 +55,7 @@ func (t *Target) Copy() Target {
 		DSN:           t.DSN,
 		Files:         files,
 		TDP:           new(*t.TDP),
+		Secrets:       maps.Clone(t.Secrets),
 		TLSSkipVerify: t.TLSSkipVerify,
 	}
 }
@@ -38,6 +38,7 @@ func TestTarget_Copy(t1 *testing.T) {
 			Left:  "[",
 			Right: "]",
 		},
+		Secrets:       map[string]string{"password": "vault://kv/mysql#password"},
 		TLSSkipVerify: true,
 	}
 
@@ -55,6 +56,7 @@ func TestTarget_Copy(t1 *testing.T) {
 	newTarget.Files["new_file"] = "new_test"
 	newTarget.TDP.Left = "{"
 	newTarget.TDP.Right = "}"
+	newTarget.Secrets["password"] = "vault://kv/mysql#new_password"
 	newTarget.TLSSkipVerify = false
 
 	// Check that original target was unchanged
@@ -68,5 +70,6 @@ func TestTarget_Copy(t1 *testing.T) {
 	assert.Equal(t1, map[string]string{"file": "test"}, target.Files)
 	assert.Equal(t1, "[", target.TDP.Left)
 	assert.Equal(t1, "]", target.TDP.Right)
+	assert.Equal(t1, map[string]string{"password": "vault://kv/mysql#password"}, target.Secrets)
 	assert.True(t1, target.TLSSkipVerify)
 }
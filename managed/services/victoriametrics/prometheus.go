@@ -194,9 +194,11 @@ func AddScrapeConfigs(l *logrus.Entry, cfg *config.Config, q *reform.Querier, //
 			continue
 		case models.QANMongoDBProfilerAgentType, models.QANMongoDBMongologAgentType:
 			continue
-		case models.QANPostgreSQLPgStatementsAgentType, models.QANPostgreSQLPgStatMonitorAgentType:
+		case models.QANPostgreSQLPgStatementsAgentType, models.QANPostgreSQLPgStatMonitorAgentType, models.QANPostgreSQLLogAgentType:
 			continue
-		case models.RTAMongoDBAgentType:
+		case models.QANValkeySlowlogAgentType, models.QANProxySQLDigestAgentType:
+			continue
+		case models.RTAMongoDBAgentType, models.RTAMySQLAgentType, models.RTAPostgreSQLAgentType:
 			continue
 		case models.RDSExporterType:
 			if skipExternalAgents && pointer.GetString(agent.RunsOnNodeID) == models.PMMServerNodeID {
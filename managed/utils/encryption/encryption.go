@@ -18,6 +18,7 @@ package encryption
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
@@ -32,6 +33,8 @@ import (
 	"github.com/tink-crypto/tink-go/keyset"
 	"github.com/tink-crypto/tink-go/tink"
 	"gopkg.in/reform.v1"
+
+	"github.com/percona/pmm/managed/utils/encryption/kms"
 )
 
 var (
@@ -39,13 +42,20 @@ var (
 	DefaultEncryptionKeyPath = "/srv/pmm-encryption.key"
 	// ErrEncryptionNotInitialized is error in case of encryption is not initialized.
 	ErrEncryptionNotInitialized = errors.New("encryption is not initialized")
+	// ErrKMSNotConfigured is error in case of key-encryption key is required, but KMS is not configured.
+	ErrKMSNotConfigured = errors.New("KMS is not configured, set " + KMSKeyURIEnvVar)
 	// DefaultEncryption is the default implementation of encryption, lazily initialized.
 	defaultEncryption    *Encryption
 	defaultEncryptionMtx sync.Mutex
 )
 
-// CustomEncryptionKeyPathEnvVar is an environment variable to set custom encryption key path.
-const CustomEncryptionKeyPathEnvVar = "PMM_ENCRYPTION_KEY_PATH"
+const (
+	// CustomEncryptionKeyPathEnvVar is an environment variable to set custom encryption key path.
+	CustomEncryptionKeyPathEnvVar = "PMM_ENCRYPTION_KEY_PATH"
+	// KMSKeyURIEnvVar is an environment variable to set URI of the key-encryption key in KMS.
+	// When set, the encryption key is stored wrapped with that key, see package kms for supported URIs.
+	KMSKeyURIEnvVar = "PMM_ENCRYPTION_KMS_KEY_URI"
+)
 
 // getDefaultEncryption returns the default encryption instance, initializing it lazily if needed.
 func getDefaultEncryption() *Encryption {
@@ -63,6 +73,10 @@ type Encryption struct {
 	Path      string
 	Key       string
 	Primitive tink.AEAD
+	// KEK is the key-encryption key used to wrap the encryption key; nil if KMS is not configured.
+	KEK kms.Key
+
+	handle *keyset.Handle
 }
 
 // Table represents table name, it's identifiers and columns to be encrypted/decrypted.
@@ -102,38 +116,66 @@ func isTest() bool {
 
 // New creates an encryption; if key on path doesn't exist, it will be generated.
 func New() *Encryption {
-	e := &Encryption{}
-	customKeyPath := os.Getenv(CustomEncryptionKeyPathEnvVar)
-	if customKeyPath != "" {
-		e.Path = customKeyPath
-	} else {
+	path := os.Getenv(CustomEncryptionKeyPathEnvVar)
+	if path == "" {
 		if isTest() {
-			e.Path = "./encryption.key"
+			path = "./encryption.key"
 		} else {
-			e.Path = DefaultEncryptionKeyPath
+			path = DefaultEncryptionKeyPath
 		}
 	}
 
+	var kek kms.Key
+	if uri := os.Getenv(KMSKeyURIEnvVar); uri != "" {
+		var err error
+		kek, err = kms.New(context.Background(), uri)
+		if err != nil {
+			logrus.Panicf("Encryption: %v", err)
+		}
+	}
+
+	e, err := newEncryption(path, kek)
+	if err != nil {
+		logrus.Panicf("Encryption: %v", err)
+	}
+	return e
+}
+
+// newEncryption creates an encryption with the key on the given path, optionally wrapped with the given key-encryption key.
+// If the key doesn't exist, it will be generated. If the key is not wrapped yet, but KEK is given, the key will be wrapped.
+func newEncryption(path string, kek kms.Key) (*Encryption, error) {
+	e := &Encryption{
+		Path: path,
+		KEK:  kek,
+	}
+
 	bytes, err := os.ReadFile(e.Path)
 	switch {
 	case os.IsNotExist(err):
 		err = e.generateAndPersistKey()
 		if err != nil {
-			logrus.Panicf("Encryption: %v", err)
+			return nil, err
 		}
 	case err != nil:
-		logrus.Panicf("Encryption: %v", err)
+		return nil, err
 	default:
 		e.Key = string(bytes)
 	}
 
 	primitive, err := e.getPrimitive()
 	if err != nil {
-		logrus.Panicf("Encryption: %v", err)
+		return nil, err
 	}
 	e.Primitive = primitive
 
-	return e
+	if e.KEK != nil && !isWrapped(e.Key) {
+		logrus.Infof("Encryption: wrapping encryption key %s with KMS key.", e.Path)
+		if err = e.wrapKey(); err != nil {
+			return nil, err
+		}
+	}
+
+	return e, nil
 }
 
 // RotateEncryptionKey is a wrapper around DefaultEncryption.RotateEncryptionKey.
@@ -150,6 +192,27 @@ func RotateEncryptionKey() error {
 	return nil
 }
 
+// RotateKEK is a wrapper around DefaultEncryption.RotateKEK.
+func RotateKEK(ctx context.Context) error {
+	return getDefaultEncryption().RotateKEK(ctx)
+}
+
+// RotateKEK creates a new version of the key-encryption key in KMS and wraps the encryption key with it.
+// The encryption key itself and the data encrypted with it are not changed, so PMM Server doesn't need to be stopped.
+func (e *Encryption) RotateKEK(ctx context.Context) error {
+	if e == nil || e.handle == nil {
+		return ErrEncryptionNotInitialized
+	}
+	if e.KEK == nil {
+		return ErrKMSNotConfigured
+	}
+
+	if err := e.KEK.Rotate(ctx); err != nil {
+		return err
+	}
+	return e.wrapKey()
+}
+
 // RestoreOldEncryptionKey is a wrapper around DefaultEncryption.RestoreOldEncryptionKey.
 func RestoreOldEncryptionKey() error {
 	err := os.Rename(strings.TrimSuffix(encryptionKeyPath(), ".key")+"_old.key", encryptionKeyPath())
@@ -191,11 +254,38 @@ func (e *Encryption) generateAndPersistKey() error {
 		return err
 	}
 	e.Key = key
+	if e.KEK != nil {
+		// new key is wrapped right after parsing, see newEncryption
+		return nil
+	}
 	return e.saveKeyToFile()
 }
 
+// wrapKey wraps the encryption key with the key-encryption key and saves it.
+func (e *Encryption) wrapKey() error {
+	buff := &bytes.Buffer{}
+	err := e.handle.Write(keyset.NewJSONWriter(buff), e.KEK)
+	if err != nil {
+		return fmt.Errorf("failed to wrap encryption key: %w", err)
+	}
+
+	e.Key = buff.String()
+	return e.saveKeyToFile()
+}
+
+// isWrapped returns true if the key is wrapped with the key-encryption key.
+// Wrapped keys are stored as JSON, plain keys are stored as base64.
+func isWrapped(key string) bool {
+	return strings.HasPrefix(strings.TrimSpace(key), "{")
+}
+
 func (e *Encryption) saveKeyToFile() error {
-	return os.WriteFile(e.Path, []byte(e.Key), 0o644) //nolint:gosec,mnd
+	// write to the temporary file first to never leave a partially written key
+	tmp := e.Path + ".tmp"
+	if err := os.WriteFile(tmp, []byte(e.Key), 0o644); err != nil { //nolint:gosec,mnd
+		return err
+	}
+	return os.Rename(tmp, e.Path)
 }
 
 // Encrypt is a wrapper around DefaultEncryption.Encrypt.
@@ -333,6 +423,32 @@ func (e *Encryption) DecryptItems(tx *reform.TX, tables []Table) error {
 }
 
 func (e *Encryption) getPrimitive() (tink.AEAD, error) { //nolint:ireturn
+	parsedHandle, err := e.readKeyset()
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := aead.New(parsedHandle)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create AEAD primitive: %w", err)
+	}
+	e.handle = parsedHandle
+	return t, err
+}
+
+func (e *Encryption) readKeyset() (*keyset.Handle, error) {
+	if isWrapped(e.Key) {
+		if e.KEK == nil {
+			return nil, fmt.Errorf("encryption key is wrapped with KMS key: %w", ErrKMSNotConfigured)
+		}
+
+		parsedHandle, err := keyset.Read(keyset.NewJSONReader(strings.NewReader(e.Key)), e.KEK)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unwrap keyset: %w", err)
+		}
+		return parsedHandle, nil
+	}
+
 	serializedKeyset, err := base64.StdEncoding.DecodeString(e.Key)
 	if err != nil {
 		return nil, fmt.Errorf("failed to decode keyset: %w", err)
@@ -343,10 +459,5 @@ func (e *Encryption) getPrimitive() (tink.AEAD, error) { //nolint:ireturn
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse keyset: %w", err)
 	}
-
-	t, err := aead.New(parsedHandle)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create AEAD primitive: %w", err)
-	}
-	return t, err
+	return parsedHandle, nil
 }
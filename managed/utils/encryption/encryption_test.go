@@ -16,12 +16,18 @@
 package encryption
 
 import (
+	"context"
 	"encoding/base64"
+	"errors"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tink-crypto/tink-go/aead"
+	"github.com/tink-crypto/tink-go/keyset"
+	"github.com/tink-crypto/tink-go/tink"
 )
 
 func TestEncryptionGenerateKey(t *testing.T) {
@@ -72,3 +78,119 @@ func TestEncryptionGenerateAndPersistKey(t *testing.T) {
 	_, err = base64.StdEncoding.DecodeString(e.Key)
 	require.NoError(t, err)
 }
+
+// testKEK is an in-memory key-encryption key with versions.
+type testKEK struct {
+	versions []tink.AEAD
+}
+
+func newTestKEK(t *testing.T) *testKEK {
+	t.Helper()
+
+	k := &testKEK{}
+	require.NoError(t, k.Rotate(context.Background()))
+	return k
+}
+
+func (k *testKEK) Encrypt(plaintext, associatedData []byte) ([]byte, error) {
+	version := len(k.versions) - 1
+	ciphertext, err := k.versions[version].Encrypt(plaintext, associatedData)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{byte(version)}, ciphertext...), nil
+}
+
+func (k *testKEK) Decrypt(ciphertext, associatedData []byte) ([]byte, error) {
+	if len(ciphertext) == 0 || int(ciphertext[0]) >= len(k.versions) || k.versions[ciphertext[0]] == nil {
+		return nil, errors.New("unknown key version")
+	}
+	return k.versions[ciphertext[0]].Decrypt(ciphertext[1:], associatedData)
+}
+
+func (k *testKEK) Rotate(_ context.Context) error {
+	handle, err := keyset.NewHandle(aead.AES256GCMKeyTemplate())
+	if err != nil {
+		return err
+	}
+	primitive, err := aead.New(handle)
+	if err != nil {
+		return err
+	}
+	k.versions = append(k.versions, primitive)
+	return nil
+}
+
+func TestEncryptionKEK(t *testing.T) {
+	t.Run("NewKey", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "encryption.key")
+		kek := newTestKEK(t)
+
+		e, err := newEncryption(path, kek)
+		require.NoError(t, err)
+		assert.True(t, isWrapped(e.Key))
+
+		content, err := os.ReadFile(path)
+		require.NoError(t, err)
+		assert.Equal(t, e.Key, string(content))
+
+		encrypted, err := e.Encrypt("secret")
+		require.NoError(t, err)
+
+		e, err = newEncryption(path, kek)
+		require.NoError(t, err)
+		decrypted, err := e.Decrypt(encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, "secret", decrypted)
+
+		_, err = newEncryption(path, nil)
+		require.ErrorIs(t, err, ErrKMSNotConfigured)
+
+		_, err = newEncryption(path, newTestKEK(t))
+		require.ErrorContains(t, err, "failed to unwrap keyset")
+	})
+
+	t.Run("WrapExistingKey", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "encryption.key")
+
+		e, err := newEncryption(path, nil)
+		require.NoError(t, err)
+		assert.False(t, isWrapped(e.Key))
+		encrypted, err := e.Encrypt("secret")
+		require.NoError(t, err)
+
+		e, err = newEncryption(path, newTestKEK(t))
+		require.NoError(t, err)
+		assert.True(t, isWrapped(e.Key))
+		decrypted, err := e.Decrypt(encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, "secret", decrypted)
+	})
+
+	t.Run("RotateKEK", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "encryption.key")
+		kek := newTestKEK(t)
+
+		e, err := newEncryption(path, kek)
+		require.NoError(t, err)
+		wrapped := e.Key
+		encrypted, err := e.Encrypt("secret")
+		require.NoError(t, err)
+
+		require.NoError(t, e.RotateKEK(context.Background()))
+		assert.Len(t, kek.versions, 2)
+		assert.NotEqual(t, wrapped, e.Key)
+
+		// drop the old version of KEK to make sure the key is wrapped with the new one
+		kek.versions[0] = nil
+		e, err = newEncryption(path, kek)
+		require.NoError(t, err)
+		decrypted, err := e.Decrypt(encrypted)
+		require.NoError(t, err)
+		assert.Equal(t, "secret", decrypted)
+
+		e, err = newEncryption(filepath.Join(t.TempDir(), "encryption.key"), nil)
+		require.NoError(t, err)
+		require.ErrorIs(t, e.RotateKEK(context.Background()), ErrKMSNotConfigured)
+	})
+}
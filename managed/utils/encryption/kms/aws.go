@@ -0,0 +1,109 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package kms
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+)
+
+// associatedDataContextKey is the encryption context key for associated data.
+const associatedDataContextKey = "associatedData"
+
+// awsKMS is a symmetric key of AWS KMS.
+//
+// Credentials are taken from the default credential chain (environment variables, shared configuration,
+// instance profile, etc.); the region is taken from the key ARN.
+type awsKMS struct {
+	keyARN string
+	client *kms.Client
+}
+
+func newAWSKMS(ctx context.Context, keyARN string) (Key, error) { //nolint:ireturn
+	parsed, err := arn.Parse(keyARN)
+	if err != nil || parsed.Service != "kms" || !strings.HasPrefix(parsed.Resource, "key/") {
+		return nil, errors.New("AWS KMS key URI should be in the form aws-kms://arn:<partition>:kms:<region>:<account ID>:key/<key ID>")
+	}
+
+	cfg, err := config.LoadDefaultConfig(ctx, config.WithRegion(parsed.Region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS default config: %w", err)
+	}
+
+	return &awsKMS{
+		keyARN: keyARN,
+		client: kms.NewFromConfig(cfg),
+	}, nil
+}
+
+// encryptionContext returns KMS encryption context for the given associated data.
+func encryptionContext(associatedData []byte) map[string]string {
+	if len(associatedData) == 0 {
+		return nil
+	}
+	return map[string]string{associatedDataContextKey: hex.EncodeToString(associatedData)}
+}
+
+// Encrypt implements tink.AEAD.
+func (a *awsKMS) Encrypt(plaintext, associatedData []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	res, err := a.client.Encrypt(ctx, &kms.EncryptInput{
+		KeyId:             aws.String(a.keyARN),
+		Plaintext:         plaintext,
+		EncryptionContext: encryptionContext(associatedData),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt with AWS KMS key %s: %w", a.keyARN, err)
+	}
+	return res.CiphertextBlob, nil
+}
+
+// Decrypt implements tink.AEAD.
+func (a *awsKMS) Decrypt(ciphertext, associatedData []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+	defer cancel()
+
+	res, err := a.client.Decrypt(ctx, &kms.DecryptInput{
+		KeyId:             aws.String(a.keyARN),
+		CiphertextBlob:    ciphertext,
+		EncryptionContext: encryptionContext(associatedData),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt with AWS KMS key %s: %w", a.keyARN, err)
+	}
+	return res.Plaintext, nil
+}
+
+// Rotate implements Key.
+func (a *awsKMS) Rotate(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	if _, err := a.client.RotateKeyOnDemand(ctx, &kms.RotateKeyOnDemandInput{KeyId: aws.String(a.keyARN)}); err != nil {
+		return fmt.Errorf("failed to rotate AWS KMS key %s: %w", a.keyARN, err)
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// Package kms provides key-encryption keys (KEK) stored in external key management services.
+// They are used to wrap PMM encryption key, so it is never stored on disk in plain text.
+//
+// Supported key URIs are:
+//   - vault-transit://<mount>/<key name> - key of HashiCorp Vault Transit secrets engine;
+//   - aws-kms://<key ARN> - AWS KMS symmetric key;
+//   - pkcs11:token=<token label>;object=<key label>?module-path=<path>&pin-value=<PIN> - AES key
+//     in the PKCS#11 token (RFC 7512 URI); pin-source=<path> may be used instead of pin-value.
+package kms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/tink-crypto/tink-go/tink"
+)
+
+// Supported key URI prefixes.
+const (
+	vaultTransitPrefix = "vault-transit://"
+	awsKMSPrefix       = "aws-kms://"
+	pkcs11Prefix       = "pkcs11:"
+)
+
+// requestTimeout is the timeout for a single KMS request.
+const requestTimeout = 10 * time.Second
+
+// Key is a key-encryption key stored in the key management service.
+type Key interface {
+	tink.AEAD
+
+	// Rotate creates a new version of the key. The new version is used to encrypt data;
+	// data encrypted with previous versions can still be decrypted.
+	Rotate(ctx context.Context) error
+}
+
+// New returns a Key for the given URI.
+func New(ctx context.Context, uri string) (Key, error) { //nolint:ireturn
+	switch {
+	case strings.HasPrefix(uri, vaultTransitPrefix):
+		return newVaultTransit(strings.TrimPrefix(uri, vaultTransitPrefix))
+	case strings.HasPrefix(uri, awsKMSPrefix):
+		return newAWSKMS(ctx, strings.TrimPrefix(uri, awsKMSPrefix))
+	case strings.HasPrefix(uri, pkcs11Prefix):
+		return newPKCS11(uri)
+	default:
+		return nil, fmt.Errorf("unsupported KMS key URI, expected one of %s, %s, %s", vaultTransitPrefix, awsKMSPrefix, pkcs11Prefix)
+	}
+}
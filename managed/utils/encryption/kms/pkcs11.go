@@ -0,0 +1,291 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+//go:build cgo
+
+package kms
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/miekg/pkcs11"
+)
+
+const (
+	pkcs11KeySize   = 32
+	pkcs11IVSize    = 12
+	pkcs11TagBits   = 128
+	pkcs11IDSize    = 4 // key version is stored in CKA_ID as big-endian uint32
+	pkcs11MaxObject = 100
+)
+
+// errPKCS11KeyNotFound is returned when the token contains no versions of the key.
+var errPKCS11KeyNotFound = errors.New("PKCS#11 key not found")
+
+// pkcs11Key is an AES key in the PKCS#11 token, like an HSM or SoftHSM.
+//
+// Every key version is a separate AES-256 object with the same CKA_LABEL and the version number in CKA_ID.
+// The first version is generated in the token if it doesn't exist yet. Ciphertext is prefixed with the
+// key version and the IV of AES-GCM.
+type pkcs11Key struct {
+	rw      sync.Mutex // PKCS#11 sessions must not be used concurrently
+	ctx     *pkcs11.Ctx
+	session pkcs11.SessionHandle
+	label   string
+}
+
+func newPKCS11(uri string) (Key, error) { //nolint:ireturn
+	params, err := parsePKCS11URI(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := pkcs11.New(params.modulePath)
+	if ctx == nil {
+		return nil, fmt.Errorf("failed to load PKCS#11 module %s", params.modulePath)
+	}
+
+	k, err := openPKCS11(ctx, params)
+	if err != nil {
+		_ = ctx.Finalize()
+		ctx.Destroy()
+		return nil, err
+	}
+	return k, nil
+}
+
+func openPKCS11(ctx *pkcs11.Ctx, params *pkcs11Params) (*pkcs11Key, error) {
+	if err := ctx.Initialize(); err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_CRYPTOKI_ALREADY_INITIALIZED)) {
+		return nil, fmt.Errorf("failed to initialize PKCS#11 module: %w", err)
+	}
+
+	slots, err := ctx.GetSlotList(true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list PKCS#11 slots: %w", err)
+	}
+	slot, found := uint(0), false
+	for _, s := range slots {
+		info, err := ctx.GetTokenInfo(s)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get PKCS#11 token info: %w", err)
+		}
+		if strings.TrimRight(info.Label, " \x00") == params.token {
+			slot, found = s, true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("PKCS#11 token %q not found", params.token)
+	}
+
+	session, err := ctx.OpenSession(slot, pkcs11.CKF_SERIAL_SESSION|pkcs11.CKF_RW_SESSION)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open PKCS#11 session: %w", err)
+	}
+	err = ctx.Login(session, pkcs11.CKU_USER, params.pin)
+	if err != nil && !errors.Is(err, pkcs11.Error(pkcs11.CKR_USER_ALREADY_LOGGED_IN)) {
+		return nil, fmt.Errorf("failed to log in to PKCS#11 token: %w", err)
+	}
+
+	k := &pkcs11Key{
+		ctx:     ctx,
+		session: session,
+		label:   params.object,
+	}
+
+	_, _, err = k.latest()
+	switch {
+	case errors.Is(err, errPKCS11KeyNotFound):
+		if _, err = k.generate(1); err != nil {
+			return nil, err
+		}
+	case err != nil:
+		return nil, err
+	}
+
+	return k, nil
+}
+
+// Encrypt implements tink.AEAD.
+func (k *pkcs11Key) Encrypt(plaintext, associatedData []byte) ([]byte, error) {
+	k.rw.Lock()
+	defer k.rw.Unlock()
+
+	version, obj, err := k.latest()
+	if err != nil {
+		return nil, err
+	}
+
+	iv := make([]byte, pkcs11IVSize)
+	if _, err = rand.Read(iv); err != nil {
+		return nil, err
+	}
+	params := pkcs11.NewGCMParams(iv, associatedData, pkcs11TagBits)
+	defer params.Free()
+
+	if err = k.ctx.EncryptInit(k.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_GCM, params)}, obj); err != nil {
+		return nil, fmt.Errorf("failed to encrypt with PKCS#11 key %s: %w", k.label, err)
+	}
+	ciphertext, err := k.ctx.Encrypt(k.session, plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt with PKCS#11 key %s: %w", k.label, err)
+	}
+
+	// some tokens ignore given IV and generate their own
+	if actual := params.IV(); len(actual) == pkcs11IVSize {
+		iv = actual
+	}
+
+	res := make([]byte, 0, pkcs11IDSize+pkcs11IVSize+len(ciphertext))
+	res = binary.BigEndian.AppendUint32(res, version)
+	res = append(res, iv...)
+	return append(res, ciphertext...), nil
+}
+
+// Decrypt implements tink.AEAD.
+func (k *pkcs11Key) Decrypt(ciphertext, associatedData []byte) ([]byte, error) {
+	if len(ciphertext) < pkcs11IDSize+pkcs11IVSize {
+		return nil, errors.New("PKCS#11 ciphertext is too short")
+	}
+	version := binary.BigEndian.Uint32(ciphertext)
+	iv := ciphertext[pkcs11IDSize : pkcs11IDSize+pkcs11IVSize]
+
+	k.rw.Lock()
+	defer k.rw.Unlock()
+
+	versions, err := k.versions()
+	if err != nil {
+		return nil, err
+	}
+	obj, ok := versions[version]
+	if !ok {
+		return nil, fmt.Errorf("version %d of PKCS#11 key %s not found", version, k.label)
+	}
+
+	params := pkcs11.NewGCMParams(iv, associatedData, pkcs11TagBits)
+	defer params.Free()
+
+	if err = k.ctx.DecryptInit(k.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_GCM, params)}, obj); err != nil {
+		return nil, fmt.Errorf("failed to decrypt with PKCS#11 key %s: %w", k.label, err)
+	}
+	plaintext, err := k.ctx.Decrypt(k.session, ciphertext[pkcs11IDSize+pkcs11IVSize:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt with PKCS#11 key %s: %w", k.label, err)
+	}
+	return plaintext, nil
+}
+
+// Rotate implements Key.
+func (k *pkcs11Key) Rotate(_ context.Context) error {
+	k.rw.Lock()
+	defer k.rw.Unlock()
+
+	version, _, err := k.latest()
+	if err != nil {
+		return err
+	}
+	_, err = k.generate(version + 1)
+	return err
+}
+
+// versions returns key objects by versions.
+func (k *pkcs11Key) versions() (map[uint32]pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_AES),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, k.label),
+	}
+	if err := k.ctx.FindObjectsInit(k.session, template); err != nil {
+		return nil, fmt.Errorf("failed to find PKCS#11 key %s: %w", k.label, err)
+	}
+
+	var objects []pkcs11.ObjectHandle
+	for {
+		found, _, err := k.ctx.FindObjects(k.session, pkcs11MaxObject)
+		if err != nil {
+			_ = k.ctx.FindObjectsFinal(k.session)
+			return nil, fmt.Errorf("failed to find PKCS#11 key %s: %w", k.label, err)
+		}
+		if len(found) == 0 {
+			break
+		}
+		objects = append(objects, found...)
+	}
+	if err := k.ctx.FindObjectsFinal(k.session); err != nil {
+		return nil, fmt.Errorf("failed to find PKCS#11 key %s: %w", k.label, err)
+	}
+
+	res := make(map[uint32]pkcs11.ObjectHandle, len(objects))
+	for _, obj := range objects {
+		attrs, err := k.ctx.GetAttributeValue(k.session, obj, []*pkcs11.Attribute{pkcs11.NewAttribute(pkcs11.CKA_ID, nil)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get PKCS#11 key %s ID: %w", k.label, err)
+		}
+		// skip objects with the same label that were not created by PMM
+		if len(attrs) != 1 || len(attrs[0].Value) != pkcs11IDSize {
+			continue
+		}
+		res[binary.BigEndian.Uint32(attrs[0].Value)] = obj
+	}
+	return res, nil
+}
+
+// latest returns the latest key version and its object.
+func (k *pkcs11Key) latest() (uint32, pkcs11.ObjectHandle, error) {
+	versions, err := k.versions()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var version uint32
+	var obj pkcs11.ObjectHandle
+	for v, o := range versions {
+		if v >= version {
+			version, obj = v, o
+		}
+	}
+	if version == 0 {
+		return 0, 0, errPKCS11KeyNotFound
+	}
+	return version, obj, nil
+}
+
+// generate generates a new non-extractable version of the key in the token.
+func (k *pkcs11Key) generate(version uint32) (pkcs11.ObjectHandle, error) {
+	template := []*pkcs11.Attribute{
+		pkcs11.NewAttribute(pkcs11.CKA_CLASS, pkcs11.CKO_SECRET_KEY),
+		pkcs11.NewAttribute(pkcs11.CKA_KEY_TYPE, pkcs11.CKK_AES),
+		pkcs11.NewAttribute(pkcs11.CKA_LABEL, k.label),
+		pkcs11.NewAttribute(pkcs11.CKA_ID, binary.BigEndian.AppendUint32(nil, version)),
+		pkcs11.NewAttribute(pkcs11.CKA_VALUE_LEN, pkcs11KeySize),
+		pkcs11.NewAttribute(pkcs11.CKA_TOKEN, true),
+		pkcs11.NewAttribute(pkcs11.CKA_PRIVATE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_SENSITIVE, true),
+		pkcs11.NewAttribute(pkcs11.CKA_EXTRACTABLE, false),
+		pkcs11.NewAttribute(pkcs11.CKA_ENCRYPT, true),
+		pkcs11.NewAttribute(pkcs11.CKA_DECRYPT, true),
+	}
+	obj, err := k.ctx.GenerateKey(k.session, []*pkcs11.Mechanism{pkcs11.NewMechanism(pkcs11.CKM_AES_KEY_GEN, nil)}, template)
+	if err != nil {
+		return 0, fmt.Errorf("failed to generate version %d of PKCS#11 key %s: %w", version, k.label, err)
+	}
+	return obj, nil
+}
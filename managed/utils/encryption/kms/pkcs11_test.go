@@ -0,0 +1,86 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+//go:build cgo
+
+package kms
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// softHSMModule returns the path to SoftHSM v2 PKCS#11 module, or skips the test if it is not installed.
+func softHSMModule(t *testing.T) string {
+	t.Helper()
+
+	if _, err := exec.LookPath("softhsm2-util"); err != nil {
+		t.Skip("softhsm2-util is not installed")
+	}
+
+	for _, p := range []string{
+		os.Getenv("PMM_TEST_PKCS11_MODULE"),
+		"/usr/lib/softhsm/libsofthsm2.so",
+		"/usr/lib64/pkcs11/libsofthsm2.so",
+		"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+		"/usr/local/lib/softhsm/libsofthsm2.so",
+	} {
+		if p == "" {
+			continue
+		}
+		if _, err := os.Stat(p); err == nil {
+			return p
+		}
+	}
+	t.Skip("SoftHSM v2 module is not found, set PMM_TEST_PKCS11_MODULE")
+	return ""
+}
+
+func TestPKCS11(t *testing.T) {
+	module := softHSMModule(t)
+
+	dir := t.TempDir()
+	conf := filepath.Join(dir, "softhsm2.conf")
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "tokens"), 0o700))
+	require.NoError(t, os.WriteFile(conf, []byte("directories.tokendir = "+filepath.Join(dir, "tokens")+"\n"), 0o600))
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	b, err := exec.Command("softhsm2-util", "--init-token", "--free", "--label", "pmm", "--pin", "1234", "--so-pin", "5678").CombinedOutput()
+	require.NoError(t, err, "%s", b)
+
+	uri := "pkcs11:token=pmm;object=pmm-kek?module-path=" + module + "&pin-value=1234"
+	k, err := New(context.Background(), uri)
+	require.NoError(t, err)
+	testKey(t, k)
+
+	ciphertext, err := k.Encrypt([]byte("keyset"), nil)
+	require.NoError(t, err)
+
+	// the same key versions are found in the token
+	k, err = New(context.Background(), uri)
+	require.NoError(t, err)
+	plaintext, err := k.Decrypt(ciphertext, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("keyset"), plaintext)
+
+	_, err = New(context.Background(), "pkcs11:token=missing;object=pmm-kek?module-path="+module+"&pin-value=1234")
+	assert.EqualError(t, err, `PKCS#11 token "missing" not found`)
+}
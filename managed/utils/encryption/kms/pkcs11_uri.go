@@ -0,0 +1,90 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package kms
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// pkcs11Params represents parsed PKCS#11 URI.
+// See https://www.rfc-editor.org/rfc/rfc7512.
+type pkcs11Params struct {
+	modulePath string
+	token      string
+	object     string
+	pin        string
+}
+
+func parsePKCS11URI(uri string) (*pkcs11Params, error) {
+	errFormat := errors.New("PKCS#11 key URI should be in the form pkcs11:token=<token label>;object=<key label>?module-path=<path>&pin-value=<PIN>")
+
+	path, query, _ := strings.Cut(strings.TrimPrefix(uri, pkcs11Prefix), "?")
+
+	var p pkcs11Params
+	for attr := range strings.SplitSeq(path, ";") {
+		k, v, ok := strings.Cut(attr, "=")
+		if !ok {
+			return nil, errFormat
+		}
+		v, err := url.PathUnescape(v)
+		if err != nil {
+			return nil, errFormat
+		}
+		switch k {
+		case "token":
+			p.token = v
+		case "object":
+			p.object = v
+		default:
+			return nil, fmt.Errorf("unexpected PKCS#11 key URI attribute %q", k)
+		}
+	}
+
+	q, err := url.ParseQuery(query)
+	if err != nil {
+		return nil, errFormat
+	}
+	for k := range q {
+		switch k {
+		case "module-path", "pin-value", "pin-source":
+		default:
+			return nil, fmt.Errorf("unexpected PKCS#11 key URI query attribute %q", k)
+		}
+	}
+	p.modulePath = q.Get("module-path")
+	p.pin = q.Get("pin-value")
+
+	if p.token == "" || p.object == "" || p.modulePath == "" {
+		return nil, errFormat
+	}
+
+	if source := q.Get("pin-source"); source != "" {
+		if p.pin != "" {
+			return nil, errors.New("PKCS#11 key URI should contain either pin-value or pin-source, not both")
+		}
+		b, err := os.ReadFile(strings.TrimPrefix(source, "file://"))
+		if err != nil {
+			return nil, fmt.Errorf("failed to read PKCS#11 PIN: %w", err)
+		}
+		p.pin = strings.TrimRight(string(b), "\r\n")
+	}
+
+	return &p, nil
+}
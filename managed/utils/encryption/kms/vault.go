@@ -0,0 +1,163 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// maxVaultResponseSize is the maximum size of Vault response body.
+const maxVaultResponseSize = 1024 * 1024
+
+// vaultTransit is a key of HashiCorp Vault Transit secrets engine.
+// See https://developer.hashicorp.com/vault/api-docs/secret/transit.
+//
+// Vault address, token, and namespace are taken from VAULT_ADDR, VAULT_TOKEN, and VAULT_NAMESPACE
+// environment variables.
+type vaultTransit struct {
+	addr      string
+	token     string
+	namespace string
+	mount     string
+	name      string
+	client    *http.Client
+}
+
+// vaultResponse represents a response of Vault Transit API.
+type vaultResponse struct {
+	Data struct {
+		Ciphertext string `json:"ciphertext"`
+		Plaintext  string `json:"plaintext"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+func newVaultTransit(path string) (Key, error) { //nolint:ireturn
+	mount, name, ok := strings.Cut(strings.Trim(path, "/"), "/")
+	if !ok || mount == "" || name == "" || strings.Contains(name, "/") {
+		return nil, errors.New("vault Transit key URI should be in the form vault-transit://<mount>/<key name>")
+	}
+
+	addr := os.Getenv("VAULT_ADDR")
+	if addr == "" {
+		return nil, errors.New("VAULT_ADDR is not set")
+	}
+
+	return &vaultTransit{
+		addr:      strings.TrimRight(addr, "/"),
+		token:     os.Getenv("VAULT_TOKEN"),
+		namespace: os.Getenv("VAULT_NAMESPACE"),
+		mount:     mount,
+		name:      name,
+		client:    &http.Client{Timeout: requestTimeout},
+	}, nil
+}
+
+// Encrypt implements tink.AEAD.
+func (v *vaultTransit) Encrypt(plaintext, associatedData []byte) ([]byte, error) {
+	req := map[string]string{"plaintext": base64.StdEncoding.EncodeToString(plaintext)}
+	if len(associatedData) != 0 {
+		req["associated_data"] = base64.StdEncoding.EncodeToString(associatedData)
+	}
+
+	res, err := v.do(context.Background(), "encrypt/"+v.name, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt with Vault Transit key %s/%s: %w", v.mount, v.name, err)
+	}
+	return []byte(res.Data.Ciphertext), nil
+}
+
+// Decrypt implements tink.AEAD.
+func (v *vaultTransit) Decrypt(ciphertext, associatedData []byte) ([]byte, error) {
+	req := map[string]string{"ciphertext": string(ciphertext)}
+	if len(associatedData) != 0 {
+		req["associated_data"] = base64.StdEncoding.EncodeToString(associatedData)
+	}
+
+	res, err := v.do(context.Background(), "decrypt/"+v.name, req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt with Vault Transit key %s/%s: %w", v.mount, v.name, err)
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(res.Data.Plaintext)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode Vault Transit plaintext: %w", err)
+	}
+	return plaintext, nil
+}
+
+// Rotate implements Key.
+func (v *vaultTransit) Rotate(ctx context.Context) error {
+	if _, err := v.do(ctx, "keys/"+v.name+"/rotate", map[string]string{}); err != nil {
+		return fmt.Errorf("failed to rotate Vault Transit key %s/%s: %w", v.mount, v.name, err)
+	}
+	return nil
+}
+
+// do sends POST request with the given body to the Transit API endpoint.
+func (v *vaultTransit) do(ctx context.Context, endpoint string, body map[string]string) (*vaultResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	b, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+
+	u := v.addr + "/v1/" + v.mount + "/" + endpoint
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if v.token != "" {
+		req.Header.Set("X-Vault-Token", v.token)
+	}
+	if v.namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.namespace)
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	var res vaultResponse
+	if resp.StatusCode == http.StatusNoContent {
+		return &res, nil
+	}
+	if err = json.NewDecoder(io.LimitReader(resp.Body, maxVaultResponseSize)).Decode(&res); err != nil {
+		return nil, fmt.Errorf("failed to decode Vault response (status %d): %w", resp.StatusCode, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if len(res.Errors) != 0 {
+			return nil, fmt.Errorf("vault responded with status %d: %s", resp.StatusCode, strings.Join(res.Errors, "; "))
+		}
+		return nil, fmt.Errorf("vault responded with status %d", resp.StatusCode)
+	}
+	return &res, nil
+}
@@ -102,7 +102,12 @@ func ParseEnvVars(envs []string) (*models.ChangeSettingsParams, []error, []strin
 		case "PMM_DEBUG", "PMM_TRACE":
 			// skip cross-component environment variables that are already handled by kingpin
 			continue
-		case "PMM_CLICKHOUSE_DATABASE", "PMM_CLICKHOUSE_ADDR",
+		case "PMM_ENABLE_RTA_RECORDER",
+			"PMM_ENABLE_PLAN_HISTORY", "PMM_PLAN_HISTORY_INTERVAL", "PMM_PLAN_HISTORY_TOP_QUERIES",
+			"PMM_ENABLE_INDEX_ADVISOR", "PMM_INDEX_ADVISOR_INTERVAL", "PMM_INDEX_ADVISOR_TOP_QUERIES":
+			// skip environment variables that are already handled by kingpin
+			continue
+		case "PMM_CLICKHOUSE_DATABASE", "PMM_CLICKHOUSE_ADDR", "PMM_CLICKHOUSE_HTTP_ADDR",
 			"PMM_CLICKHOUSE_USER", "PMM_CLICKHOUSE_PASSWORD",
 			"PMM_CLICKHOUSE_DATASOURCE_USER", "PMM_CLICKHOUSE_DATASOURCE_PASSWORD",
 			"PMM_CLICKHOUSE_HOST", "PMM_CLICKHOUSE_PORT",
@@ -110,6 +115,9 @@ func ParseEnvVars(envs []string) (*models.ChangeSettingsParams, []error, []strin
 			"PMM_CLICKHOUSE_NODES", "PMM_DISABLE_BUILTIN_CLICKHOUSE",
 			pkgenv.ClickHouseConfig:
 			continue
+		case "PMM_QAN_ROLLUP_HOURLY_RETENTION", "PMM_QAN_ROLLUP_DAILY_RETENTION":
+			// skip env variables consumed by qan-api2
+			continue
 		case "PMM_POSTGRES_ADDR",
 			"PMM_POSTGRES_DBNAME",
 			"PMM_POSTGRES_USERNAME",
@@ -224,7 +232,7 @@ func ParseEnvVars(envs []string) (*models.ChangeSettingsParams, []error, []strin
 			continue
 
 		// skip various HA-related variables
-		case "PMM_ENCRYPTION_KEY_PATH", "PMM_ADMIN_PASSWORD":
+		case "PMM_ENCRYPTION_KEY_PATH", "PMM_ENCRYPTION_KMS_KEY_URI", "PMM_ADMIN_PASSWORD":
 			continue
 
 		case pkgenv.EnableAccessControl:
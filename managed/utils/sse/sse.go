@@ -0,0 +1,75 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+// Package sse provides grpc-gateway marshaler for Server-Sent Events.
+package sse
+
+import (
+	"bytes"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+)
+
+// MIMEType is the MIME type of Server-Sent Events stream.
+// grpc-gateway selects Marshaler by Accept header, so browsers' EventSource gets it automatically.
+const MIMEType = "text/event-stream"
+
+var (
+	dataPrefix = []byte("data: ")
+	newLine    = []byte("\n")
+)
+
+// Marshaler wraps another Marshaler (typically JSON) and formats each message of a server-streaming
+// response as a Server-Sent Event. Multi-line messages are split into several "data:" fields
+// as required by the specification.
+type Marshaler struct {
+	runtime.Marshaler
+}
+
+// Marshal marshals v with the wrapped Marshaler and formats it as the event data.
+func (m *Marshaler) Marshal(v any) ([]byte, error) {
+	b, err := m.Marshaler.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := bytes.Split(b, newLine)
+	res := make([]byte, 0, len(b)+len(lines)*len(dataPrefix))
+	for i, line := range lines {
+		if i != 0 {
+			res = append(res, newLine...)
+		}
+		res = append(res, dataPrefix...)
+		res = append(res, line...)
+	}
+
+	return res, nil
+}
+
+// ContentType returns Server-Sent Events MIME type.
+func (m *Marshaler) ContentType(_ any) string {
+	return MIMEType
+}
+
+// Delimiter returns the events delimiter: an empty line.
+func (m *Marshaler) Delimiter() []byte {
+	return []byte("\n\n")
+}
+
+// check interfaces.
+var (
+	_ runtime.Marshaler = (*Marshaler)(nil)
+	_ runtime.Delimited = (*Marshaler)(nil)
+)
@@ -0,0 +1,49 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package sse
+
+import (
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func TestMarshaler(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		name     string
+		indent   string
+		expected string
+	}{
+		{name: "Compact", expected: `data: {"result":{"a":1}}`},
+		{name: "Indented", indent: " ", expected: "data: {\ndata:  \"result\": {\ndata:   \"a\": 1\ndata:  }\ndata: }"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := &Marshaler{Marshaler: &runtime.JSONPb{MarshalOptions: protojson.MarshalOptions{Indent: tc.indent}}}
+			b, err := m.Marshal(map[string]any{"result": map[string]int{"a": 1}})
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, string(b))
+			assert.Equal(t, MIMEType, m.ContentType(nil))
+			assert.Equal(t, "\n\n", string(m.Delimiter()))
+		})
+	}
+}
@@ -53,6 +53,7 @@ import (
 	qanv1 "github.com/percona/pmm/api/qan/v1"
 	"github.com/percona/pmm/qan-api2/models"
 	aservice "github.com/percona/pmm/qan-api2/services/analytics"
+	"github.com/percona/pmm/qan-api2/services/anomalies"
 	rservice "github.com/percona/pmm/qan-api2/services/receiver"
 	"github.com/percona/pmm/qan-api2/utils/interceptors"
 	"github.com/percona/pmm/utils/dsnutils"
@@ -65,13 +66,14 @@ import (
 const (
 	shutdownTimeout                 = 3 * time.Second
 	defaultDropOldPartitionInterval = 24 * time.Hour
+	defaultRollupInterval           = 10 * time.Minute
 	defaultDsnF                     = "clickhouse://%s:%s@%s/%s"
 	maxIdleConns                    = 5
 	maxOpenConns                    = 10
 )
 
 // runGRPCServer runs gRPC server until context is canceled, then gracefully stops it.
-func runGRPCServer(ctx context.Context, db *sqlx.DB, mbm *models.MetricsBucket, bind string) {
+func runGRPCServer(ctx context.Context, db *sqlx.DB, ru *models.Rollups, ex models.Exporter, mbm *models.MetricsBucket, ad *anomalies.Detector, bind string) {
 	l := logrus.WithField("component", "gRPC")
 	lis, err := net.Listen("tcp", bind)
 	if err != nil {
@@ -79,8 +81,12 @@ func runGRPCServer(ctx context.Context, db *sqlx.DB, mbm *models.MetricsBucket,
 	}
 	l.Infof("Starting server on http://%s/ ...", bind)
 
-	rm := models.NewReporter(db)
-	mm := models.NewMetrics(db)
+	rm := models.NewReporter(db, ru)
+	mm := models.NewMetrics(db, ru)
+	am := models.NewAnomalies(db)
+	qa := models.NewQueryAnnotations(db)
+	qp := models.NewQueryPlans(db)
+	ir := models.NewIndexRecommendations(db)
 	grpcServer := grpc.NewServer(
 		// Do not increase that value. If larger requests are required (there are errors in logs),
 		// implement request slicing on pmm-managed side:
@@ -97,7 +103,7 @@ func runGRPCServer(ctx context.Context, db *sqlx.DB, mbm *models.MetricsBucket,
 		)),
 	)
 
-	aserv := aservice.NewService(db, rm, mm)
+	aserv := aservice.NewService(db, rm, mm, am, qa, qp, ir, ex, ad)
 	qanv1.RegisterCollectorServiceServer(grpcServer, rservice.NewService(mbm))
 	qanv1.RegisterQANServiceServer(grpcServer, aserv)
 	reflection.Register(grpcServer)
@@ -154,7 +160,9 @@ func runJSONServer(ctx context.Context, grpcBindF, jsonBindF string) {
 	proxyMux := grpc_gateway.NewServeMux(
 		grpc_gateway.WithIncomingHeaderMatcher(customMatcher),
 		grpc_gateway.WithMetadata(gatewayAnnotator),
-		grpc_gateway.WithMarshalerOption(grpc_gateway.MIMEWildcard, marshaller),
+		grpc_gateway.WithMarshalerOption(grpc_gateway.MIMEWildcard, &streamMarshaler{
+			HTTPBodyMarshaler: grpc_gateway.HTTPBodyMarshaler{Marshaler: marshaller},
+		}),
 		grpc_gateway.WithRoutingErrorHandler(pmmerrors.PMMRoutingErrorHandler),
 	)
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
@@ -195,6 +203,19 @@ func runJSONServer(ctx context.Context, grpcBindF, jsonBindF string) {
 	cancel()
 }
 
+// streamMarshaler writes google.api.HttpBody messages as is, with their content type,
+// and marshals other messages with the wrapped Marshaler.
+// Unlike grpc_gateway.HTTPBodyMarshaler, it doesn't delimit messages of server-streaming responses,
+// so exported data in binary formats is not corrupted.
+type streamMarshaler struct {
+	grpc_gateway.HTTPBodyMarshaler
+}
+
+// Delimiter returns no delimiter, as server-streaming RPCs of QAN API return google.api.HttpBody messages only.
+func (m *streamMarshaler) Delimiter() []byte {
+	return nil
+}
+
 // runDebugServer runs debug server until context is canceled, then gracefully stops it.
 func runDebugServer(ctx context.Context, debugBindF string) {
 	handler := promhttp.HandlerFor(prom.DefaultGatherer, promhttp.HandlerOpts{
@@ -269,12 +290,26 @@ func main() {
 	dsnF := kingpin.Flag("dsn", "ClickHouse database DSN. Can be overridden with database/host/port options").Default(defaultDsnF).String()
 	clickhouseDatabaseF := kingpin.Flag("clickhouse-name", "ClickHouse database name").Default("pmm").Envar("PMM_CLICKHOUSE_DATABASE").String()
 	clickhouseAddrF := kingpin.Flag("clickhouse-addr", "ClickHouse database address").Default("127.0.0.1:9000").Envar("PMM_CLICKHOUSE_ADDR").String()
+	clickhouseHTTPAddrF := kingpin.Flag("clickhouse-http-addr", "ClickHouse HTTP interface address used for QAN data export").
+		Default("127.0.0.1:8123").Envar("PMM_CLICKHOUSE_HTTP_ADDR").String()
 	clickhouseUserF := kingpin.Flag("clickhouse-user", "ClickHouse database user").Default("default").Envar("PMM_CLICKHOUSE_USER").String()
 	clickhousePasswordF := kingpin.Flag("clickhouse-password", "ClickHouse database user password").Default("clickhouse").Envar("PMM_CLICKHOUSE_PASSWORD").String()
 
 	clickhouseIsClusterF := kingpin.Flag("clickhouse-cluster", "Is ClickHouse a cluster").Default("false").Envar("PMM_CLICKHOUSE_IS_CLUSTER").Bool()
 	clickhouseClusterNameF := kingpin.Flag("clickhouse-cluster-name", "ClickHouse cluster name").Default("").Envar("PMM_CLICKHOUSE_CLUSTER_NAME").String()
 
+	anomalyIntervalF := kingpin.Flag("anomaly-detection-interval", "Query anomaly detection interval, 0 to disable").
+		Default("5m").Envar("PMM_QAN_ANOMALY_DETECTION_INTERVAL").Duration()
+	anomalyBaselineF := kingpin.Flag("anomaly-detection-baseline", "Query anomaly detection rolling baseline window").
+		Default("24h").Envar("PMM_QAN_ANOMALY_DETECTION_BASELINE").Duration()
+	anomalyZScoreF := kingpin.Flag("anomaly-detection-z-score", "Minimal z-score of a metric to flag a query regression").
+		Default("3").Envar("PMM_QAN_ANOMALY_DETECTION_Z_SCORE").Float64()
+
+	rollupHourlyRetentionF := kingpin.Flag("rollup-hourly-retention", "QAN hourly rollup retention (in days), 0 to disable").
+		Default("90").Envar("PMM_QAN_ROLLUP_HOURLY_RETENTION").Uint()
+	rollupDailyRetentionF := kingpin.Flag("rollup-daily-retention", "QAN daily rollup retention (in days), 0 to disable").
+		Default("365").Envar("PMM_QAN_ROLLUP_DAILY_RETENTION").Uint()
+
 	debugF := kingpin.Flag("debug", "Enable debug logging").Bool()
 	traceF := kingpin.Flag("trace", "Enable trace logging (implies debug)").Bool()
 
@@ -332,6 +367,48 @@ func main() {
 		mbm.Run(mbmCtx)
 	})
 
+	var ad *anomalies.Detector
+	if *anomalyIntervalF > 0 {
+		am := models.NewAnomalies(db)
+		ad = anomalies.NewDetector(&am, anomalies.Params{
+			Interval:           *anomalyIntervalF,
+			Baseline:           *anomalyBaselineF,
+			ZScoreThreshold:    *anomalyZScoreF,
+			MinRatio:           1.5, //nolint:mnd
+			MinBaselineBuckets: 12,  //nolint:mnd
+			MinWindowQueries:   10,  //nolint:mnd
+		})
+		prom.MustRegister(ad)
+
+		wg.Go(func() {
+			ad.Run(ctx)
+		})
+	}
+
+	const day = 24 * time.Hour
+	ru := models.NewRollups(db, time.Duration(*dataRetentionF)*day,
+		models.RollupLevel{Table: models.RollupHourlyTable, Resolution: time.Hour, Retention: time.Duration(*rollupHourlyRetentionF) * day},
+		models.RollupLevel{Table: models.RollupDailyTable, Resolution: day, Retention: time.Duration(*rollupDailyRetentionF) * day},
+	)
+	if ru.Enabled() {
+		wg.Go(func() {
+			ticker := time.NewTicker(defaultRollupInterval)
+			defer ticker.Stop()
+			for {
+				ru.Run(ctx)
+				ru.DropOldPartitions(ctx)
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+					// nothing
+				}
+			}
+		})
+	}
+
+	ex := models.NewExporter(*clickhouseHTTPAddrF, *clickhouseDatabaseF, *clickhouseUserF, *clickhousePasswordF)
+
 	wg.Add(1)
 	go func() {
 		defer func() {
@@ -339,7 +416,7 @@ func main() {
 			mbmCancel()
 			wg.Done()
 		}()
-		runGRPCServer(ctx, db, mbm, *grpcBindF)
+		runGRPCServer(ctx, db, ru, ex, mbm, ad, *grpcBindF)
 	}()
 
 	wg.Go(func() {
@@ -0,0 +1 @@
+DROP TABLE rta_queries;
@@ -0,0 +1,13 @@
+CREATE TABLE rta_queries (
+  `service_id` LowCardinality(String) COMMENT 'ID of the service the query was running on',
+  `service_name` LowCardinality(String) COMMENT 'Name of the service the query was running on',
+  `query_id` String COMMENT 'Database specific query ID: connection ID, backend PID or operation ID',
+  `collect_time` DateTime64(3, 'UTC') COMMENT 'Time when the query was observed by Real-Time Analytics agent',
+  `query_start` DateTime64(3, 'UTC') COMMENT 'Time when the query was started, collect_time minus execution duration',
+  `execution_duration_ms` UInt64 COMMENT 'Query execution duration at collect_time in milliseconds',
+  `data` String COMMENT 'Collected query data in protobuf JSON format'
+) ENGINE = {{ .engine }} PARTITION BY toYYYYMMDD(collect_time)
+ORDER BY
+  (service_id, collect_time, query_id)
+TTL toDateTime(collect_time) + INTERVAL 7 DAY
+SETTINGS index_granularity = 8192;
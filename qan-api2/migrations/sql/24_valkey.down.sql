@@ -0,0 +1,22 @@
+ALTER TABLE metrics
+  DROP COLUMN m_args_cnt,
+  DROP COLUMN m_args_sum,
+  DROP COLUMN m_args_min,
+  DROP COLUMN m_args_max,
+  DROP COLUMN m_total_calls_cnt,
+  DROP COLUMN m_total_calls_sum,
+  DROP COLUMN m_total_time_cnt,
+  DROP COLUMN m_total_time_sum,
+  DROP COLUMN m_rejected_calls_cnt,
+  DROP COLUMN m_rejected_calls_sum,
+  DROP COLUMN m_failed_calls_cnt,
+  DROP COLUMN m_failed_calls_sum,
+  MODIFY COLUMN `agent_type` Enum8(
+      'qan-agent-type-invalid'=0,
+      'qan-mysql-perfschema-agent'=1,
+      'qan-mysql-slowlog-agent'=2,
+      'qan-mongodb-profiler-agent'=3,
+      'qan-postgresql-pgstatements-agent'=4,
+      'qan-postgresql-pgstatmonitor-agent'=5,
+      'qan-mongodb-mongolog-agent'=6
+      ) COMMENT 'Agent Type that collects metrics: slowlog, perf schema, etc.';
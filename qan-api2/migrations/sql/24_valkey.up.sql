@@ -0,0 +1,23 @@
+ALTER TABLE metrics
+  MODIFY COLUMN `agent_type` Enum8(
+      'qan-agent-type-invalid'=0,
+      'qan-mysql-perfschema-agent'=1,
+      'qan-mysql-slowlog-agent'=2,
+      'qan-mongodb-profiler-agent'=3,
+      'qan-postgresql-pgstatements-agent'=4,
+      'qan-postgresql-pgstatmonitor-agent'=5,
+      'qan-mongodb-mongolog-agent'=6,
+      'qan-valkey-slowlog-agent'=7
+      ) COMMENT 'Agent Type that collects metrics: slowlog, perf schema, etc.',
+  ADD COLUMN m_args_cnt Float32,
+  ADD COLUMN m_args_sum Float32 COMMENT 'The number of command arguments',
+  ADD COLUMN m_args_min Float32,
+  ADD COLUMN m_args_max Float32,
+  ADD COLUMN m_total_calls_cnt Float32,
+  ADD COLUMN m_total_calls_sum Float32 COMMENT 'The number of command calls, including calls not recorded in the slow log',
+  ADD COLUMN m_total_time_cnt Float32,
+  ADD COLUMN m_total_time_sum Float32 COMMENT 'The execution time of command calls, including calls not recorded in the slow log',
+  ADD COLUMN m_rejected_calls_cnt Float32,
+  ADD COLUMN m_rejected_calls_sum Float32 COMMENT 'The number of command calls rejected before execution',
+  ADD COLUMN m_failed_calls_cnt Float32,
+  ADD COLUMN m_failed_calls_sum Float32 COMMENT 'The number of command calls failed during execution';
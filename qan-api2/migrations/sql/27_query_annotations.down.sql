@@ -0,0 +1 @@
+DROP TABLE query_annotations;
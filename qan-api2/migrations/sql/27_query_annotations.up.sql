@@ -0,0 +1,12 @@
+CREATE TABLE query_annotations (
+  `queryid` String COMMENT 'hash of query fingerprint',
+  `owner` LowCardinality(String) COMMENT 'Team or person owning the query',
+  `ticket_url` String COMMENT 'Link to the ticket tracking the query',
+  `acknowledged` UInt8 COMMENT 'Query is known and its performance is acknowledged',
+  `notes` String COMMENT 'Free-form notes',
+  `updated_at` DateTime64(3, 'UTC') COMMENT 'Time of the change',
+  `deleted` UInt8 COMMENT 'Annotation is removed'
+) ENGINE = {{ .engine }}
+ORDER BY
+  (queryid, updated_at)
+SETTINGS index_granularity = 8192;
@@ -0,0 +1,13 @@
+CREATE TABLE query_plans (
+  `service_id` LowCardinality(String) COMMENT 'ID of the service the plan was collected on',
+  `service_name` LowCardinality(String) COMMENT 'Name of the service the plan was collected on',
+  `service_type` LowCardinality(String) COMMENT 'Type of the service: mysql or mongodb',
+  `queryid` String COMMENT 'hash of query fingerprint',
+  `plan_id` String COMMENT 'Hash of the normalized plan',
+  `plan` String COMMENT 'Normalized plan in JSON format',
+  `collected_at` DateTime64(3, 'UTC') COMMENT 'Time when the plan was collected'
+) ENGINE = {{ .engine }} PARTITION BY toYYYYMM(collected_at)
+ORDER BY
+  (service_id, queryid, collected_at)
+TTL toDateTime(collected_at) + INTERVAL 90 DAY
+SETTINGS index_granularity = 8192;
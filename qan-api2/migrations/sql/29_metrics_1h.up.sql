@@ -0,0 +1,12 @@
+CREATE TABLE metrics_1h AS metrics
+ENGINE = {{ .engine }} PARTITION BY toYYYYMM(period_start)
+ORDER BY
+  (
+    queryid,
+    service_name,
+    database,
+    schema,
+    username,
+    client_host,
+    period_start
+  ) SETTINGS index_granularity = 8192;
@@ -0,0 +1,20 @@
+CREATE TABLE index_recommendations (
+  `service_id` LowCardinality(String) COMMENT 'ID of the service the recommendation is made for',
+  `service_name` LowCardinality(String) COMMENT 'Name of the service the recommendation is made for',
+  `service_type` LowCardinality(String) COMMENT 'Type of the service: mysql, postgresql or mongodb',
+  `queryid` String COMMENT 'hash of query fingerprint',
+  `fingerprint` String COMMENT 'query fingerprint',
+  `database` LowCardinality(String) COMMENT 'Database of the table',
+  `table_name` String COMMENT 'Table or collection to create the index on',
+  `columns` Array(String) COMMENT 'Columns or fields of the candidate index',
+  `statement` String COMMENT 'Statement creating the candidate index',
+  `reason` String COMMENT 'Human-readable reason of the recommendation',
+  `evidence.name` Array(LowCardinality(String)) COMMENT 'QAN metrics names',
+  `evidence.value` Array(Float64) COMMENT 'QAN metrics values per query execution',
+  `existing_indexes` Array(String) COMMENT 'Definitions of existing indexes of the table',
+  `created_at` DateTime64(3, 'UTC') COMMENT 'Time when the recommendation was made'
+) ENGINE = {{ .engine }} PARTITION BY toYYYYMM(created_at)
+ORDER BY
+  (service_id, queryid, created_at)
+TTL toDateTime(created_at) + INTERVAL 30 DAY
+SETTINGS index_granularity = 8192;
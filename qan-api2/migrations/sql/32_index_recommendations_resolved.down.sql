@@ -0,0 +1,2 @@
+ALTER TABLE index_recommendations
+  DROP COLUMN `resolved`;
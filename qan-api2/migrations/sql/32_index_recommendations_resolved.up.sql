@@ -0,0 +1,2 @@
+ALTER TABLE index_recommendations
+  ADD COLUMN `resolved` UInt8 DEFAULT 0 COMMENT 'Recommendation is not made anymore by the latest analysis' AFTER `existing_indexes`;
@@ -0,0 +1 @@
+DROP TABLE rollup_watermarks;
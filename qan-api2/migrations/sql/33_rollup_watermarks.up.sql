@@ -0,0 +1,9 @@
+CREATE TABLE rollup_watermarks (
+  `table` LowCardinality(String) COMMENT 'Rollup table',
+  `watermark` DateTime COMMENT 'End of the last rolled up bucket',
+  `updated_at` DateTime64(3, 'UTC') COMMENT 'Time when the watermark was moved'
+) ENGINE = {{ .engine }}
+ORDER BY
+  (`table`, updated_at)
+TTL toDateTime(updated_at) + INTERVAL 30 DAY
+SETTINGS index_granularity = 8192;
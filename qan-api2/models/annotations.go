@@ -0,0 +1,307 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Annotation filter keys. They can be used in report and filters requests the same way as dimensions and labels.
+const (
+	AnnotationOwnerFilter        = "annotation_owner"
+	AnnotationAcknowledgedFilter = "annotation_acknowledged"
+)
+
+// annotationFilterColumns maps annotation filter keys to string expressions over query_annotations columns.
+var annotationFilterColumns = map[string]string{
+	AnnotationOwnerFilter:        "owner",
+	AnnotationAcknowledgedFilter: "if(acknowledged = 1, 'true', 'false')",
+}
+
+// IsAnnotationFilter checks if the given label name is an annotation filter key.
+func IsAnnotationFilter(name string) bool {
+	_, ok := annotationFilterColumns[name]
+	return ok
+}
+
+// splitAnnotationFilters splits annotation filters out of labels.
+func splitAnnotationFilters(labels map[string][]string) (map[string][]string, map[string][]string) {
+	rest := make(map[string][]string, len(labels))
+	annotations := make(map[string][]string)
+	for k, v := range labels {
+		if IsAnnotationFilter(k) {
+			annotations[k] = v
+			continue
+		}
+		rest[k] = v
+	}
+	return rest, annotations
+}
+
+// ErrQueryNotVisible is returned when the query has no metrics visible with LBAC filters of the request.
+var ErrQueryNotVisible = errors.New("query is not visible")
+
+// QueryAnnotation is metadata attached to a query.
+type QueryAnnotation struct {
+	QueryID      string    `db:"queryid"`
+	Owner        string    `db:"owner"`
+	TicketURL    string    `db:"ticket_url"`
+	Acknowledged bool      `db:"acknowledged"`
+	Notes        string    `db:"notes"`
+	UpdatedAt    time.Time `db:"updated_at"`
+}
+
+// QueryAnnotationsFilter defines filtering of query annotations.
+type QueryAnnotationsFilter struct {
+	QueryIDs     []string
+	Owner        string
+	Acknowledged *bool
+}
+
+// QueryAnnotations stores query annotations. They live in the separate table without partitions and TTL,
+// so they are not removed together with old metrics.
+// Changes are appended to the table, and the latest row of the query wins; removal is a row with the deleted flag.
+type QueryAnnotations struct {
+	db *sqlx.DB
+}
+
+// NewQueryAnnotations initialize QueryAnnotations with db instance.
+func NewQueryAnnotations(db *sqlx.DB) QueryAnnotations {
+	return QueryAnnotations{db: db}
+}
+
+const insertAnnotationSQL = `
+INSERT INTO query_annotations (queryid, owner, ticket_url, acknowledged, notes, updated_at, deleted)
+VALUES (:queryid, :owner, :ticket_url, :acknowledged, :notes, :updated_at, :deleted)
+`
+
+// Set creates or replaces the annotation of the query and sets its update time.
+// It returns ErrQueryNotVisible if the query is hidden by LBAC filters of the request.
+func (qa *QueryAnnotations) Set(ctx context.Context, a *QueryAnnotation) error {
+	if err := qa.checkVisible(ctx, a.QueryID); err != nil {
+		return err
+	}
+
+	a.UpdatedAt = time.Now().UTC().Truncate(time.Millisecond)
+	return qa.insert(ctx, a, false)
+}
+
+// Remove removes the annotation of the query.
+// It returns ErrQueryNotVisible if the query is hidden by LBAC filters of the request.
+func (qa *QueryAnnotations) Remove(ctx context.Context, queryID string) error {
+	if err := qa.checkVisible(ctx, queryID); err != nil {
+		return err
+	}
+
+	a := &QueryAnnotation{
+		QueryID:   queryID,
+		UpdatedAt: time.Now().UTC().Truncate(time.Millisecond),
+	}
+	return qa.insert(ctx, a, true)
+}
+
+const queryVisibleTmpl = `
+SELECT count()
+FROM metrics
+WHERE queryid = :queryid
+    AND queryid IN (SELECT DISTINCT queryid FROM metrics WHERE {{ .LbacFilter }})
+`
+
+var tmplQueryVisible = template.Must(template.New("queryVisible").Parse(queryVisibleTmpl))
+
+// checkVisible returns ErrQueryNotVisible if the query has no metrics visible with LBAC filters of the request.
+// Without LBAC filters all queries are visible.
+func (qa *QueryAnnotations) checkVisible(ctx context.Context, queryID string) error {
+	lbacFilter, err := headersToLbacFilter(ctx)
+	if err != nil {
+		return err
+	}
+	if lbacFilter == "" {
+		return nil
+	}
+
+	var queryBuffer bytes.Buffer
+	if err = tmplQueryVisible.Execute(&queryBuffer, struct{ LbacFilter string }{LbacFilter: lbacFilter}); err != nil {
+		return fmt.Errorf("cannot execute tmplQueryVisible: %w", err)
+	}
+
+	query, args, err := sqlx.Named(queryBuffer.String(), map[string]any{"queryid": queryID})
+	if err != nil {
+		return fmt.Errorf("prepare named: %w", err)
+	}
+	query = qa.db.Rebind(query)
+
+	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	var count uint64
+	if err = qa.db.GetContext(queryCtx, &count, query, args...); err != nil {
+		return fmt.Errorf("check query visibility: %w", err)
+	}
+	if count == 0 {
+		return ErrQueryNotVisible
+	}
+	return nil
+}
+
+func (qa *QueryAnnotations) insert(ctx context.Context, a *QueryAnnotation, deleted bool) (err error) {
+	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	var tx *sqlx.Tx
+	tx, err = qa.db.BeginTxx(queryCtx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err == nil {
+			err = tx.Commit()
+			if err != nil {
+				err = fmt.Errorf("failed to commit transaction: %w", err)
+			}
+		} else {
+			_ = tx.Rollback()
+		}
+	}()
+
+	stmt, err := tx.PrepareNamedContext(queryCtx, insertAnnotationSQL)
+	if err != nil {
+		return fmt.Errorf("failed to prepare statement: %w", err)
+	}
+	defer stmt.Close() //nolint:errcheck
+
+	_, err = stmt.ExecContext(queryCtx, map[string]any{
+		"queryid":      a.QueryID,
+		"owner":        a.Owner,
+		"ticket_url":   a.TicketURL,
+		"acknowledged": a.Acknowledged,
+		"notes":        a.Notes,
+		"updated_at":   a.UpdatedAt,
+		"deleted":      deleted,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to exec: %w", err)
+	}
+	return nil
+}
+
+const queryAnnotationsTmpl = `
+SELECT
+    queryid,
+    argMax(query_annotations.owner, query_annotations.updated_at) AS owner,
+    argMax(query_annotations.ticket_url, query_annotations.updated_at) AS ticket_url,
+    argMax(query_annotations.acknowledged, query_annotations.updated_at) AS acknowledged,
+    argMax(query_annotations.notes, query_annotations.updated_at) AS notes,
+    max(query_annotations.updated_at) AS updated_at
+FROM query_annotations
+WHERE 1
+{{ if .QueryIDs }}
+    AND queryid IN (:queryids)
+{{ end }}
+{{ if .LbacFilter }}
+    AND queryid IN (SELECT DISTINCT queryid FROM metrics WHERE {{ .LbacFilter }})
+{{ end }}
+GROUP BY queryid
+HAVING argMax(query_annotations.deleted, query_annotations.updated_at) = 0
+{{ if .Owner }}
+    AND owner = :owner
+{{ end }}
+{{ if .Acknowledged }}
+    AND acknowledged = :acknowledged
+{{ end }}
+ORDER BY queryid
+`
+
+var tmplQueryAnnotations = template.Must(template.New("queryAnnotations").Parse(queryAnnotationsTmpl))
+
+// Select returns annotations matching the filter. Queries without metrics visible
+// with LBAC filters of the request are skipped.
+func (qa *QueryAnnotations) Select(ctx context.Context, filter *QueryAnnotationsFilter) ([]*QueryAnnotation, error) {
+	lbacFilter, err := headersToLbacFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tmplArgs := struct {
+		QueryIDs     bool
+		Owner        bool
+		Acknowledged bool
+		LbacFilter   string
+	}{
+		QueryIDs:     len(filter.QueryIDs) != 0,
+		Owner:        filter.Owner != "",
+		Acknowledged: filter.Acknowledged != nil,
+		LbacFilter:   lbacFilter,
+	}
+
+	var queryBuffer bytes.Buffer
+	if err = tmplQueryAnnotations.Execute(&queryBuffer, tmplArgs); err != nil {
+		return nil, fmt.Errorf("cannot execute tmplQueryAnnotations: %w", err)
+	}
+
+	arg := map[string]any{
+		"queryids": filter.QueryIDs,
+		"owner":    filter.Owner,
+	}
+	if filter.Acknowledged != nil {
+		arg["acknowledged"] = *filter.Acknowledged
+	}
+
+	query, args, err := sqlx.Named(queryBuffer.String(), arg)
+	if err != nil {
+		return nil, fmt.Errorf("prepare named: %w", err)
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("populate arguments in IN clause: %w", err)
+	}
+	query = qa.db.Rebind(query)
+
+	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	var res []*QueryAnnotation
+	if err = qa.db.SelectContext(queryCtx, &res, query, args...); err != nil {
+		return nil, fmt.Errorf("query annotations: %w", err)
+	}
+
+	return res, nil
+}
+
+// SelectByQueryIDs returns annotations of the given queries mapped by query ID.
+func (qa *QueryAnnotations) SelectByQueryIDs(ctx context.Context, queryIDs []string) (map[string]*QueryAnnotation, error) {
+	if len(queryIDs) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	annotations, err := qa.Select(ctx, &QueryAnnotationsFilter{QueryIDs: queryIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make(map[string]*QueryAnnotation, len(annotations))
+	for _, a := range annotations {
+		res[a.QueryID] = a
+	}
+	return res, nil
+}
@@ -0,0 +1,114 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitAnnotationFilters(t *testing.T) {
+	labels, annotations := splitAnnotationFilters(map[string][]string{
+		"team":                       {"db"},
+		AnnotationOwnerFilter:        {"payments"},
+		"annotation_unknown":         {"x"},
+		AnnotationAcknowledgedFilter: {"false"},
+	})
+	assert.Equal(t, map[string][]string{"team": {"db"}, "annotation_unknown": {"x"}}, labels)
+	assert.Equal(t, map[string][]string{AnnotationOwnerFilter: {"payments"}, AnnotationAcknowledgedFilter: {"false"}}, annotations)
+}
+
+func TestAnnotationTemplates(t *testing.T) {
+	whitespace := regexp.MustCompile(`\s+`)
+
+	t.Run("ReportFilters", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := tmplQueryReport.Execute(&buf, map[string]any{
+			"Group": "queryid",
+			"Order": "load DESC",
+			"Annotations": map[string][]string{
+				AnnotationAcknowledgedFilter: {"false"},
+				AnnotationOwnerFilter:        {"payments", "search"},
+			},
+		})
+		require.NoError(t, err)
+		query := whitespace.ReplaceAllString(buf.String(), " ")
+
+		assert.Contains(t, query, "AND queryid IN ( SELECT queryid FROM query_annotations GROUP BY queryid "+
+			"HAVING argMax(deleted, updated_at) = 0 AND argMax(if(acknowledged = 1, 'true', 'false'), updated_at) IN ( 'false' ) ) "+
+			"AND queryid IN ( SELECT queryid FROM query_annotations GROUP BY queryid "+
+			"HAVING argMax(deleted, updated_at) = 0 AND argMax(owner, updated_at) IN ( 'payments', 'search' ) )")
+	})
+
+	t.Run("Dimension", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := queryAnnotationDimensionTmpl.Execute(&buf, map[string]any{
+			"MainMetric":    "m_query_time_sum",
+			"DimensionName": AnnotationOwnerFilter,
+			"Dimensions":    map[string][]string{"service_name": {"mysql1"}},
+			"LbacFilter":    "service_type = 'mysql'",
+		})
+		require.NoError(t, err)
+		query := whitespace.ReplaceAllString(buf.String(), " ")
+
+		for _, expected := range []string{
+			"SELECT 'annotation_owner' AS key, annotations.value AS value,",
+			"sumIf(m_query_time_sum, service_name IN ('mysql1') ) AS main_metric_sum",
+			"SELECT queryid, argMax(owner, updated_at) AS value FROM query_annotations",
+			") AS annotations USING (queryid)",
+			"AND (service_type = 'mysql')",
+			"GROUP BY value WITH TOTALS",
+		} {
+			assert.Contains(t, query, expected)
+		}
+	})
+
+	t.Run("Select", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := tmplQueryAnnotations.Execute(&buf, map[string]any{
+			"QueryIDs":     true,
+			"Acknowledged": true,
+			"LbacFilter":   "service_type = 'mysql'",
+		})
+		require.NoError(t, err)
+		query := whitespace.ReplaceAllString(buf.String(), " ")
+
+		for _, expected := range []string{
+			"AND queryid IN (:queryids)",
+			"AND queryid IN (SELECT DISTINCT queryid FROM metrics WHERE service_type = 'mysql')",
+			"HAVING argMax(query_annotations.deleted, query_annotations.updated_at) = 0 AND acknowledged = :acknowledged",
+		} {
+			assert.Contains(t, query, expected)
+		}
+		assert.NotContains(t, query, ":owner")
+	})
+
+	t.Run("Visible", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := tmplQueryVisible.Execute(&buf, map[string]any{
+			"LbacFilter": "service_type = 'mysql'",
+		})
+		require.NoError(t, err)
+		query := whitespace.ReplaceAllString(buf.String(), " ")
+
+		assert.Equal(t, " SELECT count() FROM metrics WHERE queryid = :queryid "+
+			"AND queryid IN (SELECT DISTINCT queryid FROM metrics WHERE service_type = 'mysql') ", query)
+	})
+}
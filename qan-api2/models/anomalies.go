@@ -0,0 +1,177 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+package models
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// Anomalies represents methods to select data for query anomaly detection.
+type Anomalies struct {
+	db *sqlx.DB
+}
+
+// NewAnomalies initialize Anomalies with db instance.
+func NewAnomalies(db *sqlx.DB) Anomalies {
+	return Anomalies{db: db}
+}
+
+// QueryStats contains metrics of a single query in the detection window and its baseline.
+// Latency and rows examined baselines are computed over baseline buckets the query was executed in.
+// Call rate baseline should be computed with BaselineQueries and BaselineQueriesSq over all baseline buckets,
+// including the ones without executions.
+type QueryStats struct {
+	ServiceID           string   `db:"service_id"`
+	ServiceName         string   `db:"service_name"`
+	ServiceType         string   `db:"service_type"`
+	QueryID             string   `db:"queryid"`
+	Fingerprint         string   `db:"fingerprint"`
+	BaselineBuckets     uint64   `db:"baseline_buckets"`
+	LatencyMean         float64  `db:"latency_mean"`
+	LatencyStddev       float64  `db:"latency_stddev"`
+	RowsExaminedMean    float64  `db:"rows_examined_mean"`
+	RowsExaminedStddev  float64  `db:"rows_examined_stddev"`
+	BaselineQueries     uint64   `db:"baseline_queries"`
+	BaselineQueriesSq   uint64   `db:"baseline_queries_sq"`
+	CurrentQueries      uint64   `db:"current_queries"`
+	CurrentQueryTime    float64  `db:"current_query_time"`
+	CurrentRowsExamined float64  `db:"current_rows_examined"`
+	BaselinePlans       []string `db:"baseline_plans"`
+	CurrentPlans        []string `db:"current_plans"`
+}
+
+const queryStatsTmpl = `
+SELECT
+    service_id,
+    queryid,
+    any(service_name) AS service_name,
+    any(service_type) AS service_type,
+    any(fingerprint) AS fingerprint,
+    countIf(NOT is_current) AS baseline_buckets,
+    ifNotFinite(avgIf(bucket_query_time / bucket_queries, NOT is_current), 0) AS latency_mean,
+    ifNotFinite(stddevPopIf(bucket_query_time / bucket_queries, NOT is_current), 0) AS latency_stddev,
+    ifNotFinite(avgIf(bucket_rows_examined / bucket_queries, NOT is_current), 0) AS rows_examined_mean,
+    ifNotFinite(stddevPopIf(bucket_rows_examined / bucket_queries, NOT is_current), 0) AS rows_examined_stddev,
+    sumIf(bucket_queries, NOT is_current) AS baseline_queries,
+    sumIf(bucket_queries * bucket_queries, NOT is_current) AS baseline_queries_sq,
+    sumIf(bucket_queries, is_current) AS current_queries,
+    sumIf(bucket_query_time, is_current) AS current_query_time,
+    sumIf(bucket_rows_examined, is_current) AS current_rows_examined,
+    groupUniqArrayArrayIf(bucket_plans, NOT is_current) AS baseline_plans,
+    groupUniqArrayArrayIf(bucket_plans, is_current) AS current_plans
+FROM (
+    SELECT
+        service_id,
+        queryid,
+        toStartOfInterval(period_start, INTERVAL {{ .BucketSec }} SECOND) AS bucket,
+        bucket >= toDateTime(:window_start) AS is_current,
+        any(service_name) AS service_name,
+        any(service_type) AS service_type,
+        any(fingerprint) AS fingerprint,
+        toUInt64(SUM(num_queries)) AS bucket_queries,
+        SUM(m_query_time_sum) AS bucket_query_time,
+        SUM(m_rows_examined_sum) AS bucket_rows_examined,
+        groupUniqArrayIf(planid, planid != '') AS bucket_plans
+    FROM metrics
+    WHERE period_start >= :baseline_start AND period_start < :window_end AND queryid != ''
+    GROUP BY service_id, queryid, bucket
+    HAVING bucket_queries > 0
+)
+GROUP BY service_id, queryid
+HAVING current_queries > 0
+`
+
+var tmplQueryStats = template.Must(template.New("queryStats").Parse(queryStatsTmpl))
+
+// SelectQueryStats selects metrics of all queries executed in the detection window [windowStartSec, windowEndSec)
+// together with their baselines computed over the [baselineStartSec, windowStartSec) period split into buckets
+// of the given size. Window and baseline bounds should be aligned to the bucket size.
+func (a *Anomalies) SelectQueryStats(ctx context.Context, baselineStartSec, windowStartSec, windowEndSec, bucketSec int64) ([]*QueryStats, error) {
+	var queryBuffer bytes.Buffer
+	if err := tmplQueryStats.Execute(&queryBuffer, map[string]any{"BucketSec": bucketSec}); err != nil {
+		return nil, fmt.Errorf("cannot execute tmplQueryStats: %w", err)
+	}
+
+	arg := map[string]any{
+		"baseline_start": baselineStartSec,
+		"window_start":   windowStartSec,
+		"window_end":     windowEndSec,
+	}
+	query, args, err := sqlx.Named(queryBuffer.String(), arg)
+	if err != nil {
+		return nil, fmt.Errorf("prepare named: %w", err)
+	}
+	query = a.db.Rebind(query)
+
+	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	var res []*QueryStats
+	if err = a.db.SelectContext(queryCtx, &res, query, args...); err != nil {
+		return nil, fmt.Errorf("query stats: %w", err)
+	}
+
+	return res, nil
+}
+
+const lbacServicesTmpl = `
+SELECT DISTINCT service_id
+FROM metrics
+WHERE period_start >= :period_start_from AND ({{ .LbacFilter }})
+`
+
+var tmplLbacServices = template.Must(template.New("lbacServices").Parse(lbacServicesTmpl))
+
+// SelectLBACServices returns IDs of services with data since the given time that are visible
+// with LBAC filters of the request. It returns nil map if the request is not restricted by LBAC.
+func (a *Anomalies) SelectLBACServices(ctx context.Context, periodStartFromSec int64) (map[string]struct{}, error) {
+	lbacFilter, err := headersToLbacFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if lbacFilter == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	var queryBuffer bytes.Buffer
+	if err = tmplLbacServices.Execute(&queryBuffer, map[string]any{"LbacFilter": lbacFilter}); err != nil {
+		return nil, fmt.Errorf("cannot execute tmplLbacServices: %w", err)
+	}
+
+	query, args, err := sqlx.Named(queryBuffer.String(), map[string]any{"period_start_from": periodStartFromSec})
+	if err != nil {
+		return nil, fmt.Errorf("prepare named: %w", err)
+	}
+	query = a.db.Rebind(query)
+
+	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	var serviceIDs []string
+	if err = a.db.SelectContext(queryCtx, &serviceIDs, query, args...); err != nil {
+		return nil, fmt.Errorf("LBAC services: %w", err)
+	}
+
+	res := make(map[string]struct{}, len(serviceIDs))
+	for _, id := range serviceIDs {
+		res[id] = struct{}{}
+	}
+	return res, nil
+}
@@ -381,6 +381,18 @@ func isValidMetricColumn(name string) bool {
 		"m_parallel_workers_to_launch_sum":                    {},
 		"m_parallel_workers_launched_cnt":                     {},
 		"m_parallel_workers_launched_sum":                     {},
+		"m_args_cnt":                                          {},
+		"m_args_sum":                                          {},
+		"m_args_min":                                          {},
+		"m_args_max":                                          {},
+		"m_total_calls_cnt":                                   {},
+		"m_total_calls_sum":                                   {},
+		"m_total_time_cnt":                                    {},
+		"m_total_time_sum":                                    {},
+		"m_rejected_calls_cnt":                                {},
+		"m_rejected_calls_sum":                                {},
+		"m_failed_calls_cnt":                                  {},
+		"m_failed_calls_sum":                                  {},
 		"m_plan_total_time":                                   {},
 		"m_plan_min_time":                                     {},
 		"m_plan_max_time":                                     {},
@@ -470,6 +482,9 @@ func agentTypeToClickHouseEnum(agentType inventoryv1.AgentType) string {
 		inventoryv1.AgentType_AGENT_TYPE_QAN_MONGODB_MONGOLOG_AGENT:         "qan-mongodb-mongolog-agent",
 		inventoryv1.AgentType_AGENT_TYPE_QAN_POSTGRESQL_PGSTATEMENTS_AGENT:  "qan-postgresql-pgstatements-agent",
 		inventoryv1.AgentType_AGENT_TYPE_QAN_POSTGRESQL_PGSTATMONITOR_AGENT: "qan-postgresql-pgstatmonitor-agent",
+		inventoryv1.AgentType_AGENT_TYPE_QAN_VALKEY_SLOWLOG_AGENT:           "qan-valkey-slowlog-agent",
+		inventoryv1.AgentType_AGENT_TYPE_QAN_PROXYSQL_DIGEST_AGENT:          "qan-proxysql-digest-agent",
+		inventoryv1.AgentType_AGENT_TYPE_QAN_POSTGRESQL_LOG_AGENT:           "qan-postgresql-log-agent",
 	}
 
 	if val, ok := agentTypes[agentType]; ok {
@@ -316,7 +316,19 @@ const insertSQL = `
     m_storage_time_reading_micros_sum,
     m_storage_time_reading_micros_min,
     m_storage_time_reading_micros_max,
-    m_storage_time_reading_micros_p99
+    m_storage_time_reading_micros_p99,
+    m_args_cnt,
+    m_args_sum,
+    m_args_min,
+    m_args_max,
+    m_total_calls_cnt,
+    m_total_calls_sum,
+    m_total_time_cnt,
+    m_total_time_sum,
+    m_rejected_calls_cnt,
+    m_rejected_calls_sum,
+    m_failed_calls_cnt,
+    m_failed_calls_sum
    )
   VALUES (
     :queryid,
@@ -345,7 +357,7 @@ const insertSQL = `
     :labels_key,
     :labels_value,
     :agent_id,
-    CAST( :agent_type_s AS Enum8('qan-agent-type-invalid'=0, 'qan-mysql-perfschema-agent'=1, 'qan-mysql-slowlog-agent'=2, 'qan-mongodb-profiler-agent'=3, 'qan-postgresql-pgstatements-agent'=4, 'qan-postgresql-pgstatmonitor-agent'=5)) AS agent_type,
+    CAST( :agent_type_s AS Enum8('qan-agent-type-invalid'=0, 'qan-mysql-perfschema-agent'=1, 'qan-mysql-slowlog-agent'=2, 'qan-mongodb-profiler-agent'=3, 'qan-postgresql-pgstatements-agent'=4, 'qan-postgresql-pgstatmonitor-agent'=5, 'qan-mongodb-mongolog-agent'=6, 'qan-valkey-slowlog-agent'=7, 'qan-proxysql-digest-agent'=8, 'qan-postgresql-log-agent'=9)) AS agent_type,
     :period_start_ts,
     :period_length_secs,
     :fingerprint,
@@ -587,7 +599,19 @@ const insertSQL = `
     :m_storage_time_reading_micros_sum,
     :m_storage_time_reading_micros_min,
     :m_storage_time_reading_micros_max,
-    :m_storage_time_reading_micros_p99  
+    :m_storage_time_reading_micros_p99,
+    :m_args_cnt,
+    :m_args_sum,
+    :m_args_min,
+    :m_args_max,
+    :m_total_calls_cnt,
+    :m_total_calls_sum,
+    :m_total_time_cnt,
+    :m_total_time_sum,
+    :m_rejected_calls_cnt,
+    :m_rejected_calls_sum,
+    :m_failed_calls_cnt,
+    :m_failed_calls_sum
   )
 `
 
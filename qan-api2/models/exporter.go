@@ -0,0 +1,227 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"text/template"
+
+	"github.com/percona/pmm/qan-api2/utils/logger"
+)
+
+// ClickHouse output formats of exported data.
+const (
+	ExportFormatCSV     = "CSVWithNames"
+	ExportFormatJSONL   = "JSONEachRow"
+	ExportFormatParquet = "Parquet"
+)
+
+// maxExportErrorSize limits the size of ClickHouse error message read from the response.
+const maxExportErrorSize = 64 * 1024
+
+// queryExportTmpl aggregates metrics per dimension, and optionally per time bucket.
+// Unlike the report query, it is executed over ClickHouse HTTP interface, so the search string
+// is passed as a query parameter instead of the named argument.
+const queryExportTmpl = `
+SELECT
+{{ if .BucketSec }}
+    toStartOfInterval(period_start, INTERVAL {{ .BucketSec }} SECOND) AS bucket_start,
+{{ end }}
+{{ .Group }},
+any(database) AS database_name,
+{{ if eq .Group "queryid" }} any(fingerprint) {{ else }} '' {{ end }} AS fingerprint,
+{{range $j, $col := .CommonColumns}}
+    SUM(m_{{ $col }}_cnt) AS m_{{ $col }}_cnt,
+    SUM(m_{{ $col }}_sum) AS m_{{ $col }}_sum,
+    MIN(m_{{ $col }}_min) AS m_{{ $col }}_min,
+    MAX(m_{{ $col }}_max) AS m_{{ $col }}_max,
+    AVG(m_{{ $col }}_p99) AS m_{{ $col }}_p99,
+    m_{{ $col }}_sum/num_queries AS m_{{ $col }}_avg,
+{{ end }}
+{{range $j, $col := .SumColumns}}
+    SUM(m_{{ $col }}_cnt) AS m_{{ $col }}_cnt,
+    SUM(m_{{ $col }}_sum) AS m_{{ $col }}_sum,
+    m_{{ $col }}_sum/num_queries AS m_{{ $col }}_avg,
+{{ end }}
+{{range $j, $col := .SpecialColumns}}
+    {{ if eq $col "load" }}
+        SUM(metrics.m_query_time_sum) / {{ $.LoadDuration }} AS load,
+    {{ else if ne $col "num_queries" }}
+        SUM({{ $col }}) AS {{ $col }},
+    {{ end }}
+{{ end }}
+SUM(num_queries) AS num_queries
+FROM metrics
+WHERE period_start >= {{ .PeriodStartFrom }} AND period_start <= {{ .PeriodStartTo }}
+{{ template "reportFilters" . }}
+{{ if .Searched }}
+  {{ if eq .Group "queryid" }}
+    AND ( lowerUTF8(queryid) LIKE {search:String} OR lowerUTF8(metrics.fingerprint) LIKE {search:String} )
+  {{ else }}
+    AND lowerUTF8({{ .Group }}) LIKE {search:String}
+  {{ end }}
+{{ end }}
+GROUP BY {{ if .BucketSec }} bucket_start, {{ end }} {{ .Group }}
+ORDER BY {{ if .BucketSec }} bucket_start, {{ end }} num_queries DESC
+FORMAT {{ .Format }}
+`
+
+var tmplQueryExport = template.Must(template.New("queryExportTmpl").Funcs(funcMap).Parse(queryExportTmpl + queryReportFiltersTmpl))
+
+// ExportParams defines filtering, granularity and format of exported metrics.
+type ExportParams struct {
+	PeriodStartFromSec int64
+	PeriodStartToSec   int64
+	// BucketSec is the length of time buckets; metrics are aggregated over the whole period if it is zero.
+	BucketSec      int64
+	Dimensions     map[string][]string
+	Labels         map[string][]string
+	Group          string
+	Search         string
+	SpecialColumns []string
+	CommonColumns  []string
+	SumColumns     []string
+	// Format is one of ExportFormat constants.
+	Format string
+}
+
+// Exporter exports metrics over ClickHouse HTTP interface,
+// so data is streamed to the client in the requested format as ClickHouse produces it.
+type Exporter struct {
+	client   *http.Client
+	url      string
+	user     string
+	password string
+}
+
+// NewExporter creates Exporter for ClickHouse HTTP interface at the given address.
+func NewExporter(addr, database, user, password string) Exporter {
+	u := &url.URL{
+		Scheme:   "http",
+		Host:     addr,
+		Path:     "/",
+		RawQuery: url.Values{"database": []string{database}}.Encode(),
+	}
+	return Exporter{
+		client:   new(http.Client),
+		url:      u.String(),
+		user:     user,
+		password: password,
+	}
+}
+
+// Export starts export of metrics and returns the reader of exported data. The caller should close it.
+// The LBAC filter from the context is applied the same way as for the report.
+func (e *Exporter) Export(ctx context.Context, params *ExportParams) (io.ReadCloser, error) {
+	lbacFilter, err := headersToLbacFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	search := strings.TrimSpace(params.Search)
+	labels, annotations := splitAnnotationFilters(params.Labels)
+	loadDuration := params.BucketSec
+	if loadDuration == 0 {
+		loadDuration = params.PeriodStartToSec - params.PeriodStartFromSec
+	}
+
+	tmplArgs := struct {
+		PeriodStartFrom int64
+		PeriodStartTo   int64
+		BucketSec       int64
+		LoadDuration    int64
+		Dimensions      map[string][]string
+		Labels          map[string][]string
+		Annotations     map[string][]string
+		Group           string
+		Search          string // always empty: the search condition of report filters uses the named argument
+		Searched        bool
+		SpecialColumns  []string
+		CommonColumns   []string
+		SumColumns      []string
+		LbacFilter      string
+		Format          string
+	}{
+		PeriodStartFrom: params.PeriodStartFromSec,
+		PeriodStartTo:   params.PeriodStartToSec,
+		BucketSec:       params.BucketSec,
+		LoadDuration:    max(loadDuration, 1),
+		Dimensions:      escapeValuesInMap(params.Dimensions),
+		Labels:          escapeValuesInMap(labels),
+		Annotations:     escapeValuesInMap(annotations),
+		Group:           params.Group,
+		Searched:        search != "",
+		SpecialColumns:  params.SpecialColumns,
+		CommonColumns:   params.CommonColumns,
+		SumColumns:      params.SumColumns,
+		LbacFilter:      lbacFilter,
+		Format:          params.Format,
+	}
+
+	var queryBuffer bytes.Buffer
+	if err = tmplQueryExport.Execute(&queryBuffer, tmplArgs); err != nil {
+		return nil, fmt.Errorf("cannot execute %s: %w", tmplQueryExport.Name(), err)
+	}
+	query := queryBuffer.String()
+	logger.Get(ctx).Debugf("QueryExport: %s, search: %q", query, search)
+
+	u, err := url.Parse(e.url)
+	if err != nil {
+		return nil, err
+	}
+	q := u.Query()
+	q.Set("param_search", "%"+strings.ToLower(search)+"%")
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u.String(), strings.NewReader(query))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-ClickHouse-User", e.user)
+	req.Header.Set("X-ClickHouse-Key", e.password)
+
+	resp, err := e.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute export query: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close() //nolint:errcheck
+		b, _ := io.ReadAll(io.LimitReader(resp.Body, maxExportErrorSize))
+		return nil, fmt.Errorf("export query failed with status %d: %s", resp.StatusCode, bytes.TrimSpace(b))
+	}
+
+	return resp.Body, nil
+}
+
+// escapeValuesInMap escapes keys and values of labels and dimensions, as they are inlined into the query.
+func escapeValuesInMap(m map[string][]string) map[string][]string {
+	escapedMap := make(map[string][]string, len(m))
+	for k, v := range m {
+		key := escapeValue(k)
+		escapedMap[key] = make([]string, len(v))
+		for i, value := range v {
+			escapedMap[key][i] = escapeValue(value)
+		}
+	}
+	return escapedMap
+}
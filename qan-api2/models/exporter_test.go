@@ -0,0 +1,120 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExporter(t *testing.T) {
+	var (
+		query  string
+		values url.Values
+		header http.Header
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		b, _ := io.ReadAll(req.Body)
+		query, values, header = string(b), req.URL.Query(), req.Header
+		if strings.Contains(query, "unknown") {
+			http.Error(rw, "Code: 47. DB::Exception: Unknown identifier", http.StatusNotFound)
+			return
+		}
+		_, _ = io.WriteString(rw, "\"queryid\",\"num_queries\"\n\"ABC\",5\n")
+	}))
+	t.Cleanup(srv.Close)
+
+	e := NewExporter(strings.TrimPrefix(srv.URL, "http://"), "pmm", "default", "clickhouse")
+
+	t.Run("Normal", func(t *testing.T) {
+		ctx := setup(t, `["{service_type=\"mysql\"}"]`)
+		r, err := e.Export(ctx, &ExportParams{
+			PeriodStartFromSec: 1735812000,
+			PeriodStartToSec:   1735815600,
+			BucketSec:          300,
+			Dimensions:         map[string][]string{"service_name": {"it's"}},
+			Labels:             map[string][]string{AnnotationOwnerFilter: {"dba"}},
+			Group:              "queryid",
+			Search:             " SELECT ",
+			SpecialColumns:     []string{"load", "num_queries"},
+			CommonColumns:      []string{"query_time"},
+			Format:             ExportFormatCSV,
+		})
+		require.NoError(t, err)
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+		assert.Equal(t, "\"queryid\",\"num_queries\"\n\"ABC\",5\n", string(data))
+
+		assert.Equal(t, "pmm", values.Get("database"))
+		assert.Equal(t, "%select%", values.Get("param_search"))
+		assert.Equal(t, "default", header.Get("X-ClickHouse-User"))
+		assert.Equal(t, "clickhouse", header.Get("X-ClickHouse-Key"))
+
+		query = strings.Join(strings.Fields(query), " ")
+		for _, expected := range []string{
+			"toStartOfInterval(period_start, INTERVAL 300 SECOND) AS bucket_start",
+			"SUM(m_query_time_sum) AS m_query_time_sum",
+			"SUM(metrics.m_query_time_sum) / 300 AS load",
+			"WHERE period_start >= 1735812000 AND period_start <= 1735815600",
+			"AND service_name IN ( 'it''s' )",
+			"AND (service_type = 'mysql')",
+			"argMax(owner, updated_at) IN ( 'dba' )",
+			"LIKE {search:String}",
+			"GROUP BY bucket_start, queryid",
+			"FORMAT CSVWithNames",
+		} {
+			assert.Contains(t, query, expected)
+		}
+		assert.NotContains(t, query, ":search")
+	})
+
+	t.Run("WholePeriod", func(t *testing.T) {
+		ctx := setup(t, `[]`)
+		r, err := e.Export(ctx, &ExportParams{
+			PeriodStartFromSec: 1735812000,
+			PeriodStartToSec:   1735815600,
+			Group:              "service_name",
+			SpecialColumns:     []string{"load"},
+			Format:             ExportFormatParquet,
+		})
+		require.NoError(t, err)
+		require.NoError(t, r.Close())
+
+		query = strings.Join(strings.Fields(query), " ")
+		assert.NotContains(t, query, "bucket_start")
+		assert.NotContains(t, query, "LIKE")
+		assert.Contains(t, query, "SUM(metrics.m_query_time_sum) / 3600 AS load")
+		assert.Contains(t, query, "FORMAT Parquet")
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		ctx := setup(t, `[]`)
+		_, err := e.Export(ctx, &ExportParams{
+			Group:          "queryid",
+			SpecialColumns: []string{"unknown"},
+			Format:         ExportFormatJSONL,
+		})
+		require.EqualError(t, err, "export query failed with status 404: Code: 47. DB::Exception: Unknown identifier")
+	})
+}
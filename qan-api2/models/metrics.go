@@ -45,12 +45,13 @@ const (
 
 // Metrics represents methods to work with metrics.
 type Metrics struct {
-	db *sqlx.DB
+	db      *sqlx.DB
+	rollups *Rollups
 }
 
-// NewMetrics initialize Metrics with db instance.
-func NewMetrics(db *sqlx.DB) Metrics {
-	return Metrics{db: db}
+// NewMetrics initialize Metrics with db instance and optional rollups.
+func NewMetrics(db *sqlx.DB, rollups *Rollups) Metrics {
+	return Metrics{db: db, rollups: rollups}
 }
 
 // Get select metrics for specific queryid, hostname, etc.
@@ -73,6 +74,7 @@ func (m *Metrics) Get(ctx context.Context, periodStartFromSec, periodStartToSec
 		DimensionVal    string
 		Group           string
 		Totals          bool
+		Sources         []*metricsSource
 	}{
 		PeriodStartFrom: periodStartFromSec,
 		PeriodStartTo:   periodStartToSec,
@@ -82,9 +84,10 @@ func (m *Metrics) Get(ctx context.Context, periodStartFromSec, periodStartToSec
 		DimensionVal:    escapeColons(filter),
 		Group:           group,
 		Totals:          totals,
+		Sources:         m.rollups.sources(periodStartFromSec, reportResolution(periodStartFromSec, periodStartToSec), time.Now()),
 	}
 	var queryBuffer bytes.Buffer
-	tmpl, err := template.New("queryMetricsTmpl").Funcs(funcMap).Parse(queryMetricsTmpl)
+	tmpl, err := template.New("queryMetricsTmpl").Funcs(funcMap).Parse(queryMetricsTmpl + metricsTableTmpl)
 	if err != nil {
 		log.Fatalln(err)
 	}
@@ -321,6 +324,20 @@ SUM(m_parallel_workers_to_launch_cnt) as m_parallel_workers_to_launch_cnt,
 SUM(m_parallel_workers_launched_sum) as m_parallel_workers_launched_sum,
 SUM(m_parallel_workers_launched_cnt) as m_parallel_workers_launched_cnt,
 
+SUM(m_args_cnt) AS m_args_cnt,
+SUM(m_args_sum) AS m_args_sum,
+MIN(m_args_min) AS m_args_min,
+MAX(m_args_max) AS m_args_max,
+
+SUM(m_total_calls_sum) AS m_total_calls_sum,
+SUM(m_total_calls_cnt) AS m_total_calls_cnt,
+SUM(m_total_time_sum) AS m_total_time_sum,
+SUM(m_total_time_cnt) AS m_total_time_cnt,
+SUM(m_rejected_calls_sum) AS m_rejected_calls_sum,
+SUM(m_rejected_calls_cnt) AS m_rejected_calls_cnt,
+SUM(m_failed_calls_sum) AS m_failed_calls_sum,
+SUM(m_failed_calls_cnt) AS m_failed_calls_cnt,
+
 SUM(m_plan_time_cnt) AS m_plan_time_cnt,
 SUM(m_plan_time_sum) AS m_plan_time_sum,
 MIN(m_plan_time_min) AS m_plan_time_min,
@@ -374,7 +391,7 @@ MIN(m_storage_time_reading_micros_min) AS m_storage_time_reading_micros_min,
 MAX(m_storage_time_reading_micros_max) AS m_storage_time_reading_micros_max,
 AVG(m_storage_time_reading_micros_p99) AS m_storage_time_reading_micros_p99
 
-FROM metrics
+FROM {{ template "metricsTable" . }}
 WHERE period_start >= :period_start_from AND period_start <= :period_start_to
 {{ if not .Totals }} AND {{ .Group }} = '{{ .DimensionVal }}' {{ end }}
 {{ if .Dimensions }}
@@ -478,7 +495,7 @@ AS m_locks_collection_acquire_count_read_shared_sum_per_sec,
 if(SUM(m_storage_bytes_read_cnt) == 0, NaN, SUM(m_storage_bytes_read_sum) / time_frame) AS m_storage_bytes_read_sum_per_sec,
 if(SUM(m_storage_time_reading_micros_cnt) == 0, NaN, SUM(m_storage_time_reading_micros_sum) / time_frame) AS m_storage_time_reading_micros_sum_per_sec
 
-FROM metrics
+FROM {{ template "metricsTable" . }}
 WHERE period_start >= :period_start_from AND period_start <= :period_start_to
 {{ if .DimensionVal }} AND {{ .Group }} = '{{ .DimensionVal }}' {{ end }}
 {{ if .Dimensions }}
@@ -495,7 +512,7 @@ GROUP BY point
 	ORDER BY point ASC;
 `
 
-var tmplMetricsSparklines = template.Must(template.New("queryMetricsSparklines").Funcs(funcMap).Parse(queryMetricsSparklinesTmpl))
+var tmplMetricsSparklines = template.Must(template.New("queryMetricsSparklines").Funcs(funcMap).Parse(queryMetricsSparklinesTmpl + metricsTableTmpl))
 
 // SelectSparklines selects datapoint for sparklines.
 func (m *Metrics) SelectSparklines(ctx context.Context, periodStartFromSec, periodStartToSec int64,
@@ -536,6 +553,7 @@ func (m *Metrics) SelectSparklines(ctx context.Context, periodStartFromSec, peri
 		DimensionVal    string
 		TimeFrame       int64
 		Group           string
+		Sources         []*metricsSource
 	}{
 		PeriodStartFrom: periodStartFromSec,
 		PeriodStartTo:   periodStartToSec,
@@ -545,6 +563,7 @@ func (m *Metrics) SelectSparklines(ctx context.Context, periodStartFromSec, peri
 		DimensionVal:    escapeColons(filter),
 		TimeFrame:       timeFrame,
 		Group:           group,
+		Sources:         m.rollups.sources(periodStartFromSec, time.Duration(timeFrame)*time.Second, time.Now()),
 	}
 
 	var results []*qanv1.Point
@@ -905,7 +924,8 @@ func (m *Metrics) GetFingerprintByQueryID(ctx context.Context, queryID string) (
 	return fingerprint, nil
 }
 
-const planByQueryID = `SELECT planid, query_plan FROM metrics WHERE queryid = ? LIMIT 1`
+// planByQueryID prefers the latest collected plan, as not every bucket of the query contains one.
+const planByQueryID = `SELECT planid, query_plan FROM metrics WHERE queryid = ? ORDER BY query_plan != '' DESC, period_start DESC LIMIT 1`
 
 // SelectQueryPlan selects query plan and related stuff for given queryid.
 func (m *Metrics) SelectQueryPlan(ctx context.Context, queryID string) (*qanv1.GetQueryPlanResponse, error) {
@@ -49,7 +49,7 @@ func TestMetrics_Get(t *testing.T) {
 	t.Parallel()
 	ctx := t.Context()
 	sqlxDB := setupTestClickHouse(t)
-	m := NewMetrics(sqlxDB)
+	m := NewMetrics(sqlxDB, nil)
 
 	t1, err := time.Parse(time.RFC3339, "2019-01-01T00:00:00Z")
 	require.NoError(t, err)
@@ -122,7 +122,7 @@ func TestMetrics_SelectQueryExamples(t *testing.T) {
 	t.Parallel()
 	ctx := t.Context()
 	sqlxDB := setupTestClickHouse(t)
-	m := NewMetrics(sqlxDB)
+	m := NewMetrics(sqlxDB, nil)
 
 	periodFrom, err := time.Parse(time.RFC3339, "2019-01-01T00:00:00Z")
 	require.NoError(t, err)
@@ -216,7 +216,7 @@ func TestMetrics_SchemaByQueryID(t *testing.T) {
 	t.Parallel()
 	ctx := t.Context()
 	sqlxDB := setupTestClickHouse(t)
-	m := NewMetrics(sqlxDB)
+	m := NewMetrics(sqlxDB, nil)
 
 	t.Run("Get schema for existing query ID and service ID", func(t *testing.T) {
 		t.Parallel()
@@ -247,7 +247,7 @@ func TestMetrics_ExplainFingerprintByQueryID(t *testing.T) {
 	t.Parallel()
 	ctx := t.Context()
 	sqlxDB := setupTestClickHouse(t)
-	m := NewMetrics(sqlxDB)
+	m := NewMetrics(sqlxDB, nil)
 
 	t.Run("Get fingerprint", func(t *testing.T) {
 		t.Parallel()
@@ -0,0 +1,215 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// QueryPlanSample is a plan of the query collected at some point in time.
+type QueryPlanSample struct {
+	ServiceName string    `db:"service_name"`
+	ServiceType string    `db:"service_type"`
+	PlanID      string    `db:"plan_id"`
+	CollectedAt time.Time `db:"collected_at"`
+}
+
+// QueryPlanVersion is a plan of the query observed by consecutive samples.
+type QueryPlanVersion struct {
+	PlanID    string
+	FirstSeen time.Time
+	LastSeen  time.Time
+	Samples   uint32
+}
+
+// QueryPlansFilter defines the query and the period to select plan samples for.
+// Zero From and To are not applied.
+type QueryPlansFilter struct {
+	ServiceID string
+	QueryID   string
+	From      time.Time
+	To        time.Time
+}
+
+// QueryPlans reads query plans collected by pmm-managed plan history.
+// Plans are written by pmm-managed directly and removed by the table TTL.
+type QueryPlans struct {
+	db *sqlx.DB
+}
+
+// NewQueryPlans initialize QueryPlans with db instance.
+func NewQueryPlans(db *sqlx.DB) QueryPlans {
+	return QueryPlans{db: db}
+}
+
+const queryPlanSamplesTmpl = `
+SELECT service_name, service_type, plan_id, collected_at
+FROM query_plans
+WHERE service_id = :service_id AND queryid = :queryid
+{{ if .From }}
+    AND collected_at >= :from
+{{ end }}
+{{ if .To }}
+    AND collected_at < :to
+{{ end }}
+{{ if .LbacFilter }}
+    AND service_id IN (SELECT DISTINCT service_id FROM metrics WHERE {{ .LbacFilter }})
+{{ end }}
+ORDER BY collected_at
+`
+
+var tmplQueryPlanSamples = template.Must(template.New("queryPlanSamples").Parse(queryPlanSamplesTmpl))
+
+// SelectSamples returns plan samples of the query ordered by collection time.
+// Samples of services without metrics visible with LBAC filters of the request are skipped.
+func (qp *QueryPlans) SelectSamples(ctx context.Context, filter *QueryPlansFilter) ([]*QueryPlanSample, error) {
+	lbacFilter, err := headersToLbacFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tmplArgs := struct {
+		From       bool
+		To         bool
+		LbacFilter string
+	}{
+		From:       !filter.From.IsZero(),
+		To:         !filter.To.IsZero(),
+		LbacFilter: lbacFilter,
+	}
+
+	var queryBuffer bytes.Buffer
+	if err = tmplQueryPlanSamples.Execute(&queryBuffer, tmplArgs); err != nil {
+		return nil, fmt.Errorf("cannot execute tmplQueryPlanSamples: %w", err)
+	}
+
+	query, args, err := sqlx.Named(queryBuffer.String(), map[string]any{
+		"service_id": filter.ServiceID,
+		"queryid":    filter.QueryID,
+		"from":       filter.From,
+		"to":         filter.To,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("prepare named: %w", err)
+	}
+	query = qp.db.Rebind(query)
+
+	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	var res []*QueryPlanSample
+	if err = qp.db.SelectContext(queryCtx, &res, query, args...); err != nil {
+		return nil, fmt.Errorf("query plan samples: %w", err)
+	}
+
+	return res, nil
+}
+
+const queryPlansByIDTmpl = `
+SELECT plan_id, any(plan) AS plan
+FROM query_plans
+WHERE service_id = :service_id AND queryid = :queryid AND plan_id IN (:plan_ids)
+{{ if .LbacFilter }}
+    AND service_id IN (SELECT DISTINCT service_id FROM metrics WHERE {{ .LbacFilter }})
+{{ end }}
+GROUP BY plan_id
+`
+
+var tmplQueryPlansByID = template.Must(template.New("queryPlansByID").Parse(queryPlansByIDTmpl))
+
+// SelectPlans returns normalized plans of the query mapped by plan ID.
+func (qp *QueryPlans) SelectPlans(ctx context.Context, serviceID, queryID string, planIDs []string) (map[string]string, error) {
+	if len(planIDs) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	lbacFilter, err := headersToLbacFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var queryBuffer bytes.Buffer
+	if err = tmplQueryPlansByID.Execute(&queryBuffer, map[string]any{"LbacFilter": lbacFilter}); err != nil {
+		return nil, fmt.Errorf("cannot execute tmplQueryPlansByID: %w", err)
+	}
+
+	query, args, err := sqlx.Named(queryBuffer.String(), map[string]any{
+		"service_id": serviceID,
+		"queryid":    queryID,
+		"plan_ids":   planIDs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("prepare named: %w", err)
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("populate arguments in IN clause: %w", err)
+	}
+	query = qp.db.Rebind(query)
+
+	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	rows, err := qp.db.QueryxContext(queryCtx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query plans: %w", err)
+	}
+	defer rows.Close() //nolint:errcheck
+
+	res := make(map[string]string, len(planIDs))
+	for rows.Next() {
+		var planID, plan string
+		if err = rows.Scan(&planID, &plan); err != nil {
+			return nil, fmt.Errorf("failed to scan plan: %w", err)
+		}
+		res[planID] = plan
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf("query plans: %w", err)
+	}
+
+	return res, nil
+}
+
+// CollapsePlanSamples collapses consecutive samples of the same plan into versions.
+// Samples must be ordered by collection time.
+func CollapsePlanSamples(samples []*QueryPlanSample) []*QueryPlanVersion {
+	var res []*QueryPlanVersion
+	for _, s := range samples {
+		if len(res) != 0 {
+			last := res[len(res)-1]
+			if last.PlanID == s.PlanID {
+				last.LastSeen = s.CollectedAt
+				last.Samples++
+				continue
+			}
+		}
+
+		res = append(res, &QueryPlanVersion{
+			PlanID:    s.PlanID,
+			FirstSeen: s.CollectedAt,
+			LastSeen:  s.CollectedAt,
+			Samples:   1,
+		})
+	}
+	return res
+}
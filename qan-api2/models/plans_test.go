@@ -0,0 +1,78 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCollapsePlanSamples(t *testing.T) {
+	t0 := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	sample := func(planID string, hours int) *QueryPlanSample {
+		return &QueryPlanSample{PlanID: planID, CollectedAt: t0.Add(time.Duration(hours) * time.Hour)}
+	}
+
+	assert.Empty(t, CollapsePlanSamples(nil))
+
+	versions := CollapsePlanSamples([]*QueryPlanSample{
+		sample("a", 0),
+		sample("a", 1),
+		sample("b", 2),
+		sample("a", 3),
+		sample("a", 4),
+		sample("a", 5),
+	})
+	expected := []*QueryPlanVersion{
+		{PlanID: "a", FirstSeen: t0, LastSeen: t0.Add(time.Hour), Samples: 2},
+		{PlanID: "b", FirstSeen: t0.Add(2 * time.Hour), LastSeen: t0.Add(2 * time.Hour), Samples: 1},
+		{PlanID: "a", FirstSeen: t0.Add(3 * time.Hour), LastSeen: t0.Add(5 * time.Hour), Samples: 3},
+	}
+	assert.Equal(t, expected, versions)
+}
+
+func TestQueryPlansTemplates(t *testing.T) {
+	whitespace := regexp.MustCompile(`\s+`)
+
+	t.Run("Samples", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := tmplQueryPlanSamples.Execute(&buf, map[string]any{
+			"From":       true,
+			"LbacFilter": "service_type = 'mysql'",
+		})
+		require.NoError(t, err)
+		query := whitespace.ReplaceAllString(buf.String(), " ")
+
+		assert.Contains(t, query, "WHERE service_id = :service_id AND queryid = :queryid AND collected_at >= :from "+
+			"AND service_id IN (SELECT DISTINCT service_id FROM metrics WHERE service_type = 'mysql') ORDER BY collected_at")
+		assert.NotContains(t, query, ":to")
+	})
+
+	t.Run("Plans", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := tmplQueryPlansByID.Execute(&buf, map[string]any{})
+		require.NoError(t, err)
+		query := whitespace.ReplaceAllString(buf.String(), " ")
+
+		assert.Contains(t, query, "plan_id IN (:plan_ids) GROUP BY plan_id")
+		assert.NotContains(t, query, "metrics")
+	})
+}
@@ -0,0 +1,146 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// IndexRecommendation is a candidate index for the query class made by pmm-managed index advisor.
+type IndexRecommendation struct {
+	ServiceID       string    `db:"service_id"`
+	ServiceName     string    `db:"service_name"`
+	ServiceType     string    `db:"service_type"`
+	QueryID         string    `db:"queryid"`
+	Fingerprint     string    `db:"fingerprint"`
+	Database        string    `db:"database"`
+	Table           string    `db:"table_name"`
+	Columns         []string  `db:"columns"`
+	Statement       string    `db:"statement"`
+	Reason          string    `db:"reason"`
+	EvidenceNames   []string  `db:"evidence_names"`
+	EvidenceValues  []float64 `db:"evidence_values"`
+	ExistingIndexes []string  `db:"existing_indexes"`
+	CreatedAt       time.Time `db:"created_at"`
+}
+
+// IndexRecommendationsFilter defines filtering of index recommendations; empty fields are not applied.
+type IndexRecommendationsFilter struct {
+	ServiceID string
+	QueryID   string
+}
+
+// IndexRecommendations reads index recommendations made by pmm-managed index advisor.
+// Recommendations are written by pmm-managed directly on every analysis and removed by the table TTL.
+// When the analysis does not recommend the index anymore, pmm-managed writes a resolved row for it.
+type IndexRecommendations struct {
+	db *sqlx.DB
+}
+
+// NewIndexRecommendations initialize IndexRecommendations with db instance.
+func NewIndexRecommendations(db *sqlx.DB) IndexRecommendations {
+	return IndexRecommendations{db: db}
+}
+
+const queryIndexRecommendationsTmpl = `
+SELECT
+    service_id, service_name, service_type, queryid, fingerprint, database, table_name, columns,
+    statement, reason, evidence_names, evidence_values, existing_indexes, created_at
+FROM (
+    SELECT
+        service_id, service_name, service_type, queryid, fingerprint, database, table_name, columns,
+        statement, reason, evidence.name AS evidence_names, evidence.value AS evidence_values,
+        existing_indexes, resolved, created_at
+    FROM index_recommendations
+    WHERE 1
+    {{ if .ServiceID }}
+        AND service_id = :service_id
+    {{ end }}
+    {{ if .QueryID }}
+        AND queryid = :queryid
+    {{ end }}
+    {{ if .LbacFilter }}
+        AND service_id IN (SELECT DISTINCT service_id FROM metrics WHERE {{ .LbacFilter }})
+    {{ end }}
+    ORDER BY created_at DESC
+    LIMIT 1 BY service_id, queryid, statement
+)
+WHERE resolved = 0
+ORDER BY created_at DESC
+`
+
+var tmplQueryIndexRecommendations = template.Must(template.New("queryIndexRecommendations").Parse(queryIndexRecommendationsTmpl))
+
+// Select returns the latest recommendation of each candidate index, newest first.
+// Candidate indexes which are not recommended by the latest analysis of the query are skipped.
+// Recommendations for services without metrics visible with LBAC filters of the request are skipped.
+func (ir *IndexRecommendations) Select(ctx context.Context, filter *IndexRecommendationsFilter) ([]*IndexRecommendation, error) {
+	lbacFilter, err := headersToLbacFilter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tmplArgs := struct {
+		ServiceID  bool
+		QueryID    bool
+		LbacFilter string
+	}{
+		ServiceID:  filter.ServiceID != "",
+		QueryID:    filter.QueryID != "",
+		LbacFilter: lbacFilter,
+	}
+
+	var queryBuffer bytes.Buffer
+	if err = tmplQueryIndexRecommendations.Execute(&queryBuffer, tmplArgs); err != nil {
+		return nil, fmt.Errorf("cannot execute tmplQueryIndexRecommendations: %w", err)
+	}
+
+	query, args, err := sqlx.Named(queryBuffer.String(), map[string]any{
+		"service_id": filter.ServiceID,
+		"queryid":    filter.QueryID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("prepare named: %w", err)
+	}
+	query = ir.db.Rebind(query)
+
+	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	var res []*IndexRecommendation
+	if err = ir.db.SelectContext(queryCtx, &res, query, args...); err != nil {
+		return nil, fmt.Errorf("index recommendations: %w", err)
+	}
+
+	return res, nil
+}
+
+// Evidence returns QAN metrics of the recommendation mapped by name.
+func (r *IndexRecommendation) Evidence() map[string]float64 {
+	res := make(map[string]float64, len(r.EvidenceNames))
+	for i, name := range r.EvidenceNames {
+		if i < len(r.EvidenceValues) {
+			res[name] = r.EvidenceValues[i]
+		}
+	}
+	return res
+}
@@ -0,0 +1,65 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"bytes"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexRecommendationsTemplate(t *testing.T) {
+	whitespace := regexp.MustCompile(`\s+`)
+
+	t.Run("Filters", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := tmplQueryIndexRecommendations.Execute(&buf, map[string]any{
+			"ServiceID":  true,
+			"LbacFilter": "service_type = 'mysql'",
+		})
+		require.NoError(t, err)
+		query := whitespace.ReplaceAllString(buf.String(), " ")
+
+		assert.Contains(t, query, "WHERE 1 AND service_id = :service_id "+
+			"AND service_id IN (SELECT DISTINCT service_id FROM metrics WHERE service_type = 'mysql') "+
+			"ORDER BY created_at DESC LIMIT 1 BY service_id, queryid, statement ) WHERE resolved = 0 ORDER BY created_at DESC")
+		assert.NotContains(t, query, ":queryid")
+	})
+
+	t.Run("NoFilters", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := tmplQueryIndexRecommendations.Execute(&buf, map[string]any{})
+		require.NoError(t, err)
+		query := whitespace.ReplaceAllString(buf.String(), " ")
+
+		assert.Contains(t, query, "FROM index_recommendations WHERE 1 ORDER BY created_at DESC")
+		assert.Contains(t, query, "WHERE resolved = 0")
+		assert.NotContains(t, query, "metrics")
+	})
+}
+
+func TestIndexRecommendationEvidence(t *testing.T) {
+	r := &IndexRecommendation{
+		EvidenceNames:  []string{"rows_examined", "rows_sent"},
+		EvidenceValues: []float64{1000, 1},
+	}
+	assert.Equal(t, map[string]float64{"rows_examined": 1000, "rows_sent": 1}, r.Evidence())
+
+	assert.Empty(t, (&IndexRecommendation{}).Evidence())
+}
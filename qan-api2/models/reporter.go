@@ -22,6 +22,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"slices"
 	"strings"
 	"sync"
@@ -39,12 +40,13 @@ import (
 
 // Reporter implements models to select metrics bucket by params.
 type Reporter struct {
-	db *sqlx.DB
+	db      *sqlx.DB
+	rollups *Rollups
 }
 
-// NewReporter initialize Reporter with db instance.
-func NewReporter(db *sqlx.DB) Reporter {
-	return Reporter{db: db}
+// NewReporter initialize Reporter with db instance and optional rollups.
+func NewReporter(db *sqlx.DB, rollups *Rollups) Reporter {
+	return Reporter{db: db, rollups: rollups}
 }
 
 // selectorCache is a thread-safe cache for selector to SQL conversions.
@@ -82,6 +84,9 @@ var promParser = parser.NewParser(parser.Options{})
 var funcMap = template.FuncMap{
 	"inc":         func(i int) int { return i + 1 },
 	"StringsJoin": strings.Join,
+	"annotationColumn": func(key string) string {
+		return annotationFilterColumns[key]
+	},
 }
 
 // M is map for interfaces.
@@ -121,8 +126,17 @@ SUM(num_queries) AS num_queries,
     {{ end }}
 {{ end }}
 count(DISTINCT dimension) AS total_rows
-FROM metrics
+FROM {{ template "metricsTable" . }}
 WHERE period_start >= :period_start_from AND period_start <= :period_start_to
+{{ template "reportFilters" . }}
+GROUP BY {{ .Group }}
+WITH TOTALS
+ORDER BY {{ .Order }}
+LIMIT :offset, :limit
+`
+
+const queryReportFiltersTmpl = `
+{{ define "reportFilters" }}
 {{ if .Search }}
   {{ if eq .Group "queryid" }}
     AND ( lowerUTF8(queryid) LIKE :search OR lowerUTF8(metrics.fingerprint) LIKE :search )
@@ -140,16 +154,98 @@ WHERE period_start >= :period_start_from AND period_start <= :period_start_to
         {{ if gt $i 1}} AND {{ end }} has(['{{ StringsJoin $vals "', '" }}'], labels.value[indexOf(labels.key, '{{ $key }}')])
     {{ end }})
 {{ end }}
+{{ template "annotationFilters" . }}
 {{ if .LbacFilter }}
     AND ({{ .LbacFilter }})
 {{ end }}
+{{ end }}
+{{ define "annotationFilters" }}
+{{ range $key, $vals := .Annotations }}
+    AND queryid IN (
+        SELECT queryid FROM query_annotations
+        GROUP BY queryid
+        HAVING argMax(deleted, updated_at) = 0
+            AND argMax({{ annotationColumn $key }}, updated_at) IN ( '{{ StringsJoin $vals "', '" }}' )
+    )
+{{ end }}
+{{ end }}
+`
+
+// queryReportComparisonTmpl selects metrics for the report and baseline periods at once.
+// Columns are qualified with the table name, as ClickHouse otherwise resolves them to aliases
+// of the report period aggregates with the same names.
+const queryReportComparisonTmpl = `
+{{ define "periodColumns" }}
+SUMIf(metrics.num_queries, {{ .Cond }}) AS {{ .Prefix }}num_queries,
+countIf({{ .Cond }}) AS {{ .Prefix }}period_rows,
+{{range $j, $col := .CommonColumns}}
+    SUMIf(metrics.m_{{ $col }}_cnt, {{ $.Cond }}) AS {{ $.Prefix }}m_{{ $col }}_cnt,
+    SUMIf(metrics.m_{{ $col }}_sum, {{ $.Cond }}) AS {{ $.Prefix }}m_{{ $col }}_sum,
+    MINIf(metrics.m_{{ $col }}_min, {{ $.Cond }}) AS {{ $.Prefix }}m_{{ $col }}_min,
+    MAXIf(metrics.m_{{ $col }}_max, {{ $.Cond }}) AS {{ $.Prefix }}m_{{ $col }}_max,
+    AVGIf(metrics.m_{{ $col }}_p99, {{ $.Cond }}) AS {{ $.Prefix }}m_{{ $col }}_p99,
+    {{ $.Prefix }}m_{{ $col }}_sum/{{ $.Prefix }}num_queries AS {{ $.Prefix }}m_{{ $col }}_avg,
+{{ end }}
+{{range $j, $col := .SumColumns}}
+    SUMIf(metrics.m_{{ $col }}_cnt, {{ $.Cond }}) AS {{ $.Prefix }}m_{{ $col }}_cnt,
+    SUMIf(metrics.m_{{ $col }}_sum, {{ $.Cond }}) AS {{ $.Prefix }}m_{{ $col }}_sum,
+    {{ $.Prefix }}m_{{ $col }}_sum/{{ $.Prefix }}num_queries AS {{ $.Prefix }}m_{{ $col }}_avg,
+{{ end }}
+{{range $j, $col := .SpecialColumns}}
+    {{ if eq $col "load" }}
+        SUMIf(metrics.m_query_time_sum, {{ $.Cond }}) / {{ $.PeriodDuration }} AS {{ $.Prefix }}load,
+    {{ else if ne $col "num_queries" }}
+        SUMIf(metrics.{{ $col }}, {{ $.Cond }}) AS {{ $.Prefix }}{{ $col }},
+    {{ end }}
+{{ end }}
+{{ end }}
+SELECT
+{{ .Group }} AS dimension,
+any(database) as database_name,
+{{ if eq .Group "queryid" }} any(fingerprint) {{ else }} '' {{ end }} AS fingerprint,
+{{ template "periodColumns" .Report }}
+{{ template "periodColumns" .Baseline }}
+count(DISTINCT dimension) AS total_rows
+FROM {{ template "metricsTable" . }}
+WHERE ((period_start >= :period_start_from AND period_start <= :period_start_to)
+    OR (period_start >= :baseline_period_start_from AND period_start <= :baseline_period_start_to))
+{{ template "reportFilters" . }}
 GROUP BY {{ .Group }}
 WITH TOTALS
 ORDER BY {{ .Order }}
 LIMIT :offset, :limit
 `
 
-var tmplQueryReport = template.Must(template.New("queryReportTmpl").Funcs(funcMap).Parse(queryReportTmpl))
+var (
+	tmplQueryReport           = template.Must(template.New("queryReportTmpl").Funcs(funcMap).Parse(queryReportTmpl + queryReportFiltersTmpl + metricsTableTmpl))
+	tmplQueryReportComparison = template.Must(template.New("queryReportComparisonTmpl").Funcs(funcMap).Parse(queryReportComparisonTmpl + queryReportFiltersTmpl + metricsTableTmpl))
+)
+
+// BaselinePrefix is a prefix of the baseline period columns in report rows.
+const BaselinePrefix = "b_"
+
+// Values of the comparison_status column of report rows.
+const (
+	ComparisonPresent     = "present"
+	ComparisonNew         = "new"
+	ComparisonDisappeared = "disappeared"
+)
+
+// BaselinePeriod is a period the report period is compared with.
+type BaselinePeriod struct {
+	PeriodStartFromSec int64
+	PeriodStartToSec   int64
+}
+
+// reportPeriod defines aggregates of a single period in the comparison report.
+type reportPeriod struct {
+	Cond           string
+	Prefix         string
+	PeriodDuration int64
+	SpecialColumns []string
+	CommonColumns  []string
+	SumColumns     []string
+}
 
 // workaround to issues in closed PR https://github.com/jmoiron/sqlx/pull/579
 func escapeColons(in string) string {
@@ -169,12 +265,16 @@ func escapeColonsInMap(m map[string][]string) map[string][]string {
 }
 
 // Select selects metrics for report.
+// If baseline is not nil, rows also contain metrics for the baseline period prefixed with BaselinePrefix,
+// comparison status, and deltas and ratios of the selected columns (see addComparison).
 func (r *Reporter) Select(ctx context.Context, periodStartFromSec, periodStartToSec int64,
 	dimensions map[string][]string, labels map[string][]string,
 	group, order, search string, offset, limit uint32,
 	specialColumns, commonColumns, sumColumns []string,
+	baseline *BaselinePeriod,
 ) ([]M, error) {
 	search = strings.TrimSpace(search)
+	labels, annotations := splitAnnotationFilters(labels)
 
 	arg := map[string]any{
 		"period_start_from": periodStartFromSec,
@@ -186,6 +286,35 @@ func (r *Reporter) Select(ctx context.Context, periodStartFromSec, periodStartTo
 		"limit":             limit,
 	}
 
+	sourcesFrom := periodStartFromSec
+	sourcesResolution := reportResolution(periodStartFromSec, periodStartToSec)
+
+	tmpl := tmplQueryReport
+	var reportPeriodArgs, baselinePeriodArgs reportPeriod
+	if baseline != nil {
+		tmpl = tmplQueryReportComparison
+		sourcesFrom = min(sourcesFrom, baseline.PeriodStartFromSec)
+		sourcesResolution = min(sourcesResolution, reportResolution(baseline.PeriodStartFromSec, baseline.PeriodStartToSec))
+		arg["baseline_period_start_from"] = baseline.PeriodStartFromSec
+		arg["baseline_period_start_to"] = baseline.PeriodStartToSec
+
+		reportPeriodArgs = reportPeriod{
+			Cond:           "period_start >= :period_start_from AND period_start <= :period_start_to",
+			PeriodDuration: periodStartToSec - periodStartFromSec,
+			SpecialColumns: specialColumns,
+			CommonColumns:  commonColumns,
+			SumColumns:     sumColumns,
+		}
+		baselinePeriodArgs = reportPeriod{
+			Cond:           "period_start >= :baseline_period_start_from AND period_start <= :baseline_period_start_to",
+			Prefix:         BaselinePrefix,
+			PeriodDuration: baseline.PeriodStartToSec - baseline.PeriodStartFromSec,
+			SpecialColumns: specialColumns,
+			CommonColumns:  commonColumns,
+			SumColumns:     sumColumns,
+		}
+	}
+
 	lbacFilter, err := headersToLbacFilter(ctx)
 	if err != nil {
 		return nil, err
@@ -197,6 +326,7 @@ func (r *Reporter) Select(ctx context.Context, periodStartFromSec, periodStartTo
 		PeriodDuration      int64
 		Dimensions          map[string][]string
 		Labels              map[string][]string
+		Annotations         map[string][]string
 		Group               string
 		Order               string
 		Search              string
@@ -207,12 +337,16 @@ func (r *Reporter) Select(ctx context.Context, periodStartFromSec, periodStartTo
 		SumColumns          []string
 		IsQueryTimeInSelect bool
 		LbacFilter          string
+		Report              reportPeriod
+		Baseline            reportPeriod
+		Sources             []*metricsSource
 	}{
 		PeriodStartFrom:     periodStartFromSec,
 		PeriodStartTo:       periodStartToSec,
 		PeriodDuration:      periodStartToSec - periodStartFromSec,
 		Dimensions:          escapeColonsInMap(dimensions),
 		Labels:              escapeColonsInMap(labels),
+		Annotations:         escapeColonsInMap(annotations),
 		Group:               group,
 		Order:               order,
 		Search:              search,
@@ -223,13 +357,16 @@ func (r *Reporter) Select(ctx context.Context, periodStartFromSec, periodStartTo
 		SumColumns:          sumColumns,
 		IsQueryTimeInSelect: slices.Contains(commonColumns, "query_time"),
 		LbacFilter:          lbacFilter,
+		Report:              reportPeriodArgs,
+		Baseline:            baselinePeriodArgs,
+		Sources:             r.rollups.sources(sourcesFrom, sourcesResolution, time.Now()),
 	}
 
 	var queryBuffer bytes.Buffer
 
-	err = tmplQueryReport.Execute(&queryBuffer, tmplArgs)
+	err = tmpl.Execute(&queryBuffer, tmplArgs)
 	if err != nil {
-		return nil, fmt.Errorf("cannot execute tmplQueryReport: %w", err)
+		return nil, fmt.Errorf("cannot execute %s: %w", tmpl.Name(), err)
 	}
 
 	var results []M
@@ -270,9 +407,99 @@ func (r *Reporter) Select(ctx context.Context, periodStartFromSec, periodStartTo
 		}
 		results = append([]M{total}, results...)
 	}
+
+	if baseline != nil {
+		for _, res := range results {
+			addComparison(res, reportPeriodArgs, baselinePeriodArgs)
+		}
+	}
+
 	return results, err
 }
 
+// addComparison sets the comparison status of the row, and deltas and ratios of the selected columns
+// between the baseline and report periods under "delta_<column>" and "ratio_<column>" keys.
+// Averages per query are compared for common and sum columns, and rates per second for special ones,
+// so periods of different length can be compared.
+func addComparison(res M, report, baseline reportPeriod) {
+	inReport := toFloat64(res["period_rows"]) > 0
+	inBaseline := toFloat64(res[BaselinePrefix+"period_rows"]) > 0
+	switch {
+	case inReport && inBaseline:
+		res["comparison_status"] = ComparisonPresent
+	case inReport:
+		res["comparison_status"] = ComparisonNew
+	default:
+		res["comparison_status"] = ComparisonDisappeared
+	}
+
+	for _, col := range report.SpecialColumns {
+		setDelta(res, col, specialColumnRate(res, report, col), specialColumnRate(res, baseline, col))
+	}
+	for _, col := range slices.Concat(report.CommonColumns, report.SumColumns) {
+		avg := "m_" + col + "_avg"
+		setDelta(res, col, toFloat64(res[report.Prefix+avg]), toFloat64(res[baseline.Prefix+avg]))
+	}
+}
+
+// specialColumnRate returns the rate per second of the special column for the period.
+func specialColumnRate(res M, period reportPeriod, col string) float64 {
+	if col == "load" {
+		return toFloat64(res[period.Prefix+"load"])
+	}
+
+	v := toFloat64(res[period.Prefix+col])
+	if period.PeriodDuration == 0 {
+		return v
+	}
+	return v / float64(period.PeriodDuration)
+}
+
+func setDelta(res M, col string, value, baselineValue float64) {
+	res["delta_"+col] = value - baselineValue
+	ratio := float64(0)
+	if baselineValue != 0 {
+		ratio = value / baselineValue
+	}
+	res["ratio_"+col] = ratio
+}
+
+// toFloat64 converts numeric value selected from ClickHouse to float64.
+// Non-finite values (like averages over no queries) are converted to 0.
+func toFloat64(v any) float64 {
+	var f float64
+	switch v := v.(type) {
+	case float64:
+		f = v
+	case float32:
+		f = float64(v)
+	case uint64:
+		f = float64(v)
+	case uint32:
+		f = float64(v)
+	case int64:
+		f = float64(v)
+	case int32:
+		f = float64(v)
+	}
+
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return 0
+	}
+	return f
+}
+
+// BaselineRow returns the baseline period columns of the comparison report row without the prefix.
+func BaselineRow(res M) M {
+	baseline := make(M)
+	for k, v := range res {
+		if after, ok := strings.CutPrefix(k, BaselinePrefix); ok {
+			baseline[after] = v
+		}
+	}
+	return baseline
+}
+
 const queryReportSparklinesTmpl = `
 SELECT
     intDivOrZero(toUnixTimestamp( :period_start_to ) - toUnixTimestamp(period_start), {{ .TimeFrame }}) AS point,
@@ -294,7 +521,7 @@ SELECT
             SUM(m_query_time_sum) / time_frame AS load
         {{ end }}
     {{ end }}
-FROM metrics
+FROM {{ template "metricsTable" . }}
 WHERE period_start >= :period_start_from AND period_start <= :period_start_to
 {{ if not .IsTotal }} AND {{ .Group }} = '{{ .DimensionVal }}' {{ end }}
     {{range $key, $vals := .Dimensions }} AND {{ $key }} IN ( '{{ StringsJoin $vals "', '" }}' ){{ end }}
@@ -303,6 +530,7 @@ WHERE period_start >= :period_start_from AND period_start <= :period_start_to
         {{ if gt $i 1}} OR {{ end }} has(['{{ StringsJoin $val "', '" }}'], labels.value[indexOf(labels.key, '{{ $key }}')])
     {{ end }})
 {{ end }}
+{{ template "annotationFilters" . }}
 {{ if .LbacFilter }}
     AND ({{ .LbacFilter }})
 {{ end }}
@@ -310,7 +538,7 @@ GROUP BY point
 ORDER BY point ASC;
 `
 
-var tmplQueryReportSparklines = template.Must(template.New("queryReportSparklines").Funcs(funcMap).Parse(queryReportSparklinesTmpl))
+var tmplQueryReportSparklines = template.Must(template.New("queryReportSparklines").Funcs(funcMap).Parse(queryReportSparklinesTmpl + queryReportFiltersTmpl + metricsTableTmpl))
 
 // SelectSparklines selects datapoint for sparklines.
 func (r *Reporter) SelectSparklines(ctx context.Context, dimensionVal string,
@@ -339,6 +567,8 @@ func (r *Reporter) SelectSparklines(ctx context.Context, dimensionVal string,
 	amountOfPoints += remainder / minutesInPoint
 	timeFrame := minutesInPoint * 60 //nolint:mnd
 
+	labels, annotations := splitAnnotationFilters(labels)
+
 	arg := map[string]any{
 		"dimension_val":     dimensionVal,
 		"period_start_from": periodStartFromSec,
@@ -360,12 +590,14 @@ func (r *Reporter) SelectSparklines(ctx context.Context, dimensionVal string,
 		PeriodDuration  int64
 		Dimensions      map[string][]string
 		Labels          map[string][]string
+		Annotations     map[string][]string
 		Group           string
 		Column          string
 		IsCommon        bool
 		TimeFrame       int64
 		IsTotal         bool
 		LbacFilter      string
+		Sources         []*metricsSource
 	}{
 		DimensionVal:    escapeColons(dimensionVal),
 		PeriodStartFrom: periodStartFromSec,
@@ -373,12 +605,14 @@ func (r *Reporter) SelectSparklines(ctx context.Context, dimensionVal string,
 		PeriodDuration:  periodStartToSec - periodStartFromSec,
 		Dimensions:      escapeColonsInMap(dimensions),
 		Labels:          escapeColonsInMap(labels),
+		Annotations:     escapeColonsInMap(annotations),
 		Group:           group,
 		Column:          column,
 		IsCommon:        !slices.Contains([]string{"load", "num_queries", "num_queries_with_errors", "num_queries_with_warnings"}, column),
 		TimeFrame:       timeFrame,
 		IsTotal:         isTotal,
 		LbacFilter:      lbacFilter,
+		Sources:         r.rollups.sources(periodStartFromSec, time.Duration(timeFrame)*time.Second, time.Now()),
 	}
 
 	var results []*qanpbv1.Point
@@ -483,6 +717,35 @@ ORDER BY
     value ASC
 `
 
+// queryAnnotationDimension is the same as queryDimension for annotation filters.
+// Only annotated queries are taken into account.
+const queryAnnotationDimension = `
+SELECT
+    '{{ .DimensionName }}' AS key,
+    annotations.value AS value,
+    sumIf({{ .MainMetric }},
+        {{ if .Dimensions }}{{ $i := 0 }}
+        {{ range $key, $vals := .Dimensions }}{{ $i = inc $i }}{{ if gt $i 1 }} AND {{ end }}{{ $key }} IN ('{{ StringsJoin $vals "', '" }}'){{ end }}
+        {{ else }}1{{ end }}
+    ) AS main_metric_sum
+FROM metrics
+INNER JOIN (
+    SELECT queryid, argMax({{ annotationColumn .DimensionName }}, updated_at) AS value
+    FROM query_annotations
+    GROUP BY queryid
+    HAVING argMax(deleted, updated_at) = 0 AND value != ''
+) AS annotations USING (queryid)
+WHERE (period_start >= ?) AND (period_start <= ?)
+{{ if .LbacFilter }}
+  AND ({{ .LbacFilter }})
+{{ end }}
+GROUP BY value
+WITH TOTALS
+ORDER BY
+    main_metric_sum DESC,
+    value ASC
+`
+
 type customLabel struct {
 	key              string
 	value            string
@@ -490,8 +753,9 @@ type customLabel struct {
 }
 
 var (
-	queryDimensionTmpl = template.Must(template.New("queryDimension").Funcs(funcMap).Parse(queryDimension))
-	dimensionQueries   = map[string]*template.Template{
+	queryDimensionTmpl           = template.Must(template.New("queryDimension").Funcs(funcMap).Parse(queryDimension))
+	queryAnnotationDimensionTmpl = template.Must(template.New("queryAnnotationDimension").Funcs(funcMap).Parse(queryAnnotationDimension))
+	dimensionQueries             = map[string]*template.Template{
 		"service_name":     queryDimensionTmpl,
 		"database":         queryDimensionTmpl,
 		"schema":           queryDimensionTmpl,
@@ -516,6 +780,9 @@ var (
 		"application_name": queryDimensionTmpl,
 		"planid":           queryDimensionTmpl,
 		"plan_summary":     queryDimensionTmpl,
+
+		AnnotationOwnerFilter:        queryAnnotationDimensionTmpl,
+		AnnotationAcknowledgedFilter: queryAnnotationDimensionTmpl,
 	}
 )
 
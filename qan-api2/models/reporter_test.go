@@ -19,6 +19,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/base64"
+	"math"
 	"regexp"
 	"strings"
 	"testing"
@@ -363,3 +364,112 @@ func TestQueryDimensionTemplate(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryReportComparisonTemplate(t *testing.T) {
+	whitespace := regexp.MustCompile(`\s+`)
+	period := func(cond, prefix string) reportPeriod {
+		return reportPeriod{
+			Cond:           cond,
+			Prefix:         prefix,
+			PeriodDuration: 3600,
+			SpecialColumns: []string{"load", "num_queries"},
+			CommonColumns:  []string{"query_time"},
+		}
+	}
+
+	var buf bytes.Buffer
+	err := tmplQueryReportComparison.Execute(&buf, map[string]any{
+		"Group":    "queryid",
+		"Order":    "m_query_time_avg DESC",
+		"Report":   period("period_start >= :period_start_from AND period_start <= :period_start_to", ""),
+		"Baseline": period("period_start >= :baseline_period_start_from AND period_start <= :baseline_period_start_to", BaselinePrefix),
+	})
+	require.NoError(t, err)
+	query := whitespace.ReplaceAllString(buf.String(), " ")
+
+	for _, expected := range []string{
+		"SUMIf(metrics.num_queries, period_start >= :period_start_from AND period_start <= :period_start_to) AS num_queries,",
+		"SUMIf(metrics.num_queries, period_start >= :baseline_period_start_from AND period_start <= :baseline_period_start_to) AS b_num_queries,",
+		"countIf(period_start >= :baseline_period_start_from AND period_start <= :baseline_period_start_to) AS b_period_rows,",
+		"b_m_query_time_sum/b_num_queries AS b_m_query_time_avg,",
+		"SUMIf(metrics.m_query_time_sum, period_start >= :period_start_from AND period_start <= :period_start_to) / 3600 AS load,",
+		"OR (period_start >= :baseline_period_start_from AND period_start <= :baseline_period_start_to))",
+		"GROUP BY queryid WITH TOTALS ORDER BY m_query_time_avg DESC",
+	} {
+		require.Contains(t, query, expected)
+	}
+}
+
+func TestAddComparison(t *testing.T) {
+	report := reportPeriod{
+		PeriodDuration: 100,
+		SpecialColumns: []string{"load", "num_queries"},
+		CommonColumns:  []string{"query_time"},
+	}
+	baseline := reportPeriod{
+		Prefix:         BaselinePrefix,
+		PeriodDuration: 200,
+		SpecialColumns: report.SpecialColumns,
+		CommonColumns:  report.CommonColumns,
+	}
+
+	t.Run("present", func(t *testing.T) {
+		res := M{
+			"period_rows":          uint64(2),
+			"b_period_rows":        uint64(3),
+			"load":                 float64(0.5),
+			"b_load":               float64(0.25),
+			"num_queries":          uint64(100),
+			"b_num_queries":        uint64(100),
+			"m_query_time_avg":     float64(0.3),
+			"b_m_query_time_avg":   float64(0.1),
+			"m_query_time_sum":     float32(30),
+			"b_m_query_time_sum":   float32(10),
+			"fingerprint":          "SELECT ?",
+			"b_unrelated_baseline": "x",
+		}
+		addComparison(res, report, baseline)
+
+		require.Equal(t, ComparisonPresent, res["comparison_status"])
+		require.InDelta(t, 0.25, res["delta_load"], 1e-9)
+		require.InDelta(t, 2, res["ratio_load"], 1e-9)
+		// 100 queries in 100 seconds vs 100 queries in 200 seconds
+		require.InDelta(t, 0.5, res["delta_num_queries"], 1e-9)
+		require.InDelta(t, 2, res["ratio_num_queries"], 1e-9)
+		require.InDelta(t, 0.2, res["delta_query_time"], 1e-9)
+		require.InDelta(t, 3, res["ratio_query_time"], 1e-9)
+
+		b := BaselineRow(res)
+		require.Equal(t, float32(10), b["m_query_time_sum"])
+		require.Equal(t, uint64(100), b["num_queries"])
+		require.NotContains(t, b, "fingerprint")
+	})
+
+	t.Run("new", func(t *testing.T) {
+		res := M{
+			"period_rows":        uint64(1),
+			"b_period_rows":      uint64(0),
+			"m_query_time_avg":   float64(0.3),
+			"b_m_query_time_avg": math.NaN(),
+		}
+		addComparison(res, report, baseline)
+
+		require.Equal(t, ComparisonNew, res["comparison_status"])
+		require.InDelta(t, 0.3, res["delta_query_time"], 1e-9)
+		require.Equal(t, float64(0), res["ratio_query_time"])
+	})
+
+	t.Run("disappeared", func(t *testing.T) {
+		res := M{
+			"period_rows":        uint64(0),
+			"b_period_rows":      uint64(1),
+			"m_query_time_avg":   math.NaN(),
+			"b_m_query_time_avg": float64(0.3),
+		}
+		addComparison(res, report, baseline)
+
+		require.Equal(t, ComparisonDisappeared, res["comparison_status"])
+		require.InDelta(t, -0.3, res["delta_query_time"], 1e-9)
+		require.Equal(t, float64(0), res["ratio_query_time"])
+	})
+}
@@ -0,0 +1,495 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// rawTable is the table with metrics buckets sent by agents.
+	rawTable = "metrics"
+
+	// watermarksTable keeps the end of the last rolled up bucket of each rollup table.
+	watermarksTable = "rollup_watermarks"
+
+	// rollupDelay is how long complete buckets wait for late metrics before they are rolled up.
+	// pmm-agent keeps metrics for up to 24 hours by default while PMM Server is unreachable (--qan-spool-max-age),
+	// so the delay covers that with an hour of margin for sending them. Keep in sync with agent/config/config.go.
+	// Metrics after the last rolled up bucket are read from finer tables, so the delay is not visible in reports.
+	rollupDelay = 25 * time.Hour
+	// rollupChunkBuckets is the maximal number of buckets rolled up by a single query.
+	rollupChunkBuckets = 24
+	// rollupMinBucketsPerPeriod is the minimal number of rollup buckets in the report period.
+	// It limits the error of report values caused by partial buckets at the period edges.
+	rollupMinBucketsPerPeriod = 48
+)
+
+// Rollup levels and their tables. Rollup tables have the same columns as the metrics table;
+// migrations adding columns to metrics should add them to rollup tables too.
+const (
+	RollupHourlyTable = "metrics_1h"
+	RollupDailyTable  = "metrics_1d"
+)
+
+// rollupAnyColumns are metrics columns that describe the query class rather than identify the bucket.
+// Any value of the rolled up buckets is kept.
+var rollupAnyColumns = map[string]struct{}{
+	"fingerprint":         {},
+	"explain_fingerprint": {},
+	"placeholders_count":  {},
+	"example":             {},
+	"example_type":        {},
+	"example_metrics":     {},
+	"is_truncated":        {},
+	"tables":              {},
+	"top_query":           {},
+	"query_plan":          {},
+	"histogram_items":     {},
+}
+
+// RollupLevel is a table with metrics aggregated into buckets of the given resolution.
+type RollupLevel struct {
+	Table      string
+	Resolution time.Duration
+	Retention  time.Duration
+}
+
+// rollupLevel is an enabled rollup level with its state.
+type rollupLevel struct {
+	RollupLevel
+	source    string    // table the level is aggregated from
+	watermark time.Time // end of the last rolled up bucket, zero if nothing is rolled up
+}
+
+// metricsSource is a part of metrics selected from the table within [From, To); zero bounds are not applied.
+type metricsSource struct {
+	Table string
+	From  int64
+	To    int64
+}
+
+// Rollups maintains rollup tables and picks tables for metrics queries.
+// A nil *Rollups is valid and selects raw metrics only.
+type Rollups struct {
+	db           *sqlx.DB
+	l            *logrus.Entry
+	rawRetention time.Duration
+
+	rw     sync.RWMutex
+	levels []*rollupLevel // finest first
+}
+
+// NewRollups creates Rollups for the given levels; levels with zero retention are disabled.
+// Each level is aggregated from the previous enabled level or from raw metrics.
+func NewRollups(db *sqlx.DB, rawRetention time.Duration, levels ...RollupLevel) *Rollups {
+	r := &Rollups{
+		db:           db,
+		l:            logrus.WithField("component", "rollups"),
+		rawRetention: rawRetention,
+	}
+
+	source := rawTable
+	for _, level := range levels {
+		if level.Retention <= 0 {
+			continue
+		}
+		r.levels = append(r.levels, &rollupLevel{RollupLevel: level, source: source})
+		source = level.Table
+	}
+	return r
+}
+
+// Enabled returns true if at least one rollup level is enabled.
+func (r *Rollups) Enabled() bool {
+	return r != nil && len(r.levels) != 0
+}
+
+// Run rolls up complete buckets of all levels.
+func (r *Rollups) Run(ctx context.Context) {
+	now := time.Now()
+	for _, level := range r.levels {
+		if err := r.rollupLevel(ctx, level, now); err != nil {
+			r.l.Errorf("Failed to roll up %s: %s.", level.Table, err)
+			// coarser levels depend on this one
+			return
+		}
+	}
+}
+
+// rollupLevel rolls up complete buckets of the level since its watermark in chunks.
+func (r *Rollups) rollupLevel(ctx context.Context, level *rollupLevel, now time.Time) error {
+	// read the persisted watermark again in case of concurrent changes
+	watermark, err := r.selectWatermark(ctx, level.Table, level.Resolution)
+	if err != nil {
+		return err
+	}
+	if !watermark.IsZero() {
+		// buckets after the watermark are left by the chunk that failed before its watermark was saved
+		if err = r.deleteAfter(ctx, level.Table, watermark); err != nil {
+			return err
+		}
+	}
+
+	from := watermark
+	if from.IsZero() {
+		if from, err = r.selectFirstBucket(ctx, level.source); err != nil {
+			return err
+		}
+		if from.IsZero() {
+			return nil
+		}
+	}
+	from = from.Truncate(level.Resolution)
+
+	to := now.Add(-rollupDelay).Truncate(level.Resolution)
+	if source := r.level(level.source); source != nil {
+		// coarser buckets can only be complete when source buckets are rolled up
+		if sourceTo := source.watermark.Truncate(level.Resolution); sourceTo.Before(to) {
+			to = sourceTo
+		}
+	}
+
+	columns, err := r.selectColumns(ctx, level.Table)
+	if err != nil {
+		return err
+	}
+	query := rollupQuery(level.Table, level.source, level.Resolution, columns)
+
+	chunk := rollupChunkBuckets * level.Resolution
+	for from.Before(to) {
+		chunkTo := from.Add(chunk)
+		if chunkTo.After(to) {
+			chunkTo = to
+		}
+
+		start := time.Now()
+		queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+		_, err = r.db.ExecContext(queryCtx, query, from.Unix(), chunkTo.Unix())
+		cancel()
+		if err != nil {
+			return fmt.Errorf("failed to roll up %s - %s: %w", from, chunkTo, err)
+		}
+		r.l.Debugf("Rolled up %s into %s: %s - %s in %s.", level.source, level.Table, from, chunkTo, time.Since(start))
+
+		if err = r.saveWatermark(ctx, level.Table, chunkTo); err != nil {
+			return err
+		}
+
+		from = chunkTo
+		watermark = chunkTo
+	}
+
+	r.rw.Lock()
+	level.watermark = watermark
+	r.rw.Unlock()
+	return nil
+}
+
+// level returns the enabled level with the given table, or nil.
+func (r *Rollups) level(table string) *rollupLevel {
+	for _, level := range r.levels {
+		if level.Table == table {
+			return level
+		}
+	}
+	return nil
+}
+
+// selectWatermark returns the persisted watermark of the rollup table.
+// If there is none, it returns the end of the last bucket in the rollup table, or zero time for the empty table.
+func (r *Rollups) selectWatermark(ctx context.Context, table string, resolution time.Duration) (time.Time, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	var watermark int64
+	query := fmt.Sprintf("SELECT toInt64(toUnixTimestamp(argMax(watermark, updated_at))) FROM %s WHERE table = ?", watermarksTable)
+	if err := r.db.GetContext(queryCtx, &watermark, query, table); err != nil {
+		return time.Time{}, fmt.Errorf("failed to select watermark of %s: %w", table, err)
+	}
+	if watermark > 0 {
+		return time.Unix(watermark, 0), nil
+	}
+
+	var last int64
+	err := r.db.GetContext(queryCtx, &last, fmt.Sprintf("SELECT toInt64(toUnixTimestamp(max(period_start))) FROM %s", table))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to select last bucket of %s: %w", table, err)
+	}
+	if last <= 0 {
+		return time.Time{}, nil
+	}
+	return time.Unix(last, 0).Add(resolution), nil
+}
+
+// saveWatermark persists the end of the last rolled up bucket of the rollup table.
+func (r *Rollups) saveWatermark(ctx context.Context, table string, watermark time.Time) error {
+	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	query := fmt.Sprintf("INSERT INTO %s (table, watermark, updated_at) VALUES (?, ?, ?)", watermarksTable)
+	if _, err := r.db.ExecContext(queryCtx, query, table, watermark.UTC(), time.Now().UTC()); err != nil {
+		return fmt.Errorf("failed to save watermark of %s: %w", table, err)
+	}
+	return nil
+}
+
+// deleteAfter deletes buckets of the rollup table started at or after the watermark, so they are not rolled up twice.
+func (r *Rollups) deleteAfter(ctx context.Context, table string, watermark time.Time) error {
+	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	var count uint64
+	err := r.db.GetContext(queryCtx, &count, fmt.Sprintf("SELECT count() FROM %s WHERE period_start >= ?", table), watermark.Unix())
+	if err != nil {
+		return fmt.Errorf("failed to count buckets of %s after %s: %w", table, watermark, err)
+	}
+	if count == 0 {
+		return nil
+	}
+
+	if _, err = r.db.ExecContext(queryCtx, fmt.Sprintf("DELETE FROM %s WHERE period_start >= ?", table), watermark.Unix()); err != nil {
+		return fmt.Errorf("failed to delete buckets of %s after %s: %w", table, watermark, err)
+	}
+	r.l.Warnf("Deleted %d buckets of %s after %s left by the failed roll up.", count, table, watermark)
+	return nil
+}
+
+// selectFirstBucket returns the start of the first bucket in the table, or zero time for the empty table.
+func (r *Rollups) selectFirstBucket(ctx context.Context, table string) (time.Time, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	var first int64
+	err := r.db.GetContext(queryCtx, &first, fmt.Sprintf("SELECT toInt64(toUnixTimestamp(min(period_start))) FROM %s", table))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to select first bucket of %s: %w", table, err)
+	}
+	if first <= 0 {
+		return time.Time{}, nil
+	}
+	return time.Unix(first, 0), nil
+}
+
+// selectColumns returns columns of the table in their order.
+func (r *Rollups) selectColumns(ctx context.Context, table string) ([]string, error) {
+	queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+
+	var columns []string
+	const query = `SELECT name FROM system.columns WHERE database = currentDatabase() AND table = ? ORDER BY position`
+	if err := r.db.SelectContext(queryCtx, &columns, query, table); err != nil {
+		return nil, fmt.Errorf("failed to select columns of %s: %w", table, err)
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table %s has no columns", table)
+	}
+	return columns, nil
+}
+
+// rollupQuery returns the query aggregating buckets of the source table started within [?, ?)
+// into buckets of the given resolution in the target table.
+func rollupQuery(target, source string, resolution time.Duration, columns []string) string {
+	bucket := fmt.Sprintf("toStartOfInterval(period_start, INTERVAL %d SECOND)", int64(resolution.Seconds()))
+
+	exprs := make([]string, 0, len(columns))
+	var groupBy []string
+	for _, c := range columns {
+		expr, group := rollupColumnExpr(c, bucket, resolution)
+		exprs = append(exprs, expr)
+		if group {
+			groupBy = append(groupBy, expr)
+		}
+	}
+
+	quoted := make([]string, 0, len(columns))
+	for _, c := range columns {
+		quoted = append(quoted, "`"+c+"`")
+	}
+
+	return fmt.Sprintf("INSERT INTO %s (%s)\nSELECT\n    %s\nFROM %s\nWHERE period_start >= ? AND period_start < ?\nGROUP BY %s",
+		target, strings.Join(quoted, ", "), strings.Join(exprs, ",\n    "), source, strings.Join(groupBy, ", "))
+}
+
+// rollupColumnExpr returns the aggregation expression of the metrics column, and true if the column identifies the bucket.
+// Unknown columns are considered dimensions, so their values are never mixed.
+func rollupColumnExpr(column, bucket string, resolution time.Duration) (string, bool) {
+	quoted := "`" + column + "`"
+
+	switch column {
+	case "period_start":
+		return bucket, true
+	case "period_length":
+		return fmt.Sprintf("toUInt32(%d)", int64(resolution.Seconds())), false
+	case "warnings.code", "errors.code":
+		prefix, _, _ := strings.Cut(column, ".")
+		return fmt.Sprintf("tupleElement(sumMap(`%[1]s.code`, `%[1]s.count`), 1)", prefix), false
+	case "warnings.count", "errors.count":
+		prefix, _, _ := strings.Cut(column, ".")
+		return fmt.Sprintf("tupleElement(sumMap(`%[1]s.code`, `%[1]s.count`), 2)", prefix), false
+	}
+
+	if _, ok := rollupAnyColumns[column]; ok {
+		return "any(" + quoted + ")", false
+	}
+
+	if strings.HasPrefix(column, "num_queries") {
+		return "sum(" + quoted + ")", false
+	}
+
+	if strings.HasPrefix(column, "m_") {
+		switch {
+		case strings.HasSuffix(column, "_min"):
+			return "min(" + quoted + ")", false
+		case strings.HasSuffix(column, "_max"):
+			return "max(" + quoted + ")", false
+		case strings.HasSuffix(column, "_p99"):
+			// percentiles can't be merged; reports average them over buckets anyway
+			return "avg(" + quoted + ")", false
+		default:
+			return "sum(" + quoted + ")", false
+		}
+	}
+
+	return quoted, true
+}
+
+// DropOldPartitions drops monthly partitions of rollup tables that are entirely older than the level retention.
+func (r *Rollups) DropOldPartitions(ctx context.Context) {
+	for _, level := range r.levels {
+		queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+		var partitions []string
+		const query = `
+			SELECT DISTINCT partition
+			FROM system.parts
+			WHERE database = currentDatabase()
+				AND table = ?
+				AND visible = 1
+				AND match(partition, '^[0-9]{6}$')
+				AND toUInt32(partition) < toYYYYMM(now() - toIntervalSecond(?))
+			ORDER BY partition
+		`
+		err := r.db.SelectContext(queryCtx, &partitions, query, level.Table, int64(level.Retention.Seconds()))
+		cancel()
+		if err != nil {
+			r.l.Errorf("Failed to select old partitions of %s: %s.", level.Table, err)
+			continue
+		}
+
+		for _, part := range partitions {
+			queryCtx, cancel := context.WithTimeout(ctx, queryTimeout)
+			_, err = r.db.ExecContext(queryCtx, fmt.Sprintf("ALTER TABLE %s DROP PARTITION %s", level.Table, part))
+			cancel()
+			if err != nil {
+				r.l.Errorf("Failed to drop partition %s of %s: %s.", part, level.Table, err)
+				continue
+			}
+			r.l.Infof("Dropped partition %s of %s.", part, level.Table)
+		}
+	}
+}
+
+// sources returns parts of metrics to select for the period with the given resolution.
+// It returns nil if raw metrics should be selected.
+//
+// The coarsest table that keeps metrics since periodStartFrom and has the fine enough resolution is used.
+// If no such table exists, the finest table that keeps metrics since periodStartFrom is used instead.
+// Metrics after the watermark of the table are selected from finer tables.
+func (r *Rollups) sources(periodStartFromSec int64, resolution time.Duration, now time.Time) []*metricsSource {
+	if !r.Enabled() {
+		return nil
+	}
+
+	r.rw.RLock()
+	defer r.rw.RUnlock()
+
+	from := time.Unix(periodStartFromSec, 0)
+	keeps := func(retention time.Duration) bool {
+		return !from.Before(now.Add(-retention))
+	}
+
+	// rolled up levels, coarsest first
+	var levels []*rollupLevel
+	for _, level := range slices.Backward(r.levels) {
+		if !level.watermark.IsZero() {
+			levels = append(levels, level)
+		}
+	}
+
+	var picked *rollupLevel
+	for _, level := range levels {
+		if keeps(level.Retention) && level.Resolution <= resolution {
+			picked = level
+			break
+		}
+	}
+	if picked == nil && !keeps(r.rawRetention) {
+		for _, level := range slices.Backward(levels) {
+			if keeps(level.Retention) {
+				picked = level
+				break
+			}
+		}
+		if picked == nil && len(levels) != 0 {
+			picked = levels[0]
+		}
+	}
+	if picked == nil {
+		return nil
+	}
+
+	// the picked level and all finer levels, each after the watermark of the coarser one
+	var res []*metricsSource
+	var after int64
+	for _, level := range levels[slices.Index(levels, picked):] {
+		to := level.watermark.Unix()
+		if to <= after {
+			continue
+		}
+		res = append(res, &metricsSource{Table: level.Table, From: after, To: to})
+		after = to
+	}
+	return append(res, &metricsSource{Table: rawTable, From: after})
+}
+
+// reportResolution returns the coarsest resolution keeping the report period precise enough.
+func reportResolution(periodStartFromSec, periodStartToSec int64) time.Duration {
+	return time.Duration((periodStartToSec-periodStartFromSec)/rollupMinBucketsPerPeriod) * time.Second
+}
+
+// metricsTableTmpl defines the metrics table or the union of tables selected by sources.
+// The union is aliased as metrics, so qualified column names keep working.
+const metricsTableTmpl = `
+{{ define "metricsTable" }}
+{{ if .Sources }}(
+    {{ range $i, $s := .Sources }}
+        {{ if $i }} UNION ALL {{ end }}
+        SELECT * FROM {{ $s.Table }} WHERE 1
+        {{ if $s.From }} AND period_start >= {{ $s.From }} {{ end }}
+        {{ if $s.To }} AND period_start < {{ $s.To }} {{ end }}
+    {{ end }}
+) AS metrics
+{{ else }}metrics{{ end }}
+{{ end }}
+`
@@ -0,0 +1,243 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package models
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/jmoiron/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRollupColumnExpr(t *testing.T) {
+	const bucket = "toStartOfInterval(period_start, INTERVAL 3600 SECOND)"
+
+	for _, tc := range []struct {
+		column string
+		expr   string
+		group  bool
+	}{
+		{"period_start", bucket, true},
+		{"period_length", "toUInt32(3600)", false},
+		{"queryid", "`queryid`", true},
+		{"service_name", "`service_name`", true},
+		{"labels.key", "`labels.key`", true},
+		{"fingerprint", "any(`fingerprint`)", false},
+		{"num_queries", "sum(`num_queries`)", false},
+		{"num_queries_with_errors", "sum(`num_queries_with_errors`)", false},
+		{"m_query_time_cnt", "sum(`m_query_time_cnt`)", false},
+		{"m_query_time_sum", "sum(`m_query_time_sum`)", false},
+		{"m_query_time_min", "min(`m_query_time_min`)", false},
+		{"m_query_time_max", "max(`m_query_time_max`)", false},
+		{"m_query_time_p99", "avg(`m_query_time_p99`)", false},
+		{"errors.code", "tupleElement(sumMap(`errors.code`, `errors.count`), 1)", false},
+		{"errors.count", "tupleElement(sumMap(`errors.code`, `errors.count`), 2)", false},
+	} {
+		t.Run(tc.column, func(t *testing.T) {
+			expr, group := rollupColumnExpr(tc.column, bucket, time.Hour)
+			assert.Equal(t, tc.expr, expr)
+			assert.Equal(t, tc.group, group)
+		})
+	}
+}
+
+func TestRollupQuery(t *testing.T) {
+	whitespace := regexp.MustCompile(`\s+`)
+
+	query := rollupQuery(RollupDailyTable, RollupHourlyTable, 24*time.Hour,
+		[]string{"queryid", "fingerprint", "period_start", "period_length", "num_queries", "m_query_time_max"})
+	expected := "INSERT INTO metrics_1d (`queryid`, `fingerprint`, `period_start`, `period_length`, `num_queries`, `m_query_time_max`) " +
+		"SELECT `queryid`, any(`fingerprint`), toStartOfInterval(period_start, INTERVAL 86400 SECOND), toUInt32(86400), " +
+		"sum(`num_queries`), max(`m_query_time_max`) " +
+		"FROM metrics_1h WHERE period_start >= ? AND period_start < ? " +
+		"GROUP BY `queryid`, toStartOfInterval(period_start, INTERVAL 86400 SECOND)"
+	assert.Equal(t, expected, whitespace.ReplaceAllString(query, " "))
+}
+
+func TestRollupsSources(t *testing.T) {
+	const day = 24 * time.Hour
+	now := time.Date(2026, 10, 17, 12, 30, 0, 0, time.UTC)
+	hourlyWatermark := time.Date(2026, 10, 17, 11, 0, 0, 0, time.UTC)
+	dailyWatermark := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+
+	newRollups := func(hourly, daily time.Time) *Rollups {
+		r := NewRollups(nil, 30*day,
+			RollupLevel{Table: RollupHourlyTable, Resolution: time.Hour, Retention: 90 * day},
+			RollupLevel{Table: RollupDailyTable, Resolution: day, Retention: 365 * day},
+		)
+		r.levels[0].watermark = hourly
+		r.levels[1].watermark = daily
+		return r
+	}
+	since := func(d time.Duration) int64 {
+		return now.Add(-d).Unix()
+	}
+
+	hourly := &metricsSource{Table: RollupHourlyTable, To: hourlyWatermark.Unix()}
+	daily := &metricsSource{Table: RollupDailyTable, To: dailyWatermark.Unix()}
+	hourlyAfterDaily := &metricsSource{Table: RollupHourlyTable, From: dailyWatermark.Unix(), To: hourlyWatermark.Unix()}
+	raw := &metricsSource{Table: rawTable, From: hourlyWatermark.Unix()}
+
+	t.Run("Disabled", func(t *testing.T) {
+		var r *Rollups
+		assert.False(t, r.Enabled())
+		assert.Nil(t, r.sources(since(60*day), day, now))
+
+		r = NewRollups(nil, 30*day, RollupLevel{Table: RollupHourlyTable, Resolution: time.Hour})
+		assert.False(t, r.Enabled())
+		assert.Nil(t, r.sources(since(60*day), day, now))
+	})
+
+	t.Run("FineResolution", func(t *testing.T) {
+		r := newRollups(hourlyWatermark, dailyWatermark)
+		assert.Nil(t, r.sources(since(time.Hour), reportResolution(since(time.Hour), now.Unix()), now))
+	})
+
+	t.Run("Hourly", func(t *testing.T) {
+		r := newRollups(hourlyWatermark, dailyWatermark)
+		from := since(7 * day)
+		assert.Equal(t, []*metricsSource{hourly, raw}, r.sources(from, reportResolution(from, now.Unix()), now))
+	})
+
+	t.Run("Daily", func(t *testing.T) {
+		r := newRollups(hourlyWatermark, dailyWatermark)
+		from := since(60 * day)
+		assert.Equal(t, []*metricsSource{daily, hourlyAfterDaily, raw}, r.sources(from, reportResolution(from, now.Unix()), now))
+	})
+
+	t.Run("OutOfRawRetention", func(t *testing.T) {
+		r := newRollups(hourlyWatermark, dailyWatermark)
+		assert.Equal(t, []*metricsSource{hourly, raw}, r.sources(since(60*day), time.Minute, now))
+		assert.Equal(t, []*metricsSource{daily, hourlyAfterDaily, raw}, r.sources(since(200*day), time.Minute, now))
+		assert.Equal(t, []*metricsSource{daily, hourlyAfterDaily, raw}, r.sources(since(500*day), time.Minute, now))
+	})
+
+	t.Run("NotRolledUp", func(t *testing.T) {
+		r := newRollups(time.Time{}, time.Time{})
+		assert.Nil(t, r.sources(since(60*day), day, now))
+
+		r = newRollups(hourlyWatermark, time.Time{})
+		assert.Equal(t, []*metricsSource{hourly, raw}, r.sources(since(60*day), day, now))
+	})
+}
+
+func TestRollupsRollupLevel(t *testing.T) {
+	now := time.Date(2026, 10, 17, 12, 30, 0, 0, time.UTC)
+	watermark := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	to := now.Add(-rollupDelay).Truncate(time.Hour)
+
+	setup := func(t *testing.T) (*Rollups, sqlmock.Sqlmock) {
+		t.Helper()
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			mock.ExpectClose()
+			require.NoError(t, db.Close())
+			require.NoError(t, mock.ExpectationsWereMet())
+		})
+
+		r := NewRollups(sqlx.NewDb(db, "clickhouse"), 30*24*time.Hour,
+			RollupLevel{Table: RollupHourlyTable, Resolution: time.Hour, Retention: 90 * 24 * time.Hour},
+		)
+		return r, mock
+	}
+	expectRollup := func(mock sqlmock.Sqlmock) {
+		mock.ExpectQuery("SELECT name FROM system.columns").
+			WithArgs(RollupHourlyTable).
+			WillReturnRows(sqlmock.NewRows([]string{"name"}).AddRow("queryid").AddRow("period_start").AddRow("num_queries"))
+		mock.ExpectExec("INSERT INTO metrics_1h").
+			WithArgs(watermark.Unix(), to.Unix()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec("INSERT INTO rollup_watermarks").
+			WithArgs(RollupHourlyTable, to, sqlmock.AnyArg()).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	t.Run("PersistedWatermark", func(t *testing.T) {
+		r, mock := setup(t)
+		mock.ExpectQuery("SELECT .+ FROM rollup_watermarks").
+			WithArgs(RollupHourlyTable).
+			WillReturnRows(sqlmock.NewRows([]string{"watermark"}).AddRow(watermark.Unix()))
+		mock.ExpectQuery(`SELECT count\(\) FROM metrics_1h WHERE period_start >= \?`).
+			WithArgs(watermark.Unix()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		expectRollup(mock)
+
+		require.NoError(t, r.rollupLevel(t.Context(), r.levels[0], now))
+		assert.Equal(t, to, r.levels[0].watermark)
+	})
+
+	t.Run("FailedRollup", func(t *testing.T) {
+		r, mock := setup(t)
+		mock.ExpectQuery("SELECT .+ FROM rollup_watermarks").
+			WithArgs(RollupHourlyTable).
+			WillReturnRows(sqlmock.NewRows([]string{"watermark"}).AddRow(watermark.Unix()))
+		mock.ExpectQuery(`SELECT count\(\) FROM metrics_1h WHERE period_start >= \?`).
+			WithArgs(watermark.Unix()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(42))
+		mock.ExpectExec(`DELETE FROM metrics_1h WHERE period_start >= \?`).
+			WithArgs(watermark.Unix()).
+			WillReturnResult(sqlmock.NewResult(0, 42))
+		expectRollup(mock)
+
+		require.NoError(t, r.rollupLevel(t.Context(), r.levels[0], now))
+		assert.Equal(t, to, r.levels[0].watermark)
+	})
+
+	t.Run("NoPersistedWatermark", func(t *testing.T) {
+		r, mock := setup(t)
+		mock.ExpectQuery("SELECT .+ FROM rollup_watermarks").
+			WithArgs(RollupHourlyTable).
+			WillReturnRows(sqlmock.NewRows([]string{"watermark"}).AddRow(0))
+		mock.ExpectQuery(`SELECT .+max\(period_start\).+ FROM metrics_1h`).
+			WillReturnRows(sqlmock.NewRows([]string{"last"}).AddRow(watermark.Add(-time.Hour).Unix()))
+		mock.ExpectQuery(`SELECT count\(\) FROM metrics_1h WHERE period_start >= \?`).
+			WithArgs(watermark.Unix()).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		expectRollup(mock)
+
+		require.NoError(t, r.rollupLevel(t.Context(), r.levels[0], now))
+		assert.Equal(t, to, r.levels[0].watermark)
+	})
+}
+
+func TestMetricsTableTemplate(t *testing.T) {
+	whitespace := regexp.MustCompile(`\s+`)
+	tmpl := template.Must(template.New("test").Parse(`FROM {{ template "metricsTable" . }} WHERE 1` + metricsTableTmpl))
+
+	render := func(t *testing.T, sources []*metricsSource) string {
+		t.Helper()
+		var buf bytes.Buffer
+		require.NoError(t, tmpl.Execute(&buf, map[string]any{"Sources": sources}))
+		return strings.TrimSpace(whitespace.ReplaceAllString(buf.String(), " "))
+	}
+
+	assert.Equal(t, "FROM metrics WHERE 1", render(t, nil))
+
+	expected := "FROM ( SELECT * FROM metrics_1h WHERE 1 AND period_start < 1000 " +
+		"UNION ALL SELECT * FROM metrics WHERE 1 AND period_start >= 1000 ) AS metrics WHERE 1"
+	assert.Equal(t, expected, render(t, []*metricsSource{
+		{Table: RollupHourlyTable, To: 1000},
+		{Table: rawTable, From: 1000},
+	}))
+}
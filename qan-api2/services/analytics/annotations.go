@@ -0,0 +1,129 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	qanpb "github.com/percona/pmm/api/qan/v1"
+	"github.com/percona/pmm/qan-api2/models"
+)
+
+// SetQueryAnnotation implements rpc to create or replace the annotation of the query.
+func (s *Service) SetQueryAnnotation(ctx context.Context, in *qanpb.SetQueryAnnotationRequest) (*qanpb.SetQueryAnnotationResponse, error) {
+	a, err := annotationFromProto(in.Annotation)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.qa.Set(ctx, a)
+	if errors.Is(err, models.ErrQueryNotVisible) {
+		return nil, status.Errorf(codes.NotFound, "query %s not found", a.QueryID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot set query annotation: %w", err)
+	}
+
+	return &qanpb.SetQueryAnnotationResponse{Annotation: annotationToProto(a)}, nil
+}
+
+// RemoveQueryAnnotation implements rpc to remove the annotation of the query.
+func (s *Service) RemoveQueryAnnotation(ctx context.Context, in *qanpb.RemoveQueryAnnotationRequest) (*qanpb.RemoveQueryAnnotationResponse, error) {
+	if in.Queryid == "" {
+		return nil, errors.New("queryid is required")
+	}
+
+	err := s.qa.Remove(ctx, in.Queryid)
+	if errors.Is(err, models.ErrQueryNotVisible) {
+		return nil, status.Errorf(codes.NotFound, "query %s not found", in.Queryid)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot remove query annotation: %w", err)
+	}
+
+	return &qanpb.RemoveQueryAnnotationResponse{}, nil
+}
+
+// ListQueryAnnotations implements rpc to list query annotations.
+func (s *Service) ListQueryAnnotations(ctx context.Context, in *qanpb.ListQueryAnnotationsRequest) (*qanpb.ListQueryAnnotationsResponse, error) {
+	annotations, err := s.qa.Select(ctx, &models.QueryAnnotationsFilter{
+		QueryIDs:     in.Queryids,
+		Owner:        in.Owner,
+		Acknowledged: in.Acknowledged,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot list query annotations: %w", err)
+	}
+
+	res := &qanpb.ListQueryAnnotationsResponse{
+		Annotations: make([]*qanpb.QueryAnnotation, 0, len(annotations)),
+	}
+	for _, a := range annotations {
+		res.Annotations = append(res.Annotations, annotationToProto(a))
+	}
+
+	return res, nil
+}
+
+// annotationFromProto validates the annotation and converts it to the model.
+func annotationFromProto(in *qanpb.QueryAnnotation) (*models.QueryAnnotation, error) {
+	if in == nil {
+		return nil, errors.New("annotation is required")
+	}
+	if in.Queryid == "" {
+		return nil, errors.New("queryid is required")
+	}
+
+	ticketURL := strings.TrimSpace(in.TicketUrl)
+	if ticketURL != "" {
+		u, err := url.Parse(ticketURL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("invalid ticket URL: %q", in.TicketUrl)
+		}
+	}
+
+	return &models.QueryAnnotation{
+		QueryID:      in.Queryid,
+		Owner:        strings.TrimSpace(in.Owner),
+		TicketURL:    ticketURL,
+		Acknowledged: in.Acknowledged,
+		Notes:        in.Notes,
+	}, nil
+}
+
+// annotationToProto converts the annotation model to protobuf; it returns nil for nil annotation.
+func annotationToProto(a *models.QueryAnnotation) *qanpb.QueryAnnotation {
+	if a == nil {
+		return nil
+	}
+
+	return &qanpb.QueryAnnotation{
+		Queryid:      a.QueryID,
+		Owner:        a.Owner,
+		TicketUrl:    a.TicketURL,
+		Acknowledged: a.Acknowledged,
+		Notes:        a.Notes,
+		UpdatedAt:    timestamppb.New(a.UpdatedAt),
+	}
+}
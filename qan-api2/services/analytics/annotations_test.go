@@ -0,0 +1,115 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package analytics
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	qanpb "github.com/percona/pmm/api/qan/v1"
+	"github.com/percona/pmm/qan-api2/models"
+)
+
+func TestAnnotationFromProto(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Normal", func(t *testing.T) {
+		t.Parallel()
+
+		a, err := annotationFromProto(&qanpb.QueryAnnotation{
+			Queryid:      "ABC",
+			Owner:        " payments ",
+			TicketUrl:    "https://jira.example.com/browse/DB-1",
+			Acknowledged: true,
+			Notes:        "Known slow report query.",
+		})
+		require.NoError(t, err)
+		expected := &models.QueryAnnotation{
+			QueryID:      "ABC",
+			Owner:        "payments",
+			TicketURL:    "https://jira.example.com/browse/DB-1",
+			Acknowledged: true,
+			Notes:        "Known slow report query.",
+		}
+		assert.Equal(t, expected, a)
+	})
+
+	for _, tc := range []struct {
+		name     string
+		in       *qanpb.QueryAnnotation
+		expected string
+	}{{
+		name:     "NoAnnotation",
+		expected: "annotation is required",
+	}, {
+		name:     "NoQueryID",
+		in:       &qanpb.QueryAnnotation{Owner: "payments"},
+		expected: "queryid is required",
+	}, {
+		name:     "InvalidTicketURL",
+		in:       &qanpb.QueryAnnotation{Queryid: "ABC", TicketUrl: "javascript:alert(1)"},
+		expected: `invalid ticket URL: "javascript:alert(1)"`,
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := annotationFromProto(tc.in)
+			require.EqualError(t, err, tc.expected)
+		})
+	}
+}
+
+func TestQueryAnnotationsLBAC(t *testing.T) {
+	db := setup()
+	s := &Service{qa: models.NewQueryAnnotations(db)}
+
+	lbacContext := func(filter string) context.Context {
+		md := metadata.Pairs(models.LBACHeaderName, base64.StdEncoding.EncodeToString([]byte(filter)))
+		return metadata.NewIncomingContext(makeContext(t), md)
+	}
+	const queryID = "B305F6354FA21F2A"
+
+	t.Run("Visible", func(t *testing.T) {
+		ctx := lbacContext(`["{service_type=\"service_type1\"}"]`)
+
+		_, err := s.SetQueryAnnotation(ctx, &qanpb.SetQueryAnnotationRequest{
+			Annotation: &qanpb.QueryAnnotation{Queryid: queryID, Owner: "payments"},
+		})
+		require.NoError(t, err)
+
+		_, err = s.RemoveQueryAnnotation(ctx, &qanpb.RemoveQueryAnnotationRequest{Queryid: queryID})
+		require.NoError(t, err)
+	})
+
+	t.Run("Hidden", func(t *testing.T) {
+		ctx := lbacContext(`["{service_type=\"not-existing\"}"]`)
+
+		_, err := s.SetQueryAnnotation(ctx, &qanpb.SetQueryAnnotationRequest{
+			Annotation: &qanpb.QueryAnnotation{Queryid: queryID, Owner: "payments"},
+		})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		_, err = s.RemoveQueryAnnotation(ctx, &qanpb.RemoveQueryAnnotationRequest{Queryid: queryID})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	qanpb "github.com/percona/pmm/api/qan/v1"
+)
+
+// ListQueryAnomalies implements rpc to list anomalies found by the last anomaly detection run.
+func (s *Service) ListQueryAnomalies(ctx context.Context, in *qanpb.ListQueryAnomaliesRequest) (*qanpb.ListQueryAnomaliesResponse, error) {
+	if s.ad == nil {
+		return nil, errors.New("query anomaly detection is disabled")
+	}
+
+	found, evaluatedAt := s.ad.Anomalies()
+	if evaluatedAt.IsZero() {
+		return &qanpb.ListQueryAnomaliesResponse{}, nil
+	}
+
+	var visible map[string]struct{}
+	if len(found) != 0 {
+		var err error
+		// Services without data since the detection window start can't have anomalies.
+		if visible, err = s.am.SelectLBACServices(ctx, found[0].WindowStart.GetSeconds()); err != nil {
+			return nil, fmt.Errorf("cannot apply LBAC filters: %w", err)
+		}
+	}
+
+	res := &qanpb.ListQueryAnomaliesResponse{
+		EvaluatedAt: timestamppb.New(evaluatedAt),
+	}
+	for _, a := range found {
+		if in.ServiceId != "" && a.ServiceId != in.ServiceId {
+			continue
+		}
+		if len(in.Types) != 0 && !slices.Contains(in.Types, a.Type) {
+			continue
+		}
+		if visible != nil {
+			if _, ok := visible[a.ServiceId]; !ok {
+				continue
+			}
+		}
+		res.Anomalies = append(res.Anomalies, a)
+	}
+
+	return res, nil
+}
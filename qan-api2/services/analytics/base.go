@@ -23,6 +23,7 @@ import (
 
 	qanpb "github.com/percona/pmm/api/qan/v1"
 	"github.com/percona/pmm/qan-api2/models"
+	"github.com/percona/pmm/qan-api2/services/anomalies"
 )
 
 // Service implements gRPC service to communicate with QAN-APP.
@@ -32,11 +33,27 @@ type Service struct {
 	db *sqlx.DB
 	rm models.Reporter
 	mm models.Metrics
+	am models.Anomalies
+	qa models.QueryAnnotations
+	qp models.QueryPlans
+	ir models.IndexRecommendations
+	ex models.Exporter
+	ad *anomalies.Detector // nil if anomaly detection is disabled
 }
 
 // NewService create new insstance of Service.
-func NewService(db *sqlx.DB, rm models.Reporter, mm models.Metrics) *Service {
-	return &Service{db: db, rm: rm, mm: mm}
+func NewService(
+	db *sqlx.DB,
+	rm models.Reporter,
+	mm models.Metrics,
+	am models.Anomalies,
+	qa models.QueryAnnotations,
+	qp models.QueryPlans,
+	ir models.IndexRecommendations,
+	ex models.Exporter,
+	ad *anomalies.Detector,
+) *Service {
+	return &Service{db: db, rm: rm, mm: mm, am: am, qa: qa, qp: qp, ir: ir, ex: ex, ad: ad}
 }
 
 // HealthCheck implements gRPC health check endpoint.
@@ -109,6 +126,10 @@ var sumColumnNames = map[string]struct{}{
 	"wal_buffers_full":                       {},
 	"parallel_workers_to_launch":             {},
 	"parallel_workers_launched":              {},
+	"total_calls":                            {},
+	"total_time":                             {},
+	"rejected_calls":                         {},
+	"failed_calls":                           {},
 	"locks_global_acquire_count_read_shared": {},
 	"locks_global_acquire_count_write_shared":       {},
 	"locks_database_acquire_count_read_shared":      {},
@@ -206,6 +227,7 @@ func isTimeMetric(name string) bool {
 		"cpu_user_time":         {},
 		"cpu_sys_time":          {},
 		"plan_time":             {},
+		"total_time":            {},
 		"locks_database_time_acquiring_micros_read_shared": {},
 		"storage_time_reading_micros":                      {},
 	}
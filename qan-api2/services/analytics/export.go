@@ -0,0 +1,143 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package analytics
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+
+	qanpb "github.com/percona/pmm/api/qan/v1"
+	"github.com/percona/pmm/qan-api2/models"
+)
+
+// exportChunkSize is the maximum size of exported data sent in a single message.
+const exportChunkSize = 64 * 1024
+
+// exportFormat contains ClickHouse output format and MIME type of the export format.
+type exportFormat struct {
+	format      string
+	contentType string
+}
+
+var exportFormats = map[qanpb.ExportFormat]exportFormat{
+	qanpb.ExportFormat_EXPORT_FORMAT_CSV:     {format: models.ExportFormatCSV, contentType: "text/csv"},
+	qanpb.ExportFormat_EXPORT_FORMAT_JSONL:   {format: models.ExportFormatJSONL, contentType: "application/jsonl"},
+	qanpb.ExportFormat_EXPORT_FORMAT_PARQUET: {format: models.ExportFormatParquet, contentType: "application/vnd.apache.parquet"},
+}
+
+// ExportReport implements rpc to stream metrics report for given filtering in the requested format.
+func (s *Service) ExportReport(in *qanpb.ExportReportRequest, stream grpc.ServerStreamingServer[httpbody.HttpBody]) error {
+	params, format, err := exportParams(in)
+	if err != nil {
+		return err
+	}
+
+	r, err := s.ex.Export(stream.Context(), params)
+	if err != nil {
+		return err
+	}
+	defer r.Close() //nolint:errcheck
+
+	buf := make([]byte, exportChunkSize)
+	for {
+		n, err := io.ReadFull(r, buf)
+		if n > 0 {
+			if sErr := stream.Send(&httpbody.HttpBody{ContentType: format.contentType, Data: buf[:n]}); sErr != nil {
+				return sErr
+			}
+		}
+
+		switch {
+		case errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+			return nil
+		case err != nil:
+			return fmt.Errorf("failed to read exported data: %w", err)
+		}
+	}
+}
+
+// exportParams validates the export request and converts it to export parameters.
+func exportParams(in *qanpb.ExportReportRequest) (*models.ExportParams, exportFormat, error) {
+	format, ok := exportFormats[in.Format]
+	if !ok {
+		return nil, exportFormat{}, fmt.Errorf("unsupported export format: %s", in.Format)
+	}
+
+	if in.PeriodStartFrom == nil || in.PeriodStartTo == nil {
+		return nil, format, fmt.Errorf("from-date: %v or to-date: %v cannot be empty", in.PeriodStartFrom, in.PeriodStartTo)
+	}
+	if in.PeriodStartFrom.Seconds > in.PeriodStartTo.Seconds {
+		return nil, format, fmt.Errorf("from-date %v cannot be later then to-date %v", in.PeriodStartFrom, in.PeriodStartTo)
+	}
+
+	if _, ok := standartDimensions[in.GroupBy]; !ok {
+		return nil, format, fmt.Errorf("unknown group dimension: %#q", in.GroupBy)
+	}
+
+	params := &models.ExportParams{
+		PeriodStartFromSec: in.PeriodStartFrom.Seconds,
+		PeriodStartToSec:   in.PeriodStartTo.Seconds,
+		Dimensions:         make(map[string][]string),
+		Labels:             make(map[string][]string),
+		Group:              in.GroupBy,
+		Search:             in.Search,
+		Format:             format.format,
+	}
+
+	if in.Bucket != nil {
+		if err := in.Bucket.CheckValid(); err != nil {
+			return nil, format, fmt.Errorf("invalid bucket: %w", err)
+		}
+		params.BucketSec = int64(in.Bucket.AsDuration().Minutes()) * 60 //nolint:mnd
+		if params.BucketSec <= 0 {
+			return nil, format, fmt.Errorf("bucket %s is shorter than a minute", in.Bucket.AsDuration())
+		}
+	}
+
+	for _, label := range in.GetLabels() {
+		if models.IsDimension(label.Key) {
+			params.Dimensions[label.Key] = label.Value
+			continue
+		}
+		params.Labels[label.Key] = label.Value
+	}
+
+	// columns are inlined into the query, so unlike the report, unknown ones are rejected
+	columns := slices.Clone(in.Columns)
+	if len(columns) == 0 {
+		columns = []string{"load", "num_queries", "query_time"}
+	}
+	slices.Sort(columns)
+	for _, col := range slices.Compact(columns) {
+		switch {
+		case isBoolMetric(col):
+			params.SumColumns = append(params.SumColumns, col)
+		case isCommonMetric(col):
+			params.CommonColumns = append(params.CommonColumns, col)
+		case isSpecialMetric(col):
+			params.SpecialColumns = append(params.SpecialColumns, col)
+		default:
+			return nil, format, fmt.Errorf("unknown column: %#q", col)
+		}
+	}
+
+	return params, format, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package analytics
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	qanpb "github.com/percona/pmm/api/qan/v1"
+	"github.com/percona/pmm/qan-api2/models"
+)
+
+func TestExportParams(t *testing.T) {
+	t.Parallel()
+
+	from := timestamppb.New(time.Unix(1735812000, 0))
+	to := timestamppb.New(time.Unix(1735815600, 0))
+
+	t.Run("Normal", func(t *testing.T) {
+		t.Parallel()
+
+		params, format, err := exportParams(&qanpb.ExportReportRequest{
+			PeriodStartFrom: from,
+			PeriodStartTo:   to,
+			GroupBy:         "queryid",
+			Labels: []*qanpb.ReportMapFieldEntry{
+				{Key: "service_name", Value: []string{"mysql1"}},
+				{Key: "team", Value: []string{"db"}},
+			},
+			Columns: []string{"query_time", "load", "num_queries", "query_time", "full_scan"},
+			Bucket:  durationpb.New(90 * time.Second),
+			Format:  qanpb.ExportFormat_EXPORT_FORMAT_JSONL,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "application/jsonl", format.contentType)
+
+		expected := &models.ExportParams{
+			PeriodStartFromSec: 1735812000,
+			PeriodStartToSec:   1735815600,
+			BucketSec:          60,
+			Dimensions:         map[string][]string{"service_name": {"mysql1"}},
+			Labels:             map[string][]string{"team": {"db"}},
+			Group:              "queryid",
+			SpecialColumns:     []string{"load", "num_queries"},
+			CommonColumns:      []string{"query_time"},
+			SumColumns:         []string{"full_scan"},
+			Format:             models.ExportFormatJSONL,
+		}
+		assert.Equal(t, expected, params)
+	})
+
+	for _, tc := range []struct {
+		name     string
+		req      *qanpb.ExportReportRequest
+		expected string
+	}{{
+		name:     "NoFormat",
+		req:      &qanpb.ExportReportRequest{PeriodStartFrom: from, PeriodStartTo: to, GroupBy: "queryid"},
+		expected: "unsupported export format: EXPORT_FORMAT_UNSPECIFIED",
+	}, {
+		name:     "UnknownGroup",
+		req:      &qanpb.ExportReportRequest{PeriodStartFrom: from, PeriodStartTo: to, GroupBy: "1; DROP TABLE metrics", Format: qanpb.ExportFormat_EXPORT_FORMAT_CSV},
+		expected: "unknown group dimension: `1; DROP TABLE metrics`",
+	}, {
+		name:     "UnknownColumn",
+		req:      &qanpb.ExportReportRequest{PeriodStartFrom: from, PeriodStartTo: to, GroupBy: "queryid", Columns: []string{"foo"}, Format: qanpb.ExportFormat_EXPORT_FORMAT_CSV},
+		expected: "unknown column: `foo`",
+	}, {
+		name: "ShortBucket",
+		req: &qanpb.ExportReportRequest{
+			PeriodStartFrom: from, PeriodStartTo: to, GroupBy: "queryid",
+			Bucket: durationpb.New(10 * time.Second), Format: qanpb.ExportFormat_EXPORT_FORMAT_PARQUET,
+		},
+		expected: "bucket 10s is shorter than a minute",
+	}} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, _, err := exportParams(tc.req)
+			require.EqualError(t, err, tc.expected)
+		})
+	}
+}
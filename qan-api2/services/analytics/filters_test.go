@@ -62,8 +62,8 @@ func TestService_GetFilters(t *testing.T) {
 		t.Fatal("Connection: ", err)
 	}
 
-	rm := models.NewReporter(db)
-	mm := models.NewMetrics(db)
+	rm := models.NewReporter(db, nil)
+	mm := models.NewMetrics(db, nil)
 	t1, _ := time.Parse(time.RFC3339, "2019-01-01T00:00:00Z")
 	t2, _ := time.Parse(time.RFC3339, "2019-01-01T00:01:00Z")
 	var want qanpb.GetFilteredMetricsNamesResponse
@@ -88,6 +88,11 @@ var metricsNames = map[string]string{ // PMM-13793
 	"m_parallel_workers_to_launch": "Total number of parallel workers to launch",
 	"m_parallel_workers_launched":  "Total number of parallel workers launched",
 	"m_plan_time":                  "Total plan time spent in query",
+	"m_args":                       "Number of command arguments",
+	"m_total_calls":                "Total number of command calls",
+	"m_total_time":                 "Total time spent in command calls",
+	"m_rejected_calls":             "Number of rejected command calls",
+	"m_failed_calls":               "Number of failed command calls",
 	"cmd_type":                     "Type of SQL command used in the query",
 	"top_queryid":                  "Top parent query ID",
 	"top_query":                    "Top query plain text",
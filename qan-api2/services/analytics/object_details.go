@@ -45,6 +45,10 @@ func (s *Service) GetMetrics(ctx context.Context, in *qanpb.GetMetricsRequest) (
 			dimensions[label.Key] = label.Value
 			continue
 		}
+		// object details are selected for the single dimension value, annotations don't narrow them down
+		if models.IsAnnotationFilter(label.Key) {
+			continue
+		}
 		labels[label.Key] = label.Value
 	}
 
@@ -134,6 +138,12 @@ func (s *Service) GetMetrics(ctx context.Context, in *qanpb.GetMetricsRequest) (
 			return resp, err
 		}
 		resp.Fingerprint = fp
+
+		annotations, err := s.qa.SelectByQueryIDs(ctx, []string{in.FilterBy})
+		if err != nil {
+			return resp, err
+		}
+		resp.Annotation = annotationToProto(annotations[in.FilterBy])
 	}
 
 	metadata, err := s.mm.GetSelectedQueryMetadata(ctx, periodStartFromSec,
@@ -245,6 +255,10 @@ func (s *Service) GetQueryExample(ctx context.Context, in *qanpb.GetQueryExample
 			dimensions[label.Key] = label.Value
 			continue
 		}
+		// object details are selected for the single dimension value, annotations don't narrow them down
+		if models.IsAnnotationFilter(label.Key) {
+			continue
+		}
 		labels[label.Key] = label.Value
 	}
 
@@ -339,6 +353,10 @@ func (s *Service) GetHistogram(ctx context.Context, in *qanpb.GetHistogramReques
 			dimensions[label.Key] = label.Value
 			continue
 		}
+		// object details are selected for the single dimension value, annotations don't narrow them down
+		if models.IsAnnotationFilter(label.Key) {
+			continue
+		}
 		labels[label.Key] = label.Value
 	}
 
@@ -33,8 +33,8 @@ import (
 
 func TestService_GetQueryExample(t *testing.T) {
 	db := setup()
-	rm := models.NewReporter(db)
-	mm := models.NewMetrics(db)
+	rm := models.NewReporter(db, nil)
+	mm := models.NewMetrics(db, nil)
 	t1, _ := time.Parse(time.RFC3339, "2019-01-01T00:00:00Z")
 	t2, _ := time.Parse(time.RFC3339, "2019-01-01T10:00:00Z")
 	var want qanpb.GetQueryExampleResponse
@@ -150,8 +150,8 @@ func TestService_GetQueryExample(t *testing.T) {
 
 func TestService_GetMetricsError(t *testing.T) {
 	db := setup()
-	rm := models.NewReporter(db)
-	mm := models.NewMetrics(db)
+	rm := models.NewReporter(db, nil)
+	mm := models.NewMetrics(db, nil)
 	t1, _ := time.Parse(time.RFC3339, "2019-01-01T00:00:00Z")
 	t2, _ := time.Parse(time.RFC3339, "2019-01-01T10:00:00Z")
 
@@ -261,6 +261,7 @@ func TestService_GetMetricsError(t *testing.T) {
 			s := &Service{
 				rm: tt.fields.rm,
 				mm: tt.fields.mm,
+				qa: models.NewQueryAnnotations(db),
 			}
 			_, err := s.GetMetrics(context.TODO(), tt.in)
 			if (err != nil) != tt.wantErr {
@@ -272,8 +273,8 @@ func TestService_GetMetricsError(t *testing.T) {
 
 func TestService_GetMetrics(t *testing.T) {
 	db := setup()
-	rm := models.NewReporter(db)
-	mm := models.NewMetrics(db)
+	rm := models.NewReporter(db, nil)
+	mm := models.NewMetrics(db, nil)
 	t1, _ := time.Parse(time.RFC3339, "2019-01-01T00:00:00Z")
 	t2, _ := time.Parse(time.RFC3339, "2019-01-01T10:00:00Z")
 
@@ -281,6 +282,7 @@ func TestService_GetMetrics(t *testing.T) {
 		s := &Service{
 			rm: rm,
 			mm: mm,
+			qa: models.NewQueryAnnotations(db),
 		}
 		in := &qanpb.GetMetricsRequest{
 			PeriodStartFrom: &timestamppb.Timestamp{Seconds: t1.Unix()},
@@ -305,6 +307,7 @@ func TestService_GetMetrics(t *testing.T) {
 		s := &Service{
 			rm: rm,
 			mm: mm,
+			qa: models.NewQueryAnnotations(db),
 		}
 		in := &qanpb.GetMetricsRequest{
 			PeriodStartFrom: &timestamppb.Timestamp{Seconds: t1.Unix()},
@@ -328,6 +331,7 @@ func TestService_GetMetrics(t *testing.T) {
 		s := &Service{
 			rm: rm,
 			mm: mm,
+			qa: models.NewQueryAnnotations(db),
 		}
 		in := &qanpb.GetMetricsRequest{
 			PeriodStartFrom: &timestamppb.Timestamp{Seconds: t1.Unix()},
@@ -351,8 +355,8 @@ func TestService_GetMetrics(t *testing.T) {
 
 func TestService_GetLabels(t *testing.T) {
 	db := setup()
-	rm := models.NewReporter(db)
-	mm := models.NewMetrics(db)
+	rm := models.NewReporter(db, nil)
+	mm := models.NewMetrics(db, nil)
 	t1, _ := time.Parse(time.RFC3339, "2019-01-01T00:00:00Z")
 	t2, _ := time.Parse(time.RFC3339, "2019-01-01T10:00:00Z")
 	want := qanpb.GetLabelsResponse{}
@@ -0,0 +1,132 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package analytics
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	qanpb "github.com/percona/pmm/api/qan/v1"
+	"github.com/percona/pmm/qan-api2/models"
+)
+
+// ListQueryPlans implements rpc to list plan versions of the query.
+func (s *Service) ListQueryPlans(ctx context.Context, in *qanpb.ListQueryPlansRequest) (*qanpb.ListQueryPlansResponse, error) {
+	if in.ServiceId == "" {
+		return nil, errors.New("service_id is required")
+	}
+	if in.Queryid == "" {
+		return nil, errors.New("queryid is required")
+	}
+
+	filter := &models.QueryPlansFilter{
+		ServiceID: in.ServiceId,
+		QueryID:   in.Queryid,
+	}
+	if in.PeriodStartFrom != nil {
+		filter.From = in.PeriodStartFrom.AsTime()
+	}
+	if in.PeriodStartTo != nil {
+		filter.To = in.PeriodStartTo.AsTime()
+	}
+
+	samples, err := s.qp.SelectSamples(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("cannot select query plans: %w", err)
+	}
+
+	res := &qanpb.ListQueryPlansResponse{
+		ServiceId: in.ServiceId,
+		Queryid:   in.Queryid,
+	}
+	if len(samples) == 0 {
+		return res, nil
+	}
+	res.ServiceName = samples[0].ServiceName
+	res.ServiceType = samples[0].ServiceType
+
+	versions := models.CollapsePlanSamples(samples)
+	planIDs := make([]string, 0, len(versions))
+	for _, v := range versions {
+		planIDs = append(planIDs, v.PlanID)
+	}
+	plans, err := s.qp.SelectPlans(ctx, in.ServiceId, in.Queryid, planIDs)
+	if err != nil {
+		return nil, fmt.Errorf("cannot select query plans: %w", err)
+	}
+
+	res.Versions = make([]*qanpb.QueryPlanVersion, 0, len(versions))
+	for _, v := range versions {
+		res.Versions = append(res.Versions, &qanpb.QueryPlanVersion{
+			PlanId:    v.PlanID,
+			Plan:      plans[v.PlanID],
+			FirstSeen: timestamppb.New(v.FirstSeen),
+			LastSeen:  timestamppb.New(v.LastSeen),
+			Samples:   v.Samples,
+		})
+	}
+
+	return res, nil
+}
+
+// DiffQueryPlans implements rpc to compare two plans of the query.
+func (s *Service) DiffQueryPlans(ctx context.Context, in *qanpb.DiffQueryPlansRequest) (*qanpb.DiffQueryPlansResponse, error) {
+	if in.ServiceId == "" {
+		return nil, errors.New("service_id is required")
+	}
+	if in.Queryid == "" {
+		return nil, errors.New("queryid is required")
+	}
+	if in.FromPlanId == "" || in.ToPlanId == "" {
+		return nil, errors.New("from_plan_id and to_plan_id are required")
+	}
+
+	plans, err := s.qp.SelectPlans(ctx, in.ServiceId, in.Queryid, []string{in.FromPlanId, in.ToPlanId})
+	if err != nil {
+		return nil, fmt.Errorf("cannot select query plans: %w", err)
+	}
+	for _, id := range []string{in.FromPlanId, in.ToPlanId} {
+		if _, ok := plans[id]; !ok {
+			return nil, fmt.Errorf("plan %q of query %q is not found", id, in.Queryid)
+		}
+	}
+
+	diff, err := diffPlans(in.FromPlanId, plans[in.FromPlanId], in.ToPlanId, plans[in.ToPlanId])
+	if err != nil {
+		return nil, err
+	}
+
+	return &qanpb.DiffQueryPlansResponse{Diff: diff}, nil
+}
+
+// diffPlans returns a unified diff of two normalized plans; it is empty for equal plans.
+func diffPlans(fromID, from, toID, to string) (string, error) {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: fromID,
+		ToFile:   toID,
+		Context:  3, //nolint:mnd
+	})
+	if err != nil {
+		return "", fmt.Errorf("cannot diff query plans: %w", err)
+	}
+	return diff, nil
+}
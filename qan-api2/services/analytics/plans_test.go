@@ -0,0 +1,55 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package analytics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	qanpb "github.com/percona/pmm/api/qan/v1"
+)
+
+func TestDiffPlans(t *testing.T) {
+	t.Parallel()
+
+	from := "{\n  \"access_type\": \"ref\",\n  \"key\": \"idx_customer\",\n  \"table_name\": \"orders\"\n}"
+	to := "{\n  \"access_type\": \"ALL\",\n  \"table_name\": \"orders\"\n}"
+
+	diff, err := diffPlans("aaa", from, "bbb", to)
+	require.NoError(t, err)
+	expected := "--- aaa\n+++ bbb\n@@ -1,5 +1,4 @@\n {\n" +
+		"-  \"access_type\": \"ref\",\n-  \"key\": \"idx_customer\",\n+  \"access_type\": \"ALL\",\n" +
+		"   \"table_name\": \"orders\"\n }\n"
+	assert.Equal(t, expected, diff)
+
+	diff, err = diffPlans("aaa", from, "aaa", from)
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+}
+
+func TestQueryPlansValidation(t *testing.T) {
+	t.Parallel()
+
+	s := &Service{}
+
+	_, err := s.ListQueryPlans(t.Context(), &qanpb.ListQueryPlansRequest{Queryid: "Q1"})
+	require.EqualError(t, err, "service_id is required")
+
+	_, err = s.DiffQueryPlans(t.Context(), &qanpb.DiffQueryPlansRequest{ServiceId: "s1", Queryid: "Q1", FromPlanId: "aaa"})
+	require.EqualError(t, err, "from_plan_id and to_plan_id are required")
+}
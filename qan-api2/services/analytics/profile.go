@@ -18,6 +18,7 @@ package analytics
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
@@ -38,6 +39,11 @@ func (s *Service) GetReport(ctx context.Context, in *qanpb.GetReportRequest) (*q
 	}
 	periodDurationSec := periodStartToSec - periodStartFromSec
 
+	baseline, err := getBaselinePeriod(in)
+	if err != nil {
+		return nil, err
+	}
+
 	if _, ok := standartDimensions[in.GroupBy]; !ok {
 		return nil, fmt.Errorf("unknown group dimension: %#q", in.GroupBy)
 	}
@@ -112,6 +118,9 @@ func (s *Service) GetReport(ctx context.Context, in *qanpb.GetReportRequest) (*q
 	if _, ok := uniqColumnsMap[orderCol]; !ok {
 		return nil, fmt.Errorf("order column %#q not in selected columns: [%s]", orderCol, strings.Join(uniqColumns, ", "))
 	}
+	if in.OrderByDelta {
+		order = getDeltaOrderBy(orderCol, strings.HasPrefix(in.OrderBy, "-"), periodDurationSec, baseline)
+	}
 
 	resp := &qanpb.GetReportResponse{}
 	results, err := s.rm.Select(
@@ -128,12 +137,30 @@ func (s *Service) GetReport(ctx context.Context, in *qanpb.GetReportRequest) (*q
 		specialColumns,
 		commonColumns,
 		sumColumns,
+		baseline,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	var annotations map[string]*models.QueryAnnotation
+	if group == "queryid" {
+		queryIDs := make([]string, 0, len(results))
+		for _, res := range results[1:] {
+			queryIDs = append(queryIDs, res["dimension"].(string)) //nolint:forcetypeassert
+		}
+		if annotations, err = s.qa.SelectByQueryIDs(ctx, queryIDs); err != nil {
+			return nil, err
+		}
+	}
+
 	total := results[0]
+	var baselineTotal models.M
+	var baselineDurationSec int64
+	if baseline != nil {
+		baselineTotal = models.BaselineRow(total)
+		baselineDurationSec = baseline.PeriodStartToSec - baseline.PeriodStartFromSec
+	}
 	resp.TotalRows = uint32(total["total_rows"].(uint64)) //nolint:forcetypeassert,gosec // TODO: fix it
 	resp.Offset = in.Offset
 	resp.Limit = in.Limit
@@ -159,6 +186,9 @@ func (s *Service) GetReport(ctx context.Context, in *qanpb.GetReportRequest) (*q
 
 		// The row with index 0 is total.
 		isTotal := i == 0
+		if !isTotal {
+			row.Annotation = annotationToProto(annotations[row.Dimension])
+		}
 
 		sparklines, err := s.rm.SelectSparklines(
 			ctx,
@@ -181,11 +211,62 @@ func (s *Service) GetReport(ctx context.Context, in *qanpb.GetReportRequest) (*q
 				Stats: stats,
 			}
 		}
+
+		if baseline != nil {
+			row.ComparisonStatus = comparisonStatus(res["comparison_status"])
+			baselineRes := models.BaselineRow(res)
+			baselineNumQueries := interfaceToFloat32(baselineRes["num_queries"])
+			for _, c := range columns {
+				row.Metrics[c].BaselineStats = makeStats(c, baselineTotal, baselineRes, baselineNumQueries, baselineDurationSec)
+				row.Metrics[c].Delta = &qanpb.MetricDelta{
+					Delta: interfaceToFloat32(res["delta_"+c]),
+					Ratio: interfaceToFloat32(res["ratio_"+c]),
+				}
+			}
+		}
 		resp.Rows = append(resp.Rows, row)
 	}
 	return resp, nil
 }
 
+// getBaselinePeriod returns the baseline period of the request, or nil if comparison is not requested.
+func getBaselinePeriod(in *qanpb.GetReportRequest) (*models.BaselinePeriod, error) {
+	if in.BaselinePeriodStartFrom == nil && in.BaselinePeriodStartTo == nil {
+		if in.OrderByDelta {
+			return nil, errors.New("ordering by delta requires the baseline period")
+		}
+		return nil, nil //nolint:nilnil
+	}
+
+	if in.BaselinePeriodStartFrom == nil || in.BaselinePeriodStartTo == nil {
+		return nil, fmt.Errorf("baseline from-date: %v or to-date: %v cannot be empty", in.BaselinePeriodStartFrom, in.BaselinePeriodStartTo)
+	}
+
+	baseline := &models.BaselinePeriod{
+		PeriodStartFromSec: in.BaselinePeriodStartFrom.Seconds,
+		PeriodStartToSec:   in.BaselinePeriodStartTo.Seconds,
+	}
+	if baseline.PeriodStartFromSec > baseline.PeriodStartToSec {
+		return nil, fmt.Errorf("baseline from-date %v cannot be later then to-date %v", in.BaselinePeriodStartFrom, in.BaselinePeriodStartTo)
+	}
+
+	return baseline, nil
+}
+
+// comparisonStatus converts comparison status of the report row to protobuf.
+func comparisonStatus(status any) qanpb.ComparisonStatus {
+	switch status {
+	case models.ComparisonPresent:
+		return qanpb.ComparisonStatus_COMPARISON_STATUS_PRESENT
+	case models.ComparisonNew:
+		return qanpb.ComparisonStatus_COMPARISON_STATUS_NEW
+	case models.ComparisonDisappeared:
+		return qanpb.ComparisonStatus_COMPARISON_STATUS_DISAPPEARED
+	default:
+		return qanpb.ComparisonStatus_COMPARISON_STATUS_UNSPECIFIED
+	}
+}
+
 func makeStats(metricNameRoot string, total, res models.M, numQueries float32, periodDurationSec int64) *qanpb.Stat {
 	var stat qanpb.Stat
 	durSec := float32(periodDurationSec)
@@ -269,3 +350,28 @@ func getOrderBy(reqOrder, defaultOrder string) (string, string) {
 
 	return queryOrder, orderCol
 }
+
+// getDeltaOrderBy creates an order by string to rank rows by the change of the order column
+// between the baseline and report periods. Values are compared the same way as deltas in the report rows:
+// averages per query for common and bool metrics, and rates per second for special ones.
+func getDeltaOrderBy(orderCol string, desc bool, periodDurationSec int64, baseline *models.BaselinePeriod) string {
+	direction := "ASC"
+	if desc {
+		direction = "DESC"
+	}
+
+	b := models.BaselinePrefix
+	var delta string
+	switch {
+	case orderCol == "load":
+		delta = fmt.Sprintf("ifNotFinite(load, 0) - ifNotFinite(%sload, 0)", b)
+	case isSpecialMetric(orderCol):
+		baselineDurationSec := baseline.PeriodStartToSec - baseline.PeriodStartFromSec
+		delta = fmt.Sprintf("%s / %d - %s%s / %d", orderCol, max(periodDurationSec, 1), b, orderCol, max(baselineDurationSec, 1))
+	default:
+		avg := fmt.Sprintf("m_%s_avg", orderCol)
+		delta = fmt.Sprintf("ifNotFinite(%s, 0) - ifNotFinite(%s%s, 0)", avg, b, avg)
+	}
+
+	return fmt.Sprintf("(%s) %s", delta, direction)
+}
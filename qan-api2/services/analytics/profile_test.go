@@ -80,8 +80,8 @@ func getExpectedJSON(t *testing.T, got proto.Message, filename string) []byte {
 
 func TestService_GetReport(t *testing.T) {
 	db := setup()
-	rm := models.NewReporter(db)
-	mm := models.NewMetrics(db)
+	rm := models.NewReporter(db, nil)
+	mm := models.NewMetrics(db, nil)
 	t1, _ := time.Parse(time.RFC3339, "2019-01-01T00:00:00Z")
 	t2, _ := time.Parse(time.RFC3339, "2019-01-01T10:00:00Z")
 	var want qanpb.GetReportResponse
@@ -149,6 +149,7 @@ func TestService_GetReport(t *testing.T) {
 			s := &Service{
 				rm: tt.fields.rm,
 				mm: tt.fields.mm,
+				qa: models.NewQueryAnnotations(db),
 			}
 
 			got, err := s.GetReport(makeContext(t), tt.in)
@@ -173,8 +174,8 @@ func TestService_GetReport(t *testing.T) {
 
 func TestService_GetReport_Mix(t *testing.T) {
 	db := setup()
-	rm := models.NewReporter(db)
-	mm := models.NewMetrics(db)
+	rm := models.NewReporter(db, nil)
+	mm := models.NewMetrics(db, nil)
 	t1, _ := time.Parse(time.RFC3339, "2019-01-01T00:00:00Z")
 	t2, _ := time.Parse(time.RFC3339, "2019-01-01T10:00:00Z")
 	var want qanpb.GetReportResponse
@@ -215,6 +216,7 @@ func TestService_GetReport_Mix(t *testing.T) {
 		s := &Service{
 			rm: test.fields.rm,
 			mm: test.fields.mm,
+			qa: models.NewQueryAnnotations(db),
 		}
 
 		got, err := s.GetReport(makeContext(t), test.in)
@@ -235,6 +237,7 @@ func TestService_GetReport_Mix(t *testing.T) {
 		s := &Service{
 			rm: test.fields.rm,
 			mm: test.fields.mm,
+			qa: models.NewQueryAnnotations(db),
 		}
 
 		got, err := s.GetReport(makeContext(t), test.in)
@@ -255,6 +258,7 @@ func TestService_GetReport_Mix(t *testing.T) {
 		s := &Service{
 			rm: test.fields.rm,
 			mm: test.fields.mm,
+			qa: models.NewQueryAnnotations(db),
 		}
 
 		got, err := s.GetReport(makeContext(t), test.in)
@@ -275,6 +279,7 @@ func TestService_GetReport_Mix(t *testing.T) {
 		s := &Service{
 			rm: test.fields.rm,
 			mm: test.fields.mm,
+			qa: models.NewQueryAnnotations(db),
 		}
 
 		test.in.Limit = 0
@@ -289,6 +294,7 @@ func TestService_GetReport_Mix(t *testing.T) {
 		s := &Service{
 			rm: test.fields.rm,
 			mm: test.fields.mm,
+			qa: models.NewQueryAnnotations(db),
 		}
 
 		test.in.GroupBy = "unknown dimension"
@@ -303,8 +309,8 @@ func TestService_GetReport_Mix(t *testing.T) {
 
 func TestService_GetReport_Groups(t *testing.T) {
 	db := setup()
-	rm := models.NewReporter(db)
-	mm := models.NewMetrics(db)
+	rm := models.NewReporter(db, nil)
+	mm := models.NewMetrics(db, nil)
 	t1, _ := time.Parse(time.RFC3339, "2019-01-01T00:00:00Z")
 	t2, _ := time.Parse(time.RFC3339, "2019-01-01T10:00:00Z")
 
@@ -539,8 +545,8 @@ func TestService_GetReport_Groups(t *testing.T) {
 
 func TestService_GetReport_AllLabels(t *testing.T) {
 	db := setup()
-	rm := models.NewReporter(db)
-	mm := models.NewMetrics(db)
+	rm := models.NewReporter(db, nil)
+	mm := models.NewMetrics(db, nil)
 	t1, _ := time.Parse(time.RFC3339, "2019-01-01T00:00:00Z")
 	t2, _ := time.Parse(time.RFC3339, "2019-01-01T10:00:00Z")
 	type fields struct {
@@ -636,6 +642,7 @@ func TestService_GetReport_AllLabels(t *testing.T) {
 		s := &Service{
 			rm: test.fields.rm,
 			mm: test.fields.mm,
+			qa: models.NewQueryAnnotations(db),
 		}
 
 		got, err := s.GetReport(makeContext(t), test.in)
@@ -654,8 +661,8 @@ func TestService_GetReport_AllLabels(t *testing.T) {
 
 func TestService_GetReport_Sparklines(t *testing.T) {
 	db := setup()
-	rm := models.NewReporter(db)
-	mm := models.NewMetrics(db)
+	rm := models.NewReporter(db, nil)
+	mm := models.NewMetrics(db, nil)
 	t1, _ := time.Parse(time.RFC3339, "2019-01-01T00:00:00Z")
 	t2, _ := time.Parse(time.RFC3339, "2019-01-01T01:00:00Z")
 
@@ -739,8 +746,8 @@ func TestService_GetReport_Sparklines(t *testing.T) {
 
 func TestService_GetReport_Search(t *testing.T) {
 	db := setup()
-	rm := models.NewReporter(db)
-	mm := models.NewMetrics(db)
+	rm := models.NewReporter(db, nil)
+	mm := models.NewMetrics(db, nil)
 	t1, _ := time.Parse(time.RFC3339, "2019-01-01T00:00:00Z")
 	t2, _ := time.Parse(time.RFC3339, "2019-01-01T10:00:00Z")
 
@@ -748,6 +755,7 @@ func TestService_GetReport_Search(t *testing.T) {
 		s := &Service{
 			rm: rm,
 			mm: mm,
+			qa: models.NewQueryAnnotations(db),
 		}
 
 		in := qanpb.GetReportRequest{
@@ -779,6 +787,7 @@ func TestService_GetReport_Search(t *testing.T) {
 		s := &Service{
 			rm: rm,
 			mm: mm,
+			qa: models.NewQueryAnnotations(db),
 		}
 
 		in := qanpb.GetReportRequest{
@@ -810,6 +819,7 @@ func TestService_GetReport_Search(t *testing.T) {
 		s := &Service{
 			rm: rm,
 			mm: mm,
+			qa: models.NewQueryAnnotations(db),
 		}
 
 		in := qanpb.GetReportRequest{
@@ -840,8 +850,8 @@ func TestService_GetReport_Search(t *testing.T) {
 
 func TestServiceGetReportSpecialMetrics(t *testing.T) {
 	db := setup()
-	rm := models.NewReporter(db)
-	mm := models.NewMetrics(db)
+	rm := models.NewReporter(db, nil)
+	mm := models.NewMetrics(db, nil)
 	t1, _ := time.Parse(time.RFC3339, "2019-01-01T00:00:00Z")
 	t2, _ := time.Parse(time.RFC3339, "2019-01-01T10:00:00Z")
 
@@ -875,3 +885,62 @@ func TestServiceGetReportSpecialMetrics(t *testing.T) {
 		assert.JSONEq(t, string(expectedJSON), string(gotJSON))
 	})
 }
+
+func TestGetBaselinePeriod(t *testing.T) {
+	t.Parallel()
+
+	from := timestamppb.New(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC))
+	to := timestamppb.New(time.Date(2019, 1, 1, 10, 0, 0, 0, time.UTC))
+
+	t.Run("not requested", func(t *testing.T) {
+		t.Parallel()
+
+		baseline, err := getBaselinePeriod(&qanpb.GetReportRequest{})
+		require.NoError(t, err)
+		assert.Nil(t, baseline)
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		baseline, err := getBaselinePeriod(&qanpb.GetReportRequest{BaselinePeriodStartFrom: from, BaselinePeriodStartTo: to})
+		require.NoError(t, err)
+		assert.Equal(t, &models.BaselinePeriod{PeriodStartFromSec: from.Seconds, PeriodStartToSec: to.Seconds}, baseline)
+	})
+
+	t.Run("one bound", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := getBaselinePeriod(&qanpb.GetReportRequest{BaselinePeriodStartFrom: from})
+		require.Error(t, err)
+	})
+
+	t.Run("wrong range", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := getBaselinePeriod(&qanpb.GetReportRequest{BaselinePeriodStartFrom: to, BaselinePeriodStartTo: from})
+		require.Error(t, err)
+	})
+
+	t.Run("order by delta without baseline", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := getBaselinePeriod(&qanpb.GetReportRequest{OrderByDelta: true})
+		require.EqualError(t, err, "ordering by delta requires the baseline period")
+	})
+}
+
+func TestGetDeltaOrderBy(t *testing.T) {
+	t.Parallel()
+
+	baseline := &models.BaselinePeriod{PeriodStartFromSec: 0, PeriodStartToSec: 7200}
+
+	assert.Equal(t, "(ifNotFinite(m_query_time_avg, 0) - ifNotFinite(b_m_query_time_avg, 0)) DESC",
+		getDeltaOrderBy("query_time", true, 3600, baseline))
+	assert.Equal(t, "(ifNotFinite(m_rows_sent_avg, 0) - ifNotFinite(b_m_rows_sent_avg, 0)) ASC",
+		getDeltaOrderBy("rows_sent", false, 3600, baseline))
+	assert.Equal(t, "(ifNotFinite(load, 0) - ifNotFinite(b_load, 0)) DESC",
+		getDeltaOrderBy("load", true, 3600, baseline))
+	assert.Equal(t, "(num_queries / 3600 - b_num_queries / 7200) DESC",
+		getDeltaOrderBy("num_queries", true, 3600, baseline))
+}
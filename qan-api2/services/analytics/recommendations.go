@@ -0,0 +1,63 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+
+package analytics
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	qanpb "github.com/percona/pmm/api/qan/v1"
+	"github.com/percona/pmm/qan-api2/models"
+)
+
+// ListIndexRecommendations implements rpc to list index recommendations.
+func (s *Service) ListIndexRecommendations(
+	ctx context.Context,
+	in *qanpb.ListIndexRecommendationsRequest,
+) (*qanpb.ListIndexRecommendationsResponse, error) {
+	recommendations, err := s.ir.Select(ctx, &models.IndexRecommendationsFilter{
+		ServiceID: in.ServiceId,
+		QueryID:   in.Queryid,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot select index recommendations: %w", err)
+	}
+
+	res := &qanpb.ListIndexRecommendationsResponse{
+		Recommendations: make([]*qanpb.IndexRecommendation, 0, len(recommendations)),
+	}
+	for _, r := range recommendations {
+		res.Recommendations = append(res.Recommendations, &qanpb.IndexRecommendation{
+			ServiceId:       r.ServiceID,
+			ServiceName:     r.ServiceName,
+			ServiceType:     r.ServiceType,
+			Queryid:         r.QueryID,
+			Fingerprint:     r.Fingerprint,
+			Database:        r.Database,
+			Table:           r.Table,
+			Columns:         r.Columns,
+			Statement:       r.Statement,
+			Reason:          r.Reason,
+			Evidence:        r.Evidence(),
+			ExistingIndexes: r.ExistingIndexes,
+			CreatedAt:       timestamppb.New(r.CreatedAt),
+		})
+	}
+
+	return res, nil
+}
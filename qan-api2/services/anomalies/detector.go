@@ -0,0 +1,318 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+// Package anomalies provides detection of query regressions and plan flips.
+package anomalies
+
+import (
+	"cmp"
+	"context"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	qanpb "github.com/percona/pmm/api/qan/v1"
+	"github.com/percona/pmm/qan-api2/models"
+)
+
+const (
+	prometheusNamespace = "qan_api2"
+	prometheusSubsystem = "anomaly_detection"
+
+	// ingestionDelay is the time given to pmm-agents to send the data of the last detection window.
+	ingestionDelay = 2 * time.Minute
+	// minRelativeStddev limits the baseline standard deviation from below, so that queries with
+	// very stable baselines are not flagged because of insignificant changes.
+	minRelativeStddev = 0.1
+	// maxAnomalies limits the number of reported anomalies, the most significant ones are kept.
+	maxAnomalies = 1000
+)
+
+// Params contains anomaly detection parameters.
+type Params struct {
+	// Interval is how often detection runs. It is also the size of the detection window
+	// and of the buckets the baseline is split into.
+	Interval time.Duration
+	// Baseline is the size of the rolling baseline window preceding the detection window.
+	Baseline time.Duration
+	// ZScoreThreshold is the minimal number of standard deviations from the baseline mean to flag a regression.
+	ZScoreThreshold float64
+	// MinRatio is the minimal ratio of the current value to the baseline mean to flag a regression.
+	MinRatio float64
+	// MinBaselineBuckets is the minimal number of baseline buckets with query executions.
+	MinBaselineBuckets uint64
+	// MinWindowQueries is the minimal number of query executions in the detection window.
+	MinWindowQueries uint64
+}
+
+// queryStatsSelector selects query metrics for anomaly detection.
+type queryStatsSelector interface {
+	SelectQueryStats(ctx context.Context, baselineStartSec, windowStartSec, windowEndSec, bucketSec int64) ([]*models.QueryStats, error)
+}
+
+// Detector periodically compares query metrics in the detection window with their rolling baselines.
+// The found anomalies are exposed as Prometheus metrics and via ListQueryAnomalies API.
+type Detector struct {
+	qs     queryStatsSelector
+	params Params
+	l      *logrus.Entry
+
+	rw          sync.RWMutex
+	anomalies   []*qanpb.QueryAnomaly
+	evaluatedAt time.Time
+
+	mRuns       *prometheus.CounterVec
+	mRunSeconds prometheus.Summary
+	mLastRun    prometheus.GaugeFunc
+	anomalyDesc *prometheus.Desc
+	zScoreDesc  *prometheus.Desc
+}
+
+// NewDetector creates new Detector.
+func NewDetector(qs queryStatsSelector, params Params) *Detector {
+	d := &Detector{
+		qs:     qs,
+		params: params,
+		l:      logrus.WithField("component", "anomaly_detection"),
+
+		mRuns: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: prometheusNamespace,
+			Subsystem: prometheusSubsystem,
+			Name:      "runs_total",
+			Help:      "Total number of anomaly detection runs.",
+		}, []string{"error"}),
+		mRunSeconds: prometheus.NewSummary(prometheus.SummaryOpts{
+			Namespace: prometheusNamespace,
+			Subsystem: prometheusSubsystem,
+			Name:      "run_seconds",
+			Help:      "Anomaly detection run duration.",
+		}),
+		anomalyDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(prometheusNamespace, prometheusSubsystem, "query_anomaly"),
+			"Query anomaly found by the last detection run, always 1.",
+			[]string{"type", "service_id", "service_name", "service_type", "queryid"}, nil),
+		zScoreDesc: prometheus.NewDesc(
+			prometheus.BuildFQName(prometheusNamespace, prometheusSubsystem, "query_anomaly_z_score"),
+			"Number of standard deviations between the query metric in the detection window and its baseline mean.",
+			[]string{"type", "service_id", "service_name", "service_type", "queryid"}, nil),
+	}
+
+	d.mLastRun = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: prometheusNamespace,
+		Subsystem: prometheusSubsystem,
+		Name:      "last_run_timestamp_seconds",
+		Help:      "Time of the last successful anomaly detection run.",
+	}, func() float64 {
+		_, evaluatedAt := d.Anomalies()
+		if evaluatedAt.IsZero() {
+			return 0
+		}
+		return float64(evaluatedAt.Unix())
+	})
+
+	// initialize metrics with labels
+	d.mRuns.WithLabelValues("0")
+	d.mRuns.WithLabelValues("1")
+
+	return d
+}
+
+// Describe implements prometheus.Collector interface.
+func (d *Detector) Describe(ch chan<- *prometheus.Desc) {
+	d.mRuns.Describe(ch)
+	d.mRunSeconds.Describe(ch)
+	d.mLastRun.Describe(ch)
+	ch <- d.anomalyDesc
+	ch <- d.zScoreDesc
+}
+
+// Collect implements prometheus.Collector interface.
+func (d *Detector) Collect(ch chan<- prometheus.Metric) {
+	d.mRuns.Collect(ch)
+	d.mRunSeconds.Collect(ch)
+	d.mLastRun.Collect(ch)
+
+	anomalies, _ := d.Anomalies()
+	for _, a := range anomalies {
+		labels := []string{TypeLabel(a.Type), a.ServiceId, a.ServiceName, a.ServiceType, a.Queryid}
+		ch <- prometheus.MustNewConstMetric(d.anomalyDesc, prometheus.GaugeValue, 1, labels...)
+		if a.Type != qanpb.QueryAnomalyType_QUERY_ANOMALY_TYPE_PLAN_FLIP {
+			ch <- prometheus.MustNewConstMetric(d.zScoreDesc, prometheus.GaugeValue, a.ZScore, labels...)
+		}
+	}
+}
+
+// Anomalies returns anomalies found by the last detection run and the time of that run.
+func (d *Detector) Anomalies() ([]*qanpb.QueryAnomaly, time.Time) {
+	d.rw.RLock()
+	defer d.rw.RUnlock()
+
+	return d.anomalies, d.evaluatedAt
+}
+
+// Run runs anomaly detection until context is canceled.
+func (d *Detector) Run(ctx context.Context) {
+	d.l.Infof("Starting with interval %s and baseline %s.", d.params.Interval, d.params.Baseline)
+
+	ticker := time.NewTicker(d.params.Interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.runOnce(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			d.l.Errorf("Anomaly detection failed: %s.", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// nothing
+		}
+	}
+}
+
+// runOnce detects anomalies in the last complete detection window before the given time.
+func (d *Detector) runOnce(ctx context.Context, now time.Time) error {
+	start := time.Now()
+	bucketSec := int64(d.params.Interval.Seconds())
+	windowEnd := now.Add(-ingestionDelay).Truncate(d.params.Interval)
+	windowStart := windowEnd.Add(-d.params.Interval)
+	baselineStart := windowStart.Add(-d.params.Baseline).Truncate(d.params.Interval)
+
+	stats, err := d.qs.SelectQueryStats(ctx, baselineStart.Unix(), windowStart.Unix(), windowEnd.Unix(), bucketSec)
+	d.mRunSeconds.Observe(time.Since(start).Seconds())
+	if err != nil {
+		d.mRuns.WithLabelValues("1").Inc()
+		return err
+	}
+	d.mRuns.WithLabelValues("0").Inc()
+
+	baselineBuckets := uint64(windowStart.Sub(baselineStart) / d.params.Interval)
+	anomalies := findAnomalies(stats, d.params, baselineBuckets)
+	for _, a := range anomalies {
+		a.WindowStart = timestamppb.New(windowStart)
+		a.WindowEnd = timestamppb.New(windowEnd)
+	}
+	d.l.Debugf("Found %d anomalies in %d queries in %s.", len(anomalies), len(stats), time.Since(start))
+
+	d.rw.Lock()
+	d.anomalies = anomalies
+	d.evaluatedAt = now
+	d.rw.Unlock()
+
+	return nil
+}
+
+// findAnomalies compares query metrics in the detection window with their baselines.
+// Anomalies are sorted by significance.
+func findAnomalies(stats []*models.QueryStats, params Params, baselineBuckets uint64) []*qanpb.QueryAnomaly {
+	bucketSec := params.Interval.Seconds()
+
+	var res []*qanpb.QueryAnomaly
+	for _, s := range stats {
+		if s.BaselineBuckets < params.MinBaselineBuckets {
+			// new queries do not have a baseline to compare with
+			continue
+		}
+
+		newAnomaly := func(t qanpb.QueryAnomalyType) *qanpb.QueryAnomaly {
+			return &qanpb.QueryAnomaly{
+				Type:        t,
+				ServiceId:   s.ServiceID,
+				ServiceName: s.ServiceName,
+				ServiceType: s.ServiceType,
+				Queryid:     s.QueryID,
+				Fingerprint: s.Fingerprint,
+			}
+		}
+		check := func(t qanpb.QueryAnomalyType, current, mean, stddev float64) {
+			if mean <= 0 || current < mean*params.MinRatio {
+				return
+			}
+			stddev = max(stddev, mean*minRelativeStddev)
+			z := (current - mean) / stddev
+			if z < params.ZScoreThreshold {
+				return
+			}
+			a := newAnomaly(t)
+			a.CurrentValue = current
+			a.BaselineMean = mean
+			a.BaselineStddev = stddev
+			a.ZScore = z
+			res = append(res, a)
+		}
+
+		if s.CurrentQueries >= params.MinWindowQueries {
+			queries := float64(s.CurrentQueries)
+			check(qanpb.QueryAnomalyType_QUERY_ANOMALY_TYPE_LATENCY,
+				s.CurrentQueryTime/queries, s.LatencyMean, s.LatencyStddev)
+			check(qanpb.QueryAnomalyType_QUERY_ANOMALY_TYPE_ROWS_EXAMINED,
+				s.CurrentRowsExamined/queries, s.RowsExaminedMean, s.RowsExaminedStddev)
+
+			// Call rate baseline includes buckets without executions.
+			if baselineBuckets > 0 {
+				n := float64(baselineBuckets)
+				mean := float64(s.BaselineQueries) / n / bucketSec
+				variance := float64(s.BaselineQueriesSq)/n/(bucketSec*bucketSec) - mean*mean
+				check(qanpb.QueryAnomalyType_QUERY_ANOMALY_TYPE_CALL_RATE,
+					queries/bucketSec, mean, math.Sqrt(max(variance, 0)))
+			}
+		}
+
+		if len(s.BaselinePlans) != 0 {
+			var newPlans []string
+			for _, p := range s.CurrentPlans {
+				if !slices.Contains(s.BaselinePlans, p) {
+					newPlans = append(newPlans, p)
+				}
+			}
+			if len(newPlans) != 0 {
+				a := newAnomaly(qanpb.QueryAnomalyType_QUERY_ANOMALY_TYPE_PLAN_FLIP)
+				a.NewPlanIds = slices.Sorted(slices.Values(newPlans))
+				a.BaselinePlanIds = slices.Sorted(slices.Values(s.BaselinePlans))
+				res = append(res, a)
+			}
+		}
+	}
+
+	// Plan flips do not have a z-score, keep them before regressions.
+	slices.SortStableFunc(res, func(a, b *qanpb.QueryAnomaly) int {
+		aFlip := a.Type == qanpb.QueryAnomalyType_QUERY_ANOMALY_TYPE_PLAN_FLIP
+		bFlip := b.Type == qanpb.QueryAnomalyType_QUERY_ANOMALY_TYPE_PLAN_FLIP
+		if aFlip != bFlip {
+			if aFlip {
+				return -1
+			}
+			return 1
+		}
+		return cmp.Compare(b.ZScore, a.ZScore)
+	})
+
+	if len(res) > maxAnomalies {
+		res = res[:maxAnomalies]
+	}
+
+	return res
+}
+
+// TypeLabel returns anomaly type as a short lowercase string, e.g. "plan_flip".
+func TypeLabel(t qanpb.QueryAnomalyType) string {
+	return strings.TrimPrefix(strings.ToLower(t.String()), "query_anomaly_type_")
+}
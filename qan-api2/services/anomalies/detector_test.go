@@ -0,0 +1,183 @@
+// Copyright (C) 2023 Percona LLC
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program. If not, see <https://www.gnu.org/licenses/>.
+package anomalies
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	qanpb "github.com/percona/pmm/api/qan/v1"
+	"github.com/percona/pmm/qan-api2/models"
+)
+
+var testParams = Params{
+	Interval:           5 * time.Minute,
+	Baseline:           24 * time.Hour,
+	ZScoreThreshold:    3,
+	MinRatio:           1.5,
+	MinBaselineBuckets: 12,
+	MinWindowQueries:   10,
+}
+
+type fakeQueryStatsSelector struct {
+	stats []*models.QueryStats
+	args  []int64
+}
+
+func (f *fakeQueryStatsSelector) SelectQueryStats(_ context.Context, baselineStartSec, windowStartSec, windowEndSec, bucketSec int64) ([]*models.QueryStats, error) {
+	f.args = []int64{baselineStartSec, windowStartSec, windowEndSec, bucketSec}
+	return f.stats, nil
+}
+
+func TestFindAnomalies(t *testing.T) {
+	t.Parallel()
+
+	// 288 five-minute buckets in 24 hours, 600 queries in each, 2 queries per second on average
+	stable := func() *models.QueryStats {
+		return &models.QueryStats{
+			ServiceID:           "service-1",
+			ServiceName:         "mysql-1",
+			ServiceType:         "mysql",
+			QueryID:             "Q1",
+			BaselineBuckets:     288,
+			LatencyMean:         0.01,
+			LatencyStddev:       0.001,
+			RowsExaminedMean:    100,
+			RowsExaminedStddev:  10,
+			BaselineQueries:     288 * 600,
+			BaselineQueriesSq:   288 * 600 * 600,
+			CurrentQueries:      600,
+			CurrentQueryTime:    600 * 0.01,
+			CurrentRowsExamined: 600 * 100,
+			BaselinePlans:       []string{"P1"},
+			CurrentPlans:        []string{"P1"},
+		}
+	}
+
+	t.Run("Stable", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, findAnomalies([]*models.QueryStats{stable()}, testParams, 288))
+	})
+
+	t.Run("Regressions", func(t *testing.T) {
+		t.Parallel()
+
+		s := stable()
+		s.CurrentQueries = 6000
+		s.CurrentQueryTime = 6000 * 0.05
+		s.CurrentRowsExamined = 6000 * 120 // below the minimal ratio
+
+		res := findAnomalies([]*models.QueryStats{s}, testParams, 288)
+		require.Len(t, res, 2)
+
+		// call rate: 20 qps vs 2 qps, the baseline stddev is 0, so the minimal one is used
+		assert.Equal(t, qanpb.QueryAnomalyType_QUERY_ANOMALY_TYPE_CALL_RATE, res[0].Type)
+		assert.InDelta(t, 20, res[0].CurrentValue, 1e-9)
+		assert.InDelta(t, 2, res[0].BaselineMean, 1e-9)
+		assert.InDelta(t, 0.2, res[0].BaselineStddev, 1e-9)
+		assert.InDelta(t, 90, res[0].ZScore, 1e-6)
+
+		assert.Equal(t, qanpb.QueryAnomalyType_QUERY_ANOMALY_TYPE_LATENCY, res[1].Type)
+		assert.InDelta(t, 0.05, res[1].CurrentValue, 1e-9)
+		assert.InDelta(t, 40, res[1].ZScore, 1e-6)
+		assert.Equal(t, "Q1", res[1].Queryid)
+		assert.Equal(t, "mysql-1", res[1].ServiceName)
+	})
+
+	t.Run("PlanFlip", func(t *testing.T) {
+		t.Parallel()
+
+		s := stable()
+		s.CurrentPlans = []string{"P3", "P1", "P2"}
+
+		res := findAnomalies([]*models.QueryStats{s}, testParams, 288)
+		require.Len(t, res, 1)
+		assert.Equal(t, qanpb.QueryAnomalyType_QUERY_ANOMALY_TYPE_PLAN_FLIP, res[0].Type)
+		assert.Equal(t, []string{"P2", "P3"}, res[0].NewPlanIds)
+		assert.Equal(t, []string{"P1"}, res[0].BaselinePlanIds)
+	})
+
+	t.Run("NoBaseline", func(t *testing.T) {
+		t.Parallel()
+
+		s := stable()
+		s.BaselineBuckets = 3
+		s.CurrentQueryTime = 600 * 0.05
+		s.CurrentPlans = []string{"P2"}
+
+		assert.Empty(t, findAnomalies([]*models.QueryStats{s}, testParams, 288))
+	})
+
+	t.Run("TooFewQueries", func(t *testing.T) {
+		t.Parallel()
+
+		s := stable()
+		s.CurrentQueries = 5
+		s.CurrentQueryTime = 5 * 0.05
+
+		assert.Empty(t, findAnomalies([]*models.QueryStats{s}, testParams, 288))
+	})
+}
+
+func TestDetector(t *testing.T) {
+	t.Parallel()
+
+	s := &models.QueryStats{
+		ServiceID:         "service-1",
+		ServiceName:       "pg-1",
+		ServiceType:       "postgresql",
+		QueryID:           "Q1",
+		BaselineBuckets:   288,
+		LatencyMean:       0.01,
+		BaselineQueries:   288 * 600,
+		BaselineQueriesSq: 288 * 600 * 600,
+		CurrentQueries:    600,
+		CurrentQueryTime:  600 * 0.1,
+		BaselinePlans:     []string{"P1"},
+		CurrentPlans:      []string{"P2"},
+	}
+	qs := &fakeQueryStatsSelector{stats: []*models.QueryStats{s}}
+	d := NewDetector(qs, testParams)
+
+	anomalies, evaluatedAt := d.Anomalies()
+	assert.Empty(t, anomalies)
+	assert.True(t, evaluatedAt.IsZero())
+
+	now := time.Date(2026, 1, 2, 10, 13, 0, 0, time.UTC)
+	require.NoError(t, d.runOnce(t.Context(), now))
+
+	windowEnd := time.Date(2026, 1, 2, 10, 10, 0, 0, time.UTC)
+	windowStart := windowEnd.Add(-5 * time.Minute)
+	assert.Equal(t, []int64{windowStart.Add(-24 * time.Hour).Unix(), windowStart.Unix(), windowEnd.Unix(), 300}, qs.args)
+
+	anomalies, evaluatedAt = d.Anomalies()
+	assert.Equal(t, now, evaluatedAt)
+	require.Len(t, anomalies, 2)
+	assert.Equal(t, qanpb.QueryAnomalyType_QUERY_ANOMALY_TYPE_PLAN_FLIP, anomalies[0].Type)
+	assert.Equal(t, qanpb.QueryAnomalyType_QUERY_ANOMALY_TYPE_LATENCY, anomalies[1].Type)
+	assert.Equal(t, windowStart, anomalies[1].WindowStart.AsTime())
+	assert.Equal(t, windowEnd, anomalies[1].WindowEnd.AsTime())
+
+	// 2 anomalies, 1 z-score, 2 runs counters, run duration summary and last run gauge
+	assert.Equal(t, 7, testutil.CollectAndCount(d))
+	assert.Equal(t, 2, testutil.CollectAndCount(d, "qan_api2_anomaly_detection_query_anomaly"))
+	assert.Equal(t, "plan_flip", TypeLabel(anomalies[0].Type))
+}
@@ -0,0 +1,255 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package secrets resolves references to credentials stored in external secret providers.
+//
+// Supported references are:
+//   - vault://<mount>/<path>#<key> - key of the secret in HashiCorp Vault KV version 2 secrets engine;
+//   - file:///<path> - content of the file;
+//   - exec:///<path>?arg=<arg1>&arg=<arg2> - standard output of the executable.
+//
+// Files and executables are resolved only if they are inside one of the directories allowed by the Resolver.
+// Trailing newline characters are removed from file content and executable output.
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Supported secret reference schemes.
+const (
+	SchemeVault = "vault"
+	SchemeFile  = "file"
+	SchemeExec  = "exec"
+)
+
+// defaultTimeout is the timeout for resolving a single reference.
+const defaultTimeout = 10 * time.Second
+
+// IsReference returns true if the given string looks like a secret reference of a supported scheme.
+// Use Validate to check that it is well-formed.
+func IsReference(s string) bool {
+	for _, scheme := range []string{SchemeVault, SchemeFile, SchemeExec} {
+		if strings.HasPrefix(s, scheme+"://") {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error if the given string is not a valid secret reference.
+func Validate(ref string) error {
+	_, err := parse(ref)
+	return err
+}
+
+// reference represents a parsed secret reference.
+type reference struct {
+	scheme string
+	// path is the secret path without mount for Vault, and the absolute path for files and executables
+	path  string
+	mount string   // Vault only
+	key   string   // Vault only
+	args  []string // executables only
+}
+
+func parse(ref string) (*reference, error) {
+	if !IsReference(ref) {
+		return nil, fmt.Errorf("unsupported secret reference scheme, expected one of %s://, %s://, %s://", SchemeVault, SchemeFile, SchemeExec)
+	}
+
+	u, err := url.Parse(ref)
+	if err != nil {
+		return nil, errors.New("failed to parse secret reference")
+	}
+
+	switch u.Scheme {
+	case SchemeVault:
+		p := strings.Trim(u.Path, "/")
+		if u.Host == "" || p == "" || u.Fragment == "" {
+			return nil, errors.New("vault secret reference should be in the form vault://<mount>/<path>#<key>")
+		}
+		return &reference{scheme: u.Scheme, mount: u.Host, path: p, key: u.Fragment}, nil
+
+	case SchemeFile:
+		if u.Host != "" || !path.IsAbs(u.Path) || u.RawQuery != "" || u.Fragment != "" {
+			return nil, errors.New("file secret reference should be in the form file:///<absolute path>")
+		}
+		return &reference{scheme: u.Scheme, path: u.Path}, nil
+
+	case SchemeExec:
+		if u.Host != "" || !path.IsAbs(u.Path) || u.Fragment != "" {
+			return nil, errors.New("exec secret reference should be in the form exec:///<absolute path>?arg=<argument>")
+		}
+		q := u.Query()
+		for k := range q {
+			if k != "arg" {
+				return nil, fmt.Errorf("unexpected exec secret reference parameter %q", k)
+			}
+		}
+		return &reference{scheme: u.Scheme, path: u.Path, args: q["arg"]}, nil
+
+	default:
+		// unreachable due to the IsReference check above
+		return nil, fmt.Errorf("unsupported secret reference scheme %q", u.Scheme)
+	}
+}
+
+// Resolver resolves secret references.
+//
+// Vault address, token, and namespace are taken from VAULT_ADDR, VAULT_TOKEN, and VAULT_NAMESPACE
+// environment variables.
+type Resolver struct {
+	allowedDirs    []string
+	vaultAddr      string
+	vaultToken     string
+	vaultNamespace string
+	client         *http.Client
+}
+
+// NewResolver creates a new Resolver configured from the environment.
+// File and exec references are resolved only for files and executables inside allowedDirs;
+// they are rejected if allowedDirs is empty.
+func NewResolver(allowedDirs []string) *Resolver {
+	return &Resolver{
+		allowedDirs:    allowedDirs,
+		vaultAddr:      os.Getenv("VAULT_ADDR"),
+		vaultToken:     os.Getenv("VAULT_TOKEN"),
+		vaultNamespace: os.Getenv("VAULT_NAMESPACE"),
+		client:         &http.Client{Timeout: defaultTimeout},
+	}
+}
+
+// Resolve returns the secret value for the given reference.
+// Returned errors never contain secret values.
+func (r *Resolver) Resolve(ctx context.Context, ref string) (string, error) {
+	parsed, err := parse(ref)
+	if err != nil {
+		return "", err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, defaultTimeout)
+	defer cancel()
+
+	switch parsed.scheme {
+	case SchemeVault:
+		return r.resolveVault(ctx, parsed)
+	case SchemeFile:
+		if err = r.checkPath(parsed); err != nil {
+			return "", err
+		}
+		return resolveFile(parsed)
+	case SchemeExec:
+		if err = r.checkPath(parsed); err != nil {
+			return "", err
+		}
+		return resolveExec(ctx, parsed)
+	default:
+		return "", fmt.Errorf("unsupported secret reference scheme %q", parsed.scheme)
+	}
+}
+
+// ResolveAll resolves all given references by name and returns secret values by the same names.
+func (r *Resolver) ResolveAll(ctx context.Context, refs map[string]string) (map[string]string, error) {
+	if len(refs) == 0 {
+		return nil, nil //nolint:nilnil
+	}
+
+	res := make(map[string]string, len(refs))
+	for name, ref := range refs {
+		v, err := r.Resolve(ctx, ref)
+		if err != nil {
+			return nil, fmt.Errorf("failed to resolve secret %q: %w", name, err)
+		}
+		res[name] = v
+	}
+	return res, nil
+}
+
+// checkPath returns an error if the referenced file or executable is not inside one of the allowed directories.
+// On success, the reference path is replaced with the path without symbolic links.
+func (r *Resolver) checkPath(ref *reference) error {
+	if len(r.allowedDirs) == 0 {
+		return fmt.Errorf("%s secret references are disabled: no allowed directories are configured", ref.scheme)
+	}
+
+	// check the path as is first to not reveal anything about files outside of allowed directories,
+	// then check the real path to not follow symbolic links out of them
+	p := filepath.Clean(ref.path)
+	if !inDirs(p, r.allowedDirs) {
+		return fmt.Errorf("%s is outside of allowed directories", p)
+	}
+	resolved, err := filepath.EvalSymlinks(p)
+	if err != nil {
+		return err
+	}
+
+	dirs := make([]string, 0, len(r.allowedDirs))
+	for _, dir := range r.allowedDirs {
+		if d, err := filepath.EvalSymlinks(dir); err == nil {
+			dirs = append(dirs, d)
+		}
+	}
+	if !inDirs(resolved, dirs) {
+		return fmt.Errorf("%s is outside of allowed directories", p)
+	}
+
+	ref.path = resolved
+	return nil
+}
+
+// inDirs returns true if the given clean absolute path is inside one of the given directories.
+func inDirs(p string, dirs []string) bool {
+	for _, dir := range dirs {
+		rel, err := filepath.Rel(filepath.Clean(dir), p)
+		if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		return true
+	}
+	return false
+}
+
+func resolveFile(ref *reference) (string, error) {
+	b, err := os.ReadFile(ref.path)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimRight(string(b), "\r\n"), nil
+}
+
+func resolveExec(ctx context.Context, ref *reference) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, ref.path, ref.args...) //nolint:gosec
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%w: %s", err, msg)
+		}
+		return "", err
+	}
+	return strings.TrimRight(stdout.String(), "\r\n"), nil
+}
@@ -0,0 +1,198 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, ref := range []string{
+		"vault://kv/mysql/prod#password",
+		"file:///etc/pmm/secrets/mysql",
+		"exec:///usr/local/bin/get-secret",
+		"exec:///usr/local/bin/get-secret?arg=mysql&arg=prod",
+	} {
+		assert.True(t, IsReference(ref), ref)
+		assert.NoError(t, Validate(ref), ref)
+	}
+
+	for _, ref := range []string{
+		"vault://kv/mysql/prod",
+		"vault://kv#password",
+		"file://etc/pmm/secrets/mysql",
+		"file:///etc/pmm/secrets/mysql#password",
+		"exec://get-secret",
+		"exec:///usr/local/bin/get-secret?name=mysql",
+	} {
+		assert.True(t, IsReference(ref), ref)
+		assert.Error(t, Validate(ref), ref)
+	}
+
+	for _, s := range []string{"", "s3cur3 p@ss", "https://example.com/", "vault:kv/mysql#password"} {
+		assert.False(t, IsReference(s), s)
+		assert.Error(t, Validate(s), s)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	t.Run("File", func(t *testing.T) {
+		t.Parallel()
+
+		p := filepath.Join(dir, "password")
+		require.NoError(t, os.WriteFile(p, []byte("s3cur3 p@ss\n"), 0o600))
+
+		v, err := NewResolver([]string{dir}).Resolve(ctx, "file://"+p)
+		require.NoError(t, err)
+		assert.Equal(t, "s3cur3 p@ss", v)
+
+		_, err = NewResolver([]string{dir}).Resolve(ctx, "file://"+filepath.Join(dir, "missing"))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("Exec", func(t *testing.T) {
+		t.Parallel()
+
+		p := filepath.Join(dir, "plugin.sh")
+		script := "#!/bin/sh\n" +
+			"if [ \"$1\" = fail ]; then echo 'no such secret' >&2; exit 1; fi\n" +
+			"echo \"secret-$1-$2\"\n"
+		require.NoError(t, os.WriteFile(p, []byte(script), 0o700)) //nolint:gosec
+
+		v, err := NewResolver([]string{dir}).Resolve(ctx, "exec://"+p+"?arg=mysql&arg=prod")
+		require.NoError(t, err)
+		assert.Equal(t, "secret-mysql-prod", v)
+
+		_, err = NewResolver([]string{dir}).Resolve(ctx, "exec://"+p+"?arg=fail")
+		assert.ErrorContains(t, err, "no such secret")
+	})
+
+	t.Run("AllowedDirs", func(t *testing.T) {
+		t.Parallel()
+
+		allowed := filepath.Join(dir, "allowed")
+		require.NoError(t, os.Mkdir(allowed, 0o700))
+		p := filepath.Join(allowed, "password")
+		require.NoError(t, os.WriteFile(p, []byte("s3cur3 p@ss"), 0o600))
+		outside := filepath.Join(dir, "outside")
+		require.NoError(t, os.WriteFile(outside, []byte("other"), 0o600))
+		link := filepath.Join(allowed, "link")
+		require.NoError(t, os.Symlink(outside, link))
+
+		_, err := NewResolver(nil).Resolve(ctx, "file://"+p)
+		assert.EqualError(t, err, "file secret references are disabled: no allowed directories are configured")
+		_, err = NewResolver(nil).Resolve(ctx, "exec://"+p)
+		assert.EqualError(t, err, "exec secret references are disabled: no allowed directories are configured")
+
+		r := NewResolver([]string{allowed})
+		v, err := r.Resolve(ctx, "file://"+p)
+		require.NoError(t, err)
+		assert.Equal(t, "s3cur3 p@ss", v)
+
+		_, err = r.Resolve(ctx, "file://"+outside)
+		assert.EqualError(t, err, outside+" is outside of allowed directories")
+		_, err = r.Resolve(ctx, "file://"+allowed+"/../outside")
+		assert.EqualError(t, err, outside+" is outside of allowed directories")
+		_, err = r.Resolve(ctx, "file://"+link)
+		assert.EqualError(t, err, link+" is outside of allowed directories")
+		_, err = r.Resolve(ctx, "exec:///bin/echo?arg=secret")
+		assert.EqualError(t, err, "/bin/echo is outside of allowed directories")
+	})
+
+	t.Run("Vault", func(t *testing.T) {
+		t.Parallel()
+
+		// stand-in for Vault server in dev mode with KV version 2 secrets engine mounted at kv/
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Header().Set("Content-Type", "application/json")
+			if req.Header.Get("X-Vault-Token") != "root" {
+				w.WriteHeader(http.StatusForbidden)
+				_ = json.NewEncoder(w).Encode(map[string]any{"errors": []string{"permission denied"}})
+				return
+			}
+			if req.Method != http.MethodGet || req.URL.Path != "/v1/kv/data/mysql/prod" {
+				w.WriteHeader(http.StatusNotFound)
+				_ = json.NewEncoder(w).Encode(map[string]any{"errors": []string{}})
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]any{
+				"data": map[string]any{
+					"data":     map[string]any{"username": "pmm", "password": "s3cur3 p@ss", "port": 3306},
+					"metadata": map[string]any{"version": 1},
+				},
+			})
+		}))
+		t.Cleanup(srv.Close)
+
+		r := NewResolver(nil)
+		r.vaultAddr = srv.URL
+		r.vaultToken = "root"
+
+		v, err := r.Resolve(ctx, "vault://kv/mysql/prod#password")
+		require.NoError(t, err)
+		assert.Equal(t, "s3cur3 p@ss", v)
+
+		_, err = r.Resolve(ctx, "vault://kv/mysql/prod#missing")
+		assert.EqualError(t, err, `key "missing" not found in Vault secret kv/mysql/prod`)
+
+		_, err = r.Resolve(ctx, "vault://kv/mysql/prod#port")
+		assert.EqualError(t, err, `key "port" of Vault secret kv/mysql/prod is not a string`)
+
+		_, err = r.Resolve(ctx, "vault://kv/mysql/dev#password")
+		assert.EqualError(t, err, "vault responded with status 404")
+
+		r.vaultToken = "invalid"
+		_, err = r.Resolve(ctx, "vault://kv/mysql/prod#password")
+		assert.EqualError(t, err, "vault responded with status 403: permission denied")
+
+		r.vaultAddr = ""
+		_, err = r.Resolve(ctx, "vault://kv/mysql/prod#password")
+		assert.EqualError(t, err, "VAULT_ADDR is not set")
+	})
+
+	t.Run("All", func(t *testing.T) {
+		t.Parallel()
+
+		p := filepath.Join(dir, "all")
+		require.NoError(t, os.WriteFile(p, []byte("value"), 0o600))
+
+		values, err := NewResolver([]string{dir}).ResolveAll(ctx, map[string]string{"password": "file://" + p})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"password": "value"}, values)
+
+		_, err = NewResolver([]string{dir}).ResolveAll(ctx, map[string]string{"password": "file://" + filepath.Join(dir, "missing")})
+		assert.ErrorContains(t, err, `failed to resolve secret "password"`)
+
+		values, err = NewResolver(nil).ResolveAll(ctx, nil)
+		require.NoError(t, err)
+		assert.Nil(t, values)
+	})
+}
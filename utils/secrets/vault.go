@@ -0,0 +1,84 @@
+// Copyright (C) 2023 Percona LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// maxVaultResponseSize is the maximum size of Vault response body.
+const maxVaultResponseSize = 1024 * 1024
+
+// vaultResponse represents a response of Vault KV version 2 read secret API.
+type vaultResponse struct {
+	Data struct {
+		Data map[string]any `json:"data"`
+	} `json:"data"`
+	Errors []string `json:"errors"`
+}
+
+// resolveVault reads the secret from Vault KV version 2 secrets engine.
+// See https://developer.hashicorp.com/vault/api-docs/secret/kv/kv-v2#read-secret-version.
+func (r *Resolver) resolveVault(ctx context.Context, ref *reference) (string, error) {
+	if r.vaultAddr == "" {
+		return "", errors.New("VAULT_ADDR is not set")
+	}
+
+	u := strings.TrimRight(r.vaultAddr, "/") + "/v1/" + ref.mount + "/data/" + ref.path
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return "", err
+	}
+	if r.vaultToken != "" {
+		req.Header.Set("X-Vault-Token", r.vaultToken)
+	}
+	if r.vaultNamespace != "" {
+		req.Header.Set("X-Vault-Namespace", r.vaultNamespace)
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close() //nolint:errcheck
+
+	var res vaultResponse
+	if err = json.NewDecoder(io.LimitReader(resp.Body, maxVaultResponseSize)).Decode(&res); err != nil {
+		return "", fmt.Errorf("failed to decode Vault response (status %d): %w", resp.StatusCode, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if len(res.Errors) != 0 {
+			return "", fmt.Errorf("vault responded with status %d: %s", resp.StatusCode, strings.Join(res.Errors, "; "))
+		}
+		return "", fmt.Errorf("vault responded with status %d", resp.StatusCode)
+	}
+
+	v, ok := res.Data.Data[ref.key]
+	if !ok {
+		return "", fmt.Errorf("key %q not found in Vault secret %s/%s", ref.key, ref.mount, ref.path)
+	}
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("key %q of Vault secret %s/%s is not a string", ref.key, ref.mount, ref.path)
+	}
+	return s, nil
+}
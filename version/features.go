@@ -20,11 +20,13 @@ type FeatureVersion *Parsed
 
 // Features list.
 var (
-	NodeExporterNewTLSConfig      FeatureVersion = MustParse("3.0.0-0")
-	MysqlExporterV0_17_2          FeatureVersion = MustParse("3.2.0-0")
-	MysqlExporterPluginCollector  FeatureVersion = MustParse("2.36.0-0")
-	NomadAgentSupportVersion      FeatureVersion = MustParse("3.2.0-0")
-	MongoDBRtaAgentSupportVersion FeatureVersion = MustParse("3.7.0-0")
+	NodeExporterNewTLSConfig         FeatureVersion = MustParse("3.0.0-0")
+	MysqlExporterV0_17_2             FeatureVersion = MustParse("3.2.0-0")
+	MysqlExporterPluginCollector     FeatureVersion = MustParse("2.36.0-0")
+	NomadAgentSupportVersion         FeatureVersion = MustParse("3.2.0-0")
+	MongoDBRtaAgentSupportVersion    FeatureVersion = MustParse("3.7.0-0")
+	MySQLRtaAgentSupportVersion      FeatureVersion = MustParse("3.8.0-0")
+	PostgreSQLRtaAgentSupportVersion FeatureVersion = MustParse("3.8.0-0")
 )
 
 // IsFeatureSupported checks if the feature is supported by the version.